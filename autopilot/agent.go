@@ -231,6 +231,66 @@ func (a *Agent) stop() error {
 	return nil
 }
 
+// AgentStatus is a snapshot of the channel budget of the agent and of its
+// pending channel-open decisions.
+type AgentStatus struct {
+	// WalletBalance is the current available balance of the backing
+	// wallet.
+	WalletBalance dcrutil.Amount
+
+	// NumChans is the number of channels counted against the constraints
+	// of the agent, including the ones still pending.
+	NumChans uint32
+
+	// AvailableFunds is the amount of funds that can still be committed
+	// to new channels within the constraints of the agent.
+	AvailableFunds dcrutil.Amount
+
+	// NumChansAvailable is the number of additional channels that can be
+	// opened within the constraints of the agent.
+	NumChansAvailable uint32
+
+	// PendingConns is the set of nodes the agent is connecting to in
+	// order to open a channel with them.
+	PendingConns []NodeID
+
+	// PendingOpens is the set of channels the agent requested to be
+	// opened, but that weren't confirmed yet.
+	PendingOpens []Channel
+}
+
+// Status returns a snapshot of the channel budget of the agent, as computed
+// from the current wallet balance and channels, and of its pending
+// channel-open decisions.
+func (a *Agent) Status() (*AgentStatus, error) {
+	balance, err := a.cfg.WalletBalance()
+	if err != nil {
+		return nil, err
+	}
+
+	status := &AgentStatus{
+		WalletBalance: balance,
+	}
+
+	a.chanStateMtx.Lock()
+	a.pendingMtx.Lock()
+	totalChans := mergeChanState(a.pendingOpens, a.chanState)
+	for nodeID := range a.pendingConns {
+		status.PendingConns = append(status.PendingConns, nodeID)
+	}
+	for _, pendingChan := range a.pendingOpens {
+		status.PendingOpens = append(status.PendingOpens, pendingChan)
+	}
+	a.pendingMtx.Unlock()
+	a.chanStateMtx.Unlock()
+
+	status.NumChans = uint32(len(totalChans))
+	status.AvailableFunds, status.NumChansAvailable =
+		a.cfg.Constraints.ChannelBudget(totalChans, balance)
+
+	return status, nil
+}
+
 // balanceUpdate is a type of external state update that reflects an
 // increase/decrease in the funds currently available to the wallet.
 type balanceUpdate struct {
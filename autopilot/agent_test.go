@@ -4,13 +4,16 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"reflect"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/davecgh/go-spew/spew"
 	"github.com/decred/dcrd/dcrec/secp256k1/v2"
 	"github.com/decred/dcrd/dcrutil/v2"
 	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/lnwire"
 )
 
 type moreChansResp struct {
@@ -1344,3 +1347,69 @@ func TestAgentChannelSizeAllocation(t *testing.T) {
 
 	checkChannelOpens(t, testCtx, expectedAllocation, numNewChannels)
 }
+
+// TestAgentStatus asserts that the status of the agent reports its channel
+// budget, computed from the wallet balance and both its confirmed and pending
+// channels, along with its pending channel-open decisions.
+func TestAgentStatus(t *testing.T) {
+	t.Parallel()
+
+	var nodes [3]NodeID
+	for i := range nodes {
+		pub, err := randKey()
+		if err != nil {
+			t.Fatalf("unable to generate key: %v", err)
+		}
+		nodes[i] = NewNodeID(pub)
+	}
+
+	initialChans := []Channel{
+		{
+			ChanID:   lnwire.NewShortChanIDFromInt(1),
+			Capacity: dcrutil.AtomsPerCoin,
+			Node:     nodes[0],
+		},
+	}
+	agent, err := New(Config{
+		WalletBalance: func() (dcrutil.Amount, error) {
+			return 10 * dcrutil.AtomsPerCoin, nil
+		},
+		Constraints: NewConstraints(
+			dcrutil.AtomsPerCoin/10, 5*dcrutil.AtomsPerCoin, 5, 10,
+			0.5,
+		),
+	}, initialChans)
+	if err != nil {
+		t.Fatalf("unable to create agent: %v", err)
+	}
+
+	// Track a pending open and a pending connection, as if the agent had
+	// decided to open channels with these nodes.
+	pendingChan := Channel{
+		Capacity: 2 * dcrutil.AtomsPerCoin,
+		Node:     nodes[1],
+	}
+	agent.pendingOpens[nodes[1]] = pendingChan
+	agent.pendingConns[nodes[2]] = struct{}{}
+
+	status, err := agent.Status()
+	if err != nil {
+		t.Fatalf("unable to get status: %v", err)
+	}
+
+	// With 3 DCR committed to channels out of a total of 13 DCR, the
+	// agent can commit 3.5 DCR more to reach its 50% allocation, and open
+	// 3 more channels before reaching its limit of 5.
+	expectedStatus := &AgentStatus{
+		WalletBalance:     10 * dcrutil.AtomsPerCoin,
+		NumChans:          2,
+		AvailableFunds:    dcrutil.Amount(3.5 * dcrutil.AtomsPerCoin),
+		NumChansAvailable: 3,
+		PendingConns:      []NodeID{nodes[2]},
+		PendingOpens:      []Channel{pendingChan},
+	}
+	if !reflect.DeepEqual(status, expectedStatus) {
+		t.Fatalf("expected status %v, got %v",
+			spew.Sdump(expectedStatus), spew.Sdump(status))
+	}
+}
@@ -262,6 +262,20 @@ func (m *Manager) StopAgent() error {
 	return nil
 }
 
+// AgentStatus returns a snapshot of the channel budget and pending
+// channel-open decisions of the active autopilot agent, or nil if no agent is
+// active.
+func (m *Manager) AgentStatus() (*AgentStatus, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	if m.pilot == nil {
+		return nil, nil
+	}
+
+	return m.pilot.Status()
+}
+
 // QueryHeuristics queries the available autopilot heuristics for node scores.
 func (m *Manager) QueryHeuristics(nodes []NodeID, localState bool) (
 	HeuristicScores, error) {
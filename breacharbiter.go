@@ -41,6 +41,24 @@ var (
 	errBrarShuttingDown = errors.New("breacharbiter shutting down")
 )
 
+const (
+	// blocksPassedSplitPublish is the number of blocks after the breach
+	// confirmed without all the breached outputs being swept, after which
+	// the justice transaction is split into one sweeping the commitment
+	// outputs and one sweeping the HTLC outputs.
+	blocksPassedSplitPublish = 4
+
+	// justiceTxRebroadcastInterval is the number of blocks between each
+	// rebroadcast of the split justice transactions with a higher fee
+	// rate.
+	justiceTxRebroadcastInterval = 2
+
+	// maxJusticeFeeMultiplier is the maximum factor applied to the
+	// estimated fee rate of the justice transactions as they get
+	// rebroadcast.
+	maxJusticeFeeMultiplier = 8
+)
+
 // ContractBreachEvent is an event the breachArbiter will receive in case a
 // contract breach is observed on-chain. It contains the necessary information
 // to handle the breach, and a ProcessACK channel we will use to ACK the event
@@ -79,6 +97,10 @@ type BreachConfig struct {
 	// transactions.
 	Estimator lnwallet.FeeEstimator
 
+	// ConfTarget is the confirmation target used to estimate the fee rate
+	// of the justice transactions.
+	ConfTarget uint32
+
 	// GenSweepScript generates the receiving scripts for swept outputs.
 	GenSweepScript func() ([]byte, error)
 
@@ -241,6 +263,21 @@ func (b *breachArbiter) Stop() error {
 	return nil
 }
 
+// HandlePendingBreach is called once a revoked commitment of a channel is
+// detected in the mempool, before it's confirmed. The link of the channel is
+// closed right away, so that no further HTLCs are sent over it. The justice
+// transaction is only crafted once the breach confirms and is handed off
+// through ContractBreaches.
+func (b *breachArbiter) HandlePendingBreach(chanPoint wire.OutPoint,
+	breachTx *wire.MsgTx) {
+
+	brarLog.Warnf("REVOKED STATE FOR ChannelPoint(%v) broadcast to the "+
+		"mempool in %v, REMOTE PEER IS DOING SOMETHING SKETCHY!!!",
+		chanPoint, breachTx.TxHash())
+
+	b.cfg.CloseLink(&chanPoint, htlcswitch.CloseBreach)
+}
+
 // IsBreached queries the breach arbiter's retribution store to see if it is
 // aware of any channel breaches for a particular channel point.
 func (b *breachArbiter) IsBreached(chanPoint *wire.OutPoint) (bool, error) {
@@ -315,24 +352,24 @@ func convertToSecondLevelRevoke(bo *breachedOutput, breachInfo *retributionInfo,
 		bo.outpoint)
 }
 
+// breachSpend wraps the index of the breached output that gets spent together
+// with the spend details.
+type breachSpend struct {
+	index  int
+	detail *chainntnfs.SpendDetail
+}
+
 // waitForSpendEvent waits for any of the breached outputs to get spent, and
-// mutates the breachInfo to be able to sweep it. This method should be used
-// when we fail to publish the justice tx because of a double spend, indicating
-// that the counter party has taken one of the breached outputs to the second
-// level. The spendNtfns map is a cache used to store registered spend
-// subscriptions, in case we must call this method multiple times.
+// returns the spends detected. The breached outputs can be spent by our own
+// justice transactions, or by the counter party taking one of the HTLC outputs
+// to the second level. The spendNtfns map is a cache used to store registered
+// spend subscriptions, in case we must call this method multiple times.
 func (b *breachArbiter) waitForSpendEvent(breachInfo *retributionInfo,
-	spendNtfns map[wire.OutPoint]*chainntnfs.SpendEvent) error {
+	spendNtfns map[wire.OutPoint]*chainntnfs.SpendEvent) ([]breachSpend,
+	error) {
 
 	inputs := breachInfo.breachedOutputs
 
-	// spend is used to wrap the index of the output that gets spent
-	// together with the spend details.
-	type spend struct {
-		index  int
-		detail *chainntnfs.SpendDetail
-	}
-
 	// We create a channel the first goroutine that gets a spend event can
 	// signal. We make it buffered in case multiple spend events come in at
 	// the same time.
@@ -340,14 +377,14 @@ func (b *breachArbiter) waitForSpendEvent(breachInfo *retributionInfo,
 
 	// The allSpends channel will be used to pass spend events from all the
 	// goroutines that detects a spend before they are signalled to exit.
-	allSpends := make(chan spend, len(inputs))
+	allSpends := make(chan breachSpend, len(inputs))
 
 	// exit will be used to signal the goroutines that they can exit.
 	exit := make(chan struct{})
 	var wg sync.WaitGroup
 
-	// We'll now launch a goroutine for each of the HTLC outputs, that will
-	// signal the moment they detect a spend event.
+	// We'll now launch a goroutine for each of the breached outputs, that
+	// will signal the moment they detect a spend event.
 	for i := range inputs {
 		breachedOutput := &inputs[i]
 
@@ -375,7 +412,7 @@ func (b *breachArbiter) waitForSpendEvent(breachInfo *retributionInfo,
 				// to avoid entering an infinite loop.
 				select {
 				case <-b.quit:
-					return errBrarShuttingDown
+					return nil, errBrarShuttingDown
 				default:
 					continue
 				}
@@ -391,7 +428,7 @@ func (b *breachArbiter) waitForSpendEvent(breachInfo *retributionInfo,
 			defer wg.Done()
 
 			select {
-			// The output has been taken to the second level!
+			// The output has been spent!
 			case sp, ok := <-spendEv.Spend:
 				if !ok {
 					return
@@ -407,7 +444,7 @@ func (b *breachArbiter) waitForSpendEvent(breachInfo *retributionInfo,
 				// First we send the spend event on the
 				// allSpends channel, such that it can be
 				// handled after all go routines have exited.
-				allSpends <- spend{index, sp}
+				allSpends <- breachSpend{index, sp}
 
 				// Finally we'll signal the anySpend channel
 				// that a spend was detected, such that the
@@ -435,15 +472,70 @@ func (b *breachArbiter) waitForSpendEvent(breachInfo *retributionInfo,
 		// channel before ranging over its content.
 		close(allSpends)
 
-		doneOutputs := make(map[int]struct{})
+		var spends []breachSpend
 		for s := range allSpends {
-			breachedOutput := &inputs[s.index]
-			delete(spendNtfns, breachedOutput.outpoint)
+			delete(spendNtfns, inputs[s.index].outpoint)
+			spends = append(spends, s)
+		}
+
+		return spends, nil
+
+	case <-b.quit:
+		return nil, errBrarShuttingDown
+	}
+}
 
-			switch breachedOutput.witnessType {
-			case input.HtlcAcceptedRevoke:
-				fallthrough
-			case input.HtlcOfferedRevoke:
+// isRevokeSpend returns true if the breached HTLC output was spent through its
+// revocation clause, which is how our justice transactions spend it.
+func isRevokeSpend(bo *breachedOutput,
+	spendDetails *chainntnfs.SpendDetail) (bool, error) {
+
+	for _, txIn := range spendDetails.SpendingTx.TxIn {
+		if txIn.PreviousOutPoint != bo.outpoint {
+			continue
+		}
+
+		return input.IsHtlcSpendRevoke(txIn, &bo.signDesc)
+	}
+
+	return false, nil
+}
+
+// updateBreachInfo mutates the breachInfo according to the spends of its
+// breached outputs: the outputs that can no longer be swept are removed, while
+// the HTLC outputs taken to the second level by the counter party are
+// converted to sweep the second level output instead. The total amount of the
+// outputs removed, along with the amount that was revoked from the counter
+// party, are returned.
+func updateBreachInfo(breachInfo *retributionInfo,
+	spends []breachSpend) (dcrutil.Amount, dcrutil.Amount) {
+
+	inputs := breachInfo.breachedOutputs
+	doneOutputs := make(map[int]struct{})
+
+	var totalFunds, revokedFunds dcrutil.Amount
+	for _, s := range spends {
+		breachedOutput := &inputs[s.index]
+
+		switch breachedOutput.witnessType {
+		case input.HtlcAcceptedRevoke:
+			fallthrough
+		case input.HtlcOfferedRevoke:
+			// If the HTLC output was spent using the revocation
+			// key, it was swept by one of our justice
+			// transactions. Otherwise it was taken to the second
+			// level by the counter party.
+			revoked, err := isRevokeSpend(breachedOutput, s.detail)
+			if err != nil {
+				brarLog.Errorf("Unable to determine the spend "+
+					"path of %s(%v) for ChannelPoint(%v): "+
+					"%v", breachedOutput.witnessType,
+					breachedOutput.outpoint,
+					breachInfo.chanPoint, err)
+				continue
+			}
+
+			if !revoked {
 				brarLog.Infof("Spend on second-level"+
 					"%s(%v) for ChannelPoint(%v) "+
 					"transitions to second-level output",
@@ -453,44 +545,70 @@ func (b *breachArbiter) waitForSpendEvent(breachInfo *retributionInfo,
 
 				// In this case we'll morph our initial revoke
 				// spend to instead point to the second level
-				// output, and update the sign descriptor in the
-				// process.
+				// output, and update the sign descriptor in
+				// the process.
 				convertToSecondLevelRevoke(
 					breachedOutput, breachInfo, s.detail,
 				)
 
 				continue
 			}
+		}
 
-			brarLog.Infof("Spend on %s(%v) for ChannelPoint(%v) "+
-				"transitions output to terminal state, "+
-				"removing input from justice transaction",
-				breachedOutput.witnessType,
-				breachedOutput.outpoint, breachInfo.chanPoint)
-
-			doneOutputs[s.index] = struct{}{}
+		// If the output being removed is the remote commitment output
+		// or an offered HTLC output, its amount contributes to the
+		// value of funds being revoked from the counter party.
+		switch breachedOutput.witnessType {
+		case input.CommitmentRevoke, input.HtlcOfferedRevoke:
+			revokedFunds += breachedOutput.Amount()
 		}
+		totalFunds += breachedOutput.Amount()
 
-		// Filter the inputs for which we can no longer proceed.
-		var nextIndex int
-		for i := range inputs {
-			if _, ok := doneOutputs[i]; ok {
-				continue
-			}
+		brarLog.Infof("Spend on %s(%v) for ChannelPoint(%v) "+
+			"transitions output to terminal state, "+
+			"removing input from justice transaction",
+			breachedOutput.witnessType,
+			breachedOutput.outpoint, breachInfo.chanPoint)
 
-			inputs[nextIndex] = inputs[i]
-			nextIndex++
+		doneOutputs[s.index] = struct{}{}
+	}
+
+	// Filter the inputs for which we can no longer proceed.
+	var nextIndex int
+	for i := range inputs {
+		if _, ok := doneOutputs[i]; ok {
+			continue
 		}
 
-		// Update our remaining set of outputs before continuing with
-		// another attempt at publication.
-		breachInfo.breachedOutputs = inputs[:nextIndex]
+		inputs[nextIndex] = inputs[i]
+		nextIndex++
+	}
 
-	case <-b.quit:
-		return errBrarShuttingDown
+	// Update our remaining set of outputs before continuing with another
+	// attempt at publication.
+	breachInfo.breachedOutputs = inputs[:nextIndex]
+
+	return totalFunds, revokedFunds
+}
+
+// publishJusticeTx attempts to broadcast a justice transaction, if any. A
+// failure is only logged, as the breached outputs are watched for spends
+// regardless of whether the publication succeeded.
+func (b *breachArbiter) publishJusticeTx(tx *wire.MsgTx, sweeping string) {
+	if tx == nil {
+		return
 	}
 
-	return nil
+	brarLog.Debugf("Broadcasting justice tx sweeping %v: %v", sweeping,
+		newLogClosure(func() string {
+			return spew.Sdump(tx)
+		}))
+
+	err := b.cfg.PublishTransaction(tx)
+	if err != nil {
+		brarLog.Errorf("Unable to broadcast justice tx sweeping %v: %v",
+			sweeping, err)
+	}
 }
 
 // exactRetribution is a goroutine which is executed once a contract breach has
@@ -525,77 +643,94 @@ func (b *breachArbiter) exactRetribution(confChan *chainntnfs.ConfirmationEvent,
 	brarLog.Debugf("Breach transaction %v has been confirmed, sweeping "+
 		"revoked funds", breachInfo.commitHash)
 
-	// We may have to wait for some of the HTLC outputs to be spent to the
-	// second level before broadcasting the justice tx. We'll store the
-	// SpendEvents between each attempt to not re-register uneccessarily.
+	// We'll register for block notifications, such that in case our
+	// justice transaction doesn't confirm within a reasonable timeframe,
+	// we can split it and bump the fees of its parts.
+	blockEpochs, err := b.cfg.Notifier.RegisterBlockEpochNtfn(nil)
+	if err != nil {
+		brarLog.Errorf("Unable to register for block notifications: %v",
+			err)
+		return
+	}
+	defer blockEpochs.Cancel()
+
+	// We'll wait for the breached outputs to be spent before crafting new
+	// justice transactions. We'll store the SpendEvents between each
+	// attempt to not re-register unnecessarily.
 	spendNtfns := make(map[wire.OutPoint]*chainntnfs.SpendEvent)
 
-	finalTx, err := b.cfg.Store.GetFinalizedTxn(&breachInfo.chanPoint)
+	var (
+		totalFunds, revokedFunds dcrutil.Amount
+
+		// feeMultiplier is the factor applied to the estimated fee rate
+		// of the justice transactions, doubled each time the split
+		// justice transactions are rebroadcast.
+		feeMultiplier int64 = 1
+
+		// splitHeight is the height at which the split justice
+		// transactions are next broadcast.
+		splitHeight    = breachConfHeight + blocksPassedSplitPublish
+		splitBroadcast bool
+	)
+
+justiceTxBroadcast:
+	// With the breach transaction confirmed, we now create the justice
+	// transactions which will claim ALL the funds within the channel.
+	justiceTxs, err := b.createJusticeTx(
+		breachInfo.breachedOutputs, feeMultiplier,
+	)
 	if err != nil {
-		brarLog.Errorf("Unable to get finalized txn for"+
-			"chanid=%v: %v", &breachInfo.chanPoint, err)
+		brarLog.Errorf("Unable to create justice tx: %v", err)
 		return
 	}
 
-	// If this retribution has not been finalized before, we will first
-	// construct a sweep transaction and write it to disk. This will allow
-	// the breach arbiter to re-register for notifications for the justice
-	// txid.
-justiceTxBroadcast:
-	if finalTx == nil {
-		// With the breach transaction confirmed, we now create the
-		// justice tx which will claim ALL the funds within the
-		// channel.
-		finalTx, err = b.createJusticeTx(breachInfo)
-		if err != nil {
-			brarLog.Errorf("Unable to create justice tx: %v", err)
-			return
-		}
+	// We'll now attempt to broadcast the transaction which finalized the
+	// channel's retribution against the cheating counter party.
+	b.publishJusticeTx(justiceTxs.spendAll, "all outputs")
+
+	// Regardless of whether the publication succeeded, we now wait for any
+	// of the breached outputs to be spent. If any of them got spent by the
+	// counter party, or only some of them by our justice transactions, we
+	// must recreate our justice transactions.
+	var (
+		spendChan = make(chan []breachSpend, 1)
+		errChan   = make(chan error, 1)
+		wg        sync.WaitGroup
+	)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
 
-		// Persist our finalized justice transaction before making an
-		// attempt to broadcast.
-		err := b.cfg.Store.Finalize(&breachInfo.chanPoint, finalTx)
+		spends, err := b.waitForSpendEvent(breachInfo, spendNtfns)
 		if err != nil {
-			brarLog.Errorf("Unable to finalize justice tx for "+
-				"chanid=%v: %v", &breachInfo.chanPoint, err)
+			errChan <- err
 			return
 		}
-	}
+		spendChan <- spends
+	}()
+
+	for {
+		select {
+		case spends := <-spendChan:
+			wg.Wait()
 
-	brarLog.Debugf("Broadcasting justice tx: %v", newLogClosure(func() string {
-		return spew.Sdump(finalTx)
-	}))
+			// Update the breach info with the new spends.
+			swept, revoked := updateBreachInfo(breachInfo, spends)
+			totalFunds += swept
+			revokedFunds += revoked
 
-	// We'll now attempt to broadcast the transaction which finalized the
-	// channel's retribution against the cheating counter party.
-	err = b.cfg.PublishTransaction(finalTx)
-	if err != nil {
-		brarLog.Errorf("Unable to broadcast justice tx: %v", err)
-
-		if err == lnwallet.ErrDoubleSpend {
-			// Broadcasting the transaction failed because of a
-			// conflict either in the mempool or in chain. We'll
-			// now create spend subscriptions for all HTLC outputs
-			// on the commitment transaction that could possibly
-			// have been spent, and wait for any of them to
-			// trigger.
-			brarLog.Infof("Waiting for a spend event before " +
-				"attempting to craft new justice tx.")
-			finalTx = nil
-
-			err := b.waitForSpendEvent(breachInfo, spendNtfns)
-			if err != nil {
-				if err != errBrarShuttingDown {
-					brarLog.Errorf("error waiting for "+
-						"spend event: %v", err)
-				}
-				return
-			}
+			brarLog.Infof("%v spends from breach tx for "+
+				"ChannelPoint(%v) have been detected, %v "+
+				"revoked funds (%v total) have been swept",
+				len(spends), breachInfo.chanPoint,
+				revokedFunds, totalFunds)
 
 			if len(breachInfo.breachedOutputs) == 0 {
-				brarLog.Debugf("No more outputs to sweep for "+
-					"breach, marking ChannelPoint(%v) "+
-					"fully resolved", breachInfo.chanPoint)
+				brarLog.Infof("Justice for ChannelPoint(%v) "+
+					"has been served, no more outputs to "+
+					"sweep, marking fully resolved",
+					breachInfo.chanPoint)
 
 				err = b.cleanupBreach(&breachInfo.chanPoint)
 				if err != nil {
@@ -603,6 +738,12 @@ justiceTxBroadcast:
 						"breached ChannelPoint(%v): %v",
 						breachInfo.chanPoint, err)
 				}
+
+				// TODO(roasbeef): add peer to blacklist?
+
+				// TODO(roasbeef): close other active channels
+				// with offending peer
+
 				return
 			}
 
@@ -611,69 +752,75 @@ justiceTxBroadcast:
 				len(breachInfo.breachedOutputs))
 
 			goto justiceTxBroadcast
-		}
-	}
-
-	// As a conclusionary step, we register for a notification to be
-	// dispatched once the justice tx is confirmed. After confirmation we
-	// notify the caller that initiated the retribution workflow that the
-	// deed has been done.
-	justiceTXID := finalTx.TxHash()
-	justiceScript := finalTx.TxOut[0].PkScript
-	confChan, err = b.cfg.Notifier.RegisterConfirmationsNtfn(
-		&justiceTXID, justiceScript, 1, breachConfHeight,
-	)
-	if err != nil {
-		brarLog.Errorf("Unable to register for conf for txid(%v): %v",
-			justiceTXID, err)
-		return
-	}
 
-	select {
-	case _, ok := <-confChan.Confirmed:
-		if !ok {
-			return
-		}
+		// On every new block, we check whether the justice transaction
+		// should be split, or its split parts rebroadcast with a
+		// higher fee rate.
+		case epoch, ok := <-blockEpochs.Epochs:
+			if !ok {
+				return
+			}
 
-		// Compute both the total value of funds being swept and the
-		// amount of funds that were revoked from the counter party.
-		var totalFunds, revokedFunds dcrutil.Amount
-		for _, inp := range breachInfo.breachedOutputs {
-			totalFunds += inp.Amount()
-
-			// If the output being revoked is the remote commitment
-			// output or an offered HTLC output, it's amount
-			// contributes to the value of funds being revoked from
-			// the counter party.
-			switch inp.WitnessType() {
-			case input.CommitmentRevoke:
-				revokedFunds += inp.Amount()
-			case input.HtlcOfferedRevoke:
-				revokedFunds += inp.Amount()
-			default:
+			// The justice transaction was published with a
+			// 2-block fee estimate, so it's not unexpected that a
+			// few blocks pass without it confirming.
+			if uint32(epoch.Height) < splitHeight {
+				continue
 			}
-		}
+			splitHeight = uint32(epoch.Height) +
+				justiceTxRebroadcastInterval
+
+			brarLog.Warnf("Block height %v arrived without justice "+
+				"tx for ChannelPoint(%v) confirming (breach "+
+				"confirmed at height %v), splitting justice tx",
+				epoch.Height, breachInfo.chanPoint,
+				breachConfHeight)
+
+			// Once the split justice transactions have been
+			// broadcast, each rebroadcast doubles their fee rate
+			// up to maxJusticeFeeMultiplier times the estimated
+			// one. As dcrd doesn't replace the transactions in its
+			// mempool, this only helps when the previous ones were
+			// rejected or evicted.
+			canBump := splitBroadcast &&
+				feeMultiplier < maxJusticeFeeMultiplier
+			if canBump {
+				bumpedTxs, err := b.createJusticeTx(
+					breachInfo.breachedOutputs,
+					feeMultiplier*2,
+				)
+				if err != nil {
+					brarLog.Warnf("Unable to bump fee of "+
+						"justice tx: %v", err)
+				} else {
+					justiceTxs = bumpedTxs
+					feeMultiplier *= 2
+				}
+			}
+			splitBroadcast = true
+
+			// We'll attempt to publish the two separate justice
+			// transactions that sweep the commitment outputs and
+			// the HTLC outputs separately. This is to mitigate the
+			// case where our "spend all" justice tx doesn't
+			// propagate because the HTLC outputs have been pinned
+			// by low fee HTLC txs, or already spent to the second
+			// level.
+			b.publishJusticeTx(
+				justiceTxs.spendCommitOuts, "commitment outputs",
+			)
+			b.publishJusticeTx(justiceTxs.spendHTLCs, "HTLC outputs")
 
-		brarLog.Infof("Justice for ChannelPoint(%v) has "+
-			"been served, %v revoked funds (%v total) "+
-			"have been claimed", breachInfo.chanPoint,
-			revokedFunds, totalFunds)
+		case err := <-errChan:
+			if err != errBrarShuttingDown {
+				brarLog.Errorf("error waiting for spend "+
+					"event: %v", err)
+			}
+			return
 
-		err = b.cleanupBreach(&breachInfo.chanPoint)
-		if err != nil {
-			brarLog.Errorf("Failed to cleanup breached "+
-				"ChannelPoint(%v): %v", breachInfo.chanPoint,
-				err)
+		case <-b.quit:
+			return
 		}
-
-		// TODO(roasbeef): add peer to blacklist?
-
-		// TODO(roasbeef): close other active channels with offending
-		// peer
-
-		return
-	case <-b.quit:
-		return
 	}
 }
 
@@ -900,6 +1047,12 @@ func (bo *breachedOutput) CraftInputScript(signer input.Signer, txn *wire.MsgTx,
 // must be built on top of the confirmation height before the output can be
 // spent.
 func (bo *breachedOutput) BlocksToMaturity() uint32 {
+	// The output paying to us on the commitment of a channel with anchors
+	// needs to be confirmed for a block before it can be spent.
+	if bo.witnessType == input.CommitmentToRemoteConfirmed {
+		return 1
+	}
+
 	return 0
 }
 
@@ -949,11 +1102,20 @@ func newRetributionInfo(chanPoint *wire.OutPoint,
 	// it is not considered dust, which is signaled by a non-nil sign
 	// descriptor. Here we use CommitmentNoDelay (or
 	// CommitmentNoDelayTweakless for newer commitments) since this output
-	// belongs to us and has no time-based constraints on spending.
+	// belongs to us and has no time-based constraints on spending, unless
+	// this is a channel with anchors, in which case the output must be
+	// confirmed for a block.
 	if breachInfo.LocalOutputSignDesc != nil {
-		witnessType := input.CommitmentNoDelay
-		if breachInfo.LocalOutputSignDesc.SingleTweak == nil {
+		var witnessType input.WitnessType
+		switch {
+		case breachInfo.LocalDelay != 0:
+			witnessType = input.CommitmentToRemoteConfirmed
+
+		case breachInfo.LocalOutputSignDesc.SingleTweak == nil:
 			witnessType = input.CommitSpendNoDelayTweakless
+
+		default:
+			witnessType = input.CommitmentNoDelay
 		}
 
 		localOutput := makeBreachedOutput(
@@ -1022,20 +1184,95 @@ func newRetributionInfo(chanPoint *wire.OutPoint,
 	}
 }
 
-// createJusticeTx creates a transaction which exacts "justice" by sweeping ALL
-// the funds within the channel which we are now entitled to due to a breach of
-// the channel's contract by the counterparty. This function returns a *fully*
-// signed transaction with the witness for each input fully in place.
-func (b *breachArbiter) createJusticeTx(
-	r *retributionInfo) (*wire.MsgTx, error) {
+// justiceTxVariants is a set of justice transactions sweeping the breached
+// outputs of a channel. All the outputs are first swept by a single
+// transaction, which may be split into one sweeping the commitment outputs and
+// one sweeping the HTLC outputs if it fails to confirm, so that pinned or
+// contested HTLC outputs don't prevent the commitment outputs from being swept.
+type justiceTxVariants struct {
+	spendAll        *wire.MsgTx
+	spendCommitOuts *wire.MsgTx
+	spendHTLCs      *wire.MsgTx
+}
 
-	// We will assemble the breached outputs into a slice of spendable
-	// outputs, while simultaneously computing the estimated size of the
-	// transaction.
-	//
-	// Allocate enough space to potentially hold each of the breached
-	// outputs in the retribution info.
-	spendableOutputs := make([]input.Input, 0, len(r.breachedOutputs))
+// createJusticeTx creates the transactions which exact "justice" by sweeping
+// ALL the funds within the channel which we are now entitled to due to a
+// breach of the channel's contract by the counterparty. The fee rate of the
+// transactions is feeMultiplier times the estimated one. This function returns
+// *fully* signed transactions with the witness for each input fully in place.
+func (b *breachArbiter) createJusticeTx(breachedOutputs []breachedOutput,
+	feeMultiplier int64) (*justiceTxVariants, error) {
+
+	var (
+		allInputs    []input.Input
+		commitInputs []input.Input
+		htlcInputs   []input.Input
+	)
+
+	// We iterate over the breached outputs, and sort them by the class of
+	// output they spend according to their witness type. If the witness
+	// type is unrecognized, we will omit it from the transactions.
+	for i := range breachedOutputs {
+		// Grab locally scoped reference to breached output.
+		inp := &breachedOutputs[i]
+
+		switch inp.WitnessType() {
+		case input.CommitSpendNoDelayTweakless,
+			input.CommitmentNoDelay,
+			input.CommitmentToRemoteConfirmed,
+			input.CommitmentRevoke:
+
+			commitInputs = append(commitInputs, inp)
+
+		case input.HtlcOfferedRevoke,
+			input.HtlcAcceptedRevoke,
+			input.HtlcSecondLevelRevoke:
+
+			htlcInputs = append(htlcInputs, inp)
+
+		default:
+			brarLog.Warnf("breached output in retribution info "+
+				"contains unexpected witness type: %v",
+				inp.WitnessType())
+			continue
+		}
+
+		allInputs = append(allInputs, inp)
+	}
+
+	var (
+		txs = &justiceTxVariants{}
+		err error
+	)
+
+	txs.spendAll, err = b.createSweepTx(feeMultiplier, allInputs...)
+	if err != nil {
+		return nil, err
+	}
+
+	txs.spendCommitOuts, err = b.createSweepTx(
+		feeMultiplier, commitInputs...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	txs.spendHTLCs, err = b.createSweepTx(feeMultiplier, htlcInputs...)
+	if err != nil {
+		return nil, err
+	}
+
+	return txs, nil
+}
+
+// createSweepTx creates a transaction sweeping the given breached outputs into
+// our wallet, or nil if there are no outputs to sweep.
+func (b *breachArbiter) createSweepTx(feeMultiplier int64,
+	inputs ...input.Input) (*wire.MsgTx, error) {
+
+	if len(inputs) == 0 {
+		return nil, nil
+	}
 
 	// The justice transaction we construct will be a transaction
 	// that pays to a p2pkh output. Components such as the version,
@@ -1043,17 +1280,10 @@ func (b *breachArbiter) createJusticeTx(
 	var sizeEstimate input.TxSizeEstimator
 	sizeEstimate.AddP2PKHOutput()
 
-	// Next, we iterate over the breached outputs contained in the
-	// retribution info.  For each, we switch over the witness type such
-	// that we contribute the appropriate size for each input and witness,
-	// finally adding to our list of spendable outputs.
-	for i := range r.breachedOutputs {
-		// Grab locally scoped reference to breached output.
-		inp := &r.breachedOutputs[i]
-
-		// First, select the appropriate estimated sig script size for
-		// the give witness type of this breached output. If the witness
-		// type is unrecognized, we will omit it from the transaction.
+	// Next, we iterate over the breached outputs. For each, we switch over
+	// the witness type such that we contribute the appropriate size for
+	// each input and witness.
+	for _, inp := range inputs {
 		var sigScriptSize int64
 		switch inp.WitnessType() {
 		case input.CommitSpendNoDelayTweakless:
@@ -1061,37 +1291,46 @@ func (b *breachArbiter) createJusticeTx(
 		case input.CommitmentNoDelay:
 			sigScriptSize = input.P2PKHSigScriptSize
 
+		case input.CommitmentToRemoteConfirmed:
+			sigScriptSize = input.ToRemoteConfirmedSigScriptSize
+
 		case input.CommitmentRevoke:
 			sigScriptSize = input.ToLocalPenaltySigScriptSize
 
 		case input.HtlcOfferedRevoke:
 			sigScriptSize = input.OfferedHtlcPenaltySigScriptSize
+			if input.IsConfirmedSpendHTLCScript(
+				inp.SignDesc().WitnessScript,
+			) {
+				sigScriptSize = input.OfferedHtlcPenaltyConfirmedSigScriptSize
+			}
 
 		case input.HtlcAcceptedRevoke:
 			sigScriptSize = input.AcceptedHtlcPenaltySigScriptSize
+			if input.IsConfirmedSpendHTLCScript(
+				inp.SignDesc().WitnessScript,
+			) {
+				sigScriptSize = input.AcceptedHtlcPenaltyConfirmedSigScriptSize
+			}
 
 		case input.HtlcSecondLevelRevoke:
 			sigScriptSize = input.ToLocalPenaltySigScriptSize
 
 		default:
-			brarLog.Warnf("breached output in retribution info "+
-				"contains unexpected witness type: %v",
+			return nil, fmt.Errorf("unexpected witness type: %v",
 				inp.WitnessType())
-			continue
 		}
 		sizeEstimate.AddCustomInput(sigScriptSize)
-
-		// Finally, append this input to our list of spendable outputs.
-		spendableOutputs = append(spendableOutputs, inp)
 	}
 
 	txSize := sizeEstimate.Size()
-	return b.sweepSpendableOutputsTxn(txSize, spendableOutputs...)
+	return b.sweepSpendableOutputsTxn(txSize, feeMultiplier, inputs...)
 }
 
 // sweepSpendableOutputsTxn creates a signed transaction from a sequence of
-// spendable outputs by sweeping the funds into a single p2wkh output.
-func (b *breachArbiter) sweepSpendableOutputsTxn(txSize int64,
+// spendable outputs by sweeping the funds into a single p2wkh output. The fee
+// rate of the transaction is feeMultiplier times the estimated one.
+func (b *breachArbiter) sweepSpendableOutputsTxn(txSize, feeMultiplier int64,
 	inputs ...input.Input) (*wire.MsgTx, error) {
 
 	// First, we obtain a new public key script from the wallet which we'll
@@ -1109,20 +1348,29 @@ func (b *breachArbiter) sweepSpendableOutputsTxn(txSize int64,
 		totalAmt += dcrutil.Amount(input.SignDesc().Output.Value)
 	}
 
-	// We'll actually attempt to target inclusion within the next two
-	// blocks as we'd like to sweep these funds back into our wallet ASAP.
-	feePerKB, err := b.cfg.Estimator.EstimateFeePerKB(2)
+	// We'll attempt to target inclusion within the next few blocks (two
+	// by default) as we'd like to sweep these funds back into our wallet
+	// ASAP.
+	feePerKB, err := b.cfg.Estimator.EstimateFeePerKB(b.cfg.ConfTarget)
 	if err != nil {
 		return nil, err
 	}
+	feePerKB *= lnwallet.AtomPerKByte(feeMultiplier)
 	txFee := feePerKB.FeeForSize(txSize)
 
 	// TODO(roasbeef): already start to siphon their funds into fees
 	sweepAmt := int64(totalAmt - txFee)
+	if sweepAmt <= 0 {
+		return nil, fmt.Errorf("fee of %v exceeds the %v swept", txFee,
+			totalAmt)
+	}
 
 	// With the fee calculated, we can now create the transaction using the
 	// information gathered above and the provided retribution information.
+	// We use a transaction version of 2 since CSV will fail unless the tx
+	// version is >= 2.
 	txn := wire.NewMsgTx()
+	txn.Version = input.LNTxVersion
 
 	// We begin by adding the output to which our funds will be deposited.
 	txn.AddTxOut(&wire.TxOut{
@@ -1136,6 +1384,7 @@ func (b *breachArbiter) sweepSpendableOutputsTxn(txSize int64,
 		txn.AddTxIn(&wire.TxIn{
 			ValueIn:          input.SignDesc().Output.Value,
 			PreviousOutPoint: *input.OutPoint(),
+			Sequence:         input.BlocksToMaturity(),
 		})
 	}
 
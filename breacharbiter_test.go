@@ -1200,7 +1200,7 @@ func TestBreachHandoffFail(t *testing.T) {
 }
 
 type publAssertion func(*testing.T, map[wire.OutPoint]*wire.MsgTx,
-	chan *wire.MsgTx)
+	chan *wire.MsgTx) *wire.MsgTx
 
 type breachTest struct {
 	name string
@@ -1210,9 +1210,10 @@ type breachTest struct {
 	// htlc is in effect "readded" to the set of inputs.
 	spend2ndLevel bool
 
-	// sendFinalConf informs the test to send a confirmation for the justice
-	// transaction before asserting the arbiter is cleaned up.
-	sendFinalConf bool
+	// spendJusticeTx informs the test to spend the remaining outputs with
+	// the last justice transaction published before asserting the arbiter
+	// is cleaned up.
+	spendJusticeTx bool
 
 	// whenNonZeroInputs is called after spending an input but there are
 	// further inputs to spend in the test.
@@ -1251,7 +1252,7 @@ var breachTests = []breachTest{
 		spend2ndLevel: true,
 		whenNonZeroInputs: func(t *testing.T,
 			inputs map[wire.OutPoint]*wire.MsgTx,
-			publTx chan *wire.MsgTx) {
+			publTx chan *wire.MsgTx) *wire.MsgTx {
 
 			var tx *wire.MsgTx
 			select {
@@ -1274,10 +1275,11 @@ var breachTests = []breachTest{
 				findInputIndex(t, in, tx)
 			}
 
+			return tx
 		},
 		whenZeroInputs: func(t *testing.T,
 			inputs map[wire.OutPoint]*wire.MsgTx,
-			publTx chan *wire.MsgTx) {
+			publTx chan *wire.MsgTx) *wire.MsgTx {
 
 			// Sanity check to ensure the brar doesn't try to
 			// broadcast another sweep, since all outputs have been
@@ -1287,25 +1289,30 @@ var breachTests = []breachTest{
 				t.Fatalf("tx published unexpectedly")
 			case <-time.After(50 * time.Millisecond):
 			}
+
+			return nil
 		},
 	},
 	{
-		name:          "commit spends, second level sweep",
-		spend2ndLevel: false,
-		sendFinalConf: true,
+		name:           "commit spends, second level sweep",
+		spend2ndLevel:  false,
+		spendJusticeTx: true,
 		whenNonZeroInputs: func(t *testing.T,
 			inputs map[wire.OutPoint]*wire.MsgTx,
-			publTx chan *wire.MsgTx) {
+			publTx chan *wire.MsgTx) *wire.MsgTx {
 
+			var tx *wire.MsgTx
 			select {
-			case <-publTx:
+			case tx = <-publTx:
 			case <-time.After(5 * time.Second):
 				t.Fatalf("tx was not published")
 			}
+
+			return tx
 		},
 		whenZeroInputs: func(t *testing.T,
 			inputs map[wire.OutPoint]*wire.MsgTx,
-			publTx chan *wire.MsgTx) {
+			publTx chan *wire.MsgTx) *wire.MsgTx {
 
 			// Now a transaction attempting to spend from the second
 			// level tx should be published instead. Let this
@@ -1338,6 +1345,8 @@ var breachTests = []breachTest{
 				t.Fatalf("tx not attempting to spend second "+
 					"level tx, %v", tx.TxIn[0])
 			}
+
+			return tx
 		},
 	},
 }
@@ -1361,12 +1370,11 @@ func testBreachSpends(t *testing.T, test breachTest) {
 	defer cleanUpArb()
 
 	var (
-		height       = bobClose.ChanSnapshot.CommitHeight
 		forceCloseTx = bobClose.CloseTx
-		chanPoint    = alice.ChanPoint
 		publTx       = make(chan *wire.MsgTx)
 		publErr      error
 		publMtx      sync.Mutex
+		justiceTx    *wire.MsgTx
 	)
 
 	// Make PublishTransaction always return ErrDoubleSpend to begin with.
@@ -1379,61 +1387,10 @@ func testBreachSpends(t *testing.T, test breachTest) {
 		return publErr
 	}
 
-	// Notify the breach arbiter about the breach.
-	retribution, err := lnwallet.NewBreachRetribution(
-		alice.State(), height, 1,
+	retribution := breachAndConfirm(
+		t, brar, alice, bobClose, contractBreaches,
 	)
-	if err != nil {
-		t.Fatalf("unable to create breach retribution: %v", err)
-	}
-
-	breach := &ContractBreachEvent{
-		ChanPoint:         *chanPoint,
-		ProcessACK:        make(chan error, 1),
-		BreachRetribution: retribution,
-	}
-	contractBreaches <- breach
-
-	// We'll also wait to consume the ACK back from the breach arbiter.
-	select {
-	case err := <-breach.ProcessACK:
-		if err != nil {
-			t.Fatalf("handoff failed: %v", err)
-		}
-	case <-time.After(time.Second * 15):
-		t.Fatalf("breach arbiter didn't send ack back")
-	}
-
-	state := alice.State()
-	err = state.CloseChannel(&channeldb.ChannelCloseSummary{
-		ChanPoint:               state.FundingOutpoint,
-		ChainHash:               state.ChainHash,
-		RemotePub:               state.IdentityPub,
-		CloseType:               channeldb.BreachClose,
-		Capacity:                state.Capacity,
-		IsPending:               true,
-		ShortChanID:             state.ShortChanID(),
-		RemoteCurrentRevocation: state.RemoteCurrentRevocation,
-		RemoteNextRevocation:    state.RemoteNextRevocation,
-		LocalChanConfig:         state.LocalChanCfg,
-	})
-	if err != nil {
-		t.Fatalf("unable to close channel: %v", err)
-	}
-
-	// After exiting, the breach arbiter should have persisted the
-	// retribution information and the channel should be shown as pending
-	// force closed.
-	assertArbiterBreach(t, brar, chanPoint)
-
-	// Assert that the database sees the channel as pending close, otherwise
-	// the breach arbiter won't be able to fully close it.
-	assertPendingClosed(t, alice)
-
-	// Notify that the breaching transaction is confirmed, to trigger the
-	// retribution logic.
 	notifier := brar.cfg.Notifier.(*mockSpendNotifier)
-	notifier.confChannel <- &chainntnfs.TxConfirmation{}
 
 	// The breach arbiter should attempt to sweep all outputs on the
 	// breached commitment. We'll pretend that the HTLC output has been
@@ -1505,26 +1462,221 @@ func testBreachSpends(t *testing.T, test breachTest) {
 		}
 
 		if len(inputs) > 0 {
-			test.whenNonZeroInputs(t, inputs, publTx)
+			justiceTx = test.whenNonZeroInputs(t, inputs, publTx)
 		} else {
 			// Reset the publishing error so that any publication,
 			// made by the breach arbiter, if any, will succeed.
 			publMtx.Lock()
 			publErr = nil
 			publMtx.Unlock()
-			test.whenZeroInputs(t, inputs, publTx)
+			justiceTx = test.whenZeroInputs(t, inputs, publTx)
 		}
 	}
 
-	// Deliver confirmation of sweep if the test expects it.
-	if test.sendFinalConf {
-		notifier.confChannel <- &chainntnfs.TxConfirmation{}
+	// Deliver the spends of the remaining outputs by the last justice
+	// transaction if the test expects it.
+	if test.spendJusticeTx {
+		for _, txIn := range justiceTx.TxIn {
+			op := txIn.PreviousOutPoint
+			notifier.Spend(&op, 3, justiceTx)
+		}
 	}
 
 	// Assert that the channel is fully resolved.
 	assertBrarCleanup(t, brar, alice.ChanPoint, alice.State().Db)
 }
 
+// TestBreachSplitJustice asserts that the breach arbiter splits the justice
+// transaction into one sweeping the commitment outputs and one sweeping the
+// HTLC outputs once it fails to confirm in time, that the split transactions
+// are rebroadcast with escalating fees, and that the spend of an HTLC output by
+// our own justice transaction isn't mistaken for a second level spend.
+func TestBreachSplitJustice(t *testing.T) {
+	brar, alice, _, bobClose, contractBreaches,
+		cleanUpChans, cleanUpArb := initBreachedState(t)
+	defer cleanUpChans()
+	defer cleanUpArb()
+
+	publTx := make(chan *wire.MsgTx)
+	brar.cfg.PublishTransaction = func(tx *wire.MsgTx) error {
+		publTx <- tx
+		return nil
+	}
+
+	retribution := breachAndConfirm(
+		t, brar, alice, bobClose, contractBreaches,
+	)
+	notifier := brar.cfg.Notifier.(*mockSpendNotifier)
+
+	localOutpoint := retribution.LocalOutpoint
+	remoteOutpoint := retribution.RemoteOutpoint
+	htlcOutpoint := retribution.HtlcRetributions[0].OutPoint
+
+	// assertPublished asserts that a transaction spending exactly the
+	// given outpoints is published.
+	assertPublished := func(ops ...wire.OutPoint) *wire.MsgTx {
+		t.Helper()
+
+		var tx *wire.MsgTx
+		select {
+		case tx = <-publTx:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("tx was not published")
+		}
+
+		if len(tx.TxIn) != len(ops) {
+			t.Fatalf("expected justice txn to have %d inputs, "+
+				"found %d", len(ops), len(tx.TxIn))
+		}
+		for _, op := range ops {
+			findInputIndex(t, op, tx)
+		}
+
+		return tx
+	}
+
+	assertNotPublished := func() {
+		t.Helper()
+
+		select {
+		case <-publTx:
+			t.Fatalf("tx published unexpectedly")
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+
+	sendBlock := func(height int32) {
+		t.Helper()
+
+		epoch := &chainntnfs.BlockEpoch{Height: height}
+		select {
+		case notifier.epochChan <- epoch:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("block %v was not delivered", height)
+		}
+	}
+
+	// The breach arbiter should first attempt to sweep all outputs with a
+	// single justice transaction.
+	assertPublished(localOutpoint, remoteOutpoint, htlcOutpoint)
+
+	// The justice transaction isn't split until blocksPassedSplitPublish
+	// blocks have passed since the breach confirmed.
+	for height := int32(1); height < blocksPassedSplitPublish; height++ {
+		sendBlock(height)
+		assertNotPublished()
+	}
+
+	// From then on, the commitment outputs and the htlc output are swept
+	// by separate transactions.
+	height := int32(blocksPassedSplitPublish)
+	sendBlock(height)
+	commitTx := assertPublished(localOutpoint, remoteOutpoint)
+	htlcTx := assertPublished(htlcOutpoint)
+
+	// The split transactions are rebroadcast with a higher fee rate once
+	// justiceTxRebroadcastInterval blocks have passed.
+	for i := int32(1); i < justiceTxRebroadcastInterval; i++ {
+		sendBlock(height + i)
+		assertNotPublished()
+	}
+	sendBlock(height + justiceTxRebroadcastInterval)
+	bumpedCommitTx := assertPublished(localOutpoint, remoteOutpoint)
+	bumpedHtlcTx := assertPublished(htlcOutpoint)
+
+	if bumpedCommitTx.TxOut[0].Value >= commitTx.TxOut[0].Value {
+		t.Fatalf("expected fee of commitment outputs sweep to be bumped")
+	}
+	if bumpedHtlcTx.TxOut[0].Value >= htlcTx.TxOut[0].Value {
+		t.Fatalf("expected fee of htlc output sweep to be bumped")
+	}
+
+	// Spending the commitment outputs leaves the remaining outputs to be
+	// swept by a new justice transaction.
+	notifier.Spend(&localOutpoint, 10, bumpedCommitTx)
+	assertPublished(remoteOutpoint, htlcOutpoint)
+
+	notifier.Spend(&remoteOutpoint, 10, bumpedCommitTx)
+	assertPublished(htlcOutpoint)
+
+	// The htlc output being spent by our justice transaction through its
+	// revocation clause, the retribution is complete.
+	notifier.Spend(&htlcOutpoint, 10, bumpedHtlcTx)
+	assertNotPublished()
+
+	assertBrarCleanup(t, brar, alice.ChanPoint, alice.State().Db)
+}
+
+// breachAndConfirm notifies the breach arbiter of the breach of alice's
+// channel by bobClose, and confirms the breach transaction once the breach is
+// persisted to trigger the retribution logic.
+func breachAndConfirm(t *testing.T, brar *breachArbiter,
+	alice *lnwallet.LightningChannel,
+	bobClose *lnwallet.LocalForceCloseSummary,
+	contractBreaches chan *ContractBreachEvent) *lnwallet.BreachRetribution {
+
+	t.Helper()
+
+	// Notify the breach arbiter about the breach.
+	height := bobClose.ChanSnapshot.CommitHeight
+	retribution, err := lnwallet.NewBreachRetribution(
+		alice.State(), height, 1,
+	)
+	if err != nil {
+		t.Fatalf("unable to create breach retribution: %v", err)
+	}
+
+	breach := &ContractBreachEvent{
+		ChanPoint:         *alice.ChanPoint,
+		ProcessACK:        make(chan error, 1),
+		BreachRetribution: retribution,
+	}
+	contractBreaches <- breach
+
+	// We'll also wait to consume the ACK back from the breach arbiter.
+	select {
+	case err := <-breach.ProcessACK:
+		if err != nil {
+			t.Fatalf("handoff failed: %v", err)
+		}
+	case <-time.After(time.Second * 15):
+		t.Fatalf("breach arbiter didn't send ack back")
+	}
+
+	state := alice.State()
+	err = state.CloseChannel(&channeldb.ChannelCloseSummary{
+		ChanPoint:               state.FundingOutpoint,
+		ChainHash:               state.ChainHash,
+		RemotePub:               state.IdentityPub,
+		CloseType:               channeldb.BreachClose,
+		Capacity:                state.Capacity,
+		IsPending:               true,
+		ShortChanID:             state.ShortChanID(),
+		RemoteCurrentRevocation: state.RemoteCurrentRevocation,
+		RemoteNextRevocation:    state.RemoteNextRevocation,
+		LocalChanConfig:         state.LocalChanCfg,
+	})
+	if err != nil {
+		t.Fatalf("unable to close channel: %v", err)
+	}
+
+	// After exiting, the breach arbiter should have persisted the
+	// retribution information and the channel should be shown as pending
+	// force closed.
+	assertArbiterBreach(t, brar, alice.ChanPoint)
+
+	// Assert that the database sees the channel as pending close, otherwise
+	// the breach arbiter won't be able to fully close it.
+	assertPendingClosed(t, alice)
+
+	// Notify that the breaching transaction is confirmed, to trigger the
+	// retribution logic.
+	notifier := brar.cfg.Notifier.(*mockSpendNotifier)
+	notifier.confChannel <- &chainntnfs.TxConfirmation{}
+
+	return retribution
+}
+
 // findInputIndex returns the index of the input that spends from the given
 // outpoint. This method fails if the outpoint is not found.
 func findInputIndex(t *testing.T, op wire.OutPoint, tx *wire.MsgTx) int {
@@ -1815,7 +1967,8 @@ func createInitChannels(revocationWindow int) (*lnwallet.LightningChannel, *lnwa
 
 	aliceCommitTx, bobCommitTx, err := lnwallet.CreateCommitmentTxns(
 		channelBal-initiatorFee, channelBal, &aliceCfg, &bobCfg, aliceCommitPoint,
-		bobCommitPoint, *fundingTxIn, chainParams, true,
+		bobCommitPoint, *fundingTxIn, chainParams,
+		channeldb.SingleFunderTweakless,
 	)
 	if err != nil {
 		return nil, nil, nil, err
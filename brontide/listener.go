@@ -22,7 +22,7 @@ const defaultHandshakes = 1000
 type Listener struct {
 	localStatic *secp256k1.PrivateKey
 
-	tcp *net.TCPListener
+	tcp net.Listener
 
 	handshakeSema chan struct{}
 	conns         chan maybeConn
@@ -46,6 +46,15 @@ func NewListener(localStatic *secp256k1.PrivateKey, listenAddr string) (*Listene
 		return nil, err
 	}
 
+	return NewListenerFrom(localStatic, l), nil
+}
+
+// NewListenerFrom returns a new net.Listener which enforces the Brontide scheme
+// on the connections accepted by the passed listener, which allows wrapping
+// listeners that handle a transport level protocol of their own.
+func NewListenerFrom(localStatic *secp256k1.PrivateKey,
+	l net.Listener) *Listener {
+
 	brontideListener := &Listener{
 		localStatic:   localStatic,
 		tcp:           l,
@@ -60,7 +69,7 @@ func NewListener(localStatic *secp256k1.PrivateKey, listenAddr string) (*Listene
 
 	go brontideListener.listen()
 
-	return brontideListener, nil
+	return brontideListener
 }
 
 // listen accepts connection from the underlying tcp conn, then performs
@@ -0,0 +1,837 @@
+package cfnotify
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/chaincfg/v2"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/gcs/blockcf"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/chainntnfs"
+	"github.com/decred/dcrlnd/queue"
+)
+
+const (
+	// notifierType uniquely identifies this concrete implementation of the
+	// ChainNotifier interface.
+	notifierType = "cfilters"
+
+	// defaultPollInterval is the interval at which the tip of the chain
+	// source is polled for new blocks.
+	defaultPollInterval = 5 * time.Second
+)
+
+// CfNotifier implements the ChainNotifier interface using the committed
+// filters of blocks, so that the chain source isn't required to index
+// transactions. The chain source is either a dcrd node (RPCChainSource) or
+// the chain IO of an SPV wallet (ChainIOSource). Blocks are only fetched in
+// full when their filter matches one of the watched outpoints or scripts.
+// Multiple concurrent clients are supported. All notifications are achieved
+// via non-blocking sends on client channels.
+type CfNotifier struct {
+	epochClientCounter uint64 // To be used atomically.
+
+	started int32 // To be used atomically.
+	stopped int32 // To be used atomically.
+
+	source      ChainSource
+	chainParams *chaincfg.Params
+
+	notificationCancels  chan interface{}
+	notificationRegistry chan interface{}
+
+	txNotifier *chainntnfs.TxNotifier
+
+	blockEpochClients map[uint64]*blockEpochRegistration
+
+	bestBlock chainntnfs.BlockEpoch
+
+	// pollInterval is the interval at which the tip of the chain source is
+	// polled for new blocks.
+	pollInterval time.Duration
+
+	// watched holds the filter entries of the registered requests.
+	watched *watchList
+
+	// connectMtx serializes the connection of new blocks with the
+	// registration of new requests, so that a request is either matched
+	// against the filter of a block or scanned for historically within
+	// it.
+	connectMtx sync.Mutex
+
+	// spendHintCache is a cache used to query and update the latest height
+	// hints for an outpoint. Each height hint represents the earliest
+	// height at which the outpoint could have been spent within the chain.
+	spendHintCache chainntnfs.SpendHintCache
+
+	// confirmHintCache is a cache used to query the latest height hints for
+	// a transaction. Each height hint represents the earliest height at
+	// which the transaction could have confirmed within the chain.
+	confirmHintCache chainntnfs.ConfirmHintCache
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// Ensure CfNotifier implements the ChainNotifier interface at compile time.
+var _ chainntnfs.ChainNotifier = (*CfNotifier)(nil)
+
+// New returns a new CfNotifier instance which watches the chain through the
+// given chain source.
+func New(source ChainSource, chainParams *chaincfg.Params,
+	spendHintCache chainntnfs.SpendHintCache,
+	confirmHintCache chainntnfs.ConfirmHintCache) (*CfNotifier, error) {
+
+	return &CfNotifier{
+		source:      source,
+		chainParams: chainParams,
+
+		notificationCancels:  make(chan interface{}),
+		notificationRegistry: make(chan interface{}),
+
+		blockEpochClients: make(map[uint64]*blockEpochRegistration),
+
+		pollInterval: defaultPollInterval,
+		watched:      newWatchList(),
+
+		spendHintCache:   spendHintCache,
+		confirmHintCache: confirmHintCache,
+
+		quit: make(chan struct{}),
+	}, nil
+}
+
+// Start fetches the current tip of the chain source and launches all related
+// helper goroutines.
+func (n *CfNotifier) Start() error {
+	// Already started?
+	if atomic.AddInt32(&n.started, 1) != 1 {
+		return nil
+	}
+
+	chainntnfs.Log.Infof("Starting committed filters notifier")
+
+	currentHash, currentHeight, err := n.source.GetBestBlock()
+	if err != nil {
+		return err
+	}
+
+	n.txNotifier = chainntnfs.NewTxNotifier(
+		uint32(currentHeight), chainntnfs.ReorgSafetyLimit,
+		n.confirmHintCache, n.spendHintCache, n.chainParams,
+	)
+
+	n.bestBlock = chainntnfs.BlockEpoch{
+		Height: int32(currentHeight),
+		Hash:   currentHash,
+	}
+
+	n.wg.Add(1)
+	go n.notificationDispatcher()
+
+	return nil
+}
+
+// Stop shuts down the CfNotifier.
+func (n *CfNotifier) Stop() error {
+	// Already shutting down?
+	if atomic.AddInt32(&n.stopped, 1) != 1 {
+		return nil
+	}
+
+	close(n.quit)
+	n.wg.Wait()
+
+	// Notify all pending clients of our shutdown by closing the related
+	// notification channels.
+	for _, epochClient := range n.blockEpochClients {
+		close(epochClient.cancelChan)
+		epochClient.wg.Wait()
+
+		close(epochClient.epochChan)
+	}
+	n.txNotifier.TearDown()
+
+	return nil
+}
+
+// notificationDispatcher is the primary goroutine which handles client
+// notification registrations, as well as notification dispatches.
+func (n *CfNotifier) notificationDispatcher() {
+	defer n.wg.Done()
+
+	ticker := time.NewTicker(n.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case cancelMsg := <-n.notificationCancels:
+			switch msg := cancelMsg.(type) {
+			case *epochCancel:
+				chainntnfs.Log.Infof("Cancelling epoch "+
+					"notification, epoch_id=%v", msg.epochID)
+
+				// First, we'll lookup the original
+				// registration in order to stop the active
+				// queue goroutine.
+				reg := n.blockEpochClients[msg.epochID]
+				reg.epochQueue.Stop()
+
+				// Next, close the cancel channel for this
+				// specific client, and wait for the client to
+				// exit.
+				close(reg.cancelChan)
+				reg.wg.Wait()
+
+				// Once the client has exited, we can then
+				// safely close the channel used to send epoch
+				// notifications, in order to notify any
+				// listeners that the intent has been
+				// canceled.
+				close(reg.epochChan)
+				delete(n.blockEpochClients, msg.epochID)
+			}
+
+		case registerMsg := <-n.notificationRegistry:
+			switch msg := registerMsg.(type) {
+			case *chainntnfs.HistoricalConfDispatch:
+				// Look up whether the transaction/output script
+				// has already confirmed in the active chain.
+				// We'll do this in a goroutine to prevent
+				// blocking potentially long rescans.
+				n.wg.Add(1)
+				go func() {
+					defer n.wg.Done()
+
+					confDetails, err := n.historicalConfDetails(
+						msg.ConfRequest,
+						msg.StartHeight, msg.EndHeight,
+					)
+					if err != nil {
+						chainntnfs.Log.Error(err)
+						return
+					}
+
+					// If the historical dispatch finished
+					// without error, we will invoke
+					// UpdateConfDetails even if none were
+					// found. This allows the notifier to
+					// begin safely updating the height hint
+					// cache at tip, since any pending
+					// rescans have now completed.
+					err = n.txNotifier.UpdateConfDetails(
+						msg.ConfRequest, confDetails,
+					)
+					if err != nil {
+						chainntnfs.Log.Error(err)
+					}
+				}()
+
+			case *chainntnfs.HistoricalSpendDispatch:
+				// Look up whether the outpoint/output script
+				// has already been spent in the active chain,
+				// in a goroutine for the same reason.
+				n.wg.Add(1)
+				go func() {
+					defer n.wg.Done()
+
+					spendDetails, err := n.historicalSpendDetails(
+						msg.SpendRequest,
+						msg.StartHeight, msg.EndHeight,
+					)
+					if err != nil {
+						chainntnfs.Log.Error(err)
+						return
+					}
+
+					err = n.txNotifier.UpdateSpendDetails(
+						msg.SpendRequest, spendDetails,
+					)
+					if err != nil {
+						chainntnfs.Log.Error(err)
+					}
+				}()
+
+			case *blockEpochRegistration:
+				chainntnfs.Log.Infof("New block epoch subscription")
+
+				n.blockEpochClients[msg.epochID] = msg
+
+				// If the client did not provide their best
+				// known block, then we'll immediately dispatch
+				// a notification for the current tip.
+				if msg.bestBlock == nil {
+					n.notifyBlockEpochClient(
+						msg, n.bestBlock.Height,
+						n.bestBlock.Hash,
+					)
+
+					msg.errorChan <- nil
+					continue
+				}
+
+				// Otherwise, we'll attempt to deliver the
+				// backlog of notifications from their best
+				// known block.
+				missedBlocks, err := chainntnfs.GetClientMissedBlocks(
+					n.source, msg.bestBlock,
+					n.bestBlock.Height, true,
+				)
+				if err != nil {
+					msg.errorChan <- err
+					continue
+				}
+
+				for _, block := range missedBlocks {
+					n.notifyBlockEpochClient(
+						msg, block.Height, block.Hash,
+					)
+				}
+
+				msg.errorChan <- nil
+			}
+
+		case <-ticker.C:
+			if err := n.syncTip(); err != nil {
+				chainntnfs.Log.Errorf("Unable to sync to the tip "+
+					"of the chain: %v", err)
+			}
+
+		case <-n.quit:
+			return
+		}
+	}
+}
+
+// syncTip brings the notifier up to the tip of the chain source, rewinding
+// the blocks that were reorganized out of the main chain and connecting the
+// new ones.
+func (n *CfNotifier) syncTip() error {
+	tipHash, tipHeight, err := n.source.GetBestBlock()
+	if err != nil {
+		return err
+	}
+	if *tipHash == *n.bestBlock.Hash {
+		return nil
+	}
+
+	// Find the most recent of our blocks that's still part of the main
+	// chain, walking back our view of the chain first if the new tip is
+	// at a lower height.
+	ourHash := *n.bestBlock.Hash
+	height := n.bestBlock.Height
+	for height > int32(tipHeight) {
+		header, err := n.source.GetBlockHeader(&ourHash)
+		if err != nil {
+			return fmt.Errorf("unable to get header for hash=%v: %v",
+				ourHash, err)
+		}
+		ourHash = header.PrevBlock
+		height--
+	}
+	mainHash, err := n.source.GetBlockHash(int64(height))
+	if err != nil {
+		return fmt.Errorf("unable to find blockhash for height=%d: %v",
+			height, err)
+	}
+	ancestor, err := chainntnfs.GetCommonBlockAncestorHeight(
+		n.source, ourHash, *mainHash,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to find common ancestor: %v", err)
+	}
+
+	// Set the bestBlock even on failure in case the rewind partially
+	// completed.
+	n.bestBlock, err = chainntnfs.RewindChain(
+		n.source, n.txNotifier, n.bestBlock, ancestor,
+	)
+	if err != nil {
+		return err
+	}
+
+	for height := ancestor + 1; height <= int32(tipHeight); height++ {
+		hash, err := n.source.GetBlockHash(int64(height))
+		if err != nil {
+			return fmt.Errorf("unable to find blockhash for "+
+				"height=%d: %v", height, err)
+		}
+
+		if err := n.connectBlock(hash); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// connectBlock applies a chain update for a new block. The block is only
+// fetched in full when its filter matches one of the watched requests. Any
+// watched transactions included in the block will be processed to either send
+// notifications now or after numConfirmations confs.
+func (n *CfNotifier) connectBlock(hash *chainhash.Hash) error {
+	n.connectMtx.Lock()
+	defer n.connectMtx.Unlock()
+
+	// Only the requests still pending are watched for, so that the
+	// filters of the new block aren't matched against the requests that
+	// were since fulfilled or canceled.
+	n.watched.refresh(n.txNotifier.PendingRequests())
+
+	header, txns, err := n.fetchFilteredBlock(hash)
+	if err != nil {
+		return err
+	}
+
+	height := header.Height
+	err = n.txNotifier.ConnectTip(hash, height, txns)
+	if err != nil {
+		return fmt.Errorf("unable to connect tip: %v", err)
+	}
+
+	chainntnfs.Log.Infof("New block: height=%v, hash=%v", height, hash)
+
+	// Now that we've guaranteed the new block extends the txNotifier's
+	// current tip, we'll proceed to dispatch notifications to all of our
+	// registered clients whom have had notifications fulfilled.
+	n.bestBlock.Hash = hash
+	n.bestBlock.Height = int32(height)
+
+	n.notifyBlockEpochs(int32(height), hash)
+	return n.txNotifier.NotifyHeight(height)
+}
+
+// fetchFilteredBlock returns the header of the block identified by the given
+// hash, along with its regular transactions if its filter matches any of the
+// watched requests.
+func (n *CfNotifier) fetchFilteredBlock(hash *chainhash.Hash) (
+	*wire.BlockHeader, []*dcrutil.Tx, error) {
+
+	header, err := n.source.GetBlockHeader(hash)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to get header for "+
+			"hash=%v: %v", hash, err)
+	}
+
+	// If the filter can't be fetched, the block is scanned in full rather
+	// than risking to miss a watched transaction.
+	filter, err := n.source.GetCFilter(hash)
+	if err != nil {
+		chainntnfs.Log.Warnf("Unable to get filter for block %v, "+
+			"fetching it in full: %v", hash, err)
+		filter = nil
+	}
+
+	key := blockcf.Key(&header.MerkleRoot)
+	if !n.watched.matches(filter, key) {
+		return header, nil, nil
+	}
+
+	block, err := n.source.GetBlock(hash)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to get block: %v", err)
+	}
+
+	txns := make([]*dcrutil.Tx, 0, len(block.Transactions))
+	for i := range block.Transactions {
+		tx := dcrutil.NewTx(block.Transactions[i])
+		tx.SetIndex(i)
+		tx.SetTree(wire.TxTreeRegular)
+		txns = append(txns, tx)
+	}
+
+	return header, txns, nil
+}
+
+// scanBlocks calls the given function with every block within the range
+// [startHeight, endHeight] whose filter matches the given entries, in
+// ascending order if forward is true and descending order otherwise. The scan
+// stops as soon as the function returns true.
+func (n *CfNotifier) scanBlocks(startHeight, endHeight uint32, forward bool,
+	entries [][]byte, scriptSpend bool,
+	f func(*wire.MsgBlock, uint32) bool) error {
+
+	if startHeight == 0 {
+		startHeight = 1
+	}
+	if endHeight < startHeight {
+		return nil
+	}
+
+	for i := uint32(0); i <= endHeight-startHeight; i++ {
+		height := endHeight - i
+		if forward {
+			height = startHeight + i
+		}
+
+		// Ensure we haven't been requested to shut down before
+		// processing the next height.
+		select {
+		case <-n.quit:
+			return chainntnfs.ErrChainNotifierShuttingDown
+		default:
+		}
+
+		blockHash, err := n.source.GetBlockHash(int64(height))
+		if err != nil {
+			return fmt.Errorf("unable to get hash from block "+
+				"with height %d: %v", height, err)
+		}
+
+		// Spends of scripts can't be matched against the filters, so
+		// every block is scanned for those.
+		if !scriptSpend {
+			header, err := n.source.GetBlockHeader(blockHash)
+			if err != nil {
+				return fmt.Errorf("unable to get header for "+
+					"hash=%v: %v", blockHash, err)
+			}
+			filter, err := n.source.GetCFilter(blockHash)
+			if err != nil {
+				chainntnfs.Log.Warnf("Unable to get filter for "+
+					"block %v, fetching it in full: %v",
+					blockHash, err)
+			}
+
+			key := blockcf.Key(&header.MerkleRoot)
+			if err == nil && !filter.MatchAny(key, entries) {
+				continue
+			}
+		}
+
+		block, err := n.source.GetBlock(blockHash)
+		if err != nil {
+			return fmt.Errorf("unable to get block with hash "+
+				"%v: %v", blockHash, err)
+		}
+
+		if f(block, height) {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// historicalConfDetails looks up whether a confirmation request (txid/output
+// script) has already been included in a block in the active chain and, if so,
+// returns details about said block. Only the blocks whose filter matches the
+// output script of the request are fetched.
+func (n *CfNotifier) historicalConfDetails(confRequest chainntnfs.ConfRequest,
+	startHeight, endHeight uint32) (*chainntnfs.TxConfirmation, error) {
+
+	var confDetails *chainntnfs.TxConfirmation
+	entries := pkScriptEntries(confRequest.PkScript.Script())
+	err := n.scanBlocks(startHeight, endHeight, false, entries, false,
+		func(block *wire.MsgBlock, height uint32) bool {
+			for txIndex, tx := range block.Transactions {
+				if !confRequest.MatchesTx(tx) {
+					continue
+				}
+
+				blockHash := block.BlockHash()
+				confDetails = &chainntnfs.TxConfirmation{
+					Tx:          tx,
+					BlockHash:   &blockHash,
+					BlockHeight: height,
+					TxIndex:     uint32(txIndex),
+				}
+				return true
+			}
+			return false
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return confDetails, nil
+}
+
+// historicalSpendDetails looks up whether a spend request (outpoint/output
+// script) has already been spent by a transaction in the active chain and, if
+// so, returns the details of the spend.
+func (n *CfNotifier) historicalSpendDetails(
+	spendRequest chainntnfs.SpendRequest, startHeight,
+	endHeight uint32) (*chainntnfs.SpendDetail, error) {
+
+	scriptSpend := spendRequest.OutPoint == chainntnfs.ZeroOutPoint
+	entries := [][]byte{outPointEntry(&spendRequest.OutPoint)}
+
+	var spendDetails *chainntnfs.SpendDetail
+	err := n.scanBlocks(startHeight, endHeight, true, entries, scriptSpend,
+		func(block *wire.MsgBlock, height uint32) bool {
+			for _, tx := range block.Transactions {
+				inputIndex := txSpendsSpendRequest(
+					tx, &spendRequest, n.chainParams,
+				)
+				if inputIndex == -1 {
+					continue
+				}
+
+				txHash := tx.TxHash()
+				spendDetails = &chainntnfs.SpendDetail{
+					SpentOutPoint:     &tx.TxIn[inputIndex].PreviousOutPoint,
+					SpenderTxHash:     &txHash,
+					SpendingTx:        tx,
+					SpenderInputIndex: uint32(inputIndex),
+					SpendingHeight:    int32(height),
+				}
+				return true
+			}
+			return false
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return spendDetails, nil
+}
+
+// txSpendsSpendRequest returns the index where the given spendRequest was
+// spent by the transaction or -1 if no inputs spend the given spendRequest.
+func txSpendsSpendRequest(tx *wire.MsgTx, spendRequest *chainntnfs.SpendRequest,
+	addrParams dcrutil.AddressParams) int {
+
+	if spendRequest.OutPoint != chainntnfs.ZeroOutPoint {
+		// Matching by outpoint.
+		for i, in := range tx.TxIn {
+			if in.PreviousOutPoint == spendRequest.OutPoint {
+				return i
+			}
+		}
+		return -1
+	}
+
+	// Matching by script.
+	for i, in := range tx.TxIn {
+		// Ignore the errors here, due to them definitely not being a
+		// match.
+		pkScript, _ := chainntnfs.ComputePkScript(
+			spendRequest.PkScript.ScriptVersion(), in.SignatureScript,
+			addrParams,
+		)
+		if spendRequest.PkScript.Equal(&pkScript) {
+			return i
+		}
+	}
+	return -1
+}
+
+// notifyBlockEpochs notifies all registered block epoch clients of the newly
+// connected block to the main chain.
+func (n *CfNotifier) notifyBlockEpochs(newHeight int32, newHash *chainhash.Hash) {
+	for _, client := range n.blockEpochClients {
+		n.notifyBlockEpochClient(client, newHeight, newHash)
+	}
+}
+
+// notifyBlockEpochClient sends a registered block epoch client a notification
+// about a specific block.
+func (n *CfNotifier) notifyBlockEpochClient(epochClient *blockEpochRegistration,
+	height int32, hash *chainhash.Hash) {
+
+	epoch := &chainntnfs.BlockEpoch{
+		Height: height,
+		Hash:   hash,
+	}
+
+	select {
+	case epochClient.epochQueue.ChanIn() <- epoch:
+	case <-epochClient.cancelChan:
+	case <-n.quit:
+	}
+}
+
+// RegisterSpendNtfn registers an intent to be notified once the target
+// outpoint/output script has been spent by a transaction on-chain. When
+// intending to be notified of the spend of an output script, a nil outpoint
+// must be used. The heightHint should represent the earliest height in the
+// chain of the transaction that spent the outpoint/output script.
+//
+// Once a spend of has been detected, the details of the spending event will be
+// sent across the 'Spend' channel.
+func (n *CfNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint,
+	pkScript []byte, heightHint uint32) (*chainntnfs.SpendEvent, error) {
+
+	// The request must be watched for before it's registered with the
+	// TxNotifier, so that it's either matched by the filters of the new
+	// blocks or included in the historical rescan.
+	n.connectMtx.Lock()
+	n.watched.addSpend(outpoint)
+	ntfn, err := n.txNotifier.RegisterSpend(outpoint, pkScript, heightHint)
+	n.connectMtx.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	// If the txNotifier didn't return any details to perform a historical
+	// scan of the chain, then we can return early as there's nothing left
+	// for us to do.
+	if ntfn.HistoricalDispatch == nil {
+		return ntfn.Event, nil
+	}
+
+	select {
+	case n.notificationRegistry <- ntfn.HistoricalDispatch:
+		return ntfn.Event, nil
+	case <-n.quit:
+		return nil, chainntnfs.ErrChainNotifierShuttingDown
+	}
+}
+
+// RegisterConfirmationsNtfn registers an intent to be notified once the target
+// txid/output script has reached numConfs confirmations on-chain. When
+// intending to be notified of the confirmation of an output script, a nil txid
+// must be used. The heightHint should represent the earliest height at which
+// the txid/output script could have been included in the chain.
+//
+// Progress on the number of confirmations left can be read from the 'Updates'
+// channel. Once it has reached all of its confirmations, a notification will be
+// sent across the 'Confirmed' channel.
+func (n *CfNotifier) RegisterConfirmationsNtfn(txid *chainhash.Hash,
+	pkScript []byte,
+	numConfs, heightHint uint32) (*chainntnfs.ConfirmationEvent, error) {
+
+	// Filters don't commit to the transaction hashes, so confirmations
+	// are matched through the output script of the transaction.
+	n.connectMtx.Lock()
+	n.watched.addConf(pkScript)
+	ntfn, err := n.txNotifier.RegisterConf(
+		txid, pkScript, numConfs, heightHint,
+	)
+	n.connectMtx.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	if ntfn.HistoricalDispatch == nil {
+		return ntfn.Event, nil
+	}
+
+	select {
+	case n.notificationRegistry <- ntfn.HistoricalDispatch:
+		return ntfn.Event, nil
+	case <-n.quit:
+		return nil, chainntnfs.ErrChainNotifierShuttingDown
+	}
+}
+
+// blockEpochRegistration represents a client's intent to receive a
+// notification with each newly connected block.
+type blockEpochRegistration struct {
+	epochID uint64
+
+	epochChan chan *chainntnfs.BlockEpoch
+
+	epochQueue *queue.ConcurrentQueue
+
+	bestBlock *chainntnfs.BlockEpoch
+
+	errorChan chan error
+
+	cancelChan chan struct{}
+
+	wg sync.WaitGroup
+}
+
+// epochCancel is a message sent to the CfNotifier when a client wishes to
+// cancel an outstanding epoch notification that has yet to be dispatched.
+type epochCancel struct {
+	epochID uint64
+}
+
+// RegisterBlockEpochNtfn returns a BlockEpochEvent which subscribes the
+// caller to receive notifications, of each new block connected to the main
+// chain. Clients have the option of passing in their best known block, which
+// the notifier uses to check if they are behind on blocks and catch them up.
+// If they do not provide one, then a notification will be dispatched
+// immediately for the current tip of the chain upon a successful registration.
+func (n *CfNotifier) RegisterBlockEpochNtfn(
+	bestBlock *chainntnfs.BlockEpoch) (*chainntnfs.BlockEpochEvent, error) {
+
+	reg := &blockEpochRegistration{
+		epochQueue: queue.NewConcurrentQueue(20),
+		epochChan:  make(chan *chainntnfs.BlockEpoch, 20),
+		cancelChan: make(chan struct{}),
+		epochID:    atomic.AddUint64(&n.epochClientCounter, 1),
+		bestBlock:  bestBlock,
+		errorChan:  make(chan error, 1),
+	}
+
+	reg.epochQueue.Start()
+
+	// Before we send the request to the main goroutine, we'll launch a new
+	// goroutine to proxy items added to our queue to the client itself.
+	// This ensures that all notifications are received *in order*.
+	reg.wg.Add(1)
+	go func() {
+		defer reg.wg.Done()
+
+		for {
+			select {
+			case ntfn := <-reg.epochQueue.ChanOut():
+				blockNtfn := ntfn.(*chainntnfs.BlockEpoch)
+				select {
+				case reg.epochChan <- blockNtfn:
+
+				case <-reg.cancelChan:
+					return
+
+				case <-n.quit:
+					return
+				}
+
+			case <-reg.cancelChan:
+				return
+
+			case <-n.quit:
+				return
+			}
+		}
+	}()
+
+	select {
+	case <-n.quit:
+		// As we're exiting before the registration could be sent,
+		// we'll stop the queue now ourselves.
+		reg.epochQueue.Stop()
+
+		return nil, errors.New("chainntnfs: system interrupt while " +
+			"attempting to register for block epoch notification")
+	case n.notificationRegistry <- reg:
+		return &chainntnfs.BlockEpochEvent{
+			Epochs: reg.epochChan,
+			Cancel: func() {
+				cancel := &epochCancel{
+					epochID: reg.epochID,
+				}
+
+				// Submit epoch cancellation to notification dispatcher.
+				select {
+				case n.notificationCancels <- cancel:
+					// Cancellation is being handled, drain
+					// the epoch channel until it is closed
+					// before yielding to caller.
+					for {
+						select {
+						case _, ok := <-reg.epochChan:
+							if !ok {
+								return
+							}
+						case <-n.quit:
+							return
+						}
+					}
+				case <-n.quit:
+				}
+			},
+		}, nil
+	}
+}
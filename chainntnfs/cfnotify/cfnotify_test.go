@@ -0,0 +1,436 @@
+package cfnotify
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/chaincfg/v2"
+	"github.com/decred/dcrd/gcs"
+	"github.com/decred/dcrd/gcs/blockcf"
+	"github.com/decred/dcrd/txscript/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/chainntnfs"
+)
+
+var (
+	testScript = []byte{
+		// OP_HASH160
+		0xA9,
+		// OP_DATA_20
+		0x14,
+		// <20-byte hash>
+		0xec, 0x6f, 0x7a, 0x5a, 0xa8, 0xf2, 0xb1, 0x0c, 0xa5, 0x15,
+		0x04, 0x52, 0x3a, 0x60, 0xd4, 0x03, 0x06, 0xf6, 0x96, 0xcd,
+		// OP_EQUAL
+		0x87,
+	}
+
+	otherScript = []byte{
+		// OP_HASH160
+		0xA9,
+		// OP_DATA_20
+		0x14,
+		// <20-byte hash>
+		0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a,
+		0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13, 0x14,
+		// OP_EQUAL
+		0x87,
+	}
+)
+
+// mockHintCache is a height hint cache that doesn't persist any hint.
+type mockHintCache struct{}
+
+func (c *mockHintCache) CommitSpendHint(uint32, ...chainntnfs.SpendRequest) error {
+	return nil
+}
+
+func (c *mockHintCache) QuerySpendHint(chainntnfs.SpendRequest) (uint32, error) {
+	return 0, chainntnfs.ErrSpendHintNotFound
+}
+
+func (c *mockHintCache) PurgeSpendHint(...chainntnfs.SpendRequest) error {
+	return nil
+}
+
+func (c *mockHintCache) CommitConfirmHint(uint32, ...chainntnfs.ConfRequest) error {
+	return nil
+}
+
+func (c *mockHintCache) QueryConfirmHint(chainntnfs.ConfRequest) (uint32, error) {
+	return 0, chainntnfs.ErrConfirmHintNotFound
+}
+
+func (c *mockHintCache) PurgeConfirmHint(...chainntnfs.ConfRequest) error {
+	return nil
+}
+
+// mockChainSource is a ChainSource backed by an in-memory chain which records
+// the blocks fetched in full.
+type mockChainSource struct {
+	sync.Mutex
+
+	chain   []*wire.MsgBlock
+	blocks  map[chainhash.Hash]*wire.MsgBlock
+	fetched map[chainhash.Hash]struct{}
+}
+
+func newMockChainSource() *mockChainSource {
+	s := &mockChainSource{
+		blocks:  make(map[chainhash.Hash]*wire.MsgBlock),
+		fetched: make(map[chainhash.Hash]struct{}),
+	}
+
+	// Add the genesis block.
+	s.addBlock()
+
+	return s
+}
+
+// addBlock extends the chain with a new block holding the given transactions
+// along with a coinbase-like transaction.
+func (s *mockChainSource) addBlock(txns ...*wire.MsgTx) *wire.MsgBlock {
+
+	s.Lock()
+	defer s.Unlock()
+
+	height := uint32(len(s.chain))
+	coinbase := wire.NewMsgTx()
+	coinbase.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Index: height},
+	})
+	coinbase.AddTxOut(wire.NewTxOut(1e8, otherScript))
+
+	block := &wire.MsgBlock{
+		Header: wire.BlockHeader{
+			Height: height,
+		},
+		Transactions: append([]*wire.MsgTx{coinbase}, txns...),
+	}
+	block.Header.MerkleRoot = coinbase.TxHash()
+	if height > 0 {
+		block.Header.PrevBlock = s.chain[height-1].BlockHash()
+	}
+
+	s.chain = append(s.chain, block)
+	s.blocks[block.BlockHash()] = block
+
+	return block
+}
+
+func (s *mockChainSource) wasFetched(block *wire.MsgBlock) bool {
+	s.Lock()
+	defer s.Unlock()
+
+	_, ok := s.fetched[block.BlockHash()]
+	return ok
+}
+
+func (s *mockChainSource) GetBestBlock() (*chainhash.Hash, int64, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	tip := s.chain[len(s.chain)-1]
+	hash := tip.BlockHash()
+	return &hash, int64(tip.Header.Height), nil
+}
+
+func (s *mockChainSource) GetBlockHash(height int64) (*chainhash.Hash, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	if height < 0 || height >= int64(len(s.chain)) {
+		return nil, errors.New("unknown height")
+	}
+	hash := s.chain[height].BlockHash()
+	return &hash, nil
+}
+
+func (s *mockChainSource) GetBlockHeader(
+	hash *chainhash.Hash) (*wire.BlockHeader, error) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	block, ok := s.blocks[*hash]
+	if !ok {
+		return nil, errors.New("unknown block")
+	}
+	header := block.Header
+	return &header, nil
+}
+
+// GetCFilter builds the filter of the block the way regular committed filters
+// are built: from the outpoints spent by the block and the data pushes of the
+// scripts it pays to.
+func (s *mockChainSource) GetCFilter(hash *chainhash.Hash) (*gcs.Filter,
+	error) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	block, ok := s.blocks[*hash]
+	if !ok {
+		return nil, errors.New("unknown block")
+	}
+
+	var data [][]byte
+	for _, tx := range block.Transactions {
+		for _, in := range tx.TxIn {
+			data = append(data, outPointEntry(&in.PreviousOutPoint))
+		}
+		for _, out := range tx.TxOut {
+			pushes, err := txscript.PushedData(out.PkScript)
+			if err != nil {
+				return nil, err
+			}
+			data = append(data, pushes...)
+		}
+	}
+
+	key := blockcf.Key(&block.Header.MerkleRoot)
+	return gcs.NewFilter(blockcf.P, key, data)
+}
+
+func (s *mockChainSource) GetBlock(hash *chainhash.Hash) (*wire.MsgBlock,
+	error) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	block, ok := s.blocks[*hash]
+	if !ok {
+		return nil, errors.New("unknown block")
+	}
+	s.fetched[*hash] = struct{}{}
+	return block, nil
+}
+
+// TestCfNotifierMatchesFilters ensures the notifier dispatches the
+// confirmation and spend notifications of the watched requests, both at tip
+// and historically, while only fetching the blocks whose filter matches them.
+func TestCfNotifierMatchesFilters(t *testing.T) {
+	t.Parallel()
+
+	source := newMockChainSource()
+	source.addBlock()
+
+	hintCache := &mockHintCache{}
+	notifier, err := New(
+		source, chaincfg.RegNetParams(), hintCache, hintCache,
+	)
+	if err != nil {
+		t.Fatalf("unable to create notifier: %v", err)
+	}
+	notifier.pollInterval = 10 * time.Millisecond
+	if err := notifier.Start(); err != nil {
+		t.Fatalf("unable to start notifier: %v", err)
+	}
+	defer notifier.Stop()
+
+	// The transaction to confirm pays to the test script, while the
+	// watched outpoint is spent by a different one.
+	confTx := wire.NewMsgTx()
+	confTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Hash: chainhash.Hash{1}},
+	})
+	confTx.AddTxOut(wire.NewTxOut(1e8, testScript))
+	confTxHash := confTx.TxHash()
+
+	spentOutPoint := wire.OutPoint{Hash: chainhash.Hash{2}, Index: 1}
+	spendTx := wire.NewMsgTx()
+	spendTx.AddTxIn(&wire.TxIn{PreviousOutPoint: spentOutPoint})
+	spendTx.AddTxOut(wire.NewTxOut(1e8, otherScript))
+	spendTxHash := spendTx.TxHash()
+
+	confEvent, err := notifier.RegisterConfirmationsNtfn(
+		&confTxHash, testScript, 1, 2,
+	)
+	if err != nil {
+		t.Fatalf("unable to register conf ntfn: %v", err)
+	}
+	spendEvent, err := notifier.RegisterSpendNtfn(
+		&spentOutPoint, otherScript, 2,
+	)
+	if err != nil {
+		t.Fatalf("unable to register spend ntfn: %v", err)
+	}
+
+	unrelatedTx := wire.NewMsgTx()
+	unrelatedTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Hash: chainhash.Hash{3}},
+	})
+	unrelatedTx.AddTxOut(wire.NewTxOut(1e8, otherScript))
+
+	unrelatedBlock := source.addBlock(unrelatedTx)
+	confBlock := source.addBlock(confTx)
+	spendBlock := source.addBlock(spendTx)
+
+	select {
+	case conf := <-confEvent.Confirmed:
+		if conf.BlockHeight != confBlock.Header.Height {
+			t.Fatalf("expected confirmation at height %d, got %d",
+				confBlock.Header.Height, conf.BlockHeight)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("confirmation notification not received")
+	}
+
+	select {
+	case spend := <-spendEvent.Spend:
+		if *spend.SpenderTxHash != spendTxHash {
+			t.Fatalf("expected spender %v, got %v", spendTxHash,
+				spend.SpenderTxHash)
+		}
+		if spend.SpendingHeight != int32(spendBlock.Header.Height) {
+			t.Fatalf("expected spend at height %d, got %d",
+				spendBlock.Header.Height, spend.SpendingHeight)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("spend notification not received")
+	}
+
+	if !source.wasFetched(confBlock) || !source.wasFetched(spendBlock) {
+		t.Fatalf("expected matching blocks to be fetched")
+	}
+	if source.wasFetched(unrelatedBlock) {
+		t.Fatalf("expected block not matching the filters to be " +
+			"skipped")
+	}
+
+	// Registering for the confirmation of the transaction once more must
+	// find it through a historical scan of the filters.
+	confEvent, err = notifier.RegisterConfirmationsNtfn(
+		&confTxHash, testScript, 2, 2,
+	)
+	if err != nil {
+		t.Fatalf("unable to register conf ntfn: %v", err)
+	}
+
+	select {
+	case conf := <-confEvent.Confirmed:
+		if conf.BlockHeight != confBlock.Header.Height {
+			t.Fatalf("expected confirmation at height %d, got %d",
+				confBlock.Header.Height, conf.BlockHeight)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("historical confirmation notification not received")
+	}
+}
+
+// TestCfNotifierPrunesWatchList ensures the requests that were either
+// fulfilled or canceled are no longer watched for, so that the blocks not
+// matching the remaining requests are skipped once again.
+func TestCfNotifierPrunesWatchList(t *testing.T) {
+	t.Parallel()
+
+	source := newMockChainSource()
+	source.addBlock()
+
+	hintCache := &mockHintCache{}
+	notifier, err := New(
+		source, chaincfg.RegNetParams(), hintCache, hintCache,
+	)
+	if err != nil {
+		t.Fatalf("unable to create notifier: %v", err)
+	}
+	notifier.pollInterval = 10 * time.Millisecond
+	if err := notifier.Start(); err != nil {
+		t.Fatalf("unable to start notifier: %v", err)
+	}
+	defer notifier.Stop()
+
+	epochEvent, err := notifier.RegisterBlockEpochNtfn(nil)
+	if err != nil {
+		t.Fatalf("unable to register block epoch ntfn: %v", err)
+	}
+	defer epochEvent.Cancel()
+
+	// waitForBlock waits until the notifier has connected the given
+	// block.
+	waitForBlock := func(block *wire.MsgBlock) {
+		t.Helper()
+
+		for {
+			select {
+			case epoch := <-epochEvent.Epochs:
+				if epoch.Height == int32(block.Header.Height) {
+					return
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatalf("block %d not connected",
+					block.Header.Height)
+			}
+		}
+	}
+
+	// Registering for the spend of a script requires every block to be
+	// fetched, while the confirmation only requires the blocks paying to
+	// the test script.
+	spendEvent, err := notifier.RegisterSpendNtfn(nil, otherScript, 2)
+	if err != nil {
+		t.Fatalf("unable to register spend ntfn: %v", err)
+	}
+
+	confTx := wire.NewMsgTx()
+	confTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Hash: chainhash.Hash{1}},
+	})
+	confTx.AddTxOut(wire.NewTxOut(1e8, testScript))
+	confTxHash := confTx.TxHash()
+
+	confEvent, err := notifier.RegisterConfirmationsNtfn(
+		&confTxHash, testScript, 1, 2,
+	)
+	if err != nil {
+		t.Fatalf("unable to register conf ntfn: %v", err)
+	}
+
+	unrelatedBlock := source.addBlock()
+	waitForBlock(unrelatedBlock)
+	if !source.wasFetched(unrelatedBlock) {
+		t.Fatalf("expected block to be fetched while watching for " +
+			"the spend of a script")
+	}
+
+	// Once the spend request is canceled, only the block paying to the
+	// test script is fetched.
+	spendEvent.Cancel()
+
+	unrelatedBlock = source.addBlock()
+	waitForBlock(unrelatedBlock)
+	if source.wasFetched(unrelatedBlock) {
+		t.Fatalf("expected block to be skipped after canceling the " +
+			"spend of a script")
+	}
+
+	confBlock := source.addBlock(confTx)
+	waitForBlock(confBlock)
+	if !source.wasFetched(confBlock) {
+		t.Fatalf("expected matching block to be fetched")
+	}
+
+	select {
+	case <-confEvent.Confirmed:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("confirmation notification not received")
+	}
+
+	// Now that the transaction has confirmed, a block paying to the test
+	// script doesn't match any request anymore.
+	payTx := wire.NewMsgTx()
+	payTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Hash: chainhash.Hash{2}},
+	})
+	payTx.AddTxOut(wire.NewTxOut(1e8, testScript))
+
+	payBlock := source.addBlock(payTx)
+	waitForBlock(payBlock)
+	if source.wasFetched(payBlock) {
+		t.Fatalf("expected block to be skipped after the " +
+			"confirmation was fulfilled")
+	}
+}
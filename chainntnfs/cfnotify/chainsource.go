@@ -0,0 +1,99 @@
+package cfnotify
+
+import (
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/gcs"
+	"github.com/decred/dcrd/rpcclient/v5"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/chainntnfs"
+	"github.com/decred/dcrlnd/lnwallet"
+)
+
+// ChainSource is the minimal view of the chain required by the CfNotifier. It
+// only needs block headers, the regular committed filters of blocks and the
+// blocks themselves, which a backend can serve without keeping any index of
+// transactions.
+type ChainSource interface {
+	chainntnfs.ChainConn
+
+	// GetBestBlock returns the hash and height of the tip of the main
+	// chain.
+	GetBestBlock() (*chainhash.Hash, int64, error)
+
+	// GetCFilter returns the regular committed filter of the block
+	// identified by the given hash.
+	GetCFilter(blockHash *chainhash.Hash) (*gcs.Filter, error)
+
+	// GetBlock returns the block identified by the given hash.
+	GetBlock(blockHash *chainhash.Hash) (*wire.MsgBlock, error)
+}
+
+// RPCChainSource is a ChainSource backed by a dcrd node over RPC. Contrary to
+// the dcrd notifier, it doesn't require the node to maintain a transaction
+// index nor to load transaction filters, only to serve committed filters.
+type RPCChainSource struct {
+	*rpcclient.Client
+}
+
+// Compile time check to ensure RPCChainSource implements ChainSource.
+var _ ChainSource = (*RPCChainSource)(nil)
+
+// NewRPCChainSource returns a new RPCChainSource connected to the node
+// detailed in the passed configuration.
+func NewRPCChainSource(config rpcclient.ConnConfig) (*RPCChainSource, error) {
+	config.DisableConnectOnNew = false
+	config.DisableAutoReconnect = false
+	client, err := rpcclient.New(&config, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RPCChainSource{Client: client}, nil
+}
+
+// GetCFilter returns the regular committed filter of the block identified by
+// the given hash.
+//
+// NOTE: This is part of the ChainSource interface.
+func (s *RPCChainSource) GetCFilter(blockHash *chainhash.Hash) (*gcs.Filter,
+	error) {
+
+	return s.Client.GetCFilter(blockHash, wire.GCSFilterRegular)
+}
+
+// FilteredChainIO is a BlockChainIO which is also able to serve block headers
+// and regular committed filters, such as the chain IO of an SPV wallet.
+type FilteredChainIO interface {
+	lnwallet.BlockChainIO
+
+	// GetBlockHeader returns the header of the block identified by the
+	// given hash.
+	GetBlockHeader(blockHash *chainhash.Hash) (*wire.BlockHeader, error)
+
+	// GetCFilter returns the regular committed filter of the block
+	// identified by the given hash.
+	GetCFilter(blockHash *chainhash.Hash) (*gcs.Filter, error)
+}
+
+// ChainIOSource is a ChainSource backed by a FilteredChainIO. It allows the
+// CfNotifier to run on top of a light backend which only downloads headers
+// and committed filters, and fetches blocks from its peers on demand.
+type ChainIOSource struct {
+	FilteredChainIO
+}
+
+// Compile time check to ensure ChainIOSource implements ChainSource.
+var _ ChainSource = (*ChainIOSource)(nil)
+
+// NewChainIOSource returns a new ChainIOSource backed by the given chain IO.
+func NewChainIOSource(chainIO FilteredChainIO) *ChainIOSource {
+	return &ChainIOSource{FilteredChainIO: chainIO}
+}
+
+// GetBestBlock returns the hash and height of the tip of the main chain.
+//
+// NOTE: This is part of the ChainSource interface.
+func (s *ChainIOSource) GetBestBlock() (*chainhash.Hash, int64, error) {
+	hash, height, err := s.FilteredChainIO.GetBestBlock()
+	return hash, int64(height), err
+}
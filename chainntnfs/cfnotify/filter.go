@@ -0,0 +1,141 @@
+package cfnotify
+
+import (
+	"encoding/binary"
+	"sync"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/gcs"
+	"github.com/decred/dcrd/txscript/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/chainntnfs"
+)
+
+// outPointEntry returns the entry a regular committed filter holds for the
+// spend of the given outpoint.
+func outPointEntry(op *wire.OutPoint) []byte {
+	entry := make([]byte, chainhash.HashSize+4)
+	copy(entry, op.Hash[:])
+	binary.LittleEndian.PutUint32(entry[chainhash.HashSize:], op.Index)
+	return entry
+}
+
+// pkScriptEntries returns the entries a regular committed filter may hold for
+// an output paying to the given script. Both the full script and its data
+// pushes are returned, so that a block paying to the script is matched
+// regardless of how the filter committed to it.
+func pkScriptEntries(pkScript []byte) [][]byte {
+	entries := [][]byte{pkScript}
+
+	// Ignore the error here, as an unparsable script still commits to
+	// its raw bytes.
+	pushes, _ := txscript.PushedData(pkScript)
+	for _, push := range pushes {
+		if len(push) > 0 {
+			entries = append(entries, push)
+		}
+	}
+
+	return entries
+}
+
+// watchList tracks the filter entries of all the outpoints and scripts the
+// notifier has been requested to watch for. Entries are added on registration
+// and the list is rebuilt from the pending requests of the TxNotifier, so that
+// fulfilled and canceled requests stop being matched.
+type watchList struct {
+	mtx     sync.Mutex
+	entries [][]byte
+	seen    map[string]struct{}
+
+	// scriptSpends is the number of registrations for the spend of an
+	// output script. Committed filters only hold the outpoints spent by a
+	// block, so these can't be matched and require every block to be
+	// scanned.
+	scriptSpends int
+}
+
+// newWatchList returns an empty watchList.
+func newWatchList() *watchList {
+	return &watchList{
+		seen: make(map[string]struct{}),
+	}
+}
+
+// addConf watches for the confirmation of a transaction paying to the given
+// script.
+func (w *watchList) addConf(pkScript []byte) {
+	w.mtx.Lock()
+	w.addEntries(pkScriptEntries(pkScript)...)
+	w.mtx.Unlock()
+}
+
+// addSpend watches for the spend of the given outpoint, or of any output
+// paying to a script if the outpoint is nil or zero.
+func (w *watchList) addSpend(outpoint *wire.OutPoint) {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	if outpoint == nil || *outpoint == chainntnfs.ZeroOutPoint {
+		w.scriptSpends++
+		return
+	}
+	w.addEntries(outPointEntry(outpoint))
+}
+
+// refresh replaces the watched entries with those of the given confirmation
+// and spend requests.
+func (w *watchList) refresh(confRequests []chainntnfs.ConfRequest,
+	spendRequests []chainntnfs.SpendRequest) {
+
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	w.entries = nil
+	w.seen = make(map[string]struct{})
+	w.scriptSpends = 0
+
+	for _, confRequest := range confRequests {
+		w.addEntries(pkScriptEntries(confRequest.PkScript.Script())...)
+	}
+	for i := range spendRequests {
+		outpoint := spendRequests[i].OutPoint
+		if outpoint == chainntnfs.ZeroOutPoint {
+			w.scriptSpends++
+			continue
+		}
+		w.addEntries(outPointEntry(&outpoint))
+	}
+}
+
+// addEntries appends the given entries to the list, skipping duplicates.
+//
+// NOTE: This must be called with the mutex held.
+func (w *watchList) addEntries(entries ...[]byte) {
+	for _, entry := range entries {
+		if _, ok := w.seen[string(entry)]; ok {
+			continue
+		}
+		w.seen[string(entry)] = struct{}{}
+		w.entries = append(w.entries, entry)
+	}
+}
+
+// matches returns true if the block committed to by the given filter must be
+// fetched and scanned in full. A nil filter always matches, since the block
+// content is then unknown.
+func (w *watchList) matches(filter *gcs.Filter, key [gcs.KeySize]byte) bool {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	switch {
+	case filter == nil, w.scriptSpends > 0:
+		return true
+
+	case len(w.entries) == 0:
+		return false
+
+	default:
+		return filter.MatchAny(key, w.entries)
+	}
+}
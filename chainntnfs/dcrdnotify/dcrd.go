@@ -63,6 +63,14 @@ type DcrdNotifier struct {
 
 	chainUpdates *queue.ConcurrentQueue
 
+	// txFilter batches the updates of the backend's transaction filter
+	// requested by spend registrations.
+	txFilter *txFilterBatcher
+
+	// mempoolSpends tracks the registrations for the spends of outpoints
+	// by transactions accepted to the backend's mempool.
+	mempoolSpends *mempoolSpendRegistry
+
 	// spendHintCache is a cache used to query and update the latest height
 	// hints for an outpoint. Each height hint represents the earliest
 	// height at which the outpoint could have been spent within the chain.
@@ -80,6 +88,10 @@ type DcrdNotifier struct {
 // Ensure DcrdNotifier implements the ChainNotifier interface at compile time.
 var _ chainntnfs.ChainNotifier = (*DcrdNotifier)(nil)
 
+// Ensure DcrdNotifier implements the MempoolSpendNotifier interface at compile
+// time.
+var _ chainntnfs.MempoolSpendNotifier = (*DcrdNotifier)(nil)
+
 // New returns a new DcrdNotifier instance. This function assumes the dcrd node
 // detailed in the passed configuration is already running, and willing to
 // accept new websockets clients.
@@ -97,6 +109,8 @@ func New(config *rpcclient.ConnConfig, chainParams *chaincfg.Params,
 
 		chainUpdates: queue.NewConcurrentQueue(10),
 
+		mempoolSpends: newMempoolSpendRegistry(),
+
 		spendHintCache:   spendHintCache,
 		confirmHintCache: confirmHintCache,
 
@@ -104,8 +118,10 @@ func New(config *rpcclient.ConnConfig, chainParams *chaincfg.Params,
 	}
 
 	ntfnCallbacks := &rpcclient.NotificationHandlers{
-		OnBlockConnected:    notifier.onBlockConnected,
-		OnBlockDisconnected: notifier.onBlockDisconnected,
+		OnBlockConnected:     notifier.onBlockConnected,
+		OnBlockDisconnected:  notifier.onBlockDisconnected,
+		OnClientConnected:    notifier.onClientConnected,
+		OnRelevantTxAccepted: notifier.onRelevantTxAccepted,
 	}
 
 	// Disable connecting to dcrd within the rpcclient.New method. We defer
@@ -117,6 +133,7 @@ func New(config *rpcclient.ConnConfig, chainParams *chaincfg.Params,
 		return nil, err
 	}
 	notifier.chainConn = chainConn
+	notifier.txFilter = newTxFilterBatcher(chainConn.LoadTxFilter)
 
 	return notifier, nil
 }
@@ -162,6 +179,8 @@ func (n *DcrdNotifier) Start() error {
 		Hash:   currentHash,
 	}
 
+	n.txFilter.Start()
+
 	n.wg.Add(1)
 	go n.notificationDispatcher()
 
@@ -182,6 +201,7 @@ func (n *DcrdNotifier) Stop() error {
 	close(n.quit)
 	n.wg.Wait()
 
+	n.txFilter.Stop()
 	n.chainUpdates.Stop()
 
 	// Notify all pending clients of our shutdown by closing the related
@@ -193,6 +213,7 @@ func (n *DcrdNotifier) Stop() error {
 		close(epochClient.epochChan)
 	}
 	n.txNotifier.TearDown()
+	n.mempoolSpends.tearDown()
 
 	return nil
 }
@@ -210,6 +231,28 @@ type filteredBlock struct {
 	connect bool
 }
 
+// onClientConnected implements the OnClientConnected callback for rpcclient.
+// The backend doesn't keep the transaction filter across connections, so it
+// is reloaded every time the connection is re-established.
+func (n *DcrdNotifier) onClientConnected() {
+	n.txFilter.Reload()
+}
+
+// onRelevantTxAccepted implements the OnRelevantTxAccepted callback for
+// rpcclient. The backend sends it for the transactions accepted to its mempool
+// that match the transaction filter, which includes the outpoints registered
+// for mempool spend notifications.
+func (n *DcrdNotifier) onRelevantTxAccepted(txBytes []byte) {
+	var tx wire.MsgTx
+	if err := tx.FromBytes(txBytes); err != nil {
+		chainntnfs.Log.Warnf("Received relevant mempool transaction "+
+			"that is malformed: %v", err)
+		return
+	}
+
+	n.mempoolSpends.notifyTx(&tx)
+}
+
 // onBlockConnected implements on OnBlockConnected callback for rpcclient.
 func (n *DcrdNotifier) onBlockConnected(blockHeader []byte, transactions [][]byte) {
 	var header wire.BlockHeader
@@ -695,7 +738,8 @@ func (n *DcrdNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint,
 	// rebuilding the tx filter every time this is called.
 	//
 	// We'll then request the backend to notify us when it has detected the
-	// outpoint or a script was spent.
+	// outpoint or a script was spent. Concurrent registrations are batched
+	// into a single loadtxfilter call.
 	var ops []wire.OutPoint
 	var addrs []dcrutil.Address
 
@@ -718,7 +762,7 @@ func (n *DcrdNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint,
 
 	// Ensure we'll receive any new notifications for either the outpoint
 	// or the address from now on.
-	if err := n.chainConn.LoadTxFilter(false, addrs, ops); err != nil {
+	if err := n.txFilter.AddToFilter(addrs, ops); err != nil {
 		return nil, err
 	}
 
@@ -806,6 +850,30 @@ func (n *DcrdNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint,
 	return ntfn.Event, nil
 }
 
+// RegisterMempoolSpendNtfn registers an intent to be notified once the target
+// outpoint is spent by a transaction accepted to the backend's mempool. Only
+// the transactions accepted after the registration are detected.
+//
+// NOTE: This is part of the chainntnfs.MempoolSpendNotifier interface.
+func (n *DcrdNotifier) RegisterMempoolSpendNtfn(
+	outpoint *wire.OutPoint) (*chainntnfs.SpendEvent, error) {
+
+	event, err := n.mempoolSpends.register(*outpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	// The backend only notifies us of the mempool transactions matching
+	// its transaction filter, so the outpoint must be part of it.
+	err = n.txFilter.AddToFilter(nil, []wire.OutPoint{*outpoint})
+	if err != nil {
+		event.Cancel()
+		return nil, err
+	}
+
+	return event, nil
+}
+
 // txSpendsSpendRequest returns the index where the given spendRequest was
 // spent by the transaction or -1 if no inputs spend the given spendRequest.
 func txSpendsSpendRequest(tx *wire.MsgTx, spendRequest *chainntnfs.SpendRequest,
@@ -39,6 +39,7 @@ func (b *DcrdNotifier) UnsafeStart(bestHeight int64, bestHash *chainhash.Hash,
 	)
 
 	b.chainUpdates.Start()
+	b.txFilter.Start()
 
 	if generateBlocks != nil {
 		// Ensure no block notifications are pending when we start the
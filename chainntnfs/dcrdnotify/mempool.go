@@ -0,0 +1,120 @@
+package dcrdnotify
+
+import (
+	"sync"
+
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/chainntnfs"
+)
+
+// mempoolSpendRegistry tracks the registrations for mempool spend
+// notifications, dispatching them as the backend notifies us of the relevant
+// transactions accepted to its mempool.
+type mempoolSpendRegistry struct {
+	mtx           sync.Mutex
+	clientCounter uint64
+	clients       map[wire.OutPoint]map[uint64]*chainntnfs.SpendEvent
+	tornDown      bool
+}
+
+// newMempoolSpendRegistry returns an empty mempoolSpendRegistry.
+func newMempoolSpendRegistry() *mempoolSpendRegistry {
+	return &mempoolSpendRegistry{
+		clients: make(
+			map[wire.OutPoint]map[uint64]*chainntnfs.SpendEvent,
+		),
+	}
+}
+
+// register returns a SpendEvent that is sent upon once a transaction spending
+// the outpoint is accepted to the mempool.
+func (r *mempoolSpendRegistry) register(
+	outpoint wire.OutPoint) (*chainntnfs.SpendEvent, error) {
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if r.tornDown {
+		return nil, ErrChainNotifierShuttingDown
+	}
+
+	clientID := r.clientCounter
+	r.clientCounter++
+
+	event := chainntnfs.NewSpendEvent(func() {
+		r.mtx.Lock()
+		defer r.mtx.Unlock()
+
+		r.remove(outpoint, clientID)
+	})
+
+	if _, ok := r.clients[outpoint]; !ok {
+		r.clients[outpoint] = make(map[uint64]*chainntnfs.SpendEvent)
+	}
+	r.clients[outpoint][clientID] = event
+
+	return event, nil
+}
+
+// remove deletes the registration of a client for the outpoint.
+//
+// NOTE: This must be called with the mutex held.
+func (r *mempoolSpendRegistry) remove(outpoint wire.OutPoint, clientID uint64) {
+	clients, ok := r.clients[outpoint]
+	if !ok {
+		return
+	}
+
+	delete(clients, clientID)
+	if len(clients) == 0 {
+		delete(r.clients, outpoint)
+	}
+}
+
+// notifyTx dispatches the spend notifications for the outpoints spent by a
+// transaction accepted to the mempool. Each client is notified at most once.
+func (r *mempoolSpendRegistry) notifyTx(tx *wire.MsgTx) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if len(r.clients) == 0 {
+		return
+	}
+
+	txHash := tx.TxHash()
+	for i, txIn := range tx.TxIn {
+		outpoint := txIn.PreviousOutPoint
+		clients, ok := r.clients[outpoint]
+		if !ok {
+			continue
+		}
+
+		chainntnfs.Log.Infof("Outpoint %v spent by mempool "+
+			"transaction %v", outpoint, txHash)
+
+		for _, event := range clients {
+			event.Spend <- &chainntnfs.SpendDetail{
+				SpentOutPoint:     &outpoint,
+				SpenderTxHash:     &txHash,
+				SpendingTx:        tx,
+				SpenderInputIndex: uint32(i),
+			}
+		}
+		delete(r.clients, outpoint)
+	}
+}
+
+// tearDown closes the spend channels of all the clients still waiting for a
+// notification, and rejects any further registration.
+func (r *mempoolSpendRegistry) tearDown() {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	for _, clients := range r.clients {
+		for _, event := range clients {
+			close(event.Spend)
+		}
+	}
+	r.clients = make(map[wire.OutPoint]map[uint64]*chainntnfs.SpendEvent)
+	r.tornDown = true
+}
@@ -0,0 +1,92 @@
+package dcrdnotify
+
+import (
+	"testing"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/chainntnfs"
+)
+
+// TestMempoolSpendRegistry asserts that the clients of the mempool spend
+// registry are notified once of the transactions spending their outpoint, and
+// that canceled and torn down registrations aren't.
+func TestMempoolSpendRegistry(t *testing.T) {
+	t.Parallel()
+
+	r := newMempoolSpendRegistry()
+
+	op1 := wire.OutPoint{Hash: chainhash.Hash{1}, Index: 0}
+	op2 := wire.OutPoint{Hash: chainhash.Hash{2}, Index: 1}
+
+	event1, err := r.register(op1)
+	if err != nil {
+		t.Fatalf("unable to register: %v", err)
+	}
+	event2, err := r.register(op1)
+	if err != nil {
+		t.Fatalf("unable to register: %v", err)
+	}
+	canceled, err := r.register(op1)
+	if err != nil {
+		t.Fatalf("unable to register: %v", err)
+	}
+	canceled.Cancel()
+	pending, err := r.register(op2)
+	if err != nil {
+		t.Fatalf("unable to register: %v", err)
+	}
+
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 5}, 0, nil))
+	tx.AddTxIn(wire.NewTxIn(&op1, 0, nil))
+	txHash := tx.TxHash()
+
+	// Notifying the same transaction twice only dispatches a single
+	// notification to each client.
+	r.notifyTx(tx)
+	r.notifyTx(tx)
+
+	for _, event := range []*chainntnfs.SpendEvent{event1, event2} {
+		select {
+		case spend := <-event.Spend:
+			if *spend.SpenderTxHash != txHash {
+				t.Fatalf("expected spender %v, got %v", txHash,
+					spend.SpenderTxHash)
+			}
+			if *spend.SpentOutPoint != op1 {
+				t.Fatalf("expected spent outpoint %v, got %v",
+					op1, spend.SpentOutPoint)
+			}
+			if spend.SpenderInputIndex != 1 {
+				t.Fatalf("expected input index 1, got %v",
+					spend.SpenderInputIndex)
+			}
+		default:
+			t.Fatal("expected spend notification")
+		}
+
+		select {
+		case <-event.Spend:
+			t.Fatal("unexpected second spend notification")
+		default:
+		}
+	}
+
+	select {
+	case <-canceled.Spend:
+		t.Fatal("unexpected spend notification after cancel")
+	default:
+	}
+
+	// Tearing down the registry closes the pending registrations and
+	// rejects further ones.
+	r.tearDown()
+	if _, ok := <-pending.Spend; ok {
+		t.Fatal("expected spend channel to be closed")
+	}
+	if _, err := r.register(op2); err != ErrChainNotifierShuttingDown {
+		t.Fatalf("expected %v, got %v", ErrChainNotifierShuttingDown,
+			err)
+	}
+}
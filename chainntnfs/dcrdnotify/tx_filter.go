@@ -0,0 +1,316 @@
+package dcrdnotify
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/chainntnfs"
+)
+
+const (
+	// txFilterBatchWindow is the amount of time the batcher waits after
+	// receiving an update for further updates to coalesce into the same
+	// loadtxfilter call.
+	txFilterBatchWindow = 10 * time.Millisecond
+
+	// txFilterMaxBatchSize is the maximum number of addresses and
+	// outpoints sent to the backend in a single loadtxfilter call.
+	txFilterMaxBatchSize = 1000
+
+	// txFilterMaxRetries is the number of times a failed loadtxfilter call
+	// is retried before the error is reported back to the callers.
+	txFilterMaxRetries = 3
+
+	// txFilterRetryBackoff is the delay before the first retry of a failed
+	// loadtxfilter call. It is doubled on every subsequent retry.
+	txFilterRetryBackoff = 100 * time.Millisecond
+)
+
+var (
+	// errTxFilterBatcherShuttingDown is returned when an update is
+	// requested while the batcher is shutting down.
+	errTxFilterBatcherShuttingDown = errors.New("tx filter batcher " +
+		"shutting down")
+)
+
+// loadTxFilterFunc adds the given addresses and outpoints to the backend's
+// transaction filter. If reload is true, the existing filter is replaced
+// instead of being added to.
+type loadTxFilterFunc func(reload bool, addrs []dcrutil.Address,
+	ops []wire.OutPoint) error
+
+// txFilterUpdate is a request to add a set of addresses and outpoints to the
+// backend's transaction filter.
+type txFilterUpdate struct {
+	addrs []dcrutil.Address
+	ops   []wire.OutPoint
+
+	// errChan receives the result of the loadtxfilter call that included
+	// this update.
+	errChan chan error
+}
+
+// txFilterBatcher coalesces concurrent transaction filter updates into as few
+// loadtxfilter calls as possible. This avoids issuing one RPC per
+// registration when many spend notifications are requested at once, such as
+// during startup with a large number of channels.
+//
+// The batcher keeps track of everything successfully loaded into the filter,
+// so that duplicate updates are skipped and the whole filter can be restored
+// after the connection to the backend is re-established.
+type txFilterBatcher struct {
+	loadTxFilter loadTxFilterFunc
+
+	updates chan *txFilterUpdate
+
+	// reloadSignal is sent upon to request the full filter to be reloaded
+	// into the backend.
+	reloadSignal chan struct{}
+
+	// The fields below are only accessed by the batch handler goroutine.
+	loadedAddrs map[string]dcrutil.Address
+	loadedOps   map[wire.OutPoint]struct{}
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// newTxFilterBatcher returns a new txFilterBatcher that updates the backend's
+// filter using the given function.
+func newTxFilterBatcher(loadTxFilter loadTxFilterFunc) *txFilterBatcher {
+	return &txFilterBatcher{
+		loadTxFilter: loadTxFilter,
+		updates:      make(chan *txFilterUpdate),
+		reloadSignal: make(chan struct{}, 1),
+		loadedAddrs:  make(map[string]dcrutil.Address),
+		loadedOps:    make(map[wire.OutPoint]struct{}),
+		quit:         make(chan struct{}),
+	}
+}
+
+// Start launches the batch handler goroutine.
+func (b *txFilterBatcher) Start() {
+	b.wg.Add(1)
+	go b.batchHandler()
+}
+
+// Stop signals the batch handler to exit and waits for it to do so. Any
+// pending updates fail with errTxFilterBatcherShuttingDown.
+func (b *txFilterBatcher) Stop() {
+	close(b.quit)
+	b.wg.Wait()
+}
+
+// AddToFilter adds the given addresses and outpoints to the backend's
+// transaction filter, blocking until the loadtxfilter call that includes
+// them has completed.
+func (b *txFilterBatcher) AddToFilter(addrs []dcrutil.Address,
+	ops []wire.OutPoint) error {
+
+	update := &txFilterUpdate{
+		addrs:   addrs,
+		ops:     ops,
+		errChan: make(chan error, 1),
+	}
+
+	select {
+	case b.updates <- update:
+	case <-b.quit:
+		return errTxFilterBatcherShuttingDown
+	}
+
+	select {
+	case err := <-update.errChan:
+		return err
+	case <-b.quit:
+		return errTxFilterBatcherShuttingDown
+	}
+}
+
+// Reload requests the full set of previously loaded addresses and outpoints
+// to be loaded into the backend again. This should be called whenever the
+// connection to the backend is re-established, as the backend does not
+// persist the filter across connections.
+func (b *txFilterBatcher) Reload() {
+	select {
+	case b.reloadSignal <- struct{}{}:
+	default:
+	}
+}
+
+// batchHandler collects filter updates into batches and sends them to the
+// backend.
+//
+// NOTE: This MUST be run as a goroutine.
+func (b *txFilterBatcher) batchHandler() {
+	defer b.wg.Done()
+
+	for {
+		var batch []*txFilterUpdate
+		select {
+		case update := <-b.updates:
+			batch = append(batch, update)
+
+		case <-b.reloadSignal:
+			b.reload()
+			continue
+
+		case <-b.quit:
+			return
+		}
+
+		// Give concurrent registrations a chance to join the batch
+		// before sending it out.
+		batch = b.collectBatch(batch)
+
+		err := b.loadBatch(batch)
+		for _, update := range batch {
+			update.errChan <- err
+		}
+	}
+}
+
+// collectBatch adds updates to the given batch until either the batch window
+// has passed without a new update or the batch is full.
+func (b *txFilterBatcher) collectBatch(batch []*txFilterUpdate) []*txFilterUpdate {
+	size := len(batch[0].addrs) + len(batch[0].ops)
+
+	timer := time.NewTimer(txFilterBatchWindow)
+	defer timer.Stop()
+
+	for size < txFilterMaxBatchSize {
+		select {
+		case update := <-b.updates:
+			batch = append(batch, update)
+			size += len(update.addrs) + len(update.ops)
+
+			if !timer.Stop() {
+				<-timer.C
+			}
+			timer.Reset(txFilterBatchWindow)
+
+		case <-timer.C:
+			return batch
+
+		case <-b.quit:
+			return batch
+		}
+	}
+
+	return batch
+}
+
+// loadBatch sends the addresses and outpoints of the given updates that
+// haven't been loaded yet to the backend, retrying on failure. Only once the
+// call succeeds are they recorded as loaded, so that a failed batch is
+// attempted again by the next registration that includes it.
+func (b *txFilterBatcher) loadBatch(batch []*txFilterUpdate) error {
+	var (
+		addrs    []dcrutil.Address
+		ops      []wire.OutPoint
+		newAddrs = make(map[string]dcrutil.Address)
+		newOps   = make(map[wire.OutPoint]struct{})
+	)
+	for _, update := range batch {
+		for _, addr := range update.addrs {
+			addrStr := addr.Address()
+			if _, ok := b.loadedAddrs[addrStr]; ok {
+				continue
+			}
+			if _, ok := newAddrs[addrStr]; ok {
+				continue
+			}
+			newAddrs[addrStr] = addr
+			addrs = append(addrs, addr)
+		}
+		for _, op := range update.ops {
+			if _, ok := b.loadedOps[op]; ok {
+				continue
+			}
+			if _, ok := newOps[op]; ok {
+				continue
+			}
+			newOps[op] = struct{}{}
+			ops = append(ops, op)
+		}
+	}
+
+	if len(addrs) == 0 && len(ops) == 0 {
+		return nil
+	}
+
+	chainntnfs.Log.Debugf("Loading %d addresses and %d outpoints into "+
+		"tx filter from %d registrations", len(addrs), len(ops),
+		len(batch))
+
+	if err := b.loadWithRetry(false, addrs, ops); err != nil {
+		return err
+	}
+
+	for addrStr, addr := range newAddrs {
+		b.loadedAddrs[addrStr] = addr
+	}
+	for op := range newOps {
+		b.loadedOps[op] = struct{}{}
+	}
+
+	return nil
+}
+
+// reload replaces the backend's filter with everything loaded so far.
+func (b *txFilterBatcher) reload() {
+	if len(b.loadedAddrs) == 0 && len(b.loadedOps) == 0 {
+		return
+	}
+
+	addrs := make([]dcrutil.Address, 0, len(b.loadedAddrs))
+	for _, addr := range b.loadedAddrs {
+		addrs = append(addrs, addr)
+	}
+	ops := make([]wire.OutPoint, 0, len(b.loadedOps))
+	for op := range b.loadedOps {
+		ops = append(ops, op)
+	}
+
+	chainntnfs.Log.Infof("Reloading tx filter with %d addresses and %d "+
+		"outpoints", len(addrs), len(ops))
+
+	if err := b.loadWithRetry(true, addrs, ops); err != nil {
+		chainntnfs.Log.Errorf("Unable to reload tx filter: %v", err)
+	}
+}
+
+// loadWithRetry calls loadTxFilter, retrying with an exponential backoff if
+// it fails.
+func (b *txFilterBatcher) loadWithRetry(reload bool, addrs []dcrutil.Address,
+	ops []wire.OutPoint) error {
+
+	backoff := txFilterRetryBackoff
+
+	var err error
+	for i := 0; i <= txFilterMaxRetries; i++ {
+		err = b.loadTxFilter(reload, addrs, ops)
+		if err == nil {
+			return nil
+		}
+
+		if i == txFilterMaxRetries {
+			break
+		}
+
+		chainntnfs.Log.Warnf("Unable to load tx filter, retrying in "+
+			"%v: %v", backoff, err)
+
+		select {
+		case <-time.After(backoff):
+		case <-b.quit:
+			return errTxFilterBatcherShuttingDown
+		}
+		backoff *= 2
+	}
+
+	return err
+}
@@ -0,0 +1,209 @@
+package dcrdnotify
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/wire"
+)
+
+// mockTxFilter records the loadtxfilter calls made by a txFilterBatcher.
+type mockTxFilter struct {
+	sync.Mutex
+
+	calls   [][]wire.OutPoint
+	reloads int
+
+	// failures is the number of calls that should fail before calls start
+	// succeeding.
+	failures int
+
+	// block, if non-nil, is received from by every call before returning.
+	block chan struct{}
+
+	// called is sent upon every time a call starts.
+	called chan struct{}
+}
+
+func newMockTxFilter() *mockTxFilter {
+	return &mockTxFilter{
+		called: make(chan struct{}, 100),
+	}
+}
+
+func (m *mockTxFilter) loadTxFilter(reload bool, _ []dcrutil.Address,
+	ops []wire.OutPoint) error {
+
+	m.called <- struct{}{}
+	if m.block != nil {
+		<-m.block
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	if m.failures > 0 {
+		m.failures--
+		return errors.New("loadtxfilter failed")
+	}
+
+	if reload {
+		m.reloads++
+	}
+	m.calls = append(m.calls, ops)
+
+	return nil
+}
+
+func (m *mockTxFilter) numCalls() int {
+	m.Lock()
+	defer m.Unlock()
+
+	return len(m.calls)
+}
+
+func testOutPoint(i uint32) wire.OutPoint {
+	return wire.OutPoint{Index: i}
+}
+
+// TestTxFilterBatching asserts that concurrent filter updates are coalesced
+// into a single loadtxfilter call and that already loaded outpoints are not
+// sent to the backend again.
+func TestTxFilterBatching(t *testing.T) {
+	t.Parallel()
+
+	filter := newMockTxFilter()
+	filter.block = make(chan struct{})
+
+	batcher := newTxFilterBatcher(filter.loadTxFilter)
+	batcher.Start()
+	defer batcher.Stop()
+
+	// The first update is sent on its own, and its call is held until
+	// all other updates were submitted.
+	errChan := make(chan error, 100)
+	go func() {
+		errChan <- batcher.AddToFilter(
+			nil, []wire.OutPoint{testOutPoint(0)},
+		)
+	}()
+
+	select {
+	case <-filter.called:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("first update was not loaded")
+	}
+
+	// Submit a number of concurrent updates, one of them for the outpoint
+	// of the first update.
+	const numUpdates = 20
+	for i := uint32(0); i < numUpdates; i++ {
+		op := testOutPoint(i)
+		go func() {
+			errChan <- batcher.AddToFilter(nil, []wire.OutPoint{op})
+		}()
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	close(filter.block)
+
+	for i := 0; i < numUpdates+1; i++ {
+		select {
+		case err := <-errChan:
+			if err != nil {
+				t.Fatalf("unable to add to filter: %v", err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("update was not completed")
+		}
+	}
+
+	// The pending updates should have been coalesced into a single call,
+	// skipping the outpoint that was already loaded.
+	filter.Lock()
+	defer filter.Unlock()
+
+	if len(filter.calls) != 2 {
+		t.Fatalf("expected 2 loadtxfilter calls, got %d",
+			len(filter.calls))
+	}
+	if len(filter.calls[1]) != numUpdates-1 {
+		t.Fatalf("expected %d outpoints in batch, got %d",
+			numUpdates-1, len(filter.calls[1]))
+	}
+	for _, op := range filter.calls[1] {
+		if op == testOutPoint(0) {
+			t.Fatalf("already loaded outpoint was loaded again")
+		}
+	}
+}
+
+// TestTxFilterErrorRecovery asserts that failed loadtxfilter calls are
+// retried, that updates whose call ultimately failed are attempted again, and
+// that the full filter is restored on reload.
+func TestTxFilterErrorRecovery(t *testing.T) {
+	t.Parallel()
+
+	filter := newMockTxFilter()
+	batcher := newTxFilterBatcher(filter.loadTxFilter)
+	batcher.Start()
+	defer batcher.Stop()
+
+	// A single failure should be transparently retried.
+	filter.Lock()
+	filter.failures = 1
+	filter.Unlock()
+
+	ops := []wire.OutPoint{testOutPoint(0)}
+	if err := batcher.AddToFilter(nil, ops); err != nil {
+		t.Fatalf("unable to add to filter: %v", err)
+	}
+	if filter.numCalls() != 1 {
+		t.Fatalf("expected 1 successful call, got %d",
+			filter.numCalls())
+	}
+
+	// If all retries fail, the error should be reported.
+	filter.Lock()
+	filter.failures = txFilterMaxRetries + 1
+	filter.Unlock()
+
+	ops = []wire.OutPoint{testOutPoint(1)}
+	if err := batcher.AddToFilter(nil, ops); err == nil {
+		t.Fatalf("expected update to fail")
+	}
+
+	// Since the outpoint was never loaded, it should be sent again on the
+	// next attempt.
+	if err := batcher.AddToFilter(nil, ops); err != nil {
+		t.Fatalf("unable to add to filter: %v", err)
+	}
+	if filter.numCalls() != 2 {
+		t.Fatalf("expected 2 successful calls, got %d",
+			filter.numCalls())
+	}
+
+	// Finally, a reload should load both outpoints at once.
+	batcher.Reload()
+
+	for i := 0; i < 100; i++ {
+		if filter.numCalls() == 3 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	filter.Lock()
+	defer filter.Unlock()
+
+	if filter.reloads != 1 {
+		t.Fatalf("expected filter to be reloaded")
+	}
+	if len(filter.calls[2]) != 2 {
+		t.Fatalf("expected 2 outpoints to be reloaded, got %d",
+			len(filter.calls[2]))
+	}
+}
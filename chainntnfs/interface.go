@@ -148,6 +148,21 @@ type ChainNotifier interface {
 	Stop() error
 }
 
+// MempoolSpendNotifier is implemented by the ChainNotifiers able to detect the
+// spends of outpoints by the transactions accepted to the mempool of their
+// backend, before they're confirmed.
+type MempoolSpendNotifier interface {
+	// RegisterMempoolSpendNtfn registers an intent to be notified once the
+	// target outpoint is spent by a transaction accepted to the mempool.
+	// The returned SpendEvent will receive a single send on the 'Spend'
+	// channel, with a SpendingHeight of zero.
+	//
+	// NOTE: A transaction in the mempool may be replaced or never
+	// confirm, so the notification is only an early warning. The spend
+	// must still be acted upon once confirmed, through RegisterSpendNtfn.
+	RegisterMempoolSpendNtfn(outpoint *wire.OutPoint) (*SpendEvent, error)
+}
+
 // TxConfirmation carries some additional block-level details of the exact
 // block that specified transactions was confirmed within.
 type TxConfirmation struct {
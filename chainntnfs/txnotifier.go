@@ -1761,6 +1761,34 @@ func (n *TxNotifier) updateHints(height uint32) {
 	}
 }
 
+// PendingRequests returns the confirmation and spend requests that still have
+// active clients and whose confirmation/spend hasn't been found in the chain
+// yet. Requests that were either canceled by all of their clients or
+// fulfilled are not included, unless a reorg removed their confirmation/spend
+// from the chain.
+func (n *TxNotifier) PendingRequests() ([]ConfRequest, []SpendRequest) {
+	n.Lock()
+	defer n.Unlock()
+
+	var confRequests []ConfRequest
+	for confRequest, confSet := range n.confNotifications {
+		if len(confSet.ntfns) == 0 || confSet.details != nil {
+			continue
+		}
+		confRequests = append(confRequests, confRequest)
+	}
+
+	var spendRequests []SpendRequest
+	for spendRequest, spendSet := range n.spendNotifications {
+		if len(spendSet.ntfns) == 0 || spendSet.details != nil {
+			continue
+		}
+		spendRequests = append(spendRequests, spendRequest)
+	}
+
+	return confRequests, spendRequests
+}
+
 // unconfirmedRequests returns the set of confirmation requests that are
 // still seen as unconfirmed by the TxNotifier.
 //
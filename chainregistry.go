@@ -13,6 +13,7 @@ import (
 	"github.com/decred/dcrd/chaincfg/chainhash"
 	"github.com/decred/dcrd/rpcclient/v5"
 	"github.com/decred/dcrlnd/chainntnfs"
+	"github.com/decred/dcrlnd/chainntnfs/cfnotify"
 	"github.com/decred/dcrlnd/chainntnfs/dcrdnotify"
 	"github.com/decred/dcrlnd/channeldb"
 	"github.com/decred/dcrlnd/htlcswitch"
@@ -22,6 +23,7 @@ import (
 	"github.com/decred/dcrlnd/lnwallet/dcrwallet"
 	walletloader "github.com/decred/dcrlnd/lnwallet/dcrwallet/loader"
 	"github.com/decred/dcrlnd/lnwallet/remotedcrwallet"
+	"github.com/decred/dcrlnd/lnwallet/remotesigner"
 	"github.com/decred/dcrlnd/lnwire"
 	"github.com/decred/dcrlnd/routing/chainview"
 	"github.com/decred/dcrwallet/wallet/v3"
@@ -44,6 +46,13 @@ const (
 
 	// defaultDecredStaticFeePerKB is the fee rate of 10000 atom/kB
 	defaultDecredStaticFeePerKB = lnwallet.AtomPerKByte(1e4)
+
+	// defaultDecredLightFallbackFeePerKB is the fee rate of 20000 atom/kB
+	// used when estimating fees fails while the chain is watched through
+	// committed filters, or when no node is available to estimate them
+	// at all (spv). The fallback errs on the side of getting transactions
+	// confirmed.
+	defaultDecredLightFallbackFeePerKB = lnwallet.AtomPerKByte(2e4)
 )
 
 // defaultDcrChannelConstraints is the default set of channel constraints that are
@@ -121,10 +130,18 @@ type chainControl struct {
 
 	wc lnwallet.WalletController
 
+	// remoteWalletCheck checks the connection to the remote wallet, if the
+	// node runs against one.
+	remoteWalletCheck func() error
+
 	msgSigner lnwallet.MessageSigner
 
 	chainNotifier chainntnfs.ChainNotifier
 
+	// mempoolSpends is set if the detection of spends in the mempool is
+	// enabled and supported by the chain backend.
+	mempoolSpends chainntnfs.MempoolSpendNotifier
+
 	chainView chainview.FilteredChainView
 
 	wallet *lnwallet.LightningWallet
@@ -133,8 +150,9 @@ type chainControl struct {
 }
 
 // newChainControlFromConfig attempts to create a chainControl instance
-// according to the parameters in the passed lnd configuration. Currently only
-// one chainControl instance exists: one backed by a running dcrd full-node.
+// according to the parameters in the passed lnd configuration. The chain
+// control is either backed by a running dcrd full-node, or by the embedded
+// wallet synced over the decred p2p network (spv).
 func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 	privateWalletPw, publicWalletPw []byte, birthday time.Time,
 	recoveryWindow uint32, wallet *wallet.Wallet,
@@ -166,8 +184,10 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 	}
 
 	var (
-		err       error
-		rpcConfig *rpcclient.ConnConfig
+		err            error
+		rpcConfig      *rpcclient.ConnConfig
+		spvSyncer      *dcrwallet.SPVSyncer
+		relayFeeSource lnwallet.RelayFeeSource
 	)
 
 	// Initialize the height hint cache within the chain directory.
@@ -230,11 +250,41 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 			DisableConnectOnNew:  true,
 			DisableAutoReconnect: false,
 		}
-		cc.chainNotifier, err = dcrdnotify.New(
-			rpcConfig, activeNetParams.Params, hintCache, hintCache,
-		)
-		if err != nil {
-			return nil, err
+		switch {
+		// When cfilters mode is enabled, the chain notifier watches for
+		// on-chain events through the committed filters of blocks
+		// served by dcrd, so it doesn't rely on its transaction index.
+		// The chain view, chain IO and fee estimator below still use
+		// the dcrd RPC connection regardless of the mode.
+		case dcrdMode.CFilters:
+			source, err := cfnotify.NewRPCChainSource(*rpcConfig)
+			if err != nil {
+				return nil, err
+			}
+			cc.chainNotifier, err = cfnotify.New(
+				source, activeNetParams.Params, hintCache,
+				hintCache,
+			)
+			if err != nil {
+				return nil, err
+			}
+			if dcrdMode.MempoolSpends {
+				ltndLog.Warnf("Mempool spends can't be watched " +
+					"for with the committed filters notifier")
+			}
+
+		default:
+			dcrdNotifier, err := dcrdnotify.New(
+				rpcConfig, activeNetParams.Params, hintCache,
+				hintCache,
+			)
+			if err != nil {
+				return nil, err
+			}
+			cc.chainNotifier = dcrdNotifier
+			if dcrdMode.MempoolSpends {
+				cc.mempoolSpends = dcrdNotifier
+			}
 		}
 
 		// Finally, we'll create an instance of the default chain view to be
@@ -254,10 +304,12 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 			return nil, err
 		}
 
-		cc.chainIO, err = dcrwallet.NewRPCChainIO(*rpcConfig, activeNetParams.Params)
+		chainIO, err := dcrwallet.NewRPCChainIO(*rpcConfig, activeNetParams.Params)
 		if err != nil {
 			return nil, err
 		}
+		cc.chainIO = chainIO
+		relayFeeSource = chainIO.RelayFee
 
 		// If we're not in simnet or regtest mode, then we'll attempt
 		// to use a proper fee estimator for testnet.
@@ -271,21 +323,97 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 			// TODO(decred) Review if fallbackFeeRate should be higher than
 			// the default relay fee.
 			fallBackFeeRate := lnwallet.AtomPerKByte(1e4)
+			if dcrdMode.CFilters {
+				fallBackFeeRate = defaultDecredLightFallbackFeePerKB
+			}
 			cc.feeEstimator, err = lnwallet.NewDcrdFeeEstimator(
 				*rpcConfig, fallBackFeeRate,
 			)
 			if err != nil {
 				return nil, err
 			}
-			if err := cc.feeEstimator.Start(); err != nil {
-				return nil, err
-			}
 		}
+
+	case "spv":
+		// In spv mode, the embedded wallet is synced over the decred
+		// p2p network, only downloading block headers and committed
+		// filters. Its syncer also serves as chain IO, and both the
+		// chain notifier and the chain view watch the chain through
+		// the committed filters downloaded by the wallet.
+		spvSyncer, err = dcrwallet.NewSPVSyncer(&dcrwallet.SPVSyncerConfig{
+			Peers:      cfg.SpvMode.Connect,
+			Net:        activeNetParams.Params,
+			AppDataDir: homeChainConfig.ChainDir,
+		})
+		if err != nil {
+			return nil, err
+		}
+		cc.chainIO = spvSyncer
+
+		source := cfnotify.NewChainIOSource(spvSyncer)
+		cc.chainNotifier, err = cfnotify.New(
+			source, activeNetParams.Params, hintCache, hintCache,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		cc.chainView, err = chainview.NewCfFilteredChainView(source)
+		if err != nil {
+			srvrLog.Errorf("unable to create chain view: %v", err)
+			return nil, err
+		}
+
+		// There's no node to ask for fee estimates nor for its relay
+		// fee, so unless the web API is used, we'll fall back to a
+		// conservative static fee rate. The relay fee floor is then
+		// the one of the estimator.
+		if !cfg.Decred.SimNet && !cfg.Decred.RegTest {
+			cc.feeEstimator = lnwallet.NewStaticFeeEstimator(
+				defaultDecredLightFallbackFeePerKB, 0,
+			)
+		}
+
 	default:
 		return nil, fmt.Errorf("unknown node type: %s",
 			homeChainConfig.Node)
 	}
 
+	// Replace the backend's fee estimator according to the configured
+	// source. The backend's estimator is kept as a fallback for the web
+	// API, which might not provide estimates for every target.
+	switch cfg.FeeEstimator {
+	case "static":
+		ltndLog.Infof("Using static fee rate of %v",
+			defaultDecredStaticFeePerKB)
+		cc.feeEstimator = lnwallet.NewStaticFeeEstimator(
+			defaultDecredStaticFeePerKB, 0,
+		)
+
+	case "webapi":
+		ltndLog.Infof("Using fee estimates from web API at %v",
+			cfg.FeeURL)
+		webEstimator := lnwallet.NewWebAPIFeeEstimator(
+			lnwallet.SparseConfFeeSource{URL: cfg.FeeURL},
+			defaultDecredStaticFeePerKB,
+		)
+		cc.feeEstimator = lnwallet.NewFallbackFeeEstimator(
+			webEstimator, cc.feeEstimator,
+		)
+	}
+
+	// Wrap the fee estimator so that all subsystems share the same view of
+	// the network's relay fee floor, which is periodically refreshed from
+	// the backend, and never produce transactions that wouldn't be
+	// relayed.
+	cc.feeEstimator = lnwallet.NewFloorFeeEstimator(
+		cc.feeEstimator, relayFeeSource,
+		lnwallet.DefaultRelayFeeRefreshInterval,
+	)
+	if err := cc.feeEstimator.Start(); err != nil {
+		return nil, err
+	}
+
 	var secretKeyRing keychain.SecretKeyRing
 
 	// Initialize the appopriate wallet controller (either the embedded
@@ -305,6 +433,24 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 			ChainIO:       cc.chainIO,
 		}
 
+		// When signing is delegated to a remote signer, the wallet is
+		// watch-only and the keys are all derived by the signer.
+		var signer *remotesigner.Signer
+		if cfg.RemoteSigner.Enable {
+			signer, err = remotesigner.New(remotesigner.Config{
+				RPCHost:      cfg.RemoteSigner.RPCHost,
+				MacaroonPath: cfg.RemoteSigner.MacaroonPath,
+				TLSCertPath:  cfg.RemoteSigner.TLSCertPath,
+				Timeout:      cfg.RemoteSigner.Timeout,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			dcrwConfig.WatchOnly = true
+			dcrwConfig.Signer = signer
+		}
+
 		wc, err := remotedcrwallet.New(*dcrwConfig)
 		if err != nil {
 			fmt.Printf("unable to create remote wallet controller: %v\n", err)
@@ -316,16 +462,29 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 		cc.signer = wc
 		cc.wc = wc
 		cc.keyRing = wc
+		cc.remoteWalletCheck = wc.CheckConnection
+
+		if signer != nil {
+			secretKeyRing = signer
+			cc.msgSigner = signer
+			cc.signer = signer
+			cc.keyRing = signer
+		}
 
 	default:
-		// Initialize an RPC syncer for this wallet and use it as
-		// blockchain IO source.
-		//
-		// TODO: switch based on the selected backend mode (rpc/spv).
-		syncer, err := dcrwallet.NewRPCSyncer(*rpcConfig,
-			activeNetParams.Params)
-		if err != nil {
-			return nil, err
+		// Initialize the syncer of the selected backend mode for this
+		// wallet.
+		var syncer dcrwallet.WalletSyncer
+		switch homeChainConfig.Node {
+		case "spv":
+			syncer = spvSyncer
+
+		default:
+			syncer, err = dcrwallet.NewRPCSyncer(*rpcConfig,
+				activeNetParams.Params)
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		dcrwConfig := &dcrwallet.Config{
@@ -355,6 +514,18 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 		cc.keyRing = wc
 	}
 
+	// If requested, guard the wallet's addresses against reuse.
+	if cfg.NoAddressReuse {
+		cc.wc = lnwallet.NewNoReuseWalletController(
+			lnwallet.NoReuseConfig{
+				WalletController: cc.wc,
+				Store:            chanDB,
+				NetParams:        activeNetParams.Params,
+				GapLimit:         cfg.AddressGapLimit,
+			},
+		)
+	}
+
 	// Select the default channel constraints for the primary chain.
 	channelConstraints := defaultDcrChannelConstraints
 
@@ -370,6 +541,7 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 		ChainIO:            cc.chainIO,
 		DefaultConstraints: channelConstraints,
 		NetParams:          *activeNetParams.Params,
+		ChangeAddressType:  cfg.changeAddrType,
 	}
 	lnWallet, err := lnwallet.NewLightningWallet(walletCfg)
 	if err != nil {
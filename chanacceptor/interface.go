@@ -16,6 +16,14 @@ type ChannelAcceptRequest struct {
 	// OpenChanMsg is the actual OpenChannel protocol message that the peer
 	// sent to us.
 	OpenChanMsg *lnwire.OpenChannel
+
+	// Tweakless denotes whether the channel would use the tweakless
+	// commitment format, as both we and the peer signal support for it.
+	Tweakless bool
+
+	// Anchors denotes whether the channel would use the anchor commitment
+	// format, as both we and the peer signal support for it.
+	Anchors bool
 }
 
 // ChannelAcceptor is an interface that represents a predicate on the data
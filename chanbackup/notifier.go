@@ -0,0 +1,156 @@
+package chanbackup
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"time"
+)
+
+const (
+	// DefaultNotifyTimeout is the default time a backup notifier is given
+	// to complete a single notification.
+	DefaultNotifyTimeout = 30 * time.Second
+)
+
+// BackupNotifier is an interface for hooks that are notified each time the
+// multi-channel backup changes. This can be used to automatically replicate
+// the backup to an off-site location.
+type BackupNotifier interface {
+	// NotifyBackupUpdate is called with the new packed multi-channel
+	// backup after it has been swapped in.
+	NotifyBackupUpdate(newBackup PackedMulti) error
+}
+
+// CommandNotifier is a BackupNotifier that executes a command each time the
+// multi-channel backup changes. The command is executed directly, without a
+// shell, with the path of the backup file as its only argument. The packed
+// backup is also written to the standard input of the command.
+type CommandNotifier struct {
+	// Command is the name or path of the command to execute.
+	Command string
+
+	// BackupFilePath is the path of the multi-channel backup file which is
+	// passed to the command.
+	BackupFilePath string
+
+	// Timeout is the duration after which the command is killed. If zero,
+	// DefaultNotifyTimeout is used.
+	Timeout time.Duration
+}
+
+// A compile-time assertion to ensure CommandNotifier meets the BackupNotifier
+// interface.
+var _ BackupNotifier = (*CommandNotifier)(nil)
+
+// NotifyBackupUpdate executes the command of the notifier.
+//
+// NOTE: This is part of the BackupNotifier interface.
+func (c *CommandNotifier) NotifyBackupUpdate(newBackup PackedMulti) error {
+	timeout := c.Timeout
+	if timeout == 0 {
+		timeout = DefaultNotifyTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, c.Command, c.BackupFilePath)
+	cmd.Stdin = bytes.NewReader(newBackup)
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("backup notify command %v failed: %v: %s",
+			c.Command, err, output)
+	}
+
+	return nil
+}
+
+// URLNotifier is a BackupNotifier that POSTs the packed multi-channel backup
+// to a URL each time it changes.
+type URLNotifier struct {
+	// URL is the URL the backup is posted to.
+	URL string
+
+	// Client is the HTTP client used to post the backup. If nil, a client
+	// with a timeout of DefaultNotifyTimeout is used.
+	Client *http.Client
+}
+
+// A compile-time assertion to ensure URLNotifier meets the BackupNotifier
+// interface.
+var _ BackupNotifier = (*URLNotifier)(nil)
+
+// NotifyBackupUpdate posts the new backup to the URL of the notifier.
+//
+// NOTE: This is part of the BackupNotifier interface.
+func (u *URLNotifier) NotifyBackupUpdate(newBackup PackedMulti) error {
+	client := u.Client
+	if client == nil {
+		client = &http.Client{
+			Timeout: DefaultNotifyTimeout,
+		}
+	}
+
+	resp, err := client.Post(
+		u.URL, "application/octet-stream", bytes.NewReader(newBackup),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to post backup: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unable to post backup: unexpected status "+
+			"%v", resp.Status)
+	}
+
+	return nil
+}
+
+// NotifyingSwapper is a Swapper that notifies a set of BackupNotifiers each
+// time the wrapped Swapper successfully swaps in a new multi-channel backup.
+// Failures of the notifiers are logged, but never fail the swap itself, as the
+// local backup is still up to date.
+type NotifyingSwapper struct {
+	Swapper
+
+	notifiers []BackupNotifier
+}
+
+// A compile-time assertion to ensure NotifyingSwapper meets the Swapper
+// interface.
+var _ Swapper = (*NotifyingSwapper)(nil)
+
+// NewNotifyingSwapper returns a new NotifyingSwapper which wraps the passed
+// swapper and notifies the passed notifiers of each update.
+func NewNotifyingSwapper(swapper Swapper,
+	notifiers ...BackupNotifier) *NotifyingSwapper {
+
+	return &NotifyingSwapper{
+		Swapper:   swapper,
+		notifiers: notifiers,
+	}
+}
+
+// UpdateAndSwap swaps in the new backup using the wrapped Swapper, then
+// notifies all notifiers.
+//
+// NOTE: This is part of the Swapper interface.
+func (n *NotifyingSwapper) UpdateAndSwap(newBackup PackedMulti) error {
+	if err := n.Swapper.UpdateAndSwap(newBackup); err != nil {
+		return err
+	}
+
+	for _, notifier := range n.notifiers {
+		if err := notifier.NotifyBackupUpdate(newBackup); err != nil {
+			log.Errorf("Unable to notify of channel backup "+
+				"update: %v", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package chanbackup
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// mockNotifier is a BackupNotifier that records all backups it is notified
+// of.
+type mockNotifier struct {
+	fail bool
+
+	backups []PackedMulti
+}
+
+func (m *mockNotifier) NotifyBackupUpdate(newBackup PackedMulti) error {
+	m.backups = append(m.backups, newBackup)
+
+	if m.fail {
+		return fmt.Errorf("fail")
+	}
+
+	return nil
+}
+
+// TestNotifyingSwapper tests that the NotifyingSwapper notifies all notifiers
+// once a backup has been swapped in, and that failing notifiers don't fail the
+// swap.
+func TestNotifyingSwapper(t *testing.T) {
+	t.Parallel()
+
+	swapper := &mockSwapper{
+		swaps: make(chan PackedMulti, 1),
+	}
+	failingNotifier := &mockNotifier{fail: true}
+	notifier := &mockNotifier{}
+
+	notifyingSwapper := NewNotifyingSwapper(
+		swapper, failingNotifier, notifier,
+	)
+
+	backup := PackedMulti([]byte{1, 2, 3})
+	if err := notifyingSwapper.UpdateAndSwap(backup); err != nil {
+		t.Fatalf("unable to swap backup: %v", err)
+	}
+	<-swapper.swaps
+
+	for _, n := range []*mockNotifier{failingNotifier, notifier} {
+		if len(n.backups) != 1 || !bytes.Equal(n.backups[0], backup) {
+			t.Fatalf("expected notification of backup %x, got %x",
+				backup, n.backups)
+		}
+	}
+
+	// If the swap itself fails, the error should be returned and no
+	// notifications should be sent.
+	swapper.fail = true
+	if err := notifyingSwapper.UpdateAndSwap(backup); err == nil {
+		t.Fatalf("expected swap to fail")
+	}
+	if len(notifier.backups) != 1 {
+		t.Fatalf("expected no notification after failed swap")
+	}
+}
+
+// TestURLNotifier tests that the URLNotifier posts the backup to its URL and
+// reports unsuccessful responses as errors.
+func TestURLNotifier(t *testing.T) {
+	t.Parallel()
+
+	var (
+		received   []byte
+		statusCode = http.StatusOK
+	)
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost {
+				t.Errorf("expected POST, got %v", r.Method)
+			}
+
+			var err error
+			received, err = ioutil.ReadAll(r.Body)
+			if err != nil {
+				t.Errorf("unable to read body: %v", err)
+			}
+
+			w.WriteHeader(statusCode)
+		},
+	))
+	defer server.Close()
+
+	notifier := &URLNotifier{URL: server.URL}
+
+	backup := PackedMulti([]byte{4, 5, 6})
+	if err := notifier.NotifyBackupUpdate(backup); err != nil {
+		t.Fatalf("unable to notify: %v", err)
+	}
+	if !bytes.Equal(received, backup) {
+		t.Fatalf("expected backup %x, got %x", backup, received)
+	}
+
+	statusCode = http.StatusInternalServerError
+	if err := notifier.NotifyBackupUpdate(backup); err == nil {
+		t.Fatalf("expected notification to fail")
+	}
+}
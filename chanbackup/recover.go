@@ -112,3 +112,83 @@ func UnpackAndRecoverMulti(packedMulti PackedMulti,
 
 	return Recover(chanBackups.StaticBackups, restorer, peerConnector)
 }
+
+// PeerChecker is an interface that allows the DryRunRecover method to check
+// whether the target node is reachable given the set of possible addresses,
+// without initiating the recovery protocol.
+type PeerChecker interface {
+	// CheckPeer returns a non-nil error if the target node can't be
+	// reached at any of the available addresses.
+	CheckPeer(node *secp256k1.PublicKey, addrs []net.Addr) error
+}
+
+// RecoveryCheck is the result of the dry run recovery of a single channel
+// backup.
+type RecoveryCheck struct {
+	// Backup is the single channel backup that was checked.
+	Backup Single
+
+	// PeerErr is the reason the channel peer couldn't be reached. If nil,
+	// then the peer is reachable.
+	PeerErr error
+}
+
+// DryRunRecover checks the reachability of the channel peers of a set of static
+// channel backups, without restoring any of the channels. This allows a caller
+// to inspect the result of a recovery attempt before committing to it, as each
+// restored channel will be force closed by the remote party.
+func DryRunRecover(backups []Single, peerChecker PeerChecker) []RecoveryCheck {
+	checks := make([]RecoveryCheck, 0, len(backups))
+	for _, backup := range backups {
+		log.Infof("Checking reachability of node=%x to restore "+
+			"ChannelPoint(%v)", backup.RemoteNodePub.SerializeCompressed(),
+			backup.FundingOutpoint)
+
+		err := peerChecker.CheckPeer(
+			backup.RemoteNodePub, backup.Addresses,
+		)
+		if err != nil {
+			log.Warnf("Unable to reach node=%x to restore "+
+				"ChannelPoint(%v): %v",
+				backup.RemoteNodePub.SerializeCompressed(),
+				backup.FundingOutpoint, err)
+		}
+
+		checks = append(checks, RecoveryCheck{
+			Backup:  backup,
+			PeerErr: err,
+		})
+	}
+
+	return checks
+}
+
+// UnpackAndDryRunSingles is a one-shot method, that given a set of packed
+// single channel backups, will decrypt them and check the reachability of the
+// channel peers, without restoring any of the channels.
+func UnpackAndDryRunSingles(singles PackedSingles,
+	keyChain keychain.KeyRing, peerChecker PeerChecker) ([]RecoveryCheck,
+	error) {
+
+	chanBackups, err := singles.Unpack(keyChain)
+	if err != nil {
+		return nil, err
+	}
+
+	return DryRunRecover(chanBackups, peerChecker), nil
+}
+
+// UnpackAndDryRunMulti is a one-shot method, that given a packed multi-channel
+// backup, will decrypt it and check the reachability of the channel peers,
+// without restoring any of the channels.
+func UnpackAndDryRunMulti(packedMulti PackedMulti,
+	keyChain keychain.KeyRing, peerChecker PeerChecker) ([]RecoveryCheck,
+	error) {
+
+	chanBackups, err := packedMulti.Unpack(keyChain)
+	if err != nil {
+		return nil, err
+	}
+
+	return DryRunRecover(chanBackups.StaticBackups, peerChecker), nil
+}
@@ -43,6 +43,26 @@ func (m *mockPeerConnector) ConnectPeer(node *secp256k1.PublicKey,
 	return nil
 }
 
+type mockPeerChecker struct {
+	// unreachable is the set of serialized node keys that can't be
+	// reached.
+	unreachable map[string]struct{}
+
+	callCount int
+}
+
+func (m *mockPeerChecker) CheckPeer(node *secp256k1.PublicKey,
+	addrs []net.Addr) error {
+
+	m.callCount++
+
+	if _, ok := m.unreachable[string(node.SerializeCompressed())]; ok {
+		return fmt.Errorf("unreachable")
+	}
+
+	return nil
+}
+
 // TestUnpackAndRecoverSingles tests that we're able to properly unpack and
 // recover a set of packed singles.
 func TestUnpackAndRecoverSingles(t *testing.T) {
@@ -230,3 +250,75 @@ func TestUnpackAndRecoverMulti(t *testing.T) {
 
 	// TODO(roasbeef): verify proper call args
 }
+
+// TestUnpackAndDryRunMulti tests that we're able to unpack a packed multi and
+// check the reachability of its channel peers without restoring any channels.
+func TestUnpackAndDryRunMulti(t *testing.T) {
+	t.Parallel()
+
+	keyRing := &mockKeyRing{}
+
+	numSingles := 10
+	backups := make([]Single, 0, numSingles)
+	for i := 0; i < numSingles; i++ {
+		channel, err := genRandomOpenChannelShell()
+		if err != nil {
+			t.Fatalf("unable make channel: %v", err)
+		}
+
+		backups = append(backups, NewSingle(channel, nil))
+	}
+
+	multi := Multi{
+		StaticBackups: backups,
+	}
+
+	var b bytes.Buffer
+	if err := multi.PackToWriter(&b, keyRing); err != nil {
+		t.Fatalf("unable to pack multi: %v", err)
+	}
+	packedMulti := PackedMulti(b.Bytes())
+
+	// We'll make the peer of the first backup unreachable, which should
+	// only be reflected in the check of that backup.
+	unreachablePub := backups[0].RemoteNodePub.SerializeCompressed()
+	peerChecker := &mockPeerChecker{
+		unreachable: map[string]struct{}{
+			string(unreachablePub): {},
+		},
+	}
+
+	checks, err := UnpackAndDryRunMulti(packedMulti, keyRing, peerChecker)
+	if err != nil {
+		t.Fatalf("unable to dry run recovery: %v", err)
+	}
+	if peerChecker.callCount != numSingles {
+		t.Fatalf("expected %v calls, instead got %v", numSingles,
+			peerChecker.callCount)
+	}
+	if len(checks) != numSingles {
+		t.Fatalf("expected %v checks, instead got %v", numSingles,
+			len(checks))
+	}
+	for i, check := range checks {
+		if check.Backup.FundingOutpoint != backups[i].FundingOutpoint {
+			t.Fatalf("check %v has wrong funding outpoint", i)
+		}
+
+		unreachable := bytes.Equal(
+			check.Backup.RemoteNodePub.SerializeCompressed(),
+			unreachablePub,
+		)
+		if unreachable != (check.PeerErr != nil) {
+			t.Fatalf("unexpected peer error for check %v: %v", i,
+				check.PeerErr)
+		}
+	}
+
+	// If we modify the keyRing, then unpacking should fail.
+	keyRing.fail = true
+	_, err = UnpackAndDryRunMulti(packedMulti, keyRing, peerChecker)
+	if err == nil {
+		t.Fatalf("unpacking should have failed")
+	}
+}
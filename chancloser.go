@@ -1,12 +1,14 @@
 package dcrlnd
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/decred/dcrd/dcrutil/v2"
 	"github.com/decred/dcrd/txscript/v2"
 	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/channeldb"
 	"github.com/decred/dcrlnd/htlcswitch"
 	"github.com/decred/dcrlnd/lnwallet"
 	"github.com/decred/dcrlnd/lnwire"
@@ -25,6 +27,12 @@ var (
 	// ErrInvalidState is returned when the closing state machine receives
 	// a message while it is in an unknown state.
 	ErrInvalidState = fmt.Errorf("invalid state")
+
+	// ErrUpfrontShutdownScriptMismatch is returned when a delivery script
+	// doesn't match the upfront shutdown script committed to when the
+	// channel was opened.
+	ErrUpfrontShutdownScriptMismatch = fmt.Errorf("shutdown script does " +
+		"not match upfront shutdown script")
 )
 
 // closeState represents all the possible states the channel closer state
@@ -116,6 +124,12 @@ type channelCloser struct {
 	// offer when starting negotiation. This will be used as a baseline.
 	idealFeeSat dcrutil.Amount
 
+	// maxFee is the highest fee that we're willing to offer or accept
+	// during the fee negotiation. A value of zero means that the fee isn't
+	// capped. This is only set if we initiated the closure with a maximum
+	// fee rate, and we're also the initiator of the channel.
+	maxFee dcrutil.Amount
+
 	// lastFeeProposal is the last fee that we proposed to the remote
 	// party. We'll use this as a pivot point to rachet our next offer up,
 	// or down, or simply accept the remote party's prior offer.
@@ -173,6 +187,23 @@ func newChannelCloser(cfg chanCloseCfg, deliveryScript []byte,
 		idealFeeSat = channelCommitFee
 	}
 
+	// If the close request specified a maximum fee rate, and we're the
+	// party that pays the closing fee, then we'll compute the absolute fee
+	// it corresponds to and ensure our ideal fee doesn't exceed it.
+	var maxFee dcrutil.Amount
+	if closeReq != nil && closeReq.MaxFeePerKB != 0 &&
+		cfg.channel.IsInitiator() {
+
+		maxFee = cfg.channel.CalcFee(closeReq.MaxFeePerKB)
+		if idealFeeSat > maxFee {
+			peerLog.Infof("Ideal starting fee of %v is greater "+
+				"than max fee of %v, clamping",
+				int64(idealFeeSat), int64(maxFee))
+
+			idealFeeSat = maxFee
+		}
+	}
+
 	peerLog.Infof("Ideal fee for closure of ChannelPoint(%v) is: %v sat",
 		cfg.channel.ChannelPoint(), int64(idealFeeSat))
 
@@ -185,6 +216,7 @@ func newChannelCloser(cfg chanCloseCfg, deliveryScript []byte,
 		cfg:                 cfg,
 		negotiationHeight:   negotiationHeight,
 		idealFeeSat:         idealFeeSat,
+		maxFee:              maxFee,
 		localDeliveryScript: deliveryScript,
 		priorFeeOffers:      make(map[dcrutil.Amount]*lnwire.ClosingSigned),
 	}
@@ -282,6 +314,16 @@ func (c *channelCloser) ProcessCloseMsg(msg lnwire.Message) ([]lnwire.Message, b
 				"instead have %v", spew.Sdump(msg))
 		}
 
+		// If the other party committed to a delivery address when the
+		// channel was opened, then they must pay to it.
+		err := maybeMatchScript(
+			c.cfg.channel.State().RemoteShutdownScript,
+			shutDownMsg.Address,
+		)
+		if err != nil {
+			return nil, false, err
+		}
+
 		// Next, we'll note the other party's preference for their
 		// delivery address. We'll use this when we craft the closure
 		// transaction.
@@ -332,6 +374,16 @@ func (c *channelCloser) ProcessCloseMsg(msg lnwire.Message) ([]lnwire.Message, b
 				"instead have %v", spew.Sdump(msg))
 		}
 
+		// Their delivery address must also match the one they
+		// committed to when the channel was opened, if any.
+		err := maybeMatchScript(
+			c.cfg.channel.State().RemoteShutdownScript,
+			shutDownMsg.Address,
+		)
+		if err != nil {
+			return nil, false, err
+		}
+
 		// Now that we know this is a valid shutdown message, we'll
 		// record their preferred delivery closing script.
 		c.remoteDeliveryScript = shutDownMsg.Address
@@ -385,6 +437,18 @@ func (c *channelCloser) ProcessCloseMsg(msg lnwire.Message) ([]lnwire.Message, b
 				remoteProposedFee,
 			)
 
+			// If we have a maximum fee set, then we'll never
+			// propose a fee above it, even if this means the
+			// negotiation can't terminate.
+			if c.maxFee != 0 && feeProposal > c.maxFee {
+				peerLog.Infof("ChannelPoint(%v): compromise "+
+					"fee of %v exceeds max fee of %v, "+
+					"clamping", c.chanPoint,
+					int64(feeProposal), int64(c.maxFee))
+
+				feeProposal = c.maxFee
+			}
+
 			// With our new fee proposal calculated, we'll craft a
 			// new close signed signature to send to the other
 			// party so we can continue the fee negotiation
@@ -435,6 +499,17 @@ func (c *channelCloser) ProcessCloseMsg(msg lnwire.Message) ([]lnwire.Message, b
 				"close: %v", c.chanPoint, err)
 		}
 
+		// We'll also record which party initiated the close, so that
+		// it can be reported once the channel is closed.
+		closeInitiator := channeldb.ChanStatusRemoteCloseInitiator
+		if c.closeReq != nil {
+			closeInitiator = channeldb.ChanStatusLocalCloseInitiator
+		}
+		err = c.cfg.channel.State().ApplyChanStatus(closeInitiator)
+		if err != nil {
+			return nil, false, err
+		}
+
 		// Before publishing the closing tx, we persist it to the
 		// database, such that it can be republished if something goes
 		// wrong.
@@ -616,3 +691,18 @@ func calcCompromiseFee(chanPoint wire.OutPoint,
 		return remoteFee
 	}
 }
+
+// maybeMatchScript returns ErrUpfrontShutdownScriptMismatch if an upfront
+// shutdown script was committed to and the given delivery script differs from
+// it. If no upfront shutdown script was set, any delivery script is accepted.
+func maybeMatchScript(upfrontScript, script lnwire.DeliveryAddress) error {
+	if len(upfrontScript) == 0 {
+		return nil
+	}
+
+	if !bytes.Equal(upfrontScript, script) {
+		return ErrUpfrontShutdownScriptMismatch
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package channeldb
+
+import (
+	"bytes"
+	"io"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	// addrReservationBucket is the name of the bucket that stores all
+	// on-chain addresses handed out by the wallet, keyed by their encoded
+	// address string. It allows detecting address reuse and recovering
+	// addresses that were handed out but never used across restarts.
+	addrReservationBucket = []byte("addr-reservations")
+)
+
+// AddrReservation records an on-chain address that has been handed out by
+// the wallet.
+type AddrReservation struct {
+	// Address is the encoded address.
+	Address string
+
+	// Change indicates whether the address is a change address which is
+	// only ever used internally by the node.
+	Change bool
+
+	// Used indicates whether a transaction paying to the address has been
+	// seen.
+	Used bool
+
+	// ReservedAt is the time the address was handed out.
+	ReservedAt time.Time
+}
+
+// PutAddrReservation stores the given address reservation, replacing any
+// existing reservation of the same address.
+func (d *DB) PutAddrReservation(r *AddrReservation) error {
+	return d.Update(func(tx *bolt.Tx) error {
+		reservations, err := tx.CreateBucketIfNotExists(
+			addrReservationBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		var b bytes.Buffer
+		if err := serializeAddrReservation(&b, r); err != nil {
+			return err
+		}
+
+		return reservations.Put([]byte(r.Address), b.Bytes())
+	})
+}
+
+// MarkAddrsUsed marks the reservations of the given addresses as used.
+// Addresses without a reservation are ignored.
+func (d *DB) MarkAddrsUsed(addrs []string) error {
+	return d.Update(func(tx *bolt.Tx) error {
+		reservations := tx.Bucket(addrReservationBucket)
+		if reservations == nil {
+			return nil
+		}
+
+		for _, addr := range addrs {
+			v := reservations.Get([]byte(addr))
+			if v == nil {
+				continue
+			}
+
+			r, err := deserializeAddrReservation(
+				addr, bytes.NewReader(v),
+			)
+			if err != nil {
+				return err
+			}
+			if r.Used {
+				continue
+			}
+			r.Used = true
+
+			var b bytes.Buffer
+			if err := serializeAddrReservation(&b, r); err != nil {
+				return err
+			}
+			err = reservations.Put([]byte(addr), b.Bytes())
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// FetchAddrReservations returns all stored address reservations.
+func (d *DB) FetchAddrReservations() ([]*AddrReservation, error) {
+	var reservations []*AddrReservation
+	err := d.View(func(tx *bolt.Tx) error {
+		reservationBucket := tx.Bucket(addrReservationBucket)
+		if reservationBucket == nil {
+			return nil
+		}
+
+		return reservationBucket.ForEach(func(k, v []byte) error {
+			r, err := deserializeAddrReservation(
+				string(k), bytes.NewReader(v),
+			)
+			if err != nil {
+				return err
+			}
+
+			reservations = append(reservations, r)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reservations, nil
+}
+
+func serializeAddrReservation(w io.Writer, r *AddrReservation) error {
+	return WriteElements(w,
+		r.Change, r.Used, uint64(r.ReservedAt.UnixNano()),
+	)
+}
+
+func deserializeAddrReservation(addr string,
+	r io.Reader) (*AddrReservation, error) {
+
+	reservation := &AddrReservation{
+		Address: addr,
+	}
+
+	var reservedAt uint64
+	err := ReadElements(r,
+		&reservation.Change, &reservation.Used, &reservedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	reservation.ReservedAt = time.Unix(0, int64(reservedAt))
+
+	return reservation, nil
+}
@@ -0,0 +1,76 @@
+package channeldb
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+// TestAddrReservations tests that address reservations can be stored, marked
+// as used and fetched back.
+func TestAddrReservations(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	reservations, err := cdb.FetchAddrReservations()
+	if err != nil {
+		t.Fatalf("unable to fetch reservations: %v", err)
+	}
+	if len(reservations) != 0 {
+		t.Fatalf("expected no reservations, got %v", len(reservations))
+	}
+
+	now := time.Unix(0, time.Now().UnixNano())
+	expected := []*AddrReservation{
+		{
+			Address:    "addr1",
+			Change:     true,
+			ReservedAt: now,
+		},
+		{
+			Address:    "addr2",
+			ReservedAt: now.Add(time.Second),
+		},
+	}
+	for _, r := range expected {
+		if err := cdb.PutAddrReservation(r); err != nil {
+			t.Fatalf("unable to store reservation: %v", err)
+		}
+	}
+
+	// Marking an unknown address as used should be ignored.
+	if err := cdb.MarkAddrsUsed([]string{"addr1", "unknown"}); err != nil {
+		t.Fatalf("unable to mark addresses used: %v", err)
+	}
+	expected[0].Used = true
+
+	reservations, err = cdb.FetchAddrReservations()
+	if err != nil {
+		t.Fatalf("unable to fetch reservations: %v", err)
+	}
+	sort.Slice(reservations, func(i, j int) bool {
+		return reservations[i].Address < reservations[j].Address
+	})
+	if len(reservations) != len(expected) {
+		t.Fatalf("expected %d reservations, got %d", len(expected),
+			len(reservations))
+	}
+	for i := range expected {
+		if !reservations[i].ReservedAt.Equal(expected[i].ReservedAt) {
+			t.Fatalf("expected reservation time %v, got %v",
+				expected[i].ReservedAt,
+				reservations[i].ReservedAt)
+		}
+		reservations[i].ReservedAt = expected[i].ReservedAt
+	}
+	if !reflect.DeepEqual(reservations, expected) {
+		t.Fatalf("expected reservations %v, got %v", expected,
+			reservations)
+	}
+}
@@ -0,0 +1,57 @@
+package channeldb
+
+import (
+	"fmt"
+
+	"github.com/decred/dcrlnd/lnwire"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	// aliasBucket is the name of the bucket that keeps track of the alias
+	// short channel IDs allocated to zero-conf channels.
+	aliasBucket = []byte("scid-alias")
+
+	// lastAliasKey is the key within the aliasBucket that stores the last
+	// alias short channel ID allocated.
+	lastAliasKey = []byte("last-alias")
+
+	// ErrNoAliasAvailable is returned when the whole range reserved for
+	// alias short channel IDs has been allocated.
+	ErrNoAliasAvailable = fmt.Errorf("no alias short channel id available")
+)
+
+// NextAliasShortChanID allocates a new alias short channel ID, to identify a
+// zero-conf channel until its funding transaction confirms. Aliases are never
+// reused, so they're unique among all the channels of the node.
+func (d *DB) NextAliasShortChanID() (lnwire.ShortChannelID, error) {
+	var alias lnwire.ShortChannelID
+	err := d.Update(func(tx *bolt.Tx) error {
+		aliases, err := tx.CreateBucketIfNotExists(aliasBucket)
+		if err != nil {
+			return err
+		}
+
+		alias = lnwire.ShortChannelID{
+			BlockHeight: lnwire.AliasStartBlockHeight,
+		}
+		if lastBytes := aliases.Get(lastAliasKey); lastBytes != nil {
+			last := byteOrder.Uint64(lastBytes)
+			alias = lnwire.NewShortChanIDFromInt(last + 1)
+		}
+
+		if !alias.IsAlias() {
+			return ErrNoAliasAvailable
+		}
+
+		var aliasBytes [8]byte
+		byteOrder.PutUint64(aliasBytes[:], alias.ToUint64())
+
+		return aliases.Put(lastAliasKey, aliasBytes[:])
+	})
+	if err != nil {
+		return lnwire.ShortChannelID{}, err
+	}
+
+	return alias, nil
+}
@@ -0,0 +1,60 @@
+package channeldb
+
+import (
+	"testing"
+
+	"github.com/decred/dcrlnd/lnwire"
+	bolt "go.etcd.io/bbolt"
+)
+
+// TestNextAliasShortChanID asserts that alias short channel IDs are allocated
+// sequentially from the start of the reserved range, and never reused.
+func TestNextAliasShortChanID(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	first, err := cdb.NextAliasShortChanID()
+	if err != nil {
+		t.Fatalf("unable to allocate alias: %v", err)
+	}
+	expected := lnwire.ShortChannelID{
+		BlockHeight: lnwire.AliasStartBlockHeight,
+	}
+	if first != expected {
+		t.Fatalf("expected alias %v, got %v", expected, first)
+	}
+
+	second, err := cdb.NextAliasShortChanID()
+	if err != nil {
+		t.Fatalf("unable to allocate alias: %v", err)
+	}
+	expected.TxPosition = 1
+	if second != expected {
+		t.Fatalf("expected alias %v, got %v", expected, second)
+	}
+
+	// Once the end of the reserved range is reached, no more aliases can
+	// be allocated.
+	last := lnwire.ShortChannelID{
+		BlockHeight: lnwire.AliasEndBlockHeight - 1,
+		TxIndex:     (1 << 24) - 1,
+		TxPosition:  (1 << 16) - 1,
+	}
+	err = cdb.Update(func(tx *bolt.Tx) error {
+		var lastBytes [8]byte
+		byteOrder.PutUint64(lastBytes[:], last.ToUint64())
+		return tx.Bucket(aliasBucket).Put(lastAliasKey, lastBytes[:])
+	})
+	if err != nil {
+		t.Fatalf("unable to store last alias: %v", err)
+	}
+
+	if _, err := cdb.NextAliasShortChanID(); err != ErrNoAliasAvailable {
+		t.Fatalf("expected ErrNoAliasAvailable, got %v", err)
+	}
+}
@@ -0,0 +1,54 @@
+package channeldb
+
+import (
+	"encoding/hex"
+	"unicode/utf8"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// BucketStats holds the size of one of the top-level buckets of the database.
+type BucketStats struct {
+	// Name is the name of the bucket, hex encoded if it isn't valid UTF-8.
+	Name string
+
+	// Keys is the number of keys stored within the bucket and its nested
+	// buckets.
+	Keys int
+
+	// Bytes is the approximate number of bytes in use by the bucket and
+	// its nested buckets, either in their own pages or inlined within
+	// their parent.
+	Bytes int
+}
+
+// BucketStats returns the size of each of the top-level buckets of the
+// database. As this traverses every bucket, it shouldn't be called often on
+// large databases.
+func (d *DB) BucketStats() ([]BucketStats, error) {
+	var stats []BucketStats
+	err := d.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, bucket *bolt.Bucket) error {
+			bucketStats := bucket.Stats()
+
+			bucketName := string(name)
+			if !utf8.Valid(name) {
+				bucketName = hex.EncodeToString(name)
+			}
+
+			stats = append(stats, BucketStats{
+				Name: bucketName,
+				Keys: bucketStats.KeyN,
+				Bytes: bucketStats.LeafInuse +
+					bucketStats.BranchInuse +
+					bucketStats.InlineBucketInuse,
+			})
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
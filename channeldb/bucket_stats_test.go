@@ -0,0 +1,62 @@
+package channeldb
+
+import (
+	"testing"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// TestBucketStats asserts that the stats of the top-level buckets of the
+// database account for the keys of their nested buckets.
+func TestBucketStats(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	bucketName := []byte("test-stats-bucket")
+	err = cdb.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucket(bucketName)
+		if err != nil {
+			return err
+		}
+		err = bucket.Put([]byte("key"), []byte("value"))
+		if err != nil {
+			return err
+		}
+
+		nested, err := bucket.CreateBucket([]byte("nested"))
+		if err != nil {
+			return err
+		}
+		return nested.Put([]byte("key"), []byte("value"))
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stats, err := cdb.BucketStats()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, s := range stats {
+		if s.Name != string(bucketName) {
+			continue
+		}
+
+		// The nested bucket is itself a key of the bucket.
+		if s.Keys != 3 {
+			t.Fatalf("expected 3 keys, got %v", s.Keys)
+		}
+		if s.Bytes == 0 {
+			t.Fatal("expected bucket to use some bytes")
+		}
+		return
+	}
+
+	t.Fatalf("bucket %s not found in stats", bucketName)
+}
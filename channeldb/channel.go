@@ -27,6 +27,20 @@ var (
 	// previously open, but now closed channels.
 	closedChannelBucket = []byte("closed-chan-bucket")
 
+	// closedChannelPeerIndexBucket indexes the closed channels by the
+	// public key of the remote peer, so that the closed channels with a
+	// peer can be fetched without scanning all closed channels.
+	//
+	// closedChanPeerIndex -> nodeID -> chanPoint
+	closedChannelPeerIndexBucket = []byte("closed-chan-peer-index")
+
+	// historicalChannelBucket stores the final state of the channels that
+	// have been closed, so that their full details remain available after
+	// the open channel state is deleted.
+	//
+	// historicalChanBucket -> chanPoint -> channel state
+	historicalChannelBucket = []byte("historical-chan-bucket")
+
 	// openChanBucket stores all the currently open channels. This bucket
 	// has a second, nested bucket which is keyed by a node's ID. Within
 	// that node ID bucket, all attributes required to track, update, and
@@ -51,6 +65,24 @@ var (
 	// party.
 	chanCommitmentKey = []byte("chan-commitment-key")
 
+	// chanHtlcCountersKey can be accessed within the sub-bucket for a
+	// particular channel. This key stores the lifetime htlc counters of
+	// the channel. It is written along with the channel info, and is
+	// absent for channels whose state hasn't been updated since the
+	// counters were introduced, in which case they start at zero.
+	chanHtlcCountersKey = []byte("chan-htlc-counters-key")
+
+	// upfrontShutdownKey can be accessed within the sub-bucket for a
+	// particular channel. This key stores the upfront shutdown scripts of
+	// the local and remote party, and is absent if neither committed to
+	// one when the channel was opened.
+	upfrontShutdownKey = []byte("upfront-shutdown-key")
+
+	// zeroConfScidKey can be accessed within the sub-bucket for a
+	// particular zero-conf channel. This key stores the short channel ID
+	// the funding transaction confirmed at, and is absent until it does.
+	zeroConfScidKey = []byte("zero-conf-scid-key")
+
 	// revocationStateKey stores their current revocation hash, our
 	// preimage producer and their preimage store.
 	revocationStateKey = []byte("revocation-state-key")
@@ -146,18 +178,31 @@ const (
 	// type, but it omits the tweak for one's key in the commitment
 	// transaction of the remote party.
 	SingleFunderTweakless ChannelType = 2
+
+	// SingleFunderAnchors is similar to the SingleFunderTweakless channel
+	// type, but its commitment transactions additionally carry an anchor
+	// output for each party, allowing either of them to bump the fee of
+	// the commitment using CPFP.
+	SingleFunderAnchors ChannelType = 3
 )
 
 // IsSingleFunder returns true if the channel type if one of the known single
 // funder variants.
 func (c ChannelType) IsSingleFunder() bool {
-	return c == SingleFunder || c == SingleFunderTweakless
+	return c == SingleFunder || c == SingleFunderTweakless ||
+		c == SingleFunderAnchors
 }
 
 // IsTweakless returns true if the target channel uses a commitment that
 // doesn't tweak the key for the remote party.
 func (c ChannelType) IsTweakless() bool {
-	return c == SingleFunderTweakless
+	return c == SingleFunderTweakless || c == SingleFunderAnchors
+}
+
+// HasAnchors returns true if the target channel uses a commitment that
+// carries anchor outputs.
+func (c ChannelType) HasAnchors() bool {
+	return c == SingleFunderAnchors
 }
 
 // ChannelConstraints represents a set of constraints meant to allow a node to
@@ -355,6 +400,14 @@ var (
 	// has been restored, and doesn't have all the fields a typical channel
 	// will have.
 	ChanStatusRestored ChannelStatus = 1 << 3
+
+	// ChanStatusLocalCloseInitiator indicates that we initiated the close
+	// of the channel.
+	ChanStatusLocalCloseInitiator ChannelStatus = 1 << 4
+
+	// ChanStatusRemoteCloseInitiator indicates that the remote peer
+	// initiated the close of the channel.
+	ChanStatusRemoteCloseInitiator ChannelStatus = 1 << 5
 )
 
 // chanStatusStrings maps a ChannelStatus to a human friendly string that
@@ -365,6 +418,9 @@ var chanStatusStrings = map[ChannelStatus]string{
 	ChanStatusCommitBroadcasted: "ChanStatusCommitBroadcasted",
 	ChanStatusLocalDataLoss:     "ChanStatusLocalDataLoss",
 	ChanStatusRestored:          "ChanStatusRestored",
+
+	ChanStatusLocalCloseInitiator:  "ChanStatusLocalCloseInitiator",
+	ChanStatusRemoteCloseInitiator: "ChanStatusRemoteCloseInitiator",
 }
 
 // orderedChanStatusFlags is an in-order list of all that channel status flags.
@@ -374,6 +430,8 @@ var orderedChanStatusFlags = []ChannelStatus{
 	ChanStatusCommitBroadcasted,
 	ChanStatusLocalDataLoss,
 	ChanStatusRestored,
+	ChanStatusLocalCloseInitiator,
+	ChanStatusRemoteCloseInitiator,
 }
 
 // String returns a human-readable representation of the ChannelStatus.
@@ -435,6 +493,12 @@ type OpenChannel struct {
 	// transaction index, and the output within the target transaction.
 	ShortChannelID lnwire.ShortChannelID
 
+	// ConfirmedShortChannelID is the location in the chain the funding
+	// transaction of a zero-conf channel confirmed at. The ShortChannelID
+	// of such a channel remains the alias it was opened with, so this is
+	// zero until the funding transaction confirms.
+	ConfirmedShortChannelID lnwire.ShortChannelID
+
 	// IsPending indicates whether a channel's funding transaction has been
 	// confirmed.
 	IsPending bool
@@ -478,12 +542,38 @@ type OpenChannel struct {
 	// received within this channel.
 	TotalMAtomsReceived lnwire.MilliAtom
 
+	// NumHtlcsSent is the number of outgoing htlcs that were settled by
+	// the remote party within this channel.
+	NumHtlcsSent uint64
+
+	// NumHtlcsReceived is the number of incoming htlcs that we settled
+	// within this channel.
+	NumHtlcsReceived uint64
+
+	// NumHtlcsSentFailed is the number of outgoing htlcs that were failed
+	// by the remote party within this channel.
+	NumHtlcsSentFailed uint64
+
+	// NumHtlcsReceivedFailed is the number of incoming htlcs that we
+	// failed within this channel.
+	NumHtlcsReceivedFailed uint64
+
 	// LocalChanCfg is the channel configuration for the local node.
 	LocalChanCfg ChannelConfig
 
 	// RemoteChanCfg is the channel configuration for the remote node.
 	RemoteChanCfg ChannelConfig
 
+	// LocalShutdownScript is the script to which our funds are paid when
+	// mutually closing the channel, as committed to when opening it. It's
+	// empty if we didn't commit to a script.
+	LocalShutdownScript lnwire.DeliveryAddress
+
+	// RemoteShutdownScript is the script to which the funds of the remote
+	// party must be paid when mutually closing the channel, as committed
+	// to when opening it. It's empty if they didn't commit to a script.
+	RemoteShutdownScript lnwire.DeliveryAddress
+
 	// LocalCommitment is the current local commitment state for the local
 	// party. This is stored distinct from the state of the remote party
 	// as there are certain asymmetric parameters which affect the
@@ -548,6 +638,21 @@ func (c *OpenChannel) ShortChanID() lnwire.ShortChannelID {
 	return c.ShortChannelID
 }
 
+// ConfirmedShortChanID returns the short channel ID the funding transaction of
+// a zero-conf channel confirmed at, or a zero value if it hasn't yet.
+func (c *OpenChannel) ConfirmedShortChanID() lnwire.ShortChannelID {
+	c.RLock()
+	defer c.RUnlock()
+
+	return c.ConfirmedShortChannelID
+}
+
+// IsZeroConf returns true if the channel was opened to be used before its
+// funding transaction confirms.
+func (c *OpenChannel) IsZeroConf() bool {
+	return c.ChannelFlags&lnwire.FFZeroConf == lnwire.FFZeroConf
+}
+
 // ChanStatus returns the current ChannelStatus of this channel.
 func (c *OpenChannel) ChanStatus() ChannelStatus {
 	c.RLock()
@@ -556,6 +661,15 @@ func (c *OpenChannel) ChanStatus() ChannelStatus {
 	return c.chanStatus
 }
 
+// CloseInitiator returns the party that initiated the close of this channel,
+// as recorded in its status.
+func (c *OpenChannel) CloseInitiator() Initiator {
+	c.RLock()
+	defer c.RUnlock()
+
+	return closeInitiator(c.chanStatus)
+}
+
 // ApplyChanStatus allows the caller to modify the internal channel state in a
 // thead-safe manner.
 func (c *OpenChannel) ApplyChanStatus(status ChannelStatus) error {
@@ -744,6 +858,40 @@ func (c *OpenChannel) MarkAsOpen(openLoc lnwire.ShortChannelID) error {
 	return nil
 }
 
+// MarkZeroConfConfirmed records the location in the chain the funding
+// transaction of a zero-conf channel confirmed at. The channel keeps being
+// identified by its alias short channel ID.
+func (c *OpenChannel) MarkZeroConfConfirmed(
+	confirmedLoc lnwire.ShortChannelID) error {
+
+	c.Lock()
+	defer c.Unlock()
+
+	if err := c.Db.Update(func(tx *bolt.Tx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		channel, err := fetchOpenChannel(chanBucket, &c.FundingOutpoint)
+		if err != nil {
+			return err
+		}
+
+		channel.ConfirmedShortChannelID = confirmedLoc
+
+		return putOpenChannel(chanBucket, channel)
+	}); err != nil {
+		return err
+	}
+
+	c.ConfirmedShortChannelID = confirmedLoc
+
+	return nil
+}
+
 // MarkDataLoss marks sets the channel status to LocalDataLoss and stores the
 // passed commitPoint for use to retrieve funds in case the remote force closes
 // the channel.
@@ -2079,6 +2227,107 @@ const (
 	Abandoned ClosureType = 5
 )
 
+// Initiator indicates the party that initiated the close of a channel.
+type Initiator uint8
+
+const (
+	// InitiatorUnknown is recorded for channels closed before the
+	// initiator was recorded, or whose initiator couldn't be determined.
+	InitiatorUnknown Initiator = 0
+
+	// InitiatorLocal indicates that we initiated the close.
+	InitiatorLocal Initiator = 1
+
+	// InitiatorRemote indicates that the remote peer initiated the close.
+	InitiatorRemote Initiator = 2
+)
+
+// String returns a human-readable representation of the Initiator.
+func (i Initiator) String() string {
+	switch i {
+	case InitiatorUnknown:
+		return "Unknown"
+	case InitiatorLocal:
+		return "Local"
+	case InitiatorRemote:
+		return "Remote"
+	default:
+		return fmt.Sprintf("Initiator(%d)", uint8(i))
+	}
+}
+
+// closeInitiator returns the initiator of the close of a channel recorded in
+// its status.
+func closeInitiator(status ChannelStatus) Initiator {
+	switch {
+	case status&ChanStatusLocalCloseInitiator != 0:
+		return InitiatorLocal
+	case status&ChanStatusRemoteCloseInitiator != 0:
+		return InitiatorRemote
+	default:
+		return InitiatorUnknown
+	}
+}
+
+// ResolutionType is the kind of an output of a closed channel that belongs to
+// us, either directly or once it's claimed.
+type ResolutionType uint8
+
+const (
+	// ResolutionTypeSettled is our output of a cooperative close, which
+	// pays to our wallet without any further action.
+	ResolutionTypeSettled ResolutionType = 0
+
+	// ResolutionTypeCommit is our output of a commitment transaction,
+	// which is time-locked if the commitment is ours.
+	ResolutionTypeCommit ResolutionType = 1
+
+	// ResolutionTypeIncomingHtlc is an htlc output claimable by us with
+	// the preimage.
+	ResolutionTypeIncomingHtlc ResolutionType = 2
+
+	// ResolutionTypeOutgoingHtlc is an htlc output claimable by us once it
+	// times out.
+	ResolutionTypeOutgoingHtlc ResolutionType = 3
+
+	// ResolutionTypeBreach is an output of a revoked commitment claimable
+	// by us through the justice transaction.
+	ResolutionTypeBreach ResolutionType = 4
+)
+
+// String returns a human-readable representation of the ResolutionType.
+func (r ResolutionType) String() string {
+	switch r {
+	case ResolutionTypeSettled:
+		return "Settled"
+	case ResolutionTypeCommit:
+		return "Commit"
+	case ResolutionTypeIncomingHtlc:
+		return "IncomingHtlc"
+	case ResolutionTypeOutgoingHtlc:
+		return "OutgoingHtlc"
+	case ResolutionTypeBreach:
+		return "Breach"
+	default:
+		return fmt.Sprintf("ResolutionType(%d)", uint8(r))
+	}
+}
+
+// CloseResolution is an output of the closing transaction of a channel which
+// belongs to us, recorded so that the funds recovered from a closed channel
+// can be traced on-chain.
+type CloseResolution struct {
+	// Type is the kind of the output.
+	Type ResolutionType
+
+	// OutPoint is the outpoint of the output within the closing
+	// transaction.
+	OutPoint wire.OutPoint
+
+	// Amount is the value of the output.
+	Amount dcrutil.Amount
+}
+
 // ChannelCloseSummary contains the final state of a channel at the point it
 // was closed. Once a channel is closed, all the information pertaining to that
 // channel within the openChannelBucket is deleted, and a compact summary is
@@ -2158,6 +2407,15 @@ type ChannelCloseSummary struct {
 	// LastChanSyncMsg is the ChannelReestablish message for this channel
 	// for the state at the point where it was closed.
 	LastChanSyncMsg *lnwire.ChannelReestablish
+
+	// CloseInitiator is the party that initiated the close of the channel.
+	// If it isn't set when the channel is closed, it is taken from the
+	// status of the channel.
+	CloseInitiator Initiator
+
+	// Resolutions are the outputs of the closing transaction that belong
+	// to us, omitting the outputs that were trimmed as dust.
+	Resolutions []CloseResolution
 }
 
 // CloseChannel closes a previously active Lightning channel. Closing a channel
@@ -2228,6 +2486,25 @@ func (c *OpenChannel) CloseChannel(summary *ChannelCloseSummary) error {
 			return err
 		}
 
+		// We'll then keep the final state of the channel within the
+		// historical channel bucket, so that its full details remain
+		// available once it's closed.
+		historicalBucket, err := tx.CreateBucketIfNotExists(
+			historicalChannelBucket,
+		)
+		if err != nil {
+			return err
+		}
+		historicalChanBucket, err := historicalBucket.CreateBucketIfNotExists(
+			chanPointBuf.Bytes(),
+		)
+		if err != nil {
+			return err
+		}
+		if err := putOpenChannel(historicalChanBucket, chanState); err != nil {
+			return err
+		}
+
 		// Finally, create a summary of this channel in the closed
 		// channel bucket for this node.
 		return putChannelCloseSummary(
@@ -2360,12 +2637,46 @@ func putChannelCloseSummary(tx *bolt.Tx, chanID []byte,
 	summary.RemoteNextRevocation = lastChanState.RemoteNextRevocation
 	summary.LocalChanConfig = lastChanState.LocalChanCfg
 
+	if summary.CloseInitiator == InitiatorUnknown {
+		summary.CloseInitiator = closeInitiator(lastChanState.chanStatus)
+	}
+
 	var b bytes.Buffer
 	if err := serializeChannelCloseSummary(&b, summary); err != nil {
 		return err
 	}
 
-	return closedChanBucket.Put(chanID, b.Bytes())
+	if err := closedChanBucket.Put(chanID, b.Bytes()); err != nil {
+		return err
+	}
+
+	return putClosedChannelPeerIndex(tx, summary.RemotePub, chanID)
+}
+
+// putClosedChannelPeerIndex adds the closed channel identified by chanID to the
+// index of the closed channels of the remote peer.
+func putClosedChannelPeerIndex(tx *bolt.Tx, remotePub *secp256k1.PublicKey,
+	chanID []byte) error {
+
+	if remotePub == nil {
+		return nil
+	}
+
+	peerIndex, err := tx.CreateBucketIfNotExists(
+		closedChannelPeerIndexBucket,
+	)
+	if err != nil {
+		return err
+	}
+
+	peerBucket, err := peerIndex.CreateBucketIfNotExists(
+		remotePub.SerializeCompressed(),
+	)
+	if err != nil {
+		return err
+	}
+
+	return peerBucket.Put(chanID, []byte{})
 }
 
 func serializeChannelCloseSummary(w io.Writer, cs *ChannelCloseSummary) error {
@@ -2379,9 +2690,13 @@ func serializeChannelCloseSummary(w io.Writer, cs *ChannelCloseSummary) error {
 	}
 
 	// If this is a close channel summary created before the addition of
-	// the new fields, then we can exit here.
+	// the new fields, then we skip to the trailing fields.
 	if cs.RemoteCurrentRevocation == nil {
-		return WriteElements(w, false)
+		if err := WriteElements(w, false); err != nil {
+			return err
+		}
+
+		return serializeCloseDetails(w, cs)
 	}
 
 	// If fields are present, write boolean to indicate this, and continue.
@@ -2422,6 +2737,71 @@ func serializeChannelCloseSummary(w io.Writer, cs *ChannelCloseSummary) error {
 		if err := WriteElements(w, cs.LastChanSyncMsg); err != nil {
 			return err
 		}
+
+		// A sync message without its optional fields would attempt to
+		// read the trailing fields as those, so they can't follow it.
+		if cs.LastChanSyncMsg.LocalUnrevokedCommitPoint == nil {
+			return nil
+		}
+	}
+
+	return serializeCloseDetails(w, cs)
+}
+
+// serializeCloseDetails writes the close initiator and resolutions of a close
+// summary, which trail the rest of its fields.
+func serializeCloseDetails(w io.Writer, cs *ChannelCloseSummary) error {
+	numResolutions := uint16(len(cs.Resolutions))
+	err := WriteElements(w, uint8(cs.CloseInitiator), numResolutions)
+	if err != nil {
+		return err
+	}
+
+	for _, res := range cs.Resolutions {
+		err := WriteElements(
+			w, uint8(res.Type), res.OutPoint, res.Amount,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deserializeCloseDetails reads the close initiator and resolutions of a
+// close summary. They were added after the rest of its fields, so they may
+// not be present for older summaries.
+func deserializeCloseDetails(r io.Reader, c *ChannelCloseSummary) error {
+	var initiator uint8
+	err := ReadElements(r, &initiator)
+	if err == io.EOF {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	c.CloseInitiator = Initiator(initiator)
+
+	var numResolutions uint16
+	if err := ReadElements(r, &numResolutions); err != nil {
+		return err
+	}
+
+	if numResolutions == 0 {
+		return nil
+	}
+
+	c.Resolutions = make([]CloseResolution, numResolutions)
+	for i := range c.Resolutions {
+		var resType uint8
+		err := ReadElements(
+			r, &resType, &c.Resolutions[i].OutPoint,
+			&c.Resolutions[i].Amount,
+		)
+		if err != nil {
+			return err
+		}
+		c.Resolutions[i].Type = ResolutionType(resType)
 	}
 
 	return nil
@@ -2447,8 +2827,12 @@ func deserializeCloseChannelSummary(r io.Reader) (*ChannelCloseSummary, error) {
 		return nil, err
 	}
 
-	// If fields are not present, we can return.
+	// If fields are not present, only the trailing fields may follow.
 	if !hasNewFields {
+		if err := deserializeCloseDetails(r, c); err != nil {
+			return nil, err
+		}
+
 		return c, nil
 	}
 
@@ -2505,6 +2889,10 @@ func deserializeCloseChannelSummary(r io.Reader) (*ChannelCloseSummary, error) {
 		c.LastChanSyncMsg = chanSync
 	}
 
+	if err := deserializeCloseDetails(r, c); err != nil {
+		return nil, err
+	}
+
 	return c, nil
 }
 
@@ -2546,7 +2934,131 @@ func putChanInfo(chanBucket *bolt.Bucket, channel *OpenChannel) error {
 		return err
 	}
 
-	return chanBucket.Put(chanInfoKey, w.Bytes())
+	if err := chanBucket.Put(chanInfoKey, w.Bytes()); err != nil {
+		return err
+	}
+
+	if err := putChanUpfrontShutdown(chanBucket, channel); err != nil {
+		return err
+	}
+
+	if err := putChanZeroConfScid(chanBucket, channel); err != nil {
+		return err
+	}
+
+	return putChanHtlcCounters(chanBucket, channel)
+}
+
+// putChanUpfrontShutdown stores the upfront shutdown scripts of the channel,
+// if either party committed to one.
+func putChanUpfrontShutdown(chanBucket *bolt.Bucket,
+	channel *OpenChannel) error {
+
+	if len(channel.LocalShutdownScript) == 0 &&
+		len(channel.RemoteShutdownScript) == 0 {
+
+		return nil
+	}
+
+	var w bytes.Buffer
+	err := WriteElements(&w,
+		[]byte(channel.LocalShutdownScript),
+		[]byte(channel.RemoteShutdownScript),
+	)
+	if err != nil {
+		return err
+	}
+
+	return chanBucket.Put(upfrontShutdownKey, w.Bytes())
+}
+
+// fetchChanUpfrontShutdown reads the upfront shutdown scripts of the channel.
+// If none have been stored, the scripts are left empty.
+func fetchChanUpfrontShutdown(chanBucket *bolt.Bucket,
+	channel *OpenChannel) error {
+
+	scriptsBytes := chanBucket.Get(upfrontShutdownKey)
+	if scriptsBytes == nil {
+		return nil
+	}
+
+	var localScript, remoteScript []byte
+	err := ReadElements(bytes.NewReader(scriptsBytes),
+		&localScript, &remoteScript,
+	)
+	if err != nil {
+		return err
+	}
+
+	if len(localScript) > 0 {
+		channel.LocalShutdownScript = localScript
+	}
+	if len(remoteScript) > 0 {
+		channel.RemoteShutdownScript = remoteScript
+	}
+
+	return nil
+}
+
+// putChanZeroConfScid stores the confirmed short channel ID of a zero-conf
+// channel, if its funding transaction has confirmed.
+func putChanZeroConfScid(chanBucket *bolt.Bucket, channel *OpenChannel) error {
+	if channel.ConfirmedShortChannelID == (lnwire.ShortChannelID{}) {
+		return nil
+	}
+
+	var w bytes.Buffer
+	err := WriteElement(&w, channel.ConfirmedShortChannelID)
+	if err != nil {
+		return err
+	}
+
+	return chanBucket.Put(zeroConfScidKey, w.Bytes())
+}
+
+// fetchChanZeroConfScid reads the confirmed short channel ID of a zero-conf
+// channel. If none has been stored, it's left zero.
+func fetchChanZeroConfScid(chanBucket *bolt.Bucket,
+	channel *OpenChannel) error {
+
+	scidBytes := chanBucket.Get(zeroConfScidKey)
+	if scidBytes == nil {
+		return nil
+	}
+
+	return ReadElement(
+		bytes.NewReader(scidBytes), &channel.ConfirmedShortChannelID,
+	)
+}
+
+// putChanHtlcCounters stores the lifetime htlc counters of the channel.
+func putChanHtlcCounters(chanBucket *bolt.Bucket, channel *OpenChannel) error {
+	var w bytes.Buffer
+	err := WriteElements(&w,
+		channel.NumHtlcsSent, channel.NumHtlcsReceived,
+		channel.NumHtlcsSentFailed, channel.NumHtlcsReceivedFailed,
+	)
+	if err != nil {
+		return err
+	}
+
+	return chanBucket.Put(chanHtlcCountersKey, w.Bytes())
+}
+
+// fetchChanHtlcCounters reads the lifetime htlc counters of the channel. If
+// none have been stored yet, the counters are left at zero.
+func fetchChanHtlcCounters(chanBucket *bolt.Bucket,
+	channel *OpenChannel) error {
+
+	countersBytes := chanBucket.Get(chanHtlcCountersKey)
+	if countersBytes == nil {
+		return nil
+	}
+
+	return ReadElements(bytes.NewReader(countersBytes),
+		&channel.NumHtlcsSent, &channel.NumHtlcsReceived,
+		&channel.NumHtlcsSentFailed, &channel.NumHtlcsReceivedFailed,
+	)
 }
 
 func serializeChanCommit(w io.Writer, c *ChannelCommitment) error {
@@ -2670,7 +3182,15 @@ func fetchChanInfo(chanBucket *bolt.Bucket, channel *OpenChannel) error {
 
 	channel.Packager = NewChannelPackager(channel.ShortChannelID)
 
-	return nil
+	if err := fetchChanUpfrontShutdown(chanBucket, channel); err != nil {
+		return err
+	}
+
+	if err := fetchChanZeroConfScid(chanBucket, channel); err != nil {
+		return err
+	}
+
+	return fetchChanHtlcCounters(chanBucket, channel)
 }
 
 func deserializeChanCommit(r io.Reader) (ChannelCommitment, error) {
@@ -2776,6 +3296,14 @@ func deleteOpenChannel(chanBucket *bolt.Bucket, chanPointBytes []byte) error {
 		return err
 	}
 
+	if err := chanBucket.Delete(upfrontShutdownKey); err != nil {
+		return err
+	}
+
+	if err := chanBucket.Delete(zeroConfScidKey); err != nil {
+		return err
+	}
+
 	if diff := chanBucket.Get(commitDiffKey); diff != nil {
 		return chanBucket.Delete(commitDiffKey)
 	}
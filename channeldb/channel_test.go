@@ -200,6 +200,9 @@ func createTestChannelState(cdb *DB) (*OpenChannel, error) {
 		RemoteChanCfg:       remoteCfg,
 		TotalMAtomsSent:     8,
 		TotalMAtomsReceived: 2,
+		NumHtlcsSent:        3,
+		NumHtlcsReceived:    1,
+		NumHtlcsSentFailed:  2,
 		LocalCommitment: ChannelCommitment{
 			CommitHeight:  0,
 			LocalBalance:  lnwire.MilliAtom(9000),
@@ -265,6 +268,12 @@ func TestOpenChannelPutGetDelete(t *testing.T) {
 		},
 	}
 
+	// Only we committed to an upfront shutdown script, which should be
+	// the only one restored.
+	state.LocalShutdownScript = lnwire.DeliveryAddress(
+		bytes.Repeat([]byte{2}, 25),
+	)
+
 	addr := &net.TCPAddr{
 		IP:   net.ParseIP("127.0.0.1"),
 		Port: 18556,
@@ -848,6 +857,74 @@ func TestFetchClosedChannels(t *testing.T) {
 	}
 }
 
+// TestFetchHistoricalChannel asserts that the final state of a channel is
+// kept once it is closed.
+func TestFetchHistoricalChannel(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+
+	// Before any channel is closed, there's no historical channel state.
+	_, err = cdb.FetchHistoricalChannel(&state.FundingOutpoint)
+	if err != ErrNoHistoricalBucket {
+		t.Fatalf("expected ErrNoHistoricalBucket, got: %v", err)
+	}
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18556,
+	}
+	if err := state.SyncPending(addr, 101); err != nil {
+		t.Fatalf("unable to save and serialize channel state: %v", err)
+	}
+
+	openChannels, err := cdb.FetchOpenChannels(state.IdentityPub)
+	if err != nil {
+		t.Fatalf("unable to fetch open channel: %v", err)
+	}
+	openChannel := openChannels[0]
+
+	closeSummary := &ChannelCloseSummary{
+		ChanPoint:         state.FundingOutpoint,
+		RemotePub:         state.IdentityPub,
+		SettledBalance:    dcrutil.Amount(500),
+		TimeLockedBalance: dcrutil.Amount(10000),
+		IsPending:         false,
+		CloseType:         CooperativeClose,
+	}
+	if err := state.CloseChannel(closeSummary); err != nil {
+		t.Fatalf("unable to close channel: %v", err)
+	}
+
+	// The final state of the channel should now be retrievable, matching
+	// the state it had right before being closed.
+	histChannel, err := cdb.FetchHistoricalChannel(&state.FundingOutpoint)
+	if err != nil {
+		t.Fatalf("unable to fetch historical channel: %v", err)
+	}
+	if !reflect.DeepEqual(openChannel, histChannel) {
+		t.Fatalf("historical channel state doesn't match: %v vs %v",
+			spew.Sdump(openChannel), spew.Sdump(histChannel))
+	}
+
+	// Channels that were never closed aren't found.
+	unknownOutpoint := state.FundingOutpoint
+	unknownOutpoint.Index++
+	_, err = cdb.FetchHistoricalChannel(&unknownOutpoint)
+	if err != ErrChannelNotFound {
+		t.Fatalf("expected ErrChannelNotFound, got: %v", err)
+	}
+}
+
 // TestFetchWaitingCloseChannels ensures that the correct channels that are
 // waiting to be closed are returned.
 func TestFetchWaitingCloseChannels(t *testing.T) {
@@ -1042,3 +1119,158 @@ func TestRefreshShortChanID(t *testing.T) {
 			pendingChannel.Packager.(*ChannelPackager).source)
 	}
 }
+
+// TestMarkZeroConfConfirmed asserts that the confirmed short channel ID of a
+// zero-conf channel is persisted separately from the alias identifying it.
+func TestMarkZeroConfConfirmed(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	state.ChannelFlags |= lnwire.FFZeroConf
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	if err := state.SyncPending(addr, 99); err != nil {
+		t.Fatalf("unable to save and serialize channel state: %v", err)
+	}
+
+	alias, err := cdb.NextAliasShortChanID()
+	if err != nil {
+		t.Fatalf("unable to allocate alias: %v", err)
+	}
+	if err := state.MarkAsOpen(alias); err != nil {
+		t.Fatalf("unable to mark channel open: %v", err)
+	}
+
+	confirmedLoc := lnwire.ShortChannelID{
+		BlockHeight: 105,
+		TxIndex:     10,
+		TxPosition:  15,
+	}
+	if err := state.MarkZeroConfConfirmed(confirmedLoc); err != nil {
+		t.Fatalf("unable to mark channel confirmed: %v", err)
+	}
+
+	openChannels, err := cdb.FetchOpenChannels(state.IdentityPub)
+	if err != nil {
+		t.Fatalf("unable to fetch open channel: %v", err)
+	}
+	dbChannel := openChannels[0]
+
+	if !dbChannel.IsZeroConf() {
+		t.Fatalf("expected channel to be zero-conf")
+	}
+	if dbChannel.ShortChanID() != alias {
+		t.Fatalf("expected short chan id %v, got %v", alias,
+			dbChannel.ShortChanID())
+	}
+	if dbChannel.ConfirmedShortChanID() != confirmedLoc {
+		t.Fatalf("expected confirmed short chan id %v, got %v",
+			confirmedLoc, dbChannel.ConfirmedShortChanID())
+	}
+}
+
+// TestCloseSummaryDetails asserts that the initiator and resolutions of a
+// close summary are stored, that the initiator is taken from the channel
+// status when it isn't set, and that summaries without the new fields can
+// still be read.
+func TestCloseSummaryDetails(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18556,
+	}
+	if err := state.SyncPending(addr, 101); err != nil {
+		t.Fatalf("unable to save and serialize channel state: %v", err)
+	}
+
+	err = state.ApplyChanStatus(ChanStatusRemoteCloseInitiator)
+	if err != nil {
+		t.Fatalf("unable to apply channel status: %v", err)
+	}
+	if state.CloseInitiator() != InitiatorRemote {
+		t.Fatalf("expected initiator %v, got %v", InitiatorRemote,
+			state.CloseInitiator())
+	}
+
+	resolutions := []CloseResolution{
+		{
+			Type: ResolutionTypeSettled,
+			OutPoint: wire.OutPoint{
+				Hash:  chainhash.Hash{1},
+				Index: 1,
+			},
+			Amount: 5000,
+		},
+	}
+	closeSummary := &ChannelCloseSummary{
+		ChanPoint:      state.FundingOutpoint,
+		RemotePub:      state.IdentityPub,
+		SettledBalance: dcrutil.Amount(5000),
+		CloseType:      CooperativeClose,
+		Resolutions:    resolutions,
+	}
+	if err := state.CloseChannel(closeSummary); err != nil {
+		t.Fatalf("unable to close channel: %v", err)
+	}
+
+	summary, err := cdb.FetchClosedChannel(&state.FundingOutpoint)
+	if err != nil {
+		t.Fatalf("unable to fetch close summary: %v", err)
+	}
+	if summary.CloseInitiator != InitiatorRemote {
+		t.Fatalf("expected initiator %v, got %v", InitiatorRemote,
+			summary.CloseInitiator)
+	}
+	if !reflect.DeepEqual(summary.Resolutions, resolutions) {
+		t.Fatalf("expected resolutions %v, got %v",
+			spew.Sdump(resolutions), spew.Sdump(summary.Resolutions))
+	}
+
+	// A summary written before the initiator and resolutions were recorded
+	// is still read.
+	var b bytes.Buffer
+	err = WriteElements(&b,
+		summary.ChanPoint, summary.ShortChanID, summary.ChainHash,
+		summary.ClosingTXID, summary.CloseHeight, summary.RemotePub,
+		summary.Capacity, summary.SettledBalance,
+		summary.TimeLockedBalance, summary.CloseType, summary.IsPending,
+		false,
+	)
+	if err != nil {
+		t.Fatalf("unable to write legacy summary: %v", err)
+	}
+	legacySummary, err := deserializeCloseChannelSummary(&b)
+	if err != nil {
+		t.Fatalf("unable to read legacy summary: %v", err)
+	}
+	if legacySummary.CloseInitiator != InitiatorUnknown ||
+		legacySummary.Resolutions != nil {
+
+		t.Fatalf("expected no close details, got %v",
+			spew.Sdump(legacySummary))
+	}
+}
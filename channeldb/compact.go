@@ -0,0 +1,217 @@
+package channeldb
+
+import (
+	"os"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	// compactTxMaxSize is the maximum size of the keys and values copied
+	// within a single transaction while compacting the database, bounding
+	// the memory used by the compaction.
+	compactTxMaxSize = 64 * 1024 * 1024
+)
+
+// freePageRatio returns the ratio of the pages of the database file which are
+// free, thus only reclaimed by compacting it.
+func freePageRatio(bdb *bolt.DB) (float64, error) {
+	var size int64
+	err := bdb.View(func(tx *bolt.Tx) error {
+		size = tx.Size()
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	numPages := size / int64(bdb.Info().PageSize)
+	if numPages == 0 {
+		return 0, nil
+	}
+
+	stats := bdb.Stats()
+	freePages := int64(stats.FreePageN + stats.PendingPageN)
+
+	return float64(freePages) / float64(numPages), nil
+}
+
+// compactIfNeeded rewrites the database at path into a new file holding no
+// free pages if the ratio of its free pages is at least minFreeRatio. Bolt
+// never shrinks its file, so space freed by deleted data is otherwise only
+// reused by later writes. The database must not be open.
+func compactIfNeeded(path string, options *bolt.Options,
+	minFreeRatio float64) error {
+
+	src, err := bolt.Open(path, dbFilePermission, options)
+	if err != nil {
+		return err
+	}
+
+	ratio, err := freePageRatio(src)
+	if err != nil {
+		src.Close()
+		return err
+	}
+	if ratio < minFreeRatio {
+		log.Debugf("Not compacting database with %.1f%% of free pages",
+			ratio*100)
+		return src.Close()
+	}
+
+	srcInfo, err := os.Stat(path)
+	if err != nil {
+		src.Close()
+		return err
+	}
+
+	log.Infof("Compacting database with %.1f%% of free pages, %d bytes",
+		ratio*100, srcInfo.Size())
+
+	tmpPath := path + ".compact"
+	if err := compactDB(tmpPath, src); err != nil {
+		src.Close()
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := src.Close(); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+
+	dstInfo, err := os.Stat(tmpPath)
+	if err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+
+	log.Infof("Compacted database from %d to %d bytes", srcInfo.Size(),
+		dstInfo.Size())
+
+	return nil
+}
+
+// compactDB copies all the buckets, keys and sequences of src into a new
+// database at path. The copy is written in batches of at most
+// compactTxMaxSize bytes, with full pages.
+func compactDB(path string, src *bolt.DB) error {
+	dst, err := bolt.Open(path, dbFilePermission, nil)
+	if err != nil {
+		return err
+	}
+
+	tx, err := dst.Begin(true)
+	if err != nil {
+		dst.Close()
+		return err
+	}
+
+	var size int64
+	err = walkDB(src, func(keys [][]byte, k, v []byte, seq uint64) error {
+		// Commit the batch copied so far once too large.
+		if size+int64(len(k)+len(v)) > compactTxMaxSize {
+			if err := tx.Commit(); err != nil {
+				return err
+			}
+
+			newTx, err := dst.Begin(true)
+			if err != nil {
+				return err
+			}
+			tx = newTx
+			size = 0
+		}
+		size += int64(len(k) + len(v))
+
+		// Top level buckets are created within the transaction itself.
+		if len(keys) == 0 {
+			bucket, err := tx.CreateBucket(k)
+			if err != nil {
+				return err
+			}
+			return bucket.SetSequence(seq)
+		}
+
+		// Otherwise, the parent bucket is looked up from its path, as
+		// it may have been created within a previous batch.
+		bucket := tx.Bucket(keys[0])
+		for _, key := range keys[1:] {
+			bucket = bucket.Bucket(key)
+		}
+
+		// Keys are copied in order, so pages are filled completely.
+		bucket.FillPercent = 1.0
+
+		if v == nil {
+			nested, err := bucket.CreateBucket(k)
+			if err != nil {
+				return err
+			}
+			return nested.SetSequence(seq)
+		}
+
+		return bucket.Put(k, v)
+	})
+	if err != nil {
+		tx.Rollback()
+		dst.Close()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		dst.Close()
+		return err
+	}
+
+	return dst.Close()
+}
+
+// walkFunc is called for every bucket and key of a database, with the path of
+// the bucket holding it. The value of a bucket is nil, and only buckets have
+// a sequence.
+type walkFunc func(keys [][]byte, k, v []byte, seq uint64) error
+
+// walkDB calls walkFn for every bucket and key of the database, parents
+// first, within a single read transaction.
+func walkDB(bdb *bolt.DB, walkFn walkFunc) error {
+	return bdb.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			return walkBucket(b, nil, name, nil, b.Sequence(), walkFn)
+		})
+	})
+}
+
+// walkBucket calls walkFn for the given key, then for the content of the
+// bucket if it is one.
+func walkBucket(b *bolt.Bucket, keys [][]byte, k, v []byte, seq uint64,
+	walkFn walkFunc) error {
+
+	if err := walkFn(keys, k, v, seq); err != nil {
+		return err
+	}
+
+	// Only nested buckets have content to walk through.
+	if v != nil {
+		return nil
+	}
+
+	// The path is copied so that sibling buckets don't share it.
+	path := make([][]byte, len(keys)+1)
+	copy(path, keys)
+	path[len(keys)] = k
+
+	return b.ForEach(func(k, v []byte) error {
+		if v == nil {
+			nested := b.Bucket(k)
+			return walkBucket(
+				nested, path, k, nil, nested.Sequence(), walkFn,
+			)
+		}
+
+		return walkBucket(b, path, k, v, 0, walkFn)
+	})
+}
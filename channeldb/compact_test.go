@@ -0,0 +1,137 @@
+package channeldb
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// TestCompactIfNeeded asserts that a database with enough free pages is
+// rewritten into a smaller file holding the same buckets, keys and sequences.
+func TestCompactIfNeeded(t *testing.T) {
+	t.Parallel()
+
+	tempDir, err := ioutil.TempDir("", "channeldb")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	path := filepath.Join(tempDir, dbName)
+	bdb, err := bolt.Open(path, dbFilePermission, nil)
+	if err != nil {
+		t.Fatalf("unable to open db: %v", err)
+	}
+
+	topBucket := []byte("top")
+	nestedBucket := []byte("nested")
+	value := bytes.Repeat([]byte{1}, 1024)
+
+	// Fill a bucket with data which is deleted afterwards, leaving its
+	// pages free, next to a nested bucket whose content is kept.
+	err = bdb.Update(func(tx *bolt.Tx) error {
+		top, err := tx.CreateBucket(topBucket)
+		if err != nil {
+			return err
+		}
+		if err := top.SetSequence(7); err != nil {
+			return err
+		}
+		nested, err := top.CreateBucket(nestedBucket)
+		if err != nil {
+			return err
+		}
+		if err := nested.SetSequence(42); err != nil {
+			return err
+		}
+		if err := nested.Put([]byte("kept"), value); err != nil {
+			return err
+		}
+
+		garbage, err := tx.CreateBucket([]byte("garbage"))
+		if err != nil {
+			return err
+		}
+		for i := 0; i < 1000; i++ {
+			var key [8]byte
+			byteOrder.PutUint64(key[:], uint64(i))
+			if err := garbage.Put(key[:], value); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to fill db: %v", err)
+	}
+	err = bdb.Update(func(tx *bolt.Tx) error {
+		return tx.DeleteBucket([]byte("garbage"))
+	})
+	if err != nil {
+		t.Fatalf("unable to delete bucket: %v", err)
+	}
+	if err := bdb.Close(); err != nil {
+		t.Fatalf("unable to close db: %v", err)
+	}
+
+	before, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("unable to stat db: %v", err)
+	}
+
+	// A database with less free pages than required isn't compacted.
+	if err := compactIfNeeded(path, nil, 1); err != nil {
+		t.Fatalf("unable to compact db: %v", err)
+	}
+	unchanged, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("unable to stat db: %v", err)
+	}
+	if unchanged.Size() != before.Size() {
+		t.Fatalf("db compacted below threshold")
+	}
+
+	if err := compactIfNeeded(path, nil, 0.1); err != nil {
+		t.Fatalf("unable to compact db: %v", err)
+	}
+	after, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("unable to stat db: %v", err)
+	}
+	if after.Size() >= before.Size() {
+		t.Fatalf("db not shrunk by compaction: %d >= %d bytes",
+			after.Size(), before.Size())
+	}
+
+	bdb, err = bolt.Open(path, dbFilePermission, nil)
+	if err != nil {
+		t.Fatalf("unable to open compacted db: %v", err)
+	}
+	defer bdb.Close()
+
+	err = bdb.View(func(tx *bolt.Tx) error {
+		top := tx.Bucket(topBucket)
+		if top == nil || top.Sequence() != 7 {
+			t.Fatalf("top bucket not copied")
+		}
+		nested := top.Bucket(nestedBucket)
+		if nested == nil || nested.Sequence() != 42 {
+			t.Fatalf("nested bucket not copied")
+		}
+		if !bytes.Equal(nested.Get([]byte("kept")), value) {
+			t.Fatalf("value not copied")
+		}
+		if tx.Bucket([]byte("garbage")) != nil {
+			t.Fatalf("deleted bucket copied")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to read compacted db: %v", err)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/decred/dcrd/dcrec/secp256k1/v2"
+	"github.com/decred/dcrd/dcrutil/v2"
 	"github.com/decred/dcrd/wire"
 	"github.com/decred/dcrlnd/lnwire"
 	"github.com/go-errors/errors"
@@ -115,6 +116,18 @@ var (
 			number:    11,
 			migration: migrateInvoices,
 		},
+		{
+			// The DB version that indexes the closed channels by
+			// the public key of the remote peer.
+			number:    12,
+			migration: migrateClosedChannelPeerIndex,
+		},
+		{
+			// The DB version that records all htlc attempts of
+			// payments, rather than only the last one.
+			number:    13,
+			migration: migratePaymentHTLCs,
+		},
 	}
 
 	// Big endian is the preferred byte order, due to cursor scans over
@@ -130,6 +143,9 @@ type DB struct {
 	dbPath string
 	graph  *ChannelGraph
 	now    func() time.Time
+
+	migrationBackup bool
+	dryRunMigration bool
 }
 
 // Open opens an existing channeldb. Any necessary schemas migrations due to
@@ -137,6 +153,12 @@ type DB struct {
 func Open(dbPath string, modifiers ...OptionModifier) (*DB, error) {
 	path := filepath.Join(dbPath, dbName)
 
+	// A database only present in encrypted form must not be mistaken for
+	// a missing one, which would be replaced by a blank database.
+	if IsDBEncrypted(dbPath) {
+		return nil, ErrDBEncrypted
+	}
+
 	if !fileExists(path) {
 		if err := createChannelDB(dbPath); err != nil {
 			return nil, err
@@ -155,15 +177,29 @@ func Open(dbPath string, modifiers ...OptionModifier) (*DB, error) {
 		FreelistType:   bolt.FreelistMapType,
 	}
 
+	// Compact the database file before opening it for good, as it can
+	// only be rewritten while closed. A dry run leaves the file untouched.
+	if opts.AutoCompact && !opts.DryRunMigration {
+		err := compactIfNeeded(
+			path, options, opts.AutoCompactMinFreeRatio,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to compact database: %v",
+				err)
+		}
+	}
+
 	bdb, err := bolt.Open(path, dbFilePermission, options)
 	if err != nil {
 		return nil, err
 	}
 
 	chanDB := &DB{
-		DB:     bdb,
-		dbPath: dbPath,
-		now:    time.Now,
+		DB:              bdb,
+		dbPath:          dbPath,
+		now:             time.Now,
+		migrationBackup: opts.MigrationBackup,
+		dryRunMigration: opts.DryRunMigration,
 	}
 	chanDB.graph = newChannelGraph(
 		chanDB, opts.RejectCacheSize, opts.ChannelCacheSize,
@@ -198,6 +234,16 @@ func (d *DB) Wipe() error {
 			return err
 		}
 
+		err = tx.DeleteBucket(closedChannelPeerIndexBucket)
+		if err != nil && err != bolt.ErrBucketNotFound {
+			return err
+		}
+
+		err = tx.DeleteBucket(historicalChannelBucket)
+		if err != nil && err != bolt.ErrBucketNotFound {
+			return err
+		}
+
 		err = tx.DeleteBucket(invoiceBucket)
 		if err != nil && err != bolt.ErrBucketNotFound {
 			return err
@@ -732,6 +778,170 @@ func (d *DB) FetchClosedChannels(pendingOnly bool) ([]*ChannelCloseSummary, erro
 	return chanSummaries, nil
 }
 
+// ChannelQuery restricts the channels returned by QueryOpenChannels and
+// QueryClosedChannels. Fields left at their zero value don't restrict the
+// result.
+type ChannelQuery struct {
+	// Peer, if set, restricts the result to the channels with this remote
+	// peer. The channels are then looked up through the peer's index,
+	// rather than by scanning all channels.
+	Peer *secp256k1.PublicKey
+
+	// MinCapacity, if non-zero, is the minimum capacity of the returned
+	// channels.
+	MinCapacity dcrutil.Amount
+
+	// MaxCapacity, if non-zero, is the maximum capacity of the returned
+	// channels.
+	MaxCapacity dcrutil.Amount
+}
+
+// matchesCapacity returns whether a channel of the given capacity matches the
+// query.
+func (q *ChannelQuery) matchesCapacity(capacity dcrutil.Amount) bool {
+	if q.MinCapacity != 0 && capacity < q.MinCapacity {
+		return false
+	}
+
+	return q.MaxCapacity == 0 || capacity <= q.MaxCapacity
+}
+
+// QueryOpenChannels returns the channels matching the query that have the
+// funding transaction confirmed, and are not waiting for a closing
+// transaction to be confirmed.
+func (d *DB) QueryOpenChannels(q ChannelQuery) ([]*OpenChannel, error) {
+	var (
+		channels []*OpenChannel
+		err      error
+	)
+	if q.Peer != nil {
+		channels, err = d.FetchOpenChannels(q.Peer)
+	} else {
+		channels, err = d.FetchAllOpenChannels()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]*OpenChannel, 0, len(channels))
+	for _, channel := range channels {
+		// The channels of a single peer include the pending channels
+		// and those waiting to be closed, so we'll skip them here.
+		if channel.IsPending ||
+			channel.ChanStatus() != ChanStatusDefault {
+
+			continue
+		}
+
+		if !q.matchesCapacity(channel.Capacity) {
+			continue
+		}
+
+		matches = append(matches, channel)
+	}
+
+	return matches, nil
+}
+
+// QueryClosedChannels returns the summaries of the closed channels matching
+// the query, including those that aren't yet fully closed.
+func (d *DB) QueryClosedChannels(q ChannelQuery) ([]*ChannelCloseSummary,
+	error) {
+
+	var chanSummaries []*ChannelCloseSummary
+
+	err := d.View(func(tx *bolt.Tx) error {
+		closeBucket := tx.Bucket(closedChannelBucket)
+		if closeBucket == nil {
+			return ErrNoClosedChannels
+		}
+
+		addSummary := func(summaryBytes []byte) error {
+			summaryReader := bytes.NewReader(summaryBytes)
+			chanSummary, err := deserializeCloseChannelSummary(
+				summaryReader,
+			)
+			if err != nil {
+				return err
+			}
+
+			if !q.matchesCapacity(chanSummary.Capacity) {
+				return nil
+			}
+
+			chanSummaries = append(chanSummaries, chanSummary)
+			return nil
+		}
+
+		if q.Peer == nil {
+			return closeBucket.ForEach(func(_, v []byte) error {
+				return addSummary(v)
+			})
+		}
+
+		// Only the closed channels of the peer are read, as found in
+		// the peer's index.
+		peerIndex := tx.Bucket(closedChannelPeerIndexBucket)
+		if peerIndex == nil {
+			return nil
+		}
+		peerBucket := peerIndex.Bucket(q.Peer.SerializeCompressed())
+		if peerBucket == nil {
+			return nil
+		}
+
+		return peerBucket.ForEach(func(chanID, _ []byte) error {
+			summaryBytes := closeBucket.Get(chanID)
+			if summaryBytes == nil {
+				return fmt.Errorf("no closed channel for "+
+					"indexed chan_id=%x found", chanID)
+			}
+
+			return addSummary(summaryBytes)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return chanSummaries, nil
+}
+
+// FetchHistoricalChannel fetches the final state of the closed channel with
+// the passed channel point. The channel state is only kept for channels closed
+// after the historical channel bucket was introduced, so ErrNoHistoricalBucket
+// or ErrChannelNotFound is returned for older ones.
+func (d *DB) FetchHistoricalChannel(outPoint *wire.OutPoint) (*OpenChannel,
+	error) {
+
+	var channel *OpenChannel
+	err := d.View(func(tx *bolt.Tx) error {
+		historicalBucket := tx.Bucket(historicalChannelBucket)
+		if historicalBucket == nil {
+			return ErrNoHistoricalBucket
+		}
+
+		var chanPointBuf bytes.Buffer
+		if err := writeOutpoint(&chanPointBuf, outPoint); err != nil {
+			return err
+		}
+		chanBucket := historicalBucket.Bucket(chanPointBuf.Bytes())
+		if chanBucket == nil {
+			return ErrChannelNotFound
+		}
+
+		var err error
+		channel, err = fetchOpenChannel(chanBucket, outPoint)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	channel.Db = d
+	return channel, nil
+}
+
 // ErrClosedChannelNotFound signals that a closed channel could not be found in
 // the channeldb.
 var ErrClosedChannelNotFound = errors.New("unable to find closed channel summary")
@@ -1100,6 +1310,14 @@ func (d *DB) AddrsForNode(nodePub *secp256k1.PublicKey) ([]net.Addr, error) {
 // syncVersions function is used for safe db version synchronization. It
 // applies migration functions to the current database and recovers the
 // previous state of db if at least one error/panic appeared during migration.
+//
+// Each migration is applied within its own transaction, which also bumps the
+// version of the database, so that a migration interrupted by a crash or a
+// failure resumes from the last migration fully applied on the next startup.
+// Unless disabled, the database is copied aside before the first migration.
+// In dry run mode, the migrations are applied within a single transaction
+// which is then rolled back, and ErrDryRunMigrationOK is returned if they
+// succeeded.
 func (d *DB) syncVersions(versions []version) error {
 	meta, err := d.FetchMeta(nil)
 	if err != nil {
@@ -1128,18 +1346,102 @@ func (d *DB) syncVersions(versions []version) error {
 	// If the current database version matches the latest version number,
 	// then we don't need to perform any migrations.
 	case meta.DbVersionNumber == latestVersion:
+		if d.dryRunMigration {
+			return ErrDryRunMigrationOK
+		}
 		return nil
 	}
 
-	log.Infof("Performing database schema migration")
-
-	// Otherwise, we fetch the migrations which need to applied, and
-	// execute them serially within a single database transaction to ensure
-	// the migration is atomic.
+	// Otherwise, we fetch the migrations which need to applied.
 	migrations, migrationVersions := getMigrationsToApply(
 		versions, meta.DbVersionNumber,
 	)
+
+	if d.dryRunMigration {
+		return d.dryRunMigrations(migrations, migrationVersions)
+	}
+
+	var target uint32
+	var resuming bool
+	err = d.View(func(tx *bolt.Tx) error {
+		target, resuming = fetchMigrationTarget(tx)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	switch {
+
+	// A previous migration was interrupted. The backup taken before it
+	// started is kept, as it holds the database as it was before the
+	// first migration.
+	case resuming:
+		log.Infof("Resuming interrupted database schema migration "+
+			"to db_version=%v from db_version=%v", target,
+			meta.DbVersionNumber)
+
+	case d.migrationBackup:
+		path, err := d.backup(meta.DbVersionNumber)
+		if err != nil {
+			return fmt.Errorf("unable to back up database before "+
+				"migration: %v", err)
+		}
+		log.Infof("Backed up database to %v", path)
+	}
+
+	log.Infof("Performing database schema migration")
+
+	err = d.Update(func(tx *bolt.Tx) error {
+		return putMigrationTarget(tx, latestVersion)
+	})
+	if err != nil {
+		return err
+	}
+
+	// Execute the migrations serially, each within its own database
+	// transaction to ensure every migration is atomic.
+	for i, migration := range migrations {
+		if migration == nil {
+			continue
+		}
+
+		log.Infof("Applying migration #%v", migrationVersions[i])
+
+		err := d.Update(func(tx *bolt.Tx) error {
+			if err := migration(tx); err != nil {
+				return err
+			}
+
+			meta.DbVersionNumber = migrationVersions[i]
+			return putMeta(meta, tx)
+		})
+		if err != nil {
+			log.Infof("Unable to apply migration #%v",
+				migrationVersions[i])
+			return err
+		}
+	}
+
 	return d.Update(func(tx *bolt.Tx) error {
+		meta.DbVersionNumber = latestVersion
+		if err := putMeta(meta, tx); err != nil {
+			return err
+		}
+
+		return deleteMigrationTarget(tx)
+	})
+}
+
+// dryRunMigrations applies the given migrations within a single transaction
+// which is rolled back, leaving the database untouched. ErrDryRunMigrationOK
+// is returned if all the migrations succeeded.
+func (d *DB) dryRunMigrations(migrations []migration,
+	migrationVersions []uint32) error {
+
+	log.Infof("Performing dry run of database schema migration")
+
+	err := d.Update(func(tx *bolt.Tx) error {
 		for i, migration := range migrations {
 			if migration == nil {
 				continue
@@ -1154,9 +1456,13 @@ func (d *DB) syncVersions(versions []version) error {
 			}
 		}
 
-		meta.DbVersionNumber = latestVersion
-		return putMeta(meta, tx)
+		return errDryRunRollback
 	})
+	if err != errDryRunRollback {
+		return err
+	}
+
+	return ErrDryRunMigrationOK
 }
 
 // ChannelGraph returns a new instance of the directed channel graph.
@@ -163,6 +163,122 @@ func TestFetchClosedChannelForID(t *testing.T) {
 	}
 }
 
+// TestQueryChannels asserts that open and closed channels can be queried by
+// remote peer and capacity.
+func TestQueryChannels(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+
+	_, otherPub := secp256k1.PrivKeyFromBytes([]byte{1, 2, 3})
+	peers := []*secp256k1.PublicKey{state.IdentityPub, otherPub}
+
+	// Open four channels of increasing capacity, alternating between the
+	// two peers.
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18556,
+	}
+	for i := uint32(0); i < 4; i++ {
+		state.FundingOutpoint.Index = i
+		state.IdentityPub = peers[i%2]
+		state.Capacity = dcrutil.Amount(1000 * (i + 1))
+
+		if err := state.SyncPending(addr, 101); err != nil {
+			t.Fatalf("unable to save channel state: %v", err)
+		}
+		err := state.MarkAsOpen(lnwire.NewShortChanIDFromInt(
+			uint64(i + 1),
+		))
+		if err != nil {
+			t.Fatalf("unable to mark channel open: %v", err)
+		}
+	}
+
+	assertOpen := func(q ChannelQuery, indexes ...uint32) {
+		t.Helper()
+
+		channels, err := cdb.QueryOpenChannels(q)
+		if err != nil {
+			t.Fatalf("unable to query channels: %v", err)
+		}
+		if len(channels) != len(indexes) {
+			t.Fatalf("expected %v channels, got %v", len(indexes),
+				len(channels))
+		}
+
+		found := make(map[uint32]bool)
+		for _, channel := range channels {
+			found[channel.FundingOutpoint.Index] = true
+		}
+		for _, index := range indexes {
+			if !found[index] {
+				t.Fatalf("channel %v not found", index)
+			}
+		}
+	}
+
+	assertOpen(ChannelQuery{}, 0, 1, 2, 3)
+	assertOpen(ChannelQuery{Peer: peers[0]}, 0, 2)
+	assertOpen(ChannelQuery{Peer: peers[1], MinCapacity: 3000}, 3)
+	assertOpen(ChannelQuery{MinCapacity: 2000, MaxCapacity: 3000}, 1, 2)
+
+	// Close all channels of the first peer.
+	channels, err := cdb.QueryOpenChannels(ChannelQuery{Peer: peers[0]})
+	if err != nil {
+		t.Fatalf("unable to query channels: %v", err)
+	}
+	for _, channel := range channels {
+		err := channel.CloseChannel(&ChannelCloseSummary{
+			ChanPoint: channel.FundingOutpoint,
+			RemotePub: channel.IdentityPub,
+			Capacity:  channel.Capacity,
+		})
+		if err != nil {
+			t.Fatalf("unable to close channel: %v", err)
+		}
+	}
+
+	assertOpen(ChannelQuery{Peer: peers[0]})
+
+	assertClosed := func(q ChannelQuery, indexes ...uint32) {
+		t.Helper()
+
+		summaries, err := cdb.QueryClosedChannels(q)
+		if err != nil {
+			t.Fatalf("unable to query channels: %v", err)
+		}
+		if len(summaries) != len(indexes) {
+			t.Fatalf("expected %v channels, got %v", len(indexes),
+				len(summaries))
+		}
+
+		found := make(map[uint32]bool)
+		for _, summary := range summaries {
+			found[summary.ChanPoint.Index] = true
+		}
+		for _, index := range indexes {
+			if !found[index] {
+				t.Fatalf("channel %v not found", index)
+			}
+		}
+	}
+
+	assertClosed(ChannelQuery{}, 0, 2)
+	assertClosed(ChannelQuery{Peer: peers[0]}, 0, 2)
+	assertClosed(ChannelQuery{Peer: peers[0], MaxCapacity: 1000}, 0)
+	assertClosed(ChannelQuery{Peer: peers[1]})
+}
+
 // TestAddrsForNode tests the we're able to properly obtain all the addresses
 // for a target node.
 func TestAddrsForNode(t *testing.T) {
@@ -0,0 +1,433 @@
+package channeldb
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/decred/dcrlnd/internal/snacl"
+	"github.com/decred/dcrlnd/internal/zero"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	// encryptedDBName is the name of the encrypted form of the channel
+	// database, which replaces the database while the node isn't running.
+	// It's kept while the node runs, until encrypting the database again
+	// replaces it.
+	encryptedDBName = "channel.db.enc"
+
+	// encryptedChunkSize is the maximum size of the plaintext of each of
+	// the chunks the encrypted database is made of, so that the database
+	// never needs to fit in memory.
+	encryptedChunkSize = 1 << 20
+
+	// encryptedChunkHeaderSize is the size of the index and final flag
+	// prepended to the plaintext of each chunk.
+	encryptedChunkHeaderSize = 9
+
+	// encryptedDBOpenTimeout is how long encrypting the database waits
+	// for the lock of the database, held while the node is running.
+	encryptedDBOpenTimeout = time.Second
+)
+
+var (
+	// encryptedDBMagic identifies the encrypted form of the database.
+	encryptedDBMagic = [8]byte{'d', 'c', 'r', 'l', 'n', 'd', 'e', '1'}
+
+	// encryptedDBHeaderSize is the size of the header of the encrypted
+	// database: the magic, the parameters deriving the password key and
+	// the data key encrypted with the password key.
+	encryptedDBHeaderSize = len(encryptedDBMagic) +
+		len((&snacl.SecretKey{}).Marshal()) + snacl.NonceSize +
+		snacl.KeySize + snacl.Overhead
+)
+
+// IsDBEncrypted returns true if the channel database in dbPath is only present
+// in encrypted form, thus must be decrypted before being opened.
+func IsDBEncrypted(dbPath string) bool {
+	return !fileExists(filepath.Join(dbPath, dbName)) &&
+		fileExists(filepath.Join(dbPath, encryptedDBName))
+}
+
+// IsDBLeftDecrypted returns true if the channel database in dbPath is present
+// in both plaintext and encrypted forms while it isn't open. This happens if
+// the node crashed, or failed to encrypt the database on shutdown, since the
+// database was decrypted: the plaintext database then stays on disk until it's
+// encrypted again. The plaintext database holds the latest state, thus must
+// not be discarded.
+func IsDBLeftDecrypted(dbPath string) bool {
+	return fileExists(filepath.Join(dbPath, dbName)) &&
+		fileExists(filepath.Join(dbPath, encryptedDBName))
+}
+
+// EncryptDB encrypts the channel database in dbPath with a random key, itself
+// encrypted with a key derived from the password, then replaces the database
+// with its encrypted form. The database must not be in use.
+//
+// The database is encrypted as a whole, as bolt requires the plaintext
+// database to be present on disk while it is open: it's meant to be encrypted
+// once the node is shut down, and decrypted with DecryptDB at startup.
+func EncryptDB(dbPath string, password []byte) error {
+	path := filepath.Join(dbPath, dbName)
+	if !fileExists(path) {
+		return ErrNoChanDBExists
+	}
+
+	// Opening the database read only takes its lock, ensuring that it
+	// isn't in use and that we encrypt a consistent snapshot of it.
+	bdb, err := bolt.Open(path, dbFilePermission, &bolt.Options{
+		ReadOnly: true,
+		Timeout:  encryptedDBOpenTimeout,
+	})
+	if err != nil {
+		return err
+	}
+
+	dataKey, err := snacl.GenerateCryptoKey()
+	if err != nil {
+		bdb.Close()
+		return err
+	}
+	defer dataKey.Zero()
+
+	header, err := newEncryptedDBHeader(dataKey, password)
+	if err != nil {
+		bdb.Close()
+		return err
+	}
+
+	encPath := filepath.Join(dbPath, encryptedDBName)
+	err = writeFileAtomic(encPath, func(w io.Writer) error {
+		if _, err := w.Write(header); err != nil {
+			return err
+		}
+
+		cw := &chunkWriter{w: w, key: dataKey}
+		err := bdb.View(func(tx *bolt.Tx) error {
+			_, err := tx.WriteTo(cw)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+
+		return cw.Close()
+	})
+	closeErr := bdb.Close()
+	if err != nil {
+		return err
+	}
+	if closeErr != nil {
+		return closeErr
+	}
+
+	return os.Remove(path)
+}
+
+// DecryptDB decrypts the encrypted form of the channel database in dbPath with
+// the password it was encrypted with. The encrypted form is kept until
+// EncryptDB replaces it, so that a failure to encrypt the database again never
+// leaves only the plaintext database behind. Nothing is done if the database
+// isn't encrypted. If both forms are present, which happens if the node
+// stopped without encrypting the database, the database is more recent and is
+// kept as is.
+//
+// NOTE: The plaintext database stays on disk until EncryptDB is called, thus
+// remains there after a crash. IsDBLeftDecrypted reports it.
+func DecryptDB(dbPath string, password []byte) error {
+	if !IsDBEncrypted(dbPath) {
+		return nil
+	}
+
+	encPath := filepath.Join(dbPath, encryptedDBName)
+	f, err := os.Open(encPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	dataKey, err := readEncryptedDBHeader(r, password)
+	if err != nil {
+		return err
+	}
+	defer dataKey.Zero()
+
+	path := filepath.Join(dbPath, dbName)
+	return writeFileAtomic(path, func(w io.Writer) error {
+		return readChunks(r, w, dataKey)
+	})
+}
+
+// RemoveEncryptedDB removes the encrypted form of the channel database in
+// dbPath, once it's no longer meant to be encrypted. The encrypted form is
+// only removed if the plaintext database is present, so the database is never
+// lost.
+func RemoveEncryptedDB(dbPath string) error {
+	if !fileExists(filepath.Join(dbPath, dbName)) {
+		return ErrNoChanDBExists
+	}
+
+	err := os.Remove(filepath.Join(dbPath, encryptedDBName))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+// ChangeDBPassword re-encrypts the key of the encrypted form of the channel
+// database in dbPath, if any, with a key derived from the new password. The
+// database itself is left untouched, so this doesn't require decrypting it.
+func ChangeDBPassword(dbPath string, oldPassword, newPassword []byte) error {
+	encPath := filepath.Join(dbPath, encryptedDBName)
+	if !fileExists(encPath) {
+		return nil
+	}
+
+	f, err := os.Open(encPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	dataKey, err := readEncryptedDBHeader(r, oldPassword)
+	if err != nil {
+		return err
+	}
+	defer dataKey.Zero()
+
+	header, err := newEncryptedDBHeader(dataKey, newPassword)
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(encPath, func(w io.Writer) error {
+		if _, err := w.Write(header); err != nil {
+			return err
+		}
+
+		_, err := io.Copy(w, r)
+		return err
+	})
+}
+
+// newEncryptedDBHeader returns the header of an encrypted database whose data
+// key is encrypted with a key derived from the password.
+func newEncryptedDBHeader(dataKey *snacl.CryptoKey,
+	password []byte) ([]byte, error) {
+
+	pwKey, err := snacl.NewSecretKey(
+		&password, snacl.DefaultN, snacl.DefaultR, snacl.DefaultP,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer pwKey.Zero()
+
+	encDataKey, err := pwKey.Encrypt(dataKey[:])
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, 0, encryptedDBHeaderSize)
+	header = append(header, encryptedDBMagic[:]...)
+	header = append(header, pwKey.Marshal()...)
+	header = append(header, encDataKey...)
+
+	return header, nil
+}
+
+// readEncryptedDBHeader reads the header of an encrypted database and returns
+// its data key, decrypted with a key derived from the password.
+func readEncryptedDBHeader(r io.Reader,
+	password []byte) (*snacl.CryptoKey, error) {
+
+	header := make([]byte, encryptedDBHeaderSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, ErrEncryptedDBCorrupted
+	}
+
+	if !bytes.Equal(header[:len(encryptedDBMagic)], encryptedDBMagic[:]) {
+		return nil, ErrEncryptedDBCorrupted
+	}
+	header = header[len(encryptedDBMagic):]
+
+	var pwKey snacl.SecretKey
+	paramsSize := len(pwKey.Marshal())
+	if err := pwKey.Unmarshal(header[:paramsSize]); err != nil {
+		return nil, ErrEncryptedDBCorrupted
+	}
+	header = header[paramsSize:]
+
+	err := pwKey.DeriveKey(&password)
+	if err == snacl.ErrInvalidPassword {
+		return nil, ErrEncryptedDBPassword
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer pwKey.Zero()
+
+	plainDataKey, err := pwKey.Decrypt(header)
+	if err != nil || len(plainDataKey) != snacl.KeySize {
+		return nil, ErrEncryptedDBCorrupted
+	}
+
+	var dataKey snacl.CryptoKey
+	copy(dataKey[:], plainDataKey)
+	zero.Bytes(plainDataKey)
+
+	return &dataKey, nil
+}
+
+// chunkWriter encrypts the data written to it in chunks of at most
+// encryptedChunkSize bytes. Each chunk is written as its length followed by
+// its ciphertext, whose plaintext starts with the index of the chunk and a
+// flag set on the final chunk, so that reordered, dropped or truncated chunks
+// are detected on decryption.
+type chunkWriter struct {
+	w     io.Writer
+	key   *snacl.CryptoKey
+	buf   []byte
+	index uint64
+}
+
+// Write buffers the data, encrypting and writing every complete chunk.
+func (c *chunkWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		free := encryptedChunkSize - len(c.buf)
+		if free > len(p) {
+			free = len(p)
+		}
+		c.buf = append(c.buf, p[:free]...)
+		p = p[free:]
+
+		if len(c.buf) == encryptedChunkSize {
+			if err := c.writeChunk(false); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return n, nil
+}
+
+// Close writes the final chunk, holding the remaining buffered data.
+func (c *chunkWriter) Close() error {
+	return c.writeChunk(true)
+}
+
+// writeChunk encrypts and writes the buffered data as the next chunk.
+func (c *chunkWriter) writeChunk(final bool) error {
+	plaintext := make(
+		[]byte, encryptedChunkHeaderSize,
+		encryptedChunkHeaderSize+len(c.buf),
+	)
+	binary.BigEndian.PutUint64(plaintext[:8], c.index)
+	if final {
+		plaintext[8] = 1
+	}
+	plaintext = append(plaintext, c.buf...)
+
+	ciphertext, err := c.key.Encrypt(plaintext)
+	if err != nil {
+		return err
+	}
+
+	var length [4]byte
+	binary.BigEndian.PutUint32(length[:], uint32(len(ciphertext)))
+	if _, err := c.w.Write(length[:]); err != nil {
+		return err
+	}
+	if _, err := c.w.Write(ciphertext); err != nil {
+		return err
+	}
+
+	c.buf = c.buf[:0]
+	c.index++
+
+	return nil
+}
+
+// readChunks decrypts the chunks written by a chunkWriter, writing their data
+// to w, and ensures they are complete and in order.
+func readChunks(r io.Reader, w io.Writer, key *snacl.CryptoKey) error {
+	maxLength := snacl.NonceSize + snacl.Overhead +
+		encryptedChunkHeaderSize + encryptedChunkSize
+
+	for index := uint64(0); ; index++ {
+		var length [4]byte
+		if _, err := io.ReadFull(r, length[:]); err != nil {
+			return ErrEncryptedDBCorrupted
+		}
+
+		chunkLength := int(binary.BigEndian.Uint32(length[:]))
+		if chunkLength > maxLength {
+			return ErrEncryptedDBCorrupted
+		}
+
+		ciphertext := make([]byte, chunkLength)
+		if _, err := io.ReadFull(r, ciphertext); err != nil {
+			return ErrEncryptedDBCorrupted
+		}
+
+		plaintext, err := key.Decrypt(ciphertext)
+		if err != nil || len(plaintext) < encryptedChunkHeaderSize ||
+			binary.BigEndian.Uint64(plaintext[:8]) != index {
+
+			return ErrEncryptedDBCorrupted
+		}
+
+		_, err = w.Write(plaintext[encryptedChunkHeaderSize:])
+		if err != nil {
+			return err
+		}
+
+		// Nothing may follow the final chunk.
+		if plaintext[8] == 1 {
+			var b [1]byte
+			if _, err := r.Read(b[:]); err != io.EOF {
+				return ErrEncryptedDBCorrupted
+			}
+			return nil
+		}
+	}
+}
+
+// writeFileAtomic writes the file at path with the data written by write to a
+// temporary file, which is synced then renamed to path once complete.
+func writeFileAtomic(path string, write func(w io.Writer) error) error {
+	tmpPath := path + ".tmp"
+	f, err := os.OpenFile(
+		tmpPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, dbFilePermission,
+	)
+	if err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(f)
+	err = write(bw)
+	if err == nil {
+		err = bw.Flush()
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+
+	return os.Rename(tmpPath, path)
+}
@@ -0,0 +1,299 @@
+package channeldb
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	testEncBucket = []byte("test-enc-bucket")
+	testEncKey    = []byte("test-enc-key")
+)
+
+// TestEncryptDB asserts that the channel database can be encrypted, that it
+// can't be opened until decrypted with the right password, and that its
+// content is preserved across encryption and password changes.
+func TestEncryptDB(t *testing.T) {
+	t.Parallel()
+
+	dbPath, err := ioutil.TempDir("", "channeldb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dbPath)
+
+	// Store a value spanning several chunks of the encrypted database.
+	value := make([]byte, 2*encryptedChunkSize+1000)
+	if _, err := rand.Read(value); err != nil {
+		t.Fatal(err)
+	}
+
+	cdb, err := Open(dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = cdb.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucket(testEncBucket)
+		if err != nil {
+			return err
+		}
+		return bucket.Put(testEncKey, value)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The database can't be encrypted while it's open.
+	if err := EncryptDB(dbPath, []byte("password")); err == nil {
+		t.Fatal("expected database in use to not be encrypted")
+	}
+	cdb.Close()
+
+	if err := EncryptDB(dbPath, []byte("password")); err != nil {
+		t.Fatal(err)
+	}
+	if !IsDBEncrypted(dbPath) {
+		t.Fatal("expected database to be encrypted")
+	}
+	if _, err := Open(dbPath); err != ErrDBEncrypted {
+		t.Fatalf("expected ErrDBEncrypted, got %v", err)
+	}
+
+	err = DecryptDB(dbPath, []byte("wrong password"))
+	if err != ErrEncryptedDBPassword {
+		t.Fatalf("expected ErrEncryptedDBPassword, got %v", err)
+	}
+
+	err = ChangeDBPassword(
+		dbPath, []byte("password"), []byte("new password"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = DecryptDB(dbPath, []byte("password"))
+	if err != ErrEncryptedDBPassword {
+		t.Fatalf("expected ErrEncryptedDBPassword, got %v", err)
+	}
+	if err := DecryptDB(dbPath, []byte("new password")); err != nil {
+		t.Fatal(err)
+	}
+	if IsDBEncrypted(dbPath) {
+		t.Fatal("expected database to be decrypted")
+	}
+
+	cdb, err = Open(dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cdb.Close()
+
+	err = cdb.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(testEncBucket)
+		if bucket == nil {
+			t.Fatal("bucket not found")
+		}
+		if !bytes.Equal(bucket.Get(testEncKey), value) {
+			t.Fatal("value mismatch")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestDecryptDBCorrupted asserts that tampering with the encrypted database,
+// either by altering or truncating it, is detected on decryption.
+func TestDecryptDBCorrupted(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		corrupt func(enc []byte) []byte
+	}{
+		{
+			name: "altered",
+			corrupt: func(enc []byte) []byte {
+				enc[len(enc)-1] ^= 1
+				return enc
+			},
+		},
+		{
+			name: "truncated",
+			corrupt: func(enc []byte) []byte {
+				return enc[:len(enc)-1]
+			},
+		},
+		{
+			name: "appended",
+			corrupt: func(enc []byte) []byte {
+				return append(enc, 0)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			dbPath, err := ioutil.TempDir("", "channeldb")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dbPath)
+
+			cdb, err := Open(dbPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cdb.Close()
+
+			password := []byte("password")
+			if err := EncryptDB(dbPath, password); err != nil {
+				t.Fatal(err)
+			}
+
+			encPath := filepath.Join(dbPath, encryptedDBName)
+			enc, err := ioutil.ReadFile(encPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = ioutil.WriteFile(
+				encPath, test.corrupt(enc), dbFilePermission,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = DecryptDB(dbPath, password)
+			if err != ErrEncryptedDBCorrupted {
+				t.Fatalf("expected ErrEncryptedDBCorrupted, "+
+					"got %v", err)
+			}
+			if !IsDBEncrypted(dbPath) {
+				t.Fatal("expected database to remain encrypted")
+			}
+		})
+	}
+}
+
+// TestDecryptDBCrash asserts that the encrypted form of the database is kept
+// while the database is decrypted, and that a database left decrypted by a
+// crash is reported and keeps its latest state across the restart until it's
+// encrypted again.
+func TestDecryptDBCrash(t *testing.T) {
+	t.Parallel()
+
+	dbPath, err := ioutil.TempDir("", "channeldb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dbPath)
+
+	// putValue stores the value in the decrypted database.
+	putValue := func(value []byte) {
+		t.Helper()
+
+		cdb, err := Open(dbPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer cdb.Close()
+
+		err = cdb.Update(func(tx *bolt.Tx) error {
+			bucket, err := tx.CreateBucketIfNotExists(testEncBucket)
+			if err != nil {
+				return err
+			}
+			return bucket.Put(testEncKey, value)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// assertValue asserts that the decrypted database holds the value.
+	assertValue := func(value []byte) {
+		t.Helper()
+
+		cdb, err := Open(dbPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer cdb.Close()
+
+		err = cdb.View(func(tx *bolt.Tx) error {
+			bucket := tx.Bucket(testEncBucket)
+			if bucket == nil {
+				t.Fatal("bucket not found")
+			}
+			if !bytes.Equal(bucket.Get(testEncKey), value) {
+				t.Fatal("value mismatch")
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	password := []byte("password")
+	putValue([]byte("old"))
+	if err := EncryptDB(dbPath, password); err != nil {
+		t.Fatal(err)
+	}
+	if IsDBLeftDecrypted(dbPath) {
+		t.Fatal("expected database to only be encrypted")
+	}
+
+	// Once decrypted at startup, the encrypted form is kept while the
+	// node runs and updates the database.
+	if err := DecryptDB(dbPath, password); err != nil {
+		t.Fatal(err)
+	}
+	if !fileExists(filepath.Join(dbPath, encryptedDBName)) {
+		t.Fatal("expected encrypted database to be kept")
+	}
+	putValue([]byte("new"))
+
+	// The node then crashes without encrypting the database, which is
+	// reported on restart.
+	if !IsDBLeftDecrypted(dbPath) {
+		t.Fatal("expected database to be reported as left decrypted")
+	}
+
+	// Decrypting the database on restart must not replace the latest
+	// state with the one of the encrypted form.
+	if err := DecryptDB(dbPath, password); err != nil {
+		t.Fatal(err)
+	}
+	assertValue([]byte("new"))
+
+	// A clean shutdown encrypts the latest state, only leaving its
+	// encrypted form behind.
+	if err := EncryptDB(dbPath, password); err != nil {
+		t.Fatal(err)
+	}
+	if !IsDBEncrypted(dbPath) || IsDBLeftDecrypted(dbPath) {
+		t.Fatal("expected database to only be encrypted")
+	}
+	if err := DecryptDB(dbPath, password); err != nil {
+		t.Fatal(err)
+	}
+	assertValue([]byte("new"))
+
+	// Decrypting the database for good removes its encrypted form.
+	if err := RemoveEncryptedDB(dbPath); err != nil {
+		t.Fatal(err)
+	}
+	if IsDBLeftDecrypted(dbPath) || IsDBEncrypted(dbPath) {
+		t.Fatal("expected database to only be decrypted")
+	}
+	assertValue([]byte("new"))
+}
@@ -10,10 +10,30 @@ var (
 	// created.
 	ErrNoChanDBExists = fmt.Errorf("channel db has not yet been created")
 
+	// ErrDBEncrypted is returned when opening a channel database that is
+	// only present in encrypted form, which must be decrypted first.
+	ErrDBEncrypted = fmt.Errorf("channel db is encrypted")
+
+	// ErrEncryptedDBPassword is returned when decrypting the encrypted
+	// form of the channel database with the wrong password.
+	ErrEncryptedDBPassword = fmt.Errorf("invalid password for encrypted " +
+		"channel db")
+
+	// ErrEncryptedDBCorrupted is returned when the encrypted form of the
+	// channel database is malformed or was tampered with.
+	ErrEncryptedDBCorrupted = fmt.Errorf("encrypted channel db is " +
+		"corrupted")
+
 	// ErrDBReversion is returned when detecting an attempt to revert to a
 	// prior database version.
 	ErrDBReversion = fmt.Errorf("channel db cannot revert to prior version")
 
+	// ErrDryRunMigrationOK is returned when opening a channel database in
+	// dry run mode once its pending migrations were successfully applied,
+	// then rolled back.
+	ErrDryRunMigrationOK = fmt.Errorf("channel db dry run migration " +
+		"successful")
+
 	// ErrLinkNodesNotFound is returned when node info bucket hasn't been
 	// created.
 	ErrLinkNodesNotFound = fmt.Errorf("no link nodes exist")
@@ -38,6 +58,11 @@ var (
 	// payment hash already exists.
 	ErrDuplicateInvoice = fmt.Errorf("invoice with payment hash already exists")
 
+	// ErrDuplicatePayAddr is returned when an invoice with the target
+	// payment addr already exists.
+	ErrDuplicatePayAddr = fmt.Errorf("invoice with payment addr already " +
+		"exists")
+
 	// ErrNoPaymentsCreated is returned when bucket of payments hasn't been
 	// created.
 	ErrNoPaymentsCreated = fmt.Errorf("there are no existing payments")
@@ -50,6 +75,12 @@ var (
 	// for a specific chain, but it is not found.
 	ErrChannelNotFound = fmt.Errorf("channel not found")
 
+	// ErrNoHistoricalBucket is returned when the historical channel bucket
+	// has not been created yet, as no channel has been closed since it was
+	// introduced.
+	ErrNoHistoricalBucket = fmt.Errorf("historical channel bucket not " +
+		"yet created")
+
 	// ErrMetaNotFound is returned when meta bucket hasn't been
 	// created.
 	ErrMetaNotFound = fmt.Errorf("unable to locate meta information")
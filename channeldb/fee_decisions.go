@@ -0,0 +1,143 @@
+package channeldb
+
+import (
+	"bytes"
+	"io"
+	"time"
+
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/lnwire"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	// feeDecisionBucket is the name of the bucket that stores the fee
+	// rate adjustments decided by the fee manager. The bucket is keyed by
+	// an increasing sequence number, so that the decisions are stored in
+	// the order they were taken.
+	feeDecisionBucket = []byte("fee-decisions")
+)
+
+// FeeDecision records an adjustment of the fee rate of one of our channels
+// decided by the fee manager, along with the data it was based on.
+type FeeDecision struct {
+	// Timestamp is the time the decision was taken.
+	Timestamp time.Time
+
+	// ChanPoint is the outpoint of the channel whose fee rate was
+	// adjusted.
+	ChanPoint wire.OutPoint
+
+	// OldFeeRate is the fee rate, in millionths, before the adjustment.
+	OldFeeRate uint32
+
+	// NewFeeRate is the fee rate, in millionths, after the adjustment.
+	NewFeeRate uint32
+
+	// Capacity is the capacity of the channel.
+	Capacity dcrutil.Amount
+
+	// LocalBalance is our balance in the channel at the time of the
+	// decision.
+	LocalBalance dcrutil.Amount
+
+	// Forwarded is the amount forwarded over the channel since the
+	// previous round of adjustments.
+	Forwarded lnwire.MilliAtom
+}
+
+// AddFeeDecisions stores the given fee decisions after the ones already
+// stored.
+func (d *DB) AddFeeDecisions(decisions []*FeeDecision) error {
+	return d.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(feeDecisionBucket)
+		if err != nil {
+			return err
+		}
+
+		for _, decision := range decisions {
+			seq, err := bucket.NextSequence()
+			if err != nil {
+				return err
+			}
+
+			var b bytes.Buffer
+			err = serializeFeeDecision(&b, decision)
+			if err != nil {
+				return err
+			}
+
+			var key [8]byte
+			byteOrder.PutUint64(key[:], seq)
+			if err := bucket.Put(key[:], b.Bytes()); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// FetchFeeDecisions returns up to maxDecisions of the most recent fee
+// decisions, most recent first. All the decisions are returned if
+// maxDecisions is zero.
+func (d *DB) FetchFeeDecisions(maxDecisions uint32) ([]*FeeDecision, error) {
+	var decisions []*FeeDecision
+	err := d.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(feeDecisionBucket)
+		if bucket == nil {
+			return nil
+		}
+
+		cursor := bucket.Cursor()
+		for k, v := cursor.Last(); k != nil; k, v = cursor.Prev() {
+			if maxDecisions != 0 &&
+				uint32(len(decisions)) >= maxDecisions {
+
+				return nil
+			}
+
+			decision, err := deserializeFeeDecision(
+				bytes.NewReader(v),
+			)
+			if err != nil {
+				return err
+			}
+			decisions = append(decisions, decision)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return decisions, nil
+}
+
+func serializeFeeDecision(w io.Writer, decision *FeeDecision) error {
+	return WriteElements(w,
+		uint64(decision.Timestamp.UnixNano()), decision.ChanPoint,
+		decision.OldFeeRate, decision.NewFeeRate, decision.Capacity,
+		decision.LocalBalance, decision.Forwarded,
+	)
+}
+
+func deserializeFeeDecision(r io.Reader) (*FeeDecision, error) {
+	var (
+		decision  FeeDecision
+		timestamp uint64
+	)
+	err := ReadElements(r,
+		&timestamp, &decision.ChanPoint, &decision.OldFeeRate,
+		&decision.NewFeeRate, &decision.Capacity,
+		&decision.LocalBalance, &decision.Forwarded,
+	)
+	if err != nil {
+		return nil, err
+	}
+	decision.Timestamp = time.Unix(0, int64(timestamp))
+
+	return &decision, nil
+}
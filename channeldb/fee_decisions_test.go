@@ -0,0 +1,74 @@
+package channeldb
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/wire"
+)
+
+// TestFeeDecisions tests that fee decisions are stored in order and fetched
+// back most recent first.
+func TestFeeDecisions(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	// Nothing should be returned before any decision has been stored.
+	decisions, err := cdb.FetchFeeDecisions(0)
+	if err != nil {
+		t.Fatalf("unable to fetch decisions: %v", err)
+	}
+	if len(decisions) != 0 {
+		t.Fatalf("expected no decisions, got %v", len(decisions))
+	}
+
+	var stored []*FeeDecision
+	for i := 0; i < 3; i++ {
+		stored = append(stored, &FeeDecision{
+			Timestamp: time.Unix(int64(1000+i), 0),
+			ChanPoint: wire.OutPoint{
+				Hash:  chainhash.Hash{byte(i)},
+				Index: uint32(i),
+			},
+			OldFeeRate:   uint32(100 * i),
+			NewFeeRate:   uint32(100*i + 10),
+			Capacity:     100000,
+			LocalBalance: 40000,
+			Forwarded:    5000000,
+		})
+	}
+	if err := cdb.AddFeeDecisions(stored[:2]); err != nil {
+		t.Fatalf("unable to add decisions: %v", err)
+	}
+	if err := cdb.AddFeeDecisions(stored[2:]); err != nil {
+		t.Fatalf("unable to add decisions: %v", err)
+	}
+
+	decisions, err = cdb.FetchFeeDecisions(0)
+	if err != nil {
+		t.Fatalf("unable to fetch decisions: %v", err)
+	}
+	expected := []*FeeDecision{stored[2], stored[1], stored[0]}
+	if !reflect.DeepEqual(decisions, expected) {
+		t.Fatalf("expected decisions %v, got %v", spew.Sdump(expected),
+			spew.Sdump(decisions))
+	}
+
+	// Only the most recent decisions should be returned when limited.
+	decisions, err = cdb.FetchFeeDecisions(2)
+	if err != nil {
+		t.Fatalf("unable to fetch decisions: %v", err)
+	}
+	if !reflect.DeepEqual(decisions, expected[:2]) {
+		t.Fatalf("expected decisions %v, got %v",
+			spew.Sdump(expected[:2]), spew.Sdump(decisions))
+	}
+}
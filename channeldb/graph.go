@@ -9,6 +9,7 @@ import (
 	"io"
 	"math"
 	"net"
+	"sort"
 	"sync"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/decred/dcrd/txscript/v2"
 	"github.com/decred/dcrd/wire"
 	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/tlv"
 	bolt "go.etcd.io/bbolt"
 )
 
@@ -843,6 +845,11 @@ const (
 // prune the graph is stored so callers can ensure the graph is fully in sync
 // with the current UTXO state. A slice of channels that have been closed by
 // the target block are returned if the function succeeds without error.
+//
+// The spent outputs are looked up in a single ordered pass over the channel
+// point index, and only the nodes of the closed channels are considered for
+// pruning, so that the cost of processing a block doesn't grow with the size
+// of the graph.
 func (c *ChannelGraph) PruneGraph(spentOutputs []*wire.OutPoint,
 	blockHash *chainhash.Hash, blockHeight uint32) ([]*ChannelEdgeInfo, error) {
 
@@ -877,37 +884,25 @@ func (c *ChannelGraph) PruneGraph(spentOutputs []*wire.OutPoint,
 			return err
 		}
 
-		// For each of the outpoints that have been spent within the
-		// block, we attempt to delete them from the graph as if that
-		// outpoint was a channel, then it has now been closed.
-		for _, chanPoint := range spentOutputs {
-			// TODO(roasbeef): load channel bloom filter, continue
-			// if NOT if filter
-
-			var opBytes bytes.Buffer
-			if err := writeOutpoint(&opBytes, chanPoint); err != nil {
-				return err
-			}
-
-			// First attempt to see if the channel exists within
-			// the database, if not, then we can exit early.
-			chanID := chanIndex.Get(opBytes.Bytes())
-			if chanID == nil {
-				continue
-			}
+		// Look up all the outpoints that have been spent within the
+		// block in the channel point index at once. Those found are
+		// the funding outputs of channels that have now been closed.
+		closedChanIDs, err := spentChanIDs(chanIndex, spentOutputs)
+		if err != nil {
+			return err
+		}
 
-			// However, if it does, then we'll read out the full
-			// version so we can add it to the set of deleted
-			// channels.
+		// Delete all the closed channels, keeping track of the nodes
+		// they connected, as they may no longer have any channel left.
+		pruneCandidates := make(map[[33]byte]struct{})
+		for _, chanID := range closedChanIDs {
+			// Read out the full version of the channel so we can
+			// add it to the set of deleted channels.
 			edgeInfo, err := fetchChanEdgeInfo(edgeIndex, chanID)
 			if err != nil {
 				return err
 			}
 
-			// Attempt to delete the channel, an ErrEdgeNotFound
-			// will be returned if that outpoint isn't known to be
-			// a channel. If no error is returned, then a channel
-			// was successfully pruned.
 			err = delChannelEdge(
 				edges, edgeIndex, chanIndex, zombieIndex, nodes,
 				chanID, false,
@@ -916,6 +911,8 @@ func (c *ChannelGraph) PruneGraph(spentOutputs []*wire.OutPoint,
 				return err
 			}
 
+			pruneCandidates[edgeInfo.NodeKey1Bytes] = struct{}{}
+			pruneCandidates[edgeInfo.NodeKey2Bytes] = struct{}{}
 			chansClosed = append(chansClosed, &edgeInfo)
 		}
 
@@ -946,7 +943,7 @@ func (c *ChannelGraph) PruneGraph(spentOutputs []*wire.OutPoint,
 		// Now that the graph has been pruned, we'll also attempt to
 		// prune any nodes that have had a channel closed within the
 		// latest block.
-		return c.pruneGraphNodes(nodes, edgeIndex)
+		return c.pruneCandidateNodes(nodes, edges, pruneCandidates)
 	})
 	if err != nil {
 		return nil, err
@@ -960,6 +957,127 @@ func (c *ChannelGraph) PruneGraph(spentOutputs []*wire.OutPoint,
 	return chansClosed, nil
 }
 
+// spentChanIDs returns the IDs of the channels funded by the passed outpoints.
+// The outpoints are serialized and sorted, so that they're all looked up in a
+// single ordered pass over the channel point index rather than through
+// independent lookups from its root.
+func spentChanIDs(chanIndex *bolt.Bucket,
+	spentOutputs []*wire.OutPoint) ([][]byte, error) {
+
+	keys := make([][]byte, 0, len(spentOutputs))
+	for _, op := range spentOutputs {
+		var opBytes bytes.Buffer
+		if err := writeOutpoint(&opBytes, op); err != nil {
+			return nil, err
+		}
+		keys = append(keys, opBytes.Bytes())
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i], keys[j]) < 0
+	})
+
+	var (
+		chanIDs [][]byte
+		cursor  = chanIndex.Cursor()
+		k, v    = cursor.First()
+	)
+	for i, key := range keys {
+		// Skip duplicate outpoints, so that each closed channel is
+		// only returned once.
+		if i > 0 && bytes.Equal(key, keys[i-1]) {
+			continue
+		}
+
+		// Only seek when the cursor is behind the outpoint, as it's
+		// otherwise already positioned on or past it.
+		if k != nil && bytes.Compare(k, key) < 0 {
+			k, v = cursor.Seek(key)
+		}
+		if k == nil {
+			break
+		}
+		if !bytes.Equal(k, key) {
+			continue
+		}
+
+		chanID := make([]byte, len(v))
+		copy(chanID, v)
+		chanIDs = append(chanIDs, chanID)
+	}
+
+	return chanIDs, nil
+}
+
+// pruneCandidateNodes deletes the passed nodes that no longer have any channel
+// within the graph, except for the source node. Rather than counting the
+// channels of every node in the graph like pruneGraphNodes, the channels of
+// each candidate are looked up through the edge policy keys of the edges
+// bucket, which are prefixed by the public key of the node they belong to.
+func (c *ChannelGraph) pruneCandidateNodes(nodes, edges *bolt.Bucket,
+	candidates map[[33]byte]struct{}) error {
+
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	sourceNode, err := c.sourceNode(nodes)
+	if err != nil {
+		return err
+	}
+
+	var numNodesPruned int
+	for nodePubKey := range candidates {
+		if nodePubKey == sourceNode.PubKeyBytes {
+			continue
+		}
+		if nodeHasChannels(edges, nodePubKey[:]) {
+			continue
+		}
+
+		// If we reach this point, then there are no longer any edges
+		// that connect this node, so we can delete it.
+		if err := c.deleteLightningNode(nodes, nodePubKey[:]); err != nil {
+			// The node may be a shell node we never received an
+			// announcement for.
+			if err == ErrGraphNodeNotFound {
+				continue
+			}
+
+			log.Warnf("Unable to prune node %x from the "+
+				"graph: %v", nodePubKey, err)
+			continue
+		}
+
+		log.Infof("Pruned unconnected node %x from channel graph",
+			nodePubKey[:])
+
+		numNodesPruned++
+	}
+
+	if numNodesPruned > 0 {
+		log.Infof("Pruned %v unconnected nodes from the channel graph",
+			numNodesPruned)
+	}
+
+	return nil
+}
+
+// nodeHasChannels returns true if the edges bucket holds an edge policy, known
+// or not, of the node with the passed public key. Such policies are keyed by
+// pubKey || chanID, and are added for both nodes of every channel.
+func nodeHasChannels(edges *bolt.Bucket, nodePub []byte) bool {
+	cursor := edges.Cursor()
+	for k, _ := cursor.Seek(nodePub); k != nil &&
+		bytes.HasPrefix(k, nodePub); k, _ = cursor.Next() {
+
+		if len(k) == 33+8 {
+			return true
+		}
+	}
+
+	return false
+}
+
 // PruneGraphNodes is a garbage collection method which attempts to prune out
 // any nodes from the channel graph that are currently unconnected. This ensure
 // that we only maintain a graph of reachable nodes. In the event that a pruned
@@ -2098,6 +2216,26 @@ func (l *LightningNode) AddPubKey(key *secp256k1.PublicKey) {
 	copy(l.PubKeyBytes[:], key.SerializeCompressed())
 }
 
+// CustomRecords returns the custom records the node included in its latest
+// announcement, keyed by their type. The records are carried as a TLV stream
+// in the extra opaque data of the announcement. As we can't understand any of
+// them, an error is returned if the stream includes records of even types or
+// if the extra opaque data isn't a valid stream at all.
+func (l *LightningNode) CustomRecords() (map[uint64][]byte, error) {
+	if len(l.ExtraOpaqueData) == 0 {
+		return nil, nil
+	}
+
+	tlvStream, err := tlv.NewStream()
+	if err != nil {
+		return nil, err
+	}
+
+	return tlvStream.DecodeWithUnknownRecords(
+		bytes.NewReader(l.ExtraOpaqueData),
+	)
+}
+
 // NodeAnnouncement retrieves the latest node announcement of the node.
 func (l *LightningNode) NodeAnnouncement(signed bool) (*lnwire.NodeAnnouncement,
 	error) {
@@ -18,6 +18,7 @@ import (
 	"github.com/decred/dcrd/dcrec/secp256k1/v2"
 	"github.com/decred/dcrd/wire"
 	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/tlv"
 	bolt "go.etcd.io/bbolt"
 )
 
@@ -130,6 +131,71 @@ func TestNodeInsertionAndDeletion(t *testing.T) {
 	}
 }
 
+// TestNodeCustomRecords checks that the custom records carried in the extra
+// opaque data of a node announcement are persisted and can be retrieved.
+func TestNodeCustomRecords(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+
+	graph := db.ChannelGraph()
+
+	node, err := createTestVertex(db)
+	if err != nil {
+		t.Fatalf("unable to create test node: %v", err)
+	}
+
+	records := map[uint64][]byte{
+		65537: []byte("service"),
+		65539: {1, 2, 3},
+	}
+	tlvRecords, err := tlv.MapToRecords(records)
+	if err != nil {
+		t.Fatalf("unable to create records: %v", err)
+	}
+	tlvStream, err := tlv.NewStream(tlvRecords...)
+	if err != nil {
+		t.Fatalf("unable to create stream: %v", err)
+	}
+	var b bytes.Buffer
+	if err := tlvStream.Encode(&b); err != nil {
+		t.Fatalf("unable to encode records: %v", err)
+	}
+	node.ExtraOpaqueData = b.Bytes()
+
+	if err := graph.AddLightningNode(node); err != nil {
+		t.Fatalf("unable to add node: %v", err)
+	}
+
+	pub, err := node.PubKey()
+	if err != nil {
+		t.Fatalf("unable to parse pubkey: %v", err)
+	}
+	dbNode, err := graph.FetchLightningNode(pub)
+	if err != nil {
+		t.Fatalf("unable to locate node: %v", err)
+	}
+
+	dbRecords, err := dbNode.CustomRecords()
+	if err != nil {
+		t.Fatalf("unable to decode custom records: %v", err)
+	}
+	if !reflect.DeepEqual(dbRecords, records) {
+		t.Fatalf("custom records don't match, want: %v, got: %v",
+			records, dbRecords)
+	}
+
+	// Records of even types can't be understood, so they are rejected.
+	dbNode.ExtraOpaqueData = []byte{0x02, 0x01, 0x00}
+	if _, err := dbNode.CustomRecords(); err == nil {
+		t.Fatalf("expected even custom record to be rejected")
+	}
+}
+
 // TestPartialNode checks that we can add and retrieve a LightningNode where
 // where only the pubkey is known to the database.
 func TestPartialNode(t *testing.T) {
@@ -2409,6 +2475,90 @@ func TestPruneGraphNodes(t *testing.T) {
 	}
 }
 
+// TestPruneGraphClosedChannelNodes asserts that pruning a block only deletes
+// the nodes whose channels were all closed by the block, and that duplicate
+// and unrelated outpoints are ignored.
+func TestPruneGraphClosedChannelNodes(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+
+	graph := db.ChannelGraph()
+	sourceNode, err := createTestVertex(db)
+	if err != nil {
+		t.Fatalf("unable to create source node: %v", err)
+	}
+	if err := graph.SetSourceNode(sourceNode); err != nil {
+		t.Fatalf("unable to set source node: %v", err)
+	}
+
+	// Create four nodes, the first three connected in a line by two
+	// channels, the last one without any channel.
+	var graphNodes []*LightningNode
+	for i := 0; i < 4; i++ {
+		node, err := createTestVertex(db)
+		if err != nil {
+			t.Fatalf("unable to create test node: %v", err)
+		}
+		if err := graph.AddLightningNode(node); err != nil {
+			t.Fatalf("unable to add node: %v", err)
+		}
+		graphNodes = append(graphNodes, node)
+	}
+
+	edge1, _ := createEdge(100, 0, 0, 0, graphNodes[0], graphNodes[1])
+	if err := graph.AddChannelEdge(&edge1); err != nil {
+		t.Fatalf("unable to add edge: %v", err)
+	}
+	edge2, _ := createEdge(101, 0, 0, 1, graphNodes[1], graphNodes[2])
+	if err := graph.AddChannelEdge(&edge2); err != nil {
+		t.Fatalf("unable to add edge: %v", err)
+	}
+
+	// Close the first channel in a block that also spends unrelated
+	// outputs, along with the funding output of the channel once more.
+	unrelated := wire.OutPoint{Hash: chainhash.Hash{1}, Index: 2}
+	var blockHash chainhash.Hash
+	prunedChans, err := graph.PruneGraph(
+		[]*wire.OutPoint{
+			&unrelated, &edge1.ChannelPoint, &edge1.ChannelPoint,
+		}, &blockHash, 1,
+	)
+	if err != nil {
+		t.Fatalf("unable to prune graph: %v", err)
+	}
+	if len(prunedChans) != 1 ||
+		prunedChans[0].ChannelID != edge1.ChannelID {
+
+		t.Fatalf("expected only channel %v to be pruned, got %v",
+			edge1.ChannelID, prunedChans)
+	}
+	assertNumChans(t, graph, 1)
+
+	// Only the first node lost all its channels. The node without any
+	// channel wasn't part of the block, so it's left for PruneGraphNodes.
+	assertNumNodes(t, graph, 4)
+	for i, node := range graphNodes {
+		pub, err := node.PubKey()
+		if err != nil {
+			t.Fatalf("unable to fetch pubkey: %v", err)
+		}
+
+		_, err = graph.FetchLightningNode(pub)
+		switch {
+		case i == 0 && err != ErrGraphNodeNotFound:
+			t.Fatalf("expected node %d to be pruned, got %v", i,
+				err)
+		case i != 0 && err != nil:
+			t.Fatalf("expected node %d to be kept: %v", i, err)
+		}
+	}
+}
+
 // TestAddChannelEdgeShellNodes tests that when we attempt to add a ChannelEdge
 // to the graph, one or both of the nodes the edge involves aren't found in the
 // database, then shell edges are created for each node if needed.
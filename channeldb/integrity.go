@@ -0,0 +1,242 @@
+package channeldb
+
+import (
+	"bytes"
+	"fmt"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// IntegrityReport counts the entries of the database which break the
+// invariants of its buckets, found by CheckIntegrity.
+type IntegrityReport struct {
+	// DanglingInvoiceIndexes is the number of invoice index entries,
+	// whether by payment hash, add index, settle index or payment
+	// address, pointing to an invoice which doesn't exist.
+	DanglingInvoiceIndexes int
+
+	// OrphanPayments is the number of payments missing their sequence
+	// number or creation info, which can't be fetched.
+	OrphanPayments int
+
+	// OrphanHTLCAttempts is the number of htlc attempts of a payment
+	// missing their attempt info, such as a settle or fail info recorded
+	// for an unknown attempt.
+	OrphanHTLCAttempts int
+
+	// Repaired is true if the entries found were deleted.
+	Repaired bool
+}
+
+// Total returns the number of entries breaking the invariants of the
+// database.
+func (r *IntegrityReport) Total() int {
+	return r.DanglingInvoiceIndexes + r.OrphanPayments +
+		r.OrphanHTLCAttempts
+}
+
+// String returns a human readable summary of the report.
+func (r *IntegrityReport) String() string {
+	return fmt.Sprintf("dangling_invoice_indexes=%d, orphan_payments=%d, "+
+		"orphan_htlc_attempts=%d, repaired=%v",
+		r.DanglingInvoiceIndexes, r.OrphanPayments,
+		r.OrphanHTLCAttempts, r.Repaired)
+}
+
+// CheckIntegrity verifies the invariants of the invoice and payment buckets,
+// which a crash or a bug of a prior release may have broken. If repair is
+// true, the offending entries are deleted within the same transaction.
+// Otherwise the database is left untouched.
+func (d *DB) CheckIntegrity(repair bool) (*IntegrityReport, error) {
+	var report *IntegrityReport
+	check := func(tx *bolt.Tx) error {
+		report = &IntegrityReport{Repaired: repair}
+
+		dangling, err := checkInvoiceIndexes(tx, repair)
+		if err != nil {
+			return err
+		}
+		report.DanglingInvoiceIndexes = dangling
+
+		orphanPayments, orphanAttempts, err := checkPayments(tx, repair)
+		if err != nil {
+			return err
+		}
+		report.OrphanPayments = orphanPayments
+		report.OrphanHTLCAttempts = orphanAttempts
+
+		return nil
+	}
+
+	var err error
+	if repair {
+		err = d.Update(check)
+	} else {
+		err = d.View(check)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// deleteKeys deletes the given keys from the bucket if repair is true, and
+// returns their number.
+func deleteKeys(bucket *bolt.Bucket, keys [][]byte, repair bool) (int, error) {
+	if !repair {
+		return len(keys), nil
+	}
+
+	for _, k := range keys {
+		if err := bucket.Delete(k); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(keys), nil
+}
+
+// checkInvoiceIndexes returns the number of invoice index entries pointing to
+// an invoice which doesn't exist, deleting them if repair is true.
+func checkInvoiceIndexes(tx *bolt.Tx, repair bool) (int, error) {
+	invoices := tx.Bucket(invoiceBucket)
+	if invoices == nil {
+		return 0, nil
+	}
+
+	invoiceExists := func(invoiceKey []byte) bool {
+		return invoices.Get(invoiceKey) != nil
+	}
+
+	// The payment hash, add, settle and payment address indexes map their
+	// keys to an invoice key, while the payment addresses and AMP indexes
+	// are keyed by the invoice key.
+	indexes := []struct {
+		name         []byte
+		keyedByValue bool
+	}{
+		{name: invoiceIndexBucket, keyedByValue: true},
+		{name: addIndexBucket, keyedByValue: true},
+		{name: settleIndexBucket, keyedByValue: true},
+		{name: payAddrIndexBucket, keyedByValue: true},
+		{name: invoicePayAddrBucket},
+		{name: ampInvoiceIndexBucket},
+	}
+
+	var numDangling int
+	for _, index := range indexes {
+		bucket := invoices.Bucket(index.name)
+		if bucket == nil {
+			continue
+		}
+
+		// A bucket can't be modified while iterating over it, so the
+		// dangling keys are collected first.
+		var dangling [][]byte
+		err := bucket.ForEach(func(k, v []byte) error {
+			if bytes.Equal(k, numInvoicesKey) {
+				return nil
+			}
+
+			invoiceKey := k
+			if index.keyedByValue {
+				invoiceKey = v
+			}
+			if invoiceKey == nil || !invoiceExists(invoiceKey) {
+				dangling = append(dangling, copySlice(k))
+			}
+
+			return nil
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		n, err := deleteKeys(bucket, dangling, repair)
+		if err != nil {
+			return 0, err
+		}
+		numDangling += n
+	}
+
+	return numDangling, nil
+}
+
+// checkPayments returns the number of payments missing their sequence number
+// or creation info, and of htlc attempts missing their attempt info, deleting
+// them if repair is true.
+func checkPayments(tx *bolt.Tx, repair bool) (int, int, error) {
+	payments := tx.Bucket(paymentsRootBucket)
+	if payments == nil {
+		return 0, 0, nil
+	}
+
+	// The buckets can't be modified while iterating over the payments, so
+	// the orphan entries are collected first.
+	var orphanPayments [][]byte
+	orphanAttempts := make(map[string][][]byte)
+	numOrphanAttempts := 0
+	err := payments.ForEach(func(k, v []byte) error {
+		bucket := payments.Bucket(k)
+		if bucket == nil {
+			return nil
+		}
+
+		if bucket.Get(paymentSequenceKey) == nil ||
+			bucket.Get(paymentCreationInfoKey) == nil {
+
+			orphanPayments = append(orphanPayments, copySlice(k))
+			return nil
+		}
+
+		htlcs := bucket.Bucket(paymentHtlcsBucket)
+		if htlcs == nil {
+			return nil
+		}
+
+		return htlcs.ForEach(func(attemptKey, v []byte) error {
+			attempt := htlcs.Bucket(attemptKey)
+			if attempt != nil && attempt.Get(htlcAttemptInfoKey) != nil {
+				return nil
+			}
+
+			orphanAttempts[string(k)] = append(
+				orphanAttempts[string(k)], copySlice(attemptKey),
+			)
+			numOrphanAttempts++
+
+			return nil
+		})
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if !repair {
+		return len(orphanPayments), numOrphanAttempts, nil
+	}
+
+	for _, k := range orphanPayments {
+		if err := payments.DeleteBucket(k); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	for k, attemptKeys := range orphanAttempts {
+		htlcs := payments.Bucket([]byte(k)).Bucket(paymentHtlcsBucket)
+		for _, attemptKey := range attemptKeys {
+			var err error
+			if htlcs.Bucket(attemptKey) != nil {
+				err = htlcs.DeleteBucket(attemptKey)
+			} else {
+				err = htlcs.Delete(attemptKey)
+			}
+			if err != nil {
+				return 0, 0, err
+			}
+		}
+	}
+
+	return len(orphanPayments), numOrphanAttempts, nil
+}
@@ -0,0 +1,168 @@
+package channeldb
+
+import (
+	"testing"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// TestCheckIntegrity asserts that the invoice index entries pointing to
+// missing invoices and the payments and htlc attempts missing their info are
+// reported, then deleted once repaired.
+func TestCheckIntegrity(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	invoiceKey := []byte{0, 0, 0, 1}
+	missingKey := []byte{0, 0, 0, 2}
+
+	err = cdb.Update(func(tx *bolt.Tx) error {
+		invoices, err := tx.CreateBucketIfNotExists(invoiceBucket)
+		if err != nil {
+			return err
+		}
+		if err := invoices.Put(invoiceKey, []byte("invoice")); err != nil {
+			return err
+		}
+
+		// Each index holds an entry for the existing invoice, and one
+		// for the missing one.
+		hashIndex, err := invoices.CreateBucketIfNotExists(
+			invoiceIndexBucket,
+		)
+		if err != nil {
+			return err
+		}
+		if err := hashIndex.Put(numInvoicesKey, missingKey); err != nil {
+			return err
+		}
+		if err := hashIndex.Put([]byte("hash1"), invoiceKey); err != nil {
+			return err
+		}
+		if err := hashIndex.Put([]byte("hash2"), missingKey); err != nil {
+			return err
+		}
+
+		payAddrs, err := invoices.CreateBucketIfNotExists(
+			invoicePayAddrBucket,
+		)
+		if err != nil {
+			return err
+		}
+		if err := payAddrs.Put(invoiceKey, []byte("addr1")); err != nil {
+			return err
+		}
+		if err := payAddrs.Put(missingKey, []byte("addr2")); err != nil {
+			return err
+		}
+
+		// A payment missing its creation info, and a payment holding
+		// an htlc attempt missing its attempt info.
+		payments, err := tx.CreateBucketIfNotExists(paymentsRootBucket)
+		if err != nil {
+			return err
+		}
+		if _, err := payments.CreateBucket([]byte("orphan")); err != nil {
+			return err
+		}
+
+		payment, err := payments.CreateBucket([]byte("payment"))
+		if err != nil {
+			return err
+		}
+		var seqNum [8]byte
+		byteOrder.PutUint64(seqNum[:], 1)
+		if err := payment.Put(paymentSequenceKey, seqNum[:]); err != nil {
+			return err
+		}
+		err = payment.Put(paymentCreationInfoKey, []byte("info"))
+		if err != nil {
+			return err
+		}
+		htlcs, err := payment.CreateBucket(paymentHtlcsBucket)
+		if err != nil {
+			return err
+		}
+		attempt, err := htlcs.CreateBucket([]byte{1})
+		if err != nil {
+			return err
+		}
+		err = attempt.Put(htlcAttemptInfoKey, []byte("attempt"))
+		if err != nil {
+			return err
+		}
+		orphanAttempt, err := htlcs.CreateBucket([]byte{2})
+		if err != nil {
+			return err
+		}
+		return orphanAttempt.Put(htlcSettleInfoKey, []byte("settle"))
+	})
+	if err != nil {
+		t.Fatalf("unable to populate db: %v", err)
+	}
+
+	assertReport := func(report *IntegrityReport, expected int) {
+		t.Helper()
+
+		if report.DanglingInvoiceIndexes != expected ||
+			report.OrphanPayments != expected ||
+			report.OrphanHTLCAttempts != expected {
+
+			t.Fatalf("unexpected integrity report: %v", report)
+		}
+	}
+
+	// Checking the integrity only reports the offending entries.
+	for i := 0; i < 2; i++ {
+		report, err := cdb.CheckIntegrity(false)
+		if err != nil {
+			t.Fatalf("unable to check integrity: %v", err)
+		}
+		assertReport(report, 2)
+	}
+
+	// Once repaired, the database passes the check.
+	report, err := cdb.CheckIntegrity(true)
+	if err != nil {
+		t.Fatalf("unable to repair integrity: %v", err)
+	}
+	assertReport(report, 2)
+	if !report.Repaired {
+		t.Fatalf("report not marked as repaired")
+	}
+
+	report, err = cdb.CheckIntegrity(false)
+	if err != nil {
+		t.Fatalf("unable to check integrity: %v", err)
+	}
+	if report.Total() != 0 {
+		t.Fatalf("integrity not repaired: %v", report)
+	}
+
+	// The valid entries were kept.
+	err = cdb.View(func(tx *bolt.Tx) error {
+		invoices := tx.Bucket(invoiceBucket)
+		hashIndex := invoices.Bucket(invoiceIndexBucket)
+		if hashIndex.Get([]byte("hash1")) == nil ||
+			hashIndex.Get(numInvoicesKey) == nil {
+
+			t.Fatalf("valid invoice index entry deleted")
+		}
+
+		payment := tx.Bucket(paymentsRootBucket).Bucket([]byte("payment"))
+		if payment == nil ||
+			payment.Bucket(paymentHtlcsBucket).Bucket([]byte{1}) == nil {
+
+			t.Fatalf("valid payment deleted")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
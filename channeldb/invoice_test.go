@@ -7,7 +7,9 @@ import (
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/decred/dcrlnd/lntypes"
 	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/record"
 )
 
 func randInvoice(value lnwire.MilliAtom) (*Invoice, error) {
@@ -397,6 +399,300 @@ func TestDuplicateSettleInvoice(t *testing.T) {
 	}
 }
 
+// TestAMPInvoice asserts that htlcs paying to an AMP invoice are settled right
+// away, that their AMP records are persisted and that their payment hashes are
+// indexed, while the invoice itself remains open.
+func TestAMPInvoice(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+	db.now = func() time.Time { return time.Unix(1, 0) }
+
+	invoice, err := randInvoice(0)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	invoice.AMP = true
+
+	payHash := invoice.Terms.PaymentPreimage.Hash()
+	if _, err := db.AddInvoice(invoice, payHash); err != nil {
+		t.Fatalf("unable to add invoice %v", err)
+	}
+
+	var rootShare, setID [32]byte
+	if _, err := rand.Read(rootShare[:]); err != nil {
+		t.Fatalf("unable to generate root share: %v", err)
+	}
+	if _, err := rand.Read(setID[:]); err != nil {
+		t.Fatalf("unable to generate set id: %v", err)
+	}
+	amp0 := record.NewAMP(payHash, rootShare, setID, 0)
+	amp1 := record.NewAMP(payHash, rootShare, setID, 1)
+
+	amt := lnwire.NewMAtomsFromAtoms(1000)
+	key0 := CircuitKey{HtlcID: 0}
+	key1 := CircuitKey{HtlcID: 1}
+	update := func(invoice *Invoice) (*InvoiceUpdateDesc, error) {
+		return &InvoiceUpdateDesc{
+			State: ContractOpen,
+			Htlcs: map[CircuitKey]*HtlcAcceptDesc{
+				key0: {Amt: amt, AMP: amp0},
+				key1: {Amt: amt, AMP: amp1},
+			},
+		}, nil
+	}
+
+	dbInvoice, err := db.UpdateInvoice(payHash, update)
+	if err != nil {
+		t.Fatalf("unable to update invoice: %v", err)
+	}
+	if dbInvoice.Terms.State != ContractOpen {
+		t.Fatalf("expected invoice to remain open, got %v",
+			dbInvoice.Terms.State)
+	}
+	if dbInvoice.AmtPaid != 2*amt {
+		t.Fatalf("expected amount paid %v, got %v", 2*amt,
+			dbInvoice.AmtPaid)
+	}
+
+	// Both htlcs should be retrievable through the hash of their own
+	// preimage, along with their AMP records.
+	for _, amp := range []*record.AMP{amp0, amp1} {
+		dbInvoice, err := db.LookupInvoice(amp.ChildHash())
+		if err != nil {
+			t.Fatalf("unable to lookup invoice by child "+
+				"hash: %v", err)
+		}
+		if !dbInvoice.AMP {
+			t.Fatalf("expected amp invoice")
+		}
+
+		htlcSet := dbInvoice.HTLCSet(setID)
+		if len(htlcSet) != 2 {
+			t.Fatalf("expected 2 htlcs in set, got %v",
+				len(htlcSet))
+		}
+
+		expectedHtlcs := map[CircuitKey]*InvoiceHTLC{
+			key0: {
+				Amt:         amt,
+				AcceptTime:  time.Unix(1, 0),
+				ResolveTime: time.Unix(1, 0),
+				State:       HtlcStateSettled,
+				AMP:         amp0,
+			},
+			key1: {
+				Amt:         amt,
+				AcceptTime:  time.Unix(1, 0),
+				ResolveTime: time.Unix(1, 0),
+				State:       HtlcStateSettled,
+				AMP:         amp1,
+			},
+		}
+		if !reflect.DeepEqual(htlcSet, expectedHtlcs) {
+			t.Fatalf("wrong htlcs, expected %v got %v",
+				spew.Sdump(expectedHtlcs), spew.Sdump(htlcSet))
+		}
+
+		setIDs := dbInvoice.AMPSetIDs()
+		if len(setIDs) != 1 || setIDs[0] != setID {
+			t.Fatalf("unexpected set ids: %x", setIDs)
+		}
+	}
+
+	// An AMP htlc can't pay to an invoice that isn't an AMP invoice.
+	invoice, err = randInvoice(amt)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	payHash = invoice.Terms.PaymentPreimage.Hash()
+	if _, err := db.AddInvoice(invoice, payHash); err != nil {
+		t.Fatalf("unable to add invoice %v", err)
+	}
+	if _, err := db.UpdateInvoice(payHash, update); err == nil {
+		t.Fatalf("expected amp htlc to be rejected")
+	}
+}
+
+// TestInvoicePaymentAddr asserts that invoices can be looked up by their
+// payment address, and that payment addresses can't be reused.
+func TestInvoicePaymentAddr(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	invoice, err := randInvoice(lnwire.NewMAtomsFromAtoms(1000))
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	if _, err := rand.Read(invoice.Terms.PaymentAddr[:]); err != nil {
+		t.Fatalf("unable to generate payment addr: %v", err)
+	}
+	payAddr := invoice.Terms.PaymentAddr
+
+	// An unknown payment address shouldn't be found.
+	_, err = db.LookupInvoiceByPayAddr(payAddr)
+	if err != ErrNoInvoicesCreated {
+		t.Fatalf("expected ErrNoInvoicesCreated, got %v", err)
+	}
+
+	payHash := invoice.Terms.PaymentPreimage.Hash()
+	if _, err := db.AddInvoice(invoice, payHash); err != nil {
+		t.Fatalf("unable to add invoice %v", err)
+	}
+
+	// The invoice should be retrievable by both its payment hash and its
+	// payment address.
+	dbInvoice, err := db.LookupInvoice(payHash)
+	if err != nil {
+		t.Fatalf("unable to lookup invoice: %v", err)
+	}
+	if dbInvoice.Terms.PaymentAddr != payAddr {
+		t.Fatalf("expected payment addr %x, got %x", payAddr,
+			dbInvoice.Terms.PaymentAddr)
+	}
+
+	dbInvoice, err = db.LookupInvoiceByPayAddr(payAddr)
+	if err != nil {
+		t.Fatalf("unable to lookup invoice by payment addr: %v", err)
+	}
+	if !reflect.DeepEqual(*invoice, dbInvoice) {
+		t.Fatalf("wrong invoice, expected %v got %v",
+			spew.Sdump(invoice), spew.Sdump(dbInvoice))
+	}
+
+	_, err = db.LookupInvoiceByPayAddr(BlankPayAddr)
+	if err != ErrInvoiceNotFound {
+		t.Fatalf("expected ErrInvoiceNotFound, got %v", err)
+	}
+
+	// A second invoice with the same payment address must be rejected.
+	dupInvoice, err := randInvoice(lnwire.NewMAtomsFromAtoms(1000))
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	dupInvoice.Terms.PaymentAddr = payAddr
+
+	_, err = db.AddInvoice(dupInvoice, dupInvoice.Terms.PaymentPreimage.Hash())
+	if err != ErrDuplicatePayAddr {
+		t.Fatalf("expected ErrDuplicatePayAddr, got %v", err)
+	}
+}
+
+// TestDeleteCanceledInvoices asserts that only canceled invoices created
+// before the cutoff are deleted, and that they can't be found through any of
+// the indexes afterwards.
+func TestDeleteCanceledInvoices(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	// Deleting invoices from an empty database is a noop.
+	numDeleted, err := db.DeleteCanceledInvoices(time.Now())
+	if err != nil {
+		t.Fatalf("unable to delete invoices: %v", err)
+	}
+	if numDeleted != 0 {
+		t.Fatalf("expected no deleted invoices, got %v", numDeleted)
+	}
+
+	cutoff := time.Unix(1000000, 0)
+
+	cancelInvoice := func(invoice *Invoice) (*InvoiceUpdateDesc, error) {
+		return &InvoiceUpdateDesc{State: ContractCanceled}, nil
+	}
+
+	// Add an old canceled invoice with a payment address, a recent
+	// canceled invoice and an old open invoice.
+	var hashes []lntypes.Hash
+	for i := 0; i < 3; i++ {
+		invoice, err := randInvoice(lnwire.NewMAtomsFromAtoms(1000))
+		if err != nil {
+			t.Fatalf("unable to create invoice: %v", err)
+		}
+		if i != 1 {
+			invoice.CreationDate = cutoff.Add(-time.Hour)
+		}
+		if i == 0 {
+			_, err := rand.Read(invoice.Terms.PaymentAddr[:])
+			if err != nil {
+				t.Fatalf("unable to generate payment addr: %v",
+					err)
+			}
+		}
+
+		hash := invoice.Terms.PaymentPreimage.Hash()
+		if _, err := db.AddInvoice(invoice, hash); err != nil {
+			t.Fatalf("unable to add invoice %v", err)
+		}
+		hashes = append(hashes, hash)
+
+		if i == 2 {
+			continue
+		}
+		if _, err := db.UpdateInvoice(hash, cancelInvoice); err != nil {
+			t.Fatalf("unable to cancel invoice: %v", err)
+		}
+	}
+
+	oldInvoice, err := db.LookupInvoice(hashes[0])
+	if err != nil {
+		t.Fatalf("unable to lookup invoice: %v", err)
+	}
+
+	numDeleted, err = db.DeleteCanceledInvoices(cutoff)
+	if err != nil {
+		t.Fatalf("unable to delete invoices: %v", err)
+	}
+	if numDeleted != 1 {
+		t.Fatalf("expected 1 deleted invoice, got %v", numDeleted)
+	}
+
+	// Only the old canceled invoice should be gone.
+	if _, err := db.LookupInvoice(hashes[0]); err != ErrInvoiceNotFound {
+		t.Fatalf("expected ErrInvoiceNotFound, got %v", err)
+	}
+	_, err = db.LookupInvoiceByPayAddr(oldInvoice.Terms.PaymentAddr)
+	if err != ErrInvoiceNotFound {
+		t.Fatalf("expected ErrInvoiceNotFound, got %v", err)
+	}
+	for _, hash := range hashes[1:] {
+		if _, err := db.LookupInvoice(hash); err != nil {
+			t.Fatalf("unable to lookup invoice: %v", err)
+		}
+	}
+
+	// The deleted invoice should also be removed from the add index.
+	resp, err := db.QueryInvoices(InvoiceQuery{NumMaxInvoices: 10})
+	if err != nil {
+		t.Fatalf("unable to query invoices: %v", err)
+	}
+	if len(resp.Invoices) != 2 {
+		t.Fatalf("expected 2 invoices, got %v", len(resp.Invoices))
+	}
+
+	// The old open invoice is the only open invoice left.
+	openInvoices, err := db.FetchOpenInvoices()
+	if err != nil {
+		t.Fatalf("unable to fetch open invoices: %v", err)
+	}
+	if _, ok := openInvoices[hashes[2]]; !ok || len(openInvoices) != 1 {
+		t.Fatalf("unexpected open invoices: %v", spew.Sdump(openInvoices))
+	}
+}
+
 // TestQueryInvoices ensures that we can properly query the invoice database for
 // invoices using different types of queries.
 func TestQueryInvoices(t *testing.T) {
@@ -6,11 +6,13 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sort"
 	"time"
 
 	"github.com/decred/dcrd/wire"
 	"github.com/decred/dcrlnd/lntypes"
 	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/record"
 	"github.com/decred/dcrlnd/tlv"
 	bolt "go.etcd.io/bbolt"
 )
@@ -20,6 +22,11 @@ var (
 	// preimage for this invoice is not yet known.
 	UnknownPreimage lntypes.Preimage
 
+	// BlankPayAddr is a sentinel payment address for legacy invoices.
+	// Invoices with this payment address are not indexed by payment
+	// address and don't require it to be present in the htlc payload.
+	BlankPayAddr [32]byte
+
 	// invoiceBucket is the name of the bucket within the database that
 	// stores all data related to invoices no matter their final state.
 	// Within the invoice bucket, each invoice is keyed by its invoice ID
@@ -63,6 +70,30 @@ var (
 	//   settleIndexNo => invoiceKey
 	settleIndexBucket = []byte("invoice-settle-index")
 
+	// ampInvoiceIndexBucket is the name of the sub-bucket within the
+	// invoiceBucket which records the invoices that accept AMP payments.
+	// The payment hash of every htlc accepted by an AMP invoice is added
+	// to the payment hash index, so that the htlc can be looked up without
+	// its onion payload.
+	//
+	// maps: invoiceKey => 1
+	ampInvoiceIndexBucket = []byte("invoice-amp-index")
+
+	// payAddrIndexBucket is the name of the sub-bucket within the
+	// invoiceBucket which indexes all invoices by their payment address.
+	// The payment address is a secret known only to the payer and the
+	// payee, so that invoices can't be probed by their payment hash.
+	//
+	// maps: payAddr => invoiceKey
+	payAddrIndexBucket = []byte("invoice-payaddr-index")
+
+	// invoicePayAddrBucket is the name of the sub-bucket within the
+	// invoiceBucket which records the payment address of every invoice
+	// that has one, as it isn't part of the serialized invoice.
+	//
+	// maps: invoiceKey => payAddr
+	invoicePayAddrBucket = []byte("invoice-payaddrs")
+
 	// ErrInvoiceAlreadySettled is returned when the invoice is already
 	// settled.
 	ErrInvoiceAlreadySettled = errors.New("invoice already settled")
@@ -104,6 +135,8 @@ const (
 	resolveTimeType  tlv.Type = 11
 	expiryHeightType tlv.Type = 13
 	stateType        tlv.Type = 15
+	rejectReasonType tlv.Type = 17
+	ampRecordType    tlv.Type = 19
 )
 
 // ContractState describes the state the invoice is in.
@@ -156,6 +189,13 @@ type ContractTerm struct {
 
 	// State describes the state the invoice is in.
 	State ContractState
+
+	// PaymentAddr is a randomly generated value included in the invoice
+	// and in the MPP record of the final hop payload. If set, htlcs
+	// paying to the invoice are only accepted if they carry the same
+	// payment address, which prevents intermediate nodes from probing
+	// whether a payment hash belongs to an invoice.
+	PaymentAddr [32]byte
 }
 
 // Invoice is a payment invoice generated by a payee in order to request
@@ -232,6 +272,58 @@ type Invoice struct {
 	// Htlcs records all htlcs that paid to this invoice. Some of these
 	// htlcs may have been marked as canceled.
 	Htlcs map[CircuitKey]*InvoiceHTLC
+
+	// AMP indicates that the invoice is reusable and only accepts AMP
+	// payments. Every htlc derives its own preimage from its AMP record
+	// and is settled on its own, while the invoice itself remains open.
+	AMP bool
+}
+
+// HTLCSet returns the htlcs of the invoice that belong to the AMP payment set
+// with the given id.
+func (i *Invoice) HTLCSet(setID [32]byte) map[CircuitKey]*InvoiceHTLC {
+	htlcSet := make(map[CircuitKey]*InvoiceHTLC)
+	for key, htlc := range i.Htlcs {
+		if htlc.AMP == nil || htlc.AMP.SetID() != setID {
+			continue
+		}
+
+		htlcSet[key] = htlc
+	}
+
+	return htlcSet
+}
+
+// AMPSetIDs returns the ids of all AMP payment sets of the invoice, ordered by
+// the time at which their first htlc was accepted.
+func (i *Invoice) AMPSetIDs() [][32]byte {
+	firstAccept := make(map[[32]byte]time.Time)
+	for _, htlc := range i.Htlcs {
+		if htlc.AMP == nil {
+			continue
+		}
+
+		setID := htlc.AMP.SetID()
+		t, ok := firstAccept[setID]
+		if !ok || htlc.AcceptTime.Before(t) {
+			firstAccept[setID] = htlc.AcceptTime
+		}
+	}
+
+	setIDs := make([][32]byte, 0, len(firstAccept))
+	for setID := range firstAccept {
+		setIDs = append(setIDs, setID)
+	}
+	sort.Slice(setIDs, func(a, b int) bool {
+		ta, tb := firstAccept[setIDs[a]], firstAccept[setIDs[b]]
+		if !ta.Equal(tb) {
+			return ta.Before(tb)
+		}
+
+		return bytes.Compare(setIDs[a][:], setIDs[b][:]) < 0
+	})
+
+	return setIDs
 }
 
 // HtlcState defines the states an htlc paying to an invoice can be in.
@@ -249,6 +341,77 @@ const (
 	HtlcStateSettled
 )
 
+// HtlcRejectReason records why the invoice registry refused to accept an htlc
+// paying to an invoice. It is persisted along with the htlc so that a replayed
+// htlc is failed back with the same failure as the original.
+type HtlcRejectReason uint8
+
+const (
+	// HtlcRejectNone indicates the htlc wasn't rejected.
+	HtlcRejectNone HtlcRejectReason = iota
+
+	// HtlcRejectAmountTooLow indicates the htlc paid less than the invoice
+	// amount.
+	HtlcRejectAmountTooLow
+
+	// HtlcRejectExpiryTooSoon indicates the htlc expiry didn't satisfy the
+	// final cltv delta of the invoice.
+	HtlcRejectExpiryTooSoon
+
+	// HtlcRejectIncorrectAmount indicates the amount in the final hop's
+	// onion payload didn't match the amount carried by the htlc.
+	HtlcRejectIncorrectAmount
+
+	// HtlcRejectIncorrectExpiry indicates the outgoing cltv in the final
+	// hop's onion payload didn't match the expiry of the htlc.
+	HtlcRejectIncorrectExpiry
+
+	// HtlcRejectInvalidAMP indicates the htlc carried an AMP record that
+	// didn't derive its payment hash, or paid to an invoice that doesn't
+	// accept AMP payments.
+	HtlcRejectInvalidAMP
+
+	// HtlcRejectAMPRequired indicates the htlc didn't carry an AMP record
+	// while paying to an AMP invoice.
+	HtlcRejectAMPRequired
+
+	// HtlcRejectAddressMismatch indicates the htlc didn't carry the
+	// payment address of the invoice it paid to.
+	HtlcRejectAddressMismatch
+)
+
+// String returns a human readable representation of the reject reason.
+func (r HtlcRejectReason) String() string {
+	switch r {
+	case HtlcRejectNone:
+		return "none"
+
+	case HtlcRejectAmountTooLow:
+		return "amount too low"
+
+	case HtlcRejectExpiryTooSoon:
+		return "expiry too soon"
+
+	case HtlcRejectIncorrectAmount:
+		return "incorrect htlc amount"
+
+	case HtlcRejectIncorrectExpiry:
+		return "incorrect htlc expiry"
+
+	case HtlcRejectInvalidAMP:
+		return "invalid amp payment"
+
+	case HtlcRejectAMPRequired:
+		return "amp payment required"
+
+	case HtlcRejectAddressMismatch:
+		return "payment address mismatch"
+
+	default:
+		return "unknown"
+	}
+}
+
 // InvoiceHTLC contains details about an htlc paying to this invoice.
 type InvoiceHTLC struct {
 	// Amt is the amount that is carried by this htlc.
@@ -274,6 +437,16 @@ type InvoiceHTLC struct {
 	// canceled htlc isn't just removed from the invoice htlcs map, because
 	// we need AcceptHeight to properly cancel the htlc back.
 	State HtlcState
+
+	// RejectReason is set when the htlc was never accepted by the invoice
+	// registry because it violated the invoice terms or carried an onion
+	// payload that didn't match the htlc. Rejected htlcs are always in the
+	// canceled state and never contribute to the amount paid.
+	RejectReason HtlcRejectReason
+
+	// AMP is the AMP record carried by the htlc, if it paid to an AMP
+	// invoice. The preimage of the htlc is derived from it.
+	AMP *record.AMP
 }
 
 // HtlcAcceptDesc describes the details of a newly accepted htlc.
@@ -286,6 +459,16 @@ type HtlcAcceptDesc struct {
 
 	// Expiry is the expiry height of this htlc.
 	Expiry uint32
+
+	// RejectReason, if set, indicates that the htlc is to be recorded as
+	// rejected instead of being accepted. The htlc is added directly in the
+	// canceled state.
+	RejectReason HtlcRejectReason
+
+	// AMP is the AMP record carried by the htlc. If set, the htlc is
+	// settled as soon as it is accepted, as its preimage is derived from
+	// the record.
+	AMP *record.AMP
 }
 
 // InvoiceUpdateDesc describes the changes that should be applied to the
@@ -378,6 +561,23 @@ func (d *DB) AddInvoice(newInvoice *Invoice, paymentHash lntypes.Hash) (
 			invoiceNum = byteOrder.Uint32(invoiceCounter)
 		}
 
+		// Ensure that an invoice with an identical payment address
+		// doesn't already exist within the index.
+		var payAddrIndex *bolt.Bucket
+		if newInvoice.Terms.PaymentAddr != BlankPayAddr {
+			payAddrIndex, err = invoices.CreateBucketIfNotExists(
+				payAddrIndexBucket,
+			)
+			if err != nil {
+				return err
+			}
+
+			payAddr := newInvoice.Terms.PaymentAddr
+			if payAddrIndex.Get(payAddr[:]) != nil {
+				return ErrDuplicatePayAddr
+			}
+		}
+
 		newIndex, err := putInvoice(
 			invoices, invoiceIndex, addIndex, newInvoice, invoiceNum,
 			paymentHash,
@@ -386,6 +586,46 @@ func (d *DB) AddInvoice(newInvoice *Invoice, paymentHash lntypes.Hash) (
 			return err
 		}
 
+		var invoiceKey [4]byte
+		byteOrder.PutUint32(invoiceKey[:], invoiceNum)
+
+		// The payment address is recorded in its own buckets, as it
+		// isn't part of the serialized invoice.
+		if payAddrIndex != nil {
+			payAddrs, err := invoices.CreateBucketIfNotExists(
+				invoicePayAddrBucket,
+			)
+			if err != nil {
+				return err
+			}
+
+			payAddr := newInvoice.Terms.PaymentAddr
+			err = payAddrIndex.Put(payAddr[:], invoiceKey[:])
+			if err != nil {
+				return err
+			}
+			err = payAddrs.Put(invoiceKey[:], payAddr[:])
+			if err != nil {
+				return err
+			}
+		}
+
+		// AMP invoices are recorded in their own index, as the flag
+		// isn't part of the serialized invoice.
+		if newInvoice.AMP {
+			ampIndex, err := invoices.CreateBucketIfNotExists(
+				ampInvoiceIndexBucket,
+			)
+			if err != nil {
+				return err
+			}
+
+			err = ampIndex.Put(invoiceKey[:], []byte{1})
+			if err != nil {
+				return err
+			}
+		}
+
 		invoiceAddIndex = newIndex
 		return nil
 	})
@@ -505,6 +745,42 @@ func (d *DB) LookupInvoice(paymentHash [32]byte) (Invoice, error) {
 	return invoice, nil
 }
 
+// LookupInvoiceByPayAddr attempts to look up an invoice according to its 32
+// byte payment address. If no invoice with the passed payment address exists,
+// ErrInvoiceNotFound is returned.
+func (d *DB) LookupInvoiceByPayAddr(payAddr [32]byte) (Invoice, error) {
+	var invoice Invoice
+	err := d.View(func(tx *bolt.Tx) error {
+		invoices := tx.Bucket(invoiceBucket)
+		if invoices == nil {
+			return ErrNoInvoicesCreated
+		}
+
+		payAddrIndex := invoices.Bucket(payAddrIndexBucket)
+		if payAddrIndex == nil {
+			return ErrInvoiceNotFound
+		}
+
+		invoiceNum := payAddrIndex.Get(payAddr[:])
+		if invoiceNum == nil {
+			return ErrInvoiceNotFound
+		}
+
+		i, err := fetchInvoice(invoiceNum, invoices)
+		if err != nil {
+			return err
+		}
+		invoice = i
+
+		return nil
+	})
+	if err != nil {
+		return invoice, err
+	}
+
+	return invoice, nil
+}
+
 // FetchAllInvoices returns all invoices currently stored within the database.
 // If the pendingOnly param is true, then only unsettled invoices will be
 // returned, skipping all invoices that are fully settled.
@@ -525,8 +801,7 @@ func (d *DB) FetchAllInvoices(pendingOnly bool) ([]Invoice, error) {
 				return nil
 			}
 
-			invoiceReader := bytes.NewReader(v)
-			invoice, err := deserializeInvoice(invoiceReader)
+			invoice, err := fetchInvoice(k, invoiceB)
 			if err != nil {
 				return err
 			}
@@ -549,6 +824,179 @@ func (d *DB) FetchAllInvoices(pendingOnly bool) ([]Invoice, error) {
 	return invoices, nil
 }
 
+// FetchOpenInvoices returns all invoices that are still open, keyed by their
+// payment hash. AMP invoices are not returned, as they can be paid any number
+// of times and the payment hash index also holds the hashes of their htlcs.
+func (d *DB) FetchOpenInvoices() (map[lntypes.Hash]Invoice, error) {
+	openInvoices := make(map[lntypes.Hash]Invoice)
+
+	err := d.View(func(tx *bolt.Tx) error {
+		invoices := tx.Bucket(invoiceBucket)
+		if invoices == nil {
+			return nil
+		}
+		invoiceIndex := invoices.Bucket(invoiceIndexBucket)
+		if invoiceIndex == nil {
+			return nil
+		}
+
+		return invoiceIndex.ForEach(func(k, v []byte) error {
+			// Skip the invoice counter, which is housed in the
+			// same bucket.
+			if bytes.Equal(k, numInvoicesKey) {
+				return nil
+			}
+
+			invoice, err := fetchInvoice(v, invoices)
+			if err != nil {
+				return err
+			}
+
+			if invoice.AMP || invoice.Terms.State != ContractOpen {
+				return nil
+			}
+
+			hash, err := lntypes.MakeHash(k)
+			if err != nil {
+				return err
+			}
+			openInvoices[hash] = invoice
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return openInvoices, nil
+}
+
+// DeleteCanceledInvoices removes all canceled invoices that were created
+// before the passed time from the database, along with their index entries.
+// The number of deleted invoices is returned.
+func (d *DB) DeleteCanceledInvoices(createdBefore time.Time) (int, error) {
+	var numDeleted int
+	err := d.Update(func(tx *bolt.Tx) error {
+		numDeleted = 0
+
+		invoices := tx.Bucket(invoiceBucket)
+		if invoices == nil {
+			return nil
+		}
+		invoiceIndex := invoices.Bucket(invoiceIndexBucket)
+		addIndex := invoices.Bucket(addIndexBucket)
+		if invoiceIndex == nil || addIndex == nil {
+			return nil
+		}
+
+		// First, we'll collect the keys of all the canceled invoices
+		// that are due for deletion along with their add index, as a
+		// bucket can't be modified while iterating over it.
+		deleted := make(map[string]uint64)
+		err := invoices.ForEach(func(k, v []byte) error {
+			// Skip the nested buckets.
+			if v == nil {
+				return nil
+			}
+
+			invoice, err := deserializeInvoice(bytes.NewReader(v))
+			if err != nil {
+				return err
+			}
+
+			if invoice.Terms.State != ContractCanceled ||
+				!invoice.CreationDate.Before(createdBefore) {
+
+				return nil
+			}
+
+			deleted[string(k)] = invoice.AddIndex
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(deleted) == 0 {
+			return nil
+		}
+
+		// An invoice may be indexed by more than one payment hash if
+		// it accepted AMP payments, so we'll look up all hashes that
+		// point to the deleted invoices.
+		var hashes [][]byte
+		err = invoiceIndex.ForEach(func(k, v []byte) error {
+			if bytes.Equal(k, numInvoicesKey) {
+				return nil
+			}
+
+			if _, ok := deleted[string(v)]; ok {
+				hashes = append(hashes, copySlice(k))
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, hash := range hashes {
+			if err := invoiceIndex.Delete(hash); err != nil {
+				return err
+			}
+		}
+
+		payAddrIndex := invoices.Bucket(payAddrIndexBucket)
+		payAddrs := invoices.Bucket(invoicePayAddrBucket)
+		ampIndex := invoices.Bucket(ampInvoiceIndexBucket)
+
+		for k, invoiceAddIndex := range deleted {
+			invoiceKey := []byte(k)
+
+			var seqNoBytes [8]byte
+			byteOrder.PutUint64(seqNoBytes[:], invoiceAddIndex)
+			if err := addIndex.Delete(seqNoBytes[:]); err != nil {
+				return err
+			}
+
+			if payAddrs != nil {
+				payAddr := copySlice(payAddrs.Get(invoiceKey))
+				if len(payAddr) != 0 && payAddrIndex != nil {
+					err := payAddrIndex.Delete(payAddr)
+					if err != nil {
+						return err
+					}
+				}
+
+				if err := payAddrs.Delete(invoiceKey); err != nil {
+					return err
+				}
+			}
+
+			if ampIndex != nil {
+				if err := ampIndex.Delete(invoiceKey); err != nil {
+					return err
+				}
+			}
+
+			if err := invoices.Delete(invoiceKey); err != nil {
+				return err
+			}
+		}
+
+		numDeleted = len(deleted)
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return numDeleted, nil
+}
+
 // InvoiceQuery represents a query to the invoice database. The query allows a
 // caller to retrieve all invoices starting from a particular add index and
 // limit the number of results returned.
@@ -757,8 +1205,8 @@ func (d *DB) UpdateInvoice(paymentHash lntypes.Hash,
 		}
 
 		updatedInvoice, err = d.updateInvoice(
-			paymentHash, invoices, settleIndex, invoiceNum,
-			callback,
+			paymentHash, invoices, invoiceIndex, settleIndex,
+			invoiceNum, callback,
 		)
 
 		return err
@@ -969,8 +1417,9 @@ func serializeHtlcs(w io.Writer, htlcs map[CircuitKey]*InvoiceHTLC) error {
 		acceptTime := uint64(htlc.AcceptTime.UnixNano())
 		resolveTime := uint64(htlc.ResolveTime.UnixNano())
 		state := uint8(htlc.State)
+		rejectReason := uint8(htlc.RejectReason)
 
-		tlvStream, err := tlv.NewStream(
+		records := []tlv.Record{
 			tlv.MakePrimitiveRecord(chanIDType, &chanID),
 			tlv.MakePrimitiveRecord(htlcIDType, &key.HtlcID),
 			tlv.MakePrimitiveRecord(amtType, &amt),
@@ -981,7 +1430,16 @@ func serializeHtlcs(w io.Writer, htlcs map[CircuitKey]*InvoiceHTLC) error {
 			tlv.MakePrimitiveRecord(resolveTimeType, &resolveTime),
 			tlv.MakePrimitiveRecord(expiryHeightType, &htlc.Expiry),
 			tlv.MakePrimitiveRecord(stateType, &state),
-		)
+			tlv.MakePrimitiveRecord(rejectReasonType, &rejectReason),
+		}
+
+		// The AMP record is only included for htlcs paying to AMP
+		// invoices.
+		if htlc.AMP != nil {
+			records = append(records, newAMPHtlcRecord(htlc.AMP))
+		}
+
+		tlvStream, err := tlv.NewStream(records...)
 		if err != nil {
 			return err
 		}
@@ -1014,7 +1472,22 @@ func fetchInvoice(invoiceNum []byte, invoices *bolt.Bucket) (Invoice, error) {
 
 	invoiceReader := bytes.NewReader(invoiceBytes)
 
-	return deserializeInvoice(invoiceReader)
+	invoice, err := deserializeInvoice(invoiceReader)
+	if err != nil {
+		return Invoice{}, err
+	}
+
+	ampIndex := invoices.Bucket(ampInvoiceIndexBucket)
+	if ampIndex != nil && ampIndex.Get(invoiceNum) != nil {
+		invoice.AMP = true
+	}
+
+	payAddrs := invoices.Bucket(invoicePayAddrBucket)
+	if payAddrs != nil {
+		copy(invoice.Terms.PaymentAddr[:], payAddrs.Get(invoiceNum))
+	}
+
+	return invoice, nil
 }
 
 func deserializeInvoice(r io.Reader) (Invoice, error) {
@@ -1120,9 +1593,10 @@ func deserializeHtlcs(r io.Reader) (map[CircuitKey]*InvoiceHTLC, error) {
 			htlc                    InvoiceHTLC
 			key                     CircuitKey
 			chanID                  uint64
-			state                   uint8
+			state, rejectReason     uint8
 			acceptTime, resolveTime uint64
 			amt                     uint64
+			amp                     = &record.AMP{}
 		)
 		tlvStream, err := tlv.NewStream(
 			tlv.MakePrimitiveRecord(chanIDType, &chanID),
@@ -1135,19 +1609,29 @@ func deserializeHtlcs(r io.Reader) (map[CircuitKey]*InvoiceHTLC, error) {
 			tlv.MakePrimitiveRecord(resolveTimeType, &resolveTime),
 			tlv.MakePrimitiveRecord(expiryHeightType, &htlc.Expiry),
 			tlv.MakePrimitiveRecord(stateType, &state),
+			tlv.MakePrimitiveRecord(rejectReasonType, &rejectReason),
+			newAMPHtlcRecord(amp),
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		if err := tlvStream.Decode(streamReader); err != nil {
+		parsedTypes, err := tlvStream.DecodeWithParsedTypes(
+			streamReader,
+		)
+		if err != nil {
 			return nil, err
 		}
 
+		if _, ok := parsedTypes[ampRecordType]; ok {
+			htlc.AMP = amp
+		}
+
 		key.ChanID = lnwire.NewShortChanIDFromInt(chanID)
 		htlc.AcceptTime = time.Unix(0, int64(acceptTime))
 		htlc.ResolveTime = time.Unix(0, int64(resolveTime))
 		htlc.State = HtlcState(state)
+		htlc.RejectReason = HtlcRejectReason(rejectReason)
 		htlc.Amt = lnwire.MilliAtom(amt)
 
 		htlcs[key] = &htlc
@@ -1156,6 +1640,17 @@ func deserializeHtlcs(r io.Reader) (map[CircuitKey]*InvoiceHTLC, error) {
 	return htlcs, nil
 }
 
+// newAMPHtlcRecord returns the tlv record used to serialize the AMP record of
+// an invoice htlc.
+func newAMPHtlcRecord(amp *record.AMP) tlv.Record {
+	ampRecord := amp.Record()
+
+	return tlv.MakeStaticRecord(
+		ampRecordType, amp, ampRecord.Size(), record.AMPEncoder,
+		record.AMPDecoder,
+	)
+}
+
 // copySlice allocates a new slice and copies the source into it.
 func copySlice(src []byte) []byte {
 	dest := make([]byte, len(src))
@@ -1179,6 +1674,7 @@ func copyInvoice(src *Invoice) *Invoice {
 		Htlcs: make(
 			map[CircuitKey]*InvoiceHTLC, len(src.Htlcs),
 		),
+		AMP: src.AMP,
 	}
 
 	for k, v := range src.Htlcs {
@@ -1190,8 +1686,9 @@ func copyInvoice(src *Invoice) *Invoice {
 
 // updateInvoice fetches the invoice, obtains the update descriptor from the
 // callback and applies the updates in a single db transaction.
-func (d *DB) updateInvoice(hash lntypes.Hash, invoices, settleIndex *bolt.Bucket,
-	invoiceNum []byte, callback InvoiceUpdateCallback) (*Invoice, error) {
+func (d *DB) updateInvoice(hash lntypes.Hash, invoices, invoiceIndex,
+	settleIndex *bolt.Bucket, invoiceNum []byte,
+	callback InvoiceUpdateCallback) (*Invoice, error) {
 
 	invoice, err := fetchInvoice(invoiceNum, invoices)
 	if err != nil {
@@ -1245,11 +1742,47 @@ func (d *DB) updateInvoice(hash lntypes.Hash, invoices, settleIndex *bolt.Bucket
 			Expiry:       htlcUpdate.Expiry,
 			AcceptHeight: uint32(htlcUpdate.AcceptHeight),
 			AcceptTime:   now,
+			AMP:          htlcUpdate.AMP,
 		}
-		if preUpdateState == ContractSettled {
+
+		// A rejected htlc is only recorded for reference. It is
+		// canceled right away and doesn't count towards the amount
+		// paid.
+		if htlcUpdate.RejectReason != HtlcRejectNone {
+			htlc.State = HtlcStateCanceled
+			htlc.ResolveTime = now
+			htlc.RejectReason = htlcUpdate.RejectReason
+			invoice.Htlcs[key] = htlc
+
+			continue
+		}
+
+		switch {
+		// An htlc paying to an AMP invoice carries its own preimage,
+		// so it is settled right away. Its payment hash is indexed so
+		// that it can be looked up again without its onion payload.
+		case htlcUpdate.AMP != nil:
+			if !invoice.AMP {
+				return nil, fmt.Errorf("amp htlc %v paying to "+
+					"non-amp invoice", key)
+			}
+
+			err := putAMPHtlcHash(
+				invoiceIndex, htlcUpdate.AMP.ChildHash(),
+				invoiceNum,
+			)
+			if err != nil {
+				return nil, err
+			}
+
 			htlc.State = HtlcStateSettled
 			htlc.ResolveTime = now
-		} else {
+
+		case preUpdateState == ContractSettled:
+			htlc.State = HtlcStateSettled
+			htlc.ResolveTime = now
+
+		default:
 			htlc.State = HtlcStateAccepted
 		}
 
@@ -1257,6 +1790,14 @@ func (d *DB) updateInvoice(hash lntypes.Hash, invoices, settleIndex *bolt.Bucket
 		invoice.AmtPaid += htlc.Amt
 	}
 
+	// An accepted invoice whose accepted htlcs were all canceled moves
+	// back to the open state, so that it can be paid again.
+	if invoice.Terms.State == ContractAccepted &&
+		!hasAcceptedHtlcs(invoice.Htlcs) {
+
+		invoice.Terms.State = ContractOpen
+	}
+
 	// If invoice moved to the settled state, update settle index and settle
 	// time.
 	if preUpdateState != invoice.Terms.State &&
@@ -1295,6 +1836,37 @@ func (d *DB) updateInvoice(hash lntypes.Hash, invoices, settleIndex *bolt.Bucket
 	return &invoice, nil
 }
 
+// hasAcceptedHtlcs returns true if any of the htlcs is in the accepted state.
+func hasAcceptedHtlcs(htlcs map[CircuitKey]*InvoiceHTLC) bool {
+	for _, htlc := range htlcs {
+		if htlc.State == HtlcStateAccepted {
+			return true
+		}
+	}
+
+	return false
+}
+
+// putAMPHtlcHash adds the payment hash of an htlc paying to an AMP invoice to
+// the payment hash index.
+func putAMPHtlcHash(invoiceIndex *bolt.Bucket, hash lntypes.Hash,
+	invoiceNum []byte) error {
+
+	existing := invoiceIndex.Get(hash[:])
+	switch {
+	case existing == nil:
+		return invoiceIndex.Put(hash[:], invoiceNum)
+
+	// The hash is already indexed if a previous htlc with the same AMP
+	// record paid to this invoice.
+	case bytes.Equal(existing, invoiceNum):
+		return nil
+
+	default:
+		return ErrDuplicateInvoice
+	}
+}
+
 func setSettleFields(settleIndex *bolt.Bucket, invoiceNum []byte,
 	invoice *Invoice, now time.Time) error {
 
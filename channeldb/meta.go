@@ -10,6 +10,11 @@ var (
 	// dbVersionKey is a boltdb key and it's used for storing/retrieving
 	// current database version.
 	dbVersionKey = []byte("dbp")
+
+	// migrationTargetKey is the key of the version a migration in progress
+	// brings the database to. It is only present while migrating, so its
+	// presence on startup reveals an interrupted migration.
+	migrationTargetKey = []byte("migration-target")
 )
 
 // Meta structure holds the database meta information.
@@ -76,3 +81,42 @@ func putDbVersion(metaBucket *bolt.Bucket, meta *Meta) error {
 	byteOrder.PutUint32(scratch, meta.DbVersionNumber)
 	return metaBucket.Put(dbVersionKey, scratch)
 }
+
+// fetchMigrationTarget returns the version the migration in progress brings
+// the database to, and whether a migration is in progress at all.
+func fetchMigrationTarget(tx *bolt.Tx) (uint32, bool) {
+	metaBucket := tx.Bucket(metaBucket)
+	if metaBucket == nil {
+		return 0, false
+	}
+
+	data := metaBucket.Get(migrationTargetKey)
+	if len(data) != 4 {
+		return 0, false
+	}
+
+	return byteOrder.Uint32(data), true
+}
+
+// putMigrationTarget records the version the migration starting brings the
+// database to.
+func putMigrationTarget(tx *bolt.Tx, target uint32) error {
+	metaBucket, err := tx.CreateBucketIfNotExists(metaBucket)
+	if err != nil {
+		return err
+	}
+
+	var scratch [4]byte
+	byteOrder.PutUint32(scratch[:], target)
+	return metaBucket.Put(migrationTargetKey, scratch[:])
+}
+
+// deleteMigrationTarget records that no migration is in progress.
+func deleteMigrationTarget(tx *bolt.Tx) error {
+	metaBucket := tx.Bucket(metaBucket)
+	if metaBucket == nil {
+		return nil
+	}
+
+	return metaBucket.Delete(migrationTargetKey)
+}
@@ -373,8 +373,8 @@ func deserializeHopMigration9(r io.Reader) (*route.Hop, error) {
 // this as otherwise, the current FetchPayments version will use the latest
 // decoding format. Note that we only need this for the
 // TestOutgoingPaymentsMigration migration test case.
-func (db *DB) fetchPaymentsMigration9() ([]*Payment, error) {
-	var payments []*Payment
+func (db *DB) fetchPaymentsMigration9() ([]*legacyPayment, error) {
+	var payments []*legacyPayment
 
 	err := db.View(func(tx *bolt.Tx) error {
 		paymentsBucket := tx.Bucket(paymentsRootBucket)
@@ -438,10 +438,10 @@ func (db *DB) fetchPaymentsMigration9() ([]*Payment, error) {
 	return payments, nil
 }
 
-func fetchPaymentMigration9(bucket *bolt.Bucket) (*Payment, error) {
+func fetchPaymentMigration9(bucket *bolt.Bucket) (*legacyPayment, error) {
 	var (
 		err error
-		p   = &Payment{}
+		p   = &legacyPayment{}
 	)
 
 	seqBytes := bucket.Get(paymentSequenceKey)
@@ -36,7 +36,9 @@ func migrateRouteSerialization(tx *bolt.Tx) error {
 
 	// Now that we have all the payment hashes, we can carry out the
 	// migration itself.
+	progress := newMigrationProgress("payments", len(payHashes))
 	for _, payHash := range payHashes {
+		progress.inc()
 		payHashBucket := rootPaymentBucket.Bucket(payHash)
 
 		// First, we'll migrate the main (non duplicate) payment to
@@ -77,6 +79,7 @@ func migrateRouteSerialization(tx *bolt.Tx) error {
 			}
 		}
 	}
+	progress.finish()
 
 	log.Infof("Migration of route/hop serialization complete!")
 
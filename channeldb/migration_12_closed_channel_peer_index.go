@@ -0,0 +1,71 @@
+package channeldb
+
+import (
+	"bytes"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrec/secp256k1/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/lnwire"
+	bolt "go.etcd.io/bbolt"
+)
+
+// migrateClosedChannelPeerIndex indexes all existing closed channels by the
+// public key of the remote peer.
+func migrateClosedChannelPeerIndex(tx *bolt.Tx) error {
+	log.Infof("Indexing closed channels by remote peer")
+
+	closedChanBucket := tx.Bucket(closedChannelBucket)
+	if closedChanBucket == nil {
+		return nil
+	}
+
+	// Collect the remote peer of every closed channel first, because it
+	// isn't safe to modify the database inside a ForEach loop.
+	type closedChannel struct {
+		chanID    []byte
+		remotePub *secp256k1.PublicKey
+	}
+
+	var closedChannels []closedChannel
+	err := closedChanBucket.ForEach(func(chanID, summary []byte) error {
+		// Only the remote peer is needed, so we'll read the serialized
+		// close summary up to it.
+		var (
+			chanPoint   wire.OutPoint
+			shortChanID lnwire.ShortChannelID
+			chainHash   chainhash.Hash
+			closingTXID chainhash.Hash
+			closeHeight uint32
+			remotePub   *secp256k1.PublicKey
+		)
+		err := ReadElements(bytes.NewReader(summary), &chanPoint,
+			&shortChanID, &chainHash, &closingTXID, &closeHeight,
+			&remotePub,
+		)
+		if err != nil {
+			return err
+		}
+
+		closedChannels = append(closedChannels, closedChannel{
+			chanID:    copySlice(chanID),
+			remotePub: remotePub,
+		})
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, c := range closedChannels {
+		err := putClosedChannelPeerIndex(tx, c.remotePub, c.chanID)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Infof("Indexed %v closed channels", len(closedChannels))
+
+	return nil
+}
@@ -0,0 +1,309 @@
+package channeldb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"github.com/decred/dcrlnd/lntypes"
+	bolt "go.etcd.io/bbolt"
+)
+
+// legacyPayment is a payment as it was stored before all of its htlc attempts
+// were recorded, when only its last payment attempt was kept.
+//
+// NOTE: Deprecated. Kept around for migration purposes.
+type legacyPayment struct {
+	sequenceNum uint64
+
+	// Status is the current PaymentStatus of this payment.
+	Status PaymentStatus
+
+	// Info holds all static information about this payment.
+	Info *PaymentCreationInfo
+
+	// Attempt is the information about the last payment attempt made.
+	//
+	// NOTE: Can be nil if no attempt is yet made.
+	Attempt *PaymentAttemptInfo
+
+	// PaymentPreimage is the preimage of a successful payment.
+	PaymentPreimage *lntypes.Preimage
+
+	// Failure is a failure reason code indicating the reason the payment
+	// failed.
+	Failure *FailureReason
+}
+
+// migratePaymentHTLCs moves the last payment attempt of every payment, which
+// was the only one that was kept, to the htlc attempts of the payment. The
+// attempt is marked as settled or failed according to the status of the
+// payment. As the times of the attempt and its resolution weren't stored, they
+// are left unset.
+func migratePaymentHTLCs(tx *bolt.Tx) error {
+	log.Infof("Migrating payment attempts to htlc attempts")
+
+	rootPaymentBucket := tx.Bucket(paymentsRootBucket)
+	if rootPaymentBucket == nil {
+		return nil
+	}
+
+	// As we can't mutate a bucket while we're iterating over it with
+	// ForEach, we'll need to collect all the known payment hashes in
+	// memory first.
+	var payHashes [][]byte
+	err := rootPaymentBucket.ForEach(func(k, v []byte) error {
+		if v != nil {
+			return nil
+		}
+
+		payHashes = append(payHashes, k)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	progress := newMigrationProgress("payments", len(payHashes))
+	for _, payHash := range payHashes {
+		progress.inc()
+		payHashBucket := rootPaymentBucket.Bucket(payHash)
+
+		// First, we'll migrate the main (non duplicate) payment to
+		// this hash.
+		if err := migratePaymentAttempt(payHashBucket); err != nil {
+			return err
+		}
+
+		// Then the duplicate payments to the same payment hash, if
+		// any.
+		dupBucket := payHashBucket.Bucket(paymentDuplicateBucket)
+		if dupBucket == nil {
+			continue
+		}
+
+		var dupSeqNos [][]byte
+		err = dupBucket.ForEach(func(k, v []byte) error {
+			dupSeqNos = append(dupSeqNos, k)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, seqNo := range dupSeqNos {
+			dupPayHashBucket := dupBucket.Bucket(seqNo)
+			err := migratePaymentAttempt(dupPayHashBucket)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	progress.finish()
+
+	log.Infof("Migration of payment attempts complete!")
+
+	return nil
+}
+
+// migratePaymentAttempt moves the payment attempt found in the passed payment
+// bucket, if any, to the htlc attempts of the payment.
+func migratePaymentAttempt(bucket *bolt.Bucket) error {
+	attemptBytes := bucket.Get(paymentAttemptInfoKey)
+	if attemptBytes == nil {
+		return nil
+	}
+
+	attempt, err := deserializePaymentAttemptInfo(
+		bytes.NewReader(attemptBytes),
+	)
+	if err != nil {
+		return err
+	}
+
+	htlcsBucket, err := bucket.CreateBucketIfNotExists(paymentHtlcsBucket)
+	if err != nil {
+		return err
+	}
+
+	// The attempt may already have been recorded among the htlc attempts,
+	// in which case only the legacy attempt info is removed.
+	attemptKey := htlcAttemptKey(attempt.PaymentID)
+	if htlcsBucket.Bucket(attemptKey) != nil {
+		return bucket.Delete(paymentAttemptInfoKey)
+	}
+
+	attemptBucket, err := htlcsBucket.CreateBucket(attemptKey)
+	if err != nil {
+		return err
+	}
+
+	var b bytes.Buffer
+	err = serializeHTLCAttempt(&b, &HTLCAttempt{
+		PaymentAttemptInfo: *attempt,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = attemptBucket.Put(htlcAttemptInfoKey, b.Bytes())
+	if err != nil {
+		return err
+	}
+
+	// The last attempt of a completed payment is the one that settled it,
+	// or that failed last.
+	b.Reset()
+	switch fetchPaymentStatus(bucket) {
+	case StatusSucceeded:
+		settle := &HTLCSettleInfo{}
+		copy(settle.Preimage[:], bucket.Get(paymentSettleInfoKey))
+
+		if err := serializeHTLCSettleInfo(&b, settle); err != nil {
+			return err
+		}
+
+		err = attemptBucket.Put(htlcSettleInfoKey, b.Bytes())
+		if err != nil {
+			return err
+		}
+
+	case StatusFailed:
+		err := serializeHTLCFailInfo(&b, &HTLCFailInfo{
+			Reason: HTLCFailUnknown,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = attemptBucket.Put(htlcFailInfoKey, b.Bytes())
+		if err != nil {
+			return err
+		}
+	}
+
+	return bucket.Delete(paymentAttemptInfoKey)
+}
+
+// fetchPaymentsMigration12 returns all sent payments found in the DB as they
+// were stored as of migration #12, with only the last payment attempt of each.
+// We need this as the current FetchPayments version no longer reads the
+// legacy payment attempt. Note that we only need this for migration test
+// cases.
+func (db *DB) fetchPaymentsMigration12() ([]*legacyPayment, error) {
+	var payments []*legacyPayment
+
+	err := db.View(func(tx *bolt.Tx) error {
+		paymentsBucket := tx.Bucket(paymentsRootBucket)
+		if paymentsBucket == nil {
+			return nil
+		}
+
+		return paymentsBucket.ForEach(func(k, v []byte) error {
+			bucket := paymentsBucket.Bucket(k)
+			if bucket == nil {
+				// We only expect sub-buckets to be found in
+				// this top-level bucket.
+				return fmt.Errorf("non bucket element in " +
+					"payments bucket")
+			}
+
+			p, err := fetchPaymentMigration12(bucket)
+			if err != nil {
+				return err
+			}
+
+			payments = append(payments, p)
+
+			// Duplicate payments are found in a sub-bucket indexed
+			// by their sequence number if available.
+			dup := bucket.Bucket(paymentDuplicateBucket)
+			if dup == nil {
+				return nil
+			}
+
+			return dup.ForEach(func(k, v []byte) error {
+				subBucket := dup.Bucket(k)
+				if subBucket == nil {
+					return fmt.Errorf("non bucket element" +
+						"in duplicate bucket")
+				}
+
+				p, err := fetchPaymentMigration12(subBucket)
+				if err != nil {
+					return err
+				}
+
+				payments = append(payments, p)
+				return nil
+			})
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Before returning, sort the payments by their sequence number.
+	sort.Slice(payments, func(i, j int) bool {
+		return payments[i].sequenceNum < payments[j].sequenceNum
+	})
+
+	return payments, nil
+}
+
+func fetchPaymentMigration12(bucket *bolt.Bucket) (*legacyPayment, error) {
+	var (
+		err error
+		p   = &legacyPayment{}
+	)
+
+	seqBytes := bucket.Get(paymentSequenceKey)
+	if seqBytes == nil {
+		return nil, fmt.Errorf("sequence number not found")
+	}
+
+	p.sequenceNum = binary.BigEndian.Uint64(seqBytes)
+
+	// Get the payment status.
+	p.Status = fetchPaymentStatus(bucket)
+
+	// Get the PaymentCreationInfo.
+	b := bucket.Get(paymentCreationInfoKey)
+	if b == nil {
+		return nil, fmt.Errorf("creation info not found")
+	}
+
+	p.Info, err = deserializePaymentCreationInfo(bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the PaymentAttemptInfo. This can be unset.
+	b = bucket.Get(paymentAttemptInfoKey)
+	if b != nil {
+		p.Attempt, err = deserializePaymentAttemptInfo(
+			bytes.NewReader(b),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Get the payment preimage. This is only found for completed payments.
+	b = bucket.Get(paymentSettleInfoKey)
+	if b != nil {
+		var preimg lntypes.Preimage
+		copy(preimg[:], b[:])
+		p.PaymentPreimage = &preimg
+	}
+
+	// Get failure reason if available.
+	b = bucket.Get(paymentFailInfoKey)
+	if b != nil {
+		reason := FailureReason(b[0])
+		p.Failure = &reason
+	}
+
+	return p, nil
+}
@@ -384,7 +384,9 @@ func migrateEdgePolicies(tx *bolt.Tx) error {
 	}
 
 	// Iterate over all channels and check both edge policies.
+	progress := newMigrationProgress("channels", edgeIndex.Stats().KeyN)
 	err := edgeIndex.ForEach(func(chanID, edgeInfoBytes []byte) error {
+		progress.inc()
 		infoReader := bytes.NewReader(edgeInfoBytes)
 		edgeInfo, err := deserializeChanEdgeInfo(infoReader)
 		if err != nil {
@@ -405,6 +407,7 @@ func migrateEdgePolicies(tx *bolt.Tx) error {
 	if err != nil {
 		return fmt.Errorf("unable to update edge policies: %v", err)
 	}
+	progress.finish()
 
 	log.Infof("Migration of edge policies complete!")
 
@@ -783,11 +786,13 @@ func migrateOutgoingPayments(tx *bolt.Tx) error {
 		return pub, nil
 	}
 
+	progress := newMigrationProgress("payments", oldPayments.Stats().KeyN)
 	err = oldPayments.ForEach(func(k, v []byte) error {
 		// Ignores if it is sub-bucket.
 		if v == nil {
 			return nil
 		}
+		progress.inc()
 
 		// Read the old payment format.
 		r := bytes.NewReader(v)
@@ -917,6 +922,8 @@ func migrateOutgoingPayments(tx *bolt.Tx) error {
 		return err
 	}
 
+	progress.finish()
+
 	// To continue producing unique sequence numbers, we set the sequence
 	// of the new bucket to that of the old one.
 	seq := oldPayments.Sequence()
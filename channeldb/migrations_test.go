@@ -771,7 +771,7 @@ func TestPaymentRouteSerialization(t *testing.T) {
 	}
 
 	const numPayments = 4
-	var oldPayments []*Payment
+	var oldPayments []*legacyPayment
 
 	sharedPayAttempt := PaymentAttemptInfo{
 		PaymentID:  1,
@@ -884,7 +884,7 @@ func TestPaymentRouteSerialization(t *testing.T) {
 						"info: %v", err)
 				}
 
-				oldPayments = append(oldPayments, &Payment{
+				oldPayments = append(oldPayments, &legacyPayment{
 					Info:    payInfo,
 					Attempt: &sharedPayAttempt,
 				})
@@ -898,7 +898,7 @@ func TestPaymentRouteSerialization(t *testing.T) {
 	}
 
 	afterMigrationFunc := func(d *DB) {
-		newPayments, err := d.FetchPayments()
+		newPayments, err := d.fetchPaymentsMigration12()
 		if err != nil {
 			t.Fatalf("unable to fetch new payments: %v", err)
 		}
@@ -950,3 +950,256 @@ func TestPaymentRouteSerialization(t *testing.T) {
 		migrateRouteSerialization,
 		false)
 }
+
+// TestMigrateClosedChannelPeerIndex asserts that existing closed channels are
+// added to the index of their remote peer.
+func TestMigrateClosedChannelPeerIndex(t *testing.T) {
+	t.Parallel()
+
+	chanState, err := createTestChannelState(nil)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+
+	var chanPointBuf bytes.Buffer
+	err = writeOutpoint(&chanPointBuf, &chanState.FundingOutpoint)
+	if err != nil {
+		t.Fatalf("unable to write outpoint: %v", err)
+	}
+
+	closeSummary := &ChannelCloseSummary{
+		ChanPoint:   chanState.FundingOutpoint,
+		ShortChanID: chanState.ShortChanID(),
+		ChainHash:   chanState.ChainHash,
+		ClosingTXID: testTx.TxHash(),
+		CloseHeight: 100,
+		RemotePub:   chanState.IdentityPub,
+		Capacity:    chanState.Capacity,
+		CloseType:   CooperativeClose,
+	}
+
+	// Before the migration, the close summary is only stored in the
+	// closed channel bucket.
+	beforeMigrationFunc := func(d *DB) {
+		err := d.Update(func(tx *bolt.Tx) error {
+			closedChanBucket, err := tx.CreateBucketIfNotExists(
+				closedChannelBucket,
+			)
+			if err != nil {
+				return err
+			}
+
+			var b bytes.Buffer
+			err = serializeChannelCloseSummary(&b, closeSummary)
+			if err != nil {
+				return err
+			}
+
+			return closedChanBucket.Put(
+				chanPointBuf.Bytes(), b.Bytes(),
+			)
+		})
+		if err != nil {
+			t.Fatalf("unable to add close summary: %v", err)
+		}
+	}
+
+	// After the migration, the closed channel should be found through the
+	// index of the peer.
+	afterMigrationFunc := func(d *DB) {
+		meta, err := d.FetchMeta(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if meta.DbVersionNumber != 1 {
+			t.Fatal("migration 'closed channel peer index' wasn't " +
+				"applied")
+		}
+
+		summaries, err := d.QueryClosedChannels(ChannelQuery{
+			Peer: chanState.IdentityPub,
+		})
+		if err != nil {
+			t.Fatalf("unable to query closed channels: %v", err)
+		}
+
+		if len(summaries) != 1 ||
+			summaries[0].ChanPoint != closeSummary.ChanPoint {
+
+			t.Fatalf("unexpected closed channels: %v",
+				spew.Sdump(summaries))
+		}
+	}
+
+	applyMigration(t,
+		beforeMigrationFunc,
+		afterMigrationFunc,
+		migrateClosedChannelPeerIndex,
+		false)
+}
+
+// TestMigratePaymentHTLCs asserts that the last payment attempt of existing
+// payments is moved to their htlc attempts, and resolved according to the
+// status of the payment.
+func TestMigratePaymentHTLCs(t *testing.T) {
+	t.Parallel()
+
+	type testPayment struct {
+		info     *PaymentCreationInfo
+		attempt  *PaymentAttemptInfo
+		preimage lntypes.Preimage
+		status   PaymentStatus
+	}
+
+	var payments []testPayment
+	for _, status := range []PaymentStatus{
+		StatusSucceeded, StatusFailed, StatusInFlight,
+	} {
+		info, attempt, preimage, err := genInfo()
+		if err != nil {
+			t.Fatalf("unable to generate payment: %v", err)
+		}
+
+		payments = append(payments, testPayment{
+			info:     info,
+			attempt:  attempt,
+			preimage: preimage,
+			status:   status,
+		})
+	}
+
+	// Store the payments along with their attempt, the way they were
+	// stored before htlc attempts were recorded.
+	beforeMigrationFunc := func(d *DB) {
+		err := d.Update(func(tx *bolt.Tx) error {
+			for i, p := range payments {
+				bucket, err := createPaymentBucket(
+					tx, p.info.PaymentHash,
+				)
+				if err != nil {
+					return err
+				}
+
+				var seqNum [8]byte
+				byteOrder.PutUint64(seqNum[:], uint64(i+1))
+				err = bucket.Put(paymentSequenceKey, seqNum[:])
+				if err != nil {
+					return err
+				}
+
+				var b bytes.Buffer
+				err = serializePaymentCreationInfo(&b, p.info)
+				if err != nil {
+					return err
+				}
+				err = bucket.Put(
+					paymentCreationInfoKey, b.Bytes(),
+				)
+				if err != nil {
+					return err
+				}
+
+				b.Reset()
+				err = serializePaymentAttemptInfo(&b, p.attempt)
+				if err != nil {
+					return err
+				}
+				err = bucket.Put(
+					paymentAttemptInfoKey, b.Bytes(),
+				)
+				if err != nil {
+					return err
+				}
+
+				switch p.status {
+				case StatusSucceeded:
+					err = bucket.Put(
+						paymentSettleInfoKey,
+						p.preimage[:],
+					)
+
+				case StatusFailed:
+					reason := byte(FailureReasonNoRoute)
+					err = bucket.Put(
+						paymentFailInfoKey, []byte{reason},
+					)
+				}
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("unable to create test payments: %v", err)
+		}
+	}
+
+	// After the migration, every payment should have its attempt as its
+	// only htlc attempt.
+	afterMigrationFunc := func(d *DB) {
+		meta, err := d.FetchMeta(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if meta.DbVersionNumber != 1 {
+			t.Fatal("migration 'payment htlcs' wasn't applied")
+		}
+
+		pControl := NewPaymentControl(d)
+		for _, p := range payments {
+			payment, err := pControl.FetchPayment(p.info.PaymentHash)
+			if err != nil {
+				t.Fatalf("unable to fetch payment: %v", err)
+			}
+
+			if payment.Status != p.status {
+				t.Fatalf("expected status %v, got %v",
+					p.status, payment.Status)
+			}
+
+			expectedHTLC := HTLCAttempt{
+				PaymentAttemptInfo: *p.attempt,
+			}
+			switch p.status {
+			case StatusSucceeded:
+				expectedHTLC.Settle = &HTLCSettleInfo{
+					Preimage: p.preimage,
+				}
+
+			case StatusFailed:
+				expectedHTLC.Failure = &HTLCFailInfo{
+					Reason: HTLCFailUnknown,
+				}
+			}
+
+			expectedHTLCs := []HTLCAttempt{expectedHTLC}
+			if !reflect.DeepEqual(payment.HTLCs, expectedHTLCs) {
+				t.Fatalf("unexpected htlc attempts: "+
+					"expected %v, got %v",
+					spew.Sdump(expectedHTLCs),
+					spew.Sdump(payment.HTLCs))
+			}
+		}
+
+		// The legacy attempt info should be gone.
+		legacyPayments, err := d.fetchPaymentsMigration12()
+		if err != nil {
+			t.Fatalf("unable to fetch legacy payments: %v", err)
+		}
+		for _, p := range legacyPayments {
+			if p.Attempt != nil {
+				t.Fatalf("legacy attempt info wasn't removed")
+			}
+		}
+	}
+
+	applyMigration(t,
+		beforeMigrationFunc,
+		afterMigrationFunc,
+		migratePaymentHTLCs,
+		false)
+}
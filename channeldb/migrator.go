@@ -0,0 +1,95 @@
+package channeldb
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	// migrationProgressInterval is the minimum interval between two
+	// reports of the progress of a long migration.
+	migrationProgressInterval = 10 * time.Second
+)
+
+// errDryRunRollback is returned from within the transaction applying the
+// migrations in dry run mode, in order to roll it back.
+var errDryRunRollback = errors.New("dry run migration rollback")
+
+// migrationBackupPath returns the path of the copy of the database taken
+// before migrating it from the given version.
+func migrationBackupPath(dbPath string, version uint32) string {
+	return filepath.Join(dbPath, fmt.Sprintf("%s.v%d.bak", dbName, version))
+}
+
+// backup writes a consistent copy of the database, taken from within a read
+// transaction, next to the database file. The copy is named after the current
+// version of the database so that restoring it is only a matter of renaming
+// it, then starting the previous release of dcrlnd.
+func (d *DB) backup(version uint32) (string, error) {
+	path := migrationBackupPath(d.dbPath, version)
+	err := d.View(func(tx *bolt.Tx) error {
+		return writeFileAtomic(path, func(w io.Writer) error {
+			_, err := tx.WriteTo(w)
+			return err
+		})
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+// migrationProgress periodically reports the progress of a migration
+// rewriting a large number of items, such as payments or graph edges.
+type migrationProgress struct {
+	name       string
+	total      int
+	done       int
+	start      time.Time
+	lastReport time.Time
+}
+
+// newMigrationProgress starts reporting the progress of the migration of the
+// given number of items of the given kind.
+func newMigrationProgress(name string, total int) *migrationProgress {
+	now := time.Now()
+	log.Infof("Migrating %d %s", total, name)
+
+	return &migrationProgress{
+		name:       name,
+		total:      total,
+		start:      now,
+		lastReport: now,
+	}
+}
+
+// inc records the migration of one more item, reporting the progress if it
+// wasn't for a while.
+func (p *migrationProgress) inc() {
+	p.done++
+
+	now := time.Now()
+	if now.Sub(p.lastReport) < migrationProgressInterval {
+		return
+	}
+	p.lastReport = now
+
+	var percent float64
+	if p.total > 0 {
+		percent = float64(p.done) * 100 / float64(p.total)
+	}
+	log.Infof("Migrated %d/%d %s (%.1f%%)", p.done, p.total, p.name,
+		percent)
+}
+
+// finish reports the completion of the migration.
+func (p *migrationProgress) finish() {
+	log.Infof("Migrated %d %s in %v", p.done, p.name,
+		time.Since(p.start).Round(time.Millisecond))
+}
@@ -0,0 +1,187 @@
+package channeldb
+
+import (
+	"errors"
+	"testing"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	testMigrationBucket = []byte("migrationbucket")
+	testMigrationKey    = []byte("migrationkey")
+)
+
+// putTestMigrationKey is a migration storing the given value in the test
+// bucket.
+func putTestMigrationKey(value []byte) migration {
+	return func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(testMigrationBucket)
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put(testMigrationKey, value)
+	}
+}
+
+// fetchTestMigrationKey returns the value stored in the test bucket, if any.
+func fetchTestMigrationKey(t *testing.T, d *DB) []byte {
+	var value []byte
+	err := d.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(testMigrationBucket)
+		if bucket != nil {
+			value = append(value, bucket.Get(testMigrationKey)...)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to read test key: %v", err)
+	}
+
+	return value
+}
+
+// resetTestDBVersion sets the version of the test database to 0.
+func resetTestDBVersion(t *testing.T, d *DB) {
+	if err := d.PutMeta(&Meta{DbVersionNumber: 0}); err != nil {
+		t.Fatalf("unable to store meta data: %v", err)
+	}
+}
+
+// TestMigrationResume asserts that the database is backed up before being
+// migrated, and that a migration interrupted by a failure resumes from the
+// last migration fully applied.
+func TestMigrationResume(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resetTestDBVersion(t, cdb)
+
+	var firstApplied int
+	firstMigration := func(tx *bolt.Tx) error {
+		firstApplied++
+		return putTestMigrationKey([]byte("first"))(tx)
+	}
+	failingMigration := func(tx *bolt.Tx) error {
+		if err := putTestMigrationKey([]byte("failed"))(tx); err != nil {
+			return err
+		}
+		return errors.New("migration failed")
+	}
+
+	versions := []version{
+		{number: 0},
+		{number: 1, migration: firstMigration},
+		{number: 2, migration: failingMigration},
+	}
+	if err := cdb.syncVersions(versions); err == nil {
+		t.Fatalf("expected migration to fail")
+	}
+
+	// The first migration was committed, while the second one was rolled
+	// back, leaving the migration in progress.
+	meta, err := cdb.FetchMeta(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if meta.DbVersionNumber != 1 {
+		t.Fatalf("expected db version 1, got %v", meta.DbVersionNumber)
+	}
+	if value := fetchTestMigrationKey(t, cdb); string(value) != "first" {
+		t.Fatalf("unexpected value after failed migration: %s", value)
+	}
+	err = cdb.View(func(tx *bolt.Tx) error {
+		target, ok := fetchMigrationTarget(tx)
+		if !ok || target != 2 {
+			return errors.New("migration not in progress")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The database was backed up at its version before the migration.
+	if !fileExists(migrationBackupPath(cdb.dbPath, 0)) {
+		t.Fatalf("database not backed up before migration")
+	}
+
+	// Once fixed, the migration resumes from the second migration.
+	versions[2].migration = putTestMigrationKey([]byte("second"))
+	if err := cdb.syncVersions(versions); err != nil {
+		t.Fatalf("unable to resume migration: %v", err)
+	}
+
+	meta, err = cdb.FetchMeta(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if meta.DbVersionNumber != 2 {
+		t.Fatalf("expected db version 2, got %v", meta.DbVersionNumber)
+	}
+	if firstApplied != 1 {
+		t.Fatalf("first migration applied %d times", firstApplied)
+	}
+	if value := fetchTestMigrationKey(t, cdb); string(value) != "second" {
+		t.Fatalf("unexpected value after migration: %s", value)
+	}
+	err = cdb.View(func(tx *bolt.Tx) error {
+		if _, ok := fetchMigrationTarget(tx); ok {
+			return errors.New("migration still in progress")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestMigrationDryRun asserts that migrations applied in dry run mode leave
+// the database untouched, reporting whether they succeeded.
+func TestMigrationDryRun(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resetTestDBVersion(t, cdb)
+	cdb.dryRunMigration = true
+
+	versions := []version{
+		{number: 0},
+		{number: 1, migration: putTestMigrationKey([]byte("first"))},
+	}
+	if err := cdb.syncVersions(versions); err != ErrDryRunMigrationOK {
+		t.Fatalf("expected ErrDryRunMigrationOK, got %v", err)
+	}
+
+	meta, err := cdb.FetchMeta(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if meta.DbVersionNumber != 0 {
+		t.Fatalf("expected db version 0, got %v", meta.DbVersionNumber)
+	}
+	if value := fetchTestMigrationKey(t, cdb); value != nil {
+		t.Fatalf("dry run migration modified database: %s", value)
+	}
+	if fileExists(migrationBackupPath(cdb.dbPath, 0)) {
+		t.Fatalf("database backed up during dry run")
+	}
+
+	// A failing migration is reported as is.
+	migrationErr := errors.New("migration failed")
+	versions[1].migration = func(tx *bolt.Tx) error {
+		return migrationErr
+	}
+	if err := cdb.syncVersions(versions); err != migrationErr {
+		t.Fatalf("expected migration error, got %v", err)
+	}
+}
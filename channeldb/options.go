@@ -10,6 +10,11 @@ const (
 	// in order to reply to gossip queries. This produces a cache size of
 	// around 40MB.
 	DefaultChannelCacheSize = 20000
+
+	// DefaultAutoCompactMinFreeRatio is the default ratio of free pages of
+	// the database file above which it is compacted on startup, when
+	// enabled.
+	DefaultAutoCompactMinFreeRatio = 0.25
 )
 
 // Options holds parameters for tuning and customizing a channeldb.DB.
@@ -26,6 +31,23 @@ type Options struct {
 	// freelist to disk, resulting in improved performance at the expense of
 	// increased startup time.
 	NoFreelistSync bool
+
+	// MigrationBackup, if true, copies the database file aside before
+	// applying any pending migration.
+	MigrationBackup bool
+
+	// DryRunMigration, if true, applies the pending migrations within a
+	// transaction which is then rolled back, opening the database failing
+	// with ErrDryRunMigrationOK if they succeeded.
+	DryRunMigration bool
+
+	// AutoCompact, if true, compacts the database file when opening it if
+	// the ratio of its free pages is at least AutoCompactMinFreeRatio.
+	AutoCompact bool
+
+	// AutoCompactMinFreeRatio is the ratio of free pages of the database
+	// file above which it is compacted, if AutoCompact is set.
+	AutoCompactMinFreeRatio float64
 }
 
 // DefaultOptions returns an Options populated with default values.
@@ -34,6 +56,9 @@ func DefaultOptions() Options {
 		RejectCacheSize:  DefaultRejectCacheSize,
 		ChannelCacheSize: DefaultChannelCacheSize,
 		NoFreelistSync:   true,
+		MigrationBackup:  true,
+
+		AutoCompactMinFreeRatio: DefaultAutoCompactMinFreeRatio,
 	}
 }
 
@@ -60,3 +85,28 @@ func OptionSetSyncFreelist(b bool) OptionModifier {
 		o.NoFreelistSync = !b
 	}
 }
+
+// OptionSetMigrationBackup sets whether the database is backed up before
+// migrating it.
+func OptionSetMigrationBackup(b bool) OptionModifier {
+	return func(o *Options) {
+		o.MigrationBackup = b
+	}
+}
+
+// OptionAutoCompact sets whether the database file is compacted when opening
+// it if the ratio of its free pages is at least minFreeRatio.
+func OptionAutoCompact(b bool, minFreeRatio float64) OptionModifier {
+	return func(o *Options) {
+		o.AutoCompact = b
+		o.AutoCompactMinFreeRatio = minFreeRatio
+	}
+}
+
+// OptionDryRunMigration sets whether the pending migrations are only
+// validated rather than applied.
+func OptionDryRunMigration(b bool) OptionModifier {
+	return func(o *Options) {
+		o.DryRunMigration = b
+	}
+}
@@ -35,6 +35,15 @@ var (
 	// existing state of a payment.
 	ErrUnknownPaymentStatus = errors.New("unknown payment status")
 
+	// ErrAttemptNotFound is returned when the htlc attempt to resolve
+	// wasn't registered for the payment.
+	ErrAttemptNotFound = errors.New("htlc attempt not found")
+
+	// ErrAttemptAlreadyResolved is returned in the event we attempt to
+	// resolve an htlc attempt that was already settled or failed.
+	ErrAttemptAlreadyResolved = errors.New("htlc attempt is already " +
+		"resolved")
+
 	// errNoAttemptInfo is returned when no attempt info is stored yet.
 	errNoAttemptInfo = errors.New("unable to find attempt info for " +
 		"inflight payment")
@@ -125,17 +134,22 @@ func (p *PaymentControl) InitPayment(paymentHash lntypes.Hash,
 			return err
 		}
 
-		// We'll delete any lingering attempt info to start with, in
+		// We'll delete any lingering htlc attempts to start with, in
 		// case we are initializing a payment that was attempted
 		// earlier, but left in a state where we could retry.
-		err = bucket.Delete(paymentAttemptInfoKey)
-		if err != nil {
+		err = bucket.DeleteBucket(paymentHtlcsBucket)
+		if err != nil && err != bolt.ErrBucketNotFound {
 			return err
 		}
 
 		// Also delete any lingering failure info now that we are
 		// re-attempting.
-		return bucket.Delete(paymentFailInfoKey)
+		err = bucket.Delete(paymentFailInfoKey)
+		if err != nil && err != bolt.ErrBucketNotFound {
+			return err
+		}
+
+		return nil
 	})
 	if err != nil {
 		return err
@@ -144,20 +158,25 @@ func (p *PaymentControl) InitPayment(paymentHash lntypes.Hash,
 	return updateErr
 }
 
-// RegisterAttempt atomically records the provided PaymentAttemptInfo to the
-// DB.
+// RegisterAttempt atomically adds the provided PaymentAttemptInfo to the htlc
+// attempts of the payment in the DB, along with the time at which it was made.
+// Registering an attempt doesn't affect the other attempts of the payment.
 func (p *PaymentControl) RegisterAttempt(paymentHash lntypes.Hash,
 	attempt *PaymentAttemptInfo) error {
 
 	// Serialize the information before opening the db transaction.
-	var a bytes.Buffer
-	if err := serializePaymentAttemptInfo(&a, attempt); err != nil {
+	var h bytes.Buffer
+	err := serializeHTLCAttempt(&h, &HTLCAttempt{
+		PaymentAttemptInfo: *attempt,
+		AttemptTime:        p.db.now(),
+	})
+	if err != nil {
 		return err
 	}
-	attemptBytes := a.Bytes()
+	htlcBytes := h.Bytes()
 
 	var updateErr error
-	err := p.db.Batch(func(tx *bolt.Tx) error {
+	err = p.db.Batch(func(tx *bolt.Tx) error {
 		// Reset the update error, to avoid carrying over an error
 		// from a previous execution of the batched db transaction.
 		updateErr = nil
@@ -177,8 +196,78 @@ func (p *PaymentControl) RegisterAttempt(paymentHash lntypes.Hash,
 			return nil
 		}
 
-		// Add the payment attempt to the payments bucket.
-		return bucket.Put(paymentAttemptInfoKey, attemptBytes)
+		// Record the attempt among the htlc attempts of the payment.
+		htlcsBucket, err := bucket.CreateBucketIfNotExists(
+			paymentHtlcsBucket,
+		)
+		if err != nil {
+			return err
+		}
+		attemptBucket, err := htlcsBucket.CreateBucketIfNotExists(
+			htlcAttemptKey(attempt.PaymentID),
+		)
+		if err != nil {
+			return err
+		}
+
+		return attemptBucket.Put(htlcAttemptInfoKey, htlcBytes)
+	})
+	if err != nil {
+		return err
+	}
+
+	return updateErr
+}
+
+// FailAttempt records the failure of the htlc attempt with the given id. The
+// fail time of the attempt is set to the current time. The payment itself
+// remains in flight, as another attempt may still be made for it.
+func (p *PaymentControl) FailAttempt(paymentHash lntypes.Hash,
+	attemptID uint64, failInfo *HTLCFailInfo) error {
+
+	info := *failInfo
+	info.FailTime = p.db.now()
+
+	var b bytes.Buffer
+	if err := serializeHTLCFailInfo(&b, &info); err != nil {
+		return err
+	}
+	failBytes := b.Bytes()
+
+	var updateErr error
+	err := p.db.Batch(func(tx *bolt.Tx) error {
+		// Reset the update error, to avoid carrying over an error
+		// from a previous execution of the batched db transaction.
+		updateErr = nil
+
+		bucket, err := fetchPaymentBucket(tx, paymentHash)
+		if err == ErrPaymentNotInitiated {
+			updateErr = ErrPaymentNotInitiated
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		// We can only fail the attempts of in-flight payments.
+		if err := ensureInFlight(bucket); err != nil {
+			updateErr = err
+			return nil
+		}
+
+		attemptBucket := fetchHtlcAttemptBucket(bucket, attemptID)
+		if attemptBucket == nil {
+			updateErr = ErrAttemptNotFound
+			return nil
+		}
+
+		if attemptBucket.Get(htlcSettleInfoKey) != nil ||
+			attemptBucket.Get(htlcFailInfoKey) != nil {
+
+			updateErr = ErrAttemptAlreadyResolved
+			return nil
+		}
+
+		return attemptBucket.Put(htlcFailInfoKey, failBytes)
 	})
 	if err != nil {
 		return err
@@ -190,15 +279,27 @@ func (p *PaymentControl) RegisterAttempt(paymentHash lntypes.Hash,
 // Success transitions a payment into the Succeeded state. After invoking this
 // method, InitPayment should always return an error to prevent us from making
 // duplicate payments to the same payment hash. The provided preimage is
-// atomically saved to the DB for record keeping.
+// atomically saved to the DB for record keeping, and all htlc attempts of the
+// payment that are still in flight are marked as settled. The route of the
+// last of them is returned.
 func (p *PaymentControl) Success(paymentHash lntypes.Hash,
 	preimage lntypes.Preimage) (*route.Route, error) {
 
+	var b bytes.Buffer
+	err := serializeHTLCSettleInfo(&b, &HTLCSettleInfo{
+		Preimage:   preimage,
+		SettleTime: p.db.now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	settleBytes := b.Bytes()
+
 	var (
 		updateErr error
 		route     *route.Route
 	)
-	err := p.db.Batch(func(tx *bolt.Tx) error {
+	err = p.db.Batch(func(tx *bolt.Tx) error {
 		// Reset the update error, to avoid carrying over an error
 		// from a previous execution of the batched db transaction.
 		updateErr = nil
@@ -224,13 +325,30 @@ func (p *PaymentControl) Success(paymentHash lntypes.Hash,
 			return err
 		}
 
-		// Retrieve attempt info for the notification.
-		attempt, err := fetchPaymentAttempt(bucket)
+		// The htlc attempts that are still in flight are the ones
+		// that reached the destination, so we'll record their settle
+		// as well.
+		inFlight, err := fetchInFlightHtlcAttempts(bucket)
 		if err != nil {
 			return err
 		}
+		if len(inFlight) == 0 {
+			return errNoAttemptInfo
+		}
+
+		for _, htlc := range inFlight {
+			attemptBucket := fetchHtlcAttemptBucket(
+				bucket, htlc.PaymentID,
+			)
+			err := attemptBucket.Put(htlcSettleInfoKey, settleBytes)
+			if err != nil {
+				return err
+			}
+		}
 
-		route = &attempt.Route
+		// Retrieve the route of the last attempt for the
+		// notification.
+		route = &inFlight[len(inFlight)-1].Route
 
 		return nil
 	})
@@ -278,13 +396,14 @@ func (p *PaymentControl) Fail(paymentHash lntypes.Hash,
 			return err
 		}
 
-		// Retrieve attempt info for the notification, if available.
-		attempt, err := fetchPaymentAttempt(bucket)
-		if err != nil && err != errNoAttemptInfo {
+		// Retrieve the route of the last attempt for the
+		// notification, if available.
+		htlcs, err := fetchHtlcAttempts(bucket)
+		if err != nil {
 			return err
 		}
-		if err != errNoAttemptInfo {
-			route = &attempt.Route
+		if len(htlcs) > 0 {
+			route = &htlcs[len(htlcs)-1].Route
 		}
 
 		return nil
@@ -350,6 +469,27 @@ func fetchPaymentBucket(tx *bolt.Tx, paymentHash lntypes.Hash) (
 
 }
 
+// htlcAttemptKey returns the key of the bucket of the htlc attempt with the
+// given id within the htlcs bucket of a payment.
+func htlcAttemptKey(attemptID uint64) []byte {
+	var key [8]byte
+	byteOrder.PutUint64(key[:], attemptID)
+	return key[:]
+}
+
+// fetchHtlcAttemptBucket returns the bucket of the htlc attempt with the given
+// id within the payment bucket, or nil if it doesn't exist.
+func fetchHtlcAttemptBucket(bucket *bolt.Bucket,
+	attemptID uint64) *bolt.Bucket {
+
+	htlcsBucket := bucket.Bucket(paymentHtlcsBucket)
+	if htlcsBucket == nil {
+		return nil
+	}
+
+	return htlcsBucket.Bucket(htlcAttemptKey(attemptID))
+}
+
 // nextPaymentSequence returns the next sequence number to store for a new
 // payment.
 func nextPaymentSequence(tx *bolt.Tx) ([]byte, error) {
@@ -416,15 +556,24 @@ func ensureInFlight(bucket *bolt.Bucket) error {
 	}
 }
 
-// fetchPaymentAttempt fetches the payment attempt from the bucket.
-func fetchPaymentAttempt(bucket *bolt.Bucket) (*PaymentAttemptInfo, error) {
-	attemptData := bucket.Get(paymentAttemptInfoKey)
-	if attemptData == nil {
-		return nil, errNoAttemptInfo
+// fetchInFlightHtlcAttempts fetches the htlc attempts of the payment in the
+// bucket that have been neither settled nor failed.
+func fetchInFlightHtlcAttempts(bucket *bolt.Bucket) ([]HTLCAttempt, error) {
+	htlcs, err := fetchHtlcAttempts(bucket)
+	if err != nil {
+		return nil, err
 	}
 
-	r := bytes.NewReader(attemptData)
-	return deserializePaymentAttemptInfo(r)
+	var inFlight []HTLCAttempt
+	for _, htlc := range htlcs {
+		if htlc.Settle != nil || htlc.Failure != nil {
+			continue
+		}
+
+		inFlight = append(inFlight, htlc)
+	}
+
+	return inFlight, nil
 }
 
 // InFlightPayment is a wrapper around a payment that has status InFlight.
@@ -432,11 +581,12 @@ type InFlightPayment struct {
 	// Info is the PaymentCreationInfo of the in-flight payment.
 	Info *PaymentCreationInfo
 
-	// Attempt contains information about the last payment attempt that was
-	// made to this payment hash.
+	// Attempts contains information about the htlc attempts made to this
+	// payment hash that have been neither settled nor failed, in the order
+	// in which they were made.
 	//
-	// NOTE: Might be nil.
-	Attempt *PaymentAttemptInfo
+	// NOTE: Might be empty.
+	Attempts []PaymentAttemptInfo
 }
 
 // FetchInFlightPayments returns all payments with status InFlight.
@@ -478,12 +628,18 @@ func (p *PaymentControl) FetchInFlightPayments() ([]*InFlightPayment, error) {
 				return err
 			}
 
-			// Now get the attempt info. It could be that there is
-			// no attempt info yet.
-			inFlight.Attempt, err = fetchPaymentAttempt(bucket)
-			if err != nil && err != errNoAttemptInfo {
+			// Now get the attempts that are still in flight. It
+			// could be that there are none yet.
+			htlcs, err := fetchInFlightHtlcAttempts(bucket)
+			if err != nil {
 				return err
 			}
+			for _, htlc := range htlcs {
+				inFlight.Attempts = append(
+					inFlight.Attempts,
+					htlc.PaymentAttemptInfo,
+				)
+			}
 
 			inFlights = append(inFlights, inFlight)
 			return nil
@@ -12,6 +12,7 @@ import (
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/decred/dcrlnd/lntypes"
+	"github.com/decred/dcrlnd/lnwire"
 	"github.com/decred/dcrlnd/routing/route"
 	bolt "go.etcd.io/bbolt"
 )
@@ -384,6 +385,125 @@ func TestPaymentControlDeleteNonInFligt(t *testing.T) {
 	}
 }
 
+// TestPaymentControlHtlcAttempts asserts that every htlc attempt of a payment
+// is recorded along with its outcome.
+func TestPaymentControlHtlcAttempts(t *testing.T) {
+	t.Parallel()
+
+	db, err := initDB()
+	if err != nil {
+		t.Fatalf("unable to init db: %v", err)
+	}
+
+	now := time.Unix(1000, 0)
+	db.now = func() time.Time { return now }
+
+	pControl := NewPaymentControl(db)
+
+	info, attempt, preimg, err := genInfo()
+	if err != nil {
+		t.Fatalf("unable to generate htlc message: %v", err)
+	}
+
+	err = pControl.InitPayment(info.PaymentHash, info)
+	if err != nil {
+		t.Fatalf("unable to send htlc message: %v", err)
+	}
+
+	// Register a first attempt and fail it.
+	err = pControl.RegisterAttempt(info.PaymentHash, attempt)
+	if err != nil {
+		t.Fatalf("unable to register attempt: %v", err)
+	}
+	firstAttemptTime := now
+
+	now = now.Add(time.Second)
+	failInfo := &HTLCFailInfo{
+		Message:            &lnwire.FailPermanentChannelFailure{},
+		Reason:             HTLCFailMessage,
+		FailureSourceIndex: 2,
+	}
+	err = pControl.FailAttempt(
+		info.PaymentHash, attempt.PaymentID, failInfo,
+	)
+	if err != nil {
+		t.Fatalf("unable to fail attempt: %v", err)
+	}
+
+	// An attempt can only be resolved once.
+	err = pControl.FailAttempt(
+		info.PaymentHash, attempt.PaymentID, failInfo,
+	)
+	if err != ErrAttemptAlreadyResolved {
+		t.Fatalf("expected ErrAttemptAlreadyResolved, got: %v", err)
+	}
+
+	// Only registered attempts can be resolved.
+	err = pControl.FailAttempt(info.PaymentHash, 99, failInfo)
+	if err != ErrAttemptNotFound {
+		t.Fatalf("expected ErrAttemptNotFound, got: %v", err)
+	}
+
+	// Register a second attempt, which settles.
+	now = now.Add(time.Second)
+	secondAttempt := *attempt
+	secondAttempt.PaymentID = 2
+	err = pControl.RegisterAttempt(info.PaymentHash, &secondAttempt)
+	if err != nil {
+		t.Fatalf("unable to register attempt: %v", err)
+	}
+
+	// Only the second attempt is reported as in flight.
+	inFlights, err := pControl.FetchInFlightPayments()
+	if err != nil {
+		t.Fatalf("unable to fetch in-flight payments: %v", err)
+	}
+	if len(inFlights) != 1 {
+		t.Fatalf("expected 1 in-flight payment, got %v", len(inFlights))
+	}
+	expectedAttempts := []PaymentAttemptInfo{secondAttempt}
+	if !reflect.DeepEqual(inFlights[0].Attempts, expectedAttempts) {
+		t.Fatalf("unexpected in-flight attempts: expected %v, got %v",
+			spew.Sdump(expectedAttempts),
+			spew.Sdump(inFlights[0].Attempts))
+	}
+
+	now = now.Add(time.Second)
+	if _, err := pControl.Success(info.PaymentHash, preimg); err != nil {
+		t.Fatalf("unable to settle payment: %v", err)
+	}
+
+	payment, err := pControl.FetchPayment(info.PaymentHash)
+	if err != nil {
+		t.Fatalf("unable to fetch payment: %v", err)
+	}
+
+	expectedHTLCs := []HTLCAttempt{
+		{
+			PaymentAttemptInfo: *attempt,
+			AttemptTime:        firstAttemptTime,
+			Failure: &HTLCFailInfo{
+				FailTime:           firstAttemptTime.Add(time.Second),
+				Message:            failInfo.Message,
+				Reason:             HTLCFailMessage,
+				FailureSourceIndex: 2,
+			},
+		},
+		{
+			PaymentAttemptInfo: secondAttempt,
+			AttemptTime:        firstAttemptTime.Add(2 * time.Second),
+			Settle: &HTLCSettleInfo{
+				Preimage:   preimg,
+				SettleTime: firstAttemptTime.Add(3 * time.Second),
+			},
+		},
+	}
+	if !reflect.DeepEqual(payment.HTLCs, expectedHTLCs) {
+		t.Fatalf("unexpected htlc attempts: expected %v, got %v",
+			spew.Sdump(expectedHTLCs), spew.Sdump(payment.HTLCs))
+	}
+}
+
 func assertPaymentStatus(t *testing.T, db *DB,
 	hash [32]byte, expStatus PaymentStatus) {
 
@@ -439,21 +559,27 @@ func checkPaymentCreationInfo(bucket *bolt.Bucket, c *PaymentCreationInfo) error
 	return nil
 }
 
+// checkPaymentAttemptInfo checks that the last htlc attempt of the payment
+// matches the passed attempt.
 func checkPaymentAttemptInfo(bucket *bolt.Bucket, a *PaymentAttemptInfo) error {
-	b := bucket.Get(paymentAttemptInfoKey)
+	htlcs, err := fetchHtlcAttempts(bucket)
+	if err != nil {
+		return err
+	}
+
 	switch {
-	case b == nil && a == nil:
+	case len(htlcs) == 0 && a == nil:
 		return nil
-	case b == nil:
+	case len(htlcs) == 0:
 		return fmt.Errorf("expected attempt info not found")
 	case a == nil:
 		return fmt.Errorf("unexpected attempt info found")
 	}
 
-	r := bytes.NewReader(b)
-	a2, err := deserializePaymentAttemptInfo(r)
-	if err != nil {
-		return err
+	a2 := htlcs[len(htlcs)-1].PaymentAttemptInfo
+	if a.PaymentID != a2.PaymentID {
+		return fmt.Errorf("attempt ID mismatch: expected %v, got %v",
+			a.PaymentID, a2.PaymentID)
 	}
 
 	return assertRouteEqual(&a.Route, &a2.Route)
@@ -31,18 +31,26 @@ var (
 	//      |-- <paymenthash>
 	//      |        |--sequence-key: <sequence number>
 	//      |        |--creation-info-key: <creation info>
-	//      |        |--attempt-info-key: <attempt info>
 	//      |        |--settle-info-key: <settle info>
 	//      |        |--fail-info-key: <fail info>
 	//      |        |
+	//      |        |--payment-htlcs-bucket
+	//      |        |        |
+	//      |        |        |-- <attempt-id>
+	//      |        |        |       |--htlc-attempt-info-key: <attempt info>
+	//      |        |        |       |--htlc-settle-info-key: <settle info>
+	//      |        |        |       |--htlc-fail-info-key: <fail info>
+	//      |        |        |
+	//      |        |       ...
+	//      |        |
 	//      |        |--duplicate-bucket (only for old, completed payments)
 	//      |                 |
 	//      |                 |-- <seq-num>
 	//      |                 |       |--sequence-key: <sequence number>
 	//      |                 |       |--creation-info-key: <creation info>
-	//      |                 |       |--attempt-info-key: <attempt info>
 	//      |                 |       |--settle-info-key: <settle info>
 	//      |                 |       |--fail-info-key: <fail info>
+	//      |                 |       |--payment-htlcs-bucket
 	//      |                 |
 	//      |                 |-- <seq-num>
 	//      |                 |       |
@@ -70,9 +78,12 @@ var (
 	// store the creation info of the payment.
 	paymentCreationInfoKey = []byte("payment-creation-info")
 
-	// paymentAttemptInfoKey is a key used in the payment's sub-bucket to
-	// store the info about the latest attempt that was done for the
-	// payment in question.
+	// paymentAttemptInfoKey is a key that was used in the payment's
+	// sub-bucket to store the info about the latest attempt that was done
+	// for the payment in question, before all htlc attempts were recorded
+	// in the htlcs bucket.
+	//
+	// NOTE: Deprecated. Kept around for migration purposes.
 	paymentAttemptInfoKey = []byte("payment-attempt-info")
 
 	// paymentSettleInfoKey is a key used in the payment's sub-bucket to
@@ -82,6 +93,33 @@ var (
 	// paymentFailInfoKey is a key used in the payment's sub-bucket to
 	// store information about the reason a payment failed.
 	paymentFailInfoKey = []byte("payment-fail-info")
+
+	// paymentHtlcsBucket is the name of the sub-bucket within the
+	// payment's sub-bucket that holds a bucket for every htlc attempt made
+	// for the payment, keyed by the id of the attempt.
+	paymentHtlcsBucket = []byte("payment-htlcs-bucket")
+
+	// htlcAttemptInfoKey is a key used in an htlc attempt's bucket to store
+	// the route and time of the attempt.
+	htlcAttemptInfoKey = []byte("htlc-attempt-info")
+
+	// htlcSettleInfoKey is a key used in an htlc attempt's bucket to store
+	// the preimage and time of the settle of the attempt.
+	htlcSettleInfoKey = []byte("htlc-settle-info")
+
+	// htlcFailInfoKey is a key used in an htlc attempt's bucket to store
+	// the failure and time of the failure of the attempt.
+	htlcFailInfoKey = []byte("htlc-fail-info")
+)
+
+const (
+	// MaxPaymentLabelSize is the maximum size of the label of outgoing
+	// payments stored in the database.
+	MaxPaymentLabelSize = 1024
+
+	// MaxPaymentReferenceSize is the maximum size of the reference linking
+	// outgoing payments to their purpose stored in the database.
+	MaxPaymentReferenceSize = 256
 )
 
 // FailureReason encodes the reason a payment ultimately failed.
@@ -104,10 +142,20 @@ const (
 	// is unknown or the final cltv delta or amount is incorrect.
 	FailureReasonIncorrectPaymentDetails FailureReason = 3
 
-	// TODO(halseth): cancel state.
+	// FailureReasonCanceled indicates that the payment was canceled by the
+	// user before it completed.
+	FailureReasonCanceled FailureReason = 4
+
+	// FailureReasonBudgetExhausted indicates that the attempt, fee or time
+	// budget of the payment was exhausted before it completed.
+	FailureReasonBudgetExhausted FailureReason = 5
+
+	// FailureReasonInsufficientBalance indicates that none of our channels
+	// had enough outbound balance to carry the payment.
+	FailureReasonInsufficientBalance FailureReason = 6
 
 	// TODO(joostjager): Add failure reasons for:
-	// LocalLiquidityInsufficient, RemoteCapacityInsufficient.
+	// RemoteCapacityInsufficient.
 )
 
 // String returns a human readable FailureReason
@@ -121,6 +169,12 @@ func (r FailureReason) String() string {
 		return "error"
 	case FailureReasonIncorrectPaymentDetails:
 		return "incorrect_payment_details"
+	case FailureReasonCanceled:
+		return "canceled"
+	case FailureReasonBudgetExhausted:
+		return "budget_exhausted"
+	case FailureReasonInsufficientBalance:
+		return "insufficient_balance"
 	}
 
 	return "unknown"
@@ -198,6 +252,33 @@ type PaymentCreationInfo struct {
 
 	// PaymentRequest is the full payment request, if any.
 	PaymentRequest []byte
+
+	// FeeLimit is the maximum amount of fees the payment was allowed to
+	// pay. It is zero for payments created before the limit was recorded
+	// or sent to a fixed route.
+	FeeLimit lnwire.MilliAtom
+
+	// Label is an optional user-supplied note describing the payment.
+	Label string
+
+	// Reference is an optional user-supplied reference linking the payment
+	// to its purpose, such as an order or bill id of a bookkeeping tool.
+	Reference string
+}
+
+// ValidatePaymentNote returns an error if the label or reference of a payment
+// exceeds the size we're willing to store.
+func ValidatePaymentNote(label, reference string) error {
+	if len(label) > MaxPaymentLabelSize {
+		return fmt.Errorf("payment label is too large: %v bytes "+
+			"(maxsize=%v)", len(label), MaxPaymentLabelSize)
+	}
+	if len(reference) > MaxPaymentReferenceSize {
+		return fmt.Errorf("payment reference is too large: %v bytes "+
+			"(maxsize=%v)", len(reference), MaxPaymentReferenceSize)
+	}
+
+	return nil
 }
 
 // PaymentAttemptInfo contains information about a specific payment attempt for
@@ -214,13 +295,115 @@ type PaymentAttemptInfo struct {
 
 	// Route is the route attempted to send the HTLC.
 	Route route.Route
+
+	// QuotedFee is the fee quoted by the first route found for the
+	// payment. The fee of the route actually attempted may differ from it
+	// when the policies of the channels along the way changed while the
+	// payment was in flight. It is zero if unknown.
+	QuotedFee lnwire.MilliAtom
+}
+
+// FeeSlippage returns the difference between the fee of the attempted route
+// and the fee quoted for the payment. A positive slippage means the attempt
+// paid more fees than quoted.
+func (a *PaymentAttemptInfo) FeeSlippage() int64 {
+	if a.QuotedFee == 0 {
+		return 0
+	}
+
+	return int64(a.Route.TotalFees()) - int64(a.QuotedFee)
+}
+
+// HTLCFailReason is the reason an htlc attempt failed.
+type HTLCFailReason byte
+
+const (
+	// HTLCFailUnknown is recorded for htlcs whose failure is unknown:
+	// either the failure message couldn't be decoded, although its source
+	// is known, or the htlc was made before failures were recorded.
+	HTLCFailUnknown HTLCFailReason = 0
+
+	// HTLCFailUnreadable is recorded for htlcs that had a failure message
+	// that couldn't be decrypted.
+	HTLCFailUnreadable HTLCFailReason = 1
+
+	// HTLCFailInternal is recorded for htlcs that failed because of an
+	// internal error.
+	HTLCFailInternal HTLCFailReason = 2
+
+	// HTLCFailMessage is recorded for htlcs that failed with a network
+	// failure message.
+	HTLCFailMessage HTLCFailReason = 3
+)
+
+// String returns a human readable HTLCFailReason.
+func (r HTLCFailReason) String() string {
+	switch r {
+	case HTLCFailUnknown:
+		return "unknown"
+	case HTLCFailUnreadable:
+		return "unreadable"
+	case HTLCFailInternal:
+		return "internal"
+	case HTLCFailMessage:
+		return "message"
+	}
+
+	return "invalid"
 }
 
-// Payment is a wrapper around a payment's PaymentCreationInfo,
-// PaymentAttemptInfo, and preimage. All payments will have the
-// PaymentCreationInfo set, the PaymentAttemptInfo will be set only if at least
-// one payment attempt has been made, while only completed payments will have a
-// non-zero payment preimage.
+// HTLCSettleInfo encapsulates the information that augments an HTLCAttempt in
+// the event that the htlc is settled.
+type HTLCSettleInfo struct {
+	// Preimage is the preimage of a successful htlc. This serves as a
+	// proof of payment.
+	Preimage lntypes.Preimage
+
+	// SettleTime is the time at which this htlc was settled.
+	SettleTime time.Time
+}
+
+// HTLCFailInfo encapsulates the information that augments an HTLCAttempt in
+// the event that the htlc fails.
+type HTLCFailInfo struct {
+	// FailTime is the time at which this htlc was failed.
+	FailTime time.Time
+
+	// Message is the wire message that failed this htlc. This field will
+	// be populated when the failure reason is HTLCFailMessage.
+	Message lnwire.FailureMessage
+
+	// Reason is the failure reason for this htlc.
+	Reason HTLCFailReason
+
+	// FailureSourceIndex is the position in the route of the node that
+	// generated the failure message, position zero being ourselves. This
+	// field will be populated when the failure reason is HTLCFailMessage,
+	// or HTLCFailUnknown for failures of a known source.
+	FailureSourceIndex uint32
+}
+
+// HTLCAttempt contains information about a specific htlc attempt made for a
+// payment, along with its outcome once the htlc is resolved.
+type HTLCAttempt struct {
+	PaymentAttemptInfo
+
+	// AttemptTime is the time at which this htlc was attempted.
+	AttemptTime time.Time
+
+	// Settle is the preimage of a successful payment. This serves as a
+	// proof of payment. It will only be non-nil for settled htlcs.
+	Settle *HTLCSettleInfo
+
+	// Failure will be non-nil if the htlc failed, and provides more
+	// information about the payment failure.
+	Failure *HTLCFailInfo
+}
+
+// Payment is a wrapper around a payment's PaymentCreationInfo, its htlc
+// attempts, and preimage. All payments will have the PaymentCreationInfo set,
+// the htlc attempts will be set only if at least one payment attempt has been
+// made, while only completed payments will have a non-zero payment preimage.
 type Payment struct {
 	// sequenceNum is a unique identifier used to sort the payments in
 	// order of creation.
@@ -233,11 +416,6 @@ type Payment struct {
 	// populated when the payment is initiated.
 	Info *PaymentCreationInfo
 
-	// Attempt is the information about the last payment attempt made.
-	//
-	// NOTE: Can be nil if no attempt is yet made.
-	Attempt *PaymentAttemptInfo
-
 	// PaymentPreimage is the preimage of a successful payment. This serves
 	// as a proof of payment. It will only be non-nil for settled payments.
 	//
@@ -249,6 +427,74 @@ type Payment struct {
 	//
 	// NOTE: Can be nil if payment is not failed.
 	Failure *FailureReason
+
+	// HTLCs holds the information about every htlc attempt made for this
+	// payment, in the order in which they were made. Of payments made
+	// before all htlc attempts were recorded, only the last attempt is
+	// known.
+	HTLCs []HTLCAttempt
+}
+
+// LastHTLC returns the last htlc attempt made for the payment, or nil if no
+// attempt has been made yet.
+func (p *Payment) LastHTLC() *HTLCAttempt {
+	if len(p.HTLCs) == 0 {
+		return nil
+	}
+
+	return &p.HTLCs[len(p.HTLCs)-1]
+}
+
+// SettledFees returns the total fees paid by the settled htlc attempts of the
+// payment. Failed attempts don't contribute to the fees.
+func (p *Payment) SettledFees() lnwire.MilliAtom {
+	var fees lnwire.MilliAtom
+	for _, htlc := range p.HTLCs {
+		if htlc.Settle != nil {
+			fees += htlc.Route.TotalFees()
+		}
+	}
+
+	return fees
+}
+
+// InFlightFees returns the total fees of the htlc attempts of the payment that
+// are neither settled nor failed.
+func (p *Payment) InFlightFees() lnwire.MilliAtom {
+	var fees lnwire.MilliAtom
+	for _, htlc := range p.HTLCs {
+		if htlc.Settle == nil && htlc.Failure == nil {
+			fees += htlc.Route.TotalFees()
+		}
+	}
+
+	return fees
+}
+
+// RemainingFeeBudget returns what the settled and in-flight htlc attempts of
+// the payment left of its fee limit, which bounds the fee of any further
+// attempt. Failed attempts give their fees back to the budget.
+func (p *Payment) RemainingFeeBudget() lnwire.MilliAtom {
+	committed := p.SettledFees() + p.InFlightFees()
+	if committed >= p.Info.FeeLimit {
+		return 0
+	}
+
+	return p.Info.FeeLimit - committed
+}
+
+// SettledFeeSlippage returns the total fee slippage of the settled htlc
+// attempts of the payment, which is the difference between the fees they paid
+// and the fees quoted for them.
+func (p *Payment) SettledFeeSlippage() int64 {
+	var slippage int64
+	for _, htlc := range p.HTLCs {
+		if htlc.Settle != nil {
+			slippage += htlc.FeeSlippage()
+		}
+	}
+
+	return slippage
 }
 
 // FetchPayments returns all sent payments found in the DB.
@@ -346,16 +592,6 @@ func fetchPayment(bucket *bolt.Bucket) (*Payment, error) {
 
 	}
 
-	// Get the PaymentAttemptInfo. This can be unset.
-	b = bucket.Get(paymentAttemptInfoKey)
-	if b != nil {
-		r = bytes.NewReader(b)
-		p.Attempt, err = deserializePaymentAttemptInfo(r)
-		if err != nil {
-			return nil, err
-		}
-	}
-
 	// Get the payment preimage. This is only found for
 	// completed payments.
 	b = bucket.Get(paymentSettleInfoKey)
@@ -372,9 +608,76 @@ func fetchPayment(bucket *bolt.Bucket) (*Payment, error) {
 		p.Failure = &reason
 	}
 
+	// Get the htlc attempts. These are unset if no attempt has been made
+	// yet.
+	p.HTLCs, err = fetchHtlcAttempts(bucket)
+	if err != nil {
+		return nil, err
+	}
+
 	return p, nil
 }
 
+// fetchHtlcAttempts retrieves all htlc attempts made for the payment found in
+// the passed bucket, ordered by their attempt id.
+func fetchHtlcAttempts(bucket *bolt.Bucket) ([]HTLCAttempt, error) {
+	htlcsBucket := bucket.Bucket(paymentHtlcsBucket)
+	if htlcsBucket == nil {
+		return nil, nil
+	}
+
+	var htlcs []HTLCAttempt
+	err := htlcsBucket.ForEach(func(k, _ []byte) error {
+		attemptBucket := htlcsBucket.Bucket(k)
+		if attemptBucket == nil {
+			return fmt.Errorf("non bucket element in htlcs bucket")
+		}
+
+		htlc, err := fetchHtlcAttempt(attemptBucket)
+		if err != nil {
+			return err
+		}
+
+		htlcs = append(htlcs, *htlc)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return htlcs, nil
+}
+
+// fetchHtlcAttempt retrieves the htlc attempt stored in the passed bucket.
+func fetchHtlcAttempt(bucket *bolt.Bucket) (*HTLCAttempt, error) {
+	b := bucket.Get(htlcAttemptInfoKey)
+	if b == nil {
+		return nil, fmt.Errorf("htlc attempt info not found")
+	}
+
+	htlc, err := deserializeHTLCAttempt(bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+
+	// The settle and fail info are only found for resolved htlcs.
+	if b := bucket.Get(htlcSettleInfoKey); b != nil {
+		htlc.Settle, err = deserializeHTLCSettleInfo(bytes.NewReader(b))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if b := bucket.Get(htlcFailInfoKey); b != nil {
+		htlc.Failure, err = deserializeHTLCFailInfo(bytes.NewReader(b))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return htlc, nil
+}
+
 // DeletePayments deletes all completed and failed payments from the DB.
 func (db *DB) DeletePayments() error {
 	return db.Update(func(tx *bolt.Tx) error {
@@ -443,9 +746,47 @@ func serializePaymentCreationInfo(w io.Writer, c *PaymentCreationInfo) error {
 		return err
 	}
 
+	byteOrder.PutUint64(scratch[:], uint64(c.FeeLimit))
+	if _, err := w.Write(scratch[:]); err != nil {
+		return err
+	}
+
+	for _, note := range []string{c.Label, c.Reference} {
+		byteOrder.PutUint32(scratch[:4], uint32(len(note)))
+		if _, err := w.Write(scratch[:4]); err != nil {
+			return err
+		}
+
+		if _, err := w.Write([]byte(note)); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// deserializePaymentNote reads a length prefixed label or reference of a
+// payment, which may be at most maxSize bytes long.
+func deserializePaymentNote(r io.Reader, maxSize uint32) (string, error) {
+	var scratch [4]byte
+	if _, err := io.ReadFull(r, scratch[:]); err != nil {
+		return "", err
+	}
+
+	noteLen := byteOrder.Uint32(scratch[:])
+	if noteLen > maxSize {
+		return "", fmt.Errorf("payment note too large: %v bytes",
+			noteLen)
+	}
+
+	note := make([]byte, noteLen)
+	if _, err := io.ReadFull(r, note); err != nil {
+		return "", err
+	}
+
+	return string(note), nil
+}
+
 func deserializePaymentCreationInfo(r io.Reader) (*PaymentCreationInfo, error) {
 	var scratch [8]byte
 
@@ -478,6 +819,31 @@ func deserializePaymentCreationInfo(r io.Reader) (*PaymentCreationInfo, error) {
 	}
 	c.PaymentRequest = payReq
 
+	// The fee limit was added after the rest of the creation info, so it
+	// may not be present for older payments.
+	_, err := io.ReadFull(r, scratch[:])
+	if err == io.EOF {
+		return c, nil
+	} else if err != nil {
+		return nil, err
+	}
+	c.FeeLimit = lnwire.MilliAtom(byteOrder.Uint64(scratch[:]))
+
+	// Similarly, the label and reference were added later on.
+	c.Label, err = deserializePaymentNote(r, MaxPaymentLabelSize)
+	if err == io.EOF {
+		return c, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	c.Reference, err = deserializePaymentNote(
+		r, MaxPaymentReferenceSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return c, nil
 }
 
@@ -490,6 +856,12 @@ func serializePaymentAttemptInfo(w io.Writer, a *PaymentAttemptInfo) error {
 		return err
 	}
 
+	var scratch [8]byte
+	byteOrder.PutUint64(scratch[:], uint64(a.QuotedFee))
+	if _, err := w.Write(scratch[:]); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -503,9 +875,149 @@ func deserializePaymentAttemptInfo(r io.Reader) (*PaymentAttemptInfo, error) {
 	if err != nil {
 		return nil, err
 	}
+
+	// The quoted fee was added after the rest of the attempt info, so it
+	// may not be present for older attempts.
+	var scratch [8]byte
+	_, err = io.ReadFull(r, scratch[:])
+	if err == io.EOF {
+		return a, nil
+	} else if err != nil {
+		return nil, err
+	}
+	a.QuotedFee = lnwire.MilliAtom(byteOrder.Uint64(scratch[:]))
+
 	return a, nil
 }
 
+// serializeHTLCAttempt serializes the attempt info and attempt time of an htlc
+// attempt.
+func serializeHTLCAttempt(w io.Writer, a *HTLCAttempt) error {
+	if err := serializeTime(w, a.AttemptTime); err != nil {
+		return err
+	}
+
+	return serializePaymentAttemptInfo(w, &a.PaymentAttemptInfo)
+}
+
+// deserializeHTLCAttempt deserializes the attempt info and attempt time of an
+// htlc attempt.
+func deserializeHTLCAttempt(r io.Reader) (*HTLCAttempt, error) {
+	attemptTime, err := deserializeTime(r)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := deserializePaymentAttemptInfo(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HTLCAttempt{
+		PaymentAttemptInfo: *info,
+		AttemptTime:        attemptTime,
+	}, nil
+}
+
+func serializeHTLCSettleInfo(w io.Writer, s *HTLCSettleInfo) error {
+	if _, err := w.Write(s.Preimage[:]); err != nil {
+		return err
+	}
+
+	return serializeTime(w, s.SettleTime)
+}
+
+func deserializeHTLCSettleInfo(r io.Reader) (*HTLCSettleInfo, error) {
+	s := &HTLCSettleInfo{}
+	if _, err := io.ReadFull(r, s.Preimage[:]); err != nil {
+		return nil, err
+	}
+
+	var err error
+	s.SettleTime, err = deserializeTime(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func serializeHTLCFailInfo(w io.Writer, f *HTLCFailInfo) error {
+	if err := serializeTime(w, f.FailTime); err != nil {
+		return err
+	}
+
+	// Write failure. If there is no failure message, write an empty
+	// byte slice.
+	var messageBytes bytes.Buffer
+	if f.Message != nil {
+		err := lnwire.EncodeFailureMessage(&messageBytes, f.Message, 0)
+		if err != nil {
+			return err
+		}
+	}
+
+	return WriteElements(
+		w, messageBytes.Bytes(), byte(f.Reason), f.FailureSourceIndex,
+	)
+}
+
+func deserializeHTLCFailInfo(r io.Reader) (*HTLCFailInfo, error) {
+	f := &HTLCFailInfo{}
+
+	var err error
+	f.FailTime, err = deserializeTime(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		messageBytes []byte
+		reason       byte
+	)
+	err = ReadElements(r, &messageBytes, &reason, &f.FailureSourceIndex)
+	if err != nil {
+		return nil, err
+	}
+	f.Reason = HTLCFailReason(reason)
+
+	if len(messageBytes) > 0 {
+		f.Message, err = lnwire.DecodeFailureMessage(
+			bytes.NewReader(messageBytes), 0,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return f, nil
+}
+
+// serializeTime serializes a time as its unix nanoseconds. The zero time is
+// written as zero.
+func serializeTime(w io.Writer, t time.Time) error {
+	var unixNano uint64
+	if !t.IsZero() {
+		unixNano = uint64(t.UnixNano())
+	}
+
+	return WriteElements(w, unixNano)
+}
+
+// deserializeTime deserializes a time written by serializeTime.
+func deserializeTime(r io.Reader) (time.Time, error) {
+	var unixNano uint64
+	if err := ReadElements(r, &unixNano); err != nil {
+		return time.Time{}, err
+	}
+
+	if unixNano == 0 {
+		return time.Time{}, nil
+	}
+
+	return time.Unix(0, int64(unixNano)), nil
+}
+
 func serializeHop(w io.Writer, h *route.Hop) error {
 	if err := WriteElements(w,
 		h.PubKeyBytes[:], h.ChannelID, h.OutgoingTimeLock,
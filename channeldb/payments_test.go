@@ -92,12 +92,16 @@ func makeFakeInfo() (*PaymentCreationInfo, *PaymentAttemptInfo) {
 		// failures due to the monotonic time component.
 		CreationDate:   time.Unix(time.Now().Unix(), 0),
 		PaymentRequest: []byte(""),
+		FeeLimit:       100,
+		Label:          "coffee",
+		Reference:      "order-42",
 	}
 
 	a := &PaymentAttemptInfo{
 		PaymentID:  44,
 		SessionKey: priv,
 		Route:      testRoute,
+		QuotedFee:  1000,
 	}
 	return c, a
 }
@@ -166,6 +170,50 @@ func makeRandomFakePayment() (*outgoingPayment, error) {
 	return fakePayment, nil
 }
 
+// TestLegacyPaymentCreationInfo asserts that the creation info of payments
+// stored before the fee limit, label and reference were recorded can still be
+// read.
+func TestLegacyPaymentCreationInfo(t *testing.T) {
+	t.Parallel()
+
+	c, _ := makeFakeInfo()
+
+	var b bytes.Buffer
+	if err := serializePaymentCreationInfo(&b, c); err != nil {
+		t.Fatalf("unable to serialize creation info: %v", err)
+	}
+
+	assertLegacyInfo := func(stripped int, expected *PaymentCreationInfo) {
+		t.Helper()
+
+		legacy := bytes.NewReader(b.Bytes()[:b.Len()-stripped])
+		legacyInfo, err := deserializePaymentCreationInfo(legacy)
+		if err != nil {
+			t.Fatalf("unable to deserialize creation info: %v", err)
+		}
+
+		if !reflect.DeepEqual(expected, legacyInfo) {
+			t.Fatalf("Payments do not match after "+
+				"serialization/deserialization %v vs %v",
+				spew.Sdump(expected), spew.Sdump(legacyInfo),
+			)
+		}
+	}
+
+	// Strip the trailing label and reference to obtain the serialization
+	// used before they were added.
+	notesLen := 8 + len(c.Label) + len(c.Reference)
+	noNotes := *c
+	noNotes.Label = ""
+	noNotes.Reference = ""
+	assertLegacyInfo(notesLen, &noNotes)
+
+	// Also strip the fee limit to obtain the oldest serialization.
+	noFeeLimit := noNotes
+	noFeeLimit.FeeLimit = 0
+	assertLegacyInfo(notesLen+8, &noFeeLimit)
+}
+
 func TestSentPaymentSerialization(t *testing.T) {
 	t.Parallel()
 
@@ -322,3 +370,52 @@ func TestRouteSerialization(t *testing.T) {
 			spew.Sdump(testRoute), spew.Sdump(route2))
 	}
 }
+
+// TestPaymentRemainingFeeBudget asserts that the fees of settled and in-flight
+// htlc attempts are deducted from the fee limit of a payment, while failed
+// attempts give their fees back.
+func TestPaymentRemainingFeeBudget(t *testing.T) {
+	t.Parallel()
+
+	makeHTLC := func(fee lnwire.MilliAtom) HTLCAttempt {
+		return HTLCAttempt{
+			PaymentAttemptInfo: PaymentAttemptInfo{
+				Route: route.Route{
+					TotalAmount: 1000 + fee,
+					Hops: []*route.Hop{
+						{AmtToForward: 1000},
+					},
+				},
+			},
+		}
+	}
+
+	settled := makeHTLC(30)
+	settled.Settle = &HTLCSettleInfo{}
+
+	failed := makeHTLC(50)
+	failed.Failure = &HTLCFailInfo{}
+
+	inFlight := makeHTLC(40)
+
+	payment := &Payment{
+		Info: &PaymentCreationInfo{
+			FeeLimit: 100,
+		},
+		HTLCs: []HTLCAttempt{settled, failed, inFlight},
+	}
+
+	if fees := payment.InFlightFees(); fees != 40 {
+		t.Fatalf("expected in-flight fees of 40, got %v", fees)
+	}
+	if budget := payment.RemainingFeeBudget(); budget != 30 {
+		t.Fatalf("expected remaining fee budget of 30, got %v", budget)
+	}
+
+	// Attempts whose fees exceed the limit must exhaust the budget rather
+	// than underflow it.
+	payment.HTLCs = append(payment.HTLCs, makeHTLC(60))
+	if budget := payment.RemainingFeeBudget(); budget != 0 {
+		t.Fatalf("expected exhausted fee budget, got %v", budget)
+	}
+}
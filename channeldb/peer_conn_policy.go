@@ -0,0 +1,193 @@
+package channeldb
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	// peerConnPolicyBucket is the name of the bucket that stores the
+	// connection policies of individual peers. The bucket is keyed by the
+	// compressed public key of the peer.
+	peerConnPolicyBucket = []byte("peer-conn-policy")
+
+	// ErrPeerConnPolicyNotFound is returned when no connection policy has
+	// been stored for a peer.
+	ErrPeerConnPolicyNotFound = fmt.Errorf("peer connection policy not found")
+)
+
+// ReconnectPolicy describes whether the connection to a peer should be
+// re-established once it has been lost.
+type ReconnectPolicy uint8
+
+const (
+	// ReconnectDefault keeps the default behavior of only maintaining a
+	// persistent connection to peers we have channels with or that were
+	// explicitly connected to as permanent peers.
+	ReconnectDefault ReconnectPolicy = 0
+
+	// ReconnectAlways always attempts to re-establish the connection to
+	// the peer, even if there are no channels with it.
+	ReconnectAlways ReconnectPolicy = 1
+
+	// ReconnectNever never attempts to re-establish the connection to the
+	// peer once it has been lost.
+	ReconnectNever ReconnectPolicy = 2
+)
+
+// String returns a human readable version of the reconnect policy.
+func (r ReconnectPolicy) String() string {
+	switch r {
+	case ReconnectDefault:
+		return "default"
+	case ReconnectAlways:
+		return "always"
+	case ReconnectNever:
+		return "never"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(r))
+	}
+}
+
+// PeerConnPolicy is the connection policy set for an individual peer. It
+// overrides the global persistent connection behavior of the node.
+type PeerConnPolicy struct {
+	// Reconnect determines whether the connection to the peer is
+	// re-established once it has been lost.
+	Reconnect ReconnectPolicy
+
+	// MinBackoff is the shortest backoff used when reconnecting to the
+	// peer. If zero, the global minimum backoff is used.
+	MinBackoff time.Duration
+
+	// MaxBackoff is the longest backoff used when reconnecting to the
+	// peer. If zero, the global maximum backoff is used.
+	MaxBackoff time.Duration
+}
+
+// IsDefault returns true if the policy doesn't override any of the global
+// settings.
+func (p *PeerConnPolicy) IsDefault() bool {
+	return p.Reconnect == ReconnectDefault && p.MinBackoff == 0 &&
+		p.MaxBackoff == 0
+}
+
+// PutPeerConnPolicy stores the connection policy of the peer identified by the
+// given compressed public key, replacing any previously stored policy. A
+// policy that doesn't override any setting is deleted instead.
+func (d *DB) PutPeerConnPolicy(pubKey [33]byte, policy *PeerConnPolicy) error {
+	if policy.MaxBackoff != 0 && policy.MinBackoff > policy.MaxBackoff {
+		return fmt.Errorf("minimum backoff %v exceeds maximum "+
+			"backoff %v", policy.MinBackoff, policy.MaxBackoff)
+	}
+
+	return d.Update(func(tx *bolt.Tx) error {
+		policies, err := tx.CreateBucketIfNotExists(peerConnPolicyBucket)
+		if err != nil {
+			return err
+		}
+
+		if policy.IsDefault() {
+			return policies.Delete(pubKey[:])
+		}
+
+		var b bytes.Buffer
+		if err := serializePeerConnPolicy(&b, policy); err != nil {
+			return err
+		}
+
+		return policies.Put(pubKey[:], b.Bytes())
+	})
+}
+
+// FetchPeerConnPolicy returns the connection policy of the peer identified by
+// the given compressed public key. If no policy has been stored,
+// ErrPeerConnPolicyNotFound is returned.
+func (d *DB) FetchPeerConnPolicy(pubKey [33]byte) (*PeerConnPolicy, error) {
+	var policy *PeerConnPolicy
+	err := d.View(func(tx *bolt.Tx) error {
+		policies := tx.Bucket(peerConnPolicyBucket)
+		if policies == nil {
+			return ErrPeerConnPolicyNotFound
+		}
+
+		v := policies.Get(pubKey[:])
+		if v == nil {
+			return ErrPeerConnPolicyNotFound
+		}
+
+		var err error
+		policy, err = deserializePeerConnPolicy(bytes.NewReader(v))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// FetchPeerConnPolicies returns the connection policies of all peers, keyed by
+// their compressed public keys.
+func (d *DB) FetchPeerConnPolicies() (map[[33]byte]*PeerConnPolicy, error) {
+	policies := make(map[[33]byte]*PeerConnPolicy)
+	err := d.View(func(tx *bolt.Tx) error {
+		policyBucket := tx.Bucket(peerConnPolicyBucket)
+		if policyBucket == nil {
+			return nil
+		}
+
+		return policyBucket.ForEach(func(k, v []byte) error {
+			if len(k) != 33 {
+				return fmt.Errorf("invalid peer key length %d",
+					len(k))
+			}
+
+			policy, err := deserializePeerConnPolicy(
+				bytes.NewReader(v),
+			)
+			if err != nil {
+				return err
+			}
+
+			var pubKey [33]byte
+			copy(pubKey[:], k)
+			policies[pubKey] = policy
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+func serializePeerConnPolicy(w io.Writer, policy *PeerConnPolicy) error {
+	return WriteElements(w,
+		uint8(policy.Reconnect), uint64(policy.MinBackoff),
+		uint64(policy.MaxBackoff),
+	)
+}
+
+func deserializePeerConnPolicy(r io.Reader) (*PeerConnPolicy, error) {
+	var (
+		reconnect              uint8
+		minBackoff, maxBackoff uint64
+	)
+	err := ReadElements(r, &reconnect, &minBackoff, &maxBackoff)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PeerConnPolicy{
+		Reconnect:  ReconnectPolicy(reconnect),
+		MinBackoff: time.Duration(minBackoff),
+		MaxBackoff: time.Duration(maxBackoff),
+	}, nil
+}
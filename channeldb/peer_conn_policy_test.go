@@ -0,0 +1,87 @@
+package channeldb
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// TestPeerConnPolicies tests that peer connection policies can be stored,
+// fetched and removed.
+func TestPeerConnPolicies(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	var pub1, pub2 [33]byte
+	pub1[0] = 0x02
+	pub2[0] = 0x03
+
+	// Nothing should be found before any policy has been stored.
+	if _, err := cdb.FetchPeerConnPolicy(pub1); err != ErrPeerConnPolicyNotFound {
+		t.Fatalf("expected ErrPeerConnPolicyNotFound, got %v", err)
+	}
+	policies, err := cdb.FetchPeerConnPolicies()
+	if err != nil {
+		t.Fatalf("unable to fetch policies: %v", err)
+	}
+	if len(policies) != 0 {
+		t.Fatalf("expected no policies, got %v", len(policies))
+	}
+
+	policy1 := &PeerConnPolicy{
+		Reconnect:  ReconnectAlways,
+		MinBackoff: 30 * time.Second,
+		MaxBackoff: time.Hour,
+	}
+	policy2 := &PeerConnPolicy{
+		Reconnect: ReconnectNever,
+	}
+	if err := cdb.PutPeerConnPolicy(pub1, policy1); err != nil {
+		t.Fatalf("unable to store policy: %v", err)
+	}
+	if err := cdb.PutPeerConnPolicy(pub2, policy2); err != nil {
+		t.Fatalf("unable to store policy: %v", err)
+	}
+
+	dbPolicy, err := cdb.FetchPeerConnPolicy(pub1)
+	if err != nil {
+		t.Fatalf("unable to fetch policy: %v", err)
+	}
+	if !reflect.DeepEqual(dbPolicy, policy1) {
+		t.Fatalf("expected policy %v, got %v", policy1, dbPolicy)
+	}
+
+	policies, err = cdb.FetchPeerConnPolicies()
+	if err != nil {
+		t.Fatalf("unable to fetch policies: %v", err)
+	}
+	expected := map[[33]byte]*PeerConnPolicy{
+		pub1: policy1,
+		pub2: policy2,
+	}
+	if !reflect.DeepEqual(policies, expected) {
+		t.Fatalf("expected policies %v, got %v", expected, policies)
+	}
+
+	// A minimum backoff above the maximum should be rejected.
+	err = cdb.PutPeerConnPolicy(pub1, &PeerConnPolicy{
+		MinBackoff: time.Hour,
+		MaxBackoff: time.Minute,
+	})
+	if err == nil {
+		t.Fatalf("expected invalid backoff bounds to be rejected")
+	}
+
+	// Storing a default policy should remove the peer's entry.
+	if err := cdb.PutPeerConnPolicy(pub2, &PeerConnPolicy{}); err != nil {
+		t.Fatalf("unable to store policy: %v", err)
+	}
+	if _, err := cdb.FetchPeerConnPolicy(pub2); err != ErrPeerConnPolicyNotFound {
+		t.Fatalf("expected ErrPeerConnPolicyNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,114 @@
+package channeldb
+
+import (
+	"fmt"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	bolt "go.etcd.io/bbolt"
+)
+
+// MaxTxLabelLength is the maximum length of the label of an on-chain
+// transaction.
+const MaxTxLabelLength = 500
+
+var (
+	// txLabelBucket is the name of the bucket that stores the labels given
+	// to on-chain transactions of the wallet. The bucket is keyed by the
+	// hash of the transactions.
+	txLabelBucket = []byte("tx-labels")
+
+	// ErrTxLabelTooLong is returned when the label of a transaction is
+	// longer than MaxTxLabelLength.
+	ErrTxLabelTooLong = fmt.Errorf("transaction label exceeds %v "+
+		"characters", MaxTxLabelLength)
+
+	// ErrTxLabelExists is returned when attempting to add a label to a
+	// transaction which is already labeled.
+	ErrTxLabelExists = fmt.Errorf("transaction already labeled")
+)
+
+// PutTxLabel stores the label of the given transaction, replacing any previous
+// one. An empty label removes the label of the transaction.
+func (d *DB) PutTxLabel(txid chainhash.Hash, label string) error {
+	return d.putTxLabel(txid, label, true)
+}
+
+// AddTxLabel stores the label of the given transaction, unless it's already
+// labeled, in which case ErrTxLabelExists is returned.
+func (d *DB) AddTxLabel(txid chainhash.Hash, label string) error {
+	return d.putTxLabel(txid, label, false)
+}
+
+// putTxLabel stores the label of the given transaction, only replacing any
+// previous one if overwrite is set.
+func (d *DB) putTxLabel(txid chainhash.Hash, label string,
+	overwrite bool) error {
+
+	if len(label) > MaxTxLabelLength {
+		return ErrTxLabelTooLong
+	}
+
+	return d.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(txLabelBucket)
+		if err != nil {
+			return err
+		}
+
+		if !overwrite && bucket.Get(txid[:]) != nil {
+			return ErrTxLabelExists
+		}
+
+		if label == "" {
+			return bucket.Delete(txid[:])
+		}
+
+		return bucket.Put(txid[:], []byte(label))
+	})
+}
+
+// FetchTxLabel returns the label of the given transaction, or an empty string
+// if it isn't labeled.
+func (d *DB) FetchTxLabel(txid chainhash.Hash) (string, error) {
+	var label string
+	err := d.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(txLabelBucket)
+		if bucket == nil {
+			return nil
+		}
+
+		label = string(bucket.Get(txid[:]))
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return label, nil
+}
+
+// FetchTxLabels returns the labels of all the labeled transactions, keyed by
+// their hash.
+func (d *DB) FetchTxLabels() (map[chainhash.Hash]string, error) {
+	labels := make(map[chainhash.Hash]string)
+	err := d.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(txLabelBucket)
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			txid, err := chainhash.NewHash(k)
+			if err != nil {
+				return err
+			}
+
+			labels[*txid] = string(v)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return labels, nil
+}
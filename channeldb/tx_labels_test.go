@@ -0,0 +1,91 @@
+package channeldb
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+)
+
+// TestTxLabels tests that transaction labels can be stored, replaced and
+// removed.
+func TestTxLabels(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	// Nothing should be returned before any label has been stored.
+	labels, err := cdb.FetchTxLabels()
+	if err != nil {
+		t.Fatalf("unable to fetch labels: %v", err)
+	}
+	if len(labels) != 0 {
+		t.Fatalf("expected no labels, got %v", len(labels))
+	}
+
+	txid1 := chainhash.Hash{1}
+	txid2 := chainhash.Hash{2}
+	if err := cdb.PutTxLabel(txid1, "funding"); err != nil {
+		t.Fatalf("unable to store label: %v", err)
+	}
+	if err := cdb.PutTxLabel(txid2, "rent"); err != nil {
+		t.Fatalf("unable to store label: %v", err)
+	}
+	if err := cdb.PutTxLabel(txid2, "groceries"); err != nil {
+		t.Fatalf("unable to replace label: %v", err)
+	}
+
+	// Adding a label to a transaction that is already labeled fails,
+	// leaving the previous label untouched.
+	if err := cdb.AddTxLabel(txid2, "rent"); err != ErrTxLabelExists {
+		t.Fatalf("expected %v, got %v", ErrTxLabelExists, err)
+	}
+	txid3 := chainhash.Hash{3}
+	if err := cdb.AddTxLabel(txid3, "sweep"); err != nil {
+		t.Fatalf("unable to add label: %v", err)
+	}
+
+	// Labels that are too long are rejected.
+	err = cdb.PutTxLabel(txid1, strings.Repeat("a", MaxTxLabelLength+1))
+	if err != ErrTxLabelTooLong {
+		t.Fatalf("expected %v, got %v", ErrTxLabelTooLong, err)
+	}
+
+	expected := map[chainhash.Hash]string{
+		txid1: "funding",
+		txid2: "groceries",
+		txid3: "sweep",
+	}
+	labels, err = cdb.FetchTxLabels()
+	if err != nil {
+		t.Fatalf("unable to fetch labels: %v", err)
+	}
+	if !reflect.DeepEqual(labels, expected) {
+		t.Fatalf("expected labels %v, got %v", expected, labels)
+	}
+	label, err := cdb.FetchTxLabel(txid2)
+	if err != nil {
+		t.Fatalf("unable to fetch label: %v", err)
+	}
+	if label != "groceries" {
+		t.Fatalf("expected label groceries, got %v", label)
+	}
+
+	// An empty label removes the label of the transaction.
+	if err := cdb.PutTxLabel(txid1, ""); err != nil {
+		t.Fatalf("unable to remove label: %v", err)
+	}
+	labels, err = cdb.FetchTxLabels()
+	if err != nil {
+		t.Fatalf("unable to fetch labels: %v", err)
+	}
+	if _, ok := labels[txid1]; ok || len(labels) != 2 {
+		t.Fatalf("expected only the labels of %v and %v, got %v",
+			txid2, txid3, labels)
+	}
+}
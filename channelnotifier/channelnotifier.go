@@ -3,6 +3,7 @@ package channelnotifier
 import (
 	"sync"
 
+	"github.com/decred/dcrd/chaincfg/chainhash"
 	"github.com/decred/dcrd/wire"
 	"github.com/decred/dcrlnd/channeldb"
 	"github.com/decred/dcrlnd/subscribe"
@@ -45,6 +46,71 @@ type ClosedChannelEvent struct {
 	CloseSummary *channeldb.ChannelCloseSummary
 }
 
+// RemoteCommitType describes which of the remote party's commitments was
+// broadcast.
+type RemoteCommitType uint8
+
+const (
+	// RemoteCommitLatest is the latest commitment of the remote party,
+	// which signals a unilateral close.
+	RemoteCommitLatest RemoteCommitType = iota
+
+	// RemoteCommitPending is the commitment of the remote party one state
+	// beyond the latest, which we signed but which wasn't revoked yet.
+	RemoteCommitPending
+
+	// RemoteCommitRevoked is a commitment the remote party already
+	// revoked, which signals a breach.
+	RemoteCommitRevoked
+
+	// RemoteCommitUnknown is a commitment beyond any state we know of,
+	// either because we lost state or because the channel was restored
+	// from a backup.
+	RemoteCommitUnknown
+)
+
+// String returns a human readable string describing the RemoteCommitType.
+func (r RemoteCommitType) String() string {
+	switch r {
+	case RemoteCommitLatest:
+		return "Latest"
+
+	case RemoteCommitPending:
+		return "Pending"
+
+	case RemoteCommitRevoked:
+		return "Revoked"
+
+	case RemoteCommitUnknown:
+		return "Unknown"
+
+	default:
+		return "Invalid"
+	}
+}
+
+// RemoteCommitmentEvent represents a new event where a commitment of the
+// remote party is detected in the mempool or in a block.
+type RemoteCommitmentEvent struct {
+	// ChannelPoint is the channelpoint of the channel being closed.
+	ChannelPoint *wire.OutPoint
+
+	// CommitTxid is the hash of the commitment transaction.
+	CommitTxid chainhash.Hash
+
+	// StateNum is the commitment height encoded in the commitment
+	// transaction.
+	StateNum uint64
+
+	// CommitType is which of the remote party's commitments was
+	// broadcast.
+	CommitType RemoteCommitType
+
+	// Confirmed is true if the commitment was detected in a block, and
+	// false if it was detected in the mempool.
+	Confirmed bool
+}
+
 // New creates a new channel notifier. The ChannelNotifier gets channel
 // events from peers and from the chain arbitrator, and dispatches them to
 // its clients.
@@ -128,3 +194,13 @@ func (c *ChannelNotifier) NotifyInactiveChannelEvent(chanPoint wire.OutPoint) {
 		log.Warnf("Unable to send inactive channel update: %v", err)
 	}
 }
+
+// NotifyRemoteCommitmentEvent notifies the channelEventNotifier goroutine that
+// a commitment of the remote party was broadcast.
+func (c *ChannelNotifier) NotifyRemoteCommitmentEvent(
+	event RemoteCommitmentEvent) {
+
+	if err := c.ntfnServer.SendUpdate(event); err != nil {
+		log.Warnf("Unable to send remote commitment update: %v", err)
+	}
+}
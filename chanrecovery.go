@@ -0,0 +1,316 @@
+package dcrlnd
+
+import (
+	"sync"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v2"
+	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/routing/route"
+)
+
+// chanRecoveryStage is the stage reached by the recovery of a channel
+// restored from a static channel backup.
+type chanRecoveryStage uint8
+
+const (
+	// recoveryWaitingForPeer means the channel peer hasn't been reached
+	// yet.
+	recoveryWaitingForPeer chanRecoveryStage = iota
+
+	// recoveryDataLossProtect means the channel peer is connected, and the
+	// channels are being reestablished to learn its latest commitment
+	// point.
+	recoveryDataLossProtect
+
+	// recoveryWaitingForForceClose means the channel peer handed us its
+	// latest commitment point, and is expected to force close the
+	// channel.
+	recoveryWaitingForForceClose
+
+	// recoverySweeping means the channel was closed on chain, and our
+	// outputs are being swept.
+	recoverySweeping
+
+	// recoveryRecovered means our outputs of the channel were swept.
+	recoveryRecovered
+)
+
+// String returns a human readable representation of the stage.
+func (s chanRecoveryStage) String() string {
+	switch s {
+	case recoveryWaitingForPeer:
+		return "WaitingForPeer"
+	case recoveryDataLossProtect:
+		return "DataLossProtect"
+	case recoveryWaitingForForceClose:
+		return "WaitingForForceClose"
+	case recoverySweeping:
+		return "Sweeping"
+	case recoveryRecovered:
+		return "Recovered"
+	default:
+		return "Unknown"
+	}
+}
+
+// recoveringChannel is a channel restored from a static channel backup, along
+// with the stage its recovery reached.
+type recoveringChannel struct {
+	// chanPoint is the funding outpoint of the channel.
+	chanPoint string
+
+	// remotePub is the public key of the channel peer.
+	remotePub *secp256k1.PublicKey
+
+	// stage is the stage the recovery of the channel reached.
+	stage chanRecoveryStage
+
+	// closeSummary is the summary of the closure of the channel, once
+	// closed on chain.
+	closeSummary *channeldb.ChannelCloseSummary
+}
+
+// recoveryPeerStatus is the status of a recovery peer.
+type recoveryPeerStatus struct {
+	// addr is the address of the peer.
+	addr *lnwire.NetAddress
+
+	// connected is true if we're connected to the peer.
+	connected bool
+
+	// forceCloseRequests are the channels the peer was asked to force
+	// close.
+	forceCloseRequests []lnwire.ChannelID
+}
+
+// chanRecovery tracks the recovery of the funds of the channels lost along
+// with the channel database, once the wallet was restored from its seed.
+//
+// The channels restored from static channel backups are recovered through the
+// data loss protection protocol: once its peer is reconnected, each channel is
+// reestablished to learn the latest commitment point of the peer, which then
+// force closes the channel, and the chain arbitrator sweeps our output of the
+// peer's commitment. Channels missing from the backups are only known to their
+// peer, so the configured recovery peers are asked to force close any channel
+// they try to reestablish with us that we know nothing about.
+type chanRecovery struct {
+	db *channeldb.DB
+
+	// peers are the recovery peers, keyed by their public key.
+	peers map[route.Vertex]*lnwire.NetAddress
+
+	// isConnected returns true if we're connected to the given peer.
+	isConnected func(*secp256k1.PublicKey) bool
+
+	// connectPeer persistently connects to the given peer.
+	connectPeer func(*lnwire.NetAddress) error
+
+	mu sync.Mutex
+
+	// forceCloseRequests holds the unknown channels each recovery peer
+	// was asked to force close.
+	forceCloseRequests map[route.Vertex][]lnwire.ChannelID
+}
+
+// newChanRecovery creates a new channel recovery tracker with the given
+// recovery peers.
+func newChanRecovery(db *channeldb.DB, peers []*lnwire.NetAddress,
+	isConnected func(*secp256k1.PublicKey) bool,
+	connectPeer func(*lnwire.NetAddress) error) *chanRecovery {
+
+	peerSet := make(map[route.Vertex]*lnwire.NetAddress, len(peers))
+	for _, peer := range peers {
+		peerSet[route.NewVertex(peer.IdentityKey)] = peer
+	}
+
+	return &chanRecovery{
+		db:                 db,
+		peers:              peerSet,
+		isConnected:        isConnected,
+		connectPeer:        connectPeer,
+		forceCloseRequests: make(map[route.Vertex][]lnwire.ChannelID),
+	}
+}
+
+// connectPeers persistently connects to the recovery peers. The peers of the
+// channels restored from static channel backups are connected to along with
+// the peers of all other channels.
+func (c *chanRecovery) connectPeers() {
+	for _, addr := range c.peers {
+		ltndLog.Infof("Connecting to recovery peer %v", addr)
+
+		err := c.connectPeer(addr)
+		if _, ok := err.(*errPeerAlreadyConnected); ok {
+			continue
+		}
+		if err != nil {
+			ltndLog.Errorf("Unable to connect to recovery peer "+
+				"%v: %v", addr, err)
+		}
+	}
+}
+
+// isRecoveryPeer returns true if the given peer is a recovery peer.
+func (c *chanRecovery) isRecoveryPeer(pub route.Vertex) bool {
+	_, ok := c.peers[pub]
+	return ok
+}
+
+// isKnownChannel returns true if the given channel is either open or closed
+// in the database.
+func (c *chanRecovery) isKnownChannel(cid lnwire.ChannelID) (bool, error) {
+	channels, err := c.db.FetchAllChannels()
+	if err != nil {
+		return false, err
+	}
+	for _, channel := range channels {
+		if cid.IsChanPoint(&channel.FundingOutpoint) {
+			return true, nil
+		}
+	}
+
+	_, err = c.db.FetchClosedChannelForID(cid)
+	switch {
+	case err == channeldb.ErrClosedChannelNotFound:
+		return false, nil
+
+	case err != nil:
+		return false, err
+	}
+
+	return true, nil
+}
+
+// forceCloseRequest returns the message asking the given recovery peer to
+// force close the given channel, or nil if the peer isn't a recovery peer or
+// if the channel is known to us.
+//
+// The request is a channel reestablish message claiming that we don't have
+// any commitment, so that the peer concludes that we lost our state and
+// force closes the channel, as required by the data loss protection protocol.
+func (c *chanRecovery) forceCloseRequest(pub route.Vertex,
+	cid lnwire.ChannelID) (*lnwire.ChannelReestablish, error) {
+
+	if !c.isRecoveryPeer(pub) {
+		return nil, nil
+	}
+
+	known, err := c.isKnownChannel(cid)
+	if err != nil || known {
+		return nil, err
+	}
+
+	// We don't know any commitment point of the channel, but the message
+	// must carry a valid one.
+	commitPoint, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	requests := c.forceCloseRequests[pub]
+	requested := false
+	for _, requestedID := range requests {
+		if requestedID == cid {
+			requested = true
+			break
+		}
+	}
+	if !requested {
+		c.forceCloseRequests[pub] = append(requests, cid)
+	}
+	c.mu.Unlock()
+
+	return &lnwire.ChannelReestablish{
+		ChanID:                    cid,
+		NextLocalCommitHeight:     0,
+		RemoteCommitTailHeight:    0,
+		LocalUnrevokedCommitPoint: commitPoint.PubKey(),
+	}, nil
+}
+
+// channels returns the channels restored from static channel backups, along
+// with the stage their recovery reached.
+func (c *chanRecovery) channels() ([]*recoveringChannel, error) {
+	var channels []*recoveringChannel
+
+	// The restored channels which aren't closed yet are still open, until
+	// their peer force closes them.
+	openChannels, err := c.db.FetchAllChannels()
+	if err != nil {
+		return nil, err
+	}
+	for _, channel := range openChannels {
+		if !channel.HasChanStatus(channeldb.ChanStatusRestored) {
+			continue
+		}
+
+		stage := recoveryWaitingForPeer
+		switch {
+		case channel.HasChanStatus(channeldb.ChanStatusLocalDataLoss):
+			stage = recoveryWaitingForForceClose
+
+		case c.isConnected(channel.IdentityPub):
+			stage = recoveryDataLossProtect
+		}
+
+		channels = append(channels, &recoveringChannel{
+			chanPoint: channel.FundingOutpoint.String(),
+			remotePub: channel.IdentityPub,
+			stage:     stage,
+		})
+	}
+
+	// The closed channels which were restored are found through their
+	// historical state, which keeps their status.
+	closedChannels, err := c.db.FetchClosedChannels(false)
+	if err != nil {
+		return nil, err
+	}
+	for _, summary := range closedChannels {
+		channel, err := c.db.FetchHistoricalChannel(&summary.ChanPoint)
+		if err != nil {
+			continue
+		}
+		if !channel.HasChanStatus(channeldb.ChanStatusRestored) {
+			continue
+		}
+
+		stage := recoveryRecovered
+		if summary.IsPending {
+			stage = recoverySweeping
+		}
+
+		channels = append(channels, &recoveringChannel{
+			chanPoint:    summary.ChanPoint.String(),
+			remotePub:    summary.RemotePub,
+			stage:        stage,
+			closeSummary: summary,
+		})
+	}
+
+	return channels, nil
+}
+
+// peerStatuses returns the status of the recovery peers.
+func (c *chanRecovery) peerStatuses() []*recoveryPeerStatus {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	statuses := make([]*recoveryPeerStatus, 0, len(c.peers))
+	for pub, addr := range c.peers {
+		requests := make(
+			[]lnwire.ChannelID, len(c.forceCloseRequests[pub]),
+		)
+		copy(requests, c.forceCloseRequests[pub])
+
+		statuses = append(statuses, &recoveryPeerStatus{
+			addr:               addr,
+			connected:          c.isConnected(addr.IdentityKey),
+			forceCloseRequests: requests,
+		})
+	}
+
+	return statuses
+}
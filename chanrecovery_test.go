@@ -0,0 +1,110 @@
+// +build !rpctest
+
+package dcrlnd
+
+import (
+	"encoding/hex"
+	"net"
+	"testing"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/decred/dcrd/dcrec/secp256k1/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/routing/route"
+)
+
+const testRecoveryPeerKey = "02a1633cafcc01ebfb6d78e39f687a1f0995c62fc95f51" +
+	"ead10a02ee0be551b5dc"
+
+// TestChanRecoveryForceCloseRequest asserts that only the recovery peers are
+// asked to force close the channels unknown to us, and that the requests are
+// reported along with the status of the peers.
+func TestChanRecoveryForceCloseRequest(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	keyBytes, _ := hex.DecodeString(testRecoveryPeerKey)
+	recoveryKey, err := secp256k1.ParsePubKey(keyBytes)
+	if err != nil {
+		t.Fatalf("unable to parse pubkey: %v", err)
+	}
+	recoveryAddr := &lnwire.NetAddress{
+		IdentityKey: recoveryKey,
+		Address:     &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 9735},
+	}
+
+	recovery := newChanRecovery(
+		cdb, []*lnwire.NetAddress{recoveryAddr},
+		func(*secp256k1.PublicKey) bool { return true },
+		func(*lnwire.NetAddress) error { return nil },
+	)
+
+	cid := lnwire.NewChanIDFromOutPoint(&wire.OutPoint{Index: 1})
+
+	// A peer which isn't a recovery peer isn't asked anything.
+	otherPriv, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	msg, err := recovery.forceCloseRequest(
+		route.NewVertex(otherPriv.PubKey()), cid,
+	)
+	if err != nil {
+		t.Fatalf("unable to make force close request: %v", err)
+	}
+	if msg != nil {
+		t.Fatalf("unexpected force close request to non recovery peer")
+	}
+
+	// A recovery peer is asked to force close the unknown channel, with a
+	// reestablish message claiming we have no commitment, once however
+	// many times it reestablishes it.
+	recoveryPub := route.NewVertex(recoveryKey)
+	for i := 0; i < 2; i++ {
+		msg, err = recovery.forceCloseRequest(recoveryPub, cid)
+		if err != nil {
+			t.Fatalf("unable to make force close request: %v", err)
+		}
+		if msg == nil {
+			t.Fatalf("expected force close request")
+		}
+		if msg.ChanID != cid || msg.NextLocalCommitHeight != 0 ||
+			msg.RemoteCommitTailHeight != 0 ||
+			msg.LocalUnrevokedCommitPoint == nil {
+
+			t.Fatalf("unexpected force close request: %v",
+				spew.Sdump(msg))
+		}
+	}
+
+	statuses := recovery.peerStatuses()
+	if len(statuses) != 1 {
+		t.Fatalf("expected 1 recovery peer, got %d", len(statuses))
+	}
+	status := statuses[0]
+	if status.addr != recoveryAddr || !status.connected {
+		t.Fatalf("unexpected recovery peer status: %v",
+			spew.Sdump(status))
+	}
+	if len(status.forceCloseRequests) != 1 ||
+		status.forceCloseRequests[0] != cid {
+
+		t.Fatalf("unexpected force close requests: %v",
+			status.forceCloseRequests)
+	}
+
+	// No channel was restored, so none is being recovered.
+	channels, err := recovery.channels()
+	if err != nil {
+		t.Fatalf("unable to fetch recovering channels: %v", err)
+	}
+	if len(channels) != 0 {
+		t.Fatalf("expected no recovering channel, got %d", len(channels))
+	}
+}
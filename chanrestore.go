@@ -6,6 +6,7 @@ import (
 
 	"github.com/decred/dcrd/chaincfg/chainhash"
 	"github.com/decred/dcrd/dcrec/secp256k1/v2"
+	"github.com/decred/dcrlnd/brontide"
 	"github.com/decred/dcrlnd/chanbackup"
 	"github.com/decred/dcrlnd/channeldb"
 	"github.com/decred/dcrlnd/contractcourt"
@@ -92,6 +93,9 @@ func (c *chanDBRestorer) openChannelShell(backup chanbackup.Single) (
 	case chanbackup.TweaklessCommitVersion:
 		chanType = channeldb.SingleFunderTweakless
 
+	case chanbackup.AnchorsCommitVersion:
+		chanType = channeldb.SingleFunderAnchors
+
 	default:
 		return nil, fmt.Errorf("unknown Single version: %v", err)
 	}
@@ -216,3 +220,40 @@ func (s *server) ConnectPeer(nodePub *secp256k1.PublicKey, addrs []net.Addr) err
 	return fmt.Errorf("unable to connect to peer %x for SCB restore",
 		nodePub.SerializeCompressed())
 }
+
+// A compile-time constraint to ensure server implements
+// chanbackup.PeerChecker.
+var _ chanbackup.PeerChecker = (*server)(nil)
+
+// CheckPeer checks whether the target node is reachable at any of the set of
+// available addresses, without taking part in any channel restoration. A
+// successful connection attempt is torn down immediately afterwards.
+//
+// NOTE: Part of the chanbackup.PeerChecker interface.
+func (s *server) CheckPeer(nodePub *secp256k1.PublicKey, addrs []net.Addr) error {
+	// If we're already connected to this peer, then it's clearly
+	// reachable.
+	if _, err := s.FindPeer(nodePub); err == nil {
+		return nil
+	}
+
+	for _, addr := range addrs {
+		netAddr := &lnwire.NetAddress{
+			IdentityKey: nodePub,
+			Address:     addr,
+		}
+
+		conn, err := brontide.Dial(s.identityPriv, netAddr, cfg.net.Dial)
+		if err != nil {
+			ltndLog.Debugf("Unable to reach %v for SCB restore "+
+				"check: %v", netAddr, err)
+			continue
+		}
+		conn.Close()
+
+		return nil
+	}
+
+	return fmt.Errorf("unable to reach peer %x at any of %v addresses",
+		nodePub.SerializeCompressed(), len(addrs))
+}
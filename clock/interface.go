@@ -0,0 +1,15 @@
+package clock
+
+import "time"
+
+// Clock is an interface that provides time functions to the subsystems of the
+// daemon. Injecting it rather than relying on the time package directly
+// allows tests and simulations to control the passing of time.
+type Clock interface {
+	// Now returns the current local time, as defined by the clock.
+	Now() time.Time
+
+	// TickAfter returns a channel that will receive a tick after the
+	// specified duration has passed, as defined by the clock.
+	TickAfter(duration time.Duration) <-chan time.Time
+}
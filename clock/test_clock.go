@@ -0,0 +1,69 @@
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// TestClock can be used in tests to mock time. Its time only moves when set
+// explicitly through SetTime, which also delivers the ticks that are due.
+type TestClock struct {
+	currentTime time.Time
+	timeChanMap map[time.Time][]chan time.Time
+	timeLock    sync.Mutex
+}
+
+// NewTestClock returns a new test clock set to the given start time.
+func NewTestClock(startTime time.Time) *TestClock {
+	return &TestClock{
+		currentTime: startTime,
+		timeChanMap: make(map[time.Time][]chan time.Time),
+	}
+}
+
+// Now returns the current time of the test clock.
+func (c *TestClock) Now() time.Time {
+	c.timeLock.Lock()
+	defer c.timeLock.Unlock()
+
+	return c.currentTime
+}
+
+// TickAfter returns a channel that will receive a tick once the time of the
+// test clock is set to at least the current time plus the passed duration.
+func (c *TestClock) TickAfter(duration time.Duration) <-chan time.Time {
+	c.timeLock.Lock()
+	defer c.timeLock.Unlock()
+
+	triggerTime := c.currentTime.Add(duration)
+	ch := make(chan time.Time, 1)
+
+	// If the trigger time is already reached, tick right away.
+	if !triggerTime.After(c.currentTime) {
+		ch <- c.currentTime
+		return ch
+	}
+
+	c.timeChanMap[triggerTime] = append(c.timeChanMap[triggerTime], ch)
+
+	return ch
+}
+
+// SetTime sets the time of the test clock and delivers the ticks that are due
+// at the new time.
+func (c *TestClock) SetTime(now time.Time) {
+	c.timeLock.Lock()
+	defer c.timeLock.Unlock()
+
+	c.currentTime = now
+	for triggerTime, chans := range c.timeChanMap {
+		if triggerTime.After(now) {
+			continue
+		}
+
+		for _, ch := range chans {
+			ch <- now
+		}
+		delete(c.timeChanMap, triggerTime)
+	}
+}
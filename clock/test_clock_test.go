@@ -0,0 +1,45 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+var testTime = time.Date(2009, time.January, 3, 12, 0, 0, 0, time.UTC)
+
+// TestTestClock asserts that the test clock only moves when its time is set,
+// and that ticks are delivered once they're due.
+func TestTestClock(t *testing.T) {
+	t.Parallel()
+
+	c := NewTestClock(testTime)
+	if !c.Now().Equal(testTime) {
+		t.Fatalf("expected %v, got %v", testTime, c.Now())
+	}
+
+	tick := c.TickAfter(time.Minute)
+
+	c.SetTime(testTime.Add(30 * time.Second))
+	select {
+	case <-tick:
+		t.Fatalf("unexpected tick")
+	default:
+	}
+
+	c.SetTime(testTime.Add(time.Minute))
+	select {
+	case now := <-tick:
+		if !now.Equal(testTime.Add(time.Minute)) {
+			t.Fatalf("unexpected tick time %v", now)
+		}
+	default:
+		t.Fatalf("expected tick")
+	}
+
+	// A tick that is already due is delivered right away.
+	select {
+	case <-c.TickAfter(0):
+	default:
+		t.Fatalf("expected tick")
+	}
+}
@@ -0,0 +1,114 @@
+package clock
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrNegativeWarp is returned when attempting to turn a WarpClock back in
+// time.
+var ErrNegativeWarp = errors.New("clock can only be warped forward")
+
+// warpTick is a tick of a WarpClock that is yet to be delivered.
+type warpTick struct {
+	deadline time.Time
+	timer    *time.Timer
+	c        chan time.Time
+}
+
+// WarpClock is a Clock that follows the system time, but can be fast-forwarded
+// by an arbitrary duration at runtime. Ticks that become due by a warp are
+// delivered right away. It is meant for integration tests on regtest and
+// simnet, that need to observe the time based behavior of a running node,
+// such as invoices expiring, without actually waiting for it.
+type WarpClock struct {
+	mtx sync.Mutex
+
+	// offset is the total duration the clock was warped by.
+	offset time.Duration
+
+	// pending holds the ticks that are yet to be delivered.
+	pending map[*warpTick]struct{}
+}
+
+// NewWarpClock returns a WarpClock that is initially in sync with the system
+// time.
+func NewWarpClock() *WarpClock {
+	return &WarpClock{
+		pending: make(map[*warpTick]struct{}),
+	}
+}
+
+// Now returns the system time, shifted by the duration the clock was warped
+// by.
+func (c *WarpClock) Now() time.Time {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return time.Now().Add(c.offset)
+}
+
+// TickAfter returns a channel that will receive a tick after the specified
+// duration has passed, either in real time or through a warp of the clock.
+func (c *WarpClock) TickAfter(duration time.Duration) <-chan time.Time {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	tick := &warpTick{
+		deadline: time.Now().Add(c.offset + duration),
+		c:        make(chan time.Time, 1),
+	}
+	c.pending[tick] = struct{}{}
+	tick.timer = time.AfterFunc(duration, func() {
+		c.mtx.Lock()
+		defer c.mtx.Unlock()
+
+		c.deliver(tick)
+	})
+
+	return tick.c
+}
+
+// Warp moves the clock forward by the passed duration, delivering all the
+// ticks that become due.
+func (c *WarpClock) Warp(duration time.Duration) error {
+	if duration < 0 {
+		return ErrNegativeWarp
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.offset += duration
+
+	now := time.Now().Add(c.offset)
+	for tick := range c.pending {
+		if !tick.deadline.After(now) {
+			c.deliver(tick)
+		}
+	}
+
+	return nil
+}
+
+// Offset returns the total duration the clock was warped by.
+func (c *WarpClock) Offset() time.Duration {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return c.offset
+}
+
+// deliver sends the passed tick, unless it was delivered already.
+//
+// NOTE: The mutex of the clock MUST be held when calling this method.
+func (c *WarpClock) deliver(tick *warpTick) {
+	if _, ok := c.pending[tick]; !ok {
+		return
+	}
+	delete(c.pending, tick)
+
+	tick.timer.Stop()
+	tick.c <- time.Now().Add(c.offset)
+}
@@ -0,0 +1,52 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+// TestWarpClock asserts that warping the clock moves its time forward and
+// delivers the ticks that become due.
+func TestWarpClock(t *testing.T) {
+	t.Parallel()
+
+	c := NewWarpClock()
+
+	hourTick := c.TickAfter(time.Hour)
+	dayTick := c.TickAfter(24 * time.Hour)
+
+	before := c.Now()
+	if err := c.Warp(2 * time.Hour); err != nil {
+		t.Fatalf("unable to warp clock: %v", err)
+	}
+	if c.Now().Sub(before) < 2*time.Hour {
+		t.Fatalf("expected clock to be warped by 2h, got %v",
+			c.Now().Sub(before))
+	}
+	if c.Offset() != 2*time.Hour {
+		t.Fatalf("expected offset of 2h, got %v", c.Offset())
+	}
+
+	select {
+	case <-hourTick:
+	default:
+		t.Fatalf("expected tick")
+	}
+
+	select {
+	case <-dayTick:
+		t.Fatalf("unexpected tick")
+	default:
+	}
+
+	if err := c.Warp(-time.Hour); err != ErrNegativeWarp {
+		t.Fatalf("expected ErrNegativeWarp, got %v", err)
+	}
+
+	// Ticks are still delivered in real time.
+	select {
+	case <-c.TickAfter(time.Millisecond):
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expected tick")
+	}
+}
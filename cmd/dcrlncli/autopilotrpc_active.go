@@ -4,6 +4,9 @@ package main
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/decred/dcrlnd/lnrpc/autopilotrpc"
 	"github.com/urfave/cli"
@@ -143,6 +146,61 @@ loop:
 	return nil
 }
 
+var setScoresCommand = cli.Command{
+	Name:      "setscores",
+	Usage:     "Set the scores of an externally scored autopilot heuristic.",
+	ArgsUsage: "[flags] <pubkey>:<score> <pubkey>:<score> ...",
+	Description: `
+	Set the scores given by an externally scored heuristic, such as the
+	externalscore heuristic, to the given nodes. Scores must be in the range
+	[0.0, 1.0], and are blended by autopilot with the scores of the other
+	active heuristics according to their weights. Nodes not part of the
+	given scores are given a score of 0.`,
+	Action: actionDecorator(setScores),
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "heuristic",
+			Usage: "the name of the heuristic to set the scores of",
+			Value: "externalscore",
+		},
+	},
+}
+
+func setScores(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getAutopilotClient(ctx)
+	defer cleanUp()
+
+	scores := make(map[string]float64)
+	for _, arg := range ctx.Args() {
+		parts := strings.Split(arg, ":")
+		if len(parts) != 2 {
+			return fmt.Errorf("invalid node score %v, expected "+
+				"<pubkey>:<score>", arg)
+		}
+
+		score, err := strconv.ParseFloat(parts[1], 64)
+		if err != nil {
+			return fmt.Errorf("invalid score for %v: %v", parts[0],
+				err)
+		}
+		scores[parts[0]] = score
+	}
+
+	req := &autopilotrpc.SetScoresRequest{
+		Heuristic: ctx.String("heuristic"),
+		Scores:    scores,
+	}
+
+	resp, err := client.SetScores(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 // autopilotCommands will return the set of commands to enable for autopilotrpc
 // builds.
 func autopilotCommands() []cli.Command {
@@ -157,6 +215,7 @@ func autopilotCommands() []cli.Command {
 				enableCommand,
 				disableCommand,
 				queryScoresCommand,
+				setScoresCommand,
 			},
 		},
 	}
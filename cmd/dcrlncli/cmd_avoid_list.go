@@ -0,0 +1,144 @@
+// +build routerrpc
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/decred/dcrlnd/lnrpc/routerrpc"
+	"github.com/decred/dcrlnd/routing/route"
+	"github.com/urfave/cli"
+)
+
+var avoidListFlags = []cli.Flag{
+	cli.StringFlag{
+		Name:  "nodes",
+		Usage: "comma separated hex pubkeys",
+	},
+	cli.Int64SliceFlag{
+		Name:  "chan_id",
+		Usage: "short channel id, can be specified multiple times",
+	},
+}
+
+var addToAvoidListCommand = cli.Command{
+	Name:     "addtoavoidlist",
+	Category: "Payments",
+	Usage:    "Exclude nodes and channels from path finding.",
+	Description: `
+	Add nodes and channels to the persistent avoid list. Nodes and channels
+	on the avoid list are never used for path finding, until they are
+	removed from the list again.`,
+	Flags:  avoidListFlags,
+	Action: actionDecorator(addToAvoidList),
+}
+
+func addToAvoidList(ctx *cli.Context) error {
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+
+	req, err := parseAvoidListRequest(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.AddToAvoidList(context.Background(), req)
+	return err
+}
+
+var removeFromAvoidListCommand = cli.Command{
+	Name:     "removefromavoidlist",
+	Category: "Payments",
+	Usage:    "Remove nodes and channels from the avoid list.",
+	Flags:    avoidListFlags,
+	Action:   actionDecorator(removeFromAvoidList),
+}
+
+func removeFromAvoidList(ctx *cli.Context) error {
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+
+	req, err := parseAvoidListRequest(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.RemoveFromAvoidList(context.Background(), req)
+	return err
+}
+
+var queryAvoidListCommand = cli.Command{
+	Name:     "queryavoidlist",
+	Category: "Payments",
+	Usage:    "Query the nodes and channels on the avoid list.",
+	Action:   actionDecorator(queryAvoidList),
+}
+
+func queryAvoidList(ctx *cli.Context) error {
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+
+	req := &routerrpc.QueryAvoidListRequest{}
+	resp, err := client.QueryAvoidList(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	type displayResp struct {
+		Nodes   []string `json:"nodes"`
+		ChanIds []uint64 `json:"chan_ids"`
+	}
+
+	displayNodes := make([]string, 0, len(resp.Nodes))
+	for _, n := range resp.Nodes {
+		node, err := route.NewVertexFromBytes(n)
+		if err != nil {
+			return err
+		}
+		displayNodes = append(displayNodes, node.String())
+	}
+
+	printJSON(&displayResp{
+		Nodes:   displayNodes,
+		ChanIds: resp.ChanIds,
+	})
+
+	return nil
+}
+
+// parseAvoidListRequest parses the nodes and channels passed on the command
+// line into an avoid list update request.
+func parseAvoidListRequest(ctx *cli.Context) (*routerrpc.UpdateAvoidListRequest,
+	error) {
+
+	if !ctx.IsSet("nodes") && !ctx.IsSet("chan_id") {
+		return nil, errors.New("nodes or chan_id required")
+	}
+
+	req := &routerrpc.UpdateAvoidListRequest{}
+	if ctx.IsSet("nodes") {
+		for _, k := range strings.Split(ctx.String("nodes"), ",") {
+			node, err := route.NewVertexFromStr(k)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing %v: %v",
+					k, err)
+			}
+			req.Nodes = append(req.Nodes, node[:])
+		}
+	}
+
+	for _, chanID := range ctx.Int64Slice("chan_id") {
+		req.ChanIds = append(req.ChanIds, uint64(chanID))
+	}
+
+	return req, nil
+}
@@ -0,0 +1,47 @@
+// +build routerrpc
+
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/decred/dcrlnd/lnrpc/routerrpc"
+	"github.com/urfave/cli"
+)
+
+var cancelPaymentCommand = cli.Command{
+	Name:      "cancelpayment",
+	Category:  "Payments",
+	Usage:     "Cancel an in-flight payment.",
+	ArgsUsage: "hash",
+	Description: `
+	Stop launching new attempts for the in-flight payment identified by the
+	passed payment hash. If an htlc of the payment is still outstanding, the
+	payment only reaches its final state once that htlc resolves.`,
+	Action: actionDecorator(cancelPayment),
+}
+
+func cancelPayment(ctx *cli.Context) error {
+	args := ctx.Args()
+	if !args.Present() {
+		return cli.ShowCommandHelp(ctx, "cancelpayment")
+	}
+
+	hash, err := hex.DecodeString(args.First())
+	if err != nil {
+		return fmt.Errorf("unable to decode payment hash: %v", err)
+	}
+
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+
+	req := &routerrpc.CancelPaymentRequest{
+		PaymentHash: hash,
+	}
+	_, err = client.CancelPayment(context.Background(), req)
+	return err
+}
@@ -0,0 +1,121 @@
+// +build routerrpc
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/decred/dcrlnd/lnrpc/routerrpc"
+	"github.com/urfave/cli"
+)
+
+var lookupCircuitCommand = cli.Command{
+	Name:      "lookupcircuit",
+	Category:  "Channels",
+	Usage:     "Look up the circuit of a forwarded htlc.",
+	ArgsUsage: "chan_id htlc_id",
+	Description: `
+	Look up the circuit the switch holds for the htlc identified by the short
+	channel id of its incoming channel and its index within that channel, and
+	display its state.`,
+	Action: actionDecorator(lookupCircuit),
+}
+
+func lookupCircuit(ctx *cli.Context) error {
+	chanID, htlcID, err := parseCircuitKey(ctx, "lookupcircuit")
+	if err != nil {
+		return err
+	}
+
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+
+	req := &routerrpc.LookupCircuitRequest{
+		ChanId: chanID,
+		HtlcId: htlcID,
+	}
+	resp, err := client.LookupCircuit(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var failCircuitCommand = cli.Command{
+	Name:      "failcircuit",
+	Category:  "Channels",
+	Usage:     "Manually fail back the htlc of a stuck circuit.",
+	ArgsUsage: "chan_id htlc_id",
+	Description: `
+	Fail back the htlc identified by the short channel id of its incoming
+	channel and its index within that channel to the incoming peer,
+	regardless of the state of the outgoing htlc.
+
+	This is only meant to recover from circuits stuck because of a bug,
+	without closing the incoming channel. If the outgoing htlc is settled
+	afterwards, its amount is lost.`,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "force",
+			Usage: "skip the confirmation prompt",
+		},
+	},
+	Action: actionDecorator(failCircuit),
+}
+
+func failCircuit(ctx *cli.Context) error {
+	chanID, htlcID, err := parseCircuitKey(ctx, "failcircuit")
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Bool("force") {
+		msg := "Fail back the htlc regardless of its outgoing htlc? " +
+			"Its amount is lost if the outgoing htlc is settled " +
+			"afterwards. (yes/no): "
+		if !promptForConfirmation(msg) {
+			return nil
+		}
+	}
+
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+
+	req := &routerrpc.FailCircuitRequest{
+		ChanId: chanID,
+		HtlcId: htlcID,
+	}
+	_, err = client.FailCircuit(context.Background(), req)
+	return err
+}
+
+// parseCircuitKey parses the incoming channel and htlc ids identifying a
+// circuit from the positional arguments of a command.
+func parseCircuitKey(ctx *cli.Context, cmd string) (uint64, uint64, error) {
+	args := ctx.Args()
+	if len(args) != 2 {
+		cli.ShowCommandHelp(ctx, cmd)
+		return 0, 0, errors.New("chan_id and htlc_id must be " +
+			"specified")
+	}
+
+	chanID, err := strconv.ParseUint(args.Get(0), 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("unable to decode chan_id: %v", err)
+	}
+	htlcID, err := strconv.ParseUint(args.Get(1), 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("unable to decode htlc_id: %v", err)
+	}
+
+	return chanID, htlcID, nil
+}
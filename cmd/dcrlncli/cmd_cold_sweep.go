@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/decred/dcrlnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var setColdSweepCommand = cli.Command{
+	Name:     "setcoldsweep",
+	Category: "On-chain",
+	Usage:    "Enable or disable the scheduled sweeps to cold storage.",
+	Description: `
+	Enable or disable the scheduled sweeps of the confirmed balance of the
+	wallet above the configured threshold to the configured cold storage
+	address. Sweeps are only available when a cold storage address is set
+	with --coldsweep.address.`,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "enable",
+			Usage: "enable the scheduled sweeps",
+		},
+		cli.BoolFlag{
+			Name:  "disable",
+			Usage: "disable the scheduled sweeps",
+		},
+	},
+	Action: actionDecorator(setColdSweep),
+}
+
+func setColdSweep(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	enable, disable := ctx.Bool("enable"), ctx.Bool("disable")
+	if enable == disable {
+		return fmt.Errorf("exactly one of --enable or --disable must " +
+			"be set")
+	}
+
+	resp, err := client.SetColdSweep(ctxb, &lnrpc.SetColdSweepRequest{
+		Enable: enable,
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var subscribeColdSweepsCommand = cli.Command{
+	Name:     "subscribecoldsweeps",
+	Category: "On-chain",
+	Usage:    "Subscribe to the events of the sweeps to cold storage.",
+	Description: `
+	Print the sweeps to cold storage being published or failing, and the
+	scheduled sweeps being enabled or disabled as they happen, until the
+	command is interrupted.`,
+	Action: actionDecorator(subscribeColdSweeps),
+}
+
+func subscribeColdSweeps(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	stream, err := client.SubscribeColdSweeps(
+		ctxb, &lnrpc.ColdSweepSubscription{},
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(event)
+	}
+}
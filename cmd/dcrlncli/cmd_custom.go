@@ -0,0 +1,133 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/decred/dcrlnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var sendCustomCommand = cli.Command{
+	Name:      "sendcustom",
+	Category:  "Peers",
+	Usage:     "Send a custom message to a connected peer.",
+	ArgsUsage: "peer type data",
+	Description: `
+	Send a custom message to a connected peer. The type of the message must
+	be within the custom range, starting at 32768, and its data is passed
+	hex encoded.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "peer",
+			Usage: "the public key of the peer to send the message to",
+		},
+		cli.Uint64Flag{
+			Name:  "type",
+			Usage: "the type of the message",
+		},
+		cli.StringFlag{
+			Name:  "data",
+			Usage: "the hex encoded data of the message",
+		},
+	},
+	Action: actionDecorator(sendCustom),
+}
+
+func sendCustom(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// Show command help if no arguments and flags were provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		return cli.ShowCommandHelp(ctx, "sendcustom")
+	}
+
+	args := ctx.Args()
+
+	var peerStr string
+	switch {
+	case ctx.IsSet("peer"):
+		peerStr = ctx.String("peer")
+	case args.Present():
+		peerStr = args.First()
+		args = args.Tail()
+	default:
+		return fmt.Errorf("peer argument missing")
+	}
+	peer, err := hex.DecodeString(peerStr)
+	if err != nil {
+		return fmt.Errorf("unable to decode peer pubkey: %v", err)
+	}
+
+	var msgType uint64
+	switch {
+	case ctx.IsSet("type"):
+		msgType = ctx.Uint64("type")
+	case args.Present():
+		_, err := fmt.Sscan(args.First(), &msgType)
+		if err != nil {
+			return fmt.Errorf("unable to decode message type: %v",
+				err)
+		}
+		args = args.Tail()
+	default:
+		return fmt.Errorf("type argument missing")
+	}
+
+	var dataStr string
+	switch {
+	case ctx.IsSet("data"):
+		dataStr = ctx.String("data")
+	case args.Present():
+		dataStr = args.First()
+	}
+	data, err := hex.DecodeString(dataStr)
+	if err != nil {
+		return fmt.Errorf("unable to decode message data: %v", err)
+	}
+
+	_, err = client.SendCustomMessage(
+		ctxb, &lnrpc.SendCustomMessageRequest{
+			Peer: peer,
+			Type: uint32(msgType),
+			Data: data,
+		},
+	)
+	return err
+}
+
+var subscribeCustomCommand = cli.Command{
+	Name:     "subscribecustom",
+	Category: "Peers",
+	Usage:    "Subscribe to the custom messages received from peers.",
+	Description: `
+	Print the custom messages received from peers as they arrive, until the
+	command is interrupted.`,
+	Action: actionDecorator(subscribeCustom),
+}
+
+func subscribeCustom(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	stream, err := client.SubscribeCustomMessages(
+		ctxb, &lnrpc.SubscribeCustomMessagesRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Received from peer %x: type=%d, data=%x\n",
+			msg.Peer, msg.Type, msg.Data)
+	}
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/decred/dcrlnd/channeldb"
+	"github.com/urfave/cli"
+)
+
+const defaultGraphSubDir = "graph"
+
+var dbEncryptionFlags = []cli.Flag{
+	cli.StringFlag{
+		Name: "dbdir",
+		Usage: "(optional) the directory of the channel database, " +
+			"by default the one of the network within dcrlnddir",
+	},
+	cli.StringFlag{
+		Name: "keyfile",
+		Usage: "(optional) the key file the database is encrypted " +
+			"with, as set with dbencryption.keyfile, instead of " +
+			"the wallet password",
+	},
+}
+
+var encryptDBCommand = cli.Command{
+	Name:     "encryptdb",
+	Category: "Startup",
+	Usage:    "Encrypt an existing channel database.",
+	Description: `
+	Encrypt the channel database of a node that isn't running, using either
+	the wallet password or the content of the key file. This migrates an
+	existing database to its encrypted form, which dcrlnd decrypts at unlock
+	once started with dbencryption.active set.
+
+	This command doesn't connect to dcrlnd, which must be stopped.`,
+	Flags:  dbEncryptionFlags,
+	Action: actionDecorator(encryptDB),
+}
+
+func encryptDB(ctx *cli.Context) error {
+	dbDir, err := dbEncryptionDir(ctx)
+	if err != nil {
+		return err
+	}
+
+	if channeldb.IsDBEncrypted(dbDir) {
+		return fmt.Errorf("channel database in %v is already encrypted",
+			dbDir)
+	}
+
+	password, err := dbEncryptionPassword(ctx, true)
+	if err != nil {
+		return err
+	}
+
+	if err := channeldb.EncryptDB(dbDir, password); err != nil {
+		return fmt.Errorf("unable to encrypt channel database: %v", err)
+	}
+
+	fmt.Printf("Channel database in %v encrypted\n", dbDir)
+	return nil
+}
+
+var decryptDBCommand = cli.Command{
+	Name:     "decryptdb",
+	Category: "Startup",
+	Usage:    "Decrypt an encrypted channel database.",
+	Description: `
+	Decrypt the channel database of a node that isn't running, using either
+	the wallet password or the content of the key file it was encrypted
+	with. This allows dcrlnd to be started without dbencryption.active set.
+
+	This command doesn't connect to dcrlnd, which must be stopped.`,
+	Flags:  dbEncryptionFlags,
+	Action: actionDecorator(decryptDB),
+}
+
+func decryptDB(ctx *cli.Context) error {
+	dbDir, err := dbEncryptionDir(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !channeldb.IsDBEncrypted(dbDir) {
+		return fmt.Errorf("no encrypted channel database in %v", dbDir)
+	}
+
+	password, err := dbEncryptionPassword(ctx, false)
+	if err != nil {
+		return err
+	}
+
+	if err := channeldb.DecryptDB(dbDir, password); err != nil {
+		return fmt.Errorf("unable to decrypt channel database: %v", err)
+	}
+
+	// The database is decrypted for good, so its encrypted form, which
+	// would otherwise remain next to it, is removed.
+	if err := channeldb.RemoveEncryptedDB(dbDir); err != nil {
+		return fmt.Errorf("unable to remove encrypted channel "+
+			"database: %v", err)
+	}
+
+	fmt.Printf("Channel database in %v decrypted\n", dbDir)
+	return nil
+}
+
+// dbEncryptionDir returns the directory of the channel database, either set
+// with --dbdir or the one of the active network within dcrlnddir.
+func dbEncryptionDir(ctx *cli.Context) (string, error) {
+	if ctx.IsSet("dbdir") {
+		return cleanAndExpandPath(ctx.String("dbdir")), nil
+	}
+
+	network := strings.ToLower(ctx.GlobalString("network"))
+	switch network {
+	case "mainnet", "testnet", "regtest", "simnet":
+	default:
+		return "", fmt.Errorf("unknown network: %v", network)
+	}
+
+	lndDir := cleanAndExpandPath(ctx.GlobalString("dcrlnddir"))
+	return filepath.Join(
+		lndDir, defaultDataDir, defaultGraphSubDir, network,
+	), nil
+}
+
+// dbEncryptionPassword returns the content of the key file if set, or
+// otherwise prompts for the wallet password, which is confirmed if the
+// database is being encrypted.
+func dbEncryptionPassword(ctx *cli.Context, confirm bool) ([]byte, error) {
+	if ctx.IsSet("keyfile") {
+		keyFile := cleanAndExpandPath(ctx.String("keyfile"))
+		password, err := ioutil.ReadFile(keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read key file: %v",
+				err)
+		}
+		if len(password) == 0 {
+			return nil, fmt.Errorf("key file %v is empty", keyFile)
+		}
+		return password, nil
+	}
+
+	fmt.Printf("Input wallet password: ")
+	password, err := readPassword()
+	if err != nil {
+		return nil, err
+	}
+	fmt.Println()
+
+	if !confirm {
+		return password, nil
+	}
+
+	fmt.Printf("Confirm wallet password: ")
+	confirmPassword, err := readPassword()
+	if err != nil {
+		return nil, err
+	}
+	fmt.Println()
+
+	if string(password) != string(confirmPassword) {
+		return nil, fmt.Errorf("passwords don't match")
+	}
+
+	return password, nil
+}
@@ -0,0 +1,58 @@
+// +build routerrpc
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/decred/dcrlnd/lnrpc"
+	"github.com/decred/dcrlnd/lnrpc/routerrpc"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/urfave/cli"
+)
+
+var importGraphCommand = cli.Command{
+	Name:      "importgraph",
+	Category:  "Channels",
+	Usage:     "Import a channel graph into the graph database.",
+	ArgsUsage: "graph-json-file",
+	Description: `
+	Import the nodes, channels and policies of a JSON file, in the format
+	returned by describegraph, directly into the graph database of the node.
+	This allows constructing large synthetic graphs for simulations and
+	benchmarks of path finding. It is only available on simnet and regnet.`,
+	Action: actionDecorator(importGraph),
+}
+
+func importGraph(ctx *cli.Context) error {
+	args := ctx.Args()
+	if !args.Present() {
+		return cli.ShowCommandHelp(ctx, "importgraph")
+	}
+
+	jsonGraph, err := ioutil.ReadFile(cleanAndExpandPath(args.First()))
+	if err != nil {
+		return fmt.Errorf("unable to read graph file: %v", err)
+	}
+
+	graph := &lnrpc.ChannelGraph{}
+	err = jsonpb.UnmarshalString(string(jsonGraph), graph)
+	if err != nil {
+		return fmt.Errorf("unable to parse graph file: %v", err)
+	}
+
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+
+	resp, err := client.XImportGraph(context.Background(), graph)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -0,0 +1,99 @@
+// +build routerrpc
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/decred/dcrlnd/lnrpc/routerrpc"
+	"github.com/decred/dcrlnd/routing/route"
+	"github.com/urfave/cli"
+)
+
+var queryPaymentMetricsCommand = cli.Command{
+	Name:     "querypaymentmetrics",
+	Category: "Payments",
+	Usage:    "Query the success rates and latencies of recent payments.",
+	Description: `
+	Query the success rates and attempt latencies of the recent payments,
+	aggregated per destination. If no destinations are given, the metrics
+	of all destinations with recent payments are returned.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "dests",
+			Usage: "comma separated hex pubkeys of the destinations",
+		},
+	},
+	Action: actionDecorator(queryPaymentMetrics),
+}
+
+func queryPaymentMetrics(ctx *cli.Context) error {
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+
+	req := &routerrpc.QueryPaymentMetricsRequest{}
+	if ctx.IsSet("dests") {
+		for _, k := range strings.Split(ctx.String("dests"), ",") {
+			dest, err := route.NewVertexFromStr(k)
+			if err != nil {
+				return fmt.Errorf("error parsing %v: %v", k, err)
+			}
+			req.Destinations = append(req.Destinations, dest[:])
+		}
+	}
+
+	resp, err := client.QueryPaymentMetrics(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	type displayMetrics struct {
+		Destination            string  `json:"destination"`
+		NumPayments            uint32  `json:"num_payments"`
+		NumPaymentsSucceeded   uint32  `json:"num_payments_succeeded"`
+		PaymentSuccessRate     float32 `json:"payment_success_rate"`
+		NumAttempts            uint32  `json:"num_attempts"`
+		NumAttemptsSucceeded   uint32  `json:"num_attempts_succeeded"`
+		AttemptSuccessRate     float32 `json:"attempt_success_rate"`
+		AvgAttemptLatencyMs    int64   `json:"avg_attempt_latency_ms"`
+		MedianAttemptLatencyMs int64   `json:"median_attempt_latency_ms"`
+		MaxAttemptLatencyMs    int64   `json:"max_attempt_latency_ms"`
+	}
+
+	displayResp := struct {
+		Destinations []displayMetrics `json:"destinations"`
+	}{
+		Destinations: make([]displayMetrics, 0, len(resp.Destinations)),
+	}
+
+	for _, m := range resp.Destinations {
+		dest, err := route.NewVertexFromBytes(m.Destination)
+		if err != nil {
+			return err
+		}
+
+		displayResp.Destinations = append(
+			displayResp.Destinations,
+			displayMetrics{
+				Destination:            dest.String(),
+				NumPayments:            m.NumPayments,
+				NumPaymentsSucceeded:   m.NumPaymentsSucceeded,
+				PaymentSuccessRate:     m.PaymentSuccessRate,
+				NumAttempts:            m.NumAttempts,
+				NumAttemptsSucceeded:   m.NumAttemptsSucceeded,
+				AttemptSuccessRate:     m.AttemptSuccessRate,
+				AvgAttemptLatencyMs:    m.AvgAttemptLatencyMs,
+				MedianAttemptLatencyMs: m.MedianAttemptLatencyMs,
+				MaxAttemptLatencyMs:    m.MaxAttemptLatencyMs,
+			},
+		)
+	}
+
+	printJSON(displayResp)
+
+	return nil
+}
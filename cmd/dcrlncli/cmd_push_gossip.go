@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/decred/dcrlnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var pushGossipCommand = cli.Command{
+	Name:      "pushgossip",
+	Category:  "Peers",
+	Usage:     "Send our gossip announcements to a connected peer.",
+	ArgsUsage: "peer",
+	Description: `
+	Send our node announcement and the announcements and latest channel
+	updates of our channels directly to a connected peer, without waiting
+	for them to propagate through the gossip network. This is useful when a
+	peer is missing our announcements, such as after a restore or when it
+	reports stale policies of our channels.
+
+	Either --all_channels or one or more --chan_id must be given to send
+	the announcements of our channels.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "peer",
+			Usage: "the public key of the peer to send gossip to",
+		},
+		cli.BoolFlag{
+			Name:  "node_ann",
+			Usage: "send our node announcement",
+		},
+		cli.Int64SliceFlag{
+			Name: "chan_id",
+			Usage: "the short channel id of a channel of ours to " +
+				"announce, can be specified multiple times",
+		},
+		cli.BoolFlag{
+			Name:  "all_channels",
+			Usage: "announce all our open channels",
+		},
+	},
+	Action: actionDecorator(pushGossip),
+}
+
+func pushGossip(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// Show command help if no arguments and flags were provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		return cli.ShowCommandHelp(ctx, "pushgossip")
+	}
+
+	var peerStr string
+	switch {
+	case ctx.IsSet("peer"):
+		peerStr = ctx.String("peer")
+	case ctx.Args().Present():
+		peerStr = ctx.Args().First()
+	default:
+		return fmt.Errorf("peer argument missing")
+	}
+	peer, err := hex.DecodeString(peerStr)
+	if err != nil {
+		return fmt.Errorf("unable to decode peer pubkey: %v", err)
+	}
+
+	req := &lnrpc.PushGossipRequest{
+		Peer:             peer,
+		NodeAnnouncement: ctx.Bool("node_ann"),
+		AllChannels:      ctx.Bool("all_channels"),
+	}
+	for _, chanID := range ctx.Int64Slice("chan_id") {
+		req.ChanIds = append(req.ChanIds, uint64(chanID))
+	}
+
+	if !req.NodeAnnouncement && !req.AllChannels && len(req.ChanIds) == 0 {
+		return fmt.Errorf("nothing to send, use --node_ann, " +
+			"--chan_id or --all_channels")
+	}
+
+	resp, err := client.PushGossip(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -4,6 +4,7 @@ package main
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/decred/dcrlnd/lnrpc/routerrpc"
 
@@ -14,7 +15,19 @@ var resetMissionControlCommand = cli.Command{
 	Name:     "resetmc",
 	Category: "Payments",
 	Usage:    "Reset internal mission control state.",
-	Action:   actionDecorator(resetMissionControl),
+	Description: `
+	Reset the internal mission control state, as if no payment attempts have
+	been made. If --older_than is set, only the results of the payment
+	attempts that completed longer ago are removed, and the state is
+	rederived from the remaining results.`,
+	Flags: []cli.Flag{
+		cli.DurationFlag{
+			Name: "older_than",
+			Usage: "(optional) only remove the results older " +
+				"than this duration, e.g. 24h",
+		},
+	},
+	Action: actionDecorator(resetMissionControl),
 }
 
 func resetMissionControl(ctx *cli.Context) error {
@@ -23,7 +36,14 @@ func resetMissionControl(ctx *cli.Context) error {
 
 	client := routerrpc.NewRouterClient(conn)
 
-	req := &routerrpc.ResetMissionControlRequest{}
+	olderThan := ctx.Duration("older_than")
+	if olderThan < 0 {
+		return fmt.Errorf("older_than must not be negative")
+	}
+
+	req := &routerrpc.ResetMissionControlRequest{
+		OlderThanSeconds: uint64(olderThan.Seconds()),
+	}
 	rpcCtx := context.Background()
 	_, err := client.ResetMissionControl(rpcCtx, req)
 	return err
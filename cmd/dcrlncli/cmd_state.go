@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"io"
+
+	"github.com/decred/dcrlnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var stateCommand = cli.Command{
+	Name:     "state",
+	Category: "Startup",
+	Usage:    "Get the lifecycle state of dcrlnd.",
+	Description: `
+	Print the lifecycle state of dcrlnd, which is one of WAITING_TO_START
+	while waiting for the wallet to be created or unlocked, UNLOCKED while
+	starting up once unlocked, ACTIVE once the main RPC server is active and
+	SERVER_ACTIVE once all RPCs are usable. No macaroon is required.
+
+	If --subscribe is set, the state is printed again each time it changes,
+	until the command is interrupted or the subscription ends. The
+	subscription made before the wallet is unlocked ends once it's unlocked.`,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "subscribe",
+			Usage: "print the state each time it changes",
+		},
+	},
+	Action: actionDecorator(getState),
+}
+
+func getState(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getStateServiceClient(ctx)
+	defer cleanUp()
+
+	if !ctx.Bool("subscribe") {
+		resp, err := client.GetState(ctxb, &lnrpc.GetStateRequest{})
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(resp)
+		return nil
+	}
+
+	stream, err := client.SubscribeState(
+		ctxb, &lnrpc.SubscribeStateRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(resp)
+	}
+}
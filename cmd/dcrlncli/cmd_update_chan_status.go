@@ -0,0 +1,91 @@
+// +build routerrpc
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/decred/dcrlnd/lnrpc/routerrpc"
+
+	"github.com/urfave/cli"
+)
+
+var updateChanStatusCommand = cli.Command{
+	Name:      "updatechanstatus",
+	Category:  "Channels",
+	Usage:     "Set the status of a channel in our channel updates.",
+	ArgsUsage: "funding_txid [output_index]",
+	Description: `
+	Manually set the status of a channel in the channel updates sent to the
+	network, e.g. to disable a channel ahead of a planned maintenance of its
+	peer without closing it.
+
+	An enabled channel stays enabled even if it becomes inactive, and a
+	disabled channel stays disabled even once its peer reconnects, until the
+	status is given back to the automatic handling with --action=auto.
+
+	To view which funding_txids/output_indexes can be used for this command,
+	see the channel_point values within the listchannels command output.
+	The format for a channel_point is 'funding_txid:output_index'.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "funding_txid",
+			Usage: "the txid of the channel's funding transaction",
+		},
+		cli.IntFlag{
+			Name: "output_index",
+			Usage: "the output index for the funding output of the funding " +
+				"transaction",
+		},
+		cli.StringFlag{
+			Name: "action",
+			Usage: "the status to set the channel to, one of " +
+				"'enable', 'disable' or 'auto'",
+		},
+	},
+	Action: actionDecorator(updateChanStatus),
+}
+
+func updateChanStatus(ctx *cli.Context) error {
+	// Show command help if no arguments provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		cli.ShowCommandHelp(ctx, "updatechanstatus")
+		return nil
+	}
+
+	chanPoint, err := parseChannelPoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	var action routerrpc.ChanStatusAction
+	switch ctx.String("action") {
+	case "enable":
+		action = routerrpc.ChanStatusAction_ENABLE
+	case "disable":
+		action = routerrpc.ChanStatusAction_DISABLE
+	case "auto":
+		action = routerrpc.ChanStatusAction_AUTO
+	default:
+		return fmt.Errorf("action must be one of 'enable', 'disable' " +
+			"or 'auto'")
+	}
+
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+
+	req := &routerrpc.UpdateChanStatusRequest{
+		ChanPoint: chanPoint,
+		Action:    action,
+	}
+	resp, err := client.UpdateChanStatus(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"crypto/rand"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -22,6 +23,8 @@ import (
 	"github.com/decred/dcrd/wire"
 	"github.com/decred/dcrlnd/lnrpc"
 	"github.com/decred/dcrlnd/lnrpc/routerrpc"
+	"github.com/decred/dcrlnd/lntypes"
+	"github.com/decred/dcrlnd/record"
 	"github.com/decred/dcrlnd/walletunlocker"
 	"github.com/golang/protobuf/jsonpb"
 	"github.com/golang/protobuf/proto"
@@ -201,6 +204,10 @@ var sendCoinsCommand = cli.Command{
 	Fees used when sending the transaction can be specified via the --conf_target, or
 	--atoms_per_byte optional flags.
 
+	The coins spent can be restricted with --min_confs, --spend_unconfirmed
+	and --utxo. When --utxo is set, all the listed outpoints are spent, the
+	excess being returned as change unless --sweepall is set.
+
 	Positional arguments and flags can be used interchangeably but not at the same time!
 	`,
 	Flags: []cli.Flag{
@@ -231,10 +238,48 @@ var sendCoinsCommand = cli.Command{
 				"atoms/byte that should be used when crafting " +
 				"the transaction",
 		},
+		cli.Int64Flag{
+			Name: "min_confs",
+			Usage: "(optional) the minimum number of " +
+				"confirmations of the coins to spend " +
+				"(default: 1)",
+		},
+		cli.BoolFlag{
+			Name: "spend_unconfirmed",
+			Usage: "(optional) allow unconfirmed coins to be " +
+				"spent, overriding min_confs",
+		},
+		cli.StringSliceFlag{
+			Name: "utxo",
+			Usage: "(optional) an outpoint of the wallet in the " +
+				"form txid:index to spend, can be repeated to " +
+				"restrict the spent coins to these outpoints",
+		},
+		cli.StringFlag{
+			Name: "label",
+			Usage: "(optional) a label for the transaction, " +
+				"shown by listchaintxns",
+		},
 	},
 	Action: actionDecorator(sendCoins),
 }
 
+// parseUtxoFlags parses the outpoints passed through the --utxo flag.
+func parseUtxoFlags(ctx *cli.Context) ([]*lnrpc.OutPoint, error) {
+	var outpoints []*lnrpc.OutPoint
+	for _, utxo := range ctx.StringSlice("utxo") {
+		outpoint, err := NewProtoOutPoint(utxo)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode utxo %v: %v",
+				utxo, err)
+		}
+
+		outpoints = append(outpoints, outpoint)
+	}
+
+	return outpoints, nil
+}
+
 func sendCoins(ctx *cli.Context) error {
 	var (
 		addr string
@@ -280,16 +325,25 @@ func sendCoins(ctx *cli.Context) error {
 			"sweep all coins out of the wallet")
 	}
 
+	outpoints, err := parseUtxoFlags(ctx)
+	if err != nil {
+		return err
+	}
+
 	ctxb := context.Background()
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
 	req := &lnrpc.SendCoinsRequest{
-		Addr:         addr,
-		Amount:       amt,
-		TargetConf:   int32(ctx.Int64("conf_target")),
-		AtomsPerByte: ctx.Int64("atoms_per_byte"),
-		SendAll:      ctx.Bool("sweepall"),
+		Addr:             addr,
+		Amount:           amt,
+		TargetConf:       int32(ctx.Int64("conf_target")),
+		AtomsPerByte:     ctx.Int64("atoms_per_byte"),
+		SendAll:          ctx.Bool("sweepall"),
+		MinConfs:         int32(ctx.Int64("min_confs")),
+		SpendUnconfirmed: ctx.Bool("spend_unconfirmed"),
+		Outpoints:        outpoints,
+		Label:            ctx.String("label"),
 	}
 	txid, err := client.SendCoins(ctxb, req)
 	if err != nil {
@@ -433,6 +487,10 @@ var sendManyCommand = cli.Command{
 	respectively in the following format:
 
 	    '{"ExampleAddr": NumCoinsInAtoms, "SecondAddr": NumCoins}'
+
+	The coins spent can be restricted with --min_confs, --spend_unconfirmed
+	and --utxo. When --utxo is set, all the listed outpoints are spent, the
+	excess being returned as change.
 	`,
 	Flags: []cli.Flag{
 		cli.Int64Flag{
@@ -445,6 +503,28 @@ var sendManyCommand = cli.Command{
 			Usage: "(optional) a manual fee expressed in atom/byte that should be " +
 				"used when crafting the transaction",
 		},
+		cli.Int64Flag{
+			Name: "min_confs",
+			Usage: "(optional) the minimum number of " +
+				"confirmations of the coins to spend " +
+				"(default: 1)",
+		},
+		cli.BoolFlag{
+			Name: "spend_unconfirmed",
+			Usage: "(optional) allow unconfirmed coins to be " +
+				"spent, overriding min_confs",
+		},
+		cli.StringSliceFlag{
+			Name: "utxo",
+			Usage: "(optional) an outpoint of the wallet in the " +
+				"form txid:index to spend, can be repeated to " +
+				"restrict the spent coins to these outpoints",
+		},
+		cli.StringFlag{
+			Name: "label",
+			Usage: "(optional) a label for the transaction, " +
+				"shown by listchaintxns",
+		},
 	},
 	Action: actionDecorator(sendMany),
 }
@@ -462,14 +542,23 @@ func sendMany(ctx *cli.Context) error {
 			"set, but not both")
 	}
 
+	outpoints, err := parseUtxoFlags(ctx)
+	if err != nil {
+		return err
+	}
+
 	ctxb := context.Background()
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
 	txid, err := client.SendMany(ctxb, &lnrpc.SendManyRequest{
-		AddrToAmount: amountToAddr,
-		TargetConf:   int32(ctx.Int64("conf_target")),
-		AtomsPerByte: ctx.Int64("atoms_per_byte"),
+		AddrToAmount:     amountToAddr,
+		TargetConf:       int32(ctx.Int64("conf_target")),
+		AtomsPerByte:     ctx.Int64("atoms_per_byte"),
+		MinConfs:         int32(ctx.Int64("min_confs")),
+		SpendUnconfirmed: ctx.Bool("spend_unconfirmed"),
+		Outpoints:        outpoints,
+		Label:            ctx.String("label"),
 	})
 	if err != nil {
 		return err
@@ -568,6 +657,103 @@ func disconnectPeer(ctx *cli.Context) error {
 	return nil
 }
 
+var setPeerPolicyCommand = cli.Command{
+	Name:     "setpeerpolicy",
+	Category: "Peers",
+	Usage:    "Set the connection policy of a peer.",
+	Description: `
+	Set the persisted connection policy of a peer, which determines whether
+	the connection to the peer is re-established once lost. The reconnect
+	policy is one of:
+
+	   - default: only reconnect if there are channels with the peer or it
+	     was connected to as a permanent peer
+	   - always: always reconnect to the peer
+	   - never: never reconnect to the peer
+
+	The backoff between reconnection attempts can optionally be bounded per
+	peer, which can be useful for peers only reachable through Tor. Setting
+	the default policy without custom backoffs removes the peer's policy.
+	`,
+	ArgsUsage: "<pubkey> <reconnect>",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "node_key",
+			Usage: "The hex-encoded compressed public key of the " +
+				"peer",
+		},
+		cli.StringFlag{
+			Name:  "reconnect",
+			Usage: "The reconnect policy: default, always or never",
+			Value: "default",
+		},
+		cli.DurationFlag{
+			Name: "min_backoff",
+			Usage: "The shortest backoff between reconnection " +
+				"attempts; if unset, the node's minimum backoff " +
+				"is used",
+		},
+		cli.DurationFlag{
+			Name: "max_backoff",
+			Usage: "The longest backoff between reconnection " +
+				"attempts; if unset, the node's maximum backoff " +
+				"is used",
+		},
+	},
+	Action: actionDecorator(setPeerPolicy),
+}
+
+func setPeerPolicy(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	args := ctx.Args()
+
+	var pubKey string
+	switch {
+	case ctx.IsSet("node_key"):
+		pubKey = ctx.String("node_key")
+	case args.Present():
+		pubKey = args.First()
+		args = args.Tail()
+	default:
+		return fmt.Errorf("must specify target public key")
+	}
+
+	reconnect := ctx.String("reconnect")
+	if !ctx.IsSet("reconnect") && args.Present() {
+		reconnect = args.First()
+	}
+
+	var policy lnrpc.SetPeerConnPolicyRequest_ReconnectPolicy
+	switch reconnect {
+	case "default":
+		policy = lnrpc.SetPeerConnPolicyRequest_DEFAULT
+	case "always":
+		policy = lnrpc.SetPeerConnPolicyRequest_ALWAYS
+	case "never":
+		policy = lnrpc.SetPeerConnPolicyRequest_NEVER
+	default:
+		return fmt.Errorf("unknown reconnect policy %q", reconnect)
+	}
+
+	req := &lnrpc.SetPeerConnPolicyRequest{
+		PubKey:         pubKey,
+		Reconnect:      policy,
+		MinBackoffSecs: uint32(ctx.Duration("min_backoff").Seconds()),
+		MaxBackoffSecs: uint32(ctx.Duration("max_backoff").Seconds()),
+	}
+
+	resp, err := client.SetPeerConnPolicy(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 // TODO(roasbeef): change default number of confirmations
 var openChannelCommand = cli.Command{
 	Name:     "openchannel",
@@ -655,6 +841,20 @@ var openChannelCommand = cli.Command{
 				"transaction must satisfy",
 			Value: 1,
 		},
+		cli.StringFlag{
+			Name: "close_address",
+			Usage: "(optional) an address to enforce payout of our " +
+				"funds to on cooperative close. Note that if this " +
+				"value is set on channel open, you will *not* be " +
+				"able to cooperatively close to a different address.",
+		},
+		cli.BoolFlag{
+			Name: "zero_conf",
+			Usage: "(optional) make the channel usable before its " +
+				"funding transaction confirms. The peer must " +
+				"support zero-conf channels and trust us to " +
+				"open them",
+		},
 	},
 	Action: actionDecorator(openChannel),
 }
@@ -682,6 +882,8 @@ func openChannel(ctx *cli.Context) error {
 		RemoteCsvDelay:   uint32(ctx.Uint64("remote_csv_delay")),
 		MinConfs:         int32(ctx.Uint64("min_confs")),
 		SpendUnconfirmed: minConfs == 0,
+		CloseAddress:     ctx.String("close_address"),
+		ZeroConf:         ctx.Bool("zero_conf"),
 	}
 
 	switch {
@@ -817,6 +1019,125 @@ func openChannel(ctx *cli.Context) error {
 	}
 }
 
+var batchOpenChannelCommand = cli.Command{
+	Name:     "batchopenchannel",
+	Category: "Channels",
+	Usage: "Open multiple channels to existing peers in a single " +
+		"transaction.",
+	Description: `
+	Attempt to open multiple new channels to existing peers, funding all of
+	them with a single on-chain transaction. The funding transaction is only
+	broadcast once all channels of the batch have been negotiated. If any of
+	the channels fails, the whole batch is aborted.
+
+	The channels to open are passed as a JSON list:
+
+	    '[{"node_pubkey": "<hex>", "local_amt": <atoms>, "push_amt": <atoms>,
+	      "private": <bool>, "min_htlc_m_atoms": <m_atoms>,
+	      "remote_csv_delay": <blocks>}, ...]'
+
+	Only node_pubkey and local_amt are required for each channel.
+
+	One can manually set the fee to be used for the funding transaction via either
+	the --conf_target or --atoms_per_byte arguments. This is optional.`,
+	ArgsUsage: "channels-json",
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "conf_target",
+			Usage: "(optional) the number of blocks that the " +
+				"transaction *should* confirm in, will be " +
+				"used for fee estimation",
+		},
+		cli.Int64Flag{
+			Name: "atoms_per_byte",
+			Usage: "(optional) a manual fee expressed in " +
+				"atom/byte that should be used when crafting " +
+				"the transaction",
+		},
+		cli.Uint64Flag{
+			Name: "min_confs",
+			Usage: "(optional) the minimum number of confirmations " +
+				"each one of your outputs used for the funding " +
+				"transaction must satisfy",
+			Value: 1,
+		},
+	},
+	Action: actionDecorator(batchOpenChannel),
+}
+
+func batchOpenChannel(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "batchopenchannel")
+	}
+
+	var channels []struct {
+		NodePubkey     string `json:"node_pubkey"`
+		LocalAmt       int64  `json:"local_amt"`
+		PushAmt        int64  `json:"push_amt"`
+		Private        bool   `json:"private"`
+		MinHtlcMAtoms  int64  `json:"min_htlc_m_atoms"`
+		RemoteCsvDelay uint32 `json:"remote_csv_delay"`
+	}
+	err := json.Unmarshal([]byte(ctx.Args().First()), &channels)
+	if err != nil {
+		return fmt.Errorf("unable to decode channels: %v", err)
+	}
+
+	minConfs := int32(ctx.Uint64("min_confs"))
+	req := &lnrpc.BatchOpenChannelRequest{
+		TargetConf:       int32(ctx.Int64("conf_target")),
+		AtomsPerByte:     ctx.Int64("atoms_per_byte"),
+		MinConfs:         minConfs,
+		SpendUnconfirmed: minConfs == 0,
+	}
+	for _, channel := range channels {
+		nodePubHex, err := hex.DecodeString(channel.NodePubkey)
+		if err != nil {
+			return fmt.Errorf("unable to decode node public key: %v",
+				err)
+		}
+
+		req.Channels = append(req.Channels, &lnrpc.BatchOpenChannel{
+			NodePubkey:         nodePubHex,
+			LocalFundingAmount: channel.LocalAmt,
+			PushAtoms:          channel.PushAmt,
+			Private:            channel.Private,
+			MinHtlcMAtoms:      channel.MinHtlcMAtoms,
+			RemoteCsvDelay:     channel.RemoteCsvDelay,
+		})
+	}
+
+	resp, err := client.BatchOpenChannel(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	channelPoints := make([]string, 0, len(resp.PendingChannels))
+	for _, pending := range resp.PendingChannels {
+		txid, err := chainhash.NewHash(pending.Txid)
+		if err != nil {
+			return err
+		}
+
+		channelPoints = append(
+			channelPoints,
+			fmt.Sprintf("%v:%v", txid, pending.OutputIndex),
+		)
+	}
+
+	printJSON(struct {
+		ChannelPoints []string `json:"channel_points"`
+	}{
+		ChannelPoints: channelPoints,
+	})
+
+	return nil
+}
+
 // TODO(roasbeef): also allow short relative channel ID.
 
 var closeChannelCommand = cli.Command{
@@ -834,7 +1155,17 @@ var closeChannelCommand = cli.Command{
 	In the case of a cooperative closure, One can manually set the fee to
 	be used for the closing transaction via either the --conf_target or
 	--atoms_per_byte arguments. This will be the starting value used during
-	fee negotiation. This is optional.
+	fee negotiation. This is optional. The --max_atoms_per_byte argument
+	can additionally be used to cap the fee rate we're willing to pay if
+	we're the initiator of the channel.
+
+	In the case of a unilateral closure of a channel with anchors, the same
+	arguments set the fee of the sweep of our anchor output, which bumps the
+	fee of the commitment transaction through CPFP.
+
+	In the case of a cooperative closure, the funds can also be sent to a
+	specific address via the --delivery_addr argument, rather than to a
+	fresh address from the wallet.
 
 	To view which funding_txids/output_indexes can be used for a channel close,
 	see the channel_point values within the listchannels command output.
@@ -870,6 +1201,17 @@ var closeChannelCommand = cli.Command{
 				"atom/byte that should be used when crafting " +
 				"the transaction",
 		},
+		cli.Int64Flag{
+			Name: "max_atoms_per_byte",
+			Usage: "(optional) the maximum fee expressed in " +
+				"atom/byte that we're willing to pay for the " +
+				"closing transaction during fee negotiation",
+		},
+		cli.StringFlag{
+			Name: "delivery_addr",
+			Usage: "(optional) an address to deliver funds " +
+				"upon cooperative channel closing",
+		},
 	},
 	Action: actionDecorator(closeChannel),
 }
@@ -891,10 +1233,12 @@ func closeChannel(ctx *cli.Context) error {
 
 	// TODO(roasbeef): implement time deadline within server
 	req := &lnrpc.CloseChannelRequest{
-		ChannelPoint: channelPoint,
-		Force:        ctx.Bool("force"),
-		TargetConf:   int32(ctx.Int64("conf_target")),
-		AtomsPerByte: ctx.Int64("atoms_per_byte"),
+		ChannelPoint:    channelPoint,
+		Force:           ctx.Bool("force"),
+		TargetConf:      int32(ctx.Int64("conf_target")),
+		AtomsPerByte:    ctx.Int64("atoms_per_byte"),
+		MaxAtomsPerByte: ctx.Int64("max_atoms_per_byte"),
+		DeliveryAddress: ctx.String("delivery_addr"),
 	}
 
 	// After parsing the request, we'll spin up a goroutine that will
@@ -1205,10 +1549,15 @@ var abandonChannelCommand = cli.Command{
 	Usage:    "Abandons an existing channel.",
 	Description: `
 	Removes all channel state from the database except for a close
-	summary. This method can be used to get rid of permanently unusable
-	channels due to bugs fixed in newer versions of dcrlnd.
+	summary, without any on-chain action. This method can be used to get
+	rid of permanently unusable channels due to bugs fixed in newer
+	versions of dcrlnd, or of pending channels whose funding transaction
+	will never confirm. The funding transaction of a pending channel we
+	initiated is removed from the wallet, returning the outputs it spends.
 
-	Only available when dcrlnd is built in debug mode.
+	Only available when dcrlnd is built in debug mode, unless the
+	--i_know_what_i_am_doing flag is set. Abandoning an active channel may
+	lead to a loss of funds.
 
 	To view which funding_txids/output_indexes can be used for this command,
 	see the channel_point values within the listchannels command output.
@@ -1224,6 +1573,12 @@ var abandonChannelCommand = cli.Command{
 			Usage: "the output index for the funding output of the funding " +
 				"transaction",
 		},
+		cli.BoolFlag{
+			Name: "i_know_what_i_am_doing",
+			Usage: "confirm that the funding transaction of the " +
+				"channel will never confirm, overriding the " +
+				"requirement of a debug build",
+		},
 	},
 	Action: actionDecorator(abandonChannel),
 }
@@ -1246,7 +1601,8 @@ func abandonChannel(ctx *cli.Context) error {
 	}
 
 	req := &lnrpc.AbandonChannelRequest{
-		ChannelPoint: channelPoint,
+		ChannelPoint:      channelPoint,
+		IKnowWhatIAmDoing: ctx.Bool("i_know_what_i_am_doing"),
 	}
 
 	resp, err := client.AbandonChannel(ctxb, req)
@@ -1258,6 +1614,149 @@ func abandonChannel(ctx *cli.Context) error {
 	return nil
 }
 
+var bumpFundingFeeCommand = cli.Command{
+	Name:     "bumpfundingfee",
+	Category: "Channels",
+	Usage: "Bump the fee of the funding transaction of a pending " +
+		"channel.",
+	Description: `
+	Bump the fee of the unconfirmed funding transaction of a pending channel
+	we opened, for example because it's stuck below the fee rate required to
+	be relayed. The fee is bumped by sweeping the change of the funding
+	transaction back to the wallet with a higher fee rate
+	(Child-Pays-For-Parent), so this is only possible if the funding
+	transaction has an output controlled by the wallet.
+
+	The new fee rate can be set via either the --conf_target or
+	--atoms_per_byte arguments.
+
+	To view which funding_txids/output_indexes can be used for this command,
+	see the channel_point values within the pendingchannels command output.
+	The format for a channel_point is 'funding_txid:output_index'.`,
+	ArgsUsage: "funding_txid [output_index]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "funding_txid",
+			Usage: "the txid of the channel's funding transaction",
+		},
+		cli.IntFlag{
+			Name: "output_index",
+			Usage: "the output index for the funding output of the funding " +
+				"transaction",
+		},
+		cli.Int64Flag{
+			Name: "conf_target",
+			Usage: "the number of blocks that the funding " +
+				"transaction *should* confirm in, will be " +
+				"used for fee estimation",
+		},
+		cli.Int64Flag{
+			Name: "atoms_per_byte",
+			Usage: "a manual fee expressed in atom/byte that " +
+				"should be used when sweeping the output of " +
+				"the funding transaction",
+		},
+	},
+	Action: actionDecorator(bumpFundingFee),
+}
+
+func bumpFundingFee(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// Show command help if no arguments and flags were provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		cli.ShowCommandHelp(ctx, "bumpfundingfee")
+		return nil
+	}
+
+	channelPoint, err := parseChannelPoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := &lnrpc.BumpFundingFeeRequest{
+		ChannelPoint: channelPoint,
+		TargetConf:   int32(ctx.Int64("conf_target")),
+		AtomsPerByte: ctx.Int64("atoms_per_byte"),
+	}
+
+	resp, err := client.BumpFundingFee(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var rebroadcastLimboOutputCommand = cli.Command{
+	Name:     "rebroadcastlimbooutput",
+	Category: "Channels",
+	Usage: "Re-broadcast the sweep of an output of a force closed " +
+		"channel.",
+	Description: `
+	Immediately re-broadcast the transaction sweeping an output of a force
+	closed channel whose funds are in limbo, for example because it's stuck
+	below the fee rate required to be relayed.
+
+	An expired htlc output is swept by its pre-signed timeout transaction,
+	which is published again as is since its fee can't be changed. The
+	other outputs whose time lock expired are swept again at the fee rate
+	set via either the --conf_target or --atoms_per_byte arguments.
+
+	To view which outpoints can be used for this command, see the outpoint
+	values of the limbo_outputs within the pendingchannels command output.
+	The format for an outpoint is 'txid:output_index'.`,
+	ArgsUsage: "outpoint",
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "conf_target",
+			Usage: "the number of blocks that the sweep " +
+				"transaction *should* confirm in, will be " +
+				"used for fee estimation",
+		},
+		cli.Int64Flag{
+			Name: "atoms_per_byte",
+			Usage: "a manual fee expressed in atom/byte that " +
+				"should be used when sweeping the output",
+		},
+	},
+	Action: actionDecorator(rebroadcastLimboOutput),
+}
+
+func rebroadcastLimboOutput(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// Show command help if no arguments were provided.
+	if ctx.NArg() != 1 {
+		cli.ShowCommandHelp(ctx, "rebroadcastlimbooutput")
+		return nil
+	}
+
+	outpoint, err := NewProtoOutPoint(ctx.Args().First())
+	if err != nil {
+		return fmt.Errorf("invalid outpoint: %v", err)
+	}
+
+	req := &lnrpc.RebroadcastLimboOutputRequest{
+		Outpoint:     outpoint,
+		TargetConf:   int32(ctx.Int64("conf_target")),
+		AtomsPerByte: ctx.Int64("atoms_per_byte"),
+	}
+
+	resp, err := client.RebroadcastLimboOutput(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 // parseChannelPoint parses a funding txid and output index from the command
 // line. Both named options as well as unnamed parameters are supported.
 func parseChannelPoint(ctx *cli.Context) (*lnrpc.ChannelPoint, error) {
@@ -1279,36 +1778,95 @@ func parseChannelPoint(ctx *cli.Context) (*lnrpc.ChannelPoint, error) {
 		return nil, fmt.Errorf("funding txid argument missing")
 	}
 
-	switch {
-	case ctx.IsSet("output_index"):
-		channelPoint.OutputIndex = uint32(ctx.Int("output_index"))
-	case args.Present():
-		index, err := strconv.ParseUint(args.First(), 10, 32)
+	switch {
+	case ctx.IsSet("output_index"):
+		channelPoint.OutputIndex = uint32(ctx.Int("output_index"))
+	case args.Present():
+		index, err := strconv.ParseUint(args.First(), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode output index: %v", err)
+		}
+		channelPoint.OutputIndex = uint32(index)
+	default:
+		channelPoint.OutputIndex = 0
+	}
+
+	return channelPoint, nil
+}
+
+var listPeersCommand = cli.Command{
+	Name:     "listpeers",
+	Category: "Peers",
+	Usage:    "List all active, currently connected peers.",
+	Action:   actionDecorator(listPeers),
+}
+
+func listPeers(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ListPeersRequest{}
+	resp, err := client.ListPeers(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var subscribePeerEventsCommand = cli.Command{
+	Name:     "subscribepeerevents",
+	Category: "Peers",
+	Usage:    "Subscribe to the events of peers coming online and offline.",
+	Description: `
+	Print the events of peers connecting, completing the exchange of init
+	messages and disconnecting as they happen, until the command is
+	interrupted.`,
+	Action: actionDecorator(subscribePeerEvents),
+}
+
+func subscribePeerEvents(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	stream, err := client.SubscribePeerEvents(
+		ctxb, &lnrpc.PeerEventSubscription{},
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		event, err := stream.Recv()
 		if err != nil {
-			return nil, fmt.Errorf("unable to decode output index: %v", err)
+			return err
 		}
-		channelPoint.OutputIndex = uint32(index)
-	default:
-		channelPoint.OutputIndex = 0
-	}
 
-	return channelPoint, nil
+		printRespJSON(event)
+	}
 }
 
-var listPeersCommand = cli.Command{
-	Name:     "listpeers",
+var listGossipSyncersCommand = cli.Command{
+	Name:     "listgossipsyncers",
 	Category: "Peers",
-	Usage:    "List all active, currently connected peers.",
-	Action:   actionDecorator(listPeers),
+	Usage:    "List the gossip syncer state of all connected peers.",
+	Description: `
+	Lists the type of gossip sync (active, passive or pinned) performed with
+	each connected peer and the state of its syncer, along with the gossip
+	bandwidth budget shared by all peers.`,
+	Action: actionDecorator(listGossipSyncers),
 }
 
-func listPeers(ctx *cli.Context) error {
+func listGossipSyncers(ctx *cli.Context) error {
 	ctxb := context.Background()
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
-	req := &lnrpc.ListPeersRequest{}
-	resp, err := client.ListPeers(ctxb, req)
+	req := &lnrpc.ListGossipSyncersRequest{}
+	resp, err := client.ListGossipSyncers(ctxb, req)
 	if err != nil {
 		return err
 	}
@@ -1317,6 +1875,61 @@ func listPeers(ctx *cli.Context) error {
 	return nil
 }
 
+var graphSyncStatusCommand = cli.Command{
+	Name:     "graphsyncstatus",
+	Category: "Graph",
+	Usage:    "Show the progress of the initial graph sync.",
+	Description: `
+	Shows whether the initial historical sync of the channel graph has
+	completed, when it started and completed, and the number of channels
+	and nodes received while it was in progress.
+
+	If --wait is set, the command blocks until the sync has completed before
+	showing its final status.`,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "wait",
+			Usage: "wait for the initial graph sync to complete",
+		},
+	},
+	Action: actionDecorator(graphSyncStatus),
+}
+
+func graphSyncStatus(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	if !ctx.Bool("wait") {
+		req := &lnrpc.GraphSyncStatusRequest{}
+		resp, err := client.GetGraphSyncStatus(ctxb, req)
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(resp)
+		return nil
+	}
+
+	req := &lnrpc.GraphSyncSubscription{}
+	stream, err := client.SubscribeGraphSyncStatus(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		status, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		if status.Synced {
+			printRespJSON(status)
+			return nil
+		}
+	}
+}
+
 var createCommand = cli.Command{
 	Name:     "create",
 	Category: "Startup",
@@ -1345,6 +1958,11 @@ var createCommand = cli.Command{
 	Channel Backups. Only one of the three parameters will be accepted. See
 	the restorechanbackup command for further details w.r.t the format
 	accepted.
+
+	With --stateless_init, dcrlnd doesn't write any macaroon file to disk.
+	The admin macaroon is instead printed or saved to the file given with
+	--save_to, and it MUST be stored safely as it is the only way to access
+	the daemon.
 	`,
 	Flags: []cli.Flag{
 		cli.StringFlag{
@@ -1361,6 +1979,8 @@ var createCommand = cli.Command{
 			Name:  "multi_file",
 			Usage: "the path to a multi-channel back up file",
 		},
+		statelessInitFlag,
+		saveToFlag,
 	},
 	Action: actionDecorator(create),
 }
@@ -1601,13 +2221,23 @@ mnemonicCheck:
 		AezeedPassphrase:   aezeedPass,
 		RecoveryWindow:     recoveryWindow,
 		ChannelBackups:     chanBackups,
+		StatelessInit:      ctx.Bool(statelessInitFlag.Name),
 	}
-	if _, err := client.InitWallet(ctxb, req); err != nil {
+	resp, err := client.InitWallet(ctxb, req)
+	if err != nil {
 		return err
 	}
 
 	fmt.Println("\ndcrlnd successfully initialized!")
 
+	// In case of a stateless init, the admin macaroon isn't written to
+	// disk by the daemon, so the user must be able to save it.
+	if req.StatelessInit {
+		return printOrSaveAdminMacaroon(
+			resp.AdminMacaroon, ctx.String(saveToFlag.Name),
+		)
+	}
+
 	return nil
 }
 
@@ -1669,6 +2299,9 @@ var unlockCommand = cli.Command{
 	start up. This command MUST be run after booting up dcrlnd before it's
 	able to carry out its duties. An exception is if a user is running with
 	--noseedbackup, then a default passphrase will be used.
+
+	If the wallet was initialized with --stateless_init, the flag should be
+	set again so that dcrlnd doesn't write any macaroon file to disk.
 	`,
 	Flags: []cli.Flag{
 		cli.IntFlag{
@@ -1679,6 +2312,7 @@ var unlockCommand = cli.Command{
 				"maximum number of consecutive, unused " +
 				"addresses ever generated by the wallet.",
 		},
+		statelessInitFlag,
 	},
 	Action: actionDecorator(unlock),
 }
@@ -1715,6 +2349,7 @@ func unlock(ctx *cli.Context) error {
 	req := &lnrpc.UnlockWalletRequest{
 		WalletPassword: pw,
 		RecoveryWindow: recoveryWindow,
+		StatelessInit:  ctx.Bool(statelessInitFlag.Name),
 	}
 	_, err = client.UnlockWallet(ctxb, req)
 	if err != nil {
@@ -1741,7 +2376,27 @@ var changePasswordCommand = cli.Command{
 	--noseedbackup), one must restart their daemon without
 	--noseedbackup and use this command. The "current password" field
 	should be left empty.
+
+	The macaroon database is re-encrypted with the new password, so the
+	existing macaroons remain valid unless --new_mac_root_key is set, in
+	which case the macaroon root key is replaced and all the previously
+	created macaroons are invalidated.
+
+	With --stateless_init, the macaroon files are removed from disk and the
+	new admin macaroon is printed or saved to the file given with
+	--save_to instead. Combined with --new_mac_root_key, it MUST be stored
+	safely as it is the only way to access the daemon.
 	`,
+	Flags: []cli.Flag{
+		statelessInitFlag,
+		saveToFlag,
+		cli.BoolFlag{
+			Name: "new_mac_root_key",
+			Usage: "rotate the macaroon root key resulting in " +
+				"all previously created macaroons to be " +
+				"invalidated",
+		},
+	},
 	Action: actionDecorator(changePassword),
 }
 
@@ -1776,15 +2431,58 @@ func changePassword(ctx *cli.Context) error {
 	}
 
 	req := &lnrpc.ChangePasswordRequest{
-		CurrentPassword: currentPw,
-		NewPassword:     newPw,
+		CurrentPassword:    currentPw,
+		NewPassword:        newPw,
+		StatelessInit:      ctx.Bool(statelessInitFlag.Name),
+		NewMacaroonRootKey: ctx.Bool("new_mac_root_key"),
 	}
 
-	_, err = client.ChangePassword(ctxb, req)
+	resp, err := client.ChangePassword(ctxb, req)
 	if err != nil {
 		return err
 	}
 
+	if req.StatelessInit {
+		return printOrSaveAdminMacaroon(
+			resp.AdminMacaroon, ctx.String(saveToFlag.Name),
+		)
+	}
+
+	return nil
+}
+
+var (
+	// statelessInitFlag is the flag of the startup commands instructing
+	// dcrlnd not to write any macaroon file to disk.
+	statelessInitFlag = cli.BoolFlag{
+		Name: "stateless_init",
+		Usage: "do not create any macaroon files in the file " +
+			"system of the daemon",
+	}
+
+	// saveToFlag is the flag of the startup commands saving the admin
+	// macaroon returned in case of a stateless init to a file.
+	saveToFlag = cli.StringFlag{
+		Name: "save_to",
+		Usage: "save the admin macaroon returned in case of a " +
+			"stateless init to this file rather than printing it",
+	}
+)
+
+// printOrSaveAdminMacaroon prints the hex serialized admin macaroon returned
+// by the wallet unlocker, or saves it to the given file if one is set.
+func printOrSaveAdminMacaroon(macBytes []byte, savePath string) error {
+	if savePath == "" {
+		fmt.Printf("Admin macaroon: %s\n", hex.EncodeToString(macBytes))
+		return nil
+	}
+
+	savePath = cleanAndExpandPath(savePath)
+	if err := ioutil.WriteFile(savePath, macBytes, 0600); err != nil {
+		return err
+	}
+	fmt.Printf("Admin macaroon saved to %s\n", savePath)
+
 	return nil
 }
 
@@ -1926,6 +2624,75 @@ func pendingChannels(ctx *cli.Context) error {
 	return nil
 }
 
+var listArbitratorsCommand = cli.Command{
+	Name:     "listarbitrators",
+	Category: "Channels",
+	Usage: "Display the state of the channel arbitrators and the " +
+		"progress of the outputs claimed on-chain.",
+	Description: `
+	Display the state of the arbitrators watching the channels on-chain,
+	along with the progress of the resolvers claiming the outputs of the
+	force closed channels: what each resolver is waiting for, at which
+	height and the transactions claiming the outputs.
+	`,
+	ArgsUsage: "[chan_point]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "chan_point",
+			Usage: "only display the arbitrator of this channel, " +
+				"in the form of: txid:output_index",
+		},
+	},
+	Action: actionDecorator(listArbitrators),
+}
+
+func listArbitrators(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ListArbitratorsRequest{}
+	switch {
+	case ctx.IsSet("chan_point"):
+		req.ChannelPoint = ctx.String("chan_point")
+	case ctx.Args().Present():
+		req.ChannelPoint = ctx.Args().First()
+	}
+
+	resp, err := client.ListArbitrators(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var pendingChannelLimitsCommand = cli.Command{
+	Name:     "pendingchannellimits",
+	Category: "Channels",
+	Usage: "Display the limits on pending channels and the current " +
+		"number of pending channels.",
+	Action: actionDecorator(pendingChannelLimits),
+}
+
+func pendingChannelLimits(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.PendingChannelLimitsRequest{}
+	resp, err := client.PendingChannelLimits(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
 var listChannelsCommand = cli.Command{
 	Name:     "listchannels",
 	Category: "Channels",
@@ -1947,6 +2714,27 @@ var listChannelsCommand = cli.Command{
 			Name:  "private_only",
 			Usage: "only list channels which are currently private",
 		},
+		cli.BoolFlag{
+			Name:  "initiator_only",
+			Usage: "only list channels which were opened by us",
+		},
+		cli.BoolFlag{
+			Name:  "non_initiator_only",
+			Usage: "only list channels which were opened by the remote peer",
+		},
+		cli.StringFlag{
+			Name: "peer",
+			Usage: "(optional) only list channels with the peer " +
+				"of this hex-encoded pubkey",
+		},
+		cli.Int64Flag{
+			Name:  "min_capacity",
+			Usage: "(optional) only list channels with at least this capacity",
+		},
+		cli.Int64Flag{
+			Name:  "max_capacity",
+			Usage: "(optional) only list channels with at most this capacity",
+		},
 	},
 	Action: actionDecorator(listChannels),
 }
@@ -1956,11 +2744,21 @@ func listChannels(ctx *cli.Context) error {
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
+	peer, err := parsePeerFilter(ctx)
+	if err != nil {
+		return err
+	}
+
 	req := &lnrpc.ListChannelsRequest{
-		ActiveOnly:   ctx.Bool("active_only"),
-		InactiveOnly: ctx.Bool("inactive_only"),
-		PublicOnly:   ctx.Bool("public_only"),
-		PrivateOnly:  ctx.Bool("private_only"),
+		ActiveOnly:       ctx.Bool("active_only"),
+		InactiveOnly:     ctx.Bool("inactive_only"),
+		PublicOnly:       ctx.Bool("public_only"),
+		PrivateOnly:      ctx.Bool("private_only"),
+		InitiatorOnly:    ctx.Bool("initiator_only"),
+		NonInitiatorOnly: ctx.Bool("non_initiator_only"),
+		Peer:             peer,
+		MinCapacity:      ctx.Int64("min_capacity"),
+		MaxCapacity:      ctx.Int64("max_capacity"),
 	}
 
 	resp, err := client.ListChannels(ctxb, req)
@@ -1975,6 +2773,21 @@ func listChannels(ctx *cli.Context) error {
 	return nil
 }
 
+// parsePeerFilter decodes the optional hex-encoded peer pubkey used to filter
+// the listed channels.
+func parsePeerFilter(ctx *cli.Context) ([]byte, error) {
+	if !ctx.IsSet("peer") {
+		return nil, nil
+	}
+
+	peer, err := hex.DecodeString(ctx.String("peer"))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode peer pubkey: %v", err)
+	}
+
+	return peer, nil
+}
+
 var closedChannelsCommand = cli.Command{
 	Name:     "closedchannels",
 	Category: "Channels",
@@ -2009,6 +2822,19 @@ var closedChannelsCommand = cli.Command{
 			Usage: "list channels that were abandoned by " +
 				"the local node",
 		},
+		cli.StringFlag{
+			Name: "peer",
+			Usage: "(optional) only list channels with the peer " +
+				"of this hex-encoded pubkey",
+		},
+		cli.Int64Flag{
+			Name:  "min_capacity",
+			Usage: "(optional) only list channels with at least this capacity",
+		},
+		cli.Int64Flag{
+			Name:  "max_capacity",
+			Usage: "(optional) only list channels with at most this capacity",
+		},
 	},
 	Action: actionDecorator(closedChannels),
 }
@@ -2018,6 +2844,11 @@ func closedChannels(ctx *cli.Context) error {
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
+	peer, err := parsePeerFilter(ctx)
+	if err != nil {
+		return err
+	}
+
 	req := &lnrpc.ClosedChannelsRequest{
 		Cooperative:     ctx.Bool("cooperative"),
 		LocalForce:      ctx.Bool("local_force"),
@@ -2025,6 +2856,9 @@ func closedChannels(ctx *cli.Context) error {
 		Breach:          ctx.Bool("breach"),
 		FundingCanceled: ctx.Bool("funding_canceled"),
 		Abandoned:       ctx.Bool("abandoned"),
+		Peer:            peer,
+		MinCapacity:     ctx.Int64("min_capacity"),
+		MaxCapacity:     ctx.Int64("max_capacity"),
 	}
 
 	resp, err := client.ClosedChannels(ctxb, req)
@@ -2072,6 +2906,22 @@ func paymentFlags() []cli.Flag {
 			Name:  "force, f",
 			Usage: "will skip payment request confirmation",
 		},
+		cli.StringFlag{
+			Name:  "label",
+			Usage: "(optional) a note describing the payment",
+		},
+		cli.StringFlag{
+			Name: "reference",
+			Usage: "(optional) a reference linking the payment to " +
+				"its purpose, such as an order id",
+		},
+		cli.StringFlag{
+			Name: "unsynced_graph",
+			Usage: "(optional) what happens to the payment if the " +
+				"graph isn't synced yet: 'send' it anyway, " +
+				"'refuse' it, or 'queue' it until the graph is " +
+				"synced (default: as configured for the node)",
+		},
 	}
 }
 
@@ -2099,6 +2949,10 @@ var sendPaymentCommand = cli.Command{
 	it'll use the hash of all zeroes. This mode allows one to quickly test
 	payment connectivity without having to create an invoice at the
 	destination.
+
+	The --keysend flag sends a spontaneous payment to a destination that
+	accepts keysend payments. A random preimage is generated and passed to
+	the destination in the onion, so no payment hash is required.
 	`,
 	ArgsUsage: "dest amt payment_hash final_cltv_delta | --pay_req=[payment request]",
 	Flags: append(paymentFlags(),
@@ -2119,6 +2973,10 @@ var sendPaymentCommand = cli.Command{
 			Name:  "debug_send",
 			Usage: "use the debug rHash when sending the HTLC",
 		},
+		cli.BoolFlag{
+			Name:  "keysend",
+			Usage: "send a spontaneous keysend payment",
+		},
 		cli.Int64Flag{
 			Name:  "final_cltv_delta",
 			Usage: "the number of blocks the last hop has to reveal the preimage",
@@ -2226,7 +3084,24 @@ func sendPayment(ctx *cli.Context) error {
 		IgnoreMaxOutboundAmt: ctx.Bool("ignore_max_outbound_amt"),
 	}
 
-	if ctx.Bool("debug_send") && (ctx.IsSet("payment_hash") || args.Present()) {
+	if ctx.Bool("keysend") {
+		if ctx.IsSet("payment_hash") {
+			return errors.New("cannot set payment hash when using " +
+				"keysend")
+		}
+
+		var preimage lntypes.Preimage
+		if _, err := rand.Read(preimage[:]); err != nil {
+			return err
+		}
+
+		req.DestTlv = map[uint64][]byte{
+			uint64(record.KeySendType): preimage[:],
+		}
+		hash := preimage.Hash()
+		req.PaymentHash = hash[:]
+		req.FinalCltvDelta = int32(ctx.Int64("final_cltv_delta"))
+	} else if ctx.Bool("debug_send") && (ctx.IsSet("payment_hash") || args.Present()) {
 		return fmt.Errorf("do not provide a payment hash with debug send")
 	} else if !ctx.Bool("debug_send") {
 		var rHash []byte
@@ -2280,6 +3155,24 @@ func sendPaymentRequest(ctx *cli.Context, req *lnrpc.SendRequest) error {
 
 	req.OutgoingChanId = ctx.Uint64("outgoing_chan_id")
 	req.CltvLimit = uint32(ctx.Int(cltvLimitFlag.Name))
+	req.Label = ctx.String("label")
+	req.Reference = ctx.String("reference")
+
+	switch ctx.String("unsynced_graph") {
+	case "":
+	case "send":
+		req.UnsyncedGraphAction =
+			lnrpc.UnsyncedGraphAction_UNSYNCED_GRAPH_SEND
+	case "refuse":
+		req.UnsyncedGraphAction =
+			lnrpc.UnsyncedGraphAction_UNSYNCED_GRAPH_REFUSE
+	case "queue":
+		req.UnsyncedGraphAction =
+			lnrpc.UnsyncedGraphAction_UNSYNCED_GRAPH_QUEUE
+	default:
+		return fmt.Errorf("invalid unsynced_graph value %v",
+			ctx.String("unsynced_graph"))
+	}
 
 	amt := req.Amt
 
@@ -2350,6 +3243,11 @@ var payInvoiceCommand = cli.Command{
 			Usage: "(optional) number of atoms to fulfill the " +
 				"invoice",
 		},
+		cli.BoolFlag{
+			Name: "amp",
+			Usage: "pay the invoice as an AMP payment, as required " +
+				"by reusable invoices",
+		},
 	),
 	Action: actionDecorator(payInvoice),
 }
@@ -2370,6 +3268,7 @@ func payInvoice(ctx *cli.Context) error {
 	req := &lnrpc.SendRequest{
 		PaymentRequest: payReq,
 		Amt:            ctx.Int64("amt"),
+		Amp:            ctx.Bool("amp"),
 	}
 
 	return sendPaymentRequest(ctx, req)
@@ -2599,12 +3498,28 @@ var addInvoiceCommand = cli.Command{
 				"private channels in order to assist the " +
 				"payer in reaching you",
 		},
+		cli.UintFlag{
+			Name: "max_hop_hints",
+			Usage: "(optional) the maximum number of routing hints " +
+				"for private channels to select, defaults to 20",
+		},
+		cli.StringFlag{
+			Name: "hop_hint_chans",
+			Usage: "(optional) comma separated ids of private " +
+				"channels to always encode as routing hints, " +
+				"even if --private=false",
+		},
 		cli.BoolFlag{
 			Name: "ignore_max_inbound_amt",
 			Usage: "ignore check for available inbound capacity " +
 				"in directly connected channels and create the " +
 				"invoice anyway.",
 		},
+		cli.BoolFlag{
+			Name: "amp",
+			Usage: "create a reusable invoice that can be paid " +
+				"any number of times using AMP payments",
+		},
 	},
 	Action: actionDecorator(addInvoice),
 }
@@ -2655,6 +3570,11 @@ func addInvoice(ctx *cli.Context) error {
 		return fmt.Errorf("unable to parse receipt: %v", err)
 	}
 
+	hopHintChans, err := parseChanIDs(ctx.String("hop_hint_chans"))
+	if err != nil {
+		return fmt.Errorf("unable to parse hop_hint_chans: %v", err)
+	}
+
 	invoice := &lnrpc.Invoice{
 		Memo:                ctx.String("memo"),
 		Receipt:             receipt,
@@ -2664,7 +3584,10 @@ func addInvoice(ctx *cli.Context) error {
 		FallbackAddr:        ctx.String("fallback_addr"),
 		Expiry:              ctx.Int64("expiry"),
 		Private:             ctx.Bool("private"),
+		MaxHopHints:         uint32(ctx.Uint("max_hop_hints")),
+		HopHintChanIds:      hopHintChans,
 		IgnoreMaxInboundAmt: ctx.Bool("ignore_max_inbound_amt"),
+		Amp:                 ctx.Bool("amp"),
 	}
 
 	resp, err := client.AddInvoice(context.Background(), invoice)
@@ -2685,10 +3608,28 @@ func addInvoice(ctx *cli.Context) error {
 	return nil
 }
 
+// parseChanIDs parses a comma separated list of short channel ids.
+func parseChanIDs(s string) ([]uint64, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	var chanIDs []uint64
+	for _, idStr := range strings.Split(s, ",") {
+		chanID, err := strconv.ParseUint(idStr, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		chanIDs = append(chanIDs, chanID)
+	}
+
+	return chanIDs, nil
+}
+
 var lookupInvoiceCommand = cli.Command{
 	Name:      "lookupinvoice",
 	Category:  "Payments",
-	Usage:     "Lookup an existing invoice by its payment hash.",
+	Usage:     "Lookup an existing invoice by its payment hash or address.",
 	ArgsUsage: "rhash",
 	Flags: []cli.Flag{
 		cli.StringFlag{
@@ -2696,6 +3637,12 @@ var lookupInvoiceCommand = cli.Command{
 			Usage: "the 32 byte payment hash of the invoice to query for, the hash " +
 				"should be a hex-encoded string",
 		},
+		cli.StringFlag{
+			Name: "payment_addr",
+			Usage: "the 32 byte payment address of the invoice to " +
+				"query for instead of its payment hash, the " +
+				"address should be a hex-encoded string",
+		},
 	},
 	Action: actionDecorator(lookupInvoice),
 }
@@ -2704,11 +3651,72 @@ func lookupInvoice(ctx *cli.Context) error {
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
+	var (
+		req = &lnrpc.PaymentHash{}
+		err error
+	)
+
+	switch {
+	case ctx.IsSet("payment_addr"):
+		req.PaymentAddr, err = hex.DecodeString(ctx.String("payment_addr"))
+		if err != nil {
+			return fmt.Errorf("unable to decode payment_addr "+
+				"argument: %v", err)
+		}
+	case ctx.IsSet("rhash"):
+		req.RHash, err = hex.DecodeString(ctx.String("rhash"))
+	case ctx.Args().Present():
+		req.RHash, err = hex.DecodeString(ctx.Args().First())
+	default:
+		return fmt.Errorf("rhash argument missing")
+	}
+
+	if err != nil {
+		return fmt.Errorf("unable to decode rhash argument: %v", err)
+	}
+
+	invoice, err := client.LookupInvoice(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(invoice)
+
+	return nil
+}
+
+var invoiceURICommand = cli.Command{
+	Name:      "invoiceuri",
+	Category:  "Payments",
+	Usage:     "Get the payment URIs of an existing invoice.",
+	ArgsUsage: "rhash",
+	Description: `
+	Look up an existing invoice by its payment hash, and print it along with
+	its canonical payment URI, carrying its amount, label and fallback
+	address, and the upper case form of the URI best suited for QR codes.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "rhash",
+			Usage: "the 32 byte payment hash of the invoice, the " +
+				"hash should be a hex-encoded string",
+		},
+		cli.StringFlag{
+			Name: "label",
+			Usage: "the label of the payment URI, defaults to the " +
+				"memo of the invoice",
+		},
+	},
+	Action: actionDecorator(invoiceURI),
+}
+
+func invoiceURI(ctx *cli.Context) error {
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
 	var (
 		rHash []byte
 		err   error
 	)
-
 	switch {
 	case ctx.IsSet("rhash"):
 		rHash, err = hex.DecodeString(ctx.String("rhash"))
@@ -2717,22 +3725,20 @@ func lookupInvoice(ctx *cli.Context) error {
 	default:
 		return fmt.Errorf("rhash argument missing")
 	}
-
 	if err != nil {
 		return fmt.Errorf("unable to decode rhash argument: %v", err)
 	}
 
-	req := &lnrpc.PaymentHash{
+	req := &lnrpc.InvoiceURIRequest{
 		RHash: rHash,
+		Label: ctx.String("label"),
 	}
-
-	invoice, err := client.LookupInvoice(context.Background(), req)
+	resp, err := client.InvoiceURI(context.Background(), req)
 	if err != nil {
 		return err
 	}
 
-	printRespJSON(invoice)
-
+	printRespJSON(resp)
 	return nil
 }
 
@@ -2847,6 +3853,10 @@ var listPaymentsCommand = cli.Command{
 			Name:  "include_incomplete",
 			Usage: "if set to true, payments still in flight (or failed) will be returned as well",
 		},
+		cli.StringFlag{
+			Name:  "reference",
+			Usage: "if set, only the payments linked to this reference will be returned",
+		},
 	},
 	Action: actionDecorator(listPayments),
 }
@@ -2857,6 +3867,7 @@ func listPayments(ctx *cli.Context) error {
 
 	req := &lnrpc.ListPaymentsRequest{
 		IncludeIncomplete: ctx.Bool("include_incomplete"),
+		Reference:         ctx.String("reference"),
 	}
 
 	payments, err := client.ListPayments(context.Background(), req)
@@ -3180,7 +4191,25 @@ var listChainTxnsCommand = cli.Command{
 	Category:    "On-chain",
 	Usage:       "List transactions from the wallet.",
 	Description: "List all transactions an address of the wallet was involved in.",
-	Action:      actionDecorator(listChainTxns),
+	Flags: []cli.Flag{
+		cli.Int64SliceFlag{
+			Name: "account",
+			Usage: "only list the transactions paying to this " +
+				"account of the wallet; can be specified " +
+				"multiple times",
+		},
+		cli.Int64Flag{
+			Name: "min_amount",
+			Usage: "only list the transactions whose absolute " +
+				"net amount is at least this many atoms",
+		},
+		cli.StringFlag{
+			Name: "label",
+			Usage: "only list the transactions whose label " +
+				"contains this string",
+		},
+	},
+	Action: actionDecorator(listChainTxns),
 }
 
 func listChainTxns(ctx *cli.Context) error {
@@ -3188,8 +4217,15 @@ func listChainTxns(ctx *cli.Context) error {
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
-	resp, err := client.GetTransactions(ctxb, &lnrpc.GetTransactionsRequest{})
+	req := &lnrpc.GetTransactionsRequest{
+		MinAmount:   ctx.Int64("min_amount"),
+		LabelFilter: ctx.String("label"),
+	}
+	for _, account := range ctx.Int64Slice("account") {
+		req.Accounts = append(req.Accounts, uint32(account))
+	}
 
+	resp, err := client.GetTransactions(ctxb, req)
 	if err != nil {
 		return err
 	}
@@ -3354,18 +4390,296 @@ func feeReport(ctx *cli.Context) error {
 	return nil
 }
 
+var feeManagerReportCommand = cli.Command{
+	Name:     "feemanagerreport",
+	Category: "Channels",
+	Usage:    "Display the fee rate adjustments of the fee manager.",
+	Description: `
+	Returns the state of the fee manager, which periodically adjusts the fee
+	rates of the channels based on their liquidity and forwarding demand
+	when enabled through the feemanager options, along with its most recent
+	fee rate adjustments.`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "max_decisions",
+			Usage: "the maximum number of the most recent fee " +
+				"rate adjustments to return, all of them if zero",
+			Value: 50,
+		},
+	},
+	Action: actionDecorator(feeManagerReport),
+}
+
+func feeManagerReport(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.FeeManagerReportRequest{
+		MaxDecisions: uint32(ctx.Uint64("max_decisions")),
+	}
+	resp, err := client.FeeManagerReport(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var effectiveFeeRatesCommand = cli.Command{
+	Name:     "effectivefeerates",
+	Category: "On-chain",
+	Usage:    "Display the fee rates used by each subsystem.",
+	Description: `
+	Returns the confirmation target configured through the conftarget
+	options for each of the subsystems paying on-chain fees (funding,
+	cooperative closes, sweeps, anchors and justice transactions), along
+	with the fee rate in atoms/kB it currently resolves to.`,
+	Action: actionDecorator(effectiveFeeRates),
+}
+
+func effectiveFeeRates(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.EffectiveFeeRatesRequest{}
+	resp, err := client.EffectiveFeeRates(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var getDebugInfoCommand = cli.Command{
+	Name:  "getdebuginfo",
+	Usage: "Dump the state of the node for a bug report.",
+	Description: `
+	Returns a single JSON document meant to be attached to bug reports. It
+	holds the active configuration, with passwords and other sensitive
+	options redacted, the number of goroutines and the heap size, the size
+	of each database bucket, and the number of peers, pending channels,
+	in-flight payments, switch circuits and pending sweeps.`,
+	Action: actionDecorator(getDebugInfo),
+}
+
+func getDebugInfo(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.GetDebugInfoRequest{}
+	resp, err := client.GetDebugInfo(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var warpClockCommand = cli.Command{
+	Name:      "warpclock",
+	Usage:     "Fast-forward the clock of the node.",
+	ArgsUsage: "duration",
+	Description: `
+	Moves the clock of the node forward by the given duration, such as 90m
+	or 24h, firing the timers that become due. Open invoices whose expiry
+	is reached are canceled, for example.
+
+	This is only available on regtest and simnet, and is meant for
+	integration testing.`,
+	Action: actionDecorator(warpClock),
+}
+
+func warpClock(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "warpclock")
+	}
+
+	duration, err := time.ParseDuration(ctx.Args().First())
+	if err != nil {
+		return fmt.Errorf("unable to parse duration: %v", err)
+	}
+	if duration < time.Second {
+		return fmt.Errorf("duration must be at least one second")
+	}
+
+	req := &lnrpc.WarpClockRequest{
+		Seconds: uint64(duration / time.Second),
+	}
+	resp, err := client.WarpClock(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var exportReceiptCommand = cli.Command{
+	Name:      "exportreceipt",
+	Category:  "Payments",
+	Usage:     "Export a signed receipt for a settled payment.",
+	ArgsUsage: "payment_hash",
+	Description: `
+	Exports a receipt for a settled payment, signed by the node. The
+	receipt holds the payment request, the preimage and a summary of the
+	routes of the payment, so that the settlement can be proven to third
+	parties with the verifyreceipt command.
+
+	The receipt is printed hex encoded.`,
+	Action: actionDecorator(exportReceipt),
+}
+
+func exportReceipt(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "exportreceipt")
+	}
+
+	paymentHash, err := hex.DecodeString(ctx.Args().First())
+	if err != nil {
+		return fmt.Errorf("unable to decode payment hash: %v", err)
+	}
+
+	req := &lnrpc.ExportPaymentReceiptRequest{
+		PaymentHash: paymentHash,
+	}
+	resp, err := client.ExportPaymentReceipt(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printJSON(struct {
+		Receipt string `json:"receipt"`
+	}{
+		Receipt: hex.EncodeToString(resp.Receipt),
+	})
+	return nil
+}
+
+var verifyReceiptCommand = cli.Command{
+	Name:      "verifyreceipt",
+	Category:  "Payments",
+	Usage:     "Verify a payment receipt.",
+	ArgsUsage: "receipt",
+	Description: `
+	Verifies that the hex encoded payment receipt is signed by the node
+	that made the payment and that its content is consistent, and prints
+	the content of the receipt.`,
+	Action: actionDecorator(verifyReceipt),
+}
+
+func verifyReceipt(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "verifyreceipt")
+	}
+
+	rcpt, err := hex.DecodeString(ctx.Args().First())
+	if err != nil {
+		return fmt.Errorf("unable to decode receipt: %v", err)
+	}
+
+	req := &lnrpc.VerifyPaymentReceiptRequest{
+		Receipt: rcpt,
+	}
+	resp, err := client.VerifyPaymentReceipt(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printJSON(struct {
+		Valid          bool   `json:"valid"`
+		InvalidReason  string `json:"invalid_reason,omitempty"`
+		Payer          string `json:"payer"`
+		PaymentHash    string `json:"payment_hash"`
+		Preimage       string `json:"preimage"`
+		AmtMAtoms      int64  `json:"amt_m_atoms"`
+		FeeMAtoms      int64  `json:"fee_m_atoms"`
+		Destination    string `json:"destination"`
+		NumHtlcs       uint32 `json:"num_htlcs"`
+		SettleTime     int64  `json:"settle_time"`
+		PaymentRequest string `json:"payment_request,omitempty"`
+	}{
+		Valid:          resp.Valid,
+		InvalidReason:  resp.InvalidReason,
+		Payer:          resp.Payer,
+		PaymentHash:    hex.EncodeToString(resp.PaymentHash),
+		Preimage:       hex.EncodeToString(resp.Preimage),
+		AmtMAtoms:      resp.AmtMAtoms,
+		FeeMAtoms:      resp.FeeMAtoms,
+		Destination:    resp.Destination,
+		NumHtlcs:       resp.NumHtlcs,
+		SettleTime:     resp.SettleTime,
+		PaymentRequest: resp.PaymentRequest,
+	})
+	return nil
+}
+
+var recoveryStatusCommand = cli.Command{
+	Name:     "recoverystatus",
+	Category: "Channels",
+	Usage:    "Display the progress of the recovery of channels.",
+	Description: `
+	Displays the stage reached by the recovery of each channel restored
+	from a static channel backup, from reconnecting to the channel peer
+	to sweeping our outputs once the peer force closed the channel.
+
+	The status of the recovery peers, set with the chanrecovery.peer
+	option, is displayed along with the channels unknown to us they
+	were asked to force close.`,
+	Action: actionDecorator(recoveryStatus),
+}
+
+func recoveryStatus(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ChannelRecoveryStatusRequest{}
+	resp, err := client.ChannelRecoveryStatus(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var updateChannelPolicyCommand = cli.Command{
 	Name:     "updatechanpolicy",
 	Category: "Channels",
 	Usage: "Update the channel policy for all channels, or a single " +
 		"channel.",
 	ArgsUsage: "base_fee_m_atoms fee_rate time_lock_delta " +
-		"[--max_htlc_m_atoms=N] [channel_point]",
+		"[--max_htlc_m_atoms=N] [--min_htlc_m_atoms=N] " +
+		"[--inbound_base_fee_m_atoms=N] [--inbound_fee_rate_ppm=N] " +
+		"[channel_point]",
 	Description: `
 	Updates the channel policy for all channels, or just a particular channel
 	identified by its channel point. The update will be committed, and
 	broadcast to the rest of the network within the next batch.
-	Channel points are encoded as: funding_txid:output_index`,
+	Channel points are encoded as: funding_txid:output_index
+
+	An inbound fee discount can be granted for the HTLCs received over the
+	channels by setting a negative inbound base fee and fee rate. The
+	discount is subtracted from the fee of the channels the HTLCs are
+	forwarded over.`,
 	Flags: []cli.Flag{
 		cli.Int64Flag{
 			Name: "base_fee_m_atoms",
@@ -3391,6 +4705,24 @@ var updateChannelPolicyCommand = cli.Command{
 				"to all forwarded HTLCs. If unset, the max HTLC " +
 				"is left unchanged.",
 		},
+		cli.Uint64Flag{
+			Name: "min_htlc_m_atoms",
+			Usage: "if set, the min HTLC size that will be " +
+				"applied to all forwarded HTLCs. If unset, " +
+				"the min HTLC is left unchanged.",
+		},
+		cli.Int64Flag{
+			Name: "inbound_base_fee_m_atoms",
+			Usage: "the inbound base fee in milli-atoms, which " +
+				"must be zero or negative, discounted from " +
+				"the fee of each HTLC received over the channel",
+		},
+		cli.Int64Flag{
+			Name: "inbound_fee_rate_ppm",
+			Usage: "the inbound fee rate in millionths, which " +
+				"must be zero or negative, discounted from " +
+				"the fee of each HTLC received over the channel",
+		},
 		cli.StringFlag{
 			Name: "chan_point",
 			Usage: "The channel whose fee policy should be " +
@@ -3507,6 +4839,22 @@ func updateChannelPolicy(ctx *cli.Context) error {
 		MaxHtlcMAtoms: ctx.Uint64("max_htlc_m_atoms"),
 	}
 
+	if ctx.IsSet("min_htlc_m_atoms") {
+		req.MinHtlcMAtoms = ctx.Uint64("min_htlc_m_atoms")
+		req.MinHtlcMAtomsSpecified = true
+	}
+
+	if ctx.IsSet("inbound_base_fee_m_atoms") ||
+		ctx.IsSet("inbound_fee_rate_ppm") {
+
+		baseFee := ctx.Int64("inbound_base_fee_m_atoms")
+		feeRate := ctx.Int64("inbound_fee_rate_ppm")
+		req.InboundFee = &lnrpc.InboundFee{
+			BaseFeeMAtoms: int32(baseFee),
+			FeeRatePpm:    int32(feeRate),
+		}
+	}
+
 	if chanPoint != nil {
 		req.Scope = &lnrpc.PolicyUpdateRequest_ChanPoint{
 			ChanPoint: chanPoint,
@@ -3526,6 +4874,34 @@ func updateChannelPolicy(ctx *cli.Context) error {
 	return nil
 }
 
+var legacyPayloadStatsCommand = cli.Command{
+	Name:     "legacypayloadstats",
+	Category: "Payments",
+	Usage:    "Display the HTLCs still using legacy onion payloads.",
+	Description: `
+	Display the number of HTLCs carrying a legacy, non-TLV, onion payload
+	forwarded, received and rejected by the node since it started, along
+	with the block height and time starting at which these HTLCs are
+	rejected, if set through the legacypayload options.`,
+	Action: actionDecorator(legacyPayloadStats),
+}
+
+func legacyPayloadStats(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.LegacyPayloadStats(
+		ctxb, &lnrpc.LegacyPayloadStatsRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var forwardingHistoryCommand = cli.Command{
 	Name:      "fwdinghistory",
 	Category:  "Payments",
@@ -3890,12 +5266,81 @@ func verifyChanBackup(ctx *cli.Context) error {
 	return nil
 }
 
+var inspectChanBackupCommand = cli.Command{
+	Name:      "inspectchanbackup",
+	Category:  "Channels",
+	Usage:     "Display the contents of an existing channel backup",
+	ArgsUsage: "[--single_backup] [--multi_backup] [--multi_file]",
+	Description: `
+    This command allows a user to display the contents of an existing Single
+    or Multi channel backup without restoring it. For each channel within the
+    backup, the funding outpoint, the remote peer and its known addresses,
+    and the capacity of the channel are shown.
+
+    The command will accept backups in the same forms as verifychanbackup.
+    `,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "single_backup",
+			Usage: "a hex encoded single channel backup obtained " +
+				"from exportchanbackup",
+		},
+		cli.StringFlag{
+			Name: "multi_backup",
+			Usage: "a hex encoded multi-channel backup obtained " +
+				"from exportchanbackup",
+		},
+		cli.StringFlag{
+			Name:  "multi_file",
+			Usage: "the path to a multi-channel back up file",
+		},
+	},
+	Action: actionDecorator(inspectChanBackup),
+}
+
+func inspectChanBackup(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// Show command help if no arguments provided
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		cli.ShowCommandHelp(ctx, "inspectchanbackup")
+		return nil
+	}
+
+	backups, err := parseChanBackups(ctx)
+	if err != nil {
+		return err
+	}
+
+	inspectReq := lnrpc.ChanBackupSnapshot{}
+
+	if backups.GetChanBackups() != nil {
+		inspectReq.SingleChanBackups = backups.GetChanBackups()
+	}
+	if backups.GetMultiChanBackup() != nil {
+		inspectReq.MultiChanBackup = &lnrpc.MultiChanBackup{
+			MultiChanBackup: backups.GetMultiChanBackup(),
+		}
+	}
+
+	resp, err := client.InspectChanBackup(ctxb, &inspectReq)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var restoreChanBackupCommand = cli.Command{
 	Name:     "restorechanbackup",
 	Category: "Channels",
 	Usage: "Restore an existing single or multi-channel static channel " +
 		"backup",
-	ArgsUsage: "[--single_backup] [--multi_backup] [--multi_file=",
+	ArgsUsage: "[--single_backup] [--multi_backup] [--multi_file=] " +
+		"[--dry_run]",
 	Description: `
 	Allows a user to restore a Static Channel Backup (SCB) that was
 	obtained either via the exportchanbackup command, or from lnd's
@@ -3916,6 +5361,11 @@ var restoreChanBackupCommand = cli.Command{
 	   * A file path which points to a packed multi-channel backup within a
 	     file, using the same format that lnd does in its channels.backup
 	     file.
+
+	If --dry_run is specified, the backups are only decrypted and the
+	reachability of the channel peers is checked, without restoring any
+	channel. As each restored channel will be force closed by the remote
+	peer, this allows verifying that the restore can succeed beforehand.
 	`,
 	Flags: []cli.Flag{
 		cli.StringFlag{
@@ -3932,6 +5382,12 @@ var restoreChanBackupCommand = cli.Command{
 			Name:  "multi_file",
 			Usage: "the path to a multi-channel back up file",
 		},
+		cli.BoolFlag{
+			Name: "dry_run",
+			Usage: "only check that the backups can be decrypted " +
+				"and that the channel peers are reachable, " +
+				"without restoring any channel",
+		},
 	},
 	Action: actionDecorator(restoreChanBackup),
 }
@@ -4015,11 +5471,50 @@ func restoreChanBackup(ctx *cli.Context) error {
 	}
 
 	req.Backup = backups.Backup
+	req.DryRun = ctx.Bool("dry_run")
 
-	_, err = client.RestoreChannelBackups(ctxb, &req)
+	resp, err := client.RestoreChannelBackups(ctxb, &req)
 	if err != nil {
 		return fmt.Errorf("unable to restore chan backups: %v", err)
 	}
 
+	// The results of the peer reachability checks are only returned for
+	// dry runs.
+	if req.DryRun {
+		printRespJSON(resp)
+	}
+
+	return nil
+}
+
+var snapshotDBCommand = cli.Command{
+	Name:     "snapshotdb",
+	Category: "Channels",
+	Usage:    "Write a hot backup of the node databases.",
+	Description: `
+	Write a consistent copy of the channel database, the watchtower client
+	database and the sphinx replay log to a new snapshot directory within
+	the data directory of the node, while it's running.
+
+	The snapshot is only complete once its MANIFEST file exists. To restore
+	it, stop dcrlnd and copy all of the databases of the snapshot back to the
+	graph directory of the network: restoring the channel database without
+	the other two may let HTLCs be replayed to the node, or leave gaps in the
+	states backed up to its watchtowers.`,
+	Action: actionDecorator(snapshotDB),
+}
+
+func snapshotDB(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.DatabaseSnapshotRequest{}
+	resp, err := client.CreateDatabaseSnapshot(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
 	return nil
 }
@@ -7,6 +7,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/decred/dcrlnd/lnrpc/invoicesrpc"
 
@@ -99,6 +100,18 @@ var cancelInvoiceCommand = cli.Command{
 			Usage: "the hex-encoded payment hash (32 byte) for which the " +
 				"corresponding invoice will be canceled.",
 		},
+		cli.StringFlag{
+			Name: "set_id",
+			Usage: "the hex-encoded id of the AMP payment set whose " +
+				"accepted htlcs should be canceled, leaving the " +
+				"invoice open",
+		},
+		cli.StringSliceFlag{
+			Name: "htlc",
+			Usage: "an accepted htlc to cancel, leaving the invoice " +
+				"open, in the form chan_id:htlc_index. Can be " +
+				"specified multiple times",
+		},
 	},
 	Action: actionDecorator(cancelInvoice),
 }
@@ -129,6 +142,35 @@ func cancelInvoice(ctx *cli.Context) error {
 		PaymentHash: paymentHash,
 	}
 
+	if ctx.IsSet("set_id") {
+		invoice.SetId, err = hex.DecodeString(ctx.String("set_id"))
+		if err != nil {
+			return fmt.Errorf("unable to parse set id: %v", err)
+		}
+	}
+
+	for _, htlc := range ctx.StringSlice("htlc") {
+		parts := strings.Split(htlc, ":")
+		if len(parts) != 2 {
+			return fmt.Errorf("expected htlc in the form "+
+				"chan_id:htlc_index, got %v", htlc)
+		}
+
+		chanID, err := strconv.ParseUint(parts[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to parse chan id: %v", err)
+		}
+		htlcIndex, err := strconv.ParseUint(parts[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to parse htlc index: %v", err)
+		}
+
+		invoice.Htlcs = append(invoice.Htlcs, &invoicesrpc.CircuitKey{
+			ChanId:    chanID,
+			HtlcIndex: htlcIndex,
+		})
+	}
+
 	resp, err := client.CancelInvoice(context.Background(), invoice)
 	if err != nil {
 		return err
@@ -185,6 +227,17 @@ var addHoldInvoiceCommand = cli.Command{
 				"private channels in order to assist the " +
 				"payer in reaching you",
 		},
+		cli.UintFlag{
+			Name: "max_hop_hints",
+			Usage: "(optional) the maximum number of routing hints " +
+				"for private channels to select, defaults to 20",
+		},
+		cli.StringFlag{
+			Name: "hop_hint_chans",
+			Usage: "(optional) comma separated ids of private " +
+				"channels to always encode as routing hints, " +
+				"even if --private=false",
+		},
 	},
 	Action: actionDecorator(addHoldInvoice),
 }
@@ -232,6 +285,11 @@ func addHoldInvoice(ctx *cli.Context) error {
 		return fmt.Errorf("unable to parse description_hash: %v", err)
 	}
 
+	hopHintChans, err := parseChanIDs(ctx.String("hop_hint_chans"))
+	if err != nil {
+		return fmt.Errorf("unable to parse hop_hint_chans: %v", err)
+	}
+
 	invoice := &invoicesrpc.AddHoldInvoiceRequest{
 		Memo:            ctx.String("memo"),
 		Hash:            hash,
@@ -240,6 +298,8 @@ func addHoldInvoice(ctx *cli.Context) error {
 		FallbackAddr:    ctx.String("fallback_addr"),
 		Expiry:          ctx.Int64("expiry"),
 		Private:         ctx.Bool("private"),
+		MaxHopHints:     uint32(ctx.Uint("max_hop_hints")),
+		HopHintChanIds:  hopHintChans,
 	}
 
 	resp, err := client.AddHoldInvoice(context.Background(), invoice)
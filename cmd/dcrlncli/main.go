@@ -58,6 +58,16 @@ func getWalletUnlockerClient(ctx *cli.Context) (lnrpc.WalletUnlockerClient, func
 	return lnrpc.NewWalletUnlockerClient(conn), cleanUp
 }
 
+func getStateServiceClient(ctx *cli.Context) (lnrpc.StateClient, func()) {
+	conn := getClientConn(ctx, true)
+
+	cleanUp := func() {
+		conn.Close()
+	}
+
+	return lnrpc.NewStateClient(conn), cleanUp
+}
+
 func getClient(ctx *cli.Context) (lnrpc.LightningClient, func()) {
 	conn := getClientConn(ctx, false)
 
@@ -256,6 +266,9 @@ func main() {
 		createCommand,
 		unlockCommand,
 		changePasswordCommand,
+		stateCommand,
+		encryptDBCommand,
+		decryptDBCommand,
 		newAddressCommand,
 		estimateFeeCommand,
 		sendManyCommand,
@@ -263,20 +276,30 @@ func main() {
 		listUnspentCommand,
 		connectCommand,
 		disconnectCommand,
+		setPeerPolicyCommand,
 		openChannelCommand,
+		batchOpenChannelCommand,
 		closeChannelCommand,
 		closeAllChannelsCommand,
 		abandonChannelCommand,
+		bumpFundingFeeCommand,
+		rebroadcastLimboOutputCommand,
 		listPeersCommand,
+		subscribePeerEventsCommand,
+		listGossipSyncersCommand,
+		graphSyncStatusCommand,
 		walletBalanceCommand,
 		channelBalanceCommand,
 		getInfoCommand,
 		pendingChannelsCommand,
+		pendingChannelLimitsCommand,
+		listArbitratorsCommand,
 		sendPaymentCommand,
 		payInvoiceCommand,
 		sendToRouteCommand,
 		addInvoiceCommand,
 		lookupInvoiceCommand,
+		invoiceURICommand,
 		listInvoicesCommand,
 		listChannelsCommand,
 		closedChannelsCommand,
@@ -289,15 +312,34 @@ func main() {
 		debugLevelCommand,
 		decodePayReqCommand,
 		listChainTxnsCommand,
+		setColdSweepCommand,
+		subscribeColdSweepsCommand,
 		stopCommand,
 		signMessageCommand,
 		verifyMessageCommand,
 		feeReportCommand,
+		feeManagerReportCommand,
+		effectiveFeeRatesCommand,
+		getDebugInfoCommand,
+		warpClockCommand,
+		exportReceiptCommand,
+		verifyReceiptCommand,
+		recoveryStatusCommand,
 		updateChannelPolicyCommand,
 		forwardingHistoryCommand,
+		legacyPayloadStatsCommand,
 		exportChanBackupCommand,
 		verifyChanBackupCommand,
+		inspectChanBackupCommand,
 		restoreChanBackupCommand,
+		snapshotDBCommand,
+		sendCustomCommand,
+		subscribeCustomCommand,
+		pushGossipCommand,
+		bakeMacaroonCommand,
+		listMacaroonIDsCommand,
+		deleteMacaroonIDCommand,
+		listPermissionsCommand,
 	}
 
 	// Add any extra commands determined by build flags.
@@ -10,5 +10,14 @@ func routerCommands() []cli.Command {
 		queryMissionControlCommand,
 		resetMissionControlCommand,
 		buildRouteCommand,
+		addToAvoidListCommand,
+		removeFromAvoidListCommand,
+		queryAvoidListCommand,
+		cancelPaymentCommand,
+		queryPaymentMetricsCommand,
+		importGraphCommand,
+		updateChanStatusCommand,
+		lookupCircuitCommand,
+		failCircuitCommand,
 	}
 }
@@ -4,9 +4,11 @@ package main
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"sort"
 
+	"github.com/decred/dcrd/chaincfg/chainhash"
 	"github.com/decred/dcrlnd/lnrpc/walletrpc"
 	"github.com/urfave/cli"
 )
@@ -23,6 +25,9 @@ func walletCommands() []cli.Command {
 			Subcommands: []cli.Command{
 				pendingSweepsCommand,
 				bumpFeeCommand,
+				leaseOutputCommand,
+				releaseOutputCommand,
+				labelTxCommand,
 			},
 		},
 	}
@@ -169,3 +174,137 @@ func bumpFee(ctx *cli.Context) error {
 
 	return nil
 }
+
+var leaseOutputCommand = cli.Command{
+	Name:      "leaseoutput",
+	Usage:     "Lock an output to prevent it from being used in coin selection.",
+	ArgsUsage: "id outpoint",
+	Description: `
+	Lock the given wallet output to the given 32 byte hex encoded ID,
+	preventing it from being used in any future coin selection attempts.
+	The lease expires after a fixed duration, which can be extended by
+	leasing the output to the same ID again.`,
+	Action: actionDecorator(leaseOutput),
+}
+
+func leaseOutput(ctx *cli.Context) error {
+	// Display the command's help message if we do not have the expected
+	// number of arguments.
+	if ctx.NArg() != 2 {
+		return cli.ShowCommandHelp(ctx, "leaseoutput")
+	}
+
+	id, err := hex.DecodeString(ctx.Args().Get(0))
+	if err != nil {
+		return fmt.Errorf("unable to decode id: %v", err)
+	}
+	protoOutPoint, err := NewProtoOutPoint(ctx.Args().Get(1))
+	if err != nil {
+		return err
+	}
+
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.LeaseOutput(context.Background(), &walletrpc.LeaseOutputRequest{
+		Id:       id,
+		Outpoint: protoOutPoint,
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var releaseOutputCommand = cli.Command{
+	Name:      "releaseoutput",
+	Usage:     "Release an output previously locked with leaseoutput.",
+	ArgsUsage: "id outpoint",
+	Description: `
+	Release the lease of the given wallet output, allowing it to be used in
+	coin selection again if it remains unspent. The 32 byte hex encoded ID
+	must match the one the output was leased to.`,
+	Action: actionDecorator(releaseOutput),
+}
+
+func releaseOutput(ctx *cli.Context) error {
+	// Display the command's help message if we do not have the expected
+	// number of arguments.
+	if ctx.NArg() != 2 {
+		return cli.ShowCommandHelp(ctx, "releaseoutput")
+	}
+
+	id, err := hex.DecodeString(ctx.Args().Get(0))
+	if err != nil {
+		return fmt.Errorf("unable to decode id: %v", err)
+	}
+	protoOutPoint, err := NewProtoOutPoint(ctx.Args().Get(1))
+	if err != nil {
+		return err
+	}
+
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ReleaseOutput(context.Background(), &walletrpc.ReleaseOutputRequest{
+		Id:       id,
+		Outpoint: protoOutPoint,
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var labelTxCommand = cli.Command{
+	Name:      "labeltx",
+	Usage:     "Add a label to a transaction of the wallet.",
+	ArgsUsage: "txid label",
+	Description: `
+	Add a label to the given transaction of the wallet. The label is
+	returned by listchaintxns, which can also filter transactions by label.
+	Transactions which already have a label, including the ones labeled
+	automatically by lnd, keep it unless the overwrite flag is set.`,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "overwrite",
+			Usage: "replace the existing label of the transaction",
+		},
+	},
+	Action: actionDecorator(labelTransaction),
+}
+
+func labelTransaction(ctx *cli.Context) error {
+	// Display the command's help message if we do not have the expected
+	// number of arguments.
+	if ctx.NArg() != 2 {
+		return cli.ShowCommandHelp(ctx, "labeltx")
+	}
+
+	txid, err := chainhash.NewHashFromStr(ctx.Args().Get(0))
+	if err != nil {
+		return fmt.Errorf("unable to decode txid: %v", err)
+	}
+
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.LabelTransaction(context.Background(), &walletrpc.LabelTransactionRequest{
+		Txid:      txid[:],
+		Label:     ctx.Args().Get(1),
+		Overwrite: ctx.Bool("overwrite"),
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
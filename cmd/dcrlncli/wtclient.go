@@ -25,6 +25,8 @@ func wtclientCommands() []cli.Command {
 				getTowerCommand,
 				statsCommand,
 				policyCommand,
+				setChanPolicyCommand,
+				listChanPoliciesCommand,
 			},
 		},
 	}
@@ -279,3 +281,75 @@ func policy(ctx *cli.Context) error {
 	printRespJSON(resp)
 	return nil
 }
+
+var setChanPolicyCommand = cli.Command{
+	Name:  "setchanpolicy",
+	Usage: "Override the backup policy of a channel.",
+	Description: "Allows a channel to opt out of backups entirely, or to " +
+		"only be backed up while the client's sweep fee rate doesn't " +
+		"exceed the given limit. Omitting both flags restores the " +
+		"client's default behavior for the channel.",
+	ArgsUsage: "funding_txid:output_index",
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "disable_backups",
+			Usage: "don't back up the channel's revoked states",
+		},
+		cli.Uint64Flag{
+			Name: "max_sweep_atoms_per_byte",
+			Usage: "the highest sweep fee rate the channel's " +
+				"justice transactions may pay",
+		},
+	},
+	Action: actionDecorator(setChanPolicy),
+}
+
+func setChanPolicy(ctx *cli.Context) error {
+	// Display the command's help message if the number of arguments/flags
+	// is not what we expect.
+	if ctx.NArg() != 1 || ctx.NumFlags() > 2 {
+		return cli.ShowCommandHelp(ctx, "setchanpolicy")
+	}
+
+	client, cleanUp := getWtclient(ctx)
+	defer cleanUp()
+
+	req := &wtclientrpc.SetChannelPolicyRequest{
+		ChanPoint:            ctx.Args().First(),
+		BackupsDisabled:      ctx.Bool("disable_backups"),
+		MaxSweepAtomsPerByte: uint32(ctx.Uint64("max_sweep_atoms_per_byte")),
+	}
+	resp, err := client.SetChannelPolicy(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listChanPoliciesCommand = cli.Command{
+	Name:   "listchanpolicies",
+	Usage:  "Display the channels with an overridden backup policy.",
+	Action: actionDecorator(listChanPolicies),
+}
+
+func listChanPolicies(ctx *cli.Context) error {
+	// Display the command's help message if the number of arguments/flags
+	// is not what we expect.
+	if ctx.NArg() > 0 || ctx.NumFlags() > 0 {
+		return cli.ShowCommandHelp(ctx, "listchanpolicies")
+	}
+
+	client, cleanUp := getWtclient(ctx)
+	defer cleanUp()
+
+	req := &wtclientrpc.ListChannelPoliciesRequest{}
+	resp, err := client.ListChannelPolicies(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -0,0 +1,262 @@
+package coldsweep
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/txscript/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/lnwallet"
+	"github.com/decred/dcrlnd/subscribe"
+)
+
+// ErrNoSweepNeeded is returned by SweepNow when the confirmed balance of the
+// wallet doesn't exceed the threshold, so there is nothing to sweep.
+var ErrNoSweepNeeded = errors.New("confirmed balance doesn't exceed the " +
+	"sweep threshold")
+
+// Config houses the parameters and the wallet functions used by the Sweeper.
+type Config struct {
+	// Address is the cold storage address the funds are swept to.
+	Address dcrutil.Address
+
+	// Threshold is the confirmed balance to keep in the wallet. Only the
+	// confirmed balance above it is swept.
+	Threshold dcrutil.Amount
+
+	// Interval is the time between two sweep attempts.
+	Interval time.Duration
+
+	// TargetConf is the confirmation target used to estimate the fee rate
+	// of the sweep transactions.
+	TargetConf uint32
+
+	// ConfirmedBalance returns the confirmed balance of the wallet.
+	ConfirmedBalance func() (dcrutil.Amount, error)
+
+	// FeeRate returns the fee rate to use for a transaction to confirm
+	// within the given number of blocks.
+	FeeRate func(confTarget uint32) (lnwallet.AtomPerKByte, error)
+
+	// SendOutputs funds, signs and broadcasts a transaction creating the
+	// given outputs. It must synchronize with any other concurrent coin
+	// selection.
+	SendOutputs func(outputs []*wire.TxOut,
+		feeRate lnwallet.AtomPerKByte) (*wire.MsgTx, error)
+}
+
+// SweepPublishedEvent is sent to subscribers when a sweep transaction has been
+// published.
+type SweepPublishedEvent struct {
+	// Txid is the hash of the sweep transaction.
+	Txid chainhash.Hash
+
+	// Amount is the amount sent to the cold storage address.
+	Amount dcrutil.Amount
+}
+
+// SweepFailedEvent is sent to subscribers when a scheduled sweep failed.
+type SweepFailedEvent struct {
+	// Err is the reason of the failure.
+	Err error
+}
+
+// StateEvent is sent to subscribers when the scheduled sweeps are enabled or
+// disabled.
+type StateEvent struct {
+	// Enabled is true if the scheduled sweeps have been enabled.
+	Enabled bool
+}
+
+// Sweeper periodically moves the confirmed balance of the wallet above a
+// threshold to a cold storage address. The scheduled sweeps can be enabled and
+// disabled at runtime, and their outcome is notified to subscribers.
+type Sweeper struct {
+	started sync.Once
+	stopped sync.Once
+
+	enabled int32 // To be used atomically.
+
+	cfg *Config
+
+	ntfnServer *subscribe.Server
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// New creates a new cold storage sweeper. The scheduled sweeps are only
+// attempted once enabled, which is done right away if enabled is true.
+func New(cfg *Config, enabled bool) *Sweeper {
+	s := &Sweeper{
+		cfg:        cfg,
+		ntfnServer: subscribe.NewServer(),
+		quit:       make(chan struct{}),
+	}
+	if enabled {
+		s.enabled = 1
+	}
+
+	return s
+}
+
+// Start launches the loop attempting the scheduled sweeps.
+func (s *Sweeper) Start() error {
+	var err error
+
+	s.started.Do(func() {
+		log.Infof("Cold storage sweeper starting, sweeping to %v "+
+			"every %v above %v", s.cfg.Address, s.cfg.Interval,
+			s.cfg.Threshold)
+
+		if err = s.ntfnServer.Start(); err != nil {
+			return
+		}
+
+		s.wg.Add(1)
+		go s.sweepLoop()
+	})
+
+	return err
+}
+
+// Stop signals the sweeper for a graceful shutdown.
+func (s *Sweeper) Stop() {
+	s.stopped.Do(func() {
+		log.Info("Cold storage sweeper shutting down")
+
+		close(s.quit)
+		s.wg.Wait()
+
+		s.ntfnServer.Stop()
+	})
+}
+
+// SetEnabled enables or disables the scheduled sweeps.
+func (s *Sweeper) SetEnabled(enabled bool) {
+	var state int32
+	if enabled {
+		state = 1
+	}
+
+	if atomic.SwapInt32(&s.enabled, state) == state {
+		return
+	}
+
+	log.Infof("Scheduled cold storage sweeps enabled=%v", enabled)
+
+	s.notify(StateEvent{Enabled: enabled})
+}
+
+// Enabled returns true if the scheduled sweeps are enabled.
+func (s *Sweeper) Enabled() bool {
+	return atomic.LoadInt32(&s.enabled) == 1
+}
+
+// SubscribeEvents returns a subscribe.Client that will receive the events of
+// the sweeper.
+func (s *Sweeper) SubscribeEvents() (*subscribe.Client, error) {
+	return s.ntfnServer.Subscribe()
+}
+
+// sweepLoop attempts a sweep at each interval, as long as the scheduled sweeps
+// are enabled.
+//
+// NOTE: This MUST be run as a goroutine.
+func (s *Sweeper) sweepLoop() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if !s.Enabled() {
+				continue
+			}
+
+			_, err := s.SweepNow()
+			switch {
+			case err == ErrNoSweepNeeded:
+				log.Debugf("Skipping cold storage sweep: %v",
+					err)
+
+			case err != nil:
+				log.Errorf("Unable to sweep to cold storage: "+
+					"%v", err)
+			}
+
+		case <-s.quit:
+			return
+		}
+	}
+}
+
+// SweepNow sweeps the confirmed balance above the threshold to the cold
+// storage address, regardless of whether the scheduled sweeps are enabled.
+// ErrNoSweepNeeded is returned if the balance doesn't exceed the threshold.
+func (s *Sweeper) SweepNow() (*wire.MsgTx, error) {
+	tx, amt, err := s.sweep()
+	switch {
+	case err == ErrNoSweepNeeded:
+		return nil, err
+
+	case err != nil:
+		s.notify(SweepFailedEvent{Err: err})
+		return nil, err
+	}
+
+	log.Infof("Swept %v to cold storage address %v in tx %v", amt,
+		s.cfg.Address, tx.TxHash())
+
+	s.notify(SweepPublishedEvent{
+		Txid:   tx.TxHash(),
+		Amount: amt,
+	})
+
+	return tx, nil
+}
+
+// sweep creates and publishes a transaction sending the confirmed balance
+// above the threshold to the cold storage address.
+func (s *Sweeper) sweep() (*wire.MsgTx, dcrutil.Amount, error) {
+	balance, err := s.cfg.ConfirmedBalance()
+	if err != nil {
+		return nil, 0, err
+	}
+	if balance <= s.cfg.Threshold {
+		return nil, 0, ErrNoSweepNeeded
+	}
+	amt := balance - s.cfg.Threshold
+
+	feeRate, err := s.cfg.FeeRate(s.cfg.TargetConf)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	pkScript, err := txscript.PayToAddrScript(s.cfg.Address)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	tx, err := s.cfg.SendOutputs(
+		[]*wire.TxOut{wire.NewTxOut(int64(amt), pkScript)}, feeRate,
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return tx, amt, nil
+}
+
+// notify sends the event to all subscribers.
+func (s *Sweeper) notify(event interface{}) {
+	if err := s.ntfnServer.SendUpdate(event); err != nil {
+		log.Warnf("Unable to send cold storage sweep event: %v", err)
+	}
+}
@@ -0,0 +1,180 @@
+package coldsweep
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/v2"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/txscript/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/lnwallet"
+)
+
+// mockWallet records the outputs sent by the sweeper, dropping them if the
+// previous ones haven't been consumed yet.
+type mockWallet struct {
+	balance dcrutil.Amount
+	sendErr error
+	sent    chan []*wire.TxOut
+}
+
+func (m *mockWallet) sendOutputs(outputs []*wire.TxOut,
+	_ lnwallet.AtomPerKByte) (*wire.MsgTx, error) {
+
+	if m.sendErr != nil {
+		return nil, m.sendErr
+	}
+
+	select {
+	case m.sent <- outputs:
+	default:
+	}
+
+	tx := wire.NewMsgTx()
+	for _, output := range outputs {
+		tx.AddTxOut(output)
+	}
+	return tx, nil
+}
+
+func newTestSweeper(t *testing.T, wallet *mockWallet,
+	interval time.Duration, enabled bool) *Sweeper {
+
+	t.Helper()
+
+	addr, err := dcrutil.DecodeAddress(
+		"TsR28UZRprhgQQhzWns2M6cAwchrNVvbYq2",
+		chaincfg.TestNet3Params(),
+	)
+	if err != nil {
+		t.Fatalf("unable to decode address: %v", err)
+	}
+
+	return New(&Config{
+		Address:    addr,
+		Threshold:  dcrutil.Amount(1e8),
+		Interval:   interval,
+		TargetConf: 6,
+		ConfirmedBalance: func() (dcrutil.Amount, error) {
+			return wallet.balance, nil
+		},
+		FeeRate: func(uint32) (lnwallet.AtomPerKByte, error) {
+			return 1e4, nil
+		},
+		SendOutputs: wallet.sendOutputs,
+	}, enabled)
+}
+
+// TestSweepNow asserts that only the confirmed balance above the threshold is
+// swept to the cold storage address, and that the outcome of each sweep is
+// notified.
+func TestSweepNow(t *testing.T) {
+	t.Parallel()
+
+	wallet := &mockWallet{
+		balance: dcrutil.Amount(3e8),
+		sent:    make(chan []*wire.TxOut, 1),
+	}
+	sweeper := newTestSweeper(t, wallet, time.Hour, false)
+	if err := sweeper.Start(); err != nil {
+		t.Fatalf("unable to start sweeper: %v", err)
+	}
+	defer sweeper.Stop()
+
+	sub, err := sweeper.SubscribeEvents()
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	defer sub.Cancel()
+
+	if _, err := sweeper.SweepNow(); err != nil {
+		t.Fatalf("unable to sweep: %v", err)
+	}
+
+	outputs := <-wallet.sent
+	expScript, _ := txscript.PayToAddrScript(sweeper.cfg.Address)
+	if len(outputs) != 1 || outputs[0].Value != 2e8 ||
+		string(outputs[0].PkScript) != string(expScript) {
+
+		t.Fatalf("unexpected sweep outputs: %v", outputs)
+	}
+
+	select {
+	case event := <-sub.Updates():
+		published, ok := event.(SweepPublishedEvent)
+		if !ok || published.Amount != dcrutil.Amount(2e8) {
+			t.Fatalf("unexpected event: %v", event)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no sweep event received")
+	}
+
+	// A balance below the threshold must not be swept.
+	wallet.balance = dcrutil.Amount(1e8)
+	if _, err := sweeper.SweepNow(); err != ErrNoSweepNeeded {
+		t.Fatalf("expected ErrNoSweepNeeded, got %v", err)
+	}
+
+	// A failure to publish the sweep must be notified.
+	wallet.balance = dcrutil.Amount(3e8)
+	wallet.sendErr = errors.New("insufficient funds")
+	if _, err := sweeper.SweepNow(); err != wallet.sendErr {
+		t.Fatalf("expected %v, got %v", wallet.sendErr, err)
+	}
+
+	select {
+	case event := <-sub.Updates():
+		if _, ok := event.(SweepFailedEvent); !ok {
+			t.Fatalf("unexpected event: %v", event)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no sweep failure event received")
+	}
+}
+
+// TestScheduledSweeps asserts that sweeps are only attempted on schedule while
+// enabled.
+func TestScheduledSweeps(t *testing.T) {
+	t.Parallel()
+
+	wallet := &mockWallet{
+		balance: dcrutil.Amount(3e8),
+		sent:    make(chan []*wire.TxOut, 1),
+	}
+	sweeper := newTestSweeper(t, wallet, 10*time.Millisecond, false)
+	if err := sweeper.Start(); err != nil {
+		t.Fatalf("unable to start sweeper: %v", err)
+	}
+	defer sweeper.Stop()
+
+	sub, err := sweeper.SubscribeEvents()
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	defer sub.Cancel()
+
+	select {
+	case <-wallet.sent:
+		t.Fatalf("sweep attempted while disabled")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	sweeper.SetEnabled(true)
+	select {
+	case event := <-sub.Updates():
+		state, ok := event.(StateEvent)
+		if !ok || !state.Enabled {
+			t.Fatalf("unexpected event: %v", event)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no state event received")
+	}
+
+	select {
+	case <-wallet.sent:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no sweep attempted while enabled")
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net"
+	"net/url"
 	"os"
 	"os/user"
 	"path/filepath"
@@ -29,6 +30,7 @@ import (
 	"github.com/decred/dcrlnd/lncfg"
 	"github.com/decred/dcrlnd/lnrpc/routerrpc"
 	"github.com/decred/dcrlnd/lnrpc/signrpc"
+	"github.com/decred/dcrlnd/lnwallet"
 	"github.com/decred/dcrlnd/lnwire"
 	"github.com/decred/dcrlnd/routing"
 	"github.com/decred/dcrlnd/tor"
@@ -149,7 +151,7 @@ var (
 type chainConfig struct {
 	ChainDir string `long:"chaindir" description:"The directory to store the chain's data within."`
 
-	Node string `long:"node" description:"The blockchain interface to use." choice:"dcrd"`
+	Node string `long:"node" description:"The blockchain interface to use." choice:"dcrd" choice:"spv"`
 
 	MainNet  bool `long:"mainnet" description:"Use the main network"`
 	TestNet3 bool `long:"testnet" description:"Use the test network"`
@@ -171,6 +173,14 @@ type dcrdConfig struct {
 	RPCPass    string `long:"rpcpass" default-mask:"-" description:"Password for RPC connections"`
 	RPCCert    string `long:"rpccert" description:"File containing the daemon's certificate file"`
 	RawRPCCert string `long:"rawrpccert" description:"The raw bytes of the daemon's PEM-encoded certificate chain which will be used to authenticate the RPC connection."`
+
+	MempoolSpends bool `long:"mempoolspends" description:"If true, the spends of the funding outputs of our channels by transactions accepted to the daemon's mempool are watched for, so that a breach is reacted upon before it's confirmed. This requires the daemon to relay the mempool transactions matching the transaction filter loaded by dcrlnd."`
+
+	CFilters bool `long:"cfilters" description:"If true, the chain notifier watches for on-chain events through the committed filters of blocks served by dcrd, only fetching the blocks that match them, so it doesn't rely on the transaction index of dcrd. This only changes the chain notifier: a dcrd RPC connection is still required, as it also backs the chain view, the chain IO and fee estimation. Use decred.node=spv to run without a dcrd node. Spends in the mempool can't be watched for in this mode."`
+}
+
+type spvConfig struct {
+	Connect []string `long:"connect" description:"Connect only to the specified peers at startup. This option can be set multiple times. By default, peers are discovered through the seeders of the network."`
 }
 
 type dcrwalletConfig struct {
@@ -200,6 +210,8 @@ type torConfig struct {
 	V2              bool   `long:"v2" description:"Automatically set up a v2 onion service to listen for inbound connections"`
 	V3              bool   `long:"v3" description:"Automatically set up a v3 onion service to listen for inbound connections"`
 	PrivateKeyPath  string `long:"privatekeypath" description:"The path to the private key of the onion service being created"`
+	WalletKey       bool   `long:"walletkey" description:"Derive the private key of the v3 onion service from the node's identity key instead of storing it on disk, so that the same onion service is restored along with the wallet"`
+	PreferOnion     bool   `long:"preferonion" description:"Advertise the onion addresses of the node before its clearnet addresses, and attempt to reconnect to the onion addresses of peers before their clearnet addresses"`
 }
 
 // config defines the configuration options for lnd.
@@ -239,23 +251,38 @@ type config struct {
 	ExternalIPs      []net.Addr
 	DisableListen    bool          `long:"nolisten" description:"Disable listening for incoming peer connections"`
 	DisableRest      bool          `long:"norest" description:"Disable REST API"`
+	RestCORS         []string      `long:"restcors" description:"Add an origin, such as https://example.com, allowed to make cross-origin requests to the REST API, and to open WebSockets to it from a browser. Set as \"*\" to allow any origin"`
 	NAT              bool          `long:"nat" description:"Toggle NAT traversal support (using either UPnP or NAT-PMP) to automatically advertise your external IP address to the network -- NOTE this does not support devices behind multiple NATs"`
 	MinBackoff       time.Duration `long:"minbackoff" description:"Shortest backoff when reconnecting to persistent peers. Valid time units are {s, m, h}."`
 	MaxBackoff       time.Duration `long:"maxbackoff" description:"Longest backoff when reconnecting to persistent peers. Valid time units are {s, m, h}."`
 
+	// The PROXY protocol options are only meant to be used when the
+	// listeners can solely be reached through a proxy or load balancer, as
+	// anyone connecting to them could otherwise forge their address.
+	ListenProxyProtocol     bool `long:"listen-proxyprotocol" description:"Expect the peer connections accepted on the listen interfaces to start with a PROXY protocol header reporting the address of the peer -- NOTE only use this if the interfaces can solely be reached through a proxy"`
+	RPCListenProxyProtocol  bool `long:"rpclisten-proxyprotocol" description:"Expect the RPC connections accepted on the rpclisten interfaces to start with a PROXY protocol header reporting the address of the client -- NOTE only use this if the interfaces can solely be reached through a proxy"`
+	RESTListenProxyProtocol bool `long:"restlisten-proxyprotocol" description:"Expect the REST connections accepted on the restlisten interfaces to start with a PROXY protocol header reporting the address of the client -- NOTE only use this if the interfaces can solely be reached through a proxy"`
+
 	DebugLevel string `short:"d" long:"debuglevel" description:"Logging level for all subsystems {trace, debug, info, warn, error, critical} -- You may also specify <subsystem>=<level>,<subsystem2>=<level>,... to set the log level for individual subsystems -- Use show to list available subsystems"`
 
+	UnsafeLogging bool `long:"unsafelogging" description:"Include the payment preimages, revocation secrets and onion blobs of wire messages in the debug logs -- NOTE only allowed on regtest and simnet"`
+
 	CPUProfile string `long:"cpuprofile" description:"Write CPU profile to the specified file"`
 
-	Profile string `long:"profile" description:"Enable HTTP profiling on given port -- NOTE port must be between 1024 and 65535"`
+	Profile string `long:"profile" description:"Enable HTTP profiling on given port or interface:port, e.g. [::1]:6060 -- NOTE port must be between 1024 and 65535"`
 
-	UnsafeDisconnect   bool   `long:"unsafe-disconnect" description:"Allows the rpcserver to intentionally disconnect from peers with open channels. USED FOR TESTING ONLY."`
-	UnsafeReplay       bool   `long:"unsafe-replay" description:"Causes a link to replay the adds on its commitment txn after starting up, this enables testing of the sphinx replay logic."`
-	MaxPendingChannels int    `long:"maxpendingchannels" description:"The maximum number of incoming pending channels permitted per peer."`
-	BackupFilePath     string `long:"backupfilepath" description:"The target location of the channel backup file"`
+	UnsafeDisconnect         bool   `long:"unsafe-disconnect" description:"Allows the rpcserver to intentionally disconnect from peers with open channels. USED FOR TESTING ONLY."`
+	UnsafeReplay             bool   `long:"unsafe-replay" description:"Causes a link to replay the adds on its commitment txn after starting up, this enables testing of the sphinx replay logic."`
+	MaxPendingChannels       int    `long:"maxpendingchannels" description:"The maximum number of incoming pending channels permitted per peer."`
+	MaxGlobalPendingChannels int    `long:"maxglobalpendingchannels" description:"The maximum number of pending channels permitted across all peers. A value of 0 disables this limit."`
+	MaxConcurrentClaims      int    `long:"maxconcurrentclaims" description:"The maximum number of HTLC outputs of force closed channels claimed on-chain at once. Once reached, the remaining claims are made by decreasing value per block left before their expiry. A value of 0 disables this limit."`
+	BackupFilePath           string `long:"backupfilepath" description:"The target location of the channel backup file"`
+	BackupNotifyCmd          string `long:"backupnotifycmd" description:"A command to execute each time the channel backup file is updated. The path of the backup file is passed as its only argument and the packed backup is written to its stdin"`
+	BackupNotifyURL          string `long:"backupnotifyurl" description:"A URL the packed channel backup is POSTed to each time it is updated"`
 
 	Decred    *chainConfig     `group:"Decred" namespace:"decred"`
 	DcrdMode  *dcrdConfig      `group:"dcrd" namespace:"dcrd"`
+	SpvMode   *spvConfig       `group:"spv" namespace:"spv"`
 	Dcrwallet *dcrwalletConfig `group:"dcrwallet" namespace:"dcrwallet"`
 
 	Autopilot *autoPilotConfig `group:"Autopilot" namespace:"autopilot"`
@@ -266,7 +293,8 @@ type config struct {
 
 	Hodl *hodl.Config `group:"hodl" namespace:"hodl"`
 
-	NoNetBootstrap bool `long:"nobootstrap" description:"If true, then automatic network bootstrapping will not be attempted."`
+	NoNetBootstrap bool     `long:"nobootstrap" description:"If true, then automatic network bootstrapping will not be attempted."`
+	BootstrapPeers []string `long:"bootstrappeer" description:"The pubkey@host:port of a peer to sample when bootstrapping connections to the network, along with the DNS seeds and the channel graph. Can be specified multiple times."`
 
 	NoSeedBackup bool `long:"noseedbackup" description:"If true, NO SEED WILL BE EXPOSED AND THE WALLET WILL BE ENCRYPTED USING THE DEFAULT PASSPHRASE -- EVER. THIS FLAG IS ONLY FOR TESTING AND IS BEING DEPRECATED."`
 
@@ -286,6 +314,15 @@ type config struct {
 
 	RejectPush bool `long:"rejectpush" description:"If true, lnd will not accept channel opening requests with non-zero push amounts. This should prevent accidental pushes to merchant nodes."`
 
+	WumboChannels bool     `long:"wumbochannels" description:"If true, channels larger than the default funding limit can be opened with and accepted from peers that also support them, up to maxchansize"`
+	MaxChanSize   int64    `long:"maxchansize" description:"The largest channel size (in atoms) that we should open or accept. Values above the default funding limit require wumbochannels to be set"`
+	WumboPeers    []string `long:"wumbopeer" description:"The hex encoded public key of a peer allowed to open wumbo channels with us. Can be specified multiple times. If unset, wumbo channels are accepted from any peer supporting them"`
+
+	ZeroConf      bool     `long:"zeroconf" description:"If true, channels usable before their funding transaction confirms can be opened with peers that also support them, and accepted from the peers set with zeroconfpeer"`
+	ZeroConfPeers []string `long:"zeroconfpeer" description:"The hex encoded public key of a peer trusted to open zero-conf channels with us. Can be specified multiple times"`
+
+	DisableFeatures []string `long:"disablefeature" description:"The name of an optional feature not to signal to peers nor in invoices, e.g. gossip-queries. Features required by others can't be disabled. Can be specified multiple times"`
+
 	RejectHTLC bool `long:"rejecthtlc" description:"If true, lnd will not forward any HTLCs that are meant as onward payments. This option will still allow lnd to send HTLCs and receive HTLCs but lnd won't be used as a hop."`
 
 	StaggerInitialReconnect bool `long:"stagger-initial-reconnect" description:"If true, will apply a randomized staggering between 0s and 30s when reconnecting to persistent peers on startup. The first 10 reconnections will be attempted instantly, regardless of the flag's value"`
@@ -294,14 +331,43 @@ type config struct {
 
 	MaxChannelFeeAllocation float64 `long:"max-channel-fee-allocation" description:"The maximum percentage of total funds that can be allocated to a channel's commitment fee. This only applies for the initiator of the channel. Valid values are within [0.1, 1]."`
 
+	FeeEstimator string `long:"feeestimator" description:"The source of the fee estimates used to fund channels, negotiate cooperative closes and sweep outputs. The backend source uses the chain backend's estimates, the static source a fixed rate and the webapi source the external API set with feeurl, falling back to the backend's estimates for the targets the API doesn't provide" choice:"backend" choice:"static" choice:"webapi"`
+	FeeURL       string `long:"feeurl" description:"The HTTPS URL of the external fee estimation API used by the webapi fee estimator. It must return a JSON object mapping confirmation targets to fee rates in atoms/kB under fee_by_block_target."`
+
+	ChangeAddressType string `long:"changeaddresstype" description:"The script type of the change and sweep outputs created by the node {p2pkh}."`
+	NoAddressReuse    bool   `long:"noaddressreuse" description:"If true, the node will never hand out an on-chain address twice, and will recover the change addresses left unused by a crash before deriving new ones."`
+	AddressGapLimit   uint32 `long:"addressgaplimit" description:"The gap limit of the wallet, used by noaddressreuse to bound the number of unused addresses."`
+
+	AcceptKeySend bool `long:"accept-keysend" description:"If true, spontaneous payments through keysend will be accepted."`
+	KeySendHold   bool `long:"keysend-hold" description:"If true, accepted keysend payments are held until they are settled or canceled through the invoices sub-server, instead of being settled right away. This allows screening spontaneous payments before accepting them."`
+
+	CancelExpiredInvoices    bool          `long:"cancel-expired-invoices" description:"If true, open invoices are canceled automatically once their expiry has passed."`
+	CanceledInvoiceRetention time.Duration `long:"canceled-invoice-retention" description:"If set, canceled invoices are deleted from the database once they are older than this duration. Canceled invoices are kept forever by default."`
+
+	MinHopHints uint32 `long:"min-hop-hints" description:"The minimum number of valid hop hints a private invoice must include, below which its creation fails. A hop hint is valid if its channel is active, its peer online and the peer's routing policy for the channel is enabled and recent."`
+
+	AcceptorTimeout         time.Duration `long:"acceptor-timeout" description:"The duration within which a ChannelAcceptor RPC client must respond to an inbound channel open request, after which acceptor-accept-on-timeout decides whether the channel is accepted."`
+	AcceptorAcceptOnTimeout bool          `long:"acceptor-accept-on-timeout" description:"If true, inbound channel open requests that a ChannelAcceptor RPC client didn't respond to within acceptor-timeout are accepted rather than rejected."`
+
+	RPCMiddlewareTimeout time.Duration `long:"rpcmiddleware-timeout" description:"The duration within which an RPC middleware must respond to a request carrying a custom macaroon caveat it enforces, after which the request is rejected."`
+
+	PeerInitTimeout time.Duration `long:"peer-init-timeout" description:"The duration within which a peer must send its init message once the encrypted connection is established, after which the connection is failed."`
+
+	ShutdownDrainTimeout time.Duration `long:"shutdown-drain-timeout" description:"The maximum duration to wait on shutdown for the in-flight HTLCs to be resolved once new HTLCs are rejected, after which the remaining ones are resolved on the next start. A value of 0 disables the draining."`
+
 	net tor.Net
 
+	// changeAddrType is the parsed version of ChangeAddressType.
+	changeAddrType lnwallet.AddressType
+
 	Routing *routing.Conf `group:"routing" namespace:"routing"`
 
 	Workers *lncfg.Workers `group:"workers" namespace:"workers"`
 
 	Caches *lncfg.Caches `group:"caches" namespace:"caches"`
 
+	Gossip *lncfg.Gossip `group:"gossip" namespace:"gossip"`
+
 	Prometheus lncfg.Prometheus `group:"prometheus" namespace:"prometheus"`
 
 	WtClient *lncfg.WtClient `group:"wtclient" namespace:"wtclient"`
@@ -309,6 +375,34 @@ type config struct {
 	Watchtower *lncfg.Watchtower `group:"watchtower" namespace:"watchtower"`
 
 	LegacyProtocol *lncfg.LegacyProtocol `group:"legacyprotocol" namespace:"legacyprotocol"`
+
+	ExperimentalProtocol *lncfg.ExperimentalProtocol `group:"experimental" namespace:"experimental"`
+
+	RemoteSigner *lncfg.RemoteSigner `group:"remotesigner" namespace:"remotesigner"`
+
+	PayoutPolicy *lncfg.PayoutPolicy `group:"payoutpolicy" namespace:"payoutpolicy"`
+
+	ColdSweep *lncfg.ColdSweep `group:"coldsweep" namespace:"coldsweep"`
+
+	LegacyPayload *lncfg.LegacyPayload `group:"legacypayload" namespace:"legacypayload"`
+
+	FeeManager *lncfg.FeeManager `group:"feemanager" namespace:"feemanager"`
+
+	ConfTargets *lncfg.ConfTargets `group:"conftarget" namespace:"conftarget"`
+
+	FundingTimeout *lncfg.FundingTimeout `group:"fundingtimeout" namespace:"fundingtimeout"`
+
+	FailureDelay *lncfg.FailureDelay `group:"failuredelay" namespace:"failuredelay"`
+
+	HtlcExposure *lncfg.HtlcExposure `group:"htlcexposure" namespace:"htlcexposure"`
+
+	DBEncryption *lncfg.DBEncryption `group:"dbencryption" namespace:"dbencryption"`
+
+	DB *lncfg.DB `group:"db" namespace:"db"`
+
+	ChanRecovery *lncfg.ChanRecovery `group:"chanrecovery" namespace:"chanrecovery"`
+
+	HealthChecks *lncfg.HealthCheckConfig `group:"healthcheck" namespace:"healthcheck"`
 }
 
 // loadConfig initializes and parses the config using a config file and command
@@ -342,6 +436,7 @@ func loadConfig() (*config, error) {
 			RPCHost: defaultRPCHost,
 			RPCCert: defaultDcrdRPCCertFile,
 		},
+		SpvMode:            &spvConfig{},
 		Dcrwallet:          &dcrwalletConfig{},
 		MaxPendingChannels: DefaultMaxPendingChannels,
 		NoSeedBackup:       defaultNoSeedBackup,
@@ -369,6 +464,7 @@ func loadConfig() (*config, error) {
 		Alias:                    defaultAlias,
 		Color:                    defaultColor,
 		MinChanSize:              int64(minChanFundingSize),
+		MaxChanSize:              int64(MaxFundingAmount),
 		NumGraphSyncPeers:        defaultMinPeers,
 		HistoricalSyncInterval:   discovery.DefaultHistoricalSyncInterval,
 		Tor: &torConfig{
@@ -386,12 +482,99 @@ func loadConfig() (*config, error) {
 			RejectCacheSize:  channeldb.DefaultRejectCacheSize,
 			ChannelCacheSize: channeldb.DefaultChannelCacheSize,
 		},
+		Gossip: &lncfg.Gossip{
+			MsgBurstBytes: lnwire.MaxMessagePayload,
+		},
 		Prometheus: lncfg.DefaultPrometheus(),
 		Watchtower: &lncfg.Watchtower{
 			TowerDir: defaultTowerDir,
 		},
+		RemoteSigner: &lncfg.RemoteSigner{
+			Timeout: lncfg.DefaultRemoteSignerRPCTimeout,
+		},
+		PayoutPolicy: &lncfg.PayoutPolicy{
+			ValidatorTimeout: lncfg.DefaultPayoutValidatorTimeout,
+		},
+		ColdSweep: &lncfg.ColdSweep{
+			Interval:   lncfg.DefaultColdSweepInterval,
+			TargetConf: lncfg.DefaultColdSweepTargetConf,
+		},
+		LegacyPayload: &lncfg.LegacyPayload{},
+		FeeManager: &lncfg.FeeManager{
+			Strategy:     lncfg.DefaultFeeManagerStrategy,
+			Interval:     lncfg.DefaultFeeManagerInterval,
+			MinFeeRate:   lncfg.DefaultFeeManagerMinFeeRate,
+			MaxFeeRate:   lncfg.DefaultFeeManagerMaxFeeRate,
+			Step:         lncfg.DefaultFeeManagerStep,
+			TargetVolume: lncfg.DefaultFeeManagerTargetVolume,
+			MinChange:    lncfg.DefaultFeeManagerMinChange,
+		},
+		ConfTargets: &lncfg.ConfTargets{
+			Funding:   lncfg.DefaultFundingConfTarget,
+			CoopClose: lncfg.DefaultCoopCloseConfTarget,
+			Sweep:     lncfg.DefaultSweepConfTarget,
+			Anchor:    lncfg.DefaultAnchorConfTarget,
+			Justice:   lncfg.DefaultJusticeConfTarget,
+		},
+		FundingTimeout: &lncfg.FundingTimeout{
+			MaxBlocks: lncfg.DefaultFundingTimeoutMaxBlocks,
+		},
+		FailureDelay: &lncfg.FailureDelay{},
+		HtlcExposure: &lncfg.HtlcExposure{},
+		DBEncryption: &lncfg.DBEncryption{},
+		DB: &lncfg.DB{
+			AutoCompactMinFreeRatio: channeldb.DefaultAutoCompactMinFreeRatio,
+		},
+		ChanRecovery:            &lncfg.ChanRecovery{},
 		MaxOutgoingCltvExpiry:   htlcswitch.DefaultMaxOutgoingCltvExpiry,
 		MaxChannelFeeAllocation: htlcswitch.DefaultMaxLinkFeeAllocation,
+		FeeEstimator:            "backend",
+		ChangeAddressType:       "p2pkh",
+		AddressGapLimit:         lnwallet.DefaultAddressGapLimit,
+		AcceptorTimeout:         defaultAcceptorTimeout,
+		RPCMiddlewareTimeout:    defaultRPCMiddlewareTimeout,
+		PeerInitTimeout:         defaultInitTimeout,
+		ShutdownDrainTimeout:    defaultShutdownDrainTimeout,
+		HealthChecks: &lncfg.HealthCheckConfig{
+			ChainCheck: &lncfg.CheckConfig{
+				Interval: lncfg.DefaultChainCheckInterval,
+				Attempts: lncfg.DefaultChainCheckAttempts,
+				Timeout:  lncfg.DefaultChainCheckTimeout,
+				Backoff:  lncfg.DefaultChainCheckBackoff,
+				Action:   lncfg.HealthCheckActionShutdown,
+			},
+			DiskCheck: &lncfg.DiskCheckConfig{
+				RequiredRemaining: lncfg.DefaultDiskRequired,
+				CheckConfig: &lncfg.CheckConfig{
+					Interval: lncfg.DefaultDiskCheckInterval,
+					Attempts: 0,
+					Timeout:  lncfg.DefaultDiskCheckTimeout,
+					Backoff:  lncfg.DefaultDiskCheckBackoff,
+					Action:   lncfg.HealthCheckActionShutdown,
+				},
+			},
+			WalletSyncCheck: &lncfg.CheckConfig{
+				Interval: lncfg.DefaultWalletSyncCheckInterval,
+				Attempts: lncfg.DefaultWalletSyncCheckAttempts,
+				Timeout:  lncfg.DefaultWalletSyncCheckTimeout,
+				Backoff:  lncfg.DefaultWalletSyncCheckBackoff,
+				Action:   lncfg.HealthCheckActionLog,
+			},
+			TorCheck: &lncfg.CheckConfig{
+				Interval: lncfg.DefaultTorCheckInterval,
+				Attempts: lncfg.DefaultTorCheckAttempts,
+				Timeout:  lncfg.DefaultTorCheckTimeout,
+				Backoff:  lncfg.DefaultTorCheckBackoff,
+				Action:   lncfg.HealthCheckActionLog,
+			},
+			RemoteWalletCheck: &lncfg.CheckConfig{
+				Interval: lncfg.DefaultRemoteWalletCheckInterval,
+				Attempts: lncfg.DefaultRemoteWalletCheckAttempts,
+				Timeout:  lncfg.DefaultRemoteWalletCheckTimeout,
+				Backoff:  lncfg.DefaultRemoteWalletCheckBackoff,
+				Action:   lncfg.HealthCheckActionLog,
+			},
+		},
 	}
 
 	// Pre-parse the command line options to pick up an alternative config
@@ -495,6 +678,13 @@ func loadConfig() (*config, error) {
 	cfg.Tor.PrivateKeyPath = cleanAndExpandPath(cfg.Tor.PrivateKeyPath)
 	cfg.Watchtower.TowerDir = cleanAndExpandPath(cfg.Watchtower.TowerDir)
 	cfg.Dcrwallet.CertPath = cleanAndExpandPath(cfg.Dcrwallet.CertPath)
+	cfg.RemoteSigner.MacaroonPath = cleanAndExpandPath(
+		cfg.RemoteSigner.MacaroonPath,
+	)
+	cfg.RemoteSigner.TLSCertPath = cleanAndExpandPath(
+		cfg.RemoteSigner.TLSCertPath,
+	)
+	cfg.DBEncryption.KeyFile = cleanAndExpandPath(cfg.DBEncryption.KeyFile)
 
 	// Ensure that the user didn't attempt to specify negative values for
 	// any of the autopilot params.
@@ -555,6 +745,79 @@ func loadConfig() (*config, error) {
 			cfg.MaxChannelFeeAllocation)
 	}
 
+	// Ensure the ChannelAcceptor clients are given some time to respond.
+	if cfg.AcceptorTimeout <= 0 {
+		return nil, fmt.Errorf("invalid acceptor timeout: %v, must be "+
+			"positive", cfg.AcceptorTimeout)
+	}
+
+	// Ensure the RPC middlewares are given some time to respond.
+	if cfg.RPCMiddlewareTimeout <= 0 {
+		return nil, fmt.Errorf("invalid RPC middleware timeout: %v, "+
+			"must be positive", cfg.RPCMiddlewareTimeout)
+	}
+
+	// Ensure the max channel size is sane, channels above the default
+	// funding limit can only be opened once wumbo channels are enabled.
+	if cfg.MaxChanSize < cfg.MinChanSize {
+		return nil, fmt.Errorf("invalid max channel size: %v, must "+
+			"not be below the min channel size of %v",
+			cfg.MaxChanSize, cfg.MinChanSize)
+	}
+	if cfg.MaxChanSize > int64(MaxFundingAmount) && !cfg.WumboChannels {
+		return nil, fmt.Errorf("max channel size %v is above the "+
+			"funding limit of %v, wumbochannels must be set",
+			cfg.MaxChanSize, int64(MaxFundingAmount))
+	}
+	if len(cfg.WumboPeers) > 0 && !cfg.WumboChannels {
+		return nil, fmt.Errorf("wumbopeer requires wumbochannels to " +
+			"be set")
+	}
+	if len(cfg.ZeroConfPeers) > 0 && !cfg.ZeroConf {
+		return nil, fmt.Errorf("zeroconfpeer requires zeroconf to be " +
+			"set")
+	}
+
+	// Ensure peers are given some time to send their init message.
+	if cfg.PeerInitTimeout <= 0 {
+		return nil, fmt.Errorf("invalid peer init timeout: %v, must "+
+			"be positive", cfg.PeerInitTimeout)
+	}
+
+	if cfg.ShutdownDrainTimeout < 0 {
+		return nil, fmt.Errorf("invalid shutdown drain timeout: %v, "+
+			"must not be negative", cfg.ShutdownDrainTimeout)
+	}
+
+	// Decred wallets only support p2pkh change outputs for now, but the
+	// option is validated so other script types can be added later on.
+	switch cfg.ChangeAddressType {
+	case "p2pkh":
+		cfg.changeAddrType = lnwallet.PubKeyHash
+	default:
+		return nil, fmt.Errorf("invalid change address type %q, must "+
+			"be p2pkh", cfg.ChangeAddressType)
+	}
+	if cfg.FeeEstimator == "webapi" {
+		feeURL, err := url.Parse(cfg.FeeURL)
+		if err != nil || feeURL.Scheme != "https" || feeURL.Host == "" {
+			return nil, fmt.Errorf("feeurl must be a valid https " +
+				"url when the webapi fee estimator is used")
+		}
+	}
+	if cfg.NoAddressReuse && cfg.AddressGapLimit == 0 {
+		return nil, fmt.Errorf("addressgaplimit must be positive when " +
+			"noaddressreuse is set")
+	}
+	if cfg.KeySendHold && !cfg.AcceptKeySend {
+		return nil, fmt.Errorf("keysend-hold requires accept-keysend " +
+			"to be set")
+	}
+	if cfg.CanceledInvoiceRetention < 0 {
+		return nil, fmt.Errorf("canceled-invoice-retention must not " +
+			"be negative")
+	}
+
 	// Validate the Tor config parameters.
 	socks, err := lncfg.ParseAddressString(
 		cfg.Tor.SOCKS, strconv.Itoa(defaultTorSOCKSPort),
@@ -591,6 +854,9 @@ func loadConfig() (*config, error) {
 	case cfg.Tor.V2 && cfg.Tor.V3:
 		return nil, errors.New("either tor.v2 or tor.v3 can be set, " +
 			"but not both")
+	case cfg.Tor.WalletKey && !cfg.Tor.V3:
+		return nil, errors.New("tor.walletkey requires tor.v3 to be " +
+			"set")
 	case cfg.DisableListen && (cfg.Tor.V2 || cfg.Tor.V3):
 		return nil, errors.New("listening must be enabled when " +
 			"enabling inbound connections over Tor")
@@ -681,9 +947,18 @@ func loadConfig() (*config, error) {
 			return nil, err
 		}
 
+	case "spv":
+		// The spv backend syncs the embedded wallet, so it can't be
+		// used along with a remote one.
+		if cfg.Dcrwallet.GRPCHost != "" {
+			str := "%s: spv mode can't be used with a remote " +
+				"dcrwallet"
+			return nil, fmt.Errorf(str, funcName)
+		}
+
 	default:
-		str := "%s: only dcrd mode supported for Decred at " +
-			"this time"
+		str := "%s: only dcrd and spv modes supported for Decred " +
+			"at this time"
 		return nil, fmt.Errorf(str, funcName)
 	}
 
@@ -731,10 +1006,18 @@ func loadConfig() (*config, error) {
 		cfg.Autopilot.MaxChannelSize = int64(MaxFundingAmount)
 	}
 
-	// Validate profile port number.
+	// Validate profile port number. The profiling server listens on all
+	// interfaces unless an interface is specified along with the port.
 	if cfg.Profile != "" {
-		profilePort, err := strconv.Atoi(cfg.Profile)
-		if err != nil || profilePort < 1024 || profilePort > 65535 {
+		profilePort := cfg.Profile
+		if _, port, err := net.SplitHostPort(cfg.Profile); err == nil {
+			profilePort = port
+		} else {
+			cfg.Profile = net.JoinHostPort("", cfg.Profile)
+		}
+
+		port, err := strconv.Atoi(profilePort)
+		if err != nil || port < 1024 || port > 65535 {
 			str := "%s: The profile port must be between 1024 and 65535"
 			err := fmt.Errorf(str, funcName)
 			fmt.Fprintln(os.Stderr, err)
@@ -804,6 +1087,17 @@ func loadConfig() (*config, error) {
 		return nil, err
 	}
 
+	// Logging the secrets carried by wire messages would allow anyone with
+	// access to the logs to steal funds, so it's only allowed on the test
+	// networks with no value.
+	if cfg.UnsafeLogging && !cfg.Decred.RegTest && !cfg.Decred.SimNet {
+		str := "%s: unsafelogging is only allowed on regtest and " +
+			"simnet"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+
 	// At least one RPCListener is required. So listen on localhost per
 	// default.
 	if len(cfg.RawRPCListeners) == 0 {
@@ -918,16 +1212,55 @@ func loadConfig() (*config, error) {
 		return nil, fmt.Errorf("maxbackoff must be greater than minbackoff")
 	}
 
-	// Validate the subconfigs for workers, caches, and the tower client.
+	// Validate the subconfigs for workers, caches, gossip, the tower
+	// client, the remote signer, the payout policy, the cold storage
+	// sweeps, the deprecation of legacy onion payloads, the fee manager,
+	// the delay before failing back HTLCs, the database encryption and
+	// the health checks.
 	err = lncfg.Validate(
 		cfg.Workers,
 		cfg.Caches,
+		cfg.Gossip,
 		cfg.WtClient,
+		cfg.RemoteSigner,
+		cfg.PayoutPolicy,
+		cfg.ColdSweep,
+		cfg.LegacyPayload,
+		cfg.FeeManager,
+		cfg.ConfTargets,
+		cfg.FundingTimeout,
+		cfg.FailureDelay,
+		cfg.HtlcExposure,
+		cfg.DBEncryption,
+		cfg.DB,
+		cfg.ChanRecovery,
+		cfg.HealthChecks,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	// Encrypting the database with the default wallet password wouldn't
+	// protect it, so a key file is required when no password is set.
+	isRemoteWallet := cfg.Dcrwallet.GRPCHost != "" &&
+		cfg.Dcrwallet.CertPath != ""
+	if cfg.DBEncryption.Active && cfg.DBEncryption.KeyFile == "" &&
+		cfg.NoSeedBackup && !isRemoteWallet {
+
+		return nil, fmt.Errorf("dbencryption.active requires " +
+			"dbencryption.keyfile when using noseedbackup")
+	}
+
+	// A node using a remote signer doesn't hold any private key, so it
+	// can only be backed by a remote, watch-only, wallet.
+	if cfg.RemoteSigner.Enable && (cfg.Dcrwallet.GRPCHost == "" ||
+		cfg.Dcrwallet.CertPath == "") {
+
+		return nil, fmt.Errorf("the remote signer can only be used " +
+			"with a remote dcrwallet, set dcrwallet.grpchost and " +
+			"dcrwallet.certpath")
+	}
+
 	// Finally, ensure that the user's color is correctly formatted,
 	// otherwise the server will not be able to start after the unlocking
 	// the wallet.
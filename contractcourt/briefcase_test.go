@@ -188,6 +188,10 @@ func assertResolversEqual(t *testing.T, originalResolver ContractResolver,
 			t.Fatalf("expected %v, got %v", ogRes.payHash,
 				diskRes.payHash)
 		}
+		if ogRes.claimDeadline != diskRes.claimDeadline {
+			t.Fatalf("expected %v, got %v", ogRes.claimDeadline,
+				diskRes.claimDeadline)
+		}
 	}
 
 	switch ogRes := originalResolver.(type) {
@@ -280,6 +284,7 @@ func TestContractInsertionRetrieval(t *testing.T) {
 		broadcastHeight:  109,
 		payHash:          testPreimage,
 		sweepTx:          nil,
+		claimDeadline:    150,
 	}
 	resolvers := []ContractResolver{
 		&timeoutResolver,
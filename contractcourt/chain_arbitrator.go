@@ -11,6 +11,8 @@ import (
 	"github.com/decred/dcrd/wire"
 	"github.com/decred/dcrlnd/chainntnfs"
 	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/channelnotifier"
+	"github.com/decred/dcrlnd/clock"
 	"github.com/decred/dcrlnd/input"
 	"github.com/decred/dcrlnd/lnwallet"
 	"github.com/decred/dcrlnd/lnwire"
@@ -101,6 +103,12 @@ type ChainArbitratorConfig struct {
 	// the channel as pending close in the database.
 	ContractBreach func(wire.OutPoint, *lnwallet.BreachRetribution) error
 
+	// PendingBreach, if non-nil, is a function closure that the
+	// ChainArbitrator will use to notify the breachArbiter of a contract
+	// breach detected in the mempool, before it's confirmed. The breach is
+	// still notified through ContractBreach once confirmed.
+	PendingBreach func(wire.OutPoint, *wire.MsgTx)
+
 	// IsOurAddress is a function that returns true if the passed address
 	// is known to the underlying wallet. Otherwise, false should be
 	// returned.
@@ -125,6 +133,10 @@ type ChainArbitratorConfig struct {
 	// certain on-chain events.
 	Notifier chainntnfs.ChainNotifier
 
+	// MempoolSpends, if non-nil, is used to detect the breaches of our
+	// channels in the mempool, before they're confirmed.
+	MempoolSpends chainntnfs.MempoolSpendNotifier
+
 	// Signer is a signer backed by the active lnd node. This should be
 	// capable of producing a signature as specified by a valid
 	// SignDescriptor.
@@ -150,6 +162,35 @@ type ChainArbitratorConfig struct {
 	// NotifyClosedChannel is a function closure that the ChainArbitrator
 	// will use to notify the ChannelNotifier about a newly closed channel.
 	NotifyClosedChannel func(wire.OutPoint)
+
+	// NotifyRemoteCommitment, if non-nil, is a function closure that the
+	// ChainArbitrator will use to notify the ChannelNotifier about a
+	// commitment of the remote party detected in the mempool or confirmed.
+	NotifyRemoteCommitment func(channelnotifier.RemoteCommitmentEvent)
+
+	// MaxConcurrentClaims is the maximum number of HTLC outputs the
+	// resolvers of all channels claim on-chain at once. A value of zero
+	// disables the limit.
+	MaxConcurrentClaims int
+
+	// SweepConfTarget is the confirmation target used to sweep the
+	// outputs of closed channels back into the wallet. If zero, a target
+	// of 6 blocks is used.
+	SweepConfTarget uint32
+
+	// AnchorConfTarget is the confirmation target used to sweep the
+	// anchors of force closed commitments, unless the force close was
+	// requested with a specific fee preference. If zero, a target of 144
+	// blocks is used.
+	AnchorConfTarget uint32
+
+	// Clock is the time source used to time the retries of the chain
+	// watchers. If nil, the system time is used.
+	Clock clock.Clock
+
+	// claims schedules the on-chain claims of the resolvers within the
+	// MaxConcurrentClaims budget. It's set by NewChainArbitrator.
+	claims *claimScheduler
 }
 
 // ChainArbitrator is a sub-system that oversees the on-chain resolution of all
@@ -193,6 +234,8 @@ type ChainArbitrator struct {
 func NewChainArbitrator(cfg ChainArbitratorConfig,
 	db *channeldb.DB) *ChainArbitrator {
 
+	cfg.claims = newClaimScheduler(cfg.MaxConcurrentClaims, cfg.ChainIO)
+
 	return &ChainArbitrator{
 		cfg:            cfg,
 		activeChannels: make(map[wire.OutPoint]*ChannelArbitrator),
@@ -265,7 +308,18 @@ func newActiveChannelArbitrator(channel *channeldb.OpenChannel,
 			}
 			return chanMachine.ForceClose()
 		},
-		MarkCommitmentBroadcasted: channel.MarkCommitmentBroadcasted,
+		MarkCommitmentBroadcasted: func(tx *wire.MsgTx) error {
+			// The commitment is only broadcast by the arbitrator
+			// when we force close the channel ourselves.
+			err := channel.ApplyChanStatus(
+				channeldb.ChanStatusLocalCloseInitiator,
+			)
+			if err != nil {
+				return err
+			}
+
+			return channel.MarkCommitmentBroadcasted(tx)
+		},
 		MarkChannelClosed: func(summary *channeldb.ChannelCloseSummary) error {
 			if err := channel.CloseChannel(summary); err != nil {
 				return err
@@ -276,6 +330,9 @@ func newActiveChannelArbitrator(channel *channeldb.OpenChannel,
 		IsPendingClose:        false,
 		ChainArbitratorConfig: c.cfg,
 		ChainEvents:           chanEvents,
+		FetchHistoricalChannel: func() (*channeldb.OpenChannel, error) {
+			return c.chanSource.FetchHistoricalChannel(&chanPoint)
+		},
 	}
 
 	// The final component needed is an arbitrator log that the arbitrator
@@ -359,6 +416,51 @@ func (c *ChainArbitrator) resolveContract(chanPoint wire.OutPoint,
 	return nil
 }
 
+// ResolveContract stops watching the passed channel and wipes the persistent
+// state of its arbitrator, without taking any on-chain action. It's used when
+// a channel is abandoned, in which case the channel itself must already have
+// been removed from the channel source.
+func (c *ChainArbitrator) ResolveContract(chanPoint wire.OutPoint) error {
+	c.Lock()
+	arbitrator, ok := c.activeChannels[chanPoint]
+	delete(c.activeChannels, chanPoint)
+
+	chainWatcher, watcherOk := c.activeWatchers[chanPoint]
+	delete(c.activeWatchers, chanPoint)
+	c.Unlock()
+
+	log.Infof("Resolving ChannelPoint(%v) without on-chain action",
+		chanPoint)
+
+	if watcherOk {
+		if err := chainWatcher.Stop(); err != nil {
+			return err
+		}
+	}
+
+	if !ok {
+		return nil
+	}
+
+	if err := arbitrator.Stop(); err != nil {
+		return err
+	}
+
+	return arbitrator.log.WipeHistory()
+}
+
+// pendingBreach notifies the breachArbiter, if requested, of a breach of the
+// channel detected in the mempool.
+func (c *ChainArbitrator) pendingBreach(chanPoint wire.OutPoint,
+	breachTx *wire.MsgTx) {
+
+	if c.cfg.PendingBreach == nil {
+		return
+	}
+
+	c.cfg.PendingBreach(chanPoint, breachTx)
+}
+
 // Start launches all goroutines that the ChainArbitrator needs to operate.
 func (c *ChainArbitrator) Start() error {
 	if !atomic.CompareAndSwapInt32(&c.started, 0, 1) {
@@ -397,6 +499,12 @@ func (c *ChainArbitrator) Start() error {
 					return c.cfg.ContractBreach(chanPoint, retInfo)
 				},
 				extractStateNumHint: lnwallet.GetStateNumHint,
+				mempoolSpends:       c.cfg.MempoolSpends,
+				pendingBreach: func(breachTx *wire.MsgTx) {
+					c.pendingBreach(chanPoint, breachTx)
+				},
+				notifyRemoteCommit: c.cfg.NotifyRemoteCommitment,
+				clock:              c.cfg.Clock,
 			},
 		)
 		if err != nil {
@@ -479,6 +587,9 @@ func (c *ChainArbitrator) Start() error {
 			IsPendingClose:        true,
 			ClosingHeight:         closeChanInfo.CloseHeight,
 			CloseType:             closeChanInfo.CloseType,
+			FetchHistoricalChannel: func() (*channeldb.OpenChannel, error) {
+				return c.chanSource.FetchHistoricalChannel(&chanPoint)
+			},
 		}
 		chanLog, err := newBoltArbitratorLog(
 			c.chanSource.DB, arbCfg, c.cfg.ChainHash, chanPoint,
@@ -681,15 +792,24 @@ type forceCloseReq struct {
 	// closeTx is a channel that carries the transaction which ultimately
 	// closed out the channel.
 	closeTx chan *wire.MsgTx
+
+	// anchorFeePref is the fee preference used to sweep the anchor of the
+	// broadcast commitment, if any. If no fee rate or confirmation target
+	// is set, the default anchor sweep confirmation target is used.
+	anchorFeePref sweep.FeePreference
 }
 
 // ForceCloseContract attempts to force close the channel infield by the passed
 // channel point. A force close will immediately terminate the contract,
 // causing it to enter the resolution phase. If the force close was successful,
-// then the force close transaction itself will be returned.
+// then the force close transaction itself will be returned. The passed fee
+// preference is used to sweep the anchor of the commitment, which allows its
+// fee to be bumped through CPFP.
 //
 // TODO(roasbeef): just return the summary itself?
-func (c *ChainArbitrator) ForceCloseContract(chanPoint wire.OutPoint) (*wire.MsgTx, error) {
+func (c *ChainArbitrator) ForceCloseContract(chanPoint wire.OutPoint,
+	anchorFeePref sweep.FeePreference) (*wire.MsgTx, error) {
+
 	c.Lock()
 	arbitrator, ok := c.activeChannels[chanPoint]
 	c.Unlock()
@@ -714,8 +834,9 @@ func (c *ChainArbitrator) ForceCloseContract(chanPoint wire.OutPoint) (*wire.Msg
 	// force close request to the arbitrator that watches this channel.
 	select {
 	case arbitrator.forceCloseReqs <- &forceCloseReq{
-		errResp: errChan,
-		closeTx: respChan,
+		errResp:       errChan,
+		closeTx:       respChan,
+		anchorFeePref: anchorFeePref,
 	}:
 	case <-c.quit:
 		return nil, ErrChainArbExiting
@@ -772,6 +893,12 @@ func (c *ChainArbitrator) WatchNewChannel(newChan *channeldb.OpenChannel) error
 				return c.cfg.ContractBreach(chanPoint, retInfo)
 			},
 			extractStateNumHint: lnwallet.GetStateNumHint,
+			mempoolSpends:       c.cfg.MempoolSpends,
+			pendingBreach: func(breachTx *wire.MsgTx) {
+				c.pendingBreach(chanPoint, breachTx)
+			},
+			notifyRemoteCommit: c.cfg.NotifyRemoteCommitment,
+			clock:              c.cfg.Clock,
 		},
 	)
 	if err != nil {
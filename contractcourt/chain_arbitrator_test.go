@@ -115,3 +115,73 @@ func TestChainArbitratorRepublishCommitment(t *testing.T) {
 		t.Fatalf("unexpected tx published")
 	}
 }
+
+// TestChainArbitratorResolveContract asserts that resolving a contract stops
+// the arbitration of the channel without publishing any transaction.
+func TestChainArbitratorResolveContract(t *testing.T) {
+	t.Parallel()
+
+	tempPath, err := ioutil.TempDir("", "testdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempPath)
+
+	db, err := channeldb.Open(tempPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	lChannel, _, cleanup, err := lnwallet.CreateTestChannels(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+
+	channel := lChannel.State()
+	channel.Db = db
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18556,
+	}
+	if err := channel.SyncPending(addr, 101); err != nil {
+		t.Fatal(err)
+	}
+
+	chainArbCfg := ChainArbitratorConfig{
+		ChainIO:  &mockChainIO{},
+		Notifier: &mockNotifier{},
+		PublishTx: func(tx *wire.MsgTx) error {
+			t.Fatalf("unexpected tx published")
+			return nil
+		},
+	}
+	chainArb := NewChainArbitrator(chainArbCfg, db)
+	if err := chainArb.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := chainArb.Stop(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	chanPoint := channel.FundingOutpoint
+	if _, err := chainArb.GetChannelArbitrator(chanPoint); err != nil {
+		t.Fatalf("channel not arbitrated: %v", err)
+	}
+
+	if err := chainArb.ResolveContract(chanPoint); err != nil {
+		t.Fatalf("unable to resolve contract: %v", err)
+	}
+	if _, err := chainArb.GetChannelArbitrator(chanPoint); err == nil {
+		t.Fatalf("channel still arbitrated")
+	}
+
+	// Resolving the contract again is a no-op.
+	if err := chainArb.ResolveContract(chanPoint); err != nil {
+		t.Fatalf("unable to resolve contract again: %v", err)
+	}
+}
@@ -15,6 +15,8 @@ import (
 	"github.com/decred/dcrd/wire"
 	"github.com/decred/dcrlnd/chainntnfs"
 	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/channelnotifier"
+	"github.com/decred/dcrlnd/clock"
 	"github.com/decred/dcrlnd/input"
 	"github.com/decred/dcrlnd/lnwallet"
 	"github.com/decred/dcrlnd/shachain"
@@ -164,6 +166,24 @@ type chainWatcherConfig struct {
 	// obfuscater. This is used by the chain watcher to identify which
 	// state was broadcast and confirmed on-chain.
 	extractStateNumHint func(*wire.MsgTx, [lnwallet.StateHintSize]byte) uint64
+
+	// mempoolSpends, if non-nil, is used to be notified of a spend of the
+	// funding output by a transaction accepted to the mempool of the
+	// backend, before it's confirmed.
+	mempoolSpends chainntnfs.MempoolSpendNotifier
+
+	// pendingBreach, if non-nil, is called once a revoked commitment of
+	// the remote party is detected in the mempool. The breach is still to
+	// be handled through contractBreach once confirmed.
+	pendingBreach func(breachTx *wire.MsgTx)
+
+	// notifyRemoteCommit, if non-nil, is called once a commitment of the
+	// remote party is detected, either in the mempool or confirmed.
+	notifyRemoteCommit func(channelnotifier.RemoteCommitmentEvent)
+
+	// clock is the time source used to time the polling for the commit
+	// point of channels with lost state. If nil, the system time is used.
+	clock clock.Clock
 }
 
 // chainWatcher is a system that's assigned to every active channel. The duty
@@ -200,6 +220,10 @@ type chainWatcher struct {
 // the chan point to watch, and also a notifier instance that will allow us to
 // detect on chain events.
 func newChainWatcher(cfg chainWatcherConfig) (*chainWatcher, error) {
+	if cfg.clock == nil {
+		cfg.clock = clock.NewDefaultClock()
+	}
+
 	// In order to be able to detect the nature of a potential channel
 	// closure we'll need to reconstruct the state hint bytes used to
 	// obfuscate the commitment state number encoded in the lock time and
@@ -274,6 +298,23 @@ func (c *chainWatcher) Start() error {
 	c.wg.Add(1)
 	go c.closeObserver(spendNtfn)
 
+	// If enabled, we'll also watch the mempool for a spend of the funding
+	// output, in order to react to a breach before it's confirmed.
+	if c.cfg.mempoolSpends == nil {
+		return nil
+	}
+	mempoolNtfn, err := c.cfg.mempoolSpends.RegisterMempoolSpendNtfn(
+		fundingOut,
+	)
+	if err != nil {
+		log.Warnf("Unable to watch the mempool for spends of "+
+			"ChannelPoint(%v): %v", chanState.FundingOutpoint, err)
+		return nil
+	}
+
+	c.wg.Add(1)
+	go c.mempoolObserver(mempoolNtfn)
+
 	return nil
 }
 
@@ -330,7 +371,8 @@ func (c *chainWatcher) SubscribeChannelEvents() *ChainEventSubscription {
 // based off of only the set of outputs included.
 func isOurCommitment(localChanCfg, remoteChanCfg channeldb.ChannelConfig,
 	commitSpend *chainntnfs.SpendDetail, broadcastStateNum uint64,
-	revocationProducer shachain.Producer, tweakless bool) (bool, error) {
+	revocationProducer shachain.Producer,
+	chanType channeldb.ChannelType) (bool, error) {
 
 	// First, we'll re-derive our commitment point for this state since
 	// this is what we use to randomize each of the keys for this state.
@@ -344,13 +386,14 @@ func isOurCommitment(localChanCfg, remoteChanCfg channeldb.ChannelConfig,
 	// and remote keys for this state. We use our point as only we can
 	// revoke our own commitment.
 	commitKeyRing := lnwallet.DeriveCommitmentKeys(
-		commitPoint, true, tweakless, &localChanCfg, &remoteChanCfg,
+		commitPoint, true, chanType.IsTweakless(), &localChanCfg,
+		&remoteChanCfg,
 	)
 
 	// With the keys derived, we'll construct the remote script that'll be
 	// present if they have a non-dust balance on the commitment.
-	remotePkScript, err := input.CommitScriptUnencumbered(
-		commitKeyRing.NoDelayKey,
+	_, remotePkScript, _, err := lnwallet.CommitScriptToRemote(
+		chanType, commitKeyRing.NoDelayKey,
 	)
 	if err != nil {
 		return false, err
@@ -391,6 +434,149 @@ func isOurCommitment(localChanCfg, remoteChanCfg channeldb.ChannelConfig,
 	return false, nil
 }
 
+// mempoolObserver is a goroutine that waits for a spend of the funding output
+// by a transaction accepted to the mempool, in order to detect a breach by the
+// remote party before it's confirmed.
+func (c *chainWatcher) mempoolObserver(spendNtfn *chainntnfs.SpendEvent) {
+	defer c.wg.Done()
+	defer spendNtfn.Cancel()
+
+	select {
+	case spend, ok := <-spendNtfn.Spend:
+		// If the channel was closed, then this means that the notifier
+		// exited, so we will as well.
+		if !ok {
+			return
+		}
+
+		if err := c.handleMempoolSpend(spend); err != nil {
+			log.Errorf("Unable to handle mempool spend of "+
+				"ChannelPoint(%v): %v",
+				c.cfg.chanState.FundingOutpoint, err)
+		}
+
+	case <-c.quit:
+	}
+}
+
+// handleMempoolSpend examines a spend of the funding output by a transaction
+// in the mempool, notifying subscribers if it is a commitment of the remote
+// party and calling pendingBreach if that commitment is revoked.
+func (c *chainWatcher) handleMempoolSpend(spend *chainntnfs.SpendDetail) error {
+	chanPoint := c.cfg.chanState.FundingOutpoint
+	spendingTx := spend.SpendingTx
+
+	// A cooperative close is characterized by a finalized input sequence.
+	if spendingTx.TxIn[0].Sequence == wire.MaxTxInSequenceNum {
+		log.Infof("Cooperative close of ChannelPoint(%v) detected in "+
+			"the mempool: %v", chanPoint, spend.SpenderTxHash)
+		return nil
+	}
+
+	// The close observer may be updating the snapshot of the channel
+	// state concurrently, so we fetch our own copy of its latest state.
+	chanState, err := c.cfg.chanState.Db.FetchChannel(chanPoint)
+	if err != nil {
+		return err
+	}
+
+	broadcastStateNum := c.cfg.extractStateNumHint(
+		spendingTx, c.stateHintObfuscator,
+	)
+	isOurCommit, err := isOurCommitment(
+		chanState.LocalChanCfg, chanState.RemoteChanCfg, spend,
+		broadcastStateNum, chanState.RevocationProducer,
+		chanState.ChanType,
+	)
+	if err != nil {
+		return err
+	}
+	if isOurCommit {
+		log.Infof("Local commitment of ChannelPoint(%v) detected in "+
+			"the mempool: %v", chanPoint, spend.SpenderTxHash)
+		return nil
+	}
+
+	remoteChainTip, err := chanState.RemoteCommitChainTip()
+	if err != nil && err != channeldb.ErrNoPendingCommit {
+		return err
+	}
+
+	// Subscribers are notified of any commitment of the remote party,
+	// though only a revoked one is handled before it confirms. A restored
+	// channel doesn't know the current state of the remote party, so it
+	// can't tell a breach apart.
+	commitType := remoteCommitType(
+		broadcastStateNum, chanState.RemoteCommitment.CommitHeight,
+		remoteChainTip != nil,
+		chanState.HasChanStatus(channeldb.ChanStatusRestored),
+	)
+	c.notifyRemoteCommit(spend, broadcastStateNum, commitType, false)
+
+	if commitType != channelnotifier.RemoteCommitRevoked {
+		log.Infof("Remote commitment of ChannelPoint(%v) for state "+
+			"#%v detected in the mempool: %v", chanPoint,
+			broadcastStateNum, spend.SpenderTxHash)
+		return nil
+	}
+
+	log.Warnf("Remote peer broadcast revoked state #%v of "+
+		"ChannelPoint(%v) to the mempool: %v", broadcastStateNum,
+		chanPoint, spend.SpenderTxHash)
+
+	if c.cfg.pendingBreach != nil {
+		c.cfg.pendingBreach(spendingTx)
+	}
+
+	return nil
+}
+
+// remoteCommitType classifies a commitment of the remote party for state
+// broadcastStateNum, given the state of their latest commitment and whether
+// they have a pending one.
+func remoteCommitType(broadcastStateNum, remoteStateNum uint64,
+	pendingCommit, isRecoveredChan bool) channelnotifier.RemoteCommitType {
+
+	switch {
+	// A restored channel doesn't know the current state of the remote
+	// party.
+	case isRecoveredChan:
+		return channelnotifier.RemoteCommitUnknown
+
+	case broadcastStateNum == remoteStateNum:
+		return channelnotifier.RemoteCommitLatest
+
+	case broadcastStateNum == remoteStateNum+1 && pendingCommit:
+		return channelnotifier.RemoteCommitPending
+
+	case broadcastStateNum > remoteStateNum:
+		return channelnotifier.RemoteCommitUnknown
+
+	default:
+		return channelnotifier.RemoteCommitRevoked
+	}
+}
+
+// notifyRemoteCommit dispatches the detection of a commitment of the remote
+// party, if a notifier was provided.
+func (c *chainWatcher) notifyRemoteCommit(spend *chainntnfs.SpendDetail,
+	stateNum uint64, commitType channelnotifier.RemoteCommitType,
+	confirmed bool) {
+
+	if c.cfg.notifyRemoteCommit == nil {
+		return
+	}
+
+	chanPoint := c.cfg.chanState.FundingOutpoint
+	c.cfg.notifyRemoteCommit(channelnotifier.RemoteCommitmentEvent{
+		ChannelPoint: &chanPoint,
+		CommitTxid:   *spend.SpenderTxHash,
+		StateNum:     stateNum,
+		CommitType:   commitType,
+		Confirmed:    confirmed,
+	})
+}
+
 // closeObserver is a dedicated goroutine that will watch for any closes of the
 // channel that it's watching on chain. In the event of an on-chain event, the
 // close observer will assembled the proper materials required to claim the
@@ -483,7 +669,7 @@ func (c *chainWatcher) closeObserver(spendNtfn *chainntnfs.SpendEvent) {
 			c.cfg.chanState.LocalChanCfg,
 			c.cfg.chanState.RemoteChanCfg, commitSpend,
 			broadcastStateNum, c.cfg.chanState.RevocationProducer,
-			tweaklessCommit,
+			c.cfg.chanState.ChanType,
 		)
 		if err != nil {
 			log.Errorf("unable to determine self commit for "+
@@ -498,6 +684,28 @@ func (c *chainWatcher) closeObserver(spendNtfn *chainntnfs.SpendEvent) {
 		if isOurCommit {
 			commitSet.ConfCommitKey = &LocalHtlcSet
 
+			// A restored channel doesn't know its local
+			// commitment, so we'll locate our time-locked output
+			// using the state number encoded within the
+			// broadcast commitment instead. Any HTLCs on it are
+			// unknown to us and can't be recovered.
+			if c.cfg.chanState.HasChanStatus(
+				channeldb.ChanStatusRestored,
+			) {
+
+				log.Infof("Local commitment of restored "+
+					"ChannelPoint(%v) for state #%v "+
+					"confirmed, recovering our delayed "+
+					"output",
+					c.cfg.chanState.FundingOutpoint,
+					broadcastStateNum)
+
+				localCommit = &channeldb.ChannelCommitment{
+					CommitHeight: broadcastStateNum,
+				}
+				commitSet.HtlcSets[LocalHtlcSet] = nil
+			}
+
 			if err := c.dispatchLocalForceClose(
 				commitSpend, *localCommit, commitSet,
 			); err != nil {
@@ -535,6 +743,15 @@ func (c *chainWatcher) closeObserver(spendNtfn *chainntnfs.SpendEvent) {
 			channeldb.ChanStatusRestored,
 		)
 
+		c.notifyRemoteCommit(
+			commitSpend, broadcastStateNum,
+			remoteCommitType(
+				broadcastStateNum, remoteStateNum,
+				remoteChainTip != nil, isRecoveredChan,
+			),
+			true,
+		)
+
 		switch {
 		// If state number spending transaction matches the current
 		// latest state, then they've initiated a unilateral close. So
@@ -656,13 +873,20 @@ func (c *chainWatcher) closeObserver(spendNtfn *chainntnfs.SpendEvent) {
 	}
 }
 
-// toSelfAmount takes a transaction and returns the sum of all outputs that pay
-// to a script that the wallet controls. If no outputs pay to us, then we
-// return zero. This is possible as our output may have been trimmed due to
-// being dust.
-func (c *chainWatcher) toSelfAmount(tx *wire.MsgTx) dcrutil.Amount {
-	var selfAmt dcrutil.Amount
-	for _, txOut := range tx.TxOut {
+// toSelfOutputs takes a transaction and returns all outputs that pay to a
+// script that the wallet controls, along with their sum. If no outputs pay to
+// us, then we return zero. This is possible as our output may have been
+// trimmed due to being dust.
+func (c *chainWatcher) toSelfOutputs(tx *wire.MsgTx) (dcrutil.Amount,
+	[]channeldb.CloseResolution) {
+
+	txHash := tx.TxHash()
+
+	var (
+		selfAmt     dcrutil.Amount
+		resolutions []channeldb.CloseResolution
+	)
+	for i, txOut := range tx.TxOut {
 		_, addrs, _, err := txscript.ExtractPkScriptAddrs(
 			// Doesn't matter what net we actually pass in.
 			//
@@ -674,13 +898,24 @@ func (c *chainWatcher) toSelfAmount(tx *wire.MsgTx) dcrutil.Amount {
 		}
 
 		for _, addr := range addrs {
-			if c.cfg.isOurAddr(addr) {
-				selfAmt += dcrutil.Amount(txOut.Value)
+			if !c.cfg.isOurAddr(addr) {
+				continue
+			}
+
+			res := channeldb.CloseResolution{
+				Type: channeldb.ResolutionTypeSettled,
+				OutPoint: wire.OutPoint{
+					Hash:  txHash,
+					Index: uint32(i),
+				},
+				Amount: dcrutil.Amount(txOut.Value),
 			}
+			resolutions = append(resolutions, res)
+			selfAmt += res.Amount
 		}
 	}
 
-	return selfAmt
+	return selfAmt, resolutions
 }
 
 // dispatchCooperativeClose processed a detect cooperative channel closure.
@@ -696,7 +931,7 @@ func (c *chainWatcher) dispatchCooperativeClose(commitSpend *chainntnfs.SpendDet
 
 	// If the input *is* final, then we'll check to see which output is
 	// ours.
-	localAmt := c.toSelfAmount(broadcastTx)
+	localAmt, resolutions := c.toSelfOutputs(broadcastTx)
 
 	// Once this is known, we'll mark the state as fully closed in the
 	// database. We can do this as a cooperatively closed channel has all
@@ -715,6 +950,7 @@ func (c *chainWatcher) dispatchCooperativeClose(commitSpend *chainntnfs.SpendDet
 		RemoteCurrentRevocation: c.cfg.chanState.RemoteCurrentRevocation,
 		RemoteNextRevocation:    c.cfg.chanState.RemoteNextRevocation,
 		LocalChanConfig:         c.cfg.chanState.LocalChanCfg,
+		Resolutions:             resolutions,
 	}
 
 	// Attempt to add a channel sync message to the close summary.
@@ -780,14 +1016,21 @@ func (c *chainWatcher) dispatchLocalForceClose(
 		RemoteCurrentRevocation: c.cfg.chanState.RemoteCurrentRevocation,
 		RemoteNextRevocation:    c.cfg.chanState.RemoteNextRevocation,
 		LocalChanConfig:         c.cfg.chanState.LocalChanCfg,
+		CloseInitiator:          channeldb.InitiatorLocal,
+		Resolutions: lnwallet.CommitCloseResolutions(
+			forceClose.CloseTx, forceClose.CommitResolution,
+			forceClose.HtlcResolutions,
+		),
 	}
 
 	// If our commitment output isn't dust or we have active HTLC's on the
 	// commitment transaction, then we'll populate the balances on the
 	// close channel summary.
 	if forceClose.CommitResolution != nil {
-		closeSummary.SettledBalance = chanSnapshot.LocalBalance.ToAtoms()
-		closeSummary.TimeLockedBalance = chanSnapshot.LocalBalance.ToAtoms()
+		selfSignDesc := forceClose.CommitResolution.SelfOutputSignDesc
+		selfAmt := dcrutil.Amount(selfSignDesc.Output.Value)
+		closeSummary.SettledBalance = selfAmt
+		closeSummary.TimeLockedBalance = selfAmt
 	}
 	for _, htlc := range forceClose.HtlcResolutions.OutgoingHTLCs {
 		htlcValue := dcrutil.Amount(htlc.SweepSignDesc.Output.Value)
@@ -976,6 +1219,8 @@ func (c *chainWatcher) dispatchContractBreach(spendEvent *chainntnfs.SpendDetail
 		RemoteCurrentRevocation: c.cfg.chanState.RemoteCurrentRevocation,
 		RemoteNextRevocation:    c.cfg.chanState.RemoteNextRevocation,
 		LocalChanConfig:         c.cfg.chanState.LocalChanCfg,
+		CloseInitiator:          channeldb.InitiatorRemote,
+		Resolutions:             retribution.CloseResolutions(),
 	}
 
 	// Attempt to add a channel sync message to the close summary.
@@ -1021,7 +1266,7 @@ func (c *chainWatcher) waitForCommitmentPoint() *secp256k1.PublicKey {
 
 		select {
 		// Wait before retrying, with an exponential backoff.
-		case <-time.After(backoff):
+		case <-c.cfg.clock.TickAfter(backoff):
 			backoff = 2 * backoff
 			if backoff > maxCommitPointPollTimeout {
 				backoff = maxCommitPointPollTimeout
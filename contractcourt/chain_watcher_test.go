@@ -12,6 +12,7 @@ import (
 	"github.com/decred/dcrd/wire"
 	"github.com/decred/dcrlnd/chainntnfs"
 	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/channelnotifier"
 	"github.com/decred/dcrlnd/input"
 	"github.com/decred/dcrlnd/lnwallet"
 	"github.com/decred/dcrlnd/lnwire"
@@ -642,3 +643,253 @@ func TestChainWatcherLocalForceCloseDetect(t *testing.T) {
 		})
 	}
 }
+
+// TestChainWatcherRestoredLocalForceClose tests that we're able to recover our
+// time-locked output when one of our commitments confirms after the channel
+// has been restored from a static backup, which doesn't carry our local
+// commitment.
+func TestChainWatcherRestoredLocalForceClose(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, cleanUp, err := lnwallet.CreateTestChannels(
+		false,
+	)
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	// We'll advance the channel a few states, then save Alice's
+	// commitment before advancing it further, so the commitment that
+	// confirms isn't her latest one.
+	const htlcAmt = 1000
+	err = executeStateTransitions(t, htlcAmt, aliceChannel, bobChannel, 3)
+	if err != nil {
+		t.Fatalf("unable to trigger state transition: %v", err)
+	}
+	aliceCommit := aliceChannel.State().LocalCommitment.CommitTx.Copy()
+	err = executeStateTransitions(t, htlcAmt, aliceChannel, bobChannel, 2)
+	if err != nil {
+		t.Fatalf("unable to trigger state transition: %v", err)
+	}
+
+	aliceState := aliceChannel.State()
+	err = aliceState.ApplyChanStatus(channeldb.ChanStatusRestored)
+	if err != nil {
+		t.Fatalf("unable to mark channel restored: %v", err)
+	}
+
+	aliceNotifier := &mockNotifier{
+		spendChan: make(chan *chainntnfs.SpendDetail),
+	}
+	aliceChainWatcher, err := newChainWatcher(chainWatcherConfig{
+		chanState:           aliceState,
+		notifier:            aliceNotifier,
+		signer:              aliceChannel.Signer,
+		extractStateNumHint: lnwallet.GetStateNumHint,
+	})
+	if err != nil {
+		t.Fatalf("unable to create chain watcher: %v", err)
+	}
+	if err := aliceChainWatcher.Start(); err != nil {
+		t.Fatalf("unable to start chain watcher: %v", err)
+	}
+	defer aliceChainWatcher.Stop()
+
+	chanEvents := aliceChainWatcher.SubscribeChannelEvents()
+
+	aliceTxHash := aliceCommit.TxHash()
+	aliceNotifier.spendChan <- &chainntnfs.SpendDetail{
+		SpenderTxHash: &aliceTxHash,
+		SpendingTx:    aliceCommit,
+	}
+
+	var closeInfo *LocalUnilateralCloseInfo
+	select {
+	case closeInfo = <-chanEvents.LocalUnilateralClosure:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("didn't get local force close")
+	}
+
+	// Alice's delayed output should have been located, and must only be
+	// swept once its CSV delay has expired.
+	res := closeInfo.CommitResolution
+	if res == nil {
+		t.Fatalf("expected commit resolution for restored channel")
+	}
+	if res.SelfOutPoint.Hash != aliceTxHash {
+		t.Fatalf("expected output of %v, got %v", aliceTxHash,
+			res.SelfOutPoint.Hash)
+	}
+	selfOutput := aliceCommit.TxOut[res.SelfOutPoint.Index]
+	resOutput := res.SelfOutputSignDesc.Output
+	if !bytes.Equal(selfOutput.PkScript, resOutput.PkScript) {
+		t.Fatalf("resolution doesn't match the delayed output")
+	}
+	if resOutput.Value != selfOutput.Value {
+		t.Fatalf("expected value %v, got %v", selfOutput.Value,
+			resOutput.Value)
+	}
+	csvDelay := uint32(aliceState.LocalChanCfg.CsvDelay)
+	if res.MaturityDelay != csvDelay {
+		t.Fatalf("expected maturity delay %v, got %v", csvDelay,
+			res.MaturityDelay)
+	}
+	if closeInfo.ChannelCloseSummary.TimeLockedBalance == 0 {
+		t.Fatalf("expected time locked balance")
+	}
+}
+
+// mockMempoolNotifier is a mock MempoolSpendNotifier dispatching the spends
+// sent on its spendChan.
+type mockMempoolNotifier struct {
+	spendChan chan *chainntnfs.SpendDetail
+}
+
+func (m *mockMempoolNotifier) RegisterMempoolSpendNtfn(
+	outpoint *wire.OutPoint) (*chainntnfs.SpendEvent, error) {
+
+	return &chainntnfs.SpendEvent{
+		Spend:  m.spendChan,
+		Cancel: func() {},
+	}, nil
+}
+
+// TestChainWatcherMempoolBreach tests that the chain watcher detects the
+// broadcast of a revoked commitment of the remote party to the mempool, while
+// ignoring the broadcast of its current commitment. Subscribers are notified
+// of both.
+func TestChainWatcherMempoolBreach(t *testing.T) {
+	t.Parallel()
+
+	t.Run("revoked commitment", func(t *testing.T) {
+		testChainWatcherMempoolBreach(t, true)
+	})
+	t.Run("current commitment", func(t *testing.T) {
+		testChainWatcherMempoolBreach(t, false)
+	})
+}
+
+func testChainWatcherMempoolBreach(t *testing.T, breach bool) {
+	aliceChannel, bobChannel, cleanUp, err := lnwallet.CreateTestChannels(true)
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	// Bob's first commitment is revoked by a state transition.
+	bobCommit := bobChannel.State().LocalCommitment.CommitTx
+	err = executeStateTransitions(t, 1000, aliceChannel, bobChannel, 1)
+	if err != nil {
+		t.Fatalf("unable to execute state transitions: %v", err)
+	}
+	if !breach {
+		bobCommit = bobChannel.State().LocalCommitment.CommitTx
+	}
+
+	aliceNotifier := &mockNotifier{
+		spendChan: make(chan *chainntnfs.SpendDetail),
+	}
+	mempoolNotifier := &mockMempoolNotifier{
+		spendChan: make(chan *chainntnfs.SpendDetail),
+	}
+	breaches := make(chan *wire.MsgTx, 1)
+	remoteCommits := make(chan channelnotifier.RemoteCommitmentEvent, 1)
+	aliceChainWatcher, err := newChainWatcher(chainWatcherConfig{
+		chanState:           aliceChannel.State(),
+		notifier:            aliceNotifier,
+		signer:              aliceChannel.Signer,
+		extractStateNumHint: lnwallet.GetStateNumHint,
+		mempoolSpends:       mempoolNotifier,
+		pendingBreach: func(tx *wire.MsgTx) {
+			breaches <- tx
+		},
+		notifyRemoteCommit: func(
+			event channelnotifier.RemoteCommitmentEvent) {
+
+			remoteCommits <- event
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to create chain watcher: %v", err)
+	}
+	if err := aliceChainWatcher.Start(); err != nil {
+		t.Fatalf("unable to start chain watcher: %v", err)
+	}
+	defer aliceChainWatcher.Stop()
+
+	bobTxHash := bobCommit.TxHash()
+	mempoolNotifier.spendChan <- &chainntnfs.SpendDetail{
+		SpenderTxHash: &bobTxHash,
+		SpendingTx:    bobCommit,
+	}
+
+	expectedType := channelnotifier.RemoteCommitLatest
+	if breach {
+		expectedType = channelnotifier.RemoteCommitRevoked
+	}
+	select {
+	case event := <-remoteCommits:
+		if event.CommitTxid != bobTxHash {
+			t.Fatalf("expected commitment %v, got %v", bobTxHash,
+				event.CommitTxid)
+		}
+		if event.CommitType != expectedType {
+			t.Fatalf("expected commitment type %v, got %v",
+				expectedType, event.CommitType)
+		}
+		if event.Confirmed {
+			t.Fatalf("mempool commitment reported as confirmed")
+		}
+
+	case <-time.After(time.Second):
+		t.Fatalf("remote commitment not notified")
+	}
+
+	select {
+	case tx := <-breaches:
+		if !breach {
+			t.Fatalf("unexpected breach %v", tx.TxHash())
+		}
+		if tx.TxHash() != bobTxHash {
+			t.Fatalf("expected breach %v, got %v", bobTxHash,
+				tx.TxHash())
+		}
+
+	case <-time.After(time.Second):
+		if breach {
+			t.Fatalf("breach not detected")
+		}
+	}
+}
+
+// TestRemoteCommitType asserts that the commitments of the remote party are
+// classified according to their state relative to the latest one.
+func TestRemoteCommitType(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		broadcastStateNum uint64
+		pendingCommit     bool
+		isRecoveredChan   bool
+		commitType        channelnotifier.RemoteCommitType
+	}{
+		{5, false, false, channelnotifier.RemoteCommitLatest},
+		{6, true, false, channelnotifier.RemoteCommitPending},
+		{6, false, false, channelnotifier.RemoteCommitUnknown},
+		{7, true, false, channelnotifier.RemoteCommitUnknown},
+		{4, false, false, channelnotifier.RemoteCommitRevoked},
+		{5, false, true, channelnotifier.RemoteCommitUnknown},
+	}
+
+	for i, testCase := range testCases {
+		commitType := remoteCommitType(
+			testCase.broadcastStateNum, 5, testCase.pendingCommit,
+			testCase.isRecoveredChan,
+		)
+		if commitType != testCase.commitType {
+			t.Fatalf("test #%v: expected %v, got %v", i,
+				testCase.commitType, commitType)
+		}
+	}
+}
@@ -12,9 +12,19 @@ import (
 	"github.com/decred/dcrd/wire"
 	"github.com/decred/dcrlnd/chainntnfs"
 	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/input"
 	"github.com/decred/dcrlnd/lntypes"
 	"github.com/decred/dcrlnd/lnwallet"
 	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/sweep"
+)
+
+const (
+	// anchorSweepConfTarget is the default conf target used when sweeping
+	// commitment anchors. The anchor is mainly offered to the sweeper so
+	// that the fee of the commitment can later be bumped through CPFP, so
+	// we use a relaxed target for the initial sweep attempt.
+	anchorSweepConfTarget = 144
 )
 
 var (
@@ -126,6 +136,13 @@ type ChannelArbitratorConfig struct {
 	// TODO(roasbeef): need RPC's to combine for pendingchannels RPC
 	MarkChannelResolved func() error
 
+	// FetchHistoricalChannel retrieves the final state of the channel
+	// once it has been closed, giving resolvers access to the full
+	// channel details such as its constraints and commitment type. An
+	// error is returned if the channel isn't closed yet, or was closed
+	// before the final channel state started to be kept.
+	FetchHistoricalChannel func() (*channeldb.OpenChannel, error)
+
 	ChainArbitratorConfig
 }
 
@@ -284,6 +301,11 @@ type ChannelArbitrator struct {
 	// upon start up to decide which actions to take.
 	state ArbitratorState
 
+	// anchorFeePref is the fee preference of the user requested force
+	// close, if any, used to sweep the anchor of our commitment. It is
+	// only accessed by the goroutine advancing the state.
+	anchorFeePref sweep.FeePreference
+
 	wg   sync.WaitGroup
 	quit chan struct{}
 }
@@ -873,6 +895,20 @@ func (c *ChannelArbitrator) stateStep(
 			}
 		}
 
+		// If our commitment carries an anchor, we'll hand it to the
+		// sweeper, such that the commitment can be fee bumped using
+		// CPFP if it doesn't confirm in time. A failure to do so isn't
+		// fatal, as the commitment may still confirm on its own.
+		if closeSummary.AnchorResolution != nil {
+			err := c.sweepAnchor(
+				closeSummary.AnchorResolution, triggerHeight,
+			)
+			if err != nil {
+				log.Errorf("ChannelArbitrator(%v): unable to "+
+					"sweep anchor: %v", c.cfg.ChanPoint, err)
+			}
+		}
+
 		// We go to the StateCommitmentBroadcasted state, where we'll
 		// be waiting for the commitment to be confirmed.
 		nextState = StateCommitmentBroadcasted
@@ -936,7 +972,7 @@ func (c *ChannelArbitrator) stateStep(
 		// trimmed.  We'll need to wait for a CSV timeout before we can
 		// reclaim the funds.
 		commitRes := contractResolutions.CommitResolution
-		if commitRes != nil && commitRes.MaturityDelay > 0 {
+		if commitRes != nil && commitRes.IsLocalCommit() {
 			log.Infof("ChannelArbitrator(%v): sending commit "+
 				"output for incubation", c.cfg.ChanPoint)
 
@@ -1039,6 +1075,38 @@ func (c *ChannelArbitrator) stateStep(
 	return nextState, closeTx, nil
 }
 
+// sweepAnchor offers our anchor output on the broadcast commitment transaction
+// to the sweeper. The sweeper will then track it as a pending input, allowing
+// the fee of the commitment to be bumped through CPFP by sweeping the anchor
+// at a higher fee rate.
+func (c *ChannelArbitrator) sweepAnchor(anchor *lnwallet.AnchorResolution,
+	heightHint uint32) error {
+
+	log.Debugf("ChannelArbitrator(%v): offering anchor %v to sweeper",
+		c.cfg.ChanPoint, anchor.CommitAnchor)
+
+	anchorInput := input.MakeBaseInput(
+		&anchor.CommitAnchor, input.CommitmentAnchor,
+		&anchor.AnchorSignDescriptor, heightHint,
+	)
+
+	// Unless the force close was requested with a specific fee
+	// preference, we'll use the configured, relaxed, confirmation target.
+	feePref := c.anchorFeePref
+	if feePref.FeeRate == 0 && feePref.ConfTarget == 0 {
+		feePref.ConfTarget = c.cfg.AnchorConfTarget
+		if feePref.ConfTarget == 0 {
+			feePref.ConfTarget = anchorSweepConfTarget
+		}
+	}
+
+	// We don't wait for the result, as the sweep of the anchor on its own
+	// may never be economical. The input will remain known to the sweeper
+	// for as long as the commitment is unconfirmed.
+	_, err := c.cfg.Sweeper.SweepInput(&anchorInput, feePref)
+	return err
+}
+
 // launchResolvers updates the activeResolvers list and starts the resolvers.
 func (c *ChannelArbitrator) launchResolvers(resolvers []ContractResolver) {
 	c.activeResolversLock.Lock()
@@ -1739,6 +1807,7 @@ func (c *ChannelArbitrator) prepContractResolutions(
 					broadcastHeight: height,
 					payHash:         htlc.RHash,
 					htlcAmt:         htlc.Amt,
+					claimDeadline:   htlc.RefundTimeout,
 					ResolverKit:     resKit,
 				}
 				htlcResolvers = append(htlcResolvers, resolver)
@@ -1807,6 +1876,7 @@ func (c *ChannelArbitrator) prepContractResolutions(
 						broadcastHeight: height,
 						payHash:         htlc.RHash,
 						htlcAmt:         htlc.Amt,
+						claimDeadline:   htlc.RefundTimeout,
 						ResolverKit:     resKit,
 					},
 				}
@@ -2327,6 +2397,8 @@ func (c *ChannelArbitrator) channelAttendant(bestHeight int32) {
 				continue
 			}
 
+			c.anchorFeePref = closeReq.anchorFeePref
+
 			nextState, closeTx, err := c.advanceState(
 				uint32(bestHeight), userTrigger, nil,
 			)
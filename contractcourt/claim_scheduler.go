@@ -0,0 +1,202 @@
+package contractcourt
+
+import (
+	"sync"
+
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrlnd/lnwallet"
+)
+
+const (
+	// highValueClaimAmt is the value above which the claim of an HTLC
+	// output is swept with the fee priority of highValueClaimConfTarget.
+	highValueClaimAmt = dcrutil.Amount(10000000)
+
+	// highValueClaimConfTarget is the confirmation target used to sweep
+	// the HTLC outputs worth at least highValueClaimAmt.
+	highValueClaimConfTarget = 2
+)
+
+// claimRequest is a claim waiting for its turn within a claimScheduler.
+type claimRequest struct {
+	// amt is the value of the HTLC output being claimed.
+	amt dcrutil.Amount
+
+	// expiry is the height at which the HTLC expires, after which the
+	// remote party may claim it for itself.
+	expiry uint32
+
+	// granted is closed once the claim is allowed to proceed.
+	granted chan struct{}
+}
+
+// claimScheduler bounds the number of HTLC outputs claimed on-chain at once
+// across all the channels of the ChainArbitrator. Once the budget is
+// exhausted, the pending claims are granted as the active ones complete, the
+// most valuable claim per block left before its expiry first. This way, when
+// many HTLCs go on-chain together, the ones closest to their deadline and
+// with the most at stake are claimed in the earliest batches.
+//
+// A nil claimScheduler grants every claim immediately.
+type claimScheduler struct {
+	// maxClaims is the number of claims allowed at once. A value of zero
+	// disables the limit.
+	maxClaims int
+
+	// chainIO is used to fetch the current height the pending claims are
+	// prioritized at.
+	chainIO lnwallet.BlockChainIO
+
+	mtx     sync.Mutex
+	active  int
+	pending []*claimRequest
+}
+
+// newClaimScheduler returns a claimScheduler allowing maxClaims claims at
+// once.
+func newClaimScheduler(maxClaims int,
+	chainIO lnwallet.BlockChainIO) *claimScheduler {
+
+	if maxClaims < 0 {
+		maxClaims = 0
+	}
+
+	return &claimScheduler{
+		maxClaims: maxClaims,
+		chainIO:   chainIO,
+	}
+}
+
+// acquire waits for the claim of an HTLC output worth amt and expiring at
+// expiry to be allowed, returning a closure that must be called once the claim
+// is complete. errResolverShuttingDown is returned if quit is closed before
+// the claim is allowed.
+func (s *claimScheduler) acquire(amt dcrutil.Amount, expiry uint32,
+	quit <-chan struct{}) (func(), error) {
+
+	if s == nil {
+		return func() {}, nil
+	}
+
+	s.mtx.Lock()
+	if s.maxClaims == 0 || s.active < s.maxClaims {
+		s.active++
+		s.mtx.Unlock()
+		return s.release, nil
+	}
+
+	req := &claimRequest{
+		amt:     amt,
+		expiry:  expiry,
+		granted: make(chan struct{}),
+	}
+	s.pending = append(s.pending, req)
+	numPending := len(s.pending)
+	s.mtx.Unlock()
+
+	log.Debugf("Claim budget of %v exhausted, claim of %v expiring at "+
+		"height %v queued behind %v others", s.maxClaims, amt, expiry,
+		numPending-1)
+
+	select {
+	case <-req.granted:
+		return s.release, nil
+
+	case <-quit:
+	}
+
+	s.mtx.Lock()
+	for i, pending := range s.pending {
+		if pending == req {
+			s.pending = append(s.pending[:i], s.pending[i+1:]...)
+			s.mtx.Unlock()
+			return nil, errResolverShuttingDown
+		}
+	}
+	s.mtx.Unlock()
+
+	// The claim was granted while we were shutting down, so its slot must
+	// be handed over to the next one.
+	s.release()
+	return nil, errResolverShuttingDown
+}
+
+// release frees the slot of a completed claim, granting the pending claim
+// with the highest priority if any.
+func (s *claimScheduler) release() {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.active--
+	if len(s.pending) == 0 {
+		return
+	}
+
+	// The claims are prioritized at the current height. If it can't be
+	// fetched, they're still ordered by value and expiry.
+	var height uint32
+	if _, bestHeight, err := s.chainIO.GetBestBlock(); err != nil {
+		log.Warnf("Unable to fetch best height to prioritize "+
+			"claims: %v", err)
+	} else {
+		height = uint32(bestHeight)
+	}
+
+	best := 0
+	for i := 1; i < len(s.pending); i++ {
+		if claimHasPriority(s.pending[i], s.pending[best], height) {
+			best = i
+		}
+	}
+
+	req := s.pending[best]
+	s.pending = append(s.pending[:best], s.pending[best+1:]...)
+	s.active++
+	close(req.granted)
+}
+
+// blocksToExpiry returns the number of blocks left at height before the
+// expiry, which is at least one so it can be used to weigh claims.
+func blocksToExpiry(expiry, height uint32) uint32 {
+	if expiry <= height {
+		return 1
+	}
+	return expiry - height
+}
+
+// claimHasPriority returns true if the claim a must be granted before the
+// claim b at the given height. The claim with the most value at stake per
+// block left before its expiry goes first, and ties go to the earliest
+// expiry.
+func claimHasPriority(a, b *claimRequest, height uint32) bool {
+	aWeight := float64(a.amt) / float64(blocksToExpiry(a.expiry, height))
+	bWeight := float64(b.amt) / float64(blocksToExpiry(b.expiry, height))
+	if aWeight != bWeight {
+		return aWeight > bWeight
+	}
+
+	return a.expiry < b.expiry
+}
+
+// claimConfTarget returns the confirmation target of the sweep of an HTLC
+// output worth amt, with blocksLeft blocks left before its expiry, starting
+// from the passed base target. High value outputs are swept with a higher fee
+// priority, and the target is shortened so that the sweep may confirm well
+// before the expiry.
+func claimConfTarget(baseTarget uint32, amt dcrutil.Amount,
+	blocksLeft uint32) uint32 {
+
+	confTarget := baseTarget
+	if amt >= highValueClaimAmt && confTarget > highValueClaimConfTarget {
+		confTarget = highValueClaimConfTarget
+	}
+
+	if deadline := blocksLeft / 2; deadline < confTarget {
+		confTarget = deadline
+	}
+	if confTarget == 0 {
+		confTarget = 1
+	}
+
+	return confTarget
+}
@@ -0,0 +1,155 @@
+package contractcourt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/dcrutil/v2"
+)
+
+// TestClaimSchedulerPriority asserts that once the claim budget is exhausted,
+// the pending claims are granted the most valuable per block left before
+// their expiry first, and that a claim abandoned while pending doesn't hold a
+// slot.
+func TestClaimSchedulerPriority(t *testing.T) {
+	t.Parallel()
+
+	s := newClaimScheduler(1, &mockChainIO{})
+	quit := make(chan struct{})
+
+	release, err := s.acquire(1000, 100, quit)
+	if err != nil {
+		t.Fatalf("unable to acquire claim: %v", err)
+	}
+
+	type claim struct {
+		name   string
+		amt    dcrutil.Amount
+		expiry uint32
+		quit   chan struct{}
+	}
+	claims := []claim{
+		{name: "far", amt: 1000, expiry: 1000},
+		{name: "near", amt: 1000, expiry: 10},
+		{name: "valuable", amt: 50000, expiry: 1000},
+		{name: "abandoned", amt: 1000000, expiry: 10},
+	}
+
+	granted := make(chan string, len(claims))
+	releases := make(chan func(), len(claims))
+	for i := range claims {
+		c := &claims[i]
+		c.quit = make(chan struct{})
+		go func() {
+			release, err := s.acquire(c.amt, c.expiry, c.quit)
+			if err != nil {
+				granted <- c.name + " " + err.Error()
+				return
+			}
+			releases <- release
+			granted <- c.name
+		}()
+	}
+
+	// Wait for all claims to be pending.
+	waitPending := func(num int) {
+		t.Helper()
+
+		for i := 0; i < 100; i++ {
+			s.mtx.Lock()
+			numPending := len(s.pending)
+			s.mtx.Unlock()
+
+			if numPending == num {
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		t.Fatalf("expected %v pending claims", num)
+	}
+	waitPending(len(claims))
+
+	// The most valuable claim is abandoned before being granted.
+	close(claims[3].quit)
+	select {
+	case name := <-granted:
+		expected := "abandoned " + errResolverShuttingDown.Error()
+		if name != expected {
+			t.Fatalf("expected %q, got %q", expected, name)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("abandoned claim not returned")
+	}
+	waitPending(len(claims) - 1)
+
+	for _, expected := range []string{"near", "valuable", "far"} {
+		release()
+
+		select {
+		case name := <-granted:
+			if name != expected {
+				t.Fatalf("expected claim %v to be granted, "+
+					"got %v", expected, name)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("claim %v not granted", expected)
+		}
+
+		release = <-releases
+	}
+	release()
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.active != 0 || len(s.pending) != 0 {
+		t.Fatalf("expected no active or pending claims, got %v "+
+			"active and %v pending", s.active, len(s.pending))
+	}
+}
+
+// TestClaimSchedulerUnlimited asserts that claims are granted immediately
+// without a claim budget.
+func TestClaimSchedulerUnlimited(t *testing.T) {
+	t.Parallel()
+
+	schedulers := []*claimScheduler{
+		nil, newClaimScheduler(0, &mockChainIO{}),
+	}
+	for _, s := range schedulers {
+		for i := 0; i < 10; i++ {
+			_, err := s.acquire(1000, 100, make(chan struct{}))
+			if err != nil {
+				t.Fatalf("unable to acquire claim: %v", err)
+			}
+		}
+	}
+}
+
+// TestClaimConfTarget asserts that the confirmation target of the claims is
+// shortened for high value outputs and close to their expiry.
+func TestClaimConfTarget(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		amt        dcrutil.Amount
+		blocksLeft uint32
+		confTarget uint32
+	}{
+		{amt: 1000, blocksLeft: 100, confTarget: sweepConfTarget},
+		{amt: highValueClaimAmt, blocksLeft: 100, confTarget: 2},
+		{amt: 1000, blocksLeft: 8, confTarget: 4},
+		{amt: highValueClaimAmt, blocksLeft: 2, confTarget: 1},
+		{amt: 1000, blocksLeft: 1, confTarget: 1},
+	}
+
+	for _, test := range tests {
+		confTarget := claimConfTarget(
+			sweepConfTarget, test.amt, test.blocksLeft,
+		)
+		if confTarget != test.confTarget {
+			t.Fatalf("expected conf target %v for %v with %v "+
+				"blocks left, got %v", test.confTarget,
+				test.amt, test.blocksLeft, confTarget)
+		}
+	}
+}
@@ -4,18 +4,14 @@ import (
 	"encoding/binary"
 	"io"
 
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v2"
 	"github.com/decred/dcrd/wire"
 	"github.com/decred/dcrlnd/input"
 	"github.com/decred/dcrlnd/lnwallet"
 	"github.com/decred/dcrlnd/sweep"
 )
 
-const (
-	// commitOutputConfTarget is the default confirmation target we'll use
-	// for sweeps of commit outputs that belong to us.
-	commitOutputConfTarget = 6
-)
-
 // commitSweepResolver is a resolver that will attempt to sweep the commitment
 // output paying to us, in the case that the remote party broadcasts their
 // version of the commitment transaction. We can sweep this output immediately,
@@ -36,6 +32,10 @@ type commitSweepResolver struct {
 	// chanPoint is the channel point of the original contract.
 	chanPoint wire.OutPoint
 
+	// sweepTxid is the hash of the transaction sweeping the output once
+	// it's known. It is only used for reporting, so it isn't persisted.
+	sweepTxid *chainhash.Hash
+
 	ResolverKit
 }
 
@@ -84,37 +84,44 @@ func (c *commitSweepResolver) Resolve() (ContractResolver, error) {
 		return nil, errResolverShuttingDown
 	}
 
-	// We're dealing with our commitment transaction if the delay on the
-	// resolution isn't zero.
-	isLocalCommitTx := c.commitResolution.MaturityDelay != 0
+	// We're dealing with our commitment transaction if the output uses
+	// our delayed to-self script.
+	isLocalCommitTx := c.commitResolution.IsLocalCommit()
 
 	if !isLocalCommitTx {
 		// There're two types of commitments, those that have tweaks
 		// for the remote key (us in this case), and those that don't.
 		// We'll rely on the presence of the commitment tweak to to
-		// discern which type of commitment this is.
+		// discern which type of commitment this is. Channels with
+		// anchors additionally require the output to be confirmed
+		// for a block before it can be spent.
 		var witnessType input.WitnessType
-		if c.commitResolution.SelfOutputSignDesc.SingleTweak == nil {
+		switch {
+		case c.commitResolution.MaturityDelay != 0:
+			witnessType = input.CommitmentToRemoteConfirmed
+
+		case c.commitResolution.SelfOutputSignDesc.SingleTweak == nil:
 			witnessType = input.CommitSpendNoDelayTweakless
-		} else {
+
+		default:
 			witnessType = input.CommitmentNoDelay
 		}
 
 		// We'll craft an input with all the information required for
 		// the sweeper to create a fully valid sweeping transaction to
 		// recover these coins.
-		inp := input.MakeBaseInput(
+		inp := input.MakeCsvInput(
 			&c.commitResolution.SelfOutPoint,
 			witnessType,
 			&c.commitResolution.SelfOutputSignDesc,
-			c.broadcastHeight,
+			c.broadcastHeight, c.commitResolution.MaturityDelay,
 		)
 
 		// With our input constructed, we'll now offer it to the
 		// sweeper.
 		log.Infof("%T(%v): sweeping commit output", c, c.chanPoint)
 
-		feePref := sweep.FeePreference{ConfTarget: commitOutputConfTarget}
+		feePref := sweep.FeePreference{ConfTarget: c.sweepTarget()}
 		resultChan, err := c.Sweeper.SweepInput(&inp, feePref)
 		if err != nil {
 			log.Errorf("%T(%v): unable to sweep input: %v",
@@ -136,6 +143,8 @@ func (c *commitSweepResolver) Resolve() (ContractResolver, error) {
 				return nil, sweepResult.Err
 			}
 
+			c.sweepTxid = optionalTxHash(sweepResult.Tx)
+
 			log.Infof("ChannelPoint(%v) commit tx is fully resolved by "+
 				"sweep tx: %v", c.chanPoint, sweepResult.Tx.TxHash())
 		case <-c.Quit:
@@ -172,6 +181,7 @@ func (c *commitSweepResolver) Resolve() (ContractResolver, error) {
 		// we'll extract the spending transaction itself, as we
 		// now consider this to be our sweep transaction.
 		sweepTx = commitSpend.SpendingTx
+		c.sweepTxid = optionalTxHash(sweepTx)
 
 		log.Infof("%T(%v): commit output swept by txid=%v",
 			c, c.chanPoint, sweepTx.TxHash())
@@ -216,6 +226,34 @@ func (c *commitSweepResolver) Resolve() (ContractResolver, error) {
 	return nil, c.Checkpoint(c)
 }
 
+// stateReport returns a report on the progress of the resolver.
+func (c *commitSweepResolver) stateReport() *ResolverReport {
+	report := &ResolverReport{
+		Type:     ResolverTypeCommit,
+		OutPoint: c.commitResolution.SelfOutPoint,
+		Amount: dcrutil.Amount(
+			c.commitResolution.SelfOutputSignDesc.Output.Value,
+		),
+		SweepTxid: c.sweepTxid,
+	}
+
+	// The output of our own commitment is time locked, and swept by the
+	// utxo nursery, while the one of the remote commitment is offered to
+	// the sweeper right away.
+	switch {
+	case c.resolved:
+		report.Stage = ResolverStageResolved
+
+	case c.commitResolution.IsLocalCommit() && c.sweepTxid == nil:
+		report.Stage = ResolverStageIncubating
+
+	default:
+		report.Stage = ResolverStageSweeping
+	}
+
+	return report
+}
+
 // Stop signals the resolver to cancel any current resolution processes, and
 // suspend.
 //
@@ -16,6 +16,16 @@ const (
 	sweepConfTarget = 6
 )
 
+// sweepTarget returns the confirmation target of the sweeps of the outputs of
+// closed channels.
+func (c *ChainArbitratorConfig) sweepTarget() uint32 {
+	if c.SweepConfTarget == 0 {
+		return sweepConfTarget
+	}
+
+	return c.SweepConfTarget
+}
+
 // ContractResolver is an interface which packages a state machine which is
 // able to carry out the necessary steps required to fully resolve a Decred
 // contract on-chain. Resolvers are fully encodable to ensure callers are able
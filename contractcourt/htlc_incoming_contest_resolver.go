@@ -275,6 +275,21 @@ func (h *htlcIncomingContestResolver) report() *ContractReport {
 	}
 }
 
+// stateReport returns a report on the progress of the resolver.
+func (h *htlcIncomingContestResolver) stateReport() *ResolverReport {
+	report := h.htlcSuccessResolver.stateReport()
+	report.MaturityHeight = h.htlcExpiry
+	report.SweepTxid = nil
+
+	if h.resolved {
+		report.Stage = ResolverStageResolved
+	} else {
+		report.Stage = ResolverStageAwaitingPreimage
+	}
+
+	return report
+}
+
 // Stop signals the resolver to cancel any current resolution processes, and
 // suspend.
 //
@@ -316,7 +331,17 @@ func (h *htlcIncomingContestResolver) Decode(r io.Reader) error {
 	}
 
 	// Then we'll decode our internal resolver.
-	return h.htlcSuccessResolver.Decode(r)
+	if err := h.htlcSuccessResolver.Decode(r); err != nil {
+		return err
+	}
+
+	// Resolvers stored without the claim deadline of the inner resolver
+	// can still use the expiry of the HTLC.
+	if h.claimDeadline == 0 {
+		h.claimDeadline = h.htlcExpiry
+	}
+
+	return nil
 }
 
 // AttachResolverKit should be called once a resolved is successfully decoded
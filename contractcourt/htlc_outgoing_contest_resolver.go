@@ -178,6 +178,20 @@ func (h *htlcOutgoingContestResolver) report() *ContractReport {
 	}
 }
 
+// stateReport returns a report on the progress of the resolver.
+func (h *htlcOutgoingContestResolver) stateReport() *ResolverReport {
+	report := h.htlcTimeoutResolver.stateReport()
+	report.SweepTxid = nil
+
+	if h.resolved {
+		report.Stage = ResolverStageResolved
+	} else {
+		report.Stage = ResolverStageAwaitingExpiry
+	}
+
+	return report
+}
+
 // Stop signals the resolver to cancel any current resolution processes, and
 // suspend.
 //
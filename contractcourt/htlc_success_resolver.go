@@ -3,8 +3,10 @@ package contractcourt
 import (
 	"encoding/binary"
 	"io"
+	"math"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/decred/dcrd/dcrutil/v2"
 	"github.com/decred/dcrd/wire"
 
 	"github.com/decred/dcrlnd/input"
@@ -53,6 +55,11 @@ type htlcSuccessResolver struct {
 	// account any fees that may have to be paid if it goes on chain.
 	htlcAmt lnwire.MilliAtom
 
+	// claimDeadline is the expiry height of the HTLC, after which the
+	// remote party may time it out. It sets the priority of our claim
+	// over the one of the other HTLCs, and is zero if unknown.
+	claimDeadline uint32
+
 	ResolverKit
 }
 
@@ -95,6 +102,21 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 	// If we don't have a success transaction, then this means that this is
 	// an output on the remote party's commitment transaction.
 	if h.htlcResolution.SignedSuccessTx == nil {
+		// Our claim first waits for its turn within the budget of
+		// concurrent claims, and holds its slot until the sweep
+		// transaction confirms.
+		expiry := h.claimDeadline
+		if expiry == 0 {
+			expiry = math.MaxUint32
+		}
+		signDesc := &h.htlcResolution.SweepSignDesc
+		amt := dcrutil.Amount(signDesc.Output.Value)
+		release, err := h.claims.acquire(amt, expiry, h.Quit)
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+
 		// If we don't already have the sweep transaction constructed,
 		// we'll do so and broadcast it.
 		if h.sweepTx == nil {
@@ -102,15 +124,36 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 				"incoming+remote htlc confirmed", h,
 				h.payHash[:])
 
+			// The HTLC output needs to be confirmed for a block
+			// before it can be spent if this is a channel with
+			// anchors. Resolutions of other channels may carry an
+			// unused CSV delay, so we only honour it if the script
+			// requires it.
+			var blocksToMaturity uint32
+			if input.IsConfirmedSpendHTLCScript(signDesc.WitnessScript) {
+				blocksToMaturity = h.htlcResolution.CsvDelay
+			}
+
 			// Before we can craft out sweeping transaction, we
 			// need to create an input which contains all the items
 			// required to add this input to a sweeping transaction,
 			// and generate a witness.
 			inp := input.MakeHtlcSucceedInput(
-				&h.htlcResolution.ClaimOutpoint,
-				&h.htlcResolution.SweepSignDesc,
+				&h.htlcResolution.ClaimOutpoint, signDesc,
 				h.htlcResolution.Preimage[:],
-				h.broadcastHeight,
+				h.broadcastHeight, blocksToMaturity,
+			)
+
+			// The fee priority of the sweep depends on the value
+			// at stake and on the blocks left until the remote
+			// party can time out the HTLC.
+			_, bestHeight, err := h.ChainIO.GetBestBlock()
+			if err != nil {
+				return nil, err
+			}
+			confTarget := claimConfTarget(
+				h.sweepTarget(), amt,
+				blocksToExpiry(expiry, uint32(bestHeight)),
 			)
 
 			// With the input created, we can now generate the full
@@ -122,19 +165,19 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 			// implementation is complete.
 			//
 			// TODO: Use time-based sweeper and result chan.
-			var err error
 			h.sweepTx, err = h.Sweeper.CreateSweepTx(
 				[]input.Input{&inp},
 				sweep.FeePreference{
-					ConfTarget: sweepConfTarget,
+					ConfTarget: confTarget,
 				}, 0,
 			)
 			if err != nil {
 				return nil, err
 			}
 
-			log.Infof("%T(%x): crafted sweep tx=%v", h,
-				h.payHash[:], spew.Sdump(h.sweepTx))
+			log.Infof("%T(%x): crafted sweep tx=%v with "+
+				"conf_target=%v", h, h.payHash[:],
+				spew.Sdump(h.sweepTx), confTarget)
 
 			// With the sweep transaction signed, we'll now
 			// Checkpoint our state.
@@ -147,7 +190,7 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 		// Regardless of whether an existing transaction was found or newly
 		// constructed, we'll broadcast the sweep transaction to the
 		// network.
-		err := h.PublishTx(h.sweepTx)
+		err = h.PublishTx(h.sweepTx)
 		if err != nil {
 			log.Infof("%T(%x): unable to publish tx: %v",
 				h, h.payHash[:], err)
@@ -247,6 +290,42 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 	return nil, h.Checkpoint(h)
 }
 
+// stateReport returns a report on the progress of the resolver.
+func (h *htlcSuccessResolver) stateReport() *ResolverReport {
+	payHash := h.payHash
+	report := &ResolverReport{
+		Type:           ResolverTypeIncomingHtlc,
+		OutPoint:       h.htlcResolution.ClaimOutpoint,
+		Amount:         h.htlcAmt.ToAtoms(),
+		PaymentHash:    &payHash,
+		MaturityHeight: h.claimDeadline,
+	}
+
+	// If the htlc is on our commitment, it's claimed by the second-level
+	// success transaction, whose output is then incubated by the utxo
+	// nursery. Otherwise, it's swept directly.
+	successTx := h.htlcResolution.SignedSuccessTx
+	if successTx != nil {
+		report.OutPoint = successTx.TxIn[0].PreviousOutPoint
+		report.SweepTxid = optionalTxHash(successTx)
+	} else {
+		report.SweepTxid = optionalTxHash(h.sweepTx)
+	}
+
+	switch {
+	case h.resolved:
+		report.Stage = ResolverStageResolved
+
+	case h.outputIncubating:
+		report.Stage = ResolverStageIncubating
+
+	default:
+		report.Stage = ResolverStageSweeping
+	}
+
+	return report
+}
+
 // Stop signals the resolver to cancel any current resolution processes, and
 // suspend.
 //
@@ -287,6 +366,9 @@ func (h *htlcSuccessResolver) Encode(w io.Writer) error {
 	if _, err := w.Write(h.payHash[:]); err != nil {
 		return err
 	}
+	if err := binary.Write(w, endian, h.claimDeadline); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -316,6 +398,18 @@ func (h *htlcSuccessResolver) Decode(r io.Reader) error {
 		return err
 	}
 
+	// The claim deadline was appended to the resolvers stored by later
+	// versions, so it may be missing.
+	var claimDeadline uint32
+	err := binary.Read(r, endian, &claimDeadline)
+	switch {
+	case err == io.EOF:
+	case err != nil:
+		return err
+	default:
+		h.claimDeadline = claimDeadline
+	}
+
 	return nil
 }
 
@@ -383,6 +383,39 @@ func (h *htlcTimeoutResolver) Resolve() (ContractResolver, error) {
 	return nil, h.Checkpoint(h)
 }
 
+// stateReport returns a report on the progress of the resolver.
+func (h *htlcTimeoutResolver) stateReport() *ResolverReport {
+	report := &ResolverReport{
+		Type:           ResolverTypeOutgoingHtlc,
+		OutPoint:       h.htlcResolution.ClaimOutpoint,
+		Amount:         h.htlcAmt.ToAtoms(),
+		MaturityHeight: h.htlcResolution.Expiry,
+	}
+
+	// If the htlc is on our commitment, it's timed out by the
+	// second-level timeout transaction.
+	timeoutTx := h.htlcResolution.SignedTimeoutTx
+	if timeoutTx != nil {
+		report.OutPoint = timeoutTx.TxIn[0].PreviousOutPoint
+		report.SweepTxid = optionalTxHash(timeoutTx)
+	}
+
+	// Once handed off to the utxo nursery, the htlc is timed out as soon
+	// as it expires.
+	switch {
+	case h.resolved:
+		report.Stage = ResolverStageResolved
+
+	case h.outputIncubating:
+		report.Stage = ResolverStageIncubating
+
+	default:
+		report.Stage = ResolverStageAwaitingExpiry
+	}
+
+	return report
+}
+
 // Stop signals the resolver to cancel any current resolution processes, and
 // suspend.
 //
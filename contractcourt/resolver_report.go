@@ -0,0 +1,211 @@
+package contractcourt
+
+import (
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/lntypes"
+)
+
+// ResolverType describes the kind of contract a resolver claims.
+type ResolverType uint8
+
+const (
+	// ResolverTypeCommit is the resolver of our output on the commitment
+	// transaction.
+	ResolverTypeCommit ResolverType = iota
+
+	// ResolverTypeIncomingHtlc is the resolver of an incoming htlc, which
+	// we may claim with its preimage.
+	ResolverTypeIncomingHtlc
+
+	// ResolverTypeOutgoingHtlc is the resolver of an outgoing htlc, which
+	// we may time out once it expires.
+	ResolverTypeOutgoingHtlc
+)
+
+// String returns a human readable string describing the ResolverType.
+func (r ResolverType) String() string {
+	switch r {
+	case ResolverTypeCommit:
+		return "Commit"
+
+	case ResolverTypeIncomingHtlc:
+		return "IncomingHtlc"
+
+	case ResolverTypeOutgoingHtlc:
+		return "OutgoingHtlc"
+
+	default:
+		return "Unknown"
+	}
+}
+
+// ResolverStage describes what a resolver is waiting for to make progress.
+type ResolverStage uint8
+
+const (
+	// ResolverStageAwaitingPreimage indicates that the preimage of the
+	// incoming htlc is required to claim it before it expires.
+	ResolverStageAwaitingPreimage ResolverStage = iota
+
+	// ResolverStageAwaitingExpiry indicates that the outgoing htlc must
+	// expire before we can time it out, unless the remote party claims it
+	// first.
+	ResolverStageAwaitingExpiry
+
+	// ResolverStageIncubating indicates that the output was handed off to
+	// the utxo nursery, which sweeps it once its time locks expire.
+	ResolverStageIncubating
+
+	// ResolverStageSweeping indicates that the output is being claimed,
+	// and the resolver is waiting for the claim to confirm.
+	ResolverStageSweeping
+
+	// ResolverStageResolved indicates that the contract is fully resolved.
+	ResolverStageResolved
+)
+
+// String returns a human readable string describing the ResolverStage.
+func (r ResolverStage) String() string {
+	switch r {
+	case ResolverStageAwaitingPreimage:
+		return "AwaitingPreimage"
+
+	case ResolverStageAwaitingExpiry:
+		return "AwaitingExpiry"
+
+	case ResolverStageIncubating:
+		return "Incubating"
+
+	case ResolverStageSweeping:
+		return "Sweeping"
+
+	case ResolverStageResolved:
+		return "Resolved"
+
+	default:
+		return "Unknown"
+	}
+}
+
+// ResolverReport describes the progress of a contract resolver.
+type ResolverReport struct {
+	// Type is the kind of contract being resolved.
+	Type ResolverType
+
+	// OutPoint is the output of the commitment transaction being claimed.
+	OutPoint wire.OutPoint
+
+	// Amount is the value of the output.
+	Amount dcrutil.Amount
+
+	// PaymentHash is the payment hash of the htlc being resolved, if
+	// known.
+	PaymentHash *lntypes.Hash
+
+	// Stage is what the resolver is currently waiting for.
+	Stage ResolverStage
+
+	// MaturityHeight is the block height at which the current stage is
+	// over, or zero if it isn't known.
+	MaturityHeight uint32
+
+	// SweepTxid is the hash of the transaction claiming the output, if
+	// known. For htlcs on our commitment, this is the second-level
+	// transaction.
+	SweepTxid *chainhash.Hash
+}
+
+// stateReportingResolver is a ContractResolver that also reports its
+// progress.
+type stateReportingResolver interface {
+	ContractResolver
+
+	stateReport() *ResolverReport
+}
+
+// ArbitratorReport describes the state of a ChannelArbitrator along with the
+// progress of its active resolvers.
+type ArbitratorReport struct {
+	// ChanPoint is the channel arbitrated.
+	ChanPoint wire.OutPoint
+
+	// State is the current state of the arbitrator.
+	State ArbitratorState
+
+	// Resolvers are the reports of the active resolvers of the channel.
+	Resolvers []*ResolverReport
+}
+
+// optionalTxHash returns the hash of tx, or nil if tx is nil.
+func optionalTxHash(tx *wire.MsgTx) *chainhash.Hash {
+	if tx == nil {
+		return nil
+	}
+
+	hash := tx.TxHash()
+	return &hash
+}
+
+// StateReport returns the state of the arbitrator along with the progress of
+// its active resolvers.
+func (c *ChannelArbitrator) StateReport() (*ArbitratorReport, error) {
+	// The state is read from the log, as the in-memory one is only
+	// accessed by the goroutine advancing the state machine.
+	state, err := c.log.CurrentState()
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ArbitratorReport{
+		ChanPoint: c.cfg.ChanPoint,
+		State:     state,
+	}
+
+	c.activeResolversLock.RLock()
+	defer c.activeResolversLock.RUnlock()
+
+	for _, resolver := range c.activeResolvers {
+		r, ok := resolver.(stateReportingResolver)
+		if !ok {
+			continue
+		}
+
+		report.Resolvers = append(report.Resolvers, r.stateReport())
+	}
+
+	return report, nil
+}
+
+// StateReports returns the state reports of the arbitrators of all the
+// channels that are open or being resolved on-chain.
+func (c *ChainArbitrator) StateReports() ([]*ArbitratorReport, error) {
+	c.Lock()
+	arbitrators := make([]*ChannelArbitrator, 0, len(c.activeChannels))
+	for _, arbitrator := range c.activeChannels {
+		arbitrators = append(arbitrators, arbitrator)
+	}
+	c.Unlock()
+
+	reports := make([]*ArbitratorReport, 0, len(arbitrators))
+	for _, arbitrator := range arbitrators {
+		report, err := arbitrator.StateReport()
+		if err != nil {
+			return nil, err
+		}
+
+		reports = append(reports, report)
+	}
+
+	return reports, nil
+}
+
+// A compile time check to ensure all the resolvers report their progress.
+var (
+	_ stateReportingResolver = (*commitSweepResolver)(nil)
+	_ stateReportingResolver = (*htlcSuccessResolver)(nil)
+	_ stateReportingResolver = (*htlcTimeoutResolver)(nil)
+	_ stateReportingResolver = (*htlcIncomingContestResolver)(nil)
+	_ stateReportingResolver = (*htlcOutgoingContestResolver)(nil)
+)
@@ -0,0 +1,170 @@
+package contractcourt
+
+import (
+	"testing"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/txscript/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/input"
+	"github.com/decred/dcrlnd/lnwallet"
+)
+
+// TestResolverStateReports asserts that the resolvers report the stage they
+// are at, along with the output they claim and the transaction claiming it.
+func TestResolverStateReports(t *testing.T) {
+	t.Parallel()
+
+	commitOutPoint := wire.OutPoint{Index: 1}
+	claimOutPoint := wire.OutPoint{Index: 2}
+
+	secondLevelTx := wire.NewMsgTx()
+	secondLevelTx.AddTxIn(wire.NewTxIn(&commitOutPoint, 0, nil))
+	secondLevelTx.AddTxOut(wire.NewTxOut(1000, nil))
+	secondLevelTxid := secondLevelTx.TxHash()
+
+	sweepTx := wire.NewMsgTx()
+	sweepTx.AddTxOut(wire.NewTxOut(2000, nil))
+	sweepTxid := sweepTx.TxHash()
+
+	localCommitRes := lnwallet.CommitOutputResolution{
+		SelfOutPoint: commitOutPoint,
+		SelfOutputSignDesc: input.SignDescriptor{
+			WitnessScript: []byte{txscript.OP_IF},
+			Output:        wire.NewTxOut(5000, nil),
+		},
+	}
+
+	incomingRes := lnwallet.IncomingHtlcResolution{
+		SignedSuccessTx: secondLevelTx,
+	}
+	outgoingRes := lnwallet.OutgoingHtlcResolution{
+		Expiry:        300,
+		ClaimOutpoint: claimOutPoint,
+	}
+
+	testCases := []struct {
+		name      string
+		resolver  stateReportingResolver
+		stage     ResolverStage
+		outPoint  wire.OutPoint
+		sweepTxid *chainhash.Hash
+		maturity  uint32
+	}{
+		{
+			name: "local commit incubating",
+			resolver: &commitSweepResolver{
+				commitResolution: localCommitRes,
+			},
+			stage:    ResolverStageIncubating,
+			outPoint: commitOutPoint,
+		},
+		{
+			name: "local commit swept",
+			resolver: &commitSweepResolver{
+				commitResolution: localCommitRes,
+				sweepTxid:        &sweepTxid,
+			},
+			stage:     ResolverStageSweeping,
+			outPoint:  commitOutPoint,
+			sweepTxid: &sweepTxid,
+		},
+		{
+			name: "remote htlc swept",
+			resolver: &htlcSuccessResolver{
+				htlcResolution: lnwallet.IncomingHtlcResolution{
+					ClaimOutpoint: claimOutPoint,
+				},
+				sweepTx:       sweepTx,
+				claimDeadline: 100,
+			},
+			stage:     ResolverStageSweeping,
+			outPoint:  claimOutPoint,
+			sweepTxid: &sweepTxid,
+			maturity:  100,
+		},
+		{
+			name: "local htlc incubating",
+			resolver: &htlcSuccessResolver{
+				htlcResolution: lnwallet.IncomingHtlcResolution{
+					SignedSuccessTx: secondLevelTx,
+					ClaimOutpoint:   claimOutPoint,
+				},
+				outputIncubating: true,
+			},
+			stage:     ResolverStageIncubating,
+			outPoint:  commitOutPoint,
+			sweepTxid: &secondLevelTxid,
+		},
+		{
+			name: "incoming htlc contested",
+			resolver: &htlcIncomingContestResolver{
+				htlcExpiry: 200,
+				htlcSuccessResolver: htlcSuccessResolver{
+					htlcResolution: incomingRes,
+				},
+			},
+			stage:    ResolverStageAwaitingPreimage,
+			outPoint: commitOutPoint,
+			maturity: 200,
+		},
+		{
+			name: "outgoing htlc contested",
+			resolver: &htlcOutgoingContestResolver{
+				htlcTimeoutResolver: htlcTimeoutResolver{
+					htlcResolution: outgoingRes,
+				},
+			},
+			stage:    ResolverStageAwaitingExpiry,
+			outPoint: claimOutPoint,
+			maturity: 300,
+		},
+		{
+			name: "local htlc timed out",
+			resolver: &htlcTimeoutResolver{
+				htlcResolution: lnwallet.OutgoingHtlcResolution{
+					Expiry:          300,
+					SignedTimeoutTx: secondLevelTx,
+				},
+				outputIncubating: true,
+				resolved:         true,
+			},
+			stage:     ResolverStageResolved,
+			outPoint:  commitOutPoint,
+			sweepTxid: &secondLevelTxid,
+			maturity:  300,
+		},
+	}
+
+	for _, testCase := range testCases {
+		report := testCase.resolver.stateReport()
+
+		if report.Stage != testCase.stage {
+			t.Fatalf("%v: expected stage %v, got %v", testCase.name,
+				testCase.stage, report.Stage)
+		}
+		if report.OutPoint != testCase.outPoint {
+			t.Fatalf("%v: expected outpoint %v, got %v",
+				testCase.name, testCase.outPoint,
+				report.OutPoint)
+		}
+		if report.MaturityHeight != testCase.maturity {
+			t.Fatalf("%v: expected maturity height %v, got %v",
+				testCase.name, testCase.maturity,
+				report.MaturityHeight)
+		}
+
+		switch {
+		case testCase.sweepTxid == nil && report.SweepTxid != nil:
+			t.Fatalf("%v: unexpected sweep txid %v", testCase.name,
+				report.SweepTxid)
+
+		case testCase.sweepTxid != nil && (report.SweepTxid == nil ||
+			*report.SweepTxid != *testCase.sweepTxid):
+
+			t.Fatalf("%v: expected sweep txid %v, got %v",
+				testCase.name, testCase.sweepTxid,
+				report.SweepTxid)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package dcrlnd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	// dbSnapshotDirname is the name of the directory, within the data
+	// directory of the network, holding the database snapshots.
+	dbSnapshotDirname = "snapshots"
+
+	// dbSnapshotManifest is the name of the file written last within a
+	// snapshot, once all of its databases were completely written. A
+	// snapshot without a manifest is incomplete and must not be restored.
+	dbSnapshotManifest = "MANIFEST"
+
+	// dbSnapshotTimeFormat is the format of the names of the snapshot
+	// directories, which sort chronologically.
+	dbSnapshotTimeFormat = "20060102T150405.000Z"
+)
+
+// dbSnapshotSource is a database included within the snapshots.
+type dbSnapshotSource struct {
+	// name is the file name of the database, which is kept within the
+	// snapshot so that it can be restored by copying it back.
+	name string
+
+	// begin starts the read-only transaction the database is copied
+	// within.
+	begin func() (*bolt.Tx, error)
+}
+
+// dbSnapshotFile describes a database written to a snapshot.
+type dbSnapshotFile struct {
+	// name is the file name of the database within the snapshot.
+	name string
+
+	// size is the size in bytes of the database.
+	size int64
+
+	// txID is the id of the transaction the database was copied within.
+	txID int
+}
+
+// snapshotDatabases writes a consistent copy of each of the databases to a new
+// snapshot directory within dir, returning the path of the snapshot.
+//
+// The read transactions of all databases are started before any of them is
+// written, in the order of the sources, and held until the snapshot is
+// complete. Each database thus reflects a single transaction boundary, and
+// the databases are at least as recent as the ones preceding them. Much like
+// a write-ahead log commit record, the manifest listing the databases is only
+// written once they were all synced to disk, so that a snapshot interrupted
+// by a crash is detected rather than restored.
+func snapshotDatabases(dir string, sources []dbSnapshotSource,
+	now time.Time) (string, []dbSnapshotFile, error) {
+
+	txs := make([]*bolt.Tx, 0, len(sources))
+	defer func() {
+		for _, tx := range txs {
+			_ = tx.Rollback()
+		}
+	}()
+	for _, source := range sources {
+		tx, err := source.begin()
+		if err != nil {
+			return "", nil, fmt.Errorf("unable to snapshot %v: %v",
+				source.name, err)
+		}
+		txs = append(txs, tx)
+	}
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", nil, err
+	}
+
+	snapshotDir := filepath.Join(
+		dir, now.UTC().Format(dbSnapshotTimeFormat),
+	)
+	if err := os.Mkdir(snapshotDir, 0700); err != nil {
+		return "", nil, err
+	}
+
+	files := make([]dbSnapshotFile, 0, len(sources))
+	for i, source := range sources {
+		path := filepath.Join(snapshotDir, source.name)
+		if err := writeSyncedFile(path, txs[i].WriteTo); err != nil {
+			return "", nil, fmt.Errorf("unable to snapshot %v: %v",
+				source.name, err)
+		}
+
+		files = append(files, dbSnapshotFile{
+			name: source.name,
+			size: txs[i].Size(),
+			txID: txs[i].ID(),
+		})
+	}
+
+	writeManifest := func(w io.Writer) (int64, error) {
+		var n int64
+		for _, file := range files {
+			written, err := fmt.Fprintf(
+				w, "%v %v %v\n", file.name, file.size,
+				file.txID,
+			)
+			n += int64(written)
+			if err != nil {
+				return n, err
+			}
+		}
+
+		return n, nil
+	}
+
+	manifestPath := filepath.Join(snapshotDir, dbSnapshotManifest)
+	if err := writeSyncedFile(manifestPath, writeManifest); err != nil {
+		return "", nil, err
+	}
+
+	return snapshotDir, files, nil
+}
+
+// writeSyncedFile writes the file at path with the data written by write,
+// syncing it to disk before returning.
+func writeSyncedFile(path string,
+	write func(w io.Writer) (int64, error)) error {
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(f)
+	_, err = write(w)
+	if err == nil {
+		err = w.Flush()
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	return err
+}
@@ -0,0 +1,154 @@
+// +build !rpctest
+
+package dcrlnd
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	testSnapshotBucket = []byte("snapshot-bucket")
+	testSnapshotKey    = []byte("snapshot-key")
+)
+
+// openTestSnapshotDB opens a bolt database in dir holding the given value.
+func openTestSnapshotDB(t *testing.T, dir, name string,
+	value []byte) *bolt.DB {
+
+	db, err := bolt.Open(filepath.Join(dir, name), 0600, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(testSnapshotBucket)
+		if err != nil {
+			return err
+		}
+		return bucket.Put(testSnapshotKey, value)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return db
+}
+
+// TestSnapshotDatabases asserts that the databases are copied along with a
+// manifest listing them, and that a snapshot whose databases couldn't all be
+// copied is left without a manifest.
+func TestSnapshotDatabases(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "dbsnapshot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	names := []string{"channel.db", "wtclient.db", "sphinxreplay.db"}
+	var sources []dbSnapshotSource
+	for _, name := range names {
+		db := openTestSnapshotDB(t, dir, name, []byte(name))
+		defer db.Close()
+
+		sources = append(sources, dbSnapshotSource{
+			name: name,
+			begin: func() (*bolt.Tx, error) {
+				return db.Begin(false)
+			},
+		})
+	}
+
+	snapshotsDir := filepath.Join(dir, dbSnapshotDirname)
+	now := time.Unix(1600000000, 0)
+	snapshotDir, files, err := snapshotDatabases(
+		snapshotsDir, sources, now,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != len(names) {
+		t.Fatalf("expected %v files, got %v", len(names), len(files))
+	}
+
+	var manifest string
+	for i, name := range names {
+		if files[i].name != name {
+			t.Fatalf("expected file %v, got %v", name,
+				files[i].name)
+		}
+		manifest += fmt.Sprintf("%v %v %v\n", name, files[i].size,
+			files[i].txID)
+
+		// The snapshot of each database must hold its content.
+		path := filepath.Join(snapshotDir, name)
+		db, err := bolt.Open(path, 0600, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = db.View(func(tx *bolt.Tx) error {
+			bucket := tx.Bucket(testSnapshotBucket)
+			value := bucket.Get(testSnapshotKey)
+			if string(value) != name {
+				return fmt.Errorf("expected value %v, got %s",
+					name, value)
+			}
+			return nil
+		})
+		db.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	content, err := ioutil.ReadFile(
+		filepath.Join(snapshotDir, dbSnapshotManifest),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != manifest {
+		t.Fatalf("expected manifest %q, got %q", manifest, content)
+	}
+
+	// A database that can't be snapshotted fails the snapshot, which is
+	// left without a manifest if it was created at all.
+	failing := append(sources, dbSnapshotSource{
+		name: "failing.db",
+		begin: func() (*bolt.Tx, error) {
+			return nil, errors.New("unavailable")
+		},
+	})
+	_, _, err = snapshotDatabases(
+		snapshotsDir, failing, now.Add(time.Second),
+	)
+	if err == nil {
+		t.Fatal("expected snapshot with failing database to fail")
+	}
+
+	failedDir := filepath.Join(
+		snapshotsDir, now.Add(time.Second).UTC().Format(
+			dbSnapshotTimeFormat,
+		),
+	)
+	_, err = os.Stat(filepath.Join(failedDir, dbSnapshotManifest))
+	if !os.IsNotExist(err) {
+		t.Fatalf("expected no manifest for failed snapshot, got %v",
+			err)
+	}
+
+	// Two snapshots can't share a directory.
+	_, _, err = snapshotDatabases(snapshotsDir, sources, now)
+	if err == nil {
+		t.Fatal("expected snapshot to an existing directory to fail")
+	}
+}
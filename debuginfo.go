@@ -0,0 +1,102 @@
+package dcrlnd
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// redactedConfigValue replaces the value of the sensitive options in the
+// config dump of GetDebugInfo.
+const redactedConfigValue = "<redacted>"
+
+// sensitiveConfigOptions lists the options, besides those whose default value
+// is masked in the help output, whose value is redacted from the config dump.
+var sensitiveConfigOptions = map[string]struct{}{
+	"rpcuser": {},
+}
+
+// isSensitiveConfigOption returns true if the value of the option described
+// by the passed struct field shouldn't leave the node.
+func isSensitiveConfigOption(field reflect.StructField) bool {
+	if field.Tag.Get("default-mask") == "-" {
+		return true
+	}
+
+	name := field.Tag.Get("long")
+	if strings.Contains(name, "pass") {
+		return true
+	}
+
+	_, ok := sensitiveConfigOptions[name]
+	return ok
+}
+
+// configDump flattens the passed config struct into a map from the full name
+// of each option, as it would be given on the command line, to its current
+// value. The values of the sensitive options, such as passwords, are redacted
+// if set.
+func configDump(cfg interface{}) map[string]string {
+	dump := make(map[string]string)
+	dumpConfigStruct(reflect.ValueOf(cfg), "", dump)
+	return dump
+}
+
+// dumpConfigStruct adds the options of the passed struct value to the dump,
+// recursing into the option groups it contains.
+func dumpConfigStruct(v reflect.Value, prefix string, dump map[string]string) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			// Unexported fields can't be set through options.
+			continue
+		}
+
+		// Groups nest their options under their namespace, if any.
+		if _, ok := field.Tag.Lookup("group"); ok {
+			groupPrefix := prefix
+			if ns := field.Tag.Get("namespace"); ns != "" {
+				groupPrefix += ns + "."
+			}
+			dumpConfigStruct(v.Field(i), groupPrefix, dump)
+			continue
+		}
+
+		name := field.Tag.Get("long")
+		if name == "" {
+			// Embedded structs may contribute options without
+			// being a group.
+			if field.Anonymous {
+				dumpConfigStruct(v.Field(i), prefix, dump)
+			}
+			continue
+		}
+
+		value := v.Field(i)
+		for value.Kind() == reflect.Ptr && !value.IsNil() {
+			value = value.Elem()
+		}
+
+		switch {
+		case value.Kind() == reflect.Ptr:
+			dump[prefix+name] = ""
+
+		case isSensitiveConfigOption(field) && !value.IsZero():
+			dump[prefix+name] = redactedConfigValue
+
+		default:
+			dump[prefix+name] = fmt.Sprint(value.Interface())
+		}
+	}
+}
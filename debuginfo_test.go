@@ -0,0 +1,72 @@
+// +build !rpctest
+
+package dcrlnd
+
+import (
+	"reflect"
+	"testing"
+)
+
+type testDumpGroup struct {
+	Enabled bool   `long:"enabled" description:"enable the group"`
+	Secret  string `long:"secret" description:"secret" default-mask:"-"`
+}
+
+type testDumpConfig struct {
+	RPCUser  string         `long:"rpcuser" description:"rpc user"`
+	RPCPass  string         `long:"rpcpass" description:"rpc password"`
+	DebugLvl string         `short:"d" long:"debuglevel" description:"level"`
+	Peers    []string       `long:"addpeer" description:"peers"`
+	Timeout  *uint32        `long:"timeout" description:"timeout"`
+	Group    *testDumpGroup `group:"grp" namespace:"grp"`
+	Other    testDumpGroup  `group:"Other"`
+
+	internal string
+}
+
+// TestConfigDump asserts that the config dump holds every option by its full
+// name, with the values of the sensitive options redacted when set.
+func TestConfigDump(t *testing.T) {
+	t.Parallel()
+
+	timeout := uint32(30)
+	cfg := &testDumpConfig{
+		RPCUser:  "user",
+		DebugLvl: "info",
+		Peers:    []string{"a", "b"},
+		Timeout:  &timeout,
+		Group: &testDumpGroup{
+			Enabled: true,
+			Secret:  "hunter2",
+		},
+		internal: "hidden",
+	}
+
+	expected := map[string]string{
+		"rpcuser":     redactedConfigValue,
+		"rpcpass":     "",
+		"debuglevel":  "info",
+		"addpeer":     "[a b]",
+		"timeout":     "30",
+		"grp.enabled": "true",
+		"grp.secret":  redactedConfigValue,
+		"enabled":     "false",
+		"secret":      "",
+	}
+
+	dump := configDump(cfg)
+	if !reflect.DeepEqual(dump, expected) {
+		t.Fatalf("expected dump %v, got %v", expected, dump)
+	}
+
+	// Groups that aren't set don't contribute any option.
+	cfg.Group = nil
+	cfg.Timeout = nil
+	dump = configDump(cfg)
+	if _, ok := dump["grp.enabled"]; ok {
+		t.Fatal("expected no option of an unset group")
+	}
+	if dump["timeout"] != "" {
+		t.Fatalf("expected empty unset timeout, got %v", dump["timeout"])
+	}
+}
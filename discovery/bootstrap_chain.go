@@ -0,0 +1,176 @@
+package discovery
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/decred/dcrlnd/autopilot"
+	"github.com/decred/dcrlnd/lnwire"
+)
+
+// BootstrapAddr is the address of a peer sampled by a BootstrapperChain, along
+// with the name of the bootstrapper it was sampled from.
+type BootstrapAddr struct {
+	*lnwire.NetAddress
+
+	// Source is the name of the bootstrapper the address was sampled
+	// from.
+	Source string
+}
+
+// bootstrapperHealth tracks the outcome of the connection attempts to the
+// addresses sampled from a bootstrapper.
+type bootstrapperHealth struct {
+	successes uint32
+	failures  uint32
+}
+
+// score returns the estimated probability that an address sampled from the
+// bootstrapper results in a connection. Bootstrappers without any recorded
+// attempt start with a score of 0.5.
+func (h *bootstrapperHealth) score() float64 {
+	return float64(h.successes+1) / float64(h.successes+h.failures+2)
+}
+
+// BootstrapperChain queries a chain of NetworkPeerBootstrappers, such as DNS
+// seeds, the channel graph and a static set of peers, to sample the addresses
+// of peers to connect to. Each bootstrapper is scored according to the outcome
+// of the connections to the addresses it returned, so that the healthiest
+// bootstrappers are queried first.
+type BootstrapperChain struct {
+	bootstrappers []NetworkPeerBootstrapper
+
+	health map[string]*bootstrapperHealth
+	mu     sync.Mutex
+}
+
+// NewBootstrapperChain returns a new chain of the passed bootstrappers.
+func NewBootstrapperChain(
+	bootstrappers ...NetworkPeerBootstrapper) *BootstrapperChain {
+
+	health := make(map[string]*bootstrapperHealth, len(bootstrappers))
+	for _, bootstrapper := range bootstrappers {
+		health[bootstrapper.Name()] = &bootstrapperHealth{}
+	}
+
+	return &BootstrapperChain{
+		bootstrappers: bootstrappers,
+		health:        health,
+	}
+}
+
+// SampleNodeAddrs queries the bootstrappers of the chain, from the healthiest
+// to the least healthy, until numAddrs peer addresses have been sampled.
+// Bootstrappers with equal scores are queried in random order. The nodes of
+// the ignore set, as well as the nodes already sampled from another
+// bootstrapper, are skipped. A bootstrapper that fails to return any address
+// sees its score lowered.
+func (c *BootstrapperChain) SampleNodeAddrs(ctx context.Context,
+	numAddrs uint32,
+	ignore map[autopilot.NodeID]struct{}) ([]*BootstrapAddr, error) {
+
+	skip := make(map[autopilot.NodeID]struct{}, len(ignore))
+	for nID := range ignore {
+		skip[nID] = struct{}{}
+	}
+
+	var addrs []*BootstrapAddr
+	for _, bootstrapper := range c.orderedBootstrappers() {
+		// If we already have enough addresses, then we can exit early
+		// w/o querying the additional bootstrappers.
+		if uint32(len(addrs)) >= numAddrs {
+			break
+		}
+
+		name := bootstrapper.Name()
+		log.Infof("Attempting to bootstrap with: %v (score %.2f)", name,
+			c.Score(name))
+
+		numAddrsLeft := numAddrs - uint32(len(addrs))
+		netAddrs, err := bootstrapper.SampleNodeAddrs(
+			ctx, numAddrsLeft, skip,
+		)
+		if err != nil {
+			log.Errorf("Unable to query bootstrapper %v: %v", name,
+				err)
+		}
+
+		var numSampled int
+		for _, netAddr := range netAddrs {
+			nID := autopilot.NewNodeID(netAddr.IdentityKey)
+			if _, ok := skip[nID]; ok {
+				continue
+			}
+			skip[nID] = struct{}{}
+
+			addrs = append(addrs, &BootstrapAddr{
+				NetAddress: netAddr,
+				Source:     name,
+			})
+			numSampled++
+		}
+
+		if numSampled == 0 {
+			c.ReportConnection(name, false)
+		}
+	}
+
+	if len(addrs) == 0 {
+		return nil, ErrNoAddressesFound
+	}
+
+	log.Infof("Obtained %v addrs to bootstrap network with", len(addrs))
+
+	return addrs, nil
+}
+
+// orderedBootstrappers returns the bootstrappers of the chain ordered from the
+// healthiest to the least healthy, those with equal scores being shuffled.
+func (c *BootstrapperChain) orderedBootstrappers() []NetworkPeerBootstrapper {
+	bootstrappers := shuffleBootstrappers(c.bootstrappers)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	sort.SliceStable(bootstrappers, func(i, j int) bool {
+		iHealth := c.health[bootstrappers[i].Name()]
+		jHealth := c.health[bootstrappers[j].Name()]
+		return iHealth.score() > jHealth.score()
+	})
+
+	return bootstrappers
+}
+
+// ReportConnection records the outcome of a connection attempt to an address
+// sampled from the bootstrapper of the given name.
+func (c *BootstrapperChain) ReportConnection(source string, success bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	health, ok := c.health[source]
+	if !ok {
+		return
+	}
+
+	if success {
+		health.successes++
+	} else {
+		health.failures++
+	}
+}
+
+// Score returns the health score of the bootstrapper of the given name, the
+// estimated probability that an address sampled from it results in a
+// connection.
+func (c *BootstrapperChain) Score(source string) float64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	health, ok := c.health[source]
+	if !ok {
+		return 0
+	}
+
+	return health.score()
+}
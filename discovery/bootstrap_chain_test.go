@@ -0,0 +1,173 @@
+package discovery
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v2"
+	"github.com/decred/dcrlnd/autopilot"
+	"github.com/decred/dcrlnd/lnwire"
+)
+
+// mockBootstrapper is a NetworkPeerBootstrapper returning a fixed set of
+// addresses.
+type mockBootstrapper struct {
+	name  string
+	addrs []*lnwire.NetAddress
+}
+
+func (m *mockBootstrapper) SampleNodeAddrs(_ context.Context, numAddrs uint32,
+	ignore map[autopilot.NodeID]struct{}) ([]*lnwire.NetAddress, error) {
+
+	var addrs []*lnwire.NetAddress
+	for _, addr := range m.addrs {
+		if uint32(len(addrs)) >= numAddrs {
+			break
+		}
+		if _, ok := ignore[autopilot.NewNodeID(addr.IdentityKey)]; ok {
+			continue
+		}
+		addrs = append(addrs, addr)
+	}
+
+	return addrs, nil
+}
+
+func (m *mockBootstrapper) Name() string {
+	return m.name
+}
+
+func newTestBootstrapAddr(t *testing.T) *lnwire.NetAddress {
+	t.Helper()
+
+	priv, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+
+	return &lnwire.NetAddress{
+		IdentityKey: priv.PubKey(),
+		Address:     &net.TCPAddr{IP: net.ParseIP("1.2.3.4"), Port: 9735},
+	}
+}
+
+// TestBootstrapperChainScoring asserts that the bootstrappers of the chain are
+// queried from the healthiest to the least healthy, that the sampled addresses
+// are attributed to their source and that duplicate nodes are skipped.
+func TestBootstrapperChainScoring(t *testing.T) {
+	t.Parallel()
+
+	shared := newTestBootstrapAddr(t)
+	good := &mockBootstrapper{
+		name:  "good",
+		addrs: []*lnwire.NetAddress{shared, newTestBootstrapAddr(t)},
+	}
+	bad := &mockBootstrapper{
+		name:  "bad",
+		addrs: []*lnwire.NetAddress{shared, newTestBootstrapAddr(t)},
+	}
+	empty := &mockBootstrapper{
+		name: "empty",
+	}
+
+	chain := NewBootstrapperChain(bad, empty, good)
+
+	// Give the good bootstrapper a better score than the bad one.
+	chain.ReportConnection("good", true)
+	chain.ReportConnection("bad", false)
+	if chain.Score("good") <= chain.Score("bad") {
+		t.Fatalf("expected score of good bootstrapper %v to exceed "+
+			"score of bad bootstrapper %v", chain.Score("good"),
+			chain.Score("bad"))
+	}
+
+	// Requesting two addresses must only query the good bootstrapper.
+	addrs, err := chain.SampleNodeAddrs(
+		context.Background(), 2, map[autopilot.NodeID]struct{}{},
+	)
+	if err != nil {
+		t.Fatalf("unable to sample addrs: %v", err)
+	}
+	if len(addrs) != 2 {
+		t.Fatalf("expected 2 addrs, got %v", len(addrs))
+	}
+	for _, addr := range addrs {
+		if addr.Source != "good" {
+			t.Fatalf("expected addr from good bootstrapper, got "+
+				"%v", addr.Source)
+		}
+	}
+
+	// Requesting more addresses must query all bootstrappers, skipping the
+	// node already sampled from the good one, and lower the score of the
+	// empty bootstrapper.
+	emptyScore := chain.Score("empty")
+	addrs, err = chain.SampleNodeAddrs(
+		context.Background(), 10, map[autopilot.NodeID]struct{}{},
+	)
+	if err != nil {
+		t.Fatalf("unable to sample addrs: %v", err)
+	}
+	if len(addrs) != 3 {
+		t.Fatalf("expected 3 addrs, got %v", len(addrs))
+	}
+	if addrs[2].Source != "bad" || addrs[2].NetAddress != bad.addrs[1] {
+		t.Fatalf("expected last addr to be the unique addr of the "+
+			"bad bootstrapper, got %v from %v", addrs[2],
+			addrs[2].Source)
+	}
+	if chain.Score("empty") >= emptyScore {
+		t.Fatalf("expected score of empty bootstrapper to be lowered")
+	}
+
+	// Ignoring all nodes must result in no addresses.
+	ignore := make(map[autopilot.NodeID]struct{})
+	for _, addr := range addrs {
+		ignore[autopilot.NewNodeID(addr.IdentityKey)] = struct{}{}
+	}
+	_, err = chain.SampleNodeAddrs(context.Background(), 10, ignore)
+	if err != ErrNoAddressesFound {
+		t.Fatalf("expected ErrNoAddressesFound, got %v", err)
+	}
+}
+
+// TestStaticBootstrapper asserts that the static bootstrapper samples its
+// addresses while skipping the ignored nodes.
+func TestStaticBootstrapper(t *testing.T) {
+	t.Parallel()
+
+	addrs := []*lnwire.NetAddress{
+		newTestBootstrapAddr(t), newTestBootstrapAddr(t),
+		newTestBootstrapAddr(t),
+	}
+	bootstrapper := NewStaticBootstrapper(addrs)
+
+	ignore := map[autopilot.NodeID]struct{}{
+		autopilot.NewNodeID(addrs[0].IdentityKey): {},
+	}
+	sampled, err := bootstrapper.SampleNodeAddrs(
+		context.Background(), 10, ignore,
+	)
+	if err != nil {
+		t.Fatalf("unable to sample addrs: %v", err)
+	}
+	if len(sampled) != 2 {
+		t.Fatalf("expected 2 addrs, got %v", len(sampled))
+	}
+	for _, addr := range sampled {
+		if addr == addrs[0] {
+			t.Fatalf("ignored addr %v was sampled", addr)
+		}
+	}
+
+	sampled, err = bootstrapper.SampleNodeAddrs(
+		context.Background(), 1, nil,
+	)
+	if err != nil {
+		t.Fatalf("unable to sample addrs: %v", err)
+	}
+	if len(sampled) != 1 {
+		t.Fatalf("expected 1 addr, got %v", len(sampled))
+	}
+}
@@ -539,3 +539,57 @@ search:
 func (d *DNSSeedBootstrapper) Name() string {
 	return fmt.Sprintf("BOLT-0010 DNS Seed: %v", d.dnsSeeds)
 }
+
+// StaticBootstrapper is an implementation of the NetworkPeerBootstrapper
+// interface which samples the addresses of a static set of peers, such as the
+// ones configured by the user.
+type StaticBootstrapper struct {
+	addrs []*lnwire.NetAddress
+}
+
+// A compile time assertion to ensure that StaticBootstrapper meets the
+// NetworkPeerBootstrapper interface.
+var _ NetworkPeerBootstrapper = (*StaticBootstrapper)(nil)
+
+// NewStaticBootstrapper returns a new instance of the StaticBootstrapper
+// sampling the passed set of peer addresses.
+func NewStaticBootstrapper(addrs []*lnwire.NetAddress) NetworkPeerBootstrapper {
+	return &StaticBootstrapper{
+		addrs: addrs,
+	}
+}
+
+// SampleNodeAddrs uniformly samples a set of specified address from the
+// network peer bootstrapper source. The num addrs field passed in denotes how
+// many valid peer addresses to return.
+//
+// NOTE: Part of the NetworkPeerBootstrapper interface.
+func (s *StaticBootstrapper) SampleNodeAddrs(_ context.Context,
+	numAddrs uint32,
+	ignore map[autopilot.NodeID]struct{}) ([]*lnwire.NetAddress, error) {
+
+	var addrs []*lnwire.NetAddress
+	for _, i := range prand.Perm(len(s.addrs)) {
+		if uint32(len(addrs)) >= numAddrs {
+			break
+		}
+
+		addr := s.addrs[i]
+		nID := autopilot.NewNodeID(addr.IdentityKey)
+		if _, ok := ignore[nID]; ok {
+			continue
+		}
+
+		addrs = append(addrs, addr)
+	}
+
+	return addrs, nil
+}
+
+// Name returns a human readable string which names the concrete
+// implementation of the NetworkPeerBootstrapper.
+//
+// NOTE: Part of the NetworkPeerBootstrapper interface.
+func (s *StaticBootstrapper) Name() string {
+	return "Static Peers"
+}
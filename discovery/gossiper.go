@@ -230,6 +230,44 @@ type Config struct {
 	// This prevents ranges with old start times from causing us to dump the
 	// graph on connect.
 	IgnoreHistoricalFilters bool
+
+	// PinnedSyncers is a set of peers that will always transition to
+	// ActiveSync upon connection. These peers will never transition to
+	// PassiveSync.
+	PinnedSyncers map[route.Vertex]struct{}
+
+	// PassiveSyncers is a set of peers that will always remain in
+	// PassiveSync, and will never be chosen to become active syncers.
+	PassiveSyncers map[route.Vertex]struct{}
+
+	// MsgRateBytes is the maximum number of bytes of channel and node
+	// announcements, shared across all peers, that we'll send out per
+	// second. A value of zero disables the bandwidth budget.
+	MsgRateBytes uint64
+
+	// MsgBurstBytes is the maximum number of bytes of channel and node
+	// announcements that can be sent out at once.
+	MsgBurstBytes uint64
+
+	// MaxQueryChanIDs is the maximum number of channels of a single
+	// QueryShortChanIDs message that we'll reply with. A value of zero
+	// uses DefaultMaxQueryChanIDs.
+	MaxQueryChanIDs int32
+
+	// QueryReplyMsgRate is the number of messages per second we'll send to
+	// each peer in reply to its queries once its QueryReplyMsgBurst has
+	// been exhausted. A value of zero uses DefaultQueryReplyMsgRate.
+	QueryReplyMsgRate uint32
+
+	// QueryReplyMsgBurst is the number of messages we'll send to each peer
+	// in reply to its queries before throttling them. A value of zero uses
+	// DefaultQueryReplyMsgBurst.
+	QueryReplyMsgBurst uint32
+
+	// MaxQueryReplyDelay is the maximum delay we'll apply to our replies
+	// to a peer that keeps exceeding its query rate. A value of zero uses
+	// DefaultMaxQueryReplyDelay.
+	MaxQueryReplyDelay time.Duration
 }
 
 // AuthenticatedGossiper is a subsystem which is responsible for receiving
@@ -338,6 +376,14 @@ func New(cfg Config, selfKey *secp256k1.PublicKey) *AuthenticatedGossiper {
 			HistoricalSyncTicker:    cfg.HistoricalSyncTicker,
 			NumActiveSyncers:        cfg.NumActiveSyncers,
 			IgnoreHistoricalFilters: cfg.IgnoreHistoricalFilters,
+			PinnedSyncers:           cfg.PinnedSyncers,
+			PassiveSyncers:          cfg.PassiveSyncers,
+			MsgRateBytes:            cfg.MsgRateBytes,
+			MsgBurstBytes:           cfg.MsgBurstBytes,
+			MaxQueryChanIDs:         cfg.MaxQueryChanIDs,
+			QueryReplyMsgRate:       cfg.QueryReplyMsgRate,
+			QueryReplyMsgBurst:      cfg.QueryReplyMsgBurst,
+			MaxQueryReplyDelay:      cfg.MaxQueryReplyDelay,
 		}),
 	}
 
@@ -1515,6 +1561,8 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 			return nil
 		}
 
+		d.syncMgr.recordNodeAnn()
+
 		// In order to ensure we don't leak unadvertised nodes, we'll
 		// make a quick check to ensure this node intends to publicly
 		// advertise itself to the network.
@@ -1564,6 +1612,17 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 			return nil
 		}
 
+		// Alias short channel IDs are only meaningful to the node that
+		// allocated them, so they're never accepted from the network.
+		if nMsg.isRemote && msg.ShortChannelID.IsAlias() {
+			err := fmt.Errorf("ignoring ChannelAnnouncement for "+
+				"alias short_chan_id=%v", msg.ShortChannelID)
+			log.Debugf(err.Error())
+
+			nMsg.err <- err
+			return nil
+		}
+
 		// If the advertised inclusionary block is beyond our knowledge
 		// of the chain tip, then we'll put the announcement in limbo
 		// to be fully verified once we advance forward in the chain.
@@ -1705,6 +1764,8 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 			return nil
 		}
 
+		d.syncMgr.recordChannelAnn()
+
 		// If we earlier received any ChannelUpdates for this channel,
 		// we can now process them, as the channel is added to the
 		// graph.
@@ -1791,6 +1852,17 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 		blockHeight := msg.ShortChannelID.BlockHeight
 		shortChanID := msg.ShortChannelID.ToUint64()
 
+		// Alias short channel IDs are only meaningful to the node that
+		// allocated them, so they're never accepted from the network.
+		if nMsg.isRemote && msg.ShortChannelID.IsAlias() {
+			err := fmt.Errorf("ignoring ChannelUpdate for alias "+
+				"short_chan_id=%v", shortChanID)
+			log.Debugf(err.Error())
+
+			nMsg.err <- err
+			return nil
+		}
+
 		// If the advertised inclusionary block is beyond our knowledge
 		// of the chain tip, then we'll put the announcement in limbo
 		// to be fully verified once we advance forward in the chain.
@@ -1986,7 +2058,11 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 		// supposed to be announced to the greater network. However,
 		// our channel counter party will need to be given the update,
 		// so we'll try sending the update directly to the remote peer.
-		if !nMsg.isRemote && chanInfo.AuthProof == nil {
+		// Updates for alias short channel IDs are kept to ourselves, as
+		// the remote peer doesn't know the channel by our alias.
+		if !nMsg.isRemote && chanInfo.AuthProof == nil &&
+			!msg.ShortChannelID.IsAlias() {
+
 			// Get our peer's public key.
 			remotePubKey := remotePubFromChanInfo(
 				chanInfo, msg.ChannelFlags,
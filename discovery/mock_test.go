@@ -63,6 +63,9 @@ func (p *mockPeer) LocalGlobalFeatures() *lnwire.FeatureVector {
 func (p *mockPeer) RemoteGlobalFeatures() *lnwire.FeatureVector {
 	return nil
 }
+func (p *mockPeer) RemoteLocalFeatures() *lnwire.FeatureVector {
+	return nil
+}
 
 // mockMessageStore is an in-memory implementation of the MessageStore interface
 // used for the gossiper's unit tests.
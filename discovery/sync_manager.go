@@ -1,6 +1,8 @@
 package discovery
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"sync"
 	"sync/atomic"
@@ -11,6 +13,7 @@ import (
 	"github.com/decred/dcrlnd/lnwire"
 	"github.com/decred/dcrlnd/routing/route"
 	"github.com/decred/dcrlnd/ticker"
+	"golang.org/x/time/rate"
 )
 
 const (
@@ -89,6 +92,72 @@ type SyncManagerCfg struct {
 	// This prevents ranges with old start times from causing us to dump the
 	// graph on connect.
 	IgnoreHistoricalFilters bool
+
+	// PinnedSyncers is a set of peers that will always transition to
+	// ActiveSync upon connection. These peers will never transition to
+	// PassiveSync and don't count towards NumActiveSyncers.
+	PinnedSyncers map[route.Vertex]struct{}
+
+	// PassiveSyncers is a set of peers that will always remain in
+	// PassiveSync. These peers will never be chosen to become active
+	// syncers, nor to be rotated with one.
+	PassiveSyncers map[route.Vertex]struct{}
+
+	// MsgRateBytes is the maximum number of bytes of channel and node
+	// announcements, shared across all peers, that we'll send out per
+	// second. A value of zero disables the bandwidth budget.
+	MsgRateBytes uint64
+
+	// MsgBurstBytes is the maximum number of bytes of channel and node
+	// announcements that can be sent out at once when the bandwidth budget
+	// hasn't been used for a while. It must be at least as large as the
+	// largest possible message.
+	MsgBurstBytes uint64
+
+	// MaxQueryChanIDs is the maximum number of channels of a single
+	// QueryShortChanIDs message that we'll reply with. A value of zero
+	// uses DefaultMaxQueryChanIDs.
+	MaxQueryChanIDs int32
+
+	// QueryReplyMsgRate is the number of messages per second we'll send to
+	// each peer in reply to its queries once its QueryReplyMsgBurst has
+	// been exhausted. A value of zero uses DefaultQueryReplyMsgRate.
+	QueryReplyMsgRate uint32
+
+	// QueryReplyMsgBurst is the number of messages we'll send to each peer
+	// in reply to its queries before throttling them. A value of zero uses
+	// DefaultQueryReplyMsgBurst.
+	QueryReplyMsgBurst uint32
+
+	// MaxQueryReplyDelay is the maximum delay we'll apply to our replies
+	// to a peer that keeps exceeding its query rate. A value of zero uses
+	// DefaultMaxQueryReplyDelay.
+	MaxQueryReplyDelay time.Duration
+}
+
+// GraphSyncStatus describes the progress of the initial historical sync, which
+// is performed to ingest as much of the public graph as possible before
+// relying on it for path finding.
+type GraphSyncStatus struct {
+	// Synced indicates whether the initial historical sync has completed.
+	Synced bool
+
+	// StartTime is the time at which the initial historical sync started.
+	// It is zero if no sync has been attempted yet, as no peers have
+	// connected.
+	StartTime time.Time
+
+	// EndTime is the time at which the initial historical sync completed.
+	// It is zero if the sync hasn't completed yet.
+	EndTime time.Time
+
+	// NumChannels is the number of new channels added to the graph while
+	// the initial historical sync was in progress.
+	NumChannels uint32
+
+	// NumNodes is the number of node announcements added to the graph
+	// while the initial historical sync was in progress.
+	NumNodes uint32
 }
 
 // SyncManager is a subsystem of the gossiper that manages the gossip syncers
@@ -124,8 +193,7 @@ type SyncManager struct {
 	// GossipSyncers for disconnected peers.
 	staleSyncers chan *staleSyncer
 
-	// syncersMu guards the read and write access to the activeSyncers and
-	// inactiveSyncers maps below.
+	// syncersMu guards the read and write access to the syncer maps below.
 	syncersMu sync.Mutex
 
 	// activeSyncers is the set of all syncers for which we are currently
@@ -137,6 +205,31 @@ type SyncManager struct {
 	// currently receiving new graph updates from.
 	inactiveSyncers map[route.Vertex]*GossipSyncer
 
+	// pinnedActiveSyncers is the set of all syncers which are pinned into
+	// an active sync. Pinned active syncers are never rotated and don't
+	// count towards NumActiveSyncers.
+	pinnedActiveSyncers map[route.Vertex]*GossipSyncer
+
+	// fixedPassiveSyncers is the set of all syncers which are configured
+	// to never become active. They are never considered as candidates for
+	// rotation.
+	fixedPassiveSyncers map[route.Vertex]*GossipSyncer
+
+	// msgLimiter is the bandwidth budget shared by all syncers for sending
+	// out channel and node announcements. It is nil if no budget was
+	// configured.
+	msgLimiter *rate.Limiter
+
+	// syncStatusMtx guards syncStatus and syncStatusChanged.
+	syncStatusMtx sync.Mutex
+
+	// syncStatus is the progress of the initial historical sync.
+	syncStatus GraphSyncStatus
+
+	// syncStatusChanged is closed, and replaced, every time the initial
+	// historical sync starts or completes.
+	syncStatusChanged chan struct{}
+
 	wg   sync.WaitGroup
 	quit chan struct{}
 }
@@ -150,9 +243,28 @@ func newSyncManager(cfg *SyncManagerCfg) *SyncManager {
 		activeSyncers: make(
 			map[route.Vertex]*GossipSyncer, cfg.NumActiveSyncers,
 		),
-		inactiveSyncers: make(map[route.Vertex]*GossipSyncer),
-		quit:            make(chan struct{}),
+		inactiveSyncers:     make(map[route.Vertex]*GossipSyncer),
+		pinnedActiveSyncers: make(map[route.Vertex]*GossipSyncer),
+		fixedPassiveSyncers: make(map[route.Vertex]*GossipSyncer),
+		msgLimiter:          newMsgLimiter(cfg),
+		syncStatusChanged:   make(chan struct{}),
+		quit:                make(chan struct{}),
+	}
+}
+
+// newMsgLimiter creates the rate limiter enforcing the bandwidth budget for
+// announcements described by the given config, or nil if there is none.
+func newMsgLimiter(cfg *SyncManagerCfg) *rate.Limiter {
+	if cfg.MsgRateBytes == 0 {
+		return nil
+	}
+
+	burst := cfg.MsgBurstBytes
+	if burst < lnwire.MaxMessagePayload {
+		burst = lnwire.MaxMessagePayload
 	}
+
+	return rate.NewLimiter(rate.Limit(cfg.MsgRateBytes), int(burst))
 }
 
 // Start starts the SyncManager in order to properly carry out its duties.
@@ -175,6 +287,12 @@ func (m *SyncManager) Stop() {
 		for _, syncer := range m.activeSyncers {
 			syncer.Stop()
 		}
+		for _, syncer := range m.pinnedActiveSyncers {
+			syncer.Stop()
+		}
+		for _, syncer := range m.fixedPassiveSyncers {
+			syncer.Stop()
+		}
 	})
 }
 
@@ -231,16 +349,41 @@ func (m *SyncManager) syncerHandler() {
 			attemptHistoricalSync := false
 
 			m.syncersMu.Lock()
-			switch {
+
 			// Regardless of whether the initial historical sync
 			// has completed, we'll re-trigger a historical sync if
 			// we no longer have any syncers. This might be
 			// necessary if we lost all our peers at one point, and
 			// now we finally have one again.
-			case len(m.activeSyncers) == 0 &&
-				len(m.inactiveSyncers) == 0:
-
+			if len(m.gossipSyncers()) == 0 {
 				attemptHistoricalSync = true
+			}
+
+			_, isPinned := m.cfg.PinnedSyncers[s.cfg.peerPub]
+			_, isFixedPassive := m.cfg.PassiveSyncers[s.cfg.peerPub]
+
+			// pinnedHistoricalSync determines whether we should
+			// attempt a historical sync with a pinned peer, which
+			// we always do unless it's already the initial one.
+			pinnedHistoricalSync := false
+
+			switch {
+			// Pinned syncers are always made active right away,
+			// and don't count towards our active syncer limit.
+			case isPinned:
+				s.setSyncType(PinnedSync)
+				m.pinnedActiveSyncers[s.cfg.peerPub] = s
+				pinnedHistoricalSync = !attemptHistoricalSync
+
+			// Peers we were told to only sync passively with are
+			// kept apart so they're never made active.
+			case isFixedPassive:
+				s.setSyncType(PassiveSync)
+				m.fixedPassiveSyncers[s.cfg.peerPub] = s
+
+			// If this is the first syncer, we'll declare it as
+			// passive until the initial historical sync completes.
+			case attemptHistoricalSync:
 				fallthrough
 
 			// If we've exceeded our total number of active syncers,
@@ -271,6 +414,22 @@ func (m *SyncManager) syncerHandler() {
 			// internal state has been updated.
 			close(newSyncer.doneChan)
 
+			// Pinned peers are trusted to have a good view of the
+			// graph, so we'll reconcile ours with theirs as soon
+			// as they connect.
+			if pinnedHistoricalSync {
+				log.Debugf("Attempting historical sync with "+
+					"pinned GossipSyncer(%x)",
+					s.cfg.peerPub)
+
+				if err := s.historicalSync(); err != nil {
+					log.Errorf("Unable to attempt "+
+						"historical sync with pinned "+
+						"GossipSyncer(%x): %v",
+						s.cfg.peerPub, err)
+				}
+			}
+
 			// We'll force a historical sync with the first peer we
 			// connect to, to ensure we get as much of the graph as
 			// possible.
@@ -344,8 +503,6 @@ func (m *SyncManager) syncerHandler() {
 			initialHistoricalSyncSignal = nil
 			m.markGraphSynced()
 
-			log.Debug("Initial historical sync completed")
-
 			// With the initial historical sync complete, we can
 			// begin receiving new graph updates at tip. We'll
 			// determine whether we can have any more active
@@ -414,12 +571,23 @@ func (m *SyncManager) createGossipSyncer(peer lnpeer.Peer) *GossipSyncer {
 		chunkSize:     encodingTypeToChunkSize[encoding],
 		batchSize:     requestBatchSize,
 		sendToPeer: func(msgs ...lnwire.Message) error {
+			msgs = m.allowMsgs(nodeID, msgs)
+			if len(msgs) == 0 {
+				return nil
+			}
 			return peer.SendMessageLazy(false, msgs...)
 		},
 		sendToPeerSync: func(msgs ...lnwire.Message) error {
+			if err := m.waitMsgs(msgs); err != nil {
+				return err
+			}
 			return peer.SendMessageLazy(true, msgs...)
 		},
 		ignoreHistoricalFilters: m.cfg.IgnoreHistoricalFilters,
+		maxQueryChanIDs:         m.cfg.MaxQueryChanIDs,
+		queryReplyMsgRate:       m.cfg.QueryReplyMsgRate,
+		queryReplyMsgBurst:      m.cfg.QueryReplyMsgBurst,
+		maxQueryReplyDelay:      m.cfg.MaxQueryReplyDelay,
 	})
 
 	// Gossip syncers are initialized by default in a PassiveSync type
@@ -430,6 +598,102 @@ func (m *SyncManager) createGossipSyncer(peer lnpeer.Peer) *GossipSyncer {
 	return s
 }
 
+// announcementSize returns the serialized size of the given message if it is
+// a channel or node announcement subject to the bandwidth budget, and zero
+// otherwise.
+func announcementSize(msg lnwire.Message) int {
+	switch msg.(type) {
+	case *lnwire.ChannelAnnouncement, *lnwire.ChannelUpdate,
+		*lnwire.NodeAnnouncement:
+
+	default:
+		return 0
+	}
+
+	var b bytes.Buffer
+	n, err := lnwire.WriteMessage(&b, msg, 0)
+	if err != nil {
+		return 0
+	}
+
+	return n
+}
+
+// allowMsgs filters out the announcements among the given messages that would
+// exceed the bandwidth budget. This is used for messages relayed to the peer
+// as part of the regular gossip flow, which must not block the gossiper.
+// Dropped announcements will eventually be rebroadcast or queried for by the
+// peer.
+func (m *SyncManager) allowMsgs(peer route.Vertex,
+	msgs []lnwire.Message) []lnwire.Message {
+
+	if m.msgLimiter == nil {
+		return msgs
+	}
+
+	allowed := msgs[:0:0]
+	var numDropped int
+	for _, msg := range msgs {
+		n := announcementSize(msg)
+		if n > 0 && !m.msgLimiter.AllowN(time.Now(), n) {
+			numDropped++
+			continue
+		}
+
+		allowed = append(allowed, msg)
+	}
+
+	if numDropped > 0 {
+		log.Debugf("Dropped %d announcements for peer=%x due to "+
+			"gossip bandwidth budget", numDropped, peer[:])
+	}
+
+	return allowed
+}
+
+// waitMsgs blocks until the bandwidth budget allows the announcements among
+// the given messages to be sent out. This is used for replies to the peer's
+// queries, which are expected to be answered in full.
+func (m *SyncManager) waitMsgs(msgs []lnwire.Message) error {
+	if m.msgLimiter == nil {
+		return nil
+	}
+
+	var total int
+	for _, msg := range msgs {
+		total += announcementSize(msg)
+	}
+	if total == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-m.quit:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	// WaitN fails if asked for more than the burst at once, so we'll wait
+	// for the budget in chunks.
+	burst := m.msgLimiter.Burst()
+	for total > 0 {
+		n := total
+		if n > burst {
+			n = burst
+		}
+		if err := m.msgLimiter.WaitN(ctx, n); err != nil {
+			return ErrSyncManagerExiting
+		}
+		total -= n
+	}
+
+	return nil
+}
+
 // removeGossipSyncer removes all internal references to the disconnected peer's
 // GossipSyncer and stops it. In the event of an active GossipSyncer being
 // disconnected, a passive GossipSyncer, if any, will take its place.
@@ -454,6 +718,17 @@ func (m *SyncManager) removeGossipSyncer(peer route.Vertex) {
 		return
 	}
 
+	// Pinned and fixed passive syncers don't count towards our active
+	// syncer limit, so there's nothing to replace.
+	if _, ok := m.pinnedActiveSyncers[peer]; ok {
+		delete(m.pinnedActiveSyncers, peer)
+		return
+	}
+	if _, ok := m.fixedPassiveSyncers[peer]; ok {
+		delete(m.fixedPassiveSyncers, peer)
+		return
+	}
+
 	// Otherwise, we'll need find a new one to replace it, if any.
 	delete(m.activeSyncers, peer)
 	newActiveSyncer := chooseRandomSyncer(
@@ -656,6 +931,14 @@ func (m *SyncManager) gossipSyncer(peer route.Vertex) (*GossipSyncer, bool) {
 	if ok {
 		return syncer, true
 	}
+	syncer, ok = m.pinnedActiveSyncers[peer]
+	if ok {
+		return syncer, true
+	}
+	syncer, ok = m.fixedPassiveSyncers[peer]
+	if ok {
+		return syncer, true
+	}
 	return nil, false
 }
 
@@ -668,7 +951,8 @@ func (m *SyncManager) GossipSyncers() map[route.Vertex]*GossipSyncer {
 
 // gossipSyncers returns all of the currently initialized gossip syncers.
 func (m *SyncManager) gossipSyncers() map[route.Vertex]*GossipSyncer {
-	numSyncers := len(m.inactiveSyncers) + len(m.activeSyncers)
+	numSyncers := len(m.inactiveSyncers) + len(m.activeSyncers) +
+		len(m.pinnedActiveSyncers) + len(m.fixedPassiveSyncers)
 	syncers := make(map[route.Vertex]*GossipSyncer, numSyncers)
 
 	for _, syncer := range m.inactiveSyncers {
@@ -677,20 +961,100 @@ func (m *SyncManager) gossipSyncers() map[route.Vertex]*GossipSyncer {
 	for _, syncer := range m.activeSyncers {
 		syncers[syncer.cfg.peerPub] = syncer
 	}
+	for _, syncer := range m.pinnedActiveSyncers {
+		syncers[syncer.cfg.peerPub] = syncer
+	}
+	for _, syncer := range m.fixedPassiveSyncers {
+		syncers[syncer.cfg.peerPub] = syncer
+	}
 
 	return syncers
 }
 
+// MsgBudget returns the number of bytes of channel and node announcements
+// that can be sent to all peers per second, and at once. Both are zero if no
+// bandwidth budget is enforced.
+func (m *SyncManager) MsgBudget() (uint64, uint64) {
+	if m.msgLimiter == nil {
+		return 0, 0
+	}
+
+	return m.cfg.MsgRateBytes, uint64(m.msgLimiter.Burst())
+}
+
 // markGraphSynced allows us to report that the initial historical sync has
 // completed.
 func (m *SyncManager) markGraphSynced() {
 	atomic.StoreInt32(&m.initialHistoricalSyncCompleted, 1)
+
+	m.syncStatusMtx.Lock()
+	defer m.syncStatusMtx.Unlock()
+
+	m.syncStatus.Synced = true
+	m.syncStatus.EndTime = time.Now()
+
+	log.Infof("Initial historical sync completed in %v, received %d "+
+		"channels and %d nodes",
+		m.syncStatus.EndTime.Sub(m.syncStatus.StartTime),
+		m.syncStatus.NumChannels, m.syncStatus.NumNodes)
+
+	m.notifySyncStatusChange()
 }
 
 // markGraphSyncing allows us to report that the initial historical sync is
 // still undergoing.
 func (m *SyncManager) markGraphSyncing() {
 	atomic.StoreInt32(&m.initialHistoricalSyncCompleted, 0)
+
+	m.syncStatusMtx.Lock()
+	defer m.syncStatusMtx.Unlock()
+
+	m.syncStatus = GraphSyncStatus{
+		StartTime: time.Now(),
+	}
+
+	m.notifySyncStatusChange()
+}
+
+// notifySyncStatusChange wakes up all callers waiting for the sync status to
+// change.
+//
+// NOTE: This method must be called with the syncStatusMtx held.
+func (m *SyncManager) notifySyncStatusChange() {
+	close(m.syncStatusChanged)
+	m.syncStatusChanged = make(chan struct{})
+}
+
+// recordChannelAnn accounts for a new channel added to the graph, if the
+// initial historical sync is in progress.
+func (m *SyncManager) recordChannelAnn() {
+	m.syncStatusMtx.Lock()
+	defer m.syncStatusMtx.Unlock()
+
+	if !m.syncStatus.StartTime.IsZero() && !m.syncStatus.Synced {
+		m.syncStatus.NumChannels++
+	}
+}
+
+// recordNodeAnn accounts for a node announcement added to the graph, if the
+// initial historical sync is in progress.
+func (m *SyncManager) recordNodeAnn() {
+	m.syncStatusMtx.Lock()
+	defer m.syncStatusMtx.Unlock()
+
+	if !m.syncStatus.StartTime.IsZero() && !m.syncStatus.Synced {
+		m.syncStatus.NumNodes++
+	}
+}
+
+// GraphSyncStatus returns the current progress of the initial historical
+// sync, along with a channel that is closed once the sync either starts again
+// or completes.
+func (m *SyncManager) GraphSyncStatus() (GraphSyncStatus, <-chan struct{}) {
+	m.syncStatusMtx.Lock()
+	defer m.syncStatusMtx.Unlock()
+
+	return m.syncStatus, m.syncStatusChanged
 }
 
 // IsGraphSynced determines whether we've completed our initial historical sync.
@@ -11,6 +11,7 @@ import (
 	"github.com/davecgh/go-spew/spew"
 	"github.com/decred/dcrlnd/lntest/wait"
 	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/routing/route"
 	"github.com/decred/dcrlnd/ticker"
 )
 
@@ -239,6 +240,77 @@ func TestSyncManagerInitialHistoricalSync(t *testing.T) {
 	assertNoMsgSent(t, extraPeer)
 }
 
+// TestSyncManagerGraphSyncStatus ensures that the sync status reflects the
+// progress of the initial historical sync, only accounts for announcements
+// received while it is in progress, and signals its completion.
+func TestSyncManagerGraphSyncStatus(t *testing.T) {
+	t.Parallel()
+
+	syncMgr := newTestSyncManager(1)
+
+	// No sync should have been attempted before any peer connects.
+	status, changed := syncMgr.GraphSyncStatus()
+	if status.Synced || !status.StartTime.IsZero() {
+		t.Fatalf("unexpected status before sync: %v", spew.Sdump(status))
+	}
+
+	// Announcements received outside of the initial historical sync
+	// should not be accounted for.
+	syncMgr.recordChannelAnn()
+
+	syncMgr.Start()
+	defer syncMgr.Stop()
+
+	// Connecting a peer should start the initial historical sync.
+	peer := randPeer(t, syncMgr.quit)
+	syncMgr.InitSyncState(peer)
+	assertMsgSent(t, peer, &lnwire.QueryChannelRange{
+		FirstBlockHeight: 0,
+		NumBlocks:        math.MaxUint32,
+	})
+
+	select {
+	case <-changed:
+	case <-time.After(time.Second):
+		t.Fatal("expected sync status change on sync start")
+	}
+
+	status, changed = syncMgr.GraphSyncStatus()
+	if status.Synced || status.StartTime.IsZero() {
+		t.Fatalf("unexpected status during sync: %v",
+			spew.Sdump(status))
+	}
+	if status.NumChannels != 0 {
+		t.Fatalf("expected no channels, got %d", status.NumChannels)
+	}
+
+	syncMgr.recordChannelAnn()
+	syncMgr.recordChannelAnn()
+	syncMgr.recordNodeAnn()
+
+	// Completing the sync should signal the change and freeze the counts.
+	s := assertSyncerExistence(t, syncMgr, peer)
+	assertTransitionToChansSynced(t, s, peer)
+
+	select {
+	case <-changed:
+	case <-time.After(time.Second):
+		t.Fatal("expected sync status change on sync completion")
+	}
+
+	syncMgr.recordNodeAnn()
+
+	status, _ = syncMgr.GraphSyncStatus()
+	if !status.Synced || status.EndTime.IsZero() {
+		t.Fatalf("unexpected status after sync: %v",
+			spew.Sdump(status))
+	}
+	if status.NumChannels != 2 || status.NumNodes != 1 {
+		t.Fatalf("expected 2 channels and 1 node, got %d and %d",
+			status.NumChannels, status.NumNodes)
+	}
+}
+
 // TestSyncManagerHistoricalSyncOnReconnect tests that the sync manager will
 // re-trigger a historical sync when a new peer connects after a historical
 // sync has completed, but we have lost all peers.
@@ -421,6 +493,174 @@ func TestSyncManagerWaitUntilInitialHistoricalSync(t *testing.T) {
 	}
 }
 
+// TestSyncManagerPinnedSyncers ensures that pinned syncers are always made
+// active upon connection regardless of the number of active syncers, perform a
+// historical sync, and are never rotated or replaced.
+func TestSyncManagerPinnedSyncers(t *testing.T) {
+	t.Parallel()
+
+	pinnedPeer := randPeer(t, nil)
+	syncMgr := newTestSyncManager(1)
+	syncMgr.cfg.PinnedSyncers = map[route.Vertex]struct{}{
+		pinnedPeer.PubKey(): {},
+	}
+	pinnedPeer.quit = syncMgr.quit
+	syncMgr.Start()
+	defer syncMgr.Stop()
+
+	// The first syncer registered always performs a historical sync.
+	activeSyncPeer := randPeer(t, syncMgr.quit)
+	syncMgr.InitSyncState(activeSyncPeer)
+	activeSyncer := assertSyncerExistence(t, syncMgr, activeSyncPeer)
+	assertTransitionToChansSynced(t, activeSyncer, activeSyncPeer)
+	assertActiveGossipTimestampRange(t, activeSyncPeer)
+	assertSyncerStatus(t, activeSyncer, chansSynced, ActiveSync)
+
+	// Even though we've reached our limit of active syncers, the pinned
+	// peer should be made active and perform a historical sync.
+	syncMgr.InitSyncState(pinnedPeer)
+	pinnedSyncer := assertSyncerExistence(t, syncMgr, pinnedPeer)
+	assertActiveGossipTimestampRange(t, pinnedPeer)
+	assertTransitionToChansSynced(t, pinnedSyncer, pinnedPeer)
+	assertSyncerStatus(t, pinnedSyncer, chansSynced, PinnedSync)
+
+	// Any further peer should be passive.
+	passiveSyncPeer := randPeer(t, syncMgr.quit)
+	syncMgr.InitSyncState(passiveSyncPeer)
+	passiveSyncer := assertSyncerExistence(t, syncMgr, passiveSyncPeer)
+	assertSyncerStatus(t, passiveSyncer, chansSynced, PassiveSync)
+
+	// Forcing a rotation should only rotate the regular active syncer with
+	// the passive one, leaving the pinned syncer untouched.
+	syncMgr.cfg.RotateTicker.(*ticker.Force).Force <- time.Time{}
+	assertActiveSyncerTransition(t, activeSyncer, activeSyncPeer)
+	assertPassiveSyncerTransition(t, passiveSyncer, passiveSyncPeer)
+	assertNoMsgSent(t, pinnedPeer)
+	assertSyncerStatus(t, pinnedSyncer, chansSynced, PinnedSync)
+
+	// Disconnecting the pinned peer shouldn't cause any passive syncer to
+	// take its place.
+	syncMgr.PruneSyncState(pinnedPeer.PubKey())
+	assertNoMsgSent(t, activeSyncPeer)
+	assertSyncerStatus(t, activeSyncer, chansSynced, PassiveSync)
+}
+
+// TestSyncManagerPassiveSyncers ensures that peers configured as passive
+// syncers are never made active, even when there's room for more active
+// syncers or an active syncer needs to be replaced.
+func TestSyncManagerPassiveSyncers(t *testing.T) {
+	t.Parallel()
+
+	passivePeer := randPeer(t, nil)
+	syncMgr := newTestSyncManager(2)
+	syncMgr.cfg.PassiveSyncers = map[route.Vertex]struct{}{
+		passivePeer.PubKey(): {},
+	}
+	passivePeer.quit = syncMgr.quit
+	syncMgr.Start()
+	defer syncMgr.Stop()
+
+	// The first syncer registered always performs a historical sync.
+	activeSyncPeer := randPeer(t, syncMgr.quit)
+	syncMgr.InitSyncState(activeSyncPeer)
+	activeSyncer := assertSyncerExistence(t, syncMgr, activeSyncPeer)
+	assertTransitionToChansSynced(t, activeSyncer, activeSyncPeer)
+	assertActiveGossipTimestampRange(t, activeSyncPeer)
+	assertSyncerStatus(t, activeSyncer, chansSynced, ActiveSync)
+
+	// Although we can still have another active syncer, the passive peer
+	// should remain passive.
+	syncMgr.InitSyncState(passivePeer)
+	passiveSyncer := assertSyncerExistence(t, syncMgr, passivePeer)
+	assertNoMsgSent(t, passivePeer)
+	assertSyncerStatus(t, passiveSyncer, chansSynced, PassiveSync)
+
+	// It also shouldn't be chosen as a rotation candidate, nor to replace
+	// the active syncer once its peer disconnects.
+	syncMgr.cfg.RotateTicker.(*ticker.Force).Force <- time.Time{}
+	assertNoMsgSent(t, activeSyncPeer)
+	assertSyncerStatus(t, activeSyncer, chansSynced, ActiveSync)
+
+	syncMgr.PruneSyncState(activeSyncPeer.PubKey())
+	assertNoMsgSent(t, passivePeer)
+	assertSyncerStatus(t, passiveSyncer, chansSynced, PassiveSync)
+}
+
+// TestSyncManagerMsgBudget ensures that announcements are subject to the
+// gossip bandwidth budget, while other messages are not.
+func TestSyncManagerMsgBudget(t *testing.T) {
+	t.Parallel()
+
+	syncMgr := newSyncManager(&SyncManagerCfg{
+		MsgRateBytes: 1,
+	})
+
+	update := &lnwire.ChannelUpdate{}
+	updateSize := announcementSize(update)
+	if updateSize == 0 {
+		t.Fatal("expected channel update to count towards the budget")
+	}
+	if announcementSize(&lnwire.GossipTimestampRange{}) != 0 {
+		t.Fatal("expected timestamp range to not count towards the " +
+			"budget")
+	}
+
+	// The burst defaults to the maximum message size, so only as many
+	// updates as fit within it should be allowed, with the remaining ones
+	// dropped.
+	numAllowed := lnwire.MaxMessagePayload / updateSize
+	msgs := []lnwire.Message{&lnwire.GossipTimestampRange{}}
+	for i := 0; i < numAllowed+5; i++ {
+		msgs = append(msgs, update)
+	}
+
+	var peer route.Vertex
+	allowed := syncMgr.allowMsgs(peer, msgs)
+	if len(allowed) != numAllowed+1 {
+		t.Fatalf("expected %d messages to be allowed, got %d",
+			numAllowed+1, len(allowed))
+	}
+	if _, ok := allowed[0].(*lnwire.GossipTimestampRange); !ok {
+		t.Fatalf("expected timestamp range to be allowed")
+	}
+
+	// Messages without announcements should go through immediately, while
+	// announcements over the budget should be waited for until the
+	// SyncManager exits.
+	err := syncMgr.waitMsgs([]lnwire.Message{&lnwire.GossipTimestampRange{}})
+	if err != nil {
+		t.Fatalf("unable to wait for messages: %v", err)
+	}
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- syncMgr.waitMsgs([]lnwire.Message{update})
+	}()
+
+	select {
+	case err := <-errChan:
+		t.Fatalf("expected to wait for budget, got %v", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	syncMgr.Stop()
+
+	select {
+	case err := <-errChan:
+		if err != ErrSyncManagerExiting {
+			t.Fatalf("expected ErrSyncManagerExiting, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected wait to be interrupted")
+	}
+
+	// Without a budget, all messages should be allowed.
+	noBudgetMgr := newSyncManager(&SyncManagerCfg{})
+	if len(noBudgetMgr.allowMsgs(peer, msgs)) != len(msgs) {
+		t.Fatal("expected all messages to be allowed without a budget")
+	}
+}
+
 // assertNoMsgSent is a helper function that ensures a peer hasn't sent any
 // messages.
 func assertNoMsgSent(t *testing.T, peer *mockPeer) {
@@ -40,6 +40,13 @@ const (
 	// They are started in a chansSynced state in order to accomplish their
 	// responsibilities above.
 	PassiveSync
+
+	// PinnedSync denotes an ActiveSync that doesn't count towards the
+	// default active syncer limits and is always active throughout the
+	// duration of the peer's connection. Each pinned syncer will begin by
+	// performing a historical sync to ensure we are well synchronized with
+	// their routing table.
+	PinnedSync
 )
 
 // String returns a human readable string describing the target SyncerType.
@@ -49,11 +56,24 @@ func (t SyncerType) String() string {
 		return "ActiveSync"
 	case PassiveSync:
 		return "PassiveSync"
+	case PinnedSync:
+		return "PinnedSync"
 	default:
 		return fmt.Sprintf("unknown sync type %d", t)
 	}
 }
 
+// IsActiveSync returns true if the SyncerType should set a GossipTimestampRange
+// allowing new gossip messages to be received from the peer.
+func (t SyncerType) IsActiveSync() bool {
+	switch t {
+	case ActiveSync, PinnedSync:
+		return true
+	default:
+		return false
+	}
+}
+
 // syncerState is an enum that represents the current state of the GossipSyncer.
 // As the syncer is a state machine, we'll gate our actions based off of the
 // current state and the next incoming message.
@@ -128,6 +148,30 @@ const (
 	// maxUndelayedQueryReplies queries.
 	DefaultDelayedQueryReplyInterval = 5 * time.Second
 
+	// DefaultMaxQueryChanIDs is the maximum number of channels of a single
+	// QueryShortChanIDs message that we'll reply with. Any channel beyond
+	// it is ignored.
+	DefaultMaxQueryChanIDs = 8000
+
+	// DefaultQueryReplyMsgRate is the number of messages per second we'll
+	// send to a peer in reply to its queries once its burst of reply
+	// messages has been exhausted.
+	DefaultQueryReplyMsgRate = 50
+
+	// DefaultQueryReplyMsgBurst is the number of messages we'll send to a
+	// peer in reply to its queries before starting to throttle them. It
+	// comfortably allows a full historical sync of the graph.
+	DefaultQueryReplyMsgBurst = 50000
+
+	// DefaultMaxQueryReplyDelay is the maximum delay we'll apply to the
+	// reply to a query from a peer that keeps exceeding its query rate.
+	DefaultMaxQueryReplyDelay = 5 * time.Minute
+
+	// queryBackoffThreshold is the number of consecutive queries a peer
+	// can send beyond its query rate before the delay of our replies
+	// starts doubling with each further query.
+	queryBackoffThreshold = 5
+
 	// chanRangeQueryBuffer is the number of blocks back that we'll go when
 	// asking the remote peer for their any channels they know of beyond
 	// our highest known channel ID.
@@ -222,6 +266,23 @@ type gossipSyncerCfg struct {
 	// maxUndelayedQueryReplies queries.
 	delayedQueryReplyInterval time.Duration
 
+	// maxQueryChanIDs is the maximum number of channels of a single
+	// QueryShortChanIDs message we'll reply with.
+	maxQueryChanIDs int32
+
+	// queryReplyMsgRate is the number of messages per second we'll send
+	// in reply to the queries of the remote peer once queryReplyMsgBurst
+	// has been exhausted.
+	queryReplyMsgRate uint32
+
+	// queryReplyMsgBurst is the number of messages we'll send in reply to
+	// the queries of the remote peer before throttling them.
+	queryReplyMsgBurst uint32
+
+	// maxQueryReplyDelay is the maximum delay applied to our replies when
+	// backing off from a peer that keeps exceeding its query rate.
+	maxQueryReplyDelay time.Duration
+
 	// noSyncChannels will prevent the GossipSyncer from spawning a
 	// channelGraphSyncer, meaning we will not try to reconcile unknown
 	// channels with the remote peer.
@@ -315,6 +376,19 @@ type GossipSyncer struct {
 	// number of queries.
 	rateLimiter *rate.Limiter
 
+	// replyMsgLimiter bounds the number of messages we send in reply to
+	// the queries of a peer, so that peers can't use us to download the
+	// graph over and over.
+	replyMsgLimiter *rate.Limiter
+
+	// throttledQueries is the number of consecutive queries from the
+	// remote peer whose reply was delayed by rateLimiter. It is used to
+	// progressively back off from peers that keep exceeding their query
+	// rate.
+	//
+	// NOTE: This MUST only be accessed by the replyHandler.
+	throttledQueries int
+
 	// syncedSignal is a channel that, if set, will be closed when the
 	// GossipSyncer reaches its terminal chansSynced state.
 	syncedSignal chan struct{}
@@ -349,9 +423,29 @@ func newGossipSyncer(cfg gossipSyncerCfg) *GossipSyncer {
 		interval, cfg.maxUndelayedQueryReplies,
 	)
 
+	// Likewise, apply the default limits on the size of our replies if
+	// none were specified.
+	if cfg.maxQueryChanIDs <= 0 {
+		cfg.maxQueryChanIDs = DefaultMaxQueryChanIDs
+	}
+	if cfg.queryReplyMsgRate == 0 {
+		cfg.queryReplyMsgRate = DefaultQueryReplyMsgRate
+	}
+	if cfg.queryReplyMsgBurst == 0 {
+		cfg.queryReplyMsgBurst = DefaultQueryReplyMsgBurst
+	}
+	if cfg.maxQueryReplyDelay <= 0 {
+		cfg.maxQueryReplyDelay = DefaultMaxQueryReplyDelay
+	}
+
+	replyMsgLimiter := rate.NewLimiter(
+		rate.Limit(cfg.queryReplyMsgRate), int(cfg.queryReplyMsgBurst),
+	)
+
 	return &GossipSyncer{
 		cfg:                cfg,
 		rateLimiter:        rateLimiter,
+		replyMsgLimiter:    replyMsgLimiter,
 		syncTransitionReqs: make(chan *syncTransitionReq),
 		historicalSyncReqs: make(chan *historicalSyncReq),
 		gossipMsgs:         make(chan lnwire.Message, 100),
@@ -527,7 +621,7 @@ func (g *GossipSyncer) channelGraphSyncer() {
 			// If we haven't yet sent out our update horizon, and
 			// we want to receive real-time channel updates, we'll
 			// do so now.
-			if g.localUpdateHorizon == nil && syncType == ActiveSync {
+			if g.localUpdateHorizon == nil && syncType.IsActiveSync() {
 				err := g.sendGossipTimestampRange(
 					time.Now(), math.MaxUint32,
 				)
@@ -772,8 +866,12 @@ func (g *GossipSyncer) replyPeerQueries(msg lnwire.Message) error {
 
 	// If we've already replied a handful of times, we will start to delay
 	// responses back to the remote peer. This can help prevent DOS attacks
-	// where the remote peer spams us endlessly.
+	// where the remote peer spams us endlessly. Peers that keep querying
+	// us regardless are backed off from progressively.
 	if delay > 0 {
+		g.throttledQueries++
+		delay = g.queryBackoff(delay)
+
 		log.Infof("GossipSyncer(%x): rate limiting gossip replies, "+
 			"responding in %s", g.cfg.peerPub[:], delay)
 
@@ -782,6 +880,8 @@ func (g *GossipSyncer) replyPeerQueries(msg lnwire.Message) error {
 		case <-g.quit:
 			return ErrGossipSyncerExiting
 		}
+	} else {
+		g.throttledQueries = 0
 	}
 
 	switch msg := msg.(type) {
@@ -801,6 +901,53 @@ func (g *GossipSyncer) replyPeerQueries(msg lnwire.Message) error {
 	}
 }
 
+// queryBackoff returns the delay to apply to our reply to a query that exceeded
+// the query rate of the remote peer, given the delay imposed by the rate
+// limiter. Once the peer exceeded its rate for more than queryBackoffThreshold
+// consecutive queries, the delay doubles with each further query, up to
+// maxQueryReplyDelay.
+func (g *GossipSyncer) queryBackoff(delay time.Duration) time.Duration {
+	excess := g.throttledQueries - queryBackoffThreshold
+	if excess <= 0 {
+		return delay
+	}
+
+	for i := 0; i < excess && delay < g.cfg.maxQueryReplyDelay; i++ {
+		delay *= 2
+	}
+	if delay > g.cfg.maxQueryReplyDelay {
+		delay = g.cfg.maxQueryReplyDelay
+	}
+
+	return delay
+}
+
+// throttleReplyMsgs charges the given number of reply messages against the
+// reply budget of the remote peer, waiting until the budget allows them to be
+// sent.
+func (g *GossipSyncer) throttleReplyMsgs(numMsgs int) error {
+	// A reply larger than the whole burst is charged as the burst, as it
+	// couldn't be reserved otherwise.
+	if numMsgs > g.replyMsgLimiter.Burst() {
+		numMsgs = g.replyMsgLimiter.Burst()
+	}
+
+	delay := g.replyMsgLimiter.ReserveN(time.Now(), numMsgs).Delay()
+	if delay <= 0 {
+		return nil
+	}
+
+	log.Infof("GossipSyncer(%x): reply budget exhausted, sending %d "+
+		"reply messages in %s", g.cfg.peerPub[:], numMsgs, delay)
+
+	select {
+	case <-time.After(delay):
+		return nil
+	case <-g.quit:
+		return ErrGossipSyncerExiting
+	}
+}
+
 // replyChanRangeQuery will be dispatched in response to a channel range query
 // by the remote node. We'll query the channel time series for channels that
 // meet the channel range, then chunk our responses to the remote node. We also
@@ -826,6 +973,13 @@ func (g *GossipSyncer) replyChanRangeQuery(query *lnwire.QueryChannelRange) erro
 
 	numChannels := int32(len(channelRange))
 	numChansSent := int32(0)
+
+	// Charge the chunks of our reply against the reply budget of the peer
+	// before sending any of them.
+	numChunks := int((numChannels-1)/g.cfg.chunkSize) + 1
+	if err := g.throttleReplyMsgs(numChunks); err != nil {
+		return err
+	}
 	for {
 		// We'll send our this response in a streaming manner,
 		// chunk-by-chunk. We do this as there's a transport message
@@ -905,19 +1059,36 @@ func (g *GossipSyncer) replyShortChanIDs(query *lnwire.QueryShortChanIDs) error
 		return nil
 	}
 
+	// We'll only reply with the first maxQueryChanIDs channels of large
+	// queries, the peer is free to query the remaining ones again later.
+	chanIDs := query.ShortChanIDs
+	if int32(len(chanIDs)) > g.cfg.maxQueryChanIDs {
+		log.Infof("GossipSyncer(%x): only replying with %v of %v "+
+			"queried chans", g.cfg.peerPub[:], g.cfg.maxQueryChanIDs,
+			len(chanIDs))
+
+		chanIDs = chanIDs[:g.cfg.maxQueryChanIDs]
+	}
+
 	log.Infof("GossipSyncer(%x): fetching chan anns for %v chans",
-		g.cfg.peerPub[:], len(query.ShortChanIDs))
+		g.cfg.peerPub[:], len(chanIDs))
 
 	// Now that we know we're on the same chain, we'll query the channel
 	// time series for the set of messages that we know of which satisfies
 	// the requirement of being a chan ann, chan update, or a node ann
 	// related to the set of queried channels.
 	replyMsgs, err := g.cfg.channelSeries.FetchChanAnns(
-		query.ChainHash, query.ShortChanIDs,
+		query.ChainHash, chanIDs,
 	)
 	if err != nil {
 		return fmt.Errorf("unable to fetch chan anns for %v..., %v",
-			query.ShortChanIDs[0].ToUint64(), err)
+			chanIDs[0].ToUint64(), err)
+	}
+
+	// Charge our reply, including its final message, against the reply
+	// budget of the peer before sending any of it.
+	if err := g.throttleReplyMsgs(len(replyMsgs) + 1); err != nil {
+		return err
 	}
 
 	// Reply with any messages related to those channel ID's, we'll write
@@ -1152,6 +1323,12 @@ func (g *GossipSyncer) syncState() syncerState {
 	return syncerState(atomic.LoadUint32(&g.state))
 }
 
+// SyncStateName returns a human readable description of the current
+// syncerState of the target GossipSyncer.
+func (g *GossipSyncer) SyncStateName() string {
+	return g.syncState().String()
+}
+
 // ResetSyncedSignal returns a channel that will be closed in order to serve as
 // a signal for when the GossipSyncer has reached its chansSynced state.
 func (g *GossipSyncer) ResetSyncedSignal() chan struct{} {
@@ -1218,7 +1395,7 @@ func (g *GossipSyncer) handleSyncTransition(req *syncTransitionReq) error {
 	switch req.newSyncType {
 	// If an active sync has been requested, then we should resume receiving
 	// new graph updates from the remote peer.
-	case ActiveSync:
+	case ActiveSync, PinnedSync:
 		firstTimestamp = time.Now()
 		timestampRange = math.MaxUint32
 
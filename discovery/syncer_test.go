@@ -693,6 +693,110 @@ func TestGossipSyncerReplyShortChanIDs(t *testing.T) {
 	}
 }
 
+// TestGossipSyncerReplyShortChanIDsLimit tests that we only reply with the
+// first maxQueryChanIDs channels of a QueryShortChanIDs message, and that the
+// reply is charged against the reply budget of the peer.
+func TestGossipSyncerReplyShortChanIDsLimit(t *testing.T) {
+	t.Parallel()
+
+	msgChan, syncer, chanSeries := newTestSyncer(
+		lnwire.NewShortChanIDFromInt(10), defaultEncoding,
+		defaultChunkSize,
+	)
+	syncer.cfg.maxQueryChanIDs = 2
+
+	queryChanIDs := []lnwire.ShortChannelID{
+		lnwire.NewShortChanIDFromInt(1),
+		lnwire.NewShortChanIDFromInt(2),
+		lnwire.NewShortChanIDFromInt(3),
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		select {
+		case <-time.After(time.Second * 15):
+			errCh <- errors.New("no query recvd")
+		case chanIDs := <-chanSeries.annReq:
+			// Only the first two channels should be fetched.
+			if !reflect.DeepEqual(chanIDs, queryChanIDs[:2]) {
+				errCh <- fmt.Errorf("wrong chan IDs: expected "+
+					"%v, got %v", queryChanIDs[:2], chanIDs)
+				return
+			}
+
+			chanSeries.annResp <- []lnwire.Message{
+				&lnwire.ChannelAnnouncement{
+					ShortChannelID: queryChanIDs[0],
+				},
+			}
+			errCh <- nil
+		}
+	}()
+
+	burst := syncer.replyMsgLimiter.Burst()
+	err := syncer.replyShortChanIDs(&lnwire.QueryShortChanIDs{
+		ShortChanIDs: queryChanIDs,
+	})
+	if err != nil {
+		t.Fatalf("unable to query for chan IDs: %v", err)
+	}
+	if err := <-errCh; err != nil {
+		t.Fatal(err)
+	}
+
+	// We should get back the announcement followed by the end of the
+	// reply.
+	for i := 0; i < 2; i++ {
+		select {
+		case <-time.After(time.Second * 15):
+			t.Fatalf("no msgs received")
+		case <-msgChan:
+		}
+	}
+
+	// Both messages of the reply must have been charged against the
+	// budget of the peer, so reserving all but one message of the burst
+	// must now be delayed.
+	r := syncer.replyMsgLimiter.ReserveN(time.Now(), burst-1)
+	if !r.OK() || r.Delay() <= 0 {
+		t.Fatalf("expected reply to be charged against the budget")
+	}
+}
+
+// TestGossipSyncerQueryBackoff tests that the delay of our replies to a peer
+// that keeps exceeding its query rate only starts doubling past the backoff
+// threshold, and never exceeds the maximum reply delay.
+func TestGossipSyncerQueryBackoff(t *testing.T) {
+	t.Parallel()
+
+	_, syncer, _ := newTestSyncer(
+		lnwire.NewShortChanIDFromInt(10), defaultEncoding,
+		defaultChunkSize,
+	)
+	syncer.cfg.maxQueryReplyDelay = time.Minute
+
+	const delay = 5 * time.Second
+	tests := []struct {
+		throttledQueries int
+		expDelay         time.Duration
+	}{
+		{1, delay},
+		{queryBackoffThreshold, delay},
+		{queryBackoffThreshold + 1, 2 * delay},
+		{queryBackoffThreshold + 3, 8 * delay},
+		{queryBackoffThreshold + 4, time.Minute},
+		{queryBackoffThreshold + 100, time.Minute},
+	}
+	for _, test := range tests {
+		syncer.throttledQueries = test.throttledQueries
+		if d := syncer.queryBackoff(delay); d != test.expDelay {
+			t.Fatalf("expected delay %v after %d throttled "+
+				"queries, got %v", test.expDelay,
+				test.throttledQueries, d)
+		}
+	}
+}
+
 // TestGossipSyncerReplyChanRangeQuery tests that if we receive a
 // QueryChannelRange message, then we'll properly send back a chunked reply to
 // the remote peer.
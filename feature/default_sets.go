@@ -0,0 +1,42 @@
+package feature
+
+import "github.com/decred/dcrlnd/lnwire"
+
+// setDesc describes which feature bits should be advertised in which feature
+// sets.
+type setDesc map[lnwire.FeatureBit]map[Set]struct{}
+
+// defaultSetDesc are the default set descriptors for generating feature
+// vectors. Each set is annotated with the corresponding identifier from BOLT 9
+// indicating where it should be advertised.
+var defaultSetDesc = setDesc{
+	lnwire.DataLossProtectRequired: {
+		SetInit: {}, // I
+	},
+	lnwire.GossipQueriesOptional: {
+		SetInit: {}, // I
+	},
+	lnwire.UpfrontShutdownScriptOptional: {
+		SetInit: {}, // I
+	},
+	lnwire.WumboChannelsOptional: {
+		SetInit: {}, // I
+	},
+	lnwire.ZeroConfOptional: {
+		SetInit: {}, // I
+	},
+	lnwire.TLVOnionPayloadOptional: {
+		SetInit:    {}, // I
+		SetNodeAnn: {}, // N
+		SetInvoice: {}, // 9
+	},
+	lnwire.StaticRemoteKeyOptional: {
+		SetNodeAnn: {}, // N
+	},
+	lnwire.AnchorsOptional: {
+		SetNodeAnn: {}, // N
+	},
+	lnwire.PaymentAddrRequired: {
+		SetInvoice: {}, // 9
+	},
+}
@@ -0,0 +1,115 @@
+package feature
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/decred/dcrlnd/lnwire"
+)
+
+type depTest struct {
+	name   string
+	raw    *lnwire.RawFeatureVector
+	expErr error
+}
+
+var depTests = []depTest{
+	{
+		name: "empty",
+		raw:  lnwire.NewRawFeatureVector(),
+	},
+	{
+		name: "no deps optional",
+		raw: lnwire.NewRawFeatureVector(
+			lnwire.GossipQueriesOptional,
+		),
+	},
+	{
+		name: "no deps required",
+		raw: lnwire.NewRawFeatureVector(
+			lnwire.TLVOnionPayloadRequired,
+		),
+	},
+	{
+		name: "one dep optional",
+		raw: lnwire.NewRawFeatureVector(
+			lnwire.TLVOnionPayloadOptional,
+			lnwire.PaymentAddrOptional,
+		),
+	},
+	{
+		name: "one dep required",
+		raw: lnwire.NewRawFeatureVector(
+			lnwire.TLVOnionPayloadRequired,
+			lnwire.PaymentAddrRequired,
+		),
+	},
+	{
+		name: "one missing optional",
+		raw: lnwire.NewRawFeatureVector(
+			lnwire.PaymentAddrOptional,
+		),
+		expErr: ErrMissingFeatureDep{lnwire.TLVOnionPayloadOptional},
+	},
+	{
+		name: "one missing required",
+		raw: lnwire.NewRawFeatureVector(
+			lnwire.PaymentAddrRequired,
+		),
+		expErr: ErrMissingFeatureDep{lnwire.TLVOnionPayloadOptional},
+	},
+	{
+		name: "anchors with static remote key",
+		raw: lnwire.NewRawFeatureVector(
+			lnwire.StaticRemoteKeyOptional,
+			lnwire.AnchorsOptional,
+		),
+	},
+	{
+		name: "anchors missing static remote key",
+		raw: lnwire.NewRawFeatureVector(
+			lnwire.AnchorsOptional,
+		),
+		expErr: ErrMissingFeatureDep{lnwire.StaticRemoteKeyOptional},
+	},
+}
+
+// TestValidateDeps tests that ValidateDeps correctly asserts whether or not the
+// set features constitute a valid feature chain when accounting for transitive
+// dependencies.
+func TestValidateDeps(t *testing.T) {
+	for _, test := range depTests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			testValidateDeps(t, test)
+		})
+	}
+}
+
+func testValidateDeps(t *testing.T, test depTest) {
+	fv := lnwire.NewFeatureVector(test.raw, lnwire.GlobalFeatures)
+	err := ValidateDeps(fv)
+	if !reflect.DeepEqual(err, test.expErr) {
+		t.Fatalf("validation mismatch, want: %v, got: %v",
+			test.expErr, err)
+
+	}
+}
+
+// TestValidateVectors asserts that dependencies are resolved across the union
+// of multiple vectors, such as the local and global vectors of an init
+// message.
+func TestValidateVectors(t *testing.T) {
+	local := lnwire.NewRawFeatureVector(lnwire.TLVOnionPayloadOptional)
+	global := lnwire.NewRawFeatureVector(lnwire.PaymentAddrOptional)
+
+	if err := ValidateVectors(local, global); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	err := ValidateVectors(nil, global)
+	expErr := ErrMissingFeatureDep{lnwire.TLVOnionPayloadOptional}
+	if !reflect.DeepEqual(err, expErr) {
+		t.Fatalf("validation mismatch, want: %v, got: %v", expErr, err)
+	}
+}
@@ -0,0 +1,190 @@
+package feature
+
+import (
+	"fmt"
+
+	"github.com/decred/dcrlnd/lnwire"
+)
+
+// Config houses any runtime modifications to the default set descriptors. For
+// our purposes, this typically means disabling certain features to test
+// legacy protocol interoperability or functionality.
+type Config struct {
+	// NoTLVOnion unsets any optional or required TLVOnionPaylod bits from
+	// all feature sets, along with the features depending on it.
+	NoTLVOnion bool
+
+	// NoStaticRemoteKey unsets any optional or required StaticRemoteKey
+	// bits from all feature sets, along with the features depending on
+	// it.
+	NoStaticRemoteKey bool
+
+	// NoAnchors unsets any optional or required Anchors bits from all
+	// feature sets.
+	NoAnchors bool
+
+	// NoWumbo unsets any optional or required WumboChannels bits from all
+	// feature sets.
+	NoWumbo bool
+
+	// NoZeroConf unsets any optional or required ZeroConf bits from all
+	// feature sets.
+	NoZeroConf bool
+
+	// Disabled is a list of additional optional feature bits to unset from
+	// all feature sets. A feature that is required by one of the sets
+	// can't be disabled.
+	Disabled []lnwire.FeatureBit
+}
+
+// Manager is responsible for generating feature vectors for different
+// requested feature sets.
+type Manager struct {
+	// fsets is a static map of feature set to raw feature vectors. Requests
+	// are fulfilled by cloning these internal feature vectors.
+	fsets map[Set]*lnwire.RawFeatureVector
+}
+
+// NewManager creates a new feature Manager, applying any custom modifications
+// to its feature sets.
+func NewManager(cfg Config) (*Manager, error) {
+	return newManager(cfg, defaultSetDesc)
+}
+
+// newManager creates a new feature Manager, applying any custom modifications
+// to its feature sets. The set descriptor is passed in to allow for testing
+// alternative descriptors.
+func newManager(cfg Config, desc setDesc) (*Manager, error) {
+	// First build the default feature vector for all known sets.
+	fsets := make(map[Set]*lnwire.RawFeatureVector)
+	for bit, sets := range desc {
+		for set := range sets {
+			// Fetch the feature vector for this set, allocating a
+			// new one if it doesn't exist.
+			fv, ok := fsets[set]
+			if !ok {
+				fv = lnwire.NewRawFeatureVector()
+			}
+
+			// Set the configured bit on the feature vector,
+			// ensuring that we don't set two feature bits for the
+			// same pair.
+			if fv.IsSet(bit ^ 1) {
+				return nil, fmt.Errorf("feature bit %d already "+
+					"set in set %v", bit^1, set)
+			}
+			fv.Set(bit)
+
+			// Write the updated feature vector under its set.
+			fsets[set] = fv
+		}
+	}
+
+	// Now, remove any features as directed by the config.
+	for set, raw := range fsets {
+		if cfg.NoTLVOnion {
+			unset(raw, lnwire.TLVOnionPayloadOptional)
+			unset(raw, lnwire.PaymentAddrOptional)
+		}
+		if cfg.NoStaticRemoteKey {
+			unset(raw, lnwire.StaticRemoteKeyOptional)
+			unset(raw, lnwire.AnchorsOptional)
+		}
+		if cfg.NoAnchors {
+			unset(raw, lnwire.AnchorsOptional)
+		}
+		if cfg.NoWumbo {
+			unset(raw, lnwire.WumboChannelsOptional)
+		}
+		if cfg.NoZeroConf {
+			unset(raw, lnwire.ZeroConfOptional)
+		}
+
+		for _, bit := range cfg.Disabled {
+			if bit.IsRequired() {
+				return nil, fmt.Errorf("feature bit %d is not "+
+					"an optional feature bit", bit)
+			}
+			if raw.IsSet(bit ^ 1) {
+				return nil, fmt.Errorf("feature %v is required "+
+					"in set %v and can't be disabled",
+					featureName(bit), set)
+			}
+
+			unset(raw, bit)
+		}
+
+		// Ensure that all of our feature sets properly set any
+		// dependent features.
+		fv := lnwire.NewFeatureVector(raw, featureNames)
+		if err := ValidateDeps(fv); err != nil {
+			return nil, fmt.Errorf("invalid feature set %v: %v",
+				set, err)
+		}
+	}
+
+	return &Manager{
+		fsets: fsets,
+	}, nil
+}
+
+// GetRaw returns a raw feature vector for the passed set. If no set is known,
+// an empty raw feature vector is returned.
+func (m *Manager) GetRaw(set Set) *lnwire.RawFeatureVector {
+	fv := lnwire.NewRawFeatureVector()
+	if raw, ok := m.fsets[set]; ok {
+		for bit := range raw.Features() {
+			fv.Set(bit)
+		}
+	}
+
+	return fv
+}
+
+// Get returns a feature vector for the passed set. If no set is known, an
+// empty feature vector is returned.
+func (m *Manager) Get(set Set) *lnwire.FeatureVector {
+	return lnwire.NewFeatureVector(m.GetRaw(set), featureNames)
+}
+
+// ParseOptionalFeature returns the optional feature bit of the feature with
+// the given name, as used in the config to disable features.
+func ParseOptionalFeature(name string) (lnwire.FeatureBit, error) {
+	for bit, bitName := range featureNames {
+		if !bit.IsRequired() && bitName == name {
+			return bit, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown optional feature %q", name)
+}
+
+// featureNames maps all the feature bits known to the manager to their name.
+var featureNames = func() map[lnwire.FeatureBit]string {
+	names := make(map[lnwire.FeatureBit]string)
+	for bit, name := range lnwire.LocalFeatures {
+		names[bit] = name
+	}
+	for bit, name := range lnwire.GlobalFeatures {
+		names[bit] = name
+	}
+
+	return names
+}()
+
+// featureName returns the name of the given feature bit, or its number if the
+// feature isn't known.
+func featureName(bit lnwire.FeatureBit) string {
+	if name, ok := featureNames[bit]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("%d", bit)
+}
+
+// unset clears both the optional and required bits of the feature of the
+// given optional bit.
+func unset(raw *lnwire.RawFeatureVector, bit lnwire.FeatureBit) {
+	raw.Unset(bit)
+	raw.Unset(bit ^ 1)
+}
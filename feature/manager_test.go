@@ -0,0 +1,146 @@
+package feature
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/decred/dcrlnd/lnwire"
+)
+
+type managerTest struct {
+	name   string
+	cfg    Config
+	expErr bool
+	sets   map[Set][]lnwire.FeatureBit
+}
+
+var managerTests = []managerTest{
+	{
+		name: "default",
+		sets: map[Set][]lnwire.FeatureBit{
+			SetInvoice: {
+				lnwire.TLVOnionPayloadOptional,
+				lnwire.PaymentAddrRequired,
+			},
+			SetNodeAnn: {
+				lnwire.TLVOnionPayloadOptional,
+				lnwire.StaticRemoteKeyOptional,
+				lnwire.AnchorsOptional,
+			},
+		},
+	},
+	{
+		name: "no tlv onion",
+		cfg: Config{
+			NoTLVOnion: true,
+			NoAnchors:  true,
+		},
+		sets: map[Set][]lnwire.FeatureBit{
+			SetInvoice: nil,
+			SetNodeAnn: {
+				lnwire.StaticRemoteKeyOptional,
+			},
+		},
+	},
+	{
+		name: "no static remote key",
+		cfg: Config{
+			NoStaticRemoteKey: true,
+		},
+		sets: map[Set][]lnwire.FeatureBit{
+			SetNodeAnn: {
+				lnwire.TLVOnionPayloadOptional,
+			},
+		},
+	},
+	{
+		name: "disable optional feature",
+		cfg: Config{
+			Disabled: []lnwire.FeatureBit{
+				lnwire.GossipQueriesOptional,
+			},
+			NoWumbo:    true,
+			NoZeroConf: true,
+		},
+		sets: map[Set][]lnwire.FeatureBit{
+			SetInit: {
+				lnwire.DataLossProtectRequired,
+				lnwire.UpfrontShutdownScriptOptional,
+				lnwire.TLVOnionPayloadOptional,
+			},
+		},
+	},
+	{
+		name: "disable required feature",
+		cfg: Config{
+			Disabled: []lnwire.FeatureBit{
+				lnwire.DataLossProtectOptional,
+			},
+		},
+		expErr: true,
+	},
+	{
+		name: "disable feature dependency",
+		cfg: Config{
+			Disabled: []lnwire.FeatureBit{
+				lnwire.TLVOnionPayloadOptional,
+			},
+		},
+		expErr: true,
+	},
+}
+
+// TestManager asserts the feature sets generated by the manager for various
+// configurations, and that invalid configurations are rejected.
+func TestManager(t *testing.T) {
+	for _, test := range managerTests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			testManager(t, test)
+		})
+	}
+}
+
+func testManager(t *testing.T, test managerTest) {
+	m, err := NewManager(test.cfg)
+	switch {
+	case test.expErr && err == nil:
+		t.Fatalf("expected invalid config to be rejected")
+	case test.expErr:
+		return
+	case err != nil:
+		t.Fatalf("unable to create manager: %v", err)
+	}
+
+	for set, bits := range test.sets {
+		expected := lnwire.NewRawFeatureVector(bits...).Features()
+		features := m.GetRaw(set).Features()
+		if !reflect.DeepEqual(expected, features) {
+			t.Fatalf("expected %v features %v, got %v", set,
+				expected, features)
+		}
+	}
+
+	// Modifying a returned vector must not affect the manager.
+	m.GetRaw(SetInvoice).Set(lnwire.GossipQueriesOptional)
+	if m.Get(SetInvoice).IsSet(lnwire.GossipQueriesOptional) {
+		t.Fatalf("manager feature set was modified")
+	}
+}
+
+// TestParseOptionalFeature asserts that feature names are resolved to their
+// optional feature bit.
+func TestParseOptionalFeature(t *testing.T) {
+	bit, err := ParseOptionalFeature("gossip-queries")
+	if err != nil {
+		t.Fatalf("unable to parse feature: %v", err)
+	}
+	if bit != lnwire.GossipQueriesOptional {
+		t.Fatalf("expected bit %d, got %d",
+			lnwire.GossipQueriesOptional, bit)
+	}
+
+	if _, err := ParseOptionalFeature("unknown-feature"); err == nil {
+		t.Fatalf("expected unknown feature to be rejected")
+	}
+}
@@ -0,0 +1,35 @@
+package feature
+
+// Set is an enum identifying the various feature sets, which separates the
+// single feature namespace into distinct categorizations.
+type Set uint8
+
+const (
+	// SetInit identifies features that should be sent in the local
+	// features of an Init message. These are the features which only
+	// concern the connection with a peer.
+	SetInit Set = iota
+
+	// SetNodeAnn identifies features that should be advertised on node
+	// announcements. They are also sent in the global features of an Init
+	// message.
+	SetNodeAnn
+
+	// SetInvoice identifies features that should be advertised on
+	// invoices generated by the daemon.
+	SetInvoice
+)
+
+// String returns a human-readable description of a Set.
+func (s Set) String() string {
+	switch s {
+	case SetInit:
+		return "SetInit"
+	case SetNodeAnn:
+		return "SetNodeAnn"
+	case SetInvoice:
+		return "SetInvoice"
+	default:
+		return "SetUnknown"
+	}
+}
@@ -0,0 +1,124 @@
+package dcrlnd
+
+import (
+	"time"
+
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/feemanager"
+	"github.com/decred/dcrlnd/lncfg"
+	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/routing"
+)
+
+// newFeeManager creates the fee manager adjusting the fee rates of the
+// channels of the server according to the given config.
+func newFeeManager(s *server,
+	cfg *lncfg.FeeManager) (*feemanager.Manager, error) {
+
+	strategy, err := feemanager.NewStrategy(
+		cfg.Strategy, cfg.MinFeeRate, cfg.MaxFeeRate, cfg.Step,
+		cfg.TargetVolume,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return feemanager.New(&feemanager.Config{
+		Strategy:         strategy,
+		Interval:         cfg.Interval,
+		MinChange:        cfg.MinChange,
+		FetchChannels:    s.feeManagerChannels,
+		ForwardingEvents: s.forwardingEvents,
+		UpdateFeeRate: func(state *feemanager.ChannelState,
+			feeRate uint32) error {
+
+			// Only the fee rate is changed, the max HTLC being left
+			// unchanged when zero.
+			policy := routing.ChannelPolicy{
+				FeeSchema: routing.FeeSchema{
+					BaseFee: state.BaseFee,
+					FeeRate: feeRate,
+				},
+				TimeLockDelta: state.TimeLockDelta,
+			}
+			return s.localChanMgr.UpdatePolicy(
+				policy, state.ChanPoint,
+			)
+		},
+		AddDecisions: s.chanDB.AddFeeDecisions,
+		Now:          time.Now,
+	}), nil
+}
+
+// feeManagerChannels returns the liquidity and current policy of our open
+// channels.
+func (s *server) feeManagerChannels() ([]*feemanager.ChannelState, error) {
+	openChans, err := s.chanDB.FetchAllOpenChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	localBalances := make(map[wire.OutPoint]dcrutil.Amount, len(openChans))
+	for _, channel := range openChans {
+		localBalance := channel.LocalCommitment.LocalBalance.ToAtoms()
+		localBalances[channel.FundingOutpoint] = localBalance
+	}
+
+	var states []*feemanager.ChannelState
+	err = s.chanRouter.ForAllOutgoingChannels(func(
+		info *channeldb.ChannelEdgeInfo,
+		edge *channeldb.ChannelEdgePolicy) error {
+
+		// Skip the channels that are no longer open.
+		localBalance, ok := localBalances[info.ChannelPoint]
+		if !ok {
+			return nil
+		}
+
+		chanID := lnwire.NewShortChanIDFromInt(info.ChannelID)
+		states = append(states, &feemanager.ChannelState{
+			ChanPoint:     info.ChannelPoint,
+			ChanID:        chanID,
+			Capacity:      info.Capacity,
+			LocalBalance:  localBalance,
+			BaseFee:       edge.FeeBaseMAtoms,
+			FeeRate:       uint32(edge.FeeProportionalMillionths),
+			TimeLockDelta: uint32(edge.TimeLockDelta),
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return states, nil
+}
+
+// forwardingEvents returns all the forwards settled within the given time
+// range, querying the forwarding log as many times as needed.
+func (s *server) forwardingEvents(start,
+	end time.Time) ([]channeldb.ForwardingEvent, error) {
+
+	query := channeldb.ForwardingEventQuery{
+		StartTime:    start,
+		EndTime:      end,
+		NumMaxEvents: channeldb.MaxResponseEvents,
+	}
+
+	var events []channeldb.ForwardingEvent
+	for {
+		timeSlice, err := s.chanDB.ForwardingLog().Query(query)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, timeSlice.ForwardingEvents...)
+
+		if len(timeSlice.ForwardingEvents) < int(query.NumMaxEvents) {
+			return events, nil
+		}
+		query.IndexOffset = timeSlice.LastIndexOffset
+	}
+}
@@ -0,0 +1,212 @@
+package feemanager
+
+import (
+	"sync"
+	"time"
+
+	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/lnwire"
+)
+
+// Config houses the parameters and the functions used by the Manager to
+// inspect and update our channels.
+type Config struct {
+	// Strategy decides the new fee rate of the channels.
+	Strategy Strategy
+
+	// Interval is the time between two rounds of adjustments.
+	Interval time.Duration
+
+	// MinChange is the minimum change, as a percentage of the current fee
+	// rate of a channel, for a new fee rate to be applied. It prevents
+	// small fluctuations from flooding the network with channel updates.
+	MinChange uint32
+
+	// FetchChannels returns the state of our active channels. The
+	// Forwarded field is filled by the manager.
+	FetchChannels func() ([]*ChannelState, error)
+
+	// ForwardingEvents returns the forwards settled within the given time
+	// range.
+	ForwardingEvents func(start,
+		end time.Time) ([]channeldb.ForwardingEvent, error)
+
+	// UpdateFeeRate applies the new fee rate to the given channel,
+	// keeping the rest of its policy unchanged.
+	UpdateFeeRate func(state *ChannelState, feeRate uint32) error
+
+	// AddDecisions persists the decisions taken during a round of
+	// adjustments.
+	AddDecisions func(decisions []*channeldb.FeeDecision) error
+
+	// Now returns the current time.
+	Now func() time.Time
+}
+
+// Manager periodically adjusts the fee rate of our channels according to a
+// strategy, based on their liquidity and on the amount recently forwarded over
+// them. Each adjustment is persisted, so that their history can be reported.
+type Manager struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *Config
+
+	// lastRun is the time of the last round of adjustments.
+	lastRun   time.Time
+	lastRunMu sync.Mutex
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// New creates a new fee manager.
+func New(cfg *Config) *Manager {
+	return &Manager{
+		cfg:     cfg,
+		lastRun: cfg.Now(),
+		quit:    make(chan struct{}),
+	}
+}
+
+// Start launches the loop adjusting the fee rates.
+func (m *Manager) Start() error {
+	m.started.Do(func() {
+		log.Infof("Fee manager starting, adjusting fee rates every %v "+
+			"using the %v strategy", m.cfg.Interval,
+			m.cfg.Strategy.Name())
+
+		m.wg.Add(1)
+		go m.adjustLoop()
+	})
+
+	return nil
+}
+
+// Stop signals the fee manager for a graceful shutdown.
+func (m *Manager) Stop() {
+	m.stopped.Do(func() {
+		log.Info("Fee manager shutting down")
+
+		close(m.quit)
+		m.wg.Wait()
+	})
+}
+
+// StrategyName returns the name of the strategy used by the manager.
+func (m *Manager) StrategyName() string {
+	return m.cfg.Strategy.Name()
+}
+
+// Interval returns the time between two rounds of adjustments.
+func (m *Manager) Interval() time.Duration {
+	return m.cfg.Interval
+}
+
+// LastRun returns the time of the last round of adjustments, or the time the
+// manager was created if none happened yet.
+func (m *Manager) LastRun() time.Time {
+	m.lastRunMu.Lock()
+	defer m.lastRunMu.Unlock()
+
+	return m.lastRun
+}
+
+// adjustLoop adjusts the fee rates at each interval.
+//
+// NOTE: This MUST be run as a goroutine.
+func (m *Manager) adjustLoop() {
+	defer m.wg.Done()
+
+	ticker := time.NewTicker(m.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if _, err := m.AdjustNow(); err != nil {
+				log.Errorf("Unable to adjust fee rates: %v",
+					err)
+			}
+
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+// AdjustNow runs a round of adjustments right away, returning the decisions
+// taken.
+func (m *Manager) AdjustNow() ([]*channeldb.FeeDecision, error) {
+	m.lastRunMu.Lock()
+	defer m.lastRunMu.Unlock()
+
+	now := m.cfg.Now()
+
+	channels, err := m.cfg.FetchChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	// Tally the amount forwarded over each channel since the previous
+	// round of adjustments.
+	events, err := m.cfg.ForwardingEvents(m.lastRun, now)
+	if err != nil {
+		return nil, err
+	}
+	forwarded := make(map[lnwire.ShortChannelID]lnwire.MilliAtom)
+	for _, event := range events {
+		forwarded[event.OutgoingChanID] += event.AmtOut
+	}
+
+	var decisions []*channeldb.FeeDecision
+	for _, channel := range channels {
+		channel.Forwarded = forwarded[channel.ChanID]
+
+		feeRate := m.cfg.Strategy.FeeRate(channel)
+		if !m.significantChange(channel.FeeRate, feeRate) {
+			continue
+		}
+
+		if err := m.cfg.UpdateFeeRate(channel, feeRate); err != nil {
+			log.Errorf("Unable to update fee rate of channel "+
+				"%v: %v", channel.ChanPoint, err)
+			continue
+		}
+
+		log.Infof("Adjusted fee rate of channel %v from %v to %v",
+			channel.ChanPoint, channel.FeeRate, feeRate)
+
+		decisions = append(decisions, &channeldb.FeeDecision{
+			Timestamp:    now,
+			ChanPoint:    channel.ChanPoint,
+			OldFeeRate:   channel.FeeRate,
+			NewFeeRate:   feeRate,
+			Capacity:     channel.Capacity,
+			LocalBalance: channel.LocalBalance,
+			Forwarded:    channel.Forwarded,
+		})
+	}
+
+	m.lastRun = now
+
+	if len(decisions) == 0 {
+		return nil, nil
+	}
+	if err := m.cfg.AddDecisions(decisions); err != nil {
+		return nil, err
+	}
+
+	return decisions, nil
+}
+
+// significantChange returns whether the fee rate changes enough from oldRate
+// to newRate to be applied.
+func (m *Manager) significantChange(oldRate, newRate uint32) bool {
+	diff := int64(newRate) - int64(oldRate)
+	if diff < 0 {
+		diff = -diff
+	}
+
+	return diff != 0 && diff*100 >= int64(oldRate)*int64(m.cfg.MinChange)
+}
@@ -0,0 +1,174 @@
+package feemanager
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/lnwire"
+)
+
+// TestManagerAdjustNow asserts that a round of adjustments applies and
+// persists the new fee rates decided by the strategy, based on the amount
+// forwarded since the previous round, skipping insignificant changes.
+func TestManagerAdjustNow(t *testing.T) {
+	t.Parallel()
+
+	start := time.Unix(1600000000, 0)
+	now := start
+
+	busyChan := &ChannelState{
+		ChanPoint: wire.OutPoint{Hash: chainhash.Hash{1}},
+		ChanID:    lnwire.NewShortChanIDFromInt(1),
+		Capacity:  1000,
+		FeeRate:   500,
+	}
+	idleChan := &ChannelState{
+		ChanPoint: wire.OutPoint{Hash: chainhash.Hash{2}},
+		ChanID:    lnwire.NewShortChanIDFromInt(2),
+		Capacity:  1000,
+		FeeRate:   500,
+	}
+	minRateChan := &ChannelState{
+		ChanPoint: wire.OutPoint{Hash: chainhash.Hash{3}},
+		ChanID:    lnwire.NewShortChanIDFromInt(3),
+		Capacity:  1000,
+		FeeRate:   10,
+	}
+
+	var (
+		queriedStart, queriedEnd time.Time
+		updated                  = make(map[wire.OutPoint]uint32)
+		persisted                []*channeldb.FeeDecision
+	)
+	manager := New(&Config{
+		Strategy: &DemandStrategy{
+			MinFeeRate:   10,
+			MaxFeeRate:   1000,
+			Step:         10,
+			TargetVolume: 5,
+		},
+		Interval:  time.Hour,
+		MinChange: 5,
+		FetchChannels: func() ([]*ChannelState, error) {
+			return []*ChannelState{busyChan, idleChan, minRateChan},
+				nil
+		},
+		ForwardingEvents: func(start,
+			end time.Time) ([]channeldb.ForwardingEvent, error) {
+
+			queriedStart, queriedEnd = start, end
+
+			// Two forwards totaling more than the target volume of
+			// the busy channel, none over the others.
+			return []channeldb.ForwardingEvent{
+				{
+					OutgoingChanID: busyChan.ChanID,
+					AmtOut:         30000,
+				},
+				{
+					OutgoingChanID: busyChan.ChanID,
+					AmtOut:         30000,
+				},
+			}, nil
+		},
+		UpdateFeeRate: func(state *ChannelState, feeRate uint32) error {
+			updated[state.ChanPoint] = feeRate
+			return nil
+		},
+		AddDecisions: func(decisions []*channeldb.FeeDecision) error {
+			persisted = append(persisted, decisions...)
+			return nil
+		},
+		Now: func() time.Time {
+			return now
+		},
+	})
+
+	now = start.Add(time.Hour)
+	decisions, err := manager.AdjustNow()
+	if err != nil {
+		t.Fatalf("unable to adjust fee rates: %v", err)
+	}
+
+	if !queriedStart.Equal(start) || !queriedEnd.Equal(now) {
+		t.Fatalf("expected forwards from %v to %v, got %v to %v",
+			start, now, queriedStart, queriedEnd)
+	}
+
+	// The fee rate of the channel already at the minimum must be left
+	// unchanged.
+	expectedUpdates := map[wire.OutPoint]uint32{
+		busyChan.ChanPoint: 550,
+		idleChan.ChanPoint: 450,
+	}
+	if !reflect.DeepEqual(updated, expectedUpdates) {
+		t.Fatalf("expected updates %v, got %v", expectedUpdates,
+			updated)
+	}
+
+	expectedDecisions := []*channeldb.FeeDecision{
+		{
+			Timestamp:  now,
+			ChanPoint:  busyChan.ChanPoint,
+			OldFeeRate: 500,
+			NewFeeRate: 550,
+			Capacity:   1000,
+			Forwarded:  60000,
+		},
+		{
+			Timestamp:  now,
+			ChanPoint:  idleChan.ChanPoint,
+			OldFeeRate: 500,
+			NewFeeRate: 450,
+			Capacity:   1000,
+		},
+	}
+	if !reflect.DeepEqual(decisions, expectedDecisions) {
+		t.Fatalf("expected decisions %v, got %v",
+			spew.Sdump(expectedDecisions), spew.Sdump(decisions))
+	}
+	if !reflect.DeepEqual(persisted, expectedDecisions) {
+		t.Fatalf("expected persisted decisions %v, got %v",
+			spew.Sdump(expectedDecisions), spew.Sdump(persisted))
+	}
+
+	if !manager.LastRun().Equal(now) {
+		t.Fatalf("expected last run at %v, got %v", now,
+			manager.LastRun())
+	}
+}
+
+// TestSignificantChange asserts that only the fee rate changes of at least the
+// configured percentage are applied.
+func TestSignificantChange(t *testing.T) {
+	t.Parallel()
+
+	manager := &Manager{cfg: &Config{MinChange: 10}}
+
+	tests := []struct {
+		oldRate, newRate uint32
+		significant      bool
+	}{
+		{oldRate: 100, newRate: 100, significant: false},
+		{oldRate: 100, newRate: 109, significant: false},
+		{oldRate: 100, newRate: 110, significant: true},
+		{oldRate: 100, newRate: 90, significant: true},
+		{oldRate: 0, newRate: 1, significant: true},
+	}
+
+	for _, test := range tests {
+		significant := manager.significantChange(
+			test.oldRate, test.newRate,
+		)
+		if significant != test.significant {
+			t.Fatalf("expected change from %v to %v to be "+
+				"significant=%v", test.oldRate, test.newRate,
+				test.significant)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package feemanager
+
+import (
+	"fmt"
+
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/lnwire"
+)
+
+const (
+	// LiquidityStrategyName is the name of the strategy setting the fee
+	// rate of the channels based on their outbound liquidity.
+	LiquidityStrategyName = "liquidity"
+
+	// DemandStrategyName is the name of the strategy adjusting the fee
+	// rate of the channels based on the amount forwarded over them.
+	DemandStrategyName = "demand"
+)
+
+// ChannelState is the liquidity, policy and recent forwarding demand of one of
+// our channels, based on which its new fee rate is decided.
+type ChannelState struct {
+	// ChanPoint is the outpoint of the channel.
+	ChanPoint wire.OutPoint
+
+	// ChanID is the short channel ID of the channel.
+	ChanID lnwire.ShortChannelID
+
+	// Capacity is the capacity of the channel.
+	Capacity dcrutil.Amount
+
+	// LocalBalance is our current balance in the channel.
+	LocalBalance dcrutil.Amount
+
+	// BaseFee is the current base fee of the channel.
+	BaseFee lnwire.MilliAtom
+
+	// FeeRate is the current fee rate of the channel, in millionths.
+	FeeRate uint32
+
+	// TimeLockDelta is the current time lock delta of the channel.
+	TimeLockDelta uint32
+
+	// Forwarded is the amount forwarded over the channel since the
+	// previous round of adjustments.
+	Forwarded lnwire.MilliAtom
+}
+
+// Strategy decides the fee rate of a channel.
+type Strategy interface {
+	// Name returns the name of the strategy.
+	Name() string
+
+	// FeeRate returns the fee rate, in millionths, to apply to the given
+	// channel.
+	FeeRate(state *ChannelState) uint32
+}
+
+// LiquidityStrategy sets the fee rate of the channels in proportion to the
+// share of their capacity that isn't ours, making the channels more expensive
+// to use as their outbound liquidity gets depleted.
+type LiquidityStrategy struct {
+	// MinFeeRate is the fee rate of a channel whose capacity is entirely
+	// ours.
+	MinFeeRate uint32
+
+	// MaxFeeRate is the fee rate of a channel in which we have no
+	// balance.
+	MaxFeeRate uint32
+}
+
+// Name returns the name of the strategy.
+//
+// NOTE: This is part of the Strategy interface.
+func (s *LiquidityStrategy) Name() string {
+	return LiquidityStrategyName
+}
+
+// FeeRate returns the fee rate, in millionths, to apply to the given channel.
+//
+// NOTE: This is part of the Strategy interface.
+func (s *LiquidityStrategy) FeeRate(state *ChannelState) uint32 {
+	if state.Capacity <= 0 {
+		return s.MaxFeeRate
+	}
+
+	localBalance := state.LocalBalance
+	if localBalance > state.Capacity {
+		localBalance = state.Capacity
+	}
+
+	// The fee rate is lowered from the max fee rate in proportion to our
+	// share of the capacity.
+	feeRange := uint64(s.MaxFeeRate - s.MinFeeRate)
+	discount := feeRange * uint64(localBalance) / uint64(state.Capacity)
+
+	return s.MaxFeeRate - uint32(discount)
+}
+
+// DemandStrategy raises the fee rate of the channels over which more than a
+// target volume was forwarded since the previous round of adjustments, and
+// lowers the fee rate of the channels over which nothing was forwarded.
+type DemandStrategy struct {
+	// MinFeeRate is the lowest fee rate set by the strategy.
+	MinFeeRate uint32
+
+	// MaxFeeRate is the highest fee rate set by the strategy.
+	MaxFeeRate uint32
+
+	// Step is the percentage by which the fee rate is raised or lowered.
+	Step uint32
+
+	// TargetVolume is the percentage of the capacity of a channel which,
+	// once forwarded over it, raises its fee rate.
+	TargetVolume uint32
+}
+
+// Name returns the name of the strategy.
+//
+// NOTE: This is part of the Strategy interface.
+func (s *DemandStrategy) Name() string {
+	return DemandStrategyName
+}
+
+// FeeRate returns the fee rate, in millionths, to apply to the given channel.
+//
+// NOTE: This is part of the Strategy interface.
+func (s *DemandStrategy) FeeRate(state *ChannelState) uint32 {
+	feeRate := uint64(state.FeeRate)
+	step := feeRate * uint64(s.Step) / 100
+	if step == 0 {
+		step = 1
+	}
+
+	target := uint64(state.Capacity) * uint64(s.TargetVolume) / 100
+	forwarded := uint64(state.Forwarded.ToAtoms())
+
+	switch {
+	case forwarded > 0 && forwarded >= target:
+		feeRate += step
+
+	case forwarded == 0 && feeRate > step:
+		feeRate -= step
+
+	case forwarded == 0:
+		feeRate = 0
+	}
+
+	switch {
+	case feeRate < uint64(s.MinFeeRate):
+		return s.MinFeeRate
+
+	case feeRate > uint64(s.MaxFeeRate):
+		return s.MaxFeeRate
+	}
+
+	return uint32(feeRate)
+}
+
+// NewStrategy returns the strategy with the given name, setting fee rates
+// between minFeeRate and maxFeeRate. The step and target volume are only used
+// by the demand strategy.
+func NewStrategy(name string, minFeeRate, maxFeeRate, step,
+	targetVolume uint32) (Strategy, error) {
+
+	if minFeeRate > maxFeeRate {
+		return nil, fmt.Errorf("min fee rate %v exceeds max fee rate "+
+			"%v", minFeeRate, maxFeeRate)
+	}
+
+	switch name {
+	case LiquidityStrategyName:
+		return &LiquidityStrategy{
+			MinFeeRate: minFeeRate,
+			MaxFeeRate: maxFeeRate,
+		}, nil
+
+	case DemandStrategyName:
+		return &DemandStrategy{
+			MinFeeRate:   minFeeRate,
+			MaxFeeRate:   maxFeeRate,
+			Step:         step,
+			TargetVolume: targetVolume,
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unknown fee strategy %q", name)
+	}
+}
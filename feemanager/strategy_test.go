@@ -0,0 +1,153 @@
+package feemanager
+
+import (
+	"testing"
+
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrlnd/lnwire"
+)
+
+// TestLiquidityStrategy asserts that the liquidity strategy sets fee rates in
+// proportion to the share of the capacity of the channels that isn't ours.
+func TestLiquidityStrategy(t *testing.T) {
+	t.Parallel()
+
+	strategy := &LiquidityStrategy{MinFeeRate: 100, MaxFeeRate: 1100}
+
+	tests := []struct {
+		name         string
+		localBalance int64
+		feeRate      uint32
+	}{
+		{
+			name:         "all local",
+			localBalance: 1000,
+			feeRate:      100,
+		},
+		{
+			name:         "all remote",
+			localBalance: 0,
+			feeRate:      1100,
+		},
+		{
+			name:         "balanced",
+			localBalance: 500,
+			feeRate:      600,
+		},
+		{
+			name:         "mostly local",
+			localBalance: 800,
+			feeRate:      300,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			feeRate := strategy.FeeRate(&ChannelState{
+				Capacity:     1000,
+				LocalBalance: dcrutil.Amount(test.localBalance),
+			})
+			if feeRate != test.feeRate {
+				t.Fatalf("expected fee rate %v, got %v",
+					test.feeRate, feeRate)
+			}
+		})
+	}
+}
+
+// TestDemandStrategy asserts that the demand strategy raises the fee rate of
+// busy channels, lowers the fee rate of idle channels and keeps the fee rates
+// within their bounds.
+func TestDemandStrategy(t *testing.T) {
+	t.Parallel()
+
+	strategy := &DemandStrategy{
+		MinFeeRate:   10,
+		MaxFeeRate:   1000,
+		Step:         10,
+		TargetVolume: 5,
+	}
+
+	tests := []struct {
+		name       string
+		oldFeeRate uint32
+		forwarded  int64
+		feeRate    uint32
+	}{
+		{
+			name:       "busy",
+			oldFeeRate: 500,
+			forwarded:  100,
+			feeRate:    550,
+		},
+		{
+			name:       "idle",
+			oldFeeRate: 500,
+			forwarded:  0,
+			feeRate:    450,
+		},
+		{
+			name:       "below target",
+			oldFeeRate: 500,
+			forwarded:  40,
+			feeRate:    500,
+		},
+		{
+			name:       "busy at max",
+			oldFeeRate: 980,
+			forwarded:  100,
+			feeRate:    1000,
+		},
+		{
+			name:       "idle at min",
+			oldFeeRate: 10,
+			forwarded:  0,
+			feeRate:    10,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			feeRate := strategy.FeeRate(&ChannelState{
+				Capacity: 1000,
+				FeeRate:  test.oldFeeRate,
+				Forwarded: lnwire.NewMAtomsFromAtoms(
+					dcrutil.Amount(test.forwarded),
+				),
+			})
+			if feeRate != test.feeRate {
+				t.Fatalf("expected fee rate %v, got %v",
+					test.feeRate, feeRate)
+			}
+		})
+	}
+}
+
+// TestNewStrategy asserts that only the known strategies with sane bounds can
+// be created.
+func TestNewStrategy(t *testing.T) {
+	t.Parallel()
+
+	for _, name := range []string{
+		LiquidityStrategyName, DemandStrategyName,
+	} {
+		strategy, err := NewStrategy(name, 1, 1000, 10, 5)
+		if err != nil {
+			t.Fatalf("unable to create %v strategy: %v", name, err)
+		}
+		if strategy.Name() != name {
+			t.Fatalf("expected %v strategy, got %v", name,
+				strategy.Name())
+		}
+	}
+
+	if _, err := NewStrategy("unknown", 1, 1000, 10, 5); err == nil {
+		t.Fatalf("expected failure for unknown strategy")
+	}
+	_, err := NewStrategy(LiquidityStrategyName, 10, 1, 0, 0)
+	if err == nil {
+		t.Fatalf("expected failure for inverted bounds")
+	}
+}
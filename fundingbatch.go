@@ -0,0 +1,347 @@
+package dcrlnd
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/lnwallet"
+	"github.com/decred/dcrlnd/lnwire"
+)
+
+var (
+	// errBatchFull is returned when attempting to add a channel to a
+	// funding batch which already holds all of its channels.
+	errBatchFull = errors.New("funding batch is full")
+)
+
+// batchChannel tracks the state of a single channel within a funding batch.
+type batchChannel struct {
+	pendingChanID [32]byte
+	resCtx        *reservationWithCtx
+
+	// fundingOutput is the 2-of-2 multi-sig output of the channel. It is
+	// set once the remote peer accepted the channel.
+	fundingOutput *wire.TxOut
+
+	// completeChan is the pending channel as stored in the database. It
+	// is set once the remote peer signed our commitment transaction.
+	completeChan *channeldb.OpenChannel
+}
+
+// fundingBatch coordinates a set of channels which are funded by a single
+// funding transaction. The funding transaction is created once all channels
+// within the batch have been accepted by their respective peers, and is only
+// broadcast once all channels have been signed. If any of the channels fails
+// before that point, the whole batch fails.
+type fundingBatch struct {
+	// numChannels is the total number of channels within the batch.
+	numChannels int
+
+	// feeRate is the fee rate used for the batch funding transaction.
+	feeRate lnwallet.AtomPerKByte
+
+	// minConfs is the minimum number of confirmations each output used to
+	// fund the batch funding transaction must have.
+	minConfs int32
+
+	mtx sync.Mutex
+
+	// channels tracks all channels of the batch by their pending channel
+	// ID.
+	channels map[[32]byte]*batchChannel
+
+	// numSigned is the number of channels within the batch which have
+	// been signed by their remote peer.
+	numSigned int
+
+	// fundingTx is the batch funding transaction. It is set once all
+	// channels within the batch have been accepted.
+	fundingTx *wire.MsgTx
+
+	// unlockInputs releases the coins used by the funding transaction in
+	// case it is never broadcast.
+	unlockInputs func()
+
+	// err is set once the batch has failed.
+	err error
+
+	// published is set once the funding transaction has been broadcast,
+	// after which the batch can no longer fail.
+	published bool
+}
+
+// newFundingBatch creates a new funding batch for the given number of
+// channels.
+func newFundingBatch(numChannels int, feeRate lnwallet.AtomPerKByte,
+	minConfs int32) *fundingBatch {
+
+	return &fundingBatch{
+		numChannels: numChannels,
+		feeRate:     feeRate,
+		minConfs:    minConfs,
+		channels:    make(map[[32]byte]*batchChannel),
+	}
+}
+
+// addChannel registers a new pending channel with the batch. An error is
+// returned if the batch already failed.
+func (b *fundingBatch) addChannel(pendingChanID [32]byte,
+	resCtx *reservationWithCtx) error {
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	switch {
+	case b.err != nil:
+		return b.err
+
+	case len(b.channels) >= b.numChannels:
+		return errBatchFull
+	}
+
+	b.channels[pendingChanID] = &batchChannel{
+		pendingChanID: pendingChanID,
+		resCtx:        resCtx,
+	}
+
+	return nil
+}
+
+// handleBatchFundingOutput records the funding output of a channel within a
+// batch. Once the funding outputs of all channels are known, the batch
+// funding transaction is created and signed, after which the funding flow of
+// every channel within the batch is resumed.
+func (f *fundingManager) handleBatchFundingOutput(batch *fundingBatch,
+	pendingChanID [32]byte, fundingOutput *wire.TxOut) {
+
+	batch.mtx.Lock()
+	if batch.err != nil {
+		batch.mtx.Unlock()
+		return
+	}
+
+	channel, ok := batch.channels[pendingChanID]
+	if !ok {
+		batch.mtx.Unlock()
+		fndgLog.Errorf("pendingID(%x) not found in funding batch",
+			pendingChanID[:])
+		return
+	}
+	channel.fundingOutput = fundingOutput
+
+	// If there are still channels we haven't received the funding output
+	// for, then there's nothing more to do for now.
+	if len(batch.channels) < batch.numChannels {
+		batch.mtx.Unlock()
+		return
+	}
+	outputs := make([]*wire.TxOut, 0, len(batch.channels))
+	channels := make([]*batchChannel, 0, len(batch.channels))
+	for _, c := range batch.channels {
+		if c.fundingOutput == nil {
+			batch.mtx.Unlock()
+			return
+		}
+		outputs = append(outputs, c.fundingOutput)
+		channels = append(channels, c)
+	}
+	batch.mtx.Unlock()
+
+	fndgLog.Infof("All %d channels of funding batch accepted, creating "+
+		"funding transaction", len(channels))
+
+	fundingTx, unlockInputs, err := f.cfg.Wallet.FundBatchTx(
+		outputs, batch.feeRate, batch.minConfs,
+	)
+	if err != nil {
+		fndgLog.Errorf("Unable to create batch funding tx: %v", err)
+		f.failBatch(batch, err)
+		return
+	}
+
+	// The batch may have failed while we were creating the funding
+	// transaction, in which case we'll release the coins again.
+	batch.mtx.Lock()
+	if batch.err != nil {
+		batch.mtx.Unlock()
+		unlockInputs()
+		return
+	}
+	batch.fundingTx = fundingTx
+	batch.unlockInputs = unlockInputs
+	batch.mtx.Unlock()
+
+	fndgLog.Debugf("Batch funding tx created: %v", spew.Sdump(fundingTx))
+
+	// With the funding transaction known, every channel can now create its
+	// commitment transactions, and send the funding outpoint over to the
+	// remote peer.
+	for _, c := range channels {
+		err := c.resCtx.reservation.ProcessExternalFunding(
+			fundingTx.Copy(),
+		)
+		if err != nil {
+			fndgLog.Errorf("Unable to process batch funding tx for "+
+				"pendingID(%x): %v", c.pendingChanID[:], err)
+			f.failBatch(batch, err)
+			return
+		}
+
+		err = f.sendFundingCreated(
+			c.resCtx.peer, c.resCtx, c.pendingChanID,
+		)
+		if err != nil {
+			f.failBatch(batch, err)
+			return
+		}
+	}
+}
+
+// handleBatchChannelSigned records that a channel within a batch has been
+// signed by the remote peer. Once all channels within the batch have been
+// signed, the funding transaction is broadcast, and all channels advance to
+// the pending state.
+func (f *fundingManager) handleBatchChannelSigned(batch *fundingBatch,
+	pendingChanID [32]byte, completeChan *channeldb.OpenChannel) {
+
+	batch.mtx.Lock()
+	channel, ok := batch.channels[pendingChanID]
+	if !ok {
+		batch.mtx.Unlock()
+		fndgLog.Errorf("pendingID(%x) not found in funding batch",
+			pendingChanID[:])
+		f.cancelBatchChannel(completeChan)
+		return
+	}
+
+	// If the batch failed in the meantime, the funding transaction won't
+	// ever be broadcast, so the channel we just committed to can be
+	// removed again.
+	if batch.err != nil {
+		batchErr := batch.err
+		batch.mtx.Unlock()
+
+		f.cancelBatchChannel(completeChan)
+		channel.resCtx.err <- fmt.Errorf("funding batch failed: %v",
+			batchErr)
+		return
+	}
+	channel.completeChan = completeChan
+	batch.numSigned++
+
+	if batch.numSigned < batch.numChannels {
+		batch.mtx.Unlock()
+		return
+	}
+	batch.published = true
+	fundingTx := batch.fundingTx
+	channels := make([]*batchChannel, 0, len(batch.channels))
+	for _, c := range batch.channels {
+		channels = append(channels, c)
+	}
+	batch.mtx.Unlock()
+
+	fndgLog.Infof("Broadcasting batch funding tx %v for %d channels",
+		fundingTx.TxHash(), len(channels))
+
+	// As with a regular funding flow, we'll watch the channels regardless
+	// of whether the broadcast succeeds, as the transaction might still
+	// have made it to the network. It will be rebroadcast at startup.
+	if err := f.cfg.PublishTransaction(fundingTx); err != nil {
+		fndgLog.Errorf("Unable to broadcast batch funding tx %v: %v",
+			fundingTx.TxHash(), err)
+	}
+
+	for _, c := range channels {
+		f.watchPendingChannel(
+			c.completeChan, c.pendingChanID, c.resCtx.updates,
+		)
+	}
+}
+
+// failBatch fails all channels within the batch, as long as the batch
+// funding transaction hasn't been broadcast yet. Channels still in the
+// funding flow are canceled, while channels which were already committed to
+// the database are removed again, as their funding transaction will never be
+// broadcast.
+func (f *fundingManager) failBatch(batch *fundingBatch, batchErr error) {
+	batch.mtx.Lock()
+	if batch.err != nil || batch.published {
+		batch.mtx.Unlock()
+		return
+	}
+	batch.err = batchErr
+
+	channels := make([]*batchChannel, 0, len(batch.channels))
+	for _, c := range batch.channels {
+		channels = append(channels, c)
+	}
+	unlockInputs := batch.unlockInputs
+	batch.mtx.Unlock()
+
+	fndgLog.Errorf("Failing funding batch of %d channels: %v",
+		batch.numChannels, batchErr)
+
+	err := fmt.Errorf("funding batch failed: %v", batchErr)
+	for _, c := range channels {
+		peer := c.resCtx.peer
+
+		if c.completeChan != nil {
+			f.cancelBatchChannel(c.completeChan)
+
+			chanID := lnwire.NewChanIDFromOutPoint(
+				&c.completeChan.FundingOutpoint,
+			)
+			errMsg := &lnwire.Error{
+				ChanID: chanID,
+				Data:   lnwire.ErrorData("funding batch failed"),
+			}
+			if err := peer.SendMessage(false, errMsg); err != nil {
+				fndgLog.Errorf("unable to send error message "+
+					"to peer %v", err)
+			}
+
+			c.resCtx.err <- err
+			continue
+		}
+
+		// Only fail the funding flows which are still active, as the
+		// channel which caused the batch to fail has already been
+		// canceled.
+		_, resErr := f.getReservationCtx(peer.IdentityKey(), c.pendingChanID)
+		if resErr != nil {
+			continue
+		}
+		f.failFundingFlow(peer, c.pendingChanID, err)
+	}
+
+	if unlockInputs != nil {
+		unlockInputs()
+	}
+}
+
+// cancelBatchChannel removes a pending channel of a failed batch from the
+// database, as its funding transaction will never be broadcast.
+func (f *fundingManager) cancelBatchChannel(completeChan *channeldb.OpenChannel) {
+	localBalance := completeChan.LocalCommitment.LocalBalance.ToAtoms()
+	closeInfo := &channeldb.ChannelCloseSummary{
+		ChanPoint:               completeChan.FundingOutpoint,
+		ChainHash:               completeChan.ChainHash,
+		RemotePub:               completeChan.IdentityPub,
+		CloseType:               channeldb.FundingCanceled,
+		Capacity:                completeChan.Capacity,
+		SettledBalance:          localBalance,
+		RemoteCurrentRevocation: completeChan.RemoteCurrentRevocation,
+		RemoteNextRevocation:    completeChan.RemoteNextRevocation,
+		LocalChanConfig:         completeChan.LocalChanCfg,
+	}
+
+	if err := completeChan.CloseChannel(closeInfo); err != nil {
+		fndgLog.Errorf("Failed closing channel %v: %v",
+			completeChan.FundingOutpoint, err)
+	}
+}
@@ -41,7 +41,8 @@ const (
 	maxDcrRemoteDelay uint16 = 4032
 
 	// for the funding transaction to be confirmed before forgetting
-	// channels that aren't initiated by us. 4032 blocks is ~2 weeks.
+	// channels that aren't initiated by us. 4032 blocks is ~2 weeks. It's
+	// the default value of the fundingtimeout.maxblocks option.
 	maxWaitNumBlocksFundingConf = 4032
 
 	// minChanFundingSize is the smallest channel that we'll allow to be
@@ -60,9 +61,9 @@ var (
 	// MaxFundingAmount is a soft-limit of the maximum channel size
 	// currently accepted within the Lightning Protocol. This limit is
 	// defined in BOLT-0002, and serves as an initial precautionary limit
-	// while implementations are battle tested in the real world.
-	//
-	// TODO(roasbeef): add command line param to modify
+	// while implementations are battle tested in the real world. Larger
+	// channels can only be funded with peers that negotiated wumbo
+	// channels.
 	MaxFundingAmount = MaxDecredFundingAmount
 
 	// ErrFundingManagerShuttingDown is an error returned when attempting to
@@ -100,6 +101,13 @@ type reservationWithCtx struct {
 	updateMtx   sync.RWMutex
 	lastUpdated time.Time
 
+	// batch is the funding batch this reservation is part of, if any.
+	batch *fundingBatch
+
+	// zeroConf is set if we requested the channel to be usable before
+	// its funding transaction confirms.
+	zeroConf bool
+
 	updates chan *lnrpc.OpenStatusUpdate
 	err     chan error
 }
@@ -217,6 +225,10 @@ type fundingConfig struct {
 	// transaction information.
 	FeeEstimator lnwallet.FeeEstimator
 
+	// CommitFeeConfTarget is the confirmation target used to estimate the
+	// fee rate of the initial commitments of the channels we fund.
+	CommitFeeConfTarget uint32
+
 	// Notifier is used by the FundingManager to determine when the
 	// channel's funding transaction has been confirmed on the blockchain
 	// so that the channel creation process can be completed.
@@ -319,10 +331,47 @@ type fundingConfig struct {
 	// due to fees.
 	MinChanSize dcrutil.Amount
 
+	// MaxChanSize is the largest channel size that we'll fund or accept. It
+	// is only allowed to exceed MaxFundingAmount for channels with peers
+	// that signal support for wumbo channels. A value of zero defaults to
+	// MaxFundingAmount.
+	MaxChanSize dcrutil.Amount
+
+	// AcceptWumbo returns true if the peer identified by the passed key is
+	// allowed to open a channel above MaxFundingAmount with us. If nil,
+	// wumbo channels are accepted from any peer signaling support for
+	// them.
+	AcceptWumbo func(*secp256k1.PublicKey) bool
+
+	// AcceptZeroConf returns true if the peer identified by the passed key
+	// is allowed to open a zero-conf channel with us. If nil, zero-conf
+	// channels are rejected from all peers.
+	AcceptZeroConf func(*secp256k1.PublicKey) bool
+
+	// NextAliasShortChanID allocates a new alias short channel ID, which
+	// identifies a zero-conf channel until its funding transaction
+	// confirms.
+	NextAliasShortChanID func() (lnwire.ShortChannelID, error)
+
+	// ReportConfirmedShortChanID informs the switch of the short channel
+	// ID the funding transaction of a zero-conf channel confirmed at, so
+	// HTLCs forwarded using it reach the channel.
+	ReportConfirmedShortChanID func(wire.OutPoint,
+		lnwire.ShortChannelID) error
+
+	// DeleteAliasEdge removes the edge of a zero-conf channel identified
+	// by its alias short channel ID from the channel graph, once the
+	// channel is announced under its confirmed one.
+	DeleteAliasEdge func(lnwire.ShortChannelID) error
+
 	// MaxPendingChannels is the maximum number of pending channels we
 	// allow for each peer.
 	MaxPendingChannels int
 
+	// MaxGlobalPendingChannels is the maximum number of pending channels
+	// we allow across all peers. A value of zero disables this limit.
+	MaxGlobalPendingChannels int
+
 	// RejectPush is set true if the fundingmanager should reject any
 	// incoming channels having a non-zero push amount.
 	RejectPush bool
@@ -331,6 +380,22 @@ type fundingConfig struct {
 	// transition from pending open to open.
 	NotifyOpenChannelEvent func(wire.OutPoint)
 
+	// NotifyClosedChannelEvent informs the ChannelNotifier when pending
+	// channels are abandoned because their funding transaction didn't
+	// confirm in time.
+	NotifyClosedChannelEvent func(wire.OutPoint)
+
+	// MaxWaitNumBlocksFundingConf is the number of blocks after which a
+	// pending channel whose funding transaction hasn't confirmed is
+	// abandoned. If zero, maxWaitNumBlocksFundingConf is used.
+	MaxWaitNumBlocksFundingConf uint32
+
+	// AbandonInitiatedChannels indicates whether the pending channels we
+	// initiated are also abandoned once MaxWaitNumBlocksFundingConf blocks
+	// have passed. Their funding transaction is then removed from the
+	// wallet, returning the outputs it spends to the wallet.
+	AbandonInitiatedChannels bool
+
 	// OpenChannelPredicate is a predicate on the lnwire.OpenChannel message
 	// and on the requesting node's public key that returns a bool which tells
 	// the funding manager whether or not to accept the channel.
@@ -616,6 +681,34 @@ func (f *fundingManager) PendingChannels() ([]*pendingChannel, error) {
 	}
 }
 
+// pendingChannelCounts returns the number of pending channels with each peer
+// that has any. This is the sum of the active reservations and the channels
+// pending open in the database.
+func (f *fundingManager) pendingChannelCounts() (map[serializedPubKey]int,
+	error) {
+
+	counts := make(map[serializedPubKey]int)
+
+	f.resMtx.RLock()
+	for peerIDKey, reservations := range f.activeReservations {
+		if len(reservations) > 0 {
+			counts[peerIDKey] += len(reservations)
+		}
+	}
+	f.resMtx.RUnlock()
+
+	channels, err := f.cfg.Wallet.Cfg.Database.FetchPendingChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range channels {
+		counts[newSerializedKey(c.IdentityPub)]++
+	}
+
+	return counts, nil
+}
+
 // CancelPeerReservations cancels all active reservations associated with the
 // passed node. This will ensure any outputs which have been pre committed,
 // (and thus locked from coin selection), are properly freed.
@@ -652,6 +745,20 @@ func (f *fundingManager) CancelPeerReservations(nodePub [33]byte) {
 	delete(f.activeReservations, nodePub)
 }
 
+// hasAnchorsFeature returns true if both our node and the given peer signal
+// support for the anchor commitment format, in which case new channels with
+// the peer will use it.
+func hasAnchorsFeature(peer lnpeer.Peer) bool {
+	localAnchors := peer.LocalGlobalFeatures().HasFeature(
+		lnwire.AnchorsOptional,
+	)
+	remoteAnchors := peer.RemoteGlobalFeatures().HasFeature(
+		lnwire.AnchorsOptional,
+	)
+
+	return localAnchors && remoteAnchors
+}
+
 // failFundingFlow will fail the active funding flow with the target peer,
 // identified by its unique temporary channel ID. This method will send an
 // error to the remote peer, and also remove the reservation from our set of
@@ -671,9 +778,14 @@ func (f *fundingManager) failFundingFlow(peer lnpeer.Peer, tempChanID [32]byte,
 	}
 
 	// In case the case where the reservation existed, send the funding
-	// error on the error channel.
+	// error on the error channel. If the reservation was part of a funding
+	// batch, the whole batch fails along with it.
 	if ctx != nil {
 		ctx.err <- fundingErr
+
+		if ctx.batch != nil {
+			f.failBatch(ctx.batch, fundingErr)
+		}
 	}
 
 	// We only send the exact error if it is part of out whitelisted set of
@@ -765,8 +877,18 @@ func (f *fundingManager) advanceFundingState(channel *channeldb.OpenChannel,
 	defer f.wg.Done()
 
 	// If the channel is still pending we must wait for the funding
-	// transaction to confirm.
-	if channel.IsPending {
+	// transaction to confirm, unless it is a zero-conf channel, which is
+	// marked open right away under an alias short channel ID.
+	switch {
+	case channel.IsPending && channel.IsZeroConf():
+		if err := f.markZeroConfOpen(channel); err != nil {
+			fndgLog.Errorf("Unable to mark zero-conf "+
+				"ChannelPoint(%v) open: %v",
+				channel.FundingOutpoint, err)
+			return
+		}
+
+	case channel.IsPending:
 		err := f.advancePendingChannelState(channel, pendingChanID)
 		if err != nil {
 			fndgLog.Errorf("Unable to advance pending state of "+
@@ -923,6 +1045,28 @@ func (f *fundingManager) stateStep(channel *channeldb.OpenChannel,
 	// The channel was added to the Router's topology, but the channel
 	// announcement was not sent.
 	case addedToRouterGraph:
+		// Zero-conf channels are added to the Router's topology under
+		// their alias before the funding transaction confirms, so
+		// we'll wait for it to before announcing the channel.
+		if shortChanID.IsAlias() {
+			confirmedID, err := f.waitForZeroConfConfirmation(
+				channel,
+			)
+			if err != nil {
+				return fmt.Errorf("error waiting for zero-conf "+
+					"confirmation: %v", err)
+			}
+
+			// Public channels are announced under their confirmed
+			// short channel ID, so we'll replace the alias edge
+			// and continue the state machine from there.
+			if channel.ChannelFlags&lnwire.FFAnnounceChannel != 0 {
+				return f.replaceAliasEdge(
+					channel, shortChanID, confirmedID,
+				)
+			}
+		}
+
 		err := f.annAfterSixConfs(channel, shortChanID)
 		if err != nil {
 			return fmt.Errorf("error sending channel "+
@@ -957,10 +1101,17 @@ func (f *fundingManager) advancePendingChannelState(
 	confChannel, err := f.waitForFundingWithTimeout(channel)
 	if err == ErrConfirmationTimeout {
 		// We'll get a timeout if the number of blocks mined
-		// since the channel was initiated reaches
-		// maxWaitNumBlocksFundingConf and we are not the
-		// channel initiator.
+		// since the channel was initiated reaches maxWaitNumBlocks
+		// and we are not the channel initiator, or are configured to
+		// abandon the channels we initiated.
 		ch := channel
+		if ch.IsInitiator {
+			if err := f.abandonFundingTx(ch); err != nil {
+				return fmt.Errorf("unable to abandon funding "+
+					"tx of %v: %v", ch.FundingOutpoint, err)
+			}
+		}
+
 		localBalance := ch.LocalCommitment.LocalBalance.ToAtoms()
 		closeInfo := &channeldb.ChannelCloseSummary{
 			ChainHash:               ch.ChainHash,
@@ -979,6 +1130,10 @@ func (f *fundingManager) advancePendingChannelState(
 				"%v: %v", ch.FundingOutpoint, err)
 		}
 
+		// Subscribers to channel events learn about the abandoned
+		// channel through its close summary.
+		f.cfg.NotifyClosedChannelEvent(ch.FundingOutpoint)
+
 		timeoutErr := fmt.Errorf("timeout waiting for funding tx "+
 			"(%v) to confirm", channel.FundingOutpoint)
 
@@ -1028,6 +1183,147 @@ func (f *fundingManager) advancePendingChannelState(
 	return nil
 }
 
+// abandonFundingTx removes the unconfirmed funding transaction of a pending
+// channel we initiated from the wallet, so that the outputs it spends are
+// eligible for coin selection again.
+func (f *fundingManager) abandonFundingTx(ch *channeldb.OpenChannel) error {
+	fundingTx := ch.FundingTxn
+	if fundingTx == nil {
+		// The funding transaction wasn't created by our wallet, so
+		// there's nothing to return to it.
+		return nil
+	}
+
+	fundingTxid := fundingTx.TxHash()
+	fndgLog.Warnf("Abandoning funding tx %v of ChannelPoint(%v)",
+		fundingTxid, ch.FundingOutpoint)
+
+	if err := f.cfg.Wallet.AbandonTransaction(&fundingTxid); err != nil {
+		return err
+	}
+
+	for _, txIn := range fundingTx.TxIn {
+		f.cfg.Wallet.UnlockOutpoint(txIn.PreviousOutPoint)
+	}
+
+	return nil
+}
+
+// markZeroConfOpen marks a pending zero-conf channel as open in the database
+// under a newly allocated alias short channel ID, and sets the
+// channelOpeningState markedOpen. The channel can then be used before its
+// funding transaction confirms.
+func (f *fundingManager) markZeroConfOpen(
+	completeChan *channeldb.OpenChannel) error {
+
+	fundingPoint := completeChan.FundingOutpoint
+	chanID := lnwire.NewChanIDFromOutPoint(&fundingPoint)
+
+	alias, err := f.cfg.NextAliasShortChanID()
+	if err != nil {
+		return fmt.Errorf("unable to allocate alias: %v", err)
+	}
+
+	fndgLog.Infof("Marking zero-conf ChannelPoint(%v) open with "+
+		"alias=%v", fundingPoint, alias)
+
+	// As with confirmed channels, we set the opening state before we mark
+	// the channel open in the database, such that we can recover from one
+	// of the db writes failing.
+	err = f.saveChannelOpeningState(&fundingPoint, markedOpen, &alias)
+	if err != nil {
+		return fmt.Errorf("error setting channel state to "+
+			"markedOpen: %v", err)
+	}
+
+	if err := completeChan.MarkAsOpen(alias); err != nil {
+		return fmt.Errorf("error setting channel pending flag to "+
+			"false: %v", err)
+	}
+
+	// Inform the ChannelNotifier that the channel has transitioned from
+	// pending open to open.
+	f.cfg.NotifyOpenChannelEvent(fundingPoint)
+
+	// There might already be a pending link in the switch if we restarted
+	// mid funding flow, so we'll instruct it to load the alias.
+	if err := f.cfg.ReportShortChanID(fundingPoint); err != nil {
+		fndgLog.Errorf("unable to report short chan id: %v", err)
+	}
+
+	// Close the discoverySignal channel, allowing the funding locked
+	// message of the peer to be processed.
+	f.localDiscoveryMtx.Lock()
+	if discoverySignal, ok := f.localDiscoverySignals[chanID]; ok {
+		close(discoverySignal)
+	}
+	f.localDiscoveryMtx.Unlock()
+
+	return nil
+}
+
+// waitForZeroConfConfirmation waits for the funding transaction of a zero-conf
+// channel to confirm, and records the short channel ID it confirmed at. The
+// switch is informed of it, so HTLCs forwarded using it reach the channel.
+func (f *fundingManager) waitForZeroConfConfirmation(
+	completeChan *channeldb.OpenChannel) (*lnwire.ShortChannelID, error) {
+
+	confChannel, err := f.waitForFundingWithTimeout(completeChan)
+	if err != nil {
+		return nil, err
+	}
+
+	err = f.cfg.Wallet.ValidateChannel(completeChan, confChannel.fundingTx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to validate channel: %v", err)
+	}
+
+	confirmedID := confChannel.shortChanID
+	if err := completeChan.MarkZeroConfConfirmed(confirmedID); err != nil {
+		return nil, fmt.Errorf("unable to mark zero-conf channel "+
+			"confirmed: %v", err)
+	}
+
+	fndgLog.Infof("Zero-conf ChannelPoint(%v) with alias=%v confirmed "+
+		"at short_chan_id=%v", completeChan.FundingOutpoint,
+		completeChan.ShortChanID(), confirmedID)
+
+	err = f.cfg.ReportConfirmedShortChanID(
+		completeChan.FundingOutpoint, confirmedID,
+	)
+	if err != nil {
+		fndgLog.Errorf("unable to report confirmed short chan id: %v",
+			err)
+	}
+
+	return &confirmedID, nil
+}
+
+// replaceAliasEdge replaces the edge of a public zero-conf channel added to the
+// Router's topology under its alias with one using the short channel ID its
+// funding transaction confirmed at, under which the channel is announced.
+func (f *fundingManager) replaceAliasEdge(completeChan *channeldb.OpenChannel,
+	alias, confirmedID *lnwire.ShortChannelID) error {
+
+	if err := f.cfg.DeleteAliasEdge(*alias); err != nil {
+		return fmt.Errorf("unable to delete alias edge: %v", err)
+	}
+
+	if err := f.addToRouterGraph(completeChan, confirmedID); err != nil {
+		return fmt.Errorf("failed adding to router graph: %v", err)
+	}
+
+	err := f.saveChannelOpeningState(
+		&completeChan.FundingOutpoint, addedToRouterGraph, confirmedID,
+	)
+	if err != nil {
+		return fmt.Errorf("error setting channel state to"+
+			" addedToRouterGraph: %v", err)
+	}
+
+	return nil
+}
+
 // handlePendingChannels responds to a request for details concerning all
 // currently pending channels waiting for the final phase of the funding
 // workflow (funding txn confirmation).
@@ -1082,14 +1378,9 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 	msg := fmsg.msg
 	amt := msg.FundingAmount
 
-	// We count the number of pending channels for this peer. This is the
-	// sum of the active reservations and the channels pending open in the
-	// database.
-	f.resMtx.RLock()
-	numPending := len(f.activeReservations[peerIDKey])
-	f.resMtx.RUnlock()
-
-	channels, err := f.cfg.Wallet.Cfg.Database.FetchOpenChannels(peerPubKey)
+	// We count the number of pending channels for this peer, as well as
+	// across all peers.
+	pendingChans, err := f.pendingChannelCounts()
 	if err != nil {
 		f.failFundingFlow(
 			fmsg.peer, fmsg.msg.PendingChannelID, err,
@@ -1097,10 +1388,11 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		return
 	}
 
-	for _, c := range channels {
-		if c.IsPending {
-			numPending++
-		}
+	numPending := pendingChans[peerIDKey]
+
+	var numGlobalPending int
+	for _, n := range pendingChans {
+		numGlobalPending += n
 	}
 
 	// TODO(roasbeef): modify to only accept a _single_ pending channel per
@@ -1113,6 +1405,18 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		return
 	}
 
+	// If a global limit is set, we'll also ensure that accepting this
+	// channel doesn't exceed it.
+	if f.cfg.MaxGlobalPendingChannels > 0 &&
+		numGlobalPending >= f.cfg.MaxGlobalPendingChannels {
+
+		f.failFundingFlow(
+			fmsg.peer, fmsg.msg.PendingChannelID,
+			lnwire.ErrMaxGlobalPendingChannels,
+		)
+		return
+	}
+
 	// We'll also reject any requests to create channels until we're fully
 	// synced to the network as we won't be able to properly validate the
 	// confirmation of the funding transaction.
@@ -1129,8 +1433,18 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 	}
 
 	// We'll reject any request to create a channel that's above the
-	// current soft-limit for channel size.
-	if msg.FundingAmount > MaxFundingAmount {
+	// current soft-limit for channel size. Wumbo channels above the
+	// default limit are additionally subject to our per-peer policy.
+	peerKey := fmsg.peer.IdentityKey()
+	tooLarge := msg.FundingAmount > f.maxChanSize(fmsg.peer)
+	if !tooLarge && msg.FundingAmount > MaxFundingAmount &&
+		f.cfg.AcceptWumbo != nil && !f.cfg.AcceptWumbo(peerKey) {
+
+		fndgLog.Infof("Rejecting wumbo channel of %v from peer %x",
+			msg.FundingAmount, peerKey.SerializeCompressed())
+		tooLarge = true
+	}
+	if tooLarge {
 		f.failFundingFlow(
 			fmsg.peer, fmsg.msg.PendingChannelID,
 			lnwire.ErrChanTooLarge,
@@ -1158,11 +1472,45 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		return
 	}
 
+	// Zero-conf channels can be used before their funding transaction
+	// confirms, so we'll only accept them from the peers we trust not to
+	// double spend it.
+	zeroConf := msg.ChannelFlags&lnwire.FFZeroConf != 0
+	if zeroConf && (f.cfg.AcceptZeroConf == nil ||
+		!f.cfg.AcceptZeroConf(peerKey)) {
+
+		fndgLog.Infof("Rejecting zero-conf channel from peer %x",
+			peerKey.SerializeCompressed())
+		f.failFundingFlow(
+			fmsg.peer, fmsg.msg.PendingChannelID,
+			fmt.Errorf("zero-conf channel rejected"),
+		)
+		return
+	}
+
+	// Before we hand the request over to the ChannelAcceptor, we'll check
+	// to see if we've negotiated the new tweakless commitment format. This
+	// is only the case if *both* us and the remote peer are signaling the
+	// proper feature bit.
+	localTweakless := fmsg.peer.LocalGlobalFeatures().HasFeature(
+		lnwire.StaticRemoteKeyOptional,
+	)
+	remoteTweakless := fmsg.peer.RemoteGlobalFeatures().HasFeature(
+		lnwire.StaticRemoteKeyOptional,
+	)
+	tweaklessCommitment := localTweakless && remoteTweakless
+
+	// Similarly, we'll only use the anchor commitment format if both
+	// sides signal support for it.
+	anchorCommitment := hasAnchorsFeature(fmsg.peer)
+
 	// Send the OpenChannel request to the ChannelAcceptor to determine whether
 	// this node will accept the channel.
 	chanReq := &chanacceptor.ChannelAcceptRequest{
 		Node:        fmsg.peer.IdentityKey(),
 		OpenChanMsg: fmsg.msg,
+		Tweakless:   tweaklessCommitment,
+		Anchors:     anchorCommitment,
 	}
 
 	if !f.cfg.OpenChannelPredicate.Accept(chanReq) {
@@ -1190,18 +1538,6 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 	// reservation attempt may be rejected. Note that since we're on the
 	// responding side of a single funder workflow, we don't commit any
 	// funds to the channel ourselves.
-	//
-	// Before we init the channel, we'll also check to see if we've
-	// negotiated the new tweakless commitment format. This is only the
-	// case if *both* us and the remote peer are signaling the proper
-	// feature bit.
-	localTweakless := fmsg.peer.LocalGlobalFeatures().HasFeature(
-		lnwire.StaticRemoteKeyOptional,
-	)
-	remoteTweakless := fmsg.peer.RemoteGlobalFeatures().HasFeature(
-		lnwire.StaticRemoteKeyOptional,
-	)
-	tweaklessCommitment := localTweakless && remoteTweakless
 	chainHash := msg.ChainHash
 	req := &lnwallet.InitFundingReserveMsg{
 		ChainHash:        &chainHash,
@@ -1215,6 +1551,7 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		Flags:            msg.ChannelFlags,
 		MinConfs:         1,
 		Tweakless:        tweaklessCommitment,
+		Anchors:          anchorCommitment,
 	}
 
 	reservation, err := f.cfg.Wallet.InitChannelReservation(req)
@@ -1229,7 +1566,11 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 	// use our mapping to derive the proper number of confirmations based on
 	// the amount of the channel, and also if any funds are being pushed to
 	// us.
+	// Zero-conf channels are usable right away.
 	numConfsReq := f.cfg.NumRequiredConfs(msg.FundingAmount, msg.PushAmount)
+	if zeroConf {
+		numConfsReq = 0
+	}
 	reservation.SetNumConfsRequired(numConfsReq)
 
 	// We'll also validate and apply all the constraints the initiating
@@ -1250,9 +1591,9 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 	}
 
 	fndgLog.Infof("Requiring %v confirmations for pendingChan(%x): "+
-		"amt=%v, push_amt=%v, tweakless=%v", numConfsReq,
+		"amt=%v, push_amt=%v, tweakless=%v, anchors=%v", numConfsReq,
 		fmsg.msg.PendingChannelID, amt, msg.PushAmount,
-		tweaklessCommitment)
+		tweaklessCommitment, anchorCommitment)
 
 	// Generate our required constraints for the remote party.
 	remoteCsvDelay := f.cfg.RequiredRemoteDelay(amt)
@@ -1320,6 +1661,11 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		return
 	}
 
+	// If the initiator committed to the address their funds are paid to
+	// upon a cooperative close, we'll record it so it can be enforced
+	// once the channel is closed.
+	reservation.SetTheirUpfrontShutdown(msg.UpfrontShutdownScript)
+
 	fndgLog.Infof("Sending fundingResp for pendingID(%x)",
 		msg.PendingChannelID)
 	fndgLog.Debugf("Remote party accepted commitment constraints: %v",
@@ -1329,20 +1675,21 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 	// contribution in the next message of the workflow.
 	ourContribution := reservation.OurContribution()
 	fundingAccept := lnwire.AcceptChannel{
-		PendingChannelID:     msg.PendingChannelID,
-		DustLimit:            ourContribution.DustLimit,
-		MaxValueInFlight:     maxValue,
-		ChannelReserve:       chanReserve,
-		MinAcceptDepth:       uint32(numConfsReq),
-		HtlcMinimum:          minHtlc,
-		CsvDelay:             remoteCsvDelay,
-		MaxAcceptedHTLCs:     maxHtlcs,
-		FundingKey:           ourContribution.MultiSigKey.PubKey,
-		RevocationPoint:      ourContribution.RevocationBasePoint.PubKey,
-		PaymentPoint:         ourContribution.PaymentBasePoint.PubKey,
-		DelayedPaymentPoint:  ourContribution.DelayBasePoint.PubKey,
-		HtlcPoint:            ourContribution.HtlcBasePoint.PubKey,
-		FirstCommitmentPoint: ourContribution.FirstCommitmentPoint,
+		PendingChannelID:      msg.PendingChannelID,
+		DustLimit:             ourContribution.DustLimit,
+		MaxValueInFlight:      maxValue,
+		ChannelReserve:        chanReserve,
+		MinAcceptDepth:        uint32(numConfsReq),
+		HtlcMinimum:           minHtlc,
+		CsvDelay:              remoteCsvDelay,
+		MaxAcceptedHTLCs:      maxHtlcs,
+		FundingKey:            ourContribution.MultiSigKey.PubKey,
+		RevocationPoint:       ourContribution.RevocationBasePoint.PubKey,
+		PaymentPoint:          ourContribution.PaymentBasePoint.PubKey,
+		DelayedPaymentPoint:   ourContribution.DelayBasePoint.PubKey,
+		HtlcPoint:             ourContribution.HtlcBasePoint.PubKey,
+		FirstCommitmentPoint:  ourContribution.FirstCommitmentPoint,
+		UpfrontShutdownScript: reservation.OurUpfrontShutdown(),
 	}
 	if err := fmsg.peer.SendMessage(false, &fundingAccept); err != nil {
 		fndgLog.Errorf("unable to send funding response to peer: %v", err)
@@ -1395,6 +1742,16 @@ func (f *fundingManager) handleFundingAccept(fmsg *fundingAcceptMsg) {
 		return
 	}
 
+	// If we requested a zero-conf channel, the responder must agree to
+	// use it right away.
+	if resCtx.zeroConf && msg.MinAcceptDepth != 0 {
+		err := fmt.Errorf("zero-conf channel requires min accept "+
+			"depth of 0, got %v", msg.MinAcceptDepth)
+		fndgLog.Warnf("Unacceptable channel constraints: %v", err)
+		f.failFundingFlow(fmsg.peer, fmsg.msg.PendingChannelID, err)
+		return
+	}
+
 	// We'll also specify the responder's preference for the number of
 	// required confirmations, and also the set of channel constraints
 	// they've specified for commitment states we can create.
@@ -1461,13 +1818,49 @@ func (f *fundingManager) handleFundingAccept(fmsg *fundingAcceptMsg) {
 		return
 	}
 
+	// Record the address the responder committed to for a cooperative
+	// close, if any, so it can be enforced once the channel is closed.
+	resCtx.reservation.SetTheirUpfrontShutdown(msg.UpfrontShutdownScript)
+
 	fndgLog.Infof("pendingChan(%x): remote party proposes num_confs=%v, "+
 		"csv_delay=%v", pendingChanID[:], msg.MinAcceptDepth, msg.CsvDelay)
 	fndgLog.Debugf("Remote party accepted commitment constraints: %v",
 		spew.Sdump(remoteContribution.ChannelConfig.ChannelConstraints))
 
-	// Now that we have their contribution, we can extract, then send over
-	// both the funding out point and our signature for their version of
+	// If this channel is part of a funding batch, then the funding
+	// transaction can only be created once all channels within the batch
+	// have been accepted. We'll hand our funding output over to the batch,
+	// which will resume the funding flow once the funding transaction is
+	// known.
+	if resCtx.batch != nil {
+		fundingOutput, err := resCtx.reservation.FundingOutput()
+		if err != nil {
+			fndgLog.Errorf("Unable to fetch funding output for "+
+				"pendingID(%x): %v", pendingChanID[:], err)
+			f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+			return
+		}
+
+		f.handleBatchFundingOutput(
+			resCtx.batch, pendingChanID, fundingOutput,
+		)
+		return
+	}
+
+	err = f.sendFundingCreated(fmsg.peer, resCtx, pendingChanID)
+	if err != nil {
+		f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+	}
+}
+
+// sendFundingCreated sends the funding outpoint along with our signature for
+// the remote party's version of the commitment transaction to the remote
+// peer, once the funding transaction of the reservation is known.
+func (f *fundingManager) sendFundingCreated(peer lnpeer.Peer,
+	resCtx *reservationWithCtx, pendingChanID [32]byte) error {
+
+	// Now that the funding transaction is known, we can extract, then send
+	// over both the funding out point and our signature for their version of
 	// the commitment transaction to the remote peer.
 	outPoint := resCtx.reservation.FundingOutpoint()
 	_, sig := resCtx.reservation.OurSignatures()
@@ -1497,17 +1890,18 @@ func (f *fundingManager) handleFundingAccept(fmsg *fundingAcceptMsg) {
 		PendingChannelID: pendingChanID,
 		FundingPoint:     *outPoint,
 	}
+	var err error
 	fundingCreated.CommitSig, err = lnwire.NewSigFromRawSignature(sig)
 	if err != nil {
 		fndgLog.Errorf("Unable to parse signature: %v", err)
-		f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
-		return
+		return err
 	}
-	if err := fmsg.peer.SendMessage(false, fundingCreated); err != nil {
+	if err := peer.SendMessage(false, fundingCreated); err != nil {
 		fndgLog.Errorf("Unable to send funding complete message: %v", err)
-		f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
-		return
+		return err
 	}
+
+	return nil
 }
 
 // processFundingCreated queues a funding complete message coupled with the
@@ -1728,6 +2122,16 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 	// delete it from our set of active reservations.
 	f.deleteReservationCtx(peerKey, pendingChanID)
 
+	// If this channel is part of a funding batch, the funding transaction
+	// is only broadcast once all channels within the batch have been
+	// signed.
+	if resCtx.batch != nil {
+		f.handleBatchChannelSigned(
+			resCtx.batch, pendingChanID, completeChan,
+		)
+		return
+	}
+
 	// Broadcast the finalized funding transaction to the network.
 	fundingTx := completeChan.FundingTxn
 	fndgLog.Infof("Broadcasting funding tx for ChannelPoint(%v): %v",
@@ -1745,6 +2149,18 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 		// delete from the DB?
 	}
 
+	f.watchPendingChannel(completeChan, pendingChanID, resCtx.updates)
+}
+
+// watchPendingChannel starts watching a pending channel whose funding
+// transaction has been broadcast, notifies the caller that the channel is
+// pending, and advances the funding state of the channel from there on.
+func (f *fundingManager) watchPendingChannel(completeChan *channeldb.OpenChannel,
+	pendingChanID [32]byte, updates chan *lnrpc.OpenStatusUpdate) {
+
+	peerKey := completeChan.IdentityPub
+	fundingPoint := &completeChan.FundingOutpoint
+
 	// Now that we have a finalized reservation for this funding flow,
 	// we'll send the to be active channel to the ChainArbitrator so it can
 	// watch for any on-chain actions before the channel has fully
@@ -1772,7 +2188,7 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 	}
 
 	select {
-	case resCtx.updates <- upd:
+	case updates <- upd:
 	case <-f.quit:
 		return
 	}
@@ -1780,7 +2196,7 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 	// At this point we have broadcast the funding transaction and done all
 	// necessary processing.
 	f.wg.Add(1)
-	go f.advanceFundingState(completeChan, pendingChanID, resCtx.updates)
+	go f.advanceFundingState(completeChan, pendingChanID, updates)
 }
 
 // confirmedChannel wraps a confirmed funding transaction, as well as the short
@@ -1813,8 +2229,11 @@ func (f *fundingManager) waitForFundingWithTimeout(
 
 	// If we are not the initiator, we have no money at stake and will
 	// timeout waiting for the funding transaction to confirm after a
-	// while.
-	if !ch.IsInitiator {
+	// while. The channels we initiated only timeout if configured to.
+	// Zero-conf channels are already in use, so we'll keep waiting for
+	// them.
+	canTimeout := !ch.IsInitiator || f.cfg.AbandonInitiatedChannels
+	if canTimeout && !ch.IsZeroConf() {
 		f.wg.Add(1)
 		go f.waitForTimeout(ch, cancelChan, timeoutChan)
 	}
@@ -1881,7 +2300,12 @@ func (f *fundingManager) waitForFundingConfirmation(
 			err)
 		return
 	}
+	// Zero-conf channels don't require any confirmation to be used, but
+	// we still wait for the first one to learn its short channel ID.
 	numConfs := uint32(completeChan.NumConfsRequired)
+	if numConfs == 0 {
+		numConfs = 1
+	}
 	confNtfn, err := f.cfg.Notifier.RegisterConfirmationsNtfn(
 		&txid, fundingScript, numConfs,
 		completeChan.FundingBroadcastHeight,
@@ -1948,8 +2372,18 @@ func (f *fundingManager) waitForFundingConfirmation(
 	}
 }
 
-// waitForTimeout will close the timeout channel if maxWaitNumBlocksFundingConf
-// has passed from the broadcast height of the given channel. In case of error,
+// maxWaitNumBlocks returns the number of blocks to wait for the funding
+// transaction of a pending channel to confirm before abandoning it.
+func (f *fundingManager) maxWaitNumBlocks() uint32 {
+	if f.cfg.MaxWaitNumBlocksFundingConf == 0 {
+		return maxWaitNumBlocksFundingConf
+	}
+
+	return f.cfg.MaxWaitNumBlocksFundingConf
+}
+
+// waitForTimeout will close the timeout channel if maxWaitNumBlocks has
+// passed from the broadcast height of the given channel. In case of error,
 // the error is sent on timeoutChan. The wait can be canceled by closing the
 // cancelChan.
 //
@@ -1969,7 +2403,8 @@ func (f *fundingManager) waitForTimeout(completeChan *channeldb.OpenChannel,
 	defer epochClient.Cancel()
 
 	// On block maxHeight we will cancel the funding confirmation wait.
-	maxHeight := completeChan.FundingBroadcastHeight + maxWaitNumBlocksFundingConf
+	maxWaitNumBlocks := f.maxWaitNumBlocks()
+	maxHeight := completeChan.FundingBroadcastHeight + maxWaitNumBlocks
 	for {
 		select {
 		case epoch, ok := <-epochClient.Epochs:
@@ -1984,18 +2419,13 @@ func (f *fundingManager) waitForTimeout(completeChan *channeldb.OpenChannel,
 			if uint32(epoch.Height) >= maxHeight {
 				fndgLog.Warnf("Waited for %v blocks without "+
 					"seeing funding transaction confirmed,"+
-					" cancelling.",
-					maxWaitNumBlocksFundingConf)
+					" cancelling.", maxWaitNumBlocks)
 
 				// Notify the caller of the timeout.
 				close(timeoutChan)
 				return
 			}
 
-			// TODO: If we are the channel initiator implement
-			// a method for recovering the funds from the funding
-			// transaction
-
 		case <-cancelChan:
 			return
 
@@ -2718,6 +3148,25 @@ func (f *fundingManager) initFundingWorkflow(peer lnpeer.Peer, req *openChanReq)
 	}
 }
 
+// maxChanSize returns the largest channel size that can be funded with the
+// passed peer. Our configured max channel size only applies above the default
+// soft-limit if the peer signals support for wumbo channels.
+func (f *fundingManager) maxChanSize(peer lnpeer.Peer) dcrutil.Amount {
+	maxChanSize := f.cfg.MaxChanSize
+	if maxChanSize == 0 {
+		return MaxFundingAmount
+	}
+
+	remoteWumbo := peer.RemoteLocalFeatures().HasFeature(
+		lnwire.WumboChannelsOptional,
+	)
+	if maxChanSize > MaxFundingAmount && !remoteWumbo {
+		return MaxFundingAmount
+	}
+
+	return maxChanSize
+}
+
 // handleInitFundingMsg creates a channel reservation within the daemon's
 // wallet, then sends a funding request to the remote peer kicking off the
 // funding workflow.
@@ -2744,9 +3193,12 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 
 	// First, we'll query the fee estimator for a fee that should get the
 	// commitment transaction confirmed by the next few blocks (conf target
-	// of 3). We target the near blocks here to ensure that we'll be able
-	// to execute a timely unilateral channel closure if needed.
-	commitFeePerKB, err := f.cfg.FeeEstimator.EstimateFeePerKB(3)
+	// of 3 by default). We target the near blocks here to ensure that
+	// we'll be able to execute a timely unilateral channel closure if
+	// needed.
+	commitFeePerKB, err := f.cfg.FeeEstimator.EstimateFeePerKB(
+		f.cfg.CommitFeeConfTarget,
+	)
 	if err != nil {
 		msg.err <- err
 		return
@@ -2760,6 +3212,23 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		channelFlags = lnwire.FFAnnounceChannel
 	}
 
+	// A zero-conf channel can only be opened with a peer that understands
+	// the feature, as it would otherwise wait for the funding transaction
+	// to confirm.
+	if msg.zeroConf {
+		remoteZeroConf := msg.peer.RemoteLocalFeatures().HasFeature(
+			lnwire.ZeroConfOptional,
+		)
+		if !remoteZeroConf {
+			msg.err <- fmt.Errorf("peer %x doesn't support "+
+				"zero-conf channels",
+				peerKey.SerializeCompressed())
+			return
+		}
+
+		channelFlags |= lnwire.FFZeroConf
+	}
+
 	// Record the peer address only for outbound connections, since inbound
 	// connections are unlikely to be recoverable from our end.
 	var peerAddr net.Addr
@@ -2767,6 +3236,27 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		peerAddr = msg.peer.Address()
 	}
 
+	// An upfront shutdown script is only enforced by the remote peer if
+	// it understands the feature, so we'll refuse to open the channel
+	// rather than silently dropping the requested close address.
+	remoteUpfrontShutdown := msg.peer.RemoteLocalFeatures().HasFeature(
+		lnwire.UpfrontShutdownScriptOptional,
+	)
+	if len(msg.shutdownScript) > 0 && !remoteUpfrontShutdown {
+		msg.err <- fmt.Errorf("peer %x doesn't support upfront "+
+			"shutdown scripts", peerKey.SerializeCompressed())
+		return
+	}
+
+	// Channels above the default soft-limit for channel size can only be
+	// opened if the remote peer signals support for wumbo channels.
+	if maxChanSize := f.maxChanSize(msg.peer); localAmt > maxChanSize {
+		msg.err <- fmt.Errorf("funding amount %v is too large, the max "+
+			"channel size with peer %x is: %v", localAmt,
+			peerKey.SerializeCompressed(), maxChanSize)
+		return
+	}
+
 	// Initialize a funding reservation with the local wallet. If the
 	// wallet doesn't have enough funds to commit to this channel, then the
 	// request will fail, and be aborted.
@@ -2782,6 +3272,11 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		lnwire.StaticRemoteKeyOptional,
 	)
 	tweaklessCommitment := localTweakless && remoteTweakless
+
+	// Similarly, we'll only use the anchor commitment format if both
+	// sides signal support for it.
+	anchorCommitment := hasAnchorsFeature(msg.peer)
+
 	req := &lnwallet.InitFundingReserveMsg{
 		ChainHash:        &msg.chainHash,
 		NodeID:           peerKey,
@@ -2795,6 +3290,8 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		Flags:            channelFlags,
 		MinConfs:         msg.minConfs,
 		Tweakless:        tweaklessCommitment,
+		Anchors:          anchorCommitment,
+		ExternalFunding:  msg.batch != nil,
 	}
 
 	reservation, err := f.cfg.Wallet.InitChannelReservation(req)
@@ -2803,6 +3300,10 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		return
 	}
 
+	// Commit to the address our funds are paid to upon a cooperative
+	// close, if one was requested.
+	reservation.SetOurUpfrontShutdown(msg.shutdownScript)
+
 	// Now that we have successfully reserved funds for this channel in the
 	// wallet, we can fetch the final channel capacity. This is done at
 	// this point since the final capacity might change in case of
@@ -2843,12 +3344,33 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		remoteMinHtlc:  minHtlc,
 		reservation:    reservation,
 		peer:           msg.peer,
+		batch:          msg.batch,
+		zeroConf:       msg.zeroConf,
 		updates:        msg.updates,
 		err:            msg.err,
 	}
 	f.activeReservations[peerIDKey][chanID] = resCtx
 	f.resMtx.Unlock()
 
+	// If this channel is part of a funding batch, we'll register it with
+	// the batch so the funding transaction can be assembled once all
+	// channels of the batch have been accepted.
+	if msg.batch != nil {
+		if err := msg.batch.addChannel(chanID, resCtx); err != nil {
+			fndgLog.Errorf("Unable to add pendingID(%x) to funding "+
+				"batch: %v", chanID, err)
+
+			_, cancelErr := f.cancelReservationCtx(peerKey, chanID)
+			if cancelErr != nil {
+				fndgLog.Errorf("unable to cancel reservation: %v",
+					cancelErr)
+			}
+
+			msg.err <- err
+			return
+		}
+	}
+
 	// Update the timestamp once the initFundingMsg has been handled.
 	defer resCtx.updateTimestamp()
 
@@ -2864,27 +3386,29 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 	maxHtlcs := f.cfg.RequiredRemoteMaxHTLCs(capacity)
 
 	fndgLog.Infof("Starting funding workflow with %v for pendingID(%x), "+
-		"tweakless=%v", msg.peer.Address(), chanID, tweaklessCommitment)
+		"tweakless=%v, anchors=%v", msg.peer.Address(), chanID,
+		tweaklessCommitment, anchorCommitment)
 
 	fundingOpen := lnwire.OpenChannel{
-		ChainHash:            f.cfg.Wallet.Cfg.NetParams.GenesisHash,
-		PendingChannelID:     chanID,
-		FundingAmount:        capacity,
-		PushAmount:           msg.pushAmt,
-		DustLimit:            ourContribution.DustLimit,
-		MaxValueInFlight:     maxValue,
-		ChannelReserve:       chanReserve,
-		HtlcMinimum:          minHtlc,
-		FeePerKiloByte:       uint32(commitFeePerKB),
-		CsvDelay:             remoteCsvDelay,
-		MaxAcceptedHTLCs:     maxHtlcs,
-		FundingKey:           ourContribution.MultiSigKey.PubKey,
-		RevocationPoint:      ourContribution.RevocationBasePoint.PubKey,
-		PaymentPoint:         ourContribution.PaymentBasePoint.PubKey,
-		HtlcPoint:            ourContribution.HtlcBasePoint.PubKey,
-		DelayedPaymentPoint:  ourContribution.DelayBasePoint.PubKey,
-		FirstCommitmentPoint: ourContribution.FirstCommitmentPoint,
-		ChannelFlags:         channelFlags,
+		ChainHash:             f.cfg.Wallet.Cfg.NetParams.GenesisHash,
+		PendingChannelID:      chanID,
+		FundingAmount:         capacity,
+		PushAmount:            msg.pushAmt,
+		DustLimit:             ourContribution.DustLimit,
+		MaxValueInFlight:      maxValue,
+		ChannelReserve:        chanReserve,
+		HtlcMinimum:           minHtlc,
+		FeePerKiloByte:        uint32(commitFeePerKB),
+		CsvDelay:              remoteCsvDelay,
+		MaxAcceptedHTLCs:      maxHtlcs,
+		FundingKey:            ourContribution.MultiSigKey.PubKey,
+		RevocationPoint:       ourContribution.RevocationBasePoint.PubKey,
+		PaymentPoint:          ourContribution.PaymentBasePoint.PubKey,
+		HtlcPoint:             ourContribution.HtlcBasePoint.PubKey,
+		DelayedPaymentPoint:   ourContribution.DelayBasePoint.PubKey,
+		FirstCommitmentPoint:  ourContribution.FirstCommitmentPoint,
+		ChannelFlags:          channelFlags,
+		UpfrontShutdownScript: msg.shutdownScript,
 	}
 	if err := msg.peer.SendMessage(false, &fundingOpen); err != nil {
 		e := fmt.Errorf("unable to send funding request message: %v",
@@ -2968,6 +3492,10 @@ func (f *fundingManager) handleErrorMsg(fmsg *fundingErrorMsg) {
 	fndgLog.Errorf(fundingErr.Error())
 
 	resCtx.err <- fundingErr
+
+	if resCtx.batch != nil {
+		f.failBatch(resCtx.batch, fundingErr)
+	}
 }
 
 // pruneZombieReservations loops through all pending reservations and fails the
@@ -198,6 +198,16 @@ func (n *testNode) RemoteGlobalFeatures() *lnwire.FeatureVector {
 	return lnwire.NewFeatureVector(nil, nil)
 }
 
+func (n *testNode) RemoteLocalFeatures() *lnwire.FeatureVector {
+	return lnwire.NewFeatureVector(
+		lnwire.NewRawFeatureVector(
+			lnwire.UpfrontShutdownScriptOptional,
+			lnwire.WumboChannelsOptional,
+			lnwire.ZeroConfOptional,
+		), lnwire.LocalFeatures,
+	)
+}
+
 func (n *testNode) AddNewChannel(channel *channeldb.OpenChannel,
 	quit <-chan struct{}) error {
 
@@ -378,11 +388,12 @@ func createTestFundingManager(t *testing.T, privKey *secp256k1.PrivateKey,
 			publTxChan <- txn
 			return nil
 		},
-		ZombieSweeperInterval:  1 * time.Hour,
-		ReservationTimeout:     1 * time.Nanosecond,
-		MaxPendingChannels:     DefaultMaxPendingChannels,
-		NotifyOpenChannelEvent: func(wire.OutPoint) {},
-		OpenChannelPredicate:   chainedAcceptor,
+		ZombieSweeperInterval:    1 * time.Hour,
+		ReservationTimeout:       1 * time.Nanosecond,
+		MaxPendingChannels:       DefaultMaxPendingChannels,
+		NotifyOpenChannelEvent:   func(wire.OutPoint) {},
+		NotifyClosedChannelEvent: func(wire.OutPoint) {},
+		OpenChannelPredicate:     chainedAcceptor,
 	}
 
 	for _, op := range options {
@@ -736,6 +747,8 @@ func assertFundingMsgSent(t *testing.T, msgChan chan lnwire.Message,
 		ok      bool
 	)
 	switch msgType {
+	case "OpenChannel":
+		sentMsg, ok = msg.(*lnwire.OpenChannel)
 	case "AcceptChannel":
 		sentMsg, ok = msg.(*lnwire.AcceptChannel)
 	case "FundingCreated":
@@ -1796,6 +1809,75 @@ func TestFundingManagerFundingNotTimeoutInitiator(t *testing.T) {
 	assertNumPendingChannelsBecomes(t, bob, 0)
 }
 
+// TestFundingManagerFundingTimeoutInitiator checks that the channels we
+// initiated are abandoned once the configured number of blocks has passed
+// without their funding transaction confirming, if configured to.
+func TestFundingManagerFundingTimeoutInitiator(t *testing.T) {
+	t.Parallel()
+
+	const maxBlocks = 10
+	closedChans := make(chan wire.OutPoint, 1)
+	alice, bob := setupFundingManagers(t, func(cfg *fundingConfig) {
+		cfg.MaxWaitNumBlocksFundingConf = maxBlocks
+		cfg.AbandonInitiatedChannels = true
+		cfg.NotifyClosedChannelEvent = func(op wire.OutPoint) {
+			select {
+			case closedChans <- op:
+			default:
+			}
+		}
+	})
+	defer tearDownFundingManagers(t, alice, bob)
+
+	// We will consume the channel updates as we go, so no buffering is needed.
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+
+	// Run through the process of opening the channel, up until the funding
+	// transaction is broadcasted.
+	fundingOutPoint, _ := openChannel(
+		t, alice, bob, 500000, 0, 1, updateChan, true,
+	)
+
+	// Alice should still be waiting for the channel to open one block
+	// before the timeout.
+	alice.mockNotifier.epochChan <- &chainntnfs.BlockEpoch{
+		Height: fundingBroadcastHeight + maxBlocks - 1,
+	}
+	assertNumPendingChannelsRemains(t, alice, 1)
+
+	alice.mockNotifier.epochChan <- &chainntnfs.BlockEpoch{
+		Height: fundingBroadcastHeight + maxBlocks,
+	}
+
+	// Alice should have abandoned the channel, notified its subscribers
+	// and sent an Error message to Bob.
+	assertNumPendingChannelsBecomes(t, alice, 0)
+	select {
+	case op := <-closedChans:
+		if op != *fundingOutPoint {
+			t.Fatalf("expected closed channel %v, got %v",
+				fundingOutPoint, op)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatalf("abandoned channel not notified")
+	}
+	assertErrorSent(t, alice.msgChan)
+
+	closedChannels, err := alice.fundingMgr.cfg.Wallet.Cfg.Database.
+		FetchClosedChannels(false)
+	if err != nil {
+		t.Fatalf("unable to fetch closed channels: %v", err)
+	}
+	if len(closedChannels) != 1 {
+		t.Fatalf("expected 1 closed channel, got %v",
+			len(closedChannels))
+	}
+	if closedChannels[0].CloseType != channeldb.FundingCanceled {
+		t.Fatalf("expected close type %v, got %v",
+			channeldb.FundingCanceled, closedChannels[0].CloseType)
+	}
+}
+
 // TestFundingManagerReceiveFundingLockedTwice checks that the fundingManager
 // continues to operate as expected in case we receive a duplicate fundingLocked
 // message.
@@ -2726,6 +2808,78 @@ func TestFundingManagerMaxPendingChannels(t *testing.T) {
 	).(*lnwire.AcceptChannel)
 }
 
+// TestFundingManagerMaxGlobalPendingChannels checks that trying to open
+// another channel when MaxGlobalPendingChannels are pending fails with a
+// distinct error, even if the per peer limit isn't reached yet.
+func TestFundingManagerMaxGlobalPendingChannels(t *testing.T) {
+	t.Parallel()
+
+	const maxGlobalPending = 2
+
+	alice, bob := setupFundingManagers(
+		t, func(cfg *fundingConfig) {
+			cfg.MaxPendingChannels = maxGlobalPending + 2
+			cfg.MaxGlobalPendingChannels = maxGlobalPending
+		},
+	)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	// Kick of maxGlobalPending+1 funding workflows.
+	for i := 0; i < maxGlobalPending+1; i++ {
+		initReq := &openChanReq{
+			targetPubkey:    bob.privKey.PubKey(),
+			chainHash:       activeNetParams.GenesisHash,
+			localFundingAmt: 5000000,
+			pushAmt:         lnwire.NewMAtomsFromAtoms(0),
+			private:         false,
+			updates:         make(chan *lnrpc.OpenStatusUpdate),
+			err:             make(chan error, 1),
+		}
+		alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+		// Alice should have sent the OpenChannel message to Bob.
+		openChannelReq := assertFundingMsgSent(
+			t, alice.msgChan, "OpenChannel",
+		).(*lnwire.OpenChannel)
+
+		// Let Bob handle the init message.
+		bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+
+		// Bob should answer with an AcceptChannel message for the
+		// first maxGlobalPending channels.
+		if i < maxGlobalPending {
+			_ = assertFundingMsgSent(
+				t, bob.msgChan, "AcceptChannel",
+			).(*lnwire.AcceptChannel)
+			continue
+		}
+
+		// For the last channel, Bob should answer with the global
+		// limit error.
+		errMsg := assertFundingMsgSent(
+			t, bob.msgChan, "Error",
+		).(*lnwire.Error)
+		expErr := lnwire.ErrMaxGlobalPendingChannels.Error()
+		if string(errMsg.Data) != expErr {
+			t.Fatalf("expected error %q, got %q", expErr,
+				string(errMsg.Data))
+		}
+	}
+
+	// Bob should count the pending channels towards Alice.
+	pendingChans, err := bob.fundingMgr.pendingChannelCounts()
+	if err != nil {
+		t.Fatalf("unable to fetch pending channel counts: %v", err)
+	}
+	aliceKey := newSerializedKey(alice.privKey.PubKey())
+	if len(pendingChans) != 1 ||
+		pendingChans[aliceKey] != maxGlobalPending {
+
+		t.Fatalf("expected %v pending channels with alice, got %v",
+			maxGlobalPending, pendingChans)
+	}
+}
+
 // TestFundingManagerRejectPush checks behaviour of 'rejectpush'
 // option, namely that non-zero incoming push amounts are disabled.
 func TestFundingManagerRejectPush(t *testing.T) {
@@ -2954,3 +3108,482 @@ func TestFundingManagerFundAll(t *testing.T) {
 		}
 	}
 }
+
+// TestFundingManagerBatchOpen checks that several channels which are part of
+// a funding batch are funded by a single funding transaction, which is only
+// broadcast once all channels of the batch have been signed.
+func TestFundingManagerBatchOpen(t *testing.T) {
+	t.Parallel()
+
+	const numChannels = 2
+
+	alice, bob := setupFundingManagers(
+		t, func(cfg *fundingConfig) {
+			cfg.MaxPendingChannels = numChannels
+		},
+	)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	batch := newFundingBatch(numChannels, 1000, 1)
+
+	// Kick off the funding workflows for all channels of the batch, and
+	// let Bob accept each of them.
+	var (
+		initReqs []*openChanReq
+		accepts  []*lnwire.AcceptChannel
+	)
+	for i := 0; i < numChannels; i++ {
+		initReq := &openChanReq{
+			targetPubkey:    bob.privKey.PubKey(),
+			chainHash:       activeNetParams.GenesisHash,
+			localFundingAmt: 500000,
+			pushAmt:         lnwire.NewMAtomsFromAtoms(0),
+			fundingFeePerKB: 1000,
+			minConfs:        1,
+			batch:           batch,
+			updates:         make(chan *lnrpc.OpenStatusUpdate, 2),
+			err:             make(chan error, 1),
+		}
+		initReqs = append(initReqs, initReq)
+
+		alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+		var aliceMsg lnwire.Message
+		select {
+		case aliceMsg = <-alice.msgChan:
+		case err := <-initReq.err:
+			t.Fatalf("error init funding workflow: %v", err)
+		case <-time.After(time.Second * 5):
+			t.Fatalf("alice did not send OpenChannel message")
+		}
+
+		openChannelReq, ok := aliceMsg.(*lnwire.OpenChannel)
+		if !ok {
+			t.Fatalf("expected OpenChannel to be sent from "+
+				"alice, instead got %T", aliceMsg)
+		}
+
+		bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+		acceptChannelResponse := assertFundingMsgSent(
+			t, bob.msgChan, "AcceptChannel",
+		).(*lnwire.AcceptChannel)
+		accepts = append(accepts, acceptChannelResponse)
+	}
+
+	// Alice shouldn't send a FundingCreated message before all channels
+	// of the batch have been accepted, as the funding transaction isn't
+	// known before that.
+	alice.fundingMgr.processFundingAccept(accepts[0], bob)
+	assertErrorNotSent(t, alice.msgChan)
+
+	// Once the last channel is accepted, Alice should send FundingCreated
+	// for all channels, each referencing the same funding transaction.
+	alice.fundingMgr.processFundingAccept(accepts[1], bob)
+
+	var signs []*lnwire.FundingSigned
+	fundingPoints := make(map[wire.OutPoint]struct{})
+	for i := 0; i < numChannels; i++ {
+		fundingCreated := assertFundingMsgSent(
+			t, alice.msgChan, "FundingCreated",
+		).(*lnwire.FundingCreated)
+		fundingPoints[fundingCreated.FundingPoint] = struct{}{}
+
+		bob.fundingMgr.processFundingCreated(fundingCreated, alice)
+		fundingSigned := assertFundingMsgSent(
+			t, bob.msgChan, "FundingSigned",
+		).(*lnwire.FundingSigned)
+		signs = append(signs, fundingSigned)
+	}
+
+	if len(fundingPoints) != numChannels {
+		t.Fatalf("expected %d distinct funding outpoints, got %d",
+			numChannels, len(fundingPoints))
+	}
+	var fundingTxid chainhash.Hash
+	for op := range fundingPoints {
+		if fundingTxid != (chainhash.Hash{}) && op.Hash != fundingTxid {
+			t.Fatalf("channels funded by different transactions: "+
+				"%v vs %v", op.Hash, fundingTxid)
+		}
+		fundingTxid = op.Hash
+	}
+
+	// The funding transaction must not be broadcast before all channels
+	// of the batch have been signed.
+	alice.fundingMgr.processFundingSigned(signs[0], bob)
+	select {
+	case <-alice.publTxChan:
+		t.Fatalf("funding tx published before batch was complete")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	alice.fundingMgr.processFundingSigned(signs[1], bob)
+
+	var publ *wire.MsgTx
+	select {
+	case publ = <-alice.publTxChan:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not publish funding tx")
+	}
+	if publ.TxHash() != fundingTxid {
+		t.Fatalf("expected funding tx %v to be published, got %v",
+			fundingTxid, publ.TxHash())
+	}
+	for op := range fundingPoints {
+		if op.Index >= uint32(len(publ.TxOut)) ||
+			publ.TxOut[op.Index].Value != 500000 {
+
+			t.Fatalf("funding output %v not found in funding tx",
+				op)
+		}
+	}
+
+	// Both channels should now be pending, and the batch funding
+	// transaction should only have been published once.
+	for _, initReq := range initReqs {
+		var pendingUpdate *lnrpc.OpenStatusUpdate
+		select {
+		case pendingUpdate = <-initReq.updates:
+		case <-time.After(time.Second * 5):
+			t.Fatalf("alice did not send OpenStatusUpdate_ChanPending")
+		}
+
+		_, ok := pendingUpdate.Update.(*lnrpc.OpenStatusUpdate_ChanPending)
+		if !ok {
+			t.Fatal("OpenStatusUpdate was not OpenStatusUpdate_ChanPending")
+		}
+	}
+
+	select {
+	case <-alice.publTxChan:
+		t.Fatalf("batch funding tx published twice")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	assertNumPendingReservations(t, alice, bobPubKey, 0)
+	assertNumPendingReservations(t, bob, alicePubKey, 0)
+}
+
+// TestFundingManagerUpfrontShutdown checks that the upfront shutdown script
+// requested by the initiator is sent to the responder, and that both parties
+// persist it along with the channel.
+func TestFundingManagerUpfrontShutdown(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := setupFundingManagers(t)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	// We will consume the channel updates as we go, so no buffering is needed.
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+
+	upfrontScript := lnwire.DeliveryAddress{
+		0x76, 0xa9, 0x14, 0x01, 0x01, 0x01, 0x01, 0x01,
+		0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01,
+		0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x88,
+		0xac,
+	}
+
+	// Create a funding request committing to the upfront shutdown script
+	// and start the workflow.
+	errChan := make(chan error, 1)
+	initReq := &openChanReq{
+		targetPubkey:    bob.privKey.PubKey(),
+		chainHash:       activeNetParams.GenesisHash,
+		localFundingAmt: 500000,
+		pushAmt:         lnwire.NewMAtomsFromAtoms(0),
+		fundingFeePerKB: 1000,
+		shutdownScript:  upfrontScript,
+		updates:         updateChan,
+		err:             errChan,
+	}
+
+	alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+	// Alice should have sent the OpenChannel message to Bob, including
+	// the upfront shutdown script.
+	openChannelReq := assertFundingMsgSent(
+		t, alice.msgChan, "OpenChannel",
+	).(*lnwire.OpenChannel)
+	if !bytes.Equal(openChannelReq.UpfrontShutdownScript, upfrontScript) {
+		t.Fatalf("expected upfront shutdown script %x, got %x",
+			upfrontScript, openChannelReq.UpfrontShutdownScript)
+	}
+
+	// We'll run through the rest of the funding workflow, until the
+	// funding transaction is broadcast.
+	bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+	acceptChannelResponse := assertFundingMsgSent(
+		t, bob.msgChan, "AcceptChannel",
+	).(*lnwire.AcceptChannel)
+	if len(acceptChannelResponse.UpfrontShutdownScript) != 0 {
+		t.Fatalf("expected no upfront shutdown script from bob, got %x",
+			acceptChannelResponse.UpfrontShutdownScript)
+	}
+
+	alice.fundingMgr.processFundingAccept(acceptChannelResponse, bob)
+	fundingCreated := assertFundingMsgSent(
+		t, alice.msgChan, "FundingCreated",
+	).(*lnwire.FundingCreated)
+
+	bob.fundingMgr.processFundingCreated(fundingCreated, alice)
+	fundingSigned := assertFundingMsgSent(
+		t, bob.msgChan, "FundingSigned",
+	).(*lnwire.FundingSigned)
+
+	alice.fundingMgr.processFundingSigned(fundingSigned, bob)
+
+	select {
+	case <-updateChan:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not send OpenStatusUpdate_ChanPending")
+	}
+
+	select {
+	case <-alice.publTxChan:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not publish funding tx")
+	}
+
+	// Both parties should now have persisted the upfront shutdown script
+	// along with the pending channel.
+	fetchPendingChannel := func(node *testNode) *channeldb.OpenChannel {
+		t.Helper()
+
+		pendingChannels, err := node.fundingMgr.cfg.Wallet.Cfg.Database.
+			FetchPendingChannels()
+		if err != nil {
+			t.Fatalf("unable to fetch pending channels: %v", err)
+		}
+		if len(pendingChannels) != 1 {
+			t.Fatalf("expected 1 pending channel, had %v",
+				len(pendingChannels))
+		}
+
+		return pendingChannels[0]
+	}
+
+	aliceChannel := fetchPendingChannel(alice)
+	if !bytes.Equal(aliceChannel.LocalShutdownScript, upfrontScript) {
+		t.Fatalf("expected alice's local shutdown script %x, got %x",
+			upfrontScript, aliceChannel.LocalShutdownScript)
+	}
+	if len(aliceChannel.RemoteShutdownScript) != 0 {
+		t.Fatalf("expected no remote shutdown script for alice, got %x",
+			aliceChannel.RemoteShutdownScript)
+	}
+
+	bobChannel := fetchPendingChannel(bob)
+	if !bytes.Equal(bobChannel.RemoteShutdownScript, upfrontScript) {
+		t.Fatalf("expected bob's remote shutdown script %x, got %x",
+			upfrontScript, bobChannel.RemoteShutdownScript)
+	}
+	if len(bobChannel.LocalShutdownScript) != 0 {
+		t.Fatalf("expected no local shutdown script for bob, got %x",
+			bobChannel.LocalShutdownScript)
+	}
+}
+
+// TestFundingManagerWumbo asserts that channels above the default funding
+// limit are only funded once wumbo channels are enabled, within the configured
+// max channel size and our per-peer policy.
+func TestFundingManagerWumbo(t *testing.T) {
+	t.Parallel()
+
+	wumboSize := 2 * MaxFundingAmount
+	testCases := []struct {
+		name          string
+		maxChanSize   dcrutil.Amount
+		acceptWumbo   func(*secp256k1.PublicKey) bool
+		fundingAmount dcrutil.Amount
+		accepted      bool
+	}{
+		{
+			name:          "wumbo disabled",
+			fundingAmount: MaxFundingAmount + 1,
+		},
+		{
+			name:          "wumbo accepted",
+			maxChanSize:   wumboSize,
+			fundingAmount: MaxFundingAmount + 1,
+			accepted:      true,
+		},
+		{
+			name:        "wumbo peer rejected",
+			maxChanSize: wumboSize,
+			acceptWumbo: func(*secp256k1.PublicKey) bool {
+				return false
+			},
+			fundingAmount: MaxFundingAmount + 1,
+		},
+		{
+			name:          "above max chan size",
+			maxChanSize:   wumboSize,
+			fundingAmount: wumboSize + 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		alice, bob := setupFundingManagers(
+			t, func(cfg *fundingConfig) {
+				cfg.MaxChanSize = testCase.maxChanSize
+				cfg.AcceptWumbo = testCase.acceptWumbo
+			},
+		)
+
+		// Alice starts the funding workflow for a regular channel,
+		// whose size we'll bump before handing it to Bob.
+		initReq := &openChanReq{
+			targetPubkey:    bob.privKey.PubKey(),
+			chainHash:       activeNetParams.GenesisHash,
+			localFundingAmt: 500000,
+			private:         true,
+			updates:         make(chan *lnrpc.OpenStatusUpdate),
+			err:             make(chan error, 1),
+		}
+		alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+		openChannelReq := assertFundingMsgSent(
+			t, alice.msgChan, "OpenChannel",
+		).(*lnwire.OpenChannel)
+		openChannelReq.FundingAmount = testCase.fundingAmount
+
+		bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+
+		if testCase.accepted {
+			assertFundingMsgSent(t, bob.msgChan, "AcceptChannel")
+		} else {
+			err := assertFundingMsgSent(
+				t, bob.msgChan, "Error",
+			).(*lnwire.Error)
+			if !strings.Contains(err.Error(), "channel too large") {
+				t.Fatalf("%v: expected ErrChanTooLarge, got "+
+					"\"%v\"", testCase.name, err.Error())
+			}
+		}
+
+		tearDownFundingManagers(t, alice, bob)
+	}
+
+	// Without wumbo channels enabled, we refuse to open a channel above
+	// the default funding limit.
+	alice, bob := setupFundingManagers(t)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	initReq := &openChanReq{
+		targetPubkey:    bob.privKey.PubKey(),
+		chainHash:       activeNetParams.GenesisHash,
+		localFundingAmt: MaxFundingAmount + 1,
+		private:         true,
+		updates:         make(chan *lnrpc.OpenStatusUpdate),
+		err:             make(chan error, 1),
+	}
+	alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+	select {
+	case err := <-initReq.err:
+		if !strings.Contains(err.Error(), "too large") {
+			t.Fatalf("expected funding amount to be too large, "+
+				"got: %v", err)
+		}
+
+	case <-time.After(time.Second * 5):
+		t.Fatalf("expected funding workflow to fail")
+	}
+}
+
+// TestFundingManagerZeroConf checks that zero-conf channels are only accepted
+// from trusted peers, which must agree to use them right away.
+func TestFundingManagerZeroConf(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		acceptZeroConf func(*secp256k1.PublicKey) bool
+		accepted       bool
+	}{
+		{
+			name: "zero-conf disabled",
+		},
+		{
+			name: "zero-conf peer rejected",
+			acceptZeroConf: func(*secp256k1.PublicKey) bool {
+				return false
+			},
+		},
+		{
+			name: "zero-conf accepted",
+			acceptZeroConf: func(*secp256k1.PublicKey) bool {
+				return true
+			},
+			accepted: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		alice, bob := setupFundingManagers(
+			t, func(cfg *fundingConfig) {
+				cfg.AcceptZeroConf = testCase.acceptZeroConf
+			},
+		)
+
+		initReq := &openChanReq{
+			targetPubkey:    bob.privKey.PubKey(),
+			chainHash:       activeNetParams.GenesisHash,
+			localFundingAmt: 500000,
+			private:         true,
+			zeroConf:        true,
+			updates:         make(chan *lnrpc.OpenStatusUpdate),
+			err:             make(chan error, 1),
+		}
+		alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+		openChannelReq := assertFundingMsgSent(
+			t, alice.msgChan, "OpenChannel",
+		).(*lnwire.OpenChannel)
+		if openChannelReq.ChannelFlags&lnwire.FFZeroConf == 0 {
+			t.Fatalf("%v: expected zero-conf flag to be set",
+				testCase.name)
+		}
+
+		bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+
+		if !testCase.accepted {
+			assertFundingMsgSent(t, bob.msgChan, "Error")
+			tearDownFundingManagers(t, alice, bob)
+			continue
+		}
+
+		acceptChannelResponse := assertFundingMsgSent(
+			t, bob.msgChan, "AcceptChannel",
+		).(*lnwire.AcceptChannel)
+		if acceptChannelResponse.MinAcceptDepth != 0 {
+			t.Fatalf("%v: expected min accept depth of 0, got %v",
+				testCase.name,
+				acceptChannelResponse.MinAcceptDepth)
+		}
+
+		// If Bob were to require confirmations anyway, Alice would
+		// fail the funding flow.
+		acceptChannelResponse.MinAcceptDepth = 1
+		alice.fundingMgr.processFundingAccept(acceptChannelResponse, bob)
+		assertFundingMsgSent(t, alice.msgChan, "Error")
+
+		select {
+		case err := <-initReq.err:
+			if !strings.Contains(err.Error(), "min accept depth") {
+				t.Fatalf("%v: unexpected error: %v",
+					testCase.name, err)
+			}
+
+		case <-time.After(time.Second * 5):
+			t.Fatalf("%v: expected funding workflow to fail",
+				testCase.name)
+		}
+
+		tearDownFundingManagers(t, alice, bob)
+	}
+}
@@ -0,0 +1,28 @@
+// Package hopfuzz holds the go-fuzz harness of the parsing of the TLV payloads
+// of onion packets.
+package hopfuzz
+
+import (
+	"bytes"
+
+	"github.com/decred/dcrlnd/htlcswitch/hop"
+)
+
+// Fuzz is the go-fuzz entry point. It parses the data as the TLV payload of a
+// hop, returning 1 if it parsed to a valid payload, and 0 otherwise.
+func Fuzz(data []byte) int {
+	payload, err := hop.NewPayloadFromReader(bytes.NewReader(data))
+	if err != nil {
+		return 0
+	}
+
+	// The parsed records must be consistent with the hop type they were
+	// validated for.
+	if payload.MultiPath() != nil &&
+		payload.ForwardingInfo().NextHop != hop.Exit {
+
+		panic("mpp record parsed for intermediate hop")
+	}
+
+	return 1
+}
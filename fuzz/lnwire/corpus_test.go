@@ -0,0 +1,36 @@
+package lnwirefuzz
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+// corpusDir is the go-fuzz corpus of the harness, holding the seeds along
+// with the inputs found to crash the harness once fixed.
+var corpusDir = filepath.Join("testdata", "corpus")
+
+// TestCorpus replays the corpus through the harness, asserting that none of
+// its inputs panics.
+func TestCorpus(t *testing.T) {
+	t.Parallel()
+
+	files, err := ioutil.ReadDir(corpusDir)
+	if err != nil {
+		t.Fatalf("unable to read corpus: %v", err)
+	}
+	if len(files) == 0 {
+		t.Fatalf("empty corpus")
+	}
+
+	for _, file := range files {
+		data, err := ioutil.ReadFile(filepath.Join(corpusDir, file.Name()))
+		if err != nil {
+			t.Fatalf("unable to read corpus input: %v", err)
+		}
+
+		t.Run(file.Name(), func(t *testing.T) {
+			Fuzz(data)
+		})
+	}
+}
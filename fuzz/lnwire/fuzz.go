@@ -0,0 +1,57 @@
+// Package lnwirefuzz holds the go-fuzz harness of the decoding of the lnwire
+// messages received from peers.
+package lnwirefuzz
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/decred/dcrlnd/lnwire"
+)
+
+// Fuzz is the go-fuzz entry point. It decodes the data as a message read from
+// a peer, and asserts that the message encodes and decodes back to the same
+// serialization. It returns 1 if the data decoded to a message, and 0
+// otherwise.
+func Fuzz(data []byte) int {
+	// Messages are read from peers within a buffer bounded by the maximum
+	// payload, along with their 2 bytes type.
+	if len(data) > lnwire.MaxMessagePayload+2 {
+		return 0
+	}
+
+	msg, err := lnwire.ReadMessage(bytes.NewReader(data), 0)
+	if err != nil {
+		return 0
+	}
+
+	// A decoded message may not fit within the maximum payload once
+	// encoded, such as short channel IDs inflated from a zlib payload, so
+	// those are ignored.
+	var b bytes.Buffer
+	if _, err := lnwire.WriteMessage(&b, msg, 0); err != nil {
+		return 0
+	}
+	encoded := b.Bytes()
+
+	// The serialization of a decoded message must decode to a message with
+	// the same serialization. Comparing the serializations rather than the
+	// messages avoids reporting differences such as nil and empty slices.
+	newMsg, err := lnwire.ReadMessage(bytes.NewReader(encoded), 0)
+	if err != nil {
+		panic(fmt.Errorf("unable to decode serialized %v message: %v",
+			msg.MsgType(), err))
+	}
+
+	var newB bytes.Buffer
+	if _, err := lnwire.WriteMessage(&newB, newMsg, 0); err != nil {
+		panic(fmt.Errorf("unable to encode decoded %v message: %v",
+			msg.MsgType(), err))
+	}
+	if !bytes.Equal(encoded, newB.Bytes()) {
+		panic(fmt.Errorf("%v message serialization changed once "+
+			"decoded: %x != %x", msg.MsgType(), encoded, newB.Bytes()))
+	}
+
+	return 1
+}
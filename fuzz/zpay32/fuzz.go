@@ -0,0 +1,23 @@
+// Package zpay32fuzz holds the go-fuzz harness of the decoding of payment
+// requests.
+package zpay32fuzz
+
+import (
+	"github.com/decred/dcrd/chaincfg/v2"
+	"github.com/decred/dcrlnd/zpay32"
+)
+
+// Fuzz is the go-fuzz entry point. It decodes the data as a mainnet payment
+// request, returning 1 if it decoded to a valid invoice, and 0 otherwise.
+func Fuzz(data []byte) int {
+	invoice, err := zpay32.Decode(string(data), chaincfg.MainNetParams())
+	if err != nil {
+		return 0
+	}
+
+	// The fields derived from the decoded tagged fields must be usable.
+	_ = invoice.Expiry()
+	_ = invoice.MinFinalCLTVExpiry()
+
+	return 1
+}
@@ -8,6 +8,7 @@ require (
 	github.com/Yawning/aez v0.0.0-20180408160647-ec7426b44926
 	github.com/davecgh/go-spew v1.1.1
 	github.com/decred/dcrd v1.2.1-0.20191016204340-338ce9d7017d
+	github.com/decred/dcrd/addrmgr v1.1.0
 	github.com/decred/dcrd/bech32 v1.0.0
 	github.com/decred/dcrd/blockchain/stake v1.2.1
 	github.com/decred/dcrd/blockchain/standalone v1.1.0
@@ -20,6 +21,7 @@ require (
 	github.com/decred/dcrd/dcrjson/v2 v2.2.0
 	github.com/decred/dcrd/dcrutil v1.4.0
 	github.com/decred/dcrd/dcrutil/v2 v2.0.1
+	github.com/decred/dcrd/gcs v1.1.0
 	github.com/decred/dcrd/hdkeychain/v2 v2.1.0
 	github.com/decred/dcrd/mempool/v3 v3.1.0
 	github.com/decred/dcrd/rpc/jsonrpc/types/v2 v2.0.0
@@ -28,7 +30,9 @@ require (
 	github.com/decred/dcrd/wire v1.3.0
 	github.com/decred/dcrwallet/chain/v3 v3.0.0
 	github.com/decred/dcrwallet/errors/v2 v2.0.0
+	github.com/decred/dcrwallet/p2p/v2 v2.0.0
 	github.com/decred/dcrwallet/rpc/walletrpc v0.3.0
+	github.com/decred/dcrwallet/spv/v3 v3.0.0
 	github.com/decred/dcrwallet/wallet/v3 v3.0.0
 	github.com/decred/lightning-onion/v2 v2.0.0
 	github.com/decred/slog v1.0.0
@@ -0,0 +1,22 @@
+// +build darwin freebsd linux
+
+package healthcheck
+
+import "syscall"
+
+// AvailableDiskSpaceRatio returns the ratio of the disk space available to
+// unprivileged users, over the total size of the file system holding path.
+func AvailableDiskSpaceRatio(path string) (float64, error) {
+	var s syscall.Statfs_t
+	if err := syscall.Statfs(path, &s); err != nil {
+		return 0, err
+	}
+
+	// The free and total blocks are both expressed in the same block
+	// size, so it cancels out of the ratio.
+	if s.Blocks == 0 {
+		return 0, nil
+	}
+
+	return float64(s.Bavail) / float64(s.Blocks), nil
+}
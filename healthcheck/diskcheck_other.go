@@ -0,0 +1,15 @@
+// +build !darwin,!freebsd,!linux,!windows
+
+package healthcheck
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// AvailableDiskSpaceRatio returns an error as measuring the available disk
+// space isn't supported on this platform.
+func AvailableDiskSpaceRatio(path string) (float64, error) {
+	return 0, fmt.Errorf("disk space check not supported on %v",
+		runtime.GOOS)
+}
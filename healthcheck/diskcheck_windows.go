@@ -0,0 +1,40 @@
+// +build windows
+
+package healthcheck
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+// getDiskFreeSpaceEx is the kernel32 function returning the available and
+// total space of a disk.
+var getDiskFreeSpaceEx = syscall.NewLazyDLL("kernel32.dll").NewProc(
+	"GetDiskFreeSpaceExW",
+)
+
+// AvailableDiskSpaceRatio returns the ratio of the disk space available to
+// the user, over the total size of the disk holding path.
+func AvailableDiskSpaceRatio(path string) (float64, error) {
+	pathPtr, err := syscall.UTF16PtrFromString(path)
+	if err != nil {
+		return 0, err
+	}
+
+	var available, total, free uint64
+	ret, _, err := getDiskFreeSpaceEx.Call(
+		uintptr(unsafe.Pointer(pathPtr)),
+		uintptr(unsafe.Pointer(&available)),
+		uintptr(unsafe.Pointer(&total)),
+		uintptr(unsafe.Pointer(&free)),
+	)
+	if ret == 0 {
+		return 0, err
+	}
+
+	if total == 0 {
+		return 0, nil
+	}
+
+	return float64(available) / float64(total), nil
+}
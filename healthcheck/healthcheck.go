@@ -0,0 +1,243 @@
+// Package healthcheck contains a monitor which runs a set of periodic checks
+// of the subsystems the node relies on, such as its chain backend, and takes
+// action when one of them fails persistently.
+package healthcheck
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/decred/dcrlnd/ticker"
+)
+
+// ErrCheckTimeout is returned by a check attempt that didn't complete within
+// the timeout of its observation.
+var ErrCheckTimeout = errors.New("health check timed out")
+
+// Config contains the checks run by a Monitor and the action it takes when
+// one of its critical checks fails.
+type Config struct {
+	// Checks is the set of checks the monitor runs.
+	Checks []*Observation
+
+	// Shutdown is called with the reason of the failure once a critical
+	// check failed all of its attempts.
+	Shutdown func(format string, params ...interface{})
+}
+
+// Monitor runs each of its checks periodically in its own goroutine.
+type Monitor struct {
+	started int32 // To be used atomically.
+	stopped int32 // To be used atomically.
+
+	cfg *Config
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewMonitor returns a monitor running the checks of the given config.
+func NewMonitor(cfg *Config) *Monitor {
+	return &Monitor{
+		cfg:  cfg,
+		quit: make(chan struct{}),
+	}
+}
+
+// Start starts running the checks of the monitor.
+func (m *Monitor) Start() error {
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return errors.New("monitor already started")
+	}
+
+	log.Infof("Health monitor starting with %v checks", len(m.cfg.Checks))
+
+	for _, check := range m.cfg.Checks {
+		check := check
+
+		// Skip the checks without attempts, which are disabled.
+		if check.Attempts == 0 {
+			log.Warnf("%v health check disabled", check.Name)
+			continue
+		}
+
+		m.wg.Add(1)
+		go func() {
+			defer m.wg.Done()
+			check.monitor(m.cfg.Shutdown, m.quit)
+		}()
+	}
+
+	return nil
+}
+
+// Stop stops running the checks of the monitor, aborting the check attempts in
+// progress.
+func (m *Monitor) Stop() error {
+	if !atomic.CompareAndSwapInt32(&m.stopped, 0, 1) {
+		return errors.New("monitor already stopped")
+	}
+
+	log.Info("Health monitor shutting down")
+
+	close(m.quit)
+	m.wg.Wait()
+
+	return nil
+}
+
+// Observation is a check run periodically, which fails once all of its
+// attempts failed.
+type Observation struct {
+	// Name describes the check.
+	Name string
+
+	// Check runs the check, returning a non-nil error if it failed.
+	Check func() error
+
+	// Interval is the ticker triggering the check.
+	Interval ticker.Ticker
+
+	// Attempts is the number of attempts made before the check fails.
+	Attempts int
+
+	// Timeout is how long an attempt is allowed to take before it fails.
+	Timeout time.Duration
+
+	// Backoff is how long to wait between two attempts.
+	Backoff time.Duration
+
+	// Critical is true if a failure of the check shuts the node down,
+	// rather than only being logged until the check succeeds again.
+	Critical bool
+
+	// failing is true while the check keeps failing, so that only changes
+	// of its state are logged.
+	failing bool
+}
+
+// NewObservation returns an observation of the check run at the given
+// interval.
+func NewObservation(name string, check func() error, interval,
+	timeout, backoff time.Duration, attempts int,
+	critical bool) *Observation {
+
+	return &Observation{
+		Name:     name,
+		Check:    check,
+		Interval: ticker.New(interval),
+		Attempts: attempts,
+		Timeout:  timeout,
+		Backoff:  backoff,
+		Critical: critical,
+	}
+}
+
+// String returns the name of the observation.
+func (o *Observation) String() string {
+	return o.Name
+}
+
+// monitor runs the check each time the interval ticks, until quit is closed.
+func (o *Observation) monitor(shutdown func(string, ...interface{}),
+	quit chan struct{}) {
+
+	log.Debugf("Monitoring %v health check with %v attempts", o,
+		o.Attempts)
+
+	o.Interval.Resume()
+	defer o.Interval.Stop()
+
+	for {
+		select {
+		case <-o.Interval.Ticks():
+			// If the check failed with the shutdown action, there's
+			// nothing left to monitor.
+			if o.retryCheck(quit, shutdown) {
+				return
+			}
+
+		case <-quit:
+			return
+		}
+	}
+}
+
+// retryCheck makes up to the configured number of attempts of the check,
+// acting on its failure if they all failed. It returns true if the monitor
+// should stop, either because it is shutting down or because the check
+// requested a shutdown of the node.
+func (o *Observation) retryCheck(quit chan struct{},
+	shutdown func(string, ...interface{})) bool {
+
+	var err error
+	for count := 1; count <= o.Attempts; count++ {
+		err = o.attempt(quit)
+		if err == nil {
+			if o.failing {
+				log.Infof("%v health check recovered", o)
+				o.failing = false
+			}
+			return false
+		}
+
+		// The attempt is aborted, rather than failed, if the monitor
+		// is shutting down.
+		select {
+		case <-quit:
+			return true
+		default:
+		}
+
+		log.Debugf("%v health check attempt %v of %v failed: %v", o,
+			count, o.Attempts, err)
+
+		// Don't wait before acting on the last failed attempt.
+		if count == o.Attempts {
+			break
+		}
+
+		select {
+		case <-time.After(o.Backoff):
+		case <-quit:
+			return true
+		}
+	}
+
+	if o.Critical {
+		shutdown("%v health check failed after %v attempts: %v", o,
+			o.Attempts, err)
+		return true
+	}
+
+	if !o.failing {
+		log.Errorf("%v health check failing after %v attempts: %v", o,
+			o.Attempts, err)
+		o.failing = true
+	}
+
+	return false
+}
+
+// attempt runs the check once, failing with ErrCheckTimeout if the check
+// takes longer than the timeout. The check keeps running in the background
+// in that case, its result being discarded.
+func (o *Observation) attempt(quit chan struct{}) error {
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- o.Check()
+	}()
+
+	select {
+	case err := <-errChan:
+		return err
+
+	case <-time.After(o.Timeout):
+		return ErrCheckTimeout
+
+	case <-quit:
+		return errors.New("health monitor shutting down")
+	}
+}
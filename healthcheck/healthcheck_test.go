@@ -0,0 +1,146 @@
+package healthcheck
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrlnd/ticker"
+)
+
+var errCheckFailed = errors.New("check failed")
+
+// newTestObservation returns an observation triggered by a forced ticker,
+// whose check returns the errors sent on the returned channel.
+func newTestObservation(attempts int, critical bool) (*Observation,
+	*ticker.Force, chan error) {
+
+	errChan := make(chan error)
+	interval := ticker.NewForce(time.Hour)
+
+	return &Observation{
+		Name: "test",
+		Check: func() error {
+			return <-errChan
+		},
+		Interval: interval,
+		Attempts: attempts,
+		Timeout:  time.Second * 5,
+		Backoff:  time.Millisecond,
+		Critical: critical,
+	}, interval, errChan
+}
+
+// sendCheckResult sends the result of the next check attempt.
+func sendCheckResult(t *testing.T, errChan chan error, err error) {
+	t.Helper()
+
+	select {
+	case errChan <- err:
+	case <-time.After(time.Second * 5):
+		t.Fatal("check not attempted")
+	}
+}
+
+// TestMonitorCritical asserts that the monitor requests a shutdown once a
+// critical check failed all of its attempts, but not while an attempt
+// succeeds.
+func TestMonitorCritical(t *testing.T) {
+	t.Parallel()
+
+	obs, interval, errChan := newTestObservation(2, true)
+
+	shutdown := make(chan string, 1)
+	m := NewMonitor(&Config{
+		Checks: []*Observation{obs},
+		Shutdown: func(format string, params ...interface{}) {
+			shutdown <- format
+		},
+	})
+	if err := m.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer m.Stop()
+
+	// A failed attempt followed by a successful one doesn't fail the
+	// check.
+	interval.Force <- time.Now()
+	sendCheckResult(t, errChan, errCheckFailed)
+	sendCheckResult(t, errChan, nil)
+
+	// Once all attempts failed, a shutdown is requested.
+	interval.Force <- time.Now()
+	sendCheckResult(t, errChan, errCheckFailed)
+
+	select {
+	case <-shutdown:
+		t.Fatal("unexpected shutdown with attempts left")
+	default:
+	}
+
+	sendCheckResult(t, errChan, errCheckFailed)
+
+	select {
+	case <-shutdown:
+	case <-time.After(time.Second * 5):
+		t.Fatal("expected shutdown")
+	}
+}
+
+// TestMonitorNonCritical asserts that a non critical check failing doesn't
+// request a shutdown and keeps being monitored.
+func TestMonitorNonCritical(t *testing.T) {
+	t.Parallel()
+
+	obs, interval, errChan := newTestObservation(1, false)
+
+	m := NewMonitor(&Config{
+		Checks: []*Observation{obs},
+		Shutdown: func(string, ...interface{}) {
+			t.Error("unexpected shutdown")
+		},
+	})
+	if err := m.Start(); err != nil {
+		t.Fatal(err)
+	}
+
+	interval.Force <- time.Now()
+	sendCheckResult(t, errChan, errCheckFailed)
+
+	// The check is still monitored after failing, and recovers.
+	interval.Force <- time.Now()
+	sendCheckResult(t, errChan, nil)
+
+	// Once the next tick is received, the previous run of the check is
+	// complete.
+	interval.Force <- time.Now()
+
+	if err := m.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	if obs.failing {
+		t.Fatal("expected check to have recovered")
+	}
+}
+
+// TestObservationTimeout asserts that a check attempt that doesn't complete
+// in time fails with ErrCheckTimeout.
+func TestObservationTimeout(t *testing.T) {
+	t.Parallel()
+
+	block := make(chan struct{})
+	defer close(block)
+
+	obs := &Observation{
+		Name: "timeout",
+		Check: func() error {
+			<-block
+			return nil
+		},
+		Timeout: time.Millisecond,
+	}
+
+	if err := obs.attempt(make(chan struct{})); err != ErrCheckTimeout {
+		t.Fatalf("expected ErrCheckTimeout, got %v", err)
+	}
+}
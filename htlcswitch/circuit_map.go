@@ -116,6 +116,11 @@ type CircuitMap interface {
 	// circuits that use the given payment hash.
 	LookupByPaymentHash(hash [32]byte) []*PaymentCircuit
 
+	// IsClosing returns true if the circuit identified by inKey has
+	// already been closed in-memory by a settle or fail, but hasn't been
+	// deleted yet.
+	IsClosing(inKey CircuitKey) bool
+
 	// NumPending returns the total number of active circuits added by
 	// CommitCircuits.
 	NumPending() int
@@ -157,6 +162,19 @@ type circuitMap struct {
 	// is also synchronized with the persistent state of the circuit map.
 	opened map[CircuitKey]*PaymentCircuit
 
+	// openIndex is a volatile index of the outgoing htlc ids of the open
+	// circuits of each outgoing channel. It allows the open circuits of a
+	// channel to be trimmed without scanning the whole set of open
+	// circuits.
+	openIndex map[lnwire.ShortChannelID]map[uint64]struct{}
+
+	// unextracted is the set of circuits loaded from disk whose error
+	// encrypter hasn't been reextracted yet. Reextracting an encrypter
+	// rederives its shared secret, which is costly enough to dominate
+	// startup on a busy node, so it is deferred until the circuit is
+	// first accessed.
+	unextracted map[CircuitKey]struct{}
+
 	// closed is an in-memory set of circuits for which the switch has
 	// received a settle or fail. This precedes the actual deletion of a
 	// circuit from disk.
@@ -226,19 +244,24 @@ func (cm *circuitMap) initBuckets() error {
 
 // restoreMemState loads the contents of the half circuit and full circuit
 // buckets from disk and reconstructs the in-memory representation of the
-// circuit map. Afterwards, the state of the hash index is reconstructed using
-// the recovered set of full circuits. This method will also remove any stray
-// keystones, which are those that appear fully-opened, but have no pending
-// circuit related to the intended incoming link.
+// circuit map. Afterwards, the state of the hash and open circuit indexes is
+// reconstructed using the recovered set of full circuits. This method will also
+// remove any stray keystones, which are those that appear fully-opened, but
+// have no pending circuit related to the intended incoming link.
+//
+// NOTE: The error encrypters of the restored circuits aren't reextracted here,
+// but on first access to each circuit.
 func (cm *circuitMap) restoreMemState() error {
 	log.Infof("Restoring in-memory circuit state from disk")
 
 	var (
-		opened  = make(map[CircuitKey]*PaymentCircuit)
-		pending = make(map[CircuitKey]*PaymentCircuit)
+		opened         []*PaymentCircuit
+		pending        = make(map[CircuitKey]*PaymentCircuit)
+		unextracted    = make(map[CircuitKey]struct{})
+		strayKeystones []Keystone
 	)
 
-	if err := cm.cfg.DB.Update(func(tx *bolt.Tx) error {
+	if err := cm.cfg.DB.View(func(tx *bolt.Tx) error {
 		// Restore any of the circuits persisted in the circuit bucket
 		// back into memory.
 		circuitBkt := tx.Bucket(circuitAddKey)
@@ -247,7 +270,7 @@ func (cm *circuitMap) restoreMemState() error {
 		}
 
 		if err := circuitBkt.ForEach(func(_, v []byte) error {
-			circuit, err := cm.decodeCircuit(v)
+			circuit, err := decodeCircuit(v)
 			if err != nil {
 				return err
 			}
@@ -255,6 +278,12 @@ func (cm *circuitMap) restoreMemState() error {
 			circuit.LoadedFromDisk = true
 			pending[circuit.Incoming] = circuit
 
+			// Locally-sourced payments have no encrypter to
+			// reextract.
+			if circuit.ErrorEncrypter != nil {
+				unextracted[circuit.Incoming] = struct{}{}
+			}
+
 			return nil
 		}); err != nil {
 			return err
@@ -267,8 +296,7 @@ func (cm *circuitMap) restoreMemState() error {
 			return ErrCorruptedCircuitMap
 		}
 
-		var strayKeystones []Keystone
-		if err := keystoneBkt.ForEach(func(k, v []byte) error {
+		return keystoneBkt.ForEach(func(k, v []byte) error {
 			var (
 				inKey  CircuitKey
 				outKey = &CircuitKey{}
@@ -283,12 +311,20 @@ func (cm *circuitMap) restoreMemState() error {
 			}
 
 			// Retrieve the pending circuit, set its keystone, then
-			// add it to the opened map.
+			// add it to the opened set.
 			circuit, ok := pending[inKey]
 			if ok {
 				circuit.Outgoing = outKey
-				opened[*outKey] = circuit
-			} else {
+				opened = append(opened, circuit)
+				return nil
+			}
+
+			// As a precaution, we will only cleanup stray
+			// keystones related to locally-initiated payments. If
+			// a documented case of stray keystones emerges for
+			// forwarded payments, this check should be removed,
+			// but with extreme caution.
+			if outKey.ChanID == hop.Source {
 				strayKeystones = append(strayKeystones, Keystone{
 					InKey:  inKey,
 					OutKey: *outKey,
@@ -296,51 +332,55 @@ func (cm *circuitMap) restoreMemState() error {
 			}
 
 			return nil
-		}); err != nil {
-			return err
-		}
+		})
+	}); err != nil {
+		return err
+	}
 
-		// If any stray keystones were found, we'll proceed to prune
-		// them from the circuit map's persistent storage. This may
-		// manifest on older nodes that had updated channels before
-		// their short channel id was set properly. We believe this
-		// issue has been fixed, though this will allow older nodes to
-		// recover without additional intervention.
-		for _, strayKeystone := range strayKeystones {
-			// As a precaution, we will only cleanup keystones
-			// related to locally-initiated payments. If a
-			// documented case of stray keystones emerges for
-			// forwarded payments, this check should be removed, but
-			// with extreme caution.
-			if strayKeystone.OutKey.ChanID != hop.Source {
-				continue
+	// If any stray keystones were found, we'll proceed to prune them from
+	// the circuit map's persistent storage. This may manifest on older
+	// nodes that had updated channels before their short channel id was
+	// set properly. We believe this issue has been fixed, though this will
+	// allow older nodes to recover without additional intervention.
+	if len(strayKeystones) > 0 {
+		if err := cm.cfg.DB.Update(func(tx *bolt.Tx) error {
+			keystoneBkt := tx.Bucket(circuitKeystoneKey)
+			if keystoneBkt == nil {
+				return ErrCorruptedCircuitMap
 			}
 
-			log.Infof("Removing stray keystone: %v", strayKeystone)
-			err := keystoneBkt.Delete(strayKeystone.OutKey.Bytes())
-			if err != nil {
-				return err
-			}
-		}
+			for _, strayKeystone := range strayKeystones {
+				log.Infof("Removing stray keystone: %v",
+					strayKeystone)
 
-		return nil
+				err := keystoneBkt.Delete(
+					strayKeystone.OutKey.Bytes(),
+				)
+				if err != nil {
+					return err
+				}
+			}
 
-	}); err != nil {
-		return err
+			return nil
+		}); err != nil {
+			return err
+		}
 	}
 
 	cm.pending = pending
-	cm.opened = opened
+	cm.unextracted = unextracted
 	cm.closed = make(map[CircuitKey]struct{})
 
 	log.Infof("Payment circuits loaded: num_pending=%v, num_open=%v",
 		len(pending), len(opened))
 
-	// Finally, reconstruct the hash index by running through our set of
-	// open circuits.
+	// Finally, reconstruct the open circuit and hash indexes by running
+	// through our set of open circuits.
+	cm.opened = make(map[CircuitKey]*PaymentCircuit, len(opened))
+	cm.openIndex = make(map[lnwire.ShortChannelID]map[uint64]struct{})
 	cm.hashIndex = make(map[[32]byte]map[CircuitKey]struct{})
 	for _, circuit := range opened {
-		cm.addCircuitToHashIndex(circuit)
+		cm.addOpenCircuit(circuit)
 	}
 
 	return nil
@@ -348,9 +388,10 @@ func (cm *circuitMap) restoreMemState() error {
 
 // decodeCircuit reconstructs an in-memory payment circuit from a byte slice.
 // The byte slice is assumed to have been generated by the circuit's Encode
-// method. If the decoding is successful, the onion obfuscator will be
-// reextracted, since it is not stored in plaintext on disk.
-func (cm *circuitMap) decodeCircuit(v []byte) (*PaymentCircuit, error) {
+// method. The onion obfuscator of the decoded circuit still has to be
+// reextracted with extractErrorEncrypter, since it is not stored in plaintext
+// on disk.
+func decodeCircuit(v []byte) (*PaymentCircuit, error) {
 	var circuit = &PaymentCircuit{}
 
 	circuitReader := bytes.NewReader(v)
@@ -358,28 +399,51 @@ func (cm *circuitMap) decodeCircuit(v []byte) (*PaymentCircuit, error) {
 		return nil, err
 	}
 
-	// If the error encrypter is nil, this is locally-source payment so
-	// there is no encrypter.
-	if circuit.ErrorEncrypter == nil {
-		return circuit, nil
+	return circuit, nil
+}
+
+// extractErrorEncrypter reextracts the error encrypter of a circuit loaded from
+// disk, so that its shared secret is rederived from what was decoded. It is a
+// no-op for circuits whose encrypter was already extracted, or that have none.
+//
+// NOTE: This method must be called with the circuit map's write lock held.
+func (cm *circuitMap) extractErrorEncrypter(circuit *PaymentCircuit) error {
+	if _, ok := cm.unextracted[circuit.Incoming]; !ok {
+		return nil
 	}
 
-	// Otherwise, we need to reextract the encrypter, so that the shared
-	// secret is rederived from what was decoded.
-	err := circuit.ErrorEncrypter.Reextract(
-		cm.cfg.ExtractErrorEncrypter,
-	)
+	err := circuit.ErrorEncrypter.Reextract(cm.cfg.ExtractErrorEncrypter)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return circuit, nil
+	delete(cm.unextracted, circuit.Incoming)
+
+	return nil
+}
+
+// needsExtraction returns true if any of the passed circuits still has to have
+// its error encrypter reextracted.
+//
+// NOTE: This method must be called with the circuit map's read lock held.
+func (cm *circuitMap) needsExtraction(circuits ...*PaymentCircuit) bool {
+	for _, circuit := range circuits {
+		if circuit == nil {
+			continue
+		}
+		if _, ok := cm.unextracted[circuit.Incoming]; ok {
+			return true
+		}
+	}
+
+	return false
 }
 
 // trimAllOpenCircuits reads the set of active channels from disk and trims
 // keystones for any non-pending channels using the next unallocated htlc index.
-// This method is intended to be called on startup. Each link will also trim
-// it's own circuits upon startup.
+// The keystones of all channels are trimmed within a single database
+// transaction. This method is intended to be called on startup. Each link will
+// also trim it's own circuits upon startup.
 //
 // NOTE: This operation will be applied to the persistent state of all active
 // channels. Therefore, it must be called before any links are created to avoid
@@ -390,6 +454,7 @@ func (cm *circuitMap) trimAllOpenCircuits() error {
 		return err
 	}
 
+	trimStarts := make(map[lnwire.ShortChannelID]uint64)
 	for _, activeChannel := range activeChannels {
 		if activeChannel.IsPending {
 			continue
@@ -410,17 +475,14 @@ func (cm *circuitMap) trimAllOpenCircuits() error {
 			return err
 		}
 
-		// Finally, remove all pending circuits above at or above the
-		// next unallocated local htlc indexes. This has the effect of
-		// reverting any circuits that have either not been locked in,
-		// or had not been included in a pending commitment.
-		err = cm.TrimOpenCircuits(chanID, start)
-		if err != nil {
-			return err
-		}
+		trimStarts[chanID] = start
 	}
 
-	return nil
+	// Finally, remove all pending circuits above at or above the next
+	// unallocated local htlc indexes. This has the effect of reverting any
+	// circuits that have either not been locked in, or had not been
+	// included in a pending commitment.
+	return cm.trimOpenCircuits(trimStarts, cm.cfg.DB.Update)
 }
 
 // TrimOpenCircuits removes a channel's keystones above the short chan id's
@@ -429,49 +491,82 @@ func (cm *circuitMap) trimAllOpenCircuits() error {
 // of actually committing the Add htlcs into a commitment txn, this allows
 // circuits to be opened preemptively, since we can roll them back after any
 // failures.
+//
+// NOTE: This method uses batched writes to improve performance, gains will only
+// be realized if it is called concurrently from separate goroutines.
 func (cm *circuitMap) TrimOpenCircuits(chanID lnwire.ShortChannelID,
 	start uint64) error {
 
-	log.Infof("Trimming open circuits for chan_id=%v, start_htlc_id=%v",
-		chanID, start)
+	return cm.trimOpenCircuits(
+		map[lnwire.ShortChannelID]uint64{chanID: start},
+		cm.cfg.DB.Batch,
+	)
+}
 
-	var trimmedOutKeys []CircuitKey
+// trimOpenCircuits removes the keystones of each of the passed channels whose
+// outgoing htlc index is at or above the channel's start index, using the
+// passed function to run the database transaction.
+func (cm *circuitMap) trimOpenCircuits(
+	trimStarts map[lnwire.ShortChannelID]uint64,
+	dbUpdate func(func(*bolt.Tx) error) error) error {
+
+	if len(trimStarts) == 0 {
+		return nil
+	}
 
-	// Scan forward from the last unacked htlc id, stopping as soon as we
-	// don't find any more. Outgoing htlc id's must be assigned in order,
-	// so there should never be disjoint segments of keystones to trim.
+	// Revert the trimmed circuits to a half-open state in memory, looking
+	// them up through the open circuit index of their outgoing channel.
 	cm.mtx.Lock()
-	for i := start; ; i++ {
-		outKey := CircuitKey{
-			ChanID: chanID,
-			HtlcID: i,
-		}
+	for chanID, start := range trimStarts {
+		log.Infof("Trimming open circuits for chan_id=%v, "+
+			"start_htlc_id=%v", chanID, start)
 
-		circuit, ok := cm.opened[outKey]
-		if !ok {
-			break
-		}
+		for htlcID := range cm.openIndex[chanID] {
+			if htlcID < start {
+				continue
+			}
 
-		circuit.Outgoing = nil
-		delete(cm.opened, outKey)
-		trimmedOutKeys = append(trimmedOutKeys, outKey)
-		cm.removeCircuitFromHashIndex(circuit)
-	}
-	cm.mtx.Unlock()
+			outKey := CircuitKey{
+				ChanID: chanID,
+				HtlcID: htlcID,
+			}
+			circuit, ok := cm.opened[outKey]
+			if !ok {
+				continue
+			}
 
-	if len(trimmedOutKeys) == 0 {
-		return nil
+			cm.removeOpenCircuit(circuit)
+			circuit.Outgoing = nil
+		}
 	}
+	cm.mtx.Unlock()
 
-	return cm.cfg.DB.Update(func(tx *bolt.Tx) error {
+	return dbUpdate(func(tx *bolt.Tx) error {
 		keystoneBkt := tx.Bucket(circuitKeystoneKey)
 		if keystoneBkt == nil {
 			return ErrCorruptedCircuitMap
 		}
 
-		for _, outKey := range trimmedOutKeys {
-			err := keystoneBkt.Delete(outKey.Bytes())
-			if err != nil {
+		// Keystones are keyed by their outgoing circuit key, which is
+		// prefixed by the outgoing channel id, so the keystones of a
+		// channel above its start index form a contiguous range of
+		// the bucket.
+		var trimmedKeys [][]byte
+		for chanID, start := range trimStarts {
+			startKey := CircuitKey{ChanID: chanID, HtlcID: start}
+			seekKey := startKey.Bytes()
+			chanPrefix := seekKey[:8]
+
+			c := keystoneBkt.Cursor()
+			for k, _ := c.Seek(seekKey); k != nil &&
+				bytes.HasPrefix(k, chanPrefix); k, _ = c.Next() {
+
+				trimmedKeys = append(trimmedKeys, k)
+			}
+		}
+
+		for _, k := range trimmedKeys {
+			if err := keystoneBkt.Delete(k); err != nil {
 				return err
 			}
 		}
@@ -484,26 +579,96 @@ func (cm *circuitMap) TrimOpenCircuits(chanID lnwire.ShortChannelID,
 // IDs. Returns nil if there is no such circuit.
 func (cm *circuitMap) LookupCircuit(inKey CircuitKey) *PaymentCircuit {
 	cm.mtx.RLock()
-	defer cm.mtx.RUnlock()
+	circuit := cm.pending[inKey]
+	lazy := cm.needsExtraction(circuit)
+	cm.mtx.RUnlock()
 
-	return cm.pending[inKey]
+	if !lazy {
+		return circuit
+	}
+
+	// The circuit was loaded from disk and hasn't been accessed since, so
+	// its error encrypter must be reextracted under the write lock.
+	cm.mtx.Lock()
+	defer cm.mtx.Unlock()
+
+	circuit = cm.pending[inKey]
+	if circuit == nil {
+		return nil
+	}
+	if err := cm.extractErrorEncrypter(circuit); err != nil {
+		log.Errorf("Unable to reextract error encrypter of circuit "+
+			"%v: %v", inKey, err)
+		return nil
+	}
+
+	return circuit
 }
 
 // LookupOpenCircuit searches for the circuit identified by its outgoing circuit
 // key.
 func (cm *circuitMap) LookupOpenCircuit(outKey CircuitKey) *PaymentCircuit {
 	cm.mtx.RLock()
-	defer cm.mtx.RUnlock()
+	circuit := cm.opened[outKey]
+	lazy := cm.needsExtraction(circuit)
+	cm.mtx.RUnlock()
 
-	return cm.opened[outKey]
+	if !lazy {
+		return circuit
+	}
+
+	cm.mtx.Lock()
+	defer cm.mtx.Unlock()
+
+	circuit = cm.opened[outKey]
+	if circuit == nil {
+		return nil
+	}
+	if err := cm.extractErrorEncrypter(circuit); err != nil {
+		log.Errorf("Unable to reextract error encrypter of circuit "+
+			"%v: %v", circuit.Incoming, err)
+		return nil
+	}
+
+	return circuit
 }
 
 // LookupByPaymentHash looks up and returns any payment circuits with a given
 // payment hash.
 func (cm *circuitMap) LookupByPaymentHash(hash [32]byte) []*PaymentCircuit {
 	cm.mtx.RLock()
-	defer cm.mtx.RUnlock()
+	circuits := cm.lookupByPaymentHash(hash)
+	lazy := cm.needsExtraction(circuits...)
+	cm.mtx.RUnlock()
+
+	if !lazy {
+		return circuits
+	}
+
+	cm.mtx.Lock()
+	defer cm.mtx.Unlock()
+
+	// Circuits whose encrypter can't be reextracted are left out, as they
+	// can't be used to fail back their htlc.
+	extracted := make([]*PaymentCircuit, 0, len(circuits))
+	for _, circuit := range cm.lookupByPaymentHash(hash) {
+		if err := cm.extractErrorEncrypter(circuit); err != nil {
+			log.Errorf("Unable to reextract error encrypter of "+
+				"circuit %v: %v", circuit.Incoming, err)
+			continue
+		}
+
+		extracted = append(extracted, circuit)
+	}
+
+	return extracted
+}
 
+// lookupByPaymentHash returns the open circuits that use the given payment
+// hash.
+//
+// NOTE: This method must be called with the circuit map's lock held.
+func (cm *circuitMap) lookupByPaymentHash(hash [32]byte) []*PaymentCircuit {
 	var circuits []*PaymentCircuit
 	if circuitSet, ok := cm.hashIndex[hash]; ok {
 		// Iterate over the outgoing circuit keys found with this hash,
@@ -706,7 +871,9 @@ func (cm *circuitMap) OpenCircuits(keystones ...Keystone) error {
 	}
 	cm.mtx.RUnlock()
 
-	err := cm.cfg.DB.Update(func(tx *bolt.Tx) error {
+	// Write the keystones using bolt's Batch write, as links open the
+	// circuits of their outgoing adds concurrently.
+	err := cm.cfg.DB.Batch(func(tx *bolt.Tx) error {
 		// Now, load the circuit bucket to which we will write the
 		// already serialized circuit.
 		keystoneBkt := tx.Bucket(circuitKeystoneKey)
@@ -737,19 +904,55 @@ func (cm *circuitMap) OpenCircuits(keystones ...Keystone) error {
 		// Since our persistent operation was successful, we can now
 		// modify the in memory representations. Set the outgoing
 		// circuit key on our pending circuit, add the same circuit to
-		// set of opened circuits, and add this circuit to the hash
-		// index.
+		// set of opened circuits, and add this circuit to the open
+		// circuit and hash indexes.
 		circuit.Outgoing = &CircuitKey{}
 		*circuit.Outgoing = ks.OutKey
 
-		cm.opened[ks.OutKey] = circuit
-		cm.addCircuitToHashIndex(circuit)
+		cm.addOpenCircuit(circuit)
 	}
 	cm.mtx.Unlock()
 
 	return nil
 }
 
+// addOpenCircuit adds a circuit with a keystone to the set of opened circuits,
+// and indexes it by outgoing channel and payment hash.
+//
+// NOTE: This method must be called with the circuit map's write lock held.
+func (cm *circuitMap) addOpenCircuit(c *PaymentCircuit) {
+	outKey := c.OutKey()
+	cm.opened[outKey] = c
+
+	htlcIDs, ok := cm.openIndex[outKey.ChanID]
+	if !ok {
+		htlcIDs = make(map[uint64]struct{})
+		cm.openIndex[outKey.ChanID] = htlcIDs
+	}
+	htlcIDs[outKey.HtlcID] = struct{}{}
+
+	cm.addCircuitToHashIndex(c)
+}
+
+// removeOpenCircuit removes a circuit from the set of opened circuits and from
+// the open circuit and hash indexes. The keystone of the circuit is left
+// untouched.
+//
+// NOTE: This method must be called with the circuit map's write lock held.
+func (cm *circuitMap) removeOpenCircuit(c *PaymentCircuit) {
+	outKey := c.OutKey()
+	delete(cm.opened, outKey)
+
+	if htlcIDs, ok := cm.openIndex[outKey.ChanID]; ok {
+		delete(htlcIDs, outKey.HtlcID)
+		if len(htlcIDs) == 0 {
+			delete(cm.openIndex, outKey.ChanID)
+		}
+	}
+
+	cm.removeCircuitFromHashIndex(c)
+}
+
 // addCirciutToHashIndex inserts a circuit into the circuit map's hash index, so
 // that it can be queried using LookupByPaymentHash.
 func (cm *circuitMap) addCircuitToHashIndex(c *PaymentCircuit) {
@@ -776,6 +979,10 @@ func (cm *circuitMap) FailCircuit(inKey CircuitKey) (*PaymentCircuit, error) {
 		return nil, ErrCircuitClosing
 	}
 
+	if err := cm.extractErrorEncrypter(circuit); err != nil {
+		return nil, err
+	}
+
 	cm.closed[inKey] = struct{}{}
 
 	return circuit, nil
@@ -799,6 +1006,10 @@ func (cm *circuitMap) CloseCircuit(outKey CircuitKey) (*PaymentCircuit, error) {
 		return nil, ErrCircuitClosing
 	}
 
+	if err := cm.extractErrorEncrypter(circuit); err != nil {
+		return nil, err
+	}
+
 	cm.closed[circuit.Incoming] = struct{}{}
 
 	return circuit, nil
@@ -817,8 +1028,9 @@ func (cm *circuitMap) DeleteCircuits(inKeys ...CircuitKey) error {
 	}))
 
 	var (
-		closingCircuits = make(map[CircuitKey]struct{})
-		removedCircuits = make(map[CircuitKey]*PaymentCircuit)
+		closingCircuits     = make(map[CircuitKey]struct{})
+		unextractedCircuits = make(map[CircuitKey]struct{})
+		removedCircuits     = make(map[CircuitKey]*PaymentCircuit)
 	)
 
 	cm.mtx.Lock()
@@ -838,9 +1050,13 @@ func (cm *circuitMap) DeleteCircuits(inKeys ...CircuitKey) error {
 			delete(cm.closed, inKey)
 		}
 
+		if _, ok := cm.unextracted[inKey]; ok {
+			unextractedCircuits[inKey] = struct{}{}
+			delete(cm.unextracted, inKey)
+		}
+
 		if circuit.HasKeystone() {
-			delete(cm.opened, circuit.OutKey())
-			cm.removeCircuitFromHashIndex(circuit)
+			cm.removeOpenCircuit(circuit)
 		}
 
 		removedCircuits[inKey] = circuit
@@ -897,9 +1113,12 @@ func (cm *circuitMap) DeleteCircuits(inKeys ...CircuitKey) error {
 			cm.closed[inKey] = struct{}{}
 		}
 
+		if _, ok := unextractedCircuits[inKey]; ok {
+			cm.unextracted[inKey] = struct{}{}
+		}
+
 		if circuit.HasKeystone() {
-			cm.opened[circuit.OutKey()] = circuit
-			cm.addCircuitToHashIndex(circuit)
+			cm.addOpenCircuit(circuit)
 		}
 	}
 	cm.mtx.Unlock()
@@ -927,6 +1146,16 @@ func (cm *circuitMap) removeCircuitFromHashIndex(c *PaymentCircuit) {
 	}
 }
 
+// IsClosing returns true if the circuit identified by inKey has already been
+// closed in-memory by a settle or fail, but hasn't been deleted yet.
+func (cm *circuitMap) IsClosing(inKey CircuitKey) bool {
+	cm.mtx.RLock()
+	defer cm.mtx.RUnlock()
+
+	_, ok := cm.closed[inKey]
+	return ok
+}
+
 // NumPending returns the number of active circuits added to the circuit map.
 func (cm *circuitMap) NumPending() int {
 	cm.mtx.RLock()
@@ -1100,6 +1100,151 @@ func TestCircuitMapTrimOpenCircuits(t *testing.T) {
 	)
 }
 
+// TestCircuitMapTrimNonContiguousOpenCircuits asserts that trimming the open
+// circuits of a channel reverts all of its circuits at or above the start
+// index, even when their outgoing htlc indexes aren't contiguous, while
+// leaving the circuits of other channels untouched.
+func TestCircuitMapTrimNonContiguousOpenCircuits(t *testing.T) {
+	t.Parallel()
+
+	var (
+		chan1 = lnwire.NewShortChanIDFromInt(1)
+		chan2 = lnwire.NewShortChanIDFromInt(2)
+		chan3 = lnwire.NewShortChanIDFromInt(3)
+	)
+
+	cfg, circuitMap := newCircuitMap(t)
+
+	// Open five circuits, four of them through chan2 with gaps between
+	// their outgoing htlc indexes, and the last one through chan3.
+	outKeys := []htlcswitch.CircuitKey{
+		{ChanID: chan2, HtlcID: 0},
+		{ChanID: chan2, HtlcID: 2},
+		{ChanID: chan2, HtlcID: 5},
+		{ChanID: chan2, HtlcID: 9},
+		{ChanID: chan3, HtlcID: 7},
+	}
+	circuits := make([]*htlcswitch.PaymentCircuit, len(outKeys))
+	keystones := make([]htlcswitch.Keystone, len(outKeys))
+	for i, outKey := range outKeys {
+		circuits[i] = &htlcswitch.PaymentCircuit{
+			Incoming: htlcswitch.CircuitKey{
+				ChanID: chan1,
+				HtlcID: uint64(i),
+			},
+			ErrorEncrypter: htlcswitch.NewMockObfuscator(),
+		}
+		keystones[i] = htlcswitch.Keystone{
+			InKey:  circuits[i].Incoming,
+			OutKey: outKey,
+		}
+	}
+
+	if _, err := circuitMap.CommitCircuits(circuits...); err != nil {
+		t.Fatalf("failed to commit circuits: %v", err)
+	}
+	if err := circuitMap.OpenCircuits(keystones...); err != nil {
+		t.Fatalf("failed to open circuits: %v", err)
+	}
+
+	// Trimming chan2 from index 3 must revert the circuits at indexes 5
+	// and 9, despite the gap left by the missing indexes 3 and 4.
+	if err := circuitMap.TrimOpenCircuits(chan2, 3); err != nil {
+		t.Fatalf("unable to trim circuits: %v", err)
+	}
+
+	assertTrimmed := func(cm htlcswitch.CircuitMap) {
+		t.Helper()
+
+		assertCircuitsOpenedPostRestart(
+			t, cm, circuits[:2], keystones[:2],
+		)
+		assertCircuitsNotOpenedPreRestart(
+			t, cm, circuits[2:4], keystones[2:4], 2,
+		)
+		assertCircuitsOpenedPostRestart(
+			t, cm, circuits[4:], keystones[4:],
+		)
+
+		if cm.NumOpen() != 3 {
+			t.Fatalf("expected 3 open circuits, got %d",
+				cm.NumOpen())
+		}
+	}
+	assertTrimmed(circuitMap)
+
+	// The trimmed keystones must also have been removed from disk.
+	_, circuitMap = restartCircuitMap(t, cfg)
+	assertTrimmed(circuitMap)
+}
+
+// TestCircuitMapLazyErrorEncrypterExtraction asserts that the error encrypters
+// of the circuits restored on startup are only reextracted once the circuits
+// are accessed, and only once per circuit.
+func TestCircuitMapLazyErrorEncrypterExtraction(t *testing.T) {
+	t.Parallel()
+
+	var (
+		chan1 = lnwire.NewShortChanIDFromInt(1)
+		chan2 = lnwire.NewShortChanIDFromInt(2)
+	)
+
+	cfg, circuitMap := newCircuitMap(t)
+
+	circuit := &htlcswitch.PaymentCircuit{
+		Incoming: htlcswitch.CircuitKey{
+			ChanID: chan1,
+			HtlcID: 1,
+		},
+		PaymentHash:    hash1,
+		ErrorEncrypter: testExtracter,
+	}
+	keystone := htlcswitch.Keystone{
+		InKey: circuit.Incoming,
+		OutKey: htlcswitch.CircuitKey{
+			ChanID: chan2,
+			HtlcID: 1,
+		},
+	}
+
+	if _, err := circuitMap.CommitCircuits(circuit); err != nil {
+		t.Fatalf("failed to commit circuits: %v", err)
+	}
+	if err := circuitMap.OpenCircuits(keystone); err != nil {
+		t.Fatalf("failed to open circuits: %v", err)
+	}
+
+	// Count the extractions performed by the restarted circuit map.
+	var numExtractions int
+	extract := cfg.ExtractErrorEncrypter
+	cfg.ExtractErrorEncrypter = func(key *secp256k1.PublicKey) (
+		hop.ErrorEncrypter, lnwire.FailCode) {
+
+		numExtractions++
+		return extract(key)
+	}
+
+	_, circuitMap = restartCircuitMap(t, cfg)
+	if numExtractions != 0 {
+		t.Fatalf("expected no extraction on startup, got %d",
+			numExtractions)
+	}
+
+	// The circuit is still reported as open without being accessed.
+	if circuitMap.NumOpen() != 1 {
+		t.Fatalf("expected 1 open circuit, got %d", circuitMap.NumOpen())
+	}
+
+	// Looking the circuit up through any of its keys must return it with
+	// its encrypter reextracted, which must only happen once.
+	assertHasKeystone(t, circuitMap, keystone.OutKey, circuit)
+	assertHasCircuit(t, circuitMap, circuit)
+	assertHasCircuitForHash(t, circuitMap, hash1, circuit)
+	if numExtractions != 1 {
+		t.Fatalf("expected 1 extraction, got %d", numExtractions)
+	}
+}
+
 // TestCircuitMapCloseOpenCircuits asserts that the circuit map can properly
 // close open circuits, and that it allows at most one response to do so
 // successfully. It also checks that a circuit is reopened if the close was not
@@ -1162,11 +1307,18 @@ func TestCircuitMapCloseOpenCircuits(t *testing.T) {
 		t.Fatalf("failed to open circuits: %v", err)
 	}
 
+	if circuitMap.IsClosing(circuit.Incoming) {
+		t.Fatalf("open circuit should not be closing")
+	}
+
 	// Close the open circuit for the first time, which should succeed.
 	_, err = circuitMap.FailCircuit(circuit.Incoming)
 	if err != nil {
 		t.Fatalf("unable to close unopened circuit")
 	}
+	if !circuitMap.IsClosing(circuit.Incoming) {
+		t.Fatalf("failed circuit should be closing")
+	}
 
 	// Closing the circuit a second time should result in a failure.
 	_, err = circuitMap.FailCircuit(circuit.Incoming)
@@ -1182,6 +1334,12 @@ func TestCircuitMapCloseOpenCircuits(t *testing.T) {
 	// will be trimmed.
 	_, circuitMap = restartCircuitMap(t, cfg)
 
+	// The in-memory close isn't persisted, so the circuit must no longer
+	// be reported as closing.
+	if circuitMap.IsClosing(circuit.Incoming) {
+		t.Fatalf("restored circuit should not be closing")
+	}
+
 	// Close the open circuit for the first time, which should succeed.
 	_, err = circuitMap.FailCircuit(circuit.Incoming)
 	if err != nil {
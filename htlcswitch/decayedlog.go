@@ -151,6 +151,17 @@ func (d *DecayedLog) Stop() error {
 	return nil
 }
 
+// BeginSnapshot starts a read-only transaction providing a consistent view of
+// the log, used to copy it while it's in use. The caller must roll back the
+// transaction once done.
+func (d *DecayedLog) BeginSnapshot() (*bolt.Tx, error) {
+	if atomic.LoadInt32(&d.started) == 0 {
+		return nil, errors.New("decayed log not started")
+	}
+
+	return d.db.Begin(false)
+}
+
 // garbageCollector deletes entries from sharedHashBucket whose expiry height
 // has already past. This function MUST be run as a goroutine.
 func (d *DecayedLog) garbageCollector(epochClient *chainntnfs.BlockEpochEvent) {
@@ -0,0 +1,44 @@
+package htlcswitch
+
+import (
+	prand "math/rand"
+	"time"
+)
+
+// FailureDelayPolicy decides how long the links wait before failing back the
+// HTLCs they reject, either as the final hop or while forwarding them. Failing
+// them back after a random delay makes it harder for the sender to tell
+// whether the node is the final hop by timing the failures.
+type FailureDelayPolicy struct {
+	// minDelay is the shortest delay before failing back an HTLC.
+	minDelay time.Duration
+
+	// maxDelay is the longest delay before failing back an HTLC.
+	maxDelay time.Duration
+
+	// randInt63n returns a random number in [0, n).
+	randInt63n func(n int64) int64
+}
+
+// NewFailureDelayPolicy creates a policy delaying the failures of HTLCs by a
+// random duration between minDelay and maxDelay, both included.
+func NewFailureDelayPolicy(minDelay,
+	maxDelay time.Duration) *FailureDelayPolicy {
+
+	return &FailureDelayPolicy{
+		minDelay:   minDelay,
+		maxDelay:   maxDelay,
+		randInt63n: prand.Int63n,
+	}
+}
+
+// Delay returns the random duration to wait before failing back the next
+// HTLC.
+func (p *FailureDelayPolicy) Delay() time.Duration {
+	if p.maxDelay <= p.minDelay {
+		return p.minDelay
+	}
+
+	spread := int64(p.maxDelay-p.minDelay) + 1
+	return p.minDelay + time.Duration(p.randInt63n(spread))
+}
@@ -0,0 +1,65 @@
+package htlcswitch
+
+import (
+	"testing"
+	"time"
+)
+
+// TestFailureDelayPolicy asserts that the delays before failing back HTLCs
+// stay within the bounds of the policy.
+func TestFailureDelayPolicy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		minDelay time.Duration
+		maxDelay time.Duration
+		rand     int64
+		delay    time.Duration
+	}{
+		{
+			name: "disabled",
+		},
+		{
+			name:     "fixed delay",
+			minDelay: time.Second,
+			maxDelay: time.Second,
+			delay:    time.Second,
+		},
+		{
+			name:     "lower bound",
+			minDelay: time.Second,
+			maxDelay: 2 * time.Second,
+			delay:    time.Second,
+		},
+		{
+			name:     "upper bound",
+			minDelay: time.Second,
+			maxDelay: 2 * time.Second,
+			rand:     int64(time.Second),
+			delay:    2 * time.Second,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			policy := NewFailureDelayPolicy(
+				test.minDelay, test.maxDelay,
+			)
+			policy.randInt63n = func(n int64) int64 {
+				if test.rand >= n {
+					t.Fatalf("random value %v out of "+
+						"range [0, %v)", test.rand, n)
+				}
+				return test.rand
+			}
+
+			delay := policy.Delay()
+			if delay != test.delay {
+				t.Fatalf("expected delay %v, got %v",
+					test.delay, delay)
+			}
+		})
+	}
+}
@@ -23,6 +23,11 @@ type Iterator interface {
 	// information given to it by the prior hop.
 	ForwardingInstructions() (ForwardingInfo, error)
 
+	// HopPayload returns the full payload of this hop, which includes the
+	// forwarding instructions along with any additional records the sender
+	// included for the final hop.
+	HopPayload() (*Payload, error)
+
 	// ExtraOnionBlob returns the additional EOB data (if available).
 	ExtraOnionBlob() []byte
 
@@ -79,30 +84,35 @@ func (r *sphinxHopIterator) EncodeNextHop(w io.Writer) error {
 //
 // NOTE: Part of the HopIterator interface.
 func (r *sphinxHopIterator) ForwardingInstructions() (ForwardingInfo, error) {
+	p, err := r.HopPayload()
+	if err != nil {
+		return ForwardingInfo{}, err
+	}
+
+	return p.ForwardingInfo(), nil
+}
+
+// HopPayload returns the full payload of this hop, decoded from either the
+// legacy or the TLV onion payload.
+//
+// NOTE: Part of the HopIterator interface.
+func (r *sphinxHopIterator) HopPayload() (*Payload, error) {
 	switch r.processedPacket.Payload.Type {
 	// If this is the legacy payload, then we'll extract the information
 	// directly from the pre-populated ForwardingInstructions field.
 	case sphinx.PayloadLegacy:
 		fwdInst := r.processedPacket.ForwardingInstructions
-		p := NewLegacyPayload(fwdInst)
-
-		return p.ForwardingInfo(), nil
+		return NewLegacyPayload(fwdInst), nil
 
 	// Otherwise, if this is the TLV payload, then we'll make a new stream
-	// to decode only what we need to make routing decisions.
+	// to decode the payload.
 	case sphinx.PayloadTLV:
-		p, err := NewPayloadFromReader(bytes.NewReader(
+		return NewPayloadFromReader(bytes.NewReader(
 			r.processedPacket.Payload.Payload,
 		))
-		if err != nil {
-			return ForwardingInfo{}, err
-		}
-
-		return p.ForwardingInfo(), nil
 
 	default:
-		return ForwardingInfo{}, fmt.Errorf("unknown "+
-			"sphinx payload type: %v",
+		return nil, fmt.Errorf("unknown sphinx payload type: %v",
 			r.processedPacket.Payload.Type)
 	}
 }
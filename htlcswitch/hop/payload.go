@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/decred/dcrlnd/lntypes"
 	"github.com/decred/dcrlnd/lnwire"
 	"github.com/decred/dcrlnd/record"
 	"github.com/decred/dcrlnd/tlv"
@@ -50,6 +51,23 @@ type Payload struct {
 	// FwdInfo holds the basic parameters required for HTLC forwarding, e.g.
 	// amount, cltv, and next hop.
 	FwdInfo ForwardingInfo
+
+	// MPP holds the info provided in an option_mpp record when parsed from
+	// a TLV onion payload. It carries the payment address of the invoice
+	// being paid, and is only set for the final hop.
+	MPP *record.MPP
+
+	// AMP holds the AMP fields of a payment to a reusable invoice. It is
+	// only set for the final hop, if the sender included the record.
+	AMP *record.AMP
+
+	// KeySend holds the preimage of a spontaneous keysend payment, if the
+	// sender included the record.
+	KeySend *lntypes.Preimage
+
+	// Legacy is true if the payload was parsed from a legacy, non-TLV,
+	// onion payload.
+	Legacy bool
 }
 
 // NewLegacyPayload builds a Payload from the amount, cltv, and next hop
@@ -64,6 +82,7 @@ func NewLegacyPayload(f *sphinx.HopData) *Payload {
 			AmountToForward: lnwire.MilliAtom(f.ForwardAmount),
 			OutgoingCTLV:    f.OutgoingCltv,
 		},
+		Legacy: true,
 	}
 }
 
@@ -71,15 +90,21 @@ func NewLegacyPayload(f *sphinx.HopData) *Payload {
 // should correspond to the bytes encapsulated in a TLV onion payload.
 func NewPayloadFromReader(r io.Reader) (*Payload, error) {
 	var (
-		cid  uint64
-		amt  uint64
-		cltv uint32
+		cid     uint64
+		amt     uint64
+		cltv    uint32
+		mpp     = &record.MPP{}
+		amp     = &record.AMP{}
+		keySend [32]byte
 	)
 
 	tlvStream, err := tlv.NewStream(
 		record.NewAmtToFwdRecord(&amt),
 		record.NewLockTimeRecord(&cltv),
 		record.NewNextHopIDRecord(&cid),
+		mpp.Record(),
+		amp.Record(),
+		record.NewKeySendRecord(&keySend),
 	)
 	if err != nil {
 		return nil, err
@@ -99,6 +124,24 @@ func NewPayloadFromReader(r io.Reader) (*Payload, error) {
 		return nil, err
 	}
 
+	// If no MPP field was parsed, set the MPP field on the resulting
+	// payload to nil.
+	if _, ok := parsedTypes[record.MPPOnionType]; !ok {
+		mpp = nil
+	}
+
+	// If no AMP record was parsed, set the AMP field on the resulting
+	// payload to nil.
+	if _, ok := parsedTypes[record.AMPOnionType]; !ok {
+		amp = nil
+	}
+
+	var keySendPreimage *lntypes.Preimage
+	if _, ok := parsedTypes[record.KeySendType]; ok {
+		preimage := lntypes.Preimage(keySend)
+		keySendPreimage = &preimage
+	}
+
 	return &Payload{
 		FwdInfo: ForwardingInfo{
 			Network:         DecredNetwork,
@@ -106,6 +149,9 @@ func NewPayloadFromReader(r io.Reader) (*Payload, error) {
 			AmountToForward: lnwire.MilliAtom(amt),
 			OutgoingCTLV:    cltv,
 		},
+		MPP:     mpp,
+		AMP:     amp,
+		KeySend: keySendPreimage,
 	}, nil
 }
 
@@ -115,6 +161,21 @@ func (h *Payload) ForwardingInfo() ForwardingInfo {
 	return h.FwdInfo
 }
 
+// MultiPath returns the MPP fields of the payload, if any.
+func (h *Payload) MultiPath() *record.MPP {
+	return h.MPP
+}
+
+// AMPRecord returns the AMP record of the payload, if any.
+func (h *Payload) AMPRecord() *record.AMP {
+	return h.AMP
+}
+
+// KeySendPreimage returns the keysend preimage of the payload, if any.
+func (h *Payload) KeySendPreimage() *lntypes.Preimage {
+	return h.KeySend
+}
+
 // ValidateParsedPayloadTypes checks the types parsed from a hop payload to
 // ensure that the proper fields are either included or omitted. The finalHop
 // boolean should be true if the payload was parsed for an exit hop. The
@@ -127,6 +188,8 @@ func ValidateParsedPayloadTypes(parsedTypes tlv.TypeSet,
 	_, hasAmt := parsedTypes[record.AmtOnionType]
 	_, hasLockTime := parsedTypes[record.LockTimeOnionType]
 	_, hasNextHop := parsedTypes[record.NextHopOnionType]
+	_, hasMPP := parsedTypes[record.MPPOnionType]
+	_, hasAMP := parsedTypes[record.AMPOnionType]
 
 	switch {
 
@@ -155,6 +218,23 @@ func ValidateParsedPayloadTypes(parsedTypes tlv.TypeSet,
 			Omitted:  false,
 			FinalHop: true,
 		}
+
+	// Intermediate nodes should never receive MPP fields.
+	case !isFinalHop && hasMPP:
+		return ErrInvalidPayload{
+			Type:     record.MPPOnionType,
+			Omitted:  false,
+			FinalHop: false,
+		}
+
+	// Intermediate nodes should never receive AMP fields, as they are
+	// only meant for the receiver of the payment.
+	case !isFinalHop && hasAMP:
+		return ErrInvalidPayload{
+			Type:     record.AMPOnionType,
+			Omitted:  false,
+			FinalHop: false,
+		}
 	}
 
 	return nil
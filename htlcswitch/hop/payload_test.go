@@ -6,7 +6,9 @@ import (
 	"testing"
 
 	"github.com/decred/dcrlnd/htlcswitch/hop"
+	"github.com/decred/dcrlnd/lntypes"
 	"github.com/decred/dcrlnd/record"
+	"github.com/decred/dcrlnd/tlv"
 )
 
 type decodePayloadTest struct {
@@ -67,6 +69,38 @@ var decodePayloadTests = []decodePayloadTest{
 			FinalHop: true,
 		},
 	},
+	{
+		name: "intermediate hop with mpp",
+		payload: append([]byte{0x02, 0x00, 0x04, 0x00, 0x06, 0x08, 0x01,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x08, 0x21,
+		}, append(make([]byte, 32), 0x01)...),
+		expErr: hop.ErrInvalidPayload{
+			Type:     record.MPPOnionType,
+			Omitted:  false,
+			FinalHop: false,
+		},
+	},
+	{
+		name: "final hop with mpp",
+		payload: append([]byte{0x02, 0x00, 0x04, 0x00, 0x08, 0x21},
+			append(make([]byte, 32), 0x01)...),
+	},
+	{
+		name: "intermediate hop with amp",
+		payload: append([]byte{0x02, 0x00, 0x04, 0x00, 0x06, 0x08, 0x01,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0e, 0x64,
+		}, make([]byte, 100)...),
+		expErr: hop.ErrInvalidPayload{
+			Type:     record.AMPOnionType,
+			Omitted:  false,
+			FinalHop: false,
+		},
+	},
+	{
+		name: "final hop with amp",
+		payload: append([]byte{0x02, 0x00, 0x04, 0x00, 0x0e, 0x64},
+			make([]byte, 100)...),
+	},
 }
 
 // TestDecodeHopPayloadRecordValidation asserts that parsing the payloads in the
@@ -87,3 +121,104 @@ func testDecodeHopPayloadValidation(t *testing.T, test decodePayloadTest) {
 			test.expErr, err)
 	}
 }
+
+// TestDecodeHopPayloadAMP asserts that the AMP record of a final hop payload
+// is decoded, and that it is omitted from payloads that don't include it.
+func TestDecodeHopPayloadAMP(t *testing.T) {
+	amt := uint64(1000)
+	cltv := uint32(100)
+	amp := record.NewAMP(
+		lntypes.Hash{1}, [32]byte{2}, [32]byte{3}, 4,
+	)
+
+	var b bytes.Buffer
+	stream := tlv.MustNewStream(
+		record.NewAmtToFwdRecord(&amt),
+		record.NewLockTimeRecord(&cltv),
+		amp.Record(),
+	)
+	if err := stream.Encode(&b); err != nil {
+		t.Fatalf("unable to encode payload: %v", err)
+	}
+
+	payload, err := hop.NewPayloadFromReader(&b)
+	if err != nil {
+		t.Fatalf("unable to decode payload: %v", err)
+	}
+	if !reflect.DeepEqual(payload.AMPRecord(), amp) {
+		t.Fatalf("amp record mismatch, want: %v, got: %v", amp,
+			payload.AMPRecord())
+	}
+
+	b.Reset()
+	stream = tlv.MustNewStream(
+		record.NewAmtToFwdRecord(&amt),
+		record.NewLockTimeRecord(&cltv),
+	)
+	if err := stream.Encode(&b); err != nil {
+		t.Fatalf("unable to encode payload: %v", err)
+	}
+
+	payload, err = hop.NewPayloadFromReader(&b)
+	if err != nil {
+		t.Fatalf("unable to decode payload: %v", err)
+	}
+	if payload.AMPRecord() != nil {
+		t.Fatalf("expected no amp record")
+	}
+}
+
+// TestDecodeHopPayloadMPP asserts that the payment address and total amount
+// of the MPP record are decoded from a final hop payload.
+func TestDecodeHopPayloadMPP(t *testing.T) {
+	amt := uint64(1000)
+	cltv := uint32(100)
+	mpp := record.NewMPP(5000, [32]byte{1, 2, 3})
+
+	var b bytes.Buffer
+	stream := tlv.MustNewStream(
+		record.NewAmtToFwdRecord(&amt),
+		record.NewLockTimeRecord(&cltv),
+		mpp.Record(),
+	)
+	if err := stream.Encode(&b); err != nil {
+		t.Fatalf("unable to encode payload: %v", err)
+	}
+
+	payload, err := hop.NewPayloadFromReader(&b)
+	if err != nil {
+		t.Fatalf("unable to decode payload: %v", err)
+	}
+	if !reflect.DeepEqual(payload.MultiPath(), mpp) {
+		t.Fatalf("mpp record mismatch, want: %v, got: %v", mpp,
+			payload.MultiPath())
+	}
+}
+
+// TestDecodeHopPayloadKeySend asserts that the keysend preimage is decoded
+// from a final hop payload if present.
+func TestDecodeHopPayloadKeySend(t *testing.T) {
+	amt := uint64(1000)
+	cltv := uint32(100)
+	preimage := [32]byte{1}
+
+	var b bytes.Buffer
+	stream := tlv.MustNewStream(
+		record.NewAmtToFwdRecord(&amt),
+		record.NewLockTimeRecord(&cltv),
+		record.NewKeySendRecord(&preimage),
+	)
+	if err := stream.Encode(&b); err != nil {
+		t.Fatalf("unable to encode payload: %v", err)
+	}
+
+	payload, err := hop.NewPayloadFromReader(&b)
+	if err != nil {
+		t.Fatalf("unable to decode payload: %v", err)
+	}
+	keySend := payload.KeySendPreimage()
+	if keySend == nil || *keySend != lntypes.Preimage(preimage) {
+		t.Fatalf("keysend preimage mismatch, want: %x, got: %v",
+			preimage, keySend)
+	}
+}
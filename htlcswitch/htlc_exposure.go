@@ -0,0 +1,182 @@
+package htlcswitch
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/decred/dcrlnd/lnwallet"
+	"github.com/decred/dcrlnd/lnwire"
+)
+
+// HtlcExposureLimits are the limits on the HTLCs pending on each channel and
+// with each peer. A zero limit is disabled.
+type HtlcExposureLimits struct {
+	// MaxChanValue is the maximum total value of the HTLCs pending on a
+	// channel.
+	MaxChanValue lnwire.MilliAtom
+
+	// MaxChanHtlcs is the maximum number of HTLCs pending on a channel.
+	MaxChanHtlcs int
+
+	// MaxPeerValue is the maximum total value of the HTLCs pending on all
+	// the channels with a peer.
+	MaxPeerValue lnwire.MilliAtom
+
+	// MaxPeerHtlcs is the maximum number of HTLCs pending on all the
+	// channels with a peer.
+	MaxPeerHtlcs int
+
+	// MaxDustValue is the maximum total value of the HTLCs pending on a
+	// channel that are dust on our commitment.
+	MaxDustValue lnwire.MilliAtom
+}
+
+// HtlcExposurePolicy enforces HTLC exposure limits across the links, so that a
+// flood of HTLCs can't grow the commitments of a channel, or of all channels
+// with a peer, beyond what the node is willing to lose on a force close. Dust
+// HTLCs are limited separately, since they are cheap to send but their value
+// goes to fees rather than to an output of the commitment.
+type HtlcExposurePolicy struct {
+	limits HtlcExposureLimits
+
+	mu sync.Mutex
+
+	// links maps the public key of each peer to the functions returning
+	// the current exposure of each of the active channels with the peer.
+	links map[[33]byte]map[lnwire.ChannelID]func() lnwallet.HtlcExposure
+}
+
+// NewHtlcExposurePolicy creates a policy enforcing the given limits.
+func NewHtlcExposurePolicy(limits HtlcExposureLimits) *HtlcExposurePolicy {
+	return &HtlcExposurePolicy{
+		limits: limits,
+		links: make(
+			map[[33]byte]map[lnwire.ChannelID]func() lnwallet.HtlcExposure,
+		),
+	}
+}
+
+// registerLink makes the exposure of the given channel count towards the
+// exposure of its peer.
+func (p *HtlcExposurePolicy) registerLink(peer [33]byte,
+	chanID lnwire.ChannelID, exposure func() lnwallet.HtlcExposure) {
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	chans, ok := p.links[peer]
+	if !ok {
+		chans = make(map[lnwire.ChannelID]func() lnwallet.HtlcExposure)
+		p.links[peer] = chans
+	}
+	chans[chanID] = exposure
+}
+
+// unregisterLink stops counting the exposure of the given channel towards the
+// exposure of its peer.
+func (p *HtlcExposurePolicy) unregisterLink(peer [33]byte,
+	chanID lnwire.ChannelID) {
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	chans, ok := p.links[peer]
+	if !ok {
+		return
+	}
+
+	delete(chans, chanID)
+	if len(chans) == 0 {
+		delete(p.links, peer)
+	}
+}
+
+// Check returns an error if the given exposure of a channel with the given
+// peer exceeds the limits of the channel, or brings the exposure of the peer
+// over its limits once added to the exposure of its other channels.
+func (p *HtlcExposurePolicy) Check(peer [33]byte, chanID lnwire.ChannelID,
+	exposure lnwallet.HtlcExposure) error {
+
+	limits := p.limits
+
+	switch {
+	case limits.MaxChanValue != 0 && exposure.Value > limits.MaxChanValue:
+		return fmt.Errorf("channel htlc value %v would exceed "+
+			"limit %v", exposure.Value, limits.MaxChanValue)
+
+	case limits.MaxChanHtlcs != 0 && exposure.NumHtlcs > limits.MaxChanHtlcs:
+		return fmt.Errorf("channel htlc count %v would exceed "+
+			"limit %v", exposure.NumHtlcs,
+			limits.MaxChanHtlcs)
+
+	case limits.MaxDustValue != 0 &&
+		exposure.DustValue > limits.MaxDustValue:
+
+		return fmt.Errorf("channel dust htlc value %v would "+
+			"exceed limit %v", exposure.DustValue,
+			limits.MaxDustValue)
+	}
+
+	if limits.MaxPeerValue == 0 && limits.MaxPeerHtlcs == 0 {
+		return nil
+	}
+
+	// Copy the exposure functions of the other channels with the peer, so
+	// that they aren't called with the lock held.
+	p.mu.Lock()
+	var others []func() lnwallet.HtlcExposure
+	for otherID, otherExposure := range p.links[peer] {
+		if otherID != chanID {
+			others = append(others, otherExposure)
+		}
+	}
+	p.mu.Unlock()
+
+	peerValue := exposure.Value
+	peerHtlcs := exposure.NumHtlcs
+	for _, otherExposure := range others {
+		e := otherExposure()
+		peerValue += e.Value
+		peerHtlcs += e.NumHtlcs
+	}
+
+	switch {
+	case limits.MaxPeerValue != 0 && peerValue > limits.MaxPeerValue:
+		return fmt.Errorf("peer htlc value %v would exceed limit "+
+			"%v", peerValue, limits.MaxPeerValue)
+
+	case limits.MaxPeerHtlcs != 0 && peerHtlcs > limits.MaxPeerHtlcs:
+		return fmt.Errorf("peer htlc count %v would exceed limit "+
+			"%v", peerHtlcs, limits.MaxPeerHtlcs)
+	}
+
+	return nil
+}
+
+// addHtlcExposure returns the given exposure with an additional HTLC of the
+// given amount.
+func addHtlcExposure(exposure lnwallet.HtlcExposure, amt lnwire.MilliAtom,
+	dust bool) lnwallet.HtlcExposure {
+
+	exposure.Value += amt
+	exposure.NumHtlcs++
+	if dust {
+		exposure.DustValue += amt
+	}
+
+	return exposure
+}
+
+// removeHtlcExposure returns the given exposure without an HTLC of the given
+// amount that was part of it.
+func removeHtlcExposure(exposure lnwallet.HtlcExposure, amt lnwire.MilliAtom,
+	dust bool) lnwallet.HtlcExposure {
+
+	exposure.Value -= amt
+	exposure.NumHtlcs--
+	if dust {
+		exposure.DustValue -= amt
+	}
+
+	return exposure
+}
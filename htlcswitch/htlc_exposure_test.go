@@ -0,0 +1,131 @@
+package htlcswitch
+
+import (
+	"testing"
+
+	"github.com/decred/dcrlnd/lnwallet"
+	"github.com/decred/dcrlnd/lnwire"
+)
+
+// TestHtlcExposurePolicy asserts that the exposure policy rejects the channel
+// exposures exceeding the channel limits, or bringing the exposure of the
+// peer over its limits once added to the exposure of its other channels.
+func TestHtlcExposurePolicy(t *testing.T) {
+	t.Parallel()
+
+	var (
+		peer      = [33]byte{1}
+		otherPeer = [33]byte{2}
+		chanID    = lnwire.ChannelID{1}
+		otherChan = lnwire.ChannelID{2}
+	)
+
+	limits := HtlcExposureLimits{
+		MaxChanValue: 1000,
+		MaxChanHtlcs: 3,
+		MaxPeerValue: 1500,
+		MaxPeerHtlcs: 4,
+		MaxDustValue: 100,
+	}
+	policy := NewHtlcExposurePolicy(limits)
+
+	// Another channel with the same peer counts towards the limits of the
+	// peer, while a channel with another peer doesn't.
+	policy.registerLink(peer, otherChan, func() lnwallet.HtlcExposure {
+		return lnwallet.HtlcExposure{Value: 600, NumHtlcs: 2}
+	})
+	policy.registerLink(otherPeer, chanID, func() lnwallet.HtlcExposure {
+		return lnwallet.HtlcExposure{Value: 1000, NumHtlcs: 3}
+	})
+
+	tests := []struct {
+		name     string
+		exposure lnwallet.HtlcExposure
+		valid    bool
+	}{
+		{
+			name:  "no htlcs",
+			valid: true,
+		},
+		{
+			name: "within limits",
+			exposure: lnwallet.HtlcExposure{
+				Value: 900, NumHtlcs: 2, DustValue: 100,
+			},
+			valid: true,
+		},
+		{
+			name: "channel value",
+			exposure: lnwallet.HtlcExposure{
+				Value: 1001, NumHtlcs: 1,
+			},
+		},
+		{
+			name: "channel htlcs",
+			exposure: lnwallet.HtlcExposure{
+				Value: 400, NumHtlcs: 4,
+			},
+		},
+		{
+			name: "dust value",
+			exposure: lnwallet.HtlcExposure{
+				Value: 101, NumHtlcs: 2, DustValue: 101,
+			},
+		},
+		{
+			name: "peer value",
+			exposure: lnwallet.HtlcExposure{
+				Value: 901, NumHtlcs: 1,
+			},
+		},
+		{
+			name: "peer htlcs",
+			exposure: lnwallet.HtlcExposure{
+				Value: 3, NumHtlcs: 3,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		err := policy.Check(peer, chanID, test.exposure)
+		switch {
+		case test.valid && err != nil:
+			t.Fatalf("%s: valid exposure was rejected: %v",
+				test.name, err)
+		case !test.valid && err == nil:
+			t.Fatalf("%s: invalid exposure was accepted", test.name)
+		}
+	}
+
+	// Once the other channel with the peer is gone, only the channel limits
+	// apply.
+	policy.unregisterLink(peer, otherChan)
+	err := policy.Check(peer, chanID, lnwallet.HtlcExposure{
+		Value: 1000, NumHtlcs: 3,
+	})
+	if err != nil {
+		t.Fatalf("exposure within channel limits was rejected: %v", err)
+	}
+}
+
+// TestHtlcExposureArithmetic asserts that adding then removing an HTLC leaves
+// the exposure of a channel unchanged.
+func TestHtlcExposureArithmetic(t *testing.T) {
+	t.Parallel()
+
+	exposure := lnwallet.HtlcExposure{Value: 500, NumHtlcs: 2, DustValue: 10}
+
+	added := addHtlcExposure(exposure, 5, true)
+	expected := lnwallet.HtlcExposure{Value: 505, NumHtlcs: 3, DustValue: 15}
+	if added != expected {
+		t.Fatalf("expected exposure %v, got %v", expected, added)
+	}
+	if removed := removeHtlcExposure(added, 5, true); removed != exposure {
+		t.Fatalf("expected exposure %v, got %v", exposure, removed)
+	}
+
+	added = addHtlcExposure(exposure, 100, false)
+	if added.DustValue != exposure.DustValue {
+		t.Fatalf("non dust htlc counted as dust")
+	}
+}
@@ -0,0 +1,319 @@
+package htlcswitch
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/decred/dcrlnd/htlcswitch/hop"
+	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/subscribe"
+)
+
+// HtlcNotifier notifies its subscribers of the events happening to the HTLCs
+// handled by the switch and the links: HTLCs being forwarded, settled and
+// failed, along with the HTLCs the links refuse to handle.
+//
+// The events are identified by the incoming and outgoing circuits of their
+// HTLC. The incoming circuit of HTLCs sent by the node is blank, as is the
+// outgoing circuit of HTLCs received by the node.
+type HtlcNotifier struct {
+	started sync.Once
+	stopped sync.Once
+
+	// now returns the current time, used to timestamp the events.
+	now func() time.Time
+
+	// ntfnServer is the subscription server used to dispatch the events
+	// to the subscribers.
+	ntfnServer *subscribe.Server
+}
+
+// NewHtlcNotifier creates a notifier timestamping its events using the given
+// clock.
+func NewHtlcNotifier(now func() time.Time) *HtlcNotifier {
+	return &HtlcNotifier{
+		now:        now,
+		ntfnServer: subscribe.NewServer(),
+	}
+}
+
+// Start starts the notifier.
+func (h *HtlcNotifier) Start() error {
+	var err error
+	h.started.Do(func() {
+		log.Trace("HtlcNotifier starting")
+		err = h.ntfnServer.Start()
+	})
+	return err
+}
+
+// Stop signals the notifier for a graceful shutdown.
+func (h *HtlcNotifier) Stop() {
+	h.stopped.Do(func() {
+		if err := h.ntfnServer.Stop(); err != nil {
+			log.Warnf("error stopping htlc notifier: %v", err)
+		}
+	})
+}
+
+// SubscribeHtlcEvents returns a client receiving the HTLC events as they
+// happen, in the form of ForwardingEvent, ForwardingFailEvent, SettleEvent
+// and LinkFailEvent.
+func (h *HtlcNotifier) SubscribeHtlcEvents() (*subscribe.Client, error) {
+	return h.ntfnServer.Subscribe()
+}
+
+// HtlcKey identifies an HTLC by its incoming and outgoing circuits.
+type HtlcKey struct {
+	// IncomingCircuit is the channel and index of the HTLC we received,
+	// blank for HTLCs sent by the node.
+	IncomingCircuit CircuitKey
+
+	// OutgoingCircuit is the channel and index of the HTLC we offered,
+	// blank for HTLCs received by the node. Its index is unknown, thus
+	// zero, for HTLCs failed before being offered.
+	OutgoingCircuit CircuitKey
+}
+
+// String returns a human readable form of the key.
+func (k HtlcKey) String() string {
+	return fmt.Sprintf("%v -> %v", k.IncomingCircuit, k.OutgoingCircuit)
+}
+
+// HtlcInfo holds the amounts and timelocks of the incoming and outgoing sides
+// of an HTLC. The side of the HTLC that doesn't exist is left blank.
+type HtlcInfo struct {
+	// IncomingTimeLock is the timelock of the HTLC we received.
+	IncomingTimeLock uint32
+
+	// OutgoingTimeLock is the timelock of the HTLC we offered.
+	OutgoingTimeLock uint32
+
+	// IncomingAmt is the amount of the HTLC we received.
+	IncomingAmt lnwire.MilliAtom
+
+	// OutgoingAmt is the amount of the HTLC we offered.
+	OutgoingAmt lnwire.MilliAtom
+}
+
+// String returns a human readable form of the info.
+func (h HtlcInfo) String() string {
+	return fmt.Sprintf("incoming amt=%v, timelock=%v, outgoing amt=%v, "+
+		"timelock=%v", h.IncomingAmt, h.IncomingTimeLock,
+		h.OutgoingAmt, h.OutgoingTimeLock)
+}
+
+// HtlcEventType is the role of the node in the HTLC an event happened to.
+type HtlcEventType uint8
+
+const (
+	// HtlcEventTypeSend is the type of the events of HTLCs sent by the
+	// node.
+	HtlcEventTypeSend HtlcEventType = iota
+
+	// HtlcEventTypeReceive is the type of the events of HTLCs received by
+	// the node as the final hop.
+	HtlcEventTypeReceive
+
+	// HtlcEventTypeForward is the type of the events of HTLCs forwarded by
+	// the node.
+	HtlcEventTypeForward
+)
+
+// String returns a human readable form of the event type.
+func (h HtlcEventType) String() string {
+	switch h {
+	case HtlcEventTypeSend:
+		return "send"
+
+	case HtlcEventTypeReceive:
+		return "receive"
+
+	case HtlcEventTypeForward:
+		return "forward"
+
+	default:
+		return "unknown"
+	}
+}
+
+// ForwardingEvent is notified once an HTLC, either sent or forwarded by the
+// node, was offered to the outgoing channel.
+type ForwardingEvent struct {
+	HtlcKey
+
+	HtlcInfo
+
+	HtlcEventType
+
+	// Timestamp is the time the HTLC was offered.
+	Timestamp time.Time
+}
+
+// LinkFailEvent is notified once a link refused to handle an HTLC, either
+// while processing the HTLC we received or before offering it to the outgoing
+// channel.
+type LinkFailEvent struct {
+	HtlcKey
+
+	HtlcInfo
+
+	HtlcEventType
+
+	// Failure is the failure sent back for the HTLC.
+	Failure lnwire.FailureMessage
+
+	// FailureDetail describes why the HTLC was failed in more details
+	// than the failure, which is kept short as it's onion encrypted.
+	FailureDetail string
+
+	// Incoming is true if the HTLC was failed while processing the HTLC
+	// we received, and false if it was failed before being offered to the
+	// outgoing channel.
+	Incoming bool
+
+	// Timestamp is the time the HTLC was failed.
+	Timestamp time.Time
+}
+
+// ForwardingFailEvent is notified once an HTLC, that was offered to the
+// outgoing channel, was failed by the downstream nodes.
+type ForwardingFailEvent struct {
+	HtlcKey
+
+	HtlcEventType
+
+	// Timestamp is the time the failure was received.
+	Timestamp time.Time
+}
+
+// SettleEvent is notified once an HTLC was settled, either by the downstream
+// nodes for HTLCs sent or forwarded by the node, or by the node itself for
+// the HTLCs it received.
+type SettleEvent struct {
+	HtlcKey
+
+	HtlcEventType
+
+	// Timestamp is the time the HTLC was settled.
+	Timestamp time.Time
+}
+
+// NotifyForwardingEvent notifies the subscribers that the given HTLC was
+// offered to the outgoing channel.
+//
+// NOTE: Part of the htlcNotifier interface.
+func (h *HtlcNotifier) NotifyForwardingEvent(key HtlcKey, info HtlcInfo,
+	eventType HtlcEventType) {
+
+	event := &ForwardingEvent{
+		HtlcKey:       key,
+		HtlcInfo:      info,
+		HtlcEventType: eventType,
+		Timestamp:     h.now(),
+	}
+
+	log.Tracef("Notifying forward event: %v over %v, %v", eventType, key,
+		info)
+
+	h.sendUpdate(event)
+}
+
+// NotifyLinkFailEvent notifies the subscribers that a link refused to handle
+// the given HTLC, either incoming or outgoing.
+//
+// NOTE: Part of the htlcNotifier interface.
+func (h *HtlcNotifier) NotifyLinkFailEvent(key HtlcKey, info HtlcInfo,
+	eventType HtlcEventType, failure lnwire.FailureMessage,
+	failureDetail string, incoming bool) {
+
+	event := &LinkFailEvent{
+		HtlcKey:       key,
+		HtlcInfo:      info,
+		HtlcEventType: eventType,
+		Failure:       failure,
+		FailureDetail: failureDetail,
+		Incoming:      incoming,
+		Timestamp:     h.now(),
+	}
+
+	log.Tracef("Notifying link failure event: %v over %v, %v: %v",
+		eventType, key, info, failureDetail)
+
+	h.sendUpdate(event)
+}
+
+// NotifyForwardingFailEvent notifies the subscribers that the given HTLC was
+// failed by the downstream nodes.
+//
+// NOTE: Part of the htlcNotifier interface.
+func (h *HtlcNotifier) NotifyForwardingFailEvent(key HtlcKey,
+	eventType HtlcEventType) {
+
+	event := &ForwardingFailEvent{
+		HtlcKey:       key,
+		HtlcEventType: eventType,
+		Timestamp:     h.now(),
+	}
+
+	log.Tracef("Notifying forward failure event: %v over %v", eventType,
+		key)
+
+	h.sendUpdate(event)
+}
+
+// NotifySettleEvent notifies the subscribers that the given HTLC was settled.
+//
+// NOTE: Part of the htlcNotifier interface.
+func (h *HtlcNotifier) NotifySettleEvent(key HtlcKey,
+	eventType HtlcEventType) {
+
+	event := &SettleEvent{
+		HtlcKey:       key,
+		HtlcEventType: eventType,
+		Timestamp:     h.now(),
+	}
+
+	log.Tracef("Notifying settle event: %v over %v", eventType, key)
+
+	h.sendUpdate(event)
+}
+
+// sendUpdate dispatches the event to the subscribers, logging any error as
+// the HTLCs are handled regardless.
+func (h *HtlcNotifier) sendUpdate(event interface{}) {
+	if err := h.ntfnServer.SendUpdate(event); err != nil {
+		log.Warnf("Unable to send htlc event: %v", err)
+	}
+}
+
+// newHtlcKey returns the key of the HTLC the packet refers to.
+func newHtlcKey(pkt *htlcPacket) HtlcKey {
+	return HtlcKey{
+		IncomingCircuit: pkt.inKey(),
+		OutgoingCircuit: pkt.outKey(),
+	}
+}
+
+// newHtlcInfo returns the amounts and timelocks of the HTLC the add packet
+// refers to.
+func newHtlcInfo(pkt *htlcPacket) HtlcInfo {
+	return HtlcInfo{
+		IncomingTimeLock: pkt.incomingTimeout,
+		OutgoingTimeLock: pkt.outgoingTimeout,
+		IncomingAmt:      pkt.incomingAmount,
+		OutgoingAmt:      pkt.amount,
+	}
+}
+
+// getEventType returns the type of the events of the HTLC the packet refers
+// to, which is either sent or forwarded by the node as the packets of the HTLCs
+// received by the node never reach the switch.
+func getEventType(pkt *htlcPacket) HtlcEventType {
+	if pkt.incomingChanID == hop.Source {
+		return HtlcEventTypeSend
+	}
+
+	return HtlcEventTypeForward
+}
@@ -0,0 +1,95 @@
+package htlcswitch
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrlnd/lnwire"
+)
+
+// TestHtlcNotifier asserts that the subscribers of the htlc notifier receive
+// the notified events, timestamped by the notifier's clock.
+func TestHtlcNotifier(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1600000000, 0)
+	notifier := NewHtlcNotifier(func() time.Time {
+		return now
+	})
+	if err := notifier.Start(); err != nil {
+		t.Fatalf("unable to start notifier: %v", err)
+	}
+	defer notifier.Stop()
+
+	client, err := notifier.SubscribeHtlcEvents()
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	defer client.Cancel()
+
+	key := HtlcKey{
+		IncomingCircuit: CircuitKey{
+			ChanID: lnwire.NewShortChanIDFromInt(1),
+			HtlcID: 2,
+		},
+		OutgoingCircuit: CircuitKey{
+			ChanID: lnwire.NewShortChanIDFromInt(3),
+			HtlcID: 4,
+		},
+	}
+	info := HtlcInfo{
+		IncomingTimeLock: 110,
+		OutgoingTimeLock: 100,
+		IncomingAmt:      1010,
+		OutgoingAmt:      1000,
+	}
+	failure := &lnwire.FailTemporaryNodeFailure{}
+
+	notifier.NotifyForwardingEvent(key, info, HtlcEventTypeForward)
+	notifier.NotifyLinkFailEvent(
+		key, info, HtlcEventTypeSend, failure, "no bandwidth", false,
+	)
+	notifier.NotifyForwardingFailEvent(key, HtlcEventTypeForward)
+	notifier.NotifySettleEvent(key, HtlcEventTypeReceive)
+
+	expectedEvents := []interface{}{
+		&ForwardingEvent{
+			HtlcKey:       key,
+			HtlcInfo:      info,
+			HtlcEventType: HtlcEventTypeForward,
+			Timestamp:     now,
+		},
+		&LinkFailEvent{
+			HtlcKey:       key,
+			HtlcInfo:      info,
+			HtlcEventType: HtlcEventTypeSend,
+			Failure:       failure,
+			FailureDetail: "no bandwidth",
+			Timestamp:     now,
+		},
+		&ForwardingFailEvent{
+			HtlcKey:       key,
+			HtlcEventType: HtlcEventTypeForward,
+			Timestamp:     now,
+		},
+		&SettleEvent{
+			HtlcKey:       key,
+			HtlcEventType: HtlcEventTypeReceive,
+			Timestamp:     now,
+		},
+	}
+
+	for i, expected := range expectedEvents {
+		select {
+		case event := <-client.Updates():
+			if !reflect.DeepEqual(event, expected) {
+				t.Fatalf("event %d: expected %v, got %v", i,
+					expected, event)
+			}
+
+		case <-time.After(5 * time.Second):
+			t.Fatalf("event %d not received", i)
+		}
+	}
+}
@@ -21,13 +21,13 @@ type InvoiceDatabase interface {
 	// invoice is a debug invoice, then this method is a noop as debug
 	// invoices are never fully settled. The return value describes how the
 	// htlc should be resolved. If the htlc cannot be resolved immediately,
-	// the resolution is sent on the passed in hodlChan later. The eob
-	// field passes the entire onion hop payload into the invoice registry
-	// for decoding purposes.
+	// the resolution is sent on the passed in hodlChan later. The payload
+	// carries the fields of the final hop's onion payload that the invoice
+	// registry validates against the htlc.
 	NotifyExitHopHtlc(payHash lntypes.Hash, paidAmount lnwire.MilliAtom,
 		expiry uint32, currentHeight int32,
 		circuitKey channeldb.CircuitKey, hodlChan chan<- interface{},
-		eob []byte) (*invoices.HodlEvent, error)
+		payload *invoices.ExitHopPayload) (*invoices.HodlEvent, error)
 
 	// CancelInvoice attempts to cancel the invoice corresponding to the
 	// passed payment hash.
@@ -106,7 +106,7 @@ type ChannelLink interface {
 	// order to signal to the source of the HTLC, the policy consistency
 	// issue.
 	HtlcSatifiesPolicy(payHash [32]byte, incomingAmt lnwire.MilliAtom,
-		amtToForward lnwire.MilliAtom,
+		amtToForward lnwire.MilliAtom, inboundFee lnwire.InboundFee,
 		incomingTimeout, outgoingTimeout uint32,
 		heightNow uint32) lnwire.FailureMessage
 
@@ -140,6 +140,14 @@ type ChannelLink interface {
 	// will use this function in forwarding decisions accordingly.
 	EligibleToForward() bool
 
+	// Drain puts the link into drain mode. Once draining, the link will
+	// no longer accept new HTLCs from either the switch or the remote
+	// peer, while existing HTLCs continue to be settled or failed as
+	// usual. The returned channel is closed once the link no longer has
+	// any active HTLCs and both commitment chains are fully synced, at
+	// which point the link can be safely detached from the switch.
+	Drain() <-chan struct{}
+
 	// AttachMailBox delivers an active MailBox to the link. The MailBox may
 	// have buffered messages.
 	AttachMailBox(MailBox)
@@ -180,3 +188,26 @@ type TowerClient interface {
 	// isTweakless should be true.
 	BackupState(*lnwire.ChannelID, *lnwallet.BreachRetribution, bool) error
 }
+
+// htlcNotifier is an interface which represents the subsystem notifying the
+// events happening to the HTLCs handled by the switch and the links.
+type htlcNotifier interface {
+	// NotifyForwardingEvent notifies that the given HTLC, either sent or
+	// forwarded by the node, was offered to the outgoing channel.
+	NotifyForwardingEvent(key HtlcKey, info HtlcInfo,
+		eventType HtlcEventType)
+
+	// NotifyLinkFailEvent notifies that a link refused to handle the
+	// given HTLC, either while processing the HTLC we received if
+	// incoming is true, or before offering it to the outgoing channel.
+	NotifyLinkFailEvent(key HtlcKey, info HtlcInfo,
+		eventType HtlcEventType, failure lnwire.FailureMessage,
+		failureDetail string, incoming bool)
+
+	// NotifyForwardingFailEvent notifies that the given HTLC was failed
+	// by the downstream nodes.
+	NotifyForwardingFailEvent(key HtlcKey, eventType HtlcEventType)
+
+	// NotifySettleEvent notifies that the given HTLC was settled.
+	NotifySettleEvent(key HtlcKey, eventType HtlcEventType)
+}
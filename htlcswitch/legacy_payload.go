@@ -0,0 +1,98 @@
+package htlcswitch
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// LegacyPayloadStats counts the HTLCs carrying a legacy, non-TLV, onion
+// payload handled by the links since the node started.
+type LegacyPayloadStats struct {
+	// Forwarded is the number of HTLCs with a legacy payload accepted
+	// for forwarding.
+	Forwarded uint64
+
+	// Received is the number of HTLCs with a legacy payload accepted as
+	// the final hop.
+	Received uint64
+
+	// Rejected is the number of HTLCs with a legacy payload that were
+	// failed back because legacy payloads are no longer accepted.
+	Rejected uint64
+}
+
+// LegacyPayloadPolicy decides whether the HTLCs carrying a legacy, non-TLV,
+// onion payload are still forwarded and received by the node, allowing their
+// deprecation to be scheduled at a given block height or time. It also counts
+// these HTLCs, showing how much legacy traffic remains.
+type LegacyPayloadPolicy struct {
+	// The following counters MUST be used atomically.
+	forwarded uint64
+	received  uint64
+	rejected  uint64
+
+	// rejectHeight is the block height starting at which legacy payloads
+	// are rejected. Zero if they aren't rejected based on the height.
+	rejectHeight uint32
+
+	// rejectTime is the time starting at which legacy payloads are
+	// rejected. The zero time if they aren't rejected based on the time.
+	rejectTime time.Time
+
+	// now returns the current time.
+	now func() time.Time
+}
+
+// NewLegacyPayloadPolicy creates a policy rejecting the HTLCs carrying a
+// legacy onion payload starting at the given block height or time, whichever
+// comes first. A zero height or time disables the corresponding deadline.
+func NewLegacyPayloadPolicy(rejectHeight uint32,
+	rejectTime time.Time) *LegacyPayloadPolicy {
+
+	return &LegacyPayloadPolicy{
+		rejectHeight: rejectHeight,
+		rejectTime:   rejectTime,
+		now:          time.Now,
+	}
+}
+
+// RejectHeight returns the block height starting at which legacy payloads are
+// rejected, or zero if not set.
+func (p *LegacyPayloadPolicy) RejectHeight() uint32 {
+	return p.rejectHeight
+}
+
+// RejectTime returns the time starting at which legacy payloads are rejected,
+// or the zero time if not set.
+func (p *LegacyPayloadPolicy) RejectTime() time.Time {
+	return p.rejectTime
+}
+
+// Accept counts an HTLC carrying a legacy onion payload, to be either received
+// by the node if exitHop is true or forwarded otherwise, and returns whether
+// it must be accepted at the given block height.
+func (p *LegacyPayloadPolicy) Accept(exitHop bool, height uint32) bool {
+	rejected := (p.rejectHeight != 0 && height >= p.rejectHeight) ||
+		(!p.rejectTime.IsZero() && !p.now().Before(p.rejectTime))
+
+	switch {
+	case rejected:
+		atomic.AddUint64(&p.rejected, 1)
+	case exitHop:
+		atomic.AddUint64(&p.received, 1)
+	default:
+		atomic.AddUint64(&p.forwarded, 1)
+	}
+
+	return !rejected
+}
+
+// Stats returns the number of HTLCs with a legacy onion payload handled since
+// the node started.
+func (p *LegacyPayloadPolicy) Stats() LegacyPayloadStats {
+	return LegacyPayloadStats{
+		Forwarded: atomic.LoadUint64(&p.forwarded),
+		Received:  atomic.LoadUint64(&p.received),
+		Rejected:  atomic.LoadUint64(&p.rejected),
+	}
+}
@@ -0,0 +1,90 @@
+package htlcswitch
+
+import (
+	"testing"
+	"time"
+)
+
+// TestLegacyPayloadPolicy asserts that HTLCs carrying a legacy onion payload
+// are rejected starting at the configured height or time, and that they are
+// counted depending on their outcome.
+func TestLegacyPayloadPolicy(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1600000000, 0)
+
+	tests := []struct {
+		name         string
+		rejectHeight uint32
+		rejectTime   time.Time
+		exitHop      bool
+		height       uint32
+		accepted     bool
+		stats        LegacyPayloadStats
+	}{
+		{
+			name:     "no deadline forward",
+			height:   1000,
+			accepted: true,
+			stats:    LegacyPayloadStats{Forwarded: 1},
+		},
+		{
+			name:     "no deadline receive",
+			exitHop:  true,
+			height:   1000,
+			accepted: true,
+			stats:    LegacyPayloadStats{Received: 1},
+		},
+		{
+			name:         "before height",
+			rejectHeight: 1001,
+			height:       1000,
+			accepted:     true,
+			stats:        LegacyPayloadStats{Forwarded: 1},
+		},
+		{
+			name:         "at height",
+			rejectHeight: 1000,
+			height:       1000,
+			stats:        LegacyPayloadStats{Rejected: 1},
+		},
+		{
+			name:       "before time",
+			rejectTime: now.Add(time.Second),
+			exitHop:    true,
+			height:     1000,
+			accepted:   true,
+			stats:      LegacyPayloadStats{Received: 1},
+		},
+		{
+			name:       "after time",
+			rejectTime: now.Add(-time.Second),
+			exitHop:    true,
+			height:     1000,
+			stats:      LegacyPayloadStats{Rejected: 1},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			policy := NewLegacyPayloadPolicy(
+				test.rejectHeight, test.rejectTime,
+			)
+			policy.now = func() time.Time {
+				return now
+			}
+
+			accepted := policy.Accept(test.exitHop, test.height)
+			if accepted != test.accepted {
+				t.Fatalf("expected accepted=%v, got %v",
+					test.accepted, accepted)
+			}
+
+			if stats := policy.Stats(); stats != test.stats {
+				t.Fatalf("expected stats %v, got %v",
+					test.stats, stats)
+			}
+		})
+	}
+}
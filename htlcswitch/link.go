@@ -98,6 +98,11 @@ type ForwardingPolicy struct {
 	//    per-hop payload of the incoming HTLC's onion packet.
 	TimeLockDelta uint32
 
+	// InboundFee is the fee charged, or the discount granted if negative,
+	// for the HTLCs received over the link, in addition to the fee of the
+	// link they're forwarded over.
+	InboundFee lnwire.InboundFee
+
 	// TODO(roasbeef): add fee module inside of switch
 }
 
@@ -272,6 +277,24 @@ type ChannelLinkConfig struct {
 	// NotifyInactiveChannel allows the switch to tell the ChannelNotifier
 	// when channels become inactive.
 	NotifyInactiveChannel func(wire.OutPoint)
+
+	// LegacyPayloadPolicy decides whether the HTLCs carrying a legacy,
+	// non-TLV, onion payload are still forwarded and received, and counts
+	// them. If nil, these HTLCs are always accepted.
+	LegacyPayloadPolicy *LegacyPayloadPolicy
+
+	// FailureDelayPolicy decides how long the link waits before failing
+	// back the HTLCs it rejects. If nil, they are failed back right away.
+	FailureDelayPolicy *FailureDelayPolicy
+
+	// HtlcExposurePolicy limits the value and number of the HTLCs pending
+	// on the channel and on all the channels with the peer. If nil, no
+	// limits are enforced.
+	HtlcExposurePolicy *HtlcExposurePolicy
+
+	// HtlcNotifier is used to notify the events happening to the HTLCs
+	// handled by the link.
+	HtlcNotifier htlcNotifier
 }
 
 // channelLink is the service which drives a channel's commitment update
@@ -284,6 +307,7 @@ type channelLink struct {
 	started       int32
 	reestablished int32
 	shutdown      int32
+	draining      int32
 
 	// failed should be set to true in case a link error happens, making
 	// sure we don't process any more updates.
@@ -379,6 +403,24 @@ type channelLink struct {
 	// resolving those htlcs when we receive a message on hodlQueue.
 	hodlMap map[channeldb.CircuitKey]hodlHtlc
 
+	// delayedFailures receives the failures of incoming HTLCs that were
+	// delayed by the failure delay policy, once they must be applied.
+	// Each failure returns whether it was added to the channel.
+	delayedFailures chan func() bool
+
+	// drainReq is used to wake up the htlcManager after the link has been
+	// put into drain mode, so it can check whether the link is already
+	// drained.
+	drainReq chan struct{}
+
+	// drained is closed by the htlcManager once the link is draining, has
+	// no active HTLCs left and both commitment chains are fully synced.
+	drained chan struct{}
+
+	// drainComplete is set once the drained channel has been closed. It
+	// MUST only be accessed from the htlcManager goroutine.
+	drainComplete bool
+
 	wg   sync.WaitGroup
 	quit chan struct{}
 }
@@ -399,12 +441,15 @@ func NewChannelLink(cfg ChannelLinkConfig,
 		channel:     channel,
 		shortChanID: channel.ShortChanID(),
 		// TODO(roasbeef): just do reserve here?
-		logCommitTimer: time.NewTimer(300 * time.Millisecond),
-		overflowQueue:  newPacketQueue(input.MaxHTLCNumber / 2),
-		htlcUpdates:    make(chan *contractcourt.ContractUpdate),
-		hodlMap:        make(map[channeldb.CircuitKey]hodlHtlc),
-		hodlQueue:      queue.NewConcurrentQueue(10),
-		quit:           make(chan struct{}),
+		logCommitTimer:  time.NewTimer(300 * time.Millisecond),
+		overflowQueue:   newPacketQueue(input.MaxHTLCNumber / 2),
+		htlcUpdates:     make(chan *contractcourt.ContractUpdate),
+		hodlMap:         make(map[channeldb.CircuitKey]hodlHtlc),
+		hodlQueue:       queue.NewConcurrentQueue(10),
+		delayedFailures: make(chan func() bool),
+		drainReq:        make(chan struct{}, 1),
+		drained:         make(chan struct{}),
+		quit:            make(chan struct{}),
 	}
 }
 
@@ -482,6 +527,12 @@ func (l *channelLink) Start() error {
 		}()
 	}
 
+	if l.cfg.HtlcExposurePolicy != nil {
+		l.cfg.HtlcExposurePolicy.registerLink(
+			l.cfg.Peer.PubKey(), l.ChanID(), l.channel.HtlcExposure,
+		)
+	}
+
 	l.updateFeeTimer = time.NewTimer(l.randomFeeUpdateTimeout())
 
 	l.wg.Add(1)
@@ -510,6 +561,12 @@ func (l *channelLink) Stop() {
 		l.cfg.ChainEvents.Cancel()
 	}
 
+	if l.cfg.HtlcExposurePolicy != nil {
+		l.cfg.HtlcExposurePolicy.unregisterLink(
+			l.cfg.Peer.PubKey(), l.ChanID(),
+		)
+	}
+
 	l.updateFeeTimer.Stop()
 	l.overflowQueue.Stop()
 	l.hodlQueue.Stop()
@@ -540,11 +597,80 @@ func (l *channelLink) WaitForShutdown() {
 // actively accept requests to forward HTLC's. We're able to forward HTLC's if
 // we know the remote party's next revocation point. Otherwise, we can't
 // initiate new channel state. We also require that the short channel ID not be
-// the all-zero source ID, meaning that the channel has had its ID finalized.
+// the all-zero source ID, meaning that the channel has had its ID finalized,
+// and that the link isn't being drained.
 func (l *channelLink) EligibleToForward() bool {
 	return l.channel.RemoteNextRevocation() != nil &&
 		l.ShortChanID() != hop.Source &&
-		l.isReestablished()
+		l.isReestablished() &&
+		!l.isDraining()
+}
+
+// Drain puts the link into drain mode, causing it to reject any new HTLCs
+// while the existing ones are resolved. The returned channel is closed once
+// the link has no active HTLCs and both commitment chains are fully synced.
+//
+// NOTE: Part of the ChannelLink interface.
+func (l *channelLink) Drain() <-chan struct{} {
+	if atomic.CompareAndSwapInt32(&l.draining, 0, 1) {
+		l.infof("entering drain mode")
+	}
+
+	// Wake up the htlcManager so it can check whether the link is already
+	// drained. If a request is already pending, there's no need to queue
+	// another one.
+	select {
+	case l.drainReq <- struct{}{}:
+	default:
+	}
+
+	return l.drained
+}
+
+// isDraining returns true if the link has been put into drain mode.
+func (l *channelLink) isDraining() bool {
+	return atomic.LoadInt32(&l.draining) == 1
+}
+
+// checkHtlcExposure returns the given exposure of the channel with an
+// additional HTLC of the given amount, and an error if that exposure would
+// exceed the limits of the link's exposure policy.
+func (l *channelLink) checkHtlcExposure(exposure lnwallet.HtlcExposure,
+	incoming bool, amt lnwire.MilliAtom) (lnwallet.HtlcExposure, error) {
+
+	if l.cfg.HtlcExposurePolicy == nil {
+		return exposure, nil
+	}
+
+	exposure = addHtlcExposure(
+		exposure, amt, l.channel.IsDustHtlc(incoming, amt),
+	)
+	err := l.cfg.HtlcExposurePolicy.Check(
+		l.cfg.Peer.PubKey(), l.ChanID(), exposure,
+	)
+
+	return exposure, err
+}
+
+// checkDrained closes the drained channel if the link is draining, and has no
+// pending HTLCs or unsynced updates left on either commitment.
+//
+// NOTE: This MUST only be called from the htlcManager goroutine.
+func (l *channelLink) checkDrained() {
+	if l.drainComplete || !l.isDraining() {
+		return
+	}
+
+	if len(l.channel.ActiveHtlcs()) != 0 || !l.channel.FullySynced() ||
+		l.overflowQueue.Length() != 0 {
+
+		return
+	}
+
+	l.infof("link fully drained")
+
+	l.drainComplete = true
+	close(l.drained)
 }
 
 // isReestablished returns true if the link has successfully completed the
@@ -572,6 +698,12 @@ func (l *channelLink) sampleNetworkFee() (lnwallet.AtomPerKByte, error) {
 		return 0, err
 	}
 
+	// Never propose a commitment fee that would leave the commitment
+	// transaction unable to propagate.
+	if relayFee := l.cfg.FeeEstimator.RelayFeePerKB(); feePerKB < relayFee {
+		feePerKB = relayFee
+	}
+
 	log.Debugf("ChannelLink(%v): sampled fee rate for 3 block conf: %s ",
 		l, feePerKB)
 
@@ -1013,6 +1145,11 @@ out:
 			break out
 		}
 
+		// If the link is being drained, signal our caller once all
+		// HTLCs have been resolved and the commitment chains have
+		// converged.
+		l.checkDrained()
+
 		// If the previous event resulted in a non-empty
 		// batch, reinstate the batch ticker so that it can be
 		// cleared.
@@ -1149,6 +1286,20 @@ out:
 
 			l.handleDownStreamPkt(pkt, false)
 
+		// The link has been put into drain mode. We'll flush any
+		// pending updates to the remote commitment, then loop back
+		// around to check whether the link has been fully drained.
+		case <-l.drainReq:
+			if l.channel.FullySynced() {
+				continue
+			}
+
+			if err := l.updateCommitTx(); err != nil {
+				l.fail(LinkFailureError{code: ErrInternalError},
+					"unable to update commitment: %v", err)
+				break out
+			}
+
 		// A message from the connected peer was just received. This
 		// indicates that we have a new incoming HTLC, either directly
 		// for us, or part of a multi-hop HTLC circuit.
@@ -1168,6 +1319,19 @@ out:
 				break out
 			}
 
+		// The delay before failing back an incoming HTLC has expired,
+		// so we'll now fail it and update the commitment tx.
+		case fail := <-l.delayedFailures:
+			if !fail() {
+				continue
+			}
+
+			if err := l.updateCommitTx(); err != nil {
+				l.fail(LinkFailureError{code: ErrInternalError},
+					"unable to update commitment: %v", err)
+				break out
+			}
+
 		case <-l.quit:
 			break out
 		}
@@ -1181,6 +1345,7 @@ func (l *channelLink) processHodlQueue(firstHodlEvent invoices.HodlEvent) error
 	// Try to read all waiting resolution messages, so that they can all be
 	// processed in a single commitment tx update.
 	hodlEvent := firstHodlEvent
+	var needUpdate bool
 loop:
 	for {
 		// Lookup all hodl htlcs that can be failed or settled with this event.
@@ -1191,9 +1356,11 @@ loop:
 			return fmt.Errorf("hodl htlc not found: %v", circuitKey)
 		}
 
-		if err := l.processHodlEvent(hodlEvent, hodlHtlc); err != nil {
+		updated, err := l.processHodlEvent(hodlEvent, hodlHtlc)
+		if err != nil {
 			return err
 		}
+		needUpdate = needUpdate || updated
 
 		// Clean up hodl map.
 		delete(l.hodlMap, circuitKey)
@@ -1206,7 +1373,10 @@ loop:
 		}
 	}
 
-	// Update the commitment tx.
+	// Update the commitment tx, unless all the failures were delayed.
+	if !needUpdate {
+		return nil
+	}
 	if err := l.updateCommitTx(); err != nil {
 		return fmt.Errorf("unable to update commitment: %v", err)
 	}
@@ -1214,10 +1384,10 @@ loop:
 	return nil
 }
 
-// processHodlEvent applies a received hodl event to the provided htlc. When
-// this function returns without an error, the commit tx should be updated.
+// processHodlEvent applies a received hodl event to the provided htlc. It
+// returns a boolean indicating whether the commit tx should be updated.
 func (l *channelLink) processHodlEvent(hodlEvent invoices.HodlEvent,
-	htlc hodlHtlc) error {
+	htlc hodlHtlc) (bool, error) {
 
 	l.batchCounter++
 
@@ -1227,25 +1397,29 @@ func (l *channelLink) processHodlEvent(hodlEvent invoices.HodlEvent,
 	if hodlEvent.Preimage != nil {
 		l.debugf("Received hodl settle event for %v", circuitKey)
 
-		return l.settleHTLC(
+		err := l.settleHTLC(
 			*hodlEvent.Preimage, htlc.pd.HtlcIndex,
 			htlc.pd.SourceRef,
 		)
+		return err == nil, err
 	}
 
-	l.debugf("Received hodl cancel event for %v", circuitKey)
+	l.debugf("Received hodl cancel event for %v: %v", circuitKey,
+		hodlEvent.Result)
 
-	// In case of a cancel, always return
-	// incorrect_or_unknown_payment_details in order to avoid leaking info.
-	failure := lnwire.NewFailIncorrectDetails(
-		htlc.pd.Amount, uint32(hodlEvent.AcceptHeight),
+	// In case of a cancel, return incorrect_or_unknown_payment_details in
+	// order to avoid leaking info, unless the htlc was rejected because
+	// its onion payload didn't match the htlc itself.
+	failure := hodlEvent.Result.FailureMessage(
+		htlc.pd.Amount, htlc.pd.Timeout,
+		uint32(hodlEvent.AcceptHeight),
 	)
 
-	l.sendHTLCError(
-		htlc.pd.HtlcIndex, failure, htlc.obfuscator,
-		htlc.pd.SourceRef,
+	updated := l.sendHTLCError(
+		htlc.pd, failure, hodlEvent.Result.String(), htlc.obfuscator,
+		true,
 	)
-	return nil
+	return updated, nil
 }
 
 // randomFeeUpdateTimeout returns a random timeout between the bounds defined
@@ -1279,9 +1453,23 @@ func (l *channelLink) handleDownStreamPkt(pkt *htlcPacket, isReProcess bool) {
 		// A new payment has been initiated via the downstream channel,
 		// so we add the new HTLC to our local log, then update the
 		// commitment chains.
+		//
+		// If the link is draining, or the HTLC would exceed our exposure
+		// limits, we won't add it to the channel, and instead fail the
+		// packet back to the switch.
+		var (
+			index uint64
+			err   error
+		)
 		htlc.ChanID = l.ChanID()
 		openCircuitRef := pkt.inKey()
-		index, err := l.channel.AddHTLC(htlc, &openCircuitRef)
+		if l.isDraining() {
+			err = ErrLinkDraining
+		} else if _, err = l.checkHtlcExposure(
+			l.channel.HtlcExposure(), false, htlc.Amount,
+		); err == nil {
+			index, err = l.channel.AddHTLC(htlc, &openCircuitRef)
+		}
 		if err != nil {
 			switch err {
 
@@ -1302,6 +1490,7 @@ func (l *channelLink) handleDownStreamPkt(pkt *htlcPacket, isReProcess bool) {
 			// cancel the pending payment.
 			default:
 				l.warnf("Unable to handle downstream add HTLC: %v", err)
+				failureDetail := err.Error()
 
 				var (
 					localFailure = false
@@ -1354,6 +1543,12 @@ func (l *channelLink) handleDownStreamPkt(pkt *htlcPacket, isReProcess bool) {
 					},
 				}
 
+				l.cfg.HtlcNotifier.NotifyLinkFailEvent(
+					newHtlcKey(pkt), newHtlcInfo(pkt),
+					getEventType(pkt), failure,
+					failureDetail, false,
+				)
+
 				go l.forwardBatch(failPkt)
 
 				// Remove this packet from the link's mailbox,
@@ -1387,6 +1582,10 @@ func (l *channelLink) handleDownStreamPkt(pkt *htlcPacket, isReProcess bool) {
 
 		l.cfg.Peer.SendMessage(false, htlc)
 
+		l.cfg.HtlcNotifier.NotifyForwardingEvent(
+			newHtlcKey(pkt), newHtlcInfo(pkt), getEventType(pkt),
+		)
+
 	case *lnwire.UpdateFulfillHTLC:
 		// If hodl.SettleOutgoing mode is active, we exit early to
 		// simulate arbitrary delays between the switch adding the
@@ -1409,6 +1608,19 @@ func (l *channelLink) handleDownStreamPkt(pkt *htlcPacket, isReProcess bool) {
 			pkt.destRef,
 			&inKey,
 		)
+
+		// If the HTLC already has a pending settle, this packet is a
+		// replay of a resolution we already applied and sent to the
+		// peer, e.g. after a restart of the switch. We'll only remove
+		// it from the mailbox, as sending the settle again would be a
+		// protocol violation.
+		if _, ok := err.(lnwallet.ErrHtlcIndexAlreadySettled); ok {
+			l.debugf("Ignoring duplicate settle for "+
+				"circuit-key=%v", inKey)
+
+			l.mailBox.AckPacket(inKey)
+			return
+		}
 		if err != nil {
 			l.errorf("unable to settle incoming HTLC for "+
 				"circuit-key=%v: %v", inKey, err)
@@ -1466,6 +1678,16 @@ func (l *channelLink) handleDownStreamPkt(pkt *htlcPacket, isReProcess bool) {
 			pkt.destRef,
 			&inKey,
 		)
+
+		// Similarly, a pending fail means this packet is a replay of a
+		// failure we already sent to the peer.
+		if _, ok := err.(lnwallet.ErrHtlcIndexAlreadyFailed); ok {
+			l.debugf("Ignoring duplicate fail for "+
+				"circuit-key=%v", inKey)
+
+			l.mailBox.AckPacket(inKey)
+			return
+		}
 		if err != nil {
 			l.errorf("unable to cancel incoming HTLC for "+
 				"circuit-key=%v: %v", inKey, err)
@@ -1847,7 +2069,7 @@ func (l *channelLink) handleUpstreamMsg(msg lnwire.Message) {
 			}
 
 			chanType := l.channel.State().ChanType
-			isTweakless := chanType == channeldb.SingleFunderTweakless
+			isTweakless := chanType.IsTweakless()
 
 			chanID := l.ChanID()
 			err = l.cfg.TowerClient.BackupState(
@@ -2180,7 +2402,7 @@ func (l *channelLink) UpdateForwardingPolicy(newPolicy ForwardingPolicy) {
 // NOTE: Part of the ChannelLink interface.
 func (l *channelLink) HtlcSatifiesPolicy(payHash [32]byte,
 	incomingHtlcAmt, amtToForward lnwire.MilliAtom,
-	incomingTimeout, outgoingTimeout uint32,
+	inboundFee lnwire.InboundFee, incomingTimeout, outgoingTimeout uint32,
 	heightNow uint32) lnwire.FailureMessage {
 
 	l.RLock()
@@ -2198,14 +2420,20 @@ func (l *channelLink) HtlcSatifiesPolicy(payHash [32]byte,
 	// Next, using the amount of the incoming HTLC, we'll calculate the
 	// expected fee this incoming HTLC must carry in order to satisfy the
 	// constraints of the outgoing link.
-	expectedFee := ExpectedFee(policy, amtToForward)
+	outFee := ExpectedFee(policy, amtToForward)
+
+	// The inbound fee of the incoming link is then computed over the sum
+	// of the outgoing amount and fee, and added to the expected fee.
+	// Inbound fees being discounts, the expected fee may be negative.
+	inFee := inboundFee.CalcFee(amtToForward + outFee)
+	expectedFee := int64(outFee) + inFee
 
 	// If the actual fee is less than our expected fee, then we'll reject
 	// this HTLC as it didn't provide a sufficient amount of fees, or the
 	// values have been tampered with, or the send used incorrect/dated
 	// information to construct the forwarding information for this hop. In
 	// any case, we'll cancel this HTLC.
-	actualFee := incomingHtlcAmt - amtToForward
+	actualFee := int64(incomingHtlcAmt) - int64(amtToForward)
 	if incomingHtlcAmt < amtToForward || actualFee < expectedFee {
 		l.errorf("outgoing htlc(%x) has insufficient fee: expected %v, "+
 			"got %v", payHash[:], int64(expectedFee), int64(actualFee))
@@ -2542,6 +2770,12 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 	l.tracef("processing %d remote adds for height %d",
 		len(lockedInHtlcs), fwdPkg.Height)
 
+	// The HTLCs forwarded to the switch carry the current inbound fee of
+	// the link, which the outgoing link adds to its own fee.
+	l.RLock()
+	inboundFee := l.cfg.FwrdingPolicy.InboundFee
+	l.RUnlock()
+
 	decodeReqs := make(
 		[]hop.DecodeHopIteratorRequest, 0, len(lockedInHtlcs),
 	)
@@ -2584,6 +2818,27 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 		switchPackets []*htlcPacket
 	)
 
+	// The locked in HTLCs are already part of the exposure of the channel.
+	// To enforce the exposure limits, we start from the exposure without
+	// them and add back each HTLC we accept, so that the HTLCs exceeding
+	// the limits are the ones rejected.
+	checkExposure := l.cfg.HtlcExposurePolicy != nil &&
+		fwdPkg.State == channeldb.FwdStateLockedIn
+	var exposure lnwallet.HtlcExposure
+	if checkExposure {
+		exposure = l.channel.HtlcExposure()
+		for _, pd := range lockedInHtlcs {
+			if pd.EntryType != lnwallet.Add {
+				continue
+			}
+
+			exposure = removeHtlcExposure(
+				exposure, pd.Amount,
+				l.channel.IsDustHtlc(true, pd.Amount),
+			)
+		}
+	}
+
 	for i, pd := range lockedInHtlcs {
 		idx := uint16(i)
 
@@ -2617,9 +2872,11 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 			// If we're unable to process the onion blob than we
 			// should send the malformed htlc error to payment
 			// sender.
-			l.sendMalformedHTLCError(pd.HtlcIndex, failureCode,
-				onionBlob[:], pd.SourceRef)
-			needUpdate = true
+			if l.sendMalformedHTLCError(pd.HtlcIndex, failureCode,
+				onionBlob[:], pd.SourceRef) {
+
+				needUpdate = true
+			}
 
 			log.Errorf("unable to decode onion hop "+
 				"iterator: %v", failureCode)
@@ -2635,41 +2892,128 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 			// If we're unable to process the onion blob than we
 			// should send the malformed htlc error to payment
 			// sender.
-			l.sendMalformedHTLCError(
+			if l.sendMalformedHTLCError(
 				pd.HtlcIndex, failureCode, onionBlob[:], pd.SourceRef,
-			)
-			needUpdate = true
+			) {
+				needUpdate = true
+			}
 
 			log.Errorf("unable to decode onion "+
 				"obfuscator: %v", failureCode)
 			continue
 		}
 
+		// If the link is being drained, we won't accept any new HTLCs
+		// from the remote peer, so we'll fail this one back without
+		// processing it any further.
+		if l.isDraining() && fwdPkg.State == channeldb.FwdStateLockedIn {
+			var failure lnwire.FailureMessage
+			update, err := l.cfg.FetchLastChannelUpdate(
+				l.ShortChanID(),
+			)
+			if err != nil {
+				failure = &lnwire.FailTemporaryNodeFailure{}
+			} else {
+				failure = lnwire.NewTemporaryChannelFailure(
+					update,
+				)
+			}
+
+			if l.sendHTLCError(
+				pd, failure, ErrLinkDraining.Error(), obfuscator,
+				false,
+			) {
+				needUpdate = true
+			}
+
+			l.debugf("rejected incoming htlc(%x) while draining",
+				pd.RHash[:])
+			continue
+		}
+
+		// Likewise, we'll fail back the HTLCs that would bring the
+		// exposure of the channel or of the peer over its limits.
+		if checkExposure {
+			newExposure, err := l.checkHtlcExposure(
+				exposure, true, pd.Amount,
+			)
+			if err != nil {
+				var failure lnwire.FailureMessage
+				update, uerr := l.cfg.FetchLastChannelUpdate(
+					l.ShortChanID(),
+				)
+				if uerr != nil {
+					failure = &lnwire.FailTemporaryNodeFailure{}
+				} else {
+					failure = lnwire.NewTemporaryChannelFailure(
+						update,
+					)
+				}
+
+				if l.sendHTLCError(
+					pd, failure, err.Error(), obfuscator,
+					false,
+				) {
+					needUpdate = true
+				}
+
+				l.debugf("rejected incoming htlc(%x): %v",
+					pd.RHash[:], err)
+				continue
+			}
+			exposure = newExposure
+		}
+
 		heightNow := l.cfg.Switch.BestHeight()
 
-		fwdInfo, err := chanIterator.ForwardingInstructions()
+		pld, err := chanIterator.HopPayload()
 		if err != nil {
 			// If we're unable to process the onion payload, or we
 			// we received malformed TLV stream, then we should
 			// send an error back to the caller so the HTLC can be
 			// canceled.
-			l.sendHTLCError(
-				pd.HtlcIndex,
-				lnwire.NewInvalidOnionVersion(onionBlob[:]),
-				obfuscator, pd.SourceRef,
-			)
-			needUpdate = true
+			if l.sendHTLCError(
+				pd, lnwire.NewInvalidOnionVersion(onionBlob[:]),
+				fmt.Sprintf("unable to decode forwarding "+
+					"instructions: %v", err),
+				obfuscator, false,
+			) {
+				needUpdate = true
+			}
 
 			log.Errorf("Unable to decode forwarding "+
 				"instructions: %v", err)
 			continue
 		}
 
+		fwdInfo := pld.ForwardingInfo()
+
+		// If legacy onion payloads are deprecated, we'll refuse to
+		// handle any new HTLC still using one. HTLCs that were already
+		// processed are replayed as is, since they may have been
+		// forwarded already.
+		exitHop := fwdInfo.NextHop == hop.Exit
+		if pld.Legacy && l.cfg.LegacyPayloadPolicy != nil &&
+			fwdPkg.State == channeldb.FwdStateLockedIn &&
+			!l.cfg.LegacyPayloadPolicy.Accept(exitHop, heightNow) {
+
+			if l.sendHTLCError(
+				pd, &lnwire.FailInvalidRealm{},
+				"legacy onion payloads are rejected",
+				obfuscator, exitHop,
+			) {
+				needUpdate = true
+			}
+
+			l.debugf("rejected incoming htlc(%x) with legacy "+
+				"onion payload", pd.RHash[:])
+			continue
+		}
+
 		switch fwdInfo.NextHop {
 		case hop.Exit:
 			updated, err := l.processExitHop(
-				pd, obfuscator, fwdInfo, heightNow,
-				chanIterator.ExtraOnionBlob(),
+				pd, obfuscator, pld, heightNow,
 			)
 			if err != nil {
 				l.fail(LinkFailureError{code: ErrInternalError},
@@ -2734,6 +3078,7 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 					obfuscator:      obfuscator,
 					incomingTimeout: pd.Timeout,
 					outgoingTimeout: fwdInfo.OutgoingCTLV,
+					inboundFee:      inboundFee,
 				}
 				switchPackets = append(
 					switchPackets, updatePacket,
@@ -2775,10 +3120,13 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 					)
 				}
 
-				l.sendHTLCError(
-					pd.HtlcIndex, failure, obfuscator, pd.SourceRef,
-				)
-				needUpdate = true
+				if l.sendHTLCError(
+					pd, failure, "unable to encode the "+
+						"remaining route", obfuscator,
+					false,
+				) {
+					needUpdate = true
+				}
 				continue
 			}
 
@@ -2802,6 +3150,7 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 					obfuscator:      obfuscator,
 					incomingTimeout: pd.Timeout,
 					outgoingTimeout: fwdInfo.OutgoingCTLV,
+					inboundFee:      inboundFee,
 				}
 
 				fwdPkg.FwdFilter.Set(idx)
@@ -2841,8 +3190,8 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 // processExitHop handles an htlc for which this link is the exit hop. It
 // returns a boolean indicating whether the commitment tx needs an update.
 func (l *channelLink) processExitHop(pd *lnwallet.PaymentDescriptor,
-	obfuscator hop.ErrorEncrypter, fwdInfo hop.ForwardingInfo,
-	heightNow uint32, eob []byte) (bool, error) {
+	obfuscator hop.ErrorEncrypter, pld *hop.Payload,
+	heightNow uint32) (bool, error) {
 
 	// If hodl.ExitSettle is requested, we will not validate the final hop's
 	// ADD, nor will we settle the corresponding invoice or respond with the
@@ -2853,37 +3202,11 @@ func (l *channelLink) processExitHop(pd *lnwallet.PaymentDescriptor,
 		return false, nil
 	}
 
-	// As we're the exit hop, we'll double check the hop-payload included in
-	// the HTLC to ensure that it was crafted correctly by the sender and
-	// matches the HTLC we were extended.
-	if pd.Amount != fwdInfo.AmountToForward {
-
-		log.Errorf("Onion payload of incoming htlc(%x) has incorrect "+
-			"value: expected %v, got %v", pd.RHash,
-			pd.Amount, fwdInfo.AmountToForward)
-
-		failure := lnwire.NewFinalIncorrectHtlcAmount(pd.Amount)
-		l.sendHTLCError(pd.HtlcIndex, failure, obfuscator, pd.SourceRef)
-
-		return true, nil
-	}
-
-	// We'll also ensure that our time-lock value has been computed
-	// correctly.
-	if pd.Timeout != fwdInfo.OutgoingCTLV {
-		log.Errorf("Onion payload of incoming htlc(%x) has incorrect "+
-			"time-lock: expected %v, got %v",
-			pd.RHash[:], pd.Timeout, fwdInfo.OutgoingCTLV)
-
-		failure := lnwire.NewFinalIncorrectCltvExpiry(pd.Timeout)
-		l.sendHTLCError(pd.HtlcIndex, failure, obfuscator, pd.SourceRef)
-
-		return true, nil
-	}
-
 	// Notify the invoiceRegistry of the exit hop htlc. If we crash right
 	// after this, this code will be re-executed after restart. We will
-	// receive back a resolution event.
+	// receive back a resolution event. The registry double checks that
+	// the hop-payload included in the HTLC was crafted correctly by the
+	// sender and matches the HTLC we were extended.
 	invoiceHash := lntypes.Hash(pd.RHash)
 
 	circuitKey := channeldb.CircuitKey{
@@ -2891,9 +3214,18 @@ func (l *channelLink) processExitHop(pd *lnwallet.PaymentDescriptor,
 		HtlcID: pd.HtlcIndex,
 	}
 
+	fwdInfo := pld.ForwardingInfo()
+	payload := &invoices.ExitHopPayload{
+		AmtToForward: fwdInfo.AmountToForward,
+		OutgoingCltv: fwdInfo.OutgoingCTLV,
+		MPP:          pld.MultiPath(),
+		AMP:          pld.AMPRecord(),
+		KeySend:      pld.KeySendPreimage(),
+	}
+
 	event, err := l.cfg.Registry.NotifyExitHopHtlc(
 		invoiceHash, pd.Amount, pd.Timeout, int32(heightNow),
-		circuitKey, l.hodlQueue.ChanIn(), eob,
+		circuitKey, l.hodlQueue.ChanIn(), payload,
 	)
 
 	switch err {
@@ -2901,9 +3233,11 @@ func (l *channelLink) processExitHop(pd *lnwallet.PaymentDescriptor,
 	// Cancel htlc if we don't have an invoice for it.
 	case channeldb.ErrInvoiceNotFound:
 		failure := lnwire.NewFailIncorrectDetails(pd.Amount, heightNow)
-		l.sendHTLCError(pd.HtlcIndex, failure, obfuscator, pd.SourceRef)
+		updated := l.sendHTLCError(
+			pd, failure, err.Error(), obfuscator, true,
+		)
 
-		return true, nil
+		return updated, nil
 
 	// No error.
 	case nil:
@@ -2927,11 +3261,7 @@ func (l *channelLink) processExitHop(pd *lnwallet.PaymentDescriptor,
 	}
 
 	// Process the received resolution.
-	err = l.processHodlEvent(*event, htlc)
-	if err != nil {
-		return false, err
-	}
-	return true, nil
+	return l.processHodlEvent(*event, htlc)
 }
 
 // settleHTLC settles the HTLC on the channel.
@@ -2945,6 +3275,13 @@ func (l *channelLink) settleHTLC(preimage lntypes.Preimage, htlcIndex uint64,
 	err := l.channel.SettleHTLC(
 		preimage, htlcIndex, sourceRef, nil, nil,
 	)
+
+	// The settle may already be pending if the resolution is being
+	// replayed, in which case it was already sent to the peer.
+	if _, ok := err.(lnwallet.ErrHtlcIndexAlreadySettled); ok {
+		l.debugf("htlc %v is already being settled", hash)
+		return nil
+	}
 	if err != nil {
 		return fmt.Errorf("unable to settle htlc: %v", err)
 	}
@@ -2965,6 +3302,16 @@ func (l *channelLink) settleHTLC(preimage lntypes.Preimage, htlcIndex uint64,
 		PaymentPreimage: preimage,
 	})
 
+	l.cfg.HtlcNotifier.NotifySettleEvent(
+		HtlcKey{
+			IncomingCircuit: CircuitKey{
+				ChanID: l.ShortChanID(),
+				HtlcID: htlcIndex,
+			},
+		},
+		HtlcEventTypeReceive,
+	)
+
 	return nil
 }
 
@@ -3008,47 +3355,111 @@ func (l *channelLink) handleBatchFwdErrs(errChan chan error) {
 	}
 }
 
+// delayFailure applies the given failure of an incoming HTLC, either right
+// away or, if the link has a failure delay policy, once the random delay it
+// returns has expired. It returns whether the failure was applied right away
+// and added to the channel, in which case the commit tx should be updated.
+// Delayed failures update the commit tx once applied by the htlcManager.
+func (l *channelLink) delayFailure(fail func() bool) bool {
+	var delay time.Duration
+	if l.cfg.FailureDelayPolicy != nil {
+		delay = l.cfg.FailureDelayPolicy.Delay()
+	}
+	if delay == 0 {
+		return fail()
+	}
+
+	// If the link is stopped before the failure is applied, the HTLC is
+	// left untouched, and its failure will be recreated when its forward
+	// package is replayed.
+	time.AfterFunc(delay, func() {
+		select {
+		case l.delayedFailures <- fail:
+		case <-l.quit:
+		}
+	})
+
+	return false
+}
+
 // sendHTLCError functions cancels HTLC and send cancel message back to the
-// peer from which HTLC was received.
-func (l *channelLink) sendHTLCError(htlcIndex uint64, failure lnwire.FailureMessage,
-	e hop.ErrorEncrypter, sourceRef *channeldb.AddRef) {
+// peer from which HTLC was received. The failure detail and whether we are
+// the final hop of the HTLC are only used to notify the link failure. It
+// returns whether the cancel was added to the channel, requiring a commitment
+// update.
+func (l *channelLink) sendHTLCError(pd *lnwallet.PaymentDescriptor,
+	failure lnwire.FailureMessage, failureDetail string,
+	e hop.ErrorEncrypter, isReceive bool) bool {
+
+	htlcIndex := pd.HtlcIndex
+	return l.delayFailure(func() bool {
+		reason, err := e.EncryptFirstHop(failure)
+		if err != nil {
+			log.Errorf("unable to obfuscate error: %v", err)
+			return false
+		}
 
-	reason, err := e.EncryptFirstHop(failure)
-	if err != nil {
-		log.Errorf("unable to obfuscate error: %v", err)
-		return
-	}
+		err = l.channel.FailHTLC(
+			htlcIndex, reason, pd.SourceRef, nil, nil,
+		)
+		if err != nil {
+			log.Errorf("unable cancel htlc: %v", err)
+			return false
+		}
 
-	err = l.channel.FailHTLC(htlcIndex, reason, sourceRef, nil, nil)
-	if err != nil {
-		log.Errorf("unable cancel htlc: %v", err)
-		return
-	}
+		l.cfg.Peer.SendMessage(false, &lnwire.UpdateFailHTLC{
+			ChanID: l.ChanID(),
+			ID:     htlcIndex,
+			Reason: reason,
+		})
+
+		eventType := HtlcEventTypeForward
+		if isReceive {
+			eventType = HtlcEventTypeReceive
+		}
+		l.cfg.HtlcNotifier.NotifyLinkFailEvent(
+			HtlcKey{
+				IncomingCircuit: CircuitKey{
+					ChanID: l.ShortChanID(),
+					HtlcID: htlcIndex,
+				},
+			},
+			HtlcInfo{
+				IncomingTimeLock: pd.Timeout,
+				IncomingAmt:      pd.Amount,
+			},
+			eventType, failure, failureDetail, true,
+		)
 
-	l.cfg.Peer.SendMessage(false, &lnwire.UpdateFailHTLC{
-		ChanID: l.ChanID(),
-		ID:     htlcIndex,
-		Reason: reason,
+		return true
 	})
 }
 
 // sendMalformedHTLCError helper function which sends the malformed HTLC update
-// to the payment sender.
+// to the payment sender. It returns whether the update was added to the
+// channel, requiring a commitment update.
 func (l *channelLink) sendMalformedHTLCError(htlcIndex uint64,
-	code lnwire.FailCode, onionBlob []byte, sourceRef *channeldb.AddRef) {
+	code lnwire.FailCode, onionBlob []byte,
+	sourceRef *channeldb.AddRef) bool {
 
 	shaOnionBlob := sha256.Sum256(onionBlob)
-	err := l.channel.MalformedFailHTLC(htlcIndex, code, shaOnionBlob, sourceRef)
-	if err != nil {
-		log.Errorf("unable cancel htlc: %v", err)
-		return
-	}
+	return l.delayFailure(func() bool {
+		err := l.channel.MalformedFailHTLC(
+			htlcIndex, code, shaOnionBlob, sourceRef,
+		)
+		if err != nil {
+			log.Errorf("unable cancel htlc: %v", err)
+			return false
+		}
 
-	l.cfg.Peer.SendMessage(false, &lnwire.UpdateFailMalformedHTLC{
-		ChanID:       l.ChanID(),
-		ID:           htlcIndex,
-		ShaOnionBlob: shaOnionBlob,
-		FailureCode:  code,
+		l.cfg.Peer.SendMessage(false, &lnwire.UpdateFailMalformedHTLC{
+			ChanID:       l.ChanID(),
+			ID:           htlcIndex,
+			ShaOnionBlob: shaOnionBlob,
+			FailureCode:  code,
+		})
+
+		return true
 	})
 }
 
@@ -1661,6 +1661,9 @@ func (m *mockPeer) LocalGlobalFeatures() *lnwire.FeatureVector {
 func (m *mockPeer) RemoteGlobalFeatures() *lnwire.FeatureVector {
 	return nil
 }
+func (m *mockPeer) RemoteLocalFeatures() *lnwire.FeatureVector {
+	return nil
+}
 
 func newSingleLinkTestHarness(chanAmt, chanReserve dcrutil.Amount) (
 	ChannelLink, *lnwallet.LightningChannel, chan time.Time, func() error,
@@ -1741,6 +1744,7 @@ func newSingleLinkTestHarness(chanAmt, chanReserve dcrutil.Amount) (
 		MaxFeeAllocation:      DefaultMaxLinkFeeAllocation,
 		NotifyActiveChannel:   func(wire.OutPoint) {},
 		NotifyInactiveChannel: func(wire.OutPoint) {},
+		HtlcNotifier:          &mockHTLCNotifier{},
 	}
 
 	aliceLink := NewChannelLink(aliceCfg, aliceLc.channel)
@@ -4304,6 +4308,7 @@ func (h *persistentLinkHarness) restartLink(
 		MaxFeeAllocation:      DefaultMaxLinkFeeAllocation,
 		NotifyActiveChannel:   func(wire.OutPoint) {},
 		NotifyInactiveChannel: func(wire.OutPoint) {},
+		HtlcNotifier:          &mockHTLCNotifier{},
 	}
 
 	aliceLink := NewChannelLink(aliceCfg, aliceChannel)
@@ -5063,6 +5068,185 @@ func TestChannelLinkCleanupSpuriousResponses(t *testing.T) {
 	}
 }
 
+// TestChannelLinkDuplicateResolutions tests that the link ignores settle and
+// fail packets replayed by the switch at every step of the commitment dance
+// locking in the original resolution, without sending them again to the peer
+// nor failing the channel.
+func TestChannelLinkDuplicateResolutions(t *testing.T) {
+	t.Parallel()
+
+	const chanAmt = dcrutil.AtomsPerCoin * 5
+	const chanReserve = dcrutil.AtomsPerCoin * 1
+	aliceLink, bobChannel, _, start, cleanUp, _, err :=
+		newSingleLinkTestHarness(chanAmt, chanReserve)
+	if err != nil {
+		t.Fatalf("unable to create link: %v", err)
+	}
+	defer cleanUp()
+
+	if err := start(); err != nil {
+		t.Fatalf("unable to start test harness: %v", err)
+	}
+
+	var (
+		coreLink  = aliceLink.(*channelLink)
+		aliceMsgs = coreLink.cfg.Peer.(*mockPeer).sentMsgs
+	)
+
+	// Set Alice in hodl ExitSettle mode so that she won't respond to the
+	// htlcs meant for her, allowing us to provide the resolutions.
+	coreLink.cfg.HodlMask = hodl.ExitSettle.Mask()
+
+	htlc1, invoice1 := generateHtlcAndInvoice(t, 0)
+	err = coreLink.cfg.Registry.(*mockInvoiceRegistry).AddInvoice(
+		*invoice1, htlc1.PaymentHash,
+	)
+	if err != nil {
+		t.Fatalf("unable to add invoice to registry: %v", err)
+	}
+	htlc2 := generateHtlc(t, coreLink, bobChannel, 1)
+
+	ctx := linkTestContext{
+		t:          t,
+		aliceLink:  aliceLink,
+		aliceMsgs:  aliceMsgs,
+		bobChannel: bobChannel,
+	}
+
+	//  Bob               Alice
+	//   |------ add-1 ----->|
+	//   |------ add-2 ----->|
+	//   |------  sig  ----->| commits add-1 + add-2
+	//   |<-----  rev  ------|
+	//   |<-----  sig  ------| commits add-1 + add-2
+	//   |------  rev  ----->|
+	ctx.sendHtlcBobToAlice(htlc1)
+	ctx.sendHtlcBobToAlice(htlc2)
+	ctx.sendCommitSigBobToAlice(2)
+	ctx.receiveRevAndAckAliceToBob()
+	ctx.receiveCommitSigAliceToBob(2)
+	ctx.sendRevAndAckBobToAlice()
+
+	// Give Alice to time to process the revocation.
+	time.Sleep(time.Second)
+
+	aliceFwdPkgs, err := coreLink.channel.LoadFwdPkgs()
+	if err != nil {
+		t.Fatalf("unable to load alice's fwdpkgs: %v", err)
+	}
+	if len(aliceFwdPkgs) != 1 {
+		t.Fatalf("alice should have 1 fwd pkgs, has %d instead",
+			len(aliceFwdPkgs))
+	}
+	addHeight := aliceFwdPkgs[0].Height
+
+	newSettle := func() *htlcPacket {
+		return &htlcPacket{
+			sourceRef: &channeldb.AddRef{
+				Height: addHeight,
+				Index:  0,
+			},
+			incomingChanID: bobChannel.ShortChanID(),
+			incomingHTLCID: 0,
+			htlc: &lnwire.UpdateFulfillHTLC{
+				PaymentPreimage: invoice1.Terms.PaymentPreimage,
+			},
+		}
+	}
+	newFail := func(htlcID uint64) *htlcPacket {
+		return &htlcPacket{
+			sourceRef: &channeldb.AddRef{
+				Height: addHeight,
+				Index:  uint16(htlcID),
+			},
+			incomingChanID: bobChannel.ShortChanID(),
+			incomingHTLCID: htlcID,
+			obfuscator:     NewMockObfuscator(),
+			htlc:           &lnwire.UpdateFailHTLC{},
+		}
+	}
+
+	// assertIgnored hands the given packets to Alice's link, and asserts
+	// that none of them results in a message being sent to Bob.
+	assertIgnored := func(pkts ...*htlcPacket) {
+		t.Helper()
+
+		for _, pkt := range pkts {
+			if err := aliceLink.HandleSwitchPacket(pkt); err != nil {
+				t.Fatalf("unable to handle packet: %v", err)
+			}
+		}
+
+		select {
+		case msg := <-aliceMsgs:
+			t.Fatalf("message %T sent for duplicate resolution",
+				msg)
+		case <-time.After(time.Second):
+		}
+	}
+
+	// Alice settles the first HTLC and signs a commitment including the
+	// settle. The settle packet is removed from her mailbox once signed.
+	//
+	//  Bob               Alice
+	//   |<----- stl-1 ------|
+	//   |<-----  sig  ------| commits stl-1
+	if err := aliceLink.HandleSwitchPacket(newSettle()); err != nil {
+		t.Fatalf("unable to handle packet: %v", err)
+	}
+	ctx.receiveSettleAliceToBob()
+	ctx.receiveCommitSigAliceToBob(1)
+
+	// The settle is still pending in Alice's commitment, so replaying it,
+	// or failing the same HTLC, should be ignored.
+	assertIgnored(newSettle(), newFail(0))
+
+	// Complete the rest of the commitment dance, which removes the first
+	// HTLC from the commitments.
+	//
+	//  Bob                Alice
+	//   |------  rev  ----->|
+	//   |------  sig  ----->|
+	//   |<-----  rev  ------|
+	ctx.sendRevAndAckBobToAlice()
+	ctx.sendCommitSigBobToAlice(1)
+	ctx.receiveRevAndAckAliceToBob()
+
+	// A replay of the settle now targets an unknown HTLC, which should be
+	// ignored as well.
+	assertIgnored(newSettle())
+
+	// We'll now do the same for the failure of the second HTLC.
+	//
+	//  Bob               Alice
+	//   |<----- fal-2 ------|
+	//   |<-----  sig  ------| commits fal-2
+	if err := aliceLink.HandleSwitchPacket(newFail(1)); err != nil {
+		t.Fatalf("unable to handle packet: %v", err)
+	}
+	ctx.receiveFailAliceToBob()
+	ctx.receiveCommitSigAliceToBob(0)
+
+	assertIgnored(newFail(1))
+
+	//  Bob                Alice
+	//   |------  rev  ----->|
+	//   |------  sig  ----->|
+	//   |<-----  rev  ------|
+	ctx.sendRevAndAckBobToAlice()
+	ctx.sendCommitSigBobToAlice(0)
+	ctx.receiveRevAndAckAliceToBob()
+
+	assertIgnored(newFail(1))
+
+	// Finally, the channel should still be usable after all the replayed
+	// resolutions, so Bob should be able to offer another HTLC.
+	htlc3 := generateHtlc(t, coreLink, bobChannel, 2)
+	ctx.sendHtlcBobToAlice(htlc3)
+	ctx.sendCommitSigBobToAlice(1)
+	ctx.receiveRevAndAckAliceToBob()
+}
+
 type mockPackager struct {
 	failLoadFwdPkgs bool
 }
@@ -5423,11 +5607,14 @@ func TestHtlcSatisfyPolicy(t *testing.T) {
 		},
 	}
 
-	var hash [32]byte
+	var (
+		hash         [32]byte
+		noInboundFee lnwire.InboundFee
+	)
 
 	t.Run("satisfied", func(t *testing.T) {
 		result := link.HtlcSatifiesPolicy(hash, 1500, 1000,
-			200, 150, 0)
+			noInboundFee, 200, 150, 0)
 		if result != nil {
 			t.Fatalf("expected policy to be satisfied")
 		}
@@ -5435,7 +5622,7 @@ func TestHtlcSatisfyPolicy(t *testing.T) {
 
 	t.Run("below minhtlc", func(t *testing.T) {
 		result := link.HtlcSatifiesPolicy(hash, 100, 50,
-			200, 150, 0)
+			noInboundFee, 200, 150, 0)
 		if _, ok := result.(*lnwire.FailAmountBelowMinimum); !ok {
 			t.Fatalf("expected FailAmountBelowMinimum failure code")
 		}
@@ -5443,7 +5630,7 @@ func TestHtlcSatisfyPolicy(t *testing.T) {
 
 	t.Run("above maxhtlc", func(t *testing.T) {
 		result := link.HtlcSatifiesPolicy(hash, 1500, 1200,
-			200, 150, 0)
+			noInboundFee, 200, 150, 0)
 		if _, ok := result.(*lnwire.FailTemporaryChannelFailure); !ok {
 			t.Fatalf("expected FailTemporaryChannelFailure failure code")
 		}
@@ -5451,7 +5638,23 @@ func TestHtlcSatisfyPolicy(t *testing.T) {
 
 	t.Run("insufficient fee", func(t *testing.T) {
 		result := link.HtlcSatifiesPolicy(hash, 1005, 1000,
-			200, 150, 0)
+			noInboundFee, 200, 150, 0)
+		if _, ok := result.(*lnwire.FailFeeInsufficient); !ok {
+			t.Fatalf("expected FailFeeInsufficient failure code")
+		}
+	})
+
+	t.Run("inbound fee discount", func(t *testing.T) {
+		result := link.HtlcSatifiesPolicy(hash, 1005, 1000,
+			lnwire.InboundFee{BaseFee: -5}, 200, 150, 0)
+		if result != nil {
+			t.Fatalf("expected policy to be satisfied")
+		}
+	})
+
+	t.Run("inbound fee discount too small", func(t *testing.T) {
+		result := link.HtlcSatifiesPolicy(hash, 1005, 1000,
+			lnwire.InboundFee{BaseFee: -4}, 200, 150, 0)
 		if _, ok := result.(*lnwire.FailFeeInsufficient); !ok {
 			t.Fatalf("expected FailFeeInsufficient failure code")
 		}
@@ -5459,7 +5662,7 @@ func TestHtlcSatisfyPolicy(t *testing.T) {
 
 	t.Run("expiry too soon", func(t *testing.T) {
 		result := link.HtlcSatifiesPolicy(hash, 1500, 1000,
-			200, 150, 190)
+			noInboundFee, 200, 150, 190)
 		if _, ok := result.(*lnwire.FailExpiryTooSoon); !ok {
 			t.Fatalf("expected FailExpiryTooSoon failure code")
 		}
@@ -5467,7 +5670,7 @@ func TestHtlcSatisfyPolicy(t *testing.T) {
 
 	t.Run("incorrect cltv expiry", func(t *testing.T) {
 		result := link.HtlcSatifiesPolicy(hash, 1500, 1000,
-			200, 190, 0)
+			noInboundFee, 200, 190, 0)
 		if _, ok := result.(*lnwire.FailIncorrectCltvExpiry); !ok {
 			t.Fatalf("expected FailIncorrectCltvExpiry failure code")
 		}
@@ -5477,7 +5680,7 @@ func TestHtlcSatisfyPolicy(t *testing.T) {
 	t.Run("cltv expiry too far in the future", func(t *testing.T) {
 		// Check that expiry isn't too far in the future.
 		result := link.HtlcSatifiesPolicy(hash, 1500, 1000,
-			10200, 10100, 0)
+			noInboundFee, 10200, 10100, 0)
 		if _, ok := result.(*lnwire.FailExpiryTooFar); !ok {
 			t.Fatalf("expected FailExpiryTooFar failure code")
 		}
@@ -5707,6 +5910,65 @@ func TestChannelLinkHoldInvoiceCancel(t *testing.T) {
 	assertFailureCode(t, err, lnwire.CodeIncorrectOrUnknownPaymentDetails)
 }
 
+// TestChannelLinkDrain asserts that a draining link stops forwarding new
+// HTLCs, and only signals that it has been drained once all of its pending
+// HTLCs have been resolved.
+func TestChannelLinkDrain(t *testing.T) {
+	t.Parallel()
+
+	defer timeout(t)()
+
+	ctx, err := newHodlInvoiceTestCtx(t)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ctx.cleanUp()
+
+	// Put Alice's link into drain mode while the hodl htlc is still
+	// pending. The link should no longer be eligible to forward, but it
+	// shouldn't be drained as long as the htlc is active.
+	drained := ctx.n.aliceChannelLink.Drain()
+	if ctx.n.aliceChannelLink.EligibleToForward() {
+		t.Fatalf("draining link should not be eligible to forward")
+	}
+
+	select {
+	case <-drained:
+		t.Fatalf("link drained with active htlc")
+	case <-time.After(time.Second):
+	}
+
+	// Settling the invoice resolves the htlc, after which the link should
+	// signal that it has been fully drained.
+	err = ctx.n.bobServer.registry.SettleHodlInvoice(ctx.preimage)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = <-ctx.errChan
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-drained:
+	case <-time.After(10 * time.Second):
+		t.Fatalf("link not drained after htlc was settled")
+	}
+
+	// Any new payment over the drained link should now be rejected.
+	amount := lnwire.NewMAtomsFromAtoms(dcrutil.AtomsPerCoin)
+	htlcAmt, totalTimelock, hops := generateHops(
+		amount, testStartingHeight, ctx.n.bobChannelLink,
+	)
+	_, err = makePayment(
+		ctx.n.aliceServer, ctx.n.bobServer,
+		ctx.n.bobChannelLink.ShortChanID(), hops, amount, htlcAmt,
+		totalTimelock,
+	).Wait(30 * time.Second)
+	assertFailureCode(t, err, lnwire.CodeTemporaryChannelFailure)
+}
+
 // TestChannelLinkHoldInvoiceRestart asserts hodl htlcs are held after blocks
 // are mined and the link is restarted. The initial expiry checks should not
 // apply to hodl htlcs after restart.
@@ -5,6 +5,10 @@ import "github.com/go-errors/errors"
 var (
 	// ErrLinkShuttingDown signals that the link is shutting down.
 	ErrLinkShuttingDown = errors.New("link shutting down")
+
+	// ErrLinkDraining signals that the link is being drained and won't
+	// accept any new HTLCs.
+	ErrLinkDraining = errors.New("link draining")
 )
 
 // errorCode encodes the possible types of errors that will make us fail the
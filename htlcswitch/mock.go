@@ -172,6 +172,7 @@ func initSwitchWithDB(startingHeight uint32, db *channeldb.DB) (*Switch, error)
 			return nil, nil
 		},
 		Notifier:       &mockNotifier{},
+		HtlcNotifier:   &mockHTLCNotifier{},
 		FwdEventTicker: ticker.NewForce(DefaultFwdEventInterval),
 		LogEventTicker: ticker.NewForce(DefaultLogInterval),
 		AckEventTicker: ticker.NewForce(DefaultAckInterval),
@@ -280,6 +281,15 @@ func (r *mockHopIterator) ForwardingInstructions() (
 	return h, nil
 }
 
+func (r *mockHopIterator) HopPayload() (*hop.Payload, error) {
+	fwdInfo, err := r.ForwardingInstructions()
+	if err != nil {
+		return nil, err
+	}
+
+	return &hop.Payload{FwdInfo: fwdInfo}, nil
+}
+
 func (r *mockHopIterator) ExtraOnionBlob() []byte {
 	return nil
 }
@@ -609,6 +619,10 @@ func (s *mockServer) RemoteGlobalFeatures() *lnwire.FeatureVector {
 	return nil
 }
 
+func (s *mockServer) RemoteLocalFeatures() *lnwire.FeatureVector {
+	return nil
+}
+
 func (s *mockServer) Stop() error {
 	if !atomic.CompareAndSwapInt32(&s.shutdown, 0, 1) {
 		return nil
@@ -701,7 +715,8 @@ func (f *mockChannelLink) HandleChannelUpdate(lnwire.Message) {
 func (f *mockChannelLink) UpdateForwardingPolicy(_ ForwardingPolicy) {
 }
 func (f *mockChannelLink) HtlcSatifiesPolicy([32]byte, lnwire.MilliAtom,
-	lnwire.MilliAtom, uint32, uint32, uint32) lnwire.FailureMessage {
+	lnwire.MilliAtom, lnwire.InboundFee, uint32, uint32,
+	uint32) lnwire.FailureMessage {
 	return nil
 }
 
@@ -740,6 +755,13 @@ func (f *mockChannelLink) UpdateShortChanID() (lnwire.ShortChannelID, error) {
 	return f.shortChanID, nil
 }
 
+func (f *mockChannelLink) Drain() <-chan struct{} {
+	f.eligible = false
+	drained := make(chan struct{})
+	close(drained)
+	return drained
+}
+
 var _ ChannelLink = (*mockChannelLink)(nil)
 
 func newDB() (*channeldb.DB, func(), error) {
@@ -783,7 +805,9 @@ func newMockRegistry(minDelta uint32) *mockInvoiceRegistry {
 
 	finalCltvRejectDelta := int32(5)
 
-	registry := invoices.NewRegistry(cdb, finalCltvRejectDelta)
+	registry := invoices.NewRegistry(cdb, &invoices.RegistryConfig{
+		FinalCltvRejectDelta: finalCltvRejectDelta,
+	})
 	registry.Start()
 
 	return &mockInvoiceRegistry{
@@ -805,10 +829,11 @@ func (i *mockInvoiceRegistry) SettleHodlInvoice(preimage lntypes.Preimage) error
 func (i *mockInvoiceRegistry) NotifyExitHopHtlc(rhash lntypes.Hash,
 	amt lnwire.MilliAtom, expiry uint32, currentHeight int32,
 	circuitKey channeldb.CircuitKey, hodlChan chan<- interface{},
-	eob []byte) (*invoices.HodlEvent, error) {
+	payload *invoices.ExitHopPayload) (*invoices.HodlEvent, error) {
 
 	event, err := i.registry.NotifyExitHopHtlc(
-		rhash, amt, expiry, currentHeight, circuitKey, hodlChan, eob,
+		rhash, amt, expiry, currentHeight, circuitKey, hodlChan,
+		payload,
 	)
 	if err != nil {
 		return nil, err
@@ -927,6 +952,25 @@ func (m *mockNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint, _ []byte,
 	}, nil
 }
 
+type mockHTLCNotifier struct{}
+
+func (h *mockHTLCNotifier) NotifyForwardingEvent(key HtlcKey, info HtlcInfo,
+	eventType HtlcEventType) {
+}
+
+func (h *mockHTLCNotifier) NotifyLinkFailEvent(key HtlcKey, info HtlcInfo,
+	eventType HtlcEventType, failure lnwire.FailureMessage,
+	failureDetail string, incoming bool) {
+}
+
+func (h *mockHTLCNotifier) NotifyForwardingFailEvent(key HtlcKey,
+	eventType HtlcEventType) {
+}
+
+func (h *mockHTLCNotifier) NotifySettleEvent(key HtlcKey,
+	eventType HtlcEventType) {
+}
+
 type mockCircuitMap struct {
 	lookup chan *PaymentCircuit
 }
@@ -86,6 +86,11 @@ type htlcPacket struct {
 	// will be extraced from the hop payload recevived by the incoming
 	// link.
 	outgoingTimeout uint32
+
+	// inboundFee is the inbound fee of the incoming link at the time the
+	// HTLC was received, which is added to the fee required by the
+	// outgoing link.
+	inboundFee lnwire.InboundFee
 }
 
 // inKey returns the circuit key used to identify the incoming htlc.
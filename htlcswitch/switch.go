@@ -58,6 +58,10 @@ var (
 	// request.
 	ErrSwitchExiting = errors.New("htlcswitch shutting down")
 
+	// ErrLinkDrainTimeout is returned when a link couldn't be fully
+	// drained within the allotted time.
+	ErrLinkDrainTimeout = errors.New("timeout while draining link")
+
 	// ErrNoLinksFound is an error returned when we attempt to retrieve the
 	// active links in the switch for a specific destination.
 	ErrNoLinksFound = errors.New("no channel links found")
@@ -104,6 +108,17 @@ type ChanClose struct {
 	// process for the cooperative closure transaction kicks off.
 	TargetFeePerKB lnwallet.AtomPerKByte
 
+	// MaxFeePerKB is the maximum fee rate the caller is willing to pay for
+	// the cooperative closure transaction. This value is only utilized if
+	// the closure type is CloseRegular and we're the initiator of the
+	// channel. A value of zero means that the fee isn't capped.
+	MaxFeePerKB lnwallet.AtomPerKByte
+
+	// DeliveryScript is an optional delivery script to pay our settled
+	// funds to in the case of a cooperative closure. If empty, a fresh
+	// script from the wallet will be used.
+	DeliveryScript []byte
+
 	// Updates is used by request creator to receive the notifications about
 	// execution of the close channel request.
 	Updates chan interface{}
@@ -166,6 +181,15 @@ type Config struct {
 	// RejectHTLC is a flag that instructs the htlcswitch to reject any
 	// HTLCs that are not from the source hop.
 	RejectHTLC bool
+
+	// HtlcNotifier is used to notify the events happening to the HTLCs
+	// forwarded by the switch.
+	HtlcNotifier htlcNotifier
+
+	// UnsafeLogging is a flag that instructs the htlcswitch to include
+	// the secrets carried by the HTLC messages, such as payment preimages
+	// and onion blobs, in its logs instead of redacting them.
+	UnsafeLogging bool
 }
 
 // Switch is the central messaging bus for all incoming/outgoing HTLCs.
@@ -229,6 +253,12 @@ type Switch struct {
 	// ChannelLink
 	forwardingIndex map[lnwire.ShortChannelID]ChannelLink
 
+	// confirmedIndex maps the channel ID of zero-conf channels identified
+	// by an alias short channel ID to the short channel ID their funding
+	// transaction confirmed at. The link is reachable under both in the
+	// forwardingIndex.
+	confirmedIndex map[lnwire.ChannelID]lnwire.ShortChannelID
+
 	// interfaceIndex maps the compressed public key of a peer to all the
 	// channels that the switch maintains with that peer.
 	interfaceIndex map[[33]byte]map[lnwire.ChannelID]ChannelLink
@@ -282,6 +312,7 @@ func New(cfg Config, currentHeight uint32) (*Switch, error) {
 		linkIndex:         make(map[lnwire.ChannelID]ChannelLink),
 		mailOrchestrator:  newMailOrchestrator(),
 		forwardingIndex:   make(map[lnwire.ShortChannelID]ChannelLink),
+		confirmedIndex:    make(map[lnwire.ChannelID]lnwire.ShortChannelID),
 		interfaceIndex:    make(map[[33]byte]map[lnwire.ChannelID]ChannelLink),
 		pendingLinkIndex:  make(map[lnwire.ChannelID]ChannelLink),
 		networkResults:    newNetworkResultStore(cfg.DB),
@@ -806,6 +837,10 @@ func (s *Switch) handleLocalDispatch(pkt *htlcPacket) error {
 			}
 		}
 
+		// The link may have been selected by the confirmed short
+		// channel ID of a zero-conf channel, so we'll make sure the
+		// packet refers to it by the one the link is known by.
+		pkt.outgoingChanID = link.ShortChanID()
 		return link.HandleSwitchPacket(pkt)
 	}
 
@@ -1052,8 +1087,9 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 			currentHeight := atomic.LoadUint32(&s.bestHeight)
 			err := link.HtlcSatifiesPolicy(
 				htlc.PaymentHash, packet.incomingAmount,
-				packet.amount, packet.incomingTimeout,
-				packet.outgoingTimeout, currentHeight,
+				packet.amount, packet.inboundFee,
+				packet.incomingTimeout, packet.outgoingTimeout,
+				currentHeight,
 			)
 			if err != nil {
 				linkErrs[link.ShortChanID()] = err
@@ -1100,7 +1136,7 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 			// At this point, some or all of the links rejected the
 			// HTLC so we couldn't forward it. So we'll try to look
 			// up the error that came from the source.
-			linkErr, ok := linkErrs[packet.outgoingChanID]
+			linkErr, ok := linkErrs[targetLink.ShortChanID()]
 			if !ok {
 				// If we can't find the error of the source,
 				// then we'll return an unknown next peer,
@@ -1134,7 +1170,24 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 			return err
 		}
 
+		// Notify the outcome of the HTLC offered to the outgoing
+		// channel, unless it was failed by the outgoing link itself,
+		// in which case the link already notified it.
 		fail, isFail := htlc.(*lnwire.UpdateFailHTLC)
+		switch {
+		case packet.hasSource:
+
+		case isFail:
+			s.cfg.HtlcNotifier.NotifyForwardingFailEvent(
+				newHtlcKey(packet), getEventType(packet),
+			)
+
+		default:
+			s.cfg.HtlcNotifier.NotifySettleEvent(
+				newHtlcKey(packet), getEventType(packet),
+			)
+		}
+
 		if isFail && !packet.hasSource {
 			switch {
 			// No message to encrypt, locally sourced payment.
@@ -1235,6 +1288,11 @@ func (s *Switch) failAddPacket(packet *htlcPacket,
 
 	log.Error(failErr)
 
+	s.cfg.HtlcNotifier.NotifyLinkFailEvent(
+		newHtlcKey(packet), newHtlcInfo(packet), getEventType(packet),
+		failure, failErr.Error(), false,
+	)
+
 	failPkt := &htlcPacket{
 		sourceRef:      packet.sourceRef,
 		incomingChanID: packet.incomingChanID,
@@ -1412,11 +1470,13 @@ func (s *Switch) teardownCircuit(pkt *htlcPacket) error {
 
 // CloseLink creates and sends the close channel command to the target link
 // directing the specified closure type. If the closure type if CloseRegular,
-// then the last parameter should be the ideal fee-per-kw that will be used as
-// a starting point for close negotiation.
+// then targetFeePerKB should be the ideal fee-per-kB that will be used as a
+// starting point for close negotiation, while maxFeePerKB optionally caps the
+// fee we're willing to pay. If deliveryScript is non-empty, our settled funds
+// will be paid to it rather than to a fresh wallet address.
 func (s *Switch) CloseLink(chanPoint *wire.OutPoint, closeType ChannelCloseType,
-	targetFeePerKB lnwallet.AtomPerKByte) (chan interface{},
-	chan error) {
+	targetFeePerKB, maxFeePerKB lnwallet.AtomPerKByte,
+	deliveryScript []byte) (chan interface{}, chan error) {
 
 	// TODO(roasbeef) abstract out the close updates.
 	updateChan := make(chan interface{}, 2)
@@ -1427,6 +1487,8 @@ func (s *Switch) CloseLink(chanPoint *wire.OutPoint, closeType ChannelCloseType,
 		ChanPoint:      chanPoint,
 		Updates:        updateChan,
 		TargetFeePerKB: targetFeePerKB,
+		MaxFeePerKB:    maxFeePerKB,
+		DeliveryScript: deliveryScript,
 		Err:            errChan,
 	}
 
@@ -1578,7 +1640,9 @@ out:
 			}
 
 			log.Infof("Received outside contract resolution, "+
-				"mapping to: %v", spew.Sdump(pkt))
+				"mapping to: %v", newLogClosure(func() string {
+				return s.spewPacket(pkt)
+			}))
 
 			// We don't check the error, as the only failure we can
 			// encounter is due to the circuit already being
@@ -2055,6 +2119,73 @@ func (s *Switch) RemoveLink(chanID lnwire.ChannelID) {
 	}
 }
 
+// DrainLink gracefully detaches the link associated with chanID from the
+// switch. The link is first put into drain mode, causing it to reject any new
+// HTLCs while the pending ones are resolved and the commitment chains
+// converge. Once the link is fully drained, it is removed from the switch and
+// stopped. If the link isn't drained within the passed timeout, it is left
+// attached in drain mode and ErrLinkDrainTimeout is returned.
+func (s *Switch) DrainLink(chanID lnwire.ChannelID,
+	timeout time.Duration) error {
+
+	s.indexMtx.RLock()
+	link, err := s.getLink(chanID)
+	s.indexMtx.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Draining channel link with ChannelID(%v)", chanID)
+
+	select {
+	case <-link.Drain():
+	case <-time.After(timeout):
+		return ErrLinkDrainTimeout
+	case <-s.quit:
+		return ErrSwitchExiting
+	}
+
+	s.RemoveLink(chanID)
+
+	return nil
+}
+
+// DrainAllLinks puts all of the links of the switch into drain mode, so that
+// they reject any new HTLCs while the in-flight ones are resolved, and waits
+// for all of them to be fully drained. Unlike DrainLink, the links are left
+// attached to the switch. If the links aren't all drained within the passed
+// timeout, they're left in drain mode and ErrLinkDrainTimeout is returned.
+func (s *Switch) DrainAllLinks(timeout time.Duration) error {
+	s.indexMtx.RLock()
+	drains := make(
+		[]<-chan struct{}, 0, len(s.linkIndex)+len(s.pendingLinkIndex),
+	)
+	for _, link := range s.linkIndex {
+		drains = append(drains, link.Drain())
+	}
+	for _, link := range s.pendingLinkIndex {
+		drains = append(drains, link.Drain())
+	}
+	s.indexMtx.RUnlock()
+
+	log.Infof("Draining %v channel links", len(drains))
+
+	drainTimeout := time.After(timeout)
+	for i, drained := range drains {
+		select {
+		case <-drained:
+		case <-drainTimeout:
+			log.Warnf("%v of %v channel links not drained after %v",
+				len(drains)-i, len(drains), timeout)
+			return ErrLinkDrainTimeout
+		case <-s.quit:
+			return ErrSwitchExiting
+		}
+	}
+
+	return nil
+}
+
 // removeLink is used to remove and stop the channel link.
 //
 // NOTE: This MUST be called with the indexMtx held.
@@ -2070,6 +2201,10 @@ func (s *Switch) removeLink(chanID lnwire.ChannelID) ChannelLink {
 	delete(s.pendingLinkIndex, link.ChanID())
 	delete(s.linkIndex, link.ChanID())
 	delete(s.forwardingIndex, link.ShortChanID())
+	if confirmedID, ok := s.confirmedIndex[link.ChanID()]; ok {
+		delete(s.forwardingIndex, confirmedID)
+		delete(s.confirmedIndex, link.ChanID())
+	}
 
 	// If the link has been added to the peer index, then we'll move to
 	// delete the entry within the index.
@@ -2135,6 +2270,43 @@ func (s *Switch) UpdateShortChanID(chanID lnwire.ChannelID) error {
 	return nil
 }
 
+// AddConfirmedShortChanID makes the live link of a zero-conf channel, which is
+// identified by an alias short channel ID, also reachable under the short
+// channel ID its funding transaction confirmed at. This allows HTLCs forwarded
+// by peers that learned of the channel once it confirmed to reach it.
+func (s *Switch) AddConfirmedShortChanID(chanID lnwire.ChannelID,
+	confirmedID lnwire.ShortChannelID) error {
+
+	s.indexMtx.Lock()
+	defer s.indexMtx.Unlock()
+
+	link, ok := s.linkIndex[chanID]
+	if !ok {
+		return fmt.Errorf("live link %v not found", chanID)
+	}
+
+	if confirmedID == hop.Source || confirmedID == link.ShortChanID() {
+		return fmt.Errorf("invalid confirmed short_chan_id=%v for "+
+			"chan_id=%v", confirmedID, chanID)
+	}
+
+	if other, ok := s.forwardingIndex[confirmedID]; ok && other != link {
+		return fmt.Errorf("short_chan_id=%v already used by "+
+			"chan_id=%v", confirmedID, other.ChanID())
+	}
+
+	log.Infof("Adding confirmed short_chan_id=%v for ChannelLink(%v) "+
+		"with alias=%v", confirmedID, chanID, link.ShortChanID())
+
+	s.forwardingIndex[confirmedID] = link
+	s.confirmedIndex[chanID] = confirmedID
+
+	mailbox := s.mailOrchestrator.GetOrCreateMailBox(chanID)
+	s.mailOrchestrator.BindLiveShortChanID(mailbox, chanID, confirmedID)
+
+	return nil
+}
+
 // GetLinksByInterface fetches all the links connected to a particular node
 // identified by the serialized compressed form of its public key.
 func (s *Switch) GetLinksByInterface(hop [33]byte) ([]ChannelLink, error) {
@@ -2168,6 +2340,127 @@ func (s *Switch) CircuitModifier() CircuitModifier {
 	return s.circuits
 }
 
+// NumCircuits returns the number of pending circuits, whose htlc wasn't
+// forwarded to the outgoing link yet, and the number of open ones.
+func (s *Switch) NumCircuits() (int, int) {
+	return s.circuits.NumPending(), s.circuits.NumOpen()
+}
+
+// CircuitState describes how far the resolution of a circuit held by the
+// switch has progressed.
+type CircuitState uint8
+
+const (
+	// CircuitPending indicates that the htlc of the circuit hasn't been
+	// locked in a commitment of the outgoing link yet.
+	CircuitPending CircuitState = iota
+
+	// CircuitOpen indicates that the htlc of the circuit has been
+	// forwarded through the outgoing link, and is awaiting a settle or
+	// fail from the remote peer.
+	CircuitOpen
+
+	// CircuitClosing indicates that a settle or fail has been received for
+	// the circuit, which will be deleted once the incoming link has
+	// committed it.
+	CircuitClosing
+)
+
+// String returns a human readable description of the circuit state.
+func (c CircuitState) String() string {
+	switch c {
+	case CircuitPending:
+		return "pending"
+	case CircuitOpen:
+		return "open"
+	case CircuitClosing:
+		return "closing"
+	default:
+		return "unknown"
+	}
+}
+
+// LookupCircuit returns the circuit identified by its incoming circuit key,
+// along with its state. ErrUnknownCircuit is returned if the switch holds no
+// such circuit.
+func (s *Switch) LookupCircuit(inKey CircuitKey) (*PaymentCircuit,
+	CircuitState, error) {
+
+	circuit := s.circuits.LookupCircuit(inKey)
+	if circuit == nil {
+		return nil, 0, ErrUnknownCircuit
+	}
+
+	switch {
+	case s.circuits.IsClosing(inKey):
+		return circuit, CircuitClosing, nil
+	case circuit.HasKeystone():
+		return circuit, CircuitOpen, nil
+	default:
+		return circuit, CircuitPending, nil
+	}
+}
+
+// ForceFailCircuit fails back the htlc of the circuit identified by its
+// incoming circuit key to the incoming link, regardless of the state of the
+// outgoing htlc. The failure is handed to the incoming link like any locally
+// sourced failure, so it is committed to the channel state and the circuit is
+// deleted once the peer has acked it, surviving restarts in between. Any
+// settle or fail later received for the outgoing htlc is dropped.
+//
+// NOTE: This is only meant to recover from circuits stuck because of a bug.
+// If the outgoing htlc is settled afterwards, its amount is lost.
+func (s *Switch) ForceFailCircuit(inKey CircuitKey) (*PaymentCircuit, error) {
+	if inKey.ChanID == hop.Source {
+		return nil, fmt.Errorf("circuit %v belongs to a local payment, "+
+			"which must be failed through the router", inKey)
+	}
+
+	circuit, state, err := s.LookupCircuit(inKey)
+	if err != nil {
+		return nil, err
+	}
+	if state == CircuitClosing {
+		return nil, ErrCircuitClosing
+	}
+	if circuit.ErrorEncrypter == nil {
+		return nil, fmt.Errorf("circuit %v has no error encrypter",
+			inKey)
+	}
+
+	reason, err := circuit.ErrorEncrypter.EncryptFirstHop(
+		&lnwire.FailTemporaryNodeFailure{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to obfuscate error: %v", err)
+	}
+
+	outKey := circuit.OutKey()
+	log.Warnf("Forcing failure of %v circuit %v -> %v for payment hash %x",
+		state, inKey, outKey, circuit.PaymentHash)
+
+	// Marking the packet as locally sourced makes the switch fail the
+	// circuit by its incoming key, which also works for circuits that
+	// haven't been opened.
+	failPkt := &htlcPacket{
+		incomingChanID: inKey.ChanID,
+		incomingHTLCID: inKey.HtlcID,
+		outgoingChanID: outKey.ChanID,
+		outgoingHTLCID: outKey.HtlcID,
+		sourceRef:      &circuit.AddRef,
+		hasSource:      true,
+		circuit:        circuit,
+		htlc: &lnwire.UpdateFailHTLC{
+			Reason: reason,
+		},
+	}
+	if err := s.route(failPkt); err != nil {
+		return nil, err
+	}
+
+	return circuit, nil
+}
+
 // commitCircuits persistently adds a circuit to the switch's circuit map.
 func (s *Switch) commitCircuits(circuits ...*PaymentCircuit) (
 	*CircuitFwdActions, error) {
@@ -2220,3 +2513,16 @@ func (s *Switch) FlushForwardingEvents() error {
 func (s *Switch) BestHeight() uint32 {
 	return atomic.LoadUint32(&s.bestHeight)
 }
+
+// spewPacket returns a full dump of the given packet for the logs. Unless
+// unsafe logging is enabled, the secrets carried by its HTLC message are
+// redacted.
+func (s *Switch) spewPacket(pkt *htlcPacket) string {
+	if !s.cfg.UnsafeLogging && pkt.htlc != nil {
+		redacted := *pkt
+		redacted.htlc = lnwire.RedactMessage(pkt.htlc)
+		pkt = &redacted
+	}
+
+	return spew.Sdump(pkt)
+}
@@ -153,6 +153,179 @@ func TestSwitchHasActiveLink(t *testing.T) {
 	}
 }
 
+// TestSwitchAddConfirmedShortChanID asserts that the link of a zero-conf
+// channel is reachable under both its alias and confirmed short channel IDs,
+// and that both are removed along with the link.
+func TestSwitchAddConfirmedShortChanID(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(t, "alice", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	chanID1, _, confirmedID, _ := genIDs()
+	aliasID := lnwire.ShortChannelID{
+		BlockHeight: lnwire.AliasStartBlockHeight,
+	}
+
+	// The confirmed short channel ID can't be added before the link is
+	// live.
+	if err := s.AddConfirmedShortChanID(chanID1, confirmedID); err == nil {
+		t.Fatalf("expected failure for unknown link")
+	}
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliasID, alicePeer, true,
+	)
+	if err := s.AddLink(aliceChannelLink); err != nil {
+		t.Fatalf("unable to add alice link: %v", err)
+	}
+
+	if err := s.AddConfirmedShortChanID(chanID1, confirmedID); err != nil {
+		t.Fatalf("unable to add confirmed short_chan_id: %v", err)
+	}
+
+	assertLink := func(scid lnwire.ShortChannelID, found bool) {
+		t.Helper()
+
+		s.indexMtx.RLock()
+		link, err := s.getLinkByShortID(scid)
+		s.indexMtx.RUnlock()
+
+		switch {
+		case found && err != nil:
+			t.Fatalf("unable to find link by %v: %v", scid, err)
+
+		case found && link != aliceChannelLink:
+			t.Fatalf("unexpected link found by %v", scid)
+
+		case !found && err != ErrChannelLinkNotFound:
+			t.Fatalf("expected no link for %v, got %v", scid, err)
+		}
+	}
+
+	assertLink(aliasID, true)
+	assertLink(confirmedID, true)
+
+	s.RemoveLink(chanID1)
+
+	assertLink(aliasID, false)
+	assertLink(confirmedID, false)
+}
+
+// TestSwitchDrainLink asserts that a link is removed from the switch once it
+// has been fully drained, and that draining an unknown link fails.
+func TestSwitchDrainLink(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(t, "alice", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	chanID1, _, aliceChanID, _ := genIDs()
+
+	// Draining a link that the switch doesn't know of should fail.
+	err = s.DrainLink(chanID1, time.Second)
+	if err != ErrChannelLinkNotFound {
+		t.Fatalf("expected ErrChannelLinkNotFound, got: %v", err)
+	}
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	if err := s.AddLink(aliceChannelLink); err != nil {
+		t.Fatalf("unable to add alice link: %v", err)
+	}
+
+	// The mock link is drained immediately, so the link should be removed
+	// from the switch once the call returns.
+	if err := s.DrainLink(chanID1, time.Second); err != nil {
+		t.Fatalf("unable to drain link: %v", err)
+	}
+
+	if _, err := s.GetLink(chanID1); err != ErrChannelLinkNotFound {
+		t.Fatalf("expected link to be removed, got: %v", err)
+	}
+	if s.HasActiveLink(chanID1) {
+		t.Fatalf("drained link should not be active")
+	}
+}
+
+// TestSwitchDrainAllLinks asserts that all links of the switch are drained,
+// while staying attached to it.
+func TestSwitchDrainAllLinks(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(t, "alice", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+	bobPeer, err := newMockServer(t, "bob", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create bob server: %v", err)
+	}
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	// Draining a switch without links completes immediately.
+	if err := s.DrainAllLinks(time.Second); err != nil {
+		t.Fatalf("unable to drain links: %v", err)
+	}
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	if err := s.AddLink(aliceChannelLink); err != nil {
+		t.Fatalf("unable to add alice link: %v", err)
+	}
+	bobChannelLink := newMockChannelLink(
+		s, chanID2, bobChanID, bobPeer, true,
+	)
+	if err := s.AddLink(bobChannelLink); err != nil {
+		t.Fatalf("unable to add bob link: %v", err)
+	}
+
+	// The mock links are drained immediately, and are kept by the switch.
+	if err := s.DrainAllLinks(time.Second); err != nil {
+		t.Fatalf("unable to drain links: %v", err)
+	}
+
+	for _, chanID := range []lnwire.ChannelID{chanID1, chanID2} {
+		if _, err := s.GetLink(chanID); err != nil {
+			t.Fatalf("expected link to be kept, got: %v", err)
+		}
+	}
+}
+
 // TestSwitchSendPending checks the inability of htlc switch to forward adds
 // over pending links, and the UpdateShortChanID makes a pending link live.
 func TestSwitchSendPending(t *testing.T) {
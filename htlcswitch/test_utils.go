@@ -260,7 +260,8 @@ func createTestChannel(alicePrivKey, bobPrivKey []byte,
 
 	aliceCommitTx, bobCommitTx, err := lnwallet.CreateCommitmentTxns(
 		aliceAmount, bobAmount, &aliceCfg, &bobCfg, aliceCommitPoint,
-		bobCommitPoint, *fundingTxIn, netParams, true,
+		bobCommitPoint, *fundingTxIn, netParams,
+		channeldb.SingleFunderTweakless,
 	)
 	if err != nil {
 		return nil, nil, nil, err
@@ -1130,6 +1131,7 @@ func (h *hopNetwork) createChannelLink(server, peer *mockServer,
 			MaxFeeAllocation:        DefaultMaxLinkFeeAllocation,
 			NotifyActiveChannel:     func(wire.OutPoint) {},
 			NotifyInactiveChannel:   func(wire.OutPoint) {},
+			HtlcNotifier:            &mockHTLCNotifier{},
 		},
 		channel,
 	)
@@ -126,6 +126,49 @@ func (bi *BaseInput) BlocksToMaturity() uint32 {
 	return 0
 }
 
+// CsvInput is a BaseInput that must be confirmed for a number of blocks
+// before it can be spent.
+type CsvInput struct {
+	BaseInput
+
+	blocksToMaturity uint32
+}
+
+// MakeCsvInput assembles a new CsvInput that can be used to construct a sweep
+// transaction.
+func MakeCsvInput(outpoint *wire.OutPoint, witnessType WitnessType,
+	signDescriptor *SignDescriptor, heightHint uint32,
+	blocksToMaturity uint32) CsvInput {
+
+	return CsvInput{
+		BaseInput: MakeBaseInput(
+			outpoint, witnessType, signDescriptor, heightHint,
+		),
+		blocksToMaturity: blocksToMaturity,
+	}
+}
+
+// NewCsvInput allocates and assembles a new *CsvInput that can be used to
+// construct a sweep transaction.
+func NewCsvInput(outpoint *wire.OutPoint, witnessType WitnessType,
+	signDescriptor *SignDescriptor, heightHint uint32,
+	blocksToMaturity uint32) *CsvInput {
+
+	input := MakeCsvInput(
+		outpoint, witnessType, signDescriptor, heightHint,
+		blocksToMaturity,
+	)
+
+	return &input
+}
+
+// BlocksToMaturity returns the relative timelock, as a number of blocks, that
+// must be built on top of the confirmation height before the output can be
+// spent.
+func (c *CsvInput) BlocksToMaturity() uint32 {
+	return c.blocksToMaturity
+}
+
 // HtlcSucceedInput constitutes a sweep input that needs a pre-image. The input
 // is expected to reside on the commitment tx of the remote party and should
 // not be a second level tx output.
@@ -133,13 +176,18 @@ type HtlcSucceedInput struct {
 	inputKit
 
 	preimage []byte
+
+	// blocksToMaturity is the number of confirmations the HTLC output
+	// requires before it can be spent, which is only non-zero for
+	// channels with anchors.
+	blocksToMaturity uint32
 }
 
 // MakeHtlcSucceedInput assembles a new redeem input that can be used to
 // construct a sweep transaction.
 func MakeHtlcSucceedInput(outpoint *wire.OutPoint,
-	signDescriptor *SignDescriptor,
-	preimage []byte, heightHint uint32) HtlcSucceedInput {
+	signDescriptor *SignDescriptor, preimage []byte, heightHint,
+	blocksToMaturity uint32) HtlcSucceedInput {
 
 	return HtlcSucceedInput{
 		inputKit: inputKit{
@@ -148,7 +196,8 @@ func MakeHtlcSucceedInput(outpoint *wire.OutPoint,
 			signDesc:    *signDescriptor,
 			heightHint:  heightHint,
 		},
-		preimage: preimage,
+		preimage:         preimage,
+		blocksToMaturity: blocksToMaturity,
 	}
 }
 
@@ -178,10 +227,63 @@ func (h *HtlcSucceedInput) CraftInputScript(signer Signer, txn *wire.MsgTx,
 // must be built on top of the confirmation height before the output can be
 // spent.
 func (h *HtlcSucceedInput) BlocksToMaturity() uint32 {
+	return h.blocksToMaturity
+}
+
+// SwapHtlcSucceedInput constitutes a sweep input that claims the on-chain HTLC
+// of a submarine swap using the payment pre-image.
+type SwapHtlcSucceedInput struct {
+	inputKit
+
+	preimage []byte
+}
+
+// MakeSwapHtlcSucceedInput assembles a new swap redeem input that can be used
+// to construct a sweep transaction.
+func MakeSwapHtlcSucceedInput(outpoint *wire.OutPoint,
+	signDescriptor *SignDescriptor,
+	preimage []byte, heightHint uint32) SwapHtlcSucceedInput {
+
+	return SwapHtlcSucceedInput{
+		inputKit: inputKit{
+			outpoint:    *outpoint,
+			witnessType: SwapHtlcSuccess,
+			signDesc:    *signDescriptor,
+			heightHint:  heightHint,
+		},
+		preimage: preimage,
+	}
+}
+
+// CraftInputScript returns a valid set of input scripts allowing this output
+// to be spent. The returns input scripts should target the input at location
+// txIndex within the passed transaction.
+func (h *SwapHtlcSucceedInput) CraftInputScript(signer Signer,
+	txn *wire.MsgTx, txinIdx int) (*Script, error) {
+
+	desc := h.signDesc
+	desc.InputIndex = txinIdx
+
+	witness, err := SwapHTLCSpendSuccess(signer, &desc, txn, h.preimage)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Script{
+		Witness: witness,
+	}, nil
+}
+
+// BlocksToMaturity returns the relative timelock, as a number of blocks, that
+// must be built on top of the confirmation height before the output can be
+// spent.
+func (h *SwapHtlcSucceedInput) BlocksToMaturity() uint32 {
 	return 0
 }
 
 // Compile-time constraints to ensure each input struct implement the Input
 // interface.
 var _ Input = (*BaseInput)(nil)
+var _ Input = (*CsvInput)(nil)
 var _ Input = (*HtlcSucceedInput)(nil)
+var _ Input = (*SwapHtlcSucceedInput)(nil)
@@ -193,6 +193,11 @@ func Ripemd160H(d []byte) []byte {
 //    * The receiver of the HTLC sweeping all the funds in the case that a
 //      revoked commitment transaction bearing this HTLC was broadcast.
 //
+// If confirmedSpend is true, the non-revocation paths additionally require
+// the output to have been confirmed for a block before being spent, as
+// required for channels with anchor outputs. The spending transaction must
+// then signal a relative lock-time of one block in its input sequence.
+//
 // Possible Input Scripts:
 //    SENDR: <sendr sig> <recvr sig> <0> (spend using HTLC timeout transaction)
 //    RECVR: <recvr sig> <preimage>
@@ -211,9 +216,11 @@ func Ripemd160H(d []byte) []byte {
 //         OP_HASH160 <ripemd160(payment hash)> OP_EQUALVERIFY
 //         OP_CHECKSIG
 //     OP_ENDIF
+//     [1 OP_CHECKSEQUENCEVERIFY OP_DROP] <- if confirmedSpend
 // OP_ENDIF
 func SenderHTLCScript(senderHtlcKey, receiverHtlcKey,
-	revocationKey *secp256k1.PublicKey, paymentHash []byte) ([]byte, error) {
+	revocationKey *secp256k1.PublicKey, paymentHash []byte,
+	confirmedSpend bool) ([]byte, error) {
 
 	builder := txscript.NewScriptBuilder()
 
@@ -287,12 +294,32 @@ func SenderHTLCScript(senderHtlcKey, receiverHtlcKey,
 	// Close out the OP_IF statement above.
 	builder.AddOp(txscript.OP_ENDIF)
 
+	// Add the 1 block CSV delay to the non-revocation clauses if this is
+	// a confirmed spend output.
+	if confirmedSpend {
+		builder.AddOp(txscript.OP_1)
+		builder.AddOp(txscript.OP_CHECKSEQUENCEVERIFY)
+		builder.AddOp(txscript.OP_DROP)
+	}
+
 	// Close out the OP_IF statement at the top of the script.
 	builder.AddOp(txscript.OP_ENDIF)
 
 	return builder.Script()
 }
 
+// IsConfirmedSpendHTLCScript returns true if the given HTLC script, as
+// generated by SenderHTLCScript or ReceiverHTLCScript, requires a confirmation
+// before its non-revocation paths can be spent.
+func IsConfirmedSpendHTLCScript(script []byte) bool {
+	confirmedSpendSuffix := []byte{
+		txscript.OP_1, txscript.OP_CHECKSEQUENCEVERIFY, txscript.OP_DROP,
+		txscript.OP_ENDIF,
+	}
+
+	return bytes.HasSuffix(script, confirmedSpendSuffix)
+}
+
 // senderHtlcSpendRevoke constructs a valid witness allowing the receiver of an
 // HTLC to claim the output with knowledge of the revocation private key in the
 // scenario that the sender of the HTLC broadcasts a previously revoked
@@ -403,6 +430,10 @@ func SenderHtlcSpendTimeout(receiverSig []byte, signer Signer,
 // HTLC sweeps the HTLC on-chain after the timeout period of the HTLC has
 // passed.
 //
+// If confirmedSpend is true, the non-revocation paths additionally require
+// the output to have been confirmed for a block before being spent, as
+// required for channels with anchor outputs.
+//
 // Possible Input Scripts:
 //    RECVR: <sender sig> <recvr sig> <preimage> (spend using HTLC success transaction)
 //    REVOK: <sig> <key>
@@ -422,10 +453,11 @@ func SenderHtlcSpendTimeout(receiverSig []byte, signer Signer,
 //         OP_DROP <cltv expiry> OP_CHECKLOCKTIMEVERIFY OP_DROP
 //         OP_CHECKSIG
 //     OP_ENDIF
+//     [1 OP_CHECKSEQUENCEVERIFY OP_DROP] <- if confirmedSpend
 // OP_ENDIF
 func ReceiverHTLCScript(cltvExpiry uint32, senderHtlcKey,
 	receiverHtlcKey, revocationKey *secp256k1.PublicKey,
-	paymentHash []byte) ([]byte, error) {
+	paymentHash []byte, confirmedSpend bool) ([]byte, error) {
 
 	builder := txscript.NewScriptBuilder()
 
@@ -509,6 +541,14 @@ func ReceiverHTLCScript(cltvExpiry uint32, senderHtlcKey,
 	// Close out the inner if statement.
 	builder.AddOp(txscript.OP_ENDIF)
 
+	// Add the 1 block CSV delay to the non-revocation clauses if this is
+	// a confirmed spend output.
+	if confirmedSpend {
+		builder.AddOp(txscript.OP_1)
+		builder.AddOp(txscript.OP_CHECKSEQUENCEVERIFY)
+		builder.AddOp(txscript.OP_DROP)
+	}
+
 	// Close out the outer if statement.
 	builder.AddOp(txscript.OP_ENDIF)
 
@@ -599,6 +639,37 @@ func ReceiverHtlcSpendRevoke(signer Signer, signDesc *SignDescriptor,
 	return receiverHtlcSpendRevoke(signer, signDesc, revokeKey, sweepTx)
 }
 
+// IsHtlcSpendRevoke returns true if the passed input spends an HTLC output
+// through its revocation clause, using the revocation key derived from the
+// revocation base point and commitment secret of the SignDescriptor.
+func IsHtlcSpendRevoke(txIn *wire.TxIn, signDesc *SignDescriptor) (
+	bool, error) {
+
+	if signDesc.KeyDesc.PubKey == nil || signDesc.DoubleTweak == nil {
+		return false, fmt.Errorf("cannot derive revocation key with " +
+			"nil KeyDesc pubkey or DoubleTweak")
+	}
+
+	witness, err := SigScriptToWitnessStack(txIn.SignatureScript)
+	if err != nil {
+		return false, err
+	}
+
+	// The revocation clause is spent with a signature, the revocation key
+	// and the script. The other clauses either require more items or don't
+	// push the revocation key second.
+	if len(witness) != 3 {
+		return false, nil
+	}
+
+	revokeKey := DeriveRevocationPubkey(
+		signDesc.KeyDesc.PubKey,
+		(*secp256k1.PublicKey)(&signDesc.DoubleTweak.PublicKey),
+	)
+
+	return bytes.Equal(witness[1], revokeKey.SerializeCompressed()), nil
+}
+
 // ReceiverHtlcSpendTimeout constructs a valid witness allowing the sender of
 // an HTLC to recover the pending funds after an absolute timeout in the
 // scenario that the receiver of the HTLC broadcasts their version of the
@@ -1014,6 +1085,245 @@ func CommitSpendNoDelay(signer Signer, signDesc *SignDescriptor,
 	return witness, nil
 }
 
+// CommitScriptToRemoteConfirmed constructs the script for the output on the
+// commitment transaction paying to the remote party of said commitment
+// transaction. The money can only be spend after one confirmation.
+//
+// Possible Input Scripts:
+//    SWEEP: <sig>
+//
+// Output Script:
+//	<key> OP_CHECKSIGVERIFY
+//	1 OP_CHECKSEQUENCEVERIFY
+func CommitScriptToRemoteConfirmed(key *secp256k1.PublicKey) ([]byte, error) {
+	builder := txscript.NewScriptBuilder()
+
+	// Only the given key can spend the output.
+	builder.AddData(key.SerializeCompressed())
+	builder.AddOp(txscript.OP_CHECKSIGVERIFY)
+
+	// Check that the output has one confirmation. The 1 left on the stack by
+	// OP_CHECKSEQUENCEVERIFY makes the script evaluate to true.
+	builder.AddOp(txscript.OP_1)
+	builder.AddOp(txscript.OP_CHECKSEQUENCEVERIFY)
+
+	return builder.Script()
+}
+
+// CommitSpendToRemoteConfirmed constructs a valid witness allowing a node to
+// spend their settled output on the counterparty's commitment transaction
+// when it has one confirmation. This is used for the anchor channel type. The
+// spending transaction must have a version >= 2 and the sequence of the input
+// set to 1 for the relative lock-time to be satisfied.
+func CommitSpendToRemoteConfirmed(signer Signer, signDesc *SignDescriptor,
+	sweepTx *wire.MsgTx) (TxWitness, error) {
+
+	if signDesc.KeyDesc.PubKey == nil {
+		return nil, fmt.Errorf("cannot generate witness with nil " +
+			"KeyDesc pubkey")
+	}
+
+	// Ensure the transaction version supports the validation of sequence
+	// locks and CSV semantics.
+	if sweepTx.Version < 2 {
+		return nil, fmt.Errorf("version of passed transaction MUST "+
+			"be >= 2, not %v", sweepTx.Version)
+	}
+
+	// Similar to non delayed output, only a signature is needed.
+	sweepSig, err := signer.SignOutputRaw(sweepTx, signDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	// Finally, we'll manually craft the witness. The witness here is the
+	// signature and the redeem script.
+	witnessStack := make([][]byte, 2)
+	witnessStack[0] = append(sweepSig, byte(signDesc.HashType))
+	witnessStack[1] = signDesc.WitnessScript
+
+	return witnessStack, nil
+}
+
+// CommitScriptAnchor constructs the script for the anchor output spendable by
+// the given key immediately, or by anyone after 16 confirmations.
+//
+// Possible Input Scripts:
+//    By owner:				<sig>
+//    By anyone (after 16 conf):	<emptyvector>
+//
+// Output Script:
+//	<funding_pubkey> OP_CHECKSIG OP_IFDUP
+//	OP_NOTIF
+//		OP_16 OP_CSV
+//	OP_ENDIF
+func CommitScriptAnchor(key *secp256k1.PublicKey) ([]byte, error) {
+	builder := txscript.NewScriptBuilder()
+
+	// Spend immediately with key.
+	builder.AddData(key.SerializeCompressed())
+	builder.AddOp(txscript.OP_CHECKSIG)
+
+	// Duplicate the value if true, since it will be consumed by the NOTIF.
+	builder.AddOp(txscript.OP_IFDUP)
+
+	// Otherwise one can spend after 16 blocks.
+	builder.AddOp(txscript.OP_NOTIF)
+	builder.AddOp(txscript.OP_16)
+	builder.AddOp(txscript.OP_CHECKSEQUENCEVERIFY)
+	builder.AddOp(txscript.OP_ENDIF)
+
+	return builder.Script()
+}
+
+// CommitSpendAnchor constructs a valid witness allowing a node to spend their
+// anchor output on the commitment transaction using their funding key. This is
+// used for the anchor channel type.
+func CommitSpendAnchor(signer Signer, signDesc *SignDescriptor,
+	sweepTx *wire.MsgTx) (TxWitness, error) {
+
+	if signDesc.KeyDesc.PubKey == nil {
+		return nil, fmt.Errorf("cannot generate witness with nil " +
+			"KeyDesc pubkey")
+	}
+
+	// Create a signature.
+	sweepSig, err := signer.SignOutputRaw(sweepTx, signDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	// The witness here is just a signature and the redeem script.
+	witnessStack := make([][]byte, 2)
+	witnessStack[0] = append(sweepSig, byte(signDesc.HashType))
+	witnessStack[1] = signDesc.WitnessScript
+
+	return witnessStack, nil
+}
+
+// CommitSpendAnchorAnyone constructs a witness allowing anyone to spend the
+// anchor output after it has gotten 16 confirmations. Since no signing is
+// required, only knowledge of the redeem script is necessary to spend it.
+func CommitSpendAnchorAnyone(script []byte) (TxWitness, error) {
+	// The witness here is just the redeem script with an empty item
+	// standing in for the signature.
+	witnessStack := make([][]byte, 2)
+	witnessStack[0] = nil
+	witnessStack[1] = script
+
+	return witnessStack, nil
+}
+
+// SwapHTLCScript constructs the redeem script of an on-chain HTLC used to
+// perform a submarine swap. Unlike the HTLCs of commitment transactions, these
+// outputs aren't part of a channel, so there is no revocation clause: the
+// receiver can claim the output by revealing the preimage of the swap's
+// payment hash, while the sender is able to reclaim the output after the
+// absolute cltvExpiry has passed.
+//
+// Possible Input Scripts:
+//    SUCCESS:	<receiver sig> <preimage>
+//    TIMEOUT:	<sender sig> <emptyvector>
+//
+// Output Script:
+//	OP_SIZE 32 OP_EQUAL
+//	OP_IF
+//		OP_SHA256 OP_RIPEMD160 <RIPEMD160(payment_hash)> OP_EQUALVERIFY
+//		<receiver key>
+//	OP_ELSE
+//		OP_DROP <cltv expiry> OP_CHECKLOCKTIMEVERIFY OP_DROP
+//		<sender key>
+//	OP_ENDIF
+//	OP_CHECKSIG
+func SwapHTLCScript(cltvExpiry uint32, senderKey,
+	receiverKey *secp256k1.PublicKey, paymentHash []byte) ([]byte, error) {
+
+	builder := txscript.NewScriptBuilder()
+
+	// The item on the top of the stack determines which clause is
+	// executed. If it is 32 bytes, then this may be the receiver
+	// attempting to claim the output with the payment pre-image.
+	builder.AddOp(txscript.OP_SIZE)
+	builder.AddInt64(32)
+	builder.AddOp(txscript.OP_EQUAL)
+
+	builder.AddOp(txscript.OP_IF)
+
+	// Hash the item on the top of the stack and ensure it matches the
+	// payment hash, then push the receiver's key for the final checksig.
+	builder.AddOp(txscript.OP_SHA256)
+	builder.AddOp(txscript.OP_RIPEMD160)
+	builder.AddData(Ripemd160H(paymentHash))
+	builder.AddOp(txscript.OP_EQUALVERIFY)
+	builder.AddData(receiverKey.SerializeCompressed())
+
+	// Otherwise, this is the sender reclaiming the output after the
+	// timeout.
+	builder.AddOp(txscript.OP_ELSE)
+
+	// Drop the empty item used to select this clause, enforce the absolute
+	// lock-time and push the sender's key for the final checksig.
+	builder.AddOp(txscript.OP_DROP)
+	builder.AddInt64(int64(cltvExpiry))
+	builder.AddOp(txscript.OP_CHECKLOCKTIMEVERIFY)
+	builder.AddOp(txscript.OP_DROP)
+	builder.AddData(senderKey.SerializeCompressed())
+
+	builder.AddOp(txscript.OP_ENDIF)
+
+	// Both clauses end with the signature check against the key pushed
+	// within the executed branch.
+	builder.AddOp(txscript.OP_CHECKSIG)
+
+	return builder.Script()
+}
+
+// SwapHTLCSpendSuccess constructs a valid witness allowing the receiver of a
+// swap HTLC to claim the output by revealing the payment pre-image.
+func SwapHTLCSpendSuccess(signer Signer, signDesc *SignDescriptor,
+	sweepTx *wire.MsgTx, paymentPreimage []byte) (TxWitness, error) {
+
+	sweepSig, err := signer.SignOutputRaw(sweepTx, signDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	witnessStack := TxWitness(make([][]byte, 3))
+	witnessStack[0] = append(sweepSig, byte(signDesc.HashType))
+	witnessStack[1] = paymentPreimage
+	witnessStack[2] = signDesc.WitnessScript
+
+	return witnessStack, nil
+}
+
+// SwapHTLCSpendTimeout constructs a valid witness allowing the sender of a
+// swap HTLC to reclaim the output after its absolute timeout. If the caller
+// has already set the lock time on the spending transaction, than a value of
+// -1 can be passed for the cltvExpiry value.
+//
+// NOTE: The target input of the passed transaction MUST NOT have a final
+// sequence number. Otherwise, the OP_CHECKLOCKTIMEVERIFY check will fail.
+func SwapHTLCSpendTimeout(signer Signer, signDesc *SignDescriptor,
+	sweepTx *wire.MsgTx, cltvExpiry int32) (TxWitness, error) {
+
+	if cltvExpiry != -1 {
+		sweepTx.LockTime = uint32(cltvExpiry)
+	}
+
+	sweepSig, err := signer.SignOutputRaw(sweepTx, signDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	// The empty item forces script execution into the timeout clause.
+	witnessStack := TxWitness(make([][]byte, 3))
+	witnessStack[0] = append(sweepSig, byte(signDesc.HashType))
+	witnessStack[1] = nil
+	witnessStack[2] = signDesc.WitnessScript
+
+	return witnessStack, nil
+}
+
 // SingleTweakBytes computes set of bytes we call the single tweak. The purpose
 // of the single tweak is to randomize all regular delay and payment base
 // points. To do this, we generate a hash that binds the commitment point to
@@ -5,6 +5,7 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/decred/dcrd/chaincfg/chainhash"
@@ -144,7 +145,7 @@ func TestHTLCSenderSpendValidation(t *testing.T) {
 
 	// Generate the raw HTLC redemption scripts, and its p2wsh counterpart.
 	htlcWitnessScript, err := SenderHTLCScript(aliceLocalKey, bobLocalKey,
-		revocationKey, paymentHash[:])
+		revocationKey, paymentHash[:], false)
 	if err != nil {
 		t.Fatalf("unable to create htlc sender script: %v", err)
 	}
@@ -401,7 +402,7 @@ func TestHTLCReceiverSpendValidation(t *testing.T) {
 
 	// Generate the raw HTLC redemption scripts, and its p2wsh counterpart.
 	htlcWitnessScript, err := ReceiverHTLCScript(cltvTimeout, aliceLocalKey,
-		bobLocalKey, revocationKey, paymentHash[:])
+		bobLocalKey, revocationKey, paymentHash[:], false)
 	if err != nil {
 		t.Fatalf("unable to create htlc sender script: %v", err)
 	}
@@ -985,3 +986,564 @@ func TestReplaceReceiverHtlcSpendRedeemPreimage(t *testing.T) {
 		}
 	}
 }
+
+// TestIsHtlcSpendRevoke asserts that only the spends of an HTLC output through
+// its revocation clause are detected as such.
+func TestIsHtlcSpendRevoke(t *testing.T) {
+	t.Parallel()
+
+	commitSecret, commitPoint := secp256k1.PrivKeyFromBytes(
+		testHdSeed.CloneBytes(),
+	)
+	_, basePub := secp256k1.PrivKeyFromBytes(testWalletPrivKey)
+	revocationPub := DeriveRevocationPubkey(basePub, commitPoint)
+
+	signDesc := &SignDescriptor{
+		KeyDesc: keychain.KeyDescriptor{
+			PubKey: basePub,
+		},
+		DoubleTweak: commitSecret,
+	}
+
+	sig := bytes.Repeat([]byte{0x30}, 71)
+	script := []byte{txscript.OP_DUP}
+	preimage := bytes.Repeat([]byte{0xaa}, 32)
+	revokeKey := revocationPub.SerializeCompressed()
+	otherKey := basePub.SerializeCompressed()
+
+	tests := []struct {
+		name    string
+		witness TxWitness
+		revoke  bool
+	}{
+		{
+			name:    "revocation clause",
+			witness: TxWitness{sig, revokeKey, script},
+			revoke:  true,
+		},
+		{
+			name:    "redeem clause",
+			witness: TxWitness{sig, preimage, script},
+			revoke:  false,
+		},
+		{
+			name:    "second-level success",
+			witness: TxWitness{sig, sig, preimage, script},
+			revoke:  false,
+		},
+		{
+			name:    "other key",
+			witness: TxWitness{sig, otherKey, script},
+			revoke:  false,
+		},
+	}
+
+	for _, tc := range tests {
+		sigScript, err := WitnessStackToSigScript(tc.witness)
+		if err != nil {
+			t.Fatalf("%s: unable to build sig script: %v",
+				tc.name, err)
+		}
+
+		revoke, err := IsHtlcSpendRevoke(
+			&wire.TxIn{SignatureScript: sigScript}, signDesc,
+		)
+		if err != nil {
+			t.Fatalf("%s: unable to check spend: %v", tc.name, err)
+		}
+		if revoke != tc.revoke {
+			t.Fatalf("%s: expected revoke=%v, got %v", tc.name,
+				tc.revoke, revoke)
+		}
+	}
+}
+
+// TestSwapHTLCSpendValidation tests all possible valid+invalid redemption
+// paths of the on-chain HTLC used by submarine swaps.
+func TestSwapHTLCSpendValidation(t *testing.T) {
+	t.Parallel()
+
+	paymentPreimage := testHdSeed.CloneBytes()
+	paymentHash := sha256.Sum256(paymentPreimage)
+
+	senderKeyPriv, senderKeyPub := secp256k1.PrivKeyFromBytes(
+		testWalletPrivKey)
+	receiverKeyPriv, receiverKeyPub := secp256k1.PrivKeyFromBytes(
+		bobsPrivKey)
+	paymentAmt := dcrutil.Amount(1 * 10e8)
+	cltvTimeout := uint32(8)
+
+	htlcRedeemScript, err := SwapHTLCScript(
+		cltvTimeout, senderKeyPub, receiverKeyPub, paymentHash[:],
+	)
+	if err != nil {
+		t.Fatalf("unable to create swap htlc script: %v", err)
+	}
+	htlcPkScript, err := ScriptHashPkScript(htlcRedeemScript)
+	if err != nil {
+		t.Fatalf("unable to create p2sh htlc script: %v", err)
+	}
+
+	// The size estimate of the script must hold for the largest possible
+	// expiry.
+	maxScript, err := SwapHTLCScript(
+		math.MaxUint32, senderKeyPub, receiverKeyPub, paymentHash[:],
+	)
+	if err != nil {
+		t.Fatalf("unable to create swap htlc script: %v", err)
+	}
+	if int64(len(maxScript)) != swapHtlcRedeemScriptSize {
+		t.Fatalf("expected script size %v, got %v",
+			swapHtlcRedeemScriptSize, len(maxScript))
+	}
+
+	htlcOutput := &wire.TxOut{
+		Value:    int64(paymentAmt),
+		PkScript: htlcPkScript,
+		Version:  scriptVersion,
+	}
+
+	sweepTx := wire.NewMsgTx()
+	sweepTx.Version = LNTxVersion
+	sweepTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{
+			Hash:  testHdSeed,
+			Index: 1,
+		},
+	})
+	sweepTx.AddTxOut(
+		&wire.TxOut{
+			PkScript: []byte("doesn't matter"),
+			Value:    1 * 10e8,
+		},
+	)
+
+	senderSigner := &MockSigner{
+		Privkeys: []*secp256k1.PrivateKey{senderKeyPriv},
+	}
+	receiverSigner := &MockSigner{
+		Privkeys: []*secp256k1.PrivateKey{receiverKeyPriv},
+	}
+
+	makeSignDesc := func(pubKey *secp256k1.PublicKey) *SignDescriptor {
+		return &SignDescriptor{
+			KeyDesc: keychain.KeyDescriptor{
+				PubKey: pubKey,
+			},
+			WitnessScript: htlcRedeemScript,
+			Output:        htlcOutput,
+			HashType:      txscript.SigHashAll,
+			InputIndex:    0,
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		witness func() TxWitness
+		valid   bool
+	}{
+		{
+			name: "receiver claims with valid preimage",
+			witness: makeWitnessTestCase(t, func() (TxWitness, error) {
+				return SwapHTLCSpendSuccess(
+					receiverSigner, makeSignDesc(receiverKeyPub),
+					sweepTx, paymentPreimage,
+				)
+			}),
+			valid: true,
+		},
+		{
+			name: "receiver claims with invalid preimage",
+			witness: makeWitnessTestCase(t, func() (TxWitness, error) {
+				return SwapHTLCSpendSuccess(
+					receiverSigner, makeSignDesc(receiverKeyPub),
+					sweepTx, paymentHash[:],
+				)
+			}),
+			valid: false,
+		},
+		{
+			name: "sender claims with preimage",
+			witness: makeWitnessTestCase(t, func() (TxWitness, error) {
+				return SwapHTLCSpendSuccess(
+					senderSigner, makeSignDesc(senderKeyPub),
+					sweepTx, paymentPreimage,
+				)
+			}),
+			valid: false,
+		},
+		{
+			name: "sender reclaims before timeout",
+			witness: makeWitnessTestCase(t, func() (TxWitness, error) {
+				return SwapHTLCSpendTimeout(
+					senderSigner, makeSignDesc(senderKeyPub),
+					sweepTx, int32(cltvTimeout-2),
+				)
+			}),
+			valid: false,
+		},
+		{
+			name: "receiver reclaims after timeout",
+			witness: makeWitnessTestCase(t, func() (TxWitness, error) {
+				return SwapHTLCSpendTimeout(
+					receiverSigner, makeSignDesc(receiverKeyPub),
+					sweepTx, int32(cltvTimeout),
+				)
+			}),
+			valid: false,
+		},
+		{
+			name: "sender reclaims after timeout",
+			witness: makeWitnessTestCase(t, func() (TxWitness, error) {
+				return SwapHTLCSpendTimeout(
+					senderSigner, makeSignDesc(senderKeyPub),
+					sweepTx, int32(cltvTimeout),
+				)
+			}),
+			valid: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		sweepTx.TxIn[0].SignatureScript, err = WitnessStackToSigScript(
+			testCase.witness(),
+		)
+		if err != nil {
+			t.Fatalf("unable to convert witness stack to sigScript: %v", err)
+		}
+
+		vm, err := txscript.NewEngine(htlcPkScript,
+			sweepTx, 0, scriptFlagsForTest, scriptVersion, nil)
+		if err != nil {
+			t.Fatalf("unable to create engine: %v", err)
+		}
+
+		err = vm.Execute()
+		if err != nil && testCase.valid {
+			t.Fatalf("case '%s' failed, spend should be valid: %v",
+				testCase.name, err)
+		} else if err == nil && !testCase.valid {
+			t.Fatalf("case '%s' succeeded, spend should be invalid",
+				testCase.name)
+		}
+	}
+}
+
+// TestCommitToRemoteConfirmedSpendValidation tests that the to-remote output
+// of channels with anchors can only be spent by the owner of the key after it
+// has been confirmed for one block.
+func TestCommitToRemoteConfirmedSpendValidation(t *testing.T) {
+	t.Parallel()
+
+	aliceKeyPriv, aliceKeyPub := secp256k1.PrivKeyFromBytes(testWalletPrivKey)
+	bobKeyPriv, _ := secp256k1.PrivKeyFromBytes(bobsPrivKey)
+	paymentAmt := dcrutil.Amount(1 * 10e8)
+
+	redeemScript, err := CommitScriptToRemoteConfirmed(aliceKeyPub)
+	if err != nil {
+		t.Fatalf("unable to create to-remote script: %v", err)
+	}
+	if int64(len(redeemScript)) != toRemoteConfirmedRedeemScriptSize {
+		t.Fatalf("expected script size %v, got %v",
+			toRemoteConfirmedRedeemScriptSize, len(redeemScript))
+	}
+	pkScript, err := ScriptHashPkScript(redeemScript)
+	if err != nil {
+		t.Fatalf("unable to create p2sh script: %v", err)
+	}
+
+	output := &wire.TxOut{
+		Value:    int64(paymentAmt),
+		PkScript: pkScript,
+		Version:  scriptVersion,
+	}
+
+	sweepTx := wire.NewMsgTx()
+	sweepTx.Version = LNTxVersion
+	sweepTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{
+			Hash:  testHdSeed,
+			Index: 1,
+		},
+	})
+	sweepTx.AddTxOut(
+		&wire.TxOut{
+			PkScript: []byte("doesn't matter"),
+			Value:    1 * 10e8,
+		},
+	)
+
+	signDesc := &SignDescriptor{
+		KeyDesc: keychain.KeyDescriptor{
+			PubKey: aliceKeyPub,
+		},
+		WitnessScript: redeemScript,
+		Output:        output,
+		HashType:      txscript.SigHashAll,
+		InputIndex:    0,
+	}
+	aliceSigner := &MockSigner{Privkeys: []*secp256k1.PrivateKey{aliceKeyPriv}}
+	bobSigner := &MockSigner{Privkeys: []*secp256k1.PrivateKey{bobKeyPriv}}
+
+	testCases := []struct {
+		name     string
+		signer   Signer
+		sequence uint32
+		valid    bool
+	}{
+		{
+			name:     "owner spends without confirmation",
+			signer:   aliceSigner,
+			sequence: 0,
+			valid:    false,
+		},
+		{
+			name:     "owner spends after confirmation",
+			signer:   aliceSigner,
+			sequence: 1,
+			valid:    true,
+		},
+		{
+			name:     "other key spends after confirmation",
+			signer:   bobSigner,
+			sequence: 1,
+			valid:    false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		sweepTx.TxIn[0].Sequence = testCase.sequence
+		witness, err := CommitSpendToRemoteConfirmed(
+			testCase.signer, signDesc, sweepTx,
+		)
+		if err != nil {
+			t.Fatalf("unable to create witness: %v", err)
+		}
+		sweepTx.TxIn[0].SignatureScript, err = WitnessStackToSigScript(
+			witness,
+		)
+		if err != nil {
+			t.Fatalf("unable to convert witness stack to sigScript: %v", err)
+		}
+
+		vm, err := txscript.NewEngine(pkScript,
+			sweepTx, 0, scriptFlagsForTest, scriptVersion, nil)
+		if err != nil {
+			t.Fatalf("unable to create engine: %v", err)
+		}
+
+		err = vm.Execute()
+		if err != nil && testCase.valid {
+			t.Fatalf("case '%s' failed, spend should be valid: %v",
+				testCase.name, err)
+		} else if err == nil && !testCase.valid {
+			t.Fatalf("case '%s' succeeded, spend should be invalid",
+				testCase.name)
+		}
+	}
+}
+
+// TestHTLCConfirmedSpendValidation tests that the non-revocation paths of the
+// HTLC scripts of channels with anchors require a confirmation, while the
+// revocation paths remain spendable immediately.
+func TestHTLCConfirmedSpendValidation(t *testing.T) {
+	t.Parallel()
+
+	revokePreimage := testHdSeed.CloneBytes()
+	commitSecret, commitPoint := secp256k1.PrivKeyFromBytes(revokePreimage)
+
+	paymentPreimage := revokePreimage
+	paymentPreimage[0] ^= 1
+	paymentHash := sha256.Sum256(paymentPreimage)
+
+	_, aliceKeyPub := secp256k1.PrivKeyFromBytes(testWalletPrivKey)
+	bobKeyPriv, bobKeyPub := secp256k1.PrivKeyFromBytes(bobsPrivKey)
+	paymentAmt := dcrutil.Amount(1 * 10e8)
+	cltvTimeout := uint32(8)
+
+	aliceLocalKey := TweakPubKey(aliceKeyPub, commitPoint)
+	bobLocalKey := TweakPubKey(bobKeyPub, commitPoint)
+	revocationKey := DeriveRevocationPubkey(bobKeyPub, commitPoint)
+
+	// Alice offers an HTLC to Bob on her commitment, and receives one from
+	// him. Bob can redeem the former with the preimage and time out the
+	// latter, or revoke both.
+	senderScript, err := SenderHTLCScript(aliceLocalKey, bobLocalKey,
+		revocationKey, paymentHash[:], true)
+	if err != nil {
+		t.Fatalf("unable to create htlc sender script: %v", err)
+	}
+	if int64(len(senderScript)) != offeredHtlcRedeemScriptSize+htlcConfirmedSpendSize {
+		t.Fatalf("expected script size %v, got %v",
+			offeredHtlcRedeemScriptSize+htlcConfirmedSpendSize,
+			len(senderScript))
+	}
+	receiverScript, err := ReceiverHTLCScript(cltvTimeout, bobLocalKey,
+		aliceLocalKey, revocationKey, paymentHash[:], true)
+	if err != nil {
+		t.Fatalf("unable to create htlc receiver script: %v", err)
+	}
+	if !IsConfirmedSpendHTLCScript(senderScript) ||
+		!IsConfirmedSpendHTLCScript(receiverScript) {
+
+		t.Fatalf("expected scripts to require a confirmation")
+	}
+	unconfirmedScript, err := SenderHTLCScript(aliceLocalKey, bobLocalKey,
+		revocationKey, paymentHash[:], false)
+	if err != nil {
+		t.Fatalf("unable to create htlc sender script: %v", err)
+	}
+	if IsConfirmedSpendHTLCScript(unconfirmedScript) {
+		t.Fatalf("expected script to not require a confirmation")
+	}
+
+	sweepTx := wire.NewMsgTx()
+	sweepTx.Version = LNTxVersion
+	sweepTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{
+			Hash:  testHdSeed,
+			Index: 1,
+		},
+	})
+	sweepTx.AddTxOut(
+		&wire.TxOut{
+			PkScript: []byte("doesn't matter"),
+			Value:    1 * 10e8,
+		},
+	)
+
+	bobSigner := &MockSigner{Privkeys: []*secp256k1.PrivateKey{bobKeyPriv}}
+
+	makeSignDesc := func(script []byte, pubKey *secp256k1.PublicKey,
+		tweak []byte, doubleTweak *secp256k1.PrivateKey) *SignDescriptor {
+
+		pkScript, err := ScriptHashPkScript(script)
+		if err != nil {
+			t.Fatalf("unable to create p2sh script: %v", err)
+		}
+
+		return &SignDescriptor{
+			KeyDesc: keychain.KeyDescriptor{
+				PubKey: pubKey,
+			},
+			SingleTweak:   tweak,
+			DoubleTweak:   doubleTweak,
+			WitnessScript: script,
+			Output: &wire.TxOut{
+				Value:    int64(paymentAmt),
+				PkScript: pkScript,
+				Version:  scriptVersion,
+			},
+			HashType:   txscript.SigHashAll,
+			InputIndex: 0,
+		}
+	}
+	bobTweak := SingleTweakBytes(commitPoint, bobKeyPub)
+
+	testCases := []struct {
+		name     string
+		sequence uint32
+		signDesc *SignDescriptor
+		witness  func(*SignDescriptor) (TxWitness, error)
+		valid    bool
+	}{
+		{
+			name:     "offered htlc redeemed without confirmation",
+			sequence: 0,
+			signDesc: makeSignDesc(senderScript, bobKeyPub, bobTweak, nil),
+			witness: func(desc *SignDescriptor) (TxWitness, error) {
+				return SenderHtlcSpendRedeem(bobSigner, desc,
+					sweepTx, paymentPreimage)
+			},
+			valid: false,
+		},
+		{
+			name:     "offered htlc redeemed after confirmation",
+			sequence: 1,
+			signDesc: makeSignDesc(senderScript, bobKeyPub, bobTweak, nil),
+			witness: func(desc *SignDescriptor) (TxWitness, error) {
+				return SenderHtlcSpendRedeem(bobSigner, desc,
+					sweepTx, paymentPreimage)
+			},
+			valid: true,
+		},
+		{
+			name:     "offered htlc revoked without confirmation",
+			sequence: 0,
+			signDesc: makeSignDesc(
+				senderScript, bobKeyPub, nil, commitSecret,
+			),
+			witness: func(desc *SignDescriptor) (TxWitness, error) {
+				return SenderHtlcSpendRevoke(bobSigner, desc,
+					sweepTx)
+			},
+			valid: true,
+		},
+		{
+			name:     "accepted htlc timed out without confirmation",
+			sequence: 0,
+			signDesc: makeSignDesc(
+				receiverScript, bobKeyPub, bobTweak, nil,
+			),
+			witness: func(desc *SignDescriptor) (TxWitness, error) {
+				return ReceiverHtlcSpendTimeout(bobSigner,
+					desc, sweepTx, int32(cltvTimeout))
+			},
+			valid: false,
+		},
+		{
+			name:     "accepted htlc timed out after confirmation",
+			sequence: 1,
+			signDesc: makeSignDesc(
+				receiverScript, bobKeyPub, bobTweak, nil,
+			),
+			witness: func(desc *SignDescriptor) (TxWitness, error) {
+				return ReceiverHtlcSpendTimeout(bobSigner,
+					desc, sweepTx, int32(cltvTimeout))
+			},
+			valid: true,
+		},
+		{
+			name:     "accepted htlc revoked without confirmation",
+			sequence: 0,
+			signDesc: makeSignDesc(
+				receiverScript, bobKeyPub, nil, commitSecret,
+			),
+			witness: func(desc *SignDescriptor) (TxWitness, error) {
+				return ReceiverHtlcSpendRevoke(bobSigner, desc,
+					sweepTx)
+			},
+			valid: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		sweepTx.LockTime = 0
+		sweepTx.TxIn[0].Sequence = testCase.sequence
+		witness, err := testCase.witness(testCase.signDesc)
+		if err != nil {
+			t.Fatalf("unable to create witness: %v", err)
+		}
+		sweepTx.TxIn[0].SignatureScript, err = WitnessStackToSigScript(
+			witness,
+		)
+		if err != nil {
+			t.Fatalf("unable to convert witness stack to sigScript: %v", err)
+		}
+
+		vm, err := txscript.NewEngine(testCase.signDesc.Output.PkScript,
+			sweepTx, 0, scriptFlagsForTest, scriptVersion, nil)
+		if err != nil {
+			t.Fatalf("unable to create engine: %v", err)
+		}
+
+		err = vm.Execute()
+		if err != nil && testCase.valid {
+			t.Fatalf("case '%s' failed, spend should be valid: %v",
+				testCase.name, err)
+		} else if err == nil && !testCase.valid {
+			t.Fatalf("case '%s' succeeded, spend should be invalid",
+				testCase.name)
+		}
+	}
+}
@@ -149,6 +149,45 @@ const (
 	// full 5 bytes (which is the maximum used by OP_CHECKSEQUENCEVERIFY).
 	toLocalRedeemScriptSize int64 = 1 + 1 + 33 + 1 + 1 + 5 + 1 + 1 + 1 + 33 + 1 + 1
 
+	// anchorRedeemScriptSize is the size of the redeemScript used in the
+	// anchor outputs of commitment transactions. It is calculated as:
+	//
+	//		- OP_DATA_33                          1 byte
+	//		- funding_key                        33 bytes
+	//		- OP_CHECKSIG                         1 byte
+	//		- OP_IFDUP                            1 byte
+	//		- OP_NOTIF                            1 byte
+	//		    - OP_16                           1 byte
+	//		    - OP_CHECKSEQUENCEVERIFY          1 byte
+	//		- OP_ENDIF                            1 byte
+	//
+	// Total: 40 bytes
+	anchorRedeemScriptSize int64 = 1 + 33 + 1 + 1 + 1 + 1 + 1 + 1
+
+	// toRemoteConfirmedRedeemScriptSize is the size of the redeemScript
+	// used in the output paying to the remote party of the commitment
+	// transaction of channels with anchor outputs. It is calculated as:
+	//
+	//		- OP_DATA_33                          1 byte
+	//		- remote_key                         33 bytes
+	//		- OP_CHECKSIGVERIFY                   1 byte
+	//		- OP_1                                1 byte
+	//		- OP_CHECKSEQUENCEVERIFY              1 byte
+	//
+	// Total: 37 bytes
+	toRemoteConfirmedRedeemScriptSize int64 = 1 + 33 + 1 + 1 + 1
+
+	// htlcConfirmedSpendSize is the size of the 1 block CSV delay added to
+	// the non-revocation clauses of the HTLC redeemScripts of channels with
+	// anchor outputs. It is calculated as:
+	//
+	//		- OP_1                                1 byte
+	//		- OP_CHECKSEQUENCEVERIFY              1 byte
+	//		- OP_DROP                             1 byte
+	//
+	// Total: 3 bytes
+	htlcConfirmedSpendSize int64 = 1 + 1 + 1
+
 	// acceptedHtlcRedeemScriptSize is the worst (largest) size of a
 	// redeemScript used by the local node when receiving payment via an HTLC
 	// output. In BOLT03 this is called a "Received HTLC Output".
@@ -243,6 +282,40 @@ const (
 	// Total: 133 bytes
 	offeredHtlcRedeemScriptSize int64 = 3*1 + 20 + 5*1 + 33 + 10*1 + 33 + 6*1 + 20 + 4*1
 
+	// swapHtlcRedeemScriptSize is the worst (largest) size of a redeemScript
+	// used by on-chain HTLCs of submarine swaps.
+	//
+	// Currently generated by SwapHTLCScript().
+	//
+	// This is calculated as:
+	//
+	//		- OP_SIZE                                    1 byte
+	//		- OP_DATA_1                                  1 byte
+	//		- 32                                         1 byte
+	//		- OP_EQUAL                                   1 byte
+	//		- OP_IF                                      1 byte
+	//		        - OP_SHA256                          1 byte
+	//		        - OP_RIPEMD160                       1 byte
+	//		        - OP_DATA_20                         1 byte
+	//		        - RIPEMD160(payment_hash)           20 bytes
+	//		        - OP_EQUALVERIFY                     1 byte
+	//		        - OP_DATA_33                         1 byte
+	//		        - receiver_key                      33 bytes
+	//		- OP_ELSE                                    1 byte
+	//		        - OP_DROP                            1 byte
+	//		        - OP_DATA_5                          1 byte
+	//		        - cltv_expiry                        5 bytes
+	//		        - OP_CHECKLOCKTIMEVERIFY             1 byte
+	//		        - OP_DROP                            1 byte
+	//		        - OP_DATA_33                         1 byte
+	//		        - sender_key                        33 bytes
+	//		- OP_ENDIF                                   1 byte
+	//		- OP_CHECKSIG                                1 byte
+	//
+	// Total: 109 bytes
+	swapHtlcRedeemScriptSize int64 = 8*1 + 20 + 2*1 + 33 + 3*1 + 5 + 3*1 +
+		33 + 2*1
+
 	// The following *SigScript constants record sizes for various types of
 	// LN-specific sigScripts, spending outputs that use one of the custom
 	// redeem scripts. These constants are the sum of the script data push plus
@@ -298,6 +371,30 @@ const (
 	ToLocalPenaltySigScriptSize int64 = 1 + 73 + 1 + 1 + 1 +
 		toLocalRedeemScriptSize
 
+	// AnchorSigScriptSize is the size of a sigScript used when redeeming an
+	// anchor output using the funding key.
+	//
+	//		- OP_DATA_73                      1 byte
+	//		- funding_sig+hash_type          73 bytes
+	//		- OP_DATA_40                      1 byte
+	//		- anchor script                  40 bytes
+	//
+	// Total: 115 bytes
+	AnchorSigScriptSize int64 = 1 + 73 + 1 + anchorRedeemScriptSize
+
+	// ToRemoteConfirmedSigScriptSize is the size of a sigScript used when
+	// redeeming the output paying to us on the remote commitment
+	// transaction of a channel with anchor outputs.
+	//
+	//		- OP_DATA_73                      1 byte
+	//		- sig+hash_type                  73 bytes
+	//		- OP_DATA_37                      1 byte
+	//		- to_remote_confirmed script     37 bytes
+	//
+	// Total: 112 bytes
+	ToRemoteConfirmedSigScriptSize int64 = 1 + 73 + 1 +
+		toRemoteConfirmedRedeemScriptSize
+
 	// AcceptedHtlcTimeoutSigScriptSize is the size of a sigScript used
 	// when redeeming an acceptedHtlcScript using the "timeout" code path.
 	//
@@ -312,6 +409,15 @@ const (
 	AcceptedHtlcTimeoutSigScriptSize int64 = 1 + 73 + 1 + 1 + 1 +
 		acceptedHtlcRedeemScriptSize
 
+	// AcceptedHtlcTimeoutConfirmedSigScriptSize is the size of a sigScript
+	// used when redeeming an acceptedHtlcScript of a channel with anchor
+	// outputs using the "timeout" code path. It is 3 bytes larger than
+	// AcceptedHtlcTimeoutSigScriptSize due to the 1 block CSV delay.
+	//
+	// Total: 220 bytes
+	AcceptedHtlcTimeoutConfirmedSigScriptSize int64 = AcceptedHtlcTimeoutSigScriptSize +
+		htlcConfirmedSpendSize
+
 	// AcceptedHtlcSuccessSigScriptSize is the size of a sigScript used
 	// when redeeming an acceptedHtlcScript using the "success" code path.
 	//
@@ -344,6 +450,14 @@ const (
 	AcceptedHtlcPenaltySigScriptSize int64 = 1 + 73 + 1 + 33 + 1 + 1 +
 		acceptedHtlcRedeemScriptSize
 
+	// AcceptedHtlcPenaltyConfirmedSigScriptSize is the size of a sigScript
+	// used when redeeming an acceptedHtlcScript of a channel with anchor
+	// outputs using the "penalty" code path.
+	//
+	// Total: 253 bytes
+	AcceptedHtlcPenaltyConfirmedSigScriptSize int64 = AcceptedHtlcPenaltySigScriptSize +
+		htlcConfirmedSpendSize
+
 	// OfferedHtlcTimeoutSigScriptSize is the size of a sigScript used
 	// when redeeming an offeredHtlcScript using the "timeout" code path.
 	//
@@ -377,6 +491,14 @@ const (
 	OfferedHtlcSuccessSigScriptSize int64 = 1 + 73 + 1 + 73 + 1 + 32 +
 		1 + 1 + offeredHtlcRedeemScriptSize
 
+	// OfferedHtlcSuccessConfirmedSigScriptSize is the size of a sigScript
+	// used when redeeming an offeredHtlcScript of a channel with anchor
+	// outputs using the "success" code path.
+	//
+	// Total: 319 bytes
+	OfferedHtlcSuccessConfirmedSigScriptSize int64 = OfferedHtlcSuccessSigScriptSize +
+		htlcConfirmedSpendSize
+
 	// OfferedHtlcPenaltySigScriptSize is the size of a sigScript used
 	// when redeeming an offeredHtlcScript using the "penalty" code path.
 	//
@@ -392,6 +514,43 @@ const (
 	OfferedHtlcPenaltySigScriptSize int64 = 1 + 73 + 1 + 33 + 1 + 1 +
 		offeredHtlcRedeemScriptSize
 
+	// OfferedHtlcPenaltyConfirmedSigScriptSize is the size of a sigScript
+	// used when redeeming an offeredHtlcScript of a channel with anchor
+	// outputs using the "penalty" code path.
+	//
+	// Total: 246 bytes
+	OfferedHtlcPenaltyConfirmedSigScriptSize int64 = OfferedHtlcPenaltySigScriptSize +
+		htlcConfirmedSpendSize
+
+	// SwapHtlcSuccessSigScriptSize is the size of a sigScript used when
+	// redeeming a swapHtlcScript using the "success" code path.
+	//
+	//		- OP_DATA_73                      1 byte
+	//		- receiver_sig+hash_type         73 bytes
+	//		- OP_DATA_32                      1 byte
+	//		- payment_preimage               32 bytes
+	//		- OP_PUSHDATA1                    1 byte
+	//		- 109                             1 byte
+	//		- swap_htlc script              109 bytes
+	//
+	// Total: 218 bytes
+	SwapHtlcSuccessSigScriptSize int64 = 1 + 73 + 1 + 32 + 1 + 1 +
+		swapHtlcRedeemScriptSize
+
+	// SwapHtlcTimeoutSigScriptSize is the size of a sigScript used when
+	// redeeming a swapHtlcScript using the "timeout" code path.
+	//
+	//		- OP_DATA_73                      1 byte
+	//		- sender_sig+hash_type           73 bytes
+	//		- OP_0                            1 byte
+	//		- OP_PUSHDATA1                    1 byte
+	//		- 109                             1 byte
+	//		- swap_htlc script              109 bytes
+	//
+	// Total: 186 bytes
+	SwapHtlcTimeoutSigScriptSize int64 = 1 + 73 + 1 + 1 + 1 +
+		swapHtlcRedeemScriptSize
+
 	// The following constants record pre-calculated inputs, outputs and
 	// transaction sizes for common transactions found in the LN ecosystem.
 
@@ -433,6 +592,26 @@ const (
 		OutputSize + 1 + P2PKHPkScriptSize + OutputSize + 1 + P2SHPkScriptSize +
 		1 + 1 + FundingOutputSigScriptSize
 
+	// AnchorOutputSize is the size of an anchor output (a p2sh output) used
+	// in commitment transactions.
+	//
+	//		- Output (value+version)        10 bytes
+	//		- pkscript varint                1 byte
+	//		- p2sh pkscript                 23 bytes
+	//
+	// Total: 34 bytes
+	AnchorOutputSize int64 = OutputSize + 1 + P2SHPkScriptSize
+
+	// AnchorCommitmentTxSize is the base size of a commitment transaction
+	// that carries anchor outputs for both parties, without any HTLCs.
+	//
+	//		- base commitment tx                      364 bytes
+	//		- local anchor output                      34 bytes
+	//		- remote anchor output                     34 bytes
+	//
+	// Total: 432 bytes
+	AnchorCommitmentTxSize int64 = CommitmentTxSize + 2*AnchorOutputSize
+
 	// HTLCTimeoutSize is the worst case (largest) size of the HTLC timeout
 	// transaction which will transition an outgoing HTLC to the
 	// delay-and-claim state. The worst case for a timeout transaction is
@@ -451,6 +630,12 @@ const (
 	//		- offered_htlc_timeout sigscript                  284 bytes
 	//
 	// Total: 392 bytes
+	//
+	// NOTE: The HTLC scripts of channels with anchor outputs are 3 bytes
+	// larger due to their 1 block CSV delay. The fees of their second level
+	// transactions are still computed using this size and HTLCSuccessTxSize,
+	// so that both parties keep agreeing on the fees regardless of the
+	// channel type.
 	// TODO(decred) Double check correctness of selected sigScript alternative
 	HTLCTimeoutTxSize int64 = baseTxSize + 1 + InputSize + 1 + OutputSize + 1 +
 		P2SHPkScriptSize + 1 + 2 + OfferedHtlcTimeoutSigScriptSize
@@ -582,6 +767,17 @@ func (twe *TxSizeEstimator) AddP2SHOutput() *TxSizeEstimator {
 	return twe
 }
 
+// AddTxOutput updates the size estimate to account for the passed output,
+// whatever its script.
+func (twe *TxSizeEstimator) AddTxOutput(txOut *wire.TxOut) *TxSizeEstimator {
+	pkScriptSize := int64(len(txOut.PkScript))
+	scriptLenSerSize := int64(wire.VarIntSerializeSize(uint64(pkScriptSize)))
+	twe.OutputSize += OutputSize + scriptLenSerSize + pkScriptSize
+	twe.outputCount++
+
+	return twe
+}
+
 // Size gets the estimated size of the transaction.
 func (twe *TxSizeEstimator) Size() int64 {
 	return baseTxSize +
@@ -0,0 +1,131 @@
+package input
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/decred/dcrd/wire"
+)
+
+// MinRegisteredWitnessType is the smallest value of a witness type that can
+// be registered through RegisterWitnessType. Lower values are reserved for the
+// witness types defined within this package, so that new commitment formats
+// added here don't conflict with registered types.
+const MinRegisteredWitnessType WitnessType = 0x8000
+
+var (
+	// ErrWitnessTypeReserved is returned when attempting to register a
+	// witness type below MinRegisteredWitnessType.
+	ErrWitnessTypeReserved = errors.New("witness type is reserved")
+
+	// ErrWitnessTypeRegistered is returned when attempting to register a
+	// witness type that was already registered.
+	ErrWitnessTypeRegistered = errors.New("witness type already " +
+		"registered")
+
+	// ErrWitnessScriptMismatch is returned when generating the witness of a
+	// registered witness type for an output whose witness script doesn't
+	// match the script template of the type.
+	ErrWitnessScriptMismatch = errors.New("witness script doesn't match " +
+		"the script template of the witness type")
+)
+
+// WitnessTemplate describes how outputs of a witness type that isn't defined
+// within this package are spent, allowing subsystems to add new output
+// scripts to be swept without modifying the code generating the witnesses and
+// estimating the size of the sweeping transactions.
+type WitnessTemplate struct {
+	// Name is the human readable name of the witness type.
+	Name string
+
+	// MatchScript returns whether the passed witness script of an output
+	// matches the script template of the witness type. If nil, any
+	// witness script is accepted.
+	MatchScript func(witnessScript []byte) bool
+
+	// GenWitness generates the witness spending the output described by
+	// the passed sign descriptor, for the input of the transaction found
+	// at the input index of the descriptor.
+	GenWitness func(signer Signer, signDesc *SignDescriptor,
+		tx *wire.MsgTx) (TxWitness, error)
+
+	// SigScriptSize is the upper bound of the size of the sig script
+	// generated from the witness.
+	SigScriptSize int64
+
+	// CsvLocked denotes whether the outputs of the witness type are
+	// encumbered by a relative lock-time.
+	CsvLocked bool
+
+	// CltvLocked denotes whether the outputs of the witness type are
+	// encumbered by an absolute lock-time.
+	CltvLocked bool
+}
+
+var (
+	// witnessRegistryMtx guards witnessRegistry.
+	witnessRegistryMtx sync.RWMutex
+
+	// witnessRegistry holds the templates of all registered witness types.
+	witnessRegistry = make(map[WitnessType]*WitnessTemplate)
+)
+
+// RegisterWitnessType registers the template describing how outputs of the
+// passed witness type are spent. The witness type must not be lower than
+// MinRegisteredWitnessType, and can only be registered once.
+func RegisterWitnessType(wt WitnessType, tmpl *WitnessTemplate) error {
+	if wt < MinRegisteredWitnessType {
+		return ErrWitnessTypeReserved
+	}
+	if tmpl.GenWitness == nil {
+		return fmt.Errorf("witness type %v has no witness generator",
+			uint32(wt))
+	}
+
+	witnessRegistryMtx.Lock()
+	defer witnessRegistryMtx.Unlock()
+
+	if _, ok := witnessRegistry[wt]; ok {
+		return ErrWitnessTypeRegistered
+	}
+
+	witnessRegistry[wt] = tmpl
+
+	return nil
+}
+
+// LookupWitnessTemplate returns the template of the passed witness type, if it
+// was registered.
+func LookupWitnessTemplate(wt WitnessType) (*WitnessTemplate, bool) {
+	witnessRegistryMtx.RLock()
+	defer witnessRegistryMtx.RUnlock()
+
+	tmpl, ok := witnessRegistry[wt]
+	return tmpl, ok
+}
+
+// genRegisteredWitness generates the input script spending an output of a
+// registered witness type.
+func genRegisteredWitness(wt WitnessType, signer Signer,
+	signDesc *SignDescriptor, tx *wire.MsgTx) (*Script, error) {
+
+	tmpl, ok := LookupWitnessTemplate(wt)
+	if !ok {
+		return nil, fmt.Errorf("unknown witness type: %v", wt)
+	}
+
+	script := signDesc.WitnessScript
+	if tmpl.MatchScript != nil && !tmpl.MatchScript(script) {
+		return nil, ErrWitnessScriptMismatch
+	}
+
+	witness, err := tmpl.GenWitness(signer, signDesc, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Script{
+		Witness: witness,
+	}, nil
+}
@@ -0,0 +1,84 @@
+package input
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/decred/dcrd/wire"
+)
+
+// TestRegisterWitnessType asserts that registered witness types are used to
+// generate the witness of their outputs, and that reserved or duplicate
+// witness types can't be registered.
+func TestRegisterWitnessType(t *testing.T) {
+	t.Parallel()
+
+	const testWitnessType = MinRegisteredWitnessType + 1
+
+	script := []byte{0x51}
+	tmpl := &WitnessTemplate{
+		Name: "TestWitnessType",
+		MatchScript: func(witnessScript []byte) bool {
+			return bytes.Equal(witnessScript, script)
+		},
+		GenWitness: func(signer Signer, signDesc *SignDescriptor,
+			tx *wire.MsgTx) (TxWitness, error) {
+
+			return TxWitness{signDesc.WitnessScript}, nil
+		},
+		SigScriptSize: 2,
+	}
+
+	// Types reserved for the witness types of this package can't be
+	// registered.
+	err := RegisterWitnessType(CommitmentAnchor, tmpl)
+	if err != ErrWitnessTypeReserved {
+		t.Fatalf("expected ErrWitnessTypeReserved, got %v", err)
+	}
+
+	if err := RegisterWitnessType(testWitnessType, tmpl); err != nil {
+		t.Fatalf("unable to register witness type: %v", err)
+	}
+
+	err = RegisterWitnessType(testWitnessType, tmpl)
+	if err != ErrWitnessTypeRegistered {
+		t.Fatalf("expected ErrWitnessTypeRegistered, got %v", err)
+	}
+
+	if testWitnessType.String() != tmpl.Name {
+		t.Fatalf("expected name %v, got %v", tmpl.Name,
+			testWitnessType.String())
+	}
+
+	// The witness of an output matching the script template is generated
+	// by the registered witness generator.
+	tx := wire.NewMsgTx()
+	signDesc := &SignDescriptor{
+		WitnessScript: script,
+	}
+	genWitness := testWitnessType.GenWitnessFunc(nil, signDesc)
+	inputScript, err := genWitness(tx, 0)
+	if err != nil {
+		t.Fatalf("unable to generate witness: %v", err)
+	}
+	if len(inputScript.Witness) != 1 ||
+		!bytes.Equal(inputScript.Witness[0], script) {
+
+		t.Fatalf("unexpected witness: %x", inputScript.Witness)
+	}
+
+	// Outputs that don't match the script template are rejected.
+	signDesc = &SignDescriptor{
+		WitnessScript: []byte{0x52},
+	}
+	genWitness = testWitnessType.GenWitnessFunc(nil, signDesc)
+	if _, err := genWitness(tx, 0); err != ErrWitnessScriptMismatch {
+		t.Fatalf("expected ErrWitnessScriptMismatch, got %v", err)
+	}
+
+	// Unknown witness types still fail to generate a witness.
+	genWitness = (testWitnessType + 1).GenWitnessFunc(nil, signDesc)
+	if _, err := genWitness(tx, 0); err == nil {
+		t.Fatalf("expected failure for unknown witness type")
+	}
+}
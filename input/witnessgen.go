@@ -89,10 +89,34 @@ const (
 	// future new types added to the upstream lnd project.
 	PublicKeyHash WitnessType = 901
 
+	// SwapHtlcSuccess is a witness that allows us to sweep the on-chain
+	// HTLC of a submarine swap for which we are the receiver, by revealing
+	// the payment preimage.
+	//
+	// NOTE(decred): This type is specific to dcrlnd.
+	SwapHtlcSuccess WitnessType = 902
+
+	// SwapHtlcTimeout is a witness that allows us to reclaim the on-chain
+	// HTLC of a submarine swap for which we are the sender, after the
+	// absolute CLTV timeout of the HTLC has passed.
+	//
+	// NOTE(decred): This type is specific to dcrlnd.
+	SwapHtlcTimeout WitnessType = 903
+
 	// CommitSpendNoDelayTweakless is similar to the CommitSpendNoDelay
 	// type, but it omits the tweak that randomizes the key we need to
 	// spend with a channel peer supplied set of randomness.
 	CommitSpendNoDelayTweakless = 12
+
+	// CommitmentAnchor is a witness that allows us to spend our anchor on
+	// the commitment transaction.
+	CommitmentAnchor WitnessType = 13
+
+	// CommitmentToRemoteConfirmed is a witness that allows us to spend our
+	// output on the counterparty's commitment transaction after a
+	// confirmation. This is used for the output paying to us on the
+	// commitment transaction of channels with anchor outputs.
+	CommitmentToRemoteConfirmed WitnessType = 14
 )
 
 // Stirng returns a human readable version of the target WitnessType.
@@ -131,7 +155,23 @@ func (wt WitnessType) String() string {
 	case HtlcSecondLevelRevoke:
 		return "HtlcSecondLevelRevoke"
 
+	case CommitmentAnchor:
+		return "CommitmentAnchor"
+
+	case CommitmentToRemoteConfirmed:
+		return "CommitmentToRemoteConfirmed"
+
+	case SwapHtlcSuccess:
+		return "SwapHtlcSuccess"
+
+	case SwapHtlcTimeout:
+		return "SwapHtlcTimeout"
+
 	default:
+		if tmpl, ok := LookupWitnessTemplate(wt); ok {
+			return tmpl.Name
+		}
+
 		return fmt.Sprintf("Unknown WitnessType: %v", uint32(wt))
 	}
 }
@@ -183,6 +223,18 @@ func (wt WitnessType) GenWitnessFunc(signer Signer,
 				Witness: witness,
 			}, nil
 
+		case CommitmentToRemoteConfirmed:
+			witness, err := CommitSpendToRemoteConfirmed(
+				signer, desc, tx,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			return &Script{
+				Witness: witness,
+			}, nil
+
 		case CommitmentRevoke:
 			witness, err := CommitSpendRevoke(signer, desc, tx)
 			if err != nil {
@@ -256,6 +308,29 @@ func (wt WitnessType) GenWitnessFunc(signer Signer,
 				Witness: witness,
 			}, nil
 
+		case CommitmentAnchor:
+			witness, err := CommitSpendAnchor(signer, desc, tx)
+			if err != nil {
+				return nil, err
+			}
+
+			return &Script{
+				Witness: witness,
+			}, nil
+
+		case SwapHtlcTimeout:
+			// We pass in a value of -1 for the timeout, as we
+			// expect the caller to have already set the lock time
+			// value.
+			witness, err := SwapHTLCSpendTimeout(signer, desc, tx, -1)
+			if err != nil {
+				return nil, err
+			}
+
+			return &Script{
+				Witness: witness,
+			}, nil
+
 		case WitnessKeyHash:
 			fallthrough
 
@@ -265,8 +340,10 @@ func (wt WitnessType) GenWitnessFunc(signer Signer,
 		case PublicKeyHash:
 			return signer.ComputeInputScript(tx, desc)
 
+		// Witness types that aren't defined within this package may
+		// have been registered along with their witness generator.
 		default:
-			return nil, fmt.Errorf("unknown witness type: %v", wt)
+			return genRegisteredWitness(wt, signer, desc, tx)
 		}
 	}
 
@@ -0,0 +1,281 @@
+package invoices
+
+import (
+	"container/heap"
+	"sync"
+	"time"
+
+	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/clock"
+	"github.com/decred/dcrlnd/lntypes"
+)
+
+const (
+	// defaultInvoiceExpiry is the expiry of invoices that don't specify
+	// one, matching the default implied by payment requests.
+	defaultInvoiceExpiry = 3600 * time.Second
+
+	// DefaultInvoiceGCInterval is the default interval at which canceled
+	// invoices that fell out of the retention window are deleted.
+	DefaultInvoiceGCInterval = time.Hour
+)
+
+// invoiceExpiry is the point in time at which an open invoice expires.
+type invoiceExpiry struct {
+	paymentHash lntypes.Hash
+	expiry      time.Time
+}
+
+// expiryHeap is a min-heap of invoice expiries, the invoice that expires
+// first being at the top.
+type expiryHeap []invoiceExpiry
+
+// Len returns the number of invoices in the heap.
+//
+// NOTE: This is part of the heap.Interface implementation.
+func (h expiryHeap) Len() int { return len(h) }
+
+// Less returns whether the invoice at index i expires before the invoice at
+// index j.
+//
+// NOTE: This is part of the heap.Interface implementation.
+func (h expiryHeap) Less(i, j int) bool {
+	return h[i].expiry.Before(h[j].expiry)
+}
+
+// Swap swaps the invoices at the passed indices in the heap.
+//
+// NOTE: This is part of the heap.Interface implementation.
+func (h expiryHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+// Push pushes a new invoice expiry onto the heap.
+//
+// NOTE: This is part of the heap.Interface implementation.
+func (h *expiryHeap) Push(x interface{}) {
+	*h = append(*h, x.(invoiceExpiry))
+}
+
+// Pop removes the invoice that expires first from the heap and returns it.
+//
+// NOTE: This is part of the heap.Interface implementation.
+func (h *expiryHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[0 : n-1]
+	return x
+}
+
+// InvoiceExpiryWatcher cancels open invoices once their expiry has passed.
+// If a retention window is set, it also periodically deletes canceled
+// invoices that were created longer ago than the window, so that stale
+// invoices don't accumulate in the database.
+type InvoiceExpiryWatcher struct {
+	cdb *channeldb.DB
+
+	// cancelInvoice is called to cancel an invoice that has expired. It
+	// should leave invoices that are no longer open untouched. If nil,
+	// invoice expiries aren't watched.
+	cancelInvoice func(lntypes.Hash) error
+
+	// retention is the duration for which canceled invoices are kept. A
+	// zero value means they're kept forever.
+	retention time.Duration
+
+	// gcInterval is the interval at which canceled invoices that fell out
+	// of the retention window are deleted.
+	gcInterval time.Duration
+
+	// clock is the time source used to determine whether invoices have
+	// expired.
+	clock clock.Clock
+
+	// expiries holds the expiries of all tracked open invoices. It is
+	// only accessed by the main loop.
+	expiries expiryHeap
+
+	// pendingMtx guards pending.
+	pendingMtx sync.Mutex
+
+	// pending holds newly added invoices that have yet to be picked up by
+	// the main loop. They're queued rather than sent over a channel, so
+	// that adding an invoice never blocks on the main loop, which may be
+	// busy canceling an invoice.
+	pending []invoiceExpiry
+
+	// newPending is signaled whenever new invoices are queued.
+	newPending chan struct{}
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// NewInvoiceExpiryWatcher creates a new invoice expiry watcher. Canceled
+// invoices are deleted once they are older than the passed retention, unless
+// it is zero.
+func NewInvoiceExpiryWatcher(cdb *channeldb.DB, retention time.Duration,
+	clock clock.Clock) *InvoiceExpiryWatcher {
+
+	return &InvoiceExpiryWatcher{
+		cdb:        cdb,
+		retention:  retention,
+		gcInterval: DefaultInvoiceGCInterval,
+		clock:      clock,
+		newPending: make(chan struct{}, 1),
+		quit:       make(chan struct{}),
+	}
+}
+
+// Start loads the open invoices from the database and starts the goroutine
+// watching them. The passed function is called for every invoice that
+// expires. If it is nil, only the deletion of canceled invoices is carried
+// out.
+func (w *InvoiceExpiryWatcher) Start(
+	cancelInvoice func(lntypes.Hash) error) error {
+
+	w.cancelInvoice = cancelInvoice
+
+	if cancelInvoice != nil {
+		openInvoices, err := w.cdb.FetchOpenInvoices()
+		if err != nil {
+			return err
+		}
+
+		for hash, invoice := range openInvoices {
+			invoice := invoice
+			heap.Push(&w.expiries, invoiceExpiry{
+				paymentHash: hash,
+				expiry:      expiryTime(&invoice),
+			})
+		}
+
+		log.Infof("Watching expiry of %v open invoices",
+			len(w.expiries))
+	}
+
+	w.wg.Add(1)
+	go w.mainLoop()
+
+	return nil
+}
+
+// Stop signals the watcher for a graceful shutdown.
+func (w *InvoiceExpiryWatcher) Stop() {
+	close(w.quit)
+
+	w.wg.Wait()
+}
+
+// AddInvoice starts watching the expiry of the passed invoice. Invoices that
+// aren't open and AMP invoices, which can be paid any number of times, are
+// ignored.
+func (w *InvoiceExpiryWatcher) AddInvoice(paymentHash lntypes.Hash,
+	invoice *channeldb.Invoice) {
+
+	if w.cancelInvoice == nil || invoice.AMP ||
+		invoice.Terms.State != channeldb.ContractOpen {
+
+		return
+	}
+
+	w.pendingMtx.Lock()
+	w.pending = append(w.pending, invoiceExpiry{
+		paymentHash: paymentHash,
+		expiry:      expiryTime(invoice),
+	})
+	w.pendingMtx.Unlock()
+
+	select {
+	case w.newPending <- struct{}{}:
+	default:
+	}
+}
+
+// expiryTime returns the point in time at which the invoice expires.
+func expiryTime(invoice *channeldb.Invoice) time.Time {
+	expiry := invoice.Expiry
+	if expiry == 0 {
+		expiry = defaultInvoiceExpiry
+	}
+
+	return invoice.CreationDate.Add(expiry)
+}
+
+// mainLoop cancels the tracked invoices as they expire and deletes the
+// canceled invoices that fell out of the retention window.
+func (w *InvoiceExpiryWatcher) mainLoop() {
+	defer w.wg.Done()
+
+	var gcTicker <-chan time.Time
+	if w.retention != 0 {
+		ticker := time.NewTicker(w.gcInterval)
+		defer ticker.Stop()
+
+		gcTicker = ticker.C
+		w.deleteCanceledInvoices()
+	}
+
+	for {
+		w.cancelExpiredInvoices()
+
+		// Wait until the next invoice expires, if any.
+		var nextExpiry <-chan time.Time
+		if len(w.expiries) > 0 {
+			nextExpiry = w.clock.TickAfter(
+				w.expiries[0].expiry.Sub(w.clock.Now()),
+			)
+		}
+
+		select {
+		case <-nextExpiry:
+
+		case <-w.newPending:
+			w.pendingMtx.Lock()
+			for _, expiry := range w.pending {
+				heap.Push(&w.expiries, expiry)
+			}
+			w.pending = nil
+			w.pendingMtx.Unlock()
+
+		case <-gcTicker:
+			w.deleteCanceledInvoices()
+
+		case <-w.quit:
+			return
+		}
+	}
+}
+
+// cancelExpiredInvoices cancels all tracked invoices whose expiry has passed.
+func (w *InvoiceExpiryWatcher) cancelExpiredInvoices() {
+	now := w.clock.Now()
+	for len(w.expiries) > 0 && !w.expiries[0].expiry.After(now) {
+		expiry := heap.Pop(&w.expiries).(invoiceExpiry)
+
+		log.Debugf("Invoice(%v): expired at %v", expiry.paymentHash,
+			expiry.expiry)
+
+		err := w.cancelInvoice(expiry.paymentHash)
+		if err != nil {
+			log.Errorf("Unable to cancel expired invoice %v: %v",
+				expiry.paymentHash, err)
+		}
+	}
+}
+
+// deleteCanceledInvoices deletes the canceled invoices that were created
+// before the retention window.
+func (w *InvoiceExpiryWatcher) deleteCanceledInvoices() {
+	numDeleted, err := w.cdb.DeleteCanceledInvoices(
+		w.clock.Now().Add(-w.retention),
+	)
+	if err != nil {
+		log.Errorf("Unable to delete canceled invoices: %v", err)
+		return
+	}
+
+	if numDeleted > 0 {
+		log.Infof("Deleted %v canceled invoices older than %v",
+			numDeleted, w.retention)
+	}
+}
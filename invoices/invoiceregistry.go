@@ -2,14 +2,18 @@ package invoices
 
 import (
 	"errors"
+	"fmt"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/clock"
 	"github.com/decred/dcrlnd/lntypes"
 	"github.com/decred/dcrlnd/lnwire"
 	"github.com/decred/dcrlnd/queue"
+	"github.com/decred/dcrlnd/record"
 )
 
 var (
@@ -42,6 +46,68 @@ type HodlEvent struct {
 
 	// AcceptHeight is the original height at which the htlc was accepted.
 	AcceptHeight int32
+
+	// Result describes the reason the htlc was resolved the way it was.
+	// For cancel events, it determines the failure sent back to the
+	// sender.
+	Result ResolutionResult
+}
+
+// ExitHopPayload carries the fields of the final hop's onion payload that
+// must match the htlc we were extended.
+type ExitHopPayload struct {
+	// AmtToForward is the amount the sender instructed us to receive.
+	AmtToForward lnwire.MilliAtom
+
+	// OutgoingCltv is the cltv expiry the sender committed to in the
+	// onion.
+	OutgoingCltv uint32
+
+	// MPP is the MPP record the sender included in the onion, if any. It
+	// carries the payment address of the invoice being paid.
+	MPP *record.MPP
+
+	// AMP is the AMP record the sender included in the onion, if the htlc
+	// pays to a reusable AMP invoice.
+	AMP *record.AMP
+
+	// KeySend is the preimage the sender included in the onion, if the
+	// htlc is a spontaneous keysend payment.
+	KeySend *lntypes.Preimage
+}
+
+// RegistryConfig contains the configuration parameters for the invoice
+// registry.
+type RegistryConfig struct {
+	// FinalCltvRejectDelta defines the number of blocks before the expiry
+	// of the htlc where we no longer settle it as an exit hop and instead
+	// cancel it back. Normally this value should be lower than the cltv
+	// expiry of any invoice we create and the code effectuating this should
+	// not be hit.
+	FinalCltvRejectDelta int32
+
+	// AcceptKeySend indicates whether spontaneous keysend payments are
+	// accepted. An invoice is created on the fly for every such payment,
+	// using the preimage the sender included in the onion.
+	AcceptKeySend bool
+
+	// KeySendHold indicates whether accepted keysend payments are held
+	// until they are explicitly settled or canceled, like hold invoices,
+	// rather than being settled right away.
+	KeySendHold bool
+
+	// CancelExpiredInvoices indicates whether open invoices are canceled
+	// automatically once their expiry has passed.
+	CancelExpiredInvoices bool
+
+	// CanceledInvoiceRetention is the duration for which canceled
+	// invoices are kept, after which they're deleted from the database.
+	// A zero value means canceled invoices are kept forever.
+	CanceledInvoiceRetention time.Duration
+
+	// Clock is the time source used to date new invoices and to determine
+	// their expiry. If nil, the system time is used.
+	Clock clock.Clock
 }
 
 // InvoiceRegistry is a central registry of all the outstanding invoices
@@ -72,12 +138,30 @@ type InvoiceRegistry struct {
 	// subscriber. This is used to unsubscribe from all hashes efficiently.
 	hodlReverseSubscriptions map[chan<- interface{}]map[channeldb.CircuitKey]struct{}
 
-	// finalCltvRejectDelta defines the number of blocks before the expiry
-	// of the htlc where we no longer settle it as an exit hop and instead
-	// cancel it back. Normally this value should be lower than the cltv
-	// expiry of any invoice we create and the code effectuating this should
-	// not be hit.
-	finalCltvRejectDelta int32
+	cfg *RegistryConfig
+
+	// resolverMtx guards the fields related to the external preimage
+	// resolver.
+	resolverMtx sync.Mutex
+
+	// preimageResolver is the currently registered external preimage
+	// resolver, if any.
+	preimageResolver PreimageResolver
+
+	// preimageResolverTimeout is the maximum amount of time we'll wait
+	// for the preimage resolver to respond to a request.
+	preimageResolverTimeout time.Duration
+
+	// pendingPreimageReqs tracks the payment hashes for which a request
+	// to the preimage resolver is currently outstanding.
+	pendingPreimageReqs map[lntypes.Hash]struct{}
+
+	// expiryWatcher cancels expired invoices and deletes old canceled
+	// invoices. It is nil if neither is enabled.
+	expiryWatcher *InvoiceExpiryWatcher
+
+	// clock is the time source used to date new invoices.
+	clock clock.Clock
 
 	wg   sync.WaitGroup
 	quit chan struct{}
@@ -87,7 +171,19 @@ type InvoiceRegistry struct {
 // wraps the persistent on-disk invoice storage with an additional in-memory
 // layer. The in-memory layer is in place such that debug invoices can be added
 // which are volatile yet available system wide within the daemon.
-func NewRegistry(cdb *channeldb.DB, finalCltvRejectDelta int32) *InvoiceRegistry {
+func NewRegistry(cdb *channeldb.DB, cfg *RegistryConfig) *InvoiceRegistry {
+
+	clk := cfg.Clock
+	if clk == nil {
+		clk = clock.NewDefaultClock()
+	}
+
+	var expiryWatcher *InvoiceExpiryWatcher
+	if cfg.CancelExpiredInvoices || cfg.CanceledInvoiceRetention != 0 {
+		expiryWatcher = NewInvoiceExpiryWatcher(
+			cdb, cfg.CanceledInvoiceRetention, clk,
+		)
+	}
 
 	return &InvoiceRegistry{
 		cdb:                       cdb,
@@ -98,13 +194,27 @@ func NewRegistry(cdb *channeldb.DB, finalCltvRejectDelta int32) *InvoiceRegistry
 		invoiceEvents:             make(chan interface{}, 100),
 		hodlSubscriptions:         make(map[channeldb.CircuitKey]map[chan<- interface{}]struct{}),
 		hodlReverseSubscriptions:  make(map[chan<- interface{}]map[channeldb.CircuitKey]struct{}),
-		finalCltvRejectDelta:      finalCltvRejectDelta,
+		cfg:                       cfg,
+		pendingPreimageReqs:       make(map[lntypes.Hash]struct{}),
+		expiryWatcher:             expiryWatcher,
+		clock:                     clk,
 		quit:                      make(chan struct{}),
 	}
 }
 
 // Start starts the registry and all goroutines it needs to carry out its task.
 func (i *InvoiceRegistry) Start() error {
+	if i.expiryWatcher != nil {
+		var cancelInvoice func(lntypes.Hash) error
+		if i.cfg.CancelExpiredInvoices {
+			cancelInvoice = i.cancelExpiredInvoice
+		}
+
+		if err := i.expiryWatcher.Start(cancelInvoice); err != nil {
+			return err
+		}
+	}
+
 	i.wg.Add(1)
 
 	go i.invoiceEventNotifier()
@@ -114,6 +224,12 @@ func (i *InvoiceRegistry) Start() error {
 
 // Stop signals the registry for a graceful shutdown.
 func (i *InvoiceRegistry) Stop() {
+	// The expiry watcher is stopped first, as it may be waiting on the
+	// registry to cancel an invoice.
+	if i.expiryWatcher != nil {
+		i.expiryWatcher.Stop()
+	}
+
 	close(i.quit)
 
 	i.wg.Wait()
@@ -125,6 +241,11 @@ func (i *InvoiceRegistry) Stop() {
 type invoiceEvent struct {
 	hash    lntypes.Hash
 	invoice *channeldb.Invoice
+
+	// htlcUpdate indicates that only the htlcs of the invoice changed,
+	// leaving the invoice state untouched. Such events are only sent to
+	// the single invoice clients that asked for htlc updates.
+	htlcUpdate bool
 }
 
 // invoiceEventNotifier is the dedicated goroutine responsible for accepting
@@ -176,13 +297,17 @@ func (i *InvoiceRegistry) invoiceEventNotifier() {
 			// we'll dispatch notifications to all registered
 			// clients.
 			case *invoiceEvent:
+				if e.htlcUpdate {
+					i.dispatchToSingleClients(e)
+					continue
+				}
+
 				// For backwards compatibility, do not notify
-				// all invoice subscribers of cancel and accept
-				// events.
+				// all invoice subscribers of accept events.
+				// Cancel events are only sent to the clients
+				// that asked for them.
 				state := e.invoice.Terms.State
-				if state != channeldb.ContractCanceled &&
-					state != channeldb.ContractAccepted {
-
+				if state != channeldb.ContractAccepted {
 					i.dispatchToClients(e)
 				}
 				i.dispatchToSingleClients(e)
@@ -214,6 +339,10 @@ func (i *InvoiceRegistry) dispatchToSingleClients(event *invoiceEvent) {
 			continue
 		}
 
+		if event.htlcUpdate && !client.htlcUpdates {
+			continue
+		}
+
 		client.notify(event)
 	}
 }
@@ -233,6 +362,11 @@ func (i *InvoiceRegistry) dispatchToClients(event *invoiceEvent) {
 		// TODO(joostjager): Refactor switches.
 		state := event.invoice.Terms.State
 		switch {
+		// Only clients that asked for cancel events receive them.
+		case state == channeldb.ContractCanceled &&
+			!client.notifyCanceled:
+			continue
+
 		// If we've already sent this settle event to
 		// the client, then we can skip this.
 		case state == channeldb.ContractSettled &&
@@ -282,6 +416,9 @@ func (i *InvoiceRegistry) dispatchToClients(event *invoiceEvent) {
 			client.settleIndex = invoice.SettleIndex
 		case channeldb.ContractOpen:
 			client.addIndex = invoice.AddIndex
+		case channeldb.ContractCanceled:
+			// Cancel events aren't indexed, so there is nothing
+			// to record.
 		default:
 			log.Errorf("unexpected invoice state: %v",
 				event.invoice.Terms.State)
@@ -400,6 +537,10 @@ func (i *InvoiceRegistry) AddInvoice(invoice *channeldb.Invoice,
 	// notify the clients of this new invoice.
 	i.notifyClients(paymentHash, invoice, channeldb.ContractOpen)
 
+	if i.expiryWatcher != nil {
+		i.expiryWatcher.AddInvoice(paymentHash, invoice)
+	}
+
 	return addIndex, nil
 }
 
@@ -426,10 +567,25 @@ func (i *InvoiceRegistry) LookupInvoice(rHash lntypes.Hash) (channeldb.Invoice,
 // to be taken on the htlc (settle or cancel). The caller needs to ensure that
 // the channel is either buffered or received on from another goroutine to
 // prevent deadlock.
+//
+// If payload is non-nil, the amount and cltv committed to in the final hop's
+// onion payload must match the htlc exactly. Any htlc that is rejected against
+// an existing invoice is recorded in the invoice along with the reason for the
+// rejection.
+//
+// If the payload carries an AMP record, the htlc pays to the AMP invoice
+// identified by the record rather than by the htlc's payment hash. Such htlcs
+// are settled right away with the preimage derived from the record, leaving
+// the invoice open for further payments.
+//
+// If keysend payments are accepted and the payload carries a keysend preimage,
+// an invoice is created for the htlc before it is settled against it. Such an
+// htlc is held instead, if the registry is configured to hold keysend
+// payments.
 func (i *InvoiceRegistry) NotifyExitHopHtlc(rHash lntypes.Hash,
 	amtPaid lnwire.MilliAtom, expiry uint32, currentHeight int32,
 	circuitKey channeldb.CircuitKey, hodlChan chan<- interface{},
-	eob []byte) (*HodlEvent, error) {
+	payload *ExitHopPayload) (*HodlEvent, error) {
 
 	i.Lock()
 	defer i.Unlock()
@@ -442,6 +598,41 @@ func (i *InvoiceRegistry) NotifyExitHopHtlc(rHash lntypes.Hash,
 	// Default is to not update subscribers after the invoice update.
 	updateSubscribers := false
 
+	// result records the outcome of the update callback. It is used to
+	// annotate the returned resolution event.
+	var result ResolutionResult
+
+	// An htlc carrying an AMP record pays to the hash of its child
+	// preimage, so the invoice is identified by the record instead.
+	var amp *record.AMP
+	invoiceRef := rHash
+	if payload != nil && payload.AMP != nil {
+		amp = payload.AMP
+		invoiceRef = amp.InvoiceHash()
+	}
+
+	// A spontaneous keysend payment doesn't pay to an existing invoice,
+	// so we'll create one from the preimage in the onion first.
+	var keySend *lntypes.Preimage
+	if payload != nil && payload.KeySend != nil && amp == nil &&
+		i.cfg.AcceptKeySend {
+
+		keySend = payload.KeySend
+		err := i.processKeySend(
+			rHash, *keySend, amtPaid, expiry, currentHeight,
+		)
+		if err != nil {
+			result = ResultKeySendError
+			debugLog(fmt.Sprintf("%v: %v", result, err))
+
+			return &HodlEvent{
+				CircuitKey:   circuitKey,
+				AcceptHeight: currentHeight,
+				Result:       result,
+			}, nil
+		}
+	}
+
 	updateInvoice := func(inv *channeldb.Invoice) (
 		*channeldb.InvoiceUpdateDesc, error) {
 
@@ -450,45 +641,106 @@ func (i *InvoiceRegistry) NotifyExitHopHtlc(rHash lntypes.Hash,
 		if ok {
 			switch htlc.State {
 			case channeldb.HtlcStateCanceled:
-				debugLog("replayed htlc to canceled invoice")
+				result = resultFromRejectReason(
+					htlc.RejectReason,
+				)
 
 			case channeldb.HtlcStateAccepted:
-				debugLog("replayed htlc to accepted invoice")
+				result = ResultReplayToAccepted
 
 			case channeldb.HtlcStateSettled:
-				debugLog("replayed htlc to settled invoice")
+				result = ResultReplayToSettled
 
 			default:
 				return nil, errors.New("unexpected htlc state")
 			}
 
+			debugLog(result.String())
 			return nil, errNoUpdate
 		}
 
 		// If the invoice is already canceled, there is no further
 		// checking to do.
 		if inv.Terms.State == channeldb.ContractCanceled {
-			debugLog("invoice already canceled")
+			result = ResultInvoiceAlreadyCanceled
+			debugLog(result.String())
 			return nil, errNoUpdate
 		}
 
+		// reject records the htlc as rejected with the given result,
+		// leaving the invoice state untouched.
+		reject := func(r ResolutionResult) (
+			*channeldb.InvoiceUpdateDesc, error) {
+
+			result = r
+			debugLog(result.String())
+
+			return &channeldb.InvoiceUpdateDesc{
+				State: inv.Terms.State,
+				Htlcs: map[channeldb.CircuitKey]*channeldb.HtlcAcceptDesc{
+					circuitKey: {
+						Amt:          amtPaid,
+						Expiry:       expiry,
+						AcceptHeight: currentHeight,
+						RejectReason: r.rejectReason(),
+					},
+				},
+			}, nil
+		}
+
+		// As we're the exit hop, we'll double check the hop-payload
+		// included in the HTLC to ensure that it was crafted correctly
+		// by the sender and matches the HTLC we were extended.
+		if payload != nil {
+			if payload.AmtToForward != amtPaid {
+				return reject(ResultIncorrectHtlcAmount)
+			}
+
+			if payload.OutgoingCltv != expiry {
+				return reject(ResultIncorrectHtlcExpiry)
+			}
+
+			// Invoices with a payment address only accept htlcs
+			// that know it, so that the payment hash alone can't
+			// be used to probe for the invoice.
+			if inv.Terms.PaymentAddr != channeldb.BlankPayAddr {
+				mpp := payload.MPP
+				if mpp == nil ||
+					mpp.PaymentAddr() != inv.Terms.PaymentAddr {
+
+					return reject(ResultAddressMismatch)
+				}
+			}
+		}
+
+		// AMP invoices only accept htlcs with an AMP record that
+		// derives the payment hash of the htlc, while regular invoices
+		// don't accept AMP records at all.
+		switch {
+		case inv.AMP && amp == nil:
+			return reject(ResultAMPRequired)
+
+		case !inv.AMP && amp != nil:
+			return reject(ResultInvalidAMP)
+
+		case amp != nil && amp.ChildHash() != rHash:
+			return reject(ResultInvalidAMP)
+		}
+
 		// If an invoice amount is specified, check that enough
 		// is paid. Also check this for duplicate payments if
 		// the invoice is already settled or accepted.
 		if inv.Terms.Value > 0 && amtPaid < inv.Terms.Value {
-			debugLog("amount too low")
-			return nil, errNoUpdate
+			return reject(ResultAmountTooLow)
 		}
 
 		// The invoice is still open. Check the expiry.
-		if expiry < uint32(currentHeight+i.finalCltvRejectDelta) {
-			debugLog("expiry too soon")
-			return nil, errNoUpdate
+		if expiry < uint32(currentHeight+i.cfg.FinalCltvRejectDelta) {
+			return reject(ResultExpiryTooSoon)
 		}
 
 		if expiry < uint32(currentHeight+inv.FinalCltvDelta) {
-			debugLog("expiry too soon")
-			return nil, errNoUpdate
+			return reject(ResultExpiryTooSoon)
 		}
 
 		// Record HTLC in the invoice database.
@@ -497,6 +749,7 @@ func (i *InvoiceRegistry) NotifyExitHopHtlc(rHash lntypes.Hash,
 				Amt:          amtPaid,
 				Expiry:       expiry,
 				AcceptHeight: currentHeight,
+				AMP:          amp,
 			},
 		}
 
@@ -504,16 +757,29 @@ func (i *InvoiceRegistry) NotifyExitHopHtlc(rHash lntypes.Hash,
 			Htlcs: newHtlcs,
 		}
 
+		// An AMP htlc is settled on its own with its derived preimage,
+		// without affecting the state of the invoice.
+		if amp != nil {
+			result = ResultSettled
+			debugLog(result.String())
+			update.State = inv.Terms.State
+			updateSubscribers = true
+
+			return &update, nil
+		}
+
 		// Don't update invoice state if we are accepting a duplicate
 		// payment. We do accept or settle the HTLC.
 		switch inv.Terms.State {
 		case channeldb.ContractAccepted:
-			debugLog("accepting duplicate payment to accepted invoice")
+			result = ResultDuplicateToAccepted
+			debugLog(result.String())
 			update.State = channeldb.ContractAccepted
 			return &update, nil
 
 		case channeldb.ContractSettled:
-			debugLog("accepting duplicate payment to settled invoice")
+			result = ResultDuplicateToSettled
+			debugLog(result.String())
 			update.State = channeldb.ContractSettled
 			return &update, nil
 		}
@@ -521,14 +787,24 @@ func (i *InvoiceRegistry) NotifyExitHopHtlc(rHash lntypes.Hash,
 		// Check to see if we can settle or this is an hold invoice and
 		// we need to wait for the preimage.
 		holdInvoice := inv.Terms.PaymentPreimage == channeldb.UnknownPreimage
+
+		// Invoices created for keysend payments don't have a payment
+		// request. Depending on the configuration, they are held like
+		// hold invoices until they are settled or canceled explicitly.
+		isKeySend := keySend != nil && len(inv.PaymentRequest) == 0
+		if isKeySend && i.cfg.KeySendHold {
+			holdInvoice = true
+		}
+
 		if holdInvoice {
-			debugLog("accepted")
+			result = ResultAccepted
 			update.State = channeldb.ContractAccepted
 		} else {
-			debugLog("settled")
+			result = ResultSettled
 			update.Preimage = inv.Terms.PaymentPreimage
 			update.State = channeldb.ContractSettled
 		}
+		debugLog(result.String())
 
 		updateSubscribers = true
 
@@ -538,15 +814,22 @@ func (i *InvoiceRegistry) NotifyExitHopHtlc(rHash lntypes.Hash,
 	// We'll attempt to settle an invoice matching this rHash on disk (if
 	// one exists). The callback will set the resolution action that is
 	// returned to the link or contract resolver.
-	invoice, err := i.cdb.UpdateInvoice(rHash, updateInvoice)
+	invoice, err := i.cdb.UpdateInvoice(invoiceRef, updateInvoice)
 	if err != nil && err != errNoUpdate {
 		debugLog(err.Error())
 
 		return nil, err
 	}
 
-	if updateSubscribers {
-		i.notifyClients(rHash, invoice, invoice.Terms.State)
+	switch {
+	case updateSubscribers:
+		i.notifyClients(invoiceRef, invoice, invoice.Terms.State)
+
+	// The htlc was recorded without changing the state of the invoice,
+	// either because it was rejected or because it paid to an invoice
+	// that was already accepted or settled.
+	case err == nil:
+		i.notifyHtlcUpdate(invoiceRef, invoice)
 	}
 
 	// Inspect latest htlc state on the invoice.
@@ -557,6 +840,7 @@ func (i *InvoiceRegistry) NotifyExitHopHtlc(rHash lntypes.Hash,
 		return &HodlEvent{
 			CircuitKey:   circuitKey,
 			AcceptHeight: currentHeight,
+			Result:       result,
 		}, nil
 	}
 
@@ -571,17 +855,39 @@ func (i *InvoiceRegistry) NotifyExitHopHtlc(rHash lntypes.Hash,
 		return &HodlEvent{
 			CircuitKey:   circuitKey,
 			AcceptHeight: acceptHeight,
+			Result:       result,
 		}, nil
 
 	case channeldb.HtlcStateSettled:
+		// Htlcs paying to an AMP invoice are settled with the preimage
+		// derived from their own AMP record.
+		preimage := invoice.Terms.PaymentPreimage
+		if invoiceHtlc.AMP != nil {
+			preimage = invoiceHtlc.AMP.ChildPreimage()
+		}
+
 		return &HodlEvent{
 			CircuitKey:   circuitKey,
-			Preimage:     &invoice.Terms.PaymentPreimage,
+			Preimage:     &preimage,
 			AcceptHeight: acceptHeight,
+			Result:       result,
 		}, nil
 
 	case channeldb.HtlcStateAccepted:
 		i.hodlSubscribe(hodlChan, circuitKey)
+
+		// If an external preimage resolver is registered, we'll ask
+		// it for the preimage of this hold invoice while the htlc is
+		// being held. Held keysend payments already carry their
+		// preimage, and await a decision instead.
+		if invoice.Terms.PaymentPreimage == channeldb.UnknownPreimage {
+			i.requestPreimage(&PreimageRequest{
+				PaymentHash: rHash,
+				AmtPaid:     amtPaid,
+				CircuitKey:  circuitKey,
+			})
+		}
+
 		return nil, nil
 
 	default:
@@ -589,6 +895,45 @@ func (i *InvoiceRegistry) NotifyExitHopHtlc(rHash lntypes.Hash,
 	}
 }
 
+// processKeySend creates an invoice for a spontaneous keysend payment with the
+// preimage the sender included in the onion. If the invoice already exists
+// because the htlc is delivered again, it is left untouched.
+//
+// NOTE: This method must be called with the registry lock held.
+func (i *InvoiceRegistry) processKeySend(rHash lntypes.Hash,
+	preimage lntypes.Preimage, amtPaid lnwire.MilliAtom, expiry uint32,
+	currentHeight int32) error {
+
+	if preimage.Hash() != rHash {
+		return errors.New("keysend preimage doesn't match payment hash")
+	}
+
+	invoice := &channeldb.Invoice{
+		CreationDate:   time.Unix(i.clock.Now().Unix(), 0),
+		FinalCltvDelta: int32(expiry) - currentHeight,
+		Terms: channeldb.ContractTerm{
+			PaymentPreimage: preimage,
+			Value:           amtPaid,
+		},
+	}
+
+	_, err := i.cdb.AddInvoice(invoice, rHash)
+	switch {
+	case err == channeldb.ErrDuplicateInvoice:
+		return nil
+
+	case err != nil:
+		return err
+	}
+
+	log.Debugf("Invoice(%v): added for keysend payment of %v", rHash,
+		amtPaid)
+
+	i.notifyClients(rHash, invoice, channeldb.ContractOpen)
+
+	return nil
+}
+
 // SettleHodlInvoice sets the preimage of a hodl invoice.
 func (i *InvoiceRegistry) SettleHodlInvoice(preimage lntypes.Preimage) error {
 	i.Lock()
@@ -637,6 +982,7 @@ func (i *InvoiceRegistry) SettleHodlInvoice(preimage lntypes.Preimage) error {
 			CircuitKey:   key,
 			Preimage:     &preimage,
 			AcceptHeight: int32(htlc.AcceptHeight),
+			Result:       ResultSettled,
 		})
 	}
 	i.notifyClients(hash, invoice, invoice.Terms.State)
@@ -647,6 +993,21 @@ func (i *InvoiceRegistry) SettleHodlInvoice(preimage lntypes.Preimage) error {
 // CancelInvoice attempts to cancel the invoice corresponding to the passed
 // payment hash.
 func (i *InvoiceRegistry) CancelInvoice(payHash lntypes.Hash) error {
+	return i.cancelInvoiceImpl(payHash, true)
+}
+
+// cancelExpiredInvoice cancels the expired invoice corresponding to the passed
+// payment hash, unless it is no longer open. Invoices that were paid in the
+// meantime are left untouched.
+func (i *InvoiceRegistry) cancelExpiredInvoice(payHash lntypes.Hash) error {
+	return i.cancelInvoiceImpl(payHash, false)
+}
+
+// cancelInvoiceImpl attempts to cancel the invoice corresponding to the passed
+// payment hash. Accepted invoices are only canceled if cancelAccepted is set.
+func (i *InvoiceRegistry) cancelInvoiceImpl(payHash lntypes.Hash,
+	cancelAccepted bool) error {
+
 	i.Lock()
 	defer i.Unlock()
 
@@ -660,6 +1021,10 @@ func (i *InvoiceRegistry) CancelInvoice(payHash lntypes.Hash) error {
 			return nil, channeldb.ErrInvoiceAlreadySettled
 		case channeldb.ContractCanceled:
 			return nil, channeldb.ErrInvoiceAlreadyCanceled
+		case channeldb.ContractAccepted:
+			if !cancelAccepted {
+				return nil, errNoUpdate
+			}
 		}
 
 		// Mark individual held htlcs as canceled.
@@ -699,6 +1064,15 @@ func (i *InvoiceRegistry) CancelInvoice(payHash lntypes.Hash) error {
 		log.Debugf("Invoice(%v): already canceled", payHash)
 		return nil
 	}
+
+	// An invoice that is no longer open when it expires has been paid in
+	// the meantime, so there is nothing to cancel.
+	if !cancelAccepted && (err == errNoUpdate ||
+		err == channeldb.ErrInvoiceAlreadySettled) {
+
+		log.Debugf("Invoice(%v): no longer open", payHash)
+		return nil
+	}
 	if err != nil {
 		return err
 	}
@@ -718,6 +1092,7 @@ func (i *InvoiceRegistry) CancelInvoice(payHash lntypes.Hash) error {
 		i.notifyHodlSubscribers(HodlEvent{
 			CircuitKey:   key,
 			AcceptHeight: int32(htlc.AcceptHeight),
+			Result:       ResultCanceled,
 		})
 	}
 	i.notifyClients(payHash, invoice, channeldb.ContractCanceled)
@@ -725,6 +1100,141 @@ func (i *InvoiceRegistry) CancelInvoice(payHash lntypes.Hash) error {
 	return nil
 }
 
+// CancelHtlcs cancels the given accepted htlcs paying to the invoice
+// corresponding to the passed payment hash, leaving the invoice itself and any
+// other htlcs untouched. Htlcs that were already canceled are skipped.
+func (i *InvoiceRegistry) CancelHtlcs(payHash lntypes.Hash,
+	circuitKeys []channeldb.CircuitKey) error {
+
+	return i.cancelHtlcs(payHash, func(invoice *channeldb.Invoice) (
+		map[channeldb.CircuitKey]*channeldb.InvoiceHTLC, error) {
+
+		htlcs := make(map[channeldb.CircuitKey]*channeldb.InvoiceHTLC)
+		for _, key := range circuitKeys {
+			htlc, ok := invoice.Htlcs[key]
+			if !ok {
+				return nil, fmt.Errorf("unknown htlc %v", key)
+			}
+
+			htlcs[key] = htlc
+		}
+
+		return htlcs, nil
+	})
+}
+
+// CancelHtlcSet cancels the accepted htlcs of the AMP payment set with the
+// given id paying to the invoice corresponding to the passed payment hash. The
+// invoice itself and the htlcs of other sets are left untouched.
+func (i *InvoiceRegistry) CancelHtlcSet(payHash lntypes.Hash,
+	setID [32]byte) error {
+
+	return i.cancelHtlcs(payHash, func(invoice *channeldb.Invoice) (
+		map[channeldb.CircuitKey]*channeldb.InvoiceHTLC, error) {
+
+		htlcs := invoice.HTLCSet(setID)
+		if len(htlcs) == 0 {
+			return nil, fmt.Errorf("unknown htlc set %x", setID[:])
+		}
+
+		return htlcs, nil
+	})
+}
+
+// cancelHtlcs cancels the accepted htlcs returned by selectHtlcs for the
+// invoice corresponding to the passed payment hash. If all accepted htlcs of
+// an accepted invoice are canceled, the invoice moves back to the open state.
+func (i *InvoiceRegistry) cancelHtlcs(payHash lntypes.Hash,
+	selectHtlcs func(*channeldb.Invoice) (
+		map[channeldb.CircuitKey]*channeldb.InvoiceHTLC, error)) error {
+
+	i.Lock()
+	defer i.Unlock()
+
+	log.Debugf("Invoice(%v): canceling htlcs", payHash)
+
+	updateInvoice := func(invoice *channeldb.Invoice) (
+		*channeldb.InvoiceUpdateDesc, error) {
+
+		switch invoice.Terms.State {
+		case channeldb.ContractSettled:
+			return nil, channeldb.ErrInvoiceAlreadySettled
+		case channeldb.ContractCanceled:
+			return nil, channeldb.ErrInvoiceAlreadyCanceled
+		}
+
+		htlcs, err := selectHtlcs(invoice)
+		if err != nil {
+			return nil, err
+		}
+
+		canceledHtlcs := make(
+			map[channeldb.CircuitKey]*channeldb.HtlcAcceptDesc,
+		)
+		for key, htlc := range htlcs {
+			switch htlc.State {
+			case channeldb.HtlcStateSettled:
+				return nil, fmt.Errorf("cannot cancel settled "+
+					"htlc %v", key)
+
+			// Don't cancel htlcs that were already canceled,
+			// because it would incorrectly modify the invoice paid
+			// amt.
+			case channeldb.HtlcStateCanceled:
+				continue
+			}
+
+			canceledHtlcs[key] = nil
+		}
+
+		if len(canceledHtlcs) == 0 {
+			return nil, errNoUpdate
+		}
+
+		return &channeldb.InvoiceUpdateDesc{
+			Htlcs: canceledHtlcs,
+			State: invoice.Terms.State,
+		}, nil
+	}
+
+	invoice, err := i.cdb.UpdateInvoice(payHash, updateInvoice)
+
+	// Implement idempotency by returning success if all htlcs were
+	// already canceled.
+	if err == errNoUpdate {
+		log.Debugf("Invoice(%v): htlcs already canceled", payHash)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	htlcs, err := selectHtlcs(invoice)
+	if err != nil {
+		return err
+	}
+
+	log.Debugf("Invoice(%v): canceled %v htlc(s)", payHash, len(htlcs))
+
+	// Notify links and resolvers that are waiting for resolution of the
+	// selected htlcs. As with canceling the invoice, htlcs that were
+	// already canceled before are notified again.
+	for key, htlc := range htlcs {
+		if htlc.State != channeldb.HtlcStateCanceled {
+			continue
+		}
+
+		i.notifyHodlSubscribers(HodlEvent{
+			CircuitKey:   key,
+			AcceptHeight: int32(htlc.AcceptHeight),
+			Result:       ResultCanceled,
+		})
+	}
+	i.notifyClients(payHash, invoice, invoice.Terms.State)
+
+	return nil
+}
+
 // notifyClients notifies all currently registered invoice notification clients
 // of a newly added/settled invoice.
 func (i *InvoiceRegistry) notifyClients(hash lntypes.Hash,
@@ -742,6 +1252,24 @@ func (i *InvoiceRegistry) notifyClients(hash lntypes.Hash,
 	}
 }
 
+// notifyHtlcUpdate sends out a notification to the single invoice clients that
+// asked for htlc updates, after the htlcs of an invoice changed without
+// changing the state of the invoice.
+func (i *InvoiceRegistry) notifyHtlcUpdate(hash lntypes.Hash,
+	invoice *channeldb.Invoice) {
+
+	event := &invoiceEvent{
+		invoice:    invoice,
+		hash:       hash,
+		htlcUpdate: true,
+	}
+
+	select {
+	case i.invoiceEvents <- event:
+	case <-i.quit:
+	}
+}
+
 // invoiceSubscriptionKit defines that are common to both all invoice
 // subscribers and single invoice subscribers.
 type invoiceSubscriptionKit struct {
@@ -758,6 +1286,7 @@ type invoiceSubscriptionKit struct {
 // or settled invoices. For each newly added invoice, a copy of the invoice
 // will be sent over the NewInvoices channel. Similarly, for each newly settled
 // invoice, a copy of the invoice will be sent over the SettledInvoices
+// channel. If requested, canceled invoices are sent over the CanceledInvoices
 // channel.
 type InvoiceSubscription struct {
 	invoiceSubscriptionKit
@@ -772,6 +1301,15 @@ type InvoiceSubscription struct {
 	// StartingInvoiceIndex field.
 	SettledInvoices chan *channeldb.Invoice
 
+	// CanceledInvoices is a channel that we'll use to send all canceled
+	// invoices, if the client asked for cancel events. As cancel events
+	// aren't indexed, no backlog of them is delivered.
+	CanceledInvoices chan *channeldb.Invoice
+
+	// notifyCanceled indicates whether the client wants to receive cancel
+	// events.
+	notifyCanceled bool
+
 	// addIndex is the highest add index the caller knows of. We'll use
 	// this information to send out an event backlog to the notifications
 	// subscriber. Any new add events with an index greater than this will
@@ -793,6 +1331,10 @@ type SingleInvoiceSubscription struct {
 
 	hash lntypes.Hash
 
+	// htlcUpdates indicates whether the client also wants to receive
+	// updates when only the htlcs paying to the invoice change.
+	htlcUpdates bool
+
 	// Updates is a channel that we'll use to send all invoice events for
 	// the invoice that is subscribed to.
 	Updates chan *channeldb.Invoice
@@ -830,13 +1372,18 @@ func (i *invoiceSubscriptionKit) notify(event *invoiceEvent) error {
 // caller to receive async notifications when any invoices are settled or
 // added. The invoiceIndex parameter is a streaming "checkpoint". We'll start
 // by first sending out all new events with an invoice index _greater_ than
-// this value. Afterwards, we'll send out real-time notifications.
-func (i *InvoiceRegistry) SubscribeNotifications(addIndex, settleIndex uint64) *InvoiceSubscription {
+// this value. Afterwards, we'll send out real-time notifications. If
+// notifyCanceled is set, invoices that are canceled are notified as well.
+func (i *InvoiceRegistry) SubscribeNotifications(addIndex, settleIndex uint64,
+	notifyCanceled bool) *InvoiceSubscription {
+
 	client := &InvoiceSubscription{
-		NewInvoices:     make(chan *channeldb.Invoice),
-		SettledInvoices: make(chan *channeldb.Invoice),
-		addIndex:        addIndex,
-		settleIndex:     settleIndex,
+		NewInvoices:      make(chan *channeldb.Invoice),
+		SettledInvoices:  make(chan *channeldb.Invoice),
+		CanceledInvoices: make(chan *channeldb.Invoice),
+		notifyCanceled:   notifyCanceled,
+		addIndex:         addIndex,
+		settleIndex:      settleIndex,
 		invoiceSubscriptionKit: invoiceSubscriptionKit{
 			inv:        i,
 			ntfnQueue:  queue.NewConcurrentQueue(20),
@@ -874,6 +1421,8 @@ func (i *InvoiceRegistry) SubscribeNotifications(addIndex, settleIndex uint64) *
 					targetChan = client.NewInvoices
 				case channeldb.ContractSettled:
 					targetChan = client.SettledInvoices
+				case channeldb.ContractCanceled:
+					targetChan = client.CanceledInvoices
 				default:
 					log.Errorf("unknown invoice "+
 						"state: %v", state)
@@ -909,9 +1458,12 @@ func (i *InvoiceRegistry) SubscribeNotifications(addIndex, settleIndex uint64) *
 }
 
 // SubscribeSingleInvoice returns an SingleInvoiceSubscription which allows the
-// caller to receive async notifications for a specific invoice.
-func (i *InvoiceRegistry) SubscribeSingleInvoice(
-	hash lntypes.Hash) (*SingleInvoiceSubscription, error) {
+// caller to receive async notifications for a specific invoice. If htlcUpdates
+// is set, the caller is also notified whenever an htlc paying to the invoice is
+// accepted, settled or canceled without changing the state of the invoice
+// itself, such as when additional htlcs arrive for an accepted hold invoice.
+func (i *InvoiceRegistry) SubscribeSingleInvoice(hash lntypes.Hash,
+	htlcUpdates bool) (*SingleInvoiceSubscription, error) {
 
 	client := &SingleInvoiceSubscription{
 		Updates: make(chan *channeldb.Invoice),
@@ -920,7 +1472,8 @@ func (i *InvoiceRegistry) SubscribeSingleInvoice(
 			ntfnQueue:  queue.NewConcurrentQueue(20),
 			cancelChan: make(chan struct{}),
 		},
-		hash: hash,
+		hash:        hash,
+		htlcUpdates: htlcUpdates,
 	}
 	client.ntfnQueue.Start()
 
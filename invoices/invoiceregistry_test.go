@@ -7,8 +7,10 @@ import (
 	"time"
 
 	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/clock"
 	"github.com/decred/dcrlnd/lntypes"
 	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/record"
 )
 
 var (
@@ -46,7 +48,9 @@ func newTestContext(t *testing.T) (*InvoiceRegistry, func()) {
 	}
 
 	// Instantiate and start the invoice registry.
-	registry := NewRegistry(cdb, testFinalCltvRejectDelta)
+	registry := NewRegistry(cdb, &RegistryConfig{
+		FinalCltvRejectDelta: testFinalCltvRejectDelta,
+	})
 
 	err = registry.Start()
 	if err != nil {
@@ -74,11 +78,11 @@ func TestSettleInvoice(t *testing.T) {
 	registry, cleanup := newTestContext(t)
 	defer cleanup()
 
-	allSubscriptions := registry.SubscribeNotifications(0, 0)
+	allSubscriptions := registry.SubscribeNotifications(0, 0, false)
 	defer allSubscriptions.Cancel()
 
 	// Subscribe to the not yet existing invoice.
-	subscription, err := registry.SubscribeSingleInvoice(hash)
+	subscription, err := registry.SubscribeSingleInvoice(hash, false)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -248,7 +252,7 @@ func TestCancelInvoice(t *testing.T) {
 	registry, cleanup := newTestContext(t)
 	defer cleanup()
 
-	allSubscriptions := registry.SubscribeNotifications(0, 0)
+	allSubscriptions := registry.SubscribeNotifications(0, 0, false)
 	defer allSubscriptions.Cancel()
 
 	// Try to cancel the not yet existing invoice. This should fail.
@@ -258,7 +262,7 @@ func TestCancelInvoice(t *testing.T) {
 	}
 
 	// Subscribe to the not yet existing invoice.
-	subscription, err := registry.SubscribeSingleInvoice(hash)
+	subscription, err := registry.SubscribeSingleInvoice(hash, false)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -365,7 +369,9 @@ func TestSettleHoldInvoice(t *testing.T) {
 	defer cleanup()
 
 	// Instantiate and start the invoice registry.
-	registry := NewRegistry(cdb, testFinalCltvRejectDelta)
+	registry := NewRegistry(cdb, &RegistryConfig{
+		FinalCltvRejectDelta: testFinalCltvRejectDelta,
+	})
 
 	err = registry.Start()
 	if err != nil {
@@ -373,11 +379,11 @@ func TestSettleHoldInvoice(t *testing.T) {
 	}
 	defer registry.Stop()
 
-	allSubscriptions := registry.SubscribeNotifications(0, 0)
+	allSubscriptions := registry.SubscribeNotifications(0, 0, false)
 	defer allSubscriptions.Cancel()
 
 	// Subscribe to the not yet existing invoice.
-	subscription, err := registry.SubscribeSingleInvoice(hash)
+	subscription, err := registry.SubscribeSingleInvoice(hash, false)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -543,7 +549,9 @@ func TestCancelHoldInvoice(t *testing.T) {
 	defer cleanup()
 
 	// Instantiate and start the invoice registry.
-	registry := NewRegistry(cdb, testFinalCltvRejectDelta)
+	registry := NewRegistry(cdb, &RegistryConfig{
+		FinalCltvRejectDelta: testFinalCltvRejectDelta,
+	})
 
 	err = registry.Start()
 	if err != nil {
@@ -610,6 +618,127 @@ func TestCancelHoldInvoice(t *testing.T) {
 	}
 }
 
+// TestCancelHoldInvoiceHtlcs tests that individual htlcs of an accepted hold
+// invoice can be canceled without canceling the invoice itself.
+func TestCancelHoldInvoiceHtlcs(t *testing.T) {
+	defer timeout(t)()
+
+	registry, cleanup := newTestContext(t)
+	defer cleanup()
+
+	invoice := &channeldb.Invoice{
+		Terms: channeldb.ContractTerm{
+			PaymentPreimage: channeldb.UnknownPreimage,
+			Value:           lnwire.MilliAtom(100000),
+		},
+	}
+
+	_, err := registry.AddInvoice(invoice, hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	amtPaid := lnwire.MilliAtom(100000)
+
+	// Have two htlcs paying to the invoice accepted.
+	hodlChans := make([]chan interface{}, 3)
+	for i := range hodlChans {
+		hodlChans[i] = make(chan interface{}, 1)
+	}
+	for i := 0; i < 2; i++ {
+		event, err := registry.NotifyExitHopHtlc(
+			hash, amtPaid, testHtlcExpiry, testCurrentHeight,
+			getCircuitKey(uint64(i)), hodlChans[i], nil,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if event != nil {
+			t.Fatalf("expected htlc %v to be held", i)
+		}
+	}
+
+	assertState := func(state channeldb.ContractState,
+		amtPaid lnwire.MilliAtom) {
+
+		t.Helper()
+
+		inv, err := registry.LookupInvoice(hash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if inv.Terms.State != state {
+			t.Fatalf("expected state %v, but got %v", state,
+				inv.Terms.State)
+		}
+		if inv.AmtPaid != amtPaid {
+			t.Fatalf("expected amount paid %v, but got %v",
+				amtPaid, inv.AmtPaid)
+		}
+	}
+
+	// Canceling the first htlc should only resolve that htlc, leaving the
+	// invoice accepted.
+	err = registry.CancelHtlcs(
+		hash, []channeldb.CircuitKey{getCircuitKey(0)},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hodlEvent := (<-hodlChans[0]).(HodlEvent)
+	if hodlEvent.Preimage != nil || hodlEvent.Result != ResultCanceled {
+		t.Fatal("expected cancel hodl event")
+	}
+	select {
+	case <-hodlChans[1]:
+		t.Fatal("unexpected resolution of second htlc")
+	default:
+	}
+	assertState(channeldb.ContractAccepted, amtPaid)
+
+	// Canceling it again should succeed without affecting the invoice.
+	err = registry.CancelHtlcs(
+		hash, []channeldb.CircuitKey{getCircuitKey(0)},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertState(channeldb.ContractAccepted, amtPaid)
+
+	// Unknown htlcs can't be canceled.
+	err = registry.CancelHtlcs(
+		hash, []channeldb.CircuitKey{getCircuitKey(5)},
+	)
+	if err == nil {
+		t.Fatal("expected cancel of unknown htlc to fail")
+	}
+
+	// Once the last accepted htlc is canceled, the invoice should move
+	// back to the open state.
+	err = registry.CancelHtlcs(
+		hash, []channeldb.CircuitKey{getCircuitKey(1)},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-hodlChans[1]
+	assertState(channeldb.ContractOpen, 0)
+
+	// A new htlc should then be accepted again.
+	event, err := registry.NotifyExitHopHtlc(
+		hash, amtPaid, testHtlcExpiry, testCurrentHeight,
+		getCircuitKey(2), hodlChans[2], nil,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event != nil {
+		t.Fatal("expected htlc to be held")
+	}
+	assertState(channeldb.ContractAccepted, amtPaid)
+}
+
 func newDB() (*channeldb.DB, func(), error) {
 	// First, create a temporary directory to be used for the duration of
 	// this test.
@@ -654,3 +783,868 @@ func TestUnknownInvoice(t *testing.T) {
 		t.Fatal("expected invoice not found error")
 	}
 }
+
+// TestExitHopPayloadMismatch tests that htlcs whose final hop onion payload
+// doesn't match the htlc are rejected with a typed result, that the rejection
+// is recorded in the invoice and that a replay yields the same result.
+func TestExitHopPayloadMismatch(t *testing.T) {
+	registry, cleanup := newTestContext(t)
+	defer cleanup()
+
+	_, err := registry.AddInvoice(testInvoice, hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	amt := testInvoice.Terms.Value
+	hodlChan := make(chan interface{}, 1)
+
+	tests := []struct {
+		name    string
+		key     channeldb.CircuitKey
+		payload *ExitHopPayload
+		result  ResolutionResult
+		reason  channeldb.HtlcRejectReason
+		code    lnwire.FailCode
+	}{
+		{
+			name: "incorrect amount",
+			key:  getCircuitKey(0),
+			payload: &ExitHopPayload{
+				AmtToForward: amt - 1,
+				OutgoingCltv: testHtlcExpiry,
+			},
+			result: ResultIncorrectHtlcAmount,
+			reason: channeldb.HtlcRejectIncorrectAmount,
+			code:   lnwire.CodeFinalIncorrectHtlcAmount,
+		},
+		{
+			name: "incorrect expiry",
+			key:  getCircuitKey(1),
+			payload: &ExitHopPayload{
+				AmtToForward: amt,
+				OutgoingCltv: testHtlcExpiry + 1,
+			},
+			result: ResultIncorrectHtlcExpiry,
+			reason: channeldb.HtlcRejectIncorrectExpiry,
+			code:   lnwire.CodeFinalIncorrectCltvExpiry,
+		},
+	}
+
+	for _, test := range tests {
+		// Notify the htlc twice, the second time simulating a replay
+		// after a restart.
+		for i := 0; i < 2; i++ {
+			event, err := registry.NotifyExitHopHtlc(
+				hash, amt, testHtlcExpiry, testCurrentHeight,
+				test.key, hodlChan, test.payload,
+			)
+			if err != nil {
+				t.Fatalf("%v: %v", test.name, err)
+			}
+			if event.Preimage != nil {
+				t.Fatalf("%v: expected cancel event", test.name)
+			}
+			if event.Result != test.result {
+				t.Fatalf("%v: expected result %v, got %v",
+					test.name, test.result, event.Result)
+			}
+
+			failure := event.Result.FailureMessage(
+				amt, testHtlcExpiry, uint32(testCurrentHeight),
+			)
+			if failure.Code() != test.code {
+				t.Fatalf("%v: expected failure %v, got %v",
+					test.name, test.code, failure.Code())
+			}
+		}
+
+		inv, err := registry.LookupInvoice(hash)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		htlc, ok := inv.Htlcs[test.key]
+		if !ok {
+			t.Fatalf("%v: rejected htlc not recorded", test.name)
+		}
+		if htlc.State != channeldb.HtlcStateCanceled {
+			t.Fatalf("%v: expected canceled htlc, got %v",
+				test.name, htlc.State)
+		}
+		if htlc.RejectReason != test.reason {
+			t.Fatalf("%v: expected reject reason %v, got %v",
+				test.name, test.reason, htlc.RejectReason)
+		}
+		if inv.AmtPaid != 0 {
+			t.Fatalf("%v: rejected htlc counted in amount paid",
+				test.name)
+		}
+		if inv.Terms.State != channeldb.ContractOpen {
+			t.Fatalf("%v: invoice state changed to %v", test.name,
+				inv.Terms.State)
+		}
+	}
+
+	// A correct payload should still be able to settle the invoice.
+	event, err := registry.NotifyExitHopHtlc(
+		hash, amt, testHtlcExpiry, testCurrentHeight, getCircuitKey(2),
+		hodlChan, &ExitHopPayload{
+			AmtToForward: amt,
+			OutgoingCltv: testHtlcExpiry,
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.Preimage == nil || event.Result != ResultSettled {
+		t.Fatalf("expected settle event, got %v", event.Result)
+	}
+}
+
+// TestPaymentAddrMismatch tests that htlcs paying to an invoice that requires a
+// payment address are rejected unless their final hop payload carries the
+// invoice's payment address.
+func TestPaymentAddrMismatch(t *testing.T) {
+	registry, cleanup := newTestContext(t)
+	defer cleanup()
+
+	payAddr := [32]byte{5}
+	invoicePreimage := lntypes.Preimage{6}
+	invoiceHash := invoicePreimage.Hash()
+	invoice := &channeldb.Invoice{
+		Terms: channeldb.ContractTerm{
+			PaymentPreimage: invoicePreimage,
+			Value:           lnwire.MilliAtom(100000),
+			PaymentAddr:     payAddr,
+		},
+	}
+	if _, err := registry.AddInvoice(invoice, invoiceHash); err != nil {
+		t.Fatal(err)
+	}
+
+	amt := invoice.Terms.Value
+	hodlChan := make(chan interface{}, 1)
+
+	tests := []struct {
+		name string
+		mpp  *record.MPP
+	}{
+		{
+			name: "no mpp record",
+		},
+		{
+			name: "incorrect payment addr",
+			mpp:  record.NewMPP(amt, [32]byte{7}),
+		},
+	}
+
+	for i, test := range tests {
+		event, err := registry.NotifyExitHopHtlc(
+			invoiceHash, amt, testHtlcExpiry, testCurrentHeight,
+			getCircuitKey(uint64(i)), hodlChan, &ExitHopPayload{
+				AmtToForward: amt,
+				OutgoingCltv: testHtlcExpiry,
+				MPP:          test.mpp,
+			},
+		)
+		if err != nil {
+			t.Fatalf("%v: %v", test.name, err)
+		}
+		if event.Result != ResultAddressMismatch {
+			t.Fatalf("%v: expected result %v, got %v", test.name,
+				ResultAddressMismatch, event.Result)
+		}
+
+		// The failure shouldn't reveal that the invoice exists.
+		failure := event.Result.FailureMessage(
+			amt, testHtlcExpiry, uint32(testCurrentHeight),
+		)
+		if failure.Code() != lnwire.CodeIncorrectOrUnknownPaymentDetails {
+			t.Fatalf("%v: unexpected failure %v", test.name,
+				failure.Code())
+		}
+	}
+
+	// An htlc carrying the correct payment address settles the invoice.
+	event, err := registry.NotifyExitHopHtlc(
+		invoiceHash, amt, testHtlcExpiry, testCurrentHeight,
+		getCircuitKey(uint64(len(tests))), hodlChan, &ExitHopPayload{
+			AmtToForward: amt,
+			OutgoingCltv: testHtlcExpiry,
+			MPP:          record.NewMPP(amt, payAddr),
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.Preimage == nil || event.Result != ResultSettled {
+		t.Fatalf("expected settle event, got %v", event.Result)
+	}
+}
+
+// TestAMPInvoice tests that htlcs carrying an AMP record settle a reusable
+// invoice with their own derived preimage, leaving the invoice open, and that
+// htlcs not matching the kind of invoice are rejected.
+func TestAMPInvoice(t *testing.T) {
+	registry, cleanup := newTestContext(t)
+	defer cleanup()
+
+	ampPreimage := lntypes.Preimage{2}
+	invoiceHash := ampPreimage.Hash()
+	ampInvoice := &channeldb.Invoice{
+		Terms: channeldb.ContractTerm{
+			PaymentPreimage: ampPreimage,
+		},
+		AMP: true,
+	}
+	if _, err := registry.AddInvoice(ampInvoice, invoiceHash); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := registry.AddInvoice(testInvoice, hash); err != nil {
+		t.Fatal(err)
+	}
+
+	amt := lnwire.MilliAtom(100000)
+	hodlChan := make(chan interface{}, 1)
+	rootShare := [32]byte{3}
+	setID := [32]byte{4}
+
+	notify := func(rHash lntypes.Hash, key channeldb.CircuitKey,
+		amp *record.AMP) *HodlEvent {
+
+		event, err := registry.NotifyExitHopHtlc(
+			rHash, amt, testHtlcExpiry, testCurrentHeight, key,
+			hodlChan, &ExitHopPayload{
+				AmtToForward: amt,
+				OutgoingCltv: testHtlcExpiry,
+				AMP:          amp,
+			},
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return event
+	}
+
+	// An htlc without an AMP record can't pay to the AMP invoice.
+	event := notify(invoiceHash, getCircuitKey(0), nil)
+	if event.Preimage != nil || event.Result != ResultAMPRequired {
+		t.Fatalf("expected amp required, got %v", event.Result)
+	}
+
+	// Neither can an htlc whose AMP record doesn't derive its payment
+	// hash.
+	amp := record.NewAMP(invoiceHash, rootShare, setID, 0)
+	event = notify(invoiceHash, getCircuitKey(1), amp)
+	if event.Preimage != nil || event.Result != ResultInvalidAMP {
+		t.Fatalf("expected invalid amp, got %v", event.Result)
+	}
+
+	// A regular invoice doesn't accept AMP records.
+	event = notify(hash, getCircuitKey(2), record.NewAMP(
+		hash, rootShare, setID, 0,
+	))
+	if event.Preimage != nil || event.Result != ResultInvalidAMP {
+		t.Fatalf("expected invalid amp, got %v", event.Result)
+	}
+
+	// Valid AMP htlcs should each be settled with their own preimage.
+	for i := uint32(0); i < 2; i++ {
+		amp := record.NewAMP(invoiceHash, rootShare, setID, i)
+		key := getCircuitKey(uint64(3 + i))
+
+		event := notify(amp.ChildHash(), key, amp)
+		if event.Result != ResultSettled {
+			t.Fatalf("expected settle, got %v", event.Result)
+		}
+		if event.Preimage == nil ||
+			*event.Preimage != amp.ChildPreimage() {
+
+			t.Fatalf("expected child preimage")
+		}
+
+		// A replay without the onion payload, as done by the contract
+		// resolvers, should find the htlc through its payment hash.
+		event, err := registry.NotifyExitHopHtlc(
+			amp.ChildHash(), amt, testHtlcExpiry, testCurrentHeight,
+			key, hodlChan, nil,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if event.Result != ResultReplayToSettled {
+			t.Fatalf("expected replay to settled, got %v",
+				event.Result)
+		}
+		if event.Preimage == nil ||
+			*event.Preimage != amp.ChildPreimage() {
+
+			t.Fatalf("expected child preimage on replay")
+		}
+	}
+
+	// The invoice should remain open after being paid twice.
+	inv, err := registry.LookupInvoice(invoiceHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inv.Terms.State != channeldb.ContractOpen {
+		t.Fatalf("expected open invoice, got %v", inv.Terms.State)
+	}
+	if inv.AmtPaid != 2*amt {
+		t.Fatalf("expected amount paid %v, got %v", 2*amt,
+			inv.AmtPaid)
+	}
+	if len(inv.HTLCSet(setID)) != 2 {
+		t.Fatalf("expected 2 htlcs in amp set")
+	}
+}
+
+// TestKeySend tests that spontaneous keysend payments are only accepted when
+// enabled, and that they are either settled right away or held until they are
+// settled explicitly, depending on the configuration.
+func TestKeySend(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		registry, cleanup := newTestContext(t)
+		defer cleanup()
+
+		keySendPreimage := lntypes.Preimage{5}
+		_, err := registry.NotifyExitHopHtlc(
+			keySendPreimage.Hash(), 1000, testHtlcExpiry,
+			testCurrentHeight, getCircuitKey(0),
+			make(chan interface{}, 1), &ExitHopPayload{
+				AmtToForward: 1000,
+				OutgoingCltv: testHtlcExpiry,
+				KeySend:      &keySendPreimage,
+			},
+		)
+		if err != channeldb.ErrInvoiceNotFound {
+			t.Fatalf("expected ErrInvoiceNotFound, got %v", err)
+		}
+	})
+	t.Run("settle", func(t *testing.T) {
+		testKeySend(t, false)
+	})
+	t.Run("hold", func(t *testing.T) {
+		testKeySend(t, true)
+	})
+}
+
+func testKeySend(t *testing.T, keySendHold bool) {
+	defer timeout(t)()
+
+	cdb, cleanup, err := newDB()
+	if err != nil {
+		t.Fatalf("unable to create db: %v", err)
+	}
+	defer cleanup()
+
+	registry := NewRegistry(cdb, &RegistryConfig{
+		FinalCltvRejectDelta: testFinalCltvRejectDelta,
+		AcceptKeySend:        true,
+		KeySendHold:          keySendHold,
+	})
+	if err := registry.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer registry.Stop()
+
+	allSubscriptions := registry.SubscribeNotifications(0, 0, false)
+	defer allSubscriptions.Cancel()
+
+	hodlChan := make(chan interface{}, 1)
+	amt := lnwire.MilliAtom(1000)
+	keySendPreimage := lntypes.Preimage{5}
+	keySendHash := keySendPreimage.Hash()
+
+	notify := func(key channeldb.CircuitKey,
+		preimage lntypes.Preimage) *HodlEvent {
+
+		event, err := registry.NotifyExitHopHtlc(
+			keySendHash, amt, testHtlcExpiry, testCurrentHeight,
+			key, hodlChan, &ExitHopPayload{
+				AmtToForward: amt,
+				OutgoingCltv: testHtlcExpiry,
+				KeySend:      &preimage,
+			},
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return event
+	}
+
+	// A keysend payment with a preimage that doesn't match the htlc should
+	// be rejected.
+	event := notify(getCircuitKey(0), lntypes.Preimage{6})
+	if event == nil || event.Preimage != nil ||
+		event.Result != ResultKeySendError {
+
+		t.Fatalf("expected keysend error")
+	}
+
+	// A valid keysend payment should create an invoice for the payment.
+	event = notify(getCircuitKey(1), keySendPreimage)
+
+	select {
+	case newInvoice := <-allSubscriptions.NewInvoices:
+		if newInvoice.Terms.Value != amt {
+			t.Fatalf("expected invoice value %v, got %v", amt,
+				newInvoice.Terms.Value)
+		}
+	case <-time.After(testTimeout):
+		t.Fatal("no invoice add notification")
+	}
+
+	// Without the hold policy, the payment is settled right away.
+	if !keySendHold {
+		if event == nil || event.Preimage == nil ||
+			*event.Preimage != keySendPreimage {
+
+			t.Fatalf("expected settle event")
+		}
+
+		inv, err := registry.LookupInvoice(keySendHash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if inv.Terms.State != channeldb.ContractSettled {
+			t.Fatalf("expected settled invoice, got %v",
+				inv.Terms.State)
+		}
+
+		return
+	}
+
+	// Otherwise, the htlc is held and the invoice accepted.
+	if event != nil {
+		t.Fatalf("expected htlc to be held")
+	}
+
+	inv, err := registry.LookupInvoice(keySendHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inv.Terms.State != channeldb.ContractAccepted {
+		t.Fatalf("expected accepted invoice, got %v", inv.Terms.State)
+	}
+
+	// A replay of the htlc should still be held.
+	event = notify(getCircuitKey(1), keySendPreimage)
+	if event != nil {
+		t.Fatalf("expected htlc to be held")
+	}
+
+	// Settling the invoice should release the htlc.
+	if err := registry.SettleHodlInvoice(keySendPreimage); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case resolution := <-hodlChan:
+		hodlEvent := resolution.(HodlEvent)
+		if hodlEvent.Preimage == nil ||
+			*hodlEvent.Preimage != keySendPreimage {
+
+			t.Fatalf("expected settle event")
+		}
+	case <-time.After(testTimeout):
+		t.Fatal("no settle event")
+	}
+}
+
+// mockResolution is a response of the mock preimage resolver.
+type mockResolution struct {
+	preimage lntypes.Preimage
+	err      error
+}
+
+// mockPreimageResolver is a preimage resolver that hands out requests over a
+// channel and waits for the test to provide a response.
+type mockPreimageResolver struct {
+	requests    chan *PreimageRequest
+	resolutions chan mockResolution
+}
+
+func (m *mockPreimageResolver) ResolvePreimage(req *PreimageRequest,
+	quit <-chan struct{}) (lntypes.Preimage, error) {
+
+	select {
+	case m.requests <- req:
+	case <-quit:
+		return lntypes.Preimage{}, ErrShuttingDown
+	}
+
+	select {
+	case res := <-m.resolutions:
+		return res.preimage, res.err
+	case <-quit:
+		return lntypes.Preimage{}, ErrShuttingDown
+	}
+}
+
+// TestExternalPreimageResolver asserts that an accepted htlc for a hold
+// invoice is settled using the preimage provided by a registered external
+// resolver, and stays held if the resolver doesn't know the preimage.
+func TestExternalPreimageResolver(t *testing.T) {
+	defer timeout(t)()
+
+	registry, cleanup := newTestContext(t)
+	defer cleanup()
+
+	resolver := &mockPreimageResolver{
+		requests:    make(chan *PreimageRequest),
+		resolutions: make(chan mockResolution),
+	}
+	err := registry.RegisterPreimageResolver(resolver, testTimeout)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Only a single resolver can be registered at a time.
+	err = registry.RegisterPreimageResolver(
+		&mockPreimageResolver{}, testTimeout,
+	)
+	if err != ErrResolverAlreadyRegistered {
+		t.Fatalf("expected ErrResolverAlreadyRegistered, got %v", err)
+	}
+
+	invoice := &channeldb.Invoice{
+		Terms: channeldb.ContractTerm{
+			PaymentPreimage: channeldb.UnknownPreimage,
+			Value:           lnwire.MilliAtom(100000),
+		},
+	}
+	_, err = registry.AddInvoice(invoice, hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	amtPaid := lnwire.MilliAtom(100000)
+	hodlChan := make(chan interface{}, 1)
+
+	// The first htlc is accepted, after which the resolver is asked for
+	// the preimage. As it doesn't know it, the htlc should remain held.
+	event, err := registry.NotifyExitHopHtlc(
+		hash, amtPaid, testHtlcExpiry, testCurrentHeight,
+		getCircuitKey(0), hodlChan, nil,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event != nil {
+		t.Fatalf("expected htlc to be held")
+	}
+
+	req := <-resolver.requests
+	if req.PaymentHash != hash || req.AmtPaid != amtPaid {
+		t.Fatalf("unexpected preimage request: %v", req)
+	}
+	resolver.resolutions <- mockResolution{err: ErrPreimageUnknown}
+
+	select {
+	case <-hodlChan:
+		t.Fatalf("htlc resolved without preimage")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Wait for the failed request to be cleaned up, so a new one can be
+	// made for the same payment hash.
+	for {
+		registry.resolverMtx.Lock()
+		numPending := len(registry.pendingPreimageReqs)
+		registry.resolverMtx.Unlock()
+
+		if numPending == 0 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// Replaying the htlc should trigger a new request, which will now be
+	// answered with the preimage, settling the invoice.
+	event, err = registry.NotifyExitHopHtlc(
+		hash, amtPaid, testHtlcExpiry, testCurrentHeight,
+		getCircuitKey(0), hodlChan, nil,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event != nil {
+		t.Fatalf("expected htlc to be held")
+	}
+	<-resolver.requests
+	resolver.resolutions <- mockResolution{preimage: preimage}
+
+	hodlEvent := (<-hodlChan).(HodlEvent)
+	if hodlEvent.Preimage == nil || *hodlEvent.Preimage != preimage {
+		t.Fatalf("expected settle hodl event with preimage")
+	}
+
+	inv, err := registry.LookupInvoice(hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inv.Terms.State != channeldb.ContractSettled {
+		t.Fatalf("expected invoice to be settled, got %v",
+			inv.Terms.State)
+	}
+
+	// Once unregistered, a new resolver can be registered.
+	registry.UnregisterPreimageResolver(resolver)
+	err = registry.RegisterPreimageResolver(
+		&mockPreimageResolver{}, testTimeout,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestInvoiceExpiry asserts that open invoices are canceled once they expire,
+// both when they were loaded at startup and when added afterwards, that the
+// cancel events are sent to the subscribers that asked for them and that old
+// canceled invoices are deleted.
+func TestInvoiceExpiry(t *testing.T) {
+	defer timeout(t)()
+
+	testClock := clock.NewTestClock(time.Now())
+
+	cdb, cleanup, err := newDB()
+	if err != nil {
+		t.Fatalf("unable to create db: %v", err)
+	}
+	defer cleanup()
+
+	newInvoice := func(preimage lntypes.Preimage,
+		creationDate time.Time) *channeldb.Invoice {
+
+		return &channeldb.Invoice{
+			CreationDate: creationDate,
+			Expiry:       time.Hour,
+			Terms: channeldb.ContractTerm{
+				PaymentPreimage: preimage,
+				Value:           lnwire.MilliAtom(100000),
+			},
+		}
+	}
+
+	// Add an expired invoice before the registry is started.
+	expiredDate := testClock.Now().Add(-2 * time.Hour)
+	startupPreimage := lntypes.Preimage{1}
+	_, err = cdb.AddInvoice(
+		newInvoice(startupPreimage, expiredDate),
+		startupPreimage.Hash(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	registry := NewRegistry(cdb, &RegistryConfig{
+		FinalCltvRejectDelta:     testFinalCltvRejectDelta,
+		CancelExpiredInvoices:    true,
+		CanceledInvoiceRetention: time.Hour,
+		Clock:                    testClock,
+	})
+	if err := registry.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer registry.Stop()
+
+	allSubscriptions := registry.SubscribeNotifications(0, 0, true)
+	defer allSubscriptions.Cancel()
+
+	// The invoice loaded at startup should be canceled right away.
+	for {
+		invoice, err := cdb.LookupInvoice(startupPreimage.Hash())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if invoice.Terms.State == channeldb.ContractCanceled {
+			break
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// Add an expired and an open invoice to the registry.
+	expiredPreimage := lntypes.Preimage{2}
+	_, err = registry.AddInvoice(
+		newInvoice(expiredPreimage, expiredDate),
+		expiredPreimage.Hash(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	openPreimage := lntypes.Preimage{3}
+	_, err = registry.AddInvoice(
+		newInvoice(openPreimage, testClock.Now()), openPreimage.Hash(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Both invoices are notified as new invoices, but only the expired
+	// invoice is canceled. The cancel event of the invoice loaded at
+	// startup may be received as well, depending on whether it was
+	// canceled before we subscribed.
+	var numNew int
+	var expiredCanceled bool
+	for numNew < 2 || !expiredCanceled {
+		select {
+		case <-allSubscriptions.NewInvoices:
+			numNew++
+
+		case invoice := <-allSubscriptions.CanceledInvoices:
+			switch invoice.Terms.PaymentPreimage {
+			case expiredPreimage:
+				expiredCanceled = true
+			case startupPreimage:
+			default:
+				t.Fatalf("unexpected canceled invoice")
+			}
+
+		case <-time.After(testTimeout):
+			t.Fatal("no update received")
+		}
+	}
+
+	invoice, err := registry.LookupInvoice(openPreimage.Hash())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if invoice.Terms.State != channeldb.ContractOpen {
+		t.Fatalf("expected invoice to be open, got %v",
+			invoice.Terms.State)
+	}
+
+	// Both canceled invoices are older than the retention window, so they
+	// should be deleted.
+	registry.expiryWatcher.deleteCanceledInvoices()
+
+	for _, preimage := range []lntypes.Preimage{
+		startupPreimage, expiredPreimage,
+	} {
+		_, err := cdb.LookupInvoice(preimage.Hash())
+		if err != channeldb.ErrInvoiceNotFound {
+			t.Fatalf("expected ErrInvoiceNotFound, got %v", err)
+		}
+	}
+
+	if _, err := cdb.LookupInvoice(openPreimage.Hash()); err != nil {
+		t.Fatal(err)
+	}
+
+	// Once the clock moves past its expiry, the open invoice should be
+	// canceled as well.
+	testClock.SetTime(testClock.Now().Add(2 * time.Hour))
+
+	select {
+	case invoice := <-allSubscriptions.CanceledInvoices:
+		if invoice.Terms.PaymentPreimage != openPreimage {
+			t.Fatalf("unexpected canceled invoice")
+		}
+
+	case <-time.After(testTimeout):
+		t.Fatal("no update received")
+	}
+}
+
+// TestSingleInvoiceHtlcUpdates asserts that single invoice subscribers that
+// asked for htlc updates are notified of htlcs that don't change the state of
+// the invoice, while other subscribers aren't.
+func TestSingleInvoiceHtlcUpdates(t *testing.T) {
+	defer timeout(t)()
+
+	registry, cleanup := newTestContext(t)
+	defer cleanup()
+
+	htlcSubscription, err := registry.SubscribeSingleInvoice(hash, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer htlcSubscription.Cancel()
+
+	subscription, err := registry.SubscribeSingleInvoice(hash, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer subscription.Cancel()
+
+	// Give some time for the subscriptions to be processed.
+	time.Sleep(time.Millisecond * 5)
+
+	// Add a hold invoice.
+	invoice := &channeldb.Invoice{
+		Terms: channeldb.ContractTerm{
+			PaymentPreimage: channeldb.UnknownPreimage,
+			Value:           lnwire.MilliAtom(100000),
+		},
+	}
+	if _, err := registry.AddInvoice(invoice, hash); err != nil {
+		t.Fatal(err)
+	}
+
+	assertUpdate := func(sub *SingleInvoiceSubscription,
+		state channeldb.ContractState, numHtlcs int) {
+
+		t.Helper()
+
+		update := <-sub.Updates
+		if update.Terms.State != state {
+			t.Fatalf("expected state %v, got %v", state,
+				update.Terms.State)
+		}
+		if len(update.Htlcs) != numHtlcs {
+			t.Fatalf("expected %v htlcs, got %v", numHtlcs,
+				len(update.Htlcs))
+		}
+	}
+
+	assertUpdate(htlcSubscription, channeldb.ContractOpen, 0)
+	assertUpdate(subscription, channeldb.ContractOpen, 0)
+
+	// An htlc that pays too little is rejected, leaving the invoice open.
+	hodlChan := make(chan interface{}, 1)
+	_, err = registry.NotifyExitHopHtlc(
+		hash, 1000, testHtlcExpiry, testCurrentHeight,
+		getCircuitKey(0), hodlChan, nil,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertUpdate(htlcSubscription, channeldb.ContractOpen, 1)
+
+	// Accept the invoice with a second htlc, which all subscribers are
+	// notified of.
+	amt := lnwire.MilliAtom(100000)
+	_, err = registry.NotifyExitHopHtlc(
+		hash, amt, testHtlcExpiry, testCurrentHeight,
+		getCircuitKey(1), hodlChan, nil,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertUpdate(htlcSubscription, channeldb.ContractAccepted, 2)
+	assertUpdate(subscription, channeldb.ContractAccepted, 2)
+
+	// A third htlc paying to the accepted invoice is only notified to the
+	// subscriber that asked for htlc updates.
+	_, err = registry.NotifyExitHopHtlc(
+		hash, amt, testHtlcExpiry, testCurrentHeight,
+		getCircuitKey(2), hodlChan, nil,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertUpdate(htlcSubscription, channeldb.ContractAccepted, 3)
+
+	select {
+	case update := <-subscription.Updates:
+		t.Fatalf("unexpected update: %v", update.Terms.State)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -0,0 +1,188 @@
+package invoices
+
+import (
+	"errors"
+	"time"
+
+	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/lntypes"
+	"github.com/decred/dcrlnd/lnwire"
+)
+
+const (
+	// DefaultPreimageResolverTimeout is the default amount of time we'll
+	// wait for an external preimage resolver to respond to a request.
+	DefaultPreimageResolverTimeout = 30 * time.Second
+)
+
+var (
+	// ErrResolverAlreadyRegistered is returned when a preimage resolver is
+	// registered while another one is still active.
+	ErrResolverAlreadyRegistered = errors.New("preimage resolver already " +
+		"registered")
+
+	// ErrPreimageUnknown is returned by a preimage resolver when it
+	// doesn't know the preimage for the requested payment hash.
+	ErrPreimageUnknown = errors.New("preimage unknown")
+
+	// ErrPreimageResolverTimeout is returned when a preimage resolver
+	// didn't respond to a request in time.
+	ErrPreimageResolverTimeout = errors.New("preimage resolver timeout")
+)
+
+// PreimageRequest describes an accepted htlc for which the preimage is
+// requested from an external preimage resolver.
+type PreimageRequest struct {
+	// PaymentHash is the hash of the hold invoice that was paid to.
+	PaymentHash lntypes.Hash
+
+	// AmtPaid is the amount of the htlc that was accepted.
+	AmtPaid lnwire.MilliAtom
+
+	// CircuitKey is the key of the htlc that was accepted.
+	CircuitKey channeldb.CircuitKey
+}
+
+// PreimageResolver is an external service that is able to provide the
+// preimage of hold invoices for which the daemon only knows the payment hash.
+// Once a resolver is registered with the invoice registry, it is asked for
+// the preimage each time an htlc paying to a hold invoice is accepted. The htlc
+// is held until either the resolver returns the preimage, or the invoice is
+// settled or canceled through other means.
+type PreimageResolver interface {
+	// ResolvePreimage requests the preimage matching the passed request.
+	// If the resolver doesn't know the preimage, ErrPreimageUnknown should
+	// be returned. The quit channel is closed when the registry is no
+	// longer interested in a response, in which case the call should
+	// return as soon as possible.
+	ResolvePreimage(req *PreimageRequest,
+		quit <-chan struct{}) (lntypes.Preimage, error)
+}
+
+// RegisterPreimageResolver registers an external preimage resolver with the
+// registry. Only a single resolver can be active at a time. Requests to the
+// resolver that aren't answered within the passed timeout are abandoned,
+// leaving the htlc held.
+func (i *InvoiceRegistry) RegisterPreimageResolver(resolver PreimageResolver,
+	timeout time.Duration) error {
+
+	i.resolverMtx.Lock()
+	defer i.resolverMtx.Unlock()
+
+	if i.preimageResolver != nil {
+		return ErrResolverAlreadyRegistered
+	}
+
+	if timeout == 0 {
+		timeout = DefaultPreimageResolverTimeout
+	}
+
+	log.Infof("Registered external preimage resolver, timeout=%v", timeout)
+
+	i.preimageResolver = resolver
+	i.preimageResolverTimeout = timeout
+
+	return nil
+}
+
+// UnregisterPreimageResolver removes the passed preimage resolver from the
+// registry, if it is the currently active one.
+func (i *InvoiceRegistry) UnregisterPreimageResolver(
+	resolver PreimageResolver) {
+
+	i.resolverMtx.Lock()
+	defer i.resolverMtx.Unlock()
+
+	if i.preimageResolver != resolver {
+		return
+	}
+
+	log.Infof("Unregistered external preimage resolver")
+
+	i.preimageResolver = nil
+}
+
+// requestPreimage asks the registered preimage resolver, if any, for the
+// preimage of the hold invoice the passed htlc was accepted for. The request
+// is carried out asynchronously. Only a single request is outstanding per
+// payment hash at a time.
+func (i *InvoiceRegistry) requestPreimage(req *PreimageRequest) {
+	i.resolverMtx.Lock()
+	defer i.resolverMtx.Unlock()
+
+	if i.preimageResolver == nil {
+		return
+	}
+
+	if _, ok := i.pendingPreimageReqs[req.PaymentHash]; ok {
+		return
+	}
+	i.pendingPreimageReqs[req.PaymentHash] = struct{}{}
+
+	i.wg.Add(1)
+	go i.resolvePreimage(
+		i.preimageResolver, i.preimageResolverTimeout, req,
+	)
+}
+
+// resolvePreimage requests the preimage for the passed request from the
+// resolver, and settles the hold invoice if a valid preimage is returned.
+//
+// NOTE: This MUST be run as a goroutine.
+func (i *InvoiceRegistry) resolvePreimage(resolver PreimageResolver,
+	timeout time.Duration, req *PreimageRequest) {
+
+	defer i.wg.Done()
+
+	defer func() {
+		i.resolverMtx.Lock()
+		delete(i.pendingPreimageReqs, req.PaymentHash)
+		i.resolverMtx.Unlock()
+	}()
+
+	log.Debugf("Invoice(%v): requesting preimage from external resolver",
+		req.PaymentHash)
+
+	quit := make(chan struct{})
+	type result struct {
+		preimage lntypes.Preimage
+		err      error
+	}
+	resultChan := make(chan result, 1)
+	go func() {
+		preimage, err := resolver.ResolvePreimage(req, quit)
+		resultChan <- result{preimage, err}
+	}()
+
+	var res result
+	select {
+	case res = <-resultChan:
+
+	case <-time.After(timeout):
+		res.err = ErrPreimageResolverTimeout
+
+	case <-i.quit:
+		close(quit)
+		return
+	}
+	close(quit)
+
+	if res.err != nil {
+		log.Warnf("Invoice(%v): unable to resolve preimage, holding "+
+			"htlc: %v", req.PaymentHash, res.err)
+		return
+	}
+
+	if res.preimage.Hash() != req.PaymentHash {
+		log.Warnf("Invoice(%v): external resolver returned invalid "+
+			"preimage %v, holding htlc", req.PaymentHash,
+			res.preimage)
+		return
+	}
+
+	err := i.SettleHodlInvoice(res.preimage)
+	if err != nil && err != channeldb.ErrInvoiceAlreadySettled {
+		log.Errorf("Invoice(%v): unable to settle with resolved "+
+			"preimage: %v", req.PaymentHash, err)
+	}
+}
@@ -0,0 +1,227 @@
+package invoices
+
+import (
+	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/lnwire"
+)
+
+// ResolutionResult provides metadata about how an incoming htlc was resolved
+// by the invoice registry.
+type ResolutionResult uint8
+
+const (
+	// ResultInvalid is an invalid resolution result.
+	ResultInvalid ResolutionResult = iota
+
+	// ResultReplayToCanceled is returned when we replay a canceled invoice.
+	ResultReplayToCanceled
+
+	// ResultReplayToAccepted is returned when we replay an accepted
+	// invoice.
+	ResultReplayToAccepted
+
+	// ResultReplayToSettled is returned when we replay a settled invoice.
+	ResultReplayToSettled
+
+	// ResultInvoiceAlreadyCanceled is returned when trying to pay an
+	// invoice that is already canceled.
+	ResultInvoiceAlreadyCanceled
+
+	// ResultAmountTooLow is returned when an invoice is underpaid.
+	ResultAmountTooLow
+
+	// ResultExpiryTooSoon is returned when we do not accept an invoice
+	// payment because it expires too soon.
+	ResultExpiryTooSoon
+
+	// ResultIncorrectHtlcAmount is returned when the amount in the final
+	// hop's onion payload doesn't match the amount of the htlc.
+	ResultIncorrectHtlcAmount
+
+	// ResultIncorrectHtlcExpiry is returned when the outgoing cltv in the
+	// final hop's onion payload doesn't match the expiry of the htlc.
+	ResultIncorrectHtlcExpiry
+
+	// ResultDuplicateToAccepted is returned when we accept a duplicate
+	// payment to an accepted invoice.
+	ResultDuplicateToAccepted
+
+	// ResultDuplicateToSettled is returned when we settle an invoice which
+	// has already been settled at least once.
+	ResultDuplicateToSettled
+
+	// ResultAccepted is returned when we accept a hodl invoice.
+	ResultAccepted
+
+	// ResultSettled is returned when we settle an invoice.
+	ResultSettled
+
+	// ResultCanceled is returned when a hodl invoice is canceled after
+	// the htlc was accepted.
+	ResultCanceled
+
+	// ResultInvalidAMP is returned when an htlc carries an AMP record that
+	// doesn't derive its payment hash, or pays to an invoice that doesn't
+	// accept AMP payments.
+	ResultInvalidAMP
+
+	// ResultAMPRequired is returned when an htlc without an AMP record
+	// pays to an AMP invoice.
+	ResultAMPRequired
+
+	// ResultKeySendError is returned when a keysend payment can't be
+	// accepted, because its preimage doesn't match the htlc or its
+	// invoice couldn't be created.
+	ResultKeySendError
+
+	// ResultAddressMismatch is returned when the payment address in the
+	// onion payload of an htlc doesn't match the one of the invoice.
+	ResultAddressMismatch
+)
+
+// String returns a human-readable representation of the invoice update
+// result.
+func (u ResolutionResult) String() string {
+	switch u {
+
+	case ResultInvalid:
+		return "invalid"
+
+	case ResultReplayToCanceled:
+		return "replayed htlc to canceled invoice"
+
+	case ResultReplayToAccepted:
+		return "replayed htlc to accepted invoice"
+
+	case ResultReplayToSettled:
+		return "replayed htlc to settled invoice"
+
+	case ResultInvoiceAlreadyCanceled:
+		return "invoice already canceled"
+
+	case ResultAmountTooLow:
+		return "amount too low"
+
+	case ResultExpiryTooSoon:
+		return "expiry too soon"
+
+	case ResultIncorrectHtlcAmount:
+		return "onion payload amount doesn't match htlc"
+
+	case ResultIncorrectHtlcExpiry:
+		return "onion payload cltv doesn't match htlc"
+
+	case ResultDuplicateToAccepted:
+		return "accepting duplicate payment to accepted invoice"
+
+	case ResultDuplicateToSettled:
+		return "accepting duplicate payment to settled invoice"
+
+	case ResultAccepted:
+		return "accepted"
+
+	case ResultSettled:
+		return "settled"
+
+	case ResultCanceled:
+		return "canceled"
+
+	case ResultInvalidAMP:
+		return "invalid amp payment"
+
+	case ResultAMPRequired:
+		return "amp payment required"
+
+	case ResultKeySendError:
+		return "invalid keysend payment"
+
+	case ResultAddressMismatch:
+		return "payment address mismatch"
+
+	default:
+		return "unknown"
+	}
+}
+
+// rejectReason returns the reason that is persisted along with an htlc that
+// was rejected with this result. Results that don't reject an htlc map to
+// channeldb.HtlcRejectNone.
+func (u ResolutionResult) rejectReason() channeldb.HtlcRejectReason {
+	switch u {
+	case ResultAmountTooLow:
+		return channeldb.HtlcRejectAmountTooLow
+
+	case ResultExpiryTooSoon:
+		return channeldb.HtlcRejectExpiryTooSoon
+
+	case ResultIncorrectHtlcAmount:
+		return channeldb.HtlcRejectIncorrectAmount
+
+	case ResultIncorrectHtlcExpiry:
+		return channeldb.HtlcRejectIncorrectExpiry
+
+	case ResultInvalidAMP:
+		return channeldb.HtlcRejectInvalidAMP
+
+	case ResultAMPRequired:
+		return channeldb.HtlcRejectAMPRequired
+
+	case ResultAddressMismatch:
+		return channeldb.HtlcRejectAddressMismatch
+
+	default:
+		return channeldb.HtlcRejectNone
+	}
+}
+
+// resultFromRejectReason maps a persisted reject reason back to the
+// resolution result that caused it. This allows replays of rejected htlcs to
+// be failed back with the original failure.
+func resultFromRejectReason(r channeldb.HtlcRejectReason) ResolutionResult {
+	switch r {
+	case channeldb.HtlcRejectAmountTooLow:
+		return ResultAmountTooLow
+
+	case channeldb.HtlcRejectExpiryTooSoon:
+		return ResultExpiryTooSoon
+
+	case channeldb.HtlcRejectIncorrectAmount:
+		return ResultIncorrectHtlcAmount
+
+	case channeldb.HtlcRejectIncorrectExpiry:
+		return ResultIncorrectHtlcExpiry
+
+	case channeldb.HtlcRejectInvalidAMP:
+		return ResultInvalidAMP
+
+	case channeldb.HtlcRejectAMPRequired:
+		return ResultAMPRequired
+
+	case channeldb.HtlcRejectAddressMismatch:
+		return ResultAddressMismatch
+
+	default:
+		return ResultReplayToCanceled
+	}
+}
+
+// FailureMessage returns the onion failure that should be sent back to the
+// sender of an htlc that was canceled with this result. The amount and height
+// are included in the failure where the failure type requires it. To avoid
+// leaking information about the invoice, every failure that isn't caused by a
+// mismatch between the htlc and the onion payload is reported as
+// incorrect_or_unknown_payment_details.
+func (u ResolutionResult) FailureMessage(amt lnwire.MilliAtom,
+	expiry, height uint32) lnwire.FailureMessage {
+
+	switch u {
+	case ResultIncorrectHtlcAmount:
+		return lnwire.NewFinalIncorrectHtlcAmount(amt)
+
+	case ResultIncorrectHtlcExpiry:
+		return lnwire.NewFinalIncorrectCltvExpiry(expiry)
+
+	default:
+		return lnwire.NewFailIncorrectDetails(amt, height)
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/decred/dcrd/dcrec/secp256k1/v2"
 	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/proxyproto"
 	"github.com/decred/dcrlnd/tor"
 )
 
@@ -101,6 +102,31 @@ func TLSListenOnAddress(addr net.Addr,
 	return tls.Listen(parseNetwork(addr), addr.String(), config)
 }
 
+// ProxyListenOnAddress creates a listener that listens on the given address,
+// and expects the accepted connections to start with a PROXY protocol header.
+func ProxyListenOnAddress(addr net.Addr) (net.Listener, error) {
+	lis, err := ListenOnAddress(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return proxyproto.NewListener(lis, proxyproto.DefaultHeaderTimeout), nil
+}
+
+// ProxyTLSListenOnAddress creates a TLS listener that listens on the given
+// address, and expects the accepted connections to start with a PROXY protocol
+// header preceding the TLS handshake.
+func ProxyTLSListenOnAddress(addr net.Addr,
+	config *tls.Config) (net.Listener, error) {
+
+	lis, err := ProxyListenOnAddress(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return tls.NewListener(lis, config), nil
+}
+
 // IsLoopback returns true if an address describes a loopback interface.
 func IsLoopback(addr string) bool {
 	for _, loopback := range loopBackAddrs {
@@ -109,7 +135,10 @@ func IsLoopback(addr string) bool {
 		}
 	}
 
-	return false
+	// IPv6 hosts split from their port lack the brackets of the loopback
+	// addresses above, so they're parsed instead.
+	ip := net.ParseIP(addr)
+	return ip != nil && ip.IsLoopback()
 }
 
 // IsUnix returns true if an address describes an Unix socket address.
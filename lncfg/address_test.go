@@ -37,6 +37,13 @@ var (
 		{"::1", "tcp", "[::1]:1234", true, false},
 		{"tcp6://::1", "tcp", "[::1]:1234", true, false},
 		{"tcp6:::1", "tcp", "[::1]:1234", true, false},
+		{
+			"[2001:db8::1]:9735",
+			"tcp",
+			"[2001:db8::1]:9735",
+			false,
+			false,
+		},
 		{"localhost:9735", "tcp", "127.0.0.1:9735", true, false},
 		{"localhost", "tcp", "127.0.0.1:1234", true, false},
 		{"unix:///tmp/lnd.sock", "unix", "/tmp/lnd.sock", false, true},
@@ -0,0 +1,47 @@
+package lncfg
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v2"
+)
+
+// ChanRecovery holds the configuration of the recovery of the channels lost
+// along with the channel database, once the wallet was restored from its
+// seed.
+type ChanRecovery struct {
+	// Peers are the addresses of the peers to reconnect to on startup, in
+	// the form <pubkey>@<host>, which are asked to force close any channel
+	// they hold with us that is unknown to the restored node.
+	Peers []string `long:"peer" description:"The address of a peer, in the form <pubkey>@<host>, reconnected to on startup in order to recover the channels missing from the static channel backups. When the peer tries to reestablish a channel unknown to the node, it is asked to force close it through the data loss protection protocol. Can be specified multiple times."`
+}
+
+// Validate checks that the recovery peers are identified by a valid public
+// key. Their host is only resolved on startup.
+func (c *ChanRecovery) Validate() error {
+	for _, peer := range c.Peers {
+		parts := strings.Split(peer, "@")
+		if len(parts) != 2 || parts[1] == "" {
+			return fmt.Errorf("invalid chanrecovery.peer %v: must "+
+				"be of the form <pubkey-hex>@<host>", peer)
+		}
+
+		pubKeyBytes, err := hex.DecodeString(parts[0])
+		if err != nil {
+			return fmt.Errorf("invalid chanrecovery.peer %v: %v",
+				peer, err)
+		}
+		if _, err := secp256k1.ParsePubKey(pubKeyBytes); err != nil {
+			return fmt.Errorf("invalid chanrecovery.peer %v: %v",
+				peer, err)
+		}
+	}
+
+	return nil
+}
+
+// Compile-time constraint to ensure ChanRecovery implements the Validator
+// interface.
+var _ Validator = (*ChanRecovery)(nil)
@@ -0,0 +1,59 @@
+package lncfg_test
+
+import (
+	"testing"
+
+	"github.com/decred/dcrlnd/lncfg"
+)
+
+// TestValidateChanRecovery asserts that validating the ChanRecovery config
+// only succeeds if all recovery peers are identified by a valid public key and
+// have a host.
+func TestValidateChanRecovery(t *testing.T) {
+	const pubKey = "02a1633cafcc01ebfb6d78e39f687a1f0995c62fc95f51ead10a02" +
+		"ee0be551b5dc"
+
+	tests := []struct {
+		name  string
+		peers []string
+		valid bool
+	}{
+		{
+			name:  "no peers",
+			valid: true,
+		},
+		{
+			name: "valid peers",
+			peers: []string{
+				pubKey + "@127.0.0.1:9735",
+				pubKey + "@example.com",
+			},
+			valid: true,
+		},
+		{
+			name:  "missing host",
+			peers: []string{pubKey + "@"},
+		},
+		{
+			name:  "missing pubkey",
+			peers: []string{"127.0.0.1:9735"},
+		},
+		{
+			name:  "invalid pubkey",
+			peers: []string{"02a1633c@127.0.0.1:9735"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := lncfg.ChanRecovery{Peers: test.peers}
+			err := cfg.Validate()
+			switch {
+			case test.valid && err != nil:
+				t.Fatalf("valid config was invalid: %v", err)
+			case !test.valid && err == nil:
+				t.Fatalf("invalid config was valid")
+			}
+		})
+	}
+}
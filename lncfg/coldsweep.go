@@ -0,0 +1,68 @@
+package lncfg
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultColdSweepInterval is the default time between two scheduled
+	// sweeps to cold storage.
+	DefaultColdSweepInterval = 24 * time.Hour
+
+	// DefaultColdSweepTargetConf is the default confirmation target used
+	// to estimate the fee rate of the sweeps to cold storage.
+	DefaultColdSweepTargetConf = 6
+)
+
+// ColdSweep holds the configuration of the job periodically sweeping the
+// confirmed balance of the wallet above a threshold to a cold storage address.
+type ColdSweep struct {
+	// Enable starts the scheduled sweeps on startup. They can also be
+	// enabled or disabled at runtime, provided Address is set.
+	Enable bool `long:"enable" description:"Enable the scheduled sweeps to cold storage on startup. They can be enabled or disabled at runtime through the SetColdSweep RPC, as long as coldsweep.address is set."`
+
+	// Address is the cold storage address the funds are swept to.
+	Address string `long:"address" description:"The cold storage address the confirmed balance of the wallet above coldsweep.threshold is swept to."`
+
+	// Threshold is the confirmed balance, in atoms, to keep in the wallet.
+	Threshold int64 `long:"threshold" description:"The confirmed balance, in atoms, to keep in the wallet. Only the confirmed balance above it is swept."`
+
+	// Interval is the time between two sweep attempts.
+	Interval time.Duration `long:"interval" description:"The time between two scheduled sweeps. Valid time units are {s, m, h}."`
+
+	// TargetConf is the confirmation target used to estimate the fee rate
+	// of the sweep transactions.
+	TargetConf uint32 `long:"targetconf" description:"The confirmation target used to estimate the fee rate of the sweep transactions."`
+}
+
+// Validate checks that the scheduled sweeps, if configured, have a sane
+// schedule and fee target.
+func (c *ColdSweep) Validate() error {
+	if c.Address == "" {
+		if c.Enable {
+			return fmt.Errorf("coldsweep.address must be set " +
+				"when the scheduled sweeps are enabled")
+		}
+		return nil
+	}
+
+	switch {
+	case c.Threshold < 0:
+		return fmt.Errorf("coldsweep.threshold must not be negative, "+
+			"got %v", c.Threshold)
+
+	case c.Interval <= 0:
+		return fmt.Errorf("coldsweep.interval must be positive, got %v",
+			c.Interval)
+
+	case c.TargetConf == 0:
+		return fmt.Errorf("coldsweep.targetconf must be positive")
+	}
+
+	return nil
+}
+
+// Compile-time constraint to ensure ColdSweep implements the Validator
+// interface.
+var _ Validator = (*ColdSweep)(nil)
@@ -0,0 +1,81 @@
+package lncfg_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/decred/dcrlnd/lncfg"
+)
+
+// TestValidateColdSweep asserts that validating the ColdSweep config only
+// succeeds if the scheduled sweeps aren't configured, or if they have an
+// address, a sane schedule and fee target.
+func TestValidateColdSweep(t *testing.T) {
+	validCfg := func() *lncfg.ColdSweep {
+		return &lncfg.ColdSweep{
+			Enable:     true,
+			Address:    "TsR28UZRprhgQQhzWns2M6cAwchrNVvbYq2",
+			Threshold:  1e8,
+			Interval:   time.Hour,
+			TargetConf: 6,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*lncfg.ColdSweep)
+		valid  bool
+	}{
+		{
+			name:   "valid",
+			modify: func(*lncfg.ColdSweep) {},
+			valid:  true,
+		},
+		{
+			name: "not configured",
+			modify: func(cfg *lncfg.ColdSweep) {
+				*cfg = lncfg.ColdSweep{}
+			},
+			valid: true,
+		},
+		{
+			name: "enabled without address",
+			modify: func(cfg *lncfg.ColdSweep) {
+				cfg.Address = ""
+			},
+		},
+		{
+			name: "negative threshold",
+			modify: func(cfg *lncfg.ColdSweep) {
+				cfg.Threshold = -1
+			},
+		},
+		{
+			name: "no interval",
+			modify: func(cfg *lncfg.ColdSweep) {
+				cfg.Interval = 0
+			},
+		},
+		{
+			name: "no target conf",
+			modify: func(cfg *lncfg.ColdSweep) {
+				cfg.TargetConf = 0
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := validCfg()
+			test.modify(cfg)
+
+			err := cfg.Validate()
+			switch {
+			case test.valid && err != nil:
+				t.Fatalf("valid config was invalid: %v", err)
+			case !test.valid && err == nil:
+				t.Fatalf("invalid config was valid")
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package lncfg
+
+import "fmt"
+
+const (
+	// DefaultFundingConfTarget is the default confirmation target used to
+	// estimate the fee rate of the initial commitments of new channels.
+	DefaultFundingConfTarget = 3
+
+	// DefaultCoopCloseConfTarget is the default confirmation target used
+	// to estimate the fee rate of cooperative closes.
+	DefaultCoopCloseConfTarget = 6
+
+	// DefaultSweepConfTarget is the default confirmation target used to
+	// sweep the outputs of closed channels back into the wallet.
+	DefaultSweepConfTarget = 6
+
+	// DefaultAnchorConfTarget is the default confirmation target used to
+	// sweep commitment anchors. The anchor is mainly offered to the
+	// sweeper so that the fee of the commitment can later be bumped
+	// through CPFP, so a relaxed target is used.
+	DefaultAnchorConfTarget = 144
+
+	// DefaultJusticeConfTarget is the default confirmation target used to
+	// estimate the fee rate of justice transactions.
+	DefaultJusticeConfTarget = 2
+
+	// MaxConfTarget is the highest confirmation target that can be
+	// configured for a subsystem.
+	MaxConfTarget = 1008
+)
+
+// ConfTargets holds the confirmation targets used by each subsystem to
+// estimate the fee rate of its transactions.
+type ConfTargets struct {
+	// Funding is the confirmation target of the initial commitments of
+	// new channels.
+	Funding uint32 `long:"funding" description:"The confirmation target used to estimate the fee rate of the initial commitments of new channels."`
+
+	// CoopClose is the confirmation target of cooperative closes.
+	CoopClose uint32 `long:"coopclose" description:"The confirmation target used to estimate the fee rate proposed for cooperative closes, unless one is given when closing the channel."`
+
+	// Sweep is the confirmation target of the sweeps of the outputs of
+	// closed channels.
+	Sweep uint32 `long:"sweep" description:"The confirmation target used to sweep the outputs of closed channels back into the wallet."`
+
+	// Anchor is the confirmation target of the sweeps of commitment
+	// anchors.
+	Anchor uint32 `long:"anchor" description:"The confirmation target used to sweep the anchors of force closed commitments, unless a fee preference is given when force closing the channel."`
+
+	// Justice is the confirmation target of justice transactions.
+	Justice uint32 `long:"justice" description:"The confirmation target used to estimate the fee rate of the justice transactions claiming the outputs of revoked commitments."`
+}
+
+// Validate checks that all the confirmation targets are within bounds.
+func (c *ConfTargets) Validate() error {
+	targets := []struct {
+		name   string
+		target uint32
+	}{
+		{"funding", c.Funding},
+		{"coopclose", c.CoopClose},
+		{"sweep", c.Sweep},
+		{"anchor", c.Anchor},
+		{"justice", c.Justice},
+	}
+
+	for _, t := range targets {
+		if t.target == 0 || t.target > MaxConfTarget {
+			return fmt.Errorf("conftarget.%v must be between 1 and "+
+				"%v, got %v", t.name, MaxConfTarget, t.target)
+		}
+	}
+
+	return nil
+}
+
+// Compile-time constraint to ensure ConfTargets implements the Validator
+// interface.
+var _ Validator = (*ConfTargets)(nil)
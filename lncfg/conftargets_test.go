@@ -0,0 +1,73 @@
+package lncfg_test
+
+import (
+	"testing"
+
+	"github.com/decred/dcrlnd/lncfg"
+)
+
+// TestValidateConfTargets asserts that validating the ConfTargets config only
+// succeeds if all the confirmation targets are within bounds.
+func TestValidateConfTargets(t *testing.T) {
+	validCfg := func() *lncfg.ConfTargets {
+		return &lncfg.ConfTargets{
+			Funding:   lncfg.DefaultFundingConfTarget,
+			CoopClose: lncfg.DefaultCoopCloseConfTarget,
+			Sweep:     lncfg.DefaultSweepConfTarget,
+			Anchor:    lncfg.DefaultAnchorConfTarget,
+			Justice:   lncfg.DefaultJusticeConfTarget,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*lncfg.ConfTargets)
+		valid  bool
+	}{
+		{
+			name:   "valid",
+			modify: func(*lncfg.ConfTargets) {},
+			valid:  true,
+		},
+		{
+			name: "max target",
+			modify: func(cfg *lncfg.ConfTargets) {
+				cfg.Anchor = lncfg.MaxConfTarget
+			},
+			valid: true,
+		},
+		{
+			name: "no funding target",
+			modify: func(cfg *lncfg.ConfTargets) {
+				cfg.Funding = 0
+			},
+		},
+		{
+			name: "no justice target",
+			modify: func(cfg *lncfg.ConfTargets) {
+				cfg.Justice = 0
+			},
+		},
+		{
+			name: "sweep target too large",
+			modify: func(cfg *lncfg.ConfTargets) {
+				cfg.Sweep = lncfg.MaxConfTarget + 1
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := validCfg()
+			test.modify(cfg)
+
+			err := cfg.Validate()
+			switch {
+			case test.valid && err != nil:
+				t.Fatalf("valid config was invalid: %v", err)
+			case !test.valid && err == nil:
+				t.Fatalf("invalid config was valid")
+			}
+		})
+	}
+}
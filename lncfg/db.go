@@ -0,0 +1,49 @@
+package lncfg
+
+import "fmt"
+
+// DB holds the configuration of the maintenance of the channel database on
+// startup: the migrations applied when a new release changes its layout, the
+// compaction of its file and the check of its integrity.
+type DB struct {
+	// NoMigrationBackup disables the copy of the channel database taken
+	// before migrating it.
+	NoMigrationBackup bool `long:"no-migration-backup" description:"Don't copy the channel database to channel.db.v<version>.bak before applying pending migrations. The copy requires as much free disk space as the database itself."`
+
+	// DryRunMigration only validates the pending migrations of the channel
+	// database.
+	DryRunMigration bool `long:"dry-run-migration" description:"Apply the pending migrations of the channel database within a transaction which is then rolled back, leaving the database untouched, then exit. Used to validate a new release against an existing database before upgrading."`
+
+	// AutoCompact enables the compaction of the channel database file on
+	// startup.
+	AutoCompact bool `long:"auto-compact" description:"Compact the channel database file on startup if the ratio of its free pages is at least auto-compact-min-free-ratio. The file never shrinks otherwise, as the space freed by deleted data is only reused by later writes. Compacting requires as much free disk space as the database itself."`
+
+	// AutoCompactMinFreeRatio is the ratio of free pages of the channel
+	// database file above which it is compacted.
+	AutoCompactMinFreeRatio float64 `long:"auto-compact-min-free-ratio" description:"The ratio of free pages of the channel database file, between 0 and 1, above which it is compacted on startup."`
+
+	// IntegrityCheck enables the check of the invariants of the channel
+	// database on startup.
+	IntegrityCheck bool `long:"integrity-check" description:"Check the invariants of the channel database on startup, reporting the invoice index entries pointing to missing invoices and the payments or htlc attempts missing their info."`
+
+	// RepairIntegrity deletes the entries found by the integrity check.
+	RepairIntegrity bool `long:"repair-integrity" description:"Delete the entries of the channel database found by the integrity check. Implies integrity-check."`
+}
+
+// Validate checks that the ratio of free pages triggering the compaction of
+// the channel database is within bounds if the compaction is enabled.
+func (d *DB) Validate() error {
+	if !d.AutoCompact {
+		return nil
+	}
+
+	if d.AutoCompactMinFreeRatio <= 0 || d.AutoCompactMinFreeRatio > 1 {
+		return fmt.Errorf("db.auto-compact-min-free-ratio must be "+
+			"within (0, 1], got %v", d.AutoCompactMinFreeRatio)
+	}
+
+	return nil
+}
+
+// Compile-time constraint to ensure DB implements the Validator interface.
+var _ Validator = (*DB)(nil)
@@ -0,0 +1,64 @@
+package lncfg_test
+
+import (
+	"testing"
+
+	"github.com/decred/dcrlnd/lncfg"
+)
+
+// TestValidateDB asserts that validating the DB config only succeeds if the
+// ratio of free pages triggering the compaction is within bounds when the
+// compaction is enabled.
+func TestValidateDB(t *testing.T) {
+	tests := []struct {
+		name  string
+		cfg   lncfg.DB
+		valid bool
+	}{
+		{
+			name:  "compaction disabled",
+			valid: true,
+		},
+		{
+			name: "compaction enabled",
+			cfg: lncfg.DB{
+				AutoCompact:             true,
+				AutoCompactMinFreeRatio: 0.25,
+			},
+			valid: true,
+		},
+		{
+			name: "always compact",
+			cfg: lncfg.DB{
+				AutoCompact:             true,
+				AutoCompactMinFreeRatio: 1,
+			},
+			valid: true,
+		},
+		{
+			name: "zero ratio",
+			cfg: lncfg.DB{
+				AutoCompact: true,
+			},
+		},
+		{
+			name: "ratio too high",
+			cfg: lncfg.DB{
+				AutoCompact:             true,
+				AutoCompactMinFreeRatio: 1.5,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.cfg.Validate()
+			switch {
+			case test.valid && err != nil:
+				t.Fatalf("valid config was invalid: %v", err)
+			case !test.valid && err == nil:
+				t.Fatalf("invalid config was valid")
+			}
+		})
+	}
+}
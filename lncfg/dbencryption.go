@@ -0,0 +1,54 @@
+package lncfg
+
+import (
+	"fmt"
+	"io/ioutil"
+)
+
+// DBEncryption holds the configuration of the encryption at rest of the
+// channel database. The database is stored encrypted while the node isn't
+// running, and decrypted on disk once the node is unlocked, using a key
+// derived from either the wallet password or the content of a key file.
+type DBEncryption struct {
+	// Active enables the encryption of the channel database.
+	Active bool `long:"active" description:"Encrypt the channel database while the node isn't running. The database is decrypted on disk at unlock and encrypted again on shutdown, using the wallet password unless a key file is set. A database left unencrypted is encrypted on the next shutdown."`
+
+	// KeyFile is the path of the file whose content is used instead of
+	// the wallet password to encrypt the channel database.
+	KeyFile string `long:"keyfile" description:"The path of a file whose content is used instead of the wallet password to encrypt the channel database, allowing it to be decrypted before the node is unlocked."`
+}
+
+// Validate checks that the key file is only set along with the encryption of
+// the channel database.
+func (d *DBEncryption) Validate() error {
+	if d.KeyFile != "" && !d.Active {
+		return fmt.Errorf("dbencryption.keyfile requires " +
+			"dbencryption.active")
+	}
+
+	return nil
+}
+
+// Password returns the password the channel database is encrypted with: the
+// content of the key file if set, or the wallet password otherwise.
+func (d *DBEncryption) Password(walletPassword []byte) ([]byte, error) {
+	if d.KeyFile == "" {
+		return walletPassword, nil
+	}
+
+	password, err := ioutil.ReadFile(d.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read database key file: %v",
+			err)
+	}
+	if len(password) == 0 {
+		return nil, fmt.Errorf("database key file %v is empty",
+			d.KeyFile)
+	}
+
+	return password, nil
+}
+
+// Compile-time constraint to ensure DBEncryption implements the Validator
+// interface.
+var _ Validator = (*DBEncryption)(nil)
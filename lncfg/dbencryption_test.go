@@ -0,0 +1,101 @@
+package lncfg_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/decred/dcrlnd/lncfg"
+)
+
+// TestValidateDBEncryption asserts that a key file can only be set along with
+// the encryption of the channel database.
+func TestValidateDBEncryption(t *testing.T) {
+	tests := []struct {
+		name  string
+		cfg   lncfg.DBEncryption
+		valid bool
+	}{
+		{
+			name:  "disabled",
+			valid: true,
+		},
+		{
+			name: "wallet password",
+			cfg: lncfg.DBEncryption{
+				Active: true,
+			},
+			valid: true,
+		},
+		{
+			name: "key file",
+			cfg: lncfg.DBEncryption{
+				Active:  true,
+				KeyFile: "db.key",
+			},
+			valid: true,
+		},
+		{
+			name: "key file without encryption",
+			cfg: lncfg.DBEncryption{
+				KeyFile: "db.key",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			err := test.cfg.Validate()
+			if test.valid && err != nil {
+				t.Fatalf("expected valid config, got: %v", err)
+			}
+			if !test.valid && err == nil {
+				t.Fatal("expected invalid config")
+			}
+		})
+	}
+}
+
+// TestDBEncryptionPassword asserts that the channel database is encrypted
+// with the content of the key file if set, and with the wallet password
+// otherwise.
+func TestDBEncryptionPassword(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dbencryption")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	walletPassword := []byte("wallet password")
+	keyFile := filepath.Join(dir, "db.key")
+	key := []byte("key file content")
+	if err := ioutil.WriteFile(keyFile, key, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := lncfg.DBEncryption{Active: true}
+	password, err := cfg.Password(walletPassword)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(password, walletPassword) {
+		t.Fatalf("expected wallet password, got %s", password)
+	}
+
+	cfg.KeyFile = keyFile
+	password, err = cfg.Password(walletPassword)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(password, key) {
+		t.Fatalf("expected key file content, got %s", password)
+	}
+
+	cfg.KeyFile = filepath.Join(dir, "missing.key")
+	if _, err := cfg.Password(walletPassword); err == nil {
+		t.Fatal("expected missing key file to fail")
+	}
+}
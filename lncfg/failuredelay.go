@@ -0,0 +1,47 @@
+package lncfg
+
+import (
+	"fmt"
+	"time"
+)
+
+// MaxFailureDelay is the longest delay allowed before failing back an HTLC,
+// keeping HTLCs from being held for long by the node.
+const MaxFailureDelay = time.Minute
+
+// FailureDelay holds the configuration of the random delay applied before
+// failing back the HTLCs rejected by the node, either as the final hop or
+// while forwarding them. Failing them back after a random delay makes it
+// harder for the sender to tell whether the node is the final hop by timing
+// the failures.
+type FailureDelay struct {
+	// MinDelay is the shortest delay before failing back an HTLC.
+	MinDelay time.Duration `long:"mindelay" description:"The shortest random delay before failing back an HTLC rejected by the node."`
+
+	// MaxDelay is the longest delay before failing back an HTLC. If zero,
+	// HTLCs are failed back right away.
+	MaxDelay time.Duration `long:"maxdelay" description:"The longest random delay before failing back an HTLC rejected by the node. 0 fails back HTLCs right away."`
+}
+
+// Validate checks that the bounds of the delay before failing back HTLCs are
+// sane.
+func (f *FailureDelay) Validate() error {
+	switch {
+	case f.MinDelay < 0:
+		return fmt.Errorf("failuredelay.mindelay must not be negative")
+
+	case f.MaxDelay < f.MinDelay:
+		return fmt.Errorf("failuredelay.maxdelay must be at least "+
+			"failuredelay.mindelay (%v)", f.MinDelay)
+
+	case f.MaxDelay > MaxFailureDelay:
+		return fmt.Errorf("failuredelay.maxdelay must be at most %v",
+			MaxFailureDelay)
+	}
+
+	return nil
+}
+
+// Compile-time constraint to ensure FailureDelay implements the Validator
+// interface.
+var _ Validator = (*FailureDelay)(nil)
@@ -0,0 +1,71 @@
+package lncfg_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/decred/dcrlnd/lncfg"
+)
+
+// TestValidateFailureDelay asserts that validating the FailureDelay config
+// only succeeds if its bounds are ordered and within the allowed range.
+func TestValidateFailureDelay(t *testing.T) {
+	tests := []struct {
+		name  string
+		cfg   lncfg.FailureDelay
+		valid bool
+	}{
+		{
+			name:  "disabled",
+			valid: true,
+		},
+		{
+			name: "bounds",
+			cfg: lncfg.FailureDelay{
+				MinDelay: 100 * time.Millisecond,
+				MaxDelay: time.Second,
+			},
+			valid: true,
+		},
+		{
+			name: "fixed delay",
+			cfg: lncfg.FailureDelay{
+				MinDelay: time.Second,
+				MaxDelay: time.Second,
+			},
+			valid: true,
+		},
+		{
+			name: "negative min delay",
+			cfg: lncfg.FailureDelay{
+				MinDelay: -time.Second,
+				MaxDelay: time.Second,
+			},
+		},
+		{
+			name: "inverted bounds",
+			cfg: lncfg.FailureDelay{
+				MinDelay: 2 * time.Second,
+				MaxDelay: time.Second,
+			},
+		},
+		{
+			name: "max delay too long",
+			cfg: lncfg.FailureDelay{
+				MaxDelay: lncfg.MaxFailureDelay + time.Second,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.cfg.Validate()
+			switch {
+			case test.valid && err != nil:
+				t.Fatalf("valid config was invalid: %v", err)
+			case !test.valid && err == nil:
+				t.Fatalf("invalid config was valid")
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package lncfg
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultFeeManagerStrategy is the default strategy used to adjust the
+	// fee rates of the channels.
+	DefaultFeeManagerStrategy = "liquidity"
+
+	// DefaultFeeManagerInterval is the default time between two rounds of
+	// fee rate adjustments.
+	DefaultFeeManagerInterval = time.Hour
+
+	// DefaultFeeManagerMinFeeRate is the default lowest fee rate, in
+	// millionths, set by the fee manager.
+	DefaultFeeManagerMinFeeRate = 1
+
+	// DefaultFeeManagerMaxFeeRate is the default highest fee rate, in
+	// millionths, set by the fee manager.
+	DefaultFeeManagerMaxFeeRate = 1000
+
+	// DefaultFeeManagerStep is the default percentage by which the demand
+	// strategy raises or lowers the fee rates.
+	DefaultFeeManagerStep = 10
+
+	// DefaultFeeManagerTargetVolume is the default percentage of the
+	// capacity of a channel which, once forwarded over it, makes the
+	// demand strategy raise its fee rate.
+	DefaultFeeManagerTargetVolume = 5
+
+	// DefaultFeeManagerMinChange is the default minimum change, as a
+	// percentage of the current fee rate, for a new fee rate to be
+	// applied.
+	DefaultFeeManagerMinChange = 5
+)
+
+// FeeManager holds the configuration of the subsystem periodically adjusting
+// the fee rates of the channels based on their liquidity and forwarding
+// demand.
+type FeeManager struct {
+	// Active enables the fee manager.
+	Active bool `long:"active" description:"Periodically adjust the fee rates of the channels according to feemanager.strategy."`
+
+	// Strategy is the strategy deciding the new fee rates.
+	Strategy string `long:"strategy" description:"The strategy deciding the fee rates: liquidity sets them in proportion to the share of the capacity of the channels that isn't ours, demand raises them on the channels forwarding more than feemanager.targetvolume and lowers them on idle channels." choice:"liquidity" choice:"demand"`
+
+	// Interval is the time between two rounds of adjustments.
+	Interval time.Duration `long:"interval" description:"The time between two rounds of fee rate adjustments. Valid time units are {s, m, h}."`
+
+	// MinFeeRate is the lowest fee rate set by the fee manager.
+	MinFeeRate uint32 `long:"minfeerate" description:"The lowest fee rate, in millionths, set by the fee manager."`
+
+	// MaxFeeRate is the highest fee rate set by the fee manager.
+	MaxFeeRate uint32 `long:"maxfeerate" description:"The highest fee rate, in millionths, set by the fee manager."`
+
+	// Step is the percentage by which the demand strategy raises or
+	// lowers the fee rates.
+	Step uint32 `long:"step" description:"The percentage by which the demand strategy raises or lowers the fee rates."`
+
+	// TargetVolume is the percentage of the capacity of a channel which,
+	// once forwarded over it, makes the demand strategy raise its fee
+	// rate.
+	TargetVolume uint32 `long:"targetvolume" description:"The percentage of the capacity of a channel which, once forwarded over it within an interval, makes the demand strategy raise its fee rate."`
+
+	// MinChange is the minimum change, as a percentage of the current fee
+	// rate, for a new fee rate to be applied.
+	MinChange uint32 `long:"minchange" description:"The minimum change, as a percentage of the current fee rate of a channel, for a new fee rate to be applied and announced."`
+}
+
+// Validate checks that the fee manager, if active, uses a known strategy with
+// sane bounds and schedule.
+func (f *FeeManager) Validate() error {
+	if !f.Active {
+		return nil
+	}
+
+	switch {
+	case f.Strategy != "liquidity" && f.Strategy != "demand":
+		return fmt.Errorf("unknown feemanager.strategy %q",
+			f.Strategy)
+
+	case f.Interval <= 0:
+		return fmt.Errorf("feemanager.interval must be positive, "+
+			"got %v", f.Interval)
+
+	case f.MinFeeRate > f.MaxFeeRate:
+		return fmt.Errorf("feemanager.minfeerate %v exceeds "+
+			"feemanager.maxfeerate %v", f.MinFeeRate, f.MaxFeeRate)
+
+	case f.Strategy == "demand" && (f.Step == 0 || f.Step > 100):
+		return fmt.Errorf("feemanager.step must be between 1 and "+
+			"100, got %v", f.Step)
+
+	case f.MinChange > 100:
+		return fmt.Errorf("feemanager.minchange must not exceed "+
+			"100, got %v", f.MinChange)
+	}
+
+	return nil
+}
+
+// Compile-time constraint to ensure FeeManager implements the Validator
+// interface.
+var _ Validator = (*FeeManager)(nil)
@@ -0,0 +1,98 @@
+package lncfg_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/decred/dcrlnd/lncfg"
+)
+
+// TestValidateFeeManager asserts that validating the FeeManager config only
+// succeeds if the fee manager isn't active, or if it uses a known strategy
+// with sane bounds and schedule.
+func TestValidateFeeManager(t *testing.T) {
+	validCfg := func() *lncfg.FeeManager {
+		return &lncfg.FeeManager{
+			Active:       true,
+			Strategy:     "demand",
+			Interval:     time.Hour,
+			MinFeeRate:   1,
+			MaxFeeRate:   1000,
+			Step:         10,
+			TargetVolume: 5,
+			MinChange:    5,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*lncfg.FeeManager)
+		valid  bool
+	}{
+		{
+			name:   "valid",
+			modify: func(*lncfg.FeeManager) {},
+			valid:  true,
+		},
+		{
+			name: "inactive",
+			modify: func(cfg *lncfg.FeeManager) {
+				*cfg = lncfg.FeeManager{}
+			},
+			valid: true,
+		},
+		{
+			name: "unknown strategy",
+			modify: func(cfg *lncfg.FeeManager) {
+				cfg.Strategy = "random"
+			},
+		},
+		{
+			name: "no interval",
+			modify: func(cfg *lncfg.FeeManager) {
+				cfg.Interval = 0
+			},
+		},
+		{
+			name: "inverted fee rate bounds",
+			modify: func(cfg *lncfg.FeeManager) {
+				cfg.MinFeeRate = 2000
+			},
+		},
+		{
+			name: "no demand step",
+			modify: func(cfg *lncfg.FeeManager) {
+				cfg.Step = 0
+			},
+		},
+		{
+			name: "no step for liquidity strategy",
+			modify: func(cfg *lncfg.FeeManager) {
+				cfg.Strategy = "liquidity"
+				cfg.Step = 0
+			},
+			valid: true,
+		},
+		{
+			name: "min change too large",
+			modify: func(cfg *lncfg.FeeManager) {
+				cfg.MinChange = 101
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := validCfg()
+			test.modify(cfg)
+
+			err := cfg.Validate()
+			switch {
+			case test.valid && err != nil:
+				t.Fatalf("valid config was invalid: %v", err)
+			case !test.valid && err == nil:
+				t.Fatalf("invalid config was valid")
+			}
+		})
+	}
+}
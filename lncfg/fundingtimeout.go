@@ -0,0 +1,35 @@
+package lncfg
+
+import "fmt"
+
+const (
+	// DefaultFundingTimeoutMaxBlocks is the default number of blocks after
+	// which a pending channel whose funding transaction hasn't confirmed
+	// is abandoned. 4032 blocks is ~2 weeks.
+	DefaultFundingTimeoutMaxBlocks = 4032
+)
+
+// FundingTimeout holds the configuration of the cleanup of the pending
+// channels whose funding transaction doesn't confirm.
+type FundingTimeout struct {
+	// MaxBlocks is the number of blocks after which a pending channel is
+	// abandoned.
+	MaxBlocks uint32 `long:"maxblocks" description:"The number of blocks after the broadcast of the funding transaction of a pending channel after which the channel is abandoned if the transaction hasn't confirmed."`
+
+	// AbandonInitiated enables abandoning the pending channels we
+	// initiated, along with their funding transaction.
+	AbandonInitiated bool `long:"abandoninitiated" description:"Also abandon the pending channels initiated by us once fundingtimeout.maxblocks have passed. Their funding transaction is removed from the wallet, returning the outputs it spends. If the funding transaction confirms afterwards, the funds are only recoverable with the help of the remote party."`
+}
+
+// Validate checks that the funding timeout is sane.
+func (f *FundingTimeout) Validate() error {
+	if f.MaxBlocks == 0 {
+		return fmt.Errorf("fundingtimeout.maxblocks must be positive")
+	}
+
+	return nil
+}
+
+// Compile-time constraint to ensure FundingTimeout implements the Validator
+// interface.
+var _ Validator = (*FundingTimeout)(nil)
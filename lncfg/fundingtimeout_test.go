@@ -0,0 +1,58 @@
+package lncfg_test
+
+import (
+	"testing"
+
+	"github.com/decred/dcrlnd/lncfg"
+)
+
+// TestValidateFundingTimeout asserts that validating the FundingTimeout config
+// only succeeds if pending channels are given some blocks to confirm.
+func TestValidateFundingTimeout(t *testing.T) {
+	validCfg := func() *lncfg.FundingTimeout {
+		return &lncfg.FundingTimeout{
+			MaxBlocks:        lncfg.DefaultFundingTimeoutMaxBlocks,
+			AbandonInitiated: true,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*lncfg.FundingTimeout)
+		valid  bool
+	}{
+		{
+			name:   "valid",
+			modify: func(*lncfg.FundingTimeout) {},
+			valid:  true,
+		},
+		{
+			name: "single block",
+			modify: func(cfg *lncfg.FundingTimeout) {
+				cfg.MaxBlocks = 1
+			},
+			valid: true,
+		},
+		{
+			name: "no blocks",
+			modify: func(cfg *lncfg.FundingTimeout) {
+				cfg.MaxBlocks = 0
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := validCfg()
+			test.modify(cfg)
+
+			err := cfg.Validate()
+			switch {
+			case test.valid && err != nil:
+				t.Fatalf("valid config was invalid: %v", err)
+			case !test.valid && err == nil:
+				t.Fatalf("invalid config was valid")
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package lncfg
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/routing/route"
+)
+
+// Gossip holds the configuration for the gossip syncers and the bandwidth
+// used to relay gossip to our peers.
+type Gossip struct {
+	// PinnedSyncers is a list of peers that will always be actively synced
+	// with, regardless of the number of graph sync peers.
+	PinnedSyncers []string `long:"pinned-syncers" description:"A hex-encoded public key of a peer that will always be actively synced with, without counting towards numgraphsyncpeers. A historical sync is performed with these peers every time they connect. Can be specified multiple times."`
+
+	// PassiveSyncers is a list of peers that will never be actively synced
+	// with.
+	PassiveSyncers []string `long:"passive-syncers" description:"A hex-encoded public key of a peer that new graph updates will never be received from, while still replying to its queries. Can be specified multiple times."`
+
+	// MsgRateBytes is the number of bytes of channel and node
+	// announcements that can be sent to all peers combined per second.
+	MsgRateBytes uint64 `long:"msg-rate-bytes" description:"The maximum number of bytes of channel and node announcements per second that will be sent to all peers combined, 0 to disable the limit."`
+
+	// MsgBurstBytes is the number of bytes of channel and node
+	// announcements that can be sent at once after the rate limit hasn't
+	// been reached for a while.
+	MsgBurstBytes uint64 `long:"msg-burst-bytes" description:"The maximum number of bytes of channel and node announcements that can be sent at once when msg-rate-bytes is set. Must be at least the maximum message size."`
+
+	// MaxQueryChanIDs is the maximum number of channels of a single
+	// query_short_channel_ids message that will be replied to.
+	MaxQueryChanIDs uint32 `long:"max-query-chan-ids" description:"The maximum number of channels of a single query_short_channel_ids message from a peer that will be replied to, 0 to use the default. The remaining channels are ignored."`
+
+	// QueryReplyMsgRate is the number of messages per second sent to each
+	// peer in reply to its gossip queries once its burst is exhausted.
+	QueryReplyMsgRate uint32 `long:"query-reply-msg-rate" description:"The number of messages per second that will be sent to each peer in reply to its gossip queries once query-reply-msg-burst is exhausted, 0 to use the default."`
+
+	// QueryReplyMsgBurst is the number of messages sent to each peer in
+	// reply to its gossip queries before they are throttled.
+	QueryReplyMsgBurst uint32 `long:"query-reply-msg-burst" description:"The number of messages that will be sent to each peer in reply to its gossip queries before throttling them, 0 to use the default."`
+
+	// MaxQueryReplyDelay is the maximum delay applied to the replies to a
+	// peer that keeps exceeding its gossip query rate.
+	MaxQueryReplyDelay time.Duration `long:"max-query-reply-delay" description:"The maximum delay applied to the replies to a peer that keeps sending gossip queries beyond its rate, 0 to use the default. Replies are delayed progressively up to this value."`
+
+	// pinnedSyncers is the parsed set of PinnedSyncers.
+	pinnedSyncers map[route.Vertex]struct{}
+
+	// passiveSyncers is the parsed set of PassiveSyncers.
+	passiveSyncers map[route.Vertex]struct{}
+}
+
+// Validate parses the configured peers and checks that the bandwidth budget and
+// gossip query limits are sane.
+func (g *Gossip) Validate() error {
+	pinned, err := parseSyncerSet(g.PinnedSyncers)
+	if err != nil {
+		return fmt.Errorf("invalid pinned syncer: %v", err)
+	}
+	passive, err := parseSyncerSet(g.PassiveSyncers)
+	if err != nil {
+		return fmt.Errorf("invalid passive syncer: %v", err)
+	}
+
+	for peer := range pinned {
+		if _, ok := passive[peer]; ok {
+			return fmt.Errorf("peer %v cannot be both a pinned and "+
+				"a passive syncer", peer)
+		}
+	}
+
+	if g.MsgRateBytes > 0 && g.MsgBurstBytes < lnwire.MaxMessagePayload {
+		return fmt.Errorf("msg-burst-bytes (%d) must be at least %d",
+			g.MsgBurstBytes, lnwire.MaxMessagePayload)
+	}
+
+	if g.MaxQueryChanIDs > math.MaxInt32 {
+		return fmt.Errorf("max-query-chan-ids (%d) must be at most %d",
+			g.MaxQueryChanIDs, math.MaxInt32)
+	}
+
+	if g.MaxQueryReplyDelay < 0 {
+		return fmt.Errorf("max-query-reply-delay (%v) must not be "+
+			"negative", g.MaxQueryReplyDelay)
+	}
+
+	g.pinnedSyncers = pinned
+	g.passiveSyncers = passive
+
+	return nil
+}
+
+// PinnedSyncerSet returns the set of peers that should always be actively
+// synced with.
+//
+// NOTE: This is only populated once Validate has been called.
+func (g *Gossip) PinnedSyncerSet() map[route.Vertex]struct{} {
+	return g.pinnedSyncers
+}
+
+// PassiveSyncerSet returns the set of peers that should never be actively
+// synced with.
+//
+// NOTE: This is only populated once Validate has been called.
+func (g *Gossip) PassiveSyncerSet() map[route.Vertex]struct{} {
+	return g.passiveSyncers
+}
+
+// parseSyncerSet parses the given hex-encoded public keys into a set of
+// vertices.
+func parseSyncerSet(pubKeys []string) (map[route.Vertex]struct{}, error) {
+	syncers := make(map[route.Vertex]struct{}, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		vertex, err := route.NewVertexFromStr(pubKey)
+		if err != nil {
+			return nil, err
+		}
+		syncers[vertex] = struct{}{}
+	}
+
+	return syncers, nil
+}
+
+// Compile-time constraint to ensure Gossip implements the Validator interface.
+var _ Validator = (*Gossip)(nil)
@@ -0,0 +1,124 @@
+package lncfg_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrlnd/lncfg"
+	"github.com/decred/dcrlnd/lnwire"
+)
+
+var (
+	testPeerA = "02" + strings.Repeat("aa", 32)
+	testPeerB = "03" + strings.Repeat("bb", 32)
+)
+
+// TestValidateGossip asserts that validating the Gossip config only succeeds
+// if all peers are valid public keys, no peer is both pinned and passive, the
+// burst can hold the largest message when a rate is set, and the gossip query
+// limits are in range.
+func TestValidateGossip(t *testing.T) {
+	tests := []struct {
+		name  string
+		cfg   *lncfg.Gossip
+		valid bool
+	}{
+		{
+			name:  "empty valid",
+			cfg:   &lncfg.Gossip{},
+			valid: true,
+		},
+		{
+			name: "pinned and passive valid",
+			cfg: &lncfg.Gossip{
+				PinnedSyncers:  []string{testPeerA},
+				PassiveSyncers: []string{testPeerB},
+			},
+			valid: true,
+		},
+		{
+			name: "invalid pinned",
+			cfg: &lncfg.Gossip{
+				PinnedSyncers: []string{"aabb"},
+			},
+		},
+		{
+			name: "invalid passive",
+			cfg: &lncfg.Gossip{
+				PassiveSyncers: []string{"zz" + testPeerA[2:]},
+			},
+		},
+		{
+			name: "pinned and passive invalid",
+			cfg: &lncfg.Gossip{
+				PinnedSyncers:  []string{testPeerA},
+				PassiveSyncers: []string{testPeerA},
+			},
+		},
+		{
+			name: "rate with min burst valid",
+			cfg: &lncfg.Gossip{
+				MsgRateBytes:  1,
+				MsgBurstBytes: lnwire.MaxMessagePayload,
+			},
+			valid: true,
+		},
+		{
+			name: "rate with small burst invalid",
+			cfg: &lncfg.Gossip{
+				MsgRateBytes:  1,
+				MsgBurstBytes: lnwire.MaxMessagePayload - 1,
+			},
+		},
+		{
+			name: "query limits valid",
+			cfg: &lncfg.Gossip{
+				MaxQueryChanIDs:    1000,
+				QueryReplyMsgRate:  10,
+				QueryReplyMsgBurst: 100,
+				MaxQueryReplyDelay: time.Minute,
+			},
+			valid: true,
+		},
+		{
+			name: "too many query chan ids invalid",
+			cfg: &lncfg.Gossip{
+				MaxQueryChanIDs: math.MaxInt32 + 1,
+			},
+		},
+		{
+			name: "negative query reply delay invalid",
+			cfg: &lncfg.Gossip{
+				MaxQueryReplyDelay: -time.Second,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.cfg.Validate()
+			switch {
+			case test.valid && err != nil:
+				t.Fatalf("valid config was invalid: %v", err)
+			case !test.valid && err == nil:
+				t.Fatalf("invalid config was valid")
+			}
+		})
+	}
+
+	// Once validated, the configured peers should be available as sets.
+	cfg := &lncfg.Gossip{
+		PinnedSyncers:  []string{testPeerA},
+		PassiveSyncers: []string{testPeerB},
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("unable to validate config: %v", err)
+	}
+	if len(cfg.PinnedSyncerSet()) != 1 || len(cfg.PassiveSyncerSet()) != 1 {
+		t.Fatalf("expected one pinned and one passive syncer, got %d "+
+			"and %d", len(cfg.PinnedSyncerSet()),
+			len(cfg.PassiveSyncerSet()))
+	}
+}
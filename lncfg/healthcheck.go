@@ -0,0 +1,221 @@
+package lncfg
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// HealthCheckActionShutdown is the action shutting the node down once
+	// a health check failed all of its attempts.
+	HealthCheckActionShutdown = "shutdown"
+
+	// HealthCheckActionLog is the action only logging the failure of a
+	// health check, until it succeeds again.
+	HealthCheckActionLog = "log"
+
+	// MinHealthCheckInterval is the minimum time between two runs of a
+	// health check.
+	MinHealthCheckInterval = time.Minute
+
+	// MinHealthCheckTimeout is the minimum time an attempt of a health
+	// check is allowed to take.
+	MinHealthCheckTimeout = time.Second
+
+	// MinHealthCheckBackoff is the minimum time between two attempts of a
+	// health check.
+	MinHealthCheckBackoff = time.Second
+
+	// DefaultChainCheckInterval is the default time between two checks of
+	// the chain backend.
+	DefaultChainCheckInterval = time.Minute
+
+	// DefaultChainCheckAttempts is the default number of attempts of the
+	// check of the chain backend.
+	DefaultChainCheckAttempts = 3
+
+	// DefaultChainCheckTimeout is the default time an attempt of the check
+	// of the chain backend is allowed to take.
+	DefaultChainCheckTimeout = time.Second * 10
+
+	// DefaultChainCheckBackoff is the default time between two attempts
+	// of the check of the chain backend.
+	DefaultChainCheckBackoff = time.Second * 30
+
+	// DefaultDiskRequired is the default ratio of the disk space which
+	// must remain available.
+	DefaultDiskRequired = 0.1
+
+	// DefaultDiskCheckInterval is the default time between two checks of
+	// the available disk space.
+	DefaultDiskCheckInterval = time.Hour * 12
+
+	// DefaultDiskCheckTimeout is the default time an attempt of the check
+	// of the available disk space is allowed to take.
+	DefaultDiskCheckTimeout = time.Second * 5
+
+	// DefaultDiskCheckBackoff is the default time between two attempts of
+	// the check of the available disk space.
+	DefaultDiskCheckBackoff = time.Minute
+
+	// DefaultWalletSyncCheckInterval is the default time between two
+	// checks of the synchronization of the wallet.
+	DefaultWalletSyncCheckInterval = time.Minute * 10
+
+	// DefaultWalletSyncCheckAttempts is the default number of attempts of
+	// the check of the synchronization of the wallet.
+	DefaultWalletSyncCheckAttempts = 3
+
+	// DefaultWalletSyncCheckTimeout is the default time an attempt of the
+	// check of the synchronization of the wallet is allowed to take.
+	DefaultWalletSyncCheckTimeout = time.Second * 10
+
+	// DefaultWalletSyncCheckBackoff is the default time between two
+	// attempts of the check of the synchronization of the wallet.
+	DefaultWalletSyncCheckBackoff = time.Minute * 5
+
+	// DefaultTorCheckInterval is the default time between two checks of
+	// the connection to the Tor server.
+	DefaultTorCheckInterval = time.Minute * 5
+
+	// DefaultTorCheckAttempts is the default number of attempts of the
+	// check of the connection to the Tor server.
+	DefaultTorCheckAttempts = 3
+
+	// DefaultTorCheckTimeout is the default time an attempt of the check
+	// of the connection to the Tor server is allowed to take.
+	DefaultTorCheckTimeout = time.Second * 10
+
+	// DefaultTorCheckBackoff is the default time between two attempts of
+	// the check of the connection to the Tor server.
+	DefaultTorCheckBackoff = time.Second * 30
+
+	// DefaultRemoteWalletCheckInterval is the default time between two
+	// checks of the connection to the remote wallet.
+	DefaultRemoteWalletCheckInterval = time.Minute
+
+	// DefaultRemoteWalletCheckAttempts is the default number of attempts
+	// of the check of the connection to the remote wallet.
+	DefaultRemoteWalletCheckAttempts = 3
+
+	// DefaultRemoteWalletCheckTimeout is the default time an attempt of
+	// the check of the connection to the remote wallet is allowed to take.
+	DefaultRemoteWalletCheckTimeout = time.Second * 10
+
+	// DefaultRemoteWalletCheckBackoff is the default time between two
+	// attempts of the check of the connection to the remote wallet.
+	DefaultRemoteWalletCheckBackoff = time.Second * 30
+)
+
+// HealthCheckConfig holds the configuration of the health checks run
+// periodically on the subsystems the node relies on.
+type HealthCheckConfig struct {
+	ChainCheck *CheckConfig `group:"chainbackend" namespace:"chainbackend"`
+
+	DiskCheck *DiskCheckConfig `group:"diskspace" namespace:"diskspace"`
+
+	WalletSyncCheck *CheckConfig `group:"walletsync" namespace:"walletsync"`
+
+	TorCheck *CheckConfig `group:"torconnection" namespace:"torconnection"`
+
+	RemoteWalletCheck *CheckConfig `group:"remotewallet" namespace:"remotewallet"`
+}
+
+// Validate checks the configuration of each of the health checks.
+func (h *HealthCheckConfig) Validate() error {
+	checks := []struct {
+		name string
+		cfg  *CheckConfig
+	}{
+		{"chainbackend", h.ChainCheck},
+		{"diskspace", h.DiskCheck.CheckConfig},
+		{"walletsync", h.WalletSyncCheck},
+		{"torconnection", h.TorCheck},
+		{"remotewallet", h.RemoteWalletCheck},
+	}
+	for _, check := range checks {
+		if err := check.cfg.validate(check.name); err != nil {
+			return err
+		}
+	}
+
+	if h.DiskCheck.RequiredRemaining < 0 ||
+		h.DiskCheck.RequiredRemaining >= 1 {
+
+		return fmt.Errorf("healthcheck.diskspace.diskrequired must be "+
+			"in [0, 1), got %v", h.DiskCheck.RequiredRemaining)
+	}
+
+	return nil
+}
+
+// CheckConfig holds the configuration of a health check.
+type CheckConfig struct {
+	// Interval is the time between two runs of the check.
+	Interval time.Duration `long:"interval" description:"The time between two runs of the health check. Valid time units are {s, m, h}."`
+
+	// Attempts is the number of attempts made before the check fails.
+	Attempts int `long:"attempts" description:"The number of attempts made before the health check fails. Set to 0 to disable the health check."`
+
+	// Timeout is the time an attempt is allowed to take.
+	Timeout time.Duration `long:"timeout" description:"The time an attempt of the health check is allowed to take before it fails. Valid time units are {s, m, h}."`
+
+	// Backoff is the time between two attempts.
+	Backoff time.Duration `long:"backoff" description:"The time waited between two attempts of the health check. Valid time units are {s, m, h}."`
+
+	// Action is what is done once the check failed all of its attempts.
+	Action string `long:"action" description:"What is done once the health check failed all of its attempts: shutdown shuts the node down, log only logs the failure until the health check succeeds again." choice:"shutdown" choice:"log"`
+}
+
+// IsCritical returns true if a failure of the check shuts the node down.
+func (c *CheckConfig) IsCritical() bool {
+	return c.Action == HealthCheckActionShutdown
+}
+
+// validate checks the configuration of the named health check, which is
+// always valid if the check is disabled.
+func (c *CheckConfig) validate(name string) error {
+	switch {
+	case c.Attempts < 0:
+		return fmt.Errorf("healthcheck.%v.attempts must be positive",
+			name)
+
+	case c.Attempts == 0:
+		return nil
+
+	case c.Interval < MinHealthCheckInterval:
+		return fmt.Errorf("healthcheck.%v.interval must be at least "+
+			"%v", name, MinHealthCheckInterval)
+
+	case c.Timeout < MinHealthCheckTimeout:
+		return fmt.Errorf("healthcheck.%v.timeout must be at least %v",
+			name, MinHealthCheckTimeout)
+
+	case c.Backoff < MinHealthCheckBackoff:
+		return fmt.Errorf("healthcheck.%v.backoff must be at least %v",
+			name, MinHealthCheckBackoff)
+	}
+
+	switch c.Action {
+	case HealthCheckActionShutdown, HealthCheckActionLog:
+		return nil
+
+	default:
+		return fmt.Errorf("unknown healthcheck.%v.action: %v", name,
+			c.Action)
+	}
+}
+
+// DiskCheckConfig holds the configuration of the health check of the
+// available disk space.
+type DiskCheckConfig struct {
+	// RequiredRemaining is the ratio of the disk space which must remain
+	// available for the check to succeed.
+	RequiredRemaining float64 `long:"diskrequired" description:"The ratio, in [0, 1), of the disk space which must remain available for the health check to succeed."`
+
+	*CheckConfig
+}
+
+// Compile-time constraint to ensure HealthCheckConfig implements the
+// Validator interface.
+var _ Validator = (*HealthCheckConfig)(nil)
@@ -0,0 +1,109 @@
+package lncfg_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/decred/dcrlnd/lncfg"
+)
+
+// TestValidateHealthCheck asserts that the enabled health checks must run at
+// a sane pace with a known action, and that the required disk space is a
+// ratio.
+func TestValidateHealthCheck(t *testing.T) {
+	validCheck := func() *lncfg.CheckConfig {
+		return &lncfg.CheckConfig{
+			Interval: time.Minute,
+			Attempts: 3,
+			Timeout:  time.Second * 10,
+			Backoff:  time.Second * 30,
+			Action:   lncfg.HealthCheckActionShutdown,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(cfg *lncfg.HealthCheckConfig)
+		valid  bool
+	}{
+		{
+			name:   "valid",
+			modify: func(cfg *lncfg.HealthCheckConfig) {},
+			valid:  true,
+		},
+		{
+			name: "disabled check",
+			modify: func(cfg *lncfg.HealthCheckConfig) {
+				cfg.ChainCheck = &lncfg.CheckConfig{}
+			},
+			valid: true,
+		},
+		{
+			name: "negative attempts",
+			modify: func(cfg *lncfg.HealthCheckConfig) {
+				cfg.ChainCheck.Attempts = -1
+			},
+		},
+		{
+			name: "interval too short",
+			modify: func(cfg *lncfg.HealthCheckConfig) {
+				cfg.WalletSyncCheck.Interval = time.Second
+			},
+		},
+		{
+			name: "timeout too short",
+			modify: func(cfg *lncfg.HealthCheckConfig) {
+				cfg.TorCheck.Timeout = time.Millisecond
+			},
+		},
+		{
+			name: "remote wallet interval too short",
+			modify: func(cfg *lncfg.HealthCheckConfig) {
+				cfg.RemoteWalletCheck.Interval = time.Second
+			},
+		},
+		{
+			name: "backoff too short",
+			modify: func(cfg *lncfg.HealthCheckConfig) {
+				cfg.DiskCheck.Backoff = 0
+			},
+		},
+		{
+			name: "unknown action",
+			modify: func(cfg *lncfg.HealthCheckConfig) {
+				cfg.ChainCheck.Action = "restart"
+			},
+		},
+		{
+			name: "disk required too high",
+			modify: func(cfg *lncfg.HealthCheckConfig) {
+				cfg.DiskCheck.RequiredRemaining = 1
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			cfg := &lncfg.HealthCheckConfig{
+				ChainCheck: validCheck(),
+				DiskCheck: &lncfg.DiskCheckConfig{
+					RequiredRemaining: 0.1,
+					CheckConfig:       validCheck(),
+				},
+				WalletSyncCheck:   validCheck(),
+				TorCheck:          validCheck(),
+				RemoteWalletCheck: validCheck(),
+			}
+			test.modify(cfg)
+
+			err := cfg.Validate()
+			if test.valid && err != nil {
+				t.Fatalf("expected valid config, got: %v", err)
+			}
+			if !test.valid && err == nil {
+				t.Fatal("expected invalid config")
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package lncfg
+
+import "fmt"
+
+// HtlcExposure holds the limits on the HTLCs pending on each channel and with
+// each peer. They keep a flood of HTLCs, small ones in particular, from
+// growing the commitments of a routing node beyond what it is willing to lose
+// if they are force closed. A limit of zero is disabled.
+type HtlcExposure struct {
+	// MaxChanMAtoms is the maximum total value of the HTLCs pending on a
+	// channel.
+	MaxChanMAtoms uint64 `long:"maxchanmatoms" description:"The maximum total value in milliatoms of the HTLCs pending on a channel. 0 disables the limit."`
+
+	// MaxChanHtlcs is the maximum number of HTLCs pending on a channel.
+	MaxChanHtlcs uint32 `long:"maxchanhtlcs" description:"The maximum number of HTLCs pending on a channel. 0 disables the limit."`
+
+	// MaxPeerMAtoms is the maximum total value of the HTLCs pending on all
+	// the channels with a peer.
+	MaxPeerMAtoms uint64 `long:"maxpeermatoms" description:"The maximum total value in milliatoms of the HTLCs pending on all the channels with a peer. 0 disables the limit."`
+
+	// MaxPeerHtlcs is the maximum number of HTLCs pending on all the
+	// channels with a peer.
+	MaxPeerHtlcs uint32 `long:"maxpeerhtlcs" description:"The maximum number of HTLCs pending on all the channels with a peer. 0 disables the limit."`
+
+	// MaxDustMAtoms is the maximum total value of the HTLCs pending on a
+	// channel that are dust on our commitment.
+	MaxDustMAtoms uint64 `long:"maxdustmatoms" description:"The maximum total value in milliatoms of the dust HTLCs pending on a channel, which go to fees if the channel is force closed. 0 disables the limit."`
+}
+
+// Enabled returns true if any of the limits is set.
+func (h *HtlcExposure) Enabled() bool {
+	return h.MaxChanMAtoms != 0 || h.MaxChanHtlcs != 0 ||
+		h.MaxPeerMAtoms != 0 || h.MaxPeerHtlcs != 0 ||
+		h.MaxDustMAtoms != 0
+}
+
+// Validate checks that the per peer limits aren't tighter than the per channel
+// limits, which would make the latter meaningless.
+func (h *HtlcExposure) Validate() error {
+	switch {
+	case h.MaxPeerMAtoms != 0 && h.MaxChanMAtoms != 0 &&
+		h.MaxPeerMAtoms < h.MaxChanMAtoms:
+
+		return fmt.Errorf("htlcexposure.maxpeermatoms must be at least "+
+			"htlcexposure.maxchanmatoms (%v)", h.MaxChanMAtoms)
+
+	case h.MaxPeerHtlcs != 0 && h.MaxChanHtlcs != 0 &&
+		h.MaxPeerHtlcs < h.MaxChanHtlcs:
+
+		return fmt.Errorf("htlcexposure.maxpeerhtlcs must be at least "+
+			"htlcexposure.maxchanhtlcs (%v)", h.MaxChanHtlcs)
+
+	case h.MaxDustMAtoms != 0 && h.MaxChanMAtoms != 0 &&
+		h.MaxDustMAtoms > h.MaxChanMAtoms:
+
+		return fmt.Errorf("htlcexposure.maxdustmatoms must be at most "+
+			"htlcexposure.maxchanmatoms (%v)", h.MaxChanMAtoms)
+	}
+
+	return nil
+}
+
+// Compile-time constraint to ensure HtlcExposure implements the Validator
+// interface.
+var _ Validator = (*HtlcExposure)(nil)
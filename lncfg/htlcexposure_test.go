@@ -0,0 +1,74 @@
+package lncfg_test
+
+import (
+	"testing"
+
+	"github.com/decred/dcrlnd/lncfg"
+)
+
+// TestValidateHtlcExposure asserts that validating the HtlcExposure config
+// only succeeds if the per peer limits are at least the per channel ones.
+func TestValidateHtlcExposure(t *testing.T) {
+	tests := []struct {
+		name  string
+		cfg   lncfg.HtlcExposure
+		valid bool
+	}{
+		{
+			name:  "disabled",
+			valid: true,
+		},
+		{
+			name: "all limits",
+			cfg: lncfg.HtlcExposure{
+				MaxChanMAtoms: 1000000,
+				MaxChanHtlcs:  100,
+				MaxPeerMAtoms: 5000000,
+				MaxPeerHtlcs:  300,
+				MaxDustMAtoms: 100000,
+			},
+			valid: true,
+		},
+		{
+			name: "only peer limits",
+			cfg: lncfg.HtlcExposure{
+				MaxPeerMAtoms: 1000,
+				MaxPeerHtlcs:  10,
+			},
+			valid: true,
+		},
+		{
+			name: "peer value below channel value",
+			cfg: lncfg.HtlcExposure{
+				MaxChanMAtoms: 2000,
+				MaxPeerMAtoms: 1000,
+			},
+		},
+		{
+			name: "peer htlcs below channel htlcs",
+			cfg: lncfg.HtlcExposure{
+				MaxChanHtlcs: 20,
+				MaxPeerHtlcs: 10,
+			},
+		},
+		{
+			name: "dust value above channel value",
+			cfg: lncfg.HtlcExposure{
+				MaxChanMAtoms: 1000,
+				MaxDustMAtoms: 2000,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.cfg.Validate()
+			switch {
+			case test.valid && err != nil:
+				t.Fatalf("valid config was invalid: %v", err)
+			case !test.valid && err == nil:
+				t.Fatalf("invalid config was valid")
+			}
+		})
+	}
+}
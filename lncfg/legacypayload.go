@@ -0,0 +1,47 @@
+package lncfg
+
+import (
+	"fmt"
+	"time"
+)
+
+// legacyPayloadDateLayout is the layout of the date starting at which legacy
+// onion payloads are rejected.
+const legacyPayloadDateLayout = "2006-01-02"
+
+// LegacyPayload holds the configuration of the deprecation of the legacy,
+// non-TLV, onion payloads. Once deprecated, the HTLCs still carrying a legacy
+// payload are neither forwarded nor received by the node.
+type LegacyPayload struct {
+	// RejectHeight is the block height starting at which legacy payloads
+	// are rejected.
+	RejectHeight uint32 `long:"rejectheight" description:"The block height starting at which HTLCs carrying a legacy, non-TLV, onion payload are no longer forwarded nor received. 0 disables the height deadline."`
+
+	// RejectDate is the UTC date starting at which legacy payloads are
+	// rejected.
+	RejectDate string `long:"rejectdate" description:"The UTC date, formatted as YYYY-MM-DD, starting at which HTLCs carrying a legacy, non-TLV, onion payload are no longer forwarded nor received."`
+}
+
+// RejectTime returns the time starting at which legacy payloads are rejected,
+// or the zero time if no date was set.
+func (l *LegacyPayload) RejectTime() (time.Time, error) {
+	if l.RejectDate == "" {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(legacyPayloadDateLayout, l.RejectDate)
+}
+
+// Validate checks that the date starting at which legacy payloads are rejected
+// is well formed.
+func (l *LegacyPayload) Validate() error {
+	if _, err := l.RejectTime(); err != nil {
+		return fmt.Errorf("invalid legacypayload.rejectdate: %v", err)
+	}
+
+	return nil
+}
+
+// Compile-time constraint to ensure LegacyPayload implements the Validator
+// interface.
+var _ Validator = (*LegacyPayload)(nil)
@@ -0,0 +1,60 @@
+package lncfg_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/decred/dcrlnd/lncfg"
+)
+
+// TestLegacyPayloadRejectTime asserts that the date starting at which legacy
+// onion payloads are rejected is parsed as a UTC date, and that malformed
+// dates are refused.
+func TestLegacyPayloadRejectTime(t *testing.T) {
+	tests := []struct {
+		name       string
+		date       string
+		rejectTime time.Time
+		valid      bool
+	}{
+		{
+			name:  "no date",
+			valid: true,
+		},
+		{
+			name:       "date",
+			date:       "2021-06-01",
+			rejectTime: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC),
+			valid:      true,
+		},
+		{
+			name: "malformed date",
+			date: "06/01/2021",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := &lncfg.LegacyPayload{RejectDate: test.date}
+
+			err := cfg.Validate()
+			switch {
+			case test.valid && err != nil:
+				t.Fatalf("valid config was invalid: %v", err)
+			case !test.valid && err == nil:
+				t.Fatalf("invalid config was valid")
+			case !test.valid:
+				return
+			}
+
+			rejectTime, err := cfg.RejectTime()
+			if err != nil {
+				t.Fatalf("unable to get reject time: %v", err)
+			}
+			if !rejectTime.Equal(test.rejectTime) {
+				t.Fatalf("expected reject time %v, got %v",
+					test.rejectTime, rejectTime)
+			}
+		})
+	}
+}
@@ -9,9 +9,10 @@ type Prometheus struct {
 	// Prometheus server to scrape our metrics.
 	Listen string `long:"listen" description:"the interface we should listen on for Prometheus"`
 
-	// Enable indicates whether to export lnd gRPC performance metrics to
-	// Prometheus. Default is false.
-	Enable bool `long:"enable" description:"enable Prometheus exporting of lnd gRPC performance metrics."`
+	// Enable indicates whether to export lnd gRPC performance metrics,
+	// along with the metrics of its subsystems, to Prometheus. Default is
+	// false.
+	Enable bool `long:"enable" description:"enable Prometheus exporting of lnd gRPC performance metrics, htlcswitch throughput and failures, pathfinding latency, channeldb bucket sizes, peer counts and chain backend health."`
 }
 
 // DefaultPrometheus is the default configuration for the Prometheus metrics
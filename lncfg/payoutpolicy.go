@@ -0,0 +1,74 @@
+package lncfg
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const (
+	// DefaultPayoutValidatorTimeout is the default duration within which
+	// the external payout validator must respond to a request.
+	DefaultPayoutValidatorTimeout = 10 * time.Second
+)
+
+// PayoutPolicy holds the configuration of the checks applied to the
+// destinations of the on-chain payments sent through the SendCoins and
+// SendMany RPCs, before they are broadcast.
+type PayoutPolicy struct {
+	// Allowlist is the list of addresses on-chain payments can be sent
+	// to. If empty, payments to any address not in the denylist are
+	// allowed.
+	Allowlist []string `long:"allow" description:"An address that on-chain payments can be sent to. If set, payments to any other address are rejected. Can be specified multiple times."`
+
+	// Denylist is the list of addresses on-chain payments can never be
+	// sent to.
+	Denylist []string `long:"deny" description:"An address that on-chain payments can never be sent to. Can be specified multiple times."`
+
+	// ValidatorURL is the URL of an external service that must approve
+	// each on-chain payment before it is broadcast.
+	ValidatorURL string `long:"validatorurl" description:"The URL of an external service that the destinations of each on-chain payment are POSTed to as JSON before it is broadcast. The payment is only sent if the service responds with a 2xx status."`
+
+	// ValidatorTimeout is the duration within which the external validator
+	// must respond to a request.
+	ValidatorTimeout time.Duration `long:"validatortimeout" description:"The timeout for each request sent to the external payout validator. Valid time units are {s, m, h}."`
+}
+
+// Validate checks that the lists of addresses don't overlap and that the
+// external validator, if any, can be reached.
+func (p *PayoutPolicy) Validate() error {
+	denied := make(map[string]struct{}, len(p.Denylist))
+	for _, addr := range p.Denylist {
+		denied[addr] = struct{}{}
+	}
+	for _, addr := range p.Allowlist {
+		if _, ok := denied[addr]; ok {
+			return fmt.Errorf("address %v cannot be both allowed "+
+				"and denied", addr)
+		}
+	}
+
+	if p.ValidatorURL == "" {
+		return nil
+	}
+
+	u, err := url.Parse(p.ValidatorURL)
+	if err != nil {
+		return fmt.Errorf("invalid payoutpolicy.validatorurl: %v", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("payoutpolicy.validatorurl must be an http "+
+			"or https URL, got %v", p.ValidatorURL)
+	}
+
+	if p.ValidatorTimeout <= 0 {
+		return fmt.Errorf("payoutpolicy.validatortimeout must be "+
+			"positive, got %v", p.ValidatorTimeout)
+	}
+
+	return nil
+}
+
+// Compile-time constraint to ensure PayoutPolicy implements the Validator
+// interface.
+var _ Validator = (*PayoutPolicy)(nil)
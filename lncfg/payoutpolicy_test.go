@@ -0,0 +1,80 @@
+package lncfg_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/decred/dcrlnd/lncfg"
+)
+
+// TestValidatePayoutPolicy asserts that validating the PayoutPolicy config
+// fails if an address is both allowed and denied, or if the external validator
+// can't be reached.
+func TestValidatePayoutPolicy(t *testing.T) {
+	validCfg := func() *lncfg.PayoutPolicy {
+		return &lncfg.PayoutPolicy{
+			Allowlist: []string{
+				"TsR28UZRprhgQQhzWns2M6cAwchrNVvbYq2",
+			},
+			Denylist: []string{
+				"TsVDyY1k1N2jZ7xYuoA1PEbwSP2mQnXR9qb",
+			},
+			ValidatorURL:     "https://localhost:8080/validate",
+			ValidatorTimeout: time.Second,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*lncfg.PayoutPolicy)
+		valid  bool
+	}{
+		{
+			name:   "valid",
+			modify: func(*lncfg.PayoutPolicy) {},
+			valid:  true,
+		},
+		{
+			name: "empty",
+			modify: func(cfg *lncfg.PayoutPolicy) {
+				*cfg = lncfg.PayoutPolicy{}
+			},
+			valid: true,
+		},
+		{
+			name: "allowed and denied",
+			modify: func(cfg *lncfg.PayoutPolicy) {
+				cfg.Denylist = append(
+					cfg.Denylist, cfg.Allowlist[0],
+				)
+			},
+		},
+		{
+			name: "invalid validator scheme",
+			modify: func(cfg *lncfg.PayoutPolicy) {
+				cfg.ValidatorURL = "ftp://localhost/validate"
+			},
+		},
+		{
+			name: "no validator timeout",
+			modify: func(cfg *lncfg.PayoutPolicy) {
+				cfg.ValidatorTimeout = 0
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := validCfg()
+			test.modify(cfg)
+
+			err := cfg.Validate()
+			switch {
+			case test.valid && err != nil:
+				t.Fatalf("valid config was invalid: %v", err)
+			case !test.valid && err == nil:
+				t.Fatalf("invalid config was valid")
+			}
+		})
+	}
+}
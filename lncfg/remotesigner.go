@@ -0,0 +1,66 @@
+package lncfg
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultRemoteSignerRPCTimeout is the default duration within which
+	// the remote signer must respond to a request.
+	DefaultRemoteSignerRPCTimeout = 5 * time.Second
+)
+
+// RemoteSigner holds the configuration for the remote signer instance that
+// signs on behalf of a watch-only node.
+type RemoteSigner struct {
+	// Enable delegates the derivation of keys and all signing operations
+	// to the remote signer, so that the node only requires a watch-only
+	// wallet.
+	Enable bool `long:"enable" description:"Use a remote signer, reachable over its signrpc and walletrpc sub-servers, to derive keys and sign all transactions and messages. Requires a remote, watch-only, dcrwallet."`
+
+	// RPCHost is the host:port of the gRPC server of the remote signer.
+	RPCHost string `long:"rpchost" description:"The host:port of the gRPC server of the remote signer."`
+
+	// MacaroonPath is the path of the macaroon used to authenticate with
+	// the remote signer.
+	MacaroonPath string `long:"macaroonpath" description:"The path of the macaroon to use to authenticate with the remote signer."`
+
+	// TLSCertPath is the path of the TLS certificate of the remote signer.
+	TLSCertPath string `long:"tlscertpath" description:"The path of the TLS certificate used to establish the identity of the remote signer."`
+
+	// Timeout is the duration within which the remote signer must respond
+	// to a request.
+	Timeout time.Duration `long:"timeout" description:"The timeout for connecting to the remote signer and for each of the requests sent to it. Valid time units are {s, m, h}."`
+}
+
+// Validate checks that the remote signer, if enabled, can be reached.
+func (r *RemoteSigner) Validate() error {
+	if !r.Enable {
+		return nil
+	}
+
+	switch {
+	case r.RPCHost == "":
+		return fmt.Errorf("remotesigner.rpchost must be set when " +
+			"the remote signer is enabled")
+
+	case r.MacaroonPath == "":
+		return fmt.Errorf("remotesigner.macaroonpath must be set " +
+			"when the remote signer is enabled")
+
+	case r.TLSCertPath == "":
+		return fmt.Errorf("remotesigner.tlscertpath must be set " +
+			"when the remote signer is enabled")
+
+	case r.Timeout <= 0:
+		return fmt.Errorf("remotesigner.timeout must be positive, "+
+			"got %v", r.Timeout)
+	}
+
+	return nil
+}
+
+// Compile-time constraint to ensure RemoteSigner implements the Validator
+// interface.
+var _ Validator = (*RemoteSigner)(nil)
@@ -0,0 +1,81 @@
+package lncfg_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/decred/dcrlnd/lncfg"
+)
+
+// TestValidateRemoteSigner asserts that validating the RemoteSigner config
+// only succeeds if the remote signer is disabled, or if all the options
+// required to reach it are set.
+func TestValidateRemoteSigner(t *testing.T) {
+	validCfg := func() *lncfg.RemoteSigner {
+		return &lncfg.RemoteSigner{
+			Enable:       true,
+			RPCHost:      "localhost:10019",
+			MacaroonPath: "signer.macaroon",
+			TLSCertPath:  "tls.cert",
+			Timeout:      time.Second,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*lncfg.RemoteSigner)
+		valid  bool
+	}{
+		{
+			name:   "valid",
+			modify: func(*lncfg.RemoteSigner) {},
+			valid:  true,
+		},
+		{
+			name: "disabled",
+			modify: func(cfg *lncfg.RemoteSigner) {
+				*cfg = lncfg.RemoteSigner{}
+			},
+			valid: true,
+		},
+		{
+			name: "no rpc host",
+			modify: func(cfg *lncfg.RemoteSigner) {
+				cfg.RPCHost = ""
+			},
+		},
+		{
+			name: "no macaroon",
+			modify: func(cfg *lncfg.RemoteSigner) {
+				cfg.MacaroonPath = ""
+			},
+		},
+		{
+			name: "no tls cert",
+			modify: func(cfg *lncfg.RemoteSigner) {
+				cfg.TLSCertPath = ""
+			},
+		},
+		{
+			name: "no timeout",
+			modify: func(cfg *lncfg.RemoteSigner) {
+				cfg.Timeout = 0
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := validCfg()
+			test.modify(cfg)
+
+			err := cfg.Validate()
+			switch {
+			case test.valid && err != nil:
+				t.Fatalf("valid config was invalid: %v", err)
+			case !test.valid && err == nil:
+				t.Fatalf("invalid config was valid")
+			}
+		})
+	}
+}
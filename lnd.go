@@ -51,6 +51,7 @@ import (
 	"github.com/decred/dcrlnd/lnwallet"
 	"github.com/decred/dcrlnd/lnwallet/dcrwallet"
 	"github.com/decred/dcrlnd/macaroons"
+	"github.com/decred/dcrlnd/restproxy"
 	"github.com/decred/dcrlnd/signal"
 	"github.com/decred/dcrlnd/walletunlocker"
 	"github.com/decred/dcrlnd/watchtower"
@@ -158,11 +159,10 @@ func Main(lisCfg ListenerCfg) error {
 	// Enable http profiling server if requested.
 	if cfg.Profile != "" {
 		go func() {
-			listenAddr := net.JoinHostPort("", cfg.Profile)
 			profileRedirect := http.RedirectHandler("/debug/pprof",
 				http.StatusSeeOther)
 			http.Handle("/", profileRedirect)
-			fmt.Println(http.ListenAndServe(listenAddr, nil))
+			fmt.Println(http.ListenAndServe(cfg.Profile, nil))
 		}()
 	}
 
@@ -186,19 +186,25 @@ func Main(lisCfg ListenerCfg) error {
 		normalizeNetwork(activeNetParams.Name))
 
 	// Open the channeldb, which is dedicated to storing channel, and
-	// network related metadata.
-	chanDB, err := channeldb.Open(
-		graphDir,
-		channeldb.OptionSetRejectCacheSize(cfg.Caches.RejectCacheSize),
-		channeldb.OptionSetChannelCacheSize(cfg.Caches.ChannelCacheSize),
-		channeldb.OptionSetSyncFreelist(cfg.SyncFreelist),
-	)
-	if err != nil {
-		err := fmt.Errorf("Unable to open channeldb: %v", err)
-		ltndLog.Error(err)
-		return err
+	// network related metadata. If it's encrypted with the wallet
+	// password, it's opened once the wallet is unlocked instead.
+	var chanDB *channeldb.DB
+	dbWalletPassword := cfg.DBEncryption.Active &&
+		cfg.DBEncryption.KeyFile == ""
+	if !dbWalletPassword {
+		var closeChanDB func()
+		chanDB, closeChanDB, err = openChannelDB(graphDir, nil)
+		if err == channeldb.ErrDryRunMigrationOK {
+			ltndLog.Infof("%v, exiting", err)
+			return nil
+		}
+		if err != nil {
+			err := fmt.Errorf("Unable to open channeldb: %v", err)
+			ltndLog.Error(err)
+			return err
+		}
+		defer closeChanDB()
 	}
-	defer chanDB.Close()
 
 	// Only process macaroons if --no-macaroons isn't set.
 	ctx := context.Background()
@@ -247,11 +253,16 @@ func Main(lisCfg ListenerCfg) error {
 	getListeners := func() ([]net.Listener, func(), []grpc.ServerOption,
 		error) {
 
+		listen := lncfg.ListenOnAddress
+		if cfg.RPCListenProxyProtocol {
+			listen = lncfg.ProxyListenOnAddress
+		}
+
 		var grpcListeners []net.Listener
 		for _, grpcEndpoint := range cfg.RPCListeners {
 			// Start a gRPC server listening for HTTP/2
 			// connections.
-			lis, err := lncfg.ListenOnAddress(grpcEndpoint)
+			lis, err := listen(grpcEndpoint)
 			if err != nil {
 				ltndLog.Errorf("unable to listen on %s",
 					grpcEndpoint)
@@ -268,6 +279,10 @@ func Main(lisCfg ListenerCfg) error {
 		return grpcListeners, cleanup, serverOpts, nil
 	}
 
+	// The state service reports the lifecycle state of the daemon over
+	// both the wallet unlocker and the main RPC server.
+	stateSvc := newStateService()
+
 	// walletUnlockerListeners is a closure we'll hand to the wallet
 	// unlocker, that will be called when it needs listeners for its GPRC
 	// server.
@@ -291,10 +306,11 @@ func Main(lisCfg ListenerCfg) error {
 	// We wait until the user provides a password over RPC. In case lnd is
 	// started with the --noseedbackup flag, we use the default password
 	// for wallet encryption.
+	shutdownUnlocker := func() {}
 	if !cfg.NoSeedBackup || isRemoteWallet {
-		params, err := waitForWalletPassword(
+		params, shutdown, err := waitForWalletPassword(
 			cfg.RESTListeners, restDialOpts, restProxyDest, tlsCfg,
-			walletUnlockerListeners,
+			walletUnlockerListeners, stateSvc,
 		)
 		if err != nil {
 			err := fmt.Errorf("Unable to set up wallet password "+
@@ -303,6 +319,12 @@ func Main(lisCfg ListenerCfg) error {
 			return err
 		}
 
+		// The unlocker is shut down once the admin macaroon has been
+		// sent back to it below, or when returning early because of an
+		// error.
+		shutdownUnlocker = shutdown
+		defer shutdownUnlocker()
+
 		walletInitParams = *params
 		privateWalletPw = walletInitParams.Password
 		publicWalletPw = walletInitParams.Password
@@ -313,12 +335,40 @@ func Main(lisCfg ListenerCfg) error {
 				walletInitParams.RecoveryWindow)
 		}
 	}
+	stateSvc.setState(lnrpc.DaemonState_UNLOCKED)
 
-	var macaroonService *macaroons.Service
+	if dbWalletPassword {
+		var closeChanDB func()
+		chanDB, closeChanDB, err = openChannelDB(
+			graphDir, privateWalletPw,
+		)
+		if err == channeldb.ErrDryRunMigrationOK {
+			ltndLog.Infof("%v, exiting", err)
+			return nil
+		}
+		if err != nil {
+			err := fmt.Errorf("Unable to open channeldb: %v", err)
+			ltndLog.Error(err)
+			return err
+		}
+		defer closeChanDB()
+	}
+
+	// The RPC middlewares enforce the custom caveats of macaroons, which
+	// are only accepted by the macaroon service if a middleware is
+	// registered for them.
+	middlewareRegistry := newRPCMiddlewareRegistry()
+
+	var (
+		macaroonService *macaroons.Service
+		adminMacBytes   []byte
+	)
 	if !cfg.NoMacaroons {
 		// Create the macaroon authentication/authorization service.
 		macaroonService, err = macaroons.NewService(
 			networkDir, macaroons.IPLockChecker,
+			macaroons.IPRangeLockChecker,
+			macaroons.CustomChecker(middlewareRegistry),
 		)
 		if err != nil {
 			err := fmt.Errorf("Unable to set up macaroon "+
@@ -336,8 +386,48 @@ func Main(lisCfg ListenerCfg) error {
 			return err
 		}
 
-		// Create macaroon files for dcrlncli to use if they don't exist.
-		if !fileExists(cfg.AdminMacPath) && !fileExists(cfg.ReadMacPath) &&
+		// In case the wallet was unlocked over RPC, an admin macaroon
+		// is baked to be returned to the caller, who might not have
+		// access to the macaroon files.
+		if walletInitParams.MacResponseChan != nil {
+			adminMacBytes, err = bakeMacaroon(
+				ctx, macaroonService, adminPermissions()...,
+			)
+			if err != nil {
+				err := fmt.Errorf("Unable to create admin "+
+					"macaroon: %v", err)
+				ltndLog.Error(err)
+				return err
+			}
+		}
+
+		// As a security service to the user, if they requested a
+		// stateless init and there are macaroon files on disk, we log
+		// a warning.
+		if walletInitParams.StatelessInit {
+			msg := "Found %s macaroon on disk (%s) even though " +
+				"--stateless_init was requested. Unencrypted " +
+				"state is accessible by the host system. You " +
+				"should change the password and use " +
+				"--new_mac_root_key with --stateless_init to " +
+				"clean up and invalidate old macaroons."
+
+			if fileExists(cfg.AdminMacPath) {
+				ltndLog.Warnf(msg, "admin", cfg.AdminMacPath)
+			}
+			if fileExists(cfg.ReadMacPath) {
+				ltndLog.Warnf(msg, "readonly", cfg.ReadMacPath)
+			}
+			if fileExists(cfg.InvoiceMacPath) {
+				ltndLog.Warnf(msg, "invoice", cfg.InvoiceMacPath)
+			}
+		}
+
+		// Create macaroon files for dcrlncli to use if they don't
+		// exist, unless the user requested a stateless init.
+		if !walletInitParams.StatelessInit &&
+			!fileExists(cfg.AdminMacPath) &&
+			!fileExists(cfg.ReadMacPath) &&
 			!fileExists(cfg.InvoiceMacPath) {
 
 			err = genMacaroons(
@@ -353,6 +443,14 @@ func Main(lisCfg ListenerCfg) error {
 		}
 	}
 
+	// The admin macaroon, if any, is sent back to the unlocker so that it
+	// can return it to the caller, and then we're done with the unlocker.
+	// The channel is buffered, so this doesn't block.
+	if walletInitParams.MacResponseChan != nil {
+		walletInitParams.MacResponseChan <- adminMacBytes
+	}
+	shutdownUnlocker()
+
 	// With the information parsed from the configuration, create valid
 	// instances of the pertinent interfaces required to operate the
 	// Lightning Network Daemon.
@@ -566,7 +664,7 @@ func Main(lisCfg ListenerCfg) error {
 	rpcServer, err := newRPCServer(
 		server, macaroonService, cfg.SubRPCServers, restDialOpts,
 		restProxyDest, atplManager, server.invoices, tower, tlsCfg,
-		rpcListeners, chainedAcceptor,
+		rpcListeners, chainedAcceptor, middlewareRegistry, stateSvc,
 	)
 	if err != nil {
 		err := fmt.Errorf("Unable to create RPC server: %v", err)
@@ -579,6 +677,7 @@ func Main(lisCfg ListenerCfg) error {
 		return err
 	}
 	defer rpcServer.Stop()
+	stateSvc.setState(lnrpc.DaemonState_ACTIVE)
 
 	// With all the relevant chains initialized, we can finally start the
 	// server itself.
@@ -588,6 +687,7 @@ func Main(lisCfg ListenerCfg) error {
 		return err
 	}
 	defer server.Stop()
+	stateSvc.setState(lnrpc.DaemonState_SERVER_ACTIVE)
 
 	// Now that the server has started, if the autopilot mode is currently
 	// active, then we'll start the autopilot agent immediately. It will be
@@ -854,8 +954,8 @@ func genMacaroons(ctx context.Context, svc *macaroons.Service,
 	// access invoice related calls. This is useful for merchants and other
 	// services to allow an isolated instance that can only query and
 	// modify invoices.
-	invoiceMac, err := svc.Oven.NewMacaroon(
-		ctx, bakery.LatestVersion, nil, invoicePermissions...,
+	invoiceMac, err := svc.NewMacaroon(
+		ctx, macaroons.DefaultRootKeyID, invoicePermissions...,
 	)
 	if err != nil {
 		return err
@@ -871,8 +971,8 @@ func genMacaroons(ctx context.Context, svc *macaroons.Service,
 	}
 
 	// Generate the read-only macaroon and write it to a file.
-	roMacaroon, err := svc.Oven.NewMacaroon(
-		ctx, bakery.LatestVersion, nil, readPermissions...,
+	roMacaroon, err := svc.NewMacaroon(
+		ctx, macaroons.DefaultRootKeyID, readPermissions...,
 	)
 	if err != nil {
 		return err
@@ -887,14 +987,7 @@ func genMacaroons(ctx context.Context, svc *macaroons.Service,
 	}
 
 	// Generate the admin macaroon and write it to a file.
-	adminPermissions := append(readPermissions, writePermissions...)
-	admMacaroon, err := svc.Oven.NewMacaroon(
-		ctx, bakery.LatestVersion, nil, adminPermissions...,
-	)
-	if err != nil {
-		return err
-	}
-	admBytes, err := admMacaroon.M().MarshalBinary()
+	admBytes, err := bakeMacaroon(ctx, svc, adminPermissions()...)
 	if err != nil {
 		return err
 	}
@@ -905,6 +998,132 @@ func genMacaroons(ctx context.Context, svc *macaroons.Service,
 	return nil
 }
 
+// adminPermissions returns the permissions granted to the admin macaroon.
+func adminPermissions() []bakery.Op {
+	perms := make([]bakery.Op, 0, len(readPermissions)+len(writePermissions))
+	perms = append(perms, readPermissions...)
+	return append(perms, writePermissions...)
+}
+
+// bakeMacaroon creates a new macaroon from the default root key granting the
+// passed permissions, and returns it serialized.
+func bakeMacaroon(ctx context.Context, svc *macaroons.Service,
+	perms ...bakery.Op) ([]byte, error) {
+
+	mac, err := svc.NewMacaroon(ctx, macaroons.DefaultRootKeyID, perms...)
+	if err != nil {
+		return nil, err
+	}
+
+	return mac.M().MarshalBinary()
+}
+
+// openChannelDB opens the channel database in graphDir. If the database
+// encryption is active, the database is first decrypted, and the returned
+// function encrypts it again once closed. The database is encrypted with the
+// content of the key file if set, or with walletPassword otherwise.
+func openChannelDB(graphDir string,
+	walletPassword []byte) (*channeldb.DB, func(), error) {
+
+	var password []byte
+	if cfg.DBEncryption.Active {
+		var err error
+		password, err = cfg.DBEncryption.Password(walletPassword)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// A plaintext database left next to its encrypted form was
+		// exposed on disk since the node stopped without encrypting
+		// it. It holds the latest state of the channels, so it's used
+		// as is and encrypted again on shutdown.
+		if channeldb.IsDBLeftDecrypted(graphDir) {
+			ltndLog.Errorf("Channeldb in %v was left decrypted on "+
+				"disk by an unclean shutdown! Its plaintext "+
+				"may have been exposed, it will be encrypted "+
+				"again on shutdown", graphDir)
+		}
+
+		if err := channeldb.DecryptDB(graphDir, password); err != nil {
+			return nil, nil, fmt.Errorf("unable to decrypt "+
+				"channeldb: %v", err)
+		}
+	}
+
+	// A plaintext copy of an encrypted database would defeat its
+	// encryption, so it isn't backed up before migrating it.
+	migrationBackup := !cfg.DB.NoMigrationBackup
+	if migrationBackup && password != nil {
+		ltndLog.Warnf("Channeldb is encrypted, not backing it up " +
+			"before migrating it")
+		migrationBackup = false
+	}
+
+	chanDB, err := channeldb.Open(
+		graphDir,
+		channeldb.OptionSetRejectCacheSize(cfg.Caches.RejectCacheSize),
+		channeldb.OptionSetChannelCacheSize(cfg.Caches.ChannelCacheSize),
+		channeldb.OptionSetSyncFreelist(cfg.SyncFreelist),
+		channeldb.OptionSetMigrationBackup(migrationBackup),
+		channeldb.OptionDryRunMigration(cfg.DB.DryRunMigration),
+		channeldb.OptionAutoCompact(
+			cfg.DB.AutoCompact, cfg.DB.AutoCompactMinFreeRatio,
+		),
+	)
+	if err != nil {
+		// The database mustn't be left decrypted if it couldn't be
+		// opened.
+		if password != nil {
+			encErr := channeldb.EncryptDB(graphDir, password)
+			if encErr != nil {
+				ltndLog.Errorf("Unable to encrypt channeldb: "+
+					"%v", encErr)
+			}
+		}
+		return nil, nil, err
+	}
+
+	closeChanDB := func() {
+		chanDB.Close()
+
+		if password == nil {
+			return
+		}
+
+		ltndLog.Infof("Encrypting channeldb")
+		if err := channeldb.EncryptDB(graphDir, password); err != nil {
+			ltndLog.Errorf("Unable to encrypt channeldb: %v", err)
+		}
+	}
+
+	// The entries breaking the invariants of the database are only
+	// reported, unless asked to repair them.
+	if cfg.DB.IntegrityCheck || cfg.DB.RepairIntegrity {
+		ltndLog.Infof("Checking channeldb integrity")
+		report, err := chanDB.CheckIntegrity(cfg.DB.RepairIntegrity)
+		if err != nil {
+			closeChanDB()
+			return nil, nil, fmt.Errorf("unable to check channeldb "+
+				"integrity: %v", err)
+		}
+
+		switch {
+		case report.Total() == 0:
+			ltndLog.Infof("Channeldb integrity check passed")
+
+		case report.Repaired:
+			ltndLog.Warnf("Repaired channeldb integrity: %v", report)
+
+		default:
+			ltndLog.Warnf("Channeldb integrity check failed: %v, "+
+				"restart with db.repair-integrity to repair it",
+				report)
+		}
+	}
+
+	return chanDB, closeChanDB, nil
+}
+
 // WalletUnlockParams holds the variables used to parameterize the unlocking of
 // lnd's wallet after it has already been created.
 type WalletUnlockParams struct {
@@ -937,46 +1156,138 @@ type WalletUnlockParams struct {
 	// ChansToRestore a set of static channel backups that should be
 	// restored before the main server instance starts up.
 	ChansToRestore walletunlocker.ChannelsToRecover
+
+	// StatelessInit signals that the user requested the daemon to not
+	// create any macaroon files on disk.
+	StatelessInit bool
+
+	// MacResponseChan is the channel the admin macaroon must be sent over
+	// to the unlocker, which returns it to the user that provided the
+	// password.
+	MacResponseChan chan []byte
+}
+
+// newRESTHandler returns the handler of the REST API of the given gRPC server,
+// which serves the given mux of the gateway generated from rpc.proto along with
+// the gateway to all the services of the server, for the origins allowed by the
+// restcors option. The returned function closes the connection of the gateway
+// to the server.
+func newRESTHandler(mux *proxy.ServeMux, grpcServer *grpc.Server,
+	restProxyDest string, restDialOpts []grpc.DialOption) (http.Handler,
+	func(), error) {
+
+	conn, err := grpc.Dial(restProxyDest, restDialOpts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gateway, err := restproxy.New(conn, grpcServer.GetServiceInfo())
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+
+	httpMux := http.NewServeMux()
+	httpMux.Handle("/", mux)
+	httpMux.Handle(restproxy.PathPrefix, gateway)
+
+	handler := restproxy.WithCORS(httpMux, cfg.RestCORS)
+	return handler, func() { conn.Close() }, nil
 }
 
 // waitForWalletPassword will spin up gRPC and REST endpoints for the
-// WalletUnlocker server, and block until a password is provided by
-// the user to this RPC server.
+// WalletUnlocker and State servers, and block until a password is provided by
+// the user to this RPC server. The servers keep running after the password is
+// received so that the admin macaroon can be returned to the user, and are
+// stopped by the returned shutdown function once it has been sent over the
+// MacResponseChan of the returned parameters.
 func waitForWalletPassword(restEndpoints []net.Addr,
 	restDialOpts []grpc.DialOption, restProxyDest string,
-	tlsConf *tls.Config, getListeners rpcListeners) (
-	*WalletUnlockParams, error) {
+	tlsConf *tls.Config, getListeners rpcListeners,
+	stateSvc *stateService) (*WalletUnlockParams, func(), error) {
 
 	// Start a gRPC server listening for HTTP/2 connections, solely used
 	// for getting the encryption password from the client.
 	listeners, cleanup, serverOpts, err := getListeners()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	defer cleanup()
 
 	// Set up a new PasswordService, which will listen for passwords
 	// provided over RPC.
 	grpcServer := grpc.NewServer(serverOpts...)
-	defer grpcServer.GracefulStop()
 
 	chainConfig := cfg.Decred
 
-	// The macaroon files are passed to the wallet unlocker since they are
-	// also encrypted with the wallet's password. These files will be
-	// deleted within it and recreated when successfully changing the
-	// wallet's password.
+	// The macaroon database is passed to the wallet unlocker since it's
+	// also encrypted with the wallet's password, and re-encrypted when
+	// successfully changing the wallet's password. The macaroon files are
+	// deleted within it when they become invalid, and recreated at
+	// startup.
+	var macaroonDir string
+	if !cfg.NoMacaroons {
+		macaroonDir = networkDir
+	}
 	macaroonFiles := []string{
-		filepath.Join(networkDir, macaroons.DBFilename),
 		cfg.AdminMacPath, cfg.ReadMacPath, cfg.InvoiceMacPath,
 	}
+
+	// Likewise, the channel database is passed to the wallet unlocker if
+	// it's encrypted with the wallet's password.
+	var encryptedDBDir string
+	if cfg.DBEncryption.Active && cfg.DBEncryption.KeyFile == "" {
+		encryptedDBDir = filepath.Join(
+			cfg.DataDir, defaultGraphSubDirname,
+			normalizeNetwork(activeNetParams.Name),
+		)
+	}
 	pwService := walletunlocker.New(
 		chainConfig.ChainDir, activeNetParams.Params, !cfg.SyncFreelist,
-		macaroonFiles, cfg.Dcrwallet.GRPCHost, cfg.Dcrwallet.CertPath,
+		macaroonDir, macaroonFiles, encryptedDBDir,
+		cfg.Dcrwallet.GRPCHost, cfg.Dcrwallet.CertPath,
 		cfg.Dcrwallet.AccountNumber,
 	)
 	lnrpc.RegisterWalletUnlockerServer(grpcServer, pwService)
 
+	// The state subscriptions end once the servers are shut down, since
+	// the main RPC server takes over serving the state.
+	stateQuit := make(chan struct{})
+	lnrpc.RegisterStateServer(grpcServer, stateSvc.newServer(stateQuit))
+
+	// Start a REST proxy for our gRPC server above.
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+
+	// shutdown stops the servers. The MacResponseChan and the state
+	// subscriptions are closed first, so that the RPCs still waiting for
+	// the admin macaroon or a state change return and don't hold up the
+	// graceful stop of the gRPC server.
+	var (
+		restListeners []net.Listener
+		closeGateway  = func() {}
+		shutdownOnce  sync.Once
+	)
+	shutdown := func() {
+		shutdownOnce.Do(func() {
+			close(pwService.MacResponseChan)
+			close(stateQuit)
+			for _, lis := range restListeners {
+				lis.Close()
+			}
+			closeGateway()
+			cancel()
+			grpcServer.GracefulStop()
+			cleanup()
+		})
+	}
+
+	success := false
+	defer func() {
+		if !success {
+			shutdown()
+		}
+	}()
+
 	// Use a WaitGroup so we can be sure the instructions on how to input the
 	// password is the last thing to be printed to the console.
 	var wg sync.WaitGroup
@@ -991,32 +1302,39 @@ func waitForWalletPassword(restEndpoints []net.Addr,
 		}(lis)
 	}
 
-	// Start a REST proxy for our gRPC server above.
-	ctx := context.Background()
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
-
 	mux := proxy.NewServeMux()
 
 	err = lnrpc.RegisterWalletUnlockerHandlerFromEndpoint(
 		ctx, mux, restProxyDest, restDialOpts,
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	handler, closeGw, err := newRESTHandler(
+		mux, grpcServer, restProxyDest, restDialOpts,
+	)
+	if err != nil {
+		return nil, nil, err
 	}
+	closeGateway = closeGw
 
-	srv := &http.Server{Handler: mux}
+	srv := &http.Server{Handler: handler}
 
+	listen := lncfg.TLSListenOnAddress
+	if cfg.RESTListenProxyProtocol {
+		listen = lncfg.ProxyTLSListenOnAddress
+	}
 	for _, restEndpoint := range restEndpoints {
-		lis, err := lncfg.TLSListenOnAddress(restEndpoint, tlsConf)
+		lis, err := listen(restEndpoint, tlsConf)
 		if err != nil {
 			ltndLog.Errorf(
 				"password gRPC proxy unable to listen on %s",
 				restEndpoint,
 			)
-			return nil, err
+			return nil, nil, err
 		}
-		defer lis.Close()
+		restListeners = append(restListeners, lis)
 
 		wg.Add(1)
 		go func() {
@@ -1056,8 +1374,8 @@ func waitForWalletPassword(restEndpoints []net.Addr,
 		// version, then we'll return an error as we don't understand
 		// this.
 		if cipherSeed.InternalVersion != keychain.KeyDerivationVersion {
-			return nil, fmt.Errorf("invalid internal seed version "+
-				"%v, current version is %v",
+			return nil, nil, fmt.Errorf("invalid internal seed "+
+				"version %v, current version is %v",
 				cipherSeed.InternalVersion,
 				keychain.KeyDerivationVersion)
 		}
@@ -1084,31 +1402,37 @@ func waitForWalletPassword(restEndpoints []net.Addr,
 				ltndLog.Errorf("Could not unload new "+
 					"wallet: %v", err)
 			}
-			return nil, err
+			return nil, nil, err
 		}
 
+		success = true
 		return &WalletUnlockParams{
-			Password:       password,
-			Birthday:       birthday,
-			RecoveryWindow: recoveryWindow,
-			Wallet:         newWallet,
-			Loader:         loader,
-			ChansToRestore: initMsg.ChanBackups,
-		}, nil
+			Password:        password,
+			Birthday:        birthday,
+			RecoveryWindow:  recoveryWindow,
+			Wallet:          newWallet,
+			Loader:          loader,
+			ChansToRestore:  initMsg.ChanBackups,
+			StatelessInit:   initMsg.StatelessInit,
+			MacResponseChan: pwService.MacResponseChan,
+		}, shutdown, nil
 
 	// The wallet has already been created in the past, and is simply being
 	// unlocked. So we'll just return these passphrases.
 	case unlockMsg := <-pwService.UnlockMsgs:
+		success = true
 		return &WalletUnlockParams{
-			Password:       unlockMsg.Passphrase,
-			RecoveryWindow: unlockMsg.RecoveryWindow,
-			Wallet:         unlockMsg.Wallet,
-			Loader:         unlockMsg.Loader,
-			ChansToRestore: unlockMsg.ChanBackups,
-			Conn:           unlockMsg.Conn,
-		}, nil
+			Password:        unlockMsg.Passphrase,
+			RecoveryWindow:  unlockMsg.RecoveryWindow,
+			Wallet:          unlockMsg.Wallet,
+			Loader:          unlockMsg.Loader,
+			ChansToRestore:  unlockMsg.ChanBackups,
+			Conn:            unlockMsg.Conn,
+			StatelessInit:   unlockMsg.StatelessInit,
+			MacResponseChan: pwService.MacResponseChan,
+		}, shutdown, nil
 
 	case <-signal.ShutdownChannel():
-		return nil, fmt.Errorf("shutting down")
+		return nil, nil, fmt.Errorf("shutting down")
 	}
 }
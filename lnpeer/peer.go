@@ -62,4 +62,10 @@ type Peer interface {
 	// this interface to gate their behavior off the set of negotiated
 	// feature bits.
 	RemoteGlobalFeatures() *lnwire.FeatureVector
+
+	// RemoteLocalFeatures returns the set of local features that has been
+	// advertised by the remote peer. This allows sub-systems that use this
+	// interface to gate their behavior off the set of negotiated feature
+	// bits.
+	RemoteLocalFeatures() *lnwire.FeatureVector
 }
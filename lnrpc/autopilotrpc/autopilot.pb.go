@@ -33,7 +33,7 @@ func (m *StatusRequest) Reset()         { *m = StatusRequest{} }
 func (m *StatusRequest) String() string { return proto.CompactTextString(m) }
 func (*StatusRequest) ProtoMessage()    {}
 func (*StatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_autopilot_71511fa1a07aa8df, []int{0}
+	return fileDescriptor_autopilot_2ce94c05d4b3d70e, []int{0}
 }
 func (m *StatusRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StatusRequest.Unmarshal(m, b)
@@ -55,17 +55,37 @@ var xxx_messageInfo_StatusRequest proto.InternalMessageInfo
 
 type StatusResponse struct {
 	// / Indicates whether the autopilot is active or not.
-	Active               bool     `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Active bool `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	// *
+	// The available balance of the wallet considered by the agent, in atoms. This
+	// and all the following fields are only set if the autopilot is active.
+	WalletBalance int64 `protobuf:"varint,2,opt,name=wallet_balance,proto3" json:"wallet_balance,omitempty"`
+	// *
+	// The number of channels counted against the limits of the agent, including
+	// the ones still pending.
+	NumChans uint32 `protobuf:"varint,3,opt,name=num_chans,proto3" json:"num_chans,omitempty"`
+	// *
+	// The funds, in atoms, that the agent can still commit to new channels
+	// within its allocation.
+	AvailableFunds int64 `protobuf:"varint,4,opt,name=available_funds,proto3" json:"available_funds,omitempty"`
+	// / The number of additional channels the agent can open within its limits.
+	NumChansAvailable uint32 `protobuf:"varint,5,opt,name=num_chans_available,proto3" json:"num_chans_available,omitempty"`
+	// *
+	// The hex-encoded public keys of the nodes the agent is connecting to in
+	// order to open a channel with them.
+	PendingConns []string `protobuf:"bytes,6,rep,name=pending_conns,proto3" json:"pending_conns,omitempty"`
+	// / The channels the agent requested to be opened, not yet confirmed.
+	PendingOpens         []*PendingOpen `protobuf:"bytes,7,rep,name=pending_opens,proto3" json:"pending_opens,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *StatusResponse) Reset()         { *m = StatusResponse{} }
 func (m *StatusResponse) String() string { return proto.CompactTextString(m) }
 func (*StatusResponse) ProtoMessage()    {}
 func (*StatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_autopilot_71511fa1a07aa8df, []int{1}
+	return fileDescriptor_autopilot_2ce94c05d4b3d70e, []int{1}
 }
 func (m *StatusResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StatusResponse.Unmarshal(m, b)
@@ -92,6 +112,48 @@ func (m *StatusResponse) GetActive() bool {
 	return false
 }
 
+func (m *StatusResponse) GetWalletBalance() int64 {
+	if m != nil {
+		return m.WalletBalance
+	}
+	return 0
+}
+
+func (m *StatusResponse) GetNumChans() uint32 {
+	if m != nil {
+		return m.NumChans
+	}
+	return 0
+}
+
+func (m *StatusResponse) GetAvailableFunds() int64 {
+	if m != nil {
+		return m.AvailableFunds
+	}
+	return 0
+}
+
+func (m *StatusResponse) GetNumChansAvailable() uint32 {
+	if m != nil {
+		return m.NumChansAvailable
+	}
+	return 0
+}
+
+func (m *StatusResponse) GetPendingConns() []string {
+	if m != nil {
+		return m.PendingConns
+	}
+	return nil
+}
+
+func (m *StatusResponse) GetPendingOpens() []*PendingOpen {
+	if m != nil {
+		return m.PendingOpens
+	}
+	return nil
+}
+
 type ModifyStatusRequest struct {
 	// / Whether the autopilot agent should be enabled or not.
 	Enable               bool     `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
@@ -104,7 +166,7 @@ func (m *ModifyStatusRequest) Reset()         { *m = ModifyStatusRequest{} }
 func (m *ModifyStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*ModifyStatusRequest) ProtoMessage()    {}
 func (*ModifyStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_autopilot_71511fa1a07aa8df, []int{2}
+	return fileDescriptor_autopilot_2ce94c05d4b3d70e, []int{2}
 }
 func (m *ModifyStatusRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ModifyStatusRequest.Unmarshal(m, b)
@@ -141,7 +203,7 @@ func (m *ModifyStatusResponse) Reset()         { *m = ModifyStatusResponse{} }
 func (m *ModifyStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*ModifyStatusResponse) ProtoMessage()    {}
 func (*ModifyStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_autopilot_71511fa1a07aa8df, []int{3}
+	return fileDescriptor_autopilot_2ce94c05d4b3d70e, []int{3}
 }
 func (m *ModifyStatusResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ModifyStatusResponse.Unmarshal(m, b)
@@ -174,7 +236,7 @@ func (m *QueryScoresRequest) Reset()         { *m = QueryScoresRequest{} }
 func (m *QueryScoresRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryScoresRequest) ProtoMessage()    {}
 func (*QueryScoresRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_autopilot_71511fa1a07aa8df, []int{4}
+	return fileDescriptor_autopilot_2ce94c05d4b3d70e, []int{4}
 }
 func (m *QueryScoresRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryScoresRequest.Unmarshal(m, b)
@@ -219,7 +281,7 @@ func (m *QueryScoresResponse) Reset()         { *m = QueryScoresResponse{} }
 func (m *QueryScoresResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryScoresResponse) ProtoMessage()    {}
 func (*QueryScoresResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_autopilot_71511fa1a07aa8df, []int{5}
+	return fileDescriptor_autopilot_2ce94c05d4b3d70e, []int{5}
 }
 func (m *QueryScoresResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryScoresResponse.Unmarshal(m, b)
@@ -258,7 +320,7 @@ func (m *QueryScoresResponse_HeuristicResult) Reset()         { *m = QueryScores
 func (m *QueryScoresResponse_HeuristicResult) String() string { return proto.CompactTextString(m) }
 func (*QueryScoresResponse_HeuristicResult) ProtoMessage()    {}
 func (*QueryScoresResponse_HeuristicResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_autopilot_71511fa1a07aa8df, []int{5, 0}
+	return fileDescriptor_autopilot_2ce94c05d4b3d70e, []int{5, 0}
 }
 func (m *QueryScoresResponse_HeuristicResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryScoresResponse_HeuristicResult.Unmarshal(m, b)
@@ -308,7 +370,7 @@ func (m *SetScoresRequest) Reset()         { *m = SetScoresRequest{} }
 func (m *SetScoresRequest) String() string { return proto.CompactTextString(m) }
 func (*SetScoresRequest) ProtoMessage()    {}
 func (*SetScoresRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_autopilot_71511fa1a07aa8df, []int{6}
+	return fileDescriptor_autopilot_2ce94c05d4b3d70e, []int{6}
 }
 func (m *SetScoresRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetScoresRequest.Unmarshal(m, b)
@@ -352,7 +414,7 @@ func (m *SetScoresResponse) Reset()         { *m = SetScoresResponse{} }
 func (m *SetScoresResponse) String() string { return proto.CompactTextString(m) }
 func (*SetScoresResponse) ProtoMessage()    {}
 func (*SetScoresResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_autopilot_71511fa1a07aa8df, []int{7}
+	return fileDescriptor_autopilot_2ce94c05d4b3d70e, []int{7}
 }
 func (m *SetScoresResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetScoresResponse.Unmarshal(m, b)
@@ -372,6 +434,54 @@ func (m *SetScoresResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SetScoresResponse proto.InternalMessageInfo
 
+type PendingOpen struct {
+	// / The hex-encoded public key of the node the channel is opened with.
+	Pubkey string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	// / The capacity of the channel, in atoms.
+	Capacity             int64    `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingOpen) Reset()         { *m = PendingOpen{} }
+func (m *PendingOpen) String() string { return proto.CompactTextString(m) }
+func (*PendingOpen) ProtoMessage()    {}
+func (*PendingOpen) Descriptor() ([]byte, []int) {
+	return fileDescriptor_autopilot_2ce94c05d4b3d70e, []int{8}
+}
+func (m *PendingOpen) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingOpen.Unmarshal(m, b)
+}
+func (m *PendingOpen) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingOpen.Marshal(b, m, deterministic)
+}
+func (dst *PendingOpen) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingOpen.Merge(dst, src)
+}
+func (m *PendingOpen) XXX_Size() int {
+	return xxx_messageInfo_PendingOpen.Size(m)
+}
+func (m *PendingOpen) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingOpen.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingOpen proto.InternalMessageInfo
+
+func (m *PendingOpen) GetPubkey() string {
+	if m != nil {
+		return m.Pubkey
+	}
+	return ""
+}
+
+func (m *PendingOpen) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*StatusRequest)(nil), "autopilotrpc.StatusRequest")
 	proto.RegisterType((*StatusResponse)(nil), "autopilotrpc.StatusResponse")
@@ -384,6 +494,7 @@ func init() {
 	proto.RegisterType((*SetScoresRequest)(nil), "autopilotrpc.SetScoresRequest")
 	proto.RegisterMapType((map[string]float64)(nil), "autopilotrpc.SetScoresRequest.ScoresEntry")
 	proto.RegisterType((*SetScoresResponse)(nil), "autopilotrpc.SetScoresResponse")
+	proto.RegisterType((*PendingOpen)(nil), "autopilotrpc.PendingOpen")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -399,7 +510,9 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type AutopilotClient interface {
 	// *
-	// Status returns whether the daemon's autopilot agent is active.
+	// Status returns whether the daemon's autopilot agent is active. If it is,
+	// the channel budget of the agent and its pending channel-open decisions are
+	// also returned.
 	Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error)
 	// *
 	// ModifyStatus is used to modify the status of the autopilot agent, like
@@ -463,7 +576,9 @@ func (c *autopilotClient) SetScores(ctx context.Context, in *SetScoresRequest, o
 // AutopilotServer is the server API for Autopilot service.
 type AutopilotServer interface {
 	// *
-	// Status returns whether the daemon's autopilot agent is active.
+	// Status returns whether the daemon's autopilot agent is active. If it is,
+	// the channel budget of the agent and its pending channel-open decisions are
+	// also returned.
 	Status(context.Context, *StatusRequest) (*StatusResponse, error)
 	// *
 	// ModifyStatus is used to modify the status of the autopilot agent, like
@@ -582,38 +697,47 @@ var _Autopilot_serviceDesc = grpc.ServiceDesc{
 }
 
 func init() {
-	proto.RegisterFile("autopilotrpc/autopilot.proto", fileDescriptor_autopilot_71511fa1a07aa8df)
-}
-
-var fileDescriptor_autopilot_71511fa1a07aa8df = []byte{
-	// 457 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x6b, 0xd4, 0x40,
-	0x14, 0xc7, 0x49, 0x8a, 0xdb, 0xe6, 0x6d, 0xb5, 0x75, 0xb6, 0x94, 0x10, 0x17, 0xdd, 0x0e, 0x1e,
-	0x16, 0xa5, 0x59, 0xac, 0x17, 0x15, 0x3c, 0x58, 0x11, 0x04, 0xf5, 0xe0, 0xac, 0xbd, 0x78, 0x29,
-	0xd9, 0xc9, 0xb3, 0x0d, 0x1d, 0x33, 0x71, 0x7e, 0x14, 0xf2, 0x0f, 0x79, 0xf5, 0x6f, 0xf0, 0xe8,
-	0x7f, 0x25, 0x9b, 0x49, 0xd6, 0x64, 0x59, 0x23, 0x42, 0x6f, 0x79, 0x3f, 0xf2, 0x79, 0xf3, 0xbe,
-	0xf3, 0x65, 0x60, 0x9c, 0x58, 0x23, 0x8b, 0x4c, 0x48, 0xa3, 0x0a, 0x3e, 0x5b, 0x05, 0x71, 0xa1,
-	0xa4, 0x91, 0x64, 0xb7, 0x5d, 0xa5, 0x7b, 0x70, 0x7b, 0x6e, 0x12, 0x63, 0x35, 0xc3, 0x6f, 0x16,
-	0xb5, 0xa1, 0x53, 0xb8, 0xd3, 0x24, 0x74, 0x21, 0x73, 0x8d, 0xe4, 0x10, 0x06, 0x09, 0x37, 0xd9,
-	0x35, 0x86, 0xde, 0xc4, 0x9b, 0xee, 0xb0, 0x3a, 0xa2, 0xc7, 0x30, 0xfa, 0x20, 0xd3, 0xec, 0x4b,
-	0xd9, 0x01, 0x2c, 0xdb, 0x31, 0x4f, 0x16, 0x62, 0xd5, 0xee, 0x22, 0x7a, 0x08, 0x07, 0xdd, 0x76,
-	0x87, 0xa7, 0x9f, 0x80, 0x7c, 0xb4, 0xa8, 0xca, 0x39, 0x97, 0x0a, 0x57, 0x94, 0x10, 0xb6, 0x0b,
-	0xbb, 0xb8, 0xc2, 0x52, 0x87, 0xde, 0x64, 0x6b, 0x1a, 0xb0, 0x26, 0x24, 0x0f, 0x81, 0x64, 0x17,
-	0xb9, 0x54, 0x78, 0x2e, 0x24, 0x4f, 0xc4, 0xb9, 0x36, 0x89, 0xc1, 0xd0, 0xaf, 0x66, 0xed, 0xe4,
-	0xd2, 0xc5, 0xf4, 0xbb, 0x0f, 0xa3, 0x0e, 0xb6, 0x5e, 0xe6, 0x1d, 0x6c, 0x2b, 0xd4, 0x56, 0x18,
-	0xc7, 0x1d, 0x9e, 0x3c, 0x89, 0xdb, 0x7a, 0xc4, 0x1b, 0xfe, 0x89, 0xdf, 0xa2, 0x55, 0x99, 0x36,
-	0x19, 0x67, 0xd5, 0x9f, 0xac, 0x21, 0x44, 0x3f, 0x3d, 0xd8, 0x5b, 0x2b, 0x92, 0x31, 0x04, 0x97,
-	0x4d, 0xaa, 0x52, 0x20, 0x60, 0x7f, 0x12, 0xe4, 0x0c, 0x06, 0xba, 0x82, 0x87, 0x7e, 0x35, 0xfd,
-	0xe5, 0x7f, 0x4f, 0x8f, 0x5d, 0xf9, 0x4d, 0x6e, 0x54, 0xc9, 0x6a, 0x58, 0xf4, 0x1c, 0x86, 0xad,
-	0x34, 0xd9, 0x87, 0xad, 0x2b, 0x2c, 0xeb, 0xe9, 0xcb, 0x4f, 0x72, 0x00, 0xb7, 0xae, 0x13, 0x61,
-	0x9d, 0x4e, 0x1e, 0x73, 0xc1, 0x0b, 0xff, 0x99, 0x47, 0x7f, 0x78, 0xb0, 0x3f, 0x47, 0xd3, 0x55,
-	0xbf, 0x7f, 0x89, 0xd3, 0xb5, 0x25, 0x1e, 0x75, 0x97, 0x58, 0xa7, 0xdd, 0xf4, 0x89, 0x47, 0x70,
-	0xb7, 0x35, 0xc2, 0xa9, 0x74, 0xf2, 0xcb, 0x87, 0xe0, 0x55, 0x73, 0x0a, 0xf2, 0x1a, 0x06, 0xce,
-	0x65, 0xe4, 0xde, 0xda, 0xd9, 0xda, 0x56, 0x8d, 0xc6, 0x9b, 0x8b, 0xb5, 0x55, 0xce, 0x60, 0xb7,
-	0x6d, 0x58, 0x72, 0xd4, 0xed, 0xde, 0xe0, 0xfd, 0x88, 0xf6, 0xb5, 0xd4, 0x58, 0x06, 0xc3, 0xd6,
-	0x35, 0x93, 0x49, 0x8f, 0x03, 0x1c, 0xf4, 0xe8, 0x9f, 0x1e, 0x21, 0xef, 0x21, 0x58, 0x49, 0x42,
-	0xee, 0xf7, 0x5f, 0x47, 0xf4, 0xe0, 0xaf, 0x75, 0x47, 0x3b, 0x3d, 0xfe, 0xfc, 0xf8, 0x22, 0x33,
-	0x97, 0x76, 0x11, 0x73, 0xf9, 0x75, 0x96, 0x22, 0x57, 0x98, 0xce, 0x52, 0xae, 0x44, 0x9e, 0xce,
-	0x44, 0xde, 0x79, 0x53, 0x54, 0xc1, 0x17, 0x83, 0xea, 0x5d, 0x79, 0xfa, 0x3b, 0x00, 0x00, 0xff,
-	0xff, 0xfd, 0x4b, 0x70, 0x42, 0x77, 0x04, 0x00, 0x00,
+	proto.RegisterFile("autopilotrpc/autopilot.proto", fileDescriptor_autopilot_2ce94c05d4b3d70e)
+}
+
+var fileDescriptor_autopilot_2ce94c05d4b3d70e = []byte{
+	// 597 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x5d, 0x6f, 0xd3, 0x30,
+	0x14, 0x55, 0x5a, 0xd6, 0xb5, 0xb7, 0xfb, 0xc2, 0x9d, 0xa6, 0x10, 0x26, 0xc8, 0xa2, 0x09, 0x45,
+	0xa0, 0xa5, 0x30, 0x5e, 0x00, 0x09, 0xa1, 0x0d, 0x21, 0x21, 0x01, 0x02, 0x3c, 0xf6, 0xc2, 0x4b,
+	0xe4, 0x3a, 0xde, 0x16, 0xcd, 0xb3, 0x43, 0xec, 0x0c, 0xe5, 0x0f, 0xf1, 0x8a, 0xf8, 0x09, 0x3c,
+	0xf2, 0xaf, 0x50, 0xe3, 0x34, 0x4d, 0xa2, 0x52, 0x84, 0xc4, 0x5b, 0xce, 0xb9, 0xd7, 0xc7, 0xbe,
+	0xc7, 0x27, 0x86, 0x5d, 0x92, 0x69, 0x99, 0xc4, 0x5c, 0xea, 0x34, 0xa1, 0xe3, 0x0a, 0x04, 0x49,
+	0x2a, 0xb5, 0x44, 0x6b, 0xf5, 0xaa, 0xb7, 0x09, 0xeb, 0x27, 0x9a, 0xe8, 0x4c, 0x61, 0xf6, 0x25,
+	0x63, 0x4a, 0x7b, 0x3f, 0x3a, 0xb0, 0x31, 0x63, 0x54, 0x22, 0x85, 0x62, 0x68, 0x07, 0x7a, 0x84,
+	0xea, 0xf8, 0x9a, 0xd9, 0x96, 0x6b, 0xf9, 0x7d, 0x5c, 0x22, 0x74, 0x0f, 0x36, 0xbe, 0x12, 0xce,
+	0x99, 0x0e, 0x27, 0x84, 0x13, 0x41, 0x99, 0xdd, 0x71, 0x2d, 0xbf, 0x8b, 0x5b, 0x2c, 0xda, 0x85,
+	0x81, 0xc8, 0xae, 0x42, 0x7a, 0x41, 0x84, 0xb2, 0xbb, 0xae, 0xe5, 0xaf, 0xe3, 0x39, 0x81, 0x7c,
+	0xd8, 0x24, 0xd7, 0x24, 0xe6, 0x64, 0xc2, 0x59, 0x78, 0x96, 0x89, 0x48, 0xd9, 0x37, 0x0a, 0x99,
+	0x36, 0x8d, 0x1e, 0xc2, 0xa8, 0x5a, 0x16, 0x56, 0x45, 0x7b, 0xa5, 0x50, 0x5c, 0x54, 0x42, 0xfb,
+	0xb0, 0x9e, 0x30, 0x11, 0xc5, 0xe2, 0x3c, 0xa4, 0x52, 0x08, 0x65, 0xf7, 0xdc, 0xae, 0x3f, 0xc0,
+	0x4d, 0x12, 0xbd, 0x98, 0x77, 0xc9, 0x84, 0x09, 0x65, 0xaf, 0xba, 0x5d, 0x7f, 0x78, 0x78, 0x2b,
+	0xa8, 0x3b, 0x15, 0x7c, 0x30, 0x2d, 0xef, 0x13, 0x26, 0x70, 0xb3, 0xdf, 0x3b, 0x80, 0xd1, 0x3b,
+	0x19, 0xc5, 0x67, 0x79, 0xc3, 0xca, 0xa9, 0x6f, 0x4c, 0x14, 0x47, 0x2c, 0x7d, 0x33, 0xc8, 0xdb,
+	0x81, 0xed, 0x66, 0xbb, 0xf1, 0xd9, 0xfb, 0x04, 0xe8, 0x63, 0xc6, 0xd2, 0xfc, 0x84, 0xca, 0x94,
+	0x55, 0x2a, 0x36, 0xac, 0x26, 0xd9, 0xe4, 0x92, 0xe5, 0xca, 0xb6, 0x8a, 0xd3, 0xcf, 0x20, 0xda,
+	0x07, 0x14, 0x9f, 0x0b, 0x99, 0xb2, 0x90, 0x4b, 0x4a, 0x78, 0xa8, 0x34, 0xd1, 0xe6, 0x0e, 0xfa,
+	0xb8, 0x2f, 0xa4, 0xc1, 0xde, 0xb7, 0x0e, 0x8c, 0x1a, 0xb2, 0xe5, 0xad, 0xbe, 0x81, 0xd5, 0x94,
+	0xa9, 0x8c, 0x6b, 0xa3, 0x3b, 0x3c, 0x7c, 0xd4, 0x9c, 0x77, 0xc1, 0x9a, 0xe0, 0x35, 0xcb, 0xd2,
+	0x58, 0xe9, 0x98, 0xe2, 0x62, 0x25, 0x9e, 0x29, 0x38, 0x3f, 0x2d, 0xd8, 0x6c, 0x15, 0xa7, 0xd7,
+	0x7e, 0x31, 0xa3, 0x0a, 0x07, 0x06, 0x78, 0x4e, 0xa0, 0x53, 0xe8, 0xa9, 0x42, 0xdc, 0xee, 0x14,
+	0xbb, 0x3f, 0xff, 0xe7, 0xdd, 0x03, 0x53, 0x7e, 0x25, 0x74, 0x9a, 0xe3, 0x52, 0xcc, 0x79, 0x0a,
+	0xc3, 0x1a, 0x8d, 0xb6, 0xa0, 0x7b, 0xc9, 0xf2, 0x72, 0xf7, 0xe9, 0x27, 0xda, 0x86, 0x95, 0x6b,
+	0xc2, 0x33, 0xe3, 0x93, 0x85, 0x0d, 0x78, 0xd6, 0x79, 0x62, 0x79, 0xdf, 0x2d, 0xd8, 0x3a, 0x61,
+	0xba, 0xe9, 0xfe, 0xf2, 0x21, 0x8e, 0x5b, 0x43, 0xdc, 0x6f, 0x0e, 0xd1, 0x56, 0xfb, 0xdf, 0x27,
+	0x1e, 0xc1, 0xcd, 0xda, 0x16, 0x65, 0x8a, 0x8e, 0x60, 0x58, 0x8b, 0xea, 0x34, 0x84, 0x26, 0x2f,
+	0xa5, 0x64, 0x89, 0x90, 0x03, 0x7d, 0x4a, 0x12, 0x42, 0x63, 0x9d, 0x97, 0xbf, 0x6d, 0x85, 0x0f,
+	0x7f, 0x75, 0x60, 0x70, 0x34, 0x1b, 0x04, 0xbd, 0x84, 0x9e, 0x09, 0x2a, 0xba, 0xdd, 0x1a, 0xaf,
+	0x9e, 0x76, 0x67, 0x77, 0x71, 0xb1, 0x4c, 0xdb, 0x29, 0xac, 0xd5, 0x33, 0x8f, 0xf6, 0x9a, 0xdd,
+	0x0b, 0x7e, 0x1f, 0xc7, 0x5b, 0xd6, 0x52, 0xca, 0x62, 0x18, 0xd6, 0x92, 0x82, 0xdc, 0x25, 0x21,
+	0x32, 0xa2, 0x7b, 0x7f, 0x8d, 0x19, 0x7a, 0x0b, 0x83, 0xca, 0x55, 0x74, 0x67, 0xf9, 0x8d, 0x3a,
+	0x77, 0xff, 0x58, 0x37, 0x6a, 0xc7, 0x07, 0x9f, 0x1f, 0x9c, 0xc7, 0xfa, 0x22, 0x9b, 0x04, 0x54,
+	0x5e, 0x8d, 0x23, 0x46, 0x53, 0x16, 0x8d, 0x23, 0x9a, 0x72, 0x11, 0x8d, 0xb9, 0x68, 0x3c, 0xd0,
+	0x69, 0x42, 0x27, 0xbd, 0xe2, 0x91, 0x7e, 0xfc, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xd3, 0xa9, 0x92,
+	0x60, 0xc4, 0x05, 0x00, 0x00,
 }
@@ -130,15 +130,43 @@ func (s *Server) RegisterWithRootServer(grpcServer *grpc.Server) error {
 	return nil
 }
 
-// Status returns the current status of the autopilot agent.
+// Status returns the current status of the autopilot agent, along with its
+// channel budget and pending channel-open decisions if it is active.
 //
 // NOTE: Part of the AutopilotServer interface.
 func (s *Server) Status(ctx context.Context,
 	in *StatusRequest) (*StatusResponse, error) {
 
-	return &StatusResponse{
-		Active: s.manager.IsActive(),
-	}, nil
+	status, err := s.manager.AgentStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	// The agent isn't active, so it has no budget to report.
+	if status == nil {
+		return &StatusResponse{}, nil
+	}
+
+	resp := &StatusResponse{
+		Active:            true,
+		WalletBalance:     int64(status.WalletBalance),
+		NumChans:          status.NumChans,
+		AvailableFunds:    int64(status.AvailableFunds),
+		NumChansAvailable: status.NumChansAvailable,
+	}
+	for _, nodeID := range status.PendingConns {
+		resp.PendingConns = append(
+			resp.PendingConns, hex.EncodeToString(nodeID[:]),
+		)
+	}
+	for _, pendingChan := range status.PendingOpens {
+		resp.PendingOpens = append(resp.PendingOpens, &PendingOpen{
+			Pubkey:   hex.EncodeToString(pendingChan.Node[:]),
+			Capacity: int64(pendingChan.Capacity),
+		})
+	}
+
+	return resp, nil
 }
 
 // ModifyStatus activates the current autopilot agent, if active.
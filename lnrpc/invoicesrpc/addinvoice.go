@@ -1,7 +1,6 @@
 package invoicesrpc
 
 import (
-	"bytes"
 	"context"
 	"crypto/rand"
 	"errors"
@@ -13,6 +12,7 @@ import (
 	"github.com/decred/dcrlnd/lntypes"
 	"github.com/decred/dcrlnd/lnwire"
 	"github.com/decred/dcrlnd/netann"
+	"github.com/decred/dcrlnd/routing/route"
 	"github.com/decred/dcrlnd/zpay32"
 
 	"github.com/davecgh/go-spew/spew"
@@ -29,6 +29,16 @@ type AddInvoiceConfig struct {
 	// IsChannelActive is used to generate valid hop hints.
 	IsChannelActive func(chanID lnwire.ChannelID) bool
 
+	// IsPeerOnline optionally returns true if we're currently connected to
+	// the given peer. It is used to skip the hop hints through offline
+	// peers.
+	IsPeerOnline func(peer route.Vertex) bool
+
+	// PeerReliability optionally returns the probability of a peer
+	// forwarding a payment of the given amount to us. It is used to rank
+	// the private channels eligible as hop hints.
+	PeerReliability func(peer route.Vertex, amt lnwire.MilliAtom) float64
+
 	// ChainParams are required to properly decode invoice payment requests
 	// that are marshalled over rpc.
 	ChainParams *chaincfg.Params
@@ -47,6 +57,16 @@ type AddInvoiceConfig struct {
 	// ChanDB is a global boltdb instance which is needed to access the
 	// channel graph.
 	ChanDB *channeldb.DB
+
+	// Features is the feature vector advertised in new invoices. If it
+	// signals the payment-addr feature, new invoices include a random
+	// payment address that payers must echo back in the final hop
+	// payload.
+	Features *lnwire.FeatureVector
+
+	// MinHopHints is the minimum number of valid hop hints private
+	// invoices must include, below which their creation fails.
+	MinHopHints uint32
 }
 
 // AddInvoiceData contains the required data to create a new invoice.
@@ -91,6 +111,20 @@ type AddInvoiceData struct {
 	// Whether this invoice should include routing hints for private
 	// channels.
 	Private bool
+
+	// MaxHopHints is the maximum number of automatically selected routing
+	// hints for private channels. If zero, DefaultMaxHopHints is used.
+	MaxHopHints uint32
+
+	// HopHintChannels are the short channel ids of private channels that
+	// should always be included as routing hints, regardless of the
+	// Private flag and of the maximum number of hints.
+	HopHintChannels []uint64
+
+	// AMP indicates that the invoice is reusable and can be paid any
+	// number of times with AMP payments, each settled with its own
+	// preimage. AMP invoices cannot be hold invoices.
+	AMP bool
 }
 
 // AddInvoice attempts to add a new invoice to the invoice database. Any
@@ -111,6 +145,12 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 		return nil, nil,
 			errors.New("preimage and hash both set")
 
+	// AMP payments are settled with the preimages derived from their AMP
+	// records, so there is nothing to hold them for.
+	case invoice.AMP && invoice.Hash != nil:
+		return nil, nil,
+			errors.New("amp invoices cannot be hold invoices")
+
 	// Prevent the unknown preimage magic value from being used for a
 	// regular invoice. This would cause the invoice the be handled as if it
 	// was a hold invoice.
@@ -255,121 +295,37 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 	}
 
 	// If we were requested to include routing hints in the invoice, then
-	// we'll fetch all of our available private channels and create routing
-	// hints for them.
-	if invoice.Private {
-		openChannels, err := cfg.ChanDB.FetchAllChannels()
-		if err != nil {
-			return nil, nil, fmt.Errorf("could not fetch all channels")
-		}
+	// we'll select them among our private channels.
+	hopHints, err := selectHopHints(cfg, amtMAtoms, invoice)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Each hint is included as an individual route hint in our set of
+	// options that will be used when creating the invoice.
+	for _, hint := range hopHints {
+		routeHint := []zpay32.HopHint{hint}
+		options = append(options, zpay32.RouteHint(routeHint))
+	}
+
+	// Advertise our invoice features, if any.
+	if cfg.Features != nil && len(cfg.Features.Features()) > 0 {
+		options = append(options, zpay32.Features(cfg.Features))
+	}
 
-		graph := cfg.ChanDB.ChannelGraph()
-
-		numHints := 0
-		for _, channel := range openChannels {
-			// We'll restrict the number of individual route hints
-			// to 20 to avoid creating overly large invoices.
-			if numHints >= 20 {
-				break
-			}
-
-			// Since we're only interested in our private channels,
-			// we'll skip public ones.
-			isPublic := channel.ChannelFlags&lnwire.FFAnnounceChannel != 0
-			if isPublic {
-				continue
-			}
-
-			// Make sure the counterparty has enough balance in the
-			// channel for our amount. We do this in order to reduce
-			// payment errors when attempting to use this channel
-			// as a hint.
-			chanPoint := lnwire.NewChanIDFromOutPoint(
-				&channel.FundingOutpoint,
-			)
-			if amtMAtoms >= channel.LocalCommitment.RemoteBalance {
-				log.Debugf("Skipping channel %v due to "+
-					"not having enough remote balance",
-					chanPoint)
-				continue
-			}
-
-			// Make sure the channel is active.
-			if !cfg.IsChannelActive(chanPoint) {
-				log.Debugf("Skipping channel %v due to not "+
-					"being eligible to forward payments",
-					chanPoint)
-				continue
-			}
-
-			// To ensure we don't leak unadvertised nodes, we'll
-			// make sure our counterparty is publicly advertised
-			// within the network. Otherwise, we'll end up leaking
-			// information about nodes that intend to stay
-			// unadvertised, like in the case of a node only having
-			// private channels.
-			var remotePub [33]byte
-			copy(remotePub[:], channel.IdentityPub.SerializeCompressed())
-			isRemoteNodePublic, err := graph.IsPublicNode(remotePub)
-			if err != nil {
-				log.Errorf("Unable to determine if node %x "+
-					"is advertised: %v", remotePub, err)
-				continue
-			}
-
-			if !isRemoteNodePublic {
-				log.Debugf("Skipping channel %v due to "+
-					"counterparty %x being unadvertised",
-					chanPoint, remotePub)
-				continue
-			}
-
-			// Fetch the policies for each end of the channel.
-			chanID := channel.ShortChanID().ToUint64()
-			info, p1, p2, err := graph.FetchChannelEdgesByID(chanID)
-			if err != nil {
-				log.Errorf("Unable to fetch the routing "+
-					"policies for the edges of the channel "+
-					"%v: %v", chanPoint, err)
-				continue
-			}
-
-			// Now, we'll need to determine which is the correct
-			// policy for HTLCs being sent from the remote node.
-			var remotePolicy *channeldb.ChannelEdgePolicy
-			if bytes.Equal(remotePub[:], info.NodeKey1Bytes[:]) {
-				remotePolicy = p1
-			} else {
-				remotePolicy = p2
-			}
-
-			// If for some reason we don't yet have the edge for
-			// the remote party, then we'll just skip adding this
-			// channel as a routing hint.
-			if remotePolicy == nil {
-				continue
-			}
-
-			// Finally, create the routing hint for this channel and
-			// add it to our list of route hints.
-			hint := zpay32.HopHint{
-				NodeID:        channel.IdentityPub,
-				ChannelID:     chanID,
-				FeeBaseMAtoms: uint32(remotePolicy.FeeBaseMAtoms),
-				FeeProportionalMillionths: uint32(
-					remotePolicy.FeeProportionalMillionths,
-				),
-				CLTVExpiryDelta: remotePolicy.TimeLockDelta,
-			}
-
-			// Include the route hint in our set of options that
-			// will be used when creating the invoice.
-			routeHint := []zpay32.HopHint{hint}
-			options = append(options, zpay32.RouteHint(routeHint))
-
-			numHints++
+	// If signaled, generate a random payment address which the payer
+	// must include in the final hop payload. As only payers that know
+	// the invoice learn the address, this prevents intermediate nodes
+	// from probing for the invoice using its payment hash alone.
+	var paymentAddr [32]byte
+	if cfg.Features != nil &&
+		cfg.Features.HasFeature(lnwire.PaymentAddrOptional) {
+
+		if _, err := rand.Read(paymentAddr[:]); err != nil {
+			return nil, nil, err
 		}
 
+		options = append(options, zpay32.PaymentAddr(paymentAddr))
 	}
 
 	// Create and encode the payment request as a bech32 (zpay32) string.
@@ -400,7 +356,9 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 		Terms: channeldb.ContractTerm{
 			Value:           amtMAtoms,
 			PaymentPreimage: paymentPreimage,
+			PaymentAddr:     paymentAddr,
 		},
+		AMP: invoice.AMP,
 	}
 
 	log.Tracef("[addinvoice] adding new invoice %v",
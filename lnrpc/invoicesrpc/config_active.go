@@ -9,6 +9,7 @@ import (
 	"github.com/decred/dcrlnd/lnwire"
 	"github.com/decred/dcrlnd/macaroons"
 	"github.com/decred/dcrlnd/netann"
+	"github.com/decred/dcrlnd/routing/route"
 )
 
 // Config is the primary configuration struct for the invoices RPC server. It
@@ -32,6 +33,15 @@ type Config struct {
 	// IsChannelActive is used to generate valid hop hints.
 	IsChannelActive func(chanID lnwire.ChannelID) bool
 
+	// IsPeerOnline returns true if we're currently connected to the given
+	// peer. It is used to skip the hop hints through offline peers.
+	IsPeerOnline func(peer route.Vertex) bool
+
+	// PeerReliability returns the probability of a peer forwarding a
+	// payment of the given amount to us. It is used to rank the private
+	// channels eligible as hop hints.
+	PeerReliability func(peer route.Vertex, amt lnwire.MilliAtom) float64
+
 	// ChainParams are required to properly decode invoice payment requests
 	// that are marshalled over rpc.
 	ChainParams *chaincfg.Params
@@ -50,4 +60,11 @@ type Config struct {
 	// ChanDB is a global boltdb instance which is needed to access the
 	// channel graph.
 	ChanDB *channeldb.DB
+
+	// Features is the feature vector advertised in new invoices.
+	Features *lnwire.FeatureVector
+
+	// MinHopHints is the minimum number of valid hop hints private
+	// invoices must include, below which their creation fails.
+	MinHopHints uint32
 }
@@ -0,0 +1,296 @@
+package invoicesrpc
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/routing/route"
+	"github.com/decred/dcrlnd/zpay32"
+)
+
+const (
+	// DefaultMaxHopHints is the maximum number of automatically selected
+	// hop hints included in an invoice if the caller didn't specify one.
+	// We restrict the number of hints to avoid creating overly large
+	// invoices.
+	DefaultMaxHopHints = 20
+
+	// hopHintPolicyMaxAge is the age after which the routing policy of the
+	// remote party of a private channel is considered stale, so that the
+	// channel isn't automatically included as a hop hint. It matches the
+	// age after which the router prunes channels from the graph.
+	hopHintPolicyMaxAge = 14 * 24 * time.Hour
+)
+
+// hopHintCandidate is a private channel eligible to be included as a hop hint
+// in an invoice.
+type hopHintCandidate struct {
+	hint zpay32.HopHint
+
+	// score ranks the candidate against the other eligible channels. It
+	// is the remote balance of the channel weighed by the reliability of
+	// the peer, which approximates the amount we can expect to receive
+	// through the channel.
+	score float64
+}
+
+// selectHopHints returns the hop hints to include in the invoice. Any
+// channels explicitly requested by the caller are always included. If the
+// invoice is private, the remaining hints are selected among our other
+// private channels, preferring those with the most inbound liquidity towards
+// the most reliable peers, up to the maximum number of hints.
+func selectHopHints(cfg *AddInvoiceConfig, amtMAtoms lnwire.MilliAtom,
+	invoice *AddInvoiceData) ([]zpay32.HopHint, error) {
+
+	if !invoice.Private && len(invoice.HopHintChannels) == 0 {
+		return nil, nil
+	}
+
+	openChannels, err := cfg.ChanDB.FetchAllChannels()
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch all channels")
+	}
+
+	graph := cfg.ChanDB.ChannelGraph()
+
+	forcedChans := make(map[uint64]struct{}, len(invoice.HopHintChannels))
+	for _, chanID := range invoice.HopHintChannels {
+		forcedChans[chanID] = struct{}{}
+	}
+
+	var (
+		forcedHints []zpay32.HopHint
+		candidates  []hopHintCandidate
+	)
+	for _, channel := range openChannels {
+		// Since we're only interested in our private channels, we'll
+		// skip public ones.
+		isPublic := channel.ChannelFlags&lnwire.FFAnnounceChannel != 0
+		if isPublic {
+			continue
+		}
+
+		chanID := channel.ShortChanID().ToUint64()
+
+		// Channels requested by the caller bypass the eligibility
+		// checks below, as the caller explicitly chose to reveal them.
+		if _, ok := forcedChans[chanID]; ok {
+			hint, err := newHopHint(graph, channel)
+			if err != nil {
+				return nil, err
+			}
+
+			forcedHints = append(forcedHints, *hint)
+			delete(forcedChans, chanID)
+			continue
+		}
+
+		if !invoice.Private {
+			continue
+		}
+
+		if !isHopHintEligible(cfg, graph, channel, amtMAtoms) {
+			continue
+		}
+
+		hint, err := newHopHint(graph, channel)
+		if err != nil {
+			log.Debugf("Skipping channel %v: %v",
+				channel.FundingOutpoint, err)
+			continue
+		}
+
+		if err := checkHopHintPolicy(graph, channel); err != nil {
+			log.Debugf("Skipping channel %v: %v",
+				channel.FundingOutpoint, err)
+			continue
+		}
+
+		// Weigh the remote balance of the channel by the likelihood of
+		// the peer forwarding our payment, if known.
+		reliability := 1.0
+		if cfg.PeerReliability != nil {
+			var peer route.Vertex
+			copy(peer[:], channel.IdentityPub.SerializeCompressed())
+			reliability = cfg.PeerReliability(peer, amtMAtoms)
+		}
+		remoteBalance := channel.LocalCommitment.RemoteBalance
+
+		candidates = append(candidates, hopHintCandidate{
+			hint:  *hint,
+			score: reliability * float64(remoteBalance),
+		})
+	}
+
+	for chanID := range forcedChans {
+		return nil, fmt.Errorf("channel %v is not an open private "+
+			"channel", lnwire.NewShortChanIDFromInt(chanID))
+	}
+
+	maxHints := invoice.MaxHopHints
+	if maxHints == 0 {
+		maxHints = DefaultMaxHopHints
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].score > candidates[j].score
+	})
+
+	hints := forcedHints
+	for _, candidate := range candidates {
+		if len(hints) >= int(maxHints) {
+			break
+		}
+
+		hints = append(hints, candidate.hint)
+	}
+
+	// Private invoices that can't be reached through enough of our
+	// private channels are refused, rather than issued with hints that
+	// payers are unlikely to succeed with.
+	if invoice.Private && len(hints) < int(cfg.MinHopHints) {
+		return nil, fmt.Errorf("only %d valid hop hints found, %d "+
+			"required", len(hints), cfg.MinHopHints)
+	}
+
+	return hints, nil
+}
+
+// isHopHintEligible returns true if the private channel can be automatically
+// included as a hop hint for an invoice of the given amount.
+func isHopHintEligible(cfg *AddInvoiceConfig, graph *channeldb.ChannelGraph,
+	channel *channeldb.OpenChannel, amtMAtoms lnwire.MilliAtom) bool {
+
+	// Make sure the counterparty has enough balance in the channel for
+	// our amount. We do this in order to reduce payment errors when
+	// attempting to use this channel as a hint.
+	chanPoint := lnwire.NewChanIDFromOutPoint(&channel.FundingOutpoint)
+	if amtMAtoms >= channel.LocalCommitment.RemoteBalance {
+		log.Debugf("Skipping channel %v due to not having enough "+
+			"remote balance", chanPoint)
+		return false
+	}
+
+	// Make sure the peer is online, as it has to be in order to forward
+	// the payment to us.
+	var peer route.Vertex
+	copy(peer[:], channel.IdentityPub.SerializeCompressed())
+	if cfg.IsPeerOnline != nil && !cfg.IsPeerOnline(peer) {
+		log.Debugf("Skipping channel %v due to counterparty %v being "+
+			"offline", chanPoint, peer)
+		return false
+	}
+
+	// Make sure the channel is active.
+	if !cfg.IsChannelActive(chanPoint) {
+		log.Debugf("Skipping channel %v due to not being eligible to "+
+			"forward payments", chanPoint)
+		return false
+	}
+
+	// To ensure we don't leak unadvertised nodes, we'll make sure our
+	// counterparty is publicly advertised within the network. Otherwise,
+	// we'll end up leaking information about nodes that intend to stay
+	// unadvertised, like in the case of a node only having private
+	// channels.
+	var remotePub [33]byte
+	copy(remotePub[:], channel.IdentityPub.SerializeCompressed())
+	isRemoteNodePublic, err := graph.IsPublicNode(remotePub)
+	if err != nil {
+		log.Errorf("Unable to determine if node %x is advertised: %v",
+			remotePub, err)
+		return false
+	}
+
+	if !isRemoteNodePublic {
+		log.Debugf("Skipping channel %v due to counterparty %x being "+
+			"unadvertised", chanPoint, remotePub)
+		return false
+	}
+
+	return true
+}
+
+// checkHopHintPolicy checks the routing policy of the remote party of a
+// channel against the latest gossip, returning an error if the channel isn't
+// usable to reach us: either the remote party disabled it, or we haven't
+// received an update of its policy for too long.
+func checkHopHintPolicy(graph *channeldb.ChannelGraph,
+	channel *channeldb.OpenChannel) error {
+
+	remotePolicy, err := fetchRemotePolicy(graph, channel)
+	if err != nil {
+		return err
+	}
+
+	if remotePolicy.IsDisabled() {
+		return fmt.Errorf("channel disabled by the remote party")
+	}
+
+	age := time.Since(remotePolicy.LastUpdate)
+	if age > hopHintPolicyMaxAge {
+		return fmt.Errorf("routing policy of the remote party last "+
+			"updated %v ago", age)
+	}
+
+	return nil
+}
+
+// fetchRemotePolicy returns the routing policy of the remote party of a
+// channel, as found in the graph.
+func fetchRemotePolicy(graph *channeldb.ChannelGraph,
+	channel *channeldb.OpenChannel) (*channeldb.ChannelEdgePolicy, error) {
+
+	// Fetch the policies for each end of the channel.
+	chanID := channel.ShortChanID().ToUint64()
+	info, p1, p2, err := graph.FetchChannelEdgesByID(chanID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch the routing policies "+
+			"for the edges of the channel %v: %v",
+			channel.FundingOutpoint, err)
+	}
+
+	// Now, we'll need to determine which is the correct policy for HTLCs
+	// being sent from the remote node.
+	remotePub := channel.IdentityPub.SerializeCompressed()
+	var remotePolicy *channeldb.ChannelEdgePolicy
+	if bytes.Equal(remotePub, info.NodeKey1Bytes[:]) {
+		remotePolicy = p1
+	} else {
+		remotePolicy = p2
+	}
+
+	// If for some reason we don't yet have the edge for the remote party,
+	// then we can't create a routing hint for this channel.
+	if remotePolicy == nil {
+		return nil, fmt.Errorf("routing policy of the remote party "+
+			"of channel %v unknown", channel.FundingOutpoint)
+	}
+
+	return remotePolicy, nil
+}
+
+// newHopHint creates the hop hint for a channel from the routing policy of
+// the remote party.
+func newHopHint(graph *channeldb.ChannelGraph,
+	channel *channeldb.OpenChannel) (*zpay32.HopHint, error) {
+
+	remotePolicy, err := fetchRemotePolicy(graph, channel)
+	if err != nil {
+		return nil, err
+	}
+
+	return &zpay32.HopHint{
+		NodeID:        channel.IdentityPub,
+		ChannelID:     channel.ShortChanID().ToUint64(),
+		FeeBaseMAtoms: uint32(remotePolicy.FeeBaseMAtoms),
+		FeeProportionalMillionths: uint32(
+			remotePolicy.FeeProportionalMillionths,
+		),
+		CLTVExpiryDelta: remotePolicy.TimeLockDelta,
+	}, nil
+}
@@ -27,17 +27,25 @@ const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
 type CancelInvoiceMsg struct {
 	// / Hash corresponding to the (hold) invoice to cancel.
-	PaymentHash          []byte   `protobuf:"bytes,1,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	PaymentHash []byte `protobuf:"bytes,1,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
+	// *
+	// If set, only the accepted htlcs of the AMP payment set with this id are
+	// canceled.
+	SetId []byte `protobuf:"bytes,2,opt,name=set_id,proto3" json:"set_id,omitempty"`
+	// *
+	// If set, only these accepted htlcs are canceled. Cannot be combined with
+	// set_id.
+	Htlcs                []*CircuitKey `protobuf:"bytes,3,rep,name=htlcs,proto3" json:"htlcs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *CancelInvoiceMsg) Reset()         { *m = CancelInvoiceMsg{} }
 func (m *CancelInvoiceMsg) String() string { return proto.CompactTextString(m) }
 func (*CancelInvoiceMsg) ProtoMessage()    {}
 func (*CancelInvoiceMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_invoices_7d6b2f30140dcc84, []int{0}
+	return fileDescriptor_invoices_68df8ffec4bc544b, []int{0}
 }
 func (m *CancelInvoiceMsg) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CancelInvoiceMsg.Unmarshal(m, b)
@@ -64,6 +72,68 @@ func (m *CancelInvoiceMsg) GetPaymentHash() []byte {
 	return nil
 }
 
+func (m *CancelInvoiceMsg) GetSetId() []byte {
+	if m != nil {
+		return m.SetId
+	}
+	return nil
+}
+
+func (m *CancelInvoiceMsg) GetHtlcs() []*CircuitKey {
+	if m != nil {
+		return m.Htlcs
+	}
+	return nil
+}
+
+type CircuitKey struct {
+	// / The short channel id over which the htlc was received.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,proto3" json:"chan_id,omitempty"`
+	// / The index identifying the htlc on the channel.
+	HtlcIndex            uint64   `protobuf:"varint,2,opt,name=htlc_index,proto3" json:"htlc_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CircuitKey) Reset()         { *m = CircuitKey{} }
+func (m *CircuitKey) String() string { return proto.CompactTextString(m) }
+func (*CircuitKey) ProtoMessage()    {}
+func (*CircuitKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_invoices_68df8ffec4bc544b, []int{1}
+}
+func (m *CircuitKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CircuitKey.Unmarshal(m, b)
+}
+func (m *CircuitKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CircuitKey.Marshal(b, m, deterministic)
+}
+func (dst *CircuitKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitKey.Merge(dst, src)
+}
+func (m *CircuitKey) XXX_Size() int {
+	return xxx_messageInfo_CircuitKey.Size(m)
+}
+func (m *CircuitKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitKey proto.InternalMessageInfo
+
+func (m *CircuitKey) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *CircuitKey) GetHtlcIndex() uint64 {
+	if m != nil {
+		return m.HtlcIndex
+	}
+	return 0
+}
+
 type CancelInvoiceResp struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -74,7 +144,7 @@ func (m *CancelInvoiceResp) Reset()         { *m = CancelInvoiceResp{} }
 func (m *CancelInvoiceResp) String() string { return proto.CompactTextString(m) }
 func (*CancelInvoiceResp) ProtoMessage()    {}
 func (*CancelInvoiceResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_invoices_7d6b2f30140dcc84, []int{1}
+	return fileDescriptor_invoices_68df8ffec4bc544b, []int{2}
 }
 func (m *CancelInvoiceResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CancelInvoiceResp.Unmarshal(m, b)
@@ -121,7 +191,16 @@ type AddHoldInvoiceRequest struct {
 	// invoice's destination.
 	RouteHints []*lnrpc.RouteHint `protobuf:"bytes,8,rep,name=route_hints,proto3" json:"route_hints,omitempty"`
 	// / Whether this invoice should include routing hints for private channels.
-	Private              bool     `protobuf:"varint,9,opt,name=private,proto3" json:"private,omitempty"`
+	Private bool `protobuf:"varint,9,opt,name=private,proto3" json:"private,omitempty"`
+	// *
+	// The maximum number of routing hints for private channels to select when
+	// private is set. If zero, up to 20 hints are selected.
+	MaxHopHints uint32 `protobuf:"varint,10,opt,name=max_hop_hints,proto3" json:"max_hop_hints,omitempty"`
+	// *
+	// The short channel ids of private channels to always include as routing
+	// hints, even if private is not set. This allows revealing only specific
+	// private channels in the invoice.
+	HopHintChanIds       []uint64 `protobuf:"varint,11,rep,packed,name=hop_hint_chan_ids,proto3" json:"hop_hint_chan_ids,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -131,7 +210,7 @@ func (m *AddHoldInvoiceRequest) Reset()         { *m = AddHoldInvoiceRequest{} }
 func (m *AddHoldInvoiceRequest) String() string { return proto.CompactTextString(m) }
 func (*AddHoldInvoiceRequest) ProtoMessage()    {}
 func (*AddHoldInvoiceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_invoices_7d6b2f30140dcc84, []int{2}
+	return fileDescriptor_invoices_68df8ffec4bc544b, []int{3}
 }
 func (m *AddHoldInvoiceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddHoldInvoiceRequest.Unmarshal(m, b)
@@ -214,6 +293,20 @@ func (m *AddHoldInvoiceRequest) GetPrivate() bool {
 	return false
 }
 
+func (m *AddHoldInvoiceRequest) GetMaxHopHints() uint32 {
+	if m != nil {
+		return m.MaxHopHints
+	}
+	return 0
+}
+
+func (m *AddHoldInvoiceRequest) GetHopHintChanIds() []uint64 {
+	if m != nil {
+		return m.HopHintChanIds
+	}
+	return nil
+}
+
 type AddHoldInvoiceResp struct {
 	// *
 	// A bare-bones invoice for a payment within the Lightning Network.  With the
@@ -229,7 +322,7 @@ func (m *AddHoldInvoiceResp) Reset()         { *m = AddHoldInvoiceResp{} }
 func (m *AddHoldInvoiceResp) String() string { return proto.CompactTextString(m) }
 func (*AddHoldInvoiceResp) ProtoMessage()    {}
 func (*AddHoldInvoiceResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_invoices_7d6b2f30140dcc84, []int{3}
+	return fileDescriptor_invoices_68df8ffec4bc544b, []int{4}
 }
 func (m *AddHoldInvoiceResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddHoldInvoiceResp.Unmarshal(m, b)
@@ -268,7 +361,7 @@ func (m *SettleInvoiceMsg) Reset()         { *m = SettleInvoiceMsg{} }
 func (m *SettleInvoiceMsg) String() string { return proto.CompactTextString(m) }
 func (*SettleInvoiceMsg) ProtoMessage()    {}
 func (*SettleInvoiceMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_invoices_7d6b2f30140dcc84, []int{4}
+	return fileDescriptor_invoices_68df8ffec4bc544b, []int{5}
 }
 func (m *SettleInvoiceMsg) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SettleInvoiceMsg.Unmarshal(m, b)
@@ -305,7 +398,7 @@ func (m *SettleInvoiceResp) Reset()         { *m = SettleInvoiceResp{} }
 func (m *SettleInvoiceResp) String() string { return proto.CompactTextString(m) }
 func (*SettleInvoiceResp) ProtoMessage()    {}
 func (*SettleInvoiceResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_invoices_7d6b2f30140dcc84, []int{5}
+	return fileDescriptor_invoices_68df8ffec4bc544b, []int{6}
 }
 func (m *SettleInvoiceResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SettleInvoiceResp.Unmarshal(m, b)
@@ -327,7 +420,13 @@ var xxx_messageInfo_SettleInvoiceResp proto.InternalMessageInfo
 
 type SubscribeSingleInvoiceRequest struct {
 	// / Hash corresponding to the (hold) invoice to subscribe to.
-	RHash                []byte   `protobuf:"bytes,2,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
+	RHash []byte `protobuf:"bytes,2,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
+	// *
+	// If set, an update is also sent out whenever an htlc paying to the invoice
+	// is accepted, settled or canceled without changing the state of the
+	// invoice, such as when additional htlcs arrive for an accepted hold
+	// invoice. The htlc states can be followed through the htlcs of the invoice.
+	HtlcUpdates          bool     `protobuf:"varint,3,opt,name=htlc_updates,proto3" json:"htlc_updates,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -337,7 +436,7 @@ func (m *SubscribeSingleInvoiceRequest) Reset()         { *m = SubscribeSingleIn
 func (m *SubscribeSingleInvoiceRequest) String() string { return proto.CompactTextString(m) }
 func (*SubscribeSingleInvoiceRequest) ProtoMessage()    {}
 func (*SubscribeSingleInvoiceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_invoices_7d6b2f30140dcc84, []int{6}
+	return fileDescriptor_invoices_68df8ffec4bc544b, []int{7}
 }
 func (m *SubscribeSingleInvoiceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SubscribeSingleInvoiceRequest.Unmarshal(m, b)
@@ -364,14 +463,120 @@ func (m *SubscribeSingleInvoiceRequest) GetRHash() []byte {
 	return nil
 }
 
+func (m *SubscribeSingleInvoiceRequest) GetHtlcUpdates() bool {
+	if m != nil {
+		return m.HtlcUpdates
+	}
+	return false
+}
+
+type PreimageRequest struct {
+	// / The hash of the hold invoice for which an htlc was accepted.
+	PaymentHash []byte `protobuf:"bytes,1,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
+	// / The amount of the accepted htlc in milli-atoms.
+	AmtPaidMAtoms        int64    `protobuf:"varint,2,opt,name=amt_paid_m_atoms,proto3" json:"amt_paid_m_atoms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PreimageRequest) Reset()         { *m = PreimageRequest{} }
+func (m *PreimageRequest) String() string { return proto.CompactTextString(m) }
+func (*PreimageRequest) ProtoMessage()    {}
+func (*PreimageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_invoices_68df8ffec4bc544b, []int{8}
+}
+func (m *PreimageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PreimageRequest.Unmarshal(m, b)
+}
+func (m *PreimageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PreimageRequest.Marshal(b, m, deterministic)
+}
+func (dst *PreimageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreimageRequest.Merge(dst, src)
+}
+func (m *PreimageRequest) XXX_Size() int {
+	return xxx_messageInfo_PreimageRequest.Size(m)
+}
+func (m *PreimageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreimageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreimageRequest proto.InternalMessageInfo
+
+func (m *PreimageRequest) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
+}
+
+func (m *PreimageRequest) GetAmtPaidMAtoms() int64 {
+	if m != nil {
+		return m.AmtPaidMAtoms
+	}
+	return 0
+}
+
+type PreimageResolution struct {
+	// / The payment hash of the request that is being answered.
+	PaymentHash []byte `protobuf:"bytes,1,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
+	// / The preimage of the payment hash. Left empty if the preimage is unknown.
+	Preimage             []byte   `protobuf:"bytes,2,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PreimageResolution) Reset()         { *m = PreimageResolution{} }
+func (m *PreimageResolution) String() string { return proto.CompactTextString(m) }
+func (*PreimageResolution) ProtoMessage()    {}
+func (*PreimageResolution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_invoices_68df8ffec4bc544b, []int{9}
+}
+func (m *PreimageResolution) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PreimageResolution.Unmarshal(m, b)
+}
+func (m *PreimageResolution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PreimageResolution.Marshal(b, m, deterministic)
+}
+func (dst *PreimageResolution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreimageResolution.Merge(dst, src)
+}
+func (m *PreimageResolution) XXX_Size() int {
+	return xxx_messageInfo_PreimageResolution.Size(m)
+}
+func (m *PreimageResolution) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreimageResolution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreimageResolution proto.InternalMessageInfo
+
+func (m *PreimageResolution) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
+}
+
+func (m *PreimageResolution) GetPreimage() []byte {
+	if m != nil {
+		return m.Preimage
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*CancelInvoiceMsg)(nil), "invoicesrpc.CancelInvoiceMsg")
+	proto.RegisterType((*CircuitKey)(nil), "invoicesrpc.CircuitKey")
 	proto.RegisterType((*CancelInvoiceResp)(nil), "invoicesrpc.CancelInvoiceResp")
 	proto.RegisterType((*AddHoldInvoiceRequest)(nil), "invoicesrpc.AddHoldInvoiceRequest")
 	proto.RegisterType((*AddHoldInvoiceResp)(nil), "invoicesrpc.AddHoldInvoiceResp")
 	proto.RegisterType((*SettleInvoiceMsg)(nil), "invoicesrpc.SettleInvoiceMsg")
 	proto.RegisterType((*SettleInvoiceResp)(nil), "invoicesrpc.SettleInvoiceResp")
 	proto.RegisterType((*SubscribeSingleInvoiceRequest)(nil), "invoicesrpc.SubscribeSingleInvoiceRequest")
+	proto.RegisterType((*PreimageRequest)(nil), "invoicesrpc.PreimageRequest")
+	proto.RegisterType((*PreimageResolution)(nil), "invoicesrpc.PreimageResolution")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -389,12 +594,15 @@ type InvoicesClient interface {
 	// *
 	// SubscribeSingleInvoice returns a uni-directional stream (server -> client)
 	// to notify the client of state transitions of the specified invoice.
-	// Initially the current invoice state is always sent out.
+	// Initially the current invoice state is always sent out. Optionally, the
+	// client is notified of the state transitions of the individual htlcs paying
+	// to the invoice as well.
 	SubscribeSingleInvoice(ctx context.Context, in *SubscribeSingleInvoiceRequest, opts ...grpc.CallOption) (Invoices_SubscribeSingleInvoiceClient, error)
 	// *
 	// CancelInvoice cancels a currently open invoice. If the invoice is already
 	// canceled, this call will succeed. If the invoice is already settled, it will
-	// fail.
+	// fail. If a set id or htlcs are specified, only the matching accepted htlcs
+	// are canceled and the invoice itself remains open.
 	CancelInvoice(ctx context.Context, in *CancelInvoiceMsg, opts ...grpc.CallOption) (*CancelInvoiceResp, error)
 	// *
 	// AddHoldInvoice creates a hold invoice. It ties the invoice to the hash
@@ -404,6 +612,14 @@ type InvoicesClient interface {
 	// SettleInvoice settles an accepted invoice. If the invoice is already
 	// settled, this call will succeed.
 	SettleInvoice(ctx context.Context, in *SettleInvoiceMsg, opts ...grpc.CallOption) (*SettleInvoiceResp, error)
+	// *
+	// RegisterPreimageResolver registers the caller as an external preimage
+	// store. Each time an htlc paying to a hold invoice is accepted, a
+	// PreimageRequest is sent over the stream. The caller is expected to answer
+	// with a PreimageResolution, after which the invoice is settled. Requests
+	// that are not answered in time leave the htlc held. Only a single resolver
+	// can be registered at a time.
+	RegisterPreimageResolver(ctx context.Context, opts ...grpc.CallOption) (Invoices_RegisterPreimageResolverClient, error)
 }
 
 type invoicesClient struct {
@@ -473,17 +689,51 @@ func (c *invoicesClient) SettleInvoice(ctx context.Context, in *SettleInvoiceMsg
 	return out, nil
 }
 
+func (c *invoicesClient) RegisterPreimageResolver(ctx context.Context, opts ...grpc.CallOption) (Invoices_RegisterPreimageResolverClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Invoices_serviceDesc.Streams[1], "/invoicesrpc.Invoices/RegisterPreimageResolver", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &invoicesRegisterPreimageResolverClient{stream}
+	return x, nil
+}
+
+type Invoices_RegisterPreimageResolverClient interface {
+	Send(*PreimageResolution) error
+	Recv() (*PreimageRequest, error)
+	grpc.ClientStream
+}
+
+type invoicesRegisterPreimageResolverClient struct {
+	grpc.ClientStream
+}
+
+func (x *invoicesRegisterPreimageResolverClient) Send(m *PreimageResolution) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *invoicesRegisterPreimageResolverClient) Recv() (*PreimageRequest, error) {
+	m := new(PreimageRequest)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // InvoicesServer is the server API for Invoices service.
 type InvoicesServer interface {
 	// *
 	// SubscribeSingleInvoice returns a uni-directional stream (server -> client)
 	// to notify the client of state transitions of the specified invoice.
-	// Initially the current invoice state is always sent out.
+	// Initially the current invoice state is always sent out. Optionally, the
+	// client is notified of the state transitions of the individual htlcs paying
+	// to the invoice as well.
 	SubscribeSingleInvoice(*SubscribeSingleInvoiceRequest, Invoices_SubscribeSingleInvoiceServer) error
 	// *
 	// CancelInvoice cancels a currently open invoice. If the invoice is already
 	// canceled, this call will succeed. If the invoice is already settled, it will
-	// fail.
+	// fail. If a set id or htlcs are specified, only the matching accepted htlcs
+	// are canceled and the invoice itself remains open.
 	CancelInvoice(context.Context, *CancelInvoiceMsg) (*CancelInvoiceResp, error)
 	// *
 	// AddHoldInvoice creates a hold invoice. It ties the invoice to the hash
@@ -493,6 +743,14 @@ type InvoicesServer interface {
 	// SettleInvoice settles an accepted invoice. If the invoice is already
 	// settled, this call will succeed.
 	SettleInvoice(context.Context, *SettleInvoiceMsg) (*SettleInvoiceResp, error)
+	// *
+	// RegisterPreimageResolver registers the caller as an external preimage
+	// store. Each time an htlc paying to a hold invoice is accepted, a
+	// PreimageRequest is sent over the stream. The caller is expected to answer
+	// with a PreimageResolution, after which the invoice is settled. Requests
+	// that are not answered in time leave the htlc held. Only a single resolver
+	// can be registered at a time.
+	RegisterPreimageResolver(Invoices_RegisterPreimageResolverServer) error
 }
 
 func RegisterInvoicesServer(s *grpc.Server, srv InvoicesServer) {
@@ -574,6 +832,32 @@ func _Invoices_SettleInvoice_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Invoices_RegisterPreimageResolver_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(InvoicesServer).RegisterPreimageResolver(&invoicesRegisterPreimageResolverServer{stream})
+}
+
+type Invoices_RegisterPreimageResolverServer interface {
+	Send(*PreimageRequest) error
+	Recv() (*PreimageResolution, error)
+	grpc.ServerStream
+}
+
+type invoicesRegisterPreimageResolverServer struct {
+	grpc.ServerStream
+}
+
+func (x *invoicesRegisterPreimageResolverServer) Send(m *PreimageRequest) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *invoicesRegisterPreimageResolverServer) Recv() (*PreimageResolution, error) {
+	m := new(PreimageResolution)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 var _Invoices_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "invoicesrpc.Invoices",
 	HandlerType: (*InvoicesServer)(nil),
@@ -597,46 +881,64 @@ var _Invoices_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Invoices_SubscribeSingleInvoice_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "RegisterPreimageResolver",
+			Handler:       _Invoices_RegisterPreimageResolver_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "invoicesrpc/invoices.proto",
 }
 
 func init() {
-	proto.RegisterFile("invoicesrpc/invoices.proto", fileDescriptor_invoices_7d6b2f30140dcc84)
-}
-
-var fileDescriptor_invoices_7d6b2f30140dcc84 = []byte{
-	// 502 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xc1, 0x8e, 0xd3, 0x30,
-	0x10, 0x55, 0xda, 0x6e, 0xb7, 0x9d, 0xee, 0x2e, 0xc5, 0x40, 0x15, 0x45, 0x2c, 0x84, 0x88, 0x43,
-	0x54, 0xa1, 0x04, 0x15, 0x71, 0x04, 0x09, 0xb8, 0x14, 0x24, 0x38, 0xa4, 0x82, 0x03, 0x97, 0xca,
-	0x4d, 0x4c, 0x6a, 0xe1, 0xda, 0xc6, 0x76, 0x2b, 0xf6, 0xab, 0xf8, 0x1e, 0xfe, 0x06, 0xc5, 0x71,
-	0x57, 0x49, 0x76, 0xd9, 0xdb, 0xcc, 0x9b, 0x99, 0x97, 0xc9, 0x7b, 0x63, 0x08, 0x28, 0x3f, 0x08,
-	0x9a, 0x13, 0xad, 0x64, 0x9e, 0x1e, 0xe3, 0x44, 0x2a, 0x61, 0x04, 0x9a, 0x34, 0x6a, 0xc1, 0xe3,
-	0x52, 0x88, 0x92, 0x91, 0x14, 0x4b, 0x9a, 0x62, 0xce, 0x85, 0xc1, 0x86, 0x0a, 0xee, 0x5a, 0x83,
-	0xb1, 0x92, 0x79, 0x1d, 0x46, 0xaf, 0x61, 0xfa, 0x01, 0xf3, 0x9c, 0xb0, 0x8f, 0xf5, 0xf4, 0x67,
-	0x5d, 0xa2, 0x67, 0x70, 0x26, 0xf1, 0xd5, 0x8e, 0x70, 0xb3, 0xde, 0x62, 0xbd, 0xf5, 0xbd, 0xd0,
-	0x8b, 0xcf, 0xb2, 0x89, 0xc3, 0x96, 0x58, 0x6f, 0xa3, 0x07, 0x70, 0xbf, 0x35, 0x96, 0x11, 0x2d,
-	0xa3, 0x3f, 0x3d, 0x78, 0xf4, 0xae, 0x28, 0x96, 0x82, 0x15, 0xd7, 0xf0, 0xaf, 0x3d, 0xd1, 0x06,
-	0x21, 0x18, 0xec, 0xc8, 0x4e, 0x58, 0xa6, 0x71, 0x66, 0xe3, 0x0a, 0xb3, 0xec, 0x3d, 0xcb, 0x6e,
-	0x63, 0xf4, 0x10, 0x4e, 0x0e, 0x98, 0xed, 0x89, 0xdf, 0x0f, 0xbd, 0xb8, 0x9f, 0xd5, 0x09, 0x9a,
-	0xc3, 0xb4, 0x20, 0x3a, 0x57, 0x54, 0x56, 0x3f, 0x51, 0xef, 0x34, 0xb0, 0x53, 0x37, 0x70, 0x34,
-	0x83, 0x21, 0xf9, 0x2d, 0xa9, 0xba, 0xf2, 0x4f, 0x2c, 0x85, 0xcb, 0xd0, 0x73, 0x38, 0xff, 0x81,
-	0x19, 0xdb, 0xe0, 0xfc, 0xe7, 0x1a, 0x17, 0x85, 0xf2, 0x87, 0x76, 0x95, 0x36, 0x88, 0x42, 0x98,
-	0xe4, 0xcc, 0x1c, 0xd6, 0x8e, 0xe2, 0x34, 0xf4, 0xe2, 0x41, 0xd6, 0x84, 0xd0, 0x02, 0x26, 0x4a,
-	0xec, 0x0d, 0x59, 0x6f, 0x29, 0x37, 0xda, 0x1f, 0x85, 0xfd, 0x78, 0xb2, 0x98, 0x26, 0x8c, 0x57,
-	0x92, 0x66, 0x55, 0x65, 0x49, 0xb9, 0xc9, 0x9a, 0x4d, 0xc8, 0x87, 0x53, 0xa9, 0xe8, 0x01, 0x1b,
-	0xe2, 0x8f, 0x43, 0x2f, 0x1e, 0x65, 0xc7, 0x34, 0x7a, 0x0b, 0xa8, 0x2b, 0x98, 0x96, 0x28, 0x86,
-	0x7b, 0x47, 0xfd, 0x55, 0x2d, 0xa0, 0x13, 0xae, 0x0b, 0x47, 0x09, 0x4c, 0x57, 0xc4, 0x18, 0x46,
-	0x1a, 0xee, 0x05, 0x30, 0x92, 0x8a, 0xd0, 0x1d, 0x2e, 0x89, 0x73, 0xee, 0x3a, 0xaf, 0x6c, 0x6b,
-	0xf5, 0x5b, 0xdb, 0xde, 0xc0, 0xe5, 0x6a, 0xbf, 0xa9, 0x74, 0xdc, 0x90, 0x15, 0xe5, 0x65, 0xa3,
-	0x5a, 0xbb, 0x37, 0x83, 0xa1, 0x5a, 0x37, 0xbc, 0x72, 0xd9, 0xa7, 0xc1, 0xc8, 0x9b, 0xf6, 0x16,
-	0x7f, 0x7b, 0x30, 0x72, 0x03, 0x1a, 0x7d, 0x83, 0xd9, 0xed, 0x5c, 0x68, 0x9e, 0x34, 0xee, 0x33,
-	0xb9, 0xf3, 0x83, 0xc1, 0x85, 0xd3, 0xd3, 0xc1, 0x2f, 0x3d, 0xf4, 0x05, 0xce, 0x5b, 0xf7, 0x86,
-	0x2e, 0x5b, 0x74, 0xdd, 0x13, 0x0e, 0x9e, 0xfc, 0xbf, 0x6c, 0x25, 0xfe, 0x0a, 0x17, 0x6d, 0xe1,
-	0x51, 0xd4, 0x9a, 0xb8, 0xf5, 0x8c, 0x83, 0xa7, 0x77, 0xf6, 0x68, 0x59, 0xad, 0xd9, 0xd2, 0xb7,
-	0xb3, 0x66, 0xd7, 0xab, 0xce, 0x9a, 0x37, 0xac, 0x79, 0xff, 0xe2, 0xfb, 0xbc, 0xa4, 0x66, 0xbb,
-	0xdf, 0x24, 0xb9, 0xd8, 0xa5, 0x05, 0xc9, 0x15, 0x29, 0xd2, 0x22, 0x57, 0x8c, 0x17, 0xa9, 0x95,
-	0x28, 0x6d, 0xcc, 0x6f, 0x86, 0xf6, 0x49, 0xbf, 0xfa, 0x17, 0x00, 0x00, 0xff, 0xff, 0xdd, 0x41,
-	0x53, 0x9a, 0x26, 0x04, 0x00, 0x00,
+	proto.RegisterFile("invoicesrpc/invoices.proto", fileDescriptor_invoices_68df8ffec4bc544b)
+}
+
+var fileDescriptor_invoices_68df8ffec4bc544b = []byte{
+	// 700 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x5d, 0x6f, 0xd3, 0x3c,
+	0x14, 0x56, 0x96, 0xae, 0xeb, 0x4e, 0xf7, 0xd1, 0xf9, 0x7d, 0xdf, 0xbd, 0x51, 0xb4, 0x8d, 0x12,
+	0x71, 0x11, 0x4d, 0xa3, 0x9d, 0xc6, 0x3d, 0x12, 0x4c, 0x42, 0x03, 0x04, 0x42, 0x1e, 0x70, 0x81,
+	0x84, 0x22, 0x37, 0x31, 0x89, 0x45, 0x3e, 0x8c, 0xed, 0x54, 0x9b, 0xc4, 0x1d, 0x7f, 0x8a, 0x9f,
+	0x87, 0xec, 0xa4, 0x5b, 0xdc, 0x6e, 0x13, 0x77, 0x3e, 0xcf, 0x39, 0x3e, 0x1f, 0xcf, 0x79, 0x9c,
+	0x80, 0xcf, 0xca, 0x79, 0xc5, 0x62, 0x2a, 0x05, 0x8f, 0xa7, 0x8b, 0xf3, 0x84, 0x8b, 0x4a, 0x55,
+	0x68, 0xd8, 0xf1, 0xf9, 0x07, 0x69, 0x55, 0xa5, 0x39, 0x9d, 0x12, 0xce, 0xa6, 0xa4, 0x2c, 0x2b,
+	0x45, 0x14, 0xab, 0xca, 0x36, 0xd4, 0xdf, 0x14, 0x3c, 0x6e, 0x8e, 0xc1, 0x4f, 0x18, 0x9d, 0x93,
+	0x32, 0xa6, 0xf9, 0xeb, 0xe6, 0xf6, 0x3b, 0x99, 0xa2, 0xc7, 0xb0, 0xc5, 0xc9, 0x75, 0x41, 0x4b,
+	0x15, 0x65, 0x44, 0x66, 0x9e, 0x33, 0x76, 0xc2, 0x2d, 0x3c, 0x6c, 0xb1, 0x0b, 0x22, 0x33, 0xb4,
+	0x0f, 0x7d, 0x49, 0x55, 0xc4, 0x12, 0x6f, 0xcd, 0x38, 0x5b, 0x0b, 0x3d, 0x85, 0xf5, 0x4c, 0xe5,
+	0xb1, 0xf4, 0xdc, 0xb1, 0x1b, 0x0e, 0xcf, 0xfe, 0x9f, 0x74, 0x9a, 0x9a, 0x9c, 0x33, 0x11, 0xd7,
+	0x4c, 0xbd, 0xa5, 0xd7, 0xb8, 0x89, 0x0a, 0x5e, 0x01, 0xdc, 0x82, 0xc8, 0x83, 0x8d, 0x38, 0x23,
+	0xa5, 0xce, 0xaa, 0x4b, 0xf6, 0xf0, 0xc2, 0x44, 0x47, 0x00, 0xfa, 0x42, 0xc4, 0xca, 0x84, 0x5e,
+	0x99, 0x92, 0x3d, 0xdc, 0x41, 0x82, 0x7f, 0x60, 0xcf, 0x9a, 0x02, 0x53, 0xc9, 0x83, 0x5f, 0x2e,
+	0xfc, 0xf7, 0x22, 0x49, 0x2e, 0xaa, 0x3c, 0xb9, 0x81, 0x7f, 0xd4, 0x54, 0x2a, 0x84, 0xa0, 0x57,
+	0xd0, 0xa2, 0x32, 0x55, 0x36, 0xb1, 0x39, 0x6b, 0xcc, 0x0c, 0xdb, 0xcc, 0x63, 0xce, 0xe8, 0x5f,
+	0x58, 0x9f, 0x93, 0xbc, 0xa6, 0x9e, 0x3b, 0x76, 0x42, 0x17, 0x37, 0x06, 0x3a, 0x86, 0x51, 0x42,
+	0x65, 0x2c, 0x18, 0xd7, 0x9c, 0x36, 0x14, 0xf5, 0xcc, 0xad, 0x15, 0x5c, 0xf3, 0x44, 0xaf, 0x38,
+	0x13, 0xd7, 0xde, 0xba, 0x49, 0xd1, 0x5a, 0xe8, 0x09, 0x6c, 0x7f, 0x23, 0x79, 0x3e, 0x23, 0xf1,
+	0xf7, 0x88, 0x24, 0x89, 0xf0, 0xfa, 0xa6, 0x15, 0x1b, 0x44, 0x63, 0x18, 0xc6, 0xb9, 0x9a, 0x47,
+	0x6d, 0x8a, 0x0d, 0x33, 0x77, 0x17, 0x42, 0x67, 0x30, 0x14, 0x55, 0xad, 0x68, 0x94, 0xb1, 0x52,
+	0x49, 0x6f, 0x60, 0x58, 0x1f, 0x4d, 0xf2, 0x52, 0xf3, 0x8d, 0xb5, 0xe7, 0x82, 0x95, 0x0a, 0x77,
+	0x83, 0x34, 0xcd, 0x5c, 0xb0, 0x39, 0x51, 0xd4, 0xdb, 0x1c, 0x3b, 0xe1, 0x00, 0x2f, 0x4c, 0xdd,
+	0x55, 0x41, 0xae, 0xa2, 0xac, 0xe2, 0x6d, 0x3e, 0x18, 0x3b, 0xe1, 0x36, 0xb6, 0x41, 0x74, 0x02,
+	0x7b, 0x0b, 0x23, 0x6a, 0x17, 0x24, 0xbd, 0xe1, 0xd8, 0x0d, 0x7b, 0x78, 0xd5, 0x11, 0x3c, 0x07,
+	0xb4, 0xbc, 0x04, 0xc9, 0x51, 0x08, 0xbb, 0x0b, 0x89, 0x89, 0x66, 0x29, 0xed, 0x32, 0x96, 0xe1,
+	0x60, 0x02, 0xa3, 0x4b, 0xaa, 0x54, 0x4e, 0x3b, 0x02, 0xf5, 0x61, 0xc0, 0x05, 0x65, 0x05, 0x49,
+	0x69, 0x2b, 0xce, 0x1b, 0x5b, 0x4b, 0xc1, 0x8a, 0x37, 0x52, 0x20, 0x70, 0x78, 0x59, 0xcf, 0xf4,
+	0x6e, 0x66, 0xf4, 0x92, 0x95, 0x69, 0xc7, 0xdb, 0x28, 0x62, 0x1f, 0xfa, 0x22, 0xea, 0xec, 0xbf,
+	0xb5, 0x50, 0x00, 0x5b, 0x46, 0x66, 0x35, 0x4f, 0x88, 0xa2, 0xd2, 0x08, 0x61, 0x80, 0x2d, 0xec,
+	0x4d, 0x6f, 0xe0, 0x8c, 0xd6, 0x02, 0x02, 0xbb, 0x1f, 0xda, 0x1e, 0x16, 0x49, 0x83, 0x3b, 0xdf,
+	0x91, 0x85, 0x69, 0x31, 0x91, 0x42, 0x45, 0x9c, 0xb0, 0x24, 0x2a, 0x22, 0xa2, 0xaa, 0x42, 0x9a,
+	0x16, 0x5c, 0xbc, 0x82, 0x07, 0x1f, 0x01, 0xdd, 0x96, 0x90, 0x55, 0x5e, 0x6b, 0x9d, 0xfd, 0x55,
+	0x95, 0x2e, 0x61, 0x6b, 0x36, 0x61, 0x67, 0xbf, 0x5d, 0x18, 0xb4, 0x6c, 0x48, 0xf4, 0x19, 0xf6,
+	0xef, 0x26, 0x0a, 0x1d, 0x5b, 0x4f, 0xf9, 0x41, 0x36, 0xfd, 0x9d, 0x56, 0x80, 0x2d, 0x7c, 0xea,
+	0xa0, 0xf7, 0xb0, 0x6d, 0x3d, 0x50, 0x74, 0x68, 0x7f, 0x19, 0x96, 0x3e, 0x41, 0xfe, 0xd1, 0xfd,
+	0x6e, 0xa3, 0x9f, 0x4f, 0xb0, 0x63, 0xab, 0x0a, 0x05, 0xd6, 0x8d, 0x3b, 0xdf, 0xbd, 0xff, 0xe8,
+	0xc1, 0x18, 0xc9, 0x75, 0x9b, 0x96, 0x78, 0x96, 0xda, 0x5c, 0x16, 0xa2, 0x7f, 0x74, 0xbf, 0xdb,
+	0xe4, 0xfb, 0x0a, 0x1e, 0xa6, 0x29, 0x93, 0x8a, 0x0a, 0x6b, 0x73, 0x73, 0x2a, 0x90, 0xdd, 0xcc,
+	0xea, 0x62, 0xfd, 0x83, 0x7b, 0x02, 0xcc, 0x2c, 0xa1, 0x73, 0xea, 0xbc, 0x3c, 0xf9, 0x72, 0x9c,
+	0x32, 0x95, 0xd5, 0xb3, 0x49, 0x5c, 0x15, 0xd3, 0x84, 0xc6, 0x82, 0x26, 0xd3, 0x24, 0x16, 0x79,
+	0x99, 0x4c, 0xf3, 0xb2, 0xfb, 0x8f, 0x10, 0x3c, 0x9e, 0xf5, 0xcd, 0x17, 0xff, 0xd9, 0x9f, 0x00,
+	0x00, 0x00, 0xff, 0xff, 0x12, 0x0e, 0x8f, 0x45, 0x45, 0x06, 0x00, 0x00,
 }
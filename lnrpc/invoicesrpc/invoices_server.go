@@ -4,6 +4,9 @@ package invoicesrpc
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -13,8 +16,10 @@ import (
 
 	"github.com/decred/dcrd/dcrutil/v2"
 	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/invoices"
 	"github.com/decred/dcrlnd/lnrpc"
 	"github.com/decred/dcrlnd/lntypes"
+	"github.com/decred/dcrlnd/lnwire"
 )
 
 const (
@@ -57,6 +62,10 @@ var (
 			Entity: "invoices",
 			Action: "write",
 		}},
+		"/invoicesrpc.Invoices/RegisterPreimageResolver": {{
+			Entity: "invoices",
+			Action: "write",
+		}},
 	}
 
 	// DefaultInvoicesMacFilename is the default name of the invoices
@@ -175,7 +184,9 @@ func (s *Server) SubscribeSingleInvoice(req *SubscribeSingleInvoiceRequest,
 		return err
 	}
 
-	invoiceClient, err := s.cfg.InvoiceRegistry.SubscribeSingleInvoice(hash)
+	invoiceClient, err := s.cfg.InvoiceRegistry.SubscribeSingleInvoice(
+		hash, req.HtlcUpdates,
+	)
 	if err != nil {
 		return err
 	}
@@ -221,7 +232,8 @@ func (s *Server) SettleInvoice(ctx context.Context,
 
 // CancelInvoice cancels a currently open invoice. If the invoice is already
 // canceled, this call will succeed. If the invoice is already settled, it will
-// fail.
+// fail. If a set id or htlcs are specified, only the matching accepted htlcs
+// are canceled.
 func (s *Server) CancelInvoice(ctx context.Context,
 	in *CancelInvoiceMsg) (*CancelInvoiceResp, error) {
 
@@ -230,12 +242,56 @@ func (s *Server) CancelInvoice(ctx context.Context,
 		return nil, err
 	}
 
-	err = s.cfg.InvoiceRegistry.CancelInvoice(paymentHash)
-	if err != nil {
-		return nil, err
-	}
+	switch {
+	case len(in.SetId) > 0 && len(in.Htlcs) > 0:
+		return nil, errors.New("set_id and htlcs cannot both be set")
+
+	case len(in.SetId) > 0:
+		if len(in.SetId) != 32 {
+			return nil, fmt.Errorf("set id must be exactly 32 "+
+				"bytes, is instead %v", len(in.SetId))
+		}
+
+		var setID [32]byte
+		copy(setID[:], in.SetId)
+
+		err := s.cfg.InvoiceRegistry.CancelHtlcSet(paymentHash, setID)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Infof("Canceled htlc set %x of invoice %v", setID[:],
+			paymentHash)
+
+	case len(in.Htlcs) > 0:
+		circuitKeys := make([]channeldb.CircuitKey, 0, len(in.Htlcs))
+		for _, htlc := range in.Htlcs {
+			circuitKeys = append(circuitKeys, channeldb.CircuitKey{
+				ChanID: lnwire.NewShortChanIDFromInt(
+					htlc.ChanId,
+				),
+				HtlcID: htlc.HtlcIndex,
+			})
+		}
+
+		err := s.cfg.InvoiceRegistry.CancelHtlcs(
+			paymentHash, circuitKeys,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Infof("Canceled %v htlc(s) of invoice %v",
+			len(circuitKeys), paymentHash)
+
+	default:
+		err = s.cfg.InvoiceRegistry.CancelInvoice(paymentHash)
+		if err != nil {
+			return nil, err
+		}
 
-	log.Infof("Canceled invoice %v", paymentHash)
+		log.Infof("Canceled invoice %v", paymentHash)
+	}
 
 	return &CancelInvoiceResp{}, nil
 }
@@ -249,11 +305,15 @@ func (s *Server) AddHoldInvoice(ctx context.Context,
 	addInvoiceCfg := &AddInvoiceConfig{
 		AddInvoice:        s.cfg.InvoiceRegistry.AddInvoice,
 		IsChannelActive:   s.cfg.IsChannelActive,
+		IsPeerOnline:      s.cfg.IsPeerOnline,
+		PeerReliability:   s.cfg.PeerReliability,
 		ChainParams:       s.cfg.ChainParams,
 		NodeSigner:        s.cfg.NodeSigner,
 		MaxPaymentMAtoms:  s.cfg.MaxPaymentMAtoms,
 		DefaultCLTVExpiry: s.cfg.DefaultCLTVExpiry,
 		ChanDB:            s.cfg.ChanDB,
+		Features:          s.cfg.Features,
+		MinHopHints:       s.cfg.MinHopHints,
 	}
 
 	hash, err := lntypes.MakeHash(invoice.Hash)
@@ -270,6 +330,8 @@ func (s *Server) AddHoldInvoice(ctx context.Context,
 		FallbackAddr:    invoice.FallbackAddr,
 		CltvExpiry:      invoice.CltvExpiry,
 		Private:         invoice.Private,
+		MaxHopHints:     invoice.MaxHopHints,
+		HopHintChannels: invoice.HopHintChanIds,
 	}
 
 	_, dbInvoice, err := AddInvoice(ctx, addInvoiceCfg, addInvoiceData)
@@ -281,3 +343,50 @@ func (s *Server) AddHoldInvoice(ctx context.Context,
 		PaymentRequest: string(dbInvoice.PaymentRequest),
 	}, nil
 }
+
+// RegisterPreimageResolver registers the caller as an external preimage store.
+// Preimage requests for accepted hold invoice htlcs are sent over the stream
+// until either side closes it.
+func (s *Server) RegisterPreimageResolver(
+	stream Invoices_RegisterPreimageResolverServer) error {
+
+	resolver := newStreamResolver(stream)
+	err := s.cfg.InvoiceRegistry.RegisterPreimageResolver(
+		resolver, invoices.DefaultPreimageResolverTimeout,
+	)
+	if err != nil {
+		return err
+	}
+	defer s.cfg.InvoiceRegistry.UnregisterPreimageResolver(resolver)
+	defer close(resolver.quit)
+
+	log.Infof("External preimage resolver registered")
+
+	// We'll read the resolutions sent by the external service in a
+	// goroutine, so we can also exit in case the server shuts down.
+	errChan := make(chan error, 1)
+	go func() {
+		for {
+			resp, err := stream.Recv()
+			if err != nil {
+				errChan <- err
+				return
+			}
+
+			resolver.deliver(resp)
+		}
+	}()
+
+	select {
+	case err := <-errChan:
+		log.Infof("External preimage resolver disconnected: %v", err)
+
+		if err == io.EOF {
+			return nil
+		}
+		return err
+
+	case <-s.quit:
+		return nil
+	}
+}
@@ -0,0 +1,119 @@
+// +build invoicesrpc
+
+package invoicesrpc
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/decred/dcrlnd/invoices"
+	"github.com/decred/dcrlnd/lntypes"
+)
+
+// errResolverExited is returned when a preimage is requested from a resolver
+// whose stream was already closed.
+var errResolverExited = errors.New("preimage resolver exited")
+
+// streamResolver is an implementation of the invoices.PreimageResolver
+// interface that forwards preimage requests to an external service connected
+// over a RegisterPreimageResolver stream.
+type streamResolver struct {
+	stream Invoices_RegisterPreimageResolverServer
+
+	// sendMtx serializes the requests sent over the stream, as concurrent
+	// sends aren't supported by grpc.
+	sendMtx sync.Mutex
+
+	// pending maps the payment hash of each outstanding request to the
+	// channel its resolution should be delivered on.
+	pending    map[lntypes.Hash]chan *PreimageResolution
+	pendingMtx sync.Mutex
+
+	quit chan struct{}
+}
+
+// A compile time check to ensure streamResolver implements the
+// invoices.PreimageResolver interface.
+var _ invoices.PreimageResolver = (*streamResolver)(nil)
+
+// newStreamResolver creates a new preimage resolver backed by the passed
+// stream.
+func newStreamResolver(
+	stream Invoices_RegisterPreimageResolverServer) *streamResolver {
+
+	return &streamResolver{
+		stream:  stream,
+		pending: make(map[lntypes.Hash]chan *PreimageResolution),
+		quit:    make(chan struct{}),
+	}
+}
+
+// ResolvePreimage sends a preimage request over the stream, and waits for the
+// external service to respond.
+//
+// NOTE: This is part of the invoices.PreimageResolver interface.
+func (r *streamResolver) ResolvePreimage(req *invoices.PreimageRequest,
+	quit <-chan struct{}) (lntypes.Preimage, error) {
+
+	respChan := make(chan *PreimageResolution, 1)
+
+	r.pendingMtx.Lock()
+	r.pending[req.PaymentHash] = respChan
+	r.pendingMtx.Unlock()
+
+	defer func() {
+		r.pendingMtx.Lock()
+		delete(r.pending, req.PaymentHash)
+		r.pendingMtx.Unlock()
+	}()
+
+	r.sendMtx.Lock()
+	err := r.stream.Send(&PreimageRequest{
+		PaymentHash:   req.PaymentHash[:],
+		AmtPaidMAtoms: int64(req.AmtPaid),
+	})
+	r.sendMtx.Unlock()
+	if err != nil {
+		return lntypes.Preimage{}, err
+	}
+
+	select {
+	case resp := <-respChan:
+		if len(resp.Preimage) == 0 {
+			return lntypes.Preimage{}, invoices.ErrPreimageUnknown
+		}
+
+		return lntypes.MakePreimage(resp.Preimage)
+
+	case <-quit:
+		return lntypes.Preimage{}, invoices.ErrPreimageResolverTimeout
+
+	case <-r.quit:
+		return lntypes.Preimage{}, errResolverExited
+	}
+}
+
+// deliver hands the passed resolution to the matching outstanding request.
+// Resolutions that don't match any request are ignored.
+func (r *streamResolver) deliver(resp *PreimageResolution) {
+	hash, err := lntypes.MakeHash(resp.PaymentHash)
+	if err != nil {
+		log.Warnf("Invalid payment hash in preimage resolution: %v",
+			err)
+		return
+	}
+
+	r.pendingMtx.Lock()
+	respChan, ok := r.pending[hash]
+	r.pendingMtx.Unlock()
+	if !ok {
+		log.Debugf("Ignoring preimage resolution for unknown "+
+			"request: %v", hash)
+		return
+	}
+
+	select {
+	case respChan <- resp:
+	default:
+	}
+}
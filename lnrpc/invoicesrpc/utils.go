@@ -3,10 +3,12 @@ package invoicesrpc
 import (
 	"encoding/hex"
 	"fmt"
+	"sort"
 
 	"github.com/decred/dcrd/chaincfg/v2"
 	"github.com/decred/dcrlnd/channeldb"
 	"github.com/decred/dcrlnd/lnrpc"
+	"github.com/decred/dcrlnd/lnwire"
 	"github.com/decred/dcrlnd/zpay32"
 )
 
@@ -14,21 +16,38 @@ import (
 func CreateRPCInvoice(invoice *channeldb.Invoice,
 	activeNetParams *chaincfg.Params) (*lnrpc.Invoice, error) {
 
+	var (
+		descHash     []byte
+		fallbackAddr string
+		routeHints   []*lnrpc.RouteHint
+		rHash        []byte
+	)
+
+	// Invoices that were created for keysend payments don't have a
+	// payment request, but always know their preimage.
 	paymentRequest := string(invoice.PaymentRequest)
-	decoded, err := zpay32.Decode(paymentRequest, activeNetParams)
-	if err != nil {
-		return nil, fmt.Errorf("unable to decode payment request: %v",
-			err)
-	}
+	if paymentRequest == "" {
+		hash := invoice.Terms.PaymentPreimage.Hash()
+		rHash = hash[:]
+	} else {
+		decoded, err := zpay32.Decode(paymentRequest, activeNetParams)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode payment "+
+				"request: %v", err)
+		}
 
-	var descHash []byte
-	if decoded.DescriptionHash != nil {
-		descHash = decoded.DescriptionHash[:]
-	}
+		if decoded.DescriptionHash != nil {
+			descHash = decoded.DescriptionHash[:]
+		}
+
+		if decoded.FallbackAddr != nil {
+			fallbackAddr = decoded.FallbackAddr.String()
+		}
+
+		// Convert between the `lnrpc` and `routing` types.
+		routeHints = CreateRPCRouteHints(decoded.RouteHints)
 
-	fallbackAddr := ""
-	if decoded.FallbackAddr != nil {
-		fallbackAddr = decoded.FallbackAddr.String()
+		rHash = decoded.PaymentHash[:]
 	}
 
 	settleDate := int64(0)
@@ -36,9 +55,6 @@ func CreateRPCInvoice(invoice *channeldb.Invoice,
 		settleDate = invoice.SettleDate.Unix()
 	}
 
-	// Convert between the `lnrpc` and `routing` types.
-	routeHints := CreateRPCRouteHints(decoded.RouteHints)
-
 	preimage := invoice.Terms.PaymentPreimage
 	atomsAmt := invoice.Terms.Value.ToAtoms()
 	atomsAmtPaid := invoice.AmtPaid.ToAtoms()
@@ -89,13 +105,19 @@ func CreateRPCInvoice(invoice *channeldb.Invoice,
 			rpcHtlc.ResolveTime = htlc.ResolveTime.Unix()
 		}
 
+		if htlc.AMP != nil {
+			setID := htlc.AMP.SetID()
+			rpcHtlc.AmpSetId = setID[:]
+			rpcHtlc.AmpChildIndex = htlc.AMP.ChildIndex()
+		}
+
 		rpcHtlcs = append(rpcHtlcs, &rpcHtlc)
 	}
 
 	rpcInvoice := &lnrpc.Invoice{
 		Memo:            string(invoice.Memo[:]),
 		Receipt:         invoice.Receipt[:],
-		RHash:           decoded.PaymentHash[:],
+		RHash:           rHash,
 		Value:           int64(atomsAmt),
 		CreationDate:    invoice.CreationDate.Unix(),
 		SettleDate:      settleDate,
@@ -114,12 +136,42 @@ func CreateRPCInvoice(invoice *channeldb.Invoice,
 		AmtPaid:         int64(invoice.AmtPaid),
 		State:           state,
 		Htlcs:           rpcHtlcs,
+		Amp:             invoice.AMP,
+	}
+
+	for _, setID := range invoice.AMPSetIDs() {
+		setID := setID
+		htlcSet := invoice.HTLCSet(setID)
+
+		ampSet := &lnrpc.AMPInvoiceSet{
+			SetId:    setID[:],
+			NumHtlcs: uint32(len(htlcSet)),
+		}
+		for _, htlc := range htlcSet {
+			if htlc.State != channeldb.HtlcStateSettled {
+				continue
+			}
+
+			ampSet.AmtPaidMAtoms += uint64(htlc.Amt)
+
+			settleDate := htlc.ResolveTime.Unix()
+			if ampSet.SettleDate == 0 || settleDate < ampSet.SettleDate {
+				ampSet.SettleDate = settleDate
+			}
+		}
+
+		rpcInvoice.AmpSets = append(rpcInvoice.AmpSets, ampSet)
 	}
 
 	if preimage != channeldb.UnknownPreimage {
 		rpcInvoice.RPreimage = preimage[:]
 	}
 
+	if invoice.Terms.PaymentAddr != channeldb.BlankPayAddr {
+		payAddr := invoice.Terms.PaymentAddr
+		rpcInvoice.PaymentAddr = payAddr[:]
+	}
+
 	return rpcInvoice, nil
 }
 
@@ -152,3 +204,30 @@ func CreateRPCRouteHints(routeHints [][]zpay32.HopHint) []*lnrpc.RouteHint {
 
 	return res
 }
+
+// CreateRPCFeatures takes in the decoded form of an invoice's feature bits and
+// converts them into the lnrpc type, ordered by feature bit.
+func CreateRPCFeatures(fv *lnwire.FeatureVector) []*lnrpc.Feature {
+	if fv == nil {
+		return nil
+	}
+
+	bits := make([]lnwire.FeatureBit, 0, len(fv.Features()))
+	for bit := range fv.Features() {
+		bits = append(bits, bit)
+	}
+	sort.Slice(bits, func(i, j int) bool { return bits[i] < bits[j] })
+
+	res := make([]*lnrpc.Feature, 0, len(bits))
+	for _, bit := range bits {
+		name, known := zpay32.InvoiceFeatures[bit]
+		res = append(res, &lnrpc.Feature{
+			Bit:        uint32(bit),
+			Name:       name,
+			IsRequired: bit.IsRequired(),
+			IsKnown:    known,
+		})
+	}
+
+	return res
+}
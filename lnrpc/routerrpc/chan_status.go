@@ -0,0 +1,82 @@
+// +build routerrpc
+
+package routerrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/lnrpc"
+)
+
+// UpdateChanStatus manually sets the status of a channel in the channel
+// updates we send out, or gives it back to the automatic handling.
+func (s *Server) UpdateChanStatus(ctx context.Context,
+	req *UpdateChanStatusRequest) (*UpdateChanStatusResponse, error) {
+
+	if req.ChanPoint == nil {
+		return nil, errors.New("chan_point must be specified")
+	}
+	outpoint, err := unmarshallChanPoint(req.ChanPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	var setStatus func(wire.OutPoint) error
+	switch req.Action {
+	case ChanStatusAction_ENABLE:
+		setStatus = s.cfg.SetChannelEnabled
+
+	case ChanStatusAction_DISABLE:
+		setStatus = s.cfg.SetChannelDisabled
+
+	case ChanStatusAction_AUTO:
+		setStatus = s.cfg.SetChannelAuto
+
+	default:
+		return nil, fmt.Errorf("unknown channel status action: %v",
+			req.Action)
+	}
+
+	log.Infof("Setting status of channel %v to %v", outpoint, req.Action)
+
+	if err := setStatus(*outpoint); err != nil {
+		return nil, err
+	}
+
+	return &UpdateChanStatusResponse{}, nil
+}
+
+// unmarshallChanPoint returns the outpoint of an rpc channel point, whose
+// funding txid can be given either as bytes or as a string.
+func unmarshallChanPoint(chanPoint *lnrpc.ChannelPoint) (*wire.OutPoint,
+	error) {
+
+	var (
+		txid *chainhash.Hash
+		err  error
+	)
+	switch chanPoint.GetFundingTxid().(type) {
+	case *lnrpc.ChannelPoint_FundingTxidBytes:
+		txid, err = chainhash.NewHash(chanPoint.GetFundingTxidBytes())
+
+	case *lnrpc.ChannelPoint_FundingTxidStr:
+		txid, err = chainhash.NewHashFromStr(
+			chanPoint.GetFundingTxidStr(),
+		)
+
+	default:
+		err = errors.New("funding txid must be specified")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	outpoint := wire.NewOutPoint(
+		txid, chanPoint.OutputIndex, wire.TxTreeRegular,
+	)
+	return outpoint, nil
+}
@@ -0,0 +1,86 @@
+// +build routerrpc
+
+package routerrpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/decred/dcrlnd/htlcswitch"
+	"github.com/decred/dcrlnd/lnwire"
+)
+
+// LookupCircuit returns the circuit the switch holds for the htlc identified
+// by its incoming channel and htlc index, along with its state.
+func (s *Server) LookupCircuit(ctx context.Context,
+	req *LookupCircuitRequest) (*LookupCircuitResponse, error) {
+
+	inKey := htlcswitch.CircuitKey{
+		ChanID: lnwire.NewShortChanIDFromInt(req.ChanId),
+		HtlcID: req.HtlcId,
+	}
+
+	circuit, state, err := s.cfg.Switch.LookupCircuit(inKey)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcState, err := marshallCircuitState(state)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &LookupCircuitResponse{
+		IncomingChanId:    circuit.Incoming.ChanID.ToUint64(),
+		IncomingHtlcId:    circuit.Incoming.HtlcID,
+		PaymentHash:       circuit.PaymentHash[:],
+		IncomingAmtMAtoms: int64(circuit.IncomingAmount),
+		OutgoingAmtMAtoms: int64(circuit.OutgoingAmount),
+		State:             rpcState,
+	}
+	if circuit.Outgoing != nil {
+		resp.OutgoingChanId = circuit.Outgoing.ChanID.ToUint64()
+		resp.OutgoingHtlcId = circuit.Outgoing.HtlcID
+	}
+
+	return resp, nil
+}
+
+// FailCircuit fails back the htlc of a circuit to its incoming channel,
+// regardless of the state of the outgoing htlc.
+func (s *Server) FailCircuit(ctx context.Context,
+	req *FailCircuitRequest) (*FailCircuitResponse, error) {
+
+	inKey := htlcswitch.CircuitKey{
+		ChanID: lnwire.NewShortChanIDFromInt(req.ChanId),
+		HtlcID: req.HtlcId,
+	}
+
+	log.Warnf("Manually failing circuit %v", inKey)
+
+	if _, err := s.cfg.Switch.ForceFailCircuit(inKey); err != nil {
+		return nil, err
+	}
+
+	return &FailCircuitResponse{}, nil
+}
+
+// marshallCircuitState converts the state of a switch circuit to its rpc
+// counterpart.
+func marshallCircuitState(state htlcswitch.CircuitState) (CircuitState,
+	error) {
+
+	switch state {
+	case htlcswitch.CircuitPending:
+		return CircuitState_PENDING, nil
+
+	case htlcswitch.CircuitOpen:
+		return CircuitState_OPEN, nil
+
+	case htlcswitch.CircuitClosing:
+		return CircuitState_CLOSING, nil
+
+	default:
+		return 0, fmt.Errorf("unknown circuit state: %v", state)
+	}
+}
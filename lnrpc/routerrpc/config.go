@@ -28,4 +28,53 @@ type RoutingConfig struct {
 	// MaxMcHistory defines the maximum number of payment results that
 	// are held on disk by mission control.
 	MaxMcHistory int `long:"maxmchistory" description:"the maximum number of payment results that are held on disk by mission control"`
+
+	// MaxMcHistoryAge defines the maximum age of the payment results that
+	// are held on disk by mission control.
+	MaxMcHistoryAge time.Duration `long:"maxmchistoryage" description:"the maximum age of the payment results that are held on disk by mission control, older results are pruned (0 to disable)"`
+
+	// MaxMcPairResults defines the maximum number of payment results held
+	// on disk by mission control for each node pair.
+	MaxMcPairResults int `long:"maxmcpairresults" description:"the maximum number of payment results that are held on disk by mission control for each node pair, results superseded for all the pairs of their route are pruned (0 to disable)"`
+
+	// FailureRelaxInterval defines after how much time a failure is no
+	// longer taken into account by mission control.
+	FailureRelaxInterval time.Duration `long:"failurerelaxinterval" description:"the duration after which a penalized node or channel is back at its a priori probability, regardless of the penalty half life (0 to disable)"`
+
+	// SuccessRelaxInterval defines after how much time a success is no
+	// longer taken into account by mission control.
+	SuccessRelaxInterval time.Duration `long:"successrelaxinterval" description:"the duration after which a successful payment attempt through a node pair no longer raises its probability (0 to disable)"`
+
+	// PathFindingWorkers is the number of route queries that are processed
+	// concurrently.
+	PathFindingWorkers int `long:"pathfindingworkers" description:"the number of route queries that are processed concurrently"`
+
+	// FeeLimitSchedule is the schedule of the fee limits applied to
+	// payments that don't specify one, as a list of buckets of the form
+	// max_amt_atoms:percent.
+	FeeLimitSchedule []string `long:"feelimitbucket" description:"a bucket of the fee limit schedule applied to payments that don't specify a fee limit, of the form max_amt_atoms:percent; payments larger than every bucket use the percentage of the largest one (can be specified multiple times)"`
+
+	// ShadowRouteMinDelta is the minimum number of blocks the final CLTV
+	// delta of the payments requesting a shadow route is extended by.
+	ShadowRouteMinDelta uint16 `long:"shadowroutemindelta" description:"the minimum number of blocks the final cltv delta of the payments requesting a shadow route is extended by"`
+
+	// ShadowRouteMaxDelta is the maximum number of blocks the final CLTV
+	// delta of the payments requesting a shadow route is extended by.
+	ShadowRouteMaxDelta uint16 `long:"shadowroutemaxdelta" description:"the maximum number of blocks the final cltv delta of the payments requesting a shadow route is extended by, within the cltv limit of the payment"`
+
+	// EdgeScoreBudget is the latency budget of a single path finding
+	// query for consulting an external edge score provider.
+	EdgeScoreBudget time.Duration `long:"edgescorebudget" description:"the maximum time a path finding query spends consulting an external edge score provider, edges not scored within it aren't penalized"`
+
+	// EdgeScoreCacheTTL is the amount of time the scores returned by an
+	// external edge score provider are cached for.
+	EdgeScoreCacheTTL time.Duration `long:"edgescorecachettl" description:"the duration the scores returned by an external edge score provider are cached for"`
+
+	// MaxInFlightPerDest is the maximum total amount of our own payments
+	// that may be in flight toward a single destination at once.
+	MaxInFlightPerDest dcrutil.Amount `long:"maxinflightperdest" description:"the maximum total amount in atoms of our own payments that may be in flight toward a single destination at once, further payments to it are rejected until previous ones resolve (0 to disable)"`
+
+	// UnsyncedGraphPayments determines what happens to the payments sent
+	// before the initial historical sync of the graph completes.
+	UnsyncedGraphPayments string `long:"unsyncedgraphpayments" description:"what happens to the payments sent before the initial sync of the graph completes, unless they request otherwise: send them anyway, refuse them, or queue them until the graph is synced" choice:"send" choice:"refuse" choice:"queue"`
 }
@@ -3,6 +3,9 @@
 package routerrpc
 
 import (
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/htlcswitch"
 	"github.com/decred/dcrlnd/macaroons"
 	"github.com/decred/dcrlnd/routing"
 )
@@ -39,6 +42,30 @@ type Config struct {
 	// RouterBackend contains shared logic between this sub server and the
 	// main rpc server.
 	RouterBackend *RouterBackend
+
+	// Graph is the channel graph that XImportGraph imports nodes and
+	// channels into.
+	Graph *channeldb.ChannelGraph
+
+	// HtlcNotifier is the notifier of the events happening to the HTLCs
+	// handled by the switch, streamed by SubscribeHtlcEvents.
+	HtlcNotifier *htlcswitch.HtlcNotifier
+
+	// Switch is the htlc switch whose circuits are inspected and failed
+	// by LookupCircuit and FailCircuit.
+	Switch *htlcswitch.Switch
+
+	// SetChannelEnabled manually enables a channel in our channel updates,
+	// overriding the automatic disabling of inactive channels.
+	SetChannelEnabled func(wire.OutPoint) error
+
+	// SetChannelDisabled manually disables a channel in our channel
+	// updates, overriding the automatic enabling of active channels.
+	SetChannelDisabled func(wire.OutPoint) error
+
+	// SetChannelAuto gives the status of a channel in our channel updates
+	// back to the automatic handling.
+	SetChannelAuto func(wire.OutPoint) error
 }
 
 // DefaultConfig defines the config defaults.
@@ -49,7 +76,23 @@ func DefaultConfig() *Config {
 		PenaltyHalfLife:       routing.DefaultPenaltyHalfLife,
 		AttemptCost: routing.DefaultPaymentAttemptPenalty.
 			ToAtoms(),
-		MaxMcHistory: routing.DefaultMaxMcHistory,
+		MaxMcHistory:       routing.DefaultMaxMcHistory,
+		MaxMcHistoryAge:    routing.DefaultMaxMcHistoryAge,
+		PathFindingWorkers: routing.DefaultPathFindingWorkers,
+		FeeLimitSchedule:   DefaultFeeLimitSchedule,
+		ShadowRouteMinDelta: routing.
+			DefaultShadowRouteMinDelta,
+		ShadowRouteMaxDelta: routing.
+			DefaultShadowRouteMaxDelta,
+		MaxMcPairResults: routing.DefaultMaxMcPairResults,
+		FailureRelaxInterval: routing.
+			DefaultFailureRelaxInterval,
+		SuccessRelaxInterval: routing.
+			DefaultSuccessRelaxInterval,
+		EdgeScoreBudget: routing.DefaultEdgeScoreBudget,
+		EdgeScoreCacheTTL: routing.
+			DefaultEdgeScoreCacheTTL,
+		UnsyncedGraphPayments: "send",
 	}
 
 	return &Config{
@@ -65,5 +108,17 @@ func GetRoutingConfig(cfg *Config) *RoutingConfig {
 		AttemptCost:           cfg.AttemptCost,
 		PenaltyHalfLife:       cfg.PenaltyHalfLife,
 		MaxMcHistory:          cfg.MaxMcHistory,
+		MaxMcHistoryAge:       cfg.MaxMcHistoryAge,
+		PathFindingWorkers:    cfg.PathFindingWorkers,
+		FeeLimitSchedule:      cfg.FeeLimitSchedule,
+		ShadowRouteMinDelta:   cfg.ShadowRouteMinDelta,
+		ShadowRouteMaxDelta:   cfg.ShadowRouteMaxDelta,
+		MaxMcPairResults:      cfg.MaxMcPairResults,
+		FailureRelaxInterval:  cfg.FailureRelaxInterval,
+		SuccessRelaxInterval:  cfg.SuccessRelaxInterval,
+		EdgeScoreBudget:       cfg.EdgeScoreBudget,
+		EdgeScoreCacheTTL:     cfg.EdgeScoreCacheTTL,
+		MaxInFlightPerDest:    cfg.MaxInFlightPerDest,
+		UnsyncedGraphPayments: cfg.UnsyncedGraphPayments,
 	}
 }
@@ -21,7 +21,23 @@ func GetRoutingConfig(cfg *Config) *RoutingConfig {
 		MinRouteProbability:   routing.DefaultMinRouteProbability,
 		AttemptCost: routing.DefaultPaymentAttemptPenalty.
 			ToAtoms(),
-		PenaltyHalfLife: routing.DefaultPenaltyHalfLife,
-		MaxMcHistory:    routing.DefaultMaxMcHistory,
+		PenaltyHalfLife:    routing.DefaultPenaltyHalfLife,
+		MaxMcHistory:       routing.DefaultMaxMcHistory,
+		MaxMcHistoryAge:    routing.DefaultMaxMcHistoryAge,
+		PathFindingWorkers: routing.DefaultPathFindingWorkers,
+		FeeLimitSchedule:   DefaultFeeLimitSchedule,
+		ShadowRouteMinDelta: routing.
+			DefaultShadowRouteMinDelta,
+		ShadowRouteMaxDelta: routing.
+			DefaultShadowRouteMaxDelta,
+		MaxMcPairResults: routing.DefaultMaxMcPairResults,
+		FailureRelaxInterval: routing.
+			DefaultFailureRelaxInterval,
+		SuccessRelaxInterval: routing.
+			DefaultSuccessRelaxInterval,
+		EdgeScoreBudget: routing.DefaultEdgeScoreBudget,
+		EdgeScoreCacheTTL: routing.
+			DefaultEdgeScoreCacheTTL,
+		UnsyncedGraphPayments: "send",
 	}
 }
@@ -0,0 +1,160 @@
+// +build routerrpc
+
+package routerrpc
+
+import (
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/decred/dcrlnd/routing"
+)
+
+// errScorerExited is returned when an edge score is requested from a provider
+// whose stream was already closed.
+var errScorerExited = errors.New("edge score provider exited")
+
+// streamScorer is an implementation of the routing.EdgeScoreProvider
+// interface that forwards edge score requests to an external service connected
+// over a RegisterEdgeScorer stream.
+type streamScorer struct {
+	stream Router_RegisterEdgeScorerServer
+
+	// sendMtx serializes the requests sent over the stream, as concurrent
+	// sends aren't supported by grpc.
+	sendMtx sync.Mutex
+
+	// pending maps the identifier of each outstanding request to the
+	// channel its response should be delivered on.
+	pending    map[uint64]chan *EdgeScoreResponse
+	nextID     uint64
+	pendingMtx sync.Mutex
+
+	quit chan struct{}
+}
+
+// A compile time check to ensure streamScorer implements the
+// routing.EdgeScoreProvider interface.
+var _ routing.EdgeScoreProvider = (*streamScorer)(nil)
+
+// newStreamScorer creates a new edge score provider backed by the passed
+// stream.
+func newStreamScorer(stream Router_RegisterEdgeScorerServer) *streamScorer {
+	return &streamScorer{
+		stream:  stream,
+		pending: make(map[uint64]chan *EdgeScoreResponse),
+		quit:    make(chan struct{}),
+	}
+}
+
+// ScoreEdge sends an edge score request over the stream, and waits for the
+// external service to respond.
+//
+// NOTE: This is part of the routing.EdgeScoreProvider interface.
+func (s *streamScorer) ScoreEdge(req *routing.EdgeScoreRequest,
+	quit <-chan struct{}) (int64, error) {
+
+	respChan := make(chan *EdgeScoreResponse, 1)
+
+	s.pendingMtx.Lock()
+	id := s.nextID
+	s.nextID++
+	s.pending[id] = respChan
+	s.pendingMtx.Unlock()
+
+	defer func() {
+		s.pendingMtx.Lock()
+		delete(s.pending, id)
+		s.pendingMtx.Unlock()
+	}()
+
+	s.sendMtx.Lock()
+	err := s.stream.Send(&EdgeScoreRequest{
+		RequestId: id,
+		FromNode:  req.FromNode[:],
+		ToNode:    req.ToNode[:],
+		ChanId:    req.ChannelID,
+		AmtMAtoms: int64(req.Amount),
+	})
+	s.sendMtx.Unlock()
+	if err != nil {
+		return 0, err
+	}
+
+	select {
+	case resp := <-respChan:
+		return resp.PenaltyMAtoms, nil
+
+	case <-quit:
+		return 0, routing.ErrEdgeScoreTimeout
+
+	case <-s.quit:
+		return 0, errScorerExited
+	}
+}
+
+// deliver hands the passed response to the matching outstanding request.
+// Responses that don't match any request, such as those arriving after the
+// latency budget of their query, are ignored.
+func (s *streamScorer) deliver(resp *EdgeScoreResponse) {
+	s.pendingMtx.Lock()
+	respChan, ok := s.pending[resp.RequestId]
+	s.pendingMtx.Unlock()
+	if !ok {
+		log.Debugf("Ignoring edge score response for unknown "+
+			"request: %v", resp.RequestId)
+		return
+	}
+
+	select {
+	case respChan <- resp:
+	default:
+	}
+}
+
+// RegisterEdgeScorer registers the caller as an external edge score provider.
+// Edge score requests are sent over the stream during path finding until
+// either side closes it.
+func (s *Server) RegisterEdgeScorer(
+	stream Router_RegisterEdgeScorerServer) error {
+
+	scorer := newStreamScorer(stream)
+	err := s.cfg.RouterBackend.EdgeScorer.RegisterProvider(
+		scorer, s.cfg.EdgeScoreBudget, s.cfg.EdgeScoreCacheTTL,
+	)
+	if err != nil {
+		return err
+	}
+	defer s.cfg.RouterBackend.EdgeScorer.UnregisterProvider(scorer)
+	defer close(scorer.quit)
+
+	log.Infof("External edge score provider registered")
+
+	// We'll read the responses sent by the external service in a
+	// goroutine, so we can also exit in case the stream is canceled.
+	errChan := make(chan error, 1)
+	go func() {
+		for {
+			resp, err := stream.Recv()
+			if err != nil {
+				errChan <- err
+				return
+			}
+
+			scorer.deliver(resp)
+		}
+	}()
+
+	select {
+	case err := <-errChan:
+		log.Infof("External edge score provider disconnected: %v", err)
+
+		if err == io.EOF {
+			return nil
+		}
+		return err
+
+	case <-stream.Context().Done():
+		return stream.Context().Err()
+	}
+}
@@ -0,0 +1,102 @@
+package routerrpc
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrlnd/lnwire"
+)
+
+// DefaultFeeLimitSchedule is the default schedule of the fee limits applied to
+// payments that don't specify one. Small payments may pay relatively higher
+// fees, as the fees of their routes are dominated by the base fees of the
+// channels.
+var DefaultFeeLimitSchedule = []string{
+	"1000:100", "100000:10", "10000000:5", "1000000000:1",
+}
+
+// FeeLimitBucket is a bucket of a fee limit schedule. Payments of an amount up
+// to the maximum amount of the bucket may pay fees up to the percentage of the
+// bucket.
+type FeeLimitBucket struct {
+	// MaxAmount is the largest payment amount the bucket applies to.
+	MaxAmount lnwire.MilliAtom
+
+	// Percent is the percentage of the payment amount that may be paid as
+	// fees.
+	Percent uint64
+}
+
+// FeeLimitSchedule is a schedule of the fee limits applied to payments that
+// don't specify one, based on their amount. The buckets are sorted by
+// increasing maximum amount.
+type FeeLimitSchedule []FeeLimitBucket
+
+// ParseFeeLimitSchedule parses a fee limit schedule from its buckets, passed
+// in the form max_amt_atoms:percent.
+func ParseFeeLimitSchedule(buckets []string) (FeeLimitSchedule, error) {
+	schedule := make(FeeLimitSchedule, 0, len(buckets))
+	for _, bucket := range buckets {
+		parts := strings.Split(bucket, ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid fee limit bucket %q, "+
+				"must be of the form max_amt_atoms:percent",
+				bucket)
+		}
+
+		maxAmt, err := strconv.ParseInt(parts[0], 10, 64)
+		if err != nil || maxAmt <= 0 {
+			return nil, fmt.Errorf("invalid maximum amount of fee "+
+				"limit bucket %q", bucket)
+		}
+
+		percent, err := strconv.ParseUint(parts[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid percentage of fee "+
+				"limit bucket %q", bucket)
+		}
+
+		schedule = append(schedule, FeeLimitBucket{
+			MaxAmount: lnwire.NewMAtomsFromAtoms(
+				dcrutil.Amount(maxAmt),
+			),
+			Percent: percent,
+		})
+	}
+
+	sort.Slice(schedule, func(i, j int) bool {
+		return schedule[i].MaxAmount < schedule[j].MaxAmount
+	})
+
+	for i := 1; i < len(schedule); i++ {
+		if schedule[i].MaxAmount == schedule[i-1].MaxAmount {
+			return nil, fmt.Errorf("duplicate fee limit bucket "+
+				"for amount %v", schedule[i].MaxAmount)
+		}
+	}
+
+	return schedule, nil
+}
+
+// FeeLimit returns the fee limit of a payment of the passed amount, according
+// to the bucket the amount falls in. Amounts larger than the maximum amount of
+// every bucket use the percentage of the last bucket. If the schedule is empty,
+// the payment amount itself is used as the limit.
+func (s FeeLimitSchedule) FeeLimit(amount lnwire.MilliAtom) lnwire.MilliAtom {
+	if len(s) == 0 {
+		return amount
+	}
+
+	percent := s[len(s)-1].Percent
+	for _, bucket := range s {
+		if amount <= bucket.MaxAmount {
+			percent = bucket.Percent
+			break
+		}
+	}
+
+	return amount * lnwire.MilliAtom(percent) / 100
+}
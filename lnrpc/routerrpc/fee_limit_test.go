@@ -0,0 +1,73 @@
+package routerrpc
+
+import (
+	"testing"
+
+	"github.com/decred/dcrlnd/lnrpc"
+	"github.com/decred/dcrlnd/lnwire"
+)
+
+// TestFeeLimitSchedule asserts that the default fee limit of a payment is taken
+// from the bucket of the schedule its amount falls in.
+func TestFeeLimitSchedule(t *testing.T) {
+	t.Parallel()
+
+	schedule, err := ParseFeeLimitSchedule(
+		[]string{"100000:10", "1000:100", "10000000:5"},
+	)
+	if err != nil {
+		t.Fatalf("unable to parse schedule: %v", err)
+	}
+
+	backend := &RouterBackend{
+		FeeLimitSchedule: schedule,
+	}
+
+	testCases := []struct {
+		amount   lnwire.MilliAtom
+		feeLimit lnwire.MilliAtom
+	}{
+		{amount: 1000, feeLimit: 1000},
+		{amount: 1000000, feeLimit: 1000000},
+		{amount: 1000001, feeLimit: 100000},
+		{amount: 100000000, feeLimit: 10000000},
+		{amount: 1000000000, feeLimit: 50000000},
+		{amount: 100000000000, feeLimit: 5000000000},
+	}
+
+	for _, testCase := range testCases {
+		feeLimit := backend.CalculateFeeLimit(nil, testCase.amount)
+		if feeLimit != testCase.feeLimit {
+			t.Fatalf("expected fee limit %v for amount %v, got %v",
+				testCase.feeLimit, testCase.amount, feeLimit)
+		}
+	}
+
+	// An explicit fee limit takes precedence over the schedule.
+	feeLimit := backend.CalculateFeeLimit(&lnrpc.FeeLimit{
+		Limit: &lnrpc.FeeLimit_Percent{Percent: 1},
+	}, 1000)
+	if feeLimit != 10 {
+		t.Fatalf("expected fee limit 10, got %v", feeLimit)
+	}
+
+	// Without a schedule, the payment amount is the limit.
+	backend.FeeLimitSchedule = nil
+	if feeLimit := backend.CalculateFeeLimit(nil, 1000); feeLimit != 1000 {
+		t.Fatalf("expected fee limit 1000, got %v", feeLimit)
+	}
+
+	// Malformed and duplicate buckets are rejected.
+	invalidSchedules := [][]string{
+		{"1000"},
+		{"0:10"},
+		{"abc:10"},
+		{"1000:-1"},
+		{"1000:10", "1000:5"},
+	}
+	for _, invalid := range invalidSchedules {
+		if _, err := ParseFeeLimitSchedule(invalid); err == nil {
+			t.Fatalf("expected failure for schedule %v", invalid)
+		}
+	}
+}
@@ -0,0 +1,228 @@
+// +build routerrpc
+
+package routerrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/lnrpc"
+	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/routing"
+	"github.com/decred/dcrlnd/routing/route"
+)
+
+// errImportGraphNetwork is returned when a graph import is requested on a
+// network other than simnet and regnet.
+var errImportGraphNetwork = errors.New("graph imports are only allowed on " +
+	"simnet and regnet")
+
+// XImportGraph imports a channel graph, in the format returned by
+// DescribeGraph, directly into the graph database. No signature or funding
+// output is validated, which is why it is only allowed on simulation
+// networks.
+func (s *Server) XImportGraph(ctx context.Context,
+	graph *lnrpc.ChannelGraph) (*XImportGraphResponse, error) {
+
+	netParams := s.cfg.RouterBackend.ActiveNetParams
+	if netParams.Net != wire.SimNet && netParams.Net != wire.RegNet {
+		return nil, errImportGraphNetwork
+	}
+
+	log.Infof("Importing graph of %d nodes and %d channels",
+		len(graph.Nodes), len(graph.Edges))
+
+	for _, rpcNode := range graph.Nodes {
+		node, err := unmarshallGraphNode(rpcNode)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cfg.Graph.AddLightningNode(node); err != nil {
+			return nil, fmt.Errorf("unable to add node %v: %v",
+				rpcNode.PubKey, err)
+		}
+	}
+
+	for _, rpcEdge := range graph.Edges {
+		edge, err := unmarshallGraphEdge(rpcEdge, netParams.GenesisHash)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cfg.Graph.AddChannelEdge(edge); err != nil {
+			return nil, fmt.Errorf("unable to add channel %v: %v",
+				rpcEdge.ChannelId, err)
+		}
+
+		policies := []*lnrpc.RoutingPolicy{
+			rpcEdge.Node1Policy, rpcEdge.Node2Policy,
+		}
+		for direction, rpcPolicy := range policies {
+			if rpcPolicy == nil {
+				continue
+			}
+
+			policy := unmarshallGraphPolicy(
+				rpcEdge.ChannelId, rpcPolicy, direction == 1,
+			)
+			err := s.cfg.Graph.UpdateEdgePolicy(policy)
+			if err != nil {
+				return nil, fmt.Errorf("unable to update "+
+					"policy of channel %v: %v",
+					rpcEdge.ChannelId, err)
+			}
+		}
+	}
+
+	log.Infof("Imported graph of %d nodes and %d channels",
+		len(graph.Nodes), len(graph.Edges))
+
+	return &XImportGraphResponse{
+		NumNodes:    uint32(len(graph.Nodes)),
+		NumChannels: uint32(len(graph.Edges)),
+	}, nil
+}
+
+// unmarshallGraphNode converts a node of an RPC channel graph into a node of
+// the graph database.
+func unmarshallGraphNode(rpcNode *lnrpc.LightningNode) (
+	*channeldb.LightningNode, error) {
+
+	pubKey, err := route.NewVertexFromStr(rpcNode.PubKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid node pubkey %v: %v",
+			rpcNode.PubKey, err)
+	}
+
+	nodeColor, err := routing.DecodeHexColor(rpcNode.Color)
+	if err != nil {
+		return nil, fmt.Errorf("invalid color of node %v: %v",
+			rpcNode.PubKey, err)
+	}
+
+	addrs := make([]net.Addr, 0, len(rpcNode.Addresses))
+	for _, rpcAddr := range rpcNode.Addresses {
+		if rpcAddr.Network != "tcp" {
+			return nil, fmt.Errorf("unsupported network %v of "+
+				"node %v address", rpcAddr.Network,
+				rpcNode.PubKey)
+		}
+
+		addr, err := net.ResolveTCPAddr("tcp", rpcAddr.Addr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address of node %v: "+
+				"%v", rpcNode.PubKey, err)
+		}
+		addrs = append(addrs, addr)
+	}
+
+	return &channeldb.LightningNode{
+		PubKeyBytes:          pubKey,
+		HaveNodeAnnouncement: true,
+		LastUpdate:           time.Unix(int64(rpcNode.LastUpdate), 0),
+		Addresses:            addrs,
+		Color:                nodeColor,
+		Alias:                rpcNode.Alias,
+		Features: lnwire.NewFeatureVector(
+			nil, lnwire.GlobalFeatures,
+		),
+	}, nil
+}
+
+// unmarshallGraphEdge converts a channel of an RPC channel graph into a
+// channel of the graph database. As the funding keys of the channel aren't
+// part of the RPC graph, the node keys are used in their place.
+func unmarshallGraphEdge(rpcEdge *lnrpc.ChannelEdge,
+	chainHash chainhash.Hash) (*channeldb.ChannelEdgeInfo, error) {
+
+	node1, err := route.NewVertexFromStr(rpcEdge.Node1Pub)
+	if err != nil {
+		return nil, fmt.Errorf("invalid node1 pubkey of channel %v: %v",
+			rpcEdge.ChannelId, err)
+	}
+	node2, err := route.NewVertexFromStr(rpcEdge.Node2Pub)
+	if err != nil {
+		return nil, fmt.Errorf("invalid node2 pubkey of channel %v: %v",
+			rpcEdge.ChannelId, err)
+	}
+
+	chanPoint, err := parseGraphChanPoint(rpcEdge.ChanPoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid channel point of channel %v: "+
+			"%v", rpcEdge.ChannelId, err)
+	}
+
+	return &channeldb.ChannelEdgeInfo{
+		ChannelID:       rpcEdge.ChannelId,
+		ChainHash:       chainHash,
+		NodeKey1Bytes:   node1,
+		NodeKey2Bytes:   node2,
+		DecredKey1Bytes: node1,
+		DecredKey2Bytes: node2,
+		ChannelPoint:    *chanPoint,
+		Capacity:        dcrutil.Amount(rpcEdge.Capacity),
+	}, nil
+}
+
+// unmarshallGraphPolicy converts a policy of a channel of an RPC channel graph
+// into a policy of the graph database. The direction bit of the policy is set
+// if it is the policy of the second node of the channel.
+func unmarshallGraphPolicy(chanID uint64, rpcPolicy *lnrpc.RoutingPolicy,
+	node2 bool) *channeldb.ChannelEdgePolicy {
+
+	policy := &channeldb.ChannelEdgePolicy{
+		ChannelID:     chanID,
+		LastUpdate:    time.Unix(int64(rpcPolicy.LastUpdate), 0),
+		TimeLockDelta: uint16(rpcPolicy.TimeLockDelta),
+		MinHTLC:       lnwire.MilliAtom(rpcPolicy.MinHtlc),
+		FeeBaseMAtoms: lnwire.MilliAtom(rpcPolicy.FeeBaseMAtoms),
+		FeeProportionalMillionths: lnwire.MilliAtom(
+			rpcPolicy.FeeRateMilliMAtoms,
+		),
+	}
+
+	if rpcPolicy.MaxHtlcMAtoms > 0 {
+		policy.MaxHTLC = lnwire.MilliAtom(rpcPolicy.MaxHtlcMAtoms)
+		policy.MessageFlags |= lnwire.ChanUpdateOptionMaxHtlc
+	}
+	if node2 {
+		policy.ChannelFlags |= lnwire.ChanUpdateDirection
+	}
+	if rpcPolicy.Disabled {
+		policy.ChannelFlags |= lnwire.ChanUpdateDisabled
+	}
+
+	return policy
+}
+
+// parseGraphChanPoint parses a channel point of the form
+// funding_txid:output_index, as returned by DescribeGraph.
+func parseGraphChanPoint(s string) (*wire.OutPoint, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 2 {
+		return nil, errors.New("channel point should be of the form " +
+			"funding_txid:output_index")
+	}
+
+	txid, err := chainhash.NewHashFromStr(parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	index, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	return wire.NewOutPoint(txid, uint32(index), wire.TxTreeRegular), nil
+}
@@ -47,6 +47,16 @@ const (
 	// Payment details incorrect (unknown hash, invalid amt or
 	// invalid final cltv delta)
 	PaymentState_FAILED_INCORRECT_PAYMENT_DETAILS PaymentState = 5
+	// *
+	// The payment was canceled by the user before it completed.
+	PaymentState_FAILED_CANCELED PaymentState = 6
+	// *
+	// The attempt, fee or time budget of the payment was exhausted before it
+	// completed.
+	PaymentState_FAILED_BUDGET_EXHAUSTED PaymentState = 7
+	// *
+	// None of our channels had enough outbound balance to carry the payment.
+	PaymentState_FAILED_INSUFFICIENT_BALANCE PaymentState = 8
 )
 
 var PaymentState_name = map[int32]string{
@@ -56,6 +66,9 @@ var PaymentState_name = map[int32]string{
 	3: "FAILED_NO_ROUTE",
 	4: "FAILED_ERROR",
 	5: "FAILED_INCORRECT_PAYMENT_DETAILS",
+	6: "FAILED_CANCELED",
+	7: "FAILED_BUDGET_EXHAUSTED",
+	8: "FAILED_INSUFFICIENT_BALANCE",
 }
 var PaymentState_value = map[string]int32{
 	"IN_FLIGHT":                        0,
@@ -64,13 +77,85 @@ var PaymentState_value = map[string]int32{
 	"FAILED_NO_ROUTE":                  3,
 	"FAILED_ERROR":                     4,
 	"FAILED_INCORRECT_PAYMENT_DETAILS": 5,
+	"FAILED_CANCELED":                  6,
+	"FAILED_BUDGET_EXHAUSTED":          7,
+	"FAILED_INSUFFICIENT_BALANCE":      8,
 }
 
 func (x PaymentState) String() string {
 	return proto.EnumName(PaymentState_name, int32(x))
 }
 func (PaymentState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_router_bf5805918396094e, []int{0}
+	return fileDescriptor_router_03cf04c818eeac84, []int{0}
+}
+
+type ChanStatusAction int32
+
+const (
+	// *
+	// Enable the channel in our outgoing channel updates, keeping it enabled
+	// while it's inactive.
+	ChanStatusAction_ENABLE ChanStatusAction = 0
+	// *
+	// Disable the channel in our outgoing channel updates, keeping it disabled
+	// once its peer reconnects.
+	ChanStatusAction_DISABLE ChanStatusAction = 1
+	// *
+	// Give the status of the channel back to the automatic disabling of inactive
+	// channels and enabling of active ones.
+	ChanStatusAction_AUTO ChanStatusAction = 2
+)
+
+var ChanStatusAction_name = map[int32]string{
+	0: "ENABLE",
+	1: "DISABLE",
+	2: "AUTO",
+}
+var ChanStatusAction_value = map[string]int32{
+	"ENABLE":  0,
+	"DISABLE": 1,
+	"AUTO":    2,
+}
+
+func (x ChanStatusAction) String() string {
+	return proto.EnumName(ChanStatusAction_name, int32(x))
+}
+func (ChanStatusAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_router_03cf04c818eeac84, []int{1}
+}
+
+type CircuitState int32
+
+const (
+	// *
+	// The htlc hasn't been locked in a commitment of the outgoing channel yet.
+	CircuitState_PENDING CircuitState = 0
+	// *
+	// The htlc has been forwarded through the outgoing channel, and is awaiting
+	// a settle or fail from the remote peer.
+	CircuitState_OPEN CircuitState = 1
+	// *
+	// A settle or fail has been received, and the circuit will be deleted once
+	// it is committed to the incoming channel.
+	CircuitState_CLOSING CircuitState = 2
+)
+
+var CircuitState_name = map[int32]string{
+	0: "PENDING",
+	1: "OPEN",
+	2: "CLOSING",
+}
+var CircuitState_value = map[string]int32{
+	"PENDING": 0,
+	"OPEN":    1,
+	"CLOSING": 2,
+}
+
+func (x CircuitState) String() string {
+	return proto.EnumName(CircuitState_name, int32(x))
+}
+func (CircuitState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_router_03cf04c818eeac84, []int{2}
 }
 
 type Failure_FailureCode int32
@@ -171,7 +256,68 @@ func (x Failure_FailureCode) String() string {
 	return proto.EnumName(Failure_FailureCode_name, int32(x))
 }
 func (Failure_FailureCode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_router_bf5805918396094e, []int{7, 0}
+	return fileDescriptor_router_03cf04c818eeac84, []int{7, 0}
+}
+
+type HTLCAttempt_HTLCStatus int32
+
+const (
+	// *
+	// The htlc is still outstanding.
+	HTLCAttempt_IN_FLIGHT HTLCAttempt_HTLCStatus = 0
+	// *
+	// The htlc was settled by the destination.
+	HTLCAttempt_SUCCEEDED HTLCAttempt_HTLCStatus = 1
+	// *
+	// The htlc failed, after which another attempt may have been made.
+	HTLCAttempt_FAILED HTLCAttempt_HTLCStatus = 2
+)
+
+var HTLCAttempt_HTLCStatus_name = map[int32]string{
+	0: "IN_FLIGHT",
+	1: "SUCCEEDED",
+	2: "FAILED",
+}
+var HTLCAttempt_HTLCStatus_value = map[string]int32{
+	"IN_FLIGHT": 0,
+	"SUCCEEDED": 1,
+	"FAILED":    2,
+}
+
+func (x HTLCAttempt_HTLCStatus) String() string {
+	return proto.EnumName(HTLCAttempt_HTLCStatus_name, int32(x))
+}
+func (HTLCAttempt_HTLCStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_router_03cf04c818eeac84, []int{26, 0}
+}
+
+type HtlcEvent_EventType int32
+
+const (
+	HtlcEvent_UNKNOWN HtlcEvent_EventType = 0
+	HtlcEvent_SEND    HtlcEvent_EventType = 1
+	HtlcEvent_RECEIVE HtlcEvent_EventType = 2
+	HtlcEvent_FORWARD HtlcEvent_EventType = 3
+)
+
+var HtlcEvent_EventType_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "SEND",
+	2: "RECEIVE",
+	3: "FORWARD",
+}
+var HtlcEvent_EventType_value = map[string]int32{
+	"UNKNOWN": 0,
+	"SEND":    1,
+	"RECEIVE": 2,
+	"FORWARD": 3,
+}
+
+func (x HtlcEvent_EventType) String() string {
+	return proto.EnumName(HtlcEvent_EventType_name, int32(x))
+}
+func (HtlcEvent_EventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_router_03cf04c818eeac84, []int{30, 0}
 }
 
 type SendPaymentRequest struct {
@@ -220,17 +366,59 @@ type SendPaymentRequest struct {
 	// An optional field that can be used to pass an arbitrary set of TLV records
 	// to a peer which understands the new records. This can be used to pass
 	// application specific data during the payment attempt.
-	DestTlv              map[uint64][]byte `protobuf:"bytes,11,rep,name=dest_tlv,json=destTlv,proto3" json:"dest_tlv,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	DestTlv map[uint64][]byte `protobuf:"bytes,11,rep,name=dest_tlv,json=destTlv,proto3" json:"dest_tlv,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// *
+	// An optional maximum number of attempts that will be made to complete the
+	// payment. If exceeded, the payment fails with FAILED_BUDGET_EXHAUSTED. If
+	// zero, the number of attempts is only bounded by timeout_seconds.
+	MaxAttempts uint32 `protobuf:"varint,12,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	// *
+	// An optional maximum sum of the fees of all routes attempted for the
+	// payment, in atoms. Every attempt is restricted to what is left of this
+	// budget, on top of fee_limit_atoms. If no route can be found within the
+	// remaining budget, the payment fails with FAILED_BUDGET_EXHAUSTED.
+	MaxTotalFeeAtoms int64 `protobuf:"varint,13,opt,name=max_total_fee_atoms,json=maxTotalFeeAtoms,proto3" json:"max_total_fee_atoms,omitempty"`
+	// *
+	// An optional maximum wall clock time in seconds during which new attempts
+	// are made for the payment. Once passed, the payment fails with
+	// FAILED_BUDGET_EXHAUSTED after the outstanding attempt, if any, resolves.
+	MaxDurationSeconds int32 `protobuf:"varint,14,opt,name=max_duration_seconds,json=maxDurationSeconds,proto3" json:"max_duration_seconds,omitempty"`
+	// *
+	// An optional set of channel ids of which one must be taken to the first hop.
+	// If empty, any channel may be used. This field can't be combined with
+	// outgoing_chan_id.
+	OutgoingChanIds []uint64 `protobuf:"varint,15,rep,packed,name=outgoing_chan_ids,json=outgoingChanIds,proto3" json:"outgoing_chan_ids,omitempty"`
+	// *
+	// The pubkey of the node that must be the last hop before the destination.
+	// If empty, any node may be the last hop.
+	LastHopPubkey []byte `protobuf:"bytes,16,opt,name=last_hop_pubkey,json=lastHopPubkey,proto3" json:"last_hop_pubkey,omitempty"`
+	// *
+	// If set, the final cltv delta of the routes attempted is extended by a
+	// random number of blocks within the configured bounds, as if the routes
+	// went on past the destination. This keeps the nodes along the routes from
+	// inferring their distance to the destination from the time lock of the
+	// htlc. The extension never exceeds the cltv limit of the payment.
+	ShadowRoute bool `protobuf:"varint,17,opt,name=shadow_route,json=shadowRoute,proto3" json:"shadow_route,omitempty"`
+	// / An optional note describing the payment, stored along with it.
+	Label string `protobuf:"bytes,18,opt,name=label,proto3" json:"label,omitempty"`
+	// *
+	// An optional reference linking the payment to its purpose, such as an order
+	// or bill id of a bookkeeping tool. Payments can be listed by reference.
+	Reference string `protobuf:"bytes,19,opt,name=reference,proto3" json:"reference,omitempty"`
+	// *
+	// What happens to the payment if it's sent before the initial sync of the
+	// graph completes, as reported by synced_to_graph.
+	UnsyncedGraphAction  lnrpc.UnsyncedGraphAction `protobuf:"varint,20,opt,name=unsynced_graph_action,json=unsyncedGraphAction,proto3,enum=lnrpc.UnsyncedGraphAction" json:"unsynced_graph_action,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
 func (m *SendPaymentRequest) Reset()         { *m = SendPaymentRequest{} }
 func (m *SendPaymentRequest) String() string { return proto.CompactTextString(m) }
 func (*SendPaymentRequest) ProtoMessage()    {}
 func (*SendPaymentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_router_bf5805918396094e, []int{0}
+	return fileDescriptor_router_03cf04c818eeac84, []int{0}
 }
 func (m *SendPaymentRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendPaymentRequest.Unmarshal(m, b)
@@ -327,6 +515,69 @@ func (m *SendPaymentRequest) GetDestTlv() map[uint64][]byte {
 	return nil
 }
 
+func (m *SendPaymentRequest) GetMaxAttempts() uint32 {
+	if m != nil {
+		return m.MaxAttempts
+	}
+	return 0
+}
+
+func (m *SendPaymentRequest) GetMaxTotalFeeAtoms() int64 {
+	if m != nil {
+		return m.MaxTotalFeeAtoms
+	}
+	return 0
+}
+
+func (m *SendPaymentRequest) GetMaxDurationSeconds() int32 {
+	if m != nil {
+		return m.MaxDurationSeconds
+	}
+	return 0
+}
+
+func (m *SendPaymentRequest) GetOutgoingChanIds() []uint64 {
+	if m != nil {
+		return m.OutgoingChanIds
+	}
+	return nil
+}
+
+func (m *SendPaymentRequest) GetLastHopPubkey() []byte {
+	if m != nil {
+		return m.LastHopPubkey
+	}
+	return nil
+}
+
+func (m *SendPaymentRequest) GetShadowRoute() bool {
+	if m != nil {
+		return m.ShadowRoute
+	}
+	return false
+}
+
+func (m *SendPaymentRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *SendPaymentRequest) GetReference() string {
+	if m != nil {
+		return m.Reference
+	}
+	return ""
+}
+
+func (m *SendPaymentRequest) GetUnsyncedGraphAction() lnrpc.UnsyncedGraphAction {
+	if m != nil {
+		return m.UnsyncedGraphAction
+	}
+	return lnrpc.UnsyncedGraphAction_UNSYNCED_GRAPH_DEFAULT
+}
+
 type TrackPaymentRequest struct {
 	// / The hash of the payment to look up.
 	PaymentHash          []byte   `protobuf:"bytes,1,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
@@ -339,7 +590,7 @@ func (m *TrackPaymentRequest) Reset()         { *m = TrackPaymentRequest{} }
 func (m *TrackPaymentRequest) String() string { return proto.CompactTextString(m) }
 func (*TrackPaymentRequest) ProtoMessage()    {}
 func (*TrackPaymentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_router_bf5805918396094e, []int{1}
+	return fileDescriptor_router_03cf04c818eeac84, []int{1}
 }
 func (m *TrackPaymentRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TrackPaymentRequest.Unmarshal(m, b)
@@ -384,7 +635,7 @@ func (m *PaymentStatus) Reset()         { *m = PaymentStatus{} }
 func (m *PaymentStatus) String() string { return proto.CompactTextString(m) }
 func (*PaymentStatus) ProtoMessage()    {}
 func (*PaymentStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_router_bf5805918396094e, []int{2}
+	return fileDescriptor_router_03cf04c818eeac84, []int{2}
 }
 func (m *PaymentStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaymentStatus.Unmarshal(m, b)
@@ -441,7 +692,7 @@ func (m *RouteFeeRequest) Reset()         { *m = RouteFeeRequest{} }
 func (m *RouteFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*RouteFeeRequest) ProtoMessage()    {}
 func (*RouteFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_router_bf5805918396094e, []int{3}
+	return fileDescriptor_router_03cf04c818eeac84, []int{3}
 }
 func (m *RouteFeeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RouteFeeRequest.Unmarshal(m, b)
@@ -494,7 +745,7 @@ func (m *RouteFeeResponse) Reset()         { *m = RouteFeeResponse{} }
 func (m *RouteFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*RouteFeeResponse) ProtoMessage()    {}
 func (*RouteFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_router_bf5805918396094e, []int{4}
+	return fileDescriptor_router_03cf04c818eeac84, []int{4}
 }
 func (m *RouteFeeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RouteFeeResponse.Unmarshal(m, b)
@@ -542,7 +793,7 @@ func (m *SendToRouteRequest) Reset()         { *m = SendToRouteRequest{} }
 func (m *SendToRouteRequest) String() string { return proto.CompactTextString(m) }
 func (*SendToRouteRequest) ProtoMessage()    {}
 func (*SendToRouteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_router_bf5805918396094e, []int{5}
+	return fileDescriptor_router_03cf04c818eeac84, []int{5}
 }
 func (m *SendToRouteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendToRouteRequest.Unmarshal(m, b)
@@ -590,7 +841,7 @@ func (m *SendToRouteResponse) Reset()         { *m = SendToRouteResponse{} }
 func (m *SendToRouteResponse) String() string { return proto.CompactTextString(m) }
 func (*SendToRouteResponse) ProtoMessage()    {}
 func (*SendToRouteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_router_bf5805918396094e, []int{6}
+	return fileDescriptor_router_03cf04c818eeac84, []int{6}
 }
 func (m *SendToRouteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendToRouteResponse.Unmarshal(m, b)
@@ -652,7 +903,7 @@ func (m *Failure) Reset()         { *m = Failure{} }
 func (m *Failure) String() string { return proto.CompactTextString(m) }
 func (*Failure) ProtoMessage()    {}
 func (*Failure) Descriptor() ([]byte, []int) {
-	return fileDescriptor_router_bf5805918396094e, []int{7}
+	return fileDescriptor_router_03cf04c818eeac84, []int{7}
 }
 func (m *Failure) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Failure.Unmarshal(m, b)
@@ -795,7 +1046,7 @@ func (m *ChannelUpdate) Reset()         { *m = ChannelUpdate{} }
 func (m *ChannelUpdate) String() string { return proto.CompactTextString(m) }
 func (*ChannelUpdate) ProtoMessage()    {}
 func (*ChannelUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_router_bf5805918396094e, []int{8}
+	return fileDescriptor_router_03cf04c818eeac84, []int{8}
 }
 func (m *ChannelUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelUpdate.Unmarshal(m, b)
@@ -900,6 +1151,11 @@ func (m *ChannelUpdate) GetExtraOpaqueData() []byte {
 }
 
 type ResetMissionControlRequest struct {
+	// *
+	// If non-zero, only the results of the payment attempts that completed more
+	// than this number of seconds ago are removed, and the mission control state
+	// is rederived from the remaining results.
+	OlderThanSeconds     uint64   `protobuf:"varint,1,opt,name=older_than_seconds,json=olderThanSeconds,proto3" json:"older_than_seconds,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -909,7 +1165,7 @@ func (m *ResetMissionControlRequest) Reset()         { *m = ResetMissionControlR
 func (m *ResetMissionControlRequest) String() string { return proto.CompactTextString(m) }
 func (*ResetMissionControlRequest) ProtoMessage()    {}
 func (*ResetMissionControlRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_router_bf5805918396094e, []int{9}
+	return fileDescriptor_router_03cf04c818eeac84, []int{9}
 }
 func (m *ResetMissionControlRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ResetMissionControlRequest.Unmarshal(m, b)
@@ -929,6 +1185,13 @@ func (m *ResetMissionControlRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ResetMissionControlRequest proto.InternalMessageInfo
 
+func (m *ResetMissionControlRequest) GetOlderThanSeconds() uint64 {
+	if m != nil {
+		return m.OlderThanSeconds
+	}
+	return 0
+}
+
 type ResetMissionControlResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -939,7 +1202,7 @@ func (m *ResetMissionControlResponse) Reset()         { *m = ResetMissionControl
 func (m *ResetMissionControlResponse) String() string { return proto.CompactTextString(m) }
 func (*ResetMissionControlResponse) ProtoMessage()    {}
 func (*ResetMissionControlResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_router_bf5805918396094e, []int{10}
+	return fileDescriptor_router_03cf04c818eeac84, []int{10}
 }
 func (m *ResetMissionControlResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ResetMissionControlResponse.Unmarshal(m, b)
@@ -969,7 +1232,7 @@ func (m *QueryMissionControlRequest) Reset()         { *m = QueryMissionControlR
 func (m *QueryMissionControlRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryMissionControlRequest) ProtoMessage()    {}
 func (*QueryMissionControlRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_router_bf5805918396094e, []int{11}
+	return fileDescriptor_router_03cf04c818eeac84, []int{11}
 }
 func (m *QueryMissionControlRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryMissionControlRequest.Unmarshal(m, b)
@@ -1004,7 +1267,7 @@ func (m *QueryMissionControlResponse) Reset()         { *m = QueryMissionControl
 func (m *QueryMissionControlResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMissionControlResponse) ProtoMessage()    {}
 func (*QueryMissionControlResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_router_bf5805918396094e, []int{12}
+	return fileDescriptor_router_03cf04c818eeac84, []int{12}
 }
 func (m *QueryMissionControlResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryMissionControlResponse.Unmarshal(m, b)
@@ -1057,7 +1320,7 @@ func (m *NodeHistory) Reset()         { *m = NodeHistory{} }
 func (m *NodeHistory) String() string { return proto.CompactTextString(m) }
 func (*NodeHistory) ProtoMessage()    {}
 func (*NodeHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_router_bf5805918396094e, []int{13}
+	return fileDescriptor_router_03cf04c818eeac84, []int{13}
 }
 func (m *NodeHistory) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeHistory.Unmarshal(m, b)
@@ -1121,7 +1384,7 @@ func (m *PairHistory) Reset()         { *m = PairHistory{} }
 func (m *PairHistory) String() string { return proto.CompactTextString(m) }
 func (*PairHistory) ProtoMessage()    {}
 func (*PairHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_router_bf5805918396094e, []int{14}
+	return fileDescriptor_router_03cf04c818eeac84, []int{14}
 }
 func (m *PairHistory) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PairHistory.Unmarshal(m, b)
@@ -1209,7 +1472,7 @@ func (m *BuildRouteRequest) Reset()         { *m = BuildRouteRequest{} }
 func (m *BuildRouteRequest) String() string { return proto.CompactTextString(m) }
 func (*BuildRouteRequest) ProtoMessage()    {}
 func (*BuildRouteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_router_bf5805918396094e, []int{15}
+	return fileDescriptor_router_03cf04c818eeac84, []int{15}
 }
 func (m *BuildRouteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BuildRouteRequest.Unmarshal(m, b)
@@ -1270,7 +1533,7 @@ func (m *BuildRouteResponse) Reset()         { *m = BuildRouteResponse{} }
 func (m *BuildRouteResponse) String() string { return proto.CompactTextString(m) }
 func (*BuildRouteResponse) ProtoMessage()    {}
 func (*BuildRouteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_router_bf5805918396094e, []int{16}
+	return fileDescriptor_router_03cf04c818eeac84, []int{16}
 }
 func (m *BuildRouteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BuildRouteResponse.Unmarshal(m, b)
@@ -1297,185 +1560,1996 @@ func (m *BuildRouteResponse) GetRoute() *lnrpc.Route {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*SendPaymentRequest)(nil), "routerrpc.SendPaymentRequest")
-	proto.RegisterMapType((map[uint64][]byte)(nil), "routerrpc.SendPaymentRequest.DestTlvEntry")
-	proto.RegisterType((*TrackPaymentRequest)(nil), "routerrpc.TrackPaymentRequest")
-	proto.RegisterType((*PaymentStatus)(nil), "routerrpc.PaymentStatus")
-	proto.RegisterType((*RouteFeeRequest)(nil), "routerrpc.RouteFeeRequest")
-	proto.RegisterType((*RouteFeeResponse)(nil), "routerrpc.RouteFeeResponse")
-	proto.RegisterType((*SendToRouteRequest)(nil), "routerrpc.SendToRouteRequest")
-	proto.RegisterType((*SendToRouteResponse)(nil), "routerrpc.SendToRouteResponse")
-	proto.RegisterType((*Failure)(nil), "routerrpc.Failure")
-	proto.RegisterType((*ChannelUpdate)(nil), "routerrpc.ChannelUpdate")
-	proto.RegisterType((*ResetMissionControlRequest)(nil), "routerrpc.ResetMissionControlRequest")
-	proto.RegisterType((*ResetMissionControlResponse)(nil), "routerrpc.ResetMissionControlResponse")
-	proto.RegisterType((*QueryMissionControlRequest)(nil), "routerrpc.QueryMissionControlRequest")
-	proto.RegisterType((*QueryMissionControlResponse)(nil), "routerrpc.QueryMissionControlResponse")
-	proto.RegisterType((*NodeHistory)(nil), "routerrpc.NodeHistory")
-	proto.RegisterType((*PairHistory)(nil), "routerrpc.PairHistory")
-	proto.RegisterType((*BuildRouteRequest)(nil), "routerrpc.BuildRouteRequest")
-	proto.RegisterType((*BuildRouteResponse)(nil), "routerrpc.BuildRouteResponse")
-	proto.RegisterEnum("routerrpc.PaymentState", PaymentState_name, PaymentState_value)
-	proto.RegisterEnum("routerrpc.Failure_FailureCode", Failure_FailureCode_name, Failure_FailureCode_value)
+type CancelPaymentRequest struct {
+	// / The hash of the payment to cancel.
+	PaymentHash          []byte   `protobuf:"bytes,1,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *CancelPaymentRequest) Reset()         { *m = CancelPaymentRequest{} }
+func (m *CancelPaymentRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelPaymentRequest) ProtoMessage()    {}
+func (*CancelPaymentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_03cf04c818eeac84, []int{17}
+}
+func (m *CancelPaymentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelPaymentRequest.Unmarshal(m, b)
+}
+func (m *CancelPaymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelPaymentRequest.Marshal(b, m, deterministic)
+}
+func (dst *CancelPaymentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelPaymentRequest.Merge(dst, src)
+}
+func (m *CancelPaymentRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelPaymentRequest.Size(m)
+}
+func (m *CancelPaymentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelPaymentRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_CancelPaymentRequest proto.InternalMessageInfo
 
-// RouterClient is the client API for Router service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type RouterClient interface {
-	// *
-	// SendPayment attempts to route a payment described by the passed
-	// PaymentRequest to the final destination. The call returns a stream of
-	// payment status updates.
-	SendPayment(ctx context.Context, in *SendPaymentRequest, opts ...grpc.CallOption) (Router_SendPaymentClient, error)
-	// *
-	// TrackPayment returns an update stream for the payment identified by the
-	// payment hash.
-	TrackPayment(ctx context.Context, in *TrackPaymentRequest, opts ...grpc.CallOption) (Router_TrackPaymentClient, error)
-	// *
-	// EstimateRouteFee allows callers to obtain a lower bound w.r.t how much it
-	// may cost to send an HTLC to the target end destination.
-	EstimateRouteFee(ctx context.Context, in *RouteFeeRequest, opts ...grpc.CallOption) (*RouteFeeResponse, error)
-	// *
-	// SendToRoute attempts to make a payment via the specified route. This method
-	// differs from SendPayment in that it allows users to specify a full route
-	// manually. This can be used for things like rebalancing, and atomic swaps.
-	SendToRoute(ctx context.Context, in *SendToRouteRequest, opts ...grpc.CallOption) (*SendToRouteResponse, error)
-	// *
-	// ResetMissionControl clears all mission control state and starts with a clean
-	// slate.
-	ResetMissionControl(ctx context.Context, in *ResetMissionControlRequest, opts ...grpc.CallOption) (*ResetMissionControlResponse, error)
-	// *
-	// QueryMissionControl exposes the internal mission control state to callers.
-	// It is a development feature.
-	QueryMissionControl(ctx context.Context, in *QueryMissionControlRequest, opts ...grpc.CallOption) (*QueryMissionControlResponse, error)
-	// *
-	// BuildRoute builds a fully specified route based on a list of hop public
-	// keys. It retrieves the relevant channel policies from the graph in order to
-	// calculate the correct fees and time locks.
-	BuildRoute(ctx context.Context, in *BuildRouteRequest, opts ...grpc.CallOption) (*BuildRouteResponse, error)
+func (m *CancelPaymentRequest) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
 }
 
-type routerClient struct {
-	cc *grpc.ClientConn
+type CancelPaymentResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func NewRouterClient(cc *grpc.ClientConn) RouterClient {
-	return &routerClient{cc}
+func (m *CancelPaymentResponse) Reset()         { *m = CancelPaymentResponse{} }
+func (m *CancelPaymentResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelPaymentResponse) ProtoMessage()    {}
+func (*CancelPaymentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_03cf04c818eeac84, []int{18}
 }
-
-func (c *routerClient) SendPayment(ctx context.Context, in *SendPaymentRequest, opts ...grpc.CallOption) (Router_SendPaymentClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Router_serviceDesc.Streams[0], "/routerrpc.Router/SendPayment", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &routerSendPaymentClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
+func (m *CancelPaymentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelPaymentResponse.Unmarshal(m, b)
 }
-
-type Router_SendPaymentClient interface {
-	Recv() (*PaymentStatus, error)
-	grpc.ClientStream
+func (m *CancelPaymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelPaymentResponse.Marshal(b, m, deterministic)
+}
+func (dst *CancelPaymentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelPaymentResponse.Merge(dst, src)
+}
+func (m *CancelPaymentResponse) XXX_Size() int {
+	return xxx_messageInfo_CancelPaymentResponse.Size(m)
+}
+func (m *CancelPaymentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelPaymentResponse.DiscardUnknown(m)
 }
 
-type routerSendPaymentClient struct {
-	grpc.ClientStream
+var xxx_messageInfo_CancelPaymentResponse proto.InternalMessageInfo
+
+type UpdateAvoidListRequest struct {
+	// *
+	// The public keys of the nodes to add to or remove from the avoid list.
+	Nodes [][]byte `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	// *
+	// The short channel ids of the channels to add to or remove from the avoid
+	// list.
+	ChanIds              []uint64 `protobuf:"varint,2,rep,packed,name=chan_ids,json=chanIds,proto3" json:"chan_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (x *routerSendPaymentClient) Recv() (*PaymentStatus, error) {
-	m := new(PaymentStatus)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *UpdateAvoidListRequest) Reset()         { *m = UpdateAvoidListRequest{} }
+func (m *UpdateAvoidListRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateAvoidListRequest) ProtoMessage()    {}
+func (*UpdateAvoidListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_03cf04c818eeac84, []int{19}
+}
+func (m *UpdateAvoidListRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateAvoidListRequest.Unmarshal(m, b)
+}
+func (m *UpdateAvoidListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateAvoidListRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateAvoidListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateAvoidListRequest.Merge(dst, src)
+}
+func (m *UpdateAvoidListRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateAvoidListRequest.Size(m)
+}
+func (m *UpdateAvoidListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateAvoidListRequest.DiscardUnknown(m)
 }
 
-func (c *routerClient) TrackPayment(ctx context.Context, in *TrackPaymentRequest, opts ...grpc.CallOption) (Router_TrackPaymentClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Router_serviceDesc.Streams[1], "/routerrpc.Router/TrackPayment", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &routerTrackPaymentClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+var xxx_messageInfo_UpdateAvoidListRequest proto.InternalMessageInfo
+
+func (m *UpdateAvoidListRequest) GetNodes() [][]byte {
+	if m != nil {
+		return m.Nodes
 	}
-	return x, nil
+	return nil
 }
 
-type Router_TrackPaymentClient interface {
-	Recv() (*PaymentStatus, error)
-	grpc.ClientStream
+func (m *UpdateAvoidListRequest) GetChanIds() []uint64 {
+	if m != nil {
+		return m.ChanIds
+	}
+	return nil
+}
+
+type UpdateAvoidListResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateAvoidListResponse) Reset()         { *m = UpdateAvoidListResponse{} }
+func (m *UpdateAvoidListResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateAvoidListResponse) ProtoMessage()    {}
+func (*UpdateAvoidListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_03cf04c818eeac84, []int{20}
+}
+func (m *UpdateAvoidListResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateAvoidListResponse.Unmarshal(m, b)
+}
+func (m *UpdateAvoidListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateAvoidListResponse.Marshal(b, m, deterministic)
+}
+func (dst *UpdateAvoidListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateAvoidListResponse.Merge(dst, src)
+}
+func (m *UpdateAvoidListResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateAvoidListResponse.Size(m)
+}
+func (m *UpdateAvoidListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateAvoidListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateAvoidListResponse proto.InternalMessageInfo
+
+type QueryAvoidListRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryAvoidListRequest) Reset()         { *m = QueryAvoidListRequest{} }
+func (m *QueryAvoidListRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAvoidListRequest) ProtoMessage()    {}
+func (*QueryAvoidListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_03cf04c818eeac84, []int{21}
+}
+func (m *QueryAvoidListRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryAvoidListRequest.Unmarshal(m, b)
+}
+func (m *QueryAvoidListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryAvoidListRequest.Marshal(b, m, deterministic)
+}
+func (dst *QueryAvoidListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAvoidListRequest.Merge(dst, src)
+}
+func (m *QueryAvoidListRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryAvoidListRequest.Size(m)
+}
+func (m *QueryAvoidListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAvoidListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAvoidListRequest proto.InternalMessageInfo
+
+type QueryAvoidListResponse struct {
+	// / The public keys of all nodes on the avoid list.
+	Nodes [][]byte `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	// / The short channel ids of all channels on the avoid list.
+	ChanIds              []uint64 `protobuf:"varint,2,rep,packed,name=chan_ids,json=chanIds,proto3" json:"chan_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryAvoidListResponse) Reset()         { *m = QueryAvoidListResponse{} }
+func (m *QueryAvoidListResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAvoidListResponse) ProtoMessage()    {}
+func (*QueryAvoidListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_03cf04c818eeac84, []int{22}
+}
+func (m *QueryAvoidListResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryAvoidListResponse.Unmarshal(m, b)
+}
+func (m *QueryAvoidListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryAvoidListResponse.Marshal(b, m, deterministic)
+}
+func (dst *QueryAvoidListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAvoidListResponse.Merge(dst, src)
+}
+func (m *QueryAvoidListResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryAvoidListResponse.Size(m)
+}
+func (m *QueryAvoidListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAvoidListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAvoidListResponse proto.InternalMessageInfo
+
+func (m *QueryAvoidListResponse) GetNodes() [][]byte {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *QueryAvoidListResponse) GetChanIds() []uint64 {
+	if m != nil {
+		return m.ChanIds
+	}
+	return nil
+}
+
+type QueryPaymentMetricsRequest struct {
+	// *
+	// The public keys of the destinations to query. If empty, the metrics of all
+	// destinations with recent payments are returned.
+	Destinations         [][]byte `protobuf:"bytes,1,rep,name=destinations,proto3" json:"destinations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryPaymentMetricsRequest) Reset()         { *m = QueryPaymentMetricsRequest{} }
+func (m *QueryPaymentMetricsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentMetricsRequest) ProtoMessage()    {}
+func (*QueryPaymentMetricsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_03cf04c818eeac84, []int{23}
+}
+func (m *QueryPaymentMetricsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryPaymentMetricsRequest.Unmarshal(m, b)
+}
+func (m *QueryPaymentMetricsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryPaymentMetricsRequest.Marshal(b, m, deterministic)
+}
+func (dst *QueryPaymentMetricsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentMetricsRequest.Merge(dst, src)
+}
+func (m *QueryPaymentMetricsRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryPaymentMetricsRequest.Size(m)
+}
+func (m *QueryPaymentMetricsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentMetricsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPaymentMetricsRequest proto.InternalMessageInfo
+
+func (m *QueryPaymentMetricsRequest) GetDestinations() [][]byte {
+	if m != nil {
+		return m.Destinations
+	}
+	return nil
+}
+
+type DestinationMetrics struct {
+	// / The public key of the destination.
+	Destination []byte `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	// / The number of payments to the destination that reached a final state.
+	NumPayments uint32 `protobuf:"varint,2,opt,name=num_payments,json=numPayments,proto3" json:"num_payments,omitempty"`
+	// / The number of payments to the destination that succeeded.
+	NumPaymentsSucceeded uint32 `protobuf:"varint,3,opt,name=num_payments_succeeded,json=numPaymentsSucceeded,proto3" json:"num_payments_succeeded,omitempty"`
+	// / The fraction of payments to the destination that succeeded.
+	PaymentSuccessRate float32 `protobuf:"fixed32,4,opt,name=payment_success_rate,json=paymentSuccessRate,proto3" json:"payment_success_rate,omitempty"`
+	// / The number of resolved payment attempts to the destination.
+	NumAttempts uint32 `protobuf:"varint,5,opt,name=num_attempts,json=numAttempts,proto3" json:"num_attempts,omitempty"`
+	// / The number of payment attempts to the destination that succeeded.
+	NumAttemptsSucceeded uint32 `protobuf:"varint,6,opt,name=num_attempts_succeeded,json=numAttemptsSucceeded,proto3" json:"num_attempts_succeeded,omitempty"`
+	// / The fraction of payment attempts to the destination that succeeded.
+	AttemptSuccessRate float32 `protobuf:"fixed32,7,opt,name=attempt_success_rate,json=attemptSuccessRate,proto3" json:"attempt_success_rate,omitempty"`
+	// *
+	// The average time in milliseconds between sending an attempt and learning
+	// about its outcome.
+	AvgAttemptLatencyMs int64 `protobuf:"varint,8,opt,name=avg_attempt_latency_ms,json=avgAttemptLatencyMs,proto3" json:"avg_attempt_latency_ms,omitempty"`
+	// / The median attempt latency in milliseconds.
+	MedianAttemptLatencyMs int64 `protobuf:"varint,9,opt,name=median_attempt_latency_ms,json=medianAttemptLatencyMs,proto3" json:"median_attempt_latency_ms,omitempty"`
+	// / The largest attempt latency in milliseconds.
+	MaxAttemptLatencyMs int64 `protobuf:"varint,10,opt,name=max_attempt_latency_ms,json=maxAttemptLatencyMs,proto3" json:"max_attempt_latency_ms,omitempty"`
+	// *
+	// The sum in milli-atoms of the differences between the fees paid by the
+	// successful payments to the destination and the fees quoted for them.
+	TotalFeeSlippageMAtoms int64 `protobuf:"varint,11,opt,name=total_fee_slippage_m_atoms,json=totalFeeSlippageMAtoms,proto3" json:"total_fee_slippage_m_atoms,omitempty"`
+	// / The number of successful payments whose fee differed from the quote.
+	NumPaymentsSlipped   uint32   `protobuf:"varint,12,opt,name=num_payments_slipped,json=numPaymentsSlipped,proto3" json:"num_payments_slipped,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DestinationMetrics) Reset()         { *m = DestinationMetrics{} }
+func (m *DestinationMetrics) String() string { return proto.CompactTextString(m) }
+func (*DestinationMetrics) ProtoMessage()    {}
+func (*DestinationMetrics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_03cf04c818eeac84, []int{24}
+}
+func (m *DestinationMetrics) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DestinationMetrics.Unmarshal(m, b)
+}
+func (m *DestinationMetrics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DestinationMetrics.Marshal(b, m, deterministic)
+}
+func (dst *DestinationMetrics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DestinationMetrics.Merge(dst, src)
+}
+func (m *DestinationMetrics) XXX_Size() int {
+	return xxx_messageInfo_DestinationMetrics.Size(m)
+}
+func (m *DestinationMetrics) XXX_DiscardUnknown() {
+	xxx_messageInfo_DestinationMetrics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DestinationMetrics proto.InternalMessageInfo
+
+func (m *DestinationMetrics) GetDestination() []byte {
+	if m != nil {
+		return m.Destination
+	}
+	return nil
+}
+
+func (m *DestinationMetrics) GetNumPayments() uint32 {
+	if m != nil {
+		return m.NumPayments
+	}
+	return 0
+}
+
+func (m *DestinationMetrics) GetNumPaymentsSucceeded() uint32 {
+	if m != nil {
+		return m.NumPaymentsSucceeded
+	}
+	return 0
+}
+
+func (m *DestinationMetrics) GetPaymentSuccessRate() float32 {
+	if m != nil {
+		return m.PaymentSuccessRate
+	}
+	return 0
+}
+
+func (m *DestinationMetrics) GetNumAttempts() uint32 {
+	if m != nil {
+		return m.NumAttempts
+	}
+	return 0
+}
+
+func (m *DestinationMetrics) GetNumAttemptsSucceeded() uint32 {
+	if m != nil {
+		return m.NumAttemptsSucceeded
+	}
+	return 0
+}
+
+func (m *DestinationMetrics) GetAttemptSuccessRate() float32 {
+	if m != nil {
+		return m.AttemptSuccessRate
+	}
+	return 0
+}
+
+func (m *DestinationMetrics) GetAvgAttemptLatencyMs() int64 {
+	if m != nil {
+		return m.AvgAttemptLatencyMs
+	}
+	return 0
+}
+
+func (m *DestinationMetrics) GetMedianAttemptLatencyMs() int64 {
+	if m != nil {
+		return m.MedianAttemptLatencyMs
+	}
+	return 0
+}
+
+func (m *DestinationMetrics) GetMaxAttemptLatencyMs() int64 {
+	if m != nil {
+		return m.MaxAttemptLatencyMs
+	}
+	return 0
+}
+
+func (m *DestinationMetrics) GetTotalFeeSlippageMAtoms() int64 {
+	if m != nil {
+		return m.TotalFeeSlippageMAtoms
+	}
+	return 0
+}
+
+func (m *DestinationMetrics) GetNumPaymentsSlipped() uint32 {
+	if m != nil {
+		return m.NumPaymentsSlipped
+	}
+	return 0
+}
+
+type QueryPaymentMetricsResponse struct {
+	// *
+	// The metrics of the queried destinations. Destinations without recent
+	// payments are omitted.
+	Destinations         []*DestinationMetrics `protobuf:"bytes,1,rep,name=destinations,proto3" json:"destinations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *QueryPaymentMetricsResponse) Reset()         { *m = QueryPaymentMetricsResponse{} }
+func (m *QueryPaymentMetricsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentMetricsResponse) ProtoMessage()    {}
+func (*QueryPaymentMetricsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_03cf04c818eeac84, []int{25}
+}
+func (m *QueryPaymentMetricsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryPaymentMetricsResponse.Unmarshal(m, b)
+}
+func (m *QueryPaymentMetricsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryPaymentMetricsResponse.Marshal(b, m, deterministic)
+}
+func (dst *QueryPaymentMetricsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentMetricsResponse.Merge(dst, src)
+}
+func (m *QueryPaymentMetricsResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryPaymentMetricsResponse.Size(m)
+}
+func (m *QueryPaymentMetricsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentMetricsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPaymentMetricsResponse proto.InternalMessageInfo
+
+func (m *QueryPaymentMetricsResponse) GetDestinations() []*DestinationMetrics {
+	if m != nil {
+		return m.Destinations
+	}
+	return nil
+}
+
+type HTLCAttempt struct {
+	// / The status of the htlc.
+	Status HTLCAttempt_HTLCStatus `protobuf:"varint,1,opt,name=status,proto3,enum=routerrpc.HTLCAttempt_HTLCStatus" json:"status,omitempty"`
+	// / The route taken by the htlc.
+	Route *lnrpc.Route `protobuf:"bytes,2,opt,name=route,proto3" json:"route,omitempty"`
+	// *
+	// The time in UNIX nanoseconds at which the htlc was sent. It is zero for
+	// htlcs sent before attempt times were recorded.
+	AttemptTimeNs int64 `protobuf:"varint,3,opt,name=attempt_time_ns,json=attemptTimeNs,proto3" json:"attempt_time_ns,omitempty"`
+	// *
+	// The time in UNIX nanoseconds at which the htlc was settled or failed. It
+	// is zero while the htlc is in flight, or if the time wasn't recorded.
+	ResolveTimeNs int64 `protobuf:"varint,4,opt,name=resolve_time_ns,json=resolveTimeNs,proto3" json:"resolve_time_ns,omitempty"`
+	// *
+	// The failure of the htlc when its status is FAILED. The failure is omitted
+	// for htlcs that failed locally without reaching the network.
+	Failure *Failure `protobuf:"bytes,5,opt,name=failure,proto3" json:"failure,omitempty"`
+	// / The preimage revealed by the destination when the status is SUCCEEDED.
+	Preimage []byte `protobuf:"bytes,6,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	// *
+	// The fee in milli-atoms quoted by the first route found for the payment,
+	// which the fee of the route of the htlc may differ from. It is zero if
+	// unknown.
+	QuotedFeeMAtoms      int64    `protobuf:"varint,7,opt,name=quoted_fee_m_atoms,json=quotedFeeMAtoms,proto3" json:"quoted_fee_m_atoms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HTLCAttempt) Reset()         { *m = HTLCAttempt{} }
+func (m *HTLCAttempt) String() string { return proto.CompactTextString(m) }
+func (*HTLCAttempt) ProtoMessage()    {}
+func (*HTLCAttempt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_03cf04c818eeac84, []int{26}
+}
+func (m *HTLCAttempt) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HTLCAttempt.Unmarshal(m, b)
+}
+func (m *HTLCAttempt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HTLCAttempt.Marshal(b, m, deterministic)
+}
+func (dst *HTLCAttempt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTLCAttempt.Merge(dst, src)
+}
+func (m *HTLCAttempt) XXX_Size() int {
+	return xxx_messageInfo_HTLCAttempt.Size(m)
+}
+func (m *HTLCAttempt) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTLCAttempt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTLCAttempt proto.InternalMessageInfo
+
+func (m *HTLCAttempt) GetStatus() HTLCAttempt_HTLCStatus {
+	if m != nil {
+		return m.Status
+	}
+	return HTLCAttempt_IN_FLIGHT
+}
+
+func (m *HTLCAttempt) GetRoute() *lnrpc.Route {
+	if m != nil {
+		return m.Route
+	}
+	return nil
+}
+
+func (m *HTLCAttempt) GetAttemptTimeNs() int64 {
+	if m != nil {
+		return m.AttemptTimeNs
+	}
+	return 0
+}
+
+func (m *HTLCAttempt) GetResolveTimeNs() int64 {
+	if m != nil {
+		return m.ResolveTimeNs
+	}
+	return 0
+}
+
+func (m *HTLCAttempt) GetFailure() *Failure {
+	if m != nil {
+		return m.Failure
+	}
+	return nil
+}
+
+func (m *HTLCAttempt) GetPreimage() []byte {
+	if m != nil {
+		return m.Preimage
+	}
+	return nil
+}
+
+func (m *HTLCAttempt) GetQuotedFeeMAtoms() int64 {
+	if m != nil {
+		return m.QuotedFeeMAtoms
+	}
+	return 0
+}
+
+type PaymentDetails struct {
+	// / Current state the payment is in.
+	State PaymentState `protobuf:"varint,1,opt,name=state,proto3,enum=routerrpc.PaymentState" json:"state,omitempty"`
+	// *
+	// The pre-image of the payment when state is SUCCEEDED.
+	Preimage []byte `protobuf:"bytes,2,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	// *
+	// All htlc attempts of the payment, in the order in which they were made.
+	// Of payments made before all attempts were recorded, only the last attempt
+	// is included.
+	Htlcs []*HTLCAttempt `protobuf:"bytes,3,rep,name=htlcs,proto3" json:"htlcs,omitempty"`
+	// / The maximum fee the payment is allowed to pay in milli-atoms.
+	FeeLimitMAtoms int64 `protobuf:"varint,4,opt,name=fee_limit_m_atoms,json=feeLimitMAtoms,proto3" json:"fee_limit_m_atoms,omitempty"`
+	// / The fees of the htlc attempts still in flight in milli-atoms.
+	InFlightFeesMAtoms int64 `protobuf:"varint,5,opt,name=in_flight_fees_m_atoms,json=inFlightFeesMAtoms,proto3" json:"in_flight_fees_m_atoms,omitempty"`
+	// *
+	// What the settled and in-flight htlc attempts left of the fee limit in
+	// milli-atoms, which bounds the fee of any further attempt. Failed attempts
+	// give their fees back to the budget.
+	RemainingFeeBudgetMAtoms int64    `protobuf:"varint,6,opt,name=remaining_fee_budget_m_atoms,json=remainingFeeBudgetMAtoms,proto3" json:"remaining_fee_budget_m_atoms,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
+	XXX_unrecognized         []byte   `json:"-"`
+	XXX_sizecache            int32    `json:"-"`
+}
+
+func (m *PaymentDetails) Reset()         { *m = PaymentDetails{} }
+func (m *PaymentDetails) String() string { return proto.CompactTextString(m) }
+func (*PaymentDetails) ProtoMessage()    {}
+func (*PaymentDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_03cf04c818eeac84, []int{27}
+}
+func (m *PaymentDetails) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaymentDetails.Unmarshal(m, b)
+}
+func (m *PaymentDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaymentDetails.Marshal(b, m, deterministic)
+}
+func (dst *PaymentDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentDetails.Merge(dst, src)
+}
+func (m *PaymentDetails) XXX_Size() int {
+	return xxx_messageInfo_PaymentDetails.Size(m)
+}
+func (m *PaymentDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymentDetails proto.InternalMessageInfo
+
+func (m *PaymentDetails) GetState() PaymentState {
+	if m != nil {
+		return m.State
+	}
+	return PaymentState_IN_FLIGHT
+}
+
+func (m *PaymentDetails) GetPreimage() []byte {
+	if m != nil {
+		return m.Preimage
+	}
+	return nil
+}
+
+func (m *PaymentDetails) GetHtlcs() []*HTLCAttempt {
+	if m != nil {
+		return m.Htlcs
+	}
+	return nil
+}
+
+func (m *PaymentDetails) GetFeeLimitMAtoms() int64 {
+	if m != nil {
+		return m.FeeLimitMAtoms
+	}
+	return 0
+}
+
+func (m *PaymentDetails) GetInFlightFeesMAtoms() int64 {
+	if m != nil {
+		return m.InFlightFeesMAtoms
+	}
+	return 0
+}
+
+func (m *PaymentDetails) GetRemainingFeeBudgetMAtoms() int64 {
+	if m != nil {
+		return m.RemainingFeeBudgetMAtoms
+	}
+	return 0
+}
+
+type XImportGraphResponse struct {
+	// / The number of nodes that were imported.
+	NumNodes uint32 `protobuf:"varint,1,opt,name=num_nodes,json=numNodes,proto3" json:"num_nodes,omitempty"`
+	// / The number of channels that were imported.
+	NumChannels          uint32   `protobuf:"varint,2,opt,name=num_channels,json=numChannels,proto3" json:"num_channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *XImportGraphResponse) Reset()         { *m = XImportGraphResponse{} }
+func (m *XImportGraphResponse) String() string { return proto.CompactTextString(m) }
+func (*XImportGraphResponse) ProtoMessage()    {}
+func (*XImportGraphResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_03cf04c818eeac84, []int{28}
+}
+func (m *XImportGraphResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_XImportGraphResponse.Unmarshal(m, b)
+}
+func (m *XImportGraphResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_XImportGraphResponse.Marshal(b, m, deterministic)
+}
+func (dst *XImportGraphResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_XImportGraphResponse.Merge(dst, src)
+}
+func (m *XImportGraphResponse) XXX_Size() int {
+	return xxx_messageInfo_XImportGraphResponse.Size(m)
+}
+func (m *XImportGraphResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_XImportGraphResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_XImportGraphResponse proto.InternalMessageInfo
+
+func (m *XImportGraphResponse) GetNumNodes() uint32 {
+	if m != nil {
+		return m.NumNodes
+	}
+	return 0
+}
+
+func (m *XImportGraphResponse) GetNumChannels() uint32 {
+	if m != nil {
+		return m.NumChannels
+	}
+	return 0
+}
+
+type SubscribeHtlcEventsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribeHtlcEventsRequest) Reset()         { *m = SubscribeHtlcEventsRequest{} }
+func (m *SubscribeHtlcEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeHtlcEventsRequest) ProtoMessage()    {}
+func (*SubscribeHtlcEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_03cf04c818eeac84, []int{29}
+}
+func (m *SubscribeHtlcEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeHtlcEventsRequest.Unmarshal(m, b)
+}
+func (m *SubscribeHtlcEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeHtlcEventsRequest.Marshal(b, m, deterministic)
+}
+func (dst *SubscribeHtlcEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeHtlcEventsRequest.Merge(dst, src)
+}
+func (m *SubscribeHtlcEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeHtlcEventsRequest.Size(m)
+}
+func (m *SubscribeHtlcEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeHtlcEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeHtlcEventsRequest proto.InternalMessageInfo
+
+// *
+// HtlcEvent contains the htlc event that was processed. These are served on a
+// best-effort basis; events are not persisted, delivery is not guaranteed
+// (in the event of a crash in the switch, forward events may be lost) and
+// some events may be replayed upon restart. Events consumed from this package
+// should be de-duplicated by the htlc's unique combination of incoming and
+// outgoing channel id and htlc id.
+type HtlcEvent struct {
+	// *
+	// The short channel id that the incoming htlc arrived at our node on. This
+	// value is zero for sends.
+	IncomingChannelId uint64 `protobuf:"varint,1,opt,name=incoming_channel_id,json=incomingChannelId,proto3" json:"incoming_channel_id,omitempty"`
+	// *
+	// The short channel id that the outgoing htlc left our node on. This value
+	// is zero for receives.
+	OutgoingChannelId uint64 `protobuf:"varint,2,opt,name=outgoing_channel_id,json=outgoingChannelId,proto3" json:"outgoing_channel_id,omitempty"`
+	// *
+	// Incoming id is the index of the incoming htlc in the incoming channel.
+	// This value is zero for sends.
+	IncomingHtlcId uint64 `protobuf:"varint,3,opt,name=incoming_htlc_id,json=incomingHtlcId,proto3" json:"incoming_htlc_id,omitempty"`
+	// *
+	// Outgoing id is the index of the outgoing htlc in the outgoing channel.
+	// This value is zero for receives, and for htlcs failed before being
+	// offered to the outgoing channel.
+	OutgoingHtlcId uint64 `protobuf:"varint,4,opt,name=outgoing_htlc_id,json=outgoingHtlcId,proto3" json:"outgoing_htlc_id,omitempty"`
+	// *
+	// The time in unix nanoseconds that the event occurred.
+	TimestampNs uint64 `protobuf:"varint,5,opt,name=timestamp_ns,json=timestampNs,proto3" json:"timestamp_ns,omitempty"`
+	// *
+	// The event type indicates whether the htlc was part of a send, receive or
+	// forward.
+	EventType HtlcEvent_EventType `protobuf:"varint,6,opt,name=event_type,json=eventType,proto3,enum=routerrpc.HtlcEvent_EventType" json:"event_type,omitempty"`
+	// *
+	// The htlc was offered to the outgoing channel. Exactly one of
+	// forward_event, forward_fail_event, settle_event and link_fail_event is
+	// set.
+	ForwardEvent *ForwardEvent `protobuf:"bytes,7,opt,name=forward_event,json=forwardEvent,proto3" json:"forward_event,omitempty"`
+	// / The htlc was failed by the downstream nodes.
+	ForwardFailEvent *ForwardFailEvent `protobuf:"bytes,8,opt,name=forward_fail_event,json=forwardFailEvent,proto3" json:"forward_fail_event,omitempty"`
+	// / The htlc was settled.
+	SettleEvent *SettleEvent `protobuf:"bytes,9,opt,name=settle_event,json=settleEvent,proto3" json:"settle_event,omitempty"`
+	// / The htlc was failed by one of our links.
+	LinkFailEvent        *LinkFailEvent `protobuf:"bytes,10,opt,name=link_fail_event,json=linkFailEvent,proto3" json:"link_fail_event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *HtlcEvent) Reset()         { *m = HtlcEvent{} }
+func (m *HtlcEvent) String() string { return proto.CompactTextString(m) }
+func (*HtlcEvent) ProtoMessage()    {}
+func (*HtlcEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_03cf04c818eeac84, []int{30}
+}
+func (m *HtlcEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HtlcEvent.Unmarshal(m, b)
+}
+func (m *HtlcEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HtlcEvent.Marshal(b, m, deterministic)
+}
+func (dst *HtlcEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HtlcEvent.Merge(dst, src)
+}
+func (m *HtlcEvent) XXX_Size() int {
+	return xxx_messageInfo_HtlcEvent.Size(m)
+}
+func (m *HtlcEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_HtlcEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HtlcEvent proto.InternalMessageInfo
+
+func (m *HtlcEvent) GetIncomingChannelId() uint64 {
+	if m != nil {
+		return m.IncomingChannelId
+	}
+	return 0
+}
+
+func (m *HtlcEvent) GetOutgoingChannelId() uint64 {
+	if m != nil {
+		return m.OutgoingChannelId
+	}
+	return 0
+}
+
+func (m *HtlcEvent) GetIncomingHtlcId() uint64 {
+	if m != nil {
+		return m.IncomingHtlcId
+	}
+	return 0
+}
+
+func (m *HtlcEvent) GetOutgoingHtlcId() uint64 {
+	if m != nil {
+		return m.OutgoingHtlcId
+	}
+	return 0
+}
+
+func (m *HtlcEvent) GetTimestampNs() uint64 {
+	if m != nil {
+		return m.TimestampNs
+	}
+	return 0
+}
+
+func (m *HtlcEvent) GetEventType() HtlcEvent_EventType {
+	if m != nil {
+		return m.EventType
+	}
+	return HtlcEvent_UNKNOWN
+}
+
+func (m *HtlcEvent) GetForwardEvent() *ForwardEvent {
+	if m != nil {
+		return m.ForwardEvent
+	}
+	return nil
+}
+
+func (m *HtlcEvent) GetForwardFailEvent() *ForwardFailEvent {
+	if m != nil {
+		return m.ForwardFailEvent
+	}
+	return nil
+}
+
+func (m *HtlcEvent) GetSettleEvent() *SettleEvent {
+	if m != nil {
+		return m.SettleEvent
+	}
+	return nil
+}
+
+func (m *HtlcEvent) GetLinkFailEvent() *LinkFailEvent {
+	if m != nil {
+		return m.LinkFailEvent
+	}
+	return nil
+}
+
+type HtlcInfo struct {
+	// / The timelock on the incoming htlc.
+	IncomingTimelock uint32 `protobuf:"varint,1,opt,name=incoming_timelock,json=incomingTimelock,proto3" json:"incoming_timelock,omitempty"`
+	// / The timelock on the outgoing htlc.
+	OutgoingTimelock uint32 `protobuf:"varint,2,opt,name=outgoing_timelock,json=outgoingTimelock,proto3" json:"outgoing_timelock,omitempty"`
+	// / The amount of the incoming htlc.
+	IncomingAmtMAtoms uint64 `protobuf:"varint,3,opt,name=incoming_amt_m_atoms,json=incomingAmtMAtoms,proto3" json:"incoming_amt_m_atoms,omitempty"`
+	// / The amount of the outgoing htlc.
+	OutgoingAmtMAtoms    uint64   `protobuf:"varint,4,opt,name=outgoing_amt_m_atoms,json=outgoingAmtMAtoms,proto3" json:"outgoing_amt_m_atoms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HtlcInfo) Reset()         { *m = HtlcInfo{} }
+func (m *HtlcInfo) String() string { return proto.CompactTextString(m) }
+func (*HtlcInfo) ProtoMessage()    {}
+func (*HtlcInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_03cf04c818eeac84, []int{31}
+}
+func (m *HtlcInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HtlcInfo.Unmarshal(m, b)
+}
+func (m *HtlcInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HtlcInfo.Marshal(b, m, deterministic)
+}
+func (dst *HtlcInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HtlcInfo.Merge(dst, src)
+}
+func (m *HtlcInfo) XXX_Size() int {
+	return xxx_messageInfo_HtlcInfo.Size(m)
+}
+func (m *HtlcInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_HtlcInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HtlcInfo proto.InternalMessageInfo
+
+func (m *HtlcInfo) GetIncomingTimelock() uint32 {
+	if m != nil {
+		return m.IncomingTimelock
+	}
+	return 0
+}
+
+func (m *HtlcInfo) GetOutgoingTimelock() uint32 {
+	if m != nil {
+		return m.OutgoingTimelock
+	}
+	return 0
+}
+
+func (m *HtlcInfo) GetIncomingAmtMAtoms() uint64 {
+	if m != nil {
+		return m.IncomingAmtMAtoms
+	}
+	return 0
+}
+
+func (m *HtlcInfo) GetOutgoingAmtMAtoms() uint64 {
+	if m != nil {
+		return m.OutgoingAmtMAtoms
+	}
+	return 0
+}
+
+type ForwardEvent struct {
+	// / Info contains details about the htlc that was forwarded.
+	Info                 *HtlcInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ForwardEvent) Reset()         { *m = ForwardEvent{} }
+func (m *ForwardEvent) String() string { return proto.CompactTextString(m) }
+func (*ForwardEvent) ProtoMessage()    {}
+func (*ForwardEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_03cf04c818eeac84, []int{32}
+}
+func (m *ForwardEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForwardEvent.Unmarshal(m, b)
+}
+func (m *ForwardEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForwardEvent.Marshal(b, m, deterministic)
+}
+func (dst *ForwardEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardEvent.Merge(dst, src)
+}
+func (m *ForwardEvent) XXX_Size() int {
+	return xxx_messageInfo_ForwardEvent.Size(m)
+}
+func (m *ForwardEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardEvent proto.InternalMessageInfo
+
+func (m *ForwardEvent) GetInfo() *HtlcInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+type ForwardFailEvent struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForwardFailEvent) Reset()         { *m = ForwardFailEvent{} }
+func (m *ForwardFailEvent) String() string { return proto.CompactTextString(m) }
+func (*ForwardFailEvent) ProtoMessage()    {}
+func (*ForwardFailEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_03cf04c818eeac84, []int{33}
+}
+func (m *ForwardFailEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForwardFailEvent.Unmarshal(m, b)
+}
+func (m *ForwardFailEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForwardFailEvent.Marshal(b, m, deterministic)
+}
+func (dst *ForwardFailEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardFailEvent.Merge(dst, src)
+}
+func (m *ForwardFailEvent) XXX_Size() int {
+	return xxx_messageInfo_ForwardFailEvent.Size(m)
+}
+func (m *ForwardFailEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardFailEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardFailEvent proto.InternalMessageInfo
+
+type SettleEvent struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SettleEvent) Reset()         { *m = SettleEvent{} }
+func (m *SettleEvent) String() string { return proto.CompactTextString(m) }
+func (*SettleEvent) ProtoMessage()    {}
+func (*SettleEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_03cf04c818eeac84, []int{34}
+}
+func (m *SettleEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SettleEvent.Unmarshal(m, b)
+}
+func (m *SettleEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SettleEvent.Marshal(b, m, deterministic)
+}
+func (dst *SettleEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettleEvent.Merge(dst, src)
+}
+func (m *SettleEvent) XXX_Size() int {
+	return xxx_messageInfo_SettleEvent.Size(m)
+}
+func (m *SettleEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettleEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettleEvent proto.InternalMessageInfo
+
+type LinkFailEvent struct {
+	// / Info contains details about the htlc that we failed.
+	Info *HtlcInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	// / The failure code sent back for the htlc.
+	WireFailure Failure_FailureCode `protobuf:"varint,2,opt,name=wire_failure,json=wireFailure,proto3,enum=routerrpc.Failure_FailureCode" json:"wire_failure,omitempty"`
+	// *
+	// A human readable description of the reason the htlc was failed, more
+	// detailed than the failure code.
+	FailureString string `protobuf:"bytes,3,opt,name=failure_string,json=failureString,proto3" json:"failure_string,omitempty"`
+	// *
+	// Whether the htlc was failed while processing the incoming htlc, rather
+	// than before offering it to the outgoing channel.
+	Incoming             bool     `protobuf:"varint,4,opt,name=incoming,proto3" json:"incoming,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LinkFailEvent) Reset()         { *m = LinkFailEvent{} }
+func (m *LinkFailEvent) String() string { return proto.CompactTextString(m) }
+func (*LinkFailEvent) ProtoMessage()    {}
+func (*LinkFailEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_03cf04c818eeac84, []int{35}
+}
+func (m *LinkFailEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LinkFailEvent.Unmarshal(m, b)
+}
+func (m *LinkFailEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LinkFailEvent.Marshal(b, m, deterministic)
+}
+func (dst *LinkFailEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LinkFailEvent.Merge(dst, src)
+}
+func (m *LinkFailEvent) XXX_Size() int {
+	return xxx_messageInfo_LinkFailEvent.Size(m)
+}
+func (m *LinkFailEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_LinkFailEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LinkFailEvent proto.InternalMessageInfo
+
+func (m *LinkFailEvent) GetInfo() *HtlcInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+func (m *LinkFailEvent) GetWireFailure() Failure_FailureCode {
+	if m != nil {
+		return m.WireFailure
+	}
+	return Failure_RESERVED
+}
+
+func (m *LinkFailEvent) GetFailureString() string {
+	if m != nil {
+		return m.FailureString
+	}
+	return ""
+}
+
+func (m *LinkFailEvent) GetIncoming() bool {
+	if m != nil {
+		return m.Incoming
+	}
+	return false
+}
+
+type UpdateChanStatusRequest struct {
+	// / The channel whose status is updated.
+	ChanPoint *lnrpc.ChannelPoint `protobuf:"bytes,1,opt,name=chan_point,json=chanPoint,proto3" json:"chan_point,omitempty"`
+	// / The status to set the channel to.
+	Action               ChanStatusAction `protobuf:"varint,2,opt,name=action,proto3,enum=routerrpc.ChanStatusAction" json:"action,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *UpdateChanStatusRequest) Reset()         { *m = UpdateChanStatusRequest{} }
+func (m *UpdateChanStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateChanStatusRequest) ProtoMessage()    {}
+func (*UpdateChanStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_03cf04c818eeac84, []int{36}
+}
+func (m *UpdateChanStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateChanStatusRequest.Unmarshal(m, b)
+}
+func (m *UpdateChanStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateChanStatusRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateChanStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateChanStatusRequest.Merge(dst, src)
+}
+func (m *UpdateChanStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateChanStatusRequest.Size(m)
+}
+func (m *UpdateChanStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateChanStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateChanStatusRequest proto.InternalMessageInfo
+
+func (m *UpdateChanStatusRequest) GetChanPoint() *lnrpc.ChannelPoint {
+	if m != nil {
+		return m.ChanPoint
+	}
+	return nil
+}
+
+func (m *UpdateChanStatusRequest) GetAction() ChanStatusAction {
+	if m != nil {
+		return m.Action
+	}
+	return ChanStatusAction_ENABLE
+}
+
+type UpdateChanStatusResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateChanStatusResponse) Reset()         { *m = UpdateChanStatusResponse{} }
+func (m *UpdateChanStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateChanStatusResponse) ProtoMessage()    {}
+func (*UpdateChanStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_03cf04c818eeac84, []int{37}
+}
+func (m *UpdateChanStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateChanStatusResponse.Unmarshal(m, b)
+}
+func (m *UpdateChanStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateChanStatusResponse.Marshal(b, m, deterministic)
+}
+func (dst *UpdateChanStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateChanStatusResponse.Merge(dst, src)
+}
+func (m *UpdateChanStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateChanStatusResponse.Size(m)
+}
+func (m *UpdateChanStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateChanStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateChanStatusResponse proto.InternalMessageInfo
+
+type EdgeScoreRequest struct {
+	// / The identifier of the request, to be included in its response.
+	RequestId uint64 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// / The node forwarding the payment over the channel.
+	FromNode []byte `protobuf:"bytes,2,opt,name=from_node,json=fromNode,proto3" json:"from_node,omitempty"`
+	// / The node receiving the payment over the channel.
+	ToNode []byte `protobuf:"bytes,3,opt,name=to_node,json=toNode,proto3" json:"to_node,omitempty"`
+	// / The short channel id of the channel.
+	ChanId uint64 `protobuf:"varint,4,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+	// / The amount the channel is expected to carry in milli-atoms.
+	AmtMAtoms            int64    `protobuf:"varint,5,opt,name=amt_m_atoms,json=amtMAtoms,proto3" json:"amt_m_atoms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EdgeScoreRequest) Reset()         { *m = EdgeScoreRequest{} }
+func (m *EdgeScoreRequest) String() string { return proto.CompactTextString(m) }
+func (*EdgeScoreRequest) ProtoMessage()    {}
+func (*EdgeScoreRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_03cf04c818eeac84, []int{38}
+}
+func (m *EdgeScoreRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EdgeScoreRequest.Unmarshal(m, b)
+}
+func (m *EdgeScoreRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EdgeScoreRequest.Marshal(b, m, deterministic)
+}
+func (dst *EdgeScoreRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EdgeScoreRequest.Merge(dst, src)
+}
+func (m *EdgeScoreRequest) XXX_Size() int {
+	return xxx_messageInfo_EdgeScoreRequest.Size(m)
+}
+func (m *EdgeScoreRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EdgeScoreRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EdgeScoreRequest proto.InternalMessageInfo
+
+func (m *EdgeScoreRequest) GetRequestId() uint64 {
+	if m != nil {
+		return m.RequestId
+	}
+	return 0
+}
+
+func (m *EdgeScoreRequest) GetFromNode() []byte {
+	if m != nil {
+		return m.FromNode
+	}
+	return nil
+}
+
+func (m *EdgeScoreRequest) GetToNode() []byte {
+	if m != nil {
+		return m.ToNode
+	}
+	return nil
+}
+
+func (m *EdgeScoreRequest) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *EdgeScoreRequest) GetAmtMAtoms() int64 {
+	if m != nil {
+		return m.AmtMAtoms
+	}
+	return 0
+}
+
+type EdgeScoreResponse struct {
+	// / The identifier of the request that is being answered.
+	RequestId uint64 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// *
+	// The additional path finding weight of the channel, expressed in
+	// milli-atoms like the fees it is weighed against. A negative value makes
+	// the channel more attractive.
+	PenaltyMAtoms        int64    `protobuf:"varint,2,opt,name=penalty_m_atoms,json=penaltyMAtoms,proto3" json:"penalty_m_atoms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EdgeScoreResponse) Reset()         { *m = EdgeScoreResponse{} }
+func (m *EdgeScoreResponse) String() string { return proto.CompactTextString(m) }
+func (*EdgeScoreResponse) ProtoMessage()    {}
+func (*EdgeScoreResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_03cf04c818eeac84, []int{39}
+}
+func (m *EdgeScoreResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EdgeScoreResponse.Unmarshal(m, b)
+}
+func (m *EdgeScoreResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EdgeScoreResponse.Marshal(b, m, deterministic)
+}
+func (dst *EdgeScoreResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EdgeScoreResponse.Merge(dst, src)
+}
+func (m *EdgeScoreResponse) XXX_Size() int {
+	return xxx_messageInfo_EdgeScoreResponse.Size(m)
+}
+func (m *EdgeScoreResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EdgeScoreResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EdgeScoreResponse proto.InternalMessageInfo
+
+func (m *EdgeScoreResponse) GetRequestId() uint64 {
+	if m != nil {
+		return m.RequestId
+	}
+	return 0
+}
+
+func (m *EdgeScoreResponse) GetPenaltyMAtoms() int64 {
+	if m != nil {
+		return m.PenaltyMAtoms
+	}
+	return 0
+}
+
+type LookupCircuitRequest struct {
+	// / The short channel id of the incoming channel of the htlc.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+	// / The index of the htlc in the incoming channel.
+	HtlcId               uint64   `protobuf:"varint,2,opt,name=htlc_id,json=htlcId,proto3" json:"htlc_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LookupCircuitRequest) Reset()         { *m = LookupCircuitRequest{} }
+func (m *LookupCircuitRequest) String() string { return proto.CompactTextString(m) }
+func (*LookupCircuitRequest) ProtoMessage()    {}
+func (*LookupCircuitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_03cf04c818eeac84, []int{40}
+}
+func (m *LookupCircuitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupCircuitRequest.Unmarshal(m, b)
+}
+func (m *LookupCircuitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupCircuitRequest.Marshal(b, m, deterministic)
+}
+func (dst *LookupCircuitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupCircuitRequest.Merge(dst, src)
+}
+func (m *LookupCircuitRequest) XXX_Size() int {
+	return xxx_messageInfo_LookupCircuitRequest.Size(m)
+}
+func (m *LookupCircuitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupCircuitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupCircuitRequest proto.InternalMessageInfo
+
+func (m *LookupCircuitRequest) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *LookupCircuitRequest) GetHtlcId() uint64 {
+	if m != nil {
+		return m.HtlcId
+	}
+	return 0
+}
+
+type LookupCircuitResponse struct {
+	// / The short channel id of the incoming channel of the htlc.
+	IncomingChanId uint64 `protobuf:"varint,1,opt,name=incoming_chan_id,json=incomingChanId,proto3" json:"incoming_chan_id,omitempty"`
+	// / The index of the htlc in the incoming channel.
+	IncomingHtlcId uint64 `protobuf:"varint,2,opt,name=incoming_htlc_id,json=incomingHtlcId,proto3" json:"incoming_htlc_id,omitempty"`
+	// *
+	// The short channel id of the outgoing channel of the htlc, only set once
+	// the circuit has been opened.
+	OutgoingChanId uint64 `protobuf:"varint,3,opt,name=outgoing_chan_id,json=outgoingChanId,proto3" json:"outgoing_chan_id,omitempty"`
+	// *
+	// The index of the htlc in the outgoing channel, only set once the circuit
+	// has been opened.
+	OutgoingHtlcId uint64 `protobuf:"varint,4,opt,name=outgoing_htlc_id,json=outgoingHtlcId,proto3" json:"outgoing_htlc_id,omitempty"`
+	// / The payment hash of the htlc.
+	PaymentHash []byte `protobuf:"bytes,5,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
+	// / The amount of the incoming htlc in milli-atoms.
+	IncomingAmtMAtoms int64 `protobuf:"varint,6,opt,name=incoming_amt_m_atoms,json=incomingAmtMAtoms,proto3" json:"incoming_amt_m_atoms,omitempty"`
+	// / The amount of the outgoing htlc in milli-atoms.
+	OutgoingAmtMAtoms int64 `protobuf:"varint,7,opt,name=outgoing_amt_m_atoms,json=outgoingAmtMAtoms,proto3" json:"outgoing_amt_m_atoms,omitempty"`
+	// / The state of the circuit.
+	State                CircuitState `protobuf:"varint,8,opt,name=state,proto3,enum=routerrpc.CircuitState" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *LookupCircuitResponse) Reset()         { *m = LookupCircuitResponse{} }
+func (m *LookupCircuitResponse) String() string { return proto.CompactTextString(m) }
+func (*LookupCircuitResponse) ProtoMessage()    {}
+func (*LookupCircuitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_03cf04c818eeac84, []int{41}
+}
+func (m *LookupCircuitResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupCircuitResponse.Unmarshal(m, b)
+}
+func (m *LookupCircuitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupCircuitResponse.Marshal(b, m, deterministic)
+}
+func (dst *LookupCircuitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupCircuitResponse.Merge(dst, src)
+}
+func (m *LookupCircuitResponse) XXX_Size() int {
+	return xxx_messageInfo_LookupCircuitResponse.Size(m)
+}
+func (m *LookupCircuitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupCircuitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupCircuitResponse proto.InternalMessageInfo
+
+func (m *LookupCircuitResponse) GetIncomingChanId() uint64 {
+	if m != nil {
+		return m.IncomingChanId
+	}
+	return 0
+}
+
+func (m *LookupCircuitResponse) GetIncomingHtlcId() uint64 {
+	if m != nil {
+		return m.IncomingHtlcId
+	}
+	return 0
+}
+
+func (m *LookupCircuitResponse) GetOutgoingChanId() uint64 {
+	if m != nil {
+		return m.OutgoingChanId
+	}
+	return 0
+}
+
+func (m *LookupCircuitResponse) GetOutgoingHtlcId() uint64 {
+	if m != nil {
+		return m.OutgoingHtlcId
+	}
+	return 0
+}
+
+func (m *LookupCircuitResponse) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
+}
+
+func (m *LookupCircuitResponse) GetIncomingAmtMAtoms() int64 {
+	if m != nil {
+		return m.IncomingAmtMAtoms
+	}
+	return 0
+}
+
+func (m *LookupCircuitResponse) GetOutgoingAmtMAtoms() int64 {
+	if m != nil {
+		return m.OutgoingAmtMAtoms
+	}
+	return 0
+}
+
+func (m *LookupCircuitResponse) GetState() CircuitState {
+	if m != nil {
+		return m.State
+	}
+	return CircuitState_PENDING
+}
+
+type FailCircuitRequest struct {
+	// / The short channel id of the incoming channel of the htlc.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+	// / The index of the htlc in the incoming channel.
+	HtlcId               uint64   `protobuf:"varint,2,opt,name=htlc_id,json=htlcId,proto3" json:"htlc_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FailCircuitRequest) Reset()         { *m = FailCircuitRequest{} }
+func (m *FailCircuitRequest) String() string { return proto.CompactTextString(m) }
+func (*FailCircuitRequest) ProtoMessage()    {}
+func (*FailCircuitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_03cf04c818eeac84, []int{42}
+}
+func (m *FailCircuitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FailCircuitRequest.Unmarshal(m, b)
+}
+func (m *FailCircuitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FailCircuitRequest.Marshal(b, m, deterministic)
+}
+func (dst *FailCircuitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailCircuitRequest.Merge(dst, src)
+}
+func (m *FailCircuitRequest) XXX_Size() int {
+	return xxx_messageInfo_FailCircuitRequest.Size(m)
+}
+func (m *FailCircuitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailCircuitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailCircuitRequest proto.InternalMessageInfo
+
+func (m *FailCircuitRequest) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *FailCircuitRequest) GetHtlcId() uint64 {
+	if m != nil {
+		return m.HtlcId
+	}
+	return 0
+}
+
+type FailCircuitResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FailCircuitResponse) Reset()         { *m = FailCircuitResponse{} }
+func (m *FailCircuitResponse) String() string { return proto.CompactTextString(m) }
+func (*FailCircuitResponse) ProtoMessage()    {}
+func (*FailCircuitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_03cf04c818eeac84, []int{43}
+}
+func (m *FailCircuitResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FailCircuitResponse.Unmarshal(m, b)
+}
+func (m *FailCircuitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FailCircuitResponse.Marshal(b, m, deterministic)
+}
+func (dst *FailCircuitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailCircuitResponse.Merge(dst, src)
+}
+func (m *FailCircuitResponse) XXX_Size() int {
+	return xxx_messageInfo_FailCircuitResponse.Size(m)
+}
+func (m *FailCircuitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailCircuitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailCircuitResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*SendPaymentRequest)(nil), "routerrpc.SendPaymentRequest")
+	proto.RegisterMapType((map[uint64][]byte)(nil), "routerrpc.SendPaymentRequest.DestTlvEntry")
+	proto.RegisterType((*TrackPaymentRequest)(nil), "routerrpc.TrackPaymentRequest")
+	proto.RegisterType((*PaymentStatus)(nil), "routerrpc.PaymentStatus")
+	proto.RegisterType((*RouteFeeRequest)(nil), "routerrpc.RouteFeeRequest")
+	proto.RegisterType((*RouteFeeResponse)(nil), "routerrpc.RouteFeeResponse")
+	proto.RegisterType((*SendToRouteRequest)(nil), "routerrpc.SendToRouteRequest")
+	proto.RegisterType((*SendToRouteResponse)(nil), "routerrpc.SendToRouteResponse")
+	proto.RegisterType((*Failure)(nil), "routerrpc.Failure")
+	proto.RegisterType((*ChannelUpdate)(nil), "routerrpc.ChannelUpdate")
+	proto.RegisterType((*ResetMissionControlRequest)(nil), "routerrpc.ResetMissionControlRequest")
+	proto.RegisterType((*ResetMissionControlResponse)(nil), "routerrpc.ResetMissionControlResponse")
+	proto.RegisterType((*QueryMissionControlRequest)(nil), "routerrpc.QueryMissionControlRequest")
+	proto.RegisterType((*QueryMissionControlResponse)(nil), "routerrpc.QueryMissionControlResponse")
+	proto.RegisterType((*NodeHistory)(nil), "routerrpc.NodeHistory")
+	proto.RegisterType((*PairHistory)(nil), "routerrpc.PairHistory")
+	proto.RegisterType((*BuildRouteRequest)(nil), "routerrpc.BuildRouteRequest")
+	proto.RegisterType((*BuildRouteResponse)(nil), "routerrpc.BuildRouteResponse")
+	proto.RegisterType((*CancelPaymentRequest)(nil), "routerrpc.CancelPaymentRequest")
+	proto.RegisterType((*CancelPaymentResponse)(nil), "routerrpc.CancelPaymentResponse")
+	proto.RegisterType((*UpdateAvoidListRequest)(nil), "routerrpc.UpdateAvoidListRequest")
+	proto.RegisterType((*UpdateAvoidListResponse)(nil), "routerrpc.UpdateAvoidListResponse")
+	proto.RegisterType((*QueryAvoidListRequest)(nil), "routerrpc.QueryAvoidListRequest")
+	proto.RegisterType((*QueryAvoidListResponse)(nil), "routerrpc.QueryAvoidListResponse")
+	proto.RegisterType((*QueryPaymentMetricsRequest)(nil), "routerrpc.QueryPaymentMetricsRequest")
+	proto.RegisterType((*DestinationMetrics)(nil), "routerrpc.DestinationMetrics")
+	proto.RegisterType((*QueryPaymentMetricsResponse)(nil), "routerrpc.QueryPaymentMetricsResponse")
+	proto.RegisterType((*HTLCAttempt)(nil), "routerrpc.HTLCAttempt")
+	proto.RegisterType((*PaymentDetails)(nil), "routerrpc.PaymentDetails")
+	proto.RegisterType((*XImportGraphResponse)(nil), "routerrpc.XImportGraphResponse")
+	proto.RegisterType((*SubscribeHtlcEventsRequest)(nil), "routerrpc.SubscribeHtlcEventsRequest")
+	proto.RegisterType((*HtlcEvent)(nil), "routerrpc.HtlcEvent")
+	proto.RegisterType((*HtlcInfo)(nil), "routerrpc.HtlcInfo")
+	proto.RegisterType((*ForwardEvent)(nil), "routerrpc.ForwardEvent")
+	proto.RegisterType((*ForwardFailEvent)(nil), "routerrpc.ForwardFailEvent")
+	proto.RegisterType((*SettleEvent)(nil), "routerrpc.SettleEvent")
+	proto.RegisterType((*LinkFailEvent)(nil), "routerrpc.LinkFailEvent")
+	proto.RegisterType((*UpdateChanStatusRequest)(nil), "routerrpc.UpdateChanStatusRequest")
+	proto.RegisterType((*UpdateChanStatusResponse)(nil), "routerrpc.UpdateChanStatusResponse")
+	proto.RegisterType((*EdgeScoreRequest)(nil), "routerrpc.EdgeScoreRequest")
+	proto.RegisterType((*EdgeScoreResponse)(nil), "routerrpc.EdgeScoreResponse")
+	proto.RegisterType((*LookupCircuitRequest)(nil), "routerrpc.LookupCircuitRequest")
+	proto.RegisterType((*LookupCircuitResponse)(nil), "routerrpc.LookupCircuitResponse")
+	proto.RegisterType((*FailCircuitRequest)(nil), "routerrpc.FailCircuitRequest")
+	proto.RegisterType((*FailCircuitResponse)(nil), "routerrpc.FailCircuitResponse")
+	proto.RegisterEnum("routerrpc.PaymentState", PaymentState_name, PaymentState_value)
+	proto.RegisterEnum("routerrpc.ChanStatusAction", ChanStatusAction_name, ChanStatusAction_value)
+	proto.RegisterEnum("routerrpc.CircuitState", CircuitState_name, CircuitState_value)
+	proto.RegisterEnum("routerrpc.Failure_FailureCode", Failure_FailureCode_name, Failure_FailureCode_value)
+	proto.RegisterEnum("routerrpc.HTLCAttempt_HTLCStatus", HTLCAttempt_HTLCStatus_name, HTLCAttempt_HTLCStatus_value)
+	proto.RegisterEnum("routerrpc.HtlcEvent_EventType", HtlcEvent_EventType_name, HtlcEvent_EventType_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RouterClient is the client API for Router service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RouterClient interface {
+	// *
+	// SendPayment attempts to route a payment described by the passed
+	// PaymentRequest to the final destination. The call returns a stream of
+	// payment status updates.
+	SendPayment(ctx context.Context, in *SendPaymentRequest, opts ...grpc.CallOption) (Router_SendPaymentClient, error)
+	// *
+	// TrackPayment returns an update stream for the payment identified by the
+	// payment hash.
+	TrackPayment(ctx context.Context, in *TrackPaymentRequest, opts ...grpc.CallOption) (Router_TrackPaymentClient, error)
+	// *
+	// EstimateRouteFee allows callers to obtain a lower bound w.r.t how much it
+	// may cost to send an HTLC to the target end destination.
+	EstimateRouteFee(ctx context.Context, in *RouteFeeRequest, opts ...grpc.CallOption) (*RouteFeeResponse, error)
+	// *
+	// SendToRoute attempts to make a payment via the specified route. This method
+	// differs from SendPayment in that it allows users to specify a full route
+	// manually. This can be used for things like rebalancing, and atomic swaps.
+	SendToRoute(ctx context.Context, in *SendToRouteRequest, opts ...grpc.CallOption) (*SendToRouteResponse, error)
+	// *
+	// ResetMissionControl clears all mission control state and starts with a clean
+	// slate. Optionally, only the results older than a given age are cleared.
+	ResetMissionControl(ctx context.Context, in *ResetMissionControlRequest, opts ...grpc.CallOption) (*ResetMissionControlResponse, error)
+	// *
+	// QueryMissionControl exposes the internal mission control state to callers.
+	// It is a development feature.
+	QueryMissionControl(ctx context.Context, in *QueryMissionControlRequest, opts ...grpc.CallOption) (*QueryMissionControlResponse, error)
+	// *
+	// BuildRoute builds a fully specified route based on a list of hop public
+	// keys. It retrieves the relevant channel policies from the graph in order to
+	// calculate the correct fees and time locks.
+	BuildRoute(ctx context.Context, in *BuildRouteRequest, opts ...grpc.CallOption) (*BuildRouteResponse, error)
+	// *
+	// AddToAvoidList adds nodes and channels to the persistent avoid list. Path
+	// finding never uses nodes or channels on the avoid list, regardless of their
+	// mission control history.
+	AddToAvoidList(ctx context.Context, in *UpdateAvoidListRequest, opts ...grpc.CallOption) (*UpdateAvoidListResponse, error)
+	// *
+	// RemoveFromAvoidList removes nodes and channels from the persistent avoid
+	// list.
+	RemoveFromAvoidList(ctx context.Context, in *UpdateAvoidListRequest, opts ...grpc.CallOption) (*UpdateAvoidListResponse, error)
+	// *
+	// QueryAvoidList returns all nodes and channels currently on the avoid list.
+	QueryAvoidList(ctx context.Context, in *QueryAvoidListRequest, opts ...grpc.CallOption) (*QueryAvoidListResponse, error)
+	// *
+	// CancelPayment stops launching new attempts for an in-flight payment. If an
+	// htlc of the payment is still outstanding, its resolution is awaited. The
+	// terminal state of the payment can be followed through TrackPayment.
+	CancelPayment(ctx context.Context, in *CancelPaymentRequest, opts ...grpc.CallOption) (*CancelPaymentResponse, error)
+	// *
+	// QueryPaymentMetrics returns the success rates and attempt latencies of the
+	// recent payments, aggregated per destination. This allows applications that
+	// regularly pay the same destinations to monitor how reliably they are
+	// reached.
+	QueryPaymentMetrics(ctx context.Context, in *QueryPaymentMetricsRequest, opts ...grpc.CallOption) (*QueryPaymentMetricsResponse, error)
+	// *
+	// TrackPaymentV2 returns an update stream for the payment identified by the
+	// payment hash. Unlike TrackPayment, every update carries the details of all
+	// htlc attempts of the payment: the route taken, the attempt and resolve
+	// times and the failure, if any. An update is sent right away, and again
+	// each time an htlc is sent or resolved, until the payment completes.
+	TrackPaymentV2(ctx context.Context, in *TrackPaymentRequest, opts ...grpc.CallOption) (Router_TrackPaymentV2Client, error)
+	// *
+	// XImportGraph imports a channel graph, in the format returned by
+	// DescribeGraph, directly into the graph database, without validating the
+	// signatures or the funding outputs of the channels. It allows simulations
+	// and benchmarks of path finding to construct large synthetic graphs
+	// quickly, and is only available on simnet and regnet.
+	XImportGraph(ctx context.Context, in *lnrpc.ChannelGraph, opts ...grpc.CallOption) (*XImportGraphResponse, error)
+	// *
+	// SubscribeHtlcEvents creates a uni-directional stream from the server to
+	// the client which delivers a stream of htlc events: forwards, settles and
+	// failures of the htlcs sent, received and forwarded by the node, along
+	// with the htlcs our links refused to handle.
+	SubscribeHtlcEvents(ctx context.Context, in *SubscribeHtlcEventsRequest, opts ...grpc.CallOption) (Router_SubscribeHtlcEventsClient, error)
+	// *
+	// UpdateChanStatus manually sets the status of a channel in the channel
+	// updates we send out, overriding the automatic disabling of inactive
+	// channels, e.g. during a planned maintenance of its peer, until the
+	// status is given back to the automatic handling.
+	UpdateChanStatus(ctx context.Context, in *UpdateChanStatusRequest, opts ...grpc.CallOption) (*UpdateChanStatusResponse, error)
+	// *
+	// RegisterEdgeScorer registers the caller as an external edge score
+	// provider. During path finding, an EdgeScoreRequest is sent over the stream
+	// for each channel whose score isn't cached yet. The caller is expected to
+	// answer with an EdgeScoreResponse, whose penalty is added to the weight of
+	// the channel. Requests that aren't answered within the latency budget of
+	// the path finding query leave the channel unpenalized. Only a single
+	// provider can be registered at a time.
+	RegisterEdgeScorer(ctx context.Context, opts ...grpc.CallOption) (Router_RegisterEdgeScorerClient, error)
+	// *
+	// LookupCircuit returns the circuit the switch holds for the htlc identified
+	// by its incoming channel and htlc index, along with its state.
+	LookupCircuit(ctx context.Context, in *LookupCircuitRequest, opts ...grpc.CallOption) (*LookupCircuitResponse, error)
+	// *
+	// FailCircuit fails back the htlc of a circuit to its incoming channel,
+	// regardless of the state of the outgoing htlc. It is meant to recover from
+	// stuck circuits without closing the incoming channel, and must be used with
+	// care: if the outgoing htlc is settled afterwards, its amount is lost.
+	FailCircuit(ctx context.Context, in *FailCircuitRequest, opts ...grpc.CallOption) (*FailCircuitResponse, error)
+}
+
+type routerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRouterClient(cc *grpc.ClientConn) RouterClient {
+	return &routerClient{cc}
+}
+
+func (c *routerClient) SendPayment(ctx context.Context, in *SendPaymentRequest, opts ...grpc.CallOption) (Router_SendPaymentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Router_serviceDesc.Streams[0], "/routerrpc.Router/SendPayment", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &routerSendPaymentClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Router_SendPaymentClient interface {
+	Recv() (*PaymentStatus, error)
+	grpc.ClientStream
+}
+
+type routerSendPaymentClient struct {
+	grpc.ClientStream
+}
+
+func (x *routerSendPaymentClient) Recv() (*PaymentStatus, error) {
+	m := new(PaymentStatus)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *routerClient) TrackPayment(ctx context.Context, in *TrackPaymentRequest, opts ...grpc.CallOption) (Router_TrackPaymentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Router_serviceDesc.Streams[1], "/routerrpc.Router/TrackPayment", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &routerTrackPaymentClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Router_TrackPaymentClient interface {
+	Recv() (*PaymentStatus, error)
+	grpc.ClientStream
 }
 
 type routerTrackPaymentClient struct {
 	grpc.ClientStream
 }
 
-func (x *routerTrackPaymentClient) Recv() (*PaymentStatus, error) {
-	m := new(PaymentStatus)
+func (x *routerTrackPaymentClient) Recv() (*PaymentStatus, error) {
+	m := new(PaymentStatus)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *routerClient) EstimateRouteFee(ctx context.Context, in *RouteFeeRequest, opts ...grpc.CallOption) (*RouteFeeResponse, error) {
+	out := new(RouteFeeResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/EstimateRouteFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) SendToRoute(ctx context.Context, in *SendToRouteRequest, opts ...grpc.CallOption) (*SendToRouteResponse, error) {
+	out := new(SendToRouteResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/SendToRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) ResetMissionControl(ctx context.Context, in *ResetMissionControlRequest, opts ...grpc.CallOption) (*ResetMissionControlResponse, error) {
+	out := new(ResetMissionControlResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/ResetMissionControl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) QueryMissionControl(ctx context.Context, in *QueryMissionControlRequest, opts ...grpc.CallOption) (*QueryMissionControlResponse, error) {
+	out := new(QueryMissionControlResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/QueryMissionControl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) BuildRoute(ctx context.Context, in *BuildRouteRequest, opts ...grpc.CallOption) (*BuildRouteResponse, error) {
+	out := new(BuildRouteResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/BuildRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) AddToAvoidList(ctx context.Context, in *UpdateAvoidListRequest, opts ...grpc.CallOption) (*UpdateAvoidListResponse, error) {
+	out := new(UpdateAvoidListResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/AddToAvoidList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) RemoveFromAvoidList(ctx context.Context, in *UpdateAvoidListRequest, opts ...grpc.CallOption) (*UpdateAvoidListResponse, error) {
+	out := new(UpdateAvoidListResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/RemoveFromAvoidList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) QueryAvoidList(ctx context.Context, in *QueryAvoidListRequest, opts ...grpc.CallOption) (*QueryAvoidListResponse, error) {
+	out := new(QueryAvoidListResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/QueryAvoidList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) CancelPayment(ctx context.Context, in *CancelPaymentRequest, opts ...grpc.CallOption) (*CancelPaymentResponse, error) {
+	out := new(CancelPaymentResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/CancelPayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) QueryPaymentMetrics(ctx context.Context, in *QueryPaymentMetricsRequest, opts ...grpc.CallOption) (*QueryPaymentMetricsResponse, error) {
+	out := new(QueryPaymentMetricsResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/QueryPaymentMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) TrackPaymentV2(ctx context.Context, in *TrackPaymentRequest, opts ...grpc.CallOption) (Router_TrackPaymentV2Client, error) {
+	stream, err := c.cc.NewStream(ctx, &_Router_serviceDesc.Streams[2], "/routerrpc.Router/TrackPaymentV2", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &routerTrackPaymentV2Client{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Router_TrackPaymentV2Client interface {
+	Recv() (*PaymentDetails, error)
+	grpc.ClientStream
+}
+
+type routerTrackPaymentV2Client struct {
+	grpc.ClientStream
+}
+
+func (x *routerTrackPaymentV2Client) Recv() (*PaymentDetails, error) {
+	m := new(PaymentDetails)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *routerClient) XImportGraph(ctx context.Context, in *lnrpc.ChannelGraph, opts ...grpc.CallOption) (*XImportGraphResponse, error) {
+	out := new(XImportGraphResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/XImportGraph", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) SubscribeHtlcEvents(ctx context.Context, in *SubscribeHtlcEventsRequest, opts ...grpc.CallOption) (Router_SubscribeHtlcEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Router_serviceDesc.Streams[3], "/routerrpc.Router/SubscribeHtlcEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &routerSubscribeHtlcEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Router_SubscribeHtlcEventsClient interface {
+	Recv() (*HtlcEvent, error)
+	grpc.ClientStream
+}
+
+type routerSubscribeHtlcEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *routerSubscribeHtlcEventsClient) Recv() (*HtlcEvent, error) {
+	m := new(HtlcEvent)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *routerClient) EstimateRouteFee(ctx context.Context, in *RouteFeeRequest, opts ...grpc.CallOption) (*RouteFeeResponse, error) {
-	out := new(RouteFeeResponse)
-	err := c.cc.Invoke(ctx, "/routerrpc.Router/EstimateRouteFee", in, out, opts...)
+func (c *routerClient) UpdateChanStatus(ctx context.Context, in *UpdateChanStatusRequest, opts ...grpc.CallOption) (*UpdateChanStatusResponse, error) {
+	out := new(UpdateChanStatusResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/UpdateChanStatus", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *routerClient) SendToRoute(ctx context.Context, in *SendToRouteRequest, opts ...grpc.CallOption) (*SendToRouteResponse, error) {
-	out := new(SendToRouteResponse)
-	err := c.cc.Invoke(ctx, "/routerrpc.Router/SendToRoute", in, out, opts...)
+func (c *routerClient) RegisterEdgeScorer(ctx context.Context, opts ...grpc.CallOption) (Router_RegisterEdgeScorerClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Router_serviceDesc.Streams[4], "/routerrpc.Router/RegisterEdgeScorer", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &routerRegisterEdgeScorerClient{stream}
+	return x, nil
 }
 
-func (c *routerClient) ResetMissionControl(ctx context.Context, in *ResetMissionControlRequest, opts ...grpc.CallOption) (*ResetMissionControlResponse, error) {
-	out := new(ResetMissionControlResponse)
-	err := c.cc.Invoke(ctx, "/routerrpc.Router/ResetMissionControl", in, out, opts...)
-	if err != nil {
+type Router_RegisterEdgeScorerClient interface {
+	Send(*EdgeScoreResponse) error
+	Recv() (*EdgeScoreRequest, error)
+	grpc.ClientStream
+}
+
+type routerRegisterEdgeScorerClient struct {
+	grpc.ClientStream
+}
+
+func (x *routerRegisterEdgeScorerClient) Send(m *EdgeScoreResponse) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *routerRegisterEdgeScorerClient) Recv() (*EdgeScoreRequest, error) {
+	m := new(EdgeScoreRequest)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
-func (c *routerClient) QueryMissionControl(ctx context.Context, in *QueryMissionControlRequest, opts ...grpc.CallOption) (*QueryMissionControlResponse, error) {
-	out := new(QueryMissionControlResponse)
-	err := c.cc.Invoke(ctx, "/routerrpc.Router/QueryMissionControl", in, out, opts...)
+func (c *routerClient) LookupCircuit(ctx context.Context, in *LookupCircuitRequest, opts ...grpc.CallOption) (*LookupCircuitResponse, error) {
+	out := new(LookupCircuitResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/LookupCircuit", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *routerClient) BuildRoute(ctx context.Context, in *BuildRouteRequest, opts ...grpc.CallOption) (*BuildRouteResponse, error) {
-	out := new(BuildRouteResponse)
-	err := c.cc.Invoke(ctx, "/routerrpc.Router/BuildRoute", in, out, opts...)
+func (c *routerClient) FailCircuit(ctx context.Context, in *FailCircuitRequest, opts ...grpc.CallOption) (*FailCircuitResponse, error) {
+	out := new(FailCircuitResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/FailCircuit", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1504,7 +3578,7 @@ type RouterServer interface {
 	SendToRoute(context.Context, *SendToRouteRequest) (*SendToRouteResponse, error)
 	// *
 	// ResetMissionControl clears all mission control state and starts with a clean
-	// slate.
+	// slate. Optionally, only the results older than a given age are cleared.
 	ResetMissionControl(context.Context, *ResetMissionControlRequest) (*ResetMissionControlResponse, error)
 	// *
 	// QueryMissionControl exposes the internal mission control state to callers.
@@ -1515,6 +3589,74 @@ type RouterServer interface {
 	// keys. It retrieves the relevant channel policies from the graph in order to
 	// calculate the correct fees and time locks.
 	BuildRoute(context.Context, *BuildRouteRequest) (*BuildRouteResponse, error)
+	// *
+	// AddToAvoidList adds nodes and channels to the persistent avoid list. Path
+	// finding never uses nodes or channels on the avoid list, regardless of their
+	// mission control history.
+	AddToAvoidList(context.Context, *UpdateAvoidListRequest) (*UpdateAvoidListResponse, error)
+	// *
+	// RemoveFromAvoidList removes nodes and channels from the persistent avoid
+	// list.
+	RemoveFromAvoidList(context.Context, *UpdateAvoidListRequest) (*UpdateAvoidListResponse, error)
+	// *
+	// QueryAvoidList returns all nodes and channels currently on the avoid list.
+	QueryAvoidList(context.Context, *QueryAvoidListRequest) (*QueryAvoidListResponse, error)
+	// *
+	// CancelPayment stops launching new attempts for an in-flight payment. If an
+	// htlc of the payment is still outstanding, its resolution is awaited. The
+	// terminal state of the payment can be followed through TrackPayment.
+	CancelPayment(context.Context, *CancelPaymentRequest) (*CancelPaymentResponse, error)
+	// *
+	// QueryPaymentMetrics returns the success rates and attempt latencies of the
+	// recent payments, aggregated per destination. This allows applications that
+	// regularly pay the same destinations to monitor how reliably they are
+	// reached.
+	QueryPaymentMetrics(context.Context, *QueryPaymentMetricsRequest) (*QueryPaymentMetricsResponse, error)
+	// *
+	// TrackPaymentV2 returns an update stream for the payment identified by the
+	// payment hash. Unlike TrackPayment, every update carries the details of all
+	// htlc attempts of the payment: the route taken, the attempt and resolve
+	// times and the failure, if any. An update is sent right away, and again
+	// each time an htlc is sent or resolved, until the payment completes.
+	TrackPaymentV2(*TrackPaymentRequest, Router_TrackPaymentV2Server) error
+	// *
+	// XImportGraph imports a channel graph, in the format returned by
+	// DescribeGraph, directly into the graph database, without validating the
+	// signatures or the funding outputs of the channels. It allows simulations
+	// and benchmarks of path finding to construct large synthetic graphs
+	// quickly, and is only available on simnet and regnet.
+	XImportGraph(context.Context, *lnrpc.ChannelGraph) (*XImportGraphResponse, error)
+	// *
+	// SubscribeHtlcEvents creates a uni-directional stream from the server to
+	// the client which delivers a stream of htlc events: forwards, settles and
+	// failures of the htlcs sent, received and forwarded by the node, along
+	// with the htlcs our links refused to handle.
+	SubscribeHtlcEvents(*SubscribeHtlcEventsRequest, Router_SubscribeHtlcEventsServer) error
+	// *
+	// UpdateChanStatus manually sets the status of a channel in the channel
+	// updates we send out, overriding the automatic disabling of inactive
+	// channels, e.g. during a planned maintenance of its peer, until the
+	// status is given back to the automatic handling.
+	UpdateChanStatus(context.Context, *UpdateChanStatusRequest) (*UpdateChanStatusResponse, error)
+	// *
+	// RegisterEdgeScorer registers the caller as an external edge score
+	// provider. During path finding, an EdgeScoreRequest is sent over the stream
+	// for each channel whose score isn't cached yet. The caller is expected to
+	// answer with an EdgeScoreResponse, whose penalty is added to the weight of
+	// the channel. Requests that aren't answered within the latency budget of
+	// the path finding query leave the channel unpenalized. Only a single
+	// provider can be registered at a time.
+	RegisterEdgeScorer(Router_RegisterEdgeScorerServer) error
+	// *
+	// LookupCircuit returns the circuit the switch holds for the htlc identified
+	// by its incoming channel and htlc index, along with its state.
+	LookupCircuit(context.Context, *LookupCircuitRequest) (*LookupCircuitResponse, error)
+	// *
+	// FailCircuit fails back the htlc of a circuit to its incoming channel,
+	// regardless of the state of the outgoing htlc. It is meant to recover from
+	// stuck circuits without closing the incoming channel, and must be used with
+	// care: if the outgoing htlc is settled afterwards, its amount is lost.
+	FailCircuit(context.Context, *FailCircuitRequest) (*FailCircuitResponse, error)
 }
 
 func RegisterRouterServer(s *grpc.Server, srv RouterServer) {
@@ -1653,6 +3795,236 @@ func _Router_BuildRoute_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Router_AddToAvoidList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAvoidListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).AddToAvoidList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/AddToAvoidList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).AddToAvoidList(ctx, req.(*UpdateAvoidListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_RemoveFromAvoidList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAvoidListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).RemoveFromAvoidList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/RemoveFromAvoidList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).RemoveFromAvoidList(ctx, req.(*UpdateAvoidListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_QueryAvoidList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAvoidListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).QueryAvoidList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/QueryAvoidList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).QueryAvoidList(ctx, req.(*QueryAvoidListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_CancelPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).CancelPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/CancelPayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).CancelPayment(ctx, req.(*CancelPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_QueryPaymentMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).QueryPaymentMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/QueryPaymentMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).QueryPaymentMetrics(ctx, req.(*QueryPaymentMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_TrackPaymentV2_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TrackPaymentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RouterServer).TrackPaymentV2(m, &routerTrackPaymentV2Server{stream})
+}
+
+type Router_TrackPaymentV2Server interface {
+	Send(*PaymentDetails) error
+	grpc.ServerStream
+}
+
+type routerTrackPaymentV2Server struct {
+	grpc.ServerStream
+}
+
+func (x *routerTrackPaymentV2Server) Send(m *PaymentDetails) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Router_XImportGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(lnrpc.ChannelGraph)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).XImportGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/XImportGraph",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).XImportGraph(ctx, req.(*lnrpc.ChannelGraph))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_SubscribeHtlcEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeHtlcEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RouterServer).SubscribeHtlcEvents(m, &routerSubscribeHtlcEventsServer{stream})
+}
+
+type Router_SubscribeHtlcEventsServer interface {
+	Send(*HtlcEvent) error
+	grpc.ServerStream
+}
+
+type routerSubscribeHtlcEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *routerSubscribeHtlcEventsServer) Send(m *HtlcEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Router_UpdateChanStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChanStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).UpdateChanStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/UpdateChanStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).UpdateChanStatus(ctx, req.(*UpdateChanStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_RegisterEdgeScorer_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RouterServer).RegisterEdgeScorer(&routerRegisterEdgeScorerServer{stream})
+}
+
+type Router_RegisterEdgeScorerServer interface {
+	Send(*EdgeScoreRequest) error
+	Recv() (*EdgeScoreResponse, error)
+	grpc.ServerStream
+}
+
+type routerRegisterEdgeScorerServer struct {
+	grpc.ServerStream
+}
+
+func (x *routerRegisterEdgeScorerServer) Send(m *EdgeScoreRequest) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *routerRegisterEdgeScorerServer) Recv() (*EdgeScoreResponse, error) {
+	m := new(EdgeScoreResponse)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Router_LookupCircuit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupCircuitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).LookupCircuit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/LookupCircuit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).LookupCircuit(ctx, req.(*LookupCircuitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_FailCircuit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FailCircuitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).FailCircuit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/FailCircuit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).FailCircuit(ctx, req.(*FailCircuitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Router_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "routerrpc.Router",
 	HandlerType: (*RouterServer)(nil),
@@ -1677,6 +4049,42 @@ var _Router_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BuildRoute",
 			Handler:    _Router_BuildRoute_Handler,
 		},
+		{
+			MethodName: "AddToAvoidList",
+			Handler:    _Router_AddToAvoidList_Handler,
+		},
+		{
+			MethodName: "RemoveFromAvoidList",
+			Handler:    _Router_RemoveFromAvoidList_Handler,
+		},
+		{
+			MethodName: "QueryAvoidList",
+			Handler:    _Router_QueryAvoidList_Handler,
+		},
+		{
+			MethodName: "CancelPayment",
+			Handler:    _Router_CancelPayment_Handler,
+		},
+		{
+			MethodName: "QueryPaymentMetrics",
+			Handler:    _Router_QueryPaymentMetrics_Handler,
+		},
+		{
+			MethodName: "XImportGraph",
+			Handler:    _Router_XImportGraph_Handler,
+		},
+		{
+			MethodName: "UpdateChanStatus",
+			Handler:    _Router_UpdateChanStatus_Handler,
+		},
+		{
+			MethodName: "LookupCircuit",
+			Handler:    _Router_LookupCircuit_Handler,
+		},
+		{
+			MethodName: "FailCircuit",
+			Handler:    _Router_FailCircuit_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1689,129 +4097,257 @@ var _Router_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Router_TrackPayment_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "TrackPaymentV2",
+			Handler:       _Router_TrackPaymentV2_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeHtlcEvents",
+			Handler:       _Router_SubscribeHtlcEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RegisterEdgeScorer",
+			Handler:       _Router_RegisterEdgeScorer_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "routerrpc/router.proto",
 }
 
-func init() { proto.RegisterFile("routerrpc/router.proto", fileDescriptor_router_bf5805918396094e) }
-
-var fileDescriptor_router_bf5805918396094e = []byte{
-	// 1868 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x58, 0xdd, 0x72, 0x1a, 0xc9,
-	0x15, 0x5e, 0x04, 0x08, 0x38, 0x80, 0x34, 0x6a, 0x69, 0x65, 0x8c, 0x2d, 0x5b, 0xcb, 0x6e, 0xbc,
-	0x94, 0x6b, 0x23, 0x6d, 0x29, 0xb5, 0x2e, 0xd7, 0xde, 0xa4, 0x10, 0x34, 0xab, 0xb1, 0x61, 0xd0,
-	0x36, 0xe0, 0x5d, 0x27, 0x17, 0x5d, 0x2d, 0xa6, 0x25, 0xa6, 0x3c, 0x3f, 0xec, 0x4c, 0xa3, 0xb2,
-	0x72, 0x91, 0xaa, 0x54, 0xae, 0xf3, 0x1e, 0xb9, 0xc8, 0x0b, 0xe4, 0x2d, 0xf2, 0x14, 0xc9, 0x33,
-	0x24, 0x57, 0xa9, 0xee, 0x9e, 0x81, 0x01, 0x21, 0x27, 0x57, 0xa2, 0xbf, 0x73, 0xba, 0xfb, 0xf4,
-	0xf9, 0xf9, 0xce, 0x19, 0xc1, 0x61, 0x18, 0xcc, 0x05, 0x0f, 0xc3, 0xd9, 0xe4, 0x54, 0xff, 0x3a,
-	0x99, 0x85, 0x81, 0x08, 0x50, 0x69, 0x81, 0xd7, 0x4b, 0xe1, 0x6c, 0xa2, 0xd1, 0xc6, 0x9f, 0x72,
-	0x80, 0x86, 0xdc, 0xb7, 0x2f, 0xd9, 0x9d, 0xc7, 0x7d, 0x41, 0xf8, 0x2f, 0x73, 0x1e, 0x09, 0x84,
-	0x20, 0x67, 0xf3, 0x48, 0xd4, 0x32, 0xc7, 0x99, 0x66, 0x85, 0xa8, 0xdf, 0xc8, 0x80, 0x2c, 0xf3,
-	0x44, 0x6d, 0xeb, 0x38, 0xd3, 0xcc, 0x12, 0xf9, 0x13, 0x7d, 0x01, 0x95, 0x99, 0xde, 0x47, 0xa7,
-	0x2c, 0x9a, 0xd6, 0xb2, 0x4a, 0xbb, 0x1c, 0x63, 0x17, 0x2c, 0x9a, 0xa2, 0x26, 0x18, 0xd7, 0x8e,
-	0xcf, 0x5c, 0x3a, 0x71, 0xc5, 0x2d, 0xb5, 0xb9, 0x2b, 0x58, 0x2d, 0x77, 0x9c, 0x69, 0xe6, 0xc9,
-	0x8e, 0xc2, 0xdb, 0xae, 0xb8, 0xed, 0x48, 0x14, 0x7d, 0x0d, 0xbb, 0xc9, 0x61, 0xa1, 0xb6, 0xa2,
-	0x96, 0x3f, 0xce, 0x34, 0x4b, 0x64, 0x67, 0xb6, 0x6a, 0xdb, 0xd7, 0xb0, 0x2b, 0x1c, 0x8f, 0x07,
-	0x73, 0x41, 0x23, 0x3e, 0x09, 0x7c, 0x3b, 0xaa, 0x6d, 0xeb, 0x13, 0x63, 0x78, 0xa8, 0x51, 0xf4,
-	0x02, 0x76, 0xaf, 0x39, 0xa7, 0xae, 0xe3, 0x39, 0x82, 0x32, 0x11, 0x78, 0x51, 0xad, 0xa0, 0x8c,
-	0xaf, 0x5e, 0x73, 0xde, 0x93, 0x68, 0x4b, 0x82, 0xd2, 0xc6, 0x60, 0x2e, 0x6e, 0x02, 0xc7, 0xbf,
-	0xa1, 0x93, 0x29, 0xf3, 0xa9, 0x63, 0xd7, 0x8a, 0xc7, 0x99, 0x66, 0x8e, 0xec, 0x24, 0x78, 0x7b,
-	0xca, 0x7c, 0xd3, 0x46, 0x47, 0x00, 0xea, 0x1d, 0xea, 0xc8, 0x5a, 0x49, 0xdd, 0x5a, 0x92, 0x88,
-	0x3a, 0x0d, 0x9d, 0x41, 0x59, 0x39, 0x99, 0x4e, 0x1d, 0x5f, 0x44, 0x35, 0x38, 0xce, 0x36, 0xcb,
-	0x67, 0xc6, 0x89, 0xeb, 0x4b, 0x7f, 0x13, 0x29, 0xb9, 0x70, 0x7c, 0x41, 0xd2, 0x4a, 0x08, 0x43,
-	0x51, 0x7a, 0x97, 0x0a, 0xf7, 0xb6, 0x56, 0x56, 0x1b, 0x5e, 0x9e, 0x2c, 0x22, 0x75, 0x72, 0x3f,
-	0x34, 0x27, 0x1d, 0x1e, 0x89, 0x91, 0x7b, 0x8b, 0x7d, 0x11, 0xde, 0x91, 0x82, 0xad, 0x57, 0xf5,
-	0xef, 0xa1, 0x92, 0x16, 0xc8, 0x60, 0x7d, 0xe0, 0x77, 0x2a, 0x7e, 0x39, 0x22, 0x7f, 0xa2, 0x03,
-	0xc8, 0xdf, 0x32, 0x77, 0xce, 0x55, 0x00, 0x2b, 0x44, 0x2f, 0xbe, 0xdf, 0x7a, 0x9d, 0x69, 0xbc,
-	0x86, 0xfd, 0x51, 0xc8, 0x26, 0x1f, 0xd6, 0x72, 0x60, 0x3d, 0xba, 0x99, 0x7b, 0xd1, 0x6d, 0xfc,
-	0x11, 0xaa, 0xf1, 0xa6, 0xa1, 0x60, 0x62, 0x1e, 0xa1, 0x5f, 0x43, 0x3e, 0x12, 0x4c, 0x70, 0xa5,
-	0xbc, 0x73, 0xf6, 0x28, 0xf5, 0x94, 0x94, 0x22, 0x27, 0x5a, 0x0b, 0xd5, 0xa1, 0x38, 0x0b, 0xb9,
-	0xe3, 0xb1, 0x9b, 0xc4, 0xac, 0xc5, 0x1a, 0x35, 0x20, 0xaf, 0x36, 0xab, 0xac, 0x2a, 0x9f, 0x55,
-	0xd2, 0x6e, 0x24, 0x5a, 0xd4, 0x38, 0x87, 0x5d, 0xb5, 0xee, 0x72, 0xfe, 0xa9, 0xcc, 0x7d, 0x02,
-	0x25, 0xe6, 0x25, 0x29, 0xa0, 0xf3, 0xb7, 0xc8, 0x3c, 0x1d, 0xfd, 0xc6, 0x14, 0x8c, 0xe5, 0x19,
-	0xd1, 0x2c, 0xf0, 0x23, 0x8e, 0xbe, 0x01, 0x24, 0x2f, 0x90, 0x09, 0x21, 0x33, 0xc8, 0xd3, 0x3b,
-	0x33, 0x6a, 0xa7, 0x11, 0x4b, 0xba, 0x9c, 0xf7, 0x15, 0x2e, 0xf3, 0x4c, 0x66, 0x1e, 0x75, 0x83,
-	0xc9, 0x07, 0x99, 0xe2, 0xec, 0x2e, 0xbe, 0xa4, 0x2a, 0xe1, 0x5e, 0x30, 0xf9, 0xd0, 0x91, 0x60,
-	0xe3, 0xf7, 0xba, 0xd4, 0x46, 0x81, 0x7e, 0xc3, 0xff, 0xed, 0xe6, 0xa5, 0x2b, 0xb6, 0x1e, 0x76,
-	0x05, 0x85, 0xfd, 0x95, 0xc3, 0xe3, 0x97, 0xa4, 0x3d, 0x9c, 0x59, 0xf3, 0xf0, 0x37, 0x50, 0xb8,
-	0x66, 0x8e, 0x3b, 0x0f, 0x93, 0x83, 0x51, 0x2a, 0x5c, 0x5d, 0x2d, 0x21, 0x89, 0x4a, 0xe3, 0x3f,
-	0x05, 0x28, 0xc4, 0x20, 0x3a, 0x83, 0xdc, 0x24, 0xb0, 0x93, 0x28, 0x3f, 0xbb, 0xbf, 0x2d, 0xf9,
-	0xdb, 0x0e, 0x6c, 0x4e, 0x94, 0x2e, 0xfa, 0x2d, 0xec, 0xc8, 0xe2, 0xf2, 0xb9, 0x4b, 0xe7, 0x33,
-	0x9b, 0x2d, 0x02, 0x5b, 0x4b, 0xed, 0x6e, 0x6b, 0x85, 0xb1, 0x92, 0x93, 0xea, 0x24, 0xbd, 0x44,
-	0xc7, 0x50, 0x99, 0x0a, 0x77, 0x42, 0xbd, 0x38, 0x90, 0x39, 0x95, 0xdb, 0x20, 0xb1, 0xbe, 0x2e,
-	0xe4, 0x06, 0x54, 0x03, 0xdf, 0x09, 0x7c, 0x1a, 0x4d, 0x19, 0x3d, 0xfb, 0xee, 0x95, 0x22, 0x90,
-	0x0a, 0x29, 0x2b, 0x70, 0x38, 0x65, 0x67, 0xdf, 0xbd, 0x42, 0xcf, 0xa1, 0xac, 0x4a, 0x98, 0x7f,
-	0x9c, 0x39, 0xe1, 0x9d, 0x62, 0x8e, 0x2a, 0x51, 0x55, 0x8d, 0x15, 0x22, 0xeb, 0xe4, 0xda, 0x65,
-	0x37, 0x9a, 0x2b, 0xaa, 0x44, 0x2f, 0xd0, 0xb7, 0x70, 0x10, 0x3b, 0x82, 0x46, 0xc1, 0x3c, 0x9c,
-	0x70, 0xea, 0xf8, 0x36, 0xff, 0xa8, 0x78, 0xa2, 0x4a, 0x50, 0x2c, 0x1b, 0x2a, 0x91, 0x29, 0x25,
-	0xe8, 0x10, 0xb6, 0xa7, 0xdc, 0xb9, 0x99, 0x6a, 0x9e, 0xa8, 0x92, 0x78, 0xd5, 0xf8, 0x5b, 0x1e,
-	0xca, 0x29, 0xef, 0xa0, 0x0a, 0x14, 0x09, 0x1e, 0x62, 0xf2, 0x0e, 0x77, 0x8c, 0xcf, 0x50, 0x13,
-	0xbe, 0x32, 0xad, 0xf6, 0x80, 0x10, 0xdc, 0x1e, 0xd1, 0x01, 0xa1, 0x63, 0xeb, 0xad, 0x35, 0xf8,
-	0xc9, 0xa2, 0x97, 0xad, 0xf7, 0x7d, 0x6c, 0x8d, 0x68, 0x07, 0x8f, 0x5a, 0x66, 0x6f, 0x68, 0x64,
-	0xd0, 0x53, 0xa8, 0x2d, 0x35, 0x13, 0x71, 0xab, 0x3f, 0x18, 0x5b, 0x23, 0x63, 0x0b, 0x3d, 0x87,
-	0x27, 0x5d, 0xd3, 0x6a, 0xf5, 0xe8, 0x52, 0xa7, 0xdd, 0x1b, 0xbd, 0xa3, 0xf8, 0xe7, 0x4b, 0x93,
-	0xbc, 0x37, 0xb2, 0x9b, 0x14, 0x2e, 0x46, 0xbd, 0x76, 0x72, 0x42, 0x0e, 0x3d, 0x86, 0xcf, 0xb5,
-	0x82, 0xde, 0x42, 0x47, 0x83, 0x01, 0x1d, 0x0e, 0x06, 0x96, 0x91, 0x47, 0x7b, 0x50, 0x35, 0xad,
-	0x77, 0xad, 0x9e, 0xd9, 0xa1, 0x04, 0xb7, 0x7a, 0x7d, 0x63, 0x1b, 0xed, 0xc3, 0xee, 0xba, 0x5e,
-	0x41, 0x1e, 0x91, 0xe8, 0x0d, 0x2c, 0x73, 0x60, 0xd1, 0x77, 0x98, 0x0c, 0xcd, 0x81, 0x65, 0x14,
-	0xd1, 0x21, 0xa0, 0x55, 0xd1, 0x45, 0xbf, 0xd5, 0x36, 0x4a, 0xe8, 0x73, 0xd8, 0x5b, 0xc5, 0xdf,
-	0xe2, 0xf7, 0x06, 0xa0, 0x1a, 0x1c, 0x68, 0xc3, 0xe8, 0x39, 0xee, 0x0d, 0x7e, 0xa2, 0x7d, 0xd3,
-	0x32, 0xfb, 0xe3, 0xbe, 0x51, 0x46, 0x07, 0x60, 0x74, 0x31, 0xa6, 0xa6, 0x35, 0x1c, 0x77, 0xbb,
-	0x66, 0xdb, 0xc4, 0xd6, 0xc8, 0xa8, 0xe8, 0x9b, 0x37, 0x3d, 0xbc, 0x2a, 0x37, 0xb4, 0x2f, 0x5a,
-	0x96, 0x85, 0x7b, 0xb4, 0x63, 0x0e, 0x5b, 0xe7, 0x3d, 0xdc, 0x31, 0x76, 0xd0, 0x11, 0x3c, 0x1e,
-	0xe1, 0xfe, 0xe5, 0x80, 0xb4, 0xc8, 0x7b, 0x9a, 0xc8, 0xbb, 0x2d, 0xb3, 0x37, 0x26, 0xd8, 0xd8,
-	0x45, 0x5f, 0xc0, 0x11, 0xc1, 0x3f, 0x8e, 0x4d, 0x82, 0x3b, 0xd4, 0x1a, 0x74, 0x30, 0xed, 0xe2,
-	0xd6, 0x68, 0x4c, 0x30, 0xed, 0x9b, 0xc3, 0xa1, 0x69, 0xfd, 0x60, 0x18, 0xe8, 0x2b, 0x38, 0x5e,
-	0xa8, 0x2c, 0x0e, 0x58, 0xd3, 0xda, 0x93, 0xef, 0x4b, 0x42, 0x6a, 0xe1, 0x9f, 0x47, 0xf4, 0x12,
-	0x63, 0x62, 0x20, 0x54, 0x87, 0xc3, 0xe5, 0xf5, 0xfa, 0x82, 0xf8, 0xee, 0x7d, 0x29, 0xbb, 0xc4,
-	0xa4, 0xdf, 0xb2, 0x64, 0x80, 0x57, 0x64, 0x07, 0xd2, 0xec, 0xa5, 0x6c, 0xdd, 0xec, 0xcf, 0x11,
-	0x82, 0x9d, 0x54, 0x54, 0xba, 0x2d, 0x62, 0x1c, 0xa2, 0x03, 0xd8, 0x4d, 0x2c, 0x48, 0x14, 0xff,
-	0x59, 0x40, 0x8f, 0x00, 0x8d, 0x2d, 0x82, 0x5b, 0x1d, 0xe9, 0x90, 0x85, 0xe0, 0x5f, 0x85, 0x37,
-	0xb9, 0xe2, 0x96, 0x91, 0x6d, 0xfc, 0x3d, 0x0b, 0xd5, 0x95, 0xe2, 0x44, 0x4f, 0xa1, 0x14, 0x39,
-	0x37, 0x3e, 0x13, 0x92, 0x3e, 0x34, 0xb3, 0x2c, 0x01, 0xd5, 0x28, 0xa7, 0xcc, 0xf1, 0x35, 0xa5,
-	0x69, 0x6a, 0x2f, 0x29, 0x44, 0x11, 0xda, 0x23, 0x28, 0x24, 0x8d, 0x36, 0xab, 0xaa, 0x78, 0x7b,
-	0xa2, 0x1b, 0xec, 0x53, 0x28, 0x49, 0xce, 0x8c, 0x04, 0xf3, 0x66, 0xaa, 0xc0, 0xab, 0x64, 0x09,
-	0xa0, 0x2f, 0xa1, 0xea, 0xf1, 0x28, 0x62, 0x37, 0x9c, 0xea, 0x12, 0x05, 0xa5, 0x51, 0x89, 0xc1,
-	0xae, 0xaa, 0xd4, 0x2f, 0x21, 0xe1, 0x8d, 0x58, 0x29, 0xaf, 0x95, 0x62, 0x50, 0x2b, 0xad, 0x53,
-	0xb6, 0x60, 0x31, 0x13, 0xa4, 0x29, 0x5b, 0x30, 0x74, 0x0a, 0x07, 0x9a, 0x73, 0x1c, 0xdf, 0xf1,
-	0xe6, 0xde, 0x82, 0x7b, 0x0a, 0xca, 0xea, 0x3d, 0xc5, 0x3d, 0x5a, 0x14, 0x53, 0xd0, 0x63, 0x28,
-	0x5e, 0xb1, 0x88, 0xcb, 0xb6, 0x11, 0x73, 0x43, 0x41, 0xae, 0xbb, 0x9c, 0x4b, 0x91, 0x6c, 0x26,
-	0xa1, 0xa4, 0x3e, 0x4d, 0x09, 0x85, 0x6b, 0xce, 0x89, 0x74, 0xe6, 0xe2, 0x1a, 0xf6, 0x71, 0xe5,
-	0x9a, 0x72, 0xea, 0x1a, 0x2d, 0x8a, 0xaf, 0x79, 0x09, 0x7b, 0xfc, 0xa3, 0x08, 0x19, 0x0d, 0x66,
-	0xec, 0x97, 0x39, 0xa7, 0x36, 0x13, 0xac, 0x56, 0x51, 0x6e, 0xde, 0x55, 0x82, 0x81, 0xc2, 0x3b,
-	0x4c, 0xb0, 0xc6, 0x53, 0xa8, 0x13, 0x1e, 0x71, 0xd1, 0x77, 0xa2, 0xc8, 0x09, 0xfc, 0x76, 0xe0,
-	0x8b, 0x30, 0x70, 0xe3, 0xf6, 0xd3, 0x38, 0x82, 0x27, 0x1b, 0xa5, 0xba, 0x7f, 0xc8, 0xcd, 0x3f,
-	0xce, 0x79, 0x78, 0xb7, 0x79, 0xf3, 0x1d, 0x3c, 0xd9, 0x28, 0x5d, 0xb4, 0xd1, 0xbc, 0x1f, 0xd8,
-	0x5c, 0x76, 0x4e, 0x39, 0xd8, 0x1c, 0xa6, 0x98, 0xde, 0x0a, 0x6c, 0x7e, 0xe1, 0x44, 0x22, 0x08,
-	0xef, 0x88, 0x56, 0x92, 0xda, 0x33, 0xe6, 0x84, 0xb2, 0x43, 0xaf, 0x6b, 0x5f, 0x32, 0x27, 0x5c,
-	0x68, 0x2b, 0xa5, 0xc6, 0x9f, 0x33, 0x50, 0x4e, 0x1d, 0x22, 0xe9, 0x76, 0x36, 0xbf, 0x4a, 0x66,
-	0x9e, 0x0a, 0x89, 0x57, 0xe8, 0x05, 0xec, 0xb8, 0x2c, 0x12, 0x54, 0x32, 0x34, 0x95, 0xc1, 0x8d,
-	0x7b, 0xf3, 0x1a, 0x8a, 0x4e, 0x00, 0x05, 0x62, 0xca, 0x43, 0x1a, 0xcd, 0x27, 0x13, 0x1e, 0x45,
-	0x74, 0x16, 0x06, 0x57, 0x2a, 0x3b, 0xb7, 0xc8, 0x06, 0xc9, 0x9b, 0x5c, 0x31, 0x67, 0xe4, 0x1b,
-	0xff, 0xce, 0x40, 0x39, 0x65, 0x9c, 0xcc, 0x5f, 0xf9, 0x18, 0x7a, 0x1d, 0x06, 0x5e, 0x52, 0x15,
-	0x0b, 0x00, 0xd5, 0xa0, 0xa0, 0x16, 0x22, 0x88, 0x4b, 0x22, 0x59, 0xae, 0xe6, 0x7d, 0x56, 0x19,
-	0x98, 0xca, 0xfb, 0x57, 0x70, 0xe8, 0x39, 0x3e, 0x9d, 0x71, 0x9f, 0xb9, 0xce, 0x1f, 0x38, 0x5d,
-	0x0e, 0x33, 0x39, 0xa5, 0xfa, 0x80, 0x14, 0x35, 0xa0, 0xb2, 0xf2, 0x9a, 0xbc, 0x7a, 0xcd, 0x0a,
-	0x86, 0x5e, 0xc3, 0x23, 0xe5, 0x09, 0x26, 0x04, 0xf7, 0x66, 0x22, 0x79, 0xe4, 0xf5, 0xdc, 0x55,
-	0x15, 0x51, 0x24, 0x0f, 0x89, 0x1b, 0x7f, 0xcd, 0xc0, 0xde, 0xf9, 0xdc, 0x71, 0xed, 0x95, 0x71,
-	0xe6, 0x19, 0x94, 0xa5, 0x01, 0x49, 0x06, 0xeb, 0x99, 0x49, 0x8e, 0x5f, 0xfd, 0xc5, 0xb0, 0x7d,
-	0xef, 0x83, 0x60, 0x6b, 0xe3, 0x07, 0xc1, 0xa6, 0xb1, 0x3c, 0xbb, 0x71, 0x2c, 0x7f, 0x0e, 0xe5,
-	0x69, 0x30, 0xa3, 0x3a, 0xe2, 0xd2, 0x29, 0xd9, 0x66, 0x85, 0xc0, 0x34, 0x98, 0x5d, 0x6a, 0xa4,
-	0xf1, 0x1a, 0x50, 0xda, 0xd2, 0x38, 0x3d, 0x17, 0x63, 0x55, 0xe6, 0xc1, 0xb1, 0xea, 0xe5, 0x5f,
-	0x32, 0x50, 0x49, 0x4f, 0xae, 0xa8, 0x0a, 0x25, 0xd3, 0xa2, 0xdd, 0x9e, 0xf9, 0xc3, 0xc5, 0xc8,
-	0xf8, 0x4c, 0x2e, 0x87, 0xe3, 0x76, 0x1b, 0xe3, 0x0e, 0xee, 0x18, 0x19, 0x49, 0xb8, 0x92, 0x3b,
-	0x71, 0x87, 0x8e, 0xcc, 0x3e, 0x1e, 0x8c, 0x65, 0x2b, 0xde, 0x87, 0xdd, 0x18, 0xb3, 0x06, 0x94,
-	0x0c, 0xc6, 0x23, 0x6c, 0x64, 0x91, 0x01, 0x95, 0x18, 0xc4, 0x84, 0x0c, 0x88, 0x91, 0x93, 0xfd,
-	0x23, 0x46, 0xee, 0xb7, 0xf5, 0xa4, 0xeb, 0xe7, 0xcf, 0xfe, 0x91, 0x83, 0x6d, 0x65, 0x60, 0x88,
-	0x2e, 0xa0, 0x9c, 0xfa, 0x3c, 0x40, 0x47, 0x9f, 0xfc, 0x6c, 0xa8, 0xd7, 0x36, 0x8f, 0xe2, 0xf3,
-	0xe8, 0xdb, 0x0c, 0x7a, 0x03, 0x95, 0xf4, 0x07, 0x00, 0x4a, 0x0f, 0x74, 0x1b, 0xbe, 0x0c, 0x3e,
-	0x79, 0xd6, 0x5b, 0x30, 0x70, 0x24, 0x1c, 0x4f, 0x0e, 0x70, 0xf1, 0x58, 0x8d, 0xea, 0x29, 0xfd,
-	0xb5, 0x79, 0xbd, 0xfe, 0x64, 0xa3, 0x2c, 0x8e, 0x50, 0x4f, 0x3f, 0x31, 0x1e, 0x6a, 0xef, 0x3d,
-	0x71, 0x75, 0x92, 0xae, 0x3f, 0x7b, 0x48, 0x1c, 0x9f, 0x66, 0xc3, 0xfe, 0x06, 0xaa, 0x43, 0xbf,
-	0x4a, 0x5b, 0xf0, 0x20, 0x51, 0xd6, 0x5f, 0xfc, 0x2f, 0xb5, 0xe5, 0x2d, 0x1b, 0x38, 0x71, 0xe5,
-	0x96, 0x87, 0x19, 0x75, 0xe5, 0x96, 0x4f, 0x51, 0xab, 0x09, 0xb0, 0xcc, 0x68, 0xf4, 0x34, 0xb5,
-	0xeb, 0x5e, 0x49, 0xd6, 0x8f, 0x1e, 0x90, 0xea, 0xa3, 0xce, 0x5f, 0xfe, 0xae, 0x79, 0xe3, 0x88,
-	0xe9, 0xfc, 0xea, 0x64, 0x12, 0x78, 0xa7, 0x36, 0x9f, 0x84, 0xdc, 0x3e, 0xb5, 0x27, 0xa1, 0xeb,
-	0xdb, 0xa7, 0xaa, 0x22, 0x4e, 0x17, 0xdb, 0xaf, 0xb6, 0xd5, 0x7f, 0x0d, 0x7e, 0xf3, 0xdf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x11, 0xf7, 0x48, 0x9d, 0x65, 0x10, 0x00, 0x00,
+func init() { proto.RegisterFile("routerrpc/router.proto", fileDescriptor_router_03cf04c818eeac84) }
+
+var fileDescriptor_router_03cf04c818eeac84 = []byte{
+	// 3649 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x7a, 0x4b, 0x73, 0x23, 0x47,
+	0x72, 0xb0, 0xf0, 0x22, 0x80, 0xc4, 0x83, 0xcd, 0x22, 0x87, 0xc4, 0x80, 0x33, 0x12, 0xa6, 0x77,
+	0x77, 0xc4, 0x6f, 0x56, 0x1f, 0x67, 0x82, 0xb2, 0x64, 0x49, 0xb1, 0xb6, 0x17, 0x03, 0x34, 0x44,
+	0x48, 0x40, 0x83, 0x5b, 0x00, 0x46, 0xd2, 0xae, 0x23, 0xca, 0x4d, 0x74, 0x91, 0xe8, 0x98, 0x7e,
+	0x40, 0xdd, 0x0d, 0x8a, 0xf4, 0xc1, 0xe1, 0xb0, 0xff, 0x88, 0x8f, 0x7b, 0xf0, 0x1f, 0xf0, 0xc5,
+	0x47, 0xff, 0x05, 0x1f, 0xed, 0x8b, 0xed, 0x83, 0x8f, 0x3e, 0xd9, 0x27, 0x47, 0x3d, 0xba, 0xd1,
+	0x0d, 0x80, 0x43, 0x29, 0xbc, 0x97, 0x19, 0x54, 0x66, 0x56, 0x56, 0x56, 0x56, 0xbe, 0x9b, 0x70,
+	0xe8, 0x7b, 0xcb, 0x90, 0xfa, 0xfe, 0x62, 0xf6, 0x52, 0xfc, 0x3a, 0x5d, 0xf8, 0x5e, 0xe8, 0xa1,
+	0x72, 0x0c, 0x6f, 0x96, 0xfd, 0xc5, 0x4c, 0x40, 0xd5, 0xbf, 0x2e, 0x02, 0x1a, 0x53, 0xd7, 0xbc,
+	0x30, 0xee, 0x1c, 0xea, 0x86, 0x98, 0x7e, 0xbf, 0xa4, 0x41, 0x88, 0x10, 0xe4, 0x4d, 0x1a, 0x84,
+	0x8d, 0x4c, 0x2b, 0x73, 0x52, 0xc5, 0xfc, 0x37, 0x52, 0x20, 0x67, 0x38, 0x61, 0x23, 0xdb, 0xca,
+	0x9c, 0xe4, 0x30, 0xfb, 0x89, 0x9e, 0x41, 0x75, 0x21, 0xf6, 0x91, 0xb9, 0x11, 0xcc, 0x1b, 0x39,
+	0x4e, 0x5d, 0x91, 0xb0, 0x73, 0x23, 0x98, 0xa3, 0x13, 0x50, 0xae, 0x2c, 0xd7, 0xb0, 0xc9, 0xcc,
+	0x0e, 0x6f, 0x88, 0x49, 0xed, 0xd0, 0x68, 0xe4, 0x5b, 0x99, 0x93, 0x02, 0xae, 0x73, 0x78, 0xc7,
+	0x0e, 0x6f, 0xba, 0x0c, 0x8a, 0x3e, 0x84, 0xdd, 0x88, 0x99, 0x2f, 0xa4, 0x68, 0x14, 0x5a, 0x99,
+	0x93, 0x32, 0xae, 0x2f, 0xd2, 0xb2, 0x7d, 0x08, 0xbb, 0xa1, 0xe5, 0x50, 0x6f, 0x19, 0x92, 0x80,
+	0xce, 0x3c, 0xd7, 0x0c, 0x1a, 0x3b, 0x82, 0xa3, 0x04, 0x8f, 0x05, 0x14, 0x3d, 0x87, 0xdd, 0x2b,
+	0x4a, 0x89, 0x6d, 0x39, 0x56, 0x48, 0x8c, 0xd0, 0x73, 0x82, 0x46, 0x91, 0x0b, 0x5f, 0xbb, 0xa2,
+	0x74, 0xc0, 0xa0, 0x6d, 0x06, 0x64, 0x32, 0x7a, 0xcb, 0xf0, 0xda, 0xb3, 0xdc, 0x6b, 0x32, 0x9b,
+	0x1b, 0x2e, 0xb1, 0xcc, 0x46, 0xa9, 0x95, 0x39, 0xc9, 0xe3, 0x7a, 0x04, 0xef, 0xcc, 0x0d, 0xb7,
+	0x6f, 0xa2, 0xa7, 0x00, 0xfc, 0x1e, 0x9c, 0x65, 0xa3, 0xcc, 0x4f, 0x2d, 0x33, 0x08, 0xe7, 0x86,
+	0xce, 0xa0, 0xc2, 0x95, 0x4c, 0xe6, 0x96, 0x1b, 0x06, 0x0d, 0x68, 0xe5, 0x4e, 0x2a, 0x67, 0xca,
+	0xa9, 0xed, 0x32, 0x7d, 0x63, 0x86, 0x39, 0xb7, 0xdc, 0x10, 0x27, 0x89, 0x90, 0x06, 0x25, 0xa6,
+	0x5d, 0x12, 0xda, 0x37, 0x8d, 0x0a, 0xdf, 0xf0, 0xe2, 0x34, 0x7e, 0xa9, 0xd3, 0xcd, 0xa7, 0x39,
+	0xed, 0xd2, 0x20, 0x9c, 0xd8, 0x37, 0x9a, 0x1b, 0xfa, 0x77, 0xb8, 0x68, 0x8a, 0x15, 0x7b, 0x0a,
+	0xc7, 0xb8, 0x25, 0x46, 0x18, 0x52, 0x67, 0x11, 0x06, 0x8d, 0x6a, 0x2b, 0x73, 0x52, 0xc3, 0x15,
+	0xc7, 0xb8, 0x6d, 0x4b, 0x10, 0xfa, 0xff, 0xb0, 0xcf, 0x48, 0x42, 0x2f, 0x34, 0x6c, 0xc2, 0x14,
+	0x23, 0x54, 0x52, 0xe3, 0x2a, 0x51, 0x1c, 0xe3, 0x76, 0xc2, 0x30, 0x3d, 0x4a, 0x85, 0x56, 0x5e,
+	0xc1, 0x01, 0x23, 0x37, 0x97, 0xbe, 0x11, 0x5a, 0x9e, 0x1b, 0xeb, 0xba, 0xce, 0x6f, 0x8d, 0x1c,
+	0xe3, 0xb6, 0x2b, 0x51, 0x91, 0xbe, 0x5f, 0xc0, 0xde, 0xba, 0x1e, 0x83, 0xc6, 0x6e, 0x2b, 0x77,
+	0x92, 0xc7, 0xbb, 0x69, 0x45, 0xf2, 0xb7, 0xb1, 0x8d, 0x20, 0x24, 0x73, 0x6f, 0x41, 0x16, 0xcb,
+	0xcb, 0xb7, 0xf4, 0xae, 0xa1, 0x70, 0xeb, 0xa9, 0x31, 0xf0, 0xb9, 0xb7, 0xb8, 0xe0, 0x40, 0x76,
+	0xaf, 0x60, 0x6e, 0x98, 0xde, 0x0f, 0x84, 0x2b, 0xa5, 0xb1, 0xd7, 0xca, 0x9c, 0x94, 0x70, 0x45,
+	0xc0, 0xb8, 0x4a, 0xd1, 0x01, 0x14, 0x6c, 0xe3, 0x92, 0xda, 0x0d, 0xc4, 0xcd, 0x45, 0x2c, 0xd0,
+	0x13, 0x28, 0xfb, 0xf4, 0x8a, 0xfa, 0xd4, 0x9d, 0xd1, 0xc6, 0x3e, 0xc7, 0xac, 0x00, 0x48, 0x87,
+	0x47, 0x4b, 0x37, 0xb8, 0x73, 0x67, 0xd4, 0x24, 0xd7, 0xbe, 0xb1, 0x98, 0x13, 0x63, 0xc6, 0xae,
+	0xd2, 0x38, 0x68, 0x65, 0x4e, 0xea, 0x67, 0x4d, 0xf9, 0x66, 0x53, 0x49, 0xf3, 0x25, 0x23, 0x69,
+	0x73, 0x0a, 0xbc, 0xbf, 0xdc, 0x04, 0x36, 0xbf, 0x80, 0x6a, 0xf2, 0x5d, 0x98, 0xaf, 0xb0, 0x2b,
+	0x65, 0xb8, 0x15, 0xb1, 0x9f, 0x4c, 0xca, 0x1b, 0xc3, 0x5e, 0x52, 0xee, 0x3f, 0x55, 0x2c, 0x16,
+	0x5f, 0x64, 0x3f, 0xcb, 0xa8, 0x9f, 0xc1, 0xfe, 0xc4, 0x37, 0x66, 0x6f, 0xd7, 0x5c, 0x70, 0xdd,
+	0xb9, 0x32, 0x1b, 0xce, 0xa5, 0xfe, 0x15, 0xd4, 0xe4, 0xa6, 0x71, 0x68, 0x84, 0x4b, 0xf6, 0xc4,
+	0x85, 0x20, 0x34, 0x42, 0xca, 0x89, 0xeb, 0x67, 0x47, 0x09, 0x4b, 0x4a, 0x10, 0x52, 0x2c, 0xa8,
+	0x50, 0x13, 0x4a, 0x0b, 0x9f, 0x5a, 0x8e, 0x71, 0x1d, 0x89, 0x15, 0xaf, 0x91, 0x0a, 0x05, 0xa1,
+	0x71, 0xe6, 0xd4, 0x95, 0xb3, 0x6a, 0xd2, 0x8a, 0xb1, 0x40, 0xa9, 0xaf, 0x61, 0x97, 0xaf, 0x7b,
+	0x94, 0xbe, 0x2b, 0x70, 0x1c, 0x43, 0xd9, 0x70, 0x22, 0x0f, 0x14, 0xe1, 0xa3, 0x64, 0x38, 0xc2,
+	0xf9, 0xd4, 0x39, 0x28, 0x2b, 0x1e, 0xc1, 0xc2, 0x73, 0x03, 0x8a, 0x3e, 0x02, 0xc4, 0x0e, 0x60,
+	0x76, 0xc4, 0xec, 0xd4, 0x11, 0x3b, 0x33, 0xc2, 0x50, 0x25, 0xa6, 0x47, 0xe9, 0x90, 0xc3, 0x99,
+	0x29, 0x31, 0xc7, 0x27, 0xb6, 0x37, 0x7b, 0xcb, 0x22, 0x8c, 0x71, 0x27, 0x0f, 0xa9, 0x31, 0xf0,
+	0xc0, 0x9b, 0xbd, 0xed, 0x32, 0xa0, 0xfa, 0x3b, 0x11, 0xe9, 0x26, 0x9e, 0xb8, 0xc3, 0x8f, 0x56,
+	0xf3, 0x4a, 0x15, 0xd9, 0xfb, 0x55, 0x41, 0x60, 0x3f, 0xc5, 0x5c, 0xde, 0x24, 0xa9, 0xe1, 0xcc,
+	0x9a, 0x86, 0x3f, 0x82, 0xe2, 0x95, 0x61, 0xd9, 0x4b, 0x3f, 0x62, 0x8c, 0x12, 0xcf, 0xd5, 0x13,
+	0x18, 0x1c, 0x91, 0xa8, 0xff, 0x53, 0x84, 0xa2, 0x04, 0xa2, 0x33, 0xc8, 0xcf, 0x3c, 0x33, 0x7a,
+	0xe5, 0xf7, 0x37, 0xb7, 0x45, 0xff, 0x77, 0x3c, 0x93, 0x62, 0x4e, 0x8b, 0xfe, 0x0c, 0xea, 0xcc,
+	0x27, 0x5d, 0x6a, 0x93, 0xe5, 0xc2, 0x34, 0xe2, 0x87, 0x6d, 0x24, 0x76, 0x77, 0x04, 0xc1, 0x94,
+	0xe3, 0x71, 0x6d, 0x96, 0x5c, 0xa2, 0x16, 0x54, 0xe7, 0xa1, 0x3d, 0x23, 0x8e, 0x7c, 0xc8, 0x3c,
+	0xb7, 0x6d, 0x60, 0xb0, 0xa1, 0x88, 0x18, 0x2a, 0xd4, 0x3c, 0x97, 0x87, 0x8a, 0xb9, 0x41, 0xce,
+	0x3e, 0xf9, 0x94, 0xc7, 0xef, 0x2a, 0xae, 0x70, 0xe0, 0x78, 0x6e, 0x9c, 0x7d, 0xf2, 0x29, 0xfa,
+	0x00, 0x2a, 0x3c, 0x82, 0xd2, 0xdb, 0x85, 0xe5, 0xdf, 0xf1, 0xc0, 0x5d, 0xc3, 0x3c, 0xa8, 0x6a,
+	0x1c, 0xc2, 0xfc, 0xe4, 0xca, 0x36, 0xae, 0x45, 0xa8, 0xae, 0x61, 0xb1, 0x60, 0xc1, 0x48, 0x2a,
+	0x82, 0x04, 0xde, 0xd2, 0x9f, 0x51, 0x62, 0xb9, 0x26, 0xbd, 0xe5, 0x61, 0xba, 0x86, 0x91, 0xc4,
+	0x8d, 0x39, 0xaa, 0xcf, 0x30, 0xe8, 0x10, 0x76, 0xe6, 0xd4, 0xba, 0x9e, 0x8b, 0x30, 0x5d, 0xc3,
+	0x72, 0xa5, 0xfe, 0x7d, 0x01, 0x2a, 0x09, 0xed, 0xa0, 0x2a, 0x94, 0xb0, 0x36, 0xd6, 0xf0, 0x1b,
+	0xad, 0xab, 0xbc, 0x87, 0x4e, 0xe0, 0xe7, 0x7d, 0xbd, 0x33, 0xc2, 0x58, 0xeb, 0x4c, 0xc8, 0x08,
+	0x93, 0xa9, 0xfe, 0xb5, 0x3e, 0xfa, 0x46, 0x27, 0x17, 0xed, 0xef, 0x86, 0x9a, 0x3e, 0x21, 0x5d,
+	0x6d, 0xd2, 0xee, 0x0f, 0xc6, 0x4a, 0x06, 0x3d, 0x81, 0xc6, 0x8a, 0x32, 0x42, 0xb7, 0x87, 0xa3,
+	0xa9, 0x3e, 0x51, 0xb2, 0xe8, 0x03, 0x38, 0xee, 0xf5, 0xf5, 0xf6, 0x80, 0xac, 0x68, 0x3a, 0x83,
+	0xc9, 0x1b, 0xa2, 0x7d, 0x7b, 0xd1, 0xc7, 0xdf, 0x29, 0xb9, 0x6d, 0x04, 0xe7, 0x93, 0x41, 0x27,
+	0xe2, 0x90, 0x47, 0x8f, 0xe1, 0x91, 0x20, 0x10, 0x5b, 0xc8, 0x64, 0x34, 0x22, 0xe3, 0xd1, 0x48,
+	0x57, 0x0a, 0x68, 0x0f, 0x6a, 0x7d, 0xfd, 0x4d, 0x7b, 0xd0, 0xef, 0x12, 0xac, 0xb5, 0x07, 0x43,
+	0x65, 0x07, 0xed, 0xc3, 0xee, 0x3a, 0x5d, 0x91, 0xb1, 0x88, 0xe8, 0x46, 0x7a, 0x7f, 0xa4, 0x93,
+	0x37, 0x1a, 0x1e, 0xf7, 0x47, 0xba, 0x52, 0x42, 0x87, 0x80, 0xd2, 0xa8, 0xf3, 0x61, 0xbb, 0xa3,
+	0x94, 0xd1, 0x23, 0xd8, 0x4b, 0xc3, 0xbf, 0xd6, 0xbe, 0x53, 0x00, 0x35, 0xe0, 0x40, 0x08, 0x46,
+	0x5e, 0x6b, 0x83, 0xd1, 0x37, 0x64, 0xd8, 0xd7, 0xfb, 0xc3, 0xe9, 0x50, 0xa9, 0xa0, 0x03, 0x50,
+	0x7a, 0x9a, 0x46, 0xfa, 0xfa, 0x78, 0xda, 0xeb, 0xf5, 0x3b, 0x7d, 0x4d, 0x9f, 0x28, 0x55, 0x71,
+	0xf2, 0xb6, 0x8b, 0xd7, 0xd8, 0x86, 0xce, 0x79, 0x5b, 0xd7, 0xb5, 0x01, 0xe9, 0xf6, 0xc7, 0xed,
+	0xd7, 0x03, 0xad, 0xab, 0xd4, 0xd1, 0x53, 0x78, 0x3c, 0xd1, 0x86, 0x17, 0x23, 0xdc, 0xc6, 0xdf,
+	0x91, 0x08, 0xdf, 0x6b, 0xf7, 0x07, 0x53, 0xac, 0x29, 0xbb, 0xe8, 0x19, 0x3c, 0xc5, 0xda, 0x6f,
+	0xa6, 0x7d, 0xac, 0x75, 0x89, 0x3e, 0xea, 0x6a, 0xa4, 0xa7, 0xb5, 0x27, 0x53, 0xac, 0x91, 0x61,
+	0x7f, 0x3c, 0xee, 0xeb, 0x5f, 0x2a, 0x0a, 0xfa, 0x39, 0xb4, 0x62, 0x92, 0x98, 0xc1, 0x1a, 0xd5,
+	0x1e, 0xbb, 0x5f, 0xf4, 0xa4, 0xba, 0xf6, 0xed, 0x84, 0x5c, 0x68, 0x1a, 0x56, 0x10, 0x6a, 0xc2,
+	0xe1, 0xea, 0x78, 0x71, 0x80, 0x3c, 0x7b, 0x9f, 0xe1, 0x2e, 0x34, 0x3c, 0x6c, 0xeb, 0xec, 0x81,
+	0x53, 0xb8, 0x03, 0x26, 0xf6, 0x0a, 0xb7, 0x2e, 0xf6, 0x23, 0x84, 0xa0, 0x9e, 0x78, 0x95, 0x5e,
+	0x1b, 0x2b, 0x87, 0xe8, 0x00, 0x76, 0x23, 0x09, 0x22, 0xc2, 0x7f, 0x2f, 0xa2, 0x23, 0x40, 0x53,
+	0x1d, 0x6b, 0xed, 0x2e, 0x53, 0x48, 0x8c, 0xf8, 0x8f, 0xe2, 0x57, 0xf9, 0x52, 0x56, 0xc9, 0xa9,
+	0xff, 0x90, 0x83, 0x5a, 0xca, 0x39, 0x59, 0x7a, 0x0b, 0xac, 0x6b, 0xd7, 0x08, 0x97, 0xbe, 0x88,
+	0x03, 0x55, 0xbc, 0x02, 0xf0, 0x3a, 0x65, 0x6e, 0x58, 0xae, 0x08, 0x69, 0x22, 0xb4, 0x97, 0x39,
+	0x84, 0x07, 0xb4, 0x23, 0x28, 0x46, 0x75, 0x4e, 0x8e, 0x7b, 0xf1, 0xce, 0x4c, 0xd4, 0x37, 0x4f,
+	0xa0, 0xcc, 0x62, 0x66, 0x10, 0x1a, 0xce, 0x82, 0x3b, 0x78, 0x0d, 0xaf, 0x00, 0xe8, 0x67, 0x50,
+	0x73, 0x68, 0x10, 0x18, 0xd7, 0x94, 0x08, 0x17, 0x05, 0x4e, 0x51, 0x95, 0xc0, 0x1e, 0x83, 0x31,
+	0xa2, 0x28, 0xce, 0x08, 0xa2, 0x82, 0x20, 0x92, 0x40, 0x41, 0xb4, 0x1e, 0xb2, 0x43, 0x43, 0x46,
+	0x82, 0x64, 0xc8, 0x0e, 0x0d, 0xf4, 0x12, 0x0e, 0x44, 0xcc, 0xb1, 0x5c, 0xcb, 0x59, 0x3a, 0x71,
+	0xec, 0x29, 0x72, 0xa9, 0xf7, 0x78, 0xec, 0x11, 0x28, 0x19, 0x82, 0x1e, 0x43, 0xe9, 0xd2, 0x08,
+	0x28, 0x4b, 0x1b, 0x32, 0x36, 0x14, 0xd9, 0xba, 0x47, 0x29, 0x43, 0xb1, 0x64, 0xe2, 0xb3, 0xd0,
+	0x27, 0x42, 0x42, 0xf1, 0x8a, 0x52, 0xcc, 0x94, 0x19, 0x1f, 0x63, 0xdc, 0xa6, 0x8e, 0xa9, 0x24,
+	0x8e, 0x31, 0x6e, 0x13, 0xc7, 0xbc, 0x80, 0x3d, 0x7a, 0x1b, 0xfa, 0x06, 0xf1, 0x16, 0xc6, 0xf7,
+	0x4b, 0x4a, 0x4c, 0x23, 0x34, 0x78, 0xc9, 0x55, 0xc5, 0xbb, 0x1c, 0x31, 0xe2, 0xf0, 0xae, 0x11,
+	0x1a, 0xea, 0x57, 0xd0, 0xc4, 0x34, 0xa0, 0xe1, 0xd0, 0x0a, 0x02, 0xcb, 0x73, 0x3b, 0x9e, 0x1b,
+	0xfa, 0x9e, 0x1d, 0xa5, 0x9f, 0x8f, 0x00, 0x79, 0xb6, 0x49, 0x7d, 0x12, 0xce, 0x8d, 0x55, 0x8d,
+	0x25, 0xea, 0x06, 0x85, 0x63, 0x26, 0x73, 0x23, 0xaa, 0xb0, 0xd4, 0xa7, 0x70, 0xbc, 0x95, 0x97,
+	0xc8, 0x36, 0xea, 0x13, 0x68, 0xfe, 0x66, 0x49, 0xfd, 0xbb, 0xad, 0x47, 0xa9, 0x77, 0x70, 0xbc,
+	0x15, 0x1b, 0x27, 0xdd, 0x82, 0xeb, 0x99, 0x94, 0x1d, 0xce, 0xaa, 0xd0, 0xc3, 0x44, 0x5e, 0xd0,
+	0x3d, 0x93, 0x9e, 0x5b, 0x41, 0xe8, 0xf9, 0x77, 0x58, 0x10, 0x31, 0xea, 0x85, 0x61, 0xf9, 0x2c,
+	0x9f, 0xaf, 0x53, 0x5f, 0x18, 0x96, 0x1f, 0x53, 0x73, 0x22, 0xf5, 0x6f, 0x33, 0x50, 0x49, 0x30,
+	0x61, 0xc1, 0x59, 0x16, 0x7d, 0xc2, 0x74, 0xe5, 0x0a, 0x3d, 0x87, 0x3a, 0xaf, 0x0a, 0x59, 0x3c,
+	0x27, 0xcc, 0x14, 0x64, 0x26, 0x5f, 0x83, 0xa2, 0x53, 0x40, 0x5e, 0x38, 0xa7, 0x3e, 0x09, 0x96,
+	0xb3, 0x19, 0x0d, 0x02, 0xb2, 0xf0, 0xbd, 0x4b, 0x6e, 0xcb, 0x59, 0xbc, 0x05, 0xf3, 0x55, 0xbe,
+	0x94, 0x57, 0x0a, 0xea, 0x7f, 0x67, 0xa0, 0x92, 0x10, 0x8e, 0x59, 0x3b, 0xbb, 0x0c, 0xb9, 0xf2,
+	0x3d, 0x27, 0xf2, 0xa1, 0x18, 0x80, 0x1a, 0x50, 0xe4, 0x8b, 0xd0, 0x93, 0x0e, 0x14, 0x2d, 0xd3,
+	0x5e, 0x92, 0xe3, 0x02, 0xae, 0x00, 0xe8, 0x53, 0x38, 0x74, 0x2c, 0x97, 0x2c, 0xa8, 0x6b, 0xd8,
+	0xd6, 0x5f, 0x52, 0xb2, 0x2a, 0x7d, 0xf2, 0x9c, 0xf4, 0x1e, 0x2c, 0x52, 0xa1, 0x9a, 0xba, 0x4d,
+	0x81, 0xdf, 0x26, 0x05, 0x43, 0x9f, 0xc1, 0x11, 0xd7, 0x84, 0x2c, 0xf3, 0xa3, 0x4b, 0x5e, 0x2d,
+	0x6d, 0xee, 0x3f, 0x25, 0x7c, 0x1f, 0x5a, 0xfd, 0x7d, 0x06, 0xf6, 0x5e, 0x2f, 0x2d, 0xdb, 0x4c,
+	0x15, 0x3f, 0xef, 0x43, 0x85, 0x09, 0x10, 0xd9, 0xbb, 0xa8, 0xb0, 0x58, 0xb1, 0x36, 0x8c, 0x3b,
+	0xa3, 0x8d, 0xee, 0x2d, 0xbb, 0xb5, 0x7b, 0xdb, 0xd6, 0x43, 0xe5, 0xb6, 0xf6, 0x50, 0x1f, 0x40,
+	0x65, 0x55, 0xf4, 0x33, 0xa5, 0xe4, 0x4e, 0xaa, 0x18, 0xe6, 0x51, 0xc5, 0x1f, 0xa8, 0x9f, 0x01,
+	0x4a, 0x4a, 0x2a, 0xcd, 0x33, 0x2e, 0xc2, 0x32, 0xf7, 0x17, 0x61, 0x9f, 0xc3, 0x41, 0xc7, 0x70,
+	0x67, 0xd4, 0xfe, 0xe9, 0xa5, 0xf4, 0x11, 0x3c, 0x5a, 0xdb, 0x2a, 0x7d, 0xaa, 0x0f, 0x87, 0x22,
+	0xe4, 0xb6, 0x6f, 0x3c, 0xcb, 0x1c, 0x58, 0x41, 0xcc, 0xf5, 0x20, 0xe9, 0x30, 0xd5, 0xc8, 0x31,
+	0x1e, 0x43, 0x29, 0xee, 0x7d, 0xb2, 0xbc, 0xf7, 0x29, 0x8a, 0xe0, 0x1a, 0xa8, 0x8f, 0xe1, 0x68,
+	0x83, 0x95, 0x3c, 0xe5, 0x08, 0x1e, 0x71, 0xdf, 0x5c, 0x3f, 0x84, 0x1d, 0xbf, 0x8e, 0x90, 0x0a,
+	0xf9, 0xc9, 0xc7, 0xff, 0x5a, 0x46, 0x07, 0x79, 0xc3, 0x21, 0x0d, 0x7d, 0x6b, 0x16, 0x44, 0xb7,
+	0x51, 0xa1, 0xca, 0x8a, 0x75, 0xcb, 0xe5, 0x2d, 0x5d, 0xc4, 0x35, 0x05, 0x53, 0xff, 0x25, 0x0f,
+	0xa8, 0xbb, 0x02, 0x48, 0x0e, 0xa8, 0x05, 0x95, 0x04, 0x59, 0xa4, 0xdd, 0x04, 0x88, 0x3d, 0x80,
+	0xbb, 0x74, 0x88, 0x54, 0xb8, 0x68, 0x02, 0x6a, 0xb8, 0xe2, 0x2e, 0x1d, 0x29, 0x4c, 0x80, 0xfe,
+	0x08, 0x0e, 0x93, 0x24, 0xc2, 0x76, 0xa9, 0x49, 0x85, 0x19, 0xd5, 0xf0, 0x41, 0x82, 0x78, 0x1c,
+	0xe1, 0x58, 0x5d, 0x18, 0xbd, 0x6c, 0xe4, 0x28, 0x3c, 0xc0, 0xe7, 0x45, 0x28, 0x90, 0xb8, 0xb1,
+	0x40, 0xf1, 0x58, 0x2f, 0x45, 0x89, 0x1b, 0xe5, 0x42, 0x2c, 0x4a, 0xdc, 0x28, 0x4b, 0x51, 0x22,
+	0x92, 0x84, 0x28, 0x3b, 0xb1, 0x28, 0x11, 0x71, 0x4a, 0x94, 0x35, 0xbf, 0x13, 0xa2, 0x14, 0x85,
+	0x28, 0x12, 0x97, 0x14, 0xe5, 0x63, 0x38, 0x34, 0x6e, 0xae, 0x63, 0x6f, 0xb5, 0x8d, 0x90, 0xba,
+	0xb3, 0x3b, 0xe2, 0x04, 0x3c, 0x75, 0xe5, 0xf0, 0xbe, 0x71, 0x73, 0x2d, 0xcf, 0x19, 0x08, 0xdc,
+	0x30, 0x40, 0x9f, 0xc3, 0x63, 0x87, 0x9a, 0x96, 0xe1, 0x6e, 0xdb, 0x57, 0x96, 0x11, 0x86, 0x13,
+	0x6c, 0x6c, 0xfd, 0x18, 0x0e, 0x13, 0x33, 0x82, 0xe4, 0x3e, 0x10, 0xe7, 0xad, 0xa6, 0x05, 0xab,
+	0x4d, 0x5f, 0x40, 0x73, 0x35, 0x31, 0x08, 0x6c, 0x6b, 0xb1, 0x60, 0xf9, 0x3f, 0x99, 0x21, 0x73,
+	0xf8, 0x30, 0x94, 0x93, 0x83, 0xb1, 0xc4, 0x0f, 0xe3, 0x11, 0x42, 0xfa, 0x4d, 0x19, 0x9a, 0x9a,
+	0x72, 0x38, 0x81, 0x92, 0x2f, 0x2a, 0x30, 0xea, 0x5f, 0xc8, 0x1c, 0xb5, 0x6e, 0xa3, 0xd2, 0xe6,
+	0xdb, 0x5b, 0x8c, 0xb4, 0x72, 0xf6, 0x34, 0x91, 0x7c, 0x36, 0xcd, 0x73, 0xcd, 0x86, 0xff, 0x33,
+	0x0b, 0x15, 0x56, 0x69, 0xcb, 0x8b, 0xa2, 0xcf, 0x61, 0x27, 0xe0, 0xcd, 0xb3, 0xec, 0xa6, 0x9e,
+	0x25, 0x98, 0x25, 0xe8, 0xf8, 0x6f, 0xd1, 0x65, 0x63, 0xb9, 0xe1, 0xc7, 0xf4, 0x85, 0xac, 0xd2,
+	0x89, 0xf4, 0xcd, 0x2b, 0x1e, 0x37, 0x90, 0x19, 0xa3, 0x26, 0xc1, 0x13, 0xcb, 0xa1, 0x3a, 0xaf,
+	0x88, 0x7c, 0x1a, 0x78, 0xf6, 0x0d, 0x8d, 0xe9, 0x44, 0xba, 0xa8, 0x49, 0xb0, 0xa4, 0x4b, 0x34,
+	0x8d, 0x85, 0x07, 0x9b, 0xc6, 0x54, 0xfb, 0xb9, 0xb3, 0xd6, 0x7e, 0xfe, 0x12, 0xd0, 0xf7, 0x4b,
+	0x2f, 0xa4, 0xa6, 0xe8, 0xb1, 0x53, 0x03, 0xb2, 0x5d, 0x81, 0xe9, 0x51, 0xf9, 0x92, 0xea, 0xa7,
+	0x00, 0x2b, 0x05, 0xa0, 0x1a, 0x94, 0xfb, 0x3a, 0xe9, 0x0d, 0xfa, 0x5f, 0x9e, 0x4f, 0x94, 0xf7,
+	0xd8, 0x72, 0x3c, 0xed, 0x74, 0x34, 0xad, 0xab, 0x75, 0x95, 0x0c, 0x02, 0xd8, 0x61, 0x05, 0xac,
+	0xd6, 0x55, 0xb2, 0xea, 0xef, 0xb3, 0x50, 0x97, 0x6f, 0xd9, 0xa5, 0xa1, 0x61, 0xd9, 0x7f, 0xd0,
+	0x19, 0xc5, 0x47, 0x50, 0x60, 0xb5, 0x19, 0x53, 0xe9, 0x7a, 0x11, 0x92, 0x78, 0x3a, 0x2c, 0x88,
+	0xd0, 0xff, 0x83, 0xbd, 0xd5, 0x38, 0xd0, 0x49, 0xe5, 0xe4, 0x7a, 0x34, 0x10, 0x94, 0x86, 0x7b,
+	0x06, 0x87, 0x96, 0x4b, 0xae, 0x6c, 0xd6, 0x31, 0x32, 0xf5, 0x04, 0x31, 0x7d, 0x81, 0xd3, 0x23,
+	0xcb, 0xed, 0x71, 0x64, 0x8f, 0xd2, 0x40, 0xee, 0xf9, 0x53, 0x78, 0xe2, 0x53, 0xc7, 0xb0, 0xdc,
+	0x68, 0x6c, 0x71, 0xb9, 0x34, 0xaf, 0xe9, 0xea, 0xa4, 0x1d, 0xbe, 0xb3, 0x11, 0xd3, 0xf4, 0x28,
+	0x7d, 0xcd, 0x29, 0xa4, 0x8a, 0xdf, 0xc0, 0xc1, 0xb7, 0x7d, 0x67, 0xe1, 0xf9, 0x21, 0x1f, 0x2c,
+	0xc5, 0x36, 0x7f, 0x0c, 0x65, 0xe6, 0x44, 0x51, 0xac, 0x67, 0x9e, 0x53, 0x72, 0x97, 0x0e, 0x2b,
+	0xa7, 0x82, 0x28, 0x9a, 0xc9, 0xe2, 0x3a, 0x19, 0x58, 0x65, 0xbb, 0x10, 0xb0, 0xa2, 0x70, 0xbc,
+	0xbc, 0x0c, 0x66, 0xbe, 0x75, 0x49, 0xcf, 0x43, 0x7b, 0xa6, 0xdd, 0x30, 0x87, 0x8b, 0xf2, 0xcb,
+	0xbf, 0xe5, 0xa1, 0x1c, 0x43, 0xd1, 0x29, 0xec, 0x5b, 0xee, 0xcc, 0x73, 0xa2, 0x2c, 0xce, 0xaa,
+	0x78, 0xcb, 0x94, 0xe5, 0xe8, 0x5e, 0x84, 0x92, 0xac, 0xfb, 0x26, 0xa3, 0x4f, 0x65, 0x7d, 0x49,
+	0x9f, 0x15, 0xf4, 0xc9, 0xc4, 0x2f, 0xe8, 0x4f, 0x40, 0x89, 0xf9, 0xf3, 0x8a, 0x7b, 0x55, 0x25,
+	0x44, 0x70, 0x26, 0x8c, 0xa0, 0x8c, 0x39, 0x47, 0x94, 0xf9, 0x74, 0x3d, 0x21, 0x29, 0x9f, 0x41,
+	0x35, 0x2e, 0xbe, 0x88, 0x2b, 0x5e, 0x28, 0x8f, 0x2b, 0x31, 0x4c, 0x0f, 0xd0, 0x9f, 0x00, 0x50,
+	0x76, 0x3f, 0x12, 0xde, 0x2d, 0x84, 0x23, 0xa4, 0xa7, 0x26, 0xb1, 0x02, 0x4e, 0xf9, 0xbf, 0x93,
+	0xbb, 0x05, 0xc5, 0x65, 0x1a, 0xfd, 0x44, 0xbf, 0x82, 0xda, 0x95, 0xe7, 0xff, 0x60, 0xf8, 0x26,
+	0xe1, 0x40, 0xee, 0x24, 0x95, 0x94, 0xe5, 0xf6, 0x04, 0x9e, 0x6f, 0xc7, 0xd5, 0xab, 0xc4, 0x0a,
+	0xf5, 0x01, 0x45, 0xbb, 0x79, 0x01, 0x2b, 0x58, 0x94, 0x38, 0x8b, 0xe3, 0x4d, 0x16, 0xcc, 0x87,
+	0x05, 0x1b, 0xe5, 0x6a, 0x0d, 0x82, 0x3e, 0x87, 0x6a, 0x40, 0xc3, 0xd0, 0xa6, 0x92, 0x49, 0xb9,
+	0x95, 0x59, 0x33, 0xfb, 0x31, 0x47, 0x8b, 0xfd, 0x95, 0x60, 0xb5, 0x40, 0xbf, 0x86, 0x5d, 0xdb,
+	0x72, 0xdf, 0x26, 0x45, 0x80, 0x8d, 0xf9, 0xcf, 0xc0, 0x72, 0xdf, 0xae, 0xce, 0xaf, 0xd9, 0xc9,
+	0xa5, 0xfa, 0x2b, 0x28, 0xc7, 0xda, 0x41, 0x15, 0x28, 0xca, 0x2e, 0x56, 0x79, 0x0f, 0x95, 0x20,
+	0x3f, 0xd6, 0x74, 0xe6, 0xfa, 0x15, 0x28, 0x62, 0xad, 0xa3, 0xf5, 0xdf, 0x68, 0x4a, 0x96, 0x2d,
+	0x7a, 0x23, 0xfc, 0x4d, 0x1b, 0x77, 0x95, 0x9c, 0xfa, 0x4f, 0x19, 0x28, 0xf1, 0x07, 0x73, 0xaf,
+	0x3c, 0xf4, 0x4b, 0x88, 0x6d, 0x89, 0x47, 0x3b, 0xd6, 0x06, 0x4a, 0xd3, 0x8e, 0xed, 0x63, 0x22,
+	0xe1, 0x8c, 0x38, 0xb6, 0x84, 0x98, 0x58, 0xd8, 0x79, 0x6c, 0x22, 0x31, 0xf1, 0x4b, 0x38, 0x88,
+	0x39, 0x27, 0x2b, 0xdb, 0x5c, 0xda, 0x82, 0xdb, 0x71, 0x85, 0xfb, 0x12, 0x0e, 0x62, 0xee, 0xc9,
+	0x0d, 0xf9, 0xb4, 0x09, 0xc7, 0x1b, 0xd4, 0x3f, 0x86, 0x6a, 0xf2, 0xb1, 0xd1, 0x87, 0x90, 0xb7,
+	0xdc, 0x2b, 0x4f, 0x96, 0xa5, 0xfb, 0x6b, 0x56, 0xc5, 0xae, 0x8b, 0x39, 0x81, 0x8a, 0x40, 0x59,
+	0x7f, 0x62, 0xb5, 0x06, 0x95, 0xc4, 0x8b, 0xa9, 0xff, 0x98, 0x81, 0x5a, 0xea, 0x0d, 0x7e, 0x34,
+	0x77, 0x96, 0x19, 0x7f, 0xb0, 0x7c, 0x4a, 0x92, 0x13, 0xc5, 0x87, 0x47, 0x83, 0x15, 0xb6, 0x47,
+	0x02, 0xd0, 0x2f, 0xa0, 0x1e, 0xcf, 0xd8, 0x42, 0xdf, 0x72, 0xaf, 0xb9, 0xd6, 0xca, 0xb8, 0x26,
+	0xa1, 0x63, 0x0e, 0x64, 0x01, 0x39, 0x52, 0x23, 0xd7, 0x52, 0x09, 0xc7, 0x6b, 0xf5, 0x6f, 0x32,
+	0x51, 0x89, 0xcb, 0x7c, 0x5e, 0xa6, 0x4b, 0x59, 0x60, 0x9e, 0xf1, 0x99, 0x84, 0x4b, 0x16, 0x9e,
+	0xe5, 0x86, 0xf1, 0x85, 0x44, 0xca, 0x94, 0x11, 0xe2, 0x82, 0xa1, 0xf8, 0xa0, 0xc2, 0xe5, 0x3f,
+	0xd1, 0xc7, 0xb0, 0x23, 0xe7, 0xf2, 0xe2, 0x3e, 0xc7, 0x6b, 0xc3, 0x4a, 0x71, 0x82, 0x1c, 0xcc,
+	0x4b, 0x52, 0xb5, 0x09, 0x8d, 0x4d, 0x19, 0x64, 0x9d, 0xfd, 0x77, 0x19, 0x50, 0x34, 0xf3, 0x9a,
+	0x8e, 0x67, 0x9e, 0x1f, 0x77, 0x41, 0x4f, 0x01, 0xe4, 0x17, 0xa7, 0x55, 0xb0, 0x2b, 0x4b, 0x48,
+	0xdf, 0x64, 0x01, 0x98, 0x35, 0x84, 0x3c, 0x02, 0x47, 0x29, 0x88, 0x01, 0x58, 0x04, 0x66, 0xa3,
+	0x94, 0xd0, 0x13, 0x28, 0xf1, 0xf5, 0x6b, 0x27, 0xf4, 0x22, 0x44, 0xd4, 0x07, 0xe5, 0x53, 0x33,
+	0x96, 0xb5, 0x9e, 0xab, 0xb0, 0xd6, 0x73, 0xa9, 0xbf, 0x85, 0xbd, 0x84, 0x84, 0x32, 0x09, 0x3c,
+	0x20, 0xe2, 0x73, 0xd8, 0xe5, 0x1d, 0x65, 0x78, 0x17, 0xf3, 0x95, 0x23, 0x70, 0x09, 0x96, 0xbc,
+	0xcf, 0xe1, 0x60, 0xe0, 0x79, 0x6f, 0x97, 0x8b, 0x8e, 0xe5, 0xcf, 0x96, 0x56, 0xdc, 0xca, 0x24,
+	0x84, 0xcd, 0xa4, 0x84, 0x3d, 0x82, 0x62, 0x14, 0x7d, 0x45, 0x50, 0xdf, 0x99, 0xf3, 0xa8, 0xab,
+	0xfe, 0x57, 0x16, 0x1e, 0xad, 0xb1, 0x92, 0xa2, 0x26, 0x63, 0x7c, 0x9a, 0x69, 0x3d, 0x99, 0x40,
+	0xee, 0xc9, 0x06, 0xd9, 0x07, 0xb3, 0xc1, 0xbb, 0xbb, 0xcb, 0x9f, 0x94, 0x37, 0x52, 0x4d, 0x61,
+	0x61, 0x73, 0xf0, 0x7f, 0x5f, 0x34, 0x11, 0xa9, 0xfc, 0x27, 0x44, 0x13, 0x51, 0x55, 0x6d, 0x46,
+	0x93, 0x55, 0x31, 0x54, 0xda, 0x28, 0x86, 0xa4, 0x5e, 0x93, 0xc5, 0x90, 0xda, 0x03, 0xc4, 0xbc,
+	0xf5, 0xff, 0xfc, 0x7a, 0x8f, 0x60, 0x3f, 0xc5, 0x47, 0x3c, 0xdd, 0x8b, 0x7f, 0xcd, 0x40, 0x35,
+	0x59, 0x83, 0x3d, 0x50, 0xe8, 0x21, 0xa8, 0x8b, 0x42, 0x8f, 0x4c, 0xfa, 0x43, 0x6d, 0x34, 0x65,
+	0x83, 0xef, 0x7d, 0xd8, 0x95, 0x30, 0x7d, 0x44, 0xf0, 0x68, 0x3a, 0xd1, 0x94, 0x1c, 0x52, 0xa0,
+	0x2a, 0x81, 0x1a, 0xc6, 0x23, 0xac, 0xe4, 0xd9, 0xb4, 0x56, 0x42, 0x36, 0x87, 0xe8, 0xd1, 0x8c,
+	0xbd, 0x90, 0x60, 0xd6, 0x69, 0xeb, 0x1d, 0x8d, 0x95, 0x94, 0x3b, 0xe8, 0x18, 0x8e, 0x24, 0xf0,
+	0xf5, 0xb4, 0xfb, 0xa5, 0x36, 0x21, 0xda, 0xb7, 0xe7, 0xed, 0xe9, 0x78, 0xa2, 0x75, 0x95, 0x22,
+	0x1f, 0xab, 0x47, 0x7c, 0x57, 0x13, 0x69, 0xf2, 0xba, 0x3d, 0x60, 0x0c, 0x94, 0xd2, 0x8b, 0x4f,
+	0x40, 0x59, 0x0f, 0x1c, 0xac, 0x60, 0xd5, 0x74, 0x36, 0x78, 0x55, 0xde, 0x63, 0x49, 0x4b, 0x8e,
+	0xa5, 0x95, 0x0c, 0x4b, 0x6c, 0xed, 0xe9, 0x64, 0xa4, 0x64, 0x5f, 0x9c, 0x41, 0x35, 0xf9, 0x1e,
+	0x8c, 0xec, 0x42, 0xd3, 0xbb, 0x6c, 0xa8, 0xcc, 0xf3, 0xdf, 0xe8, 0x42, 0xd3, 0x45, 0xfe, 0xeb,
+	0x0c, 0x46, 0x7c, 0xd6, 0x9c, 0x3d, 0xfb, 0xe7, 0x2a, 0xec, 0xf0, 0x5e, 0xc0, 0x47, 0xe7, 0x50,
+	0x49, 0x7c, 0xc9, 0x45, 0x4f, 0xdf, 0xf9, 0x85, 0xb7, 0xd9, 0xd8, 0x5e, 0x12, 0x2f, 0x83, 0x57,
+	0x19, 0xf4, 0x15, 0x54, 0x93, 0x1f, 0x0b, 0x51, 0x32, 0xc2, 0x6f, 0xf9, 0x8a, 0xf8, 0x4e, 0x5e,
+	0x5f, 0x83, 0xa2, 0x05, 0xa1, 0xe5, 0x30, 0x03, 0x93, 0x9f, 0xe0, 0x50, 0x33, 0x41, 0xbf, 0xf6,
+	0x6d, 0xaf, 0x79, 0xbc, 0x15, 0x27, 0xdd, 0x7e, 0x00, 0x95, 0xc4, 0x07, 0xb0, 0x8d, 0x2b, 0xa6,
+	0xbf, 0xba, 0x35, 0xdf, 0xbf, 0x0f, 0x2d, 0xb9, 0x99, 0xb0, 0xbf, 0x65, 0xd0, 0x89, 0x7e, 0x91,
+	0x94, 0xe0, 0xde, 0xa1, 0x6a, 0xf3, 0xf9, 0x43, 0x64, 0xab, 0x53, 0xb6, 0x4c, 0x44, 0x53, 0xa7,
+	0xdc, 0x3f, 0x4f, 0x6d, 0x3e, 0x7f, 0x88, 0x4c, 0x9e, 0xd2, 0x07, 0x58, 0xcd, 0xb3, 0xd0, 0x93,
+	0xc4, 0xae, 0x8d, 0x81, 0x5c, 0xf3, 0xe9, 0x3d, 0x58, 0xc9, 0xea, 0x1b, 0xa8, 0xb7, 0x4d, 0x73,
+	0xe2, 0xc5, 0xd3, 0x20, 0x94, 0x6c, 0x57, 0xb7, 0xcf, 0xa9, 0x9a, 0xea, 0xbb, 0x48, 0x24, 0xe3,
+	0x3f, 0x67, 0xfa, 0x76, 0xbc, 0x1b, 0xda, 0xf3, 0x3d, 0xe7, 0x0f, 0xce, 0x7d, 0x0a, 0xf5, 0xf4,
+	0x10, 0x0b, 0xb5, 0xd6, 0x75, 0xb7, 0xc1, 0xf7, 0xd9, 0x3b, 0x28, 0x24, 0x5b, 0x0c, 0xb5, 0xd4,
+	0xcc, 0x0e, 0x7d, 0x90, 0x0c, 0x9f, 0x5b, 0x06, 0x81, 0xcd, 0xd6, 0xfd, 0x04, 0x6b, 0x26, 0x91,
+	0x1e, 0x40, 0x6c, 0x9a, 0xc4, 0xd6, 0x21, 0x5a, 0xf3, 0xf9, 0x43, 0x64, 0xf2, 0x94, 0x21, 0xd4,
+	0x93, 0xce, 0xfa, 0xe6, 0xec, 0x41, 0x3f, 0x7e, 0xbc, 0xe9, 0xc7, 0xb2, 0xa1, 0x7e, 0x95, 0x41,
+	0x5d, 0xa8, 0x26, 0x5b, 0x47, 0xb4, 0x56, 0x56, 0x71, 0x60, 0x33, 0xa9, 0x9c, 0xad, 0x8d, 0xe6,
+	0x04, 0xf6, 0xb7, 0x34, 0x8a, 0xa9, 0xab, 0xdf, 0xdf, 0x48, 0x36, 0x0f, 0xb6, 0xf5, 0x53, 0xaf,
+	0x32, 0xe8, 0x77, 0xa0, 0xac, 0x57, 0x63, 0x68, 0xd3, 0x66, 0x36, 0xca, 0xc5, 0xe6, 0xcf, 0xde,
+	0x49, 0x23, 0x45, 0x1e, 0x03, 0xc2, 0xf4, 0xda, 0x0a, 0x42, 0xea, 0xc7, 0x35, 0x93, 0x9f, 0x72,
+	0xb1, 0x8d, 0x52, 0xaa, 0x79, 0xbc, 0x1d, 0xcb, 0x4f, 0x3d, 0xc9, 0xbc, 0xca, 0x30, 0xb3, 0x4a,
+	0x55, 0x36, 0x29, 0xb3, 0xda, 0x56, 0x3e, 0x35, 0x5b, 0xf7, 0x13, 0xac, 0xa2, 0x63, 0x22, 0xe1,
+	0xa6, 0xa2, 0xe3, 0x66, 0x42, 0x6f, 0xbe, 0x7f, 0x1f, 0x5a, 0x70, 0x7b, 0xfd, 0xe2, 0xb7, 0x27,
+	0xd7, 0x56, 0x38, 0x5f, 0x5e, 0x9e, 0xce, 0x3c, 0xe7, 0xa5, 0x49, 0x67, 0x3e, 0x35, 0x5f, 0x9a,
+	0x33, 0xdf, 0x76, 0xcd, 0x97, 0xb6, 0xbb, 0xfa, 0xcb, 0x2f, 0x7f, 0x31, 0xbb, 0xdc, 0xe1, 0x7f,
+	0xe7, 0xf5, 0xf1, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xb2, 0x35, 0x60, 0xb6, 0x17, 0x26, 0x00,
+	0x00,
 }
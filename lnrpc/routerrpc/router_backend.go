@@ -12,8 +12,11 @@ import (
 
 	"github.com/decred/dcrd/chaincfg/v2"
 	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/feature"
 	"github.com/decred/dcrlnd/lnrpc"
 	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/record"
 	"github.com/decred/dcrlnd/routing"
 	"github.com/decred/dcrlnd/routing/route"
 	"github.com/decred/dcrlnd/tlv"
@@ -47,6 +50,14 @@ type RouterBackend struct {
 
 	MissionControl MissionControl
 
+	// AvoidList is the operator defined set of nodes and channels that are
+	// excluded from path finding.
+	AvoidList AvoidList
+
+	// PaymentMetrics is the record of the success rates and attempt
+	// latencies of our payments per destination.
+	PaymentMetrics PaymentMetrics
+
 	// ActiveNetParams are the network parameters of the primary network
 	// that the route is operating on. This is necessary so we can ensure
 	// that we receive payment requests that send to destinations on our
@@ -60,6 +71,14 @@ type RouterBackend struct {
 	// MaxTotalTimelock is the maximum total time lock a route is allowed to
 	// have.
 	MaxTotalTimelock uint32
+
+	// FeeLimitSchedule is the schedule of the fee limits applied to
+	// payments that don't specify one.
+	FeeLimitSchedule FeeLimitSchedule
+
+	// EdgeScorer consults the registered external edge score provider, if
+	// any, for additional edge penalties during path finding.
+	EdgeScorer *routing.EdgeScorer
 }
 
 // MissionControl defines the mission control dependencies of routerrpc.
@@ -73,11 +92,48 @@ type MissionControl interface {
 	// state as if no payment attempts have been made.
 	ResetHistory() error
 
+	// PruneHistory removes the results of the payment attempts that
+	// completed before the passed time from the history of MissionControl
+	// and rederives its state from the remaining results.
+	PruneHistory(before time.Time) error
+
 	// GetHistorySnapshot takes a snapshot from the current mission control
 	// state and actual probability estimates.
 	GetHistorySnapshot() *routing.MissionControlSnapshot
 }
 
+// PaymentMetrics defines the payment metrics dependencies of routerrpc.
+type PaymentMetrics interface {
+	// Query returns the aggregated metrics of the given destination, or
+	// nil if there are no recent results towards it.
+	Query(dest route.Vertex) *routing.DestinationMetrics
+
+	// QueryAll returns the aggregated metrics of every destination with
+	// recent results.
+	QueryAll() []*routing.DestinationMetrics
+}
+
+// AvoidList defines the avoid list dependencies of routerrpc.
+type AvoidList interface {
+	// AddNodes adds the passed nodes to the avoid list.
+	AddNodes(nodes ...route.Vertex) error
+
+	// RemoveNodes removes the passed nodes from the avoid list.
+	RemoveNodes(nodes ...route.Vertex) error
+
+	// AddChannels adds the passed channels to the avoid list.
+	AddChannels(chanIDs ...uint64) error
+
+	// RemoveChannels removes the passed channels from the avoid list.
+	RemoveChannels(chanIDs ...uint64) error
+
+	// Nodes returns all nodes currently on the avoid list.
+	Nodes() []route.Vertex
+
+	// Channels returns all channels currently on the avoid list.
+	Channels() []uint64
+}
+
 // QueryRoutes attempts to query the daemons' Channel Router for a possible
 // route to a target destination capable of carrying a specific amount of
 // satoshis within the route's flow. The retuned route contains the full
@@ -129,7 +185,7 @@ func (r *RouterBackend) QueryRoutes(ctx context.Context,
 	}
 
 	// Unmarshall restrictions from request.
-	feeLimit := calculateFeeLimit(in.FeeLimit, amtMSat)
+	feeLimit := r.CalculateFeeLimit(in.FeeLimit, amtMSat)
 
 	ignoredNodes := make(map[route.Vertex]struct{})
 	for _, ignorePubKey := range in.IgnoredNodes {
@@ -218,6 +274,7 @@ func (r *RouterBackend) QueryRoutes(ctx context.Context,
 		},
 		DestPayloadTLV: len(destTLV) != 0,
 		CltvLimit:      cltvLimit,
+		EdgePenalty:    r.EdgeScorer.PenaltySource(),
 	}
 
 	// If we have any TLV records destined for the final hop, then we'll
@@ -281,6 +338,15 @@ func (r *RouterBackend) getSuccessProbability(rt *route.Route) float64 {
 	return successProb
 }
 
+// PeerReliability returns the probability of the given peer forwarding a
+// payment of the given amount to our node, based on the current state of
+// mission control.
+func (r *RouterBackend) PeerReliability(peer route.Vertex,
+	amt lnwire.MilliAtom) float64 {
+
+	return r.MissionControl.GetProbability(peer, r.SelfNode, amt)
+}
+
 // rpcEdgeToPair looks up the provided channel and returns the channel endpoints
 // as a directed pair.
 func (r *RouterBackend) rpcEdgeToPair(e *lnrpc.EdgeLocator) (
@@ -301,10 +367,11 @@ func (r *RouterBackend) rpcEdgeToPair(e *lnrpc.EdgeLocator) (
 	return pair, nil
 }
 
-// calculateFeeLimit returns the fee limit in millisatoshis. If a percentage
+// CalculateFeeLimit returns the fee limit in MilliAtoms. If a percentage
 // based fee limit has been requested, we'll factor in the ratio provided with
-// the amount of the payment.
-func calculateFeeLimit(feeLimit *lnrpc.FeeLimit,
+// the amount of the payment. If no fee limit was specified, the limit is taken
+// from the fee limit schedule.
+func (r *RouterBackend) CalculateFeeLimit(feeLimit *lnrpc.FeeLimit,
 	amount lnwire.MilliAtom) lnwire.MilliAtom {
 
 	switch feeLimit.GetLimit().(type) {
@@ -315,10 +382,10 @@ func calculateFeeLimit(feeLimit *lnrpc.FeeLimit,
 	case *lnrpc.FeeLimit_Percent:
 		return amount * lnwire.MilliAtom(feeLimit.GetPercent()) / 100
 	default:
-		// If a fee limit was not specified, we'll use the payment's
-		// amount as an upper bound in order to avoid payment attempts
-		// from incurring fees higher than the payment amount itself.
-		return amount
+		// If a fee limit was not specified, we'll use the limit of the
+		// schedule bucket the payment amount falls in, or the
+		// payment's amount itself if there's no schedule.
+		return r.FeeLimitSchedule.FeeLimit(amount)
 	}
 }
 
@@ -481,8 +548,31 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 	payIntent := &routing.LightningPayment{}
 
 	// Pass along an outgoing channel restriction if specified.
-	if rpcPayReq.OutgoingChanId != 0 {
-		payIntent.OutgoingChannelID = &rpcPayReq.OutgoingChanId
+	switch {
+	case rpcPayReq.OutgoingChanId != 0 &&
+		len(rpcPayReq.OutgoingChanIds) > 0:
+
+		return nil, errors.New("outgoing_chan_id and " +
+			"outgoing_chan_ids cannot both be set")
+
+	case rpcPayReq.OutgoingChanId != 0:
+		payIntent.OutgoingChannelIDs = []uint64{
+			rpcPayReq.OutgoingChanId,
+		}
+
+	case len(rpcPayReq.OutgoingChanIds) > 0:
+		payIntent.OutgoingChannelIDs = rpcPayReq.OutgoingChanIds
+	}
+
+	// Pass along a last hop restriction if specified.
+	if len(rpcPayReq.LastHopPubkey) > 0 {
+		lastHop, err := route.NewVertexFromBytes(
+			rpcPayReq.LastHopPubkey,
+		)
+		if err != nil {
+			return nil, err
+		}
+		payIntent.LastHop = &lastHop
 	}
 
 	// Take the CLTV limit from the request if set, otherwise use the max.
@@ -504,6 +594,41 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 		return nil, errors.New("timeout_seconds must be specified")
 	}
 
+	// Take the optional budgets of the payment from the request.
+	switch {
+	case rpcPayReq.MaxTotalFeeAtoms < 0:
+		return nil, errors.New("max_total_fee_atoms must not be " +
+			"negative")
+
+	case rpcPayReq.MaxDurationSeconds < 0:
+		return nil, errors.New("max_duration_seconds must not be " +
+			"negative")
+	}
+	payIntent.MaxAttempts = rpcPayReq.MaxAttempts
+	payIntent.MaxTotalFee = lnwire.NewMAtomsFromAtoms(
+		dcrutil.Amount(rpcPayReq.MaxTotalFeeAtoms),
+	)
+	payIntent.MaxDuration = time.Second *
+		time.Duration(rpcPayReq.MaxDurationSeconds)
+	payIntent.ShadowRoute = rpcPayReq.ShadowRoute
+
+	// Take the optional note of the payment from the request.
+	err = channeldb.ValidatePaymentNote(
+		rpcPayReq.Label, rpcPayReq.Reference,
+	)
+	if err != nil {
+		return nil, err
+	}
+	payIntent.Label = rpcPayReq.Label
+	payIntent.Reference = rpcPayReq.Reference
+
+	payIntent.UnsyncedGraphAction, err = UnmarshallUnsyncedGraphAction(
+		rpcPayReq.UnsyncedGraphAction,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	var destTLV map[uint64][]byte
 	if len(destTLV) != 0 {
 		var err error
@@ -557,6 +682,11 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 			return nil, err
 		}
 
+		err = ValidatePayReqFeatures(payReq)
+		if err != nil {
+			return nil, err
+		}
+
 		// If the amount was not included in the invoice, then we let
 		// the payee specify the amount of satoshis they wish to send.
 		// We override the amount to pay with the amount provided from
@@ -589,6 +719,18 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 		payIntent.RouteHints = append(
 			payIntent.RouteHints, payReq.RouteHints...,
 		)
+		payIntent.DestFeatures = payReq.Features
+
+		// If the invoice carries a payment address, the receiver
+		// requires it to be echoed back in the final hop payload.
+		if payReq.PaymentAddr != nil {
+			mpp := record.NewMPP(
+				payIntent.Amount, *payReq.PaymentAddr,
+			)
+			payIntent.FinalDestRecords = append(
+				payIntent.FinalDestRecords, mpp.Record(),
+			)
+		}
 	} else {
 		// Otherwise, If the payment request field was not specified
 		// (and a custom route wasn't specified), construct the payment
@@ -693,6 +835,29 @@ func ValidatePayReqExpiry(payReq *zpay32.Invoice) error {
 	return nil
 }
 
+// ValidatePayReqFeatures checks that the features of the passed payment
+// request set all their dependencies, and that a payment address is included
+// if the invoice requires one. Paying such an invoice without its payment
+// address would only get the payment rejected by the receiver.
+func ValidatePayReqFeatures(payReq *zpay32.Invoice) error {
+	if payReq.Features == nil {
+		return nil
+	}
+
+	if err := feature.ValidateDeps(payReq.Features); err != nil {
+		return fmt.Errorf("invalid invoice features: %v", err)
+	}
+
+	if payReq.Features.IsSet(lnwire.PaymentAddrRequired) &&
+		payReq.PaymentAddr == nil {
+
+		return errors.New("invoice requires a payment address, but " +
+			"none was included")
+	}
+
+	return nil
+}
+
 // ValidateCLTVLimit returns a valid CLTV limit given a value and a maximum. If
 // the value exceeds the maximum, then an error is returned. If the value is 0,
 // then the maximum is used.
@@ -707,3 +872,27 @@ func ValidateCLTVLimit(val, max uint32) (uint32, error) {
 		return val, nil
 	}
 }
+
+// UnmarshallUnsyncedGraphAction converts the action requested for a payment
+// sent before the graph is synced to its routing counterpart.
+func UnmarshallUnsyncedGraphAction(action lnrpc.UnsyncedGraphAction) (
+	routing.UnsyncedGraphAction, error) {
+
+	switch action {
+	case lnrpc.UnsyncedGraphAction_UNSYNCED_GRAPH_DEFAULT:
+		return routing.UnsyncedGraphDefault, nil
+
+	case lnrpc.UnsyncedGraphAction_UNSYNCED_GRAPH_SEND:
+		return routing.UnsyncedGraphSend, nil
+
+	case lnrpc.UnsyncedGraphAction_UNSYNCED_GRAPH_REFUSE:
+		return routing.UnsyncedGraphRefuse, nil
+
+	case lnrpc.UnsyncedGraphAction_UNSYNCED_GRAPH_QUEUE:
+		return routing.UnsyncedGraphQueue, nil
+
+	default:
+		return 0, fmt.Errorf("unknown unsynced graph action: %v",
+			action)
+	}
+}
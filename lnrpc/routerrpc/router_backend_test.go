@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/hex"
 	"testing"
+	"time"
 
 	"github.com/decred/dcrd/dcrutil/v2"
 	"github.com/decred/dcrlnd/lnwire"
@@ -171,6 +172,10 @@ func (m *mockMissionControl) ResetHistory() error {
 	return nil
 }
 
+func (m *mockMissionControl) PruneHistory(before time.Time) error {
+	return nil
+}
+
 func (m *mockMissionControl) GetHistorySnapshot() *routing.MissionControlSnapshot {
 	return nil
 }
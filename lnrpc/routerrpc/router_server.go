@@ -9,6 +9,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/decred/dcrd/dcrutil/v2"
 	"github.com/decred/dcrlnd/channeldb"
@@ -76,6 +77,54 @@ var (
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/routerrpc.Router/AddToAvoidList": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/routerrpc.Router/RemoveFromAvoidList": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/routerrpc.Router/QueryAvoidList": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/routerrpc.Router/CancelPayment": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/routerrpc.Router/QueryPaymentMetrics": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/routerrpc.Router/TrackPaymentV2": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/routerrpc.Router/XImportGraph": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/routerrpc.Router/SubscribeHtlcEvents": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/routerrpc.Router/UpdateChanStatus": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/routerrpc.Router/LookupCircuit": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/routerrpc.Router/FailCircuit": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/routerrpc.Router/RegisterEdgeScorer": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 	}
 
 	// DefaultRouterMacFilename is the default name of the router macaroon
@@ -218,6 +267,11 @@ func (s *Server) SendPayment(req *SendPaymentRequest,
 			)
 		}
 
+		// The payment may be retried once the graph is synced.
+		if err == routing.ErrGraphNotSynced {
+			return status.Error(codes.Unavailable, err.Error())
+		}
+
 		log.Errorf("SendPayment async error for hash %x: %v",
 			payment.PaymentHash, err)
 
@@ -447,11 +501,19 @@ func marshallChannelUpdate(update *lnwire.ChannelUpdate) *ChannelUpdate {
 }
 
 // ResetMissionControl clears all mission control state and starts with a clean
-// slate.
+// slate. If an age is set, only the results older than it are cleared.
 func (s *Server) ResetMissionControl(ctx context.Context,
 	req *ResetMissionControlRequest) (*ResetMissionControlResponse, error) {
 
-	err := s.cfg.RouterBackend.MissionControl.ResetHistory()
+	mc := s.cfg.RouterBackend.MissionControl
+
+	var err error
+	if req.OlderThanSeconds != 0 {
+		olderThan := time.Duration(req.OlderThanSeconds) * time.Second
+		err = mc.PruneHistory(time.Now().Add(-olderThan))
+	} else {
+		err = mc.ResetHistory()
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -509,6 +571,139 @@ func (s *Server) QueryMissionControl(ctx context.Context,
 	return &response, nil
 }
 
+// AddToAvoidList adds nodes and channels to the persistent avoid list.
+func (s *Server) AddToAvoidList(ctx context.Context,
+	req *UpdateAvoidListRequest) (*UpdateAvoidListResponse, error) {
+
+	nodes, err := parseAvoidListNodes(req.Nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	avoidList := s.cfg.RouterBackend.AvoidList
+	if err := avoidList.AddNodes(nodes...); err != nil {
+		return nil, err
+	}
+	if err := avoidList.AddChannels(req.ChanIds...); err != nil {
+		return nil, err
+	}
+
+	return &UpdateAvoidListResponse{}, nil
+}
+
+// RemoveFromAvoidList removes nodes and channels from the persistent avoid
+// list.
+func (s *Server) RemoveFromAvoidList(ctx context.Context,
+	req *UpdateAvoidListRequest) (*UpdateAvoidListResponse, error) {
+
+	nodes, err := parseAvoidListNodes(req.Nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	avoidList := s.cfg.RouterBackend.AvoidList
+	if err := avoidList.RemoveNodes(nodes...); err != nil {
+		return nil, err
+	}
+	if err := avoidList.RemoveChannels(req.ChanIds...); err != nil {
+		return nil, err
+	}
+
+	return &UpdateAvoidListResponse{}, nil
+}
+
+// QueryAvoidList returns all nodes and channels currently on the avoid list.
+func (s *Server) QueryAvoidList(ctx context.Context,
+	req *QueryAvoidListRequest) (*QueryAvoidListResponse, error) {
+
+	avoidList := s.cfg.RouterBackend.AvoidList
+
+	nodes := avoidList.Nodes()
+	rpcNodes := make([][]byte, 0, len(nodes))
+	for _, n := range nodes {
+		// Copy the vertex to prevent loop variable binding bugs.
+		node := n
+		rpcNodes = append(rpcNodes, node[:])
+	}
+
+	return &QueryAvoidListResponse{
+		Nodes:   rpcNodes,
+		ChanIds: avoidList.Channels(),
+	}, nil
+}
+
+// QueryPaymentMetrics returns the success rates and attempt latencies of the
+// recent payments, aggregated per destination.
+func (s *Server) QueryPaymentMetrics(ctx context.Context,
+	req *QueryPaymentMetricsRequest) (*QueryPaymentMetricsResponse, error) {
+
+	paymentMetrics := s.cfg.RouterBackend.PaymentMetrics
+
+	var metrics []*routing.DestinationMetrics
+	if len(req.Destinations) == 0 {
+		metrics = paymentMetrics.QueryAll()
+	} else {
+		for _, rpcDest := range req.Destinations {
+			dest, err := route.NewVertexFromBytes(rpcDest)
+			if err != nil {
+				return nil, err
+			}
+
+			destMetrics := paymentMetrics.Query(dest)
+			if destMetrics == nil {
+				continue
+			}
+			metrics = append(metrics, destMetrics)
+		}
+	}
+
+	rpcMetrics := make([]*DestinationMetrics, 0, len(metrics))
+	for _, m := range metrics {
+		// Copy the vertex to prevent loop variable binding bugs.
+		dest := m.Destination
+
+		rpcMetrics = append(rpcMetrics, &DestinationMetrics{
+			Destination:          dest[:],
+			NumPayments:          uint32(m.Payments),
+			NumPaymentsSucceeded: uint32(m.PaymentsSucceeded),
+			PaymentSuccessRate:   float32(m.PaymentSuccessRate()),
+			NumAttempts:          uint32(m.Attempts),
+			NumAttemptsSucceeded: uint32(m.AttemptsSucceeded),
+			AttemptSuccessRate:   float32(m.AttemptSuccessRate()),
+			AvgAttemptLatencyMs: int64(
+				m.AvgAttemptLatency / time.Millisecond,
+			),
+			MedianAttemptLatencyMs: int64(
+				m.MedianAttemptLatency / time.Millisecond,
+			),
+			MaxAttemptLatencyMs: int64(
+				m.MaxAttemptLatency / time.Millisecond,
+			),
+			TotalFeeSlippageMAtoms: m.TotalFeeSlippage,
+			NumPaymentsSlipped:     uint32(m.PaymentsSlipped),
+		})
+	}
+
+	return &QueryPaymentMetricsResponse{
+		Destinations: rpcMetrics,
+	}, nil
+}
+
+// parseAvoidListNodes parses the raw node public keys of an avoid list
+// request.
+func parseAvoidListNodes(rpcNodes [][]byte) ([]route.Vertex, error) {
+	nodes := make([]route.Vertex, 0, len(rpcNodes))
+	for _, rpcNode := range rpcNodes {
+		node, err := route.NewVertexFromBytes(rpcNode)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+
+	return nodes, nil
+}
+
 // TrackPayment returns a stream of payment state updates. The stream is
 // closed when the payment completes.
 func (s *Server) TrackPayment(request *TrackPaymentRequest,
@@ -524,6 +719,197 @@ func (s *Server) TrackPayment(request *TrackPaymentRequest,
 	return s.trackPayment(paymentHash, stream)
 }
 
+// TrackPaymentV2 returns a stream of payment updates that carry the details of
+// all htlc attempts of the payment. The stream is closed when the payment
+// completes.
+func (s *Server) TrackPaymentV2(request *TrackPaymentRequest,
+	stream Router_TrackPaymentV2Server) error {
+
+	paymentHash, err := lntypes.MakeHash(request.PaymentHash)
+	if err != nil {
+		return err
+	}
+
+	log.Debugf("TrackPaymentV2 called for payment %v", paymentHash)
+
+	router := s.cfg.RouterBackend
+
+	sub, err := router.Tower.SubscribePaymentUpdates(paymentHash)
+	switch {
+	case err == channeldb.ErrPaymentNotInitiated:
+		return status.Error(codes.NotFound, err.Error())
+	case err != nil:
+		return err
+	}
+	defer sub.Cancel()
+
+	// The subscription sends the current state of the payment right away,
+	// and closes the channel once the payment has completed.
+	for {
+		select {
+		case payment, ok := <-sub.Updates:
+			if !ok {
+				return nil
+			}
+
+			details, err := marshallPaymentDetails(router, payment)
+			if err != nil {
+				return err
+			}
+
+			if err := stream.Send(details); err != nil {
+				return err
+			}
+
+		case <-stream.Context().Done():
+			log.Debugf("Payment details stream %v canceled",
+				paymentHash)
+			return stream.Context().Err()
+		}
+	}
+}
+
+// marshallPaymentDetails marshalls a payment, including all of its htlc
+// attempts, to the corresponding rpc type.
+func marshallPaymentDetails(router *RouterBackend,
+	payment *channeldb.Payment) (*PaymentDetails, error) {
+
+	details := &PaymentDetails{
+		FeeLimitMAtoms:     int64(payment.Info.FeeLimit),
+		InFlightFeesMAtoms: int64(payment.InFlightFees()),
+		RemainingFeeBudgetMAtoms: int64(
+			payment.RemainingFeeBudget(),
+		),
+	}
+
+	switch payment.Status {
+	case channeldb.StatusInFlight:
+		details.State = PaymentState_IN_FLIGHT
+
+	case channeldb.StatusSucceeded:
+		details.State = PaymentState_SUCCEEDED
+		if payment.PaymentPreimage != nil {
+			details.Preimage = payment.PaymentPreimage[:]
+		}
+
+	case channeldb.StatusFailed:
+		if payment.Failure == nil {
+			return nil, errors.New("failed payment without " +
+				"failure reason")
+		}
+
+		state, err := marshallFailureReason(*payment.Failure)
+		if err != nil {
+			return nil, err
+		}
+		details.State = state
+
+	default:
+		return nil, fmt.Errorf("unexpected payment status %v",
+			payment.Status)
+	}
+
+	for _, htlc := range payment.HTLCs {
+		rpcHtlc, err := marshallHTLCAttempt(router, &htlc)
+		if err != nil {
+			return nil, err
+		}
+
+		details.Htlcs = append(details.Htlcs, rpcHtlc)
+	}
+
+	return details, nil
+}
+
+// marshallHTLCAttempt marshalls an htlc attempt to the corresponding rpc type.
+func marshallHTLCAttempt(router *RouterBackend,
+	htlc *channeldb.HTLCAttempt) (*HTLCAttempt, error) {
+
+	rpcRoute, err := router.MarshallRoute(&htlc.Route)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcHtlc := &HTLCAttempt{
+		Status:          HTLCAttempt_IN_FLIGHT,
+		Route:           rpcRoute,
+		AttemptTimeNs:   marshallTimeNano(htlc.AttemptTime),
+		QuotedFeeMAtoms: int64(htlc.QuotedFee),
+	}
+
+	switch {
+	case htlc.Settle != nil:
+		rpcHtlc.Status = HTLCAttempt_SUCCEEDED
+		rpcHtlc.ResolveTimeNs = marshallTimeNano(htlc.Settle.SettleTime)
+		rpcHtlc.Preimage = htlc.Settle.Preimage[:]
+
+	case htlc.Failure != nil:
+		rpcHtlc.Status = HTLCAttempt_FAILED
+		rpcHtlc.ResolveTimeNs = marshallTimeNano(htlc.Failure.FailTime)
+
+		rpcHtlc.Failure, err = marshallHTLCFailure(htlc.Failure)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rpcHtlc, nil
+}
+
+// marshallHTLCFailure marshalls the failure of an htlc attempt to the
+// corresponding rpc type. Internal failures, which don't have an rpc
+// representation, are marshalled as nil.
+func marshallHTLCFailure(failure *channeldb.HTLCFailInfo) (*Failure, error) {
+	switch failure.Reason {
+	case channeldb.HTLCFailInternal:
+		return nil, nil
+
+	case channeldb.HTLCFailUnreadable:
+		return marshallError(htlcswitch.ErrUnreadableFailureMessage)
+	}
+
+	// The failure message is nil for failures that couldn't be decoded,
+	// which is marshalled as an unknown failure.
+	return marshallError(&htlcswitch.ForwardingError{
+		FailureSourceIdx: int(failure.FailureSourceIndex),
+		FailureMessage:   failure.Message,
+	})
+}
+
+// marshallTimeNano converts the passed time to UNIX nanoseconds, the zero time
+// being marshalled as zero.
+func marshallTimeNano(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return t.UnixNano()
+}
+
+// CancelPayment stops launching new attempts for an in-flight payment. The
+// terminal state of the payment is reported through TrackPayment once all
+// outstanding htlcs have been resolved.
+func (s *Server) CancelPayment(ctx context.Context,
+	req *CancelPaymentRequest) (*CancelPaymentResponse, error) {
+
+	paymentHash, err := lntypes.MakeHash(req.PaymentHash)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("CancelPayment called for payment %v", paymentHash)
+
+	err = s.cfg.Router.CancelPayment(paymentHash)
+	switch {
+	case err == routing.ErrPaymentNotInFlight:
+		return nil, status.Error(codes.NotFound, err.Error())
+	case err != nil:
+		return nil, err
+	}
+
+	return &CancelPaymentResponse{}, nil
+}
+
 // trackPayment writes payment status updates to the provided stream.
 func (s *Server) trackPayment(paymentHash lntypes.Hash,
 	stream Router_TrackPaymentServer) error {
@@ -622,6 +1008,15 @@ func marshallFailureReason(reason channeldb.FailureReason) (
 
 	case channeldb.FailureReasonIncorrectPaymentDetails:
 		return PaymentState_FAILED_INCORRECT_PAYMENT_DETAILS, nil
+
+	case channeldb.FailureReasonCanceled:
+		return PaymentState_FAILED_CANCELED, nil
+
+	case channeldb.FailureReasonBudgetExhausted:
+		return PaymentState_FAILED_BUDGET_EXHAUSTED, nil
+
+	case channeldb.FailureReasonInsufficientBalance:
+		return PaymentState_FAILED_INSUFFICIENT_BALANCE, nil
 	}
 
 	return 0, errors.New("unknown failure reason")
@@ -0,0 +1,131 @@
+// +build routerrpc
+
+package routerrpc
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/decred/dcrlnd/htlcswitch"
+)
+
+// SubscribeHtlcEvents creates a uni-directional stream from the server to the
+// client which delivers a stream of htlc events: forwards, settles and
+// failures of the htlcs sent, received and forwarded by the node, along with
+// the htlcs our links refused to handle.
+func (s *Server) SubscribeHtlcEvents(req *SubscribeHtlcEventsRequest,
+	stream Router_SubscribeHtlcEventsServer) error {
+
+	htlcClient, err := s.cfg.HtlcNotifier.SubscribeHtlcEvents()
+	if err != nil {
+		return err
+	}
+	defer htlcClient.Cancel()
+
+	for {
+		select {
+		case event := <-htlcClient.Updates():
+			evt, err := rpcHtlcEvent(event)
+			if err != nil {
+				return err
+			}
+
+			if err := stream.Send(evt); err != nil {
+				return err
+			}
+
+		// If the notifier shuts down, the subscription is over.
+		case <-htlcClient.Quit():
+			return errors.New("htlc event subscription terminated")
+
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+// rpcHtlcEvent returns the rpc form of an htlc event notified by the switch.
+func rpcHtlcEvent(htlcEvent interface{}) (*HtlcEvent, error) {
+	var (
+		key       htlcswitch.HtlcKey
+		eventType htlcswitch.HtlcEventType
+		timestamp int64
+		event     = &HtlcEvent{}
+	)
+
+	switch e := htlcEvent.(type) {
+	case *htlcswitch.ForwardingEvent:
+		key, eventType = e.HtlcKey, e.HtlcEventType
+		timestamp = e.Timestamp.UnixNano()
+		event.ForwardEvent = &ForwardEvent{
+			Info: rpcHtlcInfo(e.HtlcInfo),
+		}
+
+	case *htlcswitch.ForwardingFailEvent:
+		key, eventType = e.HtlcKey, e.HtlcEventType
+		timestamp = e.Timestamp.UnixNano()
+		event.ForwardFailEvent = &ForwardFailEvent{}
+
+	case *htlcswitch.SettleEvent:
+		key, eventType = e.HtlcKey, e.HtlcEventType
+		timestamp = e.Timestamp.UnixNano()
+		event.SettleEvent = &SettleEvent{}
+
+	case *htlcswitch.LinkFailEvent:
+		key, eventType = e.HtlcKey, e.HtlcEventType
+		timestamp = e.Timestamp.UnixNano()
+
+		// The failure is marshalled as if sourced by our node, only
+		// keeping its code. Failures the rpc doesn't know of are
+		// reported with the unknown failure code.
+		wireFailure := Failure_UNKNOWN_FAILURE
+		failure, err := marshallError(&htlcswitch.ForwardingError{
+			FailureMessage: e.Failure,
+		})
+		if err == nil {
+			wireFailure = failure.Code
+		}
+
+		event.LinkFailEvent = &LinkFailEvent{
+			Info:          rpcHtlcInfo(e.HtlcInfo),
+			WireFailure:   wireFailure,
+			FailureString: e.FailureDetail,
+			Incoming:      e.Incoming,
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown htlc event type: %T", htlcEvent)
+	}
+
+	switch eventType {
+	case htlcswitch.HtlcEventTypeSend:
+		event.EventType = HtlcEvent_SEND
+
+	case htlcswitch.HtlcEventTypeReceive:
+		event.EventType = HtlcEvent_RECEIVE
+
+	case htlcswitch.HtlcEventTypeForward:
+		event.EventType = HtlcEvent_FORWARD
+
+	default:
+		return nil, fmt.Errorf("unknown htlc event type: %v", eventType)
+	}
+
+	event.IncomingChannelId = key.IncomingCircuit.ChanID.ToUint64()
+	event.OutgoingChannelId = key.OutgoingCircuit.ChanID.ToUint64()
+	event.IncomingHtlcId = key.IncomingCircuit.HtlcID
+	event.OutgoingHtlcId = key.OutgoingCircuit.HtlcID
+	event.TimestampNs = uint64(timestamp)
+
+	return event, nil
+}
+
+// rpcHtlcInfo returns the rpc form of the amounts and timelocks of an htlc.
+func rpcHtlcInfo(info htlcswitch.HtlcInfo) *HtlcInfo {
+	return &HtlcInfo{
+		IncomingTimelock:  info.IncomingTimeLock,
+		OutgoingTimelock:  info.OutgoingTimeLock,
+		IncomingAmtMAtoms: uint64(info.IncomingAmt),
+		OutgoingAmtMAtoms: uint64(info.OutgoingAmt),
+	}
+}
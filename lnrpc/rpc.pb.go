@@ -24,6 +24,78 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type DaemonState int32
+
+const (
+	// *
+	// The daemon is starting up, or waiting for the wallet to be created or
+	// unlocked through the WalletUnlocker service.
+	DaemonState_WAITING_TO_START DaemonState = 0
+	// *
+	// The wallet is unlocked, and the daemon is waiting for the chain backend
+	// to be synced before starting the main RPC server.
+	DaemonState_UNLOCKED DaemonState = 1
+	// *
+	// The main RPC server is active, but the server may still be starting, so
+	// not all RPCs are usable yet.
+	DaemonState_ACTIVE DaemonState = 2
+	// / The server is fully started, and all RPCs are usable.
+	DaemonState_SERVER_ACTIVE DaemonState = 3
+)
+
+var DaemonState_name = map[int32]string{
+	0: "WAITING_TO_START",
+	1: "UNLOCKED",
+	2: "ACTIVE",
+	3: "SERVER_ACTIVE",
+}
+var DaemonState_value = map[string]int32{
+	"WAITING_TO_START": 0,
+	"UNLOCKED":         1,
+	"ACTIVE":           2,
+	"SERVER_ACTIVE":    3,
+}
+
+func (x DaemonState) String() string {
+	return proto.EnumName(DaemonState_name, int32(x))
+}
+func (DaemonState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{0}
+}
+
+type UnsyncedGraphAction int32
+
+const (
+	// / Apply the action configured for the node.
+	UnsyncedGraphAction_UNSYNCED_GRAPH_DEFAULT UnsyncedGraphAction = 0
+	// / Send the payment over the graph as it is.
+	UnsyncedGraphAction_UNSYNCED_GRAPH_SEND UnsyncedGraphAction = 1
+	// / Fail the payment right away with a graph not synced error.
+	UnsyncedGraphAction_UNSYNCED_GRAPH_REFUSE UnsyncedGraphAction = 2
+	// / Hold the payment back until the graph is synced.
+	UnsyncedGraphAction_UNSYNCED_GRAPH_QUEUE UnsyncedGraphAction = 3
+)
+
+var UnsyncedGraphAction_name = map[int32]string{
+	0: "UNSYNCED_GRAPH_DEFAULT",
+	1: "UNSYNCED_GRAPH_SEND",
+	2: "UNSYNCED_GRAPH_REFUSE",
+	3: "UNSYNCED_GRAPH_QUEUE",
+}
+var UnsyncedGraphAction_value = map[string]int32{
+	"UNSYNCED_GRAPH_DEFAULT": 0,
+	"UNSYNCED_GRAPH_SEND":    1,
+	"UNSYNCED_GRAPH_REFUSE":  2,
+	"UNSYNCED_GRAPH_QUEUE":   3,
+}
+
+func (x UnsyncedGraphAction) String() string {
+	return proto.EnumName(UnsyncedGraphAction_name, int32(x))
+}
+func (UnsyncedGraphAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{1}
+}
+
 // *
 // `AddressType` has to be one of:
 //
@@ -60,7 +132,36 @@ func (x AddressType) String() string {
 	return proto.EnumName(AddressType_name, int32(x))
 }
 func (AddressType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{0}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{2}
+}
+
+type Initiator int32
+
+const (
+	// / The initiator of the close wasn't recorded.
+	Initiator_INITIATOR_UNKNOWN Initiator = 0
+	// / We initiated the close of the channel.
+	Initiator_INITIATOR_LOCAL Initiator = 1
+	// / The remote peer initiated the close of the channel.
+	Initiator_INITIATOR_REMOTE Initiator = 2
+)
+
+var Initiator_name = map[int32]string{
+	0: "INITIATOR_UNKNOWN",
+	1: "INITIATOR_LOCAL",
+	2: "INITIATOR_REMOTE",
+}
+var Initiator_value = map[string]int32{
+	"INITIATOR_UNKNOWN": 0,
+	"INITIATOR_LOCAL":   1,
+	"INITIATOR_REMOTE":  2,
+}
+
+func (x Initiator) String() string {
+	return proto.EnumName(Initiator_name, int32(x))
+}
+func (Initiator) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{3}
 }
 
 type InvoiceHTLCState int32
@@ -86,7 +187,180 @@ func (x InvoiceHTLCState) String() string {
 	return proto.EnumName(InvoiceHTLCState_name, int32(x))
 }
 func (InvoiceHTLCState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{1}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{4}
+}
+
+type PaymentFailureReason int32
+
+const (
+	// *
+	// The payment didn't fail (yet).
+	PaymentFailureReason_FAILURE_REASON_NONE PaymentFailureReason = 0
+	// *
+	// There are more routes to try, but the payment timeout was exceeded.
+	PaymentFailureReason_FAILURE_REASON_TIMEOUT PaymentFailureReason = 1
+	// *
+	// All possible routes were tried and failed permanently. Or were no
+	// routes to the destination at all.
+	PaymentFailureReason_FAILURE_REASON_NO_ROUTE PaymentFailureReason = 2
+	// *
+	// A non-recoverable error has occured.
+	PaymentFailureReason_FAILURE_REASON_ERROR PaymentFailureReason = 3
+	// *
+	// Payment details incorrect (unknown hash, invalid amt or
+	// invalid final cltv delta)
+	PaymentFailureReason_FAILURE_REASON_INCORRECT_PAYMENT_DETAILS PaymentFailureReason = 4
+	// *
+	// The payment was canceled by the user before it completed.
+	PaymentFailureReason_FAILURE_REASON_CANCELED PaymentFailureReason = 5
+	// *
+	// The attempt, fee or time budget of the payment was exhausted before it
+	// completed.
+	PaymentFailureReason_FAILURE_REASON_BUDGET_EXHAUSTED PaymentFailureReason = 6
+	// *
+	// None of our channels had enough outbound balance to carry the payment.
+	PaymentFailureReason_FAILURE_REASON_INSUFFICIENT_BALANCE PaymentFailureReason = 7
+)
+
+var PaymentFailureReason_name = map[int32]string{
+	0: "FAILURE_REASON_NONE",
+	1: "FAILURE_REASON_TIMEOUT",
+	2: "FAILURE_REASON_NO_ROUTE",
+	3: "FAILURE_REASON_ERROR",
+	4: "FAILURE_REASON_INCORRECT_PAYMENT_DETAILS",
+	5: "FAILURE_REASON_CANCELED",
+	6: "FAILURE_REASON_BUDGET_EXHAUSTED",
+	7: "FAILURE_REASON_INSUFFICIENT_BALANCE",
+}
+var PaymentFailureReason_value = map[string]int32{
+	"FAILURE_REASON_NONE":                      0,
+	"FAILURE_REASON_TIMEOUT":                   1,
+	"FAILURE_REASON_NO_ROUTE":                  2,
+	"FAILURE_REASON_ERROR":                     3,
+	"FAILURE_REASON_INCORRECT_PAYMENT_DETAILS": 4,
+	"FAILURE_REASON_CANCELED":                  5,
+	"FAILURE_REASON_BUDGET_EXHAUSTED":          6,
+	"FAILURE_REASON_INSUFFICIENT_BALANCE":      7,
+}
+
+func (x PaymentFailureReason) String() string {
+	return proto.EnumName(PaymentFailureReason_name, int32(x))
+}
+func (PaymentFailureReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{5}
+}
+
+type ChannelRecoveryStage int32
+
+const (
+	// / The channel peer hasn't been reached yet.
+	ChannelRecoveryStage_WAITING_FOR_PEER ChannelRecoveryStage = 0
+	// *
+	// The channel peer is connected, and the channel is being reestablished to
+	// learn its latest commitment point.
+	ChannelRecoveryStage_DATA_LOSS_PROTECT ChannelRecoveryStage = 1
+	// *
+	// The channel peer handed its latest commitment point, and is expected to
+	// force close the channel.
+	ChannelRecoveryStage_WAITING_FOR_FORCE_CLOSE ChannelRecoveryStage = 2
+	// / The channel was closed on chain, and our outputs are being swept.
+	ChannelRecoveryStage_SWEEPING ChannelRecoveryStage = 3
+	// / Our outputs of the channel were swept.
+	ChannelRecoveryStage_RECOVERED ChannelRecoveryStage = 4
+)
+
+var ChannelRecoveryStage_name = map[int32]string{
+	0: "WAITING_FOR_PEER",
+	1: "DATA_LOSS_PROTECT",
+	2: "WAITING_FOR_FORCE_CLOSE",
+	3: "SWEEPING",
+	4: "RECOVERED",
+}
+var ChannelRecoveryStage_value = map[string]int32{
+	"WAITING_FOR_PEER":        0,
+	"DATA_LOSS_PROTECT":       1,
+	"WAITING_FOR_FORCE_CLOSE": 2,
+	"SWEEPING":                3,
+	"RECOVERED":               4,
+}
+
+func (x ChannelRecoveryStage) String() string {
+	return proto.EnumName(ChannelRecoveryStage_name, int32(x))
+}
+func (ChannelRecoveryStage) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{6}
+}
+
+type SetPeerConnPolicyRequest_ReconnectPolicy int32
+
+const (
+	// *
+	// Only maintain a persistent connection to the peer if there are
+	// channels with it or it was connected to as a permanent peer.
+	SetPeerConnPolicyRequest_DEFAULT SetPeerConnPolicyRequest_ReconnectPolicy = 0
+	// / Always reconnect to the peer, even if there are no channels with it.
+	SetPeerConnPolicyRequest_ALWAYS SetPeerConnPolicyRequest_ReconnectPolicy = 1
+	// / Never reconnect to the peer once the connection has been lost.
+	SetPeerConnPolicyRequest_NEVER SetPeerConnPolicyRequest_ReconnectPolicy = 2
+)
+
+var SetPeerConnPolicyRequest_ReconnectPolicy_name = map[int32]string{
+	0: "DEFAULT",
+	1: "ALWAYS",
+	2: "NEVER",
+}
+var SetPeerConnPolicyRequest_ReconnectPolicy_value = map[string]int32{
+	"DEFAULT": 0,
+	"ALWAYS":  1,
+	"NEVER":   2,
+}
+
+func (x SetPeerConnPolicyRequest_ReconnectPolicy) String() string {
+	return proto.EnumName(SetPeerConnPolicyRequest_ReconnectPolicy_name, int32(x))
+}
+func (SetPeerConnPolicyRequest_ReconnectPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{43, 0}
+}
+
+type Resolution_ResolutionType int32
+
+const (
+	// *
+	// Our output of a cooperative close, which pays to our wallet without
+	// any further action.
+	Resolution_SETTLED Resolution_ResolutionType = 0
+	// *
+	// Our output of a commitment transaction, which is time-locked if the
+	// commitment is ours.
+	Resolution_COMMIT Resolution_ResolutionType = 1
+	// / An htlc output claimable by us with the preimage.
+	Resolution_INCOMING_HTLC Resolution_ResolutionType = 2
+	// / An htlc output claimable by us once it times out.
+	Resolution_OUTGOING_HTLC Resolution_ResolutionType = 3
+	// / An output of a revoked commitment claimed by our justice transaction.
+	Resolution_BREACH Resolution_ResolutionType = 4
+)
+
+var Resolution_ResolutionType_name = map[int32]string{
+	0: "SETTLED",
+	1: "COMMIT",
+	2: "INCOMING_HTLC",
+	3: "OUTGOING_HTLC",
+	4: "BREACH",
+}
+var Resolution_ResolutionType_value = map[string]int32{
+	"SETTLED":       0,
+	"COMMIT":        1,
+	"INCOMING_HTLC": 2,
+	"OUTGOING_HTLC": 3,
+	"BREACH":        4,
+}
+
+func (x Resolution_ResolutionType) String() string {
+	return proto.EnumName(Resolution_ResolutionType_name, int32(x))
+}
+func (Resolution_ResolutionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{49, 0}
 }
 
 type ChannelCloseSummary_ClosureType int32
@@ -121,7 +395,124 @@ func (x ChannelCloseSummary_ClosureType) String() string {
 	return proto.EnumName(ChannelCloseSummary_ClosureType_name, int32(x))
 }
 func (ChannelCloseSummary_ClosureType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{43, 0}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{50, 0}
+}
+
+type ChannelArbitrator_ArbitratorState int32
+
+const (
+	// / No action needs to be taken on-chain.
+	ChannelArbitrator_DEFAULT ChannelArbitrator_ArbitratorState = 0
+	// / The commitment transaction is about to be broadcast.
+	ChannelArbitrator_BROADCAST_COMMIT ChannelArbitrator_ArbitratorState = 1
+	// / The commitment transaction was broadcast and awaits confirmation.
+	ChannelArbitrator_COMMITMENT_BROADCASTED ChannelArbitrator_ArbitratorState = 2
+	// / The commitment transaction confirmed.
+	ChannelArbitrator_CONTRACT_CLOSED ChannelArbitrator_ArbitratorState = 3
+	// / The outputs of the commitment transaction are being claimed.
+	ChannelArbitrator_WAITING_FULL_RESOLUTION ChannelArbitrator_ArbitratorState = 4
+	// / All the outputs of the commitment transaction are claimed.
+	ChannelArbitrator_FULLY_RESOLVED ChannelArbitrator_ArbitratorState = 5
+	// / A state transition failed, manual intervention is required.
+	ChannelArbitrator_ERROR ChannelArbitrator_ArbitratorState = 6
+)
+
+var ChannelArbitrator_ArbitratorState_name = map[int32]string{
+	0: "DEFAULT",
+	1: "BROADCAST_COMMIT",
+	2: "COMMITMENT_BROADCASTED",
+	3: "CONTRACT_CLOSED",
+	4: "WAITING_FULL_RESOLUTION",
+	5: "FULLY_RESOLVED",
+	6: "ERROR",
+}
+var ChannelArbitrator_ArbitratorState_value = map[string]int32{
+	"DEFAULT":                 0,
+	"BROADCAST_COMMIT":        1,
+	"COMMITMENT_BROADCASTED":  2,
+	"CONTRACT_CLOSED":         3,
+	"WAITING_FULL_RESOLUTION": 4,
+	"FULLY_RESOLVED":          5,
+	"ERROR":                   6,
+}
+
+func (x ChannelArbitrator_ArbitratorState) String() string {
+	return proto.EnumName(ChannelArbitrator_ArbitratorState_name, int32(x))
+}
+func (ChannelArbitrator_ArbitratorState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{55, 0}
+}
+
+type ContractResolver_ResolverType int32
+
+const (
+	// / Our output on the commitment transaction.
+	ContractResolver_COMMIT ContractResolver_ResolverType = 0
+	// / An incoming htlc, claimed with its preimage.
+	ContractResolver_INCOMING_HTLC ContractResolver_ResolverType = 1
+	// / An outgoing htlc, timed out once it expires.
+	ContractResolver_OUTGOING_HTLC ContractResolver_ResolverType = 2
+)
+
+var ContractResolver_ResolverType_name = map[int32]string{
+	0: "COMMIT",
+	1: "INCOMING_HTLC",
+	2: "OUTGOING_HTLC",
+}
+var ContractResolver_ResolverType_value = map[string]int32{
+	"COMMIT":        0,
+	"INCOMING_HTLC": 1,
+	"OUTGOING_HTLC": 2,
+}
+
+func (x ContractResolver_ResolverType) String() string {
+	return proto.EnumName(ContractResolver_ResolverType_name, int32(x))
+}
+func (ContractResolver_ResolverType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{56, 0}
+}
+
+type ContractResolver_ResolverStage int32
+
+const (
+	// *
+	// The preimage of the incoming htlc is required to claim it before it
+	// expires.
+	ContractResolver_AWAITING_PREIMAGE ContractResolver_ResolverStage = 0
+	// *
+	// The outgoing htlc must expire before it can be timed out, unless the
+	// remote party claims it first.
+	ContractResolver_AWAITING_EXPIRY ContractResolver_ResolverStage = 1
+	// *
+	// The output was handed off to the utxo nursery, which sweeps it once its
+	// time locks expire.
+	ContractResolver_INCUBATING ContractResolver_ResolverStage = 2
+	// / The output is being claimed, and the claim awaits confirmation.
+	ContractResolver_SWEEPING ContractResolver_ResolverStage = 3
+	// / The output is claimed.
+	ContractResolver_RESOLVED ContractResolver_ResolverStage = 4
+)
+
+var ContractResolver_ResolverStage_name = map[int32]string{
+	0: "AWAITING_PREIMAGE",
+	1: "AWAITING_EXPIRY",
+	2: "INCUBATING",
+	3: "SWEEPING",
+	4: "RESOLVED",
+}
+var ContractResolver_ResolverStage_value = map[string]int32{
+	"AWAITING_PREIMAGE": 0,
+	"AWAITING_EXPIRY":   1,
+	"INCUBATING":        2,
+	"SWEEPING":          3,
+	"RESOLVED":          4,
+}
+
+func (x ContractResolver_ResolverStage) String() string {
+	return proto.EnumName(ContractResolver_ResolverStage_name, int32(x))
+}
+func (ContractResolver_ResolverStage) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{56, 1}
 }
 
 type Peer_SyncType int32
@@ -136,33 +527,66 @@ const (
 	// *
 	// Denotes that we are not receiving new graph updates from the peer.
 	Peer_PASSIVE_SYNC Peer_SyncType = 2
+	// *
+	// Denotes that this peer is pinned into an active sync, and we are
+	// always receiving new graph updates from it.
+	Peer_PINNED_SYNC Peer_SyncType = 3
 )
 
 var Peer_SyncType_name = map[int32]string{
 	0: "UNKNOWN_SYNC",
 	1: "ACTIVE_SYNC",
 	2: "PASSIVE_SYNC",
+	3: "PINNED_SYNC",
 }
 var Peer_SyncType_value = map[string]int32{
 	"UNKNOWN_SYNC": 0,
 	"ACTIVE_SYNC":  1,
 	"PASSIVE_SYNC": 2,
+	"PINNED_SYNC":  3,
 }
 
 func (x Peer_SyncType) String() string {
 	return proto.EnumName(Peer_SyncType_name, int32(x))
 }
 func (Peer_SyncType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{46, 0}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{57, 0}
+}
+
+type PeerEvent_EventType int32
+
+const (
+	PeerEvent_PEER_ONLINE  PeerEvent_EventType = 0
+	PeerEvent_PEER_OFFLINE PeerEvent_EventType = 1
+	PeerEvent_PEER_ACTIVE  PeerEvent_EventType = 2
+)
+
+var PeerEvent_EventType_name = map[int32]string{
+	0: "PEER_ONLINE",
+	1: "PEER_OFFLINE",
+	2: "PEER_ACTIVE",
+}
+var PeerEvent_EventType_value = map[string]int32{
+	"PEER_ONLINE":  0,
+	"PEER_OFFLINE": 1,
+	"PEER_ACTIVE":  2,
+}
+
+func (x PeerEvent_EventType) String() string {
+	return proto.EnumName(PeerEvent_EventType_name, int32(x))
+}
+func (PeerEvent_EventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{61, 0}
 }
 
 type ChannelEventUpdate_UpdateType int32
 
 const (
-	ChannelEventUpdate_OPEN_CHANNEL     ChannelEventUpdate_UpdateType = 0
-	ChannelEventUpdate_CLOSED_CHANNEL   ChannelEventUpdate_UpdateType = 1
-	ChannelEventUpdate_ACTIVE_CHANNEL   ChannelEventUpdate_UpdateType = 2
-	ChannelEventUpdate_INACTIVE_CHANNEL ChannelEventUpdate_UpdateType = 3
+	ChannelEventUpdate_OPEN_CHANNEL      ChannelEventUpdate_UpdateType = 0
+	ChannelEventUpdate_CLOSED_CHANNEL    ChannelEventUpdate_UpdateType = 1
+	ChannelEventUpdate_ACTIVE_CHANNEL    ChannelEventUpdate_UpdateType = 2
+	ChannelEventUpdate_INACTIVE_CHANNEL  ChannelEventUpdate_UpdateType = 3
+	ChannelEventUpdate_REMOTE_COMMITMENT ChannelEventUpdate_UpdateType = 4
 )
 
 var ChannelEventUpdate_UpdateType_name = map[int32]string{
@@ -170,19 +594,54 @@ var ChannelEventUpdate_UpdateType_name = map[int32]string{
 	1: "CLOSED_CHANNEL",
 	2: "ACTIVE_CHANNEL",
 	3: "INACTIVE_CHANNEL",
+	4: "REMOTE_COMMITMENT",
 }
 var ChannelEventUpdate_UpdateType_value = map[string]int32{
-	"OPEN_CHANNEL":     0,
-	"CLOSED_CHANNEL":   1,
-	"ACTIVE_CHANNEL":   2,
-	"INACTIVE_CHANNEL": 3,
+	"OPEN_CHANNEL":      0,
+	"CLOSED_CHANNEL":    1,
+	"ACTIVE_CHANNEL":    2,
+	"INACTIVE_CHANNEL":  3,
+	"REMOTE_COMMITMENT": 4,
 }
 
 func (x ChannelEventUpdate_UpdateType) String() string {
 	return proto.EnumName(ChannelEventUpdate_UpdateType_name, int32(x))
 }
 func (ChannelEventUpdate_UpdateType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{64, 0}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{87, 0}
+}
+
+type RemoteCommitmentUpdate_CommitmentType int32
+
+const (
+	// / The latest commitment of the remote party.
+	RemoteCommitmentUpdate_LATEST RemoteCommitmentUpdate_CommitmentType = 0
+	// / The pending commitment of the remote party, which wasn't revoked yet.
+	RemoteCommitmentUpdate_PENDING RemoteCommitmentUpdate_CommitmentType = 1
+	// / A commitment the remote party already revoked, signaling a breach.
+	RemoteCommitmentUpdate_REVOKED RemoteCommitmentUpdate_CommitmentType = 2
+	// / A commitment beyond any known state, or of a restored channel.
+	RemoteCommitmentUpdate_UNKNOWN RemoteCommitmentUpdate_CommitmentType = 3
+)
+
+var RemoteCommitmentUpdate_CommitmentType_name = map[int32]string{
+	0: "LATEST",
+	1: "PENDING",
+	2: "REVOKED",
+	3: "UNKNOWN",
+}
+var RemoteCommitmentUpdate_CommitmentType_value = map[string]int32{
+	"LATEST":  0,
+	"PENDING": 1,
+	"REVOKED": 2,
+	"UNKNOWN": 3,
+}
+
+func (x RemoteCommitmentUpdate_CommitmentType) String() string {
+	return proto.EnumName(RemoteCommitmentUpdate_CommitmentType_name, int32(x))
+}
+func (RemoteCommitmentUpdate_CommitmentType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{88, 0}
 }
 
 type Invoice_InvoiceState int32
@@ -211,7 +670,7 @@ func (x Invoice_InvoiceState) String() string {
 	return proto.EnumName(Invoice_InvoiceState_name, int32(x))
 }
 func (Invoice_InvoiceState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{95, 0}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{119, 0}
 }
 
 type Payment_PaymentStatus int32
@@ -240,7 +699,109 @@ func (x Payment_PaymentStatus) String() string {
 	return proto.EnumName(Payment_PaymentStatus_name, int32(x))
 }
 func (Payment_PaymentStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{102, 0}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{127, 0}
+}
+
+type LimboOutput_LimboClass int32
+
+const (
+	// *
+	// A commitment output, locked for a relative delay after the commitment
+	// transaction confirms.
+	LimboOutput_CSV LimboOutput_LimboClass = 0
+	// / An htlc output, locked until the absolute expiry of the htlc.
+	LimboOutput_CLTV LimboOutput_LimboClass = 1
+	// *
+	// The output of a second-level htlc transaction, locked for a relative
+	// delay after the second-level transaction confirms.
+	LimboOutput_SECOND_LEVEL LimboOutput_LimboClass = 2
+)
+
+var LimboOutput_LimboClass_name = map[int32]string{
+	0: "CSV",
+	1: "CLTV",
+	2: "SECOND_LEVEL",
+}
+var LimboOutput_LimboClass_value = map[string]int32{
+	"CSV":          0,
+	"CLTV":         1,
+	"SECOND_LEVEL": 2,
+}
+
+func (x LimboOutput_LimboClass) String() string {
+	return proto.EnumName(LimboOutput_LimboClass_name, int32(x))
+}
+func (LimboOutput_LimboClass) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{170, 0}
+}
+
+type LimboOutput_ResolverStage int32
+
+const (
+	// / The transaction that creates the output has yet to confirm.
+	LimboOutput_AWAITING_CONFIRMATION LimboOutput_ResolverStage = 0
+	// *
+	// The expiry of the htlc must be reached before it can be timed out.
+	LimboOutput_AWAITING_HTLC_EXPIRY LimboOutput_ResolverStage = 1
+	// *
+	// The preimage of the incoming htlc is required to claim it before it
+	// expires.
+	LimboOutput_AWAITING_PREIMAGE LimboOutput_ResolverStage = 2
+	// / The output is confirmed and waiting for its time lock to expire.
+	LimboOutput_AWAITING_MATURITY LimboOutput_ResolverStage = 3
+	// / The time lock of the output expired, and it's being swept.
+	LimboOutput_AWAITING_SWEEP LimboOutput_ResolverStage = 4
+)
+
+var LimboOutput_ResolverStage_name = map[int32]string{
+	0: "AWAITING_CONFIRMATION",
+	1: "AWAITING_HTLC_EXPIRY",
+	2: "AWAITING_PREIMAGE",
+	3: "AWAITING_MATURITY",
+	4: "AWAITING_SWEEP",
+}
+var LimboOutput_ResolverStage_value = map[string]int32{
+	"AWAITING_CONFIRMATION": 0,
+	"AWAITING_HTLC_EXPIRY":  1,
+	"AWAITING_PREIMAGE":     2,
+	"AWAITING_MATURITY":     3,
+	"AWAITING_SWEEP":        4,
+}
+
+func (x LimboOutput_ResolverStage) String() string {
+	return proto.EnumName(LimboOutput_ResolverStage_name, int32(x))
+}
+func (LimboOutput_ResolverStage) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{170, 1}
+}
+
+type ColdSweepEvent_EventType int32
+
+const (
+	ColdSweepEvent_SWEEP_PUBLISHED ColdSweepEvent_EventType = 0
+	ColdSweepEvent_SWEEP_FAILED    ColdSweepEvent_EventType = 1
+	ColdSweepEvent_SWEEPS_ENABLED  ColdSweepEvent_EventType = 2
+	ColdSweepEvent_SWEEPS_DISABLED ColdSweepEvent_EventType = 3
+)
+
+var ColdSweepEvent_EventType_name = map[int32]string{
+	0: "SWEEP_PUBLISHED",
+	1: "SWEEP_FAILED",
+	2: "SWEEPS_ENABLED",
+	3: "SWEEPS_DISABLED",
+}
+var ColdSweepEvent_EventType_value = map[string]int32{
+	"SWEEP_PUBLISHED": 0,
+	"SWEEP_FAILED":    1,
+	"SWEEPS_ENABLED":  2,
+	"SWEEPS_DISABLED": 3,
+}
+
+func (x ColdSweepEvent_EventType) String() string {
+	return proto.EnumName(ColdSweepEvent_EventType_name, int32(x))
+}
+func (ColdSweepEvent_EventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{193, 0}
 }
 
 type GenSeedRequest struct {
@@ -261,7 +822,7 @@ func (m *GenSeedRequest) Reset()         { *m = GenSeedRequest{} }
 func (m *GenSeedRequest) String() string { return proto.CompactTextString(m) }
 func (*GenSeedRequest) ProtoMessage()    {}
 func (*GenSeedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{0}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{0}
 }
 func (m *GenSeedRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GenSeedRequest.Unmarshal(m, b)
@@ -316,7 +877,7 @@ func (m *GenSeedResponse) Reset()         { *m = GenSeedResponse{} }
 func (m *GenSeedResponse) String() string { return proto.CompactTextString(m) }
 func (*GenSeedResponse) ProtoMessage()    {}
 func (*GenSeedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{1}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{1}
 }
 func (m *GenSeedResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GenSeedResponse.Unmarshal(m, b)
@@ -379,17 +940,23 @@ type InitWalletRequest struct {
 	// total data loss occurred. If specified, then after on-chain recovery of
 	// funds, lnd begin to carry out the data loss recovery protocol in order to
 	// recover the funds in each channel from a remote force closed transaction.
-	ChannelBackups       *ChanBackupSnapshot `protobuf:"bytes,5,opt,name=channel_backups,json=channelBackups,proto3" json:"channel_backups,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	ChannelBackups *ChanBackupSnapshot `protobuf:"bytes,5,opt,name=channel_backups,json=channelBackups,proto3" json:"channel_backups,omitempty"`
+	// *
+	// stateless_init is an optional argument instructing the daemon NOT to create
+	// any *.macaroon files in its filesystem. If this parameter is set, then the
+	// admin macaroon returned in the response MUST be stored by the caller of the
+	// RPC as otherwise all access to the daemon will be lost!
+	StatelessInit        bool     `protobuf:"varint,6,opt,name=stateless_init,json=statelessInit,proto3" json:"stateless_init,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *InitWalletRequest) Reset()         { *m = InitWalletRequest{} }
 func (m *InitWalletRequest) String() string { return proto.CompactTextString(m) }
 func (*InitWalletRequest) ProtoMessage()    {}
 func (*InitWalletRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{2}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{2}
 }
 func (m *InitWalletRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InitWalletRequest.Unmarshal(m, b)
@@ -444,7 +1011,21 @@ func (m *InitWalletRequest) GetChannelBackups() *ChanBackupSnapshot {
 	return nil
 }
 
+func (m *InitWalletRequest) GetStatelessInit() bool {
+	if m != nil {
+		return m.StatelessInit
+	}
+	return false
+}
+
 type InitWalletResponse struct {
+	// *
+	// The binary serialized admin macaroon that can be used to access the daemon
+	// after creating the wallet. If the stateless_init parameter was set to true,
+	// this is the ONLY copy of the macaroon and MUST be stored safely by the
+	// caller. Otherwise a copy of this macaroon is also persisted on disk by the
+	// daemon, together with other macaroon files.
+	AdminMacaroon        []byte   `protobuf:"bytes,1,opt,name=admin_macaroon,json=adminMacaroon,proto3" json:"admin_macaroon,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -454,7 +1035,7 @@ func (m *InitWalletResponse) Reset()         { *m = InitWalletResponse{} }
 func (m *InitWalletResponse) String() string { return proto.CompactTextString(m) }
 func (*InitWalletResponse) ProtoMessage()    {}
 func (*InitWalletResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{3}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{3}
 }
 func (m *InitWalletResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InitWalletResponse.Unmarshal(m, b)
@@ -474,6 +1055,13 @@ func (m *InitWalletResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_InitWalletResponse proto.InternalMessageInfo
 
+func (m *InitWalletResponse) GetAdminMacaroon() []byte {
+	if m != nil {
+		return m.AdminMacaroon
+	}
+	return nil
+}
+
 type UnlockWalletRequest struct {
 	// *
 	// wallet_password should be the current valid passphrase for the daemon. This
@@ -494,17 +1082,21 @@ type UnlockWalletRequest struct {
 	// total data loss occurred. If specified, then after on-chain recovery of
 	// funds, lnd begin to carry out the data loss recovery protocol in order to
 	// recover the funds in each channel from a remote force closed transaction.
-	ChannelBackups       *ChanBackupSnapshot `protobuf:"bytes,3,opt,name=channel_backups,json=channelBackups,proto3" json:"channel_backups,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	ChannelBackups *ChanBackupSnapshot `protobuf:"bytes,3,opt,name=channel_backups,json=channelBackups,proto3" json:"channel_backups,omitempty"`
+	// *
+	// stateless_init is an optional argument instructing the daemon NOT to create
+	// any *.macaroon files in its file system.
+	StatelessInit        bool     `protobuf:"varint,4,opt,name=stateless_init,json=statelessInit,proto3" json:"stateless_init,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *UnlockWalletRequest) Reset()         { *m = UnlockWalletRequest{} }
 func (m *UnlockWalletRequest) String() string { return proto.CompactTextString(m) }
 func (*UnlockWalletRequest) ProtoMessage()    {}
 func (*UnlockWalletRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{4}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{4}
 }
 func (m *UnlockWalletRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UnlockWalletRequest.Unmarshal(m, b)
@@ -545,7 +1137,19 @@ func (m *UnlockWalletRequest) GetChannelBackups() *ChanBackupSnapshot {
 	return nil
 }
 
+func (m *UnlockWalletRequest) GetStatelessInit() bool {
+	if m != nil {
+		return m.StatelessInit
+	}
+	return false
+}
+
 type UnlockWalletResponse struct {
+	// *
+	// The binary serialized admin macaroon that can be used to access the daemon
+	// after unlocking the wallet. If the stateless_init parameter was set to
+	// true, no copy of this macaroon is written to disk by the daemon.
+	AdminMacaroon        []byte   `protobuf:"bytes,1,opt,name=admin_macaroon,json=adminMacaroon,proto3" json:"admin_macaroon,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -555,7 +1159,7 @@ func (m *UnlockWalletResponse) Reset()         { *m = UnlockWalletResponse{} }
 func (m *UnlockWalletResponse) String() string { return proto.CompactTextString(m) }
 func (*UnlockWalletResponse) ProtoMessage()    {}
 func (*UnlockWalletResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{5}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{5}
 }
 func (m *UnlockWalletResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UnlockWalletResponse.Unmarshal(m, b)
@@ -575,6 +1179,13 @@ func (m *UnlockWalletResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UnlockWalletResponse proto.InternalMessageInfo
 
+func (m *UnlockWalletResponse) GetAdminMacaroon() []byte {
+	if m != nil {
+		return m.AdminMacaroon
+	}
+	return nil
+}
+
 type ChangePasswordRequest struct {
 	// *
 	// current_password should be the current valid passphrase used to unlock the
@@ -583,7 +1194,18 @@ type ChangePasswordRequest struct {
 	// *
 	// new_password should be the new passphrase that will be needed to unlock the
 	// daemon.
-	NewPassword          []byte   `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	NewPassword []byte `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	// *
+	// stateless_init is an optional argument instructing the daemon NOT to create
+	// any *.macaroon files in its filesystem. If this parameter is set, then the
+	// admin macaroon returned in the response MUST be stored by the caller of the
+	// RPC as otherwise all access to the daemon will be lost!
+	StatelessInit bool `protobuf:"varint,3,opt,name=stateless_init,json=statelessInit,proto3" json:"stateless_init,omitempty"`
+	// *
+	// new_macaroon_root_key is an optional argument instructing the daemon to
+	// rotate the macaroon root key when set to true. This will invalidate all
+	// previously generated macaroons.
+	NewMacaroonRootKey   bool     `protobuf:"varint,4,opt,name=new_macaroon_root_key,json=newMacaroonRootKey,proto3" json:"new_macaroon_root_key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -593,7 +1215,7 @@ func (m *ChangePasswordRequest) Reset()         { *m = ChangePasswordRequest{} }
 func (m *ChangePasswordRequest) String() string { return proto.CompactTextString(m) }
 func (*ChangePasswordRequest) ProtoMessage()    {}
 func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{6}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{6}
 }
 func (m *ChangePasswordRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChangePasswordRequest.Unmarshal(m, b)
@@ -627,7 +1249,29 @@ func (m *ChangePasswordRequest) GetNewPassword() []byte {
 	return nil
 }
 
+func (m *ChangePasswordRequest) GetStatelessInit() bool {
+	if m != nil {
+		return m.StatelessInit
+	}
+	return false
+}
+
+func (m *ChangePasswordRequest) GetNewMacaroonRootKey() bool {
+	if m != nil {
+		return m.NewMacaroonRootKey
+	}
+	return false
+}
+
 type ChangePasswordResponse struct {
+	// *
+	// The binary serialized admin macaroon that can be used to access the daemon
+	// after rotating the macaroon root key. If both the stateless_init and
+	// new_macaroon_root_key parameter were set to true, this is the ONLY copy of
+	// the macaroon that was created from the new root key and MUST be stored
+	// safely by the caller. Otherwise a copy of this macaroon is also persisted on
+	// disk by the daemon, together with other macaroon files.
+	AdminMacaroon        []byte   `protobuf:"bytes,1,opt,name=admin_macaroon,json=adminMacaroon,proto3" json:"admin_macaroon,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -637,7 +1281,7 @@ func (m *ChangePasswordResponse) Reset()         { *m = ChangePasswordResponse{}
 func (m *ChangePasswordResponse) String() string { return proto.CompactTextString(m) }
 func (*ChangePasswordResponse) ProtoMessage()    {}
 func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{7}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{7}
 }
 func (m *ChangePasswordResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChangePasswordResponse.Unmarshal(m, b)
@@ -657,47 +1301,192 @@ func (m *ChangePasswordResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ChangePasswordResponse proto.InternalMessageInfo
 
-type Utxo struct {
-	// / The type of address
-	Type AddressType `protobuf:"varint,1,opt,name=type,json=address_type,proto3,enum=lnrpc.AddressType" json:"type,omitempty"`
-	// / The address
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	// / The value of the unspent coin in atoms
-	AmountAtoms int64 `protobuf:"varint,3,opt,name=amount_atoms,proto3" json:"amount_atoms,omitempty"`
-	// / The pkscript in hex
-	PkScript string `protobuf:"bytes,4,opt,name=pk_script,proto3" json:"pk_script,omitempty"`
-	// / The outpoint in format txid:n
-	Outpoint *OutPoint `protobuf:"bytes,5,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
-	// / The number of confirmations for the Utxo
-	Confirmations        int64    `protobuf:"varint,6,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+func (m *ChangePasswordResponse) GetAdminMacaroon() []byte {
+	if m != nil {
+		return m.AdminMacaroon
+	}
+	return nil
+}
+
+type SubscribeStateRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Utxo) Reset()         { *m = Utxo{} }
-func (m *Utxo) String() string { return proto.CompactTextString(m) }
-func (*Utxo) ProtoMessage()    {}
-func (*Utxo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{8}
+func (m *SubscribeStateRequest) Reset()         { *m = SubscribeStateRequest{} }
+func (m *SubscribeStateRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeStateRequest) ProtoMessage()    {}
+func (*SubscribeStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{8}
 }
-func (m *Utxo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Utxo.Unmarshal(m, b)
+func (m *SubscribeStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeStateRequest.Unmarshal(m, b)
 }
-func (m *Utxo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Utxo.Marshal(b, m, deterministic)
+func (m *SubscribeStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeStateRequest.Marshal(b, m, deterministic)
 }
-func (dst *Utxo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Utxo.Merge(dst, src)
+func (dst *SubscribeStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeStateRequest.Merge(dst, src)
 }
-func (m *Utxo) XXX_Size() int {
-	return xxx_messageInfo_Utxo.Size(m)
+func (m *SubscribeStateRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeStateRequest.Size(m)
 }
-func (m *Utxo) XXX_DiscardUnknown() {
-	xxx_messageInfo_Utxo.DiscardUnknown(m)
+func (m *SubscribeStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeStateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Utxo proto.InternalMessageInfo
+var xxx_messageInfo_SubscribeStateRequest proto.InternalMessageInfo
+
+type SubscribeStateResponse struct {
+	// / The lifecycle state of the daemon.
+	State                DaemonState `protobuf:"varint,1,opt,name=state,proto3,enum=lnrpc.DaemonState" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *SubscribeStateResponse) Reset()         { *m = SubscribeStateResponse{} }
+func (m *SubscribeStateResponse) String() string { return proto.CompactTextString(m) }
+func (*SubscribeStateResponse) ProtoMessage()    {}
+func (*SubscribeStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{9}
+}
+func (m *SubscribeStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeStateResponse.Unmarshal(m, b)
+}
+func (m *SubscribeStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeStateResponse.Marshal(b, m, deterministic)
+}
+func (dst *SubscribeStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeStateResponse.Merge(dst, src)
+}
+func (m *SubscribeStateResponse) XXX_Size() int {
+	return xxx_messageInfo_SubscribeStateResponse.Size(m)
+}
+func (m *SubscribeStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeStateResponse proto.InternalMessageInfo
+
+func (m *SubscribeStateResponse) GetState() DaemonState {
+	if m != nil {
+		return m.State
+	}
+	return DaemonState_WAITING_TO_START
+}
+
+type GetStateRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetStateRequest) Reset()         { *m = GetStateRequest{} }
+func (m *GetStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetStateRequest) ProtoMessage()    {}
+func (*GetStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{10}
+}
+func (m *GetStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStateRequest.Unmarshal(m, b)
+}
+func (m *GetStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStateRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStateRequest.Merge(dst, src)
+}
+func (m *GetStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetStateRequest.Size(m)
+}
+func (m *GetStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStateRequest proto.InternalMessageInfo
+
+type GetStateResponse struct {
+	// / The lifecycle state of the daemon.
+	State                DaemonState `protobuf:"varint,1,opt,name=state,proto3,enum=lnrpc.DaemonState" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *GetStateResponse) Reset()         { *m = GetStateResponse{} }
+func (m *GetStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetStateResponse) ProtoMessage()    {}
+func (*GetStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{11}
+}
+func (m *GetStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStateResponse.Unmarshal(m, b)
+}
+func (m *GetStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStateResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStateResponse.Merge(dst, src)
+}
+func (m *GetStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetStateResponse.Size(m)
+}
+func (m *GetStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStateResponse proto.InternalMessageInfo
+
+func (m *GetStateResponse) GetState() DaemonState {
+	if m != nil {
+		return m.State
+	}
+	return DaemonState_WAITING_TO_START
+}
+
+type Utxo struct {
+	// / The type of address
+	Type AddressType `protobuf:"varint,1,opt,name=type,json=address_type,proto3,enum=lnrpc.AddressType" json:"type,omitempty"`
+	// / The address
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// / The value of the unspent coin in atoms
+	AmountAtoms int64 `protobuf:"varint,3,opt,name=amount_atoms,proto3" json:"amount_atoms,omitempty"`
+	// / The pkscript in hex
+	PkScript string `protobuf:"bytes,4,opt,name=pk_script,proto3" json:"pk_script,omitempty"`
+	// / The outpoint in format txid:n
+	Outpoint *OutPoint `protobuf:"bytes,5,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	// / The number of confirmations for the Utxo
+	Confirmations        int64    `protobuf:"varint,6,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Utxo) Reset()         { *m = Utxo{} }
+func (m *Utxo) String() string { return proto.CompactTextString(m) }
+func (*Utxo) ProtoMessage()    {}
+func (*Utxo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{12}
+}
+func (m *Utxo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Utxo.Unmarshal(m, b)
+}
+func (m *Utxo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Utxo.Marshal(b, m, deterministic)
+}
+func (dst *Utxo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Utxo.Merge(dst, src)
+}
+func (m *Utxo) XXX_Size() int {
+	return xxx_messageInfo_Utxo.Size(m)
+}
+func (m *Utxo) XXX_DiscardUnknown() {
+	xxx_messageInfo_Utxo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Utxo proto.InternalMessageInfo
 
 func (m *Utxo) GetType() AddressType {
 	if m != nil {
@@ -759,7 +1548,11 @@ type Transaction struct {
 	// / Addresses that received funds for this transaction
 	DestAddresses []string `protobuf:"bytes,8,rep,name=dest_addresses,proto3" json:"dest_addresses,omitempty"`
 	// / The raw transaction hex.
-	RawTxHex             string   `protobuf:"bytes,9,opt,name=raw_tx_hex,proto3" json:"raw_tx_hex,omitempty"`
+	RawTxHex string `protobuf:"bytes,9,opt,name=raw_tx_hex,proto3" json:"raw_tx_hex,omitempty"`
+	// / The details of each of the outputs of the transaction.
+	OutputDetails []*OutputDetail `protobuf:"bytes,10,rep,name=output_details,proto3" json:"output_details,omitempty"`
+	// / The label given to the transaction when it was sent, if any.
+	Label                string   `protobuf:"bytes,11,opt,name=label,proto3" json:"label,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -769,7 +1562,7 @@ func (m *Transaction) Reset()         { *m = Transaction{} }
 func (m *Transaction) String() string { return proto.CompactTextString(m) }
 func (*Transaction) ProtoMessage()    {}
 func (*Transaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{9}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{13}
 }
 func (m *Transaction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Transaction.Unmarshal(m, b)
@@ -852,7 +1645,33 @@ func (m *Transaction) GetRawTxHex() string {
 	return ""
 }
 
+func (m *Transaction) GetOutputDetails() []*OutputDetail {
+	if m != nil {
+		return m.OutputDetails
+	}
+	return nil
+}
+
+func (m *Transaction) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
 type GetTransactionsRequest struct {
+	// *
+	// If set, only the transactions with at least one output paying to one of
+	// these accounts of the wallet are returned.
+	Accounts []uint32 `protobuf:"varint,1,rep,packed,name=accounts,proto3" json:"accounts,omitempty"`
+	// *
+	// If set, only the transactions whose absolute net amount, from the point of
+	// view of the wallet, is at least this many atoms are returned.
+	MinAmount int64 `protobuf:"varint,2,opt,name=min_amount,proto3" json:"min_amount,omitempty"`
+	// *
+	// If set, only the transactions whose label contains this string are
+	// returned.
+	LabelFilter          string   `protobuf:"bytes,3,opt,name=label_filter,proto3" json:"label_filter,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -862,7 +1681,7 @@ func (m *GetTransactionsRequest) Reset()         { *m = GetTransactionsRequest{}
 func (m *GetTransactionsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTransactionsRequest) ProtoMessage()    {}
 func (*GetTransactionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{10}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{14}
 }
 func (m *GetTransactionsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTransactionsRequest.Unmarshal(m, b)
@@ -882,6 +1701,27 @@ func (m *GetTransactionsRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetTransactionsRequest proto.InternalMessageInfo
 
+func (m *GetTransactionsRequest) GetAccounts() []uint32 {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *GetTransactionsRequest) GetMinAmount() int64 {
+	if m != nil {
+		return m.MinAmount
+	}
+	return 0
+}
+
+func (m *GetTransactionsRequest) GetLabelFilter() string {
+	if m != nil {
+		return m.LabelFilter
+	}
+	return ""
+}
+
 type TransactionDetails struct {
 	// / The list of transactions relevant to the wallet.
 	Transactions         []*Transaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
@@ -894,7 +1734,7 @@ func (m *TransactionDetails) Reset()         { *m = TransactionDetails{} }
 func (m *TransactionDetails) String() string { return proto.CompactTextString(m) }
 func (*TransactionDetails) ProtoMessage()    {}
 func (*TransactionDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{11}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{15}
 }
 func (m *TransactionDetails) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TransactionDetails.Unmarshal(m, b)
@@ -935,7 +1775,7 @@ func (m *FeeLimit) Reset()         { *m = FeeLimit{} }
 func (m *FeeLimit) String() string { return proto.CompactTextString(m) }
 func (*FeeLimit) ProtoMessage()    {}
 func (*FeeLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{12}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{16}
 }
 func (m *FeeLimit) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FeeLimit.Unmarshal(m, b)
@@ -1106,17 +1946,33 @@ type SendRequest struct {
 	// An optional field that can be used to pass an arbitrary set of TLV records
 	// to a peer which understands the new records. This can be used to pass
 	// application specific data during the payment attempt.
-	DestTlv              map[uint64][]byte `protobuf:"bytes,12,rep,name=dest_tlv,json=destTlv,proto3" json:"dest_tlv,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	DestTlv map[uint64][]byte `protobuf:"bytes,12,rep,name=dest_tlv,json=destTlv,proto3" json:"dest_tlv,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// *
+	// If set, the payment request is paid as an AMP payment to a reusable
+	// invoice. A random root share and set id are generated, and the payment is
+	// made to the hash of the preimage derived from them instead of the payment
+	// hash of the invoice.
+	Amp bool `protobuf:"varint,13,opt,name=amp,proto3" json:"amp,omitempty"`
+	// / An optional note describing the payment, stored along with it.
+	Label string `protobuf:"bytes,14,opt,name=label,proto3" json:"label,omitempty"`
+	// *
+	// An optional reference linking the payment to its purpose, such as an order
+	// or bill id of a bookkeeping tool. Payments can be listed by reference.
+	Reference string `protobuf:"bytes,15,opt,name=reference,proto3" json:"reference,omitempty"`
+	// *
+	// What happens to the payment if it's sent before the initial sync of the
+	// graph completes, as reported by synced_to_graph.
+	UnsyncedGraphAction  UnsyncedGraphAction `protobuf:"varint,16,opt,name=unsynced_graph_action,json=unsyncedGraphAction,proto3,enum=lnrpc.UnsyncedGraphAction" json:"unsynced_graph_action,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *SendRequest) Reset()         { *m = SendRequest{} }
 func (m *SendRequest) String() string { return proto.CompactTextString(m) }
 func (*SendRequest) ProtoMessage()    {}
 func (*SendRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{13}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{17}
 }
 func (m *SendRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendRequest.Unmarshal(m, b)
@@ -1220,6 +2076,34 @@ func (m *SendRequest) GetDestTlv() map[uint64][]byte {
 	return nil
 }
 
+func (m *SendRequest) GetAmp() bool {
+	if m != nil {
+		return m.Amp
+	}
+	return false
+}
+
+func (m *SendRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *SendRequest) GetReference() string {
+	if m != nil {
+		return m.Reference
+	}
+	return ""
+}
+
+func (m *SendRequest) GetUnsyncedGraphAction() UnsyncedGraphAction {
+	if m != nil {
+		return m.UnsyncedGraphAction
+	}
+	return UnsyncedGraphAction_UNSYNCED_GRAPH_DEFAULT
+}
+
 type SendResponse struct {
 	PaymentError         string   `protobuf:"bytes,1,opt,name=payment_error,proto3" json:"payment_error,omitempty"`
 	PaymentPreimage      []byte   `protobuf:"bytes,2,opt,name=payment_preimage,proto3" json:"payment_preimage,omitempty"`
@@ -1234,7 +2118,7 @@ func (m *SendResponse) Reset()         { *m = SendResponse{} }
 func (m *SendResponse) String() string { return proto.CompactTextString(m) }
 func (*SendResponse) ProtoMessage()    {}
 func (*SendResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{14}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{18}
 }
 func (m *SendResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendResponse.Unmarshal(m, b)
@@ -1298,7 +2182,7 @@ func (m *SendToRouteRequest) Reset()         { *m = SendToRouteRequest{} }
 func (m *SendToRouteRequest) String() string { return proto.CompactTextString(m) }
 func (*SendToRouteRequest) ProtoMessage()    {}
 func (*SendToRouteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{15}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{19}
 }
 func (m *SendToRouteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendToRouteRequest.Unmarshal(m, b)
@@ -1367,7 +2251,16 @@ type ChannelAcceptRequest struct {
 	// / The total number of incoming HTLC's that the initiator will accept.
 	MaxAcceptedHtlcs uint32 `protobuf:"varint,12,opt,name=max_accepted_htlcs,json=maxAcceptedHtlcs,proto3" json:"max_accepted_htlcs,omitempty"`
 	// / A bit-field which the initiator uses to specify proposed channel behavior.
-	ChannelFlags         uint32   `protobuf:"varint,13,opt,name=channel_flags,json=channelFlags,proto3" json:"channel_flags,omitempty"`
+	ChannelFlags uint32 `protobuf:"varint,13,opt,name=channel_flags,json=channelFlags,proto3" json:"channel_flags,omitempty"`
+	// *
+	// Whether the channel would use the tweakless commitment format, in which the
+	// output paying to the non-broadcasting party is a static key.
+	StaticRemoteKey bool `protobuf:"varint,14,opt,name=static_remote_key,json=staticRemoteKey,proto3" json:"static_remote_key,omitempty"`
+	// *
+	// Whether the channel would use the anchor commitment format, in which both
+	// commitment outputs are paired with an anchor output used to bump the fee
+	// of the commitment through CPFP.
+	Anchors              bool     `protobuf:"varint,15,opt,name=anchors,proto3" json:"anchors,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1377,7 +2270,7 @@ func (m *ChannelAcceptRequest) Reset()         { *m = ChannelAcceptRequest{} }
 func (m *ChannelAcceptRequest) String() string { return proto.CompactTextString(m) }
 func (*ChannelAcceptRequest) ProtoMessage()    {}
 func (*ChannelAcceptRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{16}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{20}
 }
 func (m *ChannelAcceptRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelAcceptRequest.Unmarshal(m, b)
@@ -1488,6 +2381,20 @@ func (m *ChannelAcceptRequest) GetChannelFlags() uint32 {
 	return 0
 }
 
+func (m *ChannelAcceptRequest) GetStaticRemoteKey() bool {
+	if m != nil {
+		return m.StaticRemoteKey
+	}
+	return false
+}
+
+func (m *ChannelAcceptRequest) GetAnchors() bool {
+	if m != nil {
+		return m.Anchors
+	}
+	return false
+}
+
 type ChannelAcceptResponse struct {
 	// / Whether or not the client accepts the channel.
 	Accept bool `protobuf:"varint,1,opt,name=accept,proto3" json:"accept,omitempty"`
@@ -1502,7 +2409,7 @@ func (m *ChannelAcceptResponse) Reset()         { *m = ChannelAcceptResponse{} }
 func (m *ChannelAcceptResponse) String() string { return proto.CompactTextString(m) }
 func (*ChannelAcceptResponse) ProtoMessage()    {}
 func (*ChannelAcceptResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{17}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{21}
 }
 func (m *ChannelAcceptResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelAcceptResponse.Unmarshal(m, b)
@@ -1552,7 +2459,7 @@ func (m *ChannelPoint) Reset()         { *m = ChannelPoint{} }
 func (m *ChannelPoint) String() string { return proto.CompactTextString(m) }
 func (*ChannelPoint) ProtoMessage()    {}
 func (*ChannelPoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{18}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{22}
 }
 func (m *ChannelPoint) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelPoint.Unmarshal(m, b)
@@ -1698,7 +2605,7 @@ func (m *OutPoint) Reset()         { *m = OutPoint{} }
 func (m *OutPoint) String() string { return proto.CompactTextString(m) }
 func (*OutPoint) ProtoMessage()    {}
 func (*OutPoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{19}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{23}
 }
 func (m *OutPoint) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OutPoint.Unmarshal(m, b)
@@ -1753,7 +2660,7 @@ func (m *LightningAddress) Reset()         { *m = LightningAddress{} }
 func (m *LightningAddress) String() string { return proto.CompactTextString(m) }
 func (*LightningAddress) ProtoMessage()    {}
 func (*LightningAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{20}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{24}
 }
 func (m *LightningAddress) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LightningAddress.Unmarshal(m, b)
@@ -1801,7 +2708,7 @@ func (m *EstimateFeeRequest) Reset()         { *m = EstimateFeeRequest{} }
 func (m *EstimateFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimateFeeRequest) ProtoMessage()    {}
 func (*EstimateFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{21}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{25}
 }
 func (m *EstimateFeeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EstimateFeeRequest.Unmarshal(m, b)
@@ -1849,7 +2756,7 @@ func (m *EstimateFeeResponse) Reset()         { *m = EstimateFeeResponse{} }
 func (m *EstimateFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateFeeResponse) ProtoMessage()    {}
 func (*EstimateFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{22}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{26}
 }
 func (m *EstimateFeeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EstimateFeeResponse.Unmarshal(m, b)
@@ -1889,7 +2796,21 @@ type SendManyRequest struct {
 	// / The target number of blocks that this transaction should be confirmed by.
 	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,json=targetConf,proto3" json:"target_conf,omitempty"`
 	// / A manual fee rate set in atom/byte that should be used when crafting the transaction.
-	AtomsPerByte         int64    `protobuf:"varint,5,opt,name=atoms_per_byte,json=atomsPerByte,proto3" json:"atoms_per_byte,omitempty"`
+	AtomsPerByte int64 `protobuf:"varint,5,opt,name=atoms_per_byte,json=atomsPerByte,proto3" json:"atoms_per_byte,omitempty"`
+	// *
+	// The minimum number of confirmations of the coins that can be spent. If
+	// unset, coins with at least one confirmation are spent.
+	MinConfs int32 `protobuf:"varint,6,opt,name=min_confs,json=minConfs,proto3" json:"min_confs,omitempty"`
+	// / Whether unconfirmed coins can be spent. It overrides min_confs.
+	SpendUnconfirmed bool `protobuf:"varint,7,opt,name=spend_unconfirmed,json=spendUnconfirmed,proto3" json:"spend_unconfirmed,omitempty"`
+	// *
+	// If set, only these outpoints of the wallet are spent, and all of them
+	// are, the excess being returned as change.
+	Outpoints []*OutPoint `protobuf:"bytes,8,rep,name=outpoints,proto3" json:"outpoints,omitempty"`
+	// *
+	// A label for the transaction, of at most 500 characters, returned along
+	// with it by GetTransactions.
+	Label                string   `protobuf:"bytes,9,opt,name=label,proto3" json:"label,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1899,7 +2820,7 @@ func (m *SendManyRequest) Reset()         { *m = SendManyRequest{} }
 func (m *SendManyRequest) String() string { return proto.CompactTextString(m) }
 func (*SendManyRequest) ProtoMessage()    {}
 func (*SendManyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{23}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{27}
 }
 func (m *SendManyRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendManyRequest.Unmarshal(m, b)
@@ -1940,6 +2861,34 @@ func (m *SendManyRequest) GetAtomsPerByte() int64 {
 	return 0
 }
 
+func (m *SendManyRequest) GetMinConfs() int32 {
+	if m != nil {
+		return m.MinConfs
+	}
+	return 0
+}
+
+func (m *SendManyRequest) GetSpendUnconfirmed() bool {
+	if m != nil {
+		return m.SpendUnconfirmed
+	}
+	return false
+}
+
+func (m *SendManyRequest) GetOutpoints() []*OutPoint {
+	if m != nil {
+		return m.Outpoints
+	}
+	return nil
+}
+
+func (m *SendManyRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
 type SendManyResponse struct {
 	// / The id of the transaction
 	Txid                 string   `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
@@ -1952,7 +2901,7 @@ func (m *SendManyResponse) Reset()         { *m = SendManyResponse{} }
 func (m *SendManyResponse) String() string { return proto.CompactTextString(m) }
 func (*SendManyResponse) ProtoMessage()    {}
 func (*SendManyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{24}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{28}
 }
 func (m *SendManyResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendManyResponse.Unmarshal(m, b)
@@ -1992,7 +2941,22 @@ type SendCoinsRequest struct {
 	// If set, then the amount field will be ignored, and lnd will attempt to
 	// send all the coins under control of the internal wallet to the specified
 	// address.
-	SendAll              bool     `protobuf:"varint,6,opt,name=send_all,json=sendAll,proto3" json:"send_all,omitempty"`
+	SendAll bool `protobuf:"varint,6,opt,name=send_all,json=sendAll,proto3" json:"send_all,omitempty"`
+	// *
+	// The minimum number of confirmations of the coins that can be spent. If
+	// unset, coins with at least one confirmation are spent.
+	MinConfs int32 `protobuf:"varint,7,opt,name=min_confs,json=minConfs,proto3" json:"min_confs,omitempty"`
+	// / Whether unconfirmed coins can be spent. It overrides min_confs.
+	SpendUnconfirmed bool `protobuf:"varint,8,opt,name=spend_unconfirmed,json=spendUnconfirmed,proto3" json:"spend_unconfirmed,omitempty"`
+	// *
+	// If set, only these outpoints of the wallet are spent, and all of them
+	// are. The excess is returned as change, or sent to the address if send_all
+	// is set.
+	Outpoints []*OutPoint `protobuf:"bytes,9,rep,name=outpoints,proto3" json:"outpoints,omitempty"`
+	// *
+	// A label for the transaction, of at most 500 characters, returned along
+	// with it by GetTransactions.
+	Label                string   `protobuf:"bytes,10,opt,name=label,proto3" json:"label,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2002,7 +2966,7 @@ func (m *SendCoinsRequest) Reset()         { *m = SendCoinsRequest{} }
 func (m *SendCoinsRequest) String() string { return proto.CompactTextString(m) }
 func (*SendCoinsRequest) ProtoMessage()    {}
 func (*SendCoinsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{25}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{29}
 }
 func (m *SendCoinsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendCoinsRequest.Unmarshal(m, b)
@@ -2057,6 +3021,34 @@ func (m *SendCoinsRequest) GetSendAll() bool {
 	return false
 }
 
+func (m *SendCoinsRequest) GetMinConfs() int32 {
+	if m != nil {
+		return m.MinConfs
+	}
+	return 0
+}
+
+func (m *SendCoinsRequest) GetSpendUnconfirmed() bool {
+	if m != nil {
+		return m.SpendUnconfirmed
+	}
+	return false
+}
+
+func (m *SendCoinsRequest) GetOutpoints() []*OutPoint {
+	if m != nil {
+		return m.Outpoints
+	}
+	return nil
+}
+
+func (m *SendCoinsRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
 type SendCoinsResponse struct {
 	// / The transaction ID of the transaction
 	Txid                 string   `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
@@ -2069,7 +3061,7 @@ func (m *SendCoinsResponse) Reset()         { *m = SendCoinsResponse{} }
 func (m *SendCoinsResponse) String() string { return proto.CompactTextString(m) }
 func (*SendCoinsResponse) ProtoMessage()    {}
 func (*SendCoinsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{26}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{30}
 }
 func (m *SendCoinsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendCoinsResponse.Unmarshal(m, b)
@@ -2110,7 +3102,7 @@ func (m *ListUnspentRequest) Reset()         { *m = ListUnspentRequest{} }
 func (m *ListUnspentRequest) String() string { return proto.CompactTextString(m) }
 func (*ListUnspentRequest) ProtoMessage()    {}
 func (*ListUnspentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{27}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{31}
 }
 func (m *ListUnspentRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListUnspentRequest.Unmarshal(m, b)
@@ -2156,7 +3148,7 @@ func (m *ListUnspentResponse) Reset()         { *m = ListUnspentResponse{} }
 func (m *ListUnspentResponse) String() string { return proto.CompactTextString(m) }
 func (*ListUnspentResponse) ProtoMessage()    {}
 func (*ListUnspentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{28}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{32}
 }
 func (m *ListUnspentResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListUnspentResponse.Unmarshal(m, b)
@@ -2195,7 +3187,7 @@ func (m *NewAddressRequest) Reset()         { *m = NewAddressRequest{} }
 func (m *NewAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*NewAddressRequest) ProtoMessage()    {}
 func (*NewAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{29}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{33}
 }
 func (m *NewAddressRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NewAddressRequest.Unmarshal(m, b)
@@ -2234,7 +3226,7 @@ func (m *NewAddressResponse) Reset()         { *m = NewAddressResponse{} }
 func (m *NewAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*NewAddressResponse) ProtoMessage()    {}
 func (*NewAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{30}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{34}
 }
 func (m *NewAddressResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NewAddressResponse.Unmarshal(m, b)
@@ -2273,7 +3265,7 @@ func (m *SignMessageRequest) Reset()         { *m = SignMessageRequest{} }
 func (m *SignMessageRequest) String() string { return proto.CompactTextString(m) }
 func (*SignMessageRequest) ProtoMessage()    {}
 func (*SignMessageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{31}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{35}
 }
 func (m *SignMessageRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignMessageRequest.Unmarshal(m, b)
@@ -2312,7 +3304,7 @@ func (m *SignMessageResponse) Reset()         { *m = SignMessageResponse{} }
 func (m *SignMessageResponse) String() string { return proto.CompactTextString(m) }
 func (*SignMessageResponse) ProtoMessage()    {}
 func (*SignMessageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{32}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{36}
 }
 func (m *SignMessageResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignMessageResponse.Unmarshal(m, b)
@@ -2353,7 +3345,7 @@ func (m *VerifyMessageRequest) Reset()         { *m = VerifyMessageRequest{} }
 func (m *VerifyMessageRequest) String() string { return proto.CompactTextString(m) }
 func (*VerifyMessageRequest) ProtoMessage()    {}
 func (*VerifyMessageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{33}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{37}
 }
 func (m *VerifyMessageRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VerifyMessageRequest.Unmarshal(m, b)
@@ -2401,7 +3393,7 @@ func (m *VerifyMessageResponse) Reset()         { *m = VerifyMessageResponse{} }
 func (m *VerifyMessageResponse) String() string { return proto.CompactTextString(m) }
 func (*VerifyMessageResponse) ProtoMessage()    {}
 func (*VerifyMessageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{34}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{38}
 }
 func (m *VerifyMessageResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VerifyMessageResponse.Unmarshal(m, b)
@@ -2450,7 +3442,7 @@ func (m *ConnectPeerRequest) Reset()         { *m = ConnectPeerRequest{} }
 func (m *ConnectPeerRequest) String() string { return proto.CompactTextString(m) }
 func (*ConnectPeerRequest) ProtoMessage()    {}
 func (*ConnectPeerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{35}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{39}
 }
 func (m *ConnectPeerRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConnectPeerRequest.Unmarshal(m, b)
@@ -2494,7 +3486,7 @@ func (m *ConnectPeerResponse) Reset()         { *m = ConnectPeerResponse{} }
 func (m *ConnectPeerResponse) String() string { return proto.CompactTextString(m) }
 func (*ConnectPeerResponse) ProtoMessage()    {}
 func (*ConnectPeerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{36}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{40}
 }
 func (m *ConnectPeerResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConnectPeerResponse.Unmarshal(m, b)
@@ -2526,7 +3518,7 @@ func (m *DisconnectPeerRequest) Reset()         { *m = DisconnectPeerRequest{} }
 func (m *DisconnectPeerRequest) String() string { return proto.CompactTextString(m) }
 func (*DisconnectPeerRequest) ProtoMessage()    {}
 func (*DisconnectPeerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{37}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{41}
 }
 func (m *DisconnectPeerRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DisconnectPeerRequest.Unmarshal(m, b)
@@ -2563,7 +3555,7 @@ func (m *DisconnectPeerResponse) Reset()         { *m = DisconnectPeerResponse{}
 func (m *DisconnectPeerResponse) String() string { return proto.CompactTextString(m) }
 func (*DisconnectPeerResponse) ProtoMessage()    {}
 func (*DisconnectPeerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{38}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{42}
 }
 func (m *DisconnectPeerResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DisconnectPeerResponse.Unmarshal(m, b)
@@ -2583,76 +3575,176 @@ func (m *DisconnectPeerResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DisconnectPeerResponse proto.InternalMessageInfo
 
-type HTLC struct {
-	Incoming             bool     `protobuf:"varint,1,opt,name=incoming,proto3" json:"incoming,omitempty"`
-	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	HashLock             []byte   `protobuf:"bytes,3,opt,name=hash_lock,proto3" json:"hash_lock,omitempty"`
-	ExpirationHeight     uint32   `protobuf:"varint,4,opt,name=expiration_height,proto3" json:"expiration_height,omitempty"`
+type SetPeerConnPolicyRequest struct {
+	// / The pubkey of the peer to set the connection policy of.
+	PubKey string `protobuf:"bytes,1,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
+	// / Whether the connection to the peer should be re-established.
+	Reconnect SetPeerConnPolicyRequest_ReconnectPolicy `protobuf:"varint,2,opt,name=reconnect,proto3,enum=lnrpc.SetPeerConnPolicyRequest_ReconnectPolicy" json:"reconnect,omitempty"`
+	// *
+	// The shortest backoff in seconds between reconnection attempts. If zero,
+	// the minimum backoff of the node is used.
+	MinBackoffSecs uint32 `protobuf:"varint,3,opt,name=min_backoff_secs,proto3" json:"min_backoff_secs,omitempty"`
+	// *
+	// The longest backoff in seconds between reconnection attempts. If zero, the
+	// maximum backoff of the node is used.
+	MaxBackoffSecs       uint32   `protobuf:"varint,4,opt,name=max_backoff_secs,proto3" json:"max_backoff_secs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *HTLC) Reset()         { *m = HTLC{} }
-func (m *HTLC) String() string { return proto.CompactTextString(m) }
-func (*HTLC) ProtoMessage()    {}
-func (*HTLC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{39}
+func (m *SetPeerConnPolicyRequest) Reset()         { *m = SetPeerConnPolicyRequest{} }
+func (m *SetPeerConnPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*SetPeerConnPolicyRequest) ProtoMessage()    {}
+func (*SetPeerConnPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{43}
 }
-func (m *HTLC) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_HTLC.Unmarshal(m, b)
+func (m *SetPeerConnPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetPeerConnPolicyRequest.Unmarshal(m, b)
 }
-func (m *HTLC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_HTLC.Marshal(b, m, deterministic)
+func (m *SetPeerConnPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetPeerConnPolicyRequest.Marshal(b, m, deterministic)
 }
-func (dst *HTLC) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HTLC.Merge(dst, src)
+func (dst *SetPeerConnPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPeerConnPolicyRequest.Merge(dst, src)
 }
-func (m *HTLC) XXX_Size() int {
-	return xxx_messageInfo_HTLC.Size(m)
+func (m *SetPeerConnPolicyRequest) XXX_Size() int {
+	return xxx_messageInfo_SetPeerConnPolicyRequest.Size(m)
 }
-func (m *HTLC) XXX_DiscardUnknown() {
-	xxx_messageInfo_HTLC.DiscardUnknown(m)
+func (m *SetPeerConnPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPeerConnPolicyRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HTLC proto.InternalMessageInfo
+var xxx_messageInfo_SetPeerConnPolicyRequest proto.InternalMessageInfo
 
-func (m *HTLC) GetIncoming() bool {
+func (m *SetPeerConnPolicyRequest) GetPubKey() string {
 	if m != nil {
-		return m.Incoming
+		return m.PubKey
 	}
-	return false
+	return ""
 }
 
-func (m *HTLC) GetAmount() int64 {
+func (m *SetPeerConnPolicyRequest) GetReconnect() SetPeerConnPolicyRequest_ReconnectPolicy {
 	if m != nil {
-		return m.Amount
+		return m.Reconnect
 	}
-	return 0
+	return SetPeerConnPolicyRequest_DEFAULT
 }
 
-func (m *HTLC) GetHashLock() []byte {
+func (m *SetPeerConnPolicyRequest) GetMinBackoffSecs() uint32 {
 	if m != nil {
-		return m.HashLock
+		return m.MinBackoffSecs
 	}
-	return nil
+	return 0
 }
 
-func (m *HTLC) GetExpirationHeight() uint32 {
+func (m *SetPeerConnPolicyRequest) GetMaxBackoffSecs() uint32 {
 	if m != nil {
-		return m.ExpirationHeight
+		return m.MaxBackoffSecs
 	}
 	return 0
 }
 
-type Channel struct {
-	// / Whether this channel is active or not
-	Active bool `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
-	// / The identity pubkey of the remote node
-	RemotePubkey string `protobuf:"bytes,2,opt,name=remote_pubkey,proto3" json:"remote_pubkey,omitempty"`
-	// *
-	// The outpoint (txid:index) of the funding transaction. With this value, Bob
-	// will be able to generate a signature for Alice's version of the commitment
+type SetPeerConnPolicyResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetPeerConnPolicyResponse) Reset()         { *m = SetPeerConnPolicyResponse{} }
+func (m *SetPeerConnPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*SetPeerConnPolicyResponse) ProtoMessage()    {}
+func (*SetPeerConnPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{44}
+}
+func (m *SetPeerConnPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetPeerConnPolicyResponse.Unmarshal(m, b)
+}
+func (m *SetPeerConnPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetPeerConnPolicyResponse.Marshal(b, m, deterministic)
+}
+func (dst *SetPeerConnPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPeerConnPolicyResponse.Merge(dst, src)
+}
+func (m *SetPeerConnPolicyResponse) XXX_Size() int {
+	return xxx_messageInfo_SetPeerConnPolicyResponse.Size(m)
+}
+func (m *SetPeerConnPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPeerConnPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetPeerConnPolicyResponse proto.InternalMessageInfo
+
+type HTLC struct {
+	Incoming             bool     `protobuf:"varint,1,opt,name=incoming,proto3" json:"incoming,omitempty"`
+	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	HashLock             []byte   `protobuf:"bytes,3,opt,name=hash_lock,proto3" json:"hash_lock,omitempty"`
+	ExpirationHeight     uint32   `protobuf:"varint,4,opt,name=expiration_height,proto3" json:"expiration_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HTLC) Reset()         { *m = HTLC{} }
+func (m *HTLC) String() string { return proto.CompactTextString(m) }
+func (*HTLC) ProtoMessage()    {}
+func (*HTLC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{45}
+}
+func (m *HTLC) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HTLC.Unmarshal(m, b)
+}
+func (m *HTLC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HTLC.Marshal(b, m, deterministic)
+}
+func (dst *HTLC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTLC.Merge(dst, src)
+}
+func (m *HTLC) XXX_Size() int {
+	return xxx_messageInfo_HTLC.Size(m)
+}
+func (m *HTLC) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTLC.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTLC proto.InternalMessageInfo
+
+func (m *HTLC) GetIncoming() bool {
+	if m != nil {
+		return m.Incoming
+	}
+	return false
+}
+
+func (m *HTLC) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *HTLC) GetHashLock() []byte {
+	if m != nil {
+		return m.HashLock
+	}
+	return nil
+}
+
+func (m *HTLC) GetExpirationHeight() uint32 {
+	if m != nil {
+		return m.ExpirationHeight
+	}
+	return 0
+}
+
+type Channel struct {
+	// / Whether this channel is active or not
+	Active bool `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	// / The identity pubkey of the remote node
+	RemotePubkey string `protobuf:"bytes,2,opt,name=remote_pubkey,proto3" json:"remote_pubkey,omitempty"`
+	// *
+	// The outpoint (txid:index) of the funding transaction. With this value, Bob
+	// will be able to generate a signature for Alice's version of the commitment
 	// transaction.
 	ChannelPoint string `protobuf:"bytes,3,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
 	// *
@@ -2713,17 +3805,26 @@ type Channel struct {
 	// in the output of the remote party does not change each state. This makes
 	// back up and recovery easier as when the channel is closed, the funds go
 	// directly to that key.
-	StaticRemoteKey      bool     `protobuf:"varint,22,opt,name=static_remote_key,proto3" json:"static_remote_key,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	StaticRemoteKey bool `protobuf:"varint,22,opt,name=static_remote_key,proto3" json:"static_remote_key,omitempty"`
+	// / The number of HTLCs we've sent within this channel that were settled.
+	NumHtlcsSent uint64 `protobuf:"varint,23,opt,name=num_htlcs_sent,proto3" json:"num_htlcs_sent,omitempty"`
+	// *
+	// The number of HTLCs we've received within this channel that were settled.
+	NumHtlcsReceived uint64 `protobuf:"varint,24,opt,name=num_htlcs_received,proto3" json:"num_htlcs_received,omitempty"`
+	// / The number of HTLCs we've sent within this channel that failed.
+	NumHtlcsSentFailed uint64 `protobuf:"varint,25,opt,name=num_htlcs_sent_failed,proto3" json:"num_htlcs_sent_failed,omitempty"`
+	// / The number of HTLCs we've received within this channel that failed.
+	NumHtlcsReceivedFailed uint64   `protobuf:"varint,26,opt,name=num_htlcs_received_failed,proto3" json:"num_htlcs_received_failed,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_unrecognized       []byte   `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
 }
 
 func (m *Channel) Reset()         { *m = Channel{} }
 func (m *Channel) String() string { return proto.CompactTextString(m) }
 func (*Channel) ProtoMessage()    {}
 func (*Channel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{40}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{46}
 }
 func (m *Channel) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Channel.Unmarshal(m, b)
@@ -2897,11 +3998,51 @@ func (m *Channel) GetStaticRemoteKey() bool {
 	return false
 }
 
+func (m *Channel) GetNumHtlcsSent() uint64 {
+	if m != nil {
+		return m.NumHtlcsSent
+	}
+	return 0
+}
+
+func (m *Channel) GetNumHtlcsReceived() uint64 {
+	if m != nil {
+		return m.NumHtlcsReceived
+	}
+	return 0
+}
+
+func (m *Channel) GetNumHtlcsSentFailed() uint64 {
+	if m != nil {
+		return m.NumHtlcsSentFailed
+	}
+	return 0
+}
+
+func (m *Channel) GetNumHtlcsReceivedFailed() uint64 {
+	if m != nil {
+		return m.NumHtlcsReceivedFailed
+	}
+	return 0
+}
+
 type ListChannelsRequest struct {
-	ActiveOnly           bool     `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
-	InactiveOnly         bool     `protobuf:"varint,2,opt,name=inactive_only,json=inactiveOnly,proto3" json:"inactive_only,omitempty"`
-	PublicOnly           bool     `protobuf:"varint,3,opt,name=public_only,json=publicOnly,proto3" json:"public_only,omitempty"`
-	PrivateOnly          bool     `protobuf:"varint,4,opt,name=private_only,json=privateOnly,proto3" json:"private_only,omitempty"`
+	ActiveOnly   bool `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	InactiveOnly bool `protobuf:"varint,2,opt,name=inactive_only,json=inactiveOnly,proto3" json:"inactive_only,omitempty"`
+	PublicOnly   bool `protobuf:"varint,3,opt,name=public_only,json=publicOnly,proto3" json:"public_only,omitempty"`
+	PrivateOnly  bool `protobuf:"varint,4,opt,name=private_only,json=privateOnly,proto3" json:"private_only,omitempty"`
+	// *
+	// Filters the response for channels with a target peer's pubkey. If peer is
+	// empty, all channels will be returned.
+	Peer []byte `protobuf:"bytes,5,opt,name=peer,proto3" json:"peer,omitempty"`
+	// / If non-zero, only channels with at least this capacity are returned.
+	MinCapacity int64 `protobuf:"varint,6,opt,name=min_capacity,json=minCapacity,proto3" json:"min_capacity,omitempty"`
+	// / If non-zero, only channels with at most this capacity are returned.
+	MaxCapacity int64 `protobuf:"varint,7,opt,name=max_capacity,json=maxCapacity,proto3" json:"max_capacity,omitempty"`
+	// / Only return the channels that were opened by us.
+	InitiatorOnly bool `protobuf:"varint,8,opt,name=initiator_only,json=initiatorOnly,proto3" json:"initiator_only,omitempty"`
+	// / Only return the channels that were opened by the remote peer.
+	NonInitiatorOnly     bool     `protobuf:"varint,9,opt,name=non_initiator_only,json=nonInitiatorOnly,proto3" json:"non_initiator_only,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2911,7 +4052,7 @@ func (m *ListChannelsRequest) Reset()         { *m = ListChannelsRequest{} }
 func (m *ListChannelsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListChannelsRequest) ProtoMessage()    {}
 func (*ListChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{41}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{47}
 }
 func (m *ListChannelsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListChannelsRequest.Unmarshal(m, b)
@@ -2959,6 +4100,41 @@ func (m *ListChannelsRequest) GetPrivateOnly() bool {
 	return false
 }
 
+func (m *ListChannelsRequest) GetPeer() []byte {
+	if m != nil {
+		return m.Peer
+	}
+	return nil
+}
+
+func (m *ListChannelsRequest) GetMinCapacity() int64 {
+	if m != nil {
+		return m.MinCapacity
+	}
+	return 0
+}
+
+func (m *ListChannelsRequest) GetMaxCapacity() int64 {
+	if m != nil {
+		return m.MaxCapacity
+	}
+	return 0
+}
+
+func (m *ListChannelsRequest) GetInitiatorOnly() bool {
+	if m != nil {
+		return m.InitiatorOnly
+	}
+	return false
+}
+
+func (m *ListChannelsRequest) GetNonInitiatorOnly() bool {
+	if m != nil {
+		return m.NonInitiatorOnly
+	}
+	return false
+}
+
 type ListChannelsResponse struct {
 	// / The list of active channels
 	Channels             []*Channel `protobuf:"bytes,11,rep,name=channels,proto3" json:"channels,omitempty"`
@@ -2971,7 +4147,7 @@ func (m *ListChannelsResponse) Reset()         { *m = ListChannelsResponse{} }
 func (m *ListChannelsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListChannelsResponse) ProtoMessage()    {}
 func (*ListChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{42}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{48}
 }
 func (m *ListChannelsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListChannelsResponse.Unmarshal(m, b)
@@ -2998,6 +4174,63 @@ func (m *ListChannelsResponse) GetChannels() []*Channel {
 	return nil
 }
 
+type Resolution struct {
+	// / The kind of the output.
+	ResolutionType Resolution_ResolutionType `protobuf:"varint,1,opt,name=resolution_type,proto3,enum=lnrpc.Resolution_ResolutionType" json:"resolution_type,omitempty"`
+	// / The outpoint of the output within the closing transaction.
+	Outpoint *OutPoint `protobuf:"bytes,2,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	// / The value of the output in atoms.
+	AmountAtoms          int64    `protobuf:"varint,3,opt,name=amount_atoms,proto3" json:"amount_atoms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Resolution) Reset()         { *m = Resolution{} }
+func (m *Resolution) String() string { return proto.CompactTextString(m) }
+func (*Resolution) ProtoMessage()    {}
+func (*Resolution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{49}
+}
+func (m *Resolution) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Resolution.Unmarshal(m, b)
+}
+func (m *Resolution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Resolution.Marshal(b, m, deterministic)
+}
+func (dst *Resolution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Resolution.Merge(dst, src)
+}
+func (m *Resolution) XXX_Size() int {
+	return xxx_messageInfo_Resolution.Size(m)
+}
+func (m *Resolution) XXX_DiscardUnknown() {
+	xxx_messageInfo_Resolution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Resolution proto.InternalMessageInfo
+
+func (m *Resolution) GetResolutionType() Resolution_ResolutionType {
+	if m != nil {
+		return m.ResolutionType
+	}
+	return Resolution_SETTLED
+}
+
+func (m *Resolution) GetOutpoint() *OutPoint {
+	if m != nil {
+		return m.Outpoint
+	}
+	return nil
+}
+
+func (m *Resolution) GetAmountAtoms() int64 {
+	if m != nil {
+		return m.AmountAtoms
+	}
+	return 0
+}
+
 type ChannelCloseSummary struct {
 	// / The outpoint (txid:index) of the funding transaction.
 	ChannelPoint string `protobuf:"bytes,1,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
@@ -3018,17 +4251,56 @@ type ChannelCloseSummary struct {
 	// / The sum of all the time-locked outputs at the time of channel closure
 	TimeLockedBalance int64 `protobuf:"varint,9,opt,name=time_locked_balance,proto3" json:"time_locked_balance,omitempty"`
 	// / Details on how the channel was closed.
-	CloseType            ChannelCloseSummary_ClosureType `protobuf:"varint,10,opt,name=close_type,proto3,enum=lnrpc.ChannelCloseSummary_ClosureType" json:"close_type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
-	XXX_unrecognized     []byte                          `json:"-"`
-	XXX_sizecache        int32                           `json:"-"`
+	CloseType ChannelCloseSummary_ClosureType `protobuf:"varint,10,opt,name=close_type,proto3,enum=lnrpc.ChannelCloseSummary_ClosureType" json:"close_type,omitempty"`
+	// *
+	// Whether the final state of the channel was kept when it was closed. The
+	// remaining fields are only set if it was, which is the case for all
+	// channels closed since this information started to be kept.
+	HistoricalData bool `protobuf:"varint,11,opt,name=historical_data,proto3" json:"historical_data,omitempty"`
+	// / True if we were the ones that created the channel.
+	Initiator bool `protobuf:"varint,12,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	// / Whether this channel was advertised to the network or not.
+	Private bool `protobuf:"varint,13,opt,name=private,proto3" json:"private,omitempty"`
+	// / The total number of atoms we've sent within this channel.
+	TotalAtomsSent int64 `protobuf:"varint,14,opt,name=total_atoms_sent,proto3" json:"total_atoms_sent,omitempty"`
+	// / The total number of atoms we've received within this channel.
+	TotalAtomsReceived int64 `protobuf:"varint,15,opt,name=total_atoms_received,proto3" json:"total_atoms_received,omitempty"`
+	// / The CSV delay expressed in relative blocks that applied to our funds.
+	CsvDelay uint32 `protobuf:"varint,16,opt,name=csv_delay,proto3" json:"csv_delay,omitempty"`
+	// / The minimum atoms this node was required to reserve in its balance.
+	LocalChanReserveAtoms int64 `protobuf:"varint,17,opt,name=local_chan_reserve_atoms,proto3" json:"local_chan_reserve_atoms,omitempty"`
+	// *
+	// The minimum atoms the other node was required to reserve in its balance.
+	RemoteChanReserveAtoms int64 `protobuf:"varint,18,opt,name=remote_chan_reserve_atoms,proto3" json:"remote_chan_reserve_atoms,omitempty"`
+	// *
+	// If true, then this channel used the modern commitment format where the key
+	// in the output of the remote party does not change each state.
+	StaticRemoteKey bool `protobuf:"varint,19,opt,name=static_remote_key,proto3" json:"static_remote_key,omitempty"`
+	// / The number of HTLCs we've sent within this channel that were settled.
+	NumHtlcsSent uint64 `protobuf:"varint,20,opt,name=num_htlcs_sent,proto3" json:"num_htlcs_sent,omitempty"`
+	// *
+	// The number of HTLCs we've received within this channel that were settled.
+	NumHtlcsReceived uint64 `protobuf:"varint,21,opt,name=num_htlcs_received,proto3" json:"num_htlcs_received,omitempty"`
+	// / The number of HTLCs we've sent within this channel that failed.
+	NumHtlcsSentFailed uint64 `protobuf:"varint,22,opt,name=num_htlcs_sent_failed,proto3" json:"num_htlcs_sent_failed,omitempty"`
+	// / The number of HTLCs we've received within this channel that failed.
+	NumHtlcsReceivedFailed uint64 `protobuf:"varint,23,opt,name=num_htlcs_received_failed,proto3" json:"num_htlcs_received_failed,omitempty"`
+	// / The party that initiated the close of the channel.
+	CloseInitiator Initiator `protobuf:"varint,24,opt,name=close_initiator,proto3,enum=lnrpc.Initiator" json:"close_initiator,omitempty"`
+	// *
+	// The outputs of the closing transaction that belong to us, omitting the
+	// outputs that were trimmed as dust.
+	Resolutions          []*Resolution `protobuf:"bytes,25,rep,name=resolutions,proto3" json:"resolutions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *ChannelCloseSummary) Reset()         { *m = ChannelCloseSummary{} }
 func (m *ChannelCloseSummary) String() string { return proto.CompactTextString(m) }
 func (*ChannelCloseSummary) ProtoMessage()    {}
 func (*ChannelCloseSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{43}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{50}
 }
 func (m *ChannelCloseSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelCloseSummary.Unmarshal(m, b)
@@ -3118,96 +4390,230 @@ func (m *ChannelCloseSummary) GetCloseType() ChannelCloseSummary_ClosureType {
 	return ChannelCloseSummary_COOPERATIVE_CLOSE
 }
 
-type ClosedChannelsRequest struct {
-	Cooperative          bool     `protobuf:"varint,1,opt,name=cooperative,proto3" json:"cooperative,omitempty"`
-	LocalForce           bool     `protobuf:"varint,2,opt,name=local_force,json=localForce,proto3" json:"local_force,omitempty"`
-	RemoteForce          bool     `protobuf:"varint,3,opt,name=remote_force,json=remoteForce,proto3" json:"remote_force,omitempty"`
-	Breach               bool     `protobuf:"varint,4,opt,name=breach,proto3" json:"breach,omitempty"`
-	FundingCanceled      bool     `protobuf:"varint,5,opt,name=funding_canceled,json=fundingCanceled,proto3" json:"funding_canceled,omitempty"`
-	Abandoned            bool     `protobuf:"varint,6,opt,name=abandoned,proto3" json:"abandoned,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ChannelCloseSummary) GetHistoricalData() bool {
+	if m != nil {
+		return m.HistoricalData
+	}
+	return false
 }
 
-func (m *ClosedChannelsRequest) Reset()         { *m = ClosedChannelsRequest{} }
-func (m *ClosedChannelsRequest) String() string { return proto.CompactTextString(m) }
-func (*ClosedChannelsRequest) ProtoMessage()    {}
-func (*ClosedChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{44}
-}
-func (m *ClosedChannelsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ClosedChannelsRequest.Unmarshal(m, b)
-}
-func (m *ClosedChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ClosedChannelsRequest.Marshal(b, m, deterministic)
+func (m *ChannelCloseSummary) GetInitiator() bool {
+	if m != nil {
+		return m.Initiator
+	}
+	return false
 }
-func (dst *ClosedChannelsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ClosedChannelsRequest.Merge(dst, src)
+
+func (m *ChannelCloseSummary) GetPrivate() bool {
+	if m != nil {
+		return m.Private
+	}
+	return false
 }
-func (m *ClosedChannelsRequest) XXX_Size() int {
-	return xxx_messageInfo_ClosedChannelsRequest.Size(m)
+
+func (m *ChannelCloseSummary) GetTotalAtomsSent() int64 {
+	if m != nil {
+		return m.TotalAtomsSent
+	}
+	return 0
 }
-func (m *ClosedChannelsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ClosedChannelsRequest.DiscardUnknown(m)
+
+func (m *ChannelCloseSummary) GetTotalAtomsReceived() int64 {
+	if m != nil {
+		return m.TotalAtomsReceived
+	}
+	return 0
 }
 
-var xxx_messageInfo_ClosedChannelsRequest proto.InternalMessageInfo
+func (m *ChannelCloseSummary) GetCsvDelay() uint32 {
+	if m != nil {
+		return m.CsvDelay
+	}
+	return 0
+}
 
-func (m *ClosedChannelsRequest) GetCooperative() bool {
+func (m *ChannelCloseSummary) GetLocalChanReserveAtoms() int64 {
 	if m != nil {
-		return m.Cooperative
+		return m.LocalChanReserveAtoms
 	}
-	return false
+	return 0
 }
 
-func (m *ClosedChannelsRequest) GetLocalForce() bool {
+func (m *ChannelCloseSummary) GetRemoteChanReserveAtoms() int64 {
 	if m != nil {
-		return m.LocalForce
+		return m.RemoteChanReserveAtoms
 	}
-	return false
+	return 0
 }
 
-func (m *ClosedChannelsRequest) GetRemoteForce() bool {
+func (m *ChannelCloseSummary) GetStaticRemoteKey() bool {
 	if m != nil {
-		return m.RemoteForce
+		return m.StaticRemoteKey
 	}
 	return false
 }
 
-func (m *ClosedChannelsRequest) GetBreach() bool {
+func (m *ChannelCloseSummary) GetNumHtlcsSent() uint64 {
 	if m != nil {
-		return m.Breach
+		return m.NumHtlcsSent
 	}
-	return false
+	return 0
 }
 
-func (m *ClosedChannelsRequest) GetFundingCanceled() bool {
+func (m *ChannelCloseSummary) GetNumHtlcsReceived() uint64 {
 	if m != nil {
-		return m.FundingCanceled
+		return m.NumHtlcsReceived
 	}
-	return false
+	return 0
 }
 
-func (m *ClosedChannelsRequest) GetAbandoned() bool {
+func (m *ChannelCloseSummary) GetNumHtlcsSentFailed() uint64 {
 	if m != nil {
-		return m.Abandoned
+		return m.NumHtlcsSentFailed
 	}
-	return false
+	return 0
 }
 
-type ClosedChannelsResponse struct {
-	Channels             []*ChannelCloseSummary `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+func (m *ChannelCloseSummary) GetNumHtlcsReceivedFailed() uint64 {
+	if m != nil {
+		return m.NumHtlcsReceivedFailed
+	}
+	return 0
 }
 
-func (m *ClosedChannelsResponse) Reset()         { *m = ClosedChannelsResponse{} }
+func (m *ChannelCloseSummary) GetCloseInitiator() Initiator {
+	if m != nil {
+		return m.CloseInitiator
+	}
+	return Initiator_INITIATOR_UNKNOWN
+}
+
+func (m *ChannelCloseSummary) GetResolutions() []*Resolution {
+	if m != nil {
+		return m.Resolutions
+	}
+	return nil
+}
+
+type ClosedChannelsRequest struct {
+	Cooperative     bool `protobuf:"varint,1,opt,name=cooperative,proto3" json:"cooperative,omitempty"`
+	LocalForce      bool `protobuf:"varint,2,opt,name=local_force,json=localForce,proto3" json:"local_force,omitempty"`
+	RemoteForce     bool `protobuf:"varint,3,opt,name=remote_force,json=remoteForce,proto3" json:"remote_force,omitempty"`
+	Breach          bool `protobuf:"varint,4,opt,name=breach,proto3" json:"breach,omitempty"`
+	FundingCanceled bool `protobuf:"varint,5,opt,name=funding_canceled,json=fundingCanceled,proto3" json:"funding_canceled,omitempty"`
+	Abandoned       bool `protobuf:"varint,6,opt,name=abandoned,proto3" json:"abandoned,omitempty"`
+	// *
+	// Filters the response for channels with a target peer's pubkey. If peer is
+	// empty, all channels will be returned.
+	Peer []byte `protobuf:"bytes,7,opt,name=peer,proto3" json:"peer,omitempty"`
+	// / If non-zero, only channels with at least this capacity are returned.
+	MinCapacity int64 `protobuf:"varint,8,opt,name=min_capacity,json=minCapacity,proto3" json:"min_capacity,omitempty"`
+	// / If non-zero, only channels with at most this capacity are returned.
+	MaxCapacity          int64    `protobuf:"varint,9,opt,name=max_capacity,json=maxCapacity,proto3" json:"max_capacity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClosedChannelsRequest) Reset()         { *m = ClosedChannelsRequest{} }
+func (m *ClosedChannelsRequest) String() string { return proto.CompactTextString(m) }
+func (*ClosedChannelsRequest) ProtoMessage()    {}
+func (*ClosedChannelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{51}
+}
+func (m *ClosedChannelsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClosedChannelsRequest.Unmarshal(m, b)
+}
+func (m *ClosedChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClosedChannelsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ClosedChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClosedChannelsRequest.Merge(dst, src)
+}
+func (m *ClosedChannelsRequest) XXX_Size() int {
+	return xxx_messageInfo_ClosedChannelsRequest.Size(m)
+}
+func (m *ClosedChannelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClosedChannelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClosedChannelsRequest proto.InternalMessageInfo
+
+func (m *ClosedChannelsRequest) GetCooperative() bool {
+	if m != nil {
+		return m.Cooperative
+	}
+	return false
+}
+
+func (m *ClosedChannelsRequest) GetLocalForce() bool {
+	if m != nil {
+		return m.LocalForce
+	}
+	return false
+}
+
+func (m *ClosedChannelsRequest) GetRemoteForce() bool {
+	if m != nil {
+		return m.RemoteForce
+	}
+	return false
+}
+
+func (m *ClosedChannelsRequest) GetBreach() bool {
+	if m != nil {
+		return m.Breach
+	}
+	return false
+}
+
+func (m *ClosedChannelsRequest) GetFundingCanceled() bool {
+	if m != nil {
+		return m.FundingCanceled
+	}
+	return false
+}
+
+func (m *ClosedChannelsRequest) GetAbandoned() bool {
+	if m != nil {
+		return m.Abandoned
+	}
+	return false
+}
+
+func (m *ClosedChannelsRequest) GetPeer() []byte {
+	if m != nil {
+		return m.Peer
+	}
+	return nil
+}
+
+func (m *ClosedChannelsRequest) GetMinCapacity() int64 {
+	if m != nil {
+		return m.MinCapacity
+	}
+	return 0
+}
+
+func (m *ClosedChannelsRequest) GetMaxCapacity() int64 {
+	if m != nil {
+		return m.MaxCapacity
+	}
+	return 0
+}
+
+type ClosedChannelsResponse struct {
+	Channels             []*ChannelCloseSummary `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ClosedChannelsResponse) Reset()         { *m = ClosedChannelsResponse{} }
 func (m *ClosedChannelsResponse) String() string { return proto.CompactTextString(m) }
 func (*ClosedChannelsResponse) ProtoMessage()    {}
 func (*ClosedChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{45}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{52}
 }
 func (m *ClosedChannelsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClosedChannelsResponse.Unmarshal(m, b)
@@ -3234,6 +4640,240 @@ func (m *ClosedChannelsResponse) GetChannels() []*ChannelCloseSummary {
 	return nil
 }
 
+type ListArbitratorsRequest struct {
+	// *
+	// If set, only the arbitrator of this channel point, in the form
+	// funding_txid:output_index, is returned.
+	ChannelPoint         string   `protobuf:"bytes,1,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListArbitratorsRequest) Reset()         { *m = ListArbitratorsRequest{} }
+func (m *ListArbitratorsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListArbitratorsRequest) ProtoMessage()    {}
+func (*ListArbitratorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{53}
+}
+func (m *ListArbitratorsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListArbitratorsRequest.Unmarshal(m, b)
+}
+func (m *ListArbitratorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListArbitratorsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListArbitratorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListArbitratorsRequest.Merge(dst, src)
+}
+func (m *ListArbitratorsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListArbitratorsRequest.Size(m)
+}
+func (m *ListArbitratorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListArbitratorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListArbitratorsRequest proto.InternalMessageInfo
+
+func (m *ListArbitratorsRequest) GetChannelPoint() string {
+	if m != nil {
+		return m.ChannelPoint
+	}
+	return ""
+}
+
+type ListArbitratorsResponse struct {
+	// / The arbitrators of the channels open or being resolved on-chain.
+	Arbitrators          []*ChannelArbitrator `protobuf:"bytes,1,rep,name=arbitrators,proto3" json:"arbitrators,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ListArbitratorsResponse) Reset()         { *m = ListArbitratorsResponse{} }
+func (m *ListArbitratorsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListArbitratorsResponse) ProtoMessage()    {}
+func (*ListArbitratorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{54}
+}
+func (m *ListArbitratorsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListArbitratorsResponse.Unmarshal(m, b)
+}
+func (m *ListArbitratorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListArbitratorsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListArbitratorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListArbitratorsResponse.Merge(dst, src)
+}
+func (m *ListArbitratorsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListArbitratorsResponse.Size(m)
+}
+func (m *ListArbitratorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListArbitratorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListArbitratorsResponse proto.InternalMessageInfo
+
+func (m *ListArbitratorsResponse) GetArbitrators() []*ChannelArbitrator {
+	if m != nil {
+		return m.Arbitrators
+	}
+	return nil
+}
+
+type ChannelArbitrator struct {
+	// / The channel point of the channel, in the form funding_txid:output_index.
+	ChannelPoint string `protobuf:"bytes,1,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
+	// / The current state of the arbitrator.
+	State ChannelArbitrator_ArbitratorState `protobuf:"varint,2,opt,name=state,proto3,enum=lnrpc.ChannelArbitrator_ArbitratorState" json:"state,omitempty"`
+	// / The resolvers claiming the outputs of the commitment transaction.
+	Resolvers            []*ContractResolver `protobuf:"bytes,3,rep,name=resolvers,proto3" json:"resolvers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ChannelArbitrator) Reset()         { *m = ChannelArbitrator{} }
+func (m *ChannelArbitrator) String() string { return proto.CompactTextString(m) }
+func (*ChannelArbitrator) ProtoMessage()    {}
+func (*ChannelArbitrator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{55}
+}
+func (m *ChannelArbitrator) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelArbitrator.Unmarshal(m, b)
+}
+func (m *ChannelArbitrator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelArbitrator.Marshal(b, m, deterministic)
+}
+func (dst *ChannelArbitrator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelArbitrator.Merge(dst, src)
+}
+func (m *ChannelArbitrator) XXX_Size() int {
+	return xxx_messageInfo_ChannelArbitrator.Size(m)
+}
+func (m *ChannelArbitrator) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelArbitrator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelArbitrator proto.InternalMessageInfo
+
+func (m *ChannelArbitrator) GetChannelPoint() string {
+	if m != nil {
+		return m.ChannelPoint
+	}
+	return ""
+}
+
+func (m *ChannelArbitrator) GetState() ChannelArbitrator_ArbitratorState {
+	if m != nil {
+		return m.State
+	}
+	return ChannelArbitrator_DEFAULT
+}
+
+func (m *ChannelArbitrator) GetResolvers() []*ContractResolver {
+	if m != nil {
+		return m.Resolvers
+	}
+	return nil
+}
+
+type ContractResolver struct {
+	// / The kind of output being claimed.
+	ResolverType ContractResolver_ResolverType `protobuf:"varint,1,opt,name=resolver_type,proto3,enum=lnrpc.ContractResolver_ResolverType" json:"resolver_type,omitempty"`
+	// / The output of the commitment transaction being claimed.
+	Outpoint string `protobuf:"bytes,2,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	// / The value of the output in atoms.
+	Amount int64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// / The payment hash of the htlc, if known.
+	PaymentHash []byte `protobuf:"bytes,4,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
+	// / What the resolver is waiting for.
+	Stage ContractResolver_ResolverStage `protobuf:"varint,5,opt,name=stage,proto3,enum=lnrpc.ContractResolver_ResolverStage" json:"stage,omitempty"`
+	// *
+	// The block height at which the current stage is over, or zero if it isn't
+	// known.
+	MaturityHeight uint32 `protobuf:"varint,6,opt,name=maturity_height,proto3" json:"maturity_height,omitempty"`
+	// *
+	// The transaction claiming the output, if known. For the htlcs on our
+	// commitment, this is the second-level transaction.
+	SweepTxid            string   `protobuf:"bytes,7,opt,name=sweep_txid,proto3" json:"sweep_txid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContractResolver) Reset()         { *m = ContractResolver{} }
+func (m *ContractResolver) String() string { return proto.CompactTextString(m) }
+func (*ContractResolver) ProtoMessage()    {}
+func (*ContractResolver) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{56}
+}
+func (m *ContractResolver) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContractResolver.Unmarshal(m, b)
+}
+func (m *ContractResolver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContractResolver.Marshal(b, m, deterministic)
+}
+func (dst *ContractResolver) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractResolver.Merge(dst, src)
+}
+func (m *ContractResolver) XXX_Size() int {
+	return xxx_messageInfo_ContractResolver.Size(m)
+}
+func (m *ContractResolver) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractResolver.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractResolver proto.InternalMessageInfo
+
+func (m *ContractResolver) GetResolverType() ContractResolver_ResolverType {
+	if m != nil {
+		return m.ResolverType
+	}
+	return ContractResolver_COMMIT
+}
+
+func (m *ContractResolver) GetOutpoint() string {
+	if m != nil {
+		return m.Outpoint
+	}
+	return ""
+}
+
+func (m *ContractResolver) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *ContractResolver) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
+}
+
+func (m *ContractResolver) GetStage() ContractResolver_ResolverStage {
+	if m != nil {
+		return m.Stage
+	}
+	return ContractResolver_AWAITING_PREIMAGE
+}
+
+func (m *ContractResolver) GetMaturityHeight() uint32 {
+	if m != nil {
+		return m.MaturityHeight
+	}
+	return 0
+}
+
+func (m *ContractResolver) GetSweepTxid() string {
+	if m != nil {
+		return m.SweepTxid
+	}
+	return ""
+}
+
 type Peer struct {
 	// / The identity pubkey of the peer
 	PubKey string `protobuf:"bytes,1,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
@@ -3252,17 +4892,24 @@ type Peer struct {
 	// / Ping time to this peer
 	PingTime int64 `protobuf:"varint,9,opt,name=ping_time,proto3" json:"ping_time,omitempty"`
 	// The type of sync we are currently performing with this peer.
-	SyncType             Peer_SyncType `protobuf:"varint,10,opt,name=sync_type,proto3,enum=lnrpc.Peer_SyncType" json:"sync_type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	SyncType Peer_SyncType `protobuf:"varint,10,opt,name=sync_type,proto3,enum=lnrpc.Peer_SyncType" json:"sync_type,omitempty"`
+	// / Time it took to exchange init messages with this peer in microseconds
+	InitDuration int64 `protobuf:"varint,11,opt,name=init_duration,proto3" json:"init_duration,omitempty"`
+	// *
+	// The name of the network bootstrapper the address of this peer was sampled
+	// from, if the connection was established by the automatic peer
+	// bootstrapping.
+	BootstrapSource      string   `protobuf:"bytes,12,opt,name=bootstrap_source,proto3" json:"bootstrap_source,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Peer) Reset()         { *m = Peer{} }
 func (m *Peer) String() string { return proto.CompactTextString(m) }
 func (*Peer) ProtoMessage()    {}
 func (*Peer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{46}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{57}
 }
 func (m *Peer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Peer.Unmarshal(m, b)
@@ -3345,6 +4992,20 @@ func (m *Peer) GetSyncType() Peer_SyncType {
 	return Peer_UNKNOWN_SYNC
 }
 
+func (m *Peer) GetInitDuration() int64 {
+	if m != nil {
+		return m.InitDuration
+	}
+	return 0
+}
+
+func (m *Peer) GetBootstrapSource() string {
+	if m != nil {
+		return m.BootstrapSource
+	}
+	return ""
+}
+
 type ListPeersRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -3355,7 +5016,7 @@ func (m *ListPeersRequest) Reset()         { *m = ListPeersRequest{} }
 func (m *ListPeersRequest) String() string { return proto.CompactTextString(m) }
 func (*ListPeersRequest) ProtoMessage()    {}
 func (*ListPeersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{47}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{58}
 }
 func (m *ListPeersRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListPeersRequest.Unmarshal(m, b)
@@ -3387,7 +5048,7 @@ func (m *ListPeersResponse) Reset()         { *m = ListPeersResponse{} }
 func (m *ListPeersResponse) String() string { return proto.CompactTextString(m) }
 func (*ListPeersResponse) ProtoMessage()    {}
 func (*ListPeersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{48}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{59}
 }
 func (m *ListPeersResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListPeersResponse.Unmarshal(m, b)
@@ -3414,37 +5075,420 @@ func (m *ListPeersResponse) GetPeers() []*Peer {
 	return nil
 }
 
-type GetInfoRequest struct {
+type PeerEventSubscription struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetInfoRequest) Reset()         { *m = GetInfoRequest{} }
-func (m *GetInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*GetInfoRequest) ProtoMessage()    {}
-func (*GetInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{49}
+func (m *PeerEventSubscription) Reset()         { *m = PeerEventSubscription{} }
+func (m *PeerEventSubscription) String() string { return proto.CompactTextString(m) }
+func (*PeerEventSubscription) ProtoMessage()    {}
+func (*PeerEventSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{60}
 }
-func (m *GetInfoRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetInfoRequest.Unmarshal(m, b)
+func (m *PeerEventSubscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerEventSubscription.Unmarshal(m, b)
 }
-func (m *GetInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetInfoRequest.Marshal(b, m, deterministic)
+func (m *PeerEventSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerEventSubscription.Marshal(b, m, deterministic)
 }
-func (dst *GetInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetInfoRequest.Merge(dst, src)
+func (dst *PeerEventSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerEventSubscription.Merge(dst, src)
 }
-func (m *GetInfoRequest) XXX_Size() int {
-	return xxx_messageInfo_GetInfoRequest.Size(m)
+func (m *PeerEventSubscription) XXX_Size() int {
+	return xxx_messageInfo_PeerEventSubscription.Size(m)
 }
-func (m *GetInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetInfoRequest.DiscardUnknown(m)
+func (m *PeerEventSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerEventSubscription.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_PeerEventSubscription proto.InternalMessageInfo
 
-type GetInfoResponse struct {
+type PeerEvent struct {
+	// / The identity pubkey of the peer.
+	PubKey string `protobuf:"bytes,1,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
+	// / The type of the event.
+	Type PeerEvent_EventType `protobuf:"varint,2,opt,name=type,proto3,enum=lnrpc.PeerEvent_EventType" json:"type,omitempty"`
+	// / The reason the peer was disconnected for, set for PEER_OFFLINE events.
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PeerEvent) Reset()         { *m = PeerEvent{} }
+func (m *PeerEvent) String() string { return proto.CompactTextString(m) }
+func (*PeerEvent) ProtoMessage()    {}
+func (*PeerEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{61}
+}
+func (m *PeerEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerEvent.Unmarshal(m, b)
+}
+func (m *PeerEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerEvent.Marshal(b, m, deterministic)
+}
+func (dst *PeerEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerEvent.Merge(dst, src)
+}
+func (m *PeerEvent) XXX_Size() int {
+	return xxx_messageInfo_PeerEvent.Size(m)
+}
+func (m *PeerEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerEvent proto.InternalMessageInfo
+
+func (m *PeerEvent) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *PeerEvent) GetType() PeerEvent_EventType {
+	if m != nil {
+		return m.Type
+	}
+	return PeerEvent_PEER_ONLINE
+}
+
+func (m *PeerEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type GossipSyncer struct {
+	// / The identity pubkey of the peer
+	PubKey string `protobuf:"bytes,1,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
+	// / The type of sync we are currently performing with this peer.
+	SyncType Peer_SyncType `protobuf:"varint,2,opt,name=sync_type,proto3,enum=lnrpc.Peer_SyncType" json:"sync_type,omitempty"`
+	// / The current state of the syncer's state machine.
+	SyncState            string   `protobuf:"bytes,3,opt,name=sync_state,proto3" json:"sync_state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GossipSyncer) Reset()         { *m = GossipSyncer{} }
+func (m *GossipSyncer) String() string { return proto.CompactTextString(m) }
+func (*GossipSyncer) ProtoMessage()    {}
+func (*GossipSyncer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{62}
+}
+func (m *GossipSyncer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GossipSyncer.Unmarshal(m, b)
+}
+func (m *GossipSyncer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GossipSyncer.Marshal(b, m, deterministic)
+}
+func (dst *GossipSyncer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GossipSyncer.Merge(dst, src)
+}
+func (m *GossipSyncer) XXX_Size() int {
+	return xxx_messageInfo_GossipSyncer.Size(m)
+}
+func (m *GossipSyncer) XXX_DiscardUnknown() {
+	xxx_messageInfo_GossipSyncer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GossipSyncer proto.InternalMessageInfo
+
+func (m *GossipSyncer) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *GossipSyncer) GetSyncType() Peer_SyncType {
+	if m != nil {
+		return m.SyncType
+	}
+	return Peer_UNKNOWN_SYNC
+}
+
+func (m *GossipSyncer) GetSyncState() string {
+	if m != nil {
+		return m.SyncState
+	}
+	return ""
+}
+
+type ListGossipSyncersRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListGossipSyncersRequest) Reset()         { *m = ListGossipSyncersRequest{} }
+func (m *ListGossipSyncersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListGossipSyncersRequest) ProtoMessage()    {}
+func (*ListGossipSyncersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{63}
+}
+func (m *ListGossipSyncersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListGossipSyncersRequest.Unmarshal(m, b)
+}
+func (m *ListGossipSyncersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListGossipSyncersRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListGossipSyncersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListGossipSyncersRequest.Merge(dst, src)
+}
+func (m *ListGossipSyncersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListGossipSyncersRequest.Size(m)
+}
+func (m *ListGossipSyncersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListGossipSyncersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListGossipSyncersRequest proto.InternalMessageInfo
+
+type ListGossipSyncersResponse struct {
+	// / The gossip syncers of all currently connected peers.
+	Syncers []*GossipSyncer `protobuf:"bytes,1,rep,name=syncers,proto3" json:"syncers,omitempty"`
+	// / Whether the initial historical sync of the graph has completed.
+	GraphSynced bool `protobuf:"varint,2,opt,name=graph_synced,proto3" json:"graph_synced,omitempty"`
+	// *
+	// The maximum number of bytes of channel and node announcements sent to all
+	// peers per second. Zero if there is no limit.
+	MsgRateBytes uint64 `protobuf:"varint,3,opt,name=msg_rate_bytes,proto3" json:"msg_rate_bytes,omitempty"`
+	// *
+	// The maximum number of bytes of channel and node announcements that can be
+	// sent at once.
+	MsgBurstBytes        uint64   `protobuf:"varint,4,opt,name=msg_burst_bytes,proto3" json:"msg_burst_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListGossipSyncersResponse) Reset()         { *m = ListGossipSyncersResponse{} }
+func (m *ListGossipSyncersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListGossipSyncersResponse) ProtoMessage()    {}
+func (*ListGossipSyncersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{64}
+}
+func (m *ListGossipSyncersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListGossipSyncersResponse.Unmarshal(m, b)
+}
+func (m *ListGossipSyncersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListGossipSyncersResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListGossipSyncersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListGossipSyncersResponse.Merge(dst, src)
+}
+func (m *ListGossipSyncersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListGossipSyncersResponse.Size(m)
+}
+func (m *ListGossipSyncersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListGossipSyncersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListGossipSyncersResponse proto.InternalMessageInfo
+
+func (m *ListGossipSyncersResponse) GetSyncers() []*GossipSyncer {
+	if m != nil {
+		return m.Syncers
+	}
+	return nil
+}
+
+func (m *ListGossipSyncersResponse) GetGraphSynced() bool {
+	if m != nil {
+		return m.GraphSynced
+	}
+	return false
+}
+
+func (m *ListGossipSyncersResponse) GetMsgRateBytes() uint64 {
+	if m != nil {
+		return m.MsgRateBytes
+	}
+	return 0
+}
+
+func (m *ListGossipSyncersResponse) GetMsgBurstBytes() uint64 {
+	if m != nil {
+		return m.MsgBurstBytes
+	}
+	return 0
+}
+
+type GraphSyncStatusRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GraphSyncStatusRequest) Reset()         { *m = GraphSyncStatusRequest{} }
+func (m *GraphSyncStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GraphSyncStatusRequest) ProtoMessage()    {}
+func (*GraphSyncStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{65}
+}
+func (m *GraphSyncStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GraphSyncStatusRequest.Unmarshal(m, b)
+}
+func (m *GraphSyncStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GraphSyncStatusRequest.Marshal(b, m, deterministic)
+}
+func (dst *GraphSyncStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GraphSyncStatusRequest.Merge(dst, src)
+}
+func (m *GraphSyncStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_GraphSyncStatusRequest.Size(m)
+}
+func (m *GraphSyncStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GraphSyncStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GraphSyncStatusRequest proto.InternalMessageInfo
+
+type GraphSyncSubscription struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GraphSyncSubscription) Reset()         { *m = GraphSyncSubscription{} }
+func (m *GraphSyncSubscription) String() string { return proto.CompactTextString(m) }
+func (*GraphSyncSubscription) ProtoMessage()    {}
+func (*GraphSyncSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{66}
+}
+func (m *GraphSyncSubscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GraphSyncSubscription.Unmarshal(m, b)
+}
+func (m *GraphSyncSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GraphSyncSubscription.Marshal(b, m, deterministic)
+}
+func (dst *GraphSyncSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GraphSyncSubscription.Merge(dst, src)
+}
+func (m *GraphSyncSubscription) XXX_Size() int {
+	return xxx_messageInfo_GraphSyncSubscription.Size(m)
+}
+func (m *GraphSyncSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_GraphSyncSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GraphSyncSubscription proto.InternalMessageInfo
+
+type GraphSyncStatus struct {
+	// / Whether the initial historical sync of the graph has completed.
+	Synced bool `protobuf:"varint,1,opt,name=synced,proto3" json:"synced,omitempty"`
+	// *
+	// The unix timestamp in seconds at which the initial historical sync
+	// started. Zero if no peer has been synced with yet.
+	StartTime int64 `protobuf:"varint,2,opt,name=start_time,proto3" json:"start_time,omitempty"`
+	// *
+	// The unix timestamp in seconds at which the initial historical sync
+	// completed. Zero if the sync is still in progress.
+	EndTime int64 `protobuf:"varint,3,opt,name=end_time,proto3" json:"end_time,omitempty"`
+	// / The number of new channels received during the initial historical sync.
+	NumChannels uint32 `protobuf:"varint,4,opt,name=num_channels,proto3" json:"num_channels,omitempty"`
+	// / The number of node announcements received during the initial historical sync.
+	NumNodes             uint32   `protobuf:"varint,5,opt,name=num_nodes,proto3" json:"num_nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GraphSyncStatus) Reset()         { *m = GraphSyncStatus{} }
+func (m *GraphSyncStatus) String() string { return proto.CompactTextString(m) }
+func (*GraphSyncStatus) ProtoMessage()    {}
+func (*GraphSyncStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{67}
+}
+func (m *GraphSyncStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GraphSyncStatus.Unmarshal(m, b)
+}
+func (m *GraphSyncStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GraphSyncStatus.Marshal(b, m, deterministic)
+}
+func (dst *GraphSyncStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GraphSyncStatus.Merge(dst, src)
+}
+func (m *GraphSyncStatus) XXX_Size() int {
+	return xxx_messageInfo_GraphSyncStatus.Size(m)
+}
+func (m *GraphSyncStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_GraphSyncStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GraphSyncStatus proto.InternalMessageInfo
+
+func (m *GraphSyncStatus) GetSynced() bool {
+	if m != nil {
+		return m.Synced
+	}
+	return false
+}
+
+func (m *GraphSyncStatus) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *GraphSyncStatus) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *GraphSyncStatus) GetNumChannels() uint32 {
+	if m != nil {
+		return m.NumChannels
+	}
+	return 0
+}
+
+func (m *GraphSyncStatus) GetNumNodes() uint32 {
+	if m != nil {
+		return m.NumNodes
+	}
+	return 0
+}
+
+type GetInfoRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetInfoRequest) Reset()         { *m = GetInfoRequest{} }
+func (m *GetInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetInfoRequest) ProtoMessage()    {}
+func (*GetInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{68}
+}
+func (m *GetInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetInfoRequest.Unmarshal(m, b)
+}
+func (m *GetInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetInfoRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetInfoRequest.Merge(dst, src)
+}
+func (m *GetInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_GetInfoRequest.Size(m)
+}
+func (m *GetInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetInfoRequest proto.InternalMessageInfo
+
+type GetInfoResponse struct {
 	// / The identity pubkey of the current node.
 	IdentityPubkey string `protobuf:"bytes,1,opt,name=identity_pubkey,proto3" json:"identity_pubkey,omitempty"`
 	// / If applicable, the alias of the current node, e.g. "bob"
@@ -3478,17 +5522,19 @@ type GetInfoResponse struct {
 	// / The color of the current node in hex code format
 	Color string `protobuf:"bytes,17,opt,name=color,proto3" json:"color,omitempty"`
 	// Whether we consider ourselves synced with the public channel graph.
-	SyncedToGraph        bool     `protobuf:"varint,18,opt,name=synced_to_graph,proto3" json:"synced_to_graph,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	SyncedToGraph bool `protobuf:"varint,18,opt,name=synced_to_graph,proto3" json:"synced_to_graph,omitempty"`
+	// / The addresses the services of the node listen on.
+	Listeners            []*ServiceListener `protobuf:"bytes,19,rep,name=listeners,proto3" json:"listeners,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *GetInfoResponse) Reset()         { *m = GetInfoResponse{} }
 func (m *GetInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetInfoResponse) ProtoMessage()    {}
 func (*GetInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{50}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{69}
 }
 func (m *GetInfoResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetInfoResponse.Unmarshal(m, b)
@@ -3621,6 +5667,74 @@ func (m *GetInfoResponse) GetSyncedToGraph() bool {
 	return false
 }
 
+func (m *GetInfoResponse) GetListeners() []*ServiceListener {
+	if m != nil {
+		return m.Listeners
+	}
+	return nil
+}
+
+type ServiceListener struct {
+	// *
+	// The service accepting connections on the address, one of p2p, rpc, rest,
+	// watchtower or profiling.
+	Service string `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	// / The resolved address the service listens on.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// *
+	// Whether the connections accepted on the address are expected to start
+	// with a PROXY protocol header.
+	ProxyProtocol        bool     `protobuf:"varint,3,opt,name=proxy_protocol,proto3" json:"proxy_protocol,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServiceListener) Reset()         { *m = ServiceListener{} }
+func (m *ServiceListener) String() string { return proto.CompactTextString(m) }
+func (*ServiceListener) ProtoMessage()    {}
+func (*ServiceListener) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{70}
+}
+func (m *ServiceListener) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceListener.Unmarshal(m, b)
+}
+func (m *ServiceListener) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceListener.Marshal(b, m, deterministic)
+}
+func (dst *ServiceListener) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceListener.Merge(dst, src)
+}
+func (m *ServiceListener) XXX_Size() int {
+	return xxx_messageInfo_ServiceListener.Size(m)
+}
+func (m *ServiceListener) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceListener.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceListener proto.InternalMessageInfo
+
+func (m *ServiceListener) GetService() string {
+	if m != nil {
+		return m.Service
+	}
+	return ""
+}
+
+func (m *ServiceListener) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ServiceListener) GetProxyProtocol() bool {
+	if m != nil {
+		return m.ProxyProtocol
+	}
+	return false
+}
+
 type Chain struct {
 	// / The blockchain the node is on (eg decred)
 	Chain string `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
@@ -3635,7 +5749,7 @@ func (m *Chain) Reset()         { *m = Chain{} }
 func (m *Chain) String() string { return proto.CompactTextString(m) }
 func (*Chain) ProtoMessage()    {}
 func (*Chain) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{51}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{71}
 }
 func (m *Chain) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Chain.Unmarshal(m, b)
@@ -3682,7 +5796,7 @@ func (m *ConfirmationUpdate) Reset()         { *m = ConfirmationUpdate{} }
 func (m *ConfirmationUpdate) String() string { return proto.CompactTextString(m) }
 func (*ConfirmationUpdate) ProtoMessage()    {}
 func (*ConfirmationUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{52}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{72}
 }
 func (m *ConfirmationUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfirmationUpdate.Unmarshal(m, b)
@@ -3734,7 +5848,7 @@ func (m *ChannelOpenUpdate) Reset()         { *m = ChannelOpenUpdate{} }
 func (m *ChannelOpenUpdate) String() string { return proto.CompactTextString(m) }
 func (*ChannelOpenUpdate) ProtoMessage()    {}
 func (*ChannelOpenUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{53}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{73}
 }
 func (m *ChannelOpenUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelOpenUpdate.Unmarshal(m, b)
@@ -3773,7 +5887,7 @@ func (m *ChannelCloseUpdate) Reset()         { *m = ChannelCloseUpdate{} }
 func (m *ChannelCloseUpdate) String() string { return proto.CompactTextString(m) }
 func (*ChannelCloseUpdate) ProtoMessage()    {}
 func (*ChannelCloseUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{54}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{74}
 }
 func (m *ChannelCloseUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelCloseUpdate.Unmarshal(m, b)
@@ -3815,10 +5929,27 @@ type CloseChannelRequest struct {
 	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
 	// / If true, then the channel will be closed forcibly. This means the current commitment transaction will be signed and broadcast.
 	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
-	// / The target number of blocks that the closure transaction should be confirmed by.
+	// *
+	// The target number of blocks that the closure transaction should be
+	// confirmed by. When force closing a channel with anchors, it's the target of
+	// the anchor sweep instead.
 	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,json=targetConf,proto3" json:"target_conf,omitempty"`
-	// / A manual fee rate set in atom/byte that should be used when crafting the closure transaction.
-	AtomsPerByte         int64    `protobuf:"varint,4,opt,name=atoms_per_byte,json=atomsPerByte,proto3" json:"atoms_per_byte,omitempty"`
+	// *
+	// A manual fee rate set in atom/byte that should be used when crafting the
+	// closure transaction. When force closing a channel with anchors, it's the
+	// fee rate of the anchor sweep instead.
+	AtomsPerByte int64 `protobuf:"varint,4,opt,name=atoms_per_byte,json=atomsPerByte,proto3" json:"atoms_per_byte,omitempty"`
+	// *
+	// An optional address to send the funds to in the case of a cooperative
+	// close. If not set, a fresh address from the wallet will be used.
+	DeliveryAddress string `protobuf:"bytes,5,opt,name=delivery_address,json=deliveryAddress,proto3" json:"delivery_address,omitempty"`
+	// *
+	// An optional maximum fee rate set in atom/byte that we're willing to pay
+	// for the closure transaction during fee negotiation. This value is only
+	// used if we're the initiator of the channel. When force closing a channel
+	// with anchors, it caps the fee rate of the anchor sweep instead. If not
+	// set, the fee isn't capped.
+	MaxAtomsPerByte      int64    `protobuf:"varint,6,opt,name=max_atoms_per_byte,json=maxAtomsPerByte,proto3" json:"max_atoms_per_byte,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3828,7 +5959,7 @@ func (m *CloseChannelRequest) Reset()         { *m = CloseChannelRequest{} }
 func (m *CloseChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*CloseChannelRequest) ProtoMessage()    {}
 func (*CloseChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{55}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{75}
 }
 func (m *CloseChannelRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CloseChannelRequest.Unmarshal(m, b)
@@ -3876,8 +6007,22 @@ func (m *CloseChannelRequest) GetAtomsPerByte() int64 {
 	return 0
 }
 
-type CloseStatusUpdate struct {
-	// Types that are valid to be assigned to Update:
+func (m *CloseChannelRequest) GetDeliveryAddress() string {
+	if m != nil {
+		return m.DeliveryAddress
+	}
+	return ""
+}
+
+func (m *CloseChannelRequest) GetMaxAtomsPerByte() int64 {
+	if m != nil {
+		return m.MaxAtomsPerByte
+	}
+	return 0
+}
+
+type CloseStatusUpdate struct {
+	// Types that are valid to be assigned to Update:
 	//	*CloseStatusUpdate_ClosePending
 	//	*CloseStatusUpdate_ChanClose
 	Update               isCloseStatusUpdate_Update `protobuf_oneof:"update"`
@@ -3890,7 +6035,7 @@ func (m *CloseStatusUpdate) Reset()         { *m = CloseStatusUpdate{} }
 func (m *CloseStatusUpdate) String() string { return proto.CompactTextString(m) }
 func (*CloseStatusUpdate) ProtoMessage()    {}
 func (*CloseStatusUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{56}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{76}
 }
 func (m *CloseStatusUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CloseStatusUpdate.Unmarshal(m, b)
@@ -4033,7 +6178,7 @@ func (m *PendingUpdate) Reset()         { *m = PendingUpdate{} }
 func (m *PendingUpdate) String() string { return proto.CompactTextString(m) }
 func (*PendingUpdate) ProtoMessage()    {}
 func (*PendingUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{57}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{77}
 }
 func (m *PendingUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PendingUpdate.Unmarshal(m, b)
@@ -4089,7 +6234,23 @@ type OpenChannelRequest struct {
 	// / The minimum number of confirmations each one of your outputs used for the funding transaction must satisfy.
 	MinConfs int32 `protobuf:"varint,11,opt,name=min_confs,proto3" json:"min_confs,omitempty"`
 	// / Whether unconfirmed outputs should be used as inputs for the funding transaction.
-	SpendUnconfirmed     bool     `protobuf:"varint,12,opt,name=spend_unconfirmed,proto3" json:"spend_unconfirmed,omitempty"`
+	SpendUnconfirmed bool `protobuf:"varint,12,opt,name=spend_unconfirmed,proto3" json:"spend_unconfirmed,omitempty"`
+	// *
+	// Close address is an optional address which specifies the address to which
+	// funds should be paid out to upon cooperative close. This field may only be
+	// set if the peer supports the option upfront feature bit (call listpeers
+	// to check). The remote peer will only accept cooperative closes to this
+	// address if it is set.
+	//
+	// Note: If this value is set on channel creation, you will *not* be able to
+	// cooperatively close out to a different address.
+	CloseAddress string `protobuf:"bytes,13,opt,name=close_address,proto3" json:"close_address,omitempty"`
+	// *
+	// Whether the channel should be usable before its funding transaction
+	// confirms. The peer must support zero-conf channels and trust us to open
+	// them, as it could otherwise lose funds by accepting payments over the
+	// channel if the funding transaction is double spent.
+	ZeroConf             bool     `protobuf:"varint,14,opt,name=zero_conf,proto3" json:"zero_conf,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -4099,7 +6260,7 @@ func (m *OpenChannelRequest) Reset()         { *m = OpenChannelRequest{} }
 func (m *OpenChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*OpenChannelRequest) ProtoMessage()    {}
 func (*OpenChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{58}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{78}
 }
 func (m *OpenChannelRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OpenChannelRequest.Unmarshal(m, b)
@@ -4196,6 +6357,20 @@ func (m *OpenChannelRequest) GetSpendUnconfirmed() bool {
 	return false
 }
 
+func (m *OpenChannelRequest) GetCloseAddress() string {
+	if m != nil {
+		return m.CloseAddress
+	}
+	return ""
+}
+
+func (m *OpenChannelRequest) GetZeroConf() bool {
+	if m != nil {
+		return m.ZeroConf
+	}
+	return false
+}
+
 type OpenStatusUpdate struct {
 	// Types that are valid to be assigned to Update:
 	//	*OpenStatusUpdate_ChanPending
@@ -4210,7 +6385,7 @@ func (m *OpenStatusUpdate) Reset()         { *m = OpenStatusUpdate{} }
 func (m *OpenStatusUpdate) String() string { return proto.CompactTextString(m) }
 func (*OpenStatusUpdate) ProtoMessage()    {}
 func (*OpenStatusUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{59}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{79}
 }
 func (m *OpenStatusUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OpenStatusUpdate.Unmarshal(m, b)
@@ -4366,7 +6541,7 @@ func (m *PendingHTLC) Reset()         { *m = PendingHTLC{} }
 func (m *PendingHTLC) String() string { return proto.CompactTextString(m) }
 func (*PendingHTLC) ProtoMessage()    {}
 func (*PendingHTLC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{60}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{80}
 }
 func (m *PendingHTLC) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PendingHTLC.Unmarshal(m, b)
@@ -4438,7 +6613,7 @@ func (m *PendingChannelsRequest) Reset()         { *m = PendingChannelsRequest{}
 func (m *PendingChannelsRequest) String() string { return proto.CompactTextString(m) }
 func (*PendingChannelsRequest) ProtoMessage()    {}
 func (*PendingChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{61}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{81}
 }
 func (m *PendingChannelsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PendingChannelsRequest.Unmarshal(m, b)
@@ -4478,7 +6653,7 @@ func (m *PendingChannelsResponse) Reset()         { *m = PendingChannelsResponse
 func (m *PendingChannelsResponse) String() string { return proto.CompactTextString(m) }
 func (*PendingChannelsResponse) ProtoMessage()    {}
 func (*PendingChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{62}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{82}
 }
 func (m *PendingChannelsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PendingChannelsResponse.Unmarshal(m, b)
@@ -4556,7 +6731,7 @@ func (m *PendingChannelsResponse_PendingChannel) Reset() {
 func (m *PendingChannelsResponse_PendingChannel) String() string { return proto.CompactTextString(m) }
 func (*PendingChannelsResponse_PendingChannel) ProtoMessage()    {}
 func (*PendingChannelsResponse_PendingChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{62, 0}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{82, 0}
 }
 func (m *PendingChannelsResponse_PendingChannel) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PendingChannelsResponse_PendingChannel.Unmarshal(m, b)
@@ -4657,7 +6832,7 @@ func (m *PendingChannelsResponse_PendingOpenChannel) String() string {
 }
 func (*PendingChannelsResponse_PendingOpenChannel) ProtoMessage() {}
 func (*PendingChannelsResponse_PendingOpenChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{62, 1}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{82, 1}
 }
 func (m *PendingChannelsResponse_PendingOpenChannel) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.Unmarshal(m, b)
@@ -4716,10 +6891,12 @@ type PendingChannelsResponse_WaitingCloseChannel struct {
 	// / The pending channel waiting for closing tx to confirm
 	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
 	// / The balance in atoms encumbered in this channel
-	LimboBalance         int64    `protobuf:"varint,2,opt,name=limbo_balance,proto3" json:"limbo_balance,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	LimboBalance int64 `protobuf:"varint,2,opt,name=limbo_balance,proto3" json:"limbo_balance,omitempty"`
+	// / The party that initiated the close of the channel.
+	CloseInitiator       Initiator `protobuf:"varint,3,opt,name=close_initiator,proto3,enum=lnrpc.Initiator" json:"close_initiator,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
 func (m *PendingChannelsResponse_WaitingCloseChannel) Reset() {
@@ -4730,7 +6907,7 @@ func (m *PendingChannelsResponse_WaitingCloseChannel) String() string {
 }
 func (*PendingChannelsResponse_WaitingCloseChannel) ProtoMessage() {}
 func (*PendingChannelsResponse_WaitingCloseChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{62, 2}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{82, 2}
 }
 func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.Unmarshal(m, b)
@@ -4764,21 +6941,32 @@ func (m *PendingChannelsResponse_WaitingCloseChannel) GetLimboBalance() int64 {
 	return 0
 }
 
+func (m *PendingChannelsResponse_WaitingCloseChannel) GetCloseInitiator() Initiator {
+	if m != nil {
+		return m.CloseInitiator
+	}
+	return Initiator_INITIATOR_UNKNOWN
+}
+
 type PendingChannelsResponse_ClosedChannel struct {
 	// / The pending channel to be closed
 	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
 	// / The transaction id of the closing transaction
-	ClosingTxid          string   `protobuf:"bytes,2,opt,name=closing_txid,proto3" json:"closing_txid,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ClosingTxid string `protobuf:"bytes,2,opt,name=closing_txid,proto3" json:"closing_txid,omitempty"`
+	// / The party that initiated the close of the channel.
+	CloseInitiator Initiator `protobuf:"varint,3,opt,name=close_initiator,proto3,enum=lnrpc.Initiator" json:"close_initiator,omitempty"`
+	// / The outputs of the closing transaction that belong to us.
+	Resolutions          []*Resolution `protobuf:"bytes,4,rep,name=resolutions,proto3" json:"resolutions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *PendingChannelsResponse_ClosedChannel) Reset()         { *m = PendingChannelsResponse_ClosedChannel{} }
 func (m *PendingChannelsResponse_ClosedChannel) String() string { return proto.CompactTextString(m) }
 func (*PendingChannelsResponse_ClosedChannel) ProtoMessage()    {}
 func (*PendingChannelsResponse_ClosedChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{62, 3}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{82, 3}
 }
 func (m *PendingChannelsResponse_ClosedChannel) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PendingChannelsResponse_ClosedChannel.Unmarshal(m, b)
@@ -4812,6 +7000,20 @@ func (m *PendingChannelsResponse_ClosedChannel) GetClosingTxid() string {
 	return ""
 }
 
+func (m *PendingChannelsResponse_ClosedChannel) GetCloseInitiator() Initiator {
+	if m != nil {
+		return m.CloseInitiator
+	}
+	return Initiator_INITIATOR_UNKNOWN
+}
+
+func (m *PendingChannelsResponse_ClosedChannel) GetResolutions() []*Resolution {
+	if m != nil {
+		return m.Resolutions
+	}
+	return nil
+}
+
 type PendingChannelsResponse_ForceClosedChannel struct {
 	// / The pending channel to be force closed
 	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
@@ -4827,11 +7029,27 @@ type PendingChannelsResponse_ForceClosedChannel struct {
 	// mature.
 	BlocksTilMaturity int32 `protobuf:"varint,5,opt,name=blocks_til_maturity,proto3" json:"blocks_til_maturity,omitempty"`
 	// / The total value of funds successfully recovered from this channel
-	RecoveredBalance     int64          `protobuf:"varint,6,opt,name=recovered_balance,proto3" json:"recovered_balance,omitempty"`
-	PendingHtlcs         []*PendingHTLC `protobuf:"bytes,8,rep,name=pending_htlcs,proto3" json:"pending_htlcs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	RecoveredBalance int64          `protobuf:"varint,6,opt,name=recovered_balance,proto3" json:"recovered_balance,omitempty"`
+	PendingHtlcs     []*PendingHTLC `protobuf:"bytes,8,rep,name=pending_htlcs,proto3" json:"pending_htlcs,omitempty"`
+	// *
+	// The outputs of the channel whose funds are still in limbo, including
+	// the commitment output, with the details of their time locks.
+	LimboOutputs []*LimboOutput `protobuf:"bytes,9,rep,name=limbo_outputs,proto3" json:"limbo_outputs,omitempty"`
+	// / Details on how the channel was closed.
+	CloseType ChannelCloseSummary_ClosureType `protobuf:"varint,10,opt,name=close_type,proto3,enum=lnrpc.ChannelCloseSummary_ClosureType" json:"close_type,omitempty"`
+	// / The party that initiated the close of the channel.
+	CloseInitiator Initiator `protobuf:"varint,11,opt,name=close_initiator,proto3,enum=lnrpc.Initiator" json:"close_initiator,omitempty"`
+	// *
+	// The balance in atoms settled to us at the time of the close, excluding
+	// the time-locked outputs.
+	SettledBalance int64 `protobuf:"varint,12,opt,name=settled_balance,proto3" json:"settled_balance,omitempty"`
+	// / The sum in atoms of our time-locked outputs at the time of the close.
+	TimeLockedBalance int64 `protobuf:"varint,13,opt,name=time_locked_balance,proto3" json:"time_locked_balance,omitempty"`
+	// / The outputs of the closing transaction that belong to us.
+	Resolutions          []*Resolution `protobuf:"bytes,14,rep,name=resolutions,proto3" json:"resolutions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *PendingChannelsResponse_ForceClosedChannel) Reset() {
@@ -4842,7 +7060,7 @@ func (m *PendingChannelsResponse_ForceClosedChannel) String() string {
 }
 func (*PendingChannelsResponse_ForceClosedChannel) ProtoMessage() {}
 func (*PendingChannelsResponse_ForceClosedChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{62, 4}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{82, 4}
 }
 func (m *PendingChannelsResponse_ForceClosedChannel) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.Unmarshal(m, b)
@@ -4911,6 +7129,197 @@ func (m *PendingChannelsResponse_ForceClosedChannel) GetPendingHtlcs() []*Pendin
 	return nil
 }
 
+func (m *PendingChannelsResponse_ForceClosedChannel) GetLimboOutputs() []*LimboOutput {
+	if m != nil {
+		return m.LimboOutputs
+	}
+	return nil
+}
+
+func (m *PendingChannelsResponse_ForceClosedChannel) GetCloseType() ChannelCloseSummary_ClosureType {
+	if m != nil {
+		return m.CloseType
+	}
+	return ChannelCloseSummary_COOPERATIVE_CLOSE
+}
+
+func (m *PendingChannelsResponse_ForceClosedChannel) GetCloseInitiator() Initiator {
+	if m != nil {
+		return m.CloseInitiator
+	}
+	return Initiator_INITIATOR_UNKNOWN
+}
+
+func (m *PendingChannelsResponse_ForceClosedChannel) GetSettledBalance() int64 {
+	if m != nil {
+		return m.SettledBalance
+	}
+	return 0
+}
+
+func (m *PendingChannelsResponse_ForceClosedChannel) GetTimeLockedBalance() int64 {
+	if m != nil {
+		return m.TimeLockedBalance
+	}
+	return 0
+}
+
+func (m *PendingChannelsResponse_ForceClosedChannel) GetResolutions() []*Resolution {
+	if m != nil {
+		return m.Resolutions
+	}
+	return nil
+}
+
+type PendingChannelLimitsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingChannelLimitsRequest) Reset()         { *m = PendingChannelLimitsRequest{} }
+func (m *PendingChannelLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*PendingChannelLimitsRequest) ProtoMessage()    {}
+func (*PendingChannelLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{83}
+}
+func (m *PendingChannelLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingChannelLimitsRequest.Unmarshal(m, b)
+}
+func (m *PendingChannelLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingChannelLimitsRequest.Marshal(b, m, deterministic)
+}
+func (dst *PendingChannelLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChannelLimitsRequest.Merge(dst, src)
+}
+func (m *PendingChannelLimitsRequest) XXX_Size() int {
+	return xxx_messageInfo_PendingChannelLimitsRequest.Size(m)
+}
+func (m *PendingChannelLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChannelLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingChannelLimitsRequest proto.InternalMessageInfo
+
+type PendingChannelLimitsResponse struct {
+	// / The maximum number of pending channels permitted per peer.
+	MaxPendingChannels uint32 `protobuf:"varint,1,opt,name=max_pending_channels,proto3" json:"max_pending_channels,omitempty"`
+	// *
+	// The maximum number of pending channels permitted across all peers. Zero if
+	// no global limit is enforced.
+	MaxGlobalPendingChannels uint32 `protobuf:"varint,2,opt,name=max_global_pending_channels,proto3" json:"max_global_pending_channels,omitempty"`
+	// / The number of pending channels across all peers.
+	NumPendingChannels uint32 `protobuf:"varint,3,opt,name=num_pending_channels,proto3" json:"num_pending_channels,omitempty"`
+	// / The number of pending channels of each peer with any pending channel.
+	Peers                []*PeerPendingChannels `protobuf:"bytes,4,rep,name=peers,proto3" json:"peers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *PendingChannelLimitsResponse) Reset()         { *m = PendingChannelLimitsResponse{} }
+func (m *PendingChannelLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*PendingChannelLimitsResponse) ProtoMessage()    {}
+func (*PendingChannelLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{84}
+}
+func (m *PendingChannelLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingChannelLimitsResponse.Unmarshal(m, b)
+}
+func (m *PendingChannelLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingChannelLimitsResponse.Marshal(b, m, deterministic)
+}
+func (dst *PendingChannelLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChannelLimitsResponse.Merge(dst, src)
+}
+func (m *PendingChannelLimitsResponse) XXX_Size() int {
+	return xxx_messageInfo_PendingChannelLimitsResponse.Size(m)
+}
+func (m *PendingChannelLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChannelLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingChannelLimitsResponse proto.InternalMessageInfo
+
+func (m *PendingChannelLimitsResponse) GetMaxPendingChannels() uint32 {
+	if m != nil {
+		return m.MaxPendingChannels
+	}
+	return 0
+}
+
+func (m *PendingChannelLimitsResponse) GetMaxGlobalPendingChannels() uint32 {
+	if m != nil {
+		return m.MaxGlobalPendingChannels
+	}
+	return 0
+}
+
+func (m *PendingChannelLimitsResponse) GetNumPendingChannels() uint32 {
+	if m != nil {
+		return m.NumPendingChannels
+	}
+	return 0
+}
+
+func (m *PendingChannelLimitsResponse) GetPeers() []*PeerPendingChannels {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+type PeerPendingChannels struct {
+	// / The identity pubkey of the peer.
+	PubKey string `protobuf:"bytes,1,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
+	// *
+	// The number of pending channels with the peer, which includes the channels
+	// still in the funding workflow, as well as those waiting for the funding
+	// transaction to confirm.
+	NumPendingChannels   uint32   `protobuf:"varint,2,opt,name=num_pending_channels,proto3" json:"num_pending_channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PeerPendingChannels) Reset()         { *m = PeerPendingChannels{} }
+func (m *PeerPendingChannels) String() string { return proto.CompactTextString(m) }
+func (*PeerPendingChannels) ProtoMessage()    {}
+func (*PeerPendingChannels) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{85}
+}
+func (m *PeerPendingChannels) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerPendingChannels.Unmarshal(m, b)
+}
+func (m *PeerPendingChannels) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerPendingChannels.Marshal(b, m, deterministic)
+}
+func (dst *PeerPendingChannels) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerPendingChannels.Merge(dst, src)
+}
+func (m *PeerPendingChannels) XXX_Size() int {
+	return xxx_messageInfo_PeerPendingChannels.Size(m)
+}
+func (m *PeerPendingChannels) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerPendingChannels.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerPendingChannels proto.InternalMessageInfo
+
+func (m *PeerPendingChannels) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *PeerPendingChannels) GetNumPendingChannels() uint32 {
+	if m != nil {
+		return m.NumPendingChannels
+	}
+	return 0
+}
+
 type ChannelEventSubscription struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -4921,7 +7330,7 @@ func (m *ChannelEventSubscription) Reset()         { *m = ChannelEventSubscripti
 func (m *ChannelEventSubscription) String() string { return proto.CompactTextString(m) }
 func (*ChannelEventSubscription) ProtoMessage()    {}
 func (*ChannelEventSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{63}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{86}
 }
 func (m *ChannelEventSubscription) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelEventSubscription.Unmarshal(m, b)
@@ -4947,6 +7356,7 @@ type ChannelEventUpdate struct {
 	//	*ChannelEventUpdate_ClosedChannel
 	//	*ChannelEventUpdate_ActiveChannel
 	//	*ChannelEventUpdate_InactiveChannel
+	//	*ChannelEventUpdate_RemoteCommitment
 	Channel              isChannelEventUpdate_Channel  `protobuf_oneof:"channel"`
 	Type                 ChannelEventUpdate_UpdateType `protobuf:"varint,5,opt,name=type,proto3,enum=lnrpc.ChannelEventUpdate_UpdateType" json:"type,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
@@ -4958,7 +7368,7 @@ func (m *ChannelEventUpdate) Reset()         { *m = ChannelEventUpdate{} }
 func (m *ChannelEventUpdate) String() string { return proto.CompactTextString(m) }
 func (*ChannelEventUpdate) ProtoMessage()    {}
 func (*ChannelEventUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{64}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{87}
 }
 func (m *ChannelEventUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelEventUpdate.Unmarshal(m, b)
@@ -4998,6 +7408,10 @@ type ChannelEventUpdate_InactiveChannel struct {
 	InactiveChannel *ChannelPoint `protobuf:"bytes,4,opt,name=inactive_channel,proto3,oneof"`
 }
 
+type ChannelEventUpdate_RemoteCommitment struct {
+	RemoteCommitment *RemoteCommitmentUpdate `protobuf:"bytes,6,opt,name=remote_commitment,proto3,oneof"`
+}
+
 func (*ChannelEventUpdate_OpenChannel) isChannelEventUpdate_Channel() {}
 
 func (*ChannelEventUpdate_ClosedChannel) isChannelEventUpdate_Channel() {}
@@ -5006,6 +7420,8 @@ func (*ChannelEventUpdate_ActiveChannel) isChannelEventUpdate_Channel() {}
 
 func (*ChannelEventUpdate_InactiveChannel) isChannelEventUpdate_Channel() {}
 
+func (*ChannelEventUpdate_RemoteCommitment) isChannelEventUpdate_Channel() {}
+
 func (m *ChannelEventUpdate) GetChannel() isChannelEventUpdate_Channel {
 	if m != nil {
 		return m.Channel
@@ -5041,6 +7457,13 @@ func (m *ChannelEventUpdate) GetInactiveChannel() *ChannelPoint {
 	return nil
 }
 
+func (m *ChannelEventUpdate) GetRemoteCommitment() *RemoteCommitmentUpdate {
+	if x, ok := m.GetChannel().(*ChannelEventUpdate_RemoteCommitment); ok {
+		return x.RemoteCommitment
+	}
+	return nil
+}
+
 func (m *ChannelEventUpdate) GetType() ChannelEventUpdate_UpdateType {
 	if m != nil {
 		return m.Type
@@ -5055,6 +7478,7 @@ func (*ChannelEventUpdate) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Bu
 		(*ChannelEventUpdate_ClosedChannel)(nil),
 		(*ChannelEventUpdate_ActiveChannel)(nil),
 		(*ChannelEventUpdate_InactiveChannel)(nil),
+		(*ChannelEventUpdate_RemoteCommitment)(nil),
 	}
 }
 
@@ -5082,6 +7506,11 @@ func _ChannelEventUpdate_OneofMarshaler(msg proto.Message, b *proto.Buffer) erro
 		if err := b.EncodeMessage(x.InactiveChannel); err != nil {
 			return err
 		}
+	case *ChannelEventUpdate_RemoteCommitment:
+		b.EncodeVarint(6<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.RemoteCommitment); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("ChannelEventUpdate.Channel has unexpected type %T", x)
@@ -5124,6 +7553,14 @@ func _ChannelEventUpdate_OneofUnmarshaler(msg proto.Message, tag, wire int, b *p
 		err := b.DecodeMessage(msg)
 		m.Channel = &ChannelEventUpdate_InactiveChannel{msg}
 		return true, err
+	case 6: // channel.remote_commitment
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(RemoteCommitmentUpdate)
+		err := b.DecodeMessage(msg)
+		m.Channel = &ChannelEventUpdate_RemoteCommitment{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -5153,6 +7590,11 @@ func _ChannelEventUpdate_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *ChannelEventUpdate_RemoteCommitment:
+		s := proto.Size(x.RemoteCommitment)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -5160,6 +7602,81 @@ func _ChannelEventUpdate_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
+type RemoteCommitmentUpdate struct {
+	// / The channel the commitment closes.
+	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
+	// / The txid of the commitment transaction.
+	CommitTxid string `protobuf:"bytes,2,opt,name=commit_txid,proto3" json:"commit_txid,omitempty"`
+	// / The commitment height encoded in the commitment transaction.
+	StateNum uint64 `protobuf:"varint,3,opt,name=state_num,proto3" json:"state_num,omitempty"`
+	// / Which of the remote party's commitments was broadcast.
+	CommitmentType RemoteCommitmentUpdate_CommitmentType `protobuf:"varint,4,opt,name=commitment_type,proto3,enum=lnrpc.RemoteCommitmentUpdate_CommitmentType" json:"commitment_type,omitempty"`
+	// / Whether the commitment was detected in a block rather than in the mempool.
+	Confirmed            bool     `protobuf:"varint,5,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoteCommitmentUpdate) Reset()         { *m = RemoteCommitmentUpdate{} }
+func (m *RemoteCommitmentUpdate) String() string { return proto.CompactTextString(m) }
+func (*RemoteCommitmentUpdate) ProtoMessage()    {}
+func (*RemoteCommitmentUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{88}
+}
+func (m *RemoteCommitmentUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoteCommitmentUpdate.Unmarshal(m, b)
+}
+func (m *RemoteCommitmentUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoteCommitmentUpdate.Marshal(b, m, deterministic)
+}
+func (dst *RemoteCommitmentUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteCommitmentUpdate.Merge(dst, src)
+}
+func (m *RemoteCommitmentUpdate) XXX_Size() int {
+	return xxx_messageInfo_RemoteCommitmentUpdate.Size(m)
+}
+func (m *RemoteCommitmentUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteCommitmentUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteCommitmentUpdate proto.InternalMessageInfo
+
+func (m *RemoteCommitmentUpdate) GetChannelPoint() *ChannelPoint {
+	if m != nil {
+		return m.ChannelPoint
+	}
+	return nil
+}
+
+func (m *RemoteCommitmentUpdate) GetCommitTxid() string {
+	if m != nil {
+		return m.CommitTxid
+	}
+	return ""
+}
+
+func (m *RemoteCommitmentUpdate) GetStateNum() uint64 {
+	if m != nil {
+		return m.StateNum
+	}
+	return 0
+}
+
+func (m *RemoteCommitmentUpdate) GetCommitmentType() RemoteCommitmentUpdate_CommitmentType {
+	if m != nil {
+		return m.CommitmentType
+	}
+	return RemoteCommitmentUpdate_LATEST
+}
+
+func (m *RemoteCommitmentUpdate) GetConfirmed() bool {
+	if m != nil {
+		return m.Confirmed
+	}
+	return false
+}
+
 type WalletBalanceRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -5170,7 +7687,7 @@ func (m *WalletBalanceRequest) Reset()         { *m = WalletBalanceRequest{} }
 func (m *WalletBalanceRequest) String() string { return proto.CompactTextString(m) }
 func (*WalletBalanceRequest) ProtoMessage()    {}
 func (*WalletBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{65}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{89}
 }
 func (m *WalletBalanceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_WalletBalanceRequest.Unmarshal(m, b)
@@ -5206,7 +7723,7 @@ func (m *WalletBalanceResponse) Reset()         { *m = WalletBalanceResponse{} }
 func (m *WalletBalanceResponse) String() string { return proto.CompactTextString(m) }
 func (*WalletBalanceResponse) ProtoMessage()    {}
 func (*WalletBalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{66}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{90}
 }
 func (m *WalletBalanceResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_WalletBalanceResponse.Unmarshal(m, b)
@@ -5257,7 +7774,7 @@ func (m *ChannelBalanceRequest) Reset()         { *m = ChannelBalanceRequest{} }
 func (m *ChannelBalanceRequest) String() string { return proto.CompactTextString(m) }
 func (*ChannelBalanceRequest) ProtoMessage()    {}
 func (*ChannelBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{67}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{91}
 }
 func (m *ChannelBalanceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelBalanceRequest.Unmarshal(m, b)
@@ -5299,7 +7816,7 @@ func (m *ChannelBalanceResponse) Reset()         { *m = ChannelBalanceResponse{}
 func (m *ChannelBalanceResponse) String() string { return proto.CompactTextString(m) }
 func (*ChannelBalanceResponse) ProtoMessage()    {}
 func (*ChannelBalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{68}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{92}
 }
 func (m *ChannelBalanceResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelBalanceResponse.Unmarshal(m, b)
@@ -5391,7 +7908,7 @@ func (m *QueryRoutesRequest) Reset()         { *m = QueryRoutesRequest{} }
 func (m *QueryRoutesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRoutesRequest) ProtoMessage()    {}
 func (*QueryRoutesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{69}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{93}
 }
 func (m *QueryRoutesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryRoutesRequest.Unmarshal(m, b)
@@ -5496,7 +8013,7 @@ func (m *NodePair) Reset()         { *m = NodePair{} }
 func (m *NodePair) String() string { return proto.CompactTextString(m) }
 func (*NodePair) ProtoMessage()    {}
 func (*NodePair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{70}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{94}
 }
 func (m *NodePair) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodePair.Unmarshal(m, b)
@@ -5548,7 +8065,7 @@ func (m *EdgeLocator) Reset()         { *m = EdgeLocator{} }
 func (m *EdgeLocator) String() string { return proto.CompactTextString(m) }
 func (*EdgeLocator) ProtoMessage()    {}
 func (*EdgeLocator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{71}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{95}
 }
 func (m *EdgeLocator) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EdgeLocator.Unmarshal(m, b)
@@ -5600,7 +8117,7 @@ func (m *QueryRoutesResponse) Reset()         { *m = QueryRoutesResponse{} }
 func (m *QueryRoutesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRoutesResponse) ProtoMessage()    {}
 func (*QueryRoutesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{72}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{96}
 }
 func (m *QueryRoutesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryRoutesResponse.Unmarshal(m, b)
@@ -5663,7 +8180,7 @@ func (m *Hop) Reset()         { *m = Hop{} }
 func (m *Hop) String() string { return proto.CompactTextString(m) }
 func (*Hop) ProtoMessage()    {}
 func (*Hop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{73}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{97}
 }
 func (m *Hop) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Hop.Unmarshal(m, b)
@@ -5791,7 +8308,7 @@ func (m *Route) Reset()         { *m = Route{} }
 func (m *Route) String() string { return proto.CompactTextString(m) }
 func (*Route) ProtoMessage()    {}
 func (*Route) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{74}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{98}
 }
 func (m *Route) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Route.Unmarshal(m, b)
@@ -5869,7 +8386,7 @@ func (m *NodeInfoRequest) Reset()         { *m = NodeInfoRequest{} }
 func (m *NodeInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*NodeInfoRequest) ProtoMessage()    {}
 func (*NodeInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{75}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{99}
 }
 func (m *NodeInfoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeInfoRequest.Unmarshal(m, b)
@@ -5925,7 +8442,7 @@ func (m *NodeInfo) Reset()         { *m = NodeInfo{} }
 func (m *NodeInfo) String() string { return proto.CompactTextString(m) }
 func (*NodeInfo) ProtoMessage()    {}
 func (*NodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{76}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{100}
 }
 func (m *NodeInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeInfo.Unmarshal(m, b)
@@ -5979,21 +8496,26 @@ func (m *NodeInfo) GetChannels() []*ChannelEdge {
 // graph is directed, a node will also have an incoming edge attached to it for
 // each outgoing edge.
 type LightningNode struct {
-	LastUpdate           uint32         `protobuf:"varint,1,opt,name=last_update,proto3" json:"last_update,omitempty"`
-	PubKey               string         `protobuf:"bytes,2,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
-	Alias                string         `protobuf:"bytes,3,opt,name=alias,proto3" json:"alias,omitempty"`
-	Addresses            []*NodeAddress `protobuf:"bytes,4,rep,name=addresses,proto3" json:"addresses,omitempty"`
-	Color                string         `protobuf:"bytes,5,opt,name=color,proto3" json:"color,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	LastUpdate uint32         `protobuf:"varint,1,opt,name=last_update,proto3" json:"last_update,omitempty"`
+	PubKey     string         `protobuf:"bytes,2,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
+	Alias      string         `protobuf:"bytes,3,opt,name=alias,proto3" json:"alias,omitempty"`
+	Addresses  []*NodeAddress `protobuf:"bytes,4,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Color      string         `protobuf:"bytes,5,opt,name=color,proto3" json:"color,omitempty"`
+	// *
+	// The custom records the node included in its announcement, as a TLV stream
+	// of odd types following the announced addresses. This allows nodes to
+	// advertise metadata such as service discovery information.
+	CustomRecords        map[uint64][]byte `protobuf:"bytes,6,rep,name=custom_records,proto3" json:"custom_records,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *LightningNode) Reset()         { *m = LightningNode{} }
 func (m *LightningNode) String() string { return proto.CompactTextString(m) }
 func (*LightningNode) ProtoMessage()    {}
 func (*LightningNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{77}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{101}
 }
 func (m *LightningNode) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LightningNode.Unmarshal(m, b)
@@ -6048,6 +8570,13 @@ func (m *LightningNode) GetColor() string {
 	return ""
 }
 
+func (m *LightningNode) GetCustomRecords() map[uint64][]byte {
+	if m != nil {
+		return m.CustomRecords
+	}
+	return nil
+}
+
 type NodeAddress struct {
 	Network              string   `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
 	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
@@ -6060,7 +8589,7 @@ func (m *NodeAddress) Reset()         { *m = NodeAddress{} }
 func (m *NodeAddress) String() string { return proto.CompactTextString(m) }
 func (*NodeAddress) ProtoMessage()    {}
 func (*NodeAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{78}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{102}
 }
 func (m *NodeAddress) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeAddress.Unmarshal(m, b)
@@ -6111,7 +8640,7 @@ func (m *RoutingPolicy) Reset()         { *m = RoutingPolicy{} }
 func (m *RoutingPolicy) String() string { return proto.CompactTextString(m) }
 func (*RoutingPolicy) ProtoMessage()    {}
 func (*RoutingPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{79}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{103}
 }
 func (m *RoutingPolicy) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RoutingPolicy.Unmarshal(m, b)
@@ -6208,7 +8737,7 @@ func (m *ChannelEdge) Reset()         { *m = ChannelEdge{} }
 func (m *ChannelEdge) String() string { return proto.CompactTextString(m) }
 func (*ChannelEdge) ProtoMessage()    {}
 func (*ChannelEdge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{80}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{104}
 }
 func (m *ChannelEdge) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelEdge.Unmarshal(m, b)
@@ -6300,7 +8829,7 @@ func (m *ChannelGraphRequest) Reset()         { *m = ChannelGraphRequest{} }
 func (m *ChannelGraphRequest) String() string { return proto.CompactTextString(m) }
 func (*ChannelGraphRequest) ProtoMessage()    {}
 func (*ChannelGraphRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{81}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{105}
 }
 func (m *ChannelGraphRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelGraphRequest.Unmarshal(m, b)
@@ -6342,7 +8871,7 @@ func (m *ChannelGraph) Reset()         { *m = ChannelGraph{} }
 func (m *ChannelGraph) String() string { return proto.CompactTextString(m) }
 func (*ChannelGraph) ProtoMessage()    {}
 func (*ChannelGraph) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{82}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{106}
 }
 func (m *ChannelGraph) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelGraph.Unmarshal(m, b)
@@ -6391,7 +8920,7 @@ func (m *ChanInfoRequest) Reset()         { *m = ChanInfoRequest{} }
 func (m *ChanInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*ChanInfoRequest) ProtoMessage()    {}
 func (*ChanInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{83}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{107}
 }
 func (m *ChanInfoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChanInfoRequest.Unmarshal(m, b)
@@ -6428,7 +8957,7 @@ func (m *NetworkInfoRequest) Reset()         { *m = NetworkInfoRequest{} }
 func (m *NetworkInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*NetworkInfoRequest) ProtoMessage()    {}
 func (*NetworkInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{84}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{108}
 }
 func (m *NetworkInfoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NetworkInfoRequest.Unmarshal(m, b)
@@ -6470,7 +8999,7 @@ func (m *NetworkInfo) Reset()         { *m = NetworkInfo{} }
 func (m *NetworkInfo) String() string { return proto.CompactTextString(m) }
 func (*NetworkInfo) ProtoMessage()    {}
 func (*NetworkInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{85}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{109}
 }
 func (m *NetworkInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NetworkInfo.Unmarshal(m, b)
@@ -6577,7 +9106,7 @@ func (m *StopRequest) Reset()         { *m = StopRequest{} }
 func (m *StopRequest) String() string { return proto.CompactTextString(m) }
 func (*StopRequest) ProtoMessage()    {}
 func (*StopRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{86}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{110}
 }
 func (m *StopRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StopRequest.Unmarshal(m, b)
@@ -6607,7 +9136,7 @@ func (m *StopResponse) Reset()         { *m = StopResponse{} }
 func (m *StopResponse) String() string { return proto.CompactTextString(m) }
 func (*StopResponse) ProtoMessage()    {}
 func (*StopResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{87}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{111}
 }
 func (m *StopResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StopResponse.Unmarshal(m, b)
@@ -6628,6 +9157,13 @@ func (m *StopResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_StopResponse proto.InternalMessageInfo
 
 type GraphTopologySubscription struct {
+	// *
+	// The sequence number of the last update received by the client. If set,
+	// all updates after it are replayed before any new ones, so that a client
+	// can resume its subscription after a brief disconnect without fetching the
+	// full graph again. If the updates since this point are no longer available,
+	// the subscription fails and the graph should be fetched with DescribeGraph.
+	ResumeFrom           uint64   `protobuf:"varint,1,opt,name=resume_from,proto3" json:"resume_from,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -6637,7 +9173,7 @@ func (m *GraphTopologySubscription) Reset()         { *m = GraphTopologySubscrip
 func (m *GraphTopologySubscription) String() string { return proto.CompactTextString(m) }
 func (*GraphTopologySubscription) ProtoMessage()    {}
 func (*GraphTopologySubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{88}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{112}
 }
 func (m *GraphTopologySubscription) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GraphTopologySubscription.Unmarshal(m, b)
@@ -6657,20 +9193,29 @@ func (m *GraphTopologySubscription) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GraphTopologySubscription proto.InternalMessageInfo
 
+func (m *GraphTopologySubscription) GetResumeFrom() uint64 {
+	if m != nil {
+		return m.ResumeFrom
+	}
+	return 0
+}
+
 type GraphTopologyUpdate struct {
-	NodeUpdates          []*NodeUpdate          `protobuf:"bytes,1,rep,name=node_updates,json=nodeUpdates,proto3" json:"node_updates,omitempty"`
-	ChannelUpdates       []*ChannelEdgeUpdate   `protobuf:"bytes,2,rep,name=channel_updates,json=channelUpdates,proto3" json:"channel_updates,omitempty"`
-	ClosedChans          []*ClosedChannelUpdate `protobuf:"bytes,3,rep,name=closed_chans,json=closedChans,proto3" json:"closed_chans,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+	NodeUpdates    []*NodeUpdate          `protobuf:"bytes,1,rep,name=node_updates,json=nodeUpdates,proto3" json:"node_updates,omitempty"`
+	ChannelUpdates []*ChannelEdgeUpdate   `protobuf:"bytes,2,rep,name=channel_updates,json=channelUpdates,proto3" json:"channel_updates,omitempty"`
+	ClosedChans    []*ClosedChannelUpdate `protobuf:"bytes,3,rep,name=closed_chans,json=closedChans,proto3" json:"closed_chans,omitempty"`
+	// / The sequence number of this update, to be used to resume a subscription.
+	Seq                  uint64   `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GraphTopologyUpdate) Reset()         { *m = GraphTopologyUpdate{} }
 func (m *GraphTopologyUpdate) String() string { return proto.CompactTextString(m) }
 func (*GraphTopologyUpdate) ProtoMessage()    {}
 func (*GraphTopologyUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{89}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{113}
 }
 func (m *GraphTopologyUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GraphTopologyUpdate.Unmarshal(m, b)
@@ -6711,6 +9256,13 @@ func (m *GraphTopologyUpdate) GetClosedChans() []*ClosedChannelUpdate {
 	return nil
 }
 
+func (m *GraphTopologyUpdate) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
 type NodeUpdate struct {
 	Addresses            []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
 	IdentityKey          string   `protobuf:"bytes,2,opt,name=identity_key,json=identityKey,proto3" json:"identity_key,omitempty"`
@@ -6726,7 +9278,7 @@ func (m *NodeUpdate) Reset()         { *m = NodeUpdate{} }
 func (m *NodeUpdate) String() string { return proto.CompactTextString(m) }
 func (*NodeUpdate) ProtoMessage()    {}
 func (*NodeUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{90}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{114}
 }
 func (m *NodeUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeUpdate.Unmarshal(m, b)
@@ -6801,7 +9353,7 @@ func (m *ChannelEdgeUpdate) Reset()         { *m = ChannelEdgeUpdate{} }
 func (m *ChannelEdgeUpdate) String() string { return proto.CompactTextString(m) }
 func (*ChannelEdgeUpdate) ProtoMessage()    {}
 func (*ChannelEdgeUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{91}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{115}
 }
 func (m *ChannelEdgeUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelEdgeUpdate.Unmarshal(m, b)
@@ -6881,7 +9433,7 @@ func (m *ClosedChannelUpdate) Reset()         { *m = ClosedChannelUpdate{} }
 func (m *ClosedChannelUpdate) String() string { return proto.CompactTextString(m) }
 func (*ClosedChannelUpdate) ProtoMessage()    {}
 func (*ClosedChannelUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{92}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{116}
 }
 func (m *ClosedChannelUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClosedChannelUpdate.Unmarshal(m, b)
@@ -6951,7 +9503,7 @@ func (m *HopHint) Reset()         { *m = HopHint{} }
 func (m *HopHint) String() string { return proto.CompactTextString(m) }
 func (*HopHint) ProtoMessage()    {}
 func (*HopHint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{93}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{117}
 }
 func (m *HopHint) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HopHint.Unmarshal(m, b)
@@ -7020,7 +9572,7 @@ func (m *RouteHint) Reset()         { *m = RouteHint{} }
 func (m *RouteHint) String() string { return proto.CompactTextString(m) }
 func (*RouteHint) ProtoMessage()    {}
 func (*RouteHint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{94}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{118}
 }
 func (m *RouteHint) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RouteHint.Unmarshal(m, b)
@@ -7129,6 +9681,26 @@ type Invoice struct {
 	// / List of HTLCs paying to this invoice [EXPERIMENTAL].
 	Htlcs []*InvoiceHTLC `protobuf:"bytes,22,rep,name=htlcs,proto3" json:"htlcs,omitempty"`
 	// *
+	// The maximum number of routing hints for private channels to select when
+	// private is set. If zero, up to 20 hints are selected.
+	MaxHopHints uint32 `protobuf:"varint,23,opt,name=max_hop_hints,proto3" json:"max_hop_hints,omitempty"`
+	// *
+	// The short channel ids of private channels to always include as routing
+	// hints, even if private is not set. This allows revealing only specific
+	// private channels in the invoice.
+	HopHintChanIds []uint64 `protobuf:"varint,24,rep,packed,name=hop_hint_chan_ids,proto3" json:"hop_hint_chan_ids,omitempty"`
+	// *
+	// Whether the invoice is reusable and only accepts AMP payments. Every AMP
+	// payment is settled with its own preimage while the invoice remains open.
+	// AMP invoices cannot be hold invoices.
+	Amp bool `protobuf:"varint,25,opt,name=amp,proto3" json:"amp,omitempty"`
+	// / The AMP payment sets that paid to this invoice, oldest first.
+	AmpSets []*AMPInvoiceSet `protobuf:"bytes,26,rep,name=amp_sets,proto3" json:"amp_sets,omitempty"`
+	// *
+	// The payment address of the invoice, which payers must include in the
+	// final hop payload. Only set for invoices that require a payment address.
+	PaymentAddr []byte `protobuf:"bytes,27,opt,name=payment_addr,proto3" json:"payment_addr,omitempty"`
+	// *
 	// Whether to forgo checking for the current max inbound amount before
 	// creating the invoice. This is only applicable during invoice creation.
 	//
@@ -7147,7 +9719,7 @@ func (m *Invoice) Reset()         { *m = Invoice{} }
 func (m *Invoice) String() string { return proto.CompactTextString(m) }
 func (*Invoice) ProtoMessage()    {}
 func (*Invoice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{95}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{119}
 }
 func (m *Invoice) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Invoice.Unmarshal(m, b)
@@ -7324,9 +9896,44 @@ func (m *Invoice) GetHtlcs() []*InvoiceHTLC {
 	return nil
 }
 
-func (m *Invoice) GetIgnoreMaxInboundAmt() bool {
+func (m *Invoice) GetMaxHopHints() uint32 {
 	if m != nil {
-		return m.IgnoreMaxInboundAmt
+		return m.MaxHopHints
+	}
+	return 0
+}
+
+func (m *Invoice) GetHopHintChanIds() []uint64 {
+	if m != nil {
+		return m.HopHintChanIds
+	}
+	return nil
+}
+
+func (m *Invoice) GetAmp() bool {
+	if m != nil {
+		return m.Amp
+	}
+	return false
+}
+
+func (m *Invoice) GetAmpSets() []*AMPInvoiceSet {
+	if m != nil {
+		return m.AmpSets
+	}
+	return nil
+}
+
+func (m *Invoice) GetPaymentAddr() []byte {
+	if m != nil {
+		return m.PaymentAddr
+	}
+	return nil
+}
+
+func (m *Invoice) GetIgnoreMaxInboundAmt() bool {
+	if m != nil {
+		return m.IgnoreMaxInboundAmt
 	}
 	return false
 }
@@ -7348,17 +9955,21 @@ type InvoiceHTLC struct {
 	// / Block height at which this htlc expires.
 	ExpiryHeight int32 `protobuf:"varint,7,opt,name=expiry_height,proto3" json:"expiry_height,omitempty"`
 	// / Current state the htlc is in.
-	State                InvoiceHTLCState `protobuf:"varint,8,opt,name=state,proto3,enum=lnrpc.InvoiceHTLCState" json:"state,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	State InvoiceHTLCState `protobuf:"varint,8,opt,name=state,proto3,enum=lnrpc.InvoiceHTLCState" json:"state,omitempty"`
+	// / The id of the AMP payment set the htlc belongs to, if any.
+	AmpSetId []byte `protobuf:"bytes,9,opt,name=amp_set_id,proto3" json:"amp_set_id,omitempty"`
+	// / The child index of the htlc within its AMP payment set.
+	AmpChildIndex        uint32   `protobuf:"varint,10,opt,name=amp_child_index,proto3" json:"amp_child_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *InvoiceHTLC) Reset()         { *m = InvoiceHTLC{} }
 func (m *InvoiceHTLC) String() string { return proto.CompactTextString(m) }
 func (*InvoiceHTLC) ProtoMessage()    {}
 func (*InvoiceHTLC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{96}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{120}
 }
 func (m *InvoiceHTLC) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InvoiceHTLC.Unmarshal(m, b)
@@ -7434,6 +10045,86 @@ func (m *InvoiceHTLC) GetState() InvoiceHTLCState {
 	return InvoiceHTLCState_ACCEPTED
 }
 
+func (m *InvoiceHTLC) GetAmpSetId() []byte {
+	if m != nil {
+		return m.AmpSetId
+	}
+	return nil
+}
+
+func (m *InvoiceHTLC) GetAmpChildIndex() uint32 {
+	if m != nil {
+		return m.AmpChildIndex
+	}
+	return 0
+}
+
+type AMPInvoiceSet struct {
+	// / The id of the AMP payment set.
+	SetId []byte `protobuf:"bytes,1,opt,name=set_id,proto3" json:"set_id,omitempty"`
+	// / The amount paid by the settled htlcs of the set, in milli-atoms.
+	AmtPaidMAtoms uint64 `protobuf:"varint,2,opt,name=amt_paid_m_atoms,proto3" json:"amt_paid_m_atoms,omitempty"`
+	// / The time at which the first htlc of the set was settled.
+	SettleDate int64 `protobuf:"varint,3,opt,name=settle_date,proto3" json:"settle_date,omitempty"`
+	// / The number of htlcs of the set, including canceled ones.
+	NumHtlcs             uint32   `protobuf:"varint,4,opt,name=num_htlcs,proto3" json:"num_htlcs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AMPInvoiceSet) Reset()         { *m = AMPInvoiceSet{} }
+func (m *AMPInvoiceSet) String() string { return proto.CompactTextString(m) }
+func (*AMPInvoiceSet) ProtoMessage()    {}
+func (*AMPInvoiceSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{121}
+}
+func (m *AMPInvoiceSet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AMPInvoiceSet.Unmarshal(m, b)
+}
+func (m *AMPInvoiceSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AMPInvoiceSet.Marshal(b, m, deterministic)
+}
+func (dst *AMPInvoiceSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AMPInvoiceSet.Merge(dst, src)
+}
+func (m *AMPInvoiceSet) XXX_Size() int {
+	return xxx_messageInfo_AMPInvoiceSet.Size(m)
+}
+func (m *AMPInvoiceSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_AMPInvoiceSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AMPInvoiceSet proto.InternalMessageInfo
+
+func (m *AMPInvoiceSet) GetSetId() []byte {
+	if m != nil {
+		return m.SetId
+	}
+	return nil
+}
+
+func (m *AMPInvoiceSet) GetAmtPaidMAtoms() uint64 {
+	if m != nil {
+		return m.AmtPaidMAtoms
+	}
+	return 0
+}
+
+func (m *AMPInvoiceSet) GetSettleDate() int64 {
+	if m != nil {
+		return m.SettleDate
+	}
+	return 0
+}
+
+func (m *AMPInvoiceSet) GetNumHtlcs() uint32 {
+	if m != nil {
+		return m.NumHtlcs
+	}
+	return 0
+}
+
 type AddInvoiceResponse struct {
 	RHash []byte `protobuf:"bytes,1,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
 	// *
@@ -7456,7 +10147,7 @@ func (m *AddInvoiceResponse) Reset()         { *m = AddInvoiceResponse{} }
 func (m *AddInvoiceResponse) String() string { return proto.CompactTextString(m) }
 func (*AddInvoiceResponse) ProtoMessage()    {}
 func (*AddInvoiceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{97}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{122}
 }
 func (m *AddInvoiceResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddInvoiceResponse.Unmarshal(m, b)
@@ -7503,7 +10194,11 @@ type PaymentHash struct {
 	// payment hash must be exactly 32 bytes, otherwise an error is returned.
 	RHashStr string `protobuf:"bytes,1,opt,name=r_hash_str,proto3" json:"r_hash_str,omitempty"`
 	// / The payment hash of the invoice to be looked up.
-	RHash                []byte   `protobuf:"bytes,2,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
+	RHash []byte `protobuf:"bytes,2,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
+	// *
+	// The payment address of the invoice to be looked up. If set, the invoice is
+	// looked up by its payment address instead of its payment hash.
+	PaymentAddr          []byte   `protobuf:"bytes,3,opt,name=payment_addr,proto3" json:"payment_addr,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -7513,7 +10208,7 @@ func (m *PaymentHash) Reset()         { *m = PaymentHash{} }
 func (m *PaymentHash) String() string { return proto.CompactTextString(m) }
 func (*PaymentHash) ProtoMessage()    {}
 func (*PaymentHash) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{98}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{123}
 }
 func (m *PaymentHash) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaymentHash.Unmarshal(m, b)
@@ -7547,6 +10242,13 @@ func (m *PaymentHash) GetRHash() []byte {
 	return nil
 }
 
+func (m *PaymentHash) GetPaymentAddr() []byte {
+	if m != nil {
+		return m.PaymentAddr
+	}
+	return nil
+}
+
 type ListInvoiceRequest struct {
 	// / If set, only unsettled invoices will be returned in the response.
 	PendingOnly bool `protobuf:"varint,1,opt,name=pending_only,proto3" json:"pending_only,omitempty"`
@@ -7569,7 +10271,7 @@ func (m *ListInvoiceRequest) Reset()         { *m = ListInvoiceRequest{} }
 func (m *ListInvoiceRequest) String() string { return proto.CompactTextString(m) }
 func (*ListInvoiceRequest) ProtoMessage()    {}
 func (*ListInvoiceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{99}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{124}
 }
 func (m *ListInvoiceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListInvoiceRequest.Unmarshal(m, b)
@@ -7639,7 +10341,7 @@ func (m *ListInvoiceResponse) Reset()         { *m = ListInvoiceResponse{} }
 func (m *ListInvoiceResponse) String() string { return proto.CompactTextString(m) }
 func (*ListInvoiceResponse) ProtoMessage()    {}
 func (*ListInvoiceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{100}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{125}
 }
 func (m *ListInvoiceResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListInvoiceResponse.Unmarshal(m, b)
@@ -7692,7 +10394,11 @@ type InvoiceSubscription struct {
 	// notifications for all settled indexes with an settle_index greater than
 	// this value. This allows callers to catch up on any events they missed while
 	// they weren't connected to the streaming RPC.
-	SettleIndex          uint64   `protobuf:"varint,2,opt,name=settle_index,proto3" json:"settle_index,omitempty"`
+	SettleIndex uint64 `protobuf:"varint,2,opt,name=settle_index,proto3" json:"settle_index,omitempty"`
+	// *
+	// If set, invoices that are canceled, either explicitly or because they
+	// expired, are sent out as well. No backlog of cancel events is delivered.
+	IncludeCanceled      bool     `protobuf:"varint,3,opt,name=include_canceled,proto3" json:"include_canceled,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -7702,7 +10408,7 @@ func (m *InvoiceSubscription) Reset()         { *m = InvoiceSubscription{} }
 func (m *InvoiceSubscription) String() string { return proto.CompactTextString(m) }
 func (*InvoiceSubscription) ProtoMessage()    {}
 func (*InvoiceSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{101}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{126}
 }
 func (m *InvoiceSubscription) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InvoiceSubscription.Unmarshal(m, b)
@@ -7736,6 +10442,13 @@ func (m *InvoiceSubscription) GetSettleIndex() uint64 {
 	return 0
 }
 
+func (m *InvoiceSubscription) GetIncludeCanceled() bool {
+	if m != nil {
+		return m.IncludeCanceled
+	}
+	return false
+}
+
 type Payment struct {
 	// / The payment hash
 	PaymentHash string `protobuf:"bytes,1,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
@@ -7760,7 +10473,21 @@ type Payment struct {
 	// /  The fee paid for this payment in atoms
 	FeeAtoms int64 `protobuf:"varint,11,opt,name=fee_atoms,proto3" json:"fee_atoms,omitempty"`
 	// /  The fee paid for this payment in milli-atoms
-	FeeMAtoms            int64    `protobuf:"varint,12,opt,name=fee_m_atoms,proto3" json:"fee_m_atoms,omitempty"`
+	FeeMAtoms int64 `protobuf:"varint,12,opt,name=fee_m_atoms,proto3" json:"fee_m_atoms,omitempty"`
+	// / The reason the payment failed, if its status is FAILED.
+	FailureReason PaymentFailureReason `protobuf:"varint,13,opt,name=failure_reason,proto3,enum=lnrpc.PaymentFailureReason" json:"failure_reason,omitempty"`
+	// / The maximum amount of fees the payment was allowed to pay in milli-atoms
+	FeeLimitMAtoms int64 `protobuf:"varint,14,opt,name=fee_limit_m_atoms,proto3" json:"fee_limit_m_atoms,omitempty"`
+	// *
+	// The difference in milli-atoms between the fee paid by the payment and the
+	// fee quoted by the first route found for it, which arises when the policies
+	// along the route changed while the payment was in flight. A positive value
+	// means more fees than quoted were paid.
+	FeeSlippageMAtoms int64 `protobuf:"varint,15,opt,name=fee_slippage_m_atoms,proto3" json:"fee_slippage_m_atoms,omitempty"`
+	// / The optional note describing the payment.
+	Label string `protobuf:"bytes,16,opt,name=label,proto3" json:"label,omitempty"`
+	// / The optional reference linking the payment to its purpose.
+	Reference            string   `protobuf:"bytes,17,opt,name=reference,proto3" json:"reference,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -7770,7 +10497,7 @@ func (m *Payment) Reset()         { *m = Payment{} }
 func (m *Payment) String() string { return proto.CompactTextString(m) }
 func (*Payment) ProtoMessage()    {}
 func (*Payment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{102}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{127}
 }
 func (m *Payment) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Payment.Unmarshal(m, b)
@@ -7876,12 +10603,50 @@ func (m *Payment) GetFeeMAtoms() int64 {
 	return 0
 }
 
+func (m *Payment) GetFailureReason() PaymentFailureReason {
+	if m != nil {
+		return m.FailureReason
+	}
+	return PaymentFailureReason_FAILURE_REASON_NONE
+}
+
+func (m *Payment) GetFeeLimitMAtoms() int64 {
+	if m != nil {
+		return m.FeeLimitMAtoms
+	}
+	return 0
+}
+
+func (m *Payment) GetFeeSlippageMAtoms() int64 {
+	if m != nil {
+		return m.FeeSlippageMAtoms
+	}
+	return 0
+}
+
+func (m *Payment) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *Payment) GetReference() string {
+	if m != nil {
+		return m.Reference
+	}
+	return ""
+}
+
 type ListPaymentsRequest struct {
 	// *
 	// If true, then return payments that have not yet fully completed. This means
 	// that pending payments, as well as failed payments will show up if this
 	// field is set to True.
-	IncludeIncomplete    bool     `protobuf:"varint,1,opt,name=include_incomplete,json=includeIncomplete,proto3" json:"include_incomplete,omitempty"`
+	IncludeIncomplete bool `protobuf:"varint,1,opt,name=include_incomplete,json=includeIncomplete,proto3" json:"include_incomplete,omitempty"`
+	// *
+	// If set, only the payments linked to this reference are returned.
+	Reference            string   `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -7891,7 +10656,7 @@ func (m *ListPaymentsRequest) Reset()         { *m = ListPaymentsRequest{} }
 func (m *ListPaymentsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListPaymentsRequest) ProtoMessage()    {}
 func (*ListPaymentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{103}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{128}
 }
 func (m *ListPaymentsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListPaymentsRequest.Unmarshal(m, b)
@@ -7918,6 +10683,13 @@ func (m *ListPaymentsRequest) GetIncludeIncomplete() bool {
 	return false
 }
 
+func (m *ListPaymentsRequest) GetReference() string {
+	if m != nil {
+		return m.Reference
+	}
+	return ""
+}
+
 type ListPaymentsResponse struct {
 	// / The list of payments
 	Payments             []*Payment `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
@@ -7930,7 +10702,7 @@ func (m *ListPaymentsResponse) Reset()         { *m = ListPaymentsResponse{} }
 func (m *ListPaymentsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListPaymentsResponse) ProtoMessage()    {}
 func (*ListPaymentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{104}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{129}
 }
 func (m *ListPaymentsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListPaymentsResponse.Unmarshal(m, b)
@@ -7967,7 +10739,7 @@ func (m *DeleteAllPaymentsRequest) Reset()         { *m = DeleteAllPaymentsReque
 func (m *DeleteAllPaymentsRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteAllPaymentsRequest) ProtoMessage()    {}
 func (*DeleteAllPaymentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{105}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{130}
 }
 func (m *DeleteAllPaymentsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteAllPaymentsRequest.Unmarshal(m, b)
@@ -7997,7 +10769,7 @@ func (m *DeleteAllPaymentsResponse) Reset()         { *m = DeleteAllPaymentsResp
 func (m *DeleteAllPaymentsResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteAllPaymentsResponse) ProtoMessage()    {}
 func (*DeleteAllPaymentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{106}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{131}
 }
 func (m *DeleteAllPaymentsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteAllPaymentsResponse.Unmarshal(m, b)
@@ -8018,17 +10790,23 @@ func (m *DeleteAllPaymentsResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_DeleteAllPaymentsResponse proto.InternalMessageInfo
 
 type AbandonChannelRequest struct {
-	ChannelPoint         *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
+	// *
+	// Override the requirement of a dev build by confirming that the funding
+	// transaction of the channel will never confirm, or that the caller otherwise
+	// knows what they are doing. Abandoning an active channel may lead to a loss
+	// of funds.
+	IKnowWhatIAmDoing    bool     `protobuf:"varint,2,opt,name=i_know_what_i_am_doing,proto3" json:"i_know_what_i_am_doing,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *AbandonChannelRequest) Reset()         { *m = AbandonChannelRequest{} }
 func (m *AbandonChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*AbandonChannelRequest) ProtoMessage()    {}
 func (*AbandonChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{107}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{132}
 }
 func (m *AbandonChannelRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AbandonChannelRequest.Unmarshal(m, b)
@@ -8055,6 +10833,13 @@ func (m *AbandonChannelRequest) GetChannelPoint() *ChannelPoint {
 	return nil
 }
 
+func (m *AbandonChannelRequest) GetIKnowWhatIAmDoing() bool {
+	if m != nil {
+		return m.IKnowWhatIAmDoing
+	}
+	return false
+}
+
 type AbandonChannelResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -8065,7 +10850,7 @@ func (m *AbandonChannelResponse) Reset()         { *m = AbandonChannelResponse{}
 func (m *AbandonChannelResponse) String() string { return proto.CompactTextString(m) }
 func (*AbandonChannelResponse) ProtoMessage()    {}
 func (*AbandonChannelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{108}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{133}
 }
 func (m *AbandonChannelResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AbandonChannelResponse.Unmarshal(m, b)
@@ -8097,7 +10882,7 @@ func (m *DebugLevelRequest) Reset()         { *m = DebugLevelRequest{} }
 func (m *DebugLevelRequest) String() string { return proto.CompactTextString(m) }
 func (*DebugLevelRequest) ProtoMessage()    {}
 func (*DebugLevelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{109}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{134}
 }
 func (m *DebugLevelRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DebugLevelRequest.Unmarshal(m, b)
@@ -8142,7 +10927,7 @@ func (m *DebugLevelResponse) Reset()         { *m = DebugLevelResponse{} }
 func (m *DebugLevelResponse) String() string { return proto.CompactTextString(m) }
 func (*DebugLevelResponse) ProtoMessage()    {}
 func (*DebugLevelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{110}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{135}
 }
 func (m *DebugLevelResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DebugLevelResponse.Unmarshal(m, b)
@@ -8181,7 +10966,7 @@ func (m *PayReqString) Reset()         { *m = PayReqString{} }
 func (m *PayReqString) String() string { return proto.CompactTextString(m) }
 func (*PayReqString) ProtoMessage()    {}
 func (*PayReqString) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{111}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{136}
 }
 func (m *PayReqString) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PayReqString.Unmarshal(m, b)
@@ -8209,26 +10994,32 @@ func (m *PayReqString) GetPayReq() string {
 }
 
 type PayReq struct {
-	Destination          string       `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
-	PaymentHash          string       `protobuf:"bytes,2,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
-	NumAtoms             int64        `protobuf:"varint,3,opt,name=num_atoms,proto3" json:"num_atoms,omitempty"`
-	Timestamp            int64        `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Expiry               int64        `protobuf:"varint,5,opt,name=expiry,proto3" json:"expiry,omitempty"`
-	Description          string       `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	DescriptionHash      string       `protobuf:"bytes,7,opt,name=description_hash,proto3" json:"description_hash,omitempty"`
-	FallbackAddr         string       `protobuf:"bytes,8,opt,name=fallback_addr,proto3" json:"fallback_addr,omitempty"`
-	CltvExpiry           int64        `protobuf:"varint,9,opt,name=cltv_expiry,proto3" json:"cltv_expiry,omitempty"`
-	RouteHints           []*RouteHint `protobuf:"bytes,10,rep,name=route_hints,proto3" json:"route_hints,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Destination     string       `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	PaymentHash     string       `protobuf:"bytes,2,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
+	NumAtoms        int64        `protobuf:"varint,3,opt,name=num_atoms,proto3" json:"num_atoms,omitempty"`
+	Timestamp       int64        `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Expiry          int64        `protobuf:"varint,5,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	Description     string       `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	DescriptionHash string       `protobuf:"bytes,7,opt,name=description_hash,proto3" json:"description_hash,omitempty"`
+	FallbackAddr    string       `protobuf:"bytes,8,opt,name=fallback_addr,proto3" json:"fallback_addr,omitempty"`
+	CltvExpiry      int64        `protobuf:"varint,9,opt,name=cltv_expiry,proto3" json:"cltv_expiry,omitempty"`
+	RouteHints      []*RouteHint `protobuf:"bytes,10,rep,name=route_hints,proto3" json:"route_hints,omitempty"`
+	// / The payment address advertised by the invoice, if any.
+	PaymentAddr []byte `protobuf:"bytes,11,opt,name=payment_addr,proto3" json:"payment_addr,omitempty"`
+	// / The feature bits advertised by the invoice.
+	Features []*Feature `protobuf:"bytes,12,rep,name=features,proto3" json:"features,omitempty"`
+	// / The opaque payment metadata to include in the payment, if any.
+	PaymentMetadata      []byte   `protobuf:"bytes,13,opt,name=payment_metadata,proto3" json:"payment_metadata,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *PayReq) Reset()         { *m = PayReq{} }
 func (m *PayReq) String() string { return proto.CompactTextString(m) }
 func (*PayReq) ProtoMessage()    {}
 func (*PayReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{112}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{137}
 }
 func (m *PayReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PayReq.Unmarshal(m, b)
@@ -8318,6 +11109,93 @@ func (m *PayReq) GetRouteHints() []*RouteHint {
 	return nil
 }
 
+func (m *PayReq) GetPaymentAddr() []byte {
+	if m != nil {
+		return m.PaymentAddr
+	}
+	return nil
+}
+
+func (m *PayReq) GetFeatures() []*Feature {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
+func (m *PayReq) GetPaymentMetadata() []byte {
+	if m != nil {
+		return m.PaymentMetadata
+	}
+	return nil
+}
+
+type Feature struct {
+	// / The feature bit.
+	Bit uint32 `protobuf:"varint,1,opt,name=bit,proto3" json:"bit,omitempty"`
+	// / The name of the feature, if it's known.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// / Whether the feature bit is the required bit of the feature.
+	IsRequired bool `protobuf:"varint,3,opt,name=is_required,proto3" json:"is_required,omitempty"`
+	// / Whether the feature is known by the node.
+	IsKnown              bool     `protobuf:"varint,4,opt,name=is_known,proto3" json:"is_known,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Feature) Reset()         { *m = Feature{} }
+func (m *Feature) String() string { return proto.CompactTextString(m) }
+func (*Feature) ProtoMessage()    {}
+func (*Feature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{138}
+}
+func (m *Feature) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Feature.Unmarshal(m, b)
+}
+func (m *Feature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Feature.Marshal(b, m, deterministic)
+}
+func (dst *Feature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Feature.Merge(dst, src)
+}
+func (m *Feature) XXX_Size() int {
+	return xxx_messageInfo_Feature.Size(m)
+}
+func (m *Feature) XXX_DiscardUnknown() {
+	xxx_messageInfo_Feature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Feature proto.InternalMessageInfo
+
+func (m *Feature) GetBit() uint32 {
+	if m != nil {
+		return m.Bit
+	}
+	return 0
+}
+
+func (m *Feature) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Feature) GetIsRequired() bool {
+	if m != nil {
+		return m.IsRequired
+	}
+	return false
+}
+
+func (m *Feature) GetIsKnown() bool {
+	if m != nil {
+		return m.IsKnown
+	}
+	return false
+}
+
 type FeeReportRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -8328,7 +11206,7 @@ func (m *FeeReportRequest) Reset()         { *m = FeeReportRequest{} }
 func (m *FeeReportRequest) String() string { return proto.CompactTextString(m) }
 func (*FeeReportRequest) ProtoMessage()    {}
 func (*FeeReportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{113}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{139}
 }
 func (m *FeeReportRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FeeReportRequest.Unmarshal(m, b)
@@ -8356,7 +11234,11 @@ type ChannelFeeReport struct {
 	// / The amount charged per milli-atoms transferred expressed in millionths of a atom.
 	FeePerMil int64 `protobuf:"varint,3,opt,name=fee_per_mil,proto3" json:"fee_per_mil,omitempty"`
 	// / The effective fee rate in milli-atoms. Computed by dividing the fee_per_mil value by 1 million.
-	FeeRate              float64  `protobuf:"fixed64,4,opt,name=fee_rate,proto3" json:"fee_rate,omitempty"`
+	FeeRate float64 `protobuf:"fixed64,4,opt,name=fee_rate,proto3" json:"fee_rate,omitempty"`
+	// / The inbound base fee charged, or discounted if negative, for HTLCs received over the channel.
+	InboundBaseFeeMAtoms int32 `protobuf:"varint,5,opt,name=inbound_base_fee_m_atoms,proto3" json:"inbound_base_fee_m_atoms,omitempty"`
+	// / The inbound fee rate charged, or discounted if negative, for HTLCs received over the channel expressed in millionths of an atom.
+	InboundFeePerMil     int32    `protobuf:"varint,6,opt,name=inbound_fee_per_mil,proto3" json:"inbound_fee_per_mil,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -8366,7 +11248,7 @@ func (m *ChannelFeeReport) Reset()         { *m = ChannelFeeReport{} }
 func (m *ChannelFeeReport) String() string { return proto.CompactTextString(m) }
 func (*ChannelFeeReport) ProtoMessage()    {}
 func (*ChannelFeeReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{114}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{140}
 }
 func (m *ChannelFeeReport) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelFeeReport.Unmarshal(m, b)
@@ -8414,6 +11296,20 @@ func (m *ChannelFeeReport) GetFeeRate() float64 {
 	return 0
 }
 
+func (m *ChannelFeeReport) GetInboundBaseFeeMAtoms() int32 {
+	if m != nil {
+		return m.InboundBaseFeeMAtoms
+	}
+	return 0
+}
+
+func (m *ChannelFeeReport) GetInboundFeePerMil() int32 {
+	if m != nil {
+		return m.InboundFeePerMil
+	}
+	return 0
+}
+
 type FeeReportResponse struct {
 	// / An array of channel fee reports which describes the current fee schedule for each channel.
 	ChannelFees []*ChannelFeeReport `protobuf:"bytes,1,rep,name=channel_fees,proto3" json:"channel_fees,omitempty"`
@@ -8432,7 +11328,7 @@ func (m *FeeReportResponse) Reset()         { *m = FeeReportResponse{} }
 func (m *FeeReportResponse) String() string { return proto.CompactTextString(m) }
 func (*FeeReportResponse) ProtoMessage()    {}
 func (*FeeReportResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{115}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{141}
 }
 func (m *FeeReportResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FeeReportResponse.Unmarshal(m, b)
@@ -8492,17 +11388,23 @@ type PolicyUpdateRequest struct {
 	// / The required timelock delta for HTLCs forwarded over the channel.
 	TimeLockDelta uint32 `protobuf:"varint,5,opt,name=time_lock_delta,proto3" json:"time_lock_delta,omitempty"`
 	// / If set, the maximum HTLC size in milli-satoshis. If unset, the maximum HTLC will be unchanged.
-	MaxHtlcMAtoms        uint64   `protobuf:"varint,6,opt,name=max_htlc_m_atoms,proto3" json:"max_htlc_m_atoms,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	MaxHtlcMAtoms uint64 `protobuf:"varint,6,opt,name=max_htlc_m_atoms,proto3" json:"max_htlc_m_atoms,omitempty"`
+	// / The minimum HTLC size in milli-atoms. Only applied if min_htlc_m_atoms_specified is true.
+	MinHtlcMAtoms uint64 `protobuf:"varint,7,opt,name=min_htlc_m_atoms,proto3" json:"min_htlc_m_atoms,omitempty"`
+	// / If true, min_htlc_m_atoms is applied. Otherwise, the minimum HTLC will be unchanged.
+	MinHtlcMAtomsSpecified bool `protobuf:"varint,8,opt,name=min_htlc_m_atoms_specified,proto3" json:"min_htlc_m_atoms_specified,omitempty"`
+	// / If set, the inbound fee discount of HTLCs received over the channel. If unset, the inbound fee will be unchanged.
+	InboundFee           *InboundFee `protobuf:"bytes,9,opt,name=inbound_fee,proto3" json:"inbound_fee,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *PolicyUpdateRequest) Reset()         { *m = PolicyUpdateRequest{} }
 func (m *PolicyUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*PolicyUpdateRequest) ProtoMessage()    {}
 func (*PolicyUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{116}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{142}
 }
 func (m *PolicyUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PolicyUpdateRequest.Unmarshal(m, b)
@@ -8587,6 +11489,27 @@ func (m *PolicyUpdateRequest) GetMaxHtlcMAtoms() uint64 {
 	return 0
 }
 
+func (m *PolicyUpdateRequest) GetMinHtlcMAtoms() uint64 {
+	if m != nil {
+		return m.MinHtlcMAtoms
+	}
+	return 0
+}
+
+func (m *PolicyUpdateRequest) GetMinHtlcMAtomsSpecified() bool {
+	if m != nil {
+		return m.MinHtlcMAtomsSpecified
+	}
+	return false
+}
+
+func (m *PolicyUpdateRequest) GetInboundFee() *InboundFee {
+	if m != nil {
+		return m.InboundFee
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*PolicyUpdateRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _PolicyUpdateRequest_OneofMarshaler, _PolicyUpdateRequest_OneofUnmarshaler, _PolicyUpdateRequest_OneofSizer, []interface{}{
@@ -8670,7 +11593,7 @@ func (m *PolicyUpdateResponse) Reset()         { *m = PolicyUpdateResponse{} }
 func (m *PolicyUpdateResponse) String() string { return proto.CompactTextString(m) }
 func (*PolicyUpdateResponse) ProtoMessage()    {}
 func (*PolicyUpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{117}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{143}
 }
 func (m *PolicyUpdateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PolicyUpdateResponse.Unmarshal(m, b)
@@ -8708,7 +11631,7 @@ func (m *ForwardingHistoryRequest) Reset()         { *m = ForwardingHistoryReque
 func (m *ForwardingHistoryRequest) String() string { return proto.CompactTextString(m) }
 func (*ForwardingHistoryRequest) ProtoMessage()    {}
 func (*ForwardingHistoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{118}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{144}
 }
 func (m *ForwardingHistoryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ForwardingHistoryRequest.Unmarshal(m, b)
@@ -8780,7 +11703,7 @@ func (m *ForwardingEvent) Reset()         { *m = ForwardingEvent{} }
 func (m *ForwardingEvent) String() string { return proto.CompactTextString(m) }
 func (*ForwardingEvent) ProtoMessage()    {}
 func (*ForwardingEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{119}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{145}
 }
 func (m *ForwardingEvent) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ForwardingEvent.Unmarshal(m, b)
@@ -8863,7 +11786,7 @@ func (m *ForwardingHistoryResponse) Reset()         { *m = ForwardingHistoryResp
 func (m *ForwardingHistoryResponse) String() string { return proto.CompactTextString(m) }
 func (*ForwardingHistoryResponse) ProtoMessage()    {}
 func (*ForwardingHistoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{120}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{146}
 }
 func (m *ForwardingHistoryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ForwardingHistoryResponse.Unmarshal(m, b)
@@ -8909,7 +11832,7 @@ func (m *ExportChannelBackupRequest) Reset()         { *m = ExportChannelBackupR
 func (m *ExportChannelBackupRequest) String() string { return proto.CompactTextString(m) }
 func (*ExportChannelBackupRequest) ProtoMessage()    {}
 func (*ExportChannelBackupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{121}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{147}
 }
 func (m *ExportChannelBackupRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExportChannelBackupRequest.Unmarshal(m, b)
@@ -8954,7 +11877,7 @@ func (m *ChannelBackup) Reset()         { *m = ChannelBackup{} }
 func (m *ChannelBackup) String() string { return proto.CompactTextString(m) }
 func (*ChannelBackup) ProtoMessage()    {}
 func (*ChannelBackup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{122}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{148}
 }
 func (m *ChannelBackup) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelBackup.Unmarshal(m, b)
@@ -9006,7 +11929,7 @@ func (m *MultiChanBackup) Reset()         { *m = MultiChanBackup{} }
 func (m *MultiChanBackup) String() string { return proto.CompactTextString(m) }
 func (*MultiChanBackup) ProtoMessage()    {}
 func (*MultiChanBackup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{123}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{149}
 }
 func (m *MultiChanBackup) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MultiChanBackup.Unmarshal(m, b)
@@ -9050,7 +11973,7 @@ func (m *ChanBackupExportRequest) Reset()         { *m = ChanBackupExportRequest
 func (m *ChanBackupExportRequest) String() string { return proto.CompactTextString(m) }
 func (*ChanBackupExportRequest) ProtoMessage()    {}
 func (*ChanBackupExportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{124}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{150}
 }
 func (m *ChanBackupExportRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChanBackupExportRequest.Unmarshal(m, b)
@@ -9088,7 +12011,7 @@ func (m *ChanBackupSnapshot) Reset()         { *m = ChanBackupSnapshot{} }
 func (m *ChanBackupSnapshot) String() string { return proto.CompactTextString(m) }
 func (*ChanBackupSnapshot) ProtoMessage()    {}
 func (*ChanBackupSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{125}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{151}
 }
 func (m *ChanBackupSnapshot) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChanBackupSnapshot.Unmarshal(m, b)
@@ -9135,7 +12058,7 @@ func (m *ChannelBackups) Reset()         { *m = ChannelBackups{} }
 func (m *ChannelBackups) String() string { return proto.CompactTextString(m) }
 func (*ChannelBackups) ProtoMessage()    {}
 func (*ChannelBackups) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{126}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{152}
 }
 func (m *ChannelBackups) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelBackups.Unmarshal(m, b)
@@ -9166,17 +12089,21 @@ type RestoreChanBackupRequest struct {
 	// Types that are valid to be assigned to Backup:
 	//	*RestoreChanBackupRequest_ChanBackups
 	//	*RestoreChanBackupRequest_MultiChanBackup
-	Backup               isRestoreChanBackupRequest_Backup `protobuf_oneof:"backup"`
-	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
-	XXX_unrecognized     []byte                            `json:"-"`
-	XXX_sizecache        int32                             `json:"-"`
+	Backup isRestoreChanBackupRequest_Backup `protobuf_oneof:"backup"`
+	// *
+	// If set, the backup is only decrypted and the channel peers are checked for
+	// reachability. No channels are restored.
+	DryRun               bool     `protobuf:"varint,3,opt,name=dry_run,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *RestoreChanBackupRequest) Reset()         { *m = RestoreChanBackupRequest{} }
 func (m *RestoreChanBackupRequest) String() string { return proto.CompactTextString(m) }
 func (*RestoreChanBackupRequest) ProtoMessage()    {}
 func (*RestoreChanBackupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{127}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{153}
 }
 func (m *RestoreChanBackupRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RestoreChanBackupRequest.Unmarshal(m, b)
@@ -9233,6 +12160,13 @@ func (m *RestoreChanBackupRequest) GetMultiChanBackup() []byte {
 	return nil
 }
 
+func (m *RestoreChanBackupRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*RestoreChanBackupRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _RestoreChanBackupRequest_OneofMarshaler, _RestoreChanBackupRequest_OneofUnmarshaler, _RestoreChanBackupRequest_OneofSizer, []interface{}{
@@ -9304,16 +12238,20 @@ func _RestoreChanBackupRequest_OneofSizer(msg proto.Message) (n int) {
 }
 
 type RestoreBackupResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// *
+	// The result of the checks of each channel within the backup. Only populated
+	// for dry runs.
+	Channels             []*ChannelRestoreCheck `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
 func (m *RestoreBackupResponse) Reset()         { *m = RestoreBackupResponse{} }
 func (m *RestoreBackupResponse) String() string { return proto.CompactTextString(m) }
 func (*RestoreBackupResponse) ProtoMessage()    {}
 func (*RestoreBackupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{128}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{154}
 }
 func (m *RestoreBackupResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RestoreBackupResponse.Unmarshal(m, b)
@@ -9333,28 +12271,233 @@ func (m *RestoreBackupResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RestoreBackupResponse proto.InternalMessageInfo
 
-type ChannelBackupSubscription struct {
+func (m *RestoreBackupResponse) GetChannels() []*ChannelRestoreCheck {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+type ChannelBackupInfo struct {
+	// / The funding outpoint of the channel.
+	ChanPoint *ChannelPoint `protobuf:"bytes,1,opt,name=chan_point,proto3" json:"chan_point,omitempty"`
+	// / The short channel ID of the channel.
+	ChanId uint64 `protobuf:"varint,2,opt,name=chan_id,proto3" json:"chan_id,omitempty"`
+	// / The identity pubkey of the remote node of the channel.
+	RemoteNodePub string `protobuf:"bytes,3,opt,name=remote_node_pub,proto3" json:"remote_node_pub,omitempty"`
+	// / The known addresses of the remote node.
+	Addresses []string `protobuf:"bytes,4,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// / The total amount of funds held in the channel.
+	Capacity int64 `protobuf:"varint,5,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// / Whether we initiated the funding of the channel.
+	Initiator bool `protobuf:"varint,6,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	// / The version of the single channel backup.
+	Version uint32 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	// / The hash of the genesis block of the chain the channel belongs to.
+	ChainHash            string   `protobuf:"bytes,8,opt,name=chain_hash,proto3" json:"chain_hash,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChannelBackupSubscription) Reset()         { *m = ChannelBackupSubscription{} }
-func (m *ChannelBackupSubscription) String() string { return proto.CompactTextString(m) }
-func (*ChannelBackupSubscription) ProtoMessage()    {}
-func (*ChannelBackupSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{129}
+func (m *ChannelBackupInfo) Reset()         { *m = ChannelBackupInfo{} }
+func (m *ChannelBackupInfo) String() string { return proto.CompactTextString(m) }
+func (*ChannelBackupInfo) ProtoMessage()    {}
+func (*ChannelBackupInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{155}
 }
-func (m *ChannelBackupSubscription) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelBackupSubscription.Unmarshal(m, b)
+func (m *ChannelBackupInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelBackupInfo.Unmarshal(m, b)
 }
-func (m *ChannelBackupSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelBackupSubscription.Marshal(b, m, deterministic)
+func (m *ChannelBackupInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelBackupInfo.Marshal(b, m, deterministic)
 }
-func (dst *ChannelBackupSubscription) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelBackupSubscription.Merge(dst, src)
+func (dst *ChannelBackupInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelBackupInfo.Merge(dst, src)
 }
-func (m *ChannelBackupSubscription) XXX_Size() int {
+func (m *ChannelBackupInfo) XXX_Size() int {
+	return xxx_messageInfo_ChannelBackupInfo.Size(m)
+}
+func (m *ChannelBackupInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelBackupInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelBackupInfo proto.InternalMessageInfo
+
+func (m *ChannelBackupInfo) GetChanPoint() *ChannelPoint {
+	if m != nil {
+		return m.ChanPoint
+	}
+	return nil
+}
+
+func (m *ChannelBackupInfo) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *ChannelBackupInfo) GetRemoteNodePub() string {
+	if m != nil {
+		return m.RemoteNodePub
+	}
+	return ""
+}
+
+func (m *ChannelBackupInfo) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *ChannelBackupInfo) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *ChannelBackupInfo) GetInitiator() bool {
+	if m != nil {
+		return m.Initiator
+	}
+	return false
+}
+
+func (m *ChannelBackupInfo) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ChannelBackupInfo) GetChainHash() string {
+	if m != nil {
+		return m.ChainHash
+	}
+	return ""
+}
+
+type InspectChanBackupResponse struct {
+	// / The channels contained within the backup.
+	Channels             []*ChannelBackupInfo `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *InspectChanBackupResponse) Reset()         { *m = InspectChanBackupResponse{} }
+func (m *InspectChanBackupResponse) String() string { return proto.CompactTextString(m) }
+func (*InspectChanBackupResponse) ProtoMessage()    {}
+func (*InspectChanBackupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{156}
+}
+func (m *InspectChanBackupResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InspectChanBackupResponse.Unmarshal(m, b)
+}
+func (m *InspectChanBackupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InspectChanBackupResponse.Marshal(b, m, deterministic)
+}
+func (dst *InspectChanBackupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InspectChanBackupResponse.Merge(dst, src)
+}
+func (m *InspectChanBackupResponse) XXX_Size() int {
+	return xxx_messageInfo_InspectChanBackupResponse.Size(m)
+}
+func (m *InspectChanBackupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InspectChanBackupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InspectChanBackupResponse proto.InternalMessageInfo
+
+func (m *InspectChanBackupResponse) GetChannels() []*ChannelBackupInfo {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+type ChannelRestoreCheck struct {
+	// / The channel contained within the backup.
+	Channel *ChannelBackupInfo `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// / Whether the remote node of the channel could be reached.
+	PeerReachable bool `protobuf:"varint,2,opt,name=peer_reachable,proto3" json:"peer_reachable,omitempty"`
+	// / The reason the remote node couldn't be reached, if any.
+	PeerError            string   `protobuf:"bytes,3,opt,name=peer_error,proto3" json:"peer_error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelRestoreCheck) Reset()         { *m = ChannelRestoreCheck{} }
+func (m *ChannelRestoreCheck) String() string { return proto.CompactTextString(m) }
+func (*ChannelRestoreCheck) ProtoMessage()    {}
+func (*ChannelRestoreCheck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{157}
+}
+func (m *ChannelRestoreCheck) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelRestoreCheck.Unmarshal(m, b)
+}
+func (m *ChannelRestoreCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelRestoreCheck.Marshal(b, m, deterministic)
+}
+func (dst *ChannelRestoreCheck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelRestoreCheck.Merge(dst, src)
+}
+func (m *ChannelRestoreCheck) XXX_Size() int {
+	return xxx_messageInfo_ChannelRestoreCheck.Size(m)
+}
+func (m *ChannelRestoreCheck) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelRestoreCheck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelRestoreCheck proto.InternalMessageInfo
+
+func (m *ChannelRestoreCheck) GetChannel() *ChannelBackupInfo {
+	if m != nil {
+		return m.Channel
+	}
+	return nil
+}
+
+func (m *ChannelRestoreCheck) GetPeerReachable() bool {
+	if m != nil {
+		return m.PeerReachable
+	}
+	return false
+}
+
+func (m *ChannelRestoreCheck) GetPeerError() string {
+	if m != nil {
+		return m.PeerError
+	}
+	return ""
+}
+
+type ChannelBackupSubscription struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelBackupSubscription) Reset()         { *m = ChannelBackupSubscription{} }
+func (m *ChannelBackupSubscription) String() string { return proto.CompactTextString(m) }
+func (*ChannelBackupSubscription) ProtoMessage()    {}
+func (*ChannelBackupSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{158}
+}
+func (m *ChannelBackupSubscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelBackupSubscription.Unmarshal(m, b)
+}
+func (m *ChannelBackupSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelBackupSubscription.Marshal(b, m, deterministic)
+}
+func (dst *ChannelBackupSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelBackupSubscription.Merge(dst, src)
+}
+func (m *ChannelBackupSubscription) XXX_Size() int {
 	return xxx_messageInfo_ChannelBackupSubscription.Size(m)
 }
 func (m *ChannelBackupSubscription) XXX_DiscardUnknown() {
@@ -9373,7 +12516,7 @@ func (m *VerifyChanBackupResponse) Reset()         { *m = VerifyChanBackupRespon
 func (m *VerifyChanBackupResponse) String() string { return proto.CompactTextString(m) }
 func (*VerifyChanBackupResponse) ProtoMessage()    {}
 func (*VerifyChanBackupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_121e03430f9ed6eb, []int{130}
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{159}
 }
 func (m *VerifyChanBackupResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VerifyChanBackupResponse.Unmarshal(m, b)
@@ -9393,2633 +12536,7857 @@ func (m *VerifyChanBackupResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_VerifyChanBackupResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*GenSeedRequest)(nil), "lnrpc.GenSeedRequest")
-	proto.RegisterType((*GenSeedResponse)(nil), "lnrpc.GenSeedResponse")
-	proto.RegisterType((*InitWalletRequest)(nil), "lnrpc.InitWalletRequest")
-	proto.RegisterType((*InitWalletResponse)(nil), "lnrpc.InitWalletResponse")
-	proto.RegisterType((*UnlockWalletRequest)(nil), "lnrpc.UnlockWalletRequest")
-	proto.RegisterType((*UnlockWalletResponse)(nil), "lnrpc.UnlockWalletResponse")
-	proto.RegisterType((*ChangePasswordRequest)(nil), "lnrpc.ChangePasswordRequest")
-	proto.RegisterType((*ChangePasswordResponse)(nil), "lnrpc.ChangePasswordResponse")
-	proto.RegisterType((*Utxo)(nil), "lnrpc.Utxo")
-	proto.RegisterType((*Transaction)(nil), "lnrpc.Transaction")
-	proto.RegisterType((*GetTransactionsRequest)(nil), "lnrpc.GetTransactionsRequest")
-	proto.RegisterType((*TransactionDetails)(nil), "lnrpc.TransactionDetails")
-	proto.RegisterType((*FeeLimit)(nil), "lnrpc.FeeLimit")
-	proto.RegisterType((*SendRequest)(nil), "lnrpc.SendRequest")
-	proto.RegisterMapType((map[uint64][]byte)(nil), "lnrpc.SendRequest.DestTlvEntry")
-	proto.RegisterType((*SendResponse)(nil), "lnrpc.SendResponse")
-	proto.RegisterType((*SendToRouteRequest)(nil), "lnrpc.SendToRouteRequest")
-	proto.RegisterType((*ChannelAcceptRequest)(nil), "lnrpc.ChannelAcceptRequest")
-	proto.RegisterType((*ChannelAcceptResponse)(nil), "lnrpc.ChannelAcceptResponse")
-	proto.RegisterType((*ChannelPoint)(nil), "lnrpc.ChannelPoint")
-	proto.RegisterType((*OutPoint)(nil), "lnrpc.OutPoint")
-	proto.RegisterType((*LightningAddress)(nil), "lnrpc.LightningAddress")
-	proto.RegisterType((*EstimateFeeRequest)(nil), "lnrpc.EstimateFeeRequest")
-	proto.RegisterMapType((map[string]int64)(nil), "lnrpc.EstimateFeeRequest.AddrToAmountEntry")
-	proto.RegisterType((*EstimateFeeResponse)(nil), "lnrpc.EstimateFeeResponse")
-	proto.RegisterType((*SendManyRequest)(nil), "lnrpc.SendManyRequest")
-	proto.RegisterMapType((map[string]int64)(nil), "lnrpc.SendManyRequest.AddrToAmountEntry")
-	proto.RegisterType((*SendManyResponse)(nil), "lnrpc.SendManyResponse")
-	proto.RegisterType((*SendCoinsRequest)(nil), "lnrpc.SendCoinsRequest")
-	proto.RegisterType((*SendCoinsResponse)(nil), "lnrpc.SendCoinsResponse")
-	proto.RegisterType((*ListUnspentRequest)(nil), "lnrpc.ListUnspentRequest")
-	proto.RegisterType((*ListUnspentResponse)(nil), "lnrpc.ListUnspentResponse")
-	proto.RegisterType((*NewAddressRequest)(nil), "lnrpc.NewAddressRequest")
-	proto.RegisterType((*NewAddressResponse)(nil), "lnrpc.NewAddressResponse")
-	proto.RegisterType((*SignMessageRequest)(nil), "lnrpc.SignMessageRequest")
-	proto.RegisterType((*SignMessageResponse)(nil), "lnrpc.SignMessageResponse")
-	proto.RegisterType((*VerifyMessageRequest)(nil), "lnrpc.VerifyMessageRequest")
-	proto.RegisterType((*VerifyMessageResponse)(nil), "lnrpc.VerifyMessageResponse")
-	proto.RegisterType((*ConnectPeerRequest)(nil), "lnrpc.ConnectPeerRequest")
-	proto.RegisterType((*ConnectPeerResponse)(nil), "lnrpc.ConnectPeerResponse")
-	proto.RegisterType((*DisconnectPeerRequest)(nil), "lnrpc.DisconnectPeerRequest")
-	proto.RegisterType((*DisconnectPeerResponse)(nil), "lnrpc.DisconnectPeerResponse")
-	proto.RegisterType((*HTLC)(nil), "lnrpc.HTLC")
-	proto.RegisterType((*Channel)(nil), "lnrpc.Channel")
-	proto.RegisterType((*ListChannelsRequest)(nil), "lnrpc.ListChannelsRequest")
-	proto.RegisterType((*ListChannelsResponse)(nil), "lnrpc.ListChannelsResponse")
-	proto.RegisterType((*ChannelCloseSummary)(nil), "lnrpc.ChannelCloseSummary")
-	proto.RegisterType((*ClosedChannelsRequest)(nil), "lnrpc.ClosedChannelsRequest")
-	proto.RegisterType((*ClosedChannelsResponse)(nil), "lnrpc.ClosedChannelsResponse")
-	proto.RegisterType((*Peer)(nil), "lnrpc.Peer")
-	proto.RegisterType((*ListPeersRequest)(nil), "lnrpc.ListPeersRequest")
-	proto.RegisterType((*ListPeersResponse)(nil), "lnrpc.ListPeersResponse")
-	proto.RegisterType((*GetInfoRequest)(nil), "lnrpc.GetInfoRequest")
-	proto.RegisterType((*GetInfoResponse)(nil), "lnrpc.GetInfoResponse")
-	proto.RegisterType((*Chain)(nil), "lnrpc.Chain")
-	proto.RegisterType((*ConfirmationUpdate)(nil), "lnrpc.ConfirmationUpdate")
-	proto.RegisterType((*ChannelOpenUpdate)(nil), "lnrpc.ChannelOpenUpdate")
-	proto.RegisterType((*ChannelCloseUpdate)(nil), "lnrpc.ChannelCloseUpdate")
-	proto.RegisterType((*CloseChannelRequest)(nil), "lnrpc.CloseChannelRequest")
-	proto.RegisterType((*CloseStatusUpdate)(nil), "lnrpc.CloseStatusUpdate")
-	proto.RegisterType((*PendingUpdate)(nil), "lnrpc.PendingUpdate")
-	proto.RegisterType((*OpenChannelRequest)(nil), "lnrpc.OpenChannelRequest")
-	proto.RegisterType((*OpenStatusUpdate)(nil), "lnrpc.OpenStatusUpdate")
-	proto.RegisterType((*PendingHTLC)(nil), "lnrpc.PendingHTLC")
-	proto.RegisterType((*PendingChannelsRequest)(nil), "lnrpc.PendingChannelsRequest")
-	proto.RegisterType((*PendingChannelsResponse)(nil), "lnrpc.PendingChannelsResponse")
-	proto.RegisterType((*PendingChannelsResponse_PendingChannel)(nil), "lnrpc.PendingChannelsResponse.PendingChannel")
-	proto.RegisterType((*PendingChannelsResponse_PendingOpenChannel)(nil), "lnrpc.PendingChannelsResponse.PendingOpenChannel")
-	proto.RegisterType((*PendingChannelsResponse_WaitingCloseChannel)(nil), "lnrpc.PendingChannelsResponse.WaitingCloseChannel")
-	proto.RegisterType((*PendingChannelsResponse_ClosedChannel)(nil), "lnrpc.PendingChannelsResponse.ClosedChannel")
-	proto.RegisterType((*PendingChannelsResponse_ForceClosedChannel)(nil), "lnrpc.PendingChannelsResponse.ForceClosedChannel")
-	proto.RegisterType((*ChannelEventSubscription)(nil), "lnrpc.ChannelEventSubscription")
-	proto.RegisterType((*ChannelEventUpdate)(nil), "lnrpc.ChannelEventUpdate")
-	proto.RegisterType((*WalletBalanceRequest)(nil), "lnrpc.WalletBalanceRequest")
-	proto.RegisterType((*WalletBalanceResponse)(nil), "lnrpc.WalletBalanceResponse")
-	proto.RegisterType((*ChannelBalanceRequest)(nil), "lnrpc.ChannelBalanceRequest")
-	proto.RegisterType((*ChannelBalanceResponse)(nil), "lnrpc.ChannelBalanceResponse")
-	proto.RegisterType((*QueryRoutesRequest)(nil), "lnrpc.QueryRoutesRequest")
-	proto.RegisterType((*NodePair)(nil), "lnrpc.NodePair")
-	proto.RegisterType((*EdgeLocator)(nil), "lnrpc.EdgeLocator")
-	proto.RegisterType((*QueryRoutesResponse)(nil), "lnrpc.QueryRoutesResponse")
-	proto.RegisterType((*Hop)(nil), "lnrpc.Hop")
-	proto.RegisterType((*Route)(nil), "lnrpc.Route")
-	proto.RegisterType((*NodeInfoRequest)(nil), "lnrpc.NodeInfoRequest")
-	proto.RegisterType((*NodeInfo)(nil), "lnrpc.NodeInfo")
-	proto.RegisterType((*LightningNode)(nil), "lnrpc.LightningNode")
-	proto.RegisterType((*NodeAddress)(nil), "lnrpc.NodeAddress")
-	proto.RegisterType((*RoutingPolicy)(nil), "lnrpc.RoutingPolicy")
-	proto.RegisterType((*ChannelEdge)(nil), "lnrpc.ChannelEdge")
-	proto.RegisterType((*ChannelGraphRequest)(nil), "lnrpc.ChannelGraphRequest")
-	proto.RegisterType((*ChannelGraph)(nil), "lnrpc.ChannelGraph")
-	proto.RegisterType((*ChanInfoRequest)(nil), "lnrpc.ChanInfoRequest")
-	proto.RegisterType((*NetworkInfoRequest)(nil), "lnrpc.NetworkInfoRequest")
-	proto.RegisterType((*NetworkInfo)(nil), "lnrpc.NetworkInfo")
-	proto.RegisterType((*StopRequest)(nil), "lnrpc.StopRequest")
-	proto.RegisterType((*StopResponse)(nil), "lnrpc.StopResponse")
-	proto.RegisterType((*GraphTopologySubscription)(nil), "lnrpc.GraphTopologySubscription")
-	proto.RegisterType((*GraphTopologyUpdate)(nil), "lnrpc.GraphTopologyUpdate")
-	proto.RegisterType((*NodeUpdate)(nil), "lnrpc.NodeUpdate")
-	proto.RegisterType((*ChannelEdgeUpdate)(nil), "lnrpc.ChannelEdgeUpdate")
-	proto.RegisterType((*ClosedChannelUpdate)(nil), "lnrpc.ClosedChannelUpdate")
-	proto.RegisterType((*HopHint)(nil), "lnrpc.HopHint")
-	proto.RegisterType((*RouteHint)(nil), "lnrpc.RouteHint")
-	proto.RegisterType((*Invoice)(nil), "lnrpc.Invoice")
-	proto.RegisterType((*InvoiceHTLC)(nil), "lnrpc.InvoiceHTLC")
-	proto.RegisterType((*AddInvoiceResponse)(nil), "lnrpc.AddInvoiceResponse")
-	proto.RegisterType((*PaymentHash)(nil), "lnrpc.PaymentHash")
-	proto.RegisterType((*ListInvoiceRequest)(nil), "lnrpc.ListInvoiceRequest")
-	proto.RegisterType((*ListInvoiceResponse)(nil), "lnrpc.ListInvoiceResponse")
-	proto.RegisterType((*InvoiceSubscription)(nil), "lnrpc.InvoiceSubscription")
-	proto.RegisterType((*Payment)(nil), "lnrpc.Payment")
-	proto.RegisterType((*ListPaymentsRequest)(nil), "lnrpc.ListPaymentsRequest")
-	proto.RegisterType((*ListPaymentsResponse)(nil), "lnrpc.ListPaymentsResponse")
-	proto.RegisterType((*DeleteAllPaymentsRequest)(nil), "lnrpc.DeleteAllPaymentsRequest")
-	proto.RegisterType((*DeleteAllPaymentsResponse)(nil), "lnrpc.DeleteAllPaymentsResponse")
-	proto.RegisterType((*AbandonChannelRequest)(nil), "lnrpc.AbandonChannelRequest")
-	proto.RegisterType((*AbandonChannelResponse)(nil), "lnrpc.AbandonChannelResponse")
-	proto.RegisterType((*DebugLevelRequest)(nil), "lnrpc.DebugLevelRequest")
-	proto.RegisterType((*DebugLevelResponse)(nil), "lnrpc.DebugLevelResponse")
-	proto.RegisterType((*PayReqString)(nil), "lnrpc.PayReqString")
-	proto.RegisterType((*PayReq)(nil), "lnrpc.PayReq")
-	proto.RegisterType((*FeeReportRequest)(nil), "lnrpc.FeeReportRequest")
-	proto.RegisterType((*ChannelFeeReport)(nil), "lnrpc.ChannelFeeReport")
-	proto.RegisterType((*FeeReportResponse)(nil), "lnrpc.FeeReportResponse")
-	proto.RegisterType((*PolicyUpdateRequest)(nil), "lnrpc.PolicyUpdateRequest")
-	proto.RegisterType((*PolicyUpdateResponse)(nil), "lnrpc.PolicyUpdateResponse")
-	proto.RegisterType((*ForwardingHistoryRequest)(nil), "lnrpc.ForwardingHistoryRequest")
-	proto.RegisterType((*ForwardingEvent)(nil), "lnrpc.ForwardingEvent")
-	proto.RegisterType((*ForwardingHistoryResponse)(nil), "lnrpc.ForwardingHistoryResponse")
-	proto.RegisterType((*ExportChannelBackupRequest)(nil), "lnrpc.ExportChannelBackupRequest")
-	proto.RegisterType((*ChannelBackup)(nil), "lnrpc.ChannelBackup")
-	proto.RegisterType((*MultiChanBackup)(nil), "lnrpc.MultiChanBackup")
-	proto.RegisterType((*ChanBackupExportRequest)(nil), "lnrpc.ChanBackupExportRequest")
-	proto.RegisterType((*ChanBackupSnapshot)(nil), "lnrpc.ChanBackupSnapshot")
-	proto.RegisterType((*ChannelBackups)(nil), "lnrpc.ChannelBackups")
-	proto.RegisterType((*RestoreChanBackupRequest)(nil), "lnrpc.RestoreChanBackupRequest")
-	proto.RegisterType((*RestoreBackupResponse)(nil), "lnrpc.RestoreBackupResponse")
-	proto.RegisterType((*ChannelBackupSubscription)(nil), "lnrpc.ChannelBackupSubscription")
-	proto.RegisterType((*VerifyChanBackupResponse)(nil), "lnrpc.VerifyChanBackupResponse")
-	proto.RegisterEnum("lnrpc.AddressType", AddressType_name, AddressType_value)
-	proto.RegisterEnum("lnrpc.InvoiceHTLCState", InvoiceHTLCState_name, InvoiceHTLCState_value)
-	proto.RegisterEnum("lnrpc.ChannelCloseSummary_ClosureType", ChannelCloseSummary_ClosureType_name, ChannelCloseSummary_ClosureType_value)
-	proto.RegisterEnum("lnrpc.Peer_SyncType", Peer_SyncType_name, Peer_SyncType_value)
-	proto.RegisterEnum("lnrpc.ChannelEventUpdate_UpdateType", ChannelEventUpdate_UpdateType_name, ChannelEventUpdate_UpdateType_value)
-	proto.RegisterEnum("lnrpc.Invoice_InvoiceState", Invoice_InvoiceState_name, Invoice_InvoiceState_value)
-	proto.RegisterEnum("lnrpc.Payment_PaymentStatus", Payment_PaymentStatus_name, Payment_PaymentStatus_value)
+type DatabaseSnapshotRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *DatabaseSnapshotRequest) Reset()         { *m = DatabaseSnapshotRequest{} }
+func (m *DatabaseSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*DatabaseSnapshotRequest) ProtoMessage()    {}
+func (*DatabaseSnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{160}
+}
+func (m *DatabaseSnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DatabaseSnapshotRequest.Unmarshal(m, b)
+}
+func (m *DatabaseSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DatabaseSnapshotRequest.Marshal(b, m, deterministic)
+}
+func (dst *DatabaseSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatabaseSnapshotRequest.Merge(dst, src)
+}
+func (m *DatabaseSnapshotRequest) XXX_Size() int {
+	return xxx_messageInfo_DatabaseSnapshotRequest.Size(m)
+}
+func (m *DatabaseSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatabaseSnapshotRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_DatabaseSnapshotRequest proto.InternalMessageInfo
 
-// WalletUnlockerClient is the client API for WalletUnlocker service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type WalletUnlockerClient interface {
-	// *
-	// GenSeed is the first method that should be used to instantiate a new lnd
-	// instance. This method allows a caller to generate a new aezeed cipher seed
-	// given an optional passphrase. If provided, the passphrase will be necessary
-	// to decrypt the cipherseed to expose the internal wallet seed.
-	//
-	// Once the cipherseed is obtained and verified by the user, the InitWallet
-	// method should be used to commit the newly generated seed, and create the
-	// wallet.
-	GenSeed(ctx context.Context, in *GenSeedRequest, opts ...grpc.CallOption) (*GenSeedResponse, error)
-	// *
-	// InitWallet is used when lnd is starting up for the first time to fully
-	// initialize the daemon and its internal wallet. At the very least a wallet
-	// password must be provided. This will be used to encrypt sensitive material
-	// on disk.
-	//
-	// In the case of a recovery scenario, the user can also specify their aezeed
-	// mnemonic and passphrase. If set, then the daemon will use this prior state
-	// to initialize its internal wallet.
-	//
-	// Alternatively, this can be used along with the GenSeed RPC to obtain a
-	// seed, then present it to the user. Once it has been verified by the user,
-	// the seed can be fed into this RPC in order to commit the new wallet.
-	InitWallet(ctx context.Context, in *InitWalletRequest, opts ...grpc.CallOption) (*InitWalletResponse, error)
-	// * lncli: `unlock`
-	// UnlockWallet is used at startup of lnd to provide a password to unlock
-	// the wallet database.
-	UnlockWallet(ctx context.Context, in *UnlockWalletRequest, opts ...grpc.CallOption) (*UnlockWalletResponse, error)
-	// * lncli: `changepassword`
-	// ChangePassword changes the password of the encrypted wallet. This will
-	// automatically unlock the wallet database if successful.
-	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
+type DatabaseSnapshotResponse struct {
+	// / The directory the snapshot was written to.
+	SnapshotDir string `protobuf:"bytes,1,opt,name=snapshot_dir,proto3" json:"snapshot_dir,omitempty"`
+	// / The databases within the snapshot, in the order they were copied.
+	Files                []*DatabaseSnapshotFile `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
-type walletUnlockerClient struct {
-	cc *grpc.ClientConn
+func (m *DatabaseSnapshotResponse) Reset()         { *m = DatabaseSnapshotResponse{} }
+func (m *DatabaseSnapshotResponse) String() string { return proto.CompactTextString(m) }
+func (*DatabaseSnapshotResponse) ProtoMessage()    {}
+func (*DatabaseSnapshotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{161}
+}
+func (m *DatabaseSnapshotResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DatabaseSnapshotResponse.Unmarshal(m, b)
+}
+func (m *DatabaseSnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DatabaseSnapshotResponse.Marshal(b, m, deterministic)
+}
+func (dst *DatabaseSnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatabaseSnapshotResponse.Merge(dst, src)
+}
+func (m *DatabaseSnapshotResponse) XXX_Size() int {
+	return xxx_messageInfo_DatabaseSnapshotResponse.Size(m)
+}
+func (m *DatabaseSnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatabaseSnapshotResponse.DiscardUnknown(m)
 }
 
-func NewWalletUnlockerClient(cc *grpc.ClientConn) WalletUnlockerClient {
-	return &walletUnlockerClient{cc}
+var xxx_messageInfo_DatabaseSnapshotResponse proto.InternalMessageInfo
+
+func (m *DatabaseSnapshotResponse) GetSnapshotDir() string {
+	if m != nil {
+		return m.SnapshotDir
+	}
+	return ""
 }
 
-func (c *walletUnlockerClient) GenSeed(ctx context.Context, in *GenSeedRequest, opts ...grpc.CallOption) (*GenSeedResponse, error) {
-	out := new(GenSeedResponse)
-	err := c.cc.Invoke(ctx, "/lnrpc.WalletUnlocker/GenSeed", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DatabaseSnapshotResponse) GetFiles() []*DatabaseSnapshotFile {
+	if m != nil {
+		return m.Files
 	}
-	return out, nil
+	return nil
 }
 
-func (c *walletUnlockerClient) InitWallet(ctx context.Context, in *InitWalletRequest, opts ...grpc.CallOption) (*InitWalletResponse, error) {
-	out := new(InitWalletResponse)
-	err := c.cc.Invoke(ctx, "/lnrpc.WalletUnlocker/InitWallet", in, out, opts...)
-	if err != nil {
-		return nil, err
+type DatabaseSnapshotFile struct {
+	// / The file name of the database within the snapshot directory.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// / The size in bytes of the database.
+	Size int64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// / The id of the read transaction the database was copied within.
+	TxId                 uint64   `protobuf:"varint,3,opt,name=tx_id,proto3" json:"tx_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DatabaseSnapshotFile) Reset()         { *m = DatabaseSnapshotFile{} }
+func (m *DatabaseSnapshotFile) String() string { return proto.CompactTextString(m) }
+func (*DatabaseSnapshotFile) ProtoMessage()    {}
+func (*DatabaseSnapshotFile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{162}
+}
+func (m *DatabaseSnapshotFile) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DatabaseSnapshotFile.Unmarshal(m, b)
+}
+func (m *DatabaseSnapshotFile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DatabaseSnapshotFile.Marshal(b, m, deterministic)
+}
+func (dst *DatabaseSnapshotFile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatabaseSnapshotFile.Merge(dst, src)
+}
+func (m *DatabaseSnapshotFile) XXX_Size() int {
+	return xxx_messageInfo_DatabaseSnapshotFile.Size(m)
+}
+func (m *DatabaseSnapshotFile) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatabaseSnapshotFile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DatabaseSnapshotFile proto.InternalMessageInfo
+
+func (m *DatabaseSnapshotFile) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return out, nil
+	return ""
 }
 
-func (c *walletUnlockerClient) UnlockWallet(ctx context.Context, in *UnlockWalletRequest, opts ...grpc.CallOption) (*UnlockWalletResponse, error) {
-	out := new(UnlockWalletResponse)
-	err := c.cc.Invoke(ctx, "/lnrpc.WalletUnlocker/UnlockWallet", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DatabaseSnapshotFile) GetSize() int64 {
+	if m != nil {
+		return m.Size
 	}
-	return out, nil
+	return 0
 }
 
-func (c *walletUnlockerClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error) {
-	out := new(ChangePasswordResponse)
-	err := c.cc.Invoke(ctx, "/lnrpc.WalletUnlocker/ChangePassword", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DatabaseSnapshotFile) GetTxId() uint64 {
+	if m != nil {
+		return m.TxId
 	}
-	return out, nil
+	return 0
 }
 
-// WalletUnlockerServer is the server API for WalletUnlocker service.
-type WalletUnlockerServer interface {
-	// *
-	// GenSeed is the first method that should be used to instantiate a new lnd
-	// instance. This method allows a caller to generate a new aezeed cipher seed
-	// given an optional passphrase. If provided, the passphrase will be necessary
-	// to decrypt the cipherseed to expose the internal wallet seed.
-	//
-	// Once the cipherseed is obtained and verified by the user, the InitWallet
-	// method should be used to commit the newly generated seed, and create the
-	// wallet.
-	GenSeed(context.Context, *GenSeedRequest) (*GenSeedResponse, error)
-	// *
-	// InitWallet is used when lnd is starting up for the first time to fully
-	// initialize the daemon and its internal wallet. At the very least a wallet
-	// password must be provided. This will be used to encrypt sensitive material
-	// on disk.
-	//
-	// In the case of a recovery scenario, the user can also specify their aezeed
-	// mnemonic and passphrase. If set, then the daemon will use this prior state
-	// to initialize its internal wallet.
-	//
-	// Alternatively, this can be used along with the GenSeed RPC to obtain a
-	// seed, then present it to the user. Once it has been verified by the user,
-	// the seed can be fed into this RPC in order to commit the new wallet.
-	InitWallet(context.Context, *InitWalletRequest) (*InitWalletResponse, error)
-	// * lncli: `unlock`
-	// UnlockWallet is used at startup of lnd to provide a password to unlock
-	// the wallet database.
-	UnlockWallet(context.Context, *UnlockWalletRequest) (*UnlockWalletResponse, error)
-	// * lncli: `changepassword`
-	// ChangePassword changes the password of the encrypted wallet. This will
-	// automatically unlock the wallet database if successful.
-	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
+type BatchOpenChannelRequest struct {
+	// / The list of channels to open.
+	Channels []*BatchOpenChannel `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	// / The target number of blocks that the funding transaction should be confirmed by.
+	TargetConf int32 `protobuf:"varint,2,opt,name=target_conf,json=targetConf,proto3" json:"target_conf,omitempty"`
+	// / A manual fee rate set in atom/byte that should be used when crafting the funding transaction.
+	AtomsPerByte int64 `protobuf:"varint,3,opt,name=atoms_per_byte,json=atomsPerByte,proto3" json:"atoms_per_byte,omitempty"`
+	// / The minimum number of confirmations each one of your outputs used for the funding transaction must satisfy.
+	MinConfs int32 `protobuf:"varint,4,opt,name=min_confs,proto3" json:"min_confs,omitempty"`
+	// / Whether unconfirmed outputs should be used as inputs for the funding transaction.
+	SpendUnconfirmed     bool     `protobuf:"varint,5,opt,name=spend_unconfirmed,proto3" json:"spend_unconfirmed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func RegisterWalletUnlockerServer(s *grpc.Server, srv WalletUnlockerServer) {
-	s.RegisterService(&_WalletUnlocker_serviceDesc, srv)
+func (m *BatchOpenChannelRequest) Reset()         { *m = BatchOpenChannelRequest{} }
+func (m *BatchOpenChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchOpenChannelRequest) ProtoMessage()    {}
+func (*BatchOpenChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{163}
+}
+func (m *BatchOpenChannelRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchOpenChannelRequest.Unmarshal(m, b)
+}
+func (m *BatchOpenChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchOpenChannelRequest.Marshal(b, m, deterministic)
+}
+func (dst *BatchOpenChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchOpenChannelRequest.Merge(dst, src)
+}
+func (m *BatchOpenChannelRequest) XXX_Size() int {
+	return xxx_messageInfo_BatchOpenChannelRequest.Size(m)
+}
+func (m *BatchOpenChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchOpenChannelRequest.DiscardUnknown(m)
 }
 
-func _WalletUnlocker_GenSeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenSeedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WalletUnlockerServer).GenSeed(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lnrpc.WalletUnlocker/GenSeed",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletUnlockerServer).GenSeed(ctx, req.(*GenSeedRequest))
+var xxx_messageInfo_BatchOpenChannelRequest proto.InternalMessageInfo
+
+func (m *BatchOpenChannelRequest) GetChannels() []*BatchOpenChannel {
+	if m != nil {
+		return m.Channels
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _WalletUnlocker_InitWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InitWalletRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WalletUnlockerServer).InitWallet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lnrpc.WalletUnlocker/InitWallet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletUnlockerServer).InitWallet(ctx, req.(*InitWalletRequest))
+func (m *BatchOpenChannelRequest) GetTargetConf() int32 {
+	if m != nil {
+		return m.TargetConf
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _WalletUnlocker_UnlockWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnlockWalletRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WalletUnlockerServer).UnlockWallet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lnrpc.WalletUnlocker/UnlockWallet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletUnlockerServer).UnlockWallet(ctx, req.(*UnlockWalletRequest))
+func (m *BatchOpenChannelRequest) GetAtomsPerByte() int64 {
+	if m != nil {
+		return m.AtomsPerByte
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _WalletUnlocker_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChangePasswordRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WalletUnlockerServer).ChangePassword(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lnrpc.WalletUnlocker/ChangePassword",
+func (m *BatchOpenChannelRequest) GetMinConfs() int32 {
+	if m != nil {
+		return m.MinConfs
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletUnlockerServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	return 0
+}
+
+func (m *BatchOpenChannelRequest) GetSpendUnconfirmed() bool {
+	if m != nil {
+		return m.SpendUnconfirmed
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-var _WalletUnlocker_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "lnrpc.WalletUnlocker",
-	HandlerType: (*WalletUnlockerServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GenSeed",
-			Handler:    _WalletUnlocker_GenSeed_Handler,
-		},
-		{
-			MethodName: "InitWallet",
-			Handler:    _WalletUnlocker_InitWallet_Handler,
-		},
-		{
-			MethodName: "UnlockWallet",
-			Handler:    _WalletUnlocker_UnlockWallet_Handler,
-		},
-		{
-			MethodName: "ChangePassword",
-			Handler:    _WalletUnlocker_ChangePassword_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "rpc.proto",
+type BatchOpenChannel struct {
+	// / The pubkey of the node to open a channel with
+	NodePubkey []byte `protobuf:"bytes,1,opt,name=node_pubkey,proto3" json:"node_pubkey,omitempty"`
+	// / The number of atoms the wallet should commit to the channel
+	LocalFundingAmount int64 `protobuf:"varint,2,opt,name=local_funding_amount,proto3" json:"local_funding_amount,omitempty"`
+	// / The number of atoms to push to the remote side as part of the initial commitment state
+	PushAtoms int64 `protobuf:"varint,3,opt,name=push_atoms,proto3" json:"push_atoms,omitempty"`
+	// / Whether this channel should be private, not announced to the greater network.
+	Private bool `protobuf:"varint,4,opt,name=private,proto3" json:"private,omitempty"`
+	// / The minimum value in MilliAtom we will require for incoming HTLCs on the channel.
+	MinHtlcMAtoms int64 `protobuf:"varint,5,opt,name=min_htlc_m_atoms,proto3" json:"min_htlc_m_atoms,omitempty"`
+	// / The delay we require on the remote's commitment transaction. If this is not set, it will be scaled automatically with the channel size.
+	RemoteCsvDelay       uint32   `protobuf:"varint,6,opt,name=remote_csv_delay,proto3" json:"remote_csv_delay,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// LightningClient is the client API for Lightning service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type LightningClient interface {
-	// * lncli: `walletbalance`
-	// WalletBalance returns total unspent outputs(confirmed and unconfirmed), all
-	// confirmed unspent outputs and all unconfirmed unspent outputs under control
-	// of the wallet.
-	WalletBalance(ctx context.Context, in *WalletBalanceRequest, opts ...grpc.CallOption) (*WalletBalanceResponse, error)
-	// * lncli: `channelbalance`
-	// ChannelBalance returns the total funds available across all open channels
-	// in atoms.
-	ChannelBalance(ctx context.Context, in *ChannelBalanceRequest, opts ...grpc.CallOption) (*ChannelBalanceResponse, error)
-	// * lncli: `listchaintxns`
-	// GetTransactions returns a list describing all the known transactions
-	// relevant to the wallet.
-	GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*TransactionDetails, error)
-	// * lncli: `estimatefee`
-	// EstimateFee asks the chain backend to estimate the fee rate and total fees
-	// for a transaction that pays to multiple specified outputs.
-	EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error)
-	// * lncli: `sendcoins`
-	// SendCoins executes a request to send coins to a particular address. Unlike
-	// SendMany, this RPC call only allows creating a single output at a time. If
-	// neither target_conf, or atoms_per_byte are set, then the internal wallet will
-	// consult its fee model to determine a fee for the default confirmation
-	// target.
-	SendCoins(ctx context.Context, in *SendCoinsRequest, opts ...grpc.CallOption) (*SendCoinsResponse, error)
-	// * lncli: `listunspent`
-	// ListUnspent returns a list of all utxos spendable by the wallet with a
-	// number of confirmations between the specified minimum and maximum.
-	ListUnspent(ctx context.Context, in *ListUnspentRequest, opts ...grpc.CallOption) (*ListUnspentResponse, error)
+func (m *BatchOpenChannel) Reset()         { *m = BatchOpenChannel{} }
+func (m *BatchOpenChannel) String() string { return proto.CompactTextString(m) }
+func (*BatchOpenChannel) ProtoMessage()    {}
+func (*BatchOpenChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{164}
+}
+func (m *BatchOpenChannel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchOpenChannel.Unmarshal(m, b)
+}
+func (m *BatchOpenChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchOpenChannel.Marshal(b, m, deterministic)
+}
+func (dst *BatchOpenChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchOpenChannel.Merge(dst, src)
+}
+func (m *BatchOpenChannel) XXX_Size() int {
+	return xxx_messageInfo_BatchOpenChannel.Size(m)
+}
+func (m *BatchOpenChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchOpenChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchOpenChannel proto.InternalMessageInfo
+
+func (m *BatchOpenChannel) GetNodePubkey() []byte {
+	if m != nil {
+		return m.NodePubkey
+	}
+	return nil
+}
+
+func (m *BatchOpenChannel) GetLocalFundingAmount() int64 {
+	if m != nil {
+		return m.LocalFundingAmount
+	}
+	return 0
+}
+
+func (m *BatchOpenChannel) GetPushAtoms() int64 {
+	if m != nil {
+		return m.PushAtoms
+	}
+	return 0
+}
+
+func (m *BatchOpenChannel) GetPrivate() bool {
+	if m != nil {
+		return m.Private
+	}
+	return false
+}
+
+func (m *BatchOpenChannel) GetMinHtlcMAtoms() int64 {
+	if m != nil {
+		return m.MinHtlcMAtoms
+	}
+	return 0
+}
+
+func (m *BatchOpenChannel) GetRemoteCsvDelay() uint32 {
+	if m != nil {
+		return m.RemoteCsvDelay
+	}
+	return 0
+}
+
+type BatchOpenChannelResponse struct {
+	// / The pending channels of the batch, in the order of the request.
+	PendingChannels      []*PendingUpdate `protobuf:"bytes,1,rep,name=pending_channels,proto3" json:"pending_channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *BatchOpenChannelResponse) Reset()         { *m = BatchOpenChannelResponse{} }
+func (m *BatchOpenChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchOpenChannelResponse) ProtoMessage()    {}
+func (*BatchOpenChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{165}
+}
+func (m *BatchOpenChannelResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchOpenChannelResponse.Unmarshal(m, b)
+}
+func (m *BatchOpenChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchOpenChannelResponse.Marshal(b, m, deterministic)
+}
+func (dst *BatchOpenChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchOpenChannelResponse.Merge(dst, src)
+}
+func (m *BatchOpenChannelResponse) XXX_Size() int {
+	return xxx_messageInfo_BatchOpenChannelResponse.Size(m)
+}
+func (m *BatchOpenChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchOpenChannelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchOpenChannelResponse proto.InternalMessageInfo
+
+func (m *BatchOpenChannelResponse) GetPendingChannels() []*PendingUpdate {
+	if m != nil {
+		return m.PendingChannels
+	}
+	return nil
+}
+
+type BumpFundingFeeRequest struct {
+	// / The pending channel whose funding transaction should be bumped.
+	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
 	// *
-	// SubscribeTransactions creates a uni-directional stream from the server to
-	// the client in which any newly discovered transactions relevant to the
-	// wallet are sent over.
-	SubscribeTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (Lightning_SubscribeTransactionsClient, error)
-	// * lncli: `sendmany`
-	// SendMany handles a request for a transaction that creates multiple specified
-	// outputs in parallel. If neither target_conf, or atoms_per_byte are set, then
-	// the internal wallet will consult its fee model to determine a fee for the
-	// default confirmation target.
-	SendMany(ctx context.Context, in *SendManyRequest, opts ...grpc.CallOption) (*SendManyResponse, error)
-	// * lncli: `newaddress`
-	// NewAddress creates a new address under control of the local wallet.
-	NewAddress(ctx context.Context, in *NewAddressRequest, opts ...grpc.CallOption) (*NewAddressResponse, error)
-	// * lncli: `signmessage`
-	// SignMessage signs a message with this node's private key. The returned
-	// signature string is `zbase32` encoded and pubkey recoverable, meaning that
-	// only the message digest and signature are needed for verification.
-	SignMessage(ctx context.Context, in *SignMessageRequest, opts ...grpc.CallOption) (*SignMessageResponse, error)
-	// * lncli: `verifymessage`
-	// VerifyMessage verifies a signature over a msg. The signature must be
-	// zbase32 encoded and signed by an active node in the resident node's
-	// channel database. In addition to returning the validity of the signature,
-	// VerifyMessage also returns the recovered pubkey from the signature.
-	VerifyMessage(ctx context.Context, in *VerifyMessageRequest, opts ...grpc.CallOption) (*VerifyMessageResponse, error)
-	// * lncli: `connect`
-	// ConnectPeer attempts to establish a connection to a remote peer. This is at
-	// the networking level, and is used for communication between nodes. This is
-	// distinct from establishing a channel with a peer.
-	ConnectPeer(ctx context.Context, in *ConnectPeerRequest, opts ...grpc.CallOption) (*ConnectPeerResponse, error)
-	// * lncli: `disconnect`
-	// DisconnectPeer attempts to disconnect one peer from another identified by a
-	// given pubKey. In the case that we currently have a pending or active channel
-	// with the target peer, then this action will be not be allowed.
-	DisconnectPeer(ctx context.Context, in *DisconnectPeerRequest, opts ...grpc.CallOption) (*DisconnectPeerResponse, error)
-	// * lncli: `listpeers`
-	// ListPeers returns a verbose listing of all currently active peers.
-	ListPeers(ctx context.Context, in *ListPeersRequest, opts ...grpc.CallOption) (*ListPeersResponse, error)
-	// * lncli: `getinfo`
-	// GetInfo returns general information concerning the lightning node including
-	// it's identity pubkey, alias, the chains it is connected to, and information
-	// concerning the number of open+pending channels.
-	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
-	// * lncli: `pendingchannels`
-	// PendingChannels returns a list of all the channels that are currently
-	// considered "pending". A channel is pending if it has finished the funding
-	// workflow and is waiting for confirmations for the funding txn, or is in the
-	// process of closure, either initiated cooperatively or non-cooperatively.
-	PendingChannels(ctx context.Context, in *PendingChannelsRequest, opts ...grpc.CallOption) (*PendingChannelsResponse, error)
-	// * lncli: `listchannels`
-	// ListChannels returns a description of all the open channels that this node
-	// is a participant in.
-	ListChannels(ctx context.Context, in *ListChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error)
+	// The target number of blocks that the funding transaction should be
+	// confirmed by.
+	TargetConf int32 `protobuf:"varint,2,opt,name=target_conf,proto3" json:"target_conf,omitempty"`
 	// *
-	// SubscribeChannelEvents creates a uni-directional stream from the server to
-	// the client in which any updates relevant to the state of the channels are
-	// sent over. Events include new active channels, inactive channels, and closed
-	// channels.
-	SubscribeChannelEvents(ctx context.Context, in *ChannelEventSubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelEventsClient, error)
-	// * lncli: `closedchannels`
-	// ClosedChannels returns a description of all the closed channels that
-	// this node was a participant in.
-	ClosedChannels(ctx context.Context, in *ClosedChannelsRequest, opts ...grpc.CallOption) (*ClosedChannelsResponse, error)
+	// A manual fee rate set in atom/byte that should be used when sweeping the
+	// output of the funding transaction.
+	AtomsPerByte         int64    `protobuf:"varint,3,opt,name=atoms_per_byte,proto3" json:"atoms_per_byte,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BumpFundingFeeRequest) Reset()         { *m = BumpFundingFeeRequest{} }
+func (m *BumpFundingFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*BumpFundingFeeRequest) ProtoMessage()    {}
+func (*BumpFundingFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{166}
+}
+func (m *BumpFundingFeeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BumpFundingFeeRequest.Unmarshal(m, b)
+}
+func (m *BumpFundingFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BumpFundingFeeRequest.Marshal(b, m, deterministic)
+}
+func (dst *BumpFundingFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BumpFundingFeeRequest.Merge(dst, src)
+}
+func (m *BumpFundingFeeRequest) XXX_Size() int {
+	return xxx_messageInfo_BumpFundingFeeRequest.Size(m)
+}
+func (m *BumpFundingFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BumpFundingFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BumpFundingFeeRequest proto.InternalMessageInfo
+
+func (m *BumpFundingFeeRequest) GetChannelPoint() *ChannelPoint {
+	if m != nil {
+		return m.ChannelPoint
+	}
+	return nil
+}
+
+func (m *BumpFundingFeeRequest) GetTargetConf() int32 {
+	if m != nil {
+		return m.TargetConf
+	}
+	return 0
+}
+
+func (m *BumpFundingFeeRequest) GetAtomsPerByte() int64 {
+	if m != nil {
+		return m.AtomsPerByte
+	}
+	return 0
+}
+
+type BumpFundingFeeResponse struct {
 	// *
-	// OpenChannelSync is a synchronous version of the OpenChannel RPC call. This
-	// call is meant to be consumed by clients to the REST proxy. As with all
-	// other sync calls, all byte slices are intended to be populated as hex
-	// encoded strings.
-	OpenChannelSync(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (*ChannelPoint, error)
-	// * lncli: `openchannel`
-	// OpenChannel attempts to open a singly funded channel specified in the
-	// request to a remote peer. Users are able to specify a target number of
-	// blocks that the funding transaction should be confirmed in, or a manual fee
-	// rate to us for the funding transaction. If neither are specified, then a
-	// lax block confirmation target is used.
-	OpenChannel(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (Lightning_OpenChannelClient, error)
+	// The output of the funding transaction that is swept to bump its fee, in
+	// the form txid:output_index.
+	SweptOutpoint        string   `protobuf:"bytes,1,opt,name=swept_outpoint,proto3" json:"swept_outpoint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BumpFundingFeeResponse) Reset()         { *m = BumpFundingFeeResponse{} }
+func (m *BumpFundingFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*BumpFundingFeeResponse) ProtoMessage()    {}
+func (*BumpFundingFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{167}
+}
+func (m *BumpFundingFeeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BumpFundingFeeResponse.Unmarshal(m, b)
+}
+func (m *BumpFundingFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BumpFundingFeeResponse.Marshal(b, m, deterministic)
+}
+func (dst *BumpFundingFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BumpFundingFeeResponse.Merge(dst, src)
+}
+func (m *BumpFundingFeeResponse) XXX_Size() int {
+	return xxx_messageInfo_BumpFundingFeeResponse.Size(m)
+}
+func (m *BumpFundingFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BumpFundingFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BumpFundingFeeResponse proto.InternalMessageInfo
+
+func (m *BumpFundingFeeResponse) GetSweptOutpoint() string {
+	if m != nil {
+		return m.SweptOutpoint
+	}
+	return ""
+}
+
+type RebroadcastLimboOutputRequest struct {
+	// / The output in limbo whose sweep should be re-broadcast.
+	Outpoint *OutPoint `protobuf:"bytes,1,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
 	// *
-	// ChannelAcceptor dispatches a bi-directional streaming RPC in which
-	// OpenChannel requests are sent to the client and the client responds with
-	// a boolean that tells LND whether or not to accept the channel. This allows
-	// node operators to specify their own criteria for accepting inbound channels
-	// through a single persistent connection.
-	ChannelAcceptor(ctx context.Context, opts ...grpc.CallOption) (Lightning_ChannelAcceptorClient, error)
-	// * lncli: `closechannel`
-	// CloseChannel attempts to close an active channel identified by its channel
-	// outpoint (ChannelPoint). The actions of this method can additionally be
-	// augmented to attempt a force close after a timeout period in the case of an
-	// inactive peer. If a non-force close (cooperative closure) is requested,
-	// then the user can specify either a target number of blocks until the
-	// closure transaction is confirmed, or a manual fee rate. If neither are
-	// specified, then a default lax, block confirmation target is used.
-	CloseChannel(ctx context.Context, in *CloseChannelRequest, opts ...grpc.CallOption) (Lightning_CloseChannelClient, error)
-	// * lncli: `abandonchannel`
-	// AbandonChannel removes all channel state from the database except for a
-	// close summary. This method can be used to get rid of permanently unusable
-	// channels due to bugs fixed in newer versions of lnd. Only available
-	// when in debug builds of lnd.
-	AbandonChannel(ctx context.Context, in *AbandonChannelRequest, opts ...grpc.CallOption) (*AbandonChannelResponse, error)
-	// * lncli: `sendpayment`
-	// SendPayment dispatches a bi-directional streaming RPC for sending payments
-	// through the Lightning Network. A single RPC invocation creates a persistent
-	// bi-directional stream allowing clients to rapidly send payments through the
-	// Lightning Network with a single persistent connection.
-	SendPayment(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendPaymentClient, error)
+	// The target number of blocks that the new sweep transaction should be
+	// confirmed by. Not allowed for outputs swept by a timeout transaction.
+	TargetConf int32 `protobuf:"varint,2,opt,name=target_conf,proto3" json:"target_conf,omitempty"`
 	// *
-	// SendPaymentSync is the synchronous non-streaming version of SendPayment.
-	// This RPC is intended to be consumed by clients of the REST proxy.
-	// Additionally, this RPC expects the destination's public key and the payment
-	// hash (if any) to be encoded as hex strings.
-	SendPaymentSync(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*SendResponse, error)
-	// * lncli: `sendtoroute`
-	// SendToRoute is a bi-directional streaming RPC for sending payment through
-	// the Lightning Network. This method differs from SendPayment in that it
-	// allows users to specify a full route manually. This can be used for things
-	// like rebalancing, and atomic swaps.
-	SendToRoute(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendToRouteClient, error)
+	// A manual fee rate set in atom/byte that should be used by the new sweep
+	// transaction. Not allowed for outputs swept by a timeout transaction.
+	AtomsPerByte         int64    `protobuf:"varint,3,opt,name=atoms_per_byte,proto3" json:"atoms_per_byte,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RebroadcastLimboOutputRequest) Reset()         { *m = RebroadcastLimboOutputRequest{} }
+func (m *RebroadcastLimboOutputRequest) String() string { return proto.CompactTextString(m) }
+func (*RebroadcastLimboOutputRequest) ProtoMessage()    {}
+func (*RebroadcastLimboOutputRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{168}
+}
+func (m *RebroadcastLimboOutputRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RebroadcastLimboOutputRequest.Unmarshal(m, b)
+}
+func (m *RebroadcastLimboOutputRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RebroadcastLimboOutputRequest.Marshal(b, m, deterministic)
+}
+func (dst *RebroadcastLimboOutputRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebroadcastLimboOutputRequest.Merge(dst, src)
+}
+func (m *RebroadcastLimboOutputRequest) XXX_Size() int {
+	return xxx_messageInfo_RebroadcastLimboOutputRequest.Size(m)
+}
+func (m *RebroadcastLimboOutputRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebroadcastLimboOutputRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebroadcastLimboOutputRequest proto.InternalMessageInfo
+
+func (m *RebroadcastLimboOutputRequest) GetOutpoint() *OutPoint {
+	if m != nil {
+		return m.Outpoint
+	}
+	return nil
+}
+
+func (m *RebroadcastLimboOutputRequest) GetTargetConf() int32 {
+	if m != nil {
+		return m.TargetConf
+	}
+	return 0
+}
+
+func (m *RebroadcastLimboOutputRequest) GetAtomsPerByte() int64 {
+	if m != nil {
+		return m.AtomsPerByte
+	}
+	return 0
+}
+
+type RebroadcastLimboOutputResponse struct {
 	// *
-	// SendToRouteSync is a synchronous version of SendToRoute. It Will block
-	// until the payment either fails or succeeds.
-	SendToRouteSync(ctx context.Context, in *SendToRouteRequest, opts ...grpc.CallOption) (*SendResponse, error)
-	// * lncli: `addinvoice`
-	// AddInvoice attempts to add a new invoice to the invoice database. Any
-	// duplicated invoices are rejected, therefore all invoices *must* have a
-	// unique payment preimage.
-	AddInvoice(ctx context.Context, in *Invoice, opts ...grpc.CallOption) (*AddInvoiceResponse, error)
-	// * lncli: `listinvoices`
-	// ListInvoices returns a list of all the invoices currently stored within the
-	// database. Any active debug invoices are ignored. It has full support for
-	// paginated responses, allowing users to query for specific invoices through
-	// their add_index. This can be done by using either the first_index_offset or
-	// last_index_offset fields included in the response as the index_offset of the
-	// next request. By default, the first 100 invoices created will be returned.
-	// Backwards pagination is also supported through the Reversed flag.
-	ListInvoices(ctx context.Context, in *ListInvoiceRequest, opts ...grpc.CallOption) (*ListInvoiceResponse, error)
-	// * lncli: `lookupinvoice`
-	// LookupInvoice attempts to look up an invoice according to its payment hash.
-	// The passed payment hash *must* be exactly 32 bytes, if not, an error is
-	// returned.
-	LookupInvoice(ctx context.Context, in *PaymentHash, opts ...grpc.CallOption) (*Invoice, error)
+	// The txid of the timeout transaction published again, if the output is
+	// swept by one.
+	TimeoutTxid          string   `protobuf:"bytes,1,opt,name=timeout_txid,proto3" json:"timeout_txid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RebroadcastLimboOutputResponse) Reset()         { *m = RebroadcastLimboOutputResponse{} }
+func (m *RebroadcastLimboOutputResponse) String() string { return proto.CompactTextString(m) }
+func (*RebroadcastLimboOutputResponse) ProtoMessage()    {}
+func (*RebroadcastLimboOutputResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{169}
+}
+func (m *RebroadcastLimboOutputResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RebroadcastLimboOutputResponse.Unmarshal(m, b)
+}
+func (m *RebroadcastLimboOutputResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RebroadcastLimboOutputResponse.Marshal(b, m, deterministic)
+}
+func (dst *RebroadcastLimboOutputResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebroadcastLimboOutputResponse.Merge(dst, src)
+}
+func (m *RebroadcastLimboOutputResponse) XXX_Size() int {
+	return xxx_messageInfo_RebroadcastLimboOutputResponse.Size(m)
+}
+func (m *RebroadcastLimboOutputResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebroadcastLimboOutputResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebroadcastLimboOutputResponse proto.InternalMessageInfo
+
+func (m *RebroadcastLimboOutputResponse) GetTimeoutTxid() string {
+	if m != nil {
+		return m.TimeoutTxid
+	}
+	return ""
+}
+
+type LimboOutput struct {
+	// / The output whose funds are in limbo.
+	Outpoint string `protobuf:"bytes,1,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	// / The value of the output in atoms.
+	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// / The kind of time lock that keeps the funds of the output in limbo.
+	LimboClass LimboOutput_LimboClass `protobuf:"varint,3,opt,name=limbo_class,proto3,enum=lnrpc.LimboOutput_LimboClass" json:"limbo_class,omitempty"`
 	// *
-	// SubscribeInvoices returns a uni-directional stream (server -> client) for
-	// notifying the client of newly added/settled invoices. The caller can
-	// optionally specify the add_index and/or the settle_index. If the add_index
-	// is specified, then we'll first start by sending add invoice events for all
-	// invoices with an add_index greater than the specified value.  If the
-	// settle_index is specified, the next, we'll send out all settle events for
-	// invoices with a settle_index greater than the specified value.  One or both
-	// of these fields can be set. If no fields are set, then we'll only send out
-	// the latest add/settle events.
-	SubscribeInvoices(ctx context.Context, in *InvoiceSubscription, opts ...grpc.CallOption) (Lightning_SubscribeInvoicesClient, error)
-	// * lncli: `decodepayreq`
-	// DecodePayReq takes an encoded payment request string and attempts to decode
-	// it, returning a full description of the conditions encoded within the
-	// payment request.
-	DecodePayReq(ctx context.Context, in *PayReqString, opts ...grpc.CallOption) (*PayReq, error)
-	// * lncli: `listpayments`
-	// ListPayments returns a list of all outgoing payments.
-	ListPayments(ctx context.Context, in *ListPaymentsRequest, opts ...grpc.CallOption) (*ListPaymentsResponse, error)
+	// The block height at which the current stage of the output is over. Zero
+	// if it isn't known yet.
+	MaturityHeight uint32 `protobuf:"varint,4,opt,name=maturity_height,proto3" json:"maturity_height,omitempty"`
 	// *
-	// DeleteAllPayments deletes all outgoing payments from DB.
-	DeleteAllPayments(ctx context.Context, in *DeleteAllPaymentsRequest, opts ...grpc.CallOption) (*DeleteAllPaymentsResponse, error)
-	// * lncli: `describegraph`
-	// DescribeGraph returns a description of the latest graph state from the
-	// point of view of the node. The graph information is partitioned into two
-	// components: all the nodes/vertexes, and all the edges that connect the
-	// vertexes themselves.  As this is a directed graph, the edges also contain
-	// the node directional specific routing policy which includes: the time lock
-	// delta, fee information, etc.
-	DescribeGraph(ctx context.Context, in *ChannelGraphRequest, opts ...grpc.CallOption) (*ChannelGraph, error)
-	// * lncli: `getchaninfo`
-	// GetChanInfo returns the latest authenticated network announcement for the
-	// given channel identified by its channel ID: an 8-byte integer which
-	// uniquely identifies the location of transaction's funding output within the
-	// blockchain.
-	GetChanInfo(ctx context.Context, in *ChanInfoRequest, opts ...grpc.CallOption) (*ChannelEdge, error)
-	// * lncli: `getnodeinfo`
-	// GetNodeInfo returns the latest advertised, aggregated, and authenticated
-	// channel information for the specified node identified by its public key.
-	GetNodeInfo(ctx context.Context, in *NodeInfoRequest, opts ...grpc.CallOption) (*NodeInfo, error)
-	// * lncli: `queryroutes`
-	// QueryRoutes attempts to query the daemon's Channel Router for a possible
-	// route to a target destination capable of carrying a specific amount of
-	// atoms. The retuned route contains the full details required to craft and
-	// send an HTLC, also including the necessary information that should be
-	// present within the Sphinx packet encapsulated within the HTLC.
-	QueryRoutes(ctx context.Context, in *QueryRoutesRequest, opts ...grpc.CallOption) (*QueryRoutesResponse, error)
-	// * lncli: `getnetworkinfo`
-	// GetNetworkInfo returns some basic stats about the known channel graph from
-	// the point of view of the node.
-	GetNetworkInfo(ctx context.Context, in *NetworkInfoRequest, opts ...grpc.CallOption) (*NetworkInfo, error)
-	// * lncli: `stop`
-	// StopDaemon will send a shutdown request to the interrupt handler, triggering
-	// a graceful shutdown of the daemon.
-	StopDaemon(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error)
+	// The number of blocks remaining until the current stage is over. Negative
+	// values indicate how many blocks have passed since then.
+	BlocksTilMaturity int32 `protobuf:"varint,5,opt,name=blocks_til_maturity,proto3" json:"blocks_til_maturity,omitempty"`
 	// *
-	// SubscribeChannelGraph launches a streaming RPC that allows the caller to
-	// receive notifications upon any changes to the channel graph topology from
-	// the point of view of the responding node. Events notified include: new
-	// nodes coming online, nodes updating their authenticated attributes, new
-	// channels being advertised, updates in the routing policy for a directional
-	// channel edge, and when channels are closed on-chain.
-	SubscribeChannelGraph(ctx context.Context, in *GraphTopologySubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelGraphClient, error)
-	// * lncli: `debuglevel`
-	// DebugLevel allows a caller to programmatically set the logging verbosity of
-	// lnd. The logging can be targeted according to a coarse daemon-wide logging
-	// level, or in a granular fashion to specify the logging for a target
-	// sub-system.
-	DebugLevel(ctx context.Context, in *DebugLevelRequest, opts ...grpc.CallOption) (*DebugLevelResponse, error)
-	// * lncli: `feereport`
-	// FeeReport allows the caller to obtain a report detailing the current fee
-	// schedule enforced by the node globally for each channel.
-	FeeReport(ctx context.Context, in *FeeReportRequest, opts ...grpc.CallOption) (*FeeReportResponse, error)
-	// * lncli: `updatechanpolicy`
-	// UpdateChannelPolicy allows the caller to update the fee schedule and
-	// channel policies for all channels globally, or a particular channel.
-	UpdateChannelPolicy(ctx context.Context, in *PolicyUpdateRequest, opts ...grpc.CallOption) (*PolicyUpdateResponse, error)
-	// * lncli: `fwdinghistory`
-	// ForwardingHistory allows the caller to query the htlcswitch for a record of
-	// all HTLCs forwarded within the target time range, and integer offset
-	// within that time range. If no time-range is specified, then the first chunk
-	// of the past 24 hrs of forwarding history are returned.
-	//
-	// A list of forwarding events are returned. The size of each forwarding event
-	// is 40 bytes, and the max message size able to be returned in gRPC is 4 MiB.
-	// As a result each message can only contain 50k entries.  Each response has
-	// the index offset of the last entry. The index offset can be provided to the
-	// request to allow the caller to skip a series of records.
-	ForwardingHistory(ctx context.Context, in *ForwardingHistoryRequest, opts ...grpc.CallOption) (*ForwardingHistoryResponse, error)
-	// * lncli: `exportchanbackup`
-	// ExportChannelBackup attempts to return an encrypted static channel backup
-	// for the target channel identified by it channel point. The backup is
-	// encrypted with a key generated from the aezeed seed of the user. The
-	// returned backup can either be restored using the RestoreChannelBackup
-	// method once lnd is running, or via the InitWallet and UnlockWallet methods
-	// from the WalletUnlocker service.
-	ExportChannelBackup(ctx context.Context, in *ExportChannelBackupRequest, opts ...grpc.CallOption) (*ChannelBackup, error)
+	// The fee in atoms that sweeping the funds of the output back into the
+	// wallet is anticipated to cost at the current fee rate.
+	AnticipatedSweepFee int64 `protobuf:"varint,6,opt,name=anticipated_sweep_fee,proto3" json:"anticipated_sweep_fee,omitempty"`
+	// / What the output is waiting for before its funds can be swept.
+	ResolverStage LimboOutput_ResolverStage `protobuf:"varint,7,opt,name=resolver_stage,proto3,enum=lnrpc.LimboOutput_ResolverStage" json:"resolver_stage,omitempty"`
 	// *
-	// ExportAllChannelBackups returns static channel backups for all existing
-	// channels known to lnd. A set of regular singular static channel backups for
-	// each channel are returned. Additionally, a multi-channel backup is returned
-	// as well, which contains a single encrypted blob containing the backups of
-	// each channel.
-	ExportAllChannelBackups(ctx context.Context, in *ChanBackupExportRequest, opts ...grpc.CallOption) (*ChanBackupSnapshot, error)
+	// The txid of the pre-signed timeout transaction sweeping the output, if it
+	// is swept by one.
+	TimeoutTxid string `protobuf:"bytes,8,opt,name=timeout_txid,proto3" json:"timeout_txid,omitempty"`
 	// *
-	// VerifyChanBackup allows a caller to verify the integrity of a channel backup
-	// snapshot. This method will accept either a packed Single or a packed Multi.
-	// Specifying both will result in an error.
-	VerifyChanBackup(ctx context.Context, in *ChanBackupSnapshot, opts ...grpc.CallOption) (*VerifyChanBackupResponse, error)
-	// * lncli: `restorechanbackup`
-	// RestoreChannelBackups accepts a set of singular channel backups, or a
-	// single encrypted multi-chan backup and attempts to recover any funds
-	// remaining within the channel. If we are able to unpack the backup, then the
-	// new channel will be shown under listchannels, as well as pending channels.
-	RestoreChannelBackups(ctx context.Context, in *RestoreChanBackupRequest, opts ...grpc.CallOption) (*RestoreBackupResponse, error)
+	// The number of transactions sweeping the output broadcast so far. Only set
+	// for outputs being swept by the sweeper.
+	SweepBroadcastAttempts uint32 `protobuf:"varint,9,opt,name=sweep_broadcast_attempts,proto3" json:"sweep_broadcast_attempts,omitempty"`
 	// *
-	// SubscribeChannelBackups allows a client to sub-subscribe to the most up to
-	// date information concerning the state of all channel backups. Each time a
-	// new channel is added, we return the new set of channels, along with a
-	// multi-chan backup containing the backup info for all channels. Each time a
-	// channel is closed, we send a new update, which contains new new chan back
-	// ups, but the updated set of encrypted multi-chan backups with the closed
-	// channel(s) removed.
-	SubscribeChannelBackups(ctx context.Context, in *ChannelBackupSubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelBackupsClient, error)
+	// The fee rate, in atoms/byte, of the last transaction sweeping the output.
+	// Only set for outputs being swept by the sweeper.
+	SweepAtomsPerByte uint32 `protobuf:"varint,10,opt,name=sweep_atoms_per_byte,proto3" json:"sweep_atoms_per_byte,omitempty"`
+	// *
+	// The block height at which a new transaction sweeping the output will be
+	// broadcast. Only set for outputs being swept by the sweeper.
+	NextSweepBroadcastHeight uint32   `protobuf:"varint,11,opt,name=next_sweep_broadcast_height,proto3" json:"next_sweep_broadcast_height,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
+	XXX_unrecognized         []byte   `json:"-"`
+	XXX_sizecache            int32    `json:"-"`
 }
 
-type lightningClient struct {
-	cc *grpc.ClientConn
+func (m *LimboOutput) Reset()         { *m = LimboOutput{} }
+func (m *LimboOutput) String() string { return proto.CompactTextString(m) }
+func (*LimboOutput) ProtoMessage()    {}
+func (*LimboOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{170}
 }
-
-func NewLightningClient(cc *grpc.ClientConn) LightningClient {
-	return &lightningClient{cc}
+func (m *LimboOutput) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LimboOutput.Unmarshal(m, b)
+}
+func (m *LimboOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LimboOutput.Marshal(b, m, deterministic)
+}
+func (dst *LimboOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimboOutput.Merge(dst, src)
+}
+func (m *LimboOutput) XXX_Size() int {
+	return xxx_messageInfo_LimboOutput.Size(m)
+}
+func (m *LimboOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimboOutput.DiscardUnknown(m)
 }
 
-func (c *lightningClient) WalletBalance(ctx context.Context, in *WalletBalanceRequest, opts ...grpc.CallOption) (*WalletBalanceResponse, error) {
-	out := new(WalletBalanceResponse)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/WalletBalance", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_LimboOutput proto.InternalMessageInfo
+
+func (m *LimboOutput) GetOutpoint() string {
+	if m != nil {
+		return m.Outpoint
 	}
-	return out, nil
+	return ""
 }
 
-func (c *lightningClient) ChannelBalance(ctx context.Context, in *ChannelBalanceRequest, opts ...grpc.CallOption) (*ChannelBalanceResponse, error) {
-	out := new(ChannelBalanceResponse)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ChannelBalance", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LimboOutput) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*TransactionDetails, error) {
-	out := new(TransactionDetails)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetTransactions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LimboOutput) GetLimboClass() LimboOutput_LimboClass {
+	if m != nil {
+		return m.LimboClass
 	}
-	return out, nil
+	return LimboOutput_CSV
 }
 
-func (c *lightningClient) EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error) {
-	out := new(EstimateFeeResponse)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/EstimateFee", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LimboOutput) GetMaturityHeight() uint32 {
+	if m != nil {
+		return m.MaturityHeight
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) SendCoins(ctx context.Context, in *SendCoinsRequest, opts ...grpc.CallOption) (*SendCoinsResponse, error) {
-	out := new(SendCoinsResponse)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SendCoins", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LimboOutput) GetBlocksTilMaturity() int32 {
+	if m != nil {
+		return m.BlocksTilMaturity
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) ListUnspent(ctx context.Context, in *ListUnspentRequest, opts ...grpc.CallOption) (*ListUnspentResponse, error) {
-	out := new(ListUnspentResponse)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListUnspent", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LimboOutput) GetAnticipatedSweepFee() int64 {
+	if m != nil {
+		return m.AnticipatedSweepFee
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) SubscribeTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (Lightning_SubscribeTransactionsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[0], "/lnrpc.Lightning/SubscribeTransactions", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &lightningSubscribeTransactionsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *LimboOutput) GetResolverStage() LimboOutput_ResolverStage {
+	if m != nil {
+		return m.ResolverStage
 	}
-	return x, nil
-}
-
-type Lightning_SubscribeTransactionsClient interface {
-	Recv() (*Transaction, error)
-	grpc.ClientStream
-}
-
-type lightningSubscribeTransactionsClient struct {
-	grpc.ClientStream
+	return LimboOutput_AWAITING_CONFIRMATION
 }
 
-func (x *lightningSubscribeTransactionsClient) Recv() (*Transaction, error) {
-	m := new(Transaction)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *LimboOutput) GetTimeoutTxid() string {
+	if m != nil {
+		return m.TimeoutTxid
 	}
-	return m, nil
+	return ""
 }
 
-func (c *lightningClient) SendMany(ctx context.Context, in *SendManyRequest, opts ...grpc.CallOption) (*SendManyResponse, error) {
-	out := new(SendManyResponse)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SendMany", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LimboOutput) GetSweepBroadcastAttempts() uint32 {
+	if m != nil {
+		return m.SweepBroadcastAttempts
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) NewAddress(ctx context.Context, in *NewAddressRequest, opts ...grpc.CallOption) (*NewAddressResponse, error) {
-	out := new(NewAddressResponse)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/NewAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LimboOutput) GetSweepAtomsPerByte() uint32 {
+	if m != nil {
+		return m.SweepAtomsPerByte
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) SignMessage(ctx context.Context, in *SignMessageRequest, opts ...grpc.CallOption) (*SignMessageResponse, error) {
-	out := new(SignMessageResponse)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SignMessage", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LimboOutput) GetNextSweepBroadcastHeight() uint32 {
+	if m != nil {
+		return m.NextSweepBroadcastHeight
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) VerifyMessage(ctx context.Context, in *VerifyMessageRequest, opts ...grpc.CallOption) (*VerifyMessageResponse, error) {
-	out := new(VerifyMessageResponse)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/VerifyMessage", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type SendCustomMessageRequest struct {
+	// / The public key of the peer to send the message to.
+	Peer []byte `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
+	// *
+	// The type of the message. It must be within the custom range, starting at
+	// 32768.
+	Type uint32 `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	// / The opaque payload of the message.
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *lightningClient) ConnectPeer(ctx context.Context, in *ConnectPeerRequest, opts ...grpc.CallOption) (*ConnectPeerResponse, error) {
-	out := new(ConnectPeerResponse)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ConnectPeer", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *SendCustomMessageRequest) Reset()         { *m = SendCustomMessageRequest{} }
+func (m *SendCustomMessageRequest) String() string { return proto.CompactTextString(m) }
+func (*SendCustomMessageRequest) ProtoMessage()    {}
+func (*SendCustomMessageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{171}
+}
+func (m *SendCustomMessageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendCustomMessageRequest.Unmarshal(m, b)
+}
+func (m *SendCustomMessageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendCustomMessageRequest.Marshal(b, m, deterministic)
+}
+func (dst *SendCustomMessageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendCustomMessageRequest.Merge(dst, src)
+}
+func (m *SendCustomMessageRequest) XXX_Size() int {
+	return xxx_messageInfo_SendCustomMessageRequest.Size(m)
+}
+func (m *SendCustomMessageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendCustomMessageRequest.DiscardUnknown(m)
 }
 
-func (c *lightningClient) DisconnectPeer(ctx context.Context, in *DisconnectPeerRequest, opts ...grpc.CallOption) (*DisconnectPeerResponse, error) {
-	out := new(DisconnectPeerResponse)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/DisconnectPeer", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SendCustomMessageRequest proto.InternalMessageInfo
+
+func (m *SendCustomMessageRequest) GetPeer() []byte {
+	if m != nil {
+		return m.Peer
 	}
-	return out, nil
+	return nil
 }
 
-func (c *lightningClient) ListPeers(ctx context.Context, in *ListPeersRequest, opts ...grpc.CallOption) (*ListPeersResponse, error) {
-	out := new(ListPeersResponse)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListPeers", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SendCustomMessageRequest) GetType() uint32 {
+	if m != nil {
+		return m.Type
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error) {
-	out := new(GetInfoResponse)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SendCustomMessageRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return out, nil
+	return nil
 }
 
-func (c *lightningClient) PendingChannels(ctx context.Context, in *PendingChannelsRequest, opts ...grpc.CallOption) (*PendingChannelsResponse, error) {
-	out := new(PendingChannelsResponse)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/PendingChannels", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type SendCustomMessageResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *lightningClient) ListChannels(ctx context.Context, in *ListChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error) {
-	out := new(ListChannelsResponse)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListChannels", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *SendCustomMessageResponse) Reset()         { *m = SendCustomMessageResponse{} }
+func (m *SendCustomMessageResponse) String() string { return proto.CompactTextString(m) }
+func (*SendCustomMessageResponse) ProtoMessage()    {}
+func (*SendCustomMessageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{172}
+}
+func (m *SendCustomMessageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendCustomMessageResponse.Unmarshal(m, b)
+}
+func (m *SendCustomMessageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendCustomMessageResponse.Marshal(b, m, deterministic)
+}
+func (dst *SendCustomMessageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendCustomMessageResponse.Merge(dst, src)
+}
+func (m *SendCustomMessageResponse) XXX_Size() int {
+	return xxx_messageInfo_SendCustomMessageResponse.Size(m)
+}
+func (m *SendCustomMessageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendCustomMessageResponse.DiscardUnknown(m)
 }
 
-func (c *lightningClient) SubscribeChannelEvents(ctx context.Context, in *ChannelEventSubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelEventsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[1], "/lnrpc.Lightning/SubscribeChannelEvents", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &lightningSubscribeChannelEventsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
+var xxx_messageInfo_SendCustomMessageResponse proto.InternalMessageInfo
+
+type SubscribeCustomMessagesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type Lightning_SubscribeChannelEventsClient interface {
-	Recv() (*ChannelEventUpdate, error)
-	grpc.ClientStream
+func (m *SubscribeCustomMessagesRequest) Reset()         { *m = SubscribeCustomMessagesRequest{} }
+func (m *SubscribeCustomMessagesRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeCustomMessagesRequest) ProtoMessage()    {}
+func (*SubscribeCustomMessagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{173}
+}
+func (m *SubscribeCustomMessagesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeCustomMessagesRequest.Unmarshal(m, b)
+}
+func (m *SubscribeCustomMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeCustomMessagesRequest.Marshal(b, m, deterministic)
+}
+func (dst *SubscribeCustomMessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeCustomMessagesRequest.Merge(dst, src)
+}
+func (m *SubscribeCustomMessagesRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeCustomMessagesRequest.Size(m)
+}
+func (m *SubscribeCustomMessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeCustomMessagesRequest.DiscardUnknown(m)
 }
 
-type lightningSubscribeChannelEventsClient struct {
-	grpc.ClientStream
+var xxx_messageInfo_SubscribeCustomMessagesRequest proto.InternalMessageInfo
+
+type CustomMessage struct {
+	// / The public key of the peer that sent the message.
+	Peer []byte `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
+	// / The type of the message, within the custom range.
+	Type uint32 `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	// / The opaque payload of the message.
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (x *lightningSubscribeChannelEventsClient) Recv() (*ChannelEventUpdate, error) {
-	m := new(ChannelEventUpdate)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *CustomMessage) Reset()         { *m = CustomMessage{} }
+func (m *CustomMessage) String() string { return proto.CompactTextString(m) }
+func (*CustomMessage) ProtoMessage()    {}
+func (*CustomMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{174}
+}
+func (m *CustomMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CustomMessage.Unmarshal(m, b)
+}
+func (m *CustomMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CustomMessage.Marshal(b, m, deterministic)
+}
+func (dst *CustomMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomMessage.Merge(dst, src)
+}
+func (m *CustomMessage) XXX_Size() int {
+	return xxx_messageInfo_CustomMessage.Size(m)
+}
+func (m *CustomMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomMessage.DiscardUnknown(m)
 }
 
-func (c *lightningClient) ClosedChannels(ctx context.Context, in *ClosedChannelsRequest, opts ...grpc.CallOption) (*ClosedChannelsResponse, error) {
-	out := new(ClosedChannelsResponse)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ClosedChannels", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CustomMessage proto.InternalMessageInfo
+
+func (m *CustomMessage) GetPeer() []byte {
+	if m != nil {
+		return m.Peer
 	}
-	return out, nil
+	return nil
 }
 
-func (c *lightningClient) OpenChannelSync(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (*ChannelPoint, error) {
-	out := new(ChannelPoint)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/OpenChannelSync", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CustomMessage) GetType() uint32 {
+	if m != nil {
+		return m.Type
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) OpenChannel(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (Lightning_OpenChannelClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[2], "/lnrpc.Lightning/OpenChannel", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &lightningOpenChannelClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *CustomMessage) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return x, nil
+	return nil
 }
 
-type Lightning_OpenChannelClient interface {
-	Recv() (*OpenStatusUpdate, error)
-	grpc.ClientStream
+type MacaroonPermission struct {
+	// / The entity a permission grants access to.
+	Entity string `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	// / The action that is granted.
+	Action               string   `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type lightningOpenChannelClient struct {
-	grpc.ClientStream
+func (m *MacaroonPermission) Reset()         { *m = MacaroonPermission{} }
+func (m *MacaroonPermission) String() string { return proto.CompactTextString(m) }
+func (*MacaroonPermission) ProtoMessage()    {}
+func (*MacaroonPermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{175}
+}
+func (m *MacaroonPermission) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MacaroonPermission.Unmarshal(m, b)
+}
+func (m *MacaroonPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MacaroonPermission.Marshal(b, m, deterministic)
+}
+func (dst *MacaroonPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MacaroonPermission.Merge(dst, src)
+}
+func (m *MacaroonPermission) XXX_Size() int {
+	return xxx_messageInfo_MacaroonPermission.Size(m)
+}
+func (m *MacaroonPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_MacaroonPermission.DiscardUnknown(m)
 }
 
-func (x *lightningOpenChannelClient) Recv() (*OpenStatusUpdate, error) {
-	m := new(OpenStatusUpdate)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_MacaroonPermission proto.InternalMessageInfo
+
+func (m *MacaroonPermission) GetEntity() string {
+	if m != nil {
+		return m.Entity
 	}
-	return m, nil
+	return ""
 }
 
-func (c *lightningClient) ChannelAcceptor(ctx context.Context, opts ...grpc.CallOption) (Lightning_ChannelAcceptorClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[3], "/lnrpc.Lightning/ChannelAcceptor", opts...)
-	if err != nil {
-		return nil, err
+func (m *MacaroonPermission) GetAction() string {
+	if m != nil {
+		return m.Action
 	}
-	x := &lightningChannelAcceptorClient{stream}
-	return x, nil
+	return ""
 }
 
-type Lightning_ChannelAcceptorClient interface {
-	Send(*ChannelAcceptResponse) error
-	Recv() (*ChannelAcceptRequest, error)
-	grpc.ClientStream
+type BakeMacaroonRequest struct {
+	// / The list of permissions the new macaroon should grant.
+	Permissions []*MacaroonPermission `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	// / The ID of the root key the macaroon should be baked with.
+	RootKeyId            uint64   `protobuf:"varint,2,opt,name=root_key_id,proto3" json:"root_key_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type lightningChannelAcceptorClient struct {
-	grpc.ClientStream
+func (m *BakeMacaroonRequest) Reset()         { *m = BakeMacaroonRequest{} }
+func (m *BakeMacaroonRequest) String() string { return proto.CompactTextString(m) }
+func (*BakeMacaroonRequest) ProtoMessage()    {}
+func (*BakeMacaroonRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{176}
 }
-
-func (x *lightningChannelAcceptorClient) Send(m *ChannelAcceptResponse) error {
-	return x.ClientStream.SendMsg(m)
+func (m *BakeMacaroonRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BakeMacaroonRequest.Unmarshal(m, b)
+}
+func (m *BakeMacaroonRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BakeMacaroonRequest.Marshal(b, m, deterministic)
+}
+func (dst *BakeMacaroonRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BakeMacaroonRequest.Merge(dst, src)
+}
+func (m *BakeMacaroonRequest) XXX_Size() int {
+	return xxx_messageInfo_BakeMacaroonRequest.Size(m)
+}
+func (m *BakeMacaroonRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BakeMacaroonRequest.DiscardUnknown(m)
 }
 
-func (x *lightningChannelAcceptorClient) Recv() (*ChannelAcceptRequest, error) {
-	m := new(ChannelAcceptRequest)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_BakeMacaroonRequest proto.InternalMessageInfo
+
+func (m *BakeMacaroonRequest) GetPermissions() []*MacaroonPermission {
+	if m != nil {
+		return m.Permissions
 	}
-	return m, nil
+	return nil
 }
 
-func (c *lightningClient) CloseChannel(ctx context.Context, in *CloseChannelRequest, opts ...grpc.CallOption) (Lightning_CloseChannelClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[4], "/lnrpc.Lightning/CloseChannel", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &lightningCloseChannelClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *BakeMacaroonRequest) GetRootKeyId() uint64 {
+	if m != nil {
+		return m.RootKeyId
 	}
-	return x, nil
+	return 0
 }
 
-type Lightning_CloseChannelClient interface {
-	Recv() (*CloseStatusUpdate, error)
-	grpc.ClientStream
+type BakeMacaroonResponse struct {
+	// / The hex encoded macaroon, serialized in binary format.
+	Macaroon             string   `protobuf:"bytes,1,opt,name=macaroon,proto3" json:"macaroon,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type lightningCloseChannelClient struct {
-	grpc.ClientStream
+func (m *BakeMacaroonResponse) Reset()         { *m = BakeMacaroonResponse{} }
+func (m *BakeMacaroonResponse) String() string { return proto.CompactTextString(m) }
+func (*BakeMacaroonResponse) ProtoMessage()    {}
+func (*BakeMacaroonResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{177}
 }
-
-func (x *lightningCloseChannelClient) Recv() (*CloseStatusUpdate, error) {
-	m := new(CloseStatusUpdate)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *BakeMacaroonResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BakeMacaroonResponse.Unmarshal(m, b)
+}
+func (m *BakeMacaroonResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BakeMacaroonResponse.Marshal(b, m, deterministic)
+}
+func (dst *BakeMacaroonResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BakeMacaroonResponse.Merge(dst, src)
+}
+func (m *BakeMacaroonResponse) XXX_Size() int {
+	return xxx_messageInfo_BakeMacaroonResponse.Size(m)
+}
+func (m *BakeMacaroonResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BakeMacaroonResponse.DiscardUnknown(m)
 }
 
-func (c *lightningClient) AbandonChannel(ctx context.Context, in *AbandonChannelRequest, opts ...grpc.CallOption) (*AbandonChannelResponse, error) {
-	out := new(AbandonChannelResponse)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/AbandonChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BakeMacaroonResponse proto.InternalMessageInfo
+
+func (m *BakeMacaroonResponse) GetMacaroon() string {
+	if m != nil {
+		return m.Macaroon
 	}
-	return out, nil
+	return ""
 }
 
-func (c *lightningClient) SendPayment(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendPaymentClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[5], "/lnrpc.Lightning/SendPayment", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &lightningSendPaymentClient{stream}
-	return x, nil
+type ListMacaroonIDsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type Lightning_SendPaymentClient interface {
-	Send(*SendRequest) error
-	Recv() (*SendResponse, error)
-	grpc.ClientStream
+func (m *ListMacaroonIDsRequest) Reset()         { *m = ListMacaroonIDsRequest{} }
+func (m *ListMacaroonIDsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListMacaroonIDsRequest) ProtoMessage()    {}
+func (*ListMacaroonIDsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{178}
+}
+func (m *ListMacaroonIDsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListMacaroonIDsRequest.Unmarshal(m, b)
+}
+func (m *ListMacaroonIDsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListMacaroonIDsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListMacaroonIDsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMacaroonIDsRequest.Merge(dst, src)
+}
+func (m *ListMacaroonIDsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListMacaroonIDsRequest.Size(m)
+}
+func (m *ListMacaroonIDsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMacaroonIDsRequest.DiscardUnknown(m)
 }
 
-type lightningSendPaymentClient struct {
-	grpc.ClientStream
+var xxx_messageInfo_ListMacaroonIDsRequest proto.InternalMessageInfo
+
+type ListMacaroonIDsResponse struct {
+	// / The list of root key IDs that are in use.
+	RootKeyIds           []uint64 `protobuf:"varint,1,rep,packed,name=root_key_ids,proto3" json:"root_key_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (x *lightningSendPaymentClient) Send(m *SendRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (m *ListMacaroonIDsResponse) Reset()         { *m = ListMacaroonIDsResponse{} }
+func (m *ListMacaroonIDsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListMacaroonIDsResponse) ProtoMessage()    {}
+func (*ListMacaroonIDsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{179}
+}
+func (m *ListMacaroonIDsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListMacaroonIDsResponse.Unmarshal(m, b)
+}
+func (m *ListMacaroonIDsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListMacaroonIDsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListMacaroonIDsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMacaroonIDsResponse.Merge(dst, src)
+}
+func (m *ListMacaroonIDsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListMacaroonIDsResponse.Size(m)
+}
+func (m *ListMacaroonIDsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMacaroonIDsResponse.DiscardUnknown(m)
 }
 
-func (x *lightningSendPaymentClient) Recv() (*SendResponse, error) {
-	m := new(SendResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_ListMacaroonIDsResponse proto.InternalMessageInfo
+
+func (m *ListMacaroonIDsResponse) GetRootKeyIds() []uint64 {
+	if m != nil {
+		return m.RootKeyIds
 	}
-	return m, nil
+	return nil
 }
 
-func (c *lightningClient) SendPaymentSync(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*SendResponse, error) {
-	out := new(SendResponse)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SendPaymentSync", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type DeleteMacaroonIDRequest struct {
+	// / The ID of the root key to delete.
+	RootKeyId            uint64   `protobuf:"varint,1,opt,name=root_key_id,proto3" json:"root_key_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *lightningClient) SendToRoute(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendToRouteClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[6], "/lnrpc.Lightning/SendToRoute", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &lightningSendToRouteClient{stream}
-	return x, nil
+func (m *DeleteMacaroonIDRequest) Reset()         { *m = DeleteMacaroonIDRequest{} }
+func (m *DeleteMacaroonIDRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteMacaroonIDRequest) ProtoMessage()    {}
+func (*DeleteMacaroonIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{180}
+}
+func (m *DeleteMacaroonIDRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteMacaroonIDRequest.Unmarshal(m, b)
+}
+func (m *DeleteMacaroonIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteMacaroonIDRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteMacaroonIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteMacaroonIDRequest.Merge(dst, src)
+}
+func (m *DeleteMacaroonIDRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteMacaroonIDRequest.Size(m)
+}
+func (m *DeleteMacaroonIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteMacaroonIDRequest.DiscardUnknown(m)
 }
 
-type Lightning_SendToRouteClient interface {
-	Send(*SendToRouteRequest) error
-	Recv() (*SendResponse, error)
-	grpc.ClientStream
+var xxx_messageInfo_DeleteMacaroonIDRequest proto.InternalMessageInfo
+
+func (m *DeleteMacaroonIDRequest) GetRootKeyId() uint64 {
+	if m != nil {
+		return m.RootKeyId
+	}
+	return 0
 }
 
-type lightningSendToRouteClient struct {
-	grpc.ClientStream
+type DeleteMacaroonIDResponse struct {
+	// / A boolean indicating whether a root key was found and deleted.
+	Deleted              bool     `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (x *lightningSendToRouteClient) Send(m *SendToRouteRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (m *DeleteMacaroonIDResponse) Reset()         { *m = DeleteMacaroonIDResponse{} }
+func (m *DeleteMacaroonIDResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteMacaroonIDResponse) ProtoMessage()    {}
+func (*DeleteMacaroonIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{181}
+}
+func (m *DeleteMacaroonIDResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteMacaroonIDResponse.Unmarshal(m, b)
+}
+func (m *DeleteMacaroonIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteMacaroonIDResponse.Marshal(b, m, deterministic)
+}
+func (dst *DeleteMacaroonIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteMacaroonIDResponse.Merge(dst, src)
+}
+func (m *DeleteMacaroonIDResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteMacaroonIDResponse.Size(m)
+}
+func (m *DeleteMacaroonIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteMacaroonIDResponse.DiscardUnknown(m)
 }
 
-func (x *lightningSendToRouteClient) Recv() (*SendResponse, error) {
-	m := new(SendResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_DeleteMacaroonIDResponse proto.InternalMessageInfo
+
+func (m *DeleteMacaroonIDResponse) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
 	}
-	return m, nil
+	return false
 }
 
-func (c *lightningClient) SendToRouteSync(ctx context.Context, in *SendToRouteRequest, opts ...grpc.CallOption) (*SendResponse, error) {
-	out := new(SendResponse)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SendToRouteSync", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MacaroonPermissionList struct {
+	// / A list of macaroon permissions.
+	Permissions          []*MacaroonPermission `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (c *lightningClient) AddInvoice(ctx context.Context, in *Invoice, opts ...grpc.CallOption) (*AddInvoiceResponse, error) {
-	out := new(AddInvoiceResponse)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/AddInvoice", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MacaroonPermissionList) Reset()         { *m = MacaroonPermissionList{} }
+func (m *MacaroonPermissionList) String() string { return proto.CompactTextString(m) }
+func (*MacaroonPermissionList) ProtoMessage()    {}
+func (*MacaroonPermissionList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{182}
+}
+func (m *MacaroonPermissionList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MacaroonPermissionList.Unmarshal(m, b)
+}
+func (m *MacaroonPermissionList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MacaroonPermissionList.Marshal(b, m, deterministic)
+}
+func (dst *MacaroonPermissionList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MacaroonPermissionList.Merge(dst, src)
+}
+func (m *MacaroonPermissionList) XXX_Size() int {
+	return xxx_messageInfo_MacaroonPermissionList.Size(m)
+}
+func (m *MacaroonPermissionList) XXX_DiscardUnknown() {
+	xxx_messageInfo_MacaroonPermissionList.DiscardUnknown(m)
 }
 
-func (c *lightningClient) ListInvoices(ctx context.Context, in *ListInvoiceRequest, opts ...grpc.CallOption) (*ListInvoiceResponse, error) {
-	out := new(ListInvoiceResponse)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListInvoices", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MacaroonPermissionList proto.InternalMessageInfo
+
+func (m *MacaroonPermissionList) GetPermissions() []*MacaroonPermission {
+	if m != nil {
+		return m.Permissions
 	}
-	return out, nil
+	return nil
 }
 
-func (c *lightningClient) LookupInvoice(ctx context.Context, in *PaymentHash, opts ...grpc.CallOption) (*Invoice, error) {
-	out := new(Invoice)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/LookupInvoice", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ListPermissionsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *lightningClient) SubscribeInvoices(ctx context.Context, in *InvoiceSubscription, opts ...grpc.CallOption) (Lightning_SubscribeInvoicesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[7], "/lnrpc.Lightning/SubscribeInvoices", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &lightningSubscribeInvoicesClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
+func (m *ListPermissionsRequest) Reset()         { *m = ListPermissionsRequest{} }
+func (m *ListPermissionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPermissionsRequest) ProtoMessage()    {}
+func (*ListPermissionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{183}
+}
+func (m *ListPermissionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPermissionsRequest.Unmarshal(m, b)
+}
+func (m *ListPermissionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPermissionsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListPermissionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPermissionsRequest.Merge(dst, src)
+}
+func (m *ListPermissionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListPermissionsRequest.Size(m)
+}
+func (m *ListPermissionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPermissionsRequest.DiscardUnknown(m)
 }
 
-type Lightning_SubscribeInvoicesClient interface {
-	Recv() (*Invoice, error)
-	grpc.ClientStream
+var xxx_messageInfo_ListPermissionsRequest proto.InternalMessageInfo
+
+type ListPermissionsResponse struct {
+	// *
+	// A map between all RPC method URIs and their required macaroon permissions
+	// to access them.
+	MethodPermissions    map[string]*MacaroonPermissionList `protobuf:"bytes,1,rep,name=method_permissions,proto3" json:"method_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
 }
 
-type lightningSubscribeInvoicesClient struct {
-	grpc.ClientStream
+func (m *ListPermissionsResponse) Reset()         { *m = ListPermissionsResponse{} }
+func (m *ListPermissionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListPermissionsResponse) ProtoMessage()    {}
+func (*ListPermissionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{184}
+}
+func (m *ListPermissionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPermissionsResponse.Unmarshal(m, b)
+}
+func (m *ListPermissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPermissionsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListPermissionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPermissionsResponse.Merge(dst, src)
+}
+func (m *ListPermissionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListPermissionsResponse.Size(m)
+}
+func (m *ListPermissionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPermissionsResponse.DiscardUnknown(m)
 }
 
-func (x *lightningSubscribeInvoicesClient) Recv() (*Invoice, error) {
-	m := new(Invoice)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_ListPermissionsResponse proto.InternalMessageInfo
+
+func (m *ListPermissionsResponse) GetMethodPermissions() map[string]*MacaroonPermissionList {
+	if m != nil {
+		return m.MethodPermissions
 	}
-	return m, nil
+	return nil
 }
 
-func (c *lightningClient) DecodePayReq(ctx context.Context, in *PayReqString, opts ...grpc.CallOption) (*PayReq, error) {
-	out := new(PayReq)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/DecodePayReq", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type RPCMiddlewareRequest struct {
+	// / The unique ID of the intercepted request, to echo in the feedback.
+	RequestId uint64 `protobuf:"varint,1,opt,name=request_id,proto3" json:"request_id,omitempty"`
+	// / The raw bytes of the macaroon the request was made with.
+	RawMacaroon []byte `protobuf:"bytes,2,opt,name=raw_macaroon,proto3" json:"raw_macaroon,omitempty"`
+	// *
+	// The condition of the custom caveat of the macaroon the middleware is
+	// registered for.
+	CustomCaveatCondition string `protobuf:"bytes,3,opt,name=custom_caveat_condition,proto3" json:"custom_caveat_condition,omitempty"`
+	// / The full URI of the invoked RPC method, e.g. /lnrpc.Lightning/GetInfo.
+	MethodFullUri string `protobuf:"bytes,4,opt,name=method_full_uri,proto3" json:"method_full_uri,omitempty"`
+	// *
+	// Whether the method is a streaming RPC. The establishment of streams is
+	// intercepted without a message, followed by each of the messages exchanged
+	// over them.
+	StreamRpc bool `protobuf:"varint,5,opt,name=stream_rpc,proto3" json:"stream_rpc,omitempty"`
+	// / The full name of the protobuf type of the message.
+	TypeName string `protobuf:"bytes,6,opt,name=type_name,proto3" json:"type_name,omitempty"`
+	// / The protobuf serialized message.
+	Serialized []byte `protobuf:"bytes,7,opt,name=serialized,proto3" json:"serialized,omitempty"`
+	// *
+	// Whether the message is a response sent by the method, rather than a
+	// request received by it.
+	Response bool `protobuf:"varint,8,opt,name=response,proto3" json:"response,omitempty"`
+	// *
+	// The ID shared by all the intercepted messages of a call, linking a
+	// request to its response, and the messages of a stream together.
+	CallId               uint64   `protobuf:"varint,9,opt,name=call_id,proto3" json:"call_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *lightningClient) ListPayments(ctx context.Context, in *ListPaymentsRequest, opts ...grpc.CallOption) (*ListPaymentsResponse, error) {
-	out := new(ListPaymentsResponse)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListPayments", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *RPCMiddlewareRequest) Reset()         { *m = RPCMiddlewareRequest{} }
+func (m *RPCMiddlewareRequest) String() string { return proto.CompactTextString(m) }
+func (*RPCMiddlewareRequest) ProtoMessage()    {}
+func (*RPCMiddlewareRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{185}
+}
+func (m *RPCMiddlewareRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RPCMiddlewareRequest.Unmarshal(m, b)
+}
+func (m *RPCMiddlewareRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RPCMiddlewareRequest.Marshal(b, m, deterministic)
+}
+func (dst *RPCMiddlewareRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RPCMiddlewareRequest.Merge(dst, src)
+}
+func (m *RPCMiddlewareRequest) XXX_Size() int {
+	return xxx_messageInfo_RPCMiddlewareRequest.Size(m)
+}
+func (m *RPCMiddlewareRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RPCMiddlewareRequest.DiscardUnknown(m)
 }
 
-func (c *lightningClient) DeleteAllPayments(ctx context.Context, in *DeleteAllPaymentsRequest, opts ...grpc.CallOption) (*DeleteAllPaymentsResponse, error) {
-	out := new(DeleteAllPaymentsResponse)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/DeleteAllPayments", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RPCMiddlewareRequest proto.InternalMessageInfo
+
+func (m *RPCMiddlewareRequest) GetRequestId() uint64 {
+	if m != nil {
+		return m.RequestId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) DescribeGraph(ctx context.Context, in *ChannelGraphRequest, opts ...grpc.CallOption) (*ChannelGraph, error) {
-	out := new(ChannelGraph)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/DescribeGraph", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RPCMiddlewareRequest) GetRawMacaroon() []byte {
+	if m != nil {
+		return m.RawMacaroon
 	}
-	return out, nil
+	return nil
 }
 
-func (c *lightningClient) GetChanInfo(ctx context.Context, in *ChanInfoRequest, opts ...grpc.CallOption) (*ChannelEdge, error) {
-	out := new(ChannelEdge)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetChanInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RPCMiddlewareRequest) GetCustomCaveatCondition() string {
+	if m != nil {
+		return m.CustomCaveatCondition
 	}
-	return out, nil
+	return ""
 }
 
-func (c *lightningClient) GetNodeInfo(ctx context.Context, in *NodeInfoRequest, opts ...grpc.CallOption) (*NodeInfo, error) {
-	out := new(NodeInfo)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetNodeInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RPCMiddlewareRequest) GetMethodFullUri() string {
+	if m != nil {
+		return m.MethodFullUri
 	}
-	return out, nil
+	return ""
 }
 
-func (c *lightningClient) QueryRoutes(ctx context.Context, in *QueryRoutesRequest, opts ...grpc.CallOption) (*QueryRoutesResponse, error) {
-	out := new(QueryRoutesResponse)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/QueryRoutes", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RPCMiddlewareRequest) GetStreamRpc() bool {
+	if m != nil {
+		return m.StreamRpc
 	}
-	return out, nil
+	return false
 }
 
-func (c *lightningClient) GetNetworkInfo(ctx context.Context, in *NetworkInfoRequest, opts ...grpc.CallOption) (*NetworkInfo, error) {
-	out := new(NetworkInfo)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetNetworkInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RPCMiddlewareRequest) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *lightningClient) StopDaemon(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error) {
-	out := new(StopResponse)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/StopDaemon", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RPCMiddlewareRequest) GetSerialized() []byte {
+	if m != nil {
+		return m.Serialized
 	}
-	return out, nil
+	return nil
 }
 
-func (c *lightningClient) SubscribeChannelGraph(ctx context.Context, in *GraphTopologySubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelGraphClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[8], "/lnrpc.Lightning/SubscribeChannelGraph", opts...)
-	if err != nil {
-		return nil, err
+func (m *RPCMiddlewareRequest) GetResponse() bool {
+	if m != nil {
+		return m.Response
 	}
-	x := &lightningSubscribeChannelGraphClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	return false
+}
+
+func (m *RPCMiddlewareRequest) GetCallId() uint64 {
+	if m != nil {
+		return m.CallId
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	return 0
+}
+
+type RPCMiddlewareResponse struct {
+	// / The ID of the request the feedback is for.
+	RequestId uint64 `protobuf:"varint,1,opt,name=request_id,proto3" json:"request_id,omitempty"`
+	// / The registration of the middleware, set in its first message only.
+	Register *MiddlewareRegistration `protobuf:"bytes,2,opt,name=register,proto3" json:"register,omitempty"`
+	// / The feedback of the middleware on an intercepted request.
+	Feedback             *InterceptFeedback `protobuf:"bytes,3,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *RPCMiddlewareResponse) Reset()         { *m = RPCMiddlewareResponse{} }
+func (m *RPCMiddlewareResponse) String() string { return proto.CompactTextString(m) }
+func (*RPCMiddlewareResponse) ProtoMessage()    {}
+func (*RPCMiddlewareResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{186}
+}
+func (m *RPCMiddlewareResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RPCMiddlewareResponse.Unmarshal(m, b)
+}
+func (m *RPCMiddlewareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RPCMiddlewareResponse.Marshal(b, m, deterministic)
+}
+func (dst *RPCMiddlewareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RPCMiddlewareResponse.Merge(dst, src)
+}
+func (m *RPCMiddlewareResponse) XXX_Size() int {
+	return xxx_messageInfo_RPCMiddlewareResponse.Size(m)
+}
+func (m *RPCMiddlewareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RPCMiddlewareResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RPCMiddlewareResponse proto.InternalMessageInfo
+
+func (m *RPCMiddlewareResponse) GetRequestId() uint64 {
+	if m != nil {
+		return m.RequestId
 	}
-	return x, nil
+	return 0
 }
 
-type Lightning_SubscribeChannelGraphClient interface {
-	Recv() (*GraphTopologyUpdate, error)
-	grpc.ClientStream
+func (m *RPCMiddlewareResponse) GetRegister() *MiddlewareRegistration {
+	if m != nil {
+		return m.Register
+	}
+	return nil
 }
 
-type lightningSubscribeChannelGraphClient struct {
-	grpc.ClientStream
+func (m *RPCMiddlewareResponse) GetFeedback() *InterceptFeedback {
+	if m != nil {
+		return m.Feedback
+	}
+	return nil
 }
 
-func (x *lightningSubscribeChannelGraphClient) Recv() (*GraphTopologyUpdate, error) {
-	m := new(GraphTopologyUpdate)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+type MiddlewareRegistration struct {
+	// / The name of the middleware, used in logs and errors.
+	MiddlewareName string `protobuf:"bytes,1,opt,name=middleware_name,proto3" json:"middleware_name,omitempty"`
+	// *
+	// The name of the custom macaroon caveat the middleware enforces. Only one
+	// middleware can be registered for a given caveat name.
+	CustomMacaroonCaveatName string `protobuf:"bytes,2,opt,name=custom_macaroon_caveat_name,proto3" json:"custom_macaroon_caveat_name,omitempty"`
+	// *
+	// The macaroon permissions required by custom RPC methods served alongside
+	// the node, keyed by their full URI, e.g. /myext.MyService/MyMethod. They
+	// are enforced like those of the built-in methods while the middleware is
+	// registered. Only the entities and actions that can be granted to
+	// macaroons are accepted, and built-in methods can't be overridden.
+	CustomPermissions map[string]*MacaroonPermissionList `protobuf:"bytes,3,rep,name=custom_permissions,proto3" json:"custom_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// *
+	// Whether the middleware only observes the calls. Its feedback is ignored,
+	// so it can neither reject nor rewrite messages, and several read-only
+	// middlewares can observe the same caveat name. A read-only middleware
+	// without a custom caveat name observes all calls.
+	ReadOnlyMode         bool     `protobuf:"varint,4,opt,name=read_only_mode,proto3" json:"read_only_mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MiddlewareRegistration) Reset()         { *m = MiddlewareRegistration{} }
+func (m *MiddlewareRegistration) String() string { return proto.CompactTextString(m) }
+func (*MiddlewareRegistration) ProtoMessage()    {}
+func (*MiddlewareRegistration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{187}
+}
+func (m *MiddlewareRegistration) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MiddlewareRegistration.Unmarshal(m, b)
+}
+func (m *MiddlewareRegistration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MiddlewareRegistration.Marshal(b, m, deterministic)
+}
+func (dst *MiddlewareRegistration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MiddlewareRegistration.Merge(dst, src)
+}
+func (m *MiddlewareRegistration) XXX_Size() int {
+	return xxx_messageInfo_MiddlewareRegistration.Size(m)
+}
+func (m *MiddlewareRegistration) XXX_DiscardUnknown() {
+	xxx_messageInfo_MiddlewareRegistration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MiddlewareRegistration proto.InternalMessageInfo
+
+func (m *MiddlewareRegistration) GetMiddlewareName() string {
+	if m != nil {
+		return m.MiddlewareName
 	}
-	return m, nil
+	return ""
 }
 
-func (c *lightningClient) DebugLevel(ctx context.Context, in *DebugLevelRequest, opts ...grpc.CallOption) (*DebugLevelResponse, error) {
-	out := new(DebugLevelResponse)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/DebugLevel", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MiddlewareRegistration) GetCustomMacaroonCaveatName() string {
+	if m != nil {
+		return m.CustomMacaroonCaveatName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *lightningClient) FeeReport(ctx context.Context, in *FeeReportRequest, opts ...grpc.CallOption) (*FeeReportResponse, error) {
-	out := new(FeeReportResponse)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/FeeReport", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MiddlewareRegistration) GetCustomPermissions() map[string]*MacaroonPermissionList {
+	if m != nil {
+		return m.CustomPermissions
 	}
-	return out, nil
+	return nil
 }
 
-func (c *lightningClient) UpdateChannelPolicy(ctx context.Context, in *PolicyUpdateRequest, opts ...grpc.CallOption) (*PolicyUpdateResponse, error) {
-	out := new(PolicyUpdateResponse)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/UpdateChannelPolicy", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MiddlewareRegistration) GetReadOnlyMode() bool {
+	if m != nil {
+		return m.ReadOnlyMode
 	}
-	return out, nil
+	return false
 }
 
-func (c *lightningClient) ForwardingHistory(ctx context.Context, in *ForwardingHistoryRequest, opts ...grpc.CallOption) (*ForwardingHistoryResponse, error) {
-	out := new(ForwardingHistoryResponse)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ForwardingHistory", in, out, opts...)
-	if err != nil {
-		return nil, err
+type InterceptFeedback struct {
+	// *
+	// The error returned to the client if the request is rejected. An empty
+	// error accepts the request.
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	// *
+	// Whether the intercepted message is replaced by replacement_serialized
+	// before reaching the method or the client.
+	ReplaceMessage bool `protobuf:"varint,2,opt,name=replace_message,proto3" json:"replace_message,omitempty"`
+	// *
+	// The protobuf serialized message replacing the intercepted one, which must
+	// be of the same type.
+	ReplacementSerialized []byte   `protobuf:"bytes,3,opt,name=replacement_serialized,proto3" json:"replacement_serialized,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *InterceptFeedback) Reset()         { *m = InterceptFeedback{} }
+func (m *InterceptFeedback) String() string { return proto.CompactTextString(m) }
+func (*InterceptFeedback) ProtoMessage()    {}
+func (*InterceptFeedback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{188}
+}
+func (m *InterceptFeedback) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InterceptFeedback.Unmarshal(m, b)
+}
+func (m *InterceptFeedback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InterceptFeedback.Marshal(b, m, deterministic)
+}
+func (dst *InterceptFeedback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterceptFeedback.Merge(dst, src)
+}
+func (m *InterceptFeedback) XXX_Size() int {
+	return xxx_messageInfo_InterceptFeedback.Size(m)
+}
+func (m *InterceptFeedback) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterceptFeedback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterceptFeedback proto.InternalMessageInfo
+
+func (m *InterceptFeedback) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return out, nil
+	return ""
 }
 
-func (c *lightningClient) ExportChannelBackup(ctx context.Context, in *ExportChannelBackupRequest, opts ...grpc.CallOption) (*ChannelBackup, error) {
-	out := new(ChannelBackup)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ExportChannelBackup", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *InterceptFeedback) GetReplaceMessage() bool {
+	if m != nil {
+		return m.ReplaceMessage
 	}
-	return out, nil
+	return false
 }
 
-func (c *lightningClient) ExportAllChannelBackups(ctx context.Context, in *ChanBackupExportRequest, opts ...grpc.CallOption) (*ChanBackupSnapshot, error) {
-	out := new(ChanBackupSnapshot)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ExportAllChannelBackups", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *InterceptFeedback) GetReplacementSerialized() []byte {
+	if m != nil {
+		return m.ReplacementSerialized
 	}
-	return out, nil
+	return nil
 }
 
-func (c *lightningClient) VerifyChanBackup(ctx context.Context, in *ChanBackupSnapshot, opts ...grpc.CallOption) (*VerifyChanBackupResponse, error) {
-	out := new(VerifyChanBackupResponse)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/VerifyChanBackup", in, out, opts...)
-	if err != nil {
-		return nil, err
+type OutputDetail struct {
+	// / The index of the output within the transaction.
+	OutputIndex int64 `protobuf:"varint,1,opt,name=output_index,proto3" json:"output_index,omitempty"`
+	// / The amount paid to the output, denominated in atoms.
+	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// / The hex-encoded script of the output.
+	PkScript string `protobuf:"bytes,3,opt,name=pk_script,proto3" json:"pk_script,omitempty"`
+	// / The addresses the output pays to.
+	Addresses []string `protobuf:"bytes,4,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// / Whether the output pays to an address of the wallet.
+	IsOurAddress bool `protobuf:"varint,5,opt,name=is_our_address,proto3" json:"is_our_address,omitempty"`
+	// *
+	// The account of the wallet the output pays to. Only set if is_our_address is
+	// true.
+	Account uint32 `protobuf:"varint,6,opt,name=account,proto3" json:"account,omitempty"`
+	// *
+	// Whether the output pays to an internal (change) address of the account.
+	// Only set if is_our_address is true.
+	Internal             bool     `protobuf:"varint,7,opt,name=internal,proto3" json:"internal,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OutputDetail) Reset()         { *m = OutputDetail{} }
+func (m *OutputDetail) String() string { return proto.CompactTextString(m) }
+func (*OutputDetail) ProtoMessage()    {}
+func (*OutputDetail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{189}
+}
+func (m *OutputDetail) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OutputDetail.Unmarshal(m, b)
+}
+func (m *OutputDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OutputDetail.Marshal(b, m, deterministic)
+}
+func (dst *OutputDetail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutputDetail.Merge(dst, src)
+}
+func (m *OutputDetail) XXX_Size() int {
+	return xxx_messageInfo_OutputDetail.Size(m)
+}
+func (m *OutputDetail) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutputDetail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutputDetail proto.InternalMessageInfo
+
+func (m *OutputDetail) GetOutputIndex() int64 {
+	if m != nil {
+		return m.OutputIndex
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) RestoreChannelBackups(ctx context.Context, in *RestoreChanBackupRequest, opts ...grpc.CallOption) (*RestoreBackupResponse, error) {
-	out := new(RestoreBackupResponse)
-	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/RestoreChannelBackups", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OutputDetail) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return 0
 }
 
-func (c *lightningClient) SubscribeChannelBackups(ctx context.Context, in *ChannelBackupSubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelBackupsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[9], "/lnrpc.Lightning/SubscribeChannelBackups", opts...)
-	if err != nil {
-		return nil, err
+func (m *OutputDetail) GetPkScript() string {
+	if m != nil {
+		return m.PkScript
 	}
-	x := &lightningSubscribeChannelBackupsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	return ""
+}
+
+func (m *OutputDetail) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	return nil
+}
+
+func (m *OutputDetail) GetIsOurAddress() bool {
+	if m != nil {
+		return m.IsOurAddress
 	}
-	return x, nil
+	return false
 }
 
-type Lightning_SubscribeChannelBackupsClient interface {
-	Recv() (*ChanBackupSnapshot, error)
-	grpc.ClientStream
+func (m *OutputDetail) GetAccount() uint32 {
+	if m != nil {
+		return m.Account
+	}
+	return 0
 }
 
-type lightningSubscribeChannelBackupsClient struct {
-	grpc.ClientStream
+func (m *OutputDetail) GetInternal() bool {
+	if m != nil {
+		return m.Internal
+	}
+	return false
 }
 
-func (x *lightningSubscribeChannelBackupsClient) Recv() (*ChanBackupSnapshot, error) {
-	m := new(ChanBackupSnapshot)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+type SetColdSweepRequest struct {
+	// / Whether the scheduled sweeps to cold storage should be enabled.
+	Enable               bool     `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetColdSweepRequest) Reset()         { *m = SetColdSweepRequest{} }
+func (m *SetColdSweepRequest) String() string { return proto.CompactTextString(m) }
+func (*SetColdSweepRequest) ProtoMessage()    {}
+func (*SetColdSweepRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{190}
+}
+func (m *SetColdSweepRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetColdSweepRequest.Unmarshal(m, b)
+}
+func (m *SetColdSweepRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetColdSweepRequest.Marshal(b, m, deterministic)
+}
+func (dst *SetColdSweepRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetColdSweepRequest.Merge(dst, src)
+}
+func (m *SetColdSweepRequest) XXX_Size() int {
+	return xxx_messageInfo_SetColdSweepRequest.Size(m)
+}
+func (m *SetColdSweepRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetColdSweepRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetColdSweepRequest proto.InternalMessageInfo
+
+func (m *SetColdSweepRequest) GetEnable() bool {
+	if m != nil {
+		return m.Enable
 	}
-	return m, nil
+	return false
 }
 
-// LightningServer is the server API for Lightning service.
-type LightningServer interface {
-	// * lncli: `walletbalance`
-	// WalletBalance returns total unspent outputs(confirmed and unconfirmed), all
-	// confirmed unspent outputs and all unconfirmed unspent outputs under control
-	// of the wallet.
-	WalletBalance(context.Context, *WalletBalanceRequest) (*WalletBalanceResponse, error)
-	// * lncli: `channelbalance`
-	// ChannelBalance returns the total funds available across all open channels
-	// in atoms.
-	ChannelBalance(context.Context, *ChannelBalanceRequest) (*ChannelBalanceResponse, error)
-	// * lncli: `listchaintxns`
-	// GetTransactions returns a list describing all the known transactions
-	// relevant to the wallet.
-	GetTransactions(context.Context, *GetTransactionsRequest) (*TransactionDetails, error)
-	// * lncli: `estimatefee`
-	// EstimateFee asks the chain backend to estimate the fee rate and total fees
-	// for a transaction that pays to multiple specified outputs.
-	EstimateFee(context.Context, *EstimateFeeRequest) (*EstimateFeeResponse, error)
-	// * lncli: `sendcoins`
-	// SendCoins executes a request to send coins to a particular address. Unlike
-	// SendMany, this RPC call only allows creating a single output at a time. If
-	// neither target_conf, or atoms_per_byte are set, then the internal wallet will
-	// consult its fee model to determine a fee for the default confirmation
-	// target.
-	SendCoins(context.Context, *SendCoinsRequest) (*SendCoinsResponse, error)
-	// * lncli: `listunspent`
-	// ListUnspent returns a list of all utxos spendable by the wallet with a
-	// number of confirmations between the specified minimum and maximum.
-	ListUnspent(context.Context, *ListUnspentRequest) (*ListUnspentResponse, error)
+type SetColdSweepResponse struct {
+	// / Whether the scheduled sweeps to cold storage are enabled.
+	Enabled              bool     `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetColdSweepResponse) Reset()         { *m = SetColdSweepResponse{} }
+func (m *SetColdSweepResponse) String() string { return proto.CompactTextString(m) }
+func (*SetColdSweepResponse) ProtoMessage()    {}
+func (*SetColdSweepResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{191}
+}
+func (m *SetColdSweepResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetColdSweepResponse.Unmarshal(m, b)
+}
+func (m *SetColdSweepResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetColdSweepResponse.Marshal(b, m, deterministic)
+}
+func (dst *SetColdSweepResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetColdSweepResponse.Merge(dst, src)
+}
+func (m *SetColdSweepResponse) XXX_Size() int {
+	return xxx_messageInfo_SetColdSweepResponse.Size(m)
+}
+func (m *SetColdSweepResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetColdSweepResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetColdSweepResponse proto.InternalMessageInfo
+
+func (m *SetColdSweepResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+type ColdSweepSubscription struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ColdSweepSubscription) Reset()         { *m = ColdSweepSubscription{} }
+func (m *ColdSweepSubscription) String() string { return proto.CompactTextString(m) }
+func (*ColdSweepSubscription) ProtoMessage()    {}
+func (*ColdSweepSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{192}
+}
+func (m *ColdSweepSubscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ColdSweepSubscription.Unmarshal(m, b)
+}
+func (m *ColdSweepSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ColdSweepSubscription.Marshal(b, m, deterministic)
+}
+func (dst *ColdSweepSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ColdSweepSubscription.Merge(dst, src)
+}
+func (m *ColdSweepSubscription) XXX_Size() int {
+	return xxx_messageInfo_ColdSweepSubscription.Size(m)
+}
+func (m *ColdSweepSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_ColdSweepSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ColdSweepSubscription proto.InternalMessageInfo
+
+type ColdSweepEvent struct {
+	// / The type of the event.
+	Type ColdSweepEvent_EventType `protobuf:"varint,1,opt,name=type,proto3,enum=lnrpc.ColdSweepEvent_EventType" json:"type,omitempty"`
+	// / The txid of the sweep transaction, set for SWEEP_PUBLISHED events.
+	Txid string `protobuf:"bytes,2,opt,name=txid,proto3" json:"txid,omitempty"`
+	// / The amount swept in atoms, set for SWEEP_PUBLISHED events.
+	Amount int64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// / The reason the sweep failed, set for SWEEP_FAILED events.
+	Error                string   `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ColdSweepEvent) Reset()         { *m = ColdSweepEvent{} }
+func (m *ColdSweepEvent) String() string { return proto.CompactTextString(m) }
+func (*ColdSweepEvent) ProtoMessage()    {}
+func (*ColdSweepEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{193}
+}
+func (m *ColdSweepEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ColdSweepEvent.Unmarshal(m, b)
+}
+func (m *ColdSweepEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ColdSweepEvent.Marshal(b, m, deterministic)
+}
+func (dst *ColdSweepEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ColdSweepEvent.Merge(dst, src)
+}
+func (m *ColdSweepEvent) XXX_Size() int {
+	return xxx_messageInfo_ColdSweepEvent.Size(m)
+}
+func (m *ColdSweepEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ColdSweepEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ColdSweepEvent proto.InternalMessageInfo
+
+func (m *ColdSweepEvent) GetType() ColdSweepEvent_EventType {
+	if m != nil {
+		return m.Type
+	}
+	return ColdSweepEvent_SWEEP_PUBLISHED
+}
+
+func (m *ColdSweepEvent) GetTxid() string {
+	if m != nil {
+		return m.Txid
+	}
+	return ""
+}
+
+func (m *ColdSweepEvent) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *ColdSweepEvent) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type InvoiceURIRequest struct {
 	// *
-	// SubscribeTransactions creates a uni-directional stream from the server to
-	// the client in which any newly discovered transactions relevant to the
-	// wallet are sent over.
-	SubscribeTransactions(*GetTransactionsRequest, Lightning_SubscribeTransactionsServer) error
-	// * lncli: `sendmany`
-	// SendMany handles a request for a transaction that creates multiple specified
-	// outputs in parallel. If neither target_conf, or atoms_per_byte are set, then
-	// the internal wallet will consult its fee model to determine a fee for the
-	// default confirmation target.
-	SendMany(context.Context, *SendManyRequest) (*SendManyResponse, error)
-	// * lncli: `newaddress`
-	// NewAddress creates a new address under control of the local wallet.
-	NewAddress(context.Context, *NewAddressRequest) (*NewAddressResponse, error)
-	// * lncli: `signmessage`
-	// SignMessage signs a message with this node's private key. The returned
-	// signature string is `zbase32` encoded and pubkey recoverable, meaning that
-	// only the message digest and signature are needed for verification.
-	SignMessage(context.Context, *SignMessageRequest) (*SignMessageResponse, error)
-	// * lncli: `verifymessage`
-	// VerifyMessage verifies a signature over a msg. The signature must be
-	// zbase32 encoded and signed by an active node in the resident node's
-	// channel database. In addition to returning the validity of the signature,
-	// VerifyMessage also returns the recovered pubkey from the signature.
-	VerifyMessage(context.Context, *VerifyMessageRequest) (*VerifyMessageResponse, error)
-	// * lncli: `connect`
-	// ConnectPeer attempts to establish a connection to a remote peer. This is at
-	// the networking level, and is used for communication between nodes. This is
-	// distinct from establishing a channel with a peer.
-	ConnectPeer(context.Context, *ConnectPeerRequest) (*ConnectPeerResponse, error)
-	// * lncli: `disconnect`
-	// DisconnectPeer attempts to disconnect one peer from another identified by a
-	// given pubKey. In the case that we currently have a pending or active channel
-	// with the target peer, then this action will be not be allowed.
-	DisconnectPeer(context.Context, *DisconnectPeerRequest) (*DisconnectPeerResponse, error)
-	// * lncli: `listpeers`
-	// ListPeers returns a verbose listing of all currently active peers.
-	ListPeers(context.Context, *ListPeersRequest) (*ListPeersResponse, error)
-	// * lncli: `getinfo`
-	// GetInfo returns general information concerning the lightning node including
-	// it's identity pubkey, alias, the chains it is connected to, and information
-	// concerning the number of open+pending channels.
-	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
-	// * lncli: `pendingchannels`
-	// PendingChannels returns a list of all the channels that are currently
-	// considered "pending". A channel is pending if it has finished the funding
-	// workflow and is waiting for confirmations for the funding txn, or is in the
-	// process of closure, either initiated cooperatively or non-cooperatively.
-	PendingChannels(context.Context, *PendingChannelsRequest) (*PendingChannelsResponse, error)
-	// * lncli: `listchannels`
-	// ListChannels returns a description of all the open channels that this node
-	// is a participant in.
-	ListChannels(context.Context, *ListChannelsRequest) (*ListChannelsResponse, error)
+	// The hex-encoded payment hash of the invoice. The passed payment hash must
+	// be exactly 32 bytes, otherwise an error is returned.
+	RHashStr string `protobuf:"bytes,1,opt,name=r_hash_str,proto3" json:"r_hash_str,omitempty"`
+	// / The payment hash of the invoice.
+	RHash []byte `protobuf:"bytes,2,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
+	// / The label of the payment URI. Defaults to the memo of the invoice.
+	Label                string   `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InvoiceURIRequest) Reset()         { *m = InvoiceURIRequest{} }
+func (m *InvoiceURIRequest) String() string { return proto.CompactTextString(m) }
+func (*InvoiceURIRequest) ProtoMessage()    {}
+func (*InvoiceURIRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{194}
+}
+func (m *InvoiceURIRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvoiceURIRequest.Unmarshal(m, b)
+}
+func (m *InvoiceURIRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvoiceURIRequest.Marshal(b, m, deterministic)
+}
+func (dst *InvoiceURIRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvoiceURIRequest.Merge(dst, src)
+}
+func (m *InvoiceURIRequest) XXX_Size() int {
+	return xxx_messageInfo_InvoiceURIRequest.Size(m)
+}
+func (m *InvoiceURIRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvoiceURIRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvoiceURIRequest proto.InternalMessageInfo
+
+func (m *InvoiceURIRequest) GetRHashStr() string {
+	if m != nil {
+		return m.RHashStr
+	}
+	return ""
+}
+
+func (m *InvoiceURIRequest) GetRHash() []byte {
+	if m != nil {
+		return m.RHash
+	}
+	return nil
+}
+
+func (m *InvoiceURIRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+type InvoiceURIResponse struct {
+	// / The invoice.
+	Invoice *Invoice `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
 	// *
-	// SubscribeChannelEvents creates a uni-directional stream from the server to
-	// the client in which any updates relevant to the state of the channels are
-	// sent over. Events include new active channels, inactive channels, and closed
-	// channels.
-	SubscribeChannelEvents(*ChannelEventSubscription, Lightning_SubscribeChannelEventsServer) error
-	// * lncli: `closedchannels`
-	// ClosedChannels returns a description of all the closed channels that
-	// this node was a participant in.
-	ClosedChannels(context.Context, *ClosedChannelsRequest) (*ClosedChannelsResponse, error)
+	// The canonical payment URI of the invoice, of the form
+	// dcrln:<payment_request>?address=<fallback_addr>&amount=<dcr>&label=<label>
+	// where the parameters are only set if the invoice has them.
+	Uri string `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
 	// *
-	// OpenChannelSync is a synchronous version of the OpenChannel RPC call. This
-	// call is meant to be consumed by clients to the REST proxy. As with all
-	// other sync calls, all byte slices are intended to be populated as hex
-	// encoded strings.
-	OpenChannelSync(context.Context, *OpenChannelRequest) (*ChannelPoint, error)
-	// * lncli: `openchannel`
-	// OpenChannel attempts to open a singly funded channel specified in the
-	// request to a remote peer. Users are able to specify a target number of
-	// blocks that the funding transaction should be confirmed in, or a manual fee
-	// rate to us for the funding transaction. If neither are specified, then a
-	// lax block confirmation target is used.
-	OpenChannel(*OpenChannelRequest, Lightning_OpenChannelServer) error
+	// The payment URI of the invoice best suited for QR codes, in upper case and
+	// only including the payment request, so that it can be encoded with the
+	// alphanumeric mode of QR codes.
+	QrUri                string   `protobuf:"bytes,3,opt,name=qr_uri,proto3" json:"qr_uri,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InvoiceURIResponse) Reset()         { *m = InvoiceURIResponse{} }
+func (m *InvoiceURIResponse) String() string { return proto.CompactTextString(m) }
+func (*InvoiceURIResponse) ProtoMessage()    {}
+func (*InvoiceURIResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{195}
+}
+func (m *InvoiceURIResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvoiceURIResponse.Unmarshal(m, b)
+}
+func (m *InvoiceURIResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvoiceURIResponse.Marshal(b, m, deterministic)
+}
+func (dst *InvoiceURIResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvoiceURIResponse.Merge(dst, src)
+}
+func (m *InvoiceURIResponse) XXX_Size() int {
+	return xxx_messageInfo_InvoiceURIResponse.Size(m)
+}
+func (m *InvoiceURIResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvoiceURIResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvoiceURIResponse proto.InternalMessageInfo
+
+func (m *InvoiceURIResponse) GetInvoice() *Invoice {
+	if m != nil {
+		return m.Invoice
+	}
+	return nil
+}
+
+func (m *InvoiceURIResponse) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
+}
+
+func (m *InvoiceURIResponse) GetQrUri() string {
+	if m != nil {
+		return m.QrUri
+	}
+	return ""
+}
+
+type LegacyPayloadStatsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LegacyPayloadStatsRequest) Reset()         { *m = LegacyPayloadStatsRequest{} }
+func (m *LegacyPayloadStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*LegacyPayloadStatsRequest) ProtoMessage()    {}
+func (*LegacyPayloadStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{196}
+}
+func (m *LegacyPayloadStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LegacyPayloadStatsRequest.Unmarshal(m, b)
+}
+func (m *LegacyPayloadStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LegacyPayloadStatsRequest.Marshal(b, m, deterministic)
+}
+func (dst *LegacyPayloadStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LegacyPayloadStatsRequest.Merge(dst, src)
+}
+func (m *LegacyPayloadStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_LegacyPayloadStatsRequest.Size(m)
+}
+func (m *LegacyPayloadStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LegacyPayloadStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LegacyPayloadStatsRequest proto.InternalMessageInfo
+
+type LegacyPayloadStatsResponse struct {
+	// / The number of HTLCs with a legacy onion payload forwarded.
+	NumForwarded uint64 `protobuf:"varint,1,opt,name=num_forwarded,proto3" json:"num_forwarded,omitempty"`
+	// / The number of HTLCs with a legacy onion payload received.
+	NumReceived uint64 `protobuf:"varint,2,opt,name=num_received,proto3" json:"num_received,omitempty"`
 	// *
-	// ChannelAcceptor dispatches a bi-directional streaming RPC in which
-	// OpenChannel requests are sent to the client and the client responds with
-	// a boolean that tells LND whether or not to accept the channel. This allows
-	// node operators to specify their own criteria for accepting inbound channels
-	// through a single persistent connection.
-	ChannelAcceptor(Lightning_ChannelAcceptorServer) error
-	// * lncli: `closechannel`
-	// CloseChannel attempts to close an active channel identified by its channel
-	// outpoint (ChannelPoint). The actions of this method can additionally be
-	// augmented to attempt a force close after a timeout period in the case of an
-	// inactive peer. If a non-force close (cooperative closure) is requested,
-	// then the user can specify either a target number of blocks until the
-	// closure transaction is confirmed, or a manual fee rate. If neither are
-	// specified, then a default lax, block confirmation target is used.
-	CloseChannel(*CloseChannelRequest, Lightning_CloseChannelServer) error
-	// * lncli: `abandonchannel`
-	// AbandonChannel removes all channel state from the database except for a
-	// close summary. This method can be used to get rid of permanently unusable
-	// channels due to bugs fixed in newer versions of lnd. Only available
-	// when in debug builds of lnd.
-	AbandonChannel(context.Context, *AbandonChannelRequest) (*AbandonChannelResponse, error)
-	// * lncli: `sendpayment`
-	// SendPayment dispatches a bi-directional streaming RPC for sending payments
-	// through the Lightning Network. A single RPC invocation creates a persistent
-	// bi-directional stream allowing clients to rapidly send payments through the
-	// Lightning Network with a single persistent connection.
-	SendPayment(Lightning_SendPaymentServer) error
+	// The number of HTLCs with a legacy onion payload that were failed back
+	// because legacy onion payloads are no longer accepted.
+	NumRejected uint64 `protobuf:"varint,3,opt,name=num_rejected,proto3" json:"num_rejected,omitempty"`
 	// *
-	// SendPaymentSync is the synchronous non-streaming version of SendPayment.
-	// This RPC is intended to be consumed by clients of the REST proxy.
-	// Additionally, this RPC expects the destination's public key and the payment
-	// hash (if any) to be encoded as hex strings.
-	SendPaymentSync(context.Context, *SendRequest) (*SendResponse, error)
-	// * lncli: `sendtoroute`
-	// SendToRoute is a bi-directional streaming RPC for sending payment through
-	// the Lightning Network. This method differs from SendPayment in that it
-	// allows users to specify a full route manually. This can be used for things
-	// like rebalancing, and atomic swaps.
-	SendToRoute(Lightning_SendToRouteServer) error
-	// *
-	// SendToRouteSync is a synchronous version of SendToRoute. It Will block
-	// until the payment either fails or succeeds.
-	SendToRouteSync(context.Context, *SendToRouteRequest) (*SendResponse, error)
-	// * lncli: `addinvoice`
-	// AddInvoice attempts to add a new invoice to the invoice database. Any
-	// duplicated invoices are rejected, therefore all invoices *must* have a
-	// unique payment preimage.
-	AddInvoice(context.Context, *Invoice) (*AddInvoiceResponse, error)
-	// * lncli: `listinvoices`
-	// ListInvoices returns a list of all the invoices currently stored within the
-	// database. Any active debug invoices are ignored. It has full support for
-	// paginated responses, allowing users to query for specific invoices through
-	// their add_index. This can be done by using either the first_index_offset or
-	// last_index_offset fields included in the response as the index_offset of the
-	// next request. By default, the first 100 invoices created will be returned.
-	// Backwards pagination is also supported through the Reversed flag.
-	ListInvoices(context.Context, *ListInvoiceRequest) (*ListInvoiceResponse, error)
-	// * lncli: `lookupinvoice`
-	// LookupInvoice attempts to look up an invoice according to its payment hash.
-	// The passed payment hash *must* be exactly 32 bytes, if not, an error is
-	// returned.
-	LookupInvoice(context.Context, *PaymentHash) (*Invoice, error)
-	// *
-	// SubscribeInvoices returns a uni-directional stream (server -> client) for
-	// notifying the client of newly added/settled invoices. The caller can
-	// optionally specify the add_index and/or the settle_index. If the add_index
-	// is specified, then we'll first start by sending add invoice events for all
-	// invoices with an add_index greater than the specified value.  If the
-	// settle_index is specified, the next, we'll send out all settle events for
-	// invoices with a settle_index greater than the specified value.  One or both
-	// of these fields can be set. If no fields are set, then we'll only send out
-	// the latest add/settle events.
-	SubscribeInvoices(*InvoiceSubscription, Lightning_SubscribeInvoicesServer) error
-	// * lncli: `decodepayreq`
-	// DecodePayReq takes an encoded payment request string and attempts to decode
-	// it, returning a full description of the conditions encoded within the
-	// payment request.
-	DecodePayReq(context.Context, *PayReqString) (*PayReq, error)
-	// * lncli: `listpayments`
-	// ListPayments returns a list of all outgoing payments.
-	ListPayments(context.Context, *ListPaymentsRequest) (*ListPaymentsResponse, error)
-	// *
-	// DeleteAllPayments deletes all outgoing payments from DB.
-	DeleteAllPayments(context.Context, *DeleteAllPaymentsRequest) (*DeleteAllPaymentsResponse, error)
-	// * lncli: `describegraph`
-	// DescribeGraph returns a description of the latest graph state from the
-	// point of view of the node. The graph information is partitioned into two
-	// components: all the nodes/vertexes, and all the edges that connect the
-	// vertexes themselves.  As this is a directed graph, the edges also contain
-	// the node directional specific routing policy which includes: the time lock
-	// delta, fee information, etc.
-	DescribeGraph(context.Context, *ChannelGraphRequest) (*ChannelGraph, error)
-	// * lncli: `getchaninfo`
-	// GetChanInfo returns the latest authenticated network announcement for the
-	// given channel identified by its channel ID: an 8-byte integer which
-	// uniquely identifies the location of transaction's funding output within the
-	// blockchain.
-	GetChanInfo(context.Context, *ChanInfoRequest) (*ChannelEdge, error)
-	// * lncli: `getnodeinfo`
-	// GetNodeInfo returns the latest advertised, aggregated, and authenticated
-	// channel information for the specified node identified by its public key.
-	GetNodeInfo(context.Context, *NodeInfoRequest) (*NodeInfo, error)
-	// * lncli: `queryroutes`
-	// QueryRoutes attempts to query the daemon's Channel Router for a possible
-	// route to a target destination capable of carrying a specific amount of
-	// atoms. The retuned route contains the full details required to craft and
-	// send an HTLC, also including the necessary information that should be
-	// present within the Sphinx packet encapsulated within the HTLC.
-	QueryRoutes(context.Context, *QueryRoutesRequest) (*QueryRoutesResponse, error)
-	// * lncli: `getnetworkinfo`
-	// GetNetworkInfo returns some basic stats about the known channel graph from
-	// the point of view of the node.
-	GetNetworkInfo(context.Context, *NetworkInfoRequest) (*NetworkInfo, error)
-	// * lncli: `stop`
-	// StopDaemon will send a shutdown request to the interrupt handler, triggering
-	// a graceful shutdown of the daemon.
-	StopDaemon(context.Context, *StopRequest) (*StopResponse, error)
-	// *
-	// SubscribeChannelGraph launches a streaming RPC that allows the caller to
-	// receive notifications upon any changes to the channel graph topology from
-	// the point of view of the responding node. Events notified include: new
-	// nodes coming online, nodes updating their authenticated attributes, new
-	// channels being advertised, updates in the routing policy for a directional
-	// channel edge, and when channels are closed on-chain.
-	SubscribeChannelGraph(*GraphTopologySubscription, Lightning_SubscribeChannelGraphServer) error
-	// * lncli: `debuglevel`
-	// DebugLevel allows a caller to programmatically set the logging verbosity of
-	// lnd. The logging can be targeted according to a coarse daemon-wide logging
-	// level, or in a granular fashion to specify the logging for a target
-	// sub-system.
-	DebugLevel(context.Context, *DebugLevelRequest) (*DebugLevelResponse, error)
-	// * lncli: `feereport`
-	// FeeReport allows the caller to obtain a report detailing the current fee
-	// schedule enforced by the node globally for each channel.
-	FeeReport(context.Context, *FeeReportRequest) (*FeeReportResponse, error)
-	// * lncli: `updatechanpolicy`
-	// UpdateChannelPolicy allows the caller to update the fee schedule and
-	// channel policies for all channels globally, or a particular channel.
-	UpdateChannelPolicy(context.Context, *PolicyUpdateRequest) (*PolicyUpdateResponse, error)
-	// * lncli: `fwdinghistory`
-	// ForwardingHistory allows the caller to query the htlcswitch for a record of
-	// all HTLCs forwarded within the target time range, and integer offset
-	// within that time range. If no time-range is specified, then the first chunk
-	// of the past 24 hrs of forwarding history are returned.
-	//
-	// A list of forwarding events are returned. The size of each forwarding event
-	// is 40 bytes, and the max message size able to be returned in gRPC is 4 MiB.
-	// As a result each message can only contain 50k entries.  Each response has
-	// the index offset of the last entry. The index offset can be provided to the
-	// request to allow the caller to skip a series of records.
-	ForwardingHistory(context.Context, *ForwardingHistoryRequest) (*ForwardingHistoryResponse, error)
-	// * lncli: `exportchanbackup`
-	// ExportChannelBackup attempts to return an encrypted static channel backup
-	// for the target channel identified by it channel point. The backup is
-	// encrypted with a key generated from the aezeed seed of the user. The
-	// returned backup can either be restored using the RestoreChannelBackup
-	// method once lnd is running, or via the InitWallet and UnlockWallet methods
-	// from the WalletUnlocker service.
-	ExportChannelBackup(context.Context, *ExportChannelBackupRequest) (*ChannelBackup, error)
+	// The block height starting at which legacy onion payloads are rejected, or
+	// zero if not set.
+	RejectHeight uint32 `protobuf:"varint,4,opt,name=reject_height,proto3" json:"reject_height,omitempty"`
 	// *
-	// ExportAllChannelBackups returns static channel backups for all existing
-	// channels known to lnd. A set of regular singular static channel backups for
-	// each channel are returned. Additionally, a multi-channel backup is returned
-	// as well, which contains a single encrypted blob containing the backups of
-	// each channel.
-	ExportAllChannelBackups(context.Context, *ChanBackupExportRequest) (*ChanBackupSnapshot, error)
+	// The unix timestamp starting at which legacy onion payloads are rejected, or
+	// zero if not set.
+	RejectTime           int64    `protobuf:"varint,5,opt,name=reject_time,proto3" json:"reject_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LegacyPayloadStatsResponse) Reset()         { *m = LegacyPayloadStatsResponse{} }
+func (m *LegacyPayloadStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*LegacyPayloadStatsResponse) ProtoMessage()    {}
+func (*LegacyPayloadStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{197}
+}
+func (m *LegacyPayloadStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LegacyPayloadStatsResponse.Unmarshal(m, b)
+}
+func (m *LegacyPayloadStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LegacyPayloadStatsResponse.Marshal(b, m, deterministic)
+}
+func (dst *LegacyPayloadStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LegacyPayloadStatsResponse.Merge(dst, src)
+}
+func (m *LegacyPayloadStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_LegacyPayloadStatsResponse.Size(m)
+}
+func (m *LegacyPayloadStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LegacyPayloadStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LegacyPayloadStatsResponse proto.InternalMessageInfo
+
+func (m *LegacyPayloadStatsResponse) GetNumForwarded() uint64 {
+	if m != nil {
+		return m.NumForwarded
+	}
+	return 0
+}
+
+func (m *LegacyPayloadStatsResponse) GetNumReceived() uint64 {
+	if m != nil {
+		return m.NumReceived
+	}
+	return 0
+}
+
+func (m *LegacyPayloadStatsResponse) GetNumRejected() uint64 {
+	if m != nil {
+		return m.NumRejected
+	}
+	return 0
+}
+
+func (m *LegacyPayloadStatsResponse) GetRejectHeight() uint32 {
+	if m != nil {
+		return m.RejectHeight
+	}
+	return 0
+}
+
+func (m *LegacyPayloadStatsResponse) GetRejectTime() int64 {
+	if m != nil {
+		return m.RejectTime
+	}
+	return 0
+}
+
+type PushGossipRequest struct {
+	// / The public key of the connected peer to send our announcements to.
+	Peer []byte `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
+	// / Whether our node announcement should be sent.
+	NodeAnnouncement bool `protobuf:"varint,2,opt,name=node_announcement,proto3" json:"node_announcement,omitempty"`
 	// *
-	// VerifyChanBackup allows a caller to verify the integrity of a channel backup
-	// snapshot. This method will accept either a packed Single or a packed Multi.
-	// Specifying both will result in an error.
-	VerifyChanBackup(context.Context, *ChanBackupSnapshot) (*VerifyChanBackupResponse, error)
-	// * lncli: `restorechanbackup`
-	// RestoreChannelBackups accepts a set of singular channel backups, or a
-	// single encrypted multi-chan backup and attempts to recover any funds
-	// remaining within the channel. If we are able to unpack the backup, then the
-	// new channel will be shown under listchannels, as well as pending channels.
-	RestoreChannelBackups(context.Context, *RestoreChanBackupRequest) (*RestoreBackupResponse, error)
+	// The short channel IDs of our channels whose announcement and latest update
+	// should be sent.
+	ChanIds []uint64 `protobuf:"varint,3,rep,packed,name=chan_ids,proto3" json:"chan_ids,omitempty"`
 	// *
-	// SubscribeChannelBackups allows a client to sub-subscribe to the most up to
-	// date information concerning the state of all channel backups. Each time a
-	// new channel is added, we return the new set of channels, along with a
-	// multi-chan backup containing the backup info for all channels. Each time a
-	// channel is closed, we send a new update, which contains new new chan back
-	// ups, but the updated set of encrypted multi-chan backups with the closed
-	// channel(s) removed.
-	SubscribeChannelBackups(*ChannelBackupSubscription, Lightning_SubscribeChannelBackupsServer) error
+	// If set, the announcements and latest updates of all our open channels are
+	// sent, and chan_ids is ignored.
+	AllChannels          bool     `protobuf:"varint,4,opt,name=all_channels,proto3" json:"all_channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PushGossipRequest) Reset()         { *m = PushGossipRequest{} }
+func (m *PushGossipRequest) String() string { return proto.CompactTextString(m) }
+func (*PushGossipRequest) ProtoMessage()    {}
+func (*PushGossipRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{198}
+}
+func (m *PushGossipRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PushGossipRequest.Unmarshal(m, b)
+}
+func (m *PushGossipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PushGossipRequest.Marshal(b, m, deterministic)
+}
+func (dst *PushGossipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushGossipRequest.Merge(dst, src)
+}
+func (m *PushGossipRequest) XXX_Size() int {
+	return xxx_messageInfo_PushGossipRequest.Size(m)
+}
+func (m *PushGossipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushGossipRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PushGossipRequest proto.InternalMessageInfo
+
+func (m *PushGossipRequest) GetPeer() []byte {
+	if m != nil {
+		return m.Peer
+	}
+	return nil
+}
+
+func (m *PushGossipRequest) GetNodeAnnouncement() bool {
+	if m != nil {
+		return m.NodeAnnouncement
+	}
+	return false
+}
+
+func (m *PushGossipRequest) GetChanIds() []uint64 {
+	if m != nil {
+		return m.ChanIds
+	}
+	return nil
+}
+
+func (m *PushGossipRequest) GetAllChannels() bool {
+	if m != nil {
+		return m.AllChannels
+	}
+	return false
+}
+
+type PushGossipResponse struct {
+	// / The number of gossip messages sent to the peer.
+	NumMessages          uint32   `protobuf:"varint,1,opt,name=num_messages,proto3" json:"num_messages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PushGossipResponse) Reset()         { *m = PushGossipResponse{} }
+func (m *PushGossipResponse) String() string { return proto.CompactTextString(m) }
+func (*PushGossipResponse) ProtoMessage()    {}
+func (*PushGossipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{199}
+}
+func (m *PushGossipResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PushGossipResponse.Unmarshal(m, b)
+}
+func (m *PushGossipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PushGossipResponse.Marshal(b, m, deterministic)
+}
+func (dst *PushGossipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushGossipResponse.Merge(dst, src)
+}
+func (m *PushGossipResponse) XXX_Size() int {
+	return xxx_messageInfo_PushGossipResponse.Size(m)
+}
+func (m *PushGossipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushGossipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PushGossipResponse proto.InternalMessageInfo
+
+func (m *PushGossipResponse) GetNumMessages() uint32 {
+	if m != nil {
+		return m.NumMessages
+	}
+	return 0
+}
+
+type InboundFee struct {
+	// / The inbound base fee in milli-atoms. It must be zero or negative, as only discounts are supported.
+	BaseFeeMAtoms int32 `protobuf:"varint,1,opt,name=base_fee_m_atoms,proto3" json:"base_fee_m_atoms,omitempty"`
+	// / The inbound fee rate in millionths of an atom. It must be zero or negative, as only discounts are supported.
+	FeeRatePpm           int32    `protobuf:"varint,2,opt,name=fee_rate_ppm,proto3" json:"fee_rate_ppm,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InboundFee) Reset()         { *m = InboundFee{} }
+func (m *InboundFee) String() string { return proto.CompactTextString(m) }
+func (*InboundFee) ProtoMessage()    {}
+func (*InboundFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{200}
+}
+func (m *InboundFee) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InboundFee.Unmarshal(m, b)
+}
+func (m *InboundFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InboundFee.Marshal(b, m, deterministic)
+}
+func (dst *InboundFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InboundFee.Merge(dst, src)
+}
+func (m *InboundFee) XXX_Size() int {
+	return xxx_messageInfo_InboundFee.Size(m)
+}
+func (m *InboundFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_InboundFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InboundFee proto.InternalMessageInfo
+
+func (m *InboundFee) GetBaseFeeMAtoms() int32 {
+	if m != nil {
+		return m.BaseFeeMAtoms
+	}
+	return 0
+}
+
+func (m *InboundFee) GetFeeRatePpm() int32 {
+	if m != nil {
+		return m.FeeRatePpm
+	}
+	return 0
+}
+
+type FeeManagerReportRequest struct {
+	// / The maximum number of the most recent fee decisions to return. All the decisions are returned if zero.
+	MaxDecisions         uint32   `protobuf:"varint,1,opt,name=max_decisions,proto3" json:"max_decisions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FeeManagerReportRequest) Reset()         { *m = FeeManagerReportRequest{} }
+func (m *FeeManagerReportRequest) String() string { return proto.CompactTextString(m) }
+func (*FeeManagerReportRequest) ProtoMessage()    {}
+func (*FeeManagerReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{201}
+}
+func (m *FeeManagerReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeeManagerReportRequest.Unmarshal(m, b)
+}
+func (m *FeeManagerReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeeManagerReportRequest.Marshal(b, m, deterministic)
+}
+func (dst *FeeManagerReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeManagerReportRequest.Merge(dst, src)
+}
+func (m *FeeManagerReportRequest) XXX_Size() int {
+	return xxx_messageInfo_FeeManagerReportRequest.Size(m)
+}
+func (m *FeeManagerReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeManagerReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeManagerReportRequest proto.InternalMessageInfo
+
+func (m *FeeManagerReportRequest) GetMaxDecisions() uint32 {
+	if m != nil {
+		return m.MaxDecisions
+	}
+	return 0
+}
+
+type FeeDecision struct {
+	// / The unix timestamp in seconds of the fee rate adjustment.
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// / The channel whose fee rate was adjusted.
+	ChanPoint string `protobuf:"bytes,2,opt,name=chan_point,proto3" json:"chan_point,omitempty"`
+	// / The fee rate, in millionths, before the adjustment.
+	OldFeePerMil uint32 `protobuf:"varint,3,opt,name=old_fee_per_mil,proto3" json:"old_fee_per_mil,omitempty"`
+	// / The fee rate, in millionths, after the adjustment.
+	NewFeePerMil uint32 `protobuf:"varint,4,opt,name=new_fee_per_mil,proto3" json:"new_fee_per_mil,omitempty"`
+	// / The capacity of the channel in atoms.
+	Capacity int64 `protobuf:"varint,5,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// / Our balance in the channel in atoms at the time of the adjustment.
+	LocalBalance int64 `protobuf:"varint,6,opt,name=local_balance,proto3" json:"local_balance,omitempty"`
+	// / The amount forwarded over the channel since the previous round of adjustments.
+	ForwardedMAtoms      uint64   `protobuf:"varint,7,opt,name=forwarded_m_atoms,proto3" json:"forwarded_m_atoms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FeeDecision) Reset()         { *m = FeeDecision{} }
+func (m *FeeDecision) String() string { return proto.CompactTextString(m) }
+func (*FeeDecision) ProtoMessage()    {}
+func (*FeeDecision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{202}
+}
+func (m *FeeDecision) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeeDecision.Unmarshal(m, b)
+}
+func (m *FeeDecision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeeDecision.Marshal(b, m, deterministic)
+}
+func (dst *FeeDecision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDecision.Merge(dst, src)
+}
+func (m *FeeDecision) XXX_Size() int {
+	return xxx_messageInfo_FeeDecision.Size(m)
+}
+func (m *FeeDecision) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDecision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDecision proto.InternalMessageInfo
+
+func (m *FeeDecision) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *FeeDecision) GetChanPoint() string {
+	if m != nil {
+		return m.ChanPoint
+	}
+	return ""
+}
+
+func (m *FeeDecision) GetOldFeePerMil() uint32 {
+	if m != nil {
+		return m.OldFeePerMil
+	}
+	return 0
+}
+
+func (m *FeeDecision) GetNewFeePerMil() uint32 {
+	if m != nil {
+		return m.NewFeePerMil
+	}
+	return 0
+}
+
+func (m *FeeDecision) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *FeeDecision) GetLocalBalance() int64 {
+	if m != nil {
+		return m.LocalBalance
+	}
+	return 0
+}
+
+func (m *FeeDecision) GetForwardedMAtoms() uint64 {
+	if m != nil {
+		return m.ForwardedMAtoms
+	}
+	return 0
+}
+
+type FeeManagerReportResponse struct {
+	// / Whether the fee manager is active.
+	Active bool `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	// / The strategy deciding the fee rates.
+	Strategy string `protobuf:"bytes,2,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	// / The time in seconds between two rounds of adjustments.
+	Interval uint64 `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	// / The unix timestamp in seconds of the last round of adjustments.
+	LastRun int64 `protobuf:"varint,4,opt,name=last_run,proto3" json:"last_run,omitempty"`
+	// / The most recent fee rate adjustments, most recent first.
+	Decisions            []*FeeDecision `protobuf:"bytes,5,rep,name=decisions,proto3" json:"decisions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *FeeManagerReportResponse) Reset()         { *m = FeeManagerReportResponse{} }
+func (m *FeeManagerReportResponse) String() string { return proto.CompactTextString(m) }
+func (*FeeManagerReportResponse) ProtoMessage()    {}
+func (*FeeManagerReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{203}
+}
+func (m *FeeManagerReportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeeManagerReportResponse.Unmarshal(m, b)
+}
+func (m *FeeManagerReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeeManagerReportResponse.Marshal(b, m, deterministic)
+}
+func (dst *FeeManagerReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeManagerReportResponse.Merge(dst, src)
+}
+func (m *FeeManagerReportResponse) XXX_Size() int {
+	return xxx_messageInfo_FeeManagerReportResponse.Size(m)
+}
+func (m *FeeManagerReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeManagerReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeManagerReportResponse proto.InternalMessageInfo
+
+func (m *FeeManagerReportResponse) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *FeeManagerReportResponse) GetStrategy() string {
+	if m != nil {
+		return m.Strategy
+	}
+	return ""
+}
+
+func (m *FeeManagerReportResponse) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *FeeManagerReportResponse) GetLastRun() int64 {
+	if m != nil {
+		return m.LastRun
+	}
+	return 0
+}
+
+func (m *FeeManagerReportResponse) GetDecisions() []*FeeDecision {
+	if m != nil {
+		return m.Decisions
+	}
+	return nil
+}
+
+type EffectiveFeeRatesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EffectiveFeeRatesRequest) Reset()         { *m = EffectiveFeeRatesRequest{} }
+func (m *EffectiveFeeRatesRequest) String() string { return proto.CompactTextString(m) }
+func (*EffectiveFeeRatesRequest) ProtoMessage()    {}
+func (*EffectiveFeeRatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{204}
+}
+func (m *EffectiveFeeRatesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EffectiveFeeRatesRequest.Unmarshal(m, b)
+}
+func (m *EffectiveFeeRatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EffectiveFeeRatesRequest.Marshal(b, m, deterministic)
+}
+func (dst *EffectiveFeeRatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EffectiveFeeRatesRequest.Merge(dst, src)
+}
+func (m *EffectiveFeeRatesRequest) XXX_Size() int {
+	return xxx_messageInfo_EffectiveFeeRatesRequest.Size(m)
+}
+func (m *EffectiveFeeRatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EffectiveFeeRatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EffectiveFeeRatesRequest proto.InternalMessageInfo
+
+type EffectiveFeeRate struct {
+	// / The subsystem paying the fee: Funding, CoopClose, Sweep, Anchor or Justice.
+	Subsystem string `protobuf:"bytes,1,opt,name=subsystem,proto3" json:"subsystem,omitempty"`
+	// / The confirmation target configured for the subsystem.
+	ConfTarget uint32 `protobuf:"varint,2,opt,name=conf_target,proto3" json:"conf_target,omitempty"`
+	// / The fee rate in atoms/kB currently estimated for the confirmation target.
+	FeePerKb             int64    `protobuf:"varint,3,opt,name=fee_per_kb,proto3" json:"fee_per_kb,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EffectiveFeeRate) Reset()         { *m = EffectiveFeeRate{} }
+func (m *EffectiveFeeRate) String() string { return proto.CompactTextString(m) }
+func (*EffectiveFeeRate) ProtoMessage()    {}
+func (*EffectiveFeeRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{205}
+}
+func (m *EffectiveFeeRate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EffectiveFeeRate.Unmarshal(m, b)
+}
+func (m *EffectiveFeeRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EffectiveFeeRate.Marshal(b, m, deterministic)
+}
+func (dst *EffectiveFeeRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EffectiveFeeRate.Merge(dst, src)
+}
+func (m *EffectiveFeeRate) XXX_Size() int {
+	return xxx_messageInfo_EffectiveFeeRate.Size(m)
+}
+func (m *EffectiveFeeRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EffectiveFeeRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EffectiveFeeRate proto.InternalMessageInfo
+
+func (m *EffectiveFeeRate) GetSubsystem() string {
+	if m != nil {
+		return m.Subsystem
+	}
+	return ""
+}
+
+func (m *EffectiveFeeRate) GetConfTarget() uint32 {
+	if m != nil {
+		return m.ConfTarget
+	}
+	return 0
+}
+
+func (m *EffectiveFeeRate) GetFeePerKb() int64 {
+	if m != nil {
+		return m.FeePerKb
+	}
+	return 0
+}
+
+type EffectiveFeeRatesResponse struct {
+	// / The effective fee rate of each subsystem.
+	FeeRates             []*EffectiveFeeRate `protobuf:"bytes,1,rep,name=fee_rates,proto3" json:"fee_rates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *EffectiveFeeRatesResponse) Reset()         { *m = EffectiveFeeRatesResponse{} }
+func (m *EffectiveFeeRatesResponse) String() string { return proto.CompactTextString(m) }
+func (*EffectiveFeeRatesResponse) ProtoMessage()    {}
+func (*EffectiveFeeRatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{206}
+}
+func (m *EffectiveFeeRatesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EffectiveFeeRatesResponse.Unmarshal(m, b)
+}
+func (m *EffectiveFeeRatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EffectiveFeeRatesResponse.Marshal(b, m, deterministic)
+}
+func (dst *EffectiveFeeRatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EffectiveFeeRatesResponse.Merge(dst, src)
+}
+func (m *EffectiveFeeRatesResponse) XXX_Size() int {
+	return xxx_messageInfo_EffectiveFeeRatesResponse.Size(m)
+}
+func (m *EffectiveFeeRatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EffectiveFeeRatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EffectiveFeeRatesResponse proto.InternalMessageInfo
+
+func (m *EffectiveFeeRatesResponse) GetFeeRates() []*EffectiveFeeRate {
+	if m != nil {
+		return m.FeeRates
+	}
+	return nil
+}
+
+type GetDebugInfoRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDebugInfoRequest) Reset()         { *m = GetDebugInfoRequest{} }
+func (m *GetDebugInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDebugInfoRequest) ProtoMessage()    {}
+func (*GetDebugInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{207}
+}
+func (m *GetDebugInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDebugInfoRequest.Unmarshal(m, b)
+}
+func (m *GetDebugInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDebugInfoRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetDebugInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDebugInfoRequest.Merge(dst, src)
+}
+func (m *GetDebugInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDebugInfoRequest.Size(m)
+}
+func (m *GetDebugInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDebugInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDebugInfoRequest proto.InternalMessageInfo
+
+type DebugBucketStats struct {
+	// / The name of the top level database bucket.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// / The number of keys stored in the bucket and its nested buckets.
+	Keys uint64 `protobuf:"varint,2,opt,name=keys,proto3" json:"keys,omitempty"`
+	// / The number of bytes taken by the keys and values of the bucket.
+	Bytes                uint64   `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DebugBucketStats) Reset()         { *m = DebugBucketStats{} }
+func (m *DebugBucketStats) String() string { return proto.CompactTextString(m) }
+func (*DebugBucketStats) ProtoMessage()    {}
+func (*DebugBucketStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{208}
+}
+func (m *DebugBucketStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DebugBucketStats.Unmarshal(m, b)
+}
+func (m *DebugBucketStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DebugBucketStats.Marshal(b, m, deterministic)
+}
+func (dst *DebugBucketStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DebugBucketStats.Merge(dst, src)
+}
+func (m *DebugBucketStats) XXX_Size() int {
+	return xxx_messageInfo_DebugBucketStats.Size(m)
+}
+func (m *DebugBucketStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_DebugBucketStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DebugBucketStats proto.InternalMessageInfo
+
+func (m *DebugBucketStats) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DebugBucketStats) GetKeys() uint64 {
+	if m != nil {
+		return m.Keys
+	}
+	return 0
+}
+
+func (m *DebugBucketStats) GetBytes() uint64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+type GetDebugInfoResponse struct {
+	// / The active configuration, keyed by option name. Sensitive options are redacted.
+	Config map[string]string `protobuf:"bytes,1,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// / The number of goroutines currently running.
+	NumGoroutines uint32 `protobuf:"varint,2,opt,name=num_goroutines,proto3" json:"num_goroutines,omitempty"`
+	// / The number of bytes of allocated heap objects.
+	HeapAllocBytes uint64 `protobuf:"varint,3,opt,name=heap_alloc_bytes,proto3" json:"heap_alloc_bytes,omitempty"`
+	// / The size of each of the top level buckets of the channel database.
+	DbBuckets []*DebugBucketStats `protobuf:"bytes,4,rep,name=db_buckets,proto3" json:"db_buckets,omitempty"`
+	// / The number of peers currently connected.
+	NumPeers uint32 `protobuf:"varint,5,opt,name=num_peers,proto3" json:"num_peers,omitempty"`
+	// / The number of channels waiting for their funding transaction to confirm.
+	NumPendingOpenChannels uint32 `protobuf:"varint,6,opt,name=num_pending_open_channels,proto3" json:"num_pending_open_channels,omitempty"`
+	// / The number of channels waiting for their closing transaction to confirm.
+	NumWaitingCloseChannels uint32 `protobuf:"varint,7,opt,name=num_waiting_close_channels,proto3" json:"num_waiting_close_channels,omitempty"`
+	// / The number of outgoing payments still in flight.
+	NumInflightPayments uint32 `protobuf:"varint,8,opt,name=num_inflight_payments,proto3" json:"num_inflight_payments,omitempty"`
+	// / The number of circuits in the switch whose htlc wasn't forwarded yet.
+	NumPendingCircuits uint32 `protobuf:"varint,9,opt,name=num_pending_circuits,proto3" json:"num_pending_circuits,omitempty"`
+	// / The number of circuits in the switch whose htlc is forwarded.
+	NumOpenCircuits uint32 `protobuf:"varint,10,opt,name=num_open_circuits,proto3" json:"num_open_circuits,omitempty"`
+	// / The number of inputs the sweeper is trying to sweep.
+	NumPendingSweeps     uint32   `protobuf:"varint,11,opt,name=num_pending_sweeps,proto3" json:"num_pending_sweeps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDebugInfoResponse) Reset()         { *m = GetDebugInfoResponse{} }
+func (m *GetDebugInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDebugInfoResponse) ProtoMessage()    {}
+func (*GetDebugInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{209}
+}
+func (m *GetDebugInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDebugInfoResponse.Unmarshal(m, b)
+}
+func (m *GetDebugInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDebugInfoResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetDebugInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDebugInfoResponse.Merge(dst, src)
+}
+func (m *GetDebugInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDebugInfoResponse.Size(m)
+}
+func (m *GetDebugInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDebugInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDebugInfoResponse proto.InternalMessageInfo
+
+func (m *GetDebugInfoResponse) GetConfig() map[string]string {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *GetDebugInfoResponse) GetNumGoroutines() uint32 {
+	if m != nil {
+		return m.NumGoroutines
+	}
+	return 0
+}
+
+func (m *GetDebugInfoResponse) GetHeapAllocBytes() uint64 {
+	if m != nil {
+		return m.HeapAllocBytes
+	}
+	return 0
+}
+
+func (m *GetDebugInfoResponse) GetDbBuckets() []*DebugBucketStats {
+	if m != nil {
+		return m.DbBuckets
+	}
+	return nil
+}
+
+func (m *GetDebugInfoResponse) GetNumPeers() uint32 {
+	if m != nil {
+		return m.NumPeers
+	}
+	return 0
+}
+
+func (m *GetDebugInfoResponse) GetNumPendingOpenChannels() uint32 {
+	if m != nil {
+		return m.NumPendingOpenChannels
+	}
+	return 0
+}
+
+func (m *GetDebugInfoResponse) GetNumWaitingCloseChannels() uint32 {
+	if m != nil {
+		return m.NumWaitingCloseChannels
+	}
+	return 0
+}
+
+func (m *GetDebugInfoResponse) GetNumInflightPayments() uint32 {
+	if m != nil {
+		return m.NumInflightPayments
+	}
+	return 0
+}
+
+func (m *GetDebugInfoResponse) GetNumPendingCircuits() uint32 {
+	if m != nil {
+		return m.NumPendingCircuits
+	}
+	return 0
+}
+
+func (m *GetDebugInfoResponse) GetNumOpenCircuits() uint32 {
+	if m != nil {
+		return m.NumOpenCircuits
+	}
+	return 0
+}
+
+func (m *GetDebugInfoResponse) GetNumPendingSweeps() uint32 {
+	if m != nil {
+		return m.NumPendingSweeps
+	}
+	return 0
+}
+
+type CheckMacaroonPermissionsRequest struct {
+	// / The raw bytes of the macaroon to check.
+	Macaroon []byte `protobuf:"bytes,1,opt,name=macaroon,proto3" json:"macaroon,omitempty"`
+	// / The permissions the macaroon must grant.
+	Permissions []*MacaroonPermission `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	// *
+	// If set, the macaroon must also grant the permissions required by this RPC
+	// method, given by its full URI, e.g. /lnrpc.Lightning/GetInfo.
+	FullMethod           string   `protobuf:"bytes,3,opt,name=full_method,proto3" json:"full_method,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckMacaroonPermissionsRequest) Reset()         { *m = CheckMacaroonPermissionsRequest{} }
+func (m *CheckMacaroonPermissionsRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckMacaroonPermissionsRequest) ProtoMessage()    {}
+func (*CheckMacaroonPermissionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{210}
+}
+func (m *CheckMacaroonPermissionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckMacaroonPermissionsRequest.Unmarshal(m, b)
+}
+func (m *CheckMacaroonPermissionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckMacaroonPermissionsRequest.Marshal(b, m, deterministic)
+}
+func (dst *CheckMacaroonPermissionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckMacaroonPermissionsRequest.Merge(dst, src)
+}
+func (m *CheckMacaroonPermissionsRequest) XXX_Size() int {
+	return xxx_messageInfo_CheckMacaroonPermissionsRequest.Size(m)
+}
+func (m *CheckMacaroonPermissionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckMacaroonPermissionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckMacaroonPermissionsRequest proto.InternalMessageInfo
+
+func (m *CheckMacaroonPermissionsRequest) GetMacaroon() []byte {
+	if m != nil {
+		return m.Macaroon
+	}
+	return nil
+}
+
+func (m *CheckMacaroonPermissionsRequest) GetPermissions() []*MacaroonPermission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+func (m *CheckMacaroonPermissionsRequest) GetFullMethod() string {
+	if m != nil {
+		return m.FullMethod
+	}
+	return ""
+}
+
+type CheckMacaroonPermissionsResponse struct {
+	// / Whether the macaroon grants the required permissions.
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// / The first party caveats of the macaroon.
+	Caveats              []string `protobuf:"bytes,2,rep,name=caveats,proto3" json:"caveats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckMacaroonPermissionsResponse) Reset()         { *m = CheckMacaroonPermissionsResponse{} }
+func (m *CheckMacaroonPermissionsResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckMacaroonPermissionsResponse) ProtoMessage()    {}
+func (*CheckMacaroonPermissionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{211}
+}
+func (m *CheckMacaroonPermissionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckMacaroonPermissionsResponse.Unmarshal(m, b)
+}
+func (m *CheckMacaroonPermissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckMacaroonPermissionsResponse.Marshal(b, m, deterministic)
+}
+func (dst *CheckMacaroonPermissionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckMacaroonPermissionsResponse.Merge(dst, src)
+}
+func (m *CheckMacaroonPermissionsResponse) XXX_Size() int {
+	return xxx_messageInfo_CheckMacaroonPermissionsResponse.Size(m)
+}
+func (m *CheckMacaroonPermissionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckMacaroonPermissionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckMacaroonPermissionsResponse proto.InternalMessageInfo
+
+func (m *CheckMacaroonPermissionsResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *CheckMacaroonPermissionsResponse) GetCaveats() []string {
+	if m != nil {
+		return m.Caveats
+	}
+	return nil
+}
+
+type WarpClockRequest struct {
+	// / The number of seconds to move the clock of the daemon forward by.
+	Seconds              uint64   `protobuf:"varint,1,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WarpClockRequest) Reset()         { *m = WarpClockRequest{} }
+func (m *WarpClockRequest) String() string { return proto.CompactTextString(m) }
+func (*WarpClockRequest) ProtoMessage()    {}
+func (*WarpClockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{212}
+}
+func (m *WarpClockRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WarpClockRequest.Unmarshal(m, b)
+}
+func (m *WarpClockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WarpClockRequest.Marshal(b, m, deterministic)
+}
+func (dst *WarpClockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WarpClockRequest.Merge(dst, src)
+}
+func (m *WarpClockRequest) XXX_Size() int {
+	return xxx_messageInfo_WarpClockRequest.Size(m)
+}
+func (m *WarpClockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WarpClockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WarpClockRequest proto.InternalMessageInfo
+
+func (m *WarpClockRequest) GetSeconds() uint64 {
+	if m != nil {
+		return m.Seconds
+	}
+	return 0
+}
+
+type WarpClockResponse struct {
+	// / The unix timestamp of the clock of the daemon once warped.
+	Now int64 `protobuf:"varint,1,opt,name=now,proto3" json:"now,omitempty"`
+	// / The total number of seconds the clock was warped by since startup.
+	TotalOffsetSeconds   uint64   `protobuf:"varint,2,opt,name=total_offset_seconds,proto3" json:"total_offset_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WarpClockResponse) Reset()         { *m = WarpClockResponse{} }
+func (m *WarpClockResponse) String() string { return proto.CompactTextString(m) }
+func (*WarpClockResponse) ProtoMessage()    {}
+func (*WarpClockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{213}
+}
+func (m *WarpClockResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WarpClockResponse.Unmarshal(m, b)
+}
+func (m *WarpClockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WarpClockResponse.Marshal(b, m, deterministic)
+}
+func (dst *WarpClockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WarpClockResponse.Merge(dst, src)
+}
+func (m *WarpClockResponse) XXX_Size() int {
+	return xxx_messageInfo_WarpClockResponse.Size(m)
+}
+func (m *WarpClockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WarpClockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WarpClockResponse proto.InternalMessageInfo
+
+func (m *WarpClockResponse) GetNow() int64 {
+	if m != nil {
+		return m.Now
+	}
+	return 0
+}
+
+func (m *WarpClockResponse) GetTotalOffsetSeconds() uint64 {
+	if m != nil {
+		return m.TotalOffsetSeconds
+	}
+	return 0
+}
+
+type ExportPaymentReceiptRequest struct {
+	// / The hash of the settled payment to export a receipt for.
+	PaymentHash          []byte   `protobuf:"bytes,1,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportPaymentReceiptRequest) Reset()         { *m = ExportPaymentReceiptRequest{} }
+func (m *ExportPaymentReceiptRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportPaymentReceiptRequest) ProtoMessage()    {}
+func (*ExportPaymentReceiptRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{214}
+}
+func (m *ExportPaymentReceiptRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportPaymentReceiptRequest.Unmarshal(m, b)
+}
+func (m *ExportPaymentReceiptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportPaymentReceiptRequest.Marshal(b, m, deterministic)
+}
+func (dst *ExportPaymentReceiptRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportPaymentReceiptRequest.Merge(dst, src)
+}
+func (m *ExportPaymentReceiptRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportPaymentReceiptRequest.Size(m)
+}
+func (m *ExportPaymentReceiptRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportPaymentReceiptRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportPaymentReceiptRequest proto.InternalMessageInfo
+
+func (m *ExportPaymentReceiptRequest) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
+}
+
+type PaymentReceipt struct {
+	// / The serialized receipt, signed by the node.
+	Receipt              []byte   `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaymentReceipt) Reset()         { *m = PaymentReceipt{} }
+func (m *PaymentReceipt) String() string { return proto.CompactTextString(m) }
+func (*PaymentReceipt) ProtoMessage()    {}
+func (*PaymentReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{215}
+}
+func (m *PaymentReceipt) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaymentReceipt.Unmarshal(m, b)
+}
+func (m *PaymentReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaymentReceipt.Marshal(b, m, deterministic)
+}
+func (dst *PaymentReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentReceipt.Merge(dst, src)
+}
+func (m *PaymentReceipt) XXX_Size() int {
+	return xxx_messageInfo_PaymentReceipt.Size(m)
+}
+func (m *PaymentReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymentReceipt proto.InternalMessageInfo
+
+func (m *PaymentReceipt) GetReceipt() []byte {
+	if m != nil {
+		return m.Receipt
+	}
+	return nil
+}
+
+type VerifyPaymentReceiptRequest struct {
+	// / The serialized receipt to verify.
+	Receipt              []byte   `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyPaymentReceiptRequest) Reset()         { *m = VerifyPaymentReceiptRequest{} }
+func (m *VerifyPaymentReceiptRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyPaymentReceiptRequest) ProtoMessage()    {}
+func (*VerifyPaymentReceiptRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{216}
+}
+func (m *VerifyPaymentReceiptRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyPaymentReceiptRequest.Unmarshal(m, b)
+}
+func (m *VerifyPaymentReceiptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyPaymentReceiptRequest.Marshal(b, m, deterministic)
+}
+func (dst *VerifyPaymentReceiptRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyPaymentReceiptRequest.Merge(dst, src)
+}
+func (m *VerifyPaymentReceiptRequest) XXX_Size() int {
+	return xxx_messageInfo_VerifyPaymentReceiptRequest.Size(m)
+}
+func (m *VerifyPaymentReceiptRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyPaymentReceiptRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyPaymentReceiptRequest proto.InternalMessageInfo
+
+func (m *VerifyPaymentReceiptRequest) GetReceipt() []byte {
+	if m != nil {
+		return m.Receipt
+	}
+	return nil
+}
+
+type VerifyPaymentReceiptResponse struct {
+	// / Whether the receipt is valid.
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// / The reason why the receipt is invalid, if it is.
+	InvalidReason string `protobuf:"bytes,2,opt,name=invalid_reason,proto3" json:"invalid_reason,omitempty"`
+	// / The public key of the node that made the payment and signed the receipt.
+	Payer string `protobuf:"bytes,3,opt,name=payer,proto3" json:"payer,omitempty"`
+	// / The hash of the payment.
+	PaymentHash []byte `protobuf:"bytes,4,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
+	// / The preimage released in exchange for the payment.
+	Preimage []byte `protobuf:"bytes,5,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	// / The amount delivered to the destination in milli-atoms, fees excluded.
+	AmtMAtoms int64 `protobuf:"varint,6,opt,name=amt_m_atoms,proto3" json:"amt_m_atoms,omitempty"`
+	// / The total fee paid to the intermediate nodes in milli-atoms.
+	FeeMAtoms int64 `protobuf:"varint,7,opt,name=fee_m_atoms,proto3" json:"fee_m_atoms,omitempty"`
+	// / The public key of the node that was paid.
+	Destination string `protobuf:"bytes,8,opt,name=destination,proto3" json:"destination,omitempty"`
+	// / The number of htlcs the payment settled through.
+	NumHtlcs uint32 `protobuf:"varint,9,opt,name=num_htlcs,proto3" json:"num_htlcs,omitempty"`
+	// / The unix timestamp at which the payment was settled.
+	SettleTime int64 `protobuf:"varint,10,opt,name=settle_time,proto3" json:"settle_time,omitempty"`
+	// / The payment request that was paid, if any.
+	PaymentRequest       string   `protobuf:"bytes,11,opt,name=payment_request,proto3" json:"payment_request,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyPaymentReceiptResponse) Reset()         { *m = VerifyPaymentReceiptResponse{} }
+func (m *VerifyPaymentReceiptResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyPaymentReceiptResponse) ProtoMessage()    {}
+func (*VerifyPaymentReceiptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{217}
+}
+func (m *VerifyPaymentReceiptResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyPaymentReceiptResponse.Unmarshal(m, b)
+}
+func (m *VerifyPaymentReceiptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyPaymentReceiptResponse.Marshal(b, m, deterministic)
+}
+func (dst *VerifyPaymentReceiptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyPaymentReceiptResponse.Merge(dst, src)
+}
+func (m *VerifyPaymentReceiptResponse) XXX_Size() int {
+	return xxx_messageInfo_VerifyPaymentReceiptResponse.Size(m)
+}
+func (m *VerifyPaymentReceiptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyPaymentReceiptResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyPaymentReceiptResponse proto.InternalMessageInfo
+
+func (m *VerifyPaymentReceiptResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *VerifyPaymentReceiptResponse) GetInvalidReason() string {
+	if m != nil {
+		return m.InvalidReason
+	}
+	return ""
+}
+
+func (m *VerifyPaymentReceiptResponse) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
+func (m *VerifyPaymentReceiptResponse) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
+}
+
+func (m *VerifyPaymentReceiptResponse) GetPreimage() []byte {
+	if m != nil {
+		return m.Preimage
+	}
+	return nil
+}
+
+func (m *VerifyPaymentReceiptResponse) GetAmtMAtoms() int64 {
+	if m != nil {
+		return m.AmtMAtoms
+	}
+	return 0
+}
+
+func (m *VerifyPaymentReceiptResponse) GetFeeMAtoms() int64 {
+	if m != nil {
+		return m.FeeMAtoms
+	}
+	return 0
+}
+
+func (m *VerifyPaymentReceiptResponse) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *VerifyPaymentReceiptResponse) GetNumHtlcs() uint32 {
+	if m != nil {
+		return m.NumHtlcs
+	}
+	return 0
+}
+
+func (m *VerifyPaymentReceiptResponse) GetSettleTime() int64 {
+	if m != nil {
+		return m.SettleTime
+	}
+	return 0
+}
+
+func (m *VerifyPaymentReceiptResponse) GetPaymentRequest() string {
+	if m != nil {
+		return m.PaymentRequest
+	}
+	return ""
+}
+
+type ChannelRecoveryStatusRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelRecoveryStatusRequest) Reset()         { *m = ChannelRecoveryStatusRequest{} }
+func (m *ChannelRecoveryStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*ChannelRecoveryStatusRequest) ProtoMessage()    {}
+func (*ChannelRecoveryStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{218}
+}
+func (m *ChannelRecoveryStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelRecoveryStatusRequest.Unmarshal(m, b)
+}
+func (m *ChannelRecoveryStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelRecoveryStatusRequest.Marshal(b, m, deterministic)
+}
+func (dst *ChannelRecoveryStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelRecoveryStatusRequest.Merge(dst, src)
+}
+func (m *ChannelRecoveryStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_ChannelRecoveryStatusRequest.Size(m)
+}
+func (m *ChannelRecoveryStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelRecoveryStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelRecoveryStatusRequest proto.InternalMessageInfo
+
+type RecoveringChannel struct {
+	// / The outpoint (txid:index) of the funding transaction.
+	ChannelPoint string `protobuf:"bytes,1,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
+	// / The identity pubkey of the channel peer.
+	RemoteNodePub string `protobuf:"bytes,2,opt,name=remote_node_pub,proto3" json:"remote_node_pub,omitempty"`
+	// / The stage the recovery of the channel reached.
+	Stage ChannelRecoveryStage `protobuf:"varint,3,opt,name=stage,proto3,enum=lnrpc.ChannelRecoveryStage" json:"stage,omitempty"`
+	// / The total capacity of the channel, once closed.
+	Capacity int64 `protobuf:"varint,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// / The txid of the transaction which closed the channel, once closed.
+	ClosingTxHash string `protobuf:"bytes,5,opt,name=closing_tx_hash,proto3" json:"closing_tx_hash,omitempty"`
+	// / Our settled balance of the channel, once closed.
+	SettledBalance       int64    `protobuf:"varint,6,opt,name=settled_balance,proto3" json:"settled_balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RecoveringChannel) Reset()         { *m = RecoveringChannel{} }
+func (m *RecoveringChannel) String() string { return proto.CompactTextString(m) }
+func (*RecoveringChannel) ProtoMessage()    {}
+func (*RecoveringChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{219}
+}
+func (m *RecoveringChannel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecoveringChannel.Unmarshal(m, b)
+}
+func (m *RecoveringChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecoveringChannel.Marshal(b, m, deterministic)
+}
+func (dst *RecoveringChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecoveringChannel.Merge(dst, src)
+}
+func (m *RecoveringChannel) XXX_Size() int {
+	return xxx_messageInfo_RecoveringChannel.Size(m)
+}
+func (m *RecoveringChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecoveringChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecoveringChannel proto.InternalMessageInfo
+
+func (m *RecoveringChannel) GetChannelPoint() string {
+	if m != nil {
+		return m.ChannelPoint
+	}
+	return ""
+}
+
+func (m *RecoveringChannel) GetRemoteNodePub() string {
+	if m != nil {
+		return m.RemoteNodePub
+	}
+	return ""
+}
+
+func (m *RecoveringChannel) GetStage() ChannelRecoveryStage {
+	if m != nil {
+		return m.Stage
+	}
+	return ChannelRecoveryStage_WAITING_FOR_PEER
+}
+
+func (m *RecoveringChannel) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *RecoveringChannel) GetClosingTxHash() string {
+	if m != nil {
+		return m.ClosingTxHash
+	}
+	return ""
+}
+
+func (m *RecoveringChannel) GetSettledBalance() int64 {
+	if m != nil {
+		return m.SettledBalance
+	}
+	return 0
+}
+
+type RecoveryPeer struct {
+	// / The identity pubkey of the recovery peer.
+	PubKey string `protobuf:"bytes,1,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
+	// / The network address of the recovery peer.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// / Whether we're connected to the recovery peer.
+	Connected bool `protobuf:"varint,3,opt,name=connected,proto3" json:"connected,omitempty"`
+	// / The channels unknown to us the recovery peer was asked to force close.
+	ForceCloseRequests   []string `protobuf:"bytes,4,rep,name=force_close_requests,proto3" json:"force_close_requests,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RecoveryPeer) Reset()         { *m = RecoveryPeer{} }
+func (m *RecoveryPeer) String() string { return proto.CompactTextString(m) }
+func (*RecoveryPeer) ProtoMessage()    {}
+func (*RecoveryPeer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{220}
+}
+func (m *RecoveryPeer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecoveryPeer.Unmarshal(m, b)
+}
+func (m *RecoveryPeer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecoveryPeer.Marshal(b, m, deterministic)
+}
+func (dst *RecoveryPeer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecoveryPeer.Merge(dst, src)
+}
+func (m *RecoveryPeer) XXX_Size() int {
+	return xxx_messageInfo_RecoveryPeer.Size(m)
+}
+func (m *RecoveryPeer) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecoveryPeer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecoveryPeer proto.InternalMessageInfo
+
+func (m *RecoveryPeer) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *RecoveryPeer) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RecoveryPeer) GetConnected() bool {
+	if m != nil {
+		return m.Connected
+	}
+	return false
+}
+
+func (m *RecoveryPeer) GetForceCloseRequests() []string {
+	if m != nil {
+		return m.ForceCloseRequests
+	}
+	return nil
+}
+
+type ChannelRecoveryStatusResponse struct {
+	// / The channels restored from static channel backups.
+	Channels []*RecoveringChannel `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	// / The configured recovery peers.
+	Peers                []*RecoveryPeer `protobuf:"bytes,2,rep,name=peers,proto3" json:"peers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ChannelRecoveryStatusResponse) Reset()         { *m = ChannelRecoveryStatusResponse{} }
+func (m *ChannelRecoveryStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*ChannelRecoveryStatusResponse) ProtoMessage()    {}
+func (*ChannelRecoveryStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_94a2a3a65c6a413e, []int{221}
+}
+func (m *ChannelRecoveryStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelRecoveryStatusResponse.Unmarshal(m, b)
+}
+func (m *ChannelRecoveryStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelRecoveryStatusResponse.Marshal(b, m, deterministic)
+}
+func (dst *ChannelRecoveryStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelRecoveryStatusResponse.Merge(dst, src)
+}
+func (m *ChannelRecoveryStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_ChannelRecoveryStatusResponse.Size(m)
+}
+func (m *ChannelRecoveryStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelRecoveryStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelRecoveryStatusResponse proto.InternalMessageInfo
+
+func (m *ChannelRecoveryStatusResponse) GetChannels() []*RecoveringChannel {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+func (m *ChannelRecoveryStatusResponse) GetPeers() []*RecoveryPeer {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenSeedRequest)(nil), "lnrpc.GenSeedRequest")
+	proto.RegisterType((*GenSeedResponse)(nil), "lnrpc.GenSeedResponse")
+	proto.RegisterType((*InitWalletRequest)(nil), "lnrpc.InitWalletRequest")
+	proto.RegisterType((*InitWalletResponse)(nil), "lnrpc.InitWalletResponse")
+	proto.RegisterType((*UnlockWalletRequest)(nil), "lnrpc.UnlockWalletRequest")
+	proto.RegisterType((*UnlockWalletResponse)(nil), "lnrpc.UnlockWalletResponse")
+	proto.RegisterType((*ChangePasswordRequest)(nil), "lnrpc.ChangePasswordRequest")
+	proto.RegisterType((*ChangePasswordResponse)(nil), "lnrpc.ChangePasswordResponse")
+	proto.RegisterType((*SubscribeStateRequest)(nil), "lnrpc.SubscribeStateRequest")
+	proto.RegisterType((*SubscribeStateResponse)(nil), "lnrpc.SubscribeStateResponse")
+	proto.RegisterType((*GetStateRequest)(nil), "lnrpc.GetStateRequest")
+	proto.RegisterType((*GetStateResponse)(nil), "lnrpc.GetStateResponse")
+	proto.RegisterType((*Utxo)(nil), "lnrpc.Utxo")
+	proto.RegisterType((*Transaction)(nil), "lnrpc.Transaction")
+	proto.RegisterType((*GetTransactionsRequest)(nil), "lnrpc.GetTransactionsRequest")
+	proto.RegisterType((*TransactionDetails)(nil), "lnrpc.TransactionDetails")
+	proto.RegisterType((*FeeLimit)(nil), "lnrpc.FeeLimit")
+	proto.RegisterType((*SendRequest)(nil), "lnrpc.SendRequest")
+	proto.RegisterMapType((map[uint64][]byte)(nil), "lnrpc.SendRequest.DestTlvEntry")
+	proto.RegisterType((*SendResponse)(nil), "lnrpc.SendResponse")
+	proto.RegisterType((*SendToRouteRequest)(nil), "lnrpc.SendToRouteRequest")
+	proto.RegisterType((*ChannelAcceptRequest)(nil), "lnrpc.ChannelAcceptRequest")
+	proto.RegisterType((*ChannelAcceptResponse)(nil), "lnrpc.ChannelAcceptResponse")
+	proto.RegisterType((*ChannelPoint)(nil), "lnrpc.ChannelPoint")
+	proto.RegisterType((*OutPoint)(nil), "lnrpc.OutPoint")
+	proto.RegisterType((*LightningAddress)(nil), "lnrpc.LightningAddress")
+	proto.RegisterType((*EstimateFeeRequest)(nil), "lnrpc.EstimateFeeRequest")
+	proto.RegisterMapType((map[string]int64)(nil), "lnrpc.EstimateFeeRequest.AddrToAmountEntry")
+	proto.RegisterType((*EstimateFeeResponse)(nil), "lnrpc.EstimateFeeResponse")
+	proto.RegisterType((*SendManyRequest)(nil), "lnrpc.SendManyRequest")
+	proto.RegisterMapType((map[string]int64)(nil), "lnrpc.SendManyRequest.AddrToAmountEntry")
+	proto.RegisterType((*SendManyResponse)(nil), "lnrpc.SendManyResponse")
+	proto.RegisterType((*SendCoinsRequest)(nil), "lnrpc.SendCoinsRequest")
+	proto.RegisterType((*SendCoinsResponse)(nil), "lnrpc.SendCoinsResponse")
+	proto.RegisterType((*ListUnspentRequest)(nil), "lnrpc.ListUnspentRequest")
+	proto.RegisterType((*ListUnspentResponse)(nil), "lnrpc.ListUnspentResponse")
+	proto.RegisterType((*NewAddressRequest)(nil), "lnrpc.NewAddressRequest")
+	proto.RegisterType((*NewAddressResponse)(nil), "lnrpc.NewAddressResponse")
+	proto.RegisterType((*SignMessageRequest)(nil), "lnrpc.SignMessageRequest")
+	proto.RegisterType((*SignMessageResponse)(nil), "lnrpc.SignMessageResponse")
+	proto.RegisterType((*VerifyMessageRequest)(nil), "lnrpc.VerifyMessageRequest")
+	proto.RegisterType((*VerifyMessageResponse)(nil), "lnrpc.VerifyMessageResponse")
+	proto.RegisterType((*ConnectPeerRequest)(nil), "lnrpc.ConnectPeerRequest")
+	proto.RegisterType((*ConnectPeerResponse)(nil), "lnrpc.ConnectPeerResponse")
+	proto.RegisterType((*DisconnectPeerRequest)(nil), "lnrpc.DisconnectPeerRequest")
+	proto.RegisterType((*DisconnectPeerResponse)(nil), "lnrpc.DisconnectPeerResponse")
+	proto.RegisterType((*SetPeerConnPolicyRequest)(nil), "lnrpc.SetPeerConnPolicyRequest")
+	proto.RegisterType((*SetPeerConnPolicyResponse)(nil), "lnrpc.SetPeerConnPolicyResponse")
+	proto.RegisterType((*HTLC)(nil), "lnrpc.HTLC")
+	proto.RegisterType((*Channel)(nil), "lnrpc.Channel")
+	proto.RegisterType((*ListChannelsRequest)(nil), "lnrpc.ListChannelsRequest")
+	proto.RegisterType((*ListChannelsResponse)(nil), "lnrpc.ListChannelsResponse")
+	proto.RegisterType((*Resolution)(nil), "lnrpc.Resolution")
+	proto.RegisterType((*ChannelCloseSummary)(nil), "lnrpc.ChannelCloseSummary")
+	proto.RegisterType((*ClosedChannelsRequest)(nil), "lnrpc.ClosedChannelsRequest")
+	proto.RegisterType((*ClosedChannelsResponse)(nil), "lnrpc.ClosedChannelsResponse")
+	proto.RegisterType((*ListArbitratorsRequest)(nil), "lnrpc.ListArbitratorsRequest")
+	proto.RegisterType((*ListArbitratorsResponse)(nil), "lnrpc.ListArbitratorsResponse")
+	proto.RegisterType((*ChannelArbitrator)(nil), "lnrpc.ChannelArbitrator")
+	proto.RegisterType((*ContractResolver)(nil), "lnrpc.ContractResolver")
+	proto.RegisterType((*Peer)(nil), "lnrpc.Peer")
+	proto.RegisterType((*ListPeersRequest)(nil), "lnrpc.ListPeersRequest")
+	proto.RegisterType((*ListPeersResponse)(nil), "lnrpc.ListPeersResponse")
+	proto.RegisterType((*PeerEventSubscription)(nil), "lnrpc.PeerEventSubscription")
+	proto.RegisterType((*PeerEvent)(nil), "lnrpc.PeerEvent")
+	proto.RegisterType((*GossipSyncer)(nil), "lnrpc.GossipSyncer")
+	proto.RegisterType((*ListGossipSyncersRequest)(nil), "lnrpc.ListGossipSyncersRequest")
+	proto.RegisterType((*ListGossipSyncersResponse)(nil), "lnrpc.ListGossipSyncersResponse")
+	proto.RegisterType((*GraphSyncStatusRequest)(nil), "lnrpc.GraphSyncStatusRequest")
+	proto.RegisterType((*GraphSyncSubscription)(nil), "lnrpc.GraphSyncSubscription")
+	proto.RegisterType((*GraphSyncStatus)(nil), "lnrpc.GraphSyncStatus")
+	proto.RegisterType((*GetInfoRequest)(nil), "lnrpc.GetInfoRequest")
+	proto.RegisterType((*GetInfoResponse)(nil), "lnrpc.GetInfoResponse")
+	proto.RegisterType((*ServiceListener)(nil), "lnrpc.ServiceListener")
+	proto.RegisterType((*Chain)(nil), "lnrpc.Chain")
+	proto.RegisterType((*ConfirmationUpdate)(nil), "lnrpc.ConfirmationUpdate")
+	proto.RegisterType((*ChannelOpenUpdate)(nil), "lnrpc.ChannelOpenUpdate")
+	proto.RegisterType((*ChannelCloseUpdate)(nil), "lnrpc.ChannelCloseUpdate")
+	proto.RegisterType((*CloseChannelRequest)(nil), "lnrpc.CloseChannelRequest")
+	proto.RegisterType((*CloseStatusUpdate)(nil), "lnrpc.CloseStatusUpdate")
+	proto.RegisterType((*PendingUpdate)(nil), "lnrpc.PendingUpdate")
+	proto.RegisterType((*OpenChannelRequest)(nil), "lnrpc.OpenChannelRequest")
+	proto.RegisterType((*OpenStatusUpdate)(nil), "lnrpc.OpenStatusUpdate")
+	proto.RegisterType((*PendingHTLC)(nil), "lnrpc.PendingHTLC")
+	proto.RegisterType((*PendingChannelsRequest)(nil), "lnrpc.PendingChannelsRequest")
+	proto.RegisterType((*PendingChannelsResponse)(nil), "lnrpc.PendingChannelsResponse")
+	proto.RegisterType((*PendingChannelsResponse_PendingChannel)(nil), "lnrpc.PendingChannelsResponse.PendingChannel")
+	proto.RegisterType((*PendingChannelsResponse_PendingOpenChannel)(nil), "lnrpc.PendingChannelsResponse.PendingOpenChannel")
+	proto.RegisterType((*PendingChannelsResponse_WaitingCloseChannel)(nil), "lnrpc.PendingChannelsResponse.WaitingCloseChannel")
+	proto.RegisterType((*PendingChannelsResponse_ClosedChannel)(nil), "lnrpc.PendingChannelsResponse.ClosedChannel")
+	proto.RegisterType((*PendingChannelsResponse_ForceClosedChannel)(nil), "lnrpc.PendingChannelsResponse.ForceClosedChannel")
+	proto.RegisterType((*PendingChannelLimitsRequest)(nil), "lnrpc.PendingChannelLimitsRequest")
+	proto.RegisterType((*PendingChannelLimitsResponse)(nil), "lnrpc.PendingChannelLimitsResponse")
+	proto.RegisterType((*PeerPendingChannels)(nil), "lnrpc.PeerPendingChannels")
+	proto.RegisterType((*ChannelEventSubscription)(nil), "lnrpc.ChannelEventSubscription")
+	proto.RegisterType((*ChannelEventUpdate)(nil), "lnrpc.ChannelEventUpdate")
+	proto.RegisterType((*RemoteCommitmentUpdate)(nil), "lnrpc.RemoteCommitmentUpdate")
+	proto.RegisterType((*WalletBalanceRequest)(nil), "lnrpc.WalletBalanceRequest")
+	proto.RegisterType((*WalletBalanceResponse)(nil), "lnrpc.WalletBalanceResponse")
+	proto.RegisterType((*ChannelBalanceRequest)(nil), "lnrpc.ChannelBalanceRequest")
+	proto.RegisterType((*ChannelBalanceResponse)(nil), "lnrpc.ChannelBalanceResponse")
+	proto.RegisterType((*QueryRoutesRequest)(nil), "lnrpc.QueryRoutesRequest")
+	proto.RegisterType((*NodePair)(nil), "lnrpc.NodePair")
+	proto.RegisterType((*EdgeLocator)(nil), "lnrpc.EdgeLocator")
+	proto.RegisterType((*QueryRoutesResponse)(nil), "lnrpc.QueryRoutesResponse")
+	proto.RegisterType((*Hop)(nil), "lnrpc.Hop")
+	proto.RegisterType((*Route)(nil), "lnrpc.Route")
+	proto.RegisterType((*NodeInfoRequest)(nil), "lnrpc.NodeInfoRequest")
+	proto.RegisterType((*NodeInfo)(nil), "lnrpc.NodeInfo")
+	proto.RegisterType((*LightningNode)(nil), "lnrpc.LightningNode")
+	proto.RegisterMapType((map[uint64][]byte)(nil), "lnrpc.LightningNode.CustomRecordsEntry")
+	proto.RegisterType((*NodeAddress)(nil), "lnrpc.NodeAddress")
+	proto.RegisterType((*RoutingPolicy)(nil), "lnrpc.RoutingPolicy")
+	proto.RegisterType((*ChannelEdge)(nil), "lnrpc.ChannelEdge")
+	proto.RegisterType((*ChannelGraphRequest)(nil), "lnrpc.ChannelGraphRequest")
+	proto.RegisterType((*ChannelGraph)(nil), "lnrpc.ChannelGraph")
+	proto.RegisterType((*ChanInfoRequest)(nil), "lnrpc.ChanInfoRequest")
+	proto.RegisterType((*NetworkInfoRequest)(nil), "lnrpc.NetworkInfoRequest")
+	proto.RegisterType((*NetworkInfo)(nil), "lnrpc.NetworkInfo")
+	proto.RegisterType((*StopRequest)(nil), "lnrpc.StopRequest")
+	proto.RegisterType((*StopResponse)(nil), "lnrpc.StopResponse")
+	proto.RegisterType((*GraphTopologySubscription)(nil), "lnrpc.GraphTopologySubscription")
+	proto.RegisterType((*GraphTopologyUpdate)(nil), "lnrpc.GraphTopologyUpdate")
+	proto.RegisterType((*NodeUpdate)(nil), "lnrpc.NodeUpdate")
+	proto.RegisterType((*ChannelEdgeUpdate)(nil), "lnrpc.ChannelEdgeUpdate")
+	proto.RegisterType((*ClosedChannelUpdate)(nil), "lnrpc.ClosedChannelUpdate")
+	proto.RegisterType((*HopHint)(nil), "lnrpc.HopHint")
+	proto.RegisterType((*RouteHint)(nil), "lnrpc.RouteHint")
+	proto.RegisterType((*Invoice)(nil), "lnrpc.Invoice")
+	proto.RegisterType((*InvoiceHTLC)(nil), "lnrpc.InvoiceHTLC")
+	proto.RegisterType((*AMPInvoiceSet)(nil), "lnrpc.AMPInvoiceSet")
+	proto.RegisterType((*AddInvoiceResponse)(nil), "lnrpc.AddInvoiceResponse")
+	proto.RegisterType((*PaymentHash)(nil), "lnrpc.PaymentHash")
+	proto.RegisterType((*ListInvoiceRequest)(nil), "lnrpc.ListInvoiceRequest")
+	proto.RegisterType((*ListInvoiceResponse)(nil), "lnrpc.ListInvoiceResponse")
+	proto.RegisterType((*InvoiceSubscription)(nil), "lnrpc.InvoiceSubscription")
+	proto.RegisterType((*Payment)(nil), "lnrpc.Payment")
+	proto.RegisterType((*ListPaymentsRequest)(nil), "lnrpc.ListPaymentsRequest")
+	proto.RegisterType((*ListPaymentsResponse)(nil), "lnrpc.ListPaymentsResponse")
+	proto.RegisterType((*DeleteAllPaymentsRequest)(nil), "lnrpc.DeleteAllPaymentsRequest")
+	proto.RegisterType((*DeleteAllPaymentsResponse)(nil), "lnrpc.DeleteAllPaymentsResponse")
+	proto.RegisterType((*AbandonChannelRequest)(nil), "lnrpc.AbandonChannelRequest")
+	proto.RegisterType((*AbandonChannelResponse)(nil), "lnrpc.AbandonChannelResponse")
+	proto.RegisterType((*DebugLevelRequest)(nil), "lnrpc.DebugLevelRequest")
+	proto.RegisterType((*DebugLevelResponse)(nil), "lnrpc.DebugLevelResponse")
+	proto.RegisterType((*PayReqString)(nil), "lnrpc.PayReqString")
+	proto.RegisterType((*PayReq)(nil), "lnrpc.PayReq")
+	proto.RegisterType((*Feature)(nil), "lnrpc.Feature")
+	proto.RegisterType((*FeeReportRequest)(nil), "lnrpc.FeeReportRequest")
+	proto.RegisterType((*ChannelFeeReport)(nil), "lnrpc.ChannelFeeReport")
+	proto.RegisterType((*FeeReportResponse)(nil), "lnrpc.FeeReportResponse")
+	proto.RegisterType((*PolicyUpdateRequest)(nil), "lnrpc.PolicyUpdateRequest")
+	proto.RegisterType((*PolicyUpdateResponse)(nil), "lnrpc.PolicyUpdateResponse")
+	proto.RegisterType((*ForwardingHistoryRequest)(nil), "lnrpc.ForwardingHistoryRequest")
+	proto.RegisterType((*ForwardingEvent)(nil), "lnrpc.ForwardingEvent")
+	proto.RegisterType((*ForwardingHistoryResponse)(nil), "lnrpc.ForwardingHistoryResponse")
+	proto.RegisterType((*ExportChannelBackupRequest)(nil), "lnrpc.ExportChannelBackupRequest")
+	proto.RegisterType((*ChannelBackup)(nil), "lnrpc.ChannelBackup")
+	proto.RegisterType((*MultiChanBackup)(nil), "lnrpc.MultiChanBackup")
+	proto.RegisterType((*ChanBackupExportRequest)(nil), "lnrpc.ChanBackupExportRequest")
+	proto.RegisterType((*ChanBackupSnapshot)(nil), "lnrpc.ChanBackupSnapshot")
+	proto.RegisterType((*ChannelBackups)(nil), "lnrpc.ChannelBackups")
+	proto.RegisterType((*RestoreChanBackupRequest)(nil), "lnrpc.RestoreChanBackupRequest")
+	proto.RegisterType((*RestoreBackupResponse)(nil), "lnrpc.RestoreBackupResponse")
+	proto.RegisterType((*ChannelBackupInfo)(nil), "lnrpc.ChannelBackupInfo")
+	proto.RegisterType((*InspectChanBackupResponse)(nil), "lnrpc.InspectChanBackupResponse")
+	proto.RegisterType((*ChannelRestoreCheck)(nil), "lnrpc.ChannelRestoreCheck")
+	proto.RegisterType((*ChannelBackupSubscription)(nil), "lnrpc.ChannelBackupSubscription")
+	proto.RegisterType((*VerifyChanBackupResponse)(nil), "lnrpc.VerifyChanBackupResponse")
+	proto.RegisterType((*DatabaseSnapshotRequest)(nil), "lnrpc.DatabaseSnapshotRequest")
+	proto.RegisterType((*DatabaseSnapshotResponse)(nil), "lnrpc.DatabaseSnapshotResponse")
+	proto.RegisterType((*DatabaseSnapshotFile)(nil), "lnrpc.DatabaseSnapshotFile")
+	proto.RegisterType((*BatchOpenChannelRequest)(nil), "lnrpc.BatchOpenChannelRequest")
+	proto.RegisterType((*BatchOpenChannel)(nil), "lnrpc.BatchOpenChannel")
+	proto.RegisterType((*BatchOpenChannelResponse)(nil), "lnrpc.BatchOpenChannelResponse")
+	proto.RegisterType((*BumpFundingFeeRequest)(nil), "lnrpc.BumpFundingFeeRequest")
+	proto.RegisterType((*BumpFundingFeeResponse)(nil), "lnrpc.BumpFundingFeeResponse")
+	proto.RegisterType((*RebroadcastLimboOutputRequest)(nil), "lnrpc.RebroadcastLimboOutputRequest")
+	proto.RegisterType((*RebroadcastLimboOutputResponse)(nil), "lnrpc.RebroadcastLimboOutputResponse")
+	proto.RegisterType((*LimboOutput)(nil), "lnrpc.LimboOutput")
+	proto.RegisterType((*SendCustomMessageRequest)(nil), "lnrpc.SendCustomMessageRequest")
+	proto.RegisterType((*SendCustomMessageResponse)(nil), "lnrpc.SendCustomMessageResponse")
+	proto.RegisterType((*SubscribeCustomMessagesRequest)(nil), "lnrpc.SubscribeCustomMessagesRequest")
+	proto.RegisterType((*CustomMessage)(nil), "lnrpc.CustomMessage")
+	proto.RegisterType((*MacaroonPermission)(nil), "lnrpc.MacaroonPermission")
+	proto.RegisterType((*BakeMacaroonRequest)(nil), "lnrpc.BakeMacaroonRequest")
+	proto.RegisterType((*BakeMacaroonResponse)(nil), "lnrpc.BakeMacaroonResponse")
+	proto.RegisterType((*ListMacaroonIDsRequest)(nil), "lnrpc.ListMacaroonIDsRequest")
+	proto.RegisterType((*ListMacaroonIDsResponse)(nil), "lnrpc.ListMacaroonIDsResponse")
+	proto.RegisterType((*DeleteMacaroonIDRequest)(nil), "lnrpc.DeleteMacaroonIDRequest")
+	proto.RegisterType((*DeleteMacaroonIDResponse)(nil), "lnrpc.DeleteMacaroonIDResponse")
+	proto.RegisterType((*MacaroonPermissionList)(nil), "lnrpc.MacaroonPermissionList")
+	proto.RegisterType((*ListPermissionsRequest)(nil), "lnrpc.ListPermissionsRequest")
+	proto.RegisterType((*ListPermissionsResponse)(nil), "lnrpc.ListPermissionsResponse")
+	proto.RegisterMapType((map[string]*MacaroonPermissionList)(nil), "lnrpc.ListPermissionsResponse.MethodPermissionsEntry")
+	proto.RegisterType((*RPCMiddlewareRequest)(nil), "lnrpc.RPCMiddlewareRequest")
+	proto.RegisterType((*RPCMiddlewareResponse)(nil), "lnrpc.RPCMiddlewareResponse")
+	proto.RegisterType((*MiddlewareRegistration)(nil), "lnrpc.MiddlewareRegistration")
+	proto.RegisterMapType((map[string]*MacaroonPermissionList)(nil), "lnrpc.MiddlewareRegistration.CustomPermissionsEntry")
+	proto.RegisterType((*InterceptFeedback)(nil), "lnrpc.InterceptFeedback")
+	proto.RegisterType((*OutputDetail)(nil), "lnrpc.OutputDetail")
+	proto.RegisterType((*SetColdSweepRequest)(nil), "lnrpc.SetColdSweepRequest")
+	proto.RegisterType((*SetColdSweepResponse)(nil), "lnrpc.SetColdSweepResponse")
+	proto.RegisterType((*ColdSweepSubscription)(nil), "lnrpc.ColdSweepSubscription")
+	proto.RegisterType((*ColdSweepEvent)(nil), "lnrpc.ColdSweepEvent")
+	proto.RegisterType((*InvoiceURIRequest)(nil), "lnrpc.InvoiceURIRequest")
+	proto.RegisterType((*InvoiceURIResponse)(nil), "lnrpc.InvoiceURIResponse")
+	proto.RegisterType((*LegacyPayloadStatsRequest)(nil), "lnrpc.LegacyPayloadStatsRequest")
+	proto.RegisterType((*LegacyPayloadStatsResponse)(nil), "lnrpc.LegacyPayloadStatsResponse")
+	proto.RegisterType((*PushGossipRequest)(nil), "lnrpc.PushGossipRequest")
+	proto.RegisterType((*PushGossipResponse)(nil), "lnrpc.PushGossipResponse")
+	proto.RegisterType((*InboundFee)(nil), "lnrpc.InboundFee")
+	proto.RegisterType((*FeeManagerReportRequest)(nil), "lnrpc.FeeManagerReportRequest")
+	proto.RegisterType((*FeeDecision)(nil), "lnrpc.FeeDecision")
+	proto.RegisterType((*FeeManagerReportResponse)(nil), "lnrpc.FeeManagerReportResponse")
+	proto.RegisterType((*EffectiveFeeRatesRequest)(nil), "lnrpc.EffectiveFeeRatesRequest")
+	proto.RegisterType((*EffectiveFeeRate)(nil), "lnrpc.EffectiveFeeRate")
+	proto.RegisterType((*EffectiveFeeRatesResponse)(nil), "lnrpc.EffectiveFeeRatesResponse")
+	proto.RegisterType((*GetDebugInfoRequest)(nil), "lnrpc.GetDebugInfoRequest")
+	proto.RegisterType((*DebugBucketStats)(nil), "lnrpc.DebugBucketStats")
+	proto.RegisterType((*GetDebugInfoResponse)(nil), "lnrpc.GetDebugInfoResponse")
+	proto.RegisterMapType((map[string]string)(nil), "lnrpc.GetDebugInfoResponse.ConfigEntry")
+	proto.RegisterType((*CheckMacaroonPermissionsRequest)(nil), "lnrpc.CheckMacaroonPermissionsRequest")
+	proto.RegisterType((*CheckMacaroonPermissionsResponse)(nil), "lnrpc.CheckMacaroonPermissionsResponse")
+	proto.RegisterType((*WarpClockRequest)(nil), "lnrpc.WarpClockRequest")
+	proto.RegisterType((*WarpClockResponse)(nil), "lnrpc.WarpClockResponse")
+	proto.RegisterType((*ExportPaymentReceiptRequest)(nil), "lnrpc.ExportPaymentReceiptRequest")
+	proto.RegisterType((*PaymentReceipt)(nil), "lnrpc.PaymentReceipt")
+	proto.RegisterType((*VerifyPaymentReceiptRequest)(nil), "lnrpc.VerifyPaymentReceiptRequest")
+	proto.RegisterType((*VerifyPaymentReceiptResponse)(nil), "lnrpc.VerifyPaymentReceiptResponse")
+	proto.RegisterType((*ChannelRecoveryStatusRequest)(nil), "lnrpc.ChannelRecoveryStatusRequest")
+	proto.RegisterType((*RecoveringChannel)(nil), "lnrpc.RecoveringChannel")
+	proto.RegisterType((*RecoveryPeer)(nil), "lnrpc.RecoveryPeer")
+	proto.RegisterType((*ChannelRecoveryStatusResponse)(nil), "lnrpc.ChannelRecoveryStatusResponse")
+	proto.RegisterEnum("lnrpc.DaemonState", DaemonState_name, DaemonState_value)
+	proto.RegisterEnum("lnrpc.UnsyncedGraphAction", UnsyncedGraphAction_name, UnsyncedGraphAction_value)
+	proto.RegisterEnum("lnrpc.AddressType", AddressType_name, AddressType_value)
+	proto.RegisterEnum("lnrpc.Initiator", Initiator_name, Initiator_value)
+	proto.RegisterEnum("lnrpc.InvoiceHTLCState", InvoiceHTLCState_name, InvoiceHTLCState_value)
+	proto.RegisterEnum("lnrpc.PaymentFailureReason", PaymentFailureReason_name, PaymentFailureReason_value)
+	proto.RegisterEnum("lnrpc.ChannelRecoveryStage", ChannelRecoveryStage_name, ChannelRecoveryStage_value)
+	proto.RegisterEnum("lnrpc.SetPeerConnPolicyRequest_ReconnectPolicy", SetPeerConnPolicyRequest_ReconnectPolicy_name, SetPeerConnPolicyRequest_ReconnectPolicy_value)
+	proto.RegisterEnum("lnrpc.Resolution_ResolutionType", Resolution_ResolutionType_name, Resolution_ResolutionType_value)
+	proto.RegisterEnum("lnrpc.ChannelCloseSummary_ClosureType", ChannelCloseSummary_ClosureType_name, ChannelCloseSummary_ClosureType_value)
+	proto.RegisterEnum("lnrpc.ChannelArbitrator_ArbitratorState", ChannelArbitrator_ArbitratorState_name, ChannelArbitrator_ArbitratorState_value)
+	proto.RegisterEnum("lnrpc.ContractResolver_ResolverType", ContractResolver_ResolverType_name, ContractResolver_ResolverType_value)
+	proto.RegisterEnum("lnrpc.ContractResolver_ResolverStage", ContractResolver_ResolverStage_name, ContractResolver_ResolverStage_value)
+	proto.RegisterEnum("lnrpc.Peer_SyncType", Peer_SyncType_name, Peer_SyncType_value)
+	proto.RegisterEnum("lnrpc.PeerEvent_EventType", PeerEvent_EventType_name, PeerEvent_EventType_value)
+	proto.RegisterEnum("lnrpc.ChannelEventUpdate_UpdateType", ChannelEventUpdate_UpdateType_name, ChannelEventUpdate_UpdateType_value)
+	proto.RegisterEnum("lnrpc.RemoteCommitmentUpdate_CommitmentType", RemoteCommitmentUpdate_CommitmentType_name, RemoteCommitmentUpdate_CommitmentType_value)
+	proto.RegisterEnum("lnrpc.Invoice_InvoiceState", Invoice_InvoiceState_name, Invoice_InvoiceState_value)
+	proto.RegisterEnum("lnrpc.Payment_PaymentStatus", Payment_PaymentStatus_name, Payment_PaymentStatus_value)
+	proto.RegisterEnum("lnrpc.LimboOutput_LimboClass", LimboOutput_LimboClass_name, LimboOutput_LimboClass_value)
+	proto.RegisterEnum("lnrpc.LimboOutput_ResolverStage", LimboOutput_ResolverStage_name, LimboOutput_ResolverStage_value)
+	proto.RegisterEnum("lnrpc.ColdSweepEvent_EventType", ColdSweepEvent_EventType_name, ColdSweepEvent_EventType_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// WalletUnlockerClient is the client API for WalletUnlocker service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WalletUnlockerClient interface {
+	// *
+	// GenSeed is the first method that should be used to instantiate a new lnd
+	// instance. This method allows a caller to generate a new aezeed cipher seed
+	// given an optional passphrase. If provided, the passphrase will be necessary
+	// to decrypt the cipherseed to expose the internal wallet seed.
+	//
+	// Once the cipherseed is obtained and verified by the user, the InitWallet
+	// method should be used to commit the newly generated seed, and create the
+	// wallet.
+	GenSeed(ctx context.Context, in *GenSeedRequest, opts ...grpc.CallOption) (*GenSeedResponse, error)
+	// *
+	// InitWallet is used when lnd is starting up for the first time to fully
+	// initialize the daemon and its internal wallet. At the very least a wallet
+	// password must be provided. This will be used to encrypt sensitive material
+	// on disk.
+	//
+	// In the case of a recovery scenario, the user can also specify their aezeed
+	// mnemonic and passphrase. If set, then the daemon will use this prior state
+	// to initialize its internal wallet.
+	//
+	// Alternatively, this can be used along with the GenSeed RPC to obtain a
+	// seed, then present it to the user. Once it has been verified by the user,
+	// the seed can be fed into this RPC in order to commit the new wallet.
+	InitWallet(ctx context.Context, in *InitWalletRequest, opts ...grpc.CallOption) (*InitWalletResponse, error)
+	// * lncli: `unlock`
+	// UnlockWallet is used at startup of lnd to provide a password to unlock
+	// the wallet database.
+	UnlockWallet(ctx context.Context, in *UnlockWalletRequest, opts ...grpc.CallOption) (*UnlockWalletResponse, error)
+	// * lncli: `changepassword`
+	// ChangePassword changes the password of the encrypted wallet. This will
+	// automatically unlock the wallet database if successful.
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
+}
+
+type walletUnlockerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewWalletUnlockerClient(cc *grpc.ClientConn) WalletUnlockerClient {
+	return &walletUnlockerClient{cc}
+}
+
+func (c *walletUnlockerClient) GenSeed(ctx context.Context, in *GenSeedRequest, opts ...grpc.CallOption) (*GenSeedResponse, error) {
+	out := new(GenSeedResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.WalletUnlocker/GenSeed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletUnlockerClient) InitWallet(ctx context.Context, in *InitWalletRequest, opts ...grpc.CallOption) (*InitWalletResponse, error) {
+	out := new(InitWalletResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.WalletUnlocker/InitWallet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletUnlockerClient) UnlockWallet(ctx context.Context, in *UnlockWalletRequest, opts ...grpc.CallOption) (*UnlockWalletResponse, error) {
+	out := new(UnlockWalletResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.WalletUnlocker/UnlockWallet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletUnlockerClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error) {
+	out := new(ChangePasswordResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.WalletUnlocker/ChangePassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WalletUnlockerServer is the server API for WalletUnlocker service.
+type WalletUnlockerServer interface {
+	// *
+	// GenSeed is the first method that should be used to instantiate a new lnd
+	// instance. This method allows a caller to generate a new aezeed cipher seed
+	// given an optional passphrase. If provided, the passphrase will be necessary
+	// to decrypt the cipherseed to expose the internal wallet seed.
+	//
+	// Once the cipherseed is obtained and verified by the user, the InitWallet
+	// method should be used to commit the newly generated seed, and create the
+	// wallet.
+	GenSeed(context.Context, *GenSeedRequest) (*GenSeedResponse, error)
+	// *
+	// InitWallet is used when lnd is starting up for the first time to fully
+	// initialize the daemon and its internal wallet. At the very least a wallet
+	// password must be provided. This will be used to encrypt sensitive material
+	// on disk.
+	//
+	// In the case of a recovery scenario, the user can also specify their aezeed
+	// mnemonic and passphrase. If set, then the daemon will use this prior state
+	// to initialize its internal wallet.
+	//
+	// Alternatively, this can be used along with the GenSeed RPC to obtain a
+	// seed, then present it to the user. Once it has been verified by the user,
+	// the seed can be fed into this RPC in order to commit the new wallet.
+	InitWallet(context.Context, *InitWalletRequest) (*InitWalletResponse, error)
+	// * lncli: `unlock`
+	// UnlockWallet is used at startup of lnd to provide a password to unlock
+	// the wallet database.
+	UnlockWallet(context.Context, *UnlockWalletRequest) (*UnlockWalletResponse, error)
+	// * lncli: `changepassword`
+	// ChangePassword changes the password of the encrypted wallet. This will
+	// automatically unlock the wallet database if successful.
+	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
+}
+
+func RegisterWalletUnlockerServer(s *grpc.Server, srv WalletUnlockerServer) {
+	s.RegisterService(&_WalletUnlocker_serviceDesc, srv)
+}
+
+func _WalletUnlocker_GenSeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenSeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletUnlockerServer).GenSeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.WalletUnlocker/GenSeed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletUnlockerServer).GenSeed(ctx, req.(*GenSeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletUnlocker_InitWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletUnlockerServer).InitWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.WalletUnlocker/InitWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletUnlockerServer).InitWallet(ctx, req.(*InitWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletUnlocker_UnlockWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletUnlockerServer).UnlockWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.WalletUnlocker/UnlockWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletUnlockerServer).UnlockWallet(ctx, req.(*UnlockWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletUnlocker_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletUnlockerServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.WalletUnlocker/ChangePassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletUnlockerServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _WalletUnlocker_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lnrpc.WalletUnlocker",
+	HandlerType: (*WalletUnlockerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GenSeed",
+			Handler:    _WalletUnlocker_GenSeed_Handler,
+		},
+		{
+			MethodName: "InitWallet",
+			Handler:    _WalletUnlocker_InitWallet_Handler,
+		},
+		{
+			MethodName: "UnlockWallet",
+			Handler:    _WalletUnlocker_UnlockWallet_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _WalletUnlocker_ChangePassword_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpc.proto",
+}
+
+// StateClient is the client API for State service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type StateClient interface {
+	// * lncli: `state`
+	// SubscribeState streams the lifecycle state of the daemon, starting with
+	// the current one and then each time it changes. The stream served before
+	// the wallet is unlocked ends once the daemon is unlocked, since the serving
+	// RPC server is then replaced by the main one, on which the subscription
+	// should be made again.
+	SubscribeState(ctx context.Context, in *SubscribeStateRequest, opts ...grpc.CallOption) (State_SubscribeStateClient, error)
+	// *
+	// GetState returns the current lifecycle state of the daemon.
+	GetState(ctx context.Context, in *GetStateRequest, opts ...grpc.CallOption) (*GetStateResponse, error)
+}
+
+type stateClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewStateClient(cc *grpc.ClientConn) StateClient {
+	return &stateClient{cc}
+}
+
+func (c *stateClient) SubscribeState(ctx context.Context, in *SubscribeStateRequest, opts ...grpc.CallOption) (State_SubscribeStateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_State_serviceDesc.Streams[0], "/lnrpc.State/SubscribeState", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &stateSubscribeStateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type State_SubscribeStateClient interface {
+	Recv() (*SubscribeStateResponse, error)
+	grpc.ClientStream
+}
+
+type stateSubscribeStateClient struct {
+	grpc.ClientStream
+}
+
+func (x *stateSubscribeStateClient) Recv() (*SubscribeStateResponse, error) {
+	m := new(SubscribeStateResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *stateClient) GetState(ctx context.Context, in *GetStateRequest, opts ...grpc.CallOption) (*GetStateResponse, error) {
+	out := new(GetStateResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.State/GetState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StateServer is the server API for State service.
+type StateServer interface {
+	// * lncli: `state`
+	// SubscribeState streams the lifecycle state of the daemon, starting with
+	// the current one and then each time it changes. The stream served before
+	// the wallet is unlocked ends once the daemon is unlocked, since the serving
+	// RPC server is then replaced by the main one, on which the subscription
+	// should be made again.
+	SubscribeState(*SubscribeStateRequest, State_SubscribeStateServer) error
+	// *
+	// GetState returns the current lifecycle state of the daemon.
+	GetState(context.Context, *GetStateRequest) (*GetStateResponse, error)
+}
+
+func RegisterStateServer(s *grpc.Server, srv StateServer) {
+	s.RegisterService(&_State_serviceDesc, srv)
+}
+
+func _State_SubscribeState_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeStateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StateServer).SubscribeState(m, &stateSubscribeStateServer{stream})
+}
+
+type State_SubscribeStateServer interface {
+	Send(*SubscribeStateResponse) error
+	grpc.ServerStream
+}
+
+type stateSubscribeStateServer struct {
+	grpc.ServerStream
+}
+
+func (x *stateSubscribeStateServer) Send(m *SubscribeStateResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _State_GetState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StateServer).GetState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.State/GetState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StateServer).GetState(ctx, req.(*GetStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _State_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lnrpc.State",
+	HandlerType: (*StateServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetState",
+			Handler:    _State_GetState_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeState",
+			Handler:       _State_SubscribeState_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "rpc.proto",
+}
+
+// LightningClient is the client API for Lightning service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type LightningClient interface {
+	// * lncli: `walletbalance`
+	// WalletBalance returns total unspent outputs(confirmed and unconfirmed), all
+	// confirmed unspent outputs and all unconfirmed unspent outputs under control
+	// of the wallet.
+	WalletBalance(ctx context.Context, in *WalletBalanceRequest, opts ...grpc.CallOption) (*WalletBalanceResponse, error)
+	// * lncli: `channelbalance`
+	// ChannelBalance returns the total funds available across all open channels
+	// in atoms.
+	ChannelBalance(ctx context.Context, in *ChannelBalanceRequest, opts ...grpc.CallOption) (*ChannelBalanceResponse, error)
+	// * lncli: `listchaintxns`
+	// GetTransactions returns a list describing all the known transactions
+	// relevant to the wallet.
+	GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*TransactionDetails, error)
+	// * lncli: `estimatefee`
+	// EstimateFee asks the chain backend to estimate the fee rate and total fees
+	// for a transaction that pays to multiple specified outputs.
+	EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error)
+	// * lncli: `sendcoins`
+	// SendCoins executes a request to send coins to a particular address. Unlike
+	// SendMany, this RPC call only allows creating a single output at a time. If
+	// neither target_conf, or atoms_per_byte are set, then the internal wallet will
+	// consult its fee model to determine a fee for the default confirmation
+	// target.
+	SendCoins(ctx context.Context, in *SendCoinsRequest, opts ...grpc.CallOption) (*SendCoinsResponse, error)
+	// * lncli: `listunspent`
+	// ListUnspent returns a list of all utxos spendable by the wallet with a
+	// number of confirmations between the specified minimum and maximum.
+	ListUnspent(ctx context.Context, in *ListUnspentRequest, opts ...grpc.CallOption) (*ListUnspentResponse, error)
+	// *
+	// SubscribeTransactions creates a uni-directional stream from the server to
+	// the client in which any newly discovered transactions relevant to the
+	// wallet are sent over. The filters of the request are applied server-side,
+	// so that only the matching transactions are sent.
+	SubscribeTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (Lightning_SubscribeTransactionsClient, error)
+	// * lncli: `sendmany`
+	// SendMany handles a request for a transaction that creates multiple specified
+	// outputs in parallel. If neither target_conf, or atoms_per_byte are set, then
+	// the internal wallet will consult its fee model to determine a fee for the
+	// default confirmation target.
+	SendMany(ctx context.Context, in *SendManyRequest, opts ...grpc.CallOption) (*SendManyResponse, error)
+	// * lncli: `newaddress`
+	// NewAddress creates a new address under control of the local wallet.
+	NewAddress(ctx context.Context, in *NewAddressRequest, opts ...grpc.CallOption) (*NewAddressResponse, error)
+	// * lncli: `signmessage`
+	// SignMessage signs a message with this node's private key. The returned
+	// signature string is `zbase32` encoded and pubkey recoverable, meaning that
+	// only the message digest and signature are needed for verification.
+	SignMessage(ctx context.Context, in *SignMessageRequest, opts ...grpc.CallOption) (*SignMessageResponse, error)
+	// * lncli: `verifymessage`
+	// VerifyMessage verifies a signature over a msg. The signature must be
+	// zbase32 encoded and signed by an active node in the resident node's
+	// channel database. In addition to returning the validity of the signature,
+	// VerifyMessage also returns the recovered pubkey from the signature.
+	VerifyMessage(ctx context.Context, in *VerifyMessageRequest, opts ...grpc.CallOption) (*VerifyMessageResponse, error)
+	// * lncli: `connect`
+	// ConnectPeer attempts to establish a connection to a remote peer. This is at
+	// the networking level, and is used for communication between nodes. This is
+	// distinct from establishing a channel with a peer.
+	ConnectPeer(ctx context.Context, in *ConnectPeerRequest, opts ...grpc.CallOption) (*ConnectPeerResponse, error)
+	// * lncli: `disconnect`
+	// DisconnectPeer attempts to disconnect one peer from another identified by a
+	// given pubKey. In the case that we currently have a pending or active channel
+	// with the target peer, then this action will be not be allowed.
+	DisconnectPeer(ctx context.Context, in *DisconnectPeerRequest, opts ...grpc.CallOption) (*DisconnectPeerResponse, error)
+	// * lncli: `setpeerpolicy`
+	// SetPeerConnPolicy sets the connection policy of a peer, which is persisted
+	// across restarts. It determines whether the connection to the peer is
+	// re-established once lost, overriding the default persistent peer
+	// behavior, and allows custom bounds for the reconnection backoff. Setting
+	// the default policy without custom backoffs removes the peer's policy.
+	SetPeerConnPolicy(ctx context.Context, in *SetPeerConnPolicyRequest, opts ...grpc.CallOption) (*SetPeerConnPolicyResponse, error)
+	// * lncli: `listpeers`
+	// ListPeers returns a verbose listing of all currently active peers.
+	ListPeers(ctx context.Context, in *ListPeersRequest, opts ...grpc.CallOption) (*ListPeersResponse, error)
+	// * lncli: `listgossipsyncers`
+	// ListGossipSyncers returns the state of the gossip syncer of each currently
+	// connected peer, along with the gossip bandwidth budget shared by all of
+	// them.
+	ListGossipSyncers(ctx context.Context, in *ListGossipSyncersRequest, opts ...grpc.CallOption) (*ListGossipSyncersResponse, error)
+	// * lncli: `graphsyncstatus`
+	// GetGraphSyncStatus returns the progress of the initial historical sync of
+	// the channel graph, which is performed with one of our peers upon startup.
+	GetGraphSyncStatus(ctx context.Context, in *GraphSyncStatusRequest, opts ...grpc.CallOption) (*GraphSyncStatus, error)
+	// *
+	// SubscribeGraphSyncStatus returns a uni-directional stream (server -> client)
+	// of the progress of the initial historical sync of the channel graph. The
+	// current status is sent immediately, followed by a new one every time the
+	// sync starts or completes.
+	SubscribeGraphSyncStatus(ctx context.Context, in *GraphSyncSubscription, opts ...grpc.CallOption) (Lightning_SubscribeGraphSyncStatusClient, error)
+	// * lncli: `getinfo`
+	// GetInfo returns general information concerning the lightning node including
+	// it's identity pubkey, alias, the chains it is connected to, and information
+	// concerning the number of open+pending channels.
+	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
+	// * lncli: `pendingchannels`
+	// PendingChannels returns a list of all the channels that are currently
+	// considered "pending". A channel is pending if it has finished the funding
+	// workflow and is waiting for confirmations for the funding txn, or is in the
+	// process of closure, either initiated cooperatively or non-cooperatively.
+	PendingChannels(ctx context.Context, in *PendingChannelsRequest, opts ...grpc.CallOption) (*PendingChannelsResponse, error)
+	// * lncli: `listarbitrators`
+	// ListArbitrators returns the state of the arbitrators watching the channels
+	// on-chain, along with the progress of the resolvers claiming the outputs of
+	// the channels that were force closed: which outputs are being claimed, what
+	// each resolver is waiting for and the transactions claiming the outputs.
+	ListArbitrators(ctx context.Context, in *ListArbitratorsRequest, opts ...grpc.CallOption) (*ListArbitratorsResponse, error)
+	// * lncli: `pendingchannellimits`
+	// PendingChannelLimits returns the limits on the number of pending channels
+	// enforced when accepting new channels, along with the current number of
+	// pending channels per peer and across all peers.
+	PendingChannelLimits(ctx context.Context, in *PendingChannelLimitsRequest, opts ...grpc.CallOption) (*PendingChannelLimitsResponse, error)
+	// * lncli: `listchannels`
+	// ListChannels returns a description of all the open channels that this node
+	// is a participant in.
+	ListChannels(ctx context.Context, in *ListChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error)
+	// *
+	// SubscribeChannelEvents creates a uni-directional stream from the server to
+	// the client in which any updates relevant to the state of the channels are
+	// sent over. Events include new active channels, inactive channels, and closed
+	// channels.
+	SubscribeChannelEvents(ctx context.Context, in *ChannelEventSubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelEventsClient, error)
+	// * lncli: `closedchannels`
+	// ClosedChannels returns a description of all the closed channels that
+	// this node was a participant in.
+	ClosedChannels(ctx context.Context, in *ClosedChannelsRequest, opts ...grpc.CallOption) (*ClosedChannelsResponse, error)
+	// *
+	// StreamChannels is a streaming variant of ListChannels. It sends the open
+	// channels matching the request one at a time rather than in a single
+	// response, so that it can be used by nodes with more channels than fit in
+	// a single message.
+	StreamChannels(ctx context.Context, in *ListChannelsRequest, opts ...grpc.CallOption) (Lightning_StreamChannelsClient, error)
+	// *
+	// StreamClosedChannels is a streaming variant of ClosedChannels. It sends
+	// the closed channels matching the request one at a time rather than in a
+	// single response.
+	StreamClosedChannels(ctx context.Context, in *ClosedChannelsRequest, opts ...grpc.CallOption) (Lightning_StreamClosedChannelsClient, error)
+	// *
+	// OpenChannelSync is a synchronous version of the OpenChannel RPC call. This
+	// call is meant to be consumed by clients to the REST proxy. As with all
+	// other sync calls, all byte slices are intended to be populated as hex
+	// encoded strings.
+	OpenChannelSync(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (*ChannelPoint, error)
+	// * lncli: `openchannel`
+	// OpenChannel attempts to open a singly funded channel specified in the
+	// request to a remote peer. Users are able to specify a target number of
+	// blocks that the funding transaction should be confirmed in, or a manual fee
+	// rate to us for the funding transaction. If neither are specified, then a
+	// lax block confirmation target is used.
+	OpenChannel(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (Lightning_OpenChannelClient, error)
+	// *
+	// ChannelAcceptor dispatches a bi-directional streaming RPC in which
+	// OpenChannel requests are sent to the client and the client responds with
+	// a boolean that tells LND whether or not to accept the channel. This allows
+	// node operators to specify their own criteria for accepting inbound channels
+	// through a single persistent connection. Requests that aren't responded to
+	// within the acceptor timeout are accepted or rejected according to the
+	// fallback policy of the node.
+	ChannelAcceptor(ctx context.Context, opts ...grpc.CallOption) (Lightning_ChannelAcceptorClient, error)
+	// * lncli: `closechannel`
+	// CloseChannel attempts to close an active channel identified by its channel
+	// outpoint (ChannelPoint). The actions of this method can additionally be
+	// augmented to attempt a force close after a timeout period in the case of an
+	// inactive peer. If a non-force close (cooperative closure) is requested,
+	// then the user can specify either a target number of blocks until the
+	// closure transaction is confirmed, or a manual fee rate. If neither are
+	// specified, then a default lax, block confirmation target is used.
+	// When force closing a channel with anchors, the same fee parameters
+	// determine the fee of the anchor sweep, which bumps the fee of the
+	// commitment through CPFP.
+	CloseChannel(ctx context.Context, in *CloseChannelRequest, opts ...grpc.CallOption) (Lightning_CloseChannelClient, error)
+	// * lncli: `abandonchannel`
+	// AbandonChannel removes all channel state from the database except for a
+	// close summary, without any on-chain action. This method can be used to get
+	// rid of permanently unusable channels due to bugs fixed in newer versions of
+	// lnd, or of pending channels whose funding transaction will never confirm.
+	// Only available in debug builds of lnd, unless i_know_what_i_am_doing is
+	// set.
+	AbandonChannel(ctx context.Context, in *AbandonChannelRequest, opts ...grpc.CallOption) (*AbandonChannelResponse, error)
+	// * lncli: `sendpayment`
+	// SendPayment dispatches a bi-directional streaming RPC for sending payments
+	// through the Lightning Network. A single RPC invocation creates a persistent
+	// bi-directional stream allowing clients to rapidly send payments through the
+	// Lightning Network with a single persistent connection.
+	SendPayment(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendPaymentClient, error)
+	// *
+	// SendPaymentSync is the synchronous non-streaming version of SendPayment.
+	// This RPC is intended to be consumed by clients of the REST proxy.
+	// Additionally, this RPC expects the destination's public key and the payment
+	// hash (if any) to be encoded as hex strings.
+	SendPaymentSync(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*SendResponse, error)
+	// * lncli: `sendtoroute`
+	// SendToRoute is a bi-directional streaming RPC for sending payment through
+	// the Lightning Network. This method differs from SendPayment in that it
+	// allows users to specify a full route manually. This can be used for things
+	// like rebalancing, and atomic swaps.
+	SendToRoute(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendToRouteClient, error)
+	// *
+	// SendToRouteSync is a synchronous version of SendToRoute. It Will block
+	// until the payment either fails or succeeds.
+	SendToRouteSync(ctx context.Context, in *SendToRouteRequest, opts ...grpc.CallOption) (*SendResponse, error)
+	// * lncli: `addinvoice`
+	// AddInvoice attempts to add a new invoice to the invoice database. Any
+	// duplicated invoices are rejected, therefore all invoices *must* have a
+	// unique payment preimage.
+	AddInvoice(ctx context.Context, in *Invoice, opts ...grpc.CallOption) (*AddInvoiceResponse, error)
+	// * lncli: `listinvoices`
+	// ListInvoices returns a list of all the invoices currently stored within the
+	// database. Any active debug invoices are ignored. It has full support for
+	// paginated responses, allowing users to query for specific invoices through
+	// their add_index. This can be done by using either the first_index_offset or
+	// last_index_offset fields included in the response as the index_offset of the
+	// next request. By default, the first 100 invoices created will be returned.
+	// Backwards pagination is also supported through the Reversed flag.
+	ListInvoices(ctx context.Context, in *ListInvoiceRequest, opts ...grpc.CallOption) (*ListInvoiceResponse, error)
+	// * lncli: `lookupinvoice`
+	// LookupInvoice attempts to look up an invoice according to its payment hash
+	// or payment address. The passed payment hash or address *must* be exactly
+	// 32 bytes, if not, an error is returned.
+	LookupInvoice(ctx context.Context, in *PaymentHash, opts ...grpc.CallOption) (*Invoice, error)
+	// *
+	// SubscribeInvoices returns a uni-directional stream (server -> client) for
+	// notifying the client of newly added/settled invoices. The caller can
+	// optionally specify the add_index and/or the settle_index. If the add_index
+	// is specified, then we'll first start by sending add invoice events for all
+	// invoices with an add_index greater than the specified value.  If the
+	// settle_index is specified, the next, we'll send out all settle events for
+	// invoices with a settle_index greater than the specified value.  One or both
+	// of these fields can be set. If no fields are set, then we'll only send out
+	// the latest add/settle events.
+	SubscribeInvoices(ctx context.Context, in *InvoiceSubscription, opts ...grpc.CallOption) (Lightning_SubscribeInvoicesClient, error)
+	// * lncli: `decodepayreq`
+	// DecodePayReq takes an encoded payment request string and attempts to decode
+	// it, returning a full description of the conditions encoded within the
+	// payment request.
+	DecodePayReq(ctx context.Context, in *PayReqString, opts ...grpc.CallOption) (*PayReq, error)
+	// * lncli: `listpayments`
+	// ListPayments returns a list of all outgoing payments.
+	ListPayments(ctx context.Context, in *ListPaymentsRequest, opts ...grpc.CallOption) (*ListPaymentsResponse, error)
+	// *
+	// DeleteAllPayments deletes all outgoing payments from DB.
+	DeleteAllPayments(ctx context.Context, in *DeleteAllPaymentsRequest, opts ...grpc.CallOption) (*DeleteAllPaymentsResponse, error)
+	// * lncli: `describegraph`
+	// DescribeGraph returns a description of the latest graph state from the
+	// point of view of the node. The graph information is partitioned into two
+	// components: all the nodes/vertexes, and all the edges that connect the
+	// vertexes themselves.  As this is a directed graph, the edges also contain
+	// the node directional specific routing policy which includes: the time lock
+	// delta, fee information, etc.
+	DescribeGraph(ctx context.Context, in *ChannelGraphRequest, opts ...grpc.CallOption) (*ChannelGraph, error)
+	// * lncli: `getchaninfo`
+	// GetChanInfo returns the latest authenticated network announcement for the
+	// given channel identified by its channel ID: an 8-byte integer which
+	// uniquely identifies the location of transaction's funding output within the
+	// blockchain.
+	GetChanInfo(ctx context.Context, in *ChanInfoRequest, opts ...grpc.CallOption) (*ChannelEdge, error)
+	// * lncli: `getnodeinfo`
+	// GetNodeInfo returns the latest advertised, aggregated, and authenticated
+	// channel information for the specified node identified by its public key.
+	GetNodeInfo(ctx context.Context, in *NodeInfoRequest, opts ...grpc.CallOption) (*NodeInfo, error)
+	// * lncli: `queryroutes`
+	// QueryRoutes attempts to query the daemon's Channel Router for a possible
+	// route to a target destination capable of carrying a specific amount of
+	// atoms. The retuned route contains the full details required to craft and
+	// send an HTLC, also including the necessary information that should be
+	// present within the Sphinx packet encapsulated within the HTLC.
+	QueryRoutes(ctx context.Context, in *QueryRoutesRequest, opts ...grpc.CallOption) (*QueryRoutesResponse, error)
+	// * lncli: `getnetworkinfo`
+	// GetNetworkInfo returns some basic stats about the known channel graph from
+	// the point of view of the node.
+	GetNetworkInfo(ctx context.Context, in *NetworkInfoRequest, opts ...grpc.CallOption) (*NetworkInfo, error)
+	// * lncli: `stop`
+	// StopDaemon will send a shutdown request to the interrupt handler, triggering
+	// a graceful shutdown of the daemon.
+	StopDaemon(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error)
+	// *
+	// SubscribeChannelGraph launches a streaming RPC that allows the caller to
+	// receive notifications upon any changes to the channel graph topology from
+	// the point of view of the responding node. Events notified include: new
+	// nodes coming online, nodes updating their authenticated attributes, new
+	// channels being advertised, updates in the routing policy for a directional
+	// channel edge, and when channels are closed on-chain.
+	SubscribeChannelGraph(ctx context.Context, in *GraphTopologySubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelGraphClient, error)
+	// * lncli: `debuglevel`
+	// DebugLevel allows a caller to programmatically set the logging verbosity of
+	// lnd. The logging can be targeted according to a coarse daemon-wide logging
+	// level, or in a granular fashion to specify the logging for a target
+	// sub-system.
+	DebugLevel(ctx context.Context, in *DebugLevelRequest, opts ...grpc.CallOption) (*DebugLevelResponse, error)
+	// * lncli: `feereport`
+	// FeeReport allows the caller to obtain a report detailing the current fee
+	// schedule enforced by the node globally for each channel.
+	FeeReport(ctx context.Context, in *FeeReportRequest, opts ...grpc.CallOption) (*FeeReportResponse, error)
+	// * lncli: `updatechanpolicy`
+	// UpdateChannelPolicy allows the caller to update the fee schedule and
+	// channel policies for all channels globally, or a particular channel.
+	UpdateChannelPolicy(ctx context.Context, in *PolicyUpdateRequest, opts ...grpc.CallOption) (*PolicyUpdateResponse, error)
+	// * lncli: `fwdinghistory`
+	// ForwardingHistory allows the caller to query the htlcswitch for a record of
+	// all HTLCs forwarded within the target time range, and integer offset
+	// within that time range. If no time-range is specified, then the first chunk
+	// of the past 24 hrs of forwarding history are returned.
+	//
+	// A list of forwarding events are returned. The size of each forwarding event
+	// is 40 bytes, and the max message size able to be returned in gRPC is 4 MiB.
+	// As a result each message can only contain 50k entries.  Each response has
+	// the index offset of the last entry. The index offset can be provided to the
+	// request to allow the caller to skip a series of records.
+	ForwardingHistory(ctx context.Context, in *ForwardingHistoryRequest, opts ...grpc.CallOption) (*ForwardingHistoryResponse, error)
+	// * lncli: `exportchanbackup`
+	// ExportChannelBackup attempts to return an encrypted static channel backup
+	// for the target channel identified by it channel point. The backup is
+	// encrypted with a key generated from the aezeed seed of the user. The
+	// returned backup can either be restored using the RestoreChannelBackup
+	// method once lnd is running, or via the InitWallet and UnlockWallet methods
+	// from the WalletUnlocker service.
+	ExportChannelBackup(ctx context.Context, in *ExportChannelBackupRequest, opts ...grpc.CallOption) (*ChannelBackup, error)
+	// *
+	// ExportAllChannelBackups returns static channel backups for all existing
+	// channels known to lnd. A set of regular singular static channel backups for
+	// each channel are returned. Additionally, a multi-channel backup is returned
+	// as well, which contains a single encrypted blob containing the backups of
+	// each channel.
+	ExportAllChannelBackups(ctx context.Context, in *ChanBackupExportRequest, opts ...grpc.CallOption) (*ChanBackupSnapshot, error)
+	// *
+	// VerifyChanBackup allows a caller to verify the integrity of a channel backup
+	// snapshot. This method will accept either a packed Single or a packed Multi.
+	// Specifying both will result in an error.
+	VerifyChanBackup(ctx context.Context, in *ChanBackupSnapshot, opts ...grpc.CallOption) (*VerifyChanBackupResponse, error)
+	// * lncli: `inspectchanbackup`
+	// InspectChanBackup decrypts the given channel backup snapshot and returns the
+	// channels it contains, without restoring any of them. This method will
+	// accept either a set of packed Singles or a packed Multi. Specifying both
+	// will result in an error.
+	InspectChanBackup(ctx context.Context, in *ChanBackupSnapshot, opts ...grpc.CallOption) (*InspectChanBackupResponse, error)
+	// * lncli: `restorechanbackup`
+	// RestoreChannelBackups accepts a set of singular channel backups, or a
+	// single encrypted multi-chan backup and attempts to recover any funds
+	// remaining within the channel. If we are able to unpack the backup, then the
+	// new channel will be shown under listchannels, as well as pending channels.
+	// If dry_run is set, the backup is only decrypted and the channel peers are
+	// checked for reachability, without restoring any channels.
+	RestoreChannelBackups(ctx context.Context, in *RestoreChanBackupRequest, opts ...grpc.CallOption) (*RestoreBackupResponse, error)
+	// *
+	// SubscribeChannelBackups allows a client to sub-subscribe to the most up to
+	// date information concerning the state of all channel backups. Each time a
+	// new channel is added, we return the new set of channels, along with a
+	// multi-chan backup containing the backup info for all channels. Each time a
+	// channel is closed, we send a new update, which contains new new chan back
+	// ups, but the updated set of encrypted multi-chan backups with the closed
+	// channel(s) removed.
+	SubscribeChannelBackups(ctx context.Context, in *ChannelBackupSubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelBackupsClient, error)
+	// * lncli: `snapshotdb`
+	// CreateDatabaseSnapshot writes a hot backup of the channel database, the
+	// watchtower client database and the sphinx replay log to a new directory
+	// within the data directory of the node. The databases are copied within
+	// read transactions started in that order, so that the replay log and the
+	// watchtower client database restored along with the channel database are
+	// at least as recent as it. The snapshot is only complete once its manifest,
+	// written last, exists.
+	CreateDatabaseSnapshot(ctx context.Context, in *DatabaseSnapshotRequest, opts ...grpc.CallOption) (*DatabaseSnapshotResponse, error)
+	// * lncli: `batchopenchannel`
+	// BatchOpenChannel attempts to open multiple singly funded channels to
+	// several remote peers within a single funding transaction. The funding
+	// transaction is only broadcast once the funding flow of every channel in
+	// the batch succeeded. If any of the channels fails, the whole batch is
+	// aborted.
+	BatchOpenChannel(ctx context.Context, in *BatchOpenChannelRequest, opts ...grpc.CallOption) (*BatchOpenChannelResponse, error)
+	// * lncli: `bumpfundingfee`
+	// BumpFundingFee bumps the fee of the unconfirmed funding transaction of a
+	// pending channel we initiated, for example because it's stuck below the
+	// fee rate required to be relayed. As the funding transaction can't be
+	// replaced without invalidating the commitment transactions signed by the
+	// peer, its fee is bumped by sweeping one of its outputs controlled by the
+	// wallet, usually its change, with a higher fee rate (Child-Pays-For-Parent).
+	// The peer doesn't need to be online for this.
+	BumpFundingFee(ctx context.Context, in *BumpFundingFeeRequest, opts ...grpc.CallOption) (*BumpFundingFeeResponse, error)
+	// * lncli: `rebroadcastlimbooutput`
+	// RebroadcastLimboOutput immediately re-broadcasts the transaction sweeping
+	// an output of a force closed channel incubated by the nursery, for example
+	// because it's stuck below the fee rate required to be relayed. An expired
+	// htlc output is swept by its pre-signed timeout transaction, which is
+	// published again as is since its fee can't be changed. The other outputs
+	// whose time lock expired are swept again at the given fee rate.
+	RebroadcastLimboOutput(ctx context.Context, in *RebroadcastLimboOutputRequest, opts ...grpc.CallOption) (*RebroadcastLimboOutputResponse, error)
+	// * lncli: `sendcustom`
+	// SendCustomMessage sends a custom message of a type within the custom range
+	// to a connected peer. This allows applications to exchange messages of
+	// their own protocols with peers over the existing transport.
+	SendCustomMessage(ctx context.Context, in *SendCustomMessageRequest, opts ...grpc.CallOption) (*SendCustomMessageResponse, error)
+	// * lncli: `subscribecustom`
+	// SubscribeCustomMessages creates a uni-directional stream from the server to
+	// the client in which the custom messages received from peers are sent over.
+	SubscribeCustomMessages(ctx context.Context, in *SubscribeCustomMessagesRequest, opts ...grpc.CallOption) (Lightning_SubscribeCustomMessagesClient, error)
+	// * lncli: `subscribepeerevents`
+	// SubscribePeerEvents creates a uni-directional stream from the server to
+	// the client in which any events relevant to the state of peers are sent
+	// over. Events include peers connecting, completing the exchange of init
+	// messages and disconnecting, along with the reason of the disconnection.
+	SubscribePeerEvents(ctx context.Context, in *PeerEventSubscription, opts ...grpc.CallOption) (Lightning_SubscribePeerEventsClient, error)
+	// * lncli: `bakemacaroon`
+	// BakeMacaroon allows the creation of a new macaroon with custom read and
+	// write permissions. No first-party caveats are added since this can be done
+	// offline. The macaroon is baked with the root key of the given ID, which is
+	// created if it doesn't exist yet, so that it can be revoked independently
+	// of the other macaroons.
+	BakeMacaroon(ctx context.Context, in *BakeMacaroonRequest, opts ...grpc.CallOption) (*BakeMacaroonResponse, error)
+	// * lncli: `listmacaroonids`
+	// ListMacaroonIDs returns the IDs of all the root keys in use by macaroons.
+	ListMacaroonIDs(ctx context.Context, in *ListMacaroonIDsRequest, opts ...grpc.CallOption) (*ListMacaroonIDsResponse, error)
+	// * lncli: `deletemacaroonid`
+	// DeleteMacaroonID deletes the root key of the given ID, which revokes every
+	// macaroon baked with it. The default root key, used by the macaroons
+	// created on startup, can't be deleted.
+	DeleteMacaroonID(ctx context.Context, in *DeleteMacaroonIDRequest, opts ...grpc.CallOption) (*DeleteMacaroonIDResponse, error)
+	// * lncli: `listpermissions`
+	// ListPermissions lists all RPC method URIs and the macaroon permissions
+	// they require to be invoked.
+	ListPermissions(ctx context.Context, in *ListPermissionsRequest, opts ...grpc.CallOption) (*ListPermissionsResponse, error)
+	// *
+	// RegisterRPCMiddleware adds a new gRPC middleware to the interceptor chain.
+	// A middleware is an external authorizer enforcing the custom caveats of a
+	// given name: every request carrying a macaroon with such a caveat is
+	// forwarded to it, and only processed once the middleware accepts it. The
+	// middleware may rewrite the request, and the response of the method is
+	// forwarded to it in the same way. Requests carrying custom caveats no
+	// middleware is registered for are rejected. A middleware registered in
+	// read-only mode only observes the calls, optionally all of them. The first
+	// message sent by the middleware must be its registration.
+	RegisterRPCMiddleware(ctx context.Context, opts ...grpc.CallOption) (Lightning_RegisterRPCMiddlewareClient, error)
+	// * lncli: `setcoldsweep`
+	// SetColdSweep enables or disables the scheduled sweeps of the confirmed
+	// balance of the wallet above a threshold to the configured cold storage
+	// address.
+	SetColdSweep(ctx context.Context, in *SetColdSweepRequest, opts ...grpc.CallOption) (*SetColdSweepResponse, error)
+	// * lncli: `subscribecoldsweeps`
+	// SubscribeColdSweeps creates a uni-directional stream from the server to
+	// the client in which the events of the scheduled sweeps to cold storage are
+	// sent over. Events include published and failed sweeps, and the sweeps
+	// being enabled or disabled.
+	SubscribeColdSweeps(ctx context.Context, in *ColdSweepSubscription, opts ...grpc.CallOption) (Lightning_SubscribeColdSweepsClient, error)
+	// * lncli: `invoiceuri`
+	// InvoiceURI looks up an invoice according to its payment hash and returns
+	// it alongside its canonical payment URI, carrying its amount, label and
+	// fallback address, and the upper case form of the URI best suited for QR
+	// codes. Generating the URIs server-side ensures that all clients present the
+	// same URIs.
+	InvoiceURI(ctx context.Context, in *InvoiceURIRequest, opts ...grpc.CallOption) (*InvoiceURIResponse, error)
+	// * lncli: `legacypayloadstats`
+	// LegacyPayloadStats returns the number of HTLCs carrying a legacy, non-TLV,
+	// onion payload forwarded, received and rejected by the node since it
+	// started, along with the configured deadlines after which these HTLCs are
+	// rejected.
+	LegacyPayloadStats(ctx context.Context, in *LegacyPayloadStatsRequest, opts ...grpc.CallOption) (*LegacyPayloadStatsResponse, error)
+	// * lncli: `pushgossip`
+	// PushGossip sends our node announcement and the announcements and latest
+	// updates of the selected channels of ours directly to a connected peer,
+	// regardless of its gossip syncing state. Only the updates of private
+	// channels are sent, allowing a directly connected peer to learn our private
+	// routing info.
+	PushGossip(ctx context.Context, in *PushGossipRequest, opts ...grpc.CallOption) (*PushGossipResponse, error)
+	// * lncli: `feemanagerreport`
+	// FeeManagerReport returns the state of the fee manager, which periodically
+	// adjusts the fee rates of our channels based on their liquidity and
+	// forwarding demand, along with its most recent fee rate adjustments.
+	FeeManagerReport(ctx context.Context, in *FeeManagerReportRequest, opts ...grpc.CallOption) (*FeeManagerReportResponse, error)
+	// * lncli: `effectivefeerates`
+	// EffectiveFeeRates returns the confirmation target configured for each of
+	// the subsystems paying on-chain fees, along with the fee rate it currently
+	// resolves to.
+	EffectiveFeeRates(ctx context.Context, in *EffectiveFeeRatesRequest, opts ...grpc.CallOption) (*EffectiveFeeRatesResponse, error)
+	// * lncli: `getdebuginfo`
+	// GetDebugInfo returns a snapshot of the state of the node meant to be
+	// attached to bug reports: the active configuration with its sensitive
+	// options redacted, runtime stats, the size of the database buckets and the
+	// number of operations pending in the main subsystems.
+	GetDebugInfo(ctx context.Context, in *GetDebugInfoRequest, opts ...grpc.CallOption) (*GetDebugInfoResponse, error)
+	// *
+	// CheckMacaroonPermissions checks whether the given macaroon grants the
+	// required permissions, and returns its caveats. It allows external services,
+	// such as reverse proxies, to authorize requests with the macaroons of the
+	// node without embedding the verification logic. An error is returned if the
+	// macaroon is invalid or doesn't grant the permissions.
+	CheckMacaroonPermissions(ctx context.Context, in *CheckMacaroonPermissionsRequest, opts ...grpc.CallOption) (*CheckMacaroonPermissionsResponse, error)
+	// * lncli: `warpclock`
+	// WarpClock fast-forwards the clock of the daemon by the given duration,
+	// firing the timers that become due, such as the ones expiring invoices. It
+	// is only available on regtest and simnet, for integration testing.
+	WarpClock(ctx context.Context, in *WarpClockRequest, opts ...grpc.CallOption) (*WarpClockResponse, error)
+	// * lncli: `exportreceipt`
+	// ExportPaymentReceipt returns a receipt for a settled payment, signed by the
+	// node. The receipt ties the preimage of the payment to the payment request
+	// it paid and to a summary of its routes, so that the settlement can be
+	// proven to third parties.
+	ExportPaymentReceipt(ctx context.Context, in *ExportPaymentReceiptRequest, opts ...grpc.CallOption) (*PaymentReceipt, error)
+	// * lncli: `verifyreceipt`
+	// VerifyPaymentReceipt checks that a payment receipt is signed by the node
+	// that made the payment and that its content is consistent, and returns the
+	// content of the receipt.
+	VerifyPaymentReceipt(ctx context.Context, in *VerifyPaymentReceiptRequest, opts ...grpc.CallOption) (*VerifyPaymentReceiptResponse, error)
+	// * lncli: `recoverystatus`
+	// ChannelRecoveryStatus returns the progress of the recovery of the channels
+	// restored from static channel backups, along with the status of the recovery
+	// peers asked to force close the channels missing from the backups.
+	ChannelRecoveryStatus(ctx context.Context, in *ChannelRecoveryStatusRequest, opts ...grpc.CallOption) (*ChannelRecoveryStatusResponse, error)
+}
+
+type lightningClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewLightningClient(cc *grpc.ClientConn) LightningClient {
+	return &lightningClient{cc}
+}
+
+func (c *lightningClient) WalletBalance(ctx context.Context, in *WalletBalanceRequest, opts ...grpc.CallOption) (*WalletBalanceResponse, error) {
+	out := new(WalletBalanceResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/WalletBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ChannelBalance(ctx context.Context, in *ChannelBalanceRequest, opts ...grpc.CallOption) (*ChannelBalanceResponse, error) {
+	out := new(ChannelBalanceResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ChannelBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*TransactionDetails, error) {
+	out := new(TransactionDetails)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error) {
+	out := new(EstimateFeeResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/EstimateFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SendCoins(ctx context.Context, in *SendCoinsRequest, opts ...grpc.CallOption) (*SendCoinsResponse, error) {
+	out := new(SendCoinsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SendCoins", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListUnspent(ctx context.Context, in *ListUnspentRequest, opts ...grpc.CallOption) (*ListUnspentResponse, error) {
+	out := new(ListUnspentResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListUnspent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SubscribeTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (Lightning_SubscribeTransactionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[0], "/lnrpc.Lightning/SubscribeTransactions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSubscribeTransactionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_SubscribeTransactionsClient interface {
+	Recv() (*Transaction, error)
+	grpc.ClientStream
+}
+
+type lightningSubscribeTransactionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSubscribeTransactionsClient) Recv() (*Transaction, error) {
+	m := new(Transaction)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) SendMany(ctx context.Context, in *SendManyRequest, opts ...grpc.CallOption) (*SendManyResponse, error) {
+	out := new(SendManyResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SendMany", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) NewAddress(ctx context.Context, in *NewAddressRequest, opts ...grpc.CallOption) (*NewAddressResponse, error) {
+	out := new(NewAddressResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/NewAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SignMessage(ctx context.Context, in *SignMessageRequest, opts ...grpc.CallOption) (*SignMessageResponse, error) {
+	out := new(SignMessageResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SignMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) VerifyMessage(ctx context.Context, in *VerifyMessageRequest, opts ...grpc.CallOption) (*VerifyMessageResponse, error) {
+	out := new(VerifyMessageResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/VerifyMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ConnectPeer(ctx context.Context, in *ConnectPeerRequest, opts ...grpc.CallOption) (*ConnectPeerResponse, error) {
+	out := new(ConnectPeerResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ConnectPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) DisconnectPeer(ctx context.Context, in *DisconnectPeerRequest, opts ...grpc.CallOption) (*DisconnectPeerResponse, error) {
+	out := new(DisconnectPeerResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/DisconnectPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SetPeerConnPolicy(ctx context.Context, in *SetPeerConnPolicyRequest, opts ...grpc.CallOption) (*SetPeerConnPolicyResponse, error) {
+	out := new(SetPeerConnPolicyResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SetPeerConnPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListPeers(ctx context.Context, in *ListPeersRequest, opts ...grpc.CallOption) (*ListPeersResponse, error) {
+	out := new(ListPeersResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListPeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListGossipSyncers(ctx context.Context, in *ListGossipSyncersRequest, opts ...grpc.CallOption) (*ListGossipSyncersResponse, error) {
+	out := new(ListGossipSyncersResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListGossipSyncers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) GetGraphSyncStatus(ctx context.Context, in *GraphSyncStatusRequest, opts ...grpc.CallOption) (*GraphSyncStatus, error) {
+	out := new(GraphSyncStatus)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetGraphSyncStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SubscribeGraphSyncStatus(ctx context.Context, in *GraphSyncSubscription, opts ...grpc.CallOption) (Lightning_SubscribeGraphSyncStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[1], "/lnrpc.Lightning/SubscribeGraphSyncStatus", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSubscribeGraphSyncStatusClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_SubscribeGraphSyncStatusClient interface {
+	Recv() (*GraphSyncStatus, error)
+	grpc.ClientStream
+}
+
+type lightningSubscribeGraphSyncStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSubscribeGraphSyncStatusClient) Recv() (*GraphSyncStatus, error) {
+	m := new(GraphSyncStatus)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error) {
+	out := new(GetInfoResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) PendingChannels(ctx context.Context, in *PendingChannelsRequest, opts ...grpc.CallOption) (*PendingChannelsResponse, error) {
+	out := new(PendingChannelsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/PendingChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListArbitrators(ctx context.Context, in *ListArbitratorsRequest, opts ...grpc.CallOption) (*ListArbitratorsResponse, error) {
+	out := new(ListArbitratorsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListArbitrators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) PendingChannelLimits(ctx context.Context, in *PendingChannelLimitsRequest, opts ...grpc.CallOption) (*PendingChannelLimitsResponse, error) {
+	out := new(PendingChannelLimitsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/PendingChannelLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListChannels(ctx context.Context, in *ListChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error) {
+	out := new(ListChannelsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SubscribeChannelEvents(ctx context.Context, in *ChannelEventSubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[2], "/lnrpc.Lightning/SubscribeChannelEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSubscribeChannelEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_SubscribeChannelEventsClient interface {
+	Recv() (*ChannelEventUpdate, error)
+	grpc.ClientStream
+}
+
+type lightningSubscribeChannelEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSubscribeChannelEventsClient) Recv() (*ChannelEventUpdate, error) {
+	m := new(ChannelEventUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) ClosedChannels(ctx context.Context, in *ClosedChannelsRequest, opts ...grpc.CallOption) (*ClosedChannelsResponse, error) {
+	out := new(ClosedChannelsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ClosedChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) StreamChannels(ctx context.Context, in *ListChannelsRequest, opts ...grpc.CallOption) (Lightning_StreamChannelsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[3], "/lnrpc.Lightning/StreamChannels", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningStreamChannelsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_StreamChannelsClient interface {
+	Recv() (*Channel, error)
+	grpc.ClientStream
+}
+
+type lightningStreamChannelsClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningStreamChannelsClient) Recv() (*Channel, error) {
+	m := new(Channel)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) StreamClosedChannels(ctx context.Context, in *ClosedChannelsRequest, opts ...grpc.CallOption) (Lightning_StreamClosedChannelsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[4], "/lnrpc.Lightning/StreamClosedChannels", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningStreamClosedChannelsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_StreamClosedChannelsClient interface {
+	Recv() (*ChannelCloseSummary, error)
+	grpc.ClientStream
+}
+
+type lightningStreamClosedChannelsClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningStreamClosedChannelsClient) Recv() (*ChannelCloseSummary, error) {
+	m := new(ChannelCloseSummary)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) OpenChannelSync(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (*ChannelPoint, error) {
+	out := new(ChannelPoint)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/OpenChannelSync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) OpenChannel(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (Lightning_OpenChannelClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[5], "/lnrpc.Lightning/OpenChannel", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningOpenChannelClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_OpenChannelClient interface {
+	Recv() (*OpenStatusUpdate, error)
+	grpc.ClientStream
+}
+
+type lightningOpenChannelClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningOpenChannelClient) Recv() (*OpenStatusUpdate, error) {
+	m := new(OpenStatusUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) ChannelAcceptor(ctx context.Context, opts ...grpc.CallOption) (Lightning_ChannelAcceptorClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[6], "/lnrpc.Lightning/ChannelAcceptor", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningChannelAcceptorClient{stream}
+	return x, nil
+}
+
+type Lightning_ChannelAcceptorClient interface {
+	Send(*ChannelAcceptResponse) error
+	Recv() (*ChannelAcceptRequest, error)
+	grpc.ClientStream
+}
+
+type lightningChannelAcceptorClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningChannelAcceptorClient) Send(m *ChannelAcceptResponse) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *lightningChannelAcceptorClient) Recv() (*ChannelAcceptRequest, error) {
+	m := new(ChannelAcceptRequest)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) CloseChannel(ctx context.Context, in *CloseChannelRequest, opts ...grpc.CallOption) (Lightning_CloseChannelClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[7], "/lnrpc.Lightning/CloseChannel", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningCloseChannelClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_CloseChannelClient interface {
+	Recv() (*CloseStatusUpdate, error)
+	grpc.ClientStream
+}
+
+type lightningCloseChannelClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningCloseChannelClient) Recv() (*CloseStatusUpdate, error) {
+	m := new(CloseStatusUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) AbandonChannel(ctx context.Context, in *AbandonChannelRequest, opts ...grpc.CallOption) (*AbandonChannelResponse, error) {
+	out := new(AbandonChannelResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/AbandonChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SendPayment(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendPaymentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[8], "/lnrpc.Lightning/SendPayment", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSendPaymentClient{stream}
+	return x, nil
+}
+
+type Lightning_SendPaymentClient interface {
+	Send(*SendRequest) error
+	Recv() (*SendResponse, error)
+	grpc.ClientStream
+}
+
+type lightningSendPaymentClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSendPaymentClient) Send(m *SendRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *lightningSendPaymentClient) Recv() (*SendResponse, error) {
+	m := new(SendResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) SendPaymentSync(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*SendResponse, error) {
+	out := new(SendResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SendPaymentSync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SendToRoute(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendToRouteClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[9], "/lnrpc.Lightning/SendToRoute", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSendToRouteClient{stream}
+	return x, nil
+}
+
+type Lightning_SendToRouteClient interface {
+	Send(*SendToRouteRequest) error
+	Recv() (*SendResponse, error)
+	grpc.ClientStream
+}
+
+type lightningSendToRouteClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSendToRouteClient) Send(m *SendToRouteRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *lightningSendToRouteClient) Recv() (*SendResponse, error) {
+	m := new(SendResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) SendToRouteSync(ctx context.Context, in *SendToRouteRequest, opts ...grpc.CallOption) (*SendResponse, error) {
+	out := new(SendResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SendToRouteSync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) AddInvoice(ctx context.Context, in *Invoice, opts ...grpc.CallOption) (*AddInvoiceResponse, error) {
+	out := new(AddInvoiceResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/AddInvoice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListInvoices(ctx context.Context, in *ListInvoiceRequest, opts ...grpc.CallOption) (*ListInvoiceResponse, error) {
+	out := new(ListInvoiceResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListInvoices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) LookupInvoice(ctx context.Context, in *PaymentHash, opts ...grpc.CallOption) (*Invoice, error) {
+	out := new(Invoice)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/LookupInvoice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SubscribeInvoices(ctx context.Context, in *InvoiceSubscription, opts ...grpc.CallOption) (Lightning_SubscribeInvoicesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[10], "/lnrpc.Lightning/SubscribeInvoices", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSubscribeInvoicesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_SubscribeInvoicesClient interface {
+	Recv() (*Invoice, error)
+	grpc.ClientStream
+}
+
+type lightningSubscribeInvoicesClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSubscribeInvoicesClient) Recv() (*Invoice, error) {
+	m := new(Invoice)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) DecodePayReq(ctx context.Context, in *PayReqString, opts ...grpc.CallOption) (*PayReq, error) {
+	out := new(PayReq)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/DecodePayReq", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListPayments(ctx context.Context, in *ListPaymentsRequest, opts ...grpc.CallOption) (*ListPaymentsResponse, error) {
+	out := new(ListPaymentsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListPayments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) DeleteAllPayments(ctx context.Context, in *DeleteAllPaymentsRequest, opts ...grpc.CallOption) (*DeleteAllPaymentsResponse, error) {
+	out := new(DeleteAllPaymentsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/DeleteAllPayments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) DescribeGraph(ctx context.Context, in *ChannelGraphRequest, opts ...grpc.CallOption) (*ChannelGraph, error) {
+	out := new(ChannelGraph)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/DescribeGraph", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) GetChanInfo(ctx context.Context, in *ChanInfoRequest, opts ...grpc.CallOption) (*ChannelEdge, error) {
+	out := new(ChannelEdge)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetChanInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) GetNodeInfo(ctx context.Context, in *NodeInfoRequest, opts ...grpc.CallOption) (*NodeInfo, error) {
+	out := new(NodeInfo)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetNodeInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) QueryRoutes(ctx context.Context, in *QueryRoutesRequest, opts ...grpc.CallOption) (*QueryRoutesResponse, error) {
+	out := new(QueryRoutesResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/QueryRoutes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) GetNetworkInfo(ctx context.Context, in *NetworkInfoRequest, opts ...grpc.CallOption) (*NetworkInfo, error) {
+	out := new(NetworkInfo)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetNetworkInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) StopDaemon(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error) {
+	out := new(StopResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/StopDaemon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SubscribeChannelGraph(ctx context.Context, in *GraphTopologySubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelGraphClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[11], "/lnrpc.Lightning/SubscribeChannelGraph", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSubscribeChannelGraphClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_SubscribeChannelGraphClient interface {
+	Recv() (*GraphTopologyUpdate, error)
+	grpc.ClientStream
+}
+
+type lightningSubscribeChannelGraphClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSubscribeChannelGraphClient) Recv() (*GraphTopologyUpdate, error) {
+	m := new(GraphTopologyUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) DebugLevel(ctx context.Context, in *DebugLevelRequest, opts ...grpc.CallOption) (*DebugLevelResponse, error) {
+	out := new(DebugLevelResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/DebugLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) FeeReport(ctx context.Context, in *FeeReportRequest, opts ...grpc.CallOption) (*FeeReportResponse, error) {
+	out := new(FeeReportResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/FeeReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) UpdateChannelPolicy(ctx context.Context, in *PolicyUpdateRequest, opts ...grpc.CallOption) (*PolicyUpdateResponse, error) {
+	out := new(PolicyUpdateResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/UpdateChannelPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ForwardingHistory(ctx context.Context, in *ForwardingHistoryRequest, opts ...grpc.CallOption) (*ForwardingHistoryResponse, error) {
+	out := new(ForwardingHistoryResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ForwardingHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ExportChannelBackup(ctx context.Context, in *ExportChannelBackupRequest, opts ...grpc.CallOption) (*ChannelBackup, error) {
+	out := new(ChannelBackup)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ExportChannelBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ExportAllChannelBackups(ctx context.Context, in *ChanBackupExportRequest, opts ...grpc.CallOption) (*ChanBackupSnapshot, error) {
+	out := new(ChanBackupSnapshot)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ExportAllChannelBackups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) VerifyChanBackup(ctx context.Context, in *ChanBackupSnapshot, opts ...grpc.CallOption) (*VerifyChanBackupResponse, error) {
+	out := new(VerifyChanBackupResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/VerifyChanBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) InspectChanBackup(ctx context.Context, in *ChanBackupSnapshot, opts ...grpc.CallOption) (*InspectChanBackupResponse, error) {
+	out := new(InspectChanBackupResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/InspectChanBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) RestoreChannelBackups(ctx context.Context, in *RestoreChanBackupRequest, opts ...grpc.CallOption) (*RestoreBackupResponse, error) {
+	out := new(RestoreBackupResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/RestoreChannelBackups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SubscribeChannelBackups(ctx context.Context, in *ChannelBackupSubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelBackupsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[12], "/lnrpc.Lightning/SubscribeChannelBackups", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSubscribeChannelBackupsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_SubscribeChannelBackupsClient interface {
+	Recv() (*ChanBackupSnapshot, error)
+	grpc.ClientStream
+}
+
+type lightningSubscribeChannelBackupsClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSubscribeChannelBackupsClient) Recv() (*ChanBackupSnapshot, error) {
+	m := new(ChanBackupSnapshot)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) CreateDatabaseSnapshot(ctx context.Context, in *DatabaseSnapshotRequest, opts ...grpc.CallOption) (*DatabaseSnapshotResponse, error) {
+	out := new(DatabaseSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/CreateDatabaseSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) BatchOpenChannel(ctx context.Context, in *BatchOpenChannelRequest, opts ...grpc.CallOption) (*BatchOpenChannelResponse, error) {
+	out := new(BatchOpenChannelResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/BatchOpenChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) BumpFundingFee(ctx context.Context, in *BumpFundingFeeRequest, opts ...grpc.CallOption) (*BumpFundingFeeResponse, error) {
+	out := new(BumpFundingFeeResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/BumpFundingFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) RebroadcastLimboOutput(ctx context.Context, in *RebroadcastLimboOutputRequest, opts ...grpc.CallOption) (*RebroadcastLimboOutputResponse, error) {
+	out := new(RebroadcastLimboOutputResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/RebroadcastLimboOutput", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SendCustomMessage(ctx context.Context, in *SendCustomMessageRequest, opts ...grpc.CallOption) (*SendCustomMessageResponse, error) {
+	out := new(SendCustomMessageResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SendCustomMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SubscribeCustomMessages(ctx context.Context, in *SubscribeCustomMessagesRequest, opts ...grpc.CallOption) (Lightning_SubscribeCustomMessagesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[13], "/lnrpc.Lightning/SubscribeCustomMessages", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSubscribeCustomMessagesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_SubscribeCustomMessagesClient interface {
+	Recv() (*CustomMessage, error)
+	grpc.ClientStream
+}
+
+type lightningSubscribeCustomMessagesClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSubscribeCustomMessagesClient) Recv() (*CustomMessage, error) {
+	m := new(CustomMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) SubscribePeerEvents(ctx context.Context, in *PeerEventSubscription, opts ...grpc.CallOption) (Lightning_SubscribePeerEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[14], "/lnrpc.Lightning/SubscribePeerEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSubscribePeerEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_SubscribePeerEventsClient interface {
+	Recv() (*PeerEvent, error)
+	grpc.ClientStream
+}
+
+type lightningSubscribePeerEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSubscribePeerEventsClient) Recv() (*PeerEvent, error) {
+	m := new(PeerEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) BakeMacaroon(ctx context.Context, in *BakeMacaroonRequest, opts ...grpc.CallOption) (*BakeMacaroonResponse, error) {
+	out := new(BakeMacaroonResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/BakeMacaroon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListMacaroonIDs(ctx context.Context, in *ListMacaroonIDsRequest, opts ...grpc.CallOption) (*ListMacaroonIDsResponse, error) {
+	out := new(ListMacaroonIDsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListMacaroonIDs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) DeleteMacaroonID(ctx context.Context, in *DeleteMacaroonIDRequest, opts ...grpc.CallOption) (*DeleteMacaroonIDResponse, error) {
+	out := new(DeleteMacaroonIDResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/DeleteMacaroonID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListPermissions(ctx context.Context, in *ListPermissionsRequest, opts ...grpc.CallOption) (*ListPermissionsResponse, error) {
+	out := new(ListPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) RegisterRPCMiddleware(ctx context.Context, opts ...grpc.CallOption) (Lightning_RegisterRPCMiddlewareClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[15], "/lnrpc.Lightning/RegisterRPCMiddleware", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningRegisterRPCMiddlewareClient{stream}
+	return x, nil
+}
+
+type Lightning_RegisterRPCMiddlewareClient interface {
+	Send(*RPCMiddlewareResponse) error
+	Recv() (*RPCMiddlewareRequest, error)
+	grpc.ClientStream
+}
+
+type lightningRegisterRPCMiddlewareClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningRegisterRPCMiddlewareClient) Send(m *RPCMiddlewareResponse) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *lightningRegisterRPCMiddlewareClient) Recv() (*RPCMiddlewareRequest, error) {
+	m := new(RPCMiddlewareRequest)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) SetColdSweep(ctx context.Context, in *SetColdSweepRequest, opts ...grpc.CallOption) (*SetColdSweepResponse, error) {
+	out := new(SetColdSweepResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SetColdSweep", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SubscribeColdSweeps(ctx context.Context, in *ColdSweepSubscription, opts ...grpc.CallOption) (Lightning_SubscribeColdSweepsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[16], "/lnrpc.Lightning/SubscribeColdSweeps", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSubscribeColdSweepsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_SubscribeColdSweepsClient interface {
+	Recv() (*ColdSweepEvent, error)
+	grpc.ClientStream
+}
+
+type lightningSubscribeColdSweepsClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSubscribeColdSweepsClient) Recv() (*ColdSweepEvent, error) {
+	m := new(ColdSweepEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) InvoiceURI(ctx context.Context, in *InvoiceURIRequest, opts ...grpc.CallOption) (*InvoiceURIResponse, error) {
+	out := new(InvoiceURIResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/InvoiceURI", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) LegacyPayloadStats(ctx context.Context, in *LegacyPayloadStatsRequest, opts ...grpc.CallOption) (*LegacyPayloadStatsResponse, error) {
+	out := new(LegacyPayloadStatsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/LegacyPayloadStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) PushGossip(ctx context.Context, in *PushGossipRequest, opts ...grpc.CallOption) (*PushGossipResponse, error) {
+	out := new(PushGossipResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/PushGossip", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) FeeManagerReport(ctx context.Context, in *FeeManagerReportRequest, opts ...grpc.CallOption) (*FeeManagerReportResponse, error) {
+	out := new(FeeManagerReportResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/FeeManagerReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) EffectiveFeeRates(ctx context.Context, in *EffectiveFeeRatesRequest, opts ...grpc.CallOption) (*EffectiveFeeRatesResponse, error) {
+	out := new(EffectiveFeeRatesResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/EffectiveFeeRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) GetDebugInfo(ctx context.Context, in *GetDebugInfoRequest, opts ...grpc.CallOption) (*GetDebugInfoResponse, error) {
+	out := new(GetDebugInfoResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetDebugInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) CheckMacaroonPermissions(ctx context.Context, in *CheckMacaroonPermissionsRequest, opts ...grpc.CallOption) (*CheckMacaroonPermissionsResponse, error) {
+	out := new(CheckMacaroonPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/CheckMacaroonPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) WarpClock(ctx context.Context, in *WarpClockRequest, opts ...grpc.CallOption) (*WarpClockResponse, error) {
+	out := new(WarpClockResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/WarpClock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ExportPaymentReceipt(ctx context.Context, in *ExportPaymentReceiptRequest, opts ...grpc.CallOption) (*PaymentReceipt, error) {
+	out := new(PaymentReceipt)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ExportPaymentReceipt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) VerifyPaymentReceipt(ctx context.Context, in *VerifyPaymentReceiptRequest, opts ...grpc.CallOption) (*VerifyPaymentReceiptResponse, error) {
+	out := new(VerifyPaymentReceiptResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/VerifyPaymentReceipt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ChannelRecoveryStatus(ctx context.Context, in *ChannelRecoveryStatusRequest, opts ...grpc.CallOption) (*ChannelRecoveryStatusResponse, error) {
+	out := new(ChannelRecoveryStatusResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ChannelRecoveryStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LightningServer is the server API for Lightning service.
+type LightningServer interface {
+	// * lncli: `walletbalance`
+	// WalletBalance returns total unspent outputs(confirmed and unconfirmed), all
+	// confirmed unspent outputs and all unconfirmed unspent outputs under control
+	// of the wallet.
+	WalletBalance(context.Context, *WalletBalanceRequest) (*WalletBalanceResponse, error)
+	// * lncli: `channelbalance`
+	// ChannelBalance returns the total funds available across all open channels
+	// in atoms.
+	ChannelBalance(context.Context, *ChannelBalanceRequest) (*ChannelBalanceResponse, error)
+	// * lncli: `listchaintxns`
+	// GetTransactions returns a list describing all the known transactions
+	// relevant to the wallet.
+	GetTransactions(context.Context, *GetTransactionsRequest) (*TransactionDetails, error)
+	// * lncli: `estimatefee`
+	// EstimateFee asks the chain backend to estimate the fee rate and total fees
+	// for a transaction that pays to multiple specified outputs.
+	EstimateFee(context.Context, *EstimateFeeRequest) (*EstimateFeeResponse, error)
+	// * lncli: `sendcoins`
+	// SendCoins executes a request to send coins to a particular address. Unlike
+	// SendMany, this RPC call only allows creating a single output at a time. If
+	// neither target_conf, or atoms_per_byte are set, then the internal wallet will
+	// consult its fee model to determine a fee for the default confirmation
+	// target.
+	SendCoins(context.Context, *SendCoinsRequest) (*SendCoinsResponse, error)
+	// * lncli: `listunspent`
+	// ListUnspent returns a list of all utxos spendable by the wallet with a
+	// number of confirmations between the specified minimum and maximum.
+	ListUnspent(context.Context, *ListUnspentRequest) (*ListUnspentResponse, error)
+	// *
+	// SubscribeTransactions creates a uni-directional stream from the server to
+	// the client in which any newly discovered transactions relevant to the
+	// wallet are sent over. The filters of the request are applied server-side,
+	// so that only the matching transactions are sent.
+	SubscribeTransactions(*GetTransactionsRequest, Lightning_SubscribeTransactionsServer) error
+	// * lncli: `sendmany`
+	// SendMany handles a request for a transaction that creates multiple specified
+	// outputs in parallel. If neither target_conf, or atoms_per_byte are set, then
+	// the internal wallet will consult its fee model to determine a fee for the
+	// default confirmation target.
+	SendMany(context.Context, *SendManyRequest) (*SendManyResponse, error)
+	// * lncli: `newaddress`
+	// NewAddress creates a new address under control of the local wallet.
+	NewAddress(context.Context, *NewAddressRequest) (*NewAddressResponse, error)
+	// * lncli: `signmessage`
+	// SignMessage signs a message with this node's private key. The returned
+	// signature string is `zbase32` encoded and pubkey recoverable, meaning that
+	// only the message digest and signature are needed for verification.
+	SignMessage(context.Context, *SignMessageRequest) (*SignMessageResponse, error)
+	// * lncli: `verifymessage`
+	// VerifyMessage verifies a signature over a msg. The signature must be
+	// zbase32 encoded and signed by an active node in the resident node's
+	// channel database. In addition to returning the validity of the signature,
+	// VerifyMessage also returns the recovered pubkey from the signature.
+	VerifyMessage(context.Context, *VerifyMessageRequest) (*VerifyMessageResponse, error)
+	// * lncli: `connect`
+	// ConnectPeer attempts to establish a connection to a remote peer. This is at
+	// the networking level, and is used for communication between nodes. This is
+	// distinct from establishing a channel with a peer.
+	ConnectPeer(context.Context, *ConnectPeerRequest) (*ConnectPeerResponse, error)
+	// * lncli: `disconnect`
+	// DisconnectPeer attempts to disconnect one peer from another identified by a
+	// given pubKey. In the case that we currently have a pending or active channel
+	// with the target peer, then this action will be not be allowed.
+	DisconnectPeer(context.Context, *DisconnectPeerRequest) (*DisconnectPeerResponse, error)
+	// * lncli: `setpeerpolicy`
+	// SetPeerConnPolicy sets the connection policy of a peer, which is persisted
+	// across restarts. It determines whether the connection to the peer is
+	// re-established once lost, overriding the default persistent peer
+	// behavior, and allows custom bounds for the reconnection backoff. Setting
+	// the default policy without custom backoffs removes the peer's policy.
+	SetPeerConnPolicy(context.Context, *SetPeerConnPolicyRequest) (*SetPeerConnPolicyResponse, error)
+	// * lncli: `listpeers`
+	// ListPeers returns a verbose listing of all currently active peers.
+	ListPeers(context.Context, *ListPeersRequest) (*ListPeersResponse, error)
+	// * lncli: `listgossipsyncers`
+	// ListGossipSyncers returns the state of the gossip syncer of each currently
+	// connected peer, along with the gossip bandwidth budget shared by all of
+	// them.
+	ListGossipSyncers(context.Context, *ListGossipSyncersRequest) (*ListGossipSyncersResponse, error)
+	// * lncli: `graphsyncstatus`
+	// GetGraphSyncStatus returns the progress of the initial historical sync of
+	// the channel graph, which is performed with one of our peers upon startup.
+	GetGraphSyncStatus(context.Context, *GraphSyncStatusRequest) (*GraphSyncStatus, error)
+	// *
+	// SubscribeGraphSyncStatus returns a uni-directional stream (server -> client)
+	// of the progress of the initial historical sync of the channel graph. The
+	// current status is sent immediately, followed by a new one every time the
+	// sync starts or completes.
+	SubscribeGraphSyncStatus(*GraphSyncSubscription, Lightning_SubscribeGraphSyncStatusServer) error
+	// * lncli: `getinfo`
+	// GetInfo returns general information concerning the lightning node including
+	// it's identity pubkey, alias, the chains it is connected to, and information
+	// concerning the number of open+pending channels.
+	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
+	// * lncli: `pendingchannels`
+	// PendingChannels returns a list of all the channels that are currently
+	// considered "pending". A channel is pending if it has finished the funding
+	// workflow and is waiting for confirmations for the funding txn, or is in the
+	// process of closure, either initiated cooperatively or non-cooperatively.
+	PendingChannels(context.Context, *PendingChannelsRequest) (*PendingChannelsResponse, error)
+	// * lncli: `listarbitrators`
+	// ListArbitrators returns the state of the arbitrators watching the channels
+	// on-chain, along with the progress of the resolvers claiming the outputs of
+	// the channels that were force closed: which outputs are being claimed, what
+	// each resolver is waiting for and the transactions claiming the outputs.
+	ListArbitrators(context.Context, *ListArbitratorsRequest) (*ListArbitratorsResponse, error)
+	// * lncli: `pendingchannellimits`
+	// PendingChannelLimits returns the limits on the number of pending channels
+	// enforced when accepting new channels, along with the current number of
+	// pending channels per peer and across all peers.
+	PendingChannelLimits(context.Context, *PendingChannelLimitsRequest) (*PendingChannelLimitsResponse, error)
+	// * lncli: `listchannels`
+	// ListChannels returns a description of all the open channels that this node
+	// is a participant in.
+	ListChannels(context.Context, *ListChannelsRequest) (*ListChannelsResponse, error)
+	// *
+	// SubscribeChannelEvents creates a uni-directional stream from the server to
+	// the client in which any updates relevant to the state of the channels are
+	// sent over. Events include new active channels, inactive channels, and closed
+	// channels.
+	SubscribeChannelEvents(*ChannelEventSubscription, Lightning_SubscribeChannelEventsServer) error
+	// * lncli: `closedchannels`
+	// ClosedChannels returns a description of all the closed channels that
+	// this node was a participant in.
+	ClosedChannels(context.Context, *ClosedChannelsRequest) (*ClosedChannelsResponse, error)
+	// *
+	// StreamChannels is a streaming variant of ListChannels. It sends the open
+	// channels matching the request one at a time rather than in a single
+	// response, so that it can be used by nodes with more channels than fit in
+	// a single message.
+	StreamChannels(*ListChannelsRequest, Lightning_StreamChannelsServer) error
+	// *
+	// StreamClosedChannels is a streaming variant of ClosedChannels. It sends
+	// the closed channels matching the request one at a time rather than in a
+	// single response.
+	StreamClosedChannels(*ClosedChannelsRequest, Lightning_StreamClosedChannelsServer) error
+	// *
+	// OpenChannelSync is a synchronous version of the OpenChannel RPC call. This
+	// call is meant to be consumed by clients to the REST proxy. As with all
+	// other sync calls, all byte slices are intended to be populated as hex
+	// encoded strings.
+	OpenChannelSync(context.Context, *OpenChannelRequest) (*ChannelPoint, error)
+	// * lncli: `openchannel`
+	// OpenChannel attempts to open a singly funded channel specified in the
+	// request to a remote peer. Users are able to specify a target number of
+	// blocks that the funding transaction should be confirmed in, or a manual fee
+	// rate to us for the funding transaction. If neither are specified, then a
+	// lax block confirmation target is used.
+	OpenChannel(*OpenChannelRequest, Lightning_OpenChannelServer) error
+	// *
+	// ChannelAcceptor dispatches a bi-directional streaming RPC in which
+	// OpenChannel requests are sent to the client and the client responds with
+	// a boolean that tells LND whether or not to accept the channel. This allows
+	// node operators to specify their own criteria for accepting inbound channels
+	// through a single persistent connection. Requests that aren't responded to
+	// within the acceptor timeout are accepted or rejected according to the
+	// fallback policy of the node.
+	ChannelAcceptor(Lightning_ChannelAcceptorServer) error
+	// * lncli: `closechannel`
+	// CloseChannel attempts to close an active channel identified by its channel
+	// outpoint (ChannelPoint). The actions of this method can additionally be
+	// augmented to attempt a force close after a timeout period in the case of an
+	// inactive peer. If a non-force close (cooperative closure) is requested,
+	// then the user can specify either a target number of blocks until the
+	// closure transaction is confirmed, or a manual fee rate. If neither are
+	// specified, then a default lax, block confirmation target is used.
+	// When force closing a channel with anchors, the same fee parameters
+	// determine the fee of the anchor sweep, which bumps the fee of the
+	// commitment through CPFP.
+	CloseChannel(*CloseChannelRequest, Lightning_CloseChannelServer) error
+	// * lncli: `abandonchannel`
+	// AbandonChannel removes all channel state from the database except for a
+	// close summary, without any on-chain action. This method can be used to get
+	// rid of permanently unusable channels due to bugs fixed in newer versions of
+	// lnd, or of pending channels whose funding transaction will never confirm.
+	// Only available in debug builds of lnd, unless i_know_what_i_am_doing is
+	// set.
+	AbandonChannel(context.Context, *AbandonChannelRequest) (*AbandonChannelResponse, error)
+	// * lncli: `sendpayment`
+	// SendPayment dispatches a bi-directional streaming RPC for sending payments
+	// through the Lightning Network. A single RPC invocation creates a persistent
+	// bi-directional stream allowing clients to rapidly send payments through the
+	// Lightning Network with a single persistent connection.
+	SendPayment(Lightning_SendPaymentServer) error
+	// *
+	// SendPaymentSync is the synchronous non-streaming version of SendPayment.
+	// This RPC is intended to be consumed by clients of the REST proxy.
+	// Additionally, this RPC expects the destination's public key and the payment
+	// hash (if any) to be encoded as hex strings.
+	SendPaymentSync(context.Context, *SendRequest) (*SendResponse, error)
+	// * lncli: `sendtoroute`
+	// SendToRoute is a bi-directional streaming RPC for sending payment through
+	// the Lightning Network. This method differs from SendPayment in that it
+	// allows users to specify a full route manually. This can be used for things
+	// like rebalancing, and atomic swaps.
+	SendToRoute(Lightning_SendToRouteServer) error
+	// *
+	// SendToRouteSync is a synchronous version of SendToRoute. It Will block
+	// until the payment either fails or succeeds.
+	SendToRouteSync(context.Context, *SendToRouteRequest) (*SendResponse, error)
+	// * lncli: `addinvoice`
+	// AddInvoice attempts to add a new invoice to the invoice database. Any
+	// duplicated invoices are rejected, therefore all invoices *must* have a
+	// unique payment preimage.
+	AddInvoice(context.Context, *Invoice) (*AddInvoiceResponse, error)
+	// * lncli: `listinvoices`
+	// ListInvoices returns a list of all the invoices currently stored within the
+	// database. Any active debug invoices are ignored. It has full support for
+	// paginated responses, allowing users to query for specific invoices through
+	// their add_index. This can be done by using either the first_index_offset or
+	// last_index_offset fields included in the response as the index_offset of the
+	// next request. By default, the first 100 invoices created will be returned.
+	// Backwards pagination is also supported through the Reversed flag.
+	ListInvoices(context.Context, *ListInvoiceRequest) (*ListInvoiceResponse, error)
+	// * lncli: `lookupinvoice`
+	// LookupInvoice attempts to look up an invoice according to its payment hash
+	// or payment address. The passed payment hash or address *must* be exactly
+	// 32 bytes, if not, an error is returned.
+	LookupInvoice(context.Context, *PaymentHash) (*Invoice, error)
+	// *
+	// SubscribeInvoices returns a uni-directional stream (server -> client) for
+	// notifying the client of newly added/settled invoices. The caller can
+	// optionally specify the add_index and/or the settle_index. If the add_index
+	// is specified, then we'll first start by sending add invoice events for all
+	// invoices with an add_index greater than the specified value.  If the
+	// settle_index is specified, the next, we'll send out all settle events for
+	// invoices with a settle_index greater than the specified value.  One or both
+	// of these fields can be set. If no fields are set, then we'll only send out
+	// the latest add/settle events.
+	SubscribeInvoices(*InvoiceSubscription, Lightning_SubscribeInvoicesServer) error
+	// * lncli: `decodepayreq`
+	// DecodePayReq takes an encoded payment request string and attempts to decode
+	// it, returning a full description of the conditions encoded within the
+	// payment request.
+	DecodePayReq(context.Context, *PayReqString) (*PayReq, error)
+	// * lncli: `listpayments`
+	// ListPayments returns a list of all outgoing payments.
+	ListPayments(context.Context, *ListPaymentsRequest) (*ListPaymentsResponse, error)
+	// *
+	// DeleteAllPayments deletes all outgoing payments from DB.
+	DeleteAllPayments(context.Context, *DeleteAllPaymentsRequest) (*DeleteAllPaymentsResponse, error)
+	// * lncli: `describegraph`
+	// DescribeGraph returns a description of the latest graph state from the
+	// point of view of the node. The graph information is partitioned into two
+	// components: all the nodes/vertexes, and all the edges that connect the
+	// vertexes themselves.  As this is a directed graph, the edges also contain
+	// the node directional specific routing policy which includes: the time lock
+	// delta, fee information, etc.
+	DescribeGraph(context.Context, *ChannelGraphRequest) (*ChannelGraph, error)
+	// * lncli: `getchaninfo`
+	// GetChanInfo returns the latest authenticated network announcement for the
+	// given channel identified by its channel ID: an 8-byte integer which
+	// uniquely identifies the location of transaction's funding output within the
+	// blockchain.
+	GetChanInfo(context.Context, *ChanInfoRequest) (*ChannelEdge, error)
+	// * lncli: `getnodeinfo`
+	// GetNodeInfo returns the latest advertised, aggregated, and authenticated
+	// channel information for the specified node identified by its public key.
+	GetNodeInfo(context.Context, *NodeInfoRequest) (*NodeInfo, error)
+	// * lncli: `queryroutes`
+	// QueryRoutes attempts to query the daemon's Channel Router for a possible
+	// route to a target destination capable of carrying a specific amount of
+	// atoms. The retuned route contains the full details required to craft and
+	// send an HTLC, also including the necessary information that should be
+	// present within the Sphinx packet encapsulated within the HTLC.
+	QueryRoutes(context.Context, *QueryRoutesRequest) (*QueryRoutesResponse, error)
+	// * lncli: `getnetworkinfo`
+	// GetNetworkInfo returns some basic stats about the known channel graph from
+	// the point of view of the node.
+	GetNetworkInfo(context.Context, *NetworkInfoRequest) (*NetworkInfo, error)
+	// * lncli: `stop`
+	// StopDaemon will send a shutdown request to the interrupt handler, triggering
+	// a graceful shutdown of the daemon.
+	StopDaemon(context.Context, *StopRequest) (*StopResponse, error)
+	// *
+	// SubscribeChannelGraph launches a streaming RPC that allows the caller to
+	// receive notifications upon any changes to the channel graph topology from
+	// the point of view of the responding node. Events notified include: new
+	// nodes coming online, nodes updating their authenticated attributes, new
+	// channels being advertised, updates in the routing policy for a directional
+	// channel edge, and when channels are closed on-chain.
+	SubscribeChannelGraph(*GraphTopologySubscription, Lightning_SubscribeChannelGraphServer) error
+	// * lncli: `debuglevel`
+	// DebugLevel allows a caller to programmatically set the logging verbosity of
+	// lnd. The logging can be targeted according to a coarse daemon-wide logging
+	// level, or in a granular fashion to specify the logging for a target
+	// sub-system.
+	DebugLevel(context.Context, *DebugLevelRequest) (*DebugLevelResponse, error)
+	// * lncli: `feereport`
+	// FeeReport allows the caller to obtain a report detailing the current fee
+	// schedule enforced by the node globally for each channel.
+	FeeReport(context.Context, *FeeReportRequest) (*FeeReportResponse, error)
+	// * lncli: `updatechanpolicy`
+	// UpdateChannelPolicy allows the caller to update the fee schedule and
+	// channel policies for all channels globally, or a particular channel.
+	UpdateChannelPolicy(context.Context, *PolicyUpdateRequest) (*PolicyUpdateResponse, error)
+	// * lncli: `fwdinghistory`
+	// ForwardingHistory allows the caller to query the htlcswitch for a record of
+	// all HTLCs forwarded within the target time range, and integer offset
+	// within that time range. If no time-range is specified, then the first chunk
+	// of the past 24 hrs of forwarding history are returned.
+	//
+	// A list of forwarding events are returned. The size of each forwarding event
+	// is 40 bytes, and the max message size able to be returned in gRPC is 4 MiB.
+	// As a result each message can only contain 50k entries.  Each response has
+	// the index offset of the last entry. The index offset can be provided to the
+	// request to allow the caller to skip a series of records.
+	ForwardingHistory(context.Context, *ForwardingHistoryRequest) (*ForwardingHistoryResponse, error)
+	// * lncli: `exportchanbackup`
+	// ExportChannelBackup attempts to return an encrypted static channel backup
+	// for the target channel identified by it channel point. The backup is
+	// encrypted with a key generated from the aezeed seed of the user. The
+	// returned backup can either be restored using the RestoreChannelBackup
+	// method once lnd is running, or via the InitWallet and UnlockWallet methods
+	// from the WalletUnlocker service.
+	ExportChannelBackup(context.Context, *ExportChannelBackupRequest) (*ChannelBackup, error)
+	// *
+	// ExportAllChannelBackups returns static channel backups for all existing
+	// channels known to lnd. A set of regular singular static channel backups for
+	// each channel are returned. Additionally, a multi-channel backup is returned
+	// as well, which contains a single encrypted blob containing the backups of
+	// each channel.
+	ExportAllChannelBackups(context.Context, *ChanBackupExportRequest) (*ChanBackupSnapshot, error)
+	// *
+	// VerifyChanBackup allows a caller to verify the integrity of a channel backup
+	// snapshot. This method will accept either a packed Single or a packed Multi.
+	// Specifying both will result in an error.
+	VerifyChanBackup(context.Context, *ChanBackupSnapshot) (*VerifyChanBackupResponse, error)
+	// * lncli: `inspectchanbackup`
+	// InspectChanBackup decrypts the given channel backup snapshot and returns the
+	// channels it contains, without restoring any of them. This method will
+	// accept either a set of packed Singles or a packed Multi. Specifying both
+	// will result in an error.
+	InspectChanBackup(context.Context, *ChanBackupSnapshot) (*InspectChanBackupResponse, error)
+	// * lncli: `restorechanbackup`
+	// RestoreChannelBackups accepts a set of singular channel backups, or a
+	// single encrypted multi-chan backup and attempts to recover any funds
+	// remaining within the channel. If we are able to unpack the backup, then the
+	// new channel will be shown under listchannels, as well as pending channels.
+	// If dry_run is set, the backup is only decrypted and the channel peers are
+	// checked for reachability, without restoring any channels.
+	RestoreChannelBackups(context.Context, *RestoreChanBackupRequest) (*RestoreBackupResponse, error)
+	// *
+	// SubscribeChannelBackups allows a client to sub-subscribe to the most up to
+	// date information concerning the state of all channel backups. Each time a
+	// new channel is added, we return the new set of channels, along with a
+	// multi-chan backup containing the backup info for all channels. Each time a
+	// channel is closed, we send a new update, which contains new new chan back
+	// ups, but the updated set of encrypted multi-chan backups with the closed
+	// channel(s) removed.
+	SubscribeChannelBackups(*ChannelBackupSubscription, Lightning_SubscribeChannelBackupsServer) error
+	// * lncli: `snapshotdb`
+	// CreateDatabaseSnapshot writes a hot backup of the channel database, the
+	// watchtower client database and the sphinx replay log to a new directory
+	// within the data directory of the node. The databases are copied within
+	// read transactions started in that order, so that the replay log and the
+	// watchtower client database restored along with the channel database are
+	// at least as recent as it. The snapshot is only complete once its manifest,
+	// written last, exists.
+	CreateDatabaseSnapshot(context.Context, *DatabaseSnapshotRequest) (*DatabaseSnapshotResponse, error)
+	// * lncli: `batchopenchannel`
+	// BatchOpenChannel attempts to open multiple singly funded channels to
+	// several remote peers within a single funding transaction. The funding
+	// transaction is only broadcast once the funding flow of every channel in
+	// the batch succeeded. If any of the channels fails, the whole batch is
+	// aborted.
+	BatchOpenChannel(context.Context, *BatchOpenChannelRequest) (*BatchOpenChannelResponse, error)
+	// * lncli: `bumpfundingfee`
+	// BumpFundingFee bumps the fee of the unconfirmed funding transaction of a
+	// pending channel we initiated, for example because it's stuck below the
+	// fee rate required to be relayed. As the funding transaction can't be
+	// replaced without invalidating the commitment transactions signed by the
+	// peer, its fee is bumped by sweeping one of its outputs controlled by the
+	// wallet, usually its change, with a higher fee rate (Child-Pays-For-Parent).
+	// The peer doesn't need to be online for this.
+	BumpFundingFee(context.Context, *BumpFundingFeeRequest) (*BumpFundingFeeResponse, error)
+	// * lncli: `rebroadcastlimbooutput`
+	// RebroadcastLimboOutput immediately re-broadcasts the transaction sweeping
+	// an output of a force closed channel incubated by the nursery, for example
+	// because it's stuck below the fee rate required to be relayed. An expired
+	// htlc output is swept by its pre-signed timeout transaction, which is
+	// published again as is since its fee can't be changed. The other outputs
+	// whose time lock expired are swept again at the given fee rate.
+	RebroadcastLimboOutput(context.Context, *RebroadcastLimboOutputRequest) (*RebroadcastLimboOutputResponse, error)
+	// * lncli: `sendcustom`
+	// SendCustomMessage sends a custom message of a type within the custom range
+	// to a connected peer. This allows applications to exchange messages of
+	// their own protocols with peers over the existing transport.
+	SendCustomMessage(context.Context, *SendCustomMessageRequest) (*SendCustomMessageResponse, error)
+	// * lncli: `subscribecustom`
+	// SubscribeCustomMessages creates a uni-directional stream from the server to
+	// the client in which the custom messages received from peers are sent over.
+	SubscribeCustomMessages(*SubscribeCustomMessagesRequest, Lightning_SubscribeCustomMessagesServer) error
+	// * lncli: `subscribepeerevents`
+	// SubscribePeerEvents creates a uni-directional stream from the server to
+	// the client in which any events relevant to the state of peers are sent
+	// over. Events include peers connecting, completing the exchange of init
+	// messages and disconnecting, along with the reason of the disconnection.
+	SubscribePeerEvents(*PeerEventSubscription, Lightning_SubscribePeerEventsServer) error
+	// * lncli: `bakemacaroon`
+	// BakeMacaroon allows the creation of a new macaroon with custom read and
+	// write permissions. No first-party caveats are added since this can be done
+	// offline. The macaroon is baked with the root key of the given ID, which is
+	// created if it doesn't exist yet, so that it can be revoked independently
+	// of the other macaroons.
+	BakeMacaroon(context.Context, *BakeMacaroonRequest) (*BakeMacaroonResponse, error)
+	// * lncli: `listmacaroonids`
+	// ListMacaroonIDs returns the IDs of all the root keys in use by macaroons.
+	ListMacaroonIDs(context.Context, *ListMacaroonIDsRequest) (*ListMacaroonIDsResponse, error)
+	// * lncli: `deletemacaroonid`
+	// DeleteMacaroonID deletes the root key of the given ID, which revokes every
+	// macaroon baked with it. The default root key, used by the macaroons
+	// created on startup, can't be deleted.
+	DeleteMacaroonID(context.Context, *DeleteMacaroonIDRequest) (*DeleteMacaroonIDResponse, error)
+	// * lncli: `listpermissions`
+	// ListPermissions lists all RPC method URIs and the macaroon permissions
+	// they require to be invoked.
+	ListPermissions(context.Context, *ListPermissionsRequest) (*ListPermissionsResponse, error)
+	// *
+	// RegisterRPCMiddleware adds a new gRPC middleware to the interceptor chain.
+	// A middleware is an external authorizer enforcing the custom caveats of a
+	// given name: every request carrying a macaroon with such a caveat is
+	// forwarded to it, and only processed once the middleware accepts it. The
+	// middleware may rewrite the request, and the response of the method is
+	// forwarded to it in the same way. Requests carrying custom caveats no
+	// middleware is registered for are rejected. A middleware registered in
+	// read-only mode only observes the calls, optionally all of them. The first
+	// message sent by the middleware must be its registration.
+	RegisterRPCMiddleware(Lightning_RegisterRPCMiddlewareServer) error
+	// * lncli: `setcoldsweep`
+	// SetColdSweep enables or disables the scheduled sweeps of the confirmed
+	// balance of the wallet above a threshold to the configured cold storage
+	// address.
+	SetColdSweep(context.Context, *SetColdSweepRequest) (*SetColdSweepResponse, error)
+	// * lncli: `subscribecoldsweeps`
+	// SubscribeColdSweeps creates a uni-directional stream from the server to
+	// the client in which the events of the scheduled sweeps to cold storage are
+	// sent over. Events include published and failed sweeps, and the sweeps
+	// being enabled or disabled.
+	SubscribeColdSweeps(*ColdSweepSubscription, Lightning_SubscribeColdSweepsServer) error
+	// * lncli: `invoiceuri`
+	// InvoiceURI looks up an invoice according to its payment hash and returns
+	// it alongside its canonical payment URI, carrying its amount, label and
+	// fallback address, and the upper case form of the URI best suited for QR
+	// codes. Generating the URIs server-side ensures that all clients present the
+	// same URIs.
+	InvoiceURI(context.Context, *InvoiceURIRequest) (*InvoiceURIResponse, error)
+	// * lncli: `legacypayloadstats`
+	// LegacyPayloadStats returns the number of HTLCs carrying a legacy, non-TLV,
+	// onion payload forwarded, received and rejected by the node since it
+	// started, along with the configured deadlines after which these HTLCs are
+	// rejected.
+	LegacyPayloadStats(context.Context, *LegacyPayloadStatsRequest) (*LegacyPayloadStatsResponse, error)
+	// * lncli: `pushgossip`
+	// PushGossip sends our node announcement and the announcements and latest
+	// updates of the selected channels of ours directly to a connected peer,
+	// regardless of its gossip syncing state. Only the updates of private
+	// channels are sent, allowing a directly connected peer to learn our private
+	// routing info.
+	PushGossip(context.Context, *PushGossipRequest) (*PushGossipResponse, error)
+	// * lncli: `feemanagerreport`
+	// FeeManagerReport returns the state of the fee manager, which periodically
+	// adjusts the fee rates of our channels based on their liquidity and
+	// forwarding demand, along with its most recent fee rate adjustments.
+	FeeManagerReport(context.Context, *FeeManagerReportRequest) (*FeeManagerReportResponse, error)
+	// * lncli: `effectivefeerates`
+	// EffectiveFeeRates returns the confirmation target configured for each of
+	// the subsystems paying on-chain fees, along with the fee rate it currently
+	// resolves to.
+	EffectiveFeeRates(context.Context, *EffectiveFeeRatesRequest) (*EffectiveFeeRatesResponse, error)
+	// * lncli: `getdebuginfo`
+	// GetDebugInfo returns a snapshot of the state of the node meant to be
+	// attached to bug reports: the active configuration with its sensitive
+	// options redacted, runtime stats, the size of the database buckets and the
+	// number of operations pending in the main subsystems.
+	GetDebugInfo(context.Context, *GetDebugInfoRequest) (*GetDebugInfoResponse, error)
+	// *
+	// CheckMacaroonPermissions checks whether the given macaroon grants the
+	// required permissions, and returns its caveats. It allows external services,
+	// such as reverse proxies, to authorize requests with the macaroons of the
+	// node without embedding the verification logic. An error is returned if the
+	// macaroon is invalid or doesn't grant the permissions.
+	CheckMacaroonPermissions(context.Context, *CheckMacaroonPermissionsRequest) (*CheckMacaroonPermissionsResponse, error)
+	// * lncli: `warpclock`
+	// WarpClock fast-forwards the clock of the daemon by the given duration,
+	// firing the timers that become due, such as the ones expiring invoices. It
+	// is only available on regtest and simnet, for integration testing.
+	WarpClock(context.Context, *WarpClockRequest) (*WarpClockResponse, error)
+	// * lncli: `exportreceipt`
+	// ExportPaymentReceipt returns a receipt for a settled payment, signed by the
+	// node. The receipt ties the preimage of the payment to the payment request
+	// it paid and to a summary of its routes, so that the settlement can be
+	// proven to third parties.
+	ExportPaymentReceipt(context.Context, *ExportPaymentReceiptRequest) (*PaymentReceipt, error)
+	// * lncli: `verifyreceipt`
+	// VerifyPaymentReceipt checks that a payment receipt is signed by the node
+	// that made the payment and that its content is consistent, and returns the
+	// content of the receipt.
+	VerifyPaymentReceipt(context.Context, *VerifyPaymentReceiptRequest) (*VerifyPaymentReceiptResponse, error)
+	// * lncli: `recoverystatus`
+	// ChannelRecoveryStatus returns the progress of the recovery of the channels
+	// restored from static channel backups, along with the status of the recovery
+	// peers asked to force close the channels missing from the backups.
+	ChannelRecoveryStatus(context.Context, *ChannelRecoveryStatusRequest) (*ChannelRecoveryStatusResponse, error)
+}
+
+func RegisterLightningServer(s *grpc.Server, srv LightningServer) {
+	s.RegisterService(&_Lightning_serviceDesc, srv)
+}
+
+func _Lightning_WalletBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WalletBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).WalletBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/WalletBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).WalletBalance(ctx, req.(*WalletBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ChannelBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ChannelBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ChannelBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ChannelBalance(ctx, req.(*ChannelBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_GetTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).GetTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/GetTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).GetTransactions(ctx, req.(*GetTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_EstimateFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).EstimateFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/EstimateFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).EstimateFee(ctx, req.(*EstimateFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_SendCoins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendCoinsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SendCoins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SendCoins",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SendCoins(ctx, req.(*SendCoinsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ListUnspent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUnspentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListUnspent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListUnspent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListUnspent(ctx, req.(*ListUnspentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_SubscribeTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetTransactionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).SubscribeTransactions(m, &lightningSubscribeTransactionsServer{stream})
+}
+
+type Lightning_SubscribeTransactionsServer interface {
+	Send(*Transaction) error
+	grpc.ServerStream
+}
+
+type lightningSubscribeTransactionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningSubscribeTransactionsServer) Send(m *Transaction) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Lightning_SendMany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendManyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SendMany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SendMany",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SendMany(ctx, req.(*SendManyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_NewAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).NewAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/NewAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).NewAddress(ctx, req.(*NewAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_SignMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SignMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SignMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SignMessage(ctx, req.(*SignMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_VerifyMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).VerifyMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/VerifyMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).VerifyMessage(ctx, req.(*VerifyMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ConnectPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ConnectPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ConnectPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ConnectPeer(ctx, req.(*ConnectPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_DisconnectPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisconnectPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).DisconnectPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/DisconnectPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).DisconnectPeer(ctx, req.(*DisconnectPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_SetPeerConnPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPeerConnPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SetPeerConnPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SetPeerConnPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SetPeerConnPolicy(ctx, req.(*SetPeerConnPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ListPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPeersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListPeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListPeers(ctx, req.(*ListPeersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ListGossipSyncers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGossipSyncersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListGossipSyncers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListGossipSyncers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListGossipSyncers(ctx, req.(*ListGossipSyncersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_GetGraphSyncStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GraphSyncStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).GetGraphSyncStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/GetGraphSyncStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).GetGraphSyncStatus(ctx, req.(*GraphSyncStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_SubscribeGraphSyncStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GraphSyncSubscription)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).SubscribeGraphSyncStatus(m, &lightningSubscribeGraphSyncStatusServer{stream})
+}
+
+type Lightning_SubscribeGraphSyncStatusServer interface {
+	Send(*GraphSyncStatus) error
+	grpc.ServerStream
+}
+
+type lightningSubscribeGraphSyncStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningSubscribeGraphSyncStatusServer) Send(m *GraphSyncStatus) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Lightning_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).GetInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/GetInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).GetInfo(ctx, req.(*GetInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_PendingChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).PendingChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/PendingChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).PendingChannels(ctx, req.(*PendingChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ListArbitrators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListArbitratorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListArbitrators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListArbitrators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListArbitrators(ctx, req.(*ListArbitratorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_PendingChannelLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingChannelLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).PendingChannelLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/PendingChannelLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).PendingChannelLimits(ctx, req.(*PendingChannelLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ListChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListChannels(ctx, req.(*ListChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_SubscribeChannelEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ChannelEventSubscription)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).SubscribeChannelEvents(m, &lightningSubscribeChannelEventsServer{stream})
+}
+
+type Lightning_SubscribeChannelEventsServer interface {
+	Send(*ChannelEventUpdate) error
+	grpc.ServerStream
+}
+
+type lightningSubscribeChannelEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningSubscribeChannelEventsServer) Send(m *ChannelEventUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Lightning_ClosedChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClosedChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ClosedChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ClosedChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ClosedChannels(ctx, req.(*ClosedChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_StreamChannels_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListChannelsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).StreamChannels(m, &lightningStreamChannelsServer{stream})
+}
+
+type Lightning_StreamChannelsServer interface {
+	Send(*Channel) error
+	grpc.ServerStream
+}
+
+type lightningStreamChannelsServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningStreamChannelsServer) Send(m *Channel) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Lightning_StreamClosedChannels_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClosedChannelsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).StreamClosedChannels(m, &lightningStreamClosedChannelsServer{stream})
+}
+
+type Lightning_StreamClosedChannelsServer interface {
+	Send(*ChannelCloseSummary) error
+	grpc.ServerStream
+}
+
+type lightningStreamClosedChannelsServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningStreamClosedChannelsServer) Send(m *ChannelCloseSummary) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Lightning_OpenChannelSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).OpenChannelSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/OpenChannelSync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).OpenChannelSync(ctx, req.(*OpenChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_OpenChannel_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(OpenChannelRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).OpenChannel(m, &lightningOpenChannelServer{stream})
+}
+
+type Lightning_OpenChannelServer interface {
+	Send(*OpenStatusUpdate) error
+	grpc.ServerStream
+}
+
+type lightningOpenChannelServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningOpenChannelServer) Send(m *OpenStatusUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Lightning_ChannelAcceptor_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LightningServer).ChannelAcceptor(&lightningChannelAcceptorServer{stream})
+}
+
+type Lightning_ChannelAcceptorServer interface {
+	Send(*ChannelAcceptRequest) error
+	Recv() (*ChannelAcceptResponse, error)
+	grpc.ServerStream
+}
+
+type lightningChannelAcceptorServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningChannelAcceptorServer) Send(m *ChannelAcceptRequest) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func RegisterLightningServer(s *grpc.Server, srv LightningServer) {
-	s.RegisterService(&_Lightning_serviceDesc, srv)
+func (x *lightningChannelAcceptorServer) Recv() (*ChannelAcceptResponse, error) {
+	m := new(ChannelAcceptResponse)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func _Lightning_WalletBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WalletBalanceRequest)
+func _Lightning_CloseChannel_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CloseChannelRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).CloseChannel(m, &lightningCloseChannelServer{stream})
+}
+
+type Lightning_CloseChannelServer interface {
+	Send(*CloseStatusUpdate) error
+	grpc.ServerStream
+}
+
+type lightningCloseChannelServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningCloseChannelServer) Send(m *CloseStatusUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Lightning_AbandonChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbandonChannelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).WalletBalance(ctx, in)
+		return srv.(LightningServer).AbandonChannel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/WalletBalance",
+		FullMethod: "/lnrpc.Lightning/AbandonChannel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).WalletBalance(ctx, req.(*WalletBalanceRequest))
+		return srv.(LightningServer).AbandonChannel(ctx, req.(*AbandonChannelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_ChannelBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChannelBalanceRequest)
+func _Lightning_SendPayment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LightningServer).SendPayment(&lightningSendPaymentServer{stream})
+}
+
+type Lightning_SendPaymentServer interface {
+	Send(*SendResponse) error
+	Recv() (*SendRequest, error)
+	grpc.ServerStream
+}
+
+type lightningSendPaymentServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningSendPaymentServer) Send(m *SendResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *lightningSendPaymentServer) Recv() (*SendRequest, error) {
+	m := new(SendRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Lightning_SendPaymentSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).ChannelBalance(ctx, in)
+		return srv.(LightningServer).SendPaymentSync(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/ChannelBalance",
+		FullMethod: "/lnrpc.Lightning/SendPaymentSync",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).ChannelBalance(ctx, req.(*ChannelBalanceRequest))
+		return srv.(LightningServer).SendPaymentSync(ctx, req.(*SendRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_GetTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTransactionsRequest)
+func _Lightning_SendToRoute_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LightningServer).SendToRoute(&lightningSendToRouteServer{stream})
+}
+
+type Lightning_SendToRouteServer interface {
+	Send(*SendResponse) error
+	Recv() (*SendToRouteRequest, error)
+	grpc.ServerStream
+}
+
+type lightningSendToRouteServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningSendToRouteServer) Send(m *SendResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *lightningSendToRouteServer) Recv() (*SendToRouteRequest, error) {
+	m := new(SendToRouteRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Lightning_SendToRouteSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendToRouteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).GetTransactions(ctx, in)
+		return srv.(LightningServer).SendToRouteSync(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/GetTransactions",
+		FullMethod: "/lnrpc.Lightning/SendToRouteSync",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).GetTransactions(ctx, req.(*GetTransactionsRequest))
+		return srv.(LightningServer).SendToRouteSync(ctx, req.(*SendToRouteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_EstimateFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateFeeRequest)
+func _Lightning_AddInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Invoice)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).EstimateFee(ctx, in)
+		return srv.(LightningServer).AddInvoice(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/EstimateFee",
+		FullMethod: "/lnrpc.Lightning/AddInvoice",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).EstimateFee(ctx, req.(*EstimateFeeRequest))
+		return srv.(LightningServer).AddInvoice(ctx, req.(*Invoice))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_SendCoins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendCoinsRequest)
+func _Lightning_ListInvoices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvoiceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).SendCoins(ctx, in)
+		return srv.(LightningServer).ListInvoices(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/SendCoins",
+		FullMethod: "/lnrpc.Lightning/ListInvoices",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).SendCoins(ctx, req.(*SendCoinsRequest))
+		return srv.(LightningServer).ListInvoices(ctx, req.(*ListInvoiceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_ListUnspent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListUnspentRequest)
+func _Lightning_LookupInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentHash)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).ListUnspent(ctx, in)
+		return srv.(LightningServer).LookupInvoice(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/ListUnspent",
+		FullMethod: "/lnrpc.Lightning/LookupInvoice",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).ListUnspent(ctx, req.(*ListUnspentRequest))
+		return srv.(LightningServer).LookupInvoice(ctx, req.(*PaymentHash))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_SubscribeTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetTransactionsRequest)
+func _Lightning_SubscribeInvoices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(InvoiceSubscription)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(LightningServer).SubscribeTransactions(m, &lightningSubscribeTransactionsServer{stream})
+	return srv.(LightningServer).SubscribeInvoices(m, &lightningSubscribeInvoicesServer{stream})
 }
 
-type Lightning_SubscribeTransactionsServer interface {
-	Send(*Transaction) error
+type Lightning_SubscribeInvoicesServer interface {
+	Send(*Invoice) error
 	grpc.ServerStream
 }
 
-type lightningSubscribeTransactionsServer struct {
+type lightningSubscribeInvoicesServer struct {
 	grpc.ServerStream
 }
 
-func (x *lightningSubscribeTransactionsServer) Send(m *Transaction) error {
+func (x *lightningSubscribeInvoicesServer) Send(m *Invoice) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _Lightning_SendMany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendManyRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(LightningServer).SendMany(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/SendMany",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).SendMany(ctx, req.(*SendManyRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _Lightning_NewAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NewAddressRequest)
+func _Lightning_DecodePayReq_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayReqString)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).NewAddress(ctx, in)
+		return srv.(LightningServer).DecodePayReq(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/NewAddress",
+		FullMethod: "/lnrpc.Lightning/DecodePayReq",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).NewAddress(ctx, req.(*NewAddressRequest))
+		return srv.(LightningServer).DecodePayReq(ctx, req.(*PayReqString))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_SignMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignMessageRequest)
+func _Lightning_ListPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPaymentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).SignMessage(ctx, in)
+		return srv.(LightningServer).ListPayments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/SignMessage",
+		FullMethod: "/lnrpc.Lightning/ListPayments",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).SignMessage(ctx, req.(*SignMessageRequest))
+		return srv.(LightningServer).ListPayments(ctx, req.(*ListPaymentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_VerifyMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VerifyMessageRequest)
+func _Lightning_DeleteAllPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAllPaymentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).VerifyMessage(ctx, in)
+		return srv.(LightningServer).DeleteAllPayments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/VerifyMessage",
+		FullMethod: "/lnrpc.Lightning/DeleteAllPayments",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).VerifyMessage(ctx, req.(*VerifyMessageRequest))
+		return srv.(LightningServer).DeleteAllPayments(ctx, req.(*DeleteAllPaymentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_ConnectPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConnectPeerRequest)
+func _Lightning_DescribeGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelGraphRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).ConnectPeer(ctx, in)
+		return srv.(LightningServer).DescribeGraph(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/ConnectPeer",
+		FullMethod: "/lnrpc.Lightning/DescribeGraph",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).ConnectPeer(ctx, req.(*ConnectPeerRequest))
+		return srv.(LightningServer).DescribeGraph(ctx, req.(*ChannelGraphRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_DisconnectPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DisconnectPeerRequest)
+func _Lightning_GetChanInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChanInfoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).DisconnectPeer(ctx, in)
+		return srv.(LightningServer).GetChanInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/DisconnectPeer",
+		FullMethod: "/lnrpc.Lightning/GetChanInfo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).DisconnectPeer(ctx, req.(*DisconnectPeerRequest))
+		return srv.(LightningServer).GetChanInfo(ctx, req.(*ChanInfoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_ListPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListPeersRequest)
+func _Lightning_GetNodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeInfoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).ListPeers(ctx, in)
+		return srv.(LightningServer).GetNodeInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/ListPeers",
+		FullMethod: "/lnrpc.Lightning/GetNodeInfo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).ListPeers(ctx, req.(*ListPeersRequest))
+		return srv.(LightningServer).GetNodeInfo(ctx, req.(*NodeInfoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetInfoRequest)
+func _Lightning_QueryRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoutesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).GetInfo(ctx, in)
+		return srv.(LightningServer).QueryRoutes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/GetInfo",
+		FullMethod: "/lnrpc.Lightning/QueryRoutes",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).GetInfo(ctx, req.(*GetInfoRequest))
+		return srv.(LightningServer).QueryRoutes(ctx, req.(*QueryRoutesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_PendingChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PendingChannelsRequest)
+func _Lightning_GetNetworkInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkInfoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).PendingChannels(ctx, in)
+		return srv.(LightningServer).GetNetworkInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/PendingChannels",
+		FullMethod: "/lnrpc.Lightning/GetNetworkInfo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).PendingChannels(ctx, req.(*PendingChannelsRequest))
+		return srv.(LightningServer).GetNetworkInfo(ctx, req.(*NetworkInfoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_ListChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListChannelsRequest)
+func _Lightning_StopDaemon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).ListChannels(ctx, in)
+		return srv.(LightningServer).StopDaemon(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/ListChannels",
+		FullMethod: "/lnrpc.Lightning/StopDaemon",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).ListChannels(ctx, req.(*ListChannelsRequest))
+		return srv.(LightningServer).StopDaemon(ctx, req.(*StopRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_SubscribeChannelEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ChannelEventSubscription)
+func _Lightning_SubscribeChannelGraph_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GraphTopologySubscription)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(LightningServer).SubscribeChannelEvents(m, &lightningSubscribeChannelEventsServer{stream})
+	return srv.(LightningServer).SubscribeChannelGraph(m, &lightningSubscribeChannelGraphServer{stream})
 }
 
-type Lightning_SubscribeChannelEventsServer interface {
-	Send(*ChannelEventUpdate) error
+type Lightning_SubscribeChannelGraphServer interface {
+	Send(*GraphTopologyUpdate) error
 	grpc.ServerStream
 }
 
-type lightningSubscribeChannelEventsServer struct {
+type lightningSubscribeChannelGraphServer struct {
 	grpc.ServerStream
 }
 
-func (x *lightningSubscribeChannelEventsServer) Send(m *ChannelEventUpdate) error {
+func (x *lightningSubscribeChannelGraphServer) Send(m *GraphTopologyUpdate) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _Lightning_ClosedChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClosedChannelsRequest)
+func _Lightning_DebugLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DebugLevelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).ClosedChannels(ctx, in)
+		return srv.(LightningServer).DebugLevel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/ClosedChannels",
+		FullMethod: "/lnrpc.Lightning/DebugLevel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).ClosedChannels(ctx, req.(*ClosedChannelsRequest))
+		return srv.(LightningServer).DebugLevel(ctx, req.(*DebugLevelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_OpenChannelSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OpenChannelRequest)
+func _Lightning_FeeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeeReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).OpenChannelSync(ctx, in)
+		return srv.(LightningServer).FeeReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/OpenChannelSync",
+		FullMethod: "/lnrpc.Lightning/FeeReport",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).OpenChannelSync(ctx, req.(*OpenChannelRequest))
+		return srv.(LightningServer).FeeReport(ctx, req.(*FeeReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_OpenChannel_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(OpenChannelRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(LightningServer).OpenChannel(m, &lightningOpenChannelServer{stream})
-}
-
-type Lightning_OpenChannelServer interface {
-	Send(*OpenStatusUpdate) error
-	grpc.ServerStream
-}
-
-type lightningOpenChannelServer struct {
-	grpc.ServerStream
-}
-
-func (x *lightningOpenChannelServer) Send(m *OpenStatusUpdate) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _Lightning_ChannelAcceptor_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(LightningServer).ChannelAcceptor(&lightningChannelAcceptorServer{stream})
-}
-
-type Lightning_ChannelAcceptorServer interface {
-	Send(*ChannelAcceptRequest) error
-	Recv() (*ChannelAcceptResponse, error)
-	grpc.ServerStream
-}
-
-type lightningChannelAcceptorServer struct {
-	grpc.ServerStream
-}
-
-func (x *lightningChannelAcceptorServer) Send(m *ChannelAcceptRequest) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func (x *lightningChannelAcceptorServer) Recv() (*ChannelAcceptResponse, error) {
-	m := new(ChannelAcceptResponse)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func _Lightning_UpdateChannelPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyUpdateRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
-}
-
-func _Lightning_CloseChannel_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(CloseChannelRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	if interceptor == nil {
+		return srv.(LightningServer).UpdateChannelPolicy(ctx, in)
 	}
-	return srv.(LightningServer).CloseChannel(m, &lightningCloseChannelServer{stream})
-}
-
-type Lightning_CloseChannelServer interface {
-	Send(*CloseStatusUpdate) error
-	grpc.ServerStream
-}
-
-type lightningCloseChannelServer struct {
-	grpc.ServerStream
-}
-
-func (x *lightningCloseChannelServer) Send(m *CloseStatusUpdate) error {
-	return x.ServerStream.SendMsg(m)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/UpdateChannelPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).UpdateChannelPolicy(ctx, req.(*PolicyUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_AbandonChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AbandonChannelRequest)
+func _Lightning_ForwardingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForwardingHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).AbandonChannel(ctx, in)
+		return srv.(LightningServer).ForwardingHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/AbandonChannel",
+		FullMethod: "/lnrpc.Lightning/ForwardingHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).AbandonChannel(ctx, req.(*AbandonChannelRequest))
+		return srv.(LightningServer).ForwardingHistory(ctx, req.(*ForwardingHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_SendPayment_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(LightningServer).SendPayment(&lightningSendPaymentServer{stream})
-}
-
-type Lightning_SendPaymentServer interface {
-	Send(*SendResponse) error
-	Recv() (*SendRequest, error)
-	grpc.ServerStream
-}
-
-type lightningSendPaymentServer struct {
-	grpc.ServerStream
-}
-
-func (x *lightningSendPaymentServer) Send(m *SendResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func (x *lightningSendPaymentServer) Recv() (*SendRequest, error) {
-	m := new(SendRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
-
-func _Lightning_SendPaymentSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendRequest)
+func _Lightning_ExportChannelBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportChannelBackupRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).SendPaymentSync(ctx, in)
+		return srv.(LightningServer).ExportChannelBackup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/SendPaymentSync",
+		FullMethod: "/lnrpc.Lightning/ExportChannelBackup",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).SendPaymentSync(ctx, req.(*SendRequest))
+		return srv.(LightningServer).ExportChannelBackup(ctx, req.(*ExportChannelBackupRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_SendToRoute_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(LightningServer).SendToRoute(&lightningSendToRouteServer{stream})
-}
-
-type Lightning_SendToRouteServer interface {
-	Send(*SendResponse) error
-	Recv() (*SendToRouteRequest, error)
-	grpc.ServerStream
-}
-
-type lightningSendToRouteServer struct {
-	grpc.ServerStream
-}
-
-func (x *lightningSendToRouteServer) Send(m *SendResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func (x *lightningSendToRouteServer) Recv() (*SendToRouteRequest, error) {
-	m := new(SendToRouteRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
-
-func _Lightning_SendToRouteSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendToRouteRequest)
+func _Lightning_ExportAllChannelBackups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChanBackupExportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).SendToRouteSync(ctx, in)
+		return srv.(LightningServer).ExportAllChannelBackups(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/SendToRouteSync",
+		FullMethod: "/lnrpc.Lightning/ExportAllChannelBackups",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).SendToRouteSync(ctx, req.(*SendToRouteRequest))
+		return srv.(LightningServer).ExportAllChannelBackups(ctx, req.(*ChanBackupExportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_AddInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Invoice)
+func _Lightning_VerifyChanBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChanBackupSnapshot)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).AddInvoice(ctx, in)
+		return srv.(LightningServer).VerifyChanBackup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/AddInvoice",
+		FullMethod: "/lnrpc.Lightning/VerifyChanBackup",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).AddInvoice(ctx, req.(*Invoice))
+		return srv.(LightningServer).VerifyChanBackup(ctx, req.(*ChanBackupSnapshot))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_ListInvoices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListInvoiceRequest)
+func _Lightning_InspectChanBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChanBackupSnapshot)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).ListInvoices(ctx, in)
+		return srv.(LightningServer).InspectChanBackup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/ListInvoices",
+		FullMethod: "/lnrpc.Lightning/InspectChanBackup",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).ListInvoices(ctx, req.(*ListInvoiceRequest))
+		return srv.(LightningServer).InspectChanBackup(ctx, req.(*ChanBackupSnapshot))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_LookupInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PaymentHash)
+func _Lightning_RestoreChannelBackups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreChanBackupRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).LookupInvoice(ctx, in)
+		return srv.(LightningServer).RestoreChannelBackups(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/LookupInvoice",
+		FullMethod: "/lnrpc.Lightning/RestoreChannelBackups",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).LookupInvoice(ctx, req.(*PaymentHash))
+		return srv.(LightningServer).RestoreChannelBackups(ctx, req.(*RestoreChanBackupRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_SubscribeInvoices_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(InvoiceSubscription)
+func _Lightning_SubscribeChannelBackups_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ChannelBackupSubscription)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(LightningServer).SubscribeInvoices(m, &lightningSubscribeInvoicesServer{stream})
+	return srv.(LightningServer).SubscribeChannelBackups(m, &lightningSubscribeChannelBackupsServer{stream})
 }
 
-type Lightning_SubscribeInvoicesServer interface {
-	Send(*Invoice) error
+type Lightning_SubscribeChannelBackupsServer interface {
+	Send(*ChanBackupSnapshot) error
 	grpc.ServerStream
 }
 
-type lightningSubscribeInvoicesServer struct {
+type lightningSubscribeChannelBackupsServer struct {
 	grpc.ServerStream
 }
 
-func (x *lightningSubscribeInvoicesServer) Send(m *Invoice) error {
+func (x *lightningSubscribeChannelBackupsServer) Send(m *ChanBackupSnapshot) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _Lightning_DecodePayReq_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PayReqString)
+func _Lightning_CreateDatabaseSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DatabaseSnapshotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).DecodePayReq(ctx, in)
+		return srv.(LightningServer).CreateDatabaseSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/CreateDatabaseSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).CreateDatabaseSnapshot(ctx, req.(*DatabaseSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_BatchOpenChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchOpenChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).BatchOpenChannel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/DecodePayReq",
+		FullMethod: "/lnrpc.Lightning/BatchOpenChannel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).DecodePayReq(ctx, req.(*PayReqString))
+		return srv.(LightningServer).BatchOpenChannel(ctx, req.(*BatchOpenChannelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_ListPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListPaymentsRequest)
+func _Lightning_BumpFundingFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BumpFundingFeeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).ListPayments(ctx, in)
+		return srv.(LightningServer).BumpFundingFee(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/ListPayments",
+		FullMethod: "/lnrpc.Lightning/BumpFundingFee",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).ListPayments(ctx, req.(*ListPaymentsRequest))
+		return srv.(LightningServer).BumpFundingFee(ctx, req.(*BumpFundingFeeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_DeleteAllPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteAllPaymentsRequest)
+func _Lightning_RebroadcastLimboOutput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebroadcastLimboOutputRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).DeleteAllPayments(ctx, in)
+		return srv.(LightningServer).RebroadcastLimboOutput(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/DeleteAllPayments",
+		FullMethod: "/lnrpc.Lightning/RebroadcastLimboOutput",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).DeleteAllPayments(ctx, req.(*DeleteAllPaymentsRequest))
+		return srv.(LightningServer).RebroadcastLimboOutput(ctx, req.(*RebroadcastLimboOutputRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_DescribeGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChannelGraphRequest)
+func _Lightning_SendCustomMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendCustomMessageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).DescribeGraph(ctx, in)
+		return srv.(LightningServer).SendCustomMessage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/DescribeGraph",
+		FullMethod: "/lnrpc.Lightning/SendCustomMessage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).DescribeGraph(ctx, req.(*ChannelGraphRequest))
+		return srv.(LightningServer).SendCustomMessage(ctx, req.(*SendCustomMessageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_GetChanInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChanInfoRequest)
+func _Lightning_SubscribeCustomMessages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeCustomMessagesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).SubscribeCustomMessages(m, &lightningSubscribeCustomMessagesServer{stream})
+}
+
+type Lightning_SubscribeCustomMessagesServer interface {
+	Send(*CustomMessage) error
+	grpc.ServerStream
+}
+
+type lightningSubscribeCustomMessagesServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningSubscribeCustomMessagesServer) Send(m *CustomMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Lightning_SubscribePeerEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PeerEventSubscription)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).SubscribePeerEvents(m, &lightningSubscribePeerEventsServer{stream})
+}
+
+type Lightning_SubscribePeerEventsServer interface {
+	Send(*PeerEvent) error
+	grpc.ServerStream
+}
+
+type lightningSubscribePeerEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningSubscribePeerEventsServer) Send(m *PeerEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Lightning_BakeMacaroon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BakeMacaroonRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).GetChanInfo(ctx, in)
+		return srv.(LightningServer).BakeMacaroon(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/GetChanInfo",
+		FullMethod: "/lnrpc.Lightning/BakeMacaroon",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).GetChanInfo(ctx, req.(*ChanInfoRequest))
+		return srv.(LightningServer).BakeMacaroon(ctx, req.(*BakeMacaroonRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_GetNodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NodeInfoRequest)
+func _Lightning_ListMacaroonIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMacaroonIDsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).GetNodeInfo(ctx, in)
+		return srv.(LightningServer).ListMacaroonIDs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/GetNodeInfo",
+		FullMethod: "/lnrpc.Lightning/ListMacaroonIDs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).GetNodeInfo(ctx, req.(*NodeInfoRequest))
+		return srv.(LightningServer).ListMacaroonIDs(ctx, req.(*ListMacaroonIDsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_QueryRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRoutesRequest)
+func _Lightning_DeleteMacaroonID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMacaroonIDRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).QueryRoutes(ctx, in)
+		return srv.(LightningServer).DeleteMacaroonID(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/QueryRoutes",
+		FullMethod: "/lnrpc.Lightning/DeleteMacaroonID",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).QueryRoutes(ctx, req.(*QueryRoutesRequest))
+		return srv.(LightningServer).DeleteMacaroonID(ctx, req.(*DeleteMacaroonIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_GetNetworkInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NetworkInfoRequest)
+func _Lightning_ListPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPermissionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).GetNetworkInfo(ctx, in)
+		return srv.(LightningServer).ListPermissions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/GetNetworkInfo",
+		FullMethod: "/lnrpc.Lightning/ListPermissions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).GetNetworkInfo(ctx, req.(*NetworkInfoRequest))
+		return srv.(LightningServer).ListPermissions(ctx, req.(*ListPermissionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_StopDaemon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StopRequest)
+func _Lightning_RegisterRPCMiddleware_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LightningServer).RegisterRPCMiddleware(&lightningRegisterRPCMiddlewareServer{stream})
+}
+
+type Lightning_RegisterRPCMiddlewareServer interface {
+	Send(*RPCMiddlewareRequest) error
+	Recv() (*RPCMiddlewareResponse, error)
+	grpc.ServerStream
+}
+
+type lightningRegisterRPCMiddlewareServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningRegisterRPCMiddlewareServer) Send(m *RPCMiddlewareRequest) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *lightningRegisterRPCMiddlewareServer) Recv() (*RPCMiddlewareResponse, error) {
+	m := new(RPCMiddlewareResponse)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Lightning_SetColdSweep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetColdSweepRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).StopDaemon(ctx, in)
+		return srv.(LightningServer).SetColdSweep(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/StopDaemon",
+		FullMethod: "/lnrpc.Lightning/SetColdSweep",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).StopDaemon(ctx, req.(*StopRequest))
+		return srv.(LightningServer).SetColdSweep(ctx, req.(*SetColdSweepRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_SubscribeChannelGraph_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GraphTopologySubscription)
+func _Lightning_SubscribeColdSweeps_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ColdSweepSubscription)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(LightningServer).SubscribeChannelGraph(m, &lightningSubscribeChannelGraphServer{stream})
+	return srv.(LightningServer).SubscribeColdSweeps(m, &lightningSubscribeColdSweepsServer{stream})
 }
 
-type Lightning_SubscribeChannelGraphServer interface {
-	Send(*GraphTopologyUpdate) error
+type Lightning_SubscribeColdSweepsServer interface {
+	Send(*ColdSweepEvent) error
 	grpc.ServerStream
 }
 
-type lightningSubscribeChannelGraphServer struct {
+type lightningSubscribeColdSweepsServer struct {
 	grpc.ServerStream
 }
 
-func (x *lightningSubscribeChannelGraphServer) Send(m *GraphTopologyUpdate) error {
+func (x *lightningSubscribeColdSweepsServer) Send(m *ColdSweepEvent) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _Lightning_DebugLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DebugLevelRequest)
+func _Lightning_InvoiceURI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvoiceURIRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).DebugLevel(ctx, in)
+		return srv.(LightningServer).InvoiceURI(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/DebugLevel",
+		FullMethod: "/lnrpc.Lightning/InvoiceURI",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).DebugLevel(ctx, req.(*DebugLevelRequest))
+		return srv.(LightningServer).InvoiceURI(ctx, req.(*InvoiceURIRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_FeeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FeeReportRequest)
+func _Lightning_LegacyPayloadStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LegacyPayloadStatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).FeeReport(ctx, in)
+		return srv.(LightningServer).LegacyPayloadStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/FeeReport",
+		FullMethod: "/lnrpc.Lightning/LegacyPayloadStats",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).FeeReport(ctx, req.(*FeeReportRequest))
+		return srv.(LightningServer).LegacyPayloadStats(ctx, req.(*LegacyPayloadStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_UpdateChannelPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PolicyUpdateRequest)
+func _Lightning_PushGossip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushGossipRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).UpdateChannelPolicy(ctx, in)
+		return srv.(LightningServer).PushGossip(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/UpdateChannelPolicy",
+		FullMethod: "/lnrpc.Lightning/PushGossip",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).UpdateChannelPolicy(ctx, req.(*PolicyUpdateRequest))
+		return srv.(LightningServer).PushGossip(ctx, req.(*PushGossipRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_ForwardingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ForwardingHistoryRequest)
+func _Lightning_FeeManagerReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeeManagerReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).ForwardingHistory(ctx, in)
+		return srv.(LightningServer).FeeManagerReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/ForwardingHistory",
+		FullMethod: "/lnrpc.Lightning/FeeManagerReport",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).ForwardingHistory(ctx, req.(*ForwardingHistoryRequest))
+		return srv.(LightningServer).FeeManagerReport(ctx, req.(*FeeManagerReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_ExportChannelBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExportChannelBackupRequest)
+func _Lightning_EffectiveFeeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EffectiveFeeRatesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).ExportChannelBackup(ctx, in)
+		return srv.(LightningServer).EffectiveFeeRates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/ExportChannelBackup",
+		FullMethod: "/lnrpc.Lightning/EffectiveFeeRates",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).ExportChannelBackup(ctx, req.(*ExportChannelBackupRequest))
+		return srv.(LightningServer).EffectiveFeeRates(ctx, req.(*EffectiveFeeRatesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_ExportAllChannelBackups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChanBackupExportRequest)
+func _Lightning_GetDebugInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDebugInfoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).ExportAllChannelBackups(ctx, in)
+		return srv.(LightningServer).GetDebugInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/ExportAllChannelBackups",
+		FullMethod: "/lnrpc.Lightning/GetDebugInfo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).ExportAllChannelBackups(ctx, req.(*ChanBackupExportRequest))
+		return srv.(LightningServer).GetDebugInfo(ctx, req.(*GetDebugInfoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_VerifyChanBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChanBackupSnapshot)
+func _Lightning_CheckMacaroonPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckMacaroonPermissionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).VerifyChanBackup(ctx, in)
+		return srv.(LightningServer).CheckMacaroonPermissions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/VerifyChanBackup",
+		FullMethod: "/lnrpc.Lightning/CheckMacaroonPermissions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).VerifyChanBackup(ctx, req.(*ChanBackupSnapshot))
+		return srv.(LightningServer).CheckMacaroonPermissions(ctx, req.(*CheckMacaroonPermissionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_RestoreChannelBackups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RestoreChanBackupRequest)
+func _Lightning_WarpClock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WarpClockRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).RestoreChannelBackups(ctx, in)
+		return srv.(LightningServer).WarpClock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/RestoreChannelBackups",
+		FullMethod: "/lnrpc.Lightning/WarpClock",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).RestoreChannelBackups(ctx, req.(*RestoreChanBackupRequest))
+		return srv.(LightningServer).WarpClock(ctx, req.(*WarpClockRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_SubscribeChannelBackups_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ChannelBackupSubscription)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _Lightning_ExportPaymentReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportPaymentReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(LightningServer).SubscribeChannelBackups(m, &lightningSubscribeChannelBackupsServer{stream})
-}
-
-type Lightning_SubscribeChannelBackupsServer interface {
-	Send(*ChanBackupSnapshot) error
-	grpc.ServerStream
+	if interceptor == nil {
+		return srv.(LightningServer).ExportPaymentReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ExportPaymentReceipt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ExportPaymentReceipt(ctx, req.(*ExportPaymentReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type lightningSubscribeChannelBackupsServer struct {
-	grpc.ServerStream
+func _Lightning_VerifyPaymentReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyPaymentReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).VerifyPaymentReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/VerifyPaymentReceipt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).VerifyPaymentReceipt(ctx, req.(*VerifyPaymentReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *lightningSubscribeChannelBackupsServer) Send(m *ChanBackupSnapshot) error {
-	return x.ServerStream.SendMsg(m)
+func _Lightning_ChannelRecoveryStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelRecoveryStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ChannelRecoveryStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ChannelRecoveryStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ChannelRecoveryStatus(ctx, req.(*ChannelRecoveryStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
 var _Lightning_serviceDesc = grpc.ServiceDesc{
@@ -12074,10 +20441,22 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DisconnectPeer",
 			Handler:    _Lightning_DisconnectPeer_Handler,
 		},
+		{
+			MethodName: "SetPeerConnPolicy",
+			Handler:    _Lightning_SetPeerConnPolicy_Handler,
+		},
 		{
 			MethodName: "ListPeers",
 			Handler:    _Lightning_ListPeers_Handler,
 		},
+		{
+			MethodName: "ListGossipSyncers",
+			Handler:    _Lightning_ListGossipSyncers_Handler,
+		},
+		{
+			MethodName: "GetGraphSyncStatus",
+			Handler:    _Lightning_GetGraphSyncStatus_Handler,
+		},
 		{
 			MethodName: "GetInfo",
 			Handler:    _Lightning_GetInfo_Handler,
@@ -12086,6 +20465,14 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PendingChannels",
 			Handler:    _Lightning_PendingChannels_Handler,
 		},
+		{
+			MethodName: "ListArbitrators",
+			Handler:    _Lightning_ListArbitrators_Handler,
+		},
+		{
+			MethodName: "PendingChannelLimits",
+			Handler:    _Lightning_PendingChannelLimits_Handler,
+		},
 		{
 			MethodName: "ListChannels",
 			Handler:    _Lightning_ListChannels_Handler,
@@ -12186,10 +20573,98 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VerifyChanBackup",
 			Handler:    _Lightning_VerifyChanBackup_Handler,
 		},
+		{
+			MethodName: "InspectChanBackup",
+			Handler:    _Lightning_InspectChanBackup_Handler,
+		},
 		{
 			MethodName: "RestoreChannelBackups",
 			Handler:    _Lightning_RestoreChannelBackups_Handler,
 		},
+		{
+			MethodName: "CreateDatabaseSnapshot",
+			Handler:    _Lightning_CreateDatabaseSnapshot_Handler,
+		},
+		{
+			MethodName: "BatchOpenChannel",
+			Handler:    _Lightning_BatchOpenChannel_Handler,
+		},
+		{
+			MethodName: "BumpFundingFee",
+			Handler:    _Lightning_BumpFundingFee_Handler,
+		},
+		{
+			MethodName: "RebroadcastLimboOutput",
+			Handler:    _Lightning_RebroadcastLimboOutput_Handler,
+		},
+		{
+			MethodName: "SendCustomMessage",
+			Handler:    _Lightning_SendCustomMessage_Handler,
+		},
+		{
+			MethodName: "BakeMacaroon",
+			Handler:    _Lightning_BakeMacaroon_Handler,
+		},
+		{
+			MethodName: "ListMacaroonIDs",
+			Handler:    _Lightning_ListMacaroonIDs_Handler,
+		},
+		{
+			MethodName: "DeleteMacaroonID",
+			Handler:    _Lightning_DeleteMacaroonID_Handler,
+		},
+		{
+			MethodName: "ListPermissions",
+			Handler:    _Lightning_ListPermissions_Handler,
+		},
+		{
+			MethodName: "SetColdSweep",
+			Handler:    _Lightning_SetColdSweep_Handler,
+		},
+		{
+			MethodName: "InvoiceURI",
+			Handler:    _Lightning_InvoiceURI_Handler,
+		},
+		{
+			MethodName: "LegacyPayloadStats",
+			Handler:    _Lightning_LegacyPayloadStats_Handler,
+		},
+		{
+			MethodName: "PushGossip",
+			Handler:    _Lightning_PushGossip_Handler,
+		},
+		{
+			MethodName: "FeeManagerReport",
+			Handler:    _Lightning_FeeManagerReport_Handler,
+		},
+		{
+			MethodName: "EffectiveFeeRates",
+			Handler:    _Lightning_EffectiveFeeRates_Handler,
+		},
+		{
+			MethodName: "GetDebugInfo",
+			Handler:    _Lightning_GetDebugInfo_Handler,
+		},
+		{
+			MethodName: "CheckMacaroonPermissions",
+			Handler:    _Lightning_CheckMacaroonPermissions_Handler,
+		},
+		{
+			MethodName: "WarpClock",
+			Handler:    _Lightning_WarpClock_Handler,
+		},
+		{
+			MethodName: "ExportPaymentReceipt",
+			Handler:    _Lightning_ExportPaymentReceipt_Handler,
+		},
+		{
+			MethodName: "VerifyPaymentReceipt",
+			Handler:    _Lightning_VerifyPaymentReceipt_Handler,
+		},
+		{
+			MethodName: "ChannelRecoveryStatus",
+			Handler:    _Lightning_ChannelRecoveryStatus_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -12197,11 +20672,26 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Lightning_SubscribeTransactions_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscribeGraphSyncStatus",
+			Handler:       _Lightning_SubscribeGraphSyncStatus_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "SubscribeChannelEvents",
 			Handler:       _Lightning_SubscribeChannelEvents_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StreamChannels",
+			Handler:       _Lightning_StreamChannels_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamClosedChannels",
+			Handler:       _Lightning_StreamClosedChannels_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "OpenChannel",
 			Handler:       _Lightning_OpenChannel_Handler,
@@ -12245,547 +20735,917 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Lightning_SubscribeChannelBackups_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscribeCustomMessages",
+			Handler:       _Lightning_SubscribeCustomMessages_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribePeerEvents",
+			Handler:       _Lightning_SubscribePeerEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RegisterRPCMiddleware",
+			Handler:       _Lightning_RegisterRPCMiddleware_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "SubscribeColdSweeps",
+			Handler:       _Lightning_SubscribeColdSweeps_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rpc.proto",
 }
 
-func init() { proto.RegisterFile("rpc.proto", fileDescriptor_rpc_121e03430f9ed6eb) }
-
-var fileDescriptor_rpc_121e03430f9ed6eb = []byte{
-	// 8555 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7d, 0x4d, 0x6c, 0x24, 0xc9,
-	0x95, 0x5e, 0x67, 0xfd, 0x90, 0x55, 0xaf, 0x8a, 0x64, 0x31, 0xc8, 0x26, 0xab, 0x6b, 0x7a, 0x7a,
-	0x7a, 0x52, 0xed, 0x9e, 0x16, 0x35, 0x62, 0xf7, 0xb4, 0xa4, 0xd9, 0xd9, 0x99, 0x5d, 0xaf, 0xd9,
-	0x24, 0xbb, 0xd9, 0x1a, 0x0e, 0x9b, 0x4a, 0x76, 0xab, 0x2d, 0x69, 0x8d, 0x52, 0xb2, 0x2a, 0x48,
-	0xa6, 0xba, 0x2a, 0xb3, 0x94, 0x99, 0xc5, 0x26, 0x35, 0x1e, 0x1f, 0x16, 0x86, 0x61, 0x18, 0x58,
-	0x18, 0xb2, 0x2f, 0x86, 0x01, 0x63, 0x17, 0x92, 0x2f, 0xbb, 0xf6, 0xc1, 0x80, 0xb1, 0x86, 0x0d,
-	0x18, 0xd8, 0x8b, 0x01, 0xfb, 0x62, 0xf8, 0xe0, 0x83, 0x61, 0x1f, 0x7c, 0x30, 0x6c, 0xc8, 0x82,
-	0x7d, 0x31, 0x0c, 0xdf, 0x8d, 0xf7, 0xe2, 0x27, 0x23, 0x32, 0xb3, 0xba, 0x7b, 0x24, 0xd9, 0x27,
-	0x56, 0x7c, 0x11, 0x19, 0xbf, 0xef, 0xbd, 0x78, 0xef, 0xc5, 0x8b, 0x20, 0x34, 0xe3, 0xc9, 0x60,
-	0x73, 0x12, 0x47, 0x69, 0xc4, 0xea, 0xa3, 0x30, 0x9e, 0x0c, 0x7a, 0xd7, 0x4f, 0xa3, 0xe8, 0x74,
-	0xc4, 0xef, 0xfa, 0x93, 0xe0, 0xae, 0x1f, 0x86, 0x51, 0xea, 0xa7, 0x41, 0x14, 0x26, 0xa2, 0x90,
-	0xfb, 0x43, 0x58, 0x7c, 0xc4, 0xc3, 0x23, 0xce, 0x87, 0x1e, 0xff, 0xf1, 0x94, 0x27, 0x29, 0xfb,
-	0x1a, 0x2c, 0xfb, 0xfc, 0x27, 0x9c, 0x0f, 0xfb, 0x13, 0x3f, 0x49, 0x26, 0x67, 0xb1, 0x9f, 0xf0,
-	0xae, 0x73, 0xd3, 0xb9, 0xd3, 0xf6, 0x3a, 0x22, 0xe3, 0x50, 0xe3, 0xec, 0x5d, 0x68, 0x27, 0x58,
-	0x94, 0x87, 0x69, 0x1c, 0x4d, 0x2e, 0xbb, 0x15, 0x2a, 0xd7, 0x42, 0x6c, 0x57, 0x40, 0xee, 0x08,
-	0x96, 0x74, 0x0b, 0xc9, 0x24, 0x0a, 0x13, 0xce, 0xee, 0xc1, 0xea, 0x20, 0x98, 0x9c, 0xf1, 0xb8,
-	0x4f, 0x1f, 0x8f, 0x43, 0x3e, 0x8e, 0xc2, 0x60, 0xd0, 0x75, 0x6e, 0x56, 0xef, 0x34, 0x3d, 0x26,
-	0xf2, 0xf0, 0x8b, 0xcf, 0x64, 0x0e, 0x7b, 0x0f, 0x96, 0x78, 0x28, 0x70, 0x3e, 0xa4, 0xaf, 0x64,
-	0x53, 0x8b, 0x19, 0x8c, 0x1f, 0xb8, 0x7f, 0xb3, 0x02, 0xcb, 0x8f, 0xc3, 0x20, 0x7d, 0xee, 0x8f,
-	0x46, 0x3c, 0x55, 0x63, 0x7a, 0x0f, 0x96, 0x5e, 0x12, 0x40, 0x63, 0x7a, 0x19, 0xc5, 0x43, 0x39,
-	0xa2, 0x45, 0x01, 0x1f, 0x4a, 0x74, 0x66, 0xcf, 0x2a, 0x33, 0x7b, 0x56, 0x3a, 0x5d, 0xd5, 0x19,
-	0xd3, 0xf5, 0x1e, 0x2c, 0xc5, 0x7c, 0x10, 0x9d, 0xf3, 0xf8, 0xb2, 0xff, 0x32, 0x08, 0x87, 0xd1,
-	0xcb, 0x6e, 0xed, 0xa6, 0x73, 0xa7, 0xee, 0x2d, 0x2a, 0xf8, 0x39, 0xa1, 0xec, 0x01, 0x2c, 0x0d,
-	0xce, 0xfc, 0x30, 0xe4, 0xa3, 0xfe, 0xb1, 0x3f, 0x78, 0x31, 0x9d, 0x24, 0xdd, 0xfa, 0x4d, 0xe7,
-	0x4e, 0xeb, 0xfe, 0xb5, 0x4d, 0x5a, 0xd5, 0xcd, 0xed, 0x33, 0x3f, 0x7c, 0x40, 0x39, 0x47, 0xa1,
-	0x3f, 0x49, 0xce, 0xa2, 0xd4, 0x5b, 0x94, 0x5f, 0x08, 0x38, 0x71, 0x57, 0x81, 0x99, 0x33, 0x21,
-	0xe6, 0xde, 0xfd, 0x47, 0x0e, 0xac, 0x3c, 0x0b, 0x47, 0xd1, 0xe0, 0xc5, 0xaf, 0x38, 0x45, 0x25,
-	0x63, 0xa8, 0xbc, 0xe9, 0x18, 0xaa, 0x5f, 0x76, 0x0c, 0x6b, 0xb0, 0x6a, 0x77, 0x56, 0x8e, 0x82,
-	0xc3, 0x55, 0xfc, 0xfa, 0x94, 0xab, 0x6e, 0xa9, 0x61, 0x7c, 0x15, 0x3a, 0x83, 0x69, 0x1c, 0xf3,
-	0xb0, 0x30, 0x8e, 0x25, 0x89, 0xeb, 0x81, 0xbc, 0x0b, 0xed, 0x90, 0xbf, 0xcc, 0x8a, 0x49, 0xda,
-	0x0d, 0xf9, 0x4b, 0x55, 0xc4, 0xed, 0xc2, 0x5a, 0xbe, 0x19, 0xd9, 0x81, 0xff, 0xe6, 0x40, 0xed,
-	0x59, 0x7a, 0x11, 0xb1, 0x4d, 0xa8, 0xa5, 0x97, 0x13, 0xc1, 0x21, 0x8b, 0xf7, 0x99, 0x1c, 0xda,
-	0xd6, 0x70, 0x18, 0xf3, 0x24, 0x79, 0x7a, 0x39, 0xe1, 0x5e, 0xdb, 0x17, 0x89, 0x3e, 0x96, 0x63,
-	0x5d, 0x98, 0x97, 0x69, 0x6a, 0xb0, 0xe9, 0xa9, 0x24, 0x73, 0xa1, 0xed, 0x8f, 0xa3, 0x69, 0x98,
-	0xf6, 0xfd, 0x34, 0x1a, 0x8b, 0xc9, 0xaa, 0x7a, 0x16, 0xc6, 0xae, 0x43, 0x73, 0xf2, 0xa2, 0x9f,
-	0x0c, 0xe2, 0x60, 0x92, 0x12, 0xe9, 0x34, 0xbd, 0x0c, 0x60, 0x5f, 0x83, 0x46, 0x34, 0x4d, 0x27,
-	0x51, 0x10, 0xa6, 0x92, 0x5c, 0x96, 0x64, 0x7f, 0x9e, 0x4c, 0xd3, 0x43, 0x84, 0x3d, 0x5d, 0x80,
-	0xdd, 0x82, 0x85, 0x41, 0x14, 0x9e, 0x04, 0xf1, 0x58, 0x08, 0x84, 0xee, 0x1c, 0xb5, 0x67, 0x83,
-	0xee, 0xbf, 0xa8, 0x40, 0xeb, 0x69, 0xec, 0x87, 0x89, 0x3f, 0x40, 0x00, 0xbb, 0x9f, 0x5e, 0xf4,
-	0xcf, 0xfc, 0xe4, 0x8c, 0x46, 0xdc, 0xf4, 0x54, 0x92, 0xad, 0xc1, 0x9c, 0xe8, 0x2a, 0x8d, 0xab,
-	0xea, 0xc9, 0x14, 0x7b, 0x1f, 0x96, 0xc3, 0xe9, 0xb8, 0x6f, 0xb7, 0x55, 0x25, 0x8a, 0x29, 0x66,
-	0xb0, 0x1b, 0x00, 0xc7, 0xb8, 0xde, 0xa2, 0x09, 0x31, 0x42, 0x03, 0xc1, 0x49, 0x92, 0x29, 0x1e,
-	0x9c, 0x9e, 0x89, 0x61, 0xd6, 0x3d, 0x0b, 0xc3, 0x3a, 0xd2, 0x60, 0xcc, 0xfb, 0x49, 0xea, 0x8f,
-	0x27, 0x72, 0x58, 0x06, 0x42, 0xf9, 0x51, 0xea, 0x8f, 0xfa, 0x27, 0x9c, 0x27, 0xdd, 0x79, 0x99,
-	0xaf, 0x11, 0x76, 0x1b, 0x16, 0x87, 0x3c, 0x49, 0xfb, 0x72, 0x61, 0x78, 0xd2, 0x6d, 0x10, 0xfb,
-	0xe7, 0x50, 0xac, 0x27, 0xf6, 0x5f, 0xf6, 0x71, 0x02, 0xf8, 0x45, 0xb7, 0x29, 0xfa, 0x9a, 0x21,
-	0x48, 0x3d, 0x8f, 0x78, 0x6a, 0xcc, 0x5e, 0x22, 0xa9, 0xd4, 0xdd, 0x07, 0x66, 0xc0, 0x3b, 0x3c,
-	0xf5, 0x83, 0x51, 0xc2, 0x3e, 0x84, 0x76, 0x6a, 0x14, 0x26, 0x71, 0xd8, 0xd2, 0x24, 0x65, 0x7c,
-	0xe0, 0x59, 0xe5, 0xdc, 0x47, 0xd0, 0x78, 0xc8, 0xf9, 0x7e, 0x30, 0x0e, 0x52, 0xb6, 0x06, 0xf5,
-	0x93, 0xe0, 0x82, 0x0b, 0xa2, 0xaf, 0xee, 0x5d, 0xf1, 0x44, 0x92, 0xf5, 0x60, 0x7e, 0xc2, 0xe3,
-	0x01, 0x57, 0xcb, 0xb3, 0x77, 0xc5, 0x53, 0xc0, 0x83, 0x79, 0xa8, 0x8f, 0xf0, 0x63, 0xf7, 0x8f,
-	0x6b, 0xd0, 0x3a, 0xe2, 0xa1, 0x66, 0x26, 0x06, 0x35, 0x1c, 0xb2, 0x64, 0x20, 0xfa, 0xcd, 0xde,
-	0x81, 0x16, 0x4d, 0x43, 0x92, 0xc6, 0x41, 0x78, 0x2a, 0x69, 0x18, 0x10, 0x3a, 0x22, 0x84, 0x75,
-	0xa0, 0xea, 0x8f, 0x53, 0x49, 0xbd, 0xf8, 0x13, 0x19, 0x6d, 0xe2, 0x5f, 0x8e, 0x91, 0x27, 0xf5,
-	0xaa, 0xb6, 0xbd, 0x96, 0xc4, 0xf6, 0x70, 0x59, 0x37, 0x61, 0xc5, 0x2c, 0xa2, 0x6a, 0xaf, 0x53,
-	0xed, 0xcb, 0x46, 0x49, 0xd9, 0xc8, 0x7b, 0xb0, 0xa4, 0xca, 0xc7, 0xa2, 0xb3, 0xb4, 0xce, 0x4d,
-	0x6f, 0x51, 0xc2, 0x6a, 0x08, 0x77, 0xa0, 0x73, 0x12, 0x84, 0xfe, 0xa8, 0x3f, 0x18, 0xa5, 0xe7,
-	0xfd, 0x21, 0x1f, 0xa5, 0x3e, 0xad, 0x78, 0xdd, 0x5b, 0x24, 0x7c, 0x7b, 0x94, 0x9e, 0xef, 0x20,
-	0xca, 0xde, 0x87, 0xe6, 0x09, 0xe7, 0x7d, 0x9a, 0x89, 0x6e, 0xc3, 0xe2, 0x1e, 0x35, 0xbb, 0x5e,
-	0xe3, 0x44, 0xcd, 0xf3, 0x1d, 0xe8, 0x44, 0xd3, 0xf4, 0x34, 0x0a, 0xc2, 0xd3, 0x3e, 0xca, 0xac,
-	0x7e, 0x30, 0xec, 0xc2, 0x4d, 0xe7, 0x4e, 0xcd, 0x5b, 0x54, 0x38, 0x4a, 0x8e, 0xc7, 0x43, 0xf6,
-	0x2d, 0x58, 0x0f, 0x4e, 0xc3, 0x28, 0xe6, 0xfd, 0xb1, 0x7f, 0xd1, 0x8f, 0xa6, 0xe9, 0x71, 0x34,
-	0x0d, 0x87, 0x7d, 0x9c, 0x23, 0x24, 0x99, 0x86, 0xb7, 0x2a, 0xb2, 0x3f, 0xf3, 0x2f, 0x9e, 0xc8,
-	0xcc, 0xad, 0x71, 0xca, 0xde, 0x06, 0xa0, 0x2e, 0x8b, 0xfe, 0xb4, 0x6e, 0x3a, 0x77, 0x16, 0xbc,
-	0x26, 0x22, 0xa2, 0xfd, 0x8f, 0xa1, 0x41, 0xcb, 0x90, 0x8e, 0xce, 0xbb, 0x6d, 0xa2, 0x93, 0x77,
-	0x64, 0x67, 0x8d, 0x05, 0xdc, 0xdc, 0xe1, 0x49, 0xfa, 0x74, 0x74, 0x8e, 0x5b, 0xf1, 0xa5, 0x37,
-	0x3f, 0x14, 0xa9, 0xde, 0xc7, 0xd0, 0x36, 0x33, 0x70, 0xc5, 0x5e, 0xf0, 0x4b, 0x5a, 0xe5, 0x9a,
-	0x87, 0x3f, 0xd9, 0x2a, 0xd4, 0xcf, 0xfd, 0xd1, 0x94, 0x4b, 0x99, 0x28, 0x12, 0x1f, 0x57, 0x3e,
-	0x72, 0xdc, 0x7f, 0xee, 0x40, 0x5b, 0xb4, 0x20, 0xf7, 0xf2, 0x5b, 0xb0, 0xa0, 0x56, 0x82, 0xc7,
-	0x71, 0x14, 0x4b, 0xb1, 0x60, 0x83, 0x6c, 0x03, 0x3a, 0x0a, 0x98, 0xc4, 0x3c, 0x18, 0xfb, 0xa7,
-	0xaa, 0xee, 0x02, 0xce, 0xee, 0x67, 0x35, 0xc6, 0xd1, 0x34, 0xe5, 0x72, 0xd7, 0x68, 0xcb, 0xf1,
-	0x79, 0x88, 0x79, 0x76, 0x11, 0x14, 0x0b, 0x25, 0x24, 0x66, 0x61, 0xee, 0x4f, 0x1d, 0x60, 0xd8,
-	0xf5, 0xa7, 0x91, 0xa8, 0x42, 0x52, 0x48, 0x9e, 0x3a, 0x9d, 0x37, 0xa6, 0xce, 0xca, 0x2c, 0xea,
-	0x74, 0xa1, 0x2e, 0x7a, 0x5e, 0x2b, 0xe9, 0xb9, 0xc8, 0xfa, 0x76, 0xad, 0x51, 0xed, 0xd4, 0xdc,
-	0xff, 0x54, 0x85, 0xd5, 0x6d, 0xb1, 0xe5, 0x6d, 0x0d, 0x06, 0x7c, 0xa2, 0xe9, 0xf6, 0x1d, 0x68,
-	0x85, 0xd1, 0x90, 0xf7, 0x27, 0xd3, 0x63, 0xb5, 0x36, 0x6d, 0x0f, 0x10, 0x3a, 0x24, 0x84, 0xe8,
-	0xe3, 0xcc, 0x0f, 0x42, 0xd1, 0x69, 0x31, 0x97, 0x4d, 0x42, 0xa8, 0xcb, 0xb7, 0x61, 0x69, 0xc2,
-	0xc3, 0xa1, 0x49, 0x9e, 0x42, 0x29, 0x59, 0x90, 0xb0, 0xa4, 0xce, 0x77, 0xa0, 0x75, 0x32, 0x15,
-	0xe5, 0x90, 0x22, 0x6b, 0x44, 0x03, 0x20, 0x21, 0xa4, 0xc3, 0x6b, 0xd0, 0x98, 0x4c, 0x93, 0x33,
-	0xca, 0xad, 0x53, 0xee, 0x3c, 0xa6, 0x25, 0x89, 0x0e, 0xa7, 0x49, 0x2a, 0x49, 0x74, 0x8e, 0x32,
-	0x9b, 0x88, 0x08, 0x12, 0xfd, 0x3a, 0xac, 0x20, 0xc5, 0x13, 0xed, 0xf4, 0x83, 0xb0, 0x7f, 0x32,
-	0x22, 0x89, 0x3d, 0x4f, 0xe5, 0x3a, 0x63, 0xff, 0xe2, 0xbb, 0x98, 0xf3, 0x38, 0x7c, 0x48, 0x38,
-	0xb2, 0xb4, 0x52, 0x17, 0x62, 0x9e, 0xf0, 0xf8, 0x9c, 0x13, 0x17, 0xd6, 0xb4, 0x4e, 0xe0, 0x09,
-	0x14, 0x7b, 0x34, 0xc6, 0x71, 0xa7, 0xa3, 0x01, 0x71, 0x50, 0xcd, 0x9b, 0x1f, 0x07, 0xe1, 0x5e,
-	0x3a, 0x1a, 0xb0, 0xeb, 0x00, 0xc8, 0xc3, 0x13, 0x1e, 0xf7, 0x5f, 0x1c, 0x4b, 0x7e, 0x44, 0x9e,
-	0x3d, 0xe4, 0xf1, 0xa7, 0xc7, 0xec, 0x2d, 0x68, 0x0e, 0x12, 0x12, 0x02, 0xfe, 0xa5, 0xe4, 0xa8,
-	0xc6, 0x20, 0x41, 0xf6, 0xf7, 0x2f, 0xd9, 0xfb, 0xc0, 0xb0, 0xb7, 0x3e, 0xad, 0x02, 0x1f, 0x52,
-	0xf5, 0x49, 0xb7, 0x4d, 0xa5, 0xb0, 0xb3, 0x5b, 0x32, 0x03, 0xdb, 0x49, 0xd8, 0x57, 0x60, 0x41,
-	0x75, 0xf6, 0x64, 0xe4, 0x9f, 0x26, 0xdd, 0x05, 0x2a, 0xd8, 0x96, 0xe0, 0x43, 0xc4, 0xdc, 0xe7,
-	0x42, 0x49, 0x31, 0xd6, 0x56, 0xf2, 0x0c, 0x6e, 0x95, 0x84, 0xd0, 0xba, 0x36, 0x3c, 0x99, 0x2a,
-	0x5b, 0xb4, 0x4a, 0xc9, 0xa2, 0xb9, 0x3f, 0x73, 0xa0, 0x2d, 0x6b, 0xa6, 0x5d, 0x9d, 0xdd, 0x03,
-	0xa6, 0x56, 0x31, 0xbd, 0x08, 0x86, 0xfd, 0xe3, 0xcb, 0x94, 0x27, 0x82, 0x68, 0xf6, 0xae, 0x78,
-	0x25, 0x79, 0xec, 0x7d, 0xe8, 0x58, 0x68, 0x92, 0xc6, 0x82, 0x9e, 0xf7, 0xae, 0x78, 0x85, 0x1c,
-	0x64, 0x2f, 0xd4, 0x1b, 0xa6, 0x69, 0x3f, 0x08, 0x87, 0xfc, 0x82, 0x48, 0x69, 0xc1, 0xb3, 0xb0,
-	0x07, 0x8b, 0xd0, 0x36, 0xbf, 0x73, 0x7f, 0x04, 0x0d, 0xa5, 0x75, 0xd0, 0x8e, 0x9b, 0xeb, 0x97,
-	0x67, 0x20, 0xac, 0x07, 0x0d, 0xbb, 0x17, 0x5e, 0xe3, 0xcb, 0xb4, 0xed, 0xfe, 0x45, 0xe8, 0xec,
-	0x23, 0x11, 0x85, 0x48, 0xb4, 0x52, 0x9d, 0x5a, 0x83, 0x39, 0x83, 0x79, 0x9a, 0x9e, 0x4c, 0xe1,
-	0xa6, 0x76, 0x16, 0x25, 0xa9, 0x6c, 0x87, 0x7e, 0xbb, 0xff, 0xda, 0x01, 0xb6, 0x9b, 0xa4, 0xc1,
-	0xd8, 0x4f, 0xf9, 0x43, 0xae, 0x45, 0xc3, 0x13, 0x68, 0x63, 0x6d, 0x4f, 0xa3, 0x2d, 0xa1, 0xd8,
-	0x88, 0x0d, 0xf9, 0x6b, 0x92, 0x9d, 0x8b, 0x1f, 0x6c, 0x9a, 0xa5, 0x85, 0xd0, 0xb5, 0x2a, 0x40,
-	0x6e, 0x4b, 0xfd, 0xf8, 0x94, 0xa7, 0xa4, 0xf5, 0x48, 0xbd, 0x19, 0x04, 0xb4, 0x1d, 0x85, 0x27,
-	0xbd, 0xdf, 0x83, 0xe5, 0x42, 0x1d, 0xa6, 0x7c, 0x6e, 0x96, 0xc8, 0xe7, 0xaa, 0x29, 0x9f, 0x5f,
-	0xc0, 0x8a, 0xd5, 0x2f, 0x49, 0x71, 0xd7, 0xc5, 0xe6, 0x26, 0x14, 0x4b, 0x52, 0x0d, 0xbc, 0x0c,
-	0x60, 0x1f, 0xc2, 0xda, 0x09, 0xe7, 0xb1, 0x9f, 0x4a, 0x80, 0x18, 0x08, 0x57, 0x46, 0xd6, 0x3f,
-	0x23, 0xd7, 0xfd, 0x85, 0x03, 0x4b, 0x28, 0x51, 0x3f, 0xf3, 0xc3, 0x4b, 0x35, 0x67, 0xfb, 0xa5,
-	0x73, 0x76, 0xc7, 0xd8, 0x9c, 0x8c, 0xd2, 0x5f, 0x76, 0xc2, 0xaa, 0xf9, 0x09, 0x63, 0xb7, 0x60,
-	0x31, 0xd7, 0xe5, 0xba, 0x54, 0x9b, 0x11, 0x3d, 0xe4, 0xf1, 0x83, 0xcb, 0x94, 0xff, 0xfa, 0xd3,
-	0x7a, 0x1b, 0x3a, 0x59, 0xd7, 0xe5, 0x9c, 0x32, 0xa8, 0x21, 0x91, 0xca, 0x0a, 0xe8, 0xb7, 0xfb,
-	0xc7, 0x8e, 0x28, 0xb8, 0x1d, 0x05, 0x5a, 0xdb, 0xc3, 0x82, 0xa8, 0x34, 0xaa, 0x82, 0xf8, 0x7b,
-	0xa6, 0xb6, 0xfc, 0x9b, 0x19, 0x30, 0xca, 0xc8, 0x84, 0xa3, 0x96, 0x31, 0x1a, 0x91, 0x60, 0x6e,
-	0x78, 0xf3, 0x98, 0xde, 0x1a, 0x8d, 0xdc, 0xf7, 0x60, 0xd9, 0xe8, 0xe1, 0x2b, 0xc6, 0x72, 0x00,
-	0x6c, 0x3f, 0x48, 0xd2, 0x67, 0x61, 0x32, 0x31, 0x14, 0xaa, 0xb7, 0xa0, 0x89, 0xd2, 0x17, 0x7b,
-	0x27, 0x28, 0xa9, 0xee, 0xa1, 0x38, 0xc6, 0xbe, 0x25, 0x94, 0xe9, 0x5f, 0xc8, 0xcc, 0x8a, 0xcc,
-	0xf4, 0x2f, 0x28, 0xd3, 0xfd, 0x08, 0x56, 0xac, 0xfa, 0x64, 0xd3, 0xef, 0x42, 0x7d, 0x9a, 0x5e,
-	0x44, 0x4a, 0xdd, 0x6d, 0x49, 0x4a, 0x41, 0xe3, 0xca, 0x13, 0x39, 0xee, 0x27, 0xb0, 0x7c, 0xc0,
-	0x5f, 0x4a, 0xc6, 0x56, 0x1d, 0xb9, 0xfd, 0x5a, 0xc3, 0x8b, 0xf2, 0xdd, 0x4d, 0x60, 0xe6, 0xc7,
-	0xb2, 0x55, 0xc3, 0x0c, 0x73, 0x2c, 0x33, 0xcc, 0xbd, 0x0d, 0xec, 0x28, 0x38, 0x0d, 0x3f, 0xe3,
-	0x49, 0xe2, 0x9f, 0x6a, 0x51, 0xd0, 0x81, 0xea, 0x38, 0x39, 0x95, 0xa2, 0x0b, 0x7f, 0xba, 0xdf,
-	0x80, 0x15, 0xab, 0x5c, 0xc6, 0x69, 0x49, 0x70, 0x1a, 0xfa, 0xe9, 0x34, 0xe6, 0xb2, 0xea, 0x0c,
-	0x70, 0x1f, 0xc2, 0xea, 0x77, 0x79, 0x1c, 0x9c, 0x5c, 0xbe, 0xae, 0x7a, 0xbb, 0x9e, 0x4a, 0xbe,
-	0x9e, 0x5d, 0xb8, 0x9a, 0xab, 0x47, 0x36, 0x2f, 0x48, 0x58, 0xae, 0x64, 0xc3, 0x13, 0x09, 0x43,
-	0x16, 0x56, 0x4c, 0x59, 0xe8, 0x3e, 0x03, 0xb6, 0x1d, 0x85, 0x21, 0x1f, 0xa4, 0x87, 0x9c, 0xc7,
-	0x99, 0x07, 0x28, 0xa3, 0xd7, 0xd6, 0xfd, 0x75, 0x39, 0xb3, 0x79, 0x01, 0x2b, 0x09, 0x99, 0x41,
-	0x6d, 0xc2, 0xe3, 0x31, 0x55, 0xdc, 0xf0, 0xe8, 0xb7, 0x7b, 0x15, 0x56, 0xac, 0x6a, 0xa5, 0xcd,
-	0xfc, 0x01, 0x5c, 0xdd, 0x09, 0x92, 0x41, 0xb1, 0xc1, 0x2e, 0xcc, 0x4f, 0xa6, 0xc7, 0xfd, 0x8c,
-	0x1b, 0x55, 0x12, 0x4d, 0xa8, 0xfc, 0x27, 0xb2, 0xb2, 0xbf, 0xe1, 0x40, 0x6d, 0xef, 0xe9, 0xfe,
-	0x36, 0xee, 0x1d, 0x41, 0x38, 0x88, 0xc6, 0xa8, 0x91, 0x89, 0x41, 0xeb, 0xf4, 0x4c, 0x2e, 0xbb,
-	0x0e, 0x4d, 0x52, 0xe4, 0xd0, 0x6a, 0x94, 0x7a, 0x51, 0x06, 0xa0, 0xc5, 0xca, 0x2f, 0x26, 0x41,
-	0x4c, 0x26, 0xa9, 0x32, 0x34, 0x6b, 0xb4, 0xed, 0x14, 0x33, 0xdc, 0xff, 0x32, 0x07, 0xf3, 0x72,
-	0x33, 0x16, 0x1b, 0x7b, 0x1a, 0x9c, 0xf3, 0x6c, 0x63, 0xc7, 0x14, 0x2a, 0xc9, 0x31, 0x1f, 0x47,
-	0xa9, 0xd6, 0xe7, 0xc4, 0x32, 0xd8, 0x20, 0x59, 0xe4, 0x52, 0xa9, 0x10, 0x36, 0x7c, 0x55, 0x94,
-	0xb2, 0x40, 0x9c, 0x2c, 0xa5, 0x1c, 0x08, 0x6d, 0x4d, 0x25, 0x71, 0x26, 0x06, 0xfe, 0xc4, 0x1f,
-	0x04, 0xe9, 0xa5, 0x14, 0x0a, 0x3a, 0x8d, 0x75, 0x8f, 0xa2, 0x81, 0x3f, 0xea, 0x1f, 0xfb, 0x23,
-	0x3f, 0x1c, 0x70, 0x65, 0xed, 0x5b, 0x20, 0x5a, 0xbe, 0xb2, 0x4b, 0xaa, 0x98, 0xb0, 0x8e, 0x73,
-	0x28, 0xee, 0xe7, 0x83, 0x68, 0x3c, 0x0e, 0x52, 0x34, 0x98, 0x49, 0x4d, 0xab, 0x7a, 0x06, 0xc2,
-	0x6e, 0x42, 0x4b, 0xa6, 0x92, 0xe0, 0x27, 0x9c, 0xb4, 0xb4, 0xaa, 0x67, 0x42, 0x58, 0x43, 0x4e,
-	0x53, 0xab, 0x7a, 0x06, 0x82, 0x6b, 0x30, 0x0d, 0x13, 0x9e, 0xa6, 0x23, 0x3e, 0xd4, 0x9d, 0x69,
-	0x51, 0xb1, 0x62, 0x06, 0x9a, 0x17, 0xc2, 0x7e, 0x17, 0xa2, 0x31, 0x41, 0x33, 0xb7, 0x4d, 0x85,
-	0x0b, 0x38, 0xbb, 0x0f, 0xab, 0x26, 0x16, 0xf3, 0x01, 0x0f, 0xce, 0xf9, 0x90, 0x34, 0xb8, 0xaa,
-	0x57, 0x9a, 0x87, 0xe3, 0x09, 0xa7, 0xe3, 0xfe, 0x74, 0x32, 0xf4, 0x51, 0x81, 0x59, 0xa4, 0x79,
-	0x37, 0x21, 0xf6, 0x01, 0x28, 0x1d, 0x4d, 0x6a, 0x8e, 0x4b, 0x96, 0x34, 0x43, 0x4a, 0xf5, 0xec,
-	0x12, 0x48, 0x84, 0x99, 0x3a, 0xda, 0x91, 0x06, 0x9e, 0x02, 0x88, 0x27, 0xe2, 0xe0, 0xdc, 0x4f,
-	0x79, 0x77, 0x59, 0x08, 0x70, 0x99, 0xc4, 0xef, 0x82, 0x30, 0x48, 0x03, 0x3f, 0x8d, 0xe2, 0x2e,
-	0xa3, 0xbc, 0x0c, 0xc0, 0x89, 0x23, 0x7a, 0x48, 0x52, 0x3f, 0x9d, 0x26, 0x52, 0x3b, 0x5d, 0x11,
-	0x96, 0x4a, 0x21, 0x83, 0x7d, 0x0c, 0x5d, 0x41, 0x01, 0x94, 0x25, 0xf5, 0x6e, 0xa9, 0x26, 0xac,
-	0xd2, 0x84, 0xcc, 0xcc, 0x67, 0xbf, 0x03, 0xd7, 0x24, 0x59, 0x94, 0x7c, 0x7c, 0x95, 0x3e, 0x9e,
-	0x5d, 0x00, 0xfb, 0x89, 0x3d, 0x09, 0x06, 0x7d, 0x59, 0x06, 0xd9, 0x62, 0x8d, 0x46, 0x53, 0xcc,
-	0x70, 0xff, 0xc8, 0x11, 0x9b, 0x87, 0x64, 0xb4, 0xc4, 0x30, 0x93, 0x04, 0x8b, 0xf5, 0xa3, 0x70,
-	0x74, 0x29, 0xb9, 0x0e, 0x04, 0xf4, 0x24, 0x1c, 0x5d, 0xa2, 0xa2, 0x1e, 0x84, 0x66, 0x11, 0x21,
-	0xa7, 0xda, 0x0a, 0xa4, 0x42, 0xef, 0x40, 0x6b, 0x32, 0x3d, 0x1e, 0x05, 0x03, 0x51, 0xa4, 0x2a,
-	0x6a, 0x11, 0x10, 0x15, 0x40, 0x1b, 0x51, 0xcc, 0xbe, 0x28, 0x51, 0xa3, 0x12, 0x2d, 0x89, 0x61,
-	0x11, 0xf7, 0x01, 0xac, 0xda, 0x1d, 0x94, 0x02, 0x79, 0x03, 0x1a, 0x92, 0x7f, 0x93, 0x6e, 0x8b,
-	0x68, 0x62, 0xd1, 0x70, 0x7f, 0xa2, 0x59, 0xa3, 0xf3, 0xdd, 0x7f, 0x56, 0x83, 0x15, 0x89, 0x6e,
-	0x8f, 0xa2, 0x84, 0x1f, 0x4d, 0xc7, 0x63, 0x3f, 0x2e, 0x11, 0x0c, 0xce, 0x6b, 0x04, 0x43, 0xc5,
-	0x16, 0x0c, 0x37, 0x2c, 0x5b, 0x51, 0x48, 0x15, 0x03, 0x61, 0x77, 0x60, 0x69, 0x30, 0x8a, 0x12,
-	0xa1, 0xba, 0x9b, 0x9e, 0xb7, 0x3c, 0x5c, 0x14, 0x64, 0xf5, 0x32, 0x41, 0x66, 0x0a, 0xa2, 0xb9,
-	0x9c, 0x20, 0x72, 0xa1, 0x8d, 0x95, 0x72, 0x25, 0x57, 0xe7, 0xa5, 0xe1, 0x64, 0x60, 0xd8, 0x9f,
-	0x3c, 0xeb, 0x0b, 0x19, 0x93, 0x87, 0xd9, 0x3d, 0x58, 0x21, 0xc7, 0x1e, 0xca, 0x6d, 0xa3, 0xb4,
-	0x10, 0x38, 0x65, 0x59, 0xec, 0x21, 0x80, 0x68, 0x8b, 0x94, 0x07, 0x20, 0xe5, 0xe1, 0xb6, 0xbd,
-	0x22, 0xe6, 0xdc, 0x6f, 0x62, 0x62, 0x1a, 0x73, 0x52, 0x28, 0x8c, 0x2f, 0xdd, 0xbf, 0xe5, 0x40,
-	0xcb, 0xc8, 0x63, 0x57, 0x61, 0x79, 0xfb, 0xc9, 0x93, 0xc3, 0x5d, 0x6f, 0xeb, 0xe9, 0xe3, 0xef,
-	0xee, 0xf6, 0xb7, 0xf7, 0x9f, 0x1c, 0xed, 0x76, 0xae, 0x20, 0xbc, 0xff, 0x64, 0x7b, 0x6b, 0xbf,
-	0xff, 0xf0, 0x89, 0xb7, 0xad, 0x60, 0x87, 0xad, 0x01, 0xf3, 0x76, 0x3f, 0x7b, 0xf2, 0x74, 0xd7,
-	0xc2, 0x2b, 0xac, 0x03, 0xed, 0x07, 0xde, 0xee, 0xd6, 0xf6, 0x9e, 0x44, 0xaa, 0x6c, 0x15, 0x3a,
-	0x0f, 0x9f, 0x1d, 0xec, 0x3c, 0x3e, 0x78, 0xd4, 0xdf, 0xde, 0x3a, 0xd8, 0xde, 0xdd, 0xdf, 0xdd,
-	0xe9, 0xd4, 0xd8, 0x02, 0x34, 0xb7, 0x1e, 0x6c, 0x1d, 0xec, 0x3c, 0x39, 0xd8, 0xdd, 0xe9, 0xd4,
-	0xdd, 0xff, 0xec, 0xc0, 0x55, 0xea, 0xf5, 0x30, 0xcf, 0x20, 0x24, 0x89, 0xa3, 0x09, 0xaa, 0xef,
-	0xd9, 0xb6, 0x64, 0x42, 0x48, 0xfc, 0x82, 0xc5, 0x4f, 0xa2, 0x78, 0xc0, 0x25, 0x7f, 0x00, 0x41,
-	0x0f, 0x11, 0x41, 0xe2, 0x97, 0xcb, 0x2b, 0x4a, 0x08, 0xf6, 0x68, 0x09, 0x4c, 0x14, 0x59, 0x83,
-	0xb9, 0xe3, 0x98, 0xfb, 0x83, 0x33, 0xc9, 0x19, 0x32, 0xc5, 0xbe, 0x9a, 0x59, 0x99, 0x03, 0x9c,
-	0xfd, 0x11, 0x1f, 0x12, 0xc5, 0x34, 0xbc, 0x25, 0x89, 0x6f, 0x4b, 0x18, 0xa5, 0x9a, 0x7f, 0xec,
-	0x87, 0xc3, 0x28, 0xe4, 0x43, 0xa9, 0xb2, 0x66, 0x80, 0x7b, 0x08, 0x6b, 0xf9, 0xf1, 0x49, 0xfe,
-	0xfa, 0xd0, 0xe0, 0x2f, 0xa1, 0x41, 0xf6, 0x66, 0xaf, 0xa6, 0xc1, 0x6b, 0xbf, 0xa8, 0x40, 0x0d,
-	0x15, 0x8a, 0xd9, 0xca, 0x87, 0xa9, 0x23, 0x56, 0x6d, 0x57, 0xfd, 0x0d, 0x00, 0x32, 0x5c, 0xc5,
-	0x4e, 0x23, 0x9d, 0x26, 0x19, 0x92, 0xe5, 0xc7, 0x7c, 0x70, 0x2e, 0xdd, 0x26, 0x06, 0x82, 0xf9,
-	0xc6, 0x4e, 0x25, 0x3d, 0xd4, 0xc6, 0x1e, 0xa5, 0xf3, 0xe9, 0xfb, 0x79, 0x33, 0x9f, 0xbe, 0xef,
-	0xc2, 0x7c, 0x10, 0x92, 0xab, 0x90, 0x18, 0xa3, 0xe1, 0xa9, 0x24, 0x1d, 0x10, 0x10, 0xc3, 0x06,
-	0x63, 0xc5, 0x06, 0x19, 0xc0, 0xee, 0x43, 0x33, 0xb9, 0x0c, 0x07, 0x26, 0xed, 0xaf, 0xca, 0xd9,
-	0xc2, 0xb9, 0xd8, 0x3c, 0xba, 0x0c, 0x07, 0x44, 0xe9, 0x59, 0x31, 0xf7, 0xf7, 0xa0, 0xa1, 0x60,
-	0x24, 0xcf, 0x67, 0x07, 0x9f, 0x1e, 0x3c, 0x79, 0x7e, 0xd0, 0x3f, 0xfa, 0xde, 0xc1, 0x76, 0xe7,
-	0x0a, 0x5b, 0x82, 0xd6, 0xd6, 0x36, 0x51, 0x3c, 0x01, 0x0e, 0x16, 0x39, 0xdc, 0x3a, 0x3a, 0xd2,
-	0x48, 0xc5, 0x65, 0x68, 0x9c, 0x27, 0xa4, 0xbd, 0x69, 0x07, 0xf8, 0x87, 0xb0, 0x6c, 0x60, 0x99,
-	0x25, 0x30, 0x41, 0x20, 0x67, 0x09, 0x90, 0xda, 0x27, 0x72, 0xdc, 0x0e, 0x2c, 0x3e, 0xe2, 0xe9,
-	0xe3, 0xf0, 0x24, 0x52, 0x35, 0xfd, 0xa2, 0x06, 0x4b, 0x1a, 0x92, 0x15, 0xdd, 0x81, 0xa5, 0x60,
-	0xc8, 0xc3, 0x34, 0x48, 0x2f, 0xfb, 0x96, 0x0f, 0x20, 0x0f, 0xa3, 0xba, 0xec, 0x8f, 0x02, 0x5f,
-	0x9d, 0xc5, 0x88, 0x04, 0xaa, 0x08, 0xb8, 0xb7, 0x9b, 0xbe, 0x18, 0xa2, 0x2f, 0xe1, 0x7a, 0x28,
-	0xcd, 0x43, 0x49, 0x84, 0xb8, 0xdc, 0x6a, 0xf4, 0x27, 0x42, 0x6d, 0x2c, 0xcb, 0xc2, 0xa5, 0x12,
-	0x35, 0xe1, 0x90, 0xeb, 0x62, 0xff, 0xd7, 0x40, 0xe1, 0xa0, 0x63, 0x4e, 0xc8, 0xc9, 0xfc, 0x41,
-	0x87, 0x71, 0x58, 0xd2, 0x28, 0x1c, 0x96, 0xa0, 0x1c, 0xbd, 0x0c, 0x07, 0x7c, 0xd8, 0x4f, 0xa3,
-	0x3e, 0xc9, 0x7b, 0xe9, 0x72, 0xce, 0xc3, 0xec, 0x3a, 0xcc, 0xa7, 0x3c, 0x49, 0x43, 0x9e, 0x12,
-	0x59, 0x34, 0x1e, 0x54, 0xba, 0x8e, 0xa7, 0x20, 0xd4, 0xf1, 0xa7, 0x71, 0x90, 0x90, 0xa3, 0xb9,
-	0xe9, 0xd1, 0x6f, 0xf6, 0x4d, 0xb8, 0x7a, 0xcc, 0x93, 0xb4, 0x7f, 0xc6, 0xfd, 0x21, 0x8f, 0x89,
-	0xbc, 0xc4, 0x79, 0x8b, 0xd0, 0xa3, 0xca, 0x33, 0x91, 0x70, 0xcf, 0x79, 0x9c, 0x04, 0x51, 0x48,
-	0x4a, 0x54, 0xd3, 0x53, 0x49, 0xac, 0x0f, 0x07, 0xaf, 0x37, 0x6b, 0x3d, 0x83, 0x4b, 0x34, 0xf0,
-	0xf2, 0x4c, 0x76, 0x0b, 0xe6, 0x68, 0x00, 0x49, 0xb7, 0x43, 0x34, 0xd3, 0xce, 0x78, 0x3f, 0x08,
-	0x3d, 0x99, 0x87, 0xab, 0x3c, 0x88, 0x46, 0x51, 0x4c, 0x9a, 0x54, 0xd3, 0x13, 0x09, 0x7b, 0x76,
-	0x4e, 0x63, 0x7f, 0x72, 0x26, 0xb5, 0xa9, 0x3c, 0xfc, 0xed, 0x5a, 0xa3, 0xd5, 0x69, 0xbb, 0xbf,
-	0x05, 0x75, 0xaa, 0x96, 0xaa, 0xa3, 0xc9, 0x74, 0x64, 0x75, 0x84, 0x76, 0x61, 0x3e, 0xe4, 0xe9,
-	0xcb, 0x28, 0x7e, 0xa1, 0x0e, 0xf6, 0x64, 0xd2, 0xfd, 0x09, 0x59, 0x59, 0xfa, 0x90, 0xeb, 0x19,
-	0xa9, 0x8c, 0x68, 0x2b, 0x8b, 0xa5, 0x4a, 0xce, 0x7c, 0x69, 0xf8, 0x35, 0x08, 0x38, 0x3a, 0xf3,
-	0x51, 0xe6, 0x5a, 0xab, 0x2f, 0x6c, 0xe9, 0x16, 0x61, 0x7b, 0x62, 0xf1, 0x6f, 0xc1, 0xa2, 0x3a,
-	0x3e, 0x4b, 0xfa, 0x23, 0x7e, 0x92, 0x2a, 0xcf, 0x58, 0x38, 0x1d, 0x93, 0xc1, 0xbd, 0xcf, 0x4f,
-	0x52, 0xf7, 0x00, 0x96, 0xa5, 0x1c, 0x7c, 0x32, 0xe1, 0xaa, 0xe9, 0xdf, 0x2e, 0xd3, 0x27, 0x5a,
-	0xf7, 0x57, 0x6c, 0xc1, 0x29, 0x0e, 0x0c, 0xed, 0x92, 0xae, 0x07, 0xcc, 0x94, 0xab, 0xb2, 0x42,
-	0xb9, 0xa9, 0x2b, 0xdf, 0x9f, 0x1c, 0x8e, 0x85, 0xe1, 0xfc, 0x24, 0xd3, 0xc1, 0x40, 0x1d, 0x7c,
-	0x36, 0x3c, 0x95, 0x74, 0xff, 0xb1, 0x03, 0x2b, 0x54, 0x9b, 0xd2, 0x88, 0xe4, 0xde, 0xf5, 0xd1,
-	0x97, 0xe8, 0xa6, 0xf2, 0xbc, 0x0a, 0x7f, 0xe3, 0x2a, 0xd4, 0xcd, 0xdd, 0x4c, 0x24, 0x7e, 0x15,
-	0xdf, 0x4a, 0xad, 0xe8, 0x5b, 0x71, 0xff, 0x9e, 0x03, 0xcb, 0x62, 0x53, 0x21, 0x4d, 0x5a, 0x4e,
-	0xc1, 0xef, 0xc0, 0x82, 0xd0, 0x0e, 0xa4, 0x64, 0x90, 0x9d, 0xcd, 0xc4, 0x2b, 0xa1, 0xa2, 0xf0,
-	0xde, 0x15, 0xcf, 0x2e, 0xcc, 0x3e, 0x21, 0x0d, 0x2d, 0xec, 0x13, 0x5a, 0x72, 0x4c, 0x6e, 0xcf,
-	0xf7, 0xde, 0x15, 0xcf, 0x28, 0xfe, 0xa0, 0x01, 0x73, 0xc2, 0x0c, 0x71, 0x1f, 0xc1, 0x82, 0xd5,
-	0x90, 0xe5, 0xd7, 0x69, 0x0b, 0xbf, 0x4e, 0xc1, 0xa1, 0x5a, 0x29, 0x71, 0xa8, 0xfe, 0x79, 0x15,
-	0x18, 0x12, 0x4c, 0x6e, 0x45, 0x6e, 0xda, 0xa7, 0x12, 0xea, 0xc4, 0x3c, 0x83, 0xd8, 0x26, 0x30,
-	0x23, 0xa9, 0x4e, 0x4a, 0xc4, 0xf6, 0x59, 0x92, 0x83, 0xa2, 0x56, 0x6a, 0x1f, 0xfa, 0x14, 0x82,
-	0xec, 0x75, 0x31, 0xf1, 0xa5, 0x79, 0x28, 0xf6, 0xc4, 0x91, 0x04, 0x59, 0x1a, 0xc2, 0xd2, 0x35,
-	0x90, 0xfc, 0x3a, 0xcf, 0xbd, 0xc1, 0x3a, 0xcf, 0x97, 0xf8, 0xd0, 0x0c, 0x0b, 0xac, 0x61, 0x5b,
-	0x60, 0x1b, 0xd0, 0x51, 0x27, 0x10, 0xfd, 0xb1, 0xec, 0x86, 0xd8, 0x6b, 0x0b, 0x38, 0x96, 0x55,
-	0x46, 0x90, 0x36, 0xf6, 0x40, 0x9c, 0x2a, 0xe4, 0x71, 0xdc, 0x11, 0x32, 0xdf, 0x5a, 0x8b, 0xba,
-	0x9d, 0x01, 0x64, 0x31, 0x21, 0xbd, 0xf4, 0xa7, 0xa1, 0x3c, 0x33, 0xe7, 0x43, 0xb2, 0x72, 0xd1,
-	0x62, 0xca, 0x67, 0xb8, 0x7f, 0xc7, 0x81, 0x0e, 0xae, 0xa0, 0x45, 0xa4, 0x1f, 0x03, 0xf1, 0xc9,
-	0x1b, 0xd2, 0xa8, 0x55, 0x96, 0x7d, 0x04, 0x4d, 0x4a, 0x47, 0x13, 0x1e, 0x4a, 0x0a, 0xed, 0xda,
-	0x14, 0x9a, 0x49, 0x98, 0xbd, 0x2b, 0x5e, 0x56, 0xd8, 0xa0, 0xcf, 0x7f, 0xe7, 0x40, 0x4b, 0xb6,
-	0xf2, 0x2b, 0xfb, 0x6e, 0x7a, 0x46, 0x90, 0x83, 0xa0, 0xab, 0x2c, 0xa6, 0xe1, 0x0e, 0x2c, 0x8d,
-	0xfd, 0x74, 0x1a, 0xe3, 0x0e, 0x6f, 0xf9, 0x6d, 0xf2, 0x30, 0x6e, 0xd7, 0x24, 0x4c, 0x93, 0x7e,
-	0x1a, 0x8c, 0xfa, 0x2a, 0x57, 0x86, 0x13, 0x94, 0x65, 0xa1, 0x4c, 0x49, 0x52, 0xff, 0x94, 0xcb,
-	0x9d, 0x58, 0x24, 0xdc, 0x2e, 0xac, 0x1d, 0x66, 0x67, 0x33, 0x86, 0xe6, 0xed, 0xfe, 0xd3, 0x05,
-	0x58, 0x2f, 0x64, 0xe9, 0x00, 0xa8, 0x15, 0xe1, 0x67, 0x18, 0x05, 0xe3, 0xe3, 0x48, 0x9b, 0x2d,
-	0x8e, 0x34, 0x5b, 0x8a, 0x59, 0xec, 0x14, 0xae, 0x2a, 0x95, 0x03, 0xe7, 0x34, 0xdb, 0x1e, 0x2b,
-	0xb4, 0xef, 0x7d, 0x60, 0x2f, 0x61, 0xbe, 0x41, 0x85, 0x9b, 0x2c, 0x5d, 0x5e, 0x1f, 0x3b, 0x83,
-	0xae, 0xd6, 0x6d, 0xa4, 0xf8, 0x36, 0xf4, 0x1f, 0x6c, 0xeb, 0xfd, 0xd7, 0xb4, 0x65, 0x29, 0xea,
-	0xde, 0xcc, 0xda, 0xd8, 0x25, 0xdc, 0x50, 0x79, 0x24, 0x9f, 0x8b, 0xed, 0xd5, 0xde, 0x68, 0x6c,
-	0x64, 0x82, 0xd8, 0x8d, 0xbe, 0xa6, 0x62, 0xf6, 0x23, 0x58, 0x7b, 0xe9, 0x07, 0xa9, 0xea, 0x96,
-	0xa1, 0x6d, 0xd4, 0xa9, 0xc9, 0xfb, 0xaf, 0x69, 0xf2, 0xb9, 0xf8, 0xd8, 0xda, 0xb4, 0x66, 0xd4,
-	0xd8, 0xfb, 0x57, 0x15, 0x58, 0xb4, 0xeb, 0x41, 0x32, 0x95, 0xbc, 0xaf, 0x24, 0xa2, 0xd2, 0x4f,
-	0x73, 0x70, 0xd1, 0xf2, 0xaf, 0x94, 0x59, 0xfe, 0xa6, 0xbd, 0x5d, 0x7d, 0x9d, 0xe3, 0xaf, 0xf6,
-	0x66, 0x8e, 0xbf, 0x7a, 0xa9, 0xe3, 0xef, 0x55, 0xfe, 0xa2, 0xb9, 0x5f, 0xc7, 0x5f, 0x34, 0xff,
-	0x1a, 0x7f, 0x51, 0xef, 0x7f, 0x39, 0xc0, 0x8a, 0x54, 0xcc, 0x1e, 0x09, 0xa7, 0x47, 0xc8, 0x47,
-	0x52, 0x98, 0x7d, 0xfd, 0xcd, 0x38, 0x41, 0xad, 0x9a, 0xfa, 0x1a, 0x59, 0xd2, 0x8c, 0x44, 0x32,
-	0x15, 0xaf, 0x05, 0xaf, 0x2c, 0x2b, 0xe7, 0x04, 0xad, 0xbd, 0xce, 0x09, 0x5a, 0x7f, 0x9d, 0x13,
-	0x74, 0x2e, 0xef, 0x04, 0xed, 0xfd, 0x75, 0x07, 0x56, 0x4a, 0x48, 0xed, 0x37, 0x37, 0x68, 0x24,
-	0x0e, 0x4b, 0x02, 0x55, 0x24, 0x71, 0x98, 0x60, 0xef, 0xaf, 0xc2, 0x82, 0xc5, 0x5e, 0xbf, 0xb9,
-	0xf6, 0xf3, 0x7a, 0xa3, 0xa0, 0x6e, 0x0b, 0xeb, 0xfd, 0x8f, 0x0a, 0xb0, 0x22, 0x8b, 0xff, 0x7f,
-	0xed, 0x43, 0x71, 0x9e, 0xaa, 0x25, 0xf3, 0xf4, 0xff, 0x74, 0xf7, 0x79, 0x1f, 0x96, 0x65, 0x78,
-	0xa5, 0xe1, 0xe6, 0x12, 0x14, 0x53, 0xcc, 0x40, 0xcd, 0xd9, 0xf6, 0x46, 0x37, 0xac, 0x50, 0x32,
-	0x63, 0x0b, 0xce, 0x39, 0xa5, 0xdd, 0x1e, 0x74, 0xe5, 0x0c, 0xed, 0x9e, 0xf3, 0x30, 0x3d, 0x9a,
-	0x1e, 0x8b, 0xd8, 0xc2, 0x20, 0x0a, 0xdd, 0x3f, 0xab, 0x6a, 0xe5, 0x9f, 0x32, 0xa5, 0x52, 0xf1,
-	0x4d, 0x68, 0x9b, 0x5b, 0x88, 0x5c, 0x8e, 0x9c, 0x97, 0x13, 0xd5, 0x09, 0xb3, 0x14, 0xdb, 0x81,
-	0x45, 0x12, 0x94, 0x43, 0xfd, 0x5d, 0x85, 0xbe, 0x7b, 0x85, 0xf7, 0x66, 0xef, 0x8a, 0x97, 0xfb,
-	0x86, 0xfd, 0x2e, 0x2c, 0xda, 0x26, 0xa1, 0xd4, 0x4c, 0xca, 0x6c, 0x04, 0xfc, 0xdc, 0x2e, 0xcc,
-	0xb6, 0xa0, 0x93, 0xb7, 0x29, 0x65, 0xcc, 0xce, 0x8c, 0x0a, 0x0a, 0xc5, 0xd9, 0x47, 0xf2, 0x18,
-	0xb2, 0x4e, 0xde, 0x94, 0x5b, 0xf6, 0x67, 0xc6, 0x34, 0x6d, 0x8a, 0x3f, 0xc6, 0xc1, 0xe4, 0xef,
-	0x03, 0x64, 0x18, 0xeb, 0x40, 0xfb, 0xc9, 0xe1, 0xee, 0x41, 0x7f, 0x7b, 0x6f, 0xeb, 0xe0, 0x60,
-	0x77, 0xbf, 0x73, 0x85, 0x31, 0x58, 0x24, 0x27, 0xe0, 0x8e, 0xc6, 0x1c, 0xc4, 0xa4, 0xbb, 0x45,
-	0x61, 0x15, 0xb6, 0x0a, 0x9d, 0xc7, 0x07, 0x39, 0xb4, 0xfa, 0xa0, 0xa9, 0xf9, 0xc3, 0x5d, 0x83,
-	0x55, 0x11, 0x3e, 0xfb, 0x40, 0x90, 0x87, 0xd2, 0x50, 0xfe, 0x81, 0x03, 0x57, 0x73, 0x19, 0x59,
-	0x50, 0x97, 0x50, 0x42, 0x6c, 0xcd, 0xc4, 0x06, 0xe9, 0xa8, 0x41, 0xe9, 0x9b, 0x39, 0x09, 0x52,
-	0xcc, 0x40, 0x9a, 0x37, 0xf4, 0xd3, 0x1c, 0x27, 0x95, 0x65, 0xb9, 0xeb, 0x3a, 0x7e, 0x26, 0xd7,
-	0xf1, 0x7f, 0xe3, 0x88, 0xb8, 0x5c, 0x33, 0x27, 0x3b, 0xd7, 0xb5, 0xfb, 0xac, 0x92, 0x68, 0x69,
-	0x58, 0x1a, 0x8f, 0xdd, 0xe1, 0xd2, 0x3c, 0xb4, 0x66, 0xc6, 0xfe, 0x45, 0x5f, 0x3a, 0xd7, 0x94,
-	0x6d, 0x22, 0xba, 0x5c, 0x92, 0x83, 0x63, 0xcc, 0x05, 0xf9, 0x19, 0xc6, 0x4c, 0x59, 0x96, 0xfb,
-	0xa7, 0x55, 0x60, 0xdf, 0x99, 0xf2, 0xf8, 0x92, 0x82, 0xc3, 0xb4, 0xdb, 0x76, 0x3d, 0xef, 0x94,
-	0x9c, 0x9b, 0x4c, 0x8f, 0x3f, 0xe5, 0x97, 0x2a, 0xba, 0xb2, 0x92, 0x45, 0x57, 0x96, 0x45, 0x38,
-	0xd6, 0x5e, 0x1f, 0xe1, 0x58, 0x7f, 0x5d, 0x84, 0xe3, 0x57, 0x60, 0x41, 0x04, 0x26, 0x0e, 0x49,
-	0x1d, 0xc1, 0xfd, 0xbd, 0x8a, 0x46, 0xbd, 0x04, 0x0f, 0x10, 0x63, 0x9f, 0x64, 0x85, 0xf8, 0xf0,
-	0x94, 0xa2, 0x69, 0x4d, 0x41, 0xb3, 0x3b, 0x3c, 0xe5, 0xfb, 0xd1, 0xc0, 0x4f, 0xa3, 0x98, 0x3c,
-	0x4a, 0xea, 0x63, 0xc4, 0x13, 0x34, 0xc3, 0x92, 0x68, 0x8a, 0x0a, 0x9a, 0x1a, 0xab, 0x70, 0x61,
-	0xb5, 0x05, 0x7a, 0x28, 0x46, 0xbc, 0x09, 0x2b, 0xd3, 0x84, 0xf7, 0xc7, 0x41, 0x92, 0xe0, 0xe6,
-	0x3b, 0x88, 0xc2, 0x34, 0x8e, 0x46, 0xd2, 0x91, 0xb5, 0x3c, 0x4d, 0xf8, 0x67, 0x22, 0x67, 0x5b,
-	0x64, 0xb0, 0x6f, 0x66, 0x5d, 0x9a, 0xf8, 0x41, 0x9c, 0x74, 0x81, 0xba, 0xa4, 0x46, 0x8a, 0xfd,
-	0x3e, 0xf4, 0x83, 0x58, 0xf7, 0x05, 0x13, 0xc9, 0x6b, 0xc2, 0x2d, 0x65, 0xb4, 0xde, 0x26, 0x34,
-	0xd4, 0xe7, 0x68, 0x59, 0x9f, 0xc4, 0xd1, 0x58, 0x59, 0xd6, 0xf8, 0x9b, 0x2d, 0x42, 0x25, 0x8d,
-	0xa4, 0x55, 0x5c, 0x49, 0x23, 0xf7, 0x7b, 0xd0, 0x32, 0x66, 0x40, 0x86, 0xec, 0x91, 0xde, 0x26,
-	0x4d, 0xf2, 0x9a, 0x30, 0x93, 0x42, 0x3e, 0x7a, 0x3c, 0x64, 0x5f, 0x83, 0xe5, 0x61, 0x10, 0x73,
-	0x0a, 0xea, 0xed, 0xc7, 0xfc, 0x9c, 0xc7, 0x89, 0x72, 0x60, 0x74, 0x74, 0x86, 0x27, 0x70, 0xb7,
-	0x0f, 0x2b, 0x16, 0xd9, 0x68, 0xc6, 0x9d, 0xa3, 0xf0, 0x42, 0xe5, 0x43, 0xb5, 0x43, 0x0f, 0x65,
-	0x1e, 0x6e, 0x79, 0xd2, 0xf7, 0xd2, 0x9f, 0xc4, 0xd1, 0x31, 0x35, 0xe2, 0x78, 0x16, 0xe6, 0xfe,
-	0x59, 0x05, 0xaa, 0x7b, 0xd1, 0xc4, 0x3c, 0x55, 0x72, 0xec, 0x53, 0x25, 0xa9, 0x9b, 0xf6, 0xb5,
-	0xea, 0x29, 0x95, 0x07, 0x0b, 0x64, 0x1b, 0xb0, 0xe8, 0x8f, 0xd3, 0x7e, 0x1a, 0xa1, 0x2e, 0xfe,
-	0xd2, 0x8f, 0x45, 0x1c, 0x62, 0x95, 0xc8, 0x21, 0x97, 0xc3, 0x56, 0xa1, 0xaa, 0x55, 0x29, 0x2a,
-	0x80, 0x49, 0x34, 0x04, 0xe9, 0xd4, 0xfd, 0x52, 0x3a, 0x49, 0x65, 0x8a, 0x7d, 0x08, 0x6b, 0xf6,
-	0xf7, 0xda, 0x16, 0x17, 0xfb, 0xe2, 0x8c, 0x5c, 0xd4, 0xcb, 0x90, 0x0d, 0xc6, 0x96, 0xe6, 0x69,
-	0x42, 0xe6, 0x91, 0x40, 0xc3, 0x3e, 0x12, 0xb8, 0x09, 0xad, 0x74, 0x74, 0xde, 0x9f, 0xf8, 0x97,
-	0xa3, 0xc8, 0x1f, 0x4a, 0x22, 0x34, 0x21, 0xf7, 0xff, 0x38, 0x50, 0xa7, 0xd9, 0x46, 0x75, 0x40,
-	0xc8, 0x4b, 0x7d, 0x0c, 0x45, 0x33, 0xb8, 0xe0, 0xe5, 0x61, 0xe6, 0x5a, 0x01, 0xe9, 0x15, 0x3d,
-	0x7c, 0x33, 0x28, 0xfd, 0x26, 0x34, 0xe5, 0xd1, 0xb4, 0x0a, 0xae, 0xa6, 0x22, 0x19, 0xc8, 0x6e,
-	0x40, 0xed, 0x2c, 0x9a, 0x28, 0xab, 0x09, 0xd4, 0xc9, 0x73, 0x34, 0xf1, 0x08, 0x47, 0x61, 0x96,
-	0xd5, 0xa7, 0x87, 0x2f, 0xd4, 0xd2, 0x92, 0x1c, 0x14, 0xef, 0xba, 0xf2, 0xdc, 0xd4, 0x16, 0x33,
-	0xdc, 0x67, 0xb0, 0x84, 0xbc, 0x61, 0xb8, 0xe6, 0x67, 0x0b, 0xb1, 0xaf, 0xe2, 0xb6, 0x3b, 0x18,
-	0x4d, 0x87, 0xdc, 0xb4, 0x63, 0xc9, 0xf5, 0x2a, 0x71, 0xa5, 0xbd, 0xb9, 0xff, 0xc4, 0x11, 0x3c,
-	0x87, 0xf5, 0xb2, 0x3b, 0x50, 0x43, 0x51, 0x94, 0x73, 0x5b, 0xe8, 0xc0, 0x14, 0x2c, 0xe7, 0x51,
-	0x09, 0xa4, 0x70, 0x72, 0x8e, 0x9a, 0xb5, 0x0b, 0xd7, 0x68, 0x66, 0x04, 0xde, 0x86, 0x45, 0x31,
-	0x8c, 0x9c, 0xed, 0x94, 0x43, 0xd9, 0xa6, 0x71, 0xc2, 0x54, 0xb3, 0xc4, 0x9b, 0xda, 0xe5, 0x87,
-	0xa7, 0xdc, 0x38, 0x59, 0xfa, 0x13, 0x07, 0x16, 0xac, 0x3e, 0x21, 0xd5, 0x8c, 0xfc, 0x24, 0x95,
-	0xc1, 0x02, 0x92, 0x0a, 0x4c, 0xc8, 0xa4, 0xb8, 0x8a, 0x4d, 0x71, 0xfa, 0x84, 0xa2, 0x6a, 0x9e,
-	0x50, 0xdc, 0x83, 0x66, 0x76, 0x3b, 0xc1, 0xee, 0x14, 0xb6, 0xa8, 0x42, 0x74, 0xb2, 0x42, 0x99,
-	0x0f, 0xbc, 0x6e, 0xf8, 0xc0, 0xdd, 0x4f, 0xa0, 0x65, 0x94, 0x37, 0x7d, 0xd8, 0x8e, 0xe5, 0xc3,
-	0xd6, 0x31, 0x6c, 0x95, 0x2c, 0x86, 0xcd, 0xfd, 0x79, 0x05, 0x16, 0x90, 0xd4, 0x83, 0xf0, 0xf4,
-	0x30, 0x1a, 0x05, 0x83, 0x4b, 0x22, 0x79, 0x45, 0xd5, 0x72, 0x2b, 0x52, 0x24, 0x6f, 0xc3, 0x68,
-	0xb2, 0xea, 0x20, 0x5e, 0x21, 0x37, 0x74, 0x9a, 0x6d, 0x40, 0x07, 0xb9, 0xf1, 0xd8, 0x4f, 0x32,
-	0x2e, 0x15, 0x4b, 0x53, 0xc0, 0x65, 0xe8, 0x62, 0x9f, 0xa2, 0x13, 0xc7, 0xc1, 0x68, 0x14, 0xe8,
-	0x2f, 0x6a, 0x3a, 0x74, 0xb1, 0x24, 0x17, 0xdb, 0x1f, 0x06, 0x89, 0x7f, 0x9c, 0x9d, 0x48, 0xea,
-	0x34, 0xb9, 0xf7, 0xfc, 0x0b, 0xdb, 0xbd, 0x37, 0xa7, 0xa3, 0x96, 0x6d, 0xf7, 0x5e, 0x6e, 0x69,
-	0xe7, 0x0b, 0x4b, 0xeb, 0xfe, 0x79, 0x05, 0x5a, 0x06, 0xa1, 0xc8, 0xc3, 0x78, 0x7b, 0x17, 0x30,
-	0x10, 0x95, 0x6f, 0xd9, 0xfb, 0x06, 0xc2, 0x6e, 0xd9, 0x2d, 0x92, 0xd3, 0x9f, 0x44, 0x81, 0x45,
-	0x50, 0xd7, 0xa1, 0x89, 0x8c, 0xf0, 0x01, 0x39, 0x17, 0xe4, 0x45, 0x21, 0x0d, 0xa8, 0xdc, 0xfb,
-	0x94, 0x5b, 0xcf, 0x72, 0x09, 0x78, 0xe5, 0xf1, 0xfd, 0x47, 0xd0, 0x96, 0xd5, 0xd0, 0x8a, 0xd3,
-	0x80, 0x33, 0x56, 0xb4, 0xa8, 0xc1, 0xb3, 0x4a, 0xaa, 0x2f, 0xef, 0xab, 0x2f, 0x1b, 0xaf, 0xfb,
-	0x52, 0x95, 0x74, 0x1f, 0xe9, 0xa8, 0x88, 0x47, 0xb1, 0x3f, 0x39, 0x53, 0xe2, 0xe5, 0x1e, 0xac,
-	0x28, 0x29, 0x32, 0x0d, 0xfd, 0x30, 0x8c, 0xa6, 0xe1, 0x80, 0xab, 0xc0, 0xb7, 0xb2, 0x2c, 0x77,
-	0xa8, 0xc3, 0xa6, 0xa9, 0x22, 0xb6, 0x01, 0x75, 0xa1, 0xda, 0x88, 0xcd, 0xb2, 0x5c, 0xa0, 0x88,
-	0x22, 0xec, 0x0e, 0xd4, 0x85, 0x86, 0x53, 0x99, 0x29, 0x02, 0x44, 0x01, 0x77, 0x03, 0x96, 0x28,
-	0x4e, 0xdb, 0x96, 0x84, 0xf6, 0x26, 0x3a, 0x37, 0x10, 0x91, 0xdc, 0xab, 0xc0, 0x0e, 0x04, 0x87,
-	0x99, 0x67, 0x9a, 0xbf, 0xac, 0x42, 0xcb, 0x80, 0x51, 0x52, 0xd1, 0x41, 0x54, 0x7f, 0x18, 0xf8,
-	0x63, 0x9e, 0xf2, 0x58, 0x72, 0x55, 0x0e, 0xc5, 0x72, 0xfe, 0xf9, 0x29, 0xea, 0x98, 0xfd, 0x21,
-	0x3f, 0x8d, 0x39, 0x97, 0x3b, 0x7b, 0x0e, 0xc5, 0x72, 0x52, 0x17, 0x55, 0xe5, 0xc4, 0xd1, 0x51,
-	0x0e, 0x55, 0x27, 0x94, 0x62, 0x8e, 0x6a, 0xd9, 0x09, 0xa5, 0x98, 0x91, 0xbc, 0x8c, 0xad, 0x97,
-	0xc8, 0xd8, 0x0f, 0x61, 0x4d, 0x48, 0x53, 0x29, 0x47, 0xfa, 0x39, 0xc2, 0x9a, 0x91, 0x8b, 0x2c,
-	0x88, 0x7d, 0x56, 0x6c, 0x41, 0x0e, 0x94, 0x79, 0x1a, 0x4b, 0x01, 0x57, 0xde, 0x78, 0xab, 0x6c,
-	0x23, 0xf3, 0xc6, 0x17, 0xca, 0xfa, 0x17, 0x76, 0x59, 0xe5, 0xb9, 0xcf, 0xe1, 0xec, 0x23, 0x58,
-	0x1f, 0xf3, 0x61, 0xe0, 0xdb, 0x55, 0xf4, 0x13, 0x3f, 0x95, 0xf1, 0x6a, 0xb3, 0xb2, 0xb1, 0x15,
-	0x9c, 0x85, 0x9f, 0x44, 0xe3, 0xe3, 0x40, 0x6c, 0x71, 0xc2, 0x9d, 0x5f, 0xf3, 0x0a, 0xb8, 0xbb,
-	0x00, 0xad, 0xa3, 0x34, 0x9a, 0xa8, 0xa5, 0x5f, 0x84, 0xb6, 0x48, 0xca, 0x30, 0xc7, 0xb7, 0xe0,
-	0x1a, 0xd1, 0xea, 0xd3, 0x68, 0x12, 0x8d, 0xa2, 0xd3, 0x4b, 0xcb, 0x20, 0xff, 0xb7, 0x0e, 0xac,
-	0x58, 0xb9, 0x99, 0x45, 0x4e, 0x1e, 0x44, 0x15, 0xaf, 0x26, 0xc8, 0x7b, 0xd9, 0xd8, 0x20, 0x44,
-	0x41, 0x71, 0x74, 0xf3, 0x4c, 0x86, 0xb0, 0x6d, 0x65, 0x17, 0x30, 0xd4, 0x87, 0x82, 0xd6, 0xbb,
-	0x45, 0x5a, 0x97, 0xdf, 0xab, 0xab, 0x19, 0xaa, 0x8a, 0xdf, 0x95, 0xc1, 0x3d, 0x43, 0x39, 0xe8,
-	0xaa, 0x1d, 0x90, 0x61, 0x3a, 0x70, 0x54, 0x0f, 0x06, 0x1a, 0x4c, 0xdc, 0x9f, 0x39, 0x00, 0x59,
-	0xef, 0x28, 0x24, 0x44, 0x6f, 0x72, 0xe2, 0x6e, 0xb0, 0xb1, 0xa1, 0xbd, 0x0b, 0x6d, 0x7d, 0x9a,
-	0x9f, 0xed, 0x9b, 0x2d, 0x85, 0xa1, 0x9e, 0xf1, 0x1e, 0x2c, 0x9d, 0x8e, 0xa2, 0x63, 0x52, 0x6c,
-	0x28, 0x6e, 0x36, 0x91, 0xc1, 0x9e, 0x8b, 0x02, 0x7e, 0x28, 0xd1, 0x6c, 0x93, 0xad, 0x99, 0x9b,
-	0x6c, 0xf9, 0x96, 0xf9, 0x87, 0x15, 0x7d, 0xa4, 0x9a, 0xcd, 0xc4, 0x4c, 0x0e, 0x67, 0xf7, 0x0b,
-	0xe2, 0x7c, 0xc6, 0x09, 0x26, 0x59, 0x02, 0x87, 0xaf, 0xf5, 0xe7, 0x7e, 0x02, 0x8b, 0xb1, 0x90,
-	0x95, 0x4a, 0x90, 0xd6, 0x5e, 0x21, 0x48, 0x17, 0x62, 0x6b, 0x7f, 0xfe, 0x2a, 0x74, 0xfc, 0xe1,
-	0x39, 0x8f, 0xd3, 0x80, 0x7c, 0x5b, 0xa4, 0x4c, 0x89, 0xc1, 0x2d, 0x19, 0x38, 0xe9, 0x2c, 0xef,
-	0xc1, 0x92, 0x0c, 0xbb, 0xd5, 0x25, 0xe5, 0x0d, 0xbb, 0x0c, 0xc6, 0x82, 0xee, 0xcf, 0xd5, 0xe9,
-	0xad, 0xbd, 0xb2, 0xb3, 0x67, 0xc4, 0x1c, 0x5d, 0x25, 0x37, 0xba, 0xaf, 0xc8, 0x53, 0xd4, 0xa1,
-	0x72, 0xa0, 0x55, 0x8d, 0xf0, 0xb0, 0xa1, 0x3c, 0xf9, 0xb6, 0xa7, 0xb4, 0xf6, 0x26, 0x53, 0xea,
-	0xfe, 0x47, 0x07, 0xe6, 0xf7, 0xa2, 0xc9, 0x9e, 0x0c, 0x94, 0x23, 0xf6, 0xd0, 0xf1, 0xee, 0x2a,
-	0xf9, 0x8a, 0x10, 0xba, 0x59, 0x3a, 0xc9, 0x42, 0x89, 0x4e, 0xf2, 0x97, 0xe0, 0x2d, 0x72, 0xe2,
-	0xc6, 0xd1, 0x24, 0x8a, 0x91, 0x51, 0xfd, 0x91, 0xd0, 0x3e, 0xa2, 0x30, 0x3d, 0x53, 0x82, 0xf4,
-	0x55, 0x45, 0xc8, 0xb3, 0x82, 0xd6, 0xa8, 0xb0, 0x74, 0xa4, 0x26, 0x25, 0xe4, 0x6b, 0x31, 0xc3,
-	0xfd, 0x6d, 0x68, 0x92, 0xc5, 0x41, 0x83, 0x7b, 0x1f, 0x9a, 0x67, 0xd1, 0xa4, 0x7f, 0x16, 0x84,
-	0xa9, 0x62, 0xfc, 0xc5, 0xcc, 0x14, 0xd8, 0xa3, 0x69, 0xd1, 0x05, 0xdc, 0x3f, 0x98, 0x87, 0xf9,
-	0xc7, 0xe1, 0x79, 0x14, 0x0c, 0xe8, 0xac, 0x78, 0xcc, 0xc7, 0x91, 0xba, 0x03, 0x80, 0xbf, 0xd9,
-	0x75, 0x98, 0xa7, 0x20, 0xd8, 0x89, 0x20, 0xdd, 0xb6, 0x88, 0x0b, 0x91, 0x10, 0x5d, 0x80, 0xcd,
-	0xee, 0xf3, 0x09, 0xd6, 0x32, 0x10, 0xb4, 0xdc, 0x62, 0xf3, 0x3e, 0x9e, 0x4c, 0x65, 0xf7, 0x2c,
-	0xea, 0xc6, 0x3d, 0x0b, 0x6c, 0x4b, 0x86, 0xf7, 0x89, 0xf8, 0x2f, 0xd1, 0x96, 0x84, 0xc8, 0xda,
-	0x8c, 0xb9, 0x70, 0xc0, 0x6b, 0x55, 0x0b, 0xad, 0x4d, 0x13, 0x44, 0x75, 0x4c, 0x7c, 0x20, 0xca,
-	0x88, 0x6d, 0xc0, 0x84, 0x50, 0x45, 0xcd, 0xdf, 0x1c, 0x15, 0x37, 0x77, 0xf3, 0x30, 0x2e, 0xf9,
-	0x90, 0x6b, 0x61, 0x2b, 0xc6, 0x01, 0xe2, 0xce, 0x62, 0x1e, 0x37, 0x6c, 0x54, 0x11, 0xa3, 0xac,
-	0x6c, 0xd4, 0x5b, 0xb0, 0x70, 0xe2, 0x8f, 0x46, 0xc7, 0xfe, 0xe0, 0x05, 0x5d, 0x1c, 0xa6, 0xf3,
-	0xda, 0xa6, 0x67, 0x83, 0x74, 0x52, 0x90, 0xad, 0x2a, 0x45, 0xd0, 0xd4, 0x3c, 0x13, 0x62, 0xf7,
-	0xa1, 0x45, 0xb6, 0xbb, 0x5c, 0xd7, 0x45, 0x5a, 0xd7, 0x8e, 0x69, 0xdc, 0xd3, 0xca, 0x9a, 0x85,
-	0xcc, 0xf3, 0xeb, 0xa5, 0x42, 0x04, 0xb1, 0x3f, 0x1c, 0xca, 0xe3, 0xff, 0x8e, 0xf0, 0x43, 0x68,
-	0x80, 0xbc, 0x03, 0x62, 0xc2, 0x44, 0x81, 0x65, 0x2a, 0x60, 0x61, 0xec, 0x06, 0x34, 0xd0, 0xf8,
-	0x9b, 0xf8, 0xc1, 0x90, 0x82, 0x66, 0x84, 0x31, 0xaa, 0x31, 0xd2, 0x44, 0xe4, 0x6f, 0xc9, 0x2c,
-	0x2b, 0xc2, 0xb6, 0xb2, 0x51, 0xda, 0xe7, 0x15, 0x32, 0xb6, 0xe2, 0x8e, 0x0b, 0x38, 0xfb, 0x80,
-	0x0e, 0x60, 0x53, 0x4e, 0xb1, 0xc5, 0x8b, 0xf7, 0xdf, 0x92, 0xa3, 0x97, 0xe4, 0xab, 0xfe, 0x1e,
-	0x61, 0x11, 0x4f, 0x94, 0x44, 0xa5, 0x4d, 0xf8, 0xbf, 0xd7, 0x2c, 0xa5, 0x4d, 0x16, 0x25, 0xff,
-	0xb7, 0x28, 0xc0, 0x7e, 0x0b, 0xd6, 0x8c, 0x5b, 0xba, 0x99, 0x5b, 0x2f, 0xed, 0xfe, 0x72, 0x9e,
-	0x26, 0x6f, 0x46, 0xb6, 0xbb, 0x05, 0x6d, 0xb3, 0x65, 0xd6, 0x80, 0xda, 0x93, 0xc3, 0xdd, 0x83,
-	0xce, 0x15, 0xd6, 0x82, 0xf9, 0xa3, 0xdd, 0xa7, 0x4f, 0xf7, 0x77, 0x77, 0x3a, 0x0e, 0x6b, 0x43,
-	0x43, 0x87, 0x6f, 0x56, 0x30, 0xb5, 0xb5, 0xbd, 0xbd, 0x7b, 0xf8, 0x74, 0x77, 0xa7, 0x53, 0x75,
-	0xff, 0xb4, 0x02, 0x2d, 0xa3, 0x4b, 0xaf, 0x70, 0xb9, 0xdc, 0x00, 0x20, 0xeb, 0x23, 0x0b, 0xdc,
-	0xa8, 0x79, 0x06, 0x82, 0x84, 0x64, 0x1a, 0xeb, 0x55, 0x41, 0x48, 0x06, 0x84, 0x04, 0x29, 0x2e,
-	0x1b, 0x9a, 0x27, 0x14, 0x75, 0xcf, 0x06, 0xa9, 0x1e, 0x01, 0x50, 0x1c, 0xa1, 0x3c, 0xba, 0x32,
-	0x20, 0x24, 0x92, 0x98, 0x27, 0xd1, 0xe8, 0x9c, 0x8b, 0x22, 0x42, 0x9d, 0xb3, 0x30, 0x6c, 0x4b,
-	0xca, 0x29, 0x23, 0xd6, 0xb7, 0xee, 0xd9, 0x20, 0xfb, 0xba, 0x5a, 0xd6, 0x06, 0x2d, 0xeb, 0x7a,
-	0x71, 0x8d, 0xcc, 0x25, 0x75, 0x53, 0x60, 0x5b, 0xc3, 0xa1, 0xcc, 0x35, 0x6f, 0x54, 0xc6, 0xe6,
-	0xf5, 0x5d, 0x25, 0x69, 0x4a, 0xb8, 0xbd, 0x52, 0xce, 0xed, 0xaf, 0xe4, 0x09, 0x77, 0x17, 0x5a,
-	0x87, 0xc6, 0x85, 0x60, 0x12, 0x7c, 0xea, 0x2a, 0xb0, 0x14, 0x98, 0x06, 0x62, 0x74, 0xa7, 0x62,
-	0x76, 0xc7, 0xfd, 0x87, 0x8e, 0xb8, 0x53, 0xa5, 0xbb, 0x2f, 0xda, 0x76, 0xa1, 0xad, 0xdd, 0xcf,
-	0x59, 0x18, 0xbb, 0x85, 0x61, 0x19, 0xea, 0x4a, 0x3f, 0x3a, 0x39, 0x49, 0xb8, 0x0a, 0x3a, 0xb5,
-	0x30, 0xa5, 0x77, 0x0a, 0x12, 0xa5, 0x16, 0x12, 0x19, 0x7c, 0x5a, 0xc0, 0x71, 0x17, 0x96, 0x3e,
-	0x46, 0x15, 0x6e, 0xab, 0xd3, 0x3a, 0xda, 0x3e, 0x3f, 0xcb, 0x1b, 0xd0, 0xd0, 0xf5, 0xda, 0x5b,
-	0x8b, 0x2a, 0xa9, 0xf3, 0x71, 0x0b, 0x23, 0x7b, 0xd4, 0xea, 0xb4, 0xa0, 0xd8, 0x62, 0x06, 0xdb,
-	0x04, 0x76, 0x12, 0xc4, 0xf9, 0xe2, 0x82, 0x7e, 0x4b, 0x72, 0xdc, 0xe7, 0xb0, 0xa2, 0xb8, 0xce,
-	0x50, 0x88, 0xed, 0x45, 0x74, 0x5e, 0x27, 0xd8, 0x2a, 0x45, 0xc1, 0xe6, 0xfe, 0xef, 0x2a, 0xcc,
-	0xcb, 0x95, 0x2e, 0x5c, 0x2a, 0x17, 0xeb, 0x6c, 0x61, 0xac, 0x6b, 0x5d, 0x19, 0x24, 0x29, 0x28,
-	0xb7, 0xb3, 0xc2, 0x86, 0x55, 0x2d, 0xdb, 0xb0, 0x18, 0xd4, 0x26, 0x7e, 0x7a, 0x46, 0x3e, 0x9c,
-	0xa6, 0x47, 0xbf, 0x95, 0x1b, 0xb4, 0x6e, 0xbb, 0x41, 0xcb, 0xae, 0xd0, 0x0b, 0x8d, 0xac, 0x78,
-	0x85, 0xfe, 0x26, 0xb4, 0xc4, 0xb5, 0x6b, 0xcb, 0xc5, 0x69, 0x40, 0xd8, 0x3b, 0x91, 0x54, 0xb2,
-	0x42, 0x6c, 0x95, 0x36, 0xf8, 0x25, 0x36, 0xcb, 0x6f, 0xc2, 0x9c, 0xb8, 0x58, 0x22, 0xc3, 0x8a,
-	0xaf, 0xab, 0xa3, 0x46, 0x51, 0x4e, 0xfd, 0x15, 0xd1, 0x48, 0x9e, 0x2c, 0x6b, 0x5f, 0x4b, 0x6d,
-	0xe5, 0xaf, 0xa5, 0xe6, 0x1c, 0xb5, 0xed, 0x82, 0xa3, 0xd6, 0x7d, 0x08, 0x0b, 0x56, 0xc5, 0x28,
-	0x73, 0x65, 0x80, 0x72, 0xe7, 0x0a, 0x5b, 0x80, 0xe6, 0xe3, 0x83, 0xfe, 0xc3, 0xfd, 0xc7, 0x8f,
-	0xf6, 0x9e, 0x76, 0x1c, 0x4c, 0x1e, 0x3d, 0xdb, 0xde, 0xde, 0xdd, 0xdd, 0x21, 0x19, 0x0c, 0x30,
-	0xf7, 0x70, 0xeb, 0xf1, 0x3e, 0x49, 0xe0, 0x1d, 0x41, 0xef, 0xb2, 0x2e, 0x7d, 0x0a, 0xf3, 0x75,
-	0x60, 0xca, 0x8d, 0x40, 0x61, 0x49, 0x93, 0x11, 0x4f, 0x55, 0x0c, 0xfd, 0xb2, 0xcc, 0x79, 0xac,
-	0x33, 0xd4, 0x15, 0x90, 0xac, 0x96, 0x8c, 0x6d, 0xe4, 0x74, 0xe5, 0xd9, 0x46, 0x16, 0xf5, 0x74,
-	0xbe, 0xdb, 0x83, 0xee, 0x0e, 0xc7, 0xda, 0xb6, 0x46, 0xa3, 0x5c, 0x77, 0xd0, 0x16, 0x2c, 0xc9,
-	0x93, 0x86, 0xe2, 0x77, 0xe0, 0xea, 0x96, 0x08, 0x97, 0xff, 0x4d, 0x45, 0x51, 0xba, 0x5d, 0x58,
-	0xcb, 0x57, 0x29, 0x1b, 0x7b, 0x08, 0xcb, 0x3b, 0xfc, 0x78, 0x7a, 0xba, 0xcf, 0xcf, 0xb3, 0x86,
-	0x18, 0xd4, 0x92, 0xb3, 0xe8, 0xa5, 0x9c, 0x1f, 0xfa, 0xcd, 0xde, 0x06, 0x18, 0x61, 0x99, 0x7e,
-	0x32, 0xe1, 0x03, 0x75, 0x8d, 0x91, 0x90, 0xa3, 0x09, 0x1f, 0xb8, 0x1f, 0x02, 0x33, 0xeb, 0x91,
-	0xf3, 0x85, 0x4a, 0xdc, 0xf4, 0xb8, 0x9f, 0x5c, 0x26, 0x29, 0x1f, 0xab, 0xfb, 0x99, 0x26, 0xe4,
-	0xbe, 0x07, 0xed, 0x43, 0xff, 0xd2, 0xe3, 0x3f, 0x96, 0x0f, 0x2e, 0xac, 0xc3, 0xfc, 0xc4, 0xbf,
-	0x44, 0x62, 0xd4, 0x9e, 0x66, 0xca, 0x76, 0xff, 0x67, 0x05, 0xe6, 0x44, 0x49, 0xac, 0x75, 0xc8,
-	0x93, 0x34, 0x08, 0x89, 0xfb, 0x54, 0xad, 0x06, 0x54, 0xe0, 0xf7, 0x4a, 0x09, 0xbf, 0x4b, 0x97,
-	0x88, 0xe9, 0x94, 0xcc, 0x00, 0xcc, 0xcd, 0x02, 0xa1, 0x85, 0x03, 0x32, 0x03, 0x72, 0x07, 0x19,
-	0x99, 0x92, 0x28, 0x7a, 0xa6, 0x84, 0x98, 0x64, 0x6a, 0x13, 0x2a, 0x55, 0x45, 0xe7, 0x05, 0xef,
-	0x17, 0x54, 0xd1, 0x82, 0xca, 0xd9, 0x78, 0x03, 0x95, 0x53, 0xdd, 0xd0, 0x9b, 0xad, 0x72, 0xc2,
-	0x1b, 0xa8, 0x9c, 0x2e, 0x83, 0x0e, 0xdd, 0x3a, 0x47, 0xa3, 0x46, 0x51, 0xed, 0x1f, 0x39, 0xd0,
-	0x91, 0xf4, 0xa3, 0xf3, 0xd8, 0xbb, 0x96, 0x09, 0x57, 0x7a, 0x9d, 0x69, 0x03, 0x3a, 0x64, 0x55,
-	0x99, 0x22, 0x40, 0x98, 0x8b, 0x05, 0x5c, 0x49, 0x8a, 0x09, 0x8f, 0xd1, 0x8a, 0x92, 0xeb, 0x62,
-	0x42, 0xb8, 0xdd, 0x29, 0x4f, 0x30, 0x2d, 0x8c, 0xe3, 0xe9, 0xb4, 0xfb, 0x2f, 0x1d, 0x58, 0x36,
-	0xba, 0x2d, 0xa9, 0xf0, 0x13, 0x68, 0xeb, 0x27, 0x1e, 0xb8, 0xde, 0xf0, 0xd6, 0x6d, 0xb6, 0xc9,
-	0x3e, 0xb3, 0x0a, 0xd3, 0x92, 0xfa, 0x97, 0xd4, 0xc7, 0x64, 0x3a, 0x96, 0x3b, 0x8d, 0x09, 0x21,
-	0xb1, 0xbd, 0xe4, 0xfc, 0x85, 0x2e, 0x22, 0xf6, 0x3a, 0x0b, 0xc3, 0xa5, 0x1c, 0xa3, 0x41, 0xa8,
-	0x0b, 0x89, 0x4d, 0xdf, 0x06, 0xdd, 0x3f, 0xac, 0xc0, 0x8a, 0xb0, 0xef, 0xa5, 0x4f, 0x45, 0xdf,
-	0xaa, 0x9d, 0x13, 0x6e, 0x0e, 0xc1, 0x91, 0x7b, 0x57, 0x3c, 0x99, 0x66, 0xdf, 0x7a, 0x43, 0x9f,
-	0x84, 0x8e, 0x33, 0x9e, 0xbd, 0x22, 0xd5, 0x19, 0x2b, 0xf2, 0x8a, 0xf9, 0x2e, 0x3b, 0x25, 0xa8,
-	0x97, 0x9f, 0x12, 0x7c, 0x09, 0x4f, 0xfc, 0x83, 0x79, 0xa8, 0x27, 0x83, 0x68, 0xc2, 0xdd, 0x35,
-	0x58, 0xb5, 0xa7, 0x43, 0x0a, 0xad, 0x9f, 0x39, 0xd0, 0x7d, 0x28, 0xce, 0x02, 0x83, 0xf0, 0x74,
-	0x2f, 0x48, 0xd2, 0x28, 0xd6, 0xcf, 0x16, 0xdc, 0x00, 0x48, 0x52, 0x3f, 0x96, 0x0a, 0xaf, 0xf4,
-	0xca, 0x67, 0x08, 0x8e, 0x87, 0x87, 0x43, 0x91, 0x2b, 0x56, 0x53, 0xa7, 0x0b, 0xaa, 0x99, 0xf4,
-	0x59, 0x58, 0x0a, 0xce, 0x6d, 0x11, 0xad, 0x8f, 0xbd, 0xe6, 0xe7, 0xb4, 0x13, 0x08, 0x37, 0x40,
-	0x0e, 0x75, 0xff, 0x83, 0x03, 0x4b, 0x59, 0x27, 0x29, 0x7e, 0xc4, 0x96, 0x2a, 0x52, 0xab, 0xc9,
-	0xa4, 0x8a, 0x3a, 0x2f, 0x08, 0x50, 0xcd, 0x51, 0x36, 0x41, 0x86, 0x10, 0xa7, 0xcb, 0x54, 0x34,
-	0x55, 0x7a, 0xa3, 0x09, 0x89, 0x48, 0x5b, 0x54, 0xb0, 0xa4, 0xb2, 0x28, 0x53, 0x74, 0xff, 0x69,
-	0x9c, 0xd2, 0x57, 0x62, 0xd2, 0x55, 0x92, 0x75, 0x84, 0x86, 0x22, 0x9e, 0x72, 0xa9, 0xca, 0x60,
-	0x37, 0x93, 0x2c, 0xc4, 0xcb, 0x2d, 0xd6, 0x5e, 0xfd, 0xb7, 0x1d, 0xb8, 0x56, 0x32, 0xfd, 0x92,
-	0xdb, 0x76, 0x60, 0xf9, 0x44, 0x67, 0xaa, 0x29, 0x12, 0x2c, 0xb7, 0xa6, 0x62, 0x14, 0xec, 0x69,
-	0xf1, 0x8a, 0x1f, 0x68, 0xa5, 0x53, 0x4c, 0xba, 0x15, 0xdf, 0x5e, 0xcc, 0x70, 0x0f, 0xa1, 0xb7,
-	0x7b, 0x81, 0xcc, 0xbb, 0x6d, 0x3e, 0x3a, 0xa7, 0x28, 0xe2, 0x7e, 0x41, 0x44, 0xbd, 0xde, 0xcb,
-	0x74, 0x02, 0x0b, 0x56, 0x5d, 0xec, 0x1b, 0x6f, 0x5a, 0x89, 0xc9, 0x67, 0x6a, 0xc5, 0xc4, 0xab,
-	0x79, 0x2a, 0xca, 0xde, 0x80, 0xdc, 0x73, 0x58, 0xfa, 0x6c, 0x3a, 0x4a, 0x83, 0xec, 0x05, 0x3d,
-	0xf6, 0x2d, 0xf9, 0x11, 0x55, 0xa1, 0xa6, 0xae, 0xb4, 0x29, 0xb3, 0x1c, 0xce, 0xd8, 0x18, 0x6b,
-	0xea, 0x17, 0x5b, 0x2c, 0x66, 0xb8, 0xd7, 0x60, 0x3d, 0x6b, 0x52, 0xcc, 0x9d, 0x12, 0xf3, 0x3f,
-	0x77, 0x44, 0x70, 0x98, 0xfd, 0xa0, 0x1f, 0x7b, 0x04, 0x2b, 0x49, 0x10, 0x9e, 0x8e, 0xb8, 0x59,
-	0x4f, 0x22, 0x67, 0xe2, 0xaa, 0xdd, 0x3d, 0xf9, 0xe8, 0x9f, 0x57, 0xf6, 0x05, 0x12, 0x48, 0x79,
-	0x47, 0x33, 0x02, 0xc9, 0x4d, 0x49, 0xd9, 0x00, 0xbe, 0x0d, 0x8b, 0x76, 0x63, 0xec, 0x23, 0x19,
-	0x12, 0x9f, 0xf5, 0xcc, 0x3c, 0x0a, 0xb2, 0x29, 0xc3, 0x2a, 0xe9, 0xfe, 0xd4, 0x81, 0xae, 0xc7,
-	0x91, 0x8c, 0xb9, 0xd1, 0xa8, 0xa4, 0x9e, 0x4f, 0x0a, 0xd5, 0xce, 0x1e, 0xb0, 0x0e, 0xb5, 0x57,
-	0x63, 0xdd, 0x9c, 0xb9, 0x28, 0x7b, 0x57, 0x4a, 0x46, 0xf5, 0xa0, 0x01, 0x73, 0x72, 0x7c, 0xeb,
-	0x70, 0x55, 0x76, 0x49, 0x75, 0x27, 0x3b, 0x47, 0xb0, 0x1a, 0xb5, 0xce, 0x11, 0x7a, 0xd0, 0x15,
-	0xaf, 0x49, 0x98, 0xe3, 0x10, 0x1f, 0x6e, 0x5c, 0x40, 0xcb, 0x78, 0x53, 0x83, 0xad, 0xc3, 0xca,
-	0xf3, 0xc7, 0x4f, 0x0f, 0x76, 0x8f, 0x8e, 0xfa, 0x87, 0xcf, 0x1e, 0x7c, 0xba, 0xfb, 0xbd, 0xfe,
-	0xde, 0xd6, 0xd1, 0x5e, 0xe7, 0x0a, 0x5b, 0x03, 0x76, 0xb0, 0x7b, 0xf4, 0x74, 0x77, 0xc7, 0xc2,
-	0x1d, 0xb6, 0x04, 0x2d, 0x13, 0xa8, 0x20, 0x70, 0xb4, 0xed, 0x3d, 0x3e, 0x7c, 0x2a, 0x80, 0x2a,
-	0x7e, 0xf9, 0xec, 0xe0, 0xd9, 0x51, 0xee, 0xcb, 0xda, 0xc6, 0x27, 0xd0, 0xc9, 0x3b, 0x01, 0x2c,
-	0xc7, 0xc9, 0xab, 0x3c, 0x2c, 0xf7, 0x7f, 0x5a, 0x85, 0x45, 0x11, 0xdb, 0x26, 0xde, 0x8f, 0xe4,
-	0x31, 0xfb, 0x0c, 0xe6, 0xe5, 0x43, 0xa4, 0x4c, 0xad, 0x83, 0xfd, 0xf4, 0x69, 0x6f, 0x2d, 0x0f,
-	0xcb, 0xc9, 0x5b, 0xf9, 0x83, 0x7f, 0xff, 0x5f, 0xff, 0x6e, 0x65, 0x81, 0xb5, 0xee, 0x9e, 0x7f,
-	0x70, 0xf7, 0x94, 0x87, 0x09, 0xd6, 0xf1, 0xfb, 0x00, 0xd9, 0xf3, 0x9a, 0xac, 0xab, 0x0d, 0xe1,
-	0xdc, 0xdb, 0xa3, 0xbd, 0x6b, 0x25, 0x39, 0xb2, 0xde, 0x6b, 0x54, 0xef, 0x8a, 0xbb, 0x88, 0xf5,
-	0x06, 0x61, 0x90, 0x8a, 0xa7, 0x36, 0x3f, 0x76, 0x36, 0xd8, 0x10, 0xda, 0xe6, 0xc3, 0x97, 0x4c,
-	0x9d, 0xa1, 0x94, 0x3c, 0xdd, 0xd9, 0x7b, 0xab, 0x34, 0x4f, 0x2d, 0x3c, 0xb5, 0x71, 0xd5, 0xed,
-	0x60, 0x1b, 0x53, 0x2a, 0x91, 0xb5, 0x32, 0x12, 0xec, 0x90, 0xbd, 0x6f, 0xc9, 0xae, 0x1b, 0x14,
-	0x5a, 0x78, 0x5d, 0xb3, 0xf7, 0xf6, 0x8c, 0x5c, 0xd9, 0xd6, 0xdb, 0xd4, 0xd6, 0xba, 0xcb, 0xb0,
-	0xad, 0x01, 0x95, 0x51, 0xaf, 0x6b, 0x7e, 0xec, 0x6c, 0xdc, 0xff, 0xef, 0xb7, 0xa1, 0xa9, 0xcf,
-	0x56, 0xd9, 0x8f, 0x60, 0xc1, 0x0a, 0x3e, 0x64, 0x6a, 0x18, 0x65, 0xb1, 0x8a, 0xbd, 0xeb, 0xe5,
-	0x99, 0xb2, 0xe1, 0x1b, 0xd4, 0x70, 0x97, 0xad, 0x61, 0xc3, 0x32, 0x78, 0xef, 0x2e, 0x85, 0xd1,
-	0x8a, 0xbb, 0x79, 0x2f, 0x0c, 0xb6, 0x17, 0x8d, 0x5d, 0xcf, 0x73, 0xa2, 0xd5, 0xda, 0xdb, 0x33,
-	0x72, 0x65, 0x73, 0xd7, 0xa9, 0xb9, 0x35, 0xb6, 0x6a, 0x36, 0xa7, 0xcf, 0x3c, 0x39, 0x5d, 0x48,
-	0x35, 0x9f, 0x7d, 0x64, 0x6f, 0x6b, 0xc2, 0x2a, 0x7b, 0x0e, 0x52, 0x93, 0x48, 0xf1, 0x4d, 0x48,
-	0xb7, 0x4b, 0x4d, 0x31, 0x46, 0xcb, 0x67, 0xbe, 0xfa, 0xc8, 0x8e, 0xa1, 0x65, 0xbc, 0xf4, 0xc4,
-	0xae, 0xcd, 0x7c, 0x95, 0xaa, 0xd7, 0x2b, 0xcb, 0x2a, 0x1b, 0x8a, 0x59, 0xff, 0x5d, 0xdc, 0xd5,
-	0x7f, 0x00, 0x4d, 0xfd, 0x56, 0x10, 0x5b, 0x37, 0xde, 0x70, 0x32, 0xdf, 0x37, 0xea, 0x75, 0x8b,
-	0x19, 0x65, 0xc4, 0x67, 0xd6, 0x8e, 0xc4, 0xf7, 0x1c, 0x5a, 0xc6, 0x7b, 0x40, 0x7a, 0x00, 0xc5,
-	0x37, 0x87, 0xf4, 0x00, 0x4a, 0x9e, 0x0f, 0x72, 0x97, 0xa9, 0x89, 0x16, 0x6b, 0x12, 0x7d, 0xa7,
-	0x17, 0x51, 0xc2, 0xf6, 0xe1, 0xaa, 0x14, 0x6f, 0xc7, 0xfc, 0xcb, 0x2c, 0x43, 0xc9, 0x4b, 0x9b,
-	0xf7, 0x1c, 0xf6, 0x09, 0x34, 0xd4, 0xd3, 0x4f, 0x6c, 0xad, 0xfc, 0x19, 0xab, 0xde, 0x7a, 0x01,
-	0x97, 0x6a, 0xcd, 0xf7, 0x00, 0xb2, 0xc7, 0x87, 0xb4, 0x90, 0x28, 0x3c, 0x66, 0xa4, 0x29, 0xa0,
-	0xf8, 0x52, 0x91, 0xbb, 0x46, 0x03, 0xec, 0x30, 0x12, 0x12, 0x21, 0x7f, 0xa9, 0xee, 0xa0, 0xff,
-	0x10, 0x5a, 0xc6, 0xfb, 0x43, 0x7a, 0xfa, 0x8a, 0x6f, 0x17, 0xe9, 0xe9, 0x2b, 0x79, 0xae, 0xc8,
-	0xed, 0x51, 0xed, 0xab, 0xee, 0x12, 0xd6, 0x9e, 0x04, 0xa7, 0xe1, 0x58, 0x14, 0xc0, 0x05, 0x3a,
-	0x83, 0x05, 0xeb, 0x91, 0x21, 0xcd, 0xa1, 0x65, 0x4f, 0x18, 0x69, 0x0e, 0x2d, 0x7d, 0x97, 0x48,
-	0xd1, 0x99, 0xbb, 0x8c, 0xed, 0x9c, 0x53, 0x11, 0xa3, 0xa5, 0xef, 0x43, 0xcb, 0x78, 0x30, 0x48,
-	0x8f, 0xa5, 0xf8, 0x36, 0x91, 0x1e, 0x4b, 0xd9, 0xfb, 0x42, 0xab, 0xd4, 0xc6, 0xa2, 0x4b, 0xa4,
-	0x40, 0xb7, 0xa8, 0xb1, 0xee, 0x1f, 0xc1, 0xa2, 0xfd, 0x84, 0x90, 0xe6, 0xfd, 0xd2, 0xc7, 0x88,
-	0x34, 0xef, 0xcf, 0x78, 0x77, 0x48, 0x92, 0xf4, 0xc6, 0x8a, 0x6e, 0xe4, 0xee, 0xe7, 0x32, 0x56,
-	0xeb, 0x0b, 0xf6, 0x1d, 0x14, 0x70, 0xf2, 0x5a, 0x3b, 0x5b, 0x37, 0xa8, 0xd6, 0xbc, 0xfc, 0xae,
-	0xf9, 0xa5, 0x70, 0x03, 0xde, 0x26, 0x66, 0x71, 0x0f, 0x9c, 0x76, 0x2d, 0xba, 0xde, 0x6e, 0xec,
-	0x5a, 0xe6, 0x0d, 0x78, 0x63, 0xd7, 0xb2, 0x6e, 0xc1, 0xe7, 0x77, 0xad, 0x34, 0xc0, 0x3a, 0x42,
-	0x58, 0xca, 0x5d, 0x90, 0xd0, 0x5c, 0x51, 0x7e, 0x8f, 0xad, 0x77, 0xe3, 0xd5, 0xf7, 0x2a, 0x6c,
-	0x09, 0xa2, 0x84, 0xe0, 0x5d, 0x75, 0x6b, 0xf0, 0xaf, 0x40, 0xdb, 0x7c, 0x16, 0x85, 0x99, 0xac,
-	0x9c, 0x6f, 0xe9, 0xad, 0xd2, 0x3c, 0x7b, 0x71, 0x59, 0xdb, 0x6c, 0x86, 0x7d, 0x17, 0xd6, 0x34,
-	0xab, 0x9b, 0x31, 0xf7, 0x09, 0x7b, 0xa7, 0x24, 0x12, 0xdf, 0x54, 0x7a, 0x7a, 0xd7, 0x66, 0x86,
-	0xea, 0xdf, 0x73, 0x90, 0x68, 0xec, 0xf7, 0x26, 0xb2, 0x0d, 0xa3, 0xec, 0x99, 0x8d, 0x6c, 0xc3,
-	0x28, 0x7d, 0xa4, 0x42, 0x11, 0x0d, 0x5b, 0xb1, 0xe6, 0x48, 0x1c, 0x6a, 0xb3, 0xef, 0xc3, 0x92,
-	0x71, 0xa3, 0xe9, 0xe8, 0x32, 0x1c, 0x68, 0x06, 0x28, 0x5e, 0xc1, 0xed, 0x95, 0xa9, 0xf4, 0xee,
-	0x3a, 0xd5, 0xbf, 0xec, 0x5a, 0x93, 0x83, 0xc4, 0xbf, 0x0d, 0x2d, 0xf3, 0xb6, 0xd4, 0x2b, 0xea,
-	0x5d, 0x37, 0xb2, 0xcc, 0x3b, 0xa3, 0xf7, 0x1c, 0x76, 0x28, 0x02, 0x9a, 0xf4, 0x3b, 0x96, 0x51,
-	0x9c, 0xdf, 0x3e, 0xed, 0xf7, 0x2d, 0xf5, 0x42, 0x96, 0xbd, 0x6c, 0x7a, 0xc7, 0xb9, 0xe7, 0xb0,
-	0xbf, 0xef, 0x40, 0xdb, 0xba, 0xd1, 0x64, 0x85, 0x88, 0xe4, 0x7a, 0xd6, 0x35, 0xf3, 0xcc, 0xae,
-	0xb9, 0x1e, 0x0d, 0x7b, 0x7f, 0xe3, 0xdb, 0xd6, 0xb4, 0x7e, 0x6e, 0xf9, 0x91, 0x36, 0xf3, 0x8f,
-	0x59, 0x7e, 0x91, 0x2f, 0x60, 0x5e, 0x7c, 0xfe, 0xe2, 0x9e, 0xc3, 0xfe, 0xc4, 0x81, 0x45, 0xdb,
-	0xef, 0xa9, 0x87, 0x5b, 0xea, 0x61, 0xd5, 0x8b, 0x3f, 0xc3, 0x59, 0xfa, 0x7d, 0xea, 0xe5, 0xd3,
-	0x0d, 0xcf, 0xea, 0xa5, 0x7c, 0xdb, 0xe4, 0xd7, 0xeb, 0x2d, 0xfb, 0x58, 0x3c, 0xd8, 0xac, 0x4e,
-	0x2c, 0x58, 0xf1, 0x0d, 0x60, 0x4d, 0x30, 0xe6, 0xab, 0xbd, 0xb4, 0x08, 0x3f, 0x14, 0x8f, 0x37,
-	0x2a, 0x07, 0x3a, 0xd2, 0xdd, 0x9b, 0x7e, 0xef, 0xde, 0xa2, 0x31, 0xdd, 0x70, 0xaf, 0x59, 0x63,
-	0xca, 0xef, 0xf0, 0x5b, 0xa2, 0x77, 0xf2, 0xc1, 0xdd, 0x6c, 0x8b, 0x2a, 0x3c, 0xc2, 0x3b, 0xbb,
-	0x93, 0x63, 0xd1, 0x49, 0x59, 0xdc, 0x62, 0x8e, 0x37, 0xac, 0xc6, 0xdd, 0xa0, 0xbe, 0xde, 0x72,
-	0xdf, 0x99, 0xd9, 0xd7, 0xbb, 0xe4, 0xc3, 0xc4, 0x1e, 0x1f, 0x02, 0x64, 0xa7, 0x8b, 0x2c, 0x77,
-	0xba, 0xa5, 0x45, 0x46, 0xf1, 0x00, 0xd2, 0xe6, 0x40, 0x75, 0x08, 0x86, 0x35, 0xfe, 0x40, 0x08,
-	0xc0, 0xc7, 0xea, 0x5c, 0xcc, 0x54, 0x73, 0xec, 0x63, 0x40, 0x4b, 0xcd, 0xc9, 0xd7, 0x6f, 0x89,
-	0x3f, 0x7d, 0xc8, 0xf6, 0x0c, 0x16, 0xf6, 0xa3, 0xe8, 0xc5, 0x74, 0xa2, 0x63, 0x38, 0xec, 0x83,
-	0x85, 0x3d, 0x3f, 0x39, 0xeb, 0xe5, 0x46, 0xe1, 0xde, 0xa4, 0xaa, 0x7a, 0xac, 0x6b, 0x54, 0x75,
-	0xf7, 0xf3, 0xec, 0xf4, 0xf2, 0x0b, 0xe6, 0xc3, 0xb2, 0x96, 0xaa, 0xba, 0xe3, 0x3d, 0xbb, 0x1a,
-	0x4b, 0x96, 0xe6, 0x9b, 0xb0, 0xf4, 0x71, 0xd5, 0xdb, 0xbb, 0x89, 0xaa, 0x93, 0x64, 0x4a, 0x7b,
-	0x87, 0x0f, 0xe8, 0x32, 0x05, 0x79, 0xe7, 0x57, 0xb2, 0x8e, 0x6b, 0xb7, 0x7e, 0x6f, 0xc1, 0x02,
-	0xed, 0x9d, 0x66, 0xe2, 0x5f, 0xc6, 0xfc, 0xc7, 0x77, 0x3f, 0x97, 0x7e, 0xff, 0x2f, 0xd4, 0x4e,
-	0xa3, 0x0e, 0x46, 0xac, 0x9d, 0x26, 0x77, 0x92, 0x62, 0xed, 0x34, 0x85, 0x93, 0x14, 0x6b, 0xaa,
-	0xd5, 0xc1, 0x0c, 0x1b, 0xc1, 0x72, 0xe1, 0xf0, 0x45, 0x6f, 0x32, 0xb3, 0x8e, 0x6c, 0x7a, 0x37,
-	0x67, 0x17, 0xb0, 0x5b, 0xdb, 0xb0, 0x5b, 0x3b, 0x82, 0x85, 0x1d, 0x2e, 0x26, 0x4b, 0x84, 0xaa,
-	0xe6, 0xae, 0xc5, 0x99, 0x81, 0xb0, 0xf9, 0x2d, 0x81, 0xf2, 0x6c, 0x55, 0x82, 0xe2, 0x44, 0xd9,
-	0x0f, 0xa0, 0xf5, 0x88, 0xa7, 0x2a, 0x36, 0x55, 0x2b, 0xb3, 0xb9, 0x60, 0xd5, 0x5e, 0x49, 0x68,
-	0xab, 0x4d, 0x33, 0x54, 0xdb, 0x5d, 0x3e, 0x3c, 0xe5, 0x42, 0x38, 0xf5, 0x83, 0xe1, 0x17, 0xec,
-	0x2f, 0x53, 0xe5, 0x3a, 0x54, 0x7f, 0xcd, 0x08, 0x36, 0x34, 0x2b, 0x5f, 0xca, 0xe1, 0x65, 0x35,
-	0x87, 0xd1, 0x90, 0x1b, 0x4a, 0x55, 0x08, 0x2d, 0xe3, 0xaa, 0x8b, 0x66, 0xa0, 0xe2, 0xad, 0x29,
-	0xcd, 0x40, 0x25, 0x37, 0x63, 0xdc, 0x3b, 0xd4, 0x8e, 0xcb, 0x6e, 0x66, 0xed, 0x88, 0xdb, 0x30,
-	0x59, 0x4b, 0x77, 0x3f, 0xf7, 0xc7, 0xe9, 0x17, 0xec, 0x39, 0xbd, 0x31, 0x64, 0xc6, 0xdf, 0x66,
-	0xda, 0x79, 0x3e, 0x54, 0x57, 0x4f, 0x96, 0x91, 0x65, 0x6b, 0xec, 0xa2, 0x29, 0xd2, 0xbd, 0xbe,
-	0x05, 0x70, 0x94, 0x46, 0x93, 0x1d, 0x9f, 0x8f, 0xa3, 0x30, 0x93, 0xb5, 0x59, 0xf4, 0x67, 0x26,
-	0xbf, 0x8c, 0x10, 0x50, 0xf6, 0xdc, 0x30, 0x67, 0xac, 0xf0, 0x65, 0x45, 0x5c, 0x33, 0x03, 0x44,
-	0xf5, 0x84, 0x94, 0x04, 0x89, 0xde, 0x73, 0xd8, 0x16, 0x40, 0x76, 0xfa, 0xa6, 0x8d, 0x93, 0xc2,
-	0xc1, 0x9e, 0x16, 0x7b, 0x25, 0x47, 0x75, 0x87, 0xd0, 0xcc, 0x0e, 0x75, 0xd6, 0xb3, 0xcb, 0x64,
-	0xd6, 0x11, 0x90, 0xde, 0xc1, 0x0b, 0x87, 0x2c, 0x6e, 0x87, 0xa6, 0x0a, 0x58, 0x03, 0xa7, 0x8a,
-	0x4e, 0x4e, 0x02, 0x58, 0x11, 0x1d, 0xd4, 0x0a, 0x0e, 0x45, 0x2e, 0xaa, 0x91, 0x94, 0x1c, 0x74,
-	0x68, 0x6e, 0x2e, 0xf5, 0xfa, 0x5b, 0x3e, 0x16, 0xa4, 0x56, 0x11, 0x35, 0x89, 0xa2, 0x79, 0x0c,
-	0xcb, 0x05, 0x87, 0xb4, 0x66, 0xe9, 0x59, 0x27, 0x05, 0x9a, 0xa5, 0x67, 0xfa, 0xb2, 0xdd, 0xab,
-	0xd4, 0xe4, 0x92, 0x0b, 0x64, 0x53, 0xbd, 0x0c, 0xd2, 0xc1, 0x19, 0x36, 0xf7, 0x73, 0x07, 0x56,
-	0x4a, 0xfc, 0xcd, 0xec, 0x5d, 0x65, 0x9e, 0xcf, 0xf4, 0x45, 0xf7, 0x4a, 0xdd, 0x91, 0xee, 0x11,
-	0xb5, 0xf3, 0x19, 0xfb, 0xd4, 0xda, 0xd8, 0x84, 0x27, 0x50, 0x72, 0xe6, 0x2b, 0x95, 0x8a, 0x52,
-	0x8d, 0xe2, 0xc7, 0xb0, 0x2e, 0x3a, 0xb2, 0x35, 0x1a, 0xe5, 0x5c, 0xa5, 0x37, 0x0a, 0xff, 0xb7,
-	0xc5, 0x72, 0x01, 0xf7, 0x66, 0xff, 0x5f, 0x97, 0x19, 0x0a, 0xb0, 0xe8, 0x2a, 0x9b, 0x42, 0x27,
-	0xef, 0x7e, 0x64, 0xb3, 0xeb, 0xea, 0xbd, 0x63, 0x19, 0x9a, 0x45, 0x97, 0xa5, 0xfb, 0x17, 0xa8,
-	0xb1, 0x77, 0xdc, 0x5e, 0xd9, 0xbc, 0x08, 0xdb, 0x13, 0xd7, 0xe3, 0xaf, 0x69, 0x5f, 0x69, 0x6e,
-	0x9c, 0xaa, 0x81, 0x59, 0xce, 0x5d, 0x6d, 0xea, 0x96, 0xbb, 0x5a, 0x6f, 0x53, 0xf3, 0x37, 0xdd,
-	0xb7, 0xca, 0x9a, 0x8f, 0xc5, 0x27, 0xc2, 0xe8, 0x5d, 0xcf, 0xf3, 0xb5, 0xea, 0xc1, 0xcd, 0xb2,
-	0xf5, 0x9e, 0x69, 0xbd, 0xe4, 0xe6, 0xfa, 0xca, 0x3d, 0xe7, 0xc1, 0xcd, 0xef, 0xdf, 0x38, 0x0d,
-	0xd2, 0xb3, 0xe9, 0xf1, 0xe6, 0x20, 0x1a, 0xdf, 0x1d, 0xf2, 0x41, 0xcc, 0x87, 0x77, 0x87, 0x83,
-	0x78, 0x14, 0x0e, 0xef, 0xd2, 0x87, 0xc7, 0x73, 0xf4, 0xff, 0x9f, 0xbe, 0xf1, 0x7f, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0x83, 0x44, 0xb8, 0xf3, 0x31, 0x6a, 0x00, 0x00,
+func init() { proto.RegisterFile("rpc.proto", fileDescriptor_rpc_94a2a3a65c6a413e) }
+
+var fileDescriptor_rpc_94a2a3a65c6a413e = []byte{
+	// 14129 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0xbd, 0x5b, 0x6c, 0x24, 0x49,
+	0x76, 0x18, 0xda, 0x59, 0x55, 0x24, 0x8b, 0xa7, 0x8a, 0xc5, 0x62, 0xf0, 0x55, 0x5d, 0xfd, 0xe2,
+	0xe6, 0xce, 0xce, 0xf4, 0xf6, 0xce, 0x76, 0xf7, 0xf4, 0xce, 0xec, 0xce, 0xce, 0x68, 0x1f, 0xd5,
+	0x64, 0xb1, 0x9b, 0x3b, 0x6c, 0x92, 0x9b, 0x24, 0x7b, 0x76, 0x76, 0x75, 0x91, 0x4a, 0x56, 0x05,
+	0xc9, 0xdc, 0xae, 0xca, 0xac, 0xcd, 0xcc, 0xea, 0x6e, 0xee, 0xde, 0xb9, 0x57, 0x90, 0x0c, 0x01,
+	0x7e, 0x08, 0x82, 0x24, 0xcb, 0x30, 0x2c, 0xf9, 0x25, 0x19, 0xf0, 0x0b, 0x90, 0xf5, 0x63, 0x43,
+	0x30, 0x04, 0x4b, 0x1f, 0x06, 0x6c, 0xf8, 0x43, 0x06, 0x64, 0x03, 0x86, 0xbf, 0x0c, 0x03, 0x12,
+	0x60, 0x7d, 0x18, 0xb0, 0x01, 0x3f, 0x00, 0xfb, 0xc7, 0x38, 0xf1, 0xca, 0x88, 0xcc, 0x2c, 0x92,
+	0x3d, 0x33, 0xf2, 0x0f, 0x59, 0x71, 0x4e, 0x64, 0xbc, 0xe3, 0xc4, 0x89, 0xf3, 0x0a, 0x98, 0x8d,
+	0x46, 0xbd, 0xbb, 0xa3, 0x28, 0x4c, 0x42, 0x32, 0x35, 0x08, 0xa2, 0x51, 0xaf, 0x7d, 0xfd, 0x24,
+	0x0c, 0x4f, 0x06, 0xf4, 0x9e, 0x37, 0xf2, 0xef, 0x79, 0x41, 0x10, 0x26, 0x5e, 0xe2, 0x87, 0x41,
+	0xcc, 0x33, 0xd9, 0x3f, 0x03, 0x8d, 0x47, 0x34, 0xd8, 0xa7, 0xb4, 0xef, 0xd0, 0x1f, 0x8d, 0x69,
+	0x9c, 0x90, 0x2f, 0xc1, 0x82, 0x47, 0x7f, 0x4c, 0x69, 0xdf, 0x1d, 0x79, 0x71, 0x3c, 0x3a, 0x8d,
+	0xbc, 0x98, 0xb6, 0xac, 0x35, 0xeb, 0x76, 0xdd, 0x69, 0x72, 0xc4, 0x9e, 0x82, 0x93, 0xcf, 0x41,
+	0x3d, 0xc6, 0xac, 0x34, 0x48, 0xa2, 0x70, 0x74, 0xd6, 0x2a, 0xb1, 0x7c, 0x35, 0x84, 0x75, 0x39,
+	0xc8, 0x1e, 0xc0, 0xbc, 0xaa, 0x21, 0x1e, 0x85, 0x41, 0x4c, 0xc9, 0x7d, 0x58, 0xea, 0xf9, 0xa3,
+	0x53, 0x1a, 0xb9, 0xec, 0xe3, 0x61, 0x40, 0x87, 0x61, 0xe0, 0xf7, 0x5a, 0xd6, 0x5a, 0xf9, 0xf6,
+	0xac, 0x43, 0x38, 0x0e, 0xbf, 0x78, 0x22, 0x30, 0xe4, 0x0d, 0x98, 0xa7, 0x01, 0x87, 0xd3, 0x3e,
+	0xfb, 0x4a, 0x54, 0xd5, 0x48, 0xc1, 0xf8, 0x81, 0xfd, 0x8f, 0x4a, 0xb0, 0xb0, 0x15, 0xf8, 0xc9,
+	0x87, 0xde, 0x60, 0x40, 0x13, 0xd9, 0xa7, 0x37, 0x60, 0xfe, 0x05, 0x03, 0xb0, 0x3e, 0xbd, 0x08,
+	0xa3, 0xbe, 0xe8, 0x51, 0x83, 0x83, 0xf7, 0x04, 0x74, 0x62, 0xcb, 0x4a, 0x13, 0x5b, 0x56, 0x38,
+	0x5c, 0xe5, 0x09, 0xc3, 0xf5, 0x06, 0xcc, 0x47, 0xb4, 0x17, 0x3e, 0xa7, 0xd1, 0x99, 0xfb, 0xc2,
+	0x0f, 0xfa, 0xe1, 0x8b, 0x56, 0x65, 0xcd, 0xba, 0x3d, 0xe5, 0x34, 0x24, 0xf8, 0x43, 0x06, 0x25,
+	0x0f, 0x61, 0xbe, 0x77, 0xea, 0x05, 0x01, 0x1d, 0xb8, 0x47, 0x5e, 0xef, 0xd9, 0x78, 0x14, 0xb7,
+	0xa6, 0xd6, 0xac, 0xdb, 0xb5, 0x07, 0x57, 0xef, 0xb2, 0x59, 0xbd, 0xbb, 0x7e, 0xea, 0x05, 0x0f,
+	0x19, 0x66, 0x3f, 0xf0, 0x46, 0xf1, 0x69, 0x98, 0x38, 0x0d, 0xf1, 0x05, 0x07, 0xc7, 0xe4, 0x0b,
+	0xd0, 0x88, 0x13, 0x2f, 0xa1, 0x03, 0x1a, 0xc7, 0xae, 0x1f, 0xf8, 0x49, 0x6b, 0x7a, 0xcd, 0xba,
+	0x5d, 0x75, 0xe6, 0x14, 0x14, 0x07, 0xca, 0x7e, 0x1f, 0x88, 0x3e, 0x60, 0x62, 0x8a, 0xbe, 0x00,
+	0x0d, 0xaf, 0x3f, 0xf4, 0x03, 0x77, 0xe8, 0xf5, 0xbc, 0x28, 0x0c, 0x03, 0x31, 0x60, 0x73, 0x0c,
+	0xfa, 0x44, 0x00, 0xed, 0x3f, 0xb2, 0x60, 0xf1, 0x30, 0x18, 0x84, 0xbd, 0x67, 0x9f, 0x70, 0xc0,
+	0x0b, 0x46, 0xa4, 0x74, 0xd9, 0x11, 0x29, 0x7f, 0xfa, 0x11, 0xa9, 0x14, 0x8d, 0xc8, 0x37, 0x60,
+	0xc9, 0xec, 0xd3, 0xab, 0x8d, 0xc9, 0xef, 0x5b, 0xb0, 0x8c, 0x8d, 0x39, 0xa1, 0xb2, 0x97, 0x72,
+	0x54, 0xbe, 0x08, 0xcd, 0xde, 0x38, 0x8a, 0x68, 0x90, 0x1b, 0x96, 0x79, 0x01, 0x57, 0xe3, 0xf2,
+	0x39, 0xa8, 0x07, 0xf4, 0x45, 0x9a, 0x4d, 0x6c, 0xac, 0x80, 0xbe, 0x50, 0x59, 0xf2, 0xbd, 0x29,
+	0x17, 0xf4, 0x86, 0xbc, 0x05, 0xcb, 0x58, 0x92, 0x6c, 0xb3, 0x1b, 0x85, 0x61, 0xe2, 0x3e, 0xa3,
+	0x67, 0xa2, 0xef, 0x24, 0xa0, 0x2f, 0x64, 0xd3, 0x9d, 0x30, 0x4c, 0x3e, 0xa0, 0x67, 0xf6, 0xb7,
+	0x60, 0x25, 0xdb, 0x81, 0x57, 0x1b, 0x82, 0x55, 0x58, 0xde, 0x1f, 0x1f, 0xc5, 0xbd, 0xc8, 0x3f,
+	0xa2, 0xfb, 0xd8, 0x1a, 0x31, 0x02, 0xf6, 0x43, 0x58, 0xc9, 0x22, 0x44, 0xc9, 0xb7, 0x61, 0x8a,
+	0xb5, 0x9b, 0x15, 0xd8, 0x78, 0x40, 0xc4, 0xac, 0x6e, 0x78, 0xb8, 0xcf, 0x78, 0x56, 0x9e, 0xc1,
+	0x5e, 0x40, 0x82, 0x92, 0x18, 0xc5, 0xfe, 0x14, 0x34, 0x53, 0xd0, 0x2b, 0x17, 0xf8, 0x27, 0x16,
+	0x54, 0x0e, 0x93, 0x97, 0x21, 0xb9, 0x0b, 0x95, 0xe4, 0x6c, 0x94, 0xfd, 0xa2, 0xd3, 0xef, 0x47,
+	0x34, 0x8e, 0x0f, 0xce, 0x46, 0xd4, 0xa9, 0x7b, 0x3c, 0xe1, 0x62, 0x3e, 0xd2, 0x82, 0x19, 0x91,
+	0x66, 0xf3, 0x33, 0xeb, 0xc8, 0x24, 0xb1, 0xa1, 0xee, 0x0d, 0xc3, 0x71, 0x90, 0xb8, 0x5e, 0x12,
+	0x0e, 0xf9, 0x52, 0x2d, 0x3b, 0x06, 0x8c, 0x5c, 0x87, 0xd9, 0xd1, 0x33, 0x17, 0xc7, 0x62, 0xc4,
+	0x17, 0xe2, 0xac, 0x93, 0x02, 0xc8, 0x97, 0xa0, 0x1a, 0x8e, 0x93, 0x51, 0xe8, 0x07, 0x89, 0xd8,
+	0xfa, 0xf3, 0xa2, 0x3d, 0xbb, 0xe3, 0x64, 0x0f, 0xc1, 0x8e, 0xca, 0x40, 0x5e, 0x83, 0xb9, 0x5e,
+	0x18, 0x1c, 0xfb, 0xd1, 0x90, 0x13, 0x77, 0xb6, 0xd3, 0xcb, 0x8e, 0x09, 0xb4, 0xff, 0x52, 0x19,
+	0x6a, 0x07, 0x91, 0x17, 0xc4, 0x5e, 0x0f, 0x01, 0xd8, 0xfc, 0xe4, 0xa5, 0x7b, 0xea, 0xc5, 0xa7,
+	0xac, 0xc7, 0xb3, 0x8e, 0x4c, 0x92, 0x15, 0x98, 0xe6, 0x4d, 0x65, 0xfd, 0x2a, 0x3b, 0x22, 0x45,
+	0xde, 0x84, 0x85, 0x60, 0x3c, 0x74, 0xcd, 0xba, 0xca, 0x6c, 0xbf, 0xe6, 0x11, 0xe4, 0x26, 0xc0,
+	0x11, 0x6e, 0x23, 0x5e, 0x05, 0xef, 0xa1, 0x06, 0xc1, 0x41, 0x12, 0x29, 0xea, 0x9f, 0x9c, 0xf2,
+	0x6e, 0x4e, 0x39, 0x06, 0x0c, 0xcb, 0x48, 0xfc, 0x21, 0x75, 0xe3, 0xc4, 0x1b, 0x8e, 0x44, 0xb7,
+	0x34, 0x08, 0xc3, 0x87, 0x89, 0x37, 0x70, 0x8f, 0x29, 0x8d, 0x5b, 0x33, 0x02, 0xaf, 0x20, 0xe4,
+	0x75, 0x68, 0xf4, 0x69, 0x9c, 0xb8, 0x62, 0x62, 0x68, 0xdc, 0xaa, 0x32, 0x52, 0x9e, 0x81, 0x62,
+	0x39, 0x91, 0xf7, 0xc2, 0xc5, 0x01, 0xa0, 0x2f, 0x5b, 0xb3, 0xbc, 0xad, 0x29, 0x84, 0xbc, 0x0f,
+	0x0d, 0x1c, 0xed, 0x71, 0xe2, 0xf6, 0x69, 0xe2, 0xf9, 0x83, 0xb8, 0x05, 0x6b, 0xe5, 0xdb, 0xb5,
+	0x07, 0x8b, 0xe9, 0xa4, 0x8c, 0xc6, 0xc9, 0x06, 0xc3, 0x39, 0x99, 0xac, 0x64, 0x09, 0xa6, 0x06,
+	0xde, 0x11, 0x1d, 0xb4, 0x6a, 0xac, 0x5c, 0x9e, 0xb0, 0x5f, 0xc2, 0xca, 0x23, 0x9a, 0x68, 0x13,
+	0x12, 0x4b, 0x3a, 0xd1, 0x86, 0xaa, 0xd7, 0xeb, 0xe1, 0x88, 0xc7, 0xec, 0x4c, 0x9c, 0x73, 0x54,
+	0x1a, 0x1b, 0x8a, 0xfb, 0xcf, 0x98, 0x1e, 0x0d, 0x82, 0x83, 0xca, 0x8a, 0x77, 0x8f, 0xfd, 0x41,
+	0x42, 0x23, 0x36, 0x3b, 0xb3, 0x8e, 0x01, 0xb3, 0xb7, 0x81, 0x68, 0xd5, 0x6e, 0x88, 0x56, 0x7e,
+	0x15, 0xea, 0x89, 0xd6, 0x18, 0x56, 0x73, 0x4d, 0xed, 0x02, 0xed, 0x03, 0xc7, 0xc8, 0x67, 0x3f,
+	0x82, 0xea, 0x26, 0xa5, 0xdb, 0xfe, 0xd0, 0x4f, 0xc8, 0x0a, 0x4c, 0x1d, 0xfb, 0x2f, 0x29, 0x27,
+	0x6b, 0xe5, 0xc7, 0x57, 0x1c, 0x9e, 0x24, 0x6d, 0x98, 0x19, 0xd1, 0xa8, 0x47, 0x65, 0x93, 0x1f,
+	0x5f, 0x71, 0x24, 0xe0, 0xe1, 0x0c, 0x4c, 0x0d, 0xf0, 0x63, 0xfb, 0x9f, 0x4c, 0x41, 0x6d, 0x9f,
+	0x06, 0x8a, 0x5c, 0x12, 0xa8, 0xe0, 0x2c, 0x09, 0x12, 0xc3, 0x7e, 0x93, 0x5b, 0x50, 0xc3, 0xff,
+	0x6e, 0x9c, 0x44, 0x7e, 0x70, 0x22, 0xb6, 0x1d, 0x20, 0x68, 0x9f, 0x41, 0x48, 0x13, 0xca, 0xde,
+	0x30, 0x11, 0x1b, 0x0e, 0x7f, 0x22, 0x29, 0x1d, 0x79, 0x67, 0x43, 0xa4, 0xba, 0x6a, 0x21, 0xd6,
+	0x9d, 0x9a, 0x80, 0x3d, 0xc6, 0x95, 0x78, 0x17, 0x16, 0xf5, 0x2c, 0xb2, 0xf4, 0x29, 0x56, 0xfa,
+	0x82, 0x96, 0x53, 0x54, 0xf2, 0x06, 0xcc, 0xcb, 0xfc, 0x11, 0x6f, 0x2c, 0x5b, 0x9a, 0xb3, 0x4e,
+	0x43, 0x80, 0x65, 0x17, 0x6e, 0x43, 0xf3, 0xd8, 0x0f, 0xbc, 0x81, 0xdb, 0x1b, 0x24, 0xcf, 0xdd,
+	0x3e, 0x1d, 0x24, 0x1e, 0x5b, 0xa4, 0x53, 0x4e, 0x83, 0xc1, 0xd7, 0x07, 0xc9, 0xf3, 0x0d, 0x84,
+	0x92, 0x37, 0x61, 0xf6, 0x98, 0x52, 0x97, 0x8d, 0x44, 0xab, 0x6a, 0x6c, 0x78, 0x39, 0xba, 0x4e,
+	0xf5, 0x58, 0xfc, 0xc2, 0x72, 0xc3, 0x71, 0x72, 0x12, 0xfa, 0xc1, 0x89, 0x8b, 0x87, 0x9c, 0xeb,
+	0xf7, 0x5b, 0xb0, 0x66, 0xdd, 0xae, 0x38, 0x0d, 0x09, 0x47, 0x0a, 0xbe, 0xd5, 0x27, 0xef, 0xc0,
+	0xaa, 0x7f, 0x12, 0x84, 0x11, 0x75, 0x87, 0xde, 0x4b, 0x37, 0x1c, 0x27, 0x47, 0xe1, 0x38, 0xe8,
+	0xbb, 0x38, 0x46, 0xb3, 0xec, 0x00, 0x58, 0xe2, 0xe8, 0x27, 0xde, 0xcb, 0x5d, 0x81, 0xec, 0x0c,
+	0x13, 0x72, 0x03, 0x80, 0x35, 0x99, 0xb7, 0x07, 0xd7, 0xed, 0x9c, 0x33, 0x8b, 0x10, 0x5e, 0xff,
+	0x7b, 0x50, 0x65, 0xd3, 0x90, 0x0c, 0x9e, 0xb7, 0xea, 0x6c, 0x9d, 0xdc, 0x12, 0x8d, 0xd5, 0x26,
+	0xf0, 0xee, 0x06, 0x8d, 0x93, 0x83, 0xc1, 0x73, 0xe4, 0x04, 0xcf, 0x9c, 0x99, 0x3e, 0x4f, 0xf1,
+	0x19, 0x1a, 0xb5, 0xe6, 0x58, 0xed, 0xf8, 0x33, 0xdd, 0x1f, 0x0d, 0x6d, 0x7f, 0x20, 0x7d, 0x8c,
+	0xe8, 0x31, 0x8d, 0x68, 0xd0, 0xa3, 0xad, 0x79, 0x4e, 0x1f, 0x15, 0x80, 0xec, 0xc0, 0xf2, 0x38,
+	0x88, 0xcf, 0x82, 0x1e, 0xed, 0xbb, 0x27, 0x91, 0x37, 0x3a, 0x75, 0xf9, 0x7a, 0x6c, 0x35, 0x19,
+	0xf1, 0x6e, 0x8b, 0xe6, 0x1c, 0x8a, 0x3c, 0x8f, 0x30, 0x4b, 0x87, 0x2f, 0xdf, 0xc5, 0x71, 0x1e,
+	0xd8, 0x7e, 0x0f, 0xea, 0x7a, 0x73, 0xb1, 0x95, 0x78, 0x48, 0x5a, 0x6c, 0x50, 0xf1, 0x27, 0xb6,
+	0xf2, 0xb9, 0x37, 0x18, 0x53, 0x71, 0x16, 0xf3, 0xc4, 0x7b, 0xa5, 0x77, 0x2d, 0xfb, 0x77, 0x2d,
+	0xa8, 0xf3, 0x7e, 0x8b, 0xb3, 0xe7, 0x35, 0x98, 0x93, 0xeb, 0x83, 0x46, 0x51, 0x18, 0x09, 0xfa,
+	0x6a, 0x02, 0xc9, 0x1d, 0x68, 0x4a, 0xc0, 0x28, 0xa2, 0xfe, 0xd0, 0x3b, 0x91, 0x65, 0xe7, 0xe0,
+	0xe4, 0x41, 0x5a, 0x62, 0x14, 0x8e, 0x13, 0x2a, 0x98, 0x9f, 0xba, 0xe8, 0xa6, 0x83, 0x30, 0xc7,
+	0xcc, 0x82, 0xa4, 0xa0, 0x60, 0xe1, 0x1b, 0x30, 0xfb, 0x97, 0x2d, 0x20, 0xd8, 0xf4, 0x83, 0x90,
+	0x17, 0x21, 0xd6, 0x6d, 0x76, 0xcf, 0x58, 0x97, 0xde, 0x33, 0xa5, 0x49, 0x7b, 0xc6, 0x86, 0x29,
+	0xde, 0xf2, 0x4a, 0x41, 0xcb, 0x39, 0xea, 0x3b, 0x95, 0x6a, 0xb9, 0x59, 0xb1, 0xff, 0x56, 0x05,
+	0x96, 0xd6, 0x39, 0xe7, 0xd6, 0xe9, 0xf5, 0xe8, 0x48, 0xed, 0xa6, 0x5b, 0x50, 0x0b, 0xc2, 0x3e,
+	0x75, 0x47, 0xe3, 0x23, 0x39, 0x37, 0x75, 0x07, 0x10, 0xb4, 0xc7, 0x20, 0x6c, 0xd5, 0x9e, 0x7a,
+	0x7e, 0xc0, 0x1b, 0xcd, 0xc7, 0x72, 0x96, 0x41, 0x58, 0x93, 0x5f, 0x87, 0xf9, 0x11, 0x0d, 0xfa,
+	0xfa, 0xa6, 0xe1, 0x9c, 0xfa, 0x9c, 0x00, 0x8b, 0x3d, 0x73, 0x0b, 0x6a, 0xc7, 0x63, 0x9e, 0x0f,
+	0xf7, 0x49, 0x85, 0xad, 0x01, 0x10, 0x20, 0xdc, 0x1d, 0x57, 0xa1, 0x3a, 0x1a, 0xc7, 0xa7, 0x0c,
+	0x3b, 0xc5, 0xb0, 0x33, 0x98, 0x16, 0x1b, 0xa7, 0x3f, 0x8e, 0x13, 0xb1, 0x71, 0xa6, 0x19, 0x72,
+	0x16, 0x21, 0x7c, 0xe3, 0x7c, 0x19, 0x16, 0x71, 0x1f, 0xb2, 0xb5, 0xe3, 0xfa, 0x81, 0x7b, 0x3c,
+	0x60, 0x47, 0xdf, 0x0c, 0xcb, 0xd7, 0x1c, 0x7a, 0x2f, 0x9f, 0x22, 0x66, 0x2b, 0xd8, 0x64, 0x70,
+	0x24, 0x34, 0x92, 0xeb, 0x8d, 0x68, 0x4c, 0xa3, 0xe7, 0x94, 0xd1, 0x86, 0x8a, 0x62, 0x6d, 0x1d,
+	0x0e, 0xc5, 0x16, 0xe1, 0x21, 0x70, 0x9a, 0x0c, 0x7a, 0x6c, 0x5f, 0x57, 0x9c, 0x99, 0xa1, 0x1f,
+	0x3c, 0x4e, 0x06, 0x3d, 0x72, 0x1d, 0x00, 0x29, 0xcb, 0x88, 0x46, 0xee, 0xb3, 0x23, 0x41, 0x25,
+	0x90, 0x92, 0xec, 0xd1, 0xe8, 0x83, 0x23, 0x72, 0x0d, 0x66, 0x7b, 0x31, 0x23, 0x4d, 0xde, 0x99,
+	0xd8, 0xe7, 0xd5, 0x5e, 0x8c, 0x44, 0xc9, 0x3b, 0x23, 0x6f, 0x02, 0xc1, 0xd6, 0x7a, 0x6c, 0x16,
+	0x68, 0x9f, 0x15, 0x1f, 0xb7, 0xea, 0x2c, 0x17, 0x36, 0xb6, 0x23, 0x10, 0x58, 0x4f, 0x4c, 0x3e,
+	0x0f, 0x73, 0xb2, 0xb1, 0xc7, 0x03, 0xef, 0x24, 0x66, 0x5b, 0x7c, 0xce, 0xa9, 0x0b, 0xe0, 0x26,
+	0xc2, 0xc8, 0x1d, 0x58, 0x88, 0x13, 0x2f, 0xf1, 0x7b, 0x6e, 0x44, 0x87, 0x61, 0x42, 0x19, 0x2b,
+	0xda, 0x60, 0xb4, 0x60, 0x9e, 0x23, 0x1c, 0x06, 0xff, 0x80, 0x9e, 0x31, 0xfe, 0x2a, 0xe8, 0x9d,
+	0x86, 0x51, 0xcc, 0xf6, 0x7f, 0xd5, 0x91, 0x49, 0xfb, 0x43, 0x58, 0xce, 0xac, 0x10, 0xb1, 0xf3,
+	0x90, 0x73, 0x61, 0x10, 0xb6, 0x3a, 0xaa, 0x8e, 0x48, 0x15, 0x4d, 0x7d, 0xa9, 0x60, 0xea, 0xed,
+	0xdf, 0xb4, 0xa0, 0x2e, 0x4a, 0x66, 0x4c, 0x16, 0xb9, 0x0f, 0x44, 0xae, 0x85, 0xe4, 0xa5, 0xdf,
+	0x77, 0x8f, 0xce, 0x12, 0x1a, 0xf3, 0xa5, 0xf7, 0xf8, 0x8a, 0x53, 0x80, 0x23, 0x6f, 0x42, 0xd3,
+	0x80, 0xc6, 0x49, 0xc4, 0x77, 0xc5, 0xe3, 0x2b, 0x4e, 0x0e, 0x83, 0x9b, 0x54, 0x70, 0x0b, 0x7e,
+	0xd0, 0xa7, 0x2f, 0xd9, 0x82, 0x9c, 0x73, 0x0c, 0xd8, 0xc3, 0x06, 0xd4, 0xf5, 0xef, 0xec, 0x1f,
+	0x42, 0x55, 0x32, 0x81, 0x8c, 0x01, 0xca, 0xb4, 0xcb, 0xd1, 0x20, 0xc8, 0x4b, 0x98, 0xad, 0x70,
+	0xaa, 0xaf, 0x52, 0xb7, 0xfd, 0x4d, 0x68, 0x6e, 0xe3, 0x52, 0x0c, 0x70, 0xe9, 0x0b, 0xee, 0x76,
+	0x05, 0xa6, 0xb5, 0x2d, 0x38, 0xeb, 0x88, 0x14, 0x1e, 0xd8, 0xa7, 0x61, 0x9c, 0x88, 0x7a, 0xd8,
+	0x6f, 0xfb, 0x5f, 0x58, 0x40, 0xba, 0x71, 0xe2, 0x0f, 0xbd, 0x84, 0x6e, 0x52, 0x45, 0x60, 0x76,
+	0xa1, 0x8e, 0xa5, 0x1d, 0x84, 0x1d, 0xce, 0xc8, 0x70, 0x66, 0xe3, 0x4b, 0x82, 0x28, 0xe4, 0x3f,
+	0xb8, 0xab, 0xe7, 0xe6, 0x07, 0x8a, 0x51, 0x00, 0xee, 0xd9, 0xc4, 0x8b, 0x4e, 0x68, 0xc2, 0x98,
+	0x50, 0x71, 0x89, 0x04, 0x0e, 0x5a, 0x0f, 0x83, 0xe3, 0xf6, 0xb7, 0x60, 0x21, 0x57, 0x86, 0x4e,
+	0xe5, 0x67, 0x0b, 0xa8, 0x7c, 0x59, 0xa7, 0xf2, 0xcf, 0x60, 0xd1, 0x68, 0x97, 0x58, 0x71, 0xd7,
+	0xf9, 0xc1, 0xcd, 0xf9, 0x7c, 0xc6, 0xf6, 0x38, 0x29, 0x80, 0x7c, 0x15, 0x56, 0x8e, 0x29, 0x8d,
+	0xbc, 0x44, 0x00, 0xd8, 0x36, 0xc4, 0x99, 0x11, 0xe5, 0x4f, 0xc0, 0xda, 0xff, 0xa3, 0x04, 0xf3,
+	0x48, 0x97, 0x9f, 0x78, 0xc1, 0x99, 0x1c, 0xb3, 0xed, 0xc2, 0x31, 0xbb, 0xad, 0x1d, 0xbc, 0x5a,
+	0xee, 0x57, 0x1d, 0xb0, 0x72, 0x76, 0xc0, 0xc8, 0x6b, 0xd0, 0xc8, 0x34, 0x79, 0x4a, 0xdc, 0x62,
+	0x10, 0xba, 0x47, 0xa3, 0x87, 0x67, 0x09, 0x45, 0xfa, 0x81, 0x84, 0x07, 0xcb, 0xe0, 0xd7, 0x8e,
+	0x29, 0x07, 0x29, 0x11, 0x96, 0x10, 0xa3, 0x70, 0x24, 0xc6, 0xfd, 0xe5, 0x8e, 0x03, 0x71, 0x35,
+	0xa0, 0x7d, 0x46, 0xeb, 0xaa, 0x4e, 0x93, 0x21, 0x0e, 0x53, 0x38, 0xf9, 0x32, 0xcc, 0xca, 0x0b,
+	0x0d, 0xe7, 0xd2, 0x0b, 0xae, 0x3c, 0x69, 0x8e, 0x94, 0x69, 0x98, 0xd5, 0x98, 0x86, 0x4f, 0x3f,
+	0xcb, 0xaf, 0x43, 0x33, 0x1d, 0x49, 0x31, 0xc5, 0x04, 0x2a, 0xb8, 0x67, 0x44, 0x01, 0xec, 0x37,
+	0x0a, 0x9a, 0x58, 0xc6, 0xf5, 0xd0, 0x4f, 0x19, 0x77, 0x02, 0x15, 0xbc, 0x52, 0xc8, 0x8c, 0xf8,
+	0x7b, 0xe2, 0x5d, 0xea, 0x33, 0x1a, 0xff, 0xab, 0x50, 0x8d, 0x71, 0x84, 0xbd, 0xc1, 0x40, 0xc8,
+	0x77, 0x66, 0x30, 0xdd, 0x19, 0x0c, 0xcc, 0xa9, 0x99, 0xb9, 0xcc, 0xd4, 0x54, 0x2f, 0x33, 0x35,
+	0xb3, 0x97, 0x9f, 0x1a, 0xd0, 0xef, 0x3b, 0x6f, 0xc0, 0x82, 0x36, 0x60, 0xe7, 0x0c, 0xed, 0x0e,
+	0x90, 0x6d, 0x3f, 0x4e, 0x0e, 0x03, 0x6c, 0x87, 0x3a, 0xfc, 0x8d, 0xde, 0x58, 0x99, 0xde, 0x20,
+	0xd2, 0x7b, 0x29, 0x90, 0x25, 0x81, 0xf4, 0x5e, 0x32, 0xa4, 0xfd, 0x2e, 0x2c, 0x1a, 0xe5, 0x89,
+	0xaa, 0x3f, 0x07, 0x53, 0xe3, 0xe4, 0x65, 0x28, 0x2f, 0x3a, 0x35, 0xc9, 0x31, 0x26, 0x2f, 0x43,
+	0x87, 0x63, 0xec, 0xf7, 0x61, 0x61, 0x87, 0xbe, 0x10, 0x64, 0x4f, 0x36, 0xe4, 0xf5, 0x0b, 0xa5,
+	0x04, 0x0c, 0x6f, 0xdf, 0x05, 0xa2, 0x7f, 0x2c, 0x6a, 0xd5, 0x64, 0x06, 0x96, 0x21, 0x33, 0xb0,
+	0x5f, 0x07, 0xb2, 0xef, 0x9f, 0x04, 0x4f, 0x68, 0x1c, 0x7b, 0x27, 0x8a, 0x50, 0x36, 0xa1, 0x3c,
+	0x8c, 0x4f, 0x04, 0x61, 0xc7, 0x9f, 0xf6, 0x57, 0x60, 0xd1, 0xc8, 0x97, 0xd2, 0xa1, 0xd8, 0x3f,
+	0x09, 0xbc, 0x64, 0x1c, 0x51, 0x51, 0x74, 0x0a, 0xb0, 0x37, 0x61, 0xe9, 0x29, 0x8d, 0xfc, 0xe3,
+	0xb3, 0x8b, 0x8a, 0x37, 0xcb, 0x29, 0x65, 0xcb, 0xe9, 0xc2, 0x72, 0xa6, 0x1c, 0x51, 0x3d, 0xdf,
+	0x51, 0x62, 0x26, 0xab, 0x0e, 0x4f, 0x68, 0x27, 0x45, 0x49, 0x3f, 0x29, 0xec, 0x43, 0x20, 0xeb,
+	0x61, 0x10, 0xd0, 0x5e, 0xb2, 0x47, 0x69, 0x94, 0x8a, 0x9e, 0xd3, 0xed, 0x53, 0x7b, 0xb0, 0x2a,
+	0x46, 0x36, 0x7b, 0xfc, 0x88, 0x7d, 0x45, 0xa0, 0x32, 0xa2, 0xd1, 0x90, 0x15, 0x5c, 0x75, 0xd8,
+	0x6f, 0x7b, 0x19, 0x16, 0x8d, 0x62, 0x79, 0xdb, 0xec, 0xb7, 0x60, 0x79, 0xc3, 0x8f, 0x7b, 0xf9,
+	0x0a, 0x5b, 0x30, 0x33, 0x1a, 0x1f, 0xb9, 0x29, 0x71, 0x90, 0x49, 0xbb, 0x05, 0x2b, 0xd9, 0x4f,
+	0x44, 0x61, 0xbf, 0x52, 0x82, 0xd6, 0x3e, 0x65, 0x30, 0xac, 0x6b, 0x2f, 0x1c, 0xf8, 0xbd, 0xb3,
+	0x0b, 0x0b, 0x24, 0x4f, 0xf0, 0x36, 0x23, 0xca, 0x63, 0x6d, 0x6e, 0x3c, 0xb8, 0xa7, 0x28, 0x77,
+	0x71, 0x69, 0x77, 0x1d, 0xf9, 0x81, 0x00, 0xa7, 0x25, 0xe0, 0xdd, 0x01, 0x77, 0x03, 0x8a, 0x42,
+	0xc3, 0xe3, 0x63, 0x37, 0xa6, 0xbd, 0x58, 0x1c, 0xdf, 0x39, 0x38, 0xcb, 0xeb, 0xbd, 0x34, 0xf3,
+	0x56, 0x14, 0x0f, 0x67, 0xc0, 0xed, 0x77, 0x60, 0x3e, 0x53, 0x2b, 0xa9, 0xc1, 0xcc, 0x46, 0x77,
+	0xb3, 0x73, 0xb8, 0x7d, 0xd0, 0xbc, 0x42, 0x00, 0xa6, 0x3b, 0xdb, 0x1f, 0x76, 0x3e, 0xda, 0x6f,
+	0x5a, 0x64, 0x16, 0xa6, 0x76, 0xba, 0x4f, 0xbb, 0x4e, 0xb3, 0x64, 0x5f, 0x83, 0xab, 0x05, 0xbd,
+	0x10, 0x23, 0xf6, 0x0b, 0x16, 0x54, 0x1e, 0x1f, 0x6c, 0xaf, 0x23, 0x2f, 0xe2, 0x07, 0xbd, 0x70,
+	0x88, 0xf7, 0x04, 0xbe, 0x4c, 0x54, 0x7a, 0x22, 0x99, 0xbc, 0x0e, 0xb3, 0xec, 0x7a, 0x81, 0x42,
+	0x21, 0xc1, 0xad, 0xa7, 0x00, 0x14, 0x48, 0xd1, 0x97, 0x23, 0x3f, 0x62, 0x12, 0x27, 0x29, 0x47,
+	0xe2, 0x7d, 0xcb, 0x23, 0xec, 0xdf, 0xae, 0xc2, 0x8c, 0x60, 0xee, 0x58, 0x7d, 0xbd, 0xc4, 0x7f,
+	0x4e, 0x53, 0x46, 0x11, 0x53, 0x78, 0x75, 0x13, 0x8c, 0xa9, 0xb1, 0x70, 0x4d, 0x20, 0xe6, 0x92,
+	0xac, 0x2e, 0x17, 0xd1, 0x71, 0x31, 0x8b, 0x09, 0xc4, 0xd5, 0x20, 0x99, 0x4d, 0x7e, 0x87, 0x90,
+	0x49, 0x1c, 0x89, 0x9e, 0x37, 0xf2, 0x7a, 0x7e, 0x72, 0x26, 0xa8, 0xba, 0x4a, 0x63, 0xd9, 0x83,
+	0xb0, 0xe7, 0xa1, 0x9c, 0x7b, 0xe0, 0xe1, 0xdd, 0x57, 0x08, 0xf3, 0x0c, 0x20, 0x0a, 0xb6, 0x44,
+	0x93, 0x64, 0x36, 0x2e, 0xfc, 0xca, 0x40, 0x91, 0x3f, 0xec, 0x85, 0xc3, 0xa1, 0x9f, 0xa0, 0x3c,
+	0x8c, 0x11, 0xf8, 0xb2, 0xa3, 0x41, 0xc8, 0x1a, 0xd4, 0x44, 0x2a, 0xf6, 0x7f, 0x4c, 0xd9, 0x61,
+	0x5a, 0x76, 0x74, 0x10, 0x96, 0x90, 0xb9, 0x3f, 0x94, 0x1d, 0x0d, 0x82, 0x73, 0x30, 0x0e, 0x62,
+	0x9a, 0x24, 0x03, 0xda, 0x57, 0x8d, 0xa9, 0xb1, 0x6c, 0x79, 0x04, 0x2e, 0x46, 0x2e, 0x9e, 0xe3,
+	0x67, 0x5b, 0x4c, 0x83, 0x84, 0x5d, 0x28, 0xca, 0x4e, 0x0e, 0x4e, 0x1e, 0xc0, 0x92, 0x0e, 0x8b,
+	0x68, 0x8f, 0xfa, 0xcf, 0x69, 0x9f, 0xdd, 0x2b, 0xca, 0x4e, 0x21, 0x0e, 0xfb, 0x83, 0x92, 0xc8,
+	0xf1, 0xa8, 0xef, 0x21, 0x43, 0xdc, 0x60, 0xe3, 0xae, 0x83, 0xc8, 0x5b, 0x20, 0x79, 0x7e, 0x71,
+	0x9f, 0x99, 0x37, 0xe8, 0x3f, 0xae, 0x54, 0xc7, 0xcc, 0x41, 0xae, 0xeb, 0x97, 0xa4, 0xa6, 0x10,
+	0x86, 0x48, 0x00, 0xdb, 0xf4, 0x91, 0xff, 0xdc, 0x4b, 0x68, 0x6b, 0x81, 0x9f, 0xc0, 0x22, 0x89,
+	0xdf, 0xf9, 0x81, 0x9f, 0xf8, 0x5e, 0x12, 0x46, 0x2d, 0xc2, 0x70, 0x29, 0x00, 0x07, 0x8e, 0xad,
+	0x87, 0x38, 0xf1, 0x92, 0x71, 0x2c, 0xee, 0x4c, 0x8b, 0xfc, 0xfe, 0x9c, 0x43, 0x90, 0xf7, 0xa0,
+	0xc5, 0x57, 0x00, 0x43, 0x89, 0xdb, 0xa0, 0x60, 0x3b, 0x97, 0xd8, 0x80, 0x4c, 0xc4, 0x93, 0x9f,
+	0x82, 0xab, 0x62, 0x59, 0x14, 0x7c, 0xbc, 0xcc, 0x3e, 0x9e, 0x9c, 0x01, 0xdb, 0x99, 0xbf, 0xb2,
+	0xad, 0xb0, 0xde, 0xe4, 0x11, 0xb8, 0x30, 0x71, 0xb4, 0xd9, 0xc0, 0xf1, 0xe9, 0x5d, 0xe5, 0x37,
+	0x56, 0x13, 0x4a, 0xee, 0x02, 0x49, 0x21, 0x6a, 0x6a, 0x5b, 0x2c, 0x6f, 0x01, 0x86, 0xbc, 0x0d,
+	0xcb, 0x66, 0x09, 0xee, 0xb1, 0xe7, 0x0f, 0x68, 0xbf, 0x75, 0x95, 0x7d, 0x52, 0x8c, 0xc4, 0x9e,
+	0xe7, 0xcb, 0x92, 0x5f, 0xb6, 0xd9, 0x97, 0x93, 0x33, 0xd8, 0x7f, 0x58, 0xe2, 0xac, 0x83, 0x20,
+	0x1a, 0xb1, 0x26, 0x88, 0xe0, 0xe4, 0xc2, 0x0d, 0x83, 0xc1, 0x99, 0xa0, 0x20, 0xc0, 0x41, 0xbb,
+	0xc1, 0xe0, 0x0c, 0xaf, 0xc2, 0x7e, 0xa0, 0x67, 0xe1, 0xa7, 0x54, 0xdd, 0x0f, 0xb4, 0x4c, 0xb7,
+	0xa0, 0x36, 0x1a, 0x1f, 0x0d, 0xfc, 0x1e, 0xcf, 0xc2, 0xb5, 0x37, 0xc0, 0x41, 0x2c, 0x03, 0x4a,
+	0x61, 0xf8, 0x4a, 0xe2, 0x39, 0xb8, 0xc6, 0xa6, 0x26, 0x60, 0x2c, 0x0b, 0x3b, 0x05, 0x69, 0xc4,
+	0x88, 0x48, 0xdd, 0x61, 0xbf, 0xf1, 0x33, 0xc6, 0x29, 0x49, 0x02, 0xc3, 0xe9, 0x47, 0x0d, 0x99,
+	0x25, 0x01, 0x62, 0x59, 0x90, 0x5f, 0x92, 0x59, 0x66, 0x44, 0x16, 0xef, 0xa5, 0xca, 0xf2, 0x05,
+	0x68, 0xa8, 0xa5, 0xca, 0xab, 0xe7, 0xdc, 0xe1, 0x9c, 0x82, 0xb2, 0x06, 0xbc, 0x09, 0x24, 0x08,
+	0x03, 0x37, 0x93, 0x95, 0x8b, 0x16, 0x9b, 0x41, 0x18, 0x6c, 0xe9, 0xb9, 0xed, 0x87, 0xb0, 0x64,
+	0x8e, 0xa7, 0xe0, 0x1e, 0xee, 0x40, 0x55, 0x90, 0xce, 0xb8, 0x55, 0x63, 0xdb, 0xb1, 0xa1, 0xa9,
+	0xf5, 0x02, 0x3a, 0x70, 0x14, 0xde, 0xfe, 0x85, 0x12, 0x80, 0x43, 0xe3, 0x70, 0x30, 0x66, 0x5a,
+	0x8c, 0xef, 0xa0, 0x06, 0x51, 0xa6, 0x5c, 0x8d, 0x33, 0x5b, 0x13, 0x25, 0xa4, 0x79, 0xb5, 0x9f,
+	0x8c, 0x4f, 0xcb, 0x7e, 0x68, 0x28, 0x5d, 0x4a, 0x17, 0x29, 0x5d, 0x2e, 0xa1, 0xe3, 0xb1, 0x7f,
+	0x00, 0x0d, 0xb3, 0x4e, 0x3c, 0x4d, 0xf7, 0xbb, 0x07, 0x07, 0xdb, 0xdd, 0x0d, 0x7e, 0x9a, 0xae,
+	0xef, 0x3e, 0x79, 0xb2, 0x75, 0xd0, 0xb4, 0xc8, 0x02, 0xcc, 0x6d, 0xed, 0xac, 0xef, 0x3e, 0xd9,
+	0xda, 0x79, 0xe4, 0x22, 0x0d, 0x6a, 0x96, 0x10, 0xb4, 0x7b, 0x78, 0xf0, 0x68, 0x57, 0x81, 0xca,
+	0xf8, 0xc5, 0x43, 0xa7, 0xdb, 0x59, 0x7f, 0xdc, 0xac, 0xd8, 0xff, 0x7b, 0x16, 0x16, 0xc5, 0xf0,
+	0xac, 0x0f, 0xc2, 0x98, 0xee, 0x8f, 0x87, 0x43, 0x2f, 0x2a, 0x38, 0x9c, 0xac, 0x0b, 0x0e, 0xa7,
+	0x92, 0x79, 0x38, 0xdd, 0x34, 0xa4, 0x68, 0xfc, 0x64, 0xd3, 0x20, 0xe4, 0x36, 0xcc, 0xf7, 0x06,
+	0x61, 0xcc, 0xc5, 0x11, 0xba, 0x72, 0x27, 0x0b, 0xce, 0x1f, 0xa6, 0x53, 0x45, 0x87, 0xa9, 0x7e,
+	0x18, 0x4e, 0x67, 0x0e, 0x43, 0x1b, 0xea, 0x58, 0x28, 0x95, 0x67, 0xfb, 0x8c, 0x10, 0x29, 0x69,
+	0x30, 0x6c, 0x4f, 0xf6, 0xf8, 0xe1, 0xe7, 0x5c, 0x16, 0x4c, 0xee, 0xc3, 0x22, 0xd3, 0x1d, 0x21,
+	0xef, 0xa0, 0xe5, 0xe6, 0x87, 0x5e, 0x11, 0x8a, 0x6c, 0x02, 0xf0, 0xba, 0xd8, 0xc2, 0x02, 0xb6,
+	0xb0, 0x5e, 0x37, 0x97, 0xa6, 0x3e, 0xf6, 0x77, 0x31, 0x31, 0x8e, 0x28, 0x5b, 0x5e, 0xda, 0x97,
+	0xd8, 0xc6, 0x53, 0x3f, 0x4e, 0xc2, 0xc8, 0x47, 0x12, 0xdd, 0xf7, 0x12, 0x8f, 0x1d, 0x91, 0x55,
+	0x27, 0x0b, 0x36, 0xcf, 0x8c, 0x7a, 0xf6, 0xcc, 0xd0, 0xce, 0x9a, 0x39, 0xf3, 0xac, 0x29, 0x3a,
+	0x58, 0x1b, 0xaf, 0x78, 0xb0, 0xce, 0x9f, 0x73, 0xb0, 0x9e, 0x7f, 0x06, 0x9e, 0x77, 0x3a, 0x2d,
+	0x7c, 0x9a, 0xd3, 0x89, 0x7c, 0xa2, 0xd3, 0x69, 0xf1, 0xf2, 0xa7, 0xd3, 0xd2, 0x2b, 0x9c, 0x4e,
+	0xcb, 0xaf, 0x7e, 0x3a, 0xad, 0x7c, 0xe2, 0xd3, 0x69, 0xf5, 0x82, 0xd3, 0x89, 0xbc, 0xc7, 0xf7,
+	0x21, 0x4d, 0x89, 0x2f, 0x3b, 0x3e, 0x1b, 0x0f, 0x9a, 0x62, 0x81, 0x2a, 0xda, 0xeb, 0x64, 0x33,
+	0x92, 0xaf, 0x40, 0x2d, 0x25, 0x7e, 0x71, 0xeb, 0x2a, 0xa3, 0xb9, 0x0b, 0x39, 0x8a, 0xe9, 0xe8,
+	0xb9, 0xec, 0xbf, 0x60, 0x41, 0x4d, 0x5b, 0xe0, 0x64, 0x19, 0x16, 0xd6, 0x77, 0x77, 0xf7, 0xba,
+	0x4e, 0xe7, 0x60, 0xeb, 0x69, 0xd7, 0x5d, 0xdf, 0xde, 0xdd, 0xef, 0x36, 0xaf, 0x20, 0x78, 0x7b,
+	0x77, 0xbd, 0xb3, 0xed, 0x6e, 0xee, 0x3a, 0xeb, 0x12, 0x6c, 0x91, 0x15, 0x20, 0x4e, 0xf7, 0xc9,
+	0xee, 0x41, 0xd7, 0x80, 0x97, 0x48, 0x13, 0xea, 0x9c, 0xa4, 0x09, 0x48, 0x99, 0x2c, 0x41, 0x73,
+	0xf3, 0x70, 0x67, 0x03, 0xc9, 0xde, 0x7a, 0x67, 0x67, 0xbd, 0x8b, 0xc4, 0xb2, 0x42, 0xe6, 0x60,
+	0xb6, 0xf3, 0xb0, 0xb3, 0xb3, 0xb1, 0xbb, 0xd3, 0xdd, 0x68, 0x4e, 0xd9, 0xbf, 0x5b, 0x82, 0x65,
+	0xb6, 0xf5, 0xfa, 0xd9, 0xd3, 0x99, 0xb1, 0xb4, 0xe1, 0x88, 0x46, 0x9e, 0xc6, 0xdf, 0xeb, 0x20,
+	0x3c, 0x79, 0xf9, 0x6a, 0x3c, 0x0e, 0xa3, 0x1e, 0x15, 0x87, 0x33, 0x30, 0xd0, 0x26, 0x42, 0xf0,
+	0x7c, 0x14, 0x8b, 0x86, 0xe7, 0xe0, 0x67, 0x73, 0x8d, 0xc3, 0x78, 0x96, 0x15, 0x98, 0x3e, 0x8a,
+	0xa8, 0xd7, 0x3b, 0x15, 0xc7, 0xb2, 0x48, 0xa1, 0x91, 0x87, 0x14, 0xd6, 0xf6, 0x90, 0x84, 0xe0,
+	0x54, 0x4e, 0xf1, 0xad, 0x2e, 0xe0, 0xeb, 0x02, 0x8c, 0x5b, 0xca, 0x3b, 0xf2, 0x82, 0x7e, 0x18,
+	0xd0, 0xbe, 0x10, 0xde, 0xa4, 0x00, 0x75, 0xb4, 0xcf, 0x9c, 0x73, 0xb4, 0x57, 0x2f, 0x3e, 0xda,
+	0x67, 0x73, 0x47, 0xbb, 0xbd, 0x07, 0x2b, 0xd9, 0x91, 0x13, 0xe7, 0xf0, 0x57, 0xb5, 0x73, 0x98,
+	0x8b, 0x45, 0xda, 0x93, 0x89, 0x9d, 0x76, 0x26, 0x7f, 0x13, 0x56, 0xf0, 0x5c, 0xef, 0x44, 0x47,
+	0x7e, 0x12, 0xe1, 0x02, 0x53, 0x93, 0x71, 0xa9, 0xc3, 0xc8, 0x3e, 0x84, 0xd5, 0xdc, 0xf7, 0xa2,
+	0x49, 0xef, 0x41, 0xcd, 0x4b, 0xc1, 0xa2, 0x55, 0x2d, 0xb3, 0x55, 0xe9, 0x77, 0x8e, 0x9e, 0xd9,
+	0xfe, 0xb7, 0x25, 0x58, 0xc8, 0x65, 0xb9, 0xe4, 0xf9, 0xf8, 0x4d, 0x69, 0x3f, 0xc2, 0x2f, 0xeb,
+	0xb7, 0x27, 0xd5, 0x78, 0x37, 0xfd, 0xa9, 0x5b, 0x95, 0x90, 0x77, 0xf0, 0xc2, 0x1f, 0x87, 0x83,
+	0xe7, 0x34, 0x42, 0xde, 0xa0, 0xac, 0x49, 0x34, 0xd6, 0xc3, 0x20, 0x89, 0xbc, 0x5e, 0xe2, 0x08,
+	0xbc, 0x93, 0xe6, 0xb4, 0xff, 0xb6, 0x05, 0xf3, 0x99, 0x12, 0xcd, 0x1b, 0xf8, 0x12, 0x34, 0x1f,
+	0x3a, 0xbb, 0x9d, 0x8d, 0xf5, 0xce, 0xfe, 0x81, 0xab, 0xb8, 0x87, 0x36, 0xac, 0xf0, 0xdf, 0x4f,
+	0xba, 0x3b, 0x07, 0xae, 0xca, 0xd0, 0xdd, 0x68, 0x96, 0xc8, 0x22, 0xcc, 0xaf, 0xef, 0xee, 0x1c,
+	0x38, 0x9d, 0xf5, 0x03, 0xbe, 0xc5, 0x36, 0x9a, 0x65, 0x72, 0x0d, 0x56, 0x3f, 0xec, 0x6c, 0x1d,
+	0xe0, 0x1e, 0xdb, 0x3c, 0xdc, 0xde, 0x76, 0x9d, 0xee, 0xfe, 0xee, 0xf6, 0xe1, 0xc1, 0xd6, 0xee,
+	0x4e, 0xb3, 0x42, 0x08, 0x34, 0x10, 0xf8, 0x11, 0x87, 0x3e, 0xc5, 0xfd, 0x86, 0xb7, 0xfd, 0xae,
+	0xe3, 0xec, 0x3a, 0xcd, 0x69, 0xfb, 0x3f, 0x95, 0xa1, 0x99, 0xed, 0x03, 0xf9, 0x0e, 0x9e, 0xf5,
+	0xfc, 0xb7, 0xce, 0x85, 0xbd, 0x36, 0xa1, 0xcf, 0x77, 0xe5, 0x0f, 0x76, 0x54, 0x9a, 0x9f, 0x22,
+	0x47, 0x60, 0xf0, 0x61, 0xb3, 0x1a, 0xdb, 0x95, 0x0a, 0x0a, 0xca, 0x86, 0xa0, 0xe0, 0x12, 0xda,
+	0x4e, 0xf2, 0x3e, 0x9b, 0xd3, 0x13, 0x2e, 0x49, 0x6d, 0x3c, 0xf8, 0xc2, 0x45, 0x6d, 0xdb, 0xc7,
+	0xcc, 0x0e, 0xff, 0x06, 0x8f, 0xf0, 0x21, 0x0a, 0xc1, 0xfc, 0xe4, 0x4c, 0x72, 0x23, 0xd3, 0xec,
+	0x18, 0xcc, 0x82, 0x91, 0x81, 0x8a, 0x5f, 0x50, 0x3a, 0x62, 0xda, 0x1a, 0xb6, 0x7f, 0x67, 0x1d,
+	0x0d, 0x62, 0x7f, 0x1b, 0xea, 0x7a, 0xef, 0x35, 0x36, 0xf0, 0x4a, 0x9e, 0x0d, 0xb4, 0xf2, 0x6c,
+	0x60, 0xc9, 0x3e, 0x81, 0x39, 0xa3, 0x8d, 0x48, 0x73, 0x3b, 0x72, 0x3e, 0xf7, 0x9c, 0xee, 0xd6,
+	0x93, 0xce, 0x23, 0x24, 0xc5, 0x8b, 0x30, 0xaf, 0xc0, 0xdd, 0xef, 0xed, 0x6d, 0x39, 0x1f, 0x35,
+	0x2d, 0xd2, 0x00, 0xd8, 0xda, 0x59, 0x3f, 0x7c, 0xd8, 0x41, 0x70, 0xb3, 0x44, 0xea, 0x50, 0xdd,
+	0xff, 0xb0, 0xdb, 0xdd, 0xc3, 0x54, 0x19, 0x53, 0x6a, 0xd6, 0x2b, 0xf6, 0xbf, 0x2a, 0x43, 0x05,
+	0xc5, 0x3a, 0xe7, 0x48, 0xb6, 0x34, 0x89, 0x66, 0xd9, 0xb4, 0x82, 0x42, 0x03, 0x20, 0x54, 0x42,
+	0xf1, 0x83, 0x56, 0xa8, 0x51, 0x53, 0x48, 0x8a, 0x8f, 0x68, 0xef, 0xb9, 0x50, 0xa4, 0x6a, 0x10,
+	0xc4, 0x6b, 0xcc, 0x8c, 0x30, 0xfe, 0x49, 0x21, 0x29, 0x9e, 0x7d, 0x3f, 0xa3, 0xe3, 0xd9, 0xf7,
+	0x2d, 0x98, 0xf1, 0x03, 0x66, 0xd2, 0x20, 0xee, 0x2e, 0x32, 0xc9, 0x6c, 0xaf, 0x18, 0xa3, 0xea,
+	0x0f, 0x25, 0xfb, 0x97, 0x02, 0xc8, 0x03, 0x98, 0x45, 0x03, 0x01, 0x9d, 0xe7, 0x5b, 0x12, 0x4b,
+	0x05, 0xc7, 0xe2, 0xee, 0xfe, 0x59, 0xd0, 0x63, 0xcb, 0x36, 0xcd, 0x86, 0x44, 0x05, 0x4f, 0x57,
+	0xb7, 0x3f, 0xe6, 0x32, 0x27, 0x21, 0x01, 0x31, 0x81, 0xc8, 0xa4, 0x1d, 0x85, 0x61, 0x12, 0x27,
+	0x91, 0x37, 0x72, 0xe3, 0x70, 0x8c, 0x67, 0x4b, 0x9d, 0x0d, 0x5a, 0x0e, 0x6e, 0xef, 0x41, 0x55,
+	0x56, 0x84, 0x67, 0xe4, 0xe1, 0xce, 0x07, 0x3b, 0xbb, 0x1f, 0xee, 0xb8, 0xfb, 0x1f, 0xed, 0xac,
+	0x37, 0xaf, 0x90, 0x79, 0xa8, 0x75, 0xd6, 0xd9, 0xb1, 0xcb, 0x00, 0x16, 0x66, 0xd9, 0xeb, 0xec,
+	0xef, 0x2b, 0x48, 0x09, 0xb3, 0xec, 0x6d, 0xed, 0xec, 0x74, 0x37, 0x38, 0xa0, 0x6c, 0x13, 0xd4,
+	0xe5, 0xc5, 0x4c, 0x4c, 0x27, 0xe9, 0xb3, 0xfd, 0x55, 0x58, 0xd0, 0x60, 0xa9, 0x68, 0x1c, 0x8f,
+	0x9b, 0xac, 0x68, 0x1c, 0x33, 0x39, 0x1c, 0x83, 0x26, 0x7e, 0x98, 0xec, 0x3e, 0xa7, 0x41, 0x22,
+	0x4c, 0xfa, 0x46, 0xd8, 0x45, 0xfb, 0xb7, 0x2d, 0x98, 0x55, 0x98, 0x73, 0x96, 0x8d, 0x34, 0xb6,
+	0x2b, 0x19, 0xf6, 0x1a, 0xea, 0xcb, 0xbb, 0xec, 0x6f, 0x2a, 0x4e, 0xc7, 0x7d, 0x1f, 0x51, 0x2f,
+	0x0e, 0x03, 0xb1, 0xca, 0x44, 0xca, 0xfe, 0x16, 0xcc, 0xaa, 0xac, 0xac, 0xcb, 0xdd, 0xae, 0xe3,
+	0xee, 0xee, 0x6c, 0x6f, 0xed, 0xe0, 0x06, 0xc0, 0x51, 0x61, 0x80, 0xcd, 0x4d, 0x06, 0xb1, 0x54,
+	0x16, 0x3e, 0x7a, 0xcd, 0x92, 0xfd, 0xff, 0x42, 0xfd, 0x51, 0x18, 0xc7, 0xfe, 0x08, 0x47, 0xfb,
+	0xdc, 0x95, 0x6e, 0xac, 0x8b, 0xd2, 0xe5, 0xd6, 0x05, 0xd2, 0x02, 0x4c, 0xf0, 0xb3, 0x44, 0x5c,
+	0xa6, 0x52, 0x88, 0xdd, 0x86, 0x16, 0x8e, 0xbf, 0xde, 0x02, 0x35, 0x37, 0x7f, 0x60, 0xc1, 0xd5,
+	0x02, 0xa4, 0x98, 0xa4, 0x2f, 0xc3, 0x4c, 0xcc, 0x41, 0x2d, 0xcb, 0xb0, 0x45, 0xd3, 0xb3, 0x3b,
+	0x32, 0x0f, 0xd2, 0x47, 0x6e, 0x27, 0xc3, 0x00, 0x7d, 0x29, 0x91, 0xd0, 0x61, 0xc8, 0x1d, 0x0f,
+	0xe3, 0x13, 0x97, 0x29, 0x24, 0xb9, 0x42, 0xb9, 0xcc, 0xb9, 0x63, 0x13, 0xca, 0x48, 0x61, 0x7c,
+	0xe2, 0x1e, 0x8d, 0xa3, 0x38, 0x11, 0x19, 0xf9, 0xee, 0xce, 0x82, 0x51, 0x8e, 0xce, 0xac, 0x6c,
+	0xb0, 0x35, 0xfb, 0x4c, 0x9c, 0x25, 0x3b, 0xb7, 0x0a, 0xcb, 0x29, 0x46, 0x5f, 0x40, 0x7f, 0xd7,
+	0x82, 0xf9, 0xcc, 0x37, 0x38, 0xf9, 0xa2, 0xd9, 0x42, 0x5a, 0x2b, 0x1a, 0x8c, 0xa3, 0x9b, 0x78,
+	0x51, 0xc2, 0x37, 0xb2, 0xb0, 0x86, 0x4b, 0x21, 0x78, 0x90, 0xa0, 0x8e, 0x8b, 0x61, 0xf9, 0x71,
+	0xa1, 0xd2, 0x38, 0x20, 0xcc, 0x66, 0x51, 0xf2, 0x3b, 0x5c, 0x6c, 0x6c, 0xc0, 0x90, 0x4e, 0x60,
+	0x3a, 0x08, 0xfb, 0x94, 0x5b, 0x60, 0xcf, 0x39, 0x29, 0xc0, 0x6e, 0xa2, 0xf1, 0x7c, 0xb2, 0x15,
+	0x1c, 0x87, 0xb2, 0x53, 0x7f, 0x7d, 0x0a, 0xe6, 0x15, 0x48, 0x19, 0xa2, 0xce, 0xfb, 0x7d, 0x1a,
+	0x24, 0x78, 0x40, 0x18, 0x6a, 0xf3, 0x2c, 0x18, 0x75, 0x28, 0xde, 0xc0, 0xf7, 0xa4, 0x35, 0x29,
+	0x4f, 0xe0, 0x65, 0x0d, 0xab, 0xd4, 0xcd, 0x17, 0x58, 0x7b, 0xb9, 0xb8, 0xbf, 0x10, 0x87, 0x17,
+	0x5d, 0x84, 0x0b, 0x09, 0x54, 0xa6, 0x8b, 0x45, 0x28, 0xd9, 0x53, 0xbe, 0xed, 0xb5, 0x9e, 0x32,
+	0x40, 0xce, 0x54, 0x93, 0x1f, 0x7c, 0x39, 0x53, 0x4d, 0xcd, 0xdc, 0xb3, 0x9a, 0x33, 0xf7, 0xc4,
+	0x6b, 0x3a, 0x9b, 0x35, 0x37, 0x09, 0x5d, 0x26, 0x4e, 0x10, 0x62, 0xa2, 0x2c, 0x98, 0x5c, 0x87,
+	0x99, 0x84, 0xc6, 0x49, 0x40, 0x13, 0x46, 0x7d, 0xab, 0x0f, 0x4b, 0x2d, 0xcb, 0x91, 0x20, 0xe4,
+	0x8b, 0xc7, 0x91, 0x1f, 0x33, 0xbb, 0xb3, 0x59, 0x87, 0xfd, 0xc6, 0xeb, 0xd7, 0x11, 0x8d, 0x13,
+	0xf7, 0x94, 0x7a, 0x7d, 0x64, 0x22, 0xfc, 0x21, 0xe5, 0x16, 0xa3, 0x5c, 0x54, 0x5c, 0x8c, 0xc4,
+	0x9d, 0x8e, 0x3c, 0x17, 0x52, 0x6b, 0x6e, 0x79, 0x26, 0x93, 0xf2, 0x3a, 0xe7, 0x07, 0x99, 0x61,
+	0x62, 0x37, 0xe4, 0x39, 0xa7, 0x18, 0x49, 0x5e, 0x83, 0x69, 0xd6, 0x81, 0xb8, 0xd5, 0x5c, 0x2b,
+	0x6b, 0x36, 0x4e, 0xeb, 0x08, 0x74, 0x04, 0x0e, 0x67, 0xb9, 0x17, 0x0e, 0xc2, 0x88, 0xdd, 0x8b,
+	0x67, 0x1d, 0x9e, 0x30, 0x47, 0x87, 0x6d, 0x4a, 0x21, 0x30, 0xce, 0x82, 0xc9, 0xdb, 0x30, 0x3b,
+	0xf0, 0xe3, 0x84, 0x06, 0x38, 0x53, 0x8b, 0xac, 0xa2, 0x15, 0xa5, 0x49, 0x8a, 0x9e, 0xfb, 0x3d,
+	0xba, 0x2d, 0xd0, 0x4e, 0x9a, 0xf1, 0x3b, 0x95, 0x6a, 0xad, 0x59, 0xb7, 0x87, 0x30, 0x9f, 0xc9,
+	0x83, 0x83, 0x10, 0x73, 0x90, 0x24, 0x77, 0x22, 0x79, 0x8e, 0x79, 0xf3, 0xeb, 0xd0, 0x18, 0x45,
+	0xe1, 0xcb, 0x33, 0x97, 0x39, 0x91, 0xf4, 0xc2, 0x81, 0xb8, 0x20, 0x65, 0xa0, 0xf6, 0xd7, 0x60,
+	0x8a, 0xf5, 0x9d, 0xf5, 0x19, 0x7f, 0x88, 0x2a, 0x78, 0x02, 0x2b, 0x08, 0x68, 0xf2, 0x22, 0x8c,
+	0x9e, 0xc9, 0x0a, 0x44, 0xd2, 0xfe, 0x31, 0xd3, 0x0f, 0x2a, 0x5b, 0xe2, 0x43, 0x26, 0xba, 0x47,
+	0x2d, 0x2f, 0x5f, 0x4f, 0xf1, 0xa9, 0x27, 0x54, 0x96, 0x55, 0x06, 0xd8, 0x3f, 0xf5, 0xf0, 0xde,
+	0x63, 0x2c, 0x51, 0xae, 0x05, 0xae, 0x31, 0xd8, 0x63, 0x06, 0x42, 0x8d, 0xba, 0xb4, 0x52, 0x8e,
+	0xdd, 0x01, 0x3d, 0x4e, 0xa4, 0xc5, 0x4b, 0x30, 0x1e, 0x62, 0x75, 0xf1, 0x36, 0x3d, 0x4e, 0xec,
+	0x1d, 0x75, 0x67, 0xd8, 0x1d, 0x51, 0x59, 0xf5, 0xd7, 0x8b, 0xee, 0x0c, 0x29, 0xc9, 0xd5, 0x4d,
+	0x86, 0xb2, 0x77, 0x1b, 0x07, 0x88, 0x7e, 0x79, 0x12, 0x05, 0x0a, 0xc1, 0x96, 0xb4, 0xe9, 0x11,
+	0xdd, 0x31, 0x60, 0x6c, 0x6a, 0xc6, 0xbd, 0x9e, 0x9c, 0x80, 0xaa, 0x23, 0x93, 0xf6, 0x9f, 0x2b,
+	0xc1, 0x22, 0x2b, 0x6d, 0x5d, 0x9a, 0x81, 0xf1, 0xdb, 0xd6, 0xbb, 0xaf, 0xd0, 0xcc, 0x7a, 0x4f,
+	0x4b, 0xe1, 0x0c, 0xe9, 0x97, 0x61, 0x9e, 0xf8, 0x24, 0x46, 0x0a, 0x95, 0x02, 0x23, 0x85, 0x2f,
+	0x42, 0xb3, 0x4f, 0x07, 0x3e, 0xf3, 0xf2, 0x90, 0x4b, 0x8a, 0x8b, 0x02, 0xe7, 0x25, 0x5c, 0xda,
+	0x16, 0x7d, 0x49, 0x98, 0x9c, 0x99, 0x85, 0x72, 0xde, 0x6f, 0x1e, 0x4d, 0xce, 0xb4, 0x72, 0xed,
+	0xbf, 0x6a, 0xc1, 0x02, 0xbf, 0x91, 0xb2, 0x63, 0x42, 0x0c, 0xed, 0x4f, 0xc1, 0x1c, 0x17, 0x77,
+	0x08, 0xb2, 0x28, 0x06, 0x21, 0x3d, 0xaa, 0x19, 0x94, 0x67, 0x7e, 0x7c, 0xc5, 0x31, 0x33, 0x93,
+	0xf7, 0x99, 0xf4, 0x33, 0x70, 0x19, 0xb4, 0xc0, 0xc7, 0xc4, 0x9c, 0xc7, 0xc7, 0x57, 0x1c, 0x2d,
+	0xfb, 0xc3, 0x2a, 0x4c, 0x73, 0x35, 0x93, 0xfd, 0x08, 0xe6, 0x8c, 0x8a, 0x0c, 0x4b, 0x87, 0x3a,
+	0xb7, 0x74, 0xc8, 0x19, 0x60, 0x95, 0x0a, 0x0c, 0xb0, 0x7e, 0xbe, 0x02, 0x04, 0x17, 0x62, 0x66,
+	0xa6, 0xd7, 0x4c, 0x5b, 0x48, 0xe9, 0x1f, 0x92, 0x82, 0x98, 0x08, 0x2b, 0x4d, 0x4a, 0xfb, 0x4c,
+	0xce, 0x81, 0x14, 0x60, 0xf0, 0x9c, 0x11, 0x42, 0x11, 0x65, 0xfb, 0xc8, 0xae, 0x59, 0x7c, 0x42,
+	0x0b, 0x71, 0x48, 0xf3, 0xb9, 0x21, 0x24, 0x93, 0xd5, 0x71, 0x4d, 0xa6, 0x06, 0xc9, 0xae, 0x9f,
+	0xe9, 0x4b, 0xac, 0x9f, 0x99, 0x82, 0xf5, 0xa3, 0x49, 0x3d, 0xab, 0x39, 0xa9, 0xa7, 0xb4, 0x7b,
+	0x74, 0x87, 0xa2, 0x19, 0x9c, 0x9f, 0xcf, 0xc1, 0x31, 0xaf, 0x14, 0x23, 0x2a, 0x41, 0x26, 0x70,
+	0x3d, 0x78, 0x16, 0x8e, 0xc7, 0x61, 0x6a, 0x6d, 0x52, 0x63, 0xcd, 0x4e, 0x01, 0x4c, 0xe6, 0x98,
+	0x33, 0x9e, 0xa9, 0x0b, 0x99, 0x63, 0x16, 0xc1, 0xe4, 0x0d, 0x6c, 0x89, 0xc9, 0xa5, 0x3f, 0x27,
+	0xe4, 0x0d, 0x3a, 0x10, 0x6b, 0xfc, 0x31, 0x8d, 0x42, 0x3e, 0x50, 0xdc, 0x20, 0x32, 0x05, 0xd8,
+	0xbf, 0x62, 0x41, 0x13, 0x57, 0x81, 0xb1, 0xd0, 0xdf, 0x03, 0xb6, 0x87, 0x2f, 0xb9, 0xce, 0x8d,
+	0xbc, 0xe4, 0x5d, 0x98, 0x65, 0xe9, 0x70, 0x44, 0x03, 0xb1, 0xca, 0x33, 0x42, 0x95, 0x94, 0xfa,
+	0x3d, 0xbe, 0xe2, 0xa4, 0x99, 0xb5, 0x35, 0xfe, 0xaf, 0x2d, 0xa8, 0x89, 0x5a, 0x3e, 0xb1, 0x7e,
+	0x5f, 0xbf, 0xea, 0x97, 0x33, 0x57, 0xfd, 0x82, 0x1b, 0x77, 0xa5, 0xf8, 0xc6, 0x7d, 0x1f, 0x16,
+	0x19, 0xa1, 0x8f, 0xdd, 0xc4, 0x1f, 0xb8, 0x12, 0x2b, 0x3c, 0x4a, 0x8a, 0x50, 0x48, 0xef, 0xb8,
+	0x28, 0x80, 0xb3, 0x32, 0x3c, 0x81, 0xec, 0xea, 0x5e, 0x6a, 0x0f, 0xaa, 0x09, 0x15, 0xed, 0xdf,
+	0x59, 0x80, 0xd5, 0x1c, 0x4a, 0xf9, 0x33, 0x2e, 0x72, 0x91, 0xf9, 0xc0, 0x1f, 0x1e, 0x85, 0x4a,
+	0xad, 0x60, 0x09, 0xb5, 0x42, 0x1e, 0x45, 0x4e, 0x60, 0x59, 0xf2, 0x6c, 0x38, 0xa6, 0x29, 0x7f,
+	0x51, 0x62, 0xe7, 0xf9, 0x5b, 0xe6, 0x14, 0x66, 0x2b, 0x94, 0x70, 0x9d, 0x2c, 0x14, 0x97, 0x47,
+	0x4e, 0xa1, 0x25, 0x11, 0xf2, 0x68, 0xd1, 0x18, 0x48, 0xac, 0xeb, 0xcd, 0x0b, 0xea, 0x32, 0x24,
+	0x85, 0xce, 0xc4, 0xd2, 0xc8, 0x19, 0xdc, 0x94, 0x38, 0x76, 0x76, 0xe4, 0xeb, 0xab, 0x5c, 0xaa,
+	0x6f, 0x4c, 0xba, 0x6a, 0x56, 0x7a, 0x41, 0xc1, 0xe4, 0x87, 0xb0, 0xf2, 0xc2, 0xf3, 0x13, 0xd9,
+	0x2c, 0x8d, 0x5d, 0x9b, 0x62, 0x55, 0x3e, 0xb8, 0xa0, 0xca, 0x0f, 0xf9, 0xc7, 0xc6, 0x81, 0x3a,
+	0xa1, 0xc4, 0xf6, 0x3f, 0x2f, 0x41, 0xc3, 0x2c, 0x07, 0x97, 0xa9, 0xa0, 0x1f, 0x92, 0xaa, 0x4a,
+	0x06, 0x3f, 0x03, 0xce, 0x4b, 0x1e, 0x4b, 0x45, 0x92, 0x47, 0x5d, 0x1f, 0x56, 0xbe, 0xc8, 0x38,
+	0xa4, 0x72, 0x39, 0xe3, 0x90, 0xa9, 0x42, 0xe3, 0x90, 0xf3, 0xb4, 0x36, 0xd3, 0x9f, 0x46, 0x6b,
+	0x33, 0x73, 0x81, 0xd6, 0xa6, 0xfd, 0x5f, 0x2d, 0x20, 0xf9, 0x55, 0x4c, 0x1e, 0x71, 0xa5, 0x64,
+	0x40, 0x07, 0x82, 0x98, 0x7d, 0xf9, 0x72, 0x3b, 0x41, 0xce, 0x9a, 0xfc, 0x1a, 0xb7, 0xa4, 0xee,
+	0x8c, 0xa6, 0x33, 0x85, 0x73, 0x4e, 0x11, 0x2a, 0x63, 0x28, 0x53, 0xb9, 0xc8, 0x50, 0x66, 0xea,
+	0x22, 0x43, 0x99, 0xe9, 0xac, 0xa1, 0x4c, 0xfb, 0x0f, 0x2c, 0x58, 0x2c, 0x58, 0x6a, 0x9f, 0x5d,
+	0xa7, 0x71, 0x71, 0x18, 0x14, 0xa8, 0x24, 0x16, 0x87, 0x0e, 0x2c, 0x52, 0x1b, 0x95, 0x2f, 0xa9,
+	0x36, 0x6a, 0xff, 0x67, 0x0b, 0xe6, 0x8c, 0xbd, 0xf9, 0xd9, 0x35, 0x3e, 0xcb, 0x10, 0xf3, 0xad,
+	0x61, 0xc0, 0x3e, 0x4d, 0xd3, 0xb3, 0x1a, 0xaf, 0xca, 0x65, 0x34, 0x5e, 0xed, 0x7f, 0x3a, 0x05,
+	0x24, 0x4f, 0x90, 0xfe, 0xef, 0x76, 0x3a, 0x37, 0xab, 0xe5, 0xa2, 0x59, 0xfd, 0xb3, 0x3c, 0x2b,
+	0xdf, 0x84, 0x05, 0xe1, 0x8d, 0xad, 0x29, 0xcd, 0xf9, 0xfa, 0xce, 0x23, 0xf0, 0x0e, 0x62, 0xda,
+	0x57, 0x55, 0x0d, 0x47, 0x42, 0x8d, 0x61, 0xc8, 0x9a, 0x59, 0xbd, 0x2b, 0x7b, 0xca, 0x19, 0x60,
+	0x69, 0x6a, 0x4c, 0x94, 0x21, 0xe8, 0xf0, 0x28, 0xe4, 0x8e, 0x96, 0x8e, 0x99, 0xf1, 0x33, 0x53,
+	0xd3, 0x17, 0x2c, 0xb0, 0xda, 0x65, 0x17, 0x58, 0x81, 0x19, 0x42, 0xfd, 0x95, 0xcc, 0x10, 0xe6,
+	0x26, 0x9b, 0x21, 0x64, 0x16, 0x6f, 0xe3, 0x52, 0xea, 0xda, 0x1b, 0x70, 0xcd, 0x5c, 0x77, 0xcc,
+	0x05, 0x49, 0x71, 0x34, 0xff, 0xcb, 0x82, 0xeb, 0xc5, 0x78, 0xc1, 0xd6, 0x3c, 0x80, 0x25, 0xbc,
+	0x8a, 0xe5, 0x04, 0x4f, 0x16, 0x17, 0x3c, 0x15, 0xe1, 0xc8, 0xb7, 0xe1, 0x1a, 0xc2, 0x4f, 0x06,
+	0xe1, 0x91, 0x37, 0xc8, 0x7f, 0xca, 0xe9, 0xef, 0x79, 0x59, 0x3e, 0xa1, 0xb8, 0x4b, 0xc8, 0xab,
+	0x2b, 0x86, 0xce, 0x12, 0x85, 0xb2, 0xd9, 0x1d, 0xc9, 0x33, 0xda, 0x3d, 0x58, 0x2c, 0xc0, 0x9e,
+	0x2b, 0xfb, 0x2d, 0x6e, 0x56, 0x69, 0x72, 0xb3, 0x50, 0xb6, 0x2b, 0x4a, 0xce, 0x8b, 0xc9, 0x7f,
+	0xbd, 0x02, 0x44, 0x47, 0x0a, 0x96, 0xfe, 0x6d, 0xa8, 0xeb, 0x0c, 0x9c, 0x20, 0x2f, 0x19, 0x63,
+	0x28, 0x64, 0xe6, 0xf5, 0x5c, 0x64, 0x03, 0x1a, 0x6c, 0x35, 0xf6, 0xd5, 0x77, 0xdc, 0x7a, 0xe9,
+	0x1c, 0xe5, 0xed, 0xe3, 0x2b, 0x4e, 0xe6, 0x1b, 0xf2, 0x0d, 0x68, 0x98, 0x12, 0xad, 0x56, 0x79,
+	0xa2, 0xf4, 0x00, 0x3f, 0x37, 0x33, 0x93, 0x0e, 0x34, 0xb3, 0x22, 0xb1, 0x56, 0xe5, 0xbc, 0x02,
+	0x72, 0xd9, 0xc9, 0x13, 0x58, 0x90, 0x2c, 0x03, 0x3b, 0x57, 0x87, 0x52, 0xef, 0x53, 0x7b, 0x70,
+	0x43, 0x2d, 0x76, 0xc4, 0xaf, 0x2b, 0xb4, 0xba, 0xa1, 0xe4, 0xbf, 0x24, 0xef, 0x0a, 0x15, 0xc3,
+	0x94, 0xa9, 0x89, 0xcc, 0x8d, 0xfa, 0x5d, 0xfe, 0x4f, 0xb3, 0xdd, 0x8f, 0x01, 0x52, 0x18, 0x2a,
+	0x11, 0x76, 0xf7, 0xba, 0x3b, 0xee, 0xfa, 0xe3, 0xce, 0xce, 0x4e, 0x77, 0xbb, 0x79, 0x05, 0x15,
+	0xa4, 0x5c, 0x93, 0xaa, 0x60, 0x16, 0xc2, 0x84, 0x46, 0x46, 0xc2, 0x4a, 0xa8, 0xac, 0xdd, 0xda,
+	0xc9, 0x40, 0xcb, 0xa8, 0xac, 0x13, 0x96, 0x10, 0xa9, 0xce, 0xb6, 0x59, 0x79, 0x38, 0xab, 0x4e,
+	0x15, 0x34, 0x3c, 0x5c, 0x29, 0xee, 0xe9, 0xa7, 0x90, 0x44, 0x69, 0x2c, 0x8c, 0x76, 0xd8, 0xe8,
+	0x20, 0x66, 0xfc, 0x9f, 0x78, 0x09, 0x75, 0x83, 0xf1, 0x50, 0xc8, 0xfe, 0x53, 0x00, 0x79, 0x0a,
+	0xf3, 0xe9, 0xe8, 0x72, 0x52, 0x5b, 0x61, 0x43, 0xfb, 0xe6, 0xb9, 0x93, 0x73, 0x37, 0x05, 0x70,
+	0xb3, 0xbb, 0x4c, 0x21, 0x58, 0x6b, 0x7a, 0x8d, 0xe6, 0xb6, 0x12, 0x29, 0xc0, 0x5e, 0x87, 0x86,
+	0x59, 0x00, 0xea, 0x4b, 0xb7, 0x3b, 0x07, 0xdd, 0x7d, 0xd4, 0x97, 0xd6, 0x60, 0x66, 0xaf, 0xcb,
+	0x6c, 0x45, 0x9a, 0x16, 0x26, 0x9c, 0xee, 0xd3, 0xdd, 0x0f, 0x98, 0xda, 0xbb, 0x06, 0x33, 0x42,
+	0x67, 0xd6, 0x2c, 0xdb, 0x2b, 0xb0, 0xc4, 0xa3, 0x79, 0x3c, 0xe4, 0x14, 0x55, 0x09, 0xec, 0x2d,
+	0x58, 0xce, 0x20, 0x52, 0x1f, 0x5e, 0x7e, 0x73, 0x33, 0xaf, 0x73, 0x26, 0x90, 0xd9, 0xf0, 0xca,
+	0x96, 0x66, 0xd8, 0xae, 0x3c, 0x02, 0x09, 0xff, 0x38, 0xc8, 0x81, 0xc5, 0x81, 0x5e, 0x84, 0x42,
+	0x2d, 0xc9, 0xba, 0x0c, 0x62, 0x62, 0x34, 0xfc, 0x5f, 0x5a, 0xb0, 0x92, 0xc5, 0xa4, 0x2e, 0x26,
+	0x66, 0x9b, 0x65, 0x12, 0x89, 0x98, 0x71, 0x4d, 0x34, 0x1b, 0x5c, 0x88, 0x43, 0x31, 0x12, 0x92,
+	0x6b, 0xa1, 0x39, 0x75, 0x0d, 0xdd, 0x7b, 0x01, 0x06, 0xfb, 0x98, 0xf1, 0x34, 0xd7, 0xa4, 0x48,
+	0x45, 0x28, 0xfb, 0x1f, 0x94, 0x81, 0x7c, 0x77, 0x4c, 0xa3, 0x33, 0xe6, 0x0b, 0xac, 0x2c, 0x47,
+	0x56, 0xb3, 0xb4, 0x18, 0x9d, 0x47, 0xd0, 0x2d, 0x54, 0xb8, 0xf8, 0x97, 0x52, 0x17, 0xff, 0x22,
+	0x37, 0xfb, 0xca, 0xc5, 0x6e, 0xf6, 0x53, 0x17, 0xb9, 0xd9, 0xa3, 0x19, 0x2f, 0xf3, 0x8e, 0xef,
+	0x0b, 0x15, 0xd0, 0xf4, 0x5a, 0x19, 0xa5, 0xb4, 0x02, 0xb8, 0x83, 0x30, 0xf2, 0x7e, 0x9a, 0x89,
+	0xf6, 0x4f, 0x58, 0x14, 0x0a, 0x9d, 0x6b, 0xe9, 0xf6, 0x4f, 0xe8, 0x76, 0xd8, 0x43, 0x16, 0x81,
+	0xe9, 0x31, 0xe4, 0xc7, 0x08, 0x47, 0x95, 0x41, 0x83, 0xab, 0x7b, 0x5d, 0xd9, 0x57, 0xae, 0x38,
+	0xa9, 0x73, 0xe8, 0x1e, 0xef, 0xf1, 0x5d, 0x58, 0x1c, 0xc7, 0xd4, 0x1d, 0xfa, 0x31, 0x6a, 0x27,
+	0x50, 0x22, 0x94, 0x44, 0xe1, 0x40, 0xa8, 0x4f, 0x16, 0xc6, 0x31, 0x7d, 0xc2, 0x31, 0xeb, 0x1c,
+	0x41, 0xde, 0x4e, 0x9b, 0x34, 0xf2, 0xfc, 0x48, 0x06, 0xab, 0x90, 0x3d, 0xc5, 0x76, 0xef, 0x79,
+	0x7e, 0xa4, 0xda, 0x82, 0x89, 0xf8, 0x02, 0x9f, 0x7f, 0xe1, 0x9c, 0x7d, 0x17, 0xaa, 0xf2, 0x73,
+	0x14, 0x69, 0x1e, 0x47, 0xe1, 0x50, 0x8a, 0x34, 0xf1, 0x37, 0x69, 0x40, 0x29, 0x09, 0x85, 0x38,
+	0xb2, 0x94, 0x84, 0xf6, 0x47, 0x50, 0xd3, 0x46, 0x40, 0x78, 0x68, 0x33, 0x9a, 0x24, 0x64, 0xa1,
+	0x15, 0x2e, 0x5b, 0x0a, 0xe8, 0x60, 0xab, 0x8f, 0x5e, 0x69, 0x7d, 0x3f, 0xa2, 0xcc, 0x25, 0xdf,
+	0x8d, 0x28, 0xaa, 0x63, 0xa4, 0x44, 0xba, 0xa9, 0x10, 0x0e, 0x87, 0xdb, 0x2e, 0x2c, 0x1a, 0xcb,
+	0x46, 0x6d, 0xdc, 0x69, 0xe6, 0x4d, 0x2e, 0xd5, 0xa2, 0xa6, 0xa7, 0xb9, 0xc0, 0x21, 0xe7, 0x2d,
+	0x84, 0xe9, 0xa8, 0xae, 0x38, 0x62, 0x95, 0x58, 0x8e, 0x01, 0xb3, 0xff, 0x71, 0x09, 0xca, 0x8f,
+	0xc3, 0x91, 0x6e, 0x2a, 0x6b, 0x99, 0xa6, 0xb2, 0xe2, 0x42, 0x9f, 0x5a, 0x5b, 0x89, 0x1b, 0x97,
+	0x01, 0x24, 0x77, 0xa0, 0xe1, 0x0d, 0x13, 0xd4, 0xe0, 0x1c, 0x87, 0xd1, 0x0b, 0x2f, 0xe2, 0x6e,
+	0xe7, 0x65, 0xb6, 0x1c, 0x32, 0x18, 0xb2, 0x04, 0x65, 0x75, 0xff, 0x64, 0x19, 0x30, 0x89, 0xd2,
+	0x33, 0xe6, 0xce, 0x72, 0x26, 0x54, 0x73, 0x22, 0x85, 0xee, 0xa5, 0xe6, 0xf7, 0x4a, 0x08, 0xca,
+	0xd9, 0xf3, 0x09, 0x58, 0x3c, 0x09, 0x70, 0x1b, 0x0c, 0x8d, 0xeb, 0xba, 0x0e, 0xd2, 0x39, 0xa1,
+	0xaa, 0xc9, 0x09, 0xad, 0x41, 0x2d, 0x19, 0x3c, 0x77, 0x47, 0xde, 0xd9, 0x20, 0xf4, 0xfa, 0x62,
+	0x11, 0xea, 0x20, 0xfb, 0xbf, 0x5b, 0x30, 0xc5, 0x46, 0x1b, 0x79, 0x62, 0x4e, 0x2f, 0x15, 0x53,
+	0x2b, 0xb8, 0xc7, 0x2c, 0x98, 0xd8, 0x46, 0x20, 0x97, 0x92, 0xea, 0xbe, 0x06, 0x25, 0x6b, 0x30,
+	0xcb, 0x53, 0x2a, 0xc2, 0x07, 0xcb, 0x92, 0x02, 0xc9, 0x4d, 0xf4, 0x40, 0x1e, 0x49, 0x3e, 0x10,
+	0xa4, 0x4b, 0x47, 0x38, 0x72, 0x18, 0x1c, 0x89, 0x59, 0x5a, 0x9e, 0xea, 0x3e, 0xbf, 0xcb, 0x17,
+	0x60, 0x90, 0xbc, 0xab, 0xc2, 0x33, 0x43, 0x9b, 0x47, 0xd8, 0x87, 0x30, 0x8f, 0x7b, 0x43, 0x53,
+	0x08, 0x4f, 0x26, 0x62, 0x5f, 0x44, 0x6e, 0xa9, 0x37, 0x18, 0xf7, 0xa9, 0xc9, 0x4b, 0x56, 0x9d,
+	0x79, 0x01, 0x97, 0x4c, 0xa9, 0xfd, 0x3b, 0x16, 0x54, 0x65, 0xb9, 0xe4, 0x36, 0x54, 0x90, 0x14,
+	0x65, 0x64, 0xbd, 0xca, 0x47, 0x0e, 0xf3, 0x39, 0x2c, 0x47, 0x4e, 0xbf, 0x5d, 0x2a, 0xd0, 0x6f,
+	0xbf, 0x0e, 0x0d, 0xde, 0x8d, 0x8c, 0xc0, 0x29, 0x03, 0x25, 0x77, 0x35, 0xbb, 0xc0, 0x8a, 0x41,
+	0xde, 0x24, 0x37, 0xd5, 0x3f, 0xa1, 0x9a, 0x3d, 0xe0, 0xef, 0x95, 0x60, 0xce, 0x68, 0x13, 0xae,
+	0x9a, 0x81, 0x17, 0x27, 0xc2, 0x0b, 0x47, 0xac, 0x02, 0x1d, 0xa4, 0xaf, 0xb8, 0x92, 0xb9, 0xe2,
+	0x94, 0x5e, 0xbc, 0xac, 0xeb, 0xc5, 0xef, 0xc3, 0x6c, 0x1a, 0xd5, 0xc7, 0x6c, 0x14, 0xd6, 0x28,
+	0xbd, 0x05, 0xd3, 0x4c, 0xa9, 0xe6, 0x75, 0x4a, 0xd7, 0xbc, 0xee, 0x42, 0xa3, 0x37, 0x8e, 0x93,
+	0x70, 0xe8, 0xe2, 0x5d, 0x36, 0xea, 0x73, 0x2a, 0x5f, 0x7b, 0xf0, 0x46, 0xd1, 0xd8, 0xde, 0x5d,
+	0x67, 0x59, 0x1d, 0x9e, 0x93, 0xfb, 0x55, 0x67, 0x3e, 0x6f, 0x7f, 0x1b, 0x48, 0x3e, 0xd7, 0x2b,
+	0x05, 0x14, 0x79, 0x1f, 0x6a, 0x5a, 0x17, 0x74, 0x3d, 0xa9, 0x65, 0xe8, 0x49, 0x95, 0xc3, 0x71,
+	0x29, 0x75, 0x38, 0xb6, 0x7f, 0xab, 0x04, 0x73, 0xb8, 0xfb, 0xfc, 0xe0, 0x44, 0x78, 0xf0, 0xe1,
+	0x2e, 0x94, 0x1b, 0x4d, 0x9c, 0x8e, 0x72, 0x17, 0x9a, 0x60, 0x14, 0x3d, 0xaa, 0x30, 0x12, 0x9c,
+	0x94, 0xa9, 0x34, 0xaa, 0x4f, 0x90, 0x40, 0x1c, 0x79, 0x71, 0x4a, 0x38, 0xf8, 0x6a, 0xc9, 0xc1,
+	0x85, 0xdb, 0x3b, 0x37, 0x19, 0x19, 0xfa, 0x83, 0x81, 0xaf, 0xbe, 0xa8, 0x28, 0xb7, 0xf7, 0x02,
+	0x2c, 0xd6, 0xdf, 0xf7, 0x63, 0xef, 0x28, 0x35, 0x9a, 0x55, 0x69, 0xe9, 0xc6, 0x68, 0xa8, 0x7a,
+	0xa6, 0x55, 0xdc, 0x0c, 0x03, 0x9e, 0x5d, 0x6d, 0x33, 0xb9, 0xd5, 0x66, 0xff, 0x7e, 0x09, 0x6a,
+	0xda, 0xda, 0x15, 0x4e, 0x0f, 0xe6, 0xc1, 0xa4, 0x41, 0x24, 0xde, 0x90, 0xdb, 0x6a, 0x10, 0xf2,
+	0x9a, 0x59, 0x23, 0xbb, 0xad, 0x32, 0xea, 0xa4, 0x83, 0x99, 0x95, 0x45, 0xd8, 0xa7, 0x6f, 0x31,
+	0x21, 0xb1, 0x88, 0xf9, 0xa5, 0x00, 0x12, 0xfb, 0x80, 0x61, 0xa7, 0x52, 0x2c, 0x03, 0x9c, 0xeb,
+	0x26, 0xf1, 0x2e, 0xd4, 0x45, 0x31, 0x6c, 0xc6, 0x5b, 0x33, 0x06, 0x75, 0x30, 0x56, 0x83, 0x63,
+	0xe4, 0x94, 0x5f, 0x3e, 0x90, 0x5f, 0x56, 0x2f, 0xfa, 0x52, 0xe6, 0xb4, 0x1f, 0x29, 0xef, 0x13,
+	0x66, 0xad, 0x23, 0x29, 0xde, 0x7d, 0x58, 0x94, 0x84, 0x6d, 0x1c, 0x78, 0x41, 0x10, 0x8e, 0x35,
+	0xbb, 0x9d, 0x22, 0x94, 0xdd, 0x87, 0xba, 0x5e, 0x10, 0xb9, 0x03, 0x53, 0x9c, 0xdb, 0xe2, 0xe7,
+	0x77, 0x31, 0x8d, 0xe3, 0x59, 0x30, 0xca, 0x1b, 0x67, 0xba, 0x4a, 0x13, 0xa9, 0x12, 0xcf, 0x60,
+	0xdf, 0x81, 0x79, 0x84, 0x66, 0x88, 0xb3, 0x79, 0xae, 0x4f, 0xf7, 0x78, 0x14, 0x90, 0x25, 0x74,
+	0xdd, 0x66, 0x3b, 0x4c, 0xcb, 0x6e, 0xff, 0x69, 0x19, 0x6a, 0x1a, 0x18, 0x89, 0x27, 0xb7, 0x9e,
+	0xea, 0xfb, 0xde, 0x90, 0x26, 0x34, 0x12, 0xbb, 0x2a, 0x03, 0xc5, 0x7c, 0xde, 0xf3, 0x13, 0x64,
+	0x7b, 0xdd, 0x3e, 0x3d, 0x89, 0x28, 0x15, 0xcc, 0x46, 0x06, 0x8a, 0xf9, 0x04, 0x7b, 0x2c, 0xf3,
+	0x71, 0x99, 0x47, 0x06, 0x6a, 0x1a, 0x25, 0x55, 0x32, 0x46, 0x49, 0x39, 0xb2, 0x3f, 0x55, 0x40,
+	0xf6, 0xbf, 0x0a, 0x2b, 0x9c, 0xc0, 0x0b, 0x3a, 0x92, 0xf5, 0x15, 0x9b, 0x80, 0xc5, 0x2d, 0x88,
+	0x6d, 0x96, 0xdb, 0x82, 0x09, 0xc2, 0x67, 0x58, 0x5f, 0x72, 0x70, 0xa9, 0x99, 0x35, 0xf2, 0x56,
+	0x53, 0xcd, 0x6c, 0x2e, 0xaf, 0xf7, 0xd2, 0x80, 0x29, 0x2d, 0x6e, 0x06, 0x4e, 0xde, 0x85, 0xd5,
+	0x21, 0xed, 0xfb, 0x9e, 0x59, 0x84, 0x1b, 0x7b, 0x89, 0xf0, 0x4d, 0x9d, 0x84, 0xc6, 0x5a, 0x70,
+	0x14, 0x7e, 0x1c, 0x0e, 0x8f, 0x7c, 0x7e, 0xea, 0x72, 0xd5, 0x6e, 0xc5, 0xc9, 0xc1, 0xed, 0x39,
+	0xa8, 0xed, 0x27, 0xe1, 0x48, 0x4e, 0x7d, 0x03, 0xea, 0x3c, 0x29, 0x5c, 0x9a, 0xbf, 0x01, 0x57,
+	0xd9, 0x5a, 0x3d, 0x08, 0x47, 0xe1, 0x20, 0x3c, 0x39, 0xd3, 0x45, 0x3b, 0x48, 0x7c, 0x22, 0x1a,
+	0x8f, 0x87, 0xd4, 0x55, 0xdc, 0x71, 0xc5, 0xd1, 0x41, 0xf6, 0x7f, 0xb0, 0x60, 0xd1, 0xf8, 0x3e,
+	0x95, 0xfe, 0x30, 0x5d, 0x91, 0xf4, 0x5e, 0xb5, 0x0c, 0x39, 0x1f, 0xae, 0x7b, 0x9e, 0x91, 0x2b,
+	0xfa, 0xf9, 0xef, 0x98, 0x74, 0xd2, 0x20, 0x41, 0xf2, 0xc3, 0x52, 0x91, 0x95, 0x3c, 0xee, 0x06,
+	0xf1, 0xbd, 0x0c, 0x1f, 0x24, 0x8b, 0xf8, 0x06, 0xd4, 0x35, 0x61, 0x90, 0x54, 0x0d, 0x2a, 0xf1,
+	0x91, 0x2e, 0xfc, 0x96, 0x2d, 0xe8, 0x29, 0x60, 0x8c, 0x67, 0x5b, 0x4c, 0x7f, 0x24, 0x6c, 0x00,
+	0xf1, 0x27, 0xc6, 0xd1, 0x81, 0xb4, 0xbd, 0xb8, 0x64, 0xd3, 0xb3, 0x9a, 0x87, 0x79, 0x4d, 0x01,
+	0x68, 0xba, 0xa3, 0x4c, 0xe1, 0xd2, 0xe3, 0xbf, 0x26, 0x61, 0xc8, 0x2e, 0xbd, 0x01, 0xf3, 0x42,
+	0x60, 0x78, 0x4c, 0x59, 0x24, 0x82, 0x58, 0x38, 0x83, 0x37, 0x38, 0x78, 0x53, 0x40, 0x53, 0x5e,
+	0xa1, 0xa2, 0xf3, 0x0a, 0x85, 0x27, 0xbf, 0xfd, 0x8b, 0xa9, 0x7b, 0x40, 0x3a, 0x36, 0x13, 0xa9,
+	0x02, 0x79, 0x90, 0x3b, 0x02, 0x26, 0x88, 0x5d, 0xd8, 0x85, 0x66, 0xef, 0x42, 0x5d, 0xde, 0xfb,
+	0xd0, 0x88, 0x38, 0x7d, 0x95, 0xc4, 0xb7, 0x72, 0x0e, 0xf1, 0x9d, 0x8b, 0xf4, 0x24, 0xf2, 0x8f,
+	0x5e, 0xff, 0x39, 0x8d, 0x12, 0x9f, 0x69, 0x0a, 0x18, 0x4f, 0x28, 0x4c, 0x6a, 0x34, 0x38, 0x63,
+	0xbd, 0x30, 0x88, 0x14, 0xf7, 0xe8, 0x57, 0x39, 0x45, 0xb4, 0xba, 0x14, 0x8c, 0x19, 0xed, 0xdf,
+	0xb2, 0x84, 0x55, 0x91, 0x39, 0xd7, 0x93, 0x47, 0x44, 0xef, 0x5d, 0x29, 0xd3, 0xbb, 0xcf, 0x0b,
+	0xab, 0x87, 0xbe, 0x54, 0x47, 0x94, 0x35, 0xd7, 0xbd, 0xbe, 0xb0, 0xc8, 0x32, 0x87, 0xb4, 0x72,
+	0x99, 0x21, 0xb5, 0xff, 0xbd, 0x05, 0x33, 0x8f, 0xc3, 0xd1, 0x63, 0xe1, 0xc4, 0xc8, 0x36, 0x8c,
+	0x8a, 0x20, 0x22, 0x93, 0xe7, 0xb8, 0x37, 0x4e, 0xe2, 0x63, 0xe6, 0x0a, 0xf8, 0x98, 0x6f, 0xc3,
+	0x35, 0x84, 0x8d, 0xa2, 0x70, 0x14, 0x46, 0xb8, 0xb9, 0xbd, 0x01, 0xe7, 0x58, 0xc2, 0x20, 0x39,
+	0x95, 0xc4, 0xf7, 0xbc, 0x2c, 0x4c, 0x40, 0x84, 0x97, 0x6a, 0x7e, 0x61, 0x13, 0xdc, 0x17, 0xa7,
+	0xc9, 0x79, 0x84, 0xfd, 0x75, 0x98, 0x65, 0x17, 0x27, 0xd6, 0xb9, 0x37, 0x61, 0xf6, 0x34, 0x1c,
+	0xb9, 0xa7, 0xbe, 0x8c, 0x03, 0x99, 0x0a, 0x82, 0x45, 0xff, 0x9d, 0x34, 0x83, 0xfd, 0xcf, 0xaa,
+	0x30, 0xb3, 0x15, 0x3c, 0x0f, 0xfd, 0x1e, 0xb3, 0x35, 0x1a, 0xd2, 0x61, 0x28, 0xa3, 0xaa, 0xe0,
+	0x6f, 0x34, 0xaa, 0x64, 0x0e, 0x64, 0x23, 0xbe, 0x74, 0xeb, 0xdc, 0xa8, 0x52, 0x80, 0x58, 0xfc,
+	0xcb, 0x34, 0x0a, 0x1d, 0xdf, 0x5a, 0x1a, 0x84, 0x59, 0x5f, 0xeb, 0x7e, 0x15, 0x22, 0x95, 0xf2,
+	0xb0, 0x53, 0x5a, 0x20, 0x1d, 0xac, 0x4b, 0xe8, 0x3c, 0xb8, 0x5b, 0x13, 0xaf, 0x4b, 0x80, 0xd8,
+	0xa5, 0x39, 0xa2, 0x5c, 0xf9, 0xaa, 0xd8, 0xb3, 0xb2, 0x63, 0x02, 0x91, 0x8a, 0xf2, 0x0f, 0x78,
+	0x1e, 0xe1, 0xe9, 0xa4, 0x81, 0x90, 0xad, 0xcd, 0x46, 0x61, 0xe4, 0xb1, 0x80, 0xb2, 0x60, 0x9c,
+	0xf2, 0x3e, 0x55, 0x04, 0x9a, 0xf7, 0x03, 0x58, 0x3f, 0x72, 0x70, 0xed, 0xaa, 0xcd, 0x2d, 0xf8,
+	0x45, 0x0a, 0x5b, 0x7d, 0xec, 0x0d, 0x06, 0x18, 0x2d, 0x83, 0xd9, 0xec, 0x08, 0xbb, 0x7d, 0x13,
+	0x88, 0xad, 0xd6, 0x66, 0x95, 0xa9, 0x74, 0x2a, 0x8e, 0x0e, 0x22, 0x0f, 0xa0, 0xc6, 0x44, 0x10,
+	0x62, 0x5e, 0xb9, 0x2a, 0xa7, 0xa9, 0xcb, 0x28, 0xd8, 0xcc, 0xea, 0x99, 0x74, 0xfb, 0xa7, 0xf9,
+	0x5c, 0x84, 0x01, 0xaf, 0xdf, 0x17, 0xe6, 0x63, 0x4d, 0x2e, 0x4e, 0x51, 0x00, 0x26, 0xe4, 0xe0,
+	0x03, 0xc6, 0x33, 0x2c, 0xb0, 0x0c, 0x06, 0x8c, 0xdc, 0x84, 0x2a, 0xde, 0x61, 0x47, 0x9e, 0xdf,
+	0xe7, 0xce, 0x96, 0x6c, 0xb2, 0x14, 0x8c, 0x71, 0x2f, 0xe2, 0xb7, 0xd8, 0x2c, 0x8b, 0xfc, 0x8a,
+	0x68, 0x42, 0x19, 0x6f, 0x20, 0x21, 0x43, 0x23, 0x2e, 0x41, 0x0e, 0x4e, 0xde, 0x92, 0xbe, 0x55,
+	0xcb, 0x4c, 0x7c, 0x7c, 0x4d, 0x29, 0xd7, 0xd8, 0xf2, 0x95, 0xff, 0x0d, 0x77, 0xaa, 0xdb, 0x30,
+	0xc5, 0xb5, 0x89, 0x2b, 0x06, 0xa3, 0x27, 0xb2, 0x32, 0x6d, 0x22, 0xcf, 0x80, 0x13, 0xc5, 0xee,
+	0x03, 0x6a, 0xeb, 0xac, 0xb2, 0xdd, 0x66, 0x02, 0x71, 0x5f, 0xca, 0x84, 0x8c, 0x08, 0x17, 0xb7,
+	0x5a, 0x6b, 0xe5, 0xdb, 0x15, 0x27, 0x8f, 0x90, 0x31, 0x2b, 0xaf, 0xa6, 0x31, 0x2b, 0xef, 0xe3,
+	0xb0, 0x8d, 0xdc, 0x98, 0x26, 0x71, 0xab, 0x6d, 0xf0, 0xa9, 0x9d, 0x27, 0x7b, 0xb2, 0x03, 0x34,
+	0x71, 0x54, 0x2e, 0xdd, 0x41, 0x89, 0xad, 0x9f, 0x6b, 0xa6, 0x83, 0x12, 0xc2, 0xc8, 0xd7, 0x60,
+	0x45, 0x8b, 0xd6, 0x99, 0x4a, 0x56, 0x93, 0xd6, 0x9f, 0xf2, 0xb0, 0x59, 0x13, 0xd0, 0x76, 0x07,
+	0xea, 0xfa, 0xa8, 0x91, 0x2a, 0x54, 0x50, 0x65, 0xd1, 0xbc, 0xa2, 0x3b, 0x9c, 0x5b, 0xe8, 0xdc,
+	0xa3, 0x3c, 0x2a, 0x99, 0xe3, 0x4f, 0x67, 0x7d, 0xbd, 0xbb, 0x87, 0x6e, 0x62, 0x65, 0xfb, 0xbf,
+	0x95, 0xa0, 0xa6, 0x0d, 0xe7, 0x39, 0x52, 0xaf, 0x9b, 0x00, 0xec, 0xb6, 0x95, 0x1a, 0x2d, 0x56,
+	0x1c, 0x0d, 0x82, 0x9b, 0x40, 0x97, 0x97, 0x70, 0x3d, 0x82, 0x0e, 0xc2, 0x39, 0xe2, 0x81, 0xf9,
+	0x74, 0x5d, 0xf5, 0x94, 0x63, 0x02, 0x59, 0x39, 0x1c, 0xc0, 0x0c, 0xf8, 0x85, 0xc9, 0x85, 0x06,
+	0xc2, 0x31, 0x15, 0x9e, 0x63, 0x3c, 0x0b, 0x67, 0x5f, 0x0d, 0x18, 0xd6, 0x25, 0x68, 0xac, 0xe6,
+	0x43, 0x3e, 0xe5, 0x98, 0x40, 0xf2, 0x65, 0xb9, 0x24, 0xab, 0x6c, 0x49, 0xae, 0xe6, 0xd7, 0x97,
+	0xb1, 0x1c, 0xd1, 0xf5, 0x88, 0x4f, 0x2c, 0x8e, 0xcf, 0x2c, 0xa7, 0x97, 0x29, 0x04, 0x69, 0x13,
+	0xa6, 0x7a, 0xa7, 0xfe, 0x40, 0xee, 0x4e, 0x6e, 0x6a, 0x98, 0x05, 0xdb, 0xbf, 0x6a, 0xc1, 0x9c,
+	0xb1, 0x64, 0x98, 0xb3, 0x03, 0x4d, 0xe4, 0xb8, 0xd7, 0x1d, 0x91, 0x2a, 0xdc, 0x61, 0x7c, 0xf0,
+	0x73, 0xf0, 0x2c, 0xf5, 0x2c, 0xe7, 0xa9, 0xa7, 0xb8, 0x45, 0xf0, 0x4d, 0xa5, 0xdd, 0x22, 0x18,
+	0xc0, 0x4e, 0x80, 0x74, 0xfa, 0x7d, 0xd1, 0x28, 0x3d, 0xba, 0x62, 0xa4, 0x07, 0x04, 0x15, 0xa9,
+	0x22, 0x4a, 0x5c, 0x2a, 0xa6, 0xc4, 0xe7, 0xd2, 0x2b, 0xdb, 0x87, 0xda, 0x9e, 0x16, 0x62, 0x94,
+	0x1d, 0x4a, 0x32, 0xb8, 0xa8, 0x38, 0xcc, 0x34, 0x88, 0xd6, 0x9c, 0x92, 0xd1, 0x9c, 0xec, 0x4e,
+	0x2b, 0xe7, 0x77, 0x9a, 0xfd, 0x77, 0x2c, 0x1e, 0x65, 0x4c, 0x75, 0x91, 0xb7, 0x0f, 0x3f, 0x95,
+	0x5a, 0x90, 0x34, 0xb4, 0x87, 0x01, 0xc3, 0x3c, 0xac, 0xb9, 0x6e, 0x78, 0x7c, 0x1c, 0x53, 0xe9,
+	0xd8, 0x66, 0xc0, 0xe4, 0x5d, 0x83, 0x6f, 0x53, 0x56, 0x43, 0x2c, 0x1c, 0xdc, 0x72, 0x70, 0xe4,
+	0xa2, 0x84, 0xa8, 0x5b, 0x7a, 0x01, 0xab, 0xb4, 0xfd, 0x37, 0x2d, 0x58, 0x34, 0x9a, 0x99, 0x06,
+	0xcc, 0x50, 0xe5, 0x9a, 0xac, 0x81, 0xcc, 0xa9, 0xf0, 0x48, 0xea, 0x98, 0x0c, 0xc2, 0x68, 0x34,
+	0x5f, 0x38, 0x79, 0x04, 0x8a, 0x48, 0x8f, 0xfd, 0x28, 0x9b, 0x9d, 0xef, 0xe1, 0x02, 0x8c, 0xfd,
+	0xf3, 0x16, 0x2c, 0xca, 0xc5, 0xab, 0xdf, 0x82, 0x8c, 0x99, 0xb6, 0x2e, 0x3a, 0x99, 0x4a, 0x05,
+	0x27, 0xd3, 0x1d, 0x4d, 0x44, 0x2a, 0x3d, 0xa9, 0xb9, 0x9f, 0x41, 0x0e, 0x6e, 0xff, 0xe1, 0x14,
+	0xcc, 0x88, 0xa5, 0x93, 0xf3, 0x04, 0xe5, 0x0b, 0xc7, 0x80, 0x91, 0x96, 0x11, 0x00, 0x90, 0x1d,
+	0x79, 0x1c, 0x90, 0xe7, 0x4e, 0xca, 0x45, 0xdc, 0x09, 0x3a, 0x67, 0x7b, 0xc9, 0x29, 0x93, 0x3b,
+	0xce, 0x3a, 0xec, 0xb7, 0x14, 0xdd, 0x4f, 0x99, 0xa2, 0xfb, 0xa2, 0x28, 0xbf, 0x9c, 0xfd, 0xce,
+	0xc1, 0x71, 0xd7, 0xb2, 0x46, 0x98, 0x62, 0x79, 0x0d, 0x84, 0xad, 0xe3, 0x49, 0x49, 0x00, 0x38,
+	0x5f, 0x64, 0x02, 0x5f, 0x81, 0x33, 0x7a, 0x1b, 0xa6, 0x79, 0x94, 0x21, 0x61, 0x34, 0x73, 0x5d,
+	0x9a, 0x4e, 0xf0, 0x7c, 0xf2, 0xbf, 0x70, 0xdd, 0x12, 0x79, 0xcd, 0x98, 0x97, 0xb5, 0x6c, 0xcc,
+	0xcb, 0x8c, 0x72, 0xa1, 0x9e, 0x57, 0x2e, 0xac, 0x43, 0x03, 0x63, 0x18, 0x8c, 0x23, 0xea, 0x0a,
+	0x9f, 0xbe, 0x39, 0x83, 0x11, 0x10, 0xb5, 0x6e, 0xf2, 0x3c, 0x0e, 0xcb, 0xe2, 0x64, 0x3e, 0xc1,
+	0x65, 0xad, 0x54, 0x79, 0xaa, 0x32, 0x1e, 0xf1, 0x22, 0x8f, 0x40, 0xd5, 0x27, 0x02, 0xe3, 0x81,
+	0x3f, 0x1a, 0x79, 0x27, 0x69, 0xeb, 0x44, 0xc8, 0x8b, 0x22, 0x5c, 0x1a, 0xc7, 0xb0, 0x39, 0x31,
+	0x2e, 0xf5, 0x42, 0x26, 0x2e, 0xb5, 0xbd, 0x09, 0x73, 0xc6, 0x98, 0xe9, 0x6a, 0xe8, 0x2b, 0x18,
+	0xc3, 0x60, 0x6b, 0xc7, 0xdd, 0xdc, 0xde, 0x7a, 0xf4, 0x18, 0xbd, 0xb6, 0xe7, 0x60, 0x76, 0xff,
+	0x70, 0x7d, 0xbd, 0xdb, 0xdd, 0x60, 0xe7, 0x31, 0xc0, 0xf4, 0x66, 0x67, 0x6b, 0x9b, 0x9d, 0xc6,
+	0x47, 0x7c, 0xdf, 0x8b, 0xb2, 0x94, 0x52, 0xf4, 0xcb, 0x40, 0xe4, 0xda, 0x67, 0xa6, 0xd5, 0xa3,
+	0x01, 0x4d, 0x64, 0x88, 0x83, 0x05, 0x81, 0xd9, 0x52, 0x08, 0xb3, 0xad, 0xa5, 0x6c, 0x5b, 0x45,
+	0x34, 0x9e, 0xb4, 0x8e, 0x94, 0xb8, 0x88, 0x75, 0x92, 0x25, 0x2e, 0x22, 0xab, 0xa3, 0xf0, 0x68,
+	0xe3, 0xb2, 0x41, 0xb1, 0xae, 0xce, 0x60, 0x90, 0x69, 0x2c, 0x46, 0x85, 0x2b, 0xc0, 0x09, 0x11,
+	0xca, 0x9f, 0xb7, 0x60, 0xb9, 0xc3, 0x83, 0x1d, 0x7c, 0x66, 0x4e, 0x2c, 0x5f, 0x85, 0x15, 0xdf,
+	0x7d, 0x16, 0x84, 0x2f, 0xdc, 0x17, 0xa7, 0x5e, 0xe2, 0xfa, 0xae, 0x37, 0x74, 0xfb, 0xa1, 0x0c,
+	0x4b, 0x5d, 0x75, 0x26, 0x60, 0xd1, 0xec, 0x3b, 0xdb, 0x14, 0xd1, 0xca, 0x4d, 0x58, 0xd8, 0xa0,
+	0x47, 0xe3, 0x93, 0x6d, 0xfa, 0x3c, 0x6d, 0x20, 0x81, 0x4a, 0x7c, 0x1a, 0xbe, 0x10, 0xc3, 0xce,
+	0x7e, 0xa3, 0x62, 0x73, 0x80, 0x79, 0xdc, 0x78, 0x44, 0x7b, 0x72, 0xa8, 0x19, 0x64, 0x7f, 0x44,
+	0x7b, 0xf6, 0x57, 0x81, 0xe8, 0xe5, 0x88, 0x81, 0xc6, 0x53, 0x7a, 0x7c, 0xe4, 0xc6, 0x67, 0x71,
+	0x42, 0x87, 0x32, 0x20, 0xa4, 0x0e, 0xb2, 0xdf, 0x80, 0xfa, 0x9e, 0x87, 0x01, 0x01, 0x45, 0x14,
+	0x6d, 0xd4, 0x27, 0x79, 0x67, 0xb8, 0x7d, 0x95, 0x3e, 0x89, 0xa1, 0xd1, 0x27, 0x7f, 0x9a, 0xe7,
+	0xc4, 0x52, 0xfb, 0x34, 0x4e, 0xfc, 0x80, 0x3b, 0x22, 0x8b, 0x52, 0x35, 0x50, 0x8e, 0x42, 0x96,
+	0x0a, 0x28, 0xa4, 0xe0, 0x0f, 0x74, 0x39, 0x7f, 0x0a, 0x40, 0x6c, 0xea, 0x64, 0xc7, 0x65, 0xfa,
+	0x29, 0x20, 0xa3, 0xae, 0x4c, 0xef, 0x50, 0xbc, 0x65, 0xf2, 0x88, 0x10, 0x64, 0x50, 0x07, 0x15,
+	0xde, 0xd4, 0xb8, 0x03, 0x7d, 0x0e, 0x9e, 0xbf, 0x91, 0x55, 0x2f, 0x71, 0x23, 0x93, 0x01, 0xee,
+	0x26, 0xdf, 0xc8, 0xe0, 0x32, 0x37, 0xb2, 0x2c, 0x8b, 0x51, 0x2b, 0x60, 0xe6, 0xef, 0x40, 0x55,
+	0x09, 0xab, 0xea, 0xc6, 0x36, 0x12, 0xd2, 0x2a, 0x47, 0xe1, 0xf5, 0x53, 0x62, 0x48, 0x13, 0x8f,
+	0x05, 0x08, 0x9a, 0x33, 0x63, 0xc1, 0x4b, 0xb8, 0x3d, 0x84, 0x19, 0x51, 0x00, 0xde, 0x4b, 0x8e,
+	0xfc, 0x44, 0xc8, 0x9d, 0xf1, 0x27, 0xae, 0xcd, 0xc0, 0x1b, 0xca, 0xcd, 0xce, 0x7e, 0xe3, 0x10,
+	0xf8, 0x31, 0x23, 0xf9, 0x7e, 0xa4, 0xce, 0x50, 0x1d, 0xc4, 0x3c, 0x37, 0x62, 0xb6, 0x37, 0x02,
+	0x11, 0xce, 0x44, 0xa5, 0xd1, 0x6b, 0x9c, 0xc5, 0x3b, 0x46, 0xf1, 0x86, 0xdc, 0xd9, 0xbf, 0x58,
+	0x82, 0xa6, 0xd8, 0x2a, 0x0a, 0x47, 0x3e, 0x67, 0x08, 0x73, 0x0a, 0x83, 0x6a, 0xa0, 0xff, 0xbb,
+	0x17, 0x53, 0x57, 0x3f, 0x1f, 0xb8, 0xe0, 0x28, 0x07, 0x97, 0xc7, 0xc8, 0x88, 0x46, 0x28, 0x4f,
+	0x91, 0x2c, 0xac, 0x06, 0xc2, 0x56, 0x4b, 0x3d, 0x12, 0x6b, 0xb5, 0xe5, 0xa8, 0x34, 0x9a, 0xb6,
+	0xcb, 0xfb, 0x51, 0xae, 0x46, 0x6e, 0xea, 0x3a, 0x11, 0xcf, 0x55, 0x18, 0x1c, 0xa7, 0xb7, 0x80,
+	0x7b, 0x2f, 0x15, 0xa1, 0xec, 0xdf, 0xb3, 0x60, 0x41, 0x1b, 0x24, 0xb1, 0xbd, 0xdf, 0x07, 0x49,
+	0x9e, 0xb8, 0x56, 0xdb, 0x32, 0xa3, 0x80, 0x64, 0xc6, 0xcf, 0x31, 0x32, 0xb3, 0xbd, 0xe2, 0x9d,
+	0xb1, 0x5a, 0xe2, 0xf1, 0x50, 0x30, 0x48, 0x3a, 0x08, 0xd7, 0xe0, 0x0b, 0x4a, 0x9f, 0xa9, 0x2c,
+	0x9c, 0x47, 0x33, 0x60, 0xec, 0x32, 0x8c, 0x82, 0x28, 0x95, 0x89, 0x33, 0xab, 0x26, 0xd0, 0xfe,
+	0xed, 0x32, 0x2c, 0x72, 0xb9, 0xa2, 0x90, 0xee, 0xaa, 0x70, 0xa6, 0xd3, 0x5c, 0xbc, 0xca, 0x49,
+	0xdd, 0xe3, 0x2b, 0x8e, 0x48, 0x93, 0x77, 0x2e, 0x29, 0x0b, 0x55, 0xfe, 0x71, 0x93, 0xe7, 0xbf,
+	0x3c, 0x61, 0xfe, 0xcf, 0x9b, 0xdd, 0x02, 0x8d, 0xe6, 0x54, 0xb1, 0x46, 0xf3, 0x55, 0xb4, 0x86,
+	0x45, 0xce, 0x64, 0x32, 0x32, 0x7f, 0x06, 0x4e, 0xbe, 0x09, 0xed, 0x2c, 0x8c, 0x91, 0x7e, 0xff,
+	0xd8, 0x57, 0x81, 0x94, 0xcf, 0xc9, 0x81, 0x16, 0xbd, 0xda, 0x42, 0x62, 0x64, 0x29, 0x95, 0xf4,
+	0x6f, 0x71, 0x0c, 0x2e, 0x0d, 0x3d, 0x17, 0x3e, 0x95, 0x12, 0xf7, 0xc2, 0x11, 0x45, 0x73, 0x36,
+	0x73, 0xbe, 0xc4, 0x71, 0xf5, 0x9b, 0x16, 0xb4, 0x36, 0xb9, 0xad, 0x07, 0xda, 0x58, 0xb3, 0xd0,
+	0x62, 0x2a, 0x38, 0xad, 0xe9, 0x2c, 0x2f, 0x54, 0x9c, 0x13, 0x9c, 0xe5, 0xf9, 0x72, 0x33, 0x9c,
+	0xe5, 0x73, 0xf7, 0x81, 0xb9, 0xcc, 0x9d, 0x47, 0xc4, 0xd6, 0xc2, 0x61, 0xa5, 0xcf, 0x19, 0xf3,
+	0xc0, 0xaf, 0x95, 0x19, 0xa8, 0xfd, 0xef, 0x2c, 0x98, 0x4f, 0x1b, 0xc9, 0xe3, 0x44, 0x18, 0xe7,
+	0x89, 0xb8, 0x2d, 0x28, 0x80, 0x52, 0xbe, 0xfa, 0x78, 0x7d, 0x10, 0x6d, 0xd3, 0x20, 0x8c, 0xc6,
+	0x8b, 0x54, 0x38, 0x96, 0x17, 0x32, 0x1d, 0xc4, 0xdd, 0xcf, 0xf0, 0xe6, 0x22, 0x6e, 0x61, 0x22,
+	0xc5, 0x7c, 0x9c, 0x87, 0x09, 0xfb, 0x8a, 0xaf, 0x0a, 0x99, 0x44, 0xd2, 0x8a, 0x13, 0xc3, 0xe7,
+	0xbf, 0x2c, 0x3c, 0x40, 0xf4, 0x75, 0xcb, 0x1f, 0x62, 0xd0, 0x41, 0xf6, 0x2f, 0x59, 0x70, 0xb5,
+	0x60, 0xf8, 0x05, 0x39, 0xd8, 0x80, 0x85, 0x63, 0x85, 0x94, 0x43, 0x64, 0x19, 0x0e, 0xdc, 0x99,
+	0x61, 0x71, 0xf2, 0x1f, 0xa8, 0xdb, 0x1c, 0x1f, 0x74, 0xc3, 0x71, 0x34, 0x8f, 0xb0, 0xf7, 0xa0,
+	0xdd, 0x7d, 0x89, 0xd4, 0x65, 0x5d, 0x7f, 0x0a, 0x4d, 0xae, 0x88, 0x07, 0x39, 0x8a, 0x7d, 0xb1,
+	0xf8, 0xfd, 0x18, 0xe6, 0x8c, 0xb2, 0xc8, 0x57, 0x2e, 0x5b, 0x88, 0x96, 0x4d, 0xcd, 0x18, 0x7f,
+	0xcb, 0x4d, 0xba, 0xaf, 0x6a, 0x20, 0xfb, 0x39, 0xcc, 0x3f, 0x19, 0x0f, 0x12, 0x3f, 0x7d, 0xd7,
+	0x8d, 0xbc, 0x03, 0xb5, 0xb4, 0x88, 0x6c, 0xd4, 0x0b, 0xa3, 0x2a, 0x3d, 0x1f, 0x8e, 0xd8, 0x10,
+	0x4b, 0x72, 0xf3, 0x35, 0xe6, 0x11, 0xf6, 0x55, 0x58, 0x4d, 0xab, 0xe4, 0x63, 0x27, 0x4f, 0xbd,
+	0xdf, 0xb2, 0x80, 0xa4, 0x38, 0xf9, 0xcc, 0x1c, 0x79, 0x04, 0x8b, 0xa8, 0x6b, 0x19, 0x50, 0xbd,
+	0x9c, 0x58, 0x8c, 0xc4, 0xb2, 0xd9, 0x3c, 0xfe, 0x69, 0xec, 0x14, 0x7d, 0x81, 0x0b, 0xa4, 0xb8,
+	0xa1, 0xe9, 0x02, 0xc9, 0x0c, 0x49, 0x51, 0x07, 0xbe, 0x03, 0x0d, 0xb3, 0x32, 0xd4, 0xf1, 0x67,
+	0x5a, 0xa6, 0xcb, 0x2b, 0xcd, 0x95, 0x61, 0xe4, 0xb4, 0xff, 0xa1, 0x05, 0x2d, 0x87, 0xe2, 0x32,
+	0xa6, 0x5a, 0xa5, 0x62, 0xf5, 0xbc, 0x9f, 0x2b, 0x76, 0x72, 0x87, 0x95, 0xff, 0xa9, 0xec, 0xeb,
+	0xdd, 0x89, 0x93, 0x82, 0xb6, 0xdc, 0x39, 0x14, 0x6e, 0xd4, 0x7e, 0x74, 0xe6, 0x46, 0xe3, 0x40,
+	0xf0, 0x2f, 0x32, 0x89, 0xfe, 0xa8, 0xa2, 0xe7, 0xbb, 0xb0, 0x2c, 0x1a, 0x2b, 0x1b, 0x7a, 0xd9,
+	0xb0, 0x66, 0xaa, 0x8f, 0xb4, 0xf7, 0x4c, 0x33, 0x63, 0xfa, 0x1b, 0xa9, 0x82, 0x90, 0x97, 0xc8,
+	0xf4, 0xfe, 0x9f, 0x68, 0xc1, 0x4f, 0xd6, 0x47, 0x15, 0xb8, 0x0f, 0x96, 0x8b, 0xdd, 0x07, 0xaf,
+	0x67, 0x2d, 0x9e, 0x0c, 0x2d, 0xea, 0x79, 0x31, 0xa5, 0x8d, 0xa0, 0x92, 0xd3, 0x05, 0x41, 0x25,
+	0x65, 0x1c, 0x0c, 0x6e, 0x29, 0x23, 0x93, 0x99, 0x50, 0xa0, 0xd5, 0x6c, 0x28, 0x50, 0xfb, 0xbb,
+	0x70, 0x75, 0x2b, 0xc0, 0x43, 0x2d, 0xd1, 0x97, 0x87, 0x18, 0xf5, 0xb7, 0x73, 0xa3, 0xde, 0x2a,
+	0x5a, 0x1b, 0x38, 0xa6, 0xda, 0x98, 0xff, 0xb2, 0x05, 0x8b, 0x05, 0xb3, 0x42, 0x1e, 0x64, 0x7d,
+	0xae, 0x26, 0x17, 0x26, 0x33, 0xb2, 0x38, 0x15, 0x94, 0x46, 0x2e, 0x8b, 0xcc, 0x87, 0x56, 0x44,
+	0xe2, 0x1e, 0x98, 0x81, 0x62, 0x37, 0x19, 0x84, 0x3f, 0xd6, 0xc4, 0x47, 0x5f, 0x83, 0xe0, 0x45,
+	0xd6, 0xa8, 0xc5, 0xf0, 0xe4, 0x68, 0x43, 0x8b, 0x87, 0xc4, 0xcf, 0x0f, 0x01, 0x12, 0x93, 0x0d,
+	0x2f, 0xf1, 0x90, 0xb7, 0x51, 0xaf, 0x52, 0x0a, 0x62, 0xf2, 0x23, 0x68, 0xe5, 0x51, 0x62, 0xe4,
+	0x50, 0x3a, 0x26, 0x60, 0x6e, 0xdf, 0x97, 0xa2, 0x4f, 0x03, 0x86, 0x3a, 0x94, 0x63, 0x7f, 0xa0,
+	0x74, 0xfd, 0xd7, 0xd4, 0xfb, 0x86, 0x66, 0x99, 0x9b, 0xfe, 0x80, 0x3a, 0x3c, 0xa7, 0x7d, 0x00,
+	0x4b, 0x45, 0x68, 0x75, 0x67, 0xb0, 0xb4, 0x3b, 0x03, 0xde, 0x71, 0xfd, 0x1f, 0xf3, 0x01, 0x2b,
+	0x3b, 0xec, 0x37, 0xca, 0x43, 0x92, 0x97, 0xf2, 0xd5, 0xa4, 0x8a, 0xc3, 0x13, 0xf6, 0x7f, 0xb4,
+	0x60, 0xf5, 0xa1, 0x97, 0xf4, 0x4e, 0x0b, 0xa2, 0x14, 0x7c, 0x25, 0xb7, 0x04, 0x24, 0xf7, 0x9b,
+	0xfb, 0x42, 0x65, 0xbc, 0xf0, 0x29, 0x97, 0x82, 0xa0, 0x01, 0xe5, 0x82, 0xa0, 0x01, 0x86, 0x0b,
+	0x7f, 0xe5, 0x52, 0x2e, 0xfc, 0x53, 0x13, 0x5c, 0xf8, 0xd1, 0x57, 0xaa, 0x99, 0x6d, 0x71, 0x36,
+	0x04, 0x83, 0x95, 0x0f, 0xc1, 0x30, 0x29, 0xa4, 0x42, 0xe9, 0xd2, 0x21, 0x15, 0xca, 0xb9, 0x90,
+	0x0a, 0x9a, 0x2e, 0xb0, 0x72, 0x71, 0x2c, 0x84, 0xa9, 0x57, 0x88, 0x85, 0x30, 0x5d, 0x1c, 0x0b,
+	0xc1, 0xfe, 0x69, 0x68, 0xe5, 0xe7, 0x57, 0xac, 0xd4, 0x6f, 0x43, 0xb3, 0xc0, 0x3f, 0xac, 0x3c,
+	0x29, 0x0c, 0x81, 0x93, 0xcb, 0x6d, 0xff, 0x86, 0x05, 0xcb, 0x0f, 0xc7, 0xc3, 0xd1, 0x26, 0x1f,
+	0x06, 0xed, 0x8d, 0xa0, 0x4f, 0xe7, 0xe9, 0x92, 0x5f, 0x42, 0x3a, 0x88, 0xa9, 0x35, 0x8b, 0xd6,
+	0x50, 0x06, 0x6a, 0x7f, 0x1b, 0x56, 0xb2, 0xad, 0x13, 0x5d, 0x7f, 0x1d, 0x1a, 0xf1, 0x0b, 0x3a,
+	0x62, 0xdc, 0xa2, 0x7e, 0xe5, 0xcd, 0x40, 0xed, 0xbf, 0x62, 0xc1, 0x0d, 0x87, 0x1e, 0x45, 0xa1,
+	0xd7, 0xef, 0x79, 0x71, 0xa2, 0x7b, 0x31, 0xaa, 0x77, 0x2f, 0xaa, 0x46, 0x19, 0xe7, 0x86, 0x9d,
+	0xfe, 0xec, 0xba, 0xb6, 0x01, 0x37, 0x27, 0xb5, 0x2b, 0xa5, 0x43, 0xc8, 0x84, 0x87, 0x63, 0xee,
+	0x1e, 0x24, 0xe9, 0x90, 0x0e, 0xb3, 0xff, 0xcb, 0x14, 0xd4, 0xb4, 0x6f, 0x8d, 0x80, 0x0e, 0xd6,
+	0xc4, 0xd8, 0x8d, 0x66, 0x10, 0x88, 0x6f, 0x41, 0x8d, 0xfb, 0x73, 0xf6, 0x06, 0x9e, 0x08, 0x23,
+	0xd8, 0x50, 0x1e, 0x5f, 0x5a, 0xe1, 0xfc, 0xf7, 0x3a, 0x66, 0x72, 0xf4, 0x2f, 0xfe, 0x4c, 0xbd,
+	0x5f, 0xdf, 0x86, 0x65, 0x2f, 0x48, 0xfc, 0x9e, 0x3f, 0xf2, 0x12, 0xda, 0x77, 0x79, 0x1c, 0x47,
+	0xbc, 0x1a, 0x70, 0x65, 0x63, 0x31, 0x92, 0x3c, 0x86, 0x86, 0xd0, 0x42, 0x46, 0x2e, 0x0f, 0x34,
+	0x31, 0x63, 0x44, 0x25, 0xd7, 0x7b, 0x65, 0x86, 0x9b, 0xcc, 0x7c, 0x97, 0x9b, 0x84, 0x6a, 0x7e,
+	0x12, 0x50, 0xda, 0xc1, 0xab, 0x56, 0xd3, 0xe9, 0x7a, 0x49, 0x42, 0x87, 0xa3, 0x84, 0x87, 0x43,
+	0x99, 0x73, 0x26, 0xe2, 0x91, 0x48, 0x71, 0x5c, 0x66, 0xd1, 0x70, 0x7d, 0x65, 0x21, 0x0e, 0xed,
+	0x62, 0x02, 0xfa, 0x32, 0x71, 0xb3, 0x85, 0x8a, 0xb1, 0xe7, 0xbe, 0x23, 0xe7, 0x65, 0xb1, 0xdf,
+	0x02, 0x48, 0x27, 0x93, 0xcc, 0x40, 0x79, 0x7d, 0xff, 0x69, 0xf3, 0x0a, 0xea, 0xad, 0xd7, 0xb7,
+	0x0f, 0x9e, 0xf2, 0x78, 0x86, 0xfb, 0xdd, 0xf5, 0xdd, 0x9d, 0x0d, 0x77, 0xbb, 0xfb, 0x14, 0x9d,
+	0xe9, 0xf0, 0x1d, 0x91, 0x4c, 0xd4, 0xcb, 0xab, 0xb0, 0xac, 0xc2, 0x5b, 0xae, 0xef, 0xee, 0x6c,
+	0x6e, 0x39, 0x4f, 0x3a, 0x2c, 0x84, 0xe9, 0x15, 0xd2, 0x82, 0x25, 0x85, 0x42, 0xed, 0x6d, 0x1a,
+	0xfe, 0xb2, 0x30, 0x54, 0x66, 0xc9, 0x00, 0x3f, 0xe9, 0x1c, 0x1c, 0x3a, 0x5b, 0x07, 0x1f, 0x35,
+	0xcb, 0xcc, 0xab, 0x4f, 0x82, 0x59, 0x94, 0xcc, 0x66, 0xc5, 0x7e, 0x8a, 0x2f, 0xc0, 0x04, 0x7d,
+	0x6e, 0xe4, 0x9d, 0x79, 0x50, 0x47, 0x46, 0xed, 0xb5, 0xb4, 0xa8, 0xbd, 0x44, 0x0b, 0x75, 0x38,
+	0x27, 0xc2, 0x19, 0xe2, 0xe3, 0xa6, 0x5e, 0xe2, 0x09, 0xdd, 0x24, 0xfb, 0xcd, 0x5f, 0x51, 0xc9,
+	0x95, 0x2b, 0x58, 0x89, 0x35, 0xb8, 0xa9, 0x9e, 0x4e, 0x36, 0x72, 0x28, 0x71, 0xfb, 0x07, 0x30,
+	0x67, 0x20, 0x3e, 0x55, 0x5b, 0x36, 0x80, 0xc8, 0xe7, 0x9c, 0xf7, 0x68, 0x24, 0x3c, 0x8f, 0x98,
+	0x60, 0x97, 0xd9, 0xe4, 0x49, 0xe9, 0x33, 0x4f, 0xc9, 0xd7, 0x54, 0xc2, 0x40, 0xbe, 0xf3, 0xc3,
+	0x53, 0x76, 0x02, 0x8b, 0x0f, 0xbd, 0x67, 0x54, 0x96, 0x94, 0xde, 0x1c, 0x6a, 0x23, 0x55, 0xa8,
+	0x3c, 0x30, 0x64, 0x90, 0xa5, 0x7c, 0xb5, 0x8e, 0x9e, 0x9b, 0x99, 0x57, 0x8a, 0x37, 0xac, 0x53,
+	0x6e, 0x5a, 0x07, 0xd9, 0x0f, 0x60, 0xc9, 0xac, 0x55, 0x90, 0x33, 0xb4, 0x6e, 0xd7, 0xdf, 0xad,
+	0x9e, 0x75, 0x54, 0x1a, 0x55, 0x00, 0xa8, 0x0b, 0x91, 0xdf, 0x6c, 0x6d, 0xa8, 0x61, 0xfd, 0x06,
+	0xac, 0xe6, 0x30, 0x29, 0x7d, 0xd4, 0xea, 0xe5, 0x1d, 0xa9, 0x38, 0x06, 0xcc, 0x7e, 0x1f, 0x56,
+	0xb9, 0x12, 0x24, 0x2d, 0x40, 0x8b, 0xe1, 0xa4, 0xf7, 0xc4, 0xca, 0xf7, 0xe4, 0x6d, 0x68, 0xe5,
+	0x3f, 0x4e, 0xdd, 0xfc, 0xfa, 0x0c, 0x27, 0x8d, 0xab, 0x65, 0xd2, 0x3e, 0x84, 0x95, 0xfc, 0x20,
+	0x6e, 0xfb, 0x9f, 0x72, 0xe0, 0xe5, 0x10, 0xa5, 0x68, 0x35, 0x44, 0x7f, 0x62, 0xc1, 0x6a, 0x0e,
+	0x25, 0x9a, 0x49, 0x81, 0x0c, 0x69, 0x72, 0x1a, 0xf6, 0xdd, 0x7c, 0xcd, 0xef, 0x28, 0x62, 0x58,
+	0xf8, 0xed, 0xdd, 0x27, 0xec, 0x43, 0x0d, 0xc3, 0x1d, 0x2e, 0x0a, 0x0a, 0x6c, 0xf7, 0x60, 0xa5,
+	0x38, 0x77, 0xc1, 0xeb, 0x6f, 0x5f, 0xd1, 0x95, 0xbf, 0xa9, 0x6b, 0x71, 0xf1, 0x98, 0xe9, 0x7e,
+	0x19, 0x7f, 0x54, 0x82, 0x25, 0x67, 0x6f, 0xfd, 0x89, 0xdf, 0xef, 0x0f, 0xe8, 0x0b, 0x2f, 0xa2,
+	0x9a, 0x68, 0x4d, 0x68, 0x54, 0xd3, 0x89, 0xd4, 0x20, 0x6c, 0xa1, 0x78, 0xe9, 0x23, 0xec, 0x42,
+	0xfa, 0x60, 0xc0, 0xd0, 0xb0, 0x59, 0x38, 0x92, 0xf4, 0xbc, 0xe7, 0xd4, 0x63, 0x87, 0x79, 0xdf,
+	0x67, 0x9b, 0x8a, 0xdf, 0x48, 0x26, 0xa1, 0xd9, 0xe9, 0xc7, 0x47, 0xe4, 0x78, 0x3c, 0x18, 0xb8,
+	0xe3, 0xc8, 0x97, 0x0f, 0x32, 0x64, 0xc0, 0x5c, 0x04, 0x18, 0x51, 0x6f, 0xe8, 0x46, 0xa3, 0x9e,
+	0x60, 0x77, 0x35, 0x08, 0x13, 0xc3, 0x9d, 0x8d, 0xa8, 0xcb, 0xae, 0x03, 0x5c, 0x3d, 0x93, 0x02,
+	0xd8, 0xd7, 0x34, 0xf2, 0xbd, 0x81, 0xff, 0x63, 0xf1, 0x8e, 0x5f, 0xdd, 0xd1, 0x20, 0xdc, 0x90,
+	0x81, 0x4f, 0x9d, 0x90, 0x80, 0x56, 0x23, 0x6d, 0xb5, 0xf6, 0xbc, 0xc1, 0x40, 0x5a, 0xcb, 0x54,
+	0x1c, 0x99, 0xb4, 0xff, 0x9e, 0x05, 0xcb, 0x99, 0x41, 0x15, 0xdf, 0x5c, 0x34, 0xaa, 0x5f, 0xc7,
+	0xfa, 0x4e, 0xfc, 0x38, 0xa1, 0x51, 0x76, 0x2a, 0xb5, 0xc2, 0x30, 0x03, 0x0f, 0xbf, 0xeb, 0xa8,
+	0xec, 0x78, 0x37, 0x3d, 0xa6, 0xb4, 0x8f, 0x82, 0x83, 0x4c, 0xf4, 0xab, 0xad, 0x20, 0xa1, 0x51,
+	0x8f, 0x8e, 0x92, 0x4d, 0x81, 0x77, 0x54, 0x4e, 0xfb, 0x7f, 0x96, 0x60, 0xa5, 0xb8, 0x68, 0x36,
+	0x07, 0x0a, 0xe3, 0x6a, 0xd7, 0xa9, 0x2c, 0x18, 0xcf, 0x4e, 0x31, 0x91, 0x72, 0xea, 0xe5, 0x8c,
+	0x6a, 0x8a, 0x9b, 0xf3, 0xb2, 0x90, 0x3e, 0x10, 0x81, 0xd6, 0xb7, 0x14, 0xb7, 0xd9, 0x7e, 0xfb,
+	0xdc, 0x11, 0x10, 0xee, 0x4b, 0xf9, 0x1d, 0x95, 0x2f, 0x8f, 0x07, 0x11, 0xf2, 0xfa, 0xcc, 0xe6,
+	0xc5, 0x1d, 0xa2, 0xd5, 0x30, 0xbf, 0x6f, 0x64, 0xa0, 0xb8, 0xf3, 0x8a, 0x4b, 0xfd, 0x2c, 0x77,
+	0xde, 0x5f, 0xb4, 0x60, 0x21, 0x37, 0x33, 0x78, 0x21, 0xd5, 0xdf, 0x57, 0xe6, 0x09, 0x2e, 0x50,
+	0x19, 0x0d, 0xbc, 0x1e, 0x75, 0x87, 0xfc, 0x24, 0x94, 0x9e, 0x75, 0x19, 0x30, 0xea, 0x8b, 0x05,
+	0x88, 0x69, 0xd8, 0xb4, 0xc5, 0xcd, 0x8f, 0xc2, 0x09, 0x58, 0xfb, 0x8f, 0x2d, 0xa8, 0xeb, 0x2f,
+	0xbe, 0xe7, 0x02, 0xf9, 0x71, 0xbf, 0x6b, 0x03, 0x76, 0xde, 0x0b, 0x67, 0xea, 0xd9, 0x7f, 0xb1,
+	0xd3, 0x53, 0xc0, 0x05, 0x32, 0x9f, 0xd7, 0xa1, 0xe1, 0xc7, 0x6e, 0x38, 0x8e, 0x8c, 0xb0, 0x8a,
+	0x55, 0x27, 0x03, 0x65, 0x62, 0x6e, 0xfe, 0x82, 0xbc, 0xb8, 0xe5, 0xc9, 0x24, 0x8f, 0xd9, 0x96,
+	0xd0, 0x28, 0xf0, 0x06, 0xe2, 0x65, 0x4e, 0x95, 0xb6, 0xbf, 0x0c, 0x8b, 0xfb, 0x78, 0xe5, 0x1e,
+	0xf4, 0xf7, 0x91, 0x89, 0x93, 0xc4, 0x8e, 0x31, 0x01, 0x4c, 0x9a, 0x22, 0x82, 0xf1, 0xf2, 0x94,
+	0x7d, 0x1f, 0x96, 0xcc, 0xec, 0xe9, 0xd6, 0xe7, 0x39, 0xd4, 0x41, 0x25, 0x92, 0xcc, 0xbb, 0x5d,
+	0x66, 0x37, 0x64, 0x2a, 0x7f, 0x6c, 0x41, 0x43, 0x61, 0xb8, 0x86, 0xe0, 0x2b, 0xc6, 0xb3, 0x8b,
+	0xb7, 0x54, 0xe8, 0x76, 0x3d, 0x53, 0x2e, 0x68, 0xb4, 0x0c, 0xba, 0x58, 0x4a, 0x9f, 0x97, 0x9c,
+	0x18, 0x40, 0x5e, 0x2d, 0xa6, 0x8a, 0xb6, 0x98, 0xec, 0x1f, 0xe8, 0xe1, 0xa5, 0x17, 0x61, 0x9e,
+	0xf1, 0x80, 0xee, 0xde, 0xe1, 0xc3, 0xed, 0xad, 0xfd, 0xc7, 0xec, 0x11, 0x1f, 0x64, 0x54, 0x19,
+	0x50, 0xd8, 0x6e, 0xb0, 0x48, 0x10, 0x0c, 0xb2, 0xef, 0x76, 0x77, 0x3a, 0x0f, 0xb7, 0x65, 0x10,
+	0x7e, 0x01, 0xdb, 0xd8, 0xda, 0xe7, 0xc0, 0xb2, 0xed, 0xc1, 0x82, 0x30, 0x9d, 0x3a, 0x74, 0xb6,
+	0xf4, 0xb3, 0xe4, 0x93, 0x58, 0xbd, 0x29, 0x6b, 0x95, 0xb2, 0xfe, 0xea, 0xe6, 0x29, 0x10, 0xbd,
+	0x0a, 0x15, 0x93, 0x78, 0x46, 0x98, 0x87, 0x65, 0x22, 0x8c, 0x88, 0xbc, 0x8e, 0x44, 0xe3, 0x1e,
+	0xc6, 0xf3, 0x84, 0x0f, 0x20, 0xfe, 0xc4, 0xfa, 0x7f, 0x14, 0xb1, 0x43, 0x86, 0x57, 0x24, 0x52,
+	0xc8, 0xbd, 0x6e, 0xd3, 0x13, 0xaf, 0x77, 0xb6, 0xc7, 0xdd, 0x80, 0xd1, 0xfe, 0x45, 0x71, 0x08,
+	0x7f, 0x64, 0x41, 0xbb, 0x08, 0x9b, 0x06, 0x5b, 0x40, 0x25, 0x91, 0xd0, 0x76, 0x50, 0x49, 0xec,
+	0x4d, 0xa0, 0x74, 0x6d, 0x52, 0xcf, 0xb1, 0x08, 0xa3, 0x31, 0x1d, 0x96, 0xe6, 0xf9, 0x21, 0xed,
+	0x25, 0x54, 0x8a, 0xaa, 0x0c, 0x18, 0x7f, 0x96, 0x08, 0x7f, 0x9b, 0x77, 0x45, 0x13, 0xc8, 0xdd,
+	0x78, 0x18, 0x40, 0xb7, 0x3e, 0xd5, 0x40, 0xf6, 0xaf, 0x59, 0xb0, 0xb0, 0x37, 0x8e, 0x4f, 0x79,
+	0xc0, 0xed, 0xf3, 0x6e, 0x00, 0x6f, 0xc2, 0x02, 0x93, 0x0b, 0x49, 0xa7, 0x37, 0x16, 0xd8, 0x84,
+	0x13, 0xa5, 0x3c, 0x82, 0x49, 0x72, 0xa5, 0x49, 0x72, 0x99, 0xb1, 0x94, 0x2a, 0x8d, 0xfd, 0xc3,
+	0x73, 0xd3, 0x08, 0xe9, 0x5c, 0x75, 0x0c, 0x98, 0xfd, 0x2e, 0x10, 0xbd, 0x59, 0x29, 0xb3, 0xca,
+	0x14, 0x71, 0xe2, 0xd6, 0x20, 0x8c, 0x06, 0x0c, 0x98, 0xfd, 0xd3, 0x00, 0xa9, 0xee, 0xb1, 0x50,
+	0x5b, 0xcb, 0x5f, 0x5e, 0xcd, 0xc1, 0xb1, 0x74, 0xe5, 0xd3, 0x39, 0x1a, 0x0d, 0x85, 0x5c, 0xc2,
+	0x80, 0xd9, 0xdf, 0x82, 0xd5, 0x4d, 0x4a, 0x9f, 0x78, 0x81, 0x77, 0x42, 0x23, 0xc3, 0xa0, 0x40,
+	0x1a, 0x6d, 0xf7, 0x69, 0xcf, 0x97, 0x0c, 0xa2, 0x32, 0xda, 0x56, 0x40, 0xfb, 0x97, 0x4a, 0x50,
+	0xdb, 0xa4, 0x74, 0x43, 0x00, 0xf2, 0x5a, 0xc3, 0x72, 0x91, 0xd6, 0x70, 0x92, 0xcb, 0xe6, 0x6d,
+	0x98, 0x0f, 0x07, 0xa6, 0x8a, 0x9f, 0xab, 0x35, 0xb3, 0x60, 0xcc, 0x19, 0xd0, 0x17, 0x46, 0x4e,
+	0x21, 0x5e, 0xc8, 0x80, 0x3f, 0x83, 0x87, 0x1d, 0xdf, 0x54, 0x5a, 0x42, 0xda, 0xcf, 0x68, 0xa1,
+	0xf3, 0x08, 0xfb, 0x77, 0x51, 0xe1, 0x9b, 0x1b, 0x53, 0xfd, 0x31, 0xf4, 0x82, 0x37, 0x2e, 0xdb,
+	0x50, 0x65, 0x0c, 0x01, 0x3d, 0x91, 0xbe, 0x56, 0x2a, 0xad, 0xce, 0x83, 0xe7, 0xde, 0x40, 0xec,
+	0x1d, 0x95, 0x46, 0x1c, 0xd3, 0x30, 0xa2, 0x12, 0x86, 0xdb, 0xfc, 0xa8, 0x34, 0x7a, 0x63, 0xa7,
+	0x93, 0x37, 0x65, 0xd8, 0xe8, 0x6b, 0x33, 0xe6, 0xa4, 0x99, 0x50, 0x6e, 0xde, 0x3d, 0x3e, 0xa6,
+	0xac, 0x49, 0x28, 0x57, 0xf3, 0xd2, 0xd8, 0x1f, 0x76, 0x04, 0xcd, 0x2c, 0x0e, 0x27, 0x3b, 0x1e,
+	0x1f, 0x71, 0xc3, 0x28, 0xf5, 0xc0, 0xad, 0x04, 0xf0, 0xd8, 0x36, 0xc1, 0xb1, 0xcb, 0x05, 0x61,
+	0xe2, 0x7a, 0xab, 0x83, 0x32, 0xe1, 0xf9, 0xca, 0xd9, 0xf0, 0x7c, 0xb6, 0x03, 0x57, 0x0b, 0xda,
+	0x23, 0x86, 0xf2, 0x1d, 0x6e, 0xf1, 0x18, 0x69, 0x6e, 0x79, 0x52, 0x92, 0x9d, 0xfd, 0xc8, 0x49,
+	0x73, 0xe2, 0x83, 0xb4, 0x8f, 0x68, 0xc2, 0x2c, 0xbf, 0x74, 0x4f, 0xd2, 0x3d, 0x68, 0x32, 0xd8,
+	0xc3, 0x71, 0xef, 0x19, 0x65, 0x86, 0x82, 0xf1, 0x24, 0x21, 0xfc, 0x33, 0x7a, 0x26, 0xad, 0xbc,
+	0xd9, 0x6f, 0x24, 0xf3, 0x7a, 0x68, 0x7e, 0x9e, 0xb0, 0x7f, 0x7e, 0x0a, 0x96, 0xcc, 0x9a, 0x44,
+	0xc3, 0xbf, 0x05, 0xd3, 0x4c, 0x8c, 0x7d, 0xd2, 0xb2, 0x0c, 0xaf, 0xf6, 0xa2, 0xcc, 0x77, 0x59,
+	0xcc, 0xed, 0x13, 0xce, 0x12, 0x8a, 0xcf, 0xa4, 0x56, 0xff, 0x24, 0xe4, 0xfe, 0x67, 0x54, 0x06,
+	0x12, 0xc8, 0x40, 0x91, 0x58, 0x9c, 0x52, 0x6f, 0xe4, 0x7a, 0x83, 0x41, 0xd8, 0x33, 0x5e, 0x0f,
+	0xc8, 0xc1, 0xc9, 0xd7, 0x00, 0xfa, 0x47, 0xee, 0x11, 0xeb, 0xbd, 0xf4, 0xdd, 0x97, 0xc3, 0x99,
+	0x1d, 0x18, 0x47, 0xcb, 0x7a, 0x41, 0x94, 0x7a, 0xf1, 0x9c, 0x56, 0x71, 0x64, 0x55, 0xce, 0xf7,
+	0x4c, 0xce, 0x80, 0x16, 0x1d, 0x88, 0x9c, 0x10, 0x49, 0x94, 0x2b, 0xc6, 0xce, 0xc9, 0x91, 0xc6,
+	0x8c, 0x3f, 0x1e, 0xe0, 0xf9, 0xe1, 0x2a, 0x13, 0xca, 0xaa, 0x1e, 0x33, 0x3e, 0x83, 0xcc, 0xc5,
+	0x15, 0xf3, 0xa3, 0xde, 0xd8, 0x57, 0x52, 0xbb, 0x42, 0x1c, 0x3b, 0x4d, 0xc6, 0x43, 0xd1, 0x7c,
+	0xf9, 0x01, 0x17, 0xd7, 0xe5, 0x11, 0xf2, 0x29, 0x33, 0x59, 0x0a, 0x93, 0xc7, 0xc5, 0x42, 0x44,
+	0x57, 0x80, 0x69, 0x7f, 0x1d, 0x6a, 0xda, 0x3a, 0xb8, 0xe8, 0xf1, 0xf4, 0x59, 0x9d, 0x4b, 0xff,
+	0x0d, 0x0b, 0x6e, 0x31, 0x6d, 0x5d, 0x9e, 0xa1, 0x57, 0x16, 0xac, 0x59, 0x21, 0x4c, 0x3d, 0x15,
+	0xc2, 0x64, 0xc5, 0x13, 0xa5, 0x57, 0x95, 0x0b, 0xb1, 0x7b, 0x2e, 0xbf, 0xf3, 0x0a, 0xe6, 0x44,
+	0x07, 0xd9, 0x0e, 0xac, 0x4d, 0x6e, 0xdd, 0xb9, 0xef, 0x58, 0xb3, 0xdb, 0x2b, 0x5e, 0xc0, 0x78,
+	0xa3, 0x66, 0x1d, 0x99, 0xb4, 0xdf, 0x84, 0xe6, 0x87, 0x5e, 0x34, 0x5a, 0x47, 0xc1, 0xb1, 0xf6,
+	0x0a, 0x74, 0x4c, 0xf1, 0x7a, 0x1e, 0x4b, 0xcf, 0x19, 0x91, 0xb4, 0x3f, 0x82, 0x05, 0x2d, 0xb7,
+	0xa8, 0xb2, 0x09, 0xe5, 0x40, 0x58, 0x93, 0x96, 0x1d, 0xfc, 0x99, 0xbe, 0xcf, 0x27, 0x6c, 0x39,
+	0x64, 0x69, 0x9c, 0x0e, 0x14, 0xe2, 0xec, 0x0e, 0x5c, 0xe3, 0xd6, 0x0a, 0xd2, 0x46, 0x97, 0x7b,
+	0xf6, 0xe9, 0x8e, 0x0d, 0x59, 0xa3, 0xf8, 0xcc, 0xf3, 0x48, 0xf6, 0x1d, 0x68, 0x98, 0x1f, 0x63,
+	0x4f, 0xa4, 0xd3, 0x20, 0xff, 0x40, 0x26, 0xed, 0xaf, 0xc1, 0x35, 0xae, 0xf5, 0x2c, 0xae, 0x6e,
+	0xf2, 0x87, 0x3f, 0x57, 0x86, 0xeb, 0xc5, 0x5f, 0x9e, 0x3b, 0x03, 0x78, 0xd3, 0x09, 0xd8, 0x4f,
+	0x69, 0x32, 0xce, 0x57, 0x5f, 0x06, 0x8a, 0x5f, 0x8f, 0xbc, 0x33, 0x2a, 0xb5, 0xb8, 0x3c, 0x71,
+	0xa9, 0xc7, 0xa1, 0xda, 0x50, 0x55, 0x06, 0xfa, 0xfc, 0x39, 0xd5, 0xaa, 0x6e, 0x98, 0x9f, 0x8f,
+	0x00, 0xa3, 0x83, 0x2e, 0x11, 0x51, 0x27, 0x63, 0x96, 0x5b, 0xcd, 0x9b, 0xe5, 0x1a, 0x2e, 0x39,
+	0xb3, 0x19, 0x97, 0x1c, 0xcd, 0xa5, 0x87, 0xf1, 0xa3, 0x60, 0xb8, 0xf4, 0x20, 0xa8, 0xc8, 0xec,
+	0xbf, 0x56, 0x68, 0xf6, 0x6f, 0xdf, 0x84, 0xeb, 0x4a, 0x93, 0xc7, 0xe2, 0x77, 0x9e, 0x99, 0x8f,
+	0xb3, 0xfc, 0x42, 0x09, 0x16, 0x04, 0x46, 0x0b, 0x74, 0x7c, 0xb9, 0x87, 0xd3, 0x0a, 0xec, 0x19,
+	0x4a, 0xc5, 0xf6, 0x0c, 0x6f, 0xc9, 0x18, 0xdc, 0x65, 0xc3, 0xfa, 0x3f, 0xdf, 0xb2, 0xf4, 0x11,
+	0x2e, 0x9d, 0xbf, 0xaa, 0x64, 0xf8, 0xab, 0x82, 0x77, 0x49, 0xa7, 0x8a, 0xdf, 0x25, 0x2d, 0x08,
+	0xd5, 0x39, 0x5d, 0x18, 0xaa, 0xd3, 0xfe, 0xcb, 0x16, 0xd4, 0x65, 0x43, 0x2e, 0xff, 0x0e, 0x56,
+	0xe6, 0xb9, 0x0c, 0x1e, 0xdf, 0x2e, 0x48, 0x2f, 0x24, 0x55, 0x27, 0x05, 0x30, 0xcf, 0x04, 0x15,
+	0xd7, 0x9a, 0xca, 0x29, 0x92, 0x97, 0xfd, 0x42, 0x9c, 0xfd, 0xb3, 0x16, 0xdc, 0x98, 0x30, 0x81,
+	0x17, 0x1a, 0x5f, 0xe4, 0xe6, 0x55, 0x53, 0xbd, 0x7f, 0x51, 0x06, 0xd2, 0x2c, 0x19, 0xb6, 0x55,
+	0xfa, 0x08, 0x88, 0x08, 0x9a, 0x77, 0xf6, 0xa0, 0xb6, 0xe1, 0xd1, 0x61, 0x18, 0x70, 0x87, 0xc3,
+	0x25, 0x68, 0x4a, 0x35, 0xc9, 0xc1, 0xae, 0xbb, 0x7f, 0xd0, 0x71, 0x30, 0x3a, 0x5f, 0x1d, 0xaa,
+	0x87, 0x3b, 0xdb, 0xbb, 0xeb, 0x1f, 0xb0, 0x7b, 0x31, 0x3e, 0x1e, 0x2f, 0x5e, 0x5d, 0xc2, 0x47,
+	0xcd, 0xf6, 0xbb, 0xce, 0xd3, 0xf4, 0x21, 0xa6, 0xf2, 0x9d, 0xff, 0x1f, 0x16, 0x0f, 0x03, 0xfe,
+	0xda, 0x09, 0x0b, 0x8e, 0xd0, 0x61, 0x3a, 0x04, 0x7c, 0xda, 0xee, 0x70, 0x07, 0x5f, 0xb0, 0xea,
+	0x6e, 0xb8, 0x8f, 0x9c, 0xce, 0xde, 0x63, 0x37, 0x7d, 0x0c, 0x6f, 0x15, 0x16, 0x33, 0xb8, 0xfd,
+	0xee, 0x0e, 0x56, 0x75, 0x15, 0x96, 0x33, 0x08, 0xa7, 0xbb, 0x79, 0xc8, 0xde, 0x9b, 0x6c, 0xc1,
+	0x52, 0x06, 0xf5, 0xdd, 0xc3, 0xee, 0x21, 0x36, 0xe0, 0x25, 0xd4, 0x44, 0xc8, 0x1d, 0x76, 0xdb,
+	0x5f, 0x85, 0xc5, 0x0f, 0xb7, 0x0e, 0x76, 0xba, 0xfb, 0xfb, 0x78, 0xdf, 0xff, 0xa0, 0xfb, 0x91,
+	0xfb, 0xb8, 0xb3, 0xff, 0xb8, 0x79, 0x05, 0x5f, 0xb2, 0xdc, 0xe9, 0xe2, 0xe3, 0x7a, 0x06, 0x9c,
+	0x3f, 0x2d, 0xa5, 0x01, 0xd8, 0x0b, 0x5c, 0xfb, 0xeb, 0xce, 0xd6, 0xde, 0x01, 0x07, 0x94, 0xf1,
+	0xcb, 0xc3, 0x9d, 0xc3, 0xfd, 0xcc, 0x97, 0x95, 0x3b, 0x4f, 0x60, 0x56, 0x45, 0x96, 0x45, 0x4d,
+	0xd4, 0xd6, 0xce, 0xd6, 0xc1, 0x56, 0xe7, 0x60, 0xd7, 0x71, 0x53, 0xdf, 0x91, 0x45, 0x98, 0x4f,
+	0xc1, 0xec, 0x81, 0xcd, 0xa6, 0xc5, 0x03, 0x4c, 0x4a, 0x20, 0x0f, 0x2a, 0xd9, 0x2c, 0xdd, 0x79,
+	0x1f, 0x9a, 0x59, 0xc7, 0x45, 0xc3, 0xd9, 0xf3, 0x3c, 0xaf, 0xd0, 0x3b, 0xbf, 0x5e, 0x82, 0xa5,
+	0x22, 0x07, 0x1c, 0x1c, 0x0f, 0x14, 0x71, 0x1c, 0x3a, 0x5d, 0xd7, 0xe9, 0x76, 0xf6, 0x77, 0x77,
+	0xdc, 0x9d, 0x5d, 0xf6, 0xc8, 0x56, 0x1b, 0x56, 0x32, 0x88, 0x83, 0xad, 0x27, 0xdd, 0xdd, 0x43,
+	0x74, 0x71, 0xb9, 0x06, 0xab, 0xb9, 0x8f, 0x5c, 0x67, 0xf7, 0xf0, 0x40, 0x4c, 0x45, 0x06, 0xc9,
+	0x9f, 0x18, 0x2c, 0x93, 0x37, 0xe1, 0x76, 0x06, 0x83, 0xaf, 0xe2, 0x39, 0x4e, 0x77, 0xfd, 0xc0,
+	0xdd, 0xeb, 0x7c, 0xc4, 0x5e, 0x39, 0xdc, 0xe8, 0x1e, 0x74, 0xb6, 0xb6, 0xf7, 0x9b, 0x95, 0x82,
+	0x4a, 0x54, 0x7f, 0xa6, 0xc8, 0xe7, 0xe1, 0x56, 0x06, 0xf9, 0xf0, 0x70, 0xe3, 0x51, 0xf7, 0xc0,
+	0xed, 0x7e, 0xef, 0x71, 0xe7, 0x90, 0xbd, 0x91, 0x38, 0x4d, 0xde, 0x80, 0xcf, 0xe7, 0xea, 0xdb,
+	0x3f, 0xdc, 0xdc, 0xdc, 0x5a, 0xdf, 0x62, 0x0f, 0x2a, 0x76, 0xb6, 0xb1, 0xc0, 0xe6, 0xcc, 0x9d,
+	0x8f, 0x61, 0xa9, 0x88, 0x3e, 0xe9, 0xeb, 0x7f, 0x73, 0xd7, 0x71, 0xf1, 0x89, 0x31, 0xfe, 0x14,
+	0xea, 0x46, 0xe7, 0xa0, 0xe3, 0x6e, 0xef, 0xe2, 0x22, 0x72, 0x76, 0x0f, 0xba, 0xeb, 0x62, 0x50,
+	0xf4, 0xcc, 0xe6, 0x7b, 0xa8, 0xe6, 0x73, 0x7c, 0x73, 0x30, 0xeb, 0x74, 0xd7, 0x77, 0x9f, 0x76,
+	0x1d, 0x7c, 0x8f, 0xef, 0xc1, 0x2f, 0x97, 0xa1, 0xc1, 0xe3, 0x55, 0x1e, 0x06, 0x2c, 0x46, 0x70,
+	0x44, 0x9e, 0xc0, 0xcc, 0x23, 0x1a, 0xec, 0x53, 0xda, 0x27, 0xcb, 0x8a, 0xb9, 0x67, 0x69, 0x41,
+	0xcd, 0xdb, 0x2b, 0x59, 0xb0, 0x50, 0x29, 0x2e, 0xfe, 0xdc, 0xbf, 0xf9, 0xe3, 0x5f, 0x2d, 0xcd,
+	0x91, 0xda, 0xbd, 0xe7, 0x6f, 0xdd, 0x3b, 0xa1, 0x41, 0x8c, 0x65, 0x30, 0x11, 0x80, 0x9f, 0xf0,
+	0x4a, 0x48, 0x4b, 0x8b, 0x7b, 0xcc, 0x41, 0xb2, 0xd0, 0xab, 0x05, 0x18, 0x69, 0xf5, 0xc4, 0xca,
+	0x5d, 0xb4, 0x1b, 0x58, 0xae, 0x1f, 0xf8, 0xc9, 0x0b, 0x86, 0x7f, 0xcf, 0xba, 0x43, 0xfa, 0x50,
+	0xe7, 0x0d, 0x17, 0xe5, 0x4b, 0x3b, 0x3c, 0x1d, 0x28, 0x6b, 0xb8, 0x56, 0x88, 0x13, 0x75, 0x5c,
+	0x63, 0x75, 0x2c, 0xdb, 0x4d, 0xac, 0x63, 0xcc, 0x72, 0xa4, 0xb5, 0x0c, 0xb8, 0x09, 0xe4, 0x09,
+	0xdd, 0xf3, 0xe2, 0xf8, 0x45, 0x18, 0xf5, 0xc9, 0x75, 0xed, 0x6c, 0x49, 0xc1, 0xb2, 0xa6, 0x1b,
+	0x13, 0xb0, 0xa2, 0xae, 0x1b, 0xac, 0xae, 0x55, 0x9b, 0x60, 0x5d, 0x3d, 0x96, 0x67, 0x24, 0xf2,
+	0xbc, 0x67, 0xdd, 0x79, 0xf0, 0x6b, 0x16, 0x4c, 0xf1, 0x3d, 0xb6, 0x0b, 0x0d, 0xa5, 0xa3, 0xe5,
+	0x10, 0x59, 0xaf, 0x09, 0xce, 0xd6, 0x9b, 0xc5, 0xf2, 0x7a, 0xef, 0x5b, 0xe4, 0x7d, 0xa8, 0x3e,
+	0xe2, 0xf7, 0x20, 0x4a, 0xd2, 0x59, 0x4c, 0x8c, 0x42, 0x56, 0x73, 0x70, 0xfe, 0xf9, 0x83, 0x9f,
+	0xfd, 0x06, 0xcc, 0xaa, 0xa0, 0x49, 0xe4, 0x87, 0x30, 0x67, 0x04, 0x3a, 0x25, 0x72, 0x78, 0x8b,
+	0xe2, 0xa2, 0xb6, 0xaf, 0x17, 0x23, 0xc5, 0x80, 0xdc, 0x64, 0x03, 0xd2, 0x22, 0x2b, 0x38, 0x20,
+	0xe2, 0xa8, 0xbc, 0xc7, 0x6c, 0x27, 0xf8, 0xc3, 0x4e, 0xcf, 0x34, 0x13, 0x54, 0x5e, 0xd9, 0xf5,
+	0xac, 0xb1, 0x9e, 0x51, 0xdb, 0x8d, 0x09, 0x58, 0x51, 0xdd, 0x75, 0x56, 0xdd, 0x0a, 0x59, 0xd2,
+	0xab, 0x53, 0x67, 0x16, 0x65, 0x4f, 0xae, 0x1d, 0x44, 0x5e, 0x10, 0x73, 0xad, 0x73, 0x4c, 0x6e,
+	0xa4, 0x43, 0xa2, 0xc3, 0xb3, 0x4b, 0x57, 0xc3, 0x71, 0x39, 0x7e, 0x6c, 0xb7, 0x58, 0x55, 0x84,
+	0xb0, 0x65, 0x95, 0xe8, 0x65, 0x1e, 0x41, 0xad, 0x1b, 0x27, 0xfe, 0xd0, 0x4b, 0xf0, 0xa2, 0x4f,
+	0x64, 0x19, 0x1a, 0x4c, 0x16, 0xdf, 0x2e, 0x42, 0x15, 0x75, 0x45, 0x2f, 0xff, 0x1e, 0x1a, 0x8d,
+	0xfc, 0x00, 0x66, 0x99, 0x01, 0x40, 0xe8, 0x07, 0x31, 0x91, 0xf3, 0xaa, 0x20, 0xb2, 0xfc, 0x56,
+	0x1e, 0x51, 0xb4, 0x29, 0xf4, 0xd2, 0x71, 0x53, 0x7c, 0x88, 0x76, 0x3a, 0x71, 0x72, 0x18, 0xc4,
+	0x23, 0x1a, 0x24, 0xaa, 0x03, 0x1a, 0x2c, 0xdb, 0x01, 0x03, 0x25, 0xaa, 0x58, 0x60, 0x55, 0xd4,
+	0xc8, 0x2c, 0xdb, 0x77, 0xc9, 0xcb, 0x30, 0x26, 0xdb, 0xb0, 0xac, 0x16, 0xf0, 0xab, 0x4c, 0x03,
+	0xc9, 0x4f, 0x03, 0x5f, 0xf2, 0xd8, 0xb1, 0x27, 0x5e, 0x70, 0x46, 0x56, 0xb4, 0x9e, 0x22, 0x20,
+	0xbb, 0xe4, 0x53, 0xb8, 0xe0, 0x7a, 0x3e, 0x02, 0xd8, 0xa1, 0x2f, 0x54, 0xdc, 0x3c, 0x91, 0x2d,
+	0x05, 0x65, 0x57, 0x80, 0x8e, 0x11, 0x1d, 0x5c, 0x61, 0x1d, 0x6c, 0x12, 0x46, 0xbc, 0x02, 0xfa,
+	0x42, 0x32, 0x71, 0x3f, 0x03, 0xb5, 0x7d, 0xff, 0x24, 0x90, 0xb6, 0x15, 0xb2, 0x04, 0x0d, 0x96,
+	0x1d, 0x3e, 0x03, 0x25, 0x4a, 0x6f, 0xb3, 0xd2, 0x97, 0xec, 0x79, 0x2c, 0x3d, 0xf6, 0x4f, 0x02,
+	0x21, 0x7a, 0xc5, 0x09, 0x3a, 0x85, 0x39, 0x7e, 0x31, 0x92, 0x75, 0xc8, 0x1d, 0x6a, 0x40, 0xb3,
+	0x3b, 0x34, 0x83, 0x34, 0xd7, 0x99, 0xbd, 0x80, 0xf5, 0x3c, 0x67, 0x59, 0xb4, 0x9a, 0xbe, 0xcf,
+	0xae, 0xf8, 0x01, 0xed, 0xb1, 0x77, 0x3f, 0x55, 0x5f, 0x34, 0x58, 0xb6, 0x2f, 0x06, 0x4a, 0xd4,
+	0xb1, 0xc4, 0xea, 0x68, 0xd8, 0x6c, 0x29, 0x30, 0xa6, 0x10, 0xcb, 0xfe, 0x21, 0x34, 0x36, 0xfc,
+	0xb8, 0xa7, 0x15, 0x2f, 0x5b, 0x6a, 0x82, 0xb3, 0x7b, 0x3f, 0x8b, 0x35, 0x97, 0xf4, 0x9d, 0x45,
+	0x55, 0xc9, 0xbd, 0x9f, 0x08, 0x8e, 0xfb, 0x63, 0xf2, 0x14, 0x16, 0xf6, 0x29, 0xcb, 0x8f, 0xed,
+	0x13, 0x91, 0x91, 0x6e, 0xa9, 0xc5, 0x91, 0xc1, 0xc8, 0x1a, 0xd7, 0x26, 0x67, 0x10, 0xcb, 0xe8,
+	0xbb, 0x30, 0xcb, 0x4d, 0x12, 0x50, 0xaa, 0xb4, 0x6a, 0x18, 0x29, 0xd0, 0x28, 0xb7, 0x0f, 0x73,
+	0xef, 0xa7, 0x9a, 0x9b, 0x84, 0x35, 0x1a, 0x9b, 0x9a, 0x7b, 0xca, 0x53, 0x35, 0x75, 0xd2, 0x0b,
+	0xa0, 0xed, 0xb5, 0xc9, 0x19, 0x44, 0x53, 0x3f, 0x00, 0xf2, 0x88, 0x26, 0xd9, 0xf7, 0x32, 0xd5,
+	0xce, 0x2b, 0x7c, 0x7b, 0xb3, 0xbd, 0x52, 0x8c, 0x26, 0x0e, 0xb4, 0xd4, 0x4e, 0xce, 0xe2, 0xae,
+	0xe7, 0xbe, 0xd1, 0x14, 0x76, 0x93, 0x4a, 0xbc, 0x6f, 0x71, 0xf6, 0x84, 0xbd, 0x88, 0xa9, 0xb1,
+	0x27, 0xfa, 0xa3, 0x99, 0xed, 0x95, 0x2c, 0xb8, 0x98, 0x3d, 0x49, 0x7c, 0x2c, 0x23, 0x80, 0xf9,
+	0x6c, 0xd0, 0xfe, 0x1b, 0x93, 0x1e, 0xdf, 0xe0, 0xc5, 0xdf, 0x3c, 0xff, 0x6d, 0x0e, 0x93, 0x24,
+	0xcb, 0x53, 0xe5, 0x9e, 0x7c, 0x27, 0x6b, 0x0f, 0xe6, 0x33, 0x2f, 0x93, 0x93, 0x1b, 0xda, 0xa4,
+	0xe4, 0x5f, 0x3c, 0x6f, 0xdf, 0x9c, 0x84, 0x16, 0x33, 0xe6, 0xc2, 0x52, 0xd1, 0xb3, 0x0b, 0xc4,
+	0x2e, 0x6c, 0xa7, 0xf1, 0x66, 0x43, 0xfb, 0xf3, 0xe7, 0xe6, 0x11, 0x15, 0xfc, 0x3f, 0x50, 0xc7,
+	0xba, 0xd5, 0xf8, 0xe8, 0xe4, 0x3c, 0x3b, 0x38, 0xd7, 0x0a, 0x71, 0xe6, 0x06, 0x27, 0x75, 0x7d,
+	0x64, 0xc8, 0x53, 0x58, 0x49, 0x0d, 0xd1, 0xb4, 0x58, 0xfa, 0xe9, 0x72, 0x9e, 0xf4, 0xe8, 0x41,
+	0xfb, 0xea, 0xc4, 0x10, 0xfc, 0xf7, 0x2d, 0x24, 0x1c, 0xe6, 0xab, 0xf4, 0x29, 0xd3, 0x50, 0xf4,
+	0xcc, 0x7f, 0xfb, 0xc6, 0x04, 0xac, 0x49, 0x38, 0xc8, 0xa2, 0x31, 0xad, 0x3c, 0xfa, 0x18, 0xf9,
+	0x26, 0x34, 0xf6, 0x99, 0xd5, 0xcb, 0xa5, 0x06, 0x29, 0xf3, 0xfc, 0xc2, 0x7d, 0x8b, 0x38, 0xb0,
+	0x24, 0xbe, 0x7f, 0x95, 0x16, 0x9f, 0xf3, 0x1c, 0xc3, 0x7d, 0x8b, 0x7c, 0x1f, 0xe6, 0x35, 0x0b,
+	0x6b, 0xdc, 0x43, 0x8a, 0x30, 0xe7, 0x2d, 0xeb, 0xdb, 0x45, 0x56, 0xd0, 0xf6, 0x2a, 0xeb, 0xf3,
+	0x82, 0x6d, 0x4c, 0x18, 0x12, 0xe5, 0x75, 0xa8, 0x69, 0x65, 0x9c, 0x57, 0xee, 0xaa, 0x86, 0xd2,
+	0x1f, 0x9b, 0xbb, 0x6f, 0xe1, 0x56, 0x10, 0x99, 0x3b, 0x2c, 0x04, 0x4f, 0x18, 0x65, 0xd9, 0x3a,
+	0x0e, 0x97, 0x53, 0xd0, 0xbe, 0x56, 0x8c, 0x65, 0x15, 0xdd, 0xb6, 0xee, 0x5b, 0xe4, 0xaf, 0x59,
+	0x50, 0x37, 0x9e, 0x42, 0x32, 0x22, 0x0e, 0x66, 0x5a, 0xd6, 0xd2, 0x71, 0x7a, 0xd3, 0x6c, 0x87,
+	0x75, 0x7b, 0xfb, 0xce, 0x77, 0x8c, 0xa9, 0xfe, 0x89, 0x21, 0x96, 0xba, 0x2b, 0xcd, 0xec, 0xd1,
+	0x06, 0x00, 0xf5, 0xe8, 0x1f, 0x67, 0x33, 0xe8, 0xc6, 0x1a, 0x1f, 0xdf, 0xb7, 0xc8, 0xdf, 0xb7,
+	0xa0, 0x61, 0x46, 0x05, 0x50, 0xdd, 0x2d, 0x8c, 0x5b, 0xd0, 0xbe, 0x31, 0x01, 0x2b, 0x16, 0xe4,
+	0xf7, 0x59, 0x2b, 0x0f, 0xee, 0x38, 0x46, 0x2b, 0x3d, 0x9e, 0xf9, 0xd3, 0xb5, 0x96, 0xbc, 0x07,
+	0x35, 0xe4, 0x85, 0x64, 0x04, 0x14, 0xa2, 0xf1, 0x47, 0xd9, 0x05, 0xc3, 0x61, 0xbc, 0x4d, 0x6c,
+	0x12, 0x7e, 0x06, 0xe6, 0xb5, 0x6f, 0xd9, 0xba, 0xbb, 0xec, 0xf7, 0xf6, 0x6b, 0xac, 0x4f, 0x37,
+	0xed, 0xab, 0x46, 0x9f, 0xb2, 0x9c, 0x67, 0x87, 0xb7, 0xee, 0x20, 0xe4, 0x41, 0xc1, 0xaf, 0x6a,
+	0x25, 0x09, 0xd8, 0x85, 0x8d, 0x1c, 0xc2, 0xbc, 0x96, 0xdd, 0xd8, 0x1c, 0x97, 0x2c, 0xc6, 0xbe,
+	0xc3, 0xda, 0xfa, 0x9a, 0x7d, 0x6b, 0x62, 0x5b, 0xef, 0x31, 0x0f, 0x7f, 0x6c, 0xf1, 0x1e, 0x40,
+	0x1a, 0xfe, 0x88, 0x64, 0x8c, 0x23, 0x14, 0x19, 0xcb, 0x47, 0x48, 0x32, 0x77, 0xa0, 0x8c, 0xc0,
+	0x83, 0x25, 0xfe, 0x80, 0x13, 0x65, 0x91, 0x3f, 0x36, 0xd8, 0x6f, 0x33, 0x06, 0x51, 0xbb, 0x5d,
+	0x84, 0x2a, 0x22, 0xc9, 0xb2, 0x7c, 0x72, 0x08, 0x73, 0xdb, 0x61, 0xc8, 0xdc, 0x9f, 0x78, 0x8b,
+	0x89, 0x19, 0xaf, 0x03, 0xa3, 0x29, 0xb5, 0x33, 0xbd, 0xb0, 0xd7, 0x58, 0x51, 0x6d, 0xd2, 0xd2,
+	0x8a, 0xba, 0xf7, 0x93, 0xd4, 0xd0, 0xe4, 0x63, 0xe2, 0xc1, 0x82, 0xa2, 0xf4, 0xaa, 0xe1, 0x6d,
+	0xb3, 0x18, 0x83, 0xbe, 0x67, 0xab, 0x30, 0xee, 0x89, 0xb2, 0xb5, 0xf7, 0x62, 0x59, 0x26, 0xa3,
+	0x29, 0xf5, 0x0d, 0xda, 0x63, 0x0f, 0x0a, 0xb0, 0xd8, 0x15, 0x8b, 0x69, 0xc3, 0x55, 0xd0, 0x8b,
+	0xf6, 0x9c, 0x01, 0x34, 0x0f, 0xec, 0x91, 0x77, 0x16, 0xd1, 0x1f, 0xdd, 0xfb, 0x89, 0x88, 0x8a,
+	0xf1, 0xb1, 0x3c, 0xfd, 0xf6, 0xa4, 0x82, 0x4d, 0x1f, 0xcd, 0x4c, 0x80, 0x92, 0xf6, 0xb5, 0x42,
+	0x5c, 0xd1, 0x50, 0x2b, 0x7d, 0xdd, 0x00, 0x03, 0x82, 0x64, 0x62, 0x9a, 0xa8, 0x83, 0x6f, 0x52,
+	0x24, 0x94, 0xf6, 0xda, 0xe4, 0x0c, 0x66, 0x6d, 0x77, 0xcc, 0xda, 0xf6, 0x61, 0x6e, 0x83, 0x6a,
+	0xfc, 0x18, 0xc9, 0x1c, 0x21, 0x7a, 0xe4, 0xe5, 0xf6, 0x62, 0x01, 0xce, 0x64, 0x45, 0xf9, 0x03,
+	0xd2, 0x3f, 0x80, 0xda, 0x23, 0x9a, 0xc8, 0x60, 0xc8, 0xea, 0x92, 0x95, 0x89, 0x8e, 0xdc, 0x2e,
+	0x88, 0xa5, 0x6c, 0xae, 0x19, 0x56, 0xda, 0x3d, 0x8c, 0xae, 0xcc, 0x89, 0x93, 0xeb, 0xf7, 0x3f,
+	0x26, 0xdf, 0x63, 0x85, 0xab, 0x70, 0xf5, 0x2b, 0x5a, 0xec, 0x5a, 0xbd, 0xf0, 0xf9, 0x0c, 0xbc,
+	0xa8, 0x64, 0xd4, 0x13, 0x68, 0xcc, 0x7e, 0x00, 0x35, 0xed, 0xb9, 0x07, 0xb5, 0x81, 0xf2, 0x2f,
+	0x87, 0xb4, 0xdb, 0x45, 0x28, 0x31, 0xce, 0xb7, 0x59, 0x3d, 0x36, 0x59, 0x4b, 0xeb, 0x61, 0xbb,
+	0x5e, 0xbb, 0x56, 0xdc, 0xfb, 0x89, 0x37, 0x4c, 0x3e, 0x26, 0x1f, 0xb2, 0xd7, 0xdd, 0xf5, 0x80,
+	0xcf, 0xe9, 0xad, 0x31, 0x1b, 0x1b, 0xba, 0x4d, 0xf2, 0x28, 0xf3, 0x26, 0xc9, 0xab, 0x62, 0x2c,
+	0xec, 0x3b, 0x00, 0x18, 0x4c, 0x98, 0x4b, 0xcf, 0x53, 0x5a, 0x9b, 0x86, 0x1b, 0x6e, 0x2f, 0x1a,
+	0x30, 0xc1, 0xd6, 0x7d, 0xa8, 0x5d, 0xb3, 0xf5, 0x29, 0x26, 0x6b, 0x3a, 0xef, 0x5d, 0x14, 0x91,
+	0xb8, 0xdd, 0x2e, 0xca, 0xa1, 0xce, 0xf5, 0x0e, 0x40, 0x1a, 0x9b, 0x46, 0x5d, 0x9a, 0x73, 0x61,
+	0x6f, 0xda, 0x57, 0x0b, 0x30, 0xa2, 0x6d, 0x7b, 0x30, 0x9b, 0xc6, 0x01, 0x59, 0x4d, 0xed, 0x3e,
+	0x0c, 0x23, 0x9f, 0x76, 0x2b, 0x8f, 0x10, 0xb3, 0xd2, 0x64, 0x43, 0x05, 0xa4, 0x8a, 0x43, 0xc5,
+	0xc2, 0x5f, 0xf8, 0xb0, 0xc8, 0x1b, 0xa8, 0x18, 0x1c, 0x76, 0xb9, 0x53, 0x4f, 0x7b, 0xe5, 0xa3,
+	0x55, 0xb4, 0xaf, 0x15, 0xe2, 0x8a, 0x64, 0x92, 0xb8, 0x5a, 0x79, 0xc8, 0x5d, 0x24, 0xcd, 0x43,
+	0x58, 0xc8, 0x39, 0xed, 0xab, 0x2d, 0x3d, 0x29, 0x9a, 0x42, 0x7b, 0x6d, 0x72, 0x06, 0x51, 0xe5,
+	0x32, 0xab, 0x72, 0xde, 0x06, 0xac, 0x32, 0x7e, 0xe1, 0x27, 0xbd, 0x53, 0xac, 0x0e, 0xa3, 0xec,
+	0x16, 0xf8, 0xe4, 0x93, 0xcf, 0x49, 0xb1, 0xd1, 0x44, 0x7f, 0xfd, 0x76, 0xa1, 0xcb, 0xb6, 0xbd,
+	0xcf, 0xea, 0x79, 0x42, 0x3e, 0x30, 0x0e, 0x36, 0xee, 0x13, 0x2d, 0x76, 0xe6, 0xb9, 0x4c, 0x45,
+	0x21, 0x47, 0xf1, 0x23, 0x58, 0xe5, 0x0d, 0xe9, 0x0c, 0x06, 0x19, 0x77, 0xf2, 0x9b, 0x5a, 0x2b,
+	0x0a, 0xdc, 0xe4, 0xdb, 0x57, 0x73, 0x78, 0xe9, 0x6d, 0x3a, 0x81, 0x29, 0xe7, 0x4d, 0x25, 0x63,
+	0x68, 0x66, 0x1d, 0x69, 0xc9, 0xe4, 0xb2, 0xda, 0xb7, 0x0c, 0x01, 0x48, 0x81, 0xf3, 0xed, 0x17,
+	0x58, 0x65, 0xb7, 0xec, 0x76, 0xd1, 0xb8, 0x70, 0x99, 0x08, 0x3f, 0xeb, 0x17, 0x72, 0x3e, 0xcc,
+	0xe7, 0xd5, 0xbb, 0xa6, 0x0e, 0xb9, 0x49, 0x8e, 0xcf, 0xff, 0x9f, 0xf2, 0x43, 0xcf, 0x8c, 0xdc,
+	0xad, 0xf4, 0xc5, 0xbe, 0x42, 0x97, 0xfa, 0xf6, 0x75, 0x33, 0x43, 0xa6, 0x43, 0xaf, 0xb3, 0x0e,
+	0xad, 0xd9, 0xd7, 0x8a, 0x3a, 0x14, 0xf1, 0x4f, 0xb8, 0x78, 0x67, 0x35, 0x4b, 0x29, 0x64, 0x0b,
+	0xd6, 0x8a, 0x56, 0xd0, 0xc4, 0x3b, 0x5a, 0x66, 0xf6, 0xae, 0xdc, 0xb7, 0xc8, 0x47, 0xb0, 0xb2,
+	0x1e, 0x51, 0x2f, 0xa1, 0x59, 0x4f, 0x62, 0xb5, 0x2c, 0x26, 0x38, 0x3c, 0xb7, 0x6f, 0x4d, 0xc4,
+	0x8b, 0x61, 0xdb, 0x2f, 0xf0, 0xb1, 0xbd, 0x39, 0xc9, 0x5d, 0x38, 0x53, 0xe8, 0x44, 0x07, 0xd5,
+	0x27, 0xd0, 0x30, 0xfd, 0x37, 0x15, 0x13, 0x5f, 0xe8, 0x74, 0xda, 0xbe, 0x31, 0x01, 0xab, 0xbc,
+	0x59, 0x56, 0x8a, 0x7d, 0x26, 0xc9, 0x6b, 0x6a, 0xea, 0xce, 0x71, 0xf5, 0x6c, 0x7f, 0xe1, 0x82,
+	0x5c, 0xa2, 0x1a, 0x26, 0xd8, 0xca, 0x78, 0x82, 0x69, 0x82, 0xad, 0x62, 0xdf, 0xb3, 0xf6, 0xda,
+	0xe4, 0x0c, 0xa2, 0xdc, 0xef, 0xe9, 0x2b, 0x43, 0xcf, 0x11, 0x93, 0x2f, 0x64, 0x75, 0x11, 0x85,
+	0x4e, 0x66, 0x29, 0x09, 0xd2, 0xb1, 0xf7, 0x2d, 0x0c, 0x82, 0xa1, 0xbe, 0x44, 0x61, 0x98, 0x10,
+	0x09, 0x5c, 0xd7, 0x9e, 0x62, 0xcc, 0xcb, 0x03, 0x9a, 0x59, 0x2c, 0x2b, 0xa8, 0xae, 0x3b, 0x6f,
+	0x29, 0x8a, 0x5f, 0xe0, 0x47, 0xd6, 0xbe, 0x56, 0x88, 0x53, 0x67, 0xd2, 0x7c, 0xc6, 0x6f, 0xcb,
+	0x90, 0xdc, 0xe4, 0x3d, 0xbd, 0xda, 0x37, 0x27, 0xa1, 0xd3, 0x05, 0x9a, 0xf5, 0xc6, 0x4a, 0x57,
+	0x7d, 0xb1, 0x8f, 0x57, 0xfb, 0xd6, 0x44, 0xbc, 0xd9, 0x4c, 0xcd, 0x16, 0xc9, 0x68, 0x66, 0xde,
+	0x82, 0xaa, 0x7d, 0x73, 0x12, 0x5a, 0x2d, 0x9e, 0x65, 0x47, 0xf8, 0xb9, 0x18, 0x7e, 0x35, 0x6a,
+	0x32, 0x0a, 0xbd, 0x6d, 0xda, 0xd7, 0x8a, 0xb1, 0xe9, 0x6d, 0xfd, 0x11, 0xd4, 0x75, 0xfb, 0x7e,
+	0x35, 0x33, 0x05, 0x3e, 0x02, 0xed, 0x6b, 0x85, 0x38, 0xd1, 0xc0, 0x6d, 0x6d, 0xad, 0x28, 0xac,
+	0x26, 0x3c, 0x29, 0x72, 0x09, 0x68, 0x2f, 0x17, 0x5a, 0xfc, 0x73, 0xf6, 0x25, 0xb5, 0x70, 0xd7,
+	0x14, 0x96, 0x19, 0xbb, 0xfa, 0xf6, 0xd5, 0x02, 0x8c, 0x52, 0x1b, 0x90, 0xbc, 0x71, 0xba, 0xa2,
+	0x95, 0x13, 0xad, 0xda, 0xdb, 0x9f, 0x3b, 0x27, 0x87, 0x28, 0xba, 0x03, 0x90, 0xda, 0x62, 0xab,
+	0xd6, 0xe5, 0xac, 0xc6, 0xdb, 0x57, 0x0b, 0x30, 0xe9, 0xb2, 0xcb, 0x9a, 0xf8, 0xaa, 0x65, 0x37,
+	0xc1, 0x9e, 0xba, 0x7d, 0x6b, 0x22, 0x3e, 0xa5, 0x30, 0x39, 0x6b, 0x57, 0x45, 0x61, 0x26, 0xd9,
+	0xe5, 0xb6, 0xd7, 0x26, 0x67, 0x10, 0xe5, 0x3e, 0x82, 0xba, 0x6e, 0x5a, 0xaa, 0x16, 0x49, 0x81,
+	0x19, 0x6c, 0xfb, 0x5a, 0x21, 0x4e, 0x14, 0xf4, 0x0c, 0x5a, 0x93, 0x8c, 0xf5, 0x48, 0xfa, 0xb4,
+	0xef, 0xb9, 0xb6, 0x86, 0xed, 0x37, 0x2e, 0xcc, 0x27, 0x2a, 0xfb, 0x26, 0xcc, 0x2a, 0xbb, 0x3c,
+	0xc5, 0xbf, 0x66, 0xed, 0xfa, 0xda, 0xad, 0x3c, 0x42, 0x29, 0x0c, 0x96, 0x8a, 0x8c, 0xef, 0x94,
+	0x4c, 0xf7, 0x1c, 0xcb, 0x3c, 0xb5, 0xb0, 0x33, 0x9f, 0xba, 0xb0, 0x54, 0x64, 0x26, 0xa7, 0x8a,
+	0x3c, 0xc7, 0xfa, 0xae, 0xfd, 0xf9, 0x73, 0xf3, 0x88, 0x36, 0x1f, 0xc1, 0x72, 0xde, 0x92, 0x01,
+	0x25, 0xfd, 0x9f, 0x9f, 0x68, 0x87, 0xa5, 0xa9, 0x10, 0x5e, 0x3b, 0x3f, 0x13, 0xaf, 0xe3, 0xe1,
+	0xda, 0xf7, 0x6f, 0x9e, 0xf8, 0xc9, 0xe9, 0xf8, 0xe8, 0x6e, 0x2f, 0x1c, 0xde, 0xeb, 0xd3, 0x5e,
+	0x44, 0xfb, 0xf7, 0xfa, 0xbd, 0x68, 0x10, 0xf4, 0xef, 0xb1, 0xef, 0x8f, 0xa6, 0x47, 0x51, 0x98,
+	0x84, 0x5f, 0xf9, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe9, 0x60, 0x3f, 0x10, 0xb9, 0xba, 0x00,
+	0x00,
 }
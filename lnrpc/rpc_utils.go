@@ -0,0 +1,79 @@
+package lnrpc
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/decred/dcrd/chaincfg/v2"
+	"github.com/decred/dcrd/txscript/v2"
+	"github.com/decred/dcrlnd/lnwallet"
+)
+
+// scriptVersion is the script version of all outputs owned by the wallet.
+//
+// TODO(decred) version needs to come from the utxo.
+const scriptVersion uint16 = 0
+
+// MarshalUtxos translates a []*lnwallet.Utxo into a []*lnrpc.Utxo. Utxos of
+// unknown address types are skipped.
+func MarshalUtxos(utxos []*lnwallet.Utxo, activeNetParams *chaincfg.Params) (
+	[]*Utxo, error) {
+
+	res := make([]*Utxo, 0, len(utxos))
+	for _, utxo := range utxos {
+		// Translate lnwallet address type to the proper gRPC proto
+		// address type.
+		var addrType AddressType
+		switch utxo.AddressType {
+
+		case lnwallet.PubKeyHash:
+			addrType = AddressType_PUBKEY_HASH
+
+		case lnwallet.UnknownAddressType:
+			continue
+
+		default:
+			return nil, fmt.Errorf("invalid utxo address type")
+		}
+
+		// Now that we know we have a proper mapping to an address,
+		// we'll convert the regular outpoint to an lnrpc variant.
+		outpoint := &OutPoint{
+			TxidBytes:   utxo.OutPoint.Hash[:],
+			TxidStr:     utxo.OutPoint.Hash.String(),
+			OutputIndex: utxo.OutPoint.Index,
+		}
+
+		utxoResp := Utxo{
+			Type:          addrType,
+			AmountAtoms:   int64(utxo.Value),
+			PkScript:      hex.EncodeToString(utxo.PkScript),
+			Outpoint:      outpoint,
+			Confirmations: utxo.Confirmations,
+		}
+
+		// Finally, we'll attempt to extract the raw address from the
+		// script so we can display a human friendly address to the end
+		// user.
+		_, outAddresses, _, err := txscript.ExtractPkScriptAddrs(
+			scriptVersion, utxo.PkScript, activeNetParams,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// If we can't properly locate a single address, then this was
+		// an error in our mapping, and we'll return an error back to
+		// the user.
+		if len(outAddresses) != 1 {
+			return nil, errors.New("an output was unexpectedly " +
+				"multisig")
+		}
+		utxoResp.Address = outAddresses[0].String()
+
+		res = append(res, &utxoResp)
+	}
+
+	return res, nil
+}
@@ -37,7 +37,7 @@ func (m *KeyLocator) Reset()         { *m = KeyLocator{} }
 func (m *KeyLocator) String() string { return proto.CompactTextString(m) }
 func (*KeyLocator) ProtoMessage()    {}
 func (*KeyLocator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_signer_6c6df08ff5e47989, []int{0}
+	return fileDescriptor_signer_65e24e74b9f183e3, []int{0}
 }
 func (m *KeyLocator) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_KeyLocator.Unmarshal(m, b)
@@ -89,7 +89,7 @@ func (m *KeyDescriptor) Reset()         { *m = KeyDescriptor{} }
 func (m *KeyDescriptor) String() string { return proto.CompactTextString(m) }
 func (*KeyDescriptor) ProtoMessage()    {}
 func (*KeyDescriptor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_signer_6c6df08ff5e47989, []int{1}
+	return fileDescriptor_signer_65e24e74b9f183e3, []int{1}
 }
 func (m *KeyDescriptor) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_KeyDescriptor.Unmarshal(m, b)
@@ -137,7 +137,7 @@ func (m *TxOut) Reset()         { *m = TxOut{} }
 func (m *TxOut) String() string { return proto.CompactTextString(m) }
 func (*TxOut) ProtoMessage()    {}
 func (*TxOut) Descriptor() ([]byte, []int) {
-	return fileDescriptor_signer_6c6df08ff5e47989, []int{2}
+	return fileDescriptor_signer_65e24e74b9f183e3, []int{2}
 }
 func (m *TxOut) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TxOut.Unmarshal(m, b)
@@ -218,7 +218,7 @@ func (m *SignDescriptor) Reset()         { *m = SignDescriptor{} }
 func (m *SignDescriptor) String() string { return proto.CompactTextString(m) }
 func (*SignDescriptor) ProtoMessage()    {}
 func (*SignDescriptor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_signer_6c6df08ff5e47989, []int{3}
+	return fileDescriptor_signer_65e24e74b9f183e3, []int{3}
 }
 func (m *SignDescriptor) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignDescriptor.Unmarshal(m, b)
@@ -301,7 +301,7 @@ func (m *SignReq) Reset()         { *m = SignReq{} }
 func (m *SignReq) String() string { return proto.CompactTextString(m) }
 func (*SignReq) ProtoMessage()    {}
 func (*SignReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_signer_6c6df08ff5e47989, []int{4}
+	return fileDescriptor_signer_65e24e74b9f183e3, []int{4}
 }
 func (m *SignReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignReq.Unmarshal(m, b)
@@ -349,7 +349,7 @@ func (m *SignResp) Reset()         { *m = SignResp{} }
 func (m *SignResp) String() string { return proto.CompactTextString(m) }
 func (*SignResp) ProtoMessage()    {}
 func (*SignResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_signer_6c6df08ff5e47989, []int{5}
+	return fileDescriptor_signer_65e24e74b9f183e3, []int{5}
 }
 func (m *SignResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignResp.Unmarshal(m, b)
@@ -392,7 +392,7 @@ func (m *InputScript) Reset()         { *m = InputScript{} }
 func (m *InputScript) String() string { return proto.CompactTextString(m) }
 func (*InputScript) ProtoMessage()    {}
 func (*InputScript) Descriptor() ([]byte, []int) {
-	return fileDescriptor_signer_6c6df08ff5e47989, []int{6}
+	return fileDescriptor_signer_65e24e74b9f183e3, []int{6}
 }
 func (m *InputScript) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InputScript.Unmarshal(m, b)
@@ -438,7 +438,7 @@ func (m *InputScriptResp) Reset()         { *m = InputScriptResp{} }
 func (m *InputScriptResp) String() string { return proto.CompactTextString(m) }
 func (*InputScriptResp) ProtoMessage()    {}
 func (*InputScriptResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_signer_6c6df08ff5e47989, []int{7}
+	return fileDescriptor_signer_65e24e74b9f183e3, []int{7}
 }
 func (m *InputScriptResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InputScriptResp.Unmarshal(m, b)
@@ -465,6 +465,280 @@ func (m *InputScriptResp) GetInputScripts() []*InputScript {
 	return nil
 }
 
+type SignMessageReq struct {
+	// / The message to be signed.
+	Msg []byte `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// / The key locator that identifies which key to use for signing.
+	KeyLoc               *KeyLocator `protobuf:"bytes,2,opt,name=key_loc,json=keyLoc,proto3" json:"key_loc,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *SignMessageReq) Reset()         { *m = SignMessageReq{} }
+func (m *SignMessageReq) String() string { return proto.CompactTextString(m) }
+func (*SignMessageReq) ProtoMessage()    {}
+func (*SignMessageReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_signer_65e24e74b9f183e3, []int{8}
+}
+func (m *SignMessageReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignMessageReq.Unmarshal(m, b)
+}
+func (m *SignMessageReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignMessageReq.Marshal(b, m, deterministic)
+}
+func (dst *SignMessageReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignMessageReq.Merge(dst, src)
+}
+func (m *SignMessageReq) XXX_Size() int {
+	return xxx_messageInfo_SignMessageReq.Size(m)
+}
+func (m *SignMessageReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignMessageReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignMessageReq proto.InternalMessageInfo
+
+func (m *SignMessageReq) GetMsg() []byte {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (m *SignMessageReq) GetKeyLoc() *KeyLocator {
+	if m != nil {
+		return m.KeyLoc
+	}
+	return nil
+}
+
+type SignMessageResp struct {
+	// *
+	// The signature for the given message in the DER format.
+	Signature            []byte   `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignMessageResp) Reset()         { *m = SignMessageResp{} }
+func (m *SignMessageResp) String() string { return proto.CompactTextString(m) }
+func (*SignMessageResp) ProtoMessage()    {}
+func (*SignMessageResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_signer_65e24e74b9f183e3, []int{9}
+}
+func (m *SignMessageResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignMessageResp.Unmarshal(m, b)
+}
+func (m *SignMessageResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignMessageResp.Marshal(b, m, deterministic)
+}
+func (dst *SignMessageResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignMessageResp.Merge(dst, src)
+}
+func (m *SignMessageResp) XXX_Size() int {
+	return xxx_messageInfo_SignMessageResp.Size(m)
+}
+func (m *SignMessageResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignMessageResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignMessageResp proto.InternalMessageInfo
+
+func (m *SignMessageResp) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type VerifyMessageReq struct {
+	// / The message over which the signature is to be verified.
+	Msg []byte `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// *
+	// The DER encoded signature to be verified over the given message.
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	// / The public key to verify the DER signature against.
+	Pubkey               []byte   `protobuf:"bytes,3,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyMessageReq) Reset()         { *m = VerifyMessageReq{} }
+func (m *VerifyMessageReq) String() string { return proto.CompactTextString(m) }
+func (*VerifyMessageReq) ProtoMessage()    {}
+func (*VerifyMessageReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_signer_65e24e74b9f183e3, []int{10}
+}
+func (m *VerifyMessageReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyMessageReq.Unmarshal(m, b)
+}
+func (m *VerifyMessageReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyMessageReq.Marshal(b, m, deterministic)
+}
+func (dst *VerifyMessageReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyMessageReq.Merge(dst, src)
+}
+func (m *VerifyMessageReq) XXX_Size() int {
+	return xxx_messageInfo_VerifyMessageReq.Size(m)
+}
+func (m *VerifyMessageReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyMessageReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyMessageReq proto.InternalMessageInfo
+
+func (m *VerifyMessageReq) GetMsg() []byte {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (m *VerifyMessageReq) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *VerifyMessageReq) GetPubkey() []byte {
+	if m != nil {
+		return m.Pubkey
+	}
+	return nil
+}
+
+type VerifyMessageResp struct {
+	// / Whether the signature was valid over the given message.
+	Valid                bool     `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyMessageResp) Reset()         { *m = VerifyMessageResp{} }
+func (m *VerifyMessageResp) String() string { return proto.CompactTextString(m) }
+func (*VerifyMessageResp) ProtoMessage()    {}
+func (*VerifyMessageResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_signer_65e24e74b9f183e3, []int{11}
+}
+func (m *VerifyMessageResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyMessageResp.Unmarshal(m, b)
+}
+func (m *VerifyMessageResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyMessageResp.Marshal(b, m, deterministic)
+}
+func (dst *VerifyMessageResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyMessageResp.Merge(dst, src)
+}
+func (m *VerifyMessageResp) XXX_Size() int {
+	return xxx_messageInfo_VerifyMessageResp.Size(m)
+}
+func (m *VerifyMessageResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyMessageResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyMessageResp proto.InternalMessageInfo
+
+func (m *VerifyMessageResp) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+type SharedKeyRequest struct {
+	// / The ephemeral public key to use for the DH key derivation.
+	EphemeralPubkey []byte `protobuf:"bytes,1,opt,name=ephemeral_pubkey,json=ephemeralPubkey,proto3" json:"ephemeral_pubkey,omitempty"`
+	// *
+	// The optional key locator of the local key that should be used. If this
+	// parameter is not set then the node's identity private key will be used.
+	KeyLoc               *KeyLocator `protobuf:"bytes,2,opt,name=key_loc,json=keyLoc,proto3" json:"key_loc,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *SharedKeyRequest) Reset()         { *m = SharedKeyRequest{} }
+func (m *SharedKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*SharedKeyRequest) ProtoMessage()    {}
+func (*SharedKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_signer_65e24e74b9f183e3, []int{12}
+}
+func (m *SharedKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SharedKeyRequest.Unmarshal(m, b)
+}
+func (m *SharedKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SharedKeyRequest.Marshal(b, m, deterministic)
+}
+func (dst *SharedKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SharedKeyRequest.Merge(dst, src)
+}
+func (m *SharedKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_SharedKeyRequest.Size(m)
+}
+func (m *SharedKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SharedKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SharedKeyRequest proto.InternalMessageInfo
+
+func (m *SharedKeyRequest) GetEphemeralPubkey() []byte {
+	if m != nil {
+		return m.EphemeralPubkey
+	}
+	return nil
+}
+
+func (m *SharedKeyRequest) GetKeyLoc() *KeyLocator {
+	if m != nil {
+		return m.KeyLoc
+	}
+	return nil
+}
+
+type SharedKeyResponse struct {
+	// / The shared public key, hashed with sha256.
+	SharedKey            []byte   `protobuf:"bytes,1,opt,name=shared_key,json=sharedKey,proto3" json:"shared_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SharedKeyResponse) Reset()         { *m = SharedKeyResponse{} }
+func (m *SharedKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*SharedKeyResponse) ProtoMessage()    {}
+func (*SharedKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_signer_65e24e74b9f183e3, []int{13}
+}
+func (m *SharedKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SharedKeyResponse.Unmarshal(m, b)
+}
+func (m *SharedKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SharedKeyResponse.Marshal(b, m, deterministic)
+}
+func (dst *SharedKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SharedKeyResponse.Merge(dst, src)
+}
+func (m *SharedKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_SharedKeyResponse.Size(m)
+}
+func (m *SharedKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SharedKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SharedKeyResponse proto.InternalMessageInfo
+
+func (m *SharedKeyResponse) GetSharedKey() []byte {
+	if m != nil {
+		return m.SharedKey
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*KeyLocator)(nil), "signrpc.KeyLocator")
 	proto.RegisterType((*KeyDescriptor)(nil), "signrpc.KeyDescriptor")
@@ -474,6 +748,12 @@ func init() {
 	proto.RegisterType((*SignResp)(nil), "signrpc.SignResp")
 	proto.RegisterType((*InputScript)(nil), "signrpc.InputScript")
 	proto.RegisterType((*InputScriptResp)(nil), "signrpc.InputScriptResp")
+	proto.RegisterType((*SignMessageReq)(nil), "signrpc.SignMessageReq")
+	proto.RegisterType((*SignMessageResp)(nil), "signrpc.SignMessageResp")
+	proto.RegisterType((*VerifyMessageReq)(nil), "signrpc.VerifyMessageReq")
+	proto.RegisterType((*VerifyMessageResp)(nil), "signrpc.VerifyMessageResp")
+	proto.RegisterType((*SharedKeyRequest)(nil), "signrpc.SharedKeyRequest")
+	proto.RegisterType((*SharedKeyResponse)(nil), "signrpc.SharedKeyResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -508,8 +788,33 @@ type SignerClient interface {
 	// Note that when using this method to sign inputs belonging to the wallet,
 	// the only items of the SignDescriptor that need to be populated are pkScript
 	// in the TxOut field, the value in that same field, and finally the input
-	// index.
+	// index. Outputs paying to a tweaked wallet key, such as the to_remote
+	// output of a channel, can be signed by also populating the key descriptor
+	// and either the single or the double tweak.
 	ComputeInputScript(ctx context.Context, in *SignReq, opts ...grpc.CallOption) (*InputScriptResp, error)
+	// *
+	// SignMessage signs a message with the key specified in the key locator. The
+	// returned signature is DER encoded.
+	//
+	// The main difference to SignMessage in the main RPC is that a specific key
+	// is used to sign the message instead of the node identity private key.
+	SignMessage(ctx context.Context, in *SignMessageReq, opts ...grpc.CallOption) (*SignMessageResp, error)
+	// *
+	// VerifyMessage verifies a signature over a message using the public key
+	// provided. The signature must be DER encoded.
+	//
+	// The main difference to VerifyMessage in the main RPC is that the public key
+	// used to sign the message does not have to be a node known to the network.
+	VerifyMessage(ctx context.Context, in *VerifyMessageReq, opts ...grpc.CallOption) (*VerifyMessageResp, error)
+	//
+	// DeriveSharedKey returns a shared secret key by performing Diffie-Hellman key
+	// derivation between the ephemeral public key in the request and the node's
+	// key specified in the key_loc parameter (or the node's identity private key
+	// if no key locator is specified):
+	// P_shared = privKeyNode * ephemeralPubkey
+	// The resulting shared public key is serialized in the compressed format and
+	// hashed with sha256, resulting in the final key length of 256bit.
+	DeriveSharedKey(ctx context.Context, in *SharedKeyRequest, opts ...grpc.CallOption) (*SharedKeyResponse, error)
 }
 
 type signerClient struct {
@@ -538,6 +843,33 @@ func (c *signerClient) ComputeInputScript(ctx context.Context, in *SignReq, opts
 	return out, nil
 }
 
+func (c *signerClient) SignMessage(ctx context.Context, in *SignMessageReq, opts ...grpc.CallOption) (*SignMessageResp, error) {
+	out := new(SignMessageResp)
+	err := c.cc.Invoke(ctx, "/signrpc.Signer/SignMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) VerifyMessage(ctx context.Context, in *VerifyMessageReq, opts ...grpc.CallOption) (*VerifyMessageResp, error) {
+	out := new(VerifyMessageResp)
+	err := c.cc.Invoke(ctx, "/signrpc.Signer/VerifyMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) DeriveSharedKey(ctx context.Context, in *SharedKeyRequest, opts ...grpc.CallOption) (*SharedKeyResponse, error) {
+	out := new(SharedKeyResponse)
+	err := c.cc.Invoke(ctx, "/signrpc.Signer/DeriveSharedKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SignerServer is the server API for Signer service.
 type SignerServer interface {
 	// *
@@ -560,8 +892,33 @@ type SignerServer interface {
 	// Note that when using this method to sign inputs belonging to the wallet,
 	// the only items of the SignDescriptor that need to be populated are pkScript
 	// in the TxOut field, the value in that same field, and finally the input
-	// index.
+	// index. Outputs paying to a tweaked wallet key, such as the to_remote
+	// output of a channel, can be signed by also populating the key descriptor
+	// and either the single or the double tweak.
 	ComputeInputScript(context.Context, *SignReq) (*InputScriptResp, error)
+	// *
+	// SignMessage signs a message with the key specified in the key locator. The
+	// returned signature is DER encoded.
+	//
+	// The main difference to SignMessage in the main RPC is that a specific key
+	// is used to sign the message instead of the node identity private key.
+	SignMessage(context.Context, *SignMessageReq) (*SignMessageResp, error)
+	// *
+	// VerifyMessage verifies a signature over a message using the public key
+	// provided. The signature must be DER encoded.
+	//
+	// The main difference to VerifyMessage in the main RPC is that the public key
+	// used to sign the message does not have to be a node known to the network.
+	VerifyMessage(context.Context, *VerifyMessageReq) (*VerifyMessageResp, error)
+	//
+	// DeriveSharedKey returns a shared secret key by performing Diffie-Hellman key
+	// derivation between the ephemeral public key in the request and the node's
+	// key specified in the key_loc parameter (or the node's identity private key
+	// if no key locator is specified):
+	// P_shared = privKeyNode * ephemeralPubkey
+	// The resulting shared public key is serialized in the compressed format and
+	// hashed with sha256, resulting in the final key length of 256bit.
+	DeriveSharedKey(context.Context, *SharedKeyRequest) (*SharedKeyResponse, error)
 }
 
 func RegisterSignerServer(s *grpc.Server, srv SignerServer) {
@@ -604,6 +961,60 @@ func _Signer_ComputeInputScript_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Signer_SignMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignMessageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).SignMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/signrpc.Signer/SignMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).SignMessage(ctx, req.(*SignMessageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_VerifyMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyMessageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).VerifyMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/signrpc.Signer/VerifyMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).VerifyMessage(ctx, req.(*VerifyMessageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_DeriveSharedKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SharedKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).DeriveSharedKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/signrpc.Signer/DeriveSharedKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).DeriveSharedKey(ctx, req.(*SharedKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Signer_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "signrpc.Signer",
 	HandlerType: (*SignerServer)(nil),
@@ -616,48 +1027,73 @@ var _Signer_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ComputeInputScript",
 			Handler:    _Signer_ComputeInputScript_Handler,
 		},
+		{
+			MethodName: "SignMessage",
+			Handler:    _Signer_SignMessage_Handler,
+		},
+		{
+			MethodName: "VerifyMessage",
+			Handler:    _Signer_VerifyMessage_Handler,
+		},
+		{
+			MethodName: "DeriveSharedKey",
+			Handler:    _Signer_DeriveSharedKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "signrpc/signer.proto",
 }
 
-func init() { proto.RegisterFile("signrpc/signer.proto", fileDescriptor_signer_6c6df08ff5e47989) }
-
-var fileDescriptor_signer_6c6df08ff5e47989 = []byte{
-	// 556 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x4d, 0x8f, 0xd3, 0x30,
-	0x10, 0x55, 0x5b, 0xda, 0x74, 0x27, 0x49, 0x01, 0x53, 0x41, 0x00, 0x21, 0x4a, 0xa4, 0x5d, 0xf5,
-	0x80, 0x5a, 0x51, 0x10, 0x12, 0x9c, 0xd0, 0x82, 0x56, 0xac, 0xba, 0xd2, 0x4a, 0x6e, 0x4f, 0x5c,
-	0xa2, 0x34, 0x31, 0xa9, 0x95, 0x34, 0xf1, 0xda, 0x0e, 0x69, 0x6e, 0xfc, 0x07, 0xfe, 0x30, 0xb2,
-	0x9d, 0x7e, 0xc1, 0x9e, 0x9a, 0xf7, 0x3c, 0x33, 0xef, 0x79, 0x5e, 0x0d, 0x43, 0x41, 0x93, 0x9c,
-	0xb3, 0x68, 0xaa, 0x7e, 0x09, 0x9f, 0x30, 0x5e, 0xc8, 0x02, 0x59, 0x0d, 0xeb, 0x7f, 0x07, 0x98,
-	0x93, 0xfa, 0xa6, 0x88, 0x42, 0x59, 0x70, 0xf4, 0x0a, 0x20, 0x25, 0x75, 0xf0, 0x33, 0xdc, 0xd0,
-	0xac, 0xf6, 0x5a, 0xa3, 0xd6, 0xb8, 0x8b, 0xcf, 0x52, 0x52, 0x5f, 0x69, 0x02, 0xbd, 0x04, 0x05,
-	0x02, 0x9a, 0xc7, 0x64, 0xeb, 0xb5, 0xf5, 0x69, 0x3f, 0x25, 0xf5, 0xb5, 0xc2, 0x7e, 0x08, 0xee,
-	0x9c, 0xd4, 0xdf, 0x88, 0x88, 0x38, 0x65, 0x6a, 0x98, 0x0f, 0x2e, 0x0f, 0xab, 0x40, 0x75, 0xac,
-	0x6a, 0x49, 0x84, 0x9e, 0xe7, 0x60, 0x9b, 0x87, 0xd5, 0x9c, 0xd4, 0x97, 0x8a, 0x42, 0x6f, 0xc1,
-	0x52, 0xe7, 0x59, 0x11, 0xe9, 0x79, 0xf6, 0xec, 0xc9, 0xa4, 0x71, 0x36, 0x39, 0xd8, 0xc2, 0xbd,
-	0x54, 0x7f, 0xfb, 0x9f, 0xa1, 0xbb, 0xdc, 0xde, 0x96, 0x12, 0x0d, 0xa1, 0xfb, 0x2b, 0xcc, 0x4a,
-	0xa2, 0x47, 0x76, 0xb0, 0x01, 0xca, 0x1e, 0x4b, 0x03, 0xa3, 0xaf, 0xc7, 0x39, 0xb8, 0xcf, 0xd2,
-	0x85, 0xc6, 0xfe, 0x9f, 0x36, 0x0c, 0x16, 0x34, 0xc9, 0x8f, 0x0c, 0xbe, 0x03, 0xe5, 0x3e, 0x88,
-	0x89, 0x88, 0xf4, 0x20, 0x7b, 0xf6, 0xf4, 0x58, 0xfd, 0x50, 0x89, 0x95, 0x49, 0x05, 0xd1, 0x1b,
-	0x70, 0x04, 0xcd, 0x93, 0x8c, 0x04, 0xb2, 0x22, 0x61, 0xda, 0xa8, 0xd8, 0x86, 0x5b, 0x2a, 0x4a,
-	0x95, 0xc4, 0x45, 0xb9, 0xda, 0x97, 0x74, 0x4c, 0x89, 0xe1, 0x4c, 0xc9, 0x39, 0x0c, 0x2a, 0x2a,
-	0x73, 0x22, 0xc4, 0xce, 0xed, 0x03, 0x5d, 0xe4, 0x36, 0xac, 0xb1, 0x8c, 0x2e, 0xa0, 0x57, 0x94,
-	0x92, 0x95, 0xd2, 0xeb, 0x6a, 0x77, 0x83, 0xbd, 0x3b, 0xbd, 0x05, 0xdc, 0x9c, 0x22, 0x0f, 0x54,
-	0x9c, 0xeb, 0x50, 0xac, 0x3d, 0x6b, 0xd4, 0x1a, 0xbb, 0x78, 0x07, 0xd1, 0x6b, 0xb0, 0x69, 0xce,
-	0x4a, 0xd9, 0x44, 0xd6, 0xd7, 0x91, 0x81, 0xa6, 0x4c, 0x68, 0x11, 0x58, 0x6a, 0x29, 0x98, 0xdc,
-	0xa1, 0x11, 0x38, 0x2a, 0x2e, 0xb9, 0x3d, 0x49, 0x0b, 0x78, 0x58, 0x2d, 0xb7, 0x26, 0xac, 0x8f,
-	0x00, 0xca, 0x80, 0x5e, 0x98, 0xf0, 0xda, 0xa3, 0xce, 0xd8, 0x9e, 0x3d, 0xdb, 0x7b, 0x3a, 0x5d,
-	0x2e, 0x3e, 0x13, 0x0d, 0x16, 0xfe, 0x39, 0xf4, 0x8d, 0x88, 0x60, 0xe8, 0x39, 0xf4, 0x95, 0x8a,
-	0xa0, 0x89, 0x52, 0xe8, 0x8c, 0x1d, 0x6c, 0xf1, 0xb0, 0x5a, 0xd0, 0x44, 0xf8, 0x57, 0x60, 0x5f,
-	0x2b, 0x67, 0xcd, 0xed, 0x3d, 0xb0, 0x9a, 0x75, 0xec, 0x0a, 0x1b, 0xa8, 0xfe, 0xa5, 0x82, 0x26,
-	0xa7, 0x41, 0x2b, 0xb9, 0x26, 0xe9, 0x1b, 0x78, 0x78, 0x34, 0x47, 0xab, 0x7e, 0x02, 0xd7, 0xec,
-	0xc1, 0xf4, 0x98, 0x89, 0xf6, 0x6c, 0xb8, 0x37, 0x7f, 0xdc, 0xe0, 0xd0, 0x03, 0x10, 0xb3, 0xdf,
-	0x2d, 0xe8, 0x2d, 0xf4, 0xd3, 0x41, 0x1f, 0xc0, 0x55, 0x5f, 0xb7, 0x7a, 0xeb, 0x38, 0xac, 0xd0,
-	0xa3, 0x93, 0xcb, 0x63, 0x72, 0xf7, 0xe2, 0xf1, 0x3f, 0x8c, 0x60, 0xe8, 0x0b, 0xa0, 0xaf, 0xc5,
-	0x86, 0x95, 0x92, 0x1c, 0xdf, 0xee, 0xff, 0x56, 0xef, 0x5e, 0x33, 0x44, 0xb0, 0xcb, 0xf1, 0x8f,
-	0x8b, 0x84, 0xca, 0x75, 0xb9, 0x9a, 0x44, 0xc5, 0x66, 0x1a, 0x93, 0x88, 0x93, 0x78, 0x1a, 0x47,
-	0x3c, 0xcb, 0xe3, 0x69, 0xb6, 0x7f, 0xdb, 0x9c, 0x45, 0xab, 0x9e, 0x7e, 0xdd, 0xef, 0xff, 0x06,
-	0x00, 0x00, 0xff, 0xff, 0xe8, 0x09, 0x0a, 0x14, 0xf5, 0x03, 0x00, 0x00,
+func init() { proto.RegisterFile("signrpc/signer.proto", fileDescriptor_signer_65e24e74b9f183e3) }
+
+var fileDescriptor_signer_65e24e74b9f183e3 = []byte{
+	// 753 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x5d, 0x6f, 0xe2, 0x46,
+	0x14, 0x55, 0xa0, 0x7c, 0xe4, 0x1a, 0x02, 0x99, 0x46, 0x5b, 0x2f, 0x6d, 0x55, 0x6a, 0x69, 0x57,
+	0xac, 0x54, 0x81, 0x4a, 0xab, 0x4a, 0xed, 0x53, 0xb5, 0x5d, 0x45, 0xbb, 0x62, 0xab, 0x8d, 0x4c,
+	0xd4, 0x87, 0xbc, 0x58, 0xc6, 0xbe, 0x31, 0x23, 0x83, 0x3d, 0x99, 0xb1, 0x03, 0xfe, 0x1d, 0xfd,
+	0x6b, 0xfd, 0x41, 0xd5, 0x7c, 0x60, 0x6c, 0x9a, 0xae, 0x94, 0xa7, 0xf8, 0x9e, 0xb9, 0x73, 0xee,
+	0xc9, 0x39, 0xd7, 0x18, 0xae, 0x04, 0x8d, 0x12, 0xce, 0x82, 0x99, 0xfc, 0x8b, 0x7c, 0xca, 0x78,
+	0x9a, 0xa5, 0xa4, 0x63, 0x50, 0xe7, 0x3d, 0xc0, 0x02, 0x8b, 0x8f, 0x69, 0xe0, 0x67, 0x29, 0x27,
+	0xdf, 0x02, 0xc4, 0x58, 0x78, 0xf7, 0xfe, 0x96, 0x6e, 0x0a, 0xfb, 0x6c, 0x7c, 0x36, 0x69, 0xb9,
+	0xe7, 0x31, 0x16, 0xd7, 0x0a, 0x20, 0x5f, 0x83, 0x2c, 0x3c, 0x9a, 0x84, 0xb8, 0xb7, 0x1b, 0xea,
+	0xb4, 0x1b, 0x63, 0xf1, 0x41, 0xd6, 0x8e, 0x0f, 0xfd, 0x05, 0x16, 0xef, 0x50, 0x04, 0x9c, 0x32,
+	0x49, 0xe6, 0x40, 0x9f, 0xfb, 0x3b, 0x4f, 0xde, 0x58, 0x15, 0x19, 0x0a, 0xc5, 0xd7, 0x73, 0x2d,
+	0xee, 0xef, 0x16, 0x58, 0xbc, 0x95, 0x10, 0xf9, 0x01, 0x3a, 0xf2, 0x7c, 0x93, 0x06, 0x8a, 0xcf,
+	0x9a, 0x7f, 0x39, 0x35, 0xca, 0xa6, 0x47, 0x59, 0x6e, 0x3b, 0x56, 0xcf, 0xce, 0x6f, 0xd0, 0xba,
+	0xdd, 0x7f, 0xca, 0x33, 0x72, 0x05, 0xad, 0x47, 0x7f, 0x93, 0xa3, 0xa2, 0x6c, 0xba, 0xba, 0x90,
+	0xf2, 0x58, 0xec, 0xe9, 0xf9, 0x8a, 0xae, 0xe7, 0x76, 0x59, 0xbc, 0x54, 0xb5, 0xf3, 0x77, 0x03,
+	0x2e, 0x96, 0x34, 0x4a, 0x2a, 0x02, 0x7f, 0x04, 0xa9, 0xde, 0x0b, 0x51, 0x04, 0x8a, 0xc8, 0x9a,
+	0xbf, 0xa8, 0x4e, 0x3f, 0x76, 0xba, 0x9d, 0x58, 0x97, 0xe4, 0x7b, 0xe8, 0x09, 0x9a, 0x44, 0x1b,
+	0xf4, 0xb2, 0x1d, 0xfa, 0xb1, 0x99, 0x62, 0x69, 0xec, 0x56, 0x42, 0xb2, 0x25, 0x4c, 0xf3, 0x55,
+	0xd9, 0xd2, 0xd4, 0x2d, 0x1a, 0xd3, 0x2d, 0xaf, 0xe0, 0x62, 0x47, 0xb3, 0x04, 0x85, 0x38, 0xa8,
+	0xfd, 0x42, 0x35, 0xf5, 0x0d, 0xaa, 0x25, 0x93, 0xd7, 0xd0, 0x4e, 0xf3, 0x8c, 0xe5, 0x99, 0xdd,
+	0x52, 0xea, 0x2e, 0x4a, 0x75, 0xca, 0x05, 0xd7, 0x9c, 0x12, 0x1b, 0x64, 0x9c, 0x6b, 0x5f, 0xac,
+	0xed, 0xce, 0xf8, 0x6c, 0xd2, 0x77, 0x0f, 0x25, 0xf9, 0x0e, 0x2c, 0x9a, 0xb0, 0x3c, 0x33, 0x91,
+	0x75, 0x55, 0x64, 0xa0, 0x20, 0x1d, 0x5a, 0x00, 0x1d, 0x69, 0x8a, 0x8b, 0x0f, 0x64, 0x0c, 0x3d,
+	0x19, 0x57, 0xb6, 0xaf, 0xa5, 0x05, 0xdc, 0xdf, 0xdd, 0xee, 0x75, 0x58, 0xbf, 0x00, 0x48, 0x01,
+	0xca, 0x30, 0x61, 0x37, 0xc6, 0xcd, 0x89, 0x35, 0xff, 0xaa, 0xd4, 0x54, 0x37, 0xd7, 0x3d, 0x17,
+	0xa6, 0x16, 0xce, 0x2b, 0xe8, 0xea, 0x21, 0x82, 0x91, 0x97, 0xd0, 0x95, 0x53, 0x04, 0x8d, 0xe4,
+	0x84, 0xe6, 0xa4, 0xe7, 0x76, 0xb8, 0xbf, 0x5b, 0xd2, 0x48, 0x38, 0xd7, 0x60, 0x7d, 0x90, 0xca,
+	0xcc, 0x7f, 0x6f, 0x43, 0xc7, 0xd8, 0x71, 0x68, 0x34, 0xa5, 0xdc, 0x52, 0x41, 0xa3, 0x7a, 0xd0,
+	0x72, 0x9c, 0x49, 0xfa, 0x23, 0x0c, 0x2a, 0x3c, 0x6a, 0xea, 0xaf, 0xd0, 0xd7, 0x3e, 0xe8, 0x3b,
+	0x9a, 0xd1, 0x9a, 0x5f, 0x95, 0xe2, 0xab, 0x17, 0x7a, 0xf4, 0x58, 0x08, 0xe7, 0x46, 0xaf, 0xcd,
+	0x9f, 0x28, 0x84, 0x1f, 0xa1, 0x34, 0x6a, 0x08, 0xcd, 0xad, 0x88, 0x8c, 0x3f, 0xf2, 0xf1, 0x99,
+	0x5b, 0x3c, 0x83, 0x41, 0x8d, 0x51, 0x30, 0xf2, 0x0d, 0x28, 0xbb, 0xfc, 0x2c, 0xe7, 0x68, 0x88,
+	0x8f, 0x80, 0x73, 0x07, 0xc3, 0xbf, 0x90, 0xd3, 0xfb, 0xe2, 0xb3, 0x22, 0x6a, 0x1c, 0x8d, 0x13,
+	0x0e, 0xf2, 0x02, 0xda, 0x2c, 0x5f, 0xc5, 0x58, 0x98, 0x7d, 0x34, 0x95, 0xf3, 0x06, 0x2e, 0x4f,
+	0xb8, 0x05, 0x33, 0xaf, 0x17, 0x0d, 0x15, 0x7d, 0xd7, 0xd5, 0x85, 0x13, 0xc3, 0x70, 0xb9, 0xf6,
+	0x39, 0x86, 0x0b, 0x2c, 0x5c, 0x7c, 0xc8, 0x51, 0x64, 0xe4, 0x0d, 0x0c, 0x91, 0xad, 0x71, 0x8b,
+	0xdc, 0xdf, 0x78, 0x66, 0x80, 0xd6, 0x34, 0x28, 0xf1, 0x1b, 0x05, 0x3f, 0xd3, 0xa4, 0x39, 0x5c,
+	0x56, 0x86, 0x09, 0x96, 0x26, 0x02, 0x55, 0xf0, 0x0a, 0xf4, 0x8e, 0x73, 0xce, 0xc5, 0xa1, 0x6d,
+	0xfe, 0x4f, 0x03, 0xda, 0x4b, 0xf5, 0x2b, 0x47, 0x7e, 0x86, 0xbe, 0x7c, 0xfa, 0xa4, 0x5e, 0x10,
+	0xd7, 0xdf, 0x91, 0x61, 0x6d, 0x4f, 0x5d, 0x7c, 0x18, 0x5d, 0x9e, 0x20, 0x82, 0x91, 0xdf, 0x81,
+	0xfc, 0x91, 0x6e, 0x59, 0x9e, 0x61, 0x75, 0x11, 0xff, 0x7b, 0xd5, 0x7e, 0x72, 0x6f, 0x34, 0x83,
+	0x55, 0xc9, 0x96, 0xd4, 0xdf, 0x8e, 0x63, 0x7c, 0x23, 0xfb, 0xe9, 0x03, 0xc1, 0xc8, 0x35, 0xf4,
+	0x6b, 0x81, 0x90, 0x97, 0x65, 0xeb, 0xe9, 0x12, 0x8c, 0x46, 0xff, 0x77, 0x24, 0x18, 0x79, 0x0f,
+	0x83, 0x77, 0xc8, 0xe9, 0x23, 0x96, 0x36, 0x56, 0x98, 0x4e, 0x73, 0x1c, 0x8d, 0x9e, 0x3a, 0xd2,
+	0xae, 0xbf, 0x9d, 0xdc, 0xbd, 0x8e, 0x68, 0xb6, 0xce, 0x57, 0xd3, 0x20, 0xdd, 0xce, 0x42, 0x0c,
+	0x38, 0x86, 0xb3, 0x30, 0xe0, 0x9b, 0x24, 0x9c, 0x6d, 0xca, 0x4f, 0x0b, 0x67, 0xc1, 0xaa, 0xad,
+	0x3e, 0x2e, 0x3f, 0xfd, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x33, 0x47, 0x16, 0x57, 0x74, 0x06, 0x00,
+	0x00,
 }
@@ -10,6 +10,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/decred/dcrd/chaincfg/chainhash"
 	"github.com/decred/dcrd/dcrec/secp256k1/v2"
 	"github.com/decred/dcrd/txscript/v2"
 	"github.com/decred/dcrd/wire"
@@ -36,6 +37,10 @@ var (
 			Entity: "signer",
 			Action: "generate",
 		},
+		{
+			Entity: "signer",
+			Action: "read",
+		},
 	}
 
 	// macPermissions maps RPC calls to the permissions they require.
@@ -48,6 +53,18 @@ var (
 			Entity: "signer",
 			Action: "generate",
 		}},
+		"/signrpc.Signer/SignMessage": {{
+			Entity: "signer",
+			Action: "generate",
+		}},
+		"/signrpc.Signer/VerifyMessage": {{
+			Entity: "signer",
+			Action: "read",
+		}},
+		"/signrpc.Signer/DeriveSharedKey": {{
+			Entity: "signer",
+			Action: "generate",
+		}},
 	}
 
 	// DefaultSignerMacFilename is the default name of the signer macaroon
@@ -181,10 +198,7 @@ func (s *Server) SignOutputRaw(ctx context.Context, in *SignReq) (*SignResp, err
 
 	// Now that we know we have an actual transaction to decode, we'll
 	// deserialize it into something that we can properly utilize.
-	var (
-		txToSign wire.MsgTx
-		err      error
-	)
+	var txToSign wire.MsgTx
 	txReader := bytes.NewReader(in.RawTxBytes)
 	if err := txToSign.Deserialize(txReader); err != nil {
 		return nil, fmt.Errorf("unable to decode tx: %v", err)
@@ -196,55 +210,6 @@ func (s *Server) SignOutputRaw(ctx context.Context, in *SignReq) (*SignResp, err
 	// we can feed it into the actual signer.
 	signDescs := make([]*input.SignDescriptor, 0, len(in.SignDescs))
 	for _, signDesc := range in.SignDescs {
-		keyDesc := signDesc.KeyDesc
-
-		// The caller can either specify the key using the raw pubkey,
-		// or the description of the key. Below we'll feel out the
-		// oneof field to decide which one we will attempt to parse.
-		var (
-			targetPubKey *secp256k1.PublicKey
-			keyLoc       keychain.KeyLocator
-		)
-		switch {
-
-		// If this method doesn't return nil, then we know that user is
-		// attempting to include a raw serialized pub key.
-		case keyDesc.GetRawKeyBytes() != nil:
-			rawKeyBytes := keyDesc.GetRawKeyBytes()
-
-			switch {
-			// If the user provided a raw key, but it's of the
-			// wrong length, then we'll return with an error.
-			case len(rawKeyBytes) != 0 && len(rawKeyBytes) != 33:
-
-				return nil, fmt.Errorf("pubkey must be " +
-					"serialized in compressed format if " +
-					"specified")
-
-			// If a proper raw key was provided, then we'll attempt
-			// to decode and parse it.
-			case len(rawKeyBytes) != 0 && len(rawKeyBytes) == 33:
-				targetPubKey, err = secp256k1.ParsePubKey(
-					rawKeyBytes,
-				)
-				if err != nil {
-					return nil, fmt.Errorf("unable to "+
-						"parse pubkey: %v", err)
-				}
-			}
-
-		// Similarly, if they specified a key locator, then we'll use
-		// that instead.
-		case keyDesc.GetKeyLoc() != nil:
-			protoLoc := keyDesc.GetKeyLoc()
-			keyLoc = keychain.KeyLocator{
-				Family: keychain.KeyFamily(
-					protoLoc.KeyFamily,
-				),
-				Index: uint32(protoLoc.KeyIndex),
-			}
-		}
-
 		// If a witness script isn't passed, then we can't proceed, as
 		// in the p2wsh case, we can't properly generate the sighash.
 		if len(signDesc.WitnessScript) == 0 {
@@ -254,33 +219,12 @@ func (s *Server) SignOutputRaw(ctx context.Context, in *SignReq) (*SignResp, err
 				"specified")
 		}
 
-		// If the users provided a double tweak, then we'll need to
-		// parse that out now to ensure their input is properly signed.
-		var tweakPrivKey *secp256k1.PrivateKey
-		if len(signDesc.DoubleTweak) != 0 {
-			tweakPrivKey, _ = secp256k1.PrivKeyFromBytes(
-				signDesc.DoubleTweak,
-			)
+		desc, err := parseSignDescriptor(signDesc)
+		if err != nil {
+			return nil, err
 		}
 
-		// Finally, with verification and parsing complete, we can
-		// construct the final sign descriptor to generate the proper
-		// signature for this input.
-		signDescs = append(signDescs, &input.SignDescriptor{
-			KeyDesc: keychain.KeyDescriptor{
-				KeyLocator: keyLoc,
-				PubKey:     targetPubKey,
-			},
-			SingleTweak:   signDesc.SingleTweak,
-			DoubleTweak:   tweakPrivKey,
-			WitnessScript: signDesc.WitnessScript,
-			Output: &wire.TxOut{
-				Value:    signDesc.Output.Value,
-				PkScript: signDesc.Output.PkScript,
-			},
-			HashType:   txscript.SigHashType(signDesc.Sighash),
-			InputIndex: int(signDesc.InputIndex),
-		})
+		signDescs = append(signDescs, desc)
 	}
 
 	// Now that we've mapped all the proper sign descriptors, we can
@@ -313,6 +257,8 @@ func (s *Server) SignOutputRaw(ctx context.Context, in *SignReq) (*SignResp, err
 // Note that when using this method to sign inputs belonging to the wallet, the
 // only items of the SignDescriptor that need to be populated are pkScript in
 // the TxOut field, the value in that same field, and finally the input index.
+// Outputs paying to a tweaked wallet key, such as the to_remote output of a
+// channel, can be signed by also populating the key descriptor and the tweak.
 func (s *Server) ComputeInputScript(ctx context.Context,
 	in *SignReq) (*InputScriptResp, error) {
 
@@ -338,19 +284,17 @@ func (s *Server) ComputeInputScript(ctx context.Context,
 		return nil, fmt.Errorf("unable to decode tx: %v", err)
 	}
 
+	// We only know how to provide full witnesses for outputs that we
+	// solely control. Those are either found through the script of the
+	// output, or derived from the key descriptor and tweaks passed in.
 	signDescs := make([]*input.SignDescriptor, 0, len(in.SignDescs))
 	for _, signDesc := range in.SignDescs {
-		// For this method, the only fields that we care about are the
-		// hash type, and the information concerning the output as we
-		// only know how to provide full witnesses for outputs that we
-		// solely control.
-		signDescs = append(signDescs, &input.SignDescriptor{
-			Output: &wire.TxOut{
-				Value:    signDesc.Output.Value,
-				PkScript: signDesc.Output.PkScript,
-			},
-			HashType: txscript.SigHashType(signDesc.Sighash),
-		})
+		desc, err := parseSignDescriptor(signDesc)
+		if err != nil {
+			return nil, err
+		}
+
+		signDescs = append(signDescs, desc)
 	}
 
 	// With all of our signDescs assembled, we can now generate a valid
@@ -376,3 +320,233 @@ func (s *Server) ComputeInputScript(ctx context.Context,
 
 	return resp, nil
 }
+
+// parseSignDescriptor converts the passed RPC sign descriptor into the sign
+// descriptor used by the signer, validating the key and tweaks it specifies.
+func parseSignDescriptor(signDesc *SignDescriptor) (*input.SignDescriptor,
+	error) {
+
+	if signDesc.Output == nil {
+		return nil, fmt.Errorf("the output being spent MUST be " +
+			"specified")
+	}
+
+	// The caller can either specify the key using the raw pubkey, or the
+	// description of the key. Below we'll feel out the oneof field to
+	// decide which one we will attempt to parse.
+	var (
+		targetPubKey *secp256k1.PublicKey
+		keyLoc       keychain.KeyLocator
+		err          error
+	)
+	keyDesc := signDesc.KeyDesc
+	switch {
+
+	// If no key descriptor was passed in, the key is found through the
+	// script of the output being spent.
+	case keyDesc == nil:
+
+	// If this method doesn't return nil, then we know that user is
+	// attempting to include a raw serialized pub key.
+	case keyDesc.GetRawKeyBytes() != nil:
+		rawKeyBytes := keyDesc.GetRawKeyBytes()
+
+		switch {
+		// If the user provided a raw key, but it's of the wrong
+		// length, then we'll return with an error.
+		case len(rawKeyBytes) != 0 && len(rawKeyBytes) != 33:
+
+			return nil, fmt.Errorf("pubkey must be " +
+				"serialized in compressed format if " +
+				"specified")
+
+		// If a proper raw key was provided, then we'll attempt to
+		// decode and parse it.
+		case len(rawKeyBytes) != 0 && len(rawKeyBytes) == 33:
+			targetPubKey, err = secp256k1.ParsePubKey(rawKeyBytes)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse "+
+					"pubkey: %v", err)
+			}
+		}
+
+	// Similarly, if they specified a key locator, then we'll use that
+	// instead.
+	case keyDesc.GetKeyLoc() != nil:
+		protoLoc := keyDesc.GetKeyLoc()
+		keyLoc = keychain.KeyLocator{
+			Family: keychain.KeyFamily(protoLoc.KeyFamily),
+			Index:  uint32(protoLoc.KeyIndex),
+		}
+	}
+
+	// The single tweak is added to the private key, while the double
+	// tweak is combined with it to derive a revocation key, so at most one
+	// of them can be applied.
+	if len(signDesc.SingleTweak) != 0 && len(signDesc.DoubleTweak) != 0 {
+		return nil, fmt.Errorf("only one of single_tweak and " +
+			"double_tweak can be specified")
+	}
+
+	var singleTweak []byte
+	if len(signDesc.SingleTweak) != 0 {
+		if len(signDesc.SingleTweak) != 32 {
+			return nil, fmt.Errorf("single tweak must be 32 " +
+				"bytes")
+		}
+		singleTweak = signDesc.SingleTweak
+	}
+
+	// If the users provided a double tweak, then we'll need to parse that
+	// out now to ensure their input is properly signed.
+	var tweakPrivKey *secp256k1.PrivateKey
+	if len(signDesc.DoubleTweak) != 0 {
+		if len(signDesc.DoubleTweak) != 32 {
+			return nil, fmt.Errorf("double tweak must be 32 " +
+				"bytes")
+		}
+		tweakPrivKey, _ = secp256k1.PrivKeyFromBytes(
+			signDesc.DoubleTweak,
+		)
+	}
+
+	// Finally, with verification and parsing complete, we can construct
+	// the final sign descriptor to generate the proper signature for this
+	// input.
+	return &input.SignDescriptor{
+		KeyDesc: keychain.KeyDescriptor{
+			KeyLocator: keyLoc,
+			PubKey:     targetPubKey,
+		},
+		SingleTweak:   singleTweak,
+		DoubleTweak:   tweakPrivKey,
+		WitnessScript: signDesc.WitnessScript,
+		Output: &wire.TxOut{
+			Value:    signDesc.Output.Value,
+			PkScript: signDesc.Output.PkScript,
+		},
+		HashType:   txscript.SigHashType(signDesc.Sighash),
+		InputIndex: int(signDesc.InputIndex),
+	}, nil
+}
+
+// SignMessage signs a message with the key specified in the key locator. The
+// returned signature is DER encoded.
+//
+// The main difference to SignMessage in the main RPC is that a specific key is
+// used to sign the message instead of the node identity private key.
+func (s *Server) SignMessage(ctx context.Context,
+	in *SignMessageReq) (*SignMessageResp, error) {
+
+	if in.Msg == nil {
+		return nil, fmt.Errorf("a message to sign MUST be passed in")
+	}
+	if in.KeyLoc == nil {
+		return nil, fmt.Errorf("a key locator MUST be passed in")
+	}
+
+	// Derive the private key we'll be using for signing.
+	keyDesc := keychain.KeyDescriptor{
+		KeyLocator: keychain.KeyLocator{
+			Family: keychain.KeyFamily(in.KeyLoc.KeyFamily),
+			Index:  uint32(in.KeyLoc.KeyIndex),
+		},
+	}
+	privKey, err := s.cfg.KeyRing.DerivePrivKey(keyDesc)
+	if err != nil {
+		return nil, fmt.Errorf("can't derive private key: %v", err)
+	}
+
+	// The signature is over the blake256 hash of the message.
+	digest := chainhash.HashB(in.Msg)
+	sig, err := privKey.Sign(digest)
+	if err != nil {
+		return nil, fmt.Errorf("can't sign the message: %v", err)
+	}
+
+	return &SignMessageResp{
+		Signature: sig.Serialize(),
+	}, nil
+}
+
+// VerifyMessage verifies a signature over a message using the public key
+// provided. The signature must be DER encoded.
+//
+// The main difference to VerifyMessage in the main RPC is that the public key
+// used to sign the message does not have to be a node known to the network.
+func (s *Server) VerifyMessage(ctx context.Context,
+	in *VerifyMessageReq) (*VerifyMessageResp, error) {
+
+	if in.Msg == nil {
+		return nil, fmt.Errorf("a message to verify MUST be passed in")
+	}
+	if in.Signature == nil {
+		return nil, fmt.Errorf("a signature to verify MUST be passed " +
+			"in")
+	}
+	if in.Pubkey == nil {
+		return nil, fmt.Errorf("a pubkey to verify MUST be passed in")
+	}
+	pubkey, err := secp256k1.ParsePubKey(in.Pubkey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse pubkey: %v", err)
+	}
+
+	// An invalid signature encoding is reported as an invalid signature
+	// rather than an error.
+	sig, err := secp256k1.ParseDERSignature(in.Signature)
+	if err != nil {
+		return &VerifyMessageResp{Valid: false}, nil
+	}
+
+	// The signature is over the blake256 hash of the message.
+	digest := chainhash.HashB(in.Msg)
+	valid := sig.Verify(digest, pubkey)
+
+	return &VerifyMessageResp{
+		Valid: valid,
+	}, nil
+}
+
+// DeriveSharedKey returns a shared secret key by performing Diffie-Hellman key
+// derivation between the ephemeral public key in the request and the node's
+// key specified in the key_loc parameter (or the node's identity private key
+// if no key locator is specified), such that P_shared = privKeyNode *
+// ephemeralPubkey. The resulting shared public key is serialized in the
+// compressed format and hashed with sha256, resulting in the final key length
+// of 256bit.
+func (s *Server) DeriveSharedKey(ctx context.Context,
+	in *SharedKeyRequest) (*SharedKeyResponse, error) {
+
+	if len(in.EphemeralPubkey) != 33 {
+		return nil, fmt.Errorf("ephemeral pubkey must be " +
+			"serialized in compressed format")
+	}
+	ephemeralPubkey, err := secp256k1.ParsePubKey(in.EphemeralPubkey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse pubkey: %v", err)
+	}
+
+	// By default, use the node identity private key.
+	locator := keychain.KeyLocator{
+		Family: keychain.KeyFamilyNodeKey,
+		Index:  0,
+	}
+	if in.KeyLoc != nil {
+		locator.Family = keychain.KeyFamily(in.KeyLoc.KeyFamily)
+		locator.Index = uint32(in.KeyLoc.KeyIndex)
+	}
+
+	// Derive the shared key using ECDH and hashing the serialized
+	// compressed shared point.
+	keyDescriptor := keychain.KeyDescriptor{KeyLocator: locator}
+	sharedKeyHash, err := s.cfg.KeyRing.ScalarMult(
+		keyDescriptor, ephemeralPubkey,
+	)
+	if err != nil {
+		log.Errorf("unable to derive shared key: %v", err)
+		return nil, err
+	}
+
+	return &SharedKeyResponse{SharedKey: sharedKeyHash}, nil
+}
@@ -0,0 +1,52 @@
+// +build swaprpc
+
+package swaprpc
+
+import (
+	"github.com/decred/dcrd/chaincfg/v2"
+	"github.com/decred/dcrlnd/contractcourt"
+	"github.com/decred/dcrlnd/keychain"
+	"github.com/decred/dcrlnd/lnwallet"
+	"github.com/decred/dcrlnd/macaroons"
+	"github.com/decred/dcrlnd/sweep"
+)
+
+// Config is the primary configuration struct for the swap RPC server. It
+// contains all the items required for the swap rpc server to carry out its
+// duties. The fields with struct tags are meant to be parsed as normal
+// configuration options, while if able to be populated, the latter fields MUST
+// also be specified.
+type Config struct {
+	// SwapMacPath is the path for the swap macaroon. If unspecified then
+	// we assume that the macaroon will be found under the network
+	// directory, named DefaultSwapMacFilename.
+	SwapMacPath string `long:"swapmacaroonpath" description:"Path to the swap macaroon"`
+
+	// NetworkDir is the main network directory wherein the swap rpc
+	// server will find the macaroon named DefaultSwapMacFilename.
+	NetworkDir string
+
+	// MacService is the main macaroon service that we'll use to handle
+	// authentication for the swap rpc server.
+	MacService *macaroons.Service
+
+	// KeyRing is an interface that the swap server will use to derive the
+	// local keys of swap HTLCs.
+	KeyRing keychain.KeyRing
+
+	// Sweeper is the central batching engine of lnd. It is used to sweep
+	// swap HTLCs back into the wallet.
+	Sweeper *sweep.UtxoSweeper
+
+	// Chain is an interface that the swap server will use to determine
+	// the current height of the chain.
+	Chain lnwallet.BlockChainIO
+
+	// PreimageBeacon is the global preimage cache of the node, used to
+	// look up the preimages of swap HTLCs that were revealed by settled
+	// payments.
+	PreimageBeacon contractcourt.WitnessBeacon
+
+	// ChainParams are the parameters of the chain the node is running on.
+	ChainParams *chaincfg.Params
+}
@@ -0,0 +1,6 @@
+// +build !swaprpc
+
+package swaprpc
+
+// Config is empty for non-swaprpc builds.
+type Config struct{}
@@ -0,0 +1,398 @@
+// +build swaprpc
+
+package swaprpc
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrec/secp256k1/v2"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/txscript/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/input"
+	"github.com/decred/dcrlnd/keychain"
+	"github.com/decred/dcrlnd/lnrpc"
+	"github.com/decred/dcrlnd/lntypes"
+	"github.com/decred/dcrlnd/lnwallet"
+	"github.com/decred/dcrlnd/sweep"
+	"google.golang.org/grpc"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+)
+
+const (
+	// subServerName is the name of the sub rpc server. We'll use this name
+	// to register ourselves, and we also require that the main
+	// SubServerConfigDispatcher instance recognize this as the name of the
+	// config file that we need.
+	subServerName = "SwapRPC"
+)
+
+var (
+	// macaroonOps are the set of capabilities that our minted macaroon (if
+	// it doesn't already exist) will have.
+	macaroonOps = []bakery.Op{
+		{
+			Entity: "onchain",
+			Action: "read",
+		},
+		{
+			Entity: "onchain",
+			Action: "write",
+		},
+	}
+
+	// macPermissions maps RPC calls to the permissions they require.
+	macPermissions = map[string][]bakery.Op{
+		"/swaprpc.Swap/NewHtlc": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
+		"/swaprpc.Swap/SweepHtlc": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+	}
+
+	// DefaultSwapMacFilename is the default name of the swap macaroon that
+	// we expect to find via a file handle within the main configuration
+	// file in this package.
+	DefaultSwapMacFilename = "swap.macaroon"
+)
+
+// Server is a sub-server of the main RPC server: the swap RPC. This sub RPC
+// server exposes the on-chain primitives required to perform submarine swaps
+// between on-chain and off-chain funds.
+type Server struct {
+	cfg *Config
+}
+
+// A compile time check to ensure that Server fully implements the SwapServer
+// gRPC service.
+var _ SwapServer = (*Server)(nil)
+
+// New returns a new instance of the swaprpc Swap sub-server. We also return
+// the set of permissions for the macaroons that we may create within this
+// method. If the macaroons we need aren't found in the filepath, then we'll
+// create them on start up. If we're unable to locate, or create the macaroons
+// we need, then we'll return with an error.
+func New(cfg *Config) (*Server, lnrpc.MacaroonPerms, error) {
+	// If the path of the swap macaroon wasn't generated, then we'll
+	// assume that it's found at the default network directory.
+	if cfg.SwapMacPath == "" {
+		cfg.SwapMacPath = filepath.Join(
+			cfg.NetworkDir, DefaultSwapMacFilename,
+		)
+	}
+
+	// Now that we know the full path of the swap macaroon, we can check to
+	// see if we need to create it or not.
+	macFilePath := cfg.SwapMacPath
+	if cfg.MacService != nil && !lnrpc.FileExists(macFilePath) {
+		log.Infof("Making macaroons for Swap RPC Server at: %v",
+			macFilePath)
+
+		// At this point, we know that the swap macaroon doesn't yet,
+		// exist, so we need to create it with the help of the main
+		// macaroon service.
+		swapMac, err := cfg.MacService.Oven.NewMacaroon(
+			context.Background(), bakery.LatestVersion, nil,
+			macaroonOps...,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		swapMacBytes, err := swapMac.M().MarshalBinary()
+		if err != nil {
+			return nil, nil, err
+		}
+		err = ioutil.WriteFile(macFilePath, swapMacBytes, 0644)
+		if err != nil {
+			os.Remove(macFilePath)
+			return nil, nil, err
+		}
+	}
+
+	swapServer := &Server{
+		cfg: cfg,
+	}
+
+	return swapServer, macPermissions, nil
+}
+
+// Start launches any helper goroutines required for the rpcServer to function.
+//
+// NOTE: This is part of the lnrpc.SubServer interface.
+func (s *Server) Start() error {
+	return nil
+}
+
+// Stop signals any active goroutines for a graceful closure.
+//
+// NOTE: This is part of the lnrpc.SubServer interface.
+func (s *Server) Stop() error {
+	return nil
+}
+
+// Name returns a unique string representation of the sub-server. This can be
+// used to identify the sub-server and also de-duplicate them.
+//
+// NOTE: This is part of the lnrpc.SubServer interface.
+func (s *Server) Name() string {
+	return subServerName
+}
+
+// RegisterWithRootServer will be called by the root gRPC server to direct a
+// sub RPC server to register itself with the main gRPC root server. Until this
+// is called, each sub-server won't be able to have requests routed towards it.
+//
+// NOTE: This is part of the lnrpc.SubServer interface.
+func (s *Server) RegisterWithRootServer(grpcServer *grpc.Server) error {
+	// We make sure that we register it with the main gRPC server to ensure
+	// all our methods are routed properly.
+	RegisterSwapServer(grpcServer, s)
+
+	log.Debugf("Swap RPC server successfully register with root gRPC " +
+		"server")
+
+	return nil
+}
+
+// swapHtlc is the parsed form of the parameters of a swap HTLC.
+type swapHtlc struct {
+	// keyDesc describes the local key of the HTLC.
+	keyDesc keychain.KeyDescriptor
+
+	// paymentHash is the payment hash the HTLC is bound to.
+	paymentHash lntypes.Hash
+
+	// redeemScript is the redeem script of the HTLC.
+	redeemScript []byte
+
+	// pkScript is the P2SH output script paying to the HTLC.
+	pkScript []byte
+}
+
+// parseHtlc validates the given HTLC parameters, derives the local key of the
+// HTLC and constructs its scripts.
+func (s *Server) parseHtlc(in *Htlc) (*swapHtlc, error) {
+	if in == nil {
+		return nil, fmt.Errorf("htlc parameters must be specified")
+	}
+	if in.KeyLoc == nil {
+		return nil, fmt.Errorf("key locator of the local key must be " +
+			"specified")
+	}
+
+	paymentHash, err := lntypes.MakeHash(in.PaymentHash)
+	if err != nil {
+		return nil, err
+	}
+	remoteKey, err := secp256k1.ParsePubKey(in.RemotePubkey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse remote pubkey: %v", err)
+	}
+
+	keyDesc, err := s.cfg.KeyRing.DeriveKey(keychain.KeyLocator{
+		Family: keychain.KeyFamily(in.KeyLoc.KeyFamily),
+		Index:  uint32(in.KeyLoc.KeyIndex),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	senderKey, receiverKey := keyDesc.PubKey, remoteKey
+	if in.LocalIsReceiver {
+		senderKey, receiverKey = remoteKey, keyDesc.PubKey
+	}
+
+	redeemScript, err := input.SwapHTLCScript(
+		in.CltvExpiry, senderKey, receiverKey, paymentHash[:],
+	)
+	if err != nil {
+		return nil, err
+	}
+	pkScript, err := input.ScriptHashPkScript(redeemScript)
+	if err != nil {
+		return nil, err
+	}
+
+	return &swapHtlc{
+		keyDesc:      keyDesc,
+		paymentHash:  paymentHash,
+		redeemScript: redeemScript,
+		pkScript:     pkScript,
+	}, nil
+}
+
+// NewHtlc returns the redeem script and the P2SH address of the on-chain HTLC
+// described by the given parameters.
+func (s *Server) NewHtlc(ctx context.Context,
+	in *Htlc) (*NewHtlcResponse, error) {
+
+	htlc, err := s.parseHtlc(in)
+	if err != nil {
+		return nil, err
+	}
+
+	addr, err := dcrutil.NewAddressScriptHash(
+		htlc.redeemScript, s.cfg.ChainParams,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NewHtlcResponse{
+		RedeemScript: htlc.redeemScript,
+		PkScript:     htlc.pkScript,
+		Address:      addr.String(),
+	}, nil
+}
+
+// SweepHtlc hands an on-chain HTLC over to the sweeper. If the local node is
+// the receiver of the HTLC, the HTLC is claimed with the given preimage, or the
+// one found in the preimage cache. Otherwise, the HTLC is reclaimed once it
+// has expired.
+func (s *Server) SweepHtlc(ctx context.Context,
+	in *SweepHtlcRequest) (*SweepHtlcResponse, error) {
+
+	htlc, err := s.parseHtlc(in.Htlc)
+	if err != nil {
+		return nil, err
+	}
+	op, err := unmarshallOutPoint(in.Outpoint)
+	if err != nil {
+		return nil, err
+	}
+	if in.Amount <= 0 {
+		return nil, fmt.Errorf("amount of the htlc output must be " +
+			"positive")
+	}
+
+	signDesc := &input.SignDescriptor{
+		KeyDesc:       htlc.keyDesc,
+		WitnessScript: htlc.redeemScript,
+		Output: &wire.TxOut{
+			Value:    in.Amount,
+			PkScript: htlc.pkScript,
+		},
+		HashType: txscript.SigHashAll,
+	}
+
+	var (
+		sweepInput input.Input
+		deadline   uint32
+	)
+	switch {
+	// As the receiver, we'll need the preimage to claim the HTLC. If the
+	// caller didn't provide it, we'll check whether we learned it from a
+	// payment already.
+	case in.Htlc.LocalIsReceiver:
+		var preimage lntypes.Preimage
+		if len(in.Preimage) == 0 {
+			var ok bool
+			preimage, ok = s.cfg.PreimageBeacon.LookupPreimage(
+				htlc.paymentHash,
+			)
+			if !ok {
+				return nil, fmt.Errorf("preimage for payment "+
+					"hash %v is unknown", htlc.paymentHash)
+			}
+		} else {
+			preimage, err = lntypes.MakePreimage(in.Preimage)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if !preimage.Matches(htlc.paymentHash) {
+			return nil, fmt.Errorf("preimage doesn't match payment "+
+				"hash %v", htlc.paymentHash)
+		}
+
+		succeedInput := input.MakeSwapHtlcSucceedInput(
+			op, signDesc, preimage[:], in.HeightHint,
+		)
+		sweepInput = &succeedInput
+
+		// The HTLC must be claimed before the sender is able to
+		// reclaim it, so its expiry is the deadline of the sweep.
+		deadline = in.Htlc.CltvExpiry
+
+	case len(in.Preimage) != 0:
+		return nil, fmt.Errorf("preimage can only be used by the " +
+			"receiver of the htlc")
+
+	// As the sender, we can only reclaim the HTLC once it has expired, as
+	// the sweep transaction would be rejected otherwise.
+	default:
+		_, bestHeight, err := s.cfg.Chain.GetBestBlock()
+		if err != nil {
+			return nil, err
+		}
+		if uint32(bestHeight) < in.Htlc.CltvExpiry {
+			return nil, fmt.Errorf("htlc expires at height %v, "+
+				"current height is %v", in.Htlc.CltvExpiry,
+				bestHeight)
+		}
+
+		sweepInput = input.NewBaseInput(
+			op, input.SwapHtlcTimeout, signDesc, in.HeightHint,
+		)
+	}
+
+	feePreference := sweep.FeePreference{
+		ConfTarget: in.TargetConf,
+		FeeRate:    lnwallet.AtomPerKByte(in.AtomsPerByte * 1000),
+	}
+	_, err = s.cfg.Sweeper.SweepInputWithDeadline(
+		sweepInput, feePreference, deadline,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Sweeping swap htlc %v (payment_hash=%v, witness_type=%v)",
+		op, htlc.paymentHash, sweepInput.WitnessType())
+
+	return &SweepHtlcResponse{}, nil
+}
+
+// unmarshallOutPoint converts an outpoint from its lnrpc type to its canonical
+// type.
+func unmarshallOutPoint(op *lnrpc.OutPoint) (*wire.OutPoint, error) {
+	if op == nil {
+		return nil, fmt.Errorf("empty outpoint provided")
+	}
+
+	var hash chainhash.Hash
+	switch {
+	case len(op.TxidBytes) == 0 && len(op.TxidStr) == 0:
+		fallthrough
+
+	case len(op.TxidBytes) != 0 && len(op.TxidStr) != 0:
+		return nil, fmt.Errorf("either TxidBytes or TxidStr must be " +
+			"specified, but not both")
+
+	// The hash was provided as raw bytes.
+	case len(op.TxidBytes) != 0:
+		copy(hash[:], op.TxidBytes)
+
+	// The hash was provided as a hex-encoded string.
+	case len(op.TxidStr) != 0:
+		h, err := chainhash.NewHashFromStr(op.TxidStr)
+		if err != nil {
+			return nil, err
+		}
+		hash = *h
+	}
+
+	return &wire.OutPoint{
+		Hash:  hash,
+		Index: op.OutputIndex,
+	}, nil
+}
@@ -3,6 +3,8 @@
 package walletrpc
 
 import (
+	"github.com/decred/dcrd/chaincfg/v2"
+	"github.com/decred/dcrlnd/channeldb"
 	"github.com/decred/dcrlnd/keychain"
 	"github.com/decred/dcrlnd/lnwallet"
 	"github.com/decred/dcrlnd/macaroons"
@@ -47,4 +49,13 @@ type Config struct {
 	// Chain is an interface that the WalletKit will use to determine state
 	// about the backing chain of the wallet.
 	Chain lnwallet.BlockChainIO
+
+	// ChainParams are the parameters of the chain the wallet operates on.
+	// They are required to translate the outputs of the wallet into
+	// addresses.
+	ChainParams *chaincfg.Params
+
+	// ChanDB is the channel database, which stores the labels of the
+	// on-chain transactions.
+	ChanDB *channeldb.DB
 }
@@ -86,6 +86,10 @@ const (
 	// script that pays to a key solely under our control.
 	WitnessType_NESTED_WITNESS_KEY_HASH WitnessType = 12
 	//
+	// A witness type that allows us to spend our anchor on the commitment
+	// transaction.
+	WitnessType_COMMITMENT_ANCHOR WitnessType = 13
+	//
 	// A witness type that allows us to sweep an output that sends to a P2PKH
 	// script.
 	WitnessType_PUBKEY_HASH WitnessType = 128
@@ -105,6 +109,7 @@ var WitnessType_name = map[int32]string{
 	10:  "HTLC_SECOND_LEVEL_REVOKE",
 	11:  "WITNESS_KEY_HASH",
 	12:  "NESTED_WITNESS_KEY_HASH",
+	13:  "COMMITMENT_ANCHOR",
 	128: "PUBKEY_HASH",
 }
 var WitnessType_value = map[string]int32{
@@ -121,6 +126,7 @@ var WitnessType_value = map[string]int32{
 	"HTLC_SECOND_LEVEL_REVOKE":           10,
 	"WITNESS_KEY_HASH":                   11,
 	"NESTED_WITNESS_KEY_HASH":            12,
+	"COMMITMENT_ANCHOR":                  13,
 	"PUBKEY_HASH":                        128,
 }
 
@@ -128,9 +134,264 @@ func (x WitnessType) String() string {
 	return proto.EnumName(WitnessType_name, int32(x))
 }
 func (WitnessType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_walletkit_17ae671db9771517, []int{0}
+	return fileDescriptor_walletkit_80a3e285752695c0, []int{0}
+}
+
+type ListUnspentRequest struct {
+	// The minimum number of confirmations to be included.
+	MinConfs int32 `protobuf:"varint,1,opt,name=min_confs,proto3" json:"min_confs,omitempty"`
+	// The maximum number of confirmations to be included.
+	MaxConfs             int32    `protobuf:"varint,2,opt,name=max_confs,proto3" json:"max_confs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListUnspentRequest) Reset()         { *m = ListUnspentRequest{} }
+func (m *ListUnspentRequest) String() string { return proto.CompactTextString(m) }
+func (*ListUnspentRequest) ProtoMessage()    {}
+func (*ListUnspentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_80a3e285752695c0, []int{0}
+}
+func (m *ListUnspentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUnspentRequest.Unmarshal(m, b)
+}
+func (m *ListUnspentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUnspentRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListUnspentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUnspentRequest.Merge(dst, src)
+}
+func (m *ListUnspentRequest) XXX_Size() int {
+	return xxx_messageInfo_ListUnspentRequest.Size(m)
+}
+func (m *ListUnspentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUnspentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListUnspentRequest proto.InternalMessageInfo
+
+func (m *ListUnspentRequest) GetMinConfs() int32 {
+	if m != nil {
+		return m.MinConfs
+	}
+	return 0
+}
+
+func (m *ListUnspentRequest) GetMaxConfs() int32 {
+	if m != nil {
+		return m.MaxConfs
+	}
+	return 0
+}
+
+type ListUnspentResponse struct {
+	// A list of utxos satisfying the specified number of confirmations.
+	Utxos                []*lnrpc.Utxo `protobuf:"bytes,1,rep,name=utxos,proto3" json:"utxos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ListUnspentResponse) Reset()         { *m = ListUnspentResponse{} }
+func (m *ListUnspentResponse) String() string { return proto.CompactTextString(m) }
+func (*ListUnspentResponse) ProtoMessage()    {}
+func (*ListUnspentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_80a3e285752695c0, []int{1}
+}
+func (m *ListUnspentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUnspentResponse.Unmarshal(m, b)
+}
+func (m *ListUnspentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUnspentResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListUnspentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUnspentResponse.Merge(dst, src)
+}
+func (m *ListUnspentResponse) XXX_Size() int {
+	return xxx_messageInfo_ListUnspentResponse.Size(m)
+}
+func (m *ListUnspentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUnspentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListUnspentResponse proto.InternalMessageInfo
+
+func (m *ListUnspentResponse) GetUtxos() []*lnrpc.Utxo {
+	if m != nil {
+		return m.Utxos
+	}
+	return nil
+}
+
+type LeaseOutputRequest struct {
+	//
+	// An ID of 32 random bytes that must be unique for each distinct application
+	// using this RPC which will be used to bound the output lease to.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The identifying outpoint of the output being leased.
+	Outpoint             *lnrpc.OutPoint `protobuf:"bytes,2,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *LeaseOutputRequest) Reset()         { *m = LeaseOutputRequest{} }
+func (m *LeaseOutputRequest) String() string { return proto.CompactTextString(m) }
+func (*LeaseOutputRequest) ProtoMessage()    {}
+func (*LeaseOutputRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_80a3e285752695c0, []int{2}
+}
+func (m *LeaseOutputRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeaseOutputRequest.Unmarshal(m, b)
+}
+func (m *LeaseOutputRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeaseOutputRequest.Marshal(b, m, deterministic)
+}
+func (dst *LeaseOutputRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaseOutputRequest.Merge(dst, src)
+}
+func (m *LeaseOutputRequest) XXX_Size() int {
+	return xxx_messageInfo_LeaseOutputRequest.Size(m)
+}
+func (m *LeaseOutputRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaseOutputRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaseOutputRequest proto.InternalMessageInfo
+
+func (m *LeaseOutputRequest) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *LeaseOutputRequest) GetOutpoint() *lnrpc.OutPoint {
+	if m != nil {
+		return m.Outpoint
+	}
+	return nil
+}
+
+type LeaseOutputResponse struct {
+	//
+	// The absolute expiration of the output lease represented as a unix timestamp.
+	Expiration           uint64   `protobuf:"varint,1,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LeaseOutputResponse) Reset()         { *m = LeaseOutputResponse{} }
+func (m *LeaseOutputResponse) String() string { return proto.CompactTextString(m) }
+func (*LeaseOutputResponse) ProtoMessage()    {}
+func (*LeaseOutputResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_80a3e285752695c0, []int{3}
+}
+func (m *LeaseOutputResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeaseOutputResponse.Unmarshal(m, b)
+}
+func (m *LeaseOutputResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeaseOutputResponse.Marshal(b, m, deterministic)
+}
+func (dst *LeaseOutputResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaseOutputResponse.Merge(dst, src)
+}
+func (m *LeaseOutputResponse) XXX_Size() int {
+	return xxx_messageInfo_LeaseOutputResponse.Size(m)
+}
+func (m *LeaseOutputResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaseOutputResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaseOutputResponse proto.InternalMessageInfo
+
+func (m *LeaseOutputResponse) GetExpiration() uint64 {
+	if m != nil {
+		return m.Expiration
+	}
+	return 0
+}
+
+type ReleaseOutputRequest struct {
+	// The unique ID that was used to lock the output.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The identifying outpoint of the output being released.
+	Outpoint             *lnrpc.OutPoint `protobuf:"bytes,2,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ReleaseOutputRequest) Reset()         { *m = ReleaseOutputRequest{} }
+func (m *ReleaseOutputRequest) String() string { return proto.CompactTextString(m) }
+func (*ReleaseOutputRequest) ProtoMessage()    {}
+func (*ReleaseOutputRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_80a3e285752695c0, []int{4}
+}
+func (m *ReleaseOutputRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleaseOutputRequest.Unmarshal(m, b)
+}
+func (m *ReleaseOutputRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleaseOutputRequest.Marshal(b, m, deterministic)
+}
+func (dst *ReleaseOutputRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseOutputRequest.Merge(dst, src)
+}
+func (m *ReleaseOutputRequest) XXX_Size() int {
+	return xxx_messageInfo_ReleaseOutputRequest.Size(m)
+}
+func (m *ReleaseOutputRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseOutputRequest.DiscardUnknown(m)
 }
 
+var xxx_messageInfo_ReleaseOutputRequest proto.InternalMessageInfo
+
+func (m *ReleaseOutputRequest) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *ReleaseOutputRequest) GetOutpoint() *lnrpc.OutPoint {
+	if m != nil {
+		return m.Outpoint
+	}
+	return nil
+}
+
+type ReleaseOutputResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReleaseOutputResponse) Reset()         { *m = ReleaseOutputResponse{} }
+func (m *ReleaseOutputResponse) String() string { return proto.CompactTextString(m) }
+func (*ReleaseOutputResponse) ProtoMessage()    {}
+func (*ReleaseOutputResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_80a3e285752695c0, []int{5}
+}
+func (m *ReleaseOutputResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleaseOutputResponse.Unmarshal(m, b)
+}
+func (m *ReleaseOutputResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleaseOutputResponse.Marshal(b, m, deterministic)
+}
+func (dst *ReleaseOutputResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseOutputResponse.Merge(dst, src)
+}
+func (m *ReleaseOutputResponse) XXX_Size() int {
+	return xxx_messageInfo_ReleaseOutputResponse.Size(m)
+}
+func (m *ReleaseOutputResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseOutputResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseOutputResponse proto.InternalMessageInfo
+
 type KeyReq struct {
 	// *
 	// Is the key finger print of the root pubkey that this request is targeting.
@@ -150,7 +411,7 @@ func (m *KeyReq) Reset()         { *m = KeyReq{} }
 func (m *KeyReq) String() string { return proto.CompactTextString(m) }
 func (*KeyReq) ProtoMessage()    {}
 func (*KeyReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_walletkit_17ae671db9771517, []int{0}
+	return fileDescriptor_walletkit_80a3e285752695c0, []int{6}
 }
 func (m *KeyReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_KeyReq.Unmarshal(m, b)
@@ -194,7 +455,7 @@ func (m *AddrRequest) Reset()         { *m = AddrRequest{} }
 func (m *AddrRequest) String() string { return proto.CompactTextString(m) }
 func (*AddrRequest) ProtoMessage()    {}
 func (*AddrRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_walletkit_17ae671db9771517, []int{1}
+	return fileDescriptor_walletkit_80a3e285752695c0, []int{7}
 }
 func (m *AddrRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddrRequest.Unmarshal(m, b)
@@ -227,7 +488,7 @@ func (m *AddrResponse) Reset()         { *m = AddrResponse{} }
 func (m *AddrResponse) String() string { return proto.CompactTextString(m) }
 func (*AddrResponse) ProtoMessage()    {}
 func (*AddrResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_walletkit_17ae671db9771517, []int{2}
+	return fileDescriptor_walletkit_80a3e285752695c0, []int{8}
 }
 func (m *AddrResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddrResponse.Unmarshal(m, b)
@@ -267,7 +528,7 @@ func (m *Transaction) Reset()         { *m = Transaction{} }
 func (m *Transaction) String() string { return proto.CompactTextString(m) }
 func (*Transaction) ProtoMessage()    {}
 func (*Transaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_walletkit_17ae671db9771517, []int{3}
+	return fileDescriptor_walletkit_80a3e285752695c0, []int{9}
 }
 func (m *Transaction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Transaction.Unmarshal(m, b)
@@ -311,7 +572,7 @@ func (m *PublishResponse) Reset()         { *m = PublishResponse{} }
 func (m *PublishResponse) String() string { return proto.CompactTextString(m) }
 func (*PublishResponse) ProtoMessage()    {}
 func (*PublishResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_walletkit_17ae671db9771517, []int{4}
+	return fileDescriptor_walletkit_80a3e285752695c0, []int{10}
 }
 func (m *PublishResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PublishResponse.Unmarshal(m, b)
@@ -355,7 +616,7 @@ func (m *SendOutputsRequest) Reset()         { *m = SendOutputsRequest{} }
 func (m *SendOutputsRequest) String() string { return proto.CompactTextString(m) }
 func (*SendOutputsRequest) ProtoMessage()    {}
 func (*SendOutputsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_walletkit_17ae671db9771517, []int{5}
+	return fileDescriptor_walletkit_80a3e285752695c0, []int{11}
 }
 func (m *SendOutputsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendOutputsRequest.Unmarshal(m, b)
@@ -402,7 +663,7 @@ func (m *SendOutputsResponse) Reset()         { *m = SendOutputsResponse{} }
 func (m *SendOutputsResponse) String() string { return proto.CompactTextString(m) }
 func (*SendOutputsResponse) ProtoMessage()    {}
 func (*SendOutputsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_walletkit_17ae671db9771517, []int{6}
+	return fileDescriptor_walletkit_80a3e285752695c0, []int{12}
 }
 func (m *SendOutputsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendOutputsResponse.Unmarshal(m, b)
@@ -442,7 +703,7 @@ func (m *EstimateFeeRequest) Reset()         { *m = EstimateFeeRequest{} }
 func (m *EstimateFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimateFeeRequest) ProtoMessage()    {}
 func (*EstimateFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_walletkit_17ae671db9771517, []int{7}
+	return fileDescriptor_walletkit_80a3e285752695c0, []int{13}
 }
 func (m *EstimateFeeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EstimateFeeRequest.Unmarshal(m, b)
@@ -483,7 +744,7 @@ func (m *EstimateFeeResponse) Reset()         { *m = EstimateFeeResponse{} }
 func (m *EstimateFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateFeeResponse) ProtoMessage()    {}
 func (*EstimateFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_walletkit_17ae671db9771517, []int{8}
+	return fileDescriptor_walletkit_80a3e285752695c0, []int{14}
 }
 func (m *EstimateFeeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EstimateFeeResponse.Unmarshal(m, b)
@@ -537,7 +798,7 @@ func (m *PendingSweep) Reset()         { *m = PendingSweep{} }
 func (m *PendingSweep) String() string { return proto.CompactTextString(m) }
 func (*PendingSweep) ProtoMessage()    {}
 func (*PendingSweep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_walletkit_17ae671db9771517, []int{9}
+	return fileDescriptor_walletkit_80a3e285752695c0, []int{15}
 }
 func (m *PendingSweep) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PendingSweep.Unmarshal(m, b)
@@ -609,7 +870,7 @@ func (m *PendingSweepsRequest) Reset()         { *m = PendingSweepsRequest{} }
 func (m *PendingSweepsRequest) String() string { return proto.CompactTextString(m) }
 func (*PendingSweepsRequest) ProtoMessage()    {}
 func (*PendingSweepsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_walletkit_17ae671db9771517, []int{10}
+	return fileDescriptor_walletkit_80a3e285752695c0, []int{16}
 }
 func (m *PendingSweepsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PendingSweepsRequest.Unmarshal(m, b)
@@ -642,7 +903,7 @@ func (m *PendingSweepsResponse) Reset()         { *m = PendingSweepsResponse{} }
 func (m *PendingSweepsResponse) String() string { return proto.CompactTextString(m) }
 func (*PendingSweepsResponse) ProtoMessage()    {}
 func (*PendingSweepsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_walletkit_17ae671db9771517, []int{11}
+	return fileDescriptor_walletkit_80a3e285752695c0, []int{17}
 }
 func (m *PendingSweepsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PendingSweepsResponse.Unmarshal(m, b)
@@ -687,7 +948,7 @@ func (m *BumpFeeRequest) Reset()         { *m = BumpFeeRequest{} }
 func (m *BumpFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*BumpFeeRequest) ProtoMessage()    {}
 func (*BumpFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_walletkit_17ae671db9771517, []int{12}
+	return fileDescriptor_walletkit_80a3e285752695c0, []int{18}
 }
 func (m *BumpFeeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BumpFeeRequest.Unmarshal(m, b)
@@ -738,7 +999,7 @@ func (m *BumpFeeResponse) Reset()         { *m = BumpFeeResponse{} }
 func (m *BumpFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*BumpFeeResponse) ProtoMessage()    {}
 func (*BumpFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_walletkit_17ae671db9771517, []int{13}
+	return fileDescriptor_walletkit_80a3e285752695c0, []int{19}
 }
 func (m *BumpFeeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BumpFeeResponse.Unmarshal(m, b)
@@ -758,7 +1019,107 @@ func (m *BumpFeeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BumpFeeResponse proto.InternalMessageInfo
 
+type LabelTransactionRequest struct {
+	// *
+	// The txid of the transaction to label, in its internal byte order. The
+	// transaction must be known to the wallet.
+	Txid []byte `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	// *
+	// The label to give to the transaction, limited to 500 characters. An empty
+	// label can only be used along with overwrite to remove an existing label.
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// *
+	// Whether to replace the existing label of the transaction, if any.
+	// Transactions broadcast by lnd for its own operations, such as channel
+	// fundings or sweeps, are labeled automatically.
+	Overwrite            bool     `protobuf:"varint,3,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LabelTransactionRequest) Reset()         { *m = LabelTransactionRequest{} }
+func (m *LabelTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*LabelTransactionRequest) ProtoMessage()    {}
+func (*LabelTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_80a3e285752695c0, []int{20}
+}
+func (m *LabelTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LabelTransactionRequest.Unmarshal(m, b)
+}
+func (m *LabelTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LabelTransactionRequest.Marshal(b, m, deterministic)
+}
+func (dst *LabelTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LabelTransactionRequest.Merge(dst, src)
+}
+func (m *LabelTransactionRequest) XXX_Size() int {
+	return xxx_messageInfo_LabelTransactionRequest.Size(m)
+}
+func (m *LabelTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LabelTransactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LabelTransactionRequest proto.InternalMessageInfo
+
+func (m *LabelTransactionRequest) GetTxid() []byte {
+	if m != nil {
+		return m.Txid
+	}
+	return nil
+}
+
+func (m *LabelTransactionRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *LabelTransactionRequest) GetOverwrite() bool {
+	if m != nil {
+		return m.Overwrite
+	}
+	return false
+}
+
+type LabelTransactionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LabelTransactionResponse) Reset()         { *m = LabelTransactionResponse{} }
+func (m *LabelTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*LabelTransactionResponse) ProtoMessage()    {}
+func (*LabelTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_80a3e285752695c0, []int{21}
+}
+func (m *LabelTransactionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LabelTransactionResponse.Unmarshal(m, b)
+}
+func (m *LabelTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LabelTransactionResponse.Marshal(b, m, deterministic)
+}
+func (dst *LabelTransactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LabelTransactionResponse.Merge(dst, src)
+}
+func (m *LabelTransactionResponse) XXX_Size() int {
+	return xxx_messageInfo_LabelTransactionResponse.Size(m)
+}
+func (m *LabelTransactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LabelTransactionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LabelTransactionResponse proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterType((*ListUnspentRequest)(nil), "walletrpc.ListUnspentRequest")
+	proto.RegisterType((*ListUnspentResponse)(nil), "walletrpc.ListUnspentResponse")
+	proto.RegisterType((*LeaseOutputRequest)(nil), "walletrpc.LeaseOutputRequest")
+	proto.RegisterType((*LeaseOutputResponse)(nil), "walletrpc.LeaseOutputResponse")
+	proto.RegisterType((*ReleaseOutputRequest)(nil), "walletrpc.ReleaseOutputRequest")
+	proto.RegisterType((*ReleaseOutputResponse)(nil), "walletrpc.ReleaseOutputResponse")
 	proto.RegisterType((*KeyReq)(nil), "walletrpc.KeyReq")
 	proto.RegisterType((*AddrRequest)(nil), "walletrpc.AddrRequest")
 	proto.RegisterType((*AddrResponse)(nil), "walletrpc.AddrResponse")
@@ -773,6 +1134,8 @@ func init() {
 	proto.RegisterType((*PendingSweepsResponse)(nil), "walletrpc.PendingSweepsResponse")
 	proto.RegisterType((*BumpFeeRequest)(nil), "walletrpc.BumpFeeRequest")
 	proto.RegisterType((*BumpFeeResponse)(nil), "walletrpc.BumpFeeResponse")
+	proto.RegisterType((*LabelTransactionRequest)(nil), "walletrpc.LabelTransactionRequest")
+	proto.RegisterType((*LabelTransactionResponse)(nil), "walletrpc.LabelTransactionResponse")
 	proto.RegisterEnum("walletrpc.WitnessType", WitnessType_name, WitnessType_value)
 }
 
@@ -788,6 +1151,22 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type WalletKitClient interface {
+	// *
+	// ListUnspent returns a list of all utxos spendable by the wallet with a
+	// number of confirmations between the specified minimum and maximum.
+	ListUnspent(ctx context.Context, in *ListUnspentRequest, opts ...grpc.CallOption) (*ListUnspentResponse, error)
+	// *
+	// LeaseOutput locks an output to the given ID, preventing it from being
+	// available for any future coin selection attempts. The absolute time of the
+	// lock's expiration is returned. The expiration of the lock can be extended by
+	// successive invocations of this RPC. Outputs can be unlocked before their
+	// expiration through `ReleaseOutput`.
+	LeaseOutput(ctx context.Context, in *LeaseOutputRequest, opts ...grpc.CallOption) (*LeaseOutputResponse, error)
+	// *
+	// ReleaseOutput unlocks an output, allowing it to be available for coin
+	// selection if it remains unspent. The ID should match the one used to
+	// originally lock the output.
+	ReleaseOutput(ctx context.Context, in *ReleaseOutputRequest, opts ...grpc.CallOption) (*ReleaseOutputResponse, error)
 	// *
 	// DeriveNextKey attempts to derive the *next* key within the key family
 	// (account in BIP43) specified. This method should return the next external
@@ -853,6 +1232,10 @@ type WalletKitClient interface {
 	// fee preference being provided. For now, the responsibility of ensuring that
 	// the new fee preference is sufficient is delegated to the user.
 	BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error)
+	// *
+	// LabelTransaction adds a label to a transaction of the wallet. Labels are
+	// returned by GetTransactions, which can also filter transactions by label.
+	LabelTransaction(ctx context.Context, in *LabelTransactionRequest, opts ...grpc.CallOption) (*LabelTransactionResponse, error)
 }
 
 type walletKitClient struct {
@@ -863,6 +1246,33 @@ func NewWalletKitClient(cc *grpc.ClientConn) WalletKitClient {
 	return &walletKitClient{cc}
 }
 
+func (c *walletKitClient) ListUnspent(ctx context.Context, in *ListUnspentRequest, opts ...grpc.CallOption) (*ListUnspentResponse, error) {
+	out := new(ListUnspentResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/ListUnspent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) LeaseOutput(ctx context.Context, in *LeaseOutputRequest, opts ...grpc.CallOption) (*LeaseOutputResponse, error) {
+	out := new(LeaseOutputResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/LeaseOutput", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) ReleaseOutput(ctx context.Context, in *ReleaseOutputRequest, opts ...grpc.CallOption) (*ReleaseOutputResponse, error) {
+	out := new(ReleaseOutputResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/ReleaseOutput", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *walletKitClient) DeriveNextKey(ctx context.Context, in *KeyReq, opts ...grpc.CallOption) (*signrpc.KeyDescriptor, error) {
 	out := new(signrpc.KeyDescriptor)
 	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/DeriveNextKey", in, out, opts...)
@@ -935,8 +1345,33 @@ func (c *walletKitClient) BumpFee(ctx context.Context, in *BumpFeeRequest, opts
 	return out, nil
 }
 
+func (c *walletKitClient) LabelTransaction(ctx context.Context, in *LabelTransactionRequest, opts ...grpc.CallOption) (*LabelTransactionResponse, error) {
+	out := new(LabelTransactionResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/LabelTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WalletKitServer is the server API for WalletKit service.
 type WalletKitServer interface {
+	// *
+	// ListUnspent returns a list of all utxos spendable by the wallet with a
+	// number of confirmations between the specified minimum and maximum.
+	ListUnspent(context.Context, *ListUnspentRequest) (*ListUnspentResponse, error)
+	// *
+	// LeaseOutput locks an output to the given ID, preventing it from being
+	// available for any future coin selection attempts. The absolute time of the
+	// lock's expiration is returned. The expiration of the lock can be extended by
+	// successive invocations of this RPC. Outputs can be unlocked before their
+	// expiration through `ReleaseOutput`.
+	LeaseOutput(context.Context, *LeaseOutputRequest) (*LeaseOutputResponse, error)
+	// *
+	// ReleaseOutput unlocks an output, allowing it to be available for coin
+	// selection if it remains unspent. The ID should match the one used to
+	// originally lock the output.
+	ReleaseOutput(context.Context, *ReleaseOutputRequest) (*ReleaseOutputResponse, error)
 	// *
 	// DeriveNextKey attempts to derive the *next* key within the key family
 	// (account in BIP43) specified. This method should return the next external
@@ -1002,12 +1437,70 @@ type WalletKitServer interface {
 	// fee preference being provided. For now, the responsibility of ensuring that
 	// the new fee preference is sufficient is delegated to the user.
 	BumpFee(context.Context, *BumpFeeRequest) (*BumpFeeResponse, error)
+	// *
+	// LabelTransaction adds a label to a transaction of the wallet. Labels are
+	// returned by GetTransactions, which can also filter transactions by label.
+	LabelTransaction(context.Context, *LabelTransactionRequest) (*LabelTransactionResponse, error)
 }
 
 func RegisterWalletKitServer(s *grpc.Server, srv WalletKitServer) {
 	s.RegisterService(&_WalletKit_serviceDesc, srv)
 }
 
+func _WalletKit_ListUnspent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUnspentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).ListUnspent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/ListUnspent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).ListUnspent(ctx, req.(*ListUnspentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_LeaseOutput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseOutputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).LeaseOutput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/LeaseOutput",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).LeaseOutput(ctx, req.(*LeaseOutputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_ReleaseOutput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseOutputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).ReleaseOutput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/ReleaseOutput",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).ReleaseOutput(ctx, req.(*ReleaseOutputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WalletKit_DeriveNextKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(KeyReq)
 	if err := dec(in); err != nil {
@@ -1152,10 +1645,40 @@ func _WalletKit_BumpFee_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WalletKit_LabelTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LabelTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).LabelTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/LabelTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).LabelTransaction(ctx, req.(*LabelTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _WalletKit_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "walletrpc.WalletKit",
 	HandlerType: (*WalletKitServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListUnspent",
+			Handler:    _WalletKit_ListUnspent_Handler,
+		},
+		{
+			MethodName: "LeaseOutput",
+			Handler:    _WalletKit_LeaseOutput_Handler,
+		},
+		{
+			MethodName: "ReleaseOutput",
+			Handler:    _WalletKit_ReleaseOutput_Handler,
+		},
 		{
 			MethodName: "DeriveNextKey",
 			Handler:    _WalletKit_DeriveNextKey_Handler,
@@ -1188,77 +1711,96 @@ var _WalletKit_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BumpFee",
 			Handler:    _WalletKit_BumpFee_Handler,
 		},
+		{
+			MethodName: "LabelTransaction",
+			Handler:    _WalletKit_LabelTransaction_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "walletrpc/walletkit.proto",
 }
 
 func init() {
-	proto.RegisterFile("walletrpc/walletkit.proto", fileDescriptor_walletkit_17ae671db9771517)
-}
-
-var fileDescriptor_walletkit_17ae671db9771517 = []byte{
-	// 985 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xdd, 0x72, 0xe2, 0x36,
-	0x14, 0x2e, 0x21, 0x21, 0xe1, 0x18, 0x88, 0xa3, 0xfc, 0x79, 0xd9, 0xdd, 0x86, 0xba, 0xed, 0x0e,
-	0xb3, 0xed, 0x90, 0x99, 0xf4, 0x77, 0xda, 0x8b, 0x36, 0x21, 0xce, 0x24, 0x03, 0xc1, 0xd4, 0x76,
-	0x36, 0xdd, 0xde, 0xa8, 0xc6, 0xd6, 0x12, 0x4f, 0xc0, 0xf6, 0xca, 0xa2, 0xc0, 0x5d, 0xef, 0xfa,
-	0x02, 0x7d, 0x89, 0xbe, 0x42, 0x9f, 0xae, 0x63, 0xd9, 0x38, 0x32, 0x21, 0xd3, 0xd9, 0x2b, 0xc4,
-	0xf7, 0x7d, 0xe7, 0xe8, 0xe8, 0xe8, 0xe8, 0x03, 0x78, 0x36, 0xb5, 0x47, 0x23, 0xc2, 0x68, 0xe8,
-	0x1c, 0x27, 0xab, 0x7b, 0x8f, 0xb5, 0x42, 0x1a, 0xb0, 0x00, 0x95, 0x33, 0xaa, 0x5e, 0xa6, 0xa1,
-	0x93, 0xa0, 0xf5, 0xbd, 0xc8, 0x1b, 0xfa, 0xb1, 0x3c, 0xfe, 0x24, 0x34, 0x41, 0xd5, 0x5f, 0xa0,
-	0xd4, 0x21, 0x73, 0x83, 0xbc, 0x47, 0x4d, 0x90, 0xef, 0xc9, 0x1c, 0xbf, 0xf3, 0xfc, 0x21, 0xa1,
-	0x38, 0xa4, 0x9e, 0xcf, 0x94, 0x42, 0xa3, 0xd0, 0xdc, 0x30, 0x6a, 0xf7, 0x64, 0x7e, 0xc1, 0xe1,
-	0x7e, 0x8c, 0xa2, 0x97, 0x00, 0x5c, 0x69, 0x8f, 0xbd, 0xd1, 0x5c, 0x59, 0xe3, 0x9a, 0x72, 0xac,
-	0xe1, 0x80, 0x5a, 0x05, 0xe9, 0xd4, 0x75, 0xa9, 0x41, 0xde, 0x4f, 0x48, 0xc4, 0x54, 0x15, 0x2a,
-	0xc9, 0xd7, 0x28, 0x0c, 0xfc, 0x88, 0x20, 0x04, 0xeb, 0xb6, 0xeb, 0x52, 0x9e, 0xbb, 0x6c, 0xf0,
-	0xb5, 0xfa, 0x19, 0x48, 0x16, 0xb5, 0xfd, 0xc8, 0x76, 0x98, 0x17, 0xf8, 0x68, 0x1f, 0x4a, 0x6c,
-	0x86, 0xef, 0xc8, 0x8c, 0x8b, 0x2a, 0xc6, 0x06, 0x9b, 0x5d, 0x92, 0x99, 0xfa, 0x2d, 0x6c, 0xf7,
-	0x27, 0x83, 0x91, 0x17, 0xdd, 0x65, 0xc9, 0x3e, 0x85, 0x6a, 0x98, 0x40, 0x98, 0x50, 0x1a, 0x2c,
-	0xb2, 0x56, 0x52, 0x50, 0x8b, 0x31, 0xf5, 0x77, 0x40, 0x26, 0xf1, 0x5d, 0x7d, 0xc2, 0xc2, 0x09,
-	0x8b, 0xd2, 0xba, 0x50, 0x03, 0x2a, 0x36, 0x0b, 0xc6, 0x11, 0x0e, 0x09, 0xc5, 0xf7, 0x03, 0x1e,
-	0x59, 0x34, 0x80, 0x63, 0x7d, 0x42, 0x3b, 0x03, 0xd4, 0x84, 0xcd, 0x20, 0x89, 0x51, 0xd6, 0x1a,
-	0xc5, 0xa6, 0x74, 0x52, 0x6b, 0xa5, 0x3d, 0x6c, 0x59, 0x33, 0x7d, 0xc2, 0x8c, 0x05, 0xad, 0x7e,
-	0x09, 0xbb, 0xb9, 0x1d, 0xd2, 0xea, 0xf6, 0xa1, 0x44, 0xed, 0x29, 0x66, 0xd9, 0x39, 0xa8, 0x3d,
-	0xb5, 0x66, 0xea, 0x37, 0x80, 0xb4, 0x88, 0x79, 0x63, 0x9b, 0x91, 0x0b, 0x42, 0x16, 0xf5, 0x1c,
-	0x81, 0xe4, 0x04, 0xfe, 0x3b, 0xcc, 0x6c, 0x3a, 0x24, 0x8b, 0xd6, 0x43, 0x0c, 0x59, 0x1c, 0x51,
-	0xbf, 0x83, 0xdd, 0x5c, 0x58, 0xba, 0xc9, 0xff, 0x9e, 0x43, 0xfd, 0x67, 0x0d, 0x2a, 0x7d, 0xe2,
-	0xbb, 0x9e, 0x3f, 0x34, 0xa7, 0x84, 0x84, 0xe8, 0x0b, 0xd8, 0x8a, 0x2b, 0x0f, 0x16, 0x57, 0x2c,
-	0x9d, 0x6c, 0xb7, 0x46, 0xfc, 0x5c, 0xfa, 0x84, 0xf5, 0x63, 0xd8, 0xc8, 0x04, 0xe8, 0x07, 0xa8,
-	0x4c, 0x3d, 0xe6, 0x93, 0x28, 0xc2, 0x6c, 0x1e, 0x12, 0x7e, 0xdf, 0xb5, 0x93, 0x83, 0x56, 0x36,
-	0x64, 0xad, 0xdb, 0x84, 0xb6, 0xe6, 0x21, 0x31, 0x72, 0x5a, 0xa4, 0x42, 0xc5, 0x1e, 0x07, 0x13,
-	0x9f, 0x61, 0x5e, 0x8e, 0x52, 0x6c, 0x14, 0x9a, 0x55, 0x23, 0x87, 0xa1, 0x57, 0x50, 0x7b, 0xa8,
-	0x7f, 0x30, 0x67, 0x44, 0x59, 0xe7, 0xaa, 0x25, 0x14, 0xb5, 0x00, 0x0d, 0x68, 0x60, 0xbb, 0x8e,
-	0x1d, 0xc5, 0xa1, 0x8c, 0x8c, 0x43, 0x16, 0x29, 0x1b, 0x5c, 0xbb, 0x82, 0x41, 0x5f, 0xc3, 0xbe,
-	0x4f, 0x66, 0x0c, 0x3f, 0x50, 0x77, 0xc4, 0x1b, 0xde, 0x31, 0xa5, 0xc4, 0x43, 0x56, 0x93, 0xea,
-	0x01, 0xec, 0x89, 0xad, 0x5a, 0x4c, 0x8b, 0xfa, 0x2b, 0xec, 0x2f, 0xe1, 0x69, 0xfb, 0x7f, 0x82,
-	0x5a, 0x98, 0x10, 0x38, 0xe2, 0x8c, 0x52, 0xe0, 0xb3, 0x72, 0x28, 0x34, 0x48, 0x8c, 0x34, 0x96,
-	0xe4, 0xea, 0x5f, 0x05, 0xa8, 0x9d, 0x4d, 0xc6, 0xa1, 0x30, 0x0a, 0x1f, 0x74, 0x3f, 0x0d, 0x90,
-	0x92, 0x91, 0xc1, 0xf1, 0xac, 0xf0, 0xeb, 0xa9, 0x1a, 0x22, 0xb4, 0xa2, 0xc3, 0xc5, 0x55, 0x1d,
-	0x56, 0x77, 0x60, 0x3b, 0x2b, 0x24, 0x39, 0xdd, 0xeb, 0xbf, 0x8b, 0x20, 0x09, 0xd7, 0x8b, 0x76,
-	0x61, 0xfb, 0xa6, 0xd7, 0xe9, 0xe9, 0xb7, 0x3d, 0x7c, 0x7b, 0x65, 0xf5, 0x34, 0xd3, 0x94, 0x3f,
-	0x42, 0x0a, 0xec, 0xb5, 0xf5, 0xeb, 0xeb, 0x2b, 0xeb, 0x5a, 0xeb, 0x59, 0xd8, 0xba, 0xba, 0xd6,
-	0x70, 0x57, 0x6f, 0x77, 0xe4, 0x02, 0x3a, 0x84, 0x5d, 0x81, 0xe9, 0xe9, 0xf8, 0x5c, 0xeb, 0x9e,
-	0xbe, 0x95, 0xd7, 0xd0, 0x3e, 0xec, 0x08, 0x84, 0xa1, 0xbd, 0xd1, 0x3b, 0x9a, 0x5c, 0x8c, 0xf5,
-	0x97, 0x56, 0xb7, 0x8d, 0xf5, 0x8b, 0x0b, 0xcd, 0xd0, 0xce, 0x17, 0xc4, 0x7a, 0xbc, 0x05, 0x27,
-	0x4e, 0xdb, 0x6d, 0xad, 0x6f, 0x3d, 0x30, 0x1b, 0xe8, 0x73, 0xf8, 0x24, 0x17, 0x12, 0x6f, 0xaf,
-	0xdf, 0x58, 0xd8, 0xd4, 0xda, 0x7a, 0xef, 0x1c, 0x77, 0xb5, 0x37, 0x5a, 0x57, 0x2e, 0xa1, 0x57,
-	0xa0, 0xe6, 0x13, 0x98, 0x37, 0xed, 0xb6, 0x66, 0x9a, 0x79, 0xdd, 0x26, 0x3a, 0x82, 0xe7, 0x4b,
-	0x15, 0x5c, 0xeb, 0x96, 0xb6, 0xc8, 0x2a, 0x6f, 0xa1, 0x06, 0xbc, 0x58, 0xae, 0x84, 0x2b, 0xd2,
-	0x7c, 0x72, 0x19, 0xbd, 0x00, 0x85, 0x2b, 0xc4, 0xcc, 0x8b, 0x7a, 0x01, 0xed, 0x81, 0x9c, 0x76,
-	0x0e, 0x77, 0xb4, 0xb7, 0xf8, 0xf2, 0xd4, 0xbc, 0x94, 0x25, 0xf4, 0x1c, 0x0e, 0x7b, 0x9a, 0x19,
-	0xa7, 0x7b, 0x44, 0x56, 0x90, 0x0c, 0x52, 0xff, 0xe6, 0x2c, 0x03, 0xfe, 0x2c, 0x9c, 0xfc, 0xbb,
-	0x0e, 0xe5, 0x5b, 0x3e, 0x5e, 0x1d, 0x2f, 0x7e, 0xa1, 0xd5, 0x73, 0x42, 0xbd, 0x3f, 0x48, 0x8f,
-	0xcc, 0x58, 0x87, 0xcc, 0xd1, 0x8e, 0x30, 0x7b, 0x89, 0xbb, 0xd7, 0x0f, 0x32, 0xeb, 0xea, 0x90,
-	0xf9, 0x39, 0x89, 0x1c, 0xea, 0x85, 0x2c, 0xa0, 0xe8, 0x7b, 0x28, 0x27, 0xb1, 0x71, 0xdc, 0xae,
-	0x28, 0xea, 0x06, 0x8e, 0xcd, 0x02, 0xfa, 0x64, 0xe4, 0x8f, 0xb0, 0x15, 0xef, 0x17, 0x7b, 0x3b,
-	0x12, 0xdd, 0x40, 0xf0, 0xfe, 0xfa, 0xe1, 0x23, 0x3c, 0x7d, 0x35, 0x97, 0x80, 0x52, 0x2b, 0x17,
-	0x7d, 0x5f, 0x4c, 0x23, 0xe0, 0xf5, 0xba, 0xf8, 0x96, 0x96, 0x7e, 0x01, 0xba, 0x20, 0x09, 0xd6,
-	0x8b, 0x5e, 0x0a, 0xd2, 0xc7, 0xa6, 0x5f, 0xff, 0xf8, 0x29, 0xfa, 0x21, 0x9b, 0xe0, 0xb1, 0xb9,
-	0x6c, 0x8f, 0x2d, 0x3b, 0x97, 0x6d, 0x95, 0x35, 0x1b, 0x50, 0xcd, 0x99, 0x06, 0x3a, 0x7a, 0xc2,
-	0x14, 0xb2, 0xfa, 0x1a, 0x4f, 0x0b, 0xd2, 0x9c, 0x3f, 0xc3, 0x66, 0xfa, 0x48, 0xd1, 0x33, 0x41,
-	0x9c, 0x77, 0x90, 0x5c, 0xc7, 0x96, 0xde, 0xf4, 0xd9, 0xeb, 0xdf, 0x9a, 0x43, 0x8f, 0xdd, 0x4d,
-	0x06, 0x2d, 0x27, 0x18, 0x1f, 0xbb, 0xc4, 0xa1, 0xc4, 0x3d, 0x76, 0x1d, 0x3a, 0xf2, 0xdd, 0x63,
-	0xee, 0x32, 0xc7, 0x59, 0xec, 0xa0, 0xc4, 0xff, 0x25, 0x7c, 0xf5, 0x5f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xa4, 0xb5, 0x47, 0x97, 0x6e, 0x08, 0x00, 0x00,
+	proto.RegisterFile("walletrpc/walletkit.proto", fileDescriptor_walletkit_80a3e285752695c0)
+}
+
+var fileDescriptor_walletkit_80a3e285752695c0 = []byte{
+	// 1227 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x5b, 0x6f, 0xdb, 0x46,
+	0x13, 0xfd, 0xe4, 0xbb, 0x46, 0x17, 0x33, 0x2b, 0x3b, 0x56, 0x98, 0xc4, 0x51, 0x36, 0x5f, 0x03,
+	0x23, 0x2d, 0x64, 0xc0, 0x6d, 0xda, 0x20, 0x7d, 0x68, 0x1d, 0x99, 0x86, 0x0d, 0xc9, 0xa2, 0x42,
+	0xd1, 0x71, 0xd3, 0x3e, 0xb0, 0x94, 0xb8, 0xb1, 0x09, 0x4b, 0x24, 0xb3, 0x5c, 0xc5, 0xd4, 0x5b,
+	0xdf, 0xf2, 0x5b, 0x8a, 0xfe, 0xc9, 0x82, 0xcb, 0xa5, 0xb4, 0xd4, 0xa5, 0x41, 0x81, 0x3e, 0x99,
+	0x3a, 0xe7, 0xcc, 0xd9, 0xe1, 0xec, 0x70, 0xc6, 0xf0, 0xe0, 0xce, 0x1e, 0x0c, 0x08, 0xa3, 0x41,
+	0xff, 0x30, 0x79, 0xba, 0x75, 0x59, 0x3d, 0xa0, 0x3e, 0xf3, 0x51, 0x7e, 0x42, 0xa9, 0x79, 0x1a,
+	0xf4, 0x13, 0x54, 0xdd, 0x09, 0xdd, 0x6b, 0x2f, 0x96, 0xc7, 0x7f, 0x09, 0x4d, 0x50, 0xdc, 0x01,
+	0xd4, 0x72, 0x43, 0x76, 0xe9, 0x85, 0x01, 0xf1, 0x98, 0x41, 0x3e, 0x8e, 0x48, 0xc8, 0xd0, 0x23,
+	0xc8, 0x0f, 0x5d, 0xcf, 0xea, 0xfb, 0xde, 0x87, 0xb0, 0x9a, 0xab, 0xe5, 0x0e, 0xd6, 0x8d, 0x29,
+	0xc0, 0x59, 0x3b, 0x12, 0xec, 0x8a, 0x60, 0x53, 0x00, 0xbf, 0x82, 0x4a, 0xc6, 0x31, 0x0c, 0x7c,
+	0x2f, 0x24, 0xe8, 0x29, 0xac, 0x8f, 0x58, 0xe4, 0xc7, 0x76, 0xab, 0x07, 0x85, 0xa3, 0x42, 0x7d,
+	0x10, 0x27, 0x53, 0xbf, 0x64, 0x91, 0x6f, 0x24, 0x0c, 0x7e, 0x0b, 0xa8, 0x45, 0xec, 0x90, 0xe8,
+	0x23, 0x16, 0x8c, 0x26, 0xb9, 0x94, 0x61, 0xc5, 0x75, 0x78, 0x12, 0x45, 0x63, 0xc5, 0x75, 0xd0,
+	0xd7, 0xb0, 0xe5, 0x8f, 0x58, 0xe0, 0xbb, 0x1e, 0xe3, 0x87, 0x17, 0x8e, 0xb6, 0x85, 0x97, 0x3e,
+	0x62, 0x9d, 0x18, 0x36, 0x26, 0x02, 0xfc, 0x12, 0x2a, 0x19, 0x4b, 0x91, 0xcc, 0x3e, 0x00, 0x89,
+	0x02, 0x97, 0xda, 0xcc, 0xf5, 0x3d, 0xee, 0xbd, 0x66, 0x48, 0x08, 0xee, 0xc2, 0x8e, 0x41, 0x06,
+	0xff, 0x71, 0x2e, 0x7b, 0xb0, 0x3b, 0x63, 0x9a, 0x64, 0x83, 0xdf, 0xc2, 0x46, 0x93, 0x8c, 0x0d,
+	0xf2, 0x11, 0x1d, 0x80, 0x72, 0x4b, 0xc6, 0xd6, 0x07, 0xd7, 0xbb, 0x26, 0xd4, 0x0a, 0x68, 0xec,
+	0x9b, 0x94, 0xbf, 0x7c, 0x4b, 0xc6, 0xa7, 0x1c, 0xee, 0xc4, 0x28, 0x7a, 0x0c, 0xc0, 0x95, 0xf6,
+	0xd0, 0x1d, 0x8c, 0xd3, 0x4b, 0x88, 0x35, 0x1c, 0xc0, 0x25, 0x28, 0x1c, 0x3b, 0x0e, 0x15, 0x79,
+	0x63, 0x0c, 0xc5, 0xe4, 0xa7, 0x78, 0x7f, 0x04, 0x6b, 0xb6, 0xe3, 0x50, 0xee, 0x9d, 0x37, 0xf8,
+	0x33, 0xfe, 0x3f, 0x14, 0x4c, 0x6a, 0x7b, 0xa1, 0xdd, 0x8f, 0x4b, 0x80, 0x76, 0x61, 0x83, 0x45,
+	0xd6, 0x0d, 0x89, 0xc4, 0xeb, 0xae, 0xb3, 0xe8, 0x8c, 0x44, 0xf8, 0x7b, 0xd8, 0xee, 0x8c, 0x7a,
+	0x03, 0x37, 0xbc, 0x99, 0x98, 0x3d, 0x83, 0x52, 0x90, 0x40, 0x16, 0xa1, 0xd4, 0x4f, 0x5d, 0x8b,
+	0x02, 0xd4, 0x62, 0x0c, 0xff, 0x0e, 0xa8, 0x4b, 0x3c, 0x27, 0x79, 0xf3, 0x30, 0xad, 0x67, 0x0d,
+	0x8a, 0x36, 0xf3, 0x87, 0xa1, 0x15, 0x10, 0x6a, 0xdd, 0xf6, 0x78, 0xe4, 0xaa, 0x01, 0x1c, 0xeb,
+	0x10, 0xda, 0xec, 0xa1, 0x03, 0xd8, 0xf4, 0x93, 0x98, 0xea, 0x0a, 0x6f, 0x9c, 0x72, 0x5d, 0xf4,
+	0x71, 0xdd, 0x8c, 0xf4, 0x11, 0x33, 0x52, 0x1a, 0x7f, 0x03, 0x95, 0xcc, 0x09, 0x22, 0xbb, 0x5d,
+	0xd8, 0xa0, 0xf6, 0x9d, 0xc5, 0x26, 0xef, 0x41, 0xed, 0x3b, 0x33, 0xc2, 0x2f, 0x01, 0x69, 0x21,
+	0x73, 0x87, 0x36, 0x23, 0xa7, 0x84, 0xa4, 0xf9, 0x3c, 0x81, 0x42, 0xdc, 0xc4, 0x16, 0xb3, 0xe9,
+	0x35, 0x49, 0x4b, 0x0f, 0x31, 0x64, 0x72, 0x04, 0xff, 0x00, 0x95, 0x4c, 0x98, 0x38, 0xe4, 0x8b,
+	0xef, 0x81, 0xff, 0x5c, 0x81, 0x62, 0x87, 0x78, 0x8e, 0xeb, 0x5d, 0x77, 0xef, 0x08, 0x09, 0x32,
+	0xad, 0x93, 0xfb, 0x42, 0xeb, 0xa0, 0xd7, 0x50, 0xbc, 0x73, 0x99, 0x47, 0xc2, 0xd0, 0x62, 0xe3,
+	0x80, 0xf0, 0xfb, 0x2e, 0x1f, 0xdd, 0xaf, 0x4f, 0x3e, 0xf4, 0xfa, 0x55, 0x42, 0x9b, 0xe3, 0x80,
+	0x18, 0x19, 0x2d, 0xc2, 0x50, 0xb4, 0x87, 0xfe, 0xc8, 0x63, 0x16, 0x4f, 0xa7, 0xba, 0x5a, 0xcb,
+	0x1d, 0x94, 0x8c, 0x0c, 0x86, 0x9e, 0x43, 0x79, 0x9a, 0x7f, 0x6f, 0xcc, 0x48, 0x75, 0x8d, 0xab,
+	0x66, 0x50, 0x54, 0x07, 0xd4, 0xa3, 0xbe, 0xed, 0xf4, 0xed, 0x30, 0x0e, 0x65, 0x64, 0x18, 0xb0,
+	0xb0, 0xba, 0xce, 0xb5, 0x0b, 0x18, 0xf4, 0x1d, 0xec, 0x7a, 0x24, 0x62, 0xd6, 0x94, 0xba, 0x21,
+	0xee, 0xf5, 0x0d, 0xab, 0x6e, 0xf0, 0x90, 0xc5, 0x24, 0xbe, 0x0f, 0x3b, 0x72, 0xa9, 0xd2, 0x6e,
+	0xc1, 0xbf, 0xc0, 0xee, 0x0c, 0x2e, 0xca, 0xff, 0x13, 0x94, 0x83, 0x84, 0xb0, 0x42, 0xce, 0x88,
+	0x21, 0xb3, 0x27, 0x15, 0x48, 0x8e, 0x34, 0x66, 0xe4, 0xf8, 0x73, 0x0e, 0xca, 0x6f, 0x46, 0xc3,
+	0x40, 0x6a, 0x85, 0x7f, 0x75, 0x3f, 0x35, 0x28, 0x24, 0x2d, 0xc3, 0x67, 0x20, 0xbf, 0x9e, 0x92,
+	0x21, 0x43, 0x0b, 0x2a, 0xbc, 0xba, 0xa8, 0xc2, 0xf8, 0x1e, 0x6c, 0x4f, 0x12, 0x11, 0xe3, 0xc1,
+	0x86, 0xbd, 0x96, 0xdd, 0x23, 0x03, 0xe9, 0xeb, 0x4c, 0x93, 0x44, 0xb0, 0xc6, 0xa2, 0xc9, 0x44,
+	0xe2, 0xcf, 0x68, 0x07, 0xd6, 0x07, 0xb1, 0x9c, 0x67, 0x91, 0x37, 0x92, 0x1f, 0xf1, 0xcc, 0xf6,
+	0x3f, 0x11, 0x7a, 0x47, 0x5d, 0x71, 0xf4, 0x96, 0x31, 0x05, 0xb0, 0x0a, 0xd5, 0xf9, 0x23, 0x92,
+	0xe3, 0x5f, 0xfc, 0xb5, 0x0a, 0x05, 0xa9, 0xbb, 0x50, 0x05, 0xb6, 0x2f, 0xdb, 0xcd, 0xb6, 0x7e,
+	0xd5, 0xb6, 0xae, 0xce, 0xcd, 0xb6, 0xd6, 0xed, 0x2a, 0xff, 0x43, 0x55, 0xd8, 0x69, 0xe8, 0x17,
+	0x17, 0xe7, 0xe6, 0x85, 0xd6, 0x36, 0x2d, 0xf3, 0xfc, 0x42, 0xb3, 0x5a, 0x7a, 0xa3, 0xa9, 0xe4,
+	0xd0, 0x1e, 0x54, 0x24, 0xa6, 0xad, 0x5b, 0x27, 0x5a, 0xeb, 0xf8, 0xbd, 0xb2, 0x82, 0x76, 0xe1,
+	0x9e, 0x44, 0x18, 0xda, 0x3b, 0xbd, 0xa9, 0x29, 0xab, 0xb1, 0xfe, 0xcc, 0x6c, 0x35, 0x2c, 0xfd,
+	0xf4, 0x54, 0x33, 0xb4, 0x93, 0x94, 0x58, 0x8b, 0x8f, 0xe0, 0xc4, 0x71, 0xa3, 0xa1, 0x75, 0xcc,
+	0x29, 0xb3, 0x8e, 0xbe, 0x82, 0xa7, 0x99, 0x90, 0xf8, 0x78, 0xfd, 0xd2, 0xb4, 0xba, 0x5a, 0x43,
+	0x6f, 0x9f, 0x58, 0x2d, 0xed, 0x9d, 0xd6, 0x52, 0x36, 0xd0, 0x73, 0xc0, 0x59, 0x83, 0xee, 0x65,
+	0xa3, 0xa1, 0x75, 0xbb, 0x59, 0xdd, 0x26, 0x7a, 0x02, 0x0f, 0x67, 0x32, 0xb8, 0xd0, 0x4d, 0x2d,
+	0x75, 0x55, 0xb6, 0x50, 0x0d, 0x1e, 0xcd, 0x66, 0xc2, 0x15, 0xc2, 0x4f, 0xc9, 0xa3, 0x47, 0x50,
+	0xe5, 0x0a, 0xd9, 0x39, 0xcd, 0x17, 0xd0, 0x0e, 0x28, 0xa2, 0x72, 0x56, 0x53, 0x7b, 0x6f, 0x9d,
+	0x1d, 0x77, 0xcf, 0x94, 0x02, 0x7a, 0x08, 0x7b, 0x6d, 0xad, 0x1b, 0xdb, 0xcd, 0x91, 0xc5, 0x99,
+	0x62, 0x1d, 0xb7, 0x1b, 0x67, 0xba, 0xa1, 0x94, 0x90, 0x02, 0x85, 0xce, 0xe5, 0x9b, 0x89, 0xee,
+	0x8f, 0xdc, 0xd1, 0xe7, 0x4d, 0xc8, 0x5f, 0xf1, 0xa6, 0x6f, 0xba, 0x0c, 0xb5, 0xa0, 0x20, 0xed,
+	0x62, 0xf4, 0x58, 0xfa, 0x1e, 0xe6, 0xb7, 0xbe, 0xba, 0xbf, 0x8c, 0x16, 0x9f, 0x59, 0xec, 0x36,
+	0x5d, 0x5f, 0x59, 0xb7, 0xb9, 0x5d, 0xa9, 0xee, 0x2f, 0xa3, 0x85, 0x9b, 0x01, 0xa5, 0xcc, 0x3a,
+	0x44, 0x4f, 0xa4, 0x80, 0x45, 0xdb, 0x57, 0xad, 0x2d, 0x17, 0x08, 0xcf, 0xd7, 0x50, 0x3a, 0x21,
+	0xd4, 0xfd, 0x44, 0xda, 0x24, 0x62, 0x4d, 0x32, 0x46, 0xf7, 0xa4, 0x90, 0x64, 0xc7, 0xaa, 0xf7,
+	0x27, 0x0b, 0xa4, 0x49, 0xc6, 0x27, 0x24, 0xec, 0x53, 0x37, 0x60, 0x3e, 0x45, 0xaf, 0x20, 0x9f,
+	0xc4, 0xc6, 0x71, 0x15, 0x59, 0xd4, 0xf2, 0xfb, 0x36, 0xf3, 0xe9, 0xd2, 0xc8, 0x1f, 0x61, 0x2b,
+	0x3e, 0x2f, 0xde, 0xb0, 0x48, 0x9e, 0xc9, 0xd2, 0x06, 0x56, 0xf7, 0xe6, 0x70, 0x91, 0xf2, 0x19,
+	0x20, 0xb1, 0x50, 0xe5, 0xed, 0x2b, 0xdb, 0x48, 0xb8, 0xaa, 0xca, 0x13, 0x6d, 0x66, 0x0f, 0xb7,
+	0xa0, 0x20, 0x2d, 0xc0, 0xcc, 0xf5, 0xcc, 0xaf, 0x5e, 0x75, 0x7f, 0x19, 0x3d, 0x75, 0x93, 0x36,
+	0x5d, 0xc6, 0x6d, 0x7e, 0x71, 0xaa, 0xfb, 0xcb, 0xe8, 0xe9, 0x65, 0x67, 0x46, 0x77, 0xe6, 0xb2,
+	0x17, 0x0d, 0x7b, 0xb5, 0xb6, 0x5c, 0x20, 0x3c, 0x7f, 0x86, 0x4d, 0x31, 0x2a, 0xd1, 0x03, 0x49,
+	0x9c, 0x9d, 0xe3, 0xaa, 0xba, 0x88, 0x12, 0x0e, 0xbf, 0x81, 0x32, 0x3b, 0xf6, 0x10, 0x96, 0xdb,
+	0x76, 0xf1, 0xd8, 0x55, 0x9f, 0xfd, 0xa3, 0x26, 0x31, 0x7f, 0xf3, 0xe2, 0xd7, 0x83, 0x6b, 0x97,
+	0xdd, 0x8c, 0x7a, 0xf5, 0xbe, 0x3f, 0x3c, 0x74, 0x48, 0x9f, 0x12, 0xe7, 0xd0, 0xe9, 0xd3, 0x81,
+	0xe7, 0x1c, 0xf2, 0x45, 0x72, 0x38, 0x31, 0xe9, 0x6d, 0xf0, 0x7f, 0xc6, 0xbf, 0xfd, 0x3b, 0x00,
+	0x00, 0xff, 0xff, 0x75, 0xf0, 0x20, 0x0f, 0xd5, 0x0b, 0x00, 0x00,
 }
@@ -10,6 +10,8 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sync"
+	"time"
 
 	"github.com/decred/dcrd/chaincfg/chainhash"
 	"github.com/decred/dcrd/txscript/v2"
@@ -55,6 +57,18 @@ var (
 
 	// macPermissions maps RPC calls to the permissions they require.
 	macPermissions = map[string][]bakery.Op{
+		"/walletrpc.WalletKit/ListUnspent": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
+		"/walletrpc.WalletKit/LeaseOutput": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/walletrpc.WalletKit/ReleaseOutput": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
 		"/walletrpc.WalletKit/DeriveNextKey": {{
 			Entity: "address",
 			Action: "read",
@@ -87,19 +101,58 @@ var (
 			Entity: "onchain",
 			Action: "write",
 		}},
+		"/walletrpc.WalletKit/LabelTransaction": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
 	}
 
 	// DefaultWalletKitMacFilename is the default name of the wallet kit
 	// macaroon that we expect to find via a file handle within the main
 	// configuration file in this package.
 	DefaultWalletKitMacFilename = "walletkit.macaroon"
+
+	// DefaultLeaseDuration is the duration an output lease is held for
+	// before it expires and the output becomes available for coin selection
+	// again.
+	DefaultLeaseDuration = 10 * time.Minute
+
+	// ErrOutputLeased is returned when attempting to lease an output that
+	// is currently leased to a different ID.
+	ErrOutputLeased = errors.New("output is already leased")
+
+	// ErrUnknownOutputLease is returned when attempting to release an
+	// output that isn't currently leased to the given ID.
+	ErrUnknownOutputLease = errors.New("unknown output lease")
+
+	// ErrUnknownTransaction is returned when attempting to label a
+	// transaction that isn't known to the wallet.
+	ErrUnknownTransaction = errors.New("transaction not known to the " +
+		"wallet")
 )
 
+// outputLease is a lock on a wallet output held by a single lease ID.
+type outputLease struct {
+	// id is the ID the output is leased to.
+	id [32]byte
+
+	// expiration is the time at which the lease expires.
+	expiration time.Time
+
+	// timer releases the lease once it expires.
+	timer *time.Timer
+}
+
 // WalletKit is a sub-RPC server that exposes a tool kit which allows clients
 // to execute common wallet operations. This includes requesting new addresses,
 // keys (for contracts!), and publishing transactions.
 type WalletKit struct {
 	cfg *Config
+
+	// leases tracks the outputs that are currently leased through the
+	// LeaseOutput RPC.
+	leases   map[wire.OutPoint]*outputLease
+	leaseMtx sync.Mutex
 }
 
 // A compile time check to ensure that WalletKit fully implements the
@@ -145,7 +198,8 @@ func New(cfg *Config) (*WalletKit, lnrpc.MacaroonPerms, error) {
 	}
 
 	walletKit := &WalletKit{
-		cfg: cfg,
+		cfg:    cfg,
+		leases: make(map[wire.OutPoint]*outputLease),
 	}
 
 	return walletKit, macPermissions, nil
@@ -162,6 +216,15 @@ func (w *WalletKit) Start() error {
 //
 // NOTE: This is part of the lnrpc.SubServer interface.
 func (w *WalletKit) Stop() error {
+	w.leaseMtx.Lock()
+	defer w.leaseMtx.Unlock()
+
+	for op, lease := range w.leases {
+		lease.timer.Stop()
+		w.cfg.Wallet.UnlockOutpoint(op)
+		delete(w.leases, op)
+	}
+
 	return nil
 }
 
@@ -189,6 +252,161 @@ func (w *WalletKit) RegisterWithRootServer(grpcServer *grpc.Server) error {
 	return nil
 }
 
+// ListUnspent returns useful information about each unspent output owned by
+// the wallet, as reported by the underlying `ListUnspentWitness`; the
+// information returned is: outpoint, amount in atoms, address, address type,
+// scriptPubKey in hex and number of confirmations. The result is filtered to
+// contain outputs whose number of confirmations is between a minimum and
+// maximum number of confirmations specified by the user, with 0 meaning
+// unconfirmed.
+func (w *WalletKit) ListUnspent(ctx context.Context,
+	req *ListUnspentRequest) (*ListUnspentResponse, error) {
+
+	minConfs := req.MinConfs
+	maxConfs := req.MaxConfs
+
+	switch {
+	case minConfs < 0:
+		return nil, fmt.Errorf("min confirmations must be >= 0")
+
+	case minConfs > maxConfs:
+		return nil, fmt.Errorf("max confirmations must be >= min " +
+			"confirmations")
+	}
+
+	utxos, err := w.cfg.Wallet.ListUnspentWitness(minConfs, maxConfs)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcUtxos, err := lnrpc.MarshalUtxos(utxos, w.cfg.ChainParams)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListUnspentResponse{
+		Utxos: rpcUtxos,
+	}, nil
+}
+
+// LeaseOutput locks an output to the given ID, preventing it from being
+// available for any future coin selection attempts. The absolute time of the
+// lock's expiration is returned. The expiration of the lock can be extended by
+// successive invocations of this call. Outputs can be unlocked before their
+// expiration through `ReleaseOutput`.
+//
+// If the output is not known, lnwallet.ErrNotMine is returned. If the output
+// has already been leased to a different ID, ErrOutputLeased is returned.
+func (w *WalletKit) LeaseOutput(ctx context.Context,
+	req *LeaseOutputRequest) (*LeaseOutputResponse, error) {
+
+	if len(req.Id) != 32 {
+		return nil, errors.New("id must be 32 random bytes")
+	}
+	var id [32]byte
+	copy(id[:], req.Id)
+
+	op, err := unmarshallOutPoint(req.Outpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only outputs under the control of the wallet can be leased.
+	if _, err := w.cfg.Wallet.FetchInputInfo(op); err != nil {
+		return nil, err
+	}
+
+	w.leaseMtx.Lock()
+	defer w.leaseMtx.Unlock()
+
+	expiration := time.Now().Add(DefaultLeaseDuration)
+
+	// If the output is already leased to the same ID, we'll simply extend
+	// the lease. Otherwise, the output is held by a different
+	// application.
+	if lease, ok := w.leases[*op]; ok {
+		if lease.id != id {
+			return nil, ErrOutputLeased
+		}
+
+		lease.timer.Reset(DefaultLeaseDuration)
+		lease.expiration = expiration
+
+		return &LeaseOutputResponse{
+			Expiration: uint64(expiration.Unix()),
+		}, nil
+	}
+
+	outpoint := *op
+	lease := &outputLease{
+		id:         id,
+		expiration: expiration,
+	}
+	lease.timer = time.AfterFunc(DefaultLeaseDuration, func() {
+		w.expireLease(outpoint, lease)
+	})
+
+	w.cfg.Wallet.LockOutpoint(outpoint)
+	w.leases[outpoint] = lease
+
+	log.Debugf("Leased output %v until %v", outpoint, expiration)
+
+	return &LeaseOutputResponse{
+		Expiration: uint64(expiration.Unix()),
+	}, nil
+}
+
+// ReleaseOutput unlocks an output, allowing it to be available for coin
+// selection if it remains unspent. The ID should match the one used to
+// originally lock the output.
+func (w *WalletKit) ReleaseOutput(ctx context.Context,
+	req *ReleaseOutputRequest) (*ReleaseOutputResponse, error) {
+
+	if len(req.Id) != 32 {
+		return nil, errors.New("id must be 32 random bytes")
+	}
+	var id [32]byte
+	copy(id[:], req.Id)
+
+	op, err := unmarshallOutPoint(req.Outpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	w.leaseMtx.Lock()
+	defer w.leaseMtx.Unlock()
+
+	lease, ok := w.leases[*op]
+	if !ok || lease.id != id {
+		return nil, ErrUnknownOutputLease
+	}
+
+	lease.timer.Stop()
+	w.cfg.Wallet.UnlockOutpoint(*op)
+	delete(w.leases, *op)
+
+	log.Debugf("Released output %v", op)
+
+	return &ReleaseOutputResponse{}, nil
+}
+
+// expireLease releases the passed lease on an output once it expires, unless
+// it has since been released or renewed.
+func (w *WalletKit) expireLease(op wire.OutPoint, lease *outputLease) {
+	w.leaseMtx.Lock()
+	defer w.leaseMtx.Unlock()
+
+	current, ok := w.leases[op]
+	if !ok || current != lease || time.Now().Before(lease.expiration) {
+		return
+	}
+
+	w.cfg.Wallet.UnlockOutpoint(op)
+	delete(w.leases, op)
+
+	log.Debugf("Lease of output %v expired", op)
+}
+
 // DeriveNextKey attempts to derive the *next* key within the key family
 // (account in BIP43) specified. This method should return the next external
 // child within this branch.
@@ -389,6 +607,8 @@ func (w *WalletKit) PendingSweeps(ctx context.Context,
 			witnessType = WitnessType_WITNESS_KEY_HASH
 		case input.NestedWitnessKeyHash:
 			witnessType = WitnessType_NESTED_WITNESS_KEY_HASH
+		case input.CommitmentAnchor:
+			witnessType = WitnessType_COMMITMENT_ANCHOR
 		default:
 			log.Warnf("Unhandled witness type %v for input %v",
 				pendingInput.WitnessType, pendingInput.OutPoint)
@@ -539,3 +759,48 @@ func (w *WalletKit) BumpFee(ctx context.Context,
 
 	return &BumpFeeResponse{}, nil
 }
+
+// LabelTransaction adds a label to a transaction of the wallet, replacing its
+// existing label only if requested.
+func (w *WalletKit) LabelTransaction(ctx context.Context,
+	req *LabelTransactionRequest) (*LabelTransactionResponse, error) {
+
+	txid, err := chainhash.NewHash(req.Txid)
+	if err != nil {
+		return nil, err
+	}
+
+	// An empty label removes the existing label, which only makes sense
+	// when overwriting it.
+	if req.Label == "" && !req.Overwrite {
+		return nil, errors.New("cannot label transaction with an " +
+			"empty label")
+	}
+
+	// Only the transactions of the wallet can be labeled.
+	txs, err := w.cfg.Wallet.ListTransactionDetails()
+	if err != nil {
+		return nil, err
+	}
+	known := false
+	for _, tx := range txs {
+		if tx.Hash == *txid {
+			known = true
+			break
+		}
+	}
+	if !known {
+		return nil, ErrUnknownTransaction
+	}
+
+	if req.Overwrite {
+		err = w.cfg.ChanDB.PutTxLabel(*txid, req.Label)
+	} else {
+		err = w.cfg.ChanDB.AddTxLabel(*txid, req.Label)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &LabelTransactionResponse{}, nil
+}
@@ -6,13 +6,18 @@ import (
 	"fmt"
 	"net"
 	"strconv"
+	"strings"
 
+	"github.com/decred/dcrd/chaincfg/chainhash"
 	"github.com/decred/dcrd/dcrec/secp256k1/v2"
+	"github.com/decred/dcrd/wire"
 	"github.com/decred/dcrlnd/lncfg"
 	"github.com/decred/dcrlnd/lnrpc"
+	"github.com/decred/dcrlnd/lnwallet"
 	"github.com/decred/dcrlnd/lnwire"
 	"github.com/decred/dcrlnd/watchtower"
 	"github.com/decred/dcrlnd/watchtower/wtclient"
+	"github.com/decred/dcrlnd/watchtower/wtdb"
 	"google.golang.org/grpc"
 	"gopkg.in/macaroon-bakery.v2/bakery"
 )
@@ -54,6 +59,14 @@ var (
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/wtclientrpc.WatchtowerClient/SetChannelPolicy": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/wtclientrpc.WatchtowerClient/ListChannelPolicies": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 	}
 
 	// ErrWtclientNotActive signals that RPC calls cannot be processed
@@ -254,7 +267,7 @@ func (c *WatchtowerClient) Stats(ctx context.Context,
 	return &StatsResponse{
 		NumBackups:           uint32(stats.NumTasksAccepted),
 		NumFailedBackups:     uint32(stats.NumTasksIneligible),
-		NumPendingBackups:    uint32(stats.NumTasksReceived),
+		NumPendingBackups:    uint32(stats.NumTasksPending),
 		NumSessionsAcquired:  uint32(stats.NumSessionsAcquired),
 		NumSessionsExhausted: uint32(stats.NumSessionsExhausted),
 	}, nil
@@ -275,6 +288,83 @@ func (c *WatchtowerClient) Policy(ctx context.Context,
 	}, nil
 }
 
+// SetChannelPolicy overrides the backup behavior of a single channel, allowing
+// it to opt out of backups entirely or to only be backed up while the client's
+// sweep fee rate doesn't exceed a given limit.
+func (c *WatchtowerClient) SetChannelPolicy(ctx context.Context,
+	req *SetChannelPolicyRequest) (*SetChannelPolicyResponse, error) {
+
+	if err := c.isActive(); err != nil {
+		return nil, err
+	}
+
+	chanPoint, err := parseChanPoint(req.ChanPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	chanID := lnwire.NewChanIDFromOutPoint(chanPoint)
+	policy := wtdb.ClientChanPolicy{
+		BackupsDisabled: req.BackupsDisabled,
+		MaxSweepFeeRate: lnwallet.AtomPerKByte(
+			req.MaxSweepAtomsPerByte * 1000,
+		),
+	}
+	if err := c.cfg.Client.SetChannelPolicy(chanID, policy); err != nil {
+		return nil, err
+	}
+
+	return &SetChannelPolicyResponse{}, nil
+}
+
+// ListChannelPolicies returns the channels whose backup policy differs from the
+// client's default.
+func (c *WatchtowerClient) ListChannelPolicies(ctx context.Context,
+	req *ListChannelPoliciesRequest) (*ListChannelPoliciesResponse, error) {
+
+	if err := c.isActive(); err != nil {
+		return nil, err
+	}
+
+	policies := c.cfg.Client.ChannelPolicies()
+	rpcPolicies := make([]*ChannelPolicy, 0, len(policies))
+	for chanID, policy := range policies {
+		chanID := chanID
+		rpcPolicies = append(rpcPolicies, &ChannelPolicy{
+			ChanId:          chanID[:],
+			BackupsDisabled: policy.BackupsDisabled,
+			MaxSweepAtomsPerByte: uint32(
+				policy.MaxSweepFeeRate / 1000,
+			),
+		})
+	}
+
+	return &ListChannelPoliciesResponse{Policies: rpcPolicies}, nil
+}
+
+// parseChanPoint parses a channel point of the form funding_txid:output_index.
+func parseChanPoint(s string) (*wire.OutPoint, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 2 {
+		return nil, errors.New("channel point should be of the form " +
+			"funding_txid:output_index")
+	}
+
+	txid, err := chainhash.NewHashFromStr(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid funding txid %v: %v", parts[0],
+			err)
+	}
+
+	index, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid output index %v: %v", parts[1],
+			err)
+	}
+
+	return wire.NewOutPoint(txid, uint32(index), wire.TxTreeRegular), nil
+}
+
 // marshallTower converts a client registered watchtower into its corresponding
 // RPC type.
 func marshallTower(tower *wtclient.RegisteredTower, includeSessions bool) *Tower {
@@ -37,7 +37,7 @@ func (m *AddTowerRequest) Reset()         { *m = AddTowerRequest{} }
 func (m *AddTowerRequest) String() string { return proto.CompactTextString(m) }
 func (*AddTowerRequest) ProtoMessage()    {}
 func (*AddTowerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wtclient_0609778136ad65b2, []int{0}
+	return fileDescriptor_wtclient_e21dc06e5a04992e, []int{0}
 }
 func (m *AddTowerRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddTowerRequest.Unmarshal(m, b)
@@ -81,7 +81,7 @@ func (m *AddTowerResponse) Reset()         { *m = AddTowerResponse{} }
 func (m *AddTowerResponse) String() string { return proto.CompactTextString(m) }
 func (*AddTowerResponse) ProtoMessage()    {}
 func (*AddTowerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wtclient_0609778136ad65b2, []int{1}
+	return fileDescriptor_wtclient_e21dc06e5a04992e, []int{1}
 }
 func (m *AddTowerResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddTowerResponse.Unmarshal(m, b)
@@ -118,7 +118,7 @@ func (m *RemoveTowerRequest) Reset()         { *m = RemoveTowerRequest{} }
 func (m *RemoveTowerRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveTowerRequest) ProtoMessage()    {}
 func (*RemoveTowerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wtclient_0609778136ad65b2, []int{2}
+	return fileDescriptor_wtclient_e21dc06e5a04992e, []int{2}
 }
 func (m *RemoveTowerRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemoveTowerRequest.Unmarshal(m, b)
@@ -162,7 +162,7 @@ func (m *RemoveTowerResponse) Reset()         { *m = RemoveTowerResponse{} }
 func (m *RemoveTowerResponse) String() string { return proto.CompactTextString(m) }
 func (*RemoveTowerResponse) ProtoMessage()    {}
 func (*RemoveTowerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wtclient_0609778136ad65b2, []int{3}
+	return fileDescriptor_wtclient_e21dc06e5a04992e, []int{3}
 }
 func (m *RemoveTowerResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemoveTowerResponse.Unmarshal(m, b)
@@ -196,7 +196,7 @@ func (m *GetTowerInfoRequest) Reset()         { *m = GetTowerInfoRequest{} }
 func (m *GetTowerInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTowerInfoRequest) ProtoMessage()    {}
 func (*GetTowerInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wtclient_0609778136ad65b2, []int{4}
+	return fileDescriptor_wtclient_e21dc06e5a04992e, []int{4}
 }
 func (m *GetTowerInfoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTowerInfoRequest.Unmarshal(m, b)
@@ -254,7 +254,7 @@ func (m *TowerSession) Reset()         { *m = TowerSession{} }
 func (m *TowerSession) String() string { return proto.CompactTextString(m) }
 func (*TowerSession) ProtoMessage()    {}
 func (*TowerSession) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wtclient_0609778136ad65b2, []int{5}
+	return fileDescriptor_wtclient_e21dc06e5a04992e, []int{5}
 }
 func (m *TowerSession) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TowerSession.Unmarshal(m, b)
@@ -322,7 +322,7 @@ func (m *Tower) Reset()         { *m = Tower{} }
 func (m *Tower) String() string { return proto.CompactTextString(m) }
 func (*Tower) ProtoMessage()    {}
 func (*Tower) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wtclient_0609778136ad65b2, []int{6}
+	return fileDescriptor_wtclient_e21dc06e5a04992e, []int{6}
 }
 func (m *Tower) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Tower.Unmarshal(m, b)
@@ -389,7 +389,7 @@ func (m *ListTowersRequest) Reset()         { *m = ListTowersRequest{} }
 func (m *ListTowersRequest) String() string { return proto.CompactTextString(m) }
 func (*ListTowersRequest) ProtoMessage()    {}
 func (*ListTowersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wtclient_0609778136ad65b2, []int{7}
+	return fileDescriptor_wtclient_e21dc06e5a04992e, []int{7}
 }
 func (m *ListTowersRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListTowersRequest.Unmarshal(m, b)
@@ -428,7 +428,7 @@ func (m *ListTowersResponse) Reset()         { *m = ListTowersResponse{} }
 func (m *ListTowersResponse) String() string { return proto.CompactTextString(m) }
 func (*ListTowersResponse) ProtoMessage()    {}
 func (*ListTowersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wtclient_0609778136ad65b2, []int{8}
+	return fileDescriptor_wtclient_e21dc06e5a04992e, []int{8}
 }
 func (m *ListTowersResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListTowersResponse.Unmarshal(m, b)
@@ -465,7 +465,7 @@ func (m *StatsRequest) Reset()         { *m = StatsRequest{} }
 func (m *StatsRequest) String() string { return proto.CompactTextString(m) }
 func (*StatsRequest) ProtoMessage()    {}
 func (*StatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wtclient_0609778136ad65b2, []int{9}
+	return fileDescriptor_wtclient_e21dc06e5a04992e, []int{9}
 }
 func (m *StatsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StatsRequest.Unmarshal(m, b)
@@ -511,7 +511,7 @@ func (m *StatsResponse) Reset()         { *m = StatsResponse{} }
 func (m *StatsResponse) String() string { return proto.CompactTextString(m) }
 func (*StatsResponse) ProtoMessage()    {}
 func (*StatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wtclient_0609778136ad65b2, []int{10}
+	return fileDescriptor_wtclient_e21dc06e5a04992e, []int{10}
 }
 func (m *StatsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StatsResponse.Unmarshal(m, b)
@@ -576,7 +576,7 @@ func (m *PolicyRequest) Reset()         { *m = PolicyRequest{} }
 func (m *PolicyRequest) String() string { return proto.CompactTextString(m) }
 func (*PolicyRequest) ProtoMessage()    {}
 func (*PolicyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wtclient_0609778136ad65b2, []int{11}
+	return fileDescriptor_wtclient_e21dc06e5a04992e, []int{11}
 }
 func (m *PolicyRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PolicyRequest.Unmarshal(m, b)
@@ -614,7 +614,7 @@ func (m *PolicyResponse) Reset()         { *m = PolicyResponse{} }
 func (m *PolicyResponse) String() string { return proto.CompactTextString(m) }
 func (*PolicyResponse) ProtoMessage()    {}
 func (*PolicyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wtclient_0609778136ad65b2, []int{12}
+	return fileDescriptor_wtclient_e21dc06e5a04992e, []int{12}
 }
 func (m *PolicyResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PolicyResponse.Unmarshal(m, b)
@@ -648,6 +648,226 @@ func (m *PolicyResponse) GetSweepAtomsPerByte() uint32 {
 	return 0
 }
 
+type SetChannelPolicyRequest struct {
+	//
+	// The channel point of the channel whose backup policy should be
+	// overridden, in the form funding_txid:output_index.
+	ChanPoint string `protobuf:"bytes,1,opt,name=chan_point,proto3" json:"chan_point,omitempty"`
+	// Whether revoked states of the channel should not be backed up at all.
+	BackupsDisabled bool `protobuf:"varint,2,opt,name=backups_disabled,proto3" json:"backups_disabled,omitempty"`
+	//
+	// The highest fee rate, in atoms per byte, the channel's justice transactions
+	// may pay. Revoked states are not backed up while the client's sweep fee rate
+	// is higher. A value of zero means no limit.
+	MaxSweepAtomsPerByte uint32   `protobuf:"varint,3,opt,name=max_sweep_atoms_per_byte,proto3" json:"max_sweep_atoms_per_byte,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetChannelPolicyRequest) Reset()         { *m = SetChannelPolicyRequest{} }
+func (m *SetChannelPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*SetChannelPolicyRequest) ProtoMessage()    {}
+func (*SetChannelPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_wtclient_e21dc06e5a04992e, []int{13}
+}
+func (m *SetChannelPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetChannelPolicyRequest.Unmarshal(m, b)
+}
+func (m *SetChannelPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetChannelPolicyRequest.Marshal(b, m, deterministic)
+}
+func (dst *SetChannelPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetChannelPolicyRequest.Merge(dst, src)
+}
+func (m *SetChannelPolicyRequest) XXX_Size() int {
+	return xxx_messageInfo_SetChannelPolicyRequest.Size(m)
+}
+func (m *SetChannelPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetChannelPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetChannelPolicyRequest proto.InternalMessageInfo
+
+func (m *SetChannelPolicyRequest) GetChanPoint() string {
+	if m != nil {
+		return m.ChanPoint
+	}
+	return ""
+}
+
+func (m *SetChannelPolicyRequest) GetBackupsDisabled() bool {
+	if m != nil {
+		return m.BackupsDisabled
+	}
+	return false
+}
+
+func (m *SetChannelPolicyRequest) GetMaxSweepAtomsPerByte() uint32 {
+	if m != nil {
+		return m.MaxSweepAtomsPerByte
+	}
+	return 0
+}
+
+type SetChannelPolicyResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetChannelPolicyResponse) Reset()         { *m = SetChannelPolicyResponse{} }
+func (m *SetChannelPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*SetChannelPolicyResponse) ProtoMessage()    {}
+func (*SetChannelPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_wtclient_e21dc06e5a04992e, []int{14}
+}
+func (m *SetChannelPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetChannelPolicyResponse.Unmarshal(m, b)
+}
+func (m *SetChannelPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetChannelPolicyResponse.Marshal(b, m, deterministic)
+}
+func (dst *SetChannelPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetChannelPolicyResponse.Merge(dst, src)
+}
+func (m *SetChannelPolicyResponse) XXX_Size() int {
+	return xxx_messageInfo_SetChannelPolicyResponse.Size(m)
+}
+func (m *SetChannelPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetChannelPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetChannelPolicyResponse proto.InternalMessageInfo
+
+type ChannelPolicy struct {
+	// The id of the channel whose backup policy is overridden.
+	ChanId []byte `protobuf:"bytes,1,opt,name=chan_id,proto3" json:"chan_id,omitempty"`
+	// Whether revoked states of the channel are not backed up at all.
+	BackupsDisabled bool `protobuf:"varint,2,opt,name=backups_disabled,proto3" json:"backups_disabled,omitempty"`
+	//
+	// The highest fee rate, in atoms per byte, the channel's justice transactions
+	// may pay. A value of zero means no limit.
+	MaxSweepAtomsPerByte uint32   `protobuf:"varint,3,opt,name=max_sweep_atoms_per_byte,proto3" json:"max_sweep_atoms_per_byte,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelPolicy) Reset()         { *m = ChannelPolicy{} }
+func (m *ChannelPolicy) String() string { return proto.CompactTextString(m) }
+func (*ChannelPolicy) ProtoMessage()    {}
+func (*ChannelPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_wtclient_e21dc06e5a04992e, []int{15}
+}
+func (m *ChannelPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelPolicy.Unmarshal(m, b)
+}
+func (m *ChannelPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelPolicy.Marshal(b, m, deterministic)
+}
+func (dst *ChannelPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelPolicy.Merge(dst, src)
+}
+func (m *ChannelPolicy) XXX_Size() int {
+	return xxx_messageInfo_ChannelPolicy.Size(m)
+}
+func (m *ChannelPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelPolicy proto.InternalMessageInfo
+
+func (m *ChannelPolicy) GetChanId() []byte {
+	if m != nil {
+		return m.ChanId
+	}
+	return nil
+}
+
+func (m *ChannelPolicy) GetBackupsDisabled() bool {
+	if m != nil {
+		return m.BackupsDisabled
+	}
+	return false
+}
+
+func (m *ChannelPolicy) GetMaxSweepAtomsPerByte() uint32 {
+	if m != nil {
+		return m.MaxSweepAtomsPerByte
+	}
+	return 0
+}
+
+type ListChannelPoliciesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListChannelPoliciesRequest) Reset()         { *m = ListChannelPoliciesRequest{} }
+func (m *ListChannelPoliciesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListChannelPoliciesRequest) ProtoMessage()    {}
+func (*ListChannelPoliciesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_wtclient_e21dc06e5a04992e, []int{16}
+}
+func (m *ListChannelPoliciesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListChannelPoliciesRequest.Unmarshal(m, b)
+}
+func (m *ListChannelPoliciesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListChannelPoliciesRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListChannelPoliciesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListChannelPoliciesRequest.Merge(dst, src)
+}
+func (m *ListChannelPoliciesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListChannelPoliciesRequest.Size(m)
+}
+func (m *ListChannelPoliciesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListChannelPoliciesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListChannelPoliciesRequest proto.InternalMessageInfo
+
+type ListChannelPoliciesResponse struct {
+	// The channels whose backup policy differs from the client's default.
+	Policies             []*ChannelPolicy `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListChannelPoliciesResponse) Reset()         { *m = ListChannelPoliciesResponse{} }
+func (m *ListChannelPoliciesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListChannelPoliciesResponse) ProtoMessage()    {}
+func (*ListChannelPoliciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_wtclient_e21dc06e5a04992e, []int{17}
+}
+func (m *ListChannelPoliciesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListChannelPoliciesResponse.Unmarshal(m, b)
+}
+func (m *ListChannelPoliciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListChannelPoliciesResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListChannelPoliciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListChannelPoliciesResponse.Merge(dst, src)
+}
+func (m *ListChannelPoliciesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListChannelPoliciesResponse.Size(m)
+}
+func (m *ListChannelPoliciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListChannelPoliciesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListChannelPoliciesResponse proto.InternalMessageInfo
+
+func (m *ListChannelPoliciesResponse) GetPolicies() []*ChannelPolicy {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*AddTowerRequest)(nil), "wtclientrpc.AddTowerRequest")
 	proto.RegisterType((*AddTowerResponse)(nil), "wtclientrpc.AddTowerResponse")
@@ -662,6 +882,11 @@ func init() {
 	proto.RegisterType((*StatsResponse)(nil), "wtclientrpc.StatsResponse")
 	proto.RegisterType((*PolicyRequest)(nil), "wtclientrpc.PolicyRequest")
 	proto.RegisterType((*PolicyResponse)(nil), "wtclientrpc.PolicyResponse")
+	proto.RegisterType((*SetChannelPolicyRequest)(nil), "wtclientrpc.SetChannelPolicyRequest")
+	proto.RegisterType((*SetChannelPolicyResponse)(nil), "wtclientrpc.SetChannelPolicyResponse")
+	proto.RegisterType((*ChannelPolicy)(nil), "wtclientrpc.ChannelPolicy")
+	proto.RegisterType((*ListChannelPoliciesRequest)(nil), "wtclientrpc.ListChannelPoliciesRequest")
+	proto.RegisterType((*ListChannelPoliciesResponse)(nil), "wtclientrpc.ListChannelPoliciesResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -696,6 +921,17 @@ type WatchtowerClientClient interface {
 	Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error)
 	// Policy returns the active watchtower client policy configuration.
 	Policy(ctx context.Context, in *PolicyRequest, opts ...grpc.CallOption) (*PolicyResponse, error)
+	//
+	// SetChannelPolicy overrides the backup behavior of a single channel,
+	// allowing it to opt out of backups entirely or to only be backed up while the
+	// client's sweep fee rate doesn't exceed a given limit. Setting a policy
+	// without any overrides restores the client's default behavior for the
+	// channel.
+	SetChannelPolicy(ctx context.Context, in *SetChannelPolicyRequest, opts ...grpc.CallOption) (*SetChannelPolicyResponse, error)
+	//
+	// ListChannelPolicies returns the channels whose backup policy differs from
+	// the client's default.
+	ListChannelPolicies(ctx context.Context, in *ListChannelPoliciesRequest, opts ...grpc.CallOption) (*ListChannelPoliciesResponse, error)
 }
 
 type watchtowerClientClient struct {
@@ -760,6 +996,24 @@ func (c *watchtowerClientClient) Policy(ctx context.Context, in *PolicyRequest,
 	return out, nil
 }
 
+func (c *watchtowerClientClient) SetChannelPolicy(ctx context.Context, in *SetChannelPolicyRequest, opts ...grpc.CallOption) (*SetChannelPolicyResponse, error) {
+	out := new(SetChannelPolicyResponse)
+	err := c.cc.Invoke(ctx, "/wtclientrpc.WatchtowerClient/SetChannelPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchtowerClientClient) ListChannelPolicies(ctx context.Context, in *ListChannelPoliciesRequest, opts ...grpc.CallOption) (*ListChannelPoliciesResponse, error) {
+	out := new(ListChannelPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/wtclientrpc.WatchtowerClient/ListChannelPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WatchtowerClientServer is the server API for WatchtowerClient service.
 type WatchtowerClientServer interface {
 	//
@@ -782,6 +1036,17 @@ type WatchtowerClientServer interface {
 	Stats(context.Context, *StatsRequest) (*StatsResponse, error)
 	// Policy returns the active watchtower client policy configuration.
 	Policy(context.Context, *PolicyRequest) (*PolicyResponse, error)
+	//
+	// SetChannelPolicy overrides the backup behavior of a single channel,
+	// allowing it to opt out of backups entirely or to only be backed up while the
+	// client's sweep fee rate doesn't exceed a given limit. Setting a policy
+	// without any overrides restores the client's default behavior for the
+	// channel.
+	SetChannelPolicy(context.Context, *SetChannelPolicyRequest) (*SetChannelPolicyResponse, error)
+	//
+	// ListChannelPolicies returns the channels whose backup policy differs from
+	// the client's default.
+	ListChannelPolicies(context.Context, *ListChannelPoliciesRequest) (*ListChannelPoliciesResponse, error)
 }
 
 func RegisterWatchtowerClientServer(s *grpc.Server, srv WatchtowerClientServer) {
@@ -896,6 +1161,42 @@ func _WatchtowerClient_Policy_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WatchtowerClient_SetChannelPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetChannelPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchtowerClientServer).SetChannelPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wtclientrpc.WatchtowerClient/SetChannelPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchtowerClientServer).SetChannelPolicy(ctx, req.(*SetChannelPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchtowerClient_ListChannelPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChannelPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchtowerClientServer).ListChannelPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wtclientrpc.WatchtowerClient/ListChannelPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchtowerClientServer).ListChannelPolicies(ctx, req.(*ListChannelPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _WatchtowerClient_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "wtclientrpc.WatchtowerClient",
 	HandlerType: (*WatchtowerClientServer)(nil),
@@ -924,55 +1225,72 @@ var _WatchtowerClient_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Policy",
 			Handler:    _WatchtowerClient_Policy_Handler,
 		},
+		{
+			MethodName: "SetChannelPolicy",
+			Handler:    _WatchtowerClient_SetChannelPolicy_Handler,
+		},
+		{
+			MethodName: "ListChannelPolicies",
+			Handler:    _WatchtowerClient_ListChannelPolicies_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "wtclientrpc/wtclient.proto",
 }
 
 func init() {
-	proto.RegisterFile("wtclientrpc/wtclient.proto", fileDescriptor_wtclient_0609778136ad65b2)
-}
-
-var fileDescriptor_wtclient_0609778136ad65b2 = []byte{
-	// 630 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x95, 0x13, 0x12, 0xd2, 0x49, 0xfa, 0xc1, 0x94, 0x56, 0xc6, 0x14, 0x1a, 0xf9, 0x14, 0x55,
-	0x28, 0x41, 0xe5, 0xe3, 0xc0, 0x01, 0x28, 0x45, 0x54, 0x48, 0x20, 0x55, 0x2e, 0x12, 0x82, 0x8b,
-	0xe5, 0xec, 0x4e, 0x5b, 0xab, 0x8e, 0xed, 0x7a, 0xd7, 0x6d, 0x73, 0xe4, 0x5f, 0xf1, 0x07, 0xf8,
-	0x07, 0xfc, 0x20, 0xe4, 0xf5, 0x47, 0xec, 0xc6, 0x16, 0x07, 0xc4, 0x2d, 0xfb, 0xde, 0xcb, 0xdb,
-	0xc9, 0xcc, 0xcb, 0x2c, 0x18, 0xd7, 0x92, 0x79, 0x2e, 0xf9, 0x32, 0x0a, 0xd9, 0x24, 0xff, 0x3c,
-	0x0e, 0xa3, 0x40, 0x06, 0xd8, 0x2f, 0x71, 0xe6, 0x21, 0xac, 0x1f, 0x70, 0xfe, 0x25, 0xb8, 0xa6,
-	0xc8, 0xa2, 0xcb, 0x98, 0x84, 0xc4, 0x6d, 0xe8, 0x86, 0xf1, 0xf4, 0x82, 0xe6, 0xba, 0x36, 0xd4,
-	0x46, 0x03, 0x2b, 0x3b, 0xa1, 0x0e, 0x77, 0x1d, 0xce, 0x23, 0x12, 0x42, 0x6f, 0x0d, 0xb5, 0xd1,
-	0x8a, 0x95, 0x1f, 0x4d, 0x84, 0x8d, 0x85, 0x89, 0x08, 0x03, 0x5f, 0x90, 0xf9, 0x01, 0xd0, 0xa2,
-	0x59, 0x70, 0x45, 0xff, 0xe8, 0xbd, 0x05, 0x9b, 0x15, 0x9f, 0xcc, 0xfe, 0x1b, 0x6c, 0x1e, 0x91,
-	0x54, 0xd8, 0x47, 0xff, 0x34, 0xf8, 0x9b, 0xff, 0x1e, 0x6c, 0xb8, 0x3e, 0xf3, 0x62, 0x4e, 0xb6,
-	0x20, 0x21, 0xdc, 0xc0, 0x4f, 0x2f, 0xea, 0x59, 0x4b, 0xb8, 0xf9, 0x53, 0x83, 0x81, 0x32, 0x3e,
-	0x49, 0x11, 0x1c, 0x42, 0xdf, 0x8f, 0x67, 0xf6, 0xd4, 0x61, 0x17, 0x71, 0x28, 0x94, 0xf3, 0xaa,
-	0x55, 0x86, 0xf0, 0x29, 0x6c, 0x26, 0xc7, 0x90, 0x7c, 0xee, 0xfa, 0x67, 0x85, 0xb2, 0xa5, 0x94,
-	0x75, 0x54, 0xe2, 0x39, 0x73, 0x6e, 0x0a, 0x65, 0x3b, 0xf5, 0x2c, 0x41, 0xb8, 0x0f, 0xf7, 0xc5,
-	0x35, 0x51, 0x68, 0x3b, 0x32, 0x98, 0x09, 0x3b, 0xa4, 0xc8, 0x9e, 0xce, 0x25, 0xe9, 0x77, 0x94,
-	0xb4, 0x96, 0x33, 0x7f, 0x6b, 0xd0, 0x51, 0xa5, 0x37, 0x36, 0x62, 0x07, 0x56, 0xb2, 0xce, 0x52,
-	0x52, 0x5f, 0x7b, 0xb4, 0x62, 0x2d, 0x00, 0x7c, 0x05, 0xba, 0xc3, 0xa4, 0x7b, 0x55, 0x74, 0xc3,
-	0x66, 0x8e, 0xcf, 0x5d, 0xee, 0x48, 0x52, 0x25, 0xf6, 0xac, 0x46, 0x1e, 0x4d, 0x18, 0x24, 0x3f,
-	0xb4, 0x68, 0x6f, 0x5a, 0x67, 0x05, 0xc3, 0x17, 0xd0, 0x2b, 0xf8, 0xce, 0xb0, 0x3d, 0xea, 0xef,
-	0x3f, 0x18, 0x97, 0xd2, 0x38, 0x2e, 0xb7, 0xdd, 0x2a, 0xa4, 0xe6, 0x1b, 0xb8, 0xf7, 0xc9, 0x15,
-	0xe9, 0xb4, 0x45, 0x3e, 0xea, 0xba, 0x91, 0x6a, 0x0d, 0x23, 0x7d, 0x0b, 0x58, 0x36, 0x48, 0x33,
-	0x84, 0x7b, 0xd0, 0x95, 0x0a, 0xd1, 0x35, 0x55, 0x0b, 0x2e, 0xd7, 0x62, 0x65, 0x0a, 0x73, 0x0d,
-	0x06, 0x27, 0xd2, 0x91, 0xf9, 0xed, 0xe6, 0x8f, 0x16, 0xac, 0x66, 0x40, 0xe6, 0xf6, 0x3f, 0x52,
-	0x32, 0x06, 0x4c, 0xe0, 0x53, 0xc7, 0xf5, 0x88, 0xdf, 0x0a, 0x4b, 0x0d, 0x83, 0xcf, 0x61, 0xab,
-	0xdc, 0x6f, 0xdb, 0x61, 0x97, 0xb1, 0x1b, 0x11, 0xcf, 0x86, 0x51, 0x4f, 0xe2, 0x4b, 0xd8, 0xae,
-	0x10, 0x74, 0x73, 0xee, 0xc4, 0x42, 0x12, 0xd7, 0x3b, 0xea, 0x6b, 0x0d, 0xac, 0xb9, 0x0e, 0xab,
-	0xc7, 0x81, 0xe7, 0xb2, 0x79, 0xde, 0x94, 0x53, 0x58, 0xcb, 0x81, 0x45, 0x53, 0x92, 0x4c, 0xc7,
-	0x61, 0x12, 0x91, 0xa2, 0x29, 0x25, 0xa8, 0x31, 0xe6, 0xad, 0xe6, 0x98, 0xef, 0xff, 0x6a, 0xc3,
-	0xc6, 0x57, 0x47, 0xb2, 0x73, 0x35, 0x9c, 0x43, 0x35, 0x32, 0x3c, 0x82, 0x5e, 0xbe, 0x84, 0x70,
-	0xa7, 0x32, 0xc9, 0x5b, 0x0b, 0xce, 0x78, 0xd4, 0xc0, 0x66, 0x35, 0x1f, 0x43, 0xbf, 0xb4, 0x71,
-	0x70, 0xb7, 0xa2, 0x5e, 0xde, 0x69, 0xc6, 0xb0, 0x59, 0x90, 0x39, 0x7e, 0x06, 0x58, 0xc4, 0x0f,
-	0x1f, 0x57, 0xf4, 0x4b, 0xc1, 0x36, 0x76, 0x1b, 0xf9, 0xcc, 0xee, 0x3d, 0x0c, 0xca, 0xbb, 0x0f,
-	0xab, 0x05, 0xd4, 0xac, 0x45, 0xa3, 0x26, 0xd9, 0xf8, 0x1a, 0x3a, 0x2a, 0xc0, 0x58, 0xfd, 0x0b,
-	0x96, 0x53, 0x6e, 0x18, 0x75, 0x54, 0x56, 0xc5, 0x01, 0x74, 0xd3, 0x61, 0x63, 0x55, 0x55, 0x89,
-	0x84, 0xf1, 0xb0, 0x96, 0x4b, 0x2d, 0xde, 0x3d, 0xf9, 0xbe, 0x77, 0xe6, 0xca, 0xf3, 0x78, 0x3a,
-	0x66, 0xc1, 0x6c, 0xc2, 0x89, 0x45, 0xc4, 0x27, 0x9c, 0x45, 0x9e, 0xcf, 0x27, 0x9e, 0x5f, 0x7e,
-	0xba, 0xa2, 0x90, 0x4d, 0xbb, 0xea, 0xf9, 0x7a, 0xf6, 0x27, 0x00, 0x00, 0xff, 0xff, 0x6e, 0xbf,
-	0xf0, 0x24, 0xdc, 0x06, 0x00, 0x00,
+	proto.RegisterFile("wtclientrpc/wtclient.proto", fileDescriptor_wtclient_e21dc06e5a04992e)
+}
+
+var fileDescriptor_wtclient_e21dc06e5a04992e = []byte{
+	// 783 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xdd, 0x6e, 0xd3, 0x48,
+	0x18, 0x95, 0xd3, 0x4d, 0x36, 0xf9, 0x92, 0xb4, 0xd9, 0xc9, 0xb6, 0xeb, 0x75, 0xbb, 0x6d, 0x64,
+	0xed, 0x6a, 0xa3, 0x0a, 0x25, 0xa8, 0x40, 0x2f, 0xb8, 0x00, 0x4a, 0x11, 0x15, 0x12, 0x48, 0x95,
+	0x0b, 0x42, 0x70, 0x63, 0x39, 0x9e, 0x69, 0x63, 0xd5, 0x19, 0xbb, 0x9e, 0x71, 0x7f, 0x2e, 0x79,
+	0x03, 0x78, 0x04, 0xde, 0x82, 0x07, 0xe1, 0x81, 0x90, 0xc7, 0x63, 0xc7, 0x93, 0xd8, 0xf4, 0x02,
+	0xc1, 0x5d, 0xe7, 0x9c, 0xaf, 0x67, 0x4e, 0xbe, 0xbf, 0x31, 0x18, 0x57, 0xdc, 0xf5, 0x3d, 0x42,
+	0x79, 0x14, 0xba, 0xe3, 0xec, 0xef, 0x51, 0x18, 0x05, 0x3c, 0x40, 0xed, 0x02, 0x67, 0x1e, 0xc2,
+	0xda, 0x01, 0xc6, 0xaf, 0x83, 0x2b, 0x12, 0x59, 0xe4, 0x22, 0x26, 0x8c, 0xa3, 0x0d, 0x68, 0x84,
+	0xf1, 0xe4, 0x9c, 0xdc, 0xe8, 0xda, 0x40, 0x1b, 0x76, 0x2c, 0x79, 0x42, 0x3a, 0xfc, 0xee, 0x60,
+	0x1c, 0x11, 0xc6, 0xf4, 0xda, 0x40, 0x1b, 0xb6, 0xac, 0xec, 0x68, 0x22, 0xe8, 0xcd, 0x45, 0x58,
+	0x18, 0x50, 0x46, 0xcc, 0xe7, 0x80, 0x2c, 0x32, 0x0b, 0x2e, 0xc9, 0x0f, 0x6a, 0xaf, 0x43, 0x5f,
+	0xd1, 0x91, 0xf2, 0xef, 0xa0, 0x7f, 0x44, 0xb8, 0xc0, 0x5e, 0xd0, 0xd3, 0xe0, 0x36, 0xfd, 0x5d,
+	0xe8, 0x79, 0xd4, 0xf5, 0x63, 0x4c, 0x6c, 0x46, 0x18, 0xf3, 0x02, 0x9a, 0x5e, 0xd4, 0xb4, 0x96,
+	0x70, 0xf3, 0x8b, 0x06, 0x1d, 0x21, 0x7c, 0x92, 0x22, 0x68, 0x00, 0x6d, 0x1a, 0xcf, 0xec, 0x89,
+	0xe3, 0x9e, 0xc7, 0x21, 0x13, 0xca, 0x5d, 0xab, 0x08, 0xa1, 0xbb, 0xd0, 0x4f, 0x8e, 0x21, 0xa1,
+	0xd8, 0xa3, 0x67, 0x79, 0x64, 0x4d, 0x44, 0x96, 0x51, 0x89, 0xe6, 0xcc, 0xb9, 0xce, 0x23, 0x57,
+	0x52, 0xcd, 0x02, 0x84, 0xf6, 0xe0, 0x4f, 0x76, 0x45, 0x48, 0x68, 0x3b, 0x3c, 0x98, 0x31, 0x3b,
+	0x24, 0x91, 0x3d, 0xb9, 0xe1, 0x44, 0xff, 0x4d, 0x84, 0x96, 0x72, 0xe6, 0x57, 0x0d, 0xea, 0xc2,
+	0x7a, 0x65, 0x22, 0xb6, 0xa0, 0x25, 0x33, 0x4b, 0x12, 0x7f, 0x2b, 0xc3, 0x96, 0x35, 0x07, 0xd0,
+	0x43, 0xd0, 0x1d, 0x97, 0x7b, 0x97, 0x79, 0x36, 0x6c, 0xd7, 0xa1, 0xd8, 0xc3, 0x0e, 0x27, 0xc2,
+	0x62, 0xd3, 0xaa, 0xe4, 0x91, 0x09, 0x9d, 0xe4, 0x87, 0xe6, 0xe9, 0x4d, 0x7d, 0x2a, 0x18, 0x7a,
+	0x00, 0xcd, 0x9c, 0xaf, 0x0f, 0x56, 0x86, 0xed, 0xbd, 0xbf, 0x47, 0x85, 0x6e, 0x1c, 0x15, 0xd3,
+	0x6e, 0xe5, 0xa1, 0xe6, 0x63, 0xf8, 0xe3, 0xa5, 0xc7, 0xd2, 0x6a, 0xb3, 0xac, 0xd4, 0x65, 0x25,
+	0xd5, 0x2a, 0x4a, 0xfa, 0x04, 0x50, 0x51, 0x20, 0xed, 0x21, 0xb4, 0x0b, 0x0d, 0x2e, 0x10, 0x5d,
+	0x13, 0x5e, 0xd0, 0xb2, 0x17, 0x4b, 0x46, 0x98, 0xab, 0xd0, 0x39, 0xe1, 0x0e, 0xcf, 0x6e, 0x37,
+	0x3f, 0xd4, 0xa0, 0x2b, 0x01, 0xa9, 0xf6, 0x33, 0xba, 0x64, 0x04, 0x28, 0x81, 0x4f, 0x1d, 0xcf,
+	0x27, 0x78, 0xa1, 0x59, 0x4a, 0x18, 0x74, 0x1f, 0xd6, 0x8b, 0xf9, 0xb6, 0x1d, 0xf7, 0x22, 0xf6,
+	0x22, 0x82, 0x65, 0x31, 0xca, 0x49, 0xb4, 0x0f, 0x1b, 0x0a, 0x41, 0xae, 0xa7, 0x4e, 0xcc, 0x38,
+	0xc1, 0x7a, 0x5d, 0xfc, 0x5b, 0x05, 0x6b, 0xae, 0x41, 0xf7, 0x38, 0xf0, 0x3d, 0xf7, 0x26, 0x4b,
+	0xca, 0x29, 0xac, 0x66, 0xc0, 0x3c, 0x29, 0x49, 0x4f, 0xc7, 0x61, 0xd2, 0x22, 0x79, 0x52, 0x0a,
+	0x50, 0x65, 0x9b, 0xd7, 0xbe, 0xd3, 0xe6, 0x9f, 0x35, 0xf8, 0xeb, 0x84, 0xf0, 0xc3, 0xa9, 0x43,
+	0x29, 0xf1, 0x15, 0x0f, 0x68, 0x1b, 0xc0, 0x9d, 0x3a, 0xd4, 0x0e, 0x03, 0x8f, 0x72, 0x71, 0x61,
+	0xcb, 0x2a, 0x20, 0x49, 0xdb, 0xc8, 0x6c, 0xd9, 0xd8, 0x63, 0xce, 0xc4, 0x27, 0x38, 0xdb, 0x04,
+	0x8b, 0x78, 0x32, 0x0e, 0x89, 0xd5, 0x52, 0x7f, 0x69, 0x11, 0x2a, 0x79, 0xd3, 0x00, 0x7d, 0xd9,
+	0xa2, 0x5c, 0x5e, 0x9f, 0x34, 0xe8, 0x2a, 0x4c, 0xb2, 0xff, 0x84, 0x47, 0x0f, 0xcb, 0x79, 0xcd,
+	0x8e, 0xbf, 0xcc, 0xef, 0x16, 0x18, 0xc9, 0x88, 0x14, 0x6d, 0x79, 0x24, 0x6f, 0xf7, 0x37, 0xb0,
+	0x59, 0xca, 0xca, 0x32, 0xef, 0x43, 0x33, 0x94, 0x98, 0x9c, 0x25, 0x43, 0x99, 0x25, 0x35, 0x0d,
+	0x79, 0xec, 0xde, 0xc7, 0x3a, 0xf4, 0xde, 0x3a, 0xdc, 0x9d, 0x8a, 0x29, 0x3b, 0x14, 0xf1, 0xe8,
+	0x08, 0x9a, 0xd9, 0x6b, 0x82, 0xb6, 0x14, 0x99, 0x85, 0x97, 0xca, 0xf8, 0xa7, 0x82, 0x95, 0xae,
+	0x8e, 0xa1, 0x5d, 0x78, 0x3a, 0xd0, 0x8e, 0x12, 0xbd, 0xfc, 0x38, 0x19, 0x83, 0xea, 0x00, 0xa9,
+	0xf8, 0x0a, 0x60, 0xbe, 0x47, 0xd0, 0xb6, 0x12, 0xbf, 0xb4, 0xa1, 0x8c, 0x9d, 0x4a, 0x5e, 0xca,
+	0x3d, 0x83, 0x4e, 0xf1, 0x11, 0x43, 0xaa, 0x81, 0x92, 0xf7, 0xcd, 0x28, 0x59, 0x51, 0xe8, 0x11,
+	0xd4, 0xc5, 0x26, 0x42, 0xea, 0x2e, 0x2d, 0xae, 0x2b, 0xc3, 0x28, 0xa3, 0xa4, 0x8b, 0x03, 0x68,
+	0xc8, 0x2e, 0x54, 0xa3, 0x94, 0xb9, 0x32, 0x36, 0x4b, 0x39, 0x29, 0x61, 0x43, 0x6f, 0xb1, 0xd9,
+	0xd1, 0xbf, 0xea, 0x95, 0xe5, 0xe3, 0x6a, 0xfc, 0x77, 0x4b, 0x94, 0xbc, 0x60, 0x0a, 0xfd, 0x92,
+	0xfe, 0x43, 0xff, 0x2f, 0x65, 0xb8, 0xbc, 0x7f, 0x8d, 0xe1, 0xed, 0x81, 0xe9, 0x4d, 0x4f, 0xef,
+	0xbc, 0xdf, 0x3d, 0xf3, 0xf8, 0x34, 0x9e, 0x8c, 0xdc, 0x60, 0x36, 0xc6, 0xc4, 0x8d, 0x08, 0x1e,
+	0x63, 0x37, 0xf2, 0x29, 0x1e, 0xfb, 0xb4, 0xf8, 0x39, 0x15, 0x85, 0xee, 0xa4, 0x21, 0x3e, 0xa9,
+	0xee, 0x7d, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x41, 0xc8, 0x88, 0xdc, 0x70, 0x09, 0x00, 0x00,
 }
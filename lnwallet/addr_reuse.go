@@ -0,0 +1,307 @@
+package lnwallet
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/v2"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrlnd/channeldb"
+)
+
+const (
+	// DefaultAddressGapLimit is the default number of consecutive unused
+	// addresses a wallet is expected to scan for when recovering from its
+	// seed. It matches the default gap limit of dcrwallet.
+	DefaultAddressGapLimit = 20
+)
+
+// AddrReservationStore is the persistent storage of the addresses handed out
+// by a NoReuseWalletController.
+type AddrReservationStore interface {
+	// PutAddrReservation stores the given address reservation.
+	PutAddrReservation(r *channeldb.AddrReservation) error
+
+	// MarkAddrsUsed marks the reservations of the given addresses as
+	// used.
+	MarkAddrsUsed(addrs []string) error
+
+	// FetchAddrReservations returns all stored address reservations.
+	FetchAddrReservations() ([]*channeldb.AddrReservation, error)
+}
+
+// NoReuseConfig houses the parameters of a NoReuseWalletController.
+type NoReuseConfig struct {
+	// WalletController is the wallet whose addresses are guarded against
+	// reuse.
+	WalletController WalletController
+
+	// Store persists the handed out addresses.
+	Store AddrReservationStore
+
+	// NetParams are the parameters of the chain the wallet operates on.
+	NetParams *chaincfg.Params
+
+	// GapLimit is the number of consecutive unused addresses the wallet
+	// scans for during recovery. It bounds the number of derivation
+	// attempts made to find a fresh address, and the number of unused
+	// change addresses that may be outstanding before a warning is logged.
+	GapLimit uint32
+}
+
+// NoReuseWalletController is a WalletController that never hands out the same
+// address twice. Every address returned by NewAddress is reserved in a
+// persistent store before being returned, so reuse is detected even across
+// restarts and even if the underlying wallet wraps around its gap limit.
+//
+// Change addresses are only ever used by the node itself, so the ones that
+// were reserved but never paid to before a restart (for example because the
+// node crashed before broadcasting the transaction using them) are handed out
+// again before deriving new ones. This keeps the number of unused addresses
+// in the wallet below its gap limit, so funds remain recoverable from seed.
+type NoReuseWalletController struct {
+	WalletController
+
+	cfg NoReuseConfig
+
+	// mtx guards the fields below.
+	mtx sync.Mutex
+
+	// reservations are all known reservations, keyed by address.
+	reservations map[string]*channeldb.AddrReservation
+
+	// orphans are the unused change addresses reserved before the last
+	// restart, in the order they should be handed out again.
+	orphans []string
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// A compile-time assertion to ensure that NoReuseWalletController implements
+// the WalletController interface.
+var _ WalletController = (*NoReuseWalletController)(nil)
+
+// NewNoReuseWalletController wraps the configured wallet controller so that
+// its addresses are never reused.
+func NewNoReuseWalletController(cfg NoReuseConfig) *NoReuseWalletController {
+	return &NoReuseWalletController{
+		WalletController: cfg.WalletController,
+		cfg:              cfg,
+		reservations:     make(map[string]*channeldb.AddrReservation),
+		quit:             make(chan struct{}),
+	}
+}
+
+// Start starts the underlying wallet controller, loads the existing address
+// reservations and starts tracking which reserved addresses get used.
+//
+// This is a part of the WalletController interface.
+func (w *NoReuseWalletController) Start() error {
+	if err := w.WalletController.Start(); err != nil {
+		return err
+	}
+
+	reservations, err := w.cfg.Store.FetchAddrReservations()
+	if err != nil {
+		return err
+	}
+
+	var numUnusedChange int
+	for _, r := range reservations {
+		w.reservations[r.Address] = r
+		if r.Change && !r.Used {
+			numUnusedChange++
+		}
+	}
+
+	// If there are change addresses that haven't been seen used, we may
+	// have missed the transaction paying to them while we were down, so
+	// check the wallet's history before deciding which ones are orphaned.
+	if numUnusedChange > 0 {
+		txns, err := w.WalletController.ListTransactionDetails()
+		if err != nil {
+			return err
+		}
+		w.mtx.Lock()
+		for _, txn := range txns {
+			w.markUsed(txn.DestAddresses)
+		}
+
+		// Hand out the orphaned addresses in the order they were
+		// originally reserved in.
+		var orphans []*channeldb.AddrReservation
+		for _, r := range w.reservations {
+			if r.Change && !r.Used {
+				orphans = append(orphans, r)
+			}
+		}
+		sort.Slice(orphans, func(i, j int) bool {
+			return orphans[i].ReservedAt.Before(orphans[j].ReservedAt)
+		})
+		for _, r := range orphans {
+			w.orphans = append(w.orphans, r.Address)
+		}
+		w.mtx.Unlock()
+
+		walletLog.Infof("Found %d unused change addresses to hand out "+
+			"before deriving new ones", len(w.orphans))
+	}
+
+	txSub, err := w.WalletController.SubscribeTransactions()
+	if err != nil {
+		return err
+	}
+
+	w.wg.Add(1)
+	go w.trackUsage(txSub)
+
+	return nil
+}
+
+// Stop stops tracking address usage and stops the underlying wallet
+// controller.
+//
+// This is a part of the WalletController interface.
+func (w *NoReuseWalletController) Stop() error {
+	close(w.quit)
+	w.wg.Wait()
+
+	return w.WalletController.Stop()
+}
+
+// NewAddress returns an address that has never been handed out before, or an
+// unused change address reserved before the last restart if change is true.
+//
+// This is a part of the WalletController interface.
+func (w *NoReuseWalletController) NewAddress(addrType AddressType,
+	change bool) (dcrutil.Address, error) {
+
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	for change && len(w.orphans) > 0 {
+		addrStr := w.orphans[0]
+		w.orphans = w.orphans[1:]
+
+		// The address may have been used in the meantime.
+		if w.reservations[addrStr].Used {
+			continue
+		}
+
+		addr, err := dcrutil.DecodeAddress(addrStr, w.cfg.NetParams)
+		if err != nil {
+			return nil, err
+		}
+
+		walletLog.Debugf("Reusing unused change address %v reserved "+
+			"before restart", addrStr)
+
+		return addr, nil
+	}
+
+	for i := uint32(0); i <= w.cfg.GapLimit; i++ {
+		addr, err := w.WalletController.NewAddress(addrType, change)
+		if err != nil {
+			return nil, err
+		}
+
+		addrStr := addr.Address()
+		if _, ok := w.reservations[addrStr]; ok {
+			walletLog.Warnf("Wallet returned previously handed out "+
+				"address %v, deriving another one", addrStr)
+			continue
+		}
+
+		r := &channeldb.AddrReservation{
+			Address:    addrStr,
+			Change:     change,
+			ReservedAt: time.Now(),
+		}
+		if err := w.cfg.Store.PutAddrReservation(r); err != nil {
+			return nil, err
+		}
+		w.reservations[addrStr] = r
+
+		if change {
+			w.checkGap()
+		}
+
+		return addr, nil
+	}
+
+	return nil, fmt.Errorf("unable to derive an unused address after %d "+
+		"attempts", w.cfg.GapLimit+1)
+}
+
+// checkGap logs a warning if the number of unused change addresses reaches
+// the gap limit, as funds sent to addresses beyond it may not be found when
+// recovering the wallet from its seed.
+//
+// NOTE: This method must be called with the mutex held.
+func (w *NoReuseWalletController) checkGap() {
+	var numUnused uint32
+	for _, r := range w.reservations {
+		if r.Change && !r.Used {
+			numUnused++
+		}
+	}
+
+	if numUnused >= w.cfg.GapLimit {
+		walletLog.Warnf("%d change addresses are reserved but unused, "+
+			"which reaches the gap limit of %d", numUnused,
+			w.cfg.GapLimit)
+	}
+}
+
+// markUsed marks any reserved addresses among the given ones as used.
+//
+// NOTE: This method must be called with the mutex held.
+func (w *NoReuseWalletController) markUsed(addrs []dcrutil.Address) {
+	var used []string
+	for _, addr := range addrs {
+		addrStr := addr.Address()
+		r, ok := w.reservations[addrStr]
+		if !ok || r.Used {
+			continue
+		}
+
+		r.Used = true
+		used = append(used, addrStr)
+	}
+	if len(used) == 0 {
+		return
+	}
+
+	if err := w.cfg.Store.MarkAddrsUsed(used); err != nil {
+		walletLog.Errorf("Unable to mark addresses as used: %v", err)
+	}
+}
+
+// trackUsage marks reserved addresses as used as transactions paying to them
+// are seen by the wallet.
+//
+// NOTE: This MUST be run as a goroutine.
+func (w *NoReuseWalletController) trackUsage(txSub TransactionSubscription) {
+	defer w.wg.Done()
+	defer txSub.Cancel()
+
+	for {
+		var txn *TransactionDetail
+		select {
+		case txn = <-txSub.UnconfirmedTransactions():
+		case txn = <-txSub.ConfirmedTransactions():
+		case <-w.quit:
+			return
+		}
+		if txn == nil {
+			continue
+		}
+
+		w.mtx.Lock()
+		w.markUsed(txn.DestAddresses)
+		w.mtx.Unlock()
+	}
+}
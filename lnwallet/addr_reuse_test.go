@@ -0,0 +1,213 @@
+package lnwallet
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/v2"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrlnd/channeldb"
+)
+
+// mockAddrReservationStore is an in-memory AddrReservationStore.
+type mockAddrReservationStore struct {
+	sync.Mutex
+	reservations map[string]channeldb.AddrReservation
+}
+
+func (m *mockAddrReservationStore) PutAddrReservation(
+	r *channeldb.AddrReservation) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	m.reservations[r.Address] = *r
+	return nil
+}
+
+func (m *mockAddrReservationStore) MarkAddrsUsed(addrs []string) error {
+	m.Lock()
+	defer m.Unlock()
+
+	for _, addr := range addrs {
+		r, ok := m.reservations[addr]
+		if !ok {
+			continue
+		}
+		r.Used = true
+		m.reservations[addr] = r
+	}
+	return nil
+}
+
+func (m *mockAddrReservationStore) FetchAddrReservations() (
+	[]*channeldb.AddrReservation, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	var reservations []*channeldb.AddrReservation
+	for _, r := range m.reservations {
+		r := r
+		reservations = append(reservations, &r)
+	}
+	return reservations, nil
+}
+
+func (m *mockAddrReservationStore) isUsed(addr dcrutil.Address) bool {
+	m.Lock()
+	defer m.Unlock()
+
+	return m.reservations[addr.Address()].Used
+}
+
+// mockTxSubscription is a TransactionSubscription backed by plain channels.
+type mockTxSubscription struct {
+	confirmed   chan *TransactionDetail
+	unconfirmed chan *TransactionDetail
+}
+
+func (m *mockTxSubscription) ConfirmedTransactions() chan *TransactionDetail {
+	return m.confirmed
+}
+
+func (m *mockTxSubscription) UnconfirmedTransactions() chan *TransactionDetail {
+	return m.unconfirmed
+}
+
+func (m *mockTxSubscription) Cancel() {}
+
+// mockAddrWallet is a WalletController which hands out addresses from a fixed
+// list, wrapping around once the list is exhausted.
+type mockAddrWallet struct {
+	WalletController
+
+	addrs []dcrutil.Address
+	next  int
+
+	txns  []*TransactionDetail
+	txSub *mockTxSubscription
+}
+
+func (m *mockAddrWallet) Start() error { return nil }
+
+func (m *mockAddrWallet) Stop() error { return nil }
+
+func (m *mockAddrWallet) NewAddress(AddressType, bool) (dcrutil.Address, error) {
+	addr := m.addrs[m.next%len(m.addrs)]
+	m.next++
+	return addr, nil
+}
+
+func (m *mockAddrWallet) ListTransactionDetails() ([]*TransactionDetail, error) {
+	return m.txns, nil
+}
+
+func (m *mockAddrWallet) SubscribeTransactions() (TransactionSubscription, error) {
+	return m.txSub, nil
+}
+
+// TestNoReuseWalletController tests that the NoReuseWalletController never
+// hands out an address twice, and that unused change addresses are handed
+// out again after a restart.
+func TestNoReuseWalletController(t *testing.T) {
+	t.Parallel()
+
+	netParams := chaincfg.SimNetParams()
+	addrs := make([]dcrutil.Address, 5)
+	for i := range addrs {
+		var hash [20]byte
+		hash[0] = byte(i)
+		addr, err := dcrutil.NewAddressPubKeyHash(
+			hash[:], netParams, dcrec.STEcdsaSecp256k1,
+		)
+		if err != nil {
+			t.Fatalf("unable to create address: %v", err)
+		}
+		addrs[i] = addr
+	}
+
+	store := &mockAddrReservationStore{
+		reservations: make(map[string]channeldb.AddrReservation),
+	}
+	newWallet := func(walletAddrs []dcrutil.Address,
+		txns []*TransactionDetail) (*NoReuseWalletController,
+		*mockAddrWallet) {
+
+		wallet := &mockAddrWallet{
+			addrs: walletAddrs,
+			txns:  txns,
+			txSub: &mockTxSubscription{
+				confirmed:   make(chan *TransactionDetail),
+				unconfirmed: make(chan *TransactionDetail),
+			},
+		}
+		w := NewNoReuseWalletController(NoReuseConfig{
+			WalletController: wallet,
+			Store:            store,
+			NetParams:        netParams,
+			GapLimit:         5,
+		})
+		if err := w.Start(); err != nil {
+			t.Fatalf("unable to start wallet: %v", err)
+		}
+		return w, wallet
+	}
+
+	assertAddr := func(w *NoReuseWalletController, change bool,
+		expected dcrutil.Address) {
+
+		t.Helper()
+
+		addr, err := w.NewAddress(PubKeyHash, change)
+		if err != nil {
+			t.Fatalf("unable to get address: %v", err)
+		}
+		if addr.Address() != expected.Address() {
+			t.Fatalf("expected address %v, got %v", expected, addr)
+		}
+	}
+
+	// The wallet only knows about three addresses, so once they've been
+	// handed out, it wraps around and no fresh address can be found.
+	w, wallet := newWallet(addrs[:3], nil)
+	assertAddr(w, true, addrs[0])
+	assertAddr(w, true, addrs[1])
+	assertAddr(w, true, addrs[2])
+	if _, err := w.NewAddress(PubKeyHash, true); err == nil {
+		t.Fatalf("expected an error when all addresses were handed out")
+	}
+
+	// Seeing a transaction paying to the first address should mark it as
+	// used.
+	wallet.txSub.unconfirmed <- &TransactionDetail{
+		DestAddresses: []dcrutil.Address{addrs[0]},
+	}
+	for i := 0; i < 100 && !store.isUsed(addrs[0]); i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if !store.isUsed(addrs[0]) {
+		t.Fatalf("expected address to be marked as used")
+	}
+	if err := w.Stop(); err != nil {
+		t.Fatalf("unable to stop wallet: %v", err)
+	}
+
+	// After a restart, a transaction paying to the second address is found
+	// in the wallet's history, so only the third one is left unused and
+	// should be handed out again before deriving a new address.
+	w, _ = newWallet(addrs, []*TransactionDetail{{
+		DestAddresses: []dcrutil.Address{addrs[1]},
+	}})
+	defer w.Stop()
+
+	assertAddr(w, true, addrs[2])
+
+	// Unused addresses are only handed out again once, and external
+	// addresses never are, so the next addresses are freshly derived
+	// skipping the ones already handed out.
+	assertAddr(w, false, addrs[3])
+	assertAddr(w, true, addrs[4])
+}
@@ -795,8 +795,8 @@ func (lc *LightningChannel) diskHtlcToPayDesc(feeRate AtomPerKByte,
 		htlc.Amt.ToAtoms(), lc.channelState.LocalChanCfg.DustLimit)
 	if !isDustLocal && localCommitKeys != nil {
 		ourP2WSH, ourWitnessScript, err = genHtlcScript(
-			htlc.Incoming, true, htlc.RefundTimeout, htlc.RHash,
-			localCommitKeys)
+			lc.channelState.ChanType, htlc.Incoming, true,
+			htlc.RefundTimeout, htlc.RHash, localCommitKeys)
 		if err != nil {
 			return pd, err
 		}
@@ -805,8 +805,8 @@ func (lc *LightningChannel) diskHtlcToPayDesc(feeRate AtomPerKByte,
 		htlc.Amt.ToAtoms(), lc.channelState.RemoteChanCfg.DustLimit)
 	if !isDustRemote && remoteCommitKeys != nil {
 		theirP2WSH, theirWitnessScript, err = genHtlcScript(
-			htlc.Incoming, false, htlc.RefundTimeout, htlc.RHash,
-			remoteCommitKeys)
+			lc.channelState.ChanType, htlc.Incoming, false,
+			htlc.RefundTimeout, htlc.RHash, remoteCommitKeys)
 		if err != nil {
 			return pd, err
 		}
@@ -881,8 +881,7 @@ func (lc *LightningChannel) diskCommitToMemCommit(isLocal bool,
 	// If this commit is tweakless, then it'll affect the way we derive our
 	// keys, which will affect the commitment transaction reconstruction.
 	// So we'll determine this first, before we do anything else.
-	tweaklessCommit := (lc.channelState.ChanType ==
-		channeldb.SingleFunderTweakless)
+	tweaklessCommit := lc.channelState.ChanType.IsTweakless()
 
 	// First, we'll need to re-derive the commitment key ring for each
 	// party used within this particular state. If this is a pending commit
@@ -1151,10 +1150,11 @@ type updateLog struct {
 	// their list element within the main list.List.
 	htlcIndex map[uint64]*list.Element
 
-	// modifiedHtlcs is a set that keeps track of all the current modified
-	// htlcs. A modified HTLC is one that's present in the log, and has as
-	// a pending fail or settle that's attempting to consume it.
-	modifiedHtlcs map[uint64]struct{}
+	// modifiedHtlcs keeps track of all the current modified htlcs, along
+	// with the type of the update modifying them. A modified HTLC is one
+	// that's present in the log, and has as a pending fail or settle
+	// that's attempting to consume it.
+	modifiedHtlcs map[uint64]updateType
 }
 
 // newUpdateLog creates a new updateLog instance.
@@ -1165,7 +1165,7 @@ func newUpdateLog(logIndex, htlcCounter uint64) *updateLog {
 		htlcIndex:     make(map[uint64]*list.Element),
 		logIndex:      logIndex,
 		htlcCounter:   htlcCounter,
-		modifiedHtlcs: make(map[uint64]struct{}),
+		modifiedHtlcs: make(map[uint64]updateType),
 	}
 }
 
@@ -1210,36 +1210,63 @@ func (u *updateLog) lookupHtlc(i uint64) *PaymentDescriptor {
 }
 
 // remove attempts to remove an entry from the update log. If the entry is
-// found, then the entry will be removed from the update log and index.
+// found, then the entry will be removed from the update log and index. A
+// missing entry points at an inconsistent log, so it is logged as an error.
 func (u *updateLog) removeUpdate(i uint64) {
-	entry := u.updateIndex[i]
+	entry, ok := u.updateIndex[i]
+	if !ok {
+		walletLog.Errorf("Unable to remove update with log index %v: "+
+			"not found in update log", i)
+		return
+	}
+
 	u.Remove(entry)
 	delete(u.updateIndex, i)
 }
 
 // removeHtlc attempts to remove an HTLC offer form the update log. If the
 // entry is found, then the entry will be removed from both the main log and
-// the offer index.
+// the offer index. A missing entry points at an inconsistent log, so it is
+// logged as an error.
 func (u *updateLog) removeHtlc(i uint64) {
-	entry := u.htlcIndex[i]
+	delete(u.modifiedHtlcs, i)
+
+	entry, ok := u.htlcIndex[i]
+	if !ok {
+		walletLog.Errorf("Unable to remove HTLC with index %v: not "+
+			"found in update log", i)
+		return
+	}
+
 	u.Remove(entry)
 	delete(u.htlcIndex, i)
+}
 
-	delete(u.modifiedHtlcs, i)
+// htlcModification returns the type of the pending modification of the HTLC
+// identified by the passed index, and false if it has none.
+func (u *updateLog) htlcModification(i uint64) (updateType, bool) {
+	modType, ok := u.modifiedHtlcs[i]
+	return modType, ok
 }
 
-// htlcHasModification returns true if the HTLC identified by the passed index
-// has a pending modification within the log.
-func (u *updateLog) htlcHasModification(i uint64) bool {
-	_, o := u.modifiedHtlcs[i]
-	return o
+// markHtlcModified marks an HTLC as modified by an update of the given type
+// based on its HTLC index. After a call to this method, htlcModification will
+// return the type of the update until the HTLC is removed.
+func (u *updateLog) markHtlcModified(i uint64, modType updateType) {
+	u.modifiedHtlcs[i] = modType
 }
 
-// markHtlcModified marks an HTLC as modified based on its HTLC index. After a
-// call to this method, htlcHasModification will return true until the HTLC is
-// removed.
-func (u *updateLog) markHtlcModified(i uint64) {
-	u.modifiedHtlcs[i] = struct{}{}
+// modificationErr returns the error signalling that the HTLC identified by the
+// passed index already has a pending modification of the given type. A pending
+// settle results in ErrHtlcIndexAlreadySettled, while any pending fail results
+// in ErrHtlcIndexAlreadyFailed, which allows callers to tell a replay of the
+// same resolution apart from a conflicting one.
+func modificationErr(htlcIndex uint64, modType updateType) error {
+	if modType == Settle {
+		return ErrHtlcIndexAlreadySettled(htlcIndex)
+	}
+
+	return ErrHtlcIndexAlreadyFailed(htlcIndex)
 }
 
 // compactLogs performs garbage collection within the log removing HTLCs which
@@ -1556,7 +1583,8 @@ func (lc *LightningChannel) logUpdateToPayDesc(logUpdate *channeldb.LogUpdate,
 			wireMsg.Amount.ToAtoms(), remoteDustLimit)
 		if !isDustRemote {
 			theirP2WSH, theirWitnessScript, err := genHtlcScript( // TODO(decred): P2SH
-				false, false, wireMsg.Expiry, wireMsg.PaymentHash,
+				lc.channelState.ChanType, false, false,
+				wireMsg.Expiry, wireMsg.PaymentHash,
 				remoteCommitKeys,
 			)
 			if err != nil {
@@ -1887,7 +1915,9 @@ func (lc *LightningChannel) restoreStateLogs(
 		default:
 			lc.localUpdateLog.appendUpdate(payDesc)
 
-			lc.remoteUpdateLog.markHtlcModified(payDesc.ParentIndex)
+			lc.remoteUpdateLog.markHtlcModified(
+				payDesc.ParentIndex, payDesc.EntryType,
+			)
 		}
 	}
 
@@ -1988,6 +2018,11 @@ type BreachRetribution struct {
 	// RemoteDelay specifies the CSV delay applied to to-local scripts on
 	// the breaching commitment transaction.
 	RemoteDelay uint32
+
+	// LocalDelay specifies the CSV delay applied to the output paying to
+	// us on the breaching commitment transaction. It is only non-zero for
+	// channels with anchors.
+	LocalDelay uint32
 }
 
 // NewBreachRetribution creates a new fully populated BreachRetribution for the
@@ -2037,7 +2072,9 @@ func NewBreachRetribution(chanState *channeldb.OpenChannel, stateNum uint64,
 	if err != nil {
 		return nil, err
 	}
-	localPkScript, err := input.CommitScriptUnencumbered(keyRing.NoDelayKey)
+	localWitnessScript, localPkScript, localDelay, err := CommitScriptToRemote(
+		chanState.ChanType, keyRing.NoDelayKey,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -2078,7 +2115,7 @@ func NewBreachRetribution(chanState *channeldb.OpenChannel, stateNum uint64,
 		localSignDesc = &input.SignDescriptor{
 			SingleTweak:   keyRing.LocalCommitKeyTweak,
 			KeyDesc:       chanState.LocalChanCfg.PaymentBasePoint,
-			WitnessScript: localPkScript,
+			WitnessScript: localWitnessScript,
 			Output: &wire.TxOut{
 				PkScript: localPkScript,
 				Value:    int64(localAmt),
@@ -2146,6 +2183,7 @@ func NewBreachRetribution(chanState *channeldb.OpenChannel, stateNum uint64,
 			htlcWitnessScript, err = input.SenderHTLCScript(
 				keyRing.RemoteHtlcKey, keyRing.LocalHtlcKey,
 				keyRing.RevocationKey, htlc.RHash[:],
+				chanState.ChanType.HasAnchors(),
 			)
 			if err != nil {
 				return nil, err
@@ -2158,7 +2196,7 @@ func NewBreachRetribution(chanState *channeldb.OpenChannel, stateNum uint64,
 			htlcWitnessScript, err = input.ReceiverHTLCScript(
 				htlc.RefundTimeout, keyRing.LocalHtlcKey,
 				keyRing.RemoteHtlcKey, keyRing.RevocationKey,
-				htlc.RHash[:],
+				htlc.RHash[:], chanState.ChanType.HasAnchors(),
 			)
 			if err != nil {
 				return nil, err
@@ -2206,6 +2244,7 @@ func NewBreachRetribution(chanState *channeldb.OpenChannel, stateNum uint64,
 		HtlcRetributions:     htlcRetributions,
 		KeyRing:              keyRing,
 		RemoteDelay:          remoteDelay,
+		LocalDelay:           localDelay,
 	}, nil
 }
 
@@ -2414,49 +2453,58 @@ func (lc *LightningChannel) createCommitmentTx(c *commitment,
 	// on its total size. Once we have the total size, we'll multiply
 	// by the current fee-per-kb, then divide by 1000 to get the proper
 	// fee.
-	totalCommitSize := input.CommitmentTxSize + (input.HTLCOutputSize * numHTLCs)
+	chanType := lc.channelState.ChanType
+	totalCommitSize := CommitSize(chanType) + (input.HTLCOutputSize * numHTLCs)
 
 	// With the size known, we can now calculate the commitment fee,
 	// ensuring that we account for any dust outputs trimmed above.
 	commitFee := c.feePerKB.FeeForSize(totalCommitSize)
-	commitFeeMAtoms := lnwire.NewMAtomsFromAtoms(commitFee)
 
-	// Currently, within the protocol, the initiator always pays the fees.
-	// So we'll subtract the fee amount from the balance of the current
-	// initiator. If the initiator is unable to pay the fee fully, then
-	// their entire output is consumed.
+	// Currently, within the protocol, the initiator always pays the fees
+	// and funds the anchor outputs, if any. So we'll subtract those
+	// amounts from the balance of the current initiator. If the initiator
+	// is unable to pay them fully, then their entire output is consumed.
+	initiatorCost := commitFee + anchorsValue(chanType)
+	initiatorCostMAtoms := lnwire.NewMAtomsFromAtoms(initiatorCost)
 	switch {
-	case lc.channelState.IsInitiator && commitFee > ourBalance.ToAtoms():
+	case lc.channelState.IsInitiator && initiatorCost > ourBalance.ToAtoms():
 		ourBalance = 0
 
 	case lc.channelState.IsInitiator:
-		ourBalance -= commitFeeMAtoms
+		ourBalance -= initiatorCostMAtoms
 
-	case !lc.channelState.IsInitiator && commitFee > theirBalance.ToAtoms():
+	case !lc.channelState.IsInitiator && initiatorCost > theirBalance.ToAtoms():
 		theirBalance = 0
 
 	case !lc.channelState.IsInitiator:
-		theirBalance -= commitFeeMAtoms
+		theirBalance -= initiatorCostMAtoms
 	}
 
 	var (
 		delay                      uint32
 		delayBalance, p2wkhBalance dcrutil.Amount
+		localFundingKey            *secp256k1.PublicKey
+		remoteFundingKey           *secp256k1.PublicKey
 	)
 	if c.isOurs {
 		delay = uint32(lc.localChanCfg.CsvDelay)
 		delayBalance = ourBalance.ToAtoms()
 		p2wkhBalance = theirBalance.ToAtoms()
+		localFundingKey = lc.localChanCfg.MultiSigKey.PubKey
+		remoteFundingKey = lc.remoteChanCfg.MultiSigKey.PubKey
 	} else {
 		delay = uint32(lc.remoteChanCfg.CsvDelay)
 		delayBalance = theirBalance.ToAtoms()
 		p2wkhBalance = ourBalance.ToAtoms()
+		localFundingKey = lc.remoteChanCfg.MultiSigKey.PubKey
+		remoteFundingKey = lc.localChanCfg.MultiSigKey.PubKey
 	}
 
 	// Generate a new commitment transaction with all the latest
 	// unsettled/un-timed out HTLCs.
-	commitTx, err := CreateCommitTx(lc.fundingTxIn(), keyRing, delay,
-		delayBalance, p2wkhBalance, c.dustLimit)
+	commitTx, err := CreateCommitTx(chanType, lc.fundingTxIn(), keyRing,
+		localFundingKey, remoteFundingKey, delay, delayBalance,
+		p2wkhBalance, c.dustLimit, numHTLCs)
 	if err != nil {
 		return err
 	}
@@ -2590,6 +2638,14 @@ func (lc *LightningChannel) evaluateHTLCView(view *htlcView, ourBalance,
 		if mutateState && entry.EntryType == Settle && !remoteChain &&
 			entry.removeCommitHeightLocal == 0 {
 			lc.channelState.TotalMAtomsReceived += entry.Amount
+			lc.channelState.NumHtlcsReceived++
+		}
+
+		// Likewise, if we're failing an inbound HTLC that hasn't been
+		// processed yet, we'll count it as a failed incoming HTLC.
+		if mutateState && entry.EntryType != Settle && !remoteChain &&
+			entry.removeCommitHeightLocal == 0 {
+			lc.channelState.NumHtlcsReceivedFailed++
 		}
 
 		addEntry := lc.remoteUpdateLog.lookupHtlc(entry.ParentIndex)
@@ -2635,6 +2691,15 @@ func (lc *LightningChannel) evaluateHTLCView(view *htlcView, ourBalance,
 		if mutateState && entry.EntryType == Settle && !remoteChain &&
 			entry.removeCommitHeightLocal == 0 {
 			lc.channelState.TotalMAtomsSent += entry.Amount
+			lc.channelState.NumHtlcsSent++
+		}
+
+		// Likewise, if the remote party is failing one of our
+		// outbound HTLC's that hasn't been processed yet, we'll count
+		// it as a failed outgoing HTLC.
+		if mutateState && entry.EntryType != Settle && !remoteChain &&
+			entry.removeCommitHeightLocal == 0 {
+			lc.channelState.NumHtlcsSentFailed++
 		}
 
 		addEntry := lc.localUpdateLog.lookupHtlc(entry.ParentIndex)
@@ -2812,7 +2877,8 @@ func processFeeUpdate(feeUpdate *PaymentDescriptor, nextHeight uint64,
 // generating a new commitment for the remote party. The jobs generated by the
 // signature can be submitted to the sigPool to generate all the signatures
 // asynchronously and in parallel.
-func genRemoteHtlcSigJobs(keyRing *CommitmentKeyRing,
+func genRemoteHtlcSigJobs(chanType channeldb.ChannelType,
+	keyRing *CommitmentKeyRing,
 	localChanCfg, remoteChanCfg *channeldb.ChannelConfig,
 	remoteCommitView *commitment) ([]SignJob, chan struct{}, error) {
 
@@ -2860,7 +2926,7 @@ func genRemoteHtlcSigJobs(keyRing *CommitmentKeyRing,
 			Index: uint32(htlc.remoteOutputIndex),
 		} // TODO(decred): Tree?
 		sigJob.Tx, err = createHtlcTimeoutTx(
-			op, outputAmt, htlc.Timeout,
+			chanType, op, outputAmt, htlc.Timeout,
 			uint32(remoteChanCfg.CsvDelay),
 			keyRing.RevocationKey, keyRing.DelayKey,
 		)
@@ -2911,7 +2977,7 @@ func genRemoteHtlcSigJobs(keyRing *CommitmentKeyRing,
 			Index: uint32(htlc.remoteOutputIndex),
 		} // TODO(decred): Tree?
 		sigJob.Tx, err = createHtlcSuccessTx(
-			op, outputAmt, uint32(remoteChanCfg.CsvDelay),
+			chanType, op, outputAmt, uint32(remoteChanCfg.CsvDelay),
 			keyRing.RevocationKey, keyRing.DelayKey,
 		)
 		if err != nil {
@@ -3119,8 +3185,9 @@ func (lc *LightningChannel) validateCommitmentSanity(theirLogCounter,
 	feePerKB := filteredView.feePerKB
 
 	// Calculate the commitment fee, and subtract it from the initiator's
-	// balance.
-	commitFee := feePerKB.FeeForSize(commitSize)
+	// balance along with the value of any anchor outputs.
+	commitFee := feePerKB.FeeForSize(commitSize) +
+		anchorsValue(lc.channelState.ChanType)
 	commitFeeMAtoms := lnwire.NewMAtomsFromAtoms(commitFee)
 	if lc.channelState.IsInitiator {
 		ourBalance -= commitFeeMAtoms
@@ -3312,8 +3379,9 @@ func (lc *LightningChannel) SignNextCommitment() (lnwire.Sig, []lnwire.Sig, []ch
 	// need to generate signatures of each of them for the remote party's
 	// commitment state. We do so in two phases: first we generate and
 	// submit the set of signature jobs to the worker pool.
-	sigBatch, cancelChan, err := genRemoteHtlcSigJobs(keyRing,
-		lc.localChanCfg, lc.remoteChanCfg, newCommitView,
+	sigBatch, cancelChan, err := genRemoteHtlcSigJobs(
+		lc.channelState.ChanType, keyRing, lc.localChanCfg,
+		lc.remoteChanCfg, newCommitView,
 	)
 	if err != nil {
 		return sig, htlcSigs, nil, err
@@ -3767,7 +3835,7 @@ func (lc *LightningChannel) computeView(view *htlcView, remoteChain bool,
 		totalHtlcSize += input.HTLCOutputSize
 	}
 
-	totalCommitSize := input.CommitmentTxSize + totalHtlcSize
+	totalCommitSize := CommitSize(lc.channelState.ChanType) + totalHtlcSize
 	return ourBalance, theirBalance, totalCommitSize, filteredHTLCView
 }
 
@@ -3775,9 +3843,10 @@ func (lc *LightningChannel) computeView(view *htlcView, remoteChain bool,
 // meant to verify all the signatures for HTLC's attached to a newly created
 // commitment state. The jobs generated are fully populated, and can be sent
 // directly into the pool of workers.
-func genHtlcSigValidationJobs(localCommitmentView *commitment,
-	keyRing *CommitmentKeyRing, htlcSigs []lnwire.Sig,
-	localChanCfg, remoteChanCfg *channeldb.ChannelConfig) ([]VerifyJob, error) {
+func genHtlcSigValidationJobs(chanType channeldb.ChannelType,
+	localCommitmentView *commitment, keyRing *CommitmentKeyRing,
+	htlcSigs []lnwire.Sig, localChanCfg,
+	remoteChanCfg *channeldb.ChannelConfig) ([]VerifyJob, error) {
 
 	txHash := localCommitmentView.txn.TxHash()
 	feePerKB := localCommitmentView.feePerKB
@@ -3823,8 +3892,8 @@ func genHtlcSigValidationJobs(localCommitmentView *commitment,
 				htlcFee := htlcSuccessFee(feePerKB)
 				outputAmt := htlc.Amount.ToAtoms() - htlcFee
 
-				successTx, err := createHtlcSuccessTx(op,
-					outputAmt, uint32(localChanCfg.CsvDelay),
+				successTx, err := createHtlcSuccessTx(chanType,
+					op, outputAmt, uint32(localChanCfg.CsvDelay),
 					keyRing.RevocationKey, keyRing.DelayKey)
 				if err != nil {
 					return nil, err
@@ -3872,8 +3941,8 @@ func genHtlcSigValidationJobs(localCommitmentView *commitment,
 				htlcFee := htlcTimeoutFee(feePerKB)
 				outputAmt := htlc.Amount.ToAtoms() - htlcFee
 
-				timeoutTx, err := createHtlcTimeoutTx(op,
-					outputAmt, htlc.Timeout,
+				timeoutTx, err := createHtlcTimeoutTx(chanType,
+					op, outputAmt, htlc.Timeout,
 					uint32(localChanCfg.CsvDelay),
 					keyRing.RevocationKey, keyRing.DelayKey,
 				)
@@ -4071,8 +4140,8 @@ func (lc *LightningChannel) ReceiveNewCommitment(commitSig lnwire.Sig,
 	// pool to verify each of the HTLc signatures presented. Once
 	// generated, we'll submit these jobs to the worker pool.
 	verifyJobs, err := genHtlcSigValidationJobs(
-		localCommitmentView, keyRing, htlcSigs, lc.localChanCfg,
-		lc.remoteChanCfg,
+		lc.channelState.ChanType, localCommitmentView, keyRing,
+		htlcSigs, lc.localChanCfg, lc.remoteChanCfg,
 	)
 	if err != nil {
 		return err
@@ -4616,8 +4685,9 @@ func (lc *LightningChannel) SettleHTLC(preimage lntypes.Preimage,
 	// Now that we know the HTLC exists, before checking to see if the
 	// preimage matches, we'll ensure that we haven't already attempted to
 	// modify the HTLC.
-	if lc.remoteUpdateLog.htlcHasModification(htlcIndex) {
-		return ErrHtlcIndexAlreadySettled(htlcIndex)
+	modType, ok := lc.remoteUpdateLog.htlcModification(htlcIndex)
+	if ok {
+		return modificationErr(htlcIndex, modType)
 	}
 
 	if htlc.RHash != PaymentHash(preimage.Hash()) {
@@ -4640,7 +4710,7 @@ func (lc *LightningChannel) SettleHTLC(preimage lntypes.Preimage,
 	// With the settle added to our local log, we'll now mark the HTLC as
 	// modified to prevent ourselves from accidentally attempting a
 	// duplicate settle.
-	lc.remoteUpdateLog.markHtlcModified(htlcIndex)
+	lc.remoteUpdateLog.markHtlcModified(htlcIndex, Settle)
 
 	return nil
 }
@@ -4661,8 +4731,9 @@ func (lc *LightningChannel) ReceiveHTLCSettle(preimage lntypes.Preimage, htlcInd
 	// Now that we know the HTLC exists, before checking to see if the
 	// preimage matches, we'll ensure that they haven't already attempted
 	// to modify the HTLC.
-	if lc.localUpdateLog.htlcHasModification(htlcIndex) {
-		return ErrHtlcIndexAlreadySettled(htlcIndex)
+	modType, ok := lc.localUpdateLog.htlcModification(htlcIndex)
+	if ok {
+		return modificationErr(htlcIndex, modType)
 	}
 
 	if htlc.RHash != PaymentHash(preimage.Hash()) {
@@ -4683,7 +4754,7 @@ func (lc *LightningChannel) ReceiveHTLCSettle(preimage lntypes.Preimage, htlcInd
 	// With the settle added to the remote log, we'll now mark the HTLC as
 	// modified to prevent the remote party from accidentally attempting a
 	// duplicate settle.
-	lc.localUpdateLog.markHtlcModified(htlcIndex)
+	lc.localUpdateLog.markHtlcModified(htlcIndex, Settle)
 
 	return nil
 }
@@ -4725,8 +4796,9 @@ func (lc *LightningChannel) FailHTLC(htlcIndex uint64, reason []byte,
 
 	// Now that we know the HTLC exists, we'll ensure that we haven't
 	// already attempted to fail the HTLC.
-	if lc.remoteUpdateLog.htlcHasModification(htlcIndex) {
-		return ErrHtlcIndexAlreadyFailed(htlcIndex)
+	modType, ok := lc.remoteUpdateLog.htlcModification(htlcIndex)
+	if ok {
+		return modificationErr(htlcIndex, modType)
 	}
 
 	pd := &PaymentDescriptor{
@@ -4746,7 +4818,7 @@ func (lc *LightningChannel) FailHTLC(htlcIndex uint64, reason []byte,
 	// With the fail added to the remote log, we'll now mark the HTLC as
 	// modified to prevent ourselves from accidentally attempting a
 	// duplicate fail.
-	lc.remoteUpdateLog.markHtlcModified(htlcIndex)
+	lc.remoteUpdateLog.markHtlcModified(htlcIndex, Fail)
 
 	return nil
 }
@@ -4775,8 +4847,9 @@ func (lc *LightningChannel) MalformedFailHTLC(htlcIndex uint64,
 
 	// Now that we know the HTLC exists, we'll ensure that we haven't
 	// already attempted to fail the HTLC.
-	if lc.remoteUpdateLog.htlcHasModification(htlcIndex) {
-		return ErrHtlcIndexAlreadyFailed(htlcIndex)
+	modType, ok := lc.remoteUpdateLog.htlcModification(htlcIndex)
+	if ok {
+		return modificationErr(htlcIndex, modType)
 	}
 
 	pd := &PaymentDescriptor{
@@ -4795,7 +4868,9 @@ func (lc *LightningChannel) MalformedFailHTLC(htlcIndex uint64,
 	// With the fail added to the remote log, we'll now mark the HTLC as
 	// modified to prevent ourselves from accidentally attempting a
 	// duplicate fail.
-	lc.remoteUpdateLog.markHtlcModified(htlcIndex)
+	lc.remoteUpdateLog.markHtlcModified(
+		htlcIndex, MalformedFail,
+	)
 
 	return nil
 }
@@ -4818,8 +4893,9 @@ func (lc *LightningChannel) ReceiveFailHTLC(htlcIndex uint64, reason []byte,
 
 	// Now that we know the HTLC exists, we'll ensure that they haven't
 	// already attempted to fail the HTLC.
-	if lc.localUpdateLog.htlcHasModification(htlcIndex) {
-		return ErrHtlcIndexAlreadyFailed(htlcIndex)
+	modType, ok := lc.localUpdateLog.htlcModification(htlcIndex)
+	if ok {
+		return modificationErr(htlcIndex, modType)
 	}
 
 	pd := &PaymentDescriptor{
@@ -4836,7 +4912,7 @@ func (lc *LightningChannel) ReceiveFailHTLC(htlcIndex uint64, reason []byte,
 	// With the fail added to the remote log, we'll now mark the HTLC as
 	// modified to prevent ourselves from accidentally attempting a
 	// duplicate fail.
-	lc.localUpdateLog.markHtlcModified(htlcIndex)
+	lc.localUpdateLog.markHtlcModified(htlcIndex, Fail)
 
 	return nil
 }
@@ -4857,15 +4933,20 @@ func (lc *LightningChannel) ShortChanID() lnwire.ShortChannelID {
 
 // genHtlcScript generates the proper P2SH public key scripts for the HTLC
 // output modified by two-bits denoting if this is an incoming HTLC, and if the
-// HTLC is being applied to their commitment transaction or ours.
-func genHtlcScript(isIncoming, ourCommit bool, timeout uint32, rHash [32]byte,
-	keyRing *CommitmentKeyRing) ([]byte, []byte, error) {
+// HTLC is being applied to their commitment transaction or ours. Channels with
+// anchor outputs use HTLC scripts requiring a confirmation before the
+// non-revocation paths can be spent.
+func genHtlcScript(chanType channeldb.ChannelType, isIncoming, ourCommit bool,
+	timeout uint32, rHash [32]byte, keyRing *CommitmentKeyRing) ([]byte,
+	[]byte, error) {
 
 	var (
 		witnessScript []byte
 		err           error
 	)
 
+	confirmedSpend := chanType.HasAnchors()
+
 	// Generate the proper redeem scripts for the HTLC output modified by
 	// two-bits denoting if this is an incoming HTLC, and if the HTLC is
 	// being applied to their commitment transaction or ours.
@@ -4876,28 +4957,31 @@ func genHtlcScript(isIncoming, ourCommit bool, timeout uint32, rHash [32]byte,
 	case isIncoming && ourCommit:
 		witnessScript, err = input.ReceiverHTLCScript(timeout,
 			keyRing.RemoteHtlcKey, keyRing.LocalHtlcKey,
-			keyRing.RevocationKey, rHash[:])
+			keyRing.RevocationKey, rHash[:], confirmedSpend)
 
 	// We're being paid via an HTLC by the remote party, and the HTLC is
 	// being added to their commitment transaction, so we use the sender's
 	// version of the HTLC script.
 	case isIncoming && !ourCommit:
 		witnessScript, err = input.SenderHTLCScript(keyRing.RemoteHtlcKey,
-			keyRing.LocalHtlcKey, keyRing.RevocationKey, rHash[:])
+			keyRing.LocalHtlcKey, keyRing.RevocationKey, rHash[:],
+			confirmedSpend)
 
 	// We're sending an HTLC which is being added to our commitment
 	// transaction. Therefore, we need to use the sender's version of the
 	// HTLC script.
 	case !isIncoming && ourCommit:
 		witnessScript, err = input.SenderHTLCScript(keyRing.LocalHtlcKey,
-			keyRing.RemoteHtlcKey, keyRing.RevocationKey, rHash[:])
+			keyRing.RemoteHtlcKey, keyRing.RevocationKey, rHash[:],
+			confirmedSpend)
 
 	// Finally, we're paying the remote party via an HTLC, which is being
 	// added to their commitment transaction. Therefore, we use the
 	// receiver's version of the HTLC script.
 	case !isIncoming && !ourCommit:
 		witnessScript, err = input.ReceiverHTLCScript(timeout, keyRing.LocalHtlcKey,
-			keyRing.RemoteHtlcKey, keyRing.RevocationKey, rHash[:])
+			keyRing.RemoteHtlcKey, keyRing.RevocationKey, rHash[:],
+			confirmedSpend)
 	}
 	if err != nil {
 		return nil, nil, err
@@ -4927,8 +5011,8 @@ func (lc *LightningChannel) addHTLC(commitTx *wire.MsgTx, ourCommit bool,
 	timeout := paymentDesc.Timeout
 	rHash := paymentDesc.RHash
 
-	p2sh, witnessScript, err := genHtlcScript(isIncoming, ourCommit,
-		timeout, rHash, keyRing)
+	p2sh, witnessScript, err := genHtlcScript(lc.channelState.ChanType,
+		isIncoming, ourCommit, timeout, rHash, keyRing)
 	if err != nil {
 		return err
 	}
@@ -5000,11 +5084,24 @@ type CommitOutputResolution struct {
 
 	// MaturityDelay is the relative time-lock, in blocks for all outputs
 	// that pay to the local party within the broadcast commitment
-	// transaction. This value will be non-zero iff, this output was on our
-	// commitment transaction.
+	// transaction. This value will be non-zero if this output was on our
+	// commitment transaction, or if it was on the remote party's
+	// commitment transaction of a channel with anchors, in which case it
+	// is one block.
 	MaturityDelay uint32
 }
 
+// IsLocalCommit returns true if the output to sweep is the delayed output of
+// our own commitment transaction, which must be incubated until its CSV delay
+// expires. Otherwise, the output pays to us on the remote party's commitment
+// transaction.
+func (c *CommitOutputResolution) IsLocalCommit() bool {
+	// Only the to-self script branches on the revocation clause right
+	// away, so its first opcode tells the two outputs apart.
+	witnessScript := c.SelfOutputSignDesc.WitnessScript
+	return len(witnessScript) > 0 && witnessScript[0] == txscript.OP_IF
+}
+
 // UnilateralCloseSummary describes the details of a detected unilateral
 // channel closure. This includes the information about with which
 // transactions, and block the channel was unilaterally closed, as well as
@@ -5071,6 +5168,7 @@ func NewUnilateralCloseSummary(chanState *channeldb.OpenChannel, signer input.Si
 		AtomPerKByte(remoteCommit.FeePerKB), false, signer,
 		remoteCommit.Htlcs, keyRing, &chanState.LocalChanCfg,
 		&chanState.RemoteChanCfg, *commitSpend.SpenderTxHash,
+		chanState.ChanType,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create htlc "+
@@ -5082,7 +5180,9 @@ func NewUnilateralCloseSummary(chanState *channeldb.OpenChannel, signer input.Si
 	// Before we can generate the proper sign descriptor, we'll need to
 	// locate the output index of our non-delayed output on the commitment
 	// transaction.
-	selfP2WKH, err := input.CommitScriptUnencumbered(keyRing.NoDelayKey)
+	selfWitnessScript, selfP2WKH, maturityDelay, err := CommitScriptToRemote(
+		chanState.ChanType, keyRing.NoDelayKey,
+	)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create self commit "+
 			"script: %v", err)
@@ -5115,14 +5215,14 @@ func NewUnilateralCloseSummary(chanState *channeldb.OpenChannel, signer input.Si
 			SelfOutputSignDesc: input.SignDescriptor{
 				KeyDesc:       localPayBase,
 				SingleTweak:   keyRing.LocalCommitKeyTweak,
-				WitnessScript: selfP2WKH,
+				WitnessScript: selfWitnessScript,
 				Output: &wire.TxOut{
 					Value:    localBalance,
 					PkScript: selfP2WKH,
 				},
 				HashType: txscript.SigHashAll,
 			},
-			MaturityDelay: 0,
+			MaturityDelay: maturityDelay,
 		}
 
 		// If this is a tweakless commitment, then we can safely blank
@@ -5146,6 +5246,10 @@ func NewUnilateralCloseSummary(chanState *channeldb.OpenChannel, signer input.Si
 		RemoteNextRevocation:    chanState.RemoteNextRevocation,
 		ShortChanID:             chanState.ShortChanID(),
 		LocalChanConfig:         chanState.LocalChanCfg,
+		CloseInitiator:          channeldb.InitiatorRemote,
+		Resolutions: CommitCloseResolutions(
+			commitTxBroadcast, commitResolution, htlcResolutions,
+		),
 	}
 
 	// Attempt to add a channel sync message to the close summary.
@@ -5192,7 +5296,10 @@ type IncomingHtlcResolution struct {
 	// pass after the SignedSuccessTx is confirmed in the chain before the
 	// output can be swept.
 	//
-	// NOTE: If SignedSuccessTx is nil, then this field isn't needed.
+	// NOTE: If SignedSuccessTx is nil, then this is the relative time lock
+	// that must pass after the remote commitment transaction is confirmed
+	// before the HTLC output can be swept, which is only non-zero for
+	// channels with anchors.
 	CsvDelay uint32
 
 	// ClaimOutpoint is the final outpoint that needs to be spent in order
@@ -5232,7 +5339,10 @@ type OutgoingHtlcResolution struct {
 	// pass after the SignedTimeoutTx is confirmed in the chain before the
 	// output can be swept.
 	//
-	// NOTE: If SignedTimeoutTx is nil, then this field isn't needed.
+	// NOTE: If SignedTimeoutTx is nil, then this is the relative time lock
+	// that must pass after the remote commitment transaction is confirmed
+	// before the HTLC output can be swept, which is only non-zero for
+	// channels with anchors.
 	CsvDelay uint32
 
 	// ClaimOutpoint is the final outpoint that needs to be spent in order
@@ -5268,7 +5378,7 @@ type HtlcResolutions struct {
 func newOutgoingHtlcResolution(signer input.Signer, localChanCfg *channeldb.ChannelConfig,
 	commitHash chainhash.Hash, htlc *channeldb.HTLC, keyRing *CommitmentKeyRing,
 	feePerKB AtomPerKByte, dustLimit dcrutil.Amount, csvDelay uint32, localCommit bool,
-) (*OutgoingHtlcResolution, error) {
+	chanType channeldb.ChannelType) (*OutgoingHtlcResolution, error) {
 
 	op := wire.OutPoint{
 		Hash:  commitHash,
@@ -5284,7 +5394,7 @@ func newOutgoingHtlcResolution(signer input.Signer, localChanCfg *channeldb.Chan
 		htlcReceiverScript, err := input.ReceiverHTLCScript(
 			htlc.RefundTimeout, keyRing.LocalHtlcKey,
 			keyRing.RemoteHtlcKey, keyRing.RevocationKey,
-			htlc.RHash[:],
+			htlc.RHash[:], chanType.HasAnchors(),
 		)
 		if err != nil {
 			return nil, err
@@ -5299,6 +5409,7 @@ func newOutgoingHtlcResolution(signer input.Signer, localChanCfg *channeldb.Chan
 		return &OutgoingHtlcResolution{
 			Expiry:        htlc.RefundTimeout,
 			ClaimOutpoint: op,
+			CsvDelay:      HtlcSecondLevelInputSequence(chanType),
 			SweepSignDesc: input.SignDescriptor{
 				KeyDesc:       localChanCfg.HtlcBasePoint,
 				SingleTweak:   keyRing.LocalHtlcKeyTweak,
@@ -5324,8 +5435,8 @@ func newOutgoingHtlcResolution(signer input.Signer, localChanCfg *channeldb.Chan
 	// With the fee calculated, re-construct the second level timeout
 	// transaction.
 	timeoutTx, err := createHtlcTimeoutTx(
-		op, secondLevelOutputAmt, htlc.RefundTimeout, csvDelay,
-		keyRing.RevocationKey, keyRing.DelayKey,
+		chanType, op, secondLevelOutputAmt, htlc.RefundTimeout,
+		csvDelay, keyRing.RevocationKey, keyRing.DelayKey,
 	)
 	if err != nil {
 		return nil, err
@@ -5335,7 +5446,8 @@ func newOutgoingHtlcResolution(signer input.Signer, localChanCfg *channeldb.Chan
 	// that's capable of generating the signature required to spend the
 	// HTLC output using the timeout transaction.
 	htlcCreationScript, err := input.SenderHTLCScript(keyRing.LocalHtlcKey,
-		keyRing.RemoteHtlcKey, keyRing.RevocationKey, htlc.RHash[:])
+		keyRing.RemoteHtlcKey, keyRing.RevocationKey, htlc.RHash[:],
+		chanType.HasAnchors())
 	if err != nil {
 		return nil, err
 	}
@@ -5412,7 +5524,7 @@ func newOutgoingHtlcResolution(signer input.Signer, localChanCfg *channeldb.Chan
 func newIncomingHtlcResolution(signer input.Signer, localChanCfg *channeldb.ChannelConfig,
 	commitHash chainhash.Hash, htlc *channeldb.HTLC, keyRing *CommitmentKeyRing,
 	feePerKB AtomPerKByte, dustLimit dcrutil.Amount, csvDelay uint32,
-	localCommit bool) (*IncomingHtlcResolution, error) {
+	localCommit bool, chanType channeldb.ChannelType) (*IncomingHtlcResolution, error) {
 
 	op := wire.OutPoint{
 		Hash:  commitHash,
@@ -5427,6 +5539,7 @@ func newIncomingHtlcResolution(signer input.Signer, localChanCfg *channeldb.Chan
 		htlcSenderScript, err := input.SenderHTLCScript(
 			keyRing.RemoteHtlcKey, keyRing.LocalHtlcKey,
 			keyRing.RevocationKey, htlc.RHash[:],
+			chanType.HasAnchors(),
 		)
 		if err != nil {
 			return nil, err
@@ -5440,7 +5553,7 @@ func newIncomingHtlcResolution(signer input.Signer, localChanCfg *channeldb.Chan
 		// input.SignDescriptor needed to sweep the output.
 		return &IncomingHtlcResolution{
 			ClaimOutpoint: op,
-			CsvDelay:      csvDelay,
+			CsvDelay:      HtlcSecondLevelInputSequence(chanType),
 			SweepSignDesc: input.SignDescriptor{
 				KeyDesc:       localChanCfg.HtlcBasePoint,
 				SingleTweak:   keyRing.LocalHtlcKeyTweak,
@@ -5461,7 +5574,7 @@ func newIncomingHtlcResolution(signer input.Signer, localChanCfg *channeldb.Chan
 	htlcFee := htlcSuccessFee(feePerKB)
 	secondLevelOutputAmt := htlc.Amt.ToAtoms() - htlcFee
 	successTx, err := createHtlcSuccessTx(
-		op, secondLevelOutputAmt, csvDelay,
+		chanType, op, secondLevelOutputAmt, csvDelay,
 		keyRing.RevocationKey, keyRing.DelayKey,
 	)
 	if err != nil {
@@ -5472,7 +5585,7 @@ func newIncomingHtlcResolution(signer input.Signer, localChanCfg *channeldb.Chan
 	// SignDesc needed spend the HTLC output using the success transaction.
 	htlcCreationScript, err := input.ReceiverHTLCScript(htlc.RefundTimeout,
 		keyRing.RemoteHtlcKey, keyRing.LocalHtlcKey,
-		keyRing.RevocationKey, htlc.RHash[:],
+		keyRing.RevocationKey, htlc.RHash[:], chanType.HasAnchors(),
 	)
 	if err != nil {
 		return nil, err
@@ -5570,7 +5683,8 @@ func (r *OutgoingHtlcResolution) HtlcPoint() wire.OutPoint {
 func extractHtlcResolutions(feePerKB AtomPerKByte, ourCommit bool,
 	signer input.Signer, htlcs []channeldb.HTLC, keyRing *CommitmentKeyRing,
 	localChanCfg, remoteChanCfg *channeldb.ChannelConfig,
-	commitHash chainhash.Hash) (*HtlcResolutions, error) {
+	commitHash chainhash.Hash, chanType channeldb.ChannelType) (
+	*HtlcResolutions, error) {
 
 	// TODO(roasbeef): don't need to swap csv delay?
 	dustLimit := remoteChanCfg.DustLimit
@@ -5599,6 +5713,7 @@ func extractHtlcResolutions(feePerKB AtomPerKByte, ourCommit bool,
 			ihr, err := newIncomingHtlcResolution(
 				signer, localChanCfg, commitHash, &htlc, keyRing,
 				feePerKB, dustLimit, uint32(csvDelay), ourCommit,
+				chanType,
 			)
 			if err != nil {
 				return nil, err
@@ -5611,6 +5726,7 @@ func extractHtlcResolutions(feePerKB AtomPerKByte, ourCommit bool,
 		ohr, err := newOutgoingHtlcResolution(
 			signer, localChanCfg, commitHash, &htlc, keyRing,
 			feePerKB, dustLimit, uint32(csvDelay), ourCommit,
+			chanType,
 		)
 		if err != nil {
 			return nil, err
@@ -5654,6 +5770,14 @@ type LocalForceCloseSummary struct {
 	// HTLC's, we'll need to go to the second level to sweep them fully.
 	HtlcResolutions *HtlcResolutions
 
+	// AnchorResolution contains the data required to sweep our anchor
+	// output, which can be used to bump the fee of the commitment
+	// transaction using CPFP.
+	//
+	// NOTE: If the channel doesn't use anchors, or there is no anchor
+	// output paying to us, then this will be nil.
+	AnchorResolution *AnchorResolution
+
 	// ChanSnapshot is a snapshot of the final state of the channel at the
 	// time the summary was created.
 	ChanSnapshot channeldb.ChannelSnapshot
@@ -5704,7 +5828,9 @@ func (lc *LightningChannel) ForceClose() (*LocalForceCloseSummary, error) {
 
 // NewLocalForceCloseSummary generates a LocalForceCloseSummary from the given
 // channel state.  The passed commitTx must be a fully signed commitment
-// transaction corresponding to localCommit.
+// transaction corresponding to localCommit. As our delayed output is located
+// by its script, a restored channel which lost its local commitment only needs
+// to pass one carrying the height of commitTx.
 func NewLocalForceCloseSummary(chanState *channeldb.OpenChannel, signer input.Signer,
 	commitTx *wire.MsgTx, localCommit channeldb.ChannelCommitment) (
 	*LocalForceCloseSummary, error) {
@@ -5741,6 +5867,7 @@ func NewLocalForceCloseSummary(chanState *channeldb.OpenChannel, signer input.Si
 	var (
 		delayIndex  uint32
 		delayScript []byte
+		delayValue  int64
 	)
 	for i, txOut := range commitTx.TxOut {
 		if !bytes.Equal(payToUsScriptHash, txOut.PkScript) {
@@ -5749,6 +5876,7 @@ func NewLocalForceCloseSummary(chanState *channeldb.OpenChannel, signer input.Si
 
 		delayIndex = uint32(i)
 		delayScript = txOut.PkScript
+		delayValue = txOut.Value
 		break
 	}
 
@@ -5763,7 +5891,6 @@ func NewLocalForceCloseSummary(chanState *channeldb.OpenChannel, signer input.Si
 		singleTweak := input.SingleTweakBytes(
 			commitPoint, chanState.LocalChanCfg.DelayBasePoint.PubKey,
 		)
-		localBalance := localCommit.LocalBalance
 		commitResolution = &CommitOutputResolution{
 			SelfOutPoint: wire.OutPoint{
 				Hash:  commitTx.TxHash(),
@@ -5775,7 +5902,7 @@ func NewLocalForceCloseSummary(chanState *channeldb.OpenChannel, signer input.Si
 				WitnessScript: selfScript,
 				Output: &wire.TxOut{
 					PkScript: delayScript,
-					Value:    int64(localBalance.ToAtoms()),
+					Value:    delayValue,
 				},
 				HashType: txscript.SigHashAll,
 			},
@@ -5790,21 +5917,85 @@ func NewLocalForceCloseSummary(chanState *channeldb.OpenChannel, signer input.Si
 	htlcResolutions, err := extractHtlcResolutions(
 		AtomPerKByte(localCommit.FeePerKB), true, signer,
 		localCommit.Htlcs, keyRing, &chanState.LocalChanCfg,
-		&chanState.RemoteChanCfg, txHash,
+		&chanState.RemoteChanCfg, txHash, chanState.ChanType,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	anchorResolution, err := NewAnchorResolution(chanState, commitTx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &LocalForceCloseSummary{
 		ChanPoint:        chanState.FundingOutpoint,
 		CloseTx:          commitTx,
 		CommitResolution: commitResolution,
 		HtlcResolutions:  htlcResolutions,
+		AnchorResolution: anchorResolution,
 		ChanSnapshot:     *chanState.Snapshot(),
 	}, nil
 }
 
+// AnchorResolution holds the information necessary to spend our commitment tx
+// anchor.
+type AnchorResolution struct {
+	// AnchorSignDescriptor is the sign descriptor for our anchor.
+	AnchorSignDescriptor input.SignDescriptor
+
+	// CommitAnchor is the anchor outpoint on the commit tx.
+	CommitAnchor wire.OutPoint
+}
+
+// NewAnchorResolution returns the information that is required to sweep the
+// local anchor of the given commitment transaction. If the channel doesn't use
+// anchors or the commitment doesn't carry an anchor paying to us, then a nil
+// resolution is returned.
+func NewAnchorResolution(chanState *channeldb.OpenChannel,
+	commitTx *wire.MsgTx) (*AnchorResolution, error) {
+
+	if !chanState.ChanType.HasAnchors() {
+		return nil, nil
+	}
+
+	// Derive our local anchor script, which is keyed to our funding key
+	// on both versions of the commitment transaction.
+	localAnchor, err := input.CommitScriptAnchor(
+		chanState.LocalChanCfg.MultiSigKey.PubKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+	anchorPkScript, err := input.ScriptHashPkScript(localAnchor)
+	if err != nil {
+		return nil, err
+	}
+
+	// Look up the script on the commitment transaction. It may not be
+	// present if there is no output paying to us.
+	found, index := input.FindScriptOutputIndex(commitTx, anchorPkScript)
+	if !found {
+		return nil, nil
+	}
+
+	return &AnchorResolution{
+		CommitAnchor: wire.OutPoint{
+			Hash:  commitTx.TxHash(),
+			Index: index,
+		},
+		AnchorSignDescriptor: input.SignDescriptor{
+			KeyDesc:       chanState.LocalChanCfg.MultiSigKey,
+			WitnessScript: localAnchor,
+			Output: &wire.TxOut{
+				PkScript: anchorPkScript,
+				Value:    int64(anchorSize),
+			},
+			HashType: txscript.SigHashAll,
+		},
+	}, nil
+}
+
 // CreateCloseProposal is used by both parties in a cooperative channel close
 // workflow to generate proposed close transactions and signatures. This method
 // should only be executed once all pending HTLCs (if any) on the channel have
@@ -5836,8 +6027,9 @@ func (lc *LightningChannel) CreateCloseProposal(proposedFee dcrutil.Amount,
 	theirBalance := localCommit.RemoteBalance.ToAtoms()
 
 	// We'll make sure we account for the complete balance by adding the
-	// current dangling commitment fee to the balance of the initiator.
-	commitFee := localCommit.CommitFee
+	// current dangling commitment fee and the value of any anchor outputs
+	// to the balance of the initiator.
+	commitFee := localCommit.CommitFee + anchorsValue(lc.channelState.ChanType)
 	if lc.channelState.IsInitiator {
 		ourBalance = ourBalance - proposedFee + commitFee
 	} else {
@@ -5901,8 +6093,9 @@ func (lc *LightningChannel) CompleteCooperativeClose(localSig, remoteSig []byte,
 	theirBalance := localCommit.RemoteBalance.ToAtoms()
 
 	// We'll make sure we account for the complete balance by adding the
-	// current dangling commitment fee to the balance of the initiator.
-	commitFee := localCommit.CommitFee
+	// current dangling commitment fee and the value of any anchor outputs
+	// to the balance of the initiator.
+	commitFee := localCommit.CommitFee + anchorsValue(lc.channelState.ChanType)
 	if lc.channelState.IsInitiator {
 		ourBalance = ourBalance - proposedFee + commitFee
 	} else {
@@ -5985,8 +6178,10 @@ func (lc *LightningChannel) availableBalance() (lnwire.MilliAtom, int64) {
 		lc.computeView(htlcView, false, false)
 
 	// If we are the channel initiator, we must remember to subtract the
-	// commitment fee from our available balance.
-	commitFee := filteredView.feePerKB.FeeForSize(commitSize)
+	// commitment fee and the value of any anchor outputs from our
+	// available balance.
+	commitFee := filteredView.feePerKB.FeeForSize(commitSize) +
+		anchorsValue(lc.channelState.ChanType)
 	if lc.channelState.IsInitiator {
 		ourBalance -= lnwire.NewMAtomsFromAtoms(commitFee)
 	}
@@ -6143,14 +6338,39 @@ func (lc *LightningChannel) generateRevocation(height uint64) (*lnwire.RevokeAnd
 	return revocationMsg, nil
 }
 
+// CommitSize returns the base size of a commitment transaction of the given
+// channel type, without any HTLCs.
+func CommitSize(chanType channeldb.ChannelType) int64 {
+	if chanType.HasAnchors() {
+		return input.AnchorCommitmentTxSize
+	}
+
+	return input.CommitmentTxSize
+}
+
+// anchorsValue returns the total value of the anchor outputs the initiator of
+// a channel of the given type must fund on every commitment transaction.
+func anchorsValue(chanType channeldb.ChannelType) dcrutil.Amount {
+	if chanType.HasAnchors() {
+		return 2 * anchorSize
+	}
+
+	return 0
+}
+
 // CreateCommitTx creates a commitment transaction, spending from specified
 // funding output. The commitment transaction contains two outputs: one paying
 // to the "owner" of the commitment transaction which can be spent after a
 // relative block delay or revocation event, and the other paying the
-// counterparty within the channel, which can be spent immediately.
-func CreateCommitTx(fundingOutput wire.TxIn,
-	keyRing *CommitmentKeyRing, csvTimeout uint32,
-	amountToSelf, amountToThem, dustLimit dcrutil.Amount) (*wire.MsgTx, error) {
+// counterparty within the channel, which can be spent immediately. If the
+// channel type has anchors, an anchor output spendable with the funding key of
+// each party is added for every party that has an output or when there are
+// HTLCs on the commitment.
+func CreateCommitTx(chanType channeldb.ChannelType, fundingOutput wire.TxIn,
+	keyRing *CommitmentKeyRing, localFundingKey,
+	remoteFundingKey *secp256k1.PublicKey, csvTimeout uint32,
+	amountToSelf, amountToThem, dustLimit dcrutil.Amount,
+	numHTLCs int64) (*wire.MsgTx, error) {
 
 	// First, we create the script for the delayed "pay-to-self" output.
 	// This output has 2 main redemption clauses: either we can redeem the
@@ -6168,8 +6388,12 @@ func CreateCommitTx(fundingOutput wire.TxIn,
 	}
 
 	// Next, we create the script paying to them. This is just a regular
-	// P2PKH output, without any added CSV delay.
-	theirWitnessKeyHash, err := input.CommitScriptUnencumbered(keyRing.NoDelayKey)
+	// P2PKH output, without any added CSV delay, unless the channel has
+	// anchors, in which case it requires a confirmation before it can be
+	// spent.
+	_, theirWitnessKeyHash, _, err := CommitScriptToRemote(
+		chanType, keyRing.NoDelayKey,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -6182,14 +6406,16 @@ func CreateCommitTx(fundingOutput wire.TxIn,
 	commitTx.AddTxIn(&fundingOutput)
 
 	// Avoid creating dust outputs within the commitment transaction.
-	if amountToSelf >= dustLimit {
+	localOutput := amountToSelf >= dustLimit
+	if localOutput {
 		commitTx.AddTxOut(&wire.TxOut{
 			PkScript: payToUsScriptHash,
 			Value:    int64(amountToSelf),
 			Version:  scriptVersion,
 		})
 	}
-	if amountToThem >= dustLimit {
+	remoteOutput := amountToThem >= dustLimit
+	if remoteOutput {
 		commitTx.AddTxOut(&wire.TxOut{
 			PkScript: theirWitnessKeyHash,
 			Value:    int64(amountToThem),
@@ -6197,9 +6423,92 @@ func CreateCommitTx(fundingOutput wire.TxIn,
 		})
 	}
 
+	// If this channel type has anchors, we'll also add those. Each party
+	// only gets an anchor if they have an output of their own to protect,
+	// or if there are HTLCs that will need to be resolved on chain.
+	if !chanType.HasAnchors() {
+		return commitTx, nil
+	}
+
+	if localOutput || numHTLCs > 0 {
+		anchorOut, err := anchorTxOut(localFundingKey)
+		if err != nil {
+			return nil, err
+		}
+		commitTx.AddTxOut(anchorOut)
+	}
+	if remoteOutput || numHTLCs > 0 {
+		anchorOut, err := anchorTxOut(remoteFundingKey)
+		if err != nil {
+			return nil, err
+		}
+		commitTx.AddTxOut(anchorOut)
+	}
+
 	return commitTx, nil
 }
 
+// anchorTxOut returns the anchor output spendable by the given funding key.
+func anchorTxOut(fundingKey *secp256k1.PublicKey) (*wire.TxOut, error) {
+	anchorScript, err := input.CommitScriptAnchor(fundingKey)
+	if err != nil {
+		return nil, err
+	}
+	anchorPkScript, err := input.ScriptHashPkScript(anchorScript)
+	if err != nil {
+		return nil, err
+	}
+
+	return &wire.TxOut{
+		PkScript: anchorPkScript,
+		Value:    int64(anchorSize),
+		Version:  scriptVersion,
+	}, nil
+}
+
+// CommitScriptToRemote returns the redeem script and the pkScript of the
+// output paying to the non-owner of a commitment transaction of the given
+// channel type, along with the number of blocks the output must be confirmed
+// for before it can be spent. For channels with anchors, this is a P2SH output
+// that can only be spent after one confirmation. Otherwise, it is a regular
+// P2PKH output, in which case the pkScript is also returned as the redeem
+// script.
+func CommitScriptToRemote(chanType channeldb.ChannelType,
+	key *secp256k1.PublicKey) ([]byte, []byte, uint32, error) {
+
+	if !chanType.HasAnchors() {
+		pkScript, err := input.CommitScriptUnencumbered(key)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+
+		return pkScript, pkScript, 0, nil
+	}
+
+	redeemScript, err := input.CommitScriptToRemoteConfirmed(key)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	pkScript, err := input.ScriptHashPkScript(redeemScript)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	return redeemScript, pkScript, 1, nil
+}
+
+// HtlcSecondLevelInputSequence returns the sequence of the input of the
+// second level HTLC transactions of the given channel type. The HTLC outputs
+// of channels with anchors can only be spent after one confirmation, so the
+// sequence of the second level transactions must signal it.
+func HtlcSecondLevelInputSequence(chanType channeldb.ChannelType) uint32 {
+	if chanType.HasAnchors() {
+		return 1
+	}
+
+	return 0
+}
+
 // CreateCooperativeCloseTx creates a transaction which if signed by both
 // parties, then broadcast cooperatively closes an active channel. The creation
 // of the closure transaction is modified by a boolean indicating if the party
@@ -6242,7 +6551,7 @@ func CreateCooperativeCloseTx(fundingTxIn wire.TxIn,
 // CalcFee returns the commitment fee to use for the given
 // fee rate (fee-per-kw).
 func (lc *LightningChannel) CalcFee(feeRate AtomPerKByte) dcrutil.Amount {
-	return feeRate.FeeForSize(input.CommitmentTxSize)
+	return feeRate.FeeForSize(CommitSize(lc.channelState.ChanType))
 }
 
 // MaxFeeRate returns the maximum fee rate given an allocation of the channel
@@ -6368,6 +6677,69 @@ func (lc *LightningChannel) ActiveHtlcs() []channeldb.HTLC {
 	return activeHtlcs
 }
 
+// HtlcExposure summarizes the HTLCs pending on a channel, which may cost us
+// their value if the channel has to be force closed.
+type HtlcExposure struct {
+	// Value is the total value of the pending HTLCs.
+	Value lnwire.MilliAtom
+
+	// NumHtlcs is the number of pending HTLCs.
+	NumHtlcs int
+
+	// DustValue is the total value of the pending HTLCs that are dust on
+	// our commitment. Dust HTLCs don't have an output of their own, their
+	// value goes to fees instead.
+	DustValue lnwire.MilliAtom
+}
+
+// HtlcExposure returns the exposure of the channel to the HTLCs offered by
+// either party that haven't been removed from the channel yet, including those
+// that aren't committed to yet.
+func (lc *LightningChannel) HtlcExposure() HtlcExposure {
+	lc.RLock()
+	defer lc.RUnlock()
+
+	var exposure HtlcExposure
+	addLog := func(log *updateLog, incoming bool) {
+		for _, e := range log.htlcIndex {
+			htlc := e.Value.(*PaymentDescriptor)
+
+			exposure.Value += htlc.Amount
+			exposure.NumHtlcs++
+			if lc.isDustHtlc(incoming, htlc.Amount) {
+				exposure.DustValue += htlc.Amount
+			}
+		}
+	}
+	addLog(lc.localUpdateLog, false)
+	addLog(lc.remoteUpdateLog, true)
+
+	return exposure
+}
+
+// IsDustHtlc returns true if an HTLC of the given amount, offered by the remote
+// party if incoming is true or by us otherwise, would be dust on our current
+// commitment.
+func (lc *LightningChannel) IsDustHtlc(incoming bool,
+	amt lnwire.MilliAtom) bool {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	return lc.isDustHtlc(incoming, amt)
+}
+
+// isDustHtlc is the private, non mutexed version of IsDustHtlc.
+func (lc *LightningChannel) isDustHtlc(incoming bool,
+	amt lnwire.MilliAtom) bool {
+
+	feePerKB := AtomPerKByte(lc.channelState.LocalCommitment.FeePerKB)
+	return htlcIsDust(
+		incoming, true, feePerKB, amt.ToAtoms(),
+		lc.channelState.LocalChanCfg.DustLimit,
+	)
+}
+
 // LocalChanReserve returns our local ChanReserve requirement for the remote party.
 func (lc *LightningChannel) LocalChanReserve() dcrutil.Amount {
 	return lc.localChanCfg.ChanReserve
@@ -318,6 +318,20 @@ func testAddSettleWorkflow(t *testing.T, tweakless bool) {
 		t.Fatalf("bob atoms sent incorrect %v vs %v expected",
 			bobChannel.channelState.TotalMAtomsSent, 0)
 	}
+	if aliceChannel.channelState.NumHtlcsSent != 1 ||
+		aliceChannel.channelState.NumHtlcsReceived != 0 {
+
+		t.Fatalf("alice htlcs sent/received incorrect: %v/%v",
+			aliceChannel.channelState.NumHtlcsSent,
+			aliceChannel.channelState.NumHtlcsReceived)
+	}
+	if bobChannel.channelState.NumHtlcsReceived != 1 ||
+		bobChannel.channelState.NumHtlcsSent != 0 {
+
+		t.Fatalf("bob htlcs sent/received incorrect: %v/%v",
+			bobChannel.channelState.NumHtlcsSent,
+			bobChannel.channelState.NumHtlcsReceived)
+	}
 	if bobChannel.currentHeight != 2 {
 		t.Fatalf("bob has incorrect commitment height, %v vs %v",
 			bobChannel.currentHeight, 2)
@@ -6386,6 +6400,156 @@ func TestDuplicateSettleRejection(t *testing.T) {
 	}
 }
 
+// resolutionCrashPoint is a point of the settle and fail pipelines at which
+// the resolution of an HTLC is replayed.
+type resolutionCrashPoint uint8
+
+const (
+	// resolutionApplied is the crash point right after the resolution was
+	// added to the update log.
+	resolutionApplied resolutionCrashPoint = iota
+
+	// resolutionSigned is the crash point right after a commitment
+	// including the resolution was signed.
+	resolutionSigned
+
+	// resolutionCommitted is the crash point right after a full state
+	// transition locked in the resolution.
+	resolutionCommitted
+)
+
+// TestHtlcResolutionReplay tests that replaying the resolution of an incoming
+// HTLC at any point of the settle and fail pipelines is rejected with an error
+// identifying the pending resolution, both before and after a restart, and
+// that the resolution can be applied again if it was lost by a restart.
+func TestHtlcResolutionReplay(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		settle     bool
+		crashPoint resolutionCrashPoint
+	}{
+		{"settle applied", true, resolutionApplied},
+		{"settle signed", true, resolutionSigned},
+		{"settle committed", true, resolutionCommitted},
+		{"fail applied", false, resolutionApplied},
+		{"fail signed", false, resolutionSigned},
+		{"fail committed", false, resolutionCommitted},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			testHtlcResolutionReplay(
+				t, testCase.settle, testCase.crashPoint,
+			)
+		})
+	}
+}
+
+// testHtlcResolutionReplay resolves an HTLC offered by Alice to Bob up to the
+// given crash point, then asserts that Bob rejects a replay of the resolution,
+// as well as a conflicting one, before and after restarting.
+func testHtlcResolutionReplay(t *testing.T, settle bool,
+	crashPoint resolutionCrashPoint) {
+
+	aliceChannel, bobChannel, cleanUp, err := CreateTestChannels(true)
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	htlcAmount := lnwire.NewMAtomsFromAtoms(20000)
+	htlcAlice, alicePreimage := createHTLC(0, htlcAmount)
+	if _, err := aliceChannel.AddHTLC(htlcAlice, nil); err != nil {
+		t.Fatalf("alice unable to add htlc: %v", err)
+	}
+	if _, err := bobChannel.ReceiveHTLC(htlcAlice); err != nil {
+		t.Fatalf("unable to recv htlc: %v", err)
+	}
+	if err := ForceStateTransition(aliceChannel, bobChannel); err != nil {
+		t.Fatalf("unable to complete state update: %v", err)
+	}
+
+	settleHtlc := func(channel *LightningChannel) error {
+		return channel.SettleHTLC(alicePreimage, 0, nil, nil, nil)
+	}
+	failHtlc := func(channel *LightningChannel) error {
+		return channel.FailHTLC(0, []byte("failreason"), nil, nil, nil)
+	}
+
+	resolve, conflict := failHtlc, settleHtlc
+	var expErr error = ErrHtlcIndexAlreadyFailed(0)
+	if settle {
+		resolve, conflict = settleHtlc, failHtlc
+		expErr = ErrHtlcIndexAlreadySettled(0)
+	}
+
+	if err := resolve(bobChannel); err != nil {
+		t.Fatalf("unable to resolve htlc: %v", err)
+	}
+	if settle {
+		err = aliceChannel.ReceiveHTLCSettle(alicePreimage, 0)
+	} else {
+		err = aliceChannel.ReceiveFailHTLC(0, []byte("failreason"))
+	}
+	if err != nil {
+		t.Fatalf("unable to receive resolution: %v", err)
+	}
+
+	switch crashPoint {
+	case resolutionSigned:
+		if _, _, _, err := bobChannel.SignNextCommitment(); err != nil {
+			t.Fatalf("unable to sign commitment: %v", err)
+		}
+
+	case resolutionCommitted:
+		err := ForceStateTransition(bobChannel, aliceChannel)
+		if err != nil {
+			t.Fatalf("unable to complete state update: %v", err)
+		}
+	}
+
+	assertReplay := func(channel *LightningChannel) {
+		t.Helper()
+
+		for _, replay := range []func(*LightningChannel) error{
+			resolve, conflict,
+		} {
+			err := replay(channel)
+			_, unknown := err.(ErrUnknownHtlcIndex)
+			switch {
+			case crashPoint == resolutionCommitted && !unknown:
+				t.Fatalf("expected ErrUnknownHtlcIndex, got %v",
+					err)
+
+			case crashPoint != resolutionCommitted && err != expErr:
+				t.Fatalf("expected %v, got %v", expErr, err)
+			}
+		}
+	}
+
+	// Replaying the resolution, or applying a conflicting one, should be
+	// rejected with an error identifying the pending resolution.
+	assertReplay(bobChannel)
+
+	// The same should hold after a restart, unless the resolution was
+	// never signed and thus wasn't persisted.
+	bobChannel, err = restartChannel(bobChannel)
+	if err != nil {
+		t.Fatalf("unable to restart channel: %v", err)
+	}
+
+	if crashPoint == resolutionApplied {
+		if err := resolve(bobChannel); err != nil {
+			t.Fatalf("unable to resolve htlc again: %v", err)
+		}
+	}
+
+	assertReplay(bobChannel)
+}
+
 // TestChannelRestoreCommitHeight tests that the local and remote commit
 // heights of HTLCs are set correctly across restores.
 func TestChannelRestoreCommitHeight(t *testing.T) {
@@ -0,0 +1,98 @@
+package lnwallet
+
+import (
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/channeldb"
+)
+
+// closeResolutionSet accumulates the outputs of a closing transaction that
+// belong to us.
+type closeResolutionSet struct {
+	closeTx     *wire.MsgTx
+	closeTxHash chainhash.Hash
+	resolutions []channeldb.CloseResolution
+}
+
+// add records the output of the closing transaction at the given outpoint.
+// Outpoints of other transactions are ignored.
+func (s *closeResolutionSet) add(resType channeldb.ResolutionType,
+	outPoint wire.OutPoint) {
+
+	if outPoint.Hash != s.closeTxHash ||
+		int(outPoint.Index) >= len(s.closeTx.TxOut) {
+
+		return
+	}
+
+	s.resolutions = append(s.resolutions, channeldb.CloseResolution{
+		Type:     resType,
+		OutPoint: outPoint,
+		Amount:   dcrutil.Amount(s.closeTx.TxOut[outPoint.Index].Value),
+	})
+}
+
+// newCloseResolutionSet returns an empty set of the outputs of closeTx.
+func newCloseResolutionSet(closeTx *wire.MsgTx) *closeResolutionSet {
+	return &closeResolutionSet{
+		closeTx:     closeTx,
+		closeTxHash: closeTx.TxHash(),
+	}
+}
+
+// CommitCloseResolutions returns the outputs of the commitment transaction
+// commitTx that belong to us according to its commitment and htlc
+// resolutions, as recorded in the close summary of the channel. The htlc
+// outputs that must first be spent by a second-level transaction are
+// included, as they're still outputs of the commitment.
+func CommitCloseResolutions(commitTx *wire.MsgTx,
+	commitRes *CommitOutputResolution,
+	htlcRes *HtlcResolutions) []channeldb.CloseResolution {
+
+	set := newCloseResolutionSet(commitTx)
+
+	if commitRes != nil {
+		set.add(channeldb.ResolutionTypeCommit, commitRes.SelfOutPoint)
+	}
+
+	if htlcRes == nil {
+		return set.resolutions
+	}
+
+	for _, htlc := range htlcRes.IncomingHTLCs {
+		outPoint := htlc.ClaimOutpoint
+		if htlc.SignedSuccessTx != nil {
+			outPoint = htlc.SignedSuccessTx.TxIn[0].PreviousOutPoint
+		}
+		set.add(channeldb.ResolutionTypeIncomingHtlc, outPoint)
+	}
+
+	for _, htlc := range htlcRes.OutgoingHTLCs {
+		outPoint := htlc.ClaimOutpoint
+		if htlc.SignedTimeoutTx != nil {
+			outPoint = htlc.SignedTimeoutTx.TxIn[0].PreviousOutPoint
+		}
+		set.add(channeldb.ResolutionTypeOutgoingHtlc, outPoint)
+	}
+
+	return set.resolutions
+}
+
+// CloseResolutions returns the outputs of the breach transaction that belong
+// to us, either directly or once claimed by the justice transaction.
+func (b *BreachRetribution) CloseResolutions() []channeldb.CloseResolution {
+	set := newCloseResolutionSet(b.BreachTransaction)
+
+	if b.LocalOutputSignDesc != nil {
+		set.add(channeldb.ResolutionTypeCommit, b.LocalOutpoint)
+	}
+	if b.RemoteOutputSignDesc != nil {
+		set.add(channeldb.ResolutionTypeBreach, b.RemoteOutpoint)
+	}
+	for _, htlc := range b.HtlcRetributions {
+		set.add(channeldb.ResolutionTypeBreach, htlc.OutPoint)
+	}
+
+	return set.resolutions
+}
@@ -55,4 +55,9 @@ type Config struct {
 	// NetParams is the set of parameters that tells the wallet which chain
 	// it will be operating on.
 	NetParams chaincfg.Params
+
+	// ChangeAddressType is the type of address used for the change outputs
+	// of funding transactions. As WitnessPubKey addresses aren't supported
+	// in Decred, its zero value selects the default of PubKeyHash.
+	ChangeAddressType AddressType
 }
@@ -11,9 +11,9 @@ const (
 )
 
 var (
-	// We currently only support full chain sync (no spv), so hardcode
-	// the available backends.
-	availableBackends = []string{"dcrd"}
+	// availableBackends are the chain sync backends supported by the
+	// wallet: a full dcrd node over RPC, or the decred p2p network (spv).
+	availableBackends = []string{"dcrd", "spv"}
 )
 
 // createNewWallet creates a new instance of DcrWallet given the proper list of
@@ -143,3 +143,28 @@ func (s *RPCChainIO) GetBlockHash(blockHeight int64) (*chainhash.Hash, error) {
 	}
 	return s.chain.GetBlockHash(blockHeight)
 }
+
+// RelayFee returns the minimum fee rate the connected dcrd node requires for
+// relaying transactions.
+//
+// This method can be used as an lnwallet.RelayFeeSource.
+func (s *RPCChainIO) RelayFee() (lnwallet.AtomPerKByte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.chain == nil {
+		return 0, ErrUnconnected
+	}
+
+	info, err := s.chain.GetInfo()
+	if err != nil {
+		return 0, err
+	}
+
+	// The relay fee is reported in DCR/kB, so convert it to atoms/kB.
+	relayFee, err := dcrutil.NewAmount(info.RelayFee)
+	if err != nil {
+		return 0, err
+	}
+
+	return lnwallet.AtomPerKByte(relayFee), nil
+}
@@ -46,7 +46,7 @@ func (s *RPCSyncer) start(w *DcrWallet) error {
 	}
 	syncer := chain.NewSyncer(w.wallet, &chainRpcOpts)
 	syncer.SetCallbacks(&chain.Callbacks{
-		Synced: w.onRPCSyncerSynced,
+		Synced: w.onSyncerSynced,
 	})
 
 	go func() {
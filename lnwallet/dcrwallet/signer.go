@@ -192,6 +192,15 @@ func p2pkhSigScriptToWitness(scriptVersion uint16, sigScript []byte) ([][]byte,
 func (b *DcrWallet) ComputeInputScript(tx *wire.MsgTx,
 	signDesc *input.SignDescriptor) (*input.Script, error) {
 
+	// If the caller specified the key paying to the output, or tweaks to
+	// apply to it, the key is derived from the key descriptor rather than
+	// looked up through the address of the output.
+	if signDesc.KeyDesc.PubKey != nil || signDesc.SingleTweak != nil ||
+		signDesc.DoubleTweak != nil {
+
+		return b.computeKeyDescInputScript(tx, signDesc)
+	}
+
 	outputScript := signDesc.Output.PkScript
 	outputScriptVer := signDesc.Output.Version
 	walletAddr, err := b.fetchOutputAddr(outputScriptVer, outputScript)
@@ -237,6 +246,40 @@ func (b *DcrWallet) ComputeInputScript(tx *wire.MsgTx,
 	return &input.Script{Witness: witness}, nil
 }
 
+// computeKeyDescInputScript generates the input script spending a p2pkh output
+// that pays to the key described by the key descriptor of the passed sign
+// descriptor, tweaked by its single or double tweak if any. This allows
+// spending outputs that pay to keys derived by the wallet which aren't tracked
+// as wallet addresses, such as the to_remote output of a channel.
+func (b *DcrWallet) computeKeyDescInputScript(tx *wire.MsgTx,
+	signDesc *input.SignDescriptor) (*input.Script, error) {
+
+	privKey, err := b.DerivePrivKey(signDesc.KeyDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	privKey, err = maybeTweakPrivKey(signDesc, privKey)
+	if err != nil {
+		return nil, err
+	}
+
+	sigScript, err := txscript.SignatureScript(tx, signDesc.InputIndex,
+		signDesc.Output.PkScript, signDesc.HashType, privKey, true)
+	if err != nil {
+		return nil, err
+	}
+
+	witness, err := p2pkhSigScriptToWitness(
+		signDesc.Output.Version, sigScript,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &input.Script{Witness: witness}, nil
+}
+
 // A compile time check to ensure that DcrWallet implements the input.Signer
 // interface.
 var _ input.Signer = (*DcrWallet)(nil)
@@ -0,0 +1,240 @@
+package dcrwallet
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/gcs"
+	"github.com/decred/dcrd/gcs/blockcf"
+	"github.com/decred/dcrd/txscript/v2"
+	"github.com/decred/dcrd/wire"
+
+	"github.com/decred/dcrlnd/lnwallet"
+
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/spv/v3"
+	"github.com/decred/dcrwallet/wallet/v3"
+)
+
+var (
+	// ErrOutputNotFound is returned by the GetUtxo method of the SPVSyncer
+	// if the target output wasn't found in the main chain after its
+	// height hint.
+	ErrOutputNotFound = errors.New("target output not found")
+
+	// ErrGetUtxoCanceled is returned by the GetUtxo method of the
+	// SPVSyncer if the passed cancel channel was closed before the output
+	// was found.
+	ErrGetUtxoCanceled = errors.New("utxo lookup canceled")
+)
+
+// Compile time check to ensure SPVSyncer fulfills lnwallet.BlockChainIO.
+var _ lnwallet.BlockChainIO = (*SPVSyncer)(nil)
+
+// backend returns the wallet and the p2p syncer used for chain IO, or
+// ErrUnconnected if the syncer hasn't been started yet.
+func (s *SPVSyncer) backend() (*wallet.Wallet, *spv.Syncer, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.wallet == nil {
+		return nil, nil, ErrUnconnected
+	}
+	return s.wallet, s.syncer, nil
+}
+
+// GetBestBlock returns the current height and hash of the best known block
+// within the main chain.
+//
+// This method is a part of the lnwallet.BlockChainIO interface.
+func (s *SPVSyncer) GetBestBlock() (*chainhash.Hash, int32, error) {
+	w, _, err := s.backend()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	hash, height := w.MainChainTip(context.TODO())
+	return &hash, height, nil
+}
+
+// GetBlockHash returns the hash of the block in the best blockchain at the
+// given height.
+//
+// This method is a part of the lnwallet.BlockChainIO interface.
+func (s *SPVSyncer) GetBlockHash(blockHeight int64) (*chainhash.Hash, error) {
+	w, _, err := s.backend()
+	if err != nil {
+		return nil, err
+	}
+
+	id := wallet.NewBlockIdentifierFromHeight(int32(blockHeight))
+	info, err := w.BlockInfo(context.TODO(), id)
+	if err != nil {
+		return nil, err
+	}
+	return &info.Hash, nil
+}
+
+// GetBlockHeader returns the header of the block identified by the given
+// hash.
+func (s *SPVSyncer) GetBlockHeader(blockHash *chainhash.Hash) (
+	*wire.BlockHeader, error) {
+
+	w, _, err := s.backend()
+	if err != nil {
+		return nil, err
+	}
+	return w.BlockHeader(context.TODO(), blockHash)
+}
+
+// GetCFilter returns the regular committed filter of the block identified by
+// the given hash, as downloaded and verified by the wallet.
+func (s *SPVSyncer) GetCFilter(blockHash *chainhash.Hash) (*gcs.Filter, error) {
+	w, _, err := s.backend()
+	if err != nil {
+		return nil, err
+	}
+	return w.CFilter(context.TODO(), blockHash)
+}
+
+// GetBlock returns a raw block given its hash. As the light backend doesn't
+// store blocks, it is fetched from one of the connected peers.
+//
+// This method is a part of the lnwallet.BlockChainIO interface.
+func (s *SPVSyncer) GetBlock(blockHash *chainhash.Hash) (*wire.MsgBlock, error) {
+	_, syncer, err := s.backend()
+	if err != nil {
+		return nil, err
+	}
+	return s.fetchBlock(context.TODO(), syncer, blockHash)
+}
+
+// fetchBlock fetches the block identified by the given hash from one of the
+// peers of the syncer.
+func (s *SPVSyncer) fetchBlock(ctx context.Context, syncer *spv.Syncer,
+	blockHash *chainhash.Hash) (*wire.MsgBlock, error) {
+
+	blocks, err := syncer.Blocks(ctx, []*chainhash.Hash{blockHash})
+	if err != nil {
+		return nil, err
+	}
+	return blocks[0], nil
+}
+
+// GetUtxo returns the original output referenced by the passed outpoint that
+// create the target pkScript.
+//
+// As the light backend doesn't keep a utxo set, the committed filters of the
+// main chain are scanned starting at the given height hint, first for the
+// block creating the output and then for a block spending it. Only matching
+// blocks are fetched from the network.
+//
+// This method is a part of the lnwallet.BlockChainIO interface.
+func (s *SPVSyncer) GetUtxo(op *wire.OutPoint, pkScript []byte,
+	heightHint uint32, cancel <-chan struct{}) (*wire.TxOut, error) {
+
+	w, syncer, err := s.backend()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+	go func() {
+		select {
+		case <-cancel:
+			cancelCtx()
+		case <-ctx.Done():
+		}
+	}()
+
+	scriptEntries := pkScriptEntries(pkScript)
+	spendEntries := [][]byte{outPointEntry(op)}
+
+	var txOut *wire.TxOut
+	_, tipHeight := w.MainChainTip(ctx)
+	for height := int32(heightHint); height <= tipHeight; height++ {
+		select {
+		case <-cancel:
+			return nil, ErrGetUtxoCanceled
+		default:
+		}
+
+		id := wallet.NewBlockIdentifierFromHeight(height)
+		info, err := w.BlockInfo(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		header, err := w.BlockHeader(ctx, &info.Hash)
+		if err != nil {
+			return nil, err
+		}
+		filter, err := w.CFilter(ctx, &info.Hash)
+		if err != nil {
+			return nil, err
+		}
+
+		// Until the output is found we look for its script, and for
+		// its spend afterwards.
+		entries := scriptEntries
+		if txOut != nil {
+			entries = spendEntries
+		}
+		key := blockcf.Key(&header.MerkleRoot)
+		if !filter.MatchAny(key, entries) {
+			continue
+		}
+
+		block, err := s.fetchBlock(ctx, syncer, &info.Hash)
+		if err != nil {
+			return nil, err
+		}
+		for _, tx := range block.Transactions {
+			if txOut == nil && tx.TxHash() == op.Hash &&
+				int(op.Index) < len(tx.TxOut) {
+
+				txOut = tx.TxOut[op.Index]
+				continue
+			}
+			if txOut == nil {
+				continue
+			}
+			for _, txIn := range tx.TxIn {
+				if txIn.PreviousOutPoint == *op {
+					return nil, ErrOutputSpent
+				}
+			}
+		}
+	}
+
+	if txOut == nil {
+		return nil, ErrOutputNotFound
+	}
+	return txOut, nil
+}
+
+// outPointEntry returns the entry a regular committed filter holds for the
+// spend of the given outpoint.
+func outPointEntry(op *wire.OutPoint) []byte {
+	entry := make([]byte, chainhash.HashSize+4)
+	copy(entry, op.Hash[:])
+	binary.LittleEndian.PutUint32(entry[chainhash.HashSize:], op.Index)
+	return entry
+}
+
+// pkScriptEntries returns the entries a regular committed filter may hold for
+// an output paying to the given script: the full script and its data pushes.
+func pkScriptEntries(pkScript []byte) [][]byte {
+	entries := [][]byte{pkScript}
+
+	// Ignore the error here, as an unparsable script still commits to
+	// its raw bytes.
+	pushes, _ := txscript.PushedData(pkScript)
+	for _, push := range pushes {
+		if len(push) > 0 {
+			entries = append(entries, push)
+		}
+	}
+
+	return entries
+}
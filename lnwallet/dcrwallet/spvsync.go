@@ -0,0 +1,102 @@
+package dcrwallet
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"sync"
+
+	"github.com/decred/dcrd/addrmgr"
+	"github.com/decred/dcrd/chaincfg/v2"
+
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/p2p/v2"
+	"github.com/decred/dcrwallet/spv/v3"
+	"github.com/decred/dcrwallet/wallet/v3"
+)
+
+// SPVSyncerConfig houses the configuration of an SPVSyncer.
+type SPVSyncerConfig struct {
+	// Peers is the list of remote peers the syncer exclusively connects
+	// to. If empty, peers are discovered through the seeders of the
+	// network.
+	Peers []string
+
+	// Net is the network the syncer connects to.
+	Net *chaincfg.Params
+
+	// AppDataDir is the directory where the addresses of known peers are
+	// persisted.
+	AppDataDir string
+}
+
+// SPVSyncer implements the required methods for synchronizing a DcrWallet
+// instance using the decred p2p network, without relying on a full node. Only
+// block headers and committed filters are downloaded, and blocks are fetched
+// from the connected peers when needed.
+//
+// Once started, the syncer also serves as the chain IO of the light backend.
+type SPVSyncer struct {
+	cfg    *SPVSyncerConfig
+	cancel func()
+
+	mtx    sync.Mutex
+	wallet *wallet.Wallet
+	syncer *spv.Syncer
+}
+
+// NewSPVSyncer initializes a new syncer backed by the decred p2p network.
+func NewSPVSyncer(cfg *SPVSyncerConfig) (*SPVSyncer, error) {
+	return &SPVSyncer{
+		cfg: cfg,
+	}, nil
+}
+
+// start the syncer backend and begin synchronizing the given wallet.
+func (s *SPVSyncer) start(w *DcrWallet) error {
+	dcrwLog.Debugf("Starting SPV syncer")
+
+	// This context will be canceled by `w` once its Stop() method is
+	// called.
+	var ctx context.Context
+	ctx, s.cancel = context.WithCancel(context.Background())
+
+	addr := &net.TCPAddr{IP: net.ParseIP("::1"), Port: 0}
+	amgrDir := filepath.Join(s.cfg.AppDataDir, s.cfg.Net.Name)
+	amgr := addrmgr.New(amgrDir, net.LookupIP)
+	lp := p2p.NewLocalPeer(s.cfg.Net, addr, amgr)
+
+	syncer := spv.NewSyncer(w.wallet, lp)
+	if len(s.cfg.Peers) > 0 {
+		syncer.SetPersistentPeers(s.cfg.Peers)
+	}
+	syncer.SetNotifications(&spv.Notifications{
+		Synced: w.onSyncerSynced,
+	})
+	w.wallet.SetNetworkBackend(syncer)
+
+	s.mtx.Lock()
+	s.wallet = w.wallet
+	s.syncer = syncer
+	s.mtx.Unlock()
+
+	go func() {
+		err := syncer.Run(ctx)
+
+		// TODO: convert to errors.Is
+		if werr, is := err.(*errors.Error); is && werr.Err == context.Canceled {
+			// This was a graceful shutdown, so ignore the error.
+			dcrwLog.Debugf("SPVSyncer shutting down")
+			return
+		}
+
+		dcrwLog.Errorf("SPVSyncer error: %v", err)
+	}()
+
+	return nil
+}
+
+func (s *SPVSyncer) stop() {
+	dcrwLog.Debugf("SPVSyncer requested shutdown")
+	s.cancel()
+}
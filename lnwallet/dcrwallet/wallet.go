@@ -120,9 +120,12 @@ func New(cfg Config) (*DcrWallet, error) {
 //
 // This is a part of the WalletController interface.
 func (b *DcrWallet) BackEnd() string {
-	if _, is := b.syncer.(*RPCSyncer); is {
-		// This package only supports full node backends for the moment
+	switch b.syncer.(type) {
+	case *RPCSyncer:
 		return "dcrd"
+
+	case *SPVSyncer:
+		return "spv"
 	}
 
 	return ""
@@ -336,6 +339,14 @@ func (b *DcrWallet) UnlockOutpoint(o wire.OutPoint) {
 	b.wallet.UnlockOutpoint(o)
 }
 
+// AbandonTransaction removes an unconfirmed transaction, along with any
+// unconfirmed transactions spending its outputs, from the wallet.
+//
+// This is a part of the WalletController interface.
+func (b *DcrWallet) AbandonTransaction(txid *chainhash.Hash) error {
+	return b.wallet.AbandonTransaction(context.TODO(), txid)
+}
+
 // ListUnspentWitness returns a slice of all the unspent outputs the wallet
 // controls which pay to witness programs either directly or indirectly.
 //
@@ -479,6 +490,46 @@ func extractBalanceDelta(
 	return balanceDelta, nil
 }
 
+// outputDetails describes the outputs of the passed transaction, using the
+// credits of its summary to identify the ones paying to the wallet. The
+// addresses paid by the transaction are returned as well.
+func outputDetails(tx *wire.MsgTx, credits []base.TransactionSummaryOutput,
+	chainParams *chaincfg.Params) ([]lnwallet.OutputDetail,
+	[]dcrutil.Address, error) {
+
+	outputs := make([]lnwallet.OutputDetail, len(tx.TxOut))
+	var destAddresses []dcrutil.Address
+	for i, txOut := range tx.TxOut {
+		_, outAddresses, _, err := txscript.ExtractPkScriptAddrs(
+			txOut.Version, txOut.PkScript, chainParams)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		outputs[i] = lnwallet.OutputDetail{
+			OutputIndex: i,
+			Value:       dcrutil.Amount(txOut.Value),
+			PkScript:    txOut.PkScript,
+			Addresses:   outAddresses,
+		}
+		destAddresses = append(destAddresses, outAddresses...)
+	}
+
+	for _, credit := range credits {
+		if int(credit.Index) >= len(outputs) {
+			return nil, nil, fmt.Errorf("invalid credit index %d",
+				credit.Index)
+		}
+
+		output := &outputs[credit.Index]
+		output.IsOurAddress = true
+		output.Account = credit.Account
+		output.Internal = credit.Internal
+	}
+
+	return outputs, destAddresses, nil
+}
+
 // minedTransactionsToDetails is a helper function which converts a summary
 // information about mined transactions to a TransactionDetail.
 func minedTransactionsToDetails(
@@ -500,15 +551,11 @@ func minedTransactionsToDetails(
 			return nil, err
 		}
 
-		var destAddresses []dcrutil.Address
-		for _, txOut := range wireTx.TxOut {
-			_, outAddresses, _, err := txscript.ExtractPkScriptAddrs(
-				txOut.Version, txOut.PkScript, chainParams)
-			if err != nil {
-				return nil, err
-			}
-
-			destAddresses = append(destAddresses, outAddresses...)
+		outputs, destAddresses, err := outputDetails(
+			wireTx, tx.MyOutputs, chainParams,
+		)
+		if err != nil {
+			return nil, err
 		}
 
 		blockHash := block.Header.BlockHash()
@@ -520,6 +567,7 @@ func minedTransactionsToDetails(
 			Timestamp:        block.Header.Timestamp.Unix(),
 			TotalFees:        int64(tx.Fee),
 			DestAddresses:    destAddresses,
+			OutputDetails:    outputs,
 			RawTx:            tx.Transaction,
 		}
 
@@ -549,16 +597,11 @@ func unminedTransactionsToDetail(
 		return nil, err
 	}
 
-	var destAddresses []dcrutil.Address
-	for _, txOut := range wireTx.TxOut {
-		_, outAddresses, _, err :=
-			txscript.ExtractPkScriptAddrs(txOut.Version,
-				txOut.PkScript, chainParams)
-		if err != nil {
-			return nil, err
-		}
-
-		destAddresses = append(destAddresses, outAddresses...)
+	outputs, destAddresses, err := outputDetails(
+		wireTx, summary.MyOutputs, chainParams,
+	)
+	if err != nil {
+		return nil, err
 	}
 
 	txDetail := &lnwallet.TransactionDetail{
@@ -566,6 +609,7 @@ func unminedTransactionsToDetail(
 		TotalFees:     int64(summary.Fee),
 		Timestamp:     summary.Timestamp,
 		DestAddresses: destAddresses,
+		OutputDetails: outputs,
 		RawTx:         summary.Transaction,
 	}
 
@@ -804,8 +848,8 @@ func (b *DcrWallet) InitialSyncChannel() <-chan struct{} {
 	return b.syncedChan
 }
 
-func (b *DcrWallet) onRPCSyncerSynced(synced bool) {
-	dcrwLog.Debug("RPC syncer notified wallet is synced")
+func (b *DcrWallet) onSyncerSynced(synced bool) {
+	dcrwLog.Debug("Syncer notified wallet is synced")
 
 	// Now that the wallet is synced and address discovery has ended, we
 	// can create the keyring. We can only do this here (after sync)
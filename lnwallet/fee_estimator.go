@@ -559,3 +559,79 @@ func (w *WebAPIFeeEstimator) feeUpdateManager() {
 // A compile-time assertion to ensure that WebAPIFeeEstimator implements the
 // FeeEstimator interface.
 var _ FeeEstimator = (*WebAPIFeeEstimator)(nil)
+
+// FallbackFeeEstimator is an implementation of the FeeEstimator interface that
+// proxies fee estimation requests to a primary estimator, falling back to a
+// secondary one whenever the primary is unable to produce an estimate.
+type FallbackFeeEstimator struct {
+	primary  FeeEstimator
+	fallback FeeEstimator
+}
+
+// NewFallbackFeeEstimator returns a new FallbackFeeEstimator querying the
+// fallback estimator whenever the primary one fails.
+func NewFallbackFeeEstimator(primary,
+	fallback FeeEstimator) *FallbackFeeEstimator {
+
+	return &FallbackFeeEstimator{
+		primary:  primary,
+		fallback: fallback,
+	}
+}
+
+// EstimateFeePerKB takes in a target for the number of blocks until an initial
+// confirmation and returns the estimated fee expressed in atoms/kB.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (e *FallbackFeeEstimator) EstimateFeePerKB(numBlocks uint32) (AtomPerKByte, error) {
+	feePerKB, err := e.primary.EstimateFeePerKB(numBlocks)
+	if err == nil {
+		return feePerKB, nil
+	}
+
+	walletLog.Debugf("Unable to estimate fee for conf target of %d (%v), "+
+		"using fallback estimator", numBlocks, err)
+
+	return e.fallback.EstimateFeePerKB(numBlocks)
+}
+
+// Start signals the FeeEstimator to start any processes or goroutines it needs
+// to perform its duty.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (e *FallbackFeeEstimator) Start() error {
+	if err := e.fallback.Start(); err != nil {
+		return err
+	}
+
+	return e.primary.Start()
+}
+
+// Stop stops any spawned goroutines and cleans up the resources used by the
+// fee estimator.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (e *FallbackFeeEstimator) Stop() error {
+	if err := e.primary.Stop(); err != nil {
+		return err
+	}
+
+	return e.fallback.Stop()
+}
+
+// RelayFeePerKB returns the minimum fee rate required for transactions to be
+// relayed, which is the highest of the rates of both estimators.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (e *FallbackFeeEstimator) RelayFeePerKB() AtomPerKByte {
+	relayFee := e.primary.RelayFeePerKB()
+	if fallbackFee := e.fallback.RelayFeePerKB(); fallbackFee > relayFee {
+		relayFee = fallbackFee
+	}
+
+	return relayFee
+}
+
+// A compile-time assertion to ensure that FallbackFeeEstimator implements the
+// FeeEstimator interface.
+var _ FeeEstimator = (*FallbackFeeEstimator)(nil)
@@ -3,6 +3,7 @@ package lnwallet_test
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"io"
 	"reflect"
 	"strings"
@@ -172,3 +173,69 @@ func TestWebAPIFeeEstimator(t *testing.T) {
 		})
 	}
 }
+
+// mockTargetFeeEstimator is a fee estimator only providing estimates for a set
+// of confirmation targets.
+type mockTargetFeeEstimator struct {
+	lnwallet.StaticFeeEstimator
+
+	fees map[uint32]lnwallet.AtomPerKByte
+}
+
+func (e *mockTargetFeeEstimator) EstimateFeePerKB(
+	numBlocks uint32) (lnwallet.AtomPerKByte, error) {
+
+	fee, ok := e.fees[numBlocks]
+	if !ok {
+		return 0, errors.New("no estimate for target")
+	}
+	return fee, nil
+}
+
+// TestFallbackFeeEstimator checks that the FallbackFeeEstimator only queries
+// its fallback estimator when the primary one fails.
+func TestFallbackFeeEstimator(t *testing.T) {
+	t.Parallel()
+
+	primary := &mockTargetFeeEstimator{
+		StaticFeeEstimator: *lnwallet.NewStaticFeeEstimator(0, 10000),
+		fees: map[uint32]lnwallet.AtomPerKByte{
+			2: 30000,
+		},
+	}
+	fallback := lnwallet.NewStaticFeeEstimator(20000, 15000)
+
+	estimator := lnwallet.NewFallbackFeeEstimator(primary, fallback)
+	if err := estimator.Start(); err != nil {
+		t.Fatalf("unable to start fee estimator, got: %v", err)
+	}
+	defer estimator.Stop()
+
+	testCases := []struct {
+		name   string
+		target uint32
+		est    lnwallet.AtomPerKByte
+	}{
+		{"primary_estimate", 2, 30000},
+		{"fallback_estimate", 6, 20000},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			est, err := estimator.EstimateFeePerKB(tc.target)
+			if err != nil {
+				t.Fatalf("unable to estimate fee for %v block "+
+					"target, got: %v", tc.target, err)
+			}
+			if est != tc.est {
+				t.Fatalf("expected fee estimate of %v, got %v",
+					tc.est, est)
+			}
+		})
+	}
+
+	// The relay fee must be the highest of both estimators.
+	if relayFee := estimator.RelayFeePerKB(); relayFee != 15000 {
+		t.Fatalf("expected relay fee of 15000, got %v", relayFee)
+	}
+}
@@ -0,0 +1,140 @@
+package lnwallet
+
+// FeeSubsystem identifies a subsystem whose transactions are given a fee rate
+// estimated for its own confirmation target.
+type FeeSubsystem uint8
+
+const (
+	// FeeSubsystemFunding is the subsystem funding new channels, whose
+	// target is used to estimate the fee rate of their initial
+	// commitments.
+	FeeSubsystemFunding FeeSubsystem = iota
+
+	// FeeSubsystemCoopClose is the subsystem negotiating the fee of
+	// cooperative channel closes.
+	FeeSubsystemCoopClose
+
+	// FeeSubsystemSweep is the subsystem sweeping the outputs of closed
+	// channels back into the wallet.
+	FeeSubsystemSweep
+
+	// FeeSubsystemAnchor is the subsystem sweeping the anchors of
+	// commitments broadcast by force closes.
+	FeeSubsystemAnchor
+
+	// FeeSubsystemJustice is the subsystem claiming the outputs of revoked
+	// commitments broadcast by a remote party.
+	FeeSubsystemJustice
+)
+
+// FeeSubsystems lists all the subsystems known to the FeeService.
+var FeeSubsystems = []FeeSubsystem{
+	FeeSubsystemFunding,
+	FeeSubsystemCoopClose,
+	FeeSubsystemSweep,
+	FeeSubsystemAnchor,
+	FeeSubsystemJustice,
+}
+
+// String returns a human readable string describing the FeeSubsystem.
+func (s FeeSubsystem) String() string {
+	switch s {
+	case FeeSubsystemFunding:
+		return "Funding"
+
+	case FeeSubsystemCoopClose:
+		return "CoopClose"
+
+	case FeeSubsystemSweep:
+		return "Sweep"
+
+	case FeeSubsystemAnchor:
+		return "Anchor"
+
+	case FeeSubsystemJustice:
+		return "Justice"
+
+	default:
+		return "Unknown"
+	}
+}
+
+// defaultFeeServiceConfTarget is the confirmation target used for subsystems
+// that weren't given one.
+const defaultFeeServiceConfTarget = 6
+
+// EffectiveFeeRate is the fee rate a subsystem currently resolves to.
+type EffectiveFeeRate struct {
+	// Subsystem is the subsystem the fee rate is used by.
+	Subsystem FeeSubsystem
+
+	// ConfTarget is the confirmation target of the subsystem.
+	ConfTarget uint32
+
+	// FeePerKB is the fee rate estimated for the confirmation target.
+	FeePerKB AtomPerKByte
+}
+
+// FeeService maps each subsystem to its configured confirmation target, and
+// resolves it to a fee rate through a shared FeeEstimator. This allows the
+// fee priority of each kind of transaction to be tuned on its own, rather
+// than relying on a single global default.
+type FeeService struct {
+	estimator   FeeEstimator
+	confTargets map[FeeSubsystem]uint32
+}
+
+// NewFeeService returns a new FeeService that estimates the fee rates of the
+// subsystems through the passed estimator. Subsystems absent from confTargets
+// use a confirmation target of 6 blocks.
+func NewFeeService(estimator FeeEstimator,
+	confTargets map[FeeSubsystem]uint32) *FeeService {
+
+	targets := make(map[FeeSubsystem]uint32, len(confTargets))
+	for subsystem, confTarget := range confTargets {
+		targets[subsystem] = confTarget
+	}
+
+	return &FeeService{
+		estimator:   estimator,
+		confTargets: targets,
+	}
+}
+
+// ConfTarget returns the confirmation target of the passed subsystem.
+func (f *FeeService) ConfTarget(subsystem FeeSubsystem) uint32 {
+	confTarget, ok := f.confTargets[subsystem]
+	if !ok || confTarget == 0 {
+		return defaultFeeServiceConfTarget
+	}
+
+	return confTarget
+}
+
+// EstimateFeePerKB returns the fee rate estimated for the confirmation target
+// of the passed subsystem.
+func (f *FeeService) EstimateFeePerKB(
+	subsystem FeeSubsystem) (AtomPerKByte, error) {
+
+	return f.estimator.EstimateFeePerKB(f.ConfTarget(subsystem))
+}
+
+// EffectiveFeeRates returns the confirmation target and the fee rate
+// currently estimated for each of the subsystems.
+func (f *FeeService) EffectiveFeeRates() ([]EffectiveFeeRate, error) {
+	rates := make([]EffectiveFeeRate, 0, len(FeeSubsystems))
+	for _, subsystem := range FeeSubsystems {
+		feePerKB, err := f.EstimateFeePerKB(subsystem)
+		if err != nil {
+			return nil, err
+		}
+
+		rates = append(rates, EffectiveFeeRate{
+			Subsystem:  subsystem,
+			ConfTarget: f.ConfTarget(subsystem),
+			FeePerKB:   feePerKB,
+		})
+	}
+
+	return rates, nil
+}
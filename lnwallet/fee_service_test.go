@@ -0,0 +1,98 @@
+package lnwallet_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/decred/dcrlnd/lnwallet"
+)
+
+// targetFeeEstimator is a fee estimator whose fee rates decrease with the
+// number of blocks of the confirmation target.
+type targetFeeEstimator struct {
+	fail bool
+}
+
+func (e *targetFeeEstimator) EstimateFeePerKB(
+	numBlocks uint32) (lnwallet.AtomPerKByte, error) {
+
+	if e.fail {
+		return 0, fmt.Errorf("unable to estimate fee")
+	}
+
+	return lnwallet.AtomPerKByte(1e6 / numBlocks), nil
+}
+
+func (e *targetFeeEstimator) RelayFeePerKB() lnwallet.AtomPerKByte {
+	return lnwallet.FeePerKBFloor
+}
+
+func (e *targetFeeEstimator) Start() error {
+	return nil
+}
+
+func (e *targetFeeEstimator) Stop() error {
+	return nil
+}
+
+// TestFeeService asserts that the fee service estimates the fee rate of each
+// subsystem for its own confirmation target, falling back to the default
+// target for subsystems that weren't given one.
+func TestFeeService(t *testing.T) {
+	t.Parallel()
+
+	estimator := &targetFeeEstimator{}
+	feeService := lnwallet.NewFeeService(
+		estimator, map[lnwallet.FeeSubsystem]uint32{
+			lnwallet.FeeSubsystemFunding: 2,
+			lnwallet.FeeSubsystemJustice: 1,
+			lnwallet.FeeSubsystemAnchor:  0,
+		},
+	)
+
+	expectedTargets := map[lnwallet.FeeSubsystem]uint32{
+		lnwallet.FeeSubsystemFunding:   2,
+		lnwallet.FeeSubsystemCoopClose: 6,
+		lnwallet.FeeSubsystemSweep:     6,
+		lnwallet.FeeSubsystemAnchor:    6,
+		lnwallet.FeeSubsystemJustice:   1,
+	}
+
+	rates, err := feeService.EffectiveFeeRates()
+	if err != nil {
+		t.Fatalf("unable to query effective fee rates: %v", err)
+	}
+	if len(rates) != len(lnwallet.FeeSubsystems) {
+		t.Fatalf("expected %v fee rates, got %v",
+			len(lnwallet.FeeSubsystems), len(rates))
+	}
+
+	for _, rate := range rates {
+		confTarget := expectedTargets[rate.Subsystem]
+		if rate.ConfTarget != confTarget {
+			t.Fatalf("expected conf target %v for %v, got %v",
+				confTarget, rate.Subsystem, rate.ConfTarget)
+		}
+
+		feePerKB := lnwallet.AtomPerKByte(1e6 / confTarget)
+		if rate.FeePerKB != feePerKB {
+			t.Fatalf("expected fee rate %v for %v, got %v",
+				feePerKB, rate.Subsystem, rate.FeePerKB)
+		}
+
+		estimate, err := feeService.EstimateFeePerKB(rate.Subsystem)
+		if err != nil {
+			t.Fatalf("unable to estimate fee rate: %v", err)
+		}
+		if estimate != feePerKB {
+			t.Fatalf("expected estimate %v for %v, got %v",
+				feePerKB, rate.Subsystem, estimate)
+		}
+	}
+
+	// Estimation failures are reported to the caller.
+	estimator.fail = true
+	if _, err := feeService.EffectiveFeeRates(); err == nil {
+		t.Fatal("expected failure querying effective fee rates")
+	}
+}
@@ -110,10 +110,41 @@ type TransactionDetail struct {
 	// DestAddresses are the destinations for a transaction
 	DestAddresses []dcrutil.Address
 
+	// OutputDetails describes each of the outputs of the transaction,
+	// along with the derivation of the ones paying to the wallet.
+	OutputDetails []OutputDetail
+
 	// RawTx returns the raw serialized transaction.
 	RawTx []byte
 }
 
+// OutputDetail describes an output of a transaction relevant to the wallet.
+type OutputDetail struct {
+	// OutputIndex is the index of the output within the transaction.
+	OutputIndex int
+
+	// Value is the amount paid to the output.
+	Value dcrutil.Amount
+
+	// PkScript is the script of the output.
+	PkScript []byte
+
+	// Addresses are the addresses the output pays to.
+	Addresses []dcrutil.Address
+
+	// IsOurAddress is true if the output pays to an address of the
+	// wallet.
+	IsOurAddress bool
+
+	// Account is the account of the wallet the output pays to. This is
+	// only set if IsOurAddress is true.
+	Account uint32
+
+	// Internal is true if the output pays to an internal (change) address
+	// of the account. This is only set if IsOurAddress is true.
+	Internal bool
+}
+
 // TransactionSubscription is an interface which describes an object capable of
 // receiving notifications of new transaction related to the underlying wallet.
 // TODO(roasbeef): add balance updates?
@@ -220,6 +251,12 @@ type WalletController interface {
 	// eligible for coin selection.
 	UnlockOutpoint(o wire.OutPoint)
 
+	// AbandonTransaction removes an unconfirmed transaction, along with
+	// any unconfirmed transactions spending its outputs, from the wallet.
+	// The outputs spent by the transaction become eligible for coin
+	// selection again.
+	AbandonTransaction(txid *chainhash.Hash) error
+
 	// PublishTransaction performs cursory validation (dust checks, etc),
 	// then finally broadcasts the passed transaction to the Decred network.
 	// If the transaction is rejected because it is conflicting with an
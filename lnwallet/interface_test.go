@@ -721,7 +721,7 @@ func testCancelNonExistentReservation(miner *rpctest.Harness,
 	// Create our own reservation, give it some ID.
 	res, err := lnwallet.NewChannelReservation(
 		20000, 20000, feePerKB, alice, 22, 10, &testHdSeed,
-		lnwire.FFAnnounceChannel, true,
+		lnwire.FFAnnounceChannel, true, false,
 	)
 	if err != nil {
 		t.Fatalf("unable to create res: %v", err)
@@ -2939,6 +2939,29 @@ func runTests(t *testing.T, walletDriver *lnwallet.WalletDriver,
 			if err != nil {
 				t.Fatalf("unable to make bob chain IO: %v", err)
 			}
+		case "spv":
+			// The spv syncers connect to the mining node over p2p,
+			// and also serve as the chain IO of their wallet.
+			var aliceSPV, bobSPV *dcrwallet.SPVSyncer
+			aliceSPV, err = dcrwallet.NewSPVSyncer(&dcrwallet.SPVSyncerConfig{
+				Peers:      []string{miningNode.P2PAddress()},
+				Net:        netParams,
+				AppDataDir: tempTestDirAlice,
+			})
+			if err != nil {
+				t.Fatalf("unable to make alice spv syncer: %v", err)
+			}
+			bobSPV, err = dcrwallet.NewSPVSyncer(&dcrwallet.SPVSyncerConfig{
+				Peers:      []string{miningNode.P2PAddress()},
+				Net:        netParams,
+				AppDataDir: tempTestDirBob,
+			})
+			if err != nil {
+				t.Fatalf("unable to make bob spv syncer: %v", err)
+			}
+
+			aliceSyncer, aliceBio = aliceSPV, aliceSPV
+			bobSyncer, bobBio = bobSPV, bobSPV
 		default:
 			t.Fatalf("unknown chain driver: %v", backEnd)
 		}
@@ -0,0 +1,206 @@
+package lnwallet
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// DefaultRelayFeeRefreshInterval is the default interval in which a
+	// FloorFeeEstimator re-queries its backend for the current minimum
+	// relay fee.
+	DefaultRelayFeeRefreshInterval = 10 * time.Minute
+)
+
+// RelayFeeSource is a function that queries a backend node for the minimum
+// fee rate it requires in order to relay a transaction.
+type RelayFeeSource func() (AtomPerKByte, error)
+
+// RelayFeeFloor returns the lowest fee rate that can be used for transactions
+// while still having them relayed through the network, according to the given
+// fee estimator. It is never lower than FeePerKBFloor.
+func RelayFeeFloor(estimator FeeEstimator) AtomPerKByte {
+	floor := estimator.RelayFeePerKB()
+	if floor < FeePerKBFloor {
+		floor = FeePerKBFloor
+	}
+
+	return floor
+}
+
+// FloorFeeEstimator is a FeeEstimator that wraps another estimator and makes
+// sure that none of the returned fee rates are below the minimum relay fee of
+// the network. The relay fee is cached and periodically refreshed from the
+// given RelayFeeSource, so that every subsystem that consults the estimator
+// (commitment fee updates, funding, sweeps and cooperative closes) shares the
+// same view of the fee floor.
+type FloorFeeEstimator struct {
+	started uint32 // To be used atomically.
+	stopped uint32 // To be used atomically.
+
+	// estimator is the underlying estimator that provides the fee
+	// estimates.
+	estimator FeeEstimator
+
+	// relayFeeSource is used to query the current minimum relay fee of the
+	// backend. If nil, only the underlying estimator's relay fee and
+	// FeePerKBFloor are taken into account.
+	relayFeeSource RelayFeeSource
+
+	// refreshInterval is the interval in which the relay fee is
+	// re-queried from relayFeeSource.
+	refreshInterval time.Duration
+
+	// floorMtx guards floor.
+	floorMtx sync.RWMutex
+	floor    AtomPerKByte
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// A compile-time assertion to ensure that FloorFeeEstimator implements the
+// FeeEstimator interface.
+var _ FeeEstimator = (*FloorFeeEstimator)(nil)
+
+// NewFloorFeeEstimator returns a new FloorFeeEstimator wrapping the given
+// estimator. The relay fee is queried from the given source at startup and
+// then once every refreshInterval.
+func NewFloorFeeEstimator(estimator FeeEstimator, source RelayFeeSource,
+	refreshInterval time.Duration) *FloorFeeEstimator {
+
+	return &FloorFeeEstimator{
+		estimator:       estimator,
+		relayFeeSource:  source,
+		refreshInterval: refreshInterval,
+		floor:           FeePerKBFloor,
+		quit:            make(chan struct{}),
+	}
+}
+
+// Start starts the underlying estimator, performs the initial relay fee query
+// and launches the goroutine that periodically refreshes it.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (f *FloorFeeEstimator) Start() error {
+	if !atomic.CompareAndSwapUint32(&f.started, 0, 1) {
+		return nil
+	}
+
+	if err := f.estimator.Start(); err != nil {
+		return err
+	}
+
+	f.refresh()
+
+	if f.relayFeeSource != nil && f.refreshInterval > 0 {
+		f.wg.Add(1)
+		go f.refresher()
+	}
+
+	return nil
+}
+
+// Stop stops the refresh goroutine and the underlying estimator.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (f *FloorFeeEstimator) Stop() error {
+	if !atomic.CompareAndSwapUint32(&f.stopped, 0, 1) {
+		return nil
+	}
+
+	close(f.quit)
+	f.wg.Wait()
+
+	return f.estimator.Stop()
+}
+
+// EstimateFeePerKB returns the estimate of the underlying estimator, raised to
+// the current relay fee floor if needed.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (f *FloorFeeEstimator) EstimateFeePerKB(numBlocks uint32) (AtomPerKByte, error) {
+	feeRate, err := f.estimator.EstimateFeePerKB(numBlocks)
+	if err != nil {
+		return 0, err
+	}
+
+	floor := f.RelayFeePerKB()
+	if feeRate < floor {
+		walletLog.Debugf("Estimated fee rate of %v for %d blocks is "+
+			"below the relay fee floor, using %v instead", feeRate,
+			numBlocks, floor)
+
+		feeRate = floor
+	}
+
+	return feeRate, nil
+}
+
+// RelayFeePerKB returns the cached minimum relay fee.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (f *FloorFeeEstimator) RelayFeePerKB() AtomPerKByte {
+	f.floorMtx.RLock()
+	defer f.floorMtx.RUnlock()
+
+	return f.floor
+}
+
+// refresher periodically refreshes the relay fee floor until the estimator is
+// stopped.
+//
+// NOTE: This MUST be run as a goroutine.
+func (f *FloorFeeEstimator) refresher() {
+	defer f.wg.Done()
+
+	ticker := time.NewTicker(f.refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			f.refresh()
+
+		case <-f.quit:
+			return
+		}
+	}
+}
+
+// refresh recomputes the relay fee floor as the highest of FeePerKBFloor, the
+// relay fee of the underlying estimator and the one reported by the relay fee
+// source. If the source fails, the previously known floor is used in place of
+// its answer.
+func (f *FloorFeeEstimator) refresh() {
+	floor := RelayFeeFloor(f.estimator)
+
+	var (
+		relayFee AtomPerKByte
+		err      error
+	)
+	if f.relayFeeSource != nil {
+		relayFee, err = f.relayFeeSource()
+		if err != nil {
+			walletLog.Warnf("Unable to query relay fee, keeping "+
+				"previous floor: %v", err)
+		}
+	}
+
+	f.floorMtx.Lock()
+	defer f.floorMtx.Unlock()
+
+	if err != nil {
+		relayFee = f.floor
+	}
+	if relayFee > floor {
+		floor = relayFee
+	}
+
+	if floor != f.floor {
+		walletLog.Infof("Relay fee floor changed from %v to %v",
+			f.floor, floor)
+	}
+	f.floor = floor
+}
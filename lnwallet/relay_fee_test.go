@@ -0,0 +1,114 @@
+package lnwallet_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrlnd/lnwallet"
+)
+
+// mockRelayFeeSource is a relay fee source whose answer can be changed during
+// a test.
+type mockRelayFeeSource struct {
+	mtx      sync.Mutex
+	relayFee lnwallet.AtomPerKByte
+	fail     bool
+}
+
+func (m *mockRelayFeeSource) setRelayFee(relayFee lnwallet.AtomPerKByte,
+	fail bool) {
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.relayFee = relayFee
+	m.fail = fail
+}
+
+func (m *mockRelayFeeSource) fetch() (lnwallet.AtomPerKByte, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if m.fail {
+		return 0, fmt.Errorf("unable to fetch relay fee")
+	}
+
+	return m.relayFee, nil
+}
+
+// TestFloorFeeEstimator tests that the FloorFeeEstimator never returns fee
+// rates below the relay fee floor, and that it picks up changes to the relay
+// fee of the backend.
+func TestFloorFeeEstimator(t *testing.T) {
+	t.Parallel()
+
+	const feeRate = lnwallet.AtomPerKByte(2e4)
+
+	source := &mockRelayFeeSource{relayFee: 0}
+	estimator := lnwallet.NewFloorFeeEstimator(
+		lnwallet.NewStaticFeeEstimator(feeRate, 0), source.fetch,
+		10*time.Millisecond,
+	)
+	if err := estimator.Start(); err != nil {
+		t.Fatalf("unable to start estimator: %v", err)
+	}
+	defer estimator.Stop()
+
+	// A backend reporting a relay fee below the hardcoded floor should
+	// never lower the floor below it.
+	if relayFee := estimator.RelayFeePerKB(); relayFee != lnwallet.FeePerKBFloor {
+		t.Fatalf("expected relay fee %v, got %v",
+			lnwallet.FeePerKBFloor, relayFee)
+	}
+
+	assertFloor := func(expected lnwallet.AtomPerKByte) {
+		t.Helper()
+
+		var relayFee lnwallet.AtomPerKByte
+		for i := 0; i < 100; i++ {
+			relayFee = estimator.RelayFeePerKB()
+			if relayFee == expected {
+				break
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		if relayFee != expected {
+			t.Fatalf("expected relay fee %v, got %v", expected,
+				relayFee)
+		}
+	}
+
+	// Once the backend raises its relay fee above the estimate, the
+	// estimates should be raised to it.
+	source.setRelayFee(3e4, false)
+	assertFloor(3e4)
+
+	estimate, err := estimator.EstimateFeePerKB(6)
+	if err != nil {
+		t.Fatalf("unable to estimate fee: %v", err)
+	}
+	if estimate != 3e4 {
+		t.Fatalf("expected estimate of %v, got %v",
+			lnwallet.AtomPerKByte(3e4), estimate)
+	}
+
+	// A failing backend should keep the previously known floor.
+	source.setRelayFee(0, true)
+	time.Sleep(50 * time.Millisecond)
+	assertFloor(3e4)
+
+	// Finally, once the relay fee drops again, the regular estimate should
+	// be returned.
+	source.setRelayFee(1e4, false)
+	assertFloor(lnwallet.FeePerKBFloor)
+
+	estimate, err = estimator.EstimateFeePerKB(6)
+	if err != nil {
+		t.Fatalf("unable to estimate fee: %v", err)
+	}
+	if estimate != feeRate {
+		t.Fatalf("expected estimate of %v, got %v", feeRate, estimate)
+	}
+}
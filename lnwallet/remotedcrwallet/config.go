@@ -3,6 +3,7 @@ package remotedcrwallet
 import (
 	"github.com/decred/dcrd/chaincfg/v2"
 	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/input"
 	"github.com/decred/dcrlnd/lnwallet"
 	"google.golang.org/grpc"
 )
@@ -54,4 +55,15 @@ type Config struct {
 	ChainIO lnwallet.BlockChainIO
 
 	DB *channeldb.DB
+
+	// WatchOnly signals that the underlying wallet doesn't hold the
+	// private keys of the account, in which case only its extended public
+	// key is fetched and the transactions sent from it are signed by
+	// Signer. Key derivation must then be performed by the remote signer
+	// as well.
+	WatchOnly bool
+
+	// Signer signs the inputs of the transactions sent from the account
+	// when the wallet is watch-only.
+	Signer input.Signer
 }
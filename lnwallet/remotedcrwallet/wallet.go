@@ -21,10 +21,21 @@ import (
 	"github.com/decred/dcrd/txscript/v2"
 	"github.com/decred/dcrd/wire"
 	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/input"
 	"github.com/decred/dcrlnd/lnwallet"
 	"github.com/decred/dcrwallet/wallet/v3/txauthor"
 )
 
+const (
+	// minReconnectBackoff is the initial time waited before attempting to
+	// re-register for the notifications of the wallet once they broke.
+	minReconnectBackoff = time.Second
+
+	// maxReconnectBackoff is the maximum time waited between two attempts
+	// to re-register for the notifications of the wallet.
+	maxReconnectBackoff = time.Minute
+)
+
 type DcrWallet struct {
 	// syncedChan is a channel that is closed once the wallet has initially synced
 	// to the network. It is protected by atomicWalletSynced.
@@ -90,6 +101,10 @@ func New(cfg Config) (*DcrWallet, error) {
 	// branch for most keyfamilies.
 	ctxb := context.Background()
 	wallet := pb.NewWalletServiceClient(cfg.Conn)
+	if cfg.WatchOnly {
+		return newWatchOnly(cfg, wallet)
+	}
+
 	req := &pb.GetAccountExtendedPrivKeyRequest{
 		AccountNumber: uint32(cfg.AccountNumber),
 		Passphrase:    cfg.PrivatePass,
@@ -122,19 +137,9 @@ func New(cfg Config) (*DcrWallet, error) {
 	// Ensure we don't attempt to use a keyring derived from a different
 	// account than previously used by comparing the first external public
 	// key with the one stored in the database.
-	firstKey, err := branchExtXPriv.Child(0)
-	if err != nil {
-		return nil, fmt.Errorf("unable to derive first external key: %v", err)
-	}
-	firstPubKey, err := firstKey.ECPubKey()
-	if err != nil {
+	if err := compareAndStoreAccountID(cfg, branchExtXPriv); err != nil {
 		return nil, err
 	}
-	firstPubKeyBytes := firstPubKey.Serialize()
-	if err = cfg.DB.CompareAndStoreAccountID(firstPubKeyBytes); err != nil {
-		return nil, fmt.Errorf("account number %d failed to generate "+
-			"previously stored account ID: %v", cfg.AccountNumber, err)
-	}
 
 	dcrw := &DcrWallet{
 		account:         uint32(cfg.AccountNumber),
@@ -161,6 +166,75 @@ func New(cfg Config) (*DcrWallet, error) {
 	return dcrw, nil
 }
 
+// newWatchOnly creates a wallet controller on top of a watch-only wallet. No
+// private key is available to it, so it doesn't provide a keyring and signs
+// the transactions it sends through the configured signer.
+func newWatchOnly(cfg Config, wallet pb.WalletServiceClient) (*DcrWallet,
+	error) {
+
+	if cfg.Signer == nil {
+		return nil, fmt.Errorf("watch-only wallets require a signer")
+	}
+
+	req := &pb.GetAccountExtendedPubKeyRequest{
+		AccountNumber: uint32(cfg.AccountNumber),
+	}
+	resp, err := wallet.GetAccountExtendedPubKey(context.Background(), req)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to get master LN account "+
+			"extended pub key: %v", err)
+	}
+
+	acctXPub, err := hdkeychain.NewKeyFromString(
+		resp.AccExtendedPubKey, cfg.NetParams,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create account xpub: %v", err)
+	}
+
+	branchExtXPub, err := acctXPub.Child(0)
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive the external branch xpub: %v", err)
+	}
+	if err := compareAndStoreAccountID(cfg, branchExtXPub); err != nil {
+		return nil, err
+	}
+
+	return &DcrWallet{
+		account:         uint32(cfg.AccountNumber),
+		syncedChan:      make(chan struct{}),
+		chainParams:     cfg.NetParams,
+		db:              cfg.DB,
+		cfg:             cfg,
+		conn:            cfg.Conn,
+		wallet:          wallet,
+		lockedOutpoints: make(map[wire.OutPoint]struct{}),
+	}, nil
+}
+
+// compareAndStoreAccountID ensures the account of the wallet is the one
+// previously used by comparing the first key of its external branch with the
+// one stored in the database.
+func compareAndStoreAccountID(cfg Config,
+	branchExtKey *hdkeychain.ExtendedKey) error {
+
+	firstKey, err := branchExtKey.Child(0)
+	if err != nil {
+		return fmt.Errorf("unable to derive first external key: %v", err)
+	}
+	firstPubKey, err := firstKey.ECPubKey()
+	if err != nil {
+		return err
+	}
+	firstPubKeyBytes := firstPubKey.Serialize()
+	if err = cfg.DB.CompareAndStoreAccountID(firstPubKeyBytes); err != nil {
+		return fmt.Errorf("account number %d failed to generate "+
+			"previously stored account ID: %v", cfg.AccountNumber, err)
+	}
+
+	return nil
+}
+
 // BackEnd returns the underlying ChainService's name as a string.
 //
 // This is a part of the WalletController interface.
@@ -185,6 +259,17 @@ func (b *DcrWallet) Stop() error {
 	return b.conn.Close()
 }
 
+// CheckConnection returns an error if the remote wallet can't be reached,
+// which happens while it restarts.
+func (b *DcrWallet) CheckConnection() error {
+	_, err := b.wallet.Ping(context.Background(), &pb.PingRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to reach remote wallet: %v", err)
+	}
+
+	return nil
+}
+
 // ConfirmedBalance returns the sum of all the wallet's unspent outputs that
 // have at least confs confirmations. If confs is set to zero, then all unspent
 // outputs, including those currently in the mempool will be included in the
@@ -362,6 +447,33 @@ func (b *DcrWallet) SendOutputs(outputs []*wire.TxOut,
 		}
 		pkScript := credit.OutputScript
 
+		// Watch-only wallets don't know the private keys of their
+		// addresses, so the signer is asked for the input script.
+		if b.cfg.WatchOnly {
+			signDesc := &input.SignDescriptor{
+				Output: &wire.TxOut{
+					Value:    credit.Amount,
+					PkScript: pkScript,
+				},
+				InputIndex: i,
+				HashType:   txscript.SigHashAll,
+			}
+			inputScript, err := b.cfg.Signer.ComputeInputScript(
+				tx, signDesc,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to sign input "+
+					"%d: %v", i, err)
+			}
+			in.SignatureScript, err = input.WitnessStackToSigScript(
+				inputScript.Witness,
+			)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
 		// Find out the HD index of the address.
 		validReq := &pb.ValidateAddressRequest{
 			Address: credit.Address,
@@ -451,6 +563,18 @@ func (b *DcrWallet) UnlockOutpoint(o wire.OutPoint) {
 	b.lockedOutpointsMu.Unlock()
 }
 
+// AbandonTransaction removes an unconfirmed transaction, along with any
+// unconfirmed transactions spending its outputs, from the wallet.
+//
+// This is a part of the WalletController interface.
+func (b *DcrWallet) AbandonTransaction(txid *chainhash.Hash) error {
+	req := &pb.AbandonTransactionRequest{
+		TransactionHash: txid[:],
+	}
+	_, err := b.wallet.AbandonTransaction(context.Background(), req)
+	return err
+}
+
 // ListUnspentWitness returns a slice of all the unspent outputs the wallet
 // controls which pay to witness programs either directly or indirectly.
 //
@@ -621,6 +745,46 @@ func extractBalanceDelta(
 	return balanceDelta, nil
 }
 
+// outputDetails describes the outputs of the passed transaction, using the
+// credits of its summary to identify the ones paying to the wallet. The
+// addresses paid by the transaction are returned as well.
+func outputDetails(tx *wire.MsgTx, credits []*pb.TransactionDetails_Output,
+	chainParams *chaincfg.Params) ([]lnwallet.OutputDetail,
+	[]dcrutil.Address, error) {
+
+	outputs := make([]lnwallet.OutputDetail, len(tx.TxOut))
+	var destAddresses []dcrutil.Address
+	for i, txOut := range tx.TxOut {
+		_, outAddresses, _, err := txscript.ExtractPkScriptAddrs(
+			txOut.Version, txOut.PkScript, chainParams)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		outputs[i] = lnwallet.OutputDetail{
+			OutputIndex: i,
+			Value:       dcrutil.Amount(txOut.Value),
+			PkScript:    txOut.PkScript,
+			Addresses:   outAddresses,
+		}
+		destAddresses = append(destAddresses, outAddresses...)
+	}
+
+	for _, credit := range credits {
+		if int(credit.Index) >= len(outputs) {
+			return nil, nil, fmt.Errorf("invalid credit index %d",
+				credit.Index)
+		}
+
+		output := &outputs[credit.Index]
+		output.IsOurAddress = true
+		output.Account = credit.Account
+		output.Internal = credit.Internal
+	}
+
+	return outputs, destAddresses, nil
+}
+
 // minedTransactionsToDetails is a helper function which converts a summary
 // information about mined transactions to a TransactionDetail.
 func minedTransactionsToDetails(
@@ -645,15 +809,11 @@ func minedTransactionsToDetails(
 			return nil, err
 		}
 
-		var destAddresses []dcrutil.Address
-		for _, txOut := range wireTx.TxOut {
-			_, outAddresses, _, err := txscript.ExtractPkScriptAddrs(
-				txOut.Version, txOut.PkScript, chainParams)
-			if err != nil {
-				return nil, err
-			}
-
-			destAddresses = append(destAddresses, outAddresses...)
+		outputs, destAddresses, err := outputDetails(
+			wireTx, tx.Credits, chainParams,
+		)
+		if err != nil {
+			return nil, err
 		}
 
 		txDetail := &lnwallet.TransactionDetail{
@@ -664,6 +824,7 @@ func minedTransactionsToDetails(
 			Timestamp:        block.Timestamp,
 			TotalFees:        tx.Fee,
 			DestAddresses:    destAddresses,
+			OutputDetails:    outputs,
 			RawTx:            tx.Transaction,
 		}
 
@@ -693,16 +854,11 @@ func unminedTransactionsToDetail(
 		return nil, err
 	}
 
-	var destAddresses []dcrutil.Address
-	for _, txOut := range wireTx.TxOut {
-		_, outAddresses, _, err :=
-			txscript.ExtractPkScriptAddrs(txOut.Version,
-				txOut.PkScript, chainParams)
-		if err != nil {
-			return nil, err
-		}
-
-		destAddresses = append(destAddresses, outAddresses...)
+	outputs, destAddresses, err := outputDetails(
+		wireTx, summary.Credits, chainParams,
+	)
+	if err != nil {
+		return nil, err
 	}
 
 	txDetail := &lnwallet.TransactionDetail{
@@ -710,6 +866,7 @@ func unminedTransactionsToDetail(
 		TotalFees:     summary.Fee,
 		Timestamp:     summary.Timestamp,
 		DestAddresses: destAddresses,
+		OutputDetails: outputs,
 		RawTx:         summary.Transaction,
 	}
 
@@ -787,6 +944,11 @@ type txSubscriptionClient struct {
 	wallet      pb.WalletServiceClient
 	chainParams *chaincfg.Params
 
+	// lastHeight is the height of the best block known to the wallet
+	// when the last notification was received. It's only accessed by the
+	// notificationProxier.
+	lastHeight int32
+
 	wg     sync.WaitGroup
 	ctx    context.Context
 	cancel func()
@@ -819,25 +981,41 @@ func (t *txSubscriptionClient) Cancel() {
 // notificationProxier proxies the notifications received by the underlying
 // wallet's notification client to a higher-level TransactionSubscription
 // client.
+//
+// The notifications of the wallet break when it restarts, in which case they
+// are registered for again once the wallet is back.
 func (t *txSubscriptionClient) notificationProxier() {
+	defer t.wg.Done()
+
 	for {
 		msg, err := t.txClient.Recv()
-		if err == io.EOF {
+		if err == io.EOF || t.ctx.Err() != nil {
 			// Cancel() was called.
-			break
+			return
 		}
 		if err != nil {
 			dcrwLog.Errorf("Error during tx subscription: %v", err)
-			break
+			if !t.reconnect() {
+				return
+			}
+			continue
 		}
 
 		// TODO(roasbeef): handle detached blocks
+		currentHeight := t.lastHeight
 		ctxb := context.Background()
 		bestBlockResp, err := t.wallet.BestBlock(ctxb, &pb.BestBlockRequest{})
 		if err != nil {
 			dcrwLog.Errorf("Unable to query best block in tx subscription")
+		} else {
+			currentHeight = int32(bestBlockResp.Height)
 		}
-		currentHeight := int32(bestBlockResp.Height)
+		for _, block := range msg.AttachedBlocks {
+			if block.Height > currentHeight {
+				currentHeight = block.Height
+			}
+		}
+		t.lastHeight = currentHeight
 
 		// Launch a goroutine to re-package and send notifications for
 		// any newly confirmed transactions.
@@ -880,8 +1058,103 @@ func (t *txSubscriptionClient) notificationProxier() {
 			}
 		}()
 	}
+}
+
+// reconnect registers for the transaction notifications of the wallet again
+// after they broke, retrying with an exponential backoff until the wallet is
+// reachable. The transactions mined in the blocks missed in the meantime are
+// then notified. It returns false if the subscription was canceled first.
+func (t *txSubscriptionClient) reconnect() bool {
+	backoff := minReconnectBackoff
+	for {
+		select {
+		case <-time.After(backoff):
+		case <-t.ctx.Done():
+			return false
+		}
 
-	t.wg.Done()
+		// We register for the notifications before catching up with
+		// the missed blocks, so that no transaction falls in between.
+		req := &pb.TransactionNotificationsRequest{}
+		stream, err := t.wallet.TransactionNotifications(t.ctx, req)
+		if err == nil {
+			err = t.notifyMissedBlocks()
+		}
+		if err == nil {
+			dcrwLog.Infof("Tx subscription restored at height %v",
+				t.lastHeight)
+			t.txClient = stream
+			return true
+		}
+		if t.ctx.Err() != nil {
+			return false
+		}
+
+		backoff *= 2
+		if backoff > maxReconnectBackoff {
+			backoff = maxReconnectBackoff
+		}
+		dcrwLog.Errorf("Unable to restore tx subscription: %v. "+
+			"Retrying in %v.", err, backoff)
+	}
+}
+
+// notifyMissedBlocks sends the transactions of the wallet mined in the blocks
+// connected after lastHeight, up to the current best block of the wallet.
+func (t *txSubscriptionClient) notifyMissedBlocks() error {
+	bestBlockResp, err := t.wallet.BestBlock(t.ctx, &pb.BestBlockRequest{})
+	if err != nil {
+		return err
+	}
+	bestHeight := int32(bestBlockResp.Height)
+	if bestHeight <= t.lastHeight {
+		return nil
+	}
+
+	dcrwLog.Infof("Rescanning blocks %v to %v for missed transactions",
+		t.lastHeight+1, bestHeight)
+
+	req := &pb.GetTransactionsRequest{
+		StartingBlockHeight: t.lastHeight + 1,
+		EndingBlockHeight:   bestHeight,
+	}
+	stream, err := t.wallet.GetTransactions(t.ctx, req)
+	if err != nil {
+		return err
+	}
+
+	var details []*lnwallet.TransactionDetail
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if msg.MinedTransactions == nil {
+			continue
+		}
+
+		minedTxs, err := minedTransactionsToDetails(
+			bestHeight, msg.MinedTransactions, t.chainParams,
+		)
+		if err != nil {
+			return err
+		}
+		details = append(details, minedTxs...)
+	}
+
+	for _, d := range details {
+		select {
+		case t.confirmed <- d:
+		case <-t.ctx.Done():
+			return t.ctx.Err()
+		}
+	}
+	t.lastHeight = bestHeight
+
+	return nil
 }
 
 // SubscribeTransactions returns a TransactionSubscription client which
@@ -891,8 +1164,16 @@ func (t *txSubscriptionClient) notificationProxier() {
 //
 // This is a part of the WalletController interface.
 func (b *DcrWallet) SubscribeTransactions() (lnwallet.TransactionSubscription, error) {
-	req := &pb.TransactionNotificationsRequest{}
+	// The best height is tracked so that the transactions of the blocks
+	// missed while the wallet is unreachable are notified once it's back.
 	ctx, cancel := context.WithCancel(context.Background())
+	bestBlockResp, err := b.wallet.BestBlock(ctx, &pb.BestBlockRequest{})
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	req := &pb.TransactionNotificationsRequest{}
 	stream, err := b.wallet.TransactionNotifications(ctx, req)
 	if err != nil {
 		cancel()
@@ -905,6 +1186,7 @@ func (b *DcrWallet) SubscribeTransactions() (lnwallet.TransactionSubscription, e
 		unconfirmed: make(chan *lnwallet.TransactionDetail),
 		wallet:      b.wallet,
 		chainParams: b.chainParams,
+		lastHeight:  int32(bestBlockResp.Height),
 		ctx:         ctx,
 		cancel:      cancel,
 	}
@@ -0,0 +1,512 @@
+package remotesigner
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrec/secp256k1/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/input"
+	"github.com/decred/dcrlnd/keychain"
+	"github.com/decred/dcrlnd/lnrpc/signrpc"
+	"github.com/decred/dcrlnd/lnrpc/walletrpc"
+	"github.com/decred/dcrlnd/lnwallet"
+	"github.com/decred/dcrlnd/macaroons"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"gopkg.in/macaroon.v2"
+)
+
+// hotKeyFamilies are the key families whose private keys must be available in
+// the clear to the node, such as its identity key used by the transport and
+// the onion router, or the revocation root producing its commitment secrets.
+// These keys are never used to sign transactions, so they are derived locally
+// from a secret provided by the remote signer, instead of being held by it.
+var hotKeyFamilies = map[keychain.KeyFamily]struct{}{
+	keychain.KeyFamilyRevocationRoot: {},
+	keychain.KeyFamilyNodeKey:        {},
+	keychain.KeyFamilyTowerSession:   {},
+	keychain.KeyFamilyTowerID:        {},
+}
+
+// isHotKeyFamily returns true if the private keys of the given family are
+// derived locally rather than held by the remote signer.
+func isHotKeyFamily(keyFam keychain.KeyFamily) bool {
+	_, ok := hotKeyFamilies[keyFam]
+	return ok
+}
+
+// Config houses the parameters required to connect to the remote signer.
+type Config struct {
+	// RPCHost is the host:port of the gRPC interface of the remote signer.
+	RPCHost string
+
+	// MacaroonPath is the path to the macaroon granting access to the
+	// signrpc and walletrpc sub-servers of the remote signer.
+	MacaroonPath string
+
+	// TLSCertPath is the path to the TLS certificate of the remote signer.
+	TLSCertPath string
+
+	// Timeout is the duration within which the remote signer must respond
+	// to each request.
+	Timeout time.Duration
+}
+
+// Signer is a keychain.SecretKeyRing, input.Signer and lnwallet.MessageSigner
+// that delegates the derivation of keys and the signing of transactions to a
+// remote dcrlnd instance over its signrpc and walletrpc sub-servers. This
+// allows running a node holding no private key able to spend its funds, on
+// top of a watch-only wallet.
+type Signer struct {
+	cfg Config
+
+	conn      *grpc.ClientConn
+	signer    signrpc.SignerClient
+	walletKit walletrpc.WalletKitClient
+
+	// hotKeys caches the private keys of the hot key families already
+	// derived, by locator.
+	hotKeys    map[keychain.KeyLocator]*secp256k1.PrivateKey
+	hotKeysMtx sync.Mutex
+}
+
+// Compile time type assertions to ensure Signer fulfills the desired
+// interfaces.
+var _ keychain.SecretKeyRing = (*Signer)(nil)
+var _ input.Signer = (*Signer)(nil)
+var _ lnwallet.MessageSigner = (*Signer)(nil)
+
+// New connects to the remote signer described by the passed config.
+func New(cfg Config) (*Signer, error) {
+	creds, err := credentials.NewClientTLSFromFile(cfg.TLSCertPath, "")
+	if err != nil {
+		return nil, fmt.Errorf("unable to read remote signer TLS "+
+			"cert: %v", err)
+	}
+
+	macBytes, err := ioutil.ReadFile(cfg.MacaroonPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read remote signer "+
+			"macaroon: %v", err)
+	}
+	mac := &macaroon.Macaroon{}
+	if err := mac.UnmarshalBinary(macBytes); err != nil {
+		return nil, fmt.Errorf("unable to decode remote signer "+
+			"macaroon: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Timeout)
+	defer cancel()
+
+	macCred := macaroons.NewMacaroonCredential(mac)
+	conn, err := grpc.DialContext(
+		ctx, cfg.RPCHost, grpc.WithTransportCredentials(creds),
+		grpc.WithPerRPCCredentials(macCred), grpc.WithBlock(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to remote signer "+
+			"%v: %v", cfg.RPCHost, err)
+	}
+
+	log.Infof("Connected to remote signer at %v", cfg.RPCHost)
+
+	return &Signer{
+		cfg:       cfg,
+		conn:      conn,
+		signer:    signrpc.NewSignerClient(conn),
+		walletKit: walletrpc.NewWalletKitClient(conn),
+		hotKeys:   make(map[keychain.KeyLocator]*secp256k1.PrivateKey),
+	}, nil
+}
+
+// Stop closes the connection to the remote signer.
+func (s *Signer) Stop() error {
+	return s.conn.Close()
+}
+
+// requestContext returns the context of a request to the remote signer,
+// bounded by the configured timeout.
+func (s *Signer) requestContext() (context.Context, func()) {
+	return context.WithTimeout(context.Background(), s.cfg.Timeout)
+}
+
+// marshalKeyLocator converts the passed key locator into its RPC counterpart.
+func marshalKeyLocator(keyLoc keychain.KeyLocator) *signrpc.KeyLocator {
+	return &signrpc.KeyLocator{
+		KeyFamily: int32(keyLoc.Family),
+		KeyIndex:  int32(keyLoc.Index),
+	}
+}
+
+// parseKeyDescriptor converts a key descriptor returned by the remote signer
+// into a keychain.KeyDescriptor.
+func parseKeyDescriptor(desc *signrpc.KeyDescriptor) (keychain.KeyDescriptor,
+	error) {
+
+	pubKey, err := secp256k1.ParsePubKey(desc.RawKeyBytes)
+	if err != nil {
+		return keychain.KeyDescriptor{}, fmt.Errorf("unable to parse "+
+			"remote signer pubkey: %v", err)
+	}
+
+	keyDesc := keychain.KeyDescriptor{PubKey: pubKey}
+	if desc.KeyLoc != nil {
+		keyDesc.KeyLocator = keychain.KeyLocator{
+			Family: keychain.KeyFamily(desc.KeyLoc.KeyFamily),
+			Index:  uint32(desc.KeyLoc.KeyIndex),
+		}
+	}
+
+	return keyDesc, nil
+}
+
+// hotPrivKey returns the private key of the given locator of a hot key family.
+// The key is the shared secret of the ECDH between the key of the same locator
+// held by the remote signer and its own public key, which only the signer can
+// produce, while not revealing anything about the key it holds.
+func (s *Signer) hotPrivKey(keyLoc keychain.KeyLocator) (*secp256k1.PrivateKey,
+	error) {
+
+	s.hotKeysMtx.Lock()
+	defer s.hotKeysMtx.Unlock()
+
+	if privKey, ok := s.hotKeys[keyLoc]; ok {
+		return privKey, nil
+	}
+
+	ctx, cancel := s.requestContext()
+	defer cancel()
+
+	rpcKeyLoc := marshalKeyLocator(keyLoc)
+	remoteDesc, err := s.walletKit.DeriveKey(ctx, rpcKeyLoc)
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive remote key %v/%v: %v",
+			keyLoc.Family, keyLoc.Index, err)
+	}
+
+	resp, err := s.signer.DeriveSharedKey(ctx, &signrpc.SharedKeyRequest{
+		EphemeralPubkey: remoteDesc.RawKeyBytes,
+		KeyLoc:          rpcKeyLoc,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive shared key %v/%v: %v",
+			keyLoc.Family, keyLoc.Index, err)
+	}
+
+	privKey, _ := secp256k1.PrivKeyFromBytes(resp.SharedKey)
+	if privKey.D.Sign() == 0 || privKey.D.Cmp(secp256k1.S256().N) >= 0 {
+		return nil, fmt.Errorf("invalid private key derived for %v/%v",
+			keyLoc.Family, keyLoc.Index)
+	}
+
+	s.hotKeys[keyLoc] = privKey
+
+	return privKey, nil
+}
+
+// DeriveNextKey attempts to derive the *next* key within the key family
+// (account in BIP43) specified. The next index of the family is tracked by the
+// remote signer.
+//
+// NOTE: This is part of the keychain.KeyRing interface.
+func (s *Signer) DeriveNextKey(keyFam keychain.KeyFamily) (
+	keychain.KeyDescriptor, error) {
+
+	ctx, cancel := s.requestContext()
+	defer cancel()
+
+	desc, err := s.walletKit.DeriveNextKey(ctx, &walletrpc.KeyReq{
+		KeyFamily: int32(keyFam),
+	})
+	if err != nil {
+		return keychain.KeyDescriptor{}, err
+	}
+
+	keyDesc, err := parseKeyDescriptor(desc)
+	if err != nil {
+		return keychain.KeyDescriptor{}, err
+	}
+
+	if !isHotKeyFamily(keyFam) {
+		return keyDesc, nil
+	}
+
+	privKey, err := s.hotPrivKey(keyDesc.KeyLocator)
+	if err != nil {
+		return keychain.KeyDescriptor{}, err
+	}
+
+	return keychain.KeyDescriptor{
+		KeyLocator: keyDesc.KeyLocator,
+		PubKey:     privKey.PubKey(),
+	}, nil
+}
+
+// DeriveKey attempts to derive an arbitrary key specified by the passed
+// KeyLocator.
+//
+// NOTE: This is part of the keychain.KeyRing interface.
+func (s *Signer) DeriveKey(keyLoc keychain.KeyLocator) (keychain.KeyDescriptor,
+	error) {
+
+	if isHotKeyFamily(keyLoc.Family) {
+		privKey, err := s.hotPrivKey(keyLoc)
+		if err != nil {
+			return keychain.KeyDescriptor{}, err
+		}
+
+		return keychain.KeyDescriptor{
+			KeyLocator: keyLoc,
+			PubKey:     privKey.PubKey(),
+		}, nil
+	}
+
+	ctx, cancel := s.requestContext()
+	defer cancel()
+
+	desc, err := s.walletKit.DeriveKey(ctx, marshalKeyLocator(keyLoc))
+	if err != nil {
+		return keychain.KeyDescriptor{}, err
+	}
+
+	return parseKeyDescriptor(desc)
+}
+
+// DerivePrivKey attempts to derive the private key that corresponds to the
+// passed key descriptor. Only the private keys of the hot key families can be
+// derived, as the others never leave the remote signer.
+//
+// NOTE: This is part of the keychain.SecretKeyRing interface.
+func (s *Signer) DerivePrivKey(keyDesc keychain.KeyDescriptor) (
+	*secp256k1.PrivateKey, error) {
+
+	if !isHotKeyFamily(keyDesc.Family) {
+		return nil, keychain.ErrCannotDerivePrivKey
+	}
+
+	privKey, err := s.hotPrivKey(keyDesc.KeyLocator)
+	if err != nil {
+		return nil, err
+	}
+	if keyDesc.PubKey == nil || keyDesc.PubKey.IsEqual(privKey.PubKey()) {
+		return privKey, nil
+	}
+
+	// The locator doesn't match the public key, so we'll look it up among
+	// the keys of the family derived so far.
+	s.hotKeysMtx.Lock()
+	defer s.hotKeysMtx.Unlock()
+
+	for keyLoc, privKey := range s.hotKeys {
+		if keyLoc.Family == keyDesc.Family &&
+			keyDesc.PubKey.IsEqual(privKey.PubKey()) {
+
+			return privKey, nil
+		}
+	}
+
+	return nil, keychain.ErrCannotDerivePrivKey
+}
+
+// ScalarMult performs a scalar multiplication (ECDH-like operation) between
+// the target key descriptor and remote public key. The output returned will be
+// the sha256 of the resulting shared point serialized in compressed format.
+//
+// NOTE: This is part of the keychain.SecretKeyRing interface.
+func (s *Signer) ScalarMult(keyDesc keychain.KeyDescriptor,
+	pub *secp256k1.PublicKey) ([]byte, error) {
+
+	if isHotKeyFamily(keyDesc.Family) {
+		privKey, err := s.DerivePrivKey(keyDesc)
+		if err != nil {
+			return nil, err
+		}
+
+		x, y := secp256k1.S256().ScalarMult(
+			pub.X, pub.Y, privKey.D.Bytes(),
+		)
+		shared := &secp256k1.PublicKey{X: x, Y: y}
+		h := sha256.Sum256(shared.SerializeCompressed())
+
+		return h[:], nil
+	}
+
+	ctx, cancel := s.requestContext()
+	defer cancel()
+
+	resp, err := s.signer.DeriveSharedKey(ctx, &signrpc.SharedKeyRequest{
+		EphemeralPubkey: pub.SerializeCompressed(),
+		KeyLoc:          marshalKeyLocator(keyDesc.KeyLocator),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.SharedKey, nil
+}
+
+// marshalSignDescriptor converts the passed sign descriptor into its RPC
+// counterpart.
+func marshalSignDescriptor(signDesc *input.SignDescriptor) (
+	*signrpc.SignDescriptor, error) {
+
+	// The remote signer looks up the key by its raw bytes in priority, but
+	// only within the multisig family, so they are only sent if the key
+	// can't be derived from its locator.
+	var (
+		keyDesc *signrpc.KeyDescriptor
+		keyLoc  = signDesc.KeyDesc.KeyLocator
+		pubKey  = signDesc.KeyDesc.PubKey
+	)
+	switch {
+	case !keyLoc.IsEmpty():
+		keyDesc = &signrpc.KeyDescriptor{
+			KeyLoc: marshalKeyLocator(keyLoc),
+		}
+
+	case pubKey != nil:
+		keyDesc = &signrpc.KeyDescriptor{
+			RawKeyBytes: pubKey.SerializeCompressed(),
+		}
+	}
+
+	var doubleTweak []byte
+	if signDesc.DoubleTweak != nil {
+		doubleTweak = signDesc.DoubleTweak.Serialize()
+	}
+
+	if signDesc.Output == nil {
+		return nil, fmt.Errorf("the output being spent must be " +
+			"specified")
+	}
+
+	return &signrpc.SignDescriptor{
+		KeyDesc:       keyDesc,
+		SingleTweak:   signDesc.SingleTweak,
+		DoubleTweak:   doubleTweak,
+		WitnessScript: signDesc.WitnessScript,
+		Output: &signrpc.TxOut{
+			Value:    signDesc.Output.Value,
+			PkScript: signDesc.Output.PkScript,
+		},
+		Sighash:    uint32(signDesc.HashType),
+		InputIndex: int32(signDesc.InputIndex),
+	}, nil
+}
+
+// signRequest builds the request to sign the given input of the passed
+// transaction.
+func signRequest(tx *wire.MsgTx, signDesc *input.SignDescriptor) (
+	*signrpc.SignReq, error) {
+
+	rawTx, err := tx.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	rpcSignDesc, err := marshalSignDescriptor(signDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &signrpc.SignReq{
+		RawTxBytes: rawTx,
+		SignDescs:  []*signrpc.SignDescriptor{rpcSignDesc},
+	}, nil
+}
+
+// SignOutputRaw generates a signature for the passed transaction according to
+// the data within the passed input.SignDescriptor, through the remote signer.
+//
+// NOTE: This is part of the input.Signer interface.
+func (s *Signer) SignOutputRaw(tx *wire.MsgTx,
+	signDesc *input.SignDescriptor) ([]byte, error) {
+
+	req, err := signRequest(tx, signDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := s.requestContext()
+	defer cancel()
+
+	resp, err := s.signer.SignOutputRaw(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.RawSigs) != 1 {
+		return nil, fmt.Errorf("remote signer returned %d signatures "+
+			"instead of 1", len(resp.RawSigs))
+	}
+
+	return resp.RawSigs[0], nil
+}
+
+// ComputeInputScript generates a complete InputScript for the passed
+// transaction with the signature as defined within the passed
+// input.SignDescriptor, through the remote signer.
+//
+// NOTE: This is part of the input.Signer interface.
+func (s *Signer) ComputeInputScript(tx *wire.MsgTx,
+	signDesc *input.SignDescriptor) (*input.Script, error) {
+
+	req, err := signRequest(tx, signDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := s.requestContext()
+	defer cancel()
+
+	resp, err := s.signer.ComputeInputScript(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.InputScripts) != 1 {
+		return nil, fmt.Errorf("remote signer returned %d input "+
+			"scripts instead of 1", len(resp.InputScripts))
+	}
+
+	return &input.Script{
+		Witness:   resp.InputScripts[0].Witness,
+		SigScript: resp.InputScripts[0].SigScript,
+	}, nil
+}
+
+// SignMessage signs the passed message with the private key corresponding to
+// the passed public key. Only the keys of the hot key families, such as the
+// node identity key, can sign messages.
+//
+// NOTE: This is part of the lnwallet.MessageSigner interface.
+func (s *Signer) SignMessage(pubKey *secp256k1.PublicKey,
+	msg []byte) (*secp256k1.Signature, error) {
+
+	var privKey *secp256k1.PrivateKey
+	s.hotKeysMtx.Lock()
+	for _, hotKey := range s.hotKeys {
+		if pubKey.IsEqual(hotKey.PubKey()) {
+			privKey = hotKey
+			break
+		}
+	}
+	s.hotKeysMtx.Unlock()
+
+	if privKey == nil {
+		return nil, fmt.Errorf("unable to sign message with key %x: "+
+			"not a locally derived key",
+			pubKey.SerializeCompressed())
+	}
+
+	sig, err := privKey.Sign(chainhash.HashB(msg))
+	if err != nil {
+		return nil, fmt.Errorf("unable sign the message: %v", err)
+	}
+
+	return sig, nil
+}
@@ -1,6 +1,7 @@
 package lnwallet
 
 import (
+	"fmt"
 	"net"
 	"sync"
 
@@ -120,6 +121,14 @@ type ChannelReservation struct {
 	chanOpen    chan *openChanDetails
 	chanOpenErr chan error
 
+	// externalFunding is true if the funding transaction for this
+	// reservation is assembled outside of the reservation, for instance
+	// when funding several channels within a single transaction. In this
+	// case no coins are selected for the reservation, and the final
+	// funding transaction must be handed over through the
+	// .ProcessExternalFunding() method.
+	externalFunding bool
+
 	wallet *LightningWallet
 }
 
@@ -130,8 +139,8 @@ type ChannelReservation struct {
 func NewChannelReservation(capacity, localFundingAmt dcrutil.Amount,
 	commitFeePerKB AtomPerKByte, wallet *LightningWallet,
 	id uint64, pushMAtoms lnwire.MilliAtom, chainHash *chainhash.Hash,
-	flags lnwire.FundingFlag,
-	tweaklessCommit bool) (*ChannelReservation, error) {
+	flags lnwire.FundingFlag, tweaklessCommit,
+	anchors bool) (*ChannelReservation, error) {
 
 	var (
 		ourBalance   lnwire.MilliAtom
@@ -139,12 +148,27 @@ func NewChannelReservation(capacity, localFundingAmt dcrutil.Amount,
 		initiator    bool
 	)
 
-	commitFee := commitFeePerKB.FeeForSize(input.CommitmentTxSize)
+	// The anchor commitment format builds on top of the tweakless one, so
+	// we'll only use it if both were negotiated.
+	anchorCommit := anchors && tweaklessCommit
+
+	// Based on the negotiated commitment format, we'll determine the
+	// size of the initial commitment and the total amount the funder must
+	// pay for it. When anchors are in use, the funder also pays for the
+	// value of both of the anchor outputs.
+	commitSize := input.CommitmentTxSize
+	var anchorsAmt dcrutil.Amount
+	if anchorCommit {
+		commitSize = input.AnchorCommitmentTxSize
+		anchorsAmt = 2 * anchorSize
+	}
+
+	commitFee := commitFeePerKB.FeeForSize(commitSize)
 	localFundingMAtoms := lnwire.NewMAtomsFromAtoms(localFundingAmt)
 	// TODO(halseth): make method take remote funding amount directly
 	// instead of inferring it from capacity and local amt.
 	capacityMAtoms := lnwire.NewMAtomsFromAtoms(capacity)
-	feeMAtoms := lnwire.NewMAtomsFromAtoms(commitFee)
+	feeMAtoms := lnwire.NewMAtomsFromAtoms(commitFee + anchorsAmt)
 
 	// If we're the responder to a single-funder reservation, then we have
 	// no initial balance in the channel unless the remote party is pushing
@@ -214,9 +238,14 @@ func NewChannelReservation(capacity, localFundingAmt dcrutil.Amount,
 	// non-zero push amt (there's no pushing for dual funder), then this is
 	// a single-funder channel.
 	if ourBalance == 0 || theirBalance == 0 || pushMAtoms != 0 {
-		if tweaklessCommit {
+		switch {
+		case anchorCommit:
+			chanType = channeldb.SingleFunderAnchors
+
+		case tweaklessCommit:
 			chanType = channeldb.SingleFunderTweakless
-		} else {
+
+		default:
 			chanType = channeldb.SingleFunder
 		}
 	} else {
@@ -276,6 +305,40 @@ func (r *ChannelReservation) SetNumConfsRequired(numConfs uint16) {
 	r.partialState.NumConfsRequired = numConfs
 }
 
+// SetOurUpfrontShutdown sets the script to which our funds are paid when
+// mutually closing the channel, as committed to the remote party when opening
+// it. An empty script means that we don't commit to any script.
+func (r *ChannelReservation) SetOurUpfrontShutdown(
+	script lnwire.DeliveryAddress) {
+
+	r.Lock()
+	defer r.Unlock()
+
+	r.partialState.LocalShutdownScript = script
+}
+
+// SetTheirUpfrontShutdown sets the script to which the funds of the remote
+// party must be paid when mutually closing the channel, as they committed to
+// when opening it. An empty script means that they didn't commit to any
+// script.
+func (r *ChannelReservation) SetTheirUpfrontShutdown(
+	script lnwire.DeliveryAddress) {
+
+	r.Lock()
+	defer r.Unlock()
+
+	r.partialState.RemoteShutdownScript = script
+}
+
+// OurUpfrontShutdown returns the script to which our funds are paid when
+// mutually closing the channel, if we committed to one.
+func (r *ChannelReservation) OurUpfrontShutdown() lnwire.DeliveryAddress {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.partialState.LocalShutdownScript
+}
+
 // CommitConstraints takes the constraints that the remote party specifies for
 // the type of commitments that we can generate for them. These constraints
 // include several parameters that serve as flow control restricting the amount
@@ -384,6 +447,46 @@ func (r *ChannelReservation) ProcessContribution(theirContribution *ChannelContr
 	return <-errChan
 }
 
+// FundingOutput returns the 2-of-2 multi-sig output which must be included in
+// the funding transaction of this reservation.
+//
+// NOTE: This method will only succeed once the .ProcessContribution() method
+// has been called.
+func (r *ChannelReservation) FundingOutput() (*wire.TxOut, error) {
+	r.RLock()
+	defer r.RUnlock()
+
+	if r.theirContribution == nil {
+		return nil, fmt.Errorf("remote contribution not yet processed")
+	}
+
+	ourKey := r.ourContribution.MultiSigKey
+	theirKey := r.theirContribution.MultiSigKey
+	_, multiSigOut, err := input.GenFundingPkScript(
+		ourKey.PubKey.SerializeCompressed(),
+		theirKey.PubKey.SerializeCompressed(),
+		int64(r.partialState.Capacity),
+	)
+	return multiSigOut, err
+}
+
+// ProcessExternalFunding records the final funding transaction of a
+// reservation which is funded externally. The transaction must contain the
+// output returned by .FundingOutput(). Once the funding transaction has been
+// processed, both commitment transactions are created, and our signature for
+// the remote party's version of the commitment transaction is generated.
+func (r *ChannelReservation) ProcessExternalFunding(fundingTx *wire.MsgTx) error {
+	errChan := make(chan error, 1)
+
+	r.wallet.msgChan <- &addExternalFundingMsg{
+		pendingFundingID: r.reservationID,
+		fundingTx:        fundingTx,
+		err:              errChan,
+	}
+
+	return <-errChan
+}
+
 // ProcessSingleContribution verifies, and records the initiator's contribution
 // to this pending single funder channel. Internally, no further action is
 // taken other than recording the initiator's contribution to the single funder
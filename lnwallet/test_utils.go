@@ -220,10 +220,15 @@ func CreateTestChannels(tweaklessCommits bool) (
 	}
 	aliceCommitPoint := input.ComputeCommitmentPoint(aliceFirstRevoke[:])
 
+	chanType := channeldb.SingleFunderTweakless
+	if !tweaklessCommits {
+		chanType = channeldb.SingleFunder
+	}
+
 	netParams := chaincfg.RegNetParams()
 	aliceCommitTx, bobCommitTx, err := CreateCommitmentTxns(
 		channelBal, channelBal, &aliceCfg, &bobCfg, aliceCommitPoint,
-		bobCommitPoint, *fundingTxIn, netParams, tweaklessCommits)
+		bobCommitPoint, *fundingTxIn, netParams, chanType)
 	if err != nil {
 		return nil, nil, nil, err
 	}
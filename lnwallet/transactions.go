@@ -7,6 +7,7 @@ import (
 	"github.com/decred/dcrd/dcrec/secp256k1/v2"
 	"github.com/decred/dcrd/dcrutil/v2"
 	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/channeldb"
 	"github.com/decred/dcrlnd/input"
 )
 
@@ -20,6 +21,12 @@ const (
 	// StateHintSize bytes amongst the sequence number and locktime fields
 	// of the commitment transaction.
 	maxStateHint uint64 = (1 << 48) - 1
+
+	// anchorSize is the value of each of the anchor outputs present on
+	// the commitment transactions of channels using the anchor commitment
+	// format. It matches the default dust limit, so that the anchors are
+	// relayed under the default relay fee policy.
+	anchorSize = dcrutil.Amount(6030)
 )
 
 var (
@@ -44,8 +51,8 @@ var (
 // In order to spend the HTLC output, the witness for the passed transaction
 // should be:
 //   * <sender sig> <recvr sig> <preimage>
-func createHtlcSuccessTx(htlcOutput wire.OutPoint, htlcAmt dcrutil.Amount,
-	csvDelay uint32,
+func createHtlcSuccessTx(chanType channeldb.ChannelType,
+	htlcOutput wire.OutPoint, htlcAmt dcrutil.Amount, csvDelay uint32,
 	revocationKey, delayKey *secp256k1.PublicKey) (*wire.MsgTx, error) {
 
 	// Create a version two transaction (as the success version of this
@@ -54,9 +61,11 @@ func createHtlcSuccessTx(htlcOutput wire.OutPoint, htlcAmt dcrutil.Amount,
 	successTx.Version = input.LNTxVersion
 
 	// The input to the transaction is the outpoint that creates the
-	// original HTLC on the sender's commitment transaction.
+	// original HTLC on the sender's commitment transaction. Its sequence
+	// signals the confirmation required by channels with anchors.
 	successTx.AddTxIn(&wire.TxIn{
 		PreviousOutPoint: htlcOutput,
+		Sequence:         HtlcSecondLevelInputSequence(chanType),
 	})
 
 	// Next, we'll generate the script used as the output for all second
@@ -98,7 +107,8 @@ func createHtlcSuccessTx(htlcOutput wire.OutPoint, htlcAmt dcrutil.Amount,
 // NOTE: The passed amount for the HTLC should take into account the required
 // fee rate at the time the HTLC was created. The fee should be able to
 // entirely pay for this (tiny: 1-in 1-out) transaction.
-func createHtlcTimeoutTx(htlcOutput wire.OutPoint, htlcAmt dcrutil.Amount,
+func createHtlcTimeoutTx(chanType channeldb.ChannelType,
+	htlcOutput wire.OutPoint, htlcAmt dcrutil.Amount,
 	cltvExpiry, csvDelay uint32,
 	revocationKey, delayKey *secp256k1.PublicKey) (*wire.MsgTx, error) {
 
@@ -110,9 +120,11 @@ func createHtlcTimeoutTx(htlcOutput wire.OutPoint, htlcAmt dcrutil.Amount,
 	timeoutTx.LockTime = cltvExpiry
 
 	// The input to the transaction is the outpoint that creates the
-	// original HTLC on the sender's commitment transaction.
+	// original HTLC on the sender's commitment transaction. Its sequence
+	// signals the confirmation required by channels with anchors.
 	timeoutTx.AddTxIn(&wire.TxIn{
 		PreviousOutPoint: htlcOutput,
+		Sequence:         HtlcSecondLevelInputSequence(chanType),
 	})
 
 	// Next, we'll generate the script used as the output for all second
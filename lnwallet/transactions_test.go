@@ -864,7 +864,7 @@ func TestCommitmentAndHTLCTransactions(t *testing.T) {
 		htlcResolutions, err := extractHtlcResolutions(
 			AtomPerKByte(test.commitment.FeePerKB), true, signer,
 			htlcs, keys, channel.localChanCfg, channel.remoteChanCfg,
-			commitTx.TxHash(),
+			commitTx.TxHash(), channel.channelState.ChanType,
 		)
 		if err != nil {
 			t.Errorf("Case %d: Failed to extract HTLC resolutions: %v", i, err)
@@ -1087,8 +1087,9 @@ func testSpendValidation(t *testing.T, tweakless bool) {
 		NoDelayKey:    bobPayKey,
 	}
 	commitmentTx, err := CreateCommitTx(
-		*fakeFundingTxIn, keyRing, csvTimeout, channelBalance,
-		channelBalance, DefaultDustLimit(),
+		channeldb.SingleFunder, *fakeFundingTxIn, keyRing, aliceKeyPub,
+		bobKeyPub, csvTimeout, channelBalance, channelBalance,
+		DefaultDustLimit(), 0,
 	)
 	if err != nil {
 		t.Fatalf("unable to create commitment transaction: %v", nil)
@@ -1264,3 +1265,121 @@ func TestCommitmentSpendValidation(t *testing.T) {
 		})
 	}
 }
+
+// TestCommitTxAnchors asserts that commitment transactions of channels using
+// the anchor commitment format carry an anchor output for each party that has
+// something to protect, and that other channel types don't carry any.
+func TestCommitTxAnchors(t *testing.T) {
+	t.Parallel()
+
+	txid, err := chainhash.NewHash(testHdSeed.CloneBytes())
+	if err != nil {
+		t.Fatalf("unable to create txid: %v", err)
+	}
+	fundingOut := &wire.OutPoint{
+		Hash:  *txid,
+		Index: 50,
+		Tree:  wire.TxTreeRegular,
+	}
+
+	const (
+		channelBalance = dcrutil.Amount(1 * 10e8)
+		csvTimeout     = uint32(5)
+	)
+	fakeFundingTxIn := wire.NewTxIn(fundingOut, int64(channelBalance), nil)
+
+	_, aliceKeyPub := secp256k1.PrivKeyFromBytes(testWalletPrivKey)
+	_, bobKeyPub := secp256k1.PrivKeyFromBytes(bobsPrivKey)
+	_, commitPoint := secp256k1.PrivKeyFromBytes(testHdSeed.CloneBytes())
+
+	keyRing := &CommitmentKeyRing{
+		DelayKey:      input.TweakPubKey(aliceKeyPub, commitPoint),
+		RevocationKey: input.DeriveRevocationPubkey(bobKeyPub, commitPoint),
+		NoDelayKey:    bobKeyPub,
+	}
+
+	anchorPkScript := func(key *secp256k1.PublicKey) []byte {
+		script, err := input.CommitScriptAnchor(key)
+		if err != nil {
+			t.Fatalf("unable to create anchor script: %v", err)
+		}
+		pkScript, err := input.ScriptHashPkScript(script)
+		if err != nil {
+			t.Fatalf("unable to create anchor pkscript: %v", err)
+		}
+		return pkScript
+	}
+	aliceAnchor := anchorPkScript(aliceKeyPub)
+	bobAnchor := anchorPkScript(bobKeyPub)
+
+	testCases := []struct {
+		name         string
+		chanType     channeldb.ChannelType
+		toBob        dcrutil.Amount
+		numHTLCs     int64
+		expOutputs   int
+		expBobAnchor bool
+	}{
+		{
+			name:       "tweakless has no anchors",
+			chanType:   channeldb.SingleFunderTweakless,
+			toBob:      channelBalance,
+			expOutputs: 2,
+		},
+		{
+			name:         "anchors for both outputs",
+			chanType:     channeldb.SingleFunderAnchors,
+			toBob:        channelBalance,
+			expOutputs:   4,
+			expBobAnchor: true,
+		},
+		{
+			name:       "no anchor for dust output",
+			chanType:   channeldb.SingleFunderAnchors,
+			toBob:      0,
+			expOutputs: 2,
+		},
+		{
+			name:         "anchors with htlcs",
+			chanType:     channeldb.SingleFunderAnchors,
+			toBob:        0,
+			numHTLCs:     1,
+			expOutputs:   3,
+			expBobAnchor: true,
+		},
+	}
+
+	for _, test := range testCases {
+		commitTx, err := CreateCommitTx(
+			test.chanType, *fakeFundingTxIn, keyRing, aliceKeyPub,
+			bobKeyPub, csvTimeout, channelBalance, test.toBob,
+			DefaultDustLimit(), test.numHTLCs,
+		)
+		if err != nil {
+			t.Fatalf("%v: unable to create commitment "+
+				"transaction: %v", test.name, err)
+		}
+
+		if len(commitTx.TxOut) != test.expOutputs {
+			t.Fatalf("%v: expected %d outputs, got %d", test.name,
+				test.expOutputs, len(commitTx.TxOut))
+		}
+
+		hasAnchors := test.chanType.HasAnchors()
+		found, idx := input.FindScriptOutputIndex(commitTx, aliceAnchor)
+		if found != hasAnchors {
+			t.Fatalf("%v: expected local anchor=%v, got %v",
+				test.name, hasAnchors, found)
+		}
+		if found && commitTx.TxOut[idx].Value != int64(anchorSize) {
+			t.Fatalf("%v: expected anchor value %v, got %v",
+				test.name, anchorSize, commitTx.TxOut[idx].Value)
+		}
+
+		found, _ = input.FindScriptOutputIndex(commitTx, bobAnchor)
+		if found != test.expBobAnchor {
+			t.Fatalf("%v: expected remote anchor=%v, got %v",
+				test.name, test.expBobAnchor, found)
+		}
+	}
+}
@@ -112,6 +112,16 @@ type InitFundingReserveMsg struct {
 	// commitment format or not.
 	Tweakless bool
 
+	// Anchors indicates if the channel should use the anchor commitment
+	// format or not. Anchor channels are always tweakless.
+	Anchors bool
+
+	// ExternalFunding indicates that the funding transaction for this
+	// channel will be assembled outside of the reservation, so no coins
+	// should be selected to fund it. The final funding transaction is
+	// later handed to the reservation via ProcessExternalFunding.
+	ExternalFunding bool
+
 	// err is a channel in which all errors will be sent across. Will be
 	// nil if this initial set is successful.
 	//
@@ -154,6 +164,19 @@ type addContributionMsg struct {
 	err chan error
 }
 
+// addExternalFundingMsg represents a message carrying the final funding
+// transaction of an externally funded reservation. Once processed, the
+// reservation will carry both versions of the commitment transaction, and our
+// signature for the remote node's version of the commitment transaction.
+type addExternalFundingMsg struct {
+	pendingFundingID uint64
+
+	fundingTx *wire.MsgTx
+
+	// NOTE: In order to avoid deadlocks, this channel MUST be buffered.
+	err chan error
+}
+
 // addSingleContributionMsg represents a message executing the second phase of
 // a single funder channel reservation workflow. This messages carries the
 // counterparty's "contribution" to the payment channel. As this message is
@@ -290,6 +313,10 @@ type LightningWallet struct {
 // If the wallet has never been created (according to the passed dataDir),
 // first-time setup is executed.
 func NewLightningWallet(cfg Config) (*LightningWallet, error) {
+	if cfg.ChangeAddressType == WitnessPubKey {
+		cfg.ChangeAddressType = PubKeyHash
+	}
+
 	return &LightningWallet{
 		Cfg:              cfg,
 		SecretKeyRing:    cfg.SecretKeyRing,
@@ -388,6 +415,8 @@ out:
 				l.handleSingleContribution(msg)
 			case *addContributionMsg:
 				l.handleContributionMsg(msg)
+			case *addExternalFundingMsg:
+				l.handleExternalFunding(msg)
 			case *addSingleFunderSigsMsg:
 				l.handleSingleFunderSigs(msg)
 			case *addCounterPartySigsMsg:
@@ -465,9 +494,10 @@ func (l *LightningWallet) handleFundingReserveRequest(req *InitFundingReserveMsg
 
 	// If we're on the receiving end of a single funder channel then we
 	// don't need to perform any coin selection, and the remote contributes
-	// all funds. Otherwise, attempt to obtain enough coins to meet the
+	// all funds. The same applies if the funding transaction is assembled
+	// externally. Otherwise, attempt to obtain enough coins to meet the
 	// required funding amount.
-	if req.LocalFundingAmt != 0 {
+	if req.LocalFundingAmt != 0 && !req.ExternalFunding {
 		// Coin selection is done on the basis of sat/kw, so we'll use
 		// the fee rate passed in to perform coin selection.
 		var err error
@@ -509,7 +539,7 @@ func (l *LightningWallet) handleFundingReserveRequest(req *InitFundingReserveMsg
 	reservation, err := NewChannelReservation(
 		capacity, localFundingAmt, req.CommitFeePerKB, l, id,
 		req.PushMAtoms, &l.Cfg.NetParams.GenesisHash, req.Flags,
-		req.Tweakless,
+		req.Tweakless, req.Anchors,
 	)
 	if err != nil {
 		selected.unlockCoins()
@@ -517,6 +547,7 @@ func (l *LightningWallet) handleFundingReserveRequest(req *InitFundingReserveMsg
 		req.resp <- nil
 		return
 	}
+	reservation.externalFunding = req.ExternalFunding
 
 	err = l.initOurContribution(
 		reservation, selected, req.NodeAddr, req.NodeID,
@@ -677,8 +708,9 @@ func CreateCommitmentTxns(localBalance, remoteBalance dcrutil.Amount,
 	ourChanCfg, theirChanCfg *channeldb.ChannelConfig,
 	localCommitPoint, remoteCommitPoint *secp256k1.PublicKey,
 	fundingTxIn wire.TxIn, chainParams *chaincfg.Params,
-	tweaklessCommit bool) (*wire.MsgTx, *wire.MsgTx, error) {
+	chanType channeldb.ChannelType) (*wire.MsgTx, *wire.MsgTx, error) {
 
+	tweaklessCommit := chanType.IsTweakless()
 	localCommitmentKeys := DeriveCommitmentKeys(
 		localCommitPoint, true, tweaklessCommit, ourChanCfg,
 		theirChanCfg,
@@ -688,9 +720,10 @@ func CreateCommitmentTxns(localBalance, remoteBalance dcrutil.Amount,
 		theirChanCfg,
 	)
 
-	ourCommitTx, err := CreateCommitTx(fundingTxIn, localCommitmentKeys,
-		uint32(ourChanCfg.CsvDelay), localBalance, remoteBalance,
-		ourChanCfg.DustLimit)
+	ourCommitTx, err := CreateCommitTx(chanType, fundingTxIn,
+		localCommitmentKeys, ourChanCfg.MultiSigKey.PubKey,
+		theirChanCfg.MultiSigKey.PubKey, uint32(ourChanCfg.CsvDelay),
+		localBalance, remoteBalance, ourChanCfg.DustLimit, 0)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -700,9 +733,10 @@ func CreateCommitmentTxns(localBalance, remoteBalance dcrutil.Amount,
 		return nil, nil, err
 	}
 
-	theirCommitTx, err := CreateCommitTx(fundingTxIn, remoteCommitmentKeys,
-		uint32(theirChanCfg.CsvDelay), remoteBalance, localBalance,
-		theirChanCfg.DustLimit)
+	theirCommitTx, err := CreateCommitTx(chanType, fundingTxIn,
+		remoteCommitmentKeys, theirChanCfg.MultiSigKey.PubKey,
+		ourChanCfg.MultiSigKey.PubKey, uint32(theirChanCfg.CsvDelay),
+		remoteBalance, localBalance, theirChanCfg.DustLimit, 0)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -774,6 +808,15 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 		return
 	}
 
+	// If the funding transaction is assembled externally, then we're done
+	// for now. The commitment transactions will be created once the final
+	// funding transaction is handed over.
+	if pendingReservation.externalFunding {
+		pendingReservation.fundingTx = nil
+		req.err <- nil
+		return
+	}
+
 	// Sort the transaction. Since both side agree to a canonical ordering,
 	// by sorting we no longer need to send the entire transaction. Only
 	// signatures will be exchanged.
@@ -782,8 +825,95 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 
 	// Next, sign all inputs that are ours, collecting the signatures in
 	// order of the inputs.
-	pendingReservation.ourFundingInputScripts = make([]*input.Script, 0,
-		len(ourContribution.Inputs))
+	inputScripts, err := l.signFundingInputs(fundingTx)
+	if err != nil {
+		req.err <- err
+		return
+	}
+	pendingReservation.ourFundingInputScripts = inputScripts
+
+	// With the funding transaction complete, we can now create both
+	// commitment transactions.
+	err = l.handleChanPointReady(
+		pendingReservation, witnessScript, multiSigOut,
+	)
+	if err != nil {
+		req.err <- err
+		return
+	}
+
+	req.err <- nil
+}
+
+// handleExternalFunding processes the final funding transaction of an
+// externally funded reservation. Upon completion, the reservation will carry
+// both versions of the commitment transaction, and our signature for their
+// version of the commitment transaction.
+func (l *LightningWallet) handleExternalFunding(req *addExternalFundingMsg) {
+	l.limboMtx.Lock()
+	pendingReservation, ok := l.fundingLimbo[req.pendingFundingID]
+	l.limboMtx.Unlock()
+	if !ok {
+		req.err <- fmt.Errorf("attempted to update non-existent funding state")
+		return
+	}
+
+	// Grab the mutex on the ChannelReservation to ensure thread-safety
+	pendingReservation.Lock()
+	defer pendingReservation.Unlock()
+
+	if !pendingReservation.externalFunding {
+		req.err <- fmt.Errorf("reservation is not externally funded")
+		return
+	}
+	if pendingReservation.theirContribution == nil {
+		req.err <- fmt.Errorf("remote contribution not yet processed")
+		return
+	}
+
+	// Re-generate the 2-of-2 multi-sig output, and ensure that the
+	// passed funding transaction actually funds it.
+	ourKey := pendingReservation.ourContribution.MultiSigKey
+	theirKey := pendingReservation.theirContribution.MultiSigKey
+	witnessScript, multiSigOut, err := input.GenFundingPkScript(
+		ourKey.PubKey.SerializeCompressed(),
+		theirKey.PubKey.SerializeCompressed(),
+		int64(pendingReservation.partialState.Capacity),
+	)
+	if err != nil {
+		req.err <- err
+		return
+	}
+
+	found, index := input.FindScriptOutputIndex(
+		req.fundingTx, multiSigOut.PkScript,
+	)
+	if !found || req.fundingTx.TxOut[index].Value != multiSigOut.Value {
+		req.err <- fmt.Errorf("funding transaction doesn't contain " +
+			"the funding output")
+		return
+	}
+
+	pendingReservation.fundingTx = req.fundingTx
+
+	err = l.handleChanPointReady(
+		pendingReservation, witnessScript, multiSigOut,
+	)
+	if err != nil {
+		req.err <- err
+		return
+	}
+
+	req.err <- nil
+}
+
+// signFundingInputs signs all inputs of the passed funding transaction which
+// belong to the wallet, returning the generated input scripts in order of the
+// inputs.
+func (l *LightningWallet) signFundingInputs(fundingTx *wire.MsgTx) (
+	[]*input.Script, error) {
+
+	var inputScripts []*input.Script
 	signDesc := input.SignDescriptor{
 		HashType: txscript.SigHashAll,
 	}
@@ -792,8 +922,7 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 		if err == ErrNotMine {
 			continue
 		} else if err != nil {
-			req.err <- err
-			return
+			return nil, err
 		}
 
 		signDesc.Output = &wire.TxOut{
@@ -806,22 +935,35 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 			fundingTx, &signDesc,
 		)
 		if err != nil {
-			req.err <- err
-			return
+			return nil, err
 		}
 		sigScript, err := input.WitnessStackToSigScript(inputScript.Witness)
 		if err != nil {
-			req.err <- err
-			return
+			return nil, err
 		}
 
 		txIn.SignatureScript = sigScript
-		pendingReservation.ourFundingInputScripts = append(
-			pendingReservation.ourFundingInputScripts,
-			inputScript,
-		)
+		inputScripts = append(inputScripts, inputScript)
 	}
 
+	return inputScripts, nil
+}
+
+// handleChanPointReady is called once the final funding transaction of a
+// reservation is known. It records the funding outpoint, and then creates
+// both commitment transactions along with our signature for the remote
+// party's version.
+//
+// NOTE: The reservation's mutex MUST be held when calling this method.
+func (l *LightningWallet) handleChanPointReady(
+	pendingReservation *ChannelReservation, witnessScript []byte,
+	multiSigOut *wire.TxOut) error {
+
+	fundingTx := pendingReservation.fundingTx
+	theirContribution := pendingReservation.theirContribution
+	ourContribution := pendingReservation.ourContribution
+	ourKey := ourContribution.MultiSigKey
+
 	// Locate the index of the multi-sig outpoint in order to record it
 	// since the outputs are canonically sorted. If this is a single funder
 	// workflow, then we'll also need to send this to the remote node.
@@ -858,17 +1000,15 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 	// With the funding tx complete, create both commitment transactions.
 	localBalance := pendingReservation.partialState.LocalCommitment.LocalBalance.ToAtoms()
 	remoteBalance := pendingReservation.partialState.LocalCommitment.RemoteBalance.ToAtoms()
-	tweaklessCommits := pendingReservation.partialState.ChanType.IsTweakless()
 	ourCommitTx, theirCommitTx, err := CreateCommitmentTxns(
 		localBalance, remoteBalance, ourContribution.ChannelConfig,
 		theirContribution.ChannelConfig,
 		ourContribution.FirstCommitmentPoint,
 		theirContribution.FirstCommitmentPoint, fundingTxIn,
-		&l.Cfg.NetParams, tweaklessCommits,
+		&l.Cfg.NetParams, pendingReservation.partialState.ChanType,
 	)
 	if err != nil {
-		req.err <- err
-		return
+		return err
 	}
 
 	// With both commitment transactions constructed, generate the state
@@ -898,8 +1038,7 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 	}
 	err = initStateHints(ourCommitTx, theirCommitTx, stateObfuscator)
 	if err != nil {
-		req.err <- err
-		return
+		return err
 	}
 
 	// Sort both transactions according to the agreed upon canonical
@@ -920,7 +1059,7 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 
 	// Generate a signature for their version of the initial commitment
 	// transaction.
-	signDesc = input.SignDescriptor{
+	signDesc := input.SignDescriptor{
 		WitnessScript: witnessScript,
 		KeyDesc:       ourKey,
 		Output:        multiSigOut,
@@ -929,12 +1068,11 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 	}
 	sigTheirCommit, err := l.Cfg.Signer.SignOutputRaw(theirCommitTx, &signDesc)
 	if err != nil {
-		req.err <- err
-		return
+		return err
 	}
 	pendingReservation.ourCommitmentSig = sigTheirCommit
 
-	req.err <- nil
+	return nil
 }
 
 // handleSingleContribution is called as the second step to a single funder
@@ -1173,14 +1311,14 @@ func (l *LightningWallet) handleSingleFunderSigs(req *addSingleFunderSigsMsg) {
 	// remote node's commitment transactions.
 	localBalance := pendingReservation.partialState.LocalCommitment.LocalBalance.ToAtoms()
 	remoteBalance := pendingReservation.partialState.LocalCommitment.RemoteBalance.ToAtoms()
-	tweaklessCommits := pendingReservation.partialState.ChanType.IsTweakless()
 	ourCommitTx, theirCommitTx, err := CreateCommitmentTxns(
 		localBalance, remoteBalance,
 		pendingReservation.ourContribution.ChannelConfig,
 		pendingReservation.theirContribution.ChannelConfig,
 		pendingReservation.ourContribution.FirstCommitmentPoint,
 		pendingReservation.theirContribution.FirstCommitmentPoint,
-		*fundingTxIn, &l.Cfg.NetParams, tweaklessCommits,
+		*fundingTxIn, &l.Cfg.NetParams,
+		pendingReservation.partialState.ChanType,
 	)
 	if err != nil {
 		req.err <- err
@@ -1398,7 +1536,7 @@ func (l *LightningWallet) selectCoinsAndChange(feeRate AtomPerKByte,
 	// creation of dust.
 	var changeOutputs []*wire.TxOut
 	if changeAmt != 0 && changeAmt > DefaultDustLimit() {
-		changeAddr, err := l.NewAddress(PubKeyHash, true)
+		changeAddr, err := l.NewAddress(l.Cfg.ChangeAddressType, true)
 		if err != nil {
 			return nil, err
 		}
@@ -1449,6 +1587,183 @@ func (l *LightningWallet) selectCoinsAndChange(feeRate AtomPerKByte,
 	}, nil
 }
 
+// FundBatchTx creates a transaction which funds all of the passed outputs
+// using coins from the wallet, and signs all of its inputs. This is used to
+// fund several externally funded reservations with a single transaction. The
+// selected coins are locked, and a closure to unlock them in case the
+// transaction is never broadcast is returned.
+func (l *LightningWallet) FundBatchTx(outputs []*wire.TxOut,
+	feeRate AtomPerKByte, minConfs int32) (*wire.MsgTx, func(), error) {
+
+	if len(outputs) == 0 {
+		return nil, nil, fmt.Errorf("no outputs to fund")
+	}
+
+	// Coin selection only accounts for a single funding output when
+	// estimating the fee, so we'll add the fee for the remaining outputs
+	// to the amount we need to fund.
+	var amt dcrutil.Amount
+	for _, out := range outputs {
+		amt += dcrutil.Amount(out.Value)
+	}
+	extraOutputsSize := int64(len(outputs)-1) *
+		(input.OutputSize + 1 + input.P2SHPkScriptSize)
+	amt += feeRate.FeeForSize(extraOutputsSize)
+
+	selected, err := l.selectCoinsAndChange(feeRate, amt, minConfs, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fundingTx := wire.NewMsgTx()
+	fundingTx.Version = 1
+	for _, txIn := range selected.coins {
+		fundingTx.AddTxIn(txIn)
+	}
+	for _, changeOutput := range selected.change {
+		fundingTx.AddTxOut(changeOutput)
+	}
+	for _, out := range outputs {
+		fundingTx.AddTxOut(out)
+	}
+	txsort.InPlaceSort(fundingTx)
+
+	if _, err := l.signFundingInputs(fundingTx); err != nil {
+		selected.unlockCoins()
+		return nil, nil, err
+	}
+
+	return fundingTx, selected.unlockCoins, nil
+}
+
+// CoinConstraints restricts the coins of the wallet a transaction can spend.
+type CoinConstraints struct {
+	// MinConfs is the minimum number of confirmations of the coins that
+	// can be spent. Zero allows unconfirmed coins to be spent.
+	MinConfs int32
+
+	// Outpoints, if set, are the only coins that can be spent.
+	Outpoints []wire.OutPoint
+}
+
+// constrainedCoins returns the unlocked coins of the wallet satisfying the
+// constraints. An error is returned if one of the requested outpoints isn't
+// among them.
+func (l *LightningWallet) constrainedCoins(
+	constraints *CoinConstraints) ([]*Utxo, error) {
+
+	coins, err := l.ListUnspentWitness(constraints.MinConfs, math.MaxInt32)
+	if err != nil {
+		return nil, err
+	}
+
+	return filterCoins(coins, constraints.Outpoints)
+}
+
+// ConstrainedUtxoSource lists the unspent outputs of the wallet satisfying
+// coin selection constraints, allowing sweeps of the whole wallet to be
+// restricted to a subset of its coins.
+type ConstrainedUtxoSource struct {
+	// Wallet is the wallet whose coins are listed.
+	Wallet *LightningWallet
+
+	// Constraints restricts the listed coins.
+	Constraints CoinConstraints
+}
+
+// ListUnspentWitness returns the unspent outputs satisfying the constraints of
+// the source. The minimum number of confirmations of the constraints takes
+// precedence over the passed one.
+func (s *ConstrainedUtxoSource) ListUnspentWitness(_,
+	maxConfs int32) ([]*Utxo, error) {
+
+	coins, err := s.Wallet.ListUnspentWitness(
+		s.Constraints.MinConfs, maxConfs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return filterCoins(coins, s.Constraints.Outpoints)
+}
+
+// FundSendTx creates a transaction paying the passed outputs with coins of the
+// wallet satisfying the constraints, and signs all of its inputs. If the
+// constraints list outpoints, all of them are spent, any excess being returned
+// as change. The selected coins are locked, and a closure to unlock them in
+// case the transaction is never broadcast is returned.
+func (l *LightningWallet) FundSendTx(outputs []*wire.TxOut,
+	feeRate AtomPerKByte, constraints *CoinConstraints) (*wire.MsgTx,
+	func(), error) {
+
+	if len(outputs) == 0 {
+		return nil, nil, ErrNoOutputs
+	}
+
+	l.coinSelectMtx.Lock()
+	defer l.coinSelectMtx.Unlock()
+
+	coins, err := l.constrainedCoins(constraints)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	spendAll := len(constraints.Outpoints) != 0
+	selectedCoins, changeAmt, err := coinSelectOutputs(
+		feeRate, outputs, coins, spendAll,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx := wire.NewMsgTx()
+	tx.Version = 1
+	for _, coin := range selectedCoins {
+		tx.AddTxIn(wire.NewTxIn(&coin.OutPoint, int64(coin.Value), nil))
+	}
+	for _, out := range outputs {
+		tx.AddTxOut(out)
+	}
+	if changeAmt > DefaultDustLimit() {
+		changeAddr, err := l.NewAddress(l.Cfg.ChangeAddressType, true)
+		if err != nil {
+			return nil, nil, err
+		}
+		changeScript, err := txscript.PayToAddrScript(changeAddr)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		tx.AddTxOut(wire.NewTxOut(int64(changeAmt), changeScript))
+	}
+	txsort.InPlaceSort(tx)
+
+	if _, err := l.signFundingInputs(tx); err != nil {
+		return nil, nil, err
+	}
+
+	// Lock the selected coins, so that concurrent coin selections don't
+	// double spend them until the transaction is broadcast.
+	for _, coin := range selectedCoins {
+		walletLog.Debugf("Locking outpoint %s for use in send",
+			coin.OutPoint)
+		l.lockedOutPoints[coin.OutPoint] = struct{}{}
+		l.LockOutpoint(coin.OutPoint)
+	}
+
+	unlock := func() {
+		l.coinSelectMtx.Lock()
+		defer l.coinSelectMtx.Unlock()
+
+		for _, coin := range selectedCoins {
+			delete(l.lockedOutPoints, coin.OutPoint)
+			l.UnlockOutpoint(coin.OutPoint)
+		}
+	}
+
+	return tx, unlock, nil
+}
+
 // DeriveStateHintObfuscator derives the bytes to be used for obfuscating the
 // state hints from the root to be used for a new channel. The obfuscator is
 // generated via the following computation:
@@ -1654,6 +1969,111 @@ func coinSelectSubtractFees(feeRate AtomPerKByte, amt,
 	return selectedUtxos, outputAmt, changeAmt, nil
 }
 
+// filterCoins returns the coins spending the passed outpoints, or all of them
+// if no outpoint is passed. The tree of the outpoints is ignored. An error is
+// returned if one of the outpoints isn't among the coins.
+func filterCoins(coins []*Utxo, outpoints []wire.OutPoint) ([]*Utxo, error) {
+	if len(outpoints) == 0 {
+		return coins, nil
+	}
+
+	type coinKey struct {
+		hash  chainhash.Hash
+		index uint32
+	}
+	coinsByOutpoint := make(map[coinKey]*Utxo, len(coins))
+	for _, coin := range coins {
+		key := coinKey{coin.OutPoint.Hash, coin.OutPoint.Index}
+		coinsByOutpoint[key] = coin
+	}
+
+	filtered := make([]*Utxo, 0, len(outpoints))
+	for _, outpoint := range outpoints {
+		key := coinKey{outpoint.Hash, outpoint.Index}
+		coin, ok := coinsByOutpoint[key]
+		if !ok {
+			return nil, fmt.Errorf("outpoint %v is not a spendable "+
+				"output of the wallet", outpoint)
+		}
+
+		// Spending the same coin twice would make the transaction
+		// invalid.
+		delete(coinsByOutpoint, key)
+		filtered = append(filtered, coin)
+	}
+
+	return filtered, nil
+}
+
+// coinSelectOutputs attempts to select a sufficient amount of coins to fund
+// the passed outputs, adhering to the specified fee rate, and returns the
+// selected coins along with the amount of the change output. All the coins are
+// selected if spendAll is set. The fee accounts for a change output, which is
+// to be omitted if its amount is dust.
+func coinSelectOutputs(feeRate AtomPerKByte, outputs []*wire.TxOut,
+	coins []*Utxo, spendAll bool) ([]*Utxo, dcrutil.Amount, error) {
+
+	var amt dcrutil.Amount
+	for _, out := range outputs {
+		amt += dcrutil.Amount(out.Value)
+	}
+
+	amtNeeded := amt
+	for {
+		var (
+			totalAtoms    dcrutil.Amount
+			selectedUtxos []*Utxo
+			err           error
+		)
+		if spendAll {
+			selectedUtxos = coins
+			for _, coin := range coins {
+				totalAtoms += coin.Value
+			}
+		} else {
+			totalAtoms, selectedUtxos, err = selectInputs(
+				amtNeeded, coins,
+			)
+			if err != nil {
+				return nil, 0, err
+			}
+		}
+
+		var sizeEstimate input.TxSizeEstimator
+		for _, utxo := range selectedUtxos {
+			switch utxo.AddressType {
+			case PubKeyHash:
+				sizeEstimate.AddP2PKHInput()
+			default:
+				return nil, 0, fmt.Errorf("unsupported address "+
+					"type: %v", utxo.AddressType)
+			}
+		}
+		for _, out := range outputs {
+			sizeEstimate.AddTxOutput(out)
+		}
+
+		// Assume that change output is a P2PKH output.
+		sizeEstimate.AddP2PKHOutput()
+
+		requiredFee := feeRate.FeeForSize(sizeEstimate.Size())
+		if totalAtoms < amt+requiredFee {
+			// When all the coins are spent, there is nothing more
+			// to select.
+			if spendAll {
+				return nil, 0, &ErrInsufficientFunds{
+					amt + requiredFee, totalAtoms,
+				}
+			}
+
+			amtNeeded = amt + requiredFee
+			continue
+		}
+
+		return selectedUtxos, totalAtoms - amt - requiredFee, nil
+	}
+}
+
 // ValidateChannel will attempt to fully validate a newly mined channel, given
 // its funding transaction and existing channel state. If this method returns
 // an error, then the mined channel is invalid, and shouldn't be used.
@@ -3,7 +3,9 @@ package lnwallet
 import (
 	"testing"
 
+	"github.com/decred/dcrd/chaincfg/chainhash"
 	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/wire"
 	"github.com/decred/dcrlnd/input"
 )
 
@@ -348,3 +350,115 @@ func TestCoinSelectSubtractFees(t *testing.T) {
 		})
 	}
 }
+
+// TestCoinSelectOutputs tests that the coins selected to fund arbitrary
+// outputs pay for the size of these outputs, and that all the coins are spent
+// when requested.
+func TestCoinSelectOutputs(t *testing.T) {
+	t.Parallel()
+
+	const feeRate = AtomPerKByte(1e4)
+
+	outputs := []*wire.TxOut{
+		wire.NewTxOut(1e6, make([]byte, input.P2PKHPkScriptSize)),
+		wire.NewTxOut(2e6, make([]byte, input.P2SHPkScriptSize)),
+	}
+
+	sendFee := func(numInputs int) dcrutil.Amount {
+		var sizeEstimate input.TxSizeEstimator
+		for i := 0; i < numInputs; i++ {
+			sizeEstimate.AddP2PKHInput()
+		}
+		sizeEstimate.AddP2PKHOutput()
+		sizeEstimate.AddP2SHOutput()
+		sizeEstimate.AddP2PKHOutput()
+
+		return feeRate.FeeForSize(sizeEstimate.Size())
+	}
+
+	coins := []*Utxo{
+		{AddressType: PubKeyHash, Value: 2e6},
+		{AddressType: PubKeyHash, Value: 2e6},
+		{AddressType: PubKeyHash, Value: 5e6},
+	}
+
+	// Two coins are enough to fund the outputs along with the fee.
+	selected, changeAmt, err := coinSelectOutputs(
+		feeRate, outputs, coins, false,
+	)
+	if err != nil {
+		t.Fatalf("unable to select coins: %v", err)
+	}
+	if len(selected) != 2 {
+		t.Fatalf("expected 2 selected coins, got %v", len(selected))
+	}
+	if changeAmt != 1e6-sendFee(2) {
+		t.Fatalf("expected change %v, got %v", 1e6-sendFee(2),
+			changeAmt)
+	}
+
+	// All coins are spent when requested.
+	selected, changeAmt, err = coinSelectOutputs(
+		feeRate, outputs, coins, true,
+	)
+	if err != nil {
+		t.Fatalf("unable to select coins: %v", err)
+	}
+	if len(selected) != 3 {
+		t.Fatalf("expected 3 selected coins, got %v", len(selected))
+	}
+	if changeAmt != 6e6-sendFee(3) {
+		t.Fatalf("expected change %v, got %v", 6e6-sendFee(3),
+			changeAmt)
+	}
+
+	// Spending all the coins fails if they don't cover the fee.
+	_, _, err = coinSelectOutputs(feeRate, outputs, coins[:1], true)
+	if _, ok := err.(*ErrInsufficientFunds); !ok {
+		t.Fatalf("expected insufficient funds, got %v", err)
+	}
+}
+
+// TestFilterCoins tests that coins are filtered by outpoint, regardless of
+// their tree, and that unknown or duplicate outpoints are rejected.
+func TestFilterCoins(t *testing.T) {
+	t.Parallel()
+
+	coins := []*Utxo{
+		{OutPoint: wire.OutPoint{Hash: chainhash.Hash{1}, Index: 0}},
+		{OutPoint: wire.OutPoint{Hash: chainhash.Hash{1}, Index: 1}},
+		{OutPoint: wire.OutPoint{
+			Hash: chainhash.Hash{2}, Tree: wire.TxTreeStake,
+		}},
+	}
+
+	filtered, err := filterCoins(coins, nil)
+	if err != nil || len(filtered) != len(coins) {
+		t.Fatalf("expected all coins, got %v (%v)", len(filtered), err)
+	}
+
+	filtered, err = filterCoins(coins, []wire.OutPoint{
+		{Hash: chainhash.Hash{2}},
+		{Hash: chainhash.Hash{1}, Index: 1},
+	})
+	if err != nil {
+		t.Fatalf("unable to filter coins: %v", err)
+	}
+	if len(filtered) != 2 || filtered[0] != coins[2] ||
+		filtered[1] != coins[1] {
+
+		t.Fatalf("unexpected filtered coins: %v", filtered)
+	}
+
+	unknown := []wire.OutPoint{{Hash: chainhash.Hash{3}}}
+	if _, err := filterCoins(coins, unknown); err == nil {
+		t.Fatal("expected unknown outpoint to be rejected")
+	}
+
+	duplicate := []wire.OutPoint{
+		{Hash: chainhash.Hash{1}}, {Hash: chainhash.Hash{1}},
+	}
+	if _, err := filterCoins(coins, duplicate); err == nil {
+		t.Fatal("expected duplicate outpoint to be rejected")
+	}
+}
@@ -0,0 +1,83 @@
+package lnwire
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// CustomTypeStart is the start of the custom message type range. Messages of
+// a type within this range aren't defined by the protocol, and are handed over
+// to the applications exchanging them with the peers of the node.
+const CustomTypeStart MessageType = 32768
+
+// Custom represents an application-defined wire message.
+type Custom struct {
+	// Type is the type of the message, which is within the custom message
+	// type range.
+	Type MessageType
+
+	// Data is the opaque payload of the message.
+	Data []byte
+}
+
+// A compile time check to ensure Custom implements the lnwire.Message
+// interface.
+var _ Message = (*Custom)(nil)
+
+// NewCustom instantiates a new custom message of the passed type, carrying the
+// passed data. An error is returned if the type isn't within the custom
+// message type range.
+func NewCustom(msgType MessageType, data []byte) (*Custom, error) {
+	if msgType < CustomTypeStart {
+		return nil, fmt.Errorf("msg type %v is not within the custom "+
+			"range starting at %v", uint16(msgType),
+			uint16(CustomTypeStart))
+	}
+
+	return &Custom{
+		Type: msgType,
+		Data: data,
+	}, nil
+}
+
+// Decode deserializes a serialized Custom message stored in the passed
+// io.Reader observing the specified protocol version. The whole remainder of
+// the reader is read as the data of the message.
+//
+// This is part of the lnwire.Message interface.
+func (c *Custom) Decode(r io.Reader, pver uint32) error {
+	var b bytes.Buffer
+	if _, err := io.Copy(&b, r); err != nil {
+		return err
+	}
+
+	c.Data = b.Bytes()
+
+	return nil
+}
+
+// Encode serializes the target Custom message into the passed io.Writer
+// observing the protocol version specified.
+//
+// This is part of the lnwire.Message interface.
+func (c *Custom) Encode(w io.Writer, pver uint32) error {
+	_, err := w.Write(c.Data)
+	return err
+}
+
+// MsgType returns the integer uniquely identifying this message type on the
+// wire.
+//
+// This is part of the lnwire.Message interface.
+func (c *Custom) MsgType() MessageType {
+	return c.Type
+}
+
+// MaxPayloadLength returns the maximum allowed payload size for a Custom
+// complete message observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (c *Custom) MaxPayloadLength(uint32) uint32 {
+	return MaxMessagePayload
+}
@@ -0,0 +1,37 @@
+package lnwire
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+// TestCustomMessage asserts that custom messages can only be created within
+// the custom message type range, and that they're read back from the wire as
+// they were written.
+func TestCustomMessage(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewCustom(CustomTypeStart-1, nil); err == nil {
+		t.Fatalf("expected failure for type below the custom range")
+	}
+
+	msg, err := NewCustom(CustomTypeStart+1, []byte{1, 2, 3})
+	if err != nil {
+		t.Fatalf("unable to create custom message: %v", err)
+	}
+
+	var b bytes.Buffer
+	if _, err := WriteMessage(&b, msg, 0); err != nil {
+		t.Fatalf("unable to write custom message: %v", err)
+	}
+
+	readMsg, err := ReadMessage(&b, 0)
+	if err != nil {
+		t.Fatalf("unable to read custom message: %v", err)
+	}
+
+	if !reflect.DeepEqual(msg, readMsg) {
+		t.Fatalf("expected message %v, got %v", msg, readMsg)
+	}
+}
@@ -23,6 +23,11 @@ const (
 	// FundingOpen request for a channel that is above their current
 	// soft-limit.
 	ErrChanTooLarge FundingError = 3
+
+	// ErrMaxGlobalPendingChannels is returned by a remote peer when the
+	// number of pending channels across all of its peers exceeds its
+	// maximum policy limit.
+	ErrMaxGlobalPendingChannels FundingError = 4
 )
 
 // String returns a human readable version of the target FundingError.
@@ -34,6 +39,9 @@ func (e FundingError) String() string {
 		return "Synchronizing blockchain"
 	case ErrChanTooLarge:
 		return "channel too large"
+	case ErrMaxGlobalPendingChannels:
+		return "Number of pending channels across all peers exceed " +
+			"maximum"
 	default:
 		return "unknown error"
 	}
@@ -15,6 +15,11 @@ import (
 // knowledge of the feature becomes required on the network.
 type FeatureBit uint16
 
+// IsRequired returns true if the feature bit is even, and false otherwise.
+func (b FeatureBit) IsRequired() bool {
+	return b&0x01 == 0x00
+}
+
 const (
 	// DataLossProtectRequired is a feature bit that indicates that a peer
 	// *requires* the other party know about the data-loss-protect optional
@@ -35,6 +40,16 @@ const (
 	// connection is established.
 	InitialRoutingSync FeatureBit = 3
 
+	// UpfrontShutdownScriptRequired is a feature bit which indicates that
+	// a peer *requires* that the remote peer accept an upfront shutdown
+	// script, to which the payout of cooperative closes is enforced.
+	UpfrontShutdownScriptRequired FeatureBit = 4
+
+	// UpfrontShutdownScriptOptional is an optional feature bit which
+	// indicates that the peer will accept an upfront shutdown script, to
+	// which the payout of cooperative closes is enforced.
+	UpfrontShutdownScriptOptional FeatureBit = 5
+
 	// GossipQueriesRequired is a feature bit that indicates that the
 	// receiving peer MUST know of the set of features that allows nodes to
 	// more efficiently query the network view of peers on the network for
@@ -65,6 +80,56 @@ const (
 	// party's non-delay output should not be tweaked.
 	StaticRemoteKeyOptional FeatureBit = 13
 
+	// PaymentAddrRequired is a required feature bit that signals that a
+	// node requires payment addresses, which are used to mitigate probing
+	// attacks on the receiver of a payment.
+	PaymentAddrRequired FeatureBit = 14
+
+	// PaymentAddrOptional is an optional feature bit that signals that a
+	// node supports payment addresses, which are used to mitigate probing
+	// attacks on the receiver of a payment.
+	PaymentAddrOptional FeatureBit = 15
+
+	// WumboChannelsRequired is a required feature bit that signals that a
+	// node requires its peers to accept channels larger than the default
+	// funding limit of BOLT-0002.
+	WumboChannelsRequired FeatureBit = 18
+
+	// WumboChannelsOptional is an optional feature bit that signals that
+	// a node is willing to accept channels larger than the default
+	// funding limit of BOLT-0002.
+	WumboChannelsOptional FeatureBit = 19
+
+	// AnchorsRequired is a required feature bit that signals that the node
+	// requires channels to be made using commitments having anchor
+	// outputs.
+	AnchorsRequired FeatureBit = 20
+
+	// AnchorsOptional is an optional feature bit that signals that the
+	// node supports channels to be made using commitments having anchor
+	// outputs.
+	AnchorsOptional FeatureBit = 21
+
+	// PaymentMetadataRequired is a required feature bit that signals that
+	// the payment metadata of an invoice must be included in the final hop
+	// payload of the payments to it.
+	PaymentMetadataRequired FeatureBit = 48
+
+	// PaymentMetadataOptional is an optional feature bit that signals
+	// that the payment metadata of an invoice should be included in the
+	// final hop payload of the payments to it.
+	PaymentMetadataOptional FeatureBit = 49
+
+	// ZeroConfRequired is a required feature bit that signals that the
+	// node requires support for channels usable before their funding
+	// transaction confirms.
+	ZeroConfRequired FeatureBit = 50
+
+	// ZeroConfOptional is an optional feature bit that signals that the
+	// node supports channels usable before their funding transaction
+	// confirms.
+	ZeroConfOptional FeatureBit = 51
+
 	// maxAllowedSize is a maximum allowed size of feature vector.
 	//
 	// NOTE: Within the protocol, the maximum allowed message size is 65535
@@ -89,6 +154,15 @@ var LocalFeatures = map[FeatureBit]string{
 	InitialRoutingSync:      "initial-routing-sync",
 	GossipQueriesRequired:   "gossip-queries",
 	GossipQueriesOptional:   "gossip-queries",
+
+	UpfrontShutdownScriptRequired: "upfront-shutdown-script",
+	UpfrontShutdownScriptOptional: "upfront-shutdown-script",
+
+	WumboChannelsRequired: "wumbo-channels",
+	WumboChannelsOptional: "wumbo-channels",
+
+	ZeroConfRequired: "zero-conf",
+	ZeroConfOptional: "zero-conf",
 }
 
 // GlobalFeatures is a mapping of known global feature bits to a descriptive
@@ -100,6 +174,10 @@ var GlobalFeatures = map[FeatureBit]string{
 	TLVOnionPayloadOptional: "tlv-onion",
 	StaticRemoteKeyOptional: "static-remote-key",
 	StaticRemoteKeyRequired: "static-remote-key",
+	PaymentAddrOptional:     "payment-addr",
+	PaymentAddrRequired:     "payment-addr",
+	AnchorsOptional:         "anchor-commitments",
+	AnchorsRequired:         "anchor-commitments",
 }
 
 // RawFeatureVector represents a set of feature bits as defined in BOLT-09.  A
@@ -136,6 +214,16 @@ func (fv *RawFeatureVector) Unset(feature FeatureBit) {
 	delete(fv.features, feature)
 }
 
+// Features returns the set of feature bits enabled in the vector. The returned
+// set is a copy and may be freely modified by the caller.
+func (fv *RawFeatureVector) Features() map[FeatureBit]struct{} {
+	features := make(map[FeatureBit]struct{}, len(fv.features))
+	for bit := range fv.features {
+		features[bit] = struct{}{}
+	}
+	return features
+}
+
 // SerializeSize returns the number of bytes needed to represent feature vector
 // in byte format.
 func (fv *RawFeatureVector) SerializeSize() int {
@@ -0,0 +1,125 @@
+package lnwire
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/decred/dcrlnd/tlv"
+)
+
+// InboundFeeRecordType is the type of the TLV record carrying the inbound fee
+// of a channel within the extra opaque data of its channel updates. The type
+// is odd so that nodes that don't understand inbound fees can ignore it.
+const InboundFeeRecordType tlv.Type = 55555
+
+// inboundFeeLen is the length of the encoded inbound fee: its base fee
+// followed by its fee rate, both encoded as 4-byte signed integers.
+const inboundFeeLen = 8
+
+// InboundFee is the fee charged by a node for the HTLCs it receives over a
+// channel, in addition to the fee of the channel they are forwarded over.
+// Negative values are discounts on the outgoing fee.
+type InboundFee struct {
+	// BaseFee is the base fee in milli-atoms charged for each HTLC.
+	BaseFee int32
+
+	// FeeRate is the fee rate in millionths charged for each HTLC, based
+	// on the amount arriving at the outgoing channel.
+	FeeRate int32
+}
+
+// IsZero returns whether the inbound fee neither charges nor discounts
+// anything.
+func (f InboundFee) IsZero() bool {
+	return f.BaseFee == 0 && f.FeeRate == 0
+}
+
+// CalcFee returns the inbound fee of an HTLC for which amt milli-atoms arrive
+// at the outgoing channel, including the outgoing fee. The fee is negative
+// for discounts.
+func (f InboundFee) CalcFee(amt MilliAtom) int64 {
+	return int64(f.BaseFee) + int64(f.FeeRate)*int64(amt)/1000000
+}
+
+// ParseInboundFee extracts the inbound fee from the extra opaque data of a
+// channel update. The zero inbound fee is returned if none was set.
+func ParseInboundFee(extraData []byte) (InboundFee, error) {
+	var fee InboundFee
+
+	records, err := decodeExtraRecords(extraData)
+	if err != nil {
+		return fee, err
+	}
+
+	value, ok := records[uint64(InboundFeeRecordType)]
+	if !ok {
+		return fee, nil
+	}
+	if len(value) != inboundFeeLen {
+		return fee, fmt.Errorf("invalid inbound fee length: %v",
+			len(value))
+	}
+
+	fee.BaseFee = int32(binary.BigEndian.Uint32(value[:4]))
+	fee.FeeRate = int32(binary.BigEndian.Uint32(value[4:]))
+
+	return fee, nil
+}
+
+// SetInboundFee returns the extra opaque data of a channel update with its
+// inbound fee record replaced by the given fee. The record is removed if the
+// fee is zero. All the other records are kept.
+func SetInboundFee(extraData []byte, fee InboundFee) ([]byte, error) {
+	records, err := decodeExtraRecords(extraData)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(records, uint64(InboundFeeRecordType))
+	if !fee.IsZero() {
+		var value [inboundFeeLen]byte
+		binary.BigEndian.PutUint32(value[:4], uint32(fee.BaseFee))
+		binary.BigEndian.PutUint32(value[4:], uint32(fee.FeeRate))
+		records[uint64(InboundFeeRecordType)] = value[:]
+	}
+
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	tlvRecords, err := tlv.MapToRecords(records)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := tlv.NewStream(tlvRecords...)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := stream.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// decodeExtraRecords decodes the extra opaque data of a message as a TLV
+// stream, returning the raw values of its records keyed by their type.
+func decodeExtraRecords(extraData []byte) (map[uint64][]byte, error) {
+	stream, err := tlv.NewStream()
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := stream.DecodeWithUnknownRecords(
+		bytes.NewReader(extraData),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode extra opaque "+
+			"data: %v", err)
+	}
+
+	return records, nil
+}
@@ -0,0 +1,67 @@
+package lnwire
+
+import (
+	"bytes"
+	"testing"
+)
+
+// TestInboundFee asserts that inbound fees are stored within the extra opaque
+// data of channel updates without altering the other records, and that they
+// can be removed again.
+func TestInboundFee(t *testing.T) {
+	t.Parallel()
+
+	// An unrelated odd record which must be kept as is.
+	otherRecord := []byte{0x03, 0x02, 0xaa, 0xbb}
+
+	fee := InboundFee{BaseFee: -1000, FeeRate: -200}
+	extraData, err := SetInboundFee(otherRecord, fee)
+	if err != nil {
+		t.Fatalf("unable to set inbound fee: %v", err)
+	}
+	if !bytes.HasPrefix(extraData, otherRecord) {
+		t.Fatalf("other record not kept: %x", extraData)
+	}
+
+	parsedFee, err := ParseInboundFee(extraData)
+	if err != nil {
+		t.Fatalf("unable to parse inbound fee: %v", err)
+	}
+	if parsedFee != fee {
+		t.Fatalf("expected inbound fee %v, got %v", fee, parsedFee)
+	}
+
+	// Removing the inbound fee should give back the original data.
+	extraData, err = SetInboundFee(extraData, InboundFee{})
+	if err != nil {
+		t.Fatalf("unable to remove inbound fee: %v", err)
+	}
+	if !bytes.Equal(extraData, otherRecord) {
+		t.Fatalf("expected extra data %x, got %x", otherRecord,
+			extraData)
+	}
+
+	parsedFee, err = ParseInboundFee(extraData)
+	if err != nil {
+		t.Fatalf("unable to parse inbound fee: %v", err)
+	}
+	if !parsedFee.IsZero() {
+		t.Fatalf("expected no inbound fee, got %v", parsedFee)
+	}
+
+	// Extra data that isn't a valid TLV stream can't carry inbound fees.
+	if _, err := SetInboundFee([]byte{0x01}, fee); err == nil {
+		t.Fatalf("expected failure for invalid extra data")
+	}
+}
+
+// TestInboundFeeCalcFee asserts that inbound fees are computed from both their
+// base fee and fee rate.
+func TestInboundFeeCalcFee(t *testing.T) {
+	t.Parallel()
+
+	fee := InboundFee{BaseFee: -1000, FeeRate: -200}
+	if calcFee := fee.CalcFee(10000000); calcFee != -3000 {
+		t.Fatalf("expected fee -3000, got %v", calcFee)
+	}
+}
@@ -551,6 +551,14 @@ func ReadElement(r io.Reader, element interface{}) error {
 		}
 		numSigs := binary.BigEndian.Uint16(l[:])
 
+		// A message can't hold more signatures than fit within its
+		// maximum payload, so we reject such a count before allocating
+		// the signatures.
+		if int(numSigs)*len(Sig{}) > MaxMessagePayload {
+			return fmt.Errorf("number of signatures %d exceeds "+
+				"max message payload", numSigs)
+		}
+
 		var sigs []Sig
 		if numSigs > 0 {
 			sigs = make([]Sig, numSigs)
@@ -78,6 +78,19 @@ func randRawFeatureVector(r *rand.Rand) *RawFeatureVector {
 	return featureVec
 }
 
+// randDeliveryAddress returns a random delivery address, which is empty half
+// of the time.
+func randDeliveryAddress(r *rand.Rand) DeliveryAddress {
+	if r.Intn(2) == 0 {
+		return DeliveryAddress{}
+	}
+
+	addr := make(DeliveryAddress, r.Intn(34)+1)
+	r.Read(addr)
+
+	return addr
+}
+
 func randTCP4Addr(r *rand.Rand) (*net.TCPAddr, error) {
 	var ip [4]byte
 	if _, err := r.Read(ip[:]); err != nil {
@@ -229,10 +242,45 @@ func TestMaxOutPointIndex(t *testing.T) {
 	}
 }
 
+// TestReadTooManySigs asserts that a number of signatures which can't fit
+// within a message is rejected.
+func TestReadTooManySigs(t *testing.T) {
+	t.Parallel()
+
+	var b bytes.Buffer
+	if err := WriteElement(&b, uint16(math.MaxUint16)); err != nil {
+		t.Fatalf("unable to write number of sigs: %v", err)
+	}
+
+	var sigs []Sig
+	if err := ReadElement(&b, &sigs); err == nil {
+		t.Fatalf("read of sigs should fail, number of sigs exceeds " +
+			"max message payload")
+	}
+}
+
+// TestEncodeTooManyShortChanIDs asserts that encoding more short channel IDs
+// than their length prefix can describe fails rather than overflowing it.
+func TestEncodeTooManyShortChanIDs(t *testing.T) {
+	t.Parallel()
+
+	shortChanIDs := make([]ShortChannelID, math.MaxUint16/8+1)
+	for i := range shortChanIDs {
+		shortChanIDs[i] = NewShortChanIDFromInt(uint64(i))
+	}
+
+	var b bytes.Buffer
+	err := encodeShortChanIDs(&b, EncodingSortedPlain, shortChanIDs)
+	if err == nil {
+		t.Fatalf("encoding of short chan IDs should fail, length " +
+			"exceeds 16-bits")
+	}
+}
+
 func TestEmptyMessageUnknownType(t *testing.T) {
 	t.Parallel()
 
-	fakeType := MessageType(math.MaxUint16)
+	fakeType := CustomTypeStart - 1
 	if _, err := makeEmptyMessage(fakeType); err == nil {
 		t.Fatalf("should not be able to make an empty message of an " +
 			"unknown type")
@@ -353,6 +401,7 @@ func TestLightningWireProtocol(t *testing.T) {
 				t.Fatalf("unable to generate key: %v", err)
 				return
 			}
+			req.UpfrontShutdownScript = randDeliveryAddress(r)
 
 			v[0] = reflect.ValueOf(req)
 		},
@@ -403,6 +452,7 @@ func TestLightningWireProtocol(t *testing.T) {
 				t.Fatalf("unable to generate key: %v", err)
 				return
 			}
+			req.UpfrontShutdownScript = randDeliveryAddress(r)
 
 			v[0] = reflect.ValueOf(req)
 		},
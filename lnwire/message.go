@@ -116,6 +116,9 @@ func (t MessageType) String() string {
 	case MsgGossipTimestampRange:
 		return "GossipTimestampRange"
 	default:
+		if t >= CustomTypeStart {
+			return "Custom"
+		}
 		return "<unknown>"
 	}
 }
@@ -217,7 +220,12 @@ func makeEmptyMessage(msgType MessageType) (Message, error) {
 	case MsgGossipTimestampRange:
 		msg = &GossipTimestampRange{}
 	default:
-		return nil, &UnknownMessage{msgType}
+		// Messages within the custom range are handed over to the
+		// applications exchanging them, rather than being unknown.
+		if msgType < CustomTypeStart {
+			return nil, &UnknownMessage{msgType}
+		}
+		msg = &Custom{Type: msgType}
 	}
 
 	return msg, nil
@@ -17,6 +17,12 @@ const (
 	// initiator of a funding flow wishes to announce the channel to the
 	// greater network.
 	FFAnnounceChannel FundingFlag = 1 << iota
+
+	// FFZeroConf is a FundingFlag that when set, indicates the initiator
+	// of a funding flow wishes to use the channel before the funding
+	// transaction confirms. Such a channel is identified by an alias short
+	// channel ID until it does.
+	FFZeroConf
 )
 
 // OpenChannel is the message Alice sends to Bob if we should like to create a
@@ -122,6 +128,11 @@ type OpenChannel struct {
 	// Currently, the least significant bit of this bit field indicates the
 	// initiator of the channel wishes to advertise this channel publicly.
 	ChannelFlags FundingFlag
+
+	// UpfrontShutdownScript is the script to which the channel funds should
+	// be paid when mutually closing the channel. This field is optional,
+	// and should be empty if the sender doesn't commit to a script.
+	UpfrontShutdownScript DeliveryAddress
 }
 
 // A compile time check to ensure OpenChannel implements the lnwire.Message
@@ -153,6 +164,7 @@ func (o *OpenChannel) Encode(w io.Writer, pver uint32) error {
 		o.HtlcPoint,
 		o.FirstCommitmentPoint,
 		o.ChannelFlags,
+		o.UpfrontShutdownScript,
 	)
 }
 
@@ -162,7 +174,7 @@ func (o *OpenChannel) Encode(w io.Writer, pver uint32) error {
 //
 // This is part of the lnwire.Message interface.
 func (o *OpenChannel) Decode(r io.Reader, pver uint32) error {
-	return ReadElements(r,
+	err := ReadElements(r,
 		o.ChainHash[:],
 		o.PendingChannelID[:],
 		&o.FundingAmount,
@@ -182,6 +194,13 @@ func (o *OpenChannel) Decode(r io.Reader, pver uint32) error {
 		&o.FirstCommitmentPoint,
 		&o.ChannelFlags,
 	)
+	if err != nil {
+		return err
+	}
+
+	// The upfront shutdown script is optional, so peers that don't know
+	// of it may leave it out.
+	return readUpfrontShutdownScript(r, &o.UpfrontShutdownScript)
 }
 
 // MsgType returns the MessageType code which uniquely identifies this message
@@ -197,6 +216,6 @@ func (o *OpenChannel) MsgType() MessageType {
 //
 // This is part of the lnwire.Message interface.
 func (o *OpenChannel) MaxPayloadLength(uint32) uint32 {
-	// (32 * 2) + (8 * 6) + (4 * 1) + (2 * 2) + (33 * 6) + 1
-	return 319
+	// (32 * 2) + (8 * 6) + (4 * 1) + (2 * 2) + (33 * 6) + 1 + 2 + 34
+	return 355
 }
@@ -5,6 +5,7 @@ import (
 	"compress/zlib"
 	"fmt"
 	"io"
+	"math"
 	"sort"
 	"sync"
 
@@ -178,15 +179,18 @@ func decodeShortChanIDs(r io.Reader) (ShortChanIDEncoding, []ShortChannelID, err
 		defer zlibDecodeMtx.Unlock()
 
 		// Before we start to decode, we'll create a limit reader over
-		// the current reader. This will ensure that we can control how
-		// much memory we're allocating during the decoding process.
-		limitedDecompressor, err := zlib.NewReader(&io.LimitedReader{
-			R: bytes.NewReader(queryBody),
-			N: maxZlibBufSize,
-		})
+		// the output of the decompressor. This will ensure that we can
+		// control how much memory we're allocating during the decoding
+		// process, as a small compressed payload can inflate to a much
+		// larger one.
+		decompressor, err := zlib.NewReader(bytes.NewReader(queryBody))
 		if err != nil {
 			return 0, nil, fmt.Errorf("unable to create zlib reader: %v", err)
 		}
+		limitedDecompressor := &io.LimitedReader{
+			R: decompressor,
+			N: maxZlibBufSize,
+		}
 
 		var (
 			shortChanIDs []ShortChannelID
@@ -276,8 +280,12 @@ func encodeShortChanIDs(w io.Writer, encodingType ShortChanIDEncoding,
 		// First, we'll write out the number of bytes of the query
 		// body. We add 1 as the response will have the encoding type
 		// prepended to it.
-		numBytesBody := uint16(len(shortChanIDs)*8) + 1
-		if err := WriteElements(w, numBytesBody); err != nil {
+		numBytesBody := len(shortChanIDs)*8 + 1
+		if numBytesBody > math.MaxUint16 {
+			return fmt.Errorf("too many short chan IDs to encode: %d",
+				len(shortChanIDs))
+		}
+		if err := WriteElements(w, uint16(numBytesBody)); err != nil {
 			return err
 		}
 
@@ -333,6 +341,10 @@ func encodeShortChanIDs(w io.Writer, encodingType ShortChanIDEncoding,
 		// for the byte to encode the type.
 		compressedPayload := buf.Bytes()
 		numBytesBody := len(compressedPayload) + 1
+		if numBytesBody > math.MaxUint16 {
+			return fmt.Errorf("too many short chan IDs to encode: %d",
+				len(shortChanIDs))
+		}
 
 		// Finally, we can write out the number of bytes, the
 		// compression type, and finally the buffer itself.
@@ -0,0 +1,106 @@
+package lnwire
+
+import (
+	"github.com/davecgh/go-spew/spew"
+	"github.com/decred/dcrd/dcrec/secp256k1/v2"
+)
+
+// RedactMessage returns a copy of the passed message that is safe to write to
+// the logs. The payment preimages, revocation secrets and onion blobs carried
+// by the message, which would allow anyone reading the logs to claim funds or
+// to learn about the route of a payment, are zeroed in the copy. All other
+// fields are left untouched. The passed message itself is never modified.
+func RedactMessage(msg Message) Message {
+	switch m := msg.(type) {
+	case *UpdateFulfillHTLC:
+		c := *m
+		c.PaymentPreimage = [32]byte{}
+		return &c
+
+	case *UpdateAddHTLC:
+		c := *m
+		c.OnionBlob = [OnionPacketSize]byte{}
+		return &c
+
+	case *RevokeAndAck:
+		c := *m
+		c.Revocation = [32]byte{}
+		return &c
+
+	case *ChannelReestablish:
+		c := *m
+		c.LastRemoteCommitSecret = [32]byte{}
+		return &c
+
+	default:
+		return msg
+	}
+}
+
+// SpewMessage returns a full dump of the passed message, suitable for trace
+// logging. Unless unsafe is set, the message is redacted through
+// RedactMessage first. The curve parameters of the public keys held by the
+// message are omitted from the dump, as they'd otherwise be printed along
+// with each key.
+func SpewMessage(msg Message, unsafe bool) string {
+	if !unsafe {
+		msg = RedactMessage(msg)
+	}
+
+	return spew.Sdump(stripCurves(msg))
+}
+
+// stripCurves returns a copy of the passed message whose public keys have no
+// curve set. The passed message itself is never modified.
+func stripCurves(msg Message) Message {
+	switch m := msg.(type) {
+	case *ChannelReestablish:
+		c := *m
+		c.LocalUnrevokedCommitPoint = stripCurve(m.LocalUnrevokedCommitPoint)
+		return &c
+
+	case *RevokeAndAck:
+		c := *m
+		c.NextRevocationKey = stripCurve(m.NextRevocationKey)
+		return &c
+
+	case *AcceptChannel:
+		c := *m
+		c.FundingKey = stripCurve(m.FundingKey)
+		c.RevocationPoint = stripCurve(m.RevocationPoint)
+		c.PaymentPoint = stripCurve(m.PaymentPoint)
+		c.DelayedPaymentPoint = stripCurve(m.DelayedPaymentPoint)
+		c.HtlcPoint = stripCurve(m.HtlcPoint)
+		c.FirstCommitmentPoint = stripCurve(m.FirstCommitmentPoint)
+		return &c
+
+	case *OpenChannel:
+		c := *m
+		c.FundingKey = stripCurve(m.FundingKey)
+		c.RevocationPoint = stripCurve(m.RevocationPoint)
+		c.PaymentPoint = stripCurve(m.PaymentPoint)
+		c.DelayedPaymentPoint = stripCurve(m.DelayedPaymentPoint)
+		c.HtlcPoint = stripCurve(m.HtlcPoint)
+		c.FirstCommitmentPoint = stripCurve(m.FirstCommitmentPoint)
+		return &c
+
+	case *FundingLocked:
+		c := *m
+		c.NextPerCommitmentPoint = stripCurve(m.NextPerCommitmentPoint)
+		return &c
+
+	default:
+		return msg
+	}
+}
+
+// stripCurve returns a copy of the passed public key without its curve.
+func stripCurve(key *secp256k1.PublicKey) *secp256k1.PublicKey {
+	if key == nil {
+		return nil
+	}
+
+	c := *key
+	c.Curve = nil
+	return &c
+}
@@ -0,0 +1,65 @@
+package lnwire
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// TestRedactMessage asserts that the secrets carried by messages are zeroed
+// in their redacted copy, while the original messages are left untouched.
+func TestRedactMessage(t *testing.T) {
+	t.Parallel()
+
+	preimage := [32]byte{0xaa, 0xbb, 0xcc}
+	fulfill := NewUpdateFulfillHTLC(ChannelID{1}, 2, preimage)
+
+	redacted := RedactMessage(fulfill).(*UpdateFulfillHTLC)
+	if redacted.PaymentPreimage != [32]byte{} {
+		t.Fatalf("expected preimage to be redacted")
+	}
+	if redacted.ChanID != fulfill.ChanID || redacted.ID != fulfill.ID {
+		t.Fatalf("expected non secret fields to be preserved")
+	}
+	if fulfill.PaymentPreimage != preimage {
+		t.Fatalf("original message was modified")
+	}
+
+	add := NewUpdateAddHTLC()
+	add.OnionBlob[0] = 0xff
+	if RedactMessage(add).(*UpdateAddHTLC).OnionBlob[0] != 0 {
+		t.Fatalf("expected onion blob to be redacted")
+	}
+	if add.OnionBlob[0] != 0xff {
+		t.Fatalf("original message was modified")
+	}
+
+	rev := NewRevokeAndAck()
+	rev.Revocation = preimage
+	if RedactMessage(rev).(*RevokeAndAck).Revocation != [32]byte{} {
+		t.Fatalf("expected revocation to be redacted")
+	}
+
+	// Messages without any secret are returned as is.
+	ping := NewPing(10)
+	if RedactMessage(ping) != Message(ping) {
+		t.Fatalf("expected message without secrets to be returned as is")
+	}
+}
+
+// TestSpewMessage asserts that the dump of a message only includes its
+// secrets when unsafe logging is requested.
+func TestSpewMessage(t *testing.T) {
+	t.Parallel()
+
+	preimage := bytes.Repeat([]byte{0xab}, 32)
+	fulfill := &UpdateFulfillHTLC{}
+	copy(fulfill.PaymentPreimage[:], preimage)
+
+	if strings.Contains(SpewMessage(fulfill, false), "ab ab ab ab") {
+		t.Fatalf("expected preimage to be omitted from safe dump")
+	}
+	if !strings.Contains(SpewMessage(fulfill, true), "ab ab ab ab") {
+		t.Fatalf("expected preimage to be included in unsafe dump")
+	}
+}
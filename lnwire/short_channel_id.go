@@ -4,6 +4,18 @@ import (
 	"fmt"
 )
 
+const (
+	// AliasStartBlockHeight is the first block height of the range
+	// reserved for alias short channel IDs. Aliases identify channels
+	// whose funding transaction hasn't confirmed yet, so they must never
+	// collide with the short channel ID of a confirmed channel.
+	AliasStartBlockHeight uint32 = 16000000
+
+	// AliasEndBlockHeight is the block height ending the range reserved
+	// for alias short channel IDs.
+	AliasEndBlockHeight uint32 = 16250000
+)
+
 // ShortChannelID represents the set of data which is needed to retrieve all
 // necessary data to validate the channel existence.
 type ShortChannelID struct {
@@ -42,6 +54,13 @@ func (c ShortChannelID) ToUint64() uint64 {
 		(uint64(c.TxPosition)))
 }
 
+// IsAlias returns true if the short channel ID falls within the range reserved
+// for alias short channel IDs.
+func (c ShortChannelID) IsAlias() bool {
+	return c.BlockHeight >= AliasStartBlockHeight &&
+		c.BlockHeight < AliasEndBlockHeight
+}
+
 // String generates a human-readable representation of the channel ID.
 func (c ShortChannelID) String() string {
 	return fmt.Sprintf("%d:%d:%d", c.BlockHeight, c.TxIndex, c.TxPosition)
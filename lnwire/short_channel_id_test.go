@@ -39,3 +39,46 @@ func TestShortChannelIDEncoding(t *testing.T) {
 		}
 	}
 }
+
+// TestShortChannelIDIsAlias asserts that only short channel IDs within the
+// reserved alias range are considered aliases.
+func TestShortChannelIDIsAlias(t *testing.T) {
+	t.Parallel()
+
+	var testCases = []struct {
+		scid    ShortChannelID
+		isAlias bool
+	}{
+		{
+			scid:    ShortChannelID{BlockHeight: 9304934},
+			isAlias: false,
+		},
+		{
+			scid: ShortChannelID{
+				BlockHeight: AliasStartBlockHeight,
+			},
+			isAlias: true,
+		},
+		{
+			scid: ShortChannelID{
+				BlockHeight: AliasEndBlockHeight - 1,
+				TxIndex:     (1 << 24) - 1,
+				TxPosition:  (1 << 16) - 1,
+			},
+			isAlias: true,
+		},
+		{
+			scid: ShortChannelID{
+				BlockHeight: AliasEndBlockHeight,
+			},
+			isAlias: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		if testCase.scid.IsAlias() != testCase.isAlias {
+			t.Fatalf("expected IsAlias of %v to be %v",
+				testCase.scid, testCase.isAlias)
+		}
+	}
+}
@@ -22,6 +22,19 @@ type Shutdown struct {
 // p2wpkh.
 type DeliveryAddress []byte
 
+// readUpfrontShutdownScript reads the optional upfront shutdown script that
+// ends the OpenChannel and AcceptChannel messages. The script is left empty
+// if the message ends before it.
+func readUpfrontShutdownScript(r io.Reader, script *DeliveryAddress) error {
+	err := ReadElement(r, script)
+	if err == io.EOF {
+		*script = DeliveryAddress{}
+		return nil
+	}
+
+	return err
+}
+
 // NewShutdown creates a new Shutdown message.
 func NewShutdown(cid ChannelID, addr DeliveryAddress) *Shutdown {
 	return &Shutdown{
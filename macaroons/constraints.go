@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc/peer"
@@ -12,6 +13,26 @@ import (
 	macaroon "gopkg.in/macaroon.v2"
 )
 
+const (
+	// CondIPRange is the name of the caveat locking a macaroon to a range
+	// of IP addresses.
+	CondIPRange = "iprange"
+
+	// CondCustom is the name of the caveats that are not enforced by the
+	// daemon itself, but by the external authorizer registered as an RPC
+	// middleware for the name of the caveat. Custom caveats are of the form
+	// "dcrlnd-custom <name> <condition>".
+	CondCustom = "dcrlnd-custom"
+)
+
+// CustomCaveatAcceptor is the interface of the registry of the external
+// authorizers enforcing custom caveats.
+type CustomCaveatAcceptor interface {
+	// CustomCaveatSupported returns an error if no external authorizer
+	// enforcing the custom caveat of the given name is registered.
+	CustomCaveatSupported(customCaveatName string) error
+}
+
 // Constraint type adds a layer of indirection over macaroon caveats.
 type Constraint func(*macaroon.Macaroon) error
 
@@ -65,25 +86,145 @@ func IPLockConstraint(ipAddr string) func(*macaroon.Macaroon) error {
 	}
 }
 
+// IPRangeLockConstraint locks a macaroon to a range of IP addresses, given in
+// CIDR notation. If the range is an empty string, this constraint does nothing
+// to accommodate default value's desired behavior.
+func IPRangeLockConstraint(ipRange string) func(*macaroon.Macaroon) error {
+	return func(mac *macaroon.Macaroon) error {
+		if ipRange == "" {
+			return nil
+		}
+
+		_, ipNet, err := net.ParseCIDR(ipRange)
+		if err != nil {
+			return fmt.Errorf("incorrect macaroon IP range: %v", err)
+		}
+		caveat := checkers.Condition(CondIPRange, ipNet.String())
+		return mac.AddFirstPartyCaveat([]byte(caveat))
+	}
+}
+
+// CustomConstraint adds a custom caveat of the given name and condition to a
+// macaroon. The caveat is enforced by the external authorizer registered for
+// its name, requests carrying it are rejected if none is.
+func CustomConstraint(name, condition string) func(*macaroon.Macaroon) error {
+	return func(mac *macaroon.Macaroon) error {
+		if name == "" || strings.Contains(name, " ") {
+			return fmt.Errorf("invalid custom caveat name %q", name)
+		}
+
+		caveat := checkers.Condition(
+			CondCustom, fmt.Sprintf("%s %s", name, condition),
+		)
+		return mac.AddFirstPartyCaveat([]byte(caveat))
+	}
+}
+
+// ParseCustomCaveat parses the name and the condition of a custom caveat. A
+// boolean is returned to indicate whether the caveat is a custom caveat.
+func ParseCustomCaveat(caveat string) (string, string, bool, error) {
+	cond, arg, err := checkers.ParseCaveat(caveat)
+	if err != nil || cond != CondCustom {
+		return "", "", false, err
+	}
+
+	name, condition, err := parseCustomCaveatArg(arg)
+	if err != nil {
+		return "", "", false, err
+	}
+
+	return name, condition, true, nil
+}
+
+// parseCustomCaveatArg splits the argument of a custom caveat into the name
+// and the condition of the caveat.
+func parseCustomCaveatArg(arg string) (string, string, error) {
+	parts := strings.SplitN(arg, " ", 2)
+	if parts[0] == "" {
+		return "", "", fmt.Errorf("custom caveat name missing")
+	}
+
+	var condition string
+	if len(parts) == 2 {
+		condition = parts[1]
+	}
+
+	return parts[0], condition, nil
+}
+
+// peerIPFromContext returns the IP address of the client of the request of the
+// passed context.
+func peerIPFromContext(ctx context.Context) (net.IP, error) {
+	pr, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("unable to get peer info from context")
+	}
+	peerAddr, _, err := net.SplitHostPort(pr.Addr.String())
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse peer address")
+	}
+
+	return net.ParseIP(peerAddr), nil
+}
+
 // IPLockChecker accepts client IP from the validation context and compares it
 // with IP locked in the macaroon. It is of the `Checker` type.
 func IPLockChecker() (string, checkers.Func) {
 	return "ipaddr", func(ctx context.Context, cond, arg string) error {
 		// Get peer info and extract IP address from it for macaroon
 		// check.
-		pr, ok := peer.FromContext(ctx)
-		if !ok {
-			return fmt.Errorf("unable to get peer info from context")
-		}
-		peerAddr, _, err := net.SplitHostPort(pr.Addr.String())
+		peerIP, err := peerIPFromContext(ctx)
 		if err != nil {
-			return fmt.Errorf("unable to parse peer address")
+			return err
 		}
 
-		if !net.ParseIP(arg).Equal(net.ParseIP(peerAddr)) {
+		if !net.ParseIP(arg).Equal(peerIP) {
 			msg := "macaroon locked to different IP address"
 			return fmt.Errorf(msg)
 		}
 		return nil
 	}
 }
+
+// IPRangeLockChecker accepts client IP from the validation context and checks
+// that it is part of the IP range locked in the macaroon. It is of the
+// `Checker` type.
+func IPRangeLockChecker() (string, checkers.Func) {
+	return CondIPRange, func(ctx context.Context, cond, arg string) error {
+		_, ipNet, err := net.ParseCIDR(arg)
+		if err != nil {
+			return fmt.Errorf("unable to parse macaroon IP "+
+				"range: %v", err)
+		}
+
+		peerIP, err := peerIPFromContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		if !ipNet.Contains(peerIP) {
+			return fmt.Errorf("macaroon locked to different IP " +
+				"range")
+		}
+		return nil
+	}
+}
+
+// CustomChecker returns a checker accepting the custom caveats for which an
+// external authorizer is registered with the passed acceptor. The caveats are
+// then enforced by the authorizers themselves, once the macaroon has been
+// validated. It is of the `Checker` type.
+func CustomChecker(acceptor CustomCaveatAcceptor) Checker {
+	return func() (string, checkers.Func) {
+		return CondCustom, func(ctx context.Context, cond,
+			arg string) error {
+
+			name, _, err := parseCustomCaveatArg(arg)
+			if err != nil {
+				return err
+			}
+
+			return acceptor.CustomCaveatSupported(name)
+		}
+	}
+}
@@ -107,3 +107,61 @@ func TestIPLockBadIP(t *testing.T) {
 		t.Fatalf("IPLockConstraint with bad IP should fail.")
 	}
 }
+
+// TestIPRangeLockConstraint tests that a caveat locking a macaroon to a range
+// of IP addresses is created, and that invalid ranges are rejected.
+func TestIPRangeLockConstraint(t *testing.T) {
+	testMacaroon := createDummyMacaroon(t)
+	err := macaroons.IPRangeLockConstraint("10.0.0.1/24")(testMacaroon)
+	if err != nil {
+		t.Fatalf("Error applying IP range constraint: %v", err)
+	}
+
+	// The range is normalized to the network address.
+	if string(testMacaroon.Caveats()[0].Id) != "iprange 10.0.0.0/24" {
+		t.Fatalf("Added caveat '%s' does not meet the expectations!",
+			testMacaroon.Caveats()[0].Id)
+	}
+
+	err = macaroons.IPRangeLockConstraint("10.0.0.1")(testMacaroon)
+	if err == nil {
+		t.Fatalf("IPRangeLockConstraint with bad range should fail.")
+	}
+}
+
+// TestCustomConstraint tests that custom caveats are created and parsed back
+// into their name and condition.
+func TestCustomConstraint(t *testing.T) {
+	testMacaroon := createDummyMacaroon(t)
+	err := macaroons.CustomConstraint("acct", "id 42")(testMacaroon)
+	if err != nil {
+		t.Fatalf("Error applying custom constraint: %v", err)
+	}
+
+	caveat := string(testMacaroon.Caveats()[0].Id)
+	if caveat != "dcrlnd-custom acct id 42" {
+		t.Fatalf("Added caveat '%s' does not meet the expectations!",
+			caveat)
+	}
+
+	name, condition, ok, err := macaroons.ParseCustomCaveat(caveat)
+	if err != nil {
+		t.Fatalf("Error parsing custom caveat: %v", err)
+	}
+	if !ok || name != "acct" || condition != "id 42" {
+		t.Fatalf("Unexpected custom caveat: ok=%v, name=%q, "+
+			"condition=%q", ok, name, condition)
+	}
+
+	// Other caveats are not custom caveats.
+	_, _, ok, err = macaroons.ParseCustomCaveat("ipaddr 127.0.0.1")
+	if err != nil || ok {
+		t.Fatalf("Unexpected custom caveat: ok=%v, err=%v", ok, err)
+	}
+
+	// Names can't contain spaces, as they delimit the condition.
+	err = macaroons.CustomConstraint("a b", "")(testMacaroon)
+	if err == nil {
+		t.Fatalf("CustomConstraint with bad name should fail.")
+	}
+}
@@ -0,0 +1,55 @@
+package macaroons
+
+import (
+	"context"
+	"fmt"
+)
+
+var (
+	// RootKeyIDContextKey is the key to get the root key ID from a
+	// context.
+	RootKeyIDContextKey = contextKey{"rootkeyid"}
+
+	// ErrContextRootKeyID is returned when the root key ID stored in a
+	// context is not of the expected type.
+	ErrContextRootKeyID = fmt.Errorf("failed to read root key ID " +
+		"from context")
+
+	// ErrMissingRootKeyID specifies that the root key ID of a context is
+	// empty.
+	ErrMissingRootKeyID = fmt.Errorf("missing root key ID")
+)
+
+// contextKey is the type of the keys of the values the macaroon service
+// stores in a context.
+type contextKey struct {
+	Name string
+}
+
+// ContextWithRootKeyID returns a copy of the passed context carrying the ID of
+// the root key new macaroons should be baked with.
+func ContextWithRootKeyID(ctx context.Context,
+	rootKeyID []byte) context.Context {
+
+	return context.WithValue(ctx, RootKeyIDContextKey, rootKeyID)
+}
+
+// RootKeyIDFromContext returns the root key ID carried by the passed context.
+// If the context doesn't carry one, the ID of the default root key is
+// returned.
+func RootKeyIDFromContext(ctx context.Context) ([]byte, error) {
+	value := ctx.Value(RootKeyIDContextKey)
+	if value == nil {
+		return DefaultRootKeyID, nil
+	}
+
+	id, ok := value.([]byte)
+	if !ok {
+		return nil, ErrContextRootKeyID
+	}
+	if len(id) == 0 {
+		return nil, ErrMissingRootKeyID
+	}
+
+	return id, nil
+}
@@ -0,0 +1,134 @@
+package macaroons
+
+import (
+	"fmt"
+	"sync"
+
+	"gopkg.in/macaroon-bakery.v2/bakery"
+)
+
+var (
+	// ValidActions is a list of all the actions that can be granted by the
+	// permissions of a macaroon.
+	ValidActions = []string{"read", "write", "generate"}
+
+	// ValidEntities is a list of all the entities access to can be granted
+	// by the permissions of a macaroon.
+	ValidEntities = []string{
+		"onchain", "offchain", "address", "message", "peers", "info",
+		"invoices", "signer", "macaroon",
+	}
+)
+
+// ValidateOp returns an error if the entity or the action of the passed
+// operation can't be granted by the permissions of a macaroon.
+func ValidateOp(op bakery.Op) error {
+	if !stringInSlice(op.Entity, ValidEntities) {
+		return fmt.Errorf("invalid permission entity %q; supported "+
+			"entities are %v", op.Entity, ValidEntities)
+	}
+	if !stringInSlice(op.Action, ValidActions) {
+		return fmt.Errorf("invalid permission action %q; supported "+
+			"actions are %v", op.Action, ValidActions)
+	}
+
+	return nil
+}
+
+// PermissionRegistry maps the full URI of the RPC methods to the macaroon
+// permissions required to invoke them. Methods missing from the registry are
+// rejected by the interceptors of the Service. Permissions can be added and
+// removed while the RPC server is running, allowing custom RPC extensions to
+// be protected by macaroons like the built-in methods.
+type PermissionRegistry struct {
+	permissions map[string][]bakery.Op
+	mtx         sync.RWMutex
+}
+
+// NewPermissionRegistry creates a new registry without any method.
+func NewPermissionRegistry() *PermissionRegistry {
+	return &PermissionRegistry{
+		permissions: make(map[string][]bakery.Op),
+	}
+}
+
+// AddPermissions registers the permissions required by each of the passed
+// methods. Each method must require at least one permission, made of a valid
+// entity and action, and can't already be registered. No method is added if
+// any of them is rejected.
+func (r *PermissionRegistry) AddPermissions(
+	permissions map[string][]bakery.Op) error {
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	for method, ops := range permissions {
+		if method == "" {
+			return fmt.Errorf("empty method URI")
+		}
+		if _, ok := r.permissions[method]; ok {
+			return fmt.Errorf("detected duplicate macaroon "+
+				"constraints for path: %v", method)
+		}
+		if len(ops) == 0 {
+			return fmt.Errorf("no macaroon permission required "+
+				"for path: %v", method)
+		}
+		for _, op := range ops {
+			if err := ValidateOp(op); err != nil {
+				return fmt.Errorf("path %v: %v", method, err)
+			}
+		}
+	}
+
+	for method, ops := range permissions {
+		r.permissions[method] = append([]bakery.Op(nil), ops...)
+	}
+
+	return nil
+}
+
+// RemovePermissions unregisters the passed methods, which will be rejected by
+// the interceptors from now on.
+func (r *PermissionRegistry) RemovePermissions(methods ...string) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	for _, method := range methods {
+		delete(r.permissions, method)
+	}
+}
+
+// Permissions returns the permissions required to invoke the passed method,
+// and whether the method is registered.
+func (r *PermissionRegistry) Permissions(method string) ([]bakery.Op, bool) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	ops, ok := r.permissions[method]
+	return ops, ok
+}
+
+// AllPermissions returns a copy of the permissions of all the registered
+// methods.
+func (r *PermissionRegistry) AllPermissions() map[string][]bakery.Op {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	permissions := make(map[string][]bakery.Op, len(r.permissions))
+	for method, ops := range r.permissions {
+		permissions[method] = ops
+	}
+
+	return permissions
+}
+
+// stringInSlice returns true if a string is contained in the given slice.
+func stringInSlice(a string, slice []string) bool {
+	for _, b := range slice {
+		if b == a {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+package macaroons_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/decred/dcrlnd/macaroons"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+)
+
+// TestPermissionRegistry asserts that the registry only accepts permissions
+// made of valid entities and actions for methods that aren't registered yet,
+// and that methods can be removed at runtime.
+func TestPermissionRegistry(t *testing.T) {
+	t.Parallel()
+
+	readInfo := bakery.Op{Entity: "info", Action: "read"}
+	writeOffchain := bakery.Op{Entity: "offchain", Action: "write"}
+
+	registry := macaroons.NewPermissionRegistry()
+	err := registry.AddPermissions(map[string][]bakery.Op{
+		"/lnrpc.Lightning/GetInfo": {readInfo},
+		"/ext.Ext/Pay":             {readInfo, writeOffchain},
+	})
+	if err != nil {
+		t.Fatalf("unable to add permissions: %v", err)
+	}
+
+	ops, ok := registry.Permissions("/ext.Ext/Pay")
+	if !ok {
+		t.Fatal("expected registered method")
+	}
+	if !reflect.DeepEqual(ops, []bakery.Op{readInfo, writeOffchain}) {
+		t.Fatalf("unexpected permissions: %v", ops)
+	}
+
+	// Invalid mappings are rejected as a whole, leaving the registry
+	// untouched.
+	invalid := []map[string][]bakery.Op{
+		{"/ext.Ext/Other": {{Entity: "testEntity", Action: "read"}}},
+		{"/ext.Ext/Other": {{Entity: "info", Action: "delete"}}},
+		{"/ext.Ext/Other": nil},
+		{"": {readInfo}},
+		{
+			"/ext.Ext/Other":           {readInfo},
+			"/lnrpc.Lightning/GetInfo": {writeOffchain},
+		},
+	}
+	for i, perms := range invalid {
+		if err := registry.AddPermissions(perms); err == nil {
+			t.Fatalf("expected invalid permissions %d to be "+
+				"rejected", i)
+		}
+	}
+	if _, ok := registry.Permissions("/ext.Ext/Other"); ok {
+		t.Fatal("expected rejected method to not be registered")
+	}
+	if len(registry.AllPermissions()) != 2 {
+		t.Fatalf("expected 2 registered methods, got %d",
+			len(registry.AllPermissions()))
+	}
+
+	// Removed methods are no longer known, and can be registered again.
+	registry.RemovePermissions("/ext.Ext/Pay")
+	if _, ok := registry.Permissions("/ext.Ext/Pay"); ok {
+		t.Fatal("expected removed method to not be registered")
+	}
+	err = registry.AddPermissions(map[string][]bakery.Op{
+		"/ext.Ext/Pay": {writeOffchain},
+	})
+	if err != nil {
+		t.Fatalf("unable to add permissions again: %v", err)
+	}
+}
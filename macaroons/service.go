@@ -103,20 +103,22 @@ func isRegistered(c *checkers.Checker, name string) bool {
 }
 
 // UnaryServerInterceptor is a GRPC interceptor that checks whether the
-// request is authorized by the included macaroons.
+// request is authorized by the included macaroons. Methods missing from the
+// registry are always rejected.
 func (svc *Service) UnaryServerInterceptor(
-	permissionMap map[string][]bakery.Op) grpc.UnaryServerInterceptor {
+	registry *PermissionRegistry) grpc.UnaryServerInterceptor {
 
 	return func(ctx context.Context, req interface{},
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler) (interface{}, error) {
 
-		if _, ok := permissionMap[info.FullMethod]; !ok {
+		ops, ok := registry.Permissions(info.FullMethod)
+		if !ok {
 			return nil, fmt.Errorf("%s: unknown permissions "+
 				"required for method", info.FullMethod)
 		}
 
-		err := svc.ValidateMacaroon(ctx, permissionMap[info.FullMethod])
+		err := svc.ValidateMacaroon(ctx, ops)
 		if err != nil {
 			return nil, err
 		}
@@ -126,21 +128,21 @@ func (svc *Service) UnaryServerInterceptor(
 }
 
 // StreamServerInterceptor is a GRPC interceptor that checks whether the
-// request is authorized by the included macaroons.
+// request is authorized by the included macaroons. Methods missing from the
+// registry are always rejected.
 func (svc *Service) StreamServerInterceptor(
-	permissionMap map[string][]bakery.Op) grpc.StreamServerInterceptor {
+	registry *PermissionRegistry) grpc.StreamServerInterceptor {
 
 	return func(srv interface{}, ss grpc.ServerStream,
 		info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
 
-		if _, ok := permissionMap[info.FullMethod]; !ok {
+		ops, ok := registry.Permissions(info.FullMethod)
+		if !ok {
 			return fmt.Errorf("%s: unknown permissions required "+
 				"for method", info.FullMethod)
 		}
 
-		err := svc.ValidateMacaroon(
-			ss.Context(), permissionMap[info.FullMethod],
-		)
+		err := svc.ValidateMacaroon(ss.Context(), ops)
 		if err != nil {
 			return err
 		}
@@ -156,13 +158,38 @@ func (svc *Service) StreamServerInterceptor(
 func (svc *Service) ValidateMacaroon(ctx context.Context,
 	requiredPermissions []bakery.Op) error {
 
+	mac, err := MacaroonFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return svc.CheckMacAuth(ctx, mac, requiredPermissions)
+}
+
+// CheckMacAuth checks that the passed macaroon grants the required permissions
+// and that its caveats are satisfied in the passed context. This allows
+// macaroons received out of band, rather than as request metadata, to be
+// validated.
+func (svc *Service) CheckMacAuth(ctx context.Context, mac *macaroon.Macaroon,
+	requiredPermissions []bakery.Op) error {
+
+	// Check the method being called against the permitted operation and
+	// the expiration time and IP address and return the result.
+	authChecker := svc.Checker.Auth(macaroon.Slice{mac})
+	_, err := authChecker.Allow(ctx, requiredPermissions...)
+	return err
+}
+
+// MacaroonFromContext returns the macaroon encoded as request metadata of the
+// passed context using the key "macaroon".
+func MacaroonFromContext(ctx context.Context) (*macaroon.Macaroon, error) {
 	// Get macaroon bytes from context and unmarshal into macaroon.
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return fmt.Errorf("unable to get metadata from context")
+		return nil, fmt.Errorf("unable to get metadata from context")
 	}
 	if len(md["macaroon"]) != 1 {
-		return fmt.Errorf("expected 1 macaroon, got %d",
+		return nil, fmt.Errorf("expected 1 macaroon, got %d",
 			len(md["macaroon"]))
 	}
 
@@ -171,19 +198,15 @@ func (svc *Service) ValidateMacaroon(ctx context.Context,
 	// representation.
 	macBytes, err := hex.DecodeString(md["macaroon"][0])
 	if err != nil {
-		return err
+		return nil, err
 	}
 	mac := &macaroon.Macaroon{}
 	err = mac.UnmarshalBinary(macBytes)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// Check the method being called against the permitted operation and
-	// the expiration time and IP address and return the result.
-	authChecker := svc.Checker.Auth(macaroon.Slice{mac})
-	_, err = authChecker.Allow(ctx, requiredPermissions...)
-	return err
+	return mac, nil
 }
 
 // Close closes the database that underlies the RootKeyStore and zeroes the
@@ -197,3 +220,40 @@ func (svc *Service) Close() error {
 func (svc *Service) CreateUnlock(password *[]byte) error {
 	return svc.rks.CreateUnlock(password)
 }
+
+// NewMacaroon bakes a new macaroon granting the passed operations, using the
+// root key of the passed ID. The root key is created if it doesn't exist yet.
+func (svc *Service) NewMacaroon(ctx context.Context, rootKeyID []byte,
+	ops ...bakery.Op) (*bakery.Macaroon, error) {
+
+	// The ID is passed through the context down to the RootKey method of
+	// the root key store, which is called by the oven.
+	ctx = ContextWithRootKeyID(ctx, rootKeyID)
+
+	return svc.Oven.NewMacaroon(ctx, bakery.LatestVersion, nil, ops...)
+}
+
+// ListMacaroonIDs returns the IDs of all the root keys of the service.
+func (svc *Service) ListMacaroonIDs(ctx context.Context) ([][]byte, error) {
+	return svc.rks.ListMacaroonIDs(ctx)
+}
+
+// DeleteMacaroonID removes the root key of the passed ID, which revokes every
+// macaroon baked with it.
+func (svc *Service) DeleteMacaroonID(ctx context.Context,
+	rootKeyID []byte) ([]byte, error) {
+
+	return svc.rks.DeleteMacaroonID(ctx, rootKeyID)
+}
+
+// ChangePassword calls the underlying root key store's ChangePassword and
+// returns the result.
+func (svc *Service) ChangePassword(oldPw, newPw []byte) error {
+	return svc.rks.ChangePassword(oldPw, newPw)
+}
+
+// GenerateNewRootKey calls the underlying root key store's GenerateNewRootKey
+// and returns the result.
+func (svc *Service) GenerateNewRootKey() error {
+	return svc.rks.GenerateNewRootKey()
+}
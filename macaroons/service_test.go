@@ -137,3 +137,137 @@ func TestValidateMacaroon(t *testing.T) {
 		t.Fatalf("Error validating the macaroon: %v", err)
 	}
 }
+
+// TestListDeleteMacaroonID tests that macaroons baked with a custom root key
+// ID are listed, and revoked once their root key is deleted.
+func TestListDeleteMacaroonID(t *testing.T) {
+	// First, initialize the service and unlock it.
+	tempDir := setupTestRootKeyStorage(t)
+	defer os.RemoveAll(tempDir)
+	service, err := macaroons.NewService(tempDir, macaroons.IPLockChecker)
+	if err != nil {
+		t.Fatalf("Error creating new service: %v", err)
+	}
+	defer service.Close()
+	err = service.CreateUnlock(&defaultPw)
+	if err != nil {
+		t.Fatalf("Error unlocking root key storage: %v", err)
+	}
+
+	// Bake a macaroon with the default root key, and one with a custom
+	// root key.
+	_, err = service.NewMacaroon(
+		context.TODO(), macaroons.DefaultRootKeyID, testOperation,
+	)
+	if err != nil {
+		t.Fatalf("Error creating macaroon from service: %v", err)
+	}
+	rootKeyID := []byte("1")
+	macaroon, err := service.NewMacaroon(
+		context.TODO(), rootKeyID, testOperation,
+	)
+	if err != nil {
+		t.Fatalf("Error creating macaroon from service: %v", err)
+	}
+	macaroonBinary, err := macaroon.M().MarshalBinary()
+	if err != nil {
+		t.Fatalf("Error serializing macaroon: %v", err)
+	}
+
+	// Root key IDs must be numeric.
+	_, err = service.NewMacaroon(context.TODO(), []byte("a"), testOperation)
+	if err != macaroons.ErrInvalidRootKeyID {
+		t.Fatalf("Received %v instead of ErrInvalidRootKeyID", err)
+	}
+
+	// Both root keys should be listed, but not the encryption key.
+	ids, err := service.ListMacaroonIDs(context.TODO())
+	if err != nil {
+		t.Fatalf("Error listing root key IDs: %v", err)
+	}
+	if len(ids) != 2 || string(ids[0]) != "0" || string(ids[1]) != "1" {
+		t.Fatalf("Unexpected root key IDs: %s", ids)
+	}
+
+	md := metadata.New(map[string]string{
+		"macaroon": hex.EncodeToString(macaroonBinary),
+	})
+	mockContext := metadata.NewIncomingContext(context.Background(), md)
+	err = service.ValidateMacaroon(mockContext, []bakery.Op{testOperation})
+	if err != nil {
+		t.Fatalf("Error validating the macaroon: %v", err)
+	}
+
+	// The default root key can't be deleted.
+	_, err = service.DeleteMacaroonID(
+		context.TODO(), macaroons.DefaultRootKeyID,
+	)
+	if err != macaroons.ErrDeletionForbidden {
+		t.Fatalf("Received %v instead of ErrDeletionForbidden", err)
+	}
+
+	// Deleting the custom root key should revoke the macaroon baked with
+	// it.
+	deleted, err := service.DeleteMacaroonID(context.TODO(), rootKeyID)
+	if err != nil {
+		t.Fatalf("Error deleting root key: %v", err)
+	}
+	if string(deleted) != string(rootKeyID) {
+		t.Fatalf("Expected deleted ID %s, got %s", rootKeyID, deleted)
+	}
+	err = service.ValidateMacaroon(mockContext, []bakery.Op{testOperation})
+	if err == nil {
+		t.Fatalf("Expected validation of revoked macaroon to fail")
+	}
+
+	// Deleting an unknown root key is a no-op.
+	deleted, err = service.DeleteMacaroonID(context.TODO(), rootKeyID)
+	if err != nil {
+		t.Fatalf("Error deleting root key: %v", err)
+	}
+	if deleted != nil {
+		t.Fatalf("Expected no root key to be deleted, got %s", deleted)
+	}
+}
+
+// TestCheckMacAuth tests that a macaroon passed out of band is only allowed
+// the permissions it grants.
+func TestCheckMacAuth(t *testing.T) {
+	// First, initialize the service and unlock it.
+	tempDir := setupTestRootKeyStorage(t)
+	defer os.RemoveAll(tempDir)
+	service, err := macaroons.NewService(tempDir, macaroons.IPLockChecker)
+	if err != nil {
+		t.Fatalf("Error creating new service: %v", err)
+	}
+	defer service.Close()
+	err = service.CreateUnlock(&defaultPw)
+	if err != nil {
+		t.Fatalf("Error unlocking root key storage: %v", err)
+	}
+
+	macaroon, err := service.Oven.NewMacaroon(context.TODO(),
+		bakery.LatestVersion, nil, testOperation)
+	if err != nil {
+		t.Fatalf("Error creating macaroon from service: %v", err)
+	}
+
+	err = service.CheckMacAuth(
+		context.Background(), macaroon.M(), []bakery.Op{testOperation},
+	)
+	if err != nil {
+		t.Fatalf("Error checking the macaroon: %v", err)
+	}
+
+	// A permission the macaroon doesn't grant is denied.
+	writeOperation := bakery.Op{
+		Entity: testOperation.Entity,
+		Action: "write",
+	}
+	err = service.CheckMacAuth(
+		context.Background(), macaroon.M(), []bakery.Op{writeOperation},
+	)
+	if err == nil {
+		t.Fatal("Expected permission not granted to be denied")
+	}
+}
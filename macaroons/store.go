@@ -1,10 +1,12 @@
 package macaroons
 
 import (
+	"bytes"
 	"context"
 	"crypto/rand"
 	"fmt"
 	"io"
+	"strconv"
 
 	bolt "go.etcd.io/bbolt"
 
@@ -20,11 +22,9 @@ var (
 	// rootKeyBucketName is the name of the root key store bucket.
 	rootKeyBucketName = []byte("macrootkeys")
 
-	// defaultRootKeyID is the ID of the default root key. The first is
+	// DefaultRootKeyID is the ID of the default root key. The first is
 	// just 0, to emulate the memory storage that comes with bakery.
-	//
-	// TODO(aakselrod): Add support for key rotation.
-	defaultRootKeyID = []byte("0")
+	DefaultRootKeyID = []byte("0")
 
 	// encryptedKeyID is the name of the database key that stores the
 	// encryption key, encrypted with a salted + hashed password. The
@@ -41,6 +41,19 @@ var (
 
 	// ErrPasswordRequired specifies that a nil password has been passed.
 	ErrPasswordRequired = fmt.Errorf("a non-nil password is required")
+
+	// ErrDeletionForbidden is returned when attempting to delete the
+	// default root key or the encryption key of the store.
+	ErrDeletionForbidden = fmt.Errorf("the specified ID cannot be deleted")
+
+	// ErrInvalidRootKeyID is returned when a root key ID is not the
+	// decimal representation of an unsigned integer.
+	ErrInvalidRootKeyID = fmt.Errorf("root key ID must be a non-negative " +
+		"integer")
+
+	// ErrEncKeyNotFound specifies that there was no encryption key found
+	// even if one was expected to be generated.
+	ErrEncKeyNotFound = fmt.Errorf("macaroon encryption key not found")
 )
 
 // RootKeyStorage implements the bakery.RootKeyStorage interface.
@@ -147,15 +160,23 @@ func (r *RootKeyStorage) Get(_ context.Context, id []byte) ([]byte, error) {
 }
 
 // RootKey implements the RootKey method for the bakery.RootKeyStorage
-// interface.
-// TODO(aakselrod): Add support for key rotation.
-func (r *RootKeyStorage) RootKey(_ context.Context) ([]byte, []byte, error) {
+// interface. The ID of the root key is read from the passed context, see
+// ContextWithRootKeyID, and the key is created if it doesn't exist yet.
+func (r *RootKeyStorage) RootKey(ctx context.Context) ([]byte, []byte, error) {
 	if r.encKey == nil {
 		return nil, nil, ErrStoreLocked
 	}
+
+	id, err := RootKeyIDFromContext(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err := strconv.ParseUint(string(id), 10, 64); err != nil {
+		return nil, nil, ErrInvalidRootKeyID
+	}
+
 	var rootKey []byte
-	id := defaultRootKeyID
-	err := r.Update(func(tx *bolt.Tx) error {
+	err = r.Update(func(tx *bolt.Tx) error {
 		ns := tx.Bucket(rootKeyBucketName)
 		dbKey := ns.Get(id)
 
@@ -192,6 +213,204 @@ func (r *RootKeyStorage) RootKey(_ context.Context) ([]byte, []byte, error) {
 	return rootKey, id, nil
 }
 
+// ListMacaroonIDs returns the IDs of all the root keys stored in the store.
+func (r *RootKeyStorage) ListMacaroonIDs(_ context.Context) ([][]byte, error) {
+	if r.encKey == nil {
+		return nil, ErrStoreLocked
+	}
+
+	var rootKeyIDs [][]byte
+	err := r.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(rootKeyBucketName)
+		return bucket.ForEach(func(k, _ []byte) error {
+			// The encryption key is stored in the same bucket, but
+			// it isn't a root key.
+			if bytes.Equal(k, encryptedKeyID) {
+				return nil
+			}
+
+			id := make([]byte, len(k))
+			copy(id, k)
+			rootKeyIDs = append(rootKeyIDs, id)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rootKeyIDs, nil
+}
+
+// DeleteMacaroonID removes the root key of the passed ID from the store, which
+// invalidates every macaroon baked with it. The ID is returned if the key was
+// found and deleted, nil otherwise. The default root key can't be deleted.
+func (r *RootKeyStorage) DeleteMacaroonID(_ context.Context,
+	rootKeyID []byte) ([]byte, error) {
+
+	if r.encKey == nil {
+		return nil, ErrStoreLocked
+	}
+
+	if len(rootKeyID) == 0 {
+		return nil, ErrMissingRootKeyID
+	}
+	if bytes.Equal(rootKeyID, DefaultRootKeyID) ||
+		bytes.Equal(rootKeyID, encryptedKeyID) {
+
+		return nil, ErrDeletionForbidden
+	}
+
+	var deleted []byte
+	err := r.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(rootKeyBucketName)
+		if bucket.Get(rootKeyID) == nil {
+			return nil
+		}
+
+		if err := bucket.Delete(rootKeyID); err != nil {
+			return err
+		}
+
+		deleted = rootKeyID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deleted, nil
+}
+
+// ChangePassword decrypts all the root keys of the store with the old password
+// and encrypts them again with the new one. The store must have been unlocked
+// with the old password beforehand.
+func (r *RootKeyStorage) ChangePassword(oldPw, newPw []byte) error {
+	// We need the store to already be unlocked. With this we can make sure
+	// that there already is a key in the DB.
+	if r.encKey == nil {
+		return ErrStoreLocked
+	}
+
+	// Check if a nil password has been passed; return an error if so.
+	if oldPw == nil || newPw == nil {
+		return ErrPasswordRequired
+	}
+
+	return r.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(rootKeyBucketName)
+		dbKey := bucket.Get(encryptedKeyID)
+		if len(dbKey) == 0 {
+			return ErrEncKeyNotFound
+		}
+
+		// Unmarshal the parameters of the old encryption key and
+		// derive the old key with them, to make sure the old password
+		// is correct.
+		encKeyOld := &snacl.SecretKey{}
+		if err := encKeyOld.Unmarshal(dbKey); err != nil {
+			return err
+		}
+		if err := encKeyOld.DeriveKey(&oldPw); err != nil {
+			return err
+		}
+
+		encKeyNew, err := snacl.NewSecretKey(
+			&newPw, snacl.DefaultN, snacl.DefaultR, snacl.DefaultP,
+		)
+		if err != nil {
+			return err
+		}
+
+		// The bucket can't be modified while iterating over it, so
+		// the root keys are re-encrypted first and stored afterwards.
+		rootKeys := make(map[string][]byte)
+		err = bucket.ForEach(func(k, v []byte) error {
+			if bytes.Equal(k, encryptedKeyID) {
+				return nil
+			}
+
+			rootKey, err := encKeyOld.Decrypt(v)
+			if err != nil {
+				return err
+			}
+
+			encRootKey, err := encKeyNew.Encrypt(rootKey)
+			if err != nil {
+				return err
+			}
+
+			rootKeys[string(k)] = encRootKey
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for id, encRootKey := range rootKeys {
+			err := bucket.Put([]byte(id), encRootKey)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Finally, store the parameters of the new encryption key so
+		// that the store is unlocked with the new password from now
+		// on.
+		err = bucket.Put(encryptedKeyID, encKeyNew.Marshal())
+		if err != nil {
+			return err
+		}
+
+		encKeyOld.Zero()
+		r.encKey.Zero()
+		r.encKey = encKeyNew
+		return nil
+	})
+}
+
+// GenerateNewRootKey removes all the root keys of the store and generates a
+// new default root key, which invalidates every macaroon baked so far.
+func (r *RootKeyStorage) GenerateNewRootKey() error {
+	if r.encKey == nil {
+		return ErrStoreLocked
+	}
+
+	return r.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(rootKeyBucketName)
+
+		var rootKeyIDs [][]byte
+		err := bucket.ForEach(func(k, _ []byte) error {
+			if !bytes.Equal(k, encryptedKeyID) {
+				id := make([]byte, len(k))
+				copy(id, k)
+				rootKeyIDs = append(rootKeyIDs, id)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, id := range rootKeyIDs {
+			if err := bucket.Delete(id); err != nil {
+				return err
+			}
+		}
+
+		rootKey := make([]byte, RootKeyLen)
+		if _, err := io.ReadFull(rand.Reader, rootKey); err != nil {
+			return err
+		}
+
+		encKey, err := r.encKey.Encrypt(rootKey)
+		if err != nil {
+			return err
+		}
+		return bucket.Put(DefaultRootKeyID, encKey)
+	})
+}
+
 // Close closes the underlying database and zeroes the encryption key stored
 // in memory.
 func (r *RootKeyStorage) Close() error {
@@ -136,3 +136,132 @@ func TestStore(t *testing.T) {
 			rootID, id)
 	}
 }
+
+// TestStoreChangePassword tests that the root keys of the store are
+// re-encrypted when its password is changed, and replaced when a new root key
+// is generated.
+func TestStoreChangePassword(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "macaroonstore-")
+	if err != nil {
+		t.Fatalf("Error creating temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	db, err := bolt.Open(path.Join(tempDir, "weks.db"), 0600,
+		bolt.DefaultOptions)
+	if err != nil {
+		t.Fatalf("Error opening store DB: %v", err)
+	}
+
+	store, err := macaroons.NewRootKeyStorage(db)
+	if err != nil {
+		db.Close()
+		t.Fatalf("Error creating root key store: %v", err)
+	}
+	defer store.Close()
+
+	pw := []byte("weks")
+	newPw := []byte("newweks")
+	badPw := []byte("badweks")
+
+	err = store.ChangePassword(pw, newPw)
+	if err != macaroons.ErrStoreLocked {
+		t.Fatalf("Received %v instead of ErrStoreLocked", err)
+	}
+
+	err = store.CreateUnlock(&pw)
+	if err != nil {
+		t.Fatalf("Error creating store encryption key: %v", err)
+	}
+
+	key, id, err := store.RootKey(context.TODO())
+	if err != nil {
+		t.Fatalf("Error getting root key from store: %v", err)
+	}
+	otherCtx := macaroons.ContextWithRootKeyID(
+		context.TODO(), []byte("1"),
+	)
+	otherKey, otherID, err := store.RootKey(otherCtx)
+	if err != nil {
+		t.Fatalf("Error getting root key from store: %v", err)
+	}
+
+	err = store.ChangePassword(badPw, newPw)
+	if err != snacl.ErrInvalidPassword {
+		t.Fatalf("Received %v instead of ErrInvalidPassword", err)
+	}
+
+	err = store.ChangePassword(pw, nil)
+	if err != macaroons.ErrPasswordRequired {
+		t.Fatalf("Received %v instead of ErrPasswordRequired", err)
+	}
+
+	err = store.ChangePassword(pw, newPw)
+	if err != nil {
+		t.Fatalf("Error changing store password: %v", err)
+	}
+
+	// After re-opening the store, only the new password unlocks it, and
+	// both root keys are left intact.
+	store.Close()
+	db, err = bolt.Open(path.Join(tempDir, "weks.db"), 0600,
+		bolt.DefaultOptions)
+	if err != nil {
+		t.Fatalf("Error opening store DB: %v", err)
+	}
+
+	store, err = macaroons.NewRootKeyStorage(db)
+	if err != nil {
+		db.Close()
+		t.Fatalf("Error creating root key store: %v", err)
+	}
+
+	err = store.CreateUnlock(&pw)
+	if err != snacl.ErrInvalidPassword {
+		t.Fatalf("Received %v instead of ErrInvalidPassword", err)
+	}
+
+	err = store.CreateUnlock(&newPw)
+	if err != nil {
+		t.Fatalf("Error unlocking root key store: %v", err)
+	}
+
+	key2, err := store.Get(context.TODO(), id)
+	if err != nil {
+		t.Fatalf("Error getting key with ID %s: %v", string(id), err)
+	}
+	if !bytes.Equal(key, key2) {
+		t.Fatalf("Root key doesn't match: expected %v, got %v",
+			key, key2)
+	}
+
+	otherKey2, err := store.Get(context.TODO(), otherID)
+	if err != nil {
+		t.Fatalf("Error getting key with ID %s: %v", string(otherID),
+			err)
+	}
+	if !bytes.Equal(otherKey, otherKey2) {
+		t.Fatalf("Root key doesn't match: expected %v, got %v",
+			otherKey, otherKey2)
+	}
+
+	// Generating a new root key replaces the default one and removes the
+	// others.
+	err = store.GenerateNewRootKey()
+	if err != nil {
+		t.Fatalf("Error generating new root key: %v", err)
+	}
+
+	key3, err := store.Get(context.TODO(), id)
+	if err != nil {
+		t.Fatalf("Error getting key with ID %s: %v", string(id), err)
+	}
+	if bytes.Equal(key, key3) {
+		t.Fatalf("Root key wasn't replaced")
+	}
+
+	if _, err := store.Get(context.TODO(), otherID); err == nil {
+		t.Fatalf("Root key with ID %s wasn't removed",
+			string(otherID))
+	}
+}
@@ -120,9 +120,10 @@ func (m *mockNotfier) RegisterSpendNtfn(outpoint *wire.OutPoint, _ []byte,
 // triggered and delivered to subscribers.
 type mockSpendNotifier struct {
 	*mockNotfier
-	spendMap map[wire.OutPoint][]chan *chainntnfs.SpendDetail
-	spends   map[wire.OutPoint]*chainntnfs.SpendDetail
-	mtx      sync.Mutex
+	spendMap  map[wire.OutPoint][]chan *chainntnfs.SpendDetail
+	spends    map[wire.OutPoint]*chainntnfs.SpendDetail
+	epochChan chan *chainntnfs.BlockEpoch
+	mtx       sync.Mutex
 }
 
 func makeMockSpendNotifier() *mockSpendNotifier {
@@ -130,11 +131,23 @@ func makeMockSpendNotifier() *mockSpendNotifier {
 		mockNotfier: &mockNotfier{
 			confChannel: make(chan *chainntnfs.TxConfirmation),
 		},
-		spendMap: make(map[wire.OutPoint][]chan *chainntnfs.SpendDetail),
-		spends:   make(map[wire.OutPoint]*chainntnfs.SpendDetail),
+		spendMap:  make(map[wire.OutPoint][]chan *chainntnfs.SpendDetail),
+		spends:    make(map[wire.OutPoint]*chainntnfs.SpendDetail),
+		epochChan: make(chan *chainntnfs.BlockEpoch),
 	}
 }
 
+// RegisterBlockEpochNtfn returns a BlockEpochEvent delivering the blocks sent
+// on the epochChan of the notifier.
+func (m *mockSpendNotifier) RegisterBlockEpochNtfn(
+	bestBlock *chainntnfs.BlockEpoch) (*chainntnfs.BlockEpochEvent, error) {
+
+	return &chainntnfs.BlockEpochEvent{
+		Epochs: m.epochChan,
+		Cancel: func() {},
+	}, nil
+}
+
 func (m *mockSpendNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint,
 	_ []byte, heightHint uint32) (*chainntnfs.SpendEvent, error) {
 	m.mtx.Lock()
@@ -321,6 +334,9 @@ func (*mockWalletController) ListTransactionDetails() ([]*lnwallet.TransactionDe
 }
 func (*mockWalletController) LockOutpoint(o wire.OutPoint)   {}
 func (*mockWalletController) UnlockOutpoint(o wire.OutPoint) {}
+func (*mockWalletController) AbandonTransaction(txid *chainhash.Hash) error {
+	return nil
+}
 func (m *mockWalletController) PublishTransaction(tx *wire.MsgTx) error {
 	m.publishedTransactions <- tx
 	return nil
@@ -8,6 +8,7 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/decred/dcrlnd/lncfg"
+	"github.com/decred/dcrlnd/routing"
 )
 
 // GetPromInterceptors returns the set of interceptors for Prometheus
@@ -23,3 +24,14 @@ func ExportPrometheusMetrics(_ *grpc.Server, _ lncfg.Prometheus) error {
 	return fmt.Errorf("lnd must be built with the monitoring tag to " +
 		"enable exporting Prometheus metrics")
 }
+
+// ObservePathFinding is required for lnd to compile so that Prometheus metric
+// exporting can be hidden behind a build tag.
+func ObservePathFinding(_ routing.PathFindingMetrics) {}
+
+// ExportNodeMetrics is required for lnd to compile so that Prometheus metric
+// exporting can be hidden behind a build tag.
+func ExportNodeMetrics(_ *NodeMetricSources, _ <-chan struct{}) error {
+	return fmt.Errorf("lnd must be built with the monitoring tag to " +
+		"enable exporting Prometheus metrics")
+}
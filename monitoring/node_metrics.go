@@ -0,0 +1,316 @@
+// +build monitoring
+
+package monitoring
+
+import (
+	"sync"
+	"time"
+
+	"github.com/decred/dcrlnd/htlcswitch"
+	"github.com/decred/dcrlnd/routing"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// namespace is the namespace of the exported node metrics.
+	namespace = "dcrlnd"
+
+	// dbStatsInterval is the minimum interval between two traversals of
+	// the channel database to gather the size of its buckets, as it's
+	// too expensive to be done on every scrape.
+	dbStatsInterval = 5 * time.Minute
+)
+
+var (
+	exportNodeMetrics sync.Once
+
+	pathFindingDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "routing",
+		Name:      "pathfinding_duration_seconds",
+		Help:      "Duration of the path finding queries.",
+		Buckets:   prometheus.ExponentialBuckets(0.001, 2, 14),
+	})
+
+	pathFindingNodes = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "routing",
+		Name:      "pathfinding_nodes_visited",
+		Help:      "Number of nodes visited by path finding queries.",
+		Buckets:   prometheus.ExponentialBuckets(1, 4, 10),
+	})
+
+	htlcEvents = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "htlcswitch",
+		Name:      "htlc_events_total",
+		Help: "Number of HTLC events by type of HTLC (send, " +
+			"receive, forward) and event (forward, settle, " +
+			"forward_fail, link_fail).",
+	}, []string{"type", "event"})
+
+	htlcForwardedAmt = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "htlcswitch",
+		Name:      "htlc_offered_matoms_total",
+		Help: "Amount of the HTLCs offered to the outgoing channels, " +
+			"by type of HTLC.",
+	}, []string{"type"})
+
+	htlcLinkFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "htlcswitch",
+		Name:      "link_failures_total",
+		Help: "Number of HTLCs failed by the links, by type of HTLC " +
+			"and failure.",
+	}, []string{"type", "failure"})
+)
+
+// ObservePathFinding records the performance metrics of a path finding query.
+// It's meant to be set as the metrics observer of the path finding config.
+func ObservePathFinding(m routing.PathFindingMetrics) {
+	pathFindingDuration.Observe(m.Duration.Seconds())
+	pathFindingNodes.Observe(float64(m.NodesVisited))
+}
+
+// ExportNodeMetrics registers the metrics gathered from the subsystems of the
+// node, exported along with the gRPC metrics, and counts the HTLC events until
+// quit is closed.
+func ExportNodeMetrics(sources *NodeMetricSources,
+	quit <-chan struct{}) error {
+
+	exportNodeMetrics.Do(func() {
+		prometheus.MustRegister(
+			pathFindingDuration, pathFindingNodes, htlcEvents,
+			htlcForwardedAmt, htlcLinkFailures,
+			newNodeCollector(sources),
+		)
+
+		go countHtlcEvents(sources.HtlcNotifier, quit)
+	})
+
+	return nil
+}
+
+// countHtlcEvents counts the events notified by the HTLC notifier until quit
+// is closed.
+//
+// NOTE: This MUST be run as a goroutine.
+func countHtlcEvents(notifier *htlcswitch.HtlcNotifier,
+	quit <-chan struct{}) {
+
+	// Subscribing blocks until the notifier is started, which happens
+	// once the switch is started.
+	client, err := notifier.SubscribeHtlcEvents()
+	if err != nil {
+		log.Errorf("Unable to subscribe to htlc events: %v", err)
+		return
+	}
+	defer client.Cancel()
+
+	for {
+		select {
+		case update := <-client.Updates():
+			countHtlcEvent(update)
+
+		case <-client.Quit():
+			return
+
+		case <-quit:
+			return
+		}
+	}
+}
+
+// countHtlcEvent counts an event notified by the HTLC notifier.
+func countHtlcEvent(update interface{}) {
+	switch event := update.(type) {
+	case *htlcswitch.ForwardingEvent:
+		eventType := event.HtlcEventType.String()
+		htlcEvents.WithLabelValues(eventType, "forward").Inc()
+		htlcForwardedAmt.WithLabelValues(eventType).Add(
+			float64(event.OutgoingAmt),
+		)
+
+	case *htlcswitch.SettleEvent:
+		eventType := event.HtlcEventType.String()
+		htlcEvents.WithLabelValues(eventType, "settle").Inc()
+
+	case *htlcswitch.ForwardingFailEvent:
+		eventType := event.HtlcEventType.String()
+		htlcEvents.WithLabelValues(eventType, "forward_fail").Inc()
+
+	case *htlcswitch.LinkFailEvent:
+		eventType := event.HtlcEventType.String()
+		htlcEvents.WithLabelValues(eventType, "link_fail").Inc()
+
+		failure := "unknown"
+		if event.Failure != nil {
+			failure = event.Failure.Code().String()
+		}
+		htlcLinkFailures.WithLabelValues(eventType, failure).Inc()
+	}
+}
+
+// nodeCollector is a Prometheus collector gathering the metrics of the node
+// that are read from its subsystems on every scrape: the number of peers, the
+// health of the chain backend and the size of the channel database.
+type nodeCollector struct {
+	sources *NodeMetricSources
+
+	peers       *prometheus.Desc
+	chainUp     *prometheus.Desc
+	bestHeight  *prometheus.Desc
+	chainSynced *prometheus.Desc
+	bucketKeys  *prometheus.Desc
+	bucketBytes *prometheus.Desc
+
+	// dbStats holds the last gathered channel database stats, refreshed
+	// at most every dbStatsInterval.
+	dbStats     []dbBucketStat
+	dbStatsTime time.Time
+	dbStatsMtx  sync.Mutex
+}
+
+// dbBucketStat is the size of a top-level bucket of the channel database.
+type dbBucketStat struct {
+	name  string
+	keys  float64
+	bytes float64
+}
+
+// A compile time check to ensure nodeCollector implements the
+// prometheus.Collector interface.
+var _ prometheus.Collector = (*nodeCollector)(nil)
+
+// newNodeCollector creates a collector gathering its metrics from the given
+// sources.
+func newNodeCollector(sources *NodeMetricSources) *nodeCollector {
+	return &nodeCollector{
+		sources: sources,
+		peers: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "peers"),
+			"Number of connected peers.", nil, nil,
+		),
+		chainUp: prometheus.NewDesc(
+			prometheus.BuildFQName(
+				namespace, "chain", "backend_up",
+			),
+			"Whether the chain backend can be reached.", nil, nil,
+		),
+		bestHeight: prometheus.NewDesc(
+			prometheus.BuildFQName(
+				namespace, "chain", "best_height",
+			),
+			"Height of the best block known to the chain backend.",
+			nil, nil,
+		),
+		chainSynced: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "chain", "synced"),
+			"Whether the wallet is synced to the chain.", nil, nil,
+		),
+		bucketKeys: prometheus.NewDesc(
+			prometheus.BuildFQName(
+				namespace, "channeldb", "bucket_keys",
+			),
+			"Number of keys within the top-level buckets of the "+
+				"channel database.", []string{"bucket"}, nil,
+		),
+		bucketBytes: prometheus.NewDesc(
+			prometheus.BuildFQName(
+				namespace, "channeldb", "bucket_bytes",
+			),
+			"Bytes in use by the top-level buckets of the channel "+
+				"database.", []string{"bucket"}, nil,
+		),
+	}
+}
+
+// Describe sends the descriptions of the metrics of the collector.
+//
+// NOTE: Part of the prometheus.Collector interface.
+func (c *nodeCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.peers
+	ch <- c.chainUp
+	ch <- c.bestHeight
+	ch <- c.chainSynced
+	ch <- c.bucketKeys
+	ch <- c.bucketBytes
+}
+
+// Collect reads the metrics of the collector from the subsystems of the node.
+//
+// NOTE: Part of the prometheus.Collector interface.
+func (c *nodeCollector) Collect(ch chan<- prometheus.Metric) {
+	ch <- prometheus.MustNewConstMetric(
+		c.peers, prometheus.GaugeValue, float64(c.sources.NumPeers()),
+	)
+
+	chainUp := 1.0
+	bestHeight, err := c.sources.BestHeight()
+	if err != nil {
+		log.Debugf("Unable to query best height: %v", err)
+		chainUp = 0
+	} else {
+		ch <- prometheus.MustNewConstMetric(
+			c.bestHeight, prometheus.GaugeValue,
+			float64(bestHeight),
+		)
+	}
+	ch <- prometheus.MustNewConstMetric(
+		c.chainUp, prometheus.GaugeValue, chainUp,
+	)
+
+	synced, err := c.sources.IsSynced()
+	if err != nil {
+		log.Debugf("Unable to query sync state: %v", err)
+	} else {
+		var syncedValue float64
+		if synced {
+			syncedValue = 1
+		}
+		ch <- prometheus.MustNewConstMetric(
+			c.chainSynced, prometheus.GaugeValue, syncedValue,
+		)
+	}
+
+	for _, stat := range c.currentDBStats() {
+		ch <- prometheus.MustNewConstMetric(
+			c.bucketKeys, prometheus.GaugeValue, stat.keys,
+			stat.name,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			c.bucketBytes, prometheus.GaugeValue, stat.bytes,
+			stat.name,
+		)
+	}
+}
+
+// currentDBStats returns the size of the buckets of the channel database,
+// gathering it again if the last stats are older than dbStatsInterval.
+func (c *nodeCollector) currentDBStats() []dbBucketStat {
+	c.dbStatsMtx.Lock()
+	defer c.dbStatsMtx.Unlock()
+
+	if time.Since(c.dbStatsTime) < dbStatsInterval {
+		return c.dbStats
+	}
+
+	bucketStats, err := c.sources.DBBucketStats()
+	if err != nil {
+		log.Errorf("Unable to gather channel database stats: %v", err)
+		return c.dbStats
+	}
+
+	c.dbStats = make([]dbBucketStat, 0, len(bucketStats))
+	for _, s := range bucketStats {
+		c.dbStats = append(c.dbStats, dbBucketStat{
+			name:  s.Name,
+			keys:  float64(s.Keys),
+			bytes: float64(s.Bytes),
+		})
+	}
+	c.dbStatsTime = time.Now()
+
+	return c.dbStats
+}
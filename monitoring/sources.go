@@ -0,0 +1,28 @@
+package monitoring
+
+import (
+	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/htlcswitch"
+)
+
+// NodeMetricSources holds the subsystems of the node the exported metrics are
+// gathered from, besides the gRPC server.
+type NodeMetricSources struct {
+	// HtlcNotifier notifies the events happening to the HTLCs handled by
+	// the switch, counted to export its throughput and failures.
+	HtlcNotifier *htlcswitch.HtlcNotifier
+
+	// NumPeers returns the number of peers the node is connected to.
+	NumPeers func() int
+
+	// DBBucketStats returns the size of the top-level buckets of the
+	// channel database.
+	DBBucketStats func() ([]channeldb.BucketStats, error)
+
+	// BestHeight returns the height of the best block known to the chain
+	// backend, failing if the backend can't be reached.
+	BestHeight func() (int32, error)
+
+	// IsSynced returns whether the wallet is synced to the chain.
+	IsSynced func() (bool, error)
+}
@@ -105,6 +105,18 @@ type ChanStatusManager struct {
 	// primary event loop.
 	disableRequests chan statusRequest
 
+	// manualEnableRequests pipes user requests to keep a channel enabled
+	// into the primary event loop.
+	manualEnableRequests chan statusRequest
+
+	// manualDisableRequests pipes user requests to keep a channel disabled
+	// into the primary event loop.
+	manualDisableRequests chan statusRequest
+
+	// autoRequests pipes user requests to give the status of a channel back
+	// to the automatic handling into the primary event loop.
+	autoRequests chan statusRequest
+
 	// statusSampleTicker fires at the interval prescribed by
 	// ChanStatusSampleInterval to check if channels in chanStates have
 	// become inactive.
@@ -149,6 +161,10 @@ func NewChanStatusManager(cfg *ChanStatusConfig) (*ChanStatusManager, error) {
 		enableRequests:     make(chan statusRequest),
 		disableRequests:    make(chan statusRequest),
 		quit:               make(chan struct{}),
+
+		manualEnableRequests:  make(chan statusRequest),
+		manualDisableRequests: make(chan statusRequest),
+		autoRequests:          make(chan statusRequest),
 	}, nil
 }
 
@@ -225,9 +241,33 @@ func (m *ChanStatusManager) RequestDisable(outpoint wire.OutPoint) error {
 	return m.submitRequest(m.disableRequests, outpoint)
 }
 
+// RequestManualEnable submits a user request to enable a channel identified by
+// the provided outpoint, and to keep it enabled even if it becomes inactive,
+// until RequestAuto is called for the channel. The channel must be active at
+// the time of the request.
+func (m *ChanStatusManager) RequestManualEnable(outpoint wire.OutPoint) error {
+	return m.submitRequest(m.manualEnableRequests, outpoint)
+}
+
+// RequestManualDisable submits a user request to disable a channel identified
+// by the provided outpoint, and to keep it disabled even if its peer
+// reconnects, until RequestAuto is called for the channel.
+func (m *ChanStatusManager) RequestManualDisable(outpoint wire.OutPoint) error {
+	return m.submitRequest(m.manualDisableRequests, outpoint)
+}
+
+// RequestAuto submits a user request to give the status of a channel
+// identified by the provided outpoint back to the automatic handling, undoing
+// a previous call to RequestManualEnable or RequestManualDisable. A manually
+// disabled channel is enabled right away if it's active.
+func (m *ChanStatusManager) RequestAuto(outpoint wire.OutPoint) error {
+	return m.submitRequest(m.autoRequests, outpoint)
+}
+
 // statusRequest is passed to the statusManager to request a change in status
 // for a particular channel point.  The exact action is governed by passing the
-// request through one of the enableRequests or disableRequests channels.
+// request through one of the enableRequests, disableRequests,
+// manualEnableRequests, manualDisableRequests or autoRequests channels.
 type statusRequest struct {
 	outpoint wire.OutPoint
 	errChan  chan error
@@ -281,6 +321,21 @@ func (m *ChanStatusManager) statusManager() {
 		case req := <-m.disableRequests:
 			req.errChan <- m.processDisableRequest(req.outpoint)
 
+		// Process any user requests to manually set the status of a
+		// channel, or to give it back to the automatic handling.
+		case req := <-m.manualEnableRequests:
+			req.errChan <- m.processManualEnableRequest(
+				req.outpoint,
+			)
+
+		case req := <-m.manualDisableRequests:
+			req.errChan <- m.processManualDisableRequest(
+				req.outpoint,
+			)
+
+		case req := <-m.autoRequests:
+			req.errChan <- m.processAutoRequest(req.outpoint)
+
 		// Use long-polling to detect when channels become inactive.
 		case <-m.statusSampleTicker.C:
 			// First, do a sweep and mark any ChanStatusEnabled
@@ -323,7 +378,14 @@ func (m *ChanStatusManager) processEnableRequest(outpoint wire.OutPoint) error {
 	switch curState.Status {
 
 	// Channel is already enabled, nothing to do.
-	case ChanStatusEnabled:
+	case ChanStatusEnabled, ChanStatusManuallyEnabled:
+		return nil
+
+	// The user asked for the channel to stay disabled, so it won't be
+	// enabled until its status is given back to the automatic handling.
+	case ChanStatusManuallyDisabled:
+		log.Debugf("Channel(%v) manually disabled, not enabling it",
+			outpoint)
 		return nil
 
 	// The channel is enabled, though we are now canceling the scheduled
@@ -361,12 +423,14 @@ func (m *ChanStatusManager) processDisableRequest(outpoint wire.OutPoint) error
 	switch curState.Status {
 
 	// Channel is already disabled, nothing to do.
-	case ChanStatusDisabled:
+	case ChanStatusDisabled, ChanStatusManuallyDisabled:
 		return nil
 
 	// We'll sign a new update disabling the channel if the current status
 	// is enabled or pending-inactive.
-	case ChanStatusEnabled, ChanStatusPendingDisabled:
+	case ChanStatusEnabled, ChanStatusPendingDisabled,
+		ChanStatusManuallyEnabled:
+
 		log.Infof("Announcing channel(%v) disabled [requested]",
 			outpoint)
 
@@ -387,6 +451,108 @@ func (m *ChanStatusManager) processDisableRequest(outpoint wire.OutPoint) error
 	return nil
 }
 
+// processManualEnableRequest attempts to enable the given outpoint on behalf
+// of the user. If the method returns nil, the status of the channel in
+// chanStates will be ChanStatusManuallyEnabled, which is left alone by the
+// detection of inactive channels. If the channel is not active at the time of
+// the request, ErrEnableInactiveChan will be returned.
+func (m *ChanStatusManager) processManualEnableRequest(
+	outpoint wire.OutPoint) error {
+
+	curState, err := m.getOrInitChanStatus(outpoint)
+	if err != nil {
+		return err
+	}
+
+	chanID := lnwire.NewChanIDFromOutPoint(&outpoint)
+	if !m.cfg.IsChannelActive(chanID) {
+		return ErrEnableInactiveChan
+	}
+
+	switch curState.Status {
+	case ChanStatusDisabled, ChanStatusManuallyDisabled:
+		log.Infof("Announcing channel(%v) enabled [manual]", outpoint)
+
+		err := m.signAndSendNextUpdate(outpoint, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	m.chanStates.markManuallyEnabled(outpoint)
+
+	return nil
+}
+
+// processManualDisableRequest attempts to disable the given outpoint on behalf
+// of the user. If the method returns nil, the status of the channel in
+// chanStates will be ChanStatusManuallyDisabled, so that it isn't enabled
+// again when its peer reconnects.
+func (m *ChanStatusManager) processManualDisableRequest(
+	outpoint wire.OutPoint) error {
+
+	curState, err := m.getOrInitChanStatus(outpoint)
+	if err != nil {
+		return err
+	}
+
+	switch curState.Status {
+	case ChanStatusEnabled, ChanStatusPendingDisabled,
+		ChanStatusManuallyEnabled:
+
+		log.Infof("Announcing channel(%v) disabled [manual]", outpoint)
+
+		err := m.signAndSendNextUpdate(outpoint, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	m.chanStates.markManuallyDisabled(outpoint)
+
+	return nil
+}
+
+// processAutoRequest gives the status of the given outpoint back to the
+// automatic handling. A manually enabled channel is left enabled until it's
+// detected as inactive, while a manually disabled channel is enabled right
+// away if it's active, as no request to enable it would follow otherwise.
+func (m *ChanStatusManager) processAutoRequest(outpoint wire.OutPoint) error {
+	curState, err := m.getOrInitChanStatus(outpoint)
+	if err != nil {
+		return err
+	}
+
+	switch curState.Status {
+	case ChanStatusManuallyEnabled:
+		log.Debugf("Channel(%v) enabled, resuming automatic handling",
+			outpoint)
+
+		m.chanStates.markEnabled(outpoint)
+
+	case ChanStatusManuallyDisabled:
+		chanID := lnwire.NewChanIDFromOutPoint(&outpoint)
+		if !m.cfg.IsChannelActive(chanID) {
+			log.Debugf("Channel(%v) disabled, resuming automatic "+
+				"handling", outpoint)
+
+			m.chanStates.markDisabled(outpoint)
+			return nil
+		}
+
+		log.Infof("Announcing channel(%v) enabled [auto]", outpoint)
+
+		err := m.signAndSendNextUpdate(outpoint, false)
+		if err != nil {
+			return err
+		}
+
+		m.chanStates.markEnabled(outpoint)
+	}
+
+	return nil
+}
+
 // markPendingInactiveChannels performs a sweep of the database's active
 // channels and determines which, if any, should have a disable announcement
 // scheduled. Once an active channel is determined to be pending-inactive, one
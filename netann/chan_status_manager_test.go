@@ -443,6 +443,22 @@ func (h *testHarness) assertDisable(outpoint wire.OutPoint, expErr error) {
 	}
 }
 
+// assertManualStatuses requests the passed manual status change for all of the
+// passed channels, and asserts that the returned errors match expErr.
+func (h *testHarness) assertManualStatuses(channels []*channeldb.OpenChannel,
+	request func(wire.OutPoint) error, expErr error) {
+
+	h.t.Helper()
+
+	for _, channel := range channels {
+		err := request(channel.FundingOutpoint)
+		if err != expErr {
+			h.t.Fatalf("expected manual status error: %v, got %v",
+				expErr, err)
+		}
+	}
+}
+
 // assertNoUpdates waits for the specified duration, and asserts that no updates
 // are announced on the network.
 func (h *testHarness) assertNoUpdates(duration time.Duration) {
@@ -789,6 +805,71 @@ var stateMachineTests = []stateMachineTest{
 			h.assertNoUpdates(h.safeDisableTimeout)
 		},
 	},
+	{
+		name:         "manually disabled channels stay disabled",
+		startActive:  true,
+		startEnabled: true,
+		fn: func(h testHarness) {
+			// Manually disable all channels, and expect to see
+			// them disabled on the network.
+			h.assertManualStatuses(
+				h.graph.chans(), h.mgr.RequestManualDisable, nil,
+			)
+			h.assertUpdates(
+				h.graph.chans(), false, h.safeDisableTimeout,
+			)
+
+			// Requests to enable the channels, as sent once their
+			// peer reconnects, are ignored.
+			h.assertEnables(h.graph.chans(), nil)
+			h.assertNoUpdates(h.safeDisableTimeout)
+
+			// Once given back to the automatic handling, the active
+			// channels are enabled right away.
+			h.assertManualStatuses(
+				h.graph.chans(), h.mgr.RequestAuto, nil,
+			)
+			h.assertUpdates(
+				h.graph.chans(), true, h.safeDisableTimeout,
+			)
+		},
+	},
+	{
+		name:         "manually enabled channels stay enabled",
+		startActive:  true,
+		startEnabled: true,
+		fn: func(h testHarness) {
+			h.assertManualStatuses(
+				h.graph.chans(), h.mgr.RequestManualEnable, nil,
+			)
+
+			// The channels becoming inactive aren't disabled.
+			h.markInactive(h.graph.chans())
+			h.assertNoUpdates(h.safeDisableTimeout)
+
+			// Once given back to the automatic handling, the
+			// inactive channels are disabled.
+			h.assertManualStatuses(
+				h.graph.chans(), h.mgr.RequestAuto, nil,
+			)
+			h.assertUpdates(
+				h.graph.chans(), false, h.safeDisableTimeout,
+			)
+		},
+	},
+	{
+		name:         "manually enable inactive channels",
+		startActive:  false,
+		startEnabled: false,
+		fn: func(h testHarness) {
+			// Inactive channels can't be manually enabled.
+			h.assertManualStatuses(
+				h.graph.chans(), h.mgr.RequestManualEnable,
+				netann.ErrEnableInactiveChan,
+			)
+			h.assertNoUpdates(h.safeDisableTimeout)
+		},
+	},
 }
 
 // TestChanStatusManagerStateMachine tests the possible state transitions that
@@ -26,6 +26,16 @@ const (
 	// ChanStatusDisabled indicates that the channel's last announcement has
 	// the disabled bit set.
 	ChanStatusDisabled
+
+	// ChanStatusManuallyEnabled indicates that the channel's last
+	// announcement has the disabled bit cleared, and that the user asked
+	// for the channel to stay enabled even if it becomes inactive.
+	ChanStatusManuallyEnabled
+
+	// ChanStatusManuallyDisabled indicates that the channel's last
+	// announcement has the disabled bit set, and that the user asked for
+	// the channel to stay disabled even if it becomes active.
+	ChanStatusManuallyDisabled
 )
 
 // ChannelState describes the ChanStatusManager's view of a channel, and
@@ -63,6 +73,21 @@ func (s *channelStates) markDisabled(outpoint wire.OutPoint) {
 	}
 }
 
+// markManuallyEnabled creates a channelState using ChanStatusManuallyEnabled.
+func (s *channelStates) markManuallyEnabled(outpoint wire.OutPoint) {
+	(*s)[outpoint] = ChannelState{
+		Status: ChanStatusManuallyEnabled,
+	}
+}
+
+// markManuallyDisabled creates a channelState using
+// ChanStatusManuallyDisabled.
+func (s *channelStates) markManuallyDisabled(outpoint wire.OutPoint) {
+	(*s)[outpoint] = ChannelState{
+		Status: ChanStatusManuallyDisabled,
+	}
+}
+
 // markPendingDisabled creates a channelState using ChanStatusPendingDisabled
 // and sets the ChannelState's SendDisableTime to sendDisableTime.
 func (s *channelStates) markPendingDisabled(outpoint wire.OutPoint,
@@ -0,0 +1,63 @@
+package netann
+
+import (
+	"net"
+	"sort"
+
+	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/tor"
+)
+
+// NodeAnnModifier is a closure that makes in-place modifications to an
+// lnwire.NodeAnnouncement.
+type NodeAnnModifier func(*lnwire.NodeAnnouncement)
+
+// NodeAnnAddAddrs appends the passed addresses to those advertised by the node
+// announcement, skipping the ones already advertised. This allows announcing
+// the same address, such as the one of an onion service restored on startup,
+// more than once without duplicating it.
+func NodeAnnAddAddrs(addrs ...net.Addr) NodeAnnModifier {
+	return func(nodeAnn *lnwire.NodeAnnouncement) {
+		known := make(map[string]struct{}, len(nodeAnn.Addresses))
+		for _, addr := range nodeAnn.Addresses {
+			known[addr.String()] = struct{}{}
+		}
+
+		for _, addr := range addrs {
+			if _, ok := known[addr.String()]; ok {
+				continue
+			}
+
+			known[addr.String()] = struct{}{}
+			nodeAnn.Addresses = append(nodeAnn.Addresses, addr)
+		}
+	}
+}
+
+// NodeAnnSortAddrs orders the addresses advertised by the node announcement by
+// preference, as done by SortAddrs.
+func NodeAnnSortAddrs(preferOnion bool) NodeAnnModifier {
+	return func(nodeAnn *lnwire.NodeAnnouncement) {
+		nodeAnn.Addresses = SortAddrs(nodeAnn.Addresses, preferOnion)
+	}
+}
+
+// SortAddrs returns a copy of the passed addresses ordered by preference:
+// clearnet addresses come first, unless preferOnion is set in which case the
+// onion addresses do. The relative order of the addresses of each kind is
+// preserved.
+func SortAddrs(addrs []net.Addr, preferOnion bool) []net.Addr {
+	sorted := make([]net.Addr, len(addrs))
+	copy(sorted, addrs)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		_, iOnion := sorted[i].(*tor.OnionAddr)
+		_, jOnion := sorted[j].(*tor.OnionAddr)
+		if preferOnion {
+			return iOnion && !jOnion
+		}
+		return !iOnion && jOnion
+	})
+
+	return sorted
+}
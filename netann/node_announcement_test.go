@@ -0,0 +1,88 @@
+package netann_test
+
+import (
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/netann"
+	"github.com/decred/dcrlnd/tor"
+)
+
+// TestNodeAnnAddAddrs asserts that NodeAnnAddAddrs appends the addresses not
+// yet advertised by a node announcement, and only those.
+func TestNodeAnnAddAddrs(t *testing.T) {
+	t.Parallel()
+
+	ipAddr := &net.TCPAddr{IP: net.ParseIP("1.2.3.4"), Port: 9735}
+	onionAddr := &tor.OnionAddr{
+		OnionService: "3g2upl4pq6kufc4m.onion",
+		Port:         9735,
+	}
+	otherOnionAddr := &tor.OnionAddr{
+		OnionService: "vww6ybal4bd7szmgncyruucpgfkqahzddi37ktceo3ah7ngmcopnpyyd.onion",
+		Port:         9735,
+	}
+
+	nodeAnn := &lnwire.NodeAnnouncement{
+		Addresses: []net.Addr{ipAddr},
+	}
+
+	netann.NodeAnnAddAddrs(onionAddr)(nodeAnn)
+	expAddrs := []net.Addr{ipAddr, onionAddr}
+	if !reflect.DeepEqual(nodeAnn.Addresses, expAddrs) {
+		t.Fatalf("expected addresses %v, got %v", expAddrs,
+			nodeAnn.Addresses)
+	}
+
+	// Adding the same addresses again, as is the case when the onion
+	// service is restored on startup, must not duplicate them.
+	netann.NodeAnnAddAddrs(onionAddr, ipAddr, otherOnionAddr,
+		otherOnionAddr)(nodeAnn)
+	expAddrs = append(expAddrs, otherOnionAddr)
+	if !reflect.DeepEqual(nodeAnn.Addresses, expAddrs) {
+		t.Fatalf("expected addresses %v, got %v", expAddrs,
+			nodeAnn.Addresses)
+	}
+}
+
+// TestSortAddrs asserts that SortAddrs orders clearnet and onion addresses by
+// preference while preserving the relative order of the addresses of each
+// kind.
+func TestSortAddrs(t *testing.T) {
+	t.Parallel()
+
+	ipAddr1 := &net.TCPAddr{IP: net.ParseIP("1.2.3.4"), Port: 9735}
+	ipAddr2 := &net.TCPAddr{IP: net.ParseIP("5.6.7.8"), Port: 9735}
+	onionAddr1 := &tor.OnionAddr{
+		OnionService: "3g2upl4pq6kufc4m.onion",
+		Port:         9735,
+	}
+	onionAddr2 := &tor.OnionAddr{
+		OnionService: "vww6ybal4bd7szmgncyruucpgfkqahzddi37ktceo3ah7ng" +
+			"mcopnpyyd.onion",
+		Port: 9735,
+	}
+
+	addrs := []net.Addr{onionAddr1, ipAddr1, onionAddr2, ipAddr2}
+
+	sorted := netann.SortAddrs(addrs, false)
+	expAddrs := []net.Addr{ipAddr1, ipAddr2, onionAddr1, onionAddr2}
+	if !reflect.DeepEqual(sorted, expAddrs) {
+		t.Fatalf("expected addresses %v, got %v", expAddrs, sorted)
+	}
+
+	sorted = netann.SortAddrs(addrs, true)
+	expAddrs = []net.Addr{onionAddr1, onionAddr2, ipAddr1, ipAddr2}
+	if !reflect.DeepEqual(sorted, expAddrs) {
+		t.Fatalf("expected addresses %v, got %v", expAddrs, sorted)
+	}
+
+	// The passed addresses must be left untouched.
+	expAddrs = []net.Addr{onionAddr1, ipAddr1, onionAddr2, ipAddr2}
+	if !reflect.DeepEqual(addrs, expAddrs) {
+		t.Fatalf("expected addresses %v to be left untouched, got %v",
+			expAddrs, addrs)
+	}
+}
@@ -421,10 +421,20 @@ func (ns *nurseryStore) PreschoolToKinder(kid *kidOutput,
 		}
 
 		// If this output has an absolute time lock, then we'll set the
-		// maturity height directly.
+		// maturity height directly. HTLC outputs on the remote
+		// commitment of channels with anchors additionally need to be
+		// confirmed for a block, which only matters if the absolute
+		// time lock already expired by then.
 		var maturityHeight uint32
-		if kid.BlocksToMaturity() == 0 {
+		if kid.absoluteMaturity != 0 {
 			maturityHeight = kid.absoluteMaturity
+
+			csvMaturity := kid.ConfHeight() + kid.BlocksToMaturity()
+			if kid.BlocksToMaturity() != 0 &&
+				csvMaturity > maturityHeight {
+
+				maturityHeight = csvMaturity
+			}
 		} else {
 			// Otherwise, since the CSV delay on the kid output has
 			// now begun ticking, we must insert a record of in the
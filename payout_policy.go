@@ -0,0 +1,161 @@
+package dcrlnd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/decred/dcrd/chaincfg/v2"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrlnd/lncfg"
+)
+
+const (
+	// maxPayoutRejectionLen is the maximum number of bytes of the body of
+	// a rejection by the external payout validator that is reported back
+	// to the caller.
+	maxPayoutRejectionLen = 512
+)
+
+// payoutOutput is a single destination of an on-chain payment, as sent to the
+// external payout validator.
+type payoutOutput struct {
+	Address string `json:"address"`
+	Amount  int64  `json:"amount"`
+}
+
+// payoutValidationRequest is the JSON body POSTed to the external payout
+// validator for each on-chain payment.
+type payoutValidationRequest struct {
+	Outputs []payoutOutput `json:"outputs"`
+}
+
+// payoutPolicy checks the destinations of the on-chain payments sent through
+// the SendCoins and SendMany RPCs against the configured allowlist and
+// denylist, and against the external payout validator if any, before they are
+// broadcast.
+type payoutPolicy struct {
+	allowlist map[string]struct{}
+	denylist  map[string]struct{}
+
+	validatorURL string
+	client       *http.Client
+
+	params *chaincfg.Params
+}
+
+// newPayoutPolicy creates a payout policy from its config. All the configured
+// addresses must be valid for the given network.
+func newPayoutPolicy(cfg *lncfg.PayoutPolicy,
+	params *chaincfg.Params) (*payoutPolicy, error) {
+
+	allowlist, err := parsePayoutAddrs(cfg.Allowlist, params)
+	if err != nil {
+		return nil, fmt.Errorf("invalid allowed payout address: %v",
+			err)
+	}
+	denylist, err := parsePayoutAddrs(cfg.Denylist, params)
+	if err != nil {
+		return nil, fmt.Errorf("invalid denied payout address: %v", err)
+	}
+
+	return &payoutPolicy{
+		allowlist:    allowlist,
+		denylist:     denylist,
+		validatorURL: cfg.ValidatorURL,
+		client: &http.Client{
+			Timeout: cfg.ValidatorTimeout,
+		},
+		params: params,
+	}, nil
+}
+
+// parsePayoutAddrs decodes the given addresses into a set of their canonical
+// encodings.
+func parsePayoutAddrs(addrs []string,
+	params *chaincfg.Params) (map[string]struct{}, error) {
+
+	set := make(map[string]struct{}, len(addrs))
+	for _, addr := range addrs {
+		decodedAddr, err := dcrutil.DecodeAddress(addr, params)
+		if err != nil {
+			return nil, err
+		}
+		set[decodedAddr.Address()] = struct{}{}
+	}
+
+	return set, nil
+}
+
+// checkPayouts returns an error if the on-chain payment to the given addresses
+// of the given amounts must not be broadcast.
+func (p *payoutPolicy) checkPayouts(payouts map[string]int64) error {
+	outputs := make([]payoutOutput, 0, len(payouts))
+	for addr, amt := range payouts {
+		decodedAddr, err := dcrutil.DecodeAddress(addr, p.params)
+		if err != nil {
+			return err
+		}
+		addr = decodedAddr.Address()
+
+		if _, ok := p.denylist[addr]; ok {
+			return fmt.Errorf("payouts to address %v are denied",
+				addr)
+		}
+
+		_, ok := p.allowlist[addr]
+		if len(p.allowlist) > 0 && !ok {
+			return fmt.Errorf("payouts to address %v are not "+
+				"allowed", addr)
+		}
+
+		outputs = append(outputs, payoutOutput{
+			Address: addr,
+			Amount:  amt,
+		})
+	}
+
+	if p.validatorURL == "" {
+		return nil
+	}
+
+	// Sort the outputs so that the same payment is always submitted the
+	// same way to the validator.
+	sort.Slice(outputs, func(i, j int) bool {
+		return outputs[i].Address < outputs[j].Address
+	})
+
+	return p.validate(&payoutValidationRequest{Outputs: outputs})
+}
+
+// validate submits the payment to the external payout validator, returning an
+// error if it couldn't be reached or didn't approve the payment.
+func (p *payoutPolicy) validate(req *payoutValidationRequest) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	resp, err := p.client.Post(
+		p.validatorURL, "application/json", bytes.NewReader(body),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to reach payout validator: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
+		return nil
+	}
+
+	reason, _ := ioutil.ReadAll(
+		io.LimitReader(resp.Body, maxPayoutRejectionLen),
+	)
+	return fmt.Errorf("payout rejected by validator: %v: %v",
+		resp.Status, strings.TrimSpace(string(reason)))
+}
@@ -0,0 +1,155 @@
+// +build !rpctest
+
+package dcrlnd
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/v2"
+	"github.com/decred/dcrlnd/lncfg"
+)
+
+const (
+	testPayoutAddr1 = "TsR28UZRprhgQQhzWns2M6cAwchrNVvbYq2"
+	testPayoutAddr2 = "TsVDyY1k1N2jZ7xYuoA1PEbwSP2mQnXR9qb"
+	testPayoutAddr3 = "Tsi6gGYNSMmFwi7JoL5Li39SrERZTTMu6vY"
+)
+
+// TestPayoutPolicyLists asserts that payouts are rejected if any of their
+// destinations is denied, or isn't allowed when an allowlist is set.
+func TestPayoutPolicyLists(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		allowlist []string
+		denylist  []string
+		payouts   map[string]int64
+		allowed   bool
+	}{
+		{
+			name:    "no lists",
+			payouts: map[string]int64{testPayoutAddr1: 1000},
+			allowed: true,
+		},
+		{
+			name:     "denied",
+			denylist: []string{testPayoutAddr1},
+			payouts: map[string]int64{
+				testPayoutAddr1: 1000,
+				testPayoutAddr2: 1000,
+			},
+		},
+		{
+			name:     "not denied",
+			denylist: []string{testPayoutAddr1},
+			payouts:  map[string]int64{testPayoutAddr2: 1000},
+			allowed:  true,
+		},
+		{
+			name:      "allowed",
+			allowlist: []string{testPayoutAddr1, testPayoutAddr2},
+			payouts: map[string]int64{
+				testPayoutAddr1: 1000,
+				testPayoutAddr2: 1000,
+			},
+			allowed: true,
+		},
+		{
+			name:      "not allowed",
+			allowlist: []string{testPayoutAddr1},
+			payouts: map[string]int64{
+				testPayoutAddr1: 1000,
+				testPayoutAddr3: 1000,
+			},
+		},
+	}
+
+	params := chaincfg.TestNet3Params()
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			policy, err := newPayoutPolicy(&lncfg.PayoutPolicy{
+				Allowlist: test.allowlist,
+				Denylist:  test.denylist,
+			}, params)
+			if err != nil {
+				t.Fatalf("unable to create policy: %v", err)
+			}
+
+			err = policy.checkPayouts(test.payouts)
+			switch {
+			case test.allowed && err != nil:
+				t.Fatalf("payout was rejected: %v", err)
+			case !test.allowed && err == nil:
+				t.Fatalf("payout was allowed")
+			}
+		})
+	}
+}
+
+// TestPayoutPolicyValidator asserts that the destinations of payouts are
+// submitted to the external validator, and that its verdict is enforced.
+func TestPayoutPolicyValidator(t *testing.T) {
+	t.Parallel()
+
+	reqs := make(chan *payoutValidationRequest, 1)
+	validator := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			var req payoutValidationRequest
+			err := json.NewDecoder(r.Body).Decode(&req)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			reqs <- &req
+
+			for _, output := range req.Outputs {
+				if output.Address == testPayoutAddr3 {
+					w.WriteHeader(http.StatusForbidden)
+					w.Write([]byte("sanctioned address"))
+					return
+				}
+			}
+		},
+	))
+	defer validator.Close()
+
+	policy, err := newPayoutPolicy(&lncfg.PayoutPolicy{
+		ValidatorURL:     validator.URL,
+		ValidatorTimeout: 5 * time.Second,
+	}, chaincfg.TestNet3Params())
+	if err != nil {
+		t.Fatalf("unable to create policy: %v", err)
+	}
+
+	err = policy.checkPayouts(map[string]int64{
+		testPayoutAddr2: 2000,
+		testPayoutAddr1: 1000,
+	})
+	if err != nil {
+		t.Fatalf("payout was rejected: %v", err)
+	}
+
+	expectedReq := &payoutValidationRequest{
+		Outputs: []payoutOutput{
+			{Address: testPayoutAddr1, Amount: 1000},
+			{Address: testPayoutAddr2, Amount: 2000},
+		},
+	}
+	if req := <-reqs; !reflect.DeepEqual(req, expectedReq) {
+		t.Fatalf("expected validation request %v, got %v",
+			expectedReq, req)
+	}
+
+	err = policy.checkPayouts(map[string]int64{testPayoutAddr3: 1000})
+	if err == nil {
+		t.Fatalf("payout was allowed")
+	}
+	<-reqs
+}
@@ -3,6 +3,7 @@ package dcrlnd
 import (
 	"bytes"
 	"container/list"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"net"
@@ -22,11 +23,13 @@ import (
 	"github.com/decred/dcrlnd/chainntnfs"
 	"github.com/decred/dcrlnd/channeldb"
 	"github.com/decred/dcrlnd/contractcourt"
+	"github.com/decred/dcrlnd/feature"
 	"github.com/decred/dcrlnd/htlcswitch"
 	"github.com/decred/dcrlnd/lnpeer"
 	"github.com/decred/dcrlnd/lnwallet"
 	"github.com/decred/dcrlnd/lnwire"
 	"github.com/decred/dcrlnd/pool"
+	"github.com/decred/dcrlnd/sweep"
 	"github.com/decred/dcrlnd/ticker"
 )
 
@@ -44,8 +47,9 @@ const (
 	// peer.
 	readMessageTimeout = 5 * time.Second
 
-	// handshakeTimeout is the timeout used when waiting for peer init message.
-	handshakeTimeout = 15 * time.Second
+	// defaultInitTimeout is the default timeout used when waiting for peer
+	// init message.
+	defaultInitTimeout = 15 * time.Second
 
 	// outgoingQueueLen is the buffer size of the channel which houses
 	// messages to be sent across the wire, requested by objects outside
@@ -115,6 +119,10 @@ type peer struct {
 	// our last ping message.  To be used atomically.
 	pingLastSend int64
 
+	// initDuration is the time it took to exchange init messages with the
+	// peer, expressed in micro seconds. To be used atomically.
+	initDuration int64
+
 	connReq *connmgr.ConnReq
 	conn    net.Conn
 
@@ -125,6 +133,10 @@ type peer struct {
 	// ready to process messages.
 	activeSignal chan struct{}
 
+	// initTimeout is the duration within which the peer must send its init
+	// message, after which the connection is failed.
+	initTimeout time.Duration
+
 	// startTime is the time this peer connection was successfully
 	// established. It will be zero for peers that did not successfully
 	// Start().
@@ -222,6 +234,10 @@ type peer struct {
 
 	readPool *pool.Read
 
+	// disconnectReason is the reason the peer was disconnected for. It is
+	// set before the quit channel is closed, and must only be read after.
+	disconnectReason error
+
 	queueQuit chan struct{}
 	quit      chan struct{}
 	wg        sync.WaitGroup
@@ -235,7 +251,7 @@ var _ lnpeer.Peer = (*peer)(nil)
 func newPeer(conn net.Conn, connReq *connmgr.ConnReq, server *server,
 	addr *lnwire.NetAddress, inbound bool,
 	localFeatures *lnwire.RawFeatureVector,
-	chanActiveTimeout time.Duration,
+	chanActiveTimeout, initTimeout time.Duration,
 	outgoingCltvRejectDelta uint32) (
 	*peer, error) {
 
@@ -272,6 +288,7 @@ func newPeer(conn net.Conn, connReq *connmgr.ConnReq, server *server,
 		failedChannels:     make(map[lnwire.ChannelID]struct{}),
 
 		chanActiveTimeout: chanActiveTimeout,
+		initTimeout:       initTimeout,
 
 		writePool: server.writePool,
 		readPool:  server.readPool,
@@ -293,6 +310,8 @@ func (p *peer) Start() error {
 
 	peerLog.Tracef("Peer %v starting", p)
 
+	initStart := time.Now()
+
 	// Exchange local and global features, the init message should be very
 	// first between two nodes.
 	if err := p.sendInitMsg(); err != nil {
@@ -320,16 +339,26 @@ func (p *peer) Start() error {
 
 	select {
 	// In order to avoid blocking indefinitely, we'll give the other peer
-	// an upper timeout to respond before we bail out early.
-	case <-time.After(handshakeTimeout):
-		return fmt.Errorf("peer did not complete handshake within %v",
-			handshakeTimeout)
+	// an upper timeout to respond before we bail out early. The connection
+	// is failed right away, so that the goroutine reading the init message
+	// exits instead of lingering on a stalled peer.
+	case <-time.After(p.initTimeout):
+		err := fmt.Errorf("peer did not complete handshake within %v",
+			p.initTimeout)
+		p.Disconnect(err)
+		return err
 	case err := <-readErr:
 		if err != nil {
 			return fmt.Errorf("unable to read init msg: %v", err)
 		}
 	}
 
+	initDuration := time.Since(initStart)
+	atomic.StoreInt64(&p.initDuration, initDuration.Microseconds())
+
+	peerLog.Debugf("Exchanged init messages with peer %v in %v", p,
+		initDuration)
+
 	// Once the init message arrives, we can parse it so we can figure out
 	// the negotiation of features for this session.
 	msg := <-msgChan
@@ -524,12 +553,21 @@ func (p *peer) loadActiveChannels(chans []*channeldb.OpenChannel) (
 		// routing policy into a forwarding policy.
 		var forwardingPolicy *htlcswitch.ForwardingPolicy
 		if selfPolicy != nil {
+			inboundFee, err := lnwire.ParseInboundFee(
+				selfPolicy.ExtraOpaqueData,
+			)
+			if err != nil {
+				peerLog.Warnf("Unable to parse inbound fee of "+
+					"channel %v: %v", chanPoint, err)
+			}
+
 			forwardingPolicy = &htlcswitch.ForwardingPolicy{
 				MinHTLC:       selfPolicy.MinHTLC,
 				MaxHTLC:       selfPolicy.MaxHTLC,
 				BaseFee:       selfPolicy.FeeBaseMAtoms,
 				FeeRate:       selfPolicy.FeeProportionalMillionths,
 				TimeLockDelta: uint32(selfPolicy.TimeLockDelta),
+				InboundFee:    inboundFee,
 			}
 		} else {
 			peerLog.Warnf("Unable to find our forwarding policy "+
@@ -629,6 +667,10 @@ func (p *peer) addLink(chanPoint *wire.OutPoint,
 		MaxFeeAllocation:        cfg.MaxChannelFeeAllocation,
 		NotifyActiveChannel:     p.server.channelNotifier.NotifyActiveChannelEvent,
 		NotifyInactiveChannel:   p.server.channelNotifier.NotifyInactiveChannelEvent,
+		LegacyPayloadPolicy:     p.server.legacyPayloadPolicy,
+		FailureDelayPolicy:      p.server.failureDelayPolicy,
+		HtlcExposurePolicy:      p.server.htlcExposurePolicy,
+		HtlcNotifier:            p.server.htlcNotifier,
 	}
 
 	link := htlcswitch.NewChannelLink(linkCfg, lnChan)
@@ -642,7 +684,22 @@ func (p *peer) addLink(chanPoint *wire.OutPoint,
 	// With the channel link created, we'll now notify the htlc switch so
 	// this channel can be used to dispatch local payments and also
 	// passively forward payments.
-	return p.server.htlcSwitch.AddLink(link)
+	if err := p.server.htlcSwitch.AddLink(link); err != nil {
+		return err
+	}
+
+	// If this is a zero-conf channel whose funding transaction has
+	// already confirmed, the link must also be reachable under the short
+	// channel ID it confirmed at.
+	dbChan := lnChan.State()
+	confirmedID := dbChan.ConfirmedShortChanID()
+	if !dbChan.IsZeroConf() || confirmedID == (lnwire.ShortChannelID{}) {
+		return nil
+	}
+
+	return p.server.htlcSwitch.AddConfirmedShortChanID(
+		link.ChanID(), confirmedID,
+	)
 }
 
 // WaitForDisconnect waits until the peer has disconnected. A peer may be
@@ -672,12 +729,21 @@ func (p *peer) Disconnect(reason error) {
 
 	peerLog.Infof("Disconnecting %s, reason: %v", p, reason)
 
+	p.disconnectReason = reason
+
 	// Ensure that the TCP connection is properly closed before continuing.
 	p.conn.Close()
 
 	close(p.quit)
 }
 
+// DisconnectReason returns the reason the peer was disconnected for. It blocks
+// until the peer is disconnected.
+func (p *peer) DisconnectReason() error {
+	<-p.quit
+	return p.disconnectReason
+}
+
 // String returns the string representation of this peer.
 func (p *peer) String() string {
 	return fmt.Sprintf("%x@%s", p.pubKeyBytes, p.conn.RemoteAddr())
@@ -1117,12 +1183,29 @@ out:
 			// In this case we'll try to resend our last channel
 			// sync message, such that the peer can recover funds
 			// from the closed channel.
+			//
+			// If the channel is unknown to us as it was lost along
+			// with our database, a recovery peer is asked to force
+			// close it instead, so that we can recover our funds.
 			if !isLinkUpdate {
-				err := p.resendChanSyncMsg(targetChan)
+				requested, err := p.requestRecoveryForceClose(
+					targetChan,
+				)
 				if err != nil {
-					// TODO(halseth): send error to peer?
-					peerLog.Errorf("resend failed: %v",
-						err)
+					peerLog.Errorf("Unable to request force "+
+						"close of ChannelID(%v) from "+
+						"recovery peer %v: %v",
+						targetChan, p, err)
+				}
+
+				if !requested {
+					err := p.resendChanSyncMsg(targetChan)
+					if err != nil {
+						// TODO(halseth): send error to
+						// peer?
+						peerLog.Errorf("resend "+
+							"failed: %v", err)
+					}
 				}
 			}
 
@@ -1142,6 +1225,14 @@ out:
 
 			discStream.AddMsg(msg)
 
+		case *lnwire.Custom:
+			err := p.server.handleCustomMessage(p.pubKeyBytes, msg)
+			if err != nil {
+				peerLog.Errorf("unable to handle custom "+
+					"message %v from peer %v: %v",
+					uint16(msg.MsgType()), p, err)
+			}
+
 		default:
 			peerLog.Errorf("unknown message %v received from peer "+
 				"%v", uint16(msg.MsgType()), p)
@@ -1224,8 +1315,9 @@ func (p *peer) handleError(msg *lnwire.Error) bool {
 
 // messageSummary returns a human-readable string that summarizes a
 // incoming/outgoing message. Not all messages will have a summary, only those
-// which have additional data that can be informative at a glance.
-func messageSummary(msg lnwire.Message) string {
+// which have additional data that can be informative at a glance. Unless unsafe
+// is set, the secrets carried by the message are left out of the summary.
+func messageSummary(msg lnwire.Message, unsafe bool) string {
 	switch msg := msg.(type) {
 	case *lnwire.Init:
 		// No summary.
@@ -1271,6 +1363,13 @@ func messageSummary(msg lnwire.Message) string {
 			msg.ID, msg.Reason)
 
 	case *lnwire.UpdateFulfillHTLC:
+		if !unsafe {
+			// Only log the hash of the preimage, which is enough
+			// to identify the payment being settled.
+			return fmt.Sprintf("chan_id=%v, id=%v, hash=%x",
+				msg.ChanID, msg.ID,
+				sha256.Sum256(msg.PaymentPreimage[:]))
+		}
 		return fmt.Sprintf("chan_id=%v, id=%v, pre_image=%x",
 			msg.ChanID, msg.ID, msg.PaymentPreimage[:])
 
@@ -1279,6 +1378,11 @@ func messageSummary(msg lnwire.Message) string {
 			len(msg.HtlcSigs))
 
 	case *lnwire.RevokeAndAck:
+		if !unsafe {
+			return fmt.Sprintf("chan_id=%v, next_point=%x",
+				msg.ChanID,
+				msg.NextRevocationKey.SerializeCompressed())
+		}
 		return fmt.Sprintf("chan_id=%v, rev=%x, next_point=%x",
 			msg.ChanID, msg.Revocation[:],
 			msg.NextRevocationKey.SerializeCompressed())
@@ -1347,16 +1451,18 @@ func messageSummary(msg lnwire.Message) string {
 			time.Unix(int64(msg.FirstTimestamp), 0),
 			msg.TimestampRange)
 
+	case *lnwire.Custom:
+		return fmt.Sprintf("type=%d", msg.Type)
+
 	}
 
 	return ""
 }
 
-// logWireMessage logs the receipt or sending of particular wire message. This
-// function is used rather than just logging the message in order to produce
-// less spammy log messages in trace mode by setting the 'Curve" parameter to
-// nil. Doing this avoids printing out each of the field elements in the curve
-// parameters for secp256k1.
+// logWireMessage logs the receipt or sending of particular wire message. The
+// secrets carried by the message, such as payment preimages, revocation secrets
+// and onion blobs, are redacted from the logs unless unsafe logging was enabled
+// in the config.
 func (p *peer) logWireMessage(msg lnwire.Message, read bool) {
 	summaryPrefix := "Received"
 	if !read {
@@ -1365,7 +1471,7 @@ func (p *peer) logWireMessage(msg lnwire.Message, read bool) {
 
 	peerLog.Debugf("%v", newLogClosure(func() string {
 		// Debug summary of message.
-		summary := messageSummary(msg)
+		summary := messageSummary(msg, cfg.UnsafeLogging)
 		if len(summary) > 0 {
 			summary = "(" + summary + ")"
 		}
@@ -1379,38 +1485,13 @@ func (p *peer) logWireMessage(msg lnwire.Message, read bool) {
 			msg.MsgType(), summary, preposition, p)
 	}))
 
-	switch m := msg.(type) {
-	case *lnwire.ChannelReestablish:
-		if m.LocalUnrevokedCommitPoint != nil {
-			m.LocalUnrevokedCommitPoint.Curve = nil
-		}
-	case *lnwire.RevokeAndAck:
-		m.NextRevocationKey.Curve = nil
-	case *lnwire.AcceptChannel:
-		m.FundingKey.Curve = nil
-		m.RevocationPoint.Curve = nil
-		m.PaymentPoint.Curve = nil
-		m.DelayedPaymentPoint.Curve = nil
-		m.HtlcPoint.Curve = nil
-		m.FirstCommitmentPoint.Curve = nil
-	case *lnwire.OpenChannel:
-		m.FundingKey.Curve = nil
-		m.RevocationPoint.Curve = nil
-		m.PaymentPoint.Curve = nil
-		m.DelayedPaymentPoint.Curve = nil
-		m.HtlcPoint.Curve = nil
-		m.FirstCommitmentPoint.Curve = nil
-	case *lnwire.FundingLocked:
-		m.NextPerCommitmentPoint.Curve = nil
-	}
-
 	prefix := "readMessage from"
 	if !read {
 		prefix = "writeMessage to"
 	}
 
 	peerLog.Tracef(prefix+" %v: %v", p, newLogClosure(func() string {
-		return spew.Sdump(msg)
+		return lnwire.SpewMessage(msg, cfg.UnsafeLogging)
 	}))
 }
 
@@ -1679,6 +1760,12 @@ func (p *peer) PingTime() int64 {
 	return atomic.LoadInt64(&p.pingTime)
 }
 
+// InitDuration returns the time it took to exchange init messages with the
+// peer in microseconds, or zero if they haven't been exchanged yet.
+func (p *peer) InitDuration() int64 {
+	return atomic.LoadInt64(&p.initDuration)
+}
+
 // queueMsg adds the lnwire.Message to the back of the high priority send queue.
 // If the errChan is non-nil, an error is sent back if the msg failed to queue
 // or failed to write, and nil otherwise.
@@ -2065,18 +2152,28 @@ func (p *peer) fetchActiveChanCloser(chanID lnwire.ChannelID) (*channelCloser, e
 		}
 
 		// We'll create a valid closing state machine in order to
-		// respond to the initiated cooperative channel closure.
-		deliveryAddr, err := p.genDeliveryScript()
-		if err != nil {
-			peerLog.Errorf("unable to gen delivery script: %v", err)
+		// respond to the initiated cooperative channel closure. If we
+		// committed to a delivery address when the channel was
+		// opened, we must use it, otherwise we'll fetch a fresh one.
+		deliveryAddr := channel.State().LocalShutdownScript
+		if len(deliveryAddr) == 0 {
+			var err error
+			deliveryAddr, err = p.genDeliveryScript()
+			if err != nil {
+				peerLog.Errorf("unable to gen delivery script: "+
+					"%v", err)
 
-			return nil, fmt.Errorf("close addr unavailable")
+				return nil, fmt.Errorf("close addr unavailable")
+			}
 		}
 
 		// In order to begin fee negotiations, we'll first compute our
-		// target ideal fee-per-kw. We'll set this to a lax value, as
-		// we weren't the ones that initiated the channel closure.
-		feePerKB, err := p.server.cc.feeEstimator.EstimateFeePerKB(6)
+		// target ideal fee-per-kw. We'll set this to the lax value of
+		// cooperative closes, as we weren't the ones that initiated
+		// the channel closure.
+		feePerKB, err := p.server.feeService.EstimateFeePerKB(
+			lnwallet.FeeSubsystemCoopClose,
+		)
 		if err != nil {
 			peerLog.Errorf("unable to query fee estimator: %v", err)
 
@@ -2093,9 +2190,12 @@ func (p *peer) fetchActiveChanCloser(chanID lnwire.ChannelID) (*channelCloser, e
 			chanCloseCfg{
 				channel:           channel,
 				unregisterChannel: p.server.htlcSwitch.RemoveLink,
-				broadcastTx:       p.server.cc.wallet.PublishTransaction,
-				disableChannel:    p.server.chanStatusMgr.RequestDisable,
-				quit:              p.quit,
+				broadcastTx: labeledPublisher(
+					p.server.chanDB, coopCloseTxLabel,
+					p.server.cc.wallet.PublishTransaction,
+				),
+				disableChannel: p.server.chanStatusMgr.RequestDisable,
+				quit:           p.quit,
 			},
 			deliveryAddr,
 			feePerKB,
@@ -2130,14 +2230,34 @@ func (p *peer) handleLocalCloseReq(req *htlcswitch.ChanClose) {
 	// out this channel on-chain, so we execute the cooperative channel
 	// closure workflow.
 	case htlcswitch.CloseRegular:
-		// First, we'll fetch a fresh delivery address that we'll use
+		// First, we'll determine the delivery address that we'll use
 		// to send the funds to in the case of a successful
-		// negotiation.
-		deliveryAddr, err := p.genDeliveryScript()
-		if err != nil {
-			peerLog.Errorf(err.Error())
-			req.Err <- err
-			return
+		// negotiation. If we committed to one when the channel was
+		// opened, the caller can't override it. Otherwise, if the
+		// caller didn't specify one, we'll fetch a fresh address from
+		// the wallet.
+		deliveryAddr := req.DeliveryScript
+		upfrontScript := channel.State().LocalShutdownScript
+		switch {
+		case len(upfrontScript) > 0 && len(deliveryAddr) > 0:
+			err := maybeMatchScript(upfrontScript, deliveryAddr)
+			if err != nil {
+				peerLog.Errorf(err.Error())
+				req.Err <- err
+				return
+			}
+
+		case len(upfrontScript) > 0:
+			deliveryAddr = upfrontScript
+
+		case len(deliveryAddr) == 0:
+			var err error
+			deliveryAddr, err = p.genDeliveryScript()
+			if err != nil {
+				peerLog.Errorf(err.Error())
+				req.Err <- err
+				return
+			}
 		}
 
 		// Next, we'll create a new channel closer state machine to
@@ -2153,9 +2273,12 @@ func (p *peer) handleLocalCloseReq(req *htlcswitch.ChanClose) {
 			chanCloseCfg{
 				channel:           channel,
 				unregisterChannel: p.server.htlcSwitch.RemoveLink,
-				broadcastTx:       p.server.cc.wallet.PublishTransaction,
-				disableChannel:    p.server.chanStatusMgr.RequestDisable,
-				quit:              p.quit,
+				broadcastTx: labeledPublisher(
+					p.server.chanDB, coopCloseTxLabel,
+					p.server.cc.wallet.PublishTransaction,
+				),
+				disableChannel: p.server.chanStatusMgr.RequestDisable,
+				quit:           p.quit,
 			},
 			deliveryAddr,
 			req.TargetFeePerKB,
@@ -2233,7 +2356,7 @@ func (p *peer) handleLinkFailure(failure linkFailureReport) {
 			failure.shortChanID)
 
 		closeTx, err := p.server.chainArb.ForceCloseContract(
-			failure.chanPoint,
+			failure.chanPoint, sweep.FeePreference{},
 		)
 		if err != nil {
 			peerLog.Errorf("unable to force close "+
@@ -2409,6 +2532,14 @@ func (p *peer) handleInitMsg(msg *lnwire.Init) error {
 		return err
 	}
 
+	// Ensure that the features advertised by the peer also set all of
+	// their transitive dependencies. Features may depend on features in
+	// the other vector, so both are validated together.
+	err := feature.ValidateVectors(msg.LocalFeatures, msg.GlobalFeatures)
+	if err != nil {
+		return fmt.Errorf("peer set invalid feature vectors: %v", err)
+	}
+
 	// Now that we know we understand their requirements, we'll check to
 	// see if they don't support anything that we deem to be mandatory.
 	switch {
@@ -2437,6 +2568,15 @@ func (p *peer) RemoteGlobalFeatures() *lnwire.FeatureVector {
 	return p.remoteGlobalFeatures
 }
 
+// RemoteLocalFeatures returns the set of local features that has been
+// advertised by the remote node. This allows sub-systems that use this
+// interface to gate their behavior off the set of negotiated feature bits.
+//
+// NOTE: Part of the lnpeer.Peer interface.
+func (p *peer) RemoteLocalFeatures() *lnwire.FeatureVector {
+	return p.remoteLocalFeatures
+}
+
 // sendInitMsg sends init message to remote peer which contains our currently
 // supported local and global features.
 func (p *peer) sendInitMsg() error {
@@ -2482,6 +2622,28 @@ func (p *peer) resendChanSyncMsg(cid lnwire.ChannelID) error {
 	return nil
 }
 
+// requestRecoveryForceClose asks the peer to force close the given channel if
+// it's a recovery peer and the channel is unknown to us, returning true if it
+// was asked to.
+func (p *peer) requestRecoveryForceClose(cid lnwire.ChannelID) (bool, error) {
+	msg, err := p.server.chanRecovery.forceCloseRequest(
+		p.pubKeyBytes, cid,
+	)
+	if err != nil || msg == nil {
+		return false, err
+	}
+
+	peerLog.Infof("Requesting recovery peer %v to force close unknown "+
+		"ChannelID(%v)", p, cid)
+
+	if err := p.SendMessage(true, msg); err != nil {
+		return false, fmt.Errorf("unable to send force close "+
+			"request: %v", err)
+	}
+
+	return true, nil
+}
+
 // SendMessage sends a variadic number of high-priority message to remote peer.
 // The first argument denotes if the method should block until the messages have
 // been sent to the remote peer or an error is returned, otherwise it returns
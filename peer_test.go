@@ -3,6 +3,7 @@
 package dcrlnd
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
@@ -588,3 +589,233 @@ func TestPeerChannelClosureFeeNegotiationsInitiator(t *testing.T) {
 		t.Fatalf("closing tx not broadcast")
 	}
 }
+
+// TestPeerChannelClosureDeliveryAndMaxFee tests that the initiator of a
+// cooperative closure pays its funds to the requested delivery script, and
+// never proposes a fee above the requested maximum.
+func TestPeerChannelClosureDeliveryAndMaxFee(t *testing.T) {
+	t.Parallel()
+
+	notifier := &mockNotfier{
+		confChannel: make(chan *chainntnfs.TxConfirmation),
+	}
+	broadcastTxChan := make(chan *wire.MsgTx)
+
+	initiator, initiatorChan, responderChan, cleanUp, err := createTestPeer(
+		notifier, broadcastTxChan)
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	deliveryScript := []byte{
+		0x76, 0xa9, 0x14, 0x01, 0x01, 0x01, 0x01, 0x01,
+		0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01,
+		0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x88,
+		0xac,
+	}
+
+	// We make the initiator send a shutdown request, paying to our custom
+	// delivery script and capping the fee rate below the target one.
+	const maxFeePerKB = 10000
+	updateChan := make(chan interface{}, 1)
+	errChan := make(chan error, 1)
+	closeCommand := &htlcswitch.ChanClose{
+		CloseType:      htlcswitch.CloseRegular,
+		ChanPoint:      initiatorChan.ChannelPoint(),
+		Updates:        updateChan,
+		TargetFeePerKB: 12500,
+		MaxFeePerKB:    maxFeePerKB,
+		DeliveryScript: deliveryScript,
+		Err:            errChan,
+	}
+	initiator.localCloseChanReqs <- closeCommand
+
+	// We should now be getting the shutdown request, which should include
+	// the delivery script we requested.
+	var msg lnwire.Message
+	select {
+	case outMsg := <-initiator.outgoingQueue:
+		msg = outMsg.msg
+	case <-time.After(time.Second * 5):
+		t.Fatalf("did not receive shutdown request")
+	}
+
+	shutdownMsg, ok := msg.(*lnwire.Shutdown)
+	if !ok {
+		t.Fatalf("expected Shutdown message, got %T", msg)
+	}
+	if !bytes.Equal(shutdownMsg.Address, deliveryScript) {
+		t.Fatalf("expected delivery script %x, instead got %x",
+			deliveryScript, shutdownMsg.Address)
+	}
+
+	// We'll answer the shutdown message with our own Shutdown, after which
+	// the initiator should send its first proposal, clamped to the max
+	// fee.
+	chanID := shutdownMsg.ChannelID
+	initiator.chanCloseMsgs <- &closeMsg{
+		cid: chanID,
+		msg: lnwire.NewShutdown(chanID, dummyDeliveryScript),
+	}
+
+	select {
+	case outMsg := <-initiator.outgoingQueue:
+		msg = outMsg.msg
+	case <-time.After(time.Second * 5):
+		t.Fatalf("did not receive closing signed")
+	}
+	closingSignedMsg, ok := msg.(*lnwire.ClosingSigned)
+	if !ok {
+		t.Fatalf("expected ClosingSigned message, got %T", msg)
+	}
+
+	maxFee := responderChan.CalcFee(maxFeePerKB)
+	if closingSignedMsg.FeeAtoms != maxFee {
+		t.Fatalf("expected ClosingSigned fee to be %v, instead got %v",
+			maxFee, closingSignedMsg.FeeAtoms)
+	}
+
+	// We'll now propose a fee well above the max, which the initiator
+	// should refuse to meet.
+	increasedFee := maxFee * 2
+	closeSig, _, _, err := responderChan.CreateCloseProposal(
+		increasedFee, dummyDeliveryScript, deliveryScript,
+	)
+	if err != nil {
+		t.Fatalf("unable to create close proposal: %v", err)
+	}
+	parsedSig, err := lnwire.NewSigFromRawSignature(closeSig)
+	if err != nil {
+		t.Fatalf("unable to parse signature: %v", err)
+	}
+
+	closingSigned := lnwire.NewClosingSigned(chanID, increasedFee, parsedSig)
+	initiator.chanCloseMsgs <- &closeMsg{
+		cid: chanID,
+		msg: closingSigned,
+	}
+
+	select {
+	case outMsg := <-initiator.outgoingQueue:
+		msg = outMsg.msg
+	case <-time.After(time.Second * 5):
+		t.Fatalf("did not receive closing signed")
+	}
+	closingSignedMsg, ok = msg.(*lnwire.ClosingSigned)
+	if !ok {
+		t.Fatalf("expected ClosingSigned message, got %T", msg)
+	}
+	if closingSignedMsg.FeeAtoms > maxFee {
+		t.Fatalf("proposed fee %v exceeds max fee %v",
+			closingSignedMsg.FeeAtoms, maxFee)
+	}
+
+	// The closing transaction shouldn't have been broadcast, as we haven't
+	// agreed on a fee.
+	select {
+	case <-broadcastTxChan:
+		t.Fatalf("closing tx should not be broadcast")
+	case <-time.After(time.Millisecond * 100):
+	}
+}
+
+// TestPeerChannelClosureUpfrontShutdown tests that the upfront shutdown
+// scripts committed to when the channel was opened are enforced during the
+// cooperative close negotiation.
+func TestPeerChannelClosureUpfrontShutdown(t *testing.T) {
+	t.Parallel()
+
+	notifier := &mockNotfier{
+		confChannel: make(chan *chainntnfs.TxConfirmation),
+	}
+	broadcastTxChan := make(chan *wire.MsgTx)
+
+	initiator, initiatorChan, _, cleanUp, err := createTestPeer(
+		notifier, broadcastTxChan)
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	upfrontScript := []byte{
+		0x76, 0xa9, 0x14, 0x02, 0x02, 0x02, 0x02, 0x02,
+		0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02,
+		0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x88,
+		0xac,
+	}
+	remoteUpfrontScript := []byte{
+		0x76, 0xa9, 0x14, 0x03, 0x03, 0x03, 0x03, 0x03,
+		0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03,
+		0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x88,
+		0xac,
+	}
+	initiatorChan.State().LocalShutdownScript = upfrontScript
+	initiatorChan.State().RemoteShutdownScript = remoteUpfrontScript
+
+	// A close request paying to a delivery script other than the one we
+	// committed to upfront should be refused.
+	errChan := make(chan error, 1)
+	initiator.localCloseChanReqs <- &htlcswitch.ChanClose{
+		CloseType:      htlcswitch.CloseRegular,
+		ChanPoint:      initiatorChan.ChannelPoint(),
+		Updates:        make(chan interface{}, 1),
+		TargetFeePerKB: 12500,
+		DeliveryScript: dummyDeliveryScript,
+		Err:            errChan,
+	}
+
+	select {
+	case err := <-errChan:
+		if err != ErrUpfrontShutdownScriptMismatch {
+			t.Fatalf("expected %v, got %v",
+				ErrUpfrontShutdownScriptMismatch, err)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatalf("close request not refused")
+	}
+
+	// If no delivery script is specified, the shutdown request should pay
+	// to the upfront shutdown script.
+	initiator.localCloseChanReqs <- &htlcswitch.ChanClose{
+		CloseType:      htlcswitch.CloseRegular,
+		ChanPoint:      initiatorChan.ChannelPoint(),
+		Updates:        make(chan interface{}, 1),
+		TargetFeePerKB: 12500,
+		Err:            errChan,
+	}
+
+	var msg lnwire.Message
+	select {
+	case outMsg := <-initiator.outgoingQueue:
+		msg = outMsg.msg
+	case <-time.After(time.Second * 5):
+		t.Fatalf("did not receive shutdown request")
+	}
+
+	shutdownMsg, ok := msg.(*lnwire.Shutdown)
+	if !ok {
+		t.Fatalf("expected Shutdown message, got %T", msg)
+	}
+	if !bytes.Equal(shutdownMsg.Address, upfrontScript) {
+		t.Fatalf("expected delivery script %x, instead got %x",
+			upfrontScript, shutdownMsg.Address)
+	}
+
+	// Finally, a Shutdown response paying to a delivery script other than
+	// the one the remote party committed to should fail the negotiation.
+	chanID := shutdownMsg.ChannelID
+	initiator.chanCloseMsgs <- &closeMsg{
+		cid: chanID,
+		msg: lnwire.NewShutdown(chanID, dummyDeliveryScript),
+	}
+
+	select {
+	case err := <-errChan:
+		if err == nil {
+			t.Fatalf("expected negotiation to fail")
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatalf("close negotiation not failed")
+	}
+}
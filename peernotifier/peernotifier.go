@@ -22,10 +22,20 @@ type PeerOnlineEvent struct {
 	PubKey [33]byte
 }
 
+// PeerActiveEvent represents a new event where a peer has completed the
+// exchange of init messages, and is ready to be used.
+type PeerActiveEvent struct {
+	// PubKey is the peer's compressed public key.
+	PubKey [33]byte
+}
+
 // PeerOfflineEvent represents a new event where a peer goes offline.
 type PeerOfflineEvent struct {
 	// PubKey is the peer's compressed public key.
 	PubKey [33]byte
+
+	// Reason is the reason the peer was disconnected for, if known.
+	Reason error
 }
 
 // New creates a new peer notifier which notifies clients of peer online
@@ -74,12 +84,25 @@ func (p *PeerNotifier) NotifyPeerOnline(pubKey [33]byte) {
 	}
 }
 
+// NotifyPeerActive sends a peer active event to all clients subscribed to the
+// peer notifier.
+func (p *PeerNotifier) NotifyPeerActive(pubKey [33]byte) {
+	event := PeerActiveEvent{PubKey: pubKey}
+
+	log.Debugf("PeerNotifier notifying peer: %x active", pubKey)
+
+	if err := p.ntfnServer.SendUpdate(event); err != nil {
+		log.Warnf("Unable to send peer active update: %v", err)
+	}
+}
+
 // NotifyPeerOffline sends a peer offline event to all the clients subscribed
-// to the peer notifier.
-func (p *PeerNotifier) NotifyPeerOffline(pubKey [33]byte) {
-	event := PeerOfflineEvent{PubKey: pubKey}
+// to the peer notifier, along with the reason the peer was disconnected for.
+func (p *PeerNotifier) NotifyPeerOffline(pubKey [33]byte, reason error) {
+	event := PeerOfflineEvent{PubKey: pubKey, Reason: reason}
 
-	log.Debugf("PeerNotifier notifying peer: %x offline", pubKey)
+	log.Debugf("PeerNotifier notifying peer: %x offline, reason: %v",
+		pubKey, reason)
 
 	if err := p.ntfnServer.SendUpdate(event); err != nil {
 		log.Warnf("Unable to send peer offline update: %v", err)
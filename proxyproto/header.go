@@ -0,0 +1,190 @@
+package proxyproto
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+)
+
+const (
+	// maxV1HeaderLen is the maximum length of a version 1 header,
+	// including its trailing CRLF.
+	maxV1HeaderLen = 107
+
+	// v2HeaderLen is the length of the fixed part of a version 2 header.
+	v2HeaderLen = 16
+
+	// v2CmdLocal is the version 2 command of connections established by
+	// the proxy itself, e.g. for health checks.
+	v2CmdLocal = 0x0
+
+	// v2CmdProxy is the version 2 command of connections relayed on behalf
+	// of a client.
+	v2CmdProxy = 0x1
+
+	// v2FamilyInet is the version 2 address family of IPv4 connections.
+	v2FamilyInet = 0x1
+
+	// v2FamilyInet6 is the version 2 address family of IPv6 connections.
+	v2FamilyInet6 = 0x2
+)
+
+var (
+	// v1Signature is the signature starting version 1 headers.
+	v1Signature = []byte("PROXY ")
+
+	// v2Signature is the signature starting version 2 headers.
+	v2Signature = []byte("\r\n\r\n\x00\r\nQUIT\n")
+
+	// ErrNoHeader is returned when a connection doesn't start with a PROXY
+	// protocol header.
+	ErrNoHeader = errors.New("connection doesn't start with a PROXY " +
+		"protocol header")
+)
+
+// readHeader reads the PROXY protocol header at the start of the passed
+// reader, and returns the source address of the relayed connection. A nil
+// address is returned if the header doesn't carry the address of a client,
+// which is the case of connections established by the proxy itself.
+func readHeader(r *bufio.Reader) (net.Addr, error) {
+	sig, err := r.Peek(len(v2Signature))
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case bytes.HasPrefix(sig, v1Signature):
+		return readV1Header(r)
+
+	case bytes.Equal(sig, v2Signature):
+		return readV2Header(r)
+
+	default:
+		return nil, ErrNoHeader
+	}
+}
+
+// readV1Header reads a version 1, human readable, header of the form
+// "PROXY TCP4 <src ip> <dst ip> <src port> <dst port>\r\n".
+func readV1Header(r *bufio.Reader) (net.Addr, error) {
+	var line []byte
+	for !bytes.HasSuffix(line, []byte("\r\n")) {
+		if len(line) >= maxV1HeaderLen {
+			return nil, fmt.Errorf("PROXY protocol v1 header "+
+				"exceeds %d bytes", maxV1HeaderLen)
+		}
+
+		b, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		line = append(line, b)
+	}
+
+	fields := strings.Split(strings.TrimSuffix(string(line), "\r\n"), " ")
+	if len(fields) < 2 {
+		return nil, fmt.Errorf("invalid PROXY protocol v1 header: %q",
+			line)
+	}
+
+	switch fields[1] {
+	// The address of the client is unknown to the proxy, so the rest of
+	// the header is ignored.
+	case "UNKNOWN":
+		return nil, nil
+
+	case "TCP4", "TCP6":
+
+	default:
+		return nil, fmt.Errorf("unsupported PROXY protocol v1 "+
+			"protocol: %v", fields[1])
+	}
+
+	if len(fields) != 6 {
+		return nil, fmt.Errorf("invalid PROXY protocol v1 header: %q",
+			line)
+	}
+
+	ip := net.ParseIP(fields[2])
+	if ip == nil {
+		return nil, fmt.Errorf("invalid PROXY protocol v1 source "+
+			"address: %v", fields[2])
+	}
+
+	port, err := strconv.ParseUint(fields[4], 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid PROXY protocol v1 source "+
+			"port: %v", fields[4])
+	}
+
+	return &net.TCPAddr{IP: ip, Port: int(port)}, nil
+}
+
+// readV2Header reads a version 2, binary, header.
+func readV2Header(r *bufio.Reader) (net.Addr, error) {
+	var header [v2HeaderLen]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return nil, err
+	}
+
+	version, cmd := header[12]>>4, header[12]&0x0f
+	if version != 2 {
+		return nil, fmt.Errorf("unsupported PROXY protocol version: %v",
+			version)
+	}
+
+	family := header[13] >> 4
+
+	// The addresses are followed by optional TLVs, which are part of the
+	// header and skipped along with them.
+	addrs := make([]byte, binary.BigEndian.Uint16(header[14:]))
+	if _, err := io.ReadFull(r, addrs); err != nil {
+		return nil, err
+	}
+
+	switch cmd {
+	case v2CmdLocal:
+		return nil, nil
+
+	case v2CmdProxy:
+
+	default:
+		return nil, fmt.Errorf("unsupported PROXY protocol v2 "+
+			"command: %v", cmd)
+	}
+
+	switch family {
+	case v2FamilyInet:
+		if len(addrs) < 12 {
+			return nil, errors.New("PROXY protocol v2 IPv4 " +
+				"addresses too short")
+		}
+
+		return &net.TCPAddr{
+			IP:   net.IP(addrs[:4]),
+			Port: int(binary.BigEndian.Uint16(addrs[8:])),
+		}, nil
+
+	case v2FamilyInet6:
+		if len(addrs) < 36 {
+			return nil, errors.New("PROXY protocol v2 IPv6 " +
+				"addresses too short")
+		}
+
+		return &net.TCPAddr{
+			IP:   net.IP(addrs[:16]),
+			Port: int(binary.BigEndian.Uint16(addrs[32:])),
+		}, nil
+
+	// Unix sockets and unspecified families don't carry an address that
+	// can be used in place of the one of the proxy.
+	default:
+		return nil, nil
+	}
+}
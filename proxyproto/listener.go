@@ -0,0 +1,152 @@
+package proxyproto
+
+import (
+	"bufio"
+	"net"
+	"sync"
+	"time"
+)
+
+// DefaultHeaderTimeout is the default duration within which the PROXY protocol
+// header must be received on a new connection.
+const DefaultHeaderTimeout = 5 * time.Second
+
+// Listener is a net.Listener accepting connections relayed by a proxy or load
+// balancer speaking the PROXY protocol. The remote address of the accepted
+// connections is the address of the original client, as reported by the
+// proxy.
+//
+// NOTE: The PROXY protocol header can be forged by anyone able to connect to
+// the listener, so it must only be used on listeners that solely the proxy can
+// reach.
+type Listener struct {
+	net.Listener
+
+	headerTimeout time.Duration
+}
+
+// A compile time check to ensure Listener implements the net.Listener
+// interface.
+var _ net.Listener = (*Listener)(nil)
+
+// NewListener returns a new Listener accepting connections from the passed
+// listener, which must start with a PROXY protocol header received within the
+// given timeout.
+func NewListener(l net.Listener, headerTimeout time.Duration) *Listener {
+	return &Listener{
+		Listener:      l,
+		headerTimeout: headerTimeout,
+	}
+}
+
+// Accept waits for and returns the next connection to the listener. The PROXY
+// protocol header of the connection is read on first use, so that a stalled
+// client doesn't block the acceptance of other connections.
+//
+// This is part of the net.Listener interface.
+func (l *Listener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Conn{
+		Conn:          conn,
+		reader:        bufio.NewReader(conn),
+		headerTimeout: l.headerTimeout,
+	}, nil
+}
+
+// Conn is a net.Conn relayed by a proxy speaking the PROXY protocol.
+type Conn struct {
+	net.Conn
+
+	reader        *bufio.Reader
+	headerTimeout time.Duration
+
+	headerOnce sync.Once
+	srcAddr    net.Addr
+	headerErr  error
+
+	// readDeadline is the read deadline set by the user of the connection,
+	// which is restored once the header has been read.
+	readDeadline time.Time
+	mtx          sync.Mutex
+}
+
+// A compile time check to ensure Conn implements the net.Conn interface.
+var _ net.Conn = (*Conn)(nil)
+
+// readHeader reads the PROXY protocol header of the connection, if it hasn't
+// been read yet.
+func (c *Conn) readHeader() error {
+	c.headerOnce.Do(func() {
+		err := c.Conn.SetReadDeadline(time.Now().Add(c.headerTimeout))
+		if err != nil {
+			c.headerErr = err
+			return
+		}
+
+		c.srcAddr, c.headerErr = readHeader(c.reader)
+
+		c.mtx.Lock()
+		err = c.Conn.SetReadDeadline(c.readDeadline)
+		c.mtx.Unlock()
+		if err != nil && c.headerErr == nil {
+			c.headerErr = err
+		}
+
+		// The connection is useless without a valid header, so it's
+		// closed right away.
+		if c.headerErr != nil {
+			c.Conn.Close()
+		}
+	})
+
+	return c.headerErr
+}
+
+// Read reads data from the connection, following its PROXY protocol header.
+//
+// This is part of the net.Conn interface.
+func (c *Conn) Read(b []byte) (int, error) {
+	if err := c.readHeader(); err != nil {
+		return 0, err
+	}
+
+	return c.reader.Read(b)
+}
+
+// RemoteAddr returns the address of the client the connection was relayed
+// for, or the address of the proxy if it didn't report one.
+//
+// This is part of the net.Conn interface.
+func (c *Conn) RemoteAddr() net.Addr {
+	if err := c.readHeader(); err == nil && c.srcAddr != nil {
+		return c.srcAddr
+	}
+
+	return c.Conn.RemoteAddr()
+}
+
+// SetDeadline sets the read and write deadlines of the connection.
+//
+// This is part of the net.Conn interface.
+func (c *Conn) SetDeadline(t time.Time) error {
+	c.mtx.Lock()
+	c.readDeadline = t
+	c.mtx.Unlock()
+
+	return c.Conn.SetDeadline(t)
+}
+
+// SetReadDeadline sets the deadline for future Read calls.
+//
+// This is part of the net.Conn interface.
+func (c *Conn) SetReadDeadline(t time.Time) error {
+	c.mtx.Lock()
+	c.readDeadline = t
+	c.mtx.Unlock()
+
+	return c.Conn.SetReadDeadline(t)
+}
@@ -0,0 +1,148 @@
+package proxyproto
+
+import (
+	"bufio"
+	"bytes"
+	"io/ioutil"
+	"net"
+	"testing"
+	"time"
+)
+
+// TestReadHeader asserts that both versions of the PROXY protocol header are
+// parsed into the source address of the relayed connection.
+func TestReadHeader(t *testing.T) {
+	t.Parallel()
+
+	v2Header := func(cmd, family byte, addrs []byte) []byte {
+		header := append([]byte{}, v2Signature...)
+		header = append(header, 0x20|cmd, family<<4|0x1)
+		header = append(header, byte(len(addrs)>>8), byte(len(addrs)))
+		return append(header, addrs...)
+	}
+
+	testCases := []struct {
+		name    string
+		header  []byte
+		srcAddr string
+		fail    bool
+	}{
+		{
+			name:    "v1 tcp4",
+			header:  []byte("PROXY TCP4 1.2.3.4 5.6.7.8 1234 9735\r\n"),
+			srcAddr: "1.2.3.4:1234",
+		},
+		{
+			name: "v1 tcp6",
+			header: []byte("PROXY TCP6 2001:db8::1 2001:db8::2 " +
+				"1234 9735\r\n"),
+			srcAddr: "[2001:db8::1]:1234",
+		},
+		{
+			name:   "v1 unknown",
+			header: []byte("PROXY UNKNOWN\r\n"),
+		},
+		{
+			name:   "v1 invalid address",
+			header: []byte("PROXY TCP4 1.2.3 5.6.7.8 1234 9735\r\n"),
+			fail:   true,
+		},
+		{
+			name: "v2 inet",
+			header: v2Header(v2CmdProxy, v2FamilyInet, []byte{
+				1, 2, 3, 4, 5, 6, 7, 8, 0x04, 0xd2, 0x26, 0x07,
+			}),
+			srcAddr: "1.2.3.4:1234",
+		},
+		{
+			name:   "v2 local",
+			header: v2Header(v2CmdLocal, 0, nil),
+		},
+		{
+			name: "v2 short inet",
+			header: v2Header(v2CmdProxy, v2FamilyInet, []byte{
+				1, 2, 3, 4,
+			}),
+			fail: true,
+		},
+		{
+			name:   "no header",
+			header: []byte("GET / HTTP/1.1\r\n\r\n"),
+			fail:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		r := bufio.NewReader(bytes.NewReader(testCase.header))
+		srcAddr, err := readHeader(r)
+		switch {
+		case testCase.fail && err == nil:
+			t.Fatalf("%v: expected failure", testCase.name)
+
+		case !testCase.fail && err != nil:
+			t.Fatalf("%v: unable to read header: %v", testCase.name,
+				err)
+
+		case testCase.fail:
+			continue
+		}
+
+		var addrStr string
+		if srcAddr != nil {
+			addrStr = srcAddr.String()
+		}
+		if addrStr != testCase.srcAddr {
+			t.Fatalf("%v: expected source address %v, got %v",
+				testCase.name, testCase.srcAddr, addrStr)
+		}
+	}
+}
+
+// TestListener asserts that the connections accepted by the listener report
+// the address of the client, and that the data following the header is read
+// back intact.
+func TestListener(t *testing.T) {
+	t.Parallel()
+
+	tcpListener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unable to listen: %v", err)
+	}
+	listener := NewListener(tcpListener, DefaultHeaderTimeout)
+	defer listener.Close()
+
+	go func() {
+		conn, err := net.Dial("tcp", tcpListener.Addr().String())
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		conn.Write([]byte("PROXY TCP4 1.2.3.4 5.6.7.8 1234 9735\r\n" +
+			"payload"))
+	}()
+
+	conn, err := listener.Accept()
+	if err != nil {
+		t.Fatalf("unable to accept connection: %v", err)
+	}
+	defer conn.Close()
+
+	err = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	if err != nil {
+		t.Fatalf("unable to set read deadline: %v", err)
+	}
+
+	if conn.RemoteAddr().String() != "1.2.3.4:1234" {
+		t.Fatalf("expected remote address 1.2.3.4:1234, got %v",
+			conn.RemoteAddr())
+	}
+
+	payload, err := ioutil.ReadAll(conn)
+	if err != nil {
+		t.Fatalf("unable to read payload: %v", err)
+	}
+	if string(payload) != "payload" {
+		t.Fatalf("expected payload %q, got %q", "payload", payload)
+	}
+}
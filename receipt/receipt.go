@@ -0,0 +1,308 @@
+// Package receipt implements signed payment receipts. A receipt ties the
+// preimage of a settled payment to the payment request it paid and to a
+// summary of how it was paid, under the signature of the paying node, so that
+// the settlement can be proven to third parties.
+package receipt
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/chaincfg/v2"
+	"github.com/decred/dcrd/dcrec/secp256k1/v2"
+	"github.com/decred/dcrlnd/lntypes"
+	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/routing/route"
+	"github.com/decred/dcrlnd/tlv"
+	"github.com/decred/dcrlnd/zpay32"
+)
+
+const (
+	paymentHashType    tlv.Type = 0
+	preimageType       tlv.Type = 2
+	amountType         tlv.Type = 4
+	feeType            tlv.Type = 6
+	destinationType    tlv.Type = 8
+	numHTLCsType       tlv.Type = 10
+	settleTimeType     tlv.Type = 12
+	payerType          tlv.Type = 14
+	paymentRequestType tlv.Type = 16
+
+	// signatureType is the type of the signature record. It is the last
+	// record of an encoded receipt, so the signed serialization of a
+	// receipt is a prefix of its full serialization.
+	signatureType tlv.Type = 240
+)
+
+var (
+	// signedReceiptPrefix is prepended to the serialized receipt before
+	// it is signed. This prevents the signature of a receipt from being
+	// mistaken for the signature of any other message, such as those
+	// produced by SignMessage.
+	signedReceiptPrefix = []byte("Lightning Payment Receipt:")
+
+	// ErrNotSigned is returned when a receipt without a signature is
+	// encoded or verified.
+	ErrNotSigned = errors.New("receipt isn't signed")
+
+	// ErrInvalidSignature is returned when the signature of a receipt
+	// doesn't commit to its content or wasn't made by its payer.
+	ErrInvalidSignature = errors.New("invalid receipt signature")
+
+	// ErrPreimageMismatch is returned when the preimage of a receipt
+	// doesn't hash to its payment hash.
+	ErrPreimageMismatch = errors.New("preimage doesn't match payment hash")
+
+	// ErrPaymentRequestMismatch is returned when the payment request of a
+	// receipt doesn't describe the payment the receipt is for.
+	ErrPaymentRequestMismatch = errors.New("payment request doesn't " +
+		"match receipt")
+)
+
+// Signer produces pubkey recoverable signatures with the key of the node.
+type Signer interface {
+	// SignCompact signs the hash of the passed message, such that the
+	// public key of the signer can be recovered from the signature.
+	SignCompact(msg []byte) ([]byte, error)
+}
+
+// Receipt is a proof that a payment was settled. It is only valid once signed
+// by the node that made the payment.
+type Receipt struct {
+	// PaymentHash is the hash the payment paid to.
+	PaymentHash lntypes.Hash
+
+	// Preimage is the preimage released by the destination in exchange
+	// for the payment.
+	Preimage lntypes.Preimage
+
+	// Amount is the amount delivered to the destination, fees excluded.
+	Amount lnwire.MilliAtom
+
+	// Fee is the total fee paid to the intermediate nodes of the routes.
+	Fee lnwire.MilliAtom
+
+	// Destination is the public key of the node that was paid.
+	Destination route.Vertex
+
+	// NumHTLCs is the number of htlcs the payment settled through.
+	NumHTLCs uint32
+
+	// SettleTime is the time at which the payment was settled. It is
+	// encoded with a precision of a second.
+	SettleTime time.Time
+
+	// Payer is the public key of the node that made the payment and signs
+	// the receipt.
+	Payer route.Vertex
+
+	// PaymentRequest is the payment request that was paid. It is empty
+	// for payments made without a payment request.
+	PaymentRequest string
+
+	// Signature is the pubkey recoverable signature of the payer over the
+	// receipt.
+	Signature []byte
+}
+
+// Sign signs the receipt with the passed signer, which is expected to hold the
+// key of the payer.
+func (r *Receipt) Sign(signer Signer) error {
+	msg, err := r.signedMsg()
+	if err != nil {
+		return err
+	}
+
+	sig, err := signer.SignCompact(msg)
+	if err != nil {
+		return err
+	}
+	r.Signature = sig
+
+	return nil
+}
+
+// Verify checks that the receipt is signed by its payer and that its content
+// is consistent: the preimage must hash to the payment hash and, if the
+// receipt holds a payment request, the request must be valid for the passed
+// network and describe the paid amount, destination and payment hash.
+func (r *Receipt) Verify(net *chaincfg.Params) error {
+	if len(r.Signature) == 0 {
+		return ErrNotSigned
+	}
+
+	if r.Preimage.Hash() != r.PaymentHash {
+		return ErrPreimageMismatch
+	}
+
+	msg, err := r.signedMsg()
+	if err != nil {
+		return err
+	}
+
+	// RecoverCompact both recovers the pubkey and validates the signature.
+	pubKey, _, err := secp256k1.RecoverCompact(
+		r.Signature, chainhash.HashB(msg),
+	)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	if !bytes.Equal(pubKey.SerializeCompressed(), r.Payer[:]) {
+		return ErrInvalidSignature
+	}
+
+	if r.PaymentRequest == "" {
+		return nil
+	}
+
+	payReq, err := zpay32.Decode(r.PaymentRequest, net)
+	if err != nil {
+		return fmt.Errorf("invalid payment request: %v", err)
+	}
+
+	switch {
+	case payReq.PaymentHash == nil ||
+		*payReq.PaymentHash != [32]byte(r.PaymentHash):
+
+		return ErrPaymentRequestMismatch
+
+	case !bytes.Equal(
+		payReq.Destination.SerializeCompressed(), r.Destination[:],
+	):
+		return ErrPaymentRequestMismatch
+
+	// The destination may accept more than requested, but never less.
+	case payReq.MilliAt != nil && r.Amount < *payReq.MilliAt:
+		return ErrPaymentRequestMismatch
+	}
+
+	return nil
+}
+
+// Encode serializes the signed receipt to the passed writer.
+func (r *Receipt) Encode(w io.Writer) error {
+	if len(r.Signature) == 0 {
+		return ErrNotSigned
+	}
+
+	return r.encode(w, true)
+}
+
+// signedMsg returns the message signed by the payer of the receipt.
+func (r *Receipt) signedMsg() ([]byte, error) {
+	var b bytes.Buffer
+	b.Write(signedReceiptPrefix)
+	if err := r.encode(&b, false); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// encode serializes the receipt as a tlv stream, including its signature if
+// requested.
+func (r *Receipt) encode(w io.Writer, withSig bool) error {
+	var (
+		paymentHash = [32]byte(r.PaymentHash)
+		preimage    = [32]byte(r.Preimage)
+		amt         = uint64(r.Amount)
+		fee         = uint64(r.Fee)
+		destination = [33]byte(r.Destination)
+		numHTLCs    = r.NumHTLCs
+		settleTime  = uint64(r.SettleTime.Unix())
+		payer       = [33]byte(r.Payer)
+	)
+
+	records := []tlv.Record{
+		tlv.MakePrimitiveRecord(paymentHashType, &paymentHash),
+		tlv.MakePrimitiveRecord(preimageType, &preimage),
+		tlv.MakePrimitiveRecord(amountType, &amt),
+		tlv.MakePrimitiveRecord(feeType, &fee),
+		tlv.MakePrimitiveRecord(destinationType, &destination),
+		tlv.MakePrimitiveRecord(numHTLCsType, &numHTLCs),
+		tlv.MakePrimitiveRecord(settleTimeType, &settleTime),
+		tlv.MakePrimitiveRecord(payerType, &payer),
+	}
+
+	if r.PaymentRequest != "" {
+		payReq := []byte(r.PaymentRequest)
+		records = append(records, tlv.MakePrimitiveRecord(
+			paymentRequestType, &payReq,
+		))
+	}
+
+	if withSig {
+		sig := r.Signature
+		records = append(records, tlv.MakePrimitiveRecord(
+			signatureType, &sig,
+		))
+	}
+
+	stream, err := tlv.NewStream(records...)
+	if err != nil {
+		return err
+	}
+
+	return stream.Encode(w)
+}
+
+// Decode deserializes a signed receipt from the passed reader. The receipt
+// isn't verified.
+func Decode(r io.Reader) (*Receipt, error) {
+	var (
+		paymentHash, preimage [32]byte
+		amt, fee, settleTime  uint64
+		destination, payer    [33]byte
+		numHTLCs              uint32
+		payReq, sig           []byte
+	)
+
+	stream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(paymentHashType, &paymentHash),
+		tlv.MakePrimitiveRecord(preimageType, &preimage),
+		tlv.MakePrimitiveRecord(amountType, &amt),
+		tlv.MakePrimitiveRecord(feeType, &fee),
+		tlv.MakePrimitiveRecord(destinationType, &destination),
+		tlv.MakePrimitiveRecord(numHTLCsType, &numHTLCs),
+		tlv.MakePrimitiveRecord(settleTimeType, &settleTime),
+		tlv.MakePrimitiveRecord(payerType, &payer),
+		tlv.MakePrimitiveRecord(paymentRequestType, &payReq),
+		tlv.MakePrimitiveRecord(signatureType, &sig),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedTypes, err := stream.DecodeWithParsedTypes(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// All the records but the payment request are mandatory.
+	for _, typ := range []tlv.Type{
+		paymentHashType, preimageType, amountType, feeType,
+		destinationType, numHTLCsType, settleTimeType, payerType,
+		signatureType,
+	} {
+		if _, ok := parsedTypes[typ]; !ok {
+			return nil, fmt.Errorf("receipt record %d missing", typ)
+		}
+	}
+
+	return &Receipt{
+		PaymentHash:    paymentHash,
+		Preimage:       preimage,
+		Amount:         lnwire.MilliAtom(amt),
+		Fee:            lnwire.MilliAtom(fee),
+		Destination:    destination,
+		NumHTLCs:       numHTLCs,
+		SettleTime:     time.Unix(int64(settleTime), 0),
+		Payer:          payer,
+		PaymentRequest: string(payReq),
+		Signature:      sig,
+	}, nil
+}
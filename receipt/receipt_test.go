@@ -0,0 +1,160 @@
+package receipt
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/v2"
+	"github.com/decred/dcrd/dcrec/secp256k1/v2"
+	"github.com/decred/dcrlnd/lntypes"
+	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/netann"
+	"github.com/decred/dcrlnd/routing/route"
+	"github.com/decred/dcrlnd/zpay32"
+)
+
+var testNet = chaincfg.RegNetParams()
+
+// newTestReceipt returns an unsigned receipt for a payment made by the node
+// with the passed key, to an invoice for the passed amount.
+func newTestReceipt(t *testing.T, payerKey *secp256k1.PrivateKey,
+	amt lnwire.MilliAtom) *Receipt {
+
+	destKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+
+	preimage := lntypes.Preimage{1, 2, 3}
+	paymentHash := preimage.Hash()
+
+	invoice, err := zpay32.NewInvoice(
+		testNet, paymentHash, time.Now(), zpay32.Amount(amt),
+		zpay32.Description("receipt test"),
+	)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	payReq, err := invoice.Encode(zpay32.MessageSigner{
+		SignCompact: netann.NewNodeSigner(destKey).SignDigestCompact,
+	})
+	if err != nil {
+		t.Fatalf("unable to encode invoice: %v", err)
+	}
+
+	return &Receipt{
+		PaymentHash:    paymentHash,
+		Preimage:       preimage,
+		Amount:         amt,
+		Fee:            12,
+		Destination:    route.NewVertex(destKey.PubKey()),
+		NumHTLCs:       2,
+		SettleTime:     time.Unix(1600000000, 0),
+		Payer:          route.NewVertex(payerKey.PubKey()),
+		PaymentRequest: payReq,
+	}
+}
+
+// TestReceiptSignVerify asserts that a signed receipt survives a round trip
+// through its serialization and is then still valid, while tampering with its
+// content or signing it with another key invalidates it.
+func TestReceiptSignVerify(t *testing.T) {
+	t.Parallel()
+
+	payerKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	signer := netann.NewNodeSigner(payerKey)
+
+	r := newTestReceipt(t, payerKey, 1000)
+
+	// An unsigned receipt can neither be verified nor exported.
+	if err := r.Verify(testNet); err != ErrNotSigned {
+		t.Fatalf("expected ErrNotSigned, got %v", err)
+	}
+	var b bytes.Buffer
+	if err := r.Encode(&b); err != ErrNotSigned {
+		t.Fatalf("expected ErrNotSigned, got %v", err)
+	}
+
+	if err := r.Sign(signer); err != nil {
+		t.Fatalf("unable to sign receipt: %v", err)
+	}
+	if err := r.Encode(&b); err != nil {
+		t.Fatalf("unable to encode receipt: %v", err)
+	}
+
+	decoded, err := Decode(&b)
+	if err != nil {
+		t.Fatalf("unable to decode receipt: %v", err)
+	}
+	if !reflect.DeepEqual(decoded, r) {
+		t.Fatalf("decoded receipt doesn't match: expected %v, got %v",
+			r, decoded)
+	}
+	if err := decoded.Verify(testNet); err != nil {
+		t.Fatalf("unable to verify receipt: %v", err)
+	}
+
+	// Claiming a different fee invalidates the signature.
+	decoded.Fee++
+	if err := decoded.Verify(testNet); err != ErrInvalidSignature {
+		t.Fatalf("expected ErrInvalidSignature, got %v", err)
+	}
+	decoded.Fee--
+
+	// So does a receipt signed by another node than its payer.
+	otherKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	if err := decoded.Sign(netann.NewNodeSigner(otherKey)); err != nil {
+		t.Fatalf("unable to sign receipt: %v", err)
+	}
+	if err := decoded.Verify(testNet); err != ErrInvalidSignature {
+		t.Fatalf("expected ErrInvalidSignature, got %v", err)
+	}
+
+	// A preimage that doesn't match the payment hash is rejected.
+	decoded.Preimage[0] ^= 1
+	if err := decoded.Sign(signer); err != nil {
+		t.Fatalf("unable to sign receipt: %v", err)
+	}
+	if err := decoded.Verify(testNet); err != ErrPreimageMismatch {
+		t.Fatalf("expected ErrPreimageMismatch, got %v", err)
+	}
+}
+
+// TestReceiptPaymentRequestMismatch asserts that a receipt for less than the
+// amount of its payment request is rejected, even if properly signed.
+func TestReceiptPaymentRequestMismatch(t *testing.T) {
+	t.Parallel()
+
+	payerKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	signer := netann.NewNodeSigner(payerKey)
+
+	r := newTestReceipt(t, payerKey, 1000)
+	r.Amount = 999
+	if err := r.Sign(signer); err != nil {
+		t.Fatalf("unable to sign receipt: %v", err)
+	}
+	if err := r.Verify(testNet); err != ErrPaymentRequestMismatch {
+		t.Fatalf("expected ErrPaymentRequestMismatch, got %v", err)
+	}
+
+	// Payments made without a payment request are only checked against
+	// their preimage and signature.
+	r.PaymentRequest = ""
+	if err := r.Sign(signer); err != nil {
+		t.Fatalf("unable to sign receipt: %v", err)
+	}
+	if err := r.Verify(testNet); err != nil {
+		t.Fatalf("unable to verify receipt: %v", err)
+	}
+}
@@ -0,0 +1,140 @@
+package record
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/decred/dcrlnd/lntypes"
+	"github.com/decred/dcrlnd/tlv"
+)
+
+// AMPOnionType is the type used in the onion to reference the AMP fields:
+// invoice_hash, root_share, set_id and child_index.
+const AMPOnionType tlv.Type = 14
+
+// ampRecordSize is the size of the encoded AMP record: three 32-byte values
+// followed by a 4-byte child index.
+const ampRecordSize = 32 + 32 + 32 + 4
+
+// AMP is a record that encodes the fields necessary for atomic multi-path
+// style payments to a reusable invoice. Each payment attempt carries a child
+// index from which a distinct preimage is derived using the root share, so
+// that the same invoice can be paid any number of times without the receiver
+// having to hand out a new payment hash for every payment.
+type AMP struct {
+	invoiceHash lntypes.Hash
+	rootShare   [32]byte
+	setID       [32]byte
+	childIndex  uint32
+}
+
+// NewAMP generates a new AMP record with the given invoice hash, root share,
+// set id and child index.
+func NewAMP(invoiceHash lntypes.Hash, rootShare, setID [32]byte,
+	childIndex uint32) *AMP {
+
+	return &AMP{
+		invoiceHash: invoiceHash,
+		rootShare:   rootShare,
+		setID:       setID,
+		childIndex:  childIndex,
+	}
+}
+
+// InvoiceHash returns the payment hash of the reusable invoice being paid. It
+// identifies the invoice, as the htlc itself pays to the hash of the child
+// preimage.
+func (a *AMP) InvoiceHash() lntypes.Hash {
+	return a.invoiceHash
+}
+
+// RootShare returns the root share contained in the AMP record.
+func (a *AMP) RootShare() [32]byte {
+	return a.rootShare
+}
+
+// SetID returns the set id contained in the AMP record. All attempts of a
+// single payment to the invoice share the same set id.
+func (a *AMP) SetID() [32]byte {
+	return a.setID
+}
+
+// ChildIndex returns the child index contained in the AMP record.
+func (a *AMP) ChildIndex() uint32 {
+	return a.childIndex
+}
+
+// ChildPreimage returns the preimage of the attempt carrying this record,
+// derived as sha256(root_share || child_index).
+func (a *AMP) ChildPreimage() lntypes.Preimage {
+	var index [4]byte
+	binary.BigEndian.PutUint32(index[:], a.childIndex)
+
+	h := sha256.New()
+	h.Write(a.rootShare[:])
+	h.Write(index[:])
+
+	var preimage lntypes.Preimage
+	copy(preimage[:], h.Sum(nil))
+
+	return preimage
+}
+
+// ChildHash returns the payment hash of the attempt carrying this record.
+func (a *AMP) ChildHash() lntypes.Hash {
+	return a.ChildPreimage().Hash()
+}
+
+// AMPEncoder writes the AMP record to the provided io.Writer.
+func AMPEncoder(w io.Writer, val interface{}, buf *[8]byte) error {
+	if v, ok := val.(*AMP); ok {
+		if _, err := w.Write(v.invoiceHash[:]); err != nil {
+			return err
+		}
+		if _, err := w.Write(v.rootShare[:]); err != nil {
+			return err
+		}
+		if _, err := w.Write(v.setID[:]); err != nil {
+			return err
+		}
+
+		return tlv.EUint32T(w, v.childIndex, buf)
+	}
+
+	return tlv.NewTypeForEncodingErr(val, "AMP")
+}
+
+// AMPDecoder reads the AMP record from the provided io.Reader.
+func AMPDecoder(r io.Reader, val interface{}, buf *[8]byte, l uint64) error {
+	if v, ok := val.(*AMP); ok && l == ampRecordSize {
+		if _, err := io.ReadFull(r, v.invoiceHash[:]); err != nil {
+			return err
+		}
+		if _, err := io.ReadFull(r, v.rootShare[:]); err != nil {
+			return err
+		}
+		if _, err := io.ReadFull(r, v.setID[:]); err != nil {
+			return err
+		}
+
+		return tlv.DUint32(r, &v.childIndex, buf, 4)
+	}
+
+	return tlv.NewTypeForDecodingErr(val, "AMP", l, ampRecordSize)
+}
+
+// Record returns a tlv.Record that can be used to encode or decode this
+// record.
+func (a *AMP) Record() tlv.Record {
+	return tlv.MakeStaticRecord(
+		AMPOnionType, a, ampRecordSize, AMPEncoder, AMPDecoder,
+	)
+}
+
+// String returns a human-readable description of the AMP record.
+func (a *AMP) String() string {
+	return fmt.Sprintf("amp(invoice_hash=%v, set_id=%x, child_index=%d)",
+		a.invoiceHash, a.setID[:], a.childIndex)
+}
@@ -16,6 +16,11 @@ const (
 	// NextHopOnionType is the type used in the onion to reference the ID
 	// of the next hop.
 	NextHopOnionType tlv.Type = 6
+
+	// KeySendType is the custom record type used in the onion of a
+	// spontaneous keysend payment to pass the preimage of the payment to
+	// the final hop.
+	KeySendType tlv.Type = 5482373484
 )
 
 // NewAmtToFwdRecord creates a tlv.Record that encodes the amount_to_forward
@@ -45,3 +50,9 @@ func NewLockTimeRecord(lockTime *uint32) tlv.Record {
 func NewNextHopIDRecord(cid *uint64) tlv.Record {
 	return tlv.MakePrimitiveRecord(NextHopOnionType, cid)
 }
+
+// NewKeySendRecord creates a tlv.Record that encodes the keysend preimage
+// (type 5482373484) for an onion payload.
+func NewKeySendRecord(preimage *[32]byte) tlv.Record {
+	return tlv.MakePrimitiveRecord(KeySendType, preimage)
+}
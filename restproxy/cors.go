@@ -0,0 +1,64 @@
+package restproxy
+
+import (
+	"net/http"
+)
+
+// WithCORS wraps the given handler to allow cross-origin requests from the
+// given origins, "*" allowing any origin. WebSocket connections opened by
+// browsers are only accepted from these origins, since they aren't subject to
+// the same-origin policy.
+func WithCORS(handler http.Handler, origins []string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		if !originAllowed(origin, origins) {
+			if IsWebSocket(r) {
+				http.Error(w, "origin not allowed",
+					http.StatusForbidden)
+				return
+			}
+
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+		w.Header().Add("Vary", "Origin")
+
+		// Answer the preflight requests sent by browsers before
+		// requests with custom headers, such as the macaroon.
+		if r.Method == http.MethodOptions &&
+			r.Header.Get("Access-Control-Request-Method") != "" {
+
+			w.Header().Set(
+				"Access-Control-Allow-Methods",
+				"GET, POST, DELETE, OPTIONS",
+			)
+			w.Header().Set(
+				"Access-Control-Allow-Headers",
+				r.Header.Get("Access-Control-Request-Headers"),
+			)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// originAllowed returns true if the given origin is one of the allowed
+// origins.
+func originAllowed(origin string, allowed []string) bool {
+	for _, a := range allowed {
+		if a == "*" || a == origin {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,597 @@
+// Package restproxy implements a REST gateway for all the gRPC services served
+// by the daemon, the sub-servers included. Contrary to the gateway generated
+// from the HTTP annotations of rpc.proto, it doesn't need any code to be
+// generated per method: the methods and their messages are resolved from the
+// descriptors of the services registered with the gRPC server.
+//
+// Each method is served at PathPrefix followed by its full gRPC name, as in
+// /v2/routerrpc.Router/SendPayment, and takes its request as a JSON object in
+// the body of a POST. The responses of the methods streaming responses are
+// written as a sequence of JSON objects, each holding either a result or an
+// error. All the methods can also be called over a WebSocket, which is the
+// only way to call the methods streaming requests: each message received over
+// the WebSocket is a request, and each message sent is a result or an error.
+package restproxy
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"golang.org/x/net/websocket"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// PathPrefix is the prefix of the paths of the methods served by the
+	// gateway.
+	PathPrefix = "/v2/"
+
+	// maxRequestSize is the maximum size of a request, which matches the
+	// default maximum size of the messages received by a gRPC server.
+	maxRequestSize = 4 * 1024 * 1024
+
+	// protocolMetadataSep separates the key from the value of the metadata
+	// passed as a WebSocket subprotocol.
+	protocolMetadataSep = "+"
+)
+
+// method describes a gRPC method served by the gateway.
+type method struct {
+	// fullName is the full gRPC name of the method, as in
+	// /routerrpc.Router/SendPayment.
+	fullName string
+
+	// reqType and respType are the types of the request and response
+	// messages of the method.
+	reqType  reflect.Type
+	respType reflect.Type
+
+	// clientStream and serverStream are true if the method streams its
+	// requests and responses, respectively.
+	clientStream bool
+	serverStream bool
+}
+
+// newRequest returns a new, empty request message of the method.
+func (m *method) newRequest() proto.Message {
+	return reflect.New(m.reqType.Elem()).Interface().(proto.Message)
+}
+
+// newResponse returns a new, empty response message of the method.
+func (m *method) newResponse() proto.Message {
+	return reflect.New(m.respType.Elem()).Interface().(proto.Message)
+}
+
+// streamDesc returns the description of the stream used to call the method.
+func (m *method) streamDesc() *grpc.StreamDesc {
+	return &grpc.StreamDesc{
+		ServerStreams: m.serverStream,
+		ClientStreams: m.clientStream,
+	}
+}
+
+// Gateway serves the methods of a set of gRPC services over HTTP and
+// WebSocket, by calling them through a client connection to their server.
+type Gateway struct {
+	conn *grpc.ClientConn
+
+	// methods maps the paths of the methods, without PathPrefix, to their
+	// description.
+	methods map[string]*method
+
+	marshaler   jsonpb.Marshaler
+	unmarshaler jsonpb.Unmarshaler
+
+	wsServer websocket.Server
+}
+
+// New creates a gateway to the given services, as returned by the
+// GetServiceInfo method of their server, through the given client connection
+// to the server.
+func New(conn *grpc.ClientConn,
+	services map[string]grpc.ServiceInfo) (*Gateway, error) {
+
+	g := &Gateway{
+		conn:    conn,
+		methods: make(map[string]*method),
+		marshaler: jsonpb.Marshaler{
+			OrigName: true,
+		},
+	}
+	g.wsServer = websocket.Server{
+		Handshake: g.handshake,
+		Handler:   g.serveWebSocket,
+	}
+
+	files := make(map[string]*descriptor.FileDescriptorProto)
+	for serviceName, info := range services {
+		fileName, ok := info.Metadata.(string)
+		if !ok {
+			return nil, fmt.Errorf("unknown proto file of service %v",
+				serviceName)
+		}
+
+		file, ok := files[fileName]
+		if !ok {
+			var err error
+			file, err = fileDescriptor(fileName)
+			if err != nil {
+				return nil, err
+			}
+			files[fileName] = file
+		}
+
+		err := g.addService(serviceName, info, file)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return g, nil
+}
+
+// addService adds the methods of the given service, described in the given
+// file, to the gateway. Every method registered with the server must be found
+// in the descriptor of the service, as it would otherwise silently not be
+// served.
+func (g *Gateway) addService(serviceName string, info grpc.ServiceInfo,
+	file *descriptor.FileDescriptorProto) error {
+
+	for _, service := range file.GetService() {
+		if file.GetPackage()+"."+service.GetName() != serviceName {
+			continue
+		}
+
+		described := make(map[string]struct{})
+		for _, desc := range service.GetMethod() {
+			described[desc.GetName()] = struct{}{}
+		}
+		for _, m := range info.Methods {
+			if _, ok := described[m.Name]; !ok {
+				return fmt.Errorf("method %v of service %v not "+
+					"found in the descriptor of %v", m.Name,
+					serviceName, file.GetName())
+			}
+		}
+
+		for _, desc := range service.GetMethod() {
+			reqType, err := messageType(desc.GetInputType())
+			if err != nil {
+				return err
+			}
+			respType, err := messageType(desc.GetOutputType())
+			if err != nil {
+				return err
+			}
+
+			path := serviceName + "/" + desc.GetName()
+			g.methods[path] = &method{
+				fullName:     "/" + path,
+				reqType:      reqType,
+				respType:     respType,
+				clientStream: desc.GetClientStreaming(),
+				serverStream: desc.GetServerStreaming(),
+			}
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("service %v not found in %v", serviceName,
+		file.GetName())
+}
+
+// fileDescriptor returns the descriptor of the given registered proto file.
+func fileDescriptor(name string) (*descriptor.FileDescriptorProto, error) {
+	gz := proto.FileDescriptor(name)
+	if gz == nil {
+		return nil, fmt.Errorf("proto file %v not registered", name)
+	}
+
+	r, err := gzip.NewReader(bytes.NewReader(gz))
+	if err != nil {
+		return nil, err
+	}
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	file := &descriptor.FileDescriptorProto{}
+	if err := proto.Unmarshal(b, file); err != nil {
+		return nil, err
+	}
+
+	return file, nil
+}
+
+// messageType returns the type of the registered message with the given fully
+// qualified name, as found in a method descriptor.
+func messageType(name string) (reflect.Type, error) {
+	t := proto.MessageType(strings.TrimPrefix(name, "."))
+	if t == nil {
+		return nil, fmt.Errorf("message type %v not registered", name)
+	}
+
+	return t, nil
+}
+
+// ServeHTTP serves the method at the path of the request, over a WebSocket if
+// the request is an upgrade to one.
+//
+// NOTE: Part of the http.Handler interface.
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m, ok := g.lookupMethod(r)
+	switch {
+	case !ok:
+		writeError(w, status.Errorf(
+			codes.Unimplemented, "unknown method %v", r.URL.Path,
+		))
+
+	case IsWebSocket(r):
+		g.wsServer.ServeHTTP(w, r)
+
+	case m.clientStream:
+		writeError(w, status.Errorf(codes.InvalidArgument, "method "+
+			"%v streams requests and can only be called over a "+
+			"WebSocket", m.fullName))
+
+	case r.Method != http.MethodPost && r.Method != http.MethodGet:
+		writeError(w, status.Errorf(codes.InvalidArgument, "method "+
+			"%v must be called with POST", m.fullName))
+
+	case m.serverStream:
+		g.serveServerStream(w, r, m)
+
+	default:
+		g.serveUnary(w, r, m)
+	}
+}
+
+// lookupMethod returns the method at the path of the request, if any.
+func (g *Gateway) lookupMethod(r *http.Request) (*method, bool) {
+	if !strings.HasPrefix(r.URL.Path, PathPrefix) {
+		return nil, false
+	}
+
+	m, ok := g.methods[strings.TrimPrefix(r.URL.Path, PathPrefix)]
+	return m, ok
+}
+
+// serveUnary calls a method that neither streams requests nor responses with
+// the request in the body of the HTTP request.
+func (g *Gateway) serveUnary(w http.ResponseWriter, r *http.Request,
+	m *method) {
+
+	req, err := g.readRequest(r.Body, m)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	resp := m.newResponse()
+	ctx := outgoingContext(r.Context(), r)
+	if err := g.conn.Invoke(ctx, m.fullName, req, resp); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := g.marshaler.Marshal(w, resp); err != nil {
+		log.Debugf("Unable to write response of %v: %v", m.fullName,
+			err)
+	}
+}
+
+// serveServerStream calls a method streaming responses with the request in
+// the body of the HTTP request, and writes each response, or the error ending
+// the stream, on its own line of the HTTP response.
+func (g *Gateway) serveServerStream(w http.ResponseWriter, r *http.Request,
+	m *method) {
+
+	req, err := g.readRequest(r.Body, m)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(outgoingContext(r.Context(), r))
+	defer cancel()
+
+	stream, err := g.conn.NewStream(ctx, m.streamDesc(), m.fullName)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if err := stream.SendMsg(req); err != nil {
+		writeError(w, err)
+		return
+	}
+	if err := stream.CloseSend(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	flusher, _ := w.(http.Flusher)
+	for {
+		resp := m.newResponse()
+		err := stream.RecvMsg(resp)
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			resp = nil
+		}
+
+		msg, encErr := g.encodeStreamMsg(resp, err)
+		if encErr != nil {
+			log.Debugf("Unable to encode response of %v: %v",
+				m.fullName, encErr)
+			return
+		}
+		if _, err := w.Write(append(msg, '\n')); err != nil {
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+
+		if err != nil {
+			return
+		}
+	}
+}
+
+// handshake accepts the WebSocket subprotocols requested by the client. The
+// first one is selected, as browsers fail the connection if none is.
+func (g *Gateway) handshake(cfg *websocket.Config, r *http.Request) error {
+	if len(cfg.Protocol) > 0 {
+		cfg.Protocol = cfg.Protocol[:1]
+	}
+
+	return nil
+}
+
+// serveWebSocket calls the method at the path of the WebSocket request, with
+// each message received over the WebSocket as a request, and sends each
+// response, or the error ending the call, as a message.
+func (g *Gateway) serveWebSocket(ws *websocket.Conn) {
+	defer ws.Close()
+
+	r := ws.Request()
+	m, ok := g.lookupMethod(r)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(
+		outgoingContext(context.Background(), r),
+	)
+	defer cancel()
+
+	stream, err := g.conn.NewStream(ctx, m.streamDesc(), m.fullName)
+	if err != nil {
+		g.sendWebSocketMsg(ws, nil, err)
+		return
+	}
+
+	// Forward the requests received over the WebSocket to the stream,
+	// until the client closes the connection. Only the first request is
+	// forwarded to the methods that don't stream requests.
+	go func() {
+		defer cancel()
+
+		for i := 0; ; i++ {
+			var msg []byte
+			if err := websocket.Message.Receive(ws, &msg); err != nil {
+				return
+			}
+			if i > 0 && !m.clientStream {
+				continue
+			}
+
+			req := m.newRequest()
+			if err := g.unmarshalRequest(msg, req); err != nil {
+				g.sendWebSocketMsg(ws, nil, err)
+				return
+			}
+			if err := stream.SendMsg(req); err != nil {
+				return
+			}
+			if !m.clientStream {
+				if err := stream.CloseSend(); err != nil {
+					return
+				}
+			}
+		}
+	}()
+
+	for {
+		resp := m.newResponse()
+		err := stream.RecvMsg(resp)
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			g.sendWebSocketMsg(ws, nil, err)
+			return
+		}
+
+		if err := g.sendWebSocketMsg(ws, resp, nil); err != nil {
+			return
+		}
+
+		// The methods that don't stream responses are done after
+		// their single response.
+		if !m.serverStream {
+			return
+		}
+	}
+}
+
+// sendWebSocketMsg sends a response, or an error, over the WebSocket.
+func (g *Gateway) sendWebSocketMsg(ws *websocket.Conn, resp proto.Message,
+	rpcErr error) error {
+
+	msg, err := g.encodeStreamMsg(resp, rpcErr)
+	if err != nil {
+		return err
+	}
+
+	return websocket.Message.Send(ws, string(msg))
+}
+
+// readRequest reads the JSON request of the method from the given body. An
+// empty body is an empty request.
+func (g *Gateway) readRequest(body io.Reader, m *method) (proto.Message,
+	error) {
+
+	b, err := ioutil.ReadAll(io.LimitReader(body, maxRequestSize+1))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to "+
+			"read request: %v", err)
+	}
+	if len(b) > maxRequestSize {
+		return nil, status.Errorf(codes.InvalidArgument, "request "+
+			"exceeds %d bytes", maxRequestSize)
+	}
+
+	req := m.newRequest()
+	if err := g.unmarshalRequest(b, req); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// unmarshalRequest decodes the given JSON request, unless it is empty.
+func (g *Gateway) unmarshalRequest(b []byte, req proto.Message) error {
+	if len(bytes.TrimSpace(b)) == 0 {
+		return nil
+	}
+
+	err := g.unmarshaler.Unmarshal(bytes.NewReader(b), req)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid "+
+			"request: %v", err)
+	}
+
+	return nil
+}
+
+// errorBody is the JSON representation of an error returned by a method.
+type errorBody struct {
+	Error string `json:"error"`
+	Code  int32  `json:"code"`
+}
+
+// newErrorBody returns the JSON representation of the given error.
+func newErrorBody(err error) *errorBody {
+	s := status.Convert(err)
+	return &errorBody{
+		Error: s.Message(),
+		Code:  int32(s.Code()),
+	}
+}
+
+// encodeStreamMsg encodes a message of a stream of responses, which is either
+// {"result": <response>} or {"error": <error>} if rpcErr is set.
+func (g *Gateway) encodeStreamMsg(resp proto.Message,
+	rpcErr error) ([]byte, error) {
+
+	if rpcErr != nil {
+		return json.Marshal(struct {
+			Error *errorBody `json:"error"`
+		}{
+			Error: newErrorBody(rpcErr),
+		})
+	}
+
+	var b bytes.Buffer
+	b.WriteString(`{"result":`)
+	if err := g.marshaler.Marshal(&b, resp); err != nil {
+		return nil, err
+	}
+	b.WriteString("}")
+
+	return b.Bytes(), nil
+}
+
+// writeError writes the given error as the HTTP response, with the HTTP status
+// matching its gRPC code.
+func writeError(w http.ResponseWriter, err error) {
+	body := newErrorBody(err)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(runtime.HTTPStatusFromCode(codes.Code(body.Code)))
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.Debugf("Unable to write error: %v", err)
+	}
+}
+
+// outgoingContext returns a context carrying the gRPC metadata passed with the
+// given request. Like with the generated gateway, each header prefixed with
+// Grpc-Metadata- is passed as the metadata named after the rest of the header,
+// such as the macaroon in Grpc-Metadata-Macaroon. Since browsers can't set the
+// headers of WebSocket requests, the metadata can also be passed as a
+// subprotocol of the form Grpc-Metadata-Macaroon+<value>.
+func outgoingContext(ctx context.Context, r *http.Request) context.Context {
+	md := metadata.MD{}
+	for key, values := range r.Header {
+		if !strings.HasPrefix(key, runtime.MetadataHeaderPrefix) {
+			continue
+		}
+
+		key = strings.TrimPrefix(key, runtime.MetadataHeaderPrefix)
+		md.Append(strings.ToLower(key), values...)
+	}
+
+	if IsWebSocket(r) {
+		for _, protocols := range r.Header["Sec-Websocket-Protocol"] {
+			for _, protocol := range strings.Split(protocols, ",") {
+				addProtocolMetadata(md, strings.TrimSpace(protocol))
+			}
+		}
+	}
+
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// addProtocolMetadata adds the metadata passed as the given WebSocket
+// subprotocol, if any, to md.
+func addProtocolMetadata(md metadata.MD, protocol string) {
+	prefix := runtime.MetadataHeaderPrefix
+	if len(protocol) < len(prefix) ||
+		!strings.EqualFold(protocol[:len(prefix)], prefix) {
+
+		return
+	}
+
+	kv := strings.SplitN(protocol[len(prefix):], protocolMetadataSep, 2)
+	if len(kv) != 2 || kv[0] == "" {
+		return
+	}
+
+	md.Append(strings.ToLower(kv[0]), kv[1])
+}
+
+// IsWebSocket returns true if the request is an upgrade to a WebSocket.
+func IsWebSocket(r *http.Request) bool {
+	return strings.EqualFold(r.Header.Get("Upgrade"), "websocket")
+}
@@ -0,0 +1,357 @@
+package restproxy
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/decred/dcrlnd/lnrpc"
+	"github.com/decred/dcrlnd/lnrpc/autopilotrpc"
+	"github.com/decred/dcrlnd/lnrpc/chainrpc"
+	"github.com/decred/dcrlnd/lnrpc/invoicesrpc"
+	"github.com/decred/dcrlnd/lnrpc/routerrpc"
+	"github.com/decred/dcrlnd/lnrpc/signrpc"
+	"github.com/decred/dcrlnd/lnrpc/swaprpc"
+	"github.com/decred/dcrlnd/lnrpc/walletrpc"
+	"github.com/decred/dcrlnd/lnrpc/watchtowerrpc"
+	"github.com/decred/dcrlnd/lnrpc/wtclientrpc"
+	"github.com/golang/protobuf/jsonpb"
+	"golang.org/x/net/websocket"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testOrigin   = "https://wallet.example.com"
+	testMacaroon = "0201"
+)
+
+// mockStateServer serves the State service, requiring the test macaroon to be
+// passed as metadata.
+type mockStateServer struct{}
+
+func checkMacaroon(ctx context.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if mac := md.Get("macaroon"); len(mac) != 1 || mac[0] != testMacaroon {
+		return status.Error(codes.Unauthenticated, "missing macaroon")
+	}
+
+	return nil
+}
+
+func (s *mockStateServer) SubscribeState(_ *lnrpc.SubscribeStateRequest,
+	stream lnrpc.State_SubscribeStateServer) error {
+
+	if err := checkMacaroon(stream.Context()); err != nil {
+		return err
+	}
+
+	for _, state := range []lnrpc.DaemonState{
+		lnrpc.DaemonState_UNLOCKED, lnrpc.DaemonState_ACTIVE,
+	} {
+		err := stream.Send(&lnrpc.SubscribeStateResponse{State: state})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *mockStateServer) GetState(ctx context.Context,
+	_ *lnrpc.GetStateRequest) (*lnrpc.GetStateResponse, error) {
+
+	if err := checkMacaroon(ctx); err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.GetStateResponse{
+		State: lnrpc.DaemonState_ACTIVE,
+	}, nil
+}
+
+// newTestGateway starts a gRPC server serving the State service, and returns
+// an HTTP server serving the gateway to it.
+func newTestGateway(t *testing.T) (*httptest.Server, func()) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unable to listen: %v", err)
+	}
+
+	grpcServer := grpc.NewServer()
+	lnrpc.RegisterStateServer(grpcServer, &mockStateServer{})
+	go grpcServer.Serve(lis)
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("unable to dial: %v", err)
+	}
+
+	gateway, err := New(conn, grpcServer.GetServiceInfo())
+	if err != nil {
+		t.Fatalf("unable to create gateway: %v", err)
+	}
+	srv := httptest.NewServer(WithCORS(gateway, []string{testOrigin}))
+
+	return srv, func() {
+		srv.Close()
+		conn.Close()
+		grpcServer.Stop()
+	}
+}
+
+// TestGatewayServices asserts that a gateway can be created over all the
+// services served by the daemon, and that it serves each of their methods.
+func TestGatewayServices(t *testing.T) {
+	// The servers are never called, only their services are registered.
+	grpcServer := grpc.NewServer()
+	lnrpc.RegisterWalletUnlockerServer(
+		grpcServer, struct{ lnrpc.WalletUnlockerServer }{},
+	)
+	lnrpc.RegisterStateServer(grpcServer, &mockStateServer{})
+	lnrpc.RegisterLightningServer(
+		grpcServer, struct{ lnrpc.LightningServer }{},
+	)
+	autopilotrpc.RegisterAutopilotServer(
+		grpcServer, struct{ autopilotrpc.AutopilotServer }{},
+	)
+	chainrpc.RegisterChainNotifierServer(
+		grpcServer, struct{ chainrpc.ChainNotifierServer }{},
+	)
+	invoicesrpc.RegisterInvoicesServer(
+		grpcServer, struct{ invoicesrpc.InvoicesServer }{},
+	)
+	routerrpc.RegisterRouterServer(
+		grpcServer, struct{ routerrpc.RouterServer }{},
+	)
+	signrpc.RegisterSignerServer(
+		grpcServer, struct{ signrpc.SignerServer }{},
+	)
+	swaprpc.RegisterSwapServer(grpcServer, struct{ swaprpc.SwapServer }{})
+	walletrpc.RegisterWalletKitServer(
+		grpcServer, struct{ walletrpc.WalletKitServer }{},
+	)
+	watchtowerrpc.RegisterWatchtowerServer(
+		grpcServer, struct{ watchtowerrpc.WatchtowerServer }{},
+	)
+	wtclientrpc.RegisterWatchtowerClientServer(
+		grpcServer, struct {
+			wtclientrpc.WatchtowerClientServer
+		}{},
+	)
+
+	services := grpcServer.GetServiceInfo()
+	gateway, err := New(nil, services)
+	if err != nil {
+		t.Fatalf("unable to create gateway: %v", err)
+	}
+
+	numMethods := 0
+	for serviceName, info := range services {
+		for _, m := range info.Methods {
+			numMethods++
+
+			path := serviceName + "/" + m.Name
+			gm, ok := gateway.methods[path]
+			if !ok {
+				t.Fatalf("method %v not served", path)
+			}
+			if gm.clientStream != m.IsClientStream ||
+				gm.serverStream != m.IsServerStream {
+
+				t.Fatalf("method %v served with the wrong "+
+					"streaming", path)
+			}
+		}
+	}
+	if len(gateway.methods) != numMethods {
+		t.Fatalf("expected %d methods, got %d", numMethods,
+			len(gateway.methods))
+	}
+
+	// A method registered with the server but missing from the
+	// descriptor of its service must be refused.
+	info := services["lnrpc.State"]
+	info.Methods = append(info.Methods, grpc.MethodInfo{Name: "Unknown"})
+	_, err = New(nil, map[string]grpc.ServiceInfo{"lnrpc.State": info})
+	if err == nil {
+		t.Fatalf("expected method missing from the descriptor to be " +
+			"refused")
+	}
+}
+
+// TestGatewayUnary asserts that unary methods are served over HTTP, with the
+// metadata passed as headers.
+func TestGatewayUnary(t *testing.T) {
+	srv, cleanUp := newTestGateway(t)
+	defer cleanUp()
+
+	url := srv.URL + PathPrefix + "lnrpc.State/GetState"
+	req, err := http.NewRequest(http.MethodPost, url, strings.NewReader("{}"))
+	if err != nil {
+		t.Fatalf("unable to create request: %v", err)
+	}
+
+	// Without the macaroon, the error of the method is returned with the
+	// matching HTTP status.
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("unable to call method: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected status %v, got %v", http.StatusUnauthorized,
+			resp.StatusCode)
+	}
+
+	req, _ = http.NewRequest(http.MethodPost, url, strings.NewReader("{}"))
+	req.Header.Set("Grpc-Metadata-Macaroon", testMacaroon)
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("unable to call method: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var getResp lnrpc.GetStateResponse
+	if err := jsonpb.Unmarshal(resp.Body, &getResp); err != nil {
+		t.Fatalf("unable to decode response: %v", err)
+	}
+	if getResp.State != lnrpc.DaemonState_ACTIVE {
+		t.Fatalf("expected state %v, got %v", lnrpc.DaemonState_ACTIVE,
+			getResp.State)
+	}
+
+	// Unknown methods aren't served.
+	resp, err = http.Post(
+		srv.URL+PathPrefix+"lnrpc.State/Unknown", "", nil,
+	)
+	if err != nil {
+		t.Fatalf("unable to call method: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotImplemented {
+		t.Fatalf("expected status %v, got %v",
+			http.StatusNotImplemented, resp.StatusCode)
+	}
+}
+
+// TestGatewayServerStream asserts that the responses of the methods streaming
+// them are written on their own line.
+func TestGatewayServerStream(t *testing.T) {
+	srv, cleanUp := newTestGateway(t)
+	defer cleanUp()
+
+	req, _ := http.NewRequest(
+		http.MethodPost, srv.URL+PathPrefix+"lnrpc.State/SubscribeState",
+		nil,
+	)
+	req.Header.Set("Grpc-Metadata-Macaroon", testMacaroon)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("unable to call method: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	expected := []string{
+		`{"result":{"state":"UNLOCKED"}}`,
+		`{"result":{"state":"ACTIVE"}}`,
+	}
+	if strings.Join(lines, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("expected responses %v, got %v", expected, lines)
+	}
+}
+
+// TestGatewayWebSocket asserts that methods can be called over a WebSocket
+// opened from an allowed origin, with the metadata passed as a subprotocol.
+func TestGatewayWebSocket(t *testing.T) {
+	srv, cleanUp := newTestGateway(t)
+	defer cleanUp()
+
+	url := "ws" + strings.TrimPrefix(srv.URL, "http") + PathPrefix +
+		"lnrpc.State/SubscribeState"
+
+	// WebSockets opened from other origins are refused.
+	if _, err := websocket.Dial(url, "", "https://evil.com"); err == nil {
+		t.Fatalf("expected WebSocket from unknown origin to be refused")
+	}
+
+	cfg, err := websocket.NewConfig(url, testOrigin)
+	if err != nil {
+		t.Fatalf("unable to create config: %v", err)
+	}
+	cfg.Protocol = []string{"Grpc-Metadata-Macaroon+" + testMacaroon}
+	ws, err := websocket.DialConfig(cfg)
+	if err != nil {
+		t.Fatalf("unable to open WebSocket: %v", err)
+	}
+	defer ws.Close()
+
+	if err := websocket.Message.Send(ws, "{}"); err != nil {
+		t.Fatalf("unable to send request: %v", err)
+	}
+	for _, expected := range []string{
+		`{"result":{"state":"UNLOCKED"}}`,
+		`{"result":{"state":"ACTIVE"}}`,
+	} {
+		var msg string
+		if err := websocket.Message.Receive(ws, &msg); err != nil {
+			t.Fatalf("unable to receive response: %v", err)
+		}
+		if msg != expected {
+			t.Fatalf("expected response %v, got %v", expected, msg)
+		}
+	}
+}
+
+// TestCORS asserts that cross-origin requests are only allowed from the
+// allowed origins.
+func TestCORS(t *testing.T) {
+	handler := WithCORS(http.NotFoundHandler(), []string{testOrigin})
+
+	tests := []struct {
+		name    string
+		origin  string
+		allowed bool
+	}{
+		{
+			name:    "allowed origin",
+			origin:  testOrigin,
+			allowed: true,
+		},
+		{
+			name:   "other origin",
+			origin: "https://evil.com",
+		},
+	}
+
+	for _, test := range tests {
+		req := httptest.NewRequest(http.MethodOptions, "/v1/getinfo", nil)
+		req.Header.Set("Origin", test.origin)
+		req.Header.Set("Access-Control-Request-Method", "GET")
+		req.Header.Set(
+			"Access-Control-Request-Headers", "grpc-metadata-macaroon",
+		)
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+
+		allowOrigin := w.Header().Get("Access-Control-Allow-Origin")
+		switch {
+		case test.allowed && allowOrigin != test.origin:
+			t.Fatalf("%s: origin wasn't allowed", test.name)
+		case !test.allowed && allowOrigin != "":
+			t.Fatalf("%s: origin was allowed", test.name)
+		}
+	}
+}
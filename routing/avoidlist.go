@@ -0,0 +1,261 @@
+package routing
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/decred/dcrlnd/routing/route"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	// avoidListKey is the top level bucket which houses the operator's
+	// avoid list.
+	avoidListKey = []byte("routing-avoid-list")
+
+	// avoidNodesKey is the sub-bucket of the avoid list bucket which
+	// stores the avoided nodes, keyed by their public key.
+	avoidNodesKey = []byte("nodes")
+
+	// avoidChannelsKey is the sub-bucket of the avoid list bucket which
+	// stores the avoided channels, keyed by their short channel id.
+	avoidChannelsKey = []byte("channels")
+)
+
+// AvoidList is a persistent set of nodes and channels that path finding
+// always excludes. As opposed to the penalties learned by mission control,
+// the avoid list is a policy set explicitly by the operator, for instance for
+// compliance or reliability reasons. Entries stay on the list until they are
+// explicitly removed.
+type AvoidList struct {
+	db *bolt.DB
+
+	nodes    map[route.Vertex]struct{}
+	channels map[uint64]struct{}
+
+	sync.RWMutex
+}
+
+// NewAvoidList returns a new avoid list backed by the passed database, loaded
+// with all entries that were previously persisted.
+func NewAvoidList(db *bolt.DB) (*AvoidList, error) {
+	a := &AvoidList{
+		db:       db,
+		nodes:    make(map[route.Vertex]struct{}),
+		channels: make(map[uint64]struct{}),
+	}
+
+	err := db.Update(func(tx *bolt.Tx) error {
+		avoidBucket, err := tx.CreateBucketIfNotExists(avoidListKey)
+		if err != nil {
+			return fmt.Errorf("cannot create avoid list bucket: %v",
+				err)
+		}
+
+		nodesBucket, err := avoidBucket.CreateBucketIfNotExists(
+			avoidNodesKey,
+		)
+		if err != nil {
+			return err
+		}
+		err = nodesBucket.ForEach(func(k, _ []byte) error {
+			node, err := route.NewVertexFromBytes(k)
+			if err != nil {
+				return err
+			}
+			a.nodes[node] = struct{}{}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		channelsBucket, err := avoidBucket.CreateBucketIfNotExists(
+			avoidChannelsKey,
+		)
+		if err != nil {
+			return err
+		}
+		return channelsBucket.ForEach(func(k, _ []byte) error {
+			if len(k) != 8 {
+				return fmt.Errorf("invalid channel id key "+
+					"length %v", len(k))
+			}
+			a.channels[byteOrder.Uint64(k)] = struct{}{}
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("Loaded avoid list with %v nodes and %v channels",
+		len(a.nodes), len(a.channels))
+
+	return a, nil
+}
+
+// AddNodes adds the passed nodes to the avoid list.
+func (a *AvoidList) AddNodes(nodes ...route.Vertex) error {
+	a.Lock()
+	defer a.Unlock()
+
+	err := a.db.Update(func(tx *bolt.Tx) error {
+		nodesBucket := tx.Bucket(avoidListKey).Bucket(avoidNodesKey)
+		for _, node := range nodes {
+			err := nodesBucket.Put(node[:], []byte{})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, node := range nodes {
+		a.nodes[node] = struct{}{}
+	}
+
+	return nil
+}
+
+// RemoveNodes removes the passed nodes from the avoid list.
+func (a *AvoidList) RemoveNodes(nodes ...route.Vertex) error {
+	a.Lock()
+	defer a.Unlock()
+
+	err := a.db.Update(func(tx *bolt.Tx) error {
+		nodesBucket := tx.Bucket(avoidListKey).Bucket(avoidNodesKey)
+		for _, node := range nodes {
+			if err := nodesBucket.Delete(node[:]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, node := range nodes {
+		delete(a.nodes, node)
+	}
+
+	return nil
+}
+
+// AddChannels adds the channels identified by the passed short channel ids to
+// the avoid list.
+func (a *AvoidList) AddChannels(chanIDs ...uint64) error {
+	a.Lock()
+	defer a.Unlock()
+
+	err := a.db.Update(func(tx *bolt.Tx) error {
+		channelsBucket := tx.Bucket(avoidListKey).Bucket(
+			avoidChannelsKey,
+		)
+		for _, chanID := range chanIDs {
+			var k [8]byte
+			byteOrder.PutUint64(k[:], chanID)
+
+			if err := channelsBucket.Put(k[:], []byte{}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, chanID := range chanIDs {
+		a.channels[chanID] = struct{}{}
+	}
+
+	return nil
+}
+
+// RemoveChannels removes the channels identified by the passed short channel
+// ids from the avoid list.
+func (a *AvoidList) RemoveChannels(chanIDs ...uint64) error {
+	a.Lock()
+	defer a.Unlock()
+
+	err := a.db.Update(func(tx *bolt.Tx) error {
+		channelsBucket := tx.Bucket(avoidListKey).Bucket(
+			avoidChannelsKey,
+		)
+		for _, chanID := range chanIDs {
+			var k [8]byte
+			byteOrder.PutUint64(k[:], chanID)
+
+			if err := channelsBucket.Delete(k[:]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, chanID := range chanIDs {
+		delete(a.channels, chanID)
+	}
+
+	return nil
+}
+
+// Nodes returns all nodes currently on the avoid list.
+func (a *AvoidList) Nodes() []route.Vertex {
+	a.RLock()
+	defer a.RUnlock()
+
+	nodes := make([]route.Vertex, 0, len(a.nodes))
+	for node := range a.nodes {
+		nodes = append(nodes, node)
+	}
+
+	return nodes
+}
+
+// Channels returns the short channel ids of all channels currently on the
+// avoid list.
+func (a *AvoidList) Channels() []uint64 {
+	a.RLock()
+	defer a.RUnlock()
+
+	chanIDs := make([]uint64, 0, len(a.channels))
+	for chanID := range a.channels {
+		chanIDs = append(chanIDs, chanID)
+	}
+
+	return chanIDs
+}
+
+// IsNodeAvoided returns true if the passed node is on the avoid list.
+func (a *AvoidList) IsNodeAvoided(node route.Vertex) bool {
+	a.RLock()
+	defer a.RUnlock()
+
+	_, ok := a.nodes[node]
+	return ok
+}
+
+// IsChannelAvoided returns true if the channel identified by the passed short
+// channel id is on the avoid list.
+func (a *AvoidList) IsChannelAvoided(chanID uint64) bool {
+	a.RLock()
+	defer a.RUnlock()
+
+	_, ok := a.channels[chanID]
+	return ok
+}
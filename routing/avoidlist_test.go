@@ -0,0 +1,149 @@
+package routing
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/routing/route"
+	bolt "go.etcd.io/bbolt"
+)
+
+// TestAvoidListPersistence asserts that avoid list entries survive a restart,
+// and that removed entries are no longer reported.
+func TestAvoidListPersistence(t *testing.T) {
+	t.Parallel()
+
+	file, err := ioutil.TempFile("", "*.db")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dbPath := file.Name()
+	defer os.Remove(dbPath)
+
+	db, err := bolt.Open(dbPath, 0600, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	avoidList, err := NewAvoidList(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	node1, node2 := route.Vertex{1}, route.Vertex{2}
+	if err := avoidList.AddNodes(node1, node2); err != nil {
+		t.Fatal(err)
+	}
+	if err := avoidList.AddChannels(10, 20); err != nil {
+		t.Fatal(err)
+	}
+	if err := avoidList.RemoveNodes(node1); err != nil {
+		t.Fatal(err)
+	}
+	if err := avoidList.RemoveChannels(20); err != nil {
+		t.Fatal(err)
+	}
+
+	// Reopen the database to assert that the remaining entries are
+	// loaded from disk.
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+	db, err = bolt.Open(dbPath, 0600, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	avoidList, err = NewAvoidList(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if nodes := avoidList.Nodes(); !reflect.DeepEqual(
+		nodes, []route.Vertex{node2},
+	) {
+		t.Fatalf("unexpected nodes: %v", nodes)
+	}
+	if chans := avoidList.Channels(); !reflect.DeepEqual(
+		chans, []uint64{10},
+	) {
+		t.Fatalf("unexpected channels: %v", chans)
+	}
+
+	if avoidList.IsNodeAvoided(node1) || !avoidList.IsNodeAvoided(node2) {
+		t.Fatal("unexpected node avoid state")
+	}
+	if avoidList.IsChannelAvoided(20) || !avoidList.IsChannelAvoided(10) {
+		t.Fatal("unexpected channel avoid state")
+	}
+}
+
+// TestAvoidListPathFinding asserts that path finding doesn't use nodes and
+// channels on the avoid list.
+func TestAvoidListPathFinding(t *testing.T) {
+	t.Parallel()
+
+	graph, err := parseTestGraph(basicGraphFilePath)
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+	defer graph.cleanUp()
+
+	sourceNode, err := graph.graph.SourceNode()
+	if err != nil {
+		t.Fatalf("unable to fetch source node: %v", err)
+	}
+
+	avoidList, err := NewAvoidList(graph.graph.Database().DB)
+	if err != nil {
+		t.Fatalf("unable to create avoid list: %v", err)
+	}
+	cfg := &PathFindingConfig{
+		AvoidList: avoidList,
+	}
+
+	find := func() ([]*channeldb.ChannelEdgePolicy, error) {
+		return findPath(
+			&graphParams{
+				graph: graph.graph,
+			},
+			noRestrictions, cfg, sourceNode.PubKeyBytes,
+			graph.aliasMap["sophon"],
+			lnwire.NewMAtomsFromAtoms(50000),
+		)
+	}
+
+	// Without any entries on the avoid list, the cheapest path via
+	// songoku is found.
+	path, err := find()
+	if err != nil {
+		t.Fatalf("unable to find path: %v", err)
+	}
+	assertExpectedPath(t, graph.aliasMap, path, "songoku", "sophon")
+
+	// Avoiding songoku should route the payment via phamnuwen instead.
+	if err := avoidList.AddNodes(graph.aliasMap["songoku"]); err != nil {
+		t.Fatal(err)
+	}
+	path, err = find()
+	if err != nil {
+		t.Fatalf("unable to find path: %v", err)
+	}
+	assertExpectedPath(t, graph.aliasMap, path, "phamnuwen", "sophon")
+
+	// Also avoiding the channel to phamnuwen leaves no path at all.
+	roasToPham := uint64(999991)
+	if err := avoidList.AddChannels(roasToPham); err != nil {
+		t.Fatal(err)
+	}
+	_, err = find()
+	if !IsError(err, ErrNoPathFound) {
+		t.Fatalf("expected no path, got: %v", err)
+	}
+}
@@ -0,0 +1,116 @@
+package routing
+
+import (
+	"sync"
+	"time"
+
+	"github.com/decred/dcrlnd/lnwire"
+)
+
+const (
+	// DefaultBandwidthPenaltyDuration is the default duration for which a
+	// local channel that failed to carry an htlc due to insufficient
+	// bandwidth is penalized.
+	DefaultBandwidthPenaltyDuration = 30 * time.Second
+)
+
+// bandwidthPenalty caps the bandwidth of a single local channel until it
+// expires.
+type bandwidthPenalty struct {
+	// maxBandwidth is the largest amount the channel is assumed to be able
+	// to carry while the penalty is active.
+	maxBandwidth lnwire.MilliAtom
+
+	// expiry is the time at which the penalty is lifted.
+	expiry time.Time
+}
+
+// BandwidthPenalties is a short-lived, in-memory record of our own channels
+// that recently failed to carry an htlc with a TemporaryChannelFailure. The
+// bandwidth reported by the link layer may lag behind the actual state of the
+// channel, so without these penalties immediate retries would keep selecting
+// the same exhausted channel. The penalties are applied when generating the
+// bandwidth hints used during path finding.
+type BandwidthPenalties struct {
+	// duration is the time a penalty stays active after a failure.
+	duration time.Duration
+
+	// now returns the current time. It is overridable for tests.
+	now func() time.Time
+
+	penalties map[uint64]bandwidthPenalty
+	sync.Mutex
+}
+
+// NewBandwidthPenalties returns a new set of bandwidth penalties which expire
+// after the passed duration.
+func NewBandwidthPenalties(duration time.Duration) *BandwidthPenalties {
+	return &BandwidthPenalties{
+		duration:  duration,
+		now:       time.Now,
+		penalties: make(map[uint64]bandwidthPenalty),
+	}
+}
+
+// ReportFailure records that the local channel identified by chanID was unable
+// to carry an htlc of the given amount. Until the penalty expires, the channel
+// is assumed to be able to carry strictly less than this amount.
+func (b *BandwidthPenalties) ReportFailure(chanID uint64,
+	amt lnwire.MilliAtom) {
+
+	if b == nil || amt == 0 {
+		return
+	}
+
+	b.Lock()
+	defer b.Unlock()
+
+	now := b.now()
+	maxBandwidth := amt - 1
+
+	// If there is an active penalty with a tighter bound already, we'll
+	// keep that bound but still extend its expiry.
+	if penalty, ok := b.penalties[chanID]; ok && now.Before(penalty.expiry) &&
+		penalty.maxBandwidth < maxBandwidth {
+
+		maxBandwidth = penalty.maxBandwidth
+	}
+
+	b.penalties[chanID] = bandwidthPenalty{
+		maxBandwidth: maxBandwidth,
+		expiry:       now.Add(b.duration),
+	}
+
+	log.Debugf("Penalizing local channel %v, max bandwidth %v until %v",
+		chanID, maxBandwidth, now.Add(b.duration))
+}
+
+// Apply returns the passed bandwidth of the local channel identified by chanID,
+// capped by the active penalty of the channel, if any.
+func (b *BandwidthPenalties) Apply(chanID uint64,
+	bandwidth lnwire.MilliAtom) lnwire.MilliAtom {
+
+	if b == nil {
+		return bandwidth
+	}
+
+	b.Lock()
+	defer b.Unlock()
+
+	penalty, ok := b.penalties[chanID]
+	if !ok {
+		return bandwidth
+	}
+
+	// Lazily prune the penalty once it has expired.
+	if !b.now().Before(penalty.expiry) {
+		delete(b.penalties, chanID)
+		return bandwidth
+	}
+
+	if penalty.maxBandwidth < bandwidth {
+		return penalty.maxBandwidth
+	}
+
+	return bandwidth
+}
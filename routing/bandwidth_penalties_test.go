@@ -0,0 +1,66 @@
+package routing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/decred/dcrlnd/lnwire"
+)
+
+// TestBandwidthPenalties asserts that penalties cap the bandwidth of a failed
+// local channel until they expire.
+func TestBandwidthPenalties(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	penalties := NewBandwidthPenalties(time.Minute)
+	penalties.now = func() time.Time { return now }
+
+	const (
+		chanID    = 1
+		otherChan = 2
+		bandwidth = lnwire.MilliAtom(100000)
+	)
+
+	assertBandwidth := func(chanID uint64, expected lnwire.MilliAtom) {
+		t.Helper()
+
+		got := penalties.Apply(chanID, bandwidth)
+		if got != expected {
+			t.Fatalf("expected bandwidth %v for channel %v, got %v",
+				expected, chanID, got)
+		}
+	}
+
+	// Without any failures, the bandwidth is left untouched.
+	assertBandwidth(chanID, bandwidth)
+
+	// After a failure, the channel is assumed to be unable to carry the
+	// failed amount, while other channels aren't affected.
+	penalties.ReportFailure(chanID, 50000)
+	assertBandwidth(chanID, 49999)
+	assertBandwidth(otherChan, bandwidth)
+
+	// A failure of a larger amount doesn't loosen the existing cap, while a
+	// failure of a smaller amount tightens it.
+	penalties.ReportFailure(chanID, 70000)
+	assertBandwidth(chanID, 49999)
+	penalties.ReportFailure(chanID, 20000)
+	assertBandwidth(chanID, 19999)
+
+	// A failure of an amount exceeding the reported bandwidth has no
+	// effect on the hint.
+	penalties.ReportFailure(otherChan, 200000)
+	assertBandwidth(otherChan, bandwidth)
+
+	// Once the penalty expires, the bandwidth is restored.
+	now = now.Add(time.Minute)
+	assertBandwidth(chanID, bandwidth)
+
+	// A nil set of penalties is a no-op.
+	var nilPenalties *BandwidthPenalties
+	nilPenalties.ReportFailure(chanID, 1000)
+	if got := nilPenalties.Apply(chanID, bandwidth); got != bandwidth {
+		t.Fatalf("expected bandwidth %v, got %v", bandwidth, got)
+	}
+}
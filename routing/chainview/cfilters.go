@@ -0,0 +1,454 @@
+package chainview
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/gcs"
+	"github.com/decred/dcrd/gcs/blockcf"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/channeldb"
+)
+
+const (
+	// defaultCfPollInterval is the interval at which the tip of the chain
+	// source of a CfFilteredChainView is polled for new blocks.
+	defaultCfPollInterval = 5 * time.Second
+)
+
+// CfChainSource is the view of the chain required by the
+// CfFilteredChainView. It only needs block headers, the regular committed
+// filters of blocks and the blocks themselves, so that it can be served by a
+// light backend.
+type CfChainSource interface {
+	// GetBestBlock returns the hash and height of the tip of the main
+	// chain.
+	GetBestBlock() (*chainhash.Hash, int64, error)
+
+	// GetBlockHash returns the hash of the block in the main chain at the
+	// given height.
+	GetBlockHash(blockHeight int64) (*chainhash.Hash, error)
+
+	// GetBlockHeader returns the header of the block identified by the
+	// given hash.
+	GetBlockHeader(blockHash *chainhash.Hash) (*wire.BlockHeader, error)
+
+	// GetCFilter returns the regular committed filter of the block
+	// identified by the given hash.
+	GetCFilter(blockHash *chainhash.Hash) (*gcs.Filter, error)
+
+	// GetBlock returns the block identified by the given hash.
+	GetBlock(blockHash *chainhash.Hash) (*wire.MsgBlock, error)
+}
+
+// CfFilteredChainView is an implementation of the FilteredChainView
+// interface which is backed by the committed filters of blocks. The tip of
+// the chain source is polled for new blocks, and a block is only fetched in
+// full when its filter matches one of the watched outpoints.
+type CfFilteredChainView struct {
+	started int32 // To be used atomically.
+	stopped int32 // To be used atomically.
+
+	source CfChainSource
+
+	// pollInterval is the interval at which the tip of the chain source is
+	// polled for new blocks.
+	pollInterval time.Duration
+
+	// bestHash and bestHeight identify the latest block added to the
+	// blockQueue. They are only accessed by the chainFilterer goroutine
+	// once the chain view is started.
+	bestHash   chainhash.Hash
+	bestHeight int64
+
+	// blockEventQueue is the ordered queue used to keep the order
+	// of connected and disconnected blocks sent to the reader of the
+	// chainView.
+	blockQueue *blockEventQueue
+
+	// filterUpdates is a channel in which updates to the utxo filter
+	// attached to this instance are sent over.
+	filterUpdates chan filterUpdate
+
+	// chainFilter is the set of utox's that we're currently watching
+	// spends for within the chain.
+	filterMtx   sync.RWMutex
+	chainFilter map[wire.OutPoint]struct{}
+
+	// filterBlockReqs is a channel in which requests to filter select
+	// blocks will be sent over.
+	filterBlockReqs chan *filterBlockReq
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// A compile time check to ensure CfFilteredChainView implements the
+// chainview.FilteredChainView.
+var _ FilteredChainView = (*CfFilteredChainView)(nil)
+
+// NewCfFilteredChainView creates a new instance of a FilteredChainView which
+// watches the chain through the given chain source.
+func NewCfFilteredChainView(source CfChainSource) (*CfFilteredChainView, error) {
+	return &CfFilteredChainView{
+		source:          source,
+		pollInterval:    defaultCfPollInterval,
+		blockQueue:      newBlockEventQueue(),
+		chainFilter:     make(map[wire.OutPoint]struct{}),
+		filterUpdates:   make(chan filterUpdate),
+		filterBlockReqs: make(chan *filterBlockReq),
+		quit:            make(chan struct{}),
+	}, nil
+}
+
+// Start starts all goroutines necessary for normal operation.
+//
+// NOTE: This is part of the FilteredChainView interface.
+func (c *CfFilteredChainView) Start() error {
+	// Already started?
+	if atomic.AddInt32(&c.started, 1) != 1 {
+		return nil
+	}
+
+	log.Infof("FilteredChainView starting")
+
+	bestHash, bestHeight, err := c.source.GetBestBlock()
+	if err != nil {
+		return err
+	}
+	c.bestHash = *bestHash
+	c.bestHeight = bestHeight
+
+	c.blockQueue.Start()
+
+	c.wg.Add(1)
+	go c.chainFilterer()
+
+	return nil
+}
+
+// Stop stops all goroutines which we launched by the prior call to the Start
+// method.
+//
+// NOTE: This is part of the FilteredChainView interface.
+func (c *CfFilteredChainView) Stop() error {
+	// Already shutting down?
+	if atomic.AddInt32(&c.stopped, 1) != 1 {
+		return nil
+	}
+
+	c.blockQueue.Stop()
+
+	log.Infof("FilteredChainView stopping")
+
+	close(c.quit)
+	c.wg.Wait()
+
+	return nil
+}
+
+// chainFilterer is the primary goroutine which: polls the chain source for
+// new blocks and dispatches the relevant FilteredBlock notifications, updates
+// the filter due to requests by callers, and finally is able to preform
+// targeted block filtration.
+func (c *CfFilteredChainView) chainFilterer() {
+	defer c.wg.Done()
+
+	ticker := time.NewTicker(c.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := c.syncTip(); err != nil {
+				log.Errorf("Unable to sync chain tip: %v", err)
+			}
+
+		// The caller has just sent an update to the current chain
+		// filter, so we'll apply the update, possibly rewinding our
+		// state partially.
+		case update := <-c.filterUpdates:
+			log.Tracef("Updating chain filter with new UTXO's: %v",
+				update.newUtxos)
+
+			c.filterMtx.Lock()
+			for _, newOp := range update.newUtxos {
+				c.chainFilter[newOp] = struct{}{}
+			}
+			c.filterMtx.Unlock()
+
+			// If the update height matches our best known height,
+			// then we don't need to do any rewinding.
+			if update.updateHeight >= c.bestHeight {
+				continue
+			}
+
+			// Otherwise, we'll rewind the state to ensure the
+			// caller doesn't miss any relevant notifications,
+			// rescanning one block at a time starting from the
+			// height _after_ the update height. Only the blocks
+			// spending a watched output are sent again.
+			for i := update.updateHeight + 1; i <= c.bestHeight; i++ {
+				blockHash, err := c.source.GetBlockHash(i)
+				if err != nil {
+					log.Warnf("Unable to get block hash "+
+						"for block at height %d: %v",
+						i, err)
+					continue
+				}
+
+				block, err := c.filterBlock(blockHash)
+				if err != nil {
+					log.Warnf("Unable to rescan block "+
+						"with hash %v at height %d: %v",
+						blockHash, i, err)
+					continue
+				}
+				if len(block.Transactions) == 0 {
+					continue
+				}
+
+				c.blockQueue.Add(&blockEvent{
+					eventType: connected,
+					block:     block,
+				})
+			}
+
+		// We've received a new request to manually filter a block.
+		case req := <-c.filterBlockReqs:
+			block, err := c.filterBlock(req.blockHash)
+			req.resp <- block
+			req.err <- err
+
+		case <-c.quit:
+			return
+		}
+	}
+}
+
+// syncTip brings the chain view up to date with the tip of the chain source,
+// disconnecting the blocks of our view that are no longer part of the main
+// chain before connecting the new blocks.
+func (c *CfFilteredChainView) syncTip() error {
+	tipHash, tipHeight, err := c.source.GetBestBlock()
+	if err != nil {
+		return err
+	}
+	if *tipHash == c.bestHash {
+		return nil
+	}
+
+	// Walk back our view of the chain until we find a block that's still
+	// part of the main chain.
+	for c.bestHeight > 0 {
+		if c.bestHeight <= tipHeight {
+			mainHash, err := c.source.GetBlockHash(c.bestHeight)
+			if err != nil {
+				return err
+			}
+			if *mainHash == c.bestHash {
+				break
+			}
+		}
+
+		header, err := c.source.GetBlockHeader(&c.bestHash)
+		if err != nil {
+			return err
+		}
+
+		log.Debugf("got disconnected block at height %d: %v",
+			c.bestHeight, c.bestHash)
+
+		c.blockQueue.Add(&blockEvent{
+			eventType: disconnected,
+			block: &FilteredBlock{
+				Hash:   c.bestHash,
+				Height: c.bestHeight,
+			},
+		})
+
+		c.bestHash = header.PrevBlock
+		c.bestHeight--
+	}
+
+	for c.bestHeight < tipHeight {
+		blockHash, err := c.source.GetBlockHash(c.bestHeight + 1)
+		if err != nil {
+			return err
+		}
+
+		block, err := c.filterBlock(blockHash)
+		if err != nil {
+			return err
+		}
+
+		c.bestHash = block.Hash
+		c.bestHeight = block.Height
+
+		c.blockQueue.Add(&blockEvent{
+			eventType: connected,
+			block:     block,
+		})
+	}
+
+	return nil
+}
+
+// filterBlock returns the FilteredBlock of the block identified by the given
+// hash, holding the transactions that spend any of the watched outputs. The
+// block is only fetched when its committed filter matches one of the watched
+// outputs, and the chain filter is updated by removing the spent outputs.
+func (c *CfFilteredChainView) filterBlock(blockHash *chainhash.Hash) (
+	*FilteredBlock, error) {
+
+	header, err := c.source.GetBlockHeader(blockHash)
+	if err != nil {
+		return nil, err
+	}
+	filteredBlock := &FilteredBlock{
+		Hash:   *blockHash,
+		Height: int64(header.Height),
+	}
+
+	// If the filter can't be fetched, the block is scanned in full rather
+	// than risking to miss the spend of a watched output.
+	filter, err := c.source.GetCFilter(blockHash)
+	if err != nil {
+		log.Warnf("Unable to get filter for block %v, fetching it in "+
+			"full: %v", blockHash, err)
+		filter = nil
+	}
+
+	c.filterMtx.RLock()
+	if len(c.chainFilter) == 0 {
+		c.filterMtx.RUnlock()
+		return filteredBlock, nil
+	}
+	entries := make([][]byte, 0, len(c.chainFilter))
+	for op := range c.chainFilter {
+		entries = append(entries, outPointEntry(&op))
+	}
+	c.filterMtx.RUnlock()
+
+	key := blockcf.Key(&header.MerkleRoot)
+	if filter != nil && !filter.MatchAny(key, entries) {
+		return filteredBlock, nil
+	}
+
+	block, err := c.source.GetBlock(blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	c.filterMtx.Lock()
+	defer c.filterMtx.Unlock()
+
+	for _, tx := range block.Transactions {
+		var txAlreadyFiltered bool
+		for _, txIn := range tx.TxIn {
+			prevOp := txIn.PreviousOutPoint
+			if _, ok := c.chainFilter[prevOp]; !ok {
+				continue
+			}
+
+			delete(c.chainFilter, prevOp)
+
+			// Only add this txn to our list of filtered txns if it
+			// is the first previous outpoint to cause a match.
+			if txAlreadyFiltered {
+				continue
+			}
+
+			filteredBlock.Transactions = append(
+				filteredBlock.Transactions, tx,
+			)
+			txAlreadyFiltered = true
+		}
+	}
+
+	return filteredBlock, nil
+}
+
+// outPointEntry returns the entry a regular committed filter holds for the
+// spend of the given outpoint.
+func outPointEntry(op *wire.OutPoint) []byte {
+	entry := make([]byte, chainhash.HashSize+4)
+	copy(entry, op.Hash[:])
+	binary.LittleEndian.PutUint32(entry[chainhash.HashSize:], op.Index)
+	return entry
+}
+
+// FilterBlock takes a block hash, and returns a FilteredBlocks which is the
+// result of applying the current registered UTXO sub-set on the block
+// corresponding to that block hash. If any watched UTXO's are spent by the
+// selected block, then the internal chainFilter will also be updated.
+//
+// NOTE: This is part of the FilteredChainView interface.
+func (c *CfFilteredChainView) FilterBlock(blockHash *chainhash.Hash) (
+	*FilteredBlock, error) {
+
+	req := &filterBlockReq{
+		blockHash: blockHash,
+		resp:      make(chan *FilteredBlock, 1),
+		err:       make(chan error, 1),
+	}
+
+	select {
+	case c.filterBlockReqs <- req:
+	case <-c.quit:
+		return nil, fmt.Errorf("FilteredChainView shutting down")
+	}
+
+	return <-req.resp, <-req.err
+}
+
+// UpdateFilter updates the UTXO filter which is to be consulted when creating
+// FilteredBlocks to be sent to subscribed clients. This method is cumulative
+// meaning repeated calls to this method should _expand_ the size of the UTXO
+// sub-set currently being watched.  If the set updateHeight is _lower_ than
+// the best known height of the implementation, then the state should be
+// rewound to ensure all relevant notifications are dispatched.
+//
+// NOTE: This is part of the FilteredChainView interface.
+func (c *CfFilteredChainView) UpdateFilter(ops []channeldb.EdgePoint,
+	updateHeight int64) error {
+
+	newUtxos := make([]wire.OutPoint, len(ops))
+	for i, op := range ops {
+		newUtxos[i] = op.OutPoint
+	}
+
+	select {
+	case c.filterUpdates <- filterUpdate{
+		newUtxos:     newUtxos,
+		updateHeight: updateHeight,
+	}:
+		return nil
+
+	case <-c.quit:
+		return fmt.Errorf("chain filter shutting down")
+	}
+}
+
+// FilteredBlocks returns the channel that filtered blocks are to be sent over.
+// Each time a block is connected to the end of a main chain, and appropriate
+// FilteredBlock which contains the transactions which mutate our watched UTXO
+// set is to be returned.
+//
+// NOTE: This is part of the FilteredChainView interface.
+func (c *CfFilteredChainView) FilteredBlocks() <-chan *FilteredBlock {
+	return c.blockQueue.newBlocks
+}
+
+// DisconnectedBlocks returns a receive only channel which will be sent upon
+// with the empty filtered blocks of blocks which are disconnected from the
+// main chain in the case of a re-org.
+//
+// NOTE: This is part of the FilteredChainView interface.
+func (c *CfFilteredChainView) DisconnectedBlocks() <-chan *FilteredBlock {
+	return c.blockQueue.staleBlocks
+}
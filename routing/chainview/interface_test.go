@@ -17,6 +17,7 @@ import (
 	"github.com/decred/dcrd/rpctest"
 	"github.com/decred/dcrd/txscript/v2"
 	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/chainntnfs/cfnotify"
 	"github.com/decred/dcrlnd/channeldb"
 )
 
@@ -775,6 +776,27 @@ var interfaceImpls = []struct {
 			return nil, chainView, err
 		},
 	},
+	{
+		name: "cfilters",
+		chainViewInit: func(config rpcclient.ConnConfig) (func(), FilteredChainView, error) {
+			source, err := cfnotify.NewRPCChainSource(config)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			chainView, err := NewCfFilteredChainView(source)
+			if err != nil {
+				return nil, nil, err
+			}
+			chainView.pollInterval = 100 * time.Millisecond
+
+			cleanUp := func() {
+				source.Shutdown()
+			}
+
+			return cleanUp, chainView, nil
+		},
+	},
 }
 
 func TestFilteredChainView(t *testing.T) {
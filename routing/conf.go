@@ -2,9 +2,11 @@
 
 package routing
 
-// Conf provides the command line routing configuration. There are no fields in
-// the production build so that this section is hidden by default.
-type Conf struct{}
+// Conf provides the command line routing configuration. Experimental options
+// are only available in experimental builds.
+type Conf struct {
+	StrictZombiePruning bool `long:"strictgraphpruning" description:"Prune channels from the graph as zombies as soon as either of their edges hasn't been updated in two weeks, instead of only once both of them haven't. Pruned channels are resurrected if a fresh update for them is received."`
+}
 
 // UseAssumeChannelValid always returns false when not in experimental builds.
 func (c *Conf) UseAssumeChannelValid() bool {
@@ -5,6 +5,8 @@ package routing
 // Conf exposes the experimental command line routing configurations.
 type Conf struct {
 	AssumeChannelValid bool `long:"assumechanvalid" description:"Skip checking channel spentness during graph validation. (default: false)"`
+
+	StrictZombiePruning bool `long:"strictgraphpruning" description:"Prune channels from the graph as zombies as soon as either of their edges hasn't been updated in two weeks, instead of only once both of them haven't. Pruned channels are resurrected if a fresh update for them is received."`
 }
 
 // UseAssumeChannelValid returns true if the router should skip checking for
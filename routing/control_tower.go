@@ -23,6 +23,10 @@ type ControlTower interface {
 	// RegisterAttempt atomically records the provided PaymentAttemptInfo.
 	RegisterAttempt(lntypes.Hash, *channeldb.PaymentAttemptInfo) error
 
+	// FailAttempt records the failure of the htlc attempt with the given
+	// id. The payment itself remains in flight.
+	FailAttempt(lntypes.Hash, uint64, *channeldb.HTLCFailInfo) error
+
 	// Success transitions a payment into the Succeeded state. After
 	// invoking this method, InitPayment should always return an error to
 	// prevent us from making duplicate payments to the same payment hash.
@@ -44,6 +48,75 @@ type ControlTower interface {
 	// flight and a channel that provides the final outcome of the payment.
 	SubscribePayment(paymentHash lntypes.Hash) (bool, chan PaymentResult,
 		error)
+
+	// SubscribePaymentUpdates subscribes to every change of the payment
+	// with the given hash, including the registration and resolution of
+	// each of its htlc attempts.
+	SubscribePaymentUpdates(paymentHash lntypes.Hash) (
+		*PaymentUpdateSubscription, error)
+}
+
+// PaymentUpdateSubscription delivers the state of a payment, including all of
+// its htlc attempts, every time it changes.
+type PaymentUpdateSubscription struct {
+	// Updates receives the current state of the payment right away, and
+	// again after every change. Changes that happen in quick succession
+	// may be delivered as a single update. The channel is closed once the
+	// payment has reached a terminal state, or the subscription is
+	// canceled.
+	Updates <-chan *channeldb.Payment
+
+	updates chan *channeldb.Payment
+
+	// changed is signaled whenever the payment changes.
+	changed chan struct{}
+
+	paymentHash lntypes.Hash
+	tower       *controlTower
+
+	cancelOnce sync.Once
+	quit       chan struct{}
+}
+
+// Cancel stops the delivery of updates and closes the Updates channel.
+func (s *PaymentUpdateSubscription) Cancel() {
+	s.cancelOnce.Do(func() {
+		close(s.quit)
+	})
+}
+
+// deliverUpdates sends the state of the payment each time it changes, until
+// it reaches a terminal state or the subscription is canceled.
+//
+// NOTE: This MUST be run as a goroutine.
+func (s *PaymentUpdateSubscription) deliverUpdates() {
+	defer close(s.updates)
+	defer s.tower.removeUpdateSubscriber(s)
+
+	for {
+		payment, err := s.tower.db.FetchPayment(s.paymentHash)
+		if err != nil {
+			log.Errorf("Unable to fetch payment %v for update "+
+				"subscriber: %v", s.paymentHash, err)
+			return
+		}
+
+		select {
+		case s.updates <- payment:
+		case <-s.quit:
+			return
+		}
+
+		if payment.Status != channeldb.StatusInFlight {
+			return
+		}
+
+		select {
+		case <-s.changed:
+		case <-s.quit:
+			return
+		}
+	}
 }
 
 // PaymentResult is the struct describing the events received by payment
@@ -70,7 +143,13 @@ type PaymentResult struct {
 type controlTower struct {
 	db *channeldb.PaymentControl
 
-	subscribers    map[lntypes.Hash][]chan PaymentResult
+	subscribers map[lntypes.Hash][]chan PaymentResult
+
+	// updateSubscribers holds the subscribers to all changes of a
+	// payment, by payment hash.
+	updateSubscribers map[lntypes.Hash]map[*PaymentUpdateSubscription]struct{}
+
+	// subscribersMtx guards subscribers and updateSubscribers.
 	subscribersMtx sync.Mutex
 }
 
@@ -79,6 +158,9 @@ func NewControlTower(db *channeldb.PaymentControl) ControlTower {
 	return &controlTower{
 		db:          db,
 		subscribers: make(map[lntypes.Hash][]chan PaymentResult),
+		updateSubscribers: make(
+			map[lntypes.Hash]map[*PaymentUpdateSubscription]struct{},
+		),
 	}
 }
 
@@ -97,7 +179,28 @@ func (p *controlTower) InitPayment(paymentHash lntypes.Hash,
 func (p *controlTower) RegisterAttempt(paymentHash lntypes.Hash,
 	attempt *channeldb.PaymentAttemptInfo) error {
 
-	return p.db.RegisterAttempt(paymentHash, attempt)
+	if err := p.db.RegisterAttempt(paymentHash, attempt); err != nil {
+		return err
+	}
+
+	p.notifyUpdate(paymentHash)
+
+	return nil
+}
+
+// FailAttempt records the failure of the htlc attempt with the given id. The
+// payment itself remains in flight.
+func (p *controlTower) FailAttempt(paymentHash lntypes.Hash, attemptID uint64,
+	failInfo *channeldb.HTLCFailInfo) error {
+
+	err := p.db.FailAttempt(paymentHash, attemptID, failInfo)
+	if err != nil {
+		return err
+	}
+
+	p.notifyUpdate(paymentHash)
+
+	return nil
 }
 
 // Success transitions a payment into the Succeeded state. After invoking this
@@ -116,6 +219,7 @@ func (p *controlTower) Success(paymentHash lntypes.Hash,
 	p.notifyFinalEvent(
 		paymentHash, createSuccessResult(route, preimage),
 	)
+	p.notifyUpdate(paymentHash)
 
 	return nil
 }
@@ -165,6 +269,7 @@ func (p *controlTower) Fail(paymentHash lntypes.Hash,
 	p.notifyFinalEvent(
 		paymentHash, createFailedResult(route, reason),
 	)
+	p.notifyUpdate(paymentHash)
 
 	return nil
 }
@@ -214,7 +319,7 @@ func (p *controlTower) SubscribePayment(paymentHash lntypes.Hash) (
 	// immediately.
 	case channeldb.StatusSucceeded:
 		event = *createSuccessResult(
-			&payment.Attempt.Route, *payment.PaymentPreimage,
+			&payment.LastHTLC().Route, *payment.PaymentPreimage,
 		)
 
 	// Payment already failed. It is not necessary to register as a
@@ -222,8 +327,8 @@ func (p *controlTower) SubscribePayment(paymentHash lntypes.Hash) (
 	// immediately.
 	case channeldb.StatusFailed:
 		var route *route.Route
-		if payment.Attempt != nil {
-			route = &payment.Attempt.Route
+		if htlc := payment.LastHTLC(); htlc != nil {
+			route = &htlc.Route
 		}
 		event = *createFailedResult(
 			route, *payment.Failure,
@@ -263,3 +368,69 @@ func (p *controlTower) notifyFinalEvent(paymentHash lntypes.Hash,
 		close(subscriber)
 	}
 }
+
+// SubscribePaymentUpdates subscribes to every change of the payment with the
+// given hash, including the registration and resolution of each of its htlc
+// attempts.
+func (p *controlTower) SubscribePaymentUpdates(paymentHash lntypes.Hash) (
+	*PaymentUpdateSubscription, error) {
+
+	// Make sure the payment exists, so that the caller is told right away
+	// about unknown payments.
+	if _, err := p.db.FetchPayment(paymentHash); err != nil {
+		return nil, err
+	}
+
+	updates := make(chan *channeldb.Payment)
+	sub := &PaymentUpdateSubscription{
+		Updates:     updates,
+		updates:     updates,
+		changed:     make(chan struct{}, 1),
+		paymentHash: paymentHash,
+		tower:       p,
+		quit:        make(chan struct{}),
+	}
+
+	// The subscriber is registered before the payment is fetched for the
+	// first update, so that no change can go unnoticed.
+	p.subscribersMtx.Lock()
+	subs, ok := p.updateSubscribers[paymentHash]
+	if !ok {
+		subs = make(map[*PaymentUpdateSubscription]struct{})
+		p.updateSubscribers[paymentHash] = subs
+	}
+	subs[sub] = struct{}{}
+	p.subscribersMtx.Unlock()
+
+	go sub.deliverUpdates()
+
+	return sub, nil
+}
+
+// removeUpdateSubscriber removes the passed subscriber from the set of update
+// subscribers.
+func (p *controlTower) removeUpdateSubscriber(sub *PaymentUpdateSubscription) {
+	p.subscribersMtx.Lock()
+	defer p.subscribersMtx.Unlock()
+
+	subs := p.updateSubscribers[sub.paymentHash]
+	delete(subs, sub)
+	if len(subs) == 0 {
+		delete(p.updateSubscribers, sub.paymentHash)
+	}
+}
+
+// notifyUpdate signals all update subscribers of the payment that it changed.
+func (p *controlTower) notifyUpdate(paymentHash lntypes.Hash) {
+	p.subscribersMtx.Lock()
+	defer p.subscribersMtx.Unlock()
+
+	// The signal channels are buffered, so a pending signal already
+	// covers this change if the send would block.
+	for sub := range p.updateSubscribers[paymentHash] {
+		select {
+		case sub.changed <- struct{}{}:
+		default:
+		}
+	}
+}
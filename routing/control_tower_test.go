@@ -163,6 +163,126 @@ func TestControlTowerSubscribeSuccess(t *testing.T) {
 	}
 }
 
+// TestControlTowerSubscribeUpdates tests that update subscribers are told about
+// every htlc attempt of a payment, and that the updates end once the payment
+// has completed.
+func TestControlTowerSubscribeUpdates(t *testing.T) {
+	t.Parallel()
+
+	db, err := initDB()
+	if err != nil {
+		t.Fatalf("unable to init db: %v", err)
+	}
+
+	pControl := NewControlTower(channeldb.NewPaymentControl(db))
+
+	// Subscription should fail when the payment is not known.
+	_, err = pControl.SubscribePaymentUpdates(lntypes.Hash{1})
+	if err != channeldb.ErrPaymentNotInitiated {
+		t.Fatal("expected subscribe to fail for unknown payment")
+	}
+
+	info, attempt, preimg, err := genInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = pControl.InitPayment(info.PaymentHash, info)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sub, err := pControl.SubscribePaymentUpdates(info.PaymentHash)
+	if err != nil {
+		t.Fatalf("expected subscribe to succeed, but got: %v", err)
+	}
+	defer sub.Cancel()
+
+	// waitForUpdate waits for an update for which the passed predicate
+	// holds. Updates that happen in quick succession may be delivered as
+	// one, so earlier states may be skipped.
+	waitForUpdate := func(desc string,
+		pred func(*channeldb.Payment) bool) *channeldb.Payment {
+
+		t.Helper()
+
+		for {
+			select {
+			case payment, ok := <-sub.Updates:
+				if !ok {
+					t.Fatalf("updates closed waiting for %v",
+						desc)
+				}
+				if pred(payment) {
+					return payment
+				}
+
+			case <-time.After(testTimeout):
+				t.Fatalf("timeout waiting for %v", desc)
+			}
+		}
+	}
+
+	// The current state of the payment is sent right away.
+	waitForUpdate("initial update", func(p *channeldb.Payment) bool {
+		return p.Status == channeldb.StatusInFlight && len(p.HTLCs) == 0
+	})
+
+	// Register an attempt and fail it.
+	err = pControl.RegisterAttempt(info.PaymentHash, attempt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitForUpdate("first attempt", func(p *channeldb.Payment) bool {
+		return len(p.HTLCs) == 1
+	})
+
+	err = pControl.FailAttempt(
+		info.PaymentHash, attempt.PaymentID, &channeldb.HTLCFailInfo{
+			Reason: channeldb.HTLCFailInternal,
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitForUpdate("failed attempt", func(p *channeldb.Payment) bool {
+		return len(p.HTLCs) == 1 && p.HTLCs[0].Failure != nil
+	})
+
+	// Register a second attempt, which settles.
+	attempt2 := *attempt
+	attempt2.PaymentID++
+	err = pControl.RegisterAttempt(info.PaymentHash, &attempt2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := pControl.Success(info.PaymentHash, preimg); err != nil {
+		t.Fatal(err)
+	}
+
+	payment := waitForUpdate("success", func(p *channeldb.Payment) bool {
+		return p.Status == channeldb.StatusSucceeded
+	})
+	if len(payment.HTLCs) != 2 {
+		t.Fatalf("expected 2 htlcs, got %v", len(payment.HTLCs))
+	}
+	if payment.HTLCs[1].Settle == nil ||
+		payment.HTLCs[1].Settle.Preimage != preimg {
+
+		t.Fatalf("expected second htlc to be settled")
+	}
+
+	// After the final update, we expect the channel to be closed.
+	select {
+	case _, ok := <-sub.Updates:
+		if ok {
+			t.Fatal("expected channel to be closed")
+		}
+	case <-time.After(testTimeout):
+		t.Fatal("timeout waiting for updates channel close")
+	}
+}
+
 // TestPaymentControlSubscribeFail tests that payment updates for a
 // failed payment are properly sent to subscribers.
 func TestPaymentControlSubscribeFail(t *testing.T) {
@@ -0,0 +1,264 @@
+package routing
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/routing/route"
+)
+
+const (
+	// DefaultEdgeScoreBudget is the default latency budget of a single path
+	// finding query. Once it has elapsed since the query started, the
+	// external edge score provider is no longer consulted for the query.
+	DefaultEdgeScoreBudget = 100 * time.Millisecond
+
+	// DefaultEdgeScoreCacheTTL is the default amount of time the score
+	// returned by an external edge score provider is reused for before it
+	// is requested again.
+	DefaultEdgeScoreCacheTTL = 10 * time.Minute
+)
+
+var (
+	// ErrEdgeScorerAlreadyRegistered is returned when an edge score
+	// provider is registered while another one is still active.
+	ErrEdgeScorerAlreadyRegistered = errors.New("edge score provider " +
+		"already registered")
+
+	// ErrEdgeScoreTimeout is returned when an edge score provider didn't
+	// respond to a request within the latency budget of the query.
+	ErrEdgeScoreTimeout = errors.New("edge score provider timeout")
+)
+
+// EdgeScoreRequest describes a channel edge for which a score is requested
+// from an external edge score provider.
+type EdgeScoreRequest struct {
+	// FromNode is the node forwarding the payment over the channel.
+	FromNode route.Vertex
+
+	// ToNode is the node receiving the payment over the channel.
+	ToNode route.Vertex
+
+	// ChannelID is the short channel id of the channel.
+	ChannelID uint64
+
+	// Amount is the amount the channel is expected to carry in the path
+	// finding query that triggered the request.
+	Amount lnwire.MilliAtom
+}
+
+// EdgeScoreProvider is an external service that adjusts the weight path
+// finding assigns to channel edges, allowing custom routing policies to be
+// experimented with. The returned penalty is expressed in milli-atoms, the
+// unit path finding weighs fees in. A negative penalty is a bonus that makes
+// the edge more attractive.
+type EdgeScoreProvider interface {
+	// ScoreEdge returns the penalty of the edge described by the request.
+	// The quit channel is closed when path finding is no longer
+	// interested in a response, in which case the call should return as
+	// soon as possible.
+	ScoreEdge(req *EdgeScoreRequest, quit <-chan struct{}) (int64, error)
+}
+
+// edgeScoreKey identifies a direction of a channel in the score cache.
+type edgeScoreKey struct {
+	chanID   uint64
+	fromNode route.Vertex
+}
+
+// cachedEdgeScore is a score returned by the edge score provider, along with
+// the time it expires at.
+type cachedEdgeScore struct {
+	penalty int64
+	expiry  time.Time
+}
+
+// EdgeScorer consults the registered external edge score provider, if any,
+// during path finding. The scores are cached per channel direction, as
+// querying the provider for every edge expanded would make path finding
+// prohibitively slow. Each path finding query is given a latency budget, once
+// it is spent, edges whose score isn't cached aren't penalized.
+type EdgeScorer struct {
+	provider EdgeScoreProvider
+	budget   time.Duration
+	cacheTTL time.Duration
+	cache    map[edgeScoreKey]cachedEdgeScore
+	mtx      sync.Mutex
+
+	// now returns the current time. It is overridden in tests.
+	now func() time.Time
+}
+
+// NewEdgeScorer returns an EdgeScorer without any registered provider.
+func NewEdgeScorer() *EdgeScorer {
+	return &EdgeScorer{
+		cache: make(map[edgeScoreKey]cachedEdgeScore),
+		now:   time.Now,
+	}
+}
+
+// RegisterProvider registers an external edge score provider. Only a single
+// provider can be active at a time. A zero budget or cache ttl selects the
+// default value.
+func (s *EdgeScorer) RegisterProvider(provider EdgeScoreProvider,
+	budget, cacheTTL time.Duration) error {
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.provider != nil {
+		return ErrEdgeScorerAlreadyRegistered
+	}
+
+	if budget == 0 {
+		budget = DefaultEdgeScoreBudget
+	}
+	if cacheTTL == 0 {
+		cacheTTL = DefaultEdgeScoreCacheTTL
+	}
+
+	log.Infof("Registered external edge score provider, budget=%v, "+
+		"cache_ttl=%v", budget, cacheTTL)
+
+	s.provider = provider
+	s.budget = budget
+	s.cacheTTL = cacheTTL
+
+	return nil
+}
+
+// UnregisterProvider removes the passed edge score provider, if it is the
+// currently active one. The scores it returned are discarded.
+func (s *EdgeScorer) UnregisterProvider(provider EdgeScoreProvider) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.provider != provider {
+		return
+	}
+
+	log.Infof("Unregistered external edge score provider")
+
+	s.provider = nil
+	s.cache = make(map[edgeScoreKey]cachedEdgeScore)
+}
+
+// PenaltySource returns the edge penalty callback of a single path finding
+// query, or nil if no provider is registered. The latency budget of the
+// query starts when this method is called.
+func (s *EdgeScorer) PenaltySource() func(fromNode, toNode route.Vertex,
+	chanID uint64, amt lnwire.MilliAtom) int64 {
+
+	if s == nil {
+		return nil
+	}
+
+	s.mtx.Lock()
+	provider := s.provider
+	deadline := s.now().Add(s.budget)
+	s.mtx.Unlock()
+
+	if provider == nil {
+		return nil
+	}
+
+	return func(fromNode, toNode route.Vertex, chanID uint64,
+		amt lnwire.MilliAtom) int64 {
+
+		key := edgeScoreKey{
+			chanID:   chanID,
+			fromNode: fromNode,
+		}
+		if penalty, ok := s.cachedPenalty(provider, key); ok {
+			return penalty
+		}
+
+		remaining := deadline.Sub(s.now())
+		if remaining <= 0 {
+			return 0
+		}
+
+		penalty, err := scoreEdge(provider, &EdgeScoreRequest{
+			FromNode:  fromNode,
+			ToNode:    toNode,
+			ChannelID: chanID,
+			Amount:    amt,
+		}, remaining)
+		if err != nil {
+			log.Debugf("Unable to score channel %v from %v: %v",
+				chanID, fromNode, err)
+			return 0
+		}
+
+		s.cachePenalty(provider, key, penalty)
+
+		return penalty
+	}
+}
+
+// cachedPenalty returns the cached penalty of the channel direction, if it was
+// returned by the passed provider and hasn't expired.
+func (s *EdgeScorer) cachedPenalty(provider EdgeScoreProvider,
+	key edgeScoreKey) (int64, bool) {
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.provider != provider {
+		return 0, false
+	}
+
+	score, ok := s.cache[key]
+	if !ok || !s.now().Before(score.expiry) {
+		return 0, false
+	}
+
+	return score.penalty, true
+}
+
+// cachePenalty stores the penalty of the channel direction returned by the
+// passed provider, unless the provider was unregistered in the meantime.
+func (s *EdgeScorer) cachePenalty(provider EdgeScoreProvider,
+	key edgeScoreKey, penalty int64) {
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.provider != provider {
+		return
+	}
+
+	s.cache[key] = cachedEdgeScore{
+		penalty: penalty,
+		expiry:  s.now().Add(s.cacheTTL),
+	}
+}
+
+// scoreEdge requests the score of an edge from the provider, waiting at most
+// for the passed timeout.
+func scoreEdge(provider EdgeScoreProvider, req *EdgeScoreRequest,
+	timeout time.Duration) (int64, error) {
+
+	quit := make(chan struct{})
+	defer close(quit)
+
+	type result struct {
+		penalty int64
+		err     error
+	}
+	resultChan := make(chan result, 1)
+	go func() {
+		penalty, err := provider.ScoreEdge(req, quit)
+		resultChan <- result{penalty, err}
+	}()
+
+	select {
+	case res := <-resultChan:
+		return res.penalty, res.err
+
+	case <-time.After(timeout):
+		return 0, ErrEdgeScoreTimeout
+	}
+}
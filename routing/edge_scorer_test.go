@@ -0,0 +1,141 @@
+package routing
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/routing/route"
+)
+
+// mockEdgeScoreProvider is an edge score provider returning fixed penalties
+// per channel, or blocking until the request is abandoned if none is set.
+type mockEdgeScoreProvider struct {
+	penalties map[uint64]int64
+	err       error
+	requests  []*EdgeScoreRequest
+	mtx       sync.Mutex
+}
+
+func (m *mockEdgeScoreProvider) ScoreEdge(req *EdgeScoreRequest,
+	quit <-chan struct{}) (int64, error) {
+
+	m.mtx.Lock()
+	m.requests = append(m.requests, req)
+	err := m.err
+	penalty, ok := m.penalties[req.ChannelID]
+	m.mtx.Unlock()
+
+	if err != nil {
+		return 0, err
+	}
+
+	if !ok {
+		<-quit
+		return 0, ErrEdgeScoreTimeout
+	}
+
+	return penalty, nil
+}
+
+// TestEdgeScorer asserts that the edge scorer caches the penalties returned by
+// the provider, and stops consulting it once the latency budget of a query is
+// spent.
+func TestEdgeScorer(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	scorer := NewEdgeScorer()
+	scorer.now = func() time.Time { return now }
+
+	// Without a registered provider, no penalty source is returned.
+	if scorer.PenaltySource() != nil {
+		t.Fatal("expected no penalty source without provider")
+	}
+	var nilScorer *EdgeScorer
+	if nilScorer.PenaltySource() != nil {
+		t.Fatal("expected no penalty source for nil scorer")
+	}
+
+	provider := &mockEdgeScoreProvider{
+		penalties: map[uint64]int64{1: 500, 2: -200},
+	}
+	err := scorer.RegisterProvider(
+		provider, 10*time.Millisecond, time.Minute,
+	)
+	if err != nil {
+		t.Fatalf("unable to register provider: %v", err)
+	}
+	err = scorer.RegisterProvider(&mockEdgeScoreProvider{}, 0, 0)
+	if err != ErrEdgeScorerAlreadyRegistered {
+		t.Fatalf("expected %v, got %v", ErrEdgeScorerAlreadyRegistered,
+			err)
+	}
+
+	nodeA := route.Vertex{1}
+	nodeB := route.Vertex{2}
+
+	assertPenalty := func(penaltySource func(route.Vertex, route.Vertex,
+		uint64, lnwire.MilliAtom) int64, from route.Vertex,
+		chanID uint64, expectedPenalty int64, expectedRequests int) {
+
+		t.Helper()
+
+		penalty := penaltySource(from, nodeB, chanID, 1000)
+		if penalty != expectedPenalty {
+			t.Fatalf("expected penalty %v for channel %v, got %v",
+				expectedPenalty, chanID, penalty)
+		}
+
+		provider.mtx.Lock()
+		numRequests := len(provider.requests)
+		provider.mtx.Unlock()
+		if numRequests != expectedRequests {
+			t.Fatalf("expected %v requests, got %v",
+				expectedRequests, numRequests)
+		}
+	}
+
+	// The first query requests the penalties from the provider, while
+	// later ones use the cached values until they expire. Both directions
+	// of a channel are scored separately.
+	penaltySource := scorer.PenaltySource()
+	assertPenalty(penaltySource, nodeA, 1, 500, 1)
+	assertPenalty(penaltySource, nodeA, 2, -200, 2)
+	assertPenalty(penaltySource, nodeA, 1, 500, 2)
+	assertPenalty(penaltySource, nodeB, 1, 500, 3)
+
+	assertPenalty(scorer.PenaltySource(), nodeA, 1, 500, 3)
+
+	now = now.Add(time.Minute)
+	assertPenalty(scorer.PenaltySource(), nodeA, 1, 500, 4)
+
+	// A channel the provider doesn't answer for in time isn't penalized,
+	// nor cached.
+	penaltySource = scorer.PenaltySource()
+	assertPenalty(penaltySource, nodeA, 3, 0, 5)
+
+	// Once the budget of the query is spent, the provider is no longer
+	// consulted for it, while cached penalties still apply.
+	now = now.Add(10 * time.Millisecond)
+	assertPenalty(penaltySource, nodeA, 4, 0, 5)
+	assertPenalty(penaltySource, nodeA, 1, 500, 5)
+
+	// A failing provider doesn't penalize the channel either.
+	provider.mtx.Lock()
+	provider.err = errors.New("scoring failed")
+	provider.mtx.Unlock()
+	assertPenalty(scorer.PenaltySource(), nodeA, 5, 0, 6)
+
+	// Unregistering the provider discards its penalties.
+	scorer.UnregisterProvider(provider)
+	if scorer.PenaltySource() != nil {
+		t.Fatal("expected no penalty source after unregistering")
+	}
+	if cached := penaltySource(nodeA, nodeB, 1, 1000); cached != 0 {
+		t.Fatalf("expected no penalty from unregistered provider, "+
+			"got %v", cached)
+	}
+}
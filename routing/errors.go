@@ -51,6 +51,18 @@ const (
 	// ErrFeeLimitExceeded is returned when the total fees of a route exceed
 	// the user-specified fee limit.
 	ErrFeeLimitExceeded
+
+	// ErrPaymentCanceled is returned when a payment was canceled by the
+	// user before it completed.
+	ErrPaymentCanceled
+
+	// ErrPaymentBudgetExhausted is returned when the attempt, fee or time
+	// budget of a payment was exhausted before it completed.
+	ErrPaymentBudgetExhausted
+
+	// ErrInsufficientBalance is returned when none of our channels has
+	// enough outbound bandwidth to carry a payment.
+	ErrInsufficientBalance
 )
 
 // routerError is a structure that represent the error inside the routing package,
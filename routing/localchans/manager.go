@@ -82,6 +82,11 @@ func (r *Manager) UpdatePolicy(newSchema routing.ChannelPolicy,
 			return nil
 		}
 
+		inboundFee, err := lnwire.ParseInboundFee(edge.ExtraOpaqueData)
+		if err != nil {
+			return nil
+		}
+
 		// Add updated edge to list of edges to send to gossiper.
 		edgesToUpdate = append(edgesToUpdate, discovery.EdgeWithInfo{
 			Info: info,
@@ -95,6 +100,7 @@ func (r *Manager) UpdatePolicy(newSchema routing.ChannelPolicy,
 			TimeLockDelta: uint32(edge.TimeLockDelta),
 			MinHTLC:       edge.MinHTLC,
 			MaxHTLC:       edge.MaxHTLC,
+			InboundFee:    inboundFee,
 		}
 
 		return nil
@@ -131,6 +137,18 @@ func (r *Manager) updateEdge(chanPoint wire.OutPoint,
 	)
 	edge.TimeLockDelta = uint16(newSchema.TimeLockDelta)
 
+	// Update the inbound fee, carried as a TLV record within the extra
+	// opaque data of the channel update, if one was specified.
+	if newSchema.InboundFee != nil {
+		extraData, err := lnwire.SetInboundFee(
+			edge.ExtraOpaqueData, *newSchema.InboundFee,
+		)
+		if err != nil {
+			return err
+		}
+		edge.ExtraOpaqueData = extraData
+	}
+
 	// Retrieve negotiated channel htlc amt limits.
 	amtMin, amtMax, err := r.getHtlcAmtLimits(chanPoint)
 	if err != nil {
@@ -160,6 +178,12 @@ func (r *Manager) updateEdge(chanPoint wire.OutPoint,
 	// If the MaxHtlc flag wasn't already set, we can set it now.
 	edge.MessageFlags |= lnwire.ChanUpdateOptionMaxHtlc
 
+	// If a min htlc was specified, use it to update the edge. It is
+	// validated against the channel constraints below.
+	if newSchema.MinHTLC != nil {
+		edge.MinHTLC = *newSchema.MinHTLC
+	}
+
 	// Validate htlc amount constraints.
 	switch {
 	case edge.MinHTLC < amtMin:
@@ -59,6 +59,16 @@ func TestManager(t *testing.T) {
 		if policy.MaxHTLC != newPolicy.MaxHTLC {
 			t.Fatal("unexpected max htlc")
 		}
+		if newPolicy.MinHTLC != nil &&
+			policy.MinHTLC != *newPolicy.MinHTLC {
+
+			t.Fatal("unexpected min htlc")
+		}
+		if newPolicy.InboundFee != nil &&
+			policy.InboundFee != *newPolicy.InboundFee {
+
+			t.Fatal("unexpected inbound fee")
+		}
 	}
 
 	propagateChanPolicyUpdate := func(
@@ -84,6 +94,22 @@ func TestManager(t *testing.T) {
 		if policy.MaxHTLC != newPolicy.MaxHTLC {
 			t.Fatal("unexpected max htlc")
 		}
+		if newPolicy.MinHTLC != nil &&
+			policy.MinHTLC != *newPolicy.MinHTLC {
+
+			t.Fatal("unexpected min htlc")
+		}
+		if newPolicy.InboundFee != nil {
+			inboundFee, err := lnwire.ParseInboundFee(
+				policy.ExtraOpaqueData,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if inboundFee != *newPolicy.InboundFee {
+				t.Fatal("unexpected inbound fee")
+			}
+		}
 
 		return nil
 	}
@@ -145,4 +171,14 @@ func TestManager(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+
+	// Test updating the min htlc and the inbound fee of the channel.
+	newMinHTLC := minHTLC + 1000
+	newPolicy.MinHTLC = &newMinHTLC
+	newPolicy.InboundFee = &lnwire.InboundFee{BaseFee: -50, FeeRate: -10}
+
+	err = manager.UpdatePolicy(newPolicy, chanPoint)
+	if err != nil {
+		t.Fatal(err)
+	}
 }
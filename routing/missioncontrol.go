@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/clock"
 	"github.com/decred/dcrlnd/lnwire"
 	"github.com/decred/dcrlnd/routing/route"
 	bolt "go.etcd.io/bbolt"
@@ -44,6 +45,22 @@ const (
 	// DefaultMaxMcHistory is the default maximum history size.
 	DefaultMaxMcHistory = 1000
 
+	// DefaultMaxMcHistoryAge is the default maximum age of the results
+	// kept in the history.
+	DefaultMaxMcHistoryAge = 7 * 24 * time.Hour
+
+	// DefaultMaxMcPairResults is the default maximum number of results
+	// kept in the history for each node pair.
+	DefaultMaxMcPairResults = 10
+
+	// DefaultFailureRelaxInterval is the default time after which a
+	// failure is no longer taken into account.
+	DefaultFailureRelaxInterval = 24 * time.Hour
+
+	// DefaultSuccessRelaxInterval is the default time after which a
+	// success is no longer taken into account.
+	DefaultSuccessRelaxInterval = 24 * time.Hour
+
 	// prevSuccessProbability is the assumed probability for node pairs that
 	// successfully relayed the previous attempt.
 	prevSuccessProbability = 0.95
@@ -59,7 +76,7 @@ const (
 // since the last failure is used to estimate a success probability that is fed
 // into the path finding process for subsequent payment attempts.
 type MissionControl struct {
-	// lastPairResult tracks the last payment result per node pair.
+	// lastPairResult tracks the last payment results per node pair.
 	lastPairResult map[DirectedNodePair]timedPairResult
 
 	// lastNodeFailure tracks the last node level failure per node.
@@ -99,14 +116,66 @@ type MissionControlConfig struct {
 	// MaxMcHistory defines the maximum number of payment results that are
 	// held on disk.
 	MaxMcHistory int
+
+	// MaxMcHistoryAge defines the maximum age of the payment results that
+	// are held on disk. Older results are pruned. A zero value means that
+	// results aren't pruned based on their age.
+	MaxMcHistoryAge time.Duration
+
+	// MaxMcPairResults defines the maximum number of payment results held
+	// on disk for each node pair. Results that are superseded for all the
+	// node pairs along their route are pruned. A zero value means that
+	// results aren't pruned based on their node pairs.
+	MaxMcPairResults int
+
+	// FailureRelaxInterval defines after how much time a node or channel
+	// failure is no longer taken into account, giving the node or channel
+	// back its a priori probability. This is applied when estimating
+	// probabilities, so the failure is still held on disk. A zero value
+	// means failures are only relaxed through PenaltyHalfLife.
+	FailureRelaxInterval time.Duration
+
+	// SuccessRelaxInterval defines after how much time a successful
+	// payment attempt through a node pair is no longer taken into account
+	// when estimating its probability. A zero value means that the last
+	// success is always taken into account.
+	SuccessRelaxInterval time.Duration
+
+	// Clock is the time source used to timestamp payment results and to
+	// decay penalties. If nil, the system time is used.
+	Clock clock.Clock
 }
 
-// timedPairResult describes a timestamped pair result.
+// timedPairResult describes the timestamped results obtained for a node pair.
+// The last failure and the last success are tracked separately, so that a
+// failure for a large amount doesn't erase what is known about smaller amounts
+// and vice versa.
 type timedPairResult struct {
-	// timestamp is the time when this result was obtained.
-	timestamp time.Time
+	// failTime is the time of the last failure. It is zero if no failure
+	// was obtained for the pair.
+	failTime time.Time
+
+	// failAmt is the minimum amount the last failure applies to. A zero
+	// amount means that the failure applies to all amounts.
+	failAmt lnwire.MilliAtom
+
+	// successTime is the time of the last success. It is zero if no
+	// success was obtained for the pair since its last amount independent
+	// failure.
+	successTime time.Time
+
+	// successAmt is the highest amount that was successfully forwarded
+	// through the pair.
+	successAmt lnwire.MilliAtom
+}
 
-	pairResult
+// lastTime returns the time of the last result of the pair.
+func (t *timedPairResult) lastTime() time.Time {
+	if t.successTime.After(t.failTime) {
+		return t.successTime
+	}
+
+	return t.failTime
 }
 
 // MissionControlSnapshot contains a snapshot of the current state of mission
@@ -171,19 +240,31 @@ func NewMissionControl(db *bolt.DB, cfg *MissionControlConfig) (
 	*MissionControl, error) {
 
 	log.Debugf("Instantiating mission control with config: "+
-		"PenaltyHalfLife=%v, AprioriHopProbability=%v",
-		cfg.PenaltyHalfLife, cfg.AprioriHopProbability)
-
-	store, err := newMissionControlStore(db, cfg.MaxMcHistory)
+		"PenaltyHalfLife=%v, AprioriHopProbability=%v, "+
+		"MaxMcHistory=%v, MaxMcHistoryAge=%v, MaxMcPairResults=%v, "+
+		"FailureRelaxInterval=%v, SuccessRelaxInterval=%v",
+		cfg.PenaltyHalfLife, cfg.AprioriHopProbability,
+		cfg.MaxMcHistory, cfg.MaxMcHistoryAge, cfg.MaxMcPairResults,
+		cfg.FailureRelaxInterval, cfg.SuccessRelaxInterval)
+
+	store, err := newMissionControlStore(
+		db, cfg.MaxMcHistory, cfg.MaxMcHistoryAge,
+		cfg.MaxMcPairResults,
+	)
 	if err != nil {
 		return nil, err
 	}
 
+	clk := cfg.Clock
+	if clk == nil {
+		clk = clock.NewDefaultClock()
+	}
+
 	mc := &MissionControl{
 		lastPairResult:   make(map[DirectedNodePair]timedPairResult),
 		lastNodeFailure:  make(map[route.Vertex]time.Time),
 		lastSecondChance: make(map[DirectedNodePair]time.Time),
-		now:              time.Now,
+		now:              clk.Now,
 		cfg:              cfg,
 		store:            store,
 	}
@@ -201,21 +282,51 @@ func (m *MissionControl) init() error {
 
 	start := time.Now()
 
-	results, err := m.store.fetchAll()
-	if err != nil {
-		return err
+	// Results that fell out of the age window while we were offline are
+	// pruned before deriving the state from the remaining ones.
+	if m.cfg.MaxMcHistoryAge > 0 {
+		numPruned, err := m.store.pruneBefore(
+			m.now().Add(-m.cfg.MaxMcHistoryAge),
+		)
+		if err != nil {
+			return err
+		}
+
+		log.Debugf("Pruned %v mission control results older than %v",
+			numPruned, m.cfg.MaxMcHistoryAge)
 	}
 
-	for _, result := range results {
-		m.applyPaymentResult(result)
+	m.Lock()
+	defer m.Unlock()
+
+	n, err := m.applyStoredResults()
+	if err != nil {
+		return err
 	}
 
 	log.Debugf("Mission control state reconstruction finished: "+
-		"n=%v, time=%v", len(results), time.Since(start))
+		"n=%v, time=%v", n, time.Since(start))
 
 	return nil
 }
 
+// applyStoredResults applies all results found in the store to the mission
+// control state. It returns the number of applied results.
+//
+// NOTE: This method MUST be called with the mission control lock held.
+func (m *MissionControl) applyStoredResults() (int, error) {
+	results, err := m.store.fetchAll()
+	if err != nil {
+		return 0, err
+	}
+
+	for _, result := range results {
+		m.applyPaymentResultLocked(result)
+	}
+
+	return len(results), nil
+}
+
 // ResetHistory resets the history of MissionControl returning it to a state as
 // if no payment attempts have been made.
 func (m *MissionControl) ResetHistory() error {
@@ -235,6 +346,33 @@ func (m *MissionControl) ResetHistory() error {
 	return nil
 }
 
+// PruneHistory removes the results of the payment attempts that completed
+// before the passed time from the history of MissionControl. The state is then
+// rederived from the remaining results, as if the pruned attempts were never
+// made.
+func (m *MissionControl) PruneHistory(before time.Time) error {
+	m.Lock()
+	defer m.Unlock()
+
+	numPruned, err := m.store.pruneBefore(before)
+	if err != nil {
+		return err
+	}
+
+	m.lastPairResult = make(map[DirectedNodePair]timedPairResult)
+	m.lastNodeFailure = make(map[route.Vertex]time.Time)
+	m.lastSecondChance = make(map[DirectedNodePair]time.Time)
+
+	if _, err := m.applyStoredResults(); err != nil {
+		return err
+	}
+
+	log.Debugf("Pruned %v mission control results from before %v",
+		numPruned, before)
+
+	return nil
+}
+
 // GetProbability is expected to return the success probability of a payment
 // from fromNode along edge.
 func (m *MissionControl) GetProbability(fromNode, toNode route.Vertex,
@@ -253,6 +391,11 @@ func (m *MissionControl) getProbAfterFail(lastFailure time.Time) float64 {
 		return m.cfg.AprioriHopProbability
 	}
 
+	// Failures that are long enough ago are forgotten altogether.
+	if m.failureRelaxed(lastFailure) {
+		return m.cfg.AprioriHopProbability
+	}
+
 	timeSinceLastFailure := m.now().Sub(lastFailure)
 
 	// Calculate success probability. It is an exponential curve that brings
@@ -276,30 +419,109 @@ func (m *MissionControl) getPairProbability(fromNode,
 	// there is none, lastFail will be zero.
 	lastFail := m.lastNodeFailure[fromNode]
 
-	// Retrieve the last pair outcome.
+	// Retrieve the last pair outcomes.
 	pair := NewDirectedNodePair(fromNode, toNode)
 	lastPairResult, ok := m.lastPairResult[pair]
+	if !ok {
+		return m.getProbAfterFail(lastFail)
+	}
+
+	// Only look at the last pair failure if it happened after the last
+	// node level failure. Otherwise the node level failure is the most
+	// recent and used as the basis for calculation of the probability.
+	//
+	// Take into account a minimum penalize amount. For balance errors, a
+	// failure may be reported with such a minimum to prevent too aggresive
+	// penalization. We only take into account a previous failure if the
+	// amount that we currently get the probability for is greater or equal
+	// than the minimum amount of the previous failure. A failure that is
+	// long enough ago is ignored as well.
+	if lastPairResult.failTime.After(lastFail) &&
+		amt >= lastPairResult.failAmt &&
+		!m.failureRelaxed(lastPairResult.failTime) {
+
+		return m.getProbAfterFail(lastPairResult.failTime)
+	}
 
-	// Only look at the last pair outcome if it happened after the last node
-	// level failure. Otherwise the node level failure is the most recent
-	// and used as the basis for calculation of the probability.
-	if ok && lastPairResult.timestamp.After(lastFail) {
-		if lastPairResult.success {
-			return prevSuccessProbability
+	// Otherwise, a success that happened after the last node level failure
+	// raises the probability, unless it is long enough ago. Successes that
+	// happened before a failure for a higher amount are still taken into
+	// account for lower amounts.
+	if lastPairResult.successTime.After(lastFail) &&
+		!m.successRelaxed(lastPairResult.successTime) {
+
+		return prevSuccessProbability
+	}
+
+	return m.getProbAfterFail(lastFail)
+}
+
+// setLastPairResult applies a new result obtained at the passed time to the
+// state kept for the pair.
+func (m *MissionControl) setLastPairResult(pair DirectedNodePair,
+	timestamp time.Time, result pairResult) {
+
+	current := m.lastPairResult[pair]
+
+	if result.success {
+		current.successTime = timestamp
+
+		// Only raise the success amount, so that successes for small
+		// amounts don't shrink what is known about larger ones.
+		if result.amt > current.successAmt {
+			current.successAmt = result.amt
 		}
 
-		// Take into account a minimum penalize amount. For balance
-		// errors, a failure may be reported with such a minimum to
-		// prevent too aggresive penalization. We only take into account
-		// a previous failure if the amount that we currently get the
-		// probability for is greater or equal than the minPenalizeAmt
-		// of the previous failure.
-		if amt >= lastPairResult.minPenalizeAmt {
-			lastFail = lastPairResult.timestamp
+		// If the success amount reaches into the failure range, move
+		// the failure range up. Attempts up to the success amount are
+		// likely to succeed, but nothing was learnt about the amounts
+		// above it.
+		if !current.failTime.IsZero() && result.amt >= current.failAmt {
+			current.failAmt = result.amt + 1
 		}
+
+		m.lastPairResult[pair] = current
+		return
 	}
 
-	return m.getProbAfterFail(lastFail)
+	// The time and amount of a failure are always updated together, as
+	// the time is used to decay the penalty of that specific failure.
+	current.failTime = timestamp
+	current.failAmt = result.amt
+
+	switch {
+	// An amount independent failure supersedes all previous successes.
+	case result.amt == 0:
+		current.successTime = time.Time{}
+		current.successAmt = 0
+
+	// If the failure range reaches into the success range, move the
+	// success range down.
+	case result.amt <= current.successAmt:
+		current.successAmt = result.amt - 1
+	}
+
+	m.lastPairResult[pair] = current
+}
+
+// failureRelaxed returns true if a failure obtained at the passed time is no
+// longer taken into account.
+func (m *MissionControl) failureRelaxed(timestamp time.Time) bool {
+	if m.cfg.FailureRelaxInterval <= 0 {
+		return false
+	}
+
+	return m.now().Sub(timestamp) >= m.cfg.FailureRelaxInterval
+}
+
+// successRelaxed returns true if a success obtained at the passed time is no
+// longer taken into account.
+func (m *MissionControl) successRelaxed(timestamp time.Time) bool {
+	if m.cfg.SuccessRelaxInterval <= 0 {
+		return false
+	}
+
+	return m.now().Sub(timestamp) >= m.cfg.SuccessRelaxInterval
 }
 
 // requestSecondChance checks whether the node fromNode can have a second chance
@@ -359,14 +581,14 @@ func (m *MissionControl) GetHistorySnapshot() *MissionControlSnapshot {
 	for v, h := range m.lastPairResult {
 		// Show probability assuming amount meets min
 		// penalization amount.
-		prob := m.getPairProbability(v.From, v.To, h.minPenalizeAmt)
+		prob := m.getPairProbability(v.From, v.To, h.failAmt)
 
 		pair := MissionControlPairSnapshot{
 			Pair:                  v,
-			MinPenalizeAmt:        h.minPenalizeAmt,
-			Timestamp:             h.timestamp,
+			MinPenalizeAmt:        h.failAmt,
+			Timestamp:             h.lastTime(),
 			SuccessProb:           prob,
-			LastAttemptSuccessful: h.success,
+			LastAttemptSuccessful: h.successTime.After(h.failTime),
 		}
 
 		pairs = append(pairs, pair)
@@ -445,6 +667,19 @@ func (m *MissionControl) processPaymentResult(result *paymentResult) (
 func (m *MissionControl) applyPaymentResult(
 	result *paymentResult) *channeldb.FailureReason {
 
+	m.Lock()
+	defer m.Unlock()
+
+	return m.applyPaymentResultLocked(result)
+}
+
+// applyPaymentResultLocked applies a payment result to the mission control
+// state, like applyPaymentResult does.
+//
+// NOTE: This method MUST be called with the mission control lock held.
+func (m *MissionControl) applyPaymentResultLocked(
+	result *paymentResult) *channeldb.FailureReason {
+
 	// Interpret result.
 	i := interpretResult(
 		result.route, result.success, result.failureSourceIdx,
@@ -452,9 +687,6 @@ func (m *MissionControl) applyPaymentResult(
 	)
 
 	// Update mission control state using the interpretation.
-	m.Lock()
-	defer m.Unlock()
-
 	if i.policyFailure != nil {
 		if m.requestSecondChance(
 			result.timeReply,
@@ -472,19 +704,10 @@ func (m *MissionControl) applyPaymentResult(
 	}
 
 	for pair, pairResult := range i.pairResults {
-		if pairResult.success {
-			log.Debugf("Reporting pair success to Mission "+
-				"Control: pair=%v", pair)
-		} else {
-			log.Debugf("Reporting pair failure to Mission "+
-				"Control: pair=%v, minPenalizeAmt=%v",
-				pair, pairResult.minPenalizeAmt)
-		}
+		log.Debugf("Reporting pair result to Mission Control: "+
+			"pair=%v, result=%v", pair, pairResult)
 
-		m.lastPairResult[pair] = timedPairResult{
-			timestamp:  result.timeReply,
-			pairResult: pairResult,
-		}
+		m.setLastPairResult(pair, result.timeReply, pairResult)
 	}
 
 	return i.finalFailureReason
@@ -35,15 +35,32 @@ const (
 // Also changes to mission control parameters can be applied to historical data.
 // Finally, it enables importing raw data from an external source.
 type missionControlStore struct {
-	db         *bolt.DB
-	maxRecords int
-	numRecords int
+	db             *bolt.DB
+	maxRecords     int
+	maxAge         time.Duration
+	maxPairResults int
+	numRecords     int
+
+	// pairResults tracks the keys of the most recent results of each node
+	// pair, oldest first, when the number of results kept per pair is
+	// limited.
+	pairResults map[DirectedNodePair][]string
+
+	// resultRefs tracks the number of node pairs for which a result is
+	// still among their most recent ones.
+	resultRefs map[string]int
 }
 
-func newMissionControlStore(db *bolt.DB, maxRecords int) (*missionControlStore, error) {
+func newMissionControlStore(db *bolt.DB, maxRecords int, maxAge time.Duration,
+	maxPairResults int) (*missionControlStore, error) {
+
 	store := &missionControlStore{
-		db:         db,
-		maxRecords: maxRecords,
+		db:             db,
+		maxRecords:     maxRecords,
+		maxAge:         maxAge,
+		maxPairResults: maxPairResults,
+		pairResults:    make(map[DirectedNodePair][]string),
+		resultRefs:     make(map[string]int),
 	}
 
 	// Create buckets if not yet existing.
@@ -58,12 +75,25 @@ func newMissionControlStore(db *bolt.DB, maxRecords int) (*missionControlStore,
 		// memory to avoid calling Stats().KeyN. The reliability of
 		// Stats() is doubtful and seemed to have caused crashes in the
 		// past (see #1874).
+		var evicted [][]byte
 		c := resultsBucket.Cursor()
-		for k, _ := c.First(); k != nil; k, _ = c.Next() {
+		for k, v := c.First(); k != nil; k, v = c.Next() {
 			store.numRecords++
+
+			if maxPairResults <= 0 {
+				continue
+			}
+
+			result, err := deserializeResult(k, v)
+			if err != nil {
+				return err
+			}
+			evicted = append(evicted, store.trackPairs(k, result)...)
 		}
 
-		return nil
+		// Results that are superseded for all their node pairs are
+		// compacted away.
+		return store.deleteResults(resultsBucket, evicted)
 	})
 	if err != nil {
 		return nil, err
@@ -74,7 +104,7 @@ func newMissionControlStore(db *bolt.DB, maxRecords int) (*missionControlStore,
 
 // clear removes all results from the db.
 func (b *missionControlStore) clear() error {
-	return b.db.Update(func(tx *bolt.Tx) error {
+	err := b.db.Update(func(tx *bolt.Tx) error {
 		if err := tx.DeleteBucket(resultsKey); err != nil {
 			return err
 		}
@@ -82,6 +112,125 @@ func (b *missionControlStore) clear() error {
 		_, err := tx.CreateBucket(resultsKey)
 		return err
 	})
+	if err != nil {
+		return err
+	}
+
+	b.pairResults = make(map[DirectedNodePair][]string)
+	b.resultRefs = make(map[string]int)
+
+	return nil
+}
+
+// resultPairs returns the node pairs along the route of a result.
+func resultPairs(rp *paymentResult) []DirectedNodePair {
+	pairs := make([]DirectedNodePair, 0, len(rp.route.Hops))
+
+	from := rp.route.SourcePubKey
+	for _, hop := range rp.route.Hops {
+		pairs = append(pairs, NewDirectedNodePair(from, hop.PubKeyBytes))
+		from = hop.PubKeyBytes
+	}
+
+	return pairs
+}
+
+// trackPairs records the result stored under key as the most recent one of
+// the node pairs along its route. It returns the keys of the results that
+// are no longer among the maxPairResults most recent ones of any of their node
+// pairs.
+func (b *missionControlStore) trackPairs(key []byte,
+	rp *paymentResult) [][]byte {
+
+	k := string(key)
+	if _, ok := b.resultRefs[k]; ok {
+		return nil
+	}
+
+	var evicted [][]byte
+	for _, pair := range resultPairs(rp) {
+		keys := append(b.pairResults[pair], k)
+		b.resultRefs[k]++
+
+		for len(keys) > b.maxPairResults {
+			oldest := keys[0]
+			keys = keys[1:]
+
+			b.resultRefs[oldest]--
+			if b.resultRefs[oldest] == 0 {
+				delete(b.resultRefs, oldest)
+				evicted = append(evicted, []byte(oldest))
+			}
+		}
+
+		b.pairResults[pair] = keys
+	}
+
+	return evicted
+}
+
+// deleteResults removes the results stored under the passed keys from the
+// bucket. Keys of results that were already removed are ignored.
+func (b *missionControlStore) deleteResults(bucket *bolt.Bucket,
+	keys [][]byte) error {
+
+	for _, k := range keys {
+		if bucket.Get(k) == nil {
+			continue
+		}
+
+		if err := bucket.Delete(k); err != nil {
+			return err
+		}
+
+		b.numRecords--
+	}
+
+	return nil
+}
+
+// pruneBefore removes all results from the db that were obtained before the
+// passed time. It returns the number of removed results.
+func (b *missionControlStore) pruneBefore(before time.Time) (int, error) {
+	var numPruned int
+	err := b.db.Update(func(tx *bolt.Tx) error {
+		var err error
+		numPruned, err = pruneResultsBefore(
+			tx.Bucket(resultsKey), before,
+		)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	b.numRecords -= numPruned
+
+	return numPruned, nil
+}
+
+// pruneResultsBefore removes all results from the bucket that were obtained
+// before the passed time and returns the number of removed results. As results
+// are keyed by the time of their reply, the oldest ones are always found at
+// the start of the bucket.
+func pruneResultsBefore(bucket *bolt.Bucket, before time.Time) (int, error) {
+	var numPruned int
+
+	cursor := bucket.Cursor()
+	for k, _ := cursor.First(); k != nil; k, _ = cursor.First() {
+		timeReply := time.Unix(0, int64(byteOrder.Uint64(k)))
+		if !timeReply.Before(before) {
+			break
+		}
+
+		if err := cursor.Delete(); err != nil {
+			return 0, err
+		}
+
+		numPruned++
+	}
+
+	return numPruned, nil
 }
 
 // fetchAll returns all results currently stored in the database.
@@ -234,6 +383,19 @@ func (b *missionControlStore) AddResult(rp *paymentResult) error {
 			}
 		}
 
+		// Prune entries that fell out of the age window, relative to
+		// the result that is added.
+		if b.maxAge > 0 {
+			numPruned, err := pruneResultsBefore(
+				bucket, rp.timeReply.Add(-b.maxAge),
+			)
+			if err != nil {
+				return err
+			}
+
+			b.numRecords -= numPruned
+		}
+
 		// Serialize result into key and value byte slices.
 		k, v, err := serializeResult(rp)
 		if err != nil {
@@ -248,7 +410,17 @@ func (b *missionControlStore) AddResult(rp *paymentResult) error {
 		}
 
 		// Put into results bucket.
-		return bucket.Put(k, v)
+		if err := bucket.Put(k, v); err != nil {
+			return err
+		}
+
+		// Compact the results that this one supersedes for all their
+		// node pairs.
+		if b.maxPairResults <= 0 {
+			return nil
+		}
+
+		return b.deleteResults(bucket, b.trackPairs(k, rp))
 	})
 }
 
@@ -34,7 +34,7 @@ func TestMissionControlStore(t *testing.T) {
 	defer db.Close()
 	defer os.Remove(dbPath)
 
-	store, err := newMissionControlStore(db, testMaxRecords)
+	store, err := newMissionControlStore(db, testMaxRecords, 0, 0)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -110,7 +110,7 @@ func TestMissionControlStore(t *testing.T) {
 	}
 
 	// Recreate store to test pruning.
-	store, err = newMissionControlStore(db, testMaxRecords)
+	store, err = newMissionControlStore(db, testMaxRecords, 0, 0)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -144,3 +144,200 @@ func TestMissionControlStore(t *testing.T) {
 			spew.Sdump(results[1]))
 	}
 }
+
+// TestMissionControlStoreMaxAge tests that results that fell out of the age
+// window of the store are pruned.
+func TestMissionControlStoreMaxAge(t *testing.T) {
+	// Set time zone explictly to keep test deterministic.
+	time.Local = time.UTC
+
+	file, err := ioutil.TempFile("", "*.db")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dbPath := file.Name()
+
+	db, err := bolt.Open(dbPath, 0600, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	defer os.Remove(dbPath)
+
+	store, err := newMissionControlStore(db, 0, 2*time.Hour, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testRoute := route.Route{
+		SourcePubKey: route.Vertex{1},
+		Hops: []*route.Hop{
+			{
+				PubKeyBytes:   route.Vertex{2},
+				LegacyPayload: true,
+			},
+		},
+	}
+
+	// Store results that completed an hour apart from each other.
+	var results [4]paymentResult
+	for i := range results {
+		results[i] = paymentResult{
+			route:     &testRoute,
+			success:   true,
+			id:        uint64(i),
+			timeReply: testTime.Add(time.Duration(i) * time.Hour),
+			timeFwd:   testTime.Add(time.Duration(i) * time.Hour),
+		}
+
+		if err := store.AddResult(&results[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Adding the last result should have pruned the first one, as it is
+	// older than two hours relative to it.
+	stored, err := store.fetchAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stored) != 3 {
+		t.Fatalf("expected three results, got %v", len(stored))
+	}
+	if !reflect.DeepEqual(&results[1], stored[0]) {
+		t.Fatalf("the results differ: %v vs %v", spew.Sdump(&results[1]),
+			spew.Sdump(stored[0]))
+	}
+
+	// Explicitly prune the results that completed before the last one.
+	numPruned, err := store.pruneBefore(results[3].timeReply)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if numPruned != 2 {
+		t.Fatalf("expected two pruned results, got %v", numPruned)
+	}
+	if store.numRecords != 1 {
+		t.Fatalf("expected one record, got %v", store.numRecords)
+	}
+
+	stored, err = store.fetchAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stored) != 1 || !reflect.DeepEqual(&results[3], stored[0]) {
+		t.Fatalf("expected only the last result, got %v",
+			spew.Sdump(stored))
+	}
+}
+
+// TestMissionControlStoreMaxPairResults tests that results superseded for all
+// the node pairs along their route are compacted, both when adding results
+// and when opening the store.
+func TestMissionControlStoreMaxPairResults(t *testing.T) {
+	// Set time zone explictly to keep test deterministic.
+	time.Local = time.UTC
+
+	file, err := ioutil.TempFile("", "*.db")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dbPath := file.Name()
+
+	db, err := bolt.Open(dbPath, 0600, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	defer os.Remove(dbPath)
+
+	store, err := newMissionControlStore(db, 0, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newRoute := func(source route.Vertex,
+		hops ...route.Vertex) *route.Route {
+
+		rt := &route.Route{SourcePubKey: source}
+		for _, hop := range hops {
+			rt.Hops = append(rt.Hops, &route.Hop{
+				PubKeyBytes:   hop,
+				LegacyPayload: true,
+			})
+		}
+		return rt
+	}
+
+	var nextID uint64
+	addResult := func(rt *route.Route) {
+		t.Helper()
+
+		timestamp := testTime.Add(time.Duration(nextID) * time.Hour)
+		result := &paymentResult{
+			route:     rt,
+			success:   true,
+			id:        nextID,
+			timeReply: timestamp,
+			timeFwd:   timestamp,
+		}
+		nextID++
+
+		if err := store.AddResult(result); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	assertStored := func(ids ...uint64) {
+		t.Helper()
+
+		stored, err := store.fetchAll()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(stored) != len(ids) {
+			t.Fatalf("expected %v results, got %v", len(ids),
+				len(stored))
+		}
+		for i, id := range ids {
+			if stored[i].id != id {
+				t.Fatalf("expected result %v at %v, got %v",
+					id, i, stored[i].id)
+			}
+		}
+		if store.numRecords != len(ids) {
+			t.Fatalf("expected %v records, got %v", len(ids),
+				store.numRecords)
+		}
+	}
+
+	routeA := newRoute(route.Vertex{1}, route.Vertex{2})
+	routeB := newRoute(route.Vertex{1}, route.Vertex{2}, route.Vertex{3})
+	routeC := newRoute(route.Vertex{2}, route.Vertex{3})
+
+	// Without a limit, all the results of a pair are kept.
+	addResult(routeA)
+	addResult(routeA)
+	assertStored(0, 1)
+
+	// Reopening the store with a limit compacts the superseded result.
+	store, err = newMissionControlStore(db, 0, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertStored(1)
+
+	// A result is superseded by a newer one for the same pair.
+	addResult(routeB)
+	assertStored(2)
+
+	// A result is kept as long as it's the most recent of one of its
+	// pairs.
+	addResult(routeC)
+	assertStored(2, 3)
+
+	addResult(routeA)
+	assertStored(3, 4)
+}
@@ -174,14 +174,90 @@ func TestMissionControl(t *testing.T) {
 			len(history.Nodes))
 	}
 
-	if len(history.Pairs) != 2 {
-		t.Fatalf("expected 2 pairs, but got %v", len(history.Pairs))
+	// The node level failure is also attributed to the pairs leading into
+	// and out of the node, in both directions.
+	if len(history.Pairs) != 4 {
+		t.Fatalf("expected 4 pairs, but got %v", len(history.Pairs))
 	}
 
 	// Test reporting a success.
 	ctx.reportSuccess()
 }
 
+// TestMissionControlPruneHistory tests that pruning the history rederives the
+// state from the remaining results.
+func TestMissionControlPruneHistory(t *testing.T) {
+	ctx := createMcTestContext(t)
+	defer ctx.cleanup()
+
+	ctx.now = testTime
+
+	// Penalize the edge, which then starts to decay.
+	ctx.reportFailure(0, lnwire.NewTemporaryChannelFailure(nil))
+	ctx.expectP(1000, 0)
+
+	ctx.now = testTime.Add(30 * time.Minute)
+	ctx.expectP(1000, 0.4)
+
+	// Pruning the results from before the failure should leave the state
+	// untouched.
+	if err := ctx.mc.PruneHistory(testTime); err != nil {
+		t.Fatal(err)
+	}
+	ctx.expectP(1000, 0.4)
+
+	// Once the failure itself is pruned, the edge should be back at the a
+	// priori probability, also after a restart.
+	if err := ctx.mc.PruneHistory(ctx.now); err != nil {
+		t.Fatal(err)
+	}
+	ctx.expectP(1000, 0.8)
+
+	ctx.restartMc()
+	ctx.expectP(1000, 0.8)
+}
+
+// TestMissionControlPairAmounts tests that the amounts of the failures and
+// successes of a pair are taken into account, so that a failure for a large
+// amount doesn't erase a success for a smaller one.
+func TestMissionControlPairAmounts(t *testing.T) {
+	ctx := createMcTestContext(t)
+	defer ctx.cleanup()
+
+	ctx.now = testTime
+
+	// A success raises the probability regardless of the amount, as long
+	// as no failure is known.
+	mcTestRoute.Hops[0].AmtToForward = 1000
+	ctx.reportSuccess()
+	ctx.expectP(1000, prevSuccessProbability)
+	ctx.expectP(2000, prevSuccessProbability)
+
+	// A balance failure for a higher amount only penalizes the amounts
+	// from the failed one, while the success still applies below it.
+	ctx.reportFailure(1500, lnwire.NewTemporaryChannelFailure(nil))
+	ctx.expectP(1500, 0)
+	ctx.expectP(2000, 0)
+	ctx.expectP(1000, prevSuccessProbability)
+
+	// A success for a lower amount leaves the failure range untouched.
+	mcTestRoute.Hops[0].AmtToForward = 1200
+	ctx.reportSuccess()
+	ctx.expectP(1500, 0)
+	ctx.expectP(1200, prevSuccessProbability)
+
+	// A success reaching into the failure range moves it up.
+	mcTestRoute.Hops[0].AmtToForward = 2000
+	ctx.reportSuccess()
+	ctx.expectP(1500, prevSuccessProbability)
+	ctx.expectP(2000, prevSuccessProbability)
+	ctx.expectP(3000, 0)
+
+	// An amount independent failure supersedes all previous successes.
+	ctx.reportFailure(0, lnwire.NewTemporaryChannelFailure(nil))
+	ctx.expectP(500, 0)
+}
+
 // TestMissionControlChannelUpdate tests that the first channel update is not
 // penalizing the channel yet.
 func TestMissionControlChannelUpdate(t *testing.T) {
@@ -201,3 +277,34 @@ func TestMissionControlChannelUpdate(t *testing.T) {
 	)
 	ctx.expectP(0, 0)
 }
+
+// TestMissionControlRelaxIntervals tests that failures and successes are no
+// longer taken into account once their relaxation interval has passed.
+func TestMissionControlRelaxIntervals(t *testing.T) {
+	ctx := createMcTestContext(t)
+	defer ctx.cleanup()
+
+	ctx.mc.cfg.FailureRelaxInterval = 2 * time.Hour
+	ctx.mc.cfg.SuccessRelaxInterval = time.Hour
+
+	ctx.now = testTime
+
+	// The failure decays as usual within its relaxation interval.
+	ctx.reportFailure(0, lnwire.NewTemporaryChannelFailure(nil))
+	ctx.expectP(1000, 0)
+
+	ctx.now = testTime.Add(30 * time.Minute)
+	ctx.expectP(1000, 0.4)
+
+	// Once the interval has passed, the edge is back at the a priori
+	// probability.
+	ctx.now = testTime.Add(2 * time.Hour)
+	ctx.expectP(1000, 0.8)
+
+	// A success raises the probability until its own interval has passed.
+	ctx.reportSuccess()
+	ctx.expectP(1000, prevSuccessProbability)
+
+	ctx.now = testTime.Add(3 * time.Hour)
+	ctx.expectP(1000, 0.8)
+}
@@ -252,12 +252,37 @@ func (m *mockControlTower) RegisterAttempt(phash lntypes.Hash,
 		return fmt.Errorf("not in flight")
 	}
 
-	p.Attempt = a
+	p.Attempts = append(p.Attempts, *a)
 	m.inflights[phash] = p
 
 	return nil
 }
 
+func (m *mockControlTower) FailAttempt(phash lntypes.Hash, attemptID uint64,
+	failInfo *channeldb.HTLCFailInfo) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	p, ok := m.inflights[phash]
+	if !ok {
+		return fmt.Errorf("not in flight")
+	}
+
+	for i, a := range p.Attempts {
+		if a.PaymentID != attemptID {
+			continue
+		}
+
+		p.Attempts = append(p.Attempts[:i:i], p.Attempts[i+1:]...)
+		m.inflights[phash] = p
+
+		return nil
+	}
+
+	return fmt.Errorf("attempt not found")
+}
+
 func (m *mockControlTower) Success(phash lntypes.Hash,
 	preimg lntypes.Preimage) error {
 
@@ -310,3 +335,9 @@ func (m *mockControlTower) SubscribePayment(paymentHash lntypes.Hash) (
 
 	return false, nil, errors.New("not implemented")
 }
+
+func (m *mockControlTower) SubscribePaymentUpdates(paymentHash lntypes.Hash) (
+	*PaymentUpdateSubscription, error) {
+
+	return nil, errors.New("not implemented")
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"image/color"
 	"net"
+	"sort"
 	"sync"
 	"sync/atomic"
 
@@ -16,6 +17,20 @@ import (
 	"github.com/go-errors/errors"
 )
 
+const (
+	// topologyBacklogSize is the number of most recent topology changes
+	// kept by the router so that clients can resume their subscription
+	// after a brief disconnect.
+	topologyBacklogSize = 1000
+)
+
+// ErrTopologyResumeUnavailable is returned when a topology client attempts to
+// resume its subscription from a point that is no longer (or was never) part
+// of the router's backlog of topology changes. The client should then fetch
+// the full graph again instead.
+var ErrTopologyResumeUnavailable = errors.New("topology changes since " +
+	"resume point no longer available")
+
 // TopologyClient represents an intent to receive notifications from the
 // channel router regarding changes to the topology of the channel graph. The
 // TopologyChanges channel will be sent upon with new updates to the channel
@@ -49,6 +64,14 @@ type topologyClientUpdate struct {
 	// ntfnChan is a *send-only* channel in which notifications should be
 	// sent over from router -> client.
 	ntfnChan chan<- *TopologyChange
+
+	// resumeSeq is the sequence number of the last topology change seen
+	// by the client. If non-zero, all changes after it are replayed to the
+	// client before any new ones.
+	resumeSeq uint64
+
+	// errChan is sent upon with the result of registering the client.
+	errChan chan error
 }
 
 // SubscribeTopology returns a new topology client which can be used by the
@@ -57,6 +80,19 @@ type topologyClientUpdate struct {
 // nodes appearing, node updating their attributes, new channels, channels
 // closing, and updates in the routing policies of a channel's directed edges.
 func (r *ChannelRouter) SubscribeTopology() (*TopologyClient, error) {
+	return r.SubscribeTopologyFrom(0)
+}
+
+// SubscribeTopologyFrom returns a new topology client like SubscribeTopology,
+// but first replays all topology changes since the one with the given
+// sequence number. This allows a client to resume its subscription after a
+// brief disconnect without fetching the full graph again. If the changes
+// since the resume point are no longer available, then
+// ErrTopologyResumeUnavailable is returned. A resume point of zero replays
+// nothing.
+func (r *ChannelRouter) SubscribeTopologyFrom(resumeSeq uint64) (
+	*TopologyClient, error) {
+
 	// If the router is not yet started, return an error to avoid a
 	// deadlock waiting for it to handle the subscription request.
 	if atomic.LoadUint32(&r.started) == 0 {
@@ -67,21 +103,33 @@ func (r *ChannelRouter) SubscribeTopology() (*TopologyClient, error) {
 	// incrementing client ID counter.
 	clientID := atomic.AddUint64(&r.ntfnClientCounter, 1)
 
-	log.Debugf("New graph topology client subscription, client %v",
-		clientID)
+	log.Debugf("New graph topology client subscription, client %v, "+
+		"resume_seq=%v", clientID, resumeSeq)
 
 	ntfnChan := make(chan *TopologyChange, 10)
+	errChan := make(chan error, 1)
 
 	select {
 	case r.ntfnClientUpdates <- &topologyClientUpdate{
-		cancel:   false,
-		clientID: clientID,
-		ntfnChan: ntfnChan,
+		cancel:    false,
+		clientID:  clientID,
+		ntfnChan:  ntfnChan,
+		resumeSeq: resumeSeq,
+		errChan:   errChan,
 	}:
 	case <-r.quit:
 		return nil, errors.New("ChannelRouter shutting down")
 	}
 
+	select {
+	case err := <-errChan:
+		if err != nil {
+			return nil, err
+		}
+	case <-r.quit:
+		return nil, errors.New("ChannelRouter shutting down")
+	}
+
 	return &TopologyClient{
 		TopologyChanges: ntfnChan,
 		Cancel: func() {
@@ -111,12 +159,92 @@ type topologyClient struct {
 	// cancel any active un-consumed goroutine notifications.
 	exit chan struct{}
 
+	// replayed is closed once all topology changes replayed to the client
+	// have been sent, so that new changes are only sent after them.
+	replayed chan struct{}
+
 	wg sync.WaitGroup
 }
 
+// registerTopologyClient adds a new topology client, replaying the changes
+// since its resume point if it requested any.
+func (r *ChannelRouter) registerTopologyClient(
+	update *topologyClientUpdate) error {
+
+	// The backlog mutex is held until the client is registered, so that
+	// every change is either replayed or sent as a new notification, but
+	// never both or neither.
+	r.topologyBacklogMtx.Lock()
+	defer r.topologyBacklogMtx.Unlock()
+
+	var replay []*TopologyChange
+	if update.resumeSeq != 0 && update.resumeSeq != r.topologySeq {
+		backlog := r.topologyBacklog
+		if update.resumeSeq > r.topologySeq || len(backlog) == 0 ||
+			backlog[0].Seq > update.resumeSeq+1 {
+
+			return ErrTopologyResumeUnavailable
+		}
+
+		i := sort.Search(len(backlog), func(i int) bool {
+			return backlog[i].Seq > update.resumeSeq
+		})
+		replay = make([]*TopologyChange, len(backlog)-i)
+		copy(replay, backlog[i:])
+	}
+
+	client := &topologyClient{
+		ntfnChan: update.ntfnChan,
+		exit:     make(chan struct{}),
+		replayed: make(chan struct{}),
+	}
+
+	r.Lock()
+	r.topologyClients[update.clientID] = client
+	r.Unlock()
+
+	if len(replay) == 0 {
+		close(client.replayed)
+		return nil
+	}
+
+	log.Debugf("Replaying %v topology changes to client %v", len(replay),
+		update.clientID)
+
+	client.wg.Add(1)
+	go func() {
+		defer client.wg.Done()
+		defer close(client.replayed)
+
+		for _, topologyDiff := range replay {
+			select {
+			case client.ntfnChan <- topologyDiff:
+			case <-client.exit:
+				return
+			case <-r.quit:
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
 // notifyTopologyChange notifies all registered clients of a new change in
-// graph topology in a non-blocking.
+// graph topology in a non-blocking. The change is assigned the next sequence
+// number and added to the backlog of changes available for replay.
 func (r *ChannelRouter) notifyTopologyChange(topologyDiff *TopologyChange) {
+	r.topologyBacklogMtx.Lock()
+	defer r.topologyBacklogMtx.Unlock()
+
+	r.topologySeq++
+	topologyDiff.Seq = r.topologySeq
+
+	r.topologyBacklog = append(r.topologyBacklog, topologyDiff)
+	if len(r.topologyBacklog) > topologyBacklogSize {
+		r.topologyBacklog = r.topologyBacklog[1:]
+	}
+
 	r.RLock()
 	numClients := len(r.topologyClients)
 	r.RUnlock()
@@ -140,6 +268,15 @@ func (r *ChannelRouter) notifyTopologyChange(topologyDiff *TopologyChange) {
 		go func(c *topologyClient) {
 			defer c.wg.Done()
 
+			// Wait for any replayed changes to be sent first.
+			select {
+			case <-c.replayed:
+			case <-c.exit:
+				return
+			case <-r.quit:
+				return
+			}
+
 			select {
 
 			// In this case we'll try to send the notification
@@ -164,6 +301,11 @@ func (r *ChannelRouter) notifyTopologyChange(topologyDiff *TopologyChange) {
 // Topology changes will be dispatched in real-time as the ChannelGraph
 // validates and process modifications to the authenticated channel graph.
 type TopologyChange struct {
+	// Seq is the sequence number of the change. It is strictly increasing
+	// for every change notified by the router, and can be used to resume a
+	// subscription through SubscribeTopologyFrom.
+	Seq uint64
+
 	// NodeUpdates is a slice of nodes which are either new to the channel
 	// graph, or have had their attributes updated in an authenticated
 	// manner.
@@ -398,3 +540,16 @@ func addToTopologyChange(graph *channeldb.ChannelGraph, update *TopologyChange,
 func EncodeHexColor(color color.RGBA) string {
 	return fmt.Sprintf("#%02x%02x%02x", color.R, color.G, color.B)
 }
+
+// DecodeHexColor takes a color in the hex code format returned by
+// EncodeHexColor and returns the color.
+func DecodeHexColor(colorStr string) (color.RGBA, error) {
+	var c color.RGBA
+	_, err := fmt.Sscanf(colorStr, "#%02x%02x%02x", &c.R, &c.G, &c.B)
+	if err != nil || len(colorStr) != 7 {
+		return color.RGBA{}, fmt.Errorf("invalid hex color %q, must be "+
+			"of the form #RRGGBB", colorStr)
+	}
+
+	return c, nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"image/color"
 	"net"
+	"sort"
 	"sync"
 	"testing"
 	"time"
@@ -932,6 +933,132 @@ func TestChannelCloseNotification(t *testing.T) {
 	}
 }
 
+// TestTopologyClientResume tests that a topology client can resume its
+// subscription from the sequence number of a previously received change, and
+// that resuming from unknown points fails.
+func TestTopologyClientResume(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+	ctx, cleanUp, err := createTestCtxSingleNode(startingBlockHeight)
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+
+	// We'll create a channel between two new nodes, so that their node
+	// announcements are accepted by the router.
+	const chanValue = 10000
+	fundingTx, _, chanID, err := createChannelEdge(ctx,
+		bitcoinKey1.SerializeCompressed(),
+		bitcoinKey2.SerializeCompressed(),
+		chanValue, startingBlockHeight)
+	if err != nil {
+		t.Fatalf("unable create channel edge: %v", err)
+	}
+	fundingBlock := &wire.MsgBlock{
+		Transactions: []*wire.MsgTx{fundingTx},
+	}
+	ctx.chain.addBlock(fundingBlock, chanID.BlockHeight, chanID.BlockHeight)
+
+	node1, err := createTestNode()
+	if err != nil {
+		t.Fatalf("unable to create test node: %v", err)
+	}
+	node2, err := createTestNode()
+	if err != nil {
+		t.Fatalf("unable to create test node: %v", err)
+	}
+
+	edge := &channeldb.ChannelEdgeInfo{
+		ChannelID:     chanID.ToUint64(),
+		NodeKey1Bytes: node1.PubKeyBytes,
+		NodeKey2Bytes: node2.PubKeyBytes,
+		AuthProof: &channeldb.ChannelAuthProof{
+			NodeSig1Bytes:   testSig.Serialize(),
+			NodeSig2Bytes:   testSig.Serialize(),
+			DecredSig1Bytes: testSig.Serialize(),
+			DecredSig2Bytes: testSig.Serialize(),
+		},
+	}
+	copy(edge.DecredKey1Bytes[:], bitcoinKey1.SerializeCompressed())
+	copy(edge.DecredKey2Bytes[:], bitcoinKey2.SerializeCompressed())
+	if err := ctx.router.AddEdge(edge); err != nil {
+		t.Fatalf("unable to add edge: %v", err)
+	}
+
+	ntfnClient, err := ctx.router.SubscribeTopology()
+	if err != nil {
+		t.Fatalf("unable to subscribe for channel notifications: %v", err)
+	}
+	defer ntfnClient.Cancel()
+
+	if err := ctx.router.AddNode(node1); err != nil {
+		t.Fatalf("unable to add node: %v", err)
+	}
+	if err := ctx.router.AddNode(node2); err != nil {
+		t.Fatalf("unable to add node: %v", err)
+	}
+
+	// The two node announcements should result in two changes with
+	// consecutive sequence numbers.
+	var seqs []uint64
+	for i := 0; i < 2; i++ {
+		select {
+		case ntfn := <-ntfnClient.TopologyChanges:
+			seqs = append(seqs, ntfn.Seq)
+
+		case <-time.After(time.Second * 5):
+			t.Fatal("node update not received")
+		}
+	}
+	sort.Slice(seqs, func(i, j int) bool { return seqs[i] < seqs[j] })
+	if seqs[1] != seqs[0]+1 {
+		t.Fatalf("expected consecutive sequence numbers, got %v", seqs)
+	}
+
+	// Resuming from the first change should replay only the second one.
+	resumedClient, err := ctx.router.SubscribeTopologyFrom(seqs[0])
+	if err != nil {
+		t.Fatalf("unable to resume subscription: %v", err)
+	}
+	defer resumedClient.Cancel()
+
+	select {
+	case ntfn := <-resumedClient.TopologyChanges:
+		if ntfn.Seq != seqs[1] {
+			t.Fatalf("expected replayed change %v, got %v", seqs[1],
+				ntfn.Seq)
+		}
+
+	case <-time.After(time.Second * 5):
+		t.Fatal("change not replayed")
+	}
+
+	// Resuming from the latest change is possible, but replays nothing.
+	upToDateClient, err := ctx.router.SubscribeTopologyFrom(seqs[1])
+	if err != nil {
+		t.Fatalf("unable to resume subscription: %v", err)
+	}
+	defer upToDateClient.Cancel()
+
+	select {
+	case ntfn := <-upToDateClient.TopologyChanges:
+		t.Fatalf("unexpected change replayed: %v", ntfn.Seq)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Resuming from a point that's too old or has not been reached yet
+	// should fail.
+	for _, seq := range []uint64{seqs[0] - 2, seqs[1] + 1} {
+		_, err := ctx.router.SubscribeTopologyFrom(seq)
+		if err != ErrTopologyResumeUnavailable {
+			t.Fatalf("expected ErrTopologyResumeUnavailable when "+
+				"resuming from %v, got %v", seq, err)
+		}
+	}
+}
+
 // TestEncodeHexColor tests that the string used to represent a node color is
 // correctly encoded.
 func TestEncodeHexColor(t *testing.T) {
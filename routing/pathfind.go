@@ -37,6 +37,12 @@ const (
 	RiskFactorBillionths = 15
 )
 
+// errNoTLVPayload is returned when the destination of a payment is known to
+// only understand legacy hop payloads, while the payment requires TLV records
+// to be delivered to it.
+var errNoTLVPayload = fmt.Errorf("destination hop doesn't understand new " +
+	"TLV payloads")
+
 // pathFinder defines the interface of a path finding algorithm.
 type pathFinder = func(g *graphParams, r *RestrictParams,
 	cfg *PathFindingConfig, source, target route.Vertex,
@@ -75,7 +81,7 @@ type edgePolicyWithSource struct {
 // the source to the target node of the path finding attempt.
 func newRoute(amtToSend lnwire.MilliAtom, sourceVertex route.Vertex,
 	pathEdges []*channeldb.ChannelEdgePolicy, currentHeight uint32,
-	finalCLTVDelta uint16,
+	finalCLTVDelta uint16, destFeatures *lnwire.FeatureVector,
 	finalDestRecords []tlv.Record) (*route.Route, error) {
 
 	var (
@@ -164,18 +170,27 @@ func newRoute(amtToSend lnwire.MilliAtom, sourceVertex route.Vertex,
 		// legacy payload, as if we don't have the full
 		// NodeAnnouncement information for this node, then we can't
 		// assume it knows the latest features. If we do have a feature
-		// vector for this node, then we'll update the info now.
-		if edge.Node.Features != nil {
-			features := edge.Node.Features
+		// vector for this node, then we'll update the info now. The
+		// final hop may be a private node that isn't in our graph, in
+		// which case we fall back to the features signaled in the
+		// invoice.
+		features := edge.Node.Features
+		if features == nil && i == len(pathEdges)-1 {
+			features = destFeatures
+		}
+		if features != nil {
 			currentHop.LegacyPayload = !features.HasFeature(
 				lnwire.TLVOnionPayloadOptional,
 			)
 		}
 
 		// If this is the last hop, then we'll populate any TLV records
-		// destined for it.
+		// destined for it. These can only be delivered within a TLV
+		// payload, so we'll use one even if we don't know the
+		// destination's features, as is the case for private nodes.
 		if i == len(pathEdges)-1 && len(finalDestRecords) != 0 {
 			currentHop.TLVRecords = finalDestRecords
+			currentHop.LegacyPayload = false
 		}
 
 		hops = append([]*route.Hop{currentHop}, hops...)
@@ -184,6 +199,17 @@ func newRoute(amtToSend lnwire.MilliAtom, sourceVertex route.Vertex,
 		// *next* hop, which is the amount this hop needs to forward,
 		// accounting for the fee that it takes.
 		nextIncomingAmount = amtToForward + fee
+
+		// The htlc extended to this hop must satisfy the minimum htlc
+		// amount of the channel it is extended over, as it would be
+		// rejected otherwise.
+		if nextIncomingAmount < edge.MinHTLC {
+			return nil, ErrHtlcBelowMinimum{
+				channelID: edge.ChannelID,
+				amt:       nextIncomingAmount,
+				minHtlc:   edge.MinHTLC,
+			}
+		}
 	}
 
 	// With the base routing data expressed as hops, build the full route
@@ -251,9 +277,13 @@ type RestrictParams struct {
 	// the source to the target.
 	FeeLimit lnwire.MilliAtom
 
-	// OutgoingChannelID is the channel that needs to be taken to the first
-	// hop. If nil, any channel may be used.
-	OutgoingChannelID *uint64
+	// OutgoingChannelIDs is the set of channels of which one needs to be
+	// taken to the first hop. If empty, any channel may be used.
+	OutgoingChannelIDs []uint64
+
+	// LastHop is the pubkey of the node that needs to be the last hop
+	// before the target. If nil, any node may be the last hop.
+	LastHop *route.Vertex
 
 	// CltvLimit is the maximum time lock of the route excluding the final
 	// ctlv. After path finding is complete, the caller needs to increase
@@ -264,6 +294,18 @@ type RestrictParams struct {
 	// payload at the final hop in order to properly complete this payment
 	// attempt.
 	DestPayloadTLV bool
+
+	// DestFeatures is the optional feature vector of the destination, as
+	// signaled in its invoice. It is used to determine whether the
+	// destination understands TLV payloads when it isn't part of our
+	// graph, or its node announcement is unknown.
+	DestFeatures *lnwire.FeatureVector
+
+	// EdgePenalty is an optional callback that returns an additional
+	// weight, expressed in milli-atoms, of traversing the channel from the
+	// node. A negative value makes the channel more attractive.
+	EdgePenalty func(fromNode, toNode route.Vertex, chanID uint64,
+		amt lnwire.MilliAtom) int64
 }
 
 // PathFindingConfig defines global parameters that control the trade-off in
@@ -278,6 +320,35 @@ type PathFindingConfig struct {
 	// MinProbability defines the minimum success probability of the
 	// returned route.
 	MinProbability float64
+
+	// AvoidList is the operator defined set of nodes and channels that
+	// path finding always excludes. If nil, no nodes or channels are
+	// excluded.
+	AvoidList *AvoidList
+
+	// ShadowRouteMinDelta is the minimum number of blocks the final CLTV
+	// delta of the payments requesting a shadow route is extended by.
+	ShadowRouteMinDelta uint16
+
+	// ShadowRouteMaxDelta is the maximum number of blocks the final CLTV
+	// delta of the payments requesting a shadow route is extended by.
+	ShadowRouteMaxDelta uint16
+
+	// MetricsObserver, if set, is called with the performance metrics of
+	// every path finding query, such as for exporting them.
+	MetricsObserver func(PathFindingMetrics)
+}
+
+// PathFindingMetrics holds the performance metrics of a path finding query.
+type PathFindingMetrics struct {
+	// NodesVisited is the number of nodes visited by the query.
+	NodesVisited int
+
+	// EdgesExpanded is the number of edges expanded by the query.
+	EdgesExpanded int
+
+	// Duration is how long the query took.
+	Duration time.Duration
 }
 
 // findPath attempts to find a path from the source node within the
@@ -305,6 +376,14 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		timeElapsed := time.Since(start)
 		log.Debugf("Pathfinding perf metrics: nodes=%v, edges=%v, "+
 			"time=%v", nodesVisited, edgesExpanded, timeElapsed)
+
+		if cfg.MetricsObserver != nil {
+			cfg.MetricsObserver(PathFindingMetrics{
+				NodesVisited:  nodesVisited,
+				EdgesExpanded: edgesExpanded,
+				Duration:      timeElapsed,
+			})
+		}
 	}()
 
 	var err error
@@ -327,6 +406,7 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 	// also returns the source node, so there is no need to add the source
 	// node explicitly.
 	distance := make(map[route.Vertex]nodeWithDist)
+	targetFeatures := r.DestFeatures
 	if err := g.graph.ForEachNode(tx, func(_ *bolt.Tx,
 		node *channeldb.LightningNode) error {
 		// TODO(roasbeef): with larger graph can just use disk seeks
@@ -337,26 +417,11 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 			node: route.Vertex(node.PubKeyBytes),
 		}
 
-		// If we don't have any features for this node, then we can
-		// stop here.
-		if node.Features == nil || !r.DestPayloadTLV {
-			return nil
-		}
-
-		// We only need to perform this check for the final node, so we
-		// can exit here if this isn't them.
-		if vertex != target {
-			return nil
-		}
-
-		// If we have any records for the final hop, then we'll check
-		// not to ensure that they are actually able to interpret them.
-		supportsTLV := node.Features.HasFeature(
-			lnwire.TLVOnionPayloadOptional,
-		)
-		if !supportsTLV {
-			return fmt.Errorf("destination hop doesn't " +
-				"understand new TLV paylods")
+		// The features of the final node found in our graph take
+		// precedence over the ones signaled in the invoice, as they
+		// are what its onion processing is based on.
+		if vertex == target && node.Features != nil {
+			targetFeatures = node.Features
 		}
 
 		return nil
@@ -364,6 +429,22 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		return nil, err
 	}
 
+	// If we have any records for the final hop, then we'll check now to
+	// ensure that they are actually able to interpret them. If we don't
+	// know any features for the destination, we optimistically attempt
+	// the payment anyway.
+	if r.DestPayloadTLV && targetFeatures != nil &&
+		!targetFeatures.HasFeature(lnwire.TLVOnionPayloadOptional) {
+
+		return nil, errNoTLVPayload
+	}
+
+	// The policies of the additional edges, which come from route hints,
+	// take precedence over the policies found in the graph for the same
+	// channels. The graph policy may be outdated, or unknown for a private
+	// channel, while the hint tells how the destination expects to be
+	// reached, so paths follow the policy of the hints.
+	hintedChans := make(map[uint64]route.Vertex)
 	additionalEdgesWithSrc := make(map[route.Vertex][]*edgePolicyWithSource)
 	for vertex, outgoingEdgePolicies := range g.additionalEdges {
 		// We'll also include all the nodes found within the additional
@@ -385,6 +466,8 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 			additionalEdgesWithSrc[toVertex] =
 				append(additionalEdgesWithSrc[toVertex],
 					incomingEdgePolicy)
+
+			hintedChans[outgoingEdgePolicy.ChannelID] = vertex
 		}
 	}
 
@@ -429,13 +512,35 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		}
 
 		// If we have an outgoing channel restriction and this is not
-		// the specified channel, skip it.
-		if isSourceChan && r.OutgoingChannelID != nil &&
-			*r.OutgoingChannelID != edge.ChannelID {
+		// one of the specified channels, skip it.
+		if isSourceChan && len(r.OutgoingChannelIDs) > 0 &&
+			!isOutgoingChannelAllowed(r.OutgoingChannelIDs,
+				edge.ChannelID) {
 
 			return
 		}
 
+		// If we have a last hop restriction and this edge leads into
+		// the target from any other node, skip it.
+		if r.LastHop != nil && toNode == target &&
+			fromVertex != *r.LastHop {
+
+			return
+		}
+
+		// Skip channels and nodes that the operator placed on the
+		// avoid list.
+		if cfg.AvoidList != nil {
+			if cfg.AvoidList.IsChannelAvoided(edge.ChannelID) {
+				return
+			}
+			if !isSourceChan &&
+				cfg.AvoidList.IsNodeAvoided(fromVertex) {
+
+				return
+			}
+		}
+
 		// Calculate amount that the candidate node would have to sent
 		// out.
 		toNodeDist := distance[toNode]
@@ -530,6 +635,20 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		// the HTLC that is handed out to fromVertex.
 		weight := edgeWeight(amountToReceive, fee, timeLockDelta)
 
+		// Adjust the weight by the penalty of the edge, if a penalty
+		// source was provided. As path finding requires non-negative
+		// weights, a bonus can at most cancel out the weight of the
+		// edge.
+		if r.EdgePenalty != nil {
+			weight += r.EdgePenalty(
+				fromVertex, toNode, edge.ChannelID,
+				amountToSend,
+			)
+			if weight < 0 {
+				weight = 0
+			}
+		}
+
 		// Compute the tentative weight to this new channel/edge
 		// which is the weight from our toNode to the target node
 		// plus the weight of this edge.
@@ -638,6 +757,14 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 				return err
 			}
 
+			// Skip the graph policy of a channel hinted in this
+			// direction, as the additional edge below applies the
+			// policy of the hint instead.
+			hintSource, ok := hintedChans[edgeInfo.ChannelID]
+			if ok && hintSource == chanSource {
+				return nil
+			}
+
 			// Check if this candidate node is better than what we
 			// already have.
 			processEdge(route.Vertex(chanSource), edgeBandwidth, inEdge, pivot)
@@ -653,12 +780,18 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		}
 
 		// Then, we'll examine all the additional edges from the node
-		// we're currently visiting. Since we don't know the capacity
-		// of the private channel, we'll assume it was selected as a
-		// routing hint due to having enough capacity for the payment
-		// and use the payment amount as its capacity.
-		bandWidth := partialPath.amountToReceive
+		// we're currently visiting. Unless it is one of our own
+		// channels, we don't know the capacity of the private
+		// channel, so we'll assume it was selected as a routing hint
+		// due to having enough capacity for the payment and use the
+		// payment amount as its capacity.
 		for _, reverseEdge := range additionalEdgesWithSrc[pivot] {
+			chanID := reverseEdge.edge.ChannelID
+			bandWidth, ok := g.bandwidthHints[chanID]
+			if !ok || reverseEdge.sourceNode != source {
+				bandWidth = partialPath.amountToReceive
+			}
+
 			processEdge(reverseEdge.sourceNode, bandWidth,
 				reverseEdge.edge, pivot)
 		}
@@ -746,3 +879,15 @@ func getProbabilityBasedDist(weight int64, probability float64, penalty int64) i
 
 	return weight + int64(float64(penalty)/probability)
 }
+
+// isOutgoingChannelAllowed returns whether the channel is part of the passed
+// set of allowed outgoing channels.
+func isOutgoingChannelAllowed(allowed []uint64, chanID uint64) bool {
+	for _, id := range allowed {
+		if id == chanID {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,513 @@
+package routing
+
+import (
+	"bytes"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"math/rand"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrec/secp256k1/v2"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/routing/route"
+)
+
+const (
+	// benchPaymentAmt is the amount of the payments paths are searched
+	// for in the benchmarks. It is lower than the capacity of any
+	// synthetic channel, so that every target is reachable.
+	benchPaymentAmt = lnwire.MilliAtom(10000 * 1000)
+
+	// benchNumTargets is the number of distinct targets paths are searched
+	// to, so that the results don't depend on a single lucky target.
+	benchNumTargets = 16
+
+	// maxAllocsPerChannel is the budget of heap allocations of a single
+	// path finding attempt, relative to the number of channels in the
+	// graph.
+	maxAllocsPerChannel = 500
+
+	// maxPathFindingLatency is the budget of the average latency of a
+	// path finding attempt within the graph of the budget test.
+	maxPathFindingLatency = 2 * time.Second
+)
+
+var (
+	// The flags below allow benchmarking path finding within a graph of
+	// the given shape rather than the default ones, e.g.:
+	//
+	//   go test -run=none -bench=FindPath ./routing -pathfind.nodes=20000
+	benchNodes = flag.Int("pathfind.nodes", 0, "number of nodes of the "+
+		"synthetic graph to benchmark path finding in")
+	benchDegree = flag.Int("pathfind.degree", 4, "average number of "+
+		"channels per node of the synthetic graph")
+	benchDistribution = flag.String("pathfind.distribution", "scalefree",
+		"degree distribution of the synthetic graph, either uniform "+
+			"or scalefree")
+)
+
+// degreeDistribution describes how the channels of a synthetic graph are
+// spread among its nodes.
+type degreeDistribution uint8
+
+const (
+	// uniformDegree connects the channels to nodes chosen uniformly at
+	// random, so that most nodes have a degree close to the average one.
+	uniformDegree degreeDistribution = iota
+
+	// scaleFreeDegree connects the channels through preferential
+	// attachment, yielding a few highly connected hubs and many leaves,
+	// which is closer to the shape of the real network.
+	scaleFreeDegree
+)
+
+// String returns a human readable name of the distribution.
+func (d degreeDistribution) String() string {
+	switch d {
+	case uniformDegree:
+		return "uniform"
+	case scaleFreeDegree:
+		return "scalefree"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(d))
+	}
+}
+
+// parseDegreeDistribution returns the distribution of the given name.
+func parseDegreeDistribution(name string) (degreeDistribution, error) {
+	for _, d := range []degreeDistribution{uniformDegree, scaleFreeDegree} {
+		if d.String() == name {
+			return d, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown degree distribution: %v", name)
+}
+
+// syntheticGraphConfig describes the shape of a generated graph.
+type syntheticGraphConfig struct {
+	// numNodes is the number of nodes of the graph.
+	numNodes int
+
+	// avgDegree is the average number of channels per node.
+	avgDegree int
+
+	// distribution is the way channels are spread among the nodes.
+	distribution degreeDistribution
+
+	// seed seeds the generator, so that the same configuration always
+	// yields the same graph.
+	seed int64
+}
+
+// String returns a name of the configuration suitable for sub-benchmarks.
+func (c syntheticGraphConfig) String() string {
+	return fmt.Sprintf("%v/nodes=%d/degree=%d", c.distribution, c.numNodes,
+		c.avgDegree)
+}
+
+// syntheticGraph is a graph generated from a syntheticGraphConfig.
+type syntheticGraph struct {
+	graph   *channeldb.ChannelGraph
+	cleanUp func()
+
+	// source is the node paths are searched from.
+	source route.Vertex
+
+	// targets are the nodes paths are searched to.
+	targets []route.Vertex
+
+	numChannels int
+}
+
+// generateSyntheticGraph creates a graph database of the given shape. All the
+// nodes are reachable from the source node, and every channel has policies in
+// both directions able to carry benchPaymentAmt.
+func generateSyntheticGraph(cfg syntheticGraphConfig) (*syntheticGraph, error) {
+	if cfg.numNodes < 2 || cfg.avgDegree < 1 {
+		return nil, fmt.Errorf("invalid synthetic graph: %v", cfg)
+	}
+
+	graph, cleanUp, err := makeTestGraph()
+	if err != nil {
+		return nil, err
+	}
+
+	// Durability is irrelevant to the generated graph, and syncing every
+	// update would make the generation of large graphs unbearably slow.
+	graph.Database().NoSync = true
+
+	success := false
+	defer func() {
+		if !success {
+			cleanUp()
+		}
+	}()
+
+	testAddr, err := net.ResolveTCPAddr("tcp", "192.0.0.1:8888")
+	if err != nil {
+		return nil, err
+	}
+
+	r := rand.New(rand.NewSource(cfg.seed))
+
+	nodes := make([]route.Vertex, cfg.numNodes)
+	for i := range nodes {
+		var keyBytes [32]byte
+		binary.BigEndian.PutUint64(keyBytes[24:], uint64(i+1))
+		_, pubKey := secp256k1.PrivKeyFromBytes(keyBytes[:])
+
+		dbNode := &channeldb.LightningNode{
+			HaveNodeAnnouncement: true,
+			AuthSigBytes:         testSig.Serialize(),
+			LastUpdate:           testTime,
+			Addresses:            []net.Addr{testAddr},
+			Alias:                fmt.Sprintf("node%d", i),
+			Features:             testFeatures,
+		}
+		copy(dbNode.PubKeyBytes[:], pubKey.SerializeCompressed())
+
+		if err := graph.AddLightningNode(dbNode); err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			if err := graph.SetSourceNode(dbNode); err != nil {
+				return nil, err
+			}
+		}
+
+		nodes[i] = dbNode.PubKeyBytes
+	}
+
+	var numChannels int
+	addChannel := func(a, b int) error {
+		numChannels++
+		return addSyntheticChannel(
+			graph, r, uint64(numChannels), nodes[a], nodes[b],
+		)
+	}
+
+	switch cfg.distribution {
+	case uniformDegree:
+		err = generateUniformChannels(cfg, r, addChannel)
+	case scaleFreeDegree:
+		err = generateScaleFreeChannels(cfg, r, addChannel)
+	default:
+		err = fmt.Errorf("unknown degree distribution: %v",
+			cfg.distribution)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make([]route.Vertex, benchNumTargets)
+	for i := range targets {
+		targets[i] = nodes[1+r.Intn(cfg.numNodes-1)]
+	}
+
+	success = true
+	return &syntheticGraph{
+		graph:       graph,
+		cleanUp:     cleanUp,
+		source:      nodes[0],
+		targets:     targets,
+		numChannels: numChannels,
+	}, nil
+}
+
+// channelKey identifies the pair of nodes of a channel, regardless of its
+// direction.
+func channelKey(a, b int) [2]int {
+	if a > b {
+		a, b = b, a
+	}
+	return [2]int{a, b}
+}
+
+// generateUniformChannels connects every node to a random previous one, so
+// that the graph is connected, then adds channels between random pairs of
+// nodes until the average degree is reached.
+func generateUniformChannels(cfg syntheticGraphConfig, r *rand.Rand,
+	addChannel func(a, b int) error) error {
+
+	targetChannels := cfg.numNodes * cfg.avgDegree / 2
+	maxChannels := cfg.numNodes * (cfg.numNodes - 1) / 2
+	if targetChannels > maxChannels {
+		targetChannels = maxChannels
+	}
+
+	channels := make(map[[2]int]struct{}, targetChannels)
+	for i := 1; i < cfg.numNodes; i++ {
+		j := r.Intn(i)
+		channels[channelKey(i, j)] = struct{}{}
+		if err := addChannel(i, j); err != nil {
+			return err
+		}
+	}
+
+	for len(channels) < targetChannels {
+		a, b := r.Intn(cfg.numNodes), r.Intn(cfg.numNodes)
+		if a == b {
+			continue
+		}
+		if _, ok := channels[channelKey(a, b)]; ok {
+			continue
+		}
+
+		channels[channelKey(a, b)] = struct{}{}
+		if err := addChannel(a, b); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// generateScaleFreeChannels connects the nodes through preferential
+// attachment: every new node opens channels to existing nodes chosen with a
+// probability proportional to their degree.
+func generateScaleFreeChannels(cfg syntheticGraphConfig, r *rand.Rand,
+	addChannel func(a, b int) error) error {
+
+	m := cfg.avgDegree / 2
+	if m < 1 {
+		m = 1
+	}
+	if m >= cfg.numNodes {
+		m = cfg.numNodes - 1
+	}
+
+	// endpoints holds every node once per channel it is part of, so that
+	// picking a random entry picks a node proportionally to its degree.
+	var endpoints []int
+
+	// The initial m+1 nodes are fully connected.
+	for i := 0; i <= m; i++ {
+		for j := 0; j < i; j++ {
+			if err := addChannel(i, j); err != nil {
+				return err
+			}
+			endpoints = append(endpoints, i, j)
+		}
+	}
+
+	for i := m + 1; i < cfg.numNodes; i++ {
+		peers := make(map[int]struct{}, m)
+		for len(peers) < m {
+			peers[endpoints[r.Intn(len(endpoints))]] = struct{}{}
+		}
+
+		for j := 0; j < i; j++ {
+			if _, ok := peers[j]; !ok {
+				continue
+			}
+
+			if err := addChannel(i, j); err != nil {
+				return err
+			}
+			endpoints = append(endpoints, i, j)
+		}
+	}
+
+	return nil
+}
+
+// addSyntheticChannel adds a channel between the two nodes to the graph, along
+// with random policies in both directions.
+func addSyntheticChannel(graph *channeldb.ChannelGraph, r *rand.Rand,
+	channelID uint64, node1, node2 route.Vertex) error {
+
+	if bytes.Compare(node1[:], node2[:]) == 1 {
+		node1, node2 = node2, node1
+	}
+
+	var hash chainhash.Hash
+	binary.BigEndian.PutUint64(hash[:], channelID)
+
+	capacity := dcrutil.Amount(100000 + r.Int63n(100000000))
+
+	edgeInfo := &channeldb.ChannelEdgeInfo{
+		ChannelID:    channelID,
+		AuthProof:    &testAuthProof,
+		ChannelPoint: wire.OutPoint{Hash: hash},
+		Capacity:     capacity,
+
+		NodeKey1Bytes:   node1,
+		DecredKey1Bytes: node1,
+		NodeKey2Bytes:   node2,
+		DecredKey2Bytes: node2,
+	}
+	if err := graph.AddChannelEdge(edgeInfo); err != nil {
+		return err
+	}
+
+	for _, direction := range []lnwire.ChanUpdateChanFlags{
+		0, lnwire.ChanUpdateDirection,
+	} {
+		edgePolicy := &channeldb.ChannelEdgePolicy{
+			SigBytes:      testSig.Serialize(),
+			MessageFlags:  lnwire.ChanUpdateOptionMaxHtlc,
+			ChannelFlags:  direction,
+			ChannelID:     channelID,
+			LastUpdate:    testTime,
+			TimeLockDelta: uint16(40 + r.Intn(105)),
+			MinHTLC:       1000,
+			MaxHTLC:       lnwire.NewMAtomsFromAtoms(capacity),
+			FeeBaseMAtoms: lnwire.MilliAtom(r.Intn(1001)),
+			FeeProportionalMillionths: lnwire.MilliAtom(
+				1 + r.Intn(1000),
+			),
+		}
+		if err := graph.UpdateEdgePolicy(edgePolicy); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findSyntheticPath finds a path from the source of the synthetic graph to
+// its i-th target.
+func (g *syntheticGraph) findPath(i int) error {
+	_, err := findPath(
+		&graphParams{graph: g.graph}, noRestrictions,
+		testPathFindingConfig, g.source, g.targets[i%len(g.targets)],
+		benchPaymentAmt,
+	)
+	return err
+}
+
+// benchmarkGraphConfigs returns the shapes of the graphs to benchmark path
+// finding in, which are either given through the flags or a default matrix
+// of sizes and distributions.
+func benchmarkGraphConfigs() ([]syntheticGraphConfig, error) {
+	if *benchNodes > 0 {
+		distribution, err := parseDegreeDistribution(
+			*benchDistribution,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return []syntheticGraphConfig{{
+			numNodes:     *benchNodes,
+			avgDegree:    *benchDegree,
+			distribution: distribution,
+			seed:         1,
+		}}, nil
+	}
+
+	var configs []syntheticGraphConfig
+	for _, distribution := range []degreeDistribution{
+		uniformDegree, scaleFreeDegree,
+	} {
+		for _, numNodes := range []int{100, 1000, 5000} {
+			configs = append(configs, syntheticGraphConfig{
+				numNodes:     numNodes,
+				avgDegree:    4,
+				distribution: distribution,
+				seed:         1,
+			})
+		}
+	}
+
+	return configs, nil
+}
+
+// BenchmarkFindPath measures the latency and allocations of path finding
+// within synthetic graphs of various shapes.
+func BenchmarkFindPath(b *testing.B) {
+	configs, err := benchmarkGraphConfigs()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	for _, cfg := range configs {
+		cfg := cfg
+
+		// A benchmark running sub-benchmarks is only run once, so the
+		// graph is generated here rather than for every b.N.
+		g, err := generateSyntheticGraph(cfg)
+		if err != nil {
+			b.Fatalf("unable to generate graph %v: %v", cfg, err)
+		}
+
+		b.Run(cfg.String(), func(b *testing.B) {
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				if err := g.findPath(i); err != nil {
+					b.Fatalf("unable to find path: %v", err)
+				}
+			}
+		})
+
+		g.cleanUp()
+	}
+}
+
+// TestPathFindingBudget asserts that path finding within a synthetic graph
+// stays within the allocation and latency budgets, so that performance
+// regressions of the routing code are caught.
+func TestPathFindingBudget(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping path finding budget test in short mode")
+	}
+
+	cfg := syntheticGraphConfig{
+		numNodes:     1000,
+		avgDegree:    4,
+		distribution: scaleFreeDegree,
+		seed:         1,
+	}
+	g, err := generateSyntheticGraph(cfg)
+	if err != nil {
+		t.Fatalf("unable to generate graph %v: %v", cfg, err)
+	}
+	defer g.cleanUp()
+
+	// Every target must be reachable, which also warms up the database
+	// before anything is measured.
+	for i := range g.targets {
+		if err := g.findPath(i); err != nil {
+			t.Fatalf("unable to find path to target %d: %v", i, err)
+		}
+	}
+
+	target := 0
+	allocs := testing.AllocsPerRun(len(g.targets), func() {
+		g.findPath(target)
+		target++
+	})
+	allocsPerChannel := allocs / float64(g.numChannels)
+	if allocsPerChannel > maxAllocsPerChannel {
+		t.Fatalf("path finding within %v made %.0f allocations, "+
+			"%.1f per channel, exceeding the budget of %d per "+
+			"channel", cfg, allocs, allocsPerChannel,
+			maxAllocsPerChannel)
+	}
+
+	start := time.Now()
+	for i := range g.targets {
+		if err := g.findPath(i); err != nil {
+			t.Fatalf("unable to find path to target %d: %v", i, err)
+		}
+	}
+	latency := time.Since(start) / time.Duration(len(g.targets))
+	if latency > maxPathFindingLatency {
+		t.Fatalf("path finding within %v took %v on average, "+
+			"exceeding the budget of %v", cfg, latency,
+			maxPathFindingLatency)
+	}
+
+	t.Logf("path finding within %v: %.0f allocations, %v on average", cfg,
+		allocs, latency)
+}
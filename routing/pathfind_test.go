@@ -652,7 +652,7 @@ func TestFindLowestFeePath(t *testing.T) {
 	}
 	route, err := newRoute(
 		paymentAmt, sourceVertex, path, startingHeight,
-		finalHopCLTV, nil,
+		finalHopCLTV, nil, nil,
 	)
 	if err != nil {
 		t.Fatalf("unable to create path: %v", err)
@@ -803,7 +803,7 @@ func testBasicGraphPathFindingCase(t *testing.T, graphInstance *testGraphInstanc
 
 	route, err := newRoute(
 		paymentAmt, sourceVertex, path, startingHeight,
-		finalHopCLTV, nil,
+		finalHopCLTV, nil, nil,
 	)
 	if err != nil {
 		t.Fatalf("unable to create path: %v", err)
@@ -1179,7 +1179,7 @@ func TestNewRoute(t *testing.T) {
 			route, err := newRoute(
 				testCase.paymentAmount, sourceVertex,
 				testCase.hops, startingHeight, finalHopCLTV,
-				nil,
+				nil, nil,
 			)
 
 			if testCase.expectError {
@@ -1202,6 +1202,180 @@ func TestNewRoute(t *testing.T) {
 	}
 }
 
+// TestNewRouteHtlcMinimum asserts that a route isn't built if the amount that
+// would be extended to one of its hops is below the minimum htlc amount of the
+// channel it would be extended over.
+func TestNewRouteHtlcMinimum(t *testing.T) {
+	t.Parallel()
+
+	var sourceVertex route.Vertex
+
+	// The first channel has a minimum that is only satisfied once the fee
+	// charged by the first hop is added to the amount.
+	hops := []*channeldb.ChannelEdgePolicy{
+		{
+			Node:      &channeldb.LightningNode{},
+			ChannelID: 1,
+			MinHTLC:   1100,
+		},
+		{
+			Node:          &channeldb.LightningNode{},
+			ChannelID:     2,
+			FeeBaseMAtoms: 100,
+			MinHTLC:       900,
+		},
+	}
+
+	_, err := newRoute(1000, sourceVertex, hops, 100, 1, nil, nil)
+	if err != nil {
+		t.Fatalf("unable to create route: %v", err)
+	}
+
+	// Below the minimum of the second channel, the route should be
+	// rejected.
+	_, err = newRoute(800, sourceVertex, hops, 100, 1, nil, nil)
+	belowMin, ok := err.(ErrHtlcBelowMinimum)
+	if !ok {
+		t.Fatalf("expected htlc minimum error, got %v", err)
+	}
+	if belowMin.channelID != 2 {
+		t.Fatalf("expected channel 2 to be binding, got %v",
+			belowMin.channelID)
+	}
+
+	// Above the minimum of the second channel but not of the first one
+	// once the fee is added, the first channel should be binding.
+	hops[1].MinHTLC = 0
+	_, err = newRoute(900, sourceVertex, hops, 100, 1, nil, nil)
+	belowMin, ok = err.(ErrHtlcBelowMinimum)
+	if !ok {
+		t.Fatalf("expected htlc minimum error, got %v", err)
+	}
+	if belowMin.channelID != 1 || belowMin.amt != 1000 {
+		t.Fatalf("unexpected htlc minimum error: %v", belowMin)
+	}
+}
+
+// TestNewRouteDestFeatures asserts that the final hop of a route uses a TLV
+// payload when the destination isn't in the graph, but signaled support for
+// it in its invoice.
+func TestNewRouteDestFeatures(t *testing.T) {
+	t.Parallel()
+
+	var sourceVertex route.Vertex
+	hops := []*channeldb.ChannelEdgePolicy{
+		{
+			Node: &channeldb.LightningNode{
+				Features: lnwire.NewFeatureVector(
+					lnwire.NewRawFeatureVector(
+						lnwire.TLVOnionPayloadOptional,
+					), lnwire.GlobalFeatures,
+				),
+			},
+			ChannelID: 1,
+		},
+		{
+			Node:      &channeldb.LightningNode{},
+			ChannelID: 2,
+		},
+	}
+
+	// Without any features for the destination, it is assumed to only
+	// understand legacy payloads.
+	rt, err := newRoute(1000, sourceVertex, hops, 100, 1, nil, nil)
+	if err != nil {
+		t.Fatalf("unable to create route: %v", err)
+	}
+	if rt.Hops[0].LegacyPayload || !rt.Hops[1].LegacyPayload {
+		t.Fatalf("unexpected payload types: %v, %v",
+			rt.Hops[0].LegacyPayload, rt.Hops[1].LegacyPayload)
+	}
+
+	destFeatures := lnwire.NewFeatureVector(
+		lnwire.NewRawFeatureVector(lnwire.TLVOnionPayloadOptional),
+		lnwire.GlobalFeatures,
+	)
+	rt, err = newRoute(1000, sourceVertex, hops, 100, 1, destFeatures, nil)
+	if err != nil {
+		t.Fatalf("unable to create route: %v", err)
+	}
+	if rt.Hops[0].LegacyPayload || rt.Hops[1].LegacyPayload {
+		t.Fatalf("expected tlv payloads for all hops")
+	}
+}
+
+// TestDestPayloadTLV asserts that path finding refuses to route to a
+// destination known to only understand legacy payloads when TLV records must
+// be delivered to it.
+func TestDestPayloadTLV(t *testing.T) {
+	t.Parallel()
+
+	testChannels := []*testChannel{
+		symmetricTestChannel("roasbeef", "a", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 400,
+			MinHTLC: 1,
+		}, 1),
+		symmetricTestChannel("a", "target", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 400,
+			MinHTLC: 1,
+		}, 2),
+	}
+
+	testGraphInstance, err := createTestGraphFromChannels(
+		testChannels, "roasbeef",
+	)
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+	defer testGraphInstance.cleanUp()
+
+	sourceNode, err := testGraphInstance.graph.SourceNode()
+	if err != nil {
+		t.Fatalf("unable to fetch source node: %v", err)
+	}
+	sourceVertex := route.Vertex(sourceNode.PubKeyBytes)
+
+	paymentAmt := lnwire.NewMAtomsFromAtoms(100)
+	target := testGraphInstance.aliasMap["target"]
+
+	find := func(destPayloadTLV bool) error {
+		_, err := findPath(
+			&graphParams{
+				graph: testGraphInstance.graph,
+			},
+			&RestrictParams{
+				FeeLimit:          noFeeLimit,
+				ProbabilitySource: noProbabilitySource,
+				CltvLimit:         math.MaxUint32,
+				DestPayloadTLV:    destPayloadTLV,
+
+				// The features of the target found in the
+				// graph take precedence over these.
+				DestFeatures: lnwire.NewFeatureVector(
+					lnwire.NewRawFeatureVector(
+						lnwire.TLVOnionPayloadOptional,
+					), lnwire.GlobalFeatures,
+				),
+			},
+			testPathFindingConfig,
+			sourceVertex, target, paymentAmt,
+		)
+		return err
+	}
+
+	if err := find(false); err != nil {
+		t.Fatalf("unable to find path: %v", err)
+	}
+
+	// The target doesn't advertise support for TLV payloads in the graph,
+	// so it must be refused once records need to be delivered to it.
+	if err := find(true); err != errNoTLVPayload {
+		t.Fatalf("expected errNoTLVPayload, got %v", err)
+	}
+}
+
 func TestNewRoutePathTooLong(t *testing.T) {
 	t.Skip()
 
@@ -1911,10 +2085,10 @@ func TestRestrictOutgoingChannel(t *testing.T) {
 			graph: testGraphInstance.graph,
 		},
 		&RestrictParams{
-			FeeLimit:          noFeeLimit,
-			OutgoingChannelID: &outgoingChannelID,
-			ProbabilitySource: noProbabilitySource,
-			CltvLimit:         math.MaxUint32,
+			FeeLimit:           noFeeLimit,
+			OutgoingChannelIDs: []uint64{outgoingChannelID},
+			ProbabilitySource:  noProbabilitySource,
+			CltvLimit:          math.MaxUint32,
 		},
 		testPathFindingConfig,
 		sourceVertex, target, paymentAmt,
@@ -1924,7 +2098,7 @@ func TestRestrictOutgoingChannel(t *testing.T) {
 	}
 	route, err := newRoute(
 		paymentAmt, sourceVertex, path, startingHeight,
-		finalHopCLTV, nil,
+		finalHopCLTV, nil, nil,
 	)
 	if err != nil {
 		t.Fatalf("unable to create path: %v", err)
@@ -1938,6 +2112,81 @@ func TestRestrictOutgoingChannel(t *testing.T) {
 	}
 }
 
+// TestRestrictLastHop asserts that a last hop restriction is obeyed by the
+// path finding algorithm.
+func TestRestrictLastHop(t *testing.T) {
+	t.Parallel()
+
+	// Set up a test graph with two possible paths from roasbeef to target.
+	// The path through b is the highest cost path.
+	testChannels := []*testChannel{
+		symmetricTestChannel("roasbeef", "a", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 400,
+			MinHTLC: 1,
+		}, 1),
+		symmetricTestChannel("a", "target", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 400,
+			MinHTLC: 1,
+		}, 2),
+		symmetricTestChannel("roasbeef", "b", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 800,
+			MinHTLC: 1,
+		}, 3),
+		symmetricTestChannel("b", "target", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 800,
+			MinHTLC: 1,
+		}, 4),
+	}
+
+	testGraphInstance, err := createTestGraphFromChannels(
+		testChannels, "roasbeef",
+	)
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+	defer testGraphInstance.cleanUp()
+
+	sourceNode, err := testGraphInstance.graph.SourceNode()
+	if err != nil {
+		t.Fatalf("unable to fetch source node: %v", err)
+	}
+	sourceVertex := route.Vertex(sourceNode.PubKeyBytes)
+
+	paymentAmt := lnwire.NewMAtomsFromAtoms(100)
+	target := testGraphInstance.aliasMap["target"]
+	lastHop := testGraphInstance.aliasMap["b"]
+
+	// Find the best path given the restriction to only reach the target
+	// through b.
+	path, err := findPath(
+		&graphParams{
+			graph: testGraphInstance.graph,
+		},
+		&RestrictParams{
+			FeeLimit:          noFeeLimit,
+			LastHop:           &lastHop,
+			ProbabilitySource: noProbabilitySource,
+			CltvLimit:         math.MaxUint32,
+		},
+		testPathFindingConfig,
+		sourceVertex, target, paymentAmt,
+	)
+	if err != nil {
+		t.Fatalf("unable to find path: %v", err)
+	}
+
+	// Assert that the path reaches the target through channel 4, in line
+	// with the specified restriction.
+	if len(path) != 2 || path[1].ChannelID != 4 {
+		t.Fatalf("expected path to end with channel 4, but got %v",
+			path)
+	}
+}
+
 // TestCltvLimit asserts that a cltv limit is obeyed by the path finding
 // algorithm.
 func TestCltvLimit(t *testing.T) {
@@ -2026,7 +2275,7 @@ func testCltvLimit(t *testing.T, limit uint32, expectedChannel uint64) {
 	)
 	route, err := newRoute(
 		paymentAmt, sourceVertex, path, startingHeight, finalHopCLTV,
-		nil,
+		nil, nil,
 	)
 	if err != nil {
 		t.Fatalf("unable to create path: %v", err)
@@ -2213,3 +2462,141 @@ func testProbabilityRouting(t *testing.T, p10, p11, p20, minProbability float64,
 			path[1].ChannelID)
 	}
 }
+
+// TestPathFindingMetricsObserver asserts that the metrics observer of the path
+// finding config is called with the performance metrics of the queries.
+func TestPathFindingMetricsObserver(t *testing.T) {
+	t.Parallel()
+
+	graph, err := parseTestGraph(basicGraphFilePath)
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+	defer graph.cleanUp()
+
+	sourceNode, err := graph.graph.SourceNode()
+	if err != nil {
+		t.Fatalf("unable to fetch source node: %v", err)
+	}
+
+	var metrics []PathFindingMetrics
+	cfg := &PathFindingConfig{
+		MetricsObserver: func(m PathFindingMetrics) {
+			metrics = append(metrics, m)
+		},
+	}
+
+	_, err = findPath(
+		&graphParams{
+			graph: graph.graph,
+		},
+		noRestrictions, cfg, sourceNode.PubKeyBytes,
+		graph.aliasMap["sophon"], lnwire.NewMAtomsFromAtoms(100),
+	)
+	if err != nil {
+		t.Fatalf("unable to find path: %v", err)
+	}
+
+	if len(metrics) != 1 {
+		t.Fatalf("expected metrics of 1 query, got %v", len(metrics))
+	}
+	if metrics[0].NodesVisited == 0 || metrics[0].EdgesExpanded == 0 {
+		t.Fatalf("expected nodes and edges to be visited, got %+v",
+			metrics[0])
+	}
+}
+
+// TestEdgePenalty asserts that the penalties returned by the edge penalty
+// source are taken into account by path finding.
+func TestEdgePenalty(t *testing.T) {
+	t.Parallel()
+
+	// Set up a test graph with two possible paths from roasbeef to
+	// target. The path through b is the most expensive one.
+	testChannels := []*testChannel{
+		symmetricTestChannel("roasbeef", "a", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 400,
+			MinHTLC: 1,
+		}, 1),
+		symmetricTestChannel("a", "target", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 400,
+			MinHTLC: 1,
+		}, 2),
+		symmetricTestChannel("roasbeef", "b", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 800,
+			MinHTLC: 1,
+		}, 3),
+		symmetricTestChannel("b", "target", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 800,
+			MinHTLC: 1,
+		}, 4),
+	}
+
+	testGraphInstance, err := createTestGraphFromChannels(
+		testChannels, "roasbeef",
+	)
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+	defer testGraphInstance.cleanUp()
+
+	sourceNode, err := testGraphInstance.graph.SourceNode()
+	if err != nil {
+		t.Fatalf("unable to fetch source node: %v", err)
+	}
+	sourceVertex := route.Vertex(sourceNode.PubKeyBytes)
+
+	paymentAmt := lnwire.NewMAtomsFromAtoms(100)
+	target := testGraphInstance.aliasMap["target"]
+
+	findFirstHop := func(penalties map[uint64]int64) uint64 {
+		t.Helper()
+
+		edgePenalty := func(_, _ route.Vertex, chanID uint64,
+			_ lnwire.MilliAtom) int64 {
+
+			return penalties[chanID]
+		}
+
+		path, err := findPath(
+			&graphParams{
+				graph: testGraphInstance.graph,
+			},
+			&RestrictParams{
+				FeeLimit:          noFeeLimit,
+				ProbabilitySource: noProbabilitySource,
+				CltvLimit:         math.MaxUint32,
+				EdgePenalty:       edgePenalty,
+			},
+			testPathFindingConfig,
+			sourceVertex, target, paymentAmt,
+		)
+		if err != nil {
+			t.Fatalf("unable to find path: %v", err)
+		}
+
+		return path[0].ChannelID
+	}
+
+	// Without penalties, the cheapest path through a is selected.
+	if chanID := findFirstHop(nil); chanID != 1 {
+		t.Fatalf("expected path through channel 1, got %v", chanID)
+	}
+
+	// Penalizing the channel from a to target makes the path through b
+	// the best one.
+	penalties := map[uint64]int64{2: 1000000}
+	if chanID := findFirstHop(penalties); chanID != 3 {
+		t.Fatalf("expected path through channel 3, got %v", chanID)
+	}
+
+	// A bonus on the path through b has the same effect.
+	penalties = map[uint64]int64{4: -1000000}
+	if chanID := findFirstHop(penalties); chanID != 3 {
+		t.Fatalf("expected path through channel 3, got %v", chanID)
+	}
+}
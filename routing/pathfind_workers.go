@@ -0,0 +1,158 @@
+package routing
+
+import (
+	"sync"
+	"time"
+
+	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/routing/route"
+)
+
+const (
+	// DefaultPathFindingWorkers is the default number of path finding
+	// queries made through FindRoute that are processed concurrently.
+	DefaultPathFindingWorkers = 4
+
+	// bandwidthSnapshotInterval is the interval during which path finding
+	// queries made through FindRoute share the same snapshot of the
+	// bandwidth hints of our channels.
+	bandwidthSnapshotInterval = time.Second
+)
+
+// pathFindingRequest is a request to find a path, processed by one of the path
+// finding workers of the router.
+type pathFindingRequest struct {
+	source       route.Vertex
+	target       route.Vertex
+	amt          lnwire.MilliAtom
+	restrictions *RestrictParams
+
+	// resp is the channel over which the outcome of the request is sent.
+	//
+	// NOTE: This channel MUST be buffered.
+	resp chan *pathFindingResponse
+}
+
+// pathFindingResponse is the outcome of a path finding request.
+type pathFindingResponse struct {
+	path []*channeldb.ChannelEdgePolicy
+	err  error
+}
+
+// bandwidthSnapshot holds the bandwidth hints of our channels as they were
+// when the snapshot was taken. Concurrent path finding queries share it, rather
+// than each querying the bandwidth of all our links.
+type bandwidthSnapshot struct {
+	// source is the node whose channels the hints belong to.
+	source route.Vertex
+
+	// hints maps the channel ids of our channels to their bandwidth. It
+	// must not be modified once the snapshot is taken.
+	hints map[uint64]lnwire.MilliAtom
+
+	// expiry is the time after which a new snapshot needs to be taken.
+	expiry time.Time
+
+	sync.Mutex
+}
+
+// pathFindingWorker processes path finding requests until the router exits.
+//
+// NOTE: This MUST be run as a goroutine.
+func (r *ChannelRouter) pathFindingWorker() {
+	defer r.wg.Done()
+
+	for {
+		select {
+		case req := <-r.pathFindingReqs:
+			path, err := r.processPathFindingRequest(req)
+			req.resp <- &pathFindingResponse{
+				path: path,
+				err:  err,
+			}
+
+		case <-r.quit:
+			return
+		}
+	}
+}
+
+// queryPath hands a path finding query over to the path finding workers and
+// waits for the found path.
+func (r *ChannelRouter) queryPath(source, target route.Vertex,
+	amt lnwire.MilliAtom, restrictions *RestrictParams) (
+	[]*channeldb.ChannelEdgePolicy, error) {
+
+	req := &pathFindingRequest{
+		source:       source,
+		target:       target,
+		amt:          amt,
+		restrictions: restrictions,
+		resp:         make(chan *pathFindingResponse, 1),
+	}
+
+	select {
+	case r.pathFindingReqs <- req:
+	case <-r.quit:
+		return nil, ErrRouterShuttingDown
+	}
+
+	select {
+	case resp := <-req.resp:
+		return resp.path, resp.err
+
+	case <-r.quit:
+		return nil, ErrRouterShuttingDown
+	}
+}
+
+// processPathFindingRequest finds a path for the passed request, using the
+// current snapshot of the bandwidth hints of our channels.
+func (r *ChannelRouter) processPathFindingRequest(req *pathFindingRequest) (
+	[]*channeldb.ChannelEdgePolicy, error) {
+
+	bandwidthHints, err := r.currentBandwidthHints()
+	if err != nil {
+		return nil, err
+	}
+
+	return findPath(
+		&graphParams{
+			graph:          r.cfg.Graph,
+			bandwidthHints: bandwidthHints,
+		},
+		req.restrictions, &r.cfg.PathFindingConfig,
+		req.source, req.target, req.amt,
+	)
+}
+
+// currentBandwidthHints returns the bandwidth hints of the current snapshot,
+// taking a new snapshot if it expired.
+func (r *ChannelRouter) currentBandwidthHints() (map[uint64]lnwire.MilliAtom,
+	error) {
+
+	s := &r.bandwidthSnapshot
+
+	s.Lock()
+	defer s.Unlock()
+
+	now := time.Now()
+	source := route.Vertex(r.selfNode.PubKeyBytes)
+	if s.hints != nil && s.source == source && now.Before(s.expiry) {
+		return s.hints, nil
+	}
+
+	hints, err := generateBandwidthHints(
+		r.selfNode, r.cfg.QueryBandwidth, r.cfg.BandwidthPenalties,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	s.source = source
+	s.hints = hints
+	s.expiry = now.Add(bandwidthSnapshotInterval)
+
+	return hints, nil
+}
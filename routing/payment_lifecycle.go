@@ -33,11 +33,37 @@ type paymentLifecycle struct {
 	payment        *LightningPayment
 	paySession     PaymentSession
 	timeoutChan    <-chan time.Time
+	cancelChan     <-chan struct{}
 	currentHeight  int32
 	finalCLTVDelta uint16
 	attempt        *channeldb.PaymentAttemptInfo
 	circuit        *sphinx.Circuit
 	lastError      error
+
+	// attemptStart is the time at which the current attempt was sent. It
+	// is zero for attempts resumed after a restart.
+	attemptStart time.Time
+
+	// startTime is the time at which the lifecycle was started, against
+	// which the time budget of the payment is checked.
+	startTime time.Time
+
+	// numAttempts is the number of attempts created by this lifecycle.
+	numAttempts uint32
+
+	// totalFees is the sum of the fees of the routes of all attempts
+	// created by this lifecycle.
+	totalFees lnwire.MilliAtom
+
+	// inFlightFees is the sum of the fees of the routes of the attempts
+	// that are neither settled nor failed. These fees are committed, so
+	// they are deducted from the fee limit of the next attempts, while
+	// failed attempts give their fees back.
+	inFlightFees lnwire.MilliAtom
+
+	// quotedFee is the fee of the first route found for the payment,
+	// against which the fee slippage of the attempts is accounted.
+	quotedFee lnwire.MilliAtom
 }
 
 // resumePayment resumes the paymentLifecycle from the current state.
@@ -56,8 +82,11 @@ func (p *paymentLifecycle) resumePayment() ([32]byte, *route.Route, error) {
 
 			// Now that the attempt is created and checkpointed to
 			// the DB, we send it.
+			p.attemptStart = time.Now()
 			sendErr := p.sendPaymentAttempt(firstHop, htlcAdd)
 			if sendErr != nil {
+				p.reportAttempt(false)
+
 				// We must inspect the error to know whether it
 				// was critical or not, to decide whether we
 				// should continue trying.
@@ -83,6 +112,7 @@ func (p *paymentLifecycle) resumePayment() ([32]byte, *route.Route, error) {
 				return [32]byte{}, nil, err
 			}
 			p.circuit = c
+			p.attemptStart = time.Time{}
 		}
 
 		// Using the created circuit, initialize the error decrypter so we can
@@ -144,6 +174,8 @@ func (p *paymentLifecycle) resumePayment() ([32]byte, *route.Route, error) {
 			log.Errorf("Attempt to send payment %x failed: %v",
 				p.payment.PaymentHash, result.Error)
 
+			p.reportAttempt(false)
+
 			// We must inspect the error to know whether it was
 			// critical or not, to decide whether we should
 			// continue trying.
@@ -161,6 +193,8 @@ func (p *paymentLifecycle) resumePayment() ([32]byte, *route.Route, error) {
 		log.Debugf("Payment %x succeeded with pid=%v",
 			p.payment.PaymentHash, p.attempt.PaymentID)
 
+		p.reportAttempt(true)
+
 		// Report success to mission control.
 		err = p.router.cfg.MissionControl.ReportPaymentSuccess(
 			p.attempt.PaymentID, &p.attempt.Route,
@@ -178,6 +212,15 @@ func (p *paymentLifecycle) resumePayment() ([32]byte, *route.Route, error) {
 				"attempt: %v", err)
 			return [32]byte{}, nil, err
 		}
+		p.reportPayment(true)
+
+		if slippage := p.attempt.FeeSlippage(); slippage != 0 {
+			log.Infof("Payment %x settled with a fee of %v, "+
+				"slipped by %v milli-atoms from the quoted "+
+				"fee of %v", p.payment.PaymentHash,
+				p.attempt.Route.TotalFees(), slippage,
+				p.attempt.QuotedFee)
+		}
 
 		// Terminal state, return the preimage and the route
 		// taken.
@@ -192,9 +235,10 @@ func (p *paymentLifecycle) createNewPaymentAttempt() (lnwire.ShortChannelID,
 	*lnwire.UpdateAddHTLC, error) {
 
 	// Before we attempt this next payment, we'll check to see if either
-	// we've gone past the payment attempt timeout, or the router is
-	// exiting. In either case, we'll stop this payment attempt short. If a
-	// timeout is not applicable, timeoutChan will be nil.
+	// we've gone past the payment attempt timeout, the payment was
+	// canceled, or the router is exiting. In either case, we'll stop this
+	// payment attempt short. If a timeout is not applicable, timeoutChan
+	// will be nil.
 	select {
 	case <-p.timeoutChan:
 		// Mark the payment as failed because of the
@@ -205,6 +249,7 @@ func (p *paymentLifecycle) createNewPaymentAttempt() (lnwire.ShortChannelID,
 		if err != nil {
 			return lnwire.ShortChannelID{}, nil, err
 		}
+		p.reportPayment(false)
 
 		errStr := fmt.Sprintf("payment attempt not completed " +
 			"before timeout")
@@ -212,6 +257,20 @@ func (p *paymentLifecycle) createNewPaymentAttempt() (lnwire.ShortChannelID,
 		return lnwire.ShortChannelID{}, nil,
 			newErr(ErrPaymentAttemptTimeout, errStr)
 
+	case <-p.cancelChan:
+		// Mark the payment as failed because it was canceled by the
+		// user.
+		err := p.router.cfg.Control.Fail(
+			p.payment.PaymentHash, channeldb.FailureReasonCanceled,
+		)
+		if err != nil {
+			return lnwire.ShortChannelID{}, nil, err
+		}
+		p.reportPayment(false)
+
+		return lnwire.ShortChannelID{}, nil,
+			newErr(ErrPaymentCanceled, "payment canceled by user")
+
 	case <-p.router.quit:
 		// The payment will be resumed from the current state
 		// after restart.
@@ -222,23 +281,81 @@ func (p *paymentLifecycle) createNewPaymentAttempt() (lnwire.ShortChannelID,
 		// are expiring.
 	}
 
+	// Neither should we make another attempt if that would exceed the
+	// attempt or time budget of the payment.
+	payment := p.payment
+	elapsed := p.router.cfg.Clock.Now().Sub(p.startTime)
+	switch {
+	case payment.MaxAttempts != 0 && p.numAttempts >= payment.MaxAttempts:
+		return p.failBudgetExhausted(fmt.Sprintf("attempt budget of "+
+			"%v exhausted", payment.MaxAttempts))
+
+	case payment.MaxDuration != 0 && elapsed >= payment.MaxDuration:
+		return p.failBudgetExhausted(fmt.Sprintf("time budget of %v "+
+			"exhausted", payment.MaxDuration))
+	}
+
+	// The next route may only use what the attempts still in flight left
+	// of the fee limit of the payment, so that the aggregate fee of its
+	// outstanding htlcs never exceeds the limit.
+	feeLimit := payment.FeeLimit
+	if p.inFlightFees >= feeLimit {
+		feeLimit = 0
+	} else {
+		feeLimit -= p.inFlightFees
+	}
+
+	// If the fee budget of the payment is binding, restrict the fee of
+	// the next route to what is left of it.
+	var feeBudgetBinding bool
+	if payment.MaxTotalFee != 0 {
+		remaining := payment.MaxTotalFee - p.totalFees
+		if remaining < feeLimit {
+			feeLimit = remaining
+			feeBudgetBinding = true
+		}
+	}
+
+	if feeLimit != payment.FeeLimit {
+		restricted := *payment
+		restricted.FeeLimit = feeLimit
+		payment = &restricted
+	}
+
 	// Create a new payment attempt from the given payment session.
 	route, err := p.paySession.RequestRoute(
-		p.payment, uint32(p.currentHeight), p.finalCLTVDelta,
+		payment, uint32(p.currentHeight), p.finalCLTVDelta,
 	)
 	if err != nil {
 		log.Warnf("Failed to find route for payment %x: %v",
 			p.payment.PaymentHash, err)
 
-		// If we're unable to successfully make a payment using
-		// any of the routes we've found, then mark the payment
-		// as permanently failed.
+		// If previous attempts used up the fee budget such that no
+		// route could be found within what is left of it, the budget
+		// is the cause of the failure.
+		if feeBudgetBinding && p.totalFees > 0 {
+			return p.failBudgetExhausted(fmt.Sprintf("fee budget "+
+				"of %v exhausted after spending %v",
+				payment.MaxTotalFee, p.totalFees))
+		}
+
+		// If none of our channels has enough balance to carry the
+		// payment, that's the reason it failed. Otherwise, we're
+		// unable to successfully make a payment using any of the
+		// routes we've found.
+		reason := channeldb.FailureReasonNoRoute
+		if IsError(err, ErrInsufficientBalance) {
+			reason = channeldb.FailureReasonInsufficientBalance
+		}
+
+		// Either way, mark the payment as permanently failed.
 		saveErr := p.router.cfg.Control.Fail(
-			p.payment.PaymentHash, channeldb.FailureReasonNoRoute,
+			p.payment.PaymentHash, reason,
 		)
 		if saveErr != nil {
 			return lnwire.ShortChannelID{}, nil, saveErr
 		}
+		p.reportPayment(false)
 
 		// If there was an error already recorded for this
 		// payment, we'll return that.
@@ -294,12 +411,20 @@ func (p *paymentLifecycle) createNewPaymentAttempt() (lnwire.ShortChannelID,
 		return lnwire.ShortChannelID{}, nil, err
 	}
 
+	// The fee of the first route is the one quoted for the payment. The
+	// routes of later attempts may pay more or less if the policies along
+	// the way changed in the meantime.
+	if p.quotedFee == 0 && p.numAttempts == 0 {
+		p.quotedFee = route.TotalFees()
+	}
+
 	// We now have all the information needed to populate
 	// the current attempt information.
 	p.attempt = &channeldb.PaymentAttemptInfo{
 		PaymentID:  paymentID,
 		SessionKey: sessionKey,
 		Route:      *route,
+		QuotedFee:  p.quotedFee,
 	}
 
 	// Before sending this HTLC to the switch, we checkpoint the
@@ -313,9 +438,37 @@ func (p *paymentLifecycle) createNewPaymentAttempt() (lnwire.ShortChannelID,
 		return lnwire.ShortChannelID{}, nil, err
 	}
 
+	// Account for the attempt in the budgets of the payment.
+	p.numAttempts++
+	p.totalFees += route.TotalFees()
+	p.inFlightFees += route.TotalFees()
+
 	return firstHop, htlcAdd, nil
 }
 
+// failBudgetExhausted marks the payment as failed because one of its budgets
+// has been exhausted, and returns the terminal error describing the budget.
+func (p *paymentLifecycle) failBudgetExhausted(reason string) (
+	lnwire.ShortChannelID, *lnwire.UpdateAddHTLC, error) {
+
+	err := p.router.cfg.Control.Fail(
+		p.payment.PaymentHash, channeldb.FailureReasonBudgetExhausted,
+	)
+	if err != nil {
+		return lnwire.ShortChannelID{}, nil, err
+	}
+	p.reportPayment(false)
+
+	// Include the error of the last attempt, if any, as it is likely what
+	// made us run out of budget.
+	if p.lastError != nil {
+		reason = fmt.Sprintf("%v: %v", reason, p.lastError)
+	}
+
+	return lnwire.ShortChannelID{}, nil,
+		newErr(ErrPaymentBudgetExhausted, reason)
+}
+
 // sendPaymentAttempt attempts to send the current attempt to the switch.
 func (p *paymentLifecycle) sendPaymentAttempt(firstHop lnwire.ShortChannelID,
 	htlcAdd *lnwire.UpdateAddHTLC) error {
@@ -351,6 +504,12 @@ func (p *paymentLifecycle) sendPaymentAttempt(firstHop lnwire.ShortChannelID,
 // whether we should make another payment attempt.
 func (p *paymentLifecycle) handleSendError(sendErr error) error {
 
+	// Record the failure of the attempt before deciding what to do next,
+	// so that it's part of the payment's history whatever the outcome.
+	if err := p.failAttempt(sendErr); err != nil {
+		return err
+	}
+
 	reason := p.router.processSendError(
 		p.attempt.PaymentID, &p.attempt.Route, sendErr,
 	)
@@ -375,7 +534,79 @@ func (p *paymentLifecycle) handleSendError(sendErr error) error {
 	if err != nil {
 		return err
 	}
+	p.reportPayment(false)
 
 	// Terminal state, return the error we encountered.
 	return sendErr
 }
+
+// failAttempt records the failure of the current attempt, caused by the given
+// error from the Switch.
+func (p *paymentLifecycle) failAttempt(sendErr error) error {
+	failInfo := &channeldb.HTLCFailInfo{}
+
+	switch err := sendErr.(type) {
+	case *htlcswitch.ForwardingError:
+		failInfo.Message = err.FailureMessage
+		failInfo.FailureSourceIndex = uint32(err.FailureSourceIdx)
+
+		// Failures that couldn't be decoded come without a message,
+		// although their source is known.
+		if err.FailureMessage == nil {
+			failInfo.Reason = channeldb.HTLCFailUnknown
+		} else {
+			failInfo.Reason = channeldb.HTLCFailMessage
+		}
+
+	default:
+		if sendErr == htlcswitch.ErrUnreadableFailureMessage {
+			failInfo.Reason = channeldb.HTLCFailUnreadable
+		} else {
+			failInfo.Reason = channeldb.HTLCFailInternal
+		}
+	}
+
+	err := p.router.cfg.Control.FailAttempt(
+		p.payment.PaymentHash, p.attempt.PaymentID, failInfo,
+	)
+	if err != nil {
+		return err
+	}
+
+	// The failed attempt gives its fee back to the fee limit of the
+	// payment.
+	fee := p.attempt.Route.TotalFees()
+	if fee > p.inFlightFees {
+		fee = p.inFlightFees
+	}
+	p.inFlightFees -= fee
+
+	return nil
+}
+
+// reportAttempt records the outcome of the current attempt in the payment
+// metrics. Attempts resumed after a restart are skipped, as their latency is
+// unknown.
+func (p *paymentLifecycle) reportAttempt(success bool) {
+	if p.attemptStart.IsZero() {
+		return
+	}
+
+	p.router.cfg.PaymentMetrics.ReportAttempt(
+		p.payment.Target, success, time.Since(p.attemptStart),
+	)
+}
+
+// reportPayment records the final outcome of the payment in the payment
+// metrics. The fee slippage of the current attempt is only accounted for
+// successful payments.
+func (p *paymentLifecycle) reportPayment(success bool) {
+	var feeSlippage int64
+	if success && p.attempt != nil {
+		feeSlippage = p.attempt.FeeSlippage()
+	}
+
+	p.router.cfg.PaymentMetrics.ReportPayment(
+		p.payment.Target, success, feeSlippage,
+	)
+}
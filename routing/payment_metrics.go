@@ -0,0 +1,309 @@
+package routing
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/decred/dcrlnd/routing/route"
+)
+
+const (
+	// DefaultPaymentMetricsWindow is the default duration for which
+	// payment results are taken into account by the payment metrics.
+	DefaultPaymentMetricsWindow = 24 * time.Hour
+
+	// DefaultPaymentMetricsMaxRecords is the default maximum number of
+	// payments and of attempts kept per destination.
+	DefaultPaymentMetricsMaxRecords = 1000
+)
+
+// paymentRecord is the outcome of a single payment or payment attempt.
+type paymentRecord struct {
+	// timestamp is the time at which the outcome was known.
+	timestamp time.Time
+
+	// success indicates whether the payment or attempt succeeded.
+	success bool
+
+	// latency is the time between sending the attempt and learning about
+	// its outcome. It is unused for payment records.
+	latency time.Duration
+
+	// feeSlippage is the difference in milli-atoms between the fee paid
+	// by a successful payment and the fee quoted for it. It is unused for
+	// attempt records.
+	feeSlippage int64
+}
+
+// destinationRecords are the recent payment and attempt outcomes towards a
+// single destination, oldest first.
+type destinationRecords struct {
+	payments []paymentRecord
+	attempts []paymentRecord
+}
+
+// DestinationMetrics are the aggregated payment results towards a single
+// destination within the metrics window.
+type DestinationMetrics struct {
+	// Destination is the node the payments were sent to.
+	Destination route.Vertex
+
+	// Payments is the number of payments that reached a final state.
+	Payments int
+
+	// PaymentsSucceeded is the number of payments that succeeded.
+	PaymentsSucceeded int
+
+	// Attempts is the number of payment attempts that were resolved.
+	Attempts int
+
+	// AttemptsSucceeded is the number of attempts that settled.
+	AttemptsSucceeded int
+
+	// AvgAttemptLatency is the average time between sending an attempt
+	// and learning about its outcome.
+	AvgAttemptLatency time.Duration
+
+	// MedianAttemptLatency is the median attempt latency.
+	MedianAttemptLatency time.Duration
+
+	// MaxAttemptLatency is the largest attempt latency.
+	MaxAttemptLatency time.Duration
+
+	// TotalFeeSlippage is the sum in milli-atoms of the differences
+	// between the fees paid by the successful payments and the fees quoted
+	// for them, which arise when the policies along their route changed
+	// while they were in flight.
+	TotalFeeSlippage int64
+
+	// PaymentsSlipped is the number of successful payments whose fee
+	// differed from the quoted one.
+	PaymentsSlipped int
+}
+
+// PaymentSuccessRate returns the fraction of payments that succeeded, or zero
+// if no payments were made.
+func (d *DestinationMetrics) PaymentSuccessRate() float64 {
+	if d.Payments == 0 {
+		return 0
+	}
+
+	return float64(d.PaymentsSucceeded) / float64(d.Payments)
+}
+
+// AttemptSuccessRate returns the fraction of attempts that succeeded, or zero
+// if no attempts were made.
+func (d *DestinationMetrics) AttemptSuccessRate() float64 {
+	if d.Attempts == 0 {
+		return 0
+	}
+
+	return float64(d.AttemptsSucceeded) / float64(d.Attempts)
+}
+
+// PaymentMetrics is a rolling, in-memory record of the success rates and
+// attempt latencies of our payments per destination. As opposed to mission
+// control, which tracks the reliability of individual node pairs for path
+// finding, the metrics are meant for applications that regularly pay the
+// same destinations and want to monitor how reliably they are reached.
+type PaymentMetrics struct {
+	// window is the duration for which results are kept.
+	window time.Duration
+
+	// maxRecords is the maximum number of payments and of attempts kept
+	// per destination.
+	maxRecords int
+
+	// now returns the current time. It is overridable for tests.
+	now func() time.Time
+
+	destinations map[route.Vertex]*destinationRecords
+	sync.Mutex
+}
+
+// NewPaymentMetrics returns a new payment metrics store which keeps results
+// for the passed window, up to maxRecords payments and attempts per
+// destination.
+func NewPaymentMetrics(window time.Duration, maxRecords int) *PaymentMetrics {
+	return &PaymentMetrics{
+		window:       window,
+		maxRecords:   maxRecords,
+		now:          time.Now,
+		destinations: make(map[route.Vertex]*destinationRecords),
+	}
+}
+
+// ReportAttempt records the outcome of a payment attempt towards the given
+// destination, along with the time it took for the outcome to be known.
+func (m *PaymentMetrics) ReportAttempt(dest route.Vertex, success bool,
+	latency time.Duration) {
+
+	if m == nil {
+		return
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	records := m.records(dest)
+	records.attempts = m.appendRecord(records.attempts, paymentRecord{
+		timestamp: m.now(),
+		success:   success,
+		latency:   latency,
+	})
+}
+
+// ReportPayment records the final outcome of a payment towards the given
+// destination, along with the fee slippage of successful payments.
+func (m *PaymentMetrics) ReportPayment(dest route.Vertex, success bool,
+	feeSlippage int64) {
+
+	if m == nil {
+		return
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	records := m.records(dest)
+	records.payments = m.appendRecord(records.payments, paymentRecord{
+		timestamp:   m.now(),
+		success:     success,
+		feeSlippage: feeSlippage,
+	})
+}
+
+// Query returns the aggregated metrics of the given destination. If no
+// results towards the destination are known within the window, nil is
+// returned.
+func (m *PaymentMetrics) Query(dest route.Vertex) *DestinationMetrics {
+	m.Lock()
+	defer m.Unlock()
+
+	m.prune()
+
+	records, ok := m.destinations[dest]
+	if !ok {
+		return nil
+	}
+
+	return aggregateRecords(dest, records)
+}
+
+// QueryAll returns the aggregated metrics of every destination with results
+// within the window.
+func (m *PaymentMetrics) QueryAll() []*DestinationMetrics {
+	m.Lock()
+	defer m.Unlock()
+
+	m.prune()
+
+	metrics := make([]*DestinationMetrics, 0, len(m.destinations))
+	for dest, records := range m.destinations {
+		metrics = append(metrics, aggregateRecords(dest, records))
+	}
+
+	return metrics
+}
+
+// records returns the records of the given destination, creating them if
+// needed.
+//
+// NOTE: This method must be called with the mutex held.
+func (m *PaymentMetrics) records(dest route.Vertex) *destinationRecords {
+	records, ok := m.destinations[dest]
+	if !ok {
+		records = &destinationRecords{}
+		m.destinations[dest] = records
+	}
+
+	return records
+}
+
+// appendRecord adds the record to the given ones, dropping the oldest records
+// that exceed the maximum number of records or fall out of the window.
+func (m *PaymentMetrics) appendRecord(records []paymentRecord,
+	record paymentRecord) []paymentRecord {
+
+	records = append(records, record)
+	if len(records) > m.maxRecords {
+		records = records[len(records)-m.maxRecords:]
+	}
+
+	return m.pruneRecords(records)
+}
+
+// pruneRecords drops the records older than the window.
+func (m *PaymentMetrics) pruneRecords(records []paymentRecord) []paymentRecord {
+	cutoff := m.now().Add(-m.window)
+
+	i := sort.Search(len(records), func(i int) bool {
+		return records[i].timestamp.After(cutoff)
+	})
+
+	return records[i:]
+}
+
+// prune drops all records older than the window, along with destinations
+// that have no records left.
+//
+// NOTE: This method must be called with the mutex held.
+func (m *PaymentMetrics) prune() {
+	for dest, records := range m.destinations {
+		records.payments = m.pruneRecords(records.payments)
+		records.attempts = m.pruneRecords(records.attempts)
+
+		if len(records.payments) == 0 && len(records.attempts) == 0 {
+			delete(m.destinations, dest)
+		}
+	}
+}
+
+// aggregateRecords computes the metrics of a destination from its records.
+func aggregateRecords(dest route.Vertex,
+	records *destinationRecords) *DestinationMetrics {
+
+	metrics := &DestinationMetrics{
+		Destination: dest,
+		Payments:    len(records.payments),
+		Attempts:    len(records.attempts),
+	}
+
+	for _, record := range records.payments {
+		if record.success {
+			metrics.PaymentsSucceeded++
+		}
+
+		if record.feeSlippage != 0 {
+			metrics.TotalFeeSlippage += record.feeSlippage
+			metrics.PaymentsSlipped++
+		}
+	}
+
+	if len(records.attempts) == 0 {
+		return metrics
+	}
+
+	var totalLatency time.Duration
+	latencies := make([]time.Duration, 0, len(records.attempts))
+	for _, record := range records.attempts {
+		if record.success {
+			metrics.AttemptsSucceeded++
+		}
+
+		totalLatency += record.latency
+		latencies = append(latencies, record.latency)
+	}
+
+	sort.Slice(latencies, func(i, j int) bool {
+		return latencies[i] < latencies[j]
+	})
+
+	metrics.AvgAttemptLatency = totalLatency /
+		time.Duration(len(latencies))
+	metrics.MedianAttemptLatency = latencies[len(latencies)/2]
+	metrics.MaxAttemptLatency = latencies[len(latencies)-1]
+
+	return metrics
+}
@@ -0,0 +1,101 @@
+package routing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/decred/dcrlnd/routing/route"
+)
+
+// TestPaymentMetrics asserts that payment results are aggregated per
+// destination, and that results outside the window or beyond the maximum
+// number of records are dropped.
+func TestPaymentMetrics(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	metrics := NewPaymentMetrics(time.Hour, 4)
+	metrics.now = func() time.Time { return now }
+
+	dest1 := route.Vertex{1}
+	dest2 := route.Vertex{2}
+
+	// Without any results, nothing is known about the destinations.
+	if m := metrics.Query(dest1); m != nil {
+		t.Fatalf("expected no metrics, got %v", m)
+	}
+
+	// Record a failed and a successful attempt, followed by the success of
+	// the payment, which paid more fees than quoted.
+	metrics.ReportAttempt(dest1, false, 100*time.Millisecond)
+	metrics.ReportAttempt(dest1, true, 300*time.Millisecond)
+	metrics.ReportPayment(dest1, true, 1500)
+
+	// The second destination has a single failed payment.
+	metrics.ReportAttempt(dest2, false, time.Second)
+	metrics.ReportPayment(dest2, false, 0)
+
+	m := metrics.Query(dest1)
+	if m == nil {
+		t.Fatalf("expected metrics for destination")
+	}
+	if m.Payments != 1 || m.PaymentsSucceeded != 1 {
+		t.Fatalf("unexpected payment counts: %v/%v",
+			m.PaymentsSucceeded, m.Payments)
+	}
+	if m.Attempts != 2 || m.AttemptsSucceeded != 1 {
+		t.Fatalf("unexpected attempt counts: %v/%v",
+			m.AttemptsSucceeded, m.Attempts)
+	}
+	if m.PaymentSuccessRate() != 1 || m.AttemptSuccessRate() != 0.5 {
+		t.Fatalf("unexpected success rates: %v, %v",
+			m.PaymentSuccessRate(), m.AttemptSuccessRate())
+	}
+	if m.AvgAttemptLatency != 200*time.Millisecond {
+		t.Fatalf("unexpected average latency: %v", m.AvgAttemptLatency)
+	}
+	if m.MedianAttemptLatency != 300*time.Millisecond {
+		t.Fatalf("unexpected median latency: %v",
+			m.MedianAttemptLatency)
+	}
+	if m.MaxAttemptLatency != 300*time.Millisecond {
+		t.Fatalf("unexpected max latency: %v", m.MaxAttemptLatency)
+	}
+	if m.TotalFeeSlippage != 1500 || m.PaymentsSlipped != 1 {
+		t.Fatalf("unexpected fee slippage: %v over %v payments",
+			m.TotalFeeSlippage, m.PaymentsSlipped)
+	}
+
+	if all := metrics.QueryAll(); len(all) != 2 {
+		t.Fatalf("expected metrics for 2 destinations, got %v",
+			len(all))
+	}
+
+	// Only the latest records are kept once the maximum is exceeded.
+	now = now.Add(time.Minute)
+	for i := 0; i < 4; i++ {
+		metrics.ReportAttempt(dest1, false, time.Second)
+	}
+	m = metrics.Query(dest1)
+	if m.Attempts != 4 || m.AttemptsSucceeded != 0 {
+		t.Fatalf("unexpected attempt counts: %v/%v",
+			m.AttemptsSucceeded, m.Attempts)
+	}
+
+	// Once the window has passed, the old results are dropped, along with
+	// destinations without any results left.
+	now = now.Add(time.Hour - time.Second)
+	metrics.ReportPayment(dest1, false, 0)
+
+	m = metrics.Query(dest1)
+	if m.Payments != 1 || m.PaymentsSucceeded != 0 {
+		t.Fatalf("unexpected payment counts: %v/%v",
+			m.PaymentsSucceeded, m.Payments)
+	}
+	if m.Attempts != 4 {
+		t.Fatalf("expected 4 attempts, got %v", m.Attempts)
+	}
+	if m := metrics.Query(dest2); m != nil {
+		t.Fatalf("expected metrics of destination to be dropped")
+	}
+}
@@ -12,6 +12,18 @@ import (
 // to prevent an HTLC being failed if some blocks are mined while it's in-flight.
 const BlockPadding uint16 = 3
 
+const (
+	// DefaultShadowRouteMinDelta is the default minimum number of blocks
+	// the final CLTV delta of a payment requesting a shadow route is
+	// extended by.
+	DefaultShadowRouteMinDelta uint16 = 0
+
+	// DefaultShadowRouteMaxDelta is the default maximum number of blocks
+	// the final CLTV delta of a payment requesting a shadow route is
+	// extended by.
+	DefaultShadowRouteMaxDelta uint16 = 144
+)
+
 // PaymentSession is used during SendPayment attempts to provide routes to
 // attempt. It also defines methods to give the PaymentSession additional
 // information learned during the previous attempts.
@@ -41,6 +53,10 @@ type paymentSession struct {
 	preBuiltRouteTried bool
 
 	pathFinder pathFinder
+
+	// randInt63n returns a random number in [0,n), used to pick the
+	// shadow route extension of the final CLTV delta.
+	randInt63n func(n int64) int64
 }
 
 // RequestRoute returns a route which is likely to be capable for successfully
@@ -86,10 +102,14 @@ func (p *paymentSession) RequestRoute(payment *LightningPayment,
 	ss := p.sessionSource
 
 	restrictions := &RestrictParams{
-		ProbabilitySource: ss.MissionControl.GetProbability,
-		FeeLimit:          payment.FeeLimit,
-		OutgoingChannelID: payment.OutgoingChannelID,
-		CltvLimit:         cltvLimit,
+		ProbabilitySource:  ss.MissionControl.GetProbability,
+		FeeLimit:           payment.FeeLimit,
+		OutgoingChannelIDs: payment.OutgoingChannelIDs,
+		LastHop:            payment.LastHop,
+		CltvLimit:          cltvLimit,
+		DestPayloadTLV:     len(payment.FinalDestRecords) != 0,
+		DestFeatures:       payment.DestFeatures,
+		EdgePenalty:        ss.EdgeScorer.PenaltySource(),
 	}
 
 	// We'll also obtain a set of bandwidthHints from the lower layer for
@@ -103,6 +123,22 @@ func (p *paymentSession) RequestRoute(payment *LightningPayment,
 		return nil, err
 	}
 
+	// If none of the channels the payment may go out through has enough
+	// bandwidth to carry its amount, even before adding any fees, there's
+	// no point in searching for a path: our own balance is what prevents
+	// the payment from completing.
+	if bandwidthHints != nil {
+		maxBandwidth := maxOutgoingBandwidth(
+			bandwidthHints, payment.OutgoingChannelIDs,
+		)
+		if maxBandwidth < payment.Amount {
+			return nil, newErrf(ErrInsufficientBalance,
+				"insufficient local balance: payment amount "+
+					"%v, max outgoing bandwidth %v",
+				payment.Amount, maxBandwidth)
+		}
+	}
+
 	path, err := p.pathFinder(
 		&graphParams{
 			graph:           ss.Graph,
@@ -122,7 +158,7 @@ func (p *paymentSession) RequestRoute(payment *LightningPayment,
 	sourceVertex := route.Vertex(ss.SelfNode.PubKeyBytes)
 	route, err := newRoute(
 		payment.Amount, sourceVertex, path, height, finalCltvDelta,
-		payment.FinalDestRecords,
+		payment.DestFeatures, payment.FinalDestRecords,
 	)
 	if err != nil {
 		// TODO(roasbeef): return which edge/vertex didn't work
@@ -130,5 +166,77 @@ func (p *paymentSession) RequestRoute(payment *LightningPayment,
 		return nil, err
 	}
 
-	return route, err
+	// If requested, extend the final CLTV delta by a random number of
+	// blocks, within what's left of the CLTV limit of the payment once the
+	// time locks of the path are accounted for.
+	if !payment.ShadowRoute {
+		return route, nil
+	}
+
+	var slack uint32
+	if totalDelta := route.TotalTimeLock - height; totalDelta <
+		payment.CltvLimit {
+
+		slack = payment.CltvLimit - totalDelta
+	}
+
+	shadowDelta := p.shadowRouteDelta(slack)
+	if shadowDelta == 0 {
+		return route, nil
+	}
+
+	log.Debugf("Extending final cltv delta of route to %v by %v blocks",
+		payment.Target, shadowDelta)
+
+	return newRoute(
+		payment.Amount, sourceVertex, path, height,
+		finalCltvDelta+shadowDelta, payment.DestFeatures,
+		payment.FinalDestRecords,
+	)
+}
+
+// shadowRouteDelta returns a random number of blocks, within the shadow route
+// bounds of the path finding config, to extend the final CLTV delta of a route
+// by. The result never exceeds maxDelta, even if below the configured minimum,
+// so that the route stays within the CLTV limit of the payment.
+func (p *paymentSession) shadowRouteDelta(maxDelta uint32) uint16 {
+	cfg := &p.sessionSource.PathFindingConfig
+
+	lower := uint32(cfg.ShadowRouteMinDelta)
+	upper := uint32(cfg.ShadowRouteMaxDelta)
+	if upper > maxDelta {
+		upper = maxDelta
+	}
+	if lower > upper {
+		lower = upper
+	}
+
+	delta := lower
+	if upper > lower {
+		delta += uint32(p.randInt63n(int64(upper-lower) + 1))
+	}
+
+	return uint16(delta)
+}
+
+// maxOutgoingBandwidth returns the largest bandwidth available on a single one
+// of our channels, according to the passed bandwidth hints. If outgoing
+// channels are set, only the bandwidth of those channels is considered.
+func maxOutgoingBandwidth(bandwidthHints map[uint64]lnwire.MilliAtom,
+	outgoingChanIDs []uint64) lnwire.MilliAtom {
+
+	var maxBandwidth lnwire.MilliAtom
+	for chanID, bandwidth := range bandwidthHints {
+		if len(outgoingChanIDs) > 0 &&
+			!isOutgoingChannelAllowed(outgoingChanIDs, chanID) {
+
+			continue
+		}
+
+		if bandwidth > maxBandwidth {
+			maxBandwidth = bandwidth
+		}
+	}
+
+	return maxBandwidth
 }
@@ -1,7 +1,8 @@
 package routing
 
 import (
-	"github.com/decred/dcrd/dcrec/secp256k1/v2"
+	prand "math/rand"
+
 	"github.com/decred/dcrlnd/channeldb"
 	"github.com/decred/dcrlnd/lnwire"
 	"github.com/decred/dcrlnd/routing/route"
@@ -26,6 +27,11 @@ type SessionSource struct {
 	// the available bandwidth of the link should be returned.
 	QueryBandwidth func(*channeldb.ChannelEdgeInfo) lnwire.MilliAtom
 
+	// BandwidthPenalties is an optional record of local channels that
+	// recently failed to carry an htlc due to insufficient bandwidth. It
+	// caps the bandwidth hints of the penalized channels.
+	BandwidthPenalties *BandwidthPenalties
+
 	// SelfNode is our own node.
 	SelfNode *channeldb.LightningNode
 
@@ -41,6 +47,10 @@ type SessionSource struct {
 	// PathFindingConfig defines global parameters that control the
 	// trade-off in path finding between fees and probabiity.
 	PathFindingConfig PathFindingConfig
+
+	// EdgeScorer is an optional scorer that consults an external service
+	// for additional edge penalties during path finding.
+	EdgeScorer *EdgeScorer
 }
 
 // NewPaymentSession creates a new payment session backed by the latest prune
@@ -50,54 +60,17 @@ type SessionSource struct {
 func (m *SessionSource) NewPaymentSession(routeHints [][]zpay32.HopHint,
 	target route.Vertex) (PaymentSession, error) {
 
-	edges := make(map[route.Vertex][]*channeldb.ChannelEdgePolicy)
-
-	// Traverse through all of the available hop hints and include them in
-	// our edges map, indexed by the public key of the channel's starting
-	// node.
-	for _, routeHint := range routeHints {
-		// If multiple hop hints are provided within a single route
-		// hint, we'll assume they must be chained together and sorted
-		// in forward order in order to reach the target successfully.
-		for i, hopHint := range routeHint {
-			// In order to determine the end node of this hint,
-			// we'll need to look at the next hint's start node. If
-			// we've reached the end of the hints list, we can
-			// assume we've reached the destination.
-			endNode := &channeldb.LightningNode{}
-			if i != len(routeHint)-1 {
-				endNode.AddPubKey(routeHint[i+1].NodeID)
-			} else {
-				targetPubKey, err := secp256k1.ParsePubKey(
-					target[:],
-				)
-				if err != nil {
-					return nil, err
-				}
-				endNode.AddPubKey(targetPubKey)
-			}
-
-			// Finally, create the channel edge from the hop hint
-			// and add it to list of edges corresponding to the node
-			// at the start of the channel.
-			edge := &channeldb.ChannelEdgePolicy{
-				Node:      endNode,
-				ChannelID: hopHint.ChannelID,
-				FeeBaseMAtoms: lnwire.MilliAtom(
-					hopHint.FeeBaseMAtoms,
-				),
-				FeeProportionalMillionths: lnwire.MilliAtom(
-					hopHint.FeeProportionalMillionths,
-				),
-				TimeLockDelta: hopHint.CLTVExpiryDelta,
-			}
-
-			v := route.NewVertex(hopHint.NodeID)
-			edges[v] = append(edges[v], edge)
-		}
+	sourceNode, err := m.Graph.SourceNode()
+	if err != nil {
+		return nil, err
 	}
 
-	sourceNode, err := m.Graph.SourceNode()
+	// Include the hops of the valid route hints in the edges explored by
+	// path finding. Multiple hops in a single route hint form a chain of
+	// private channels, sorted in forward order, leading to the target.
+	edges, err := routeHintEdges(
+		m.Graph, routeHints, sourceNode.PubKeyBytes, target,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -105,7 +78,9 @@ func (m *SessionSource) NewPaymentSession(routeHints [][]zpay32.HopHint,
 	getBandwidthHints := func() (map[uint64]lnwire.MilliAtom,
 		error) {
 
-		return generateBandwidthHints(sourceNode, m.QueryBandwidth)
+		return generateBandwidthHints(
+			sourceNode, m.QueryBandwidth, m.BandwidthPenalties,
+		)
 	}
 
 	return &paymentSession{
@@ -113,6 +88,7 @@ func (m *SessionSource) NewPaymentSession(routeHints [][]zpay32.HopHint,
 		getBandwidthHints: getBandwidthHints,
 		sessionSource:     m,
 		pathFinder:        findPath,
+		randInt63n:        prand.Int63n,
 	}, nil
 }
 
@@ -74,3 +74,177 @@ func TestRequestRoute(t *testing.T) {
 			route.TotalTimeLock)
 	}
 }
+
+// TestRequestRouteInsufficientBalance asserts that no path finding is
+// attempted if none of our channels can carry the payment amount, and that the
+// failure is attributed to our local balance.
+func TestRequestRouteInsufficientBalance(t *testing.T) {
+	findPath := func(g *graphParams, r *RestrictParams,
+		cfg *PathFindingConfig, source, target route.Vertex,
+		amt lnwire.MilliAtom) ([]*channeldb.ChannelEdgePolicy,
+		error) {
+
+		t.Fatal("unexpected path finding attempt")
+		return nil, nil
+	}
+
+	sessionSource := &SessionSource{
+		SelfNode: &channeldb.LightningNode{},
+		MissionControl: &MissionControl{
+			cfg: &MissionControlConfig{},
+		},
+	}
+
+	session := &paymentSession{
+		getBandwidthHints: func() (map[uint64]lnwire.MilliAtom,
+			error) {
+
+			return map[uint64]lnwire.MilliAtom{
+				1: 5000,
+				2: 8000,
+			}, nil
+		},
+		sessionSource: sessionSource,
+		pathFinder:    findPath,
+	}
+
+	payment := &LightningPayment{
+		Amount:         10000,
+		CltvLimit:      30,
+		FinalCLTVDelta: 8,
+	}
+
+	_, err := session.RequestRoute(payment, 10, payment.FinalCLTVDelta)
+	if !IsError(err, ErrInsufficientBalance) {
+		t.Fatalf("expected insufficient balance error, got: %v", err)
+	}
+}
+
+// TestRequestRouteShadowRoute asserts that the final cltv delta of the routes
+// of payments requesting a shadow route is extended within the configured
+// bounds, without exceeding the cltv limit of the payment.
+func TestRequestRouteShadowRoute(t *testing.T) {
+	const (
+		height         = 10
+		cltvLimit      = 30
+		finalCltvDelta = 8
+	)
+
+	// The time lock of the route before any extension, leaving 19 blocks
+	// to the cltv limit.
+	baseTimeLock := height + finalCltvDelta + uint32(BlockPadding)
+
+	tests := []struct {
+		name        string
+		shadowRoute bool
+		minDelta    uint16
+		maxDelta    uint16
+		randHigh    bool
+		timeLock    uint32
+	}{
+		{
+			name:     "no shadow route",
+			minDelta: 5,
+			maxDelta: 10,
+			timeLock: baseTimeLock,
+		},
+		{
+			name:        "min delta",
+			shadowRoute: true,
+			minDelta:    5,
+			maxDelta:    10,
+			timeLock:    baseTimeLock + 5,
+		},
+		{
+			name:        "max delta",
+			shadowRoute: true,
+			minDelta:    5,
+			maxDelta:    10,
+			randHigh:    true,
+			timeLock:    baseTimeLock + 10,
+		},
+		{
+			name:        "max delta above cltv limit",
+			shadowRoute: true,
+			minDelta:    5,
+			maxDelta:    144,
+			randHigh:    true,
+			timeLock:    height + cltvLimit,
+		},
+		{
+			name:        "min delta above cltv limit",
+			shadowRoute: true,
+			minDelta:    100,
+			maxDelta:    144,
+			timeLock:    height + cltvLimit,
+		},
+	}
+
+	findPath := func(g *graphParams, r *RestrictParams,
+		cfg *PathFindingConfig, source, target route.Vertex,
+		amt lnwire.MilliAtom) ([]*channeldb.ChannelEdgePolicy,
+		error) {
+
+		path := []*channeldb.ChannelEdgePolicy{
+			{
+				Node: &channeldb.LightningNode{
+					Features: lnwire.NewFeatureVector(
+						nil, nil,
+					),
+				},
+			},
+		}
+
+		return path, nil
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			sessionSource := &SessionSource{
+				SelfNode: &channeldb.LightningNode{},
+				MissionControl: &MissionControl{
+					cfg: &MissionControlConfig{},
+				},
+				PathFindingConfig: PathFindingConfig{
+					ShadowRouteMinDelta: test.minDelta,
+					ShadowRouteMaxDelta: test.maxDelta,
+				},
+			}
+
+			session := &paymentSession{
+				getBandwidthHints: func() (
+					map[uint64]lnwire.MilliAtom, error) {
+
+					return nil, nil
+				},
+				sessionSource: sessionSource,
+				pathFinder:    findPath,
+				randInt63n: func(n int64) int64 {
+					if test.randHigh {
+						return n - 1
+					}
+					return 0
+				},
+			}
+
+			payment := &LightningPayment{
+				CltvLimit:      cltvLimit,
+				FinalCLTVDelta: finalCltvDelta,
+				ShadowRoute:    test.shadowRoute,
+			}
+
+			route, err := session.RequestRoute(
+				payment, height, finalCltvDelta,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if route.TotalTimeLock != test.timeLock {
+				t.Fatalf("expected total time lock %v, got %v",
+					test.timeLock, route.TotalTimeLock)
+			}
+		})
+	}
+}
@@ -17,22 +17,39 @@ var (
 // pairResult contains the result of the interpretation of a payment attempt for
 // a specific node pair.
 type pairResult struct {
-	// minPenalizeAmt is the minimum amount for which a penalty should be
-	// applied based on this result. Only applies to fail results.
-	minPenalizeAmt lnwire.MilliAtom
+	// amt is the amount this result relates to. For failures, it is the
+	// minimum amount for which a penalty should be applied, which is zero
+	// for failures that are amount independent. For successes, it is the
+	// amount that was forwarded through the pair.
+	amt lnwire.MilliAtom
 
 	// success indicates whether the payment attempt was successful through
 	// this pair.
 	success bool
 }
 
+// failPairResult creates a new result struct for a failure.
+func failPairResult(minPenalizeAmt lnwire.MilliAtom) pairResult {
+	return pairResult{
+		amt: minPenalizeAmt,
+	}
+}
+
+// successPairResult creates a new result struct for a success.
+func successPairResult(successAmt lnwire.MilliAtom) pairResult {
+	return pairResult{
+		success: true,
+		amt:     successAmt,
+	}
+}
+
 // String returns the human-readable representation of a pair result.
 func (p pairResult) String() string {
 	if p.success {
-		return "success"
+		return fmt.Sprintf("success (amt=%v)", p.amt)
 	}
 
-	return fmt.Sprintf("failed (minPenalizeAmt=%v)", p.minPenalizeAmt)
+	return fmt.Sprintf("failed (minPenalizeAmt=%v)", p.amt)
 }
 
 // interpretedResult contains the result of the interpretation of a payment
@@ -118,7 +135,9 @@ func (i *interpretedResult) processPaymentOutcomeSelf(
 	switch failure.(type) {
 
 	// We receive a malformed htlc failure from our peer. We trust ourselves
-	// to send the correct htlc, so our peer must be at fault.
+	// to send the correct htlc, so our peer must be at fault. Besides the
+	// node itself, this penalizes the channels of the peer along the
+	// route.
 	case *lnwire.FailInvalidOnionVersion,
 		*lnwire.FailInvalidOnionHmac,
 		*lnwire.FailInvalidOnionKey:
@@ -297,8 +316,12 @@ func (i *interpretedResult) processPaymentOutcomeIntermediate(
 		reportOutgoing()
 
 	// If we get a permanent channel, we'll prune the channel set in both
-	// directions and continue with the rest of the routes.
-	case *lnwire.FailPermanentChannelFailure:
+	// directions and continue with the rest of the routes. The same
+	// applies when the outgoing channel requires a feature we don't
+	// support.
+	case *lnwire.FailPermanentChannelFailure,
+		*lnwire.FailRequiredChannelFeatureMissing:
+
 		reportOutgoing()
 
 	// When an HTLC parameter is incorrect, the node sending the error may
@@ -339,6 +362,33 @@ func (i *interpretedResult) processPaymentOutcomeIntermediate(
 	case *lnwire.FailExpiryTooSoon:
 		reportAll()
 
+	// The node refuses to hold the htlc for as long as the route requires.
+	// This is a policy of the node that applies regardless of the amount,
+	// so we penalize the incoming pair like for other htlc parameters.
+	// There is no channel update to apply, so no second chance is given.
+	case *lnwire.FailExpiryTooFar:
+		reportIncoming()
+
+	// The node reports a problem with itself rather than with one of its
+	// channels, so all of its channels are penalized.
+	case *lnwire.FailTemporaryNodeFailure,
+		*lnwire.FailPermanentNodeFailure,
+		*lnwire.FailRequiredNodeFeatureMissing:
+
+		i.failNode(route, errorSourceIdx)
+
+	// Failures that only the final hop is supposed to send, as well as a
+	// node unable to parse the payload intended for it, can't be caused by
+	// anyone but the reporting node.
+	case *lnwire.FailInvalidRealm,
+		*lnwire.FailIncorrectDetails,
+		*lnwire.FailIncorrectPaymentAmount,
+		*lnwire.FailFinalExpiryTooSoon,
+		*lnwire.FailFinalIncorrectCltvExpiry,
+		*lnwire.FailFinalIncorrectHtlcAmount:
+
+		i.failNode(route, errorSourceIdx)
+
 	// In all other cases, we penalize the reporting node. These are all
 	// failures that should not happen.
 	default:
@@ -364,10 +414,21 @@ func (i *interpretedResult) processPaymentOutcomeUnknown(route *route.Route) {
 	i.failPairRange(route, 0, n-1)
 }
 
-// failNode marks the node indicated by idx in the route as failed. This
-// function intentionally panics when the self node is failed.
+// failNode marks the node indicated by idx in the route as failed. It also
+// marks the incoming and outgoing pairs of the node along the route as failed,
+// so that the failure is attributed to the exact connections that were used
+// and not only to the node. This function intentionally panics when the self
+// node is failed.
 func (i *interpretedResult) failNode(rt *route.Route, idx int) {
 	i.nodeFailure = &rt.Hops[idx-1].PubKeyBytes
+
+	// Mark the incoming pair of the node as failed in both directions.
+	i.failPair(rt, idx-1)
+
+	// If this isn't the final node, also mark its outgoing pair as failed.
+	if idx < len(rt.Hops) {
+		i.failPair(rt, idx)
+	}
 }
 
 // failPairRange marks the node pairs from node fromIdx to node toIdx as failed
@@ -387,8 +448,8 @@ func (i *interpretedResult) failPair(
 	pair, _ := getPair(rt, idx)
 
 	// Report pair in both directions without a minimum penalization amount.
-	i.pairResults[pair] = pairResult{}
-	i.pairResults[pair.Reverse()] = pairResult{}
+	i.pairResults[pair] = failPairResult(0)
+	i.pairResults[pair.Reverse()] = failPairResult(0)
 }
 
 // failPairBalance marks a pair as failed with a minimum penalization amount.
@@ -397,9 +458,7 @@ func (i *interpretedResult) failPairBalance(
 
 	pair, amt := getPair(rt, channelIdx)
 
-	i.pairResults[pair] = pairResult{
-		minPenalizeAmt: amt,
-	}
+	i.pairResults[pair] = failPairResult(amt)
 }
 
 // successPairRange marks the node pairs from node fromIdx to node toIdx as
@@ -408,11 +467,9 @@ func (i *interpretedResult) successPairRange(
 	rt *route.Route, fromIdx, toIdx int) {
 
 	for idx := fromIdx; idx <= toIdx; idx++ {
-		pair, _ := getPair(rt, idx)
+		pair, amt := getPair(rt, idx)
 
-		i.pairResults[pair] = pairResult{
-			success: true,
-		}
+		i.pairResults[pair] = successPairResult(amt)
 	}
 }
 
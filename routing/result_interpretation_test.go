@@ -68,12 +68,8 @@ var resultTestCases = []resultTestCase{
 
 		expectedResult: &interpretedResult{
 			pairResults: map[DirectedNodePair]pairResult{
-				getTestPair(0, 1): {
-					success: true,
-				},
-				getTestPair(1, 2): {
-					minPenalizeAmt: 99,
-				},
+				getTestPair(0, 1): successPairResult(100),
+				getTestPair(1, 2): failPairResult(99),
 			},
 		},
 	},
@@ -87,12 +83,12 @@ var resultTestCases = []resultTestCase{
 
 		expectedResult: &interpretedResult{
 			pairResults: map[DirectedNodePair]pairResult{
-				getTestPair(0, 1): {},
-				getTestPair(1, 0): {},
-				getTestPair(1, 2): {},
-				getTestPair(2, 1): {},
-				getTestPair(2, 3): {},
-				getTestPair(3, 2): {},
+				getTestPair(0, 1): failPairResult(0),
+				getTestPair(1, 0): failPairResult(0),
+				getTestPair(1, 2): failPairResult(0),
+				getTestPair(2, 1): failPairResult(0),
+				getTestPair(2, 3): failPairResult(0),
+				getTestPair(3, 2): failPairResult(0),
 			},
 		},
 	},
@@ -107,12 +103,8 @@ var resultTestCases = []resultTestCase{
 
 		expectedResult: &interpretedResult{
 			pairResults: map[DirectedNodePair]pairResult{
-				getTestPair(0, 1): {
-					success: true,
-				},
-				getTestPair(1, 2): {
-					success: true,
-				},
+				getTestPair(0, 1): successPairResult(100),
+				getTestPair(1, 2): successPairResult(99),
 			},
 			finalFailureReason: &reasonIncorrectDetails,
 		},
@@ -126,9 +118,7 @@ var resultTestCases = []resultTestCase{
 
 		expectedResult: &interpretedResult{
 			pairResults: map[DirectedNodePair]pairResult{
-				getTestPair(0, 1): {
-					success: true,
-				},
+				getTestPair(0, 1): successPairResult(100),
 			},
 		},
 	},
@@ -141,17 +131,15 @@ var resultTestCases = []resultTestCase{
 
 		expectedResult: &interpretedResult{
 			pairResults: map[DirectedNodePair]pairResult{
-				getTestPair(0, 1): {
-					success: true,
-				},
-				getTestPair(1, 2): {
-					success: true,
-				},
+				getTestPair(0, 1): successPairResult(100),
+				getTestPair(1, 2): successPairResult(99),
 			},
 		},
 	},
 
-	// Tests a malformed htlc from a direct peer.
+	// Tests a malformed htlc from a direct peer. Both the incoming and
+	// outgoing pairs of the peer are expected to be penalized along with
+	// the peer itself.
 	{
 		name:          "fail malformed htlc from direct peer",
 		route:         &routeTwoHop,
@@ -160,6 +148,12 @@ var resultTestCases = []resultTestCase{
 
 		expectedResult: &interpretedResult{
 			nodeFailure: &hops[1],
+			pairResults: map[DirectedNodePair]pairResult{
+				getTestPair(0, 1): failPairResult(0),
+				getTestPair(1, 0): failPairResult(0),
+				getTestPair(1, 2): failPairResult(0),
+				getTestPair(2, 1): failPairResult(0),
+			},
 		},
 	},
 
@@ -174,6 +168,64 @@ var resultTestCases = []resultTestCase{
 		expectedResult: &interpretedResult{
 			finalFailureReason: &reasonError,
 			nodeFailure:        &hops[1],
+			pairResults: map[DirectedNodePair]pairResult{
+				getTestPair(0, 1): failPairResult(0),
+				getTestPair(1, 0): failPairResult(0),
+			},
+		},
+	},
+
+	// Tests a node failure reported by an intermediate node. The pairs
+	// leading into and out of the node are expected to be penalized, while
+	// the pair of our own channel succeeded.
+	{
+		name:          "fail temporary node failure",
+		route:         &routeFourHop,
+		failureSrcIdx: 2,
+		failure:       &lnwire.FailTemporaryNodeFailure{},
+
+		expectedResult: &interpretedResult{
+			nodeFailure: &hops[2],
+			pairResults: map[DirectedNodePair]pairResult{
+				getTestPair(1, 2): failPairResult(0),
+				getTestPair(2, 1): failPairResult(0),
+				getTestPair(2, 3): failPairResult(0),
+				getTestPair(3, 2): failPairResult(0),
+			},
+		},
+	},
+
+	// Tests that a missing channel feature only penalizes the outgoing
+	// pair of the reporting node.
+	{
+		name:          "fail required channel feature missing",
+		route:         &routeFourHop,
+		failureSrcIdx: 2,
+		failure:       &lnwire.FailRequiredChannelFeatureMissing{},
+
+		expectedResult: &interpretedResult{
+			pairResults: map[DirectedNodePair]pairResult{
+				getTestPair(2, 3): failPairResult(0),
+				getTestPair(3, 2): failPairResult(0),
+			},
+		},
+	},
+
+	// Tests that an expiry too far failure penalizes the incoming pair of
+	// the reporting node.
+	{
+		name:          "fail expiry too far",
+		route:         &routeFourHop,
+		failureSrcIdx: 3,
+		failure:       &lnwire.FailExpiryTooFar{},
+
+		expectedResult: &interpretedResult{
+			pairResults: map[DirectedNodePair]pairResult{
+				getTestPair(0, 1): successPairResult(100),
+				getTestPair(1, 2): successPairResult(99),
+				getTestPair(2, 3): failPairResult(0),
+				getTestPair(3, 2): failPairResult(0),
+			},
 		},
 	},
 }
@@ -0,0 +1,142 @@
+package routing
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v2"
+	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/routing/route"
+	"github.com/decred/dcrlnd/zpay32"
+)
+
+// validateRouteHint checks that the hops of a route hint form a chain of
+// channels leading from the first hop to the target, sorted in forward order.
+// Each hop is the channel starting at its node and ending at the node of the
+// next hop, the last one ending at the target. The channels of the chain that
+// are known to the graph must connect these nodes.
+func validateRouteHint(graph *channeldb.ChannelGraph, routeHint []zpay32.HopHint,
+	source, target route.Vertex) error {
+
+	switch {
+	case len(routeHint) == 0:
+		return errors.New("empty route hint")
+
+	case len(routeHint) > HopLimit:
+		return fmt.Errorf("route hint of %d hops exceeds the hop "+
+			"limit", len(routeHint))
+	}
+
+	nodes := make(map[route.Vertex]struct{}, len(routeHint))
+	channels := make(map[uint64]struct{}, len(routeHint))
+	for i, hopHint := range routeHint {
+		if hopHint.NodeID == nil {
+			return fmt.Errorf("hop %d has no node", i)
+		}
+		node := route.NewVertex(hopHint.NodeID)
+
+		// The chain can't loop, either through one of its nodes or
+		// channels, nor go through the target or through us before
+		// reaching the target.
+		switch {
+		case node == target:
+			return fmt.Errorf("hop %d starts at the target", i)
+
+		case node == source && i != 0:
+			return fmt.Errorf("hop %d goes through the source", i)
+		}
+		if _, ok := nodes[node]; ok {
+			return fmt.Errorf("hop %d loops through node %v", i, node)
+		}
+		nodes[node] = struct{}{}
+
+		if _, ok := channels[hopHint.ChannelID]; ok {
+			return fmt.Errorf("hop %d loops through channel %v", i,
+				hopHint.ChannelID)
+		}
+		channels[hopHint.ChannelID] = struct{}{}
+
+		next := target
+		if i != len(routeHint)-1 {
+			if routeHint[i+1].NodeID == nil {
+				return fmt.Errorf("hop %d has no node", i+1)
+			}
+			next = route.NewVertex(routeHint[i+1].NodeID)
+		}
+
+		// A private channel isn't known to the graph, but a public one
+		// must connect the nodes the hint claims it does.
+		info, _, _, err := graph.FetchChannelEdgesByID(
+			hopHint.ChannelID,
+		)
+		switch {
+		case err == channeldb.ErrEdgeNotFound:
+			continue
+
+		case err != nil:
+			return err
+		}
+
+		connects := (info.NodeKey1Bytes == node &&
+			info.NodeKey2Bytes == next) ||
+			(info.NodeKey1Bytes == next && info.NodeKey2Bytes == node)
+		if !connects {
+			return fmt.Errorf("hop %d: channel %v doesn't connect %v "+
+				"to %v", i, hopHint.ChannelID, node, next)
+		}
+	}
+
+	return nil
+}
+
+// routeHintEdges converts the valid route hints into the additional edges
+// explored by path finding, indexed by the node at the start of each edge. The
+// invalid route hints are skipped.
+func routeHintEdges(graph *channeldb.ChannelGraph,
+	routeHints [][]zpay32.HopHint, source, target route.Vertex) (
+	map[route.Vertex][]*channeldb.ChannelEdgePolicy, error) {
+
+	targetPubKey, err := secp256k1.ParsePubKey(target[:])
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make(map[route.Vertex][]*channeldb.ChannelEdgePolicy)
+	for _, routeHint := range routeHints {
+		err := validateRouteHint(graph, routeHint, source, target)
+		if err != nil {
+			log.Warnf("Skipping invalid route hint to %v: %v",
+				target, err)
+			continue
+		}
+
+		for i, hopHint := range routeHint {
+			// The end node of a hop is the start node of the next
+			// one, or the target for the last hop.
+			endNode := &channeldb.LightningNode{}
+			if i != len(routeHint)-1 {
+				endNode.AddPubKey(routeHint[i+1].NodeID)
+			} else {
+				endNode.AddPubKey(targetPubKey)
+			}
+
+			edge := &channeldb.ChannelEdgePolicy{
+				Node:      endNode,
+				ChannelID: hopHint.ChannelID,
+				FeeBaseMAtoms: lnwire.MilliAtom(
+					hopHint.FeeBaseMAtoms,
+				),
+				FeeProportionalMillionths: lnwire.MilliAtom(
+					hopHint.FeeProportionalMillionths,
+				),
+				TimeLockDelta: hopHint.CLTVExpiryDelta,
+			}
+
+			v := route.NewVertex(hopHint.NodeID)
+			edges[v] = append(edges[v], edge)
+		}
+	}
+
+	return edges, nil
+}
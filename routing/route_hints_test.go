@@ -0,0 +1,255 @@
+package routing
+
+import (
+	"math"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v2"
+	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/routing/route"
+	"github.com/decred/dcrlnd/zpay32"
+)
+
+// newRouteHintTestGraph creates the graph roasbeef -> a -> b -> c, where the
+// channel from b to c charges a high fee, and returns it along with the key of
+// a private target only reachable through route hints.
+func newRouteHintTestGraph(t *testing.T) (*testGraphInstance,
+	*secp256k1.PublicKey) {
+
+	policy := &testChannelPolicy{
+		Expiry:        144,
+		FeeBaseMAtoms: 100,
+		MinHTLC:       1,
+	}
+	testChannels := []*testChannel{
+		symmetricTestChannel("roasbeef", "a", 100000, policy, 1),
+		symmetricTestChannel("a", "b", 100000, policy, 2),
+		symmetricTestChannel("b", "c", 100000, &testChannelPolicy{
+			Expiry:        144,
+			FeeBaseMAtoms: 100000,
+			MinHTLC:       1,
+		}, 3),
+	}
+
+	graph, err := createTestGraphFromChannels(testChannels, "roasbeef")
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+
+	targetKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		graph.cleanUp()
+		t.Fatalf("unable to generate key: %v", err)
+	}
+
+	return graph, targetKey.PubKey()
+}
+
+// hintNode returns the public key of the node of the test graph with the
+// given alias.
+func hintNode(t *testing.T, graph *testGraphInstance,
+	alias string) *secp256k1.PublicKey {
+
+	vertex := graph.aliasMap[alias]
+	pubKey, err := secp256k1.ParsePubKey(vertex[:])
+	if err != nil {
+		t.Fatalf("unable to parse key: %v", err)
+	}
+
+	return pubKey
+}
+
+// TestValidateRouteHint asserts that only the route hints forming a chain of
+// channels leading to the target are valid.
+func TestValidateRouteHint(t *testing.T) {
+	t.Parallel()
+
+	graph, targetKey := newRouteHintTestGraph(t)
+	defer graph.cleanUp()
+
+	source := graph.aliasMap["roasbeef"]
+	target := route.NewVertex(targetKey)
+	node := func(alias string) *secp256k1.PublicKey {
+		return hintNode(t, graph, alias)
+	}
+
+	tests := []struct {
+		name      string
+		routeHint []zpay32.HopHint
+		valid     bool
+	}{
+		{
+			name: "private chain",
+			routeHint: []zpay32.HopHint{
+				{NodeID: node("b"), ChannelID: 3},
+				{NodeID: node("c"), ChannelID: 4},
+			},
+			valid: true,
+		},
+		{
+			name: "single hop",
+			routeHint: []zpay32.HopHint{
+				{NodeID: node("c"), ChannelID: 4},
+			},
+			valid: true,
+		},
+		{
+			name: "empty",
+		},
+		{
+			name: "missing node",
+			routeHint: []zpay32.HopHint{
+				{NodeID: node("b"), ChannelID: 3},
+				{ChannelID: 4},
+			},
+		},
+		{
+			name: "starts at target",
+			routeHint: []zpay32.HopHint{
+				{NodeID: targetKey, ChannelID: 4},
+			},
+		},
+		{
+			name: "goes through source",
+			routeHint: []zpay32.HopHint{
+				{NodeID: node("a"), ChannelID: 5},
+				{NodeID: node("roasbeef"), ChannelID: 6},
+			},
+		},
+		{
+			name: "node loop",
+			routeHint: []zpay32.HopHint{
+				{NodeID: node("b"), ChannelID: 3},
+				{NodeID: node("c"), ChannelID: 5},
+				{NodeID: node("b"), ChannelID: 6},
+			},
+		},
+		{
+			name: "channel loop",
+			routeHint: []zpay32.HopHint{
+				{NodeID: node("b"), ChannelID: 3},
+				{NodeID: node("c"), ChannelID: 3},
+			},
+		},
+		{
+			name: "broken chain",
+			routeHint: []zpay32.HopHint{
+				{NodeID: node("a"), ChannelID: 3},
+				{NodeID: node("c"), ChannelID: 4},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		err := validateRouteHint(
+			graph.graph, test.routeHint, source, target,
+		)
+		switch {
+		case test.valid && err != nil:
+			t.Fatalf("%s: valid route hint was invalid: %v",
+				test.name, err)
+		case !test.valid && err == nil:
+			t.Fatalf("%s: invalid route hint was valid", test.name)
+		}
+	}
+
+	// Invalid route hints are left out of the additional edges.
+	edges, err := routeHintEdges(graph.graph, [][]zpay32.HopHint{
+		tests[0].routeHint, tests[len(tests)-1].routeHint,
+	}, source, target)
+	if err != nil {
+		t.Fatalf("unable to convert route hints: %v", err)
+	}
+	if len(edges) != 2 || len(edges[graph.aliasMap["b"]]) != 1 ||
+		len(edges[graph.aliasMap["c"]]) != 1 {
+
+		t.Fatalf("unexpected additional edges: %v", edges)
+	}
+}
+
+// TestPathFindingMultiHopRouteHint asserts that a target is reached through a
+// chain of hinted channels, applying the policy of each hinted hop instead of
+// the policy the graph knows for the same channel.
+func TestPathFindingMultiHopRouteHint(t *testing.T) {
+	t.Parallel()
+
+	graph, targetKey := newRouteHintTestGraph(t)
+	defer graph.cleanUp()
+
+	source := graph.aliasMap["roasbeef"]
+	target := route.NewVertex(targetKey)
+
+	routeHints := [][]zpay32.HopHint{{
+		{
+			NodeID:          hintNode(t, graph, "b"),
+			ChannelID:       3,
+			FeeBaseMAtoms:   10,
+			CLTVExpiryDelta: 20,
+		},
+		{
+			NodeID:          hintNode(t, graph, "c"),
+			ChannelID:       4,
+			FeeBaseMAtoms:   20,
+			CLTVExpiryDelta: 30,
+		},
+	}}
+	additionalEdges, err := routeHintEdges(
+		graph.graph, routeHints, source, target,
+	)
+	if err != nil {
+		t.Fatalf("unable to convert route hints: %v", err)
+	}
+
+	paymentAmt := lnwire.NewMAtomsFromAtoms(100)
+	path, err := findPath(
+		&graphParams{
+			graph:           graph.graph,
+			additionalEdges: additionalEdges,
+		},
+		&RestrictParams{
+			FeeLimit:          noFeeLimit,
+			ProbabilitySource: noProbabilitySource,
+			CltvLimit:         math.MaxUint32,
+		},
+		testPathFindingConfig, source, target, paymentAmt,
+	)
+	if err != nil {
+		t.Fatalf("unable to find path: %v", err)
+	}
+
+	expectedChans := []uint64{1, 2, 3, 4}
+	if len(path) != len(expectedChans) {
+		t.Fatalf("expected %d hops, got %d", len(expectedChans),
+			len(path))
+	}
+	for i, edge := range path {
+		if edge.ChannelID != expectedChans[i] {
+			t.Fatalf("expected channel %v at hop %d, got %v",
+				expectedChans[i], i, edge.ChannelID)
+		}
+	}
+
+	const startingHeight = 100
+	rt, err := newRoute(
+		paymentAmt, source, path, startingHeight,
+		zpay32.DefaultFinalCLTVDelta, nil, nil,
+	)
+	if err != nil {
+		t.Fatalf("unable to create route: %v", err)
+	}
+
+	// The fees of b and c are those of the hint, not the fee the graph
+	// knows for the channel from b to c.
+	expectedFees := lnwire.MilliAtom(100 + 10 + 20)
+	if rt.TotalFees() != expectedFees {
+		t.Fatalf("expected fees %v, got %v", expectedFees,
+			rt.TotalFees())
+	}
+
+	expectedTimeLock := uint32(startingHeight) +
+		uint32(zpay32.DefaultFinalCLTVDelta) + 144 + 20 + 30
+	if rt.TotalTimeLock != expectedTimeLock {
+		t.Fatalf("expected time lock %v, got %v", expectedTimeLock,
+			rt.TotalTimeLock)
+	}
+}
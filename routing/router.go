@@ -17,6 +17,7 @@ import (
 	bolt "go.etcd.io/bbolt"
 
 	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/clock"
 	"github.com/decred/dcrlnd/htlcswitch"
 	"github.com/decred/dcrlnd/input"
 	"github.com/decred/dcrlnd/lntypes"
@@ -51,6 +52,20 @@ var (
 	// ErrRouterShuttingDown is returned if the router is in the process of
 	// shutting down.
 	ErrRouterShuttingDown = fmt.Errorf("router shutting down")
+
+	// ErrPaymentNotInFlight is returned when attempting to cancel a
+	// payment which isn't currently being sent by the router.
+	ErrPaymentNotInFlight = fmt.Errorf("payment not in flight")
+
+	// ErrMaxInFlightPerDestExceeded is returned when sending a payment
+	// would raise the total amount of our payments in flight toward its
+	// destination above MaxInFlightPerDest.
+	ErrMaxInFlightPerDestExceeded = fmt.Errorf("payment would exceed the " +
+		"maximum amount in flight toward its destination")
+
+	// ErrGraphNotSynced is returned when a payment is refused because the
+	// initial historical sync of the graph hasn't completed yet.
+	ErrGraphNotSynced = fmt.Errorf("graph not synced")
 )
 
 // ChannelGraphSource represents the source of information about the topology
@@ -224,6 +239,15 @@ type ChannelPolicy struct {
 	// MaxHTLC is the maximum HTLC size including fees we are allowed to
 	// forward over this channel.
 	MaxHTLC lnwire.MilliAtom
+
+	// MinHTLC is the minimum HTLC size we are allowed to forward over this
+	// channel. If nil, the minimum HTLC is left unchanged.
+	MinHTLC *lnwire.MilliAtom
+
+	// InboundFee is the fee charged, or the discount granted if negative,
+	// for the HTLCs received over this channel. If nil, the inbound fee is
+	// left unchanged.
+	InboundFee *lnwire.InboundFee
 }
 
 // Config defines the configuration for the ChannelRouter. ALL elements within
@@ -285,6 +309,17 @@ type Config struct {
 	// returned.
 	QueryBandwidth func(edge *channeldb.ChannelEdgeInfo) lnwire.MilliAtom
 
+	// BandwidthPenalties is an optional record of local channels that
+	// recently failed to carry an htlc due to insufficient bandwidth. The
+	// router reports such failures to it, and caps the bandwidth hints of
+	// the penalized channels accordingly.
+	BandwidthPenalties *BandwidthPenalties
+
+	// PaymentMetrics is an optional record of the success rates and
+	// attempt latencies of our payments per destination, which the router
+	// reports the results of its payments to.
+	PaymentMetrics *PaymentMetrics
+
 	// NextPaymentID is a method that guarantees to return a new, unique ID
 	// each time it is called. This is used by the router to generate a
 	// unique payment ID for each payment it attempts to send, such that
@@ -296,8 +331,41 @@ type Config struct {
 	// from blocking initial usage of the daemon.
 	AssumeChannelValid bool
 
+	// StrictZombiePruning toggles whether channels are pruned as zombies
+	// as soon as either of their edges hasn't been updated within
+	// ChannelPruneExpiry, as opposed to only once both of them haven't.
+	// Pruned channels are added to the zombie index, and are resurrected
+	// if a fresh update for them is received.
+	StrictZombiePruning bool
+
 	// PathFindingConfig defines global path finding parameters.
 	PathFindingConfig PathFindingConfig
+
+	// PathFindingWorkers is the number of path finding queries made
+	// through FindRoute that are processed concurrently. If not positive,
+	// DefaultPathFindingWorkers is used.
+	PathFindingWorkers int
+
+	// MaxInFlightPerDest is the maximum total amount of our own payments
+	// that may be in flight toward a single destination at once. Payments
+	// which would exceed it are rejected until previous payments to the
+	// destination resolve. Zero disables the limit.
+	MaxInFlightPerDest lnwire.MilliAtom
+
+	// GraphSyncStatus returns whether the initial historical sync of the
+	// graph has completed, along with a channel that is closed once this
+	// changes. If nil, the graph is always considered synced.
+	GraphSyncStatus func() (bool, <-chan struct{})
+
+	// UnsyncedGraphAction determines what happens to the payments sent
+	// before the graph is synced, unless they override it. If
+	// UnsyncedGraphDefault, they are sent anyway.
+	UnsyncedGraphAction UnsyncedGraphAction
+
+	// Clock is the time source used to date payments, to time out payment
+	// attempts and to determine which channels are stale. If nil, the
+	// system time is used.
+	Clock clock.Clock
 }
 
 // EdgeLocator is a struct used to identify a specific edge.
@@ -365,6 +433,19 @@ type ChannelRouter struct {
 	// existing client.
 	ntfnClientUpdates chan *topologyClientUpdate
 
+	// topologySeq is the sequence number of the last topology change
+	// notified to the clients. It's seeded with the router's start time so
+	// that the resume point of a client from a previous run is never
+	// mistaken for one of the current run.
+	topologySeq uint64
+
+	// topologyBacklog holds the most recent topology changes, in the order
+	// of their sequence number, to be replayed to resuming clients.
+	topologyBacklog []*TopologyChange
+
+	// topologyBacklogMtx guards topologySeq and topologyBacklog.
+	topologyBacklogMtx sync.Mutex
+
 	// channelEdgeMtx is a mutex we use to make sure we process only one
 	// ChannelEdgePolicy at a time for a given channelID, to ensure
 	// consistency between the various database accesses.
@@ -378,6 +459,26 @@ type ChannelRouter struct {
 	// announcements over a window of defaultStatInterval.
 	stats *routerStats
 
+	// activePayments maps the payment hash of every payment the router is
+	// currently sending to a channel which is closed once the user
+	// requests the payment to be canceled.
+	activePayments    map[lntypes.Hash]chan struct{}
+	activePaymentsMtx sync.Mutex
+
+	// inFlightPerDest tracks the total amount of our payments currently
+	// in flight toward each destination, in order to enforce
+	// MaxInFlightPerDest.
+	inFlightPerDest    map[route.Vertex]lnwire.MilliAtom
+	inFlightPerDestMtx sync.Mutex
+
+	// pathFindingReqs is a channel over which the path finding queries
+	// made through FindRoute are handed to the path finding workers.
+	pathFindingReqs chan *pathFindingRequest
+
+	// bandwidthSnapshot is the snapshot of the bandwidth hints of our
+	// channels shared by the path finding workers.
+	bandwidthSnapshot bandwidthSnapshot
+
 	sync.RWMutex
 
 	quit chan struct{}
@@ -395,6 +496,10 @@ var _ ChannelGraphSource = (*ChannelRouter)(nil)
 // to fully sync to the latest state of the UTXO set.
 func New(cfg Config) (*ChannelRouter, error) {
 
+	if cfg.Clock == nil {
+		cfg.Clock = clock.NewDefaultClock()
+	}
+
 	selfNode, err := cfg.Graph.SourceNode()
 	if err != nil {
 		return nil, err
@@ -405,10 +510,14 @@ func New(cfg Config) (*ChannelRouter, error) {
 		networkUpdates:    make(chan *routingMsg),
 		topologyClients:   make(map[uint64]*topologyClient),
 		ntfnClientUpdates: make(chan *topologyClientUpdate),
+		topologySeq:       uint64(time.Now().UnixNano()),
 		channelEdgeMtx:    multimutex.NewMutex(),
 		selfNode:          selfNode,
 		statTicker:        ticker.New(defaultStatInterval),
 		stats:             new(routerStats),
+		activePayments:    make(map[lntypes.Hash]chan struct{}),
+		inFlightPerDest:   make(map[route.Vertex]lnwire.MilliAtom),
+		pathFindingReqs:   make(chan *pathFindingRequest),
 		quit:              make(chan struct{}),
 	}
 
@@ -533,7 +642,32 @@ func (r *ChannelRouter) Start() error {
 				PaymentHash: payment.Info.PaymentHash,
 			}
 
-			_, _, err = r.sendPayment(payment.Attempt, lPayment, paySession)
+			// Payments only have a single attempt in flight at a
+			// time, which is the one we resume.
+			var attempt *channeldb.PaymentAttemptInfo
+			if n := len(payment.Attempts); n > 0 {
+				attempt = &payment.Attempts[n-1]
+			}
+
+			// Set the target from the route of the attempt, so
+			// the outcome is attributed to the right destination
+			// in the payment metrics.
+			if attempt != nil {
+				hops := attempt.Route.Hops
+				if len(hops) > 0 {
+					lPayment.Target =
+						hops[len(hops)-1].PubKeyBytes
+				}
+			}
+
+			// The resumed payment counts toward the amount in
+			// flight to its destination until it resolves, though
+			// it's never rejected as it's already in flight.
+			amt := payment.Info.Value
+			r.addInFlight(lPayment.Target, amt)
+			defer r.releaseInFlight(lPayment.Target, amt)
+
+			_, _, err = r.sendPayment(attempt, lPayment, paySession)
 			if err != nil {
 				log.Errorf("Resuming payment with hash %v "+
 					"failed: %v.", payment.Info.PaymentHash, err)
@@ -548,6 +682,15 @@ func (r *ChannelRouter) Start() error {
 	r.wg.Add(1)
 	go r.networkHandler()
 
+	numWorkers := r.cfg.PathFindingWorkers
+	if numWorkers <= 0 {
+		numWorkers = DefaultPathFindingWorkers
+	}
+	for i := 0; i < numWorkers; i++ {
+		r.wg.Add(1)
+		go r.pathFindingWorker()
+	}
+
 	return nil
 }
 
@@ -712,7 +855,8 @@ func (r *ChannelRouter) syncGraphWithChain() error {
 
 // pruneZombieChans is a method that will be called periodically to prune out
 // any "zombie" channels. We consider channels zombies if *both* edges haven't
-// been updated since our zombie horizon. If AssumeChannelValid is present,
+// been updated since our zombie horizon, or if *either* of them hasn't when
+// StrictZombiePruning is set. If AssumeChannelValid is present,
 // we'll also consider channels zombies if *both* edges are disabled. This
 // usually signals that a channel has been closed on-chain. We do this
 // periodically to keep a healthy, lively routing table.
@@ -767,8 +911,15 @@ func (r *ChannelRouter) pruneZombieChans() error {
 		}
 
 		// If the channel is not considered zombie, we can move on to
-		// the next.
-		if !e1Zombie || !e2Zombie {
+		// the next. With strict pruning, a single stale edge is enough
+		// for the channel to be considered a zombie, since payments
+		// can't be routed reliably through a channel one of whose
+		// endpoints stopped refreshing its policy.
+		switch {
+		case r.cfg.StrictZombiePruning && !e1Zombie && !e2Zombie:
+			return nil
+
+		case !r.cfg.StrictZombiePruning && (!e1Zombie || !e2Zombie):
 			return nil
 		}
 
@@ -806,7 +957,7 @@ func (r *ChannelRouter) pruneZombieChans() error {
 	}
 
 	startTime := time.Unix(0, 0)
-	endTime := time.Now().Add(-1 * chanExpiry)
+	endTime := r.cfg.Clock.Now().Add(-1 * chanExpiry)
 	oldEdges, err := r.cfg.Graph.ChanUpdatesInHorizon(startTime, endTime)
 	if err != nil {
 		return fmt.Errorf("unable to fetch expired channel updates "+
@@ -817,7 +968,8 @@ func (r *ChannelRouter) pruneZombieChans() error {
 		filterPruneChans(u.Info, u.Policy1, u.Policy2)
 	}
 
-	log.Infof("Pruning %v zombie channels", len(chansToPrune))
+	log.Infof("Pruning %v zombie channels (strict=%v)", len(chansToPrune),
+		r.cfg.StrictZombiePruning)
 
 	// With the set of zombie-like channels obtained, we'll do another pass
 	// to delete them from the channel graph.
@@ -1041,12 +1193,7 @@ func (r *ChannelRouter) networkHandler() {
 				continue
 			}
 
-			r.Lock()
-			r.topologyClients[ntfnUpdate.clientID] = &topologyClient{
-				ntfnChan: ntfnUpdate.ntfnChan,
-				exit:     make(chan struct{}),
-			}
-			r.Unlock()
+			ntfnUpdate.errChan <- r.registerTopologyClient(ntfnUpdate)
 
 		// The graph prune ticker has ticked, so we'll examine the
 		// state of the known graph to filter out any zombie channels
@@ -1112,6 +1259,54 @@ func (r *ChannelRouter) assertNodeAnnFreshness(node route.Vertex,
 	return nil
 }
 
+// addAliasEdge adds the edge of one of our zero-conf channels identified by an
+// alias short channel ID to the graph, and starts watching its funding output
+// so the edge is pruned once the channel is closed.
+func (r *ChannelRouter) addAliasEdge(edge *channeldb.ChannelEdgeInfo) error {
+	if edge.NodeKey1Bytes != r.selfNode.PubKeyBytes &&
+		edge.NodeKey2Bytes != r.selfNode.PubKeyBytes {
+
+		return errors.Errorf("alias chan_id=%v isn't one of our "+
+			"channels", edge.ChannelID)
+	}
+
+	witnessScript, err := input.GenMultiSigScript(
+		edge.DecredKey1Bytes[:], edge.DecredKey2Bytes[:],
+	)
+	if err != nil {
+		return err
+	}
+	fundingPkScript, err := input.ScriptHashPkScript(witnessScript)
+	if err != nil {
+		return err
+	}
+
+	if err := r.cfg.Graph.AddChannelEdge(edge); err != nil {
+		return errors.Errorf("unable to add edge: %v", err)
+	}
+
+	log.Debugf("New alias channel added! Link connects %x and %x with "+
+		"ChannelPoint(%v): chan_id=%v, capacity=%v",
+		edge.NodeKey1Bytes, edge.NodeKey2Bytes, edge.ChannelPoint,
+		edge.ChannelID, edge.Capacity)
+	r.stats.incNumEdgesDiscovered()
+
+	filterUpdate := []channeldb.EdgePoint{
+		{
+			FundingPkScript: fundingPkScript,
+			OutPoint:        edge.ChannelPoint,
+		},
+	}
+	err = r.cfg.ChainView.UpdateFilter(
+		filterUpdate, int64(atomic.LoadUint32(&r.bestHeight)),
+	)
+	if err != nil {
+		return errors.Errorf("unable to update chain view: %v", err)
+	}
+
+	return nil
+}
+
 // processUpdate processes a new relate authenticated channel/edge, node or
 // channel/edge update network update. If the update didn't affect the internal
 // state of the draft due to either being out of date, invalid, or redundant,
@@ -1171,10 +1366,19 @@ func (r *ChannelRouter) processUpdate(msg interface{}) error {
 			break
 		}
 
+		// Edges identified by an alias short channel ID are our own
+		// zero-conf channels, whose funding transaction may not have
+		// confirmed yet. As such, they can't be validated against the
+		// chain, and the channel point and capacity set by the funding
+		// manager are trusted instead.
+		channelID := lnwire.NewShortChanIDFromInt(msg.ChannelID)
+		if channelID.IsAlias() {
+			return r.addAliasEdge(msg)
+		}
+
 		// Before we can add the channel to the channel graph, we need
 		// to obtain the full funding outpoint that's encoded within
 		// the channel ID.
-		channelID := lnwire.NewShortChanIDFromInt(msg.ChannelID)
 		fundingTx, err := r.fetchFundingTx(&channelID)
 		if err != nil {
 			return errors.Errorf("unable to fetch funding tx for "+
@@ -1415,25 +1619,11 @@ func (r *ChannelRouter) FindRoute(source, target route.Vertex,
 		return nil, newErrf(ErrTargetNotInNetwork, "target not found")
 	}
 
-	// We'll attempt to obtain a set of bandwidth hints that can help us
-	// eliminate certain routes early on in the path finding process.
-	bandwidthHints, err := generateBandwidthHints(
-		r.selfNode, r.cfg.QueryBandwidth,
-	)
-	if err != nil {
-		return nil, err
-	}
-
 	// Now that we know the destination is reachable within the graph, we'll
-	// execute our path finding algorithm.
-	path, err := findPath(
-		&graphParams{
-			graph:          r.cfg.Graph,
-			bandwidthHints: bandwidthHints,
-		},
-		restrictions, &r.cfg.PathFindingConfig,
-		source, target, amt,
-	)
+	// hand the query to one of our path finding workers. They make use of
+	// a shared snapshot of the bandwidth hints of our channels, which help
+	// us eliminate certain routes early on in the path finding process.
+	path, err := r.queryPath(source, target, amt, restrictions)
 	if err != nil {
 		return nil, err
 	}
@@ -1448,7 +1638,7 @@ func (r *ChannelRouter) FindRoute(source, target route.Vertex,
 	// Create the route with absolute time lock values.
 	route, err := newRoute(
 		amt, source, path, uint32(currentHeight), finalCLTVDelta,
-		destTlvRecords,
+		restrictions.DestFeatures, destTlvRecords,
 	)
 	if err != nil {
 		return nil, err
@@ -1579,6 +1769,22 @@ type LightningPayment struct {
 	// TODO(halseth): make wallclock time to allow resume after startup.
 	PayAttemptTimeout time.Duration
 
+	// MaxAttempts is the maximum number of attempts that will be made to
+	// complete this payment. A zero value means the number of attempts
+	// isn't bounded.
+	MaxAttempts uint32
+
+	// MaxTotalFee is the maximum sum of the fees of all routes attempted
+	// for this payment. Each attempt is restricted to what's left of this
+	// budget, on top of FeeLimit. A zero value means only FeeLimit applies.
+	MaxTotalFee lnwire.MilliAtom
+
+	// MaxDuration is the maximum wall clock time that may pass between
+	// sending the payment and launching any of its attempts. As opposed
+	// to PayAttemptTimeout, exceeding it fails the payment as having
+	// exhausted its budget. A zero value means the duration isn't bounded.
+	MaxDuration time.Duration
+
 	// RouteHints represents the different routing hints that can be used to
 	// assist a payment in reaching its destination successfully. These
 	// hints will act as intermediate hops along the route.
@@ -1589,9 +1795,13 @@ type LightningPayment struct {
 	// destination successfully.
 	RouteHints [][]zpay32.HopHint
 
-	// OutgoingChannelID is the channel that needs to be taken to the first
-	// hop. If nil, any channel may be used.
-	OutgoingChannelID *uint64
+	// OutgoingChannelIDs is the set of channels of which one needs to be
+	// taken to the first hop. If empty, any channel may be used.
+	OutgoingChannelIDs []uint64
+
+	// LastHop is the pubkey of the node that needs to be the last hop
+	// before the target. If nil, any node may be the last hop.
+	LastHop *route.Vertex
 
 	// PaymentRequest is an optional payment request that this payment is
 	// attempting to complete.
@@ -1602,6 +1812,33 @@ type LightningPayment struct {
 	// understand this new onion payload format, then the payment will
 	// fail.
 	FinalDestRecords []tlv.Record
+
+	// DestFeatures is the optional feature vector the destination signaled
+	// in its invoice. It is used to construct a TLV payload for the final
+	// hop when the destination isn't part of our graph, and to refuse
+	// paying destinations that only understand legacy payloads when TLV
+	// records, such as the payment address, must be delivered to them.
+	DestFeatures *lnwire.FeatureVector
+
+	// ShadowRoute, if set, extends the final CLTV delta of the routes
+	// attempted with a random number of blocks, as if the routes went on
+	// past the destination. This keeps the nodes along the routes from
+	// inferring their distance to the destination from the time lock of
+	// the HTLC.
+	ShadowRoute bool
+
+	// Label is an optional user-supplied note stored along with the
+	// payment.
+	Label string
+
+	// Reference is an optional user-supplied reference linking the
+	// payment to its purpose, stored along with the payment.
+	Reference string
+
+	// UnsyncedGraphAction determines what happens to the payment if it's
+	// sent before the graph is synced. If UnsyncedGraphDefault, the action
+	// configured for the router applies.
+	UnsyncedGraphAction UnsyncedGraphAction
 }
 
 // SendPayment attempts to send a payment as described within the passed
@@ -1614,6 +1851,17 @@ type LightningPayment struct {
 func (r *ChannelRouter) SendPayment(payment *LightningPayment) ([32]byte,
 	*route.Route, error) {
 
+	err := r.awaitGraphSync(r.unsyncedGraphAction(payment))
+	if err != nil {
+		return [32]byte{}, nil, err
+	}
+
+	err = r.reserveInFlight(payment.Target, payment.Amount)
+	if err != nil {
+		return [32]byte{}, nil, err
+	}
+	defer r.releaseInFlight(payment.Target, payment.Amount)
+
 	paySession, err := r.preparePayment(payment)
 	if err != nil {
 		return [32]byte{}, nil, err
@@ -1627,8 +1875,23 @@ func (r *ChannelRouter) SendPayment(payment *LightningPayment) ([32]byte,
 // SendPaymentAsync is the non-blocking version of SendPayment. The payment
 // result needs to be retrieved via the control tower.
 func (r *ChannelRouter) SendPaymentAsync(payment *LightningPayment) error {
+	// A payment queued until the graph is synced is only held back once
+	// it's recorded, so that it can be tracked in the meantime.
+	action := r.unsyncedGraphAction(payment)
+	if action != UnsyncedGraphQueue {
+		if err := r.awaitGraphSync(action); err != nil {
+			return err
+		}
+	}
+
+	err := r.reserveInFlight(payment.Target, payment.Amount)
+	if err != nil {
+		return err
+	}
+
 	paySession, err := r.preparePayment(payment)
 	if err != nil {
+		r.releaseInFlight(payment.Target, payment.Amount)
 		return err
 	}
 
@@ -1637,6 +1900,15 @@ func (r *ChannelRouter) SendPaymentAsync(payment *LightningPayment) error {
 	r.wg.Add(1)
 	go func() {
 		defer r.wg.Done()
+		defer r.releaseInFlight(payment.Target, payment.Amount)
+
+		if action == UnsyncedGraphQueue {
+			if err := r.awaitGraphSync(action); err != nil {
+				log.Errorf("Queued payment with hash %x "+
+					"failed: %v", payment.PaymentHash, err)
+				return
+			}
+		}
 
 		_, _, err := r.sendPayment(nil, payment, paySession)
 		if err != nil {
@@ -1670,8 +1942,11 @@ func (r *ChannelRouter) preparePayment(payment *LightningPayment) (
 	info := &channeldb.PaymentCreationInfo{
 		PaymentHash:    payment.PaymentHash,
 		Value:          payment.Amount,
-		CreationDate:   time.Now(),
+		CreationDate:   r.cfg.Clock.Now(),
 		PaymentRequest: payment.PaymentRequest,
+		FeeLimit:       payment.FeeLimit,
+		Label:          payment.Label,
+		Reference:      payment.Reference,
 	}
 
 	err = r.cfg.Control.InitPayment(payment.PaymentHash, info)
@@ -1694,16 +1969,29 @@ func (r *ChannelRouter) SendToRoute(hash lntypes.Hash, route *route.Route) (
 	// Calculate amount paid to receiver.
 	amt := route.TotalAmount - route.TotalFees()
 
+	// The route variable shadows the route package here, so the target
+	// is declared through its underlying type.
+	var target [33]byte
+	if len(route.Hops) > 0 {
+		target = route.Hops[len(route.Hops)-1].PubKeyBytes
+	}
+
+	err := r.reserveInFlight(target, amt)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	defer r.releaseInFlight(target, amt)
+
 	// Record this payment hash with the ControlTower, ensuring it is not
 	// already in-flight.
 	info := &channeldb.PaymentCreationInfo{
 		PaymentHash:    hash,
 		Value:          amt,
-		CreationDate:   time.Now(),
+		CreationDate:   r.cfg.Clock.Now(),
 		PaymentRequest: nil,
 	}
 
-	err := r.cfg.Control.InitPayment(hash, info)
+	err = r.cfg.Control.InitPayment(hash, info)
 	if err != nil {
 		return [32]byte{}, err
 	}
@@ -1717,6 +2005,7 @@ func (r *ChannelRouter) SendToRoute(hash lntypes.Hash, route *route.Route) (
 	// attempt.
 	payment := &LightningPayment{
 		PaymentHash: hash,
+		Target:      target,
 	}
 
 	// Since this is the first time this payment is being made, we pass nil
@@ -1789,30 +2078,159 @@ func (r *ChannelRouter) sendPayment(
 		return [32]byte{}, nil, err
 	}
 
+	// Register the payment as active, such that it can be canceled by the
+	// user while we're sending it.
+	cancelChan := r.registerActivePayment(payment.PaymentHash)
+	defer r.unregisterActivePayment(payment.PaymentHash)
+
 	// Now set up a paymentLifecycle struct with these params, such that we
 	// can resume the payment from the current state.
 	p := &paymentLifecycle{
 		router:         r,
 		payment:        payment,
 		paySession:     paySession,
+		cancelChan:     cancelChan,
 		currentHeight:  currentHeight,
 		finalCLTVDelta: payment.FinalCLTVDelta,
 		attempt:        existingAttempt,
 		circuit:        nil,
 		lastError:      nil,
+		startTime:      r.cfg.Clock.Now(),
+	}
+
+	// The fee quoted for a resumed payment was recorded along with its
+	// attempt, whose fee is still committed.
+	if existingAttempt != nil {
+		p.quotedFee = existingAttempt.QuotedFee
+		p.inFlightFees = existingAttempt.Route.TotalFees()
 	}
 
 	// If a timeout is specified, create a timeout channel. If no timeout is
 	// specified, the channel is left nil and will never abort the payment
 	// loop.
 	if payment.PayAttemptTimeout != 0 {
-		p.timeoutChan = time.After(payment.PayAttemptTimeout)
+		p.timeoutChan = r.cfg.Clock.TickAfter(
+			payment.PayAttemptTimeout,
+		)
 	}
 
 	return p.resumePayment()
 
 }
 
+// reserveInFlight accounts for a new payment of the given amount toward the
+// given destination, failing with ErrMaxInFlightPerDestExceeded if it would
+// raise the total amount in flight toward it above MaxInFlightPerDest. The
+// reserved amount must be released through releaseInFlight once the payment
+// resolves.
+func (r *ChannelRouter) reserveInFlight(target route.Vertex,
+	amt lnwire.MilliAtom) error {
+
+	if r.cfg.MaxInFlightPerDest == 0 {
+		return nil
+	}
+
+	r.inFlightPerDestMtx.Lock()
+	defer r.inFlightPerDestMtx.Unlock()
+
+	inFlight := r.inFlightPerDest[target]
+	if inFlight+amt > r.cfg.MaxInFlightPerDest {
+		log.Warnf("Rejecting payment of %v to %v: %v already in "+
+			"flight toward it, limit is %v", amt, target, inFlight,
+			r.cfg.MaxInFlightPerDest)
+
+		return ErrMaxInFlightPerDestExceeded
+	}
+
+	r.inFlightPerDest[target] = inFlight + amt
+
+	return nil
+}
+
+// addInFlight accounts for a payment of the given amount toward the given
+// destination which is already in flight, regardless of MaxInFlightPerDest.
+func (r *ChannelRouter) addInFlight(target route.Vertex, amt lnwire.MilliAtom) {
+	if r.cfg.MaxInFlightPerDest == 0 {
+		return
+	}
+
+	r.inFlightPerDestMtx.Lock()
+	r.inFlightPerDest[target] += amt
+	r.inFlightPerDestMtx.Unlock()
+}
+
+// releaseInFlight releases the amount accounted for a resolved payment toward
+// the given destination.
+func (r *ChannelRouter) releaseInFlight(target route.Vertex,
+	amt lnwire.MilliAtom) {
+
+	if r.cfg.MaxInFlightPerDest == 0 {
+		return
+	}
+
+	r.inFlightPerDestMtx.Lock()
+	defer r.inFlightPerDestMtx.Unlock()
+
+	inFlight := r.inFlightPerDest[target]
+	if inFlight <= amt {
+		delete(r.inFlightPerDest, target)
+		return
+	}
+	r.inFlightPerDest[target] = inFlight - amt
+}
+
+// registerActivePayment marks the payment with the given hash as being sent
+// by the router, and returns the channel that is closed once the payment is
+// canceled.
+func (r *ChannelRouter) registerActivePayment(
+	paymentHash lntypes.Hash) <-chan struct{} {
+
+	r.activePaymentsMtx.Lock()
+	defer r.activePaymentsMtx.Unlock()
+
+	cancelChan := make(chan struct{})
+	r.activePayments[paymentHash] = cancelChan
+
+	return cancelChan
+}
+
+// unregisterActivePayment removes the payment with the given hash from the set
+// of active payments.
+func (r *ChannelRouter) unregisterActivePayment(paymentHash lntypes.Hash) {
+	r.activePaymentsMtx.Lock()
+	defer r.activePaymentsMtx.Unlock()
+
+	delete(r.activePayments, paymentHash)
+}
+
+// CancelPayment requests the payment with the given hash to be canceled. No
+// new attempts are launched for the payment after this call. If an htlc is
+// still outstanding, its result is awaited. The payment is then either marked
+// as succeeded in case the htlc settled, or failed with the canceled reason
+// otherwise. The final outcome can be tracked through the ControlTower.
+//
+// NOTE: The cancel request isn't persisted. If the router restarts before the
+// payment reached a terminal state, the payment will be resumed.
+func (r *ChannelRouter) CancelPayment(paymentHash lntypes.Hash) error {
+	r.activePaymentsMtx.Lock()
+	defer r.activePaymentsMtx.Unlock()
+
+	cancelChan, ok := r.activePayments[paymentHash]
+	if !ok {
+		return ErrPaymentNotInFlight
+	}
+
+	select {
+	case <-cancelChan:
+		// Already canceled.
+	default:
+		log.Infof("Canceling payment %v", paymentHash)
+		close(cancelChan)
+	}
+
+	return nil
+}
+
 // tryApplyChannelUpdate tries to apply a channel update present in the failure
 // message if any.
 func (r *ChannelRouter) tryApplyChannelUpdate(rt *route.Route,
@@ -1907,6 +2325,17 @@ func (r *ChannelRouter) processSendError(paymentID uint64, rt *route.Route,
 		}
 	}
 
+	// If one of our own channels was unable to carry the htlc, we'll
+	// temporarily cap its bandwidth such that immediate retries don't
+	// select the same exhausted channel again.
+	if _, ok := failureMessage.(*lnwire.FailTemporaryChannelFailure); ok &&
+		failureSourceIdx == 0 && len(rt.Hops) > 0 {
+
+		r.cfg.BandwidthPenalties.ReportFailure(
+			rt.Hops[0].ChannelID, rt.TotalAmount,
+		)
+	}
+
 	log.Tracef("Node=%v reported failure when sending htlc",
 		failureSourceIdx)
 
@@ -2226,8 +2655,12 @@ func (r *ChannelRouter) MarkEdgeLive(chanID lnwire.ShortChannelID) error {
 // these hints allows us to reduce the number of extraneous attempts as we can
 // skip channels that are inactive, or just don't have enough bandwidth to
 // carry the payment.
+//
+// The optional set of penalties caps the bandwidth of local channels that
+// recently failed to carry an htlc.
 func generateBandwidthHints(sourceNode *channeldb.LightningNode,
-	queryBandwidth func(*channeldb.ChannelEdgeInfo) lnwire.MilliAtom) (map[uint64]lnwire.MilliAtom, error) {
+	queryBandwidth func(*channeldb.ChannelEdgeInfo) lnwire.MilliAtom,
+	penalties *BandwidthPenalties) (map[uint64]lnwire.MilliAtom, error) {
 
 	// First, we'll collect the set of outbound edges from the target
 	// source node.
@@ -2248,7 +2681,9 @@ func generateBandwidthHints(sourceNode *channeldb.LightningNode,
 	// to date values.
 	bandwidthHints := make(map[uint64]lnwire.MilliAtom)
 	for _, localChan := range localChans {
-		bandwidthHints[localChan.ChannelID] = queryBandwidth(localChan)
+		bandwidthHints[localChan.ChannelID] = penalties.Apply(
+			localChan.ChannelID, queryBandwidth(localChan),
+		)
 	}
 
 	return bandwidthHints, nil
@@ -2283,7 +2718,15 @@ func (r *runningAmounts) prependChannel(policy *channeldb.ChannelEdgePolicy,
 	// validate it against the channel constraints and return the new
 	// running amount.
 	if !canIncreaseAmt {
-		if amt < policy.MinHTLC || amt > maxHtlc {
+		if amt < policy.MinHTLC {
+			return runningAmounts{}, ErrHtlcBelowMinimum{
+				channelID: policy.ChannelID,
+				amt:       amt,
+				minHtlc:   policy.MinHTLC,
+			}
+		}
+
+		if amt > maxHtlc {
 			return runningAmounts{}, fmt.Errorf("channel htlc "+
 				"constraints [%v - %v] violated with amt %v",
 				policy.MinHTLC, maxHtlc, amt)
@@ -2343,14 +2786,39 @@ func (r *runningAmounts) prependChannel(policy *channeldb.ChannelEdgePolicy,
 type ErrNoChannel struct {
 	position int
 	fromNode route.Vertex
+
+	// reason is the constraint violated by the last candidate channel, if
+	// any candidates were found.
+	reason error
 }
 
 // Error returns a human readable string describing the error.
 func (e ErrNoChannel) Error() string {
+	if e.reason != nil {
+		return fmt.Sprintf("no matching outgoing channel available "+
+			"for node %v (%v): %v", e.position, e.fromNode,
+			e.reason)
+	}
+
 	return fmt.Sprintf("no matching outgoing channel available for "+
 		"node %v (%v)", e.position, e.fromNode)
 }
 
+// ErrHtlcBelowMinimum is returned when a route cannot be built because the
+// amount that would be carried by one of its channels is below the minimum
+// htlc amount of that channel.
+type ErrHtlcBelowMinimum struct {
+	channelID uint64
+	amt       lnwire.MilliAtom
+	minHtlc   lnwire.MilliAtom
+}
+
+// Error returns a human readable string describing the error.
+func (e ErrHtlcBelowMinimum) Error() string {
+	return fmt.Sprintf("amount %v is below the minimum htlc of %v of "+
+		"channel %v", e.amt, e.minHtlc, e.channelID)
+}
+
 // BuildRoute returns a fully specified route based on a list of pubkeys. If
 // amount is nil, the minimum routable amount is used. To force a specific
 // outgoing channel, use the outgoingChan parameter.
@@ -2368,7 +2836,7 @@ func (r *ChannelRouter) BuildRoute(amt *lnwire.MilliAtom,
 	// We'll attempt to obtain a set of bandwidth hints that helps us select
 	// the best outgoing channel to use in case no outgoing channel is set.
 	bandwidthHints, err := generateBandwidthHints(
-		r.selfNode, r.cfg.QueryBandwidth,
+		r.selfNode, r.cfg.QueryBandwidth, r.cfg.BandwidthPenalties,
 	)
 	if err != nil {
 		return nil, err
@@ -2414,6 +2882,7 @@ func (r *ChannelRouter) BuildRoute(amt *lnwire.MilliAtom,
 			bestEdge      *channeldb.ChannelEdgePolicy
 			bestAmts      *runningAmounts
 			bestBandwidth lnwire.MilliAtom
+			constraintErr error
 		)
 
 		cb := func(tx *bolt.Tx,
@@ -2463,6 +2932,7 @@ func (r *ChannelRouter) BuildRoute(amt *lnwire.MilliAtom,
 				log.Tracef("Skipping chan %v: %v",
 					inEdge.ChannelID, err)
 
+				constraintErr = err
 				return nil
 			}
 
@@ -2511,6 +2981,7 @@ func (r *ChannelRouter) BuildRoute(amt *lnwire.MilliAtom,
 			return nil, ErrNoChannel{
 				fromNode: fromNode,
 				position: i,
+				reason:   constraintErr,
 			}
 		}
 
@@ -2542,8 +3013,23 @@ func (r *ChannelRouter) BuildRoute(amt *lnwire.MilliAtom,
 	}
 
 	// Build and return the final route.
-	return newRoute(
-		receiverAmt, source, edges, uint32(height),
-		uint16(finalCltvDelta), nil,
-	)
+	for {
+		rt, err := newRoute(
+			receiverAmt, source, edges, uint32(height),
+			uint16(finalCltvDelta), nil, nil,
+		)
+
+		// Subtracting the fees from the minimum amount may have been
+		// rounded down such that one of the hops no longer receives
+		// its minimum htlc amount. In that case, we'll raise the
+		// receiver amount by the shortfall and try again. As the
+		// amount carried by every hop grows at least as much as the
+		// receiver amount, this is guaranteed to terminate.
+		belowMin, ok := err.(ErrHtlcBelowMinimum)
+		if !useMinAmt || !ok {
+			return rt, err
+		}
+
+		receiverAmt += belowMin.minHtlc - belowMin.amt
+	}
 }
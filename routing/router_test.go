@@ -249,6 +249,92 @@ func TestFindRoutesWithFeeLimit(t *testing.T) {
 	}
 }
 
+// TestFindRouteBandwidthSnapshot tests that concurrent route queries are
+// processed by the path finding workers, sharing a single snapshot of the
+// bandwidth hints of our channels until it expires.
+func TestFindRouteBandwidthSnapshot(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+	ctx, cleanUp, err := createTestCtxFromFile(
+		startingBlockHeight, basicGraphFilePath,
+	)
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+	defer cleanUp()
+
+	// Count the bandwidth queries for our channels.
+	var numQueries uint32
+	queryBandwidth := ctx.router.cfg.QueryBandwidth
+	ctx.router.cfg.QueryBandwidth = func(
+		e *channeldb.ChannelEdgeInfo) lnwire.MilliAtom {
+
+		atomic.AddUint32(&numQueries, 1)
+		return queryBandwidth(e)
+	}
+
+	target := ctx.aliases["sophon"]
+	paymentAmt := lnwire.NewMAtomsFromAtoms(100)
+	findRoute := func() error {
+		_, err := ctx.router.FindRoute(
+			ctx.router.selfNode.PubKeyBytes,
+			target, paymentAmt, noRestrictions, nil,
+			zpay32.DefaultFinalCLTVDelta,
+		)
+		return err
+	}
+
+	// The first query takes the snapshot, querying the bandwidth of all of
+	// our channels.
+	if err := findRoute(); err != nil {
+		t.Fatalf("unable to find route: %v", err)
+	}
+	numChanQueries := atomic.LoadUint32(&numQueries)
+	if numChanQueries == 0 {
+		t.Fatalf("expected bandwidth of our channels to be queried")
+	}
+
+	// Concurrent queries should all succeed, without querying the
+	// bandwidth again.
+	const numRouteQueries = 10
+	errChan := make(chan error, numRouteQueries)
+	for i := 0; i < numRouteQueries; i++ {
+		go func() {
+			errChan <- findRoute()
+		}()
+	}
+	for i := 0; i < numRouteQueries; i++ {
+		select {
+		case err := <-errChan:
+			if err != nil {
+				t.Fatalf("unable to find route: %v", err)
+			}
+
+		case <-time.After(5 * time.Second):
+			t.Fatalf("route query not processed")
+		}
+	}
+
+	if n := atomic.LoadUint32(&numQueries); n != numChanQueries {
+		t.Fatalf("expected %v bandwidth queries, got %v",
+			numChanQueries, n)
+	}
+
+	// Once the snapshot expired, the next query should take a new one.
+	ctx.router.bandwidthSnapshot.Lock()
+	ctx.router.bandwidthSnapshot.expiry = time.Time{}
+	ctx.router.bandwidthSnapshot.Unlock()
+
+	if err := findRoute(); err != nil {
+		t.Fatalf("unable to find route: %v", err)
+	}
+	if n := atomic.LoadUint32(&numQueries); n != 2*numChanQueries {
+		t.Fatalf("expected %v bandwidth queries, got %v",
+			2*numChanQueries, n)
+	}
+}
+
 // TestSendPaymentRouteFailureFallback tests that when sending a payment, if
 // one of the target routes is seen as unavailable, then the next route in the
 // queue is used instead. This process should continue until either a payment
@@ -325,6 +411,161 @@ func TestSendPaymentRouteFailureFallback(t *testing.T) {
 	}
 }
 
+// TestSendPaymentCancel tests that a payment which is canceled while an
+// attempt is outstanding awaits the result of that attempt, and is then failed
+// with the canceled reason instead of launching a new attempt.
+func TestSendPaymentCancel(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+	ctx, cleanUp, err := createTestCtxFromFile(startingBlockHeight, basicGraphFilePath)
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+	defer cleanUp()
+
+	var payHash [32]byte
+	paymentAmt := lnwire.NewMAtomsFromAtoms(1000)
+	payment := LightningPayment{
+		Target:      ctx.aliases["sophon"],
+		Amount:      paymentAmt,
+		FeeLimit:    noFeeLimit,
+		PaymentHash: payHash,
+	}
+
+	// Capture the failure reason recorded by the control tower.
+	control := ctx.router.cfg.Control.(*mockControlTower)
+	control.fail = make(chan failArgs, 1)
+
+	// We'll cancel the payment while its first attempt is in flight, after
+	// which the attempt fails with a non-terminal error. Without the cancel,
+	// the router would continue with the path through pham nuwen.
+	var numAttempts int
+	ctx.router.cfg.Payer.(*mockPaymentAttemptDispatcher).setPaymentResult(
+		func(firstHop lnwire.ShortChannelID) ([32]byte, error) {
+			numAttempts++
+
+			if err := ctx.router.CancelPayment(payHash); err != nil {
+				t.Errorf("unable to cancel payment: %v", err)
+			}
+
+			return [32]byte{}, &htlcswitch.ForwardingError{
+				FailureSourceIdx: 1,
+				FailureMessage:   &lnwire.FailTemporaryChannelFailure{},
+			}
+		})
+
+	_, _, err = ctx.router.SendPayment(&payment)
+	if !IsError(err, ErrPaymentCanceled) {
+		t.Fatalf("expected payment to be canceled, got: %v", err)
+	}
+
+	if numAttempts != 1 {
+		t.Fatalf("expected a single attempt, got %v", numAttempts)
+	}
+
+	select {
+	case f := <-control.fail:
+		if f.reason != channeldb.FailureReasonCanceled {
+			t.Fatalf("expected failure reason %v, got %v",
+				channeldb.FailureReasonCanceled, f.reason)
+		}
+	default:
+		t.Fatalf("payment not failed")
+	}
+
+	// Now that the payment reached its terminal state, it can no longer be
+	// canceled.
+	err = ctx.router.CancelPayment(payHash)
+	if err != ErrPaymentNotInFlight {
+		t.Fatalf("expected ErrPaymentNotInFlight, got: %v", err)
+	}
+}
+
+// TestSendPaymentBudget tests that a payment isn't attempted beyond its attempt
+// and time budgets, and that it is failed with the budget exhausted reason once
+// they are used up.
+func TestSendPaymentBudget(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+	ctx, cleanUp, err := createTestCtxFromFile(startingBlockHeight, basicGraphFilePath)
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+	defer cleanUp()
+
+	control := ctx.router.cfg.Control.(*mockControlTower)
+	control.fail = make(chan failArgs, 1)
+
+	// Every attempt fails with a non-terminal error, such that the router
+	// would keep on trying other routes if it wasn't for the budgets.
+	var numAttempts int
+	ctx.router.cfg.Payer.(*mockPaymentAttemptDispatcher).setPaymentResult(
+		func(firstHop lnwire.ShortChannelID) ([32]byte, error) {
+			numAttempts++
+
+			return [32]byte{}, &htlcswitch.ForwardingError{
+				FailureSourceIdx: 1,
+				FailureMessage:   &lnwire.FailTemporaryChannelFailure{},
+			}
+		})
+
+	tests := []struct {
+		name             string
+		maxAttempts      uint32
+		maxDuration      time.Duration
+		expectedAttempts int
+	}{
+		{
+			name:             "attempt budget",
+			maxAttempts:      1,
+			expectedAttempts: 1,
+		},
+		{
+			name:             "time budget",
+			maxDuration:      time.Nanosecond,
+			expectedAttempts: 0,
+		},
+	}
+
+	for i, test := range tests {
+		numAttempts = 0
+
+		payment := LightningPayment{
+			Target:      ctx.aliases["sophon"],
+			Amount:      lnwire.NewMAtomsFromAtoms(1000),
+			FeeLimit:    noFeeLimit,
+			PaymentHash: [32]byte{byte(i)},
+			MaxAttempts: test.maxAttempts,
+			MaxDuration: test.maxDuration,
+		}
+
+		_, _, err := ctx.router.SendPayment(&payment)
+		if !IsError(err, ErrPaymentBudgetExhausted) {
+			t.Fatalf("%v: expected budget to be exhausted, got: %v",
+				test.name, err)
+		}
+
+		if numAttempts != test.expectedAttempts {
+			t.Fatalf("%v: expected %v attempts, got %v", test.name,
+				test.expectedAttempts, numAttempts)
+		}
+
+		select {
+		case f := <-control.fail:
+			if f.reason != channeldb.FailureReasonBudgetExhausted {
+				t.Fatalf("%v: expected failure reason %v, "+
+					"got %v", test.name,
+					channeldb.FailureReasonBudgetExhausted,
+					f.reason)
+			}
+		default:
+			t.Fatalf("%v: payment not failed", test.name)
+		}
+	}
+}
+
 // TestChannelUpdateValidation tests that a failed payment with an associated
 // channel update will only be applied to the graph when the update contains a
 // valid signature.
@@ -2012,6 +2253,97 @@ func TestPruneChannelGraphStaleEdges(t *testing.T) {
 	assertChannelsPruned(t, ctx.graph, testChannels, prunedChannel)
 }
 
+// TestPruneChannelGraphStrict ensures that with strict zombie pruning, channels
+// are pruned as soon as either of their edges is stale.
+func TestPruneChannelGraphStrict(t *testing.T) {
+	t.Parallel()
+
+	freshTimestamp := time.Now()
+	staleTimestamp := time.Unix(0, 0)
+
+	testChannels := []*testChannel{
+		// Only one edge with a stale timestamp.
+		{
+			Node1: &testChannelEnd{
+				Alias: "c",
+				testChannelPolicy: &testChannelPolicy{
+					LastUpdate: staleTimestamp,
+				},
+			},
+			Node2:     &testChannelEnd{Alias: "d"},
+			Capacity:  100000,
+			ChannelID: 1,
+		},
+
+		// One edge fresh, one edge stale.
+		{
+			Node1: &testChannelEnd{
+				Alias: "e",
+				testChannelPolicy: &testChannelPolicy{
+					LastUpdate: freshTimestamp,
+				},
+			},
+			Node2: &testChannelEnd{
+				Alias: "f",
+				testChannelPolicy: &testChannelPolicy{
+					LastUpdate: staleTimestamp,
+				},
+			},
+			Capacity:  100000,
+			ChannelID: 2,
+		},
+
+		// Only one edge with a fresh timestamp.
+		{
+			Node1: &testChannelEnd{
+				Alias: "i",
+				testChannelPolicy: &testChannelPolicy{
+					LastUpdate: freshTimestamp,
+				},
+			},
+			Node2:     &testChannelEnd{Alias: "j"},
+			Capacity:  100000,
+			ChannelID: 3,
+		},
+
+		// Both edges fresh.
+		symmetricTestChannel("g", "h", 100000, &testChannelPolicy{
+			LastUpdate: freshTimestamp,
+		}, 4),
+
+		// Both edges stale, but our own channel.
+		symmetricTestChannel("a", "b", 100000, &testChannelPolicy{
+			LastUpdate: staleTimestamp,
+		}, 5),
+	}
+
+	testGraph, err := createTestGraphFromChannels(testChannels, "a")
+	if err != nil {
+		t.Fatalf("unable to create test graph: %v", err)
+	}
+	defer testGraph.cleanUp()
+
+	const startingHeight = 100
+	ctx, cleanUp, err := createTestCtxFromGraphInstance(
+		startingHeight, testGraph,
+	)
+	if err != nil {
+		t.Fatalf("unable to create test context: %v", err)
+	}
+	defer cleanUp()
+
+	ctx.router.cfg.StrictZombiePruning = true
+
+	assertChannelsPruned(t, ctx.graph, testChannels)
+
+	// Both channels with a stale edge should be pruned.
+	if err := ctx.router.pruneZombieChans(); err != nil {
+		t.Fatalf("unable to prune zombie channels: %v", err)
+	}
+
+	assertChannelsPruned(t, ctx.graph, testChannels, 1, 2)
+}
+
 // TestPruneChannelGraphDoubleDisabled test that we can properly prune channels
 // with both edges disabled from our channel graph.
 func TestPruneChannelGraphDoubleDisabled(t *testing.T) {
@@ -3475,4 +3807,72 @@ func TestBuildRoute(t *testing.T) {
 	if errNoChannel.fromNode != ctx.aliases["a"] {
 		t.Fatalf("unexpected no channel error node")
 	}
+
+	// Building a route for an amount below the minimum htlc of the channels
+	// from b to c should fail, with the violated minimum as the reason.
+	hops = []route.Vertex{
+		ctx.aliases["b"], ctx.aliases["c"],
+	}
+	amt = lnwire.NewMAtomsFromAtoms(10)
+	_, err = ctx.router.BuildRoute(
+		&amt, hops, nil, 40,
+	)
+	errNoChannel, ok = err.(ErrNoChannel)
+	if !ok {
+		t.Fatalf("expected no channel error, but got %v", err)
+	}
+	if errNoChannel.position != 1 {
+		t.Fatalf("unexpected no channel error position")
+	}
+	belowMin, ok := errNoChannel.reason.(ErrHtlcBelowMinimum)
+	if !ok {
+		t.Fatalf("expected htlc minimum reason, but got %v",
+			errNoChannel.reason)
+	}
+	if belowMin.amt != amt ||
+		belowMin.minHtlc != lnwire.NewMAtomsFromAtoms(20) {
+
+		t.Fatalf("unexpected htlc minimum error: %v", belowMin)
+	}
+}
+
+// TestMaxInFlightPerDest asserts that the amount of our payments in flight
+// toward a destination is capped by MaxInFlightPerDest, while other
+// destinations are unaffected and released amounts can be reused.
+func TestMaxInFlightPerDest(t *testing.T) {
+	t.Parallel()
+
+	r := &ChannelRouter{
+		cfg: &Config{
+			MaxInFlightPerDest: 1000,
+		},
+		inFlightPerDest: make(map[route.Vertex]lnwire.MilliAtom),
+	}
+
+	dest := route.Vertex{1}
+	otherDest := route.Vertex{2}
+
+	if err := r.reserveInFlight(dest, 600); err != nil {
+		t.Fatalf("unable to reserve in flight amount: %v", err)
+	}
+	err := r.reserveInFlight(dest, 500)
+	if err != ErrMaxInFlightPerDestExceeded {
+		t.Fatalf("expected ErrMaxInFlightPerDestExceeded, got %v", err)
+	}
+	if err := r.reserveInFlight(otherDest, 1000); err != nil {
+		t.Fatalf("unable to reserve in flight amount: %v", err)
+	}
+
+	// Once the first payment resolves, its amount is available again.
+	r.releaseInFlight(dest, 600)
+	if err := r.reserveInFlight(dest, 1000); err != nil {
+		t.Fatalf("unable to reserve in flight amount: %v", err)
+	}
+
+	// Resumed payments are accounted for without being rejected.
+	r.addInFlight(dest, 500)
+	if r.inFlightPerDest[dest] != 1500 {
+		t.Fatalf("expected 1500 in flight, got %v",
+			r.inFlightPerDest[dest])
+	}
 }
@@ -0,0 +1,108 @@
+package routing
+
+import (
+	"fmt"
+)
+
+// UnsyncedGraphAction determines what happens to a payment sent before the
+// initial historical sync of the graph completes. Routes found over a
+// partial graph may be missing channels or nodes, resulting in failed or
+// overpaying attempts.
+type UnsyncedGraphAction uint8
+
+const (
+	// UnsyncedGraphDefault applies the action configured for the router.
+	UnsyncedGraphDefault UnsyncedGraphAction = iota
+
+	// UnsyncedGraphSend sends the payment over the graph as it is.
+	UnsyncedGraphSend
+
+	// UnsyncedGraphRefuse fails the payment with ErrGraphNotSynced.
+	UnsyncedGraphRefuse
+
+	// UnsyncedGraphQueue holds the payment back until the graph is
+	// synced.
+	UnsyncedGraphQueue
+)
+
+// String returns a human readable representation of the action.
+func (a UnsyncedGraphAction) String() string {
+	switch a {
+	case UnsyncedGraphDefault:
+		return "default"
+
+	case UnsyncedGraphSend:
+		return "send"
+
+	case UnsyncedGraphRefuse:
+		return "refuse"
+
+	case UnsyncedGraphQueue:
+		return "queue"
+
+	default:
+		return fmt.Sprintf("unknown<%d>", uint8(a))
+	}
+}
+
+// ParseUnsyncedGraphAction parses the action named as in the configuration.
+// An empty name is the default action.
+func ParseUnsyncedGraphAction(name string) (UnsyncedGraphAction, error) {
+	switch name {
+	case "":
+		return UnsyncedGraphDefault, nil
+
+	case "send":
+		return UnsyncedGraphSend, nil
+
+	case "refuse":
+		return UnsyncedGraphRefuse, nil
+
+	case "queue":
+		return UnsyncedGraphQueue, nil
+
+	default:
+		return 0, fmt.Errorf("unknown unsynced graph action: %v", name)
+	}
+}
+
+// unsyncedGraphAction returns the action applying to the given payment if
+// the graph isn't synced.
+func (r *ChannelRouter) unsyncedGraphAction(
+	payment *LightningPayment) UnsyncedGraphAction {
+
+	if payment.UnsyncedGraphAction != UnsyncedGraphDefault {
+		return payment.UnsyncedGraphAction
+	}
+	if r.cfg.UnsyncedGraphAction != UnsyncedGraphDefault {
+		return r.cfg.UnsyncedGraphAction
+	}
+
+	return UnsyncedGraphSend
+}
+
+// awaitGraphSync applies the given action to a payment while the graph isn't
+// synced. It fails with ErrGraphNotSynced if the payment is refused, and
+// blocks until the graph is synced if it is queued.
+func (r *ChannelRouter) awaitGraphSync(action UnsyncedGraphAction) error {
+	if r.cfg.GraphSyncStatus == nil || action == UnsyncedGraphSend {
+		return nil
+	}
+
+	for {
+		synced, changed := r.cfg.GraphSyncStatus()
+		if synced {
+			return nil
+		}
+
+		if action == UnsyncedGraphRefuse {
+			return ErrGraphNotSynced
+		}
+
+		select {
+		case <-changed:
+		case <-r.quit:
+			return ErrRouterShuttingDown
+		}
+	}
+}
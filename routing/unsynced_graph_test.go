@@ -0,0 +1,135 @@
+package routing
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// testGraphSyncStatus is a graph sync status that can be flipped to synced.
+type testGraphSyncStatus struct {
+	mu      sync.Mutex
+	synced  bool
+	changed chan struct{}
+}
+
+func newTestGraphSyncStatus() *testGraphSyncStatus {
+	return &testGraphSyncStatus{
+		changed: make(chan struct{}),
+	}
+}
+
+func (s *testGraphSyncStatus) status() (bool, <-chan struct{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.synced, s.changed
+}
+
+func (s *testGraphSyncStatus) setSynced() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.synced = true
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+// TestAwaitGraphSync asserts that payments sent before the graph is synced are
+// sent, refused or queued according to their action, falling back to the one
+// configured for the router.
+func TestAwaitGraphSync(t *testing.T) {
+	t.Parallel()
+
+	syncStatus := newTestGraphSyncStatus()
+	r := &ChannelRouter{
+		cfg: &Config{
+			GraphSyncStatus:     syncStatus.status,
+			UnsyncedGraphAction: UnsyncedGraphRefuse,
+		},
+		quit: make(chan struct{}),
+	}
+
+	// The payments not overriding the action of the router are refused.
+	action := r.unsyncedGraphAction(&LightningPayment{})
+	if action != UnsyncedGraphRefuse {
+		t.Fatalf("expected action %v, got %v", UnsyncedGraphRefuse,
+			action)
+	}
+	if err := r.awaitGraphSync(action); err != ErrGraphNotSynced {
+		t.Fatalf("expected ErrGraphNotSynced, got %v", err)
+	}
+
+	// The payments requesting to be sent anyway are sent.
+	action = r.unsyncedGraphAction(&LightningPayment{
+		UnsyncedGraphAction: UnsyncedGraphSend,
+	})
+	if err := r.awaitGraphSync(action); err != nil {
+		t.Fatalf("unable to send payment: %v", err)
+	}
+
+	// The queued payments are held back until the graph is synced.
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- r.awaitGraphSync(UnsyncedGraphQueue)
+	}()
+
+	select {
+	case err := <-errChan:
+		t.Fatalf("queued payment released before sync: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	syncStatus.setSynced()
+
+	select {
+	case err := <-errChan:
+		if err != nil {
+			t.Fatalf("unable to release queued payment: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("queued payment not released after sync")
+	}
+
+	// Once the graph is synced, no payment is refused.
+	if err := r.awaitGraphSync(UnsyncedGraphRefuse); err != nil {
+		t.Fatalf("unable to send payment: %v", err)
+	}
+}
+
+// TestAwaitGraphSyncShutdown asserts that queued payments are released with
+// an error when the router shuts down.
+func TestAwaitGraphSyncShutdown(t *testing.T) {
+	t.Parallel()
+
+	r := &ChannelRouter{
+		cfg: &Config{
+			GraphSyncStatus: newTestGraphSyncStatus().status,
+		},
+		quit: make(chan struct{}),
+	}
+
+	// Without an action configured for the router, payments are sent
+	// anyway.
+	action := r.unsyncedGraphAction(&LightningPayment{})
+	if action != UnsyncedGraphSend {
+		t.Fatalf("expected action %v, got %v", UnsyncedGraphSend,
+			action)
+	}
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- r.awaitGraphSync(UnsyncedGraphQueue)
+	}()
+
+	close(r.quit)
+
+	select {
+	case err := <-errChan:
+		if err != ErrRouterShuttingDown {
+			t.Fatalf("expected ErrRouterShuttingDown, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("queued payment not released on shutdown")
+	}
+}
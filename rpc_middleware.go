@@ -0,0 +1,457 @@
+package dcrlnd
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/decred/dcrlnd/lnrpc"
+	"github.com/decred/dcrlnd/macaroons"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+)
+
+const (
+	// defaultRPCMiddlewareTimeout is the default duration within which an
+	// RPC middleware must respond to an intercepted request.
+	defaultRPCMiddlewareTimeout = 2 * time.Second
+
+	// registerRPCMiddlewareMethod is the full URI of the method the
+	// middlewares are registered through. Its own messages are never
+	// intercepted, as they would be forwarded to the middlewares
+	// exchanging them.
+	registerRPCMiddlewareMethod = "/lnrpc.Lightning/RegisterRPCMiddleware"
+)
+
+// middlewareFeedback is the feedback of an RPC middleware on an intercepted
+// message.
+type middlewareFeedback struct {
+	// err is the reason the message was rejected for, nil if it was
+	// accepted.
+	err error
+
+	// replace indicates whether the message is replaced by replacement.
+	replace bool
+
+	// replacement is the serialized message replacing the intercepted
+	// one.
+	replacement []byte
+}
+
+// middlewareRequest is a message intercepted on behalf of an RPC middleware,
+// along with the channel its feedback is delivered over.
+type middlewareRequest struct {
+	request *lnrpc.RPCMiddlewareRequest
+
+	// feedback receives the feedback of the middleware on the message. It
+	// is nil for read-only middlewares, which don't send any.
+	feedback chan *middlewareFeedback
+}
+
+// rpcMiddleware is an external authorizer registered through the
+// RegisterRPCMiddleware RPC, enforcing the custom macaroon caveats of a given
+// name, or only observing the calls in read-only mode.
+type rpcMiddleware struct {
+	name       string
+	caveatName string
+	readOnly   bool
+	timeout    time.Duration
+
+	// requests is the channel the intercepted messages are sent to the
+	// stream of the middleware over.
+	requests chan *middlewareRequest
+
+	quit chan struct{}
+}
+
+// newRPCMiddleware creates a new middleware for the custom caveats of the
+// given name, which must respond to intercepted messages within the given
+// timeout. A read-only middleware only observes the calls carrying these
+// caveats, or all calls if the caveat name is empty.
+func newRPCMiddleware(name, caveatName string, readOnly bool,
+	timeout time.Duration) *rpcMiddleware {
+
+	return &rpcMiddleware{
+		name:       name,
+		caveatName: caveatName,
+		readOnly:   readOnly,
+		timeout:    timeout,
+		requests:   make(chan *middlewareRequest),
+		quit:       make(chan struct{}),
+	}
+}
+
+// intercept forwards the message to the middleware and waits for its
+// feedback. The message is rejected if the middleware doesn't respond in
+// time. Read-only middlewares are only waited for until they receive the
+// message.
+func (m *rpcMiddleware) intercept(
+	req *lnrpc.RPCMiddlewareRequest) (*middlewareFeedback, error) {
+
+	var feedback chan *middlewareFeedback
+	if !m.readOnly {
+		feedback = make(chan *middlewareFeedback, 1)
+	}
+	timeout := time.After(m.timeout)
+
+	select {
+	case m.requests <- &middlewareRequest{request: req, feedback: feedback}:
+	case <-timeout:
+		return nil, fmt.Errorf("RPC middleware %v timed out", m.name)
+	case <-m.quit:
+		return nil, fmt.Errorf("RPC middleware %v exited", m.name)
+	}
+
+	if m.readOnly {
+		return &middlewareFeedback{}, nil
+	}
+
+	select {
+	case f := <-feedback:
+		return f, nil
+	case <-timeout:
+		return nil, fmt.Errorf("RPC middleware %v timed out", m.name)
+	case <-m.quit:
+		return nil, fmt.Errorf("RPC middleware %v exited", m.name)
+	}
+}
+
+// rpcMiddlewareRegistry keeps track of the RPC middlewares registered for the
+// custom macaroon caveats, and forwards them the calls carrying these caveats.
+type rpcMiddlewareRegistry struct {
+	nextRequestID uint64 // To be used atomically.
+	nextCallID    uint64 // To be used atomically.
+
+	// middlewares are the middlewares enforcing the custom caveats, by
+	// caveat name.
+	middlewares map[string]*rpcMiddleware
+
+	// observers are the read-only middlewares.
+	observers map[*rpcMiddleware]struct{}
+
+	mtx sync.RWMutex
+}
+
+// A compile time check to ensure rpcMiddlewareRegistry implements the
+// macaroons.CustomCaveatAcceptor interface.
+var _ macaroons.CustomCaveatAcceptor = (*rpcMiddlewareRegistry)(nil)
+
+// newRPCMiddlewareRegistry creates a new registry without any middleware.
+func newRPCMiddlewareRegistry() *rpcMiddlewareRegistry {
+	return &rpcMiddlewareRegistry{
+		middlewares: make(map[string]*rpcMiddleware),
+		observers:   make(map[*rpcMiddleware]struct{}),
+	}
+}
+
+// register adds a middleware to the registry. Only one middleware can enforce
+// a given custom caveat name, while any number of read-only middlewares can
+// observe it.
+func (r *rpcMiddlewareRegistry) register(m *rpcMiddleware) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if m.readOnly {
+		r.observers[m] = struct{}{}
+		return nil
+	}
+
+	if existing, ok := r.middlewares[m.caveatName]; ok {
+		return fmt.Errorf("RPC middleware %v already registered for "+
+			"custom caveat %v", existing.name, m.caveatName)
+	}
+
+	r.middlewares[m.caveatName] = m
+	return nil
+}
+
+// unregister removes a middleware from the registry, failing the calls
+// waiting for its feedback.
+func (r *rpcMiddlewareRegistry) unregister(m *rpcMiddleware) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if r.middlewares[m.caveatName] == m {
+		delete(r.middlewares, m.caveatName)
+	}
+	delete(r.observers, m)
+	close(m.quit)
+}
+
+// CustomCaveatSupported returns an error if no middleware enforces the custom
+// caveat of the given name. Read-only middlewares don't enforce the caveats
+// they observe.
+//
+// NOTE: This is part of the macaroons.CustomCaveatAcceptor interface.
+func (r *rpcMiddlewareRegistry) CustomCaveatSupported(name string) error {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	if _, ok := r.middlewares[name]; !ok {
+		return fmt.Errorf("no RPC middleware registered for custom "+
+			"caveat %v", name)
+	}
+
+	return nil
+}
+
+// callMiddleware is a middleware a call is forwarded to, along with the
+// condition of the custom caveat it is forwarded for.
+type callMiddleware struct {
+	middleware *rpcMiddleware
+	condition  string
+}
+
+// middlewareCall is an RPC call intercepted on behalf of the middlewares its
+// macaroon concerns.
+type middlewareCall struct {
+	registry    *rpcMiddlewareRegistry
+	callID      uint64
+	fullMethod  string
+	streamRPC   bool
+	rawMacaroon []byte
+	middlewares []callMiddleware
+}
+
+// newCall returns the call to the given method made with the macaroon of the
+// context, or nil if no middleware is concerned by it. It fails if the
+// macaroon carries custom caveats no middleware enforces.
+func (r *rpcMiddlewareRegistry) newCall(ctx context.Context,
+	fullMethod string, streamRPC bool) (*middlewareCall, error) {
+
+	// Macaroons carrying custom caveats are rejected by the macaroon
+	// service if no middleware is registered, so there is nothing to do if
+	// there aren't any.
+	r.mtx.RLock()
+	numMiddlewares := len(r.middlewares) + len(r.observers)
+	r.mtx.RUnlock()
+	if numMiddlewares == 0 || fullMethod == registerRPCMiddlewareMethod {
+		return nil, nil
+	}
+
+	mac, err := macaroons.MacaroonFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	call := &middlewareCall{
+		registry:   r,
+		fullMethod: fullMethod,
+		streamRPC:  streamRPC,
+	}
+
+	r.mtx.RLock()
+	for _, caveat := range mac.Caveats() {
+		name, condition, ok, err := macaroons.ParseCustomCaveat(
+			string(caveat.Id),
+		)
+		if err != nil {
+			r.mtx.RUnlock()
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+
+		middleware, ok := r.middlewares[name]
+		if !ok {
+			r.mtx.RUnlock()
+			return nil, fmt.Errorf("no RPC middleware registered "+
+				"for custom caveat %v", name)
+		}
+		call.middlewares = append(call.middlewares, callMiddleware{
+			middleware: middleware,
+			condition:  condition,
+		})
+
+		for observer := range r.observers {
+			if observer.caveatName == name {
+				call.middlewares = append(
+					call.middlewares, callMiddleware{
+						middleware: observer,
+						condition:  condition,
+					},
+				)
+			}
+		}
+	}
+
+	// The observers without a caveat name observe all calls.
+	for observer := range r.observers {
+		if observer.caveatName == "" {
+			call.middlewares = append(call.middlewares, callMiddleware{
+				middleware: observer,
+			})
+		}
+	}
+	r.mtx.RUnlock()
+
+	if len(call.middlewares) == 0 {
+		return nil, nil
+	}
+
+	call.rawMacaroon, err = mac.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	call.callID = atomic.AddUint64(&r.nextCallID, 1)
+
+	return call, nil
+}
+
+// intercept forwards a message of the call to its middlewares in turn, and
+// returns the message as rewritten by them, or an error if any of them
+// rejects it. The message is nil for the establishment of streams, and a
+// response if it's sent by the method rather than received by it.
+func (c *middlewareCall) intercept(msg interface{},
+	response bool) (interface{}, error) {
+
+	for _, cm := range c.middlewares {
+		requestID := atomic.AddUint64(&c.registry.nextRequestID, 1)
+		request := &lnrpc.RPCMiddlewareRequest{
+			RequestId:             requestID,
+			RawMacaroon:           c.rawMacaroon,
+			CustomCaveatCondition: cm.condition,
+			MethodFullUri:         c.fullMethod,
+			StreamRpc:             c.streamRPC,
+			Response:              response,
+			CallId:                c.callID,
+		}
+		protoMsg, isProto := msg.(proto.Message)
+		if isProto {
+			var err error
+			request.TypeName = proto.MessageName(protoMsg)
+			request.Serialized, err = proto.Marshal(protoMsg)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		feedback, err := cm.middleware.intercept(request)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case feedback.err != nil:
+			return nil, feedback.err
+
+		case feedback.replace && isProto:
+			// The replacement must be of the type of the message
+			// it replaces.
+			replacement := proto.Clone(protoMsg)
+			err := proto.Unmarshal(feedback.replacement, replacement)
+			if err != nil {
+				return nil, fmt.Errorf("invalid replacement "+
+					"from RPC middleware %v: %v",
+					cm.middleware.name, err)
+			}
+			msg = replacement
+		}
+	}
+
+	return msg, nil
+}
+
+// middlewareStream is a server stream whose messages are intercepted on behalf
+// of the middlewares of its call.
+type middlewareStream struct {
+	grpc.ServerStream
+
+	call *middlewareCall
+}
+
+// RecvMsg receives a message from the client, and forwards it to the
+// middlewares before handing it to the method.
+func (s *middlewareStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	msg, err := s.call.intercept(m, false)
+	if err != nil {
+		return err
+	}
+
+	// The message is filled in place, so a replacement is copied over the
+	// received message.
+	if replacement, ok := msg.(proto.Message); ok && msg != m {
+		dst := m.(proto.Message)
+		dst.Reset()
+		proto.Merge(dst, replacement)
+	}
+
+	return nil
+}
+
+// SendMsg forwards a message sent by the method to the middlewares before
+// sending it to the client.
+func (s *middlewareStream) SendMsg(m interface{}) error {
+	msg, err := s.call.intercept(m, true)
+	if err != nil {
+		return err
+	}
+
+	return s.ServerStream.SendMsg(msg)
+}
+
+// UnaryServerInterceptor is a gRPC interceptor forwarding the requests and
+// responses of the calls concerning RPC middlewares to them. It must be
+// chained after the interceptor of the macaroon service.
+func (r *rpcMiddlewareRegistry) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+
+		call, err := r.newCall(ctx, info.FullMethod, false)
+		if err != nil {
+			return nil, err
+		}
+		if call == nil {
+			return handler(ctx, req)
+		}
+
+		req, err = call.intercept(req, false)
+		if err != nil {
+			return nil, err
+		}
+
+		// The errors of the method are returned as is, only actual
+		// responses are intercepted.
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		return call.intercept(resp, true)
+	}
+}
+
+// StreamServerInterceptor is a gRPC interceptor forwarding the establishment
+// of the streams concerning RPC middlewares to them, along with the messages
+// exchanged over these streams. It must be chained after the interceptor of
+// the macaroon service.
+func (r *rpcMiddlewareRegistry) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream,
+		info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+
+		call, err := r.newCall(ss.Context(), info.FullMethod, true)
+		if err != nil {
+			return err
+		}
+		if call == nil {
+			return handler(srv, ss)
+		}
+
+		if _, err := call.intercept(nil, false); err != nil {
+			return err
+		}
+
+		return handler(srv, &middlewareStream{
+			ServerStream: ss,
+			call:         call,
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package dcrlnd
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrlnd/lnrpc"
+	"github.com/decred/dcrlnd/macaroons"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"gopkg.in/macaroon.v2"
+)
+
+// macaroonContext returns the context of a call made with a macaroon carrying
+// the given custom caveat, if any.
+func macaroonContext(t *testing.T, caveatName,
+	condition string) context.Context {
+
+	mac, err := macaroon.New(
+		[]byte("root key"), []byte("id"), "dcrlnd", macaroon.LatestVersion,
+	)
+	if err != nil {
+		t.Fatalf("unable to create macaroon: %v", err)
+	}
+	if caveatName != "" {
+		constraint := macaroons.CustomConstraint(caveatName, condition)
+		if err := constraint(mac); err != nil {
+			t.Fatalf("unable to add custom caveat: %v", err)
+		}
+	}
+
+	macBytes, err := mac.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unable to serialize macaroon: %v", err)
+	}
+	md := metadata.Pairs("macaroon", hex.EncodeToString(macBytes))
+
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+// runMiddleware answers the messages intercepted on behalf of the given
+// middleware with the feedback returned by the given function, and forwards
+// them over the returned channel. The function is only called if the
+// middleware isn't read-only.
+func runMiddleware(m *rpcMiddleware, respond func(
+	*lnrpc.RPCMiddlewareRequest) *middlewareFeedback) <-chan *lnrpc.RPCMiddlewareRequest {
+
+	intercepted := make(chan *lnrpc.RPCMiddlewareRequest, 10)
+	go func() {
+		for {
+			select {
+			case req := <-m.requests:
+				intercepted <- req.request
+				if req.feedback != nil {
+					req.feedback <- respond(req.request)
+				}
+
+			case <-m.quit:
+				return
+			}
+		}
+	}()
+
+	return intercepted
+}
+
+// TestRPCMiddlewareUnary asserts that the requests and responses of the calls
+// carrying a custom caveat are forwarded to the middleware enforcing it, which
+// can reject or rewrite them, and that read-only middlewares observe them.
+func TestRPCMiddlewareUnary(t *testing.T) {
+	t.Parallel()
+
+	const timeout = time.Second
+
+	registry := newRPCMiddlewareRegistry()
+
+	// The enforcing middleware rejects the calls of any other account
+	// than the one of id 42, and hides the balance of the responses.
+	enforcer := newRPCMiddleware("acct", "acct", false, timeout)
+	if err := registry.register(enforcer); err != nil {
+		t.Fatalf("unable to register middleware: %v", err)
+	}
+	defer registry.unregister(enforcer)
+
+	replacement, err := proto.Marshal(&lnrpc.WalletBalanceResponse{})
+	if err != nil {
+		t.Fatalf("unable to serialize replacement: %v", err)
+	}
+	runMiddleware(enforcer, func(
+		req *lnrpc.RPCMiddlewareRequest) *middlewareFeedback {
+
+		if !req.Response {
+			if req.CustomCaveatCondition != "id 42" {
+				return &middlewareFeedback{
+					err: errors.New("unknown account"),
+				}
+			}
+
+			return &middlewareFeedback{}
+		}
+
+		return &middlewareFeedback{
+			replace:     true,
+			replacement: replacement,
+		}
+	})
+
+	// A second enforcing middleware can't be registered for the same
+	// caveat, while read-only middlewares can observe it.
+	duplicate := newRPCMiddleware("acct2", "acct", false, timeout)
+	if err := registry.register(duplicate); err == nil {
+		t.Fatalf("expected duplicate middleware to be refused")
+	}
+
+	observer := newRPCMiddleware("audit", "", true, timeout)
+	if err := registry.register(observer); err != nil {
+		t.Fatalf("unable to register middleware: %v", err)
+	}
+	defer registry.unregister(observer)
+	observed := runMiddleware(observer, nil)
+
+	if err := registry.CustomCaveatSupported("acct"); err != nil {
+		t.Fatalf("custom caveat not supported: %v", err)
+	}
+	if err := registry.CustomCaveatSupported("other"); err == nil {
+		t.Fatalf("custom caveat observed only was supported")
+	}
+
+	interceptor := registry.UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{
+		FullMethod: "/lnrpc.Lightning/WalletBalance",
+	}
+	handler := func(context.Context, interface{}) (interface{}, error) {
+		return &lnrpc.WalletBalanceResponse{TotalBalance: 1000}, nil
+	}
+	req := &lnrpc.WalletBalanceRequest{}
+
+	// The calls of other accounts are rejected.
+	ctx := macaroonContext(t, "acct", "id 7")
+	if _, err := interceptor(ctx, req, info, handler); err == nil {
+		t.Fatalf("expected call to be rejected")
+	}
+
+	// The response of the allowed account is rewritten.
+	ctx = macaroonContext(t, "acct", "id 42")
+	resp, err := interceptor(ctx, req, info, handler)
+	if err != nil {
+		t.Fatalf("unable to make call: %v", err)
+	}
+	balance := resp.(*lnrpc.WalletBalanceResponse).TotalBalance
+	if balance != 0 {
+		t.Fatalf("expected balance to be hidden, got %v", balance)
+	}
+
+	// Calls without custom caveats are only observed.
+	ctx = macaroonContext(t, "", "")
+	resp, err = interceptor(ctx, req, info, handler)
+	if err != nil {
+		t.Fatalf("unable to make call: %v", err)
+	}
+	balance = resp.(*lnrpc.WalletBalanceResponse).TotalBalance
+	if balance != 1000 {
+		t.Fatalf("expected balance 1000, got %v", balance)
+	}
+
+	// The observer saw the messages that made it through the enforcing
+	// middleware, that is the requests and responses of the last two
+	// calls.
+	var requests, responses int
+	callIDs := make(map[uint64]struct{})
+	for i := 0; i < 4; i++ {
+		select {
+		case msg := <-observed:
+			if msg.Response {
+				responses++
+			} else {
+				requests++
+			}
+			callIDs[msg.CallId] = struct{}{}
+
+		case <-time.After(timeout):
+			t.Fatalf("message not observed")
+		}
+	}
+	if requests != 2 || responses != 2 || len(callIDs) != 2 {
+		t.Fatalf("unexpected observed messages: %d requests, %d "+
+			"responses, %d calls", requests, responses,
+			len(callIDs))
+	}
+}
+
+// TestRPCMiddlewareMissing asserts that the calls carrying a custom caveat no
+// middleware enforces are rejected.
+func TestRPCMiddlewareMissing(t *testing.T) {
+	t.Parallel()
+
+	registry := newRPCMiddlewareRegistry()
+	observer := newRPCMiddleware("audit", "acct", true, time.Second)
+	if err := registry.register(observer); err != nil {
+		t.Fatalf("unable to register middleware: %v", err)
+	}
+	defer registry.unregister(observer)
+	runMiddleware(observer, nil)
+
+	interceptor := registry.UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{
+		FullMethod: "/lnrpc.Lightning/WalletBalance",
+	}
+	handler := func(context.Context, interface{}) (interface{}, error) {
+		t.Fatalf("rejected call was handled")
+		return nil, nil
+	}
+
+	ctx := macaroonContext(t, "acct", "id 42")
+	_, err := interceptor(ctx, &lnrpc.WalletBalanceRequest{}, info, handler)
+	if err == nil {
+		t.Fatalf("expected call to be rejected")
+	}
+}
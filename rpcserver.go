@@ -3,6 +3,7 @@ package dcrlnd
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
 	"crypto/tls"
 	"encoding/hex"
 	"errors"
@@ -11,7 +12,10 @@ import (
 	"math"
 	"net"
 	"net/http"
+	"path/filepath"
+	"runtime"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -30,7 +34,10 @@ import (
 	"github.com/decred/dcrlnd/chanbackup"
 	"github.com/decred/dcrlnd/channeldb"
 	"github.com/decred/dcrlnd/channelnotifier"
+	"github.com/decred/dcrlnd/coldsweep"
+	"github.com/decred/dcrlnd/contractcourt"
 	"github.com/decred/dcrlnd/discovery"
+	"github.com/decred/dcrlnd/feature"
 	"github.com/decred/dcrlnd/htlcswitch"
 	"github.com/decred/dcrlnd/input"
 	"github.com/decred/dcrlnd/invoices"
@@ -43,6 +50,9 @@ import (
 	"github.com/decred/dcrlnd/lnwire"
 	"github.com/decred/dcrlnd/macaroons"
 	"github.com/decred/dcrlnd/monitoring"
+	"github.com/decred/dcrlnd/peernotifier"
+	"github.com/decred/dcrlnd/receipt"
+	"github.com/decred/dcrlnd/record"
 	"github.com/decred/dcrlnd/routing"
 	"github.com/decred/dcrlnd/routing/route"
 	"github.com/decred/dcrlnd/signal"
@@ -58,6 +68,7 @@ import (
 	bolt "go.etcd.io/bbolt"
 	"google.golang.org/grpc"
 	"gopkg.in/macaroon-bakery.v2/bakery"
+	macaroon "gopkg.in/macaroon.v2"
 )
 
 const (
@@ -65,8 +76,6 @@ const (
 	// permitted as defined in BOLT-0002. This is the same as the maximum
 	// channel size.
 	maxDcrPaymentMAtoms = lnwire.MilliAtom(MaxDecredFundingAmount * 1000)
-
-	scriptVersion uint16 = 0
 )
 
 var (
@@ -75,12 +84,20 @@ var (
 	// It is set to the value under the Decred chain as default.
 	MaxPaymentMAtoms = maxDcrPaymentMAtoms
 
-	// defaultAcceptorTimeout is the time after which an RPCAcceptor will time
-	// out and return false if it hasn't yet received a response.
-	//
-	// TODO: Make this configurable
+	// defaultAcceptorTimeout is the default time after which an
+	// RPCAcceptor will time out if it hasn't yet received a response.
 	defaultAcceptorTimeout = 15 * time.Second
 
+	// errMacaroonsDisabled is returned by the macaroon RPCs when the
+	// daemon was started with macaroons disabled.
+	errMacaroonsDisabled = errors.New("macaroon authentication disabled, " +
+		"remove --no-macaroons flag to enable")
+
+	// errColdSweepDisabled is an error returned when the cold storage
+	// sweep RPCs are called without a cold storage address configured.
+	errColdSweepDisabled = errors.New("sweeps to cold storage disabled, " +
+		"set --coldsweep.address to enable")
+
 	// readPermissions is a slice of all entities that allow read
 	// permissions for authorization purposes, all lowercase.
 	readPermissions = []bakery.Op{
@@ -112,6 +129,10 @@ var (
 			Entity: "invoices",
 			Action: "read",
 		},
+		{
+			Entity: "macaroon",
+			Action: "read",
+		},
 	}
 
 	// writePermissions is a slice of all entities that allow write
@@ -149,6 +170,14 @@ var (
 			Entity: "signer",
 			Action: "generate",
 		},
+		{
+			Entity: "macaroon",
+			Action: "generate",
+		},
+		{
+			Entity: "macaroon",
+			Action: "write",
+		},
 	}
 
 	// invoicePermissions is a slice of all the entities that allows a user
@@ -210,6 +239,10 @@ func mainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "peers",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/SetPeerConnPolicy": {{
+			Entity: "peers",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/OpenChannel": {{
 			Entity: "onchain",
 			Action: "write",
@@ -224,6 +257,35 @@ func mainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/BatchOpenChannel": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/BumpFundingFee": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/RebroadcastLimboOutput": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/SendCustomMessage": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/SubscribeCustomMessages": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/CloseChannel": {{
 			Entity: "onchain",
 			Action: "write",
@@ -243,6 +305,50 @@ func mainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "peers",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/SubscribePeerEvents": {{
+			Entity: "peers",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/BakeMacaroon": {{
+			Entity: "macaroon",
+			Action: "generate",
+		}},
+		"/lnrpc.Lightning/ListMacaroonIDs": {{
+			Entity: "macaroon",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/DeleteMacaroonID": {{
+			Entity: "macaroon",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/ListPermissions": {{
+			Entity: "info",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/RegisterRPCMiddleware": {{
+			Entity: "macaroon",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/SetColdSweep": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/SubscribeColdSweeps": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/ListGossipSyncers": {{
+			Entity: "peers",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/GetGraphSyncStatus": {{
+			Entity: "info",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/SubscribeGraphSyncStatus": {{
+			Entity: "info",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/WalletBalance": {{
 			Entity: "onchain",
 			Action: "read",
@@ -259,6 +365,14 @@ func mainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/ListArbitrators": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/PendingChannelLimits": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/ListChannels": {{
 			Entity: "offchain",
 			Action: "read",
@@ -271,6 +385,14 @@ func mainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/StreamChannels": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/StreamClosedChannels": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/SendPayment": {{
 			Entity: "offchain",
 			Action: "write",
@@ -295,6 +417,18 @@ func mainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "invoices",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/InvoiceURI": {{
+			Entity: "invoices",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/LegacyPayloadStats": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/PushGossip": {{
+			Entity: "peers",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/ListInvoices": {{
 			Entity: "invoices",
 			Action: "read",
@@ -359,6 +493,47 @@ func mainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/FeeManagerReport": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/EffectiveFeeRates": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/CheckMacaroonPermissions": {{
+			Entity: "macaroon",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/WarpClock": {{
+			Entity: "info",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/ExportPaymentReceipt": {{
+			Entity: "offchain",
+			Action: "read",
+		}, {
+			Entity: "message",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/VerifyPaymentReceipt": {{
+			Entity: "message",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/ChannelRecoveryStatus": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/GetDebugInfo": {{
+			Entity: "info",
+			Action: "read",
+		}, {
+			Entity: "onchain",
+			Action: "read",
+		}, {
+			Entity: "offchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/UpdateChannelPolicy": {{
 			Entity: "offchain",
 			Action: "write",
@@ -379,6 +554,10 @@ func mainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/InspectChanBackup": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/ExportAllChannelBackups": {{
 			Entity: "offchain",
 			Action: "read",
@@ -387,6 +566,10 @@ func mainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/CreateDatabaseSnapshot": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/ChannelAcceptor": {{
 			Entity: "onchain",
 			Action: "write",
@@ -397,6 +580,48 @@ func mainRPCServerPermissions() map[string][]bakery.Op {
 	}
 }
 
+// macaroonWhitelist returns the set of the calls served without a macaroon,
+// since they're also available before the wallet is unlocked.
+func macaroonWhitelist() map[string]struct{} {
+	return map[string]struct{}{
+		"/lnrpc.State/SubscribeState": {},
+		"/lnrpc.State/GetState":       {},
+	}
+}
+
+// whitelistUnaryInterceptor wraps an interceptor so that it's skipped for the
+// calls of the whitelist.
+func whitelistUnaryInterceptor(whitelist map[string]struct{},
+	interceptor grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+
+	return func(ctx context.Context, req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+
+		if _, ok := whitelist[info.FullMethod]; ok {
+			return handler(ctx, req)
+		}
+
+		return interceptor(ctx, req, info, handler)
+	}
+}
+
+// whitelistStreamInterceptor wraps an interceptor so that it's skipped for the
+// calls of the whitelist.
+func whitelistStreamInterceptor(whitelist map[string]struct{},
+	interceptor grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+
+	return func(srv interface{}, ss grpc.ServerStream,
+		info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+
+		if _, ok := whitelist[info.FullMethod]; ok {
+			return handler(srv, ss)
+		}
+
+		return interceptor(srv, ss, info, handler)
+	}
+}
+
 // rpcServer is a gRPC, RPC front end to the lnd daemon.
 // TODO(roasbeef): pagination support for the list-style calls
 type rpcServer struct {
@@ -446,6 +671,26 @@ type rpcServer struct {
 	// method.
 	chanPredicate *chanacceptor.ChainedAcceptor
 
+	// tower is the watchtower running alongside the daemon, if active.
+	tower *watchtower.Standalone
+
+	// macService is the macaroon service used to bake new macaroons. It is
+	// nil if macaroons are disabled.
+	macService *macaroons.Service
+
+	// permissions is the set of macaroon permissions required by each RPC
+	// method of the main RPC server, its sub-servers and the custom
+	// methods registered along with RPC middlewares.
+	permissions *macaroons.PermissionRegistry
+
+	// middlewareRegistry keeps track of the RPC middlewares enforcing the
+	// custom caveats of macaroons.
+	middlewareRegistry *rpcMiddlewareRegistry
+
+	// payoutPolicy checks the destinations of the on-chain payments sent
+	// through SendCoins and SendMany before they are broadcast.
+	payoutPolicy *payoutPolicy
+
 	quit chan struct{}
 }
 
@@ -463,7 +708,9 @@ func newRPCServer(s *server, macService *macaroons.Service,
 	restProxyDest string, atpl *autopilot.Manager,
 	invoiceRegistry *invoices.InvoiceRegistry, tower *watchtower.Standalone,
 	tlsCfg *tls.Config, getListeners rpcListeners,
-	chanPredicate *chanacceptor.ChainedAcceptor) (*rpcServer, error) {
+	chanPredicate *chanacceptor.ChainedAcceptor,
+	middlewareRegistry *rpcMiddlewareRegistry,
+	stateSvc *stateService) (*rpcServer, error) {
 
 	// Set up router rpc backend.
 	channelGraph := s.chanDB.ChannelGraph()
@@ -472,6 +719,22 @@ func newRPCServer(s *server, macService *macaroons.Service,
 		return nil, err
 	}
 	graph := s.chanDB.ChannelGraph()
+
+	policy, err := newPayoutPolicy(
+		cfg.PayoutPolicy, activeNetParams.Params,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	routingConfig := routerrpc.GetRoutingConfig(cfg.SubRPCServers.RouterRPC)
+	feeLimitSchedule, err := routerrpc.ParseFeeLimitSchedule(
+		routingConfig.FeeLimitSchedule,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	routerBackend := &routerrpc.RouterBackend{
 		MaxPaymentMAtoms: MaxPaymentMAtoms,
 		SelfNode:         selfNode.PubKeyBytes,
@@ -501,9 +764,13 @@ func newRPCServer(s *server, macService *macaroons.Service,
 		},
 		FindRoute:        s.chanRouter.FindRoute,
 		MissionControl:   s.missionControl,
+		AvoidList:        s.avoidList,
+		PaymentMetrics:   s.paymentMetrics,
 		ActiveNetParams:  activeNetParams.Params,
 		Tower:            s.controlTower,
 		MaxTotalTimelock: cfg.MaxOutgoingCltvExpiry,
+		FeeLimitSchedule: feeLimitSchedule,
+		EdgeScorer:       s.edgeScorer,
 	}
 
 	var (
@@ -516,9 +783,11 @@ func newRPCServer(s *server, macService *macaroons.Service,
 	// server configuration struct.
 	err = subServerCgs.PopulateDependencies(
 		s.cc, networkDir, macService, atpl, invoiceRegistry,
-		s.htlcSwitch, activeNetParams.Params, s.chanRouter,
-		routerBackend, s.nodeSigner, s.chanDB, s.sweeper, tower,
-		s.towerClient, cfg.net.ResolveTCPAddr,
+		s.htlcSwitch, s.htlcNotifier, activeNetParams.Params,
+		s.chanRouter, routerBackend, s.nodeSigner, s.chanStatusMgr,
+		s.IsPeerOnline, s.chanDB, s.sweeper, tower, s.towerClient,
+		cfg.net.ResolveTCPAddr, s.witnessBeacon,
+		s.featureMgr.Get(feature.SetInvoice),
 	)
 	if err != nil {
 		return nil, err
@@ -542,19 +811,17 @@ func newRPCServer(s *server, macService *macaroons.Service,
 
 	// Next, we need to merge the set of sub server macaroon permissions
 	// with the main RPC server permissions so we can unite them under a
-	// single set of interceptors.
-	permissions := mainRPCServerPermissions()
+	// single set of interceptors. The registry ensures that none of the
+	// sub-servers try to override each other, and that they only require
+	// permissions macaroons can grant.
+	permissions := macaroons.NewPermissionRegistry()
+	err = permissions.AddPermissions(mainRPCServerPermissions())
+	if err != nil {
+		return nil, err
+	}
 	for _, subServerPerm := range subServerPerms {
-		for method, ops := range subServerPerm {
-			// For each new method:ops combo, we also ensure that
-			// non of the sub-servers try to override each other.
-			if _, ok := permissions[method]; ok {
-				return nil, fmt.Errorf("detected duplicate "+
-					"macaroon constraints for path: %v",
-					method)
-			}
-
-			permissions[method] = ops
+		if err := permissions.AddPermissions(subServerPerm); err != nil {
+			return nil, err
 		}
 	}
 
@@ -569,6 +836,30 @@ func newRPCServer(s *server, macService *macaroons.Service,
 
 		strmInterceptor := macService.StreamServerInterceptor(permissions)
 		macStrmInterceptors = append(macStrmInterceptors, strmInterceptor)
+
+		// The requests carrying custom caveats are forwarded to their
+		// middlewares once their macaroon has been validated.
+		macUnaryInterceptors = append(
+			macUnaryInterceptors,
+			middlewareRegistry.UnaryServerInterceptor(),
+		)
+		macStrmInterceptors = append(
+			macStrmInterceptors,
+			middlewareRegistry.StreamServerInterceptor(),
+		)
+
+		// The methods of the whitelist are served without a macaroon.
+		whitelist := macaroonWhitelist()
+		for i, interceptor := range macUnaryInterceptors {
+			macUnaryInterceptors[i] = whitelistUnaryInterceptor(
+				whitelist, interceptor,
+			)
+		}
+		for i, interceptor := range macStrmInterceptors {
+			macStrmInterceptors[i] = whitelistStreamInterceptor(
+				whitelist, interceptor,
+			)
+		}
 	}
 
 	// Get interceptors for Prometheus to gather gRPC performance metrics.
@@ -610,19 +901,27 @@ func newRPCServer(s *server, macService *macaroons.Service,
 	// gRPC server, and register the main lnrpc server along side.
 	grpcServer := grpc.NewServer(serverOpts...)
 	rootRPCServer := &rpcServer{
-		restDialOpts:    restDialOpts,
-		listeners:       listeners,
-		listenerCleanUp: []func(){cleanup},
-		restProxyDest:   restProxyDest,
-		subServers:      subServers,
-		tlsCfg:          tlsCfg,
-		grpcServer:      grpcServer,
-		server:          s,
-		routerBackend:   routerBackend,
-		chanPredicate:   chanPredicate,
-		quit:            make(chan struct{}, 1),
+		restDialOpts:       restDialOpts,
+		listeners:          listeners,
+		listenerCleanUp:    []func(){cleanup},
+		restProxyDest:      restProxyDest,
+		subServers:         subServers,
+		tlsCfg:             tlsCfg,
+		grpcServer:         grpcServer,
+		server:             s,
+		routerBackend:      routerBackend,
+		chanPredicate:      chanPredicate,
+		tower:              tower,
+		macService:         macService,
+		permissions:        permissions,
+		middlewareRegistry: middlewareRegistry,
+		payoutPolicy:       policy,
+		quit:               make(chan struct{}, 1),
 	}
 	lnrpc.RegisterLightningServer(grpcServer, rootRPCServer)
+	lnrpc.RegisterStateServer(
+		grpcServer, stateSvc.newServer(rootRPCServer.quit),
+	)
 
 	// Now the main RPC server has been registered, we'll iterate through
 	// all the sub-RPC servers and register them to ensure that requests
@@ -677,13 +976,11 @@ func (r *rpcServer) Start() error {
 		}
 	}
 
-	// Finally, start the REST proxy for our gRPC server above. We'll ensure
-	// we direct LND to connect to its loopback address rather than a
-	// wildcard to prevent certificate issues when accessing the proxy
+	// Finally, start the REST proxy for our gRPC server above, along with
+	// the gateway to all of its services, sub-servers included. We'll
+	// ensure we direct LND to connect to its loopback address rather than
+	// a wildcard to prevent certificate issues when accessing the proxy
 	// externally.
-	//
-	// TODO(roasbeef): eventually also allow the sub-servers to themselves
-	// have a REST proxy.
 	mux := proxy.NewServeMux()
 
 	err := lnrpc.RegisterLightningHandlerFromEndpoint(
@@ -693,8 +990,21 @@ func (r *rpcServer) Start() error {
 	if err != nil {
 		return err
 	}
+
+	handler, closeGateway, err := newRESTHandler(
+		mux, r.grpcServer, r.restProxyDest, r.restDialOpts,
+	)
+	if err != nil {
+		return err
+	}
+	r.listenerCleanUp = append(r.listenerCleanUp, closeGateway)
+
+	listen := lncfg.TLSListenOnAddress
+	if cfg.RESTListenProxyProtocol {
+		listen = lncfg.ProxyTLSListenOnAddress
+	}
 	for _, restEndpoint := range cfg.RESTListeners {
-		lis, err := lncfg.TLSListenOnAddress(restEndpoint, r.tlsCfg)
+		lis, err := listen(restEndpoint, r.tlsCfg)
 		if err != nil {
 			ltndLog.Errorf(
 				"gRPC proxy unable to listen on %s",
@@ -709,7 +1019,7 @@ func (r *rpcServer) Start() error {
 
 		go func() {
 			rpcsLog.Infof("gRPC proxy started at %s", lis.Addr())
-			http.Serve(lis, mux)
+			http.Serve(lis, handler)
 		}()
 	}
 
@@ -772,18 +1082,115 @@ func addrPairsToOutputs(addrPairs map[string]int64, netParams *chaincfg.Params)
 	return outputs, nil
 }
 
+// parseCoinConstraints parses the coin selection controls of the on-chain send
+// RPCs. Nil is returned if none is set, in which case the coins are selected
+// by the wallet itself.
+func parseCoinConstraints(minConfs int32, spendUnconfirmed bool,
+	rpcOutpoints []*lnrpc.OutPoint) (*lnwallet.CoinConstraints, error) {
+
+	if minConfs < 0 {
+		return nil, fmt.Errorf("min confirmations must be >= 0")
+	}
+	if minConfs == 0 && !spendUnconfirmed && len(rpcOutpoints) == 0 {
+		return nil, nil
+	}
+
+	constraints := &lnwallet.CoinConstraints{
+		MinConfs: 1,
+	}
+	switch {
+	case spendUnconfirmed:
+		constraints.MinConfs = 0
+
+	case minConfs > 0:
+		constraints.MinConfs = minConfs
+	}
+
+	for _, op := range rpcOutpoints {
+		outpoint, err := unmarshallOutPoint(op)
+		if err != nil {
+			return nil, err
+		}
+
+		constraints.Outpoints = append(constraints.Outpoints, *outpoint)
+	}
+
+	return constraints, nil
+}
+
+// unmarshallOutPoint converts an outpoint from its lnrpc type to its canonical
+// type.
+func unmarshallOutPoint(op *lnrpc.OutPoint) (*wire.OutPoint, error) {
+	if op == nil {
+		return nil, fmt.Errorf("empty outpoint provided")
+	}
+
+	var txid *chainhash.Hash
+	switch {
+	case len(op.TxidBytes) != 0 && len(op.TxidStr) != 0:
+		return nil, fmt.Errorf("either txid_bytes or txid_str must be " +
+			"specified, but not both")
+
+	case len(op.TxidBytes) != 0:
+		h, err := chainhash.NewHash(op.TxidBytes)
+		if err != nil {
+			return nil, err
+		}
+		txid = h
+
+	default:
+		h, err := chainhash.NewHashFromStr(op.TxidStr)
+		if err != nil {
+			return nil, err
+		}
+		txid = h
+	}
+
+	return wire.NewOutPoint(txid, op.OutputIndex, wire.TxTreeRegular), nil
+}
+
 // sendCoinsOnChain makes an on-chain transaction in or to send coins to one or
 // more addresses specified in the passed payment map. The payment map maps an
-// address to a specified output value to be sent to that address.
+// address to a specified output value to be sent to that address. If coin
+// selection constraints are passed, the spent coins are selected according to
+// them rather than by the wallet.
 func (r *rpcServer) sendCoinsOnChain(paymentMap map[string]int64,
-	feeRate lnwallet.AtomPerKByte) (*chainhash.Hash, error) {
+	feeRate lnwallet.AtomPerKByte,
+	constraints *lnwallet.CoinConstraints) (*chainhash.Hash, error) {
 
 	outputs, err := addrPairsToOutputs(paymentMap, activeNetParams.Params)
 	if err != nil {
 		return nil, err
 	}
 
-	tx, err := r.server.cc.wallet.SendOutputs(outputs, feeRate)
+	wallet := r.server.cc.wallet
+	if constraints != nil {
+		tx, unlockCoins, err := wallet.FundSendTx(
+			outputs, feeRate, constraints,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := wallet.PublishTransaction(tx); err != nil {
+			unlockCoins()
+
+			return nil, fmt.Errorf("unable to broadcast "+
+				"transaction: %v", err)
+		}
+
+		txHash := tx.TxHash()
+		return &txHash, nil
+	}
+
+	// We'll use the wallet's coin selection synchronization method to
+	// ensure that no coin selection (funding, sweep alls, other sends) can
+	// proceed while we instruct the wallet to send this transaction.
+	var tx *wire.MsgTx
+	err = wallet.WithCoinSelectLock(func() error {
+		tx, err = wallet.SendOutputs(outputs, feeRate)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -792,6 +1199,19 @@ func (r *rpcServer) sendCoinsOnChain(paymentMap map[string]int64,
 	return &txHash, nil
 }
 
+// labelTransaction stores the label of a transaction sent through one of the
+// on-chain send RPCs. As the transaction is already broadcast, a failure is
+// only logged.
+func (r *rpcServer) labelTransaction(txid *chainhash.Hash, label string) {
+	if label == "" {
+		return
+	}
+
+	if err := r.server.chanDB.PutTxLabel(*txid, label); err != nil {
+		rpcsLog.Errorf("Unable to store label of tx %v: %v", txid, err)
+	}
+}
+
 // ListUnspent returns useful information about each unspent output owned by
 // the wallet, as reported by the underlying `ListUnspentWitness`; the
 // information returned is: outpoint, amount in atoms, address, address
@@ -825,79 +1245,23 @@ func (r *rpcServer) ListUnspent(ctx context.Context,
 		return nil, err
 	}
 
-	resp := &lnrpc.ListUnspentResponse{
-		Utxos: make([]*lnrpc.Utxo, 0, len(utxos)),
+	rpcUtxos, err := lnrpc.MarshalUtxos(utxos, activeNetParams.Params)
+	if err != nil {
+		return nil, err
 	}
 
-	for _, utxo := range utxos {
-		// Translate lnwallet address type to the proper gRPC proto
-		// address type.
-		var addrType lnrpc.AddressType
-		switch utxo.AddressType {
-
-		case lnwallet.PubKeyHash:
-			addrType = lnrpc.AddressType_PUBKEY_HASH
-
-		case lnwallet.UnknownAddressType:
-			rpcsLog.Warnf("[listunspent] utxo with address of "+
-				"unknown type ignored: %v",
-				utxo.OutPoint.String())
-			continue
+	maxStr := ""
+	if maxConfs != math.MaxInt32 {
+		maxStr = " max=" + fmt.Sprintf("%d", maxConfs)
+	}
 
-		default:
-			return nil, fmt.Errorf("invalid utxo address type")
-		}
+	rpcsLog.Debugf("[listunspent] min=%v%v, generated utxos: %v", minConfs,
+		maxStr, utxos)
 
-		// Now that we know we have a proper mapping to an address,
-		// we'll convert the regular outpoint to an lnrpc variant.
-		outpoint := &lnrpc.OutPoint{
-			TxidBytes:   utxo.OutPoint.Hash[:],
-			TxidStr:     utxo.OutPoint.Hash.String(),
-			OutputIndex: utxo.OutPoint.Index,
-		}
-
-		utxoResp := lnrpc.Utxo{
-			Type:          addrType,
-			AmountAtoms:   int64(utxo.Value),
-			PkScript:      hex.EncodeToString(utxo.PkScript),
-			Outpoint:      outpoint,
-			Confirmations: utxo.Confirmations,
-		}
-
-		// Finally, we'll attempt to extract the raw address from the
-		// script so we can display a human friendly address to the end
-		// user.
-		// TODO(decred) version needs to come from utxo.
-		_, outAddresses, _, err := txscript.ExtractPkScriptAddrs(
-			scriptVersion, utxo.PkScript, activeNetParams.Params,
-		)
-		if err != nil {
-			return nil, err
-		}
-
-		// If we can't properly locate a single address, then this was
-		// an error in our mapping, and we'll return an error back to
-		// the user.
-		if len(outAddresses) != 1 {
-			return nil, fmt.Errorf("an output was unexpectedly " +
-				"multisig")
-		}
-
-		utxoResp.Address = outAddresses[0].String()
-
-		resp.Utxos = append(resp.Utxos, &utxoResp)
-	}
-
-	maxStr := ""
-	if maxConfs != math.MaxInt32 {
-		maxStr = " max=" + fmt.Sprintf("%d", maxConfs)
-	}
-
-	rpcsLog.Debugf("[listunspent] min=%v%v, generated utxos: %v", minConfs,
-		maxStr, utxos)
-
-	return resp, nil
-}
+	return &lnrpc.ListUnspentResponse{
+		Utxos: rpcUtxos,
+	}, nil
+}
 
 // EstimateFee handles a request for estimating the fee for sending a
 // transaction spending to multiple specified outputs in parallel.
@@ -974,6 +1338,17 @@ func (r *rpcServer) SendCoins(ctx context.Context,
 		in.Addr, dcrutil.Amount(in.Amount), int64(feePerKB),
 		in.SendAll)
 
+	if len(in.Label) > channeldb.MaxTxLabelLength {
+		return nil, channeldb.ErrTxLabelTooLong
+	}
+
+	constraints, err := parseCoinConstraints(
+		in.MinConfs, in.SpendUnconfirmed, in.Outpoints,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	// Decode the address receiving the coins, we need to check whether the
 	// address is valid for this network.
 	targetAddr, err := dcrutil.DecodeAddress(in.Addr, activeNetParams.Params)
@@ -1011,13 +1386,23 @@ func (r *rpcServer) SendCoins(ctx context.Context,
 			return nil, err
 		}
 
+		// Unless restricted by the coin selection constraints, all the
+		// confirmed outputs of the wallet are swept.
+		if constraints == nil {
+			constraints = &lnwallet.CoinConstraints{MinConfs: 1}
+		}
+		utxoSource := &lnwallet.ConstrainedUtxoSource{
+			Wallet:      wallet,
+			Constraints: *constraints,
+		}
+
 		// With the sweeper instance created, we can now generate a
 		// transaction that will sweep ALL outputs from the wallet in a
 		// single transaction. This will be generated in a concurrent
 		// safe manner, so no need to worry about locking.
 		sweepTxPkg, err := sweep.CraftSweepAllTx(
 			feePerKB, uint32(bestHeight), targetAddr, wallet,
-			wallet.WalletController, wallet.WalletController,
+			utxoSource, wallet.WalletController,
 			r.server.cc.feeEstimator, r.server.cc.signer,
 			activeNetParams.Params,
 		)
@@ -1025,6 +1410,21 @@ func (r *rpcServer) SendCoins(ctx context.Context,
 			return nil, err
 		}
 
+		// Now that the amount swept is known, we'll make sure the
+		// payout is allowed by our policy before broadcasting it.
+		var sweptAmt int64
+		for _, txOut := range sweepTxPkg.SweepTx.TxOut {
+			sweptAmt += txOut.Value
+		}
+		err = r.payoutPolicy.checkPayouts(
+			map[string]int64{targetAddr.String(): sweptAmt},
+		)
+		if err != nil {
+			sweepTxPkg.CancelSweepAttempt()
+
+			return nil, err
+		}
+
 		rpcsLog.Debugf("Sweeping all coins from wallet to addr=%v, "+
 			"with tx=%v", in.Addr, spew.Sdump(sweepTxPkg.SweepTx))
 
@@ -1043,21 +1443,14 @@ func (r *rpcServer) SendCoins(ctx context.Context,
 		txid = &sweepTXID
 	} else {
 
-		// We'll now construct out payment map, and use the wallet's
-		// coin selection synchronization method to ensure that no coin
-		// selection (funding, sweep alls, other sends) can proceed
-		// while we instruct the wallet to send this transaction.
+		// We'll now construct out payment map, and send it once we've
+		// made sure it's allowed by our payout policy.
 		paymentMap := map[string]int64{targetAddr.String(): in.Amount}
-		err := wallet.WithCoinSelectLock(func() error {
-			newTXID, err := r.sendCoinsOnChain(paymentMap, feePerKB)
-			if err != nil {
-				return err
-			}
-
-			txid = newTXID
+		if err := r.payoutPolicy.checkPayouts(paymentMap); err != nil {
+			return nil, err
+		}
 
-			return nil
-		})
+		txid, err = r.sendCoinsOnChain(paymentMap, feePerKB, constraints)
 		if err != nil {
 			return nil, err
 		}
@@ -1065,6 +1458,8 @@ func (r *rpcServer) SendCoins(ctx context.Context,
 
 	rpcsLog.Infof("[sendcoins] spend generated txid: %v", txid.String())
 
+	r.labelTransaction(txid, in.Label)
+
 	return &lnrpc.SendCoinsResponse{Txid: txid.String()}, nil
 }
 
@@ -1089,31 +1484,35 @@ func (r *rpcServer) SendMany(ctx context.Context,
 	rpcsLog.Infof("[sendmany] outputs=%v, atom/kb=%v",
 		spew.Sdump(in.AddrToAmount), int64(feePerKB))
 
-	var txid *chainhash.Hash
+	if len(in.Label) > channeldb.MaxTxLabelLength {
+		return nil, channeldb.ErrTxLabelTooLong
+	}
+
+	constraints, err := parseCoinConstraints(
+		in.MinConfs, in.SpendUnconfirmed, in.Outpoints,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Before any coin is selected, we'll make sure all the outputs are
+	// allowed by our payout policy.
+	if err := r.payoutPolicy.checkPayouts(in.AddrToAmount); err != nil {
+		return nil, err
+	}
 
 	// We'll attempt to send to the target set of outputs, ensuring that we
 	// synchronize with any other ongoing coin selection attempts which
 	// happen to also be concurrently executing.
-	wallet := r.server.cc.wallet
-	err = wallet.WithCoinSelectLock(func() error {
-
-		sendManyTXID, err := r.sendCoinsOnChain(
-			in.AddrToAmount, feePerKB,
-		)
-		if err != nil {
-			return err
-		}
-
-		txid = sendManyTXID
-
-		return nil
-	})
+	txid, err := r.sendCoinsOnChain(in.AddrToAmount, feePerKB, constraints)
 	if err != nil {
 		return nil, err
 	}
 
 	rpcsLog.Infof("[sendmany] spend generated txid: %v", txid.String())
 
+	r.labelTransaction(txid, in.Label)
+
 	return &lnrpc.SendManyResponse{Txid: txid.String()}, nil
 }
 
@@ -1365,6 +1764,24 @@ func extractOpenChannelMinConfs(in *lnrpc.OpenChannelRequest) (int32, error) {
 	}
 }
 
+// parseUpfrontShutdownAddress attempts to parse an upfront shutdown address.
+// If the address is empty, it returns nil. If it successfully decoded the
+// address, it returns a script that pays out to the address.
+func parseUpfrontShutdownAddress(address string) (lnwire.DeliveryAddress,
+	error) {
+
+	if len(address) == 0 {
+		return nil, nil
+	}
+
+	addr, err := dcrutil.DecodeAddress(address, activeNetParams.Params)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address: %v", err)
+	}
+
+	return txscript.PayToAddrScript(addr)
+}
+
 // OpenChannel attempts to open a singly funded channel specified in the
 // request to a remote peer.
 func (r *rpcServer) OpenChannel(in *lnrpc.OpenChannelRequest,
@@ -1394,12 +1811,14 @@ func (r *rpcServer) OpenChannel(in *lnrpc.OpenChannelRequest,
 			"state must be below the local funding amount")
 	}
 
-	// Ensure that the user doesn't exceed the current soft-limit for
-	// channel size. If the funding amount is above the soft-limit, then
-	// we'll reject the request.
-	if localFundingAmt > MaxFundingAmount {
+	// Ensure that the user doesn't exceed the configured max channel
+	// size. If the funding amount is above it, then we'll reject the
+	// request. Whether the peer supports channels above the default
+	// soft-limit is checked by the funding manager.
+	maxChanSize := dcrutil.Amount(cfg.MaxChanSize)
+	if localFundingAmt > maxChanSize {
 		return fmt.Errorf("funding amount is too large, the max "+
-			"channel size is: %v", MaxFundingAmount)
+			"channel size is: %v", maxChanSize)
 	}
 
 	// Restrict the size of the channel we'll actually open. At a later
@@ -1418,6 +1837,13 @@ func (r *rpcServer) OpenChannel(in *lnrpc.OpenChannelRequest,
 		return err
 	}
 
+	// If the user has provided a shutdown address, we'll parse it into
+	// the script our funds will be paid to upon a cooperative close.
+	script, err := parseUpfrontShutdownAddress(in.CloseAddress)
+	if err != nil {
+		return fmt.Errorf("error parsing upfront shutdown: %v", err)
+	}
+
 	var (
 		nodePubKey      *secp256k1.PublicKey
 		nodePubKeyBytes []byte
@@ -1464,6 +1890,13 @@ func (r *rpcServer) OpenChannel(in *lnrpc.OpenChannelRequest,
 	// Instruct the server to trigger the necessary events to attempt to
 	// open a new channel. A stream is returned in place, this stream will
 	// be used to consume updates of the state of the pending channel.
+	// Zero-conf channels can only be opened once we signal support for
+	// them.
+	if in.ZeroConf && !cfg.ZeroConf {
+		return fmt.Errorf("zero-conf channels are disabled, zeroconf " +
+			"must be set")
+	}
+
 	req := &openChanReq{
 		targetPubkey:    nodePubKey,
 		chainHash:       activeNetParams.GenesisHash,
@@ -1474,6 +1907,8 @@ func (r *rpcServer) OpenChannel(in *lnrpc.OpenChannelRequest,
 		private:         in.Private,
 		remoteCsvDelay:  remoteCsvDelay,
 		minConfs:        minConfs,
+		shutdownScript:  script,
+		zeroConf:        in.ZeroConf,
 	}
 
 	updateChan, errChan := r.server.OpenChannel(req)
@@ -1591,6 +2026,14 @@ func (r *rpcServer) OpenChannelSync(ctx context.Context,
 		return nil, err
 	}
 
+	// If the user has provided a shutdown address, we'll parse it into
+	// the script our funds will be paid to upon a cooperative close.
+	script, err := parseUpfrontShutdownAddress(in.CloseAddress)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing upfront shutdown: %v",
+			err)
+	}
+
 	// Based on the passed fee related parameters, we'll determine an
 	// appropriate fee rate for the funding transaction.
 	atomsPerKB := lnwallet.AtomPerKByte(in.AtomsPerByte * 1000)
@@ -1607,6 +2050,13 @@ func (r *rpcServer) OpenChannelSync(ctx context.Context,
 	rpcsLog.Tracef("[openchannel] target atom/kB for funding tx: %v",
 		int64(feeRate))
 
+	// Zero-conf channels can only be opened once we signal support for
+	// them.
+	if in.ZeroConf && !cfg.ZeroConf {
+		return nil, fmt.Errorf("zero-conf channels are disabled, " +
+			"zeroconf must be set")
+	}
+
 	req := &openChanReq{
 		targetPubkey:    nodepubKey,
 		chainHash:       activeNetParams.GenesisHash,
@@ -1617,6 +2067,8 @@ func (r *rpcServer) OpenChannelSync(ctx context.Context,
 		private:         in.Private,
 		remoteCsvDelay:  remoteCsvDelay,
 		minConfs:        minConfs,
+		shutdownScript:  script,
+		zeroConf:        in.ZeroConf,
 	}
 
 	updateChan, errChan := r.server.OpenChannel(req)
@@ -1650,6 +2102,182 @@ func (r *rpcServer) OpenChannelSync(ctx context.Context,
 	}
 }
 
+// BatchOpenChannel attempts to open multiple singly funded channels to
+// several remote peers within a single funding transaction. The call blocks
+// until the funding transaction has been broadcast, or any of the channels of
+// the batch failed.
+func (r *rpcServer) BatchOpenChannel(ctx context.Context,
+	in *lnrpc.BatchOpenChannelRequest) (*lnrpc.BatchOpenChannelResponse,
+	error) {
+
+	rpcsLog.Tracef("[batchopenchannel] request to open %d channels",
+		len(in.Channels))
+
+	// We don't allow new channels to be open while the server is still
+	// syncing, as otherwise we may not be able to obtain the relevant
+	// notifications.
+	if !r.server.Started() {
+		return nil, fmt.Errorf("chain backend is still syncing, server " +
+			"not active yet")
+	}
+
+	// Creation of channels before the wallet syncs up is currently
+	// disallowed.
+	isSynced, _, err := r.server.cc.wallet.IsSynced()
+	if err != nil {
+		return nil, err
+	}
+	if !isSynced {
+		return nil, errors.New("channels cannot be created before the " +
+			"wallet is fully synced")
+	}
+
+	if len(in.Channels) < 2 {
+		return nil, errors.New("a batch must contain at least two " +
+			"channels")
+	}
+
+	// The inputs of the funding transaction are shared by all channels of
+	// the batch, so the confirmation and fee parameters apply to the batch
+	// as a whole.
+	minConfs, err := extractOpenChannelMinConfs(&lnrpc.OpenChannelRequest{
+		MinConfs:         in.MinConfs,
+		SpendUnconfirmed: in.SpendUnconfirmed,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	atomsPerKB := lnwallet.AtomPerKByte(in.AtomsPerByte * 1000)
+	feeRate, err := sweep.DetermineFeePerKB(
+		r.server.cc.feeEstimator, sweep.FeePreference{
+			ConfTarget: uint32(in.TargetConf),
+			FeeRate:    atomsPerKB,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Tracef("[batchopenchannel] target atom/kB for funding tx: %v",
+		int64(feeRate))
+
+	batch := newFundingBatch(len(in.Channels), feeRate, minConfs)
+	reqs := make([]*openChanReq, 0, len(in.Channels))
+	peers := make(map[string]struct{}, len(in.Channels))
+	for i, channel := range in.Channels {
+		nodePubKey, err := secp256k1.ParsePubKey(channel.NodePubkey)
+		if err != nil {
+			return nil, fmt.Errorf("channel %d: unable to parse "+
+				"node pubkey: %v", i, err)
+		}
+
+		// Only a single channel per peer can be negotiated within a
+		// batch.
+		if _, ok := peers[string(channel.NodePubkey)]; ok {
+			return nil, fmt.Errorf("channel %d: duplicate peer %x",
+				i, channel.NodePubkey)
+		}
+		peers[string(channel.NodePubkey)] = struct{}{}
+
+		localFundingAmt := dcrutil.Amount(channel.LocalFundingAmount)
+		remoteInitialBalance := dcrutil.Amount(channel.PushAtoms)
+
+		// Ensure that the initial balance of the remote party (if
+		// pushing atoms) does not exceed the amount the local party
+		// has requested for funding.
+		if remoteInitialBalance >= localFundingAmt {
+			return nil, fmt.Errorf("channel %d: amount pushed to "+
+				"remote peer for initial state must be below "+
+				"the local funding amount", i)
+		}
+
+		// Ensure that the user doesn't exceed the configured max
+		// channel size, nor falls below the minimum channel size.
+		maxChanSize := dcrutil.Amount(cfg.MaxChanSize)
+		if localFundingAmt > maxChanSize {
+			return nil, fmt.Errorf("channel %d: funding amount is "+
+				"too large, the max channel size is: %v", i,
+				maxChanSize)
+		}
+		if localFundingAmt < minChanFundingSize {
+			return nil, fmt.Errorf("channel %d: channel is too "+
+				"small, the minimum channel size is: %v Atoms",
+				i, int64(minChanFundingSize))
+		}
+
+		reqs = append(reqs, &openChanReq{
+			targetPubkey:    nodePubKey,
+			chainHash:       activeNetParams.GenesisHash,
+			localFundingAmt: localFundingAmt,
+			pushAmt:         lnwire.NewMAtomsFromAtoms(remoteInitialBalance),
+			minHtlc:         lnwire.MilliAtom(channel.MinHtlcMAtoms),
+			fundingFeePerKB: feeRate,
+			private:         channel.Private,
+			remoteCsvDelay:  uint16(channel.RemoteCsvDelay),
+			minConfs:        minConfs,
+			batch:           batch,
+		})
+	}
+
+	// With all requests validated, we'll kick off the funding flow of
+	// every channel within the batch, and wait for each of them to either
+	// fail or reach the pending state.
+	type batchResult struct {
+		index  int
+		update *lnrpc.OpenStatusUpdate
+		err    error
+	}
+	results := make(chan batchResult, len(reqs))
+	for i, req := range reqs {
+		updateChan, errChan := r.server.OpenChannel(req)
+
+		go func(i int) {
+			select {
+			case err := <-errChan:
+				results <- batchResult{index: i, err: err}
+			case upd := <-updateChan:
+				results <- batchResult{index: i, update: upd}
+			case <-r.quit:
+			}
+		}(i)
+	}
+
+	pendingChannels := make([]*lnrpc.PendingUpdate, len(reqs))
+	for range reqs {
+		var res batchResult
+		select {
+		case res = <-results:
+
+		// If the caller goes away before the funding transaction is
+		// broadcast, the whole batch is canceled.
+		case <-ctx.Done():
+			r.server.fundingMgr.failBatch(batch, ctx.Err())
+			return nil, ctx.Err()
+
+		case <-r.quit:
+			return nil, errors.New("server shutting down")
+		}
+
+		// If any of the channels failed, we'll make sure the remaining
+		// channels of the batch are canceled as well.
+		if res.err != nil {
+			r.server.fundingMgr.failBatch(batch, res.err)
+
+			rpcsLog.Errorf("unable to open channel to NodeKey(%x) "+
+				"within batch: %v", in.Channels[res.index].NodePubkey,
+				res.err)
+			return nil, res.err
+		}
+
+		pendingChannels[res.index] = res.update.GetChanPending()
+	}
+
+	return &lnrpc.BatchOpenChannelResponse{
+		PendingChannels: pendingChannels,
+	}, nil
+}
+
 // GetChanPointFundingTxid returns the given channel point's funding txid in
 // raw bytes.
 func GetChanPointFundingTxid(chanPoint *lnrpc.ChannelPoint) (*chainhash.Hash, error) {
@@ -1685,10 +2313,11 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 		return fmt.Errorf("must specify channel point in close channel")
 	}
 
-	// If force closing a channel, the fee set in the commitment transaction
-	// is used.
-	if in.Force && (in.AtomsPerByte != 0 || in.TargetConf != 0) {
-		return fmt.Errorf("force closing a channel uses a pre-defined fee")
+	// A delivery address only makes sense for cooperative closures, as the
+	// outputs of the commitment transaction are fixed.
+	if in.Force && in.DeliveryAddress != "" {
+		return fmt.Errorf("cannot set delivery address when force " +
+			"closing a channel")
 	}
 
 	force := in.Force
@@ -1722,6 +2351,15 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 	// transaction here rather than going to the switch as we don't require
 	// interaction from the peer.
 	if force {
+		// If force closing a channel, the fee set in the commitment
+		// transaction is used. Channels with anchors allow bumping it
+		// through CPFP though, in which case the fee parameters are
+		// used to sweep the anchor.
+		anchorFeePref, err := r.anchorFeePreference(in, channel)
+		if err != nil {
+			return err
+		}
+
 		_, bestHeight, err := r.server.cc.chainIO.GetBestBlock()
 		if err != nil {
 			return err
@@ -1746,7 +2384,7 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 		// the channel.
 		chainArbitrator := r.server.chainArb
 		closingTx, err := chainArbitrator.ForceCloseContract(
-			*chanPoint,
+			*chanPoint, anchorFeePref,
 		)
 		if err != nil {
 			rpcsLog.Errorf("unable to force close transaction: %v", err)
@@ -1786,11 +2424,18 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 
 		// Based on the passed fee related parameters, we'll determine
 		// an appropriate fee rate for the cooperative closure
-		// transaction.
+		// transaction. Without any, the configured confirmation target
+		// of cooperative closes is used.
 		atomsPerKB := lnwallet.AtomPerKByte(in.AtomsPerByte * 1000)
+		targetConf := uint32(in.TargetConf)
+		if targetConf == 0 && atomsPerKB == 0 {
+			targetConf = r.server.feeService.ConfTarget(
+				lnwallet.FeeSubsystemCoopClose,
+			)
+		}
 		feeRate, err := sweep.DetermineFeePerKB(
 			r.server.cc.feeEstimator, sweep.FeePreference{
-				ConfTarget: uint32(in.TargetConf),
+				ConfTarget: targetConf,
 				FeeRate:    atomsPerKB,
 			},
 		)
@@ -1801,6 +2446,46 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 		rpcsLog.Debugf("Target atom/kB for closing transaction: %v",
 			int64(feeRate))
 
+		// If the caller specified a maximum fee rate, we'll make sure
+		// our starting fee rate doesn't exceed it.
+		if in.MaxAtomsPerByte < 0 {
+			return fmt.Errorf("max fee rate must not be negative")
+		}
+		maxFeeRate := lnwallet.AtomPerKByte(in.MaxAtomsPerByte * 1000)
+		if maxFeeRate != 0 && feeRate > maxFeeRate {
+			rpcsLog.Debugf("Clamping target atom/kB for closing "+
+				"transaction to max of %v", int64(maxFeeRate))
+
+			feeRate = maxFeeRate
+		}
+
+		// The closing transaction must still be relayable, so refuse
+		// to negotiate it below the network's relay fee floor.
+		relayFeeFloor := lnwallet.RelayFeeFloor(r.server.cc.feeEstimator)
+		if feeRate < relayFeeFloor {
+			return fmt.Errorf("fee rate of %v is below the relay "+
+				"fee floor of %v", feeRate, relayFeeFloor)
+		}
+
+		// If a delivery address was specified, we'll decode it and
+		// make sure it's valid for the current network before
+		// crafting the script we'll pay our settled funds to.
+		var deliveryScript []byte
+		if in.DeliveryAddress != "" {
+			addr, err := dcrutil.DecodeAddress(
+				in.DeliveryAddress, activeNetParams.Params,
+			)
+			if err != nil {
+				return fmt.Errorf("invalid delivery address: "+
+					"%v", err)
+			}
+
+			deliveryScript, err = txscript.PayToAddrScript(addr)
+			if err != nil {
+				return err
+			}
+		}
+
 		// Before we attempt the cooperative channel closure, we'll
 		// examine the channel to ensure that it doesn't have a
 		// lingering HTLC.
@@ -1815,6 +2500,7 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 		// broadcast details.
 		updateChan, errChan = r.server.htlcSwitch.CloseLink(
 			chanPoint, htlcswitch.CloseRegular, feeRate,
+			maxFeeRate, deliveryScript,
 		)
 	}
 out:
@@ -1883,18 +2569,70 @@ func createRPCCloseUpdate(update interface{}) (
 	return nil, errors.New("unknown close status update")
 }
 
+// anchorFeePreference returns the fee preference used to sweep the anchor of
+// the commitment when force closing the passed channel, according to the fee
+// parameters of the close request. As the fee of the commitment can only be
+// bumped through its anchor, setting any of them is refused for channels
+// without anchors.
+func (r *rpcServer) anchorFeePreference(in *lnrpc.CloseChannelRequest,
+	channel *lnwallet.LightningChannel) (sweep.FeePreference, error) {
+
+	var feePref sweep.FeePreference
+	if in.AtomsPerByte == 0 && in.TargetConf == 0 &&
+		in.MaxAtomsPerByte == 0 {
+
+		return feePref, nil
+	}
+
+	if !channel.State().ChanType.HasAnchors() {
+		return feePref, fmt.Errorf("force closing a channel without " +
+			"anchors uses a pre-defined fee")
+	}
+
+	if in.MaxAtomsPerByte < 0 {
+		return feePref, fmt.Errorf("max fee rate must not be negative")
+	}
+
+	feePref = sweep.FeePreference{
+		ConfTarget: uint32(in.TargetConf),
+		FeeRate:    lnwallet.AtomPerKByte(in.AtomsPerByte * 1000),
+		MaxFeeRate: lnwallet.AtomPerKByte(in.MaxAtomsPerByte * 1000),
+	}
+
+	// Make sure the preference resolves to a fee rate, so that it's
+	// refused before the commitment is broadcast rather than by the
+	// sweeper later on.
+	feeEstimator := r.server.cc.feeEstimator
+	if _, err := sweep.DetermineFeePerKB(feeEstimator, feePref); err != nil {
+		return feePref, err
+	}
+
+	// The anchor sweep must still be relayable, so the maximum fee rate
+	// can't be below the network's relay fee floor.
+	relayFeeFloor := lnwallet.RelayFeeFloor(feeEstimator)
+	if feePref.MaxFeeRate != 0 && feePref.MaxFeeRate < relayFeeFloor {
+		return feePref, fmt.Errorf("max fee rate of %v is below the "+
+			"relay fee floor of %v", feePref.MaxFeeRate,
+			relayFeeFloor)
+	}
+
+	return feePref, nil
+}
+
 // AbandonChannel removes all channel state from the database except for a
-// close summary. This method can be used to get rid of permanently unusable
-// channels due to bugs fixed in newer versions of lnd.
+// close summary, without any on-chain action. This method can be used to get
+// rid of permanently unusable channels due to bugs fixed in newer versions of
+// lnd, or of pending channels whose funding transaction will never confirm.
 func (r *rpcServer) AbandonChannel(ctx context.Context,
 	in *lnrpc.AbandonChannelRequest) (*lnrpc.AbandonChannelResponse, error) {
 
 	// If this isn't the dev build, then we won't allow the RPC to be
-	// executed, as it's an advanced feature and won't be activated in
-	// regular production/release builds.
-	if !build.IsDevBuild() {
+	// executed unless the caller explicitly confirmed it, as it's an
+	// advanced feature that may lead to a loss of funds.
+	if !build.IsDevBuild() && !in.IKnowWhatIAmDoing {
 		return nil, fmt.Errorf("AbandonChannel RPC call only " +
-			"available in dev builds")
+			"available in dev builds, unless " +
+			"i_know_what_i_am_doing is set")
 	}
 
 	// We'll parse out the arguments to we can obtain the chanPoint of the
@@ -1934,66 +2672,268 @@ func (r *rpcServer) AbandonChannel(ctx context.Context,
 		RemoteCurrentRevocation: dbChan.RemoteCurrentRevocation,
 		RemoteNextRevocation:    dbChan.RemoteNextRevocation,
 		LocalChanConfig:         dbChan.LocalChanCfg,
+		CloseInitiator:          channeldb.InitiatorLocal,
+	}
+
+	// If the channel is still pending and we created its funding
+	// transaction, we'll remove the transaction from the wallet so that
+	// the outputs it spends can be used again.
+	if dbChan.IsPending && dbChan.IsInitiator && dbChan.FundingTxn != nil {
+		fundingTxid := dbChan.FundingTxn.TxHash()
+		err := r.server.cc.wallet.AbandonTransaction(&fundingTxid)
+		if err != nil {
+			return nil, fmt.Errorf("unable to abandon funding tx "+
+				"%v: %v", fundingTxid, err)
+		}
+
+		for _, txIn := range dbChan.FundingTxn.TxIn {
+			r.server.cc.wallet.UnlockOutpoint(txIn.PreviousOutPoint)
+		}
 	}
 
-	// Finally, we'll close the channel in the DB, and return back to the
-	// caller.
+	// We'll close the channel in the DB, and stop watching it on-chain.
 	err = dbChan.CloseChannel(summary)
 	if err != nil {
 		return nil, err
 	}
+	if err := r.server.chainArb.ResolveContract(*chanPoint); err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Infof("Abandoned ChannelPoint(%v)", chanPoint)
+
+	// Finally, we'll notify the subscribers to channel events of the
+	// abandoned channel, and return back to the caller.
+	r.server.channelNotifier.NotifyClosedChannelEvent(*chanPoint)
 
 	return &lnrpc.AbandonChannelResponse{}, nil
 }
 
-// fetchActiveChannel attempts to locate a channel identified by its channel
-// point from the database's set of all currently opened channels and
-// return it as a fully populated state machine
-func (r *rpcServer) fetchActiveChannel(chanPoint wire.OutPoint) (
-	*lnwallet.LightningChannel, error) {
+// BumpFundingFee bumps the fee of the unconfirmed funding transaction of a
+// pending channel we initiated. The funding transaction can't be replaced, as
+// that would invalidate the commitment transactions already signed by the
+// remote peer, so instead one of its outputs controlled by the wallet is swept
+// with a higher fee rate, such that the child pays for its parent.
+func (r *rpcServer) BumpFundingFee(ctx context.Context,
+	in *lnrpc.BumpFundingFeeRequest) (*lnrpc.BumpFundingFeeResponse, error) {
 
-	dbChan, err := r.server.chanDB.FetchChannel(chanPoint)
+	txid, err := GetChanPointFundingTxid(in.GetChannelPoint())
 	if err != nil {
 		return nil, err
 	}
+	index := in.ChannelPoint.OutputIndex
+	chanPoint := wire.NewOutPoint(txid, index, wire.TxTreeRegular)
 
-	// If the channel is successfully fetched from the database,
-	// we create a fully populated channel state machine which
-	// uses the db channel as backing storage.
-	return lnwallet.NewLightningChannel(
-		r.server.cc.wallet.Cfg.Signer, dbChan, nil,
-	)
-}
-
-// GetInfo returns general information concerning the lightning node including
-// its identity pubkey, alias, the chains it is connected to, and information
-// concerning the number of open+pending channels.
-func (r *rpcServer) GetInfo(ctx context.Context,
-	in *lnrpc.GetInfoRequest) (*lnrpc.GetInfoResponse, error) {
-
-	serverPeers := r.server.Peers()
+	rpcsLog.Tracef("[bumpfundingfee] chan_point=%v, target_conf=%v, "+
+		"atoms_per_byte=%v", chanPoint, in.TargetConf, in.AtomsPerByte)
 
-	openChannels, err := r.server.chanDB.FetchAllOpenChannels()
+	dbChan, err := r.server.chanDB.FetchChannel(*chanPoint)
 	if err != nil {
 		return nil, err
 	}
 
-	var activeChannels uint32
-	for _, channel := range openChannels {
-		chanID := lnwire.NewChanIDFromOutPoint(&channel.FundingOutpoint)
-		if r.server.htlcSwitch.HasActiveLink(chanID) {
-			activeChannels++
-		}
-	}
+	// Only the funding transactions of the channels we funded are known to
+	// us, and only those that aren't confirmed yet need a bump.
+	switch {
+	case !dbChan.IsPending:
+		return nil, fmt.Errorf("channel %v is no longer pending",
+			chanPoint)
 
-	inactiveChannels := uint32(len(openChannels)) - activeChannels
+	case !dbChan.IsInitiator || dbChan.FundingTxn == nil:
+		return nil, fmt.Errorf("funding transaction of channel %v "+
+			"wasn't created by us", chanPoint)
+	}
 
-	pendingChannels, err := r.server.chanDB.FetchPendingChannels()
-	if err != nil {
-		return nil, fmt.Errorf("unable to get retrieve pending "+
-			"channels: %v", err)
+	feePreference := sweep.FeePreference{
+		ConfTarget: uint32(in.TargetConf),
+		FeeRate:    lnwallet.AtomPerKByte(in.AtomsPerByte * 1000),
 	}
-	nPendingChannels := uint32(len(pendingChannels))
+
+	// Look for an output of the funding transaction that is controlled by
+	// the wallet. The funding output itself and the funding outputs of
+	// other channels of the same batch are never ours.
+	fundingTx := dbChan.FundingTxn
+	fundingTxid := fundingTx.TxHash()
+	for i := range fundingTx.TxOut {
+		op := wire.NewOutPoint(
+			&fundingTxid, uint32(i), wire.TxTreeRegular,
+		)
+		if *op == *chanPoint {
+			continue
+		}
+
+		// If the output is already being swept, bumping the fee of
+		// the sweep is enough.
+		_, err := r.server.sweeper.BumpFee(*op, feePreference)
+		switch err {
+		case nil:
+			return &lnrpc.BumpFundingFeeResponse{
+				SweptOutpoint: op.String(),
+			}, nil
+
+		case lnwallet.ErrNotMine:
+
+		default:
+			return nil, err
+		}
+
+		utxo, err := r.server.cc.wallet.FetchInputInfo(op)
+		if err == lnwallet.ErrNotMine {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if utxo.Confirmations > 0 {
+			return nil, fmt.Errorf("funding transaction of channel "+
+				"%v is already confirmed", chanPoint)
+		}
+
+		if utxo.AddressType != lnwallet.PubKeyHash {
+			continue
+		}
+
+		signDesc := &input.SignDescriptor{
+			Output: &wire.TxOut{
+				PkScript: utxo.PkScript,
+				Value:    int64(utxo.Value),
+			},
+			HashType: txscript.SigHashAll,
+		}
+
+		// We'll use the current height as the height hint since we're
+		// dealing with an unconfirmed transaction.
+		_, bestHeight, err := r.server.cc.chainIO.GetBestBlock()
+		if err != nil {
+			return nil, err
+		}
+
+		inp := input.NewBaseInput(
+			op, input.PublicKeyHash, signDesc, uint32(bestHeight),
+		)
+		_, err = r.server.sweeper.SweepInput(inp, feePreference)
+		if err != nil {
+			return nil, err
+		}
+
+		rpcsLog.Infof("Bumping fee of funding transaction %v of "+
+			"channel %v by sweeping %v", fundingTxid, chanPoint, op)
+
+		return &lnrpc.BumpFundingFeeResponse{
+			SweptOutpoint: op.String(),
+		}, nil
+	}
+
+	return nil, fmt.Errorf("funding transaction of channel %v has no "+
+		"output controlled by the wallet to bump its fee with",
+		chanPoint)
+}
+
+// RebroadcastLimboOutput immediately re-broadcasts the transaction sweeping an
+// output of a force closed channel incubated by the nursery.
+func (r *rpcServer) RebroadcastLimboOutput(ctx context.Context,
+	in *lnrpc.RebroadcastLimboOutputRequest) (
+	*lnrpc.RebroadcastLimboOutputResponse, error) {
+
+	op := in.GetOutpoint()
+	if op == nil {
+		return nil, fmt.Errorf("outpoint must be specified")
+	}
+
+	var txid *chainhash.Hash
+	switch {
+	case len(op.TxidBytes) != 0 && len(op.TxidStr) != 0:
+		return nil, fmt.Errorf("either txid_bytes or txid_str must be " +
+			"specified, but not both")
+
+	case len(op.TxidBytes) != 0:
+		h, err := chainhash.NewHash(op.TxidBytes)
+		if err != nil {
+			return nil, err
+		}
+		txid = h
+
+	default:
+		h, err := chainhash.NewHashFromStr(op.TxidStr)
+		if err != nil {
+			return nil, err
+		}
+		txid = h
+	}
+	outpoint := wire.NewOutPoint(txid, op.OutputIndex, wire.TxTreeRegular)
+
+	rpcsLog.Tracef("[rebroadcastlimbooutput] outpoint=%v, target_conf=%v, "+
+		"atoms_per_byte=%v", outpoint, in.TargetConf, in.AtomsPerByte)
+
+	feePreference := sweep.FeePreference{
+		ConfTarget: uint32(in.TargetConf),
+		FeeRate:    lnwallet.AtomPerKByte(in.AtomsPerByte * 1000),
+	}
+
+	timeoutTxid, err := r.server.utxoNursery.RebroadcastOutput(
+		*outpoint, feePreference,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &lnrpc.RebroadcastLimboOutputResponse{}
+	if timeoutTxid != nil {
+		resp.TimeoutTxid = timeoutTxid.String()
+	}
+
+	return resp, nil
+}
+
+// fetchActiveChannel attempts to locate a channel identified by its channel
+// point from the database's set of all currently opened channels and
+// return it as a fully populated state machine
+func (r *rpcServer) fetchActiveChannel(chanPoint wire.OutPoint) (
+	*lnwallet.LightningChannel, error) {
+
+	dbChan, err := r.server.chanDB.FetchChannel(chanPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the channel is successfully fetched from the database,
+	// we create a fully populated channel state machine which
+	// uses the db channel as backing storage.
+	return lnwallet.NewLightningChannel(
+		r.server.cc.wallet.Cfg.Signer, dbChan, nil,
+	)
+}
+
+// GetInfo returns general information concerning the lightning node including
+// its identity pubkey, alias, the chains it is connected to, and information
+// concerning the number of open+pending channels.
+func (r *rpcServer) GetInfo(ctx context.Context,
+	in *lnrpc.GetInfoRequest) (*lnrpc.GetInfoResponse, error) {
+
+	serverPeers := r.server.Peers()
+
+	openChannels, err := r.server.chanDB.FetchAllOpenChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	var activeChannels uint32
+	for _, channel := range openChannels {
+		chanID := lnwire.NewChanIDFromOutPoint(&channel.FundingOutpoint)
+		if r.server.htlcSwitch.HasActiveLink(chanID) {
+			activeChannels++
+		}
+	}
+
+	inactiveChannels := uint32(len(openChannels)) - activeChannels
+
+	pendingChannels, err := r.server.chanDB.FetchPendingChannels()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get retrieve pending "+
+			"channels: %v", err)
+	}
+	nPendingChannels := uint32(len(pendingChannels))
 
 	idPub := r.server.identityPriv.PubKey().SerializeCompressed()
 	encodedIDPub := hex.EncodeToString(idPub)
@@ -2052,9 +2992,91 @@ func (r *rpcServer) GetInfo(ctx context.Context,
 		BestHeaderTimestamp: bestHeaderTimestamp,
 		Version:             build.Version(),
 		SyncedToGraph:       isGraphSynced,
+		Listeners:           r.serviceListeners(),
 	}, nil
 }
 
+// serviceListeners returns the resolved addresses the services of the daemon
+// listen on, along with whether they expect the PROXY protocol.
+func (r *rpcServer) serviceListeners() []*lnrpc.ServiceListener {
+	var listeners []*lnrpc.ServiceListener
+	addListeners := func(service string, addrs []net.Addr,
+		proxyProtocol bool) {
+
+		for _, addr := range addrs {
+			listeners = append(listeners, &lnrpc.ServiceListener{
+				Service:       service,
+				Address:       addr.String(),
+				ProxyProtocol: proxyProtocol,
+			})
+		}
+	}
+
+	addListeners("p2p", r.server.listenAddrs, cfg.ListenProxyProtocol)
+	addListeners("rpc", cfg.RPCListeners, cfg.RPCListenProxyProtocol)
+	addListeners("rest", cfg.RESTListeners, cfg.RESTListenProxyProtocol)
+
+	if r.tower != nil {
+		addListeners(
+			"watchtower", r.tower.ListeningAddrs(),
+			cfg.Watchtower.ProxyProtocol,
+		)
+	}
+
+	if cfg.Profile != "" {
+		listeners = append(listeners, &lnrpc.ServiceListener{
+			Service: "profiling",
+			Address: cfg.Profile,
+		})
+	}
+
+	return listeners
+}
+
+// SetPeerConnPolicy sets the persisted connection policy of a peer,
+// determining whether and how often the connection to the peer is
+// re-established once lost.
+func (r *rpcServer) SetPeerConnPolicy(ctx context.Context,
+	in *lnrpc.SetPeerConnPolicyRequest) (*lnrpc.SetPeerConnPolicyResponse, error) {
+
+	rpcsLog.Debugf("[setpeerpolicy] peer=%s, reconnect=%v, "+
+		"min_backoff=%ds, max_backoff=%ds", in.PubKey, in.Reconnect,
+		in.MinBackoffSecs, in.MaxBackoffSecs)
+
+	pubKeyBytes, err := hex.DecodeString(in.PubKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode pubkey bytes: %v", err)
+	}
+	peerPubKey, err := secp256k1.ParsePubKey(pubKeyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse pubkey: %v", err)
+	}
+
+	policy := &channeldb.PeerConnPolicy{
+		MinBackoff: time.Duration(in.MinBackoffSecs) * time.Second,
+		MaxBackoff: time.Duration(in.MaxBackoffSecs) * time.Second,
+	}
+	switch in.Reconnect {
+	case lnrpc.SetPeerConnPolicyRequest_DEFAULT:
+		policy.Reconnect = channeldb.ReconnectDefault
+	case lnrpc.SetPeerConnPolicyRequest_ALWAYS:
+		policy.Reconnect = channeldb.ReconnectAlways
+	case lnrpc.SetPeerConnPolicyRequest_NEVER:
+		policy.Reconnect = channeldb.ReconnectNever
+	default:
+		return nil, fmt.Errorf("unknown reconnect policy %v",
+			in.Reconnect)
+	}
+
+	var pubKey [33]byte
+	copy(pubKey[:], peerPubKey.SerializeCompressed())
+	if err := r.server.SetPeerConnPolicy(pubKey, policy); err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.SetPeerConnPolicyResponse{}, nil
+}
+
 // ListPeers returns a verbose listing of all currently active peers.
 func (r *rpcServer) ListPeers(ctx context.Context,
 	in *lnrpc.ListPeersRequest) (*lnrpc.ListPeersResponse, error) {
@@ -2099,28 +3121,27 @@ func (r *rpcServer) ListPeers(ctx context.Context,
 				nodePub)
 			lnrpcSyncType = lnrpc.Peer_UNKNOWN_SYNC
 		} else {
-			syncType := syncer.SyncType()
-			switch syncType {
-			case discovery.ActiveSync:
-				lnrpcSyncType = lnrpc.Peer_ACTIVE_SYNC
-			case discovery.PassiveSync:
-				lnrpcSyncType = lnrpc.Peer_PASSIVE_SYNC
-			default:
-				return nil, fmt.Errorf("unhandled sync type %v",
-					syncType)
+			var err error
+			lnrpcSyncType, err = marshallSyncType(syncer.SyncType())
+			if err != nil {
+				return nil, err
 			}
 		}
 
 		peer := &lnrpc.Peer{
-			PubKey:    hex.EncodeToString(nodePub[:]),
-			Address:   serverPeer.conn.RemoteAddr().String(),
-			Inbound:   serverPeer.inbound,
-			BytesRecv: atomic.LoadUint64(&serverPeer.bytesReceived),
-			BytesSent: atomic.LoadUint64(&serverPeer.bytesSent),
-			AtomsSent: atomsSent,
-			AtomsRecv: atomsRecv,
-			PingTime:  serverPeer.PingTime(),
-			SyncType:  lnrpcSyncType,
+			PubKey:       hex.EncodeToString(nodePub[:]),
+			Address:      serverPeer.conn.RemoteAddr().String(),
+			Inbound:      serverPeer.inbound,
+			BytesRecv:    atomic.LoadUint64(&serverPeer.bytesReceived),
+			BytesSent:    atomic.LoadUint64(&serverPeer.bytesSent),
+			AtomsSent:    atomsSent,
+			AtomsRecv:    atomsRecv,
+			PingTime:     serverPeer.PingTime(),
+			SyncType:     lnrpcSyncType,
+			InitDuration: serverPeer.InitDuration(),
+			BootstrapSource: r.server.bootstrapSource(
+				serverPeer.addr.IdentityKey,
+			),
 		}
 
 		resp.Peers = append(resp.Peers, peer)
@@ -2131,6 +3152,183 @@ func (r *rpcServer) ListPeers(ctx context.Context,
 	return resp, nil
 }
 
+// SubscribePeerEvents returns a uni-directional stream (server -> client)
+// for notifying the client of peers connecting, completing the exchange of
+// init messages and disconnecting.
+func (r *rpcServer) SubscribePeerEvents(req *lnrpc.PeerEventSubscription,
+	eventStream lnrpc.Lightning_SubscribePeerEventsServer) error {
+
+	peerEventSub, err := r.server.peerNotifier.SubscribePeerEvents()
+	if err != nil {
+		return err
+	}
+
+	// Ensure that the resources for the client is cleaned up once either
+	// the server, or client exits.
+	defer peerEventSub.Cancel()
+
+	for {
+		select {
+		// A new update has been sent by the peer notifier, we'll
+		// marshal it into the form expected by the gRPC client, then
+		// send it off to the client.
+		case e := <-peerEventSub.Updates():
+			var event *lnrpc.PeerEvent
+
+			switch peerEvent := e.(type) {
+			case peernotifier.PeerOnlineEvent:
+				event = &lnrpc.PeerEvent{
+					PubKey: hex.EncodeToString(
+						peerEvent.PubKey[:],
+					),
+					Type: lnrpc.PeerEvent_PEER_ONLINE,
+				}
+
+			case peernotifier.PeerActiveEvent:
+				event = &lnrpc.PeerEvent{
+					PubKey: hex.EncodeToString(
+						peerEvent.PubKey[:],
+					),
+					Type: lnrpc.PeerEvent_PEER_ACTIVE,
+				}
+
+			case peernotifier.PeerOfflineEvent:
+				event = &lnrpc.PeerEvent{
+					PubKey: hex.EncodeToString(
+						peerEvent.PubKey[:],
+					),
+					Type: lnrpc.PeerEvent_PEER_OFFLINE,
+				}
+				if peerEvent.Reason != nil {
+					event.Reason = peerEvent.Reason.Error()
+				}
+
+			default:
+				return fmt.Errorf("unexpected peer event: %v",
+					peerEvent)
+			}
+
+			if err := eventStream.Send(event); err != nil {
+				return err
+			}
+
+		case <-r.quit:
+			return nil
+		}
+	}
+}
+
+// marshallSyncType converts a gossip SyncerType into its RPC counterpart.
+func marshallSyncType(syncType discovery.SyncerType) (lnrpc.Peer_SyncType,
+	error) {
+
+	switch syncType {
+	case discovery.ActiveSync:
+		return lnrpc.Peer_ACTIVE_SYNC, nil
+	case discovery.PassiveSync:
+		return lnrpc.Peer_PASSIVE_SYNC, nil
+	case discovery.PinnedSync:
+		return lnrpc.Peer_PINNED_SYNC, nil
+	default:
+		return 0, fmt.Errorf("unhandled sync type %v", syncType)
+	}
+}
+
+// ListGossipSyncers returns the state of the gossip syncer of each currently
+// connected peer, along with the gossip bandwidth budget.
+func (r *rpcServer) ListGossipSyncers(ctx context.Context,
+	in *lnrpc.ListGossipSyncersRequest) (*lnrpc.ListGossipSyncersResponse,
+	error) {
+
+	rpcsLog.Tracef("[listgossipsyncers] request")
+
+	syncMgr := r.server.authGossiper.SyncManager()
+	rateBytes, burstBytes := syncMgr.MsgBudget()
+
+	resp := &lnrpc.ListGossipSyncersResponse{
+		GraphSynced:   syncMgr.IsGraphSynced(),
+		MsgRateBytes:  rateBytes,
+		MsgBurstBytes: burstBytes,
+	}
+	for peer, syncer := range syncMgr.GossipSyncers() {
+		syncType, err := marshallSyncType(syncer.SyncType())
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Syncers = append(resp.Syncers, &lnrpc.GossipSyncer{
+			PubKey:    hex.EncodeToString(peer[:]),
+			SyncType:  syncType,
+			SyncState: syncer.SyncStateName(),
+		})
+	}
+
+	// Sort the syncers by public key so the output is stable.
+	sort.Slice(resp.Syncers, func(i, j int) bool {
+		return resp.Syncers[i].PubKey < resp.Syncers[j].PubKey
+	})
+
+	return resp, nil
+}
+
+// marshallGraphSyncStatus converts the progress of the initial historical sync
+// into its RPC counterpart.
+func marshallGraphSyncStatus(status discovery.GraphSyncStatus) *lnrpc.GraphSyncStatus {
+	rpcStatus := &lnrpc.GraphSyncStatus{
+		Synced:      status.Synced,
+		NumChannels: status.NumChannels,
+		NumNodes:    status.NumNodes,
+	}
+	if !status.StartTime.IsZero() {
+		rpcStatus.StartTime = status.StartTime.Unix()
+	}
+	if !status.EndTime.IsZero() {
+		rpcStatus.EndTime = status.EndTime.Unix()
+	}
+
+	return rpcStatus
+}
+
+// GetGraphSyncStatus returns the progress of the initial historical sync of
+// the channel graph.
+func (r *rpcServer) GetGraphSyncStatus(ctx context.Context,
+	in *lnrpc.GraphSyncStatusRequest) (*lnrpc.GraphSyncStatus, error) {
+
+	rpcsLog.Tracef("[graphsyncstatus] request")
+
+	status, _ := r.server.authGossiper.SyncManager().GraphSyncStatus()
+
+	return marshallGraphSyncStatus(status), nil
+}
+
+// SubscribeGraphSyncStatus sends the current progress of the initial
+// historical sync of the channel graph, followed by a new status every time
+// the sync starts or completes.
+func (r *rpcServer) SubscribeGraphSyncStatus(req *lnrpc.GraphSyncSubscription,
+	updateStream lnrpc.Lightning_SubscribeGraphSyncStatusServer) error {
+
+	syncMgr := r.server.authGossiper.SyncManager()
+	for {
+		status, changed := syncMgr.GraphSyncStatus()
+		err := updateStream.Send(marshallGraphSyncStatus(status))
+		if err != nil {
+			return err
+		}
+
+		select {
+		case <-changed:
+
+		case <-updateStream.Context().Done():
+			return updateStream.Context().Err()
+
+		// The server is quitting, so we'll exit immediately. Returning
+		// nil will close the clients read end of the stream.
+		case <-r.quit:
+			return nil
+		}
+	}
+}
+
 // WalletBalance returns total unspent outputs(confirmed and unconfirmed), all
 // confirmed unspent outputs and all unconfirmed unspent outputs under control
 // by the wallet. This method can be modified by having the request specify
@@ -2288,6 +3486,32 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 		rpcsLog.Errorf("unable to fetch closed channels: %v", err)
 		return nil, err
 	}
+
+	// The sweeps of the outputs of force closed channels that are still in
+	// limbo are anticipated to be made at the fee rate currently estimated
+	// for the confirmation target of the nursery.
+	var sweepFeeRate lnwallet.AtomPerKByte
+	if len(pendingCloseChannels) > 0 {
+		sweepFeeRate, err = sweep.DetermineFeePerKB(
+			r.server.cc.feeEstimator, sweep.FeePreference{
+				ConfTarget: r.server.utxoNursery.confTarget(),
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The inputs currently being swept are fetched so that the state of
+	// the sweeps of the kindergarten outputs can be reported.
+	var pendingSweeps map[wire.OutPoint]*sweep.PendingInput
+	if len(pendingCloseChannels) > 0 {
+		pendingSweeps, err = r.server.sweeper.PendingInputs()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	for _, pendingClose := range pendingCloseChannels {
 		// First construct the channel struct itself, this will be
 		// needed regardless of how this channel was closed.
@@ -2316,6 +3540,12 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 				&lnrpc.PendingChannelsResponse_ClosedChannel{
 					Channel:     channel,
 					ClosingTxid: closeTXID,
+					CloseInitiator: marshallCloseInitiator(
+						pendingClose.CloseInitiator,
+					),
+					Resolutions: marshallCloseResolutions(
+						pendingClose.Resolutions,
+					),
 				},
 			)
 
@@ -2325,9 +3555,28 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 		// the utxoNursery for additional information.
 		// TODO(halseth): distinguish remote and local case?
 		case channeldb.LocalForceClose, channeldb.RemoteForceClose:
+			closeType := lnrpc.ChannelCloseSummary_LOCAL_FORCE_CLOSE
+			if pendingClose.CloseType == channeldb.RemoteForceClose {
+				closeType =
+					lnrpc.ChannelCloseSummary_REMOTE_FORCE_CLOSE
+			}
+
 			forceClose := &lnrpc.PendingChannelsResponse_ForceClosedChannel{
 				Channel:     channel,
 				ClosingTxid: closeTXID,
+				CloseType:   closeType,
+				CloseInitiator: marshallCloseInitiator(
+					pendingClose.CloseInitiator,
+				),
+				SettledBalance: int64(
+					pendingClose.SettledBalance,
+				),
+				TimeLockedBalance: int64(
+					pendingClose.TimeLockedBalance,
+				),
+				Resolutions: marshallCloseResolutions(
+					pendingClose.Resolutions,
+				),
 			}
 
 			// Fetch reports from both nursery and resolvers. At the
@@ -2336,14 +3585,16 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 			// and channel arbitrator will be the single source for
 			// these kind of reports.
 			err := r.nurseryPopulateForceCloseResp(
-				&chanPoint, currentHeight, forceClose,
+				&chanPoint, currentHeight, sweepFeeRate,
+				pendingSweeps, forceClose,
 			)
 			if err != nil {
 				return nil, err
 			}
 
 			err = r.arbitratorPopulateForceCloseResp(
-				&chanPoint, currentHeight, forceClose,
+				&chanPoint, currentHeight, sweepFeeRate,
+				forceClose,
 			)
 			if err != nil {
 				return nil, err
@@ -2385,6 +3636,9 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 			&lnrpc.PendingChannelsResponse_WaitingCloseChannel{
 				Channel:      channel,
 				LimboBalance: channel.LocalBalance,
+				CloseInitiator: marshallCloseInitiator(
+					waitingClose.CloseInitiator(),
+				),
 			},
 		)
 
@@ -2394,10 +3648,41 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 	return resp, nil
 }
 
+// PendingChannelLimits returns the limits on the number of pending channels
+// enforced when accepting new channels, along with the current number of
+// pending channels per peer and across all peers.
+func (r *rpcServer) PendingChannelLimits(ctx context.Context,
+	in *lnrpc.PendingChannelLimitsRequest) (
+	*lnrpc.PendingChannelLimitsResponse, error) {
+
+	fundingCfg := r.server.fundingMgr.cfg
+	pendingChans, err := r.server.fundingMgr.pendingChannelCounts()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &lnrpc.PendingChannelLimitsResponse{
+		MaxPendingChannels:       uint32(fundingCfg.MaxPendingChannels),
+		MaxGlobalPendingChannels: uint32(fundingCfg.MaxGlobalPendingChannels),
+		Peers: make(
+			[]*lnrpc.PeerPendingChannels, 0, len(pendingChans),
+		),
+	}
+	for peerKey, numPending := range pendingChans {
+		resp.NumPendingChannels += uint32(numPending)
+		resp.Peers = append(resp.Peers, &lnrpc.PeerPendingChannels{
+			PubKey:             hex.EncodeToString(peerKey[:]),
+			NumPendingChannels: uint32(numPending),
+		})
+	}
+
+	return resp, nil
+}
+
 // arbitratorPopulateForceCloseResp populates the pending channels response
 // message with channel resolution information from the contract resolvers.
 func (r *rpcServer) arbitratorPopulateForceCloseResp(chanPoint *wire.OutPoint,
-	currentHeight int32,
+	currentHeight int32, sweepFeeRate lnwallet.AtomPerKByte,
 	forceClose *lnrpc.PendingChannelsResponse_ForceClosedChannel) error {
 
 	// Query for contract resolvers state.
@@ -2425,15 +3710,33 @@ func (r *rpcServer) arbitratorPopulateForceCloseResp(chanPoint *wire.OutPoint,
 		forceClose.RecoveredBalance += int64(report.RecoveredBalance)
 
 		forceClose.PendingHtlcs = append(forceClose.PendingHtlcs, htlc)
+
+		// The contested htlcs are awaiting either their preimage, or
+		// their expiry to be timed out.
+		stage := lnrpc.LimboOutput_AWAITING_HTLC_EXPIRY
+		if report.Incoming {
+			stage = lnrpc.LimboOutput_AWAITING_PREIMAGE
+		}
+
+		forceClose.LimboOutputs = append(
+			forceClose.LimboOutputs, marshallLimboOutput(
+				report.Outpoint, report.LimboBalance,
+				lnrpc.LimboOutput_CLTV, stage,
+				report.MaturityHeight, currentHeight,
+				sweepFeeRate,
+			),
+		)
 	}
 
 	return nil
 }
 
 // nurseryPopulateForceCloseResp populates the pending channels response
-// message with contract resolution information from utxonursery.
+// message with contract resolution information from utxonursery, along with
+// the state of the sweeps of its outputs found within pendingSweeps.
 func (r *rpcServer) nurseryPopulateForceCloseResp(chanPoint *wire.OutPoint,
-	currentHeight int32,
+	currentHeight int32, sweepFeeRate lnwallet.AtomPerKByte,
+	pendingSweeps map[wire.OutPoint]*sweep.PendingInput,
 	forceClose *lnrpc.PendingChannelsResponse_ForceClosedChannel) error {
 
 	// Query for the maturity state for this force closed channel. If we
@@ -2486,23 +3789,309 @@ func (r *rpcServer) nurseryPopulateForceCloseResp(chanPoint *wire.OutPoint,
 			htlc)
 	}
 
+	for _, output := range nurseryInfo.limboOutputs {
+		var class lnrpc.LimboOutput_LimboClass
+		switch output.class {
+		case limboClassCSV:
+			class = lnrpc.LimboOutput_CSV
+		case limboClassCLTV:
+			class = lnrpc.LimboOutput_CLTV
+		case limboClassSecondLevel:
+			class = lnrpc.LimboOutput_SECOND_LEVEL
+		}
+
+		var stage lnrpc.LimboOutput_ResolverStage
+		switch output.stage {
+		case limboStageAwaitingExpiry:
+			stage = lnrpc.LimboOutput_AWAITING_HTLC_EXPIRY
+		case limboStageAwaitingConfirmation:
+			stage = lnrpc.LimboOutput_AWAITING_CONFIRMATION
+		case limboStageAwaitingMaturity:
+			stage = lnrpc.LimboOutput_AWAITING_MATURITY
+		}
+
+		limboOutput := marshallLimboOutput(
+			output.outpoint, output.amount, class, stage,
+			output.maturityHeight, currentHeight, sweepFeeRate,
+		)
+
+		if output.timeoutTxid != nil {
+			limboOutput.TimeoutTxid = output.timeoutTxid.String()
+		}
+
+		if pendingSweep, ok := pendingSweeps[output.outpoint]; ok {
+			limboOutput.SweepBroadcastAttempts = uint32(
+				pendingSweep.BroadcastAttempts,
+			)
+			limboOutput.SweepAtomsPerByte = uint32(
+				pendingSweep.LastFeeRate / 1000,
+			)
+			limboOutput.NextSweepBroadcastHeight =
+				pendingSweep.NextBroadcastHeight
+		}
+
+		forceClose.LimboOutputs = append(
+			forceClose.LimboOutputs, limboOutput,
+		)
+	}
+
 	return nil
 }
 
+// marshallLimboOutput converts the details of an output of a force closed
+// channel whose funds are in limbo to the type expected by the RPC.
+func marshallLimboOutput(outpoint wire.OutPoint, amount dcrutil.Amount,
+	class lnrpc.LimboOutput_LimboClass,
+	stage lnrpc.LimboOutput_ResolverStage, maturityHeight uint32,
+	currentHeight int32,
+	sweepFeeRate lnwallet.AtomPerKByte) *lnrpc.LimboOutput {
+
+	output := &lnrpc.LimboOutput{
+		Outpoint:       outpoint.String(),
+		Amount:         int64(amount),
+		LimboClass:     class,
+		MaturityHeight: maturityHeight,
+		ResolverStage:  stage,
+	}
+
+	if maturityHeight != 0 {
+		output.BlocksTilMaturity = int32(maturityHeight) - currentHeight
+
+		// Once mature, the output is only waiting for its sweep to
+		// confirm.
+		if stage == lnrpc.LimboOutput_AWAITING_MATURITY &&
+			output.BlocksTilMaturity <= 0 {
+
+			output.ResolverStage = lnrpc.LimboOutput_AWAITING_SWEEP
+		}
+	}
+
+	// The output is anticipated to be swept on its own into a single
+	// wallet output. Unlike the other outputs, htlc outputs that are
+	// spent directly after their expiry also reveal the htlc script.
+	sigScriptSize := input.ToLocalTimeoutSigScriptSize
+	if class == lnrpc.LimboOutput_CLTV {
+		sigScriptSize = input.AcceptedHtlcTimeoutSigScriptSize
+	}
+
+	var sizeEstimate input.TxSizeEstimator
+	sizeEstimate.AddCustomInput(sigScriptSize)
+	sizeEstimate.AddP2PKHOutput()
+	output.AnticipatedSweepFee = int64(
+		sweepFeeRate.FeeForSize(sizeEstimate.Size()),
+	)
+
+	return output
+}
+
+// parseChannelQuery builds the database query shared by the channel listing
+// RPCs from the peer and capacity range of a request.
+func parseChannelQuery(peer []byte, minCapacity,
+	maxCapacity int64) (channeldb.ChannelQuery, error) {
+
+	var query channeldb.ChannelQuery
+
+	if minCapacity < 0 || maxCapacity < 0 {
+		return query, fmt.Errorf("capacity filters must not be " +
+			"negative")
+	}
+	if maxCapacity != 0 && minCapacity > maxCapacity {
+		return query, fmt.Errorf("`min_capacity` must not be greater " +
+			"than `max_capacity`")
+	}
+	query.MinCapacity = dcrutil.Amount(minCapacity)
+	query.MaxCapacity = dcrutil.Amount(maxCapacity)
+
+	if len(peer) > 0 {
+		peerPubKey, err := secp256k1.ParsePubKey(peer)
+		if err != nil {
+			return query, fmt.Errorf("unable to parse peer "+
+				"pubkey: %v", err)
+		}
+		query.Peer = peerPubKey
+	}
+
+	return query, nil
+}
+
+// rpcChannelArbitrator maps the state report of a channel arbitrator to its
+// rpc counterpart.
+func rpcChannelArbitrator(
+	report *contractcourt.ArbitratorReport) *lnrpc.ChannelArbitrator {
+
+	arb := &lnrpc.ChannelArbitrator{
+		ChannelPoint: report.ChanPoint.String(),
+	}
+
+	switch report.State {
+	case contractcourt.StateBroadcastCommit:
+		arb.State = lnrpc.ChannelArbitrator_BROADCAST_COMMIT
+
+	case contractcourt.StateCommitmentBroadcasted:
+		arb.State = lnrpc.ChannelArbitrator_COMMITMENT_BROADCASTED
+
+	case contractcourt.StateContractClosed:
+		arb.State = lnrpc.ChannelArbitrator_CONTRACT_CLOSED
+
+	case contractcourt.StateWaitingFullResolution:
+		arb.State = lnrpc.ChannelArbitrator_WAITING_FULL_RESOLUTION
+
+	case contractcourt.StateFullyResolved:
+		arb.State = lnrpc.ChannelArbitrator_FULLY_RESOLVED
+
+	case contractcourt.StateError:
+		arb.State = lnrpc.ChannelArbitrator_ERROR
+
+	default:
+		arb.State = lnrpc.ChannelArbitrator_DEFAULT
+	}
+
+	for _, resolver := range report.Resolvers {
+		arb.Resolvers = append(
+			arb.Resolvers, rpcContractResolver(resolver),
+		)
+	}
+
+	return arb
+}
+
+// rpcContractResolver maps the progress report of a contract resolver to its
+// rpc counterpart.
+func rpcContractResolver(
+	report *contractcourt.ResolverReport) *lnrpc.ContractResolver {
+
+	resolver := &lnrpc.ContractResolver{
+		Outpoint:       report.OutPoint.String(),
+		Amount:         int64(report.Amount),
+		MaturityHeight: report.MaturityHeight,
+	}
+
+	switch report.Type {
+	case contractcourt.ResolverTypeIncomingHtlc:
+		resolver.ResolverType = lnrpc.ContractResolver_INCOMING_HTLC
+
+	case contractcourt.ResolverTypeOutgoingHtlc:
+		resolver.ResolverType = lnrpc.ContractResolver_OUTGOING_HTLC
+
+	default:
+		resolver.ResolverType = lnrpc.ContractResolver_COMMIT
+	}
+
+	switch report.Stage {
+	case contractcourt.ResolverStageAwaitingPreimage:
+		resolver.Stage = lnrpc.ContractResolver_AWAITING_PREIMAGE
+
+	case contractcourt.ResolverStageAwaitingExpiry:
+		resolver.Stage = lnrpc.ContractResolver_AWAITING_EXPIRY
+
+	case contractcourt.ResolverStageIncubating:
+		resolver.Stage = lnrpc.ContractResolver_INCUBATING
+
+	case contractcourt.ResolverStageSweeping:
+		resolver.Stage = lnrpc.ContractResolver_SWEEPING
+
+	case contractcourt.ResolverStageResolved:
+		resolver.Stage = lnrpc.ContractResolver_RESOLVED
+	}
+
+	if report.PaymentHash != nil {
+		resolver.PaymentHash = report.PaymentHash[:]
+	}
+	if report.SweepTxid != nil {
+		resolver.SweepTxid = report.SweepTxid.String()
+	}
+
+	return resolver
+}
+
+// ListArbitrators returns the state of the arbitrators of the channels that
+// are open or being resolved on-chain, along with the progress of the
+// resolvers claiming the outputs of the force closed channels.
+func (r *rpcServer) ListArbitrators(ctx context.Context,
+	in *lnrpc.ListArbitratorsRequest) (*lnrpc.ListArbitratorsResponse,
+	error) {
+
+	rpcsLog.Debugf("[listarbitrators]")
+
+	reports, err := r.server.chainArb.StateReports()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &lnrpc.ListArbitratorsResponse{}
+	for _, report := range reports {
+		arbitrator := rpcChannelArbitrator(report)
+		if in.ChannelPoint != "" &&
+			in.ChannelPoint != arbitrator.ChannelPoint {
+
+			continue
+		}
+
+		resp.Arbitrators = append(resp.Arbitrators, arbitrator)
+	}
+
+	// Sort the arbitrators by channel point so the output is stable.
+	sort.Slice(resp.Arbitrators, func(i, j int) bool {
+		return resp.Arbitrators[i].ChannelPoint <
+			resp.Arbitrators[j].ChannelPoint
+	})
+
+	return resp, nil
+}
+
 // ClosedChannels returns a list of all the channels have been closed.
 // This does not include channels that are still in the process of closing.
 func (r *rpcServer) ClosedChannels(ctx context.Context,
 	in *lnrpc.ClosedChannelsRequest) (*lnrpc.ClosedChannelsResponse,
 	error) {
 
+	channels, err := r.fetchClosedChannels(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.ClosedChannelsResponse{
+		Channels: channels,
+	}, nil
+}
+
+// StreamClosedChannels is a streaming variant of ClosedChannels. The closed
+// channels matching the request are sent one at a time.
+func (r *rpcServer) StreamClosedChannels(in *lnrpc.ClosedChannelsRequest,
+	updateStream lnrpc.Lightning_StreamClosedChannelsServer) error {
+
+	channels, err := r.fetchClosedChannels(in)
+	if err != nil {
+		return err
+	}
+
+	for _, channel := range channels {
+		if err := updateStream.Send(channel); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fetchClosedChannels returns the closed channels matching the request,
+// sorted by their closing height.
+func (r *rpcServer) fetchClosedChannels(
+	in *lnrpc.ClosedChannelsRequest) ([]*lnrpc.ChannelCloseSummary, error) {
+
 	// Show all channels when no filter flags are set.
 	filterResults := in.Cooperative || in.LocalForce ||
 		in.RemoteForce || in.Breach || in.FundingCanceled ||
 		in.Abandoned
 
-	resp := &lnrpc.ClosedChannelsResponse{}
+	query, err := parseChannelQuery(
+		in.Peer, in.MinCapacity, in.MaxCapacity,
+	)
+	if err != nil {
+		return nil, err
+	}
 
-	dbChannels, err := r.server.chanDB.FetchClosedChannels(false)
+	dbChannels, err := r.server.chanDB.QueryClosedChannels(query)
 	if err != nil {
 		return nil, err
 	}
@@ -2514,6 +4103,7 @@ func (r *rpcServer) ClosedChannels(ctx context.Context,
 		return dbChannels[i].CloseHeight < dbChannels[j].CloseHeight
 	})
 
+	var channels []*lnrpc.ChannelCloseSummary
 	for _, dbChannel := range dbChannels {
 		if dbChannel.IsPending {
 			continue
@@ -2546,17 +4136,53 @@ func (r *rpcServer) ClosedChannels(ctx context.Context,
 			}
 		}
 
-		channel := createRPCClosedChannel(dbChannel)
-		resp.Channels = append(resp.Channels, channel)
+		channel, err := r.createRPCClosedChannel(dbChannel)
+		if err != nil {
+			return nil, err
+		}
+		channels = append(channels, channel)
+	}
+
+	return channels, nil
+}
+
+// ListChannels returns a description of all the open channels that this node
+// is a participant in.
+func (r *rpcServer) ListChannels(ctx context.Context,
+	in *lnrpc.ListChannelsRequest) (*lnrpc.ListChannelsResponse, error) {
+
+	channels, err := r.fetchOpenChannels(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.ListChannelsResponse{
+		Channels: channels,
+	}, nil
+}
+
+// StreamChannels is a streaming variant of ListChannels. The open channels
+// matching the request are sent one at a time.
+func (r *rpcServer) StreamChannels(in *lnrpc.ListChannelsRequest,
+	updateStream lnrpc.Lightning_StreamChannelsServer) error {
+
+	channels, err := r.fetchOpenChannels(in)
+	if err != nil {
+		return err
+	}
+
+	for _, channel := range channels {
+		if err := updateStream.Send(channel); err != nil {
+			return err
+		}
 	}
 
-	return resp, nil
+	return nil
 }
 
-// ListChannels returns a description of all the open channels that this node
-// is a participant in.
-func (r *rpcServer) ListChannels(ctx context.Context,
-	in *lnrpc.ListChannelsRequest) (*lnrpc.ListChannelsResponse, error) {
+// fetchOpenChannels returns the open channels matching the request.
+func (r *rpcServer) fetchOpenChannels(
+	in *lnrpc.ListChannelsRequest) ([]*lnrpc.Channel, error) {
 
 	if in.ActiveOnly && in.InactiveOnly {
 		return nil, fmt.Errorf("either `active_only` or " +
@@ -2568,11 +4194,21 @@ func (r *rpcServer) ListChannels(ctx context.Context,
 			"`private_only` can be set, but not both")
 	}
 
-	resp := &lnrpc.ListChannelsResponse{}
+	if in.InitiatorOnly && in.NonInitiatorOnly {
+		return nil, fmt.Errorf("either `initiator_only` or " +
+			"`non_initiator_only` can be set, but not both")
+	}
+
+	query, err := parseChannelQuery(
+		in.Peer, in.MinCapacity, in.MaxCapacity,
+	)
+	if err != nil {
+		return nil, err
+	}
 
 	graph := r.server.chanDB.ChannelGraph()
 
-	dbChannels, err := r.server.chanDB.FetchAllOpenChannels()
+	dbChannels, err := r.server.chanDB.QueryOpenChannels(query)
 	if err != nil {
 		return nil, err
 	}
@@ -2580,7 +4216,17 @@ func (r *rpcServer) ListChannels(ctx context.Context,
 	rpcsLog.Debugf("[listchannels] fetched %v channels from DB",
 		len(dbChannels))
 
+	var channels []*lnrpc.Channel
 	for _, dbChannel := range dbChannels {
+		// The initiator filters don't need the state of the channel,
+		// so we'll apply them first.
+		switch {
+		case in.InitiatorOnly && !dbChannel.IsInitiator:
+			continue
+		case in.NonInitiatorOnly && dbChannel.IsInitiator:
+			continue
+		}
+
 		nodePub := dbChannel.IdentityPub
 		chanPoint := dbChannel.FundingOutpoint
 
@@ -2616,10 +4262,10 @@ func (r *rpcServer) ListChannels(ctx context.Context,
 			continue
 		}
 
-		resp.Channels = append(resp.Channels, channel)
+		channels = append(channels, channel)
 	}
 
-	return resp, nil
+	return channels, nil
 }
 
 // createRPCOpenChannel creates an *lnrpc.Channel from the *channeldb.Channel.
@@ -2681,6 +4327,10 @@ func createRPCOpenChannel(r *rpcServer, graph *channeldb.ChannelGraph,
 		LocalChanReserveAtoms:  int64(dbChannel.LocalChanCfg.ChanReserve),
 		RemoteChanReserveAtoms: int64(dbChannel.RemoteChanCfg.ChanReserve),
 		StaticRemoteKey:        dbChannel.ChanType.IsTweakless(),
+		NumHtlcsSent:           dbChannel.NumHtlcsSent,
+		NumHtlcsReceived:       dbChannel.NumHtlcsReceived,
+		NumHtlcsSentFailed:     dbChannel.NumHtlcsSentFailed,
+		NumHtlcsReceivedFailed: dbChannel.NumHtlcsReceivedFailed,
 	}
 
 	for i, htlc := range localCommit.Htlcs {
@@ -2698,10 +4348,59 @@ func createRPCOpenChannel(r *rpcServer, graph *channeldb.ChannelGraph,
 	return channel
 }
 
+// marshallCloseInitiator converts the initiator of the close of a channel to
+// its rpc type.
+func marshallCloseInitiator(initiator channeldb.Initiator) lnrpc.Initiator {
+	switch initiator {
+	case channeldb.InitiatorLocal:
+		return lnrpc.Initiator_INITIATOR_LOCAL
+	case channeldb.InitiatorRemote:
+		return lnrpc.Initiator_INITIATOR_REMOTE
+	default:
+		return lnrpc.Initiator_INITIATOR_UNKNOWN
+	}
+}
+
+// marshallCloseResolutions converts the outputs of the closing transaction of
+// a channel that belong to us to their rpc type.
+func marshallCloseResolutions(
+	resolutions []channeldb.CloseResolution) []*lnrpc.Resolution {
+
+	rpcResolutions := make([]*lnrpc.Resolution, 0, len(resolutions))
+	for _, res := range resolutions {
+		var resType lnrpc.Resolution_ResolutionType
+		switch res.Type {
+		case channeldb.ResolutionTypeSettled:
+			resType = lnrpc.Resolution_SETTLED
+		case channeldb.ResolutionTypeCommit:
+			resType = lnrpc.Resolution_COMMIT
+		case channeldb.ResolutionTypeIncomingHtlc:
+			resType = lnrpc.Resolution_INCOMING_HTLC
+		case channeldb.ResolutionTypeOutgoingHtlc:
+			resType = lnrpc.Resolution_OUTGOING_HTLC
+		case channeldb.ResolutionTypeBreach:
+			resType = lnrpc.Resolution_BREACH
+		}
+
+		rpcResolutions = append(rpcResolutions, &lnrpc.Resolution{
+			ResolutionType: resType,
+			Outpoint: &lnrpc.OutPoint{
+				TxidBytes:   res.OutPoint.Hash[:],
+				TxidStr:     res.OutPoint.Hash.String(),
+				OutputIndex: res.OutPoint.Index,
+			},
+			AmountAtoms: int64(res.Amount),
+		})
+	}
+
+	return rpcResolutions
+}
+
 // createRPCClosedChannel creates an *lnrpc.ClosedChannelSummary from a
 // *channeldb.ChannelCloseSummary.
-func createRPCClosedChannel(
-	dbChannel *channeldb.ChannelCloseSummary) *lnrpc.ChannelCloseSummary {
+func (r *rpcServer) createRPCClosedChannel(
+	dbChannel *channeldb.ChannelCloseSummary) (*lnrpc.ChannelCloseSummary,
+	error) {
 
 	nodePub := dbChannel.RemotePub
 	nodeID := hex.EncodeToString(nodePub.SerializeCompressed())
@@ -2722,7 +4421,7 @@ func createRPCClosedChannel(
 		closeType = lnrpc.ChannelCloseSummary_ABANDONED
 	}
 
-	return &lnrpc.ChannelCloseSummary{
+	channel := &lnrpc.ChannelCloseSummary{
 		Capacity:          int64(dbChannel.Capacity),
 		RemotePubkey:      nodeID,
 		CloseHeight:       dbChannel.CloseHeight,
@@ -2733,7 +4432,47 @@ func createRPCClosedChannel(
 		TimeLockedBalance: int64(dbChannel.TimeLockedBalance),
 		ChainHash:         dbChannel.ChainHash.String(),
 		ClosingTxHash:     dbChannel.ClosingTXID.String(),
+		CloseInitiator: marshallCloseInitiator(
+			dbChannel.CloseInitiator,
+		),
+		Resolutions: marshallCloseResolutions(dbChannel.Resolutions),
+	}
+
+	// The final state of the channel is only available for the channels
+	// closed since it started to be kept, so we'll report the remaining
+	// details only if we find it.
+	histChan, err := r.server.chanDB.FetchHistoricalChannel(
+		&dbChannel.ChanPoint,
+	)
+	switch {
+	case err == channeldb.ErrNoHistoricalBucket ||
+		err == channeldb.ErrChannelNotFound:
+
+		return channel, nil
+
+	case err != nil:
+		return nil, err
 	}
+
+	channel.HistoricalData = true
+	channel.Initiator = histChan.IsInitiator
+	channel.Private = histChan.ChannelFlags&lnwire.FFAnnounceChannel == 0
+	channel.TotalAtomsSent = int64(histChan.TotalMAtomsSent.ToAtoms())
+	channel.TotalAtomsReceived = int64(
+		histChan.TotalMAtomsReceived.ToAtoms(),
+	)
+	channel.CsvDelay = uint32(histChan.LocalChanCfg.CsvDelay)
+	channel.LocalChanReserveAtoms = int64(histChan.LocalChanCfg.ChanReserve)
+	channel.RemoteChanReserveAtoms = int64(
+		histChan.RemoteChanCfg.ChanReserve,
+	)
+	channel.StaticRemoteKey = histChan.ChanType.IsTweakless()
+	channel.NumHtlcsSent = histChan.NumHtlcsSent
+	channel.NumHtlcsReceived = histChan.NumHtlcsReceived
+	channel.NumHtlcsSentFailed = histChan.NumHtlcsSentFailed
+	channel.NumHtlcsReceivedFailed = histChan.NumHtlcsReceivedFailed
+
+	return channel, nil
 }
 
 // SubscribeChannelEvents returns a uni-directional stream (server -> client)
@@ -2770,7 +4509,12 @@ func (r *rpcServer) SubscribeChannelEvents(req *lnrpc.ChannelEventSubscription,
 					},
 				}
 			case channelnotifier.ClosedChannelEvent:
-				closedChannel := createRPCClosedChannel(event.CloseSummary)
+				closedChannel, err := r.createRPCClosedChannel(
+					event.CloseSummary,
+				)
+				if err != nil {
+					return err
+				}
 				update = &lnrpc.ChannelEventUpdate{
 					Type: lnrpc.ChannelEventUpdate_CLOSED_CHANNEL,
 					Channel: &lnrpc.ChannelEventUpdate_ClosedChannel{
@@ -2801,6 +4545,14 @@ func (r *rpcServer) SubscribeChannelEvents(req *lnrpc.ChannelEventSubscription,
 						},
 					},
 				}
+			case channelnotifier.RemoteCommitmentEvent:
+				commitUpdate := rpcRemoteCommitmentUpdate(event)
+				update = &lnrpc.ChannelEventUpdate{
+					Type: lnrpc.ChannelEventUpdate_REMOTE_COMMITMENT,
+					Channel: &lnrpc.ChannelEventUpdate_RemoteCommitment{
+						RemoteCommitment: commitUpdate,
+					},
+				}
 			default:
 				return fmt.Errorf("unexpected channel event update: %v", event)
 			}
@@ -2814,6 +4566,149 @@ func (r *rpcServer) SubscribeChannelEvents(req *lnrpc.ChannelEventSubscription,
 	}
 }
 
+// rpcRemoteCommitmentUpdate marshals the detection of a commitment of the
+// remote party into its RPC representation.
+func rpcRemoteCommitmentUpdate(
+	e channelnotifier.RemoteCommitmentEvent) *lnrpc.RemoteCommitmentUpdate {
+
+	update := &lnrpc.RemoteCommitmentUpdate{
+		ChannelPoint: &lnrpc.ChannelPoint{
+			FundingTxid: &lnrpc.ChannelPoint_FundingTxidBytes{
+				FundingTxidBytes: e.ChannelPoint.Hash[:],
+			},
+			OutputIndex: e.ChannelPoint.Index,
+		},
+		CommitTxid: e.CommitTxid.String(),
+		StateNum:   e.StateNum,
+		Confirmed:  e.Confirmed,
+	}
+
+	switch e.CommitType {
+	case channelnotifier.RemoteCommitLatest:
+		update.CommitmentType = lnrpc.RemoteCommitmentUpdate_LATEST
+
+	case channelnotifier.RemoteCommitPending:
+		update.CommitmentType = lnrpc.RemoteCommitmentUpdate_PENDING
+
+	case channelnotifier.RemoteCommitRevoked:
+		update.CommitmentType = lnrpc.RemoteCommitmentUpdate_REVOKED
+
+	default:
+		update.CommitmentType = lnrpc.RemoteCommitmentUpdate_UNKNOWN
+	}
+
+	return update
+}
+
+// SendCustomMessage sends a custom message of a type within the custom range
+// to a connected peer.
+func (r *rpcServer) SendCustomMessage(ctx context.Context,
+	req *lnrpc.SendCustomMessageRequest) (*lnrpc.SendCustomMessageResponse,
+	error) {
+
+	peer, err := route.NewVertexFromBytes(req.Peer)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Type > math.MaxUint16 {
+		return nil, fmt.Errorf("message type %v exceeds the maximum "+
+			"message type", req.Type)
+	}
+
+	err = r.server.SendCustomMessage(
+		peer, lnwire.MessageType(req.Type), req.Data,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.SendCustomMessageResponse{}, nil
+}
+
+// PushGossip sends our node announcement and the announcements and latest
+// updates of the selected channels of ours directly to a connected peer.
+func (r *rpcServer) PushGossip(ctx context.Context,
+	req *lnrpc.PushGossipRequest) (*lnrpc.PushGossipResponse, error) {
+
+	peer, err := route.NewVertexFromBytes(req.Peer)
+	if err != nil {
+		return nil, err
+	}
+
+	var chanIDs []lnwire.ShortChannelID
+	if req.AllChannels {
+		channels, err := r.server.chanDB.FetchAllOpenChannels()
+		if err != nil {
+			return nil, err
+		}
+		for _, channel := range channels {
+			chanIDs = append(chanIDs, channel.ShortChannelID)
+		}
+	} else {
+		for _, chanID := range req.ChanIds {
+			chanIDs = append(
+				chanIDs, lnwire.NewShortChanIDFromInt(chanID),
+			)
+		}
+	}
+
+	numMsgs, err := r.server.PushGossip(
+		peer, req.NodeAnnouncement, chanIDs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Debugf("[pushgossip] sent %v gossip messages to peer %v",
+		numMsgs, peer)
+
+	return &lnrpc.PushGossipResponse{
+		NumMessages: uint32(numMsgs),
+	}, nil
+}
+
+// SubscribeCustomMessages creates a uni-directional stream from the server to
+// the client in which the custom messages received from peers are sent over.
+func (r *rpcServer) SubscribeCustomMessages(
+	req *lnrpc.SubscribeCustomMessagesRequest,
+	updateStream lnrpc.Lightning_SubscribeCustomMessagesServer) error {
+
+	client, err := r.server.SubscribeCustomMessages()
+	if err != nil {
+		return err
+	}
+
+	// Ensure that the resources for the client is cleaned up once either
+	// the server, or client exits.
+	defer client.Cancel()
+
+	for {
+		select {
+		case update := <-client.Updates():
+			msg := update.(*CustomMessage)
+
+			err := updateStream.Send(&lnrpc.CustomMessage{
+				Peer: msg.Peer[:],
+				Type: uint32(msg.Msg.Type),
+				Data: msg.Msg.Data,
+			})
+			if err != nil {
+				return err
+			}
+
+		case <-client.Quit():
+			return errors.New("custom message subscription canceled")
+
+		case <-updateStream.Context().Done():
+			return updateStream.Context().Err()
+
+		case <-r.quit:
+			return nil
+		}
+	}
+}
+
 // paymentStream enables different types of payment streams, such as:
 // lnrpc.Lightning_SendPaymentServer and lnrpc.Lightning_SendToRouteServer to
 // execute sendPayment. We use this struct as a sort of bridge to enable code
@@ -2830,25 +4725,18 @@ type rpcPaymentRequest struct {
 	route *route.Route
 }
 
-// calculateFeeLimit returns the fee limit in MilliAtoms. If a percentage
-// based fee limit has been requested, we'll factor in the ratio provided with
-// the amount of the payment.
-func calculateFeeLimit(feeLimit *lnrpc.FeeLimit,
-	amount lnwire.MilliAtom) lnwire.MilliAtom {
-
-	switch feeLimit.GetLimit().(type) {
-	case *lnrpc.FeeLimit_Fixed:
-		return lnwire.NewMAtomsFromAtoms(
-			dcrutil.Amount(feeLimit.GetFixed()),
-		)
-	case *lnrpc.FeeLimit_Percent:
-		return amount * lnwire.MilliAtom(feeLimit.GetPercent()) / 100
-	default:
-		// If a fee limit was not specified, we'll use the payment's
-		// amount as an upper bound in order to avoid payment attempts
-		// from incurring fees higher than the payment amount itself.
-		return amount
+// newAMPRecord returns an AMP record paying to the invoice with the given
+// payment hash, using a random root share and set id.
+func newAMPRecord(invoiceHash lntypes.Hash) (*record.AMP, error) {
+	var rootShare, setID [32]byte
+	if _, err := rand.Read(rootShare[:]); err != nil {
+		return nil, err
 	}
+	if _, err := rand.Read(setID[:]); err != nil {
+		return nil, err
+	}
+
+	return record.NewAMP(invoiceHash, rootShare, setID, 0), nil
 }
 
 // SendPayment dispatches a bi-directional streaming RPC for sending payments
@@ -2939,11 +4827,15 @@ type rpcPaymentIntent struct {
 	rHash                [32]byte
 	cltvDelta            uint16
 	routeHints           [][]zpay32.HopHint
-	outgoingChannelID    *uint64
+	outgoingChannelIDs   []uint64
 	ignoreMaxOutboundAmt bool
 	payReq               []byte
+	label                string
+	reference            string
+	unsyncedGraphAction  routing.UnsyncedGraphAction
 
-	destTLV []tlv.Record
+	destTLV      []tlv.Record
+	destFeatures *lnwire.FeatureVector
 
 	route *route.Route
 }
@@ -2952,9 +4844,37 @@ type rpcPaymentIntent struct {
 // dispatch a client from the information presented by an RPC client. There are
 // three ways a client can specify their payment details: a payment request,
 // via manual details, or via a complete route.
-func extractPaymentIntent(rpcPayReq *rpcPaymentRequest) (rpcPaymentIntent, error) {
+func (r *rpcServer) extractPaymentIntent(
+	rpcPayReq *rpcPaymentRequest) (rpcPaymentIntent, error) {
+
 	payIntent := rpcPaymentIntent{
 		ignoreMaxOutboundAmt: rpcPayReq.IgnoreMaxOutboundAmt,
+		label:                rpcPayReq.Label,
+		reference:            rpcPayReq.Reference,
+	}
+
+	// Ensure the optional note of the payment can be stored along with
+	// it.
+	err := channeldb.ValidatePaymentNote(
+		rpcPayReq.Label, rpcPayReq.Reference,
+	)
+	if err != nil {
+		return payIntent, err
+	}
+
+	payIntent.unsyncedGraphAction, err =
+		routerrpc.UnmarshallUnsyncedGraphAction(
+			rpcPayReq.UnsyncedGraphAction,
+		)
+	if err != nil {
+		return payIntent, err
+	}
+
+	// AMP payments derive their payment hash from the invoice being paid,
+	// so they can only be made to a payment request.
+	if rpcPayReq.Amp && rpcPayReq.PaymentRequest == "" {
+		return payIntent, errors.New("amp payments require a " +
+			"payment request")
 	}
 
 	// If a route was specified, then we can use that directly.
@@ -2981,7 +4901,9 @@ func extractPaymentIntent(rpcPayReq *rpcPaymentRequest) (rpcPaymentIntent, error
 	// If there are no routes specified, pass along a outgoing channel
 	// restriction if specified.
 	if rpcPayReq.OutgoingChanId != 0 {
-		payIntent.outgoingChannelID = &rpcPayReq.OutgoingChanId
+		payIntent.outgoingChannelIDs = []uint64{
+			rpcPayReq.OutgoingChanId,
+		}
 	}
 
 	// Take the CLTV limit from the request if set, otherwise use the max.
@@ -3024,6 +4946,11 @@ func extractPaymentIntent(rpcPayReq *rpcPaymentRequest) (rpcPaymentIntent, error
 			return payIntent, err
 		}
 
+		err = routerrpc.ValidatePayReqFeatures(payReq)
+		if err != nil {
+			return payIntent, err
+		}
+
 		// If the amount was not included in the invoice, then we let
 		// the payee specify the amount of atoms they wish to send.
 		// We override the amount to pay with the amount provided from
@@ -3043,15 +4970,37 @@ func extractPaymentIntent(rpcPayReq *rpcPaymentRequest) (rpcPaymentIntent, error
 		}
 
 		// Calculate the fee limit that should be used for this payment.
-		payIntent.feeLimit = calculateFeeLimit(
+		payIntent.feeLimit = r.routerBackend.CalculateFeeLimit(
 			rpcPayReq.FeeLimit, payIntent.mat,
 		)
 
 		copy(payIntent.rHash[:], payReq.PaymentHash[:])
+
+		// For AMP payments, we'll pay to the hash of a freshly derived
+		// child preimage instead, and pass along the AMP record so the
+		// receiver can identify the invoice and settle the payment.
+		if rpcPayReq.Amp {
+			amp, err := newAMPRecord(*payReq.PaymentHash)
+			if err != nil {
+				return payIntent, err
+			}
+
+			payIntent.rHash = amp.ChildHash()
+			payIntent.destTLV = append(payIntent.destTLV, amp.Record())
+		}
+
+		// If the invoice carries a payment address, the receiver
+		// requires it to be echoed back in the final hop payload.
+		if payReq.PaymentAddr != nil {
+			mpp := record.NewMPP(payIntent.mat, *payReq.PaymentAddr)
+			payIntent.destTLV = append(payIntent.destTLV, mpp.Record())
+		}
+
 		destKey := payReq.Destination.SerializeCompressed()
 		copy(payIntent.dest[:], destKey)
 		payIntent.cltvDelta = uint16(payReq.MinFinalCLTVExpiry())
 		payIntent.routeHints = payReq.RouteHints
+		payIntent.destFeatures = payReq.Features
 		payIntent.payReq = []byte(rpcPayReq.PaymentRequest)
 
 		return payIntent, nil
@@ -3083,7 +5032,7 @@ func extractPaymentIntent(rpcPayReq *rpcPaymentRequest) (rpcPaymentIntent, error
 	)
 
 	// Calculate the fee limit that should be used for this payment.
-	payIntent.feeLimit = calculateFeeLimit(
+	payIntent.feeLimit = r.routerBackend.CalculateFeeLimit(
 		rpcPayReq.FeeLimit, payIntent.mat,
 	)
 
@@ -3256,17 +5205,21 @@ func (r *rpcServer) dispatchPaymentIntent(
 	// router, otherwise we'll create a payment session to execute it.
 	if payIntent.route == nil {
 		payment := &routing.LightningPayment{
-			Target:            payIntent.dest,
-			Amount:            payIntent.mat,
-			FinalCLTVDelta:    payIntent.cltvDelta,
-			FeeLimit:          payIntent.feeLimit,
-			CltvLimit:         payIntent.cltvLimit,
-			PaymentHash:       payIntent.rHash,
-			RouteHints:        payIntent.routeHints,
-			OutgoingChannelID: payIntent.outgoingChannelID,
-			PaymentRequest:    payIntent.payReq,
-			PayAttemptTimeout: routing.DefaultPayAttemptTimeout,
-			FinalDestRecords:  payIntent.destTLV,
+			Target:              payIntent.dest,
+			Amount:              payIntent.mat,
+			FinalCLTVDelta:      payIntent.cltvDelta,
+			FeeLimit:            payIntent.feeLimit,
+			CltvLimit:           payIntent.cltvLimit,
+			PaymentHash:         payIntent.rHash,
+			RouteHints:          payIntent.routeHints,
+			OutgoingChannelIDs:  payIntent.outgoingChannelIDs,
+			PaymentRequest:      payIntent.payReq,
+			PayAttemptTimeout:   routing.DefaultPayAttemptTimeout,
+			FinalDestRecords:    payIntent.destTLV,
+			DestFeatures:        payIntent.destFeatures,
+			Label:               payIntent.label,
+			Reference:           payIntent.reference,
+			UnsyncedGraphAction: payIntent.unsyncedGraphAction,
 		}
 
 		preImage, route, routerErr = r.server.chanRouter.SendPayment(
@@ -3366,7 +5319,9 @@ func (r *rpcServer) sendPayment(stream *paymentStream) error {
 				// fields. If the payment proto wasn't well
 				// formed, then we'll send an error reply and
 				// wait for the next payment.
-				payIntent, err := extractPaymentIntent(nextPayment)
+				payIntent, err := r.extractPaymentIntent(
+					nextPayment,
+				)
 				if err != nil {
 					if err := stream.send(&lnrpc.SendResponse{
 						PaymentError: err.Error(),
@@ -3506,7 +5461,7 @@ func (r *rpcServer) sendPaymentSync(ctx context.Context,
 
 	// First we'll attempt to map the proto describing the next payment to
 	// an intent that we can pass to local sub-systems.
-	payIntent, err := extractPaymentIntent(nextPayment)
+	payIntent, err := r.extractPaymentIntent(nextPayment)
 	if err != nil {
 		return nil, err
 	}
@@ -3642,11 +5597,15 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 	addInvoiceCfg := &invoicesrpc.AddInvoiceConfig{
 		AddInvoice:        r.server.invoices.AddInvoice,
 		IsChannelActive:   r.server.htlcSwitch.HasActiveLink,
+		IsPeerOnline:      r.server.IsPeerOnline,
+		PeerReliability:   r.routerBackend.PeerReliability,
 		ChainParams:       activeNetParams.Params,
 		NodeSigner:        r.server.nodeSigner,
 		MaxPaymentMAtoms:  MaxPaymentMAtoms,
 		DefaultCLTVExpiry: defaultDelta,
 		ChanDB:            r.server.chanDB,
+		Features:          r.server.featureMgr.Get(feature.SetInvoice),
+		MinHopHints:       cfg.MinHopHints,
 	}
 
 	addInvoiceData := &invoicesrpc.AddInvoiceData{
@@ -3658,6 +5617,9 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 		FallbackAddr:    invoice.FallbackAddr,
 		CltvExpiry:      invoice.CltvExpiry,
 		Private:         invoice.Private,
+		MaxHopHints:     invoice.MaxHopHints,
+		HopHintChannels: invoice.HopHintChanIds,
+		AMP:             invoice.Amp,
 	}
 
 	if invoice.RPreimage != nil {
@@ -3688,6 +5650,12 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 func (r *rpcServer) LookupInvoice(ctx context.Context,
 	req *lnrpc.PaymentHash) (*lnrpc.Invoice, error) {
 
+	// If a payment address was provided, then we'll look up the invoice
+	// using it instead of the payment hash.
+	if len(req.PaymentAddr) != 0 {
+		return r.lookupInvoiceByPayAddr(req.PaymentAddr)
+	}
+
 	var (
 		payHash [32]byte
 		rHash   []byte
@@ -3734,6 +5702,72 @@ func (r *rpcServer) LookupInvoice(ctx context.Context,
 	return rpcInvoice, nil
 }
 
+// lookupInvoiceByPayAddr looks up the invoice with the given payment address.
+func (r *rpcServer) lookupInvoiceByPayAddr(
+	payAddrBytes []byte) (*lnrpc.Invoice, error) {
+
+	// Ensure that the payment address is *exactly* 32-bytes.
+	if len(payAddrBytes) != 32 {
+		return nil, fmt.Errorf("payment addr must be exactly "+
+			"32 bytes, is instead %v", len(payAddrBytes))
+	}
+
+	var payAddr [32]byte
+	copy(payAddr[:], payAddrBytes)
+
+	rpcsLog.Tracef("[lookupinvoice] searching for invoice with payment "+
+		"addr %x", payAddr[:])
+
+	invoice, err := r.server.chanDB.LookupInvoiceByPayAddr(payAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return invoicesrpc.CreateRPCInvoice(&invoice, activeNetParams.Params)
+}
+
+// InvoiceURI looks up an invoice according to its payment hash and returns it
+// alongside its canonical payment URI and the form of the URI best suited for
+// QR codes.
+func (r *rpcServer) InvoiceURI(ctx context.Context,
+	req *lnrpc.InvoiceURIRequest) (*lnrpc.InvoiceURIResponse, error) {
+
+	rpcInvoice, err := r.LookupInvoice(ctx, &lnrpc.PaymentHash{
+		RHashStr: req.RHashStr,
+		RHash:    req.RHash,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Invoices that were not created through AddInvoice, such as the ones
+	// of spontaneous payments, have no payment request to pay.
+	if rpcInvoice.PaymentRequest == "" {
+		return nil, fmt.Errorf("invoice %x has no payment request",
+			rpcInvoice.RHash)
+	}
+
+	invoice, err := zpay32.Decode(
+		rpcInvoice.PaymentRequest, activeNetParams.Params,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	label := req.Label
+	if label == "" {
+		label = rpcInvoice.Memo
+	}
+
+	return &lnrpc.InvoiceURIResponse{
+		Invoice: rpcInvoice,
+		Uri: zpay32.PaymentURI(
+			rpcInvoice.PaymentRequest, invoice, label,
+		),
+		QrUri: zpay32.QRPaymentURI(rpcInvoice.PaymentRequest),
+	}, nil
+}
+
 // ListInvoices returns a list of all the invoices currently stored within the
 // database. Any active debug invoices are ignored.
 func (r *rpcServer) ListInvoices(ctx context.Context,
@@ -3792,7 +5826,7 @@ func (r *rpcServer) SubscribeInvoices(req *lnrpc.InvoiceSubscription,
 	updateStream lnrpc.Lightning_SubscribeInvoicesServer) error {
 
 	invoiceClient := r.server.invoices.SubscribeNotifications(
-		req.AddIndex, req.SettleIndex,
+		req.AddIndex, req.SettleIndex, req.IncludeCanceled,
 	)
 	defer invoiceClient.Cancel()
 
@@ -3822,15 +5856,89 @@ func (r *rpcServer) SubscribeInvoices(req *lnrpc.InvoiceSubscription,
 				return err
 			}
 
+		case canceledInvoice := <-invoiceClient.CanceledInvoices:
+			rpcInvoice, err := invoicesrpc.CreateRPCInvoice(
+				canceledInvoice, activeNetParams.Params,
+			)
+			if err != nil {
+				return err
+			}
+
+			if err := updateStream.Send(rpcInvoice); err != nil {
+				return err
+			}
+
 		case <-r.quit:
 			return nil
 		}
 	}
 }
 
+// txMatchesFilter returns true if the transaction, given its label, matches
+// the account, amount and label filters of the request. A transaction matches
+// the account filter if at least one of its outputs pays to one of the
+// accounts.
+func txMatchesFilter(req *lnrpc.GetTransactionsRequest,
+	tx *lnwallet.TransactionDetail, label string) bool {
+
+	if !strings.Contains(label, req.LabelFilter) {
+		return false
+	}
+
+	value := tx.Value
+	if value < 0 {
+		value = -value
+	}
+	if int64(value) < req.MinAmount {
+		return false
+	}
+
+	if len(req.Accounts) == 0 {
+		return true
+	}
+	for _, output := range tx.OutputDetails {
+		if !output.IsOurAddress {
+			continue
+		}
+		for _, account := range req.Accounts {
+			if output.Account == account {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// marshallOutputDetails converts the details of the outputs of a transaction
+// into their RPC counterpart.
+func marshallOutputDetails(
+	outputs []lnwallet.OutputDetail) []*lnrpc.OutputDetail {
+
+	rpcOutputs := make([]*lnrpc.OutputDetail, 0, len(outputs))
+	for _, output := range outputs {
+		addresses := make([]string, 0, len(output.Addresses))
+		for _, addr := range output.Addresses {
+			addresses = append(addresses, addr.Address())
+		}
+
+		rpcOutputs = append(rpcOutputs, &lnrpc.OutputDetail{
+			OutputIndex:  int64(output.OutputIndex),
+			Amount:       int64(output.Value),
+			PkScript:     hex.EncodeToString(output.PkScript),
+			Addresses:    addresses,
+			IsOurAddress: output.IsOurAddress,
+			Account:      output.Account,
+			Internal:     output.Internal,
+		})
+	}
+
+	return rpcOutputs
+}
+
 // SubscribeTransactions creates a uni-directional stream (server -> client) in
 // which any newly discovered transactions relevant to the wallet are sent
-// over.
+// over. Only the transactions matching the filters of the request are sent.
 func (r *rpcServer) SubscribeTransactions(req *lnrpc.GetTransactionsRequest,
 	updateStream lnrpc.Lightning_SubscribeTransactionsServer) error {
 
@@ -3843,6 +5951,14 @@ func (r *rpcServer) SubscribeTransactions(req *lnrpc.GetTransactionsRequest,
 	for {
 		select {
 		case tx := <-txClient.ConfirmedTransactions():
+			label, err := r.server.chanDB.FetchTxLabel(tx.Hash)
+			if err != nil {
+				return err
+			}
+			if !txMatchesFilter(req, tx, label) {
+				continue
+			}
+
 			destAddresses := make([]string, 0, len(tx.DestAddresses))
 			for _, destAddress := range tx.DestAddresses {
 				destAddresses = append(destAddresses, destAddress.Address())
@@ -3857,12 +5973,24 @@ func (r *rpcServer) SubscribeTransactions(req *lnrpc.GetTransactionsRequest,
 				TotalFees:        tx.TotalFees,
 				DestAddresses:    destAddresses,
 				RawTxHex:         hex.EncodeToString(tx.RawTx),
+				OutputDetails: marshallOutputDetails(
+					tx.OutputDetails,
+				),
+				Label: label,
 			}
 			if err := updateStream.Send(detail); err != nil {
 				return err
 			}
 
 		case tx := <-txClient.UnconfirmedTransactions():
+			label, err := r.server.chanDB.FetchTxLabel(tx.Hash)
+			if err != nil {
+				return err
+			}
+			if !txMatchesFilter(req, tx, label) {
+				continue
+			}
+
 			var destAddresses []string
 			for _, destAddress := range tx.DestAddresses {
 				destAddresses = append(destAddresses, destAddress.Address())
@@ -3874,6 +6002,10 @@ func (r *rpcServer) SubscribeTransactions(req *lnrpc.GetTransactionsRequest,
 				TotalFees:     tx.TotalFees,
 				DestAddresses: destAddresses,
 				RawTxHex:      hex.EncodeToString(tx.RawTx),
+				OutputDetails: marshallOutputDetails(
+					tx.OutputDetails,
+				),
+				Label: label,
 			}
 			if err := updateStream.Send(detail); err != nil {
 				return err
@@ -3886,9 +6018,9 @@ func (r *rpcServer) SubscribeTransactions(req *lnrpc.GetTransactionsRequest,
 }
 
 // GetTransactions returns a list of describing all the known transactions
-// relevant to the wallet.
+// relevant to the wallet and matching the filters of the request.
 func (r *rpcServer) GetTransactions(ctx context.Context,
-	_ *lnrpc.GetTransactionsRequest) (*lnrpc.TransactionDetails, error) {
+	req *lnrpc.GetTransactionsRequest) (*lnrpc.TransactionDetails, error) {
 
 	// TODO(roasbeef): add pagination support
 	transactions, err := r.server.cc.wallet.ListTransactionDetails()
@@ -3896,10 +6028,19 @@ func (r *rpcServer) GetTransactions(ctx context.Context,
 		return nil, err
 	}
 
+	labels, err := r.server.chanDB.FetchTxLabels()
+	if err != nil {
+		return nil, err
+	}
+
 	txDetails := &lnrpc.TransactionDetails{
-		Transactions: make([]*lnrpc.Transaction, len(transactions)),
+		Transactions: make([]*lnrpc.Transaction, 0, len(transactions)),
 	}
-	for i, tx := range transactions {
+	for _, tx := range transactions {
+		if !txMatchesFilter(req, tx, labels[tx.Hash]) {
+			continue
+		}
+
 		var destAddresses []string
 		for _, destAddress := range tx.DestAddresses {
 			destAddresses = append(destAddresses, destAddress.Address())
@@ -3912,7 +6053,7 @@ func (r *rpcServer) GetTransactions(ctx context.Context,
 			blockHash = tx.BlockHash.String()
 		}
 
-		txDetails.Transactions[i] = &lnrpc.Transaction{
+		rpcTx := &lnrpc.Transaction{
 			TxHash:           tx.Hash.String(),
 			Amount:           int64(tx.Value),
 			NumConfirmations: tx.NumConfirmations,
@@ -3922,7 +6063,12 @@ func (r *rpcServer) GetTransactions(ctx context.Context,
 			TotalFees:        tx.TotalFees,
 			DestAddresses:    destAddresses,
 			RawTxHex:         hex.EncodeToString(tx.RawTx),
+			OutputDetails: marshallOutputDetails(
+				tx.OutputDetails,
+			),
+			Label: labels[tx.Hash],
 		}
+		txDetails.Transactions = append(txDetails.Transactions, rpcTx)
 	}
 
 	return txDetails, nil
@@ -3958,12 +6104,21 @@ func (r *rpcServer) DescribeGraph(ctx context.Context,
 			nodeAddrs = append(nodeAddrs, nodeAddr)
 		}
 
+		// Nodes announcing malformed custom records are still
+		// returned, just without them.
+		customRecords, err := node.CustomRecords()
+		if err != nil {
+			rpcsLog.Debugf("Unable to decode custom records of "+
+				"node %x: %v", node.PubKeyBytes, err)
+		}
+
 		resp.Nodes = append(resp.Nodes, &lnrpc.LightningNode{
-			LastUpdate: uint32(node.LastUpdate.Unix()),
-			PubKey:     hex.EncodeToString(node.PubKeyBytes[:]),
-			Addresses:  nodeAddrs,
-			Alias:      node.Alias,
-			Color:      routing.EncodeHexColor(node.Color),
+			LastUpdate:    uint32(node.LastUpdate.Unix()),
+			PubKey:        hex.EncodeToString(node.PubKeyBytes[:]),
+			Addresses:     nodeAddrs,
+			Alias:         node.Alias,
+			Color:         routing.EncodeHexColor(node.Color),
+			CustomRecords: customRecords,
 		})
 
 		return nil
@@ -4145,13 +6300,20 @@ func (r *rpcServer) GetNodeInfo(ctx context.Context,
 		nodeAddrs = append(nodeAddrs, nodeAddr)
 	}
 
+	customRecords, err := node.CustomRecords()
+	if err != nil {
+		rpcsLog.Debugf("Unable to decode custom records of node %v: %v",
+			in.PubKey, err)
+	}
+
 	return &lnrpc.NodeInfo{
 		Node: &lnrpc.LightningNode{
-			LastUpdate: uint32(node.LastUpdate.Unix()),
-			PubKey:     in.PubKey,
-			Addresses:  nodeAddrs,
-			Alias:      node.Alias,
-			Color:      routing.EncodeHexColor(node.Color),
+			LastUpdate:    uint32(node.LastUpdate.Unix()),
+			PubKey:        in.PubKey,
+			Addresses:     nodeAddrs,
+			Alias:         node.Alias,
+			Color:         routing.EncodeHexColor(node.Color),
+			CustomRecords: customRecords,
 		},
 		NumChannels:   numChannels,
 		TotalCapacity: int64(totalCapacity),
@@ -4324,8 +6486,11 @@ func (r *rpcServer) SubscribeChannelGraph(req *lnrpc.GraphTopologySubscription,
 	updateStream lnrpc.Lightning_SubscribeChannelGraphServer) error {
 
 	// First, we start by subscribing to a new intent to receive
-	// notifications from the channel router.
-	client, err := r.server.chanRouter.SubscribeTopology()
+	// notifications from the channel router, replaying the updates the
+	// client missed since its resume point if it specified one.
+	client, err := r.server.chanRouter.SubscribeTopologyFrom(
+		req.ResumeFrom,
+	)
 	if err != nil {
 		return err
 	}
@@ -4436,6 +6601,7 @@ func marshallTopologyChange(topChange *routing.TopologyChange) *lnrpc.GraphTopol
 		NodeUpdates:    nodeUpdates,
 		ChannelUpdates: channelUpdates,
 		ClosedChans:    closedChans,
+		Seq:            topChange.Seq,
 	}
 }
 
@@ -4459,12 +6625,19 @@ func (r *rpcServer) ListPayments(ctx context.Context,
 			continue
 		}
 
-		// If a payment attempt has been made we can fetch the route.
-		// Otherwise we'll just populate the RPC response with an empty
-		// one.
+		// If a reference was requested, we only return the payments
+		// linked to it.
+		if req.Reference != "" &&
+			payment.Info.Reference != req.Reference {
+			continue
+		}
+
+		// If a payment attempt has been made we can fetch the route
+		// of the last one. Otherwise we'll just populate the RPC
+		// response with an empty one.
 		var route route.Route
-		if payment.Attempt != nil {
-			route = payment.Attempt.Route
+		if htlc := payment.LastHTLC(); htlc != nil {
+			route = htlc.Route
 		}
 		path := make([]string, len(route.Hops))
 		for i, hop := range route.Hops {
@@ -4480,25 +6653,40 @@ func (r *rpcServer) ListPayments(ctx context.Context,
 		mAtomsValue := int64(payment.Info.Value)
 		atomsValue := int64(payment.Info.Value.ToAtoms())
 
+		// Only the attempts that settled paid fees.
+		fees := payment.SettledFees()
+
 		status, err := convertPaymentStatus(payment.Status)
 		if err != nil {
 			return nil, err
 		}
 
+		failureReason, err := convertPaymentFailureReason(
+			payment.Failure,
+		)
+		if err != nil {
+			return nil, err
+		}
+
 		paymentHash := payment.Info.PaymentHash
 		paymentsResp.Payments = append(paymentsResp.Payments, &lnrpc.Payment{
-			PaymentHash:     hex.EncodeToString(paymentHash[:]),
-			Value:           atomsValue,
-			ValueMAtoms:     mAtomsValue,
-			ValueAtoms:      atomsValue,
-			CreationDate:    payment.Info.CreationDate.Unix(),
-			Path:            path,
-			Fee:             int64(route.TotalFees().ToAtoms()),
-			FeeAtoms:        int64(route.TotalFees().ToAtoms()),
-			FeeMAtoms:       int64(route.TotalFees()),
-			PaymentPreimage: hex.EncodeToString(preimage[:]),
-			PaymentRequest:  string(payment.Info.PaymentRequest),
-			Status:          status,
+			PaymentHash:       hex.EncodeToString(paymentHash[:]),
+			Value:             atomsValue,
+			ValueMAtoms:       mAtomsValue,
+			ValueAtoms:        atomsValue,
+			CreationDate:      payment.Info.CreationDate.Unix(),
+			Path:              path,
+			Fee:               int64(fees.ToAtoms()),
+			FeeAtoms:          int64(fees.ToAtoms()),
+			FeeMAtoms:         int64(fees),
+			PaymentPreimage:   hex.EncodeToString(preimage[:]),
+			PaymentRequest:    string(payment.Info.PaymentRequest),
+			Status:            status,
+			FailureReason:     failureReason,
+			FeeLimitMAtoms:    int64(payment.Info.FeeLimit),
+			FeeSlippageMAtoms: payment.SettledFeeSlippage(),
+			Label:             payment.Info.Label,
+			Reference:         payment.Info.Reference,
 		})
 	}
 
@@ -4528,6 +6716,43 @@ func convertPaymentStatus(dbStatus channeldb.PaymentStatus) (
 	}
 }
 
+// convertPaymentFailureReason converts the failure reason of a payment, which
+// is only set for failed payments, to the type expected by the RPC.
+func convertPaymentFailureReason(reason *channeldb.FailureReason) (
+	lnrpc.PaymentFailureReason, error) {
+
+	if reason == nil {
+		return lnrpc.PaymentFailureReason_FAILURE_REASON_NONE, nil
+	}
+
+	switch *reason {
+	case channeldb.FailureReasonTimeout:
+		return lnrpc.PaymentFailureReason_FAILURE_REASON_TIMEOUT, nil
+
+	case channeldb.FailureReasonNoRoute:
+		return lnrpc.PaymentFailureReason_FAILURE_REASON_NO_ROUTE, nil
+
+	case channeldb.FailureReasonError:
+		return lnrpc.PaymentFailureReason_FAILURE_REASON_ERROR, nil
+
+	case channeldb.FailureReasonIncorrectPaymentDetails:
+		return lnrpc.PaymentFailureReason_FAILURE_REASON_INCORRECT_PAYMENT_DETAILS, nil
+
+	case channeldb.FailureReasonCanceled:
+		return lnrpc.PaymentFailureReason_FAILURE_REASON_CANCELED, nil
+
+	case channeldb.FailureReasonBudgetExhausted:
+		return lnrpc.PaymentFailureReason_FAILURE_REASON_BUDGET_EXHAUSTED, nil
+
+	case channeldb.FailureReasonInsufficientBalance:
+		return lnrpc.PaymentFailureReason_FAILURE_REASON_INSUFFICIENT_BALANCE, nil
+
+	default:
+		return 0, fmt.Errorf("unhandled payment failure reason %v",
+			*reason)
+	}
+}
+
 // DeleteAllPayments deletes all outgoing payments from DB.
 func (r *rpcServer) DeleteAllPayments(ctx context.Context,
 	_ *lnrpc.DeleteAllPaymentsRequest) (*lnrpc.DeleteAllPaymentsResponse, error) {
@@ -4611,6 +6836,11 @@ func (r *rpcServer) DecodePayReq(ctx context.Context,
 		amt = int64(payReq.MilliAt.ToAtoms())
 	}
 
+	var paymentAddr []byte
+	if payReq.PaymentAddr != nil {
+		paymentAddr = payReq.PaymentAddr[:]
+	}
+
 	dest := payReq.Destination.SerializeCompressed()
 	return &lnrpc.PayReq{
 		Destination:     hex.EncodeToString(dest),
@@ -4623,6 +6853,9 @@ func (r *rpcServer) DecodePayReq(ctx context.Context,
 		Expiry:          expiry,
 		CltvExpiry:      int64(payReq.MinFinalCLTVExpiry()),
 		RouteHints:      routeHints,
+		PaymentAddr:     paymentAddr,
+		Features:        invoicesrpc.CreateRPCFeatures(payReq.Features),
+		PaymentMetadata: payReq.Metadata,
 	}, nil
 }
 
@@ -4665,12 +6898,21 @@ func (r *rpcServer) FeeReport(ctx context.Context,
 		feeRateFixedPoint := edgePolicy.FeeProportionalMillionths
 		feeRate := float64(feeRateFixedPoint) / float64(feeBase)
 
+		inboundFee, err := lnwire.ParseInboundFee(
+			edgePolicy.ExtraOpaqueData,
+		)
+		if err != nil {
+			return err
+		}
+
 		// TODO(roasbeef): also add stats for revenue for each channel
 		feeReports = append(feeReports, &lnrpc.ChannelFeeReport{
-			ChanPoint:     chanInfo.ChannelPoint.String(),
-			BaseFeeMAtoms: int64(edgePolicy.FeeBaseMAtoms),
-			FeePerMil:     int64(feeRateFixedPoint),
-			FeeRate:       feeRate,
+			ChanPoint:            chanInfo.ChannelPoint.String(),
+			BaseFeeMAtoms:        int64(edgePolicy.FeeBaseMAtoms),
+			FeePerMil:            int64(feeRateFixedPoint),
+			FeeRate:              feeRate,
+			InboundBaseFeeMAtoms: inboundFee.BaseFee,
+			InboundFeePerMil:     inboundFee.FeeRate,
 		})
 
 		return nil
@@ -4770,6 +7012,392 @@ func (r *rpcServer) FeeReport(ctx context.Context,
 	}, nil
 }
 
+// FeeManagerReport returns the state of the fee manager along with its most
+// recent fee rate adjustments. The adjustments persisted while the fee manager
+// was active are returned even if it's no longer active.
+func (r *rpcServer) FeeManagerReport(ctx context.Context,
+	req *lnrpc.FeeManagerReportRequest) (*lnrpc.FeeManagerReportResponse,
+	error) {
+
+	decisions, err := r.server.chanDB.FetchFeeDecisions(req.MaxDecisions)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &lnrpc.FeeManagerReportResponse{
+		Decisions: make([]*lnrpc.FeeDecision, 0, len(decisions)),
+	}
+	if feeManager := r.server.feeManager; feeManager != nil {
+		resp.Active = true
+		resp.Strategy = feeManager.StrategyName()
+		resp.Interval = uint64(feeManager.Interval().Seconds())
+		resp.LastRun = feeManager.LastRun().Unix()
+	}
+
+	for _, decision := range decisions {
+		resp.Decisions = append(resp.Decisions, &lnrpc.FeeDecision{
+			Timestamp:       decision.Timestamp.Unix(),
+			ChanPoint:       decision.ChanPoint.String(),
+			OldFeePerMil:    decision.OldFeeRate,
+			NewFeePerMil:    decision.NewFeeRate,
+			Capacity:        int64(decision.Capacity),
+			LocalBalance:    int64(decision.LocalBalance),
+			ForwardedMAtoms: uint64(decision.Forwarded),
+		})
+	}
+
+	return resp, nil
+}
+
+// EffectiveFeeRates returns the confirmation target configured for each of the
+// subsystems paying on-chain fees, along with the fee rate it currently
+// resolves to.
+func (r *rpcServer) EffectiveFeeRates(ctx context.Context,
+	req *lnrpc.EffectiveFeeRatesRequest) (*lnrpc.EffectiveFeeRatesResponse,
+	error) {
+
+	rates, err := r.server.feeService.EffectiveFeeRates()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &lnrpc.EffectiveFeeRatesResponse{
+		FeeRates: make([]*lnrpc.EffectiveFeeRate, 0, len(rates)),
+	}
+	for _, rate := range rates {
+		resp.FeeRates = append(resp.FeeRates, &lnrpc.EffectiveFeeRate{
+			Subsystem:  rate.Subsystem.String(),
+			ConfTarget: rate.ConfTarget,
+			FeePerKb:   int64(rate.FeePerKB),
+		})
+	}
+
+	return resp, nil
+}
+
+// GetDebugInfo returns a snapshot of the state of the node meant to be
+// attached to bug reports: the active configuration with its sensitive options
+// redacted, runtime stats, the size of the database buckets and the number of
+// operations pending in the main subsystems.
+func (r *rpcServer) GetDebugInfo(ctx context.Context,
+	req *lnrpc.GetDebugInfoRequest) (*lnrpc.GetDebugInfoResponse, error) {
+
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+
+	bucketStats, err := r.server.chanDB.BucketStats()
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch db bucket stats: %v",
+			err)
+	}
+
+	pendingOpen, err := r.server.chanDB.FetchPendingChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	waitingClose, err := r.server.chanDB.FetchWaitingCloseChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	inFlight, err := r.server.controlTower.FetchInFlightPayments()
+	if err != nil {
+		return nil, err
+	}
+
+	pendingSweeps, err := r.server.sweeper.PendingInputs()
+	if err != nil {
+		return nil, err
+	}
+
+	numPending, numOpen := r.server.htlcSwitch.NumCircuits()
+
+	dbBuckets := make([]*lnrpc.DebugBucketStats, 0, len(bucketStats))
+	for _, stats := range bucketStats {
+		dbBuckets = append(dbBuckets, &lnrpc.DebugBucketStats{
+			Name:  stats.Name,
+			Keys:  uint64(stats.Keys),
+			Bytes: uint64(stats.Bytes),
+		})
+	}
+
+	return &lnrpc.GetDebugInfoResponse{
+		Config:                  configDump(cfg),
+		NumGoroutines:           uint32(runtime.NumGoroutine()),
+		HeapAllocBytes:          memStats.HeapAlloc,
+		DbBuckets:               dbBuckets,
+		NumPeers:                uint32(len(r.server.Peers())),
+		NumPendingOpenChannels:  uint32(len(pendingOpen)),
+		NumWaitingCloseChannels: uint32(len(waitingClose)),
+		NumInflightPayments:     uint32(len(inFlight)),
+		NumPendingCircuits:      uint32(numPending),
+		NumOpenCircuits:         uint32(numOpen),
+		NumPendingSweeps:        uint32(len(pendingSweeps)),
+	}, nil
+}
+
+// CheckMacaroonPermissions checks whether the given macaroon grants the
+// required permissions, and returns its caveats. An error is returned if the
+// macaroon is invalid or doesn't grant the permissions.
+func (r *rpcServer) CheckMacaroonPermissions(ctx context.Context,
+	req *lnrpc.CheckMacaroonPermissionsRequest) (
+	*lnrpc.CheckMacaroonPermissionsResponse, error) {
+
+	rpcsLog.Debugf("[checkmacaroonpermissions] full_method=%v",
+		req.FullMethod)
+
+	if r.macService == nil {
+		return nil, errMacaroonsDisabled
+	}
+
+	mac := &macaroon.Macaroon{}
+	if err := mac.UnmarshalBinary(req.Macaroon); err != nil {
+		return nil, fmt.Errorf("unable to decode macaroon: %v", err)
+	}
+
+	// The required permissions are the ones explicitly listed, along with
+	// those of the method, if any.
+	var permissions []bakery.Op
+	for _, perm := range req.Permissions {
+		op := bakery.Op{
+			Entity: perm.Entity,
+			Action: perm.Action,
+		}
+		if err := macaroons.ValidateOp(op); err != nil {
+			return nil, err
+		}
+
+		permissions = append(permissions, op)
+	}
+	if req.FullMethod != "" {
+		ops, ok := r.permissions.Permissions(req.FullMethod)
+		if !ok {
+			return nil, fmt.Errorf("%s: unknown permissions "+
+				"required for method", req.FullMethod)
+		}
+
+		permissions = append(permissions, ops...)
+	}
+	if len(permissions) == 0 {
+		return nil, fmt.Errorf("no permission to check, specify at " +
+			"least one permission or a method")
+	}
+
+	err := r.macService.CheckMacAuth(ctx, mac, permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	caveats := make([]string, 0, len(mac.Caveats()))
+	for _, caveat := range mac.Caveats() {
+		caveats = append(caveats, string(caveat.Id))
+	}
+
+	return &lnrpc.CheckMacaroonPermissionsResponse{
+		Valid:   true,
+		Caveats: caveats,
+	}, nil
+}
+
+// WarpClock fast-forwards the clock of the daemon by the given duration,
+// firing the timers that become due. It is only available on regtest and
+// simnet.
+func (r *rpcServer) WarpClock(ctx context.Context,
+	req *lnrpc.WarpClockRequest) (*lnrpc.WarpClockResponse, error) {
+
+	rpcsLog.Debugf("[warpclock] seconds=%v", req.Seconds)
+
+	if r.server.warpClock == nil {
+		return nil, fmt.Errorf("the clock can only be warped on " +
+			"regtest and simnet")
+	}
+
+	maxSeconds := uint64(math.MaxInt64 / int64(time.Second))
+	if req.Seconds == 0 || req.Seconds > maxSeconds {
+		return nil, fmt.Errorf("the number of seconds to warp the "+
+			"clock by must be between 1 and %d", maxSeconds)
+	}
+
+	warp := time.Duration(req.Seconds) * time.Second
+	if err := r.server.warpClock.Warp(warp); err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Infof("Warped clock by %v, now at %v", warp,
+		r.server.warpClock.Now())
+
+	return &lnrpc.WarpClockResponse{
+		Now: r.server.warpClock.Now().Unix(),
+		TotalOffsetSeconds: uint64(
+			r.server.warpClock.Offset() / time.Second,
+		),
+	}, nil
+}
+
+// ExportPaymentReceipt returns a receipt for a settled payment, signed with the
+// node key. The receipt summarizes the htlcs the payment settled through.
+func (r *rpcServer) ExportPaymentReceipt(ctx context.Context,
+	req *lnrpc.ExportPaymentReceiptRequest) (*lnrpc.PaymentReceipt, error) {
+
+	paymentHash, err := lntypes.MakeHash(req.PaymentHash)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Debugf("[exportpaymentreceipt] payment_hash=%v", paymentHash)
+
+	payment, err := channeldb.NewPaymentControl(
+		r.server.chanDB,
+	).FetchPayment(paymentHash)
+	if err != nil {
+		return nil, err
+	}
+	if payment.Status != channeldb.StatusSucceeded ||
+		payment.PaymentPreimage == nil {
+
+		return nil, fmt.Errorf("payment %v isn't settled", paymentHash)
+	}
+
+	rcpt := &receipt.Receipt{
+		PaymentHash:    paymentHash,
+		Preimage:       *payment.PaymentPreimage,
+		Payer:          route.NewVertex(r.server.identityPriv.PubKey()),
+		PaymentRequest: string(payment.Info.PaymentRequest),
+	}
+	for _, htlc := range payment.HTLCs {
+		if htlc.Settle == nil || len(htlc.Route.Hops) == 0 {
+			continue
+		}
+
+		finalHop := htlc.Route.Hops[len(htlc.Route.Hops)-1]
+		rcpt.Amount += finalHop.AmtToForward
+		rcpt.Fee += htlc.Route.TotalFees()
+		rcpt.Destination = finalHop.PubKeyBytes
+		rcpt.NumHTLCs++
+
+		if htlc.Settle.SettleTime.After(rcpt.SettleTime) {
+			rcpt.SettleTime = htlc.Settle.SettleTime
+		}
+	}
+
+	// Payments made before the htlcs were recorded with their outcome
+	// can't be summarized.
+	if rcpt.NumHTLCs == 0 {
+		return nil, fmt.Errorf("no settled htlc recorded for payment %v",
+			paymentHash)
+	}
+
+	if err := rcpt.Sign(r.server.nodeSigner); err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := rcpt.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.PaymentReceipt{Receipt: b.Bytes()}, nil
+}
+
+// VerifyPaymentReceipt checks that a payment receipt is signed by its payer and
+// that its content is consistent, and returns its content. Receipts that can't
+// be decoded result in an error, while those that fail verification are
+// reported as invalid along with their content.
+func (r *rpcServer) VerifyPaymentReceipt(ctx context.Context,
+	req *lnrpc.VerifyPaymentReceiptRequest) (
+	*lnrpc.VerifyPaymentReceiptResponse, error) {
+
+	rcpt, err := receipt.Decode(bytes.NewReader(req.Receipt))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode receipt: %v", err)
+	}
+
+	resp := &lnrpc.VerifyPaymentReceiptResponse{
+		Valid:          true,
+		Payer:          hex.EncodeToString(rcpt.Payer[:]),
+		PaymentHash:    rcpt.PaymentHash[:],
+		Preimage:       rcpt.Preimage[:],
+		AmtMAtoms:      int64(rcpt.Amount),
+		FeeMAtoms:      int64(rcpt.Fee),
+		Destination:    hex.EncodeToString(rcpt.Destination[:]),
+		NumHtlcs:       rcpt.NumHTLCs,
+		SettleTime:     rcpt.SettleTime.Unix(),
+		PaymentRequest: rcpt.PaymentRequest,
+	}
+	if err := rcpt.Verify(activeNetParams.Params); err != nil {
+		resp.Valid = false
+		resp.InvalidReason = err.Error()
+	}
+
+	return resp, nil
+}
+
+// ChannelRecoveryStatus returns the progress of the recovery of the channels
+// restored from static channel backups, along with the status of the recovery
+// peers.
+func (r *rpcServer) ChannelRecoveryStatus(ctx context.Context,
+	req *lnrpc.ChannelRecoveryStatusRequest) (
+	*lnrpc.ChannelRecoveryStatusResponse, error) {
+
+	channels, err := r.server.chanRecovery.channels()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &lnrpc.ChannelRecoveryStatusResponse{}
+	for _, channel := range channels {
+		var stage lnrpc.ChannelRecoveryStage
+		switch channel.stage {
+		case recoveryWaitingForPeer:
+			stage = lnrpc.ChannelRecoveryStage_WAITING_FOR_PEER
+		case recoveryDataLossProtect:
+			stage = lnrpc.ChannelRecoveryStage_DATA_LOSS_PROTECT
+		case recoveryWaitingForForceClose:
+			stage = lnrpc.ChannelRecoveryStage_WAITING_FOR_FORCE_CLOSE
+		case recoverySweeping:
+			stage = lnrpc.ChannelRecoveryStage_SWEEPING
+		case recoveryRecovered:
+			stage = lnrpc.ChannelRecoveryStage_RECOVERED
+		default:
+			return nil, fmt.Errorf("unknown recovery stage: %v",
+				channel.stage)
+		}
+
+		rpcChannel := &lnrpc.RecoveringChannel{
+			ChannelPoint: channel.chanPoint,
+			RemoteNodePub: hex.EncodeToString(
+				channel.remotePub.SerializeCompressed(),
+			),
+			Stage: stage,
+		}
+		if summary := channel.closeSummary; summary != nil {
+			rpcChannel.Capacity = int64(summary.Capacity)
+			rpcChannel.ClosingTxHash = summary.ClosingTXID.String()
+			rpcChannel.SettledBalance = int64(summary.SettledBalance)
+		}
+		resp.Channels = append(resp.Channels, rpcChannel)
+	}
+
+	for _, peer := range r.server.chanRecovery.peerStatuses() {
+		rpcPeer := &lnrpc.RecoveryPeer{
+			PubKey: hex.EncodeToString(
+				peer.addr.IdentityKey.SerializeCompressed(),
+			),
+			Address:   peer.addr.Address.String(),
+			Connected: peer.connected,
+		}
+		for _, cid := range peer.forceCloseRequests {
+			rpcPeer.ForceCloseRequests = append(
+				rpcPeer.ForceCloseRequests, cid.String(),
+			)
+		}
+		resp.Peers = append(resp.Peers, rpcPeer)
+	}
+
+	return resp, nil
+}
+
 // minFeeRate is the smallest permitted fee rate within the network. This is
 // derived by the fact that fee rates are computed using a fixed point of
 // 1,000,000. As a result, the smallest representable fee rate is 1e-6, or
@@ -4816,6 +7444,14 @@ func (r *rpcServer) UpdateChannelPolicy(ctx context.Context,
 		return nil, fmt.Errorf("time lock delta of %v is too small, "+
 			"minimum supported is %v", req.TimeLockDelta,
 			minTimeLockDelta)
+
+	// Only inbound fee discounts are supported, as the senders that don't
+	// know about inbound fees would fail to pay any extra fee.
+	case req.InboundFee != nil && (req.InboundFee.BaseFeeMAtoms > 0 ||
+		req.InboundFee.FeeRatePpm > 0):
+
+		return nil, fmt.Errorf("inbound fees must be zero or " +
+			"negative discounts")
 	}
 
 	// We'll also need to convert the floating point fee rate we accept
@@ -4836,6 +7472,18 @@ func (r *rpcServer) UpdateChannelPolicy(ctx context.Context,
 		MaxHTLC:       lnwire.MilliAtom(req.MaxHtlcMAtoms),
 	}
 
+	if req.MinHtlcMAtomsSpecified {
+		minHTLC := lnwire.MilliAtom(req.MinHtlcMAtoms)
+		chanPolicy.MinHTLC = &minHTLC
+	}
+
+	if req.InboundFee != nil {
+		chanPolicy.InboundFee = &lnwire.InboundFee{
+			BaseFee: req.InboundFee.BaseFeeMAtoms,
+			FeeRate: req.InboundFee.FeeRatePpm,
+		}
+	}
+
 	rpcsLog.Debugf("[updatechanpolicy] updating channel policy base_fee=%v, "+
 		"rate_float=%v, rate_fixed=%v, time_lock_delta: %v, targets=%v",
 		req.BaseFeeMAtoms, req.FeeRate, feeRateFixed, req.TimeLockDelta,
@@ -5040,22 +7688,132 @@ func (r *rpcServer) VerifyChanBackup(ctx context.Context,
 				"backup: %v", err)
 		}
 
-	case in.GetMultiChanBackup() != nil:
-		// We'll convert the raw byte slice into a PackedMulti that we
-		// can easily work with.
-		packedMultiBackup := in.GetMultiChanBackup().MultiChanBackup
-		packedMulti := chanbackup.PackedMulti(packedMultiBackup)
+	case in.GetMultiChanBackup() != nil:
+		// We'll convert the raw byte slice into a PackedMulti that we
+		// can easily work with.
+		packedMultiBackup := in.GetMultiChanBackup().MultiChanBackup
+		packedMulti := chanbackup.PackedMulti(packedMultiBackup)
+
+		// We'll now attempt to unpack the Multi. If this fails, then we
+		// know it's invalid.
+		_, err := packedMulti.Unpack(r.server.cc.keyRing)
+		if err != nil {
+			return nil, fmt.Errorf("invalid multi channel backup: "+
+				"%v", err)
+		}
+	}
+
+	return &lnrpc.VerifyChanBackupResponse{}, nil
+}
+
+// InspectChanBackup decrypts either a set of packed Singles or a packed Multi
+// and returns the contents of each channel backup, without restoring any of
+// the channels. Specifying both will result in an error.
+func (r *rpcServer) InspectChanBackup(ctx context.Context,
+	in *lnrpc.ChanBackupSnapshot) (*lnrpc.InspectChanBackupResponse, error) {
+
+	var backups []chanbackup.Single
+	switch {
+	// If neither a Single or Multi has been specified, then we have nothing
+	// to inspect.
+	case in.GetSingleChanBackups() == nil && in.GetMultiChanBackup() == nil:
+		return nil, errors.New("either a Single or Multi channel " +
+			"backup must be specified")
+
+	// Either a Single or a Multi must be specified, but not both.
+	case in.GetSingleChanBackups() != nil && in.GetMultiChanBackup() != nil:
+		return nil, errors.New("either a Single or Multi channel " +
+			"backup must be specified, but not both")
+
+	case in.GetSingleChanBackups() != nil:
+		chanBackupsProtos := in.GetSingleChanBackups().ChanBackups
+		packedBackups := make([][]byte, 0, len(chanBackupsProtos))
+		for _, chanBackup := range chanBackupsProtos {
+			packedBackups = append(
+				packedBackups, chanBackup.ChanBackup,
+			)
+		}
+
+		var err error
+		backups, err = chanbackup.PackedSingles(packedBackups).Unpack(
+			r.server.cc.keyRing,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid single channel "+
+				"backup: %v", err)
+		}
+
+	case in.GetMultiChanBackup() != nil:
+		packedMulti := chanbackup.PackedMulti(
+			in.GetMultiChanBackup().MultiChanBackup,
+		)
+		multi, err := packedMulti.Unpack(r.server.cc.keyRing)
+		if err != nil {
+			return nil, fmt.Errorf("invalid multi channel backup: "+
+				"%v", err)
+		}
+		backups = multi.StaticBackups
+	}
+
+	resp := &lnrpc.InspectChanBackupResponse{
+		Channels: make([]*lnrpc.ChannelBackupInfo, 0, len(backups)),
+	}
+	for _, backup := range backups {
+		resp.Channels = append(
+			resp.Channels, marshallChannelBackupInfo(backup),
+		)
+	}
+
+	return resp, nil
+}
+
+// marshallChannelBackupInfo converts the contents of a static channel backup
+// into its RPC representation.
+func marshallChannelBackupInfo(backup chanbackup.Single) *lnrpc.ChannelBackupInfo {
+	txid := backup.FundingOutpoint.Hash
+	addrs := make([]string, 0, len(backup.Addresses))
+	for _, addr := range backup.Addresses {
+		addrs = append(addrs, addr.String())
+	}
+
+	return &lnrpc.ChannelBackupInfo{
+		ChanPoint: &lnrpc.ChannelPoint{
+			FundingTxid: &lnrpc.ChannelPoint_FundingTxidBytes{
+				FundingTxidBytes: txid[:],
+			},
+			OutputIndex: backup.FundingOutpoint.Index,
+		},
+		ChanId: backup.ShortChannelID.ToUint64(),
+		RemoteNodePub: hex.EncodeToString(
+			backup.RemoteNodePub.SerializeCompressed(),
+		),
+		Addresses: addrs,
+		Capacity:  int64(backup.Capacity),
+		Initiator: backup.IsInitiator,
+		Version:   uint32(backup.Version),
+		ChainHash: backup.ChainHash.String(),
+	}
+}
+
+// marshallRecoveryChecks converts the results of a dry run recovery into their
+// RPC representation.
+func marshallRecoveryChecks(
+	checks []chanbackup.RecoveryCheck) []*lnrpc.ChannelRestoreCheck {
 
-		// We'll now attempt to unpack the Multi. If this fails, then we
-		// know it's invalid.
-		_, err := packedMulti.Unpack(r.server.cc.keyRing)
-		if err != nil {
-			return nil, fmt.Errorf("invalid multi channel backup: "+
-				"%v", err)
+	rpcChecks := make([]*lnrpc.ChannelRestoreCheck, 0, len(checks))
+	for _, check := range checks {
+		rpcCheck := &lnrpc.ChannelRestoreCheck{
+			Channel:       marshallChannelBackupInfo(check.Backup),
+			PeerReachable: check.PeerErr == nil,
+		}
+		if check.PeerErr != nil {
+			rpcCheck.PeerError = check.PeerErr.Error()
 		}
+
+		rpcChecks = append(rpcChecks, rpcCheck)
 	}
 
-	return &lnrpc.VerifyChanBackupResponse{}, nil
+	return rpcChecks
 }
 
 // createBackupSnapshot converts the passed Single backup into a snapshot which
@@ -5179,6 +7937,24 @@ func (r *rpcServer) RestoreChannelBackups(ctx context.Context,
 			)
 		}
 
+		// If this is a dry run, we'll only check whether the backups
+		// can be decrypted and whether our prior channel peers can be
+		// reached, without restoring anything.
+		if in.DryRun {
+			checks, err := chanbackup.UnpackAndDryRunSingles(
+				chanbackup.PackedSingles(packedBackups),
+				r.server.cc.keyRing, r.server,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to unpack single "+
+					"backups: %v", err)
+			}
+
+			return &lnrpc.RestoreBackupResponse{
+				Channels: marshallRecoveryChecks(checks),
+			}, nil
+		}
+
 		// With our backups obtained, we'll now restore them which will
 		// write the new backups to disk, and then attempt to connect
 		// out to any peers that we know of which were our prior
@@ -5195,11 +7971,29 @@ func (r *rpcServer) RestoreChannelBackups(ctx context.Context,
 	case in.GetMultiChanBackup() != nil:
 		packedMultiBackup := in.GetMultiChanBackup()
 
+		packedMulti := chanbackup.PackedMulti(packedMultiBackup)
+
+		// If this is a dry run, we'll only check whether the backup can
+		// be decrypted and whether our prior channel peers can be
+		// reached, without restoring anything.
+		if in.DryRun {
+			checks, err := chanbackup.UnpackAndDryRunMulti(
+				packedMulti, r.server.cc.keyRing, r.server,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to unpack chan "+
+					"backup: %v", err)
+			}
+
+			return &lnrpc.RestoreBackupResponse{
+				Channels: marshallRecoveryChecks(checks),
+			}, nil
+		}
+
 		// With our backups obtained, we'll now restore them which will
 		// write the new backups to disk, and then attempt to connect
 		// out to any peers that we know of which were our prior
 		// channel peers.
-		packedMulti := chanbackup.PackedMulti(packedMultiBackup)
 		err := chanbackup.UnpackAndRecoverMulti(
 			packedMulti, r.server.cc.keyRing, chanRestorer,
 			r.server,
@@ -5241,11 +8035,14 @@ func (r *rpcServer) SubscribeChannelBackups(req *lnrpc.ChannelBackupSubscription
 			switch e.(type) {
 
 			// We only care about new/closed channels, so we'll
-			// skip any events for active/inactive channels.
+			// skip any events for active/inactive channels and
+			// remote commitments yet to be resolved.
 			case channelnotifier.ActiveChannelEvent:
 				continue
 			case channelnotifier.InactiveChannelEvent:
 				continue
+			case channelnotifier.RemoteCommitmentEvent:
+				continue
 			}
 
 			// Now that we know the channel state has changed,
@@ -5278,6 +8075,61 @@ func (r *rpcServer) SubscribeChannelBackups(req *lnrpc.ChannelBackupSubscription
 	}
 }
 
+// CreateDatabaseSnapshot writes a hot backup of the channel database, the
+// watchtower client database and the sphinx replay log to a new directory
+// within the data directory. Restoring the channel database without the other
+// two could either let HTLCs be replayed to us or leave gaps in the states
+// backed up to our towers, so they are copied within read transactions
+// started after the one of the channel database, making them at least as
+// recent as it.
+func (r *rpcServer) CreateDatabaseSnapshot(ctx context.Context,
+	in *lnrpc.DatabaseSnapshotRequest) (*lnrpc.DatabaseSnapshotResponse,
+	error) {
+
+	sources := []dbSnapshotSource{{
+		name: "channel.db",
+		begin: func() (*bolt.Tx, error) {
+			return r.server.chanDB.Begin(false)
+		},
+	}}
+	if r.server.towerClientDB != nil {
+		sources = append(sources, dbSnapshotSource{
+			name:  "wtclient.db",
+			begin: r.server.towerClientDB.BeginSnapshot,
+		})
+	}
+	sources = append(sources, dbSnapshotSource{
+		name:  "sphinxreplay.db",
+		begin: r.server.replayLog.BeginSnapshot,
+	})
+
+	dir := filepath.Join(
+		cfg.DataDir, dbSnapshotDirname,
+		normalizeNetwork(activeNetParams.Name),
+	)
+	snapshotDir, files, err := snapshotDatabases(dir, sources, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("unable to snapshot databases: %v", err)
+	}
+
+	rpcsLog.Infof("Wrote snapshot of %v databases to %v", len(files),
+		snapshotDir)
+
+	resp := &lnrpc.DatabaseSnapshotResponse{
+		SnapshotDir: snapshotDir,
+		Files:       make([]*lnrpc.DatabaseSnapshotFile, 0, len(files)),
+	}
+	for _, file := range files {
+		resp.Files = append(resp.Files, &lnrpc.DatabaseSnapshotFile{
+			Name: file.name,
+			Size: file.size,
+			TxId: uint64(file.txID),
+		})
+	}
+
+	return resp, nil
+}
+
 // chanAcceptInfo is used in the ChannelAcceptor bidirectional stream and
 // encapsulates the request information sent from the RPCAcceptor to the
 // RPCServer.
@@ -5286,11 +8138,26 @@ type chanAcceptInfo struct {
 	responseChan chan bool
 }
 
+// acceptorTimeoutFallback returns whether a channel open request that wasn't
+// answered by the RPC client within the acceptor timeout is accepted,
+// according to the configured fallback policy.
+func acceptorTimeoutFallback(req *chanacceptor.ChannelAcceptRequest) bool {
+	accept := cfg.AcceptorAcceptOnTimeout
+
+	rpcsLog.Warnf("RPCAcceptor reached timeout of %v for pending "+
+		"channel %x, accept=%v", cfg.AcceptorTimeout,
+		req.OpenChanMsg.PendingChannelID, accept)
+
+	return accept
+}
+
 // ChannelAcceptor dispatches a bi-directional streaming RPC in which
 // OpenChannel requests are sent to the client and the client responds with
 // a boolean that tells LND whether or not to accept the channel. This allows
 // node operators to specify their own criteria for accepting inbound channels
-// through a single persistent connection.
+// through a single persistent connection. Requests the client doesn't respond
+// to within the acceptor timeout are accepted or rejected according to the
+// configured fallback policy.
 func (r *rpcServer) ChannelAcceptor(stream lnrpc.Lightning_ChannelAcceptorServer) error {
 	chainedAcceptor := r.chanPredicate
 
@@ -5314,15 +8181,13 @@ func (r *rpcServer) ChannelAcceptor(stream lnrpc.Lightning_ChannelAcceptorServer
 		}
 
 		// timeout is the time after which ChannelAcceptRequests expire.
-		timeout := time.After(defaultAcceptorTimeout)
+		timeout := time.After(cfg.AcceptorTimeout)
 
 		// Send the request to the newRequests channel.
 		select {
 		case newRequests <- newRequest:
 		case <-timeout:
-			rpcsLog.Errorf("RPCAcceptor returned false - reached timeout of %d",
-				defaultAcceptorTimeout)
-			return false
+			return acceptorTimeoutFallback(req)
 		case <-quit:
 			return false
 		case <-r.quit:
@@ -5330,14 +8195,12 @@ func (r *rpcServer) ChannelAcceptor(stream lnrpc.Lightning_ChannelAcceptorServer
 		}
 
 		// Receive the response and return it. If no response has been received
-		// in defaultAcceptorTimeout, then return false.
+		// within the acceptor timeout, then apply the fallback policy.
 		select {
 		case resp := <-respChan:
 			return resp
 		case <-timeout:
-			rpcsLog.Errorf("RPCAcceptor returned false - reached timeout of %d",
-				defaultAcceptorTimeout)
-			return false
+			return acceptorTimeoutFallback(req)
 		case <-quit:
 			return false
 		case <-r.quit:
@@ -5414,6 +8277,8 @@ func (r *rpcServer) ChannelAcceptor(stream lnrpc.Lightning_ChannelAcceptorServer
 				CsvDelay:         uint32(req.OpenChanMsg.CsvDelay),
 				MaxAcceptedHtlcs: uint32(req.OpenChanMsg.MaxAcceptedHTLCs),
 				ChannelFlags:     uint32(req.OpenChanMsg.ChannelFlags),
+				StaticRemoteKey:  req.Tweakless,
+				Anchors:          req.Anchors,
 			}
 
 			if err := stream.Send(chanAcceptReq); err != nil {
@@ -5442,3 +8307,418 @@ func (r *rpcServer) ChannelAcceptor(stream lnrpc.Lightning_ChannelAcceptorServer
 		}
 	}
 }
+
+// BakeMacaroon allows the creation of a new macaroon with custom read and write
+// permissions. No first-party caveats are added since this can be done
+// offline.
+func (r *rpcServer) BakeMacaroon(ctx context.Context,
+	req *lnrpc.BakeMacaroonRequest) (*lnrpc.BakeMacaroonResponse, error) {
+
+	rpcsLog.Debugf("[bakemacaroon]")
+
+	// If macaroons are disabled, the macaroon service is not initialized
+	// and no macaroons can be baked.
+	if r.macService == nil {
+		return nil, errMacaroonsDisabled
+	}
+
+	helpMsg := fmt.Sprintf("supported actions are %v, supported entities "+
+		"are %v", macaroons.ValidActions, macaroons.ValidEntities)
+
+	// A macaroon without any permission can't access any RPC, so an empty
+	// list of permissions is most likely a mistake.
+	if len(req.Permissions) == 0 {
+		return nil, fmt.Errorf("permission list cannot be empty, "+
+			"specify at least one action/entity pair; %s", helpMsg)
+	}
+
+	// Validate the permissions and map them to the operations used by the
+	// bakery.
+	requestedPermissions := make([]bakery.Op, len(req.Permissions))
+	for idx, op := range req.Permissions {
+		requestedPermissions[idx] = bakery.Op{
+			Entity: op.Entity,
+			Action: op.Action,
+		}
+
+		err := macaroons.ValidateOp(requestedPermissions[idx])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Root key IDs are stored as the decimal representation of the
+	// requested ID, matching the ID of the default root key.
+	rootKeyID := []byte(strconv.FormatUint(req.RootKeyId, 10))
+
+	newMac, err := r.macService.NewMacaroon(
+		ctx, rootKeyID, requestedPermissions...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	newMacBytes, err := newMac.M().MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.BakeMacaroonResponse{
+		Macaroon: hex.EncodeToString(newMacBytes),
+	}, nil
+}
+
+// ListMacaroonIDs returns the IDs of all the root keys in use by macaroons.
+func (r *rpcServer) ListMacaroonIDs(ctx context.Context,
+	req *lnrpc.ListMacaroonIDsRequest) (*lnrpc.ListMacaroonIDsResponse,
+	error) {
+
+	rpcsLog.Debugf("[listmacaroonids]")
+
+	if r.macService == nil {
+		return nil, errMacaroonsDisabled
+	}
+
+	rootKeyIDByteSlice, err := r.macService.ListMacaroonIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var rootKeyIDs []uint64
+	for _, value := range rootKeyIDByteSlice {
+		// Skip the keys that weren't created through the RPC, whose
+		// IDs aren't numeric.
+		id, err := strconv.ParseUint(string(value), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		rootKeyIDs = append(rootKeyIDs, id)
+	}
+
+	return &lnrpc.ListMacaroonIDsResponse{RootKeyIds: rootKeyIDs}, nil
+}
+
+// DeleteMacaroonID deletes the root key of the given ID, which revokes every
+// macaroon baked with it.
+func (r *rpcServer) DeleteMacaroonID(ctx context.Context,
+	req *lnrpc.DeleteMacaroonIDRequest) (*lnrpc.DeleteMacaroonIDResponse,
+	error) {
+
+	rpcsLog.Debugf("[deletemacaroonid]")
+
+	if r.macService == nil {
+		return nil, errMacaroonsDisabled
+	}
+
+	rootKeyID := []byte(strconv.FormatUint(req.RootKeyId, 10))
+	deletedIDBytes, err := r.macService.DeleteMacaroonID(ctx, rootKeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.DeleteMacaroonIDResponse{
+		Deleted: deletedIDBytes != nil,
+	}, nil
+}
+
+// ListPermissions lists all RPC method URIs and the macaroon permissions they
+// require to be invoked.
+func (r *rpcServer) ListPermissions(_ context.Context,
+	_ *lnrpc.ListPermissionsRequest) (*lnrpc.ListPermissionsResponse,
+	error) {
+
+	rpcsLog.Debugf("[listpermissions]")
+
+	permissionMap := make(map[string]*lnrpc.MacaroonPermissionList)
+	for uri, perms := range r.permissions.AllPermissions() {
+		rpcPerms := make([]*lnrpc.MacaroonPermission, len(perms))
+		for idx, perm := range perms {
+			rpcPerms[idx] = &lnrpc.MacaroonPermission{
+				Entity: perm.Entity,
+				Action: perm.Action,
+			}
+		}
+		permissionMap[uri] = &lnrpc.MacaroonPermissionList{
+			Permissions: rpcPerms,
+		}
+	}
+
+	return &lnrpc.ListPermissionsResponse{
+		MethodPermissions: permissionMap,
+	}, nil
+}
+
+// RegisterRPCMiddleware adds a new gRPC middleware enforcing the custom
+// macaroon caveats of the name given in its registration, or only observing
+// the calls in read-only mode. The middleware is removed once the stream is
+// closed.
+func (r *rpcServer) RegisterRPCMiddleware(
+	stream lnrpc.Lightning_RegisterRPCMiddlewareServer) error {
+
+	// Custom caveats are part of macaroons, so there is nothing to enforce
+	// if they are disabled.
+	if r.macService == nil {
+		return errMacaroonsDisabled
+	}
+
+	// The first message of the middleware must be its registration.
+	msg, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	registration := msg.Register
+	switch {
+	case registration == nil:
+		return errors.New("the first message of an RPC middleware " +
+			"must be its registration")
+
+	case registration.MiddlewareName == "":
+		return errors.New("RPC middleware name missing")
+
+	// Only read-only middlewares can observe all calls, regardless of the
+	// custom caveats of their macaroon.
+	case (registration.CustomMacaroonCaveatName == "" &&
+		!registration.ReadOnlyMode) ||
+		strings.Contains(registration.CustomMacaroonCaveatName, " "):
+
+		return fmt.Errorf("invalid custom caveat name %q",
+			registration.CustomMacaroonCaveatName)
+	}
+
+	middleware := newRPCMiddleware(
+		registration.MiddlewareName,
+		registration.CustomMacaroonCaveatName,
+		registration.ReadOnlyMode, cfg.RPCMiddlewareTimeout,
+	)
+	if err := r.middlewareRegistry.register(middleware); err != nil {
+		return err
+	}
+	defer r.middlewareRegistry.unregister(middleware)
+
+	// The custom methods of the middleware are protected by macaroons for
+	// as long as it is registered.
+	customPerms := make(
+		map[string][]bakery.Op, len(registration.CustomPermissions),
+	)
+	customMethods := make([]string, 0, len(registration.CustomPermissions))
+	for method, permList := range registration.CustomPermissions {
+		var ops []bakery.Op
+		for _, perm := range permList.GetPermissions() {
+			ops = append(ops, bakery.Op{
+				Entity: perm.Entity,
+				Action: perm.Action,
+			})
+		}
+
+		customPerms[method] = ops
+		customMethods = append(customMethods, method)
+	}
+	if err := r.permissions.AddPermissions(customPerms); err != nil {
+		return fmt.Errorf("invalid custom permissions: %v", err)
+	}
+	defer r.permissions.RemovePermissions(customMethods...)
+
+	switch {
+	case middleware.readOnly && middleware.caveatName == "":
+		rpcsLog.Infof("Read-only RPC middleware %v registered for all "+
+			"calls", middleware.name)
+
+	case middleware.readOnly:
+		rpcsLog.Infof("Read-only RPC middleware %v registered for "+
+			"custom caveat %v", middleware.name,
+			middleware.caveatName)
+
+	default:
+		rpcsLog.Infof("RPC middleware %v registered for custom "+
+			"caveat %v", middleware.name, middleware.caveatName)
+	}
+	defer rpcsLog.Infof("RPC middleware %v unregistered", middleware.name)
+
+	// The feedback of the middleware is received in a goroutine, as the
+	// call is blocking and would prevent us from forwarding it more
+	// requests.
+	quit := make(chan struct{})
+	defer close(quit)
+
+	errChan := make(chan error, 1)
+	responses := make(chan *lnrpc.RPCMiddlewareResponse)
+	go func() {
+		for {
+			resp, err := stream.Recv()
+			if err != nil {
+				errChan <- err
+				return
+			}
+
+			select {
+			case responses <- resp:
+			case <-quit:
+				return
+			case <-r.quit:
+				return
+			}
+		}
+	}()
+
+	pendingRequests := make(map[uint64]chan *middlewareFeedback)
+	for {
+		select {
+		case req := <-middleware.requests:
+			// The feedback of read-only middlewares is ignored.
+			if req.feedback != nil {
+				pendingRequests[req.request.RequestId] =
+					req.feedback
+			}
+
+			if err := stream.Send(req.request); err != nil {
+				return err
+			}
+
+		case resp := <-responses:
+			feedback, ok := pendingRequests[resp.RequestId]
+			if !ok {
+				continue
+			}
+			delete(pendingRequests, resp.RequestId)
+
+			switch {
+			case resp.Feedback == nil:
+				feedback <- &middlewareFeedback{
+					err: fmt.Errorf("RPC middleware %v "+
+						"sent no feedback",
+						middleware.name),
+				}
+
+			case resp.Feedback.Error != "":
+				feedback <- &middlewareFeedback{
+					err: fmt.Errorf("request rejected by "+
+						"RPC middleware %v: %v",
+						middleware.name,
+						resp.Feedback.Error),
+				}
+
+			default:
+				feedback <- &middlewareFeedback{
+					replace: resp.Feedback.ReplaceMessage,
+					replacement: resp.Feedback.
+						ReplacementSerialized,
+				}
+			}
+
+		case err := <-errChan:
+			return err
+
+		case <-r.quit:
+			return fmt.Errorf("RPC server is shutting down")
+		}
+	}
+}
+
+// SetColdSweep enables or disables the scheduled sweeps of the confirmed
+// balance of the wallet to cold storage.
+func (r *rpcServer) SetColdSweep(ctx context.Context,
+	req *lnrpc.SetColdSweepRequest) (*lnrpc.SetColdSweepResponse, error) {
+
+	if r.server.coldSweeper == nil {
+		return nil, errColdSweepDisabled
+	}
+
+	r.server.coldSweeper.SetEnabled(req.Enable)
+
+	rpcsLog.Infof("[setcoldsweep] enabled=%v", req.Enable)
+
+	return &lnrpc.SetColdSweepResponse{
+		Enabled: r.server.coldSweeper.Enabled(),
+	}, nil
+}
+
+// SubscribeColdSweeps returns a uni-directional stream (server -> client)
+// for notifying the client of the sweeps to cold storage being published or
+// failing, and of the scheduled sweeps being enabled or disabled.
+func (r *rpcServer) SubscribeColdSweeps(req *lnrpc.ColdSweepSubscription,
+	eventStream lnrpc.Lightning_SubscribeColdSweepsServer) error {
+
+	if r.server.coldSweeper == nil {
+		return errColdSweepDisabled
+	}
+
+	sweepSub, err := r.server.coldSweeper.SubscribeEvents()
+	if err != nil {
+		return err
+	}
+	defer sweepSub.Cancel()
+
+	for {
+		select {
+		case e := <-sweepSub.Updates():
+			event, err := marshallColdSweepEvent(e)
+			if err != nil {
+				return err
+			}
+
+			if err := eventStream.Send(event); err != nil {
+				return err
+			}
+
+		case <-sweepSub.Quit():
+			return nil
+
+		case <-r.quit:
+			return nil
+		}
+	}
+}
+
+// marshallColdSweepEvent converts an event of the cold storage sweeper into
+// its RPC representation.
+func marshallColdSweepEvent(e interface{}) (*lnrpc.ColdSweepEvent, error) {
+	switch event := e.(type) {
+	case coldsweep.SweepPublishedEvent:
+		return &lnrpc.ColdSweepEvent{
+			Type:   lnrpc.ColdSweepEvent_SWEEP_PUBLISHED,
+			Txid:   event.Txid.String(),
+			Amount: int64(event.Amount),
+		}, nil
+
+	case coldsweep.SweepFailedEvent:
+		return &lnrpc.ColdSweepEvent{
+			Type:  lnrpc.ColdSweepEvent_SWEEP_FAILED,
+			Error: event.Err.Error(),
+		}, nil
+
+	case coldsweep.StateEvent:
+		eventType := lnrpc.ColdSweepEvent_SWEEPS_DISABLED
+		if event.Enabled {
+			eventType = lnrpc.ColdSweepEvent_SWEEPS_ENABLED
+		}
+		return &lnrpc.ColdSweepEvent{
+			Type: eventType,
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unexpected cold sweep event: %v", event)
+	}
+}
+
+// LegacyPayloadStats returns the number of HTLCs carrying a legacy onion
+// payload handled by the node since it started, along with the deadlines after
+// which these HTLCs are rejected.
+func (r *rpcServer) LegacyPayloadStats(ctx context.Context,
+	req *lnrpc.LegacyPayloadStatsRequest) (
+	*lnrpc.LegacyPayloadStatsResponse, error) {
+
+	policy := r.server.legacyPayloadPolicy
+	stats := policy.Stats()
+
+	resp := &lnrpc.LegacyPayloadStatsResponse{
+		NumForwarded: stats.Forwarded,
+		NumReceived:  stats.Received,
+		NumRejected:  stats.Rejected,
+		RejectHeight: policy.RejectHeight(),
+	}
+	if rejectTime := policy.RejectTime(); !rejectTime.IsZero() {
+		resp.RejectTime = rejectTime.Unix()
+	}
+
+	return resp, nil
+}
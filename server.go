@@ -13,6 +13,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -29,8 +30,13 @@ import (
 	"github.com/decred/dcrlnd/chanbackup"
 	"github.com/decred/dcrlnd/channeldb"
 	"github.com/decred/dcrlnd/channelnotifier"
+	"github.com/decred/dcrlnd/clock"
+	"github.com/decred/dcrlnd/coldsweep"
 	"github.com/decred/dcrlnd/contractcourt"
 	"github.com/decred/dcrlnd/discovery"
+	"github.com/decred/dcrlnd/feature"
+	"github.com/decred/dcrlnd/feemanager"
+	"github.com/decred/dcrlnd/healthcheck"
 	"github.com/decred/dcrlnd/htlcswitch"
 	"github.com/decred/dcrlnd/htlcswitch/hop"
 	"github.com/decred/dcrlnd/input"
@@ -41,6 +47,7 @@ import (
 	"github.com/decred/dcrlnd/lnrpc/routerrpc"
 	"github.com/decred/dcrlnd/lnwallet"
 	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/monitoring"
 	"github.com/decred/dcrlnd/nat"
 	"github.com/decred/dcrlnd/netann"
 	"github.com/decred/dcrlnd/peernotifier"
@@ -48,6 +55,8 @@ import (
 	"github.com/decred/dcrlnd/routing"
 	"github.com/decred/dcrlnd/routing/localchans"
 	"github.com/decred/dcrlnd/routing/route"
+	"github.com/decred/dcrlnd/signal"
+	"github.com/decred/dcrlnd/subscribe"
 	"github.com/decred/dcrlnd/sweep"
 	"github.com/decred/dcrlnd/ticker"
 	"github.com/decred/dcrlnd/tor"
@@ -82,6 +91,11 @@ const (
 	// value used or a particular peer will be chosen between 0s and this
 	// value.
 	maxInitReconnectDelay = 30
+
+	// onionServiceCheckInterval is the interval at which the connection to
+	// the Tor server is checked, in order to recreate our onion service if
+	// the Tor server restarted.
+	onionServiceCheckInterval = time.Minute
 )
 
 var (
@@ -142,6 +156,11 @@ type server struct {
 	// creating and setting up onion services, etc.
 	torController *tor.Controller
 
+	// onionAddr is the address of the onion service currently advertised
+	// in our node announcement. It's only accessed by the goroutine
+	// starting the server and then by watchOnionService.
+	onionAddr *tor.OnionAddr
+
 	// natTraversal is the specific NAT traversal technique used to
 	// automatically set up port forwarding rules in order to advertise to
 	// the network that the node is accepting inbound connections.
@@ -166,6 +185,16 @@ type server struct {
 	persistentConnReqs     map[string][]*connmgr.ConnReq
 	persistentRetryCancels map[string]chan struct{}
 
+	// bootstrapSources tracks the name of the network bootstrapper the
+	// peers connected to by the automatic peer bootstrapping were sampled
+	// from, keyed by the pubkey string of the peer.
+	bootstrapSources map[string]string
+
+	// peerConnPolicies caches the connection policies of individual peers
+	// that have been persisted in the database, keyed by the pubkey
+	// string of the peer.
+	peerConnPolicies map[string]*channeldb.PeerConnPolicy
+
 	// ignorePeerTermination tracks peers for which the server has initiated
 	// a disconnect. Adding a peer to this map causes the peer termination
 	// watcher to short circuit in the event that peers are purposefully
@@ -187,18 +216,57 @@ type server struct {
 
 	htlcSwitch *htlcswitch.Switch
 
+	// htlcNotifier notifies the events happening to the HTLCs handled by
+	// the switch and our links.
+	htlcNotifier *htlcswitch.HtlcNotifier
+
+	// legacyPayloadPolicy decides whether the HTLCs carrying a legacy
+	// onion payload are still accepted by our links, and counts them.
+	legacyPayloadPolicy *htlcswitch.LegacyPayloadPolicy
+
+	// failureDelayPolicy decides how long our links wait before failing
+	// back the HTLCs they reject. Nil if they are failed back right away.
+	failureDelayPolicy *htlcswitch.FailureDelayPolicy
+
+	// htlcExposurePolicy limits the HTLCs pending on each of our channels
+	// and with each peer. Nil if no limits are configured.
+	htlcExposurePolicy *htlcswitch.HtlcExposurePolicy
+
 	invoices *invoices.InvoiceRegistry
 
 	channelNotifier *channelnotifier.ChannelNotifier
 
 	peerNotifier *peernotifier.PeerNotifier
 
+	// coldSweeper periodically sweeps the confirmed balance of the wallet
+	// above a threshold to cold storage. It is nil if no cold storage
+	// address was configured.
+	coldSweeper *coldsweep.Sweeper
+
+	// feeManager periodically adjusts the fee rates of our channels. It
+	// is nil if the fee manager isn't active.
+	feeManager *feemanager.Manager
+
+	// livelinessMonitor periodically checks the health of the subsystems
+	// we rely on, such as our chain backend.
+	livelinessMonitor *healthcheck.Monitor
+
+	// customMessageServer dispatches the custom messages received from
+	// our peers to their subscribers.
+	customMessageServer *subscribe.Server
+
 	witnessBeacon contractcourt.WitnessBeacon
 
 	breachArbiter *breachArbiter
 
 	missionControl *routing.MissionControl
 
+	avoidList *routing.AvoidList
+
+	paymentMetrics *routing.PaymentMetrics
+
+	edgeScorer *routing.EdgeScorer
+
 	chanRouter *routing.ChannelRouter
 
 	controlTower routing.ControlTower
@@ -211,12 +279,24 @@ type server struct {
 
 	sweeper *sweep.UtxoSweeper
 
+	// feeService resolves the confirmation target of each subsystem to a
+	// fee rate.
+	feeService *lnwallet.FeeService
+
 	chainArb *contractcourt.ChainArbitrator
 
 	sphinx *hop.OnionProcessor
 
+	// replayLog is the persistent log of the shared secrets of the onions
+	// we processed, protecting us from replayed HTLCs.
+	replayLog *htlcswitch.DecayedLog
+
 	towerClient wtclient.Client
 
+	// towerClientDB is the database of the watchtower client, nil if the
+	// client isn't active.
+	towerClientDB *wtdb.ClientDB
+
 	connMgr *connmgr.ConnManager
 
 	sigPool *lnwallet.SigPool
@@ -225,6 +305,17 @@ type server struct {
 
 	readPool *pool.Read
 
+	// featureMgr dispatches feature vectors for various contexts within
+	// the daemon.
+	featureMgr *feature.Manager
+
+	// clock is the time source shared by the subsystems of the daemon.
+	clock clock.Clock
+
+	// warpClock is set on regtest and simnet only, where it backs clock.
+	// It allows integration tests to fast-forward the time of the daemon.
+	warpClock *clock.WarpClock
+
 	// globalFeatures feature vector which affects HTLCs and thus are also
 	// advertised to other nodes.
 	globalFeatures *lnwire.FeatureVector
@@ -243,6 +334,11 @@ type server struct {
 	// channelNotifier to be notified of newly opened and closed channels.
 	chanSubSwapper *chanbackup.SubSwapper
 
+	// chanRecovery tracks the recovery of the channels lost along with
+	// the channel database, and asks the recovery peers to force close
+	// the channels missing from the backups.
+	chanRecovery *chanRecovery
+
 	quit chan struct{}
 
 	wg sync.WaitGroup
@@ -287,10 +383,74 @@ func parseAddr(address string) (net.Addr, error) {
 // noiseDial is a factory function which creates a connmgr compliant dialing
 // function by returning a closure which includes the server's identity key.
 func noiseDial(idPriv *secp256k1.PrivateKey) func(net.Addr) (net.Conn, error) {
+	dial := func(lnAddr *lnwire.NetAddress) (net.Conn, error) {
+		return brontide.Dial(idPriv, lnAddr, cfg.net.Dial)
+	}
+
 	return func(a net.Addr) (net.Conn, error) {
 		lnAddr := a.(*lnwire.NetAddress)
-		return brontide.Dial(idPriv, lnAddr, cfg.net.Dial)
+		if fallback, ok := lnAddr.Address.(*fallbackAddr); ok {
+			return fallback.dial(lnAddr, dial)
+		}
+
+		return dial(lnAddr)
+	}
+}
+
+// fallbackAddr is a net.Addr made of several addresses of the same peer,
+// ordered by preference. Dialing it attempts each of the addresses in turn
+// until a connection is established, so that a peer advertising both clearnet
+// and onion addresses can still be reached if one of them is unreachable.
+type fallbackAddr struct {
+	addrs []net.Addr
+}
+
+// A compile-time check to ensure fallbackAddr implements the net.Addr
+// interface.
+var _ net.Addr = (*fallbackAddr)(nil)
+
+// Network returns the network of the preferred address.
+//
+// NOTE: This is part of the net.Addr interface.
+func (f *fallbackAddr) Network() string {
+	return f.addrs[0].Network()
+}
+
+// String returns the comma separated list of the addresses.
+//
+// NOTE: This is part of the net.Addr interface.
+func (f *fallbackAddr) String() string {
+	addrs := make([]string, 0, len(f.addrs))
+	for _, addr := range f.addrs {
+		addrs = append(addrs, addr.String())
+	}
+
+	return strings.Join(addrs, ",")
+}
+
+// dial attempts to connect to each of the addresses in turn using the passed
+// dial function, returning the first connection established.
+func (f *fallbackAddr) dial(lnAddr *lnwire.NetAddress,
+	dial func(*lnwire.NetAddress) (net.Conn, error)) (net.Conn, error) {
+
+	errs := make([]string, 0, len(f.addrs))
+	for _, addr := range f.addrs {
+		conn, err := dial(&lnwire.NetAddress{
+			IdentityKey: lnAddr.IdentityKey,
+			Address:     addr,
+			ChainNet:    lnAddr.ChainNet,
+		})
+		if err == nil {
+			return conn, nil
+		}
+
+		srvrLog.Debugf("Unable to dial %x@%v, trying next address: %v",
+			lnAddr.IdentityKey.SerializeCompressed(), addr, err)
+		errs = append(errs, fmt.Sprintf("%v: %v", addr, err))
 	}
+
+	return nil, fmt.Errorf("unable to dial any address: %v",
+		strings.Join(errs, "; "))
 }
 
 // newServer creates a new instance of the server which is to listen using the
@@ -305,6 +465,18 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 
 	listeners := make([]net.Listener, len(listenAddrs))
 	for i, listenAddr := range listenAddrs {
+		// If peer connections are relayed by a proxy, the brontide
+		// handshake is carried out on top of the PROXY protocol.
+		if cfg.ListenProxyProtocol {
+			lis, err := lncfg.ProxyListenOnAddress(listenAddr)
+			if err != nil {
+				return nil, err
+			}
+
+			listeners[i] = brontide.NewListenerFrom(privKey, lis)
+			continue
+		}
+
 		// Note: though brontide.NewListener uses ResolveTCPAddr, it
 		// doesn't need to call the general lndResolveTCP function
 		// since we are resolving a local address.
@@ -316,18 +488,29 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		}
 	}
 
-	globalFeatures := lnwire.NewRawFeatureVector()
-
-	// Only if we're not being forced to use the legacy onion format, will
-	// we signal our knowledge of the new TLV onion format.
-	if !cfg.LegacyProtocol.LegacyOnion() {
-		globalFeatures.Set(lnwire.TLVOnionPayloadOptional)
-	}
-
-	// Similarly, we default to the new modern commitment format unless the
-	// legacy commitment config is set to true.
-	if !cfg.LegacyProtocol.LegacyCommitment() {
-		globalFeatures.Set(lnwire.StaticRemoteKeyOptional)
+	// Resolve the optional features the user asked not to signal.
+	disabledFeatures := make([]lnwire.FeatureBit, 0, len(cfg.DisableFeatures))
+	for _, name := range cfg.DisableFeatures {
+		bit, err := feature.ParseOptionalFeature(name)
+		if err != nil {
+			return nil, err
+		}
+		disabledFeatures = append(disabledFeatures, bit)
+	}
+
+	// The feature manager assembles the feature vectors we signal in each
+	// context, only keeping the features enabled by the config, and
+	// ensures every feature we signal has its dependencies set as well.
+	featureMgr, err := feature.NewManager(feature.Config{
+		NoTLVOnion:        cfg.LegacyProtocol.LegacyOnion(),
+		NoStaticRemoteKey: cfg.LegacyProtocol.LegacyCommitment(),
+		NoAnchors:         !cfg.ExperimentalProtocol.AnchorCommitments(),
+		NoWumbo:           !cfg.WumboChannels,
+		NoZeroConf:        !cfg.ZeroConf,
+		Disabled:          disabledFeatures,
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	var serializedPubKey [33]byte
@@ -361,6 +544,19 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		readBufferPool, cfg.Workers.Read, pool.DefaultWorkerTimeout,
 	)
 
+	// All the time based behavior of the daemon is driven by a shared
+	// clock. On regtest and simnet, the clock can be warped forward
+	// through the WarpClock RPC, so that integration tests don't need to
+	// wait for invoices to expire or for timers to fire.
+	var (
+		clk       clock.Clock = clock.NewDefaultClock()
+		warpClock *clock.WarpClock
+	)
+	if cfg.Decred.RegTest || cfg.Decred.SimNet {
+		warpClock = clock.NewWarpClock()
+		clk = warpClock
+	}
+
 	s := &server{
 		chanDB:         chanDB,
 		cc:             cc,
@@ -368,13 +564,25 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		writePool:      writePool,
 		readPool:       readPool,
 		chansToRestore: chansToRestore,
+		replayLog:      replayLog,
+		towerClientDB:  towerClientDB,
 
-		invoices: invoices.NewRegistry(
-			chanDB, defaultFinalCltvRejectDelta,
-		),
+		invoices: invoices.NewRegistry(chanDB, &invoices.RegistryConfig{
+			FinalCltvRejectDelta:     defaultFinalCltvRejectDelta,
+			AcceptKeySend:            cfg.AcceptKeySend,
+			KeySendHold:              cfg.KeySendHold,
+			CancelExpiredInvoices:    cfg.CancelExpiredInvoices,
+			CanceledInvoiceRetention: cfg.CanceledInvoiceRetention,
+			Clock:                    clk,
+		}),
+
+		clock:     clk,
+		warpClock: warpClock,
 
 		channelNotifier: channelnotifier.New(chanDB),
 
+		customMessageServer: subscribe.NewServer(),
+
 		identityPriv: privKey,
 		nodeSigner:   netann.NewNodeSigner(privKey),
 
@@ -388,6 +596,8 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		persistentPeersBackoff:  make(map[string]time.Duration),
 		persistentConnReqs:      make(map[string][]*connmgr.ConnReq),
 		persistentRetryCancels:  make(map[string]chan struct{}),
+		peerConnPolicies:        make(map[string]*channeldb.PeerConnPolicy),
+		bootstrapSources:        make(map[string]string),
 		ignorePeerTermination:   make(map[*peer]struct{}),
 		scheduledPeerConnection: make(map[string]func()),
 
@@ -397,10 +607,9 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		peerConnectedListeners:    make(map[string][]chan<- lnpeer.Peer),
 		peerDisconnectedListeners: make(map[string][]chan<- struct{}),
 
-		globalFeatures: lnwire.NewFeatureVector(
-			globalFeatures, lnwire.GlobalFeatures,
-		),
-		quit: make(chan struct{}),
+		featureMgr:     featureMgr,
+		globalFeatures: featureMgr.Get(feature.SetNodeAnn),
+		quit:           make(chan struct{}),
 	}
 
 	s.witnessBeacon = &preimageBeacon{
@@ -408,11 +617,23 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		subscribers: make(map[uint64]*preimageSubscriber),
 	}
 
+	// Load the connection policies of individual peers, so they're
+	// respected from the first connection attempt onwards.
+	peerConnPolicies, err := chanDB.FetchPeerConnPolicies()
+	if err != nil {
+		return nil, err
+	}
+	for pubKey, policy := range peerConnPolicies {
+		s.peerConnPolicies[string(pubKey[:])] = policy
+	}
+
 	_, currentHeight, err := s.cc.chainIO.GetBestBlock()
 	if err != nil {
 		return nil, err
 	}
 
+	s.htlcNotifier = htlcswitch.NewHtlcNotifier(time.Now)
+
 	s.htlcSwitch, err = htlcswitch.New(htlcswitch.Config{
 		DB: chanDB,
 		LocalChannelClose: func(pubKey []byte,
@@ -446,11 +667,45 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		LogEventTicker:         ticker.New(htlcswitch.DefaultLogInterval),
 		AckEventTicker:         ticker.New(htlcswitch.DefaultAckInterval),
 		RejectHTLC:             cfg.RejectHTLC,
+		HtlcNotifier:           s.htlcNotifier,
+		UnsafeLogging:          cfg.UnsafeLogging,
 	}, uint32(currentHeight))
 	if err != nil {
 		return nil, err
 	}
 
+	legacyRejectTime, err := cfg.LegacyPayload.RejectTime()
+	if err != nil {
+		return nil, err
+	}
+	s.legacyPayloadPolicy = htlcswitch.NewLegacyPayloadPolicy(
+		cfg.LegacyPayload.RejectHeight, legacyRejectTime,
+	)
+
+	if cfg.FailureDelay.MaxDelay > 0 {
+		s.failureDelayPolicy = htlcswitch.NewFailureDelayPolicy(
+			cfg.FailureDelay.MinDelay, cfg.FailureDelay.MaxDelay,
+		)
+	}
+
+	if cfg.HtlcExposure.Enabled() {
+		s.htlcExposurePolicy = htlcswitch.NewHtlcExposurePolicy(
+			htlcswitch.HtlcExposureLimits{
+				MaxChanValue: lnwire.MilliAtom(
+					cfg.HtlcExposure.MaxChanMAtoms,
+				),
+				MaxChanHtlcs: int(cfg.HtlcExposure.MaxChanHtlcs),
+				MaxPeerValue: lnwire.MilliAtom(
+					cfg.HtlcExposure.MaxPeerMAtoms,
+				),
+				MaxPeerHtlcs: int(cfg.HtlcExposure.MaxPeerHtlcs),
+				MaxDustValue: lnwire.MilliAtom(
+					cfg.HtlcExposure.MaxDustMAtoms,
+				),
+			},
+		)
+	}
+
 	chanStatusMgrCfg := &netann.ChanStatusConfig{
 		ChanStatusSampleInterval: cfg.ChanStatusSampleInterval,
 		ChanEnableTimeout:        cfg.ChanEnableTimeout,
@@ -546,8 +801,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 	if err != nil {
 		return nil, err
 	}
-	selfAddrs := make([]net.Addr, 0, len(externalIPs))
-	selfAddrs = append(selfAddrs, externalIPs...)
+	selfAddrs := netann.SortAddrs(externalIPs, cfg.Tor.PreferOnion)
 
 	// If we were requested to route connections through Tor and to
 	// automatically create an onion service, we'll initiate our Tor
@@ -661,50 +915,108 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 			AprioriHopProbability: routingConfig.AprioriHopProbability,
 			PenaltyHalfLife:       routingConfig.PenaltyHalfLife,
 			MaxMcHistory:          routingConfig.MaxMcHistory,
+			MaxMcHistoryAge:       routingConfig.MaxMcHistoryAge,
+			MaxMcPairResults:      routingConfig.MaxMcPairResults,
+			FailureRelaxInterval: routingConfig.
+				FailureRelaxInterval,
+			SuccessRelaxInterval: routingConfig.
+				SuccessRelaxInterval,
+			Clock: s.clock,
 		},
 	)
 	if err != nil {
 		return nil, fmt.Errorf("can't create mission control: %v", err)
 	}
 
+	s.avoidList, err = routing.NewAvoidList(chanDB.DB)
+	if err != nil {
+		return nil, fmt.Errorf("can't create avoid list: %v", err)
+	}
+
 	srvrLog.Debugf("Instantiating payment session source with config: "+
 		"PaymentAttemptPenalty=%v, MinRouteProbability=%v",
 		int64(routingConfig.AttemptCost),
 		routingConfig.MinRouteProbability)
 
+	if routingConfig.ShadowRouteMinDelta >
+		routingConfig.ShadowRouteMaxDelta {
+
+		return nil, fmt.Errorf("shadow route min delta %v above max "+
+			"delta %v", routingConfig.ShadowRouteMinDelta,
+			routingConfig.ShadowRouteMaxDelta)
+	}
+
 	pathFindingConfig := routing.PathFindingConfig{
 		PaymentAttemptPenalty: lnwire.NewMAtomsFromAtoms(
 			routingConfig.AttemptCost,
 		),
-		MinProbability: routingConfig.MinRouteProbability,
+		MinProbability:      routingConfig.MinRouteProbability,
+		AvoidList:           s.avoidList,
+		ShadowRouteMinDelta: routingConfig.ShadowRouteMinDelta,
+		ShadowRouteMaxDelta: routingConfig.ShadowRouteMaxDelta,
+	}
+	if cfg.Prometheus.Enabled() {
+		pathFindingConfig.MetricsObserver = monitoring.ObservePathFinding
 	}
 
+	bandwidthPenalties := routing.NewBandwidthPenalties(
+		routing.DefaultBandwidthPenaltyDuration,
+	)
+	s.edgeScorer = routing.NewEdgeScorer()
 	paymentSessionSource := &routing.SessionSource{
-		Graph:             chanGraph,
-		MissionControl:    s.missionControl,
-		QueryBandwidth:    queryBandwidth,
-		SelfNode:          selfNode,
-		PathFindingConfig: pathFindingConfig,
+		Graph:              chanGraph,
+		MissionControl:     s.missionControl,
+		QueryBandwidth:     queryBandwidth,
+		BandwidthPenalties: bandwidthPenalties,
+		SelfNode:           selfNode,
+		PathFindingConfig:  pathFindingConfig,
+		EdgeScorer:         s.edgeScorer,
+	}
+
+	unsyncedGraphAction, err := routing.ParseUnsyncedGraphAction(
+		routingConfig.UnsyncedGraphPayments,
+	)
+	if err != nil {
+		return nil, err
 	}
 
+	s.paymentMetrics = routing.NewPaymentMetrics(
+		routing.DefaultPaymentMetricsWindow,
+		routing.DefaultPaymentMetricsMaxRecords,
+	)
+
 	paymentControl := channeldb.NewPaymentControl(chanDB)
 
 	s.controlTower = routing.NewControlTower(paymentControl)
 
 	s.chanRouter, err = routing.New(routing.Config{
-		Graph:              chanGraph,
-		Chain:              cc.chainIO,
-		ChainView:          cc.chainView,
-		Payer:              s.htlcSwitch,
-		Control:            s.controlTower,
-		MissionControl:     s.missionControl,
-		SessionSource:      paymentSessionSource,
-		ChannelPruneExpiry: routing.DefaultChannelPruneExpiry,
-		GraphPruneInterval: time.Hour,
-		QueryBandwidth:     queryBandwidth,
-		AssumeChannelValid: cfg.Routing.UseAssumeChannelValid(),
-		NextPaymentID:      sequencer.NextID,
-		PathFindingConfig:  pathFindingConfig,
+		Graph:               chanGraph,
+		Chain:               cc.chainIO,
+		ChainView:           cc.chainView,
+		Payer:               s.htlcSwitch,
+		Control:             s.controlTower,
+		MissionControl:      s.missionControl,
+		SessionSource:       paymentSessionSource,
+		ChannelPruneExpiry:  routing.DefaultChannelPruneExpiry,
+		GraphPruneInterval:  time.Hour,
+		QueryBandwidth:      queryBandwidth,
+		BandwidthPenalties:  bandwidthPenalties,
+		PaymentMetrics:      s.paymentMetrics,
+		AssumeChannelValid:  cfg.Routing.UseAssumeChannelValid(),
+		StrictZombiePruning: cfg.Routing.StrictZombiePruning,
+		NextPaymentID:       sequencer.NextID,
+		PathFindingConfig:   pathFindingConfig,
+		PathFindingWorkers:  routingConfig.PathFindingWorkers,
+		MaxInFlightPerDest: lnwire.NewMAtomsFromAtoms(
+			routingConfig.MaxInFlightPerDest,
+		),
+		GraphSyncStatus: func() (bool, <-chan struct{}) {
+			syncMgr := s.authGossiper.SyncManager()
+			status, changed := syncMgr.GraphSyncStatus()
+			return status.Synced, changed
+		},
+		UnsyncedGraphAction: unsyncedGraphAction,
+		Clock:               s.clock,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("can't create router: %v", err)
@@ -744,6 +1056,14 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		MinimumBatchSize:        10,
 		SubBatchDelay:           time.Second * 5,
 		IgnoreHistoricalFilters: cfg.IgnoreHistoricalGossipFilters,
+		PinnedSyncers:           cfg.Gossip.PinnedSyncerSet(),
+		PassiveSyncers:          cfg.Gossip.PassiveSyncerSet(),
+		MsgRateBytes:            cfg.Gossip.MsgRateBytes,
+		MsgBurstBytes:           cfg.Gossip.MsgBurstBytes,
+		MaxQueryChanIDs:         int32(cfg.Gossip.MaxQueryChanIDs),
+		QueryReplyMsgRate:       cfg.Gossip.QueryReplyMsgRate,
+		QueryReplyMsgBurst:      cfg.Gossip.QueryReplyMsgBurst,
+		MaxQueryReplyDelay:      cfg.Gossip.MaxQueryReplyDelay,
 	},
 		s.identityPriv.PubKey(),
 	)
@@ -772,13 +1092,29 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		return nil, err
 	}
 
+	// Each subsystem paying on-chain fees resolves its configured
+	// confirmation target through the shared fee service.
+	confTargets := cfg.ConfTargets
+	s.feeService = lnwallet.NewFeeService(
+		cc.feeEstimator, map[lnwallet.FeeSubsystem]uint32{
+			lnwallet.FeeSubsystemFunding:   confTargets.Funding,
+			lnwallet.FeeSubsystemCoopClose: confTargets.CoopClose,
+			lnwallet.FeeSubsystemSweep:     confTargets.Sweep,
+			lnwallet.FeeSubsystemAnchor:    confTargets.Anchor,
+			lnwallet.FeeSubsystemJustice:   confTargets.Justice,
+		},
+	)
+
+	publishSweepTx := labeledPublisher(
+		chanDB, sweepTxLabel, cc.wallet.PublishTransaction,
+	)
 	s.sweeper = sweep.New(&sweep.UtxoSweeperConfig{
 		FeeEstimator:       cc.feeEstimator,
-		GenSweepScript:     newSweepPkScriptGen(cc.wallet),
+		GenSweepScript:     newSweepPkScriptGen(cc.wallet, cfg.changeAddrType),
 		Signer:             cc.wallet.Cfg.Signer,
-		PublishTransaction: cc.wallet.PublishTransaction,
+		PublishTransaction: publishSweepTx,
 		NewBatchTimer: func() <-chan time.Time {
-			return time.NewTimer(sweep.DefaultBatchWindowDuration).C
+			return s.clock.TickAfter(sweep.DefaultBatchWindowDuration)
 		},
 		Notifier:             cc.chainNotifier,
 		ChainIO:              cc.chainIO,
@@ -789,6 +1125,8 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		NetParams:            activeNetParams.Params,
 		MaxFeeRate:           sweep.DefaultMaxFeeRate,
 		FeeRateBucketSize:    sweep.DefaultFeeRateBucketSize,
+		DeadlineExpiryDelta:  sweep.DefaultDeadlineExpiryDelta,
+		DeadlineFeeRateBump:  sweep.DefaultDeadlineFeeRateBump,
 	})
 
 	s.utxoNursery = newUtxoNursery(&NurseryConfig{
@@ -800,6 +1138,10 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		PublishTransaction:  cc.wallet.PublishTransaction,
 		Store:               utxnStore,
 		SweepInput:          s.sweeper.SweepInput,
+		BumpFee:             s.sweeper.BumpFee,
+		ConfTarget: s.feeService.ConfTarget(
+			lnwallet.FeeSubsystemSweep,
+		),
 	})
 
 	// Construct a closure that wraps the htlcswitch's CloseLink method.
@@ -807,7 +1149,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		closureType htlcswitch.ChannelCloseType) {
 		// TODO(conner): Properly respect the update and error channels
 		// returned by CloseLink.
-		s.htlcSwitch.CloseLink(chanPoint, closureType, 0)
+		s.htlcSwitch.CloseLink(chanPoint, closureType, 0, 0, nil)
 	}
 
 	// We will use the following channel to reliably hand off contract
@@ -818,7 +1160,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		ChainHash:              activeNetParams.GenesisHash,
 		IncomingBroadcastDelta: DefaultIncomingBroadcastDelta,
 		OutgoingBroadcastDelta: DefaultOutgoingBroadcastDelta,
-		NewSweepAddr:           newSweepPkScriptGen(cc.wallet),
+		NewSweepAddr:           newSweepPkScriptGen(cc.wallet, cfg.changeAddrType),
 		PublishTx:              cc.wallet.PublishTransaction,
 		DeliverResolutionMsg: func(msgs ...contractcourt.ResolutionMsg) error {
 			for _, msg := range msgs {
@@ -885,23 +1227,42 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 				return ErrServerShuttingDown
 			}
 		},
+		MempoolSpends: cc.mempoolSpends,
+		PendingBreach: func(chanPoint wire.OutPoint,
+			breachTx *wire.MsgTx) {
+
+			s.breachArbiter.HandlePendingBreach(chanPoint, breachTx)
+		},
 		DisableChannel:      s.chanStatusMgr.RequestDisable,
 		Sweeper:             s.sweeper,
 		Registry:            s.invoices,
 		NotifyClosedChannel: s.channelNotifier.NotifyClosedChannelEvent,
+		NotifyRemoteCommitment: s.channelNotifier.
+			NotifyRemoteCommitmentEvent,
+		MaxConcurrentClaims: cfg.MaxConcurrentClaims,
+		SweepConfTarget: s.feeService.ConfTarget(
+			lnwallet.FeeSubsystemSweep,
+		),
+		AnchorConfTarget: s.feeService.ConfTarget(
+			lnwallet.FeeSubsystemAnchor,
+		),
+		Clock: s.clock,
 	}, chanDB)
 
 	s.breachArbiter = newBreachArbiter(&BreachConfig{
 		CloseLink:          closeLink,
 		DB:                 chanDB,
 		Estimator:          s.cc.feeEstimator,
-		GenSweepScript:     newSweepPkScriptGen(cc.wallet),
+		GenSweepScript:     newSweepPkScriptGen(cc.wallet, cfg.changeAddrType),
 		Notifier:           cc.chainNotifier,
 		PublishTransaction: cc.wallet.PublishTransaction,
 		ContractBreaches:   contractBreaches,
 		Signer:             cc.wallet.Cfg.Signer,
 		Store:              newRetributionStore(chanDB),
 		NetParams:          activeNetParams.Params,
+		ConfTarget: s.feeService.ConfTarget(
+			lnwallet.FeeSubsystemJustice,
+		),
 	})
 
 	// Select the configuration and funding parameters for Decred
@@ -914,12 +1275,32 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		return nil, err
 	}
 
+	// If only specific peers are allowed to open wumbo channels with us,
+	// we'll restrict the channels above the default funding limit to them.
+	acceptWumbo, err := newPeerAllowList(cfg.WumboPeers)
+	if err != nil {
+		return nil, fmt.Errorf("invalid wumbo peer: %v", err)
+	}
+
+	// Zero-conf channels are only accepted from the peers we trust not to
+	// double spend their funding transaction.
+	acceptZeroConf, err := newPeerAllowList(cfg.ZeroConfPeers)
+	if err != nil {
+		return nil, fmt.Errorf("invalid zero-conf peer: %v", err)
+	}
+
+	publishFundingTx := labeledPublisher(
+		chanDB, fundingTxLabel, cc.wallet.PublishTransaction,
+	)
 	s.fundingMgr, err = newFundingManager(fundingConfig{
 		IDKey:              privKey.PubKey(),
 		Wallet:             cc.wallet,
-		PublishTransaction: cc.wallet.PublishTransaction,
+		PublishTransaction: publishFundingTx,
 		Notifier:           cc.chainNotifier,
 		FeeEstimator:       cc.feeEstimator,
+		CommitFeeConfTarget: s.feeService.ConfTarget(
+			lnwallet.FeeSubsystemFunding,
+		),
 		SignMessage: func(pubKey *secp256k1.PublicKey,
 			msg []byte) (*secp256k1.Signature, error) {
 
@@ -1045,6 +1426,23 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 			cid := lnwire.NewChanIDFromOutPoint(&chanPoint)
 			return s.htlcSwitch.UpdateShortChanID(cid)
 		},
+		ReportConfirmedShortChanID: func(chanPoint wire.OutPoint,
+			confirmedID lnwire.ShortChannelID) error {
+
+			cid := lnwire.NewChanIDFromOutPoint(&chanPoint)
+			return s.htlcSwitch.AddConfirmedShortChanID(
+				cid, confirmedID,
+			)
+		},
+		DeleteAliasEdge: func(alias lnwire.ShortChannelID) error {
+			err := chanDB.ChannelGraph().DeleteChannelEdges(
+				alias.ToUint64(),
+			)
+			if err == channeldb.ErrEdgeNotFound {
+				return nil
+			}
+			return err
+		},
 		RequiredRemoteChanReserve: func(chanAmt,
 			dustLimit dcrutil.Amount) dcrutil.Amount {
 
@@ -1072,13 +1470,21 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 			// channel bandwidth.
 			return uint16(input.MaxHTLCNumber / 2)
 		},
-		ZombieSweeperInterval:  1 * time.Minute,
-		ReservationTimeout:     10 * time.Minute,
-		MinChanSize:            dcrutil.Amount(cfg.MinChanSize),
-		MaxPendingChannels:     cfg.MaxPendingChannels,
-		RejectPush:             cfg.RejectPush,
-		NotifyOpenChannelEvent: s.channelNotifier.NotifyOpenChannelEvent,
-		OpenChannelPredicate:   chanPredicate,
+		ZombieSweeperInterval:       1 * time.Minute,
+		ReservationTimeout:          10 * time.Minute,
+		MinChanSize:                 dcrutil.Amount(cfg.MinChanSize),
+		MaxChanSize:                 dcrutil.Amount(cfg.MaxChanSize),
+		AcceptWumbo:                 acceptWumbo,
+		AcceptZeroConf:              acceptZeroConf,
+		NextAliasShortChanID:        chanDB.NextAliasShortChanID,
+		MaxPendingChannels:          cfg.MaxPendingChannels,
+		MaxGlobalPendingChannels:    cfg.MaxGlobalPendingChannels,
+		RejectPush:                  cfg.RejectPush,
+		NotifyOpenChannelEvent:      s.channelNotifier.NotifyOpenChannelEvent,
+		NotifyClosedChannelEvent:    s.channelNotifier.NotifyClosedChannelEvent,
+		MaxWaitNumBlocksFundingConf: cfg.FundingTimeout.MaxBlocks,
+		AbandonInitiatedChannels:    cfg.FundingTimeout.AbandonInitiated,
+		OpenChannelPredicate:        chanPredicate,
 	})
 	if err != nil {
 		return nil, err
@@ -1095,17 +1501,124 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 	if err != nil {
 		return nil, err
 	}
+
+	// If the user configured any hooks to replicate the backup, we'll
+	// notify them each time the backup file is swapped.
+	var (
+		backupSwapper   chanbackup.Swapper = backupFile
+		backupNotifiers []chanbackup.BackupNotifier
+	)
+	if cfg.BackupNotifyCmd != "" {
+		backupNotifiers = append(backupNotifiers,
+			&chanbackup.CommandNotifier{
+				Command:        cfg.BackupNotifyCmd,
+				BackupFilePath: cfg.BackupFilePath,
+			},
+		)
+	}
+	if cfg.BackupNotifyURL != "" {
+		backupNotifiers = append(backupNotifiers,
+			&chanbackup.URLNotifier{
+				URL: cfg.BackupNotifyURL,
+			},
+		)
+	}
+	if len(backupNotifiers) > 0 {
+		backupSwapper = chanbackup.NewNotifyingSwapper(
+			backupFile, backupNotifiers...,
+		)
+	}
+
 	s.chanSubSwapper, err = chanbackup.NewSubSwapper(
-		startingChans, chanNotifier, s.cc.keyRing, backupFile,
+		startingChans, chanNotifier, s.cc.keyRing, backupSwapper,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	var recoveryPeers []*lnwire.NetAddress
+	for _, peer := range cfg.ChanRecovery.Peers {
+		addr, err := lncfg.ParseLNAddressString(
+			peer, strconv.Itoa(defaultPeerPort),
+			cfg.net.ResolveTCPAddr,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid recovery peer %v: %v",
+				peer, err)
+		}
+		addr.ChainNet = activeNetParams.Net
+		recoveryPeers = append(recoveryPeers, addr)
+	}
+	s.chanRecovery = newChanRecovery(
+		chanDB, recoveryPeers,
+		func(pub *secp256k1.PublicKey) bool {
+			_, err := s.FindPeer(pub)
+			return err == nil
+		},
+		func(addr *lnwire.NetAddress) error {
+			return s.ConnectToPeer(addr, true)
+		},
+	)
+
 	// Assemble a peer notifier which will provide clients with subscriptions
 	// to peer online and offline events.
 	s.peerNotifier = peernotifier.New()
 
+	// If a cold storage address was configured, we'll create the sweeper
+	// moving the confirmed balance above the threshold to it.
+	if cfg.ColdSweep.Address != "" {
+		coldAddr, err := dcrutil.DecodeAddress(
+			cfg.ColdSweep.Address, activeNetParams.Params,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cold storage address: "+
+				"%v", err)
+		}
+
+		// The sweeps synchronize with any other coin selection, such
+		// as channel fundings or sends.
+		sendOutputs := func(outputs []*wire.TxOut,
+			feeRate lnwallet.AtomPerKByte) (*wire.MsgTx, error) {
+
+			var tx *wire.MsgTx
+			err := s.cc.wallet.WithCoinSelectLock(func() error {
+				var err error
+				tx, err = s.cc.wallet.SendOutputs(
+					outputs, feeRate,
+				)
+				return err
+			})
+			return tx, err
+		}
+
+		s.coldSweeper = coldsweep.New(&coldsweep.Config{
+			Address:    coldAddr,
+			Threshold:  dcrutil.Amount(cfg.ColdSweep.Threshold),
+			Interval:   cfg.ColdSweep.Interval,
+			TargetConf: cfg.ColdSweep.TargetConf,
+			ConfirmedBalance: func() (dcrutil.Amount, error) {
+				return s.cc.wallet.ConfirmedBalance(1)
+			},
+			FeeRate: func(confTarget uint32) (lnwallet.AtomPerKByte,
+				error) {
+
+				return s.cc.feeEstimator.EstimateFeePerKB(
+					confTarget,
+				)
+			},
+			SendOutputs: sendOutputs,
+		}, cfg.ColdSweep.Enable)
+	}
+
+	if cfg.FeeManager.Active {
+		s.feeManager, err = newFeeManager(s, cfg.FeeManager)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	s.livelinessMonitor = s.newLivelinessMonitor(cfg.HealthChecks)
+
 	if cfg.WtClient.Active {
 		policy := wtpolicy.DefaultPolicy()
 
@@ -1125,7 +1638,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		s.towerClient, err = wtclient.New(&wtclient.Config{
 			ChainParams:    activeNetParams.Params,
 			Signer:         cc.wallet.Cfg.Signer,
-			NewAddress:     newSweepPkScriptGen(cc.wallet),
+			NewAddress:     newSweepPkScriptGen(cc.wallet, cfg.changeAddrType),
 			SecretKeyRing:  s.cc.keyRing,
 			Dial:           cfg.net.Dial,
 			AuthDial:       wtclient.AuthDial,
@@ -1177,6 +1690,9 @@ func (s *server) Start() error {
 				startErr = err
 				return
 			}
+
+			s.wg.Add(1)
+			go s.watchOnionService()
 		}
 
 		if s.natTraversal != nil {
@@ -1213,6 +1729,26 @@ func (s *server) Start() error {
 			startErr = err
 			return
 		}
+		if err := s.customMessageServer.Start(); err != nil {
+			startErr = err
+			return
+		}
+		if s.coldSweeper != nil {
+			if err := s.coldSweeper.Start(); err != nil {
+				startErr = err
+				return
+			}
+		}
+		if s.feeManager != nil {
+			if err := s.feeManager.Start(); err != nil {
+				startErr = err
+				return
+			}
+		}
+		if err := s.livelinessMonitor.Start(); err != nil {
+			startErr = err
+			return
+		}
 		if err := s.sphinx.Start(); err != nil {
 			startErr = err
 			return
@@ -1223,6 +1759,22 @@ func (s *server) Start() error {
 				return
 			}
 		}
+		if err := s.htlcNotifier.Start(); err != nil {
+			startErr = err
+			return
+		}
+
+		// If Prometheus monitoring is enabled, the metrics gathered
+		// from our subsystems are exported along with the gRPC ones.
+		if cfg.Prometheus.Enabled() {
+			err := monitoring.ExportNodeMetrics(
+				s.nodeMetricSources(), s.quit,
+			)
+			if err != nil {
+				startErr = err
+				return
+			}
+		}
 		if err := s.htlcSwitch.Start(); err != nil {
 			startErr = err
 			return
@@ -1317,11 +1869,20 @@ func (s *server) Start() error {
 			startErr = err
 			return
 		}
+
+		// The recovery peers are connected to in order to recover
+		// the channels missing from the restored backups.
+		s.chanRecovery.connectPeers()
+
 		// If network bootstrapping hasn't been disabled, then we'll
 		// configure the set of active bootstrappers, and launch a
 		// dedicated goroutine to maintain a set of persistent
-		// connections.
-		if !cfg.NoNetBootstrap && !cfg.Decred.SimNet && !cfg.Decred.RegTest {
+		// connections. Static bootstrap peers can be used on any
+		// network.
+		localNet := cfg.Decred.SimNet || cfg.Decred.RegTest
+		if !cfg.NoNetBootstrap &&
+			(!localNet || len(cfg.BootstrapPeers) > 0) {
+
 			bootstrappers, err := initNetworkBootstrappers(s)
 			if err != nil {
 				startErr = err
@@ -1350,8 +1911,17 @@ func (s *server) Stop() error {
 	s.stop.Do(func() {
 		atomic.StoreInt32(&s.stopping, 1)
 
+		// Let the in-flight HTLCs be resolved before tearing down the
+		// subsystems resolving them.
+		s.drainForShutdown()
+
 		close(s.quit)
 
+		if err := s.livelinessMonitor.Stop(); err != nil {
+			srvrLog.Warnf("Unable to stop liveliness monitor: %v",
+				err)
+		}
+
 		if s.torController != nil {
 			s.torController.Stop()
 		}
@@ -1361,6 +1931,7 @@ func (s *server) Stop() error {
 		s.cc.chainNotifier.Stop()
 		s.chanRouter.Stop()
 		s.htlcSwitch.Stop()
+		s.htlcNotifier.Stop()
 		s.sphinx.Stop()
 		s.utxoNursery.Stop()
 		s.breachArbiter.Stop()
@@ -1369,6 +1940,13 @@ func (s *server) Stop() error {
 		s.sweeper.Stop()
 		s.channelNotifier.Stop()
 		s.peerNotifier.Stop()
+		s.customMessageServer.Stop()
+		if s.coldSweeper != nil {
+			s.coldSweeper.Stop()
+		}
+		if s.feeManager != nil {
+			s.feeManager.Stop()
+		}
 		s.cc.wallet.Shutdown()
 		s.cc.chainView.Stop()
 		s.connMgr.Stop()
@@ -1567,6 +2145,7 @@ out:
 			// it to our peers.
 			newNodeAnn, err := s.genNodeAnnouncement(
 				true, lnwire.UpdateNodeAnnAddrs(newAddrs),
+				netann.NodeAnnSortAddrs(cfg.Tor.PreferOnion),
 			)
 			if err != nil {
 				srvrLog.Debugf("Unable to generate new node "+
@@ -1607,6 +2186,32 @@ func initNetworkBootstrappers(s *server) ([]discovery.NetworkPeerBootstrapper, e
 	}
 	bootStrappers = append(bootStrappers, graphBootstrapper)
 
+	// If static bootstrap peers were configured, they'll be sampled as
+	// well.
+	if len(cfg.BootstrapPeers) > 0 {
+		var staticAddrs []*lnwire.NetAddress
+		for _, peer := range cfg.BootstrapPeers {
+			addr, err := lncfg.ParseLNAddressString(
+				peer, strconv.Itoa(defaultPeerPort),
+				cfg.net.ResolveTCPAddr,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("invalid bootstrap peer "+
+					"%v: %v", peer, err)
+			}
+			addr.ChainNet = activeNetParams.Net
+			staticAddrs = append(staticAddrs, addr)
+		}
+
+		srvrLog.Infof("Creating static peer bootstrapper with %v "+
+			"peers", len(staticAddrs))
+
+		staticBootstrapper := discovery.NewStaticBootstrapper(
+			staticAddrs,
+		)
+		bootStrappers = append(bootStrappers, staticBootstrapper)
+	}
+
 	// If this isn't simnet mode, then one of our additional bootstrapping
 	// sources will be the set of running DNS seeds.
 	if !cfg.Decred.SimNet {
@@ -1638,6 +2243,10 @@ func (s *server) peerBootstrapper(numTargetPeers uint32,
 
 	defer s.wg.Done()
 
+	// The bootstrappers are chained so that the sources producing the
+	// most successful connections are queried first.
+	chain := discovery.NewBootstrapperChain(bootstrappers...)
+
 	// Create a context so that initial DNS bootstrapping can be canceled in
 	// case of shutdown.
 	//
@@ -1658,7 +2267,7 @@ func (s *server) peerBootstrapper(numTargetPeers uint32,
 
 	// We'll start off by aggressively attempting connections to peers in
 	// order to be a part of the network as soon as possible.
-	s.initialPeerBootstrap(ctx, ignore, numTargetPeers, bootstrappers)
+	s.initialPeerBootstrap(ctx, ignore, numTargetPeers, chain)
 
 	// Once done, we'll attempt to maintain our target minimum number of
 	// peers.
@@ -1742,8 +2351,8 @@ func (s *server) peerBootstrapper(numTargetPeers uint32,
 			}
 			s.mu.RUnlock()
 
-			peerAddrs, err := discovery.MultiSourceBootstrap(
-				ctx, ignoreList, numNeeded*2, bootstrappers...,
+			peerAddrs, err := chain.SampleNodeAddrs(
+				ctx, numNeeded*2, ignoreList,
 			)
 			if err == discovery.ErrNoAddressesFound {
 				srvrLog.Errorf("No addresses returned by " +
@@ -1763,13 +2372,16 @@ func (s *server) peerBootstrapper(numTargetPeers uint32,
 			for _, addr := range peerAddrs {
 				epochAttempts++
 
-				go func(a *lnwire.NetAddress) {
+				go func(a *discovery.BootstrapAddr) {
 					// TODO(roasbeef): can do AS, subnet,
 					// country diversity, etc
 					errChan := make(chan error, 1)
-					s.connectToPeer(a, errChan)
+					s.connectToPeer(a.NetAddress, errChan)
 					select {
 					case err := <-errChan:
+						s.bootstrapConnected(
+							chain, a, err,
+						)
 						if err == nil {
 							return
 						}
@@ -1797,7 +2409,8 @@ const bootstrapBackOffCeiling = time.Minute * 5
 // until the target number of peers has been reached. This ensures that nodes
 // receive an up to date network view as soon as possible.
 func (s *server) initialPeerBootstrap(ctx context.Context,
-	ignore map[autopilot.NodeID]struct{}, numTargetPeers uint32, bootstrappers []discovery.NetworkPeerBootstrapper) {
+	ignore map[autopilot.NodeID]struct{}, numTargetPeers uint32,
+	chain *discovery.BootstrapperChain) {
 
 	// We'll start off by waiting 2 seconds between failed attempts, then
 	// double each time we fail until we hit the bootstrapBackOffCeiling.
@@ -1851,8 +2464,8 @@ func (s *server) initialPeerBootstrap(ctx context.Context,
 		// Otherwise, we'll request for the remaining number of peers
 		// in order to reach our target.
 		peersNeeded := numTargetPeers - numActivePeers
-		bootstrapAddrs, err := discovery.MultiSourceBootstrap(
-			ctx, ignore, peersNeeded, bootstrappers...,
+		bootstrapAddrs, err := chain.SampleNodeAddrs(
+			ctx, peersNeeded, ignore,
 		)
 		if err == discovery.ErrNoAddressesFound {
 			srvrLog.Errorf("No addresses returned by initial " +
@@ -1870,11 +2483,11 @@ func (s *server) initialPeerBootstrap(ctx context.Context,
 		var wg sync.WaitGroup
 		for _, bootstrapAddr := range bootstrapAddrs {
 			wg.Add(1)
-			go func(addr *lnwire.NetAddress) {
+			go func(addr *discovery.BootstrapAddr) {
 				defer wg.Done()
 
 				errChan := make(chan error, 1)
-				go s.connectToPeer(addr, errChan)
+				go s.connectToPeer(addr.NetAddress, errChan)
 
 				// We'll only allow this connection attempt to
 				// take up to 3 seconds. This allows us to move
@@ -1882,6 +2495,7 @@ func (s *server) initialPeerBootstrap(ctx context.Context,
 				// us down.
 				select {
 				case err := <-errChan:
+					s.bootstrapConnected(chain, addr, err)
 					if err == nil {
 						return
 					}
@@ -1890,6 +2504,9 @@ func (s *server) initialPeerBootstrap(ctx context.Context,
 				// TODO: tune timeout? 3 seconds might be *too*
 				// aggressive but works well.
 				case <-time.After(3 * time.Second):
+					chain.ReportConnection(
+						addr.Source, false,
+					)
 					srvrLog.Tracef("Skipping peer %v due "+
 						"to not establishing a "+
 						"connection within 3 seconds",
@@ -1903,81 +2520,235 @@ func (s *server) initialPeerBootstrap(ctx context.Context,
 	}
 }
 
+// bootstrapConnected records the outcome of the connection attempt to a peer
+// sampled by the bootstrapper chain, keeping track of the bootstrapper the
+// peer was sampled from if the connection succeeded.
+func (s *server) bootstrapConnected(chain *discovery.BootstrapperChain,
+	addr *discovery.BootstrapAddr, err error) {
+
+	chain.ReportConnection(addr.Source, err == nil)
+	if err != nil {
+		return
+	}
+
+	pubStr := string(addr.IdentityKey.SerializeCompressed())
+
+	s.mu.Lock()
+	s.bootstrapSources[pubStr] = addr.Source
+	s.mu.Unlock()
+}
+
+// bootstrapSource returns the name of the network bootstrapper the given peer
+// was sampled from, or an empty string if the connection to the peer wasn't
+// established by the automatic peer bootstrapping.
+func (s *server) bootstrapSource(pubKey *secp256k1.PublicKey) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.bootstrapSources[string(pubKey.SerializeCompressed())]
+}
+
 // initTorController initiliazes the Tor controller backed by lnd and
-// automatically sets up a v2 onion service in order to listen for inbound
-// connections over Tor.
+// automatically sets up a v2 or v3 onion service in order to listen for
+// inbound connections over Tor.
 func (s *server) initTorController() error {
 	if err := s.torController.Start(); err != nil {
 		return err
 	}
 
-	// Determine the different ports the server is listening on. The onion
-	// service's virtual port will map to these ports and one will be picked
-	// at random when the onion service is being accessed.
-	listenPorts := make([]int, 0, len(s.listenAddrs))
-	for _, listenAddr := range s.listenAddrs {
-		port := listenAddr.(*net.TCPAddr).Port
-		listenPorts = append(listenPorts, port)
+	addr, err := s.addOnionService()
+	if err != nil {
+		return err
 	}
+	s.onionAddr = addr
 
-	// Once the port mapping has been set, we can go ahead and automatically
-	// create our onion service. The service's private key will be saved to
-	// disk in order to regain access to this service when restarting `lnd`.
-	onionCfg := tor.AddOnionConfig{
-		VirtualPort:    defaultPeerPort,
-		TargetPorts:    listenPorts,
-		PrivateKeyPath: cfg.Tor.PrivateKeyPath,
+	srvrLog.Infof("Onion service created, listening for inbound "+
+		"connections on %v", addr)
+
+	// Now that the onion service has been created, we'll add the onion
+	// address it can be reached at to our list of advertised addresses,
+	// keeping them ordered by preference.
+	newNodeAnn, err := s.genNodeAnnouncement(
+		true, netann.NodeAnnAddAddrs(addr),
+		netann.NodeAnnSortAddrs(cfg.Tor.PreferOnion),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to generate new node announcement: %v", err)
 	}
 
-	switch {
+	// Finally, we'll update the on-disk version of our announcement so it
+	// will eventually propagate to nodes in the network.
+	selfNode := selfNodeFromAnn(newNodeAnn)
+	if err := s.chanDB.ChannelGraph().SetSourceNode(selfNode); err != nil {
+		return fmt.Errorf("can't set self node: %v", err)
+	}
+
+	return nil
+}
+
+// addOnionService creates our onion service through the Tor controller, or
+// restores it from its stored private key, and returns its address.
+func (s *server) addOnionService() (*tor.OnionAddr, error) {
+	// Determine the different ports the server is listening on. The onion
+	// service's virtual port will map to these ports and one will be picked
+	// at random when the onion service is being accessed.
+	listenPorts := make([]int, 0, len(s.listenAddrs))
+	for _, listenAddr := range s.listenAddrs {
+		port := listenAddr.(*net.TCPAddr).Port
+		listenPorts = append(listenPorts, port)
+	}
+
+	// Once the port mapping has been set, we can go ahead and automatically
+	// create our onion service. The service's private key will be saved to
+	// disk in order to regain access to this service when restarting `lnd`,
+	// unless it's derived from our identity key, in which case it doesn't
+	// need to be persisted at all.
+	var onionStore tor.OnionStore = tor.NewOnionFile(cfg.Tor.PrivateKeyPath)
+	if cfg.Tor.WalletKey {
+		onionStore = tor.NewDerivedOnionKey(s.identityPriv.Serialize())
+	}
+	onionCfg := tor.AddOnionConfig{
+		VirtualPort: defaultPeerPort,
+		TargetPorts: listenPorts,
+		Store:       onionStore,
+	}
+
+	switch {
 	case cfg.Tor.V2:
 		onionCfg.Type = tor.V2
 	case cfg.Tor.V3:
 		onionCfg.Type = tor.V3
 	}
 
-	addr, err := s.torController.AddOnion(onionCfg)
+	return s.torController.AddOnion(onionCfg)
+}
+
+// watchOnionService periodically checks the connection to the Tor server, and
+// recreates our onion service if the connection was lost, as the Tor server
+// removes it along with the connection, e.g. when restarting. If the onion
+// service comes back at a different address, because its private key was
+// rotated or its type changed, our node announcement is updated and broadcast
+// so that peers can still reach us.
+//
+// NOTE: This MUST be run as a goroutine.
+func (s *server) watchOnionService() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(onionServiceCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			err := s.torController.CheckConnection()
+			if err == nil {
+				continue
+			}
+
+			srvrLog.Warnf("Lost connection to the Tor server, "+
+				"recreating onion service: %v", err)
+
+			if err := s.torController.Reconnect(); err != nil {
+				srvrLog.Errorf("Unable to reconnect to the Tor "+
+					"server: %v", err)
+				continue
+			}
+
+			addr, err := s.addOnionService()
+			if err != nil {
+				srvrLog.Errorf("Unable to recreate onion "+
+					"service: %v", err)
+				continue
+			}
+
+			if addr.String() == s.onionAddr.String() {
+				srvrLog.Infof("Onion service recreated, "+
+					"listening for inbound connections on "+
+					"%v", addr)
+				continue
+			}
+
+			if err := s.updateOnionAddr(addr); err != nil {
+				srvrLog.Errorf("Unable to advertise new onion "+
+					"address %v: %v", addr, err)
+				continue
+			}
+
+		case <-s.quit:
+			return
+		}
+	}
+}
+
+// updateOnionAddr replaces the onion address advertised in our node
+// announcement with the given one, and broadcasts the new announcement to our
+// peers. The announcement is also applied to the graph through the router,
+// which notifies the graph topology subscribers of the new address.
+func (s *server) updateOnionAddr(newAddr *tor.OnionAddr) error {
+	currentNodeAnn, err := s.genNodeAnnouncement(false)
 	if err != nil {
-		return err
+		return fmt.Errorf("unable to retrieve current node "+
+			"announcement: %v", err)
+	}
+
+	oldAddr := s.onionAddr
+	newAddrs := []net.Addr{newAddr}
+	for _, addr := range currentNodeAnn.Addresses {
+		if oldAddr != nil && addr.String() == oldAddr.String() {
+			continue
+		}
+		newAddrs = append(newAddrs, addr)
 	}
 
-	// Now that the onion service has been created, we'll add the onion
-	// address it can be reached at to our list of advertised addresses.
 	newNodeAnn, err := s.genNodeAnnouncement(
-		true, func(currentAnn *lnwire.NodeAnnouncement) {
-			currentAnn.Addresses = append(currentAnn.Addresses, addr)
-		},
+		true, lnwire.UpdateNodeAnnAddrs(newAddrs),
+		netann.NodeAnnSortAddrs(cfg.Tor.PreferOnion),
 	)
 	if err != nil {
-		return fmt.Errorf("unable to generate new node announcement: %v", err)
+		return fmt.Errorf("unable to generate new node "+
+			"announcement: %v", err)
 	}
 
-	// Finally, we'll update the on-disk version of our announcement so it
-	// will eventually propagate to nodes in the network.
+	if err := s.chanRouter.AddNode(selfNodeFromAnn(newNodeAnn)); err != nil {
+		return fmt.Errorf("unable to update self node: %v", err)
+	}
+
+	if err := s.BroadcastMessage(nil, &newNodeAnn); err != nil {
+		return fmt.Errorf("unable to broadcast new node "+
+			"announcement to peers: %v", err)
+	}
+
+	srvrLog.Infof("Onion service address changed from %v to %v, "+
+		"broadcast new node announcement", oldAddr, newAddr)
+
+	s.onionAddr = newAddr
+
+	return nil
+}
+
+// selfNodeFromAnn returns the on-disk version of our node announcement.
+func selfNodeFromAnn(nodeAnn lnwire.NodeAnnouncement) *channeldb.LightningNode {
 	selfNode := &channeldb.LightningNode{
 		HaveNodeAnnouncement: true,
-		LastUpdate:           time.Unix(int64(newNodeAnn.Timestamp), 0),
-		Addresses:            newNodeAnn.Addresses,
-		Alias:                newNodeAnn.Alias.String(),
+		LastUpdate:           time.Unix(int64(nodeAnn.Timestamp), 0),
+		Addresses:            nodeAnn.Addresses,
+		Alias:                nodeAnn.Alias.String(),
 		Features: lnwire.NewFeatureVector(
-			newNodeAnn.Features, lnwire.GlobalFeatures,
+			nodeAnn.Features, lnwire.GlobalFeatures,
 		),
-		Color:        newNodeAnn.RGBColor,
-		AuthSigBytes: newNodeAnn.Signature.ToSignatureBytes(),
-	}
-	copy(selfNode.PubKeyBytes[:], s.identityPriv.PubKey().SerializeCompressed())
-	if err := s.chanDB.ChannelGraph().SetSourceNode(selfNode); err != nil {
-		return fmt.Errorf("can't set self node: %v", err)
+		Color:        nodeAnn.RGBColor,
+		AuthSigBytes: nodeAnn.Signature.ToSignatureBytes(),
 	}
+	copy(selfNode.PubKeyBytes[:], nodeAnn.NodeID[:])
 
-	return nil
+	return selfNode
 }
 
 // genNodeAnnouncement generates and returns the current fully signed node
 // announcement. If refresh is true, then the time stamp of the announcement
 // will be updated in order to ensure it propagates through the network.
 func (s *server) genNodeAnnouncement(refresh bool,
-	updates ...func(*lnwire.NodeAnnouncement)) (lnwire.NodeAnnouncement, error) {
+	updates ...netann.NodeAnnModifier) (lnwire.NodeAnnouncement, error) {
 
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -2145,6 +2916,41 @@ func (s *server) establishPersistentConnections() error {
 		return err
 	}
 
+	// Peers whose connection policy requests to always reconnect to them
+	// are included even if we don't have any channels with them, as long
+	// as they have advertised an address.
+	s.mu.RLock()
+	var alwaysReconnect []string
+	for pubStr, policy := range s.peerConnPolicies {
+		if policy.Reconnect != channeldb.ReconnectAlways {
+			continue
+		}
+		if _, ok := nodeAddrsMap[pubStr]; ok {
+			continue
+		}
+		alwaysReconnect = append(alwaysReconnect, pubStr)
+	}
+	s.mu.RUnlock()
+
+	for _, pubStr := range alwaysReconnect {
+		pubKey, err := secp256k1.ParsePubKey([]byte(pubStr))
+		if err != nil {
+			return err
+		}
+
+		addr, err := s.reconnectAddr(pubKey, nil)
+		if err != nil {
+			srvrLog.Debugf("Unable to find address of peer %x to "+
+				"always reconnect to: %v", pubStr, err)
+			continue
+		}
+
+		nodeAddrsMap[pubStr] = &nodeAddresses{
+			pubKey:    pubKey,
+			addresses: []net.Addr{addr},
+		}
+	}
+
 	// Acquire and hold server lock until all persistent connection requests
 	// have been recorded and sent to the connection manager.
 	s.mu.Lock()
@@ -2154,14 +2960,25 @@ func (s *server) establishPersistentConnections() error {
 	// node announcements and attempt to reconnect to each node.
 	var numOutboundConns int
 	for pubStr, nodeAddr := range nodeAddrsMap {
+		// Peers that have been configured to never be reconnected to
+		// are skipped entirely.
+		reconnect := s.peerReconnectPolicy(pubStr)
+		if reconnect == channeldb.ReconnectNever {
+			srvrLog.Debugf("Skipping persistent connection to "+
+				"peer %x due to its connection policy", pubStr)
+			continue
+		}
+
 		// Add this peer to the set of peers we should maintain a
 		// persistent connection with. We set the value to false to
 		// indicate that we should not continue to reconnect if the
 		// number of channels returns to zero, since this peer has not
-		// been requested as perm by the user.
-		s.persistentPeers[pubStr] = false
+		// been requested as perm by the user, unless its connection
+		// policy requests to always reconnect to it.
+		s.persistentPeers[pubStr] = reconnect == channeldb.ReconnectAlways
 		if _, ok := s.persistentPeersBackoff[pubStr]; !ok {
-			s.persistentPeersBackoff[pubStr] = cfg.MinBackoff
+			minBackoff, _ := s.peerBackoffBounds(pubStr)
+			s.persistentPeersBackoff[pubStr] = minBackoff
 		}
 
 		// We might have been contacted by this peer at this point, so
@@ -2365,6 +3182,146 @@ func (s *server) NotifyWhenOffline(peerPubKey [33]byte) <-chan struct{} {
 	return c
 }
 
+// CustomMessage is a custom message received from one of our peers.
+type CustomMessage struct {
+	// Peer is the public key of the peer that sent the message.
+	Peer [33]byte
+
+	// Msg is the custom message itself.
+	Msg *lnwire.Custom
+}
+
+// SendCustomMessage sends a custom message of the passed type, carrying the
+// passed data, to the connected peer with the passed public key.
+//
+// NOTE: This function is safe for concurrent access.
+func (s *server) SendCustomMessage(peerPub [33]byte,
+	msgType lnwire.MessageType, data []byte) error {
+
+	msg, err := lnwire.NewCustom(msgType, data)
+	if err != nil {
+		return err
+	}
+
+	peer, err := s.FindPeerByPubStr(string(peerPub[:]))
+	if err != nil {
+		return err
+	}
+
+	// Messages can only be sent once the peer is active.
+	select {
+	case <-peer.activeSignal:
+	case <-peer.quit:
+		return ErrPeerNotConnected
+	case <-s.quit:
+		return ErrServerShuttingDown
+	}
+
+	// Custom messages are sent as low priority messages, so they don't
+	// hold up the messages of the protocol itself.
+	return peer.SendMessageLazy(true, msg)
+}
+
+// PushGossip sends our node announcement, if nodeAnn is true, and the
+// announcements and latest updates of the given channels of ours directly to
+// the connected peer with the passed public key, regardless of the gossip
+// syncing state of the peer. Only the updates of private channels are sent, as
+// they have no announcement. The number of messages sent is returned.
+//
+// NOTE: This function is safe for concurrent access.
+func (s *server) PushGossip(peerPub [33]byte, nodeAnn bool,
+	chanIDs []lnwire.ShortChannelID) (int, error) {
+
+	peer, err := s.FindPeerByPubStr(string(peerPub[:]))
+	if err != nil {
+		return 0, err
+	}
+
+	var msgs []lnwire.Message
+	if nodeAnn {
+		ann, err := s.genNodeAnnouncement(false)
+		if err != nil {
+			return 0, err
+		}
+		msgs = append(msgs, &ann)
+	}
+
+	var ourPubKey [33]byte
+	copy(ourPubKey[:], s.identityPriv.PubKey().SerializeCompressed())
+	for _, chanID := range chanIDs {
+		info, edge1, edge2, err := s.chanRouter.GetChannelByID(chanID)
+		if err != nil {
+			return 0, fmt.Errorf("unable to find channel %v: %v",
+				chanID, err)
+		}
+		if info.NodeKey1Bytes != ourPubKey &&
+			info.NodeKey2Bytes != ourPubKey {
+
+			return 0, fmt.Errorf("channel %v is not one of our "+
+				"channels", chanID)
+		}
+
+		if info.AuthProof != nil {
+			chanAnn, _, _, err := discovery.CreateChanAnnouncement(
+				info.AuthProof, info, edge1, edge2,
+			)
+			if err != nil {
+				return 0, err
+			}
+			msgs = append(msgs, chanAnn)
+		}
+
+		update, err := netann.ExtractChannelUpdate(
+			ourPubKey[:], info, edge1, edge2,
+		)
+		if err != nil {
+			return 0, fmt.Errorf("unable to get update of channel "+
+				"%v: %v", chanID, err)
+		}
+		msgs = append(msgs, update)
+	}
+
+	if len(msgs) == 0 {
+		return 0, nil
+	}
+
+	// Messages can only be sent once the peer is active.
+	select {
+	case <-peer.activeSignal:
+	case <-peer.quit:
+		return 0, ErrPeerNotConnected
+	case <-s.quit:
+		return 0, ErrServerShuttingDown
+	}
+
+	// Gossip messages are sent as low priority messages, like the ones
+	// of the gossip syncers.
+	if err := peer.SendMessageLazy(true, msgs...); err != nil {
+		return 0, err
+	}
+
+	return len(msgs), nil
+}
+
+// SubscribeCustomMessages returns a client that receives the custom messages
+// sent by our peers, as CustomMessage updates.
+//
+// NOTE: This function is safe for concurrent access.
+func (s *server) SubscribeCustomMessages() (*subscribe.Client, error) {
+	return s.customMessageServer.Subscribe()
+}
+
+// handleCustomMessage dispatches a custom message received from the peer with
+// the passed public key to the subscribers of custom messages.
+func (s *server) handleCustomMessage(peerPub [33]byte,
+	msg *lnwire.Custom) error {
+
+	return s.customMessageServer.SendUpdate(&CustomMessage{
+		Peer: peerPub,
+		Msg:  msg,
+	})
+}
+
 // FindPeer will return the peer that corresponds to the passed in public key.
 // This function is used by the funding manager, allowing it to update the
 // daemon's local representation of the remote peer.
@@ -2391,6 +3348,14 @@ func (s *server) FindPeerByPubStr(pubStr string) (*peer, error) {
 	return s.findPeerByPubStr(pubStr)
 }
 
+// IsPeerOnline returns true if we're currently connected to the given peer.
+//
+// NOTE: This function is safe for concurrent access.
+func (s *server) IsPeerOnline(peer route.Vertex) bool {
+	_, err := s.FindPeerByPubStr(string(peer[:]))
+	return err == nil
+}
+
 // findPeerByPubStr is an internal method that retrieves the specified peer from
 // the server's internal state using.
 func (s *server) findPeerByPubStr(pubStr string) (*peer, error) {
@@ -2408,18 +3373,22 @@ func (s *server) findPeerByPubStr(pubStr string) (*peer, error) {
 func (s *server) nextPeerBackoff(pubStr string,
 	startTime time.Time) time.Duration {
 
+	// The backoff bounds may be overridden by the peer's connection
+	// policy.
+	minBackoff, maxBackoff := s.peerBackoffBounds(pubStr)
+
 	// Now, determine the appropriate backoff to use for the retry.
 	backoff, ok := s.persistentPeersBackoff[pubStr]
 	if !ok {
 		// If an existing backoff was unknown, use the default.
-		return cfg.MinBackoff
+		return minBackoff
 	}
 
 	// If the peer failed to start properly, we'll just use the previous
 	// backoff to compute the subsequent randomized exponential backoff
 	// duration. This will roughly double on average.
 	if startTime.IsZero() {
-		return computeNextBackoff(backoff)
+		return computeNextBackoff(backoff, maxBackoff)
 	}
 
 	// The peer succeeded in starting. If the connection didn't last long
@@ -2427,24 +3396,117 @@ func (s *server) nextPeerBackoff(pubStr string,
 	// with this peer.
 	connDuration := time.Since(startTime)
 	if connDuration < defaultStableConnDuration {
-		return computeNextBackoff(backoff)
+		return computeNextBackoff(backoff, maxBackoff)
 	}
 
 	// The peer succeed in starting and this was stable peer, so we'll
 	// reduce the timeout duration by the length of the connection after
 	// applying randomized exponential backoff. We'll only apply this in the
 	// case that:
-	//   reb(curBackoff) - connDuration > cfg.MinBackoff
-	relaxedBackoff := computeNextBackoff(backoff) - connDuration
-	if relaxedBackoff > cfg.MinBackoff {
+	//   reb(curBackoff) - connDuration > minBackoff
+	relaxedBackoff := computeNextBackoff(backoff, maxBackoff) - connDuration
+	if relaxedBackoff > minBackoff {
 		return relaxedBackoff
 	}
 
-	// Lastly, if reb(currBackoff) - connDuration <= cfg.MinBackoff, meaning
+	// Lastly, if reb(currBackoff) - connDuration <= minBackoff, meaning
 	// the stable connection lasted much longer than our previous backoff.
 	// To reward such good behavior, we'll reconnect after the default
 	// timeout.
-	return cfg.MinBackoff
+	return minBackoff
+}
+
+// peerReconnectPolicy returns the reconnect policy configured for the peer
+// with the given pubkey string.
+//
+// NOTE: This function must be called with the server mutex held.
+func (s *server) peerReconnectPolicy(pubStr string) channeldb.ReconnectPolicy {
+	policy, ok := s.peerConnPolicies[pubStr]
+	if !ok {
+		return channeldb.ReconnectDefault
+	}
+
+	return policy.Reconnect
+}
+
+// peerBackoffBounds returns the minimum and maximum reconnection backoff to
+// use for the peer with the given pubkey string, taking its connection policy
+// into account.
+//
+// NOTE: This function must be called with the server mutex held.
+func (s *server) peerBackoffBounds(pubStr string) (time.Duration,
+	time.Duration) {
+
+	minBackoff, maxBackoff := cfg.MinBackoff, cfg.MaxBackoff
+	if policy, ok := s.peerConnPolicies[pubStr]; ok {
+		if policy.MinBackoff != 0 {
+			minBackoff = policy.MinBackoff
+		}
+		if policy.MaxBackoff != 0 {
+			maxBackoff = policy.MaxBackoff
+		}
+	}
+
+	// A custom minimum above the global maximum, or the other way around,
+	// shouldn't result in a maximum below the minimum.
+	if maxBackoff < minBackoff {
+		maxBackoff = minBackoff
+	}
+
+	return minBackoff, maxBackoff
+}
+
+// SetPeerConnPolicy persists the connection policy of the peer identified by
+// the given compressed public key and applies it to any existing persistent
+// connection state of that peer.
+func (s *server) SetPeerConnPolicy(pubKey [33]byte,
+	policy *channeldb.PeerConnPolicy) error {
+
+	if err := s.chanDB.PutPeerConnPolicy(pubKey, policy); err != nil {
+		return err
+	}
+
+	pubStr := string(pubKey[:])
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if policy.IsDefault() {
+		delete(s.peerConnPolicies, pubStr)
+	} else {
+		s.peerConnPolicies[pubStr] = policy
+	}
+
+	switch policy.Reconnect {
+	// The peer should be reconnected to regardless of whether we have
+	// channels with it, so we'll mark it as a permanent peer.
+	case channeldb.ReconnectAlways:
+		s.persistentPeers[pubStr] = true
+
+	// Any pending reconnection attempts to the peer are canceled, and it
+	// is no longer considered a persistent peer.
+	case channeldb.ReconnectNever:
+		delete(s.persistentPeers, pubStr)
+		delete(s.persistentPeersBackoff, pubStr)
+		s.cancelConnReqs(pubStr, nil)
+	}
+
+	// Ensure a pending backoff is within the new bounds.
+	if backoff, ok := s.persistentPeersBackoff[pubStr]; ok {
+		minBackoff, maxBackoff := s.peerBackoffBounds(pubStr)
+		switch {
+		case backoff < minBackoff:
+			s.persistentPeersBackoff[pubStr] = minBackoff
+		case backoff > maxBackoff:
+			s.persistentPeersBackoff[pubStr] = maxBackoff
+		}
+	}
+
+	srvrLog.Infof("Set connection policy of peer %x to reconnect=%v, "+
+		"min_backoff=%v, max_backoff=%v", pubKey[:], policy.Reconnect,
+		policy.MinBackoff, policy.MaxBackoff)
+
+	return nil
 }
 
 // shouldDropConnection determines if our local connection to a remote peer
@@ -2728,12 +3790,7 @@ func (s *server) peerConnected(conn net.Conn, connReq *connmgr.ConnReq,
 
 	// With the brontide connection established, we'll now craft the local
 	// feature vector to advertise to the remote node.
-	localFeatures := lnwire.NewRawFeatureVector()
-
-	// We'll signal that we understand the data loss protection feature,
-	// and also that we support the new gossip query features.
-	localFeatures.Set(lnwire.DataLossProtectRequired)
-	localFeatures.Set(lnwire.GossipQueriesOptional)
+	localFeatures := s.featureMgr.GetRaw(feature.SetInit)
 
 	// Now that we've established a connection, create a peer, and it to the
 	// set of currently active peers. Configure the peer with the incoming
@@ -2743,7 +3800,7 @@ func (s *server) peerConnected(conn net.Conn, connReq *connmgr.ConnReq,
 	// closed when the htlc is outstanding and a new block comes in.
 	p, err := newPeer(
 		conn, connReq, s, peerAddr, inbound, localFeatures,
-		cfg.ChanEnableTimeout,
+		cfg.ChanEnableTimeout, cfg.PeerInitTimeout,
 		defaultOutgoingCltvRejectDelta,
 	)
 	if err != nil {
@@ -2842,7 +3899,16 @@ func (s *server) peerInitializer(p *peer) {
 	// was successful, and to begin watching the peer's wait group.
 	close(ready)
 
-	pubStr := string(p.addr.IdentityKey.SerializeCompressed())
+	pubSer := p.addr.IdentityKey.SerializeCompressed()
+	pubStr := string(pubSer)
+
+	// Inform the peer notifier that the peer has completed the exchange of
+	// init messages, so that it can be reported to clients listening for
+	// peer events.
+	var pubKey [33]byte
+	copy(pubKey[:], pubSer)
+
+	s.peerNotifier.NotifyPeerActive(pubKey)
 
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -2946,7 +4012,17 @@ func (s *server) peerTerminationWatcher(p *peer, ready chan struct{}) {
 	// in question.
 	s.removePeer(p)
 
-	// Next, check to see if this is a persistent peer or not.
+	// Next, check to see if this is a persistent peer or not, taking the
+	// peer's connection policy into account.
+	switch s.peerReconnectPolicy(pubStr) {
+	case channeldb.ReconnectAlways:
+		s.persistentPeers[pubStr] = true
+
+	case channeldb.ReconnectNever:
+		srvrLog.Debugf("Not reconnecting to peer %v due to its "+
+			"connection policy", p)
+		return
+	}
 	_, ok := s.persistentPeers[pubStr]
 	if ok {
 		// We'll only need to re-launch a connection request if one
@@ -2955,33 +4031,33 @@ func (s *server) peerTerminationWatcher(p *peer, ready chan struct{}) {
 			return
 		}
 
-		// We'll ensure that we locate an advertised address to use
-		// within the peer's address for reconnection purposes.
-		//
-		// TODO(roasbeef): use them all?
-		if p.inbound {
-			advertisedAddr, err := s.fetchNodeAdvertisedAddr(
-				pubKey,
-			)
-			if err != nil {
-				srvrLog.Errorf("Unable to retrieve advertised "+
-					"address for node %x: %v",
-					pubKey.SerializeCompressed(), err)
-
-				// Do not attempt to re-connect if the only
-				// address we have for this peer was due to an
-				// inbound connection, since this is unlikely
-				// to succeed.
-				return
-			}
+		// We'll locate the addresses to reconnect to, falling back
+		// from the address we were last connected to onto all the
+		// addresses advertised by the peer.
+		var lastAddr net.Addr
+		if !p.inbound {
+			lastAddr = p.addr.Address
+		}
+		addr, err := s.reconnectAddr(pubKey, lastAddr)
+		if err != nil {
+			srvrLog.Errorf("Unable to retrieve advertised "+
+				"address for node %x: %v",
+				pubKey.SerializeCompressed(), err)
 
-			p.addr.Address = advertisedAddr
+			// Do not attempt to re-connect if the only address we
+			// have for this peer was due to an inbound connection,
+			// since this is unlikely to succeed.
+			return
 		}
 
 		// Otherwise, we'll launch a new connection request in order to
 		// attempt to maintain a persistent connection with this peer.
 		connReq := &connmgr.ConnReq{
-			Addr:      p.addr,
+			Addr: &lnwire.NetAddress{
+				IdentityKey: p.addr.IdentityKey,
+				Address:     addr,
+				ChainNet:    p.addr.ChainNet,
+			},
 			Permanent: true,
 		}
 		s.persistentConnReqs[pubStr] = append(
@@ -3049,6 +4125,7 @@ func (s *server) removePeer(p *peer) {
 	pubStr := string(pubSer)
 
 	delete(s.peersByPub, pubStr)
+	delete(s.bootstrapSources, pubStr)
 
 	if p.inbound {
 		delete(s.inboundPeers, pubStr)
@@ -3057,11 +4134,12 @@ func (s *server) removePeer(p *peer) {
 	}
 
 	// Inform the peer notifier of a peer offline event so that it can be
-	// reported to clients listening for peer events.
+	// reported to clients listening for peer events, along with the reason
+	// the peer was disconnected for.
 	var pubKey [33]byte
 	copy(pubKey[:], pubSer)
 
-	s.peerNotifier.NotifyPeerOffline(pubKey)
+	s.peerNotifier.NotifyPeerOffline(pubKey, p.DisconnectReason())
 }
 
 // openChanReq is a message sent to the server in order to request the
@@ -3089,6 +4167,20 @@ type openChanReq struct {
 	// output selected to fund the channel should satisfy.
 	minConfs int32
 
+	// batch is set if this channel is funded together with other channels
+	// within a single funding transaction.
+	batch *fundingBatch
+
+	// shutdownScript is an optional script our funds are paid to upon a
+	// cooperative close of the channel. If set, it is committed to during
+	// the funding workflow, and can't be changed afterwards.
+	shutdownScript lnwire.DeliveryAddress
+
+	// zeroConf indicates that the channel should be usable before its
+	// funding transaction confirms. The remote peer must support and
+	// accept zero-conf channels from us.
+	zeroConf bool
+
 	// TODO(roasbeef): add ability to specify channel constraints as well
 
 	updates chan *lnrpc.OpenStatusUpdate
@@ -3143,7 +4235,8 @@ func (s *server) ConnectToPeer(addr *lnwire.NetAddress, perm bool) error {
 		// zero.
 		s.persistentPeers[targetPub] = true
 		if _, ok := s.persistentPeersBackoff[targetPub]; !ok {
-			s.persistentPeersBackoff[targetPub] = cfg.MinBackoff
+			minBackoff, _ := s.peerBackoffBounds(targetPub)
+			s.persistentPeersBackoff[targetPub] = minBackoff
 		}
 		s.persistentConnReqs[targetPub] = append(
 			s.persistentConnReqs[targetPub], connReq,
@@ -3302,6 +4395,132 @@ func (s *server) Peers() []*peer {
 	return peers
 }
 
+// nodeMetricSources returns the sources of the node metrics exported to
+// Prometheus.
+func (s *server) nodeMetricSources() *monitoring.NodeMetricSources {
+	return &monitoring.NodeMetricSources{
+		HtlcNotifier: s.htlcNotifier,
+		NumPeers: func() int {
+			return len(s.Peers())
+		},
+		DBBucketStats: s.chanDB.BucketStats,
+		BestHeight: func() (int32, error) {
+			_, height, err := s.cc.chainIO.GetBestBlock()
+			return height, err
+		},
+		IsSynced: func() (bool, error) {
+			synced, _, err := s.cc.wallet.IsSynced()
+			return synced, err
+		},
+	}
+}
+
+// newLivelinessMonitor returns the monitor running the health checks of the
+// subsystems we rely on, according to the given config.
+func (s *server) newLivelinessMonitor(
+	healthCfg *lncfg.HealthCheckConfig) *healthcheck.Monitor {
+
+	newObservation := func(name string, check func() error,
+		checkCfg *lncfg.CheckConfig) *healthcheck.Observation {
+
+		return healthcheck.NewObservation(
+			name, check, checkCfg.Interval, checkCfg.Timeout,
+			checkCfg.Backoff, checkCfg.Attempts,
+			checkCfg.IsCritical(),
+		)
+	}
+
+	chainCheck := newObservation("chain backend", func() error {
+		_, _, err := s.cc.chainIO.GetBestBlock()
+		return err
+	}, healthCfg.ChainCheck)
+
+	diskCheck := newObservation("disk space", func() error {
+		free, err := healthcheck.AvailableDiskSpaceRatio(cfg.DataDir)
+		if err != nil {
+			return err
+		}
+
+		if free < healthCfg.DiskCheck.RequiredRemaining {
+			return fmt.Errorf("available disk space ratio %.3f "+
+				"below required %.3f", free,
+				healthCfg.DiskCheck.RequiredRemaining)
+		}
+
+		return nil
+	}, healthCfg.DiskCheck.CheckConfig)
+
+	walletSyncCheck := newObservation("wallet sync", func() error {
+		synced, _, err := s.cc.wallet.IsSynced()
+		if err != nil {
+			return err
+		}
+		if !synced {
+			return errors.New("wallet not synced to the chain")
+		}
+
+		return nil
+	}, healthCfg.WalletSyncCheck)
+
+	checks := []*healthcheck.Observation{
+		chainCheck, diskCheck, walletSyncCheck,
+	}
+
+	// The connection to the Tor server is only checked if we use it to
+	// create our onion services.
+	if s.torController != nil {
+		torCheck := newObservation(
+			"tor connection", s.torController.CheckConnection,
+			healthCfg.TorCheck,
+		)
+		checks = append(checks, torCheck)
+	}
+
+	// The connection to the wallet is only checked if it's a remote one.
+	if s.cc.remoteWalletCheck != nil {
+		remoteWalletCheck := newObservation(
+			"remote wallet", s.cc.remoteWalletCheck,
+			healthCfg.RemoteWalletCheck,
+		)
+		checks = append(checks, remoteWalletCheck)
+	}
+
+	return healthcheck.NewMonitor(&healthcheck.Config{
+		Checks: checks,
+		Shutdown: func(format string, params ...interface{}) {
+			srvrLog.Criticalf("Health check: "+format, params...)
+			signal.RequestShutdown()
+		},
+	})
+}
+
+// newPeerAllowList parses the hex encoded public keys of the passed peers, and
+// returns a predicate matching only them. If no peers are passed, a nil
+// predicate is returned.
+func newPeerAllowList(peers []string) (func(*secp256k1.PublicKey) bool, error) {
+	if len(peers) == 0 {
+		return nil, nil
+	}
+
+	allowed := make(map[string]struct{}, len(peers))
+	for _, peer := range peers {
+		pubKeyBytes, err := hex.DecodeString(peer)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %v", peer, err)
+		}
+		pubKey, err := secp256k1.ParsePubKey(pubKeyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %v", peer, err)
+		}
+		allowed[string(pubKey.SerializeCompressed())] = struct{}{}
+	}
+
+	return func(peerKey *secp256k1.PublicKey) bool {
+		_, ok := allowed[string(peerKey.SerializeCompressed())]
+		return ok
+	}, nil
+}
+
 // parseHexColor takes a hex string representation of a color in the
 // form "#RRGGBB", parses the hex color values, and returns a color.RGBA
 // struct of the same color.
@@ -3323,14 +4542,14 @@ func parseHexColor(colorStr string) (color.RGBA, error) {
 }
 
 // computeNextBackoff uses a truncated exponential backoff to compute the next
-// backoff using the value of the exiting backoff. The returned duration is
-// randomized in either direction by 1/20 to prevent tight loops from
-// stabilizing.
-func computeNextBackoff(currBackoff time.Duration) time.Duration {
+// backoff using the value of the exiting backoff, truncated to maxBackoff. The
+// returned duration is randomized in either direction by 1/20 to prevent tight
+// loops from stabilizing.
+func computeNextBackoff(currBackoff, maxBackoff time.Duration) time.Duration {
 	// Double the current backoff, truncating if it exceeds our maximum.
 	nextBackoff := 2 * currBackoff
-	if nextBackoff > cfg.MaxBackoff {
-		nextBackoff = cfg.MaxBackoff
+	if nextBackoff > maxBackoff {
+		nextBackoff = maxBackoff
 	}
 
 	// Using 1/10 of our duration as a margin, compute a random offset to
@@ -3350,18 +4569,56 @@ func computeNextBackoff(currBackoff time.Duration) time.Duration {
 	return nextBackoff + (time.Duration(wiggle.Uint64()) - margin/2)
 }
 
-// fetchNodeAdvertisedAddr attempts to fetch an advertised address of a node.
-func (s *server) fetchNodeAdvertisedAddr(pub *secp256k1.PublicKey) (net.Addr, error) {
+// reconnectAddr returns the address to reconnect to the given peer at. The
+// last address we were connected to, if any, is attempted first, followed by
+// all the addresses advertised by the peer ordered by preference. Onion
+// addresses are only included if Tor is active.
+func (s *server) reconnectAddr(pub *secp256k1.PublicKey,
+	lastAddr net.Addr) (net.Addr, error) {
+
+	var addrs []net.Addr
+	if lastAddr != nil {
+		addrs = append(addrs, lastAddr)
+	}
+
 	node, err := s.chanDB.ChannelGraph().FetchLightningNode(pub)
-	if err != nil {
+	switch {
+	case err == nil:
+		known := make(map[string]struct{})
+		if lastAddr != nil {
+			known[lastAddr.String()] = struct{}{}
+		}
+
+		advertised := netann.SortAddrs(
+			node.Addresses, cfg.Tor.PreferOnion,
+		)
+		for _, addr := range advertised {
+			_, isOnion := addr.(*tor.OnionAddr)
+			if isOnion && !cfg.Tor.Active {
+				continue
+			}
+			if _, ok := known[addr.String()]; ok {
+				continue
+			}
+
+			known[addr.String()] = struct{}{}
+			addrs = append(addrs, addr)
+		}
+
+	case err != channeldb.ErrGraphNodeNotFound:
 		return nil, err
 	}
 
-	if len(node.Addresses) == 0 {
+	switch len(addrs) {
+	case 0:
 		return nil, errors.New("no advertised addresses found")
-	}
 
-	return node.Addresses[0], nil
+	case 1:
+		return addrs[0], nil
+
+	default:
+		return &fallbackAddr{addrs: addrs}, nil
+	}
 }
 
 // fetchLastChanUpdate returns a function which is able to retrieve our latest
@@ -3397,13 +4654,12 @@ func (s *server) applyChannelUpdate(update *lnwire.ChannelUpdate) error {
 
 // newSweepPkScriptGen creates closure that generates a new public key script
 // which should be used to sweep any funds into the on-chain wallet.
-// Specifically, the script generated is a version 0, pay-to-witness-pubkey-hash
-// (p2wkh) output.
-func newSweepPkScriptGen(
-	wallet lnwallet.WalletController) func() ([]byte, error) {
+// Specifically, the script generated pays to an address of the given type.
+func newSweepPkScriptGen(wallet lnwallet.WalletController,
+	addrType lnwallet.AddressType) func() ([]byte, error) {
 
 	return func() ([]byte, error) {
-		sweepAddr, err := wallet.NewAddress(lnwallet.PubKeyHash, false)
+		sweepAddr, err := wallet.NewAddress(addrType, false)
 		if err != nil {
 			return nil, err
 		}
@@ -11,12 +11,18 @@ import (
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/pem"
+	"errors"
 	"io/ioutil"
 	"math/big"
 	"net"
 	"os"
+	"reflect"
 	"testing"
 	"time"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v2"
+	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/tor"
 )
 
 func TestParseHexColor(t *testing.T) {
@@ -196,3 +202,64 @@ func genExpiredCertPair(t *testing.T, certDirPath string) ([]byte, []byte) {
 
 	return certDerBytes, keyBytes
 }
+
+// TestFallbackAddrDial asserts that dialing a fallbackAddr attempts each of its
+// addresses in turn, until a connection is established.
+func TestFallbackAddrDial(t *testing.T) {
+	t.Parallel()
+
+	privKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	pubKey := privKey.PubKey()
+
+	ipAddr := &net.TCPAddr{IP: net.ParseIP("1.2.3.4"), Port: 9735}
+	onionAddr := &tor.OnionAddr{
+		OnionService: "3g2upl4pq6kufc4m.onion",
+		Port:         9735,
+	}
+	lnAddr := &lnwire.NetAddress{
+		IdentityKey: pubKey,
+		Address: &fallbackAddr{
+			addrs: []net.Addr{ipAddr, onionAddr},
+		},
+	}
+
+	// The connection can only be established over the onion address, which
+	// must be attempted after the clearnet one.
+	var dialed []net.Addr
+	dial := func(addr *lnwire.NetAddress) (net.Conn, error) {
+		if addr.IdentityKey != pubKey {
+			t.Fatalf("unexpected identity key %x",
+				addr.IdentityKey.SerializeCompressed())
+		}
+
+		dialed = append(dialed, addr.Address)
+		if addr.Address != onionAddr {
+			return nil, errors.New("unreachable")
+		}
+
+		conn, _ := net.Pipe()
+		return conn, nil
+	}
+
+	fallback := lnAddr.Address.(*fallbackAddr)
+	conn, err := fallback.dial(lnAddr, dial)
+	if err != nil {
+		t.Fatalf("unable to dial: %v", err)
+	}
+	conn.Close()
+
+	expDialed := []net.Addr{ipAddr, onionAddr}
+	if !reflect.DeepEqual(dialed, expDialed) {
+		t.Fatalf("expected addresses %v to be dialed, got %v",
+			expDialed, dialed)
+	}
+
+	// If none of the addresses is reachable, dialing must fail.
+	fallback.addrs = []net.Addr{ipAddr}
+	if _, err := fallback.dial(lnAddr, dial); err == nil {
+		t.Fatalf("expected dialing unreachable addresses to fail")
+	}
+}
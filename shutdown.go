@@ -0,0 +1,49 @@
+package dcrlnd
+
+import (
+	"time"
+
+	"github.com/decred/dcrlnd/htlcswitch"
+)
+
+const (
+	// defaultShutdownDrainTimeout is the default maximum duration the
+	// in-flight HTLCs are waited on when shutting down.
+	defaultShutdownDrainTimeout = 30 * time.Second
+)
+
+// drainForShutdown is the first step of the shutdown sequence of the server.
+// All links are put into drain mode, so that no new HTLC is accepted, and the
+// in-flight ones are given up to the configured drain timeout to be resolved.
+// The channel database is then synced to disk, before the subsystems are
+// stopped and the peers disconnected. The HTLCs still in-flight once the
+// timeout expires are resolved on the next start, just as they would be after
+// an abrupt teardown.
+func (s *server) drainForShutdown() {
+	if cfg.ShutdownDrainTimeout == 0 {
+		return
+	}
+
+	srvrLog.Infof("Draining in-flight HTLCs for up to %v before shutting "+
+		"down", cfg.ShutdownDrainTimeout)
+
+	start := time.Now()
+	err := s.htlcSwitch.DrainAllLinks(cfg.ShutdownDrainTimeout)
+	switch err {
+	case nil:
+		srvrLog.Infof("In-flight HTLCs drained in %v",
+			time.Since(start))
+
+	case htlcswitch.ErrLinkDrainTimeout:
+		srvrLog.Warnf("In-flight HTLCs not drained after %v, the "+
+			"remaining ones will be resolved on the next start",
+			cfg.ShutdownDrainTimeout)
+
+	default:
+		srvrLog.Warnf("Unable to drain in-flight HTLCs: %v", err)
+	}
+
+	if err := s.chanDB.Sync(); err != nil {
+		srvrLog.Warnf("Unable to sync channel database: %v", err)
+	}
+}
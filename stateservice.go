@@ -0,0 +1,106 @@
+package dcrlnd
+
+import (
+	"context"
+	"sync"
+
+	"github.com/decred/dcrlnd/lnrpc"
+)
+
+// stateService tracks the lifecycle state of the daemon. The state is served
+// through the State RPC service of each of the gRPC servers successively
+// started by the daemon, from the wallet unlocker to the main RPC server.
+type stateService struct {
+	mtx   sync.Mutex
+	state lnrpc.DaemonState
+
+	// changed is closed, then replaced, each time the state changes.
+	changed chan struct{}
+}
+
+// newStateService returns a state service in the WAITING_TO_START state.
+func newStateService() *stateService {
+	return &stateService{
+		state:   lnrpc.DaemonState_WAITING_TO_START,
+		changed: make(chan struct{}),
+	}
+}
+
+// setState moves the daemon to the given state, notifying the subscribers.
+func (s *stateService) setState(state lnrpc.DaemonState) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if state == s.state {
+		return
+	}
+
+	ltndLog.Debugf("Daemon state changed from %v to %v", s.state, state)
+
+	s.state = state
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+// currentState returns the current state, along with a channel closed once it
+// changes.
+func (s *stateService) currentState() (lnrpc.DaemonState, <-chan struct{}) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.state, s.changed
+}
+
+// newServer returns an implementation of the State RPC service to register
+// with a gRPC server. The subscriptions made through it end once quit is
+// closed, so that the gRPC server can be stopped gracefully.
+func (s *stateService) newServer(quit <-chan struct{}) lnrpc.StateServer {
+	return &stateServer{
+		svc:  s,
+		quit: quit,
+	}
+}
+
+// stateServer serves the state of a state service over a single gRPC server.
+type stateServer struct {
+	svc  *stateService
+	quit <-chan struct{}
+}
+
+// A compile-time check to ensure that stateServer fully implements the
+// StateServer gRPC service.
+var _ lnrpc.StateServer = (*stateServer)(nil)
+
+// SubscribeState streams the lifecycle state of the daemon, starting with the
+// current one and then each time it changes.
+func (s *stateServer) SubscribeState(_ *lnrpc.SubscribeStateRequest,
+	stream lnrpc.State_SubscribeStateServer) error {
+
+	for {
+		state, changed := s.svc.currentState()
+		err := stream.Send(&lnrpc.SubscribeStateResponse{
+			State: state,
+		})
+		if err != nil {
+			return err
+		}
+
+		select {
+		case <-changed:
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-s.quit:
+			return nil
+		}
+	}
+}
+
+// GetState returns the current lifecycle state of the daemon.
+func (s *stateServer) GetState(_ context.Context,
+	_ *lnrpc.GetStateRequest) (*lnrpc.GetStateResponse, error) {
+
+	state, _ := s.svc.currentState()
+	return &lnrpc.GetStateResponse{
+		State: state,
+	}, nil
+}
@@ -0,0 +1,103 @@
+// +build !rpctest
+
+package dcrlnd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrlnd/lnrpc"
+	"google.golang.org/grpc"
+)
+
+// mockStateStream is a mock of the stream of a state subscription.
+type mockStateStream struct {
+	grpc.ServerStream
+
+	ctx     context.Context
+	updates chan lnrpc.DaemonState
+}
+
+func (m *mockStateStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockStateStream) Send(resp *lnrpc.SubscribeStateResponse) error {
+	m.updates <- resp.State
+	return nil
+}
+
+// TestStateService asserts that the subscribers of the state service receive
+// the current state and its changes, until the server they subscribed to
+// quits.
+func TestStateService(t *testing.T) {
+	t.Parallel()
+
+	svc := newStateService()
+	quit := make(chan struct{})
+	server := svc.newServer(quit)
+
+	stream := &mockStateStream{
+		ctx:     context.Background(),
+		updates: make(chan lnrpc.DaemonState),
+	}
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- server.SubscribeState(
+			&lnrpc.SubscribeStateRequest{}, stream,
+		)
+	}()
+
+	assertState := func(expected lnrpc.DaemonState) {
+		t.Helper()
+
+		select {
+		case state := <-stream.updates:
+			if state != expected {
+				t.Fatalf("expected state %v, got %v", expected,
+					state)
+			}
+		case <-time.After(time.Second * 5):
+			t.Fatalf("state %v not received", expected)
+		}
+
+		resp, err := server.GetState(
+			context.Background(), &lnrpc.GetStateRequest{},
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.State != expected {
+			t.Fatalf("expected current state %v, got %v", expected,
+				resp.State)
+		}
+	}
+
+	assertState(lnrpc.DaemonState_WAITING_TO_START)
+
+	// Setting the current state again doesn't notify the subscribers.
+	svc.setState(lnrpc.DaemonState_WAITING_TO_START)
+	svc.setState(lnrpc.DaemonState_UNLOCKED)
+	assertState(lnrpc.DaemonState_UNLOCKED)
+
+	// The subscription ends once the server quits.
+	close(quit)
+	select {
+	case err := <-errChan:
+		if err != nil {
+			t.Fatalf("expected subscription to end, got %v", err)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatal("subscription didn't end")
+	}
+
+	// The state keeps being tracked for the subscribers of the next
+	// server.
+	svc.setState(lnrpc.DaemonState_ACTIVE)
+	state, _ := svc.currentState()
+	if state != lnrpc.DaemonState_ACTIVE {
+		t.Fatalf("expected state %v, got %v", lnrpc.DaemonState_ACTIVE,
+			state)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/decred/dcrd/chaincfg/v2"
 	"github.com/decred/dcrlnd/autopilot"
 	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/contractcourt"
 	"github.com/decred/dcrlnd/htlcswitch"
 	"github.com/decred/dcrlnd/invoices"
 	"github.com/decred/dcrlnd/lncfg"
@@ -15,12 +16,15 @@ import (
 	"github.com/decred/dcrlnd/lnrpc/invoicesrpc"
 	"github.com/decred/dcrlnd/lnrpc/routerrpc"
 	"github.com/decred/dcrlnd/lnrpc/signrpc"
+	"github.com/decred/dcrlnd/lnrpc/swaprpc"
 	"github.com/decred/dcrlnd/lnrpc/walletrpc"
 	"github.com/decred/dcrlnd/lnrpc/watchtowerrpc"
 	"github.com/decred/dcrlnd/lnrpc/wtclientrpc"
+	"github.com/decred/dcrlnd/lnwire"
 	"github.com/decred/dcrlnd/macaroons"
 	"github.com/decred/dcrlnd/netann"
 	"github.com/decred/dcrlnd/routing"
+	"github.com/decred/dcrlnd/routing/route"
 	"github.com/decred/dcrlnd/sweep"
 	"github.com/decred/dcrlnd/watchtower"
 	"github.com/decred/dcrlnd/watchtower/wtclient"
@@ -71,6 +75,10 @@ type subRPCServerConfigs struct {
 	// instance within lnd in order to add, remove, list registered client
 	// towers, etc.
 	WatchtowerClientRPC *wtclientrpc.Config `group:"wtclientrpc" namespace:"wtclientrpc"`
+
+	// SwapRPC is an experimental sub-RPC server that exposes the on-chain
+	// primitives required to perform submarine swaps.
+	SwapRPC *swaprpc.Config `group:"swaprpc" namespace:"swaprpc"`
 }
 
 // PopulateDependencies attempts to iterate through all the sub-server configs
@@ -84,15 +92,20 @@ func (s *subRPCServerConfigs) PopulateDependencies(cc *chainControl,
 	atpl *autopilot.Manager,
 	invoiceRegistry *invoices.InvoiceRegistry,
 	htlcSwitch *htlcswitch.Switch,
+	htlcNotifier *htlcswitch.HtlcNotifier,
 	activeNetParams *chaincfg.Params,
 	chanRouter *routing.ChannelRouter,
 	routerBackend *routerrpc.RouterBackend,
 	nodeSigner *netann.NodeSigner,
+	chanStatusMgr *netann.ChanStatusManager,
+	isPeerOnline func(route.Vertex) bool,
 	chanDB *channeldb.DB,
 	sweeper *sweep.UtxoSweeper,
 	tower *watchtower.Standalone,
 	towerClient wtclient.Client,
-	tcpResolver lncfg.TCPResolver) error {
+	tcpResolver lncfg.TCPResolver,
+	preimageBeacon contractcourt.WitnessBeacon,
+	invoiceFeatures *lnwire.FeatureVector) error {
 
 	// First, we'll use reflect to obtain a version of the config struct
 	// that allows us to programmatically inspect its fields.
@@ -130,6 +143,9 @@ func (s *subRPCServerConfigs) PopulateDependencies(cc *chainControl,
 			subCfgValue.FieldByName("Signer").Set(
 				reflect.ValueOf(cc.signer),
 			)
+			subCfgValue.FieldByName("KeyRing").Set(
+				reflect.ValueOf(cc.keyRing),
+			)
 
 		case *walletrpc.Config:
 			subCfgValue := extractReflectValue(subCfg)
@@ -155,6 +171,12 @@ func (s *subRPCServerConfigs) PopulateDependencies(cc *chainControl,
 			subCfgValue.FieldByName("Chain").Set(
 				reflect.ValueOf(cc.chainIO),
 			)
+			subCfgValue.FieldByName("ChainParams").Set(
+				reflect.ValueOf(activeNetParams.Params),
+			)
+			subCfgValue.FieldByName("ChanDB").Set(
+				reflect.ValueOf(chanDB),
+			)
 
 		case *autopilotrpc.Config:
 			subCfgValue := extractReflectValue(subCfg)
@@ -191,6 +213,12 @@ func (s *subRPCServerConfigs) PopulateDependencies(cc *chainControl,
 			subCfgValue.FieldByName("IsChannelActive").Set(
 				reflect.ValueOf(htlcSwitch.HasActiveLink),
 			)
+			subCfgValue.FieldByName("IsPeerOnline").Set(
+				reflect.ValueOf(isPeerOnline),
+			)
+			subCfgValue.FieldByName("PeerReliability").Set(
+				reflect.ValueOf(routerBackend.PeerReliability),
+			)
 			subCfgValue.FieldByName("ChainParams").Set(
 				reflect.ValueOf(activeNetParams),
 			)
@@ -207,6 +235,12 @@ func (s *subRPCServerConfigs) PopulateDependencies(cc *chainControl,
 			subCfgValue.FieldByName("ChanDB").Set(
 				reflect.ValueOf(chanDB),
 			)
+			subCfgValue.FieldByName("Features").Set(
+				reflect.ValueOf(invoiceFeatures),
+			)
+			subCfgValue.FieldByName("MinHopHints").Set(
+				reflect.ValueOf(cfg.MinHopHints),
+			)
 
 		case *routerrpc.Config:
 			subCfgValue := extractReflectValue(subCfg)
@@ -223,6 +257,28 @@ func (s *subRPCServerConfigs) PopulateDependencies(cc *chainControl,
 			subCfgValue.FieldByName("RouterBackend").Set(
 				reflect.ValueOf(routerBackend),
 			)
+			subCfgValue.FieldByName("Graph").Set(
+				reflect.ValueOf(chanDB.ChannelGraph()),
+			)
+			subCfgValue.FieldByName("HtlcNotifier").Set(
+				reflect.ValueOf(htlcNotifier),
+			)
+			subCfgValue.FieldByName("Switch").Set(
+				reflect.ValueOf(htlcSwitch),
+			)
+			subCfgValue.FieldByName("SetChannelEnabled").Set(
+				reflect.ValueOf(
+					chanStatusMgr.RequestManualEnable,
+				),
+			)
+			subCfgValue.FieldByName("SetChannelDisabled").Set(
+				reflect.ValueOf(
+					chanStatusMgr.RequestManualDisable,
+				),
+			)
+			subCfgValue.FieldByName("SetChannelAuto").Set(
+				reflect.ValueOf(chanStatusMgr.RequestAuto),
+			)
 
 		case *watchtowerrpc.Config:
 			subCfgValue := extractReflectValue(subCfg)
@@ -249,6 +305,31 @@ func (s *subRPCServerConfigs) PopulateDependencies(cc *chainControl,
 				reflect.ValueOf(tcpResolver),
 			)
 
+		case *swaprpc.Config:
+			subCfgValue := extractReflectValue(subCfg)
+
+			subCfgValue.FieldByName("NetworkDir").Set(
+				reflect.ValueOf(networkDir),
+			)
+			subCfgValue.FieldByName("MacService").Set(
+				reflect.ValueOf(macService),
+			)
+			subCfgValue.FieldByName("KeyRing").Set(
+				reflect.ValueOf(cc.keyRing),
+			)
+			subCfgValue.FieldByName("Sweeper").Set(
+				reflect.ValueOf(sweeper),
+			)
+			subCfgValue.FieldByName("Chain").Set(
+				reflect.ValueOf(cc.chainIO),
+			)
+			subCfgValue.FieldByName("PreimageBeacon").Set(
+				reflect.ValueOf(preimageBeacon),
+			)
+			subCfgValue.FieldByName("ChainParams").Set(
+				reflect.ValueOf(activeNetParams),
+			)
+
 		default:
 			return fmt.Errorf("unknown field: %v, %T", fieldName,
 				cfg)
@@ -36,6 +36,18 @@ const (
 	//   #1: min = 1 atom/KB, max = 10 atom/KB
 	//   #2: min = 11 atom/KB, max = 20 atom/KB...
 	DefaultFeeRateBucketSize = 10
+
+	// DefaultDeadlineExpiryDelta is the default number of blocks during
+	// which a sweep transaction of inputs with a deadline can be mined.
+	// Decred doesn't allow replacing unconfirmed transactions, so these
+	// sweep transactions expire instead, which allows the inputs to be
+	// republished with a higher fee rate once an attempt didn't confirm.
+	DefaultDeadlineExpiryDelta = 3
+
+	// DefaultDeadlineFeeRateBump is the default percentage by which the fee
+	// rate of inputs with a deadline is increased, at a minimum, on each
+	// republish attempt.
+	DefaultDeadlineFeeRateBump = 25
 )
 
 var (
@@ -94,6 +106,15 @@ type pendingInput struct {
 	// lastFeeRate is the most recent fee rate used for this input within a
 	// transaction broadcast to the network.
 	lastFeeRate lnwallet.AtomPerKByte
+
+	// deadline, if non-zero, is the block height by which the input should
+	// be swept. As the deadline nears, the input is republished with
+	// escalating fee rates.
+	deadline int32
+
+	// publishedFeeRate is the fee rate of the most recent sweep transaction
+	// of this input that was successfully published.
+	publishedFeeRate lnwallet.AtomPerKByte
 }
 
 // pendingInputs is a type alias for a set of pending inputs.
@@ -103,9 +124,18 @@ type pendingInputs = map[wire.OutPoint]*pendingInput
 // be swept with the specified fee rate.
 type inputCluster struct {
 	sweepFeeRate lnwallet.AtomPerKByte
+	hasDeadline  bool
 	inputs       pendingInputs
 }
 
+// clusterKey identifies the cluster an input belongs to. Inputs with a deadline
+// are never clustered with inputs without one, as only the sweep transactions
+// of the former expire.
+type clusterKey struct {
+	bucket      lnwallet.AtomPerKByte
+	hasDeadline bool
+}
+
 // pendingSweepsReq is an internal message we'll use to represent an external
 // caller's intent to retrieve all of the pending inputs the UtxoSweeper is
 // attempting to sweep.
@@ -136,6 +166,10 @@ type PendingInput struct {
 	// NextBroadcastHeight is the next height of the chain at which we'll
 	// attempt to broadcast a transaction sweeping the input.
 	NextBroadcastHeight uint32
+
+	// Deadline is the block height by which the input should be swept. Zero
+	// if the input has no deadline.
+	Deadline uint32
 }
 
 // bumpFeeReq is an internal message we'll use to represent an external caller's
@@ -183,8 +217,6 @@ type UtxoSweeper struct {
 
 	currentOutputScript []byte
 
-	relayFeeRate lnwallet.AtomPerKByte
-
 	quit chan struct{}
 	wg   sync.WaitGroup
 }
@@ -256,6 +288,15 @@ type UtxoSweeperConfig struct {
 	//   #1: min = 1 sat/vbyte, max = 10 sat/vbyte
 	//   #2: min = 11 sat/vbyte, max = 20 sat/vbyte...
 	FeeRateBucketSize int
+
+	// DeadlineExpiryDelta is the number of blocks during which a sweep
+	// transaction of inputs with a deadline can be mined before it expires
+	// and the inputs are republished.
+	DeadlineExpiryDelta uint32
+
+	// DeadlineFeeRateBump is the minimum percentage by which the fee rate
+	// of inputs with a deadline is increased on each republish attempt.
+	DeadlineFeeRateBump uint32
 }
 
 // Result is the struct that is pushed through the result channel. Callers can
@@ -276,6 +317,7 @@ type Result struct {
 type sweepInputMessage struct {
 	input         input.Input
 	feePreference FeePreference
+	deadline      int32
 	resultChan    chan Result
 }
 
@@ -324,10 +366,6 @@ func (s *UtxoSweeper) Start() error {
 		}
 	}
 
-	// Retrieve relay fee for dust limit calculation. Assume that this will
-	// not change from here on.
-	s.relayFeeRate = s.cfg.FeeEstimator.RelayFeePerKB()
-
 	// Register for block epochs to retry sweeping every block.
 	bestHash, bestHeight, err := s.cfg.ChainIO.GetBestBlock()
 	if err != nil {
@@ -391,6 +429,19 @@ func (s *UtxoSweeper) Stop() error {
 func (s *UtxoSweeper) SweepInput(input input.Input,
 	feePreference FeePreference) (chan Result, error) {
 
+	return s.SweepInputWithDeadline(input, feePreference, 0)
+}
+
+// SweepInputWithDeadline sweeps an input back into the wallet, like
+// SweepInput, with the additional requirement that the input be swept by the
+// given block height. The fee preference is used for the first sweep attempt,
+// after which the input is republished with escalating fee rates until it
+// confirms. The confirmation target of the fee preference is capped by the
+// number of blocks left until the deadline, and once the deadline is reached,
+// the maximum fee rate is used. A zero deadline is equivalent to SweepInput.
+func (s *UtxoSweeper) SweepInputWithDeadline(input input.Input,
+	feePreference FeePreference, deadline uint32) (chan Result, error) {
+
 	if input == nil || input.OutPoint() == nil || input.SignDesc() == nil {
 		return nil, errors.New("nil input received")
 	}
@@ -401,13 +452,15 @@ func (s *UtxoSweeper) SweepInput(input input.Input,
 	}
 
 	log.Infof("Sweep request received: out_point=%v, witness_type=%v, "+
-		"time_lock=%v, amount=%v, fee_preference=%v", input.OutPoint(),
-		input.WitnessType(), input.BlocksToMaturity(),
-		dcrutil.Amount(input.SignDesc().Output.Value), feePreference)
+		"time_lock=%v, amount=%v, fee_preference=%v, deadline=%v",
+		input.OutPoint(), input.WitnessType(), input.BlocksToMaturity(),
+		dcrutil.Amount(input.SignDesc().Output.Value), feePreference,
+		deadline)
 
 	sweeperInput := &sweepInputMessage{
 		input:         input,
 		feePreference: feePreference,
+		deadline:      int32(deadline),
 		resultChan:    make(chan Result, 1),
 	}
 
@@ -436,9 +489,10 @@ func (s *UtxoSweeper) feeRateForPreference(
 	if err != nil {
 		return 0, err
 	}
-	if feeRate < s.relayFeeRate {
+	relayFeeRate := s.cfg.FeeEstimator.RelayFeePerKB()
+	if feeRate < relayFeeRate {
 		return 0, fmt.Errorf("fee preference resulted in invalid fee "+
-			"rate %v, mininum is %v", feeRate, s.relayFeeRate)
+			"rate %v, mininum is %v", feeRate, relayFeeRate)
 	}
 	if feeRate > s.cfg.MaxFeeRate {
 		return 0, fmt.Errorf("fee preference resulted in invalid fee "+
@@ -448,6 +502,58 @@ func (s *UtxoSweeper) feeRateForPreference(
 	return feeRate, nil
 }
 
+// feeRateForInput returns the fee rate that should be used to sweep the given
+// pending input at the current height. Inputs without a deadline are swept at
+// the fee rate of their fee preference. For inputs with a deadline, the
+// confirmation target is capped by the number of blocks left until the
+// deadline, and each republish attempt escalates the fee rate by at least the
+// configured bump. Once the deadline is reached, the maximum fee rate is used.
+func (s *UtxoSweeper) feeRateForInput(pi *pendingInput,
+	currentHeight int32) (lnwallet.AtomPerKByte, error) {
+
+	if pi.deadline == 0 {
+		return s.feeRateForPreference(pi.feePreference)
+	}
+
+	// The fee preference of the input may further restrict the maximum
+	// fee rate that we're willing to pay to sweep it.
+	maxFeeRate := s.cfg.MaxFeeRate
+	if pi.feePreference.MaxFeeRate != 0 &&
+		pi.feePreference.MaxFeeRate < maxFeeRate {
+
+		maxFeeRate = pi.feePreference.MaxFeeRate
+	}
+
+	blocksLeft := pi.deadline - currentHeight
+	if blocksLeft <= 0 {
+		return maxFeeRate, nil
+	}
+
+	feePreference := pi.feePreference
+	if feePreference.ConfTarget > uint32(blocksLeft) {
+		feePreference.ConfTarget = uint32(blocksLeft)
+	}
+	feeRate, err := s.feeRateForPreference(feePreference)
+	if err != nil {
+		return 0, err
+	}
+
+	// If a previous sweep transaction of this input was published but
+	// didn't confirm, we'll make sure to pay more this time around.
+	if pi.publishedFeeRate != 0 {
+		bump := 100 + lnwallet.AtomPerKByte(s.cfg.DeadlineFeeRateBump)
+		minFeeRate := pi.publishedFeeRate * bump / 100
+		if feeRate < minFeeRate {
+			feeRate = minFeeRate
+		}
+	}
+	if feeRate > maxFeeRate {
+		feeRate = maxFeeRate
+	}
+
+	return feeRate, nil
+}
+
 // collector is the sweeper main loop. It processes new inputs, spend
 // notifications and counts down to publication of the sweep tx.
 func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch,
@@ -470,6 +576,14 @@ func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch,
 				pendInput.listeners = append(
 					pendInput.listeners, input.resultChan,
 				)
+
+				// If the input is now required to be swept by
+				// an earlier deadline, we'll adhere to it.
+				if input.deadline != 0 && (pendInput.deadline == 0 ||
+					input.deadline < pendInput.deadline) {
+
+					pendInput.deadline = input.deadline
+				}
 				continue
 			}
 
@@ -482,6 +596,7 @@ func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch,
 				input:            input.input,
 				minPublishHeight: bestHeight,
 				feePreference:    input.feePreference,
+				deadline:         input.deadline,
 			}
 			s.pendingInputs[outpoint] = pendInput
 
@@ -593,7 +708,7 @@ func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch,
 			// this to ensure any inputs which have had their fee
 			// rate bumped are broadcast first in order enforce the
 			// RBF policy.
-			inputClusters := s.clusterBySweepFeeRate()
+			inputClusters := s.clusterBySweepFeeRate(bestHeight)
 			sort.Slice(inputClusters, func(i, j int) bool {
 				return inputClusters[i].sweepFeeRate >
 					inputClusters[j].sweepFeeRate
@@ -610,11 +725,19 @@ func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch,
 					continue
 				}
 
-				// Sweep selected inputs.
+				// Sweep selected inputs. The sweep
+				// transactions of inputs with a deadline
+				// expire, so they can be republished with a
+				// higher fee rate if they don't confirm.
+				var expiry uint32
+				if cluster.hasDeadline {
+					expiry = uint32(bestHeight) + 1 +
+						s.cfg.DeadlineExpiryDelta
+				}
 				for _, inputs := range inputLists {
 					err := s.sweep(
 						inputs, cluster.sweepFeeRate,
-						bestHeight,
+						expiry, bestHeight,
 					)
 					if err != nil {
 						log.Errorf("Unable to sweep "+
@@ -650,34 +773,38 @@ func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch,
 func (s *UtxoSweeper) bucketForFeeRate(
 	feeRate lnwallet.AtomPerKByte) lnwallet.AtomPerKByte {
 
-	minBucket := s.relayFeeRate + lnwallet.AtomPerKByte(s.cfg.FeeRateBucketSize)
+	relayFeeRate := s.cfg.FeeEstimator.RelayFeePerKB()
+	minBucket := relayFeeRate + lnwallet.AtomPerKByte(s.cfg.FeeRateBucketSize)
 	return lnwallet.AtomPerKByte(
 		math.Ceil(float64(feeRate) / float64(minBucket)),
 	)
 }
 
 // clusterBySweepFeeRate takes the set of pending inputs within the UtxoSweeper
-// and clusters those together with similar fee rates. Each cluster contains a
-// sweep fee rate, which is determined by calculating the average fee rate of
-// all inputs within that cluster.
-func (s *UtxoSweeper) clusterBySweepFeeRate() []inputCluster {
-	bucketInputs := make(map[lnwallet.AtomPerKByte]pendingInputs)
+// and clusters those together with similar fee rates at the current height.
+// Each cluster contains a sweep fee rate, which is determined by calculating
+// the average fee rate of all inputs within that cluster.
+func (s *UtxoSweeper) clusterBySweepFeeRate(currentHeight int32) []inputCluster {
+	bucketInputs := make(map[clusterKey]pendingInputs)
 	inputFeeRates := make(map[wire.OutPoint]lnwallet.AtomPerKByte)
 
 	// First, we'll group together all inputs with similar fee rates. This
 	// is done by determining the fee rate bucket they should belong in.
 	for op, input := range s.pendingInputs {
-		feeRate, err := s.feeRateForPreference(input.feePreference)
+		feeRate, err := s.feeRateForInput(input, currentHeight)
 		if err != nil {
 			log.Warnf("Skipping input %v: %v", op, err)
 			continue
 		}
-		bucket := s.bucketForFeeRate(feeRate)
+		key := clusterKey{
+			bucket:      s.bucketForFeeRate(feeRate),
+			hasDeadline: input.deadline != 0,
+		}
 
-		inputs, ok := bucketInputs[bucket]
+		inputs, ok := bucketInputs[key]
 		if !ok {
 			inputs = make(pendingInputs)
-			bucketInputs[bucket] = inputs
+			bucketInputs[key] = inputs
 		}
 
 		input.lastFeeRate = feeRate
@@ -688,7 +815,7 @@ func (s *UtxoSweeper) clusterBySweepFeeRate() []inputCluster {
 	// We'll then determine the sweep fee rate for each set of inputs by
 	// calculating the average fee rate of the inputs within each set.
 	inputClusters := make([]inputCluster, 0, len(bucketInputs))
-	for _, inputs := range bucketInputs {
+	for key, inputs := range bucketInputs {
 		var sweepFeeRate lnwallet.AtomPerKByte
 		for op := range inputs {
 			sweepFeeRate += inputFeeRates[op]
@@ -696,6 +823,7 @@ func (s *UtxoSweeper) clusterBySweepFeeRate() []inputCluster {
 		sweepFeeRate /= lnwallet.AtomPerKByte(len(inputs))
 		inputClusters = append(inputClusters, inputCluster{
 			sweepFeeRate: sweepFeeRate,
+			hasDeadline:  key.hasDeadline,
 			inputs:       inputs,
 		})
 	}
@@ -715,7 +843,7 @@ func (s *UtxoSweeper) scheduleSweep(currentHeight int32) error {
 
 	// We'll only start our timer once we have inputs we're able to sweep.
 	startTimer := false
-	for _, cluster := range s.clusterBySweepFeeRate() {
+	for _, cluster := range s.clusterBySweepFeeRate(currentHeight) {
 		// Examine pending inputs and try to construct lists of inputs.
 		inputLists, err := s.getInputLists(cluster, currentHeight)
 		if err != nil {
@@ -814,13 +942,17 @@ func (s *UtxoSweeper) getInputLists(cluster inputCluster,
 		}
 	}
 
+	// The relay fee is used for the dust limit calculation. It is queried
+	// for every sweep as the estimator may refresh it over time.
+	relayFeeRate := s.cfg.FeeEstimator.RelayFeePerKB()
+
 	// If there is anything to retry, combine it with the new inputs and
 	// form input sets.
 	var allSets []inputSet
 	if len(retryInputs) > 0 {
 		var err error
 		allSets, err = generateInputPartitionings(
-			append(retryInputs, newInputs...), s.relayFeeRate,
+			append(retryInputs, newInputs...), relayFeeRate,
 			cluster.sweepFeeRate, s.cfg.MaxInputsPerTx,
 		)
 		if err != nil {
@@ -830,7 +962,7 @@ func (s *UtxoSweeper) getInputLists(cluster inputCluster,
 
 	// Create sets for just the new inputs.
 	newSets, err := generateInputPartitionings(
-		newInputs, s.relayFeeRate, cluster.sweepFeeRate,
+		newInputs, relayFeeRate, cluster.sweepFeeRate,
 		s.cfg.MaxInputsPerTx,
 	)
 	if err != nil {
@@ -846,9 +978,10 @@ func (s *UtxoSweeper) getInputLists(cluster inputCluster,
 }
 
 // sweep takes a set of preselected inputs, creates a sweep tx and publishes the
-// tx. The output address is only marked as used if the publish succeeds.
+// tx. The output address is only marked as used if the publish succeeds. A
+// non-zero expiry is set as the expiry height of the sweep tx.
 func (s *UtxoSweeper) sweep(inputs inputSet, feeRate lnwallet.AtomPerKByte,
-	currentHeight int32) error {
+	expiry uint32, currentHeight int32) error {
 
 	// Generate an output script if there isn't an unused script available.
 	if s.currentOutputScript == nil {
@@ -861,8 +994,8 @@ func (s *UtxoSweeper) sweep(inputs inputSet, feeRate lnwallet.AtomPerKByte,
 
 	// Create sweep tx.
 	tx, err := createSweepTx(
-		inputs, s.currentOutputScript, uint32(currentHeight), feeRate,
-		s.cfg.Signer, s.cfg.NetParams,
+		inputs, s.currentOutputScript, uint32(currentHeight), expiry,
+		feeRate, s.cfg.Signer, s.cfg.NetParams,
 	)
 	if err != nil {
 		return fmt.Errorf("create sweep tx: %v", err)
@@ -914,6 +1047,26 @@ func (s *UtxoSweeper) sweep(inputs inputSet, feeRate lnwallet.AtomPerKByte,
 		// Record another publish attempt.
 		pi.publishAttempts++
 
+		// Inputs with a deadline are retried until they're swept. If
+		// the tx was published, we'll wait for it to either confirm or
+		// expire before republishing the input with a higher fee rate.
+		// Otherwise, a conflicting tx is still pending, so we'll retry
+		// on the next block.
+		if pi.deadline != 0 {
+			pi.minPublishHeight = currentHeight + 1
+			if err == nil {
+				pi.publishedFeeRate = feeRate
+				pi.minPublishHeight = int32(expiry) - 1
+			}
+
+			log.Debugf("Rescheduling input %v with deadline %v "+
+				"after %v attempts at height %v",
+				input.PreviousOutPoint, pi.deadline,
+				pi.publishAttempts, pi.minPublishHeight)
+
+			continue
+		}
+
 		// We don't care what the result of the publish call was. Even
 		// if it is published successfully, it can still be that it
 		// needs to be retried. Call NextAttemptDeltaFunc to calculate
@@ -1020,6 +1173,7 @@ func (s *UtxoSweeper) handlePendingSweepsReq(
 			LastFeeRate:         pendingInput.lastFeeRate,
 			BroadcastAttempts:   pendingInput.publishAttempts,
 			NextBroadcastHeight: uint32(pendingInput.minPublishHeight),
+			Deadline:            uint32(pendingInput.deadline),
 		}
 	}
 
@@ -1142,7 +1296,8 @@ func (s *UtxoSweeper) CreateSweepTx(inputs []input.Input, feePref FeePreference,
 	}
 
 	return createSweepTx(
-		inputs, pkScript, currentBlockHeight, feePerKB, s.cfg.Signer, s.cfg.NetParams,
+		inputs, pkScript, currentBlockHeight, 0, feePerKB, s.cfg.Signer,
+		s.cfg.NetParams,
 	)
 }
 
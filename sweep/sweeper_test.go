@@ -149,9 +149,11 @@ func createSweeperTestContext(t *testing.T) *sweeperTestContext {
 			// Use delta func without random factor.
 			return 1 << uint(attempts-1)
 		},
-		NetParams:         chaincfg.RegNetParams(),
-		MaxFeeRate:        DefaultMaxFeeRate,
-		FeeRateBucketSize: DefaultFeeRateBucketSize,
+		NetParams:           chaincfg.RegNetParams(),
+		MaxFeeRate:          DefaultMaxFeeRate,
+		FeeRateBucketSize:   DefaultFeeRateBucketSize,
+		DeadlineExpiryDelta: DefaultDeadlineExpiryDelta,
+		DeadlineFeeRateBump: DefaultDeadlineFeeRateBump,
 	})
 
 	ctx.sweeper.Start()
@@ -1198,3 +1200,59 @@ func TestBumpFeeRBF(t *testing.T) {
 
 	ctx.finish(1)
 }
+
+// TestDeadlineFeeBump ensures that the UtxoSweeper republishes an input with a
+// deadline at escalating fee rates whenever its sweep transaction expires
+// without confirming, and that it doesn't give up on the input.
+func TestDeadlineFeeBump(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	feePref := FeePreference{ConfTarget: 6}
+	baseFeeRate := lnwallet.AtomPerKByte(10000)
+	ctx.estimator.blocksToFee[feePref.ConfTarget] = baseFeeRate
+
+	// The input should be swept within 10 blocks from the current height.
+	deadline := mockChainIOHeight + 10
+	input := createTestInput(
+		dcrutil.AtomsPerCoin, input.CommitmentTimeLock,
+	)
+	sweepResult, err := ctx.sweeper.SweepInputWithDeadline(
+		&input, feePref, uint32(deadline),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The first sweep transaction should use the fee rate of the fee
+	// preference and expire after the configured number of blocks.
+	ctx.tick()
+	sweepTx := ctx.receiveTx()
+	assertTxFeeRate(t, &sweepTx, baseFeeRate, &input)
+	expiry := uint32(mockChainIOHeight) + 1 + DefaultDeadlineExpiryDelta
+	if sweepTx.Expiry != expiry {
+		t.Fatalf("expected expiry %v, got %v", expiry, sweepTx.Expiry)
+	}
+
+	// We'll now have the sweep transaction expire. Once it has, the input
+	// should be republished with a bumped fee rate.
+	ctx.backend.deleteUnconfirmed(sweepTx.TxHash())
+	ctx.notifier.NotifyEpoch(int32(expiry) - 1)
+	ctx.tick()
+	sweepTx = ctx.receiveTx()
+	bumpedFeeRate := baseFeeRate * (100 + DefaultDeadlineFeeRateBump) / 100
+	assertTxFeeRate(t, &sweepTx, bumpedFeeRate, &input)
+
+	// Once the deadline is reached, the maximum fee rate should be used.
+	// As the number of publish attempts reaches the maximum, this also
+	// ensures the UtxoSweeper doesn't give up on inputs with a deadline.
+	ctx.backend.deleteUnconfirmed(sweepTx.TxHash())
+	ctx.notifier.NotifyEpoch(deadline)
+	ctx.tick()
+	sweepTx = ctx.receiveTx()
+	assertTxFeeRate(t, &sweepTx, DefaultMaxFeeRate, &input)
+
+	ctx.backend.mine()
+	ctx.expectResult(sweepResult, nil)
+
+	ctx.finish(1)
+}
@@ -159,8 +159,9 @@ func getPositiveYieldInputs(sweepableInputs []input.Input, maxInputs int,
 }
 
 // createSweepTx builds a signed tx spending the inputs to a the output script.
+// If expiry is non-zero, the tx can't be mined at or after that block height.
 func createSweepTx(inputs []input.Input, outputPkScript []byte,
-	currentBlockHeight uint32, feePerKB lnwallet.AtomPerKByte,
+	currentBlockHeight, expiry uint32, feePerKB lnwallet.AtomPerKByte,
 	signer input.Signer, netParams *chaincfg.Params) (*wire.MsgTx, error) {
 
 	inputs, txSize, csvCount, cltvCount := getSizeEstimate(inputs)
@@ -191,6 +192,7 @@ func createSweepTx(inputs []input.Input, outputPkScript []byte,
 	})
 
 	sweepTx.LockTime = currentBlockHeight
+	sweepTx.Expiry = expiry
 
 	// Add all inputs to the sweep transaction. Ensure that for each
 	// csvInput, we set the sequence number properly.
@@ -262,6 +264,11 @@ func getInputSigScriptSizeUpperBound(inp input.Input) (int64, error) {
 	case input.CommitmentNoDelay:
 		return input.P2PKHSigScriptSize, nil
 
+	// The output paying to us on a remote commitment transaction of a
+	// channel with anchors, which can be spent after one confirmation.
+	case input.CommitmentToRemoteConfirmed:
+		return input.ToRemoteConfirmedSigScriptSize, nil
+
 	// Outputs on a past commitment transaction that pay directly
 	// to us.
 	case input.CommitmentTimeLock:
@@ -282,17 +289,43 @@ func getInputSigScriptSizeUpperBound(inp input.Input) (int64, error) {
 	// An HTLC on the commitment transaction of the remote party,
 	// that has had its absolute timelock expire.
 	case input.HtlcOfferedRemoteTimeout:
+		if input.IsConfirmedSpendHTLCScript(inp.SignDesc().WitnessScript) {
+			return input.AcceptedHtlcTimeoutConfirmedSigScriptSize, nil
+		}
 		return input.AcceptedHtlcTimeoutSigScriptSize, nil
 
 	// An HTLC on the commitment transaction of the remote party,
 	// that can be swept with the preimage.
 	case input.HtlcAcceptedRemoteSuccess:
+		if input.IsConfirmedSpendHTLCScript(inp.SignDesc().WitnessScript) {
+			return input.OfferedHtlcSuccessConfirmedSigScriptSize, nil
+		}
 		return input.OfferedHtlcSuccessSigScriptSize, nil
 
 	// A standard p2pkh signature script.
 	case input.PublicKeyHash:
 		return input.P2PKHSigScriptSize, nil
 
+	// The anchor output of a commitment transaction, spent using our
+	// funding key.
+	case input.CommitmentAnchor:
+		return input.AnchorSigScriptSize, nil
+
+	// The on-chain HTLC of a submarine swap, claimed with the preimage.
+	case input.SwapHtlcSuccess:
+		return input.SwapHtlcSuccessSigScriptSize, nil
+
+	// The on-chain HTLC of a submarine swap, that has had its absolute
+	// timelock expire.
+	case input.SwapHtlcTimeout:
+		return input.SwapHtlcTimeoutSigScriptSize, nil
+
+	}
+
+	// Witness types registered outside of the input package specify the
+	// size of their sig script along with their template.
+	if tmpl, ok := input.LookupWitnessTemplate(inp.WitnessType()); ok {
+		return tmpl.SigScriptSize, nil
 	}
 
 	return 0, fmt.Errorf("unexpected witness type: %v", inp.WitnessType())
@@ -337,8 +370,18 @@ func getSizeEstimate(inputs []input.Input) ([]input.Input, int64, int, int) {
 			input.HtlcOfferedTimeoutSecondLevel,
 			input.HtlcAcceptedSuccessSecondLevel:
 			csvCount++
-		case input.HtlcOfferedRemoteTimeout:
+		case input.HtlcOfferedRemoteTimeout, input.SwapHtlcTimeout:
 			cltvCount++
+		default:
+			tmpl, ok := input.LookupWitnessTemplate(
+				inp.WitnessType(),
+			)
+			switch {
+			case ok && tmpl.CsvLocked:
+				csvCount++
+			case ok && tmpl.CltvLocked:
+				cltvCount++
+			}
 		}
 		sweepInputs = append(sweepInputs, inp)
 	}
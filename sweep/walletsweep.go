@@ -31,6 +31,10 @@ type FeePreference struct {
 	// FeeRate if non-zero, signals a fee pre fence expressed in the fee
 	// rate expressed in atom/KB for a particular transaction.
 	FeeRate lnwallet.AtomPerKByte
+
+	// MaxFeeRate if non-zero, caps the fee rate that the preference
+	// resolves to, expressed in atom/KB.
+	MaxFeeRate lnwallet.AtomPerKByte
 }
 
 // String returns a human-readable string of the fee preference.
@@ -44,10 +48,31 @@ func (p FeePreference) String() string {
 // DetermineFeePerKw will determine the fee in atom/KB that should be paid
 // given an estimator, a confirmation target, and a manual value for sat/byte.
 // A value is chosen based on the two free parameters as one, or both of them
-// can be zero.
+// can be zero. If the preference sets a maximum fee rate, the returned fee
+// rate won't exceed it.
 func DetermineFeePerKB(feeEstimator lnwallet.FeeEstimator,
 	feePref FeePreference) (lnwallet.AtomPerKByte, error) {
 
+	feePerKB, err := determineFeePerKB(feeEstimator, feePref)
+	if err != nil {
+		return 0, err
+	}
+
+	if feePref.MaxFeeRate != 0 && feePerKB > feePref.MaxFeeRate {
+		log.Debugf("Clamping fee rate of %d atom/KB to max of %d "+
+			"atom/KB", feePerKB, feePref.MaxFeeRate)
+
+		feePerKB = feePref.MaxFeeRate
+	}
+
+	return feePerKB, nil
+}
+
+// determineFeePerKB maps the confirmation target or the manual fee rate of the
+// passed preference to a concrete fee rate, ignoring its maximum fee rate.
+func determineFeePerKB(feeEstimator lnwallet.FeeEstimator,
+	feePref FeePreference) (lnwallet.AtomPerKByte, error) {
+
 	switch {
 	// If both values are set, then we'll return an error as we require a
 	// strict directive.
@@ -73,12 +98,13 @@ func DetermineFeePerKB(feeEstimator lnwallet.FeeEstimator,
 	// internally.
 	case feePref.FeeRate != 0:
 		feePerKB := feePref.FeeRate
-		if feePerKB < lnwallet.FeePerKBFloor {
+		relayFeeFloor := lnwallet.RelayFeeFloor(feeEstimator)
+		if feePerKB < relayFeeFloor {
 			log.Infof("Manual fee rate input of %d atom/KB is "+
 				"too low, using %d atom/KB instead", feePerKB,
-				lnwallet.FeePerKBFloor)
+				relayFeeFloor)
 
-			feePerKB = lnwallet.FeePerKBFloor
+			feePerKB = relayFeeFloor
 		}
 
 		return feePerKB, nil
@@ -271,8 +297,8 @@ func CraftSweepAllTx(feeRate lnwallet.AtomPerKByte, blockHeight uint32,
 	// Finally, we'll ask the sweeper to craft a sweep transaction which
 	// respects our fee preference and targets all the UTXOs of the wallet.
 	sweepTx, err := createSweepTx(
-		inputsToSweep, deliveryPkScript, blockHeight, feeRate, signer,
-		netParams,
+		inputsToSweep, deliveryPkScript, blockHeight, 0, feeRate,
+		signer, netParams,
 	)
 	if err != nil {
 		unlockOutputs()
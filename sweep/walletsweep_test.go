@@ -74,6 +74,26 @@ func TestDetermineFeePerKw(t *testing.T) {
 			fee:     20000,
 		},
 
+		// A maximum fee rate below the estimate of the confirmation
+		// target should cap the returned fee rate.
+		{
+			feePref: FeePreference{
+				ConfTarget: 50,
+				MaxFeeRate: 25000,
+			},
+			fee: 25000,
+		},
+
+		// A maximum fee rate above the manual fee rate should leave it
+		// untouched.
+		{
+			feePref: FeePreference{
+				FeeRate:    90000,
+				MaxFeeRate: 100000,
+			},
+			fee: 90000,
+		},
+
 		// Both conf target and fee rate are set, we should return with
 		// an error.
 		{
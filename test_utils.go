@@ -193,7 +193,8 @@ func createTestPeer(notifier chainntnfs.ChainNotifier,
 
 	aliceCommitTx, bobCommitTx, err := lnwallet.CreateCommitmentTxns(
 		channelBal, channelBal, &aliceCfg, &bobCfg, aliceCommitPoint,
-		bobCommitPoint, *fundingTxIn, chainParams, true,
+		bobCommitPoint, *fundingTxIn, chainParams,
+		channeldb.SingleFunderTweakless,
 	)
 	if err != nil {
 		return nil, nil, nil, nil, err
@@ -362,6 +363,12 @@ func createTestPeer(notifier chainntnfs.ChainNotifier,
 		cc:            cc,
 		breachArbiter: breachArbiter,
 		chainArb:      chainArb,
+		feeService:    lnwallet.NewFeeService(estimator, nil),
+		chanRecovery: newChanRecovery(
+			dbAlice, nil,
+			func(*secp256k1.PublicKey) bool { return false },
+			func(*lnwire.NetAddress) error { return nil },
+		),
 	}
 
 	_, currentHeight, err := s.cc.chainIO.GetBestBlock()
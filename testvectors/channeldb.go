@@ -0,0 +1,171 @@
+package testvectors
+
+import (
+	"io"
+
+	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/routing/route"
+)
+
+// Codec is implemented by every channeldb structure that has test vectors.
+type Codec interface {
+	// Encode writes the serialization of the structure to w.
+	Encode(w io.Writer) error
+
+	// Decode reads the serialization of the structure from r.
+	Decode(r io.Reader) error
+}
+
+// ChannelDBVector is the fixture of a channeldb test vector.
+type ChannelDBVector struct {
+	// Value is the structure the vector serializes.
+	Value Codec
+
+	// New returns an empty instance of the same type as Value, which the
+	// golden serialization can be decoded into.
+	New func() Codec
+}
+
+// HTLCs wraps a set of htlcs, serialized through channeldb.SerializeHtlcs.
+type HTLCs []channeldb.HTLC
+
+// Encode writes the htlcs to w.
+func (h *HTLCs) Encode(w io.Writer) error {
+	return channeldb.SerializeHtlcs(w, *h...)
+}
+
+// Decode reads the htlcs from r.
+func (h *HTLCs) Decode(r io.Reader) error {
+	htlcs, err := channeldb.DeserializeHtlcs(r)
+	if err != nil {
+		return err
+	}
+
+	*h = htlcs
+	return nil
+}
+
+// Route wraps a route, serialized through channeldb.SerializeRoute.
+type Route route.Route
+
+// Encode writes the route to w.
+func (rt *Route) Encode(w io.Writer) error {
+	return channeldb.SerializeRoute(w, route.Route(*rt))
+}
+
+// Decode reads the route from r.
+func (rt *Route) Decode(r io.Reader) error {
+	decoded, err := channeldb.DeserializeRoute(r)
+	if err != nil {
+		return err
+	}
+
+	*rt = Route(decoded)
+	return nil
+}
+
+// ChannelDBVectors returns the fixtures of the channeldb test vectors, keyed
+// by vector name. A new set of fixtures is returned on every call, so callers
+// are free to modify them.
+func ChannelDBVectors() map[string]ChannelDBVector {
+	pkgFilter := channeldb.NewPkgFilter(10)
+	pkgFilter.Set(0)
+	pkgFilter.Set(3)
+	pkgFilter.Set(9)
+
+	return map[string]ChannelDBVector{
+		"htlcs": {
+			Value: &HTLCs{
+				{
+					Signature:     []byte{0x01, 0x02, 0x03},
+					RHash:         fill(0x20),
+					Amt:           100000000,
+					RefundTimeout: 500000,
+					OutputIndex:   2,
+					Incoming:      true,
+					OnionBlob:     []byte{0x04, 0x05},
+					HtlcIndex:     3,
+					LogIndex:      4,
+				},
+				{
+					Signature:     []byte{0x06},
+					RHash:         fill(0x21),
+					Amt:           2000,
+					RefundTimeout: 500144,
+					OutputIndex:   -1,
+					Incoming:      false,
+					OnionBlob:     []byte{},
+					HtlcIndex:     5,
+					LogIndex:      6,
+				},
+			},
+			New: func() Codec { return &HTLCs{} },
+		},
+		"route": {
+			Value: &Route{
+				TotalTimeLock: 500144,
+				TotalAmount:   100001000,
+				SourcePubKey:  route.Vertex(rawPubKey(0x22)),
+				Hops: []*route.Hop{
+					{
+						PubKeyBytes:      route.Vertex(rawPubKey(0x23)),
+						ChannelID:        12345,
+						OutgoingTimeLock: 500000,
+						AmtToForward:     100000000,
+						LegacyPayload:    true,
+					},
+				},
+			},
+			New: func() Codec { return &Route{} },
+		},
+		"log_update": {
+			Value: &channeldb.LogUpdate{
+				LogIndex: 11,
+				UpdateMsg: &lnwire.UpdateFulfillHTLC{
+					ChanID:          chanID(0x24),
+					ID:              12,
+					PaymentPreimage: fill(0x25),
+				},
+			},
+			New: func() Codec { return &channeldb.LogUpdate{} },
+		},
+		"circuit_key": {
+			Value: &channeldb.CircuitKey{
+				ChanID: lnwire.ShortChannelID{
+					BlockHeight: 100000,
+					TxIndex:     12,
+					TxPosition:  1,
+				},
+				HtlcID: 13,
+			},
+			New: func() Codec { return &channeldb.CircuitKey{} },
+		},
+		"pkg_filter": {
+			Value: pkgFilter,
+			New:   func() Codec { return &channeldb.PkgFilter{} },
+		},
+		"add_ref": {
+			Value: &channeldb.AddRef{
+				Height: 14,
+				Index:  15,
+			},
+			New: func() Codec { return &channeldb.AddRef{} },
+		},
+		"waiting_proof": {
+			Value: channeldb.NewWaitingProof(
+				true, &lnwire.AnnounceSignatures{
+					ChannelID: chanID(0x26),
+					ShortChannelID: lnwire.ShortChannelID{
+						BlockHeight: 100000,
+						TxIndex:     12,
+						TxPosition:  1,
+					},
+					NodeSignature:   sig(0x27),
+					DecredSignature: sig(0x28),
+				},
+			),
+			New: func() Codec { return &channeldb.WaitingProof{} },
+		},
+	}
+}
@@ -0,0 +1,288 @@
+package testvectors
+
+import (
+	"bytes"
+	"image/color"
+	"net"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrec/secp256k1/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/lnwire"
+)
+
+// testChainHash is the chain hash used within all vectors.
+var testChainHash = chainhash.Hash{
+	0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+	0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+	0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+	0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+}
+
+// fill returns a 32 byte array with every byte set to b.
+func fill(b byte) [32]byte {
+	var a [32]byte
+	copy(a[:], bytes.Repeat([]byte{b}, 32))
+	return a
+}
+
+// pubKey returns the public key of the private key which has all bytes set to
+// b.
+func pubKey(b byte) *secp256k1.PublicKey {
+	privKey := fill(b)
+	_, pub := secp256k1.PrivKeyFromBytes(privKey[:])
+	return pub
+}
+
+// rawPubKey returns the compressed serialization of pubKey(b).
+func rawPubKey(b byte) [33]byte {
+	var raw [33]byte
+	copy(raw[:], pubKey(b).SerializeCompressed())
+	return raw
+}
+
+// sig returns a signature with every byte set to b. Signatures are encoded as
+// raw bytes on the wire, so they don't need to be valid.
+func sig(b byte) lnwire.Sig {
+	var s lnwire.Sig
+	copy(s[:], bytes.Repeat([]byte{b}, len(s)))
+	return s
+}
+
+// chanID returns a channel id with every byte set to b.
+func chanID(b byte) lnwire.ChannelID {
+	return lnwire.ChannelID(fill(b))
+}
+
+// LNWireMessages returns the fixtures of the lnwire test vectors, keyed by
+// vector name. A new set of messages is returned on every call, so callers
+// are free to modify them.
+func LNWireMessages() map[string]lnwire.Message {
+	alias, err := lnwire.NewNodeAlias("dcrlnd-vectors")
+	if err != nil {
+		panic(err)
+	}
+
+	var onionBlob [lnwire.OnionPacketSize]byte
+	for i := range onionBlob {
+		onionBlob[i] = byte(i)
+	}
+
+	shortChanID := lnwire.ShortChannelID{
+		BlockHeight: 100000,
+		TxIndex:     12,
+		TxPosition:  1,
+	}
+
+	return map[string]lnwire.Message{
+		"init": &lnwire.Init{
+			GlobalFeatures: lnwire.NewRawFeatureVector(),
+			LocalFeatures: lnwire.NewRawFeatureVector(
+				lnwire.DataLossProtectRequired,
+				lnwire.GossipQueriesOptional,
+			),
+		},
+		"error": &lnwire.Error{
+			ChanID: chanID(0x01),
+			Data:   lnwire.ErrorData("internal error"),
+		},
+		"ping": &lnwire.Ping{
+			NumPongBytes: 4,
+			PaddingBytes: lnwire.PingPayload{0, 0, 0, 0, 0, 0},
+		},
+		"pong": &lnwire.Pong{
+			PongBytes: lnwire.PongPayload{0, 0, 0, 0},
+		},
+		"open_channel": &lnwire.OpenChannel{
+			ChainHash:            testChainHash,
+			PendingChannelID:     fill(0x02),
+			FundingAmount:        10000000,
+			PushAmount:           5000000,
+			DustLimit:            6030,
+			MaxValueInFlight:     9900000000,
+			ChannelReserve:       100000,
+			HtlcMinimum:          1000,
+			FeePerKiloByte:       10000,
+			CsvDelay:             288,
+			MaxAcceptedHTLCs:     483,
+			FundingKey:           pubKey(0x01),
+			RevocationPoint:      pubKey(0x02),
+			PaymentPoint:         pubKey(0x03),
+			DelayedPaymentPoint:  pubKey(0x04),
+			HtlcPoint:            pubKey(0x05),
+			FirstCommitmentPoint: pubKey(0x06),
+			ChannelFlags:         lnwire.FFAnnounceChannel,
+		},
+		"accept_channel": &lnwire.AcceptChannel{
+			PendingChannelID:     fill(0x02),
+			DustLimit:            6030,
+			MaxValueInFlight:     9900000000,
+			ChannelReserve:       100000,
+			HtlcMinimum:          1000,
+			MinAcceptDepth:       3,
+			CsvDelay:             144,
+			MaxAcceptedHTLCs:     483,
+			FundingKey:           pubKey(0x07),
+			RevocationPoint:      pubKey(0x08),
+			PaymentPoint:         pubKey(0x09),
+			DelayedPaymentPoint:  pubKey(0x0a),
+			HtlcPoint:            pubKey(0x0b),
+			FirstCommitmentPoint: pubKey(0x0c),
+		},
+		"funding_created": &lnwire.FundingCreated{
+			PendingChannelID: fill(0x02),
+			FundingPoint: wire.OutPoint{
+				Hash:  chainhash.Hash(fill(0x03)),
+				Index: 1,
+			},
+			CommitSig: sig(0x04),
+		},
+		"funding_signed": &lnwire.FundingSigned{
+			ChanID:    chanID(0x05),
+			CommitSig: sig(0x06),
+		},
+		"funding_locked": &lnwire.FundingLocked{
+			ChanID:                 chanID(0x05),
+			NextPerCommitmentPoint: pubKey(0x0d),
+		},
+		"shutdown": &lnwire.Shutdown{
+			ChannelID: chanID(0x05),
+			Address: lnwire.DeliveryAddress{
+				0x76, 0xa9, 0x14, 0x01, 0x02, 0x03, 0x04, 0x05,
+				0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d,
+				0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13, 0x14, 0x88,
+				0xac,
+			},
+		},
+		"closing_signed": &lnwire.ClosingSigned{
+			ChannelID: chanID(0x05),
+			FeeAtoms:  5000,
+			Signature: sig(0x07),
+		},
+		"update_add_htlc": &lnwire.UpdateAddHTLC{
+			ChanID:      chanID(0x05),
+			ID:          7,
+			Amount:      100000000,
+			PaymentHash: fill(0x08),
+			Expiry:      500000,
+			OnionBlob:   onionBlob,
+		},
+		"update_fulfill_htlc": &lnwire.UpdateFulfillHTLC{
+			ChanID:          chanID(0x05),
+			ID:              7,
+			PaymentPreimage: fill(0x09),
+		},
+		"update_fail_htlc": &lnwire.UpdateFailHTLC{
+			ChanID: chanID(0x05),
+			ID:     8,
+			Reason: lnwire.OpaqueReason{0x01, 0x02, 0x03, 0x04},
+		},
+		"commit_sig": &lnwire.CommitSig{
+			ChanID:    chanID(0x05),
+			CommitSig: sig(0x0a),
+			HtlcSigs:  []lnwire.Sig{sig(0x0b), sig(0x0c)},
+		},
+		"revoke_and_ack": &lnwire.RevokeAndAck{
+			ChanID:            chanID(0x05),
+			Revocation:        fill(0x0d),
+			NextRevocationKey: pubKey(0x0e),
+		},
+		"update_fee": &lnwire.UpdateFee{
+			ChanID:   chanID(0x05),
+			FeePerKB: 20000,
+		},
+		"update_fail_malformed_htlc": &lnwire.UpdateFailMalformedHTLC{
+			ChanID:       chanID(0x05),
+			ID:           9,
+			ShaOnionBlob: fill(0x0e),
+			FailureCode:  lnwire.CodeInvalidOnionKey,
+		},
+		"channel_reestablish": &lnwire.ChannelReestablish{
+			ChanID:                    chanID(0x05),
+			NextLocalCommitHeight:     10,
+			RemoteCommitTailHeight:    9,
+			LastRemoteCommitSecret:    fill(0x0f),
+			LocalUnrevokedCommitPoint: pubKey(0x0f),
+		},
+		"channel_announcement": &lnwire.ChannelAnnouncement{
+			NodeSig1:       sig(0x10),
+			NodeSig2:       sig(0x11),
+			DecredSig1:     sig(0x12),
+			DecredSig2:     sig(0x13),
+			Features:       lnwire.NewRawFeatureVector(),
+			ChainHash:      testChainHash,
+			ShortChannelID: shortChanID,
+			NodeID1:        rawPubKey(0x10),
+			NodeID2:        rawPubKey(0x11),
+			DecredKey1:     rawPubKey(0x12),
+			DecredKey2:     rawPubKey(0x13),
+		},
+		"node_announcement": &lnwire.NodeAnnouncement{
+			Signature: sig(0x14),
+			Features: lnwire.NewRawFeatureVector(
+				lnwire.DataLossProtectOptional,
+			),
+			Timestamp: 1577836800,
+			NodeID:    rawPubKey(0x14),
+			RGBColor:  color.RGBA{R: 0x29, G: 0x70, B: 0xef},
+			Alias:     alias,
+			Addresses: []net.Addr{
+				&net.TCPAddr{
+					IP:   net.IPv4(127, 0, 0, 1),
+					Port: 9735,
+				},
+			},
+		},
+		"channel_update": &lnwire.ChannelUpdate{
+			Signature:         sig(0x15),
+			ChainHash:         testChainHash,
+			ShortChannelID:    shortChanID,
+			Timestamp:         1577836800,
+			MessageFlags:      lnwire.ChanUpdateOptionMaxHtlc,
+			ChannelFlags:      lnwire.ChanUpdateDirection,
+			TimeLockDelta:     80,
+			HtlcMinimumMAtoms: 1000,
+			BaseFee:           1000,
+			FeeRate:           1,
+			HtlcMaximumMAtoms: 9900000000,
+		},
+		"announce_signatures": &lnwire.AnnounceSignatures{
+			ChannelID:       chanID(0x05),
+			ShortChannelID:  shortChanID,
+			NodeSignature:   sig(0x16),
+			DecredSignature: sig(0x17),
+		},
+		"query_short_chan_ids": &lnwire.QueryShortChanIDs{
+			ChainHash:    testChainHash,
+			EncodingType: lnwire.EncodingSortedPlain,
+			ShortChanIDs: []lnwire.ShortChannelID{
+				shortChanID,
+				lnwire.NewShortChanIDFromInt(1),
+			},
+		},
+		"reply_short_chan_ids_end": &lnwire.ReplyShortChanIDsEnd{
+			ChainHash: testChainHash,
+			Complete:  1,
+		},
+		"query_channel_range": &lnwire.QueryChannelRange{
+			ChainHash:        testChainHash,
+			FirstBlockHeight: 100000,
+			NumBlocks:        1000,
+		},
+		"reply_channel_range": &lnwire.ReplyChannelRange{
+			QueryChannelRange: lnwire.QueryChannelRange{
+				ChainHash:        testChainHash,
+				FirstBlockHeight: 100000,
+				NumBlocks:        1000,
+			},
+			Complete:     1,
+			EncodingType: lnwire.EncodingSortedPlain,
+			ShortChanIDs: []lnwire.ShortChannelID{shortChanID},
+		},
+		"gossip_timestamp_range": &lnwire.GossipTimestampRange{
+			ChainHash:      testChainHash,
+			FirstTimestamp: 1577836800,
+			TimestampRange: 86400,
+		},
+	}
+}
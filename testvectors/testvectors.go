@@ -0,0 +1,139 @@
+// Package testvectors provides golden serializations of the wire messages,
+// database structures and payment requests used by dcrlnd.
+//
+// Each vector consists of a deterministic fixture defined within this package
+// and a golden file that stores its serialization, as produced by the
+// version of dcrlnd the vector was introduced in. The tests of this package
+// assert that the current serialization code still produces, and is able to
+// parse, each golden file, such that format-breaking changes are caught during
+// development.
+//
+// The golden files are stored as plain files beneath the testdata directory
+// of this package, grouped by category. Binary vectors are hex encoded, while
+// payment requests are stored as their bech32 string. Other implementations
+// can consume these files directly, or through Load and LoadAll from Go code.
+//
+// The golden files can be regenerated from the fixtures by running the tests
+// with the -update flag. This should only ever be done when adding new vectors,
+// as changing existing vectors defeats their purpose.
+package testvectors
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+)
+
+// Category identifies a group of related test vectors.
+type Category string
+
+const (
+	// CategoryLNWire contains the serializations of lnwire messages,
+	// including their message type prefix.
+	CategoryLNWire Category = "lnwire"
+
+	// CategoryChannelDB contains the serializations of channeldb
+	// structures.
+	CategoryChannelDB Category = "channeldb"
+
+	// CategoryZpay32 contains bech32 encoded payment requests.
+	CategoryZpay32 Category = "zpay32"
+)
+
+const (
+	// hexExt is the extension of golden files that store binary data in
+	// hex.
+	hexExt = ".hex"
+
+	// textExt is the extension of golden files that store their data as
+	// is.
+	textExt = ".txt"
+)
+
+// Vector is a single named test vector.
+type Vector struct {
+	// Name uniquely identifies the vector within its category.
+	Name string
+
+	// Data is the golden serialization of the vector.
+	Data []byte
+}
+
+// Dir returns the directory the golden files of the passed category are
+// stored in.
+func Dir(category Category) string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(file), "testdata", string(category))
+}
+
+// fileExt returns the golden file extension used by the passed category.
+func fileExt(category Category) string {
+	if category == CategoryZpay32 {
+		return textExt
+	}
+
+	return hexExt
+}
+
+// goldenPath returns the path of the golden file for the named vector.
+func goldenPath(category Category, name string) string {
+	return filepath.Join(Dir(category), name+fileExt(category))
+}
+
+// Load returns the golden serialization of the named vector.
+func Load(category Category, name string) ([]byte, error) {
+	raw, err := ioutil.ReadFile(goldenPath(category, name))
+	if err != nil {
+		return nil, err
+	}
+
+	if fileExt(category) == textExt {
+		return []byte(strings.TrimSpace(string(raw))), nil
+	}
+
+	data, err := hex.DecodeString(strings.TrimSpace(string(raw)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid golden file for %v/%v: %v",
+			category, name, err)
+	}
+
+	return data, nil
+}
+
+// LoadAll returns all vectors of the passed category, sorted by name.
+func LoadAll(category Category) ([]Vector, error) {
+	files, err := ioutil.ReadDir(Dir(category))
+	if err != nil {
+		return nil, err
+	}
+
+	ext := fileExt(category)
+
+	var vectors []Vector
+	for _, file := range files {
+		if file.IsDir() || filepath.Ext(file.Name()) != ext {
+			continue
+		}
+
+		name := strings.TrimSuffix(file.Name(), ext)
+		data, err := Load(category, name)
+		if err != nil {
+			return nil, err
+		}
+
+		vectors = append(vectors, Vector{
+			Name: name,
+			Data: data,
+		})
+	}
+
+	sort.Slice(vectors, func(i, j int) bool {
+		return vectors[i].Name < vectors[j].Name
+	})
+
+	return vectors, nil
+}
@@ -0,0 +1,210 @@
+package testvectors
+
+import (
+	"bytes"
+	"encoding/hex"
+	"flag"
+	"io/ioutil"
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/zpay32"
+)
+
+// update regenerates the golden files from the current fixtures instead of
+// checking them.
+var update = flag.Bool("update", false, "update the golden files")
+
+// store writes the golden file of the named vector.
+func store(category Category, name string, data []byte) error {
+	if err := os.MkdirAll(Dir(category), 0755); err != nil {
+		return err
+	}
+
+	content := string(data)
+	if fileExt(category) == hexExt {
+		content = hex.EncodeToString(data)
+	}
+
+	return ioutil.WriteFile(
+		goldenPath(category, name), []byte(content+"\n"), 0644,
+	)
+}
+
+// checkGolden asserts that the passed serialization matches the golden file of
+// the named vector, or overwrites the golden file if the update flag is set.
+func checkGolden(t *testing.T, category Category, name string, data []byte) {
+	t.Helper()
+
+	if *update {
+		if err := store(category, name, data); err != nil {
+			t.Fatalf("unable to store %v/%v: %v", category, name,
+				err)
+		}
+		return
+	}
+
+	golden, err := Load(category, name)
+	if err != nil {
+		t.Fatalf("unable to load %v/%v: %v", category, name, err)
+	}
+
+	if !bytes.Equal(golden, data) {
+		t.Fatalf("serialization of %v/%v doesn't match golden file: "+
+			"expected %x, got %x", category, name, golden, data)
+	}
+}
+
+// checkNames asserts that every golden file of the category has a fixture, and
+// vice versa.
+func checkNames(t *testing.T, category Category, names []string) {
+	t.Helper()
+
+	if *update {
+		return
+	}
+
+	vectors, err := LoadAll(category)
+	if err != nil {
+		t.Fatalf("unable to load %v vectors: %v", category, err)
+	}
+
+	sort.Strings(names)
+	if len(vectors) != len(names) {
+		t.Fatalf("expected %v %v vectors, got %v", len(names),
+			category, len(vectors))
+	}
+	for i, vector := range vectors {
+		if vector.Name != names[i] {
+			t.Fatalf("expected %v vector %v, got %v", category,
+				names[i], vector.Name)
+		}
+	}
+}
+
+// TestLNWireVectors asserts that all lnwire messages still serialize to, and
+// can be parsed from, their golden files.
+func TestLNWireVectors(t *testing.T) {
+	t.Parallel()
+
+	var names []string
+	for name, msg := range LNWireMessages() {
+		names = append(names, name)
+
+		var b bytes.Buffer
+		if _, err := lnwire.WriteMessage(&b, msg, 0); err != nil {
+			t.Fatalf("unable to encode %v: %v", name, err)
+		}
+		checkGolden(t, CategoryLNWire, name, b.Bytes())
+
+		golden, err := Load(CategoryLNWire, name)
+		if err != nil {
+			t.Fatalf("unable to load %v: %v", name, err)
+		}
+		decoded, err := lnwire.ReadMessage(bytes.NewReader(golden), 0)
+		if err != nil {
+			t.Fatalf("unable to decode %v: %v", name, err)
+		}
+		if decoded.MsgType() != msg.MsgType() {
+			t.Fatalf("expected %v to decode as %v, got %v", name,
+				msg.MsgType(), decoded.MsgType())
+		}
+
+		b.Reset()
+		if _, err := lnwire.WriteMessage(&b, decoded, 0); err != nil {
+			t.Fatalf("unable to re-encode %v: %v", name, err)
+		}
+		if !bytes.Equal(golden, b.Bytes()) {
+			t.Fatalf("re-encoded %v doesn't match golden file: "+
+				"expected %x, got %x", name, golden, b.Bytes())
+		}
+	}
+
+	checkNames(t, CategoryLNWire, names)
+}
+
+// TestChannelDBVectors asserts that all channeldb structures still serialize
+// to, and can be parsed from, their golden files.
+func TestChannelDBVectors(t *testing.T) {
+	t.Parallel()
+
+	var names []string
+	for name, vector := range ChannelDBVectors() {
+		names = append(names, name)
+
+		var b bytes.Buffer
+		if err := vector.Value.Encode(&b); err != nil {
+			t.Fatalf("unable to encode %v: %v", name, err)
+		}
+		checkGolden(t, CategoryChannelDB, name, b.Bytes())
+
+		golden, err := Load(CategoryChannelDB, name)
+		if err != nil {
+			t.Fatalf("unable to load %v: %v", name, err)
+		}
+		decoded := vector.New()
+		if err := decoded.Decode(bytes.NewReader(golden)); err != nil {
+			t.Fatalf("unable to decode %v: %v", name, err)
+		}
+
+		b.Reset()
+		if err := decoded.Encode(&b); err != nil {
+			t.Fatalf("unable to re-encode %v: %v", name, err)
+		}
+		if !bytes.Equal(golden, b.Bytes()) {
+			t.Fatalf("re-encoded %v doesn't match golden file: "+
+				"expected %x, got %x", name, golden, b.Bytes())
+		}
+	}
+
+	checkNames(t, CategoryChannelDB, names)
+}
+
+// TestZpay32Vectors asserts that all payment requests still encode to, and can
+// be decoded from, their golden files.
+func TestZpay32Vectors(t *testing.T) {
+	t.Parallel()
+
+	signer := Zpay32Signer()
+
+	var names []string
+	for name, invoice := range Zpay32Invoices() {
+		names = append(names, name)
+
+		encoded, err := invoice.Encode(signer)
+		if err != nil {
+			t.Fatalf("unable to encode %v: %v", name, err)
+		}
+		checkGolden(t, CategoryZpay32, name, []byte(encoded))
+
+		golden, err := Load(CategoryZpay32, name)
+		if err != nil {
+			t.Fatalf("unable to load %v: %v", name, err)
+		}
+		decoded, err := zpay32.Decode(string(golden), invoice.Net)
+		if err != nil {
+			t.Fatalf("unable to decode %v: %v", name, err)
+		}
+
+		// Unless the payee was explicitly set, it is recovered from
+		// the signature, so we must clear it to get back the same
+		// payment request.
+		if invoice.Destination == nil {
+			decoded.Destination = nil
+		}
+
+		reencoded, err := decoded.Encode(signer)
+		if err != nil {
+			t.Fatalf("unable to re-encode %v: %v", name, err)
+		}
+		if string(golden) != reencoded {
+			t.Fatalf("re-encoded %v doesn't match golden file: "+
+				"expected %v, got %v", name, string(golden),
+				reencoded)
+		}
+	}
+
+	checkNames(t, CategoryZpay32, names)
+}
@@ -0,0 +1,148 @@
+package testvectors
+
+import (
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/chaincfg/v2"
+	"github.com/decred/dcrd/dcrec/secp256k1/v2"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/zpay32"
+)
+
+var (
+	// zpay32PrivKeyBytes is the private key all payment request vectors
+	// are signed with.
+	zpay32PrivKeyBytes, _ = hex.DecodeString(
+		"e126f68f7eafcc8b74f54d269fe206be715000f94dac067d1c04a8ca3b2db734",
+	)
+
+	// zpay32PaymentHash is the payment hash used by all payment request
+	// vectors.
+	zpay32PaymentHash = [32]byte{
+		0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07,
+		0x08, 0x09, 0x00, 0x01, 0x02, 0x03, 0x04, 0x05,
+		0x06, 0x07, 0x08, 0x09, 0x00, 0x01, 0x02, 0x03,
+		0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x01, 0x02,
+	}
+
+	// zpay32DescriptionHash is the description hash used by the payment
+	// request vectors which commit to a description hash.
+	zpay32DescriptionHash = chainhash.HashH([]byte("One piece of " +
+		"chocolate cake, one icecream cone, one pickle, one slice " +
+		"of swiss cheese, one slice of salami, one lollypop, one " +
+		"piece of cherry pie, one sausage, one cupcake, and one " +
+		"slice of watermelon"))
+
+	// zpay32Timestamp is the creation time of the payment request
+	// vectors.
+	zpay32Timestamp = time.Unix(1496314658, 0)
+
+	// zpay32PayeeTimestamp is the creation time of the payment request
+	// vector which explicitly commits to its payee.
+	zpay32PayeeTimestamp = time.Unix(1503429093, 0)
+)
+
+// Zpay32Signer returns the signer that the payment request vectors are signed
+// with.
+func Zpay32Signer() zpay32.MessageSigner {
+	privKey, _ := secp256k1.PrivKeyFromBytes(zpay32PrivKeyBytes)
+
+	return zpay32.MessageSigner{
+		SignCompact: func(hash []byte) ([]byte, error) {
+			sig, err := secp256k1.SignCompact(privKey, hash, true)
+			if err != nil {
+				return nil, fmt.Errorf("can't sign the "+
+					"message: %v", err)
+			}
+			return sig, nil
+		},
+	}
+}
+
+// Zpay32Invoices returns the fixtures of the payment request vectors, keyed by
+// vector name. Unless the Destination of an invoice is set, the payee is
+// recovered from the signature of the encoded payment request. A new set of
+// invoices is returned on every call, so callers are free to modify them.
+func Zpay32Invoices() map[string]*zpay32.Invoice {
+	_, payee := secp256k1.PrivKeyFromBytes(zpay32PrivKeyBytes)
+
+	mainnet := chaincfg.MainNetParams()
+	testnet := chaincfg.TestNet3Params()
+
+	mustAddr := func(addr string, net *chaincfg.Params) dcrutil.Address {
+		a, err := dcrutil.DecodeAddress(addr, net)
+		if err != nil {
+			panic(err)
+		}
+		return a
+	}
+
+	hopHintKey, err := hex.DecodeString("029e03a901b85534ff1e92c43c74431" +
+		"f7ce72046060fcf7a95c37e148f78c77255")
+	if err != nil {
+		panic(err)
+	}
+	hopHintPubKey, err := secp256k1.ParsePubKey(hopHintKey)
+	if err != nil {
+		panic(err)
+	}
+
+	mustInvoice := func(net *chaincfg.Params, timestamp time.Time,
+		options ...func(*zpay32.Invoice)) *zpay32.Invoice {
+
+		invoice, err := zpay32.NewInvoice(
+			net, zpay32PaymentHash, timestamp, options...,
+		)
+		if err != nil {
+			panic(err)
+		}
+		return invoice
+	}
+
+	return map[string]*zpay32.Invoice{
+		"description_expiry": mustInvoice(
+			mainnet, zpay32Timestamp,
+			zpay32.Amount(lnwire.MilliAtom(250000000)),
+			zpay32.Description("1 cup coffee"),
+			zpay32.Expiry(60*time.Second),
+		),
+		"description_hash": mustInvoice(
+			mainnet, zpay32Timestamp,
+			zpay32.Amount(lnwire.MilliAtom(2000000000)),
+			zpay32.DescriptionHash(zpay32DescriptionHash),
+		),
+		"testnet_fallback_addr": mustInvoice(
+			testnet, zpay32Timestamp,
+			zpay32.Amount(lnwire.MilliAtom(2000000000)),
+			zpay32.DescriptionHash(zpay32DescriptionHash),
+			zpay32.FallbackAddr(mustAddr(
+				"TsR28UZRprhgQQhzWns2M6cAwchrNVvbYq2", testnet,
+			)),
+		),
+		"route_hint": mustInvoice(
+			mainnet, zpay32Timestamp,
+			zpay32.Amount(lnwire.MilliAtom(2000000000)),
+			zpay32.DescriptionHash(zpay32DescriptionHash),
+			zpay32.FallbackAddr(mustAddr(
+				"DsQxuVRvS4eaJ42dhQEsCXauMWjvopWgrVg", mainnet,
+			)),
+			zpay32.RouteHint([]zpay32.HopHint{{
+				NodeID:                    hopHintPubKey,
+				ChannelID:                 0x0102030405060708,
+				FeeBaseMAtoms:             0,
+				FeeProportionalMillionths: 20,
+				CLTVExpiryDelta:           3,
+			}}),
+		),
+		"payee": mustInvoice(
+			mainnet, zpay32PayeeTimestamp,
+			zpay32.Amount(lnwire.MilliAtom(2400000000000)),
+			zpay32.Description(""),
+			zpay32.Destination(payee),
+		),
+	}
+}
@@ -144,7 +144,7 @@ func (s *Stream) Encode(w io.Writer) error {
 // the last record was read cleanly and we should stop parsing. All other io.EOF
 // or io.ErrUnexpectedEOF errors are returned.
 func (s *Stream) Decode(r io.Reader) error {
-	_, err := s.decode(r, nil)
+	_, err := s.decode(r, nil, nil)
 	return err
 }
 
@@ -152,13 +152,31 @@ func (s *Stream) Decode(r io.Reader) error {
 // TypeSet containing the types of all records that were decoded or ignored from
 // the stream.
 func (s *Stream) DecodeWithParsedTypes(r io.Reader) (TypeSet, error) {
-	return s.decode(r, make(TypeSet))
+	return s.decode(r, make(TypeSet), nil)
+}
+
+// DecodeWithUnknownRecords is identical to Decode, but if successful, returns
+// the raw values of all records of unknown odd types that were skipped while
+// decoding the stream, keyed by their type.
+func (s *Stream) DecodeWithUnknownRecords(r io.Reader) (map[uint64][]byte,
+	error) {
+
+	unknownRecords := make(map[uint64][]byte)
+	if _, err := s.decode(r, nil, unknownRecords); err != nil {
+		return nil, err
+	}
+
+	return unknownRecords, nil
 }
 
 // decode is a helper function that performs the basis of stream decoding. If
 // the caller needs the set of parsed types, it must provide an initialized
-// parsedTypes, otherwise the returned TypeSet will be nil.
-func (s *Stream) decode(r io.Reader, parsedTypes TypeSet) (TypeSet, error) {
+// parsedTypes, otherwise the returned TypeSet will be nil. Likewise, the values
+// of unknown odd records are only retained if the caller provides an
+// initialized unknownRecords map.
+func (s *Stream) decode(r io.Reader, parsedTypes TypeSet,
+	unknownRecords map[uint64][]byte) (TypeSet, error) {
+
 	var (
 		typ       Type
 		min       Type
@@ -245,8 +263,26 @@ func (s *Stream) decode(r io.Reader, parsedTypes TypeSet) (TypeSet, error) {
 		case typ%2 == 0:
 			return nil, ErrUnknownRequiredType(typ)
 
-		// Otherwise, the record type is unknown and is odd, discard the
-		// number of bytes specified by length.
+		// Otherwise, the record type is unknown and is odd, we'll
+		// retain its value if the caller asked for it.
+		case unknownRecords != nil:
+			value := make([]byte, length)
+			_, err := io.ReadFull(r, value)
+			switch {
+
+			// We'll convert any EOFs to ErrUnexpectedEOF, since this
+			// results in an invalid record.
+			case err == io.EOF:
+				return nil, io.ErrUnexpectedEOF
+
+			// Other unexpected errors.
+			case err != nil:
+				return nil, err
+			}
+
+			unknownRecords[uint64(typ)] = value
+
+		// Otherwise, discard the number of bytes specified by length.
 		default:
 			_, err := io.CopyN(ioutil.Discard, r, int64(length))
 			switch {
@@ -49,3 +49,48 @@ func TestParsedTypes(t *testing.T) {
 			unknownType)
 	}
 }
+
+// TestUnknownRecords asserts that a Stream returns the raw values of the
+// unknown odd records it encounters, while still decoding the known ones.
+func TestUnknownRecords(t *testing.T) {
+	const (
+		knownType   = 1
+		unknownType = 3
+	)
+
+	known := uint64(5)
+	unknown := [32]byte{6}
+	encStream := tlv.MustNewStream(
+		tlv.MakePrimitiveRecord(knownType, &known),
+		tlv.MakePrimitiveRecord(unknownType, &unknown),
+	)
+
+	var b bytes.Buffer
+	if err := encStream.Encode(&b); err != nil {
+		t.Fatalf("unable to encode stream: %v", err)
+	}
+
+	var decoded uint64
+	decStream := tlv.MustNewStream(
+		tlv.MakePrimitiveRecord(knownType, &decoded),
+	)
+
+	unknownRecords, err := decStream.DecodeWithUnknownRecords(
+		bytes.NewReader(b.Bytes()),
+	)
+	if err != nil {
+		t.Fatalf("unable to decode stream: %v", err)
+	}
+
+	if decoded != known {
+		t.Fatalf("expected known record %v, got %v", known, decoded)
+	}
+	if len(unknownRecords) != 1 {
+		t.Fatalf("expected 1 unknown record, got %v",
+			len(unknownRecords))
+	}
+	if !bytes.Equal(unknownRecords[unknownType], unknown[:]) {
+		t.Fatalf("expected unknown record %x, got %x", unknown[:],
+			unknownRecords[unknownType])
+	}
+}
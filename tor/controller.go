@@ -10,9 +10,9 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/textproto"
-	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"sync/atomic"
 )
 
@@ -81,6 +81,10 @@ type Controller struct {
 
 	// version is the current version of the Tor server.
 	version string
+
+	// mtx serializes the commands sent to the Tor server, so that each
+	// reply is read by the sender of its command.
+	mtx sync.Mutex
 }
 
 // NewController returns a new Tor controller that will be able to interact with
@@ -116,9 +120,39 @@ func (c *Controller) Stop() error {
 	return c.conn.Close()
 }
 
+// Reconnect closes the connection between the controller and the Tor server
+// and establishes and authenticates a new one. The Tor server removes the
+// onion services created through a connection once it's closed, so they must
+// be created again afterwards.
+func (c *Controller) Reconnect() error {
+	if atomic.LoadInt32(&c.started) == 0 {
+		return errors.New("tor controller not started")
+	}
+	if atomic.LoadInt32(&c.stopped) != 0 {
+		return errors.New("tor controller stopped")
+	}
+
+	c.mtx.Lock()
+	c.conn.Close()
+	conn, err := textproto.Dial("tcp", c.controlAddr)
+	if err == nil {
+		c.conn = conn
+	}
+	c.mtx.Unlock()
+
+	if err != nil {
+		return fmt.Errorf("unable to connect to Tor server: %v", err)
+	}
+
+	return c.authenticate()
+}
+
 // sendCommand sends a command to the Tor server and returns its response, as a
 // single space-delimited string, and code.
 func (c *Controller) sendCommand(command string) (int, string, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
 	if err := c.conn.Writer.PrintfLine(command); err != nil {
 		return 0, "", err
 	}
@@ -133,6 +167,17 @@ func (c *Controller) sendCommand(command string) (int, string, error) {
 	return code, reply, nil
 }
 
+// CheckConnection asserts that the connection to the Tor server is still
+// alive, by requesting the version of the Tor server.
+func (c *Controller) CheckConnection() error {
+	if atomic.LoadInt32(&c.started) == 0 {
+		return errors.New("tor controller not started")
+	}
+
+	_, _, err := c.sendCommand("GETINFO version")
+	return err
+}
+
 // parseTorReply parses the reply from the Tor server after receiving a command
 // from a controller. This will parse the relevant reply parameters into a map
 // of keys and values.
@@ -427,9 +472,9 @@ type AddOnionConfig struct {
 	// port.
 	TargetPorts []int
 
-	// PrivateKeyPath is the full path to where the onion service's private
-	// key is stored. This can be used to restore an existing onion service.
-	PrivateKeyPath string
+	// Store is the store of the onion service's private key. This is used
+	// to restore an existing onion service.
+	Store OnionStore
 }
 
 // AddOnion creates an onion service and returns its onion address. Once
@@ -445,24 +490,26 @@ func (c *Controller) AddOnion(cfg AddOnionConfig) (*OnionAddr, error) {
 		}
 	}
 
-	// We'll start off by checking if the file containing the private key
-	// exists. If it does not, then we should request the server to create
-	// a new onion service and return its private key. Otherwise, we'll
-	// request the server to recreate the onion server from our private key.
+	// We'll start off by checking if the store holds the private key. If
+	// it does not, then we should request the server to create a new
+	// onion service and return its private key. Otherwise, we'll request
+	// the server to recreate the onion server from our private key.
 	var keyParam string
-	if _, err := os.Stat(cfg.PrivateKeyPath); os.IsNotExist(err) {
+	privateKey, err := cfg.Store.PrivateKey(cfg.Type)
+	switch err {
+	case ErrNoPrivateKey:
 		switch cfg.Type {
 		case V2:
 			keyParam = "NEW:RSA1024"
 		case V3:
 			keyParam = "NEW:ED25519-V3"
 		}
-	} else {
-		privateKey, err := ioutil.ReadFile(cfg.PrivateKeyPath)
-		if err != nil {
-			return nil, err
-		}
+
+	case nil:
 		keyParam = string(privateKey)
+
+	default:
+		return nil, err
 	}
 
 	// Now, we'll create a mapping from the virtual port to each target
@@ -507,16 +554,13 @@ func (c *Controller) AddOnion(cfg AddOnionConfig) (*OnionAddr, error) {
 		return nil, errors.New("service id not found in reply")
 	}
 
-	// If a new onion service was created, we'll write its private key to
-	// disk under strict permissions in the event that it needs to be
-	// recreated later on.
+	// If a new onion service was created, we'll store its private key in
+	// the event that it needs to be recreated later on.
 	if privateKey, ok := replyParams["PrivateKey"]; ok {
-		err := ioutil.WriteFile(
-			cfg.PrivateKeyPath, []byte(privateKey), 0600,
-		)
+		err := cfg.Store.StorePrivateKey(cfg.Type, []byte(privateKey))
 		if err != nil {
-			return nil, fmt.Errorf("unable to write private key "+
-				"to file: %v", err)
+			return nil, fmt.Errorf("unable to store private key: "+
+				"%v", err)
 		}
 	}
 
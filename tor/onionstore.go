@@ -0,0 +1,125 @@
+package tor
+
+import (
+	"crypto/sha512"
+	"encoding/base64"
+	"errors"
+	"io/ioutil"
+	"os"
+)
+
+var (
+	// ErrNoPrivateKey is returned by an OnionStore when it doesn't hold
+	// the private key of the onion service requested, in which case a new
+	// one should be created.
+	ErrNoPrivateKey = errors.New("private key not found")
+
+	// derivedKeyTag is the tag prepended to the secrets the private keys
+	// of derived onion services are computed from, so that they are never
+	// used as is.
+	derivedKeyTag = []byte("dcrlnd onion service")
+)
+
+// OnionStore is a store of the private keys of the onion services created by
+// the controller, allowing the same services to be restored across restarts.
+type OnionStore interface {
+	// StorePrivateKey stores the private key of an onion service of the
+	// given type, as returned by the Tor server.
+	StorePrivateKey(OnionType, []byte) error
+
+	// PrivateKey returns the private key of the onion service of the given
+	// type, or ErrNoPrivateKey if no service was created yet.
+	PrivateKey(OnionType) ([]byte, error)
+}
+
+// OnionFile is an OnionStore persisting the private key of an onion service
+// to a file.
+type OnionFile struct {
+	privateKeyPath string
+}
+
+// A compile time check to ensure OnionFile implements the OnionStore
+// interface.
+var _ OnionStore = (*OnionFile)(nil)
+
+// NewOnionFile returns an OnionStore persisting the private key of an onion
+// service to the file at the given path.
+func NewOnionFile(privateKeyPath string) *OnionFile {
+	return &OnionFile{
+		privateKeyPath: privateKeyPath,
+	}
+}
+
+// StorePrivateKey writes the private key of the onion service to disk under
+// strict permissions.
+//
+// NOTE: This is part of the OnionStore interface.
+func (f *OnionFile) StorePrivateKey(_ OnionType, privateKey []byte) error {
+	return ioutil.WriteFile(f.privateKeyPath, privateKey, 0600)
+}
+
+// PrivateKey reads the private key of the onion service from disk.
+//
+// NOTE: This is part of the OnionStore interface.
+func (f *OnionFile) PrivateKey(_ OnionType) ([]byte, error) {
+	privateKey, err := ioutil.ReadFile(f.privateKeyPath)
+	if os.IsNotExist(err) {
+		return nil, ErrNoPrivateKey
+	}
+
+	return privateKey, err
+}
+
+// DerivedOnionKey is an OnionStore providing the private key of a v3 onion
+// service derived from a secret, such as a key of the wallet, rather than
+// generated by the Tor server. This allows the onion service to be restored
+// along with the wallet, without persisting its private key.
+type DerivedOnionKey struct {
+	privateKey []byte
+}
+
+// A compile time check to ensure DerivedOnionKey implements the OnionStore
+// interface.
+var _ OnionStore = (*DerivedOnionKey)(nil)
+
+// NewDerivedOnionKey returns an OnionStore providing the private key of a v3
+// onion service derived from the given secret.
+func NewDerivedOnionKey(secret []byte) *DerivedOnionKey {
+	// The private key expected by the Tor server is an expanded ed25519
+	// key, whose first half is the clamped scalar of the key.
+	h := sha512.New()
+	h.Write(derivedKeyTag)
+	h.Write(secret)
+	expandedKey := h.Sum(nil)
+	expandedKey[0] &= 248
+	expandedKey[31] &= 127
+	expandedKey[31] |= 64
+
+	privateKey := "ED25519-V3:" +
+		base64.StdEncoding.EncodeToString(expandedKey)
+
+	return &DerivedOnionKey{
+		privateKey: []byte(privateKey),
+	}
+}
+
+// StorePrivateKey is a no-op, as the private key is derived again on each
+// start.
+//
+// NOTE: This is part of the OnionStore interface.
+func (d *DerivedOnionKey) StorePrivateKey(OnionType, []byte) error {
+	return nil
+}
+
+// PrivateKey returns the derived private key of the onion service. Only v3
+// onion services are supported.
+//
+// NOTE: This is part of the OnionStore interface.
+func (d *DerivedOnionKey) PrivateKey(onionType OnionType) ([]byte, error) {
+	if onionType != V3 {
+		return nil, errors.New("only v3 onion service keys can be " +
+			"derived")
+	}
+
+	return d.privateKey, nil
+}
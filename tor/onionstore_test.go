@@ -0,0 +1,97 @@
+package tor
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestOnionFile asserts that the private key of an onion service stored in an
+// OnionFile can be read back, and that ErrNoPrivateKey is returned before any
+// key was stored.
+func TestOnionFile(t *testing.T) {
+	t.Parallel()
+
+	tempDir, err := ioutil.TempDir("", "onionfile")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	store := NewOnionFile(filepath.Join(tempDir, "v3_onion_private_key"))
+
+	if _, err := store.PrivateKey(V3); err != ErrNoPrivateKey {
+		t.Fatalf("expected ErrNoPrivateKey, got %v", err)
+	}
+
+	privateKey := []byte("ED25519-V3:privatekey")
+	if err := store.StorePrivateKey(V3, privateKey); err != nil {
+		t.Fatalf("unable to store private key: %v", err)
+	}
+
+	storedKey, err := store.PrivateKey(V3)
+	if err != nil {
+		t.Fatalf("unable to read private key: %v", err)
+	}
+	if !bytes.Equal(storedKey, privateKey) {
+		t.Fatalf("expected private key %s, got %s", privateKey,
+			storedKey)
+	}
+}
+
+// TestDerivedOnionKey asserts that the private keys of derived onion services
+// are valid expanded ed25519 keys, that the same secret always derives the
+// same key, and that only v3 keys are provided.
+func TestDerivedOnionKey(t *testing.T) {
+	t.Parallel()
+
+	secret := bytes.Repeat([]byte{0x01}, 32)
+	privateKey, err := NewDerivedOnionKey(secret).PrivateKey(V3)
+	if err != nil {
+		t.Fatalf("unable to derive private key: %v", err)
+	}
+
+	const prefix = "ED25519-V3:"
+	if !strings.HasPrefix(string(privateKey), prefix) {
+		t.Fatalf("private key %s doesn't start with %s", privateKey,
+			prefix)
+	}
+	expandedKey, err := base64.StdEncoding.DecodeString(
+		strings.TrimPrefix(string(privateKey), prefix),
+	)
+	if err != nil {
+		t.Fatalf("unable to decode private key: %v", err)
+	}
+	if len(expandedKey) != 64 {
+		t.Fatalf("expected 64 byte expanded key, got %d bytes",
+			len(expandedKey))
+	}
+	if expandedKey[0]&7 != 0 || expandedKey[31]&0xc0 != 0x40 {
+		t.Fatalf("expanded key isn't clamped")
+	}
+
+	sameKey, err := NewDerivedOnionKey(secret).PrivateKey(V3)
+	if err != nil {
+		t.Fatalf("unable to derive private key: %v", err)
+	}
+	if !bytes.Equal(privateKey, sameKey) {
+		t.Fatalf("same secret derived different keys")
+	}
+
+	otherSecret := bytes.Repeat([]byte{0x02}, 32)
+	otherKey, err := NewDerivedOnionKey(otherSecret).PrivateKey(V3)
+	if err != nil {
+		t.Fatalf("unable to derive private key: %v", err)
+	}
+	if bytes.Equal(privateKey, otherKey) {
+		t.Fatalf("different secrets derived the same key")
+	}
+
+	if _, err := NewDerivedOnionKey(secret).PrivateKey(V2); err == nil {
+		t.Fatalf("expected v2 key derivation to fail")
+	}
+}
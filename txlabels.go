@@ -0,0 +1,43 @@
+package dcrlnd
+
+import (
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/channeldb"
+)
+
+const (
+	// fundingTxLabel is the label given to the funding transactions we
+	// broadcast.
+	fundingTxLabel = "channel funding"
+
+	// coopCloseTxLabel is the label given to the cooperative close
+	// transactions we broadcast.
+	coopCloseTxLabel = "cooperative channel close"
+
+	// sweepTxLabel is the label given to the transactions broadcast by the
+	// sweeper.
+	sweepTxLabel = "sweep"
+)
+
+// labeledPublisher wraps the passed function broadcasting transactions, so
+// that the transactions successfully broadcast are given the passed label.
+// Transactions which already have a label, for example one set by the user,
+// keep it. As the transaction is already broadcast, failing to store its
+// label is only logged.
+func labeledPublisher(db *channeldb.DB, label string,
+	publish func(*wire.MsgTx) error) func(*wire.MsgTx) error {
+
+	return func(tx *wire.MsgTx) error {
+		if err := publish(tx); err != nil {
+			return err
+		}
+
+		txid := tx.TxHash()
+		err := db.AddTxLabel(txid, label)
+		if err != nil && err != channeldb.ErrTxLabelExists {
+			srvrLog.Errorf("Unable to label tx %v: %v", txid, err)
+		}
+
+		return nil
+	}
+}
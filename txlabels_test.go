@@ -0,0 +1,69 @@
+// +build !rpctest
+
+package dcrlnd
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/decred/dcrd/wire"
+)
+
+// TestLabeledPublisher asserts that only the transactions successfully
+// broadcast are labeled, and that existing labels are preserved.
+func TestLabeledPublisher(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	errPublish := errors.New("publish failed")
+	var publishErr error
+	publish := labeledPublisher(cdb, sweepTxLabel, func(*wire.MsgTx) error {
+		return publishErr
+	})
+
+	// A transaction that fails to be broadcast isn't labeled.
+	failedTx := wire.NewMsgTx()
+	failedTx.LockTime = 1
+	publishErr = errPublish
+	if err := publish(failedTx); err != errPublish {
+		t.Fatalf("expected %v, got %v", errPublish, err)
+	}
+
+	// A transaction already labeled by the user keeps its label.
+	userTx := wire.NewMsgTx()
+	userTx.LockTime = 2
+	if err := cdb.PutTxLabel(userTx.TxHash(), "user"); err != nil {
+		t.Fatalf("unable to label tx: %v", err)
+	}
+
+	sweepTx := wire.NewMsgTx()
+	sweepTx.LockTime = 3
+
+	publishErr = nil
+	for _, tx := range []*wire.MsgTx{userTx, sweepTx} {
+		if err := publish(tx); err != nil {
+			t.Fatalf("unable to publish tx: %v", err)
+		}
+	}
+
+	labels, err := cdb.FetchTxLabels()
+	if err != nil {
+		t.Fatalf("unable to fetch labels: %v", err)
+	}
+	if _, ok := labels[failedTx.TxHash()]; ok {
+		t.Fatalf("expected failed tx to not be labeled")
+	}
+	if labels[userTx.TxHash()] != "user" {
+		t.Fatalf("expected user label, got %q",
+			labels[userTx.TxHash()])
+	}
+	if labels[sweepTx.TxHash()] != sweepTxLabel {
+		t.Fatalf("expected sweep label, got %q",
+			labels[sweepTx.TxHash()])
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"sync/atomic"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/decred/dcrd/chaincfg/chainhash"
 	"github.com/decred/dcrd/dcrutil/v2"
 	"github.com/decred/dcrd/wire"
 	"github.com/decred/dcrlnd/chainntnfs"
@@ -163,6 +164,10 @@ var (
 	// ErrContractNotFound is returned when the nursery is unable to
 	// retrieve information about a queried contract.
 	ErrContractNotFound = fmt.Errorf("unable to locate contract")
+
+	// ErrLimboOutputNotFound is returned when the nursery is unable to
+	// locate an output which is still incubating.
+	ErrLimboOutputNotFound = fmt.Errorf("unable to locate output in limbo")
 )
 
 // NurseryConfig abstracts the required subsystems used by the utxo nursery. An
@@ -201,6 +206,15 @@ type NurseryConfig struct {
 
 	// Sweep sweeps an input back to the wallet.
 	SweepInput func(input.Input, sweep.FeePreference) (chan sweep.Result, error)
+
+	// BumpFee updates the fee preference of an input offered to the
+	// sweeper, so that a new sweep transaction is broadcast right away.
+	BumpFee func(wire.OutPoint, sweep.FeePreference) (chan sweep.Result,
+		error)
+
+	// ConfTarget is the confirmation target used to sweep the CSV delayed
+	// outputs. If zero, kgtnOutputConfTarget is used.
+	ConfTarget uint32
 }
 
 // utxoNursery is a system dedicated to incubating time-locked outputs created
@@ -324,6 +338,16 @@ func (u *utxoNursery) Stop() error {
 	return nil
 }
 
+// confTarget returns the confirmation target of the sweeps of the CSV delayed
+// outputs.
+func (u *utxoNursery) confTarget() uint32 {
+	if u.cfg.ConfTarget == 0 {
+		return kgtnOutputConfTarget
+	}
+
+	return u.cfg.ConfTarget
+}
+
 // IncubateOutputs sends a request to the utxoNursery to incubate a set of
 // outputs from an existing commitment transaction. Outputs need to incubate if
 // they're CLTV absolute time locked, or if they're CSV relative time locked.
@@ -421,10 +445,11 @@ func (u *utxoNursery) IncubateOutputs(chanPoint wire.OutPoint,
 
 		// Otherwise, this is actually a kid output as we can sweep it
 		// once the commitment transaction confirms, and the absolute
-		// CLTV lock has expired. We set the CSV delay to zero to
-		// indicate this is actually a CLTV output.
+		// CLTV lock has expired. The CSV delay is zero unless this is
+		// a channel with anchors, in which case the output must also
+		// have been confirmed for a block.
 		htlcOutput := makeKidOutput(
-			&htlcRes.ClaimOutpoint, &chanPoint, 0,
+			&htlcRes.ClaimOutpoint, &chanPoint, htlcRes.CsvDelay,
 			input.HtlcOfferedRemoteTimeout,
 			&htlcRes.SweepSignDesc, htlcRes.Expiry,
 		)
@@ -618,6 +643,121 @@ func (u *utxoNursery) NurseryReport(
 	return report, nil
 }
 
+// RebroadcastOutput immediately re-broadcasts the transaction sweeping an
+// output in limbo, if it doesn't confirm. A crib output is swept by its
+// pre-signed timeout transaction, which is published again once the htlc
+// expired and whose txid is returned. The fee of the timeout transaction
+// can't be changed, so no fee preference may be given for a crib output. A
+// kindergarten output is instead swept again by the sweeper at the given fee
+// preference, or at the default one of the nursery if none is given.
+func (u *utxoNursery) RebroadcastOutput(outpoint wire.OutPoint,
+	feePref sweep.FeePreference) (*chainhash.Hash, error) {
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	chanPoints, err := u.cfg.Store.ListChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	// Look for the output among the incubating outputs of all channels.
+	// Only crib and kindergarten outputs are being swept.
+	var (
+		baby      *babyOutput
+		kid       *kidOutput
+		isKndrKid bool
+	)
+	findOutput := func(k, v []byte) error {
+		switch {
+		case bytes.HasPrefix(k, cribPrefix):
+			var candidate babyOutput
+			err := candidate.Decode(bytes.NewReader(v))
+			if err != nil {
+				return err
+			}
+			if *candidate.OutPoint() == outpoint {
+				baby = &candidate
+			}
+
+		case bytes.HasPrefix(k, psclPrefix),
+			bytes.HasPrefix(k, kndrPrefix):
+
+			var candidate kidOutput
+			err := candidate.Decode(bytes.NewReader(v))
+			if err != nil {
+				return err
+			}
+			if *candidate.OutPoint() == outpoint {
+				kid = &candidate
+				isKndrKid = bytes.HasPrefix(k, kndrPrefix)
+			}
+		}
+
+		return nil
+	}
+	for i := range chanPoints {
+		err := u.cfg.Store.ForChanOutputs(&chanPoints[i], findOutput)
+		if err == ErrContractNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch {
+	case baby != nil:
+		if feePref.ConfTarget != 0 || feePref.FeeRate != 0 {
+			return nil, fmt.Errorf("fee of the pre-signed timeout "+
+				"transaction of %v can't be changed", outpoint)
+		}
+
+		bestHeight := atomic.LoadUint32(&u.bestHeight)
+		if bestHeight < baby.expiry {
+			return nil, fmt.Errorf("htlc %v only expires at height "+
+				"%v", outpoint, baby.expiry)
+		}
+
+		timeoutTxid := baby.timeoutTx.TxHash()
+		utxnLog.Infof("Re-broadcasting timeout tx (txid=%v) of htlc "+
+			"output %v", timeoutTxid, outpoint)
+
+		err := u.cfg.PublishTransaction(baby.timeoutTx)
+		if err != nil && err != lnwallet.ErrDoubleSpend {
+			return nil, err
+		}
+
+		return &timeoutTxid, nil
+
+	case kid != nil && isKndrKid:
+		if feePref.ConfTarget == 0 && feePref.FeeRate == 0 {
+			feePref.ConfTarget = u.confTarget()
+		}
+
+		utxnLog.Infof("Re-broadcasting sweep of kindergarten output "+
+			"%v with fee preference %v", outpoint, feePref)
+
+		_, err := u.cfg.BumpFee(outpoint, feePref)
+		if err == lnwallet.ErrNotMine {
+			return nil, fmt.Errorf("output %v isn't being swept yet",
+				outpoint)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, nil
+
+	case kid != nil:
+		return nil, fmt.Errorf("output %v is awaiting the confirmation "+
+			"of its commitment transaction", outpoint)
+
+	default:
+		return nil, ErrLimboOutputNotFound
+	}
+}
+
 // reloadPreschool re-initializes the chain notifier with all of the outputs
 // that had been saved to the "preschool" database bucket prior to shutdown.
 func (u *utxoNursery) reloadPreschool() error {
@@ -807,7 +947,7 @@ func (u *utxoNursery) sweepMatureOutputs(classHeight uint32,
 	utxnLog.Infof("Sweeping %v CSV-delayed outputs with sweep tx for "+
 		"height %v", len(kgtnOutputs), classHeight)
 
-	feePref := sweep.FeePreference{ConfTarget: kgtnOutputConfTarget}
+	feePref := sweep.FeePreference{ConfTarget: u.confTarget()}
 	for _, output := range kgtnOutputs {
 		// Create local copy to prevent pointer to loop variable to be
 		// passed in with disastrous consequences.
@@ -1076,6 +1216,98 @@ type contractMaturityReport struct {
 
 	// htlcs records a maturity report for each htlc output in this channel.
 	htlcs []htlcMaturityReport
+
+	// limboOutputs records a report for each output of this channel whose
+	// funds are still in limbo, including the commitment output.
+	limboOutputs []limboOutputReport
+}
+
+// limboClass describes the kind of time lock that keeps the funds of an
+// output in limbo.
+type limboClass uint8
+
+const (
+	// limboClassCSV is the class of commitment outputs, which are locked
+	// for a relative delay after the commitment transaction confirms.
+	limboClassCSV limboClass = iota
+
+	// limboClassCLTV is the class of htlc outputs that are locked until
+	// the absolute expiry height of the htlc.
+	limboClassCLTV
+
+	// limboClassSecondLevel is the class of the outputs of second-level
+	// htlc transactions, which are locked for a relative delay after the
+	// second-level transaction confirms.
+	limboClassSecondLevel
+)
+
+// limboStage describes what an output in limbo is waiting for before its
+// funds can be swept back into the wallet.
+type limboStage uint8
+
+const (
+	// limboStageAwaitingExpiry indicates that the expiry of the htlc must
+	// be reached before its second-level timeout transaction can be
+	// broadcast.
+	limboStageAwaitingExpiry limboStage = iota
+
+	// limboStageAwaitingConfirmation indicates that the transaction that
+	// creates the output has yet to confirm.
+	limboStageAwaitingConfirmation
+
+	// limboStageAwaitingMaturity indicates that the output is confirmed,
+	// and will be swept once its time lock expires.
+	limboStageAwaitingMaturity
+)
+
+// limboOutputReport provides a summary of a single output whose funds are
+// still in limbo, and is embedded as part of the overarching
+// contractMaturityReport.
+type limboOutputReport struct {
+	// outpoint is the output that will be swept back to the wallet.
+	outpoint wire.OutPoint
+
+	// amount is the value of the output.
+	amount dcrutil.Amount
+
+	// class is the kind of time lock that keeps the output in limbo.
+	class limboClass
+
+	// stage is what the output is waiting for before it can be swept.
+	stage limboStage
+
+	// maturityHeight is the absolute block height at which the current
+	// stage of the output is over. It is zero if it isn't known yet.
+	maturityHeight uint32
+
+	// timeoutTxid is the txid of the pre-signed timeout transaction
+	// sweeping the output, if it is swept by one.
+	timeoutTxid *chainhash.Hash
+}
+
+// addLimboOutput adds a report of an output whose funds are in limbo to the
+// maturity report.
+func (c *contractMaturityReport) addLimboOutput(outpoint wire.OutPoint,
+	amount dcrutil.Amount, class limboClass, stage limboStage,
+	maturityHeight uint32) {
+
+	c.limboOutputs = append(c.limboOutputs, limboOutputReport{
+		outpoint:       outpoint,
+		amount:         amount,
+		class:          class,
+		stage:          stage,
+		maturityHeight: maturityHeight,
+	})
+}
+
+// confirmationStage returns the stage of an output that is awaiting either
+// the confirmation of the transaction creating it, or its maturity.
+func confirmationStage(kid *kidOutput) limboStage {
+	if kid.ConfHeight() == 0 {
+		return limboStageAwaitingConfirmation
+	}
+
+	return limboStageAwaitingMaturity
 }
 
 // htlcMaturityReport provides a summary of a single htlc output, and is
@@ -1108,6 +1340,11 @@ func (c *contractMaturityReport) AddLimboCommitment(kid *kidOutput) {
 	if kid.ConfHeight() != 0 {
 		c.maturityHeight = kid.BlocksToMaturity() + kid.ConfHeight()
 	}
+
+	c.addLimboOutput(
+		*kid.OutPoint(), kid.Amount(), limboClassCSV,
+		confirmationStage(kid), c.maturityHeight,
+	)
 }
 
 // AddRecoveredCommitment adds a graduated commitment output to maturity
@@ -1130,6 +1367,14 @@ func (c *contractMaturityReport) AddLimboStage1TimeoutHtlc(baby *babyOutput) {
 		maturityHeight: baby.expiry,
 		stage:          1,
 	})
+
+	c.addLimboOutput(
+		*baby.OutPoint(), baby.Amount(), limboClassCLTV,
+		limboStageAwaitingExpiry, baby.expiry,
+	)
+
+	timeoutTxid := baby.timeoutTx.TxHash()
+	c.limboOutputs[len(c.limboOutputs)-1].timeoutTxid = &timeoutTxid
 }
 
 // AddLimboDirectHtlc adds a direct HTLC on the commitment transaction of the
@@ -1146,6 +1391,11 @@ func (c *contractMaturityReport) AddLimboDirectHtlc(kid *kidOutput) {
 	}
 
 	c.htlcs = append(c.htlcs, htlcReport)
+
+	c.addLimboOutput(
+		*kid.OutPoint(), kid.Amount(), limboClassCLTV,
+		confirmationStage(kid), kid.absoluteMaturity,
+	)
 }
 
 // AddLimboStage1SuccessHtlcHtlc adds an htlc crib output to the maturity
@@ -1159,6 +1409,11 @@ func (c *contractMaturityReport) AddLimboStage1SuccessHtlc(kid *kidOutput) {
 		amount:   kid.Amount(),
 		stage:    1,
 	})
+
+	c.addLimboOutput(
+		*kid.OutPoint(), kid.Amount(), limboClassSecondLevel,
+		limboStageAwaitingConfirmation, 0,
+	)
 }
 
 // AddLimboStage2Htlc adds an htlc kindergarten output to the maturity report's
@@ -1180,6 +1435,11 @@ func (c *contractMaturityReport) AddLimboStage2Htlc(kid *kidOutput) {
 	}
 
 	c.htlcs = append(c.htlcs, htlcReport)
+
+	c.addLimboOutput(
+		*kid.OutPoint(), kid.Amount(), limboClassSecondLevel,
+		confirmationStage(kid), htlcReport.maturityHeight,
+	)
 }
 
 // AddRecoveredHtlc adds a graduate output to the maturity report's htlcs, and
@@ -471,6 +471,7 @@ func createNurseryTestContext(t *testing.T,
 		Store:      storeIntercepter,
 		ChainIO:    chainIO,
 		SweepInput: sweeper.sweepInput,
+		BumpFee:    sweeper.bumpFee,
 		PublishTransaction: func(tx *wire.MsgTx) error {
 			return publishFunc(tx, "nursery")
 		},
@@ -515,6 +516,7 @@ func createNurseryTestContext(t *testing.T,
 
 			/// Restart nursery.
 			nurseryCfg.SweepInput = ctx.sweeper.sweepInput
+			nurseryCfg.BumpFee = ctx.sweeper.bumpFee
 			ctx.nursery = newUtxoNursery(&nurseryCfg)
 			ctx.nursery.Start()
 
@@ -699,6 +701,17 @@ func assertNurseryReport(t *testing.T, nursery *utxoNursery,
 		t.Fatalf("expected limbo balance to be %v, but it is %v instead",
 			expectedLimboBalance, report.limboBalance)
 	}
+
+	// Every output in limbo should be reported individually.
+	var limboOutputsBalance dcrutil.Amount
+	for _, output := range report.limboOutputs {
+		limboOutputsBalance += output.amount
+	}
+	if limboOutputsBalance != report.limboBalance {
+		t.Fatalf("expected limbo outputs to sum up to %v, but they "+
+			"sum up to %v", report.limboBalance,
+			limboOutputsBalance)
+	}
 }
 
 func assertNurseryReportUnavailable(t *testing.T, nursery *utxoNursery) {
@@ -896,6 +909,109 @@ func testNurseryCommitSuccessOnLocal(t *testing.T,
 	ctx.finish()
 }
 
+// TestNurseryRebroadcastOutput asserts that the timeout transaction of an
+// expired crib output is published again as is, and that the sweep of a
+// kindergarten output is bumped to the requested fee preference.
+func TestNurseryRebroadcastOutput(t *testing.T) {
+	ctx := createNurseryTestContext(t, func(func()) bool { return false })
+
+	outgoingRes := incubateTestOutput(t, ctx.nursery, true)
+	// Both the crib output and the kindergarten output it's promoted to
+	// are identified by the output of the timeout tx.
+	op := outgoingRes.ClaimOutpoint
+	timeoutTxHash := outgoingRes.SignedTimeoutTx.TxHash()
+
+	// The timeout tx is reported along with the crib output.
+	report, err := ctx.nursery.NurseryReport(&testChanPoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	timeoutTxid := report.limboOutputs[0].timeoutTxid
+	if timeoutTxid == nil || *timeoutTxid != timeoutTxHash {
+		t.Fatalf("expected timeout txid %v, got %v", timeoutTxHash,
+			timeoutTxid)
+	}
+
+	// The timeout tx can't be published before the htlc expires.
+	_, err = ctx.nursery.RebroadcastOutput(op, sweep.FeePreference{})
+	if err == nil {
+		t.Fatal("expected rebroadcast of unexpired htlc to fail")
+	}
+
+	ctx.notifyEpoch(125)
+	ctx.receiveTx()
+
+	// The fee of the timeout tx can't be changed.
+	_, err = ctx.nursery.RebroadcastOutput(
+		op, sweep.FeePreference{ConfTarget: 2},
+	)
+	if err == nil {
+		t.Fatal("expected rebroadcast with fee preference to fail")
+	}
+
+	txid, err := ctx.nursery.RebroadcastOutput(
+		op, sweep.FeePreference{},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *txid != timeoutTxHash {
+		t.Fatalf("expected timeout tx %v, got %v", timeoutTxHash, txid)
+	}
+	if tx := ctx.receiveTx(); tx.TxHash() != timeoutTxHash {
+		t.Fatalf("expected timeout tx %v to be published, got %v",
+			timeoutTxHash, tx.TxHash())
+	}
+
+	if err := ctx.notifier.ConfirmTx(&timeoutTxHash, 126); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-ctx.store.cribToKinderChan:
+	case <-time.After(defaultTestTimeout):
+		t.Fatalf("output not promoted to KNDR")
+	}
+
+	// The kindergarten output can't be bumped until it's being swept.
+	_, err = ctx.nursery.RebroadcastOutput(op, sweep.FeePreference{})
+	if err == nil {
+		t.Fatal("expected rebroadcast of immature output to fail")
+	}
+
+	ctx.notifyEpoch(128)
+	ctx.sweeper.expectSweep()
+
+	feePref := sweep.FeePreference{FeeRate: 50000}
+	txid, err = ctx.nursery.RebroadcastOutput(op, feePref)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if txid != nil {
+		t.Fatalf("expected no timeout tx, got %v", txid)
+	}
+	if bumped := ctx.sweeper.bumped[op]; bumped != feePref {
+		t.Fatalf("expected fee preference %v, got %v", feePref,
+			bumped)
+	}
+
+	// Outputs unknown to the nursery can't be rebroadcast.
+	_, err = ctx.nursery.RebroadcastOutput(
+		wire.OutPoint{Index: 5}, sweep.FeePreference{},
+	)
+	if err != ErrLimboOutputNotFound {
+		t.Fatalf("expected ErrLimboOutputNotFound, got %v", err)
+	}
+
+	ctx.sweeper.sweepAll()
+	select {
+	case <-ctx.store.graduateKinderChan:
+	case <-time.After(defaultTestTimeout):
+		t.Fatalf("output not graduated")
+	}
+
+	ctx.finish()
+}
+
 func testSweepHtlc(t *testing.T, ctx *nurseryTestContext) {
 	testSweep(t, ctx, func() {
 		// Verify stage in nursery report. HTLCs should now both still
@@ -1027,6 +1143,7 @@ type mockSweeper struct {
 	lock sync.Mutex
 
 	resultChans map[wire.OutPoint]chan sweep.Result
+	bumped      map[wire.OutPoint]sweep.FeePreference
 	t           *testing.T
 
 	sweepChan chan input.Input
@@ -1035,6 +1152,7 @@ type mockSweeper struct {
 func newMockSweeper(t *testing.T) *mockSweeper {
 	return &mockSweeper{
 		resultChans: make(map[wire.OutPoint]chan sweep.Result),
+		bumped:      make(map[wire.OutPoint]sweep.FeePreference),
 		sweepChan:   make(chan input.Input, 1),
 		t:           t,
 	}
@@ -1060,6 +1178,21 @@ func (s *mockSweeper) sweepInput(input input.Input,
 	return c, nil
 }
 
+func (s *mockSweeper) bumpFee(op wire.OutPoint,
+	feePref sweep.FeePreference) (chan sweep.Result, error) {
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	c, ok := s.resultChans[op]
+	if !ok {
+		return nil, lnwallet.ErrNotMine
+	}
+	s.bumped[op] = feePref
+
+	return c, nil
+}
+
 func (s *mockSweeper) expectSweep() {
 	s.t.Helper()
 
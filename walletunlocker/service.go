@@ -6,14 +6,17 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/decred/dcrd/chaincfg/v2"
 	"github.com/decred/dcrlnd/aezeed"
 	"github.com/decred/dcrlnd/chanbackup"
+	"github.com/decred/dcrlnd/channeldb"
 	"github.com/decred/dcrlnd/keychain"
 	"github.com/decred/dcrlnd/lnrpc"
 	"github.com/decred/dcrlnd/lnwallet"
+	"github.com/decred/dcrlnd/macaroons"
 
 	"github.com/decred/dcrlnd/lnwallet/dcrwallet"
 	walletloader "github.com/decred/dcrlnd/lnwallet/dcrwallet/loader"
@@ -24,6 +27,14 @@ import (
 	"google.golang.org/grpc/credentials"
 )
 
+var (
+	// ErrNoAdminMacaroon is returned when the daemon stops the unlocker
+	// without sending back the admin macaroon, e.g. because it failed to
+	// start with the provided password.
+	ErrNoAdminMacaroon = errors.New("daemon shut down before creating " +
+		"the admin macaroon")
+)
+
 // ChannelsToRecover wraps any set of packed (serialized+encrypted) channel
 // back ups together. These can be passed in when unlocking the wallet, or
 // creating a new wallet for the first time with an existing seed.
@@ -60,6 +71,10 @@ type WalletInitMsg struct {
 	// ChanBackups a set of static channel backups that should be received
 	// after the wallet has been initialized.
 	ChanBackups ChannelsToRecover
+
+	// StatelessInit signals that the daemon must not create any macaroon
+	// files on disk.
+	StatelessInit bool
 }
 
 // WalletUnlockMsg is a message sent by the UnlockerService when a user wishes
@@ -93,6 +108,10 @@ type WalletUnlockMsg struct {
 	// ChanBackups a set of static channel backups that should be received
 	// after the wallet has been unlocked.
 	ChanBackups ChannelsToRecover
+
+	// StatelessInit signals that the daemon must not create any macaroon
+	// files on disk.
+	StatelessInit bool
 }
 
 // UnlockerService implements the WalletUnlocker service used to provide lnd
@@ -108,30 +127,65 @@ type UnlockerService struct {
 	// sent.
 	UnlockMsgs chan *WalletUnlockMsg
 
+	// MacResponseChan is the channel the daemon sends the admin macaroon
+	// over once it has started its macaroon service, so that it's
+	// returned to the caller of the RPC that provided the password. The
+	// daemon closes it when it's done with the unlocker.
+	MacResponseChan chan []byte
+
 	chainDir       string
 	noFreelistSync bool
 	netParams      *chaincfg.Params
+	macaroonDir    string
 	macaroonFiles  []string
+	encryptedDBDir string
 
 	dcrwHost    string
 	dcrwCert    string
 	dcrwAccount int32
 }
 
-// New creates and returns a new UnlockerService.
+// New creates and returns a new UnlockerService. The macaroon database found in
+// macaroonDir, if any, is re-encrypted when changing the wallet's password. An
+// empty macaroonDir disables macaroons altogether. Likewise, the encrypted
+// channel database found in encryptedDBDir, if any, is re-encrypted when
+// changing the wallet's password, which is left empty unless the channel
+// database is encrypted with the wallet's password.
 func New(chainDir string, params *chaincfg.Params, noFreelistSync bool,
-	macaroonFiles []string, dcrwHost, dcrwCert string, dcrwAccount int32) *UnlockerService {
+	macaroonDir string, macaroonFiles []string, encryptedDBDir string,
+	dcrwHost, dcrwCert string, dcrwAccount int32) *UnlockerService {
 
 	return &UnlockerService{
-		InitMsgs:       make(chan *WalletInitMsg, 1),
-		UnlockMsgs:     make(chan *WalletUnlockMsg, 1),
-		chainDir:       chainDir,
-		noFreelistSync: noFreelistSync,
-		netParams:      params,
-		macaroonFiles:  macaroonFiles,
-		dcrwHost:       dcrwHost,
-		dcrwCert:       dcrwCert,
-		dcrwAccount:    dcrwAccount,
+		InitMsgs:        make(chan *WalletInitMsg, 1),
+		UnlockMsgs:      make(chan *WalletUnlockMsg, 1),
+		MacResponseChan: make(chan []byte, 1),
+		chainDir:        chainDir,
+		noFreelistSync:  noFreelistSync,
+		netParams:       params,
+		macaroonDir:     macaroonDir,
+		macaroonFiles:   macaroonFiles,
+		encryptedDBDir:  encryptedDBDir,
+		dcrwHost:        dcrwHost,
+		dcrwCert:        dcrwCert,
+		dcrwAccount:     dcrwAccount,
+	}
+}
+
+// waitForAdminMacaroon waits for the daemon to send back the admin macaroon
+// once it has been unlocked with the password sent over one of the message
+// channels.
+func (u *UnlockerService) waitForAdminMacaroon(
+	ctx context.Context) ([]byte, error) {
+
+	select {
+	case adminMac, ok := <-u.MacResponseChan:
+		if !ok {
+			return nil, ErrNoAdminMacaroon
+		}
+		return adminMac, nil
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
 	}
 }
 
@@ -324,6 +378,7 @@ func (u *UnlockerService) InitWallet(ctx context.Context,
 		Passphrase:     password,
 		WalletSeed:     cipherSeed,
 		RecoveryWindow: uint32(gapLimit),
+		StatelessInit:  in.StatelessInit,
 	}
 
 	// Before we return the unlock payload, we'll check if we can extract
@@ -335,16 +390,24 @@ func (u *UnlockerService) InitWallet(ctx context.Context,
 
 	u.InitMsgs <- initMsg
 
-	return &lnrpc.InitWalletResponse{}, nil
+	// The daemon creates the wallet and then sends back the admin
+	// macaroon, which is the only copy of it in case of a stateless init.
+	adminMac, err := u.waitForAdminMacaroon(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.InitWalletResponse{AdminMacaroon: adminMac}, nil
 }
 
 // UnlockRemoteWallet sends the password provided by the incoming
 // UnlockRemoteWalletRequest over the UnlockMsgs channel in case it
 // successfully decrypts an existing remote wallet.
-func (u *UnlockerService) unlockRemoteWallet(password []byte,
-	chanBackups *lnrpc.ChanBackupSnapshot) (*lnrpc.UnlockWalletResponse, error) {
+func (u *UnlockerService) unlockRemoteWallet(ctx context.Context,
+	in *lnrpc.UnlockWalletRequest) (*lnrpc.UnlockWalletResponse, error) {
 
 	ctxb := context.Background()
+	password := in.WalletPassword
 
 	creds, err := credentials.NewClientTLSFromFile(u.dcrwCert, "localhost")
 	if err != nil {
@@ -372,13 +435,14 @@ func (u *UnlockerService) unlockRemoteWallet(password []byte,
 	// We successfully opened the wallet and pass the instance back to
 	// avoid it needing to be unlocked again.
 	walletUnlockMsg := &WalletUnlockMsg{
-		Passphrase: password,
-		Conn:       conn,
+		Passphrase:    password,
+		Conn:          conn,
+		StatelessInit: in.StatelessInit,
 	}
 
 	// Before we return the unlock payload, we'll check if we can extract
 	// any channel backups to pass up to the higher level sub-system.
-	chansToRestore := extractChanBackups(chanBackups)
+	chansToRestore := extractChanBackups(in.ChannelBackups)
 	if chansToRestore != nil {
 		walletUnlockMsg.ChanBackups = *chansToRestore
 	}
@@ -388,7 +452,12 @@ func (u *UnlockerService) unlockRemoteWallet(password []byte,
 	// such that it can be used by lnd to open the wallet.
 	u.UnlockMsgs <- walletUnlockMsg
 
-	return &lnrpc.UnlockWalletResponse{}, nil
+	adminMac, err := u.waitForAdminMacaroon(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.UnlockWalletResponse{AdminMacaroon: adminMac}, nil
 }
 
 // UnlockWallet sends the password provided by the incoming UnlockWalletRequest
@@ -399,7 +468,7 @@ func (u *UnlockerService) UnlockWallet(ctx context.Context,
 
 	password := in.WalletPassword
 	if u.dcrwHost != "" && u.dcrwCert != "" {
-		return u.unlockRemoteWallet(password, in.ChannelBackups)
+		return u.unlockRemoteWallet(ctx, in)
 	}
 	gapLimit := wallet.DefaultGapLimit
 	if int(in.RecoveryWindow) > gapLimit {
@@ -438,6 +507,7 @@ func (u *UnlockerService) UnlockWallet(ctx context.Context,
 		RecoveryWindow: uint32(gapLimit),
 		Wallet:         unlockedWallet,
 		Loader:         loader,
+		StatelessInit:  in.StatelessInit,
 	}
 
 	// Before we return the unlock payload, we'll check if we can extract
@@ -452,7 +522,12 @@ func (u *UnlockerService) UnlockWallet(ctx context.Context,
 	// channel, such that it can be used by lnd to open the wallet.
 	u.UnlockMsgs <- walletUnlockMsg
 
-	return &lnrpc.UnlockWalletResponse{}, nil
+	adminMac, err := u.waitForAdminMacaroon(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.UnlockWalletResponse{AdminMacaroon: adminMac}, nil
 }
 
 // ChangePassword changes the password of the wallet and sends the new password
@@ -501,18 +576,59 @@ func (u *UnlockerService) ChangePassword(ctx context.Context,
 	// Unload the wallet to allow lnd to open it later on.
 	defer loader.UnloadWallet()
 
-	// Since the macaroon database is also encrypted with the wallet's
-	// password, we'll remove all of the macaroon files so that they're
-	// re-generated at startup using the new password. We'll make sure to do
-	// this after unlocking the wallet to ensure macaroon files don't get
-	// deleted with incorrect password attempts.
-	for _, file := range u.macaroonFiles {
-		err := os.Remove(file)
-		if err != nil && !os.IsNotExist(err) {
-			return nil, err
+	// The macaroon database is also encrypted with the wallet's password,
+	// so we'll make sure it can be unlocked before changing anything.
+	macaroonService, err := u.openMacaroonService(privatePw)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if macaroonService != nil {
+			macaroonService.Close()
+		}
+	}()
+
+	// The macaroon files become invalid once the root key is replaced,
+	// and must not be left on disk in case of a stateless init, so we'll
+	// remove them and they'll be re-generated at startup if needed. We'll
+	// make sure to do this after unlocking the wallet to ensure macaroon
+	// files don't get deleted with incorrect password attempts.
+	if in.NewMacaroonRootKey || in.StatelessInit {
+		for _, file := range u.macaroonFiles {
+			err := os.Remove(file)
+			if err != nil && !os.IsNotExist(err) {
+				return nil, err
+			}
 		}
 	}
 
+	// The key of the channel database encrypted with the wallet's password
+	// is re-encrypted first, as this also checks the current password. It's
+	// reverted if the wallet's password can't be changed.
+	if u.encryptedDBDir != "" {
+		err := channeldb.ChangeDBPassword(
+			u.encryptedDBDir, privatePw, in.NewPassword,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to change channel "+
+				"database password: %v", err)
+		}
+	}
+	revertDBPassword := func(err error) error {
+		if u.encryptedDBDir == "" {
+			return err
+		}
+
+		revertErr := channeldb.ChangeDBPassword(
+			u.encryptedDBDir, in.NewPassword, privatePw,
+		)
+		if revertErr != nil {
+			return fmt.Errorf("%v, unable to revert channel "+
+				"database password: %v", err, revertErr)
+		}
+		return err
+	}
+
 	// Attempt to change both the public and private passphrases for the
 	// wallet. This will be done atomically in order to prevent one
 	// passphrase change from being successful and not the other.
@@ -521,8 +637,8 @@ func (u *UnlockerService) ChangePassword(ctx context.Context,
 	// actually use the public pssword.
 	err = w.ChangePrivatePassphrase(ctx, privatePw, in.NewPassword)
 	if err != nil {
-		return nil, fmt.Errorf("unable to change wallet private passphrase: "+
-			"%v", err)
+		return nil, revertDBPassword(fmt.Errorf("unable to change "+
+			"wallet private passphrase: %v", err))
 	}
 	err = w.ChangePublicPassphrase(ctx, publicPw, in.NewPassword)
 	if err != nil {
@@ -530,11 +646,75 @@ func (u *UnlockerService) ChangePassword(ctx context.Context,
 			"%v", err)
 	}
 
+	// The root keys of the macaroon database are then re-encrypted with
+	// the new password, so that the existing macaroons remain valid,
+	// unless the caller asked for the root key to be replaced.
+	if macaroonService != nil {
+		err = macaroonService.ChangePassword(privatePw, in.NewPassword)
+		if err != nil {
+			return nil, fmt.Errorf("unable to change macaroon "+
+				"database password: %v", err)
+		}
+
+		if in.NewMacaroonRootKey {
+			err := macaroonService.GenerateNewRootKey()
+			if err != nil {
+				return nil, fmt.Errorf("unable to generate new "+
+					"macaroon root key: %v", err)
+			}
+		}
+
+		// The daemon opens the macaroon database itself once it's
+		// unlocked.
+		if err := macaroonService.Close(); err != nil {
+			return nil, err
+		}
+		macaroonService = nil
+	}
+
 	// Finally, send the new password across the UnlockPasswords channel to
 	// automatically unlock the wallet.
-	u.UnlockMsgs <- &WalletUnlockMsg{Passphrase: in.NewPassword}
+	u.UnlockMsgs <- &WalletUnlockMsg{
+		Passphrase:    in.NewPassword,
+		StatelessInit: in.StatelessInit,
+	}
+
+	adminMac, err := u.waitForAdminMacaroon(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.ChangePasswordResponse{AdminMacaroon: adminMac}, nil
+}
+
+// openMacaroonService opens and unlocks the macaroon database with the passed
+// password. A nil service is returned if macaroons are disabled or if the
+// database doesn't exist yet, in which case it will be created with the new
+// password by the daemon.
+func (u *UnlockerService) openMacaroonService(
+	password []byte) (*macaroons.Service, error) {
+
+	if u.macaroonDir == "" {
+		return nil, nil
+	}
+
+	dbPath := filepath.Join(u.macaroonDir, macaroons.DBFilename)
+	if _, err := os.Stat(dbPath); os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	macaroonService, err := macaroons.NewService(u.macaroonDir)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := macaroonService.CreateUnlock(&password); err != nil {
+		macaroonService.Close()
+		return nil, fmt.Errorf("unable to unlock macaroon database: "+
+			"%v", err)
+	}
 
-	return &lnrpc.ChangePasswordResponse{}, nil
+	return macaroonService, nil
 }
 
 // ValidatePassword assures the password meets all of our constraints.
@@ -5,6 +5,7 @@ import (
 	"context"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -15,9 +16,11 @@ import (
 	"github.com/decred/dcrlnd/lnrpc"
 	"github.com/decred/dcrlnd/lnwallet/dcrwallet"
 	walletloader "github.com/decred/dcrlnd/lnwallet/dcrwallet/loader"
+	"github.com/decred/dcrlnd/macaroons"
 	"github.com/decred/dcrlnd/walletunlocker"
 	"github.com/decred/dcrwallet/wallet/v3"
 	"github.com/decred/dcrwallet/wallet/v3/txrules"
+	bolt "go.etcd.io/bbolt"
 )
 
 var (
@@ -34,6 +37,8 @@ var (
 	testNetParams = chaincfg.MainNetParams()
 
 	testRecoveryWindow uint32 = 150
+
+	testMac = []byte("fakemacaroon")
 )
 
 func createTestWallet(t *testing.T, dir string, netParams *chaincfg.Params) {
@@ -54,6 +59,80 @@ func createTestWallet(t *testing.T, dir string, netParams *chaincfg.Params) {
 	}
 }
 
+// createTestMacaroonDB creates a macaroon database encrypted with the passed
+// password in the given directory, and returns its default root key.
+func createTestMacaroonDB(t *testing.T, dir string, pw []byte) []byte {
+	svc, err := macaroons.NewService(dir)
+	if err != nil {
+		t.Fatalf("unable to create macaroon service: %v", err)
+	}
+	defer svc.Close()
+
+	if err := svc.CreateUnlock(&pw); err != nil {
+		t.Fatalf("unable to unlock macaroon service: %v", err)
+	}
+
+	_, err = svc.NewMacaroon(
+		context.Background(), macaroons.DefaultRootKeyID,
+	)
+	if err != nil {
+		t.Fatalf("unable to create macaroon: %v", err)
+	}
+
+	return getTestRootKey(t, dir, pw)
+}
+
+// getTestRootKey returns the default root key of the macaroon database of the
+// given directory, decrypted with the passed password.
+func getTestRootKey(t *testing.T, dir string, pw []byte) []byte {
+	db, err := bolt.Open(
+		filepath.Join(dir, macaroons.DBFilename), 0600,
+		bolt.DefaultOptions,
+	)
+	if err != nil {
+		t.Fatalf("unable to open macaroon database: %v", err)
+	}
+
+	store, err := macaroons.NewRootKeyStorage(db)
+	if err != nil {
+		db.Close()
+		t.Fatalf("unable to create root key store: %v", err)
+	}
+	defer store.Close()
+
+	if err := store.CreateUnlock(&pw); err != nil {
+		t.Fatalf("unable to unlock root key store: %v", err)
+	}
+
+	rootKey, err := store.Get(
+		context.Background(), macaroons.DefaultRootKeyID,
+	)
+	if err != nil {
+		t.Fatalf("unable to get root key: %v", err)
+	}
+
+	return rootKey
+}
+
+// waitForAdminMacaroon plays the role of the daemon by sending the test admin
+// macaroon to the service, and waits for the RPC that provided the password to
+// return.
+func waitForAdminMacaroon(t *testing.T, service *walletunlocker.UnlockerService,
+	errChan chan error) {
+
+	service.MacResponseChan <- testMac
+
+	select {
+	case err := <-errChan:
+		if err != nil {
+			t.Fatalf("RPC failed: %v", err)
+		}
+
+	case <-time.After(3 * time.Second):
+		t.Fatalf("RPC didn't return")
+	}
+}
+
 // TestGenSeedUserEntropy tests that the gen seed method generates a valid
 // cipher seed mnemonic phrase and user provided source of entropy.
 func TestGenSeed(t *testing.T) {
@@ -67,7 +146,9 @@ func TestGenSeed(t *testing.T) {
 	}
 	defer os.RemoveAll(testDir)
 
-	service := walletunlocker.New(testDir, testNetParams, true, nil, "", "", 0)
+	service := walletunlocker.New(
+		testDir, testNetParams, true, "", nil, "", "", "", 0,
+	)
 
 	// Now that the service has been created, we'll ask it to generate a
 	// new seed for us given a test passphrase.
@@ -108,7 +189,9 @@ func TestGenSeedGenerateEntropy(t *testing.T) {
 	defer func() {
 		os.RemoveAll(testDir)
 	}()
-	service := walletunlocker.New(testDir, testNetParams, true, nil, "", "", 0)
+	service := walletunlocker.New(
+		testDir, testNetParams, true, "", nil, "", "", "", 0,
+	)
 
 	// Now that the service has been created, we'll ask it to generate a
 	// new seed for us given a test passphrase. Note that we don't actually
@@ -148,7 +231,9 @@ func TestGenSeedInvalidEntropy(t *testing.T) {
 	defer func() {
 		os.RemoveAll(testDir)
 	}()
-	service := walletunlocker.New(testDir, testNetParams, true, nil, "", "", 0)
+	service := walletunlocker.New(
+		testDir, testNetParams, true, "", nil, "", "", "", 0,
+	)
 
 	// Now that the service has been created, we'll ask it to generate a
 	// new seed for us given a test passphrase. However, we'll be using an
@@ -186,7 +271,9 @@ func TestInitWallet(t *testing.T) {
 	}()
 
 	// Create new UnlockerService.
-	service := walletunlocker.New(testDir, testNetParams, true, nil, "", "", 0)
+	service := walletunlocker.New(
+		testDir, testNetParams, true, "", nil, "", "", "", 0,
+	)
 
 	// Once we have the unlocker service created, we'll now instantiate a
 	// new cipher seed instance.
@@ -215,12 +302,19 @@ func TestInitWallet(t *testing.T) {
 		CipherSeedMnemonic: mnemonic[:],
 		AezeedPassphrase:   pass,
 		RecoveryWindow:     int32(testRecoveryWindow),
-	}
-	_, err = service.InitWallet(ctx, req)
-	if err != nil {
-		t.Fatalf("InitWallet call failed: %v", err)
+		StatelessInit:      true,
 	}
 
+	// The call only returns once the daemon has sent back the admin
+	// macaroon, which is then part of the response.
+	var resp *lnrpc.InitWalletResponse
+	errChan := make(chan error, 1)
+	go func() {
+		var err error
+		resp, err = service.InitWallet(ctx, req)
+		errChan <- err
+	}()
+
 	// The same user passphrase, and also the plaintext cipher seed
 	// should be sent over and match exactly.
 	select {
@@ -249,11 +343,20 @@ func TestInitWallet(t *testing.T) {
 				"got %v", testRecoveryWindow,
 				msg.RecoveryWindow)
 		}
+		if !msg.StatelessInit {
+			t.Fatalf("expected stateless init")
+		}
 
 	case <-time.After(3 * time.Second):
 		t.Fatalf("password not received")
 	}
 
+	waitForAdminMacaroon(t, service, errChan)
+	if !bytes.Equal(resp.AdminMacaroon, testMac) {
+		t.Fatalf("expected admin macaroon %x, got %x", testMac,
+			resp.AdminMacaroon)
+	}
+
 	// Create a wallet in testDir.
 	createTestWallet(t, testDir, testNetParams)
 
@@ -287,7 +390,9 @@ func TestCreateWalletInvalidEntropy(t *testing.T) {
 	}()
 
 	// Create new UnlockerService.
-	service := walletunlocker.New(testDir, testNetParams, true, nil, "", "", 0)
+	service := walletunlocker.New(
+		testDir, testNetParams, true, "", nil, "", "", "", 0,
+	)
 
 	// We'll attempt to init the wallet with an invalid cipher seed and
 	// passphrase.
@@ -320,7 +425,9 @@ func TestUnlockWallet(t *testing.T) {
 	}()
 
 	// Create new UnlockerService.
-	service := walletunlocker.New(testDir, testNetParams, true, nil, "", "", 0)
+	service := walletunlocker.New(
+		testDir, testNetParams, true, "", nil, "", "", "", 0,
+	)
 
 	ctx := context.Background()
 	req := &lnrpc.UnlockWalletRequest{
@@ -347,10 +454,13 @@ func TestUnlockWallet(t *testing.T) {
 	}
 
 	// With the correct password, we should be able to unlock the wallet.
-	_, err = service.UnlockWallet(ctx, req)
-	if err != nil {
-		t.Fatalf("unable to unlock wallet: %v", err)
-	}
+	var resp *lnrpc.UnlockWalletResponse
+	errChan := make(chan error, 1)
+	go func() {
+		var err error
+		resp, err = service.UnlockWallet(ctx, req)
+		errChan <- err
+	}()
 
 	// Password and recovery window should be sent over the channel.
 	select {
@@ -367,10 +477,17 @@ func TestUnlockWallet(t *testing.T) {
 	case <-time.After(3 * time.Second):
 		t.Fatalf("password not received")
 	}
+
+	waitForAdminMacaroon(t, service, errChan)
+	if !bytes.Equal(resp.AdminMacaroon, testMac) {
+		t.Fatalf("expected admin macaroon %x, got %x", testMac,
+			resp.AdminMacaroon)
+	}
 }
 
 // TestChangeWalletPassword tests that we can successfully change the wallet's
-// password needed to unlock it.
+// password needed to unlock it, and that the macaroon database is re-encrypted
+// along with it.
 func TestChangeWalletPassword(t *testing.T) {
 	t.Parallel()
 
@@ -382,7 +499,7 @@ func TestChangeWalletPassword(t *testing.T) {
 	defer os.RemoveAll(testDir)
 
 	// Create some files that will act as macaroon files that should be
-	// deleted after a password change is successful.
+	// deleted after a password change replacing the macaroon root key.
 	var tempFiles []string
 	for i := 0; i < 3; i++ {
 		file, err := ioutil.TempFile(testDir, "")
@@ -393,8 +510,14 @@ func TestChangeWalletPassword(t *testing.T) {
 		file.Close()
 	}
 
+	// Create a macaroon database encrypted with the current password.
+	rootKey := createTestMacaroonDB(t, testDir, testPassword)
+
 	// Create a new UnlockerService with our temp files.
-	service := walletunlocker.New(testDir, testNetParams, true, tempFiles, "", "", 0)
+	service := walletunlocker.New(
+		testDir, testNetParams, true, testDir, tempFiles, "", "", "",
+		0,
+	)
 
 	ctx := context.Background()
 	newPassword := []byte("hunter2???")
@@ -416,8 +539,9 @@ func TestChangeWalletPassword(t *testing.T) {
 	// Attempting to change the wallet's password using an incorrect
 	// current password should fail.
 	wrongReq := &lnrpc.ChangePasswordRequest{
-		CurrentPassword: []byte("wrong-ofc"),
-		NewPassword:     newPassword,
+		CurrentPassword:    []byte("wrong-ofc"),
+		NewPassword:        newPassword,
+		NewMacaroonRootKey: true,
 	}
 	_, err = service.ChangePassword(ctx, wrongReq)
 	if err == nil {
@@ -440,29 +564,81 @@ func TestChangeWalletPassword(t *testing.T) {
 		t.Fatal("expected call to ChangePassword to fail")
 	}
 
+	// changePassword changes the password and asserts that the new
+	// password is sent over the channel.
+	changePassword := func(req *lnrpc.ChangePasswordRequest) {
+		var resp *lnrpc.ChangePasswordResponse
+		errChan := make(chan error, 1)
+		go func() {
+			var err error
+			resp, err = service.ChangePassword(ctx, req)
+			errChan <- err
+		}()
+
+		select {
+		case unlockMsg := <-service.UnlockMsgs:
+			if !bytes.Equal(unlockMsg.Passphrase, req.NewPassword) {
+				t.Fatalf("expected to receive password %x, "+
+					"got %x", req.NewPassword,
+					unlockMsg.Passphrase)
+			}
+			if unlockMsg.StatelessInit != req.StatelessInit {
+				t.Fatalf("expected stateless init %v, got %v",
+					req.StatelessInit,
+					unlockMsg.StatelessInit)
+			}
+
+		case err := <-errChan:
+			t.Fatalf("unable to change wallet's password: %v", err)
+
+		case <-time.After(3 * time.Second):
+			t.Fatalf("password not received")
+		}
+
+		waitForAdminMacaroon(t, service, errChan)
+		if !bytes.Equal(resp.AdminMacaroon, testMac) {
+			t.Fatalf("expected admin macaroon %x, got %x", testMac,
+				resp.AdminMacaroon)
+		}
+	}
+
 	// When providing the correct wallet's current password and a new
 	// password that meets the length requirement, the password change
 	// should succeed.
-	_, err = service.ChangePassword(ctx, req)
-	if err != nil {
-		t.Fatalf("unable to change wallet's password: %v", err)
+	changePassword(req)
+
+	// The macaroon files are still valid, so they should still exist.
+	for _, tempFile := range tempFiles {
+		if _, err := os.Stat(tempFile); os.IsNotExist(err) {
+			t.Fatal("file does not exist but it should")
+		}
 	}
 
-	// The files should no longer exist.
+	// The root key of the macaroon database should now be encrypted with
+	// the new password.
+	newRootKey := getTestRootKey(t, testDir, newPassword)
+	if !bytes.Equal(rootKey, newRootKey) {
+		t.Fatalf("expected root key %x, got %x", rootKey, newRootKey)
+	}
+
+	// Changing the password again while replacing the root key in
+	// stateless mode should remove the macaroon files.
+	newPassword2 := []byte("hunter3???")
+	changePassword(&lnrpc.ChangePasswordRequest{
+		CurrentPassword:    newPassword,
+		NewPassword:        newPassword2,
+		StatelessInit:      true,
+		NewMacaroonRootKey: true,
+	})
+
 	for _, tempFile := range tempFiles {
 		if _, err := os.Open(tempFile); err == nil {
 			t.Fatal("file exists but it shouldn't")
 		}
 	}
 
-	// The new password should be sent over the channel.
-	select {
-	case unlockMsg := <-service.UnlockMsgs:
-		if !bytes.Equal(unlockMsg.Passphrase, newPassword) {
-			t.Fatalf("expected to receive password %x, got %x",
-				testPassword, unlockMsg.Passphrase)
-		}
-	case <-time.After(3 * time.Second):
-		t.Fatalf("password not received")
+	newRootKey = getTestRootKey(t, testDir, newPassword2)
+	if bytes.Equal(rootKey, newRootKey) {
+		t.Fatalf("root key wasn't replaced")
 	}
 }
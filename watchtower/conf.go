@@ -21,6 +21,10 @@ type Conf struct {
 	// WriteTimeout specifies the duration the tower will wait when trying
 	// to write a message from a client before hanging up.
 	WriteTimeout time.Duration `long:"writetimeout" description:"Duration the watchtower server will wait for messages to be written before hanging up on client connections"`
+
+	// ProxyProtocol specifies whether the connections accepted by the
+	// watchtower start with a PROXY protocol header.
+	ProxyProtocol bool `long:"proxyprotocol" description:"Expect the client connections accepted on the listen interfaces to start with a PROXY protocol header reporting the address of the client -- NOTE only use this if the interfaces can solely be reached through a proxy"`
 }
 
 // Apply completes the passed Config struct by applying any parsed Conf options.
@@ -86,5 +90,11 @@ func (c *Conf) Apply(cfg *Config,
 		cfg.WriteTimeout = c.WriteTimeout
 	}
 
+	// If the Config doesn't expect the PROXY protocol, we will use the
+	// parsed Conf value.
+	if !cfg.ProxyProtocol {
+		cfg.ProxyProtocol = c.ProxyProtocol
+	}
+
 	return cfg, nil
 }
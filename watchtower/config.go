@@ -94,4 +94,9 @@ type Config struct {
 	// message from the other end, if the connection has stopped buffering
 	// the server's replies.
 	WriteTimeout time.Duration
+
+	// ProxyProtocol specifies whether the connections accepted on the
+	// listening addresses start with a PROXY protocol header, reporting the
+	// address of the client the connection was relayed for.
+	ProxyProtocol bool
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/decred/dcrd/dcrec/secp256k1/v2"
 	"github.com/decred/dcrlnd/brontide"
+	"github.com/decred/dcrlnd/proxyproto"
 	"github.com/decred/dcrlnd/watchtower/lookout"
 	"github.com/decred/dcrlnd/watchtower/wtserver"
 )
@@ -71,6 +72,27 @@ func New(cfg *Config) (*Standalone, error) {
 	// communicate with this Standalone instance.
 	listeners := make([]net.Listener, 0, len(cfg.ListenAddrs))
 	for _, listenAddr := range cfg.ListenAddrs {
+		// If client connections are relayed by a proxy, the brontide
+		// handshake is carried out on top of the PROXY protocol.
+		if cfg.ProxyProtocol {
+			tcpListener, err := net.Listen(
+				"tcp", listenAddr.String(),
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			lis := proxyproto.NewListener(
+				tcpListener, proxyproto.DefaultHeaderTimeout,
+			)
+			listener := brontide.NewListenerFrom(
+				cfg.NodePrivKey, lis,
+			)
+
+			listeners = append(listeners, listener)
+			continue
+		}
+
 		listener, err := brontide.NewListener(
 			cfg.NodePrivKey, listenAddr.String(),
 		)
@@ -81,6 +81,15 @@ type Client interface {
 	// Policy returns the active client policy configuration.
 	Policy() wtpolicy.Policy
 
+	// SetChannelPolicy persistently overrides the backup behavior of a
+	// registered channel, allowing it to opt out of backups entirely or
+	// only while the client's sweep fee rate exceeds a given limit.
+	SetChannelPolicy(lnwire.ChannelID, wtdb.ClientChanPolicy) error
+
+	// ChannelPolicies returns the policy overrides of all channels that
+	// deviate from the client's default backup behavior.
+	ChannelPolicies() wtdb.ChannelPolicies
+
 	// RegisterChannel persistently initializes any channel-dependent
 	// parameters within the client. This should be called during link
 	// startup to ensure that the client is able to support the link during
@@ -235,6 +244,7 @@ type TowerClient struct {
 
 	backupMu          sync.Mutex
 	summaries         wtdb.ChannelSummaries
+	chanPolicies      wtdb.ChannelPolicies
 	chanCommitHeights map[lnwire.ChannelID]uint64
 
 	statTicker *time.Ticker
@@ -325,6 +335,13 @@ func New(config *Config) (*TowerClient, error) {
 		return nil, err
 	}
 
+	// Load the policy overrides of any channels that deviate from the
+	// client's default backup behavior.
+	chanPolicies, err := cfg.DB.FetchChanPolicies()
+	if err != nil {
+		return nil, err
+	}
+
 	c := &TowerClient{
 		cfg:               cfg,
 		pipeline:          newTaskPipeline(),
@@ -332,6 +349,7 @@ func New(config *Config) (*TowerClient, error) {
 		candidateSessions: candidateSessions,
 		activeSessions:    make(sessionQueueSet),
 		summaries:         chanSummaries,
+		chanPolicies:      chanPolicies,
 		statTicker:        time.NewTicker(DefaultStatInterval),
 		stats:             new(ClientStats),
 		newTowers:         make(chan *newTowerMsg),
@@ -569,7 +587,8 @@ func (c *TowerClient) RegisterChannel(chanID lnwire.ChannelID) error {
 //  - justice transaction would create dust outputs when trying to abide by the
 //    negotiated policy, or
 //  - breached outputs contain too little value to sweep at the target sweep fee
-//    rate.
+//    rate, or
+//  - channel's policy override excludes it from being backed up.
 func (c *TowerClient) BackupState(chanID *lnwire.ChannelID,
 	breachInfo *lnwallet.BreachRetribution, isTweakless bool) error {
 
@@ -581,6 +600,16 @@ func (c *TowerClient) BackupState(chanID *lnwire.ChannelID,
 		return ErrUnregisteredChannel
 	}
 
+	// Skip the backup if the channel has opted out of backups under the
+	// client's current policy.
+	if policy, ok := c.chanPolicies[*chanID]; ok && !c.allowsBackup(&policy) {
+		c.backupMu.Unlock()
+		log.Debugf("Skipping backup for chanid=%v at height=%d due to "+
+			"channel policy override", chanID,
+			breachInfo.RevokedStateNum)
+		return nil
+	}
+
 	// Ignore backups that have already been presented to the client.
 	height, ok := c.chanCommitHeights[*chanID]
 	if ok && breachInfo.RevokedStateNum <= height {
@@ -604,6 +633,69 @@ func (c *TowerClient) BackupState(chanID *lnwire.ChannelID,
 	return c.pipeline.QueueBackupTask(task)
 }
 
+// allowsBackup returns true if the given channel policy override permits the
+// channel to be backed up under the client's policy.
+func (c *TowerClient) allowsBackup(policy *wtdb.ClientChanPolicy) bool {
+	switch {
+	case policy.BackupsDisabled:
+		return false
+
+	case policy.MaxSweepFeeRate != 0 &&
+		c.cfg.Policy.SweepFeeRate > policy.MaxSweepFeeRate:
+
+		return false
+
+	default:
+		return true
+	}
+}
+
+// SetChannelPolicy persistently overrides the backup behavior of a registered
+// channel. The override applies to all revoked states presented to the client
+// after this call returns. Setting a policy that doesn't override any of the
+// client's behavior restores the default behavior for the channel.
+func (c *TowerClient) SetChannelPolicy(chanID lnwire.ChannelID,
+	policy wtdb.ClientChanPolicy) error {
+
+	c.backupMu.Lock()
+	defer c.backupMu.Unlock()
+
+	if _, ok := c.summaries[chanID]; !ok {
+		return ErrUnregisteredChannel
+	}
+
+	err := c.cfg.DB.SetChanPolicy(chanID, &policy)
+	if err != nil {
+		return err
+	}
+
+	if policy.IsDefault() {
+		delete(c.chanPolicies, chanID)
+	} else {
+		c.chanPolicies[chanID] = policy
+	}
+
+	log.Infof("Updated backup policy of chanid=%v: backups_disabled=%v, "+
+		"max_sweep_fee_rate=%v", chanID, policy.BackupsDisabled,
+		policy.MaxSweepFeeRate)
+
+	return nil
+}
+
+// ChannelPolicies returns the policy overrides of all channels that deviate
+// from the client's default backup behavior.
+func (c *TowerClient) ChannelPolicies() wtdb.ChannelPolicies {
+	c.backupMu.Lock()
+	defer c.backupMu.Unlock()
+
+	policies := make(wtdb.ChannelPolicies, len(c.chanPolicies))
+	for chanID, policy := range c.chanPolicies {
+		policies[chanID] = policy
+	}
+
+	return policies
+}
+
 // nextSessionQueue attempts to fetch an active session from our set of
 // candidate sessions. Candidate sessions with a differing policy from the
 // active client's advertised policy will be ignored, but may be resumed if the
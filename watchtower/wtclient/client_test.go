@@ -593,6 +593,17 @@ func (h *testHarness) registerChannel(id uint64) {
 	}
 }
 
+// setChannelPolicy overrides the backup policy of the channel identified by id.
+func (h *testHarness) setChannelPolicy(id uint64, policy wtdb.ClientChanPolicy) {
+	h.t.Helper()
+
+	chanID := chanIDFromInt(id)
+	err := h.client.SetChannelPolicy(chanID, policy)
+	if err != nil {
+		h.t.Fatalf("unable to set policy of channel %d: %v", id, err)
+	}
+}
+
 // advanceChannelN calls advanceState on the channel identified by id the number
 // of provided times and returns the breach hints corresponding to the new
 // states.
@@ -1398,6 +1409,78 @@ var clientTests = []clientTest{
 			h.waitServerUpdates(hints, 5*time.Second)
 		},
 	},
+	{
+		// Asserts that the client skips backups of channels whose
+		// policy override opts out of backups, that the override is
+		// persisted across restarts, and that backups resume once the
+		// override is removed.
+		name: "channel policy override",
+		cfg: harnessCfg{
+			localBalance:  localBalance,
+			remoteBalance: remoteBalance,
+			policy: wtpolicy.Policy{
+				TxPolicy: wtpolicy.TxPolicy{
+					BlobType:     blob.TypeAltruistCommit,
+					SweepFeeRate: wtpolicy.DefaultSweepFeeRate,
+				},
+				MaxUpdates: 5,
+			},
+		},
+		fn: func(h *testHarness) {
+			const (
+				numUpdates = 15
+				chanID     = 0
+			)
+
+			hints := h.advanceChannelN(chanID, numUpdates)
+
+			// Opt the channel out of backups. None of the first
+			// batch of states should reach the tower.
+			h.setChannelPolicy(chanID, wtdb.ClientChanPolicy{
+				BackupsDisabled: true,
+			})
+			h.backupStates(chanID, 0, numUpdates/3, nil)
+
+			// Restart the client and limit the sweep fee rate of
+			// the channel below the client's, which should also
+			// prevent the second batch from being backed up.
+			h.client.Stop()
+			h.startClient()
+			defer h.client.ForceQuit()
+
+			policies := h.client.ChannelPolicies()
+			if !policies[chanIDFromInt(chanID)].BackupsDisabled {
+				h.t.Fatalf("channel policy not persisted")
+			}
+
+			h.setChannelPolicy(chanID, wtdb.ClientChanPolicy{
+				MaxSweepFeeRate: wtpolicy.DefaultSweepFeeRate - 1,
+			})
+			h.backupStates(chanID, numUpdates/3, 2*numUpdates/3, nil)
+
+			// Finally, remove the override and back up the last
+			// batch, which should be the only one received by the
+			// tower.
+			h.setChannelPolicy(chanID, wtdb.ClientChanPolicy{})
+			if len(h.client.ChannelPolicies()) != 0 {
+				h.t.Fatalf("channel policy not removed")
+			}
+			h.backupStates(chanID, 2*numUpdates/3, numUpdates, nil)
+
+			h.waitServerUpdates(hints[2*numUpdates/3:], 5*time.Second)
+
+			matches, err := h.serverDB.QueryMatches(
+				hints[:2*numUpdates/3],
+			)
+			if err != nil {
+				h.t.Fatalf("unable to query for hints: %v", err)
+			}
+			if len(matches) != 0 {
+				h.t.Fatalf("expected no backups for skipped "+
+					"states, got %d", len(matches))
+			}
+		},
+	},
 }
 
 // TestClient executes the client test suite, asserting the ability to backup
@@ -75,6 +75,15 @@ type DB interface {
 	// the client's active policy.
 	RegisterChannel(lnwire.ChannelID, []byte) error
 
+	// FetchChanPolicies loads a mapping from all channels with a policy
+	// override to their channel policies.
+	FetchChanPolicies() (wtdb.ChannelPolicies, error)
+
+	// SetChanPolicy persists the policy override of a registered channel.
+	// Setting a policy that doesn't override any of the client's behavior
+	// removes the channel's existing override.
+	SetChanPolicy(lnwire.ChannelID, *wtdb.ClientChanPolicy) error
+
 	// MarkBackupIneligible records that the state identified by the
 	// (channel id, commit height) tuple was ineligible for being backed up
 	// under the current policy. This state can be retried later under a
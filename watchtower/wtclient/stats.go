@@ -10,9 +10,10 @@ import (
 type ClientStats struct {
 	mu sync.Mutex
 
-	// NumTasksReceived is the total number of backups that are pending to
-	// be acknowledged by all active and exhausted watchtower sessions.
-	NumTasksReceived int
+	// NumTasksPending is the number of backups received from active
+	// channels that are neither accepted by a session nor found
+	// ineligible yet.
+	NumTasksPending int
 
 	// NumTasksAccepted is the total number of backups made to all active
 	// and exhausted watchtower sessions.
@@ -31,29 +32,32 @@ type ClientStats struct {
 	NumSessionsExhausted int
 }
 
-// taskReceived increments the number to backup requests the client has received
-// from active channels.
+// taskReceived increments the number of pending backup requests the client has
+// received from active channels.
 func (s *ClientStats) taskReceived() {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	s.NumTasksReceived++
+	s.NumTasksPending++
 }
 
 // taskAccepted increments the number of tasks that have been assigned to active
-// session queues, and are awaiting upload to a tower.
+// session queues, and are awaiting upload to a tower. The task is no longer
+// pending.
 func (s *ClientStats) taskAccepted() {
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	s.NumTasksPending--
 	s.NumTasksAccepted++
 }
 
 // taskIneligible increments the number of tasks that were unable to satisfy the
 // active session queue's policy. These can potentially be retried later, but
 // typically this means that the balance created dust outputs, so it may not be
-// worth backing up at all.
+// worth backing up at all. The task is no longer pending.
 func (s *ClientStats) taskIneligible() {
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	s.NumTasksPending--
 	s.NumTasksIneligible++
 }
 
@@ -77,8 +81,8 @@ func (s *ClientStats) sessionExhausted() {
 func (s *ClientStats) String() string {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	return fmt.Sprintf("tasks(received=%d accepted=%d ineligible=%d) "+
-		"sessions(acquired=%d exhausted=%d)", s.NumTasksReceived,
+	return fmt.Sprintf("tasks(pending=%d accepted=%d ineligible=%d) "+
+		"sessions(acquired=%d exhausted=%d)", s.NumTasksPending,
 		s.NumTasksAccepted, s.NumTasksIneligible, s.NumSessionsAcquired,
 		s.NumSessionsExhausted)
 }
@@ -88,7 +92,7 @@ func (s *ClientStats) Copy() ClientStats {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	return ClientStats{
-		NumTasksReceived:     s.NumTasksReceived,
+		NumTasksPending:      s.NumTasksPending,
 		NumTasksAccepted:     s.NumTasksAccepted,
 		NumTasksIneligible:   s.NumTasksIneligible,
 		NumSessionsAcquired:  s.NumSessionsAcquired,
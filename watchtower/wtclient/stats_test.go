@@ -0,0 +1,41 @@
+package wtclient
+
+import "testing"
+
+// TestClientStatsPendingTasks asserts that tasks are only counted as pending
+// until they are either accepted or found ineligible.
+func TestClientStatsPendingTasks(t *testing.T) {
+	t.Parallel()
+
+	var stats ClientStats
+	for i := 0; i < 3; i++ {
+		stats.taskReceived()
+	}
+	if stats.Copy().NumTasksPending != 3 {
+		t.Fatalf("expected 3 pending tasks, got %d",
+			stats.Copy().NumTasksPending)
+	}
+
+	stats.taskAccepted()
+	stats.taskIneligible()
+
+	snapshot := stats.Copy()
+	if snapshot.NumTasksPending != 1 {
+		t.Fatalf("expected 1 pending task, got %d",
+			snapshot.NumTasksPending)
+	}
+	if snapshot.NumTasksAccepted != 1 {
+		t.Fatalf("expected 1 accepted task, got %d",
+			snapshot.NumTasksAccepted)
+	}
+	if snapshot.NumTasksIneligible != 1 {
+		t.Fatalf("expected 1 ineligible task, got %d",
+			snapshot.NumTasksIneligible)
+	}
+
+	stats.taskAccepted()
+	if stats.Copy().NumTasksPending != 0 {
+		t.Fatalf("expected no pending task, got %d",
+			stats.Copy().NumTasksPending)
+	}
+}
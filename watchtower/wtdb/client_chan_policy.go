@@ -0,0 +1,57 @@
+package wtdb
+
+import (
+	"io"
+
+	"github.com/decred/dcrlnd/lnwallet"
+	"github.com/decred/dcrlnd/lnwire"
+)
+
+// ChannelPolicies is a map for a given channel id to it's ClientChanPolicy.
+type ChannelPolicies map[lnwire.ChannelID]ClientChanPolicy
+
+// ClientChanPolicy holds the per-channel overrides of the client's backup
+// behavior. Channels without a ClientChanPolicy are backed up according to
+// the client's configured policy.
+type ClientChanPolicy struct {
+	// BackupsDisabled signals that revoked states of this channel should
+	// not be backed up to any tower.
+	BackupsDisabled bool
+
+	// MaxSweepFeeRate is the highest sweep fee rate the channel's justice
+	// transactions may pay. Revoked states are not backed up while the
+	// client's policy specifies a higher sweep fee rate, which allows
+	// low-value channels to forgo backups that would be mostly consumed by
+	// fees. A value of zero means no limit.
+	MaxSweepFeeRate lnwallet.AtomPerKByte
+}
+
+// IsDefault returns true if the policy doesn't override any of the client's
+// backup behavior.
+func (p *ClientChanPolicy) IsDefault() bool {
+	return !p.BackupsDisabled && p.MaxSweepFeeRate == 0
+}
+
+// Encode writes the ClientChanPolicy to the passed io.Writer.
+func (p *ClientChanPolicy) Encode(w io.Writer) error {
+	return WriteElements(w,
+		p.BackupsDisabled,
+		uint64(p.MaxSweepFeeRate),
+	)
+}
+
+// Decode reads a ClientChanPolicy from the passed io.Reader.
+func (p *ClientChanPolicy) Decode(r io.Reader) error {
+	var maxSweepFeeRate uint64
+	err := ReadElements(r,
+		&p.BackupsDisabled,
+		&maxSweepFeeRate,
+	)
+	if err != nil {
+		return err
+	}
+
+	p.MaxSweepFeeRate = lnwallet.AtomPerKByte(maxSweepFeeRate)
+
+	return nil
+}
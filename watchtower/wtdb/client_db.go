@@ -26,6 +26,10 @@ var (
 	//   channel-id -> encoded ClientChanSummary.
 	cChanSummaryBkt = []byte("client-channel-summary-bucket")
 
+	// cChanPolicyBkt is a top-level bucket storing:
+	//   channel-id -> encoded ClientChanPolicy.
+	cChanPolicyBkt = []byte("client-channel-policy-bucket")
+
 	// cSessionBkt is a top-level bucket storing:
 	//   session-id => cSessionBody -> encoded ClientSessionBody
 	//              => cSessionCommits => seqnum -> encoded CommittedUpdate
@@ -161,6 +165,7 @@ func initClientDBBuckets(tx *bolt.Tx) error {
 	buckets := [][]byte{
 		cSessionKeyIndexBkt,
 		cChanSummaryBkt,
+		cChanPolicyBkt,
 		cSessionBkt,
 		cTowerBkt,
 		cTowerIndexBkt,
@@ -205,6 +210,13 @@ func (c *ClientDB) Close() error {
 	return c.db.Close()
 }
 
+// BeginSnapshot starts a read-only transaction providing a consistent view of
+// the database, used to copy it while it's in use. The caller must roll back
+// the transaction once done.
+func (c *ClientDB) BeginSnapshot() (*bolt.Tx, error) {
+	return c.db.Begin(false)
+}
+
 // CreateTower initialize an address record used to communicate with a
 // watchtower. Each Tower is assigned a unique ID, that is used to amortize
 // storage costs of the public key when used by multiple sessions. If the tower
@@ -677,6 +689,75 @@ func (c *ClientDB) RegisterChannel(chanID lnwire.ChannelID,
 	})
 }
 
+// FetchChanPolicies loads a mapping from all channels with a policy override
+// to their ClientChanPolicy.
+func (c *ClientDB) FetchChanPolicies() (ChannelPolicies, error) {
+	policies := make(ChannelPolicies)
+	err := c.db.View(func(tx *bolt.Tx) error {
+		chanPolicies := tx.Bucket(cChanPolicyBkt)
+		if chanPolicies == nil {
+			return ErrUninitializedDB
+		}
+
+		return chanPolicies.ForEach(func(k, v []byte) error {
+			var chanID lnwire.ChannelID
+			copy(chanID[:], k)
+
+			var policy ClientChanPolicy
+			err := policy.Decode(bytes.NewReader(v))
+			if err != nil {
+				return err
+			}
+
+			policies[chanID] = policy
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+// SetChanPolicy persists the policy override of a registered channel. Setting
+// a policy that doesn't override any of the client's behavior removes the
+// channel's existing override. ErrChannelNotRegistered is returned if the
+// channel has not been registered.
+func (c *ClientDB) SetChanPolicy(chanID lnwire.ChannelID,
+	policy *ClientChanPolicy) error {
+
+	return c.db.Update(func(tx *bolt.Tx) error {
+		chanSummaries := tx.Bucket(cChanSummaryBkt)
+		if chanSummaries == nil {
+			return ErrUninitializedDB
+		}
+
+		chanPolicies := tx.Bucket(cChanPolicyBkt)
+		if chanPolicies == nil {
+			return ErrUninitializedDB
+		}
+
+		// Only registered channels may have their policy overridden.
+		_, err := getChanSummary(chanSummaries, chanID)
+		if err != nil {
+			return err
+		}
+
+		if policy.IsDefault() {
+			return chanPolicies.Delete(chanID[:])
+		}
+
+		var b bytes.Buffer
+		if err := policy.Encode(&b); err != nil {
+			return err
+		}
+
+		return chanPolicies.Put(chanID[:], b.Bytes())
+	})
+}
+
 // MarkBackupIneligible records that the state identified by the (channel id,
 // commit height) tuple was ineligible for being backed up under the current
 // policy. This state can be retried later under a different policy.
@@ -195,6 +195,29 @@ func (h *clientDBHarness) registerChan(chanID lnwire.ChannelID,
 	}
 }
 
+func (h *clientDBHarness) fetchChanPolicies() wtdb.ChannelPolicies {
+	h.t.Helper()
+
+	policies, err := h.db.FetchChanPolicies()
+	if err != nil {
+		h.t.Fatalf("unable to fetch chan policies: %v", err)
+	}
+
+	return policies
+}
+
+func (h *clientDBHarness) setChanPolicy(chanID lnwire.ChannelID,
+	policy *wtdb.ClientChanPolicy, expErr error) {
+
+	h.t.Helper()
+
+	err := h.db.SetChanPolicy(chanID, policy)
+	if err != expErr {
+		h.t.Fatalf("expected set chan policy error: %v, got: %v",
+			expErr, err)
+	}
+}
+
 func (h *clientDBHarness) commitUpdate(id *wtdb.SessionID,
 	update *wtdb.CommittedUpdate, expErr error) uint16 {
 
@@ -523,6 +546,47 @@ func testChanSummaries(h *clientDBHarness) {
 	h.registerChan(chanID, expPkScript, wtdb.ErrChannelAlreadyRegistered)
 }
 
+// testChanPolicies asserts that policy overrides can only be set for registered
+// channels, and that they can be updated and removed.
+func testChanPolicies(h *clientDBHarness) {
+	chanID := lnwire.ChannelID{0x01}
+	policy := &wtdb.ClientChanPolicy{
+		BackupsDisabled: true,
+	}
+
+	// Setting the policy of an unregistered channel should fail.
+	h.setChanPolicy(chanID, policy, wtdb.ErrChannelNotRegistered)
+	if len(h.fetchChanPolicies()) != 0 {
+		h.t.Fatalf("expected no chan policies")
+	}
+
+	// Once registered, the policy should be persisted.
+	h.registerChan(chanID, []byte{0x01, 0x02}, nil)
+	h.setChanPolicy(chanID, policy, nil)
+
+	if p := h.fetchChanPolicies()[chanID]; p != *policy {
+		h.t.Fatalf("chan policy mismatch, want: %v, got: %v",
+			*policy, p)
+	}
+
+	// Overwriting the policy should replace the previous one.
+	policy = &wtdb.ClientChanPolicy{
+		MaxSweepFeeRate: 10000,
+	}
+	h.setChanPolicy(chanID, policy, nil)
+
+	if p := h.fetchChanPolicies()[chanID]; p != *policy {
+		h.t.Fatalf("chan policy mismatch, want: %v, got: %v",
+			*policy, p)
+	}
+
+	// Finally, setting the default policy should remove the override.
+	h.setChanPolicy(chanID, &wtdb.ClientChanPolicy{}, nil)
+	if _, ok := h.fetchChanPolicies()[chanID]; ok {
+		h.t.Fatalf("chan policy should have been removed")
+	}
+}
+
 // testCommitUpdate tests the behavior of CommitUpdate, ensuring that they can
 func testCommitUpdate(h *clientDBHarness) {
 	session := &wtdb.ClientSession{
@@ -836,6 +900,10 @@ func TestClientDB(t *testing.T) {
 			name: "chan summaries",
 			run:  testChanSummaries,
 		},
+		{
+			name: "chan policies",
+			run:  testChanPolicies,
+		},
 		{
 			name: "commit update",
 			run:  testCommitUpdate,
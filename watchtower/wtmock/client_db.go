@@ -19,6 +19,7 @@ type ClientDB struct {
 
 	mu             sync.Mutex
 	summaries      map[lnwire.ChannelID]wtdb.ClientChanSummary
+	policies       map[lnwire.ChannelID]wtdb.ClientChanPolicy
 	activeSessions map[wtdb.SessionID]*wtdb.ClientSession
 	towerIndex     map[towerPK]wtdb.TowerID
 	towers         map[wtdb.TowerID]*wtdb.Tower
@@ -31,6 +32,7 @@ type ClientDB struct {
 func NewClientDB() *ClientDB {
 	return &ClientDB{
 		summaries:      make(map[lnwire.ChannelID]wtdb.ClientChanSummary),
+		policies:       make(map[lnwire.ChannelID]wtdb.ClientChanPolicy),
 		activeSessions: make(map[wtdb.SessionID]*wtdb.ClientSession),
 		towerIndex:     make(map[towerPK]wtdb.TowerID),
 		towers:         make(map[wtdb.TowerID]*wtdb.Tower),
@@ -404,6 +406,43 @@ func (m *ClientDB) RegisterChannel(chanID lnwire.ChannelID,
 	return nil
 }
 
+// FetchChanPolicies loads a mapping from all channels with a policy override
+// to their ClientChanPolicy.
+func (m *ClientDB) FetchChanPolicies() (wtdb.ChannelPolicies, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	policies := make(wtdb.ChannelPolicies)
+	for chanID, policy := range m.policies {
+		policies[chanID] = policy
+	}
+
+	return policies, nil
+}
+
+// SetChanPolicy persists the policy override of a registered channel. Setting
+// a policy that doesn't override any of the client's behavior removes the
+// channel's existing override.
+func (m *ClientDB) SetChanPolicy(chanID lnwire.ChannelID,
+	policy *wtdb.ClientChanPolicy) error {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.summaries[chanID]; !ok {
+		return wtdb.ErrChannelNotRegistered
+	}
+
+	if policy.IsDefault() {
+		delete(m.policies, chanID)
+		return nil
+	}
+
+	m.policies[chanID] = *policy
+
+	return nil
+}
+
 func cloneBytes(b []byte) []byte {
 	if b == nil {
 		return nil
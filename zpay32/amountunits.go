@@ -2,6 +2,7 @@ package zpay32
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 
 	"github.com/decred/dcrlnd/lnwire"
@@ -27,19 +28,28 @@ var (
 	}
 )
 
+// mulMAtoms returns the given amount multiplied by the number of MilliAtoms
+// per unit, failing if the result overflows.
+func mulMAtoms(amt, mAtPerUnit uint64) (lnwire.MilliAtom, error) {
+	if amt > math.MaxUint64/mAtPerUnit {
+		return 0, fmt.Errorf("amount %d overflows mAt", amt)
+	}
+	return lnwire.MilliAtom(amt * mAtPerUnit), nil
+}
+
 // mDcrToMAtoms converts the given amount in milliDCR to MilliAtoms.
 func mDcrToMAtoms(m uint64) (lnwire.MilliAtom, error) {
-	return lnwire.MilliAtom(m) * 100000000, nil
+	return mulMAtoms(m, 100000000)
 }
 
 // uDcrToMAtoms converts the given amount in microDCR to MilliAtoms.
 func uDcrToMAtoms(u uint64) (lnwire.MilliAtom, error) {
-	return lnwire.MilliAtom(u * 100000), nil
+	return mulMAtoms(u, 100000)
 }
 
 // nDcrToMAtoms converts the given amount in nanoDCR to MilliAtoms.
 func nDcrToMAtoms(n uint64) (lnwire.MilliAtom, error) {
-	return lnwire.MilliAtom(n * 100), nil
+	return mulMAtoms(n, 100)
 }
 
 // pDcrToMAtoms converts the given amount in picoDCR to MilliAtoms.
@@ -101,7 +111,7 @@ func decodeAmount(amount string) (lnwire.MilliAtom, error) {
 		if err != nil {
 			return 0, err
 		}
-		return lnwire.MilliAtom(dcr) * mAtPerDcr, nil
+		return mulMAtoms(dcr, mAtPerDcr)
 	}
 
 	// If not a digit, it must be part of the known units.
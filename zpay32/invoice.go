@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"math"
 	"strings"
 	"time"
 
@@ -49,6 +50,9 @@ const (
 	// fieldTypeP is the field containing the payment hash.
 	fieldTypeP = 1
 
+	// fieldTypeS is the field containing the payment address.
+	fieldTypeS = 16
+
 	// fieldTypeD contains a short description of the payment.
 	fieldTypeD = 13
 
@@ -74,6 +78,10 @@ const (
 	// supported or required by the receiver.
 	fieldType9 = 5
 
+	// fieldTypeM contains the payment metadata which is to be included in
+	// the final hop payload of the payments to the invoice.
+	fieldTypeM = 27
+
 	// maxInvoiceLength is the maximum total length an invoice can have.
 	// This is chosen to be the maximum number of bytes that can fit into a
 	// single QR code: https://en.wikipedia.org/wiki/QR_code#Storage
@@ -83,7 +91,14 @@ const (
 var (
 	// InvoiceFeatures holds the set of all known feature bits that are
 	// exposed as BOLT 11 features.
-	InvoiceFeatures = map[lnwire.FeatureBit]string{}
+	InvoiceFeatures = map[lnwire.FeatureBit]string{
+		lnwire.TLVOnionPayloadRequired: "tlv-onion",
+		lnwire.TLVOnionPayloadOptional: "tlv-onion",
+		lnwire.PaymentAddrRequired:     "payment-addr",
+		lnwire.PaymentAddrOptional:     "payment-addr",
+		lnwire.PaymentMetadataRequired: "payment-metadata",
+		lnwire.PaymentMetadataOptional: "payment-metadata",
+	}
 
 	// ErrInvoiceTooLarge is returned when an invoice exceeds maxInvoiceLength.
 	ErrInvoiceTooLarge = errors.New("invoice is too large")
@@ -128,6 +143,10 @@ type Invoice struct {
 	// invoice.
 	PaymentHash *[32]byte
 
+	// PaymentAddr is the payment address to be used by payments to prevent
+	// probing of the destination.
+	PaymentAddr *[32]byte
+
 	// Destination is the public key of the target node. This will always
 	// be set after decoding, and can optionally be set before encoding to
 	// include the pubkey as an 'n' field. If this is not set before
@@ -179,6 +198,14 @@ type Invoice struct {
 	// Features represents an optional field used to signal optional or
 	// required support for features by the receiver.
 	Features *lnwire.FeatureVector
+
+	// Metadata is an opaque blob set by the receiver, which the payer
+	// should send back to it in the final hop payload of the payment.
+	// This allows the receiver to be stateless about the invoices it
+	// issued.
+	//
+	// NOTE: This is optional.
+	Metadata []byte
 }
 
 // Amount is a functional option that allows callers of NewInvoice to set the
@@ -197,6 +224,31 @@ func Destination(destination *secp256k1.PublicKey) func(*Invoice) {
 	}
 }
 
+// PaymentAddr is a functional option that allows callers of NewInvoice to set
+// the desired payment address that is advertised on the invoice.
+func PaymentAddr(addr [32]byte) func(*Invoice) {
+	return func(i *Invoice) {
+		i.PaymentAddr = &addr
+	}
+}
+
+// Features is a functional option that allows callers of NewInvoice to set the
+// desired feature bits that are advertised on the invoice.
+func Features(features *lnwire.FeatureVector) func(*Invoice) {
+	return func(i *Invoice) {
+		i.Features = features
+	}
+}
+
+// Metadata is a functional option that allows callers of NewInvoice to set
+// the payment metadata of the created Invoice. The payment-metadata feature bit
+// should be set along with it through the Features option.
+func Metadata(metadata []byte) func(*Invoice) {
+	return func(i *Invoice) {
+		i.Metadata = metadata
+	}
+}
+
 // Description is a functional option that allows callers of NewInvoice to set
 // the payment description of the created Invoice.
 //
@@ -325,6 +377,13 @@ func Decode(invoice string, net *chaincfg.Params) (*Invoice, error) {
 		decodedInvoice.MilliAt = &amount
 	}
 
+	// The data must at least hold the signature, which makes up its last
+	// 520 bits.
+	if len(data) < signatureBase32Len {
+		return nil, fmt.Errorf("data too short to hold signature: %d",
+			len(data))
+	}
+
 	// Everything except the last 520 bits of the data encodes the invoice's
 	// timestamp and tagged fields.
 	invoiceData := data[:len(data)-signatureBase32Len]
@@ -629,6 +688,14 @@ func parseTaggedFields(invoice *Invoice, fields []byte, net *chaincfg.Params) er
 			}
 
 			invoice.PaymentHash, err = parsePaymentHash(base32Data)
+		case fieldTypeS:
+			if invoice.PaymentAddr != nil {
+				// We skip the field if we have already seen a
+				// supported one.
+				continue
+			}
+
+			invoice.PaymentAddr, err = parsePaymentAddr(base32Data)
 		case fieldTypeD:
 			if invoice.Description != nil {
 				// We skip the field if we have already seen a
@@ -695,6 +762,14 @@ func parseTaggedFields(invoice *Invoice, fields []byte, net *chaincfg.Params) er
 			}
 
 			invoice.Features, err = parseFeatures(base32Data)
+		case fieldTypeM:
+			if invoice.Metadata != nil {
+				// We skip the field if we have already seen a
+				// supported one.
+				continue
+			}
+
+			invoice.Metadata, err = parseMetadata(base32Data)
 		default:
 			// Ignore unknown type.
 		}
@@ -722,22 +797,33 @@ func parseFieldDataLength(data []byte) (uint16, error) {
 // parsePaymentHash converts a 256-bit payment hash (encoded in base32)
 // to *[32]byte.
 func parsePaymentHash(data []byte) (*[32]byte, error) {
-	var paymentHash [32]byte
+	return parse32Bytes(data)
+}
 
-	// As BOLT-11 states, a reader must skip over the payment hash field if
-	// it does not have a length of 52, so avoid returning an error.
+// parsePaymentAddr converts a 256-bit payment address (encoded in base32)
+// to *[32]byte.
+func parsePaymentAddr(data []byte) (*[32]byte, error) {
+	return parse32Bytes(data)
+}
+
+// parse32Bytes converts a 256-bit value (encoded in base32) to *[32]byte.
+func parse32Bytes(data []byte) (*[32]byte, error) {
+	var value [32]byte
+
+	// As BOLT-11 states, a reader must skip over the 32-byte fields if
+	// they don't have a length of 52, so avoid returning an error.
 	if len(data) != hashBase32Len {
 		return nil, nil
 	}
 
-	hash, err := bech32.ConvertBits(data, 5, 8, false)
+	b, err := bech32.ConvertBits(data, 5, 8, false)
 	if err != nil {
 		return nil, err
 	}
 
-	copy(paymentHash[:], hash)
+	copy(value[:], b)
 
-	return &paymentHash, nil
+	return &value, nil
 }
 
 // parseDescription converts the data (encoded in base32) into a string to use
@@ -798,6 +884,12 @@ func parseExpiry(data []byte) (*time.Duration, error) {
 		return nil, err
 	}
 
+	// The expiry must be representable as a duration.
+	if expiry > math.MaxInt64/uint64(time.Second) {
+		return nil, fmt.Errorf("expiry of %d seconds is too large",
+			expiry)
+	}
+
 	duration := time.Duration(expiry) * time.Second
 
 	return &duration, nil
@@ -909,6 +1001,12 @@ func parseFeatures(data []byte) (*lnwire.FeatureVector, error) {
 	return fv, nil
 }
 
+// parseMetadata converts the data (encoded in base32) into the payment
+// metadata of the invoice.
+func parseMetadata(data []byte) ([]byte, error) {
+	return bech32.ConvertBits(data, 5, 8, false)
+}
+
 // writeTaggedFields writes the non-nil tagged fields of the Invoice to the
 // base32 buffer.
 func writeTaggedFields(bufferBase32 *bytes.Buffer, invoice *Invoice) error {
@@ -930,6 +1028,15 @@ func writeTaggedFields(bufferBase32 *bytes.Buffer, invoice *Invoice) error {
 		}
 	}
 
+	if invoice.PaymentAddr != nil {
+		err := writeBytes32(
+			bufferBase32, fieldTypeS, *invoice.PaymentAddr,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
 	if invoice.Description != nil {
 		base32, err := bech32.ConvertBits([]byte(*invoice.Description),
 			8, 5, true)
@@ -1068,9 +1175,38 @@ func writeTaggedFields(bufferBase32 *bytes.Buffer, invoice *Invoice) error {
 		}
 	}
 
+	if len(invoice.Metadata) > 0 {
+		base32, err := bech32.ConvertBits(invoice.Metadata, 8, 5, true)
+		if err != nil {
+			return err
+		}
+
+		err = writeTaggedField(bufferBase32, fieldTypeM, base32)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// writeBytes32 encodes a 32-byte array as base32 and writes it to the buffer
+// as a tagged field of the given type.
+func writeBytes32(bufferBase32 *bytes.Buffer, fieldType byte,
+	b [32]byte) error {
+
+	base32, err := bech32.ConvertBits(b[:], 8, 5, true)
+	if err != nil {
+		return err
+	}
+	if len(base32) != hashBase32Len {
+		return fmt.Errorf("invalid 32-byte field length: %d",
+			len(base32))
+	}
+
+	return writeTaggedField(bufferBase32, fieldType, base32)
+}
+
 // writeTaggedField takes the type of a tagged data field, and the data of
 // the tagged field (encoded in base32), and writes the type, length and data
 // to the buffer.
@@ -1111,6 +1247,11 @@ func base32ToUint64(data []byte) (uint64, error) {
 			len(data))
 	}
 
+	// Only the lowest bit of the first of 13 groups fits in uint64.
+	if len(data) == 13 && data[0] > 1 {
+		return 0, fmt.Errorf("base32 encoded number overflows uint64")
+	}
+
 	val := uint64(0)
 	for i := 0; i < len(data); i++ {
 		val = val<<5 | uint64(data[i])
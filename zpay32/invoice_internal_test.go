@@ -125,6 +125,22 @@ func TestDecodeAmount(t *testing.T) {
 			valid:  true,
 			result: 2100000000000000000, // mAt
 		},
+		{
+			amount: "200000000", // DCR
+			valid:  false,       // overflows mAt
+		},
+		{
+			amount: "184467440738m", // mDCR
+			valid:  false,           // overflows mAt
+		},
+		{
+			amount: "184467440737096u", // uDCR
+			valid:  false,              // overflows mAt
+		},
+		{
+			amount: "184467440737095517n", // nDCR
+			valid:  false,                 // overflows mAt
+		},
 	}
 
 	for i, test := range tests {
@@ -535,6 +551,13 @@ func TestParseExpiry(t *testing.T) {
 			},
 			valid: false, // data too long
 		},
+		{
+			data: []byte{
+				0x1f, 0x1f, 0x1f, 0x1f, 0x1f, 0x1f,
+				0x1f, 0x1f,
+			},
+			valid: false, // overflows duration
+		},
 	}
 
 	for i, test := range tests {
@@ -589,6 +612,14 @@ func TestParseMinFinalCLTVExpiry(t *testing.T) {
 			},
 			valid: false, // data too long
 		},
+		{
+			data: []byte{
+				0x2, 0x0, 0x0, 0x0, 0x0, 0x0,
+				0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+				0x0,
+			},
+			valid: false, // overflows uint64
+		},
 	}
 
 	for i, test := range tests {
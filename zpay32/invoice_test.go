@@ -117,6 +117,10 @@ func TestDecodeEncode(t *testing.T) {
 			encodedInvoice: "lndcr1abcde", // too short
 			valid:          false,
 		},
+		{
+			encodedInvoice: "lndcr1qqqqqqq99ff7s", // too short for signature
+			valid:          false,
+		},
 		{
 			encodedInvoice: "1asdsaddnv4wudz", // empty hrp
 			valid:          false,
@@ -726,6 +730,94 @@ func TestMaxInvoiceLength(t *testing.T) {
 	}
 }
 
+// TestPaymentAddrRoundTrip asserts that the payment address and the
+// payment-addr feature bit of an invoice survive encoding and decoding.
+func TestPaymentAddrRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	payAddr := [32]byte{1, 2, 3}
+	features := lnwire.NewFeatureVector(
+		lnwire.NewRawFeatureVector(
+			lnwire.TLVOnionPayloadOptional,
+			lnwire.PaymentAddrRequired,
+		),
+		InvoiceFeatures,
+	)
+
+	invoice, err := NewInvoice(
+		chaincfg.MainNetParams(), testPaymentHash,
+		time.Unix(1496314658, 0), Description(testCupOfCoffee),
+		PaymentAddr(payAddr), Features(features),
+	)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+
+	encoded, err := invoice.Encode(testMessageSigner)
+	if err != nil {
+		t.Fatalf("unable to encode invoice: %v", err)
+	}
+
+	decoded, err := Decode(encoded, chaincfg.MainNetParams())
+	if err != nil {
+		t.Fatalf("unable to decode invoice: %v", err)
+	}
+
+	if decoded.PaymentAddr == nil || *decoded.PaymentAddr != payAddr {
+		t.Fatalf("expected payment addr %x, got %v", payAddr,
+			decoded.PaymentAddr)
+	}
+	if !decoded.Features.HasFeature(lnwire.PaymentAddrRequired) {
+		t.Fatalf("expected payment addr to be required")
+	}
+}
+
+// TestMetadataRoundTrip asserts that the payment metadata, the feature bits
+// and the final CLTV delta of an invoice survive encoding and decoding.
+func TestMetadataRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	metadata := []byte{0x01, 0xfa, 0xfa, 0xf0}
+	features := lnwire.NewFeatureVector(
+		lnwire.NewRawFeatureVector(
+			lnwire.TLVOnionPayloadOptional,
+			lnwire.PaymentMetadataOptional,
+		),
+		InvoiceFeatures,
+	)
+
+	invoice, err := NewInvoice(
+		chaincfg.MainNetParams(), testPaymentHash,
+		time.Unix(1496314658, 0), Description(testCupOfCoffee),
+		Metadata(metadata), Features(features), CLTVExpiry(144),
+	)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+
+	encoded, err := invoice.Encode(testMessageSigner)
+	if err != nil {
+		t.Fatalf("unable to encode invoice: %v", err)
+	}
+
+	decoded, err := Decode(encoded, chaincfg.MainNetParams())
+	if err != nil {
+		t.Fatalf("unable to decode invoice: %v", err)
+	}
+
+	invoice.Destination = testPubKey
+	if err := compareInvoices(invoice, decoded); err != nil {
+		t.Fatalf("decoded invoice doesn't match: %v", err)
+	}
+	if !decoded.Features.HasFeature(lnwire.PaymentMetadataOptional) {
+		t.Fatalf("expected payment metadata feature to be set")
+	}
+	if decoded.MinFinalCLTVExpiry() != 144 {
+		t.Fatalf("expected min final cltv expiry 144, got %d",
+			decoded.MinFinalCLTVExpiry())
+	}
+}
+
 func compareInvoices(expected, actual *Invoice) error {
 	if !reflect.DeepEqual(expected.Net, actual.Net) {
 		return fmt.Errorf("expected net %v, got %v",
@@ -747,6 +839,11 @@ func compareInvoices(expected, actual *Invoice) error {
 			*expected.PaymentHash, *actual.PaymentHash)
 	}
 
+	if !compareHashes(expected.PaymentAddr, actual.PaymentAddr) {
+		return fmt.Errorf("expected payment addr %x, got %x",
+			expected.PaymentAddr, actual.PaymentAddr)
+	}
+
 	if !reflect.DeepEqual(expected.Description, actual.Description) {
 		return fmt.Errorf("expected description \"%s\", got \"%s\"",
 			*expected.Description, *actual.Description)
@@ -789,6 +886,11 @@ func compareInvoices(expected, actual *Invoice) error {
 			expected.Features.RawFeatureVector, actual.Features.RawFeatureVector)
 	}
 
+	if !bytes.Equal(expected.Metadata, actual.Metadata) {
+		return fmt.Errorf("expected metadata %x, got %x",
+			expected.Metadata, actual.Metadata)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,48 @@
+package zpay32
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// URIScheme is the scheme of the payment URIs of invoices.
+const URIScheme = "dcrln"
+
+// PaymentURI returns the canonical payment URI of the given invoice, whose
+// encoded form is payReq. Besides the invoice itself, the URI carries its
+// amount in DCR, the given label and its on-chain fallback address if any, so
+// that all wallets present the same information about the payment. The query
+// parameters are always written in the same order.
+func PaymentURI(payReq string, invoice *Invoice, label string) string {
+	params := url.Values{}
+	if invoice.MilliAt != nil {
+		amt := invoice.MilliAt.ToAtoms().ToCoin()
+		params.Set("amount", strconv.FormatFloat(amt, 'f', -1, 64))
+	}
+	if label != "" {
+		params.Set("label", label)
+	}
+	if invoice.FallbackAddr != nil {
+		params.Set("address", invoice.FallbackAddr.Address())
+	}
+
+	uri := URIScheme + ":" + strings.ToLower(payReq)
+	if len(params) == 0 {
+		return uri
+	}
+
+	// Spaces are percent-encoded rather than written as '+', as commonly
+	// expected from payment URIs.
+	query := strings.Replace(params.Encode(), "+", "%20", -1)
+	return uri + "?" + query
+}
+
+// QRPaymentURI returns the form of the payment URI of the invoice whose
+// encoded form is payReq that is best suited for QR codes. The URI is written
+// in upper case, allowing it to be encoded with the alphanumeric mode of QR
+// codes, which produces denser codes. As the case of the other parameters of
+// the canonical URI can't be changed, only the invoice is included.
+func QRPaymentURI(payReq string) string {
+	return strings.ToUpper(URIScheme + ":" + payReq)
+}
@@ -0,0 +1,67 @@
+package zpay32
+
+import (
+	"testing"
+)
+
+// TestPaymentURI asserts that the payment URIs of invoices carry their amount,
+// label and fallback address in a canonical form.
+func TestPaymentURI(t *testing.T) {
+	t.Parallel()
+
+	const payReq = "lntdcr1pvjluezpp5qqqsyqcyq5rqwzqfqqqsyqcyq5rqw"
+
+	tests := []struct {
+		name    string
+		invoice *Invoice
+		label   string
+		uri     string
+	}{
+		{
+			name:    "no parameters",
+			invoice: &Invoice{},
+			uri:     "dcrln:" + payReq,
+		},
+		{
+			name: "amount",
+			invoice: &Invoice{
+				MilliAt: &testMilliAt25mDCR,
+			},
+			uri: "dcrln:" + payReq + "?amount=0.025",
+		},
+		{
+			name: "all parameters",
+			invoice: &Invoice{
+				MilliAt:      &testMilliAt24DCR,
+				FallbackAddr: testAddrTestnet,
+			},
+			label: "1 cup coffee & cake",
+			uri: "dcrln:" + payReq + "?address=" +
+				testAddrTestnet.Address() + "&amount=24" +
+				"&label=1%20cup%20coffee%20%26%20cake",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			uri := PaymentURI(payReq, test.invoice, test.label)
+			if uri != test.uri {
+				t.Fatalf("expected uri %v, got %v", test.uri,
+					uri)
+			}
+		})
+	}
+}
+
+// TestQRPaymentURI asserts that the QR-friendly payment URIs of invoices only
+// contain upper case characters.
+func TestQRPaymentURI(t *testing.T) {
+	t.Parallel()
+
+	uri := QRPaymentURI("lntdcr1pvjluezpp5qqqsyqcyq5rqwzqfqqqs")
+	expectedURI := "DCRLN:LNTDCR1PVJLUEZPP5QQQSYQCYQ5RQWZQFQQQS"
+	if uri != expectedURI {
+		t.Fatalf("expected uri %v, got %v", expectedURI, uri)
+	}
+}